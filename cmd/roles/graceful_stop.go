@@ -0,0 +1,187 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package roles
+
+import (
+	"strings"
+	"sync"
+	"time"
+
+	"go.uber.org/zap"
+
+	"github.com/milvus-io/milvus/internal/http/healthz"
+	"github.com/milvus-io/milvus/pkg/log"
+)
+
+// the stages of graceful stop, the order is configured by common.gracefulStop.order
+const (
+	stopStageProxy       = "proxy"
+	stopStageDataNode    = "datanode"
+	stopStageQueryNode   = "querynode"
+	stopStageIndexNode   = "indexnode"
+	stopStageCoordinator = "coordinator"
+)
+
+const (
+	stopStatePending  = "pending"
+	stopStateStopping = "stopping"
+	stopStateDone     = "done"
+	stopStateTimeout  = "timeout"
+)
+
+var defaultStopOrder = []string{stopStageProxy, stopStageDataNode, stopStageQueryNode, stopStageIndexNode, stopStageCoordinator}
+
+type stopStage struct {
+	name       string
+	components []component
+	state      string
+	start      time.Time
+	elapsed    time.Duration
+}
+
+// gracefulStopper stops the components stage by stage,
+// the next stage starts after all components of the current stage stopped or the stage timeout.
+type gracefulStopper struct {
+	mu           sync.RWMutex
+	stages       []*stopStage
+	stageTimeout time.Duration
+}
+
+// newGracefulStopper creates the stopper with the given stage order,
+// the unknown stages are ignored, and the stages not listed are appended in the default order
+// to make sure all the components get stopped.
+func newGracefulStopper(order []string, stageTimeout time.Duration, components map[string][]component) *gracefulStopper {
+	stopper := &gracefulStopper{
+		stageTimeout: stageTimeout,
+	}
+
+	added := make(map[string]struct{})
+	addStage := func(name string) {
+		if _, ok := added[name]; ok {
+			return
+		}
+		added[name] = struct{}{}
+		stage := &stopStage{name: name, state: stopStatePending}
+		for _, c := range components[name] {
+			if c != nil {
+				stage.components = append(stage.components, c)
+			}
+		}
+		if len(stage.components) > 0 {
+			stopper.stages = append(stopper.stages, stage)
+		}
+	}
+
+	for _, name := range order {
+		name = strings.ToLower(strings.TrimSpace(name))
+		if !isKnownStopStage(name) {
+			log.Warn("unknown graceful stop stage, ignore it", zap.String("stage", name))
+			continue
+		}
+		addStage(name)
+	}
+	for _, name := range defaultStopOrder {
+		addStage(name)
+	}
+	return stopper
+}
+
+func isKnownStopStage(name string) bool {
+	for _, stage := range defaultStopOrder {
+		if stage == name {
+			return true
+		}
+	}
+	return false
+}
+
+// Stop stops all the stages in order.
+func (s *gracefulStopper) Stop() {
+	for _, stage := range s.stages {
+		s.stopStage(stage)
+	}
+}
+
+func (s *gracefulStopper) stopStage(stage *stopStage) {
+	log := log.With(zap.String("stage", stage.name))
+	s.mu.Lock()
+	stage.state = stopStateStopping
+	stage.start = time.Now()
+	s.mu.Unlock()
+	log.Info("graceful stop stage start", zap.Int("componentNum", len(stage.components)))
+
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		wg := sync.WaitGroup{}
+		for _, c := range stage.components {
+			wg.Add(1)
+			go func(c component) {
+				defer wg.Done()
+				if err := c.Stop(); err != nil {
+					log.Warn("failed to stop component", zap.String("component", c.GetName()), zap.Error(err))
+				}
+			}(c)
+		}
+		wg.Wait()
+	}()
+
+	state := stopStateDone
+	if s.stageTimeout > 0 {
+		timer := time.NewTimer(s.stageTimeout)
+		defer timer.Stop()
+		select {
+		case <-done:
+		case <-timer.C:
+			state = stopStateTimeout
+			log.Warn("graceful stop stage timeout, continue the next stage", zap.Duration("timeout", s.stageTimeout))
+		}
+	} else {
+		<-done
+	}
+
+	s.mu.Lock()
+	stage.state = state
+	stage.elapsed = time.Since(stage.start)
+	s.mu.Unlock()
+	log.Info("graceful stop stage finished", zap.String("state", state), zap.Duration("elapsed", stage.elapsed))
+}
+
+// Progress returns the progress of all stages, reported via healthz.
+func (s *gracefulStopper) Progress() []*healthz.StopStageState {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	progress := make([]*healthz.StopStageState, 0, len(s.stages))
+	for _, stage := range s.stages {
+		names := make([]string, 0, len(stage.components))
+		for _, c := range stage.components {
+			names = append(names, c.GetName())
+		}
+		elapsed := stage.elapsed
+		if stage.state == stopStateStopping {
+			elapsed = time.Since(stage.start)
+		}
+		progress = append(progress, &healthz.StopStageState{
+			Stage:      stage.name,
+			Components: names,
+			State:      stage.state,
+			ElapsedMs:  elapsed.Milliseconds(),
+		})
+	}
+	return progress
+}
@@ -0,0 +1,118 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package roles
+
+import (
+	"context"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/milvus-io/milvus-proto/go-api/v2/commonpb"
+)
+
+type fakeComponent struct {
+	name     string
+	stopWait time.Duration
+	mu       *sync.Mutex
+	stopped  *[]string
+}
+
+func (c *fakeComponent) GetName() string {
+	return c.name
+}
+
+func (c *fakeComponent) Health(ctx context.Context) commonpb.StateCode {
+	return commonpb.StateCode_Healthy
+}
+
+func (c *fakeComponent) Run() error {
+	return nil
+}
+
+func (c *fakeComponent) Stop() error {
+	time.Sleep(c.stopWait)
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	*c.stopped = append(*c.stopped, c.name)
+	return nil
+}
+
+func TestGracefulStopper(t *testing.T) {
+	var (
+		mu      sync.Mutex
+		stopped []string
+	)
+	newComponent := func(name string, wait time.Duration) component {
+		return &fakeComponent{name: name, stopWait: wait, mu: &mu, stopped: &stopped}
+	}
+
+	components := map[string][]component{
+		stopStageProxy:       {newComponent("proxy", 0)},
+		stopStageDataNode:    {newComponent("datanode", 0)},
+		stopStageQueryNode:   {newComponent("querynode", 10*time.Millisecond)},
+		stopStageIndexNode:   {nil},
+		stopStageCoordinator: {newComponent("rootcoord", 0), nil, newComponent("datacoord", 0)},
+	}
+
+	t.Run("default order", func(t *testing.T) {
+		stopped = nil
+		stopper := newGracefulStopper(defaultStopOrder, time.Minute, components)
+		progress := stopper.Progress()
+		assert.Len(t, progress, 4)
+		for _, stage := range progress {
+			assert.Equal(t, stopStatePending, stage.State)
+		}
+
+		stopper.Stop()
+		assert.Equal(t, []string{"proxy", "datanode", "querynode"}, stopped[:3])
+		assert.ElementsMatch(t, []string{"rootcoord", "datacoord"}, stopped[3:])
+		progress = stopper.Progress()
+		assert.Equal(t, stopStageCoordinator, progress[3].Stage)
+		assert.ElementsMatch(t, []string{"rootcoord", "datacoord"}, progress[3].Components)
+		for _, stage := range progress {
+			assert.Equal(t, stopStateDone, stage.State)
+		}
+	})
+
+	t.Run("custom order", func(t *testing.T) {
+		stopped = nil
+		stopper := newGracefulStopper([]string{" QueryNode", "unknown", "proxy"}, time.Minute, components)
+		stopper.Stop()
+		assert.Equal(t, []string{"querynode", "proxy", "datanode"}, stopped[:3])
+		assert.Len(t, stopped, 5)
+	})
+
+	t.Run("stage timeout", func(t *testing.T) {
+		stopped = nil
+		stopper := newGracefulStopper([]string{"querynode"}, time.Millisecond, map[string][]component{
+			stopStageQueryNode: {newComponent("querynode", 100*time.Millisecond)},
+			stopStageProxy:     {newComponent("proxy", 0)},
+		})
+		stopper.Stop()
+		progress := stopper.Progress()
+		assert.Equal(t, stopStateTimeout, progress[0].State)
+		assert.Equal(t, stopStateDone, progress[1].State)
+		assert.Eventually(t, func() bool {
+			mu.Lock()
+			defer mu.Unlock()
+			return len(stopped) == 2
+		}, time.Second, 10*time.Millisecond)
+	})
+}
@@ -34,6 +34,7 @@ import (
 	"github.com/milvus-io/milvus/cmd/components"
 	"github.com/milvus-io/milvus/internal/http"
 	"github.com/milvus-io/milvus/internal/http/healthz"
+	etcdkv "github.com/milvus-io/milvus/internal/kv/etcd"
 	rocksmqimpl "github.com/milvus-io/milvus/internal/mq/mqimpl/rocksmq/server"
 	"github.com/milvus-io/milvus/internal/util/dependency"
 	kvfactory "github.com/milvus-io/milvus/internal/util/dependency/kv"
@@ -59,6 +60,7 @@ func init() {
 	metrics.RegisterMetaMetrics(Registry.GoRegistry)
 	metrics.RegisterMsgStreamMetrics(Registry.GoRegistry)
 	metrics.RegisterStorageMetrics(Registry.GoRegistry)
+	metrics.RegisterRetryMetrics(Registry.GoRegistry)
 }
 
 func stopRocksmq() {
@@ -253,8 +255,9 @@ func (mr *MilvusRoles) setupLogger() {
 func setupPrometheusHTTPServer(r *internalmetrics.MilvusRegistry) {
 	log.Info("setupPrometheusHTTPServer")
 	http.Register(&http.Handler{
-		Path:    "/metrics",
-		Handler: promhttp.HandlerFor(r, promhttp.HandlerOpts{}),
+		Path: "/metrics",
+		// exemplars are exposed in the OpenMetrics format only
+		Handler: promhttp.HandlerFor(r, promhttp.HandlerOpts{EnableOpenMetrics: true}),
 	})
 	http.Register(&http.Handler{
 		Path:    "/metrics_default",
@@ -339,6 +342,10 @@ func (mr *MilvusRoles) Run() {
 		paramtable.Init()
 	}
 
+	etcdCli, rootPath := kvfactory.GetEtcdAndPath()
+	if err := http.RestoreModuleLogLevels(etcdkv.NewEtcdKV(etcdCli, rootPath)); err != nil {
+		log.Warn("failed to restore module log levels", zap.Error(err))
+	}
 	http.ServeHTTP()
 	setupPrometheusHTTPServer(Registry)
 
@@ -387,44 +394,22 @@ func (mr *MilvusRoles) Run() {
 	paramtable.SetCreateTime(time.Now())
 	paramtable.SetUpdateTime(time.Now())
 
-	<-mr.closed
+	params := paramtable.Get()
+	stopper := newGracefulStopper(
+		params.CommonCfg.GracefulStopOrder.GetAsStrings(),
+		params.CommonCfg.GracefulStopStageTimeout.GetAsDuration(time.Second),
+		map[string][]component{
+			stopStageProxy:       {proxy},
+			stopStageDataNode:    {dataNode},
+			stopStageQueryNode:   {queryNode},
+			stopStageIndexNode:   {indexNode},
+			stopStageCoordinator: {rootCoord, queryCoord, dataCoord, indexCoord},
+		})
+	healthz.RegisterStopProgress(stopper.Progress)
 
-	// stop coordinators first
-	//	var component
-	coordinators := []component{rootCoord, queryCoord, dataCoord, indexCoord}
-	for idx, coord := range coordinators {
-		log.Warn("stop processing")
-		if coord != nil {
-			log.Warn("stop coord", zap.Int("idx", idx), zap.Any("coord", coord))
-			wg.Add(1)
-			go func(coord component) {
-				defer wg.Done()
-				coord.Stop()
-			}(coord)
-		}
-	}
-	wg.Wait()
-	log.Info("All coordinators have stopped")
-
-	// stop nodes
-	nodes := []component{queryNode, indexNode, dataNode}
-	for _, node := range nodes {
-		if node != nil {
-			wg.Add(1)
-			go func(node component) {
-				defer wg.Done()
-				node.Stop()
-			}(node)
-		}
-	}
-	wg.Wait()
-	log.Info("All nodes have stopped")
+	<-mr.closed
 
-	// stop proxy
-	if proxy != nil {
-		proxy.Stop()
-		log.Info("proxy stopped")
-	}
+	stopper.Stop()
 
 	// close reused etcd client
 	kvfactory.CloseEtcdClient()
@@ -46,11 +46,18 @@ const int64_t DEFAULT_LOW_PRIORITY_THREAD_CORE_COEFFICIENT = 1;
 
 const int64_t DEFAULT_INDEX_FILE_SLICE_SIZE = 4 << 20;  // bytes
 
+// the size of the vectors decoded at once while searching the quantized vectors
+const int64_t DEFAULT_QUANTIZED_DECODE_BLOCK_SIZE = 4 << 20;  // bytes
+
 const int DEFAULT_CPU_NUM = 1;
 
 constexpr const char* RADIUS = knowhere::meta::RADIUS;
 constexpr const char* RANGE_FILTER = knowhere::meta::RANGE_FILTER;
 
+// the search params of grouping search, which are not passed to knowhere
+const char GROUP_BY_FIELD_ID[] = "group_by_field_id";
+const char GROUP_SIZE[] = "group_size";
+
 const int64_t DEFAULT_MAX_OUTPUT_SIZE = 67108864;  // bytes, 64MB
 
 const int64_t DEFAULT_CHUNK_MANAGER_REQUEST_TIMEOUT_MS = 3000;
@@ -37,6 +37,13 @@ struct LoadFieldDataInfo {
     // Set empty to disable mmap,
     // mmap file path will be {mmap_dir_path}/{segment_id}/{field_id}
     std::string mmap_dir_path = "";
+    // Intern the string fields into the node level string pool,
+    // ignored if mmap enabled
+    bool enable_string_pool = false;
+    // Quantize the raw float vectors kept in memory,
+    // ignored if mmap enabled
+    milvus::VectorQuantization vector_quantization =
+        milvus::VectorQuantization::NONE;
 };
 
 struct LoadDeletedRecordInfo {
@@ -17,6 +17,7 @@
 #pragma once
 
 #include <memory>
+#include <optional>
 
 #include "common/Types.h"
 #include "knowhere/config.h"
@@ -27,6 +28,9 @@ struct SearchInfo {
     FieldId field_id_;
     MetricType metric_type_;
     knowhere::Json search_params_;
+    // group the results by the scalar field, each group keeps at most group_size_ results
+    std::optional<FieldId> group_by_field_id_;
+    int64_t group_size_ = 0;
 };
 
 using SearchInfoPtr = std::shared_ptr<SearchInfo>;
@@ -56,6 +56,9 @@ struct SearchResult {
     std::vector<PkType> primary_keys_;
     DataType pk_type_;
 
+    // fill data before reducing search result if grouping by field
+    std::vector<GroupByValueType> group_by_values_;
+
     // fill data during reducing search result
     std::vector<int64_t> result_offsets_;
     // after reducing search result done, size(distances_) = size(seg_offsets_) = size(primary_keys_) =
@@ -0,0 +1,95 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+#include "common/StringPool.h"
+#include "common/EasyAssert.h"
+
+namespace milvus {
+
+StringPool::Holder::~Holder() {
+    pool_.Release(entries_, referenced_bytes_);
+}
+
+std::string_view
+StringPool::Holder::Intern(std::string_view value) {
+    AssertInfo(!sealed_, "can't intern value after the holder sealed");
+    referenced_bytes_ += value.size();
+    pool_.AddReferencedBytes(value.size());
+
+    // each distinct value is acquired from pool once per holder,
+    // so the pool lock is only taken for the values first seen by the column
+    auto it = interned_.find(value);
+    if (it != interned_.end()) {
+        return it->second->value;
+    }
+    auto entry = pool_.Acquire(value);
+    entries_.push_back(entry);
+    interned_.emplace(entry->value, entry);
+    return entry->value;
+}
+
+void
+StringPool::Holder::Seal() {
+    sealed_ = true;
+    interned_ = {};
+}
+
+StringPool::Entry*
+StringPool::Acquire(std::string_view value) {
+    std::lock_guard<std::mutex> lck(mutex_);
+    auto it = entries_.find(value);
+    if (it == entries_.end()) {
+        auto entry = std::make_unique<Entry>(value);
+        std::string_view key = entry->value;
+        pooled_bytes_ += entry->value.size();
+        it = entries_.emplace(key, std::move(entry)).first;
+    }
+    it->second->refs++;
+    return it->second.get();
+}
+
+void
+StringPool::AddReferencedBytes(int64_t bytes) {
+    std::lock_guard<std::mutex> lck(mutex_);
+    referenced_bytes_ += bytes;
+}
+
+void
+StringPool::Release(const std::vector<Entry*>& entries,
+                    int64_t referenced_bytes) {
+    std::lock_guard<std::mutex> lck(mutex_);
+    referenced_bytes_ -= referenced_bytes;
+    for (auto entry : entries) {
+        if (--entry->refs > 0) {
+            continue;
+        }
+        pooled_bytes_ -= entry->value.size();
+        // the key refers the value of entry, erase by a copy of the view
+        // before the entry destroyed
+        std::string_view key = entry->value;
+        entries_.erase(key);
+    }
+}
+
+StringPoolStats
+StringPool::Stats() const {
+    std::lock_guard<std::mutex> lck(mutex_);
+    return StringPoolStats{static_cast<int64_t>(entries_.size()),
+                           pooled_bytes_,
+                           referenced_bytes_};
+}
+
+}  // namespace milvus
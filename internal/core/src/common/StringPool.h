@@ -0,0 +1,118 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+#pragma once
+
+#include <cstdint>
+#include <memory>
+#include <mutex>
+#include <string>
+#include <string_view>
+#include <unordered_map>
+#include <vector>
+
+namespace milvus {
+
+struct StringPoolStats {
+    // number of the distinct strings in pool
+    int64_t unique_num = 0;
+    // bytes of the distinct strings in pool
+    int64_t pooled_bytes = 0;
+    // bytes of all the rows referencing the pool, as if they are not shared
+    int64_t referenced_bytes = 0;
+};
+
+// StringPool is the node level pool of the string values, the identical
+// values of the columns loaded with the pool enabled are stored only once.
+// The values are reference counted by the columns, a value is freed once
+// no column refers it.
+class StringPool {
+ private:
+    struct Entry {
+        explicit Entry(std::string_view value) : value(value) {
+        }
+
+        std::string value;
+        int64_t refs = 0;
+    };
+
+ public:
+    // Holder interns the values of a column, and releases them once destroyed.
+    // It's not thread safe, a holder is used by one loading column.
+    class Holder {
+     public:
+        explicit Holder(StringPool& pool) : pool_(pool) {
+        }
+
+        Holder(const Holder&) = delete;
+        Holder&
+        operator=(const Holder&) = delete;
+
+        ~Holder();
+
+        // Intern returns the view of the pooled value, which keeps valid
+        // until the holder is destroyed
+        std::string_view
+        Intern(std::string_view value);
+
+        // Seal drops the lookup table of the loading,
+        // no value could be interned after sealed
+        void
+        Seal();
+
+     private:
+        StringPool& pool_;
+        std::unordered_map<std::string_view, Entry*> interned_;
+        std::vector<Entry*> entries_;
+        int64_t referenced_bytes_ = 0;
+        bool sealed_ = false;
+    };
+
+    static StringPool&
+    GetInstance() {
+        static StringPool pool;
+        return pool;
+    }
+
+    std::unique_ptr<Holder>
+    NewHolder() {
+        return std::make_unique<Holder>(*this);
+    }
+
+    StringPoolStats
+    Stats() const;
+
+ private:
+    StringPool() = default;
+
+    Entry*
+    Acquire(std::string_view value);
+
+    void
+    AddReferencedBytes(int64_t bytes);
+
+    void
+    Release(const std::vector<Entry*>& entries, int64_t referenced_bytes);
+
+ private:
+    mutable std::mutex mutex_;
+    // the key is the view of the value owned by the entry
+    std::unordered_map<std::string_view, std::unique_ptr<Entry>> entries_;
+    int64_t pooled_bytes_ = 0;
+    int64_t referenced_bytes_ = 0;
+};
+
+}  // namespace milvus
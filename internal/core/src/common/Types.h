@@ -112,6 +112,8 @@ using VectorArray = proto::schema::VectorField;
 using IdArray = proto::schema::IDs;
 using InsertData = proto::segcore::InsertRecord;
 using PkType = std::variant<std::monostate, int64_t, std::string>;
+// the integers of all widths are grouped as int64
+using GroupByValueType = std::variant<std::monostate, bool, int64_t, std::string>;
 using ContainsType = proto::plan::JSONContainsExpr_JSONOp;
 
 inline bool
@@ -119,6 +121,25 @@ IsPrimaryKeyDataType(DataType data_type) {
     return data_type == DataType::INT64 || data_type == DataType::VARCHAR;
 }
 
+// the quantization of the raw float vectors kept in memory by the sealed
+// segments, independent of the vector index, the binlogs are not changed
+enum class VectorQuantization {
+    NONE = 0,
+    FP16 = 1,
+    SQ8 = 2,
+};
+
+inline VectorQuantization
+VectorQuantizationFromString(const std::string& name) {
+    if (name == "FP16") {
+        return VectorQuantization::FP16;
+    }
+    if (name == "SQ8") {
+        return VectorQuantization::SQ8;
+    }
+    return VectorQuantization::NONE;
+}
+
 // NOTE: dependent type
 // used at meta-template programming
 template <class...>
@@ -216,8 +216,14 @@ VectorDiskAnnIndex<T>::Query(const DatasetPtr dataset,
         if (search_list_size.has_value()) {
             search_config[DISK_ANN_SEARCH_LIST_SIZE] = search_list_size.value();
         }
-        // set beamwidth
-        search_config[DISK_ANN_QUERY_BEAMWIDTH] = int(search_beamwidth_);
+        // set beamwidth, the one passed by search request overrides the loaded one
+        auto beamwidth = GetValueFromConfig<uint32_t>(search_info.search_params_,
+                                                      DISK_ANN_QUERY_BEAMWIDTH);
+        if (beamwidth.has_value() && beamwidth.value() > 0) {
+            search_config[DISK_ANN_QUERY_BEAMWIDTH] = int(beamwidth.value());
+        } else {
+            search_config[DISK_ANN_QUERY_BEAMWIDTH] = int(search_beamwidth_);
+        }
         // set json reset field, will be removed later
         search_config[DISK_ANN_PQ_CODE_BUDGET] = 0.0;
     }
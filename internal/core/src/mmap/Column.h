@@ -17,6 +17,7 @@
 
 #include <sys/mman.h>
 #include <algorithm>
+#include <cmath>
 #include <cstddef>
 #include <cstring>
 #include <filesystem>
@@ -25,6 +26,7 @@
 #include "common/Span.h"
 #include "common/EasyAssert.h"
 #include "common/File.h"
+#include "common/StringPool.h"
 #include "fmt/format.h"
 #include "log/Log.h"
 #include "mmap/Utils.h"
@@ -185,6 +187,21 @@ class ColumnBase {
     }
 
  protected:
+    // memory mode ctor of the columns storing the encoded rows,
+    // of which the row size differs from the field
+    ColumnBase(size_t reserve, size_t type_size) : type_size_(type_size) {
+        cap_size_ = type_size * reserve;
+        data_ = static_cast<char*>(mmap(nullptr,
+                                        cap_size_,
+                                        PROT_READ | PROT_WRITE,
+                                        mmap_flags | MAP_ANON,
+                                        -1,
+                                        0));
+        AssertInfo(
+            data_ != MAP_FAILED,
+            fmt::format("failed to create anon map, err: {}", strerror(errno)));
+    }
+
     // only for memory mode, not mmap
     void
     Expand(size_t new_size) {
@@ -251,6 +268,170 @@ class Column : public ColumnBase {
     }
 };
 
+// QuantizedVectorColumn keeps the float vectors quantized in memory,
+// FP16 stores each dimension in half precision,
+// SQ8 stores each dimension in one byte with the min and the scale per vector,
+// the vectors are decoded while searching and outputting
+class QuantizedVectorColumn : public ColumnBase {
+ public:
+    QuantizedVectorColumn(size_t reserve,
+                          const FieldMeta& field_meta,
+                          VectorQuantization quantization)
+        : ColumnBase(reserve, CodeSize(field_meta.get_dim(), quantization)),
+          dim_(field_meta.get_dim()),
+          quantization_(quantization) {
+        AssertInfo(field_meta.get_data_type() == DataType::VECTOR_FLOAT,
+                   "only float vector could be quantized");
+    }
+
+    QuantizedVectorColumn(QuantizedVectorColumn&& column) noexcept
+        : ColumnBase(std::move(column)),
+          dim_(column.dim_),
+          quantization_(column.quantization_) {
+    }
+
+    ~QuantizedVectorColumn() override = default;
+
+    static size_t
+    CodeSize(int64_t dim, VectorQuantization quantization) {
+        switch (quantization) {
+            case VectorQuantization::FP16:
+                return dim * sizeof(uint16_t);
+            case VectorQuantization::SQ8:
+                return dim * sizeof(uint8_t) + 2 * sizeof(float);
+            default:
+                PanicInfo(DataTypeInvalid,
+                          fmt::format("unsupported vector quantization {}",
+                                      static_cast<int>(quantization)));
+        }
+    }
+
+    // the codes can't be read as the float vectors, decode them instead
+    SpanBase
+    Span() const override {
+        PanicInfo(NotImplemented, "quantized vector column has no span");
+    }
+
+    VectorQuantization
+    Quantization() const {
+        return quantization_;
+    }
+
+    void
+    AppendBatch(const storage::FieldDataPtr& data) {
+        auto num_rows = data->get_num_rows();
+        size_t required_size = size_ + num_rows * type_size_;
+        if (required_size > cap_size_) {
+            Expand(required_size * 2);
+        }
+
+        auto src = static_cast<const float*>(data->Data());
+        for (int64_t i = 0; i < num_rows; i++) {
+            Encode(src + i * dim_, data_ + size_ + i * type_size_);
+        }
+        size_ = required_size;
+        num_rows_ += num_rows;
+    }
+
+    // Decode the vectors of the rows [offset, offset + count) into dst
+    void
+    Decode(int64_t offset, int64_t count, float* dst) const {
+        for (int64_t i = 0; i < count; i++) {
+            DecodeRow(offset + i, dst + i * dim_);
+        }
+    }
+
+    // Decode the vectors of the given rows into dst
+    void
+    Decode(const int64_t* offsets, int64_t count, float* dst) const {
+        for (int64_t i = 0; i < count; i++) {
+            DecodeRow(offsets[i], dst + i * dim_);
+        }
+    }
+
+ private:
+    void
+    Encode(const float* vec, char* code) const {
+        if (quantization_ == VectorQuantization::FP16) {
+            auto half = reinterpret_cast<uint16_t*>(code);
+            for (int64_t i = 0; i < dim_; i++) {
+                half[i] = FloatToHalf(vec[i]);
+            }
+            return;
+        }
+
+        auto [min, max] = std::minmax_element(vec, vec + dim_);
+        float scale = (*max - *min) / 255.0f;
+        std::memcpy(code, min, sizeof(float));
+        std::memcpy(code + sizeof(float), &scale, sizeof(float));
+        auto sq = reinterpret_cast<uint8_t*>(code + 2 * sizeof(float));
+        for (int64_t i = 0; i < dim_; i++) {
+            sq[i] = scale > 0 ? static_cast<uint8_t>(
+                                    std::lround((vec[i] - *min) / scale))
+                              : 0;
+        }
+    }
+
+    void
+    DecodeRow(int64_t offset, float* vec) const {
+        auto code = data_ + offset * type_size_;
+        if (quantization_ == VectorQuantization::FP16) {
+            auto half = reinterpret_cast<const uint16_t*>(code);
+            for (int64_t i = 0; i < dim_; i++) {
+                vec[i] = HalfToFloat(half[i]);
+            }
+            return;
+        }
+
+        float min, scale;
+        std::memcpy(&min, code, sizeof(float));
+        std::memcpy(&scale, code + sizeof(float), sizeof(float));
+        auto sq = reinterpret_cast<const uint8_t*>(code + 2 * sizeof(float));
+        for (int64_t i = 0; i < dim_; i++) {
+            vec[i] = min + sq[i] * scale;
+        }
+    }
+
+    // the subnormals are flushed to zero and the overflows are clamped to
+    // the max half, which are rare for the embeddings
+    static uint16_t
+    FloatToHalf(float value) {
+        uint32_t bits;
+        std::memcpy(&bits, &value, sizeof(bits));
+        uint16_t sign = (bits >> 16) & 0x8000;
+        int32_t exponent = static_cast<int32_t>((bits >> 23) & 0xff) - 112;
+        uint32_t mantissa = bits & 0x7fffff;
+        if (exponent <= 0) {
+            return sign;
+        }
+        if (exponent >= 31) {
+            return sign | 0x7bff;
+        }
+        uint16_t half = sign | (exponent << 10) | (mantissa >> 13);
+        // round to nearest, the carry goes into the exponent
+        if ((mantissa & 0x1000) != 0 && (half & 0x7fff) != 0x7bff) {
+            half++;
+        }
+        return half;
+    }
+
+    static float
+    HalfToFloat(uint16_t half) {
+        uint32_t sign = static_cast<uint32_t>(half & 0x8000) << 16;
+        uint32_t exponent = (half >> 10) & 0x1f;
+        uint32_t mantissa = half & 0x3ff;
+        uint32_t bits =
+            exponent == 0 ? sign
+                          : sign | ((exponent + 112) << 23) | (mantissa << 13);
+        float value;
+        std::memcpy(&value, &bits, sizeof(value));
+        return value;
+    }
+
+    int64_t dim_;
+    VectorQuantization quantization_;
+};
+
 template <typename T>
 class VariableColumn : public ColumnBase {
  public:
@@ -267,10 +448,20 @@ class VariableColumn : public ColumnBase {
         : ColumnBase(file, size, field_meta) {
     }
 
+    // pooled mode ctor, the values are interned in the node level string pool
+    // rather than stored in the column, only for string column
+    VariableColumn(const FieldMeta& field_meta,
+                   std::unique_ptr<StringPool::Holder> pool_holder)
+        : ColumnBase(0, field_meta), pool_holder_(std::move(pool_holder)) {
+        static_assert(std::is_same_v<T, std::string>,
+                      "only string column could be pooled");
+    }
+
     VariableColumn(VariableColumn&& column) noexcept
         : ColumnBase(std::move(column)),
           indices_(std::move(column.indices_)),
-          views_(std::move(column.views_)) {
+          views_(std::move(column.views_)),
+          pool_holder_(std::move(column.pool_holder_)) {
     }
 
     ~VariableColumn() override = default;
@@ -292,6 +483,11 @@ class VariableColumn : public ColumnBase {
 
     std::string_view
     RawAt(const int i) const {
+        if constexpr (std::is_same_v<T, std::string>) {
+            if (pool_holder_ != nullptr) {
+                return views_[i];
+            }
+        }
         size_t len = (i == indices_.size() - 1) ? size_ - indices_.back()
                                                 : indices_[i + 1] - indices_[i];
         return std::string_view(data_ + indices_[i], len);
@@ -299,12 +495,24 @@ class VariableColumn : public ColumnBase {
 
     void
     Append(const char* data, size_t size) {
+        if constexpr (std::is_same_v<T, std::string>) {
+            if (pool_holder_ != nullptr) {
+                views_.emplace_back(
+                    pool_holder_->Intern(std::string_view(data, size)));
+                num_rows_++;
+                return;
+            }
+        }
         indices_.emplace_back(size_);
         ColumnBase::Append(data, size);
     }
 
     void
     Seal(std::vector<uint64_t> indices = {}) {
+        if (pool_holder_ != nullptr) {
+            pool_holder_->Seal();
+            return;
+        }
         if (!indices.empty()) {
             indices_ = std::move(indices);
         }
@@ -312,6 +520,11 @@ class VariableColumn : public ColumnBase {
         ConstructViews();
     }
 
+    bool
+    IsPooled() const {
+        return pool_holder_ != nullptr;
+    }
+
  protected:
     void
     ConstructViews() {
@@ -328,6 +541,9 @@ class VariableColumn : public ColumnBase {
 
     // Compatible with current Span type
     std::vector<ViewType> views_{};
+
+    // not null if the values are interned in the string pool
+    std::unique_ptr<StringPool::Holder> pool_holder_{};
 };
 
 class ArrayColumn : public ColumnBase {
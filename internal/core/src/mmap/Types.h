@@ -84,5 +84,7 @@ struct FieldDataInfo {
     size_t row_count;
     std::string mmap_dir_path;
     storage::FieldDataChannelPtr channel;
+    bool enable_string_pool = false;
+    VectorQuantization vector_quantization = VectorQuantization::NONE;
 };
 }  // namespace milvus
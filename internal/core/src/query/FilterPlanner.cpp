@@ -0,0 +1,171 @@
+// Copyright (C) 2019-2020 Zilliz. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software distributed under the License
+// is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express
+// or implied. See the License for the specific language governing permissions and limitations under the License
+
+
+#include "query/FilterPlanner.h"
+
+#include <algorithm>
+#include <cmath>
+#include <vector>
+
+#include "common/Consts.h"
+#include "common/EasyAssert.h"
+#include "common/Tracer.h"
+#include "query/SearchBruteForce.h"
+#include "segcore/SegcoreConfig.h"
+
+namespace milvus::query {
+
+std::string
+FilterStrategyName(FilterStrategy strategy) {
+    switch (strategy) {
+        case FilterStrategy::PostFilter:
+            return "post_filter";
+        case FilterStrategy::BruteForce:
+            return "brute_force";
+        default:
+            return "pre_filter";
+    }
+}
+
+FilterStrategy
+ChooseFilterStrategy(const segcore::SegmentInternalInterface& segment,
+                     const SearchInfo& search_info,
+                     int64_t active_count,
+                     int64_t passed_count) {
+    auto& config = segcore::SegcoreConfig::default_config();
+    // the segments without index are searched by brute force with the filter already,
+    // and the results grouped by field need all the rows passing the filter
+    if (!config.get_enable_filter_planner() || active_count <= 0 ||
+        passed_count <= 0 || search_info.group_by_field_id_.has_value() ||
+        segment.type() != SegmentType::Sealed ||
+        !segment.HasIndex(search_info.field_id_)) {
+        return FilterStrategy::PreFilter;
+    }
+
+    auto selectivity = static_cast<double>(passed_count) / active_count;
+    if ((passed_count <= search_info.topk_ ||
+         selectivity <= config.get_brute_force_ratio()) &&
+        segment.HasRawData(search_info.field_id_.get())) {
+        return FilterStrategy::BruteForce;
+    }
+    if (selectivity < 1 && selectivity >= config.get_post_filter_ratio()) {
+        return FilterStrategy::PostFilter;
+    }
+    return FilterStrategy::PreFilter;
+}
+
+int64_t
+PostFilterTopk(int64_t topk, int64_t active_count, int64_t passed_count) {
+    auto& config = segcore::SegcoreConfig::default_config();
+    auto selectivity = static_cast<double>(passed_count) / active_count;
+    auto amplified = static_cast<int64_t>(std::ceil(
+        topk * config.get_post_filter_amplification() / selectivity));
+    return std::min(std::max(amplified, topk), active_count);
+}
+
+bool
+PostFilterSearchResult(SearchResult& result,
+                       const BitsetType& filtered,
+                       int64_t topk,
+                       int64_t passed_count) {
+    auto nq = result.total_nq_;
+    auto amplified_topk = result.unity_topK_;
+    auto expected = std::min(topk, passed_count);
+    std::vector<int64_t> seg_offsets(nq * topk, INVALID_SEG_OFFSET);
+    std::vector<float> distances(nq * topk, 0);
+    for (int64_t q = 0; q < nq; q++) {
+        int64_t kept = 0;
+        for (int64_t i = 0; i < amplified_topk && kept < topk; i++) {
+            auto src = q * amplified_topk + i;
+            auto offset = result.seg_offsets_[src];
+            if (offset == INVALID_SEG_OFFSET || filtered[offset]) {
+                continue;
+            }
+            seg_offsets[q * topk + kept] = offset;
+            distances[q * topk + kept] = result.distances_[src];
+            kept++;
+        }
+        // range search may return less results, the amplified one could have more
+        if (kept < expected && amplified_topk < filtered.size()) {
+            return false;
+        }
+    }
+    result.seg_offsets_ = std::move(seg_offsets);
+    result.distances_ = std::move(distances);
+    result.unity_topK_ = topk;
+    return true;
+}
+
+void
+BruteForceSearchPassed(const segcore::SegmentInternalInterface& segment,
+                       const SearchInfo& search_info,
+                       const void* query_data,
+                       int64_t num_queries,
+                       const BitsetType& filtered,
+                       SearchResult& result) {
+    auto& field = segment.get_schema()[search_info.field_id_];
+    std::vector<int64_t> passed;
+    passed.reserve(filtered.size() - filtered.count());
+    for (int64_t offset = 0; offset < filtered.size(); offset++) {
+        if (!filtered[offset]) {
+            passed.push_back(offset);
+        }
+    }
+
+    auto vectors = segment.bulk_subscript(
+        search_info.field_id_, passed.data(), passed.size());
+    const void* raw = nullptr;
+    switch (field.get_data_type()) {
+        case DataType::VECTOR_FLOAT:
+            raw = vectors->vectors().float_vector().data().data();
+            break;
+        case DataType::VECTOR_BINARY:
+            raw = vectors->vectors().binary_vector().data();
+            break;
+        case DataType::VECTOR_FLOAT16:
+            raw = vectors->vectors().float16_vector().data();
+            break;
+        default:
+            PanicInfo(DataTypeInvalid,
+                      fmt::format("unsupported vector type {}",
+                                  field.get_data_type()));
+    }
+    milvus::tracer::AddEvent("finish_fetching_passed_vectors");
+
+    dataset::SearchDataset dataset{search_info.metric_type_,
+                                   num_queries,
+                                   search_info.topk_,
+                                   search_info.round_decimal_,
+                                   field.get_dim(),
+                                   query_data};
+    CheckBruteForceSearchParam(field, search_info);
+    auto sub_result = BruteForceSearch(dataset,
+                                       raw,
+                                       passed.size(),
+                                       search_info.search_params_,
+                                       BitsetView(),
+                                       field.get_data_type());
+
+    // map the offsets in the passed rows back to the segment
+    auto& seg_offsets = sub_result.mutable_seg_offsets();
+    for (auto& offset : seg_offsets) {
+        if (offset != INVALID_SEG_OFFSET) {
+            offset = passed[offset];
+        }
+    }
+    result.seg_offsets_ = std::move(seg_offsets);
+    result.distances_ = std::move(sub_result.mutable_distances());
+    result.unity_topK_ = dataset.topk;
+    result.total_nq_ = dataset.num_queries;
+}
+
+}  // namespace milvus::query
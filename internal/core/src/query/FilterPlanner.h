@@ -0,0 +1,70 @@
+// Copyright (C) 2019-2020 Zilliz. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software distributed under the License
+// is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express
+// or implied. See the License for the specific language governing permissions and limitations under the License
+
+
+#pragma once
+
+#include <cstdint>
+#include <string>
+
+#include "common/QueryResult.h"
+#include "common/Types.h"
+#include "segcore/SegmentInterface.h"
+
+namespace milvus::query {
+
+// FilterStrategy is how a filtered search runs on a segment.
+enum class FilterStrategy {
+    // search the index with the filter bitset
+    PreFilter,
+    // search the index with the amplified topk but without the filter,
+    // then drop the results filtered out, for the filters passing most rows
+    PostFilter,
+    // compute the distances to the rows passing the filter only,
+    // for the highly selective filters which the index searches poorly
+    BruteForce,
+};
+
+std::string
+FilterStrategyName(FilterStrategy strategy);
+
+// ChooseFilterStrategy chooses the strategy of filtered search by the selectivity of the filter,
+// which is the ratio of the rows passing the filter to the active rows of the segment.
+FilterStrategy
+ChooseFilterStrategy(const segcore::SegmentInternalInterface& segment,
+                     const SearchInfo& search_info,
+                     int64_t active_count,
+                     int64_t passed_count);
+
+// PostFilterTopk returns the amplified topk to search without the filter,
+// so that enough results are left after the results filtered out are dropped.
+int64_t
+PostFilterTopk(int64_t topk, int64_t active_count, int64_t passed_count);
+
+// PostFilterSearchResult drops the results filtered out of the result searched with the amplified topk,
+// and keeps the topk results at most for each query. Returns false if some query has less results
+// than expected, which should be searched with the filter again.
+bool
+PostFilterSearchResult(SearchResult& result,
+                       const BitsetType& filtered,
+                       int64_t topk,
+                       int64_t passed_count);
+
+// BruteForceSearchPassed computes the distances to the rows passing the filter only.
+void
+BruteForceSearchPassed(const segcore::SegmentInternalInterface& segment,
+                       const SearchInfo& search_info,
+                       const void* query_data,
+                       int64_t num_queries,
+                       const BitsetType& filtered,
+                       SearchResult& result);
+
+}  // namespace milvus::query
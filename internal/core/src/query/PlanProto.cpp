@@ -17,6 +17,7 @@
 #include <string>
 
 #include "ExprImpl.h"
+#include "common/Consts.h"
 #include "common/VectorTrait.h"
 #include "common/EasyAssert.h"
 #include "generated/ExtractInfoExprVisitor.h"
@@ -196,6 +197,17 @@ ProtoParser::PlanNodeFromProto(const planpb::PlanNode& plan_node_proto) {
     search_info.round_decimal_ = query_info_proto.round_decimal();
     search_info.search_params_ =
         nlohmann::json::parse(query_info_proto.search_params());
+    if (search_info.search_params_.contains(GROUP_BY_FIELD_ID)) {
+        search_info.group_by_field_id_ = FieldId(
+            search_info.search_params_[GROUP_BY_FIELD_ID].get<int64_t>());
+        search_info.group_size_ =
+            search_info.search_params_.value(GROUP_SIZE, int64_t(1));
+        AssertInfo(search_info.group_size_ > 0,
+                   fmt::format("invalid group size {}",
+                               search_info.group_size_));
+        search_info.search_params_.erase(GROUP_BY_FIELD_ID);
+        search_info.search_params_.erase(GROUP_SIZE);
+    }
 
     auto plan_node = [&]() -> std::unique_ptr<VectorPlanNode> {
         if (anns_proto.vector_type() ==
@@ -12,6 +12,7 @@
 #include <cmath>
 #include <string>
 
+#include "common/Consts.h"
 #include "common/QueryInfo.h"
 #include "common/Types.h"
 #include "query/SearchBruteForce.h"
@@ -99,4 +100,61 @@ SearchOnSealed(const Schema& schema,
     result.total_nq_ = dataset.num_queries;
 }
 
+void
+SearchOnSealedQuantized(const Schema& schema,
+                        const QuantizedVectorColumn& column,
+                        const SearchInfo& search_info,
+                        const void* query_data,
+                        int64_t num_queries,
+                        int64_t row_count,
+                        const BitsetView& bitset,
+                        SearchResult& result) {
+    auto field_id = search_info.field_id_;
+    auto& field = schema[field_id];
+    auto dim = field.get_dim();
+
+    query::dataset::SearchDataset dataset{search_info.metric_type_,
+                                          num_queries,
+                                          search_info.topk_,
+                                          search_info.round_decimal_,
+                                          dim,
+                                          query_data};
+
+    auto data_type = field.get_data_type();
+    CheckBruteForceSearchParam(field, search_info);
+
+    SubSearchResult final_qr(num_queries,
+                             search_info.topk_,
+                             search_info.metric_type_,
+                             search_info.round_decimal_);
+    auto block_rows = std::max<int64_t>(
+        1, DEFAULT_QUANTIZED_DECODE_BLOCK_SIZE / (dim * sizeof(float)));
+    std::vector<float> block(std::min(block_rows, row_count) * dim);
+    for (int64_t begin = 0; begin < row_count; begin += block_rows) {
+        auto size = std::min(block_rows, row_count - begin);
+        column.Decode(begin, size, block.data());
+
+        auto sub_view = bitset.subview(begin, size);
+        auto sub_qr = BruteForceSearch(dataset,
+                                       block.data(),
+                                       size,
+                                       search_info.search_params_,
+                                       sub_view,
+                                       data_type);
+
+        // convert block offset to segment offset
+        for (auto& x : sub_qr.mutable_seg_offsets()) {
+            if (x != -1) {
+                x += begin;
+            }
+        }
+        final_qr.merge(sub_qr);
+    }
+
+    result.distances_ = std::move(final_qr.mutable_distances());
+    result.seg_offsets_ = std::move(final_qr.mutable_seg_offsets());
+    result.unity_topK_ = dataset.topk;
+    result.total_nq_ = dataset.num_queries;
+}
+
 }  // namespace milvus::query
@@ -13,6 +13,7 @@
 
 #include "common/BitsetView.h"
 #include "query/PlanNode.h"
+#include "mmap/Column.h"
 #include "query/SearchOnGrowing.h"
 #include "segcore/SealedIndexingRecord.h"
 
@@ -37,4 +38,16 @@ SearchOnSealed(const Schema& schema,
                const BitsetView& bitset,
                SearchResult& result);
 
+// search the quantized vectors by brute force, the vectors are decoded block
+// by block to bound the memory
+void
+SearchOnSealedQuantized(const Schema& schema,
+                        const QuantizedVectorColumn& column,
+                        const SearchInfo& search_info,
+                        const void* query_data,
+                        int64_t num_queries,
+                        int64_t row_count,
+                        const BitsetView& bitset,
+                        SearchResult& result);
+
 }  // namespace milvus::query
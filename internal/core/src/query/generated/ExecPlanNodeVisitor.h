@@ -96,6 +96,23 @@ class ExecPlanNodeVisitor : public PlanNodeVisitor {
         return expr_use_pk_index_;
     }
 
+    // the cached filter bitset is used instead of evaluating the predicate
+    // if its size matches the active count of the segment
+    void
+    SetCachedFilterBitset(const BitsetType* bitset) {
+        cached_filter_bitset_ = bitset;
+    }
+
+    void
+    SetOutputFilterBitset(bool output) {
+        output_filter_bitset_ = output;
+    }
+
+    BitsetTypeOpt&
+    GetEvaluatedFilterBitset() {
+        return evaluated_filter_bitset_;
+    }
+
  private:
     template <typename VectorType>
     void
@@ -110,5 +127,8 @@ class ExecPlanNodeVisitor : public PlanNodeVisitor {
     RetrieveResultOpt retrieve_result_opt_;
     bool expr_use_pk_index_ = false;
     std::vector<int64_t> expr_cached_pk_id_offsets_;
+    const BitsetType* cached_filter_bitset_ = nullptr;
+    bool output_filter_bitset_ = false;
+    BitsetTypeOpt evaluated_filter_bitset_;
 };
 }  // namespace milvus::query
@@ -13,11 +13,13 @@
 
 #include <utility>
 
+#include "query/FilterPlanner.h"
 #include "query/PlanImpl.h"
 #include "query/SubSearchResult.h"
 #include "query/generated/ExecExprVisitor.h"
 #include "segcore/SegmentGrowing.h"
 #include "common/Json.h"
+#include "common/Tracer.h"
 #include "log/Log.h"
 
 namespace milvus::query {
@@ -98,11 +100,19 @@ ExecPlanNodeVisitor::VectorVisitorImpl(VectorPlanNode& node) {
     }
 
     std::unique_ptr<BitsetType> bitset_holder;
-    if (node.predicate_.has_value()) {
+    if (node.predicate_.has_value() && cached_filter_bitset_ != nullptr &&
+        cached_filter_bitset_->size() == active_count) {
+        bitset_holder = std::make_unique<BitsetType>(*cached_filter_bitset_);
+    } else if (node.predicate_.has_value()) {
         bitset_holder = std::make_unique<BitsetType>(
             ExecExprVisitor(*segment, this, active_count, timestamp_)
                 .call_child(*node.predicate_.value()));
         bitset_holder->flip();
+        // output the filter result before masking the timestamps and deletes,
+        // so that it could be reused by the later searches
+        if (output_filter_bitset_) {
+            evaluated_filter_bitset_ = *bitset_holder;
+        }
     } else {
         bitset_holder = std::make_unique<BitsetType>(active_count, false);
     }
@@ -116,6 +126,51 @@ ExecPlanNodeVisitor::VectorVisitorImpl(VectorPlanNode& node) {
             empty_search_result(num_queries, node.search_info_);
         return;
     }
+
+    auto strategy = FilterStrategy::PreFilter;
+    auto passed_count = active_count - int64_t(bitset_holder->count());
+    if (node.predicate_.has_value()) {
+        strategy = ChooseFilterStrategy(
+            *segment, node.search_info_, active_count, passed_count);
+    }
+    if (strategy == FilterStrategy::BruteForce) {
+        BruteForceSearchPassed(*segment,
+                               node.search_info_,
+                               src_data,
+                               num_queries,
+                               *bitset_holder,
+                               search_result);
+        milvus::tracer::AddEvent("finish_brute_force_searching_passed");
+        search_result_opt_ = std::move(search_result);
+        return;
+    }
+    if (strategy == FilterStrategy::PostFilter) {
+        // only the timestamps and deletes are masked, the filter is applied on the results
+        BitsetType masked(active_count, false);
+        segment->mask_with_timestamps(masked, timestamp_);
+        segment->mask_with_delete(masked, active_count, timestamp_);
+        auto search_info = node.search_info_;
+        search_info.topk_ =
+            PostFilterTopk(search_info.topk_, active_count, passed_count);
+        BitsetView masked_view = masked;
+        segment->vector_search(search_info,
+                               src_data,
+                               num_queries,
+                               timestamp_,
+                               masked_view,
+                               search_result);
+        if (PostFilterSearchResult(search_result,
+                                   *bitset_holder,
+                                   node.search_info_.topk_,
+                                   passed_count)) {
+            milvus::tracer::AddEvent("finish_post_filter_searching");
+            search_result_opt_ = std::move(search_result);
+            return;
+        }
+        // too many results filtered out, search with the filter again
+        search_result = SearchResult();
+    }
+
     BitsetView final_view = *bitset_holder;
     segment->vector_search(node.search_info_,
                            src_data,
@@ -130,6 +130,7 @@ ReduceHelper::FillPrimaryKey() {
             auto segment =
                 static_cast<SegmentInterface*>(search_result->segment_);
             segment->FillPrimaryKeys(plan_, *search_result);
+            segment->FillGroupByValues(plan_, *search_result);
             search_results_[valid_index++] = search_result;
         }
     }
@@ -188,6 +189,7 @@ ReduceHelper::ReduceSearchResultForOneNQ(int64_t qi,
         heap_.pop();
     }
     pk_set_.clear();
+    group_count_.clear();
     pairs_.clear();
 
     pairs_.reserve(num_segments_);
@@ -211,6 +213,8 @@ ReduceHelper::ReduceSearchResultForOneNQ(int64_t qi,
         return 0;
     }
 
+    auto& search_info = plan_->plan_node_->search_info_;
+    auto group_by = search_info.group_by_field_id_.has_value();
     int64_t dup_cnt = 0;
     auto start = offset;
     while (offset - start < topk && !heap_.empty()) {
@@ -225,9 +229,15 @@ ReduceHelper::ReduceSearchResultForOneNQ(int64_t qi,
         }
         // remove duplicates
         if (pk_set_.count(pk) == 0) {
-            pilot->search_result_->result_offsets_.push_back(offset++);
-            final_search_records_[index][qi].push_back(pilot->offset_);
             pk_set_.insert(pk);
+            // skip entity if its group is full
+            if (!group_by ||
+                group_count_[pilot->search_result_
+                                 ->group_by_values_[pilot->offset_]]++ <
+                    search_info.group_size_) {
+                pilot->search_result_->result_offsets_.push_back(offset++);
+                final_search_records_[index][qi].push_back(pilot->offset_);
+            }
         } else {
             // skip entity with same primary key
             dup_cnt++;
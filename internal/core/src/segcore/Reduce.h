@@ -16,6 +16,7 @@
 #include <memory>
 #include <vector>
 #include <queue>
+#include <unordered_map>
 #include <unordered_set>
 
 #include "common/type_c.h"
@@ -108,6 +109,8 @@ class ReduceHelper {
                         SearchResultPairComparator>
         heap_;
     std::unordered_set<milvus::PkType> pk_set_;
+    // group value -> count of results, used if grouping by field
+    std::unordered_map<milvus::GroupByValueType, int64_t> group_count_;
 };
 
 }  // namespace milvus::segcore
@@ -73,11 +73,46 @@ class SegcoreConfig {
         return enable_growing_segment_index_;
     }
 
+    void
+    set_filter_planner(bool enable,
+                       float brute_force_ratio,
+                       float post_filter_ratio,
+                       float post_filter_amplification) {
+        enable_filter_planner_ = enable;
+        brute_force_ratio_ = brute_force_ratio;
+        post_filter_ratio_ = post_filter_ratio;
+        post_filter_amplification_ = post_filter_amplification;
+    }
+
+    bool
+    get_enable_filter_planner() const {
+        return enable_filter_planner_;
+    }
+
+    float
+    get_brute_force_ratio() const {
+        return brute_force_ratio_;
+    }
+
+    float
+    get_post_filter_ratio() const {
+        return post_filter_ratio_;
+    }
+
+    float
+    get_post_filter_amplification() const {
+        return post_filter_amplification_;
+    }
+
  private:
     bool enable_growing_segment_index_ = false;
     int64_t chunk_rows_ = 32 * 1024;
     int64_t nlist_ = 100;
     int64_t nprobe_ = 4;
+    bool enable_filter_planner_ = false;
+    float brute_force_ratio_ = 0.01;
+    float post_filter_ratio_ = 0.95;
+    float post_filter_amplification_ = 2.0;
 };
 
 }  // namespace milvus::segcore
@@ -64,17 +64,54 @@ SegmentInternalInterface::FillTargetEntry(const query::Plan* plan,
     }
 }
 
+void
+SegmentInternalInterface::FillGroupByValues(const query::Plan* plan,
+                                            SearchResult& results) const {
+    std::shared_lock lck(mutex_);
+    AssertInfo(plan, "empty plan");
+    auto& search_info = plan->plan_node_->search_info_;
+    if (!search_info.group_by_field_id_.has_value()) {
+        return;
+    }
+    auto size = results.seg_offsets_.size();
+    auto field_data = bulk_subscript(search_info.group_by_field_id_.value(),
+                                     results.seg_offsets_.data(),
+                                     size);
+    ParseGroupByValuesFromFieldData(results.group_by_values_, *field_data);
+}
+
 std::unique_ptr<SearchResult>
 SegmentInternalInterface::Search(
     const query::Plan* plan,
-    const query::PlaceholderGroup* placeholder_group) const {
+    const query::PlaceholderGroup* placeholder_group,
+    Timestamp timestamp) const {
+    std::shared_lock lck(mutex_);
+    milvus::tracer::AddEvent("obtained_segment_lock_mutex");
+    check_search(plan);
+    query::ExecPlanNodeVisitor visitor(*this, timestamp, placeholder_group);
+    auto results = std::make_unique<SearchResult>();
+    *results = visitor.get_moved_result(*plan->plan_node_);
+    results->segment_ = (void*)this;
+    return results;
+}
+
+std::unique_ptr<SearchResult>
+SegmentInternalInterface::SearchWithFilterBitset(
+    const query::Plan* plan,
+    const query::PlaceholderGroup* placeholder_group,
+    Timestamp timestamp,
+    const BitsetType* cached_filter,
+    BitsetTypeOpt& evaluated_filter) const {
     std::shared_lock lck(mutex_);
     milvus::tracer::AddEvent("obtained_segment_lock_mutex");
     check_search(plan);
-    query::ExecPlanNodeVisitor visitor(*this, 1L << 63, placeholder_group);
+    query::ExecPlanNodeVisitor visitor(*this, timestamp, placeholder_group);
+    visitor.SetCachedFilterBitset(cached_filter);
+    visitor.SetOutputFilterBitset(cached_filter == nullptr);
     auto results = std::make_unique<SearchResult>();
     *results = visitor.get_moved_result(*plan->plan_node_);
     results->segment_ = (void*)this;
+    evaluated_filter = std::move(visitor.GetEvaluatedFilterBitset());
     return results;
 }
 
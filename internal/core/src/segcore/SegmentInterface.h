@@ -47,12 +47,17 @@ class SegmentInterface {
     virtual void
     FillTargetEntry(const query::Plan* plan, SearchResult& results) const = 0;
 
+    virtual void
+    FillGroupByValues(const query::Plan* plan,
+                      SearchResult& results) const = 0;
+
     virtual bool
     Contain(const PkType& pk) const = 0;
 
     virtual std::unique_ptr<SearchResult>
     Search(const query::Plan* Plan,
-           const query::PlaceholderGroup* placeholder_group) const = 0;
+           const query::PlaceholderGroup* placeholder_group,
+           Timestamp timestamp = MAX_TIMESTAMP) const = 0;
 
     virtual std::unique_ptr<proto::segcore::RetrieveResults>
     Retrieve(const query::RetrievePlan* Plan,
@@ -131,7 +136,17 @@ class SegmentInternalInterface : public SegmentInterface {
 
     std::unique_ptr<SearchResult>
     Search(const query::Plan* Plan,
-           const query::PlaceholderGroup* placeholder_group) const override;
+           const query::PlaceholderGroup* placeholder_group,
+           Timestamp timestamp = MAX_TIMESTAMP) const override;
+
+    // SearchWithFilterBitset skips evaluating the predicate if the cached filter
+    // bitset is given, otherwise outputs the evaluated filter bitset.
+    std::unique_ptr<SearchResult>
+    SearchWithFilterBitset(const query::Plan* Plan,
+                           const query::PlaceholderGroup* placeholder_group,
+                           Timestamp timestamp,
+                           const BitsetType* cached_filter,
+                           BitsetTypeOpt& evaluated_filter) const;
 
     void
     FillPrimaryKeys(const query::Plan* plan,
@@ -141,6 +156,10 @@ class SegmentInternalInterface : public SegmentInterface {
     FillTargetEntry(const query::Plan* plan,
                     SearchResult& results) const override;
 
+    void
+    FillGroupByValues(const query::Plan* plan,
+                      SearchResult& results) const override;
+
     std::unique_ptr<proto::segcore::RetrieveResults>
     Retrieve(const query::RetrievePlan* Plan,
              Timestamp timestamp,
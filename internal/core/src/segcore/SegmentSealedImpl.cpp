@@ -41,6 +41,7 @@
 #include "storage/ChunkCacheSingleton.h"
 #include "common/File.h"
 #include "common/Tracer.h"
+#include "common/StringPool.h"
 
 namespace milvus::segcore {
 
@@ -189,6 +190,8 @@ SegmentSealedImpl::LoadFieldData(const LoadFieldDataInfo& load_info) {
         auto insert_files = info.insert_files;
         auto field_data_info =
             FieldDataInfo(field_id.get(), num_rows, load_info.mmap_dir_path);
+        field_data_info.enable_string_pool = load_info.enable_string_pool;
+        field_data_info.vector_quantization = load_info.vector_quantization;
 
         auto parallel_degree = static_cast<uint64_t>(
             DEFAULT_FIELD_MAX_MEMORY_LIMIT / FILE_SLICE_SIZE);
@@ -276,8 +279,12 @@ SegmentSealedImpl::LoadFieldData(FieldId field_id, FieldDataInfo& data) {
                 case milvus::DataType::STRING:
                 case milvus::DataType::VARCHAR: {
                     auto var_column =
-                        std::make_shared<VariableColumn<std::string>>(
-                            num_rows, field_meta);
+                        data.enable_string_pool
+                            ? std::make_shared<VariableColumn<std::string>>(
+                                  field_meta,
+                                  StringPool::GetInstance().NewHolder())
+                            : std::make_shared<VariableColumn<std::string>>(
+                                  num_rows, field_meta);
                     storage::FieldDataPtr field_data;
                     while (data.channel->pop(field_data)) {
                         for (auto i = 0; i < field_data->get_num_rows(); i++) {
@@ -338,6 +345,15 @@ SegmentSealedImpl::LoadFieldData(FieldId field_id, FieldDataInfo& data) {
             // update average row data size
             SegmentInternalInterface::set_field_avg_size(
                 field_id, num_rows, field_data_size);
+        } else if (data_type == DataType::VECTOR_FLOAT &&
+                   data.vector_quantization != VectorQuantization::NONE) {
+            auto quantized_column = std::make_shared<QuantizedVectorColumn>(
+                num_rows, field_meta, data.vector_quantization);
+            storage::FieldDataPtr field_data;
+            while (data.channel->pop(field_data)) {
+                quantized_column->AppendBatch(field_data);
+            }
+            column = std::move(quantized_column);
         } else {
             column = std::make_shared<Column>(num_rows, field_meta);
             storage::FieldDataPtr field_data;
@@ -628,6 +644,19 @@ SegmentSealedImpl::vector_search(SearchInfo& search_info,
         AssertInfo(num_rows_.has_value(), "Can't get row count value");
         auto row_count = num_rows_.value();
         auto vec_data = fields_.at(field_id);
+        if (auto quantized = std::dynamic_pointer_cast<QuantizedVectorColumn>(
+                vec_data)) {
+            query::SearchOnSealedQuantized(*schema_,
+                                           *quantized,
+                                           search_info,
+                                           query_data,
+                                           query_count,
+                                           row_count,
+                                           bitset,
+                                           output);
+            milvus::tracer::AddEvent("finish_searching_quantized_vector_data");
+            return;
+        }
         query::SearchOnSealed(*schema_,
                               vec_data->Data(),
                               search_info,
@@ -1046,6 +1075,14 @@ SegmentSealedImpl::bulk_subscript(FieldId field_id,
         case DataType::VECTOR_FLOAT16:
         case DataType::VECTOR_BINARY: {
             aligned_vector<char> output(field_meta.get_sizeof() * count);
+            if (auto quantized =
+                    std::dynamic_pointer_cast<QuantizedVectorColumn>(column)) {
+                quantized->Decode(seg_offsets,
+                                  count,
+                                  reinterpret_cast<float*>(output.data()));
+                return CreateVectorDataArrayFrom(
+                    output.data(), count, field_meta);
+            }
             bulk_subscript_impl(field_meta.get_sizeof(),
                                 src_vec,
                                 seg_offsets,
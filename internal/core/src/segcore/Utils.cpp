@@ -46,6 +46,46 @@ ParsePksFromFieldData(std::vector<PkType>& pks, const DataArray& data) {
     }
 }
 
+void
+ParseGroupByValuesFromFieldData(std::vector<GroupByValueType>& values,
+                                const DataArray& data) {
+    auto data_type = static_cast<DataType>(data.type());
+    auto& scalars = data.scalars();
+    switch (data_type) {
+        case DataType::BOOL: {
+            auto& src_data = scalars.bool_data().data();
+            values.assign(src_data.begin(), src_data.end());
+            break;
+        }
+        case DataType::INT8:
+        case DataType::INT16:
+        case DataType::INT32: {
+            auto& src_data = scalars.int_data().data();
+            values.clear();
+            values.reserve(src_data.size());
+            for (auto v : src_data) {
+                values.emplace_back(int64_t(v));
+            }
+            break;
+        }
+        case DataType::INT64: {
+            auto& src_data = scalars.long_data().data();
+            values.assign(src_data.begin(), src_data.end());
+            break;
+        }
+        case DataType::VARCHAR: {
+            auto& src_data = scalars.string_data().data();
+            values.assign(src_data.begin(), src_data.end());
+            break;
+        }
+        default: {
+            PanicInfo(DataTypeInvalid,
+                      fmt::format("unsupported group by field type {}",
+                                  data_type));
+        }
+    }
+}
+
 void
 ParsePksFromFieldData(DataType data_type,
                       std::vector<PkType>& pks,
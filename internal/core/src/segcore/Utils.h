@@ -32,6 +32,10 @@ namespace milvus::segcore {
 void
 ParsePksFromFieldData(std::vector<PkType>& pks, const DataArray& data);
 
+void
+ParseGroupByValuesFromFieldData(std::vector<GroupByValueType>& values,
+                                const DataArray& data);
+
 void
 ParsePksFromFieldData(DataType data_type,
                       std::vector<PkType>& pks,
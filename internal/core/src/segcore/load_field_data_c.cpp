@@ -88,3 +88,19 @@ AppendMMapDirPath(CLoadFieldDataInfo c_load_field_data_info,
         static_cast<LoadFieldDataInfo*>(c_load_field_data_info);
     load_field_data_info->mmap_dir_path = std::string(c_dir_path);
 }
+
+void
+EnableStringPool(CLoadFieldDataInfo c_load_field_data_info, bool enabled) {
+    auto load_field_data_info =
+        static_cast<LoadFieldDataInfo*>(c_load_field_data_info);
+    load_field_data_info->enable_string_pool = enabled;
+}
+
+void
+SetVectorQuantization(CLoadFieldDataInfo c_load_field_data_info,
+                      const char* quantization) {
+    auto load_field_data_info =
+        static_cast<LoadFieldDataInfo*>(c_load_field_data_info);
+    load_field_data_info->vector_quantization =
+        milvus::VectorQuantizationFromString(std::string(quantization));
+}
@@ -19,6 +19,7 @@
 extern "C" {
 #endif
 
+#include <stdbool.h>
 #include <stdlib.h>
 
 #include "common/type_c.h"
@@ -46,6 +47,13 @@ void
 AppendMMapDirPath(CLoadFieldDataInfo c_load_field_data_info,
                   const char* dir_path);
 
+void
+EnableStringPool(CLoadFieldDataInfo c_load_field_data_info, bool enabled);
+
+void
+SetVectorQuantization(CLoadFieldDataInfo c_load_field_data_info,
+                      const char* quantization);
+
 #ifdef __cplusplus
 }
 #endif
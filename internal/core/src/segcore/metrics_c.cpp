@@ -11,6 +11,7 @@
 
 #include <string>
 
+#include "common/StringPool.h"
 #include "knowhere/prometheus_client.h"
 #include "segcore/metrics_c.h"
 
@@ -23,3 +24,10 @@ GetKnowhereMetrics() {
     res[len] = '\0';
     return res;
 }
+
+CStringPoolStats
+GetStringPoolStats() {
+    auto stats = milvus::StringPool::GetInstance().Stats();
+    return CStringPoolStats{
+        stats.unique_num, stats.pooled_bytes, stats.referenced_bytes};
+}
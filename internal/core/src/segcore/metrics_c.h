@@ -18,6 +18,15 @@ extern "C" {
 char*
 GetKnowhereMetrics();
 
+typedef struct CStringPoolStats {
+    int64_t unique_num;
+    int64_t pooled_bytes;
+    int64_t referenced_bytes;
+} CStringPoolStats;
+
+CStringPoolStats
+GetStringPoolStats();
+
 #ifdef __cplusplus
 }
 #endif
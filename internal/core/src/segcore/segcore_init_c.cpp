@@ -52,6 +52,19 @@ SegcoreSetNprobe(const int64_t value) {
     config.set_nprobe(value);
 }
 
+extern "C" void
+SegcoreSetFilterPlanner(const bool enable,
+                        const float brute_force_ratio,
+                        const float post_filter_ratio,
+                        const float post_filter_amplification) {
+    milvus::segcore::SegcoreConfig& config =
+        milvus::segcore::SegcoreConfig::default_config();
+    config.set_filter_planner(enable,
+                              brute_force_ratio,
+                              post_filter_ratio,
+                              post_filter_amplification);
+}
+
 extern "C" void
 SegcoreSetKnowhereBuildThreadPoolNum(const uint32_t num_threads) {
     milvus::config::KnowhereInitBuildThreadPool(num_threads);
@@ -30,6 +30,12 @@ SegcoreSetNlist(const int64_t);
 void
 SegcoreSetNprobe(const int64_t);
 
+void
+SegcoreSetFilterPlanner(const bool enable,
+                        const float brute_force_ratio,
+                        const float post_filter_ratio,
+                        const float post_filter_amplification);
+
 // return value must be freed by the caller
 char*
 SegcoreSetSimdType(const char*);
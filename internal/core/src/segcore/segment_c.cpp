@@ -69,6 +69,7 @@ CStatus
 Search(CSegmentInterface c_segment,
        CSearchPlan c_plan,
        CPlaceholderGroup c_placeholder_group,
+       uint64_t timestamp,
        CTraceContext c_trace,
        CSearchResult* result) {
     try {
@@ -80,7 +81,7 @@ Search(CSegmentInterface c_segment,
             c_trace.traceID, c_trace.spanID, c_trace.flag};
         auto span = milvus::tracer::StartSpan("SegCoreSearch", &ctx);
         milvus::tracer::SetRootSpan(span);
-        auto search_result = segment->Search(plan, phg_ptr);
+        auto search_result = segment->Search(plan, phg_ptr, timestamp);
         if (!milvus::PositivelyRelated(
                 plan->plan_node_->search_info_.metric_type_)) {
             for (auto& dis : search_result->distances_) {
@@ -96,6 +97,76 @@ Search(CSegmentInterface c_segment,
     }
 }
 
+void
+DeleteFilterBitset(CFilterBitset* bitset) {
+    std::free(bitset->blocks);
+    bitset->blocks = nullptr;
+    bitset->num_blocks = 0;
+    bitset->num_bits = 0;
+}
+
+CStatus
+SearchWithFilterBitset(CSegmentInterface c_segment,
+                       CSearchPlan c_plan,
+                       CPlaceholderGroup c_placeholder_group,
+                       uint64_t timestamp,
+                       CTraceContext c_trace,
+                       const uint64_t* cached_blocks,
+                       int64_t cached_num_blocks,
+                       int64_t cached_num_bits,
+                       CFilterBitset* evaluated_filter,
+                       CSearchResult* result) {
+    static_assert(sizeof(milvus::BitsetType::block_type) == sizeof(uint64_t));
+    try {
+        auto segment =
+            dynamic_cast<milvus::segcore::SegmentInternalInterface*>(
+                static_cast<milvus::segcore::SegmentInterface*>(c_segment));
+        AssertInfo(segment != nullptr, "unsupported segment type");
+        auto plan = (milvus::query::Plan*)c_plan;
+        auto phg_ptr = reinterpret_cast<const milvus::query::PlaceholderGroup*>(
+            c_placeholder_group);
+        auto ctx = milvus::tracer::TraceContext{
+            c_trace.traceID, c_trace.spanID, c_trace.flag};
+        auto span = milvus::tracer::StartSpan("SegCoreSearch", &ctx);
+        milvus::tracer::SetRootSpan(span);
+
+        std::unique_ptr<milvus::BitsetType> cached;
+        if (cached_blocks != nullptr) {
+            cached = std::make_unique<milvus::BitsetType>(cached_num_bits);
+            boost::from_block_range(
+                cached_blocks, cached_blocks + cached_num_blocks, *cached);
+        }
+        milvus::BitsetTypeOpt evaluated;
+        auto search_result = segment->SearchWithFilterBitset(
+            plan, phg_ptr, timestamp, cached.get(), evaluated);
+        if (!milvus::PositivelyRelated(
+                plan->plan_node_->search_info_.metric_type_)) {
+            for (auto& dis : search_result->distances_) {
+                dis *= -1;
+            }
+        }
+
+        evaluated_filter->blocks = nullptr;
+        evaluated_filter->num_blocks = 0;
+        evaluated_filter->num_bits = 0;
+        if (evaluated.has_value()) {
+            auto num_blocks = evaluated->num_blocks();
+            auto blocks = static_cast<uint64_t*>(
+                std::malloc(num_blocks * sizeof(uint64_t)));
+            boost::to_block_range(evaluated.value(), blocks);
+            evaluated_filter->blocks = blocks;
+            evaluated_filter->num_blocks = num_blocks;
+            evaluated_filter->num_bits = evaluated->size();
+        }
+        *result = search_result.release();
+        span->End();
+        milvus::tracer::CloseRootSpan();
+        return milvus::SuccessCStatus();
+    } catch (std::exception& e) {
+        return milvus::FailureCStatus(&e);
+    }
+}
+
 void
 DeleteRetrieveResult(CRetrieveResult* retrieve_result) {
     std::free(const_cast<void*>(retrieve_result->proto_blob));
@@ -28,6 +28,17 @@ typedef void* CSegmentInterface;
 typedef void* CSearchResult;
 typedef CProto CRetrieveResult;
 
+// CFilterBitset is the evaluated filter of a search plan, the set bits are the
+// rows filtered out. The blocks are allocated by segcore and must be released
+// by DeleteFilterBitset.
+// SearchWithFilterBitset reuses the cached filter blocks if given, otherwise
+// evaluates the filter and outputs it to evaluated_filter.
+typedef struct CFilterBitset {
+    uint64_t* blocks;
+    int64_t num_blocks;
+    int64_t num_bits;
+} CFilterBitset;
+
 //////////////////////////////    common interfaces    //////////////////////////////
 CSegmentInterface
 NewSegment(CCollection collection, SegmentType seg_type, int64_t segment_id);
@@ -42,9 +53,25 @@ CStatus
 Search(CSegmentInterface c_segment,
        CSearchPlan c_plan,
        CPlaceholderGroup c_placeholder_group,
+       uint64_t timestamp,
        CTraceContext c_trace,
        CSearchResult* result);
 
+void
+DeleteFilterBitset(CFilterBitset* bitset);
+
+CStatus
+SearchWithFilterBitset(CSegmentInterface c_segment,
+                       CSearchPlan c_plan,
+                       CPlaceholderGroup c_placeholder_group,
+                       uint64_t timestamp,
+                       CTraceContext c_trace,
+                       const uint64_t* cached_blocks,
+                       int64_t cached_num_blocks,
+                       int64_t cached_num_bits,
+                       CFilterBitset* evaluated_filter,
+                       CSearchResult* result);
+
 void
 DeleteRetrieveResult(CRetrieveResult* retrieve_result);
 
@@ -39,7 +39,9 @@ namespace milvus::storage {
 std::map<std::string, ChunkManagerType> ChunkManagerType_Map = {
     {"local", ChunkManagerType::Local},
     {"minio", ChunkManagerType::Minio},
-    {"remote", ChunkManagerType::Remote}};
+    {"remote", ChunkManagerType::Remote},
+    // segcore accesses gcs by the s3 compatible api
+    {"gcs", ChunkManagerType::Remote}};
 
 enum class CloudProviderType : int8_t {
     UNKNOWN = 0,
@@ -586,6 +588,9 @@ CreateChunkManager(const StorageConfig& storage_config) {
             return std::make_shared<MinioChunkManager>(storage_config);
         }
         case ChunkManagerType::Remote: {
+            if (storage_config.storage_type == "gcs") {
+                return std::make_shared<GcpChunkManager>(storage_config);
+            }
             auto cloud_provider_type =
                 CloudProviderType_Map[storage_config.cloud_provider];
             switch (cloud_provider_type) {
@@ -984,11 +984,13 @@ TEST(CApiTest, SearchTest) {
     placeholderGroups.push_back(placeholderGroup);
 
     CSearchResult search_result;
-    auto res = Search(segment, plan, placeholderGroup, {}, &search_result);
+    auto res = Search(
+        segment, plan, placeholderGroup, MAX_TIMESTAMP, {}, &search_result);
     ASSERT_EQ(res.error_code, Success);
 
     CSearchResult search_result2;
-    auto res2 = Search(segment, plan, placeholderGroup, {}, &search_result2);
+    auto res2 = Search(
+        segment, plan, placeholderGroup, MAX_TIMESTAMP, {}, &search_result2);
     ASSERT_EQ(res2.error_code, Success);
 
     DeleteSearchPlan(plan);
@@ -1050,7 +1052,8 @@ TEST(CApiTest, SearchTestWithExpr) {
     dataset.timestamps_.push_back(1);
 
     CSearchResult search_result;
-    auto res = Search(segment, plan, placeholderGroup, {}, &search_result);
+    auto res = Search(
+        segment, plan, placeholderGroup, MAX_TIMESTAMP, {}, &search_result);
     ASSERT_EQ(res.error_code, Success);
 
     DeleteSearchPlan(plan);
@@ -1060,6 +1063,110 @@ TEST(CApiTest, SearchTestWithExpr) {
     DeleteSegment(segment);
 }
 
+TEST(CApiTest, SearchWithFilterBitsetTest) {
+    auto c_collection = NewCollection(get_default_schema_config());
+    auto segment = NewSegment(c_collection, Growing, -1);
+    auto col = (milvus::segcore::Collection*)c_collection;
+
+    int N = 10000;
+    auto dataset = DataGen(col->get_schema(), N);
+
+    int64_t offset;
+    PreInsert(segment, N, &offset);
+
+    auto insert_data = serialize(dataset.raw_);
+    auto ins_res = Insert(segment,
+                          offset,
+                          N,
+                          dataset.row_ids_.data(),
+                          dataset.timestamps_.data(),
+                          insert_data.data(),
+                          insert_data.size());
+    ASSERT_EQ(ins_res.error_code, Success);
+
+    const char* serialized_expr_plan = R"(vector_anns: <
+                                            field_id: 100
+                                            predicates: <
+                                              unary_range_expr: <
+                                                column_info: <
+                                                  field_id: 101
+                                                  data_type: Int64
+                                                >
+                                                op: LessThan
+                                                value: <
+                                                  int64_val: 4200
+                                                >
+                                              >
+                                            >
+                                            query_info: <
+                                                topk: 10
+                                                metric_type: "L2"
+                                                search_params: "{\"nprobe\": 10}"
+                                            >
+                                            placeholder_tag: "$0"
+                                         >)";
+
+    int num_queries = 10;
+    auto blob = generate_query_data(num_queries);
+
+    void* plan = nullptr;
+    auto binary_plan = translate_text_plan_to_binary_plan(serialized_expr_plan);
+    auto status = CreateSearchPlanByExpr(
+        c_collection, binary_plan.data(), binary_plan.size(), &plan);
+    ASSERT_EQ(status.error_code, Success);
+
+    void* placeholderGroup = nullptr;
+    status = ParsePlaceholderGroup(
+        plan, blob.data(), blob.length(), &placeholderGroup);
+    ASSERT_EQ(status.error_code, Success);
+
+    // the first search evaluates the filter
+    CFilterBitset evaluated;
+    CSearchResult search_result;
+    auto res = SearchWithFilterBitset(segment,
+                                      plan,
+                                      placeholderGroup,
+                                      MAX_TIMESTAMP,
+                                      {},
+                                      nullptr,
+                                      0,
+                                      0,
+                                      &evaluated,
+                                      &search_result);
+    ASSERT_EQ(res.error_code, Success);
+    ASSERT_EQ(evaluated.num_bits, N);
+    ASSERT_NE(evaluated.blocks, nullptr);
+
+    // the second search reuses the evaluated filter
+    CFilterBitset evaluated2;
+    CSearchResult search_result2;
+    res = SearchWithFilterBitset(segment,
+                                 plan,
+                                 placeholderGroup,
+                                 MAX_TIMESTAMP,
+                                 {},
+                                 evaluated.blocks,
+                                 evaluated.num_blocks,
+                                 evaluated.num_bits,
+                                 &evaluated2,
+                                 &search_result2);
+    ASSERT_EQ(res.error_code, Success);
+    ASSERT_EQ(evaluated2.blocks, nullptr);
+
+    auto sr = (SearchResult*)search_result;
+    auto sr2 = (SearchResult*)search_result2;
+    ASSERT_EQ(sr->seg_offsets_, sr2->seg_offsets_);
+    ASSERT_EQ(sr->distances_, sr2->distances_);
+
+    DeleteFilterBitset(&evaluated);
+    DeleteSearchPlan(plan);
+    DeletePlaceholderGroup(placeholderGroup);
+    DeleteSearchResult(search_result);
+    DeleteSearchResult(search_result2);
+    DeleteCollection(c_collection);
+    DeleteSegment(segment);
+}
+
 TEST(CApiTest, RetrieveTestWithExpr) {
     auto collection = NewCollection(get_default_schema_config());
     auto segment = NewSegment(collection, Growing, -1);
@@ -1316,7 +1423,8 @@ TEST(CApiTest, ReudceNullResult) {
         auto slice_topKs = std::vector<int64_t>{1};
         std::vector<CSearchResult> results;
         CSearchResult res;
-        status = Search(segment, plan, placeholderGroup, {}, &res);
+        status = Search(
+            segment, plan, placeholderGroup, MAX_TIMESTAMP, {}, &res);
         ASSERT_EQ(status.error_code, Success);
         results.push_back(res);
         CSearchResultDataBlobs cSearchResultData;
@@ -1401,9 +1509,11 @@ TEST(CApiTest, ReduceRemoveDuplicates) {
         auto slice_topKs = std::vector<int64_t>{topK / 2, topK};
         std::vector<CSearchResult> results;
         CSearchResult res1, res2;
-        status = Search(segment, plan, placeholderGroup, {}, &res1);
+        status = Search(
+            segment, plan, placeholderGroup, MAX_TIMESTAMP, {}, &res1);
         ASSERT_EQ(status.error_code, Success);
-        status = Search(segment, plan, placeholderGroup, {}, &res2);
+        status = Search(
+            segment, plan, placeholderGroup, MAX_TIMESTAMP, {}, &res2);
         ASSERT_EQ(status.error_code, Success);
         results.push_back(res1);
         results.push_back(res2);
@@ -1432,11 +1542,14 @@ TEST(CApiTest, ReduceRemoveDuplicates) {
         auto slice_topKs = std::vector<int64_t>{topK / 2, topK, topK};
         std::vector<CSearchResult> results;
         CSearchResult res1, res2, res3;
-        status = Search(segment, plan, placeholderGroup, {}, &res1);
+        status = Search(
+            segment, plan, placeholderGroup, MAX_TIMESTAMP, {}, &res1);
         ASSERT_EQ(status.error_code, Success);
-        status = Search(segment, plan, placeholderGroup, {}, &res2);
+        status = Search(
+            segment, plan, placeholderGroup, MAX_TIMESTAMP, {}, &res2);
         ASSERT_EQ(status.error_code, Success);
-        status = Search(segment, plan, placeholderGroup, {}, &res3);
+        status = Search(
+            segment, plan, placeholderGroup, MAX_TIMESTAMP, {}, &res3);
         ASSERT_EQ(status.error_code, Success);
         results.push_back(res1);
         results.push_back(res2);
@@ -1523,9 +1636,10 @@ testReduceSearchWithExpr(int N, int topK, int num_queries) {
     std::vector<CSearchResult> results;
     CSearchResult res1;
     CSearchResult res2;
-    auto res = Search(segment, plan, placeholderGroup, {}, &res1);
+    auto res = Search(
+        segment, plan, placeholderGroup, MAX_TIMESTAMP, {}, &res1);
     ASSERT_EQ(res.error_code, Success);
-    res = Search(segment, plan, placeholderGroup, {}, &res2);
+    res = Search(segment, plan, placeholderGroup, MAX_TIMESTAMP, {}, &res2);
     ASSERT_EQ(res.error_code, Success);
     results.push_back(res1);
     results.push_back(res2);
@@ -1748,8 +1862,12 @@ TEST(CApiTest, Indexing_Without_Predicate) {
     placeholderGroups.push_back(placeholderGroup);
 
     CSearchResult c_search_result_on_smallIndex;
-    auto res_before_load_index = Search(
-        segment, plan, placeholderGroup, {}, &c_search_result_on_smallIndex);
+    auto res_before_load_index = Search(segment,
+                                        plan,
+                                        placeholderGroup,
+                                        MAX_TIMESTAMP,
+                                        {},
+                                        &c_search_result_on_smallIndex);
     ASSERT_EQ(res_before_load_index.error_code, Success);
 
     // load index to segment
@@ -1808,6 +1926,7 @@ TEST(CApiTest, Indexing_Without_Predicate) {
     auto res_after_load_index = Search(sealed_segment.get(),
                                        plan,
                                        placeholderGroup,
+                                       MAX_TIMESTAMP,
                                        {},
                                        &c_search_result_on_bigIndex);
     ASSERT_EQ(res_after_load_index.error_code, Success);
@@ -1890,8 +2009,12 @@ TEST(CApiTest, Indexing_Expr_Without_Predicate) {
     placeholderGroups.push_back(placeholderGroup);
 
     CSearchResult c_search_result_on_smallIndex;
-    auto res_before_load_index = Search(
-        segment, plan, placeholderGroup, {}, &c_search_result_on_smallIndex);
+    auto res_before_load_index = Search(segment,
+                                        plan,
+                                        placeholderGroup,
+                                        MAX_TIMESTAMP,
+                                        {},
+                                        &c_search_result_on_smallIndex);
     ASSERT_EQ(res_before_load_index.error_code, Success);
 
     // load index to segment
@@ -1951,6 +2074,7 @@ TEST(CApiTest, Indexing_Expr_Without_Predicate) {
     auto res_after_load_index = Search(sealed_segment.get(),
                                        plan,
                                        placeholderGroup,
+                                       MAX_TIMESTAMP,
                                        {},
                                        &c_search_result_on_bigIndex);
     ASSERT_EQ(res_after_load_index.error_code, Success);
@@ -2062,8 +2186,12 @@ TEST(CApiTest, Indexing_With_float_Predicate_Range) {
     placeholderGroups.push_back(placeholderGroup);
 
     CSearchResult c_search_result_on_smallIndex;
-    auto res_before_load_index = Search(
-        segment, plan, placeholderGroup, {}, &c_search_result_on_smallIndex);
+    auto res_before_load_index = Search(segment,
+                                        plan,
+                                        placeholderGroup,
+                                        MAX_TIMESTAMP,
+                                        {},
+                                        &c_search_result_on_smallIndex);
     ASSERT_EQ(res_before_load_index.error_code, Success);
 
     // load index to segment
@@ -2123,6 +2251,7 @@ TEST(CApiTest, Indexing_With_float_Predicate_Range) {
     auto res_after_load_index = Search(sealed_segment.get(),
                                        plan,
                                        placeholderGroup,
+                                       MAX_TIMESTAMP,
                                        {},
                                        &c_search_result_on_bigIndex);
     ASSERT_EQ(res_after_load_index.error_code, Success);
@@ -2236,8 +2365,12 @@ TEST(CApiTest, Indexing_Expr_With_float_Predicate_Range) {
     placeholderGroups.push_back(placeholderGroup);
 
     CSearchResult c_search_result_on_smallIndex;
-    auto res_before_load_index = Search(
-        segment, plan, placeholderGroup, {}, &c_search_result_on_smallIndex);
+    auto res_before_load_index = Search(segment,
+                                        plan,
+                                        placeholderGroup,
+                                        MAX_TIMESTAMP,
+                                        {},
+                                        &c_search_result_on_smallIndex);
     ASSERT_EQ(res_before_load_index.error_code, Success);
 
     // load index to segment
@@ -2297,6 +2430,7 @@ TEST(CApiTest, Indexing_Expr_With_float_Predicate_Range) {
     auto res_after_load_index = Search(sealed_segment.get(),
                                        plan,
                                        placeholderGroup,
+                                       MAX_TIMESTAMP,
                                        {},
                                        &c_search_result_on_bigIndex);
     ASSERT_EQ(res_after_load_index.error_code, Success);
@@ -2402,8 +2536,12 @@ TEST(CApiTest, Indexing_With_float_Predicate_Term) {
     placeholderGroups.push_back(placeholderGroup);
 
     CSearchResult c_search_result_on_smallIndex;
-    auto res_before_load_index = Search(
-        segment, plan, placeholderGroup, {}, &c_search_result_on_smallIndex);
+    auto res_before_load_index = Search(segment,
+                                        plan,
+                                        placeholderGroup,
+                                        MAX_TIMESTAMP,
+                                        {},
+                                        &c_search_result_on_smallIndex);
     ASSERT_EQ(res_before_load_index.error_code, Success);
 
     // load index to segment
@@ -2463,6 +2601,7 @@ TEST(CApiTest, Indexing_With_float_Predicate_Term) {
     auto res_after_load_index = Search(sealed_segment.get(),
                                        plan,
                                        placeholderGroup,
+                                       MAX_TIMESTAMP,
                                        {},
                                        &c_search_result_on_bigIndex);
     ASSERT_EQ(res_after_load_index.error_code, Success);
@@ -2569,8 +2708,12 @@ TEST(CApiTest, Indexing_Expr_With_float_Predicate_Term) {
     placeholderGroups.push_back(placeholderGroup);
 
     CSearchResult c_search_result_on_smallIndex;
-    auto res_before_load_index = Search(
-        segment, plan, placeholderGroup, {}, &c_search_result_on_smallIndex);
+    auto res_before_load_index = Search(segment,
+                                        plan,
+                                        placeholderGroup,
+                                        MAX_TIMESTAMP,
+                                        {},
+                                        &c_search_result_on_smallIndex);
     ASSERT_EQ(res_before_load_index.error_code, Success);
 
     // load index to segment
@@ -2630,6 +2773,7 @@ TEST(CApiTest, Indexing_Expr_With_float_Predicate_Term) {
     auto res_after_load_index = Search(sealed_segment.get(),
                                        plan,
                                        placeholderGroup,
+                                       MAX_TIMESTAMP,
                                        {},
                                        &c_search_result_on_bigIndex);
     ASSERT_EQ(res_after_load_index.error_code, Success);
@@ -2741,8 +2885,12 @@ TEST(CApiTest, Indexing_With_binary_Predicate_Range) {
     placeholderGroups.push_back(placeholderGroup);
 
     CSearchResult c_search_result_on_smallIndex;
-    auto res_before_load_index = Search(
-        segment, plan, placeholderGroup, {}, &c_search_result_on_smallIndex);
+    auto res_before_load_index = Search(segment,
+                                        plan,
+                                        placeholderGroup,
+                                        MAX_TIMESTAMP,
+                                        {},
+                                        &c_search_result_on_smallIndex);
     ASSERT_EQ(res_before_load_index.error_code, Success);
 
     // load index to segment
@@ -2803,6 +2951,7 @@ TEST(CApiTest, Indexing_With_binary_Predicate_Range) {
     auto res_after_load_index = Search(sealed_segment.get(),
                                        plan,
                                        placeholderGroup,
+                                       MAX_TIMESTAMP,
                                        {},
                                        &c_search_result_on_bigIndex);
     ASSERT_EQ(res_after_load_index.error_code, Success);
@@ -2914,8 +3063,12 @@ TEST(CApiTest, Indexing_Expr_With_binary_Predicate_Range) {
     placeholderGroups.push_back(placeholderGroup);
 
     CSearchResult c_search_result_on_smallIndex;
-    auto res_before_load_index = Search(
-        segment, plan, placeholderGroup, {}, &c_search_result_on_smallIndex);
+    auto res_before_load_index = Search(segment,
+                                        plan,
+                                        placeholderGroup,
+                                        MAX_TIMESTAMP,
+                                        {},
+                                        &c_search_result_on_smallIndex);
     ASSERT_TRUE(res_before_load_index.error_code == Success)
         << res_before_load_index.error_msg;
 
@@ -2976,6 +3129,7 @@ TEST(CApiTest, Indexing_Expr_With_binary_Predicate_Range) {
     auto res_after_load_index = Search(sealed_segment.get(),
                                        plan,
                                        placeholderGroup,
+                                       MAX_TIMESTAMP,
                                        {},
                                        &c_search_result_on_bigIndex);
     ASSERT_EQ(res_after_load_index.error_code, Success);
@@ -3082,8 +3236,12 @@ TEST(CApiTest, Indexing_With_binary_Predicate_Term) {
     placeholderGroups.push_back(placeholderGroup);
 
     CSearchResult c_search_result_on_smallIndex;
-    auto res_before_load_index = Search(
-        segment, plan, placeholderGroup, {}, &c_search_result_on_smallIndex);
+    auto res_before_load_index = Search(segment,
+                                        plan,
+                                        placeholderGroup,
+                                        MAX_TIMESTAMP,
+                                        {},
+                                        &c_search_result_on_smallIndex);
     ASSERT_EQ(res_before_load_index.error_code, Success);
 
     // load index to segment
@@ -3143,6 +3301,7 @@ TEST(CApiTest, Indexing_With_binary_Predicate_Term) {
     auto res_after_load_index = Search(sealed_segment.get(),
                                        plan,
                                        placeholderGroup,
+                                       MAX_TIMESTAMP,
                                        {},
                                        &c_search_result_on_bigIndex);
     ASSERT_EQ(res_after_load_index.error_code, Success);
@@ -3272,8 +3431,12 @@ TEST(CApiTest, Indexing_Expr_With_binary_Predicate_Term) {
     Timestamp time = 10000000;
 
     CSearchResult c_search_result_on_smallIndex;
-    auto res_before_load_index = Search(
-        segment, plan, placeholderGroup, {}, &c_search_result_on_smallIndex);
+    auto res_before_load_index = Search(segment,
+                                        plan,
+                                        placeholderGroup,
+                                        MAX_TIMESTAMP,
+                                        {},
+                                        &c_search_result_on_smallIndex);
     ASSERT_EQ(res_before_load_index.error_code, Success);
 
     // load index to segment
@@ -3333,6 +3496,7 @@ TEST(CApiTest, Indexing_Expr_With_binary_Predicate_Term) {
     auto res_after_load_index = Search(sealed_segment.get(),
                                        plan,
                                        placeholderGroup,
+                                       MAX_TIMESTAMP,
                                        {},
                                        &c_search_result_on_bigIndex);
     ASSERT_EQ(res_after_load_index.error_code, Success);
@@ -3526,6 +3690,7 @@ TEST(CApiTest, SealedSegment_search_float_Predicate_Range) {
     auto res_after_load_index = Search(sealed_segment.get(),
                                        plan,
                                        placeholderGroup,
+                                       MAX_TIMESTAMP,
                                        {},
                                        &c_search_result_on_bigIndex);
     ASSERT_EQ(res_after_load_index.error_code, Success);
@@ -3603,12 +3768,14 @@ TEST(CApiTest, SealedSegment_search_without_predicates) {
     std::vector<CPlaceholderGroup> placeholderGroups;
     placeholderGroups.push_back(placeholderGroup);
     CSearchResult search_result;
-    auto res = Search(segment, plan, placeholderGroup, {}, &search_result);
+    auto res = Search(
+        segment, plan, placeholderGroup, MAX_TIMESTAMP, {}, &search_result);
     std::cout << res.error_msg << std::endl;
     ASSERT_EQ(res.error_code, Success);
 
     CSearchResult search_result2;
-    auto res2 = Search(segment, plan, placeholderGroup, {}, &search_result2);
+    auto res2 = Search(
+        segment, plan, placeholderGroup, MAX_TIMESTAMP, {}, &search_result2);
     ASSERT_EQ(res2.error_code, Success);
 
     DeleteSearchPlan(plan);
@@ -3754,8 +3921,12 @@ TEST(CApiTest, SealedSegment_search_float_With_Expr_Predicate_Range) {
     }
 
     CSearchResult c_search_result_on_bigIndex;
-    auto res_after_load_index = Search(
-        segment, plan, placeholderGroup, {}, &c_search_result_on_bigIndex);
+    auto res_after_load_index = Search(segment,
+                                       plan,
+                                       placeholderGroup,
+                                       MAX_TIMESTAMP,
+                                       {},
+                                       &c_search_result_on_bigIndex);
     ASSERT_EQ(res_after_load_index.error_code, Success);
 
     auto search_result_on_bigIndex = (SearchResult*)c_search_result_on_bigIndex;
@@ -4044,7 +4215,8 @@ TEST(CApiTest, RANGE_SEARCH_WITH_RADIUS_WHEN_IP) {
     placeholderGroups.push_back(placeholderGroup);
 
     CSearchResult search_result;
-    auto res = Search(segment, plan, placeholderGroup, {}, &search_result);
+    auto res = Search(
+        segment, plan, placeholderGroup, MAX_TIMESTAMP, {}, &search_result);
     ASSERT_EQ(res.error_code, Success);
 
     DeleteSearchPlan(plan);
@@ -4105,7 +4277,8 @@ TEST(CApiTest, RANGE_SEARCH_WITH_RADIUS_AND_RANGE_FILTER_WHEN_IP) {
     placeholderGroups.push_back(placeholderGroup);
 
     CSearchResult search_result;
-    auto res = Search(segment, plan, placeholderGroup, {}, &search_result);
+    auto res = Search(
+        segment, plan, placeholderGroup, MAX_TIMESTAMP, {}, &search_result);
     ASSERT_EQ(res.error_code, Success);
 
     DeleteSearchPlan(plan);
@@ -4166,7 +4339,8 @@ TEST(CApiTest, RANGE_SEARCH_WITH_RADIUS_WHEN_L2) {
     placeholderGroups.push_back(placeholderGroup);
 
     CSearchResult search_result;
-    auto res = Search(segment, plan, placeholderGroup, {}, &search_result);
+    auto res = Search(
+        segment, plan, placeholderGroup, MAX_TIMESTAMP, {}, &search_result);
     ASSERT_EQ(res.error_code, Success);
 
     DeleteSearchPlan(plan);
@@ -4227,7 +4401,8 @@ TEST(CApiTest, RANGE_SEARCH_WITH_RADIUS_AND_RANGE_FILTER_WHEN_L2) {
     placeholderGroups.push_back(placeholderGroup);
 
     CSearchResult search_result;
-    auto res = Search(segment, plan, placeholderGroup, {}, &search_result);
+    auto res = Search(
+        segment, plan, placeholderGroup, MAX_TIMESTAMP, {}, &search_result);
     ASSERT_EQ(res.error_code, Success);
 
     DeleteSearchPlan(plan);
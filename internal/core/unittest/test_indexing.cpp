@@ -646,5 +646,15 @@ TEST(Indexing, SearchDiskAnnWithInvalidParam) {
     };
     EXPECT_THROW(vec_index->Query(xq_dataset, search_info, nullptr),
                  std::runtime_error);
+
+    // search disk index with beamwidth overridden by search request
+    search_info.search_params_ = milvus::Config{
+        {knowhere::meta::METRIC_TYPE, metric_type},
+        {milvus::index::DISK_ANN_QUERY_LIST, K},
+        {milvus::index::DISK_ANN_QUERY_BEAMWIDTH, 2},
+    };
+    auto result = vec_index->Query(xq_dataset, search_info, nullptr);
+    EXPECT_EQ(result->total_nq_, NQ);
+    EXPECT_EQ(result->unity_topK_, K);
 }
 #endif
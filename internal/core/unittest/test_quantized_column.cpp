@@ -0,0 +1,115 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+#include <gtest/gtest.h>
+
+#include <cmath>
+#include <random>
+
+#include "mmap/Column.h"
+#include "storage/Util.h"
+
+using namespace milvus;
+
+namespace {
+std::vector<float>
+RandomVectors(int64_t num, int64_t dim) {
+    std::mt19937 gen(42);
+    std::normal_distribution<float> dist(0, 1);
+    std::vector<float> data(num * dim);
+    for (auto& x : data) {
+        x = dist(gen);
+    }
+    return data;
+}
+}  // namespace
+
+TEST(QuantizedVectorColumn, CodeSize) {
+    EXPECT_EQ(QuantizedVectorColumn::CodeSize(128, VectorQuantization::FP16),
+              256);
+    EXPECT_EQ(QuantizedVectorColumn::CodeSize(128, VectorQuantization::SQ8),
+              136);
+    EXPECT_ANY_THROW(
+        QuantizedVectorColumn::CodeSize(128, VectorQuantization::NONE));
+
+    EXPECT_EQ(VectorQuantizationFromString("FP16"), VectorQuantization::FP16);
+    EXPECT_EQ(VectorQuantizationFromString("SQ8"), VectorQuantization::SQ8);
+    EXPECT_EQ(VectorQuantizationFromString(""), VectorQuantization::NONE);
+}
+
+TEST(QuantizedVectorColumn, EncodeDecode) {
+    int64_t dim = 16;
+    int64_t num = 100;
+    FieldMeta field_meta(FieldName("vec"),
+                         FieldId(100),
+                         DataType::VECTOR_FLOAT,
+                         dim,
+                         knowhere::metric::L2);
+    auto data = RandomVectors(num, dim);
+
+    for (auto [quantization, tolerance] :
+         std::vector<std::pair<VectorQuantization, float>>{
+             {VectorQuantization::FP16, 1e-2},
+             {VectorQuantization::SQ8, 5e-2}}) {
+        // load in two batches, the column expands while appending
+        QuantizedVectorColumn column(num / 2, field_meta, quantization);
+        for (int64_t begin = 0; begin < num; begin += num / 2) {
+            auto field_data =
+                storage::CreateFieldData(DataType::VECTOR_FLOAT, dim);
+            field_data->FillFieldData(data.data() + begin * dim, num / 2);
+            column.AppendBatch(field_data);
+        }
+        EXPECT_EQ(column.NumRows(), num);
+        EXPECT_EQ(column.Quantization(), quantization);
+        EXPECT_ANY_THROW(column.Span());
+
+        std::vector<float> decoded(num * dim);
+        column.Decode(0, num, decoded.data());
+        for (int64_t i = 0; i < num * dim; i++) {
+            EXPECT_NEAR(decoded[i], data[i], tolerance);
+        }
+
+        std::vector<int64_t> offsets{99, 0, 42};
+        std::vector<float> picked(offsets.size() * dim);
+        column.Decode(offsets.data(), offsets.size(), picked.data());
+        for (int64_t i = 0; i < offsets.size(); i++) {
+            for (int64_t j = 0; j < dim; j++) {
+                EXPECT_EQ(picked[i * dim + j], decoded[offsets[i] * dim + j]);
+            }
+        }
+    }
+}
+
+TEST(QuantizedVectorColumn, Constant) {
+    int64_t dim = 4;
+    FieldMeta field_meta(FieldName("vec"),
+                         FieldId(100),
+                         DataType::VECTOR_FLOAT,
+                         dim,
+                         knowhere::metric::L2);
+    std::vector<float> data{0.5, 0.5, 0.5, 0.5, 0, 0, 0, 0};
+    auto field_data = storage::CreateFieldData(DataType::VECTOR_FLOAT, dim);
+    field_data->FillFieldData(data.data(), 2);
+
+    for (auto quantization :
+         {VectorQuantization::FP16, VectorQuantization::SQ8}) {
+        QuantizedVectorColumn column(2, field_meta, quantization);
+        column.AppendBatch(field_data);
+        std::vector<float> decoded(data.size());
+        column.Decode(0, 2, decoded.data());
+        EXPECT_EQ(decoded, data);
+    }
+}
@@ -0,0 +1,91 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+#include <gtest/gtest.h>
+
+#include "common/StringPool.h"
+#include "mmap/Column.h"
+
+using namespace milvus;
+
+TEST(StringPool, Holder) {
+    auto& pool = StringPool::GetInstance();
+    auto base = pool.Stats();
+
+    auto holder1 = pool.NewHolder();
+    auto a1 = holder1->Intern("shard-a");
+    auto a2 = holder1->Intern("shard-a");
+    holder1->Intern("shard-b");
+    EXPECT_EQ(a1.data(), a2.data());
+
+    auto holder2 = pool.NewHolder();
+    auto a3 = holder2->Intern(std::string("shard-a"));
+    EXPECT_EQ(a1.data(), a3.data());
+    EXPECT_EQ(a3, "shard-a");
+
+    auto stats = pool.Stats();
+    EXPECT_EQ(stats.unique_num - base.unique_num, 2);
+    EXPECT_EQ(stats.pooled_bytes - base.pooled_bytes, 14);
+    EXPECT_EQ(stats.referenced_bytes - base.referenced_bytes, 28);
+
+    holder1.reset();
+    stats = pool.Stats();
+    EXPECT_EQ(stats.unique_num - base.unique_num, 1);
+    EXPECT_EQ(stats.pooled_bytes - base.pooled_bytes, 7);
+    EXPECT_EQ(stats.referenced_bytes - base.referenced_bytes, 7);
+    EXPECT_EQ(a3, "shard-a");
+
+    holder2->Seal();
+    EXPECT_ANY_THROW(holder2->Intern("shard-c"));
+
+    holder2.reset();
+    stats = pool.Stats();
+    EXPECT_EQ(stats.unique_num, base.unique_num);
+    EXPECT_EQ(stats.pooled_bytes, base.pooled_bytes);
+    EXPECT_EQ(stats.referenced_bytes, base.referenced_bytes);
+}
+
+TEST(StringPool, PooledColumn) {
+    auto& pool = StringPool::GetInstance();
+    auto base = pool.Stats();
+
+    FieldMeta field_meta(FieldName("tag"), FieldId(100), DataType::VARCHAR, 64);
+    std::vector<std::string> values{"red", "green", "red", "blue", "red"};
+    {
+        VariableColumn<std::string> column(field_meta, pool.NewHolder());
+        for (auto& value : values) {
+            column.Append(value.data(), value.size());
+        }
+        column.Seal();
+
+        EXPECT_TRUE(column.IsPooled());
+        EXPECT_EQ(column.NumRows(), values.size());
+        for (int i = 0; i < values.size(); i++) {
+            EXPECT_EQ(column.RawAt(i), values[i]);
+            EXPECT_EQ(column[i], values[i]);
+        }
+        EXPECT_EQ(column.RawAt(0).data(), column.RawAt(2).data());
+
+        auto stats = pool.Stats();
+        EXPECT_EQ(stats.unique_num - base.unique_num, 3);
+        EXPECT_EQ(stats.pooled_bytes - base.pooled_bytes, 12);
+        EXPECT_EQ(stats.referenced_bytes - base.referenced_bytes, 18);
+    }
+
+    auto stats = pool.Stats();
+    EXPECT_EQ(stats.unique_num, base.unique_num);
+    EXPECT_EQ(stats.pooled_bytes, base.pooled_bytes);
+}
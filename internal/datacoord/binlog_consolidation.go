@@ -0,0 +1,346 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package datacoord
+
+import (
+	"context"
+	"fmt"
+	"path"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/samber/lo"
+	"go.uber.org/zap"
+
+	"github.com/milvus-io/milvus-proto/go-api/v2/commonpb"
+	"github.com/milvus-io/milvus-proto/go-api/v2/schemapb"
+	"github.com/milvus-io/milvus/internal/proto/datapb"
+	"github.com/milvus-io/milvus/internal/proto/etcdpb"
+	"github.com/milvus-io/milvus/internal/storage"
+	"github.com/milvus-io/milvus/pkg/log"
+	"github.com/milvus-io/milvus/pkg/util/logutil"
+	"github.com/milvus-io/milvus/pkg/util/metautil"
+)
+
+// binlogConsolidator merges the small insert binlog files of flushed segments into larger ones.
+// Unlike compaction, the segments are kept as they are: the rows are rewritten in the same order,
+// so the row offsets, the stats and the built indexes of the segment stay valid.
+// The replaced binlog files are left to the garbage collector.
+type binlogConsolidator struct {
+	meta      *meta
+	allocator allocator
+	cli       storage.ChunkManager
+
+	startOnce sync.Once
+	stopOnce  sync.Once
+	wg        sync.WaitGroup
+	closeCh   chan struct{}
+}
+
+func newBinlogConsolidator(meta *meta, allocator allocator, cli storage.ChunkManager) *binlogConsolidator {
+	return &binlogConsolidator{
+		meta:      meta,
+		allocator: allocator,
+		cli:       cli,
+		closeCh:   make(chan struct{}),
+	}
+}
+
+// start a goroutine and consolidate the segments every interval
+func (c *binlogConsolidator) start() {
+	if !Params.DataCoordCfg.BinlogConsolidationEnable.GetAsBool() {
+		return
+	}
+	if c.cli == nil {
+		log.Warn("DataCoord binlog consolidation enabled, but chunk manager is not provided")
+		return
+	}
+	c.startOnce.Do(func() {
+		c.wg.Add(1)
+		go c.work()
+	})
+}
+
+func (c *binlogConsolidator) work() {
+	defer logutil.LogPanic()
+	defer c.wg.Done()
+	ticker := time.NewTicker(Params.DataCoordCfg.BinlogConsolidationInterval.GetAsDuration(time.Second))
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ticker.C:
+			c.consolidate()
+		case <-c.closeCh:
+			log.Info("binlog consolidator quit")
+			return
+		}
+	}
+}
+
+func (c *binlogConsolidator) close() {
+	c.stopOnce.Do(func() {
+		close(c.closeCh)
+		c.wg.Wait()
+	})
+}
+
+// consolidate consolidates the segments having the most small binlog files.
+func (c *binlogConsolidator) consolidate() {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	go func() {
+		select {
+		case <-c.closeCh:
+			cancel()
+		case <-ctx.Done():
+		}
+	}()
+
+	segments := c.selectSegments()
+	for _, segment := range segments {
+		if ctx.Err() != nil {
+			return
+		}
+		if err := c.consolidateSegment(ctx, segment); err != nil {
+			log.Warn("failed to consolidate binlogs of segment", zap.Int64("segmentID", segment.GetID()), zap.Error(err))
+		}
+	}
+}
+
+// selectSegments returns the flushed segments to consolidate, the ones with more small binlog files come first.
+func (c *binlogConsolidator) selectSegments() []*SegmentInfo {
+	minBinlogNum := Params.DataCoordCfg.BinlogConsolidationMinBinlogNum.GetAsInt()
+	segments := c.meta.SelectSegments(func(segment *SegmentInfo) bool {
+		return isSegmentHealthy(segment) &&
+			segment.GetState() == commonpb.SegmentState_Flushed &&
+			segment.GetLevel() != datapb.SegmentLevel_L0 &&
+			!segment.isCompacting &&
+			!segment.GetIsImporting() &&
+			canConsolidateBinlogs(segment) &&
+			countSmallBinlogs(segment) >= minBinlogNum
+	})
+	sort.Slice(segments, func(i, j int) bool {
+		return countSmallBinlogs(segments[i]) > countSmallBinlogs(segments[j])
+	})
+	if maxSegments := Params.DataCoordCfg.BinlogConsolidationMaxSegments.GetAsInt(); len(segments) > maxSegments {
+		segments = segments[:maxSegments]
+	}
+	return segments
+}
+
+// canConsolidateBinlogs checks the binlogs of all fields are aligned, and the stats of segment
+// don't rely on the binlog files, which is true for the segments with the merged stats log or compacted ones.
+func canConsolidateBinlogs(segment *SegmentInfo) bool {
+	binlogs := segment.GetBinlogs()
+	if len(binlogs) == 0 {
+		return false
+	}
+	for _, fieldBinlog := range binlogs {
+		if len(fieldBinlog.GetBinlogs()) != len(binlogs[0].GetBinlogs()) {
+			return false
+		}
+	}
+	if len(segment.GetCompactionFrom()) > 0 || len(segment.GetStatslogs()) == 0 {
+		return true
+	}
+	for _, statslog := range segment.GetStatslogs()[0].GetBinlogs() {
+		if path.Base(statslog.GetLogPath()) == storage.CompoundStatsType.LogIdx() {
+			return true
+		}
+	}
+	return false
+}
+
+// isSmallBinlog returns whether the idx-th binlog files of all fields are small.
+func isSmallBinlog(segment *SegmentInfo, idx int) bool {
+	smallSize := int64(Params.DataCoordCfg.BinlogConsolidationSmallBinlogSize.GetAsFloat() * 1024 * 1024)
+	for _, fieldBinlog := range segment.GetBinlogs() {
+		if fieldBinlog.GetBinlogs()[idx].GetLogSize() >= smallSize {
+			return false
+		}
+	}
+	return true
+}
+
+func countSmallBinlogs(segment *SegmentInfo) int {
+	count := 0
+	for idx := range segment.GetBinlogs()[0].GetBinlogs() {
+		if isSmallBinlog(segment, idx) {
+			count++
+		}
+	}
+	return count
+}
+
+// groupSmallBinlogs groups the consecutive small binlogs up to the target size,
+// each group is the [start, end) range of binlog index, and only the groups with more than one binlog are returned.
+func groupSmallBinlogs(segment *SegmentInfo) [][2]int {
+	targetSize := int64(Params.DataCoordCfg.BinlogConsolidationTargetSize.GetAsFloat() * 1024 * 1024)
+	groups := make([][2]int, 0)
+	start, size := 0, int64(0)
+	flush := func(end int) {
+		if end-start > 1 {
+			groups = append(groups, [2]int{start, end})
+		}
+		start, size = end, 0
+	}
+
+	num := len(segment.GetBinlogs()[0].GetBinlogs())
+	for idx := 0; idx < num; idx++ {
+		if !isSmallBinlog(segment, idx) {
+			flush(idx)
+			start = idx + 1
+			continue
+		}
+		binlogSize := lo.SumBy(segment.GetBinlogs(), func(fieldBinlog *datapb.FieldBinlog) int64 {
+			return fieldBinlog.GetBinlogs()[idx].GetLogSize()
+		})
+		if idx > start && size+binlogSize > targetSize {
+			flush(idx)
+		}
+		size += binlogSize
+	}
+	flush(num)
+	return groups
+}
+
+// consolidateSegment merges the small binlog files of the segment and replaces them in meta.
+func (c *binlogConsolidator) consolidateSegment(ctx context.Context, segment *SegmentInfo) error {
+	log := log.Ctx(ctx).With(zap.Int64("collectionID", segment.GetCollectionID()),
+		zap.Int64("segmentID", segment.GetID()))
+
+	collection := c.meta.GetCollection(segment.GetCollectionID())
+	if collection == nil {
+		return fmt.Errorf("collection %d not found", segment.GetCollectionID())
+	}
+	codec := storage.NewInsertCodecWithSchema(&etcdpb.CollectionMeta{ID: collection.ID, Schema: collection.Schema})
+
+	// the segment may be compacted or dropped since it is selected
+	segment = c.meta.GetHealthySegment(segment.GetID())
+	if segment == nil || segment.GetState() != commonpb.SegmentState_Flushed || segment.isCompacting || !canConsolidateBinlogs(segment) {
+		return nil
+	}
+	// prevent the segment from being compacted during consolidation
+	c.meta.SetSegmentCompacting(segment.GetID(), true)
+	defer c.meta.SetSegmentCompacting(segment.GetID(), false)
+	groups := groupSmallBinlogs(segment)
+	if len(groups) == 0 {
+		return nil
+	}
+	origin := segment.GetBinlogs()
+
+	merged := make([][]*datapb.Binlog, len(origin))
+	for i := range origin {
+		merged[i] = make([]*datapb.Binlog, 0, len(origin[i].GetBinlogs()))
+	}
+	next := 0
+	for _, group := range groups {
+		for idx := next; idx < group[0]; idx++ {
+			for i, fieldBinlog := range origin {
+				merged[i] = append(merged[i], fieldBinlog.GetBinlogs()[idx])
+			}
+		}
+		logID, err := c.allocator.allocID(ctx)
+		if err != nil {
+			return err
+		}
+		for i, fieldBinlog := range origin {
+			binlog, err := c.mergeBinlogs(ctx, codec, segment, fieldBinlog.GetFieldID(), fieldBinlog.GetBinlogs()[group[0]:group[1]], logID)
+			if err != nil {
+				return err
+			}
+			merged[i] = append(merged[i], binlog)
+		}
+		next = group[1]
+	}
+	for idx := next; idx < len(origin[0].GetBinlogs()); idx++ {
+		for i, fieldBinlog := range origin {
+			merged[i] = append(merged[i], fieldBinlog.GetBinlogs()[idx])
+		}
+	}
+
+	consolidated := make([]*datapb.FieldBinlog, 0, len(origin))
+	for i, fieldBinlog := range origin {
+		consolidated = append(consolidated, &datapb.FieldBinlog{
+			FieldID: fieldBinlog.GetFieldID(),
+			Binlogs: merged[i],
+		})
+	}
+	if err := c.meta.ReplaceSegmentBinlogs(segment.GetID(), origin, consolidated); err != nil {
+		return err
+	}
+	log.Info("binlogs of segment consolidated",
+		zap.Int("originBinlogNum", len(origin[0].GetBinlogs())),
+		zap.Int("consolidatedBinlogNum", len(consolidated[0].GetBinlogs())))
+	return nil
+}
+
+// mergeBinlogs reads the binlog files of a field, and writes the rows in one binlog file of the same order.
+func (c *binlogConsolidator) mergeBinlogs(ctx context.Context, codec *storage.InsertCodec, segment *SegmentInfo,
+	fieldID int64, binlogs []*datapb.Binlog, logID int64,
+) (*datapb.Binlog, error) {
+	paths := lo.Map(binlogs, func(binlog *datapb.Binlog, _ int) string { return binlog.GetLogPath() })
+	values, err := c.cli.MultiRead(ctx, paths)
+	if err != nil {
+		return nil, err
+	}
+	blobs := make([]*storage.Blob, 0, len(values))
+	for i, value := range values {
+		if checksum := binlogs[i].GetChecksum(); checksum != "" && checksum != storage.BinlogChecksum(value) {
+			return nil, fmt.Errorf("checksum of binlog %s mismatch", paths[i])
+		}
+		blobs = append(blobs, &storage.Blob{Key: paths[i], Value: value})
+	}
+
+	rowNum := lo.SumBy(binlogs, func(binlog *datapb.Binlog) int64 { return binlog.GetEntriesNum() })
+	data := &storage.InsertData{Data: make(map[storage.FieldID]storage.FieldData)}
+	if _, _, _, err := codec.DeserializeInto(blobs, int(rowNum), data); err != nil {
+		return nil, err
+	}
+	fieldData, ok := data.Data[fieldID]
+	if !ok || int64(fieldData.RowNum()) != rowNum {
+		return nil, fmt.Errorf("binlogs of field %d don't match the entries num %d", fieldID, rowNum)
+	}
+	field, ok := lo.Find(codec.Schema.GetSchema().GetFields(), func(field *schemapb.FieldSchema) bool {
+		return field.GetFieldID() == fieldID
+	})
+	if !ok {
+		return nil, fmt.Errorf("field %d not found in schema", fieldID)
+	}
+
+	startTs := lo.MinBy(binlogs, func(a, b *datapb.Binlog) bool { return a.GetTimestampFrom() < b.GetTimestampFrom() }).GetTimestampFrom()
+	endTs := lo.MaxBy(binlogs, func(a, b *datapb.Binlog) bool { return a.GetTimestampTo() > b.GetTimestampTo() }).GetTimestampTo()
+	blob, err := codec.SerializeField(segment.GetPartitionID(), segment.GetID(), field, fieldData, startTs, endTs)
+	if err != nil {
+		return nil, err
+	}
+
+	logPath := metautil.BuildInsertLogPath(c.cli.RootPath(), segment.GetCollectionID(), segment.GetPartitionID(), segment.GetID(), fieldID, logID)
+	if err := c.cli.Write(ctx, logPath, blob.GetValue()); err != nil {
+		return nil, err
+	}
+	return &datapb.Binlog{
+		EntriesNum:    rowNum,
+		TimestampFrom: startTs,
+		TimestampTo:   endTs,
+		LogPath:       logPath,
+		LogSize:       int64(len(blob.GetValue())),
+		LogID:         logID,
+		Checksum:      storage.BinlogChecksum(blob.GetValue()),
+	}, nil
+}
@@ -0,0 +1,177 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package datacoord
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/milvus-io/milvus-proto/go-api/v2/commonpb"
+	"github.com/milvus-io/milvus-proto/go-api/v2/schemapb"
+	"github.com/milvus-io/milvus/internal/metastore/kv/datacoord"
+	"github.com/milvus-io/milvus/internal/proto/datapb"
+	"github.com/milvus-io/milvus/internal/proto/etcdpb"
+	"github.com/milvus-io/milvus/internal/storage"
+	"github.com/milvus-io/milvus/pkg/common"
+	"github.com/milvus-io/milvus/pkg/util/metautil"
+	"github.com/milvus-io/milvus/pkg/util/paramtable"
+)
+
+// writeTestBinlogs writes the binlog files of batchNum batches, each batch has 10 rows of descending row ids,
+// which would be reordered if the binlogs were sorted.
+func writeTestBinlogs(t *testing.T, cm storage.ChunkManager, schema *schemapb.CollectionSchema, batchNum int) []*datapb.FieldBinlog {
+	codec := storage.NewInsertCodecWithSchema(&etcdpb.CollectionMeta{ID: 1, Schema: schema})
+	fieldBinlogs := make([]*datapb.FieldBinlog, 0, len(schema.GetFields()))
+	for _, field := range schema.GetFields() {
+		fieldBinlog := &datapb.FieldBinlog{FieldID: field.GetFieldID()}
+		for batch := 0; batch < batchNum; batch++ {
+			data := &storage.Int64FieldData{}
+			for i := 0; i < 10; i++ {
+				data.Data = append(data.Data, int64(1000-batch*10-i))
+			}
+			ts := uint64(batch + 1)
+			blob, err := codec.SerializeField(10, 100, field, data, ts, ts)
+			require.NoError(t, err)
+			logID := int64(1000 + batch)
+			logPath := metautil.BuildInsertLogPath(cm.RootPath(), 1, 10, 100, field.GetFieldID(), logID)
+			require.NoError(t, cm.Write(context.Background(), logPath, blob.GetValue()))
+			fieldBinlog.Binlogs = append(fieldBinlog.Binlogs, &datapb.Binlog{
+				EntriesNum:    10,
+				TimestampFrom: ts,
+				TimestampTo:   ts,
+				LogPath:       logPath,
+				LogSize:       int64(len(blob.GetValue())),
+				LogID:         logID,
+				Checksum:      storage.BinlogChecksum(blob.GetValue()),
+			})
+		}
+		fieldBinlogs = append(fieldBinlogs, fieldBinlog)
+	}
+	return fieldBinlogs
+}
+
+func Test_binlogConsolidator(t *testing.T) {
+	paramtable.Init()
+	ctx := context.Background()
+
+	cm := storage.NewLocalChunkManager(storage.RootPath(t.TempDir()))
+	kv := NewMetaMemoryKV()
+	m, err := newMeta(ctx, datacoord.NewCatalog(kv, "", ""), cm)
+	require.NoError(t, err)
+	schema := &schemapb.CollectionSchema{
+		Name: "test",
+		Fields: []*schemapb.FieldSchema{
+			{FieldID: common.RowIDField, Name: common.RowIDFieldName, DataType: schemapb.DataType_Int64},
+			{FieldID: common.TimeStampField, Name: common.TimeStampFieldName, DataType: schemapb.DataType_Int64},
+			{FieldID: 100, Name: "pk", DataType: schemapb.DataType_Int64, IsPrimaryKey: true},
+		},
+	}
+	m.AddCollection(&collectionInfo{ID: 1, Schema: schema})
+
+	statslogPath := metautil.BuildStatsLogPath(cm.RootPath(), 1, 10, 100, 100, int64(storage.CompoundStatsType))
+	require.NoError(t, m.AddSegment(ctx, NewSegmentInfo(&datapb.SegmentInfo{
+		ID:            100,
+		CollectionID:  1,
+		PartitionID:   10,
+		InsertChannel: "ch1",
+		State:         commonpb.SegmentState_Flushed,
+		NumOfRows:     40,
+		Binlogs:       writeTestBinlogs(t, cm, schema, 4),
+		Statslogs:     []*datapb.FieldBinlog{{FieldID: 100, Binlogs: []*datapb.Binlog{{LogPath: statslogPath}}}},
+	})))
+	// the statslogs of segment 101 are not merged, which are bound to the binlogs
+	require.NoError(t, m.AddSegment(ctx, NewSegmentInfo(&datapb.SegmentInfo{
+		ID:            101,
+		CollectionID:  1,
+		PartitionID:   10,
+		InsertChannel: "ch1",
+		State:         commonpb.SegmentState_Flushed,
+		Binlogs: []*datapb.FieldBinlog{{FieldID: 100, Binlogs: []*datapb.Binlog{
+			{LogID: 1, LogPath: "insert_log/1/10/101/100/1"}, {LogID: 2, LogPath: "insert_log/1/10/101/100/2"},
+		}}},
+		Statslogs: []*datapb.FieldBinlog{{FieldID: 100, Binlogs: []*datapb.Binlog{
+			{LogID: 3, LogPath: "stats_log/1/10/101/100/3"}, {LogID: 4, LogPath: "stats_log/1/10/101/100/4"},
+		}}},
+	})))
+
+	paramtable.Get().Save(Params.DataCoordCfg.BinlogConsolidationMinBinlogNum.Key, "2")
+	defer paramtable.Get().Reset(Params.DataCoordCfg.BinlogConsolidationMinBinlogNum.Key)
+
+	consolidator := newBinlogConsolidator(m, newMockAllocator(), cm)
+	segments := consolidator.selectSegments()
+	require.Len(t, segments, 1)
+	assert.EqualValues(t, 100, segments[0].GetID())
+
+	require.NoError(t, consolidator.consolidateSegment(ctx, segments[0]))
+	segment := m.GetSegment(100)
+	assert.False(t, segment.isCompacting)
+	assert.EqualValues(t, 40, segment.GetNumOfRows())
+	require.Len(t, segment.GetBinlogs(), 3)
+	for _, fieldBinlog := range segment.GetBinlogs() {
+		require.Len(t, fieldBinlog.GetBinlogs(), 1)
+		binlog := fieldBinlog.GetBinlogs()[0]
+		assert.EqualValues(t, 40, binlog.GetEntriesNum())
+		assert.EqualValues(t, 1, binlog.GetTimestampFrom())
+		assert.EqualValues(t, 4, binlog.GetTimestampTo())
+
+		value, err := cm.Read(ctx, binlog.GetLogPath())
+		require.NoError(t, err)
+		assert.Equal(t, storage.BinlogChecksum(value), binlog.GetChecksum())
+		data := &storage.InsertData{Data: make(map[storage.FieldID]storage.FieldData)}
+		_, _, _, err = storage.NewInsertCodec().DeserializeInto([]*storage.Blob{{Value: value}}, 40, data)
+		require.NoError(t, err)
+		rows := data.Data[fieldBinlog.GetFieldID()].(*storage.Int64FieldData).Data
+		require.Len(t, rows, 40)
+		for i, row := range rows {
+			assert.EqualValues(t, 1000-i, row)
+		}
+	}
+
+	// the consolidated binlogs are persisted
+	reloaded, err := newMeta(ctx, datacoord.NewCatalog(kv, "", ""), cm)
+	require.NoError(t, err)
+	for _, fieldBinlog := range reloaded.GetSegment(100).GetBinlogs() {
+		assert.Len(t, fieldBinlog.GetBinlogs(), 1)
+	}
+
+	// nothing left to consolidate
+	assert.Empty(t, consolidator.selectSegments())
+}
+
+func Test_groupSmallBinlogs(t *testing.T) {
+	paramtable.Init()
+	paramtable.Get().Save(Params.DataCoordCfg.BinlogConsolidationSmallBinlogSize.Key, "1")
+	defer paramtable.Get().Reset(Params.DataCoordCfg.BinlogConsolidationSmallBinlogSize.Key)
+	paramtable.Get().Save(Params.DataCoordCfg.BinlogConsolidationTargetSize.Key, "2")
+	defer paramtable.Get().Reset(Params.DataCoordCfg.BinlogConsolidationTargetSize.Key)
+
+	const mb = 1024 * 1024
+	sizes := []int64{mb / 2, mb / 2, mb / 2, mb / 2, mb / 2, 2 * mb, mb / 2, mb / 2, 3 * mb, mb / 2}
+	binlogs := make([]*datapb.Binlog, 0, len(sizes))
+	for _, size := range sizes {
+		binlogs = append(binlogs, &datapb.Binlog{LogSize: size})
+	}
+	segment := NewSegmentInfo(&datapb.SegmentInfo{
+		Binlogs: []*datapb.FieldBinlog{{FieldID: 100, Binlogs: binlogs}},
+	})
+
+	assert.Equal(t, 8, countSmallBinlogs(segment))
+	assert.Equal(t, [][2]int{{0, 4}, {6, 8}}, groupSmallBinlogs(segment))
+}
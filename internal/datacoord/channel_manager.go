@@ -19,6 +19,7 @@ package datacoord
 import (
 	"context"
 	"fmt"
+	"strconv"
 	"sync"
 	"time"
 
@@ -32,10 +33,13 @@ import (
 	"github.com/milvus-io/milvus/internal/kv"
 	"github.com/milvus-io/milvus/internal/proto/datapb"
 	"github.com/milvus-io/milvus/pkg/common"
+	"github.com/milvus-io/milvus/pkg/eventlog"
 	"github.com/milvus-io/milvus/pkg/log"
 	"github.com/milvus-io/milvus/pkg/mq/msgstream"
 	"github.com/milvus-io/milvus/pkg/util/funcutil"
 	"github.com/milvus-io/milvus/pkg/util/logutil"
+	"github.com/milvus-io/milvus/pkg/util/paramtable"
+	"github.com/milvus-io/milvus/pkg/util/typeutil"
 )
 
 // ChannelManager manages the allocation and the balance between channels and data nodes.
@@ -520,6 +524,20 @@ func (c *ChannelManager) GetBufferChannels() *NodeChannelInfo {
 	return c.store.GetBufferChannelInfo()
 }
 
+// checkAllChannelsWatched returns error if some channels are not assigned to datanodes yet, or being watched or released.
+func (c *ChannelManager) checkAllChannelsWatched() error {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+
+	if bufferChannels := c.store.GetBufferChannelInfo(); bufferChannels != nil && len(bufferChannels.Channels) > 0 {
+		return fmt.Errorf("%d channels are not assigned to datanodes", len(bufferChannels.Channels))
+	}
+	if count := c.stateTimer.runningTimerCount.Load(); count > 0 {
+		return fmt.Errorf("%d channels are being watched or released", count)
+	}
+	return nil
+}
+
 // Match checks and returns whether the node ID and channel match.
 // use vchannel
 func (c *ChannelManager) Match(nodeID int64, channel string) bool {
@@ -821,7 +839,11 @@ func (c *ChannelManager) Reassign(originNodeID UniqueID, channelName string) err
 	log.Info("channel manager reassigning channels",
 		zap.Int64("old node ID", originNodeID),
 		zap.Array("updates", updates))
-	return c.updateWithTimer(updates, datapb.ChannelWatchState_ToWatch)
+	if err := c.updateWithTimer(updates, datapb.ChannelWatchState_ToWatch); err != nil {
+		return err
+	}
+	publishChannelReassigned(originNodeID, updates)
+	return nil
 }
 
 // CleanupAndReassign tries to clean up datanode's subscription, and then reassigns the channel to another DataNode.
@@ -879,7 +901,33 @@ func (c *ChannelManager) CleanupAndReassign(nodeID UniqueID, channelName string)
 	log.Info("channel manager reassigning channels",
 		zap.Int64("old nodeID", nodeID),
 		zap.Array("updates", updates))
-	return c.updateWithTimer(updates, datapb.ChannelWatchState_ToWatch)
+	if err := c.updateWithTimer(updates, datapb.ChannelWatchState_ToWatch); err != nil {
+		return err
+	}
+	publishChannelReassigned(nodeID, updates)
+	return nil
+}
+
+// publishChannelReassigned publishes the channels newly assigned by the updates to the event bus.
+func publishChannelReassigned(originNodeID UniqueID, updates ChannelOpSet) {
+	for _, op := range updates {
+		if op.Type != Add {
+			continue
+		}
+		for _, ch := range op.Channels {
+			eventlog.Publish(&eventlog.ClusterEvent{
+				EventType:    eventlog.EventChannelReassigned,
+				Role:         typeutil.DataCoordRole,
+				NodeID:       paramtable.GetNodeID(),
+				CollectionID: ch.CollectionID,
+				Channel:      ch.Name,
+				Attrs: map[string]string{
+					"from_node": strconv.FormatInt(originNodeID, 10),
+					"to_node":   strconv.FormatInt(op.NodeID, 10),
+				},
+			})
+		}
+	}
 }
 
 func (c *ChannelManager) getChannelByNodeAndName(nodeID UniqueID, channelName string) *channel {
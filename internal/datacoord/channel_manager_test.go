@@ -467,6 +467,37 @@ func TestChannelManager(t *testing.T) {
 		chManager.stateTimer.removeTimers([]string{"channel-3"})
 	})
 
+	t.Run("test checkAllChannelsWatched", func(t *testing.T) {
+		defer watchkv.RemoveWithPrefix("")
+		var (
+			collectionID = UniqueID(8)
+			nodeID       = UniqueID(120)
+		)
+
+		chManager, err := NewChannelManager(watchkv, newMockHandler())
+		require.NoError(t, err)
+		chManager.store = &ChannelStore{
+			store: watchkv,
+			channelsInfo: map[int64]*NodeChannelInfo{
+				bufferID: {bufferID, []*channel{{Name: "channel1", CollectionID: collectionID}}},
+			},
+		}
+		assert.Error(t, chManager.checkAllChannelsWatched())
+
+		chManager.store = &ChannelStore{
+			store: watchkv,
+			channelsInfo: map[int64]*NodeChannelInfo{
+				nodeID: {nodeID, []*channel{{Name: "channel1", CollectionID: collectionID}}},
+			},
+		}
+		assert.NoError(t, chManager.checkAllChannelsWatched())
+
+		chManager.stateTimer.startOne(datapb.ChannelWatchState_ToWatch, "channel1", nodeID, time.Minute)
+		assert.Error(t, chManager.checkAllChannelsWatched())
+		chManager.stateTimer.removeTimers([]string{"channel1"})
+		assert.NoError(t, chManager.checkAllChannelsWatched())
+	})
+
 	t.Run("test Watch", func(t *testing.T) {
 		defer watchkv.RemoveWithPrefix("")
 		var (
@@ -0,0 +1,343 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package datacoord
+
+import (
+	"context"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/cockroachdb/errors"
+	"go.uber.org/zap"
+
+	"github.com/milvus-io/milvus/pkg/log"
+	"github.com/milvus-io/milvus/pkg/util/logutil"
+	"github.com/milvus-io/milvus/pkg/util/merr"
+)
+
+const (
+	// OrphanChannelWatched is the channel watched by DataNode or in buffer, but its collection is gone.
+	OrphanChannelWatched = "watched"
+	// OrphanChannelCheckpoint is the channel only having checkpoint left, and its collection is gone.
+	OrphanChannelCheckpoint = "checkpoint"
+	// OrphanChannelUnwatched is the channel of live collection, but not watched by any DataNode.
+	OrphanChannelUnwatched = "unwatched"
+)
+
+// OrphanChannel is the vchannel inconsistent between the meta of DataCoord and the live collections.
+type OrphanChannel struct {
+	Channel      string `json:"channel"`
+	Kind         string `json:"kind"`
+	CollectionID int64  `json:"collection_id,omitempty"`
+	NodeID       int64  `json:"node_id,omitempty"`
+	Segments     int    `json:"segments"`
+}
+
+// OrphanChannelReport is the result of the latest orphaned channel detection.
+type OrphanChannelReport struct {
+	Channels    []*OrphanChannel `json:"channels"`
+	CheckedTime time.Time        `json:"checked_time"`
+}
+
+// orphanChannelChecker keeps the latest report of orphaned channel detection.
+type orphanChannelChecker struct {
+	mu     sync.RWMutex
+	report *OrphanChannelReport
+}
+
+func (c *orphanChannelChecker) get() *OrphanChannelReport {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	return c.report
+}
+
+func (c *orphanChannelChecker) set(report *OrphanChannelReport) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.report = report
+}
+
+// remove removes the released channel from the latest report.
+func (c *orphanChannelChecker) remove(channelName string) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if c.report == nil {
+		return
+	}
+	channels := make([]*OrphanChannel, 0, len(c.report.Channels))
+	for _, ch := range c.report.Channels {
+		if ch.Channel != channelName {
+			channels = append(channels, ch)
+		}
+	}
+	c.report = &OrphanChannelReport{Channels: channels, CheckedTime: c.report.CheckedTime}
+}
+
+// channelSnapshot is the channels known by DataCoord at a moment.
+type channelSnapshot struct {
+	// channel name -> the channel watched or in buffer
+	watched map[string]*channel
+	// channel name -> the DataNode watching it, bufferID if in buffer
+	watchers    map[string]int64
+	checkpoints []string
+}
+
+func (s *Server) snapshotChannels() *channelSnapshot {
+	snapshot := &channelSnapshot{
+		watched:     make(map[string]*channel),
+		watchers:    make(map[string]int64),
+		checkpoints: s.meta.GetChannelCheckpointChannels(),
+	}
+	infos := s.channelManager.GetAssignedChannels()
+	if buffer := s.channelManager.GetBufferChannels(); buffer != nil {
+		infos = append(infos, buffer)
+	}
+	for _, info := range infos {
+		for _, ch := range info.Channels {
+			snapshot.watched[ch.Name] = ch
+			snapshot.watchers[ch.Name] = info.NodeID
+		}
+	}
+	return snapshot
+}
+
+// listLiveChannels returns the vchannels of all the collections in RootCoord, vchannel -> collection ID.
+func (s *Server) listLiveChannels(ctx context.Context) (map[string]int64, error) {
+	dbsRsp, err := s.broker.ListDatabases(ctx)
+	if err != nil {
+		return nil, err
+	}
+	live := make(map[string]int64)
+	for _, dbName := range dbsRsp.GetDbNames() {
+		showColRsp, err := s.broker.ShowCollections(ctx, dbName)
+		if err != nil {
+			return nil, err
+		}
+		for _, collectionID := range showColRsp.GetCollectionIds() {
+			describeColRsp, err := s.broker.DescribeCollectionInternal(ctx, collectionID)
+			if err != nil {
+				if errors.Is(err, merr.ErrCollectionNotFound) {
+					// dropped during listing
+					continue
+				}
+				return nil, err
+			}
+			for _, vchannel := range describeColRsp.GetVirtualChannelNames() {
+				live[vchannel] = collectionID
+			}
+		}
+	}
+	return live, nil
+}
+
+// findOrphanChannels compares the channels known by DataCoord with the vchannels of live collections.
+func findOrphanChannels(snapshot *channelSnapshot, live map[string]int64, segmentsOf func(channel string) []*SegmentInfo) []*OrphanChannel {
+	orphans := make([]*OrphanChannel, 0)
+	for name, ch := range snapshot.watched {
+		if _, ok := live[name]; ok {
+			continue
+		}
+		nodeID := snapshot.watchers[name]
+		if nodeID == bufferID {
+			nodeID = 0
+		}
+		orphans = append(orphans, &OrphanChannel{
+			Channel:      name,
+			Kind:         OrphanChannelWatched,
+			CollectionID: ch.CollectionID,
+			NodeID:       nodeID,
+			Segments:     len(segmentsOf(name)),
+		})
+	}
+	for _, name := range snapshot.checkpoints {
+		if _, ok := live[name]; ok {
+			continue
+		}
+		if _, ok := snapshot.watched[name]; ok {
+			continue
+		}
+		segments := segmentsOf(name)
+		orphan := &OrphanChannel{
+			Channel:  name,
+			Kind:     OrphanChannelCheckpoint,
+			Segments: len(segments),
+		}
+		if len(segments) > 0 {
+			orphan.CollectionID = segments[0].GetCollectionID()
+		}
+		orphans = append(orphans, orphan)
+	}
+	for name, collectionID := range live {
+		if _, ok := snapshot.watched[name]; ok {
+			continue
+		}
+		orphans = append(orphans, &OrphanChannel{
+			Channel:      name,
+			Kind:         OrphanChannelUnwatched,
+			CollectionID: collectionID,
+			Segments:     len(segmentsOf(name)),
+		})
+	}
+	sort.Slice(orphans, func(i, j int) bool {
+		return orphans[i].Channel < orphans[j].Channel
+	})
+	return orphans
+}
+
+// detectOrphanChannels detects the orphaned channels, the channels are snapshotted before and after listing
+// the live collections, only the ones orphaned in both snapshots are reported, to skip the collections creating or dropping.
+func (s *Server) detectOrphanChannels(ctx context.Context) (*OrphanChannelReport, error) {
+	before := s.snapshotChannels()
+	live, err := s.listLiveChannels(ctx)
+	if err != nil {
+		return nil, err
+	}
+	after := s.snapshotChannels()
+
+	segmentsOf := func(channel string) []*SegmentInfo {
+		return s.meta.SelectSegments(func(segment *SegmentInfo) bool {
+			return segment.GetInsertChannel() == channel && isSegmentHealthy(segment)
+		})
+	}
+	confirmed := make(map[string]string)
+	for _, orphan := range findOrphanChannels(before, live, segmentsOf) {
+		confirmed[orphan.Channel] = orphan.Kind
+	}
+
+	report := &OrphanChannelReport{Channels: make([]*OrphanChannel, 0), CheckedTime: time.Now()}
+	for _, orphan := range findOrphanChannels(after, live, segmentsOf) {
+		if confirmed[orphan.Channel] != orphan.Kind {
+			continue
+		}
+		if orphan.Kind != OrphanChannelUnwatched && orphan.CollectionID != 0 {
+			exist, err := s.broker.HasCollection(ctx, orphan.CollectionID)
+			if err != nil {
+				return nil, err
+			}
+			if exist {
+				continue
+			}
+		}
+		report.Channels = append(report.Channels, orphan)
+	}
+	return report, nil
+}
+
+// GetOrphanChannels returns the latest report of orphaned channels, or detects them again if refresh.
+func (s *Server) GetOrphanChannels(ctx context.Context, refresh bool) (*OrphanChannelReport, error) {
+	if err := merr.CheckHealthy(s.GetStateCode()); err != nil {
+		return nil, err
+	}
+	if report := s.orphanChannels.get(); report != nil && !refresh {
+		return report, nil
+	}
+	report, err := s.detectOrphanChannels(ctx)
+	if err != nil {
+		return nil, err
+	}
+	s.orphanChannels.set(report)
+	return report, nil
+}
+
+// ForceReleaseOrphanChannel releases the orphaned channel the same as dropping the channel of collection,
+// the segments of channel are marked dropped, and the channel is unwatched from DataNode.
+// The channel is confirmed orphaned before releasing, the unwatched channels of live collections can't be released.
+func (s *Server) ForceReleaseOrphanChannel(ctx context.Context, channelName string) error {
+	log := log.Ctx(ctx).With(zap.String("channel", channelName))
+	if err := merr.CheckHealthy(s.GetStateCode()); err != nil {
+		return err
+	}
+	report, err := s.detectOrphanChannels(ctx)
+	if err != nil {
+		return err
+	}
+	s.orphanChannels.set(report)
+
+	var orphan *OrphanChannel
+	for _, ch := range report.Channels {
+		if ch.Channel == channelName {
+			orphan = ch
+		}
+	}
+	if orphan == nil {
+		return merr.WrapErrParameterInvalidMsg("channel %s is not orphaned", channelName)
+	}
+	if orphan.Kind == OrphanChannelUnwatched {
+		return merr.WrapErrParameterInvalidMsg("channel %s belongs to live collection %d, can't be released", channelName, orphan.CollectionID)
+	}
+
+	log.Warn("force release orphaned channel",
+		zap.String("kind", orphan.Kind),
+		zap.Int64("collectionID", orphan.CollectionID),
+		zap.Int64("nodeID", orphan.NodeID),
+		zap.Int("segments", orphan.Segments))
+	if orphan.Segments > 0 || orphan.Kind == OrphanChannelWatched {
+		// the checkpoint is cleaned by garbage collector after the dropped segments collected
+		if err := s.meta.UpdateDropChannelSegmentInfo(channelName, nil); err != nil {
+			return err
+		}
+	} else if err := s.meta.DropChannelCheckpoint(channelName); err != nil {
+		return err
+	}
+	if err := s.channelManager.RemoveChannel(channelName); err != nil {
+		return err
+	}
+	s.segmentManager.DropSegmentsOfChannel(ctx, channelName)
+	if err := s.handler.FinishDropChannel(channelName); err != nil {
+		return err
+	}
+	s.orphanChannels.remove(channelName)
+	log.Info("orphaned channel released")
+	return nil
+}
+
+func (s *Server) startOrphanChannelCheckLoop(ctx context.Context) {
+	interval := Params.DataCoordCfg.OrphanChannelCheckInterval.GetAsDuration(time.Second)
+	if interval <= 0 {
+		return
+	}
+	s.serverLoopWg.Add(1)
+	go func() {
+		defer logutil.LogPanic()
+		defer s.serverLoopWg.Done()
+		ticker := time.NewTicker(interval)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-ctx.Done():
+				log.Info("orphan channel check loop shutdown")
+				return
+			case <-ticker.C:
+				report, err := s.detectOrphanChannels(ctx)
+				if err != nil {
+					log.Warn("failed to detect orphaned channels", zap.Error(err))
+					continue
+				}
+				s.orphanChannels.set(report)
+				for _, orphan := range report.Channels {
+					log.Warn("orphaned channel detected",
+						zap.String("channel", orphan.Channel),
+						zap.String("kind", orphan.Kind),
+						zap.Int64("collectionID", orphan.CollectionID),
+						zap.Int64("nodeID", orphan.NodeID),
+						zap.Int("segments", orphan.Segments))
+				}
+			}
+		}
+	}()
+}
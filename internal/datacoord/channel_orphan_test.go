@@ -0,0 +1,150 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package datacoord
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	management "github.com/milvus-io/milvus/internal/http"
+	"github.com/milvus-io/milvus/internal/proto/datapb"
+	"github.com/milvus-io/milvus/pkg/util/merr"
+)
+
+func Test_findOrphanChannels(t *testing.T) {
+	snapshot := &channelSnapshot{
+		watched: map[string]*channel{
+			"ch-live":    {Name: "ch-live", CollectionID: 1},
+			"ch-dropped": {Name: "ch-dropped", CollectionID: 2},
+			"ch-buffer":  {Name: "ch-buffer", CollectionID: 3},
+		},
+		watchers: map[string]int64{
+			"ch-live":    10,
+			"ch-dropped": 11,
+			"ch-buffer":  bufferID,
+		},
+		checkpoints: []string{"ch-live", "ch-dropped", "ch-checkpoint"},
+	}
+	live := map[string]int64{
+		"ch-live":      1,
+		"ch-unwatched": 4,
+	}
+	segments := map[string][]*SegmentInfo{
+		"ch-dropped":    {NewSegmentInfo(&datapb.SegmentInfo{ID: 100, CollectionID: 2})},
+		"ch-checkpoint": {NewSegmentInfo(&datapb.SegmentInfo{ID: 101, CollectionID: 5})},
+	}
+
+	orphans := findOrphanChannels(snapshot, live, func(channel string) []*SegmentInfo {
+		return segments[channel]
+	})
+	assert.Equal(t, []*OrphanChannel{
+		{Channel: "ch-buffer", Kind: OrphanChannelWatched, CollectionID: 3},
+		{Channel: "ch-checkpoint", Kind: OrphanChannelCheckpoint, CollectionID: 5, Segments: 1},
+		{Channel: "ch-dropped", Kind: OrphanChannelWatched, CollectionID: 2, NodeID: 11, Segments: 1},
+		{Channel: "ch-unwatched", Kind: OrphanChannelUnwatched, CollectionID: 4},
+	}, orphans)
+}
+
+func Test_orphanChannelChecker(t *testing.T) {
+	checker := &orphanChannelChecker{}
+	assert.Nil(t, checker.get())
+	checker.remove("ch-1")
+
+	checker.set(&OrphanChannelReport{Channels: []*OrphanChannel{
+		{Channel: "ch-1", Kind: OrphanChannelWatched},
+		{Channel: "ch-2", Kind: OrphanChannelCheckpoint},
+	}})
+	checker.remove("ch-1")
+	require.Len(t, checker.get().Channels, 1)
+	assert.Equal(t, "ch-2", checker.get().Channels[0].Channel)
+}
+
+type mockOrphanChannelOperator struct {
+	orphans  []*OrphanChannel
+	released []string
+}
+
+func (m *mockOrphanChannelOperator) GetOrphanChannels(ctx context.Context, refresh bool) (*OrphanChannelReport, error) {
+	return &OrphanChannelReport{Channels: m.orphans}, nil
+}
+
+func (m *mockOrphanChannelOperator) ForceReleaseOrphanChannel(ctx context.Context, channelName string) error {
+	for i, orphan := range m.orphans {
+		if orphan.Channel == channelName {
+			m.orphans = append(m.orphans[:i], m.orphans[i+1:]...)
+			m.released = append(m.released, channelName)
+			return nil
+		}
+	}
+	return merr.WrapErrParameterInvalidMsg("channel %s is not orphaned", channelName)
+}
+
+func Test_orphanChannelHandler(t *testing.T) {
+	// the handler serves a standalone component, the one of the datacoord started by other tests is restored after
+	defer func(c *management.Component[orphanChannelOperator]) { orphanChannelComponent = c }(orphanChannelComponent)
+	orphanChannelComponent = management.NewComponent[orphanChannelOperator]("datacoord")
+
+	t.Run("not started", func(t *testing.T) {
+		w := httptest.NewRecorder()
+		orphanChannelHandler(w, httptest.NewRequest(http.MethodGet, "/datacoord/channels/orphan", nil))
+		assert.Equal(t, http.StatusServiceUnavailable, w.Code)
+	})
+
+	op := &mockOrphanChannelOperator{orphans: []*OrphanChannel{
+		{Channel: "ch-1", Kind: OrphanChannelWatched, CollectionID: 1, NodeID: 10},
+	}}
+	orphanChannelComponent.Serve(op)
+
+	t.Run("list", func(t *testing.T) {
+		w := httptest.NewRecorder()
+		orphanChannelHandler(w, httptest.NewRequest(http.MethodGet, "/datacoord/channels/orphan?refresh=true", nil))
+		assert.Equal(t, http.StatusOK, w.Code)
+		report := &OrphanChannelReport{}
+		require.NoError(t, json.Unmarshal(w.Body.Bytes(), report))
+		require.Len(t, report.Channels, 1)
+		assert.Equal(t, "ch-1", report.Channels[0].Channel)
+
+		w = httptest.NewRecorder()
+		orphanChannelHandler(w, httptest.NewRequest(http.MethodGet, "/datacoord/channels/orphan?refresh=yes", nil))
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+	})
+
+	t.Run("release", func(t *testing.T) {
+		w := httptest.NewRecorder()
+		orphanChannelHandler(w, httptest.NewRequest(http.MethodDelete, "/datacoord/channels/orphan?channel=ch-1", nil))
+		assert.Equal(t, http.StatusOK, w.Code)
+		assert.Equal(t, []string{"ch-1"}, op.released)
+
+		w = httptest.NewRecorder()
+		orphanChannelHandler(w, httptest.NewRequest(http.MethodDelete, "/datacoord/channels/orphan?channel=ch-1", nil))
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+
+		w = httptest.NewRecorder()
+		orphanChannelHandler(w, httptest.NewRequest(http.MethodDelete, "/datacoord/channels/orphan", nil))
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+
+		w = httptest.NewRecorder()
+		orphanChannelHandler(w, httptest.NewRequest(http.MethodPost, "/datacoord/channels/orphan", nil))
+		assert.Equal(t, http.StatusMethodNotAllowed, w.Code)
+	})
+}
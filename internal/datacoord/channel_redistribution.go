@@ -0,0 +1,143 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package datacoord
+
+import (
+	"context"
+	"fmt"
+	"sort"
+
+	"go.uber.org/zap"
+
+	"github.com/milvus-io/milvus-proto/go-api/v2/commonpb"
+	"github.com/milvus-io/milvus/pkg/log"
+	"github.com/milvus-io/milvus/pkg/util/merr"
+	"github.com/milvus-io/milvus/pkg/util/typeutil"
+)
+
+// channelRedistributionPlan moves the flushed segments of the removed channels to the remaining channels,
+// so that the data stays queryable after the removed channels unwatched.
+type channelRedistributionPlan struct {
+	removed []string
+	// target channel -> segments moved to it
+	moves map[string][]UniqueID
+}
+
+// newChannelRedistributionPlan assigns the segments of removed channels to the remaining channel with least rows one by one,
+// returns error if any removed channel is not drained, which means it still has segments not flushed.
+func newChannelRedistributionPlan(m *meta, removed []string, remaining []string) (*channelRedistributionPlan, error) {
+	if len(remaining) == 0 {
+		return nil, merr.WrapErrParameterInvalidMsg("no channel remains to redistribute the segments")
+	}
+
+	rows := make(map[string]int64, len(remaining))
+	for _, channel := range remaining {
+		rows[channel] = 0
+		for _, segment := range m.GetSegmentsByChannel(channel) {
+			rows[channel] += segment.GetNumOfRows()
+		}
+	}
+
+	segments := make([]*SegmentInfo, 0)
+	for _, channel := range removed {
+		for _, segment := range m.GetSegmentsByChannel(channel) {
+			if segment.GetState() != commonpb.SegmentState_Flushed || segment.isCompacting || segment.GetIsImporting() {
+				return nil, merr.WrapErrChannelNotAvailable(channel,
+					fmt.Sprintf("channel not drained, segment %d is %s, flush the collection before removing the channel", segment.GetID(), segment.GetState().String()))
+			}
+			segments = append(segments, segment)
+		}
+	}
+	// place the large segments first for better balance
+	sort.Slice(segments, func(i, j int) bool {
+		if segments[i].GetNumOfRows() == segments[j].GetNumOfRows() {
+			return segments[i].GetID() < segments[j].GetID()
+		}
+		return segments[i].GetNumOfRows() > segments[j].GetNumOfRows()
+	})
+
+	plan := &channelRedistributionPlan{
+		removed: removed,
+		moves:   make(map[string][]UniqueID),
+	}
+	for _, segment := range segments {
+		target := remaining[0]
+		for _, channel := range remaining[1:] {
+			if rows[channel] < rows[target] {
+				target = channel
+			}
+		}
+		rows[target] += segment.GetNumOfRows()
+		plan.moves[target] = append(plan.moves[target], segment.GetID())
+	}
+	return plan, nil
+}
+
+// reconcileChannels aligns the watched channels of the collection with the virtual channels recorded by RootCoord,
+// the segments of the removed channels are redistributed before the channels unwatched.
+func (s *Server) reconcileChannels(ctx context.Context, collectionID UniqueID) error {
+	log := log.Ctx(ctx).With(zap.Int64("collectionID", collectionID))
+	resp, err := s.broker.DescribeCollectionInternal(ctx, collectionID)
+	if err != nil {
+		return err
+	}
+	expected := typeutil.NewSet(resp.GetVirtualChannelNames()...)
+	removed := make([]string, 0)
+	for _, ch := range s.channelManager.GetChannelsByCollectionID(collectionID) {
+		if !expected.Contain(ch.Name) {
+			removed = append(removed, ch.Name)
+		}
+	}
+	if len(removed) == 0 {
+		return nil
+	}
+
+	plan, err := newChannelRedistributionPlan(s.meta, removed, resp.GetVirtualChannelNames())
+	if err != nil {
+		log.Warn("failed to plan the channel redistribution", zap.Strings("removed", removed), zap.Error(err))
+		return err
+	}
+	for target, segmentIDs := range plan.moves {
+		if err := s.meta.UpdateSegmentsChannel(segmentIDs, target); err != nil {
+			return err
+		}
+		log.Info("segments redistributed", zap.String("channel", target), zap.Int64s("segmentIDs", segmentIDs))
+	}
+
+	// DataNodes re-subscribe the target channels to recover the moved segments
+	for target := range plan.moves {
+		nodeID, err := s.channelManager.FindWatcher(target)
+		if err != nil {
+			// the channel is not watched by any DataNode yet, it recovers the segments once watched
+			continue
+		}
+		if err := s.channelManager.Release(nodeID, target); err != nil {
+			log.Warn("failed to release channel for re-subscription", zap.String("channel", target), zap.Error(err))
+		}
+	}
+
+	for _, channel := range removed {
+		if err := s.channelManager.RemoveChannel(channel); err != nil {
+			return err
+		}
+		if err := s.meta.DropChannelCheckpoint(channel); err != nil {
+			log.Warn("failed to drop checkpoint of removed channel", zap.String("channel", channel), zap.Error(err))
+		}
+		log.Info("channel removed from collection", zap.String("channel", channel))
+	}
+	return nil
+}
@@ -0,0 +1,77 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package datacoord
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/milvus-io/milvus-proto/go-api/v2/commonpb"
+	"github.com/milvus-io/milvus/internal/proto/datapb"
+)
+
+func addChannelTestSegment(t *testing.T, m *meta, id int64, channel string, rows int64, state commonpb.SegmentState) {
+	err := m.AddSegment(context.TODO(), NewSegmentInfo(&datapb.SegmentInfo{
+		ID:            id,
+		CollectionID:  1,
+		PartitionID:   2,
+		InsertChannel: channel,
+		NumOfRows:     rows,
+		State:         state,
+	}))
+	require.NoError(t, err)
+}
+
+func TestChannelRedistributionPlan(t *testing.T) {
+	m, err := newMemoryMeta()
+	require.NoError(t, err)
+
+	addChannelTestSegment(t, m, 1, "ch0", 100, commonpb.SegmentState_Flushed)
+	addChannelTestSegment(t, m, 2, "ch1", 10, commonpb.SegmentState_Flushed)
+	addChannelTestSegment(t, m, 3, "ch2", 80, commonpb.SegmentState_Flushed)
+	addChannelTestSegment(t, m, 4, "ch2", 50, commonpb.SegmentState_Flushed)
+	addChannelTestSegment(t, m, 5, "ch2", 20, commonpb.SegmentState_Flushed)
+	addChannelTestSegment(t, m, 6, "ch2", 0, commonpb.SegmentState_Dropped)
+
+	plan, err := newChannelRedistributionPlan(m, []string{"ch2"}, []string{"ch0", "ch1"})
+	require.NoError(t, err)
+	// 80 -> ch1 (10), 50 -> ch1 (90), then 20 -> ch0 (100) since ch1 has 140 rows
+	assert.ElementsMatch(t, []int64{3, 4}, plan.moves["ch1"])
+	assert.ElementsMatch(t, []int64{5}, plan.moves["ch0"])
+
+	for channel, segmentIDs := range plan.moves {
+		assert.NoError(t, m.UpdateSegmentsChannel(segmentIDs, channel))
+	}
+	assert.Empty(t, m.GetSegmentsByChannel("ch2"))
+	assert.Len(t, m.GetSegmentsByChannel("ch1"), 3)
+	assert.Equal(t, "ch0", m.GetSegment(5).GetInsertChannel())
+
+	t.Run("not drained", func(t *testing.T) {
+		addChannelTestSegment(t, m, 7, "ch3", 10, commonpb.SegmentState_Growing)
+		_, err := newChannelRedistributionPlan(m, []string{"ch3"}, []string{"ch0"})
+		assert.Error(t, err)
+		assert.Error(t, m.UpdateSegmentsChannel([]int64{7}, "ch0"))
+	})
+
+	t.Run("no channel remains", func(t *testing.T) {
+		_, err := newChannelRedistributionPlan(m, []string{"ch0"}, nil)
+		assert.Error(t, err)
+	})
+}
@@ -53,20 +53,30 @@ func (c *Cluster) Startup(ctx context.Context, nodes []*NodeInfo) error {
 	}
 	currs := make([]int64, 0, len(nodes))
 	for _, node := range nodes {
+		if node.CompactionDedicated {
+			continue
+		}
 		currs = append(currs, node.NodeID)
 	}
 	return c.channelManager.Startup(ctx, currs)
 }
 
-// Register registers a new node in cluster
+// Register registers a new node in cluster,
+// the nodes dedicated to compaction are not assigned any channel.
 func (c *Cluster) Register(node *NodeInfo) error {
 	c.sessionManager.AddSession(node)
+	if node.CompactionDedicated {
+		return nil
+	}
 	return c.channelManager.AddNode(node.NodeID)
 }
 
 // UnRegister removes a node from cluster
 func (c *Cluster) UnRegister(node *NodeInfo) error {
 	c.sessionManager.DeleteSession(node)
+	if node.CompactionDedicated {
+		return nil
+	}
 	return c.channelManager.DeleteNode(node.NodeID)
 }
 
@@ -19,17 +19,25 @@ package datacoord
 import (
 	"context"
 	"fmt"
+	"sort"
+	"strconv"
 	"sync"
 	"time"
 
 	"github.com/cockroachdb/errors"
+	"github.com/golang/protobuf/proto"
 	"go.uber.org/zap"
 
 	"github.com/milvus-io/milvus-proto/go-api/v2/commonpb"
+	"github.com/milvus-io/milvus-proto/go-api/v2/schemapb"
 	"github.com/milvus-io/milvus/internal/proto/datapb"
+	"github.com/milvus-io/milvus/pkg/eventlog"
 	"github.com/milvus-io/milvus/pkg/log"
 	"github.com/milvus-io/milvus/pkg/metrics"
+	"github.com/milvus-io/milvus/pkg/util/merr"
+	"github.com/milvus-io/milvus/pkg/util/paramtable"
 	"github.com/milvus-io/milvus/pkg/util/tsoutil"
+	"github.com/milvus-io/milvus/pkg/util/typeutil"
 )
 
 // TODO this num should be determined by resources of datanode, for now, we set to a fixed value for simple
@@ -51,6 +59,8 @@ type compactionPlanContext interface {
 	isFull() bool
 	// get compaction tasks by signal id
 	getCompactionTasksBySignalID(signalID int64) []*compactionTask
+	// cancelCompaction stops the pipelining or executing plan and releases the compacting segments
+	cancelCompaction(planID int64) error
 }
 
 type compactionTaskState int8
@@ -61,8 +71,35 @@ const (
 	completed
 	failed
 	timeout
+	cancelled
+	// verifying is the state of the plan executed whose result is being verified
+	verifying
 )
 
+func (s compactionTaskState) String() string {
+	switch s {
+	case executing:
+		return "executing"
+	case pipelining:
+		return "pipelining"
+	case completed:
+		return "completed"
+	case failed:
+		return "failed"
+	case timeout:
+		return "timeout"
+	case cancelled:
+		return "cancelled"
+	case verifying:
+		return "verifying"
+	default:
+		return "unknown"
+	}
+}
+
+// compactionVerifyTimeout is the timeout to verify the result of a compaction plan.
+const compactionVerifyTimeout = 10 * time.Minute
+
 var (
 	errChannelNotWatched = errors.New("channel is not watched")
 	errChannelInBuffer   = errors.New("channel is in buffer")
@@ -73,15 +110,22 @@ type compactionTask struct {
 	plan        *datapb.CompactionPlan
 	state       compactionTaskState
 	dataNodeID  int64
-	result      *datapb.CompactionResult
+	// channelNodeID is the DataNode watching the channel if the plan is executed by a dedicated compaction DataNode,
+	// otherwise it's zero since the plan is executed by the DataNode watching the channel
+	channelNodeID int64
+	result        *datapb.CompactionResult
+	// retryTimes is the times the plan re-executed for the result failed to pass the verification
+	retryTimes int
 }
 
 func (t *compactionTask) shadowClone(opts ...compactionTaskOpt) *compactionTask {
 	task := &compactionTask{
-		triggerInfo: t.triggerInfo,
-		plan:        t.plan,
-		state:       t.state,
-		dataNodeID:  t.dataNodeID,
+		triggerInfo:   t.triggerInfo,
+		plan:          t.plan,
+		state:         t.state,
+		dataNodeID:    t.dataNodeID,
+		channelNodeID: t.channelNodeID,
+		retryTimes:    t.retryTimes,
 	}
 	for _, opt := range opts {
 		opt(task)
@@ -89,6 +133,14 @@ func (t *compactionTask) shadowClone(opts ...compactionTaskOpt) *compactionTask
 	return task
 }
 
+// syncNodeID returns the DataNode watching the channel, which the compaction result is synced with.
+func (t *compactionTask) syncNodeID() int64 {
+	if t.channelNodeID != 0 {
+		return t.channelNodeID
+	}
+	return t.dataNodeID
+}
+
 var _ compactionPlanContext = (*compactionPlanHandler)(nil)
 
 type compactionPlanHandler struct {
@@ -163,14 +215,19 @@ func (c *compactionPlanHandler) updateTask(planID int64, opts ...compactionTaskO
 
 // execCompactionPlan start to execute plan and return immediately
 func (c *compactionPlanHandler) execCompactionPlan(signal *compactionSignal, plan *datapb.CompactionPlan) error {
+	return c.enqueuePlan(signal, plan)
+}
+
+func (c *compactionPlanHandler) enqueuePlan(signal *compactionSignal, plan *datapb.CompactionPlan, opts ...compactionTaskOpt) error {
 	c.mu.Lock()
 	defer c.mu.Unlock()
 
-	nodeID, err := c.chManager.FindWatcher(plan.GetChannel())
+	channelNodeID, err := c.chManager.FindWatcher(plan.GetChannel())
 	if err != nil {
 		log.Error("failed to find watcher", zap.Int64("planID", plan.GetPlanID()), zap.Error(err))
 		return err
 	}
+	nodeID := c.pickExecutor(plan, channelNodeID)
 
 	log := log.With(zap.Int64("planID", plan.GetPlanID()), zap.Int64("nodeID", nodeID))
 	c.setSegmentsCompacting(plan, true)
@@ -181,23 +238,44 @@ func (c *compactionPlanHandler) execCompactionPlan(signal *compactionSignal, pla
 		state:       pipelining,
 		dataNodeID:  nodeID,
 	}
+	if nodeID != channelNodeID {
+		task.channelNodeID = channelNodeID
+		log.Info("compaction plan scheduled onto dedicated node", zap.Int64("channelNodeID", channelNodeID))
+	}
+	for _, opt := range opts {
+		opt(task)
+	}
 	c.plans[plan.PlanID] = task
 	c.executingTaskNum++
 
 	go func() {
 		log.Info("acquire queue")
 		c.acquireQueue(nodeID)
+		if c.getCompaction(plan.PlanID).state == cancelled {
+			log.Info("compaction plan cancelled before executing")
+			c.releaseQueue(nodeID)
+			return
+		}
 
 		ts, err := c.allocator.allocTimestamp(context.TODO())
 		if err != nil {
 			log.Warn("Alloc start time for CompactionPlan failed", zap.Error(err))
 			// update plan ts to TIMEOUT ts
-			c.updateTask(plan.PlanID, setState(executing), setStartTime(tsTimeout))
+			if !c.markExecuting(plan.PlanID, setStartTime(tsTimeout)) {
+				c.releaseQueue(nodeID)
+			}
 			return
 		}
 		c.updateTask(plan.PlanID, setStartTime(ts))
 		err = c.sessions.Compaction(nodeID, plan)
-		c.updateTask(plan.PlanID, setState(executing))
+		if !c.markExecuting(plan.PlanID) {
+			log.Info("compaction plan cancelled while submitting")
+			c.releaseQueue(nodeID)
+			if err == nil {
+				c.abandonOnNode(nodeID, plan.PlanID)
+			}
+			return
+		}
 		if err != nil {
 			log.Warn("try to Compaction but DataNode rejected", zap.Error(err))
 			// do nothing here, prevent double release, see issue#21014
@@ -209,6 +287,87 @@ func (c *compactionPlanHandler) execCompactionPlan(signal *compactionSignal, pla
 	return nil
 }
 
+// markExecuting moves the pipelining task to executing, returns false if the task has been cancelled meanwhile.
+func (c *compactionPlanHandler) markExecuting(planID int64, opts ...compactionTaskOpt) bool {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	task := c.plans[planID]
+	if task.state == cancelled {
+		return false
+	}
+	c.plans[planID] = task.shadowClone(append(opts, setState(executing))...)
+	return true
+}
+
+// cancelCompaction stops the pipelining or executing plan, the segments compacted from are released to be picked again.
+// The plan submitted to the DataNode is stopped and its result, if any, is discarded.
+func (c *compactionPlanHandler) cancelCompaction(planID int64) error {
+	c.mu.Lock()
+	task, ok := c.plans[planID]
+	if !ok {
+		c.mu.Unlock()
+		return merr.WrapErrParameterInvalidMsg("compaction plan %d not found", planID)
+	}
+	state := task.state
+	if state != pipelining && state != executing && state != timeout && state != verifying {
+		c.mu.Unlock()
+		return merr.WrapErrParameterInvalidMsg("compaction plan %d is %s, not running", planID, state)
+	}
+	c.plans[planID] = task.shadowClone(setState(cancelled))
+	c.setSegmentsCompacting(task.plan, false)
+	c.executingTaskNum--
+	// the pipelining plan releases the queue by itself once it sees the cancellation
+	if state != pipelining {
+		c.releaseQueue(task.dataNodeID)
+	}
+	c.mu.Unlock()
+
+	log.Info("compaction plan cancelled", zap.Int64("planID", planID), zap.Int64("nodeID", task.dataNodeID), zap.Stringer("state", state))
+	if state != pipelining {
+		c.abandonOnNode(task.dataNodeID, planID)
+	}
+	return nil
+}
+
+// abandonOnNode notifies the DataNode to stop the plan and discard its result.
+func (c *compactionPlanHandler) abandonOnNode(nodeID int64, planID int64) {
+	req := &datapb.SyncSegmentsRequest{
+		PlanID:    planID,
+		Abandoned: true,
+	}
+	if err := c.sessions.SyncSegments(nodeID, req); err != nil {
+		log.Warn("fail to notify node to abandon the compaction plan", zap.Int64("planID", planID), zap.Int64("nodeID", nodeID), zap.Error(err))
+	}
+}
+
+// pickExecutor picks the DataNode to execute the plan. The dedicated compaction DataNode with the fewest tasks is preferred
+// for the mix compactions, while the level zero compactions relying on the channel meta are executed by the channel watcher.
+// not threadsafe, only can be used internally
+func (c *compactionPlanHandler) pickExecutor(plan *datapb.CompactionPlan, channelNodeID int64) int64 {
+	if plan.GetType() == datapb.CompactionType_Level0DeleteCompaction ||
+		!Params.DataCoordCfg.CompactionPreferDedicatedNode.GetAsBool() {
+		return channelNodeID
+	}
+	dedicated := c.sessions.getCompactionDedicatedNodeIDs()
+	if len(dedicated) == 0 {
+		return channelNodeID
+	}
+
+	taskNum := make(map[int64]int)
+	for _, task := range c.plans {
+		if task.state == pipelining || task.state == executing || task.state == timeout {
+			taskNum[task.dataNodeID]++
+		}
+	}
+	sort.Slice(dedicated, func(i, j int) bool {
+		if taskNum[dedicated[i]] != taskNum[dedicated[j]] {
+			return taskNum[dedicated[i]] < taskNum[dedicated[j]]
+		}
+		return dedicated[i] < dedicated[j]
+	})
+	return dedicated[0]
+}
+
 func (c *compactionPlanHandler) setSegmentsCompacting(plan *datapb.CompactionPlan, compacting bool) {
 	for _, segmentBinlogs := range plan.GetSegmentBinlogs() {
 		c.meta.SetSegmentCompacting(segmentBinlogs.GetSegmentID(), compacting)
@@ -227,12 +386,72 @@ func (c *compactionPlanHandler) completeCompaction(result *datapb.CompactionResu
 		return fmt.Errorf("plan %d's state is %v", planID, c.plans[planID].state)
 	}
 
+	plan := c.plans[planID].plan
+	if (plan.GetType() == datapb.CompactionType_MergeCompaction || plan.GetType() == datapb.CompactionType_MixCompaction) &&
+		Params.DataCoordCfg.CompactionVerificationEnabled.GetAsBool() {
+		// the result is verified in background, since it reads the result binlogs from storage
+		task := c.plans[planID].shadowClone(setState(verifying), setResult(result))
+		c.plans[planID] = task
+		go c.verifyCompaction(task, result)
+		return nil
+	}
+	return c.applyCompactionResult(planID, result)
+}
+
+// verifyCompaction verifies the result of compaction without holding the lock, then applies or abandons the result.
+func (c *compactionPlanHandler) verifyCompaction(task *compactionTask, result *datapb.CompactionResult) {
+	plan := task.plan
+	log := log.With(zap.Int64("planID", plan.GetPlanID()), zap.Int64("resultSegmentID", result.GetSegmentID()))
+	var schema *schemapb.CollectionSchema
+	if collection := c.meta.GetCollection(c.getPlanCollectionID(plan)); collection != nil {
+		schema = collection.Schema
+	}
+	inputs := make([]*SegmentInfo, 0, len(plan.GetSegmentBinlogs()))
+	for _, segmentBinlogs := range plan.GetSegmentBinlogs() {
+		if segment := c.meta.GetSegment(segmentBinlogs.GetSegmentID()); segment != nil {
+			inputs = append(inputs, segment)
+		}
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), compactionVerifyTimeout)
+	defer cancel()
+	err := verifyCompactionResult(ctx, c.meta.chunkManager, schema, inputs, result)
+	if err != nil {
+		metrics.DataCoordCompactionVerifyCount.WithLabelValues(metrics.FailLabel).Inc()
+	} else {
+		metrics.DataCoordCompactionVerifyCount.WithLabelValues(metrics.SuccessLabel).Inc()
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if current, ok := c.plans[plan.GetPlanID()]; !ok || current.state != verifying {
+		log.Info("compaction plan is not verifying any more, skip the verified result")
+		return
+	}
+	if err != nil {
+		c.abandonCompaction(task, result, err)
+		return
+	}
+	if err := c.applyCompactionResult(plan.GetPlanID(), result); err != nil {
+		// back to executing, the result is verified and applied again once DataNode reports it next time
+		log.Warn("fail to complete compaction", zap.Error(err))
+		c.plans[plan.GetPlanID()] = c.plans[plan.GetPlanID()].shadowClone(setState(executing))
+	}
+}
+
+// applyCompactionResult applies the result of compaction into meta and syncs it with the DataNode.
+// not threadsafe, only can be used internally
+func (c *compactionPlanHandler) applyCompactionResult(planID int64, result *datapb.CompactionResult) error {
 	plan := c.plans[planID].plan
 	switch plan.GetType() {
 	case datapb.CompactionType_MergeCompaction, datapb.CompactionType_MixCompaction:
 		if err := c.handleMergeCompactionResult(plan, result); err != nil {
 			return err
 		}
+	case datapb.CompactionType_Level0DeleteCompaction:
+		if err := c.handleLevelZeroCompactionResult(plan, result); err != nil {
+			return err
+		}
 	default:
 		return errors.New("unknown compaction type")
 	}
@@ -248,9 +467,31 @@ func (c *compactionPlanHandler) completeCompaction(result *datapb.CompactionResu
 	c.releaseQueue(nodeID)
 
 	metrics.DataCoordCompactedSegmentSize.WithLabelValues().Observe(float64(getCompactedSegmentSize(result)))
+	eventlog.Publish(&eventlog.ClusterEvent{
+		EventType:    eventlog.EventCompactionDone,
+		Role:         typeutil.DataCoordRole,
+		NodeID:       paramtable.GetNodeID(),
+		CollectionID: c.getPlanCollectionID(plan),
+		Channel:      plan.GetChannel(),
+		SegmentIDs:   []int64{result.GetSegmentID()},
+		Attrs: map[string]string{
+			"plan_id":   strconv.FormatInt(planID, 10),
+			"type":      plan.GetType().String(),
+			"data_node": strconv.FormatInt(nodeID, 10),
+		},
+	})
 	return nil
 }
 
+func (c *compactionPlanHandler) getPlanCollectionID(plan *datapb.CompactionPlan) UniqueID {
+	for _, binlogs := range plan.GetSegmentBinlogs() {
+		if segment := c.meta.GetSegment(binlogs.GetSegmentID()); segment != nil {
+			return segment.GetCollectionID()
+		}
+	}
+	return 0
+}
+
 func (c *compactionPlanHandler) handleMergeCompactionResult(plan *datapb.CompactionPlan, result *datapb.CompactionResult) error {
 	// Also prepare metric updates.
 	_, modSegments, newSegment, metricMutation, err := c.meta.PrepareCompleteCompactionMutation(plan, result)
@@ -264,7 +505,8 @@ func (c *compactionPlanHandler) handleMergeCompactionResult(plan *datapb.Compact
 		return err
 	}
 
-	nodeID := c.plans[plan.GetPlanID()].dataNodeID
+	task := c.plans[plan.GetPlanID()]
+	nodeID := task.syncNodeID()
 	req := &datapb.SyncSegmentsRequest{
 		PlanID:        plan.PlanID,
 		CompactedTo:   newSegment.GetID(),
@@ -279,6 +521,13 @@ func (c *compactionPlanHandler) handleMergeCompactionResult(plan *datapb.Compact
 			zap.Int64("nodeID", nodeID), zap.Error(err))
 		return err
 	}
+	if nodeID != task.dataNodeID {
+		// release the result kept by the dedicated compaction DataNode
+		if err := c.sessions.SyncSegments(task.dataNodeID, req); err != nil {
+			log.Warn("handleCompactionResult: fail to release the result on the dedicated node",
+				zap.Int64("nodeID", task.dataNodeID), zap.Error(err))
+		}
+	}
 	// Apply metrics after successful meta update.
 	metricMutation.commit()
 
@@ -286,6 +535,76 @@ func (c *compactionPlanHandler) handleMergeCompactionResult(plan *datapb.Compact
 	return nil
 }
 
+// handleLevelZeroCompactionResult applies the deltalogs of the L0 segments to their target segments.
+// No new segment is produced, so there is nothing to sync with the datanode nor to build index for.
+func (c *compactionPlanHandler) handleLevelZeroCompactionResult(plan *datapb.CompactionPlan, result *datapb.CompactionResult) error {
+	log := log.With(zap.Int64("planID", plan.GetPlanID()), zap.String("channel", plan.GetChannel()))
+	if err := c.meta.CompleteLevelZeroCompaction(plan, result); err != nil {
+		log.Warn("fail to complete level zero compaction", zap.Error(err))
+		return err
+	}
+	c.setSegmentsCompacting(plan, false)
+
+	log.Info("handleCompactionResult: success to handle level zero compaction result",
+		zap.Int("targetSegmentNum", len(result.GetLevelZeroSegments())))
+	return nil
+}
+
+// abandonCompaction discards the result failed to pass the verification and keeps the segments compacted from.
+// The result segment is quarantined by never being added into meta, so its binlogs are recycled by garbage collector.
+// The plan is re-queued with a new plan id unless the retry times exceeds the limit.
+// not threadsafe, only can be used internally
+func (c *compactionPlanHandler) abandonCompaction(task *compactionTask, result *datapb.CompactionResult, reason error) {
+	planID := task.plan.GetPlanID()
+	log := log.With(zap.Int64("planID", planID), zap.Int64("nodeID", task.dataNodeID),
+		zap.Int64("resultSegmentID", result.GetSegmentID()), zap.Int("retryTimes", task.retryTimes))
+	log.Warn("compaction result quarantined", zap.Error(reason))
+
+	compactedFrom := make([]int64, 0, len(task.plan.GetSegmentBinlogs()))
+	for _, segmentBinlogs := range task.plan.GetSegmentBinlogs() {
+		compactedFrom = append(compactedFrom, segmentBinlogs.GetSegmentID())
+	}
+	req := &datapb.SyncSegmentsRequest{
+		PlanID:        planID,
+		CompactedTo:   result.GetSegmentID(),
+		CompactedFrom: compactedFrom,
+		Abandoned:     true,
+	}
+	if err := c.sessions.SyncSegments(task.dataNodeID, req); err != nil {
+		log.Warn("fail to notify node to abandon the compaction result", zap.Error(err))
+	}
+
+	c.plans[planID] = task.shadowClone(setState(failed), setResult(result))
+	c.executingTaskNum--
+	c.releaseQueue(task.dataNodeID)
+
+	if task.retryTimes >= Params.DataCoordCfg.CompactionVerificationMaxRetry.GetAsInt() {
+		log.Warn("compaction retry times exceeds the limit, give up")
+		c.setSegmentsCompacting(task.plan, false)
+		return
+	}
+	// segments are kept compacting to prevent being picked by other plans before re-queued
+	go c.retryCompaction(task)
+}
+
+func (c *compactionPlanHandler) retryCompaction(task *compactionTask) {
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	plan := proto.Clone(task.plan).(*datapb.CompactionPlan)
+	planID, err := c.allocator.allocID(ctx)
+	if err == nil {
+		plan.PlanID = planID
+		err = c.enqueuePlan(task.triggerInfo, plan, setRetryTimes(task.retryTimes+1))
+	}
+	if err != nil {
+		log.Warn("fail to re-queue compaction plan", zap.Int64("planID", task.plan.GetPlanID()), zap.Error(err))
+		c.setSegmentsCompacting(task.plan, false)
+		return
+	}
+	log.Info("compaction plan re-queued", zap.Int64("originPlanID", task.plan.GetPlanID()), zap.Int64("planID", planID))
+}
+
 // getCompaction return compaction task. If planId does not exist, return nil.
 func (c *compactionPlanHandler) getCompaction(planID int64) *compactionTask {
 	c.mu.RLock()
@@ -454,6 +773,12 @@ func setResult(result *datapb.CompactionResult) compactionTaskOpt {
 	}
 }
 
+func setRetryTimes(retryTimes int) compactionTaskOpt {
+	return func(task *compactionTask) {
+		task.retryTimes = retryTimes
+	}
+}
+
 // 0.5*min(8, NumCPU/2)
 func calculateParallel() int {
 	// TODO after node memory management enabled, use this config as hard limit
@@ -0,0 +1,191 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package datacoord
+
+import (
+	"math"
+	"time"
+
+	"github.com/samber/lo"
+	"go.uber.org/zap"
+
+	"github.com/milvus-io/milvus/internal/proto/datapb"
+	"github.com/milvus-io/milvus/pkg/common"
+	"github.com/milvus-io/milvus/pkg/log"
+	"github.com/milvus-io/milvus/pkg/util/tsoutil"
+)
+
+// handleLevelZeroSignal generates a LevelZero compaction plan for each channel with L0 segments,
+// the plan applies the deletes buffered in L0 segments to the sealed segments of the same channel.
+// not threadsafe, t.forceMu shall be held by caller
+func (t *compactionTrigger) handleLevelZeroSignal(signal *compactionSignal) {
+	log := log.With(zap.Int64("compactionID", signal.id))
+
+	l0Segments := t.meta.SelectSegments(func(segment *SegmentInfo) bool {
+		return (signal.collectionID == 0 || segment.CollectionID == signal.collectionID) &&
+			segment.GetLevel() == datapb.SegmentLevel_L0 &&
+			isSegmentHealthy(segment) &&
+			isFlush(segment) &&
+			!segment.isCompacting
+	})
+	channels := lo.GroupBy(l0Segments, func(segment *SegmentInfo) string { return segment.GetInsertChannel() })
+
+	for channel, segments := range channels {
+		if !signal.isForce && t.compactionHandler.isFull() {
+			log.Info("compaction handler is full, skip level zero compaction")
+			return
+		}
+
+		coll, err := t.getCollection(segments[0].GetCollectionID())
+		if err != nil {
+			log.Warn("get collection info failed, skip level zero compaction",
+				zap.String("channel", channel), zap.Error(err))
+			continue
+		}
+		if !signal.isForce && !t.isCollectionAutoCompactionEnabled(coll) {
+			continue
+		}
+
+		plan := t.generateLevelZeroPlan(channel, segments, signal.isForce)
+		if plan == nil {
+			continue
+		}
+		if err := t.fillOriginPlan(plan); err != nil {
+			log.Warn("failed to fill level zero compaction plan", zap.String("channel", channel), zap.Error(err))
+			continue
+		}
+		segIDs := fetchSegIDs(plan.GetSegmentBinlogs())
+		if err := t.compactionHandler.execCompactionPlan(signal, plan); err != nil {
+			log.Warn("failed to execute level zero compaction plan",
+				zap.Int64("planID", plan.GetPlanID()),
+				zap.String("channel", channel),
+				zap.Int64s("segmentIDs", segIDs),
+				zap.Error(err))
+			continue
+		}
+		log.Info("level zero compaction triggered",
+			zap.Int64("planID", plan.GetPlanID()),
+			zap.String("channel", channel),
+			zap.Int64s("segmentIDs", segIDs))
+	}
+}
+
+// generateLevelZeroPlan picks the L0 segments whose deletes could be applied now and the sealed segments they apply to.
+// An L0 segment could be compacted only if all the segments holding rows inserted before its deletes are sealed,
+// which means its dml position shall be earlier than the start position of any growing segment in the channel.
+func (t *compactionTrigger) generateLevelZeroPlan(channel string, l0Segments []*SegmentInfo, force bool) *datapb.CompactionPlan {
+	log := log.With(zap.String("channel", channel))
+
+	segments := t.meta.SelectSegments(func(segment *SegmentInfo) bool {
+		return segment.GetInsertChannel() == channel &&
+			segment.GetLevel() != datapb.SegmentLevel_L0 &&
+			isSegmentHealthy(segment)
+	})
+
+	growingStartTs := uint64(math.MaxUint64)
+	for _, segment := range segments {
+		if isFlush(segment) || segment.GetIsImporting() || segment.GetStartPosition() == nil {
+			continue
+		}
+		if ts := segment.GetStartPosition().GetTimestamp(); ts < growingStartTs {
+			growingStartTs = ts
+		}
+	}
+
+	l0Segments = lo.Filter(l0Segments, func(segment *SegmentInfo, _ int) bool {
+		return segment.GetDmlPosition() != nil && segment.GetDmlPosition().GetTimestamp() < growingStartTs
+	})
+	if len(l0Segments) == 0 {
+		return nil
+	}
+
+	var (
+		deltalogNum  int
+		deltalogSize int64
+		maxDeleteTs  uint64
+		minStartTs   = uint64(math.MaxUint64)
+		partitionIDs = make(map[int64]struct{})
+	)
+	for _, segment := range l0Segments {
+		for _, fieldBinlog := range segment.GetDeltalogs() {
+			deltalogNum += len(fieldBinlog.GetBinlogs())
+			for _, binlog := range fieldBinlog.GetBinlogs() {
+				deltalogSize += binlog.GetLogSize()
+			}
+		}
+		if ts := segment.GetDmlPosition().GetTimestamp(); ts > maxDeleteTs {
+			maxDeleteTs = ts
+		}
+		if segment.GetStartPosition() != nil && segment.GetStartPosition().GetTimestamp() < minStartTs {
+			minStartTs = segment.GetStartPosition().GetTimestamp()
+		}
+		partitionIDs[segment.GetPartitionID()] = struct{}{}
+	}
+
+	maxInterval := Params.DataCoordCfg.LevelZeroCompactionTriggerMaxInterval.GetAsDuration(time.Second)
+	expired := minStartTs != math.MaxUint64 && time.Since(tsoutil.PhysicalTime(minStartTs)) > maxInterval
+	if !force && !expired &&
+		deltalogNum < Params.DataCoordCfg.LevelZeroCompactionTriggerDeltalogMinNum.GetAsInt() &&
+		deltalogSize < Params.DataCoordCfg.LevelZeroCompactionTriggerMinSize.GetAsInt64() {
+		return nil
+	}
+
+	_, allPartitions := partitionIDs[common.InvalidPartitionID]
+	targets := lo.Filter(segments, func(segment *SegmentInfo, _ int) bool {
+		_, ok := partitionIDs[segment.GetPartitionID()]
+		return isFlush(segment) &&
+			!segment.GetIsImporting() &&
+			(allPartitions || ok) &&
+			segment.GetStartPosition().GetTimestamp() < maxDeleteTs
+	})
+	if lo.ContainsBy(targets, func(segment *SegmentInfo) bool { return segment.isCompacting }) {
+		log.Info("target segments of level zero compaction are compacting, wait for next round")
+		return nil
+	}
+
+	plan := &datapb.CompactionPlan{
+		Type:    datapb.CompactionType_Level0DeleteCompaction,
+		Channel: channel,
+	}
+	for _, segment := range l0Segments {
+		plan.SegmentBinlogs = append(plan.SegmentBinlogs, &datapb.CompactionSegmentBinlogs{
+			SegmentID:     segment.GetID(),
+			Deltalogs:     segment.GetDeltalogs(),
+			InsertChannel: channel,
+			Level:         datapb.SegmentLevel_L0,
+			PartitionID:   segment.GetPartitionID(),
+		})
+	}
+	for _, segment := range targets {
+		plan.SegmentBinlogs = append(plan.SegmentBinlogs, &datapb.CompactionSegmentBinlogs{
+			SegmentID:           segment.GetID(),
+			Field2StatslogPaths: segment.GetStatslogs(),
+			InsertChannel:       channel,
+			Level:               segment.GetLevel(),
+			PartitionID:         segment.GetPartitionID(),
+		})
+		plan.TotalRows += segment.GetNumOfRows()
+	}
+
+	log.Info("generate level zero compaction plan",
+		zap.Int("l0SegmentNum", len(l0Segments)),
+		zap.Int("targetSegmentNum", len(targets)),
+		zap.Int("deltalogNum", deltalogNum),
+		zap.Int64("deltalogSize", deltalogSize),
+		zap.Bool("expired", expired))
+	return plan
+}
@@ -0,0 +1,201 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package datacoord
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/suite"
+
+	"github.com/milvus-io/milvus-proto/go-api/v2/commonpb"
+	"github.com/milvus-io/milvus-proto/go-api/v2/msgpb"
+	"github.com/milvus-io/milvus/internal/proto/datapb"
+	"github.com/milvus-io/milvus/pkg/common"
+	"github.com/milvus-io/milvus/pkg/util/paramtable"
+	"github.com/milvus-io/milvus/pkg/util/tsoutil"
+)
+
+type LevelZeroCompactionSuite struct {
+	suite.Suite
+
+	meta    *meta
+	spy     *spyCompactionHandler
+	trigger *compactionTrigger
+	now     time.Time
+}
+
+func (s *LevelZeroCompactionSuite) SetupSuite() {
+	paramtable.Init()
+}
+
+func (s *LevelZeroCompactionSuite) SetupTest() {
+	var err error
+	s.meta, err = newMemoryMeta()
+	s.Require().NoError(err)
+	s.spy = &spyCompactionHandler{spyChan: make(chan *datapb.CompactionPlan, 10)}
+	s.trigger = &compactionTrigger{
+		handler:           newMockHandler(),
+		meta:              s.meta,
+		allocator:         newMockAllocator(),
+		compactionHandler: s.spy,
+	}
+	s.now = time.Now()
+}
+
+func (s *LevelZeroCompactionSuite) position(offset time.Duration) *msgpb.MsgPosition {
+	return &msgpb.MsgPosition{
+		ChannelName: "ch-1",
+		Timestamp:   tsoutil.ComposeTSByTime(s.now.Add(offset), 0),
+	}
+}
+
+func (s *LevelZeroCompactionSuite) addSegment(id, partitionID int64, level datapb.SegmentLevel, state commonpb.SegmentState, start, dml *msgpb.MsgPosition, deltalogNum int) {
+	info := &datapb.SegmentInfo{
+		ID:            id,
+		CollectionID:  1,
+		PartitionID:   partitionID,
+		InsertChannel: "ch-1",
+		NumOfRows:     100,
+		State:         state,
+		Level:         level,
+		StartPosition: start,
+		DmlPosition:   dml,
+	}
+	for i := 0; i < deltalogNum; i++ {
+		info.Deltalogs = append(info.Deltalogs, getFieldBinlogPathsWithEntry(0, 10, "deltalog"))
+	}
+	s.Require().NoError(s.meta.AddSegment(context.TODO(), NewSegmentInfo(info)))
+}
+
+func (s *LevelZeroCompactionSuite) TestGeneratePlan() {
+	// sealed segments inserted before and after the deletes
+	s.addSegment(100, 10, datapb.SegmentLevel_Legacy, commonpb.SegmentState_Flushed, s.position(-time.Hour), s.position(-50*time.Minute), 0)
+	s.addSegment(101, 11, datapb.SegmentLevel_L1, commonpb.SegmentState_Flushed, s.position(-time.Hour), s.position(-50*time.Minute), 0)
+	s.addSegment(102, 10, datapb.SegmentLevel_L1, commonpb.SegmentState_Flushed, s.position(-time.Minute), s.position(-time.Second), 0)
+	// L0 segments
+	s.addSegment(200, 10, datapb.SegmentLevel_L0, commonpb.SegmentState_Flushed, s.position(-40*time.Minute), s.position(-30*time.Minute), 5)
+	s.addSegment(201, 10, datapb.SegmentLevel_L0, commonpb.SegmentState_Flushed, s.position(-20*time.Minute), s.position(-10*time.Minute), 5)
+
+	l0Segments := s.meta.SelectSegments(func(segment *SegmentInfo) bool { return segment.GetLevel() == datapb.SegmentLevel_L0 })
+	plan := s.trigger.generateLevelZeroPlan("ch-1", l0Segments, false)
+	s.Require().NotNil(plan)
+	s.Equal(datapb.CompactionType_Level0DeleteCompaction, plan.GetType())
+	s.Equal("ch-1", plan.GetChannel())
+
+	levels := make(map[int64]datapb.SegmentLevel)
+	for _, binlogs := range plan.GetSegmentBinlogs() {
+		levels[binlogs.GetSegmentID()] = binlogs.GetLevel()
+	}
+	s.Equal(map[int64]datapb.SegmentLevel{
+		100: datapb.SegmentLevel_Legacy,
+		200: datapb.SegmentLevel_L0,
+		201: datapb.SegmentLevel_L0,
+	}, levels)
+
+	s.Run("all partitions", func() {
+		s.addSegment(202, common.InvalidPartitionID, datapb.SegmentLevel_L0, commonpb.SegmentState_Flushed, s.position(-20*time.Minute), s.position(-10*time.Minute), 1)
+		l0Segments := s.meta.SelectSegments(func(segment *SegmentInfo) bool { return segment.GetLevel() == datapb.SegmentLevel_L0 })
+		plan := s.trigger.generateLevelZeroPlan("ch-1", l0Segments, false)
+		s.Require().NotNil(plan)
+		s.Len(plan.GetSegmentBinlogs(), 5)
+	})
+}
+
+func (s *LevelZeroCompactionSuite) TestGeneratePlanWaitGrowing() {
+	s.addSegment(100, 10, datapb.SegmentLevel_L1, commonpb.SegmentState_Flushed, s.position(-time.Hour), s.position(-50*time.Minute), 0)
+	// growing segment holding rows inserted before the deletes
+	s.addSegment(101, 10, datapb.SegmentLevel_L1, commonpb.SegmentState_Growing, s.position(-40*time.Minute), nil, 0)
+	s.addSegment(200, 10, datapb.SegmentLevel_L0, commonpb.SegmentState_Flushed, s.position(-30*time.Minute), s.position(-20*time.Minute), 20)
+
+	l0Segments := s.meta.SelectSegments(func(segment *SegmentInfo) bool { return segment.GetLevel() == datapb.SegmentLevel_L0 })
+	s.Nil(s.trigger.generateLevelZeroPlan("ch-1", l0Segments, true))
+}
+
+func (s *LevelZeroCompactionSuite) TestGeneratePlanThreshold() {
+	s.addSegment(100, 10, datapb.SegmentLevel_L1, commonpb.SegmentState_Flushed, s.position(-2*time.Minute), s.position(-time.Minute), 0)
+	s.addSegment(200, 10, datapb.SegmentLevel_L0, commonpb.SegmentState_Flushed, s.position(-time.Minute), s.position(-time.Second), 1)
+
+	l0Segments := s.meta.SelectSegments(func(segment *SegmentInfo) bool { return segment.GetLevel() == datapb.SegmentLevel_L0 })
+	s.Nil(s.trigger.generateLevelZeroPlan("ch-1", l0Segments, false))
+	s.NotNil(s.trigger.generateLevelZeroPlan("ch-1", l0Segments, true))
+
+	s.Run("target compacting", func() {
+		s.meta.SetSegmentCompacting(100, true)
+		defer s.meta.SetSegmentCompacting(100, false)
+		s.Nil(s.trigger.generateLevelZeroPlan("ch-1", l0Segments, true))
+	})
+}
+
+func (s *LevelZeroCompactionSuite) TestHandleLevelZeroSignal() {
+	s.addSegment(100, 10, datapb.SegmentLevel_L1, commonpb.SegmentState_Flushed, s.position(-time.Hour), s.position(-50*time.Minute), 0)
+	s.addSegment(200, 10, datapb.SegmentLevel_L0, commonpb.SegmentState_Flushed, s.position(-40*time.Minute), s.position(-30*time.Minute), 20)
+
+	s.trigger.handleLevelZeroSignal(&compactionSignal{id: 1, isGlobal: true})
+	s.Require().Len(s.spy.spyChan, 1)
+	plan := <-s.spy.spyChan
+	s.NotZero(plan.GetPlanID())
+	s.Len(plan.GetSegmentBinlogs(), 2)
+}
+
+func (s *LevelZeroCompactionSuite) TestCompleteLevelZeroCompaction() {
+	s.addSegment(100, 10, datapb.SegmentLevel_L1, commonpb.SegmentState_Flushed, s.position(-time.Hour), s.position(-50*time.Minute), 1)
+	s.addSegment(200, 10, datapb.SegmentLevel_L0, commonpb.SegmentState_Flushed, s.position(-40*time.Minute), s.position(-30*time.Minute), 2)
+
+	plan := &datapb.CompactionPlan{
+		PlanID: 1,
+		Type:   datapb.CompactionType_Level0DeleteCompaction,
+		SegmentBinlogs: []*datapb.CompactionSegmentBinlogs{
+			{SegmentID: 200, Level: datapb.SegmentLevel_L0},
+			{SegmentID: 100, Level: datapb.SegmentLevel_L1},
+		},
+	}
+	result := &datapb.CompactionResult{
+		PlanID: 1,
+		LevelZeroSegments: []*datapb.CompactionSegmentBinlogs{
+			{SegmentID: 100, Deltalogs: []*datapb.FieldBinlog{getFieldBinlogPathsWithEntry(0, 5, "l0-applied")}},
+		},
+	}
+	s.Require().NoError(s.meta.CompleteLevelZeroCompaction(plan, result))
+
+	target := s.meta.GetSegment(100)
+	s.Equal(commonpb.SegmentState_Flushed, target.GetState())
+	paths := make([]string, 0)
+	for _, fieldBinlog := range target.GetDeltalogs() {
+		for _, binlog := range fieldBinlog.GetBinlogs() {
+			paths = append(paths, binlog.GetLogPath())
+		}
+	}
+	s.ElementsMatch([]string{"deltalog", "l0-applied"}, paths)
+
+	l0 := s.meta.GetSegment(200)
+	s.Equal(commonpb.SegmentState_Dropped, l0.GetState())
+	s.True(l0.GetCompacted())
+
+	s.Run("target not healthy", func() {
+		result := &datapb.CompactionResult{
+			PlanID:            2,
+			LevelZeroSegments: []*datapb.CompactionSegmentBinlogs{{SegmentID: 200}},
+		}
+		s.Error(s.meta.CompleteLevelZeroCompaction(plan, result))
+	})
+}
+
+func TestLevelZeroCompaction(t *testing.T) {
+	suite.Run(t, new(LevelZeroCompactionSuite))
+}
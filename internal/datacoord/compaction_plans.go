@@ -0,0 +1,142 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package datacoord
+
+import (
+	"context"
+	"math"
+	"sort"
+	"time"
+
+	"go.uber.org/zap"
+
+	"github.com/milvus-io/milvus-proto/go-api/v2/commonpb"
+	"github.com/milvus-io/milvus/internal/proto/datapb"
+	"github.com/milvus-io/milvus/pkg/log"
+	"github.com/milvus-io/milvus/pkg/util/merr"
+	"github.com/milvus-io/milvus/pkg/util/tsoutil"
+)
+
+// CompactionPlanInfo is the snapshot of a compaction plan for the operators.
+type CompactionPlanInfo struct {
+	PlanID        int64   `json:"plan_id"`
+	SignalID      int64   `json:"signal_id"`
+	CollectionID  int64   `json:"collection_id"`
+	Channel       string  `json:"channel"`
+	Type          string  `json:"type"`
+	State         string  `json:"state"`
+	InputSegments []int64 `json:"input_segments"`
+	// OutputSegment is the segment compacted to, zero before the plan completed
+	OutputSegment int64 `json:"output_segment,omitempty"`
+	ExecutorNode  int64 `json:"executor_node"`
+	// ChannelNode is the DataNode watching the channel if the plan is executed by a dedicated compaction DataNode
+	ChannelNode int64     `json:"channel_node,omitempty"`
+	StartTime   time.Time `json:"start_time,omitempty"`
+	// Progress is the elapsed fraction of the plan timeout, since the DataNodes don't report the progress of the plans
+	Progress       float64 `json:"progress"`
+	TimeoutSeconds int32   `json:"timeout_seconds"`
+	RetryTimes     int     `json:"retry_times"`
+}
+
+func newCompactionPlanInfo(task *compactionTask, collectionID int64, now time.Time) *CompactionPlanInfo {
+	plan := task.plan
+	info := &CompactionPlanInfo{
+		PlanID:         plan.GetPlanID(),
+		CollectionID:   collectionID,
+		Channel:        plan.GetChannel(),
+		Type:           plan.GetType().String(),
+		State:          task.state.String(),
+		ExecutorNode:   task.dataNodeID,
+		ChannelNode:    task.channelNodeID,
+		TimeoutSeconds: plan.GetTimeoutInSeconds(),
+		RetryTimes:     task.retryTimes,
+	}
+	if task.triggerInfo != nil {
+		info.SignalID = task.triggerInfo.id
+	}
+	for _, segmentBinlogs := range plan.GetSegmentBinlogs() {
+		info.InputSegments = append(info.InputSegments, segmentBinlogs.GetSegmentID())
+	}
+	if task.result != nil && task.state == completed {
+		info.OutputSegment = task.result.GetSegmentID()
+	}
+	if plan.GetStartTime() > tsTimeout {
+		info.StartTime, _ = tsoutil.ParseTS(plan.GetStartTime())
+	}
+	switch task.state {
+	case completed:
+		info.Progress = 1
+	case executing, timeout, verifying:
+		if !info.StartTime.IsZero() && info.TimeoutSeconds > 0 {
+			elapsed := now.Sub(info.StartTime).Seconds() / float64(info.TimeoutSeconds)
+			info.Progress = math.Floor(math.Min(elapsed, 1)*100) / 100
+		}
+	}
+	return info
+}
+
+// ListCompactionPlans returns the compaction plans of the collection, all collections if collectionID is zero.
+// Only the running plans are returned unless all is true, the plans are sorted by plan id.
+func (s *Server) ListCompactionPlans(ctx context.Context, collectionID int64, all bool) ([]*CompactionPlanInfo, error) {
+	if err := merr.CheckHealthy(s.GetStateCode()); err != nil {
+		return nil, err
+	}
+
+	now := time.Now()
+	plans := make([]*CompactionPlanInfo, 0)
+	for _, task := range s.compactionHandler.getCompactionTasksBySignalID(0) {
+		if !all && task.state != pipelining && task.state != executing && task.state != timeout && task.state != verifying {
+			continue
+		}
+		planCollectionID := s.getCompactionPlanCollectionID(task)
+		if collectionID != 0 && planCollectionID != collectionID {
+			continue
+		}
+		plans = append(plans, newCompactionPlanInfo(task, planCollectionID, now))
+	}
+	sort.Slice(plans, func(i, j int) bool {
+		return plans[i].PlanID < plans[j].PlanID
+	})
+	return plans, nil
+}
+
+// CancelCompactionPlan stops the running compaction plan, the segments compacted from are kept and could be compacted again.
+func (s *Server) CancelCompactionPlan(ctx context.Context, req *datapb.CancelCompactionPlanRequest) (*commonpb.Status, error) {
+	log := log.Ctx(ctx).With(zap.Int64("planID", req.GetPlanID()))
+	log.Info("received request to cancel compaction plan")
+	if err := merr.CheckHealthy(s.GetStateCode()); err != nil {
+		return merr.Status(err), nil
+	}
+	if err := s.compactionHandler.cancelCompaction(req.GetPlanID()); err != nil {
+		log.Warn("failed to cancel compaction plan", zap.Error(err))
+		return merr.Status(err), nil
+	}
+	log.Info("compaction plan cancelled")
+	return merr.Success(), nil
+}
+
+func (s *Server) getCompactionPlanCollectionID(task *compactionTask) int64 {
+	if task.triggerInfo != nil && task.triggerInfo.collectionID != 0 {
+		return task.triggerInfo.collectionID
+	}
+	for _, segmentBinlogs := range task.plan.GetSegmentBinlogs() {
+		if segment := s.meta.GetSegment(segmentBinlogs.GetSegmentID()); segment != nil {
+			return segment.GetCollectionID()
+		}
+	}
+	return 0
+}
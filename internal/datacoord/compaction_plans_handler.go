@@ -0,0 +1,81 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package datacoord
+
+import (
+	"context"
+	"net/http"
+	"strconv"
+
+	management "github.com/milvus-io/milvus/internal/http"
+)
+
+// compactionPlanLister is the part of datacoord listing the compaction plans.
+type compactionPlanLister interface {
+	ListCompactionPlans(ctx context.Context, collectionID int64, all bool) ([]*CompactionPlanInfo, error)
+}
+
+var compactionPlanComponent = management.NewComponent[compactionPlanLister]("datacoord")
+
+// registerCompactionPlanHandler exposes the compaction plans through the management http server,
+// the plan is cancelled by the authenticated CancelCompactionPlan rpc rather than the management port.
+func registerCompactionPlanHandler(l compactionPlanLister) {
+	compactionPlanComponent.Serve(l, &management.Handler{
+		Path:        management.DataCoordCompactionPlanRouterPath,
+		HandlerFunc: compactionPlanHTTPHandler,
+	})
+}
+
+// compactionPlanHTTPHandler lists the compaction plans, only the running plans are listed unless all is true.
+//
+//	GET /datacoord/compaction/plans?collection_id=445566778899&all=false
+func compactionPlanHTTPHandler(w http.ResponseWriter, req *http.Request) {
+	if req.Method != http.MethodGet {
+		management.WriteJSON(w, http.StatusMethodNotAllowed, map[string]string{"error": "method not allowed"})
+		return
+	}
+	l, ok := compactionPlanComponent.Get(w)
+	if !ok {
+		return
+	}
+
+	query := req.URL.Query()
+	var collectionID int64
+	if value := query.Get("collection_id"); value != "" {
+		var err error
+		collectionID, err = strconv.ParseInt(value, 10, 64)
+		if err != nil {
+			management.WriteJSON(w, http.StatusBadRequest, map[string]string{"error": "invalid collection_id: " + err.Error()})
+			return
+		}
+	}
+	all := false
+	if value := query.Get("all"); value != "" {
+		var err error
+		all, err = strconv.ParseBool(value)
+		if err != nil {
+			management.WriteJSON(w, http.StatusBadRequest, map[string]string{"error": "invalid all: " + err.Error()})
+			return
+		}
+	}
+	plans, err := l.ListCompactionPlans(req.Context(), collectionID, all)
+	if err != nil {
+		management.WriteError(w, err)
+		return
+	}
+	management.WriteJSON(w, http.StatusOK, plans)
+}
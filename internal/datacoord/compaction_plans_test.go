@@ -0,0 +1,196 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package datacoord
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/milvus-io/milvus-proto/go-api/v2/commonpb"
+	management "github.com/milvus-io/milvus/internal/http"
+	"github.com/milvus-io/milvus/internal/mocks"
+	"github.com/milvus-io/milvus/internal/proto/datapb"
+	"github.com/milvus-io/milvus/pkg/util/merr"
+	"github.com/milvus-io/milvus/pkg/util/tsoutil"
+)
+
+func Test_compactionPlanHandler_cancelCompaction(t *testing.T) {
+	ctx := context.Background()
+	m, err := newMemoryMeta()
+	require.NoError(t, err)
+	for _, segmentID := range []int64{1, 2} {
+		require.NoError(t, m.AddSegment(ctx, NewSegmentInfo(&datapb.SegmentInfo{ID: segmentID, CollectionID: 100, State: commonpb.SegmentState_Flushed})))
+		m.SetSegmentCompacting(segmentID, true)
+	}
+
+	mockDataNode := &mocks.MockDataNodeClient{}
+	mockDataNode.EXPECT().SyncSegments(mock.Anything, mock.MatchedBy(func(req *datapb.SyncSegmentsRequest) bool {
+		return req.GetPlanID() == 1 && req.GetAbandoned()
+	}), mock.Anything).Return(merr.Success(), nil).Once()
+	c := &compactionPlanHandler{
+		plans: map[int64]*compactionTask{
+			1: {plan: &datapb.CompactionPlan{PlanID: 1, SegmentBinlogs: []*datapb.CompactionSegmentBinlogs{{SegmentID: 1}}}, state: executing, dataNodeID: 1},
+			2: {plan: &datapb.CompactionPlan{PlanID: 2, SegmentBinlogs: []*datapb.CompactionSegmentBinlogs{{SegmentID: 2}}}, state: pipelining, dataNodeID: 1},
+			3: {plan: &datapb.CompactionPlan{PlanID: 3}, state: completed, dataNodeID: 1},
+		},
+		sessions: &SessionManager{
+			sessions: struct {
+				sync.RWMutex
+				data map[int64]*Session
+			}{
+				data: map[int64]*Session{
+					1: {client: mockDataNode},
+				},
+			},
+		},
+		meta:             m,
+		executingTaskNum: 2,
+		parallelCh:       map[int64]chan struct{}{1: make(chan struct{}, 2)},
+	}
+	// the queue is acquired by the executing plan only
+	c.parallelCh[1] <- struct{}{}
+
+	require.NoError(t, c.cancelCompaction(1))
+	assert.Equal(t, cancelled, c.getCompaction(1).state)
+	assert.False(t, m.GetSegment(1).isCompacting)
+	assert.Len(t, c.parallelCh[1], 0)
+	assert.Equal(t, 1, c.executingTaskNum)
+	mockDataNode.AssertExpectations(t)
+
+	// the pipelining plan releases the queue by itself
+	require.NoError(t, c.cancelCompaction(2))
+	assert.Equal(t, cancelled, c.getCompaction(2).state)
+	assert.False(t, m.GetSegment(2).isCompacting)
+	assert.Equal(t, 0, c.executingTaskNum)
+	assert.False(t, c.markExecuting(2))
+
+	assert.ErrorIs(t, c.cancelCompaction(1), merr.ErrParameterInvalid)
+	assert.ErrorIs(t, c.cancelCompaction(3), merr.ErrParameterInvalid)
+	assert.ErrorIs(t, c.cancelCompaction(4), merr.ErrParameterInvalid)
+}
+
+func Test_newCompactionPlanInfo(t *testing.T) {
+	now := time.Now()
+	task := &compactionTask{
+		triggerInfo: &compactionSignal{id: 10},
+		plan: &datapb.CompactionPlan{
+			PlanID:           1,
+			Channel:          "ch1",
+			Type:             datapb.CompactionType_MixCompaction,
+			SegmentBinlogs:   []*datapb.CompactionSegmentBinlogs{{SegmentID: 1}, {SegmentID: 2}},
+			StartTime:        tsoutil.ComposeTSByTime(now.Add(-30*time.Second), 0),
+			TimeoutInSeconds: 120,
+		},
+		state:      executing,
+		dataNodeID: 3,
+	}
+
+	info := newCompactionPlanInfo(task, 100, now)
+	assert.EqualValues(t, 10, info.SignalID)
+	assert.EqualValues(t, 100, info.CollectionID)
+	assert.Equal(t, "executing", info.State)
+	assert.Equal(t, []int64{1, 2}, info.InputSegments)
+	assert.EqualValues(t, 0, info.OutputSegment)
+	assert.EqualValues(t, 3, info.ExecutorNode)
+	assert.InDelta(t, 0.25, info.Progress, 0.01)
+
+	task.state = completed
+	task.result = &datapb.CompactionResult{SegmentID: 4}
+	info = newCompactionPlanInfo(task, 100, now)
+	assert.EqualValues(t, 4, info.OutputSegment)
+	assert.EqualValues(t, 1, info.Progress)
+}
+
+func TestServer_CancelCompactionPlan(t *testing.T) {
+	t.Run("not healthy", func(t *testing.T) {
+		s := &Server{}
+		s.stateCode.Store(commonpb.StateCode_Abnormal)
+		status, err := s.CancelCompactionPlan(context.Background(), &datapb.CancelCompactionPlanRequest{PlanID: 1})
+		assert.NoError(t, err)
+		assert.ErrorIs(t, merr.Error(status), merr.ErrServiceNotReady)
+	})
+
+	handler := NewMockCompactionPlanContext(t)
+	handler.EXPECT().cancelCompaction(int64(1)).Return(nil).Once()
+	handler.EXPECT().cancelCompaction(int64(2)).Return(merr.WrapErrParameterInvalidMsg("compaction plan 2 not found")).Once()
+	s := &Server{compactionHandler: handler}
+	s.stateCode.Store(commonpb.StateCode_Healthy)
+
+	status, err := s.CancelCompactionPlan(context.Background(), &datapb.CancelCompactionPlanRequest{PlanID: 1})
+	assert.NoError(t, err)
+	assert.True(t, merr.Ok(status))
+
+	status, err = s.CancelCompactionPlan(context.Background(), &datapb.CancelCompactionPlanRequest{PlanID: 2})
+	assert.NoError(t, err)
+	assert.ErrorIs(t, merr.Error(status), merr.ErrParameterInvalid)
+}
+
+type mockCompactionPlanLister struct{}
+
+func (m *mockCompactionPlanLister) ListCompactionPlans(ctx context.Context, collectionID int64, all bool) ([]*CompactionPlanInfo, error) {
+	plans := []*CompactionPlanInfo{{PlanID: 1, CollectionID: 2, State: "executing"}}
+	if all {
+		plans = append(plans, &CompactionPlanInfo{PlanID: 2, CollectionID: 2, State: "completed"})
+	}
+	return plans, nil
+}
+
+func Test_compactionPlanHTTPHandler(t *testing.T) {
+	// the handler serves a standalone component, the one of the datacoord started by other tests is restored after
+	defer func(c *management.Component[compactionPlanLister]) { compactionPlanComponent = c }(compactionPlanComponent)
+	compactionPlanComponent = management.NewComponent[compactionPlanLister]("datacoord")
+
+	t.Run("not started", func(t *testing.T) {
+		w := httptest.NewRecorder()
+		compactionPlanHTTPHandler(w, httptest.NewRequest(http.MethodGet, "/datacoord/compaction/plans", nil))
+		assert.Equal(t, http.StatusServiceUnavailable, w.Code)
+	})
+
+	compactionPlanComponent.Serve(&mockCompactionPlanLister{})
+
+	t.Run("list", func(t *testing.T) {
+		w := httptest.NewRecorder()
+		compactionPlanHTTPHandler(w, httptest.NewRequest(http.MethodGet, "/datacoord/compaction/plans?collection_id=2&all=true", nil))
+		assert.Equal(t, http.StatusOK, w.Code)
+		plans := make([]*CompactionPlanInfo, 0)
+		require.NoError(t, json.Unmarshal(w.Body.Bytes(), &plans))
+		assert.Len(t, plans, 2)
+	})
+
+	t.Run("bad requests", func(t *testing.T) {
+		w := httptest.NewRecorder()
+		compactionPlanHTTPHandler(w, httptest.NewRequest(http.MethodGet, "/datacoord/compaction/plans?collection_id=abc", nil))
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+
+		w = httptest.NewRecorder()
+		compactionPlanHTTPHandler(w, httptest.NewRequest(http.MethodGet, "/datacoord/compaction/plans?all=abc", nil))
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+
+		w = httptest.NewRecorder()
+		compactionPlanHTTPHandler(w, httptest.NewRequest(http.MethodDelete, "/datacoord/compaction/plans?plan_id=1", nil))
+		assert.Equal(t, http.StatusMethodNotAllowed, w.Code)
+	})
+}
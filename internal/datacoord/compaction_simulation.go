@@ -0,0 +1,218 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package datacoord
+
+import (
+	"context"
+	"time"
+
+	"github.com/milvus-io/milvus/internal/proto/datapb"
+	"github.com/milvus-io/milvus/pkg/util/merr"
+	"github.com/milvus-io/milvus/pkg/util/tsoutil"
+)
+
+// The reasons why a segment is put into a compaction plan.
+const (
+	CompactionReasonForce             = "force"
+	CompactionReasonTooManyStatslogs  = "too many statslogs"
+	CompactionReasonTooManyDeltalogs  = "too many deltalogs"
+	CompactionReasonExpiredData       = "expired data"
+	CompactionReasonDeletedData       = "deleted data"
+	CompactionReasonSmallSegment      = "small segment"
+	CompactionReasonMergeTarget       = "merge target"
+	CompactionSkipReasonAutoDisabled  = "auto compaction disabled"
+	CompactionSkipReasonInvalidConfig = "invalid collection properties"
+)
+
+// SimulatedCompactionSegment is an input segment of the simulated compaction plan.
+type SimulatedCompactionSegment struct {
+	SegmentID   int64  `json:"segment_id"`
+	NumRows     int64  `json:"num_rows"`
+	Size        int64  `json:"size"`
+	DeletedRows int64  `json:"deleted_rows"`
+	Reason      string `json:"reason"`
+}
+
+// SimulatedCompactionPlan is the compaction plan the trigger would generate, the expected output is estimated
+// by excluding the deleted rows, the expired rows are not excluded as they are not known before compaction.
+type SimulatedCompactionPlan struct {
+	CollectionID int64                         `json:"collection_id"`
+	PartitionID  int64                         `json:"partition_id"`
+	Channel      string                        `json:"channel"`
+	Segments     []*SimulatedCompactionSegment `json:"segments"`
+	ExpectedRows int64                         `json:"expected_rows"`
+	ExpectedSize int64                         `json:"expected_size"`
+}
+
+// SkippedCompactionGroup is the channel-partition group skipped by the compaction trigger.
+type SkippedCompactionGroup struct {
+	CollectionID int64  `json:"collection_id"`
+	PartitionID  int64  `json:"partition_id"`
+	Channel      string `json:"channel"`
+	Reason       string `json:"reason"`
+}
+
+// CompactionSimulation is the result of running the compaction trigger against the current segment meta,
+// nothing is executed.
+type CompactionSimulation struct {
+	CollectionID int64                      `json:"collection_id,omitempty"`
+	Force        bool                       `json:"force"`
+	Plans        []*SimulatedCompactionPlan `json:"plans"`
+	Skipped      []*SkippedCompactionGroup  `json:"skipped,omitempty"`
+	// HandlerFull is true if the compaction handler is full now, the plans would wait for the next trigger.
+	HandlerFull   bool      `json:"handler_full"`
+	SimulatedTime time.Time `json:"simulated_time"`
+}
+
+// simulateCompaction runs the same logic as the global compaction signal, but returns the plans instead of
+// executing them. Zero collectionID means all collections.
+func (t *compactionTrigger) simulateCompaction(collectionID int64, force bool) (*CompactionSimulation, error) {
+	t.forceMu.Lock()
+	defer t.forceMu.Unlock()
+
+	now := time.Now()
+	result := &CompactionSimulation{
+		CollectionID:  collectionID,
+		Force:         force,
+		Plans:         make([]*SimulatedCompactionPlan, 0),
+		HandlerFull:   t.compactionHandler.isFull(),
+		SimulatedTime: now,
+	}
+	m := t.meta.GetSegmentsChanPart(func(segment *SegmentInfo) bool {
+		return (collectionID == 0 || segment.CollectionID == collectionID) &&
+			isSegmentHealthy(segment) &&
+			isFlush(segment) &&
+			!segment.isCompacting &&
+			!segment.GetIsImporting() &&
+			segment.GetLevel() != datapb.SegmentLevel_L0
+	})
+	ts := tsoutil.ComposeTSByTime(now, 0)
+
+	for _, group := range m {
+		skip := func(reason string) {
+			result.Skipped = append(result.Skipped, &SkippedCompactionGroup{
+				CollectionID: group.collectionID,
+				PartitionID:  group.partitionID,
+				Channel:      group.channelName,
+				Reason:       reason,
+			})
+		}
+		segments := group.segments
+		if Params.DataCoordCfg.IndexBasedCompaction.GetAsBool() {
+			segments = FilterInIndexedSegments(t.handler, t.meta, segments...)
+		}
+		// the max size of segments may be updated, don't touch the segments in meta
+		clones := make([]*SegmentInfo, 0, len(segments))
+		for _, segment := range segments {
+			clones = append(clones, segment.Clone())
+		}
+		isDiskIndex, err := t.updateSegmentMaxSize(clones)
+		if err != nil {
+			return nil, err
+		}
+
+		coll, err := t.getCollection(group.collectionID)
+		if err != nil {
+			return nil, err
+		}
+		if !force && !t.isCollectionAutoCompactionEnabled(coll) {
+			skip(CompactionSkipReasonAutoDisabled)
+			continue
+		}
+		ct, err := t.getCompactTime(ts, coll)
+		if err != nil {
+			skip(CompactionSkipReasonInvalidConfig)
+			continue
+		}
+
+		id2Segment := make(map[int64]*SegmentInfo, len(clones))
+		for _, segment := range clones {
+			id2Segment[segment.GetID()] = segment
+		}
+		for _, plan := range t.generatePlans(clones, force, isDiskIndex, ct) {
+			result.Plans = append(result.Plans, t.simulatePlan(group, plan, id2Segment, force, isDiskIndex, ct))
+		}
+	}
+	return result, nil
+}
+
+func (t *compactionTrigger) simulatePlan(group *chanPartSegments, plan *datapb.CompactionPlan, id2Segment map[int64]*SegmentInfo,
+	force bool, isDiskIndex bool, ct *compactTime,
+) *SimulatedCompactionPlan {
+	simulated := &SimulatedCompactionPlan{
+		CollectionID: group.collectionID,
+		PartitionID:  group.partitionID,
+		Channel:      plan.GetChannel(),
+		Segments:     make([]*SimulatedCompactionSegment, 0, len(plan.GetSegmentBinlogs())),
+	}
+	var totalRows, totalSize int64
+	for _, binlogs := range plan.GetSegmentBinlogs() {
+		segment, ok := id2Segment[binlogs.GetSegmentID()]
+		if !ok {
+			continue
+		}
+		var deletedRows int64
+		for _, deltaLogs := range segment.GetDeltalogs() {
+			for _, l := range deltaLogs.GetBinlogs() {
+				deletedRows += l.GetEntriesNum()
+			}
+		}
+		if deletedRows > segment.GetNumOfRows() {
+			deletedRows = segment.GetNumOfRows()
+		}
+		reason := CompactionReasonForce
+		if !force {
+			reason = t.singleCompactionReason(segment, isDiskIndex, ct)
+			if reason == "" && t.isSmallSegment(segment) {
+				reason = CompactionReasonSmallSegment
+			} else if reason == "" {
+				reason = CompactionReasonMergeTarget
+			}
+		}
+		size := segment.getSegmentSize()
+		simulated.Segments = append(simulated.Segments, &SimulatedCompactionSegment{
+			SegmentID:   segment.GetID(),
+			NumRows:     segment.GetNumOfRows(),
+			Size:        size,
+			DeletedRows: deletedRows,
+			Reason:      reason,
+		})
+		totalRows += segment.GetNumOfRows()
+		totalSize += size
+		simulated.ExpectedRows += segment.GetNumOfRows() - deletedRows
+	}
+	if totalRows > 0 {
+		simulated.ExpectedSize = int64(float64(totalSize) * float64(simulated.ExpectedRows) / float64(totalRows))
+	}
+	return simulated
+}
+
+// SimulateCompaction returns the compaction plans would be generated for the collection now without executing them,
+// so the compaction configs could be tuned safely. Zero collectionID means all collections.
+func (s *Server) SimulateCompaction(ctx context.Context, collectionID int64, force bool) (*CompactionSimulation, error) {
+	if err := merr.CheckHealthy(s.GetStateCode()); err != nil {
+		return nil, err
+	}
+	if collectionID != 0 {
+		if coll, err := s.handler.GetCollection(ctx, collectionID); err != nil {
+			return nil, err
+		} else if coll == nil {
+			return nil, merr.WrapErrCollectionNotFound(collectionID)
+		}
+	}
+	return s.compactionTrigger.simulateCompaction(collectionID, force)
+}
@@ -0,0 +1,83 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package datacoord
+
+import (
+	"context"
+	"net/http"
+	"strconv"
+
+	management "github.com/milvus-io/milvus/internal/http"
+)
+
+// compactionSimulator is the part of datacoord simulating the compaction trigger.
+type compactionSimulator interface {
+	SimulateCompaction(ctx context.Context, collectionID int64, force bool) (*CompactionSimulation, error)
+}
+
+var compactionSimulationComponent = management.NewComponent[compactionSimulator]("datacoord")
+
+// registerCompactionSimulationHandler exposes the compaction simulation through the management http server,
+// the handler is registered only once and serves the latest started datacoord.
+func registerCompactionSimulationHandler(op compactionSimulator) {
+	compactionSimulationComponent.Serve(op, &management.Handler{
+		Path:        management.DataCoordCompactionSimulationRouterPath,
+		HandlerFunc: compactionSimulationHandler,
+	})
+}
+
+// compactionSimulationHandler returns the compaction plans would be generated now, nothing is executed.
+// All collections are simulated unless collection_id is specified, force simulates the manual compaction.
+//
+//	GET /datacoord/compaction/simulate?collection_id=445566778899&force=false
+func compactionSimulationHandler(w http.ResponseWriter, req *http.Request) {
+	if req.Method != http.MethodGet {
+		management.WriteJSON(w, http.StatusMethodNotAllowed, map[string]string{"error": "method not allowed"})
+		return
+	}
+	op, ok := compactionSimulationComponent.Get(w)
+	if !ok {
+		return
+	}
+
+	query := req.URL.Query()
+	var collectionID int64
+	if value := query.Get("collection_id"); value != "" {
+		var err error
+		collectionID, err = strconv.ParseInt(value, 10, 64)
+		if err != nil {
+			management.WriteJSON(w, http.StatusBadRequest, map[string]string{"error": "invalid collection_id: " + err.Error()})
+			return
+		}
+	}
+	force := false
+	if value := query.Get("force"); value != "" {
+		var err error
+		force, err = strconv.ParseBool(value)
+		if err != nil {
+			management.WriteJSON(w, http.StatusBadRequest, map[string]string{"error": "invalid force: " + err.Error()})
+			return
+		}
+	}
+
+	simulation, err := op.SimulateCompaction(req.Context(), collectionID, force)
+	if err != nil {
+		management.WriteError(w, err)
+		return
+	}
+	management.WriteJSON(w, http.StatusOK, simulation)
+}
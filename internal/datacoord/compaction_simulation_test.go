@@ -0,0 +1,233 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package datacoord
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/milvus-io/milvus-proto/go-api/v2/commonpb"
+	"github.com/milvus-io/milvus-proto/go-api/v2/schemapb"
+	management "github.com/milvus-io/milvus/internal/http"
+	"github.com/milvus-io/milvus/internal/metastore/model"
+	"github.com/milvus-io/milvus/internal/proto/datapb"
+	"github.com/milvus-io/milvus/pkg/common"
+	"github.com/milvus-io/milvus/pkg/util/merr"
+)
+
+type CompactionSimulationSuite struct {
+	suite.Suite
+
+	tr *compactionTrigger
+}
+
+func (s *CompactionSimulationSuite) SetupTest() {
+	vecFieldID := int64(201)
+	segments := make(map[int64]*SegmentInfo)
+	for i := 1; i <= 7; i++ {
+		segID := int64(i)
+		segments[segID] = &SegmentInfo{
+			SegmentInfo: &datapb.SegmentInfo{
+				ID:             segID,
+				CollectionID:   2,
+				PartitionID:    1,
+				LastExpireTime: 100,
+				NumOfRows:      20,
+				MaxRowNum:      110,
+				InsertChannel:  "ch1",
+				State:          commonpb.SegmentState_Flushed,
+				Binlogs: []*datapb.FieldBinlog{
+					{Binlogs: []*datapb.Binlog{{EntriesNum: 20, LogPath: "log1", LogSize: 100}}},
+				},
+			},
+			lastFlushTime: time.Now().Add(-100 * time.Minute),
+			segmentIndexes: map[UniqueID]*model.SegmentIndex{
+				indexID: {
+					SegmentID:    segID,
+					CollectionID: 2,
+					PartitionID:  1,
+					NumRows:      20,
+					IndexID:      indexID,
+					BuildID:      segID,
+					IndexVersion: 1,
+					IndexState:   commonpb.IndexState_Finished,
+				},
+			},
+		}
+	}
+	m := &meta{
+		segments: &SegmentsInfo{segments},
+		collections: map[int64]*collectionInfo{
+			2: {
+				ID: 2,
+				Schema: &schemapb.CollectionSchema{
+					Fields: []*schemapb.FieldSchema{{FieldID: vecFieldID, DataType: schemapb.DataType_FloatVector}},
+				},
+			},
+		},
+		indexes: map[UniqueID]map[UniqueID]*model.Index{
+			2: {
+				indexID: {
+					CollectionID: 2,
+					FieldID:      vecFieldID,
+					IndexID:      indexID,
+					IndexName:    "_default_idx",
+					IndexParams:  []*commonpb.KeyValuePair{{Key: common.IndexTypeKey, Value: "HNSW"}},
+				},
+			},
+		},
+	}
+	s.tr = &compactionTrigger{
+		meta:                         m,
+		handler:                      newMockHandlerWithMeta(m),
+		allocator:                    newMockAllocator(),
+		signals:                      make(chan *compactionSignal, 1),
+		compactionHandler:            &spyCompactionHandler{spyChan: make(chan *datapb.CompactionPlan, 1)},
+		estimateDiskSegmentPolicy:    calBySchemaPolicyWithDiskIndex,
+		estimateNonDiskSegmentPolicy: calBySchemaPolicy,
+		testingOnly:                  true,
+	}
+}
+
+func (s *CompactionSimulationSuite) TestSmallSegments() {
+	simulation, err := s.tr.simulateCompaction(2, false)
+	s.Require().NoError(err)
+	s.False(simulation.HandlerFull)
+	s.Require().NotEmpty(simulation.Plans)
+	for _, plan := range simulation.Plans {
+		s.EqualValues(2, plan.CollectionID)
+		s.Equal("ch1", plan.Channel)
+		for _, segment := range plan.Segments {
+			s.Equal(CompactionReasonSmallSegment, segment.Reason)
+		}
+	}
+	// nothing is executed
+	s.Empty(s.tr.compactionHandler.(*spyCompactionHandler).spyChan)
+	for _, segment := range s.tr.meta.GetAllSegmentsUnsafe() {
+		s.False(segment.isCompacting)
+	}
+}
+
+func (s *CompactionSimulationSuite) TestForceWithDeletedRows() {
+	for segID := int64(3); segID <= 7; segID++ {
+		delete(s.tr.meta.segments.segments, segID)
+	}
+	s.tr.meta.segments.segments[1].Deltalogs = []*datapb.FieldBinlog{
+		{Binlogs: []*datapb.Binlog{{EntriesNum: 10, LogPath: "deltalog1", LogSize: 100}}},
+	}
+	simulation, err := s.tr.simulateCompaction(0, true)
+	s.Require().NoError(err)
+	s.Require().Len(simulation.Plans, 1)
+	plan := simulation.Plans[0]
+	s.Require().Len(plan.Segments, 2)
+	for _, segment := range plan.Segments {
+		s.Equal(CompactionReasonForce, segment.Reason)
+	}
+	s.EqualValues(30, plan.ExpectedRows)
+	s.EqualValues(225, plan.ExpectedSize)
+}
+
+func (s *CompactionSimulationSuite) TestAutoCompactionDisabled() {
+	s.tr.meta.collections[2].Properties = map[string]string{common.CollectionAutoCompactionKey: "false"}
+	simulation, err := s.tr.simulateCompaction(2, false)
+	s.Require().NoError(err)
+	s.Empty(simulation.Plans)
+	s.Require().Len(simulation.Skipped, 1)
+	s.Equal(CompactionSkipReasonAutoDisabled, simulation.Skipped[0].Reason)
+}
+
+func (s *CompactionSimulationSuite) TestOtherCollection() {
+	simulation, err := s.tr.simulateCompaction(3, false)
+	s.Require().NoError(err)
+	s.Empty(simulation.Plans)
+	s.Empty(simulation.Skipped)
+}
+
+func TestCompactionSimulation(t *testing.T) {
+	suite.Run(t, new(CompactionSimulationSuite))
+}
+
+func TestServer_SimulateCompaction(t *testing.T) {
+	s := &Server{}
+	s.stateCode.Store(commonpb.StateCode_Abnormal)
+	_, err := s.SimulateCompaction(context.Background(), 0, false)
+	assert.ErrorIs(t, err, merr.ErrServiceNotReady)
+}
+
+type mockCompactionSimulator struct{}
+
+func (m *mockCompactionSimulator) SimulateCompaction(ctx context.Context, collectionID int64, force bool) (*CompactionSimulation, error) {
+	if collectionID != 2 {
+		return nil, merr.WrapErrCollectionNotFound(collectionID)
+	}
+	return &CompactionSimulation{
+		CollectionID: collectionID,
+		Force:        force,
+		Plans:        []*SimulatedCompactionPlan{{CollectionID: collectionID, Channel: "ch1"}},
+	}, nil
+}
+
+func Test_compactionSimulationHandler(t *testing.T) {
+	// the handler serves a standalone component, the one of the datacoord started by other tests is restored after
+	defer func(c *management.Component[compactionSimulator]) { compactionSimulationComponent = c }(compactionSimulationComponent)
+	compactionSimulationComponent = management.NewComponent[compactionSimulator]("datacoord")
+
+	t.Run("not started", func(t *testing.T) {
+		w := httptest.NewRecorder()
+		compactionSimulationHandler(w, httptest.NewRequest(http.MethodGet, "/datacoord/compaction/simulate", nil))
+		assert.Equal(t, http.StatusServiceUnavailable, w.Code)
+	})
+
+	compactionSimulationComponent.Serve(&mockCompactionSimulator{})
+
+	t.Run("simulate", func(t *testing.T) {
+		w := httptest.NewRecorder()
+		compactionSimulationHandler(w, httptest.NewRequest(http.MethodGet, "/datacoord/compaction/simulate?collection_id=2&force=true", nil))
+		assert.Equal(t, http.StatusOK, w.Code)
+		simulation := &CompactionSimulation{}
+		require.NoError(t, json.Unmarshal(w.Body.Bytes(), simulation))
+		assert.True(t, simulation.Force)
+		require.Len(t, simulation.Plans, 1)
+		assert.Equal(t, "ch1", simulation.Plans[0].Channel)
+	})
+
+	t.Run("bad requests", func(t *testing.T) {
+		w := httptest.NewRecorder()
+		compactionSimulationHandler(w, httptest.NewRequest(http.MethodGet, "/datacoord/compaction/simulate?collection_id=abc", nil))
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+
+		w = httptest.NewRecorder()
+		compactionSimulationHandler(w, httptest.NewRequest(http.MethodGet, "/datacoord/compaction/simulate?collection_id=2&force=yes", nil))
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+
+		w = httptest.NewRecorder()
+		compactionSimulationHandler(w, httptest.NewRequest(http.MethodGet, "/datacoord/compaction/simulate?collection_id=3", nil))
+		assert.Equal(t, http.StatusNotFound, w.Code)
+
+		w = httptest.NewRecorder()
+		compactionSimulationHandler(w, httptest.NewRequest(http.MethodPost, "/datacoord/compaction/simulate", nil))
+		assert.Equal(t, http.StatusMethodNotAllowed, w.Code)
+	})
+}
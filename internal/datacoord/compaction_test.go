@@ -34,6 +34,7 @@ import (
 	"github.com/milvus-io/milvus/internal/metastore/kv/datacoord"
 	"github.com/milvus-io/milvus/internal/mocks"
 	"github.com/milvus-io/milvus/internal/proto/datapb"
+	"github.com/milvus-io/milvus/internal/storage"
 	"github.com/milvus-io/milvus/pkg/log"
 	"github.com/milvus-io/milvus/pkg/util/metautil"
 	"github.com/milvus-io/milvus/pkg/util/paramtable"
@@ -646,6 +647,75 @@ func TestCompactionPlanHandler_completeCompaction(t *testing.T) {
 			assert.True(t, segment.State == commonpb.SegmentState_Dropped)
 		}
 	})
+
+	t.Run("test verify merge compaction result in background", func(t *testing.T) {
+		paramtable.Get().Save(Params.DataCoordCfg.CompactionVerificationEnabled.Key, "true")
+		defer paramtable.Get().Reset(Params.DataCoordCfg.CompactionVerificationEnabled.Key)
+		paramtable.Get().Save(Params.DataCoordCfg.CompactionVerificationMaxRetry.Key, "0")
+		defer paramtable.Get().Reset(Params.DataCoordCfg.CompactionVerificationMaxRetry.Key)
+
+		mockDataNode := &mocks.MockDataNodeClient{}
+		mockDataNode.EXPECT().SyncSegments(mock.Anything, mock.Anything, mock.Anything).
+			Return(&commonpb.Status{ErrorCode: commonpb.ErrorCode_Success}, nil)
+		dataNodeID := UniqueID(111)
+		sessions := &SessionManager{
+			sessions: struct {
+				sync.RWMutex
+				data map[int64]*Session
+			}{
+				data: map[int64]*Session{
+					dataNodeID: {client: mockDataNode},
+				},
+			},
+		}
+
+		seg1 := &datapb.SegmentInfo{ID: 1, NumOfRows: 10}
+		plan := &datapb.CompactionPlan{
+			PlanID:         1,
+			SegmentBinlogs: []*datapb.CompactionSegmentBinlogs{{SegmentID: seg1.ID}},
+			Type:           datapb.CompactionType_MixCompaction,
+		}
+		meta := &meta{
+			catalog:      &datacoord.Catalog{MetaKv: NewMetaMemoryKV()},
+			chunkManager: storage.NewLocalChunkManager(storage.RootPath(t.TempDir())),
+			segments: &SegmentsInfo{
+				map[int64]*SegmentInfo{
+					seg1.ID: {SegmentInfo: seg1, isCompacting: true},
+				},
+			},
+		}
+		c := &compactionPlanHandler{
+			plans: map[int64]*compactionTask{1: {
+				triggerInfo: &compactionSignal{id: 1},
+				state:       executing,
+				plan:        plan,
+				dataNodeID:  dataNodeID,
+			}},
+			sessions:         sessions,
+			meta:             meta,
+			flushCh:          make(chan UniqueID, 1),
+			executingTaskNum: 1,
+		}
+
+		// the binlogs of result don't exist
+		c.mu.Lock()
+		err := c.completeCompaction(&datapb.CompactionResult{
+			PlanID:     1,
+			SegmentID:  3,
+			NumOfRows:  10,
+			InsertLogs: []*datapb.FieldBinlog{getFieldBinlogPaths(101, getInsertLogPath("log301", 3))},
+		})
+		assert.NoError(t, err)
+		assert.Equal(t, verifying, c.plans[1].state)
+		c.mu.Unlock()
+
+		assert.Eventually(t, func() bool {
+			return c.getCompaction(1).state == failed
+		}, 5*time.Second, 10*time.Millisecond)
+		assert.False(t, meta.GetSegment(seg1.ID).isCompacting)
+		assert.Equal(t, 0, c.executingTaskNum)
+		assert.Nil(t, meta.GetSegment(3))
+	})
 }
 
 func Test_compactionPlanHandler_getCompaction(t *testing.T) {
@@ -968,3 +1038,29 @@ func getFieldBinlogPathsWithEntry(id int64, entry int64, paths ...string) *datap
 	}
 	return l
 }
+
+func Test_compactionPlanHandler_pickExecutor(t *testing.T) {
+	sessions := NewSessionManager()
+	sessions.AddSession(&NodeInfo{NodeID: 1})
+	c := &compactionPlanHandler{
+		plans: map[int64]*compactionTask{
+			1: {plan: &datapb.CompactionPlan{PlanID: 1}, state: executing, dataNodeID: 2},
+			2: {plan: &datapb.CompactionPlan{PlanID: 2}, state: completed, dataNodeID: 3},
+		},
+		sessions: sessions,
+	}
+	mixPlan := &datapb.CompactionPlan{Type: datapb.CompactionType_MixCompaction}
+	l0Plan := &datapb.CompactionPlan{Type: datapb.CompactionType_Level0DeleteCompaction}
+
+	// no dedicated node registered
+	assert.EqualValues(t, 1, c.pickExecutor(mixPlan, 1))
+
+	sessions.AddSession(&NodeInfo{NodeID: 2, CompactionDedicated: true})
+	sessions.AddSession(&NodeInfo{NodeID: 3, CompactionDedicated: true})
+	assert.EqualValues(t, 3, c.pickExecutor(mixPlan, 1))
+	assert.EqualValues(t, 1, c.pickExecutor(l0Plan, 1))
+
+	paramtable.Get().Save(Params.DataCoordCfg.CompactionPreferDedicatedNode.Key, "false")
+	defer paramtable.Get().Reset(Params.DataCoordCfg.CompactionPreferDedicatedNode.Key)
+	assert.EqualValues(t, 1, c.pickExecutor(mixPlan, 1))
+}
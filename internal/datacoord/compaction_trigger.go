@@ -28,6 +28,7 @@ import (
 
 	"github.com/milvus-io/milvus-proto/go-api/v2/commonpb"
 	"github.com/milvus-io/milvus/internal/proto/datapb"
+	"github.com/milvus-io/milvus/pkg/common"
 	"github.com/milvus-io/milvus/pkg/log"
 	"github.com/milvus-io/milvus/pkg/util/indexparamcheck"
 	"github.com/milvus-io/milvus/pkg/util/logutil"
@@ -37,6 +38,9 @@ import (
 type compactTime struct {
 	expireTime    Timestamp
 	collectionTTL time.Duration
+	// travelTime is the earliest timestamp could be read by time travel,
+	// data modified after it shall not be compacted.
+	travelTime Timestamp
 }
 
 type trigger interface {
@@ -48,6 +52,8 @@ type trigger interface {
 	triggerSingleCompaction(collectionID, partitionID, segmentID int64, channel string) error
 	// forceTriggerCompaction force to start a compaction
 	forceTriggerCompaction(collectionID int64) (UniqueID, error)
+	// simulateCompaction returns the plans would be generated without executing them
+	simulateCompaction(collectionID int64, force bool) (*CompactionSimulation, error)
 }
 
 type compactionSignal struct {
@@ -197,16 +203,23 @@ func (t *compactionTrigger) getCompactTime(ts Timestamp, coll *collectionInfo) (
 		return nil, err
 	}
 
+	retention, err := common.GetCollectionRetention(coll.Properties, Params.CommonCfg.RetentionDuration.GetAsDuration(time.Second))
+	if err != nil {
+		return nil, err
+	}
+
 	pts, _ := tsoutil.ParseTS(ts)
 
+	result := &compactTime{}
 	if collectionTTL > 0 {
 		ttexpired := pts.Add(-collectionTTL)
-		ttexpiredLogic := tsoutil.ComposeTS(ttexpired.UnixNano()/int64(time.Millisecond), 0)
-		return &compactTime{ttexpiredLogic, collectionTTL}, nil
+		result.expireTime = tsoutil.ComposeTS(ttexpired.UnixNano()/int64(time.Millisecond), 0)
+		result.collectionTTL = collectionTTL
 	}
-
-	// no expiration time
-	return &compactTime{0, 0}, nil
+	if retention > 0 {
+		result.travelTime = tsoutil.ComposeTSByTime(pts.Add(-retention), 0)
+	}
+	return result, nil
 }
 
 // triggerCompaction trigger a compaction if any compaction condition satisfy.
@@ -336,12 +349,17 @@ func (t *compactionTrigger) handleGlobalSignal(signal *compactionSignal) {
 	defer t.forceMu.Unlock()
 
 	log := log.With(zap.Int64("compactionID", signal.id))
+	if Params.DataCoordCfg.EnableLevelZeroSegment.GetAsBool() {
+		t.handleLevelZeroSignal(signal)
+	}
+
 	m := t.meta.GetSegmentsChanPart(func(segment *SegmentInfo) bool {
 		return (signal.collectionID == 0 || segment.CollectionID == signal.collectionID) &&
 			isSegmentHealthy(segment) &&
 			isFlush(segment) &&
 			!segment.isCompacting && // not compacting now
-			!segment.GetIsImporting() // not importing now
+			!segment.GetIsImporting() && // not importing now
+			segment.GetLevel() != datapb.SegmentLevel_L0 // L0 segments are compacted by LevelZero compaction
 	}) // m is list of chanPartSegments, which is channel-partition organized segments
 
 	if len(m) == 0 {
@@ -545,6 +563,10 @@ func (t *compactionTrigger) generatePlans(segments []*SegmentInfo, force bool, i
 	// TODO, currently we lack of the measurement of data distribution, there should be another compaction help on redistributing segment based on scalar/vector field distribution
 	for _, segment := range segments {
 		segment := segment.ShadowClone()
+		// segment modified within time travel retention shall keep its binlogs for historical read
+		if isSegmentInRetention(segment, compactTime) {
+			continue
+		}
 		// TODO should we trigger compaction periodically even if the segment has no obvious reason to be compacted?
 		if force || t.ShouldDoSingleCompaction(segment, isDiskIndex, compactTime) {
 			prioritizedCandidates = append(prioritizedCandidates, segment)
@@ -719,6 +741,7 @@ func segmentsToPlan(segments []*SegmentInfo, compactTime *compactTime) *datapb.C
 		Type:          datapb.CompactionType_MixCompaction,
 		Channel:       segments[0].GetInsertChannel(),
 		CollectionTtl: compactTime.collectionTTL.Nanoseconds(),
+		Timetravel:    compactTime.travelTime,
 	}
 
 	for _, s := range segments {
@@ -735,6 +758,23 @@ func segmentsToPlan(segments []*SegmentInfo, compactTime *compactTime) *datapb.C
 	return plan
 }
 
+// isSegmentInRetention returns whether the segment has insert or delete data after the time travel timestamp.
+func isSegmentInRetention(segment *SegmentInfo, compactTime *compactTime) bool {
+	if compactTime == nil || compactTime.travelTime == 0 {
+		return false
+	}
+	for _, fieldBinlogs := range [][]*datapb.FieldBinlog{segment.GetBinlogs(), segment.GetDeltalogs()} {
+		for _, fieldBinlog := range fieldBinlogs {
+			for _, binlog := range fieldBinlog.GetBinlogs() {
+				if binlog.GetTimestampTo() >= compactTime.travelTime {
+					return true
+				}
+			}
+		}
+	}
+	return false
+}
+
 func greedySelect(candidates []*SegmentInfo, free int64, maxSegment int) ([]*SegmentInfo, []*SegmentInfo, int64) {
 	var result []*SegmentInfo
 
@@ -779,7 +819,8 @@ func (t *compactionTrigger) getCandidateSegments(channel string, partitionID Uni
 			s.GetInsertChannel() != channel ||
 			s.GetPartitionID() != partitionID ||
 			s.isCompacting ||
-			s.GetIsImporting() {
+			s.GetIsImporting() ||
+			s.GetLevel() == datapb.SegmentLevel_L0 {
 			continue
 		}
 		res = append(res, s)
@@ -824,6 +865,11 @@ func (t *compactionTrigger) isStaleSegment(segment *SegmentInfo) bool {
 }
 
 func (t *compactionTrigger) ShouldDoSingleCompaction(segment *SegmentInfo, isDiskIndex bool, compactTime *compactTime) bool {
+	return t.singleCompactionReason(segment, isDiskIndex, compactTime) != ""
+}
+
+// singleCompactionReason returns why the segment should be compacted by itself, or empty if it needn't.
+func (t *compactionTrigger) singleCompactionReason(segment *SegmentInfo, isDiskIndex bool, compactTime *compactTime) string {
 	// no longer restricted binlog numbers because this is now related to field numbers
 	var binLog int
 	for _, binlogs := range segment.GetBinlogs() {
@@ -849,7 +895,7 @@ func (t *compactionTrigger) ShouldDoSingleCompaction(segment *SegmentInfo, isDis
 		// TODO avoid rebuild index twice.
 		if statsLog > maxSize*2.0 {
 			log.Info("stats number is too much, trigger compaction", zap.Int64("segmentID", segment.ID), zap.Int("Bin logs", binLog), zap.Int("Stat logs", statsLog))
-			return true
+			return CompactionReasonTooManyStatslogs
 		}
 	}
 
@@ -860,7 +906,7 @@ func (t *compactionTrigger) ShouldDoSingleCompaction(segment *SegmentInfo, isDis
 
 	if deltaLog > Params.DataCoordCfg.SingleCompactionDeltalogMaxNum.GetAsInt() {
 		log.Info("total delta number is too much, trigger compaction", zap.Int64("segmentID", segment.ID), zap.Int("Bin logs", binLog), zap.Int("Delta logs", deltaLog))
-		return true
+		return CompactionReasonTooManyDeltalogs
 	}
 
 	// if expire time is enabled, put segment into compaction candidate
@@ -886,7 +932,7 @@ func (t *compactionTrigger) ShouldDoSingleCompaction(segment *SegmentInfo, isDis
 		log.Info("total expired entities is too much, trigger compaction", zap.Int64("segmentID", segment.ID),
 			zap.Int("expiredRows", totalExpiredRows), zap.Int64("expiredLogSize", totalExpiredSize),
 			zap.Bool("createdByCompaction", segment.CreatedByCompaction), zap.Int64s("compactionFrom", segment.CompactionFrom))
-		return true
+		return CompactionReasonExpiredData
 	}
 
 	totalDeletedRows := 0
@@ -905,10 +951,10 @@ func (t *compactionTrigger) ShouldDoSingleCompaction(segment *SegmentInfo, isDis
 			zap.Int64("numRows", segment.GetNumOfRows()),
 			zap.Int("deleted rows", totalDeletedRows),
 			zap.Int64("delete log size", totalDeleteLogSize))
-		return true
+		return CompactionReasonDeletedData
 	}
 
-	return false
+	return ""
 }
 
 func isFlush(segment *SegmentInfo) bool {
@@ -69,6 +69,10 @@ func (h *spyCompactionHandler) getCompactionTasksBySignalID(signalID int64) []*c
 	panic("not implemented") // TODO: Implement
 }
 
+func (h *spyCompactionHandler) cancelCompaction(planID int64) error {
+	panic("not implemented") // TODO: Implement
+}
+
 func (h *spyCompactionHandler) start() {}
 
 func (h *spyCompactionHandler) stop() {}
@@ -1910,6 +1914,26 @@ func Test_compactionTrigger_getCompactTime(t *testing.T) {
 	assert.NotNil(t, ct)
 }
 
+func Test_isSegmentInRetention(t *testing.T) {
+	segment := &SegmentInfo{
+		SegmentInfo: &datapb.SegmentInfo{
+			ID: 1,
+			Binlogs: []*datapb.FieldBinlog{
+				{FieldID: 1, Binlogs: []*datapb.Binlog{{TimestampFrom: 100, TimestampTo: 200}}},
+			},
+			Deltalogs: []*datapb.FieldBinlog{
+				{Binlogs: []*datapb.Binlog{{TimestampFrom: 300, TimestampTo: 400}}},
+			},
+		},
+	}
+
+	assert.False(t, isSegmentInRetention(segment, nil))
+	assert.False(t, isSegmentInRetention(segment, &compactTime{}))
+	assert.True(t, isSegmentInRetention(segment, &compactTime{travelTime: 150}))
+	assert.True(t, isSegmentInRetention(segment, &compactTime{travelTime: 350}))
+	assert.False(t, isSegmentInRetention(segment, &compactTime{travelTime: 500}))
+}
+
 type CompactionTriggerSuite struct {
 	suite.Suite
 
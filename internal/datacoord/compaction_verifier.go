@@ -0,0 +1,179 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package datacoord
+
+import (
+	"context"
+	"math/rand"
+
+	"github.com/cockroachdb/errors"
+	"go.uber.org/zap"
+
+	"github.com/milvus-io/milvus-proto/go-api/v2/schemapb"
+	"github.com/milvus-io/milvus/internal/proto/datapb"
+	"github.com/milvus-io/milvus/internal/storage"
+	"github.com/milvus-io/milvus/pkg/log"
+	"github.com/milvus-io/milvus/pkg/util/typeutil"
+)
+
+var errCompactionVerifyFailed = errors.New("compaction result verification failed")
+
+// verifyCompactionResult checks the compacted segment before the segments compacted from are dropped:
+//  1. the row number shall not exceed the rows of inputs, and equals to the entries of each field;
+//  2. the binlogs shall exist in storage with the size and checksum recorded;
+//  3. the primary keys in the sampled binlogs shall be unique.
+//
+// It reads the result binlogs from storage, so shall not be called with any lock held.
+func verifyCompactionResult(ctx context.Context, cm storage.ChunkManager, schema *schemapb.CollectionSchema,
+	inputs []*SegmentInfo, result *datapb.CompactionResult,
+) error {
+	var inputRows int64
+	for _, segment := range inputs {
+		inputRows += segment.GetNumOfRows()
+	}
+	if result.GetNumOfRows() > inputRows {
+		return errors.Wrapf(errCompactionVerifyFailed, "result has %d rows, more than %d rows of inputs",
+			result.GetNumOfRows(), inputRows)
+	}
+	for _, fieldBinlog := range result.GetInsertLogs() {
+		var entries int64
+		for _, binlog := range fieldBinlog.GetBinlogs() {
+			entries += binlog.GetEntriesNum()
+		}
+		if entries != result.GetNumOfRows() {
+			return errors.Wrapf(errCompactionVerifyFailed, "field %d has %d entries, but result has %d rows",
+				fieldBinlog.GetFieldID(), entries, result.GetNumOfRows())
+		}
+	}
+
+	for _, fieldBinlogs := range [][]*datapb.FieldBinlog{result.GetInsertLogs(), result.GetField2StatslogPaths(), result.GetDeltalogs()} {
+		for _, fieldBinlog := range fieldBinlogs {
+			for _, binlog := range fieldBinlog.GetBinlogs() {
+				if err := verifyBinlog(ctx, cm, binlog); err != nil {
+					return err
+				}
+			}
+		}
+	}
+
+	return verifyPrimaryKeyUniqueness(ctx, cm, schema, result)
+}
+
+// verifyBinlog checks the size of binlog, and the checksum if recorded, the binlogs are read one by one to bound the memory.
+func verifyBinlog(ctx context.Context, cm storage.ChunkManager, binlog *datapb.Binlog) error {
+	var size int64
+	if binlog.GetChecksum() == "" {
+		var err error
+		size, err = cm.Size(ctx, binlog.GetLogPath())
+		if err != nil {
+			return errors.Wrapf(errCompactionVerifyFailed, "failed to stat binlog %s, err: %v", binlog.GetLogPath(), err)
+		}
+	} else {
+		data, err := cm.Read(ctx, binlog.GetLogPath())
+		if err != nil {
+			return errors.Wrapf(errCompactionVerifyFailed, "failed to read binlog %s, err: %v", binlog.GetLogPath(), err)
+		}
+		if err := storage.VerifyBinlogChecksum(binlog, data); err != nil {
+			return errors.Wrapf(errCompactionVerifyFailed, "binlog %s is broken, err: %v", binlog.GetLogPath(), err)
+		}
+		size = int64(len(data))
+	}
+	if binlog.GetLogSize() > 0 && size != binlog.GetLogSize() {
+		return errors.Wrapf(errCompactionVerifyFailed, "binlog %s size mismatch, expected %d, actual %d",
+			binlog.GetLogPath(), binlog.GetLogSize(), size)
+	}
+	return nil
+}
+
+// verifyPrimaryKeyUniqueness reads sampled binlogs of the primary key field and checks there is no duplicated key,
+// the sampled binlogs are skipped once the keys held in memory reach the limit.
+func verifyPrimaryKeyUniqueness(ctx context.Context, cm storage.ChunkManager, schema *schemapb.CollectionSchema,
+	result *datapb.CompactionResult,
+) error {
+	sampleNum := Params.DataCoordCfg.CompactionVerificationPkSampleLogs.GetAsInt()
+	if sampleNum <= 0 || schema == nil {
+		return nil
+	}
+	pkField, err := typeutil.GetPrimaryFieldSchema(schema)
+	if err != nil {
+		return err
+	}
+
+	var binlogs []*datapb.Binlog
+	for _, fieldBinlog := range result.GetInsertLogs() {
+		if fieldBinlog.GetFieldID() == pkField.GetFieldID() {
+			binlogs = fieldBinlog.GetBinlogs()
+		}
+	}
+	if len(binlogs) > sampleNum {
+		sampled := make([]*datapb.Binlog, 0, sampleNum)
+		for _, idx := range rand.Perm(len(binlogs))[:sampleNum] {
+			sampled = append(sampled, binlogs[idx])
+		}
+		binlogs = sampled
+	}
+
+	maxKeys := Params.DataCoordCfg.CompactionVerificationPkMaxKeys.GetAsInt64()
+	int64Keys := typeutil.NewSet[int64]()
+	stringKeys := typeutil.NewSet[string]()
+	for _, binlog := range binlogs {
+		if keyNum := int64(len(int64Keys) + len(stringKeys)); keyNum > 0 && keyNum+binlog.GetEntriesNum() > maxKeys {
+			log.Info("skip checking the rest primary keys of compaction result, too many keys",
+				zap.Int64("segmentID", result.GetSegmentID()), zap.Int64("checkedKeys", keyNum))
+			break
+		}
+		data, err := cm.Read(ctx, binlog.GetLogPath())
+		if err != nil {
+			return errors.Wrapf(errCompactionVerifyFailed, "failed to read binlog %s, err: %v", binlog.GetLogPath(), err)
+		}
+		reader, err := storage.NewBinlogReader(data)
+		if err != nil {
+			return errors.Wrapf(errCompactionVerifyFailed, "failed to parse binlog %s, err: %v", binlog.GetLogPath(), err)
+		}
+		eventReader, err := reader.NextEventReader()
+		if err != nil {
+			reader.Close()
+			return errors.Wrapf(errCompactionVerifyFailed, "failed to parse binlog %s, err: %v", binlog.GetLogPath(), err)
+		}
+
+		var duplicated bool
+		switch pkField.GetDataType() {
+		case schemapb.DataType_Int64:
+			var pks []int64
+			pks, err = eventReader.GetInt64FromPayload()
+			for _, pk := range pks {
+				duplicated = duplicated || int64Keys.Contain(pk)
+				int64Keys.Insert(pk)
+			}
+		case schemapb.DataType_VarChar:
+			var pks []string
+			pks, err = eventReader.GetStringFromPayload()
+			for _, pk := range pks {
+				duplicated = duplicated || stringKeys.Contain(pk)
+				stringKeys.Insert(pk)
+			}
+		}
+		reader.Close()
+		if err != nil {
+			return errors.Wrapf(errCompactionVerifyFailed, "failed to read primary keys from binlog %s, err: %v", binlog.GetLogPath(), err)
+		}
+		if duplicated {
+			return errors.Wrapf(errCompactionVerifyFailed, "duplicated primary keys found in binlog %s", binlog.GetLogPath())
+		}
+	}
+	return nil
+}
@@ -0,0 +1,151 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package datacoord
+
+import (
+	"context"
+	"path"
+	"strconv"
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+
+	"github.com/milvus-io/milvus-proto/go-api/v2/schemapb"
+	"github.com/milvus-io/milvus/internal/proto/datapb"
+	"github.com/milvus-io/milvus/internal/storage"
+)
+
+type CompactionVerifierSuite struct {
+	suite.Suite
+
+	ctx    context.Context
+	cm     storage.ChunkManager
+	schema *schemapb.CollectionSchema
+	inputs []*SegmentInfo
+}
+
+func (s *CompactionVerifierSuite) SetupTest() {
+	s.ctx = context.Background()
+	s.cm = storage.NewLocalChunkManager(storage.RootPath(s.T().TempDir()))
+	s.schema = &schemapb.CollectionSchema{
+		Fields: []*schemapb.FieldSchema{
+			{FieldID: 100, Name: "pk", IsPrimaryKey: true, DataType: schemapb.DataType_Int64},
+		},
+	}
+	s.inputs = []*SegmentInfo{
+		NewSegmentInfo(&datapb.SegmentInfo{ID: 1, NumOfRows: 3}),
+		NewSegmentInfo(&datapb.SegmentInfo{ID: 2, NumOfRows: 3}),
+	}
+}
+
+// writePkBinlog writes a binlog of primary keys and returns the binlog meta.
+func (s *CompactionVerifierSuite) writePkBinlog(logID int64, pks []int64) *datapb.Binlog {
+	writer := storage.NewInsertBinlogWriter(schemapb.DataType_Int64, 1, 2, 3, 100)
+	defer writer.Close()
+	eventWriter, err := writer.NextInsertEventWriter()
+	s.Require().NoError(err)
+	s.Require().NoError(eventWriter.AddDataToPayload(pks))
+	eventWriter.SetEventTimestamp(100, 200)
+	writer.SetEventTimeStamp(100, 200)
+	writer.AddExtra("original_size", "100")
+	s.Require().NoError(writer.Finish())
+	data, err := writer.GetBuffer()
+	s.Require().NoError(err)
+
+	logPath := path.Join(s.cm.RootPath(), "insert_log", "1", "2", "3", "100", strconv.FormatInt(logID, 10))
+	s.Require().NoError(s.cm.Write(s.ctx, logPath, data))
+	return &datapb.Binlog{LogID: logID, LogPath: logPath, LogSize: int64(len(data)), EntriesNum: int64(len(pks))}
+}
+
+func (s *CompactionVerifierSuite) result(binlogs ...*datapb.Binlog) *datapb.CompactionResult {
+	var rows int64
+	for _, binlog := range binlogs {
+		rows += binlog.GetEntriesNum()
+	}
+	return &datapb.CompactionResult{
+		SegmentID:  3,
+		NumOfRows:  rows,
+		InsertLogs: []*datapb.FieldBinlog{{FieldID: 100, Binlogs: binlogs}},
+	}
+}
+
+func (s *CompactionVerifierSuite) TestPass() {
+	result := s.result(s.writePkBinlog(1, []int64{1, 2, 3}), s.writePkBinlog(2, []int64{4, 5}))
+	s.NoError(verifyCompactionResult(s.ctx, s.cm, s.schema, s.inputs, result))
+}
+
+func (s *CompactionVerifierSuite) TestTooManyRows() {
+	result := s.result(s.writePkBinlog(1, []int64{1, 2, 3, 4}), s.writePkBinlog(2, []int64{5, 6, 7}))
+	s.ErrorIs(verifyCompactionResult(s.ctx, s.cm, s.schema, s.inputs, result), errCompactionVerifyFailed)
+}
+
+func (s *CompactionVerifierSuite) TestEntriesMismatch() {
+	result := s.result(s.writePkBinlog(1, []int64{1, 2, 3}))
+	result.NumOfRows = 2
+	s.ErrorIs(verifyCompactionResult(s.ctx, s.cm, s.schema, s.inputs, result), errCompactionVerifyFailed)
+}
+
+func (s *CompactionVerifierSuite) TestBinlogBroken() {
+	binlog := s.writePkBinlog(1, []int64{1, 2, 3})
+	binlog.LogSize++
+	result := s.result(binlog)
+	s.ErrorIs(verifyCompactionResult(s.ctx, s.cm, s.schema, s.inputs, result), errCompactionVerifyFailed)
+
+	binlog = s.writePkBinlog(2, []int64{1, 2, 3})
+	s.NoError(s.cm.Remove(s.ctx, binlog.GetLogPath()))
+	result = s.result(binlog)
+	s.ErrorIs(verifyCompactionResult(s.ctx, s.cm, s.schema, s.inputs, result), errCompactionVerifyFailed)
+}
+
+func (s *CompactionVerifierSuite) TestDuplicatedPk() {
+	result := s.result(s.writePkBinlog(1, []int64{1, 2, 3}), s.writePkBinlog(2, []int64{3, 4}))
+	s.ErrorIs(verifyCompactionResult(s.ctx, s.cm, s.schema, s.inputs, result), errCompactionVerifyFailed)
+
+	// sampling disabled
+	Params.Save(Params.DataCoordCfg.CompactionVerificationPkSampleLogs.Key, "0")
+	defer Params.Reset(Params.DataCoordCfg.CompactionVerificationPkSampleLogs.Key)
+	s.NoError(verifyCompactionResult(s.ctx, s.cm, s.schema, s.inputs, result))
+}
+
+func (s *CompactionVerifierSuite) TestChecksum() {
+	binlog := s.writePkBinlog(1, []int64{1, 2, 3})
+	data, err := s.cm.Read(s.ctx, binlog.GetLogPath())
+	s.Require().NoError(err)
+	binlog.Checksum = storage.BinlogChecksum(data)
+	s.NoError(verifyCompactionResult(s.ctx, s.cm, s.schema, s.inputs, s.result(binlog)))
+
+	binlog.Checksum = "00000000"
+	s.ErrorIs(verifyCompactionResult(s.ctx, s.cm, s.schema, s.inputs, s.result(binlog)), errCompactionVerifyFailed)
+}
+
+func (s *CompactionVerifierSuite) TestPkMaxKeys() {
+	Params.Save(Params.DataCoordCfg.CompactionVerificationPkMaxKeys.Key, "4")
+	defer Params.Reset(Params.DataCoordCfg.CompactionVerificationPkMaxKeys.Key)
+
+	// the keys of the first binlog are always checked
+	result := s.result(s.writePkBinlog(1, []int64{1, 1, 2, 3, 4}))
+	s.inputs[0].NumOfRows = 5
+	s.ErrorIs(verifyCompactionResult(s.ctx, s.cm, s.schema, s.inputs, result), errCompactionVerifyFailed)
+
+	// the second binlog is skipped since the keys exceed the limit
+	result = s.result(s.writePkBinlog(2, []int64{1, 2, 3}), s.writePkBinlog(3, []int64{3, 4}))
+	s.NoError(verifyCompactionResult(s.ctx, s.cm, s.schema, s.inputs, result))
+}
+
+func TestCompactionVerifier(t *testing.T) {
+	suite.Run(t, new(CompactionVerifierSuite))
+}
@@ -41,6 +41,9 @@ type Broker interface {
 	ShowCollections(ctx context.Context, dbName string) (*milvuspb.ShowCollectionsResponse, error)
 	ListDatabases(ctx context.Context) (*milvuspb.ListDatabasesResponse, error)
 	HasCollection(ctx context.Context, collectionID int64) (bool, error)
+	ShowPartitions(ctx context.Context, collectionID int64) (*milvuspb.ShowPartitionsResponse, error)
+	CreatePartition(ctx context.Context, dbName string, collectionName string, partitionName string) error
+	DropPartition(ctx context.Context, dbName string, collectionName string, partitionName string) error
 }
 
 type CoordinatorBroker struct {
@@ -153,3 +156,70 @@ func (b *CoordinatorBroker) HasCollection(ctx context.Context, collectionID int6
 	}
 	return err == nil, err
 }
+
+// ShowPartitions returns both the ids and names of the partitions of the collection.
+func (b *CoordinatorBroker) ShowPartitions(ctx context.Context, collectionID int64) (*milvuspb.ShowPartitionsResponse, error) {
+	ctx, cancel := context.WithTimeout(ctx, brokerRPCTimeout)
+	defer cancel()
+	resp, err := b.rootCoord.ShowPartitionsInternal(ctx, &milvuspb.ShowPartitionsRequest{
+		Base: commonpbutil.NewMsgBase(
+			commonpbutil.WithMsgType(commonpb.MsgType_ShowPartitions),
+			commonpbutil.WithSourceID(paramtable.GetNodeID()),
+		),
+		// please do not specify the collection name alone after database feature.
+		CollectionID: collectionID,
+	})
+	if err = VerifyResponse(resp, err); err != nil {
+		log.Warn("ShowPartitions failed",
+			zap.Int64("collectionID", collectionID),
+			zap.Error(err))
+		return nil, err
+	}
+	return resp, nil
+}
+
+func (b *CoordinatorBroker) CreatePartition(ctx context.Context, dbName string, collectionName string, partitionName string) error {
+	ctx, cancel := context.WithTimeout(ctx, brokerRPCTimeout)
+	defer cancel()
+	status, err := b.rootCoord.CreatePartition(ctx, &milvuspb.CreatePartitionRequest{
+		Base: commonpbutil.NewMsgBase(
+			commonpbutil.WithMsgType(commonpb.MsgType_CreatePartition),
+			commonpbutil.WithSourceID(paramtable.GetNodeID()),
+		),
+		DbName:         dbName,
+		CollectionName: collectionName,
+		PartitionName:  partitionName,
+	})
+	if err = VerifyResponse(status, err); err != nil {
+		log.Warn("CreatePartition failed",
+			zap.String("dbName", dbName),
+			zap.String("collectionName", collectionName),
+			zap.String("partitionName", partitionName),
+			zap.Error(err))
+		return err
+	}
+	return nil
+}
+
+func (b *CoordinatorBroker) DropPartition(ctx context.Context, dbName string, collectionName string, partitionName string) error {
+	ctx, cancel := context.WithTimeout(ctx, brokerRPCTimeout)
+	defer cancel()
+	status, err := b.rootCoord.DropPartition(ctx, &milvuspb.DropPartitionRequest{
+		Base: commonpbutil.NewMsgBase(
+			commonpbutil.WithMsgType(commonpb.MsgType_DropPartition),
+			commonpbutil.WithSourceID(paramtable.GetNodeID()),
+		),
+		DbName:         dbName,
+		CollectionName: collectionName,
+		PartitionName:  partitionName,
+	})
+	if err = VerifyResponse(status, err); err != nil {
+		log.Warn("DropPartition failed",
+			zap.String("dbName", dbName),
+			zap.String("collectionName", collectionName),
+			zap.String("partitionName", partitionName),
+			zap.Error(err))
+		return err
+	}
+	return nil
+}
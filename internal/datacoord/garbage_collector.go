@@ -19,6 +19,7 @@ package datacoord
 import (
 	"context"
 	"fmt"
+	"math/rand"
 	"path"
 	"sort"
 	"strings"
@@ -47,6 +48,8 @@ type GcOption struct {
 	checkInterval    time.Duration        // each interval
 	missingTolerance time.Duration        // key missing in meta tolerance time
 	dropTolerance    time.Duration        // dropped segment related key tolerance time
+
+	checksumVerifyBatch int // number of binlogs sampled to verify checksums each round
 }
 
 // garbageCollector handles garbage files in object storage
@@ -100,6 +103,7 @@ func (gc *garbageCollector) work() {
 			gc.recycleUnusedIndexes()
 			gc.recycleUnusedSegIndexes()
 			gc.scan()
+			gc.verifyChecksums()
 			gc.recycleUnusedIndexFiles()
 		case <-gc.closeCh:
 			log.Warn("garbage collector quit")
@@ -207,6 +211,56 @@ func (gc *garbageCollector) scan() {
 		zap.Strings("removedKeys", removedKeys))
 }
 
+// verifyChecksums samples the binlogs of healthy segments and verifies them against the checksums in meta,
+// so that the corrupted binlogs are surfaced before they are loaded.
+func (gc *garbageCollector) verifyChecksums() {
+	if gc.option.checksumVerifyBatch <= 0 {
+		return
+	}
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	type segmentBinlog struct {
+		segmentID int64
+		binlog    *datapb.Binlog
+	}
+	binlogs := make([]segmentBinlog, 0)
+	for _, segment := range gc.meta.SelectSegments(isSegmentHealthy) {
+		for _, binlog := range getLogs(segment) {
+			if binlog.GetChecksum() != "" {
+				binlogs = append(binlogs, segmentBinlog{segmentID: segment.GetID(), binlog: binlog})
+			}
+		}
+	}
+	rand.Shuffle(len(binlogs), func(i, j int) {
+		binlogs[i], binlogs[j] = binlogs[j], binlogs[i]
+	})
+	if len(binlogs) > gc.option.checksumVerifyBatch {
+		binlogs = binlogs[:gc.option.checksumVerifyBatch]
+	}
+
+	corrupted := 0
+	for _, b := range binlogs {
+		data, err := gc.option.cli.Read(ctx, b.binlog.GetLogPath())
+		if err != nil {
+			log.Warn("failed to read binlog to verify checksum",
+				zap.Int64("segmentID", b.segmentID),
+				zap.String("path", b.binlog.GetLogPath()),
+				zap.Error(err))
+			continue
+		}
+		if err := storage.VerifyBinlogChecksum(b.binlog, data); err != nil {
+			corrupted++
+			metrics.PersistentDataChecksumMismatchCounter.WithLabelValues(typeutil.DataCoordRole).Inc()
+			log.Error("binlog corrupted",
+				zap.Int64("segmentID", b.segmentID),
+				zap.String("path", b.binlog.GetLogPath()),
+				zap.Error(err))
+		}
+	}
+	log.Info("verify binlog checksums done", zap.Int("verified", len(binlogs)), zap.Int("corrupted", corrupted))
+}
+
 func (gc *garbageCollector) checkDroppedSegmentGC(segment *SegmentInfo,
 	childSegment *SegmentInfo,
 	indexSet typeutil.UniqueSet,
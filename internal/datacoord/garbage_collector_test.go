@@ -29,6 +29,7 @@ import (
 	"github.com/cockroachdb/errors"
 	minio "github.com/minio/minio-go/v7"
 	"github.com/minio/minio-go/v7/pkg/credentials"
+	"github.com/prometheus/client_golang/prometheus/testutil"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/require"
@@ -46,8 +47,11 @@ import (
 	"github.com/milvus-io/milvus/internal/proto/indexpb"
 	"github.com/milvus-io/milvus/internal/storage"
 	"github.com/milvus-io/milvus/pkg/common"
+	"github.com/milvus-io/milvus/pkg/metrics"
 	"github.com/milvus-io/milvus/pkg/util/funcutil"
+	"github.com/milvus-io/milvus/pkg/util/metautil"
 	"github.com/milvus-io/milvus/pkg/util/paramtable"
+	"github.com/milvus-io/milvus/pkg/util/typeutil"
 )
 
 func Test_garbageCollector_basic(t *testing.T) {
@@ -757,6 +761,68 @@ func TestGarbageCollector_recycleUnusedIndexFiles(t *testing.T) {
 	})
 }
 
+func TestGarbageCollector_verifyChecksums(t *testing.T) {
+	meta, err := newMemoryMeta()
+	require.NoError(t, err)
+
+	content := []byte("binlog")
+	goodPath := metautil.BuildInsertLogPath("files", 1, 10, 100, 0, 1)
+	corruptedPath := metautil.BuildInsertLogPath("files", 1, 10, 100, 0, 2)
+	segment := buildSegment(1, 10, 100, "ch", false)
+	segment.State = commonpb.SegmentState_Flushed
+	segment.Binlogs = []*datapb.FieldBinlog{{
+		FieldID: 0,
+		Binlogs: []*datapb.Binlog{
+			{LogPath: goodPath, Checksum: storage.BinlogChecksum(content)},
+			{LogPath: corruptedPath, Checksum: storage.BinlogChecksum([]byte("origin"))},
+			{LogPath: metautil.BuildInsertLogPath("files", 1, 10, 100, 0, 3)},
+		},
+	}}
+	err = meta.AddSegment(context.TODO(), segment)
+	require.NoError(t, err)
+
+	dropped := buildSegment(1, 10, 101, "ch", false)
+	dropped.State = commonpb.SegmentState_Dropped
+	dropped.Binlogs = []*datapb.FieldBinlog{{
+		FieldID: 0,
+		Binlogs: []*datapb.Binlog{{LogPath: metautil.BuildInsertLogPath("files", 1, 10, 101, 0, 4), Checksum: storage.BinlogChecksum(content)}},
+	}}
+	err = meta.AddSegment(context.TODO(), dropped)
+	require.NoError(t, err)
+
+	t.Run("verify all", func(t *testing.T) {
+		cm := mocks.NewChunkManager(t)
+		cm.EXPECT().Read(mock.Anything, goodPath).Return(content, nil).Once()
+		cm.EXPECT().Read(mock.Anything, corruptedPath).Return(content, nil).Once()
+		gc := newGarbageCollector(meta, newMockHandler(), GcOption{
+			cli:                 cm,
+			checksumVerifyBatch: 10,
+		})
+		before := testutil.ToFloat64(metrics.PersistentDataChecksumMismatchCounter.WithLabelValues(typeutil.DataCoordRole))
+		gc.verifyChecksums()
+		after := testutil.ToFloat64(metrics.PersistentDataChecksumMismatchCounter.WithLabelValues(typeutil.DataCoordRole))
+		assert.Equal(t, float64(1), after-before)
+	})
+
+	t.Run("sample batch", func(t *testing.T) {
+		cm := mocks.NewChunkManager(t)
+		cm.EXPECT().Read(mock.Anything, mock.Anything).Return(nil, errors.New("mock")).Once()
+		gc := newGarbageCollector(meta, newMockHandler(), GcOption{
+			cli:                 cm,
+			checksumVerifyBatch: 1,
+		})
+		gc.verifyChecksums()
+	})
+
+	t.Run("disabled", func(t *testing.T) {
+		cm := mocks.NewChunkManager(t)
+		gc := newGarbageCollector(meta, newMockHandler(), GcOption{
+			cli: cm,
+		})
+		gc.verifyChecksums()
+	})
+}
+
 func TestGarbageCollector_clearETCD(t *testing.T) {
 	catalog := catalogmocks.NewDataCoordCatalog(t)
 	catalog.On("ChannelExists",
@@ -78,6 +78,10 @@ func (h *ServerHandler) GetDataVChanPositions(channel *channel, partitionID Uniq
 			// Skip bulk insert segments.
 			continue
 		}
+		if s.GetLevel() == datapb.SegmentLevel_L0 {
+			// L0 segments are only buffered by datanode before synced
+			continue
+		}
 
 		if s.GetState() == commonpb.SegmentState_Dropped {
 			droppedIDs.Insert(s.GetID())
@@ -137,6 +141,10 @@ func (h *ServerHandler) GetQueryVChanPositions(channel *channel, partitionIDs ..
 			// Skip bulk insert segments.
 			continue
 		}
+		if s.GetLevel() == datapb.SegmentLevel_L0 {
+			// Skip L0 segments, the deletes not compacted yet are replayed from the seek position.
+			continue
+		}
 		segmentInfos[s.GetID()] = s
 		switch {
 		case s.GetState() == commonpb.SegmentState_Dropped:
@@ -202,16 +210,44 @@ func (h *ServerHandler) GetQueryVChanPositions(channel *channel, partitionIDs ..
 	// unindexed is flushed segments as well
 	indexedIDs.Insert(unIndexedIDs.Collect()...)
 
+	seekPosition := h.GetChannelSeekPosition(channel, partitionIDs...)
+	if l0Position := h.getEarliestLevelZeroStartPos(channel); l0Position != nil &&
+		(seekPosition == nil || l0Position.GetTimestamp() < seekPosition.GetTimestamp()) {
+		log.Info("channel seek position set from earliest L0 segment start position",
+			zap.String("channel", channel.Name),
+			zap.Uint64("posTs", l0Position.GetTimestamp()),
+			zap.Time("posTime", tsoutil.PhysicalTime(l0Position.GetTimestamp())))
+		seekPosition = l0Position
+	}
+
 	return &datapb.VchannelInfo{
 		CollectionID:        channel.CollectionID,
 		ChannelName:         channel.Name,
-		SeekPosition:        h.GetChannelSeekPosition(channel, partitionIDs...),
+		SeekPosition:        seekPosition,
 		FlushedSegmentIds:   indexedIDs.Collect(),
 		UnflushedSegmentIds: growingIDs.Collect(),
 		DroppedSegmentIds:   droppedIDs.Collect(),
 	}
 }
 
+// getEarliestLevelZeroStartPos returns the earliest start position of the L0 segments not compacted yet,
+// the deletes after it are not applied to any sealed segment and must be consumed from the channel
+func (h *ServerHandler) getEarliestLevelZeroStartPos(channel *channel) *msgpb.MsgPosition {
+	var minPos *msgpb.MsgPosition
+	segments := h.s.meta.SelectSegments(func(s *SegmentInfo) bool {
+		return s.InsertChannel == channel.Name && s.GetLevel() == datapb.SegmentLevel_L0 && isSegmentHealthy(s)
+	})
+	for _, s := range segments {
+		if s.GetStartPosition() == nil {
+			continue
+		}
+		if minPos == nil || s.GetStartPosition().GetTimestamp() < minPos.GetTimestamp() {
+			minPos = s.GetStartPosition()
+		}
+	}
+	return minPos
+}
+
 // getEarliestSegmentDMLPos returns the earliest dml position of segments,
 // this is mainly for COMPATIBILITY with old version <=2.1.x
 func (h *ServerHandler) getEarliestSegmentDMLPos(channel *channel, partitionIDs ...UniqueID) *msgpb.MsgPosition {
@@ -376,7 +412,7 @@ func (h *ServerHandler) HasCollection(ctx context.Context, collectionID UniqueID
 		}
 		hasCollection = has
 		return nil
-	}, retry.Attempts(5)); err != nil {
+	}, retry.Attempts(5), retry.CallSite("datacoord.HasCollection")); err != nil {
 		log.Ctx(ctx2).Error("datacoord ServerHandler HasCollection finally failed",
 			zap.Int64("collectionID", collectionID),
 			zap.Error(err))
@@ -0,0 +1,82 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package datacoord
+
+import (
+	"context"
+	"strings"
+
+	"go.uber.org/zap"
+
+	"github.com/milvus-io/milvus/internal/proto/datapb"
+	"github.com/milvus-io/milvus/internal/util/importutil"
+	"github.com/milvus-io/milvus/pkg/log"
+	"github.com/milvus-io/milvus/pkg/util/merr"
+	"github.com/milvus-io/milvus/pkg/util/typeutil"
+)
+
+// validateImportTask samples the files of the import task before it's assigned to datanode,
+// so that the incompatible files fail fast instead of failing deep into segment building.
+// It returns a parameter invalid error if the task should be rejected.
+func (s *Server) validateImportTask(ctx context.Context, task *datapb.ImportTask) error {
+	if !Params.DataCoordCfg.ImportPreValidationEnabled.GetAsBool() || importutil.IsBackup(task.GetInfos()) {
+		return nil
+	}
+	log := log.Ctx(ctx).With(
+		zap.Int64("taskID", task.GetTaskId()),
+		zap.Int64("collectionID", task.GetCollectionId()),
+		zap.Strings("files", task.GetFiles()),
+	)
+
+	coll, err := s.handler.GetCollection(ctx, task.GetCollectionId())
+	if err != nil {
+		return err
+	}
+	if coll == nil {
+		return merr.WrapErrCollectionNotFound(task.GetCollectionId())
+	}
+
+	partitionIDs := []int64{task.GetPartitionId()}
+	if typeutil.HasPartitionKey(coll.Schema) {
+		partitionIDs = coll.Partitions
+	}
+	shardNum := int32(len(task.GetChannelNames()))
+	if shardNum == 0 {
+		shardNum = 1
+	}
+	collectionInfo, err := importutil.NewCollectionInfo(coll.Schema, shardNum, partitionIDs)
+	if err != nil {
+		return merr.WrapErrParameterInvalidMsg("import pre-validation failed: %s", err.Error())
+	}
+
+	result, err := importutil.SampleImportFiles(ctx, collectionInfo, s.meta.chunkManager, task.GetFiles(),
+		Params.DataCoordCfg.ImportPreValidationSampleRows.GetAsInt64())
+	if err != nil {
+		return merr.WrapErrParameterInvalidMsg("import pre-validation failed: %s", err.Error())
+	}
+
+	ratio := result.DuplicateRatio()
+	maxRatio := Params.DataCoordCfg.ImportPreValidationMaxPKDuplicateRatio.GetAsFloat()
+	log.Info("import files sampled",
+		zap.Int64("sampledRows", result.SampledRows),
+		zap.Int64("duplicatedPKs", result.DuplicatedPKs))
+	if ratio > maxRatio {
+		return merr.WrapErrParameterInvalidMsg("import pre-validation failed: %d of %d sampled rows have duplicated primary key, exceeds the max ratio %v: %s",
+			result.DuplicatedPKs, result.SampledRows, maxRatio, strings.Join(result.Diagnostics, "; "))
+	}
+	return nil
+}
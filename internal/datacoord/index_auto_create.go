@@ -0,0 +1,205 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package datacoord
+
+import (
+	"context"
+	"encoding/json"
+	"strconv"
+
+	"github.com/cockroachdb/errors"
+	"github.com/samber/lo"
+	"go.uber.org/zap"
+
+	"github.com/milvus-io/milvus-proto/go-api/v2/schemapb"
+	"github.com/milvus-io/milvus/internal/proto/indexpb"
+	"github.com/milvus-io/milvus/pkg/common"
+	"github.com/milvus-io/milvus/pkg/log"
+	"github.com/milvus-io/milvus/pkg/util/funcutil"
+	"github.com/milvus-io/milvus/pkg/util/indexparamcheck"
+	"github.com/milvus-io/milvus/pkg/util/merr"
+	"github.com/milvus-io/milvus/pkg/util/typeutil"
+)
+
+// autoIndexPolicy describes the index to create for the vector fields
+// whose data type matches and dim is in range [MinDim, MaxDim],
+// or for the field named FieldName if set, which is used by the collections created from templates.
+type autoIndexPolicy struct {
+	FieldName  string            `json:"field_name,omitempty"`
+	DataType   string            `json:"data_type"`
+	MinDim     int64             `json:"min_dim"`
+	MaxDim     int64             `json:"max_dim"`
+	IndexType  string            `json:"index_type"`
+	MetricType string            `json:"metric_type"`
+	Params     map[string]string `json:"params"`
+}
+
+func (p *autoIndexPolicy) match(dataType schemapb.DataType, dim int64) bool {
+	return p.DataType == dataType.String() &&
+		dim >= p.MinDim &&
+		(p.MaxDim <= 0 || dim <= p.MaxDim)
+}
+
+func (p *autoIndexPolicy) matchField(field *schemapb.FieldSchema, dim int64) bool {
+	if p.FieldName != "" {
+		return p.FieldName == field.GetName()
+	}
+	return p.match(field.GetDataType(), dim)
+}
+
+// indexParams returns the flattened index params like the ones proxy passed.
+func (p *autoIndexPolicy) indexParams() map[string]string {
+	params := make(map[string]string, len(p.Params)+2)
+	for k, v := range p.Params {
+		params[k] = v
+	}
+	params[common.IndexTypeKey] = p.IndexType
+	params[common.MetricTypeKey] = p.MetricType
+	return params
+}
+
+func parseAutoIndexPolicies(value string) ([]*autoIndexPolicy, error) {
+	var policies []*autoIndexPolicy
+	if err := json.Unmarshal([]byte(value), &policies); err != nil {
+		return nil, err
+	}
+	for _, policy := range policies {
+		if policy.FieldName == "" || policy.DataType != "" {
+			if _, ok := schemapb.DataType_value[policy.DataType]; !ok {
+				return nil, errors.Newf("invalid data type %s of auto index policy", policy.DataType)
+			}
+		}
+		checker, err := indexparamcheck.GetIndexCheckerMgrInstance().GetChecker(policy.IndexType)
+		if err != nil {
+			return nil, err
+		}
+		if err := checker.StaticCheck(policy.indexParams()); err != nil {
+			return nil, err
+		}
+	}
+	return policies, nil
+}
+
+// autoIndexOnSealEnabled checks whether to create index automatically for the collection,
+// the collection property overrides the cluster config.
+func (s *Server) autoIndexOnSealEnabled(ctx context.Context, coll *collectionInfo) bool {
+	if v, ok := coll.Properties[common.CollectionAutoIndexOnSealKey]; ok {
+		enabled, err := strconv.ParseBool(v)
+		if err == nil {
+			return enabled
+		}
+		log.Warn("invalid collection auto index property", zap.Int64("collectionID", coll.ID), zap.String("value", v))
+	}
+	if !Params.DataCoordCfg.AutoIndexOnSealEnabled.GetAsBool() {
+		return false
+	}
+
+	databases := lo.Filter(Params.DataCoordCfg.AutoIndexOnSealDatabases.GetAsStrings(), func(name string, _ int) bool {
+		return name != ""
+	})
+	if len(databases) == 0 {
+		return true
+	}
+	resp, err := s.broker.DescribeCollectionInternal(ctx, coll.ID)
+	if err != nil {
+		log.Warn("failed to describe collection for auto index", zap.Int64("collectionID", coll.ID), zap.Error(err))
+		return false
+	}
+	return funcutil.SliceContain(databases, resp.GetDbName())
+}
+
+// autoCreateIndexOnSeal creates the default index for the vector fields without index,
+// so that the search doesn't fall back to brute force if users forget to create index.
+func (s *Server) autoCreateIndexOnSeal(ctx context.Context, segment *SegmentInfo) error {
+	log := log.Ctx(ctx).With(zap.Int64("collectionID", segment.GetCollectionID()), zap.Int64("segmentID", segment.GetID()))
+
+	indexedFields := typeutil.NewUniqueSet()
+	for _, index := range s.meta.GetIndexesForCollection(segment.GetCollectionID(), "") {
+		indexedFields.Insert(index.FieldID)
+	}
+
+	coll, err := s.handler.GetCollection(ctx, segment.GetCollectionID())
+	if err != nil {
+		return err
+	}
+	if coll == nil {
+		return merr.WrapErrCollectionNotFound(segment.GetCollectionID())
+	}
+
+	var fields []*schemapb.FieldSchema
+	for _, field := range coll.Schema.GetFields() {
+		if typeutil.IsVectorType(field.GetDataType()) && !indexedFields.Contain(field.GetFieldID()) {
+			fields = append(fields, field)
+		}
+	}
+	if len(fields) == 0 || !s.autoIndexOnSealEnabled(ctx, coll) {
+		return nil
+	}
+
+	policiesValue := Params.DataCoordCfg.AutoIndexOnSealPolicies.GetValue()
+	if v, ok := coll.Properties[common.CollectionAutoIndexPoliciesKey]; ok {
+		policiesValue = v
+	}
+	policies, err := parseAutoIndexPolicies(policiesValue)
+	if err != nil {
+		log.Warn("invalid auto index policies", zap.Error(err))
+		return err
+	}
+
+	for _, field := range fields {
+		dim, err := typeutil.GetDim(field)
+		if err != nil {
+			return err
+		}
+		var policy *autoIndexPolicy
+		for _, p := range policies {
+			if p.matchField(field, dim) {
+				policy = p
+				break
+			}
+		}
+		if policy == nil {
+			log.Info("no auto index policy matched the field, skip it",
+				zap.Int64("fieldID", field.GetFieldID()), zap.String("dataType", field.GetDataType().String()), zap.Int64("dim", dim))
+			continue
+		}
+
+		ts, err := s.allocator.allocTimestamp(ctx)
+		if err != nil {
+			return err
+		}
+		indexParams := funcutil.Map2KeyValuePair(policy.indexParams())
+		status, err := s.CreateIndex(ctx, &indexpb.CreateIndexRequest{
+			CollectionID:    coll.ID,
+			FieldID:         field.GetFieldID(),
+			IndexName:       Params.CommonCfg.DefaultIndexName.GetValue() + "_" + strconv.FormatInt(field.GetFieldID(), 10),
+			TypeParams:      field.GetTypeParams(),
+			IndexParams:     indexParams,
+			UserIndexParams: indexParams,
+			Timestamp:       ts,
+		})
+		if err = merr.CheckRPCCall(status, err); err != nil {
+			log.Warn("failed to create index automatically", zap.Int64("fieldID", field.GetFieldID()), zap.Error(err))
+			return err
+		}
+		log.Info("index created automatically",
+			zap.Int64("fieldID", field.GetFieldID()),
+			zap.String("indexType", policy.IndexType),
+			zap.String("metricType", policy.MetricType))
+	}
+	return nil
+}
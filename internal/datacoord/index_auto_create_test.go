@@ -0,0 +1,163 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package datacoord
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/milvus-io/milvus-proto/go-api/v2/commonpb"
+	"github.com/milvus-io/milvus-proto/go-api/v2/milvuspb"
+	"github.com/milvus-io/milvus-proto/go-api/v2/schemapb"
+	catalogmocks "github.com/milvus-io/milvus/internal/metastore/mocks"
+	"github.com/milvus-io/milvus/internal/metastore/model"
+	"github.com/milvus-io/milvus/internal/mocks"
+	"github.com/milvus-io/milvus/internal/proto/datapb"
+	"github.com/milvus-io/milvus/pkg/common"
+	"github.com/milvus-io/milvus/pkg/util/merr"
+	"github.com/milvus-io/milvus/pkg/util/paramtable"
+)
+
+func TestParseAutoIndexPolicies(t *testing.T) {
+	policies, err := parseAutoIndexPolicies(Params.DataCoordCfg.AutoIndexOnSealPolicies.GetValue())
+	require.NoError(t, err)
+	require.Len(t, policies, 4)
+	assert.True(t, policies[0].match(schemapb.DataType_FloatVector, 128))
+	assert.False(t, policies[0].match(schemapb.DataType_FloatVector, 8192))
+	assert.True(t, policies[1].match(schemapb.DataType_FloatVector, 8192))
+	assert.False(t, policies[1].match(schemapb.DataType_BinaryVector, 128))
+	assert.True(t, policies[3].match(schemapb.DataType_BinaryVector, 128))
+
+	policies, err = parseAutoIndexPolicies(`[{"field_name": "embedding", "index_type": "HNSW", "metric_type": "COSINE", "params": {"M": "16", "efConstruction": "200"}}]`)
+	require.NoError(t, err)
+	assert.True(t, policies[0].matchField(&schemapb.FieldSchema{Name: "embedding", DataType: schemapb.DataType_FloatVector}, 1536))
+	assert.False(t, policies[0].matchField(&schemapb.FieldSchema{Name: "other", DataType: schemapb.DataType_FloatVector}, 1536))
+
+	_, err = parseAutoIndexPolicies(`invalid`)
+	assert.Error(t, err)
+	_, err = parseAutoIndexPolicies(`[{"data_type": "Vector", "index_type": "HNSW", "metric_type": "L2"}]`)
+	assert.Error(t, err)
+	_, err = parseAutoIndexPolicies(`[{"data_type": "FloatVector", "index_type": "UNKNOWN", "metric_type": "L2"}]`)
+	assert.Error(t, err)
+}
+
+func TestServer_AutoCreateIndexOnSeal(t *testing.T) {
+	var (
+		collID  = UniqueID(1)
+		fieldID = UniqueID(101)
+		ctx     = context.Background()
+		segment = &SegmentInfo{SegmentInfo: &datapb.SegmentInfo{ID: 1000, CollectionID: collID}}
+	)
+	schema := &schemapb.CollectionSchema{
+		Fields: []*schemapb.FieldSchema{
+			{FieldID: 100, DataType: schemapb.DataType_Int64, IsPrimaryKey: true},
+			{
+				FieldID:    fieldID,
+				DataType:   schemapb.DataType_FloatVector,
+				TypeParams: []*commonpb.KeyValuePair{{Key: common.DimKey, Value: "128"}},
+			},
+		},
+	}
+
+	newServer := func(t *testing.T, properties map[string]string) *Server {
+		catalog := catalogmocks.NewDataCoordCatalog(t)
+		catalog.EXPECT().CreateIndex(mock.Anything, mock.Anything).Return(nil).Maybe()
+		s := &Server{
+			meta: &meta{
+				catalog: catalog,
+				collections: map[UniqueID]*collectionInfo{
+					collID: {ID: collID, Schema: schema, Properties: properties},
+				},
+				indexes: map[UniqueID]map[UniqueID]*model.Index{},
+			},
+			allocator:       newMockAllocator(),
+			notifyIndexChan: make(chan UniqueID, 1),
+		}
+		s.handler = newServerHandler(s)
+		s.stateCode.Store(commonpb.StateCode_Healthy)
+		return s
+	}
+
+	t.Run("disabled", func(t *testing.T) {
+		s := newServer(t, nil)
+		assert.NoError(t, s.autoCreateIndexOnSeal(ctx, segment))
+		assert.Empty(t, s.meta.GetIndexesForCollection(collID, ""))
+	})
+
+	t.Run("enabled", func(t *testing.T) {
+		paramtable.Get().Save(Params.DataCoordCfg.AutoIndexOnSealEnabled.Key, "true")
+		defer paramtable.Get().Reset(Params.DataCoordCfg.AutoIndexOnSealEnabled.Key)
+
+		s := newServer(t, nil)
+		assert.NoError(t, s.autoCreateIndexOnSeal(ctx, segment))
+		indexes := s.meta.GetIndexesForCollection(collID, "")
+		require.Len(t, indexes, 1)
+		assert.Equal(t, fieldID, indexes[0].FieldID)
+		assert.Equal(t, "_default_idx_101", indexes[0].IndexName)
+		assert.Equal(t, "HNSW", getIndexType(indexes[0].IndexParams))
+
+		// no more index created for the indexed field
+		assert.NoError(t, s.autoCreateIndexOnSeal(ctx, segment))
+		assert.Len(t, s.meta.GetIndexesForCollection(collID, ""), 1)
+	})
+
+	t.Run("disabled by collection property", func(t *testing.T) {
+		paramtable.Get().Save(Params.DataCoordCfg.AutoIndexOnSealEnabled.Key, "true")
+		defer paramtable.Get().Reset(Params.DataCoordCfg.AutoIndexOnSealEnabled.Key)
+
+		s := newServer(t, map[string]string{common.CollectionAutoIndexOnSealKey: "false"})
+		assert.NoError(t, s.autoCreateIndexOnSeal(ctx, segment))
+		assert.Empty(t, s.meta.GetIndexesForCollection(collID, ""))
+	})
+
+	t.Run("enabled by collection property", func(t *testing.T) {
+		s := newServer(t, map[string]string{common.CollectionAutoIndexOnSealKey: "true"})
+		assert.NoError(t, s.autoCreateIndexOnSeal(ctx, segment))
+		assert.Len(t, s.meta.GetIndexesForCollection(collID, ""), 1)
+	})
+
+	t.Run("database not matched", func(t *testing.T) {
+		paramtable.Get().Save(Params.DataCoordCfg.AutoIndexOnSealEnabled.Key, "true")
+		defer paramtable.Get().Reset(Params.DataCoordCfg.AutoIndexOnSealEnabled.Key)
+		paramtable.Get().Save(Params.DataCoordCfg.AutoIndexOnSealDatabases.Key, "db1,db2")
+		defer paramtable.Get().Reset(Params.DataCoordCfg.AutoIndexOnSealDatabases.Key)
+
+		rootCoord := mocks.NewMockRootCoordClient(t)
+		rootCoord.EXPECT().DescribeCollectionInternal(mock.Anything, mock.Anything).Return(&milvuspb.DescribeCollectionResponse{
+			Status:       merr.Success(),
+			CollectionID: collID,
+			DbName:       "default",
+		}, nil)
+		s := newServer(t, nil)
+		s.broker = NewCoordinatorBroker(rootCoord)
+		assert.NoError(t, s.autoCreateIndexOnSeal(ctx, segment))
+		assert.Empty(t, s.meta.GetIndexesForCollection(collID, ""))
+	})
+
+	t.Run("collection not found", func(t *testing.T) {
+		s := newServer(t, nil)
+		s.meta.collections = map[UniqueID]*collectionInfo{}
+		rootCoord := mocks.NewMockRootCoordClient(t)
+		rootCoord.EXPECT().DescribeCollectionInternal(mock.Anything, mock.Anything).Return(nil, merr.WrapErrCollectionNotFound(collID))
+		s.broker = NewCoordinatorBroker(rootCoord)
+		assert.Error(t, s.autoCreateIndexOnSeal(ctx, segment))
+	})
+}
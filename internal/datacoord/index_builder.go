@@ -74,6 +74,8 @@ type indexBuilder struct {
 	// TODO @xiaocai2333: use priority queue
 	tasks      map[int64]indexTaskState
 	notifyChan chan struct{}
+	// the latest progress reported by IndexNodes of the in progress tasks
+	progress map[int64]*indexpb.IndexTaskInfo
 
 	meta *meta
 
@@ -96,6 +98,7 @@ func newIndexBuilder(
 		cancel:                    cancel,
 		meta:                      metaTable,
 		tasks:                     make(map[int64]indexTaskState),
+		progress:                  make(map[int64]*indexpb.IndexTaskInfo),
 		notifyChan:                make(chan struct{}, 1),
 		scheduleDuration:          Params.DataCoordCfg.IndexTaskSchedulerInterval.GetAsDuration(time.Millisecond),
 		policy:                    defaultBuildIndexPolicy,
@@ -206,12 +209,16 @@ func (ib *indexBuilder) process(buildID UniqueID) bool {
 		ib.taskMutex.Lock()
 		defer ib.taskMutex.Unlock()
 		ib.tasks[buildID] = state
+		if state != indexTaskInProgress {
+			delete(ib.progress, buildID)
+		}
 	}
 
 	deleteFunc := func(buildID UniqueID) {
 		ib.taskMutex.Lock()
 		defer ib.taskMutex.Unlock()
 		delete(ib.tasks, buildID)
+		delete(ib.progress, buildID)
 	}
 
 	meta, exist := ib.meta.GetIndexJob(buildID)
@@ -384,6 +391,7 @@ func (ib *indexBuilder) getTaskState(buildID, nodeID UniqueID) indexTaskState {
 					log.Ctx(ib.ctx).Info("this task should be retry", zap.Int64("buildID", buildID), zap.String("fail reason", info.GetFailReason()))
 					return indexTaskRetry
 				}
+				ib.storeTaskProgress(info)
 				return indexTaskInProgress
 			}
 		}
@@ -397,6 +405,26 @@ func (ib *indexBuilder) getTaskState(buildID, nodeID UniqueID) indexTaskState {
 	return indexTaskRetry
 }
 
+func (ib *indexBuilder) storeTaskProgress(info *indexpb.IndexTaskInfo) {
+	ib.taskMutex.Lock()
+	defer ib.taskMutex.Unlock()
+	ib.progress[info.GetBuildID()] = &indexpb.IndexTaskInfo{
+		BuildID:                   info.GetBuildID(),
+		State:                     info.GetState(),
+		Phase:                     info.GetPhase(),
+		ProcessedRows:             info.GetProcessedRows(),
+		TotalRows:                 info.GetTotalRows(),
+		EstimatedRemainingSeconds: info.GetEstimatedRemainingSeconds(),
+	}
+}
+
+// getTaskProgress returns the latest progress of the in progress task, nil if not reported yet.
+func (ib *indexBuilder) getTaskProgress(buildID UniqueID) *indexpb.IndexTaskInfo {
+	ib.taskMutex.RLock()
+	defer ib.taskMutex.RUnlock()
+	return ib.progress[buildID]
+}
+
 func (ib *indexBuilder) dropIndexTask(buildID, nodeID UniqueID) bool {
 	client, exist := ib.nodeManager.GetClientByID(nodeID)
 	if exist {
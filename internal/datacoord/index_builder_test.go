@@ -737,6 +737,7 @@ func TestIndexBuilder_Error(t *testing.T) {
 		tasks: map[int64]indexTaskState{
 			buildID: indexTaskInit,
 		},
+		progress:                  make(map[int64]*indexpb.IndexTaskInfo),
 		meta:                      createMetaTable(ec),
 		chunkManager:              chunkManager,
 		indexEngineVersionManager: newIndexEngineVersionManager(),
@@ -539,6 +539,8 @@ func (m *meta) FinishTask(taskInfo *indexpb.IndexTaskInfo) error {
 		return err
 	}
 
+	m.sizeEstimator.onBuildFinished(segIdx.CollectionID, segIdx.BuildID, segIdx.NumRows,
+		taskInfo.GetState() == commonpb.IndexState_Finished)
 	log.Info("finish index task success", zap.Int64("buildID", taskInfo.GetBuildID()),
 		zap.String("state", taskInfo.GetState().String()), zap.String("fail reason", taskInfo.GetFailReason()),
 		zap.Int32("current_index_version", taskInfo.GetCurrentIndexVersion()),
@@ -595,6 +597,7 @@ func (m *meta) BuildIndex(buildID UniqueID) error {
 	if err := m.updateSegIndexMeta(segIdx, updateFunc); err != nil {
 		return err
 	}
+	m.sizeEstimator.onBuildStarted(buildID)
 	log.Info("meta update: segment index in progress success", zap.Int64("buildID", segIdx.BuildID),
 		zap.Int64("segmentID", segIdx.SegmentID))
 
@@ -0,0 +1,85 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package datacoord
+
+import (
+	"math"
+
+	"github.com/milvus-io/milvus-proto/go-api/v2/commonpb"
+	"github.com/milvus-io/milvus/internal/proto/indexpb"
+)
+
+// indexProgressEstimator estimates the build progress and remaining time of an index
+// by the progress of the in progress tasks reported by IndexNodes.
+type indexProgressEstimator struct {
+	// the estimated indexed rows of the in progress segments
+	buildingRows float64
+	// the max remaining seconds of the in progress tasks
+	maxRemainingSeconds int64
+	// the sum of the build speed of the in progress tasks
+	rowsPerSecond float64
+}
+
+// addTask counts the progress of an in progress task building the segment of segmentRows.
+func (e *indexProgressEstimator) addTask(segmentRows int64, progress *indexpb.IndexTaskInfo) {
+	if progress == nil || progress.GetTotalRows() <= 0 {
+		return
+	}
+	ratio := math.Min(float64(progress.GetProcessedRows())/float64(progress.GetTotalRows()), 1)
+	e.buildingRows += ratio * float64(segmentRows)
+	remaining := progress.GetEstimatedRemainingSeconds()
+	if remaining <= 0 {
+		return
+	}
+	if remaining > e.maxRemainingSeconds {
+		e.maxRemainingSeconds = remaining
+	}
+	e.rowsPerSecond += (1 - ratio) * float64(segmentRows) / float64(remaining)
+}
+
+// complete fills the progress percentage and the estimated remaining seconds of the index,
+// the remaining seconds is 0 if it can't be estimated, e.g. no task has reported its progress.
+func (e *indexProgressEstimator) complete(indexInfo *indexpb.IndexInfo) {
+	if indexInfo.GetState() == commonpb.IndexState_Finished {
+		indexInfo.Progress = 100
+		indexInfo.EstimatedRemainingSeconds = 0
+		return
+	}
+	totalRows := float64(indexInfo.GetTotalRows())
+	if totalRows <= 0 {
+		return
+	}
+	doneRows := math.Min(float64(indexInfo.GetIndexedRows())+e.buildingRows, totalRows)
+	indexInfo.Progress = float32(doneRows * 100 / totalRows)
+	if e.rowsPerSecond <= 0 {
+		return
+	}
+	// the rows not built yet are assumed to be built at the current speed of the index nodes
+	remaining := int64(math.Ceil((totalRows - doneRows) / e.rowsPerSecond))
+	if remaining < e.maxRemainingSeconds {
+		remaining = e.maxRemainingSeconds
+	}
+	indexInfo.EstimatedRemainingSeconds = remaining
+}
+
+// getIndexTaskProgress returns the progress of the in progress index task reported by IndexNode.
+func (s *Server) getIndexTaskProgress(buildID UniqueID) *indexpb.IndexTaskInfo {
+	if s.indexBuilder == nil {
+		return nil
+	}
+	return s.indexBuilder.getTaskProgress(buildID)
+}
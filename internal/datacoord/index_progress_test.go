@@ -0,0 +1,84 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package datacoord
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/milvus-io/milvus-proto/go-api/v2/commonpb"
+	"github.com/milvus-io/milvus/internal/proto/indexpb"
+)
+
+func TestIndexProgressEstimator(t *testing.T) {
+	t.Run("finished", func(t *testing.T) {
+		indexInfo := &indexpb.IndexInfo{State: commonpb.IndexState_Finished}
+		(&indexProgressEstimator{}).complete(indexInfo)
+		assert.EqualValues(t, 100, indexInfo.GetProgress())
+		assert.EqualValues(t, 0, indexInfo.GetEstimatedRemainingSeconds())
+	})
+
+	t.Run("no progress reported", func(t *testing.T) {
+		estimator := &indexProgressEstimator{}
+		estimator.addTask(1000, nil)
+		estimator.addTask(1000, &indexpb.IndexTaskInfo{})
+		indexInfo := &indexpb.IndexInfo{State: commonpb.IndexState_InProgress, IndexedRows: 1000, TotalRows: 4000}
+		estimator.complete(indexInfo)
+		assert.EqualValues(t, 25, indexInfo.GetProgress())
+		assert.EqualValues(t, 0, indexInfo.GetEstimatedRemainingSeconds())
+	})
+
+	t.Run("in progress", func(t *testing.T) {
+		estimator := &indexProgressEstimator{}
+		// half built, 500 rows left in 10 seconds
+		estimator.addTask(1000, &indexpb.IndexTaskInfo{ProcessedRows: 50, TotalRows: 100, EstimatedRemainingSeconds: 10})
+		// just started, 1000 rows left in 20 seconds
+		estimator.addTask(1000, &indexpb.IndexTaskInfo{ProcessedRows: 0, TotalRows: 100, EstimatedRemainingSeconds: 20})
+		indexInfo := &indexpb.IndexInfo{State: commonpb.IndexState_InProgress, IndexedRows: 1500, TotalRows: 5000}
+		estimator.complete(indexInfo)
+		assert.EqualValues(t, 40, indexInfo.GetProgress())
+		// 3000 rows left at 100 rows per second
+		assert.EqualValues(t, 30, indexInfo.GetEstimatedRemainingSeconds())
+	})
+
+	t.Run("bounded by the slowest task", func(t *testing.T) {
+		estimator := &indexProgressEstimator{}
+		estimator.addTask(1000, &indexpb.IndexTaskInfo{ProcessedRows: 10, TotalRows: 100, EstimatedRemainingSeconds: 100})
+		estimator.addTask(1000, &indexpb.IndexTaskInfo{ProcessedRows: 90, TotalRows: 100, EstimatedRemainingSeconds: 1})
+		indexInfo := &indexpb.IndexInfo{State: commonpb.IndexState_InProgress, TotalRows: 2000}
+		estimator.complete(indexInfo)
+		assert.EqualValues(t, 50, indexInfo.GetProgress())
+		assert.EqualValues(t, 100, indexInfo.GetEstimatedRemainingSeconds())
+	})
+}
+
+func TestIndexBuilder_TaskProgress(t *testing.T) {
+	ib := &indexBuilder{progress: make(map[int64]*indexpb.IndexTaskInfo)}
+	assert.Nil(t, ib.getTaskProgress(1))
+
+	ib.storeTaskProgress(&indexpb.IndexTaskInfo{BuildID: 1, Phase: "build", ProcessedRows: 10, TotalRows: 100, IndexFileKeys: []string{"file"}})
+	progress := ib.getTaskProgress(1)
+	assert.Equal(t, "build", progress.GetPhase())
+	assert.EqualValues(t, 10, progress.GetProcessedRows())
+	assert.Empty(t, progress.GetIndexFileKeys())
+
+	s := &Server{}
+	assert.Nil(t, s.getIndexTaskProgress(1))
+	s.indexBuilder = ib
+	assert.NotNil(t, s.getIndexTaskProgress(1))
+}
@@ -124,6 +124,9 @@ func (s *Server) createIndexForSegmentLoop(ctx context.Context) {
 				log.Warn("segment is not exist, no need to build index", zap.Int64("segmentID", segID))
 				continue
 			}
+			if err := s.autoCreateIndexOnSeal(ctx, segment); err != nil {
+				log.Warn("failed to create index automatically", zap.Int64("segmentID", segment.ID), zap.Error(err))
+			}
 			if err := s.createIndexesForSegment(segment); err != nil {
 				log.Warn("create index for segment fail, wait for retry", zap.Int64("segmentID", segment.ID))
 				continue
@@ -358,6 +361,7 @@ func (s *Server) completeIndexInfo(indexInfo *indexpb.IndexInfo, index *model.In
 		totalRows        = int64(0)
 		indexedRows      = int64(0)
 		pendingIndexRows = int64(0)
+		estimator        = &indexProgressEstimator{}
 	)
 
 	for _, seg := range segments {
@@ -390,6 +394,7 @@ func (s *Server) completeIndexInfo(indexInfo *indexpb.IndexInfo, index *model.In
 			cntUnissued++
 		case commonpb.IndexState_InProgress:
 			cntInProgress++
+			estimator.addTask(seg.GetNumOfRows(), s.getIndexTaskProgress(segIdx.BuildID))
 		case commonpb.IndexState_Finished:
 			cntFinished++
 			indexedRows += seg.NumOfRows
@@ -417,10 +422,12 @@ func (s *Server) completeIndexInfo(indexInfo *indexpb.IndexInfo, index *model.In
 	default:
 		indexInfo.State = commonpb.IndexState_Finished
 	}
+	estimator.complete(indexInfo)
 
 	log.Info("completeIndexInfo success", zap.Int64("collectionID", index.CollectionID), zap.Int64("indexID", index.IndexID),
 		zap.Int64("totalRows", indexInfo.TotalRows), zap.Int64("indexRows", indexInfo.IndexedRows),
 		zap.Int64("pendingIndexRows", indexInfo.PendingIndexRows),
+		zap.Float32("progress", indexInfo.Progress), zap.Int64("estimatedRemainingSeconds", indexInfo.EstimatedRemainingSeconds),
 		zap.String("state", indexInfo.State.String()), zap.String("failReason", indexInfo.IndexStateFailReason))
 }
 
@@ -469,10 +476,12 @@ func (s *Server) GetIndexBuildProgress(ctx context.Context, req *indexpb.GetInde
 	log.Info("GetIndexBuildProgress success", zap.Int64("collectionID", req.GetCollectionID()),
 		zap.String("indexName", req.GetIndexName()))
 	return &indexpb.GetIndexBuildProgressResponse{
-		Status:           merr.Success(),
-		IndexedRows:      indexInfo.IndexedRows,
-		TotalRows:        indexInfo.TotalRows,
-		PendingIndexRows: indexInfo.PendingIndexRows,
+		Status:                    merr.Success(),
+		IndexedRows:               indexInfo.IndexedRows,
+		TotalRows:                 indexInfo.TotalRows,
+		PendingIndexRows:          indexInfo.PendingIndexRows,
+		Progress:                  indexInfo.Progress,
+		EstimatedRemainingSeconds: indexInfo.EstimatedRemainingSeconds,
 	}, nil
 }
 
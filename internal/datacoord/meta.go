@@ -21,6 +21,7 @@ import (
 	"context"
 	"fmt"
 	"path"
+	"strconv"
 	"sync"
 	"time"
 
@@ -39,9 +40,11 @@ import (
 	"github.com/milvus-io/milvus/internal/storage"
 	"github.com/milvus-io/milvus/internal/util/segmentutil"
 	"github.com/milvus-io/milvus/pkg/common"
+	"github.com/milvus-io/milvus/pkg/eventlog"
 	"github.com/milvus-io/milvus/pkg/log"
 	"github.com/milvus-io/milvus/pkg/metrics"
 	"github.com/milvus-io/milvus/pkg/util/metautil"
+	"github.com/milvus-io/milvus/pkg/util/paramtable"
 	"github.com/milvus-io/milvus/pkg/util/timerecord"
 	"github.com/milvus-io/milvus/pkg/util/tsoutil"
 	"github.com/milvus-io/milvus/pkg/util/typeutil"
@@ -62,6 +65,9 @@ type meta struct {
 	// buildID2Meta records the meta information of the segment
 	// buildID -> segmentIndex
 	buildID2SegmentIndex map[UniqueID]*model.SegmentIndex
+
+	// sizeEstimator records the index build speed to adjust the segment size
+	sizeEstimator *segmentSizeEstimator
 }
 
 // A local cache of segment metric update. Must call commit() to take effect.
@@ -91,6 +97,7 @@ func newMeta(ctx context.Context, catalog metastore.DataCoordCatalog, chunkManag
 		chunkManager:         chunkManager,
 		indexes:              make(map[UniqueID]map[UniqueID]*model.Index),
 		buildID2SegmentIndex: make(map[UniqueID]*model.SegmentIndex),
+		sizeEstimator:        newSegmentSizeEstimator(),
 	}
 	err := mt.reloadFromKV()
 	if err != nil {
@@ -404,6 +411,9 @@ func (m *meta) SetState(segmentID UniqueID, targetState commonpb.SegmentState) e
 		metricMutation.commit()
 		// Update in-memory meta.
 		m.segments.SetState(segmentID, targetState)
+		if curSegInfo.GetState() != targetState {
+			publishSegmentStateEvent(curSegInfo, targetState)
+		}
 	}
 	log.Info("meta update: setting segment state - complete",
 		zap.Int64("segmentID", segmentID),
@@ -411,6 +421,30 @@ func (m *meta) SetState(segmentID UniqueID, targetState commonpb.SegmentState) e
 	return nil
 }
 
+// publishSegmentStateEvent publishes the event of the segment changed to the sealed, flushed or dropped state.
+func publishSegmentStateEvent(segment *SegmentInfo, state commonpb.SegmentState) {
+	var eventType eventlog.EventType
+	switch state {
+	case commonpb.SegmentState_Sealed:
+		eventType = eventlog.EventSegmentSealed
+	case commonpb.SegmentState_Flushed:
+		eventType = eventlog.EventSegmentFlushed
+	case commonpb.SegmentState_Dropped:
+		eventType = eventlog.EventSegmentDropped
+	default:
+		return
+	}
+	eventlog.Publish(&eventlog.ClusterEvent{
+		EventType:    eventType,
+		Role:         typeutil.DataCoordRole,
+		NodeID:       paramtable.GetNodeID(),
+		CollectionID: segment.GetCollectionID(),
+		Channel:      segment.GetInsertChannel(),
+		SegmentIDs:   []int64{segment.GetID()},
+		Attrs:        map[string]string{"num_rows": strconv.FormatInt(segment.GetNumOfRows(), 10)},
+	})
+}
+
 // UnsetIsImporting removes the `isImporting` flag of a segment.
 func (m *meta) UnsetIsImporting(segmentID UniqueID) error {
 	log.Debug("meta update: unsetting isImport state of segment",
@@ -434,11 +468,45 @@ func (m *meta) UnsetIsImporting(segmentID UniqueID) error {
 	}
 	// Update in-memory meta.
 	m.segments.SetIsImporting(segmentID, false)
+	if curSegInfo.GetState() == commonpb.SegmentState_Flushed {
+		publishSegmentStateEvent(curSegInfo, commonpb.SegmentState_Flushed)
+	}
 	log.Info("meta update: unsetting isImport state of segment - complete",
 		zap.Int64("segmentID", segmentID))
 	return nil
 }
 
+// UpdateSegmentsChannel moves the flushed segments to the channel, which doesn't touch the binlogs.
+func (m *meta) UpdateSegmentsChannel(segmentIDs []UniqueID, channel string) error {
+	m.Lock()
+	defer m.Unlock()
+	segments := make([]*SegmentInfo, 0, len(segmentIDs))
+	for _, segmentID := range segmentIDs {
+		segment := m.segments.GetSegment(segmentID)
+		if segment == nil || !isSegmentHealthy(segment) {
+			return fmt.Errorf("segment not found %d", segmentID)
+		}
+		if segment.GetState() != commonpb.SegmentState_Flushed {
+			return fmt.Errorf("segment %d is not flushed, state %s", segmentID, segment.GetState().String())
+		}
+		cloned := segment.Clone()
+		cloned.InsertChannel = channel
+		segments = append(segments, cloned)
+	}
+
+	if err := m.catalog.AlterSegments(m.ctx, lo.Map(segments, func(segment *SegmentInfo, _ int) *datapb.SegmentInfo {
+		return segment.SegmentInfo
+	})); err != nil {
+		log.Warn("meta update: move segments channel failed", zap.Int64s("segmentIDs", segmentIDs), zap.Error(err))
+		return err
+	}
+	for _, segment := range segments {
+		m.segments.SetSegment(segment.GetID(), segment)
+	}
+	log.Info("meta update: move segments channel - complete", zap.Int64s("segmentIDs", segmentIDs), zap.String("channel", channel))
+	return nil
+}
+
 // UpdateFlushSegmentsInfo update segment partial/completed flush info
 // `flushed` parameter indicating whether segment is flushed completely or partially
 // `binlogs`, `checkpoints` and `statPositions` are persistence data for segment
@@ -516,6 +584,13 @@ func (m *meta) UpdateFlushSegmentsInfo(
 		if fieldStatsLog == nil {
 			currStatsLogs = append(currStatsLogs, tStatsLogs)
 		} else {
+			// the compound stats log is rewritten on every sync once the stats logs merged,
+			// remove the stale one so that the stats logs synced after it keep following it
+			fieldStatsLog.Binlogs = lo.Filter(fieldStatsLog.Binlogs, func(binlog *datapb.Binlog, _ int) bool {
+				return !lo.ContainsBy(tStatsLogs.GetBinlogs(), func(statsLog *datapb.Binlog) bool {
+					return statsLog.GetLogPath() == binlog.GetLogPath()
+				})
+			})
 			fieldStatsLog.Binlogs = append(fieldStatsLog.Binlogs, tStatsLogs.Binlogs...)
 		}
 	}
@@ -614,6 +689,9 @@ func (m *meta) UpdateFlushSegmentsInfo(
 	for id, s := range modSegments {
 		m.segments.SetSegment(id, s)
 	}
+	if dropped {
+		publishSegmentStateEvent(segment, commonpb.SegmentState_Dropped)
+	}
 	log.Info("meta update: update flush segments info - update flush segments info successfully",
 		zap.Int64("segmentID", segmentID))
 	return nil
@@ -1142,6 +1220,103 @@ func (m *meta) alterMetaStoreAfterCompaction(segmentCompactTo *SegmentInfo, segm
 	return nil
 }
 
+// CompleteLevelZeroCompaction appends the deltalogs produced by a LevelZero compaction to the
+// target segments and marks the compacted L0 segments as dropped, in one meta store transaction.
+func (m *meta) CompleteLevelZeroCompaction(plan *datapb.CompactionPlan, result *datapb.CompactionResult) error {
+	m.Lock()
+	defer m.Unlock()
+
+	log := log.With(zap.Int64("planID", plan.GetPlanID()))
+	metricMutation := &segMetricMutation{
+		stateChange: make(map[string]int),
+	}
+
+	var (
+		modSegments = make([]*SegmentInfo, 0, len(plan.GetSegmentBinlogs()))
+		increments  = make([]metastore.BinlogsIncrement, 0, len(result.GetLevelZeroSegments()))
+	)
+	for _, target := range result.GetLevelZeroSegments() {
+		segment := m.segments.GetSegment(target.GetSegmentID())
+		if !isSegmentHealthy(segment) {
+			return fmt.Errorf("target segment %d of level zero compaction is not healthy", target.GetSegmentID())
+		}
+		cloned := segment.Clone()
+		cloned.Deltalogs = m.updateBinlogs(cloned.GetDeltalogs(), nil, target.GetDeltalogs())
+		modSegments = append(modSegments, cloned)
+		increments = append(increments, metastore.BinlogsIncrement{Segment: cloned.SegmentInfo})
+	}
+
+	for _, l0 := range plan.GetSegmentBinlogs() {
+		if l0.GetLevel() != datapb.SegmentLevel_L0 {
+			continue
+		}
+		segment := m.segments.GetSegment(l0.GetSegmentID())
+		if segment == nil {
+			continue
+		}
+		cloned := segment.Clone()
+		updateSegStateAndPrepareMetrics(cloned, commonpb.SegmentState_Dropped, metricMutation)
+		cloned.DroppedAt = uint64(time.Now().UnixNano())
+		cloned.Compacted = true
+		modSegments = append(modSegments, cloned)
+	}
+
+	modInfos := lo.Map(modSegments, func(segment *SegmentInfo, _ int) *datapb.SegmentInfo { return segment.SegmentInfo })
+	if err := m.catalog.AlterSegments(m.ctx, modInfos, increments...); err != nil {
+		log.Warn("meta update: complete level zero compaction - failed to alter segments", zap.Error(err))
+		return err
+	}
+	metricMutation.commit()
+	for _, segment := range modSegments {
+		m.segments.SetSegment(segment.GetID(), segment)
+	}
+	log.Info("meta update: complete level zero compaction - complete",
+		zap.Int64s("segments", lo.Map(modInfos, func(segment *datapb.SegmentInfo, _ int) int64 { return segment.GetID() })))
+	return nil
+}
+
+// ReplaceSegmentBinlogs replaces the insert binlogs of the segment with the consolidated ones,
+// it fails if the segment is no longer healthy or its binlogs are changed since consolidation started.
+func (m *meta) ReplaceSegmentBinlogs(segmentID UniqueID, origin []*datapb.FieldBinlog, consolidated []*datapb.FieldBinlog) error {
+	m.Lock()
+	defer m.Unlock()
+
+	segment := m.segments.GetSegment(segmentID)
+	if !isSegmentHealthy(segment) {
+		return fmt.Errorf("segment %d is not healthy", segmentID)
+	}
+	if !sameBinlogIDs(segment.GetBinlogs(), origin) {
+		return fmt.Errorf("binlogs of segment %d changed during consolidation", segmentID)
+	}
+	cloned := segment.Clone()
+	cloned.Binlogs = consolidated
+	if err := m.catalog.AlterSegments(m.ctx, []*datapb.SegmentInfo{cloned.SegmentInfo},
+		metastore.BinlogsIncrement{Segment: cloned.SegmentInfo}); err != nil {
+		log.Warn("meta update: replace segment binlogs - failed to alter segments",
+			zap.Int64("segmentID", segmentID), zap.Error(err))
+		return err
+	}
+	m.segments.SetSegment(segmentID, cloned)
+	return nil
+}
+
+func sameBinlogIDs(a, b []*datapb.FieldBinlog) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := range a {
+		if a[i].GetFieldID() != b[i].GetFieldID() || len(a[i].GetBinlogs()) != len(b[i].GetBinlogs()) {
+			return false
+		}
+		for j := range a[i].GetBinlogs() {
+			if a[i].GetBinlogs()[j].GetLogID() != b[i].GetBinlogs()[j].GetLogID() {
+				return false
+			}
+		}
+	}
+	return true
+}
+
 func (m *meta) updateBinlogs(origin []*datapb.FieldBinlog, removes []*datapb.FieldBinlog, adds []*datapb.FieldBinlog) []*datapb.FieldBinlog {
 	fieldBinlogs := make(map[int64]map[string]*datapb.Binlog)
 	for _, f := range origin {
@@ -1302,6 +1477,13 @@ func (m *meta) GetChannelCheckpoint(vChannel string) *msgpb.MsgPosition {
 	return proto.Clone(m.channelCPs[vChannel]).(*msgpb.MsgPosition)
 }
 
+// GetChannelCheckpointChannels returns the vchannels having checkpoint.
+func (m *meta) GetChannelCheckpointChannels() []string {
+	m.RLock()
+	defer m.RUnlock()
+	return lo.Keys(m.channelCPs)
+}
+
 func (m *meta) DropChannelCheckpoint(vChannel string) error {
 	m.Lock()
 	defer m.Unlock()
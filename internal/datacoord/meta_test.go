@@ -38,6 +38,7 @@ import (
 	"github.com/milvus-io/milvus/internal/proto/datapb"
 	"github.com/milvus-io/milvus/pkg/common"
 	"github.com/milvus-io/milvus/pkg/metrics"
+	"github.com/milvus-io/milvus/pkg/util/metautil"
 	"github.com/milvus-io/milvus/pkg/util/paramtable"
 	"github.com/milvus-io/milvus/pkg/util/testutils"
 )
@@ -526,6 +527,28 @@ func TestUpdateFlushSegmentsInfo(t *testing.T) {
 		assert.Equal(t, updated.NumOfRows, expected.NumOfRows)
 	})
 
+	t.Run("replace the compound stats log", func(t *testing.T) {
+		meta, err := newMemoryMeta()
+		assert.NoError(t, err)
+
+		compound := metautil.BuildStatsLogPath("statslog", 10, 100, 1, 1000, 1)
+		segment1 := &SegmentInfo{SegmentInfo: &datapb.SegmentInfo{
+			ID: 1, State: commonpb.SegmentState_Growing,
+			Statslogs: []*datapb.FieldBinlog{getFieldBinlogPaths(1000, getStatsLogPath("statslog0", 1), compound, getStatsLogPath("statslog1", 1))},
+		}}
+		err = meta.AddSegment(context.TODO(), segment1)
+		assert.NoError(t, err)
+
+		err = meta.UpdateFlushSegmentsInfo(1, false, false, false, nil,
+			[]*datapb.FieldBinlog{{FieldID: 1000, Binlogs: []*datapb.Binlog{{LogPath: compound, LogSize: 100}}}}, nil, nil, nil)
+		assert.NoError(t, err)
+
+		statslogs := meta.GetHealthySegment(1).GetStatslogs()[0].GetBinlogs()
+		assert.Len(t, statslogs, 3)
+		assert.Equal(t, compound, statslogs[2].GetLogPath())
+		assert.EqualValues(t, 100, statslogs[2].GetLogSize())
+	})
+
 	t.Run("update non-existed segment", func(t *testing.T) {
 		meta, err := newMemoryMeta()
 		assert.NoError(t, err)
@@ -18,14 +18,19 @@ package datacoord
 
 import (
 	"context"
+	"sort"
+	"sync"
+	"time"
 
 	"github.com/cockroachdb/errors"
+	"github.com/samber/lo"
 	"go.uber.org/zap"
 
 	"github.com/milvus-io/milvus-proto/go-api/v2/commonpb"
 	"github.com/milvus-io/milvus-proto/go-api/v2/milvuspb"
 	"github.com/milvus-io/milvus/internal/types"
 	"github.com/milvus-io/milvus/pkg/log"
+	"github.com/milvus-io/milvus/pkg/util/funcutil"
 	"github.com/milvus-io/milvus/pkg/util/hardware"
 	"github.com/milvus-io/milvus/pkg/util/merr"
 	"github.com/milvus-io/milvus/pkg/util/metricsinfo"
@@ -58,25 +63,69 @@ func (s *Server) getSystemInfoMetrics(
 		ConnectedIndexNodes: make([]metricsinfo.IndexNodeInfos, 0),
 	}
 
-	// for each data node, fetch metrics info
-	for _, node := range nodes {
-		infos, err := s.getDataNodeMetrics(ctx, req, node)
-		if err != nil {
-			log.Warn("fails to get DataNode metrics", zap.Error(err))
-			continue
+	// fetch metrics info of the nodes in parallel, the slow ones are tagged instead of failing the response
+	timeout := Params.CommonCfg.MetricsCollectTimeout.GetAsDuration(time.Millisecond)
+	parallelism := Params.CommonCfg.MetricsCollectParallelism.GetAsInt()
+	indexNodes := s.indexNodeManager.GetAllClients()
+	indexNodeIDs := lo.Keys(indexNodes)
+	sort.Slice(indexNodeIDs, func(i, j int) bool { return indexNodeIDs[i] < indexNodeIDs[j] })
+
+	var (
+		wg                sync.WaitGroup
+		dataNodeSnapshot  *funcutil.MetricsSnapshot[metricsinfo.DataNodeInfos]
+		indexNodeSnapshot *funcutil.MetricsSnapshot[metricsinfo.IndexNodeInfos]
+	)
+	wg.Add(2)
+	go func() {
+		defer wg.Done()
+		dataNodeSnapshot = funcutil.CollectMetricsParallel(ctx, nodes, timeout, parallelism,
+			func(ctx context.Context, node *Session) (metricsinfo.DataNodeInfos, error) {
+				return s.getDataNodeMetrics(ctx, req, node)
+			})
+	}()
+	go func() {
+		defer wg.Done()
+		indexNodeSnapshot = funcutil.CollectMetricsParallel(ctx, indexNodeIDs, timeout, parallelism,
+			func(ctx context.Context, nodeID UniqueID) (metricsinfo.IndexNodeInfos, error) {
+				return s.getIndexNodeMetrics(ctx, req, indexNodes[nodeID])
+			})
+	}()
+	wg.Wait()
+
+	for i, result := range dataNodeSnapshot.Results {
+		infos := result.Value
+		if result.Err != nil {
+			log.Warn("fails to get DataNode metrics", zap.Int64("nodeID", nodes[i].info.NodeID), zap.Error(result.Err))
+			infos = metricsinfo.DataNodeInfos{
+				BaseComponentInfos: metricsinfo.BaseComponentInfos{
+					HasError:    true,
+					ErrorReason: result.Err.Error(),
+					Name:        metricsinfo.ConstructComponentName(typeutil.DataNodeRole, nodes[i].info.NodeID),
+					ID:          nodes[i].info.NodeID,
+				},
+			}
 		}
+		clusterTopology.Partial = clusterTopology.Partial || infos.HasError
 		clusterTopology.ConnectedDataNodes = append(clusterTopology.ConnectedDataNodes, infos)
 	}
 
-	indexNodes := s.indexNodeManager.GetAllClients()
-	for _, node := range indexNodes {
-		infos, err := s.getIndexNodeMetrics(ctx, req, node)
-		if err != nil {
-			log.Warn("fails to get IndexNode metrics", zap.Error(err))
-			continue
+	for i, result := range indexNodeSnapshot.Results {
+		infos := result.Value
+		if result.Err != nil {
+			log.Warn("fails to get IndexNode metrics", zap.Int64("nodeID", indexNodeIDs[i]), zap.Error(result.Err))
+			infos = metricsinfo.IndexNodeInfos{
+				BaseComponentInfos: metricsinfo.BaseComponentInfos{
+					HasError:    true,
+					ErrorReason: result.Err.Error(),
+					Name:        metricsinfo.ConstructComponentName(typeutil.IndexNodeRole, indexNodeIDs[i]),
+					ID:          indexNodeIDs[i],
+				},
+			}
 		}
+		clusterTopology.Partial = clusterTopology.Partial || infos.HasError
 		clusterTopology.ConnectedIndexNodes = append(clusterTopology.ConnectedIndexNodes, infos)
 	}
+	clusterTopology.SnapshotTime = dataNodeSnapshot.SnapshotTime.String()
 
 	// compose topolgoy struct
 	coordTopology := metricsinfo.DataCoordTopology{
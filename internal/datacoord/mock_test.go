@@ -155,6 +155,7 @@ func newTestSchema() *schemapb.CollectionSchema {
 type mockDataNodeClient struct {
 	id                   int64
 	state                commonpb.StateCode
+	subStates            []*milvuspb.ComponentInfo
 	ch                   chan interface{}
 	compactionStateResp  *datapb.CompactionStateResponse
 	addImportSegmentResp *datapb.AddImportSegmentResponse
@@ -194,6 +195,7 @@ func (c *mockDataNodeClient) GetComponentStates(ctx context.Context, req *milvus
 			NodeID:    c.id,
 			StateCode: c.state,
 		},
+		SubcomponentStates: c.subStates,
 	}, nil
 }
 
@@ -656,6 +658,15 @@ func (h *mockCompactionHandler) getCompactionTasksBySignalID(signalID int64) []*
 	panic("not implemented")
 }
 
+func (h *mockCompactionHandler) cancelCompaction(planID int64) error {
+	if f, ok := h.methods["cancelCompaction"]; ok {
+		if ff, ok := f.(func(planID int64) error); ok {
+			return ff(planID)
+		}
+	}
+	panic("not implemented")
+}
+
 type mockCompactionTrigger struct {
 	methods map[string]interface{}
 }
@@ -690,6 +701,16 @@ func (t *mockCompactionTrigger) forceTriggerCompaction(collectionID int64) (Uniq
 	panic("not implemented")
 }
 
+// simulateCompaction returns the plans would be generated without executing them
+func (t *mockCompactionTrigger) simulateCompaction(collectionID int64, force bool) (*CompactionSimulation, error) {
+	if f, ok := t.methods["simulateCompaction"]; ok {
+		if ff, ok := f.(func(collectionID int64, force bool) (*CompactionSimulation, error)); ok {
+			return ff(collectionID, force)
+		}
+	}
+	panic("not implemented")
+}
+
 func (t *mockCompactionTrigger) start() {
 	if f, ok := t.methods["start"]; ok {
 		if ff, ok := f.(func()); ok {
@@ -762,6 +783,38 @@ func (m *mockRootCoordClient) ListPolicy(ctx context.Context, in *internalpb.Lis
 	return &internalpb.ListPolicyResponse{Status: &commonpb.Status{ErrorCode: commonpb.ErrorCode_Success}}, nil
 }
 
+func (m *mockRootCoordClient) CancelDDLTask(ctx context.Context, req *rootcoordpb.CancelDDLTaskRequest, opts ...grpc.CallOption) (*commonpb.Status, error) {
+	panic("implement me")
+}
+
+func (m *mockRootCoordClient) OperateCollectionTrash(ctx context.Context, req *rootcoordpb.OperateCollectionTrashRequest, opts ...grpc.CallOption) (*commonpb.Status, error) {
+	panic("implement me")
+}
+
+func (m *mockRootCoordClient) OperateCollectionTemplate(ctx context.Context, req *rootcoordpb.OperateCollectionTemplateRequest, opts ...grpc.CallOption) (*commonpb.Status, error) {
+	panic("implement me")
+}
+
+func (m *mockRootCoordClient) CreateCollectionFromTemplate(ctx context.Context, req *rootcoordpb.CreateCollectionFromTemplateRequest, opts ...grpc.CallOption) (*commonpb.Status, error) {
+	panic("implement me")
+}
+
+func (m *mockRootCoordClient) OperateRoleInheritance(ctx context.Context, req *rootcoordpb.OperateRoleInheritanceRequest, opts ...grpc.CallOption) (*commonpb.Status, error) {
+	panic("implement me")
+}
+
+func (m *mockRootCoordClient) ListRoleInheritances(ctx context.Context, req *rootcoordpb.ListRoleInheritancesRequest, opts ...grpc.CallOption) (*rootcoordpb.ListRoleInheritancesResponse, error) {
+	panic("implement me")
+}
+
+func (m *mockRootCoordClient) OperateAPIKey(ctx context.Context, req *rootcoordpb.OperateAPIKeyRequest, opts ...grpc.CallOption) (*rootcoordpb.OperateAPIKeyResponse, error) {
+	panic("implement me")
+}
+
+func (m *mockRootCoordClient) ListAPIKeys(ctx context.Context, req *rootcoordpb.ListAPIKeysRequest, opts ...grpc.CallOption) (*rootcoordpb.ListAPIKeysResponse, error) {
+	panic("implement me")
+}
+
 type mockHandler struct {
 	meta *meta
 }
@@ -0,0 +1,86 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package datacoord
+
+import (
+	"context"
+	"net/http"
+	"strconv"
+
+	management "github.com/milvus-io/milvus/internal/http"
+)
+
+// orphanChannelOperator is the part of datacoord operating the orphaned channels.
+type orphanChannelOperator interface {
+	GetOrphanChannels(ctx context.Context, refresh bool) (*OrphanChannelReport, error)
+	ForceReleaseOrphanChannel(ctx context.Context, channelName string) error
+}
+
+var orphanChannelComponent = management.NewComponent[orphanChannelOperator]("datacoord")
+
+// registerOrphanChannelHandler exposes the orphaned channels through the management http server,
+// the handler is registered only once and serves the latest started datacoord.
+func registerOrphanChannelHandler(op orphanChannelOperator) {
+	orphanChannelComponent.Serve(op, &management.Handler{
+		Path:        management.DataCoordOrphanChannelRouterPath,
+		HandlerFunc: orphanChannelHandler,
+	})
+}
+
+// orphanChannelHandler lists the orphaned channels, or force releases the orphaned one.
+// The latest detected report is returned unless refresh is specified.
+//
+//	GET /datacoord/channels/orphan?refresh=true
+//	DELETE /datacoord/channels/orphan?channel=by-dev-rootcoord-dml_0_445566778899v0
+func orphanChannelHandler(w http.ResponseWriter, req *http.Request) {
+	op, ok := orphanChannelComponent.Get(w)
+	if !ok {
+		return
+	}
+
+	switch req.Method {
+	case http.MethodGet:
+		refresh := false
+		if value := req.URL.Query().Get("refresh"); value != "" {
+			var err error
+			refresh, err = strconv.ParseBool(value)
+			if err != nil {
+				management.WriteJSON(w, http.StatusBadRequest, map[string]string{"error": "invalid refresh: " + err.Error()})
+				return
+			}
+		}
+		report, err := op.GetOrphanChannels(req.Context(), refresh)
+		if err != nil {
+			management.WriteError(w, err)
+			return
+		}
+		management.WriteJSON(w, http.StatusOK, report)
+	case http.MethodDelete:
+		channel := req.URL.Query().Get("channel")
+		if channel == "" {
+			management.WriteJSON(w, http.StatusBadRequest, map[string]string{"error": "the channel parameter is required"})
+			return
+		}
+		if err := op.ForceReleaseOrphanChannel(req.Context(), channel); err != nil {
+			management.WriteError(w, err)
+			return
+		}
+		management.WriteJSON(w, http.StatusOK, map[string]string{"channel": channel})
+	default:
+		management.WriteJSON(w, http.StatusMethodNotAllowed, map[string]string{"error": "method not allowed"})
+	}
+}
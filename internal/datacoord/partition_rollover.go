@@ -0,0 +1,161 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package datacoord
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"go.uber.org/zap"
+
+	"github.com/milvus-io/milvus/internal/util/timepartition"
+	"github.com/milvus-io/milvus/pkg/log"
+	"github.com/milvus-io/milvus/pkg/util/logutil"
+	"github.com/milvus-io/milvus/pkg/util/typeutil"
+)
+
+// partitionRoller creates the partitions of the current and next intervals for the time partitioned collections,
+// and drops the partitions beyond their retention.
+type partitionRoller struct {
+	broker  Broker
+	handler Handler
+	now     func() time.Time
+
+	startOnce sync.Once
+	stopOnce  sync.Once
+	wg        sync.WaitGroup
+	closeCh   chan struct{}
+}
+
+func newPartitionRoller(broker Broker, handler Handler) *partitionRoller {
+	return &partitionRoller{
+		broker:  broker,
+		handler: handler,
+		now:     time.Now,
+		closeCh: make(chan struct{}),
+	}
+}
+
+// start a goroutine and roll the partitions every interval
+func (r *partitionRoller) start() {
+	if !Params.DataCoordCfg.PartitionRolloverEnable.GetAsBool() {
+		return
+	}
+	r.startOnce.Do(func() {
+		r.wg.Add(1)
+		go r.work()
+	})
+}
+
+func (r *partitionRoller) work() {
+	defer logutil.LogPanic()
+	defer r.wg.Done()
+	ticker := time.NewTicker(Params.DataCoordCfg.PartitionRolloverCheckInterval.GetAsDuration(time.Second))
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ticker.C:
+			r.roll()
+		case <-r.closeCh:
+			log.Info("partition roller quit")
+			return
+		}
+	}
+}
+
+func (r *partitionRoller) close() {
+	r.stopOnce.Do(func() {
+		close(r.closeCh)
+		r.wg.Wait()
+	})
+}
+
+// roll checks the partitions of all the time partitioned collections.
+func (r *partitionRoller) roll() {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	go func() {
+		select {
+		case <-r.closeCh:
+			cancel()
+		case <-ctx.Done():
+		}
+	}()
+
+	dbs, err := r.broker.ListDatabases(ctx)
+	if err != nil {
+		log.Warn("failed to list databases for partition rollover", zap.Error(err))
+		return
+	}
+	for _, dbName := range dbs.GetDbNames() {
+		colls, err := r.broker.ShowCollections(ctx, dbName)
+		if err != nil {
+			log.Warn("failed to show collections for partition rollover", zap.String("dbName", dbName), zap.Error(err))
+			continue
+		}
+		for i, collectionID := range colls.GetCollectionIds() {
+			if err := r.rollCollection(ctx, dbName, colls.GetCollectionNames()[i], collectionID); err != nil {
+				log.Warn("failed to roll partitions of collection",
+					zap.String("dbName", dbName), zap.Int64("collectionID", collectionID), zap.Error(err))
+			}
+		}
+	}
+}
+
+func (r *partitionRoller) rollCollection(ctx context.Context, dbName string, collectionName string, collectionID int64) error {
+	coll, err := r.handler.GetCollection(ctx, collectionID)
+	if err != nil || coll == nil {
+		return err
+	}
+	policy, err := timepartition.Parse(coll.Properties)
+	if err != nil || policy == nil {
+		return err
+	}
+
+	partitions, err := r.broker.ShowPartitions(ctx, collectionID)
+	if err != nil {
+		return err
+	}
+	names := typeutil.NewSet(partitions.GetPartitionNames()...)
+	now := r.now()
+	log := log.With(zap.String("dbName", dbName), zap.String("collection", collectionName), zap.Int64("collectionID", collectionID))
+
+	// the partition of next interval is created ahead, so the inserts are never routed to the default partition at rollover
+	for _, start := range []time.Time{policy.Start(now), policy.Add(now, 1)} {
+		name := policy.PartitionName(start)
+		if names.Contain(name) {
+			continue
+		}
+		if err := r.broker.CreatePartition(ctx, dbName, collectionName, name); err != nil {
+			return err
+		}
+		log.Info("partition created by rollover", zap.String("partition", name))
+	}
+
+	for _, name := range partitions.GetPartitionNames() {
+		start, ok := policy.ParsePartitionName(name)
+		if !ok || !policy.Expired(start, now) {
+			continue
+		}
+		if err := r.broker.DropPartition(ctx, dbName, collectionName, name); err != nil {
+			return err
+		}
+		log.Info("partition dropped beyond retention", zap.String("partition", name), zap.Int("retention", policy.Retention))
+	}
+	return nil
+}
@@ -0,0 +1,86 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package datacoord
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"google.golang.org/grpc"
+
+	"github.com/milvus-io/milvus-proto/go-api/v2/commonpb"
+	"github.com/milvus-io/milvus-proto/go-api/v2/milvuspb"
+	"github.com/milvus-io/milvus/internal/mocks"
+	"github.com/milvus-io/milvus/pkg/common"
+	"github.com/milvus-io/milvus/pkg/util/merr"
+	"github.com/milvus-io/milvus/pkg/util/paramtable"
+)
+
+func Test_partitionRoller(t *testing.T) {
+	paramtable.Init()
+
+	rootCoord := mocks.NewMockRootCoordClient(t)
+	rootCoord.EXPECT().ListDatabases(mock.Anything, mock.Anything).Return(&milvuspb.ListDatabasesResponse{
+		Status:  merr.Success(),
+		DbNames: []string{"default"},
+	}, nil)
+	rootCoord.EXPECT().ShowCollections(mock.Anything, mock.Anything).Return(&milvuspb.ShowCollectionsResponse{
+		Status:          merr.Success(),
+		CollectionIds:   []int64{1, 2},
+		CollectionNames: []string{"logs", "plain"},
+	}, nil)
+	rootCoord.EXPECT().ShowPartitionsInternal(mock.Anything, mock.Anything).Return(&milvuspb.ShowPartitionsResponse{
+		Status:         merr.Success(),
+		PartitionNames: []string{"_default", "p_20231112", "p_20231113", "p_20231114", "p_20231115", "p_foo"},
+	}, nil).Once()
+
+	created := make([]string, 0)
+	rootCoord.EXPECT().CreatePartition(mock.Anything, mock.Anything).RunAndReturn(
+		func(ctx context.Context, req *milvuspb.CreatePartitionRequest, opts ...grpc.CallOption) (*commonpb.Status, error) {
+			assert.Equal(t, "logs", req.GetCollectionName())
+			created = append(created, req.GetPartitionName())
+			return merr.Success(), nil
+		})
+	dropped := make([]string, 0)
+	rootCoord.EXPECT().DropPartition(mock.Anything, mock.Anything).RunAndReturn(
+		func(ctx context.Context, req *milvuspb.DropPartitionRequest, opts ...grpc.CallOption) (*commonpb.Status, error) {
+			assert.Equal(t, "logs", req.GetCollectionName())
+			dropped = append(dropped, req.GetPartitionName())
+			return merr.Success(), nil
+		})
+
+	handler := NewNMockHandler(t)
+	handler.EXPECT().GetCollection(mock.Anything, int64(1)).Return(&collectionInfo{
+		ID: 1,
+		Properties: map[string]string{
+			common.CollectionPartitionRolloverKey:  "daily",
+			common.CollectionPartitionRetentionKey: "3",
+		},
+	}, nil)
+	handler.EXPECT().GetCollection(mock.Anything, int64(2)).Return(&collectionInfo{ID: 2}, nil)
+
+	roller := newPartitionRoller(NewCoordinatorBroker(rootCoord), handler)
+	roller.now = func() time.Time {
+		return time.Date(2023, 11, 15, 13, 30, 0, 0, time.UTC)
+	}
+	roller.roll()
+	assert.Equal(t, []string{"p_20231116"}, created)
+	assert.Equal(t, []string{"p_20231112"}, dropped)
+}
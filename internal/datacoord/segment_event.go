@@ -0,0 +1,100 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package datacoord
+
+import (
+	"sync"
+
+	"go.uber.org/zap"
+
+	"github.com/milvus-io/milvus/internal/kv"
+	"github.com/milvus-io/milvus/internal/util/segmentevent"
+	"github.com/milvus-io/milvus/pkg/eventlog"
+	"github.com/milvus-io/milvus/pkg/log"
+	"github.com/milvus-io/milvus/pkg/util/logutil"
+)
+
+// segmentEventBufferSize is the number of segment events buffered before pushed,
+// the events are dropped if the buffer is full, and QueryCoord pulls the targets later.
+const segmentEventBufferSize = 1024
+
+// segmentEventPusher pushes the events of the segments flushed, dropped or compacted to QueryCoord,
+// which watches the keys in etcd and updates the next targets of the collections,
+// so QueryCoord no longer polls DataCoord to find the changed segments.
+type segmentEventPusher struct {
+	kv           kv.BaseKV
+	subscription *eventlog.Subscription
+
+	startOnce sync.Once
+	stopOnce  sync.Once
+	wg        sync.WaitGroup
+}
+
+func newSegmentEventPusher(kv kv.BaseKV) *segmentEventPusher {
+	return &segmentEventPusher{
+		kv: kv,
+	}
+}
+
+// start a goroutine and push the segment events published to the event bus
+func (p *segmentEventPusher) start() {
+	p.startOnce.Do(func() {
+		p.subscription = eventlog.Subscribe(segmentEventBufferSize,
+			eventlog.EventSegmentFlushed, eventlog.EventSegmentDropped, eventlog.EventCompactionDone)
+		p.wg.Add(1)
+		go p.work()
+	})
+}
+
+func (p *segmentEventPusher) work() {
+	defer logutil.LogPanic()
+	defer p.wg.Done()
+	for evt := range p.subscription.Events() {
+		p.push(evt)
+	}
+	log.Info("segment event pusher quit")
+}
+
+func (p *segmentEventPusher) close() {
+	p.stopOnce.Do(func() {
+		if p.subscription != nil {
+			p.subscription.Close()
+		}
+		p.wg.Wait()
+	})
+}
+
+// push puts the event to the key of its collection, the watchers receive all the puts in order.
+func (p *segmentEventPusher) push(evt *eventlog.ClusterEvent) {
+	if !Params.DataCoordCfg.SegmentEventPushEnabled.GetAsBool() || evt.CollectionID == 0 {
+		return
+	}
+	if err := p.kv.Save(segmentevent.Key(evt.CollectionID), string(evt.Raw())); err != nil {
+		log.Warn("failed to push segment event, QueryCoord pulls the target later",
+			zap.Int64("collectionID", evt.CollectionID),
+			zap.String("type", evt.EventType.String()),
+			zap.Int64s("segmentIDs", evt.SegmentIDs),
+			zap.Error(err))
+	}
+}
+
+// remove removes the key of the collection after the collection dropped.
+func (p *segmentEventPusher) remove(collectionID int64) {
+	if err := p.kv.Remove(segmentevent.Key(collectionID)); err != nil {
+		log.Warn("failed to remove segment event key", zap.Int64("collectionID", collectionID), zap.Error(err))
+	}
+}
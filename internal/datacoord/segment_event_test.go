@@ -0,0 +1,75 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package datacoord
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/milvus-io/milvus/internal/util/segmentevent"
+	"github.com/milvus-io/milvus/pkg/eventlog"
+	"github.com/milvus-io/milvus/pkg/util/paramtable"
+)
+
+func Test_segmentEventPusher(t *testing.T) {
+	paramtable.Init()
+	kv := NewMetaMemoryKV()
+	pusher := newSegmentEventPusher(kv)
+	pusher.start()
+	defer pusher.close()
+
+	type pushedEvent struct {
+		Type       string  `json:"type"`
+		SegmentIDs []int64 `json:"segment_ids"`
+	}
+	loadEvent := func(collectionID int64) *pushedEvent {
+		value, err := kv.Load(segmentevent.Key(collectionID))
+		if err != nil {
+			return nil
+		}
+		evt := &pushedEvent{}
+		require.NoError(t, json.Unmarshal([]byte(value), evt))
+		return evt
+	}
+
+	// the sealed events are not pushed
+	eventlog.Publish(&eventlog.ClusterEvent{EventType: eventlog.EventSegmentSealed, CollectionID: 1, SegmentIDs: []int64{10}})
+	eventlog.Publish(&eventlog.ClusterEvent{EventType: eventlog.EventSegmentFlushed, CollectionID: 1, SegmentIDs: []int64{11}})
+	assert.Eventually(t, func() bool {
+		evt := loadEvent(1)
+		return evt != nil && evt.Type == "segment_flushed" && evt.SegmentIDs[0] == 11
+	}, 5*time.Second, 10*time.Millisecond)
+
+	eventlog.Publish(&eventlog.ClusterEvent{EventType: eventlog.EventCompactionDone, CollectionID: 1, SegmentIDs: []int64{12}})
+	assert.Eventually(t, func() bool {
+		evt := loadEvent(1)
+		return evt != nil && evt.Type == "compaction_done" && evt.SegmentIDs[0] == 12
+	}, 5*time.Second, 10*time.Millisecond)
+
+	// disabled
+	paramtable.Get().Save(Params.DataCoordCfg.SegmentEventPushEnabled.Key, "false")
+	defer paramtable.Get().Reset(Params.DataCoordCfg.SegmentEventPushEnabled.Key)
+	pusher.push(&eventlog.ClusterEvent{EventType: eventlog.EventSegmentDropped, CollectionID: 2, SegmentIDs: []int64{20}})
+	assert.Nil(t, loadEvent(2))
+
+	pusher.remove(1)
+	assert.Nil(t, loadEvent(1))
+}
@@ -425,7 +425,11 @@ func (s *SegmentManager) estimateMaxNumOfRows(collectionID UniqueID) (int, error
 	if collMeta == nil {
 		return -1, fmt.Errorf("failed to get collection %d", collectionID)
 	}
-	return s.estimatePolicy(collMeta.Schema)
+	maxRows, err := s.estimatePolicy(collMeta.Schema)
+	if err != nil || !Params.DataCoordCfg.SegmentDynamicSizeEnabled.GetAsBool() {
+		return maxRows, err
+	}
+	return s.meta.adjustSegmentMaxRows(collMeta, maxRows), nil
 }
 
 // DropSegment drop the segment from manager.
@@ -0,0 +1,152 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package datacoord
+
+import (
+	"math"
+	"sync"
+	"time"
+
+	"go.uber.org/zap"
+
+	"github.com/milvus-io/milvus-proto/go-api/v2/commonpb"
+	"github.com/milvus-io/milvus/pkg/log"
+	"github.com/milvus-io/milvus/pkg/util/typeutil"
+)
+
+// the weight of the latest index build speed in the moving average
+const buildSpeedSmoothFactor = 0.2
+
+// segmentSizeEstimator records the index build speed of each collection,
+// which is used to adjust the max rows of new segments.
+type segmentSizeEstimator struct {
+	mu         sync.Mutex
+	buildStart map[UniqueID]time.Time // buildID -> the time index task started
+	buildSpeed map[UniqueID]float64   // collectionID -> moving average of rows built per second
+}
+
+func newSegmentSizeEstimator() *segmentSizeEstimator {
+	return &segmentSizeEstimator{
+		buildStart: make(map[UniqueID]time.Time),
+		buildSpeed: make(map[UniqueID]float64),
+	}
+}
+
+func (e *segmentSizeEstimator) onBuildStarted(buildID UniqueID) {
+	if e == nil {
+		return
+	}
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	e.buildStart[buildID] = time.Now()
+}
+
+func (e *segmentSizeEstimator) onBuildFinished(collectionID, buildID UniqueID, numRows int64, succeed bool) {
+	if e == nil {
+		return
+	}
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	start, ok := e.buildStart[buildID]
+	if !ok {
+		return
+	}
+	delete(e.buildStart, buildID)
+	cost := time.Since(start).Seconds()
+	if !succeed || numRows <= 0 || cost <= 0 {
+		return
+	}
+
+	speed := float64(numRows) / cost
+	if avg, ok := e.buildSpeed[collectionID]; ok {
+		speed = avg*(1-buildSpeedSmoothFactor) + speed*buildSpeedSmoothFactor
+	}
+	e.buildSpeed[collectionID] = speed
+}
+
+func (e *segmentSizeEstimator) getBuildSpeed(collectionID UniqueID) float64 {
+	if e == nil {
+		return 0
+	}
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	return e.buildSpeed[collectionID]
+}
+
+// getAvgRowSize returns the average row size of the flushed segments in binlog, 0 if there is no flushed segment.
+func (m *meta) getAvgRowSize(collectionID UniqueID) float64 {
+	var size, rows int64
+	for _, segment := range m.GetSegmentsOfCollection(collectionID) {
+		if segment.GetState() != commonpb.SegmentState_Flushed || segment.GetNumOfRows() <= 0 {
+			continue
+		}
+		for _, fieldBinlog := range segment.GetBinlogs() {
+			for _, binlog := range fieldBinlog.GetBinlogs() {
+				size += binlog.GetLogSize()
+			}
+		}
+		rows += segment.GetNumOfRows()
+	}
+	if size <= 0 || rows <= 0 {
+		return 0
+	}
+	return float64(size) / float64(rows)
+}
+
+// adjustSegmentMaxRows adjusts the max rows estimated by schema with the observed average row size,
+// and limits the rows so that the index of the sealed segment could be built in the target time.
+func (m *meta) adjustSegmentMaxRows(coll *collectionInfo, maxRows int) int {
+	schemaRowSize, err := typeutil.EstimateSizePerRecord(coll.Schema)
+	if err != nil || schemaRowSize <= 0 || maxRows <= 0 {
+		return maxRows
+	}
+	rowSize := m.getAvgRowSize(coll.ID)
+	buildSpeed := m.sizeEstimator.getBuildSpeed(coll.ID)
+	adjusted := adjustMaxRows(maxRows, schemaRowSize, rowSize, buildSpeed,
+		Params.DataCoordCfg.SegmentTargetIndexBuildTime.GetAsDuration(time.Second),
+		Params.DataCoordCfg.SegmentDynamicSizeMinRatio.GetAsFloat(),
+		Params.DataCoordCfg.SegmentDynamicSizeMaxRatio.GetAsFloat())
+	if adjusted != maxRows {
+		log.Info("adjust max rows of segment",
+			zap.Int64("collectionID", coll.ID),
+			zap.Int("schemaMaxRows", maxRows),
+			zap.Int("adjustedMaxRows", adjusted),
+			zap.Float64("avgRowSize", rowSize),
+			zap.Float64("buildSpeed", buildSpeed))
+	}
+	return adjusted
+}
+
+func adjustMaxRows(maxRows int, schemaRowSize int, rowSize float64, buildSpeed float64,
+	targetBuildTime time.Duration, minRatio, maxRatio float64,
+) int {
+	rows := float64(maxRows)
+	// the segment size is the same, while the row size is observed instead of estimated
+	if rowSize > 0 {
+		rows = rows * float64(schemaRowSize) / rowSize
+	}
+	if buildSpeed > 0 && targetBuildTime > 0 {
+		rows = math.Min(rows, buildSpeed*targetBuildTime.Seconds())
+	}
+	if minRatio > 0 {
+		rows = math.Max(rows, float64(maxRows)*minRatio)
+	}
+	if maxRatio > 0 {
+		rows = math.Min(rows, float64(maxRows)*maxRatio)
+	}
+	return int(rows)
+}
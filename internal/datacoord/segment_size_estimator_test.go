@@ -0,0 +1,113 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package datacoord
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/milvus-io/milvus-proto/go-api/v2/commonpb"
+	"github.com/milvus-io/milvus-proto/go-api/v2/schemapb"
+	"github.com/milvus-io/milvus/internal/proto/datapb"
+)
+
+func TestAdjustMaxRows(t *testing.T) {
+	// no observation
+	assert.Equal(t, 1000, adjustMaxRows(1000, 100, 0, 0, 10*time.Minute, 0.25, 4))
+	// the observed row size is smaller than estimated
+	assert.Equal(t, 2000, adjustMaxRows(1000, 100, 50, 0, 10*time.Minute, 0.25, 4))
+	// limited by the max ratio
+	assert.Equal(t, 4000, adjustMaxRows(1000, 100, 10, 0, 10*time.Minute, 0.25, 4))
+	// limited by the index build speed
+	assert.Equal(t, 600, adjustMaxRows(1000, 100, 0, 1, 10*time.Minute, 0.25, 4))
+	// limited by the min ratio
+	assert.Equal(t, 250, adjustMaxRows(1000, 100, 0, 0.1, 10*time.Minute, 0.25, 4))
+	// no build time limit
+	assert.Equal(t, 2000, adjustMaxRows(1000, 100, 50, 0.1, 0, 0.25, 4))
+}
+
+func TestSegmentSizeEstimator(t *testing.T) {
+	var nilEstimator *segmentSizeEstimator
+	nilEstimator.onBuildStarted(1)
+	nilEstimator.onBuildFinished(100, 1, 1000, true)
+	assert.Zero(t, nilEstimator.getBuildSpeed(100))
+
+	estimator := newSegmentSizeEstimator()
+	// not started
+	estimator.onBuildFinished(100, 1, 1000, true)
+	assert.Zero(t, estimator.getBuildSpeed(100))
+
+	// failed
+	estimator.onBuildStarted(1)
+	estimator.onBuildFinished(100, 1, 1000, false)
+	assert.Zero(t, estimator.getBuildSpeed(100))
+	assert.Empty(t, estimator.buildStart)
+
+	estimator.onBuildStarted(2)
+	estimator.buildStart[2] = time.Now().Add(-10 * time.Second)
+	estimator.onBuildFinished(100, 2, 1000, true)
+	speed := estimator.getBuildSpeed(100)
+	assert.InDelta(t, 100, speed, 1)
+
+	estimator.onBuildStarted(3)
+	estimator.buildStart[3] = time.Now().Add(-time.Second)
+	estimator.onBuildFinished(100, 3, 1000, true)
+	assert.InDelta(t, speed*(1-buildSpeedSmoothFactor)+1000*buildSpeedSmoothFactor, estimator.getBuildSpeed(100), 10)
+	assert.Zero(t, estimator.getBuildSpeed(200))
+}
+
+func TestMeta_AdjustSegmentMaxRows(t *testing.T) {
+	coll := &collectionInfo{
+		ID: 100,
+		Schema: &schemapb.CollectionSchema{
+			Fields: []*schemapb.FieldSchema{
+				{FieldID: 100, DataType: schemapb.DataType_Int64, IsPrimaryKey: true},
+				{FieldID: 101, DataType: schemapb.DataType_FloatVector, TypeParams: []*commonpb.KeyValuePair{{Key: "dim", Value: "6"}}},
+			},
+		},
+	}
+	m := &meta{
+		segments:      NewSegmentsInfo(),
+		sizeEstimator: newSegmentSizeEstimator(),
+	}
+	// 32 bytes per record estimated by schema
+	assert.Equal(t, 1000, m.adjustSegmentMaxRows(coll, 1000))
+
+	newBinlogs := func(size int64) []*datapb.FieldBinlog {
+		return []*datapb.FieldBinlog{{FieldID: 101, Binlogs: []*datapb.Binlog{{LogSize: size}}}}
+	}
+	m.segments.SetSegment(1, NewSegmentInfo(&datapb.SegmentInfo{
+		ID: 1, CollectionID: 100, State: commonpb.SegmentState_Flushed, NumOfRows: 100, Binlogs: newBinlogs(800),
+	}))
+	m.segments.SetSegment(2, NewSegmentInfo(&datapb.SegmentInfo{
+		ID: 2, CollectionID: 100, State: commonpb.SegmentState_Flushed, NumOfRows: 100, Binlogs: newBinlogs(800),
+	}))
+	// growing and other collections' segments are ignored
+	m.segments.SetSegment(3, NewSegmentInfo(&datapb.SegmentInfo{
+		ID: 3, CollectionID: 100, State: commonpb.SegmentState_Growing, NumOfRows: 100, Binlogs: newBinlogs(100),
+	}))
+	m.segments.SetSegment(4, NewSegmentInfo(&datapb.SegmentInfo{
+		ID: 4, CollectionID: 200, State: commonpb.SegmentState_Flushed, NumOfRows: 100, Binlogs: newBinlogs(100),
+	}))
+	assert.Equal(t, 8.0, m.getAvgRowSize(100))
+	assert.Equal(t, 4000, m.adjustSegmentMaxRows(coll, 1000))
+
+	m.sizeEstimator.buildSpeed[100] = 1
+	assert.Equal(t, 600, m.adjustSegmentMaxRows(coll, 1000))
+}
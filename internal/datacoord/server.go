@@ -36,6 +36,7 @@ import (
 	datanodeclient "github.com/milvus-io/milvus/internal/distributed/datanode/client"
 	indexnodeclient "github.com/milvus-io/milvus/internal/distributed/indexnode/client"
 	rootcoordclient "github.com/milvus-io/milvus/internal/distributed/rootcoord/client"
+	"github.com/milvus-io/milvus/internal/http/healthz"
 	"github.com/milvus-io/milvus/internal/kv"
 	etcdkv "github.com/milvus-io/milvus/internal/kv/etcd"
 	"github.com/milvus-io/milvus/internal/kv/tikv"
@@ -45,6 +46,7 @@ import (
 	"github.com/milvus-io/milvus/internal/types"
 	"github.com/milvus-io/milvus/internal/util/dependency"
 	"github.com/milvus-io/milvus/internal/util/sessionutil"
+	"github.com/milvus-io/milvus/pkg/eventlog"
 	"github.com/milvus-io/milvus/pkg/log"
 	"github.com/milvus-io/milvus/pkg/metrics"
 	"github.com/milvus-io/milvus/pkg/mq/msgstream"
@@ -104,26 +106,32 @@ type Server struct {
 	stateCode        atomic.Value
 	helper           ServerHelper
 
-	etcdCli          *clientv3.Client
-	tikvCli          *txnkv.Client
-	address          string
-	watchClient      kv.WatchKV
-	kv               kv.MetaKv
-	meta             *meta
-	segmentManager   Manager
-	allocator        allocator
-	cluster          *Cluster
-	sessionManager   *SessionManager
-	channelManager   *ChannelManager
-	rootCoordClient  types.RootCoordClient
-	garbageCollector *garbageCollector
-	gcOpt            GcOption
-	handler          Handler
+	etcdCli            *clientv3.Client
+	tikvCli            *txnkv.Client
+	address            string
+	watchClient        kv.WatchKV
+	kv                 kv.MetaKv
+	meta               *meta
+	segmentManager     Manager
+	allocator          allocator
+	cluster            *Cluster
+	sessionManager     *SessionManager
+	channelManager     *ChannelManager
+	rootCoordClient    types.RootCoordClient
+	garbageCollector   *garbageCollector
+	binlogConsolidator *binlogConsolidator
+	uploadQuotaGranter *uploadQuotaGranter
+	segmentEventPusher *segmentEventPusher
+	partitionRoller    *partitionRoller
+	gcOpt              GcOption
+	handler            Handler
 
 	compactionTrigger trigger
 	compactionHandler compactionPlanContext
 
 	metricsCacheManager *metricsinfo.MetricsCacheManager
+	orphanChannels      orphanChannelChecker
+	storageMigrator     *storageMigrator
 
 	flushCh         chan UniqueID
 	buildIndexCh    chan UniqueID
@@ -365,6 +373,10 @@ func (s *Server) initDataCoord() error {
 	}
 
 	s.initGarbageCollection(storageCli)
+	s.binlogConsolidator = newBinlogConsolidator(s.meta, s.allocator, storageCli)
+	s.uploadQuotaGranter = newUploadQuotaGranter(s.watchClient, s.uploadQuotaWeights)
+	s.segmentEventPusher = newSegmentEventPusher(s.watchClient)
+	s.partitionRoller = newPartitionRoller(s.broker, s.handler)
 	s.initIndexBuilder(storageCli)
 
 	s.serverLoopCtx, s.serverLoopCancel = context.WithCancel(s.ctx)
@@ -394,6 +406,17 @@ func (s *Server) startDataCoord() {
 		s.compactionTrigger.start()
 	}
 	s.startServerLoop()
+	s.storageMigrator = newStorageMigrator(s.meta)
+	if err := s.storageMigrator.Recover(s.ctx); err != nil {
+		log.Warn("failed to recover storage migrations", zap.Error(err))
+	}
+	registerOrphanChannelHandler(s)
+	registerCompactionSimulationHandler(s)
+	registerStorageMigrationHandler(s)
+	registerCompactionPlanHandler(s)
+	healthz.RegisterCheck(typeutil.DataCoordRole, healthz.ChannelsWatchedCheck, func(ctx context.Context) error {
+		return s.channelManager.checkAllChannelsWatched()
+	})
 	s.stateCode.Store(commonpb.StateCode_Healthy)
 	sessionutil.SaveServerInfo(typeutil.DataCoordRole, s.session.ServerID)
 }
@@ -472,6 +495,8 @@ func (s *Server) initGarbageCollection(cli storage.ChunkManager) {
 		checkInterval:    Params.DataCoordCfg.GCInterval.GetAsDuration(time.Second),
 		missingTolerance: Params.DataCoordCfg.GCMissingTolerance.GetAsDuration(time.Second),
 		dropTolerance:    Params.DataCoordCfg.GCDropTolerance.GetAsDuration(time.Second),
+
+		checksumVerifyBatch: Params.DataCoordCfg.GCChecksumVerifyBatch.GetAsInt(),
 	})
 }
 
@@ -487,8 +512,9 @@ func (s *Server) initServiceDiscovery() error {
 	datanodes := make([]*NodeInfo, 0, len(sessions))
 	for _, session := range sessions {
 		info := &NodeInfo{
-			NodeID:  session.ServerID,
-			Address: session.Address,
+			NodeID:              session.ServerID,
+			Address:             session.Address,
+			CompactionDedicated: session.CompactionDedicated,
 		}
 		datanodes = append(datanodes, info)
 	}
@@ -592,7 +618,12 @@ func (s *Server) startServerLoop() {
 	s.startWatchService(s.serverLoopCtx)
 	s.startFlushLoop(s.serverLoopCtx)
 	s.startIndexService(s.serverLoopCtx)
+	s.startOrphanChannelCheckLoop(s.serverLoopCtx)
 	s.garbageCollector.start()
+	s.binlogConsolidator.start()
+	s.uploadQuotaGranter.start()
+	s.segmentEventPusher.start()
+	s.partitionRoller.start()
 }
 
 // startDataNodeTtLoop start a goroutine to recv data node tt msg from msgstream
@@ -854,8 +885,9 @@ func (s *Server) handleSessionEvent(ctx context.Context, role string, event *ses
 			Channels: []*datapb.ChannelStatus{},
 		}
 		node := &NodeInfo{
-			NodeID:  event.Session.ServerID,
-			Address: event.Session.Address,
+			NodeID:              event.Session.ServerID,
+			Address:             event.Session.Address,
+			CompactionDedicated: event.Session.CompactionDedicated,
 		}
 		switch event.EventType {
 		case sessionutil.SessionAddEvent:
@@ -876,6 +908,12 @@ func (s *Server) handleSessionEvent(ctx context.Context, role string, event *ses
 				return err
 			}
 			s.metricsCacheManager.InvalidateSystemInfoMetrics()
+			eventlog.Publish(&eventlog.ClusterEvent{
+				EventType: eventlog.EventNodeDown,
+				Role:      typeutil.DataNodeRole,
+				NodeID:    node.NodeID,
+				Attrs:     map[string]string{"address": node.Address},
+			})
 		default:
 			log.Warn("receive unknown service event type",
 				zap.Any("type", event.EventType))
@@ -1024,6 +1062,10 @@ func (s *Server) Stop() error {
 	logutil.Logger(s.ctx).Info("server shutdown")
 	s.cluster.Close()
 	s.garbageCollector.close()
+	s.binlogConsolidator.close()
+	s.uploadQuotaGranter.close()
+	s.segmentEventPusher.close()
+	s.partitionRoller.close()
 	s.stopServerLoop()
 
 	if Params.DataCoordCfg.EnableCompaction.GetAsBool() {
@@ -1031,6 +1073,9 @@ func (s *Server) Stop() error {
 		s.stopCompactionHandler()
 	}
 	s.indexBuilder.Stop()
+	if s.storageMigrator != nil {
+		s.storageMigrator.Stop()
+	}
 
 	if s.session != nil {
 		s.session.Stop()
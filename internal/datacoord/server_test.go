@@ -220,6 +220,33 @@ func TestAssignSegmentID(t *testing.T) {
 		assert.NoError(t, err)
 		assert.EqualValues(t, 0, len(resp.SegIDAssignments))
 	})
+
+	t.Run("assign segment for read-only collection", func(t *testing.T) {
+		svr := newTestServer(t, nil)
+		defer closeTestServer(t, svr)
+		schema := newTestSchema()
+		svr.meta.AddCollection(&collectionInfo{
+			ID:         collID,
+			Schema:     schema,
+			Partitions: []int64{},
+			Properties: map[string]string{common.CollectionReadOnlyKey: "true"},
+		})
+		req := &datapb.SegmentIDRequest{
+			Count:        1000,
+			ChannelName:  channel0,
+			CollectionID: collID,
+			PartitionID:  partID,
+		}
+
+		resp, err := svr.AssignSegmentID(context.TODO(), &datapb.AssignSegmentIDRequest{
+			NodeID:            0,
+			PeerRole:          "",
+			SegmentIDRequests: []*datapb.SegmentIDRequest{req},
+		})
+		assert.NoError(t, err)
+		assert.EqualValues(t, 1, len(resp.SegIDAssignments))
+		assert.ErrorIs(t, merr.Error(resp.SegIDAssignments[0].GetStatus()), merr.ErrCollectionReadOnly)
+	})
 }
 
 type mockRootCoord struct {
@@ -4361,6 +4388,37 @@ func Test_CheckHealth(t *testing.T) {
 		assert.Equal(t, false, resp.IsHealthy)
 		assert.NotEmpty(t, resp.Reasons)
 	})
+
+	t.Run("data node is degraded", func(t *testing.T) {
+		svr := &Server{session: &sessionutil.Session{SessionRaw: sessionutil.SessionRaw{ServerID: 1}}}
+		svr.stateCode.Store(commonpb.StateCode_Healthy)
+		degradedClient := &mockDataNodeClient{
+			id:    1,
+			state: commonpb.StateCode_Healthy,
+			subStates: []*milvuspb.ComponentInfo{{
+				Role:      "TimeTick",
+				StateCode: commonpb.StateCode_Abnormal,
+				ExtraInfo: []*commonpb.KeyValuePair{{Key: "reason", Value: "timetick lags behind"}},
+			}},
+		}
+		sm := NewSessionManager()
+		sm.sessions = struct {
+			sync.RWMutex
+			data map[int64]*Session
+		}{data: map[int64]*Session{1: {
+			client: degradedClient,
+			clientCreator: func(ctx context.Context, addr string, nodeID int64) (types.DataNodeClient, error) {
+				return degradedClient, nil
+			},
+		}}}
+		svr.sessionManager = sm
+		ctx := context.Background()
+		resp, err := svr.CheckHealth(ctx, &milvuspb.CheckHealthRequest{})
+		assert.NoError(t, err)
+		assert.Equal(t, false, resp.IsHealthy)
+		assert.Len(t, resp.Reasons, 1)
+		assert.Contains(t, resp.Reasons[0], "timetick lags behind")
+	})
 }
 
 //func Test_initServiceDiscovery(t *testing.T) {
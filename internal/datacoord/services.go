@@ -40,6 +40,7 @@ import (
 	"github.com/milvus-io/milvus/pkg/log"
 	"github.com/milvus-io/milvus/pkg/metrics"
 	"github.com/milvus-io/milvus/pkg/util/commonpbutil"
+	"github.com/milvus-io/milvus/pkg/util/funcutil"
 	"github.com/milvus-io/milvus/pkg/util/merr"
 	"github.com/milvus-io/milvus/pkg/util/metricsinfo"
 	"github.com/milvus-io/milvus/pkg/util/paramtable"
@@ -188,10 +189,20 @@ func (s *Server) AssignSegmentID(ctx context.Context, req *datapb.AssignSegmentI
 
 		// Load the collection info from Root Coordinator, if it is not found in server meta.
 		// Note: this request wouldn't be received if collection didn't exist.
-		_, err := s.handler.GetCollection(ctx, r.GetCollectionID())
+		coll, err := s.handler.GetCollection(ctx, r.GetCollectionID())
 		if err != nil {
 			log.Warn("cannot get collection schema", zap.Error(err))
 		}
+		if coll != nil && common.IsCollectionReadOnly(coll.Properties) {
+			log.Warn("reject to assign segment for read-only collection", zap.Int64("collectionID", r.GetCollectionID()))
+			assigns = append(assigns, &datapb.SegmentIDAssignment{
+				ChannelName:  r.GetChannelName(),
+				CollectionID: r.GetCollectionID(),
+				PartitionID:  r.GetPartitionID(),
+				Status:       merr.Status(merr.WrapErrCollectionReadOnly(r.GetCollectionID())),
+			})
+			continue
+		}
 
 		// Add the channel to cluster for watching.
 		s.cluster.Watch(ctx, r.ChannelName, r.CollectionID)
@@ -451,6 +462,23 @@ func (s *Server) SaveBinlogPaths(ctx context.Context, req *datapb.SaveBinlogPath
 	segmentID := req.GetSegmentID()
 	segment := s.meta.GetSegment(segmentID)
 
+	// L0 segments are created by datanode when syncing buffered deletes, add the meta on first report
+	if segment == nil && req.GetSegLevel() == datapb.SegmentLevel_L0 {
+		segment = NewSegmentInfo(&datapb.SegmentInfo{
+			ID:            segmentID,
+			CollectionID:  req.GetCollectionID(),
+			PartitionID:   req.GetPartitionID(),
+			InsertChannel: channelName,
+			State:         commonpb.SegmentState_Growing,
+			Level:         datapb.SegmentLevel_L0,
+		})
+		if err := s.meta.AddSegment(ctx, segment); err != nil {
+			log.Warn("failed to add L0 segment", zap.Error(err))
+			return merr.Status(err), nil
+		}
+		log.Info("L0 segment added", zap.Int64("partitionID", req.GetPartitionID()))
+	}
+
 	if segment == nil {
 		err := merr.WrapErrSegmentNotFound(segmentID)
 		log.Warn("failed to get segment", zap.Error(err))
@@ -489,6 +517,15 @@ func (s *Server) SaveBinlogPaths(ctx context.Context, req *datapb.SaveBinlogPath
 	log.Info("flush segment with meta", zap.Any("meta", req.GetField2BinlogPaths()))
 
 	if req.GetFlushed() {
+		if segment.GetLevel() == datapb.SegmentLevel_L0 {
+			// L0 segments are neither indexed nor compacted as single segment,
+			// they wait for the LevelZero compaction of the channel
+			if err := s.meta.SetState(segmentID, commonpb.SegmentState_Flushed); err != nil {
+				log.Warn("failed to set L0 segment flushed", zap.Error(err))
+				return merr.Status(err), nil
+			}
+			return merr.Success(), nil
+		}
 		s.segmentManager.DropSegment(ctx, req.SegmentID)
 		s.flushCh <- req.SegmentID
 
@@ -565,6 +602,9 @@ func (s *Server) DropVirtualChannel(ctx context.Context, req *datapb.DropVirtual
 	s.segmentManager.DropSegmentsOfChannel(ctx, channel)
 
 	metrics.CleanupDataCoordNumStoredRows(collectionID)
+	if collectionID != 0 && s.segmentEventPusher != nil {
+		s.segmentEventPusher.remove(collectionID)
+	}
 
 	// no compaction triggered in Drop procedure
 	return resp, nil
@@ -1156,11 +1196,11 @@ func getCompactionState(tasks []*compactionTask) (state commonpb.CompactionState
 		switch t.state {
 		case pipelining:
 			executingCnt++
-		case executing:
+		case executing, verifying:
 			executingCnt++
 		case completed:
 			completedCnt++
-		case failed:
+		case failed, cancelled:
 			failedCnt++
 		case timeout:
 			timeoutCnt++
@@ -1339,7 +1379,8 @@ func (s *Server) GetFlushAllState(ctx context.Context, req *milvuspb.GetFlushAll
 }
 
 // Import distributes the import tasks to DataNodes.
-// It returns a failed status if no DataNode is available or if any error occurs.
+// It returns a failed status if no DataNode is available or if any error occurs,
+// the status is parameter invalid if the import files failed the pre-validation.
 func (s *Server) Import(ctx context.Context, req *datapb.ImportTaskRequest) (*datapb.ImportTaskResponse, error) {
 	log := log.Ctx(ctx)
 	log.Info("DataCoord receives import request", zap.Any("req", req))
@@ -1353,7 +1394,8 @@ func (s *Server) Import(ctx context.Context, req *datapb.ImportTaskRequest) (*da
 		}, nil
 	}
 
-	nodes := s.sessionManager.getLiveNodeIDs()
+	// the DataNodes dedicated to compaction are not assigned any import task
+	nodes := lo.Without(s.sessionManager.getLiveNodeIDs(), s.sessionManager.getCompactionDedicatedNodeIDs()...)
 	if len(nodes) == 0 {
 		log.Warn("import failed as all DataNodes are offline")
 		resp.Status = merr.Status(merr.WrapErrNodeLackAny("no live DataNode"))
@@ -1363,6 +1405,12 @@ func (s *Server) Import(ctx context.Context, req *datapb.ImportTaskRequest) (*da
 
 	avaNodes := getDiff(nodes, req.GetWorkingNodes())
 	if len(avaNodes) > 0 {
+		// Validate the task only if it could be assigned, the rejected tasks are retried.
+		if err := s.validateImportTask(ctx, req.GetImportTask()); err != nil {
+			log.Warn("import task failed the pre-validation", zap.Int64("taskID", req.GetImportTask().GetTaskId()), zap.Error(err))
+			resp.Status = merr.Status(err)
+			return resp, nil
+		}
 		// If there exists available DataNodes, pick one at random.
 		resp.DatanodeId = avaNodes[rand.Intn(len(avaNodes))]
 		log.Info("picking a free DataNode",
@@ -1603,19 +1651,23 @@ func (s *Server) BroadcastAlteredCollection(ctx context.Context, req *datapb.Alt
 
 	// cache miss and update cache
 	if clonedColl == nil {
-		collInfo := &collectionInfo{
+		clonedColl = &collectionInfo{
 			ID:             req.GetCollectionID(),
 			Schema:         req.GetSchema(),
 			Partitions:     req.GetPartitionIDs(),
 			StartPositions: req.GetStartPositions(),
-			Properties:     properties,
 		}
-		s.meta.AddCollection(collInfo)
-		return merr.Success(), nil
 	}
-
+	clonedColl.StartPositions = req.GetStartPositions()
 	clonedColl.Properties = properties
 	s.meta.AddCollection(clonedColl)
+
+	// the number of virtual channels altered
+	if _, ok := properties[common.CollectionShardsNumKey]; ok {
+		if err := s.reconcileChannels(ctx, req.GetCollectionID()); err != nil {
+			return merr.Status(err), nil
+		}
+	}
 	return merr.Success(), nil
 }
 
@@ -1652,6 +1704,16 @@ func (s *Server) CheckHealth(ctx context.Context, req *milvuspb.CheckHealthReque
 				mu.Lock()
 				defer mu.Unlock()
 				errReasons = append(errReasons, err.Error())
+				return nil
+			}
+			// the DataNode is degraded if any subcomponent is abnormal, like the timetick lags behind
+			for _, sub := range sta.GetSubcomponentStates() {
+				if sub.GetStateCode() == commonpb.StateCode_Abnormal {
+					mu.Lock()
+					errReasons = append(errReasons, fmt.Sprintf("DataNode=%d %s degraded: %s",
+						nodeID, sub.GetRole(), funcutil.KeyValuePair2Map(sub.GetExtraInfo())["reason"]))
+					mu.Unlock()
+				}
 			}
 			return nil
 		})
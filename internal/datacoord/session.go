@@ -32,6 +32,8 @@ var errDisposed = errors.New("client is disposed")
 type NodeInfo struct {
 	NodeID  int64
 	Address string
+	// CompactionDedicated indicates the node is dedicated to compaction, no channel is assigned to it
+	CompactionDedicated bool
 }
 
 // Session contains session info of a node
@@ -114,6 +114,20 @@ func (c *SessionManager) getLiveNodeIDs() []int64 {
 	return ret
 }
 
+// getCompactionDedicatedNodeIDs returns IDs of the live DataNodes dedicated to compaction.
+func (c *SessionManager) getCompactionDedicatedNodeIDs() []int64 {
+	c.sessions.RLock()
+	defer c.sessions.RUnlock()
+
+	ret := make([]int64, 0)
+	for id, s := range c.sessions.data {
+		if s.info != nil && s.info.CompactionDedicated {
+			ret = append(ret, id)
+		}
+	}
+	return ret
+}
+
 // GetSessions gets all node sessions
 func (c *SessionManager) GetSessions() []*Session {
 	c.sessions.RLock()
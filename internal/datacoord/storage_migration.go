@@ -0,0 +1,470 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package datacoord
+
+import (
+	"context"
+	"fmt"
+	"path"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"go.uber.org/atomic"
+	"go.uber.org/zap"
+	"golang.org/x/sync/errgroup"
+
+	"github.com/milvus-io/milvus-proto/go-api/v2/commonpb"
+	"github.com/milvus-io/milvus/internal/metastore/model"
+	"github.com/milvus-io/milvus/internal/proto/datapb"
+	"github.com/milvus-io/milvus/internal/storage"
+	"github.com/milvus-io/milvus/pkg/log"
+	"github.com/milvus-io/milvus/pkg/util/merr"
+	"github.com/milvus-io/milvus/pkg/util/metautil"
+	"github.com/milvus-io/milvus/pkg/util/typeutil"
+)
+
+const (
+	StorageMigrationRunning   = "running"
+	StorageMigrationCompleted = "completed"
+	StorageMigrationFailed    = "failed"
+	StorageMigrationCanceled  = "canceled"
+)
+
+// StorageMigrationTarget is the object storage the files are copied to,
+// the storage type is the same as the current one if not specified.
+type StorageMigrationTarget struct {
+	StorageType     string `json:"storage_type"`
+	Address         string `json:"address"`
+	BucketName      string `json:"bucket_name"`
+	RootPath        string `json:"root_path"`
+	AccessKeyID     string `json:"access_key_id"`
+	SecretAccessKey string `json:"secret_access_key"`
+	UseSSL          bool   `json:"use_ssl"`
+	UseIAM          bool   `json:"use_iam"`
+	CloudProvider   string `json:"cloud_provider"`
+	IAMEndpoint     string `json:"iam_endpoint"`
+	Region          string `json:"region"`
+}
+
+// StorageMigrationJob is the progress of copying the binlogs and index files of a collection to another object storage,
+// the credentials of the target are never exposed.
+type StorageMigrationJob struct {
+	CollectionID   int64  `json:"collection_id"`
+	StorageType    string `json:"storage_type"`
+	Address        string `json:"address"`
+	BucketName     string `json:"bucket_name"`
+	RootPath       string `json:"root_path"`
+	State          string `json:"state"`
+	Reason         string `json:"reason,omitempty"`
+	Round          int    `json:"round"`
+	SegmentNum     int64  `json:"segment_num"`
+	FileNum        int64  `json:"file_num"`
+	SkippedFileNum int64  `json:"skipped_file_num"`
+	TotalBytes     int64  `json:"total_bytes"`
+	StartTime      int64  `json:"start_time,omitempty"`
+	CompleteTime   int64  `json:"complete_time,omitempty"`
+}
+
+// migrationFile is a file of the collection to copy.
+type migrationFile struct {
+	path string
+	// crc32c checksum recorded in meta, empty for the index files and legacy binlogs
+	checksum string
+}
+
+type storageMigrationTask struct {
+	mu     sync.RWMutex
+	job    *StorageMigrationJob
+	cancel context.CancelFunc
+
+	fileNum        atomic.Int64
+	skippedFileNum atomic.Int64
+	totalBytes     atomic.Int64
+}
+
+func (t *storageMigrationTask) snapshot() *StorageMigrationJob {
+	t.mu.RLock()
+	defer t.mu.RUnlock()
+	job := *t.job
+	if job.State == StorageMigrationRunning {
+		job.FileNum = t.fileNum.Load()
+		job.SkippedFileNum = t.skippedFileNum.Load()
+		job.TotalBytes = t.totalBytes.Load()
+	}
+	return &job
+}
+
+func (t *storageMigrationTask) update(fn func(job *StorageMigrationJob)) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	fn(t.job)
+}
+
+// storageMigrator copies the binlogs and index files of the collections to another object storage,
+// so the storage could be migrated to another bucket, region or provider without exporting and importing the data.
+// The files are verified by checksum after copied, and the record of the migration is saved to meta once all the
+// files of the collection are copied. The paths in meta are relative to the root path of the storage,
+// so switching the storage config of the cluster to the target completes the migration.
+type storageMigrator struct {
+	ctx    context.Context
+	cancel context.CancelFunc
+	wg     sync.WaitGroup
+
+	meta *meta
+	// newChunkManager creates the chunk manager of the target, replaced in unittest.
+	newChunkManager func(ctx context.Context, target *StorageMigrationTarget) (storage.ChunkManager, error)
+
+	mu    sync.RWMutex
+	tasks map[int64]*storageMigrationTask
+}
+
+func newStorageMigrator(meta *meta) *storageMigrator {
+	ctx, cancel := context.WithCancel(context.Background())
+	return &storageMigrator{
+		ctx:             ctx,
+		cancel:          cancel,
+		meta:            meta,
+		newChunkManager: newTargetChunkManager,
+		tasks:           make(map[int64]*storageMigrationTask),
+	}
+}
+
+func newTargetChunkManager(ctx context.Context, target *StorageMigrationTarget) (storage.ChunkManager, error) {
+	factory := storage.NewChunkManagerFactory(target.StorageType,
+		storage.RootPath(target.RootPath),
+		storage.Address(target.Address),
+		storage.AccessKeyID(target.AccessKeyID),
+		storage.SecretAccessKeyID(target.SecretAccessKey),
+		storage.UseSSL(target.UseSSL),
+		storage.BucketName(target.BucketName),
+		storage.UseIAM(target.UseIAM),
+		storage.CloudProvider(target.CloudProvider),
+		storage.IAMEndpoint(target.IAMEndpoint),
+		storage.Region(target.Region),
+		storage.RequestTimeout(Params.MinioCfg.RequestTimeoutMs.GetAsInt64()),
+		storage.CreateBucket(true))
+	return factory.NewPersistentStorageChunkManager(ctx)
+}
+
+// Recover loads the completed migrations from meta.
+func (m *storageMigrator) Recover(ctx context.Context) error {
+	migrations, err := m.meta.catalog.ListStorageMigrations(ctx)
+	if err != nil {
+		return err
+	}
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	for _, migration := range migrations {
+		m.tasks[migration.CollectionID] = &storageMigrationTask{
+			job: &StorageMigrationJob{
+				CollectionID: migration.CollectionID,
+				StorageType:  migration.StorageType,
+				Address:      migration.Address,
+				BucketName:   migration.BucketName,
+				RootPath:     migration.RootPath,
+				State:        StorageMigrationCompleted,
+				SegmentNum:   int64(len(migration.SegmentIDs)),
+				FileNum:      migration.FileNum,
+				TotalBytes:   migration.TotalBytes,
+				CompleteTime: migration.CompleteTime,
+			},
+		}
+	}
+	return nil
+}
+
+// Start starts the job to copy the files of the collection to the target storage,
+// only one job of a collection could be running at the same time.
+func (m *storageMigrator) Start(collectionID int64, target *StorageMigrationTarget) (*StorageMigrationJob, error) {
+	if target.StorageType == "" {
+		target.StorageType = Params.CommonCfg.StorageType.GetValue()
+	}
+	if target.StorageType != "local" && target.BucketName == "" {
+		return nil, merr.WrapErrParameterInvalidMsg("bucket name of the target storage is required")
+	}
+	if target.StorageType == Params.CommonCfg.StorageType.GetValue() &&
+		target.Address == Params.MinioCfg.Address.GetValue() &&
+		target.BucketName == Params.MinioCfg.BucketName.GetValue() &&
+		strings.Trim(target.RootPath, "/") == strings.Trim(m.meta.chunkManager.RootPath(), "/") {
+		return nil, merr.WrapErrParameterInvalidMsg("the target storage is the same as the current one")
+	}
+
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if task, ok := m.tasks[collectionID]; ok && task.snapshot().State == StorageMigrationRunning {
+		return nil, merr.WrapErrParameterInvalidMsg("storage migration of collection %d is running", collectionID)
+	}
+
+	ctx, cancel := context.WithCancel(m.ctx)
+	targetCM, err := m.newChunkManager(ctx, target)
+	if err != nil {
+		cancel()
+		return nil, err
+	}
+	task := &storageMigrationTask{
+		job: &StorageMigrationJob{
+			CollectionID: collectionID,
+			StorageType:  target.StorageType,
+			Address:      target.Address,
+			BucketName:   target.BucketName,
+			RootPath:     target.RootPath,
+			State:        StorageMigrationRunning,
+			StartTime:    time.Now().Unix(),
+		},
+		cancel: cancel,
+	}
+	m.tasks[collectionID] = task
+
+	m.wg.Add(1)
+	go func() {
+		defer m.wg.Done()
+		defer cancel()
+		m.run(ctx, task, targetCM)
+	}()
+	return task.snapshot(), nil
+}
+
+// Cancel cancels the running job of the collection, the copied files are left in the target storage.
+func (m *storageMigrator) Cancel(collectionID int64) error {
+	m.mu.RLock()
+	task, ok := m.tasks[collectionID]
+	m.mu.RUnlock()
+	if !ok || task.snapshot().State != StorageMigrationRunning {
+		return merr.WrapErrParameterInvalidMsg("no running storage migration of collection %d", collectionID)
+	}
+	task.cancel()
+	return nil
+}
+
+// List returns the jobs of the collection, or all the jobs if the collection id is 0.
+func (m *storageMigrator) List(collectionID int64) []*StorageMigrationJob {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+	jobs := make([]*StorageMigrationJob, 0, len(m.tasks))
+	for id, task := range m.tasks {
+		if collectionID == 0 || id == collectionID {
+			jobs = append(jobs, task.snapshot())
+		}
+	}
+	sort.Slice(jobs, func(i, j int) bool { return jobs[i].CollectionID < jobs[j].CollectionID })
+	return jobs
+}
+
+func (m *storageMigrator) Stop() {
+	m.cancel()
+	m.wg.Wait()
+}
+
+func (m *storageMigrator) run(ctx context.Context, task *storageMigrationTask, targetCM storage.ChunkManager) {
+	job := task.snapshot()
+	log := log.With(zap.Int64("collectionID", job.CollectionID),
+		zap.String("targetBucket", job.BucketName),
+		zap.String("targetRootPath", job.RootPath))
+	log.Info("start storage migration")
+
+	segmentIDs, err := m.migrate(ctx, task, targetCM)
+	if err == nil {
+		err = m.meta.catalog.SaveStorageMigration(ctx, &model.StorageMigration{
+			CollectionID: job.CollectionID,
+			StorageType:  job.StorageType,
+			Address:      job.Address,
+			BucketName:   job.BucketName,
+			RootPath:     job.RootPath,
+			SegmentIDs:   segmentIDs,
+			FileNum:      task.fileNum.Load(),
+			TotalBytes:   task.totalBytes.Load(),
+			CompleteTime: time.Now().Unix(),
+		})
+	}
+
+	task.update(func(job *StorageMigrationJob) {
+		job.FileNum = task.fileNum.Load()
+		job.SkippedFileNum = task.skippedFileNum.Load()
+		job.TotalBytes = task.totalBytes.Load()
+		job.CompleteTime = time.Now().Unix()
+		switch {
+		case err == nil:
+			job.State = StorageMigrationCompleted
+			job.SegmentNum = int64(len(segmentIDs))
+		case ctx.Err() != nil:
+			job.State = StorageMigrationCanceled
+			job.Reason = err.Error()
+		default:
+			job.State = StorageMigrationFailed
+			job.Reason = err.Error()
+		}
+	})
+	if err != nil {
+		log.Warn("storage migration failed", zap.Error(err))
+		return
+	}
+	log.Info("storage migration completed", zap.Int("segmentNum", len(segmentIDs)), zap.Int64("fileNum", task.fileNum.Load()))
+}
+
+// migrate copies the files of the collection in rounds, the files added by flush and compaction during the last round
+// are copied in the next round, until all the files of the current segments are copied.
+func (m *storageMigrator) migrate(ctx context.Context, task *storageMigrationTask, targetCM storage.ChunkManager) ([]int64, error) {
+	collectionID := task.snapshot().CollectionID
+	copied := typeutil.NewSet[string]()
+	maxRounds := Params.DataCoordCfg.StorageMigrationMaxRounds.GetAsInt()
+	for round := 1; round <= maxRounds; round++ {
+		task.update(func(job *StorageMigrationJob) { job.Round = round })
+
+		segmentIDs, files := m.collectFiles(collectionID)
+		pending := make([]*migrationFile, 0, len(files))
+		for _, file := range files {
+			if !copied.Contain(file.path) {
+				pending = append(pending, file)
+			}
+		}
+		if len(pending) == 0 {
+			return segmentIDs, nil
+		}
+
+		group, groupCtx := errgroup.WithContext(ctx)
+		group.SetLimit(Params.DataCoordCfg.StorageMigrationParallelism.GetAsInt())
+		for _, file := range pending {
+			file := file
+			group.Go(func() error {
+				return m.copyFile(groupCtx, task, targetCM, file)
+			})
+		}
+		if err := group.Wait(); err != nil {
+			return nil, err
+		}
+		for _, file := range pending {
+			copied.Insert(file.path)
+		}
+	}
+	return nil, merr.WrapErrServiceInternal(fmt.Sprintf("files of collection %d keep changing after %d rounds, pause the writes and retry", collectionID, maxRounds))
+}
+
+// collectFiles returns the healthy segments of the collection and their binlogs and index files.
+func (m *storageMigrator) collectFiles(collectionID int64) ([]int64, []*migrationFile) {
+	segments := m.meta.GetSegmentsOfCollection(collectionID)
+	segmentIDs := make([]int64, 0, len(segments))
+	files := make([]*migrationFile, 0)
+	for _, segment := range segments {
+		segmentIDs = append(segmentIDs, segment.GetID())
+		for _, fieldBinlogs := range [][]*datapb.FieldBinlog{segment.GetBinlogs(), segment.GetDeltalogs(), segment.GetStatslogs()} {
+			for _, fieldBinlog := range fieldBinlogs {
+				for _, binlog := range fieldBinlog.GetBinlogs() {
+					files = append(files, &migrationFile{path: binlog.GetLogPath(), checksum: binlog.GetChecksum()})
+				}
+			}
+		}
+		for _, segIdx := range m.meta.GetSegmentIndexes(segment.GetID()) {
+			if segIdx.IndexState != commonpb.IndexState_Finished {
+				continue
+			}
+			for _, filePath := range metautil.BuildSegmentIndexFilePaths(m.meta.chunkManager.RootPath(), segIdx.BuildID, segIdx.IndexVersion,
+				segIdx.PartitionID, segIdx.SegmentID, segIdx.IndexFileKeys) {
+				files = append(files, &migrationFile{path: filePath})
+			}
+		}
+	}
+	sort.Slice(segmentIDs, func(i, j int) bool { return segmentIDs[i] < segmentIDs[j] })
+	return segmentIDs, files
+}
+
+// copyFile copies the file to the same relative path in the target storage and verifies it by checksum,
+// the file already copied with the same content is skipped, so the retried migration resumes quickly.
+func (m *storageMigrator) copyFile(ctx context.Context, task *storageMigrationTask, targetCM storage.ChunkManager, file *migrationFile) error {
+	data, err := m.meta.chunkManager.Read(ctx, file.path)
+	if err != nil {
+		return err
+	}
+	checksum := storage.BinlogChecksum(data)
+	if file.checksum != "" && file.checksum != checksum {
+		return merr.WrapErrIoChecksum(file.path, fmt.Sprintf("source file corrupted, expected=%s, actual=%s", file.checksum, checksum))
+	}
+
+	relative := strings.TrimPrefix(strings.TrimPrefix(file.path, m.meta.chunkManager.RootPath()), "/")
+	targetPath := path.Join(targetCM.RootPath(), relative)
+	if existing, err := targetCM.Read(ctx, targetPath); err == nil && storage.BinlogChecksum(existing) == checksum {
+		task.skippedFileNum.Inc()
+		task.fileNum.Inc()
+		task.totalBytes.Add(int64(len(data)))
+		return nil
+	}
+
+	if err := targetCM.Write(ctx, targetPath, data); err != nil {
+		return err
+	}
+	copied, err := targetCM.Read(ctx, targetPath)
+	if err != nil {
+		return err
+	}
+	if actual := storage.BinlogChecksum(copied); actual != checksum {
+		return merr.WrapErrIoChecksum(targetPath, fmt.Sprintf("copied file mismatched, expected=%s, actual=%s", checksum, actual))
+	}
+	task.fileNum.Inc()
+	task.totalBytes.Add(int64(len(data)))
+	return nil
+}
+
+// OperateStorageMigration starts or cancels the job copying the binlogs and index files of the collection to the target storage.
+func (s *Server) OperateStorageMigration(ctx context.Context, req *datapb.OperateStorageMigrationRequest) (*commonpb.Status, error) {
+	log := log.Ctx(ctx).With(zap.Int64("collectionID", req.GetCollectionID()), zap.String("operateType", req.GetOperateType().String()))
+	log.Info("received request to operate storage migration")
+	if err := merr.CheckHealthy(s.GetStateCode()); err != nil {
+		return merr.Status(err), nil
+	}
+
+	var err error
+	switch req.GetOperateType() {
+	case datapb.StorageMigrationOperateType_StartMigration:
+		if _, err = s.handler.GetCollection(ctx, req.GetCollectionID()); err == nil {
+			_, err = s.storageMigrator.Start(req.GetCollectionID(), newStorageMigrationTarget(req.GetTarget()))
+		}
+	case datapb.StorageMigrationOperateType_CancelMigration:
+		err = s.storageMigrator.Cancel(req.GetCollectionID())
+	default:
+		err = merr.WrapErrParameterInvalidMsg("invalid storage migration operate type %d", req.GetOperateType())
+	}
+	if err != nil {
+		log.Warn("failed to operate storage migration", zap.Error(err))
+		return merr.Status(err), nil
+	}
+	return merr.Success(), nil
+}
+
+func newStorageMigrationTarget(target *datapb.StorageMigrationTarget) *StorageMigrationTarget {
+	return &StorageMigrationTarget{
+		StorageType:     target.GetStorageType(),
+		Address:         target.GetAddress(),
+		BucketName:      target.GetBucketName(),
+		RootPath:        target.GetRootPath(),
+		AccessKeyID:     target.GetAccessKeyID(),
+		SecretAccessKey: target.GetSecretAccessKey(),
+		UseSSL:          target.GetUseSsl(),
+		UseIAM:          target.GetUseIam(),
+		CloudProvider:   target.GetCloudProvider(),
+		IAMEndpoint:     target.GetIamEndpoint(),
+		Region:          target.GetRegion(),
+	}
+}
+
+// GetStorageMigrations returns the storage migration jobs of the collection, or all the jobs if the collection id is 0.
+func (s *Server) GetStorageMigrations(ctx context.Context, collectionID int64) ([]*StorageMigrationJob, error) {
+	if err := merr.CheckHealthy(s.GetStateCode()); err != nil {
+		return nil, err
+	}
+	return s.storageMigrator.List(collectionID), nil
+}
@@ -0,0 +1,72 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package datacoord
+
+import (
+	"context"
+	"net/http"
+	"strconv"
+
+	management "github.com/milvus-io/milvus/internal/http"
+)
+
+// storageMigrationLister is the part of datacoord listing the storage migrations.
+type storageMigrationLister interface {
+	GetStorageMigrations(ctx context.Context, collectionID int64) ([]*StorageMigrationJob, error)
+}
+
+var storageMigrationComponent = management.NewComponent[storageMigrationLister]("datacoord")
+
+// registerStorageMigrationHandler exposes the storage migrations through the management http server,
+// the migration is started or cancelled by the authenticated OperateStorageMigration rpc rather than the management port.
+func registerStorageMigrationHandler(l storageMigrationLister) {
+	storageMigrationComponent.Serve(l, &management.Handler{
+		Path:        management.DataCoordStorageMigrationRouterPath,
+		HandlerFunc: storageMigrationHandler,
+	})
+}
+
+// storageMigrationHandler lists the jobs copying the collection files to another object storage,
+// the jobs of all collections are listed if the collection_id is not specified.
+//
+//	GET /datacoord/storage/migrate?collection_id=445566778899
+func storageMigrationHandler(w http.ResponseWriter, req *http.Request) {
+	if req.Method != http.MethodGet {
+		management.WriteJSON(w, http.StatusMethodNotAllowed, map[string]string{"error": "method not allowed"})
+		return
+	}
+	l, ok := storageMigrationComponent.Get(w)
+	if !ok {
+		return
+	}
+
+	var collectionID int64
+	if value := req.URL.Query().Get("collection_id"); value != "" {
+		var err error
+		collectionID, err = strconv.ParseInt(value, 10, 64)
+		if err != nil {
+			management.WriteJSON(w, http.StatusBadRequest, map[string]string{"error": "invalid collection_id: " + err.Error()})
+			return
+		}
+	}
+	jobs, err := l.GetStorageMigrations(req.Context(), collectionID)
+	if err != nil {
+		management.WriteError(w, err)
+		return
+	}
+	management.WriteJSON(w, http.StatusOK, jobs)
+}
@@ -0,0 +1,246 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package datacoord
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"path"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/milvus-io/milvus-proto/go-api/v2/commonpb"
+	management "github.com/milvus-io/milvus/internal/http"
+	"github.com/milvus-io/milvus/internal/metastore/kv/datacoord"
+	"github.com/milvus-io/milvus/internal/proto/datapb"
+	"github.com/milvus-io/milvus/internal/storage"
+	"github.com/milvus-io/milvus/pkg/util/merr"
+)
+
+type StorageMigrationSuite struct {
+	suite.Suite
+
+	ctx      context.Context
+	migrator *storageMigrator
+	target   storage.ChunkManager
+}
+
+func (s *StorageMigrationSuite) SetupTest() {
+	s.ctx = context.Background()
+	source := storage.NewLocalChunkManager(storage.RootPath(s.T().TempDir()))
+	m, err := newMeta(s.ctx, datacoord.NewCatalog(NewMetaMemoryKV(), "", ""), source)
+	s.Require().NoError(err)
+
+	binlog := path.Join(source.RootPath(), "insert_log/1/10/100/101/1001")
+	deltalog := path.Join(source.RootPath(), "delta_log/1/10/100/1002")
+	s.Require().NoError(source.Write(s.ctx, binlog, []byte("insert data")))
+	s.Require().NoError(source.Write(s.ctx, deltalog, []byte("delete data")))
+	s.Require().NoError(m.AddSegment(s.ctx, NewSegmentInfo(&datapb.SegmentInfo{
+		ID:            100,
+		CollectionID:  1,
+		PartitionID:   10,
+		InsertChannel: "ch1",
+		State:         commonpb.SegmentState_Flushed,
+		Binlogs: []*datapb.FieldBinlog{{FieldID: 101, Binlogs: []*datapb.Binlog{
+			{LogID: 1001, LogPath: binlog, Checksum: storage.BinlogChecksum([]byte("insert data"))},
+		}}},
+		Deltalogs: []*datapb.FieldBinlog{{Binlogs: []*datapb.Binlog{{LogID: 1002, LogPath: deltalog}}}},
+	})))
+
+	s.target = storage.NewLocalChunkManager(storage.RootPath(s.T().TempDir()))
+	s.migrator = newStorageMigrator(m)
+	s.migrator.newChunkManager = func(ctx context.Context, _ *StorageMigrationTarget) (storage.ChunkManager, error) {
+		return s.target, nil
+	}
+}
+
+func (s *StorageMigrationSuite) TearDownTest() {
+	s.migrator.Stop()
+}
+
+func (s *StorageMigrationSuite) waitMigration(collectionID int64) *StorageMigrationJob {
+	var job *StorageMigrationJob
+	s.Eventually(func() bool {
+		job = s.migrator.List(collectionID)[0]
+		return job.State != StorageMigrationRunning
+	}, 10*time.Second, 10*time.Millisecond)
+	return job
+}
+
+func (s *StorageMigrationSuite) TestMigrate() {
+	job, err := s.migrator.Start(1, &StorageMigrationTarget{StorageType: "local", RootPath: s.target.RootPath()})
+	s.Require().NoError(err)
+	s.Equal(StorageMigrationRunning, job.State)
+
+	job = s.waitMigration(1)
+	s.Equal(StorageMigrationCompleted, job.State, job.Reason)
+	s.EqualValues(1, job.SegmentNum)
+	s.EqualValues(2, job.FileNum)
+	s.EqualValues(len("insert data")+len("delete data"), job.TotalBytes)
+
+	data, err := s.target.Read(s.ctx, path.Join(s.target.RootPath(), "insert_log/1/10/100/101/1001"))
+	s.Require().NoError(err)
+	s.Equal([]byte("insert data"), data)
+
+	migrations, err := s.migrator.meta.catalog.ListStorageMigrations(s.ctx)
+	s.Require().NoError(err)
+	s.Require().Len(migrations, 1)
+	s.Equal([]int64{100}, migrations[0].SegmentIDs)
+
+	// the copied files are skipped by the retried migration
+	_, err = s.migrator.Start(1, &StorageMigrationTarget{StorageType: "local", RootPath: s.target.RootPath()})
+	s.Require().NoError(err)
+	job = s.waitMigration(1)
+	s.Equal(StorageMigrationCompleted, job.State, job.Reason)
+	s.EqualValues(2, job.SkippedFileNum)
+
+	recovered := newStorageMigrator(s.migrator.meta)
+	s.Require().NoError(recovered.Recover(s.ctx))
+	jobs := recovered.List(0)
+	s.Require().Len(jobs, 1)
+	s.Equal(StorageMigrationCompleted, jobs[0].State)
+}
+
+func (s *StorageMigrationSuite) TestCorruptedSource() {
+	s.Require().NoError(s.migrator.meta.chunkManager.Write(s.ctx,
+		path.Join(s.migrator.meta.chunkManager.RootPath(), "insert_log/1/10/100/101/1001"), []byte("corrupted")))
+
+	_, err := s.migrator.Start(1, &StorageMigrationTarget{StorageType: "local", RootPath: s.target.RootPath()})
+	s.Require().NoError(err)
+	job := s.waitMigration(1)
+	s.Equal(StorageMigrationFailed, job.State)
+	s.Contains(job.Reason, "checksum")
+
+	migrations, err := s.migrator.meta.catalog.ListStorageMigrations(s.ctx)
+	s.Require().NoError(err)
+	s.Empty(migrations)
+}
+
+func (s *StorageMigrationSuite) TestInvalidTarget() {
+	_, err := s.migrator.Start(1, &StorageMigrationTarget{StorageType: "minio"})
+	s.ErrorIs(err, merr.ErrParameterInvalid)
+	s.ErrorIs(s.migrator.Cancel(1), merr.ErrParameterInvalid)
+}
+
+func (s *StorageMigrationSuite) TestServerNotHealthy() {
+	server := &Server{}
+	server.stateCode.Store(commonpb.StateCode_Abnormal)
+	status, err := server.OperateStorageMigration(s.ctx, &datapb.OperateStorageMigrationRequest{CollectionID: 1})
+	s.NoError(err)
+	s.ErrorIs(merr.Error(status), merr.ErrServiceNotReady)
+	_, err = server.GetStorageMigrations(s.ctx, 1)
+	s.ErrorIs(err, merr.ErrServiceNotReady)
+}
+
+func (s *StorageMigrationSuite) TestServer() {
+	handler := NewNMockHandler(s.T())
+	handler.EXPECT().GetCollection(mock.Anything, int64(1)).Return(&collectionInfo{ID: 1}, nil)
+	handler.EXPECT().GetCollection(mock.Anything, int64(2)).Return(nil, merr.WrapErrCollectionNotFound(int64(2)))
+	server := &Server{handler: handler, storageMigrator: s.migrator}
+	server.stateCode.Store(commonpb.StateCode_Healthy)
+
+	s.Run("start", func() {
+		status, err := server.OperateStorageMigration(s.ctx, &datapb.OperateStorageMigrationRequest{
+			CollectionID: 1,
+			OperateType:  datapb.StorageMigrationOperateType_StartMigration,
+			Target:       &datapb.StorageMigrationTarget{StorageType: "local", RootPath: s.target.RootPath(), SecretAccessKey: "secret"},
+		})
+		s.NoError(err)
+		s.True(merr.Ok(status))
+		job := s.waitMigration(1)
+		s.Equal(StorageMigrationCompleted, job.State, job.Reason)
+
+		jobs, err := server.GetStorageMigrations(s.ctx, 1)
+		s.NoError(err)
+		s.Len(jobs, 1)
+
+		status, err = server.OperateStorageMigration(s.ctx, &datapb.OperateStorageMigrationRequest{
+			CollectionID: 2,
+			OperateType:  datapb.StorageMigrationOperateType_StartMigration,
+		})
+		s.NoError(err)
+		s.ErrorIs(merr.Error(status), merr.ErrCollectionNotFound)
+	})
+
+	s.Run("cancel", func() {
+		status, err := server.OperateStorageMigration(s.ctx, &datapb.OperateStorageMigrationRequest{
+			CollectionID: 1,
+			OperateType:  datapb.StorageMigrationOperateType_CancelMigration,
+		})
+		s.NoError(err)
+		s.ErrorIs(merr.Error(status), merr.ErrParameterInvalid)
+	})
+
+	s.Run("invalid operate type", func() {
+		status, err := server.OperateStorageMigration(s.ctx, &datapb.OperateStorageMigrationRequest{
+			CollectionID: 1,
+			OperateType:  datapb.StorageMigrationOperateType(100),
+		})
+		s.NoError(err)
+		s.ErrorIs(merr.Error(status), merr.ErrParameterInvalid)
+	})
+}
+
+func TestStorageMigration(t *testing.T) {
+	suite.Run(t, new(StorageMigrationSuite))
+}
+
+type mockStorageMigrationLister struct{}
+
+func (m *mockStorageMigrationLister) GetStorageMigrations(ctx context.Context, collectionID int64) ([]*StorageMigrationJob, error) {
+	return []*StorageMigrationJob{{CollectionID: 2, State: StorageMigrationCompleted}}, nil
+}
+
+func Test_storageMigrationHandler(t *testing.T) {
+	// the handler serves a standalone component, the one of the datacoord started by other tests is restored after
+	defer func(c *management.Component[storageMigrationLister]) { storageMigrationComponent = c }(storageMigrationComponent)
+	storageMigrationComponent = management.NewComponent[storageMigrationLister]("datacoord")
+
+	t.Run("not started", func(t *testing.T) {
+		w := httptest.NewRecorder()
+		storageMigrationHandler(w, httptest.NewRequest(http.MethodGet, "/datacoord/storage/migrate", nil))
+		assert.Equal(t, http.StatusServiceUnavailable, w.Code)
+	})
+
+	storageMigrationComponent.Serve(&mockStorageMigrationLister{})
+
+	t.Run("list", func(t *testing.T) {
+		w := httptest.NewRecorder()
+		storageMigrationHandler(w, httptest.NewRequest(http.MethodGet, "/datacoord/storage/migrate?collection_id=2", nil))
+		assert.Equal(t, http.StatusOK, w.Code)
+		jobs := make([]*StorageMigrationJob, 0)
+		require.NoError(t, json.Unmarshal(w.Body.Bytes(), &jobs))
+		assert.Len(t, jobs, 1)
+	})
+
+	t.Run("bad requests", func(t *testing.T) {
+		w := httptest.NewRecorder()
+		storageMigrationHandler(w, httptest.NewRequest(http.MethodGet, "/datacoord/storage/migrate?collection_id=abc", nil))
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+
+		w = httptest.NewRecorder()
+		storageMigrationHandler(w, httptest.NewRequest(http.MethodPost, "/datacoord/storage/migrate", nil))
+		assert.Equal(t, http.StatusMethodNotAllowed, w.Code)
+	})
+}
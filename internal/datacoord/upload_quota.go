@@ -0,0 +1,179 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package datacoord
+
+import (
+	"encoding/json"
+	"path"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"go.uber.org/zap"
+
+	"github.com/milvus-io/milvus/internal/kv"
+	"github.com/milvus-io/milvus/internal/util/uploadquota"
+	"github.com/milvus-io/milvus/pkg/log"
+	"github.com/milvus-io/milvus/pkg/util/logutil"
+)
+
+// uploadQuotaGranter splits the PUT rate limit of each object storage endpoint among the alive datanodes,
+// and saves the grants into etcd, from which the datanodes limit the uploads of flush and compaction,
+// so the PUT rate of the whole cluster stays under the limit of the object storage provider, e.g. during recovery storms.
+type uploadQuotaGranter struct {
+	kv kv.BaseKV
+	// nodeWeights returns the alive datanodes and their shares of the rate limit
+	nodeWeights func() map[int64]int
+
+	startOnce sync.Once
+	stopOnce  sync.Once
+	wg        sync.WaitGroup
+	closeCh   chan struct{}
+}
+
+func newUploadQuotaGranter(kv kv.BaseKV, nodeWeights func() map[int64]int) *uploadQuotaGranter {
+	return &uploadQuotaGranter{
+		kv:          kv,
+		nodeWeights: nodeWeights,
+		closeCh:     make(chan struct{}),
+	}
+}
+
+// start a goroutine and refresh the grants every interval
+func (g *uploadQuotaGranter) start() {
+	g.startOnce.Do(func() {
+		g.wg.Add(1)
+		go g.work()
+	})
+}
+
+func (g *uploadQuotaGranter) work() {
+	defer logutil.LogPanic()
+	defer g.wg.Done()
+	ticker := time.NewTicker(Params.DataCoordCfg.UploadRateLimitGrantInterval.GetAsDuration(time.Second))
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ticker.C:
+			g.grant()
+		case <-g.closeCh:
+			log.Info("upload quota granter quit")
+			return
+		}
+	}
+}
+
+func (g *uploadQuotaGranter) close() {
+	g.stopOnce.Do(func() {
+		close(g.closeCh)
+		g.wg.Wait()
+	})
+}
+
+// grant saves the grants of the alive datanodes and revokes the others,
+// all the grants are revoked if the upload rate limit is disabled.
+func (g *uploadQuotaGranter) grant() {
+	weights := make(map[int64]int)
+	if Params.DataCoordCfg.UploadRateLimitEnable.GetAsBool() {
+		weights = g.nodeWeights()
+	}
+
+	totalWeight := 0
+	for _, weight := range weights {
+		totalWeight += weight
+	}
+	defaultRate, endpointRates := parseUploadPutRates()
+	for nodeID, weight := range weights {
+		share := float64(weight) / float64(totalWeight)
+		grant := &uploadquota.Grant{
+			PutRates:       make(map[string]float64, len(endpointRates)),
+			DefaultPutRate: defaultRate * share,
+		}
+		for endpoint, rate := range endpointRates {
+			grant.PutRates[endpoint] = rate * share
+		}
+		value, err := json.Marshal(grant)
+		if err != nil {
+			log.Warn("failed to marshal upload quota grant", zap.Int64("nodeID", nodeID), zap.Error(err))
+			continue
+		}
+		if err := g.kv.Save(uploadquota.GrantKey(nodeID), string(value)); err != nil {
+			log.Warn("failed to save upload quota grant", zap.Int64("nodeID", nodeID), zap.Error(err))
+		}
+	}
+
+	keys, _, err := g.kv.LoadWithPrefix(uploadquota.GrantPrefix)
+	if err != nil {
+		log.Warn("failed to load upload quota grants", zap.Error(err))
+		return
+	}
+	for _, key := range keys {
+		nodeID, err := strconv.ParseInt(path.Base(key), 10, 64)
+		if err != nil {
+			continue
+		}
+		if _, ok := weights[nodeID]; ok {
+			continue
+		}
+		if err := g.kv.Remove(uploadquota.GrantKey(nodeID)); err != nil {
+			log.Warn("failed to revoke upload quota grant", zap.Int64("nodeID", nodeID), zap.Error(err))
+			continue
+		}
+		log.Info("upload quota grant revoked", zap.Int64("nodeID", nodeID))
+	}
+}
+
+// parseUploadPutRates returns the PUT rate limit of the endpoints not listed, and the ones of the listed endpoints,
+// which are configured like "endpoint1=rate1,endpoint2=rate2".
+func parseUploadPutRates() (float64, map[string]float64) {
+	defaultRate := Params.DataCoordCfg.UploadRateLimitDefaultPutRate.GetAsFloat()
+	endpointRates := make(map[string]float64)
+	for _, item := range strings.Split(Params.DataCoordCfg.UploadRateLimitEndpointPutRates.GetValue(), ",") {
+		item = strings.TrimSpace(item)
+		if item == "" {
+			continue
+		}
+		endpoint, value, ok := strings.Cut(item, "=")
+		if !ok {
+			log.Warn("invalid endpoint put rate, endpoint=rate is expected", zap.String("item", item))
+			continue
+		}
+		rate, err := strconv.ParseFloat(strings.TrimSpace(value), 64)
+		if err != nil {
+			log.Warn("invalid endpoint put rate", zap.String("item", item), zap.Error(err))
+			continue
+		}
+		endpointRates[strings.TrimSpace(endpoint)] = rate
+	}
+	return defaultRate, endpointRates
+}
+
+// uploadQuotaWeights returns the alive datanodes weighted by the number of channels they watch plus one,
+// since the nodes watching more channels flush more.
+func (s *Server) uploadQuotaWeights() map[int64]int {
+	weights := make(map[int64]int)
+	for _, session := range s.cluster.GetSessions() {
+		weights[session.info.NodeID] = 1
+	}
+	for _, info := range s.channelManager.GetAssignedChannels() {
+		if _, ok := weights[info.NodeID]; ok {
+			weights[info.NodeID] += len(info.Channels)
+		}
+	}
+	return weights
+}
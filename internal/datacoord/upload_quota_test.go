@@ -0,0 +1,73 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package datacoord
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/milvus-io/milvus/internal/util/uploadquota"
+	"github.com/milvus-io/milvus/pkg/util/paramtable"
+)
+
+func Test_uploadQuotaGranter(t *testing.T) {
+	paramtable.Init()
+	paramtable.Get().Save(Params.DataCoordCfg.UploadRateLimitEnable.Key, "true")
+	defer paramtable.Get().Reset(Params.DataCoordCfg.UploadRateLimitEnable.Key)
+	paramtable.Get().Save(Params.DataCoordCfg.UploadRateLimitDefaultPutRate.Key, "300")
+	defer paramtable.Get().Reset(Params.DataCoordCfg.UploadRateLimitDefaultPutRate.Key)
+	paramtable.Get().Save(Params.DataCoordCfg.UploadRateLimitEndpointPutRates.Key, "s3.amazonaws.com=3000, localhost:9000 = 600,invalid")
+	defer paramtable.Get().Reset(Params.DataCoordCfg.UploadRateLimitEndpointPutRates.Key)
+
+	kv := NewMetaMemoryKV()
+	weights := map[int64]int{1: 1, 2: 2}
+	granter := newUploadQuotaGranter(kv, func() map[int64]int { return weights })
+
+	loadGrant := func(nodeID int64) *uploadquota.Grant {
+		value, err := kv.Load(uploadquota.GrantKey(nodeID))
+		require.NoError(t, err)
+		grant := &uploadquota.Grant{}
+		require.NoError(t, json.Unmarshal([]byte(value), grant))
+		return grant
+	}
+
+	granter.grant()
+	grant := loadGrant(1)
+	assert.Equal(t, 100.0, grant.PutRateOf("minio:9000"))
+	assert.Equal(t, 1000.0, grant.PutRateOf("s3.amazonaws.com"))
+	assert.Equal(t, 200.0, grant.PutRateOf("localhost:9000"))
+	grant = loadGrant(2)
+	assert.Equal(t, 200.0, grant.PutRateOf("minio:9000"))
+	assert.Equal(t, 2000.0, grant.PutRateOf("s3.amazonaws.com"))
+
+	// node 1 is down, the whole limit is granted to node 2
+	weights = map[int64]int{2: 2}
+	granter.grant()
+	_, err := kv.Load(uploadquota.GrantKey(1))
+	assert.Error(t, err)
+	assert.Equal(t, 300.0, loadGrant(2).PutRateOf("minio:9000"))
+
+	// all grants are revoked once disabled
+	paramtable.Get().Save(Params.DataCoordCfg.UploadRateLimitEnable.Key, "false")
+	granter.grant()
+	keys, _, err := kv.LoadWithPrefix(uploadquota.GrantPrefix)
+	require.NoError(t, err)
+	assert.Empty(t, keys)
+}
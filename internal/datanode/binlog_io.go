@@ -153,7 +153,7 @@ func (b *binlogIO) uploadSegmentFiles(
 
 // genDeltaBlobs returns key, value
 func (b *binlogIO) genDeltaBlobs(data *DeleteData, collID, partID, segID UniqueID) (string, []byte, error) {
-	dCodec := storage.NewDeleteCodec()
+	dCodec := storage.NewDeleteCodec(storage.WithDeltalogFormat(Params.DataNodeCfg.DeltalogFormat.GetValue()))
 
 	blob, err := dCodec.Serialize(collID, partID, segID, data)
 	if err != nil {
@@ -199,7 +199,7 @@ func (b *binlogIO) genInsertBlobs(data *InsertData, partID, segID UniqueID, iCod
 		kvs[key] = value
 		inpaths[fID] = &datapb.FieldBinlog{
 			FieldID: fID,
-			Binlogs: []*datapb.Binlog{{LogSize: int64(fileLen), LogPath: key, EntriesNum: blob.RowNum}},
+			Binlogs: []*datapb.Binlog{{LogSize: int64(fileLen), LogPath: key, EntriesNum: blob.RowNum, Checksum: storage.BinlogChecksum(value)}},
 		}
 	}
 
@@ -230,7 +230,7 @@ func (b *binlogIO) genStatBlobs(stats *storage.PrimaryKeyStats, partID, segID Un
 
 	statPaths[fID] = &datapb.FieldBinlog{
 		FieldID: fID,
-		Binlogs: []*datapb.Binlog{{LogSize: int64(fileLen), LogPath: key, EntriesNum: totRows}},
+		Binlogs: []*datapb.Binlog{{LogSize: int64(fileLen), LogPath: key, EntriesNum: totRows, Checksum: storage.BinlogChecksum(value)}},
 	}
 	return statPaths, nil
 }
@@ -336,6 +336,7 @@ func (b *binlogIO) uploadDeltaLog(
 				EntriesNum: dData.RowCount,
 				LogPath:    k,
 				LogSize:    int64(len(v)),
+				Checksum:   storage.BinlogChecksum(v),
 			}},
 		})
 	} else {
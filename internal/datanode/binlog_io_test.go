@@ -265,6 +265,11 @@ func TestBinlogIOInnerMethods(t *testing.T) {
 				assert.NoError(t, err)
 				assert.Equal(t, 12, len(pin))
 				assert.Equal(t, 12, len(kvs))
+				for _, fieldBinlog := range pin {
+					binlog := fieldBinlog.GetBinlogs()[0]
+					assert.NoError(t, storage.VerifyBinlogChecksum(binlog, kvs[binlog.GetLogPath()]))
+					assert.NotEmpty(t, binlog.GetChecksum())
+				}
 
 				log.Debug("test paths",
 					zap.Any("kvs no.", len(kvs)),
@@ -36,6 +36,13 @@ func (dc *dataCoordBroker) AssignSegmentID(ctx context.Context, reqs ...*datapb.
 		return nil, err
 	}
 
+	for _, assignment := range resp.GetSegIDAssignments() {
+		if err := merr.Error(assignment.GetStatus()); err != nil {
+			log.Warn("failed to assign segment ID", zap.Int64("collectionID", assignment.GetCollectionID()), zap.Error(err))
+			return nil, err
+		}
+	}
+
 	return lo.Map(resp.GetSegIDAssignments(), func(result *datapb.SegmentIDAssignment, _ int) typeutil.UniqueID {
 		return result.GetSegID()
 	}), nil
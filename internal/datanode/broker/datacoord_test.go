@@ -88,6 +88,20 @@ func (s *dataCoordSuite) TestAssignSegmentID() {
 		s.Error(err)
 		s.resetMock()
 	})
+
+	s.Run("datacoord_return_assignment_failure", func() {
+		s.dc.EXPECT().AssignSegmentID(mock.Anything, mock.Anything).
+			Return(&datapb.AssignSegmentIDResponse{
+				Status: merr.Status(nil),
+				SegIDAssignments: []*datapb.SegmentIDAssignment{
+					{Status: merr.Status(merr.WrapErrCollectionReadOnly(100))},
+				},
+			}, nil)
+
+		_, err := s.broker.AssignSegmentID(ctx, reqs...)
+		s.ErrorIs(err, merr.ErrCollectionReadOnly)
+		s.resetMock()
+	})
 }
 
 func (s *dataCoordSuite) TestReportTimeTick() {
@@ -261,7 +261,12 @@ func (pq *PriorityQueue) update(item *Item, memorySize int64) {
 // BufferData buffers insert data, monitoring buffer size and limit
 // size and limit both indicate numOfRows
 type BufferData struct {
-	buffer   *InsertData
+	buffer *InsertData
+	// chunks are the insert data sharing the memory with the consumed insert messages,
+	// which are concatenated into buffer only once before sync, instead of copying on every message.
+	chunks     []*InsertData
+	chunksSize int64
+
 	size     int64
 	limit    int64
 	tsFrom   Timestamp
@@ -298,13 +303,47 @@ func (bd *BufferData) updateStartAndEndPosition(startPos *msgpb.MsgPosition, end
 }
 
 func (bd *BufferData) memorySize() int64 {
-	var size int64
-	for _, field := range bd.buffer.Data {
-		size += int64(field.GetMemorySize())
+	size := bd.chunksSize
+	if bd.buffer != nil {
+		for _, field := range bd.buffer.Data {
+			size += int64(field.GetMemorySize())
+		}
 	}
 	return size
 }
 
+// appendChunk buffers the insert data without copying it.
+func (bd *BufferData) appendChunk(data *InsertData) {
+	// keep appending chunks once there are any, to keep the order of rows
+	if Params.DataNodeCfg.ZeroCopyInsertEnabled.GetAsBool() || len(bd.chunks) > 0 {
+		bd.chunks = append(bd.chunks, data)
+		for _, field := range data.Data {
+			bd.chunksSize += int64(field.GetMemorySize())
+		}
+		return
+	}
+	storage.MergeInsertData(bd.buffer, data)
+}
+
+// mergeChunks concatenates the buffered chunks into buffer for sync, it's idempotent.
+func (bd *BufferData) mergeChunks() {
+	if bd == nil || len(bd.chunks) == 0 {
+		return
+	}
+	datas := bd.chunks
+	if bd.buffer != nil && len(bd.buffer.Data) > 0 {
+		datas = append([]*InsertData{bd.buffer}, bd.chunks...)
+	}
+	if len(datas) == 1 {
+		// nothing to concatenate, the data is serialized without copying
+		bd.buffer = datas[0]
+	} else {
+		bd.buffer = storage.ConcatInsertData(datas...)
+	}
+	bd.chunks = nil
+	bd.chunksSize = 0
+}
+
 // DelDataBuf buffers delete data, monitoring buffer size and limit
 // size and limit both indicate numOfRows
 type DelDataBuf struct {
@@ -98,6 +98,49 @@ func TestBufferData(t *testing.T) {
 	}
 }
 
+func TestBufferData_appendChunk(t *testing.T) {
+	paramtable.Init()
+	newChunk := func(pks ...int64) *InsertData {
+		return &InsertData{Data: map[UniqueID]storage.FieldData{
+			common.RowIDField: &storage.Int64FieldData{Data: pks},
+		}}
+	}
+
+	t.Run("zero copy", func(t *testing.T) {
+		bd, err := newBufferData(genTestCollectionSchema(128, schemapb.DataType_FloatVector))
+		require.NoError(t, err)
+		chunk := newChunk(1, 2)
+		bd.appendChunk(chunk)
+		bd.appendChunk(newChunk(3))
+		assert.Len(t, bd.chunks, 2)
+		assert.Equal(t, 0, bd.buffer.GetRowNum())
+		assert.Equal(t, int64(24), bd.memorySize())
+
+		bd.mergeChunks()
+		assert.Empty(t, bd.chunks)
+		assert.Equal(t, []int64{1, 2, 3}, bd.buffer.Data[common.RowIDField].(*storage.Int64FieldData).Data)
+		assert.Equal(t, int64(24), bd.memorySize())
+		// the merged buffer doesn't share memory with the chunks
+		chunk.Data[common.RowIDField].(*storage.Int64FieldData).Data[0] = 100
+		assert.Equal(t, int64(1), bd.buffer.Data[common.RowIDField].(*storage.Int64FieldData).Data[0])
+
+		// idempotent
+		bd.mergeChunks()
+		assert.Equal(t, 3, bd.buffer.GetRowNum())
+	})
+
+	t.Run("disabled", func(t *testing.T) {
+		paramtable.Get().Save(Params.DataNodeCfg.ZeroCopyInsertEnabled.Key, "false")
+		defer paramtable.Get().Reset(Params.DataNodeCfg.ZeroCopyInsertEnabled.Key)
+
+		bd, err := newBufferData(genTestCollectionSchema(128, schemapb.DataType_FloatVector))
+		require.NoError(t, err)
+		bd.appendChunk(newChunk(1, 2))
+		assert.Empty(t, bd.chunks)
+		assert.Equal(t, 2, bd.buffer.GetRowNum())
+	})
+}
+
 func TestBufferData_updateTimeRange(t *testing.T) {
 	paramtable.Get().Save(Params.DataNodeCfg.FlushInsertBufferSize.Key, strconv.FormatInt(16*(1<<20), 10)) // 16 MB
 
@@ -0,0 +1,293 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package datanode
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"sync"
+
+	"github.com/apache/pulsar-client-go/pulsar"
+	"github.com/golang/protobuf/proto"
+	"go.uber.org/zap"
+
+	"github.com/milvus-io/milvus/internal/storage"
+	"github.com/milvus-io/milvus/pkg/log"
+	"github.com/milvus-io/milvus/pkg/metrics"
+	"github.com/milvus-io/milvus/pkg/mq/msgstream/mqwrapper"
+	kafkawrapper "github.com/milvus-io/milvus/pkg/mq/msgstream/mqwrapper/kafka"
+	pulsarmqwrapper "github.com/milvus-io/milvus/pkg/mq/msgstream/mqwrapper/pulsar"
+	"github.com/milvus-io/milvus/pkg/util/merr"
+	"github.com/milvus-io/milvus/pkg/util/paramtable"
+	"github.com/milvus-io/milvus/pkg/util/retry"
+)
+
+const (
+	cdcEventInsert = "insert"
+	cdcEventDelete = "delete"
+)
+
+// changeEvent is the message published for the inserts or deletes of a segment committed by one sync.
+type changeEvent struct {
+	Type          string `json:"type"`
+	CollectionID  int64  `json:"collectionID"`
+	PartitionID   int64  `json:"partitionID"`
+	SegmentID     int64  `json:"segmentID"`
+	Channel       string `json:"channel"`
+	TimestampFrom uint64 `json:"timestampFrom"`
+	TimestampTo   uint64 `json:"timestampTo"`
+	NumRows       int64  `json:"numRows"`
+	Flushed       bool   `json:"flushed"`
+	// InsertRecord is the marshaled segcorepb.InsertRecord of the inserted rows.
+	InsertRecord []byte   `json:"insertRecord,omitempty"`
+	DeletePks    []any    `json:"deletePks,omitempty"`
+	DeleteTss    []uint64 `json:"deleteTss,omitempty"`
+}
+
+// cdcPublishTask is a committed flush pack waiting to be published.
+type cdcPublishTask struct {
+	pack         *segmentFlushPack
+	collectionID UniqueID
+	partitionID  UniqueID
+	channel      string
+}
+
+// cdcPublisher publishes the changes to the external topic of collection after they are committed to datacoord,
+// so that the downstream pipelines could capture the changes without consuming the internal DML channels.
+//
+// The flush packs are published in the order enqueued by a background worker, so the sync is not slowed down by
+// the external message queue unless the queue is full. A change failed to publish after retries is dropped,
+// and counted by the metric DataNodeCDCPublishCount with status fail.
+type cdcPublisher struct {
+	client      mqwrapper.Client
+	topicPrefix string
+
+	mu        sync.Mutex
+	producers map[UniqueID]mqwrapper.Producer
+
+	tasks     chan *cdcPublishTask
+	ctx       context.Context
+	cancel    context.CancelFunc
+	wg        sync.WaitGroup
+	closeOnce sync.Once
+}
+
+// newCDCPublisher creates a publisher to the external message queue configured.
+func newCDCPublisher() (*cdcPublisher, error) {
+	address := Params.DataNodeCfg.CDCAddress.GetValue()
+	if address == "" {
+		return nil, merr.WrapErrParameterInvalidMsg("address of cdc message queue not set")
+	}
+
+	var client mqwrapper.Client
+	switch mqType := Params.DataNodeCfg.CDCMQType.GetValue(); mqType {
+	case "kafka":
+		client = kafkawrapper.NewKafkaClientInstance(address)
+	case "pulsar":
+		pulsarClient, err := pulsarmqwrapper.NewClient("public", "default", pulsar.ClientOptions{URL: address})
+		if err != nil {
+			return nil, err
+		}
+		client = pulsarClient
+	default:
+		return nil, merr.WrapErrParameterInvalid("kafka or pulsar", mqType, "unsupported cdc message queue type")
+	}
+	return newCDCPublisherWithClient(client, Params.DataNodeCfg.CDCTopicPrefix.GetValue()), nil
+}
+
+func newCDCPublisherWithClient(client mqwrapper.Client, topicPrefix string) *cdcPublisher {
+	ctx, cancel := context.WithCancel(context.Background())
+	p := &cdcPublisher{
+		client:      client,
+		topicPrefix: topicPrefix,
+		producers:   make(map[UniqueID]mqwrapper.Producer),
+		tasks:       make(chan *cdcPublishTask, Params.DataNodeCfg.CDCQueueSize.GetAsInt()),
+		ctx:         ctx,
+		cancel:      cancel,
+	}
+	p.wg.Add(1)
+	go p.work()
+	return p
+}
+
+// enqueue puts the committed flush pack into the queue to publish,
+// blocks if the queue is full until the queue has room, ctx done or the publisher closed.
+func (p *cdcPublisher) enqueue(ctx context.Context, pack *segmentFlushPack, collectionID, partitionID UniqueID, channel string) error {
+	task := &cdcPublishTask{
+		pack:         pack,
+		collectionID: collectionID,
+		partitionID:  partitionID,
+		channel:      channel,
+	}
+	select {
+	case p.tasks <- task:
+		metrics.DataNodeCDCPublishQueueLength.WithLabelValues(fmt.Sprint(paramtable.GetNodeID())).Inc()
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-p.ctx.Done():
+		return merr.WrapErrServiceNotReady(paramtable.GetRole(), paramtable.GetNodeID(), "cdc publisher closed")
+	}
+}
+
+func (p *cdcPublisher) work() {
+	defer p.wg.Done()
+	nodeID := fmt.Sprint(paramtable.GetNodeID())
+	for {
+		select {
+		case <-p.ctx.Done():
+			return
+		case task := <-p.tasks:
+			metrics.DataNodeCDCPublishQueueLength.WithLabelValues(nodeID).Dec()
+			err := p.publish(p.ctx, task.pack, task.collectionID, task.partitionID, task.channel)
+			if err != nil {
+				log.Error("failed to publish changes to cdc topic, the changes are dropped",
+					zap.Int64("collectionID", task.collectionID),
+					zap.Int64("segmentID", task.pack.segmentID),
+					zap.String("channel", task.channel),
+					zap.Error(err))
+				metrics.DataNodeCDCPublishCount.WithLabelValues(nodeID, metrics.FailLabel).Inc()
+				continue
+			}
+			metrics.DataNodeCDCPublishCount.WithLabelValues(nodeID, metrics.SuccessLabel).Inc()
+		}
+	}
+}
+
+func (p *cdcPublisher) topic(collectionID UniqueID) string {
+	return fmt.Sprintf("%s-%d", p.topicPrefix, collectionID)
+}
+
+func (p *cdcPublisher) getProducer(collectionID UniqueID) (mqwrapper.Producer, error) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	if producer, ok := p.producers[collectionID]; ok {
+		return producer, nil
+	}
+	producer, err := p.client.CreateProducer(mqwrapper.ProducerOptions{Topic: p.topic(collectionID)})
+	if err != nil {
+		return nil, err
+	}
+	p.producers[collectionID] = producer
+	return producer, nil
+}
+
+// publish sends the committed inserts and deletes of the flush pack.
+func (p *cdcPublisher) publish(ctx context.Context, pack *segmentFlushPack, collectionID, partitionID UniqueID, channel string) error {
+	events, err := buildChangeEvents(pack, collectionID, partitionID, channel)
+	if err != nil || len(events) == 0 {
+		return err
+	}
+
+	producer, err := p.getProducer(collectionID)
+	if err != nil {
+		return err
+	}
+	for _, event := range events {
+		payload, err := json.Marshal(event)
+		if err != nil {
+			return err
+		}
+		message := &mqwrapper.ProducerMessage{
+			Payload: payload,
+			Properties: map[string]string{
+				"type":        event.Type,
+				"segmentID":   strconv.FormatInt(event.SegmentID, 10),
+				"timestampTo": strconv.FormatUint(event.TimestampTo, 10),
+			},
+		}
+		// retry each event alone, so the events sent are not sent again
+		err = retry.Do(ctx, func() error {
+			_, err := producer.Send(ctx, message)
+			return err
+		}, retry.Attempts(uint(Params.DataNodeCfg.CDCMaxRetries.GetAsInt())))
+		if err != nil {
+			return err
+		}
+	}
+	log.Debug("cdc events published", zap.Int64("collectionID", collectionID),
+		zap.Int64("segmentID", pack.segmentID), zap.Int("events", len(events)))
+	return nil
+}
+
+// close stops publishing, the flush packs still in queue are dropped.
+func (p *cdcPublisher) close() {
+	p.closeOnce.Do(func() {
+		p.cancel()
+		p.wg.Wait()
+
+		p.mu.Lock()
+		defer p.mu.Unlock()
+		for _, producer := range p.producers {
+			producer.Close()
+		}
+		p.producers = make(map[UniqueID]mqwrapper.Producer)
+		p.client.Close()
+	})
+}
+
+func buildChangeEvents(pack *segmentFlushPack, collectionID, partitionID UniqueID, channel string) ([]*changeEvent, error) {
+	var events []*changeEvent
+	if data := pack.insertData; data != nil && data.buffer != nil && data.buffer.GetRowNum() > 0 {
+		record, err := storage.TransferInsertDataToInsertRecord(data.buffer)
+		if err != nil {
+			return nil, err
+		}
+		bytes, err := proto.Marshal(record)
+		if err != nil {
+			return nil, err
+		}
+		events = append(events, &changeEvent{
+			Type:          cdcEventInsert,
+			CollectionID:  collectionID,
+			PartitionID:   partitionID,
+			SegmentID:     pack.segmentID,
+			Channel:       channel,
+			TimestampFrom: data.tsFrom,
+			TimestampTo:   data.tsTo,
+			NumRows:       int64(data.buffer.GetRowNum()),
+			Flushed:       pack.flushed,
+			InsertRecord:  bytes,
+		})
+	}
+	if data := pack.deleteData; data != nil && data.RowCount > 0 {
+		event := &changeEvent{
+			Type:         cdcEventDelete,
+			CollectionID: collectionID,
+			PartitionID:  partitionID,
+			SegmentID:    pack.segmentID,
+			Channel:      channel,
+			NumRows:      data.RowCount,
+			Flushed:      pack.flushed,
+			DeletePks:    make([]any, 0, len(data.Pks)),
+			DeleteTss:    data.Tss,
+		}
+		for i, pk := range data.Pks {
+			event.DeletePks = append(event.DeletePks, pk.GetValue())
+			if i == 0 || data.Tss[i] < event.TimestampFrom {
+				event.TimestampFrom = data.Tss[i]
+			}
+			if data.Tss[i] > event.TimestampTo {
+				event.TimestampTo = data.Tss[i]
+			}
+		}
+		events = append(events, event)
+	}
+	return events, nil
+}
@@ -0,0 +1,222 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package datanode
+
+import (
+	"context"
+	"encoding/json"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/cockroachdb/errors"
+	"github.com/golang/protobuf/proto"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/milvus-io/milvus/internal/proto/segcorepb"
+	"github.com/milvus-io/milvus/internal/storage"
+	"github.com/milvus-io/milvus/pkg/mq/msgstream/mqwrapper"
+	"github.com/milvus-io/milvus/pkg/util/paramtable"
+)
+
+type fakeCDCProducer struct {
+	mu       sync.Mutex
+	messages []*mqwrapper.ProducerMessage
+	err      error
+	attempts int
+	closed   bool
+}
+
+func (p *fakeCDCProducer) Send(ctx context.Context, message *mqwrapper.ProducerMessage) (mqwrapper.MessageID, error) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	p.attempts++
+	if p.err != nil {
+		return nil, p.err
+	}
+	p.messages = append(p.messages, message)
+	return nil, nil
+}
+
+func (p *fakeCDCProducer) sent() int {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	return len(p.messages)
+}
+
+func (p *fakeCDCProducer) Close() {
+	p.closed = true
+}
+
+type fakeCDCClient struct {
+	mqwrapper.Client
+	mu        sync.Mutex
+	producers map[string]*fakeCDCProducer
+	closed    bool
+}
+
+func (c *fakeCDCClient) CreateProducer(options mqwrapper.ProducerOptions) (mqwrapper.Producer, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	producer := &fakeCDCProducer{}
+	c.producers[options.Topic] = producer
+	return producer, nil
+}
+
+func (c *fakeCDCClient) producer(topic string) *fakeCDCProducer {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.producers[topic]
+}
+
+func (c *fakeCDCClient) Close() {
+	c.closed = true
+}
+
+type CDCPublisherSuite struct {
+	suite.Suite
+
+	client    *fakeCDCClient
+	publisher *cdcPublisher
+}
+
+func (s *CDCPublisherSuite) SetupSuite() {
+	paramtable.Init()
+}
+
+func (s *CDCPublisherSuite) SetupTest() {
+	s.client = &fakeCDCClient{producers: make(map[string]*fakeCDCProducer)}
+	s.publisher = newCDCPublisherWithClient(s.client, "cdc")
+}
+
+func (s *CDCPublisherSuite) TearDownTest() {
+	s.publisher.close()
+}
+
+func (s *CDCPublisherSuite) TestPublish() {
+	deleteData := &DeleteData{}
+	deleteData.Append(storage.NewInt64PrimaryKey(1), 200)
+	deleteData.Append(storage.NewInt64PrimaryKey(2), 100)
+	pack := &segmentFlushPack{
+		segmentID: 10,
+		flushed:   true,
+		insertData: &BufferData{
+			buffer: genInsertData(),
+			tsFrom: 50,
+			tsTo:   60,
+		},
+		deleteData: deleteData,
+	}
+
+	err := s.publisher.publish(context.Background(), pack, 1, 2, "ch-1")
+	s.Require().NoError(err)
+	producer := s.client.producers["cdc-1"]
+	s.Require().NotNil(producer)
+	s.Require().Len(producer.messages, 2)
+
+	insert := &changeEvent{}
+	s.Require().NoError(json.Unmarshal(producer.messages[0].Payload, insert))
+	s.Equal(cdcEventInsert, insert.Type)
+	s.EqualValues(1, insert.CollectionID)
+	s.EqualValues(2, insert.PartitionID)
+	s.EqualValues(10, insert.SegmentID)
+	s.Equal("ch-1", insert.Channel)
+	s.EqualValues(50, insert.TimestampFrom)
+	s.EqualValues(60, insert.TimestampTo)
+	s.EqualValues(2, insert.NumRows)
+	s.True(insert.Flushed)
+	record := &segcorepb.InsertRecord{}
+	s.Require().NoError(proto.Unmarshal(insert.InsertRecord, record))
+	s.EqualValues(2, record.GetNumRows())
+	s.Equal("10", producer.messages[0].Properties["segmentID"])
+
+	del := &changeEvent{}
+	s.Require().NoError(json.Unmarshal(producer.messages[1].Payload, del))
+	s.Equal(cdcEventDelete, del.Type)
+	s.EqualValues(2, del.NumRows)
+	s.Len(del.DeletePks, 2)
+	s.Equal([]uint64{200, 100}, del.DeleteTss)
+	s.EqualValues(100, del.TimestampFrom)
+	s.EqualValues(200, del.TimestampTo)
+
+	// producer reused for the same collection
+	err = s.publisher.publish(context.Background(), pack, 1, 2, "ch-1")
+	s.NoError(err)
+	s.Len(s.client.producers, 1)
+	s.Len(producer.messages, 4)
+
+	s.publisher.close()
+	s.True(producer.closed)
+	s.True(s.client.closed)
+}
+
+func (s *CDCPublisherSuite) TestPublishNothing() {
+	err := s.publisher.publish(context.Background(), &segmentFlushPack{segmentID: 10}, 1, 2, "ch-1")
+	s.NoError(err)
+	s.Empty(s.client.producers)
+}
+
+func (s *CDCPublisherSuite) TestSendFailed() {
+	paramtable.Get().Save(Params.DataNodeCfg.CDCMaxRetries.Key, "2")
+	defer paramtable.Get().Reset(Params.DataNodeCfg.CDCMaxRetries.Key)
+
+	producer := &fakeCDCProducer{err: errors.New("mock")}
+	s.publisher.producers[1] = producer
+	deleteData := &DeleteData{}
+	deleteData.Append(storage.NewInt64PrimaryKey(1), 100)
+	err := s.publisher.publish(context.Background(), &segmentFlushPack{segmentID: 10, deleteData: deleteData}, 1, 2, "ch-1")
+	s.Error(err)
+	s.Equal(2, producer.attempts)
+}
+
+func (s *CDCPublisherSuite) TestEnqueue() {
+	producer := &fakeCDCProducer{}
+	s.publisher.producers[1] = producer
+	for i := 0; i < 3; i++ {
+		deleteData := &DeleteData{}
+		deleteData.Append(storage.NewInt64PrimaryKey(int64(i)), 100)
+		err := s.publisher.enqueue(context.Background(), &segmentFlushPack{segmentID: 10, deleteData: deleteData}, 1, 2, "ch-1")
+		s.NoError(err)
+	}
+	s.Eventually(func() bool {
+		return producer.sent() == 3
+	}, 5*time.Second, 10*time.Millisecond)
+
+	s.publisher.close()
+	err := s.publisher.enqueue(context.Background(), &segmentFlushPack{segmentID: 10}, 1, 2, "ch-1")
+	s.Error(err)
+}
+
+func (s *CDCPublisherSuite) TestEnqueueFull() {
+	paramtable.Get().Save(Params.DataNodeCfg.CDCQueueSize.Key, "1")
+	defer paramtable.Get().Reset(Params.DataNodeCfg.CDCQueueSize.Key)
+	// no worker consumes the queue
+	publisher := &cdcPublisher{tasks: make(chan *cdcPublishTask, Params.DataNodeCfg.CDCQueueSize.GetAsInt())}
+	publisher.ctx, publisher.cancel = context.WithCancel(context.Background())
+	defer publisher.cancel()
+
+	err := publisher.enqueue(context.Background(), &segmentFlushPack{segmentID: 10}, 1, 2, "ch-1")
+	s.NoError(err)
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+	err = publisher.enqueue(ctx, &segmentFlushPack{segmentID: 11}, 1, 2, "ch-1")
+	s.ErrorIs(err, context.DeadlineExceeded)
+}
+
+func TestCDCPublisher(t *testing.T) {
+	suite.Run(t, new(CDCPublisherSuite))
+}
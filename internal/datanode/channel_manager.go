@@ -92,6 +92,7 @@ func (m *ChannelManager) GetProgress(info *datapb.ChannelWatchInfo) *datapb.Chan
 		if runner, ok := m.opRunners.Get(channel); ok {
 			if runner.Exist(info.GetOpID()) {
 				resp.State = datapb.ChannelWatchState_ToWatch
+				resp.Progress = runner.Progress(info.GetOpID())
 			} else {
 				resp.State = datapb.ChannelWatchState_WatchFailure
 			}
@@ -261,6 +262,16 @@ func (r *opRunner) Exist(opID UniqueID) bool {
 	return ok
 }
 
+// Progress returns the progress of the watch operation, 0 if it's not started yet.
+func (r *opRunner) Progress(opID UniqueID) int32 {
+	r.guard.RLock()
+	defer r.guard.RUnlock()
+	if info, ok := r.allOps[opID]; ok && info.tickler != nil {
+		return info.tickler.progress()
+	}
+	return 0
+}
+
 func (r *opRunner) Enqueue(info *datapb.ChannelWatchInfo) error {
 	if info.GetState() != datapb.ChannelWatchState_ToWatch &&
 		info.GetState() != datapb.ChannelWatchState_ToRelease {
@@ -474,6 +485,14 @@ func (t *tickler) inc() {
 	t.progressSig <- struct{}{}
 }
 
+// keepAlive resets the watch timer without progress, e.g. while waiting to recover.
+func (t *tickler) keepAlive() {
+	select {
+	case t.progressSig <- struct{}{}:
+	default:
+	}
+}
+
 func (t *tickler) setTotal(total int32) {
 	t.total.Store(total)
 }
@@ -484,7 +503,7 @@ func (t *tickler) progress() int32 {
 	if t.total.Load() == 0 {
 		return t.count.Load()
 	}
-	return (t.count.Load() / t.total.Load()) * 100
+	return t.count.Load() * 100 / t.total.Load()
 }
 
 func (t *tickler) close() {
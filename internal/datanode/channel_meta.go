@@ -20,7 +20,6 @@ import (
 	"context"
 	"fmt"
 	"math"
-	"path"
 	"sync"
 	"time"
 
@@ -63,6 +62,7 @@ type Channel interface {
 
 	addSegment(ctx context.Context, req addSegmentReq) error
 	getSegment(segID UniqueID) *Segment
+	getLevelZeroSegment(partitionID UniqueID) *Segment
 	removeSegments(segID ...UniqueID)
 	hasSegment(segID UniqueID, countFlushed bool) bool
 
@@ -145,6 +145,7 @@ type addSegmentReq struct {
 	binLogs                    []*datapb.FieldBinlog
 	recoverTs                  Timestamp
 	importing                  bool
+	level                      datapb.SegmentLevel
 }
 
 var _ Channel = &ChannelMeta{}
@@ -165,6 +166,7 @@ func newChannel(channelName string, collID UniqueID, schema *schemapb.Collection
 			syncPeriodically(),
 			syncMemoryTooHigh(),
 			syncSegmentsAtTs(),
+			syncLevelZeroPeriodically(),
 		},
 
 		metaService:  metaService,
@@ -227,11 +229,13 @@ func (c *ChannelMeta) addSegment(ctx context.Context, req addSegmentReq) error {
 		zap.Any("endPosition", req.endPos),
 		zap.Uint64("recoverTs", req.recoverTs),
 		zap.Bool("importing", req.importing),
+		zap.String("level", req.level.String()),
 	)
 	seg := &Segment{
 		collectionID:     req.collID,
 		partitionID:      req.partitionID,
 		segmentID:        req.segID,
+		level:            req.level,
 		numRows:          req.numOfRows, // 0 if segType == NEW
 		historyInsertBuf: make([]*BufferData, 0),
 		historyDeleteBuf: make([]*DelDataBuf, 0),
@@ -264,6 +268,23 @@ func (c *ChannelMeta) getSegment(segID UniqueID) *Segment {
 	return seg
 }
 
+// getLevelZeroSegment returns the L0 segment of the partition which is still accepting deletes,
+// the L0 segment is sealed once it starts syncing.
+func (c *ChannelMeta) getLevelZeroSegment(partitionID UniqueID) *Segment {
+	c.segMu.RLock()
+	defer c.segMu.RUnlock()
+
+	for _, seg := range c.segments {
+		if seg.level == datapb.SegmentLevel_L0 &&
+			seg.partitionID == partitionID &&
+			seg.notFlushed() &&
+			!seg.isSyncing() {
+			return seg
+		}
+	}
+	return nil
+}
+
 func (c *ChannelMeta) listCompactedSegmentIDs() map[UniqueID][]UniqueID {
 	c.segMu.RLock()
 	defer c.segMu.RUnlock()
@@ -309,6 +330,9 @@ func (c *ChannelMeta) filterSegments(partitionID UniqueID) []*Segment {
 
 	var results []*Segment
 	for _, seg := range c.segments {
+		if seg.level == datapb.SegmentLevel_L0 {
+			continue
+		}
 		if seg.isValid() &&
 			partitionID == common.InvalidPartitionID || seg.partitionID == partitionID {
 			results = append(results, seg)
@@ -396,36 +420,23 @@ func (c *ChannelMeta) loadStats(ctx context.Context, segmentID int64, collection
 	}
 
 	// filter stats binlog files which is pk field stats log
-	bloomFilterFiles := []string{}
-	logType := storage.DefaultStatsType
-
+	var pkStatsBinlogs []*datapb.Binlog
 	for _, binlog := range statsBinlogs {
-		if binlog.FieldID != pkField {
-			continue
-		}
-	Loop:
-		for _, log := range binlog.GetBinlogs() {
-			_, logidx := path.Split(log.GetLogPath())
-			// if special status log exist
-			// only load one file
-			switch logidx {
-			case storage.CompoundStatsType.LogIdx():
-				bloomFilterFiles = []string{log.GetLogPath()}
-				logType = storage.CompoundStatsType
-				break Loop
-			default:
-				bloomFilterFiles = append(bloomFilterFiles, log.GetLogPath())
-			}
+		if binlog.FieldID == pkField {
+			pkStatsBinlogs = append(pkStatsBinlogs, binlog.GetBinlogs()...)
 		}
 	}
+	// if compound stats log exist, only load it and the ones synced after it
+	pkStatsBinlogs, logType := storage.SelectPkStatsLogs(pkStatsBinlogs)
 
 	// no stats log to parse, initialize a new BF
-	if len(bloomFilterFiles) == 0 {
+	if len(pkStatsBinlogs) == 0 {
 		log.Warn("no stats files to load")
 		return nil, nil
 	}
 
 	// read historical PK filter
+	bloomFilterFiles := lo.Map(pkStatsBinlogs, func(binlog *datapb.Binlog, _ int) string { return binlog.GetLogPath() })
 	values, err := c.chunkManager.MultiRead(ctx, bloomFilterFiles)
 	if err != nil {
 		log.Warn("failed to load bloom filter files", zap.Error(err))
@@ -436,19 +447,10 @@ func (c *ChannelMeta) loadStats(ctx context.Context, segmentID int64, collection
 		blobs = append(blobs, &Blob{Value: values[i]})
 	}
 
-	var stats []*storage.PrimaryKeyStats
-	if logType == storage.CompoundStatsType {
-		stats, err = storage.DeserializeStatsList(blobs[0])
-		if err != nil {
-			log.Warn("failed to deserialize stats list", zap.Error(err))
-			return nil, err
-		}
-	} else {
-		stats, err = storage.DeserializeStats(blobs)
-		if err != nil {
-			log.Warn("failed to deserialize stats", zap.Error(err))
-			return nil, err
-		}
+	stats, err := storage.DeserializePkStatsLogs(blobs, logType)
+	if err != nil {
+		log.Warn("failed to deserialize stats", zap.Int("logType", int(logType)), zap.Error(err))
+		return nil, err
 	}
 
 	var size uint
@@ -515,7 +517,8 @@ func (c *ChannelMeta) listNewSegmentsStartPositions() []*datapb.SegmentStartPosi
 
 	var result []*datapb.SegmentStartPosition
 	for id, seg := range c.segments {
-		if seg.getType() == datapb.SegmentType_New {
+		// L0 segments report their start positions when synced
+		if seg.getType() == datapb.SegmentType_New && seg.level != datapb.SegmentLevel_L0 {
 			result = append(result, &datapb.SegmentStartPosition{
 				SegmentID:     id,
 				StartPosition: seg.startPos,
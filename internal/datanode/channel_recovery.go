@@ -0,0 +1,135 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package datanode
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"go.uber.org/zap"
+
+	"github.com/milvus-io/milvus/internal/proto/datapb"
+	"github.com/milvus-io/milvus/pkg/log"
+)
+
+const (
+	// recoveryWaitingProgress is the progress reported while the channel is waiting to recover,
+	// which must be nonzero, or the watch info updated would be handled as a new watch event.
+	recoveryWaitingProgress int32 = -1
+
+	recoveryKeepAliveInterval = 5 * time.Second
+)
+
+var (
+	recoveryLimiter         *channelRecoveryLimiter
+	recoveryLimiterInitOnce sync.Once
+)
+
+func getOrCreateRecoveryLimiter() *channelRecoveryLimiter {
+	recoveryLimiterInitOnce.Do(func() {
+		recoveryLimiter = newChannelRecoveryLimiter()
+	})
+	return recoveryLimiter
+}
+
+// channelRecoveryLimiter bounds the channels recovering their segments in parallel,
+// by both the number of channels and the memory of the stats logs loaded.
+type channelRecoveryLimiter struct {
+	mu         sync.Mutex
+	running    int
+	memoryUsed int64
+	// released is closed and renewed whenever a recovery finishes
+	released chan struct{}
+}
+
+func newChannelRecoveryLimiter() *channelRecoveryLimiter {
+	return &channelRecoveryLimiter{
+		released: make(chan struct{}),
+	}
+}
+
+// tryAcquire returns whether the recovery is allowed, the memory is clamped to the budget,
+// so that the channel exceeding the budget alone is able to recover exclusively.
+func (l *channelRecoveryLimiter) tryAcquire(memory int64) (int64, bool) {
+	concurrency := Params.DataNodeCfg.ChannelRecoveryConcurrency.GetAsInt()
+	budget := Params.DataNodeCfg.ChannelRecoveryMemoryBudget.GetAsInt64() * 1024 * 1024
+	if memory > budget {
+		memory = budget
+	}
+	if l.running > 0 && (l.running >= concurrency || l.memoryUsed+memory > budget) {
+		return memory, false
+	}
+	l.running++
+	l.memoryUsed += memory
+	return memory, true
+}
+
+// acquire blocks until the channel is allowed to recover with the estimated memory,
+// keepAlive is called periodically while waiting so that the watch doesn't time out.
+// The returned function must be called to release once the recovery finishes.
+func (l *channelRecoveryLimiter) acquire(ctx context.Context, channel string, memory int64, keepAlive func()) (func(), error) {
+	log := log.Ctx(ctx).With(zap.String("channel", channel), zap.Int64("memory", memory))
+	start := time.Now()
+	ticker := time.NewTicker(recoveryKeepAliveInterval)
+	defer ticker.Stop()
+	for {
+		l.mu.Lock()
+		acquired, ok := l.tryAcquire(memory)
+		released := l.released
+		l.mu.Unlock()
+		if ok {
+			if waited := time.Since(start); waited > recoveryKeepAliveInterval {
+				log.Info("channel starts to recover after waiting", zap.Duration("waited", waited))
+			}
+			return func() { l.release(acquired) }, nil
+		}
+
+		select {
+		case <-ctx.Done():
+			log.Warn("channel gives up waiting to recover", zap.Duration("waited", time.Since(start)), zap.Error(ctx.Err()))
+			return nil, ctx.Err()
+		case <-released:
+		case <-ticker.C:
+			keepAlive()
+		}
+	}
+}
+
+func (l *channelRecoveryLimiter) release(memory int64) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	l.running--
+	l.memoryUsed -= memory
+	close(l.released)
+	l.released = make(chan struct{})
+}
+
+// estimateRecoveryMemory returns the memory to load the stats logs of the segments while recovering.
+func estimateRecoveryMemory(segments ...[]*datapb.SegmentInfo) int64 {
+	var memory int64
+	for _, infos := range segments {
+		for _, info := range infos {
+			for _, fieldBinlog := range info.GetStatslogs() {
+				for _, binlog := range fieldBinlog.GetBinlogs() {
+					memory += binlog.GetLogSize()
+				}
+			}
+		}
+	}
+	return memory
+}
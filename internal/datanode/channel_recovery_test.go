@@ -0,0 +1,104 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package datanode
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/milvus-io/milvus/internal/proto/datapb"
+	"github.com/milvus-io/milvus/pkg/util/paramtable"
+)
+
+func TestChannelRecoveryLimiter(t *testing.T) {
+	paramtable.Init()
+	params := paramtable.Get()
+	params.Save(Params.DataNodeCfg.ChannelRecoveryConcurrency.Key, "2")
+	defer params.Reset(Params.DataNodeCfg.ChannelRecoveryConcurrency.Key)
+	params.Save(Params.DataNodeCfg.ChannelRecoveryMemoryBudget.Key, "1")
+	defer params.Reset(Params.DataNodeCfg.ChannelRecoveryMemoryBudget.Key)
+
+	const mb = 1024 * 1024
+	ctx := context.Background()
+	limiter := newChannelRecoveryLimiter()
+	keepAlive := func() {}
+
+	// the channel exceeding the budget alone recovers exclusively
+	release1, err := limiter.acquire(ctx, "ch1", 2*mb, keepAlive)
+	require.NoError(t, err)
+	assert.EqualValues(t, mb, limiter.memoryUsed)
+
+	_, ok := limiter.tryAcquire(0)
+	assert.False(t, ok)
+	release1()
+
+	release2, err := limiter.acquire(ctx, "ch2", mb/2, keepAlive)
+	require.NoError(t, err)
+	release3, err := limiter.acquire(ctx, "ch3", mb/4, keepAlive)
+	require.NoError(t, err)
+
+	// bounded by the concurrency
+	timeoutCtx, cancel := context.WithTimeout(ctx, 100*time.Millisecond)
+	defer cancel()
+	_, err = limiter.acquire(timeoutCtx, "ch4", 0, keepAlive)
+	assert.ErrorIs(t, err, context.DeadlineExceeded)
+
+	acquired := make(chan func())
+	go func() {
+		release, err := limiter.acquire(ctx, "ch4", 0, keepAlive)
+		assert.NoError(t, err)
+		acquired <- release
+	}()
+	release2()
+	release4 := <-acquired
+	assert.Equal(t, 2, limiter.running)
+
+	// bounded by the memory budget
+	release4()
+	timeoutCtx, cancel = context.WithTimeout(ctx, 100*time.Millisecond)
+	defer cancel()
+	_, err = limiter.acquire(timeoutCtx, "ch5", mb, keepAlive)
+	assert.ErrorIs(t, err, context.DeadlineExceeded)
+	release3()
+	assert.Equal(t, 0, limiter.running)
+	assert.EqualValues(t, 0, limiter.memoryUsed)
+}
+
+func TestEstimateRecoveryMemory(t *testing.T) {
+	segments := []*datapb.SegmentInfo{
+		{Statslogs: []*datapb.FieldBinlog{{Binlogs: []*datapb.Binlog{{LogSize: 10}, {LogSize: 20}}}}},
+		{Statslogs: []*datapb.FieldBinlog{{Binlogs: []*datapb.Binlog{{LogSize: 30}}}}},
+	}
+	assert.EqualValues(t, 60, estimateRecoveryMemory(segments[:1], segments[1:]))
+	assert.EqualValues(t, 0, estimateRecoveryMemory(nil))
+}
+
+func TestTicklerProgress(t *testing.T) {
+	tickler := newTickler()
+	tickler.setTotal(4)
+	tickler.inc()
+	assert.EqualValues(t, 25, tickler.progress())
+
+	// keep alive never blocks
+	for i := 0; i < 300; i++ {
+		tickler.keepAlive()
+	}
+}
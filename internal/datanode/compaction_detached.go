@@ -0,0 +1,71 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package datanode
+
+import (
+	"context"
+
+	"github.com/milvus-io/milvus/internal/proto/datapb"
+	"github.com/milvus-io/milvus/pkg/util/merr"
+)
+
+// detachedFlushManager is the flush manager of the compactions executed on the dedicated compaction DataNode,
+// where the channel is not watched and nothing is flushed, so the injections take effect immediately.
+// Only injectFlush is expected to be called by the compaction tasks.
+type detachedFlushManager struct {
+	flushManager
+}
+
+func (m detachedFlushManager) injectFlush(injection *taskInjection, segments ...UniqueID) {
+	go injection.waitForInjected()
+	for range segments {
+		injection.injectOne()
+	}
+}
+
+// newDetachedChannel builds the channel meta of the segments to compact for a channel not watched by this DataNode,
+// the segments compacted from are recorded with the meta got from DataCoord, and the schema is got from RootCoord lazily.
+func (node *DataNode) newDetachedChannel(ctx context.Context, plan *datapb.CompactionPlan) (Channel, error) {
+	segmentIDs := make([]UniqueID, 0, len(plan.GetSegmentBinlogs()))
+	for _, segmentBinlogs := range plan.GetSegmentBinlogs() {
+		segmentIDs = append(segmentIDs, segmentBinlogs.GetSegmentID())
+	}
+	infos, err := node.broker.GetSegmentInfo(ctx, segmentIDs)
+	if err != nil {
+		return nil, err
+	}
+	if len(infos) != len(segmentIDs) {
+		return nil, merr.WrapErrSegmentLack(segmentIDs[0], "segments of compaction plan not found")
+	}
+
+	channel := newChannel(plan.GetChannel(), infos[0].GetCollectionID(), nil, node.broker, node.chunkManager)
+	for _, info := range infos {
+		// the pk stats are not loaded since no data is buffered into the segments compacted from
+		err := channel.addSegment(ctx, addSegmentReq{
+			segType:     datapb.SegmentType_Flushed,
+			segID:       info.GetID(),
+			collID:      info.GetCollectionID(),
+			partitionID: info.GetPartitionID(),
+			numOfRows:   info.GetNumOfRows(),
+			level:       info.GetLevel(),
+		})
+		if err != nil {
+			return nil, err
+		}
+	}
+	return channel, nil
+}
@@ -0,0 +1,88 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package datanode
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/milvus-io/milvus-proto/go-api/v2/milvuspb"
+	"github.com/milvus-io/milvus-proto/go-api/v2/schemapb"
+	"github.com/milvus-io/milvus/internal/datanode/broker"
+	"github.com/milvus-io/milvus/internal/proto/datapb"
+	"github.com/milvus-io/milvus/internal/storage"
+	"github.com/milvus-io/milvus/pkg/util/merr"
+)
+
+func TestDetachedFlushManager(t *testing.T) {
+	ti := newTaskInjection(2, func(pack *segmentFlushPack) {})
+	detachedFlushManager{}.injectFlush(ti, 1, 2)
+	<-ti.Injected()
+	ti.injectDone(true)
+}
+
+func TestNewDetachedChannel(t *testing.T) {
+	ctx := context.Background()
+	meta := NewMetaFactory().GetCollectionMeta(1, "test_collection", schemapb.DataType_Int64)
+	b := broker.NewMockBroker(t)
+	node := &DataNode{
+		broker:       b,
+		chunkManager: storage.NewLocalChunkManager(storage.RootPath(t.TempDir())),
+	}
+	plan := &datapb.CompactionPlan{
+		PlanID:  1,
+		Channel: "ch1",
+		Type:    datapb.CompactionType_MixCompaction,
+		SegmentBinlogs: []*datapb.CompactionSegmentBinlogs{
+			{SegmentID: 100},
+			{SegmentID: 101},
+		},
+	}
+
+	t.Run("ok", func(t *testing.T) {
+		b.EXPECT().GetSegmentInfo(mock.Anything, []int64{100, 101}).Return([]*datapb.SegmentInfo{
+			{ID: 100, CollectionID: 1, PartitionID: 10, NumOfRows: 10},
+			{ID: 101, CollectionID: 1, PartitionID: 10, NumOfRows: 20},
+		}, nil).Once()
+		b.EXPECT().DescribeCollection(mock.Anything, int64(1), mock.Anything).Return(&milvuspb.DescribeCollectionResponse{
+			Status: merr.Success(),
+			Schema: meta.GetSchema(),
+		}, nil)
+
+		channel, err := node.newDetachedChannel(ctx, plan)
+		require.NoError(t, err)
+		assert.EqualValues(t, 1, channel.getCollectionID())
+		assert.EqualValues(t, 20, channel.getSegment(101).numRows)
+		collID, partID, err := channel.getCollectionAndPartitionID(100)
+		assert.NoError(t, err)
+		assert.EqualValues(t, 1, collID)
+		assert.EqualValues(t, 10, partID)
+	})
+
+	t.Run("segment lack", func(t *testing.T) {
+		b.EXPECT().GetSegmentInfo(mock.Anything, []int64{100, 101}).Return([]*datapb.SegmentInfo{
+			{ID: 100, CollectionID: 1, PartitionID: 10, NumOfRows: 10},
+		}, nil).Once()
+
+		_, err := node.newDetachedChannel(ctx, plan)
+		assert.Error(t, err)
+	})
+}
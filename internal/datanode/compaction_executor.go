@@ -70,6 +70,18 @@ func (c *compactionExecutor) injectDone(planID UniqueID, success bool) {
 	}
 }
 
+// discardLevelZeroResult removes the result of a LevelZero compaction plan after reported,
+// these results are never synced back by SyncSegments since no new segment is produced.
+func (c *compactionExecutor) discardLevelZeroResult(planID UniqueID) {
+	task, ok := c.completedCompactor.Get(planID)
+	if !ok {
+		return
+	}
+	if _, isLevelZero := task.(*levelZeroCompactionTask); isLevelZero {
+		c.injectDone(planID, true)
+	}
+}
+
 // These two func are bounded for waitGroup
 func (c *compactionExecutor) executeWithState(task compactor) {
 	go c.executeTask(task)
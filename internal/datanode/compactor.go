@@ -548,6 +548,10 @@ func (t *compactionTask) compact() (*datapb.CompactionResult, error) {
 				pack.err = err
 				return
 			}
+			if err = storage.VerifyBinlogChecksum(insertLog, blob); err != nil {
+				pack.err = err
+				return
+			}
 			err = t.chunkManager.Write(t.ctx, blobPath, blob)
 			if err != nil {
 				pack.err = err
@@ -574,6 +578,10 @@ func (t *compactionTask) compact() (*datapb.CompactionResult, error) {
 				pack.err = err
 				return
 			}
+			if err = storage.VerifyBinlogChecksum(deltaLog, blob); err != nil {
+				pack.err = err
+				return
+			}
 			err = t.chunkManager.Write(t.ctx, blobPath, blob)
 			if err != nil {
 				pack.err = err
@@ -606,6 +614,10 @@ func (t *compactionTask) compact() (*datapb.CompactionResult, error) {
 				pack.err = err
 				return
 			}
+			if err = storage.VerifyBinlogChecksum(statsLog, blob); err != nil {
+				pack.err = err
+				return
+			}
 			err = t.chunkManager.Write(t.ctx, blobPath, blob)
 			if err != nil {
 				pack.err = err
@@ -90,6 +90,7 @@ type DataNode struct {
 	segmentCache       *Cache
 	compactionExecutor *compactionExecutor
 	timeTickSender     *timeTickSender
+	cdcPublisher       *cdcPublisher // nil if cdc disabled
 
 	etcdCli   *clientv3.Client
 	address   string
@@ -201,7 +202,7 @@ func (node *DataNode) Register() error {
 }
 
 func (node *DataNode) initSession() error {
-	node.session = sessionutil.NewSession(node.ctx)
+	node.session = sessionutil.NewSession(node.ctx, sessionutil.WithCompactionDedicated(Params.DataNodeCfg.CompactionDedicated.GetAsBool()))
 	if node.session == nil {
 		return errors.New("failed to initialize session")
 	}
@@ -256,6 +257,16 @@ func (node *DataNode) Init() error {
 		}
 		node.allocator = alloc
 
+		if Params.DataNodeCfg.CDCEnabled.GetAsBool() {
+			node.cdcPublisher, err = newCDCPublisher()
+			if err != nil {
+				log.Error("failed to create cdc publisher", zap.Error(err))
+				initError = err
+				return
+			}
+			log.Info("DataNode server init cdc publisher done", zap.String("mqType", Params.DataNodeCfg.CDCMQType.GetValue()))
+		}
+
 		node.factory.Init(Params)
 		log.Info("DataNode server init succeeded",
 			zap.String("MsgChannelSubName", Params.CommonCfg.DataNodeSubName.GetValue()))
@@ -347,7 +358,10 @@ func (node *DataNode) Start() error {
 			return
 		}
 
-		node.chunkManager = chunkManager
+		uploadLimiter := newUploadLimiter(node.watchKv, paramtable.GetNodeID(), Params.MinioCfg.Address.GetValue())
+		node.chunkManager = newUploadLimitedChunkManager(chunkManager, uploadLimiter)
+		node.stopWaiter.Add(1)
+		go uploadLimiter.start(node.ctx, &node.stopWaiter)
 
 		node.stopWaiter.Add(1)
 		go node.BackGroundGC(node.clearSignal)
@@ -365,6 +379,7 @@ func (node *DataNode) Start() error {
 
 		node.stopWaiter.Add(1)
 		go node.flowgraphManager.start(&node.stopWaiter)
+		registerFlowGraphStatsHandler(node.flowgraphManager)
 
 		node.UpdateStateCode(commonpb.StateCode_Healthy)
 	})
@@ -415,6 +430,10 @@ func (node *DataNode) Stop() error {
 			node.closer.Close()
 		}
 
+		if node.cdcPublisher != nil {
+			node.cdcPublisher.close()
+		}
+
 		if node.session != nil {
 			node.session.Stop()
 		}
@@ -29,9 +29,11 @@ import (
 	"github.com/milvus-io/milvus/internal/storage"
 	"github.com/milvus-io/milvus/internal/util/flowgraph"
 	"github.com/milvus-io/milvus/pkg/log"
+	"github.com/milvus-io/milvus/pkg/metrics"
 	"github.com/milvus-io/milvus/pkg/mq/msgdispatcher"
 	"github.com/milvus-io/milvus/pkg/mq/msgstream"
 	"github.com/milvus-io/milvus/pkg/util/conc"
+	"github.com/milvus-io/milvus/pkg/util/paramtable"
 	"github.com/milvus-io/milvus/pkg/util/retry"
 )
 
@@ -65,6 +67,7 @@ type dataSyncService struct {
 	msFactory    msgstream.Factory
 	dispClient   msgdispatcher.Client
 	chunkManager storage.ChunkManager
+	cdcPublisher *cdcPublisher
 
 	// test only
 	flushListener chan *segmentFlushPack // chan to listen flush event
@@ -111,6 +114,7 @@ func (dsService *dataSyncService) close() {
 			log.Info("dataSyncService closing flowgraph")
 			dsService.dispClient.Deregister(dsService.vchannelName)
 			dsService.fg.Close()
+			metrics.CleanupDataNodeFlowGraphMetrics(paramtable.GetNodeID(), dsService.vchannelName)
 			log.Info("dataSyncService flowgraph closed")
 		}
 
@@ -144,6 +148,13 @@ func getChannelWithTickler(initCtx context.Context, node *DataNode, info *datapb
 	// init channel meta
 	channel := newChannel(channelName, collectionID, info.GetSchema(), node.broker, node.chunkManager)
 
+	// bound the channels recovering in parallel, progress is kept alive while waiting
+	release, err := getOrCreateRecoveryLimiter().acquire(initCtx, channelName, estimateRecoveryMemory(unflushed, flushed), tickler.keepAlive)
+	if err != nil {
+		return nil, err
+	}
+	defer release()
+
 	// tickler will update addSegment progress to watchInfo
 	futures := make([]*conc.Future[any], 0, len(unflushed)+len(flushed))
 	tickler.setTotal(int32(len(unflushed) + len(flushed)))
@@ -225,6 +236,14 @@ func getChannelWithEtcdTickler(initCtx context.Context, node *DataNode, info *da
 	// tickler will update addSegment progress to watchInfo
 	tickler.watch()
 	defer tickler.stop()
+
+	// bound the channels recovering in parallel, progress is kept alive while waiting
+	release, err := getOrCreateRecoveryLimiter().acquire(initCtx, channelName, estimateRecoveryMemory(unflushed, flushed), tickler.keepAlive)
+	if err != nil {
+		return nil, err
+	}
+	defer release()
+
 	futures := make([]*conc.Future[any], 0, len(unflushed)+len(flushed))
 
 	for _, us := range unflushed {
@@ -342,6 +361,7 @@ func getServiceWithChannel(initCtx context.Context, node *DataNode, info *datapb
 		chunkManager:     node.chunkManager,
 		compactor:        node.compactionExecutor,
 		timetickSender:   node.timeTickSender,
+		cdcPublisher:     node.cdcPublisher,
 
 		fg:           nil,
 		flushManager: nil,
@@ -403,6 +423,7 @@ func getServiceWithChannel(initCtx context.Context, node *DataNode, info *datapb
 	if err := fg.AssembleNodes(dmStreamNode, ddNode, insertBufferNode, deleteNode, ttNode); err != nil {
 		return nil, err
 	}
+	fg.SetNodeObserver(newFlowGraphNodeObserver(channelName))
 	ds.fg = fg
 
 	return ds, nil
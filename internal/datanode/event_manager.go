@@ -294,6 +294,8 @@ func isEndWatchState(state datapb.ChannelWatchState) bool {
 type etcdTickler struct {
 	progress *atomic.Int32
 	version  int64
+	// whether to update the watch info even if the progress is not changed
+	alive *atomic.Bool
 
 	kv        kv.WatchKV
 	path      string
@@ -309,6 +311,12 @@ func (t *etcdTickler) inc() {
 	t.progress.Inc()
 }
 
+// keepAlive updates the watch info without progress at the next tick,
+// which resets the watch timer of DataCoord, e.g. while waiting to recover.
+func (t *etcdTickler) keepAlive() {
+	t.alive.Store(true)
+}
+
 func (t *etcdTickler) watch() {
 	if t.interval == 0 {
 		log.Info("zero interval, close ticler watch",
@@ -326,7 +334,11 @@ func (t *etcdTickler) watch() {
 			select {
 			case <-ticker.C:
 				nowProgress := t.progress.Load()
-				if t.watchInfo.Progress == nowProgress {
+				alive := t.alive.CompareAndSwap(true, false)
+				if alive && nowProgress == 0 {
+					nowProgress = recoveryWaitingProgress
+				}
+				if !alive && t.watchInfo.Progress == nowProgress {
 					continue
 				}
 
@@ -377,6 +389,7 @@ func newEtcdTickler(version int64, path string, watchInfo *datapb.ChannelWatchIn
 		version:       version,
 		interval:      interval,
 		closeCh:       make(chan struct{}),
+		alive:         atomic.NewBool(false),
 		isWatchFailed: atomic.NewBool(false),
 	}
 }
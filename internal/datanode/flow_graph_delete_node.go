@@ -25,6 +25,8 @@ import (
 	"go.uber.org/zap"
 
 	"github.com/milvus-io/milvus-proto/go-api/v2/msgpb"
+	"github.com/milvus-io/milvus/internal/datanode/allocator"
+	"github.com/milvus-io/milvus/internal/proto/datapb"
 	"github.com/milvus-io/milvus/internal/storage"
 	"github.com/milvus-io/milvus/pkg/log"
 	"github.com/milvus-io/milvus/pkg/mq/msgstream"
@@ -42,6 +44,7 @@ type deleteNode struct {
 	delBufferManager *DeltaBufferManager // manager of delete msg
 	channel          Channel
 	flushManager     flushManager
+	allocator        allocator.Allocator
 
 	clearSignal chan<- string
 }
@@ -158,6 +161,13 @@ func (dn *deleteNode) bufferDeleteMsg(msg *msgstream.DeleteMsg, tr TimeRange, st
 	log.Debug("bufferDeleteMsg", zap.Any("primary keys", msg.PrimaryKeys), zap.String("vChannelName", dn.channelName))
 
 	primaryKeys := storage.ParseIDs2PrimaryKeys(msg.PrimaryKeys)
+	if Params.DataCoordCfg.EnableLevelZeroSegment.GetAsBool() {
+		segID, err := dn.bufferLevelZeroDeletes(msg.PartitionID, primaryKeys, msg.Timestamps, tr, startPos, endPos)
+		if err != nil {
+			return nil, err
+		}
+		return []UniqueID{segID}, nil
+	}
 	segIDToPks, segIDToTss := dn.filterSegmentByPK(msg.PartitionID, primaryKeys, msg.Timestamps)
 
 	segIDs := make([]UniqueID, 0, len(segIDToPks))
@@ -174,6 +184,43 @@ func (dn *deleteNode) bufferDeleteMsg(msg *msgstream.DeleteMsg, tr TimeRange, st
 	return segIDs, nil
 }
 
+// bufferLevelZeroDeletes buffers the deletes into the L0 segment of the partition without any bloom filter lookup,
+// the deletes are applied to the sealed segments by LevelZero compaction later.
+func (dn *deleteNode) bufferLevelZeroDeletes(partID UniqueID, pks []primaryKey, tss []Timestamp, tr TimeRange, startPos, endPos *msgpb.MsgPosition) (UniqueID, error) {
+	if len(pks) != len(tss) {
+		return 0, fmt.Errorf("primary keys and timestamp's element num mis-match, partitionID = %d", partID)
+	}
+
+	segment := dn.channel.getLevelZeroSegment(partID)
+	if segment == nil {
+		var segID UniqueID
+		err := retry.Do(dn.ctx, func() error {
+			var err error
+			segID, err = dn.allocator.AllocOne()
+			return err
+		}, getFlowGraphRetryOpt())
+		if err != nil {
+			return 0, err
+		}
+		err = dn.channel.addSegment(dn.ctx, addSegmentReq{
+			segType:     datapb.SegmentType_New,
+			segID:       segID,
+			collID:      dn.channel.getCollectionID(),
+			partitionID: partID,
+			startPos:    startPos,
+			endPos:      endPos,
+			level:       datapb.SegmentLevel_L0,
+		})
+		if err != nil {
+			return 0, err
+		}
+		segment = dn.channel.getSegment(segID)
+	}
+
+	dn.delBufferManager.StoreNewDeletes(segment.segmentID, pks, tss, tr, startPos, endPos)
+	return segment.segmentID, nil
+}
+
 // filterSegmentByPK returns the bloom filter check result.
 // If the key may exist in the segment, returns it in map.
 // If the key not exist in the segment, the segment is filter out.
@@ -208,6 +255,7 @@ func newDeleteNode(ctx context.Context, fm flushManager, manager *DeltaBufferMan
 		channel:          config.channel,
 		channelName:      config.vChannelName,
 		flushManager:     fm,
+		allocator:        config.allocator,
 		clearSignal:      sig,
 	}, nil
 }
@@ -180,15 +180,20 @@ func (ibNode *insertBufferNode) Operate(in []Msg) []Msg {
 
 	ibNode.lastTimestamp = endPositions[0].Timestamp
 
+	insertMessages := fgMsg.insertMessages
+	if Params.DataNodeCfg.InsertBatchEnabled.GetAsBool() {
+		insertMessages = batchInsertMsgs(insertMessages, Params.DataNodeCfg.InsertBatchMaxRows.GetAsInt())
+	}
+
 	// Add segment in channel if need and updating segment row number
-	seg2Upload, err := ibNode.addSegmentAndUpdateRowNum(fgMsg.insertMessages, startPositions[0], endPositions[0])
+	seg2Upload, err := ibNode.addSegmentAndUpdateRowNum(insertMessages, startPositions[0], endPositions[0])
 	if err != nil {
 		// Occurs only if the collectionID is mismatch, should not happen
 		log.Fatal("failed to update segment states in channel meta", zap.String("channelName", ibNode.channelName), zap.Error(err))
 	}
 
 	// insert messages -> buffer
-	for _, msg := range fgMsg.insertMessages {
+	for _, msg := range insertMessages {
 		err := ibNode.bufferInsertMsg(msg, startPositions[0], endPositions[0])
 		if err != nil {
 			// error occurs when missing schema info or data is misaligned, should not happen
@@ -465,6 +470,15 @@ func (ibNode *insertBufferNode) Sync(fgMsg *flowGraphMsg, seg2Upload []UniqueID,
 		// check if segment is syncing
 		segment := ibNode.channel.getSegment(task.segmentID)
 
+		if segment.isLevelZero() {
+			// L0 segment is sealed and flushed in a single sync,
+			// the deletes arriving during the sync are buffered into a new L0 segment
+			if segment.isSyncing() {
+				log.RatedInfo(10, "L0 segment is syncing, skip it")
+				continue
+			}
+			task.flushed = true
+		}
 		if !task.dropped && !task.flushed && segment.isSyncing() {
 			log.RatedInfo(10, "segment is syncing, skip it")
 			continue
@@ -614,7 +628,7 @@ func (ibNode *insertBufferNode) bufferInsertMsg(msg *msgstream.InsertMsg, startP
 		}
 	}
 
-	addedBuffer, err := storage.InsertMsgToInsertData(msg, collSchema)
+	addedBuffer, err := storage.InsertMsgToInsertDataShared(msg, collSchema)
 	if err != nil {
 		log.Warn("failed to transfer insert msg to insert data", zap.Error(err))
 		return err
@@ -627,8 +641,8 @@ func (ibNode *insertBufferNode) bufferInsertMsg(msg *msgstream.InsertMsg, startP
 		ibNode.channel.updateSegmentPKRange(currentSegID, addedPfData)
 	}
 
-	// Maybe there are large write zoom if frequent insert requests are met.
-	storage.MergeInsertData(buffer.buffer, addedBuffer)
+	// the insert data is shared with the message until sync, to avoid copying it on every message
+	buffer.appendChunk(addedBuffer)
 
 	tsData, err := storage.GetTimestampFromInsertData(addedBuffer)
 	if err != nil {
@@ -778,7 +792,11 @@ func newInsertBufferNode(
 		metrics.DataNodeProduceTimeTickLag.
 			WithLabelValues(fmt.Sprint(config.serverID), fmt.Sprint(config.collectionID), pChan).
 			Set(float64(sub))
-		return wTtMsgStream.Produce(&msgPack)
+		if err := wTtMsgStream.Produce(&msgPack); err != nil {
+			return err
+		}
+		getTimeTickLagMonitor().onSent(config.vChannelName, ts)
+		return nil
 	})
 
 	return &insertBufferNode{
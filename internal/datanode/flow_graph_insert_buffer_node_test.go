@@ -18,6 +18,7 @@ package datanode
 
 import (
 	"context"
+	"encoding/json"
 	"math"
 	"strconv"
 	"sync"
@@ -737,6 +738,108 @@ func TestInsertBufferNodeRollBF(t *testing.T) {
 	})
 }
 
+func TestInsertBufferNodeSyncPublishChanges(t *testing.T) {
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	paramtable.Get().Save(Params.DataNodeCfg.CDCEnabled.Key, "true")
+	defer paramtable.Get().Reset(Params.DataNodeCfg.CDCEnabled.Key)
+	paramtable.Get().Save(Params.DataNodeCfg.FlushInsertBufferSize.Key, "200")
+	defer paramtable.Get().Reset(Params.DataNodeCfg.FlushInsertBufferSize.Key)
+
+	collMeta := NewMetaFactory().GetCollectionMeta(UniqueID(0), "coll1", schemapb.DataType_Int64)
+	broker := broker.NewMockBroker(t)
+	broker.EXPECT().DescribeCollection(mock.Anything, mock.Anything, mock.Anything).
+		Return(&milvuspb.DescribeCollectionResponse{
+			Status: merr.Status(nil),
+			Schema: collMeta.GetSchema(),
+		}, nil).Maybe()
+	broker.EXPECT().ReportTimeTick(mock.Anything, mock.Anything).
+		Return(nil).Maybe()
+
+	channel := &ChannelMeta{
+		collectionID: collMeta.ID,
+		segments:     make(map[UniqueID]*Segment),
+		isHighMemory: atomic.NewBool(false),
+	}
+	channel.metaService = newMetaService(broker, collMeta.ID)
+
+	client := &fakeCDCClient{producers: make(map[string]*fakeCDCProducer)}
+	publisher := newCDCPublisherWithClient(client, "cdc")
+	defer publisher.close()
+	ds := &dataSyncService{
+		ctx:          ctx,
+		channel:      channel,
+		vchannelName: "string",
+		cdcPublisher: publisher,
+	}
+
+	cm := storage.NewLocalChunkManager(storage.RootPath(insertNodeTestDir))
+	defer cm.RemoveWithPrefix(ctx, cm.RootPath())
+	alloc := allocator.NewMockAllocator(t)
+	alloc.EXPECT().Alloc(mock.Anything).Call.Return(int64(22222),
+		func(count uint32) int64 {
+			return int64(22222 + count)
+		}, nil)
+	wg := sync.WaitGroup{}
+	fm := NewRendezvousFlushManager(alloc, cm, channel, func(pack *segmentFlushPack) {
+		publishChanges(ds, pack)
+		wg.Done()
+	}, emptyFlushAndDropFunc)
+
+	c := &nodeConfig{
+		collectionID: collMeta.ID,
+		channel:      channel,
+		msFactory:    dependency.NewDefaultFactory(true),
+		allocator:    alloc,
+		vChannelName: "string",
+	}
+	delBufManager := &DeltaBufferManager{
+		channel:    channel,
+		delBufHeap: &PriorityQueue{},
+	}
+	iBNode, err := newInsertBufferNode(ctx, make(chan flushMsg, 100), make(chan resendTTMsg, 100), delBufManager, fm, newCache(), newTimeTickSender(broker, 0), c)
+	require.NoError(t, err)
+
+	inMsg := genFlowGraphInsertMsg("")
+	inMsg.insertMessages = NewDataFactory().GetMsgStreamInsertMsgs(1)
+	inMsg.startPositions = []*msgpb.MsgPosition{{Timestamp: 100}}
+	inMsg.endPositions = []*msgpb.MsgPosition{{Timestamp: 123}}
+	iBNode.Operate([]flowgraph.Msg{&inMsg})
+
+	// the second insert triggers the auto sync
+	inMsg.startPositions = []*msgpb.MsgPosition{{Timestamp: 200}}
+	inMsg.endPositions = []*msgpb.MsgPosition{{Timestamp: 234}}
+	output := iBNode.Operate([]flowgraph.Msg{&inMsg})
+	fgm := output[0].(*flowGraphMsg)
+	require.Len(t, fgm.segmentsToSync, 1)
+	segmentID := fgm.segmentsToSync[0]
+
+	// the insert buffer is rolled before the sync committed
+	buf, ok := channel.getCurInsertBuffer(segmentID)
+	assert.False(t, ok)
+	assert.Nil(t, buf)
+
+	wg.Add(1)
+	err = fm.flushDelData(nil, segmentID, fgm.endPositions[0])
+	require.NoError(t, err)
+	wg.Wait()
+
+	var producer *fakeCDCProducer
+	assert.Eventually(t, func() bool {
+		producer = client.producer(publisher.topic(collMeta.ID))
+		return producer != nil && producer.sent() == 1
+	}, 5*time.Second, 10*time.Millisecond)
+
+	producer.mu.Lock()
+	defer producer.mu.Unlock()
+	event := &changeEvent{}
+	require.NoError(t, json.Unmarshal(producer.messages[0].Payload, event))
+	assert.Equal(t, cdcEventInsert, event.Type)
+	assert.Equal(t, segmentID, event.SegmentID)
+	assert.EqualValues(t, 2, event.NumRows)
+}
+
 type InsertBufferNodeSuite struct {
 	suite.Suite
 
@@ -150,6 +150,7 @@ func (fm *flowgraphManager) release(vchanName string) {
 
 		metrics.DataNodeNumFlowGraphs.WithLabelValues(fmt.Sprint(paramtable.GetNodeID())).Dec()
 		rateCol.removeFlowGraphChannel(vchanName)
+		getTimeTickLagMonitor().remove(vchanName)
 	}
 }
 
@@ -0,0 +1,103 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package datanode
+
+import (
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/milvus-io/milvus/internal/util/flowgraph"
+	"github.com/milvus-io/milvus/pkg/metrics"
+	"github.com/milvus-io/milvus/pkg/util/paramtable"
+)
+
+// the types of flowgraph nodes, used as metrics label and in the flowgraph stats
+const (
+	fgNodeTypeDmInput = "dmInput"
+	fgNodeTypeDD      = "dd"
+	fgNodeTypeInsert  = "insert"
+	fgNodeTypeDelete  = "delete"
+	fgNodeTypeTT      = "tt"
+	fgNodeTypeUnknown = "unknown"
+)
+
+// FlowGraphNodeStats is the statistics of a node in the flowgraph.
+type FlowGraphNodeStats struct {
+	Type string `json:"type"`
+	flowgraph.NodeStats
+}
+
+// FlowGraphStats is the topology and statistics of the flowgraph of a channel, the nodes are ordered from upstream
+// to downstream, so a stall could be localized to the first node with the queue piled up.
+type FlowGraphStats struct {
+	Channel      string                `json:"channel"`
+	CollectionID int64                 `json:"collection_id"`
+	Nodes        []*FlowGraphNodeStats `json:"nodes"`
+}
+
+func getFlowGraphNodeType(node flowgraph.Node) string {
+	switch node.(type) {
+	case *flowgraph.InputNode:
+		return fgNodeTypeDmInput
+	case *ddNode:
+		return fgNodeTypeDD
+	case *insertBufferNode:
+		return fgNodeTypeInsert
+	case *deleteNode:
+		return fgNodeTypeDelete
+	case *ttNode:
+		return fgNodeTypeTT
+	default:
+		return fgNodeTypeUnknown
+	}
+}
+
+// newFlowGraphNodeObserver returns the observer recording the operations of the flowgraph nodes of the channel into metrics.
+func newFlowGraphNodeObserver(channel string) flowgraph.NodeObserver {
+	nodeID := fmt.Sprint(paramtable.GetNodeID())
+	return func(node flowgraph.Node, latency time.Duration, queueLength int) {
+		nodeType := getFlowGraphNodeType(node)
+		metrics.DataNodeFlowGraphNodeOperateCount.WithLabelValues(nodeID, channel, nodeType).Inc()
+		metrics.DataNodeFlowGraphNodeOperateLatency.WithLabelValues(nodeID, channel, nodeType).Observe(float64(latency.Milliseconds()))
+		metrics.DataNodeFlowGraphNodeQueueLength.WithLabelValues(nodeID, channel, nodeType).Set(float64(queueLength))
+	}
+}
+
+// getFlowGraphStats returns the flowgraph stats of the channel, or all the channels if channel is empty.
+func (fm *flowgraphManager) getFlowGraphStats(channel string) []*FlowGraphStats {
+	result := make([]*FlowGraphStats, 0)
+	fm.flowgraphs.Range(func(vchannel string, ds *dataSyncService) bool {
+		if (channel != "" && vchannel != channel) || ds.fg == nil {
+			return true
+		}
+		stats := &FlowGraphStats{
+			Channel:      vchannel,
+			CollectionID: ds.collectionID,
+		}
+		for _, nodeStats := range ds.fg.Stats() {
+			stats.Nodes = append(stats.Nodes, &FlowGraphNodeStats{
+				Type:      getFlowGraphNodeType(ds.fg.GetNode(nodeStats.Name)),
+				NodeStats: nodeStats,
+			})
+		}
+		result = append(result, stats)
+		return true
+	})
+	sort.Slice(result, func(i, j int) bool { return result[i].Channel < result[j].Channel })
+	return result
+}
@@ -0,0 +1,58 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package datanode
+
+import (
+	"net/http"
+
+	management "github.com/milvus-io/milvus/internal/http"
+)
+
+var flowGraphStatsComponent = management.NewComponent[*flowgraphManager]("datanode")
+
+// registerFlowGraphStatsHandler exposes the flowgraph stats through the management http server,
+// the handler is registered only once and serves the flowgraphs of the latest started datanode.
+func registerFlowGraphStatsHandler(manager *flowgraphManager) {
+	flowGraphStatsComponent.Serve(manager, &management.Handler{
+		Path:        management.DataNodeFlowGraphRouterPath,
+		HandlerFunc: flowGraphStatsHandler,
+	})
+}
+
+// flowGraphStatsHandler lists the nodes of the flowgraphs with the queue length, operation count and latency,
+// so a stall of the channel could be localized to a specific node.
+//
+//	GET /datanode/flowgraphs
+//	GET /datanode/flowgraphs?channel=by-dev-rootcoord-dml_0_445566778899v0
+func flowGraphStatsHandler(w http.ResponseWriter, req *http.Request) {
+	if req.Method != http.MethodGet {
+		management.WriteJSON(w, http.StatusMethodNotAllowed, map[string]string{"error": "method not allowed"})
+		return
+	}
+	manager, ok := flowGraphStatsComponent.Get(w)
+	if !ok {
+		return
+	}
+
+	channel := req.URL.Query().Get("channel")
+	stats := manager.getFlowGraphStats(channel)
+	if channel != "" && len(stats) == 0 {
+		management.WriteJSON(w, http.StatusNotFound, map[string]string{"error": "channel not found: " + channel})
+		return
+	}
+	management.WriteJSON(w, http.StatusOK, stats)
+}
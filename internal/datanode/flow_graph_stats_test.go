@@ -0,0 +1,88 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package datanode
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	management "github.com/milvus-io/milvus/internal/http"
+	"github.com/milvus-io/milvus/internal/util/flowgraph"
+)
+
+func TestFlowGraphNodeObserver(t *testing.T) {
+	observer := newFlowGraphNodeObserver("ch-1")
+	assert.NotPanics(t, func() {
+		observer(&ddNode{}, time.Millisecond, 1)
+		observer(&flowgraph.InputNode{}, time.Millisecond, 0)
+	})
+	assert.Equal(t, fgNodeTypeInsert, getFlowGraphNodeType(&insertBufferNode{}))
+	assert.Equal(t, fgNodeTypeDelete, getFlowGraphNodeType(&deleteNode{}))
+	assert.Equal(t, fgNodeTypeTT, getFlowGraphNodeType(&ttNode{}))
+}
+
+func TestFlowGraphStatsHandler(t *testing.T) {
+	// the handler serves a standalone component, the one of the datanode started by other tests is restored after
+	defer func(c *management.Component[*flowgraphManager]) { flowGraphStatsComponent = c }(flowGraphStatsComponent)
+	flowGraphStatsComponent = management.NewComponent[*flowgraphManager]("datanode")
+
+	t.Run("not started", func(t *testing.T) {
+		w := httptest.NewRecorder()
+		flowGraphStatsHandler(w, httptest.NewRequest(http.MethodGet, "/datanode/flowgraphs", nil))
+		assert.Equal(t, http.StatusServiceUnavailable, w.Code)
+	})
+
+	fg := flowgraph.NewTimeTickedFlowGraph(context.Background())
+	require.NoError(t, fg.AssembleNodes(
+		&ddNode{collectionID: 1, vChannelName: "ch-1"},
+		&ttNode{vChannelName: "ch-1"},
+	))
+	fm := newFlowgraphManager()
+	fm.flowgraphs.Insert("ch-1", &dataSyncService{collectionID: 1, vchannelName: "ch-1", fg: fg})
+	flowGraphStatsComponent.Serve(fm)
+
+	t.Run("list", func(t *testing.T) {
+		w := httptest.NewRecorder()
+		flowGraphStatsHandler(w, httptest.NewRequest(http.MethodGet, "/datanode/flowgraphs?channel=ch-1", nil))
+		assert.Equal(t, http.StatusOK, w.Code)
+		var stats []*FlowGraphStats
+		require.NoError(t, json.Unmarshal(w.Body.Bytes(), &stats))
+		require.Len(t, stats, 1)
+		assert.Equal(t, "ch-1", stats[0].Channel)
+		require.Len(t, stats[0].Nodes, 2)
+		assert.Equal(t, fgNodeTypeDD, stats[0].Nodes[0].Type)
+		assert.Equal(t, "ttNode-ch-1", stats[0].Nodes[0].Downstream)
+		assert.Equal(t, fgNodeTypeTT, stats[0].Nodes[1].Type)
+	})
+
+	t.Run("bad requests", func(t *testing.T) {
+		w := httptest.NewRecorder()
+		flowGraphStatsHandler(w, httptest.NewRequest(http.MethodGet, "/datanode/flowgraphs?channel=ch-2", nil))
+		assert.Equal(t, http.StatusNotFound, w.Code)
+
+		w = httptest.NewRecorder()
+		flowGraphStatsHandler(w, httptest.NewRequest(http.MethodPost, "/datanode/flowgraphs", nil))
+		assert.Equal(t, http.StatusMethodNotAllowed, w.Code)
+	})
+}
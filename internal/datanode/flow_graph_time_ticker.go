@@ -126,7 +126,11 @@ func (mt *mergedTimeTickerSender) work() {
 		mt.mu.Unlock()
 
 		if isDiffTs {
-			if err := mt.send(lastTs, sids); err != nil {
+			// send in the dedicated pool, which is isolated from data syncing
+			_, err := getOrCreateTimeTickPool().Submit(func() (any, error) {
+				return nil, mt.send(lastTs, sids)
+			}).Await()
+			if err != nil {
 				log.Error("send hard time tick failed", zap.Error(err))
 				mt.mu.Lock()
 				maps.Copy(mt.segmentIDs, lo.SliceToMap(sids, func(t int64) (int64, struct{}) {
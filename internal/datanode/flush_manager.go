@@ -25,6 +25,7 @@ import (
 
 	"github.com/cockroachdb/errors"
 	"github.com/samber/lo"
+	"go.opentelemetry.io/otel"
 	"go.uber.org/atomic"
 	"go.uber.org/zap"
 	"golang.org/x/sync/errgroup"
@@ -74,6 +75,10 @@ type segmentFlushPack struct {
 	flushed    bool
 	dropped    bool
 	err        error // task execution error, if not nil, notify func should stop datanode
+
+	// the data synced, published as changes after committed if cdc enabled
+	insertData *BufferData
+	deleteData *DeleteData
 }
 
 // notifyMetaFunc notify meta to persistent flush result
@@ -362,7 +367,26 @@ func (m *rendezvousFlushManager) serializeBinLog(segmentID, partID int64, data *
 	return blobs, fieldMemorySize, nil
 }
 
-func (m *rendezvousFlushManager) serializePkStatsLog(segmentID int64, flushed bool, data *BufferData, inCodec *storage.InsertCodec) (*Blob, *storage.PrimaryKeyStats, error) {
+// mergeStatsLogs returns whether to persist all the pk stats of the segment into one compound stats log,
+// which is done on flush, or on every sync with new rows once the growing segment has too many stats logs,
+// so that the recovery of the channel reloads the bloom filters from one file.
+func (m *rendezvousFlushManager) mergeStatsLogs(segmentID int64, flushed bool, data *BufferData) bool {
+	if flushed {
+		return true
+	}
+	mergeNum := Params.DataNodeCfg.StatsLogMergeNum.GetAsInt()
+	if mergeNum <= 0 || data == nil || data.size == 0 {
+		return false
+	}
+	seg := m.getSegment(segmentID)
+	// the stats loaded lazily are incomplete, which must not be merged
+	if seg == nil || seg.isLoadingLazy() {
+		return false
+	}
+	return len(seg.historyStats)+1 >= mergeNum
+}
+
+func (m *rendezvousFlushManager) serializePkStatsLog(segmentID int64, compound bool, data *BufferData, inCodec *storage.InsertCodec) (*Blob, *storage.PrimaryKeyStats, error) {
 	var err error
 	var stats *storage.PrimaryKeyStats
 
@@ -385,8 +409,8 @@ func (m *rendezvousFlushManager) serializePkStatsLog(segmentID int64, flushed bo
 	}
 
 	// get all stats log as a list, serialize to blob
-	// if flushed
-	if flushed {
+	// if flushed or merging the stats logs
+	if compound {
 		seg := m.getSegment(segmentID)
 		if seg == nil {
 			return nil, nil, merr.WrapErrSegmentNotFound(segmentID)
@@ -442,6 +466,7 @@ func (m *rendezvousFlushManager) flushBufferData(data *BufferData, segmentID Uni
 		return nil, err
 	}
 	inCodec := storage.NewInsertCodecWithSchema(meta)
+	data.mergeChunks()
 	// build bin log blob
 	binLogBlobs, fieldMemorySize, err := m.serializeBinLog(segmentID, partID, data, inCodec)
 	if err != nil {
@@ -449,15 +474,16 @@ func (m *rendezvousFlushManager) flushBufferData(data *BufferData, segmentID Uni
 	}
 
 	// build stats log blob
-	pkStatsBlob, stats, err := m.serializePkStatsLog(segmentID, flushed, data, inCodec)
+	compound := m.mergeStatsLogs(segmentID, flushed, data)
+	pkStatsBlob, stats, err := m.serializePkStatsLog(segmentID, compound, data, inCodec)
 	if err != nil {
 		return nil, err
 	}
 
 	// allocate
-	// alloc for stats log if have new stats log and not flushing
+	// alloc for stats log if have new stats log and not compound
 	var logidx int64
-	allocNum := uint32(len(binLogBlobs) + boolToInt(!flushed && pkStatsBlob != nil))
+	allocNum := uint32(len(binLogBlobs) + boolToInt(!compound && pkStatsBlob != nil))
 	if allocNum != 0 {
 		logidx, _, err = m.Alloc(allocNum)
 		if err != nil {
@@ -483,6 +509,7 @@ func (m *rendezvousFlushManager) flushBufferData(data *BufferData, segmentID Uni
 			TimestampTo:   data.tsTo,
 			LogPath:       key,
 			LogSize:       int64(fieldMemorySize[fieldID]),
+			Checksum:      storage.BinlogChecksum(blob.Value),
 		}
 
 		logidx += 1
@@ -496,10 +523,10 @@ func (m *rendezvousFlushManager) flushBufferData(data *BufferData, segmentID Uni
 			return nil, err
 		}
 
-		// use storage.FlushedStatsLogIdx as logidx if flushed
+		// use storage.CompoundStatsType.LogIdx() as logidx if compound
 		// else use last idx we allocated
 		var key string
-		if flushed {
+		if compound {
 			k := metautil.JoinIDPath(collID, partID, segmentID, fieldID)
 			key = path.Join(m.ChunkManager.RootPath(), common.SegmentStatslogPath, k, storage.CompoundStatsType.LogIdx())
 		} else {
@@ -514,13 +541,24 @@ func (m *rendezvousFlushManager) flushBufferData(data *BufferData, segmentID Uni
 			TimestampTo:   0, // TODO,
 			LogPath:       key,
 			LogSize:       int64(len(pkStatsBlob.Value)),
+			Checksum:      storage.BinlogChecksum(pkStatsBlob.Value),
 		}
 	}
 
-	m.handleInsertTask(segmentID, &flushBufferInsertTask{
+	task := &flushBufferInsertTask{
 		ChunkManager: m.ChunkManager,
 		data:         kvs,
-	}, field2Insert, field2Stats, flushed, dropped, pos)
+	}
+	// keep the data for change data capture until the sync committed, the buffer is copied since
+	// the insert buffer node rolls and frees the current buffer right after the sync submitted
+	if Params.DataNodeCfg.CDCEnabled.GetAsBool() {
+		task.insertData = &BufferData{
+			buffer: data.buffer,
+			tsFrom: data.tsFrom,
+			tsTo:   data.tsTo,
+		}
+	}
+	m.handleInsertTask(segmentID, task, field2Insert, field2Stats, flushed, dropped, pos)
 
 	metrics.DataNodeEncodeBufferLatency.WithLabelValues(fmt.Sprint(paramtable.GetNodeID())).Observe(float64(tr.ElapseSpan().Milliseconds()))
 	return stats, nil
@@ -541,7 +579,7 @@ func (m *rendezvousFlushManager) flushDelData(data *DelDataBuf, segmentID Unique
 		return err
 	}
 
-	delCodec := storage.NewDeleteCodec()
+	delCodec := storage.NewDeleteCodec(storage.WithDeltalogFormat(Params.DataNodeCfg.DeltalogFormat.GetValue()))
 
 	blob, err := delCodec.Serialize(collID, partID, segmentID, data.delData)
 	if err != nil {
@@ -559,6 +597,7 @@ func (m *rendezvousFlushManager) flushDelData(data *DelDataBuf, segmentID Unique
 	kvs := map[string][]byte{blobPath: blob.Value[:]}
 	data.LogSize = int64(len(blob.Value))
 	data.LogPath = blobPath
+	data.Checksum = storage.BinlogChecksum(blob.Value)
 	log.Info("delete blob path", zap.String("path", blobPath))
 	m.handleDeleteTask(segmentID, &flushBufferDeleteTask{
 		ChunkManager: m.ChunkManager,
@@ -662,12 +701,16 @@ func (m *rendezvousFlushManager) close() {
 
 type flushBufferInsertTask struct {
 	storage.ChunkManager
-	data map[string][]byte
+	data       map[string][]byte
+	insertData *BufferData
 }
 
 // flushInsertData implements flushInsertTask
 func (t *flushBufferInsertTask) flushInsertData() error {
-	ctx, cancel := context.WithCancel(context.Background())
+	// the span links the sync latency to the trace of the sync
+	ctx, sp := otel.Tracer(typeutil.DataNodeRole).Start(context.Background(), "FlushInsertData")
+	defer sp.End()
+	ctx, cancel := context.WithCancel(ctx)
 	defer cancel()
 	if t.ChunkManager != nil && len(t.data) > 0 {
 		tr := timerecord.NewTimeRecorder("insertData")
@@ -680,7 +723,10 @@ func (t *flushBufferInsertTask) flushInsertData() error {
 			})
 		}
 		err := group.Wait()
-		metrics.DataNodeSave2StorageLatency.WithLabelValues(fmt.Sprint(paramtable.GetNodeID()), metrics.InsertLabel).Observe(float64(tr.ElapseSpan().Milliseconds()))
+		if err != nil {
+			sp.RecordError(err)
+		}
+		metrics.ObserveWithTrace(ctx, metrics.DataNodeSave2StorageLatency.WithLabelValues(fmt.Sprint(paramtable.GetNodeID()), metrics.InsertLabel), float64(tr.ElapseSpan().Milliseconds()))
 		if err == nil {
 			for _, d := range t.data {
 				metrics.DataNodeFlushedSize.WithLabelValues(fmt.Sprint(paramtable.GetNodeID()), metrics.InsertLabel).Add(float64(len(d)))
@@ -698,12 +744,18 @@ type flushBufferDeleteTask struct {
 
 // flushDeleteData implements flushDeleteTask
 func (t *flushBufferDeleteTask) flushDeleteData() error {
-	ctx, cancel := context.WithCancel(context.Background())
+	// the span links the sync latency to the trace of the sync
+	ctx, sp := otel.Tracer(typeutil.DataNodeRole).Start(context.Background(), "FlushDeleteData")
+	defer sp.End()
+	ctx, cancel := context.WithCancel(ctx)
 	defer cancel()
 	if len(t.data) > 0 && t.ChunkManager != nil {
 		tr := timerecord.NewTimeRecorder("deleteData")
 		err := t.MultiWrite(ctx, t.data)
-		metrics.DataNodeSave2StorageLatency.WithLabelValues(fmt.Sprint(paramtable.GetNodeID()), metrics.DeleteLabel).Observe(float64(tr.ElapseSpan().Milliseconds()))
+		if err != nil {
+			sp.RecordError(err)
+		}
+		metrics.ObserveWithTrace(ctx, metrics.DataNodeSave2StorageLatency.WithLabelValues(fmt.Sprint(paramtable.GetNodeID()), metrics.DeleteLabel), float64(tr.ElapseSpan().Milliseconds()))
 		if err == nil {
 			for _, d := range t.data {
 				metrics.DataNodeFlushedSize.WithLabelValues(fmt.Sprint(paramtable.GetNodeID()), metrics.DeleteLabel).Add(float64(len(d)))
@@ -837,6 +889,9 @@ func dropVirtualChannelFunc(dsService *dataSyncService, opts ...retry.Option) fl
 			log.Warn("failed to DropVirtualChannel", zap.String("channel", dsService.vchannelName), zap.Error(err))
 			panic(err)
 		}
+		for _, pack := range packs {
+			publishChanges(dsService, pack)
+		}
 		for segID := range segmentPack {
 			dsService.channel.segmentFlushed(segID)
 			dsService.flushingSegCache.Remove(segID)
@@ -844,6 +899,25 @@ func dropVirtualChannelFunc(dsService *dataSyncService, opts ...retry.Option) fl
 	}
 }
 
+// publishChanges enqueues the committed flush pack to publish its changes in background if cdc enabled,
+// the sync waits only if the publish queue is full.
+func publishChanges(dsService *dataSyncService, pack *segmentFlushPack) {
+	if dsService.cdcPublisher == nil {
+		return
+	}
+	collID, partID, err := dsService.channel.getCollectionAndPartitionID(pack.segmentID)
+	if err == nil {
+		err = dsService.cdcPublisher.enqueue(dsService.ctx, pack, collID, partID, dsService.vchannelName)
+	}
+	if err != nil {
+		metrics.DataNodeCDCPublishCount.WithLabelValues(fmt.Sprint(paramtable.GetNodeID()), metrics.FailLabel).Inc()
+		log.Warn("failed to enqueue changes to publish to cdc topic",
+			zap.Int64("segmentID", pack.segmentID),
+			zap.String("channel", dsService.vchannelName),
+			zap.Error(err))
+	}
+}
+
 func flushNotifyFunc(dsService *dataSyncService, opts ...retry.Option) notifyMetaFunc {
 	return func(pack *segmentFlushPack) {
 		if pack.err != nil {
@@ -877,6 +951,13 @@ func flushNotifyFunc(dsService *dataSyncService, opts ...retry.Option) notifyMet
 		})
 
 		startPos := dsService.channel.listNewSegmentsStartPositions()
+		segment := dsService.channel.getSegment(pack.segmentID)
+		if segment.isLevelZero() {
+			startPos = append(startPos, &datapb.SegmentStartPosition{
+				SegmentID:     pack.segmentID,
+				StartPosition: segment.startPos,
+			})
+		}
 
 		log.Info("SaveBinlogPath",
 			zap.Int64("SegmentID", pack.segmentID),
@@ -908,6 +989,11 @@ func flushNotifyFunc(dsService *dataSyncService, opts ...retry.Option) notifyMet
 			Dropped:        pack.dropped,
 			Channel:        dsService.vchannelName,
 		}
+		if segment.isLevelZero() {
+			req.SegLevel = datapb.SegmentLevel_L0
+			req.PartitionID = segment.partitionID
+		}
+		committed := false
 		err := retry.Do(context.Background(), func() error {
 			err := dsService.broker.SaveBinlogPaths(context.Background(), req)
 			// Segment not found during stale segment flush. Segment might get compacted already.
@@ -933,6 +1019,7 @@ func flushNotifyFunc(dsService *dataSyncService, opts ...retry.Option) notifyMet
 			dsService.channel.transferNewSegments(lo.Map(startPos, func(pos *datapb.SegmentStartPosition, _ int) UniqueID {
 				return pos.GetSegmentID()
 			}))
+			committed = true
 			return nil
 		}, opts...)
 		if err != nil {
@@ -942,6 +1029,9 @@ func flushNotifyFunc(dsService *dataSyncService, opts ...retry.Option) notifyMet
 			// TODO change to graceful stop
 			panic(err)
 		}
+		if committed {
+			publishChanges(dsService, pack)
+		}
 		if pack.dropped {
 			dsService.channel.removeSegments(pack.segmentID)
 		} else if pack.flushed {
@@ -954,9 +1044,12 @@ func flushNotifyFunc(dsService *dataSyncService, opts ...retry.Option) notifyMet
 		dsService.flushingSegCache.Remove(req.GetSegmentID())
 		dsService.channel.evictHistoryInsertBuffer(req.GetSegmentID(), pack.pos)
 		dsService.channel.evictHistoryDeleteBuffer(req.GetSegmentID(), pack.pos)
-		segment := dsService.channel.getSegment(req.GetSegmentID())
 		dsService.channel.updateSingleSegmentMemorySize(req.GetSegmentID())
 		segment.setSyncing(false)
+		if segment.isLevelZero() {
+			// L0 segment never accepts deletes after synced, release it
+			dsService.channel.removeSegments(req.GetSegmentID())
+		}
 		// dsService.channel.saveBinlogPath(fieldStats)
 	}
 }
@@ -38,6 +38,7 @@ import (
 	"github.com/milvus-io/milvus/internal/proto/etcdpb"
 	"github.com/milvus-io/milvus/internal/storage"
 	"github.com/milvus-io/milvus/pkg/util/merr"
+	"github.com/milvus-io/milvus/pkg/util/paramtable"
 	"github.com/milvus-io/milvus/pkg/util/retry"
 )
 
@@ -365,6 +366,36 @@ func TestRendezvousFlushManager_getSegmentMeta(t *testing.T) {
 	// assert.Error(t, err)
 }
 
+func TestRendezvousFlushManager_mergeStatsLogs(t *testing.T) {
+	paramtable.Get().Save(Params.DataNodeCfg.StatsLogMergeNum.Key, "2")
+	defer paramtable.Get().Reset(Params.DataNodeCfg.StatsLogMergeNum.Key)
+
+	channel := newTestChannel()
+	seg := &Segment{segmentID: 1}
+	seg.setType(datapb.SegmentType_Normal)
+	channel.segments[1] = seg
+	fm := NewRendezvousFlushManager(allocator.NewMockAllocator(t), nil, channel, func(*segmentFlushPack) {
+	}, emptyFlushAndDropFunc)
+	data := &BufferData{size: 10}
+
+	assert.True(t, fm.mergeStatsLogs(1, true, nil))
+	assert.False(t, fm.mergeStatsLogs(1, false, data))
+
+	seg.historyStats = []*storage.PkStatistics{{}}
+	assert.True(t, fm.mergeStatsLogs(1, false, data))
+	// nothing new to persist
+	assert.False(t, fm.mergeStatsLogs(1, false, &BufferData{}))
+	assert.False(t, fm.mergeStatsLogs(2, false, data))
+
+	// the stats loading lazily are incomplete
+	seg.setLoadingLazy(true)
+	assert.False(t, fm.mergeStatsLogs(1, false, data))
+	seg.setLoadingLazy(false)
+
+	paramtable.Get().Save(Params.DataNodeCfg.StatsLogMergeNum.Key, "0")
+	assert.False(t, fm.mergeStatsLogs(1, false, data))
+}
+
 func TestRendezvousFlushManager_waitForAllFlushQueue(t *testing.T) {
 	ctx, cancel := context.WithCancel(context.Background())
 	defer cancel()
@@ -67,6 +67,8 @@ type flushTaskRunner struct {
 	pos        *msgpb.MsgPosition
 	flushed    bool
 	dropped    bool
+	insertData *BufferData
+	deleteData *DeleteData
 
 	insertErr error // task execution error
 	deleteErr error // task execution error
@@ -133,6 +135,9 @@ func (t *flushTaskRunner) runFlushInsert(task flushInsertTask,
 		t.flushed = flushed
 		t.pos = pos
 		t.dropped = dropped
+		if task, ok := task.(*flushBufferInsertTask); ok {
+			t.insertData = task.insertData
+		}
 		log.Info("running flush insert task",
 			zap.Int64("segmentID", t.segmentID),
 			zap.Bool("flushed", flushed),
@@ -158,6 +163,9 @@ func (t *flushTaskRunner) runFlushDel(task flushDeleteTask, deltaLogs *DelDataBu
 		if deltaLogs == nil {
 			t.deltaLogs = nil // []*DelDataBuf{}
 		} else {
+			if Params.DataNodeCfg.CDCEnabled.GetAsBool() {
+				t.deleteData = deltaLogs.delData
+			}
 			t.deltaLogs = []*datapb.Binlog{
 				{
 					LogSize:       deltaLogs.GetLogSize(),
@@ -165,6 +173,7 @@ func (t *flushTaskRunner) runFlushDel(task flushDeleteTask, deltaLogs *DelDataBu
 					TimestampFrom: deltaLogs.GetTimestampFrom(),
 					TimestampTo:   deltaLogs.GetTimestampTo(),
 					EntriesNum:    deltaLogs.GetEntriesNum(),
+					Checksum:      deltaLogs.GetChecksum(),
 				},
 			}
 		}
@@ -218,6 +227,8 @@ func (t *flushTaskRunner) getFlushPack() *segmentFlushPack {
 		deltaLogs:  t.deltaLogs,
 		flushed:    t.flushed,
 		dropped:    t.dropped,
+		insertData: t.insertData,
+		deleteData: t.deleteData,
 	}
 	log.Debug("flush pack composed",
 		zap.Int64("segmentID", t.segmentID),
@@ -0,0 +1,134 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package datanode
+
+import (
+	"github.com/milvus-io/milvus-proto/go-api/v2/msgpb"
+	"github.com/milvus-io/milvus-proto/go-api/v2/schemapb"
+	"github.com/milvus-io/milvus/pkg/mq/msgstream"
+	"github.com/milvus-io/milvus/pkg/util/typeutil"
+)
+
+// insertBatch is the small insert messages of the same segment to be coalesced.
+type insertBatch struct {
+	msgs []*msgstream.InsertMsg
+	rows uint64
+}
+
+func (b *insertBatch) accept(msg *msgstream.InsertMsg, maxRows uint64) bool {
+	if b.rows+msg.NRows() > maxRows {
+		return false
+	}
+	first := b.msgs[0]
+	if msg.GetPartitionID() != first.GetPartitionID() || len(msg.GetFieldsData()) != len(first.GetFieldsData()) {
+		return false
+	}
+	// the fields are appended by index, the messages before and after a schema change are not coalesced
+	for i, field := range msg.GetFieldsData() {
+		if field.GetFieldId() != first.GetFieldsData()[i].GetFieldId() {
+			return false
+		}
+	}
+	return true
+}
+
+// batchInsertMsgs coalesces the small insert messages of the same segment into batched ones, to reduce the overhead
+// of buffering many tiny inserts, e.g. from the clients inserting row by row.
+// The batched message takes the place of its first message, and the order of the messages of the same segment is kept,
+// since a message not coalesced closes the batch of its segment.
+func batchInsertMsgs(msgs []*msgstream.InsertMsg, maxRows int) []*msgstream.InsertMsg {
+	if len(msgs) <= 1 || maxRows <= 1 {
+		return msgs
+	}
+
+	// the batches and the messages not coalesced in order
+	ordered := make([]*insertBatch, 0, len(msgs))
+	open := make(map[int64]*insertBatch)
+	for _, msg := range msgs {
+		segmentID := msg.GetSegmentID()
+		if !msg.IsColumnBased() || msg.NRows() >= uint64(maxRows) {
+			delete(open, segmentID)
+			ordered = append(ordered, &insertBatch{msgs: []*msgstream.InsertMsg{msg}, rows: msg.NRows()})
+			continue
+		}
+		if batch, ok := open[segmentID]; ok && batch.accept(msg, uint64(maxRows)) {
+			batch.msgs = append(batch.msgs, msg)
+			batch.rows += msg.NRows()
+			continue
+		}
+		batch := &insertBatch{msgs: []*msgstream.InsertMsg{msg}, rows: msg.NRows()}
+		open[segmentID] = batch
+		ordered = append(ordered, batch)
+	}
+	if len(ordered) == len(msgs) {
+		return msgs
+	}
+
+	ret := make([]*msgstream.InsertMsg, 0, len(ordered))
+	for _, batch := range ordered {
+		ret = append(ret, batch.merge())
+	}
+	return ret
+}
+
+// merge merges the messages of batch into one column based message.
+func (b *insertBatch) merge() *msgstream.InsertMsg {
+	if len(b.msgs) == 1 {
+		return b.msgs[0]
+	}
+	first := b.msgs[0]
+	merged := &msgstream.InsertMsg{
+		BaseMsg: msgstream.BaseMsg{
+			Ctx:            first.TraceCtx(),
+			BeginTimestamp: first.BeginTs(),
+			EndTimestamp:   first.EndTs(),
+			HashValues:     make([]uint32, 0, b.rows),
+			MsgPosition:    first.Position(),
+		},
+		InsertRequest: msgpb.InsertRequest{
+			Base:           first.GetBase(),
+			ShardName:      first.GetShardName(),
+			DbName:         first.GetDbName(),
+			CollectionName: first.GetCollectionName(),
+			PartitionName:  first.GetPartitionName(),
+			DbID:           first.GetDbID(),
+			CollectionID:   first.GetCollectionID(),
+			PartitionID:    first.GetPartitionID(),
+			SegmentID:      first.GetSegmentID(),
+			RowIDs:         make([]int64, 0, b.rows),
+			Timestamps:     make([]uint64, 0, b.rows),
+			FieldsData:     make([]*schemapb.FieldData, len(first.GetFieldsData())),
+			Version:        msgpb.InsertDataVersion_ColumnBased,
+			NumRows:        b.rows,
+		},
+	}
+	for _, msg := range b.msgs {
+		if msg.BeginTs() < merged.BeginTimestamp {
+			merged.BeginTimestamp = msg.BeginTs()
+		}
+		if msg.EndTs() > merged.EndTimestamp {
+			merged.EndTimestamp = msg.EndTs()
+		}
+		merged.HashValues = append(merged.HashValues, msg.HashValues...)
+		merged.RowIDs = append(merged.RowIDs, msg.GetRowIDs()...)
+		merged.Timestamps = append(merged.Timestamps, msg.GetTimestamps()...)
+		for i := int64(0); i < int64(msg.NRows()); i++ {
+			typeutil.AppendFieldData(merged.FieldsData, msg.GetFieldsData(), i)
+		}
+	}
+	return merged
+}
@@ -0,0 +1,66 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package datanode
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/milvus-io/milvus/pkg/mq/msgstream"
+)
+
+func TestBatchInsertMsgs(t *testing.T) {
+	df := &DataFactory{}
+	msgs := []*msgstream.InsertMsg{
+		df.GenMsgStreamInsertMsgWithPks(1, 100, 1),
+		df.GenMsgStreamInsertMsgWithPks(2, 101, 2),
+		df.GenMsgStreamInsertMsgWithPks(1, 102, 3, 4),
+		df.GenMsgStreamInsertMsgWithPks(2, 103, 5),
+		// too many rows, closes the batch of segment 1
+		df.GenMsgStreamInsertMsgWithPks(1, 104, 6, 7, 8, 9),
+		df.GenMsgStreamInsertMsgWithPks(1, 105, 10),
+		df.GenMsgStreamInsertMsgWithPks(2, 106, 11, 12),
+	}
+	batched := batchInsertMsgs(msgs, 4)
+	require.Len(t, batched, 4)
+
+	assert.Equal(t, []int64{1, 3, 4}, batched[0].GetRowIDs())
+	assert.EqualValues(t, 3, batched[0].NRows())
+	assert.EqualValues(t, 100, batched[0].BeginTs())
+	assert.EqualValues(t, 102, batched[0].EndTs())
+	assert.Equal(t, []uint64{100, 102, 102}, batched[0].GetTimestamps())
+	assert.Equal(t, []int64{1, 3, 4}, batched[0].GetFieldsData()[0].GetScalars().GetLongData().GetData())
+	assert.Equal(t, []float32{1, 1, 3, 3, 4, 4}, batched[0].GetFieldsData()[1].GetVectors().GetFloatVector().GetData())
+	assert.NoError(t, batched[0].CheckAligned())
+
+	assert.Equal(t, []int64{2, 5, 11, 12}, batched[1].GetRowIDs())
+	assert.Len(t, batched[1].HashValues, 4)
+	assert.NoError(t, batched[1].CheckAligned())
+	assert.Same(t, msgs[4], batched[2])
+	assert.Same(t, msgs[5], batched[3])
+
+	// nothing coalesced
+	msgs = []*msgstream.InsertMsg{df.GenMsgStreamInsertMsgWithPks(1, 100, 1), df.GenMsgStreamInsertMsgWithPks(2, 101, 2)}
+	assert.Equal(t, msgs, batchInsertMsgs(msgs, 4))
+
+	// the fields changed
+	msgs = []*msgstream.InsertMsg{df.GenMsgStreamInsertMsgWithPks(1, 100, 1), df.GenMsgStreamInsertMsgWithPks(1, 101, 2)}
+	msgs[1].FieldsData = msgs[1].FieldsData[:1]
+	assert.Len(t, batchInsertMsgs(msgs, 4), 2)
+}
@@ -17,6 +17,11 @@ var (
 	statsPoolInitOnce sync.Once
 )
 
+var (
+	timeTickPool         *conc.Pool[any]
+	timeTickPoolInitOnce sync.Once
+)
+
 func initIOPool() {
 	capacity := Params.DataNodeCfg.IOConcurrency.GetAsInt()
 	if capacity > 32 {
@@ -44,6 +49,21 @@ func getOrCreateStatsPool() *conc.Pool[any] {
 	return statsPool
 }
 
+func initTimeTickPool() {
+	poolSize := Params.DataNodeCfg.DataNodeTimeTickPoolSize.GetAsInt()
+	if poolSize <= 0 {
+		poolSize = runtime.GOMAXPROCS(0)
+	}
+	timeTickPool = conc.NewPool[any](poolSize, conc.WithPreAlloc(false), conc.WithNonBlocking(false))
+}
+
+// getOrCreateTimeTickPool returns the dedicated pool sending timetick,
+// so that the timetick is not blocked by the data syncing tasks.
+func getOrCreateTimeTickPool() *conc.Pool[any] {
+	timeTickPoolInitOnce.Do(initTimeTickPool)
+	return timeTickPool
+}
+
 func initMultiReadPool() {
 	capacity := Params.DataNodeCfg.FileReadConcurrency.GetAsInt()
 	if capacity > runtime.GOMAXPROCS(0) {
@@ -0,0 +1,233 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package datanode
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/samber/lo"
+	"go.uber.org/zap"
+
+	"github.com/milvus-io/milvus/internal/proto/datapb"
+	"github.com/milvus-io/milvus/internal/proto/etcdpb"
+	"github.com/milvus-io/milvus/internal/storage"
+	"github.com/milvus-io/milvus/pkg/common"
+	"github.com/milvus-io/milvus/pkg/log"
+	"github.com/milvus-io/milvus/pkg/metrics"
+	"github.com/milvus-io/milvus/pkg/util/funcutil"
+	"github.com/milvus-io/milvus/pkg/util/merr"
+	"github.com/milvus-io/milvus/pkg/util/paramtable"
+	"github.com/milvus-io/milvus/pkg/util/timerecord"
+)
+
+// make sure levelZeroCompactionTask implements compactor interface
+var _ compactor = (*levelZeroCompactionTask)(nil)
+
+// levelZeroCompactionTask applies the deletes of L0 segments to the sealed segments of the same channel.
+// The deletes are split by the pk bloom filters of the target segments and written as new deltalogs of them,
+// no insert data is rewritten.
+type levelZeroCompactionTask struct {
+	downloader
+	uploader
+	Channel
+
+	plan *datapb.CompactionPlan
+
+	ctx    context.Context
+	cancel context.CancelFunc
+
+	done chan struct{}
+	tr   *timerecord.TimeRecorder
+}
+
+func newLevelZeroCompactionTask(
+	ctx context.Context,
+	dl downloader,
+	ul uploader,
+	channel Channel,
+	plan *datapb.CompactionPlan,
+) *levelZeroCompactionTask {
+	ctx1, cancel := context.WithCancel(ctx)
+	return &levelZeroCompactionTask{
+		ctx:    ctx1,
+		cancel: cancel,
+
+		downloader: dl,
+		uploader:   ul,
+		Channel:    channel,
+		plan:       plan,
+		tr:         timerecord.NewTimeRecorder("levelZeroCompactionTask"),
+		done:       make(chan struct{}, 1),
+	}
+}
+
+func (t *levelZeroCompactionTask) complete() {
+	t.done <- struct{}{}
+}
+
+func (t *levelZeroCompactionTask) stop() {
+	t.cancel()
+	<-t.done
+}
+
+// injectDone is a no-op, LevelZero compaction never blocks the flush of the channel
+func (t *levelZeroCompactionTask) injectDone(success bool) {}
+
+func (t *levelZeroCompactionTask) getPlanID() UniqueID {
+	return t.plan.GetPlanID()
+}
+
+func (t *levelZeroCompactionTask) getChannelName() string {
+	return t.plan.GetChannel()
+}
+
+func (t *levelZeroCompactionTask) getCollection() UniqueID {
+	return t.getCollectionID()
+}
+
+func (t *levelZeroCompactionTask) compact() (*datapb.CompactionResult, error) {
+	log := log.With(zap.Int64("planID", t.plan.GetPlanID()), zap.String("channel", t.plan.GetChannel()))
+	if ok := funcutil.CheckCtxValid(t.ctx); !ok {
+		log.Warn("compact wrong, task context done or timeout")
+		return nil, errContext
+	}
+	if t.plan.GetType() != datapb.CompactionType_Level0DeleteCompaction {
+		log.Warn("compact wrong, not a level zero compaction plan", zap.String("type", t.plan.GetType().String()))
+		return nil, errIllegalCompactionPlan
+	}
+
+	durInQueue := t.tr.RecordSpan()
+	ctxTimeout, cancelAll := context.WithTimeout(t.ctx, time.Duration(t.plan.GetTimeoutInSeconds())*time.Second)
+	defer cancelAll()
+
+	var l0Segments, targetSegments []*datapb.CompactionSegmentBinlogs
+	for _, s := range t.plan.GetSegmentBinlogs() {
+		if s.GetLevel() == datapb.SegmentLevel_L0 {
+			l0Segments = append(l0Segments, s)
+		} else {
+			targetSegments = append(targetSegments, s)
+		}
+	}
+	if len(l0Segments) == 0 {
+		log.Warn("compact wrong, there's no L0 segments in plan")
+		return nil, errIllegalCompactionPlan
+	}
+
+	// partitionID -> deletes, common.InvalidPartitionID for the deletes applied to all partitions
+	partitionDeletes, err := t.loadDeletes(ctxTimeout, l0Segments)
+	if err != nil {
+		log.Warn("compact wrong, failed to load L0 deltalogs", zap.Error(err))
+		return nil, err
+	}
+
+	meta := &etcdpb.CollectionMeta{ID: t.getCollectionID()}
+	results := make([]*datapb.CompactionSegmentBinlogs, 0, len(targetSegments))
+	for _, target := range targetSegments {
+		segment := t.getSegment(target.GetSegmentID())
+		if segment == nil {
+			err := merr.WrapErrSegmentNotFound(target.GetSegmentID(), "target segment of level zero compaction")
+			log.Warn("compact wrong", zap.Error(err))
+			return nil, err
+		}
+
+		deletes := t.filterDeletes(segment, partitionDeletes[common.InvalidPartitionID], partitionDeletes[target.GetPartitionID()])
+		if deletes.RowCount == 0 {
+			continue
+		}
+		deltalogs, err := t.uploadDeltaLog(ctxTimeout, target.GetSegmentID(), target.GetPartitionID(), deletes, meta)
+		if err != nil {
+			log.Warn("compact wrong, failed to upload deltalogs", zap.Int64("segmentID", target.GetSegmentID()), zap.Error(err))
+			return nil, err
+		}
+		results = append(results, &datapb.CompactionSegmentBinlogs{
+			SegmentID:     target.GetSegmentID(),
+			Deltalogs:     deltalogs,
+			InsertChannel: t.plan.GetChannel(),
+			Level:         target.GetLevel(),
+			PartitionID:   target.GetPartitionID(),
+		})
+	}
+
+	log.Info("level zero compaction done",
+		zap.Int("l0SegmentNum", len(l0Segments)),
+		zap.Int("targetSegmentNum", len(targetSegments)),
+		zap.Int("affectedSegmentNum", len(results)),
+		zap.Duration("elapse", t.tr.ElapseSpan()))
+	metrics.DataNodeCompactionLatency.WithLabelValues(fmt.Sprint(paramtable.GetNodeID())).Observe(float64(t.tr.ElapseSpan().Milliseconds()))
+	metrics.DataNodeCompactionLatencyInQueue.WithLabelValues(fmt.Sprint(paramtable.GetNodeID())).Observe(float64(durInQueue.Milliseconds()))
+
+	return &datapb.CompactionResult{
+		PlanID:            t.plan.GetPlanID(),
+		Channel:           t.plan.GetChannel(),
+		LevelZeroSegments: results,
+	}, nil
+}
+
+// loadDeletes downloads the deltalogs of L0 segments and groups the deletes by partition
+func (t *levelZeroCompactionTask) loadDeletes(ctx context.Context, l0Segments []*datapb.CompactionSegmentBinlogs) (map[UniqueID]*DeleteData, error) {
+	dCodec := storage.NewDeleteCodec()
+	partitionDeletes := make(map[UniqueID]*DeleteData)
+	for _, segment := range l0Segments {
+		binlogs := lo.FlatMap(segment.GetDeltalogs(), func(fieldBinlog *datapb.FieldBinlog, _ int) []*datapb.Binlog {
+			return fieldBinlog.GetBinlogs()
+		})
+		if len(binlogs) == 0 {
+			continue
+		}
+		blobs, err := t.download(ctx, lo.Map(binlogs, func(binlog *datapb.Binlog, _ int) string { return binlog.GetLogPath() }))
+		if err != nil {
+			return nil, err
+		}
+		for i, blob := range blobs {
+			if err := storage.VerifyBinlogChecksum(binlogs[i], blob.GetValue()); err != nil {
+				return nil, err
+			}
+		}
+		_, _, dData, err := dCodec.Deserialize(blobs)
+		if err != nil {
+			return nil, err
+		}
+
+		deletes, ok := partitionDeletes[segment.GetPartitionID()]
+		if !ok {
+			deletes = &DeleteData{}
+			partitionDeletes[segment.GetPartitionID()] = deletes
+		}
+		for i, pk := range dData.Pks {
+			deletes.Append(pk, dData.Tss[i])
+		}
+	}
+	return partitionDeletes, nil
+}
+
+// filterDeletes returns the deletes which may hit the rows of the segment
+func (t *levelZeroCompactionTask) filterDeletes(segment *Segment, deletesList ...*DeleteData) *DeleteData {
+	result := &DeleteData{}
+	for _, deletes := range deletesList {
+		if deletes == nil {
+			continue
+		}
+		for i, pk := range deletes.Pks {
+			if segment.isPKExist(pk) {
+				result.Append(pk, deletes.Tss[i])
+			}
+		}
+	}
+	return result
+}
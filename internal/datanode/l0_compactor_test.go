@@ -0,0 +1,130 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package datanode
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/milvus-io/milvus-proto/go-api/v2/milvuspb"
+	"github.com/milvus-io/milvus-proto/go-api/v2/schemapb"
+	"github.com/milvus-io/milvus/internal/datanode/allocator"
+	"github.com/milvus-io/milvus/internal/datanode/broker"
+	"github.com/milvus-io/milvus/internal/proto/datapb"
+	"github.com/milvus-io/milvus/internal/storage"
+	"github.com/milvus-io/milvus/pkg/common"
+	"github.com/milvus-io/milvus/pkg/util/merr"
+)
+
+func TestLevelZeroCompactionTask(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	cm := storage.NewLocalChunkManager(storage.RootPath(compactTestDir))
+	defer cm.RemoveWithPrefix(ctx, cm.RootPath())
+
+	const (
+		collID = UniqueID(1)
+		partID = UniqueID(10)
+	)
+	collMeta := NewMetaFactory().GetCollectionMeta(collID, "test_l0_compact", schemapb.DataType_Int64)
+	broker := broker.NewMockBroker(t)
+	broker.EXPECT().DescribeCollection(mock.Anything, mock.Anything, mock.Anything).
+		Return(&milvuspb.DescribeCollectionResponse{
+			Status:       merr.Status(nil),
+			Schema:       collMeta.GetSchema(),
+			CollectionID: collID,
+			ShardsNum:    common.DefaultShardsNum,
+		}, nil).Maybe()
+	alloc := allocator.NewMockAllocator(t)
+	alloc.EXPECT().AllocOne().Return(int64(19530), nil).Maybe()
+	bIO := &binlogIO{cm, alloc}
+
+	channel := newChannel("ch-1", collID, collMeta.GetSchema(), broker, cm)
+	require.NoError(t, channel.addFlushedSegmentWithPKs(100, collID, partID, 2, &storage.Int64FieldData{Data: []int64{1, 2}}))
+	require.NoError(t, channel.addFlushedSegmentWithPKs(101, collID, partID, 2, &storage.Int64FieldData{Data: []int64{8, 9}}))
+
+	l0Deletes := &DeleteData{
+		Pks:      []primaryKey{newInt64PrimaryKey(1), newInt64PrimaryKey(5)},
+		Tss:      []Timestamp{20000, 20001},
+		RowCount: 2,
+	}
+	deltalogs, err := bIO.uploadDeltaLog(ctx, 200, partID, l0Deletes, collMeta)
+	require.NoError(t, err)
+
+	plan := &datapb.CompactionPlan{
+		PlanID: 1,
+		SegmentBinlogs: []*datapb.CompactionSegmentBinlogs{
+			{SegmentID: 200, Deltalogs: deltalogs, Level: datapb.SegmentLevel_L0, PartitionID: partID},
+			{SegmentID: 100, Level: datapb.SegmentLevel_L1, PartitionID: partID},
+			{SegmentID: 101, Level: datapb.SegmentLevel_L1, PartitionID: partID},
+		},
+		TimeoutInSeconds: 10,
+		Type:             datapb.CompactionType_Level0DeleteCompaction,
+		Channel:          "ch-1",
+	}
+
+	t.Run("compact", func(t *testing.T) {
+		task := newLevelZeroCompactionTask(ctx, bIO, bIO, channel, plan)
+		result, err := task.compact()
+		require.NoError(t, err)
+		assert.Equal(t, plan.GetPlanID(), result.GetPlanID())
+		// pk 1 only hits segment 100
+		require.Len(t, result.GetLevelZeroSegments(), 1)
+		target := result.GetLevelZeroSegments()[0]
+		assert.EqualValues(t, 100, target.GetSegmentID())
+		assert.NotEmpty(t, target.GetDeltalogs())
+
+		task.complete()
+		task.stop()
+	})
+
+	t.Run("illegal plan", func(t *testing.T) {
+		task := newLevelZeroCompactionTask(ctx, bIO, bIO, channel, &datapb.CompactionPlan{
+			PlanID:         2,
+			Type:           datapb.CompactionType_MixCompaction,
+			SegmentBinlogs: plan.GetSegmentBinlogs(),
+		})
+		_, err := task.compact()
+		assert.ErrorIs(t, err, errIllegalCompactionPlan)
+
+		task = newLevelZeroCompactionTask(ctx, bIO, bIO, channel, &datapb.CompactionPlan{
+			PlanID:         3,
+			Type:           datapb.CompactionType_Level0DeleteCompaction,
+			SegmentBinlogs: plan.GetSegmentBinlogs()[1:],
+		})
+		_, err = task.compact()
+		assert.ErrorIs(t, err, errIllegalCompactionPlan)
+	})
+
+	t.Run("target not found", func(t *testing.T) {
+		task := newLevelZeroCompactionTask(ctx, bIO, bIO, channel, &datapb.CompactionPlan{
+			PlanID:           4,
+			TimeoutInSeconds: 10,
+			Type:             datapb.CompactionType_Level0DeleteCompaction,
+			SegmentBinlogs: []*datapb.CompactionSegmentBinlogs{
+				plan.GetSegmentBinlogs()[0],
+				{SegmentID: 999, Level: datapb.SegmentLevel_L1, PartitionID: partID},
+			},
+		})
+		_, err := task.compact()
+		assert.Error(t, err)
+	})
+}
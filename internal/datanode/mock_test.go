@@ -804,6 +804,50 @@ func (df *DataFactory) GenMsgStreamInsertMsgWithTs(idx int, chanName string, ts
 	return msg
 }
 
+// GenMsgStreamInsertMsgWithPks generates the insert message of the segment, which has the int64 pk field 100
+// and the 2-dim float vector field 101 of the same values as the pks.
+func (df *DataFactory) GenMsgStreamInsertMsgWithPks(segmentID UniqueID, ts Timestamp, pks ...int64) *msgstream.InsertMsg {
+	rows := len(pks)
+	msg := &msgstream.InsertMsg{
+		BaseMsg: msgstream.BaseMsg{
+			BeginTimestamp: ts,
+			EndTimestamp:   ts,
+			HashValues:     make([]uint32, rows),
+		},
+		InsertRequest: msgpb.InsertRequest{
+			CollectionID: 1,
+			PartitionID:  10,
+			SegmentID:    segmentID,
+			Version:      msgpb.InsertDataVersion_ColumnBased,
+			NumRows:      uint64(rows),
+		},
+	}
+	vectors := make([]float32, 0, rows*2)
+	for _, pk := range pks {
+		msg.RowIDs = append(msg.RowIDs, pk)
+		msg.Timestamps = append(msg.Timestamps, ts)
+		vectors = append(vectors, float32(pk), float32(pk))
+	}
+	msg.FieldsData = []*schemapb.FieldData{
+		{
+			Type:    schemapb.DataType_Int64,
+			FieldId: 100,
+			Field: &schemapb.FieldData_Scalars{Scalars: &schemapb.ScalarField{
+				Data: &schemapb.ScalarField_LongData{LongData: &schemapb.LongArray{Data: pks}},
+			}},
+		},
+		{
+			Type:    schemapb.DataType_FloatVector,
+			FieldId: 101,
+			Field: &schemapb.FieldData_Vectors{Vectors: &schemapb.VectorField{
+				Dim:  2,
+				Data: &schemapb.VectorField_FloatVector{FloatVector: &schemapb.FloatArray{Data: vectors}},
+			}},
+		},
+	}
+	return msg
+}
+
 func (df *DataFactory) GetMsgStreamTsInsertMsgs(n int, chanName string, ts Timestamp) (inMsgs []msgstream.TsMsg) {
 	for i := 0; i < n; i++ {
 		msg := df.GenMsgStreamInsertMsgWithTs(i, chanName, ts)
@@ -42,12 +42,14 @@ type Segment struct {
 	partitionID  UniqueID
 	segmentID    UniqueID
 	sType        atomic.Value // datapb.SegmentType
+	level        datapb.SegmentLevel
 
 	numRows     int64
 	memorySize  int64
 	compactedTo UniqueID
 
 	curInsertBuf     *BufferData
+	curInsertBufSize int64 // the buffer size reported to metrics
 	curDeleteBuf     *DelDataBuf
 	historyInsertBuf []*BufferData
 	historyDeleteBuf []*DelDataBuf
@@ -106,6 +108,11 @@ func (s *Segment) isValid() bool {
 	return s != nil && s.getType() != datapb.SegmentType_Compacted
 }
 
+// isLevelZero returns whether the segment is a delete-only L0 segment
+func (s *Segment) isLevelZero() bool {
+	return s != nil && s.level == datapb.SegmentLevel_L0
+}
+
 func (s *Segment) notFlushed() bool {
 	return s.isValid() && s.getType() != datapb.SegmentType_Flushed
 }
@@ -184,13 +191,11 @@ func (s *Segment) isPKExist(pk primaryKey) bool {
 func (s *Segment) setInsertBuffer(buf *BufferData) {
 	s.curInsertBuf = buf
 
-	if buf != nil && buf.buffer != nil {
-		dataSize := 0
-		for _, data := range buf.buffer.Data {
-			dataSize += data.GetMemorySize()
-		}
+	if buf != nil {
+		dataSize := buf.memorySize()
 		metrics.DataNodeFlowGraphBufferDataSize.WithLabelValues(fmt.Sprint(paramtable.GetNodeID()),
-			strconv.FormatInt(s.collectionID, 10)).Add(float64(dataSize))
+			strconv.FormatInt(s.collectionID, 10)).Add(float64(dataSize - s.curInsertBufSize))
+		s.curInsertBufSize = dataSize
 	}
 }
 
@@ -200,16 +205,13 @@ func (s *Segment) rollInsertBuffer() {
 		return
 	}
 
-	if s.curInsertBuf.buffer != nil {
-		dataSize := 0
-		for _, data := range s.curInsertBuf.buffer.Data {
-			dataSize += data.GetMemorySize()
-		}
-		metrics.DataNodeFlowGraphBufferDataSize.WithLabelValues(fmt.Sprint(paramtable.GetNodeID()),
-			strconv.FormatInt(s.collectionID, 10)).Sub(float64(dataSize))
-	}
+	metrics.DataNodeFlowGraphBufferDataSize.WithLabelValues(fmt.Sprint(paramtable.GetNodeID()),
+		strconv.FormatInt(s.collectionID, 10)).Sub(float64(s.curInsertBufSize))
+	s.curInsertBufSize = 0
 
 	s.curInsertBuf.buffer = nil // free buffer memory, only keep meta infos in historyInsertBuf
+	s.curInsertBuf.chunks = nil
+	s.curInsertBuf.chunksSize = 0
 	s.historyInsertBuf = append(s.historyInsertBuf, s.curInsertBuf)
 	s.curInsertBuf = nil
 }
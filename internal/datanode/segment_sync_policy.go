@@ -24,6 +24,7 @@ import (
 	"github.com/samber/lo"
 	"go.uber.org/zap"
 
+	"github.com/milvus-io/milvus/internal/proto/datapb"
 	"github.com/milvus-io/milvus/pkg/log"
 	"github.com/milvus-io/milvus/pkg/util/tsoutil"
 )
@@ -52,6 +53,28 @@ func syncPeriodically() segmentSyncPolicy {
 	}
 }
 
+// syncLevelZeroPeriodically get segmentSyncPolicy with L0 segments sync in a shorter period,
+// so that the buffered deletes are persisted with low latency.
+func syncLevelZeroPeriodically() segmentSyncPolicy {
+	return func(segments []*Segment, c Channel, ts Timestamp) []UniqueID {
+		segmentsToSync := make([]UniqueID, 0)
+		for _, seg := range segments {
+			if seg.level != datapb.SegmentLevel_L0 || seg.isBufferEmpty() {
+				continue
+			}
+			endPosTime := tsoutil.PhysicalTime(ts)
+			minBufferTime := tsoutil.PhysicalTime(seg.minBufferTs())
+			if endPosTime.Sub(minBufferTime) >= Params.DataNodeCfg.LevelZeroSyncPeriod.GetAsDuration(time.Second) {
+				segmentsToSync = append(segmentsToSync, seg.segmentID)
+			}
+		}
+		if len(segmentsToSync) > 0 {
+			log.Info("sync L0 segment periodically", zap.Int64s("segmentIDs", segmentsToSync))
+		}
+		return segmentsToSync
+	}
+}
+
 // syncMemoryTooHigh force sync the largest segment.
 func syncMemoryTooHigh() segmentSyncPolicy {
 	return func(segments []*Segment, c Channel, _ Timestamp) []UniqueID {
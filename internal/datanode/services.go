@@ -24,6 +24,7 @@ import (
 	"fmt"
 	"path"
 	"strconv"
+	"strings"
 	"time"
 
 	"github.com/cockroachdb/errors"
@@ -34,6 +35,7 @@ import (
 	"github.com/milvus-io/milvus-proto/go-api/v2/milvuspb"
 	"github.com/milvus-io/milvus-proto/go-api/v2/msgpb"
 	"github.com/milvus-io/milvus-proto/go-api/v2/schemapb"
+	"github.com/milvus-io/milvus/internal/datanode/allocator"
 	"github.com/milvus-io/milvus/internal/proto/datapb"
 	"github.com/milvus-io/milvus/internal/proto/etcdpb"
 	"github.com/milvus-io/milvus/internal/proto/internalpb"
@@ -81,6 +83,15 @@ func (node *DataNode) GetComponentStates(ctx context.Context, req *milvuspb.GetC
 		SubcomponentStates: make([]*milvuspb.ComponentInfo, 0),
 		Status:             merr.Success(),
 	}
+	// the DataNode is degraded if the timetick lags behind, while it is still able to serve
+	if reasons := getTimeTickLagMonitor().check(); len(reasons) > 0 {
+		states.SubcomponentStates = append(states.SubcomponentStates, &milvuspb.ComponentInfo{
+			NodeID:    nodeID,
+			Role:      timeTickSubcomponent,
+			StateCode: commonpb.StateCode_Abnormal,
+			ExtraInfo: []*commonpb.KeyValuePair{{Key: "reason", Value: strings.Join(reasons, "; ")}},
+		})
+	}
 	return states, nil
 }
 
@@ -264,8 +275,25 @@ func (node *DataNode) Compaction(ctx context.Context, req *datapb.CompactionPlan
 		return merr.Status(err), nil
 	}
 
+	var (
+		channel Channel
+		fm      flushManager
+		alloc   allocator.Allocator
+	)
 	ds, ok := node.flowgraphManager.getFlowgraphService(req.GetChannel())
-	if !ok {
+	switch {
+	case ok:
+		channel, fm, alloc = ds.channel, ds.flushManager, ds.idAllocator
+	case Params.DataNodeCfg.CompactionDedicated.GetAsBool() && req.GetType() != datapb.CompactionType_Level0DeleteCompaction:
+		// the dedicated DataNode watches no channel, the mix compactions are executed detached from the channel
+		var err error
+		channel, err = node.newDetachedChannel(ctx, req)
+		if err != nil {
+			log.Warn("fail to build the channel of compaction plan", zap.String("channelName", req.GetChannel()), zap.Error(err))
+			return merr.Status(err), nil
+		}
+		fm, alloc = detachedFlushManager{}, node.allocator
+	default:
 		log.Warn("illegel compaction plan, channel not in this DataNode", zap.String("channelName", req.GetChannel()))
 		return merr.Status(merr.WrapErrChannelNotFound(req.GetChannel(), "illegel compaction plan")), nil
 	}
@@ -275,16 +303,27 @@ func (node *DataNode) Compaction(ctx context.Context, req *datapb.CompactionPlan
 		return merr.Status(merr.WrapErrChannelNotFound(req.GetChannel(), "channel is dropping")), nil
 	}
 
-	binlogIO := &binlogIO{node.chunkManager, ds.idAllocator}
-	task := newCompactionTask(
-		node.ctx,
-		binlogIO, binlogIO,
-		ds.channel,
-		ds.flushManager,
-		ds.idAllocator,
-		req,
-		node.chunkManager,
-	)
+	binlogIO := &binlogIO{node.chunkManager, alloc}
+	var task compactor
+	switch req.GetType() {
+	case datapb.CompactionType_Level0DeleteCompaction:
+		task = newLevelZeroCompactionTask(
+			node.ctx,
+			binlogIO, binlogIO,
+			channel,
+			req,
+		)
+	default:
+		task = newCompactionTask(
+			node.ctx,
+			binlogIO, binlogIO,
+			channel,
+			fm,
+			alloc,
+			req,
+			node.chunkManager,
+		)
+	}
 
 	node.compactionExecutor.execute(task)
 
@@ -317,6 +356,12 @@ func (node *DataNode) GetCompactionState(ctx context.Context, req *datapb.Compac
 		return true
 	})
 
+	for _, result := range results {
+		if result.GetState() == commonpb.CompactionState_Completed {
+			node.compactionExecutor.discardLevelZeroResult(result.GetPlanID())
+		}
+	}
+
 	if len(results) > 0 {
 		planIDs := lo.Map(results, func(result *datapb.CompactionStateResult, i int) UniqueID {
 			return result.GetPlanID()
@@ -343,6 +388,14 @@ func (node *DataNode) SyncSegments(ctx context.Context, req *datapb.SyncSegments
 		return merr.Status(err), nil
 	}
 
+	if req.GetAbandoned() {
+		// the compacted segment is discarded or the plan is cancelled by DataCoord, release the compacting segments only
+		log.Ctx(ctx).Info("compaction result abandoned", zap.Int64("planID", req.GetPlanID()))
+		node.compactionExecutor.stopTask(req.GetPlanID())
+		node.compactionExecutor.injectDone(req.GetPlanID(), false)
+		return merr.Success(), nil
+	}
+
 	if len(req.GetCompactedFrom()) <= 0 {
 		return merr.Status(merr.WrapErrParameterInvalid(">0", "0", "compacted from segments shouldn't be empty")), nil
 	}
@@ -371,6 +424,8 @@ func (node *DataNode) SyncSegments(ctx context.Context, req *datapb.SyncSegments
 	}
 	if oneSegment == 0 {
 		log.Ctx(ctx).Warn("no valid segment, maybe the request is a retry")
+		// the result of a compaction executed detached from the channel is released once synced
+		node.compactionExecutor.injectDone(req.GetPlanID(), true)
 		return merr.Success(), nil
 	}
 
@@ -919,6 +974,7 @@ func createBinLogs(rowNum int, schema *schemapb.CollectionSchema, ts Timestamp,
 			TimestampTo:   ts,
 			LogPath:       key,
 			LogSize:       int64(len(blob.Value)),
+			Checksum:      storage.BinlogChecksum(blob.Value),
 		}
 		field2Logidx[fieldID] = logidx
 	}
@@ -949,6 +1005,7 @@ func createBinLogs(rowNum int, schema *schemapb.CollectionSchema, ts Timestamp,
 		TimestampTo:   ts,
 		LogPath:       key,
 		LogSize:       int64(len(statsBinLog.Value)),
+		Checksum:      storage.BinlogChecksum(statsBinLog.Value),
 	}
 
 	err = node.chunkManager.MultiWrite(ctx, kvs)
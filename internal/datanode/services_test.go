@@ -700,6 +700,22 @@ func (s *DataNodeServicesSuite) TestSyncSegments() {
 		s.Assert().True(merr.Ok(status))
 	})
 
+	s.Run("abandoned result", func() {
+		req := &datapb.SyncSegmentsRequest{
+			CompactedFrom: []UniqueID{100, 200},
+			CompactedTo:   400,
+			NumOfRows:     100,
+			Abandoned:     true,
+		}
+		status, err := s.node.SyncSegments(s.ctx, req)
+		s.Assert().NoError(err)
+		s.Assert().True(merr.Ok(status))
+
+		s.Assert().False(fg.channel.hasSegment(req.CompactedTo, true))
+		s.Assert().True(fg.channel.hasSegment(req.CompactedFrom[0], true))
+		s.Assert().True(fg.channel.hasSegment(req.CompactedFrom[1], true))
+	})
+
 	s.Run("valid request numRows>0", func() {
 		req := &datapb.SyncSegmentsRequest{
 			CompactedFrom: []UniqueID{100, 200, 101, 201},
@@ -0,0 +1,91 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package datanode
+
+import (
+	"fmt"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/milvus-io/milvus/pkg/util/tsoutil"
+)
+
+// timeTickSubcomponent is the role of subcomponent state reported if the timetick lags behind.
+const timeTickSubcomponent = "TimeTick"
+
+// ttLagMonitor is global timeTickLagMonitor in DataNode.
+var (
+	ttLagMonitor         *timeTickLagMonitor
+	ttLagMonitorInitOnce sync.Once
+)
+
+func getTimeTickLagMonitor() *timeTickLagMonitor {
+	ttLagMonitorInitOnce.Do(func() {
+		ttLagMonitor = newTimeTickLagMonitor()
+	})
+	return ttLagMonitor
+}
+
+// timeTickLagMonitor records the latest timetick sent of each channel,
+// the channels whose timetick lag exceeds the threshold violate the SLO.
+type timeTickLagMonitor struct {
+	mu     sync.RWMutex
+	sentTt map[string]Timestamp
+}
+
+func newTimeTickLagMonitor() *timeTickLagMonitor {
+	return &timeTickLagMonitor{
+		sentTt: make(map[string]Timestamp),
+	}
+}
+
+// onSent records the timetick of channel sent successfully.
+func (m *timeTickLagMonitor) onSent(channel string, ts Timestamp) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if ts > m.sentTt[channel] {
+		m.sentTt[channel] = ts
+	}
+}
+
+// remove stops monitoring the channel.
+func (m *timeTickLagMonitor) remove(channel string) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	delete(m.sentTt, channel)
+}
+
+// check returns the reasons of the channels whose timetick lag exceeds the threshold.
+func (m *timeTickLagMonitor) check() []string {
+	threshold := Params.DataNodeCfg.DataNodeTimeTickLagThreshold.GetAsDuration(time.Second)
+	if threshold <= 0 {
+		return nil
+	}
+
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+	var reasons []string
+	for channel, ts := range m.sentTt {
+		lag := time.Since(tsoutil.PhysicalTime(ts))
+		if lag > threshold {
+			reasons = append(reasons, fmt.Sprintf("timetick lag of channel %s is %s, exceeds %s", channel, lag.Truncate(time.Millisecond), threshold))
+		}
+	}
+	sort.Strings(reasons)
+	return reasons
+}
@@ -0,0 +1,54 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package datanode
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/milvus-io/milvus/pkg/util/paramtable"
+	"github.com/milvus-io/milvus/pkg/util/tsoutil"
+)
+
+func TestTimeTickLagMonitor(t *testing.T) {
+	m := newTimeTickLagMonitor()
+	now := tsoutil.ComposeTSByTime(time.Now(), 0)
+	lagged := tsoutil.ComposeTSByTime(time.Now().Add(-2*time.Minute), 0)
+
+	m.onSent("ch1", now)
+	m.onSent("ch2", lagged)
+	assert.Len(t, m.check(), 1)
+	assert.Contains(t, m.check()[0], "ch2")
+
+	// the timetick never goes back
+	m.onSent("ch1", lagged)
+	assert.Equal(t, now, m.sentTt["ch1"])
+
+	m.onSent("ch2", now)
+	assert.Empty(t, m.check())
+
+	m.onSent("ch3", lagged)
+	paramtable.Get().Save(Params.DataNodeCfg.DataNodeTimeTickLagThreshold.Key, "0")
+	assert.Empty(t, m.check())
+	paramtable.Get().Reset(Params.DataNodeCfg.DataNodeTimeTickLagThreshold.Key)
+	assert.Len(t, m.check(), 1)
+
+	m.remove("ch3")
+	assert.Empty(t, m.check())
+}
@@ -64,7 +64,10 @@ func (m *timeTickSender) start(ctx context.Context) {
 			log.Info("timeTickSender context done")
 			return
 		case <-ticker.C:
-			m.sendReport(ctx)
+			// send in the dedicated pool, which is isolated from data syncing
+			getOrCreateTimeTickPool().Submit(func() (any, error) {
+				return nil, m.sendReport(ctx)
+			}).Await()
 		}
 	}
 }
@@ -163,5 +166,8 @@ func (m *timeTickSender) sendReport(ctx context.Context) error {
 		return err
 	}
 	m.cleanStatesCache(sendLastTss)
+	for channelName, ts := range sendLastTss {
+		getTimeTickLagMonitor().onSent(channelName, ts)
+	}
 	return nil
 }
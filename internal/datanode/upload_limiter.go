@@ -0,0 +1,149 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package datanode
+
+import (
+	"context"
+	"encoding/json"
+	"sync"
+	"time"
+
+	"github.com/cockroachdb/errors"
+	"go.uber.org/zap"
+	"golang.org/x/time/rate"
+
+	"github.com/milvus-io/milvus/internal/kv"
+	"github.com/milvus-io/milvus/internal/storage"
+	"github.com/milvus-io/milvus/internal/util/uploadquota"
+	"github.com/milvus-io/milvus/pkg/log"
+	"github.com/milvus-io/milvus/pkg/util/merr"
+)
+
+// uploadLimiter limits the PUT requests issued to the object storage endpoint,
+// by the rate granted to this datanode by datacoord, it's unlimited if nothing is granted.
+type uploadLimiter struct {
+	kv       kv.BaseKV
+	nodeID   int64
+	endpoint string
+	limiter  *rate.Limiter
+}
+
+func newUploadLimiter(kv kv.BaseKV, nodeID int64, endpoint string) *uploadLimiter {
+	return &uploadLimiter{
+		kv:       kv,
+		nodeID:   nodeID,
+		endpoint: endpoint,
+		limiter:  rate.NewLimiter(rate.Inf, 1),
+	}
+}
+
+// start refreshes the granted rate every interval until the context is done.
+func (l *uploadLimiter) start(ctx context.Context, wg *sync.WaitGroup) {
+	defer wg.Done()
+	l.refresh()
+	ticker := time.NewTicker(Params.DataCoordCfg.UploadRateLimitGrantInterval.GetAsDuration(time.Second))
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ticker.C:
+			l.refresh()
+		case <-ctx.Done():
+			log.Info("upload limiter quit")
+			return
+		}
+	}
+}
+
+// refresh loads the grant of this datanode, the last rate is kept if failed to load it.
+func (l *uploadLimiter) refresh() {
+	value, err := l.kv.Load(uploadquota.GrantKey(l.nodeID))
+	if errors.Is(err, merr.ErrIoKeyNotFound) {
+		l.setRate(0)
+		return
+	}
+	if err != nil {
+		log.Warn("failed to load upload quota grant", zap.Int64("nodeID", l.nodeID), zap.Error(err))
+		return
+	}
+	grant := &uploadquota.Grant{}
+	if err := json.Unmarshal([]byte(value), grant); err != nil {
+		log.Warn("failed to unmarshal upload quota grant", zap.Int64("nodeID", l.nodeID), zap.Error(err))
+		return
+	}
+	l.setRate(grant.PutRateOf(l.endpoint))
+}
+
+// setRate sets the PUT requests per second allowed, non-positive means unlimited.
+// The burst is one second of requests, so the bursts of all datanodes stay under the limit of the endpoint.
+func (l *uploadLimiter) setRate(putRate float64) {
+	limit := rate.Inf
+	burst := 1
+	if putRate > 0 {
+		limit = rate.Limit(putRate)
+		if int(putRate) > burst {
+			burst = int(putRate)
+		}
+	}
+	if l.limiter.Limit() == limit && l.limiter.Burst() == burst {
+		return
+	}
+	l.limiter.SetLimit(limit)
+	l.limiter.SetBurst(burst)
+	log.Info("upload rate limit updated", zap.String("endpoint", l.endpoint), zap.Float64("putRate", putRate))
+}
+
+// wait blocks until n PUT requests are allowed.
+func (l *uploadLimiter) wait(ctx context.Context, n int) error {
+	for n > 0 {
+		batch := n
+		if burst := l.limiter.Burst(); l.limiter.Limit() != rate.Inf && batch > burst {
+			batch = burst
+		}
+		if err := l.limiter.WaitN(ctx, batch); err != nil {
+			return err
+		}
+		n -= batch
+	}
+	return nil
+}
+
+// uploadLimitedChunkManager limits the writes of the flush and compaction by the upload limiter.
+type uploadLimitedChunkManager struct {
+	storage.ChunkManager
+	limiter *uploadLimiter
+}
+
+func newUploadLimitedChunkManager(cm storage.ChunkManager, limiter *uploadLimiter) *uploadLimitedChunkManager {
+	return &uploadLimitedChunkManager{
+		ChunkManager: cm,
+		limiter:      limiter,
+	}
+}
+
+func (cm *uploadLimitedChunkManager) Write(ctx context.Context, filePath string, content []byte) error {
+	if err := cm.limiter.wait(ctx, 1); err != nil {
+		return err
+	}
+	return cm.ChunkManager.Write(ctx, filePath, content)
+}
+
+func (cm *uploadLimitedChunkManager) MultiWrite(ctx context.Context, contents map[string][]byte) error {
+	if err := cm.limiter.wait(ctx, len(contents)); err != nil {
+		return err
+	}
+	return cm.ChunkManager.MultiWrite(ctx, contents)
+}
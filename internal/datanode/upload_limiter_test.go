@@ -0,0 +1,67 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package datanode
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"golang.org/x/time/rate"
+
+	memkv "github.com/milvus-io/milvus/internal/kv/mem"
+	"github.com/milvus-io/milvus/internal/storage"
+	"github.com/milvus-io/milvus/internal/util/uploadquota"
+)
+
+func TestUploadLimiter(t *testing.T) {
+	kv := memkv.NewMemoryKV()
+	limiter := newUploadLimiter(kv, 1, "minio:9000")
+
+	// unlimited if nothing is granted
+	limiter.refresh()
+	assert.Equal(t, rate.Inf, limiter.limiter.Limit())
+	assert.NoError(t, limiter.wait(context.Background(), 1000))
+
+	value, err := json.Marshal(&uploadquota.Grant{
+		PutRates:       map[string]float64{"minio:9000": 10},
+		DefaultPutRate: 100,
+	})
+	require.NoError(t, err)
+	require.NoError(t, kv.Save(uploadquota.GrantKey(1), string(value)))
+	limiter.refresh()
+	assert.Equal(t, rate.Limit(10), limiter.limiter.Limit())
+	assert.Equal(t, 10, limiter.limiter.Burst())
+
+	// the requests more than the burst are waited in batches
+	ctx, cancel := context.WithTimeout(context.Background(), 100*time.Millisecond)
+	defer cancel()
+	assert.NoError(t, limiter.wait(ctx, 10))
+	assert.Error(t, limiter.wait(ctx, 10))
+
+	cm := newUploadLimitedChunkManager(storage.NewLocalChunkManager(storage.RootPath(t.TempDir())), limiter)
+	assert.Error(t, cm.Write(ctx, "a", []byte("a")))
+
+	// the grant is revoked
+	require.NoError(t, kv.Remove(uploadquota.GrantKey(1)))
+	limiter.refresh()
+	assert.Equal(t, rate.Inf, limiter.limiter.Limit())
+	assert.NoError(t, cm.MultiWrite(context.Background(), map[string][]byte{"a": []byte("a"), "b": []byte("b")}))
+}
@@ -51,6 +51,18 @@ type Client struct {
 
 // NewClient creates a new client instance
 func NewClient(ctx context.Context) (*Client, error) {
+	config := &Params.DataCoordGrpcClientCfg
+	return newClient(ctx, grpcclient.NewClientBase[datapb.DataCoordClient](config, "milvus.proto.data.DataCoord"))
+}
+
+// NewPooledClient creates a data coordinator client whose calls are spread over poolSize connections
+// by the pool key of the context, and the concurrent calls of each key are limited by maxConcurrencyPerKey.
+func NewPooledClient(ctx context.Context, poolSize int, maxConcurrencyPerKey int) (*Client, error) {
+	config := &Params.DataCoordGrpcClientCfg
+	return newClient(ctx, grpcclient.NewClientPool[datapb.DataCoordClient](config, "milvus.proto.data.DataCoord", poolSize, maxConcurrencyPerKey))
+}
+
+func newClient(ctx context.Context, grpcClient grpcclient.GrpcClient[datapb.DataCoordClient]) (*Client, error) {
 	sess := sessionutil.NewSession(ctx)
 	if sess == nil {
 		err := fmt.Errorf("new session error, maybe can not connect to etcd")
@@ -58,9 +70,8 @@ func NewClient(ctx context.Context) (*Client, error) {
 		return nil, err
 	}
 
-	config := &Params.DataCoordGrpcClientCfg
 	client := &Client{
-		grpcClient: grpcclient.NewClientBase[datapb.DataCoordClient](config, "milvus.proto.data.DataCoord"),
+		grpcClient: grpcClient,
 		sess:       sess,
 	}
 	client.grpcClient.SetRole(typeutil.DataCoordRole)
@@ -615,3 +626,25 @@ func (c *Client) ReportDataNodeTtMsgs(ctx context.Context, req *datapb.ReportDat
 		return client.ReportDataNodeTtMsgs(ctx, req)
 	})
 }
+
+func (c *Client) CancelCompactionPlan(ctx context.Context, req *datapb.CancelCompactionPlanRequest, opts ...grpc.CallOption) (*commonpb.Status, error) {
+	req = typeutil.Clone(req)
+	commonpbutil.UpdateMsgBase(
+		req.GetBase(),
+		commonpbutil.FillMsgBaseFromClient(paramtable.GetNodeID(), commonpbutil.WithTargetID(c.grpcClient.GetNodeID())),
+	)
+	return wrapGrpcCall(ctx, c, func(client datapb.DataCoordClient) (*commonpb.Status, error) {
+		return client.CancelCompactionPlan(ctx, req)
+	})
+}
+
+func (c *Client) OperateStorageMigration(ctx context.Context, req *datapb.OperateStorageMigrationRequest, opts ...grpc.CallOption) (*commonpb.Status, error) {
+	req = typeutil.Clone(req)
+	commonpbutil.UpdateMsgBase(
+		req.GetBase(),
+		commonpbutil.FillMsgBaseFromClient(paramtable.GetNodeID(), commonpbutil.WithTargetID(c.grpcClient.GetNodeID())),
+	)
+	return wrapGrpcCall(ctx, c, func(client datapb.DataCoordClient) (*commonpb.Status, error) {
+		return client.OperateStorageMigration(ctx, req)
+	})
+}
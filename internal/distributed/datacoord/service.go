@@ -474,3 +474,11 @@ func (s *Server) GetIndexBuildProgress(ctx context.Context, req *indexpb.GetInde
 func (s *Server) ReportDataNodeTtMsgs(ctx context.Context, req *datapb.ReportDataNodeTtMsgsRequest) (*commonpb.Status, error) {
 	return s.dataCoord.ReportDataNodeTtMsgs(ctx, req)
 }
+
+func (s *Server) CancelCompactionPlan(ctx context.Context, req *datapb.CancelCompactionPlanRequest) (*commonpb.Status, error) {
+	return s.dataCoord.CancelCompactionPlan(ctx, req)
+}
+
+func (s *Server) OperateStorageMigration(ctx context.Context, req *datapb.OperateStorageMigrationRequest) (*commonpb.Status, error) {
+	return s.dataCoord.OperateStorageMigration(ctx, req)
+}
@@ -0,0 +1,145 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package grpcproxy
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"encoding/pem"
+	"fmt"
+	"os"
+	"sync"
+	"time"
+
+	"go.uber.org/zap"
+
+	"github.com/milvus-io/milvus/pkg/log"
+	"github.com/milvus-io/milvus/pkg/util/paramtable"
+	"github.com/milvus-io/milvus/pkg/util/typeutil"
+)
+
+// crlVerifier rejects the client certificates revoked by the certificate revocation list,
+// the list is reloaded if the file updated.
+type crlVerifier struct {
+	path string
+
+	mu        sync.RWMutex
+	revoked   typeutil.Set[string] // serial numbers of the revoked certificates
+	modTime   time.Time
+	lastCheck time.Time
+}
+
+func newCRLVerifier(path string) (*crlVerifier, error) {
+	v := &crlVerifier{path: path}
+	if err := v.reload(); err != nil {
+		return nil, err
+	}
+	return v, nil
+}
+
+func parseCRL(data []byte) (typeutil.Set[string], error) {
+	revoked := typeutil.NewSet[string]()
+	for {
+		var block *pem.Block
+		block, data = pem.Decode(data)
+		if block == nil {
+			break
+		}
+		if block.Type != "X509 CRL" {
+			continue
+		}
+		crl, err := x509.ParseCRL(block.Bytes)
+		if err != nil {
+			return nil, err
+		}
+		for _, cert := range crl.TBSCertList.RevokedCertificates {
+			revoked.Insert(cert.SerialNumber.String())
+		}
+	}
+	return revoked, nil
+}
+
+func (v *crlVerifier) reload() error {
+	v.mu.Lock()
+	defer v.mu.Unlock()
+	v.lastCheck = time.Now()
+
+	info, err := os.Stat(v.path)
+	if err != nil {
+		return err
+	}
+	if v.revoked != nil && info.ModTime().Equal(v.modTime) {
+		return nil
+	}
+
+	data, err := os.ReadFile(v.path)
+	if err != nil {
+		return err
+	}
+	revoked, err := parseCRL(data)
+	if err != nil {
+		return err
+	}
+	v.revoked = revoked
+	v.modTime = info.ModTime()
+	log.Info("certificate revocation list loaded", zap.String("path", v.path), zap.Int("revoked", revoked.Len()))
+	return nil
+}
+
+func (v *crlVerifier) reloadIfExpired() {
+	interval := paramtable.Get().ProxyGrpcServerCfg.CrlReloadInterval.GetAsDuration(time.Second)
+	v.mu.RLock()
+	expired := time.Since(v.lastCheck) >= interval
+	v.mu.RUnlock()
+	if !expired {
+		return
+	}
+	// keep the previous list if failed to reload
+	if err := v.reload(); err != nil {
+		log.Warn("failed to reload certificate revocation list", zap.String("path", v.path), zap.Error(err))
+	}
+}
+
+// VerifyPeerCertificate is used as the tls.Config.VerifyPeerCertificate callback.
+func (v *crlVerifier) VerifyPeerCertificate(rawCerts [][]byte, verifiedChains [][]*x509.Certificate) error {
+	v.reloadIfExpired()
+
+	v.mu.RLock()
+	defer v.mu.RUnlock()
+	for _, chain := range verifiedChains {
+		for _, cert := range chain {
+			if v.revoked.Contain(cert.SerialNumber.String()) {
+				return fmt.Errorf("certificate %s (serial %s) is revoked", cert.Subject.CommonName, cert.SerialNumber)
+			}
+		}
+	}
+	return nil
+}
+
+// setupCRLVerifier rejects the revoked client certificates if the certificate revocation list configured.
+func setupCRLVerifier(tlsConf *tls.Config) error {
+	path := paramtable.Get().ProxyGrpcServerCfg.CrlPath.GetValue()
+	if path == "" {
+		return nil
+	}
+	v, err := newCRLVerifier(path)
+	if err != nil {
+		return err
+	}
+	tlsConf.VerifyPeerCertificate = v.VerifyPeerCertificate
+	return nil
+}
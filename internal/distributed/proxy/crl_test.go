@@ -0,0 +1,110 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package grpcproxy
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"math/big"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/milvus-io/milvus/pkg/util/paramtable"
+)
+
+func TestCRLVerifier(t *testing.T) {
+	paramtable.Init()
+
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+	caTemplate := &x509.Certificate{
+		SerialNumber:          big.NewInt(1),
+		Subject:               pkix.Name{CommonName: "ca"},
+		NotBefore:             time.Now().Add(-time.Hour),
+		NotAfter:              time.Now().Add(time.Hour),
+		KeyUsage:              x509.KeyUsageCertSign | x509.KeyUsageCRLSign,
+		BasicConstraintsValid: true,
+		IsCA:                  true,
+	}
+	caDer, err := x509.CreateCertificate(rand.Reader, caTemplate, caTemplate, &key.PublicKey, key)
+	require.NoError(t, err)
+	ca, err := x509.ParseCertificate(caDer)
+	require.NoError(t, err)
+
+	writeCRL := func(path string, serials ...int64) {
+		revoked := make([]pkix.RevokedCertificate, 0, len(serials))
+		for _, serial := range serials {
+			revoked = append(revoked, pkix.RevokedCertificate{SerialNumber: big.NewInt(serial), RevocationTime: time.Now()})
+		}
+		der, err := x509.CreateRevocationList(rand.Reader, &x509.RevocationList{
+			RevokedCertificates: revoked,
+			Number:              big.NewInt(time.Now().UnixNano()),
+			ThisUpdate:          time.Now(),
+			NextUpdate:          time.Now().Add(time.Hour),
+		}, ca, key)
+		require.NoError(t, err)
+		require.NoError(t, os.WriteFile(path, pem.EncodeToMemory(&pem.Block{Type: "X509 CRL", Bytes: der}), 0o600))
+	}
+
+	path := filepath.Join(t.TempDir(), "ca.crl")
+	_, err = newCRLVerifier(path)
+	assert.Error(t, err)
+
+	writeCRL(path, 2)
+	verifier, err := newCRLVerifier(path)
+	require.NoError(t, err)
+
+	chain := func(serial int64) [][]*x509.Certificate {
+		return [][]*x509.Certificate{{{SerialNumber: big.NewInt(serial)}, ca}}
+	}
+	assert.Error(t, verifier.VerifyPeerCertificate(nil, chain(2)))
+	assert.NoError(t, verifier.VerifyPeerCertificate(nil, chain(3)))
+
+	// reloaded after the list updated
+	paramtable.Get().Save(paramtable.Get().ProxyGrpcServerCfg.CrlReloadInterval.Key, "0")
+	defer paramtable.Get().Reset(paramtable.Get().ProxyGrpcServerCfg.CrlReloadInterval.Key)
+	writeCRL(path, 3)
+	modTime := time.Now().Add(time.Minute)
+	require.NoError(t, os.Chtimes(path, modTime, modTime))
+	assert.NoError(t, verifier.VerifyPeerCertificate(nil, chain(2)))
+	assert.Error(t, verifier.VerifyPeerCertificate(nil, chain(3)))
+
+	// keep the previous list if failed to reload
+	require.NoError(t, os.Remove(path))
+	assert.Error(t, verifier.VerifyPeerCertificate(nil, chain(3)))
+
+	// setup
+	tlsConf := &tls.Config{}
+	assert.NoError(t, setupCRLVerifier(tlsConf))
+	assert.Nil(t, tlsConf.VerifyPeerCertificate)
+	paramtable.Get().Save(paramtable.Get().ProxyGrpcServerCfg.CrlPath.Key, path)
+	defer paramtable.Get().Reset(paramtable.Get().ProxyGrpcServerCfg.CrlPath.Key)
+	assert.Error(t, setupCRLVerifier(tlsConf))
+	writeCRL(path)
+	assert.NoError(t, setupCRLVerifier(tlsConf))
+	assert.NotNil(t, tlsConf.VerifyPeerCertificate)
+}
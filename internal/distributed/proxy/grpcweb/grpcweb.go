@@ -0,0 +1,291 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package grpcweb translates gRPC-Web requests sent over HTTP/1.1 by browser SDKs
+// into native gRPC calls served by grpc.Server.
+package grpcweb
+
+import (
+	"bytes"
+	"encoding/base64"
+	"encoding/binary"
+	"fmt"
+	"io"
+	"net/http"
+	"sort"
+	"strings"
+
+	"google.golang.org/grpc"
+
+	"github.com/milvus-io/milvus/pkg/util/paramtable"
+)
+
+const (
+	contentTypeGrpc     = "application/grpc"
+	contentTypeGrpcWeb  = "application/grpc-web"
+	contentTypeGrpcText = "application/grpc-web-text"
+
+	// trailerFrameFlag is the flag of the frame carrying trailers in gRPC-Web response body.
+	trailerFrameFlag byte = 0x80
+)
+
+var defaultAllowedHeaders = []string{
+	"content-type", "x-grpc-web", "x-user-agent", "grpc-timeout", "authorization", "dbname",
+}
+
+var exposedHeaders = []string{"grpc-status", "grpc-message", "grpc-status-details-bin"}
+
+// Handler serves gRPC-Web requests with the wrapped grpc server.
+type Handler struct {
+	server *grpc.Server
+}
+
+// NewHandler returns a Handler serving gRPC-Web requests by server.
+func NewHandler(server *grpc.Server) *Handler {
+	return &Handler{server: server}
+}
+
+// IsGrpcWebRequest returns whether the request is a gRPC-Web request.
+func IsGrpcWebRequest(r *http.Request) bool {
+	return r.Method == http.MethodPost && strings.HasPrefix(r.Header.Get("Content-Type"), contentTypeGrpcWeb)
+}
+
+// IsGrpcWebPreflight returns whether the request is a CORS preflight of gRPC-Web request.
+func IsGrpcWebPreflight(r *http.Request) bool {
+	if r.Method != http.MethodOptions || r.Header.Get("Access-Control-Request-Method") == "" {
+		return false
+	}
+	for _, header := range strings.Split(r.Header.Get("Access-Control-Request-Headers"), ",") {
+		if strings.EqualFold(strings.TrimSpace(header), "x-grpc-web") {
+			return true
+		}
+	}
+	return false
+}
+
+// ServeHTTP implements http.Handler.
+func (h *Handler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	origin := r.Header.Get("Origin")
+	allowOrigin := allowedOrigin(origin)
+	if origin != "" && allowOrigin == "" {
+		http.Error(w, fmt.Sprintf("origin %s is not allowed", origin), http.StatusForbidden)
+		return
+	}
+	setCorsHeaders(w.Header(), allowOrigin)
+
+	if IsGrpcWebPreflight(r) {
+		w.WriteHeader(http.StatusNoContent)
+		return
+	}
+	if !IsGrpcWebRequest(r) {
+		http.Error(w, "not a gRPC-Web request", http.StatusBadRequest)
+		return
+	}
+
+	textMode := strings.HasPrefix(r.Header.Get("Content-Type"), contentTypeGrpcText)
+	writer := newResponseWriter(w, textMode)
+	h.server.ServeHTTP(writer, toGrpcRequest(r, textMode))
+	writer.finish()
+}
+
+// toGrpcRequest converts gRPC-Web request into the native gRPC request accepted by grpc.Server.
+func toGrpcRequest(r *http.Request, textMode bool) *http.Request {
+	req := r.Clone(r.Context())
+	req.ProtoMajor = 2
+	req.ProtoMinor = 0
+
+	contentType := req.Header.Get("Content-Type")
+	if textMode {
+		contentType = strings.Replace(contentType, contentTypeGrpcText, contentTypeGrpc, 1)
+		req.Body = io.NopCloser(base64.NewDecoder(base64.StdEncoding, r.Body))
+	} else {
+		contentType = strings.Replace(contentType, contentTypeGrpcWeb, contentTypeGrpc, 1)
+	}
+	req.Header.Set("Content-Type", contentType)
+	req.Header.Del("Content-Length")
+	req.ContentLength = -1
+	return req
+}
+
+// allowedOrigin returns the Access-Control-Allow-Origin for the origin,
+// the origin itself if listed explicitly, * if any origin allowed, empty if not allowed.
+func allowedOrigin(origin string) string {
+	if origin == "" {
+		return ""
+	}
+	wildcard := false
+	for _, allowed := range paramtable.Get().HTTPCfg.GrpcWebAllowedOrigins.GetAsStrings() {
+		allowed = strings.TrimSpace(allowed)
+		if allowed == "*" {
+			wildcard = true
+		} else if allowed != "" && strings.EqualFold(allowed, origin) {
+			return origin
+		}
+	}
+	if wildcard {
+		return "*"
+	}
+	return ""
+}
+
+// setCorsHeaders sets the CORS headers of the allowed origin,
+// the credentials are only allowed for the origins listed explicitly.
+func setCorsHeaders(header http.Header, allowOrigin string) {
+	if allowOrigin == "" {
+		return
+	}
+	allowedHeaders := append([]string{}, defaultAllowedHeaders...)
+	for _, h := range paramtable.Get().HTTPCfg.GrpcWebAllowedHeaders.GetAsStrings() {
+		if h = strings.TrimSpace(h); h != "" {
+			allowedHeaders = append(allowedHeaders, h)
+		}
+	}
+	header.Set("Access-Control-Allow-Origin", allowOrigin)
+	header.Set("Access-Control-Allow-Methods", "POST, OPTIONS")
+	header.Set("Access-Control-Allow-Headers", strings.Join(allowedHeaders, ", "))
+	header.Set("Access-Control-Expose-Headers", strings.Join(exposedHeaders, ", "))
+	if allowOrigin != "*" {
+		header.Set("Access-Control-Allow-Credentials", "true")
+		header.Add("Vary", "Origin")
+	}
+}
+
+// responseWriter collects the headers & trailers written by grpc.Server,
+// and encodes trailers into the response body as gRPC-Web requires.
+type responseWriter struct {
+	wrapped      http.ResponseWriter
+	headers      http.Header
+	body         io.Writer
+	encoder      io.WriteCloser
+	textMode     bool
+	wroteHeaders bool
+}
+
+var _ http.Flusher = (*responseWriter)(nil)
+
+func newResponseWriter(w http.ResponseWriter, textMode bool) *responseWriter {
+	rw := &responseWriter{
+		wrapped:  w,
+		headers:  make(http.Header),
+		body:     w,
+		textMode: textMode,
+	}
+	if textMode {
+		rw.encoder = base64.NewEncoder(base64.StdEncoding, w)
+		rw.body = rw.encoder
+	}
+	return rw
+}
+
+func (w *responseWriter) Header() http.Header {
+	return w.headers
+}
+
+func (w *responseWriter) Write(b []byte) (int, error) {
+	if !w.wroteHeaders {
+		w.WriteHeader(http.StatusOK)
+	}
+	return w.body.Write(b)
+}
+
+func (w *responseWriter) WriteHeader(code int) {
+	if w.wroteHeaders {
+		return
+	}
+	w.wroteHeaders = true
+	declaredTrailers := w.declaredTrailers()
+	for key, values := range w.headers {
+		if key == "Trailer" || declaredTrailers[key] || strings.HasPrefix(key, http.TrailerPrefix) {
+			continue
+		}
+		for _, value := range values {
+			w.wrapped.Header().Add(key, value)
+		}
+	}
+	contentType := contentTypeGrpcWeb + "+proto"
+	if w.textMode {
+		contentType = contentTypeGrpcText + "+proto"
+	}
+	w.wrapped.Header().Set("Content-Type", contentType)
+	w.wrapped.WriteHeader(code)
+}
+
+func (w *responseWriter) Flush() {
+	if !w.wroteHeaders {
+		return
+	}
+	if flusher, ok := w.wrapped.(http.Flusher); ok {
+		flusher.Flush()
+	}
+}
+
+// finish writes the trailer frame after grpc.Server finished the request.
+func (w *responseWriter) finish() {
+	if !w.wroteHeaders {
+		// no message written, send trailers only.
+		w.WriteHeader(http.StatusOK)
+	}
+	w.body.Write(encodeTrailers(w.trailers()))
+	if w.encoder != nil {
+		w.encoder.Close()
+	}
+	w.Flush()
+}
+
+func (w *responseWriter) declaredTrailers() map[string]bool {
+	declared := make(map[string]bool)
+	for _, values := range w.headers["Trailer"] {
+		for _, key := range strings.Split(values, ",") {
+			declared[http.CanonicalHeaderKey(strings.TrimSpace(key))] = true
+		}
+	}
+	return declared
+}
+
+func (w *responseWriter) trailers() http.Header {
+	trailers := make(http.Header)
+	declared := w.declaredTrailers()
+	for key, values := range w.headers {
+		if declared[key] {
+			trailers[key] = values
+		} else if strings.HasPrefix(key, http.TrailerPrefix) {
+			trailers[http.CanonicalHeaderKey(strings.TrimPrefix(key, http.TrailerPrefix))] = values
+		}
+	}
+	return trailers
+}
+
+// encodeTrailers encodes trailers as the gRPC-Web trailer frame,
+// 1 byte flag, 4 bytes length and `key: value\r\n` lines with lower case keys.
+func encodeTrailers(trailers http.Header) []byte {
+	keys := make([]string, 0, len(trailers))
+	for key := range trailers {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	payload := &bytes.Buffer{}
+	for _, key := range keys {
+		for _, value := range trailers[key] {
+			fmt.Fprintf(payload, "%s: %s\r\n", strings.ToLower(key), value)
+		}
+	}
+
+	frame := make([]byte, 5, 5+payload.Len())
+	frame[0] = trailerFrameFlag
+	binary.BigEndian.PutUint32(frame[1:], uint32(payload.Len()))
+	return append(frame, payload.Bytes()...)
+}
@@ -0,0 +1,186 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package grpcweb
+
+import (
+	"bytes"
+	"encoding/base64"
+	"encoding/binary"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/golang/protobuf/proto"
+	"github.com/stretchr/testify/suite"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/health"
+	"google.golang.org/grpc/health/grpc_health_v1"
+
+	"github.com/milvus-io/milvus/pkg/util/paramtable"
+)
+
+type GrpcWebSuite struct {
+	suite.Suite
+
+	server  *grpc.Server
+	handler *Handler
+}
+
+func (s *GrpcWebSuite) SetupSuite() {
+	paramtable.Init()
+}
+
+func (s *GrpcWebSuite) SetupTest() {
+	paramtable.Get().Save(paramtable.Get().HTTPCfg.GrpcWebAllowedOrigins.Key, "http://localhost:3000")
+	s.server = grpc.NewServer()
+	grpc_health_v1.RegisterHealthServer(s.server, health.NewServer())
+	s.handler = NewHandler(s.server)
+}
+
+func (s *GrpcWebSuite) TearDownTest() {
+	s.server.Stop()
+	paramtable.Get().Reset(paramtable.Get().HTTPCfg.GrpcWebAllowedOrigins.Key)
+}
+
+func (s *GrpcWebSuite) frame(msg proto.Message) []byte {
+	payload, err := proto.Marshal(msg)
+	s.Require().NoError(err)
+	frame := make([]byte, 5, 5+len(payload))
+	binary.BigEndian.PutUint32(frame[1:], uint32(len(payload)))
+	return append(frame, payload...)
+}
+
+func (s *GrpcWebSuite) request(body []byte, contentType string) *http.Request {
+	req := httptest.NewRequest(http.MethodPost, "/grpc.health.v1.Health/Check", bytes.NewReader(body))
+	req.Header.Set("Content-Type", contentType)
+	req.Header.Set("X-Grpc-Web", "1")
+	req.Header.Set("Origin", "http://localhost:3000")
+	return req
+}
+
+func (s *GrpcWebSuite) TestUnaryCall() {
+	req := s.request(s.frame(&grpc_health_v1.HealthCheckRequest{}), "application/grpc-web+proto")
+	s.True(IsGrpcWebRequest(req))
+
+	recorder := httptest.NewRecorder()
+	s.handler.ServeHTTP(recorder, req)
+
+	s.Equal(http.StatusOK, recorder.Code)
+	s.Equal("application/grpc-web+proto", recorder.Header().Get("Content-Type"))
+	s.Equal("http://localhost:3000", recorder.Header().Get("Access-Control-Allow-Origin"))
+	s.Equal("true", recorder.Header().Get("Access-Control-Allow-Credentials"))
+
+	body := recorder.Body.Bytes()
+	s.Require().True(len(body) > 5)
+	s.EqualValues(0, body[0])
+	length := binary.BigEndian.Uint32(body[1:5])
+	resp := &grpc_health_v1.HealthCheckResponse{}
+	s.NoError(proto.Unmarshal(body[5:5+length], resp))
+	s.Equal(grpc_health_v1.HealthCheckResponse_SERVING, resp.GetStatus())
+
+	trailer := body[5+length:]
+	s.Equal(trailerFrameFlag, trailer[0])
+	s.Contains(string(trailer[5:]), "grpc-status: 0\r\n")
+}
+
+func (s *GrpcWebSuite) TestTextMode() {
+	body := base64.StdEncoding.EncodeToString(s.frame(&grpc_health_v1.HealthCheckRequest{}))
+	req := s.request([]byte(body), "application/grpc-web-text")
+
+	recorder := httptest.NewRecorder()
+	s.handler.ServeHTTP(recorder, req)
+
+	s.Equal(http.StatusOK, recorder.Code)
+	s.Equal("application/grpc-web-text+proto", recorder.Header().Get("Content-Type"))
+	decoded, err := base64.StdEncoding.DecodeString(recorder.Body.String())
+	s.NoError(err)
+	s.Contains(string(decoded), "grpc-status: 0\r\n")
+}
+
+func (s *GrpcWebSuite) TestUnknownMethod() {
+	req := s.request(s.frame(&grpc_health_v1.HealthCheckRequest{}), "application/grpc-web+proto")
+	req.URL.Path = "/grpc.health.v1.Health/Unknown"
+
+	recorder := httptest.NewRecorder()
+	s.handler.ServeHTTP(recorder, req)
+
+	s.Contains(recorder.Body.String(), "grpc-status: 12\r\n")
+}
+
+func (s *GrpcWebSuite) TestPreflight() {
+	req := httptest.NewRequest(http.MethodOptions, "/grpc.health.v1.Health/Check", nil)
+	req.Header.Set("Origin", "http://localhost:3000")
+	req.Header.Set("Access-Control-Request-Method", "POST")
+	req.Header.Set("Access-Control-Request-Headers", "content-type,x-grpc-web")
+	s.True(IsGrpcWebPreflight(req))
+
+	recorder := httptest.NewRecorder()
+	s.handler.ServeHTTP(recorder, req)
+	s.Equal(http.StatusNoContent, recorder.Code)
+	s.Contains(recorder.Header().Get("Access-Control-Allow-Headers"), "x-grpc-web")
+}
+
+func (s *GrpcWebSuite) TestOriginNotAllowed() {
+	paramtable.Get().Save(paramtable.Get().HTTPCfg.GrpcWebAllowedOrigins.Key, "http://milvus.io")
+
+	req := s.request(s.frame(&grpc_health_v1.HealthCheckRequest{}), "application/grpc-web+proto")
+	recorder := httptest.NewRecorder()
+	s.handler.ServeHTTP(recorder, req)
+	s.Equal(http.StatusForbidden, recorder.Code)
+
+	req = s.request(s.frame(&grpc_health_v1.HealthCheckRequest{}), "application/grpc-web+proto")
+	req.Header.Set("Origin", "http://milvus.io")
+	recorder = httptest.NewRecorder()
+	s.handler.ServeHTTP(recorder, req)
+	s.Equal(http.StatusOK, recorder.Code)
+}
+
+func (s *GrpcWebSuite) TestAnyOrigin() {
+	// no origin allowed by default
+	paramtable.Get().Reset(paramtable.Get().HTTPCfg.GrpcWebAllowedOrigins.Key)
+	req := s.request(s.frame(&grpc_health_v1.HealthCheckRequest{}), "application/grpc-web+proto")
+	recorder := httptest.NewRecorder()
+	s.handler.ServeHTTP(recorder, req)
+	s.Equal(http.StatusForbidden, recorder.Code)
+
+	// the requests not from browser are not restricted
+	req = s.request(s.frame(&grpc_health_v1.HealthCheckRequest{}), "application/grpc-web+proto")
+	req.Header.Del("Origin")
+	recorder = httptest.NewRecorder()
+	s.handler.ServeHTTP(recorder, req)
+	s.Equal(http.StatusOK, recorder.Code)
+	s.Empty(recorder.Header().Get("Access-Control-Allow-Origin"))
+
+	// any origin is allowed without credentials
+	paramtable.Get().Save(paramtable.Get().HTTPCfg.GrpcWebAllowedOrigins.Key, "*")
+	req = s.request(s.frame(&grpc_health_v1.HealthCheckRequest{}), "application/grpc-web+proto")
+	recorder = httptest.NewRecorder()
+	s.handler.ServeHTTP(recorder, req)
+	s.Equal(http.StatusOK, recorder.Code)
+	s.Equal("*", recorder.Header().Get("Access-Control-Allow-Origin"))
+	s.Empty(recorder.Header().Get("Access-Control-Allow-Credentials"))
+}
+
+func (s *GrpcWebSuite) TestNotGrpcWeb() {
+	req := httptest.NewRequest(http.MethodGet, "/v1/vector/collections", nil)
+	s.False(IsGrpcWebRequest(req))
+	s.False(IsGrpcWebPreflight(req))
+}
+
+func TestGrpcWeb(t *testing.T) {
+	suite.Run(t, new(GrpcWebSuite))
+}
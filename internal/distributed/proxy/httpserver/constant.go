@@ -9,9 +9,31 @@ const (
 	VectorInsertPath              = "/vector/insert"
 	VectorUpsertPath              = "/vector/upsert"
 	VectorSearchPath              = "/vector/search"
+	VectorHybridSearchPath        = "/vector/hybrid_search"
 	VectorGetPath                 = "/vector/get"
 	VectorQueryPath               = "/vector/query"
 	VectorDeletePath              = "/vector/delete"
+	VectorSQLPath                 = "/vector/sql"
+
+	AdminDDLCancelPath                     = "/admin/ddl/cancel"
+	AdminCompactionCancelPath              = "/admin/compaction/cancel"
+	AdminStorageMigratePath                = "/admin/storage/migrate"
+	AdminLoadSchedulePath                  = "/admin/load/schedule"
+	AdminLoadConfigPath                    = "/admin/load/config"
+	AdminCollectionRestorePath             = "/admin/collection/restore"
+	AdminCollectionPurgePath               = "/admin/collection/purge"
+	AdminCollectionTemplateCreatePath      = "/admin/collection/template/create"
+	AdminCollectionTemplateDropPath        = "/admin/collection/template/drop"
+	AdminCollectionTemplateInstantiatePath = "/admin/collection/template/instantiate"
+	AdminRoleInheritanceGrantPath          = "/admin/role/inheritance/grant"
+	AdminRoleInheritanceRevokePath         = "/admin/role/inheritance/revoke"
+	AdminRoleInheritanceListPath           = "/admin/role/inheritance/list"
+	AdminAPIKeyCreatePath                  = "/admin/apikey/create"
+	AdminAPIKeyRotatePath                  = "/admin/apikey/rotate"
+	AdminAPIKeyRevokePath                  = "/admin/apikey/revoke"
+	AdminAPIKeyListPath                    = "/admin/apikey/list"
+	AdminSessionListPath                   = "/admin/session/list"
+	AdminSessionKillPath                   = "/admin/session/kill"
 
 	ShardNumDefault = 1
 
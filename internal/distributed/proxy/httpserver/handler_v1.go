@@ -9,6 +9,7 @@ import (
 	"github.com/gin-gonic/gin"
 	"github.com/gin-gonic/gin/binding"
 	"github.com/golang/protobuf/proto"
+	"github.com/samber/lo"
 	"github.com/tidwall/gjson"
 	"go.uber.org/zap"
 
@@ -111,6 +112,11 @@ func (h *Handlers) RegisterRoutesToV1(router gin.IRouter) {
 	router.POST(VectorInsertPath, h.insert)
 	router.POST(VectorUpsertPath, h.upsert)
 	router.POST(VectorSearchPath, h.search)
+	router.POST(VectorHybridSearchPath, h.hybridSearch)
+	if proxy.Params.HTTPCfg.SQLEnabled.GetAsBool() {
+		router.POST(VectorSQLPath, h.sql)
+	}
+	h.registerAdminRoutesToV1(router)
 }
 
 func (h *Handlers) listCollections(c *gin.Context) {
@@ -734,3 +740,193 @@ func (h *Handlers) search(c *gin.Context) {
 		}
 	}
 }
+
+func (h *Handlers) hybridSearch(c *gin.Context) {
+	httpReq := HybridSearchReq{
+		DbName: DefaultDbName,
+		Limit:  100,
+		Rerank: RerankReq{Strategy: proxy.RRFRankerName},
+	}
+	if err := c.ShouldBindBodyWith(&httpReq, binding.JSON); err != nil {
+		log.Warn("high level restful api, the parameter of hybrid search is incorrect", zap.Any("request", httpReq), zap.Error(err))
+		c.AbortWithStatusJSON(http.StatusOK, gin.H{HTTPReturnCode: merr.Code(merr.ErrIncorrectParameterFormat), HTTPReturnMessage: merr.ErrIncorrectParameterFormat.Error()})
+		return
+	}
+	if httpReq.CollectionName == "" || len(httpReq.Search) == 0 || lo.ContainsBy(httpReq.Search, func(s AnnSearchReq) bool { return s.Vector == nil }) {
+		log.Warn("high level restful api, hybrid search require parameter: [collectionName, search, search.vector], but miss")
+		c.AbortWithStatusJSON(http.StatusOK, gin.H{HTTPReturnCode: merr.Code(merr.ErrMissingRequiredParameters), HTTPReturnMessage: merr.ErrMissingRequiredParameters.Error()})
+		return
+	}
+	ranker, err := proxy.NewRanker(httpReq.Rerank.Strategy, httpReq.Rerank.Params, len(httpReq.Search))
+	if err != nil {
+		c.AbortWithStatusJSON(http.StatusOK, gin.H{HTTPReturnCode: merr.Code(err), HTTPReturnMessage: err.Error()})
+		return
+	}
+	reqs := make([]*milvuspb.SearchRequest, 0, len(httpReq.Search))
+	for _, subReq := range httpReq.Search {
+		limit := subReq.Limit
+		if limit <= 0 {
+			limit = httpReq.Limit
+		}
+		params := subReq.Params
+		if params == nil {
+			params = map[string]interface{}{}
+		}
+		bs, _ := json.Marshal(params)
+		searchParams := []*commonpb.KeyValuePair{
+			{Key: common.TopKKey, Value: strconv.FormatInt(int64(limit), 10)},
+			{Key: Params, Value: string(bs)},
+			{Key: ParamRoundDecimal, Value: "-1"},
+		}
+		if subReq.AnnsField != "" {
+			searchParams = append(searchParams, &commonpb.KeyValuePair{Key: ParamAnnsField, Value: subReq.AnnsField})
+		}
+		if subReq.MetricType != "" {
+			searchParams = append(searchParams, &commonpb.KeyValuePair{Key: common.MetricTypeKey, Value: subReq.MetricType})
+		}
+		reqs = append(reqs, &milvuspb.SearchRequest{
+			DbName:             httpReq.DbName,
+			CollectionName:     httpReq.CollectionName,
+			Dsl:                subReq.Filter,
+			PlaceholderGroup:   vector2PlaceholderGroupBytes(subReq.Vector),
+			DslType:            commonpb.DslType_BoolExprV1,
+			OutputFields:       httpReq.OutputFields,
+			SearchParams:       searchParams,
+			GuaranteeTimestamp: BoundedTimestamp,
+			Nq:                 int64(1),
+		})
+	}
+	username, _ := c.Get(ContextUsername)
+	ctx := proxy.NewContextWithMetadata(c, username.(string), httpReq.DbName)
+	// the requests search the same collection
+	if err := checkAuthorization(ctx, c, reqs[0]); err != nil {
+		return
+	}
+	if !h.checkDatabase(ctx, c, httpReq.DbName) {
+		return
+	}
+	response, err := proxy.HybridSearch(ctx, h.proxy.Search, reqs, ranker, int64(httpReq.Limit))
+	if err != nil {
+		c.JSON(http.StatusOK, gin.H{HTTPReturnCode: merr.Code(err), HTTPReturnMessage: err.Error()})
+		return
+	}
+	if len(response.Results.Scores) == 0 {
+		c.JSON(http.StatusOK, gin.H{HTTPReturnCode: http.StatusOK, HTTPReturnData: []interface{}{}})
+		return
+	}
+	outputData, err := buildQueryResp(int64(len(response.Results.Scores)), response.Results.OutputFields, response.Results.FieldsData, response.Results.Ids, response.Results.Scores)
+	if err != nil {
+		log.Warn("high level restful api, fail to deal with hybrid search result", zap.Any("result", response.Results), zap.Error(err))
+		c.JSON(http.StatusOK, gin.H{HTTPReturnCode: merr.Code(merr.ErrInvalidSearchResult), HTTPReturnMessage: merr.ErrInvalidSearchResult.Error()})
+		return
+	}
+	c.JSON(http.StatusOK, gin.H{HTTPReturnCode: http.StatusOK, HTTPReturnData: outputData})
+}
+
+// sql translates the statement of restricted sql dialect to a search if it's ordered by distance, otherwise a query.
+func (h *Handlers) sql(c *gin.Context) {
+	httpReq := SQLReq{
+		DbName: DefaultDbName,
+	}
+	if err := c.ShouldBindBodyWith(&httpReq, binding.JSON); err != nil {
+		log.Warn("high level restful api, the parameter of sql is incorrect", zap.Any("request", httpReq), zap.Error(err))
+		c.AbortWithStatusJSON(http.StatusOK, gin.H{HTTPReturnCode: merr.Code(merr.ErrIncorrectParameterFormat), HTTPReturnMessage: merr.ErrIncorrectParameterFormat.Error()})
+		return
+	}
+	if httpReq.SQL == "" {
+		log.Warn("high level restful api, sql require parameter: [sql], but miss")
+		c.AbortWithStatusJSON(http.StatusOK, gin.H{HTTPReturnCode: merr.Code(merr.ErrMissingRequiredParameters), HTTPReturnMessage: merr.ErrMissingRequiredParameters.Error()})
+		return
+	}
+	stmt, err := parseSQL(httpReq.SQL, httpReq.Params)
+	if err != nil {
+		log.Warn("high level restful api, fail to parse sql", zap.String("sql", httpReq.SQL), zap.Error(err))
+		c.AbortWithStatusJSON(http.StatusOK, gin.H{HTTPReturnCode: merr.Code(err), HTTPReturnMessage: err.Error()})
+		return
+	}
+	username, _ := c.Get(ContextUsername)
+	ctx := proxy.NewContextWithMetadata(c, username.(string), httpReq.DbName)
+
+	if stmt.AnnsField == "" {
+		req := milvuspb.QueryRequest{
+			DbName:             httpReq.DbName,
+			CollectionName:     stmt.CollectionName,
+			Expr:               stmt.Filter,
+			OutputFields:       stmt.OutputFields,
+			GuaranteeTimestamp: BoundedTimestamp,
+			QueryParams: []*commonpb.KeyValuePair{
+				{Key: ParamLimit, Value: strconv.FormatInt(stmt.Limit, 10)},
+				{Key: ParamOffset, Value: strconv.FormatInt(stmt.Offset, 10)},
+			},
+		}
+		if err := checkAuthorization(ctx, c, &req); err != nil {
+			return
+		}
+		if !h.checkDatabase(ctx, c, req.DbName) {
+			return
+		}
+		response, err := h.proxy.Query(ctx, &req)
+		if err == nil {
+			err = merr.Error(response.GetStatus())
+		}
+		if err != nil {
+			c.JSON(http.StatusOK, gin.H{HTTPReturnCode: merr.Code(err), HTTPReturnMessage: err.Error()})
+			return
+		}
+		outputData, err := buildQueryResp(int64(0), response.OutputFields, response.FieldsData, nil, nil)
+		if err != nil {
+			log.Warn("high level restful api, fail to deal with sql result", zap.Any("response", response), zap.Error(err))
+			c.JSON(http.StatusOK, gin.H{HTTPReturnCode: merr.Code(merr.ErrInvalidSearchResult), HTTPReturnMessage: merr.ErrInvalidSearchResult.Error()})
+			return
+		}
+		c.JSON(http.StatusOK, gin.H{HTTPReturnCode: http.StatusOK, HTTPReturnData: outputData})
+		return
+	}
+
+	params := map[string]interface{}{ // auto generated mapping
+		"level": int(commonpb.ConsistencyLevel_Bounded),
+	}
+	bs, _ := json.Marshal(params)
+	req := milvuspb.SearchRequest{
+		DbName:           httpReq.DbName,
+		CollectionName:   stmt.CollectionName,
+		Dsl:              stmt.Filter,
+		PlaceholderGroup: vector2PlaceholderGroupBytes(stmt.Vector),
+		DslType:          commonpb.DslType_BoolExprV1,
+		OutputFields:     lo.Without(stmt.OutputFields, HTTPReturnDistance),
+		SearchParams: []*commonpb.KeyValuePair{
+			{Key: common.TopKKey, Value: strconv.FormatInt(stmt.Limit, 10)},
+			{Key: Params, Value: string(bs)},
+			{Key: ParamRoundDecimal, Value: "-1"},
+			{Key: ParamOffset, Value: strconv.FormatInt(stmt.Offset, 10)},
+			{Key: ParamAnnsField, Value: stmt.AnnsField},
+		},
+		GuaranteeTimestamp: BoundedTimestamp,
+		Nq:                 int64(1),
+	}
+	if err := checkAuthorization(ctx, c, &req); err != nil {
+		return
+	}
+	if !h.checkDatabase(ctx, c, req.DbName) {
+		return
+	}
+	response, err := h.proxy.Search(ctx, &req)
+	if err == nil {
+		err = merr.Error(response.GetStatus())
+	}
+	if err != nil {
+		c.JSON(http.StatusOK, gin.H{HTTPReturnCode: merr.Code(err), HTTPReturnMessage: err.Error()})
+		return
+	}
+	if response.Results.TopK == int64(0) {
+		c.JSON(http.StatusOK, gin.H{HTTPReturnCode: http.StatusOK, HTTPReturnData: []interface{}{}})
+		return
+	}
+	outputData, err := buildQueryResp(response.Results.TopK, response.Results.OutputFields, response.Results.FieldsData, response.Results.Ids, response.Results.Scores)
+	if err != nil {
+		log.Warn("high level restful api, fail to deal with sql result", zap.Any("result", response.Results), zap.Error(err))
+		c.JSON(http.StatusOK, gin.H{HTTPReturnCode: merr.Code(merr.ErrInvalidSearchResult), HTTPReturnMessage: merr.ErrInvalidSearchResult.Error()})
+		return
+	}
+	c.JSON(http.StatusOK, gin.H{HTTPReturnCode: http.StatusOK, HTTPReturnData: outputData})
+}
@@ -0,0 +1,582 @@
+package httpserver
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/gin-gonic/gin/binding"
+	"github.com/golang/protobuf/proto"
+	"go.uber.org/zap"
+
+	"github.com/milvus-io/milvus-proto/go-api/v2/commonpb"
+	"github.com/milvus-io/milvus-proto/go-api/v2/milvuspb"
+	"github.com/milvus-io/milvus-proto/go-api/v2/schemapb"
+	"github.com/milvus-io/milvus/internal/proto/datapb"
+	"github.com/milvus-io/milvus/internal/proto/proxypb"
+	"github.com/milvus-io/milvus/internal/proto/querypb"
+	"github.com/milvus-io/milvus/internal/proto/rootcoordpb"
+	"github.com/milvus-io/milvus/internal/proxy"
+	"github.com/milvus-io/milvus/pkg/log"
+	"github.com/milvus-io/milvus/pkg/util/funcutil"
+	"github.com/milvus-io/milvus/pkg/util/merr"
+)
+
+// registerAdminRoutesToV1 registers the routes of the admin operations, which are authenticated as the other
+// routes of v1 and authorized by the privilege declared on the internal request.
+func (h *Handlers) registerAdminRoutesToV1(router gin.IRouter) {
+	router.POST(AdminDDLCancelPath, h.cancelDDLTask)
+	router.POST(AdminCompactionCancelPath, h.cancelCompactionPlan)
+	router.POST(AdminStorageMigratePath, h.operateStorageMigration)
+	router.POST(AdminLoadSchedulePath, h.operateLoadSchedule)
+	router.POST(AdminLoadConfigPath, h.updateLoadConfig)
+	router.POST(AdminCollectionRestorePath, h.operateCollectionTrash(rootcoordpb.CollectionTrashOperateType_RestoreCollection))
+	router.POST(AdminCollectionPurgePath, h.operateCollectionTrash(rootcoordpb.CollectionTrashOperateType_PurgeCollection))
+	router.POST(AdminCollectionTemplateCreatePath, h.createCollectionTemplate)
+	router.POST(AdminCollectionTemplateDropPath, h.dropCollectionTemplate)
+	router.POST(AdminCollectionTemplateInstantiatePath, h.createCollectionFromTemplate)
+	router.POST(AdminRoleInheritanceGrantPath, h.operateRoleInheritance(milvuspb.OperatePrivilegeType_Grant))
+	router.POST(AdminRoleInheritanceRevokePath, h.operateRoleInheritance(milvuspb.OperatePrivilegeType_Revoke))
+	router.GET(AdminRoleInheritanceListPath, h.listRoleInheritances)
+	router.POST(AdminAPIKeyCreatePath, h.createAPIKey)
+	router.POST(AdminAPIKeyRotatePath, h.operateAPIKey(rootcoordpb.APIKeyOperateType_RotateAPIKey))
+	router.POST(AdminAPIKeyRevokePath, h.operateAPIKey(rootcoordpb.APIKeyOperateType_RevokeAPIKey))
+	router.GET(AdminAPIKeyListPath, h.listAPIKeys)
+	router.GET(AdminSessionListPath, h.listSessions)
+	router.POST(AdminSessionKillPath, h.killSession)
+}
+
+// bindAdminRequest binds the body of the admin request, the response is written if the body is incorrect.
+func bindAdminRequest(c *gin.Context, httpReq any) bool {
+	if err := c.ShouldBindBodyWith(httpReq, binding.JSON); err != nil {
+		log.Warn("high level restful api, the parameter of admin request is incorrect",
+			zap.String("path", c.FullPath()), zap.Any("request", httpReq), zap.Error(err))
+		c.AbortWithStatusJSON(http.StatusOK, gin.H{HTTPReturnCode: merr.Code(merr.ErrIncorrectParameterFormat), HTTPReturnMessage: merr.ErrIncorrectParameterFormat.Error()})
+		return false
+	}
+	return true
+}
+
+// authorizeAdminRequest checks the privilege of the user on the internal request of the admin operation in the database.
+func authorizeAdminRequest(c *gin.Context, dbName string, req any) (context.Context, bool) {
+	username, _ := c.Get(ContextUsername)
+	ctx := proxy.NewContextWithMetadata(c, username.(string), dbName)
+	if err := checkAuthorization(ctx, c, req); err != nil {
+		return nil, false
+	}
+	return ctx, true
+}
+
+// writeAdminStatus writes the status returned by the admin operation.
+func writeAdminStatus(c *gin.Context, status *commonpb.Status, err error) {
+	if err == nil {
+		err = merr.Error(status)
+	}
+	if err != nil {
+		c.JSON(http.StatusOK, gin.H{HTTPReturnCode: merr.Code(err), HTTPReturnMessage: err.Error()})
+		return
+	}
+	c.JSON(http.StatusOK, gin.H{HTTPReturnCode: http.StatusOK, HTTPReturnData: gin.H{}})
+}
+
+func (h *Handlers) cancelDDLTask(c *gin.Context) {
+	httpReq := CancelDDLTaskReq{}
+	if !bindAdminRequest(c, &httpReq) {
+		return
+	}
+	if httpReq.TaskID == 0 {
+		log.Warn("high level restful api, cancel ddl task require parameter: [taskId], but miss")
+		c.AbortWithStatusJSON(http.StatusOK, gin.H{HTTPReturnCode: merr.Code(merr.ErrMissingRequiredParameters), HTTPReturnMessage: merr.ErrMissingRequiredParameters.Error()})
+		return
+	}
+	req := &rootcoordpb.CancelDDLTaskRequest{TaskID: httpReq.TaskID}
+	ctx, ok := authorizeAdminRequest(c, DefaultDbName, req)
+	if !ok {
+		return
+	}
+	status, err := h.proxy.CancelDDLTask(ctx, req)
+	writeAdminStatus(c, status, err)
+}
+
+func (h *Handlers) cancelCompactionPlan(c *gin.Context) {
+	httpReq := CancelCompactionPlanReq{}
+	if !bindAdminRequest(c, &httpReq) {
+		return
+	}
+	if httpReq.PlanID == 0 {
+		log.Warn("high level restful api, cancel compaction plan require parameter: [planId], but miss")
+		c.AbortWithStatusJSON(http.StatusOK, gin.H{HTTPReturnCode: merr.Code(merr.ErrMissingRequiredParameters), HTTPReturnMessage: merr.ErrMissingRequiredParameters.Error()})
+		return
+	}
+	req := &datapb.CancelCompactionPlanRequest{PlanID: httpReq.PlanID}
+	ctx, ok := authorizeAdminRequest(c, DefaultDbName, req)
+	if !ok {
+		return
+	}
+	status, err := h.proxy.CancelCompactionPlan(ctx, req)
+	writeAdminStatus(c, status, err)
+}
+
+func (h *Handlers) operateStorageMigration(c *gin.Context) {
+	httpReq := StorageMigrationReq{
+		DbName: DefaultDbName,
+	}
+	if !bindAdminRequest(c, &httpReq) {
+		return
+	}
+	if httpReq.CollectionName == "" {
+		log.Warn("high level restful api, storage migration require parameter: [collectionName], but miss")
+		c.AbortWithStatusJSON(http.StatusOK, gin.H{HTTPReturnCode: merr.Code(merr.ErrMissingRequiredParameters), HTTPReturnMessage: merr.ErrMissingRequiredParameters.Error()})
+		return
+	}
+	req := &datapb.OperateStorageMigrationRequest{
+		DbName:         httpReq.DbName,
+		CollectionName: httpReq.CollectionName,
+		OperateType:    datapb.StorageMigrationOperateType_StartMigration,
+		Target: &datapb.StorageMigrationTarget{
+			StorageType:     httpReq.Target.StorageType,
+			Address:         httpReq.Target.Address,
+			BucketName:      httpReq.Target.BucketName,
+			RootPath:        httpReq.Target.RootPath,
+			AccessKeyID:     httpReq.Target.AccessKeyID,
+			SecretAccessKey: httpReq.Target.SecretAccessKey,
+			UseSsl:          httpReq.Target.UseSSL,
+			UseIam:          httpReq.Target.UseIAM,
+			CloudProvider:   httpReq.Target.CloudProvider,
+			IamEndpoint:     httpReq.Target.IAMEndpoint,
+			Region:          httpReq.Target.Region,
+		},
+	}
+	if httpReq.Cancel {
+		req.OperateType = datapb.StorageMigrationOperateType_CancelMigration
+		req.Target = nil
+	}
+	ctx, ok := authorizeAdminRequest(c, req.DbName, req)
+	if !ok {
+		return
+	}
+	status, err := h.proxy.OperateStorageMigration(ctx, req)
+	writeAdminStatus(c, status, err)
+}
+
+func (h *Handlers) operateLoadSchedule(c *gin.Context) {
+	httpReq := LoadScheduleReq{
+		DbName: DefaultDbName,
+	}
+	if !bindAdminRequest(c, &httpReq) {
+		return
+	}
+	if httpReq.CollectionName == "" {
+		log.Warn("high level restful api, load schedule require parameter: [collectionName], but miss")
+		c.AbortWithStatusJSON(http.StatusOK, gin.H{HTTPReturnCode: merr.Code(merr.ErrMissingRequiredParameters), HTTPReturnMessage: merr.ErrMissingRequiredParameters.Error()})
+		return
+	}
+	req := &querypb.OperateLoadScheduleRequest{
+		DbName:         httpReq.DbName,
+		CollectionName: httpReq.CollectionName,
+		OperateType:    querypb.LoadScheduleOperateType_PutLoadSchedule,
+		LoadSpec:       httpReq.LoadSpec,
+		ReleaseSpec:    httpReq.ReleaseSpec,
+		Timezone:       httpReq.Timezone,
+		ReplicaNumber:  httpReq.ReplicaNumber,
+		ResourceGroups: httpReq.ResourceGroups,
+	}
+	if httpReq.Remove {
+		req = &querypb.OperateLoadScheduleRequest{
+			DbName:         httpReq.DbName,
+			CollectionName: httpReq.CollectionName,
+			OperateType:    querypb.LoadScheduleOperateType_RemoveLoadSchedule,
+		}
+	}
+	ctx, ok := authorizeAdminRequest(c, req.DbName, req)
+	if !ok {
+		return
+	}
+	status, err := h.proxy.OperateLoadSchedule(ctx, req)
+	writeAdminStatus(c, status, err)
+}
+
+func (h *Handlers) updateLoadConfig(c *gin.Context) {
+	httpReq := UpdateLoadConfigReq{
+		DbName: DefaultDbName,
+	}
+	if !bindAdminRequest(c, &httpReq) {
+		return
+	}
+	if httpReq.CollectionName == "" {
+		log.Warn("high level restful api, update load config require parameter: [collectionName], but miss")
+		c.AbortWithStatusJSON(http.StatusOK, gin.H{HTTPReturnCode: merr.Code(merr.ErrMissingRequiredParameters), HTTPReturnMessage: merr.ErrMissingRequiredParameters.Error()})
+		return
+	}
+	req := &querypb.UpdateLoadConfigRequest{
+		DbName:         httpReq.DbName,
+		CollectionName: httpReq.CollectionName,
+		ReplicaNumber:  httpReq.ReplicaNumber,
+		ResourceGroups: httpReq.ResourceGroups,
+	}
+	if httpReq.MmapEnabled != nil {
+		req.MmapEnabled = *httpReq.MmapEnabled
+		req.MmapSpecified = true
+	}
+	ctx, ok := authorizeAdminRequest(c, req.DbName, req)
+	if !ok {
+		return
+	}
+	status, err := h.proxy.UpdateLoadConfig(ctx, req)
+	writeAdminStatus(c, status, err)
+}
+
+func (h *Handlers) operateCollectionTrash(operateType rootcoordpb.CollectionTrashOperateType) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		httpReq := CollectionTrashReq{}
+		if !bindAdminRequest(c, &httpReq) {
+			return
+		}
+		if httpReq.CollectionID == 0 {
+			log.Warn("high level restful api, operate collection trash require parameter: [collectionId], but miss")
+			c.AbortWithStatusJSON(http.StatusOK, gin.H{HTTPReturnCode: merr.Code(merr.ErrMissingRequiredParameters), HTTPReturnMessage: merr.ErrMissingRequiredParameters.Error()})
+			return
+		}
+		req := &rootcoordpb.OperateCollectionTrashRequest{
+			CollectionID: httpReq.CollectionID,
+			OperateType:  operateType,
+		}
+		ctx, ok := authorizeAdminRequest(c, DefaultDbName, req)
+		if !ok {
+			return
+		}
+		status, err := h.proxy.OperateCollectionTrash(ctx, req)
+		writeAdminStatus(c, status, err)
+	}
+}
+
+// newCollectionTemplate converts the collection template in the http body to the internal one, the data types and
+// consistency level are in the names.
+func newCollectionTemplate(httpReq *CreateCollectionTemplateReq) (*rootcoordpb.CollectionTemplate, error) {
+	schema := &schemapb.CollectionSchema{
+		Name:               httpReq.Name,
+		Description:        httpReq.Description,
+		EnableDynamicField: httpReq.EnableDynamicField,
+	}
+	for _, field := range httpReq.Fields {
+		dataType, ok := schemapb.DataType_value[field.DataType]
+		if !ok {
+			return nil, merr.WrapErrParameterInvalidMsg("invalid data type %s of field %s", field.DataType, field.FieldName)
+		}
+		schema.Fields = append(schema.Fields, &schemapb.FieldSchema{
+			Name:           field.FieldName,
+			Description:    field.Description,
+			DataType:       schemapb.DataType(dataType),
+			IsPrimaryKey:   field.IsPrimary,
+			AutoID:         field.AutoID,
+			IsPartitionKey: field.IsPartitionKey,
+			TypeParams:     funcutil.Map2KeyValuePair(field.TypeParams),
+		})
+		if field.IsPrimary {
+			schema.AutoID = field.AutoID
+		}
+	}
+	marshaledSchema, err := proto.Marshal(schema)
+	if err != nil {
+		return nil, err
+	}
+
+	consistencyLevel := commonpb.ConsistencyLevel_Bounded
+	if httpReq.ConsistencyLevel != "" {
+		level, ok := commonpb.ConsistencyLevel_value[httpReq.ConsistencyLevel]
+		if !ok {
+			return nil, merr.WrapErrParameterInvalidMsg("invalid consistency level %s", httpReq.ConsistencyLevel)
+		}
+		consistencyLevel = commonpb.ConsistencyLevel(level)
+	}
+	indexPresets := make([]*rootcoordpb.CollectionTemplateIndexPreset, 0, len(httpReq.Indexes))
+	for _, index := range httpReq.Indexes {
+		indexPresets = append(indexPresets, &rootcoordpb.CollectionTemplateIndexPreset{
+			FieldName:  index.FieldName,
+			IndexType:  index.IndexType,
+			MetricType: index.MetricType,
+			Params:     funcutil.Map2KeyValuePair(index.Params),
+		})
+	}
+	return &rootcoordpb.CollectionTemplate{
+		Name:             httpReq.Name,
+		Description:      httpReq.Description,
+		Schema:           marshaledSchema,
+		ShardsNum:        httpReq.ShardsNum,
+		ConsistencyLevel: consistencyLevel,
+		Properties:       funcutil.Map2KeyValuePair(httpReq.Properties),
+		IndexPresets:     indexPresets,
+	}, nil
+}
+
+func (h *Handlers) createCollectionTemplate(c *gin.Context) {
+	httpReq := CreateCollectionTemplateReq{}
+	if !bindAdminRequest(c, &httpReq) {
+		return
+	}
+	if httpReq.Name == "" || len(httpReq.Fields) == 0 {
+		log.Warn("high level restful api, create collection template require parameter: [name, fields], but miss")
+		c.AbortWithStatusJSON(http.StatusOK, gin.H{HTTPReturnCode: merr.Code(merr.ErrMissingRequiredParameters), HTTPReturnMessage: merr.ErrMissingRequiredParameters.Error()})
+		return
+	}
+	template, err := newCollectionTemplate(&httpReq)
+	if err != nil {
+		log.Warn("high level restful api, the collection template is invalid", zap.Error(err))
+		c.AbortWithStatusJSON(http.StatusOK, gin.H{HTTPReturnCode: merr.Code(err), HTTPReturnMessage: err.Error()})
+		return
+	}
+	req := &rootcoordpb.OperateCollectionTemplateRequest{
+		OperateType: rootcoordpb.CollectionTemplateOperateType_CreateCollectionTemplate,
+		Template:    template,
+	}
+	ctx, ok := authorizeAdminRequest(c, DefaultDbName, req)
+	if !ok {
+		return
+	}
+	status, err := h.proxy.OperateCollectionTemplate(ctx, req)
+	writeAdminStatus(c, status, err)
+}
+
+func (h *Handlers) dropCollectionTemplate(c *gin.Context) {
+	httpReq := DropCollectionTemplateReq{}
+	if !bindAdminRequest(c, &httpReq) {
+		return
+	}
+	if httpReq.Name == "" {
+		log.Warn("high level restful api, drop collection template require parameter: [name], but miss")
+		c.AbortWithStatusJSON(http.StatusOK, gin.H{HTTPReturnCode: merr.Code(merr.ErrMissingRequiredParameters), HTTPReturnMessage: merr.ErrMissingRequiredParameters.Error()})
+		return
+	}
+	req := &rootcoordpb.OperateCollectionTemplateRequest{
+		OperateType:  rootcoordpb.CollectionTemplateOperateType_DropCollectionTemplate,
+		TemplateName: httpReq.Name,
+	}
+	ctx, ok := authorizeAdminRequest(c, DefaultDbName, req)
+	if !ok {
+		return
+	}
+	status, err := h.proxy.OperateCollectionTemplate(ctx, req)
+	writeAdminStatus(c, status, err)
+}
+
+func (h *Handlers) createCollectionFromTemplate(c *gin.Context) {
+	httpReq := CreateCollectionFromTemplateReq{
+		DbName: DefaultDbName,
+	}
+	if !bindAdminRequest(c, &httpReq) {
+		return
+	}
+	if httpReq.CollectionName == "" || httpReq.TemplateName == "" {
+		log.Warn("high level restful api, create collection from template require parameter: [collectionName, templateName], but miss")
+		c.AbortWithStatusJSON(http.StatusOK, gin.H{HTTPReturnCode: merr.Code(merr.ErrMissingRequiredParameters), HTTPReturnMessage: merr.ErrMissingRequiredParameters.Error()})
+		return
+	}
+	req := &rootcoordpb.CreateCollectionFromTemplateRequest{
+		DbName:         httpReq.DbName,
+		CollectionName: httpReq.CollectionName,
+		TemplateName:   httpReq.TemplateName,
+	}
+	ctx, ok := authorizeAdminRequest(c, req.DbName, req)
+	if !ok {
+		return
+	}
+	status, err := h.proxy.CreateCollectionFromTemplate(ctx, req)
+	writeAdminStatus(c, status, err)
+}
+
+func (h *Handlers) operateRoleInheritance(operateType milvuspb.OperatePrivilegeType) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		httpReq := RoleInheritanceReq{}
+		if !bindAdminRequest(c, &httpReq) {
+			return
+		}
+		if httpReq.RoleName == "" || httpReq.ParentRoleName == "" {
+			log.Warn("high level restful api, operate role inheritance require parameter: [roleName, parentRoleName], but miss")
+			c.AbortWithStatusJSON(http.StatusOK, gin.H{HTTPReturnCode: merr.Code(merr.ErrMissingRequiredParameters), HTTPReturnMessage: merr.ErrMissingRequiredParameters.Error()})
+			return
+		}
+		req := &rootcoordpb.OperateRoleInheritanceRequest{
+			RoleName:       httpReq.RoleName,
+			ParentRoleName: httpReq.ParentRoleName,
+			Type:           operateType,
+		}
+		ctx, ok := authorizeAdminRequest(c, DefaultDbName, req)
+		if !ok {
+			return
+		}
+		status, err := h.proxy.OperateRoleInheritance(ctx, req)
+		writeAdminStatus(c, status, err)
+	}
+}
+
+func (h *Handlers) listRoleInheritances(c *gin.Context) {
+	req := &rootcoordpb.ListRoleInheritancesRequest{}
+	ctx, ok := authorizeAdminRequest(c, DefaultDbName, req)
+	if !ok {
+		return
+	}
+	resp, err := h.proxy.ListRoleInheritances(ctx, req)
+	if err == nil {
+		err = merr.Error(resp.GetStatus())
+	}
+	if err != nil {
+		c.JSON(http.StatusOK, gin.H{HTTPReturnCode: merr.Code(err), HTTPReturnMessage: err.Error()})
+		return
+	}
+	inheritances := make([]gin.H, 0, len(resp.GetInheritances()))
+	for _, inheritance := range resp.GetInheritances() {
+		inheritances = append(inheritances, gin.H{"roleName": inheritance.GetRoleName(), "parentRoleNames": inheritance.GetParentRoleNames()})
+	}
+	c.JSON(http.StatusOK, gin.H{HTTPReturnCode: http.StatusOK, HTTPReturnData: inheritances})
+}
+
+// apiKeyData is the api key in the response, the hashed secret is never exposed,
+// and the key with secret is only present when the api key is created or rotated.
+func apiKeyData(key *rootcoordpb.APIKeyInfo, token string) gin.H {
+	data := gin.H{
+		"id":          key.GetId(),
+		"username":    key.GetUsername(),
+		"scopes":      key.GetScopes(),
+		"expireTime":  key.GetExpireTime(),
+		"createdTime": key.GetCreatedTime(),
+		"rotatedTime": key.GetRotatedTime(),
+	}
+	if token != "" {
+		data["key"] = token
+	}
+	return data
+}
+
+func writeAPIKeyResponse(c *gin.Context, resp *rootcoordpb.OperateAPIKeyResponse, err error) {
+	if err == nil {
+		err = merr.Error(resp.GetStatus())
+	}
+	if err != nil {
+		c.JSON(http.StatusOK, gin.H{HTTPReturnCode: merr.Code(err), HTTPReturnMessage: err.Error()})
+		return
+	}
+	if resp.GetKey() == nil {
+		c.JSON(http.StatusOK, gin.H{HTTPReturnCode: http.StatusOK, HTTPReturnData: gin.H{}})
+		return
+	}
+	c.JSON(http.StatusOK, gin.H{HTTPReturnCode: http.StatusOK, HTTPReturnData: apiKeyData(resp.GetKey(), resp.GetToken())})
+}
+
+func (h *Handlers) createAPIKey(c *gin.Context) {
+	httpReq := CreateAPIKeyReq{}
+	if !bindAdminRequest(c, &httpReq) {
+		return
+	}
+	if httpReq.Username == "" {
+		log.Warn("high level restful api, create api key require parameter: [username], but miss")
+		c.AbortWithStatusJSON(http.StatusOK, gin.H{HTTPReturnCode: merr.Code(merr.ErrMissingRequiredParameters), HTTPReturnMessage: merr.ErrMissingRequiredParameters.Error()})
+		return
+	}
+	req := &rootcoordpb.OperateAPIKeyRequest{
+		OperateType: rootcoordpb.APIKeyOperateType_CreateAPIKey,
+		Username:    httpReq.Username,
+		Scopes:      httpReq.Scopes,
+		TtlSeconds:  httpReq.TTLSeconds,
+	}
+	ctx, ok := authorizeAdminRequest(c, DefaultDbName, req)
+	if !ok {
+		return
+	}
+	resp, err := h.proxy.OperateAPIKey(ctx, req)
+	writeAPIKeyResponse(c, resp, err)
+}
+
+func (h *Handlers) operateAPIKey(operateType rootcoordpb.APIKeyOperateType) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		httpReq := APIKeyReq{}
+		if !bindAdminRequest(c, &httpReq) {
+			return
+		}
+		if httpReq.ID == "" {
+			log.Warn("high level restful api, operate api key require parameter: [id], but miss")
+			c.AbortWithStatusJSON(http.StatusOK, gin.H{HTTPReturnCode: merr.Code(merr.ErrMissingRequiredParameters), HTTPReturnMessage: merr.ErrMissingRequiredParameters.Error()})
+			return
+		}
+		req := &rootcoordpb.OperateAPIKeyRequest{
+			OperateType: operateType,
+			Id:          httpReq.ID,
+		}
+		ctx, ok := authorizeAdminRequest(c, DefaultDbName, req)
+		if !ok {
+			return
+		}
+		resp, err := h.proxy.OperateAPIKey(ctx, req)
+		writeAPIKeyResponse(c, resp, err)
+	}
+}
+
+func (h *Handlers) listAPIKeys(c *gin.Context) {
+	req := &rootcoordpb.ListAPIKeysRequest{Username: c.Query("username")}
+	ctx, ok := authorizeAdminRequest(c, DefaultDbName, req)
+	if !ok {
+		return
+	}
+	resp, err := h.proxy.ListAPIKeys(ctx, req)
+	if err == nil {
+		err = merr.Error(resp.GetStatus())
+	}
+	if err != nil {
+		c.JSON(http.StatusOK, gin.H{HTTPReturnCode: merr.Code(err), HTTPReturnMessage: err.Error()})
+		return
+	}
+	keys := make([]gin.H, 0, len(resp.GetKeys()))
+	for _, key := range resp.GetKeys() {
+		keys = append(keys, apiKeyData(key, ""))
+	}
+	c.JSON(http.StatusOK, gin.H{HTTPReturnCode: http.StatusOK, HTTPReturnData: keys})
+}
+
+// listSessions lists the sessions established by Connect in the proxy serving the request, the sessions are not
+// shared among proxies.
+func (h *Handlers) listSessions(c *gin.Context) {
+	req := &proxypb.ListSessionsRequest{User: c.Query("user")}
+	ctx, ok := authorizeAdminRequest(c, DefaultDbName, req)
+	if !ok {
+		return
+	}
+	resp, err := h.proxy.ListSessions(ctx, req)
+	if err == nil {
+		err = merr.Error(resp.GetStatus())
+	}
+	if err != nil {
+		c.JSON(http.StatusOK, gin.H{HTTPReturnCode: merr.Code(err), HTTPReturnMessage: err.Error()})
+		return
+	}
+	sessions := make([]gin.H, 0, len(resp.GetSessions()))
+	for _, session := range resp.GetSessions() {
+		sessions = append(sessions, gin.H{
+			"identifier":     session.GetIdentifier(),
+			"user":           session.GetUser(),
+			"sdkType":        session.GetSdkType(),
+			"sdkVersion":     session.GetSdkVersion(),
+			"host":           session.GetHost(),
+			"lastActiveTime": session.GetLastActiveTime(),
+			"idleSeconds":    session.GetIdleSeconds(),
+		})
+	}
+	c.JSON(http.StatusOK, gin.H{HTTPReturnCode: http.StatusOK, HTTPReturnData: sessions})
+}
+
+func (h *Handlers) killSession(c *gin.Context) {
+	httpReq := KillSessionReq{}
+	if !bindAdminRequest(c, &httpReq) {
+		return
+	}
+	if httpReq.Identifier == 0 {
+		log.Warn("high level restful api, kill session require parameter: [identifier], but miss")
+		c.AbortWithStatusJSON(http.StatusOK, gin.H{HTTPReturnCode: merr.Code(merr.ErrMissingRequiredParameters), HTTPReturnMessage: merr.ErrMissingRequiredParameters.Error()})
+		return
+	}
+	req := &proxypb.KillSessionRequest{Identifier: httpReq.Identifier}
+	ctx, ok := authorizeAdminRequest(c, DefaultDbName, req)
+	if !ok {
+		return
+	}
+	status, err := h.proxy.KillSession(ctx, req)
+	writeAdminStatus(c, status, err)
+}
@@ -0,0 +1,663 @@
+package httpserver
+
+import (
+	"bytes"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/golang/protobuf/proto"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+
+	"github.com/milvus-io/milvus-proto/go-api/v2/commonpb"
+	"github.com/milvus-io/milvus-proto/go-api/v2/milvuspb"
+	"github.com/milvus-io/milvus-proto/go-api/v2/schemapb"
+	"github.com/milvus-io/milvus/internal/mocks"
+	"github.com/milvus-io/milvus/internal/proto/datapb"
+	"github.com/milvus-io/milvus/internal/proto/proxypb"
+	"github.com/milvus-io/milvus/internal/proto/querypb"
+	"github.com/milvus-io/milvus/internal/proto/rootcoordpb"
+	"github.com/milvus-io/milvus/internal/proxy"
+	"github.com/milvus-io/milvus/pkg/util"
+	"github.com/milvus-io/milvus/pkg/util/merr"
+	"github.com/milvus-io/milvus/pkg/util/paramtable"
+)
+
+type adminTestCase struct {
+	name         string
+	mp           *mocks.MockProxy
+	body         string
+	expectedBody string
+}
+
+func runAdminTestCases(t *testing.T, path string, testCases []adminTestCase) {
+	runAdminRequestTestCases(t, http.MethodPost, path, testCases)
+}
+
+func runAdminRequestTestCases(t *testing.T, method string, path string, testCases []adminTestCase) {
+	for _, tt := range testCases {
+		t.Run(tt.name, func(t *testing.T) {
+			mp := tt.mp
+			if mp == nil {
+				mp = mocks.NewMockProxy(t)
+			}
+			testEngine := initHTTPServer(mp, true)
+			req := httptest.NewRequest(method, versional(path), bytes.NewReader([]byte(tt.body)))
+			req.SetBasicAuth(util.UserRoot, util.DefaultRootPassword)
+			w := httptest.NewRecorder()
+			testEngine.ServeHTTP(w, req)
+			assert.Equal(t, http.StatusOK, w.Code)
+			assert.Equal(t, tt.expectedBody, w.Body.String())
+		})
+	}
+}
+
+func TestAdminAuthorization(t *testing.T) {
+	paramtable.Init()
+	paramtable.Get().Save(proxy.Params.CommonCfg.AuthorizationEnabled.Key, "true")
+	defer paramtable.Get().Reset(proxy.Params.CommonCfg.AuthorizationEnabled.Key)
+
+	errorStr := Print(merr.Code(merr.ErrServiceUnavailable), "internal: Milvus Proxy is not ready yet. please wait: service unavailable")
+	paths := map[string]string{
+		AdminDDLCancelPath:                     `{"taskId": 1}`,
+		AdminCompactionCancelPath:              `{"planId": 1}`,
+		AdminStorageMigratePath:                `{"collectionName": "book", "cancel": true}`,
+		AdminLoadSchedulePath:                  `{"collectionName": "book", "remove": true}`,
+		AdminLoadConfigPath:                    `{"collectionName": "book", "replicaNumber": 2}`,
+		AdminCollectionRestorePath:             `{"collectionId": 1}`,
+		AdminCollectionPurgePath:               `{"collectionId": 1}`,
+		AdminCollectionTemplateCreatePath:      `{"name": "openai-1536-cosine", "fields": [{"fieldName": "id", "dataType": "Int64", "isPrimary": true}]}`,
+		AdminCollectionTemplateDropPath:        `{"name": "openai-1536-cosine"}`,
+		AdminCollectionTemplateInstantiatePath: `{"collectionName": "docs", "templateName": "openai-1536-cosine"}`,
+		AdminRoleInheritanceGrantPath:          `{"roleName": "reader", "parentRoleName": "public"}`,
+		AdminRoleInheritanceRevokePath:         `{"roleName": "reader", "parentRoleName": "public"}`,
+		AdminAPIKeyCreatePath:                  `{"username": "foo", "scopes": ["read"]}`,
+		AdminAPIKeyRotatePath:                  `{"id": "8c2f4a1e9b7d3c05"}`,
+		AdminAPIKeyRevokePath:                  `{"id": "8c2f4a1e9b7d3c05"}`,
+		AdminSessionKillPath:                   `{"identifier": 445566778899}`,
+	}
+	for path, body := range paths {
+		t.Run(path, func(t *testing.T) {
+			testEngine := initHTTPServer(mocks.NewMockProxy(t), true)
+			req := httptest.NewRequest(http.MethodPost, versional(path), bytes.NewReader([]byte(body)))
+			req.Header.Set("authorization", "Bearer test:test")
+			w := httptest.NewRecorder()
+			testEngine.ServeHTTP(w, req)
+			assert.Equal(t, http.StatusForbidden, w.Code)
+			assert.Equal(t, errorStr, w.Body.String())
+		})
+	}
+}
+
+func TestCancelDDLTask(t *testing.T) {
+	paramtable.Init()
+	err := merr.WrapErrParameterInvalidMsg("ddl task 1 not found in queue")
+
+	mp1 := mocks.NewMockProxy(t)
+	mp1.EXPECT().CancelDDLTask(mock.Anything, mock.Anything).Return(nil, ErrDefault).Once()
+	mp2 := mocks.NewMockProxy(t)
+	mp2.EXPECT().CancelDDLTask(mock.Anything, mock.Anything).Return(merr.Status(err), nil).Once()
+	mp3 := mocks.NewMockProxy(t)
+	mp3.EXPECT().CancelDDLTask(mock.Anything, mock.Anything).Return(&StatusSuccess, nil).Once()
+
+	runAdminTestCases(t, AdminDDLCancelPath, []adminTestCase{
+		{
+			name:         "incorrect parameter",
+			body:         `{"taskId": "abc"}`,
+			expectedBody: PrintErr(merr.ErrIncorrectParameterFormat),
+		},
+		{
+			name:         "missing task id",
+			body:         `{}`,
+			expectedBody: PrintErr(merr.ErrMissingRequiredParameters),
+		},
+		{
+			name:         "cancel ddl task fail",
+			mp:           mp1,
+			body:         `{"taskId": 1}`,
+			expectedBody: PrintErr(ErrDefault),
+		},
+		{
+			name:         "task not found",
+			mp:           mp2,
+			body:         `{"taskId": 1}`,
+			expectedBody: PrintErr(err),
+		},
+		{
+			name:         "ok",
+			mp:           mp3,
+			body:         `{"taskId": 2}`,
+			expectedBody: "{\"code\":200,\"data\":{}}",
+		},
+	})
+}
+
+func TestCancelCompactionPlan(t *testing.T) {
+	paramtable.Init()
+	err := merr.WrapErrParameterInvalidMsg("compaction plan 1 not found")
+
+	mp1 := mocks.NewMockProxy(t)
+	mp1.EXPECT().CancelCompactionPlan(mock.Anything, mock.Anything).Return(nil, ErrDefault).Once()
+	mp2 := mocks.NewMockProxy(t)
+	mp2.EXPECT().CancelCompactionPlan(mock.Anything, mock.Anything).Return(merr.Status(err), nil).Once()
+	mp3 := mocks.NewMockProxy(t)
+	mp3.EXPECT().CancelCompactionPlan(mock.Anything, mock.Anything).Return(&StatusSuccess, nil).Once()
+
+	runAdminTestCases(t, AdminCompactionCancelPath, []adminTestCase{
+		{
+			name:         "missing plan id",
+			body:         `{}`,
+			expectedBody: PrintErr(merr.ErrMissingRequiredParameters),
+		},
+		{
+			name:         "cancel compaction plan fail",
+			mp:           mp1,
+			body:         `{"planId": 1}`,
+			expectedBody: PrintErr(ErrDefault),
+		},
+		{
+			name:         "plan not found",
+			mp:           mp2,
+			body:         `{"planId": 1}`,
+			expectedBody: PrintErr(err),
+		},
+		{
+			name:         "ok",
+			mp:           mp3,
+			body:         `{"planId": 2}`,
+			expectedBody: "{\"code\":200,\"data\":{}}",
+		},
+	})
+}
+
+func TestOperateStorageMigration(t *testing.T) {
+	paramtable.Init()
+	err := merr.WrapErrCollectionNotFound(DefaultCollectionName)
+
+	mp1 := mocks.NewMockProxy(t)
+	mp1.EXPECT().OperateStorageMigration(mock.Anything, mock.Anything).Return(merr.Status(err), nil).Once()
+	mp2 := mocks.NewMockProxy(t)
+	mp2.EXPECT().OperateStorageMigration(mock.Anything, mock.MatchedBy(func(req *datapb.OperateStorageMigrationRequest) bool {
+		return req.GetOperateType() == datapb.StorageMigrationOperateType_StartMigration &&
+			req.GetCollectionName() == DefaultCollectionName && req.GetTarget().GetBucketName() == "west"
+	})).Return(&StatusSuccess, nil).Once()
+	mp3 := mocks.NewMockProxy(t)
+	mp3.EXPECT().OperateStorageMigration(mock.Anything, mock.MatchedBy(func(req *datapb.OperateStorageMigrationRequest) bool {
+		return req.GetOperateType() == datapb.StorageMigrationOperateType_CancelMigration && req.GetTarget() == nil
+	})).Return(&StatusSuccess, nil).Once()
+
+	runAdminTestCases(t, AdminStorageMigratePath, []adminTestCase{
+		{
+			name:         "missing collection name",
+			body:         `{"target": {"bucketName": "west"}}`,
+			expectedBody: PrintErr(merr.ErrMissingRequiredParameters),
+		},
+		{
+			name:         "collection not found",
+			mp:           mp1,
+			body:         `{"collectionName": "book", "target": {"bucketName": "west"}}`,
+			expectedBody: PrintErr(err),
+		},
+		{
+			name:         "start",
+			mp:           mp2,
+			body:         `{"collectionName": "book", "target": {"bucketName": "west", "secretAccessKey": "secret"}}`,
+			expectedBody: "{\"code\":200,\"data\":{}}",
+		},
+		{
+			name:         "cancel",
+			mp:           mp3,
+			body:         `{"collectionName": "book", "cancel": true}`,
+			expectedBody: "{\"code\":200,\"data\":{}}",
+		},
+	})
+}
+
+func TestOperateLoadSchedule(t *testing.T) {
+	paramtable.Init()
+	err := merr.WrapErrParameterInvalidMsg("invalid load spec")
+
+	mp1 := mocks.NewMockProxy(t)
+	mp1.EXPECT().OperateLoadSchedule(mock.Anything, mock.Anything).Return(merr.Status(err), nil).Once()
+	mp2 := mocks.NewMockProxy(t)
+	mp2.EXPECT().OperateLoadSchedule(mock.Anything, mock.MatchedBy(func(req *querypb.OperateLoadScheduleRequest) bool {
+		return req.GetOperateType() == querypb.LoadScheduleOperateType_PutLoadSchedule &&
+			req.GetDbName() == DefaultDbName && req.GetLoadSpec() == "0 9 * * 1-5" && req.GetReplicaNumber() == 2
+	})).Return(&StatusSuccess, nil).Once()
+	mp3 := mocks.NewMockProxy(t)
+	mp3.EXPECT().OperateLoadSchedule(mock.Anything, mock.MatchedBy(func(req *querypb.OperateLoadScheduleRequest) bool {
+		return req.GetOperateType() == querypb.LoadScheduleOperateType_RemoveLoadSchedule && req.GetLoadSpec() == ""
+	})).Return(&StatusSuccess, nil).Once()
+
+	runAdminTestCases(t, AdminLoadSchedulePath, []adminTestCase{
+		{
+			name:         "missing collection name",
+			body:         `{"loadSpec": "0 9 * * 1-5"}`,
+			expectedBody: PrintErr(merr.ErrMissingRequiredParameters),
+		},
+		{
+			name:         "invalid spec",
+			mp:           mp1,
+			body:         `{"collectionName": "book", "loadSpec": "0 9 * *", "releaseSpec": "0 18 * * 1-5"}`,
+			expectedBody: PrintErr(err),
+		},
+		{
+			name:         "put",
+			mp:           mp2,
+			body:         `{"collectionName": "book", "loadSpec": "0 9 * * 1-5", "releaseSpec": "0 18 * * 1-5", "replicaNumber": 2}`,
+			expectedBody: "{\"code\":200,\"data\":{}}",
+		},
+		{
+			name:         "remove",
+			mp:           mp3,
+			body:         `{"collectionName": "book", "remove": true, "loadSpec": "0 9 * * 1-5"}`,
+			expectedBody: "{\"code\":200,\"data\":{}}",
+		},
+	})
+}
+
+func TestUpdateLoadConfig(t *testing.T) {
+	paramtable.Init()
+	err := merr.WrapErrCollectionNotLoaded("book")
+
+	mp1 := mocks.NewMockProxy(t)
+	mp1.EXPECT().UpdateLoadConfig(mock.Anything, mock.Anything).Return(merr.Status(err), nil).Once()
+	mp2 := mocks.NewMockProxy(t)
+	mp2.EXPECT().UpdateLoadConfig(mock.Anything, mock.MatchedBy(func(req *querypb.UpdateLoadConfigRequest) bool {
+		return req.GetDbName() == DefaultDbName && req.GetReplicaNumber() == 2 && !req.GetMmapSpecified()
+	})).Return(&StatusSuccess, nil).Once()
+	mp3 := mocks.NewMockProxy(t)
+	mp3.EXPECT().UpdateLoadConfig(mock.Anything, mock.MatchedBy(func(req *querypb.UpdateLoadConfigRequest) bool {
+		return req.GetMmapSpecified() && !req.GetMmapEnabled()
+	})).Return(&StatusSuccess, nil).Once()
+
+	runAdminTestCases(t, AdminLoadConfigPath, []adminTestCase{
+		{
+			name:         "missing collection name",
+			body:         `{"replicaNumber": 2}`,
+			expectedBody: PrintErr(merr.ErrMissingRequiredParameters),
+		},
+		{
+			name:         "not loaded",
+			mp:           mp1,
+			body:         `{"collectionName": "book", "replicaNumber": 2}`,
+			expectedBody: PrintErr(err),
+		},
+		{
+			name:         "update replica number",
+			mp:           mp2,
+			body:         `{"collectionName": "book", "replicaNumber": 2, "resourceGroups": ["rg1", "rg2"]}`,
+			expectedBody: "{\"code\":200,\"data\":{}}",
+		},
+		{
+			name:         "disable mmap",
+			mp:           mp3,
+			body:         `{"collectionName": "book", "mmapEnabled": false}`,
+			expectedBody: "{\"code\":200,\"data\":{}}",
+		},
+	})
+}
+
+func TestOperateCollectionTrash(t *testing.T) {
+	paramtable.Init()
+	err := merr.WrapErrCollectionNotFound(int64(1))
+
+	mp1 := mocks.NewMockProxy(t)
+	mp1.EXPECT().OperateCollectionTrash(mock.Anything, mock.Anything).Return(merr.Status(err), nil).Once()
+	mp2 := mocks.NewMockProxy(t)
+	mp2.EXPECT().OperateCollectionTrash(mock.Anything, mock.MatchedBy(func(req *rootcoordpb.OperateCollectionTrashRequest) bool {
+		return req.GetCollectionID() == 2 && req.GetOperateType() == rootcoordpb.CollectionTrashOperateType_RestoreCollection
+	})).Return(&StatusSuccess, nil).Once()
+	mp3 := mocks.NewMockProxy(t)
+	mp3.EXPECT().OperateCollectionTrash(mock.Anything, mock.MatchedBy(func(req *rootcoordpb.OperateCollectionTrashRequest) bool {
+		return req.GetCollectionID() == 2 && req.GetOperateType() == rootcoordpb.CollectionTrashOperateType_PurgeCollection
+	})).Return(&StatusSuccess, nil).Once()
+
+	runAdminTestCases(t, AdminCollectionRestorePath, []adminTestCase{
+		{
+			name:         "missing collection id",
+			body:         `{}`,
+			expectedBody: PrintErr(merr.ErrMissingRequiredParameters),
+		},
+		{
+			name:         "not in trash",
+			mp:           mp1,
+			body:         `{"collectionId": 1}`,
+			expectedBody: PrintErr(err),
+		},
+		{
+			name:         "restore",
+			mp:           mp2,
+			body:         `{"collectionId": 2}`,
+			expectedBody: "{\"code\":200,\"data\":{}}",
+		},
+	})
+	runAdminTestCases(t, AdminCollectionPurgePath, []adminTestCase{
+		{
+			name:         "purge",
+			mp:           mp3,
+			body:         `{"collectionId": 2}`,
+			expectedBody: "{\"code\":200,\"data\":{}}",
+		},
+	})
+}
+
+func TestOperateCollectionTemplate(t *testing.T) {
+	paramtable.Init()
+	err := merr.WrapErrParameterInvalidMsg("the schema of collection template should have at least one vector field")
+
+	mp1 := mocks.NewMockProxy(t)
+	mp1.EXPECT().OperateCollectionTemplate(mock.Anything, mock.Anything).Return(merr.Status(err), nil).Once()
+	mp2 := mocks.NewMockProxy(t)
+	mp2.EXPECT().OperateCollectionTemplate(mock.Anything, mock.MatchedBy(func(req *rootcoordpb.OperateCollectionTemplateRequest) bool {
+		schema := &schemapb.CollectionSchema{}
+		if proto.Unmarshal(req.GetTemplate().GetSchema(), schema) != nil {
+			return false
+		}
+		return req.GetOperateType() == rootcoordpb.CollectionTemplateOperateType_CreateCollectionTemplate &&
+			schema.GetAutoID() && schema.GetFields()[1].GetDataType() == schemapb.DataType_FloatVector &&
+			req.GetTemplate().GetConsistencyLevel() == commonpb.ConsistencyLevel_Bounded &&
+			req.GetTemplate().GetIndexPresets()[0].GetIndexType() == "HNSW"
+	})).Return(&StatusSuccess, nil).Once()
+	mp3 := mocks.NewMockProxy(t)
+	mp3.EXPECT().OperateCollectionTemplate(mock.Anything, mock.MatchedBy(func(req *rootcoordpb.OperateCollectionTemplateRequest) bool {
+		return req.GetOperateType() == rootcoordpb.CollectionTemplateOperateType_DropCollectionTemplate &&
+			req.GetTemplateName() == "openai-1536-cosine"
+	})).Return(&StatusSuccess, nil).Once()
+
+	runAdminTestCases(t, AdminCollectionTemplateCreatePath, []adminTestCase{
+		{
+			name:         "missing fields",
+			body:         `{"name": "openai-1536-cosine"}`,
+			expectedBody: PrintErr(merr.ErrMissingRequiredParameters),
+		},
+		{
+			name:         "invalid data type",
+			body:         `{"name": "invalid", "fields": [{"fieldName": "id", "dataType": "Int128"}]}`,
+			expectedBody: PrintErr(merr.WrapErrParameterInvalidMsg("invalid data type Int128 of field id")),
+		},
+		{
+			name:         "invalid template",
+			mp:           mp1,
+			body:         `{"name": "no-vector", "fields": [{"fieldName": "id", "dataType": "Int64", "isPrimary": true}]}`,
+			expectedBody: PrintErr(err),
+		},
+		{
+			name: "create",
+			mp:   mp2,
+			body: `{
+				"name": "openai-1536-cosine",
+				"fields": [
+					{"fieldName": "id", "dataType": "Int64", "isPrimary": true, "autoId": true},
+					{"fieldName": "embedding", "dataType": "FloatVector", "typeParams": {"dim": "1536"}}
+				],
+				"indexes": [{"fieldName": "embedding", "indexType": "HNSW", "metricType": "COSINE", "params": {"M": "16"}}]
+			}`,
+			expectedBody: "{\"code\":200,\"data\":{}}",
+		},
+	})
+	runAdminTestCases(t, AdminCollectionTemplateDropPath, []adminTestCase{
+		{
+			name:         "missing name",
+			body:         `{}`,
+			expectedBody: PrintErr(merr.ErrMissingRequiredParameters),
+		},
+		{
+			name:         "drop",
+			mp:           mp3,
+			body:         `{"name": "openai-1536-cosine"}`,
+			expectedBody: "{\"code\":200,\"data\":{}}",
+		},
+	})
+}
+
+func TestCreateCollectionFromTemplate(t *testing.T) {
+	paramtable.Init()
+	err := merr.WrapErrParameterInvalidMsg("collection template unknown not found")
+
+	mp1 := mocks.NewMockProxy(t)
+	mp1.EXPECT().CreateCollectionFromTemplate(mock.Anything, mock.Anything).Return(merr.Status(err), nil).Once()
+	mp2 := mocks.NewMockProxy(t)
+	mp2.EXPECT().CreateCollectionFromTemplate(mock.Anything, mock.MatchedBy(func(req *rootcoordpb.CreateCollectionFromTemplateRequest) bool {
+		return req.GetDbName() == DefaultDbName && req.GetCollectionName() == "docs" && req.GetTemplateName() == "openai-1536-cosine"
+	})).Return(&StatusSuccess, nil).Once()
+
+	runAdminTestCases(t, AdminCollectionTemplateInstantiatePath, []adminTestCase{
+		{
+			name:         "missing template name",
+			body:         `{"collectionName": "docs"}`,
+			expectedBody: PrintErr(merr.ErrMissingRequiredParameters),
+		},
+		{
+			name:         "template not found",
+			mp:           mp1,
+			body:         `{"collectionName": "docs", "templateName": "unknown"}`,
+			expectedBody: PrintErr(err),
+		},
+		{
+			name:         "create",
+			mp:           mp2,
+			body:         `{"collectionName": "docs", "templateName": "openai-1536-cosine"}`,
+			expectedBody: "{\"code\":200,\"data\":{}}",
+		},
+	})
+}
+
+func TestOperateRoleInheritance(t *testing.T) {
+	paramtable.Init()
+	err := merr.WrapErrParameterInvalidMsg("the role inheritance of reader from public forms a cycle")
+
+	mp1 := mocks.NewMockProxy(t)
+	mp1.EXPECT().OperateRoleInheritance(mock.Anything, mock.Anything).Return(merr.Status(err), nil).Once()
+	mp2 := mocks.NewMockProxy(t)
+	mp2.EXPECT().OperateRoleInheritance(mock.Anything, mock.MatchedBy(func(req *rootcoordpb.OperateRoleInheritanceRequest) bool {
+		return req.GetRoleName() == "reader" && req.GetParentRoleName() == "public" && req.GetType() == milvuspb.OperatePrivilegeType_Grant
+	})).Return(&StatusSuccess, nil).Once()
+	mp3 := mocks.NewMockProxy(t)
+	mp3.EXPECT().OperateRoleInheritance(mock.Anything, mock.MatchedBy(func(req *rootcoordpb.OperateRoleInheritanceRequest) bool {
+		return req.GetRoleName() == "reader" && req.GetParentRoleName() == "public" && req.GetType() == milvuspb.OperatePrivilegeType_Revoke
+	})).Return(&StatusSuccess, nil).Once()
+
+	runAdminTestCases(t, AdminRoleInheritanceGrantPath, []adminTestCase{
+		{
+			name:         "missing parent role name",
+			body:         `{"roleName": "reader"}`,
+			expectedBody: PrintErr(merr.ErrMissingRequiredParameters),
+		},
+		{
+			name:         "cycle",
+			mp:           mp1,
+			body:         `{"roleName": "reader", "parentRoleName": "public"}`,
+			expectedBody: PrintErr(err),
+		},
+		{
+			name:         "grant",
+			mp:           mp2,
+			body:         `{"roleName": "reader", "parentRoleName": "public"}`,
+			expectedBody: "{\"code\":200,\"data\":{}}",
+		},
+	})
+	runAdminTestCases(t, AdminRoleInheritanceRevokePath, []adminTestCase{
+		{
+			name:         "revoke",
+			mp:           mp3,
+			body:         `{"roleName": "reader", "parentRoleName": "public"}`,
+			expectedBody: "{\"code\":200,\"data\":{}}",
+		},
+	})
+}
+
+func TestListRoleInheritances(t *testing.T) {
+	paramtable.Init()
+
+	mp1 := mocks.NewMockProxy(t)
+	mp1.EXPECT().ListRoleInheritances(mock.Anything, mock.Anything).Return(nil, ErrDefault).Once()
+	mp2 := mocks.NewMockProxy(t)
+	mp2.EXPECT().ListRoleInheritances(mock.Anything, mock.Anything).Return(&rootcoordpb.ListRoleInheritancesResponse{
+		Status: &StatusSuccess,
+		Inheritances: []*rootcoordpb.RoleInheritance{
+			{RoleName: "reader", ParentRoleNames: []string{"public"}},
+		},
+	}, nil).Once()
+
+	runAdminRequestTestCases(t, http.MethodGet, AdminRoleInheritanceListPath, []adminTestCase{
+		{
+			name:         "list role inheritances fail",
+			mp:           mp1,
+			expectedBody: PrintErr(ErrDefault),
+		},
+		{
+			name:         "ok",
+			mp:           mp2,
+			expectedBody: "{\"code\":200,\"data\":[{\"parentRoleNames\":[\"public\"],\"roleName\":\"reader\"}]}",
+		},
+	})
+}
+
+func TestOperateAPIKey(t *testing.T) {
+	paramtable.Init()
+	err := merr.WrapErrParameterInvalidMsg("api key 1 not found")
+	key := &rootcoordpb.APIKeyInfo{Id: "1", Username: "foo", Scopes: []string{"read"}, CreatedTime: 100}
+
+	mp1 := mocks.NewMockProxy(t)
+	mp1.EXPECT().OperateAPIKey(mock.Anything, mock.Anything).Return(nil, ErrDefault).Once()
+	mp2 := mocks.NewMockProxy(t)
+	mp2.EXPECT().OperateAPIKey(mock.Anything, mock.MatchedBy(func(req *rootcoordpb.OperateAPIKeyRequest) bool {
+		return req.GetOperateType() == rootcoordpb.APIKeyOperateType_CreateAPIKey && req.GetUsername() == "foo" &&
+			req.GetScopes()[0] == "read" && req.GetTtlSeconds() == 3600
+	})).Return(&rootcoordpb.OperateAPIKeyResponse{Status: &StatusSuccess, Key: key, Token: "1.secret"}, nil).Once()
+	mp3 := mocks.NewMockProxy(t)
+	mp3.EXPECT().OperateAPIKey(mock.Anything, mock.Anything).Return(&rootcoordpb.OperateAPIKeyResponse{Status: merr.Status(err)}, nil).Once()
+	mp4 := mocks.NewMockProxy(t)
+	mp4.EXPECT().OperateAPIKey(mock.Anything, mock.MatchedBy(func(req *rootcoordpb.OperateAPIKeyRequest) bool {
+		return req.GetOperateType() == rootcoordpb.APIKeyOperateType_RevokeAPIKey && req.GetId() == "1"
+	})).Return(&rootcoordpb.OperateAPIKeyResponse{Status: &StatusSuccess}, nil).Once()
+
+	runAdminTestCases(t, AdminAPIKeyCreatePath, []adminTestCase{
+		{
+			name:         "missing username",
+			body:         `{"scopes": ["read"]}`,
+			expectedBody: PrintErr(merr.ErrMissingRequiredParameters),
+		},
+		{
+			name:         "create api key fail",
+			mp:           mp1,
+			body:         `{"username": "foo", "scopes": ["read"]}`,
+			expectedBody: PrintErr(ErrDefault),
+		},
+		{
+			name:         "create",
+			mp:           mp2,
+			body:         `{"username": "foo", "scopes": ["read"], "ttlSeconds": 3600}`,
+			expectedBody: "{\"code\":200,\"data\":{\"createdTime\":100,\"expireTime\":0,\"id\":\"1\",\"key\":\"1.secret\",\"rotatedTime\":0,\"scopes\":[\"read\"],\"username\":\"foo\"}}",
+		},
+	})
+	runAdminTestCases(t, AdminAPIKeyRotatePath, []adminTestCase{
+		{
+			name:         "missing id",
+			body:         `{}`,
+			expectedBody: PrintErr(merr.ErrMissingRequiredParameters),
+		},
+		{
+			name:         "not found",
+			mp:           mp3,
+			body:         `{"id": "1"}`,
+			expectedBody: PrintErr(err),
+		},
+	})
+	runAdminTestCases(t, AdminAPIKeyRevokePath, []adminTestCase{
+		{
+			name:         "revoke",
+			mp:           mp4,
+			body:         `{"id": "1"}`,
+			expectedBody: "{\"code\":200,\"data\":{}}",
+		},
+	})
+}
+
+func TestListAPIKeys(t *testing.T) {
+	paramtable.Init()
+
+	mp1 := mocks.NewMockProxy(t)
+	mp1.EXPECT().ListAPIKeys(mock.Anything, mock.Anything).Return(nil, ErrDefault).Once()
+	mp2 := mocks.NewMockProxy(t)
+	mp2.EXPECT().ListAPIKeys(mock.Anything, mock.MatchedBy(func(req *rootcoordpb.ListAPIKeysRequest) bool {
+		return req.GetUsername() == "foo"
+	})).Return(&rootcoordpb.ListAPIKeysResponse{
+		Status: &StatusSuccess,
+		Keys:   []*rootcoordpb.APIKeyInfo{{Id: "1", Username: "foo", Scopes: []string{"read"}, CreatedTime: 100}},
+	}, nil).Once()
+
+	runAdminRequestTestCases(t, http.MethodGet, AdminAPIKeyListPath+"?username=foo", []adminTestCase{
+		{
+			name:         "list api keys fail",
+			mp:           mp1,
+			expectedBody: PrintErr(ErrDefault),
+		},
+		{
+			name:         "ok",
+			mp:           mp2,
+			expectedBody: "{\"code\":200,\"data\":[{\"createdTime\":100,\"expireTime\":0,\"id\":\"1\",\"rotatedTime\":0,\"scopes\":[\"read\"],\"username\":\"foo\"}]}",
+		},
+	})
+}
+
+func TestKillSession(t *testing.T) {
+	paramtable.Init()
+	err := merr.WrapErrParameterInvalidMsg("session 1 not found")
+
+	mp1 := mocks.NewMockProxy(t)
+	mp1.EXPECT().KillSession(mock.Anything, mock.Anything).Return(merr.Status(err), nil).Once()
+	mp2 := mocks.NewMockProxy(t)
+	mp2.EXPECT().KillSession(mock.Anything, mock.MatchedBy(func(req *proxypb.KillSessionRequest) bool {
+		return req.GetIdentifier() == 445566778899
+	})).Return(&StatusSuccess, nil).Once()
+
+	runAdminTestCases(t, AdminSessionKillPath, []adminTestCase{
+		{
+			name:         "missing identifier",
+			body:         `{}`,
+			expectedBody: PrintErr(merr.ErrMissingRequiredParameters),
+		},
+		{
+			name:         "not found",
+			mp:           mp1,
+			body:         `{"identifier": 1}`,
+			expectedBody: PrintErr(err),
+		},
+		{
+			name:         "kill",
+			mp:           mp2,
+			body:         `{"identifier": 445566778899}`,
+			expectedBody: "{\"code\":200,\"data\":{}}",
+		},
+	})
+}
+
+func TestListSessions(t *testing.T) {
+	paramtable.Init()
+
+	mp1 := mocks.NewMockProxy(t)
+	mp1.EXPECT().ListSessions(mock.Anything, mock.Anything).Return(nil, ErrDefault).Once()
+	mp2 := mocks.NewMockProxy(t)
+	mp2.EXPECT().ListSessions(mock.Anything, mock.MatchedBy(func(req *proxypb.ListSessionsRequest) bool {
+		return req.GetUser() == "foo"
+	})).Return(&proxypb.ListSessionsResponse{
+		Status:   &StatusSuccess,
+		Sessions: []*proxypb.SessionInfo{{Identifier: 1, User: "foo", SdkType: "python", LastActiveTime: 100, IdleSeconds: 5}},
+	}, nil).Once()
+
+	runAdminRequestTestCases(t, http.MethodGet, AdminSessionListPath+"?user=foo", []adminTestCase{
+		{
+			name:         "list sessions fail",
+			mp:           mp1,
+			expectedBody: PrintErr(ErrDefault),
+		},
+		{
+			name:         "ok",
+			mp:           mp2,
+			expectedBody: "{\"code\":200,\"data\":[{\"host\":\"\",\"identifier\":1,\"idleSeconds\":5,\"lastActiveTime\":100,\"sdkType\":\"python\",\"sdkVersion\":\"\",\"user\":\"foo\"}]}",
+		},
+	})
+}
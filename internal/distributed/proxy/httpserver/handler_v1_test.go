@@ -2,6 +2,7 @@ package httpserver
 
 import (
 	"bytes"
+	"context"
 	"encoding/json"
 	"fmt"
 	"net/http"
@@ -20,6 +21,7 @@ import (
 	"github.com/milvus-io/milvus/internal/proxy"
 	"github.com/milvus-io/milvus/internal/types"
 	"github.com/milvus-io/milvus/pkg/util"
+	"github.com/milvus-io/milvus/pkg/util/funcutil"
 	"github.com/milvus-io/milvus/pkg/util/merr"
 	"github.com/milvus-io/milvus/pkg/util/paramtable"
 )
@@ -1611,3 +1613,107 @@ func Test_Handles_VectorCollectionsDescribe(t *testing.T) {
 		assert.Equal(t, w.Body.String(), "{\"code\":200,\"data\":{\"collectionName\":\"\",\"description\":\"\",\"enableDynamic\":false,\"fields\":[{\"autoId\":false,\"description\":\"RowID field\",\"name\":\"RowID\",\"primaryKey\":false,\"type\":\"Int64\"},{\"autoId\":false,\"description\":\"Timestamp field\",\"name\":\"Timestamp\",\"primaryKey\":false,\"type\":\"Int64\"},{\"autoId\":false,\"description\":\"field 100\",\"name\":\"float_vector_field\",\"primaryKey\":false,\"type\":\"FloatVector(2)\"},{\"autoId\":false,\"description\":\"field 106\",\"name\":\"int64_field\",\"primaryKey\":true,\"type\":\"Int64\"}],\"indexes\":[],\"load\":\"LoadStateLoaded\",\"shardsNum\":0}}")
 	})
 }
+
+func TestHybridSearch(t *testing.T) {
+	paramtable.Init()
+	mp := mocks.NewMockProxy(t)
+	mp.EXPECT().Search(mock.Anything, mock.Anything).Return(&milvuspb.SearchResults{
+		Status: &StatusSuccess,
+		Results: &schemapb.SearchResultData{
+			NumQueries: 1,
+			TopK:       3,
+			Topks:      []int64{3},
+			Ids:        generateIds(3),
+			FieldsData: generateFieldData(),
+			Scores:     []float32{0.01, 0.04, 0.09},
+		},
+	}, nil).Times(2)
+	testEngine := initHTTPServer(mp, true)
+
+	search := func(rerank map[string]interface{}) map[string]interface{} {
+		data, _ := json.Marshal(map[string]interface{}{
+			HTTPCollectionName: DefaultCollectionName,
+			"search": []map[string]interface{}{
+				{"vector": []float32{0.0, 0.0}, "annsField": "book_intro"},
+				{"vector": []float32{0.1, 0.1}, "annsField": "book_intro", "metricType": "L2"},
+			},
+			"rerank": rerank,
+		})
+		req := httptest.NewRequest(http.MethodPost, versional(VectorHybridSearchPath), bytes.NewReader(data))
+		req.SetBasicAuth(util.UserRoot, util.DefaultRootPassword)
+		w := httptest.NewRecorder()
+		testEngine.ServeHTTP(w, req)
+		assert.Equal(t, http.StatusOK, w.Code)
+		resp := map[string]interface{}{}
+		assert.NoError(t, json.Unmarshal(w.Body.Bytes(), &resp))
+		return resp
+	}
+
+	resp := search(map[string]interface{}{"strategy": "weighted", "params": map[string]interface{}{"weights": []float64{0.5}}})
+	assert.EqualValues(t, merr.Code(merr.ErrParameterInvalid), resp[HTTPReturnCode])
+
+	resp = search(map[string]interface{}{"strategy": "rrf", "params": map[string]interface{}{"k": 10}})
+	assert.EqualValues(t, http.StatusOK, resp[HTTPReturnCode])
+	data := resp[HTTPReturnData].([]interface{})
+	assert.Len(t, data, 3)
+	for i, row := range data {
+		assert.EqualValues(t, i+1, row.(map[string]interface{})[FieldBookID])
+	}
+}
+
+func TestSQL(t *testing.T) {
+	paramtable.Init()
+	paramtable.Get().Save(proxy.Params.HTTPCfg.SQLEnabled.Key, "true")
+	defer paramtable.Get().Reset(proxy.Params.HTTPCfg.SQLEnabled.Key)
+
+	mp := mocks.NewMockProxy(t)
+	mp.EXPECT().Query(mock.Anything, mock.Anything).RunAndReturn(func(ctx context.Context, req *milvuspb.QueryRequest) (*milvuspb.QueryResults, error) {
+		assert.Equal(t, "word_count >= 1000", req.GetExpr())
+		assert.Equal(t, []string{FieldBookID, FieldWordCount, FieldBookIntro}, req.GetOutputFields())
+		return &milvuspb.QueryResults{
+			Status:         &StatusSuccess,
+			FieldsData:     generateFieldData(),
+			CollectionName: DefaultCollectionName,
+			OutputFields:   []string{FieldBookID, FieldWordCount, FieldBookIntro},
+		}, nil
+	}).Once()
+	mp.EXPECT().Search(mock.Anything, mock.Anything).RunAndReturn(func(ctx context.Context, req *milvuspb.SearchRequest) (*milvuspb.SearchResults, error) {
+		assert.Equal(t, "word_count >= 1000", req.GetDsl())
+		annsField, err := funcutil.GetAttrByKeyFromRepeatedKV(ParamAnnsField, req.GetSearchParams())
+		assert.NoError(t, err)
+		assert.Equal(t, FieldBookIntro, annsField)
+		return &milvuspb.SearchResults{
+			Status: &StatusSuccess,
+			Results: &schemapb.SearchResultData{
+				TopK:       3,
+				Ids:        generateIds(3),
+				FieldsData: generateFieldData(),
+				Scores:     []float32{0.01, 0.04, 0.09},
+			},
+		}, nil
+	}).Once()
+	testEngine := initHTTPServer(mp, true)
+
+	sql := func(statement string, params map[string]interface{}) map[string]interface{} {
+		data, _ := json.Marshal(map[string]interface{}{"sql": statement, "params": params})
+		req := httptest.NewRequest(http.MethodPost, versional(VectorSQLPath), bytes.NewReader(data))
+		req.SetBasicAuth(util.UserRoot, util.DefaultRootPassword)
+		w := httptest.NewRecorder()
+		testEngine.ServeHTTP(w, req)
+		assert.Equal(t, http.StatusOK, w.Code)
+		resp := map[string]interface{}{}
+		assert.NoError(t, json.Unmarshal(w.Body.Bytes(), &resp))
+		return resp
+	}
+
+	resp := sql("SELECT book_id, word_count, book_intro FROM book WHERE word_count >= :min", map[string]interface{}{"min": 1000})
+	assert.EqualValues(t, http.StatusOK, resp[HTTPReturnCode])
+	assert.Len(t, resp[HTTPReturnData], 3)
+
+	resp = sql("SELECT * FROM book WHERE word_count >= 1000 ORDER BY distance(book_intro, :v) LIMIT 3", map[string]interface{}{"v": []float32{0.1, 0.2}})
+	assert.EqualValues(t, http.StatusOK, resp[HTTPReturnCode])
+	assert.Len(t, resp[HTTPReturnData], 3)
+
+	resp = sql("SELECT * FROM book ORDER BY distance(book_intro, :v) DESC", map[string]interface{}{"v": []float32{0.1, 0.2}})
+	assert.EqualValues(t, merr.Code(merr.ErrParameterInvalid), resp[HTTPReturnCode])
+}
@@ -71,3 +71,154 @@ type SearchReq struct {
 	OutputFields   []string  `json:"outputFields"`
 	Vector         []float32 `json:"vector"`
 }
+
+// AnnSearchReq is an ann search request of the hybrid search.
+type AnnSearchReq struct {
+	AnnsField  string                 `json:"annsField"`
+	Vector     []float32              `json:"vector"`
+	Filter     string                 `json:"filter"`
+	Limit      int32                  `json:"limit"`
+	MetricType string                 `json:"metricType"`
+	Params     map[string]interface{} `json:"params"`
+}
+
+// RerankReq declares the ranker fusing the results of the ann search requests.
+type RerankReq struct {
+	Strategy string                 `json:"strategy"`
+	Params   map[string]interface{} `json:"params"`
+}
+
+type HybridSearchReq struct {
+	DbName         string         `json:"dbName"`
+	CollectionName string         `json:"collectionName" validate:"required"`
+	Search         []AnnSearchReq `json:"search"`
+	Rerank         RerankReq      `json:"rerank"`
+	Limit          int32          `json:"limit"`
+	OutputFields   []string       `json:"outputFields"`
+}
+
+// SQLReq is a statement of the restricted sql dialect, the named parameters like :name in the sql are bound to the params.
+type SQLReq struct {
+	DbName string                 `json:"dbName"`
+	SQL    string                 `json:"sql" validate:"required"`
+	Params map[string]interface{} `json:"params"`
+}
+
+type CancelDDLTaskReq struct {
+	TaskID int64 `json:"taskId" validate:"required"`
+}
+
+// CollectionTrashReq restores or purges the dropped collection kept in the trash, which is listed by the management port.
+type CollectionTrashReq struct {
+	CollectionID int64 `json:"collectionId" validate:"required"`
+}
+
+// CollectionTemplateFieldReq is the field schema of the collection template, the data type is in the name like "FloatVector".
+type CollectionTemplateFieldReq struct {
+	FieldName      string            `json:"fieldName" validate:"required"`
+	Description    string            `json:"description"`
+	DataType       string            `json:"dataType" validate:"required"`
+	IsPrimary      bool              `json:"isPrimary"`
+	AutoID         bool              `json:"autoId"`
+	IsPartitionKey bool              `json:"isPartitionKey"`
+	TypeParams     map[string]string `json:"typeParams"`
+}
+
+// CollectionTemplateIndexReq is the index built on the vector field of the collections created from the template.
+type CollectionTemplateIndexReq struct {
+	FieldName  string            `json:"fieldName" validate:"required"`
+	IndexType  string            `json:"indexType" validate:"required"`
+	MetricType string            `json:"metricType" validate:"required"`
+	Params     map[string]string `json:"params"`
+}
+
+type CreateCollectionTemplateReq struct {
+	Name               string                        `json:"name" validate:"required"`
+	Description        string                        `json:"description"`
+	Fields             []*CollectionTemplateFieldReq `json:"fields" validate:"required"`
+	EnableDynamicField bool                          `json:"enableDynamicField"`
+	ShardsNum          int32                         `json:"shardsNum"`
+	ConsistencyLevel   string                        `json:"consistencyLevel"`
+	Properties         map[string]string             `json:"properties"`
+	Indexes            []*CollectionTemplateIndexReq `json:"indexes"`
+}
+
+type DropCollectionTemplateReq struct {
+	Name string `json:"name" validate:"required"`
+}
+
+type CreateCollectionFromTemplateReq struct {
+	DbName         string `json:"dbName"`
+	CollectionName string `json:"collectionName" validate:"required"`
+	TemplateName   string `json:"templateName" validate:"required"`
+}
+
+// RoleInheritanceReq grants or revokes the parent role to the role, the role inherits the privileges of the parent role.
+type RoleInheritanceReq struct {
+	RoleName       string `json:"roleName" validate:"required"`
+	ParentRoleName string `json:"parentRoleName" validate:"required"`
+}
+
+// CreateAPIKeyReq creates the api key of the user, the key never expires if the ttl is zero.
+type CreateAPIKeyReq struct {
+	Username   string   `json:"username" validate:"required"`
+	Scopes     []string `json:"scopes"`
+	TTLSeconds int64    `json:"ttlSeconds"`
+}
+
+// APIKeyReq rotates or revokes the api key.
+type APIKeyReq struct {
+	ID string `json:"id" validate:"required"`
+}
+
+// KillSessionReq terminates the session established by Connect in the proxy serving the request.
+type KillSessionReq struct {
+	Identifier int64 `json:"identifier" validate:"required"`
+}
+
+type CancelCompactionPlanReq struct {
+	PlanID int64 `json:"planId" validate:"required"`
+}
+
+type StorageMigrationTargetReq struct {
+	StorageType     string `json:"storageType"`
+	Address         string `json:"address"`
+	BucketName      string `json:"bucketName"`
+	RootPath        string `json:"rootPath"`
+	AccessKeyID     string `json:"accessKeyId"`
+	SecretAccessKey string `json:"secretAccessKey"`
+	UseSSL          bool   `json:"useSsl"`
+	UseIAM          bool   `json:"useIam"`
+	CloudProvider   string `json:"cloudProvider"`
+	IAMEndpoint     string `json:"iamEndpoint"`
+	Region          string `json:"region"`
+}
+
+// StorageMigrationReq starts the storage migration of the collection to the target, or cancels it if cancel is true.
+type StorageMigrationReq struct {
+	DbName         string                    `json:"dbName"`
+	CollectionName string                    `json:"collectionName" validate:"required"`
+	Cancel         bool                      `json:"cancel"`
+	Target         StorageMigrationTargetReq `json:"target"`
+}
+
+// LoadScheduleReq puts the schedule loading and releasing the collection by the cron specs, or removes it if remove is true.
+type LoadScheduleReq struct {
+	DbName         string   `json:"dbName"`
+	CollectionName string   `json:"collectionName" validate:"required"`
+	Remove         bool     `json:"remove"`
+	LoadSpec       string   `json:"loadSpec"`
+	ReleaseSpec    string   `json:"releaseSpec"`
+	Timezone       string   `json:"timezone"`
+	ReplicaNumber  int32    `json:"replicaNumber"`
+	ResourceGroups []string `json:"resourceGroups"`
+}
+
+// UpdateLoadConfigReq updates the load config of the loaded collection, the unspecified fields are kept unchanged.
+type UpdateLoadConfigReq struct {
+	DbName         string   `json:"dbName"`
+	CollectionName string   `json:"collectionName" validate:"required"`
+	ReplicaNumber  int32    `json:"replicaNumber"`
+	ResourceGroups []string `json:"resourceGroups"`
+	MmapEnabled    *bool    `json:"mmapEnabled"`
+}
@@ -0,0 +1,453 @@
+package httpserver
+
+import (
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"strings"
+	"unicode"
+
+	"github.com/milvus-io/milvus/pkg/util/merr"
+)
+
+// sqlStatement is a parsed statement of the restricted sql dialect:
+//
+//	SELECT fields FROM collection [WHERE expr] [ORDER BY distance(vector_field, :param)] [LIMIT k [OFFSET n]]
+//
+// the statement ordered by distance is translated to a search, otherwise a query.
+type sqlStatement struct {
+	OutputFields   []string
+	CollectionName string
+	Filter         string
+	AnnsField      string
+	Vector         []float32
+	Limit          int64
+	Offset         int64
+}
+
+type sqlTokenKind int
+
+const (
+	sqlTokenIdent sqlTokenKind = iota
+	sqlTokenNumber
+	sqlTokenString
+	sqlTokenParam
+	sqlTokenSymbol
+)
+
+type sqlToken struct {
+	kind  sqlTokenKind
+	value string
+}
+
+func (t sqlToken) isKeyword(keyword string) bool {
+	return t.kind == sqlTokenIdent && strings.EqualFold(t.value, keyword)
+}
+
+func (t sqlToken) isSymbol(symbol string) bool {
+	return t.kind == sqlTokenSymbol && t.value == symbol
+}
+
+// sqlTwoCharSymbols are the operators of two characters, which are matched before the single character ones.
+var sqlTwoCharSymbols = []string{"==", "!=", "<>", "<=", ">=", "&&", "||", "**"}
+
+func tokenizeSQL(sql string) ([]sqlToken, error) {
+	tokens := make([]sqlToken, 0)
+	runes := []rune(sql)
+	for i := 0; i < len(runes); {
+		r := runes[i]
+		switch {
+		case unicode.IsSpace(r):
+			i++
+		case r == '\'' || r == '"':
+			var sb strings.Builder
+			j := i + 1
+			for ; j < len(runes); j++ {
+				if runes[j] == r {
+					// the quote is escaped by doubling it as sql does
+					if j+1 < len(runes) && runes[j+1] == r {
+						sb.WriteRune(r)
+						j++
+						continue
+					}
+					break
+				}
+				sb.WriteRune(runes[j])
+			}
+			if j >= len(runes) {
+				return nil, merr.WrapErrParameterInvalidMsg("unterminated string literal at position %d", i)
+			}
+			tokens = append(tokens, sqlToken{kind: sqlTokenString, value: sb.String()})
+			i = j + 1
+		case r == ':':
+			j := i + 1
+			for j < len(runes) && (unicode.IsLetter(runes[j]) || unicode.IsDigit(runes[j]) || runes[j] == '_') {
+				j++
+			}
+			if j == i+1 {
+				return nil, merr.WrapErrParameterInvalidMsg("empty parameter name at position %d", i)
+			}
+			tokens = append(tokens, sqlToken{kind: sqlTokenParam, value: string(runes[i+1 : j])})
+			i = j
+		case unicode.IsLetter(r) || r == '_' || r == '$':
+			j := i + 1
+			for j < len(runes) && (unicode.IsLetter(runes[j]) || unicode.IsDigit(runes[j]) || runes[j] == '_') {
+				j++
+			}
+			tokens = append(tokens, sqlToken{kind: sqlTokenIdent, value: string(runes[i:j])})
+			i = j
+		case unicode.IsDigit(r) || (r == '.' && i+1 < len(runes) && unicode.IsDigit(runes[i+1])):
+			j := i + 1
+			for j < len(runes) && (unicode.IsDigit(runes[j]) || runes[j] == '.' || runes[j] == 'e' || runes[j] == 'E' ||
+				((runes[j] == '-' || runes[j] == '+') && (runes[j-1] == 'e' || runes[j-1] == 'E'))) {
+				j++
+			}
+			tokens = append(tokens, sqlToken{kind: sqlTokenNumber, value: string(runes[i:j])})
+			i = j
+		default:
+			matched := false
+			if i+1 < len(runes) {
+				for _, symbol := range sqlTwoCharSymbols {
+					if string(runes[i:i+2]) == symbol {
+						tokens = append(tokens, sqlToken{kind: sqlTokenSymbol, value: symbol})
+						i += 2
+						matched = true
+						break
+					}
+				}
+			}
+			if matched {
+				continue
+			}
+			if !strings.ContainsRune("=<>!+-*/%(),[];", r) {
+				return nil, merr.WrapErrParameterInvalidMsg("unexpected character %q at position %d", r, i)
+			}
+			tokens = append(tokens, sqlToken{kind: sqlTokenSymbol, value: string(r)})
+			i++
+		}
+	}
+	return tokens, nil
+}
+
+type sqlParser struct {
+	tokens []sqlToken
+	pos    int
+	params map[string]interface{}
+}
+
+func (p *sqlParser) peek() (sqlToken, bool) {
+	if p.pos >= len(p.tokens) {
+		return sqlToken{}, false
+	}
+	return p.tokens[p.pos], true
+}
+
+func (p *sqlParser) peekKeyword(keyword string) bool {
+	token, ok := p.peek()
+	return ok && token.isKeyword(keyword)
+}
+
+func (p *sqlParser) expectKeyword(keyword string) error {
+	if !p.peekKeyword(keyword) {
+		return p.unexpected(keyword)
+	}
+	p.pos++
+	return nil
+}
+
+func (p *sqlParser) expectSymbol(symbol string) error {
+	token, ok := p.peek()
+	if !ok || !token.isSymbol(symbol) {
+		return p.unexpected(symbol)
+	}
+	p.pos++
+	return nil
+}
+
+func (p *sqlParser) expectIdent() (string, error) {
+	token, ok := p.peek()
+	if !ok || token.kind != sqlTokenIdent {
+		return "", p.unexpected("identifier")
+	}
+	p.pos++
+	return token.value, nil
+}
+
+func (p *sqlParser) expectInt() (int64, error) {
+	token, ok := p.peek()
+	if !ok {
+		return 0, p.unexpected("integer")
+	}
+	var text string
+	switch token.kind {
+	case sqlTokenNumber:
+		text = token.value
+	case sqlTokenParam:
+		value, err := p.param(token.value)
+		if err != nil {
+			return 0, err
+		}
+		text = fmt.Sprint(value)
+	default:
+		return 0, p.unexpected("integer")
+	}
+	n, err := strconv.ParseInt(text, 10, 64)
+	if err != nil || n < 0 {
+		return 0, merr.WrapErrParameterInvalidMsg("expect non-negative integer but got %s", text)
+	}
+	p.pos++
+	return n, nil
+}
+
+func (p *sqlParser) unexpected(expect string) error {
+	token, ok := p.peek()
+	if !ok {
+		return merr.WrapErrParameterInvalidMsg("expect %s but reach the end of sql", expect)
+	}
+	return merr.WrapErrParameterInvalidMsg("expect %s but got %q", expect, token.value)
+}
+
+func (p *sqlParser) param(name string) (interface{}, error) {
+	value, ok := p.params[name]
+	if !ok {
+		return nil, merr.WrapErrParameterInvalidMsg("parameter %s is not bound", name)
+	}
+	return value, nil
+}
+
+// parseSQL parses the sql into statement, the named parameters like :name are bound to the given params.
+func parseSQL(sql string, params map[string]interface{}) (*sqlStatement, error) {
+	tokens, err := tokenizeSQL(sql)
+	if err != nil {
+		return nil, err
+	}
+	// the trailing semicolon is optional
+	if len(tokens) > 0 && tokens[len(tokens)-1].isSymbol(";") {
+		tokens = tokens[:len(tokens)-1]
+	}
+	p := &sqlParser{tokens: tokens, params: params}
+	stmt := &sqlStatement{Limit: 100}
+
+	if err := p.expectKeyword("SELECT"); err != nil {
+		return nil, err
+	}
+	if stmt.OutputFields, err = p.parseSelectList(); err != nil {
+		return nil, err
+	}
+	if err := p.expectKeyword("FROM"); err != nil {
+		return nil, err
+	}
+	if stmt.CollectionName, err = p.expectIdent(); err != nil {
+		return nil, err
+	}
+	if p.peekKeyword("WHERE") {
+		p.pos++
+		if stmt.Filter, err = p.parseFilter(); err != nil {
+			return nil, err
+		}
+	}
+	if p.peekKeyword("ORDER") {
+		p.pos++
+		if err := p.parseOrderBy(stmt); err != nil {
+			return nil, err
+		}
+	}
+	if p.peekKeyword("LIMIT") {
+		p.pos++
+		if stmt.Limit, err = p.expectInt(); err != nil {
+			return nil, err
+		}
+		if p.peekKeyword("OFFSET") {
+			p.pos++
+			if stmt.Offset, err = p.expectInt(); err != nil {
+				return nil, err
+			}
+		}
+	}
+	if _, ok := p.peek(); ok {
+		return nil, p.unexpected("end of sql")
+	}
+	return stmt, nil
+}
+
+func (p *sqlParser) parseSelectList() ([]string, error) {
+	fields := make([]string, 0)
+	for {
+		var sb strings.Builder
+		depth := 0
+		for {
+			token, ok := p.peek()
+			if !ok || (depth == 0 && (token.isSymbol(",") || token.isKeyword("FROM"))) {
+				break
+			}
+			if token.kind != sqlTokenIdent && token.kind != sqlTokenSymbol {
+				return nil, p.unexpected("field")
+			}
+			switch {
+			case token.isSymbol("("):
+				depth++
+			case token.isSymbol(")"):
+				depth--
+			}
+			sb.WriteString(token.value)
+			p.pos++
+		}
+		if sb.Len() == 0 {
+			return nil, p.unexpected("field")
+		}
+		fields = append(fields, sb.String())
+		if token, ok := p.peek(); !ok || !token.isSymbol(",") {
+			return fields, nil
+		}
+		p.pos++
+	}
+}
+
+// parseFilter translates the sql condition to the boolean expression of milvus.
+func (p *sqlParser) parseFilter() (string, error) {
+	parts := make([]string, 0)
+	// the positions of parentheses opening the lists of IN, which are translated to brackets
+	listDepths := make([]int, 0)
+	depth := 0
+	for {
+		token, ok := p.peek()
+		if !ok || (depth == 0 && (token.isKeyword("ORDER") || token.isKeyword("LIMIT"))) {
+			break
+		}
+		p.pos++
+		switch token.kind {
+		case sqlTokenString:
+			parts = append(parts, strconv.Quote(token.value))
+		case sqlTokenNumber:
+			parts = append(parts, token.value)
+		case sqlTokenParam:
+			value, err := p.param(token.value)
+			if err != nil {
+				return "", err
+			}
+			literal, err := sqlLiteral(value)
+			if err != nil {
+				return "", err
+			}
+			parts = append(parts, literal)
+		case sqlTokenIdent:
+			switch strings.ToUpper(token.value) {
+			case "AND", "OR", "NOT", "IN", "LIKE":
+				parts = append(parts, strings.ToLower(token.value))
+				if next, ok := p.peek(); ok && token.isKeyword("IN") && next.isSymbol("(") {
+					p.pos++
+					depth++
+					listDepths = append(listDepths, depth)
+					parts = append(parts, "[")
+				}
+			case "TRUE", "FALSE":
+				parts = append(parts, strings.ToLower(token.value))
+			default:
+				parts = append(parts, token.value)
+			}
+		case sqlTokenSymbol:
+			switch token.value {
+			case "=":
+				parts = append(parts, "==")
+			case "<>":
+				parts = append(parts, "!=")
+			case "(":
+				depth++
+				parts = append(parts, token.value)
+			case ")":
+				if len(listDepths) > 0 && listDepths[len(listDepths)-1] == depth {
+					listDepths = listDepths[:len(listDepths)-1]
+					parts = append(parts, "]")
+				} else {
+					parts = append(parts, token.value)
+				}
+				depth--
+			default:
+				parts = append(parts, token.value)
+			}
+		}
+	}
+	if len(parts) == 0 {
+		return "", p.unexpected("condition")
+	}
+	if depth != 0 {
+		return "", merr.WrapErrParameterInvalidMsg("unbalanced parentheses in condition")
+	}
+	return strings.Join(parts, " "), nil
+}
+
+func (p *sqlParser) parseOrderBy(stmt *sqlStatement) error {
+	if err := p.expectKeyword("BY"); err != nil {
+		return err
+	}
+	if err := p.expectKeyword("DISTANCE"); err != nil {
+		return err
+	}
+	if err := p.expectSymbol("("); err != nil {
+		return err
+	}
+	annsField, err := p.expectIdent()
+	if err != nil {
+		return err
+	}
+	if err := p.expectSymbol(","); err != nil {
+		return err
+	}
+	token, ok := p.peek()
+	if !ok || token.kind != sqlTokenParam {
+		return p.unexpected("vector parameter")
+	}
+	p.pos++
+	value, err := p.param(token.value)
+	if err != nil {
+		return err
+	}
+	vector, err := sqlVector(value)
+	if err != nil {
+		return err
+	}
+	if err := p.expectSymbol(")"); err != nil {
+		return err
+	}
+	if p.peekKeyword("DESC") {
+		return merr.WrapErrParameterInvalidMsg("only the ascending order of distance is supported")
+	}
+	if p.peekKeyword("ASC") {
+		p.pos++
+	}
+	stmt.AnnsField = annsField
+	stmt.Vector = vector
+	return nil
+}
+
+// sqlLiteral formats the parameter bound as the literal of milvus expression.
+func sqlLiteral(value interface{}) (string, error) {
+	switch v := value.(type) {
+	case string:
+		return strconv.Quote(v), nil
+	case nil:
+		return "", merr.WrapErrParameterInvalidMsg("null parameter is not supported")
+	default:
+		bs, err := json.Marshal(v)
+		if err != nil {
+			return "", merr.WrapErrParameterInvalidMsg("invalid parameter %v: %s", v, err.Error())
+		}
+		return string(bs), nil
+	}
+}
+
+func sqlVector(value interface{}) ([]float32, error) {
+	values, ok := value.([]interface{})
+	if !ok || len(values) == 0 {
+		return nil, merr.WrapErrParameterInvalidMsg("the vector parameter should be a non-empty array of numbers")
+	}
+	vector := make([]float32, 0, len(values))
+	for _, v := range values {
+		f, ok := v.(float64)
+		if !ok {
+			return nil, merr.WrapErrParameterInvalidMsg("the vector parameter should be a non-empty array of numbers, got element %v", v)
+		}
+		vector = append(vector, float32(f))
+	}
+	return vector, nil
+}
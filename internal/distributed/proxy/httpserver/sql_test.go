@@ -0,0 +1,68 @@
+package httpserver
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseSQL(t *testing.T) {
+	t.Run("query", func(t *testing.T) {
+		stmt, err := parseSQL("select book_id, word_count from book where word_count >= :min and book_intro <> 'it''s' or book_id not in (1, 2, (3)) limit 10 offset :offset;",
+			map[string]interface{}{"min": 1000, "offset": float64(5)})
+		require.NoError(t, err)
+		assert.Equal(t, []string{"book_id", "word_count"}, stmt.OutputFields)
+		assert.Equal(t, "book", stmt.CollectionName)
+		assert.Equal(t, `word_count >= 1000 and book_intro != "it's" or book_id not in [ 1 , 2 , ( 3 ) ]`, stmt.Filter)
+		assert.Empty(t, stmt.AnnsField)
+		assert.EqualValues(t, 10, stmt.Limit)
+		assert.EqualValues(t, 5, stmt.Offset)
+	})
+
+	t.Run("count", func(t *testing.T) {
+		stmt, err := parseSQL("SELECT count(*) FROM book WHERE tag = :tag AND valid = TRUE", map[string]interface{}{"tag": "a\"b"})
+		require.NoError(t, err)
+		assert.Equal(t, []string{"count(*)"}, stmt.OutputFields)
+		assert.Equal(t, `tag == "a\"b" and valid == true`, stmt.Filter)
+		assert.EqualValues(t, 100, stmt.Limit)
+	})
+
+	t.Run("search", func(t *testing.T) {
+		stmt, err := parseSQL("SELECT * FROM book WHERE word_count > 10 ORDER BY distance(book_intro, :v) ASC LIMIT 3",
+			map[string]interface{}{"v": []interface{}{0.1, 0.2}})
+		require.NoError(t, err)
+		assert.Equal(t, []string{"*"}, stmt.OutputFields)
+		assert.Equal(t, "word_count > 10", stmt.Filter)
+		assert.Equal(t, "book_intro", stmt.AnnsField)
+		assert.Equal(t, []float32{0.1, 0.2}, stmt.Vector)
+		assert.EqualValues(t, 3, stmt.Limit)
+	})
+
+	t.Run("invalid", func(t *testing.T) {
+		cases := []struct {
+			sql    string
+			params map[string]interface{}
+		}{
+			{"DELETE FROM book", nil},
+			{"SELECT FROM book", nil},
+			{"SELECT * FROM", nil},
+			{"SELECT * FROM book WHERE", nil},
+			{"SELECT * FROM book WHERE a = 'b", nil},
+			{"SELECT * FROM book WHERE (a = 1", nil},
+			{"SELECT * FROM book WHERE a = :a", nil},
+			{"SELECT * FROM book WHERE a = :a", map[string]interface{}{"a": nil}},
+			{"SELECT * FROM book WHERE a = 1 # comment", nil},
+			{"SELECT * FROM book ORDER BY a", nil},
+			{"SELECT * FROM book ORDER BY distance(vec, :v)", map[string]interface{}{"v": []interface{}{"a"}}},
+			{"SELECT * FROM book ORDER BY distance(vec, :v) DESC", map[string]interface{}{"v": []interface{}{0.1}}},
+			{"SELECT * FROM book LIMIT -1", nil},
+			{"SELECT * FROM book LIMIT 1.5", nil},
+			{"SELECT * FROM book LIMIT 10 GROUP BY a", nil},
+		}
+		for _, c := range cases {
+			_, err := parseSQL(c.sql, c.params)
+			assert.Error(t, err, c.sql)
+		}
+	})
+}
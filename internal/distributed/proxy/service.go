@@ -49,6 +49,7 @@ import (
 	"github.com/milvus-io/milvus-proto/go-api/v2/federpb"
 	"github.com/milvus-io/milvus-proto/go-api/v2/milvuspb"
 	dcc "github.com/milvus-io/milvus/internal/distributed/datacoord/client"
+	"github.com/milvus-io/milvus/internal/distributed/proxy/grpcweb"
 	"github.com/milvus-io/milvus/internal/distributed/proxy/httpserver"
 	qcc "github.com/milvus-io/milvus/internal/distributed/querycoord/client"
 	rcc "github.com/milvus-io/milvus/internal/distributed/rootcoord/client"
@@ -122,10 +123,23 @@ func authenticate(c *gin.Context) {
 	if !proxy.Params.CommonCfg.AuthorizationEnabled.GetAsBool() {
 		return
 	}
+	if user, ok := proxy.TLSIdentityVerify(c, c.Request.TLS); ok {
+		log.Debug("auth by client certificate successful", zap.String("username", user))
+		c.Set(httpserver.ContextUsername, user)
+		return
+	}
 	// TODO fubang
 	username, password, ok := httpserver.ParseUsernamePassword(c)
 	if ok {
 		if proxy.PasswordVerify(c, username, password) {
+			// the user with expired password could only update the password
+			if !isUpdateCredentialRequest(c) {
+				if err := proxy.CheckPasswordExpired(c, username); err != nil {
+					log.Warn("password check failed", zap.String("username", username), zap.Error(err))
+					c.AbortWithStatusJSON(http.StatusUnauthorized, gin.H{httpserver.HTTPReturnCode: merr.Code(err), httpserver.HTTPReturnMessage: err.Error()})
+					return
+				}
+			}
 			log.Debug("auth successful", zap.String("username", username))
 			c.Set(httpserver.ContextUsername, username)
 			return
@@ -133,7 +147,7 @@ func authenticate(c *gin.Context) {
 	}
 	rawToken := httpserver.GetAuthorization(c)
 	if rawToken != "" && !strings.Contains(rawToken, util.CredentialSeperator) {
-		user, err := proxy.VerifyAPIKey(rawToken)
+		user, err := proxy.VerifyAPIKey(c, rawToken, proxy.APIKeyScopeOfHTTPRequest(c.Request.Method, c.Request.URL.Path))
 		if err == nil {
 			c.Set(httpserver.ContextUsername, user)
 			return
@@ -143,6 +157,10 @@ func authenticate(c *gin.Context) {
 	c.AbortWithStatusJSON(http.StatusUnauthorized, gin.H{httpserver.HTTPReturnCode: merr.Code(merr.ErrNeedAuthenticate), httpserver.HTTPReturnMessage: merr.ErrNeedAuthenticate.Error()})
 }
 
+func isUpdateCredentialRequest(c *gin.Context) bool {
+	return c.Request.Method == http.MethodPatch && strings.HasSuffix(c.Request.URL.Path, "/credential")
+}
+
 // registerHTTPServer register the http server, panic when failed
 func (s *Server) registerHTTPServer() {
 	// (Embedded Milvus Only) Discard gin logs if logging is disabled.
@@ -181,7 +199,7 @@ func (s *Server) startHTTPServer(errChan chan error) {
 	})
 	app := ginHandler.Group("/v1", authenticate)
 	httpserver.NewHandlers(s.proxy).RegisterRoutesToV1(app)
-	s.httpServer = &http.Server{Handler: ginHandler, ReadHeaderTimeout: time.Second}
+	s.httpServer = &http.Server{Handler: s.wrapGrpcWebHandler(ginHandler), ReadHeaderTimeout: time.Second}
 	errChan <- nil
 	if err := s.httpServer.Serve(s.httpListener); err != nil && err != cmux.ErrServerClosed {
 		log.Error("start Proxy http server to listen failed", zap.Error(err))
@@ -191,6 +209,23 @@ func (s *Server) startHTTPServer(errChan chan error) {
 	log.Info("Proxy http server exited")
 }
 
+// wrapGrpcWebHandler routes gRPC-Web requests from browser SDKs to the external grpc server,
+// other requests are served by the given handler.
+func (s *Server) wrapGrpcWebHandler(handler http.Handler) http.Handler {
+	if !proxy.Params.HTTPCfg.GrpcWebEnabled.GetAsBool() || s.grpcExternalServer == nil {
+		return handler
+	}
+	log.Info("Proxy http server serves gRPC-Web requests")
+	grpcWebHandler := grpcweb.NewHandler(s.grpcExternalServer)
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if grpcweb.IsGrpcWebRequest(r) || grpcweb.IsGrpcWebPreflight(r) {
+			grpcWebHandler.ServeHTTP(w, r)
+			return
+		}
+		handler.ServeHTTP(w, r)
+	})
+}
+
 func (s *Server) startInternalRPCServer(grpcInternalPort int, errChan chan error) {
 	s.wg.Add(1)
 	go s.startInternalGrpc(grpcInternalPort, errChan)
@@ -230,8 +265,10 @@ func (s *Server) startExternalGrpc(grpcPort int, errChan chan error) {
 		grpc.MaxSendMsgSize(Params.ServerMaxSendSize.GetAsInt()),
 		grpc.UnaryInterceptor(grpc_middleware.ChainUnaryServer(
 			otelgrpc.UnaryServerInterceptor(opts...),
+			proxy.RequestIDInterceptor,
 			grpc_auth.UnaryServerInterceptor(proxy.AuthenticationInterceptor),
 			proxy.DatabaseInterceptor(),
+			proxy.AuditLogInterceptor(),
 			proxy.UnaryServerHookInterceptor(),
 			proxy.UnaryServerInterceptor(proxy.PrivilegeInterceptor),
 			logutil.UnaryTraceLoggerInterceptor,
@@ -277,6 +314,11 @@ func (s *Server) startExternalGrpc(grpcPort int, errChan chan error) {
 			ClientCAs:    certPool,
 			MinVersion:   tls.VersionTLS13,
 		}
+		if err := setupCRLVerifier(tlsConf); err != nil {
+			log.Warn("failed to load certificate revocation list", zap.Error(err))
+			errChan <- err
+			return
+		}
 		grpcOpts = append(grpcOpts, grpc.Creds(credentials.NewTLS(tlsConf)))
 	}
 	s.grpcExternalServer = grpc.NewServer(grpcOpts...)
@@ -496,6 +538,10 @@ func (s *Server) init() error {
 					ClientCAs:    certPool,
 					MinVersion:   tls.VersionTLS13,
 				}
+				if err := setupCRLVerifier(tlsConf); err != nil {
+					log.Error("failed to load certificate revocation list", zap.Error(err))
+					return err
+				}
 				s.httpListener, listenErr = tls.Listen("tcp", ":"+strconv.Itoa(HTTPParams.Port.GetAsInt()), tlsConf)
 				if listenErr != nil {
 					log.Error("Proxy server(grpc/http) failed to listen on", zap.Error(err), zap.Int("port", Params.Port.GetAsInt()))
@@ -522,7 +568,12 @@ func (s *Server) init() error {
 	if s.rootCoordClient == nil {
 		var err error
 		log.Debug("create RootCoord client for Proxy")
-		s.rootCoordClient, err = rcc.NewClient(s.ctx)
+		if proxy.Params.ProxyCfg.CoordClientPoolEnabled.GetAsBool() {
+			s.rootCoordClient, err = rcc.NewPooledClient(s.ctx, proxy.Params.ProxyCfg.CoordClientPoolSize.GetAsInt(),
+				proxy.Params.ProxyCfg.CoordClientPoolMaxConcurrencyPerDatabase.GetAsInt())
+		} else {
+			s.rootCoordClient, err = rcc.NewClient(s.ctx)
+		}
 		if err != nil {
 			log.Warn("failed to create RootCoord client for Proxy", zap.Error(err))
 			return err
@@ -544,7 +595,12 @@ func (s *Server) init() error {
 	if s.dataCoordClient == nil {
 		var err error
 		log.Debug("create DataCoord client for Proxy")
-		s.dataCoordClient, err = dcc.NewClient(s.ctx)
+		if proxy.Params.ProxyCfg.CoordClientPoolEnabled.GetAsBool() {
+			s.dataCoordClient, err = dcc.NewPooledClient(s.ctx, proxy.Params.ProxyCfg.CoordClientPoolSize.GetAsInt(),
+				proxy.Params.ProxyCfg.CoordClientPoolMaxConcurrencyPerDatabase.GetAsInt())
+		} else {
+			s.dataCoordClient, err = dcc.NewClient(s.ctx)
+		}
 		if err != nil {
 			log.Warn("failed to create DataCoord client for Proxy", zap.Error(err))
 			return err
@@ -566,7 +622,12 @@ func (s *Server) init() error {
 	if s.queryCoordClient == nil {
 		var err error
 		log.Debug("create QueryCoord client for Proxy")
-		s.queryCoordClient, err = qcc.NewClient(s.ctx)
+		if proxy.Params.ProxyCfg.CoordClientPoolEnabled.GetAsBool() {
+			s.queryCoordClient, err = qcc.NewPooledClient(s.ctx, proxy.Params.ProxyCfg.CoordClientPoolSize.GetAsInt(),
+				proxy.Params.ProxyCfg.CoordClientPoolMaxConcurrencyPerDatabase.GetAsInt())
+		} else {
+			s.queryCoordClient, err = qcc.NewClient(s.ctx)
+		}
 		if err != nil {
 			log.Warn("failed to create QueryCoord client for Proxy", zap.Error(err))
 			return err
@@ -44,8 +44,11 @@ import (
 	"github.com/milvus-io/milvus-proto/go-api/v2/milvuspb"
 	"github.com/milvus-io/milvus/internal/distributed/proxy/httpserver"
 	"github.com/milvus-io/milvus/internal/mocks"
+	"github.com/milvus-io/milvus/internal/proto/datapb"
 	"github.com/milvus-io/milvus/internal/proto/internalpb"
 	"github.com/milvus-io/milvus/internal/proto/proxypb"
+	"github.com/milvus-io/milvus/internal/proto/querypb"
+	"github.com/milvus-io/milvus/internal/proto/rootcoordpb"
 	"github.com/milvus-io/milvus/internal/proxy"
 	"github.com/milvus-io/milvus/internal/types"
 	milvusmock "github.com/milvus-io/milvus/internal/util/mock"
@@ -512,6 +515,62 @@ func (m *MockProxy) ReplicateMessage(ctx context.Context, req *milvuspb.Replicat
 	return nil, nil
 }
 
+func (m *MockProxy) CancelDDLTask(ctx context.Context, req *rootcoordpb.CancelDDLTaskRequest) (*commonpb.Status, error) {
+	return nil, nil
+}
+
+func (m *MockProxy) CancelCompactionPlan(ctx context.Context, req *datapb.CancelCompactionPlanRequest) (*commonpb.Status, error) {
+	return nil, nil
+}
+
+func (m *MockProxy) OperateStorageMigration(ctx context.Context, req *datapb.OperateStorageMigrationRequest) (*commonpb.Status, error) {
+	return nil, nil
+}
+
+func (m *MockProxy) OperateLoadSchedule(ctx context.Context, req *querypb.OperateLoadScheduleRequest) (*commonpb.Status, error) {
+	return nil, nil
+}
+
+func (m *MockProxy) UpdateLoadConfig(ctx context.Context, req *querypb.UpdateLoadConfigRequest) (*commonpb.Status, error) {
+	return nil, nil
+}
+
+func (m *MockProxy) OperateCollectionTrash(ctx context.Context, req *rootcoordpb.OperateCollectionTrashRequest) (*commonpb.Status, error) {
+	return nil, nil
+}
+
+func (m *MockProxy) OperateCollectionTemplate(ctx context.Context, req *rootcoordpb.OperateCollectionTemplateRequest) (*commonpb.Status, error) {
+	return nil, nil
+}
+
+func (m *MockProxy) CreateCollectionFromTemplate(ctx context.Context, req *rootcoordpb.CreateCollectionFromTemplateRequest) (*commonpb.Status, error) {
+	return nil, nil
+}
+
+func (m *MockProxy) OperateRoleInheritance(ctx context.Context, req *rootcoordpb.OperateRoleInheritanceRequest) (*commonpb.Status, error) {
+	return nil, nil
+}
+
+func (m *MockProxy) ListRoleInheritances(ctx context.Context, req *rootcoordpb.ListRoleInheritancesRequest) (*rootcoordpb.ListRoleInheritancesResponse, error) {
+	return nil, nil
+}
+
+func (m *MockProxy) OperateAPIKey(ctx context.Context, req *rootcoordpb.OperateAPIKeyRequest) (*rootcoordpb.OperateAPIKeyResponse, error) {
+	return nil, nil
+}
+
+func (m *MockProxy) ListAPIKeys(ctx context.Context, req *rootcoordpb.ListAPIKeysRequest) (*rootcoordpb.ListAPIKeysResponse, error) {
+	return nil, nil
+}
+
+func (m *MockProxy) ListSessions(ctx context.Context, req *proxypb.ListSessionsRequest) (*proxypb.ListSessionsResponse, error) {
+	return nil, nil
+}
+
+func (m *MockProxy) KillSession(ctx context.Context, req *proxypb.KillSessionRequest) (*commonpb.Status, error) {
+	return nil, nil
+}
+
 // /////////////////////////////////////////////////////////////////////////////////////////////////////////////////////
 
 type WaitOption struct {
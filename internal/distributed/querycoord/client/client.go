@@ -46,15 +46,26 @@ type Client struct {
 
 // NewClient creates a client for QueryCoord grpc call.
 func NewClient(ctx context.Context) (*Client, error) {
+	config := &Params.QueryCoordGrpcClientCfg
+	return newClient(ctx, grpcclient.NewClientBase[querypb.QueryCoordClient](config, "milvus.proto.query.QueryCoord"))
+}
+
+// NewPooledClient creates a query coordinator client whose calls are spread over poolSize connections
+// by the pool key of the context, and the concurrent calls of each key are limited by maxConcurrencyPerKey.
+func NewPooledClient(ctx context.Context, poolSize int, maxConcurrencyPerKey int) (*Client, error) {
+	config := &Params.QueryCoordGrpcClientCfg
+	return newClient(ctx, grpcclient.NewClientPool[querypb.QueryCoordClient](config, "milvus.proto.query.QueryCoord", poolSize, maxConcurrencyPerKey))
+}
+
+func newClient(ctx context.Context, grpcClient grpcclient.GrpcClient[querypb.QueryCoordClient]) (*Client, error) {
 	sess := sessionutil.NewSession(ctx)
 	if sess == nil {
 		err := fmt.Errorf("new session error, maybe can not connect to etcd")
 		log.Debug("QueryCoordClient NewClient failed", zap.Error(err))
 		return nil, err
 	}
-	config := &Params.QueryCoordGrpcClientCfg
 	client := &Client{
-		grpcClient: grpcclient.NewClientBase[querypb.QueryCoordClient](config, "milvus.proto.query.QueryCoord"),
+		grpcClient: grpcClient,
 		sess:       sess,
 	}
 	client.grpcClient.SetRole(typeutil.QueryCoordRole)
@@ -363,3 +374,25 @@ func (c *Client) ListResourceGroups(ctx context.Context, req *milvuspb.ListResou
 		return client.ListResourceGroups(ctx, req)
 	})
 }
+
+func (c *Client) OperateLoadSchedule(ctx context.Context, req *querypb.OperateLoadScheduleRequest, opts ...grpc.CallOption) (*commonpb.Status, error) {
+	req = typeutil.Clone(req)
+	commonpbutil.UpdateMsgBase(
+		req.GetBase(),
+		commonpbutil.FillMsgBaseFromClient(paramtable.GetNodeID(), commonpbutil.WithTargetID(c.grpcClient.GetNodeID())),
+	)
+	return wrapGrpcCall(ctx, c, func(client querypb.QueryCoordClient) (*commonpb.Status, error) {
+		return client.OperateLoadSchedule(ctx, req)
+	})
+}
+
+func (c *Client) UpdateLoadConfig(ctx context.Context, req *querypb.UpdateLoadConfigRequest, opts ...grpc.CallOption) (*commonpb.Status, error) {
+	req = typeutil.Clone(req)
+	commonpbutil.UpdateMsgBase(
+		req.GetBase(),
+		commonpbutil.FillMsgBaseFromClient(paramtable.GetNodeID(), commonpbutil.WithTargetID(c.grpcClient.GetNodeID())),
+	)
+	return wrapGrpcCall(ctx, c, func(client querypb.QueryCoordClient) (*commonpb.Status, error) {
+		return client.UpdateLoadConfig(ctx, req)
+	})
+}
@@ -417,3 +417,11 @@ func (s *Server) ListResourceGroups(ctx context.Context, req *milvuspb.ListResou
 func (s *Server) DescribeResourceGroup(ctx context.Context, req *querypb.DescribeResourceGroupRequest) (*querypb.DescribeResourceGroupResponse, error) {
 	return s.queryCoord.DescribeResourceGroup(ctx, req)
 }
+
+func (s *Server) OperateLoadSchedule(ctx context.Context, req *querypb.OperateLoadScheduleRequest) (*commonpb.Status, error) {
+	return s.queryCoord.OperateLoadSchedule(ctx, req)
+}
+
+func (s *Server) UpdateLoadConfig(ctx context.Context, req *querypb.UpdateLoadConfigRequest) (*commonpb.Status, error) {
+	return s.queryCoord.UpdateLoadConfig(ctx, req)
+}
@@ -53,15 +53,26 @@ type Client struct {
 // etcdEndpoints are the address list for etcd end points
 // timeout is default setting for each grpc call
 func NewClient(ctx context.Context) (*Client, error) {
+	config := &Params.RootCoordGrpcClientCfg
+	return newClient(ctx, grpcclient.NewClientBase[rootcoordpb.RootCoordClient](config, "milvus.proto.rootcoord.RootCoord"))
+}
+
+// NewPooledClient creates a root coordinator client whose calls are spread over poolSize connections
+// by the pool key of the context, and the concurrent calls of each key are limited by maxConcurrencyPerKey.
+func NewPooledClient(ctx context.Context, poolSize int, maxConcurrencyPerKey int) (*Client, error) {
+	config := &Params.RootCoordGrpcClientCfg
+	return newClient(ctx, grpcclient.NewClientPool[rootcoordpb.RootCoordClient](config, "milvus.proto.rootcoord.RootCoord", poolSize, maxConcurrencyPerKey))
+}
+
+func newClient(ctx context.Context, grpcClient grpcclient.GrpcClient[rootcoordpb.RootCoordClient]) (*Client, error) {
 	sess := sessionutil.NewSession(ctx)
 	if sess == nil {
 		err := fmt.Errorf("new session error, maybe can not connect to etcd")
 		log.Debug("QueryCoordClient NewClient failed", zap.Error(err))
 		return nil, err
 	}
-	config := &Params.RootCoordGrpcClientCfg
 	client := &Client{
-		grpcClient: grpcclient.NewClientBase[rootcoordpb.RootCoordClient](config, "milvus.proto.rootcoord.RootCoord"),
+		grpcClient: grpcClient,
 		sess:       sess,
 	}
 	client.grpcClient.SetRole(typeutil.RootCoordRole)
@@ -651,3 +662,91 @@ func (c *Client) ListDatabases(ctx context.Context, in *milvuspb.ListDatabasesRe
 	}
 	return ret.(*milvuspb.ListDatabasesResponse), err
 }
+
+func (c *Client) CancelDDLTask(ctx context.Context, req *rootcoordpb.CancelDDLTaskRequest, opts ...grpc.CallOption) (*commonpb.Status, error) {
+	req = typeutil.Clone(req)
+	commonpbutil.UpdateMsgBase(
+		req.GetBase(),
+		commonpbutil.FillMsgBaseFromClient(paramtable.GetNodeID(), commonpbutil.WithTargetID(c.grpcClient.GetNodeID())),
+	)
+	return wrapGrpcCall(ctx, c, func(client rootcoordpb.RootCoordClient) (*commonpb.Status, error) {
+		return client.CancelDDLTask(ctx, req)
+	})
+}
+
+func (c *Client) OperateCollectionTrash(ctx context.Context, req *rootcoordpb.OperateCollectionTrashRequest, opts ...grpc.CallOption) (*commonpb.Status, error) {
+	req = typeutil.Clone(req)
+	commonpbutil.UpdateMsgBase(
+		req.GetBase(),
+		commonpbutil.FillMsgBaseFromClient(paramtable.GetNodeID(), commonpbutil.WithTargetID(c.grpcClient.GetNodeID())),
+	)
+	return wrapGrpcCall(ctx, c, func(client rootcoordpb.RootCoordClient) (*commonpb.Status, error) {
+		return client.OperateCollectionTrash(ctx, req)
+	})
+}
+
+func (c *Client) OperateCollectionTemplate(ctx context.Context, req *rootcoordpb.OperateCollectionTemplateRequest, opts ...grpc.CallOption) (*commonpb.Status, error) {
+	req = typeutil.Clone(req)
+	commonpbutil.UpdateMsgBase(
+		req.GetBase(),
+		commonpbutil.FillMsgBaseFromClient(paramtable.GetNodeID(), commonpbutil.WithTargetID(c.grpcClient.GetNodeID())),
+	)
+	return wrapGrpcCall(ctx, c, func(client rootcoordpb.RootCoordClient) (*commonpb.Status, error) {
+		return client.OperateCollectionTemplate(ctx, req)
+	})
+}
+
+func (c *Client) CreateCollectionFromTemplate(ctx context.Context, req *rootcoordpb.CreateCollectionFromTemplateRequest, opts ...grpc.CallOption) (*commonpb.Status, error) {
+	req = typeutil.Clone(req)
+	commonpbutil.UpdateMsgBase(
+		req.GetBase(),
+		commonpbutil.FillMsgBaseFromClient(paramtable.GetNodeID(), commonpbutil.WithTargetID(c.grpcClient.GetNodeID())),
+	)
+	return wrapGrpcCall(ctx, c, func(client rootcoordpb.RootCoordClient) (*commonpb.Status, error) {
+		return client.CreateCollectionFromTemplate(ctx, req)
+	})
+}
+
+func (c *Client) OperateRoleInheritance(ctx context.Context, req *rootcoordpb.OperateRoleInheritanceRequest, opts ...grpc.CallOption) (*commonpb.Status, error) {
+	req = typeutil.Clone(req)
+	commonpbutil.UpdateMsgBase(
+		req.GetBase(),
+		commonpbutil.FillMsgBaseFromClient(paramtable.GetNodeID(), commonpbutil.WithTargetID(c.grpcClient.GetNodeID())),
+	)
+	return wrapGrpcCall(ctx, c, func(client rootcoordpb.RootCoordClient) (*commonpb.Status, error) {
+		return client.OperateRoleInheritance(ctx, req)
+	})
+}
+
+func (c *Client) ListRoleInheritances(ctx context.Context, req *rootcoordpb.ListRoleInheritancesRequest, opts ...grpc.CallOption) (*rootcoordpb.ListRoleInheritancesResponse, error) {
+	req = typeutil.Clone(req)
+	commonpbutil.UpdateMsgBase(
+		req.GetBase(),
+		commonpbutil.FillMsgBaseFromClient(paramtable.GetNodeID(), commonpbutil.WithTargetID(c.grpcClient.GetNodeID())),
+	)
+	return wrapGrpcCall(ctx, c, func(client rootcoordpb.RootCoordClient) (*rootcoordpb.ListRoleInheritancesResponse, error) {
+		return client.ListRoleInheritances(ctx, req)
+	})
+}
+
+func (c *Client) OperateAPIKey(ctx context.Context, req *rootcoordpb.OperateAPIKeyRequest, opts ...grpc.CallOption) (*rootcoordpb.OperateAPIKeyResponse, error) {
+	req = typeutil.Clone(req)
+	commonpbutil.UpdateMsgBase(
+		req.GetBase(),
+		commonpbutil.FillMsgBaseFromClient(paramtable.GetNodeID(), commonpbutil.WithTargetID(c.grpcClient.GetNodeID())),
+	)
+	return wrapGrpcCall(ctx, c, func(client rootcoordpb.RootCoordClient) (*rootcoordpb.OperateAPIKeyResponse, error) {
+		return client.OperateAPIKey(ctx, req)
+	})
+}
+
+func (c *Client) ListAPIKeys(ctx context.Context, req *rootcoordpb.ListAPIKeysRequest, opts ...grpc.CallOption) (*rootcoordpb.ListAPIKeysResponse, error) {
+	req = typeutil.Clone(req)
+	commonpbutil.UpdateMsgBase(
+		req.GetBase(),
+		commonpbutil.FillMsgBaseFromClient(paramtable.GetNodeID(), commonpbutil.WithTargetID(c.grpcClient.GetNodeID())),
+	)
+	return wrapGrpcCall(ctx, c, func(client rootcoordpb.RootCoordClient) (*rootcoordpb.ListAPIKeysResponse, error) {
+		return client.ListAPIKeys(ctx, req)
+	})
+}
@@ -519,3 +519,35 @@ func (s *Server) AlterCollection(ctx context.Context, request *milvuspb.AlterCol
 func (s *Server) RenameCollection(ctx context.Context, request *milvuspb.RenameCollectionRequest) (*commonpb.Status, error) {
 	return s.rootCoord.RenameCollection(ctx, request)
 }
+
+func (s *Server) CancelDDLTask(ctx context.Context, req *rootcoordpb.CancelDDLTaskRequest) (*commonpb.Status, error) {
+	return s.rootCoord.CancelDDLTask(ctx, req)
+}
+
+func (s *Server) OperateCollectionTrash(ctx context.Context, req *rootcoordpb.OperateCollectionTrashRequest) (*commonpb.Status, error) {
+	return s.rootCoord.OperateCollectionTrash(ctx, req)
+}
+
+func (s *Server) OperateCollectionTemplate(ctx context.Context, req *rootcoordpb.OperateCollectionTemplateRequest) (*commonpb.Status, error) {
+	return s.rootCoord.OperateCollectionTemplate(ctx, req)
+}
+
+func (s *Server) CreateCollectionFromTemplate(ctx context.Context, req *rootcoordpb.CreateCollectionFromTemplateRequest) (*commonpb.Status, error) {
+	return s.rootCoord.CreateCollectionFromTemplate(ctx, req)
+}
+
+func (s *Server) OperateRoleInheritance(ctx context.Context, req *rootcoordpb.OperateRoleInheritanceRequest) (*commonpb.Status, error) {
+	return s.rootCoord.OperateRoleInheritance(ctx, req)
+}
+
+func (s *Server) ListRoleInheritances(ctx context.Context, req *rootcoordpb.ListRoleInheritancesRequest) (*rootcoordpb.ListRoleInheritancesResponse, error) {
+	return s.rootCoord.ListRoleInheritances(ctx, req)
+}
+
+func (s *Server) OperateAPIKey(ctx context.Context, req *rootcoordpb.OperateAPIKeyRequest) (*rootcoordpb.OperateAPIKeyResponse, error) {
+	return s.rootCoord.OperateAPIKey(ctx, req)
+}
+
+func (s *Server) ListAPIKeys(ctx context.Context, req *rootcoordpb.ListAPIKeysRequest) (*rootcoordpb.ListAPIKeysResponse, error) {
+	return s.rootCoord.ListAPIKeys(ctx, req)
+}
@@ -0,0 +1,87 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package http
+
+import (
+	"encoding/json"
+	"net/http"
+	"sync"
+
+	"github.com/cockroachdb/errors"
+
+	"github.com/milvus-io/milvus/pkg/util/merr"
+)
+
+// Component holds the component serving the handlers registered on the management server.
+// The handlers are registered only once since a path can't be registered twice, and serve the latest
+// started component, e.g. the component restarted in the same process by the tests.
+type Component[T any] struct {
+	role      string
+	once      sync.Once
+	mu        sync.RWMutex
+	component T
+	started   bool
+}
+
+// NewComponent creates the holder of component with the role, which is reported if the component not started.
+func NewComponent[T any](role string) *Component[T] {
+	return &Component[T]{role: role}
+}
+
+// Serve sets the component serving the handlers, and registers the handlers at the first time.
+func (c *Component[T]) Serve(component T, handlers ...*Handler) {
+	c.mu.Lock()
+	c.component = component
+	c.started = true
+	c.mu.Unlock()
+	c.once.Do(func() {
+		for _, handler := range handlers {
+			Register(handler)
+		}
+	})
+}
+
+// Get returns the component serving the handlers, the service unavailable is written if no component started.
+func (c *Component[T]) Get(w http.ResponseWriter) (T, bool) {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	if !c.started {
+		WriteJSON(w, http.StatusServiceUnavailable, map[string]string{"error": c.role + " not started"})
+	}
+	return c.component, c.started
+}
+
+// WriteJSON writes the value in json with the status code.
+func WriteJSON(w http.ResponseWriter, code int, v any) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(code)
+	_ = json.NewEncoder(w).Encode(v)
+}
+
+// WriteError writes the error in json, the status code is derived from the type of error.
+func WriteError(w http.ResponseWriter, err error) {
+	code := http.StatusInternalServerError
+	switch {
+	case errors.Is(err, merr.ErrParameterInvalid), errors.Is(err, merr.ErrResourceGroupNotFound):
+		code = http.StatusBadRequest
+	case errors.Is(err, merr.ErrCollectionNotFound), errors.Is(err, merr.ErrCollectionNotLoaded):
+		code = http.StatusNotFound
+	case errors.Is(err, merr.ErrServiceNotReady):
+		code = http.StatusServiceUnavailable
+	}
+	WriteJSON(w, code, map[string]string{"error": err.Error()})
+}
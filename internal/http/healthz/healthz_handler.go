@@ -21,12 +21,16 @@ import (
 	"encoding/json"
 	"fmt"
 	"net/http"
+	"sync"
 
+	"github.com/samber/lo"
 	"go.uber.org/zap"
 
 	"github.com/milvus-io/milvus-proto/go-api/v2/commonpb"
 	"github.com/milvus-io/milvus-proto/go-api/v2/milvuspb"
 	"github.com/milvus-io/milvus/pkg/log"
+	"github.com/milvus-io/milvus/pkg/util/paramtable"
+	"github.com/milvus-io/milvus/pkg/util/typeutil"
 )
 
 // GetComponentStatesInterface defines the interface that get states from component.
@@ -40,48 +44,212 @@ type Indicator interface {
 	Health(ctx context.Context) commonpb.StateCode
 }
 
+// The states of component reported in the detail of health response.
+const (
+	// StateServing means the component is healthy or standby, and all its checks are passed.
+	StateServing = "serving"
+	// StateDegraded means the component is healthy, but some of its checks are not passed, e.g. channels are not all watched yet.
+	StateDegraded = "degraded"
+	// StateInitializing means the component is not healthy yet, e.g. recovering.
+	StateInitializing = "initializing"
+	// StateStopping means the component is gracefully stopping.
+	StateStopping = "stopping"
+	// StateAbnormal means the component is abnormal, which fails the health check.
+	StateAbnormal = "abnormal"
+)
+
+// The names of the checks registered by components, which could be required by readiness.
+const (
+	// ChannelsWatchedCheck checks whether all the channels are watched by datanodes.
+	ChannelsWatchedCheck = "channelsWatched"
+	// TargetsReadyCheck checks whether the targets of all the loaded collections are ready.
+	TargetsReadyCheck = "targetsReady"
+)
+
 type IndicatorState struct {
-	Name string             `json:"name"`
-	Code commonpb.StateCode `json:"code"`
+	Name   string             `json:"name"`
+	Code   commonpb.StateCode `json:"code"`
+	State  string             `json:"state"`
+	Reason string             `json:"reason,omitempty"`
+	Checks []*CheckState      `json:"checks,omitempty"`
+}
+
+// CheckState is the result of a check registered by component.
+type CheckState struct {
+	Name     string `json:"name"`
+	Passed   bool   `json:"passed"`
+	Required bool   `json:"required"`
+	Reason   string `json:"reason,omitempty"`
+}
+
+// StopStageState is the progress of a graceful stop stage.
+type StopStageState struct {
+	Stage      string   `json:"stage"`
+	Components []string `json:"components"`
+	State      string   `json:"state"`
+	ElapsedMs  int64    `json:"elapsed_ms"`
 }
 
 type HealthResponse struct {
-	State  string            `json:"state"`
-	Detail []*IndicatorState `json:"detail"`
+	State        string            `json:"state"`
+	Detail       []*IndicatorState `json:"detail"`
+	StopProgress []*StopStageState `json:"stop_progress,omitempty"`
+}
+
+type check struct {
+	name string
+	fn   func(ctx context.Context) error
 }
 
 type HealthHandler struct {
-	indicators []Indicator
+	indicators   []Indicator
+	stopProgress func() []*StopStageState
+
+	checksMu sync.RWMutex
+	checks   map[string][]*check // component name -> checks
 }
 
-var _ http.Handler = (*HealthHandler)(nil)
+// ReadyHandler serves the readiness, the component is ready when it's serving and passes the required checks.
+type ReadyHandler struct {
+	health *HealthHandler
+}
 
-var defaultHandler = HealthHandler{}
+var (
+	_ http.Handler = (*HealthHandler)(nil)
+	_ http.Handler = (*ReadyHandler)(nil)
+)
+
+var defaultHandler = HealthHandler{checks: make(map[string][]*check)}
 
 func Register(indicator Indicator) {
 	defaultHandler.indicators = append(defaultHandler.indicators, indicator)
 }
 
+// RegisterStopProgress registers the provider of graceful stop progress,
+// which is reported in the detail of health response.
+func RegisterStopProgress(provider func() []*StopStageState) {
+	defaultHandler.stopProgress = provider
+}
+
+// RegisterCheck registers the check of component, which returns the reason in error if not passed.
+// The check with the same name of the component is replaced.
+func RegisterCheck(component string, name string, fn func(ctx context.Context) error) {
+	defaultHandler.registerCheck(component, name, fn)
+}
+
 func Handler() *HealthHandler {
 	return &defaultHandler
 }
 
-func (handler *HealthHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
-	resp := &HealthResponse{
-		State: "OK",
+// Readiness returns the handler of readiness, the required checks are configured by common.readiness.checks.
+func Readiness() *ReadyHandler {
+	return &ReadyHandler{health: &defaultHandler}
+}
+
+func (handler *HealthHandler) registerCheck(component string, name string, fn func(ctx context.Context) error) {
+	handler.checksMu.Lock()
+	defer handler.checksMu.Unlock()
+	if handler.checks == nil {
+		handler.checks = make(map[string][]*check)
 	}
-	ctx := context.Background()
+	checks := lo.Filter(handler.checks[component], func(c *check, _ int) bool { return c.name != name })
+	handler.checks[component] = append(checks, &check{name: name, fn: fn})
+}
+
+// states collects the states of the components, with the results of their checks.
+func (handler *HealthHandler) states(ctx context.Context, required typeutil.Set[string]) []*IndicatorState {
+	handler.checksMu.RLock()
+	defer handler.checksMu.RUnlock()
+
+	ret := make([]*IndicatorState, 0, len(handler.indicators))
 	for _, in := range handler.indicators {
 		code := in.Health(ctx)
-		resp.Detail = append(resp.Detail, &IndicatorState{
+		state := &IndicatorState{
 			Name: in.GetName(),
 			Code: code,
-		})
-		if code != commonpb.StateCode_Healthy && code != commonpb.StateCode_StandBy {
-			resp.State = fmt.Sprintf("component %s state is %s", in.GetName(), code.String())
 		}
+		switch code {
+		case commonpb.StateCode_Healthy, commonpb.StateCode_StandBy:
+			state.State = StateServing
+		case commonpb.StateCode_Initializing:
+			state.State = StateInitializing
+		case commonpb.StateCode_Stopping:
+			state.State = StateStopping
+		default:
+			state.State = StateAbnormal
+		}
+		if state.State != StateServing {
+			state.Reason = fmt.Sprintf("component %s state is %s", in.GetName(), code.String())
+			ret = append(ret, state)
+			continue
+		}
+
+		for _, c := range handler.checks[in.GetName()] {
+			checkState := &CheckState{Name: c.name, Passed: true, Required: required.Contain(c.name)}
+			if err := c.fn(ctx); err != nil {
+				checkState.Passed = false
+				checkState.Reason = err.Error()
+				if state.State == StateServing {
+					state.State = StateDegraded
+					state.Reason = fmt.Sprintf("check %s of component %s is not passed: %s", c.name, in.GetName(), err.Error())
+				}
+			}
+			state.Checks = append(state.Checks, checkState)
+		}
+		ret = append(ret, state)
+	}
+	return ret
+}
+
+// ServeHTTP serves the liveness, which fails only if some component is abnormal,
+// so the components initializing or degraded during recovery are not restarted.
+func (handler *HealthHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	resp := &HealthResponse{
+		State:  "OK",
+		Detail: handler.states(r.Context(), typeutil.NewSet[string]()),
+	}
+	for _, state := range resp.Detail {
+		if state.State == StateAbnormal {
+			resp.State = state.Reason
+		}
+	}
+	if handler.stopProgress != nil {
+		resp.StopProgress = handler.stopProgress()
 	}
+	writeResponse(w, r, resp)
+}
+
+// ServeHTTP serves the readiness, which fails if some component is not serving or fails the required checks.
+func (handler *ReadyHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	params := paramtable.Get()
+	required := typeutil.NewSet(lo.Filter(params.CommonCfg.ReadinessChecks.GetAsStrings(), func(name string, _ int) bool {
+		return name != ""
+	})...)
+	standbyReady := params.CommonCfg.ReadinessStandbyReady.GetAsBool()
+
+	resp := &HealthResponse{
+		State:  "OK",
+		Detail: handler.health.states(r.Context(), required),
+	}
+	for _, state := range resp.Detail {
+		switch {
+		case state.State == StateServing && state.Code == commonpb.StateCode_StandBy && !standbyReady:
+			resp.State = fmt.Sprintf("component %s is standby", state.Name)
+		case state.State == StateServing:
+		case state.State == StateDegraded:
+			for _, c := range state.Checks {
+				if c.Required && !c.Passed {
+					resp.State = fmt.Sprintf("check %s of component %s is not passed: %s", c.Name, state.Name, c.Reason)
+				}
+			}
+		default:
+			resp.State = state.Reason
+		}
+	}
+	writeResponse(w, r, resp)
+}
 
+func writeResponse(w http.ResponseWriter, r *http.Request, resp *HealthResponse) {
 	if resp.State == "OK" {
 		w.WriteHeader(http.StatusOK)
 	} else {
@@ -0,0 +1,119 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package healthz
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/cockroachdb/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/milvus-io/milvus-proto/go-api/v2/commonpb"
+	"github.com/milvus-io/milvus/pkg/util/paramtable"
+)
+
+type mockIndicator struct {
+	name string
+	code commonpb.StateCode
+}
+
+func (m *mockIndicator) Health(ctx context.Context) commonpb.StateCode {
+	return m.code
+}
+
+func (m *mockIndicator) GetName() string {
+	return m.name
+}
+
+func serve(t *testing.T, handler http.Handler) (int, *HealthResponse) {
+	w := httptest.NewRecorder()
+	req := httptest.NewRequest(http.MethodGet, "/", nil)
+	req.Header.Set(ContentTypeHeader, ContentTypeJSON)
+	handler.ServeHTTP(w, req)
+	resp := &HealthResponse{}
+	require.NoError(t, json.Unmarshal(w.Body.Bytes(), resp))
+	return w.Code, resp
+}
+
+func TestReadyHandler(t *testing.T) {
+	paramtable.Init()
+	params := paramtable.Get()
+
+	coord := &mockIndicator{name: "datacoord", code: commonpb.StateCode_Initializing}
+	node := &mockIndicator{name: "querynode", code: commonpb.StateCode_Healthy}
+	health := &HealthHandler{indicators: []Indicator{coord, node}}
+	ready := &ReadyHandler{health: health}
+
+	var watched error = errors.New("channel ch-1 is not watched")
+	health.registerCheck("datacoord", ChannelsWatchedCheck, func(ctx context.Context) error { return watched })
+	health.registerCheck("datacoord", "balanced", func(ctx context.Context) error { return errors.New("not balanced") })
+
+	// initializing is alive but not ready
+	code, resp := serve(t, health)
+	assert.Equal(t, http.StatusOK, code)
+	assert.Equal(t, StateInitializing, resp.Detail[0].State)
+	code, resp = serve(t, ready)
+	assert.Equal(t, http.StatusInternalServerError, code)
+	assert.Equal(t, "component datacoord state is Initializing", resp.State)
+
+	// degraded by the required check
+	coord.code = commonpb.StateCode_Healthy
+	code, resp = serve(t, ready)
+	assert.Equal(t, http.StatusInternalServerError, code)
+	assert.Equal(t, StateDegraded, resp.Detail[0].State)
+	require.Len(t, resp.Detail[0].Checks, 2)
+	assert.True(t, resp.Detail[0].Checks[0].Required)
+	assert.False(t, resp.Detail[0].Checks[1].Required)
+	assert.Equal(t, StateServing, resp.Detail[1].State)
+	code, _ = serve(t, health)
+	assert.Equal(t, http.StatusOK, code)
+
+	// degraded by the check not required only
+	watched = nil
+	code, resp = serve(t, ready)
+	assert.Equal(t, http.StatusOK, code)
+	assert.Equal(t, StateDegraded, resp.Detail[0].State)
+	assert.Equal(t, "check balanced of component datacoord is not passed: not balanced", resp.Detail[0].Reason)
+
+	// the check of the same name is replaced
+	health.registerCheck("datacoord", "balanced", func(ctx context.Context) error { return nil })
+	_, resp = serve(t, ready)
+	assert.Equal(t, StateServing, resp.Detail[0].State)
+	assert.Len(t, resp.Detail[0].Checks, 2)
+
+	coord.code = commonpb.StateCode_StandBy
+	code, _ = serve(t, ready)
+	assert.Equal(t, http.StatusOK, code)
+	params.Save(params.CommonCfg.ReadinessStandbyReady.Key, "false")
+	defer params.Reset(params.CommonCfg.ReadinessStandbyReady.Key)
+	code, resp = serve(t, ready)
+	assert.Equal(t, http.StatusInternalServerError, code)
+	assert.Equal(t, "component datacoord is standby", resp.State)
+
+	// abnormal fails both
+	node.code = commonpb.StateCode_Abnormal
+	code, resp = serve(t, health)
+	assert.Equal(t, http.StatusInternalServerError, code)
+	assert.Equal(t, "component querynode state is Abnormal", resp.State)
+	code, _ = serve(t, ready)
+	assert.Equal(t, http.StatusInternalServerError, code)
+}
@@ -0,0 +1,202 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package http
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"path"
+	"sync"
+	"time"
+
+	"go.etcd.io/etcd/api/v3/mvccpb"
+	"go.uber.org/zap"
+	"go.uber.org/zap/zapcore"
+
+	"github.com/milvus-io/milvus/internal/kv"
+	"github.com/milvus-io/milvus/pkg/log"
+)
+
+// moduleLogLevelPrefix is the kv path prefix to persist the log level overrides of modules.
+const moduleLogLevelPrefix = "log/level"
+
+// moduleLogLevelRewatchInterval is the interval to watch the overrides again after the watch broken.
+var moduleLogLevelRewatchInterval = time.Second
+
+var (
+	moduleLogLevelMu sync.Mutex
+	moduleLogLevelKV kv.BaseKV
+)
+
+type moduleLogLevel struct {
+	Module string `json:"module,omitempty"`
+	Level  string `json:"level"`
+}
+
+// RestoreModuleLogLevels restores the log level overrides of modules persisted in the kv,
+// and the overrides set through LogLevelRouterPath later will be persisted into it.
+// The overrides are watched if the kv is watchable, so the override set through any node applies to all nodes.
+func RestoreModuleLogLevels(metaKV kv.BaseKV) error {
+	moduleLogLevelMu.Lock()
+	defer moduleLogLevelMu.Unlock()
+	if err := loadModuleLogLevels(metaKV); err != nil {
+		return err
+	}
+	moduleLogLevelKV = metaKV
+	if watchKV, ok := metaKV.(kv.WatchKV); ok {
+		go watchModuleLogLevels(watchKV)
+	}
+	return nil
+}
+
+func loadModuleLogLevels(metaKV kv.BaseKV) error {
+	keys, values, err := metaKV.LoadWithPrefix(moduleLogLevelPrefix)
+	if err != nil {
+		return err
+	}
+	for i, key := range keys {
+		module := path.Base(key)
+		if err := applyModuleLogLevel(module, values[i]); err != nil {
+			log.Warn("skip invalid module log level", zap.String("module", module), zap.String("level", values[i]))
+			continue
+		}
+		log.Info("restore module log level", zap.String("module", module), zap.String("level", values[i]))
+	}
+	return nil
+}
+
+// watchModuleLogLevels applies the overrides persisted by other nodes, the overrides are reloaded
+// if the watch is broken, e.g. by compaction of etcd.
+func watchModuleLogLevels(watchKV kv.WatchKV) {
+	for {
+		for resp := range watchKV.WatchWithPrefix(moduleLogLevelPrefix) {
+			if err := resp.Err(); err != nil {
+				log.Warn("failed to watch module log levels", zap.Error(err))
+				break
+			}
+			for _, event := range resp.Events {
+				module := path.Base(string(event.Kv.Key))
+				level := string(event.Kv.Value)
+				if event.Type == mvccpb.DELETE {
+					level = ""
+				}
+				if err := applyModuleLogLevel(module, level); err != nil {
+					log.Warn("skip invalid module log level", zap.String("module", module), zap.String("level", level))
+					continue
+				}
+				log.Info("module log level updated by watch", zap.String("module", module), zap.String("level", level))
+			}
+		}
+		time.Sleep(moduleLogLevelRewatchInterval)
+		moduleLogLevelMu.Lock()
+		if err := loadModuleLogLevels(watchKV); err != nil {
+			log.Warn("failed to reload module log levels", zap.Error(err))
+		}
+		moduleLogLevelMu.Unlock()
+	}
+}
+
+// applyModuleLogLevel sets the log level of module, or resets it to follow the global level if level is empty.
+func applyModuleLogLevel(module string, level string) error {
+	if level == "" {
+		log.ResetModuleLevel(module)
+		return nil
+	}
+	var l zapcore.Level
+	if err := l.UnmarshalText([]byte(level)); err != nil {
+		return err
+	}
+	log.SetModuleLevel(module, l)
+	return nil
+}
+
+func persistModuleLogLevel(module string, level string) error {
+	moduleLogLevelMu.Lock()
+	defer moduleLogLevelMu.Unlock()
+	if moduleLogLevelKV == nil {
+		return nil
+	}
+	key := path.Join(moduleLogLevelPrefix, module)
+	if level == "" {
+		return moduleLogLevelKV.Remove(key)
+	}
+	return moduleLogLevelKV.Save(key, level)
+}
+
+// logLevelHandler gets and updates the global log level, or the log level of module if specified.
+//
+// The modules are the ones logging through log.Module, e.g. querycoordv2.balance and querycoordv2.checkers.
+// The global log level applies to the current node only, while the log level of module is persisted
+// and applies to all nodes of the cluster.
+//
+//	GET /log/level?module=querycoordv2.balance
+//	GET /log/level?module=*
+//	PUT /log/level {"module": "querycoordv2.balance", "level": "debug"}
+//	PUT /log/level {"module": "querycoordv2.balance", "level": ""} to follow the global level again
+func logLevelHandler(w http.ResponseWriter, req *http.Request) {
+	switch req.Method {
+	case http.MethodGet:
+		module := req.URL.Query().Get("module")
+		switch module {
+		case "":
+			log.Level().ServeHTTP(w, req)
+		case "*":
+			levels := make(map[string]string)
+			for name, level := range log.GetModuleLevels() {
+				levels[name] = level.String()
+			}
+			WriteJSON(w, http.StatusOK, levels)
+		default:
+			WriteJSON(w, http.StatusOK, moduleLogLevel{Module: module, Level: log.GetModuleLevel(module).String()})
+		}
+
+	case http.MethodPut:
+		body, err := io.ReadAll(req.Body)
+		if err != nil {
+			WriteJSON(w, http.StatusBadRequest, map[string]string{"error": err.Error()})
+			return
+		}
+		var request moduleLogLevel
+		if json.Unmarshal(body, &request) != nil || request.Module == "" {
+			request.Module = req.URL.Query().Get("module")
+		}
+		if request.Module == "" {
+			req.Body = io.NopCloser(bytes.NewReader(body))
+			log.Level().ServeHTTP(w, req)
+			return
+		}
+
+		if err := applyModuleLogLevel(request.Module, request.Level); err != nil {
+			WriteJSON(w, http.StatusBadRequest, map[string]string{"error": err.Error()})
+			return
+		}
+		if err := persistModuleLogLevel(request.Module, request.Level); err != nil {
+			log.Warn("failed to persist module log level", zap.String("module", request.Module), zap.Error(err))
+			WriteJSON(w, http.StatusInternalServerError,
+				map[string]string{"error": fmt.Sprintf("failed to persist log level: %v", err)})
+			return
+		}
+		log.Info("module log level updated", zap.String("module", request.Module), zap.String("level", request.Level))
+		WriteJSON(w, http.StatusOK, moduleLogLevel{Module: request.Module, Level: log.GetModuleLevel(request.Module).String()})
+
+	default:
+		log.Level().ServeHTTP(w, req)
+	}
+}
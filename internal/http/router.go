@@ -19,8 +19,82 @@ package http
 // HealthzRouterPath is default path for check health state.
 const HealthzRouterPath = "/healthz"
 
-// LogLevelRouterPath is path for Get and Update log level at runtime.
+// ReadyzRouterPath is path for check readiness, the components are ready when they're serving and pass the checks
+// required by common.readiness.checks.
+const ReadyzRouterPath = "/readyz"
+
+// LogLevelRouterPath is path for Get and Update log level at runtime, the log level of module could be
+// specified by the "module" parameter.
 const LogLevelRouterPath = "/log/level"
 
 // EventLogRouterPath is path for eventlog control.
 const EventLogRouterPath = "/eventlog"
+
+// EventStreamRouterPath is path to stream the typed cluster events as server-sent events, the event types could be
+// filtered by the "types" parameter separated by comma.
+const EventStreamRouterPath = "/eventlog/stream"
+
+// RootCoordDDLQueueRouterPath is path to list the ddl tasks queued in rootcoord.
+const RootCoordDDLQueueRouterPath = "/rootcoord/ddl"
+
+// RootCoordCollectionTemplateRouterPath is path to list the collection templates in rootcoord.
+const RootCoordCollectionTemplateRouterPath = "/rootcoord/collection-templates"
+
+// RootCoordCollectionTrashRouterPath is path to list the dropped collections kept in the trash of rootcoord.
+const RootCoordCollectionTrashRouterPath = "/rootcoord/collection-trash"
+
+// RootCoordTSODiagnosticsRouterPath is path to get the drift between the timestamps allocated by rootcoord and the wall clock,
+// and the allocation rate of the timestamps.
+const RootCoordTSODiagnosticsRouterPath = "/rootcoord/tso/diagnostics"
+
+// QueryNodeWarmupRouterPath is path to warm up the mmapped sealed segments of querynode, the segments could be
+// specified by the "segment_ids" parameter separated by comma, all the loaded sealed segments by default.
+const QueryNodeWarmupRouterPath = "/querynode/warmup"
+
+// QueryCoordBalanceExplainRouterPath is path to explain the segment distribution of the collection specified by
+// the "collection_id" parameter, with the score breakdown of each node and the moves the balancer would propose next.
+const QueryCoordBalanceExplainRouterPath = "/querycoord/balance/explain"
+
+// QueryCoordLoadScheduleRouterPath is path to list the load schedules in querycoord, which load and release
+// the collection periodically, the schedule to get is specified by the "collection_id" parameter.
+const QueryCoordLoadScheduleRouterPath = "/querycoord/load/schedule"
+
+// QueryCoordLoadIncidentRouterPath is path to list the impossible load states detected and recovered by querycoord,
+// optionally filtered by the "collection_id" parameter.
+const QueryCoordLoadIncidentRouterPath = "/querycoord/load/incidents"
+
+// QueryCoordLoadFeasibilityRouterPath is path to estimate the resources to load the collection specified by the "collection_id" parameter
+// with the "replica_number" replicas in the "resource_groups", and preview the placement on querynodes against their free resources.
+const QueryCoordLoadFeasibilityRouterPath = "/querycoord/load/feasibility"
+
+// QueryCoordLoadConfigRouterPath is path to get the load config of the loaded collection specified by the "collection_id" parameter,
+// and whether the replicas converged to it.
+const QueryCoordLoadConfigRouterPath = "/querycoord/load/config"
+
+// QueryNodeStoppingRouterPath is path to mark the querynode stopping, QueryCoord moves the shard leaders and segments
+// out of the stopping querynode. It's supposed to be called by the preStop hook of Kubernetes before SIGTERM.
+const QueryNodeStoppingRouterPath = "/querynode/stopping"
+
+// DataCoordOrphanChannelRouterPath is path to list the orphaned channels in datacoord, which are left in meta but the collection
+// is gone or vice versa, or force release the orphaned channel specified by the "channel" parameter.
+const DataCoordOrphanChannelRouterPath = "/datacoord/channels/orphan"
+
+// DataCoordCompactionSimulationRouterPath is path to simulate the compaction trigger against current segment meta,
+// the plans would be generated for the collection specified by the "collection_id" parameter are returned without executing.
+const DataCoordCompactionSimulationRouterPath = "/datacoord/compaction/simulate"
+
+// DataCoordCompactionPlanRouterPath is path to list the compaction plans in datacoord, of the collection specified by the
+// "collection_id" parameter.
+const DataCoordCompactionPlanRouterPath = "/datacoord/compaction/plans"
+
+// DataCoordStorageMigrationRouterPath is path to list the jobs copying the binlogs and index files of the collection
+// specified by the "collection_id" parameter to another object storage.
+const DataCoordStorageMigrationRouterPath = "/datacoord/storage/migrate"
+
+// DataNodeFlowGraphRouterPath is path to list the flowgraph topology and node statistics of the channels in datanode,
+// of the channel specified by the "channel" parameter, all the channels by default.
+const DataNodeFlowGraphRouterPath = "/datanode/flowgraphs"
+
+// QueryNodeSegmentAccessStatsRouterPath is path to list the access statistics of the segments loaded in querynode,
+// of the collection specified by the "collection_id" parameter, all the loaded segments by default.
+const QueryNodeSegmentAccessStatsRouterPath = "/querynode/segments/access-stats"
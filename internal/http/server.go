@@ -44,20 +44,26 @@ type Handler struct {
 
 func registerDefaults() {
 	Register(&Handler{
-		Path: LogLevelRouterPath,
-		HandlerFunc: func(w http.ResponseWriter, req *http.Request) {
-			log.Level().ServeHTTP(w, req)
-		},
+		Path:        LogLevelRouterPath,
+		HandlerFunc: logLevelHandler,
 	})
 	Register(&Handler{
 		Path:    HealthzRouterPath,
 		Handler: healthz.Handler(),
 	})
+	Register(&Handler{
+		Path:    ReadyzRouterPath,
+		Handler: healthz.Readiness(),
+	})
 
 	Register(&Handler{
 		Path:    EventLogRouterPath,
 		Handler: eventlog.Handler(),
 	})
+	Register(&Handler{
+		Path:    EventStreamRouterPath,
+		Handler: eventlog.StreamHandler(),
+	})
 }
 
 func Register(h *Handler) {
@@ -24,14 +24,21 @@ import (
 	"net/http"
 	"net/http/httptest"
 	"testing"
+	"time"
 
+	"github.com/cockroachdb/errors"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/suite"
+	"go.etcd.io/etcd/api/v3/mvccpb"
+	clientv3 "go.etcd.io/etcd/client/v3"
 	"go.uber.org/zap"
 
 	"github.com/milvus-io/milvus-proto/go-api/v2/commonpb"
 	"github.com/milvus-io/milvus/internal/http/healthz"
+	memkv "github.com/milvus-io/milvus/internal/kv/mem"
+	"github.com/milvus-io/milvus/internal/kv/mocks"
 	"github.com/milvus-io/milvus/pkg/log"
+	"github.com/milvus-io/milvus/pkg/util/merr"
 	"github.com/milvus-io/milvus/pkg/util/paramtable"
 )
 
@@ -90,6 +97,87 @@ func (suite *HTTPServerTestSuite) TestDefaultLogHandler() {
 	suite.Equal(zap.ErrorLevel, log.GetLevel())
 }
 
+func (suite *HTTPServerTestSuite) TestModuleLogLevelHandler() {
+	metaKV := memkv.NewMemoryKV()
+	suite.Require().NoError(metaKV.Save("log/level/test.restored", "error"))
+	suite.Require().NoError(RestoreModuleLogLevels(metaKV))
+	suite.Equal(zap.ErrorLevel, log.GetModuleLevel("test.restored"))
+
+	client := suite.server.Client()
+	url := suite.server.URL + LogLevelRouterPath
+	do := func(method string, url string, payload any) (int, string) {
+		var body io.Reader
+		if payload != nil {
+			data, err := json.Marshal(payload)
+			suite.Require().NoError(err)
+			body = bytes.NewBuffer(data)
+		}
+		req, err := http.NewRequest(method, url, body)
+		suite.Require().NoError(err)
+		resp, err := client.Do(req)
+		suite.Require().NoError(err)
+		defer resp.Body.Close()
+		data, err := io.ReadAll(resp.Body)
+		suite.Require().NoError(err)
+		return resp.StatusCode, string(data)
+	}
+
+	log.SetLevel(zap.InfoLevel)
+	code, body := do(http.MethodPut, url, map[string]any{"module": "test.module", "level": "debug"})
+	suite.Equal(http.StatusOK, code)
+	suite.Equal("{\"module\":\"test.module\",\"level\":\"debug\"}\n", body)
+	suite.Equal(zap.DebugLevel, log.GetModuleLevel("test.module"))
+	suite.Equal(zap.InfoLevel, log.GetLevel())
+	value, err := metaKV.Load("log/level/test.module")
+	suite.NoError(err)
+	suite.Equal("debug", value)
+
+	code, body = do(http.MethodGet, url+"?module=test.module", nil)
+	suite.Equal(http.StatusOK, code)
+	suite.Equal("{\"module\":\"test.module\",\"level\":\"debug\"}\n", body)
+
+	code, body = do(http.MethodGet, url+"?module=*", nil)
+	suite.Equal(http.StatusOK, code)
+	suite.Contains(body, "\"test.module\":\"debug\"")
+	suite.Contains(body, "\"test.restored\":\"error\"")
+
+	code, _ = do(http.MethodPut, url, map[string]any{"module": "test.module", "level": "invalid"})
+	suite.Equal(http.StatusBadRequest, code)
+
+	// reset to follow the global level
+	code, body = do(http.MethodPut, url, map[string]any{"module": "test.module", "level": ""})
+	suite.Equal(http.StatusOK, code)
+	suite.Equal("{\"module\":\"test.module\",\"level\":\"info\"}\n", body)
+	_, err = metaKV.Load("log/level/test.module")
+	suite.Error(err)
+}
+
+func (suite *HTTPServerTestSuite) TestWatchModuleLogLevels() {
+	watchKV := mocks.NewWatchKV(suite.T())
+	watchKV.EXPECT().LoadWithPrefix(moduleLogLevelPrefix).Return(nil, nil, nil)
+	ch := make(chan clientv3.WatchResponse, 2)
+	watchKV.EXPECT().WatchWithPrefix(moduleLogLevelPrefix).Return(ch)
+	suite.Require().NoError(RestoreModuleLogLevels(watchKV))
+
+	// the override set through another node
+	ch <- clientv3.WatchResponse{Events: []*clientv3.Event{{
+		Type: mvccpb.PUT,
+		Kv:   &mvccpb.KeyValue{Key: []byte("by-dev/meta/log/level/test.watched"), Value: []byte("warn")},
+	}}}
+	suite.Eventually(func() bool {
+		return log.GetModuleLevel("test.watched") == zap.WarnLevel
+	}, 5*time.Second, 10*time.Millisecond)
+
+	log.SetLevel(zap.InfoLevel)
+	ch <- clientv3.WatchResponse{Events: []*clientv3.Event{{
+		Type: mvccpb.DELETE,
+		Kv:   &mvccpb.KeyValue{Key: []byte("by-dev/meta/log/level/test.watched")},
+	}}}
+	suite.Eventually(func() bool {
+		return log.GetModuleLevel("test.watched") == zap.InfoLevel
+	}, 5*time.Second, 10*time.Millisecond)
+}
+
 func (suite *HTTPServerTestSuite) TestHealthzHandler() {
 	url := suite.server.URL + "/healthz"
 	client := suite.server.Client()
@@ -109,7 +197,7 @@ func (suite *HTTPServerTestSuite) TestHealthzHandler() {
 	suite.Nil(err)
 	defer resp.Body.Close()
 	body, _ = io.ReadAll(resp.Body)
-	suite.Equal("{\"state\":\"OK\",\"detail\":[{\"name\":\"m1\",\"code\":1}]}", string(body))
+	suite.Equal("{\"state\":\"OK\",\"detail\":[{\"name\":\"m1\",\"code\":1,\"state\":\"serving\"}]}", string(body))
 
 	healthz.Register(&MockIndicator{"m2", commonpb.StateCode_Abnormal})
 	req, _ = http.NewRequest(http.MethodGet, url, nil)
@@ -118,7 +206,42 @@ func (suite *HTTPServerTestSuite) TestHealthzHandler() {
 	suite.Nil(err)
 	defer resp.Body.Close()
 	body, _ = io.ReadAll(resp.Body)
-	suite.Equal("{\"state\":\"component m2 state is Abnormal\",\"detail\":[{\"name\":\"m1\",\"code\":1},{\"name\":\"m2\",\"code\":2}]}", string(body))
+	suite.Equal("{\"state\":\"component m2 state is Abnormal\",\"detail\":[{\"name\":\"m1\",\"code\":1,\"state\":\"serving\"},"+
+		"{\"name\":\"m2\",\"code\":2,\"state\":\"abnormal\",\"reason\":\"component m2 state is Abnormal\"}]}", string(body))
+}
+
+func TestComponent(t *testing.T) {
+	component := NewComponent[string]("testcoord")
+	handler := func(w http.ResponseWriter, req *http.Request) {
+		name, ok := component.Get(w)
+		if !ok {
+			return
+		}
+		WriteJSON(w, http.StatusOK, map[string]string{"name": name})
+	}
+
+	w := httptest.NewRecorder()
+	handler(w, httptest.NewRequest(http.MethodGet, "/testcoord/component", nil))
+	assert.Equal(t, http.StatusServiceUnavailable, w.Code)
+	assert.Contains(t, w.Body.String(), "testcoord not started")
+
+	component.Serve("first", &Handler{Path: "/testcoord/component", HandlerFunc: handler})
+	// the handler is registered only once, registering the same path twice panics
+	component.Serve("second", &Handler{Path: "/testcoord/component", HandlerFunc: handler})
+	w = httptest.NewRecorder()
+	handler(w, httptest.NewRequest(http.MethodGet, "/testcoord/component", nil))
+	assert.Equal(t, http.StatusOK, w.Code)
+	assert.Equal(t, "{\"name\":\"second\"}\n", w.Body.String())
+
+	w = httptest.NewRecorder()
+	WriteError(w, merr.WrapErrParameterInvalidMsg("invalid"))
+	assert.Equal(t, http.StatusBadRequest, w.Code)
+	w = httptest.NewRecorder()
+	WriteError(w, merr.WrapErrCollectionNotLoaded(1))
+	assert.Equal(t, http.StatusNotFound, w.Code)
+	w = httptest.NewRecorder()
+	WriteError(w, errors.New("mock error"))
+	assert.Equal(t, http.StatusInternalServerError, w.Code)
 }
 
 func TestHTTPServerSuite(t *testing.T) {
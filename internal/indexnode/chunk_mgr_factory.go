@@ -6,6 +6,7 @@ import (
 
 	"github.com/milvus-io/milvus/internal/proto/indexpb"
 	"github.com/milvus-io/milvus/internal/storage"
+	"github.com/milvus-io/milvus/pkg/util/paramtable"
 	"github.com/milvus-io/milvus/pkg/util/typeutil"
 )
 
@@ -37,6 +38,10 @@ func (m *chunkMgrFactory) NewChunkManager(ctx context.Context, config *indexpb.S
 		storage.UseVirtualHost(config.GetUseVirtualHost()),
 		storage.RequestTimeout(config.GetRequestTimeoutMs()),
 		storage.Region(config.GetRegion()),
+		// the settings of native gcs client are not carried by the storage config
+		storage.GcsCredentialFile(paramtable.Get().MinioCfg.GcsCredentialFile.GetValue()),
+		storage.GcsKmsKeyName(paramtable.Get().MinioCfg.GcsKmsKeyName.GetValue()),
+		storage.GcsUploadChunkSize(paramtable.Get().MinioCfg.GcsUploadChunkSize.GetAsInt64()*1024*1024),
 		storage.CreateBucket(true),
 	)
 	return chunkManagerFactory.NewPersistentStorageChunkManager(ctx)
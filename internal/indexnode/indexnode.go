@@ -108,6 +108,8 @@ type IndexNode struct {
 	initOnce  sync.Once
 	stateLock sync.Mutex
 	tasks     map[taskKey]*taskInfo
+
+	buildThroughput buildThroughput
 }
 
 // NewIndexNode creates a new IndexNode component.
@@ -20,6 +20,7 @@ import (
 	"context"
 	"fmt"
 	"strconv"
+	"time"
 
 	"github.com/golang/protobuf/proto"
 	"go.opentelemetry.io/otel"
@@ -73,8 +74,10 @@ func (i *IndexNode) CreateJob(ctx context.Context, req *indexpb.CreateJobRequest
 
 	taskCtx, taskCancel := context.WithCancel(i.loopCtx)
 	if oldInfo := i.loadOrStoreTask(req.GetClusterID(), req.GetBuildID(), &taskInfo{
-		cancel: taskCancel,
-		state:  commonpb.IndexState_InProgress,
+		cancel:    taskCancel,
+		state:     commonpb.IndexState_InProgress,
+		phase:     indexBuildPhasePending,
+		totalRows: req.GetNumRows(),
 	}); oldInfo != nil {
 		err := merr.WrapErrIndexDuplicate(req.GetIndexName(), "building index task existed")
 		log.Warn("duplicated index build task", zap.Error(err))
@@ -138,9 +141,13 @@ func (i *IndexNode) QueryJobs(ctx context.Context, req *indexpb.QueryJobsRequest
 				serializedSize:      info.serializedSize,
 				failReason:          info.failReason,
 				currentIndexVersion: info.currentIndexVersion,
+				phase:               info.phase,
+				totalRows:           info.totalRows,
+				buildStartTime:      info.buildStartTime,
 			}
 		}
 	})
+	rowsPerSecond, now := i.buildThroughput.get(), time.Now()
 	ret := &indexpb.QueryJobsResponse{
 		Status:     merr.Success(),
 		ClusterID:  req.GetClusterID(),
@@ -159,6 +166,9 @@ func (i *IndexNode) QueryJobs(ctx context.Context, req *indexpb.QueryJobsRequest
 			ret.IndexInfos[i].SerializedSize = info.serializedSize
 			ret.IndexInfos[i].FailReason = info.failReason
 			ret.IndexInfos[i].CurrentIndexVersion = info.currentIndexVersion
+			ret.IndexInfos[i].Phase = info.phase
+			ret.IndexInfos[i].TotalRows = info.totalRows
+			ret.IndexInfos[i].ProcessedRows, ret.IndexInfos[i].EstimatedRemainingSeconds = estimateProgress(info, rowsPerSecond, now)
 			log.RatedDebug(5, "querying index build task",
 				zap.Int64("indexBuildID", buildID),
 				zap.String("state", info.state.String()),
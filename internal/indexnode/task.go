@@ -60,6 +60,11 @@ type taskInfo struct {
 	failReason          string
 	currentIndexVersion int32
 
+	// build progress
+	phase          string
+	totalRows      int64
+	buildStartTime time.Time
+
 	// task statistics
 	statistic *indexpb.JobInfo
 }
@@ -103,6 +108,8 @@ type indexBuildTask struct {
 	serializedSize      uint64
 	tr                  *timerecord.TimeRecorder
 	queueDur            time.Duration
+	buildDur            time.Duration
+	checkpoint          *buildCheckpoint
 	statistic           indexpb.JobInfo
 	node                *IndexNode
 }
@@ -120,6 +127,7 @@ func (it *indexBuildTask) Reset() {
 	it.newTypeParams = nil
 	it.newIndexParams = nil
 	it.tr = nil
+	it.checkpoint = nil
 	it.node = nil
 }
 
@@ -153,6 +161,7 @@ func (it *indexBuildTask) OnEnqueue(ctx context.Context) error {
 
 func (it *indexBuildTask) Prepare(ctx context.Context) error {
 	it.queueDur = it.tr.RecordSpan()
+	it.node.storeTaskPhase(it.ClusterID, it.BuildID, indexBuildPhasePrepare)
 	log.Ctx(ctx).Info("Begin to prepare indexBuildTask", zap.Int64("buildID", it.BuildID),
 		zap.Int64("Collection", it.collectionID), zap.Int64("SegmentID", it.segmentID))
 	typeParams := make(map[string]string)
@@ -181,6 +190,8 @@ func (it *indexBuildTask) Prepare(ctx context.Context) error {
 			// ignore error
 		}
 	}
+	// the index files built by previous attempt are reused if the build inputs are unchanged
+	it.resumeFromCheckpoint(ctx)
 	log.Ctx(ctx).Info("Successfully prepare indexBuildTask", zap.Int64("buildID", it.BuildID),
 		zap.Int64("Collection", it.collectionID), zap.Int64("SegmentID", it.segmentID))
 	return nil
@@ -247,6 +258,12 @@ func (it *indexBuildTask) LoadData(ctx context.Context) error {
 }
 
 func (it *indexBuildTask) BuildIndex(ctx context.Context) error {
+	if it.checkpoint != nil {
+		log.Ctx(ctx).Info("skip building index resumed from checkpoint", zap.Int64("buildID", it.BuildID),
+			zap.Int64("checkpointVersion", it.checkpoint.IndexVersion))
+		return nil
+	}
+	it.node.storeTaskPhase(it.ClusterID, it.BuildID, indexBuildPhaseBuild)
 	err := it.parseFieldMetaFromBinlog(ctx)
 	if err != nil {
 		log.Ctx(ctx).Warn("parse field meta from binlog failed", zap.Error(err))
@@ -358,6 +375,7 @@ func (it *indexBuildTask) BuildIndex(ctx context.Context) error {
 	}
 
 	buildIndexLatency := it.tr.RecordSpan()
+	it.buildDur = buildIndexLatency
 	metrics.IndexNodeKnowhereBuildIndexLatency.WithLabelValues(strconv.FormatInt(paramtable.GetNodeID(), 10)).Observe(buildIndexLatency.Seconds())
 
 	log.Ctx(ctx).Info("Successfully build index", zap.Int64("buildID", it.BuildID), zap.Int64("Collection", it.collectionID), zap.Int64("SegmentID", it.segmentID))
@@ -365,6 +383,10 @@ func (it *indexBuildTask) BuildIndex(ctx context.Context) error {
 }
 
 func (it *indexBuildTask) SaveIndexFiles(ctx context.Context) error {
+	if it.checkpoint != nil {
+		return it.saveIndexFilesFromCheckpoint(ctx)
+	}
+	it.node.storeTaskPhase(it.ClusterID, it.BuildID, indexBuildPhaseSave)
 	gcIndex := func() {
 		if err := it.index.Delete(); err != nil {
 			log.Ctx(ctx).Error("IndexNode indexBuildTask Execute CIndexDelete failed", zap.Error(err))
@@ -381,6 +403,7 @@ func (it *indexBuildTask) SaveIndexFiles(ctx context.Context) error {
 
 	// early release index for gc, and we can ensure that Delete is idempotent.
 	gcIndex()
+	it.saveBuildCheckpoint(ctx, indexFilePath2Size, it.buildDur)
 
 	// use serialized size before encoding
 	it.serializedSize = 0
@@ -394,6 +417,7 @@ func (it *indexBuildTask) SaveIndexFiles(ctx context.Context) error {
 
 	it.statistic.EndTime = time.Now().UnixMicro()
 	it.node.storeIndexFilesAndStatistic(it.ClusterID, it.BuildID, saveFileKeys, it.serializedSize, &it.statistic, it.currentIndexVersion)
+	it.node.observeBuildThroughput(it.ClusterID, it.BuildID)
 	log.Ctx(ctx).Debug("save index files done", zap.Strings("IndexFiles", saveFileKeys))
 	saveIndexFileDur := it.tr.RecordSpan()
 	metrics.IndexNodeSaveIndexFileLatency.WithLabelValues(strconv.FormatInt(paramtable.GetNodeID(), 10)).Observe(saveIndexFileDur.Seconds())
@@ -403,6 +427,23 @@ func (it *indexBuildTask) SaveIndexFiles(ctx context.Context) error {
 	return nil
 }
 
+// saveIndexFilesFromCheckpoint reports the index files reused from the checkpoint as the result of the build.
+func (it *indexBuildTask) saveIndexFilesFromCheckpoint(ctx context.Context) error {
+	it.serializedSize = 0
+	saveFileKeys := make([]string, 0, len(it.checkpoint.Files))
+	for fileKey, fileSize := range it.checkpoint.Files {
+		it.serializedSize += uint64(fileSize)
+		saveFileKeys = append(saveFileKeys, fileKey)
+	}
+
+	it.statistic.EndTime = time.Now().UnixMicro()
+	it.node.storeIndexFilesAndStatistic(it.ClusterID, it.BuildID, saveFileKeys, it.serializedSize, &it.statistic, it.currentIndexVersion)
+	it.tr.Elapse("index resumed from checkpoint")
+	log.Ctx(ctx).Info("Successfully save index files resumed from checkpoint", zap.Int64("buildID", it.BuildID), zap.Int64("Collection", it.collectionID),
+		zap.Int64("partition", it.partitionID), zap.Int64("SegmentId", it.segmentID), zap.Strings("IndexFiles", saveFileKeys))
+	return nil
+}
+
 func (it *indexBuildTask) parseFieldMetaFromBinlog(ctx context.Context) error {
 	toLoadDataPaths := it.req.GetDataPaths()
 	if len(toLoadDataPaths) == 0 {
@@ -0,0 +1,183 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package indexnode
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"path"
+	"runtime"
+	"strconv"
+	"time"
+
+	"go.uber.org/zap"
+
+	"github.com/milvus-io/milvus/pkg/common"
+	"github.com/milvus-io/milvus/pkg/log"
+	"github.com/milvus-io/milvus/pkg/util/funcutil"
+	"github.com/milvus-io/milvus/pkg/util/metautil"
+)
+
+// buildCheckpointKey is the file name of build checkpoint, which is placed beside the index files of the build,
+// so it's recycled by the garbage collector of DataCoord together with the stale index files.
+const buildCheckpointKey = "build_checkpoint"
+
+// buildCheckpoint records the index files built by a previous attempt of the same build,
+// the retried attempt reuses the files instead of building from scratch, if the build inputs are unchanged.
+type buildCheckpoint struct {
+	BuildID             int64            `json:"build_id"`
+	IndexVersion        int64            `json:"index_version"`
+	CollectionID        int64            `json:"collection_id"`
+	PartitionID         int64            `json:"partition_id"`
+	SegmentID           int64            `json:"segment_id"`
+	CurrentIndexVersion int32            `json:"current_index_version"`
+	Fingerprint         string           `json:"fingerprint"`
+	Files               map[string]int64 `json:"files"`
+	CreatedTime         int64            `json:"created_time"`
+}
+
+func buildCheckpointPath(rootPath string, buildID int64) string {
+	return path.Join(rootPath, common.SegmentIndexPath, strconv.FormatInt(buildID, 10), buildCheckpointKey)
+}
+
+// buildFingerprint identifies the inputs of the build, the index built from the same inputs is identical.
+func (it *indexBuildTask) buildFingerprint() (string, error) {
+	inputs := struct {
+		IndexID             int64             `json:"index_id"`
+		TypeParams          map[string]string `json:"type_params"`
+		IndexParams         map[string]string `json:"index_params"`
+		DataPaths           []string          `json:"data_paths"`
+		NumRows             int64             `json:"num_rows"`
+		CurrentIndexVersion int32             `json:"current_index_version"`
+	}{
+		IndexID:             it.req.GetIndexID(),
+		TypeParams:          funcutil.KeyValuePair2Map(it.req.GetTypeParams()),
+		IndexParams:         funcutil.KeyValuePair2Map(it.req.GetIndexParams()),
+		DataPaths:           it.req.GetDataPaths(),
+		NumRows:             it.req.GetNumRows(),
+		CurrentIndexVersion: getCurrentIndexVersion(it.req.GetCurrentIndexVersion()),
+	}
+	// map keys are sorted by json marshal
+	bs, err := json.Marshal(inputs)
+	if err != nil {
+		return "", err
+	}
+	sum := sha256.Sum256(bs)
+	return hex.EncodeToString(sum[:]), nil
+}
+
+// saveBuildCheckpoint saves the checkpoint after the index files uploaded, only the builds taking long enough are checkpointed.
+func (it *indexBuildTask) saveBuildCheckpoint(ctx context.Context, indexFilePath2Size map[string]int64, buildDur time.Duration) {
+	if !Params.IndexNodeCfg.BuildCheckpointEnabled.GetAsBool() ||
+		buildDur < Params.IndexNodeCfg.BuildCheckpointMinBuildTime.GetAsDuration(time.Second) {
+		return
+	}
+	log := log.Ctx(ctx).With(zap.Int64("buildID", it.BuildID), zap.Int64("indexVersion", it.req.GetIndexVersion()))
+	fingerprint, err := it.buildFingerprint()
+	if err != nil {
+		log.Warn("failed to generate build fingerprint, skip checkpoint", zap.Error(err))
+		return
+	}
+	checkpoint := &buildCheckpoint{
+		BuildID:             it.BuildID,
+		IndexVersion:        it.req.GetIndexVersion(),
+		CollectionID:        it.collectionID,
+		PartitionID:         it.partitionID,
+		SegmentID:           it.segmentID,
+		CurrentIndexVersion: it.currentIndexVersion,
+		Fingerprint:         fingerprint,
+		Files:               make(map[string]int64, len(indexFilePath2Size)),
+		CreatedTime:         time.Now().Unix(),
+	}
+	for filePath, fileSize := range indexFilePath2Size {
+		checkpoint.Files[path.Base(filePath)] = fileSize
+	}
+	bs, err := json.Marshal(checkpoint)
+	if err != nil {
+		log.Warn("failed to marshal build checkpoint", zap.Error(err))
+		return
+	}
+	if err := it.cm.Write(ctx, buildCheckpointPath(it.cm.RootPath(), it.BuildID), bs); err != nil {
+		log.Warn("failed to save build checkpoint", zap.Error(err))
+		return
+	}
+	log.Info("build checkpoint saved", zap.Int("files", len(checkpoint.Files)), zap.Duration("buildDuration", buildDur))
+}
+
+// resumeFromCheckpoint reuses the index files of the previous attempt if the checkpoint matches the build inputs,
+// the files are copied to the path of current index version. Any failure falls back to build from scratch.
+func (it *indexBuildTask) resumeFromCheckpoint(ctx context.Context) bool {
+	if !Params.IndexNodeCfg.BuildCheckpointEnabled.GetAsBool() {
+		return false
+	}
+	log := log.Ctx(ctx).With(zap.Int64("buildID", it.BuildID), zap.Int64("indexVersion", it.req.GetIndexVersion()))
+	checkpointPath := buildCheckpointPath(it.cm.RootPath(), it.BuildID)
+	exist, err := it.cm.Exist(ctx, checkpointPath)
+	if err != nil || !exist {
+		return false
+	}
+	bs, err := it.cm.Read(ctx, checkpointPath)
+	if err != nil {
+		log.Warn("failed to read build checkpoint", zap.Error(err))
+		return false
+	}
+	checkpoint := &buildCheckpoint{}
+	if err := json.Unmarshal(bs, checkpoint); err != nil {
+		log.Warn("failed to unmarshal build checkpoint", zap.Error(err))
+		return false
+	}
+	fingerprint, err := it.buildFingerprint()
+	if err != nil || checkpoint.BuildID != it.BuildID || checkpoint.Fingerprint != fingerprint {
+		log.Info("build checkpoint mismatched, build from scratch", zap.Int64("checkpointVersion", checkpoint.IndexVersion))
+		return false
+	}
+
+	if checkpoint.IndexVersion != it.req.GetIndexVersion() {
+		fileKeys := make([]string, 0, len(checkpoint.Files))
+		for fileKey := range checkpoint.Files {
+			fileKeys = append(fileKeys, fileKey)
+		}
+		copyFile := func(idx int) error {
+			src := metautil.BuildSegmentIndexFilePath(it.cm.RootPath(), it.BuildID, checkpoint.IndexVersion,
+				checkpoint.PartitionID, checkpoint.SegmentID, fileKeys[idx])
+			dst := metautil.BuildSegmentIndexFilePath(it.cm.RootPath(), it.BuildID, it.req.GetIndexVersion(),
+				checkpoint.PartitionID, checkpoint.SegmentID, fileKeys[idx])
+			data, err := it.cm.Read(ctx, src)
+			if err != nil {
+				return err
+			}
+			return it.cm.Write(ctx, dst, data)
+		}
+		if err := funcutil.ProcessFuncParallel(len(fileKeys), runtime.GOMAXPROCS(0), copyFile, "copyIndexFile"); err != nil {
+			log.Warn("failed to copy index files of build checkpoint, build from scratch",
+				zap.Int64("checkpointVersion", checkpoint.IndexVersion), zap.Error(err))
+			return false
+		}
+	}
+
+	it.collectionID = checkpoint.CollectionID
+	it.partitionID = checkpoint.PartitionID
+	it.segmentID = checkpoint.SegmentID
+	it.currentIndexVersion = checkpoint.CurrentIndexVersion
+	it.checkpoint = checkpoint
+	log.Info("resume index build from checkpoint",
+		zap.Int64("checkpointVersion", checkpoint.IndexVersion),
+		zap.Int("files", len(checkpoint.Files)))
+	return true
+}
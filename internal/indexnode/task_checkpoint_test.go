@@ -0,0 +1,129 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package indexnode
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/suite"
+
+	"github.com/milvus-io/milvus-proto/go-api/v2/commonpb"
+	"github.com/milvus-io/milvus/internal/proto/indexpb"
+	"github.com/milvus-io/milvus/internal/storage"
+	"github.com/milvus-io/milvus/pkg/util/metautil"
+	"github.com/milvus-io/milvus/pkg/util/paramtable"
+)
+
+type IndexBuildCheckpointSuite struct {
+	suite.Suite
+
+	ctx   context.Context
+	cm    storage.ChunkManager
+	files map[string]int64
+	// the index files written by the build of version 1
+	indexFilePath2Size map[string]int64
+	task               *indexBuildTask
+}
+
+func (s *IndexBuildCheckpointSuite) SetupSuite() {
+	paramtable.Init()
+}
+
+func (s *IndexBuildCheckpointSuite) SetupTest() {
+	s.ctx = context.Background()
+	s.cm = storage.NewLocalChunkManager(storage.RootPath(s.T().TempDir()))
+	s.files = map[string]int64{"index_file_0": 3, "index_file_1": 2}
+	s.indexFilePath2Size = make(map[string]int64)
+	for fileKey, size := range s.files {
+		filePath := metautil.BuildSegmentIndexFilePath(s.cm.RootPath(), 100, 1, 2, 3, fileKey)
+		s.Require().NoError(s.cm.Write(s.ctx, filePath, make([]byte, size)))
+		s.indexFilePath2Size[filePath] = size
+	}
+	s.task = &indexBuildTask{
+		BuildID:      100,
+		cm:           s.cm,
+		collectionID: 1,
+		partitionID:  2,
+		segmentID:    3,
+		req: &indexpb.CreateJobRequest{
+			BuildID:      100,
+			IndexID:      10,
+			IndexVersion: 1,
+			DataPaths:    []string{"insert_log/1/2/3/101/1"},
+			NumRows:      1000,
+			TypeParams:   []*commonpb.KeyValuePair{{Key: "dim", Value: "128"}},
+			IndexParams:  []*commonpb.KeyValuePair{{Key: "index_type", Value: "DISKANN"}},
+		},
+	}
+}
+
+// saveCheckpoint saves the checkpoint of the build of version 1, and makes the task the retry of version 2.
+func (s *IndexBuildCheckpointSuite) saveCheckpoint() {
+	s.task.saveBuildCheckpoint(s.ctx, s.indexFilePath2Size, time.Hour)
+	exist, err := s.cm.Exist(s.ctx, buildCheckpointPath(s.cm.RootPath(), 100))
+	s.Require().NoError(err)
+	s.Require().True(exist)
+	s.task.req.IndexVersion = 2
+}
+
+func (s *IndexBuildCheckpointSuite) TestBuildTooFast() {
+	s.task.saveBuildCheckpoint(s.ctx, s.indexFilePath2Size, time.Second)
+	exist, err := s.cm.Exist(s.ctx, buildCheckpointPath(s.cm.RootPath(), 100))
+	s.NoError(err)
+	s.False(exist)
+}
+
+func (s *IndexBuildCheckpointSuite) TestInputsChanged() {
+	s.saveCheckpoint()
+	s.task.req.IndexParams = []*commonpb.KeyValuePair{{Key: "index_type", Value: "HNSW"}}
+	s.False(s.task.resumeFromCheckpoint(s.ctx))
+	s.Nil(s.task.checkpoint)
+}
+
+func (s *IndexBuildCheckpointSuite) TestDisabled() {
+	s.saveCheckpoint()
+	paramtable.Get().Save(Params.IndexNodeCfg.BuildCheckpointEnabled.Key, "false")
+	defer paramtable.Get().Reset(Params.IndexNodeCfg.BuildCheckpointEnabled.Key)
+	s.False(s.task.resumeFromCheckpoint(s.ctx))
+}
+
+func (s *IndexBuildCheckpointSuite) TestResume() {
+	s.saveCheckpoint()
+	s.task.collectionID, s.task.partitionID, s.task.segmentID = 0, 0, 0
+	s.Require().True(s.task.resumeFromCheckpoint(s.ctx))
+	s.EqualValues(1, s.task.collectionID)
+	s.EqualValues(2, s.task.partitionID)
+	s.EqualValues(3, s.task.segmentID)
+	s.Equal(s.files, s.task.checkpoint.Files)
+	for fileKey, size := range s.files {
+		data, err := s.cm.Read(s.ctx, metautil.BuildSegmentIndexFilePath(s.cm.RootPath(), 100, 2, 2, 3, fileKey))
+		s.NoError(err)
+		s.Len(data, int(size))
+	}
+}
+
+func (s *IndexBuildCheckpointSuite) TestIndexFilesLost() {
+	s.saveCheckpoint()
+	s.Require().NoError(s.cm.RemoveWithPrefix(s.ctx, metautil.BuildSegmentIndexFilePath(s.cm.RootPath(), 100, 1, 2, 3, "")))
+	s.False(s.task.resumeFromCheckpoint(s.ctx))
+}
+
+func TestIndexBuildCheckpoint(t *testing.T) {
+	suite.Run(t, new(IndexBuildCheckpointSuite))
+}
@@ -0,0 +1,119 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package indexnode
+
+import (
+	"sync"
+	"time"
+
+	"github.com/milvus-io/milvus-proto/go-api/v2/commonpb"
+)
+
+// the phases of an index build task reported to datacoord
+const (
+	indexBuildPhasePending = "pending"
+	indexBuildPhasePrepare = "prepare"
+	indexBuildPhaseBuild   = "build"
+	indexBuildPhaseSave    = "save"
+)
+
+// buildThroughputWeight is the weight of the latest finished task when smoothing the build throughput.
+const buildThroughputWeight = 0.3
+
+// maxEstimatedProgress caps the estimated progress of the unfinished tasks,
+// the build is never reported as done before the index files are saved.
+const maxEstimatedProgress = 0.99
+
+// buildThroughput is the smoothed rows built per second of this index node,
+// which is used to estimate the progress and remaining time of the building tasks,
+// as the index engine builds an index in one call without reporting its progress.
+type buildThroughput struct {
+	mu            sync.Mutex
+	rowsPerSecond float64
+}
+
+func (t *buildThroughput) observe(rows int64, duration time.Duration) {
+	if rows <= 0 || duration <= 0 {
+		return
+	}
+	rate := float64(rows) / duration.Seconds()
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	if t.rowsPerSecond == 0 {
+		t.rowsPerSecond = rate
+		return
+	}
+	t.rowsPerSecond = buildThroughputWeight*rate + (1-buildThroughputWeight)*t.rowsPerSecond
+}
+
+func (t *buildThroughput) get() float64 {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	return t.rowsPerSecond
+}
+
+func (i *IndexNode) storeTaskPhase(ClusterID string, buildID UniqueID, phase string) {
+	key := taskKey{ClusterID: ClusterID, BuildID: buildID}
+	i.stateLock.Lock()
+	defer i.stateLock.Unlock()
+	if info, ok := i.tasks[key]; ok {
+		info.phase = phase
+		if phase == indexBuildPhaseBuild {
+			info.buildStartTime = time.Now()
+		}
+	}
+}
+
+// observeBuildThroughput updates the build throughput by the task which has saved its index files.
+func (i *IndexNode) observeBuildThroughput(ClusterID string, buildID UniqueID) {
+	key := taskKey{ClusterID: ClusterID, BuildID: buildID}
+	i.stateLock.Lock()
+	info, ok := i.tasks[key]
+	if !ok || info.buildStartTime.IsZero() {
+		i.stateLock.Unlock()
+		return
+	}
+	rows, duration := info.totalRows, time.Since(info.buildStartTime)
+	i.stateLock.Unlock()
+	i.buildThroughput.observe(rows, duration)
+}
+
+// estimateProgress returns the estimated processed rows and remaining seconds of the task,
+// the remaining seconds is 0 if there is no finished task to estimate by.
+func estimateProgress(info *taskInfo, rowsPerSecond float64, now time.Time) (int64, int64) {
+	if info.state == commonpb.IndexState_Finished {
+		return info.totalRows, 0
+	}
+	if info.state != commonpb.IndexState_InProgress || info.totalRows <= 0 || rowsPerSecond <= 0 {
+		return 0, 0
+	}
+	expected := float64(info.totalRows) / rowsPerSecond
+	elapsed := 0.0
+	if !info.buildStartTime.IsZero() {
+		elapsed = now.Sub(info.buildStartTime).Seconds()
+	}
+	progress := elapsed / expected
+	if progress > maxEstimatedProgress {
+		progress = maxEstimatedProgress
+	}
+	remaining := expected - elapsed
+	if remaining < 1 {
+		// the task runs slower than expected, it's going to finish at any time
+		remaining = 1
+	}
+	return int64(progress * float64(info.totalRows)), int64(remaining)
+}
@@ -0,0 +1,102 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package indexnode
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/milvus-io/milvus-proto/go-api/v2/commonpb"
+)
+
+func TestBuildThroughput(t *testing.T) {
+	throughput := &buildThroughput{}
+	assert.Zero(t, throughput.get())
+
+	throughput.observe(0, time.Second)
+	throughput.observe(100, 0)
+	assert.Zero(t, throughput.get())
+
+	throughput.observe(1000, time.Second)
+	assert.InDelta(t, 1000, throughput.get(), 0.001)
+	throughput.observe(2000, time.Second)
+	assert.InDelta(t, 1300, throughput.get(), 0.001)
+}
+
+func TestEstimateProgress(t *testing.T) {
+	now := time.Now()
+	info := &taskInfo{
+		state:          commonpb.IndexState_InProgress,
+		phase:          indexBuildPhaseBuild,
+		totalRows:      1000,
+		buildStartTime: now.Add(-5 * time.Second),
+	}
+
+	// no finished task to estimate by
+	processed, remaining := estimateProgress(info, 0, now)
+	assert.EqualValues(t, 0, processed)
+	assert.EqualValues(t, 0, remaining)
+
+	processed, remaining = estimateProgress(info, 100, now)
+	assert.EqualValues(t, 500, processed)
+	assert.EqualValues(t, 5, remaining)
+
+	// slower than expected
+	processed, remaining = estimateProgress(info, 10000, now)
+	assert.EqualValues(t, 990, processed)
+	assert.EqualValues(t, 1, remaining)
+
+	// not started building yet
+	info.phase = indexBuildPhasePending
+	info.buildStartTime = time.Time{}
+	processed, remaining = estimateProgress(info, 100, now)
+	assert.EqualValues(t, 0, processed)
+	assert.EqualValues(t, 10, remaining)
+
+	info.state = commonpb.IndexState_Finished
+	processed, remaining = estimateProgress(info, 100, now)
+	assert.EqualValues(t, 1000, processed)
+	assert.EqualValues(t, 0, remaining)
+}
+
+func TestIndexNode_TaskPhase(t *testing.T) {
+	node := &IndexNode{tasks: map[taskKey]*taskInfo{}}
+	node.loadOrStoreTask("cluster", 1, &taskInfo{
+		state:     commonpb.IndexState_InProgress,
+		phase:     indexBuildPhasePending,
+		totalRows: 1000,
+	})
+
+	node.storeTaskPhase("cluster", 1, indexBuildPhasePrepare)
+	node.observeBuildThroughput("cluster", 1)
+	assert.Zero(t, node.buildThroughput.get())
+
+	node.storeTaskPhase("cluster", 1, indexBuildPhaseBuild)
+	info := node.tasks[taskKey{ClusterID: "cluster", BuildID: 1}]
+	assert.Equal(t, indexBuildPhaseBuild, info.phase)
+	assert.False(t, info.buildStartTime.IsZero())
+
+	node.storeTaskPhase("cluster", 1, indexBuildPhaseSave)
+	node.observeBuildThroughput("cluster", 1)
+	assert.Positive(t, node.buildThroughput.get())
+
+	// unknown task
+	node.storeTaskPhase("cluster", 2, indexBuildPhaseBuild)
+	node.observeBuildThroughput("cluster", 2)
+}
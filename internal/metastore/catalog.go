@@ -8,6 +8,7 @@ import (
 	"github.com/milvus-io/milvus/internal/metastore/model"
 	"github.com/milvus-io/milvus/internal/proto/datapb"
 	"github.com/milvus-io/milvus/internal/proto/querypb"
+	"github.com/milvus-io/milvus/pkg/util/crypto"
 	"github.com/milvus-io/milvus/pkg/util/typeutil"
 )
 
@@ -32,6 +33,8 @@ type RootCoordCatalog interface {
 	CreateAlias(ctx context.Context, alias *model.Alias, ts typeutil.Timestamp) error
 	DropAlias(ctx context.Context, dbID int64, alias string, ts typeutil.Timestamp) error
 	AlterAlias(ctx context.Context, alias *model.Alias, ts typeutil.Timestamp) error
+	// AlterAliasWithCollection alters the alias and the collection in one transaction.
+	AlterAliasWithCollection(ctx context.Context, alias *model.Alias, coll *model.Collection, ts typeutil.Timestamp) error
 	ListAliases(ctx context.Context, dbID int64, ts typeutil.Timestamp) ([]*model.Alias, error)
 
 	// GetCredential gets the credential info for the username, returns error if no credential exists for this username.
@@ -78,6 +81,29 @@ type RootCoordCatalog interface {
 	// List all user role pair in string for the tenant
 	// For example []string{"user1/role1"}
 	ListUserRole(ctx context.Context, tenant string) ([]string, error)
+	// AlterRoleInheritance grants or revokes the parent role to the role, the role inherits the privileges of the parent role.
+	// Returns common.IgnorableError
+	// - if the role has inherited the parent role when Grant
+	// - if the role doesn't inherit the parent role when Revoke
+	AlterRoleInheritance(ctx context.Context, tenant string, roleName string, parentRoleName string, operateType milvuspb.OperatePrivilegeType) error
+	// ListRoleInheritance lists the parent roles of each role for the tenant
+	ListRoleInheritance(ctx context.Context, tenant string) (map[string][]string, error)
+
+	// SaveAPIKey creates or overwrites the api key with the same id
+	SaveAPIKey(ctx context.Context, key *crypto.APIKey) error
+	// GetAPIKey returns the api key by id
+	GetAPIKey(ctx context.Context, id string) (*crypto.APIKey, error)
+	// DropAPIKey removes the api key, it's no-op if the key doesn't exist
+	DropAPIKey(ctx context.Context, id string) error
+	// ListAPIKeys lists all the api keys
+	ListAPIKeys(ctx context.Context) ([]*crypto.APIKey, error)
+
+	// SaveCollectionTemplate creates or overwrites the collection template with the same name
+	SaveCollectionTemplate(ctx context.Context, template *model.CollectionTemplate) error
+	// DropCollectionTemplate removes the collection template, it's no-op if the template doesn't exist
+	DropCollectionTemplate(ctx context.Context, name string) error
+	// ListCollectionTemplates lists all the collection templates
+	ListCollectionTemplates(ctx context.Context) ([]*model.CollectionTemplate, error)
 
 	Close()
 }
@@ -136,6 +162,9 @@ type DataCoordCatalog interface {
 	DropSegmentIndex(ctx context.Context, collID, partID, segID, buildID typeutil.UniqueID) error
 
 	GcConfirm(ctx context.Context, collectionID, partitionID typeutil.UniqueID) bool
+
+	SaveStorageMigration(ctx context.Context, migration *model.StorageMigration) error
+	ListStorageMigrations(ctx context.Context) ([]*model.StorageMigration, error)
 }
 
 type QueryCoordCatalog interface {
@@ -152,4 +181,8 @@ type QueryCoordCatalog interface {
 	SaveResourceGroup(rgs ...*querypb.ResourceGroup) error
 	RemoveResourceGroup(rgName string) error
 	GetResourceGroups() ([]*querypb.ResourceGroup, error)
+
+	SaveLoadSchedule(schedule *model.LoadSchedule) error
+	RemoveLoadSchedule(collectionID int64) error
+	GetLoadSchedules() ([]*model.LoadSchedule, error)
 }
@@ -728,6 +728,31 @@ func (kc *Catalog) GcConfirm(ctx context.Context, collectionID, partitionID type
 	return len(keys) == 0 && len(values) == 0
 }
 
+// SaveStorageMigration saves the record of the collection migrated to another storage, the former record is overwritten.
+func (kc *Catalog) SaveStorageMigration(ctx context.Context, migration *model.StorageMigration) error {
+	value, err := model.MarshalStorageMigrationModel(migration)
+	if err != nil {
+		return err
+	}
+	return kc.MetaKv.Save(buildStorageMigrationKey(migration.CollectionID), string(value))
+}
+
+func (kc *Catalog) ListStorageMigrations(ctx context.Context) ([]*model.StorageMigration, error) {
+	_, values, err := kc.MetaKv.LoadWithPrefix(StorageMigrationPrefix)
+	if err != nil {
+		return nil, err
+	}
+	migrations := make([]*model.StorageMigration, 0, len(values))
+	for _, value := range values {
+		migration, err := model.UnmarshalStorageMigrationModel([]byte(value))
+		if err != nil {
+			return nil, err
+		}
+		migrations = append(migrations, migration)
+	}
+	return migrations, nil
+}
+
 func fillLogPathByLogID(chunkManagerRootPath string, binlogType storage.BinlogType, collectionID, partitionID,
 	segmentID typeutil.UniqueID, fieldBinlog *datapb.FieldBinlog,
 ) {
@@ -781,8 +806,9 @@ func CompressBinLog(fieldBinLogs []*datapb.FieldBinlog) ([]*datapb.FieldBinlog,
 			binlog := &datapb.Binlog{
 				EntriesNum: binlog.EntriesNum,
 				// remove timestamp since it's not necessary
-				LogSize: binlog.LogSize,
-				LogID:   logID,
+				LogSize:  binlog.LogSize,
+				LogID:    logID,
+				Checksum: binlog.Checksum,
 			}
 			compressedFieldBinLog.Binlogs = append(compressedFieldBinLog.Binlogs, binlog)
 		}
@@ -1052,6 +1078,10 @@ func buildChannelCPKey(vChannel string) string {
 	return fmt.Sprintf("%s/%s", ChannelCheckpointPrefix, vChannel)
 }
 
+func buildStorageMigrationKey(collectionID typeutil.UniqueID) string {
+	return fmt.Sprintf("%s/%d", StorageMigrationPrefix, collectionID)
+}
+
 func BuildIndexKey(collectionID, indexID int64) string {
 	return fmt.Sprintf("%s/%d/%d", util.FieldIndexPrefix, collectionID, indexID)
 }
@@ -1287,3 +1287,35 @@ func TestCatalog_GcConfirm(t *testing.T) {
 		Return(nil, nil, nil)
 	assert.True(t, kc.GcConfirm(context.TODO(), 100, 10000))
 }
+
+func TestCatalog_StorageMigration(t *testing.T) {
+	kc := &Catalog{}
+	txn := mocks.NewMetaKv(t)
+	kc.MetaKv = txn
+
+	migration := &model.StorageMigration{
+		CollectionID: collectionID,
+		StorageType:  "minio",
+		BucketName:   "new-bucket",
+		RootPath:     "files",
+		SegmentIDs:   []int64{segmentID, segmentID2},
+		FileNum:      10,
+	}
+	value, err := model.MarshalStorageMigrationModel(migration)
+	assert.NoError(t, err)
+	txn.EXPECT().Save(buildStorageMigrationKey(collectionID), string(value)).Return(nil).Once()
+	assert.NoError(t, kc.SaveStorageMigration(context.TODO(), migration))
+
+	txn.EXPECT().LoadWithPrefix(StorageMigrationPrefix).Return([]string{buildStorageMigrationKey(collectionID)}, []string{string(value)}, nil).Once()
+	migrations, err := kc.ListStorageMigrations(context.TODO())
+	assert.NoError(t, err)
+	assert.Equal(t, []*model.StorageMigration{migration}, migrations)
+
+	txn.EXPECT().LoadWithPrefix(StorageMigrationPrefix).Return([]string{"key"}, []string{"invalid"}, nil).Once()
+	_, err = kc.ListStorageMigrations(context.TODO())
+	assert.Error(t, err)
+
+	txn.EXPECT().LoadWithPrefix(StorageMigrationPrefix).Return(nil, nil, errors.New("mock")).Once()
+	_, err = kc.ListStorageMigrations(context.TODO())
+	assert.Error(t, err)
+}
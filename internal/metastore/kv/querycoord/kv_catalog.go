@@ -9,6 +9,7 @@ import (
 
 	"github.com/milvus-io/milvus-proto/go-api/v2/milvuspb"
 	"github.com/milvus-io/milvus/internal/kv"
+	"github.com/milvus-io/milvus/internal/metastore/model"
 	"github.com/milvus-io/milvus/internal/proto/querypb"
 )
 
@@ -21,6 +22,7 @@ const (
 	CollectionMetaPrefixV1   = "queryCoord-collectionMeta"
 	ReplicaMetaPrefixV1      = "queryCoord-ReplicaMeta"
 	ResourceGroupPrefix      = "queryCoord-ResourceGroup"
+	LoadSchedulePrefix       = "querycoord-load-schedule"
 )
 
 type Catalog struct {
@@ -229,6 +231,35 @@ func (s Catalog) ReleaseReplica(collection, replica int64) error {
 	return s.cli.Remove(key)
 }
 
+func (s Catalog) SaveLoadSchedule(schedule *model.LoadSchedule) error {
+	v, err := model.MarshalLoadScheduleModel(schedule)
+	if err != nil {
+		return err
+	}
+	return s.cli.Save(encodeLoadScheduleKey(schedule.CollectionID), string(v))
+}
+
+func (s Catalog) RemoveLoadSchedule(collectionID int64) error {
+	return s.cli.Remove(encodeLoadScheduleKey(collectionID))
+}
+
+func (s Catalog) GetLoadSchedules() ([]*model.LoadSchedule, error) {
+	_, values, err := s.cli.LoadWithPrefix(LoadSchedulePrefix)
+	if err != nil {
+		return nil, err
+	}
+
+	ret := make([]*model.LoadSchedule, 0, len(values))
+	for _, value := range values {
+		schedule, err := model.UnmarshalLoadScheduleModel([]byte(value))
+		if err != nil {
+			return nil, err
+		}
+		ret = append(ret, schedule)
+	}
+	return ret, nil
+}
+
 func EncodeCollectionLoadInfoKey(collection int64) string {
 	return fmt.Sprintf("%s/%d", CollectionLoadInfoPrefix, collection)
 }
@@ -248,3 +279,7 @@ func encodeCollectionReplicaKey(collection int64) string {
 func encodeResourceGroupKey(rgName string) string {
 	return fmt.Sprintf("%s/%s", ResourceGroupPrefix, rgName)
 }
+
+func encodeLoadScheduleKey(collection int64) string {
+	return fmt.Sprintf("%s/%d", LoadSchedulePrefix, collection)
+}
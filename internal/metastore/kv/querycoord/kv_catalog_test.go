@@ -8,6 +8,7 @@ import (
 
 	"github.com/milvus-io/milvus/internal/kv"
 	etcdkv "github.com/milvus-io/milvus/internal/kv/etcd"
+	"github.com/milvus-io/milvus/internal/metastore/model"
 	"github.com/milvus-io/milvus/internal/proto/querypb"
 	. "github.com/milvus-io/milvus/internal/querycoordv2/params"
 	"github.com/milvus-io/milvus/pkg/util/etcd"
@@ -182,6 +183,47 @@ func (suite *CatalogTestSuite) TestResourceGroup() {
 	suite.Equal([]int64{4, 5}, groups[1].GetNodes())
 }
 
+func (suite *CatalogTestSuite) TestLoadSchedule() {
+	suite.NoError(suite.catalog.SaveLoadSchedule(&model.LoadSchedule{
+		CollectionID:  1,
+		LoadSpec:      "0 9 * * 1-5",
+		ReleaseSpec:   "0 18 * * 1-5",
+		ReplicaNumber: 1,
+	}))
+	suite.NoError(suite.catalog.SaveLoadSchedule(&model.LoadSchedule{
+		CollectionID:   2,
+		LoadSpec:       "0 0 * * *",
+		ReleaseSpec:    "0 6 * * *",
+		Timezone:       "Asia/Shanghai",
+		ReplicaNumber:  2,
+		ResourceGroups: []string{"rg1"},
+	}))
+	suite.NoError(suite.catalog.SaveLoadSchedule(&model.LoadSchedule{
+		CollectionID:    1,
+		LoadSpec:        "0 9 * * 1-5",
+		ReleaseSpec:     "0 18 * * 1-5",
+		ReplicaNumber:   1,
+		LastTriggerTime: 100,
+	}))
+	suite.NoError(suite.catalog.SaveLoadSchedule(&model.LoadSchedule{
+		CollectionID: 3,
+		LoadSpec:     "0 9 * * *",
+		ReleaseSpec:  "0 18 * * *",
+	}))
+	suite.NoError(suite.catalog.RemoveLoadSchedule(3))
+
+	schedules, err := suite.catalog.GetLoadSchedules()
+	suite.NoError(err)
+	suite.Len(schedules, 2)
+	sort.Slice(schedules, func(i, j int) bool {
+		return schedules[i].CollectionID < schedules[j].CollectionID
+	})
+	suite.EqualValues(100, schedules[0].LastTriggerTime)
+	suite.Equal("Asia/Shanghai", schedules[1].Timezone)
+	suite.EqualValues(2, schedules[1].ReplicaNumber)
+	suite.Equal([]string{"rg1"}, schedules[1].ResourceGroups)
+}
+
 func (suite *CatalogTestSuite) TestLoadRelease() {
 	// TODO(sunby): add ut
 }
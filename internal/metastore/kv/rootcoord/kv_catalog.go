@@ -15,7 +15,6 @@ import (
 	"github.com/milvus-io/milvus/internal/metastore"
 	"github.com/milvus-io/milvus/internal/metastore/model"
 	pb "github.com/milvus-io/milvus/internal/proto/etcdpb"
-	"github.com/milvus-io/milvus/internal/proto/internalpb"
 	"github.com/milvus-io/milvus/pkg/common"
 	"github.com/milvus-io/milvus/pkg/log"
 	"github.com/milvus-io/milvus/pkg/util"
@@ -283,7 +282,13 @@ func (kc *Catalog) CreateAlias(ctx context.Context, alias *model.Alias, ts typeu
 
 func (kc *Catalog) CreateCredential(ctx context.Context, credential *model.Credential) error {
 	k := fmt.Sprintf("%s/%s", CredentialPrefix, credential.Username)
-	v, err := json.Marshal(&internalpb.CredentialInfo{EncryptedPassword: credential.EncryptedPassword})
+	v, err := json.Marshal(&crypto.CredentialState{
+		EncryptedPassword:          credential.EncryptedPassword,
+		PreviousPassword:           credential.PreviousPassword,
+		PreviousPasswordExpireTime: credential.PreviousPasswordExpireTime,
+		PasswordHistory:            credential.PasswordHistory,
+		UpdatedTime:                credential.UpdatedTime,
+	})
 	if err != nil {
 		log.Error("create credential marshal fail", zap.String("key", k), zap.Error(err))
 		return err
@@ -392,19 +397,48 @@ func (kc *Catalog) GetCredential(ctx context.Context, username string) (*model.C
 		return nil, err
 	}
 
-	credentialInfo := internalpb.CredentialInfo{}
-	err = json.Unmarshal([]byte(v), &credentialInfo)
+	// compatible with the credential info persisted before the password policy is supported
+	state := crypto.CredentialState{}
+	err = json.Unmarshal([]byte(v), &state)
 	if err != nil {
 		return nil, fmt.Errorf("unmarshal credential info err:%w", err)
 	}
 
-	return &model.Credential{Username: username, EncryptedPassword: credentialInfo.EncryptedPassword}, nil
+	return &model.Credential{
+		Username:                   username,
+		EncryptedPassword:          state.EncryptedPassword,
+		PreviousPassword:           state.PreviousPassword,
+		PreviousPasswordExpireTime: state.PreviousPasswordExpireTime,
+		PasswordHistory:            state.PasswordHistory,
+		UpdatedTime:                state.UpdatedTime,
+	}, nil
 }
 
 func (kc *Catalog) AlterAlias(ctx context.Context, alias *model.Alias, ts typeutil.Timestamp) error {
 	return kc.CreateAlias(ctx, alias, ts)
 }
 
+// AlterAliasWithCollection saves the alias and the collection in one transaction,
+// e.g. repoints the alias and marks the collection it pointed to previously dropping.
+func (kc *Catalog) AlterAliasWithCollection(ctx context.Context, alias *model.Alias, coll *model.Collection, ts typeutil.Timestamp) error {
+	if alias.DbID != coll.DBID {
+		return fmt.Errorf("altering alias with collection of another database is forbidden")
+	}
+	aliasValue, err := proto.Marshal(model.MarshalAliasModel(alias))
+	if err != nil {
+		return err
+	}
+	collValue, err := proto.Marshal(model.MarshalCollectionModel(coll))
+	if err != nil {
+		return err
+	}
+	kvs := map[string]string{
+		BuildAliasKeyWithDB(alias.DbID, alias.Name):      string(aliasValue),
+		BuildCollectionKey(coll.DBID, coll.CollectionID): string(collValue),
+	}
+	return kc.Snapshot.MultiSaveAndRemoveWithPrefix(kvs, []string{BuildAliasKey210(alias.Name), BuildAliasKey(alias.Name)}, ts)
+}
+
 func (kc *Catalog) DropCollection(ctx context.Context, collectionInfo *model.Collection, ts typeutil.Timestamp) error {
 	collectionKeys := []string{BuildCollectionKey(collectionInfo.DBID, collectionInfo.CollectionID)}
 
@@ -455,6 +489,7 @@ func (kc *Catalog) alterModifyCollection(oldColl *model.Collection, newColl *mod
 	oldCollClone.CreateTime = newColl.CreateTime
 	oldCollClone.ConsistencyLevel = newColl.ConsistencyLevel
 	oldCollClone.State = newColl.State
+	oldCollClone.Properties = newColl.Properties
 
 	oldKey := BuildCollectionKey(oldColl.DBID, oldColl.CollectionID)
 	newKey := BuildCollectionKey(newColl.DBID, oldColl.CollectionID)
@@ -1167,6 +1202,143 @@ func (kc *Catalog) ListUserRole(ctx context.Context, tenant string) ([]string, e
 	return userRoles, nil
 }
 
+func (kc *Catalog) AlterRoleInheritance(ctx context.Context, tenant string, roleName string, parentRoleName string, operateType milvuspb.OperatePrivilegeType) error {
+	k := funcutil.HandleTenantForEtcdKey(RoleInheritancePrefix, tenant, fmt.Sprintf("%s/%s", roleName, parentRoleName))
+	var err error
+	switch {
+	case funcutil.IsGrant(operateType):
+		err = kc.save(k)
+	case funcutil.IsRevoke(operateType):
+		err = kc.remove(k)
+	default:
+		err = fmt.Errorf("invalid operate role inheritance type, operate type: %d", operateType)
+	}
+	if err != nil && !common.IsIgnorableError(err) {
+		log.Error("fail to alter the role inheritance", zap.String("key", k), zap.Any("type", operateType), zap.Error(err))
+	}
+	return err
+}
+
+func (kc *Catalog) ListRoleInheritance(ctx context.Context, tenant string) (map[string][]string, error) {
+	inheritances := make(map[string][]string)
+	k := funcutil.HandleTenantForEtcdKey(RoleInheritancePrefix, tenant, "")
+	keys, _, err := kc.Txn.LoadWithPrefix(k)
+	if err != nil {
+		log.Error("fail to load all role inheritances", zap.String("key", k), zap.Error(err))
+		return nil, err
+	}
+
+	for _, key := range keys {
+		inheritanceInfos := typeutil.AfterN(key, k+"/", "/")
+		if len(inheritanceInfos) != 2 {
+			log.Warn("invalid role inheritance key", zap.String("string", key), zap.String("sub_string", k))
+			continue
+		}
+		inheritances[inheritanceInfos[0]] = append(inheritances[inheritanceInfos[0]], inheritanceInfos[1])
+	}
+	return inheritances, nil
+}
+
+func (kc *Catalog) SaveAPIKey(ctx context.Context, key *crypto.APIKey) error {
+	k := fmt.Sprintf("%s/%s", APIKeyPrefix, key.ID)
+	v, err := crypto.EncodeAPIKey(key)
+	if err != nil {
+		log.Error("fail to marshal the api key", zap.String("id", key.ID), zap.Error(err))
+		return err
+	}
+	if err := kc.Txn.Save(k, v); err != nil {
+		log.Error("fail to save the api key", zap.String("key", k), zap.Error(err))
+		return err
+	}
+	return nil
+}
+
+func (kc *Catalog) GetAPIKey(ctx context.Context, id string) (*crypto.APIKey, error) {
+	k := fmt.Sprintf("%s/%s", APIKeyPrefix, id)
+	v, err := kc.Txn.Load(k)
+	if err != nil {
+		if errors.Is(err, merr.ErrIoKeyNotFound) {
+			log.Debug("not found the api key", zap.String("key", k))
+		} else {
+			log.Warn("fail to load the api key", zap.String("key", k), zap.Error(err))
+		}
+		return nil, err
+	}
+	key, err := crypto.DecodeAPIKey(v)
+	if err != nil {
+		return nil, fmt.Errorf("unmarshal api key err:%w", err)
+	}
+	return key, nil
+}
+
+func (kc *Catalog) DropAPIKey(ctx context.Context, id string) error {
+	k := fmt.Sprintf("%s/%s", APIKeyPrefix, id)
+	if err := kc.Txn.Remove(k); err != nil {
+		log.Error("fail to remove the api key", zap.String("key", k), zap.Error(err))
+		return err
+	}
+	return nil
+}
+
+func (kc *Catalog) ListAPIKeys(ctx context.Context) ([]*crypto.APIKey, error) {
+	_, values, err := kc.Txn.LoadWithPrefix(APIKeyPrefix + "/")
+	if err != nil {
+		log.Error("fail to load all api keys", zap.Error(err))
+		return nil, err
+	}
+	keys := make([]*crypto.APIKey, 0, len(values))
+	for _, value := range values {
+		key, err := crypto.DecodeAPIKey(value)
+		if err != nil {
+			log.Error("fail to unmarshal the api key", zap.Error(err))
+			return nil, err
+		}
+		keys = append(keys, key)
+	}
+	return keys, nil
+}
+
+func (kc *Catalog) SaveCollectionTemplate(ctx context.Context, template *model.CollectionTemplate) error {
+	k := fmt.Sprintf("%s/%s", CollectionTemplatePrefix, template.Name)
+	v, err := model.MarshalCollectionTemplateModel(template)
+	if err != nil {
+		log.Error("fail to marshal the collection template", zap.String("name", template.Name), zap.Error(err))
+		return err
+	}
+	if err := kc.Txn.Save(k, string(v)); err != nil {
+		log.Error("fail to save the collection template", zap.String("key", k), zap.Error(err))
+		return err
+	}
+	return nil
+}
+
+func (kc *Catalog) DropCollectionTemplate(ctx context.Context, name string) error {
+	k := fmt.Sprintf("%s/%s", CollectionTemplatePrefix, name)
+	if err := kc.Txn.Remove(k); err != nil {
+		log.Error("fail to remove the collection template", zap.String("key", k), zap.Error(err))
+		return err
+	}
+	return nil
+}
+
+func (kc *Catalog) ListCollectionTemplates(ctx context.Context) ([]*model.CollectionTemplate, error) {
+	_, values, err := kc.Txn.LoadWithPrefix(CollectionTemplatePrefix + "/")
+	if err != nil {
+		log.Error("fail to load all collection templates", zap.Error(err))
+		return nil, err
+	}
+	templates := make([]*model.CollectionTemplate, 0, len(values))
+	for _, value := range values {
+		template, err := model.UnmarshalCollectionTemplateModel([]byte(value))
+		if err != nil {
+			log.Error("fail to unmarshal the collection template", zap.Error(err))
+			return nil, err
+		}
+		templates = append(templates, template)
+	}
+	return templates, nil
+}
+
 func (kc *Catalog) Close() {
 	// do nothing
 }
@@ -633,6 +633,28 @@ func TestCatalog_AlterAliasV2(t *testing.T) {
 	assert.NoError(t, err)
 }
 
+func TestCatalog_AlterAliasWithCollection(t *testing.T) {
+	ctx := context.Background()
+
+	snapshot := kv.NewMockSnapshotKV()
+	kc := Catalog{Snapshot: snapshot}
+
+	err := kc.AlterAliasWithCollection(ctx, &model.Alias{Name: "alias", DbID: 1}, &model.Collection{CollectionID: 100, DBID: 2}, 0)
+	assert.Error(t, err)
+
+	var saved map[string]string
+	snapshot.MultiSaveAndRemoveWithPrefixFunc = func(saves map[string]string, removals []string, ts typeutil.Timestamp) error {
+		saved = saves
+		return nil
+	}
+	err = kc.AlterAliasWithCollection(ctx, &model.Alias{Name: "alias", DbID: 1, CollectionID: 101},
+		&model.Collection{CollectionID: 100, DBID: 1, State: pb.CollectionState_CollectionDropping}, 0)
+	assert.NoError(t, err)
+	assert.Len(t, saved, 2)
+	assert.Contains(t, saved, BuildAliasKeyWithDB(1, "alias"))
+	assert.Contains(t, saved, BuildCollectionKey(1, 100))
+}
+
 func Test_dropPartition(t *testing.T) {
 	t.Run("nil, won't panic", func(t *testing.T) {
 		dropPartition(nil, 1)
@@ -1358,6 +1380,35 @@ func TestRBAC_Credential(t *testing.T) {
 		}
 	})
 
+	t.Run("test credential password state", func(t *testing.T) {
+		var (
+			kvmock = mocks.NewTxnKV(t)
+			c      = &Catalog{Txn: kvmock}
+			key    = fmt.Sprintf("%s/%s", CredentialPrefix, "user1")
+			saved  string
+		)
+
+		kvmock.EXPECT().Save(key, mock.Anything).Run(func(_ string, value string) {
+			saved = value
+		}).Return(nil)
+		kvmock.EXPECT().Load(key).Call.Return(func(string) string { return saved }, nil)
+
+		credential := &model.Credential{
+			Username:                   "user1",
+			EncryptedPassword:          "pwd",
+			PreviousPassword:           "previous",
+			PreviousPasswordExpireTime: 100,
+			PasswordHistory:            []string{"previous", "oldest"},
+			UpdatedTime:                10,
+		}
+		err := c.AlterCredential(ctx, credential)
+		assert.NoError(t, err)
+
+		cre, err := c.GetCredential(ctx, "user1")
+		assert.NoError(t, err)
+		assert.Equal(t, credential, cre)
+	})
+
 	t.Run("test DropCredential", func(t *testing.T) {
 		var (
 			kvmock = mocks.NewTxnKV(t)
@@ -49,6 +49,15 @@ const (
 
 	// GranteeIDPrefix prefix for mapping among privilege and grantor
 	GranteeIDPrefix = ComponentPrefix + CommonCredentialPrefix + "/grantee-id"
+
+	// RoleInheritancePrefix prefix for mapping between role and the parent role it inherits
+	RoleInheritancePrefix = ComponentPrefix + CommonCredentialPrefix + "/role-inheritance"
+
+	// APIKeyPrefix prefix for api key
+	APIKeyPrefix = ComponentPrefix + CommonCredentialPrefix + "/api-keys"
+
+	// CollectionTemplatePrefix prefix for collection template
+	CollectionTemplatePrefix = ComponentPrefix + "/collection-template"
 )
 
 func BuildDatabasePrefixWithDBID(dbID int64) string {
@@ -3,6 +3,7 @@
 package mocks
 
 import (
+	model "github.com/milvus-io/milvus/internal/metastore/model"
 	querypb "github.com/milvus-io/milvus/internal/proto/querypb"
 	mock "github.com/stretchr/testify/mock"
 )
@@ -73,6 +74,59 @@ func (_c *QueryCoordCatalog_GetCollections_Call) RunAndReturn(run func() ([]*que
 	return _c
 }
 
+// GetLoadSchedules provides a mock function with given fields:
+func (_m *QueryCoordCatalog) GetLoadSchedules() ([]*model.LoadSchedule, error) {
+	ret := _m.Called()
+
+	var r0 []*model.LoadSchedule
+	var r1 error
+	if rf, ok := ret.Get(0).(func() ([]*model.LoadSchedule, error)); ok {
+		return rf()
+	}
+	if rf, ok := ret.Get(0).(func() []*model.LoadSchedule); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*model.LoadSchedule)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func() error); ok {
+		r1 = rf()
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// QueryCoordCatalog_GetLoadSchedules_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetLoadSchedules'
+type QueryCoordCatalog_GetLoadSchedules_Call struct {
+	*mock.Call
+}
+
+// GetLoadSchedules is a helper method to define mock.On call
+func (_e *QueryCoordCatalog_Expecter) GetLoadSchedules() *QueryCoordCatalog_GetLoadSchedules_Call {
+	return &QueryCoordCatalog_GetLoadSchedules_Call{Call: _e.mock.On("GetLoadSchedules")}
+}
+
+func (_c *QueryCoordCatalog_GetLoadSchedules_Call) Run(run func()) *QueryCoordCatalog_GetLoadSchedules_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *QueryCoordCatalog_GetLoadSchedules_Call) Return(_a0 []*model.LoadSchedule, _a1 error) *QueryCoordCatalog_GetLoadSchedules_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *QueryCoordCatalog_GetLoadSchedules_Call) RunAndReturn(run func() ([]*model.LoadSchedule, error)) *QueryCoordCatalog_GetLoadSchedules_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // GetPartitions provides a mock function with given fields:
 func (_m *QueryCoordCatalog) GetPartitions() (map[int64][]*querypb.PartitionLoadInfo, error) {
 	ret := _m.Called()
@@ -416,6 +470,48 @@ func (_c *QueryCoordCatalog_ReleaseReplicas_Call) RunAndReturn(run func(int64) e
 	return _c
 }
 
+// RemoveLoadSchedule provides a mock function with given fields: collectionID
+func (_m *QueryCoordCatalog) RemoveLoadSchedule(collectionID int64) error {
+	ret := _m.Called(collectionID)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(int64) error); ok {
+		r0 = rf(collectionID)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// QueryCoordCatalog_RemoveLoadSchedule_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RemoveLoadSchedule'
+type QueryCoordCatalog_RemoveLoadSchedule_Call struct {
+	*mock.Call
+}
+
+// RemoveLoadSchedule is a helper method to define mock.On call
+//   - collectionID int64
+func (_e *QueryCoordCatalog_Expecter) RemoveLoadSchedule(collectionID interface{}) *QueryCoordCatalog_RemoveLoadSchedule_Call {
+	return &QueryCoordCatalog_RemoveLoadSchedule_Call{Call: _e.mock.On("RemoveLoadSchedule", collectionID)}
+}
+
+func (_c *QueryCoordCatalog_RemoveLoadSchedule_Call) Run(run func(collectionID int64)) *QueryCoordCatalog_RemoveLoadSchedule_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(int64))
+	})
+	return _c
+}
+
+func (_c *QueryCoordCatalog_RemoveLoadSchedule_Call) Return(_a0 error) *QueryCoordCatalog_RemoveLoadSchedule_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *QueryCoordCatalog_RemoveLoadSchedule_Call) RunAndReturn(run func(int64) error) *QueryCoordCatalog_RemoveLoadSchedule_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // RemoveResourceGroup provides a mock function with given fields: rgName
 func (_m *QueryCoordCatalog) RemoveResourceGroup(rgName string) error {
 	ret := _m.Called(rgName)
@@ -515,6 +611,48 @@ func (_c *QueryCoordCatalog_SaveCollection_Call) RunAndReturn(run func(*querypb.
 	return _c
 }
 
+// SaveLoadSchedule provides a mock function with given fields: schedule
+func (_m *QueryCoordCatalog) SaveLoadSchedule(schedule *model.LoadSchedule) error {
+	ret := _m.Called(schedule)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(*model.LoadSchedule) error); ok {
+		r0 = rf(schedule)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// QueryCoordCatalog_SaveLoadSchedule_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SaveLoadSchedule'
+type QueryCoordCatalog_SaveLoadSchedule_Call struct {
+	*mock.Call
+}
+
+// SaveLoadSchedule is a helper method to define mock.On call
+//   - schedule *model.LoadSchedule
+func (_e *QueryCoordCatalog_Expecter) SaveLoadSchedule(schedule interface{}) *QueryCoordCatalog_SaveLoadSchedule_Call {
+	return &QueryCoordCatalog_SaveLoadSchedule_Call{Call: _e.mock.On("SaveLoadSchedule", schedule)}
+}
+
+func (_c *QueryCoordCatalog_SaveLoadSchedule_Call) Run(run func(schedule *model.LoadSchedule)) *QueryCoordCatalog_SaveLoadSchedule_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(*model.LoadSchedule))
+	})
+	return _c
+}
+
+func (_c *QueryCoordCatalog_SaveLoadSchedule_Call) Return(_a0 error) *QueryCoordCatalog_SaveLoadSchedule_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *QueryCoordCatalog_SaveLoadSchedule_Call) RunAndReturn(run func(*model.LoadSchedule) error) *QueryCoordCatalog_SaveLoadSchedule_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // SavePartition provides a mock function with given fields: info
 func (_m *QueryCoordCatalog) SavePartition(info ...*querypb.PartitionLoadInfo) error {
 	_va := make([]interface{}, len(info))
@@ -5,6 +5,8 @@ package mocks
 import (
 	context "context"
 
+	crypto "github.com/milvus-io/milvus/pkg/util/crypto"
+
 	milvuspb "github.com/milvus-io/milvus-proto/go-api/v2/milvuspb"
 	metastore "github.com/milvus-io/milvus/internal/metastore"
 
@@ -70,6 +72,51 @@ func (_c *RootCoordCatalog_AlterAlias_Call) RunAndReturn(run func(context.Contex
 	return _c
 }
 
+// AlterAliasWithCollection provides a mock function with given fields: ctx, alias, coll, ts
+func (_m *RootCoordCatalog) AlterAliasWithCollection(ctx context.Context, alias *model.Alias, coll *model.Collection, ts uint64) error {
+	ret := _m.Called(ctx, alias, coll, ts)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, *model.Alias, *model.Collection, uint64) error); ok {
+		r0 = rf(ctx, alias, coll, ts)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// RootCoordCatalog_AlterAliasWithCollection_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'AlterAliasWithCollection'
+type RootCoordCatalog_AlterAliasWithCollection_Call struct {
+	*mock.Call
+}
+
+// AlterAliasWithCollection is a helper method to define mock.On call
+//   - ctx context.Context
+//   - alias *model.Alias
+//   - coll *model.Collection
+//   - ts uint64
+func (_e *RootCoordCatalog_Expecter) AlterAliasWithCollection(ctx interface{}, alias interface{}, coll interface{}, ts interface{}) *RootCoordCatalog_AlterAliasWithCollection_Call {
+	return &RootCoordCatalog_AlterAliasWithCollection_Call{Call: _e.mock.On("AlterAliasWithCollection", ctx, alias, coll, ts)}
+}
+
+func (_c *RootCoordCatalog_AlterAliasWithCollection_Call) Run(run func(ctx context.Context, alias *model.Alias, coll *model.Collection, ts uint64)) *RootCoordCatalog_AlterAliasWithCollection_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*model.Alias), args[2].(*model.Collection), args[3].(uint64))
+	})
+	return _c
+}
+
+func (_c *RootCoordCatalog_AlterAliasWithCollection_Call) Return(_a0 error) *RootCoordCatalog_AlterAliasWithCollection_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *RootCoordCatalog_AlterAliasWithCollection_Call) RunAndReturn(run func(context.Context, *model.Alias, *model.Collection, uint64) error) *RootCoordCatalog_AlterAliasWithCollection_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // AlterCollection provides a mock function with given fields: ctx, oldColl, newColl, alterType, ts
 func (_m *RootCoordCatalog) AlterCollection(ctx context.Context, oldColl *model.Collection, newColl *model.Collection, alterType metastore.AlterType, ts uint64) error {
 	ret := _m.Called(ctx, oldColl, newColl, alterType, ts)
@@ -251,6 +298,52 @@ func (_c *RootCoordCatalog_AlterPartition_Call) RunAndReturn(run func(context.Co
 	return _c
 }
 
+// AlterRoleInheritance provides a mock function with given fields: ctx, tenant, roleName, parentRoleName, operateType
+func (_m *RootCoordCatalog) AlterRoleInheritance(ctx context.Context, tenant string, roleName string, parentRoleName string, operateType milvuspb.OperatePrivilegeType) error {
+	ret := _m.Called(ctx, tenant, roleName, parentRoleName, operateType)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, string, milvuspb.OperatePrivilegeType) error); ok {
+		r0 = rf(ctx, tenant, roleName, parentRoleName, operateType)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// RootCoordCatalog_AlterRoleInheritance_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'AlterRoleInheritance'
+type RootCoordCatalog_AlterRoleInheritance_Call struct {
+	*mock.Call
+}
+
+// AlterRoleInheritance is a helper method to define mock.On call
+//   - ctx context.Context
+//   - tenant string
+//   - roleName string
+//   - parentRoleName string
+//   - operateType milvuspb.OperatePrivilegeType
+func (_e *RootCoordCatalog_Expecter) AlterRoleInheritance(ctx interface{}, tenant interface{}, roleName interface{}, parentRoleName interface{}, operateType interface{}) *RootCoordCatalog_AlterRoleInheritance_Call {
+	return &RootCoordCatalog_AlterRoleInheritance_Call{Call: _e.mock.On("AlterRoleInheritance", ctx, tenant, roleName, parentRoleName, operateType)}
+}
+
+func (_c *RootCoordCatalog_AlterRoleInheritance_Call) Run(run func(ctx context.Context, tenant string, roleName string, parentRoleName string, operateType milvuspb.OperatePrivilegeType)) *RootCoordCatalog_AlterRoleInheritance_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(string), args[3].(string), args[4].(milvuspb.OperatePrivilegeType))
+	})
+	return _c
+}
+
+func (_c *RootCoordCatalog_AlterRoleInheritance_Call) Return(_a0 error) *RootCoordCatalog_AlterRoleInheritance_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *RootCoordCatalog_AlterRoleInheritance_Call) RunAndReturn(run func(context.Context, string, string, string, milvuspb.OperatePrivilegeType) error) *RootCoordCatalog_AlterRoleInheritance_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // AlterUserRole provides a mock function with given fields: ctx, tenant, userEntity, roleEntity, operateType
 func (_m *RootCoordCatalog) AlterUserRole(ctx context.Context, tenant string, userEntity *milvuspb.UserEntity, roleEntity *milvuspb.RoleEntity, operateType milvuspb.OperateUserRoleType) error {
 	ret := _m.Called(ctx, tenant, userEntity, roleEntity, operateType)
@@ -682,6 +775,49 @@ func (_c *RootCoordCatalog_DeleteGrant_Call) RunAndReturn(run func(context.Conte
 	return _c
 }
 
+// DropAPIKey provides a mock function with given fields: ctx, id
+func (_m *RootCoordCatalog) DropAPIKey(ctx context.Context, id string) error {
+	ret := _m.Called(ctx, id)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) error); ok {
+		r0 = rf(ctx, id)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// RootCoordCatalog_DropAPIKey_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DropAPIKey'
+type RootCoordCatalog_DropAPIKey_Call struct {
+	*mock.Call
+}
+
+// DropAPIKey is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id string
+func (_e *RootCoordCatalog_Expecter) DropAPIKey(ctx interface{}, id interface{}) *RootCoordCatalog_DropAPIKey_Call {
+	return &RootCoordCatalog_DropAPIKey_Call{Call: _e.mock.On("DropAPIKey", ctx, id)}
+}
+
+func (_c *RootCoordCatalog_DropAPIKey_Call) Run(run func(ctx context.Context, id string)) *RootCoordCatalog_DropAPIKey_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *RootCoordCatalog_DropAPIKey_Call) Return(_a0 error) *RootCoordCatalog_DropAPIKey_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *RootCoordCatalog_DropAPIKey_Call) RunAndReturn(run func(context.Context, string) error) *RootCoordCatalog_DropAPIKey_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // DropAlias provides a mock function with given fields: ctx, dbID, alias, ts
 func (_m *RootCoordCatalog) DropAlias(ctx context.Context, dbID int64, alias string, ts uint64) error {
 	ret := _m.Called(ctx, dbID, alias, ts)
@@ -771,6 +907,49 @@ func (_c *RootCoordCatalog_DropCollection_Call) RunAndReturn(run func(context.Co
 	return _c
 }
 
+// DropCollectionTemplate provides a mock function with given fields: ctx, name
+func (_m *RootCoordCatalog) DropCollectionTemplate(ctx context.Context, name string) error {
+	ret := _m.Called(ctx, name)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) error); ok {
+		r0 = rf(ctx, name)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// RootCoordCatalog_DropCollectionTemplate_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DropCollectionTemplate'
+type RootCoordCatalog_DropCollectionTemplate_Call struct {
+	*mock.Call
+}
+
+// DropCollectionTemplate is a helper method to define mock.On call
+//   - ctx context.Context
+//   - name string
+func (_e *RootCoordCatalog_Expecter) DropCollectionTemplate(ctx interface{}, name interface{}) *RootCoordCatalog_DropCollectionTemplate_Call {
+	return &RootCoordCatalog_DropCollectionTemplate_Call{Call: _e.mock.On("DropCollectionTemplate", ctx, name)}
+}
+
+func (_c *RootCoordCatalog_DropCollectionTemplate_Call) Run(run func(ctx context.Context, name string)) *RootCoordCatalog_DropCollectionTemplate_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *RootCoordCatalog_DropCollectionTemplate_Call) Return(_a0 error) *RootCoordCatalog_DropCollectionTemplate_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *RootCoordCatalog_DropCollectionTemplate_Call) RunAndReturn(run func(context.Context, string) error) *RootCoordCatalog_DropCollectionTemplate_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // DropCredential provides a mock function with given fields: ctx, username
 func (_m *RootCoordCatalog) DropCredential(ctx context.Context, username string) error {
 	ret := _m.Called(ctx, username)
@@ -948,6 +1127,61 @@ func (_c *RootCoordCatalog_DropRole_Call) RunAndReturn(run func(context.Context,
 	return _c
 }
 
+// GetAPIKey provides a mock function with given fields: ctx, id
+func (_m *RootCoordCatalog) GetAPIKey(ctx context.Context, id string) (*crypto.APIKey, error) {
+	ret := _m.Called(ctx, id)
+
+	var r0 *crypto.APIKey
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) (*crypto.APIKey, error)); ok {
+		return rf(ctx, id)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) *crypto.APIKey); ok {
+		r0 = rf(ctx, id)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*crypto.APIKey)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, id)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// RootCoordCatalog_GetAPIKey_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetAPIKey'
+type RootCoordCatalog_GetAPIKey_Call struct {
+	*mock.Call
+}
+
+// GetAPIKey is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id string
+func (_e *RootCoordCatalog_Expecter) GetAPIKey(ctx interface{}, id interface{}) *RootCoordCatalog_GetAPIKey_Call {
+	return &RootCoordCatalog_GetAPIKey_Call{Call: _e.mock.On("GetAPIKey", ctx, id)}
+}
+
+func (_c *RootCoordCatalog_GetAPIKey_Call) Run(run func(ctx context.Context, id string)) *RootCoordCatalog_GetAPIKey_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *RootCoordCatalog_GetAPIKey_Call) Return(_a0 *crypto.APIKey, _a1 error) *RootCoordCatalog_GetAPIKey_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *RootCoordCatalog_GetAPIKey_Call) RunAndReturn(run func(context.Context, string) (*crypto.APIKey, error)) *RootCoordCatalog_GetAPIKey_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // GetCollectionByID provides a mock function with given fields: ctx, dbID, ts, collectionID
 func (_m *RootCoordCatalog) GetCollectionByID(ctx context.Context, dbID int64, ts uint64, collectionID int64) (*model.Collection, error) {
 	ret := _m.Called(ctx, dbID, ts, collectionID)
@@ -1117,6 +1351,60 @@ func (_c *RootCoordCatalog_GetCredential_Call) RunAndReturn(run func(context.Con
 	return _c
 }
 
+// ListAPIKeys provides a mock function with given fields: ctx
+func (_m *RootCoordCatalog) ListAPIKeys(ctx context.Context) ([]*crypto.APIKey, error) {
+	ret := _m.Called(ctx)
+
+	var r0 []*crypto.APIKey
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context) ([]*crypto.APIKey, error)); ok {
+		return rf(ctx)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context) []*crypto.APIKey); ok {
+		r0 = rf(ctx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*crypto.APIKey)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// RootCoordCatalog_ListAPIKeys_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListAPIKeys'
+type RootCoordCatalog_ListAPIKeys_Call struct {
+	*mock.Call
+}
+
+// ListAPIKeys is a helper method to define mock.On call
+//   - ctx context.Context
+func (_e *RootCoordCatalog_Expecter) ListAPIKeys(ctx interface{}) *RootCoordCatalog_ListAPIKeys_Call {
+	return &RootCoordCatalog_ListAPIKeys_Call{Call: _e.mock.On("ListAPIKeys", ctx)}
+}
+
+func (_c *RootCoordCatalog_ListAPIKeys_Call) Run(run func(ctx context.Context)) *RootCoordCatalog_ListAPIKeys_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context))
+	})
+	return _c
+}
+
+func (_c *RootCoordCatalog_ListAPIKeys_Call) Return(_a0 []*crypto.APIKey, _a1 error) *RootCoordCatalog_ListAPIKeys_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *RootCoordCatalog_ListAPIKeys_Call) RunAndReturn(run func(context.Context) ([]*crypto.APIKey, error)) *RootCoordCatalog_ListAPIKeys_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // ListAliases provides a mock function with given fields: ctx, dbID, ts
 func (_m *RootCoordCatalog) ListAliases(ctx context.Context, dbID int64, ts uint64) ([]*model.Alias, error) {
 	ret := _m.Called(ctx, dbID, ts)
@@ -1173,6 +1461,60 @@ func (_c *RootCoordCatalog_ListAliases_Call) RunAndReturn(run func(context.Conte
 	return _c
 }
 
+// ListCollectionTemplates provides a mock function with given fields: ctx
+func (_m *RootCoordCatalog) ListCollectionTemplates(ctx context.Context) ([]*model.CollectionTemplate, error) {
+	ret := _m.Called(ctx)
+
+	var r0 []*model.CollectionTemplate
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context) ([]*model.CollectionTemplate, error)); ok {
+		return rf(ctx)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context) []*model.CollectionTemplate); ok {
+		r0 = rf(ctx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*model.CollectionTemplate)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// RootCoordCatalog_ListCollectionTemplates_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListCollectionTemplates'
+type RootCoordCatalog_ListCollectionTemplates_Call struct {
+	*mock.Call
+}
+
+// ListCollectionTemplates is a helper method to define mock.On call
+//   - ctx context.Context
+func (_e *RootCoordCatalog_Expecter) ListCollectionTemplates(ctx interface{}) *RootCoordCatalog_ListCollectionTemplates_Call {
+	return &RootCoordCatalog_ListCollectionTemplates_Call{Call: _e.mock.On("ListCollectionTemplates", ctx)}
+}
+
+func (_c *RootCoordCatalog_ListCollectionTemplates_Call) Run(run func(ctx context.Context)) *RootCoordCatalog_ListCollectionTemplates_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context))
+	})
+	return _c
+}
+
+func (_c *RootCoordCatalog_ListCollectionTemplates_Call) Return(_a0 []*model.CollectionTemplate, _a1 error) *RootCoordCatalog_ListCollectionTemplates_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *RootCoordCatalog_ListCollectionTemplates_Call) RunAndReturn(run func(context.Context) ([]*model.CollectionTemplate, error)) *RootCoordCatalog_ListCollectionTemplates_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // ListCollections provides a mock function with given fields: ctx, dbID, ts
 func (_m *RootCoordCatalog) ListCollections(ctx context.Context, dbID int64, ts uint64) ([]*model.Collection, error) {
 	ret := _m.Called(ctx, dbID, ts)
@@ -1506,6 +1848,61 @@ func (_c *RootCoordCatalog_ListRole_Call) RunAndReturn(run func(context.Context,
 	return _c
 }
 
+// ListRoleInheritance provides a mock function with given fields: ctx, tenant
+func (_m *RootCoordCatalog) ListRoleInheritance(ctx context.Context, tenant string) (map[string][]string, error) {
+	ret := _m.Called(ctx, tenant)
+
+	var r0 map[string][]string
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) (map[string][]string, error)); ok {
+		return rf(ctx, tenant)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) map[string][]string); ok {
+		r0 = rf(ctx, tenant)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(map[string][]string)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, tenant)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// RootCoordCatalog_ListRoleInheritance_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListRoleInheritance'
+type RootCoordCatalog_ListRoleInheritance_Call struct {
+	*mock.Call
+}
+
+// ListRoleInheritance is a helper method to define mock.On call
+//   - ctx context.Context
+//   - tenant string
+func (_e *RootCoordCatalog_Expecter) ListRoleInheritance(ctx interface{}, tenant interface{}) *RootCoordCatalog_ListRoleInheritance_Call {
+	return &RootCoordCatalog_ListRoleInheritance_Call{Call: _e.mock.On("ListRoleInheritance", ctx, tenant)}
+}
+
+func (_c *RootCoordCatalog_ListRoleInheritance_Call) Run(run func(ctx context.Context, tenant string)) *RootCoordCatalog_ListRoleInheritance_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *RootCoordCatalog_ListRoleInheritance_Call) Return(_a0 map[string][]string, _a1 error) *RootCoordCatalog_ListRoleInheritance_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *RootCoordCatalog_ListRoleInheritance_Call) RunAndReturn(run func(context.Context, string) (map[string][]string, error)) *RootCoordCatalog_ListRoleInheritance_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // ListUser provides a mock function with given fields: ctx, tenant, entity, includeRoleInfo
 func (_m *RootCoordCatalog) ListUser(ctx context.Context, tenant string, entity *milvuspb.UserEntity, includeRoleInfo bool) ([]*milvuspb.UserResult, error) {
 	ret := _m.Called(ctx, tenant, entity, includeRoleInfo)
@@ -1618,6 +2015,92 @@ func (_c *RootCoordCatalog_ListUserRole_Call) RunAndReturn(run func(context.Cont
 	return _c
 }
 
+// SaveAPIKey provides a mock function with given fields: ctx, key
+func (_m *RootCoordCatalog) SaveAPIKey(ctx context.Context, key *crypto.APIKey) error {
+	ret := _m.Called(ctx, key)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, *crypto.APIKey) error); ok {
+		r0 = rf(ctx, key)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// RootCoordCatalog_SaveAPIKey_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SaveAPIKey'
+type RootCoordCatalog_SaveAPIKey_Call struct {
+	*mock.Call
+}
+
+// SaveAPIKey is a helper method to define mock.On call
+//   - ctx context.Context
+//   - key *crypto.APIKey
+func (_e *RootCoordCatalog_Expecter) SaveAPIKey(ctx interface{}, key interface{}) *RootCoordCatalog_SaveAPIKey_Call {
+	return &RootCoordCatalog_SaveAPIKey_Call{Call: _e.mock.On("SaveAPIKey", ctx, key)}
+}
+
+func (_c *RootCoordCatalog_SaveAPIKey_Call) Run(run func(ctx context.Context, key *crypto.APIKey)) *RootCoordCatalog_SaveAPIKey_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*crypto.APIKey))
+	})
+	return _c
+}
+
+func (_c *RootCoordCatalog_SaveAPIKey_Call) Return(_a0 error) *RootCoordCatalog_SaveAPIKey_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *RootCoordCatalog_SaveAPIKey_Call) RunAndReturn(run func(context.Context, *crypto.APIKey) error) *RootCoordCatalog_SaveAPIKey_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// SaveCollectionTemplate provides a mock function with given fields: ctx, template
+func (_m *RootCoordCatalog) SaveCollectionTemplate(ctx context.Context, template *model.CollectionTemplate) error {
+	ret := _m.Called(ctx, template)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, *model.CollectionTemplate) error); ok {
+		r0 = rf(ctx, template)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// RootCoordCatalog_SaveCollectionTemplate_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SaveCollectionTemplate'
+type RootCoordCatalog_SaveCollectionTemplate_Call struct {
+	*mock.Call
+}
+
+// SaveCollectionTemplate is a helper method to define mock.On call
+//   - ctx context.Context
+//   - template *model.CollectionTemplate
+func (_e *RootCoordCatalog_Expecter) SaveCollectionTemplate(ctx interface{}, template interface{}) *RootCoordCatalog_SaveCollectionTemplate_Call {
+	return &RootCoordCatalog_SaveCollectionTemplate_Call{Call: _e.mock.On("SaveCollectionTemplate", ctx, template)}
+}
+
+func (_c *RootCoordCatalog_SaveCollectionTemplate_Call) Run(run func(ctx context.Context, template *model.CollectionTemplate)) *RootCoordCatalog_SaveCollectionTemplate_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*model.CollectionTemplate))
+	})
+	return _c
+}
+
+func (_c *RootCoordCatalog_SaveCollectionTemplate_Call) Return(_a0 error) *RootCoordCatalog_SaveCollectionTemplate_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *RootCoordCatalog_SaveCollectionTemplate_Call) RunAndReturn(run func(context.Context, *model.CollectionTemplate) error) *RootCoordCatalog_SaveCollectionTemplate_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // NewRootCoordCatalog creates a new instance of RootCoordCatalog. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
 // The first argument is typically a *testing.T value.
 func NewRootCoordCatalog(t interface {
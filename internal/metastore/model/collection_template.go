@@ -0,0 +1,106 @@
+package model
+
+import (
+	"encoding/json"
+
+	"github.com/golang/protobuf/proto"
+
+	"github.com/milvus-io/milvus-proto/go-api/v2/commonpb"
+	"github.com/milvus-io/milvus-proto/go-api/v2/schemapb"
+	"github.com/milvus-io/milvus/pkg/common"
+)
+
+// IndexPreset is the index to build on the field of the collections created from the template.
+type IndexPreset struct {
+	FieldName  string            `json:"field_name"`
+	IndexType  string            `json:"index_type"`
+	MetricType string            `json:"metric_type"`
+	Params     map[string]string `json:"params,omitempty"`
+}
+
+// CollectionTemplate is a reusable preset of schema, indexes and properties to create standardized collections.
+type CollectionTemplate struct {
+	Name             string
+	Description      string
+	Schema           *schemapb.CollectionSchema
+	ShardsNum        int32
+	ConsistencyLevel commonpb.ConsistencyLevel
+	Properties       []*commonpb.KeyValuePair
+	IndexPresets     []*IndexPreset
+	CreatedTime      uint64
+}
+
+type collectionTemplateInfo struct {
+	Name             string                    `json:"name"`
+	Description      string                    `json:"description,omitempty"`
+	Schema           []byte                    `json:"schema"`
+	ShardsNum        int32                     `json:"shards_num,omitempty"`
+	ConsistencyLevel commonpb.ConsistencyLevel `json:"consistency_level"`
+	Properties       []*commonpb.KeyValuePair  `json:"properties,omitempty"`
+	IndexPresets     []*IndexPreset            `json:"index_presets,omitempty"`
+	CreatedTime      uint64                    `json:"created_time"`
+}
+
+func (t *CollectionTemplate) Clone() *CollectionTemplate {
+	indexPresets := make([]*IndexPreset, 0, len(t.IndexPresets))
+	for _, preset := range t.IndexPresets {
+		params := make(map[string]string, len(preset.Params))
+		for k, v := range preset.Params {
+			params[k] = v
+		}
+		indexPresets = append(indexPresets, &IndexPreset{
+			FieldName:  preset.FieldName,
+			IndexType:  preset.IndexType,
+			MetricType: preset.MetricType,
+			Params:     params,
+		})
+	}
+	return &CollectionTemplate{
+		Name:             t.Name,
+		Description:      t.Description,
+		Schema:           proto.Clone(t.Schema).(*schemapb.CollectionSchema),
+		ShardsNum:        t.ShardsNum,
+		ConsistencyLevel: t.ConsistencyLevel,
+		Properties:       common.CloneKeyValuePairs(t.Properties),
+		IndexPresets:     indexPresets,
+		CreatedTime:      t.CreatedTime,
+	}
+}
+
+func MarshalCollectionTemplateModel(template *CollectionTemplate) ([]byte, error) {
+	schema, err := proto.Marshal(template.Schema)
+	if err != nil {
+		return nil, err
+	}
+	return json.Marshal(&collectionTemplateInfo{
+		Name:             template.Name,
+		Description:      template.Description,
+		Schema:           schema,
+		ShardsNum:        template.ShardsNum,
+		ConsistencyLevel: template.ConsistencyLevel,
+		Properties:       template.Properties,
+		IndexPresets:     template.IndexPresets,
+		CreatedTime:      template.CreatedTime,
+	})
+}
+
+func UnmarshalCollectionTemplateModel(value []byte) (*CollectionTemplate, error) {
+	info := &collectionTemplateInfo{}
+	if err := json.Unmarshal(value, info); err != nil {
+		return nil, err
+	}
+	schema := &schemapb.CollectionSchema{}
+	if err := proto.Unmarshal(info.Schema, schema); err != nil {
+		return nil, err
+	}
+	return &CollectionTemplate{
+		Name:             info.Name,
+		Description:      info.Description,
+		Schema:           schema,
+		ShardsNum:        info.ShardsNum,
+		ConsistencyLevel: info.ConsistencyLevel,
+		Properties:       info.Properties,
+		IndexPresets:     info.IndexPresets,
+		CreatedTime:      info.CreatedTime,
+	}, nil
+}
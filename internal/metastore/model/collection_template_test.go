@@ -0,0 +1,50 @@
+package model
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/milvus-io/milvus-proto/go-api/v2/commonpb"
+	"github.com/milvus-io/milvus-proto/go-api/v2/schemapb"
+)
+
+func TestCollectionTemplateModel(t *testing.T) {
+	template := &CollectionTemplate{
+		Name:        "openai-1536-cosine",
+		Description: "openai embeddings",
+		Schema: &schemapb.CollectionSchema{
+			Fields: []*schemapb.FieldSchema{
+				{Name: "id", DataType: schemapb.DataType_Int64, IsPrimaryKey: true},
+				{Name: "embedding", DataType: schemapb.DataType_FloatVector, TypeParams: []*commonpb.KeyValuePair{{Key: "dim", Value: "1536"}}},
+			},
+		},
+		ShardsNum:        2,
+		ConsistencyLevel: commonpb.ConsistencyLevel_Bounded,
+		Properties:       []*commonpb.KeyValuePair{{Key: "collection.ttl.seconds", Value: "86400"}},
+		IndexPresets:     []*IndexPreset{{FieldName: "embedding", IndexType: "HNSW", MetricType: "COSINE", Params: map[string]string{"M": "16"}}},
+		CreatedTime:      1,
+	}
+
+	value, err := MarshalCollectionTemplateModel(template)
+	require.NoError(t, err)
+	ret, err := UnmarshalCollectionTemplateModel(value)
+	require.NoError(t, err)
+	assert.Equal(t, template.Name, ret.Name)
+	assert.Equal(t, template.Description, ret.Description)
+	assert.Equal(t, template.Schema.String(), ret.Schema.String())
+	assert.Equal(t, template.ShardsNum, ret.ShardsNum)
+	assert.Equal(t, template.ConsistencyLevel, ret.ConsistencyLevel)
+	assert.Equal(t, template.IndexPresets, ret.IndexPresets)
+	assert.Equal(t, template.CreatedTime, ret.CreatedTime)
+
+	cloned := template.Clone()
+	cloned.IndexPresets[0].Params["M"] = "32"
+	cloned.Schema.Fields[0].Name = "pk"
+	assert.Equal(t, "16", template.IndexPresets[0].Params["M"])
+	assert.Equal(t, "id", template.Schema.Fields[0].Name)
+
+	_, err = UnmarshalCollectionTemplateModel([]byte("invalid"))
+	assert.Error(t, err)
+}
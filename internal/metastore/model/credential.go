@@ -8,6 +8,11 @@ type Credential struct {
 	Tenant            string
 	IsSuper           bool
 	Sha256Password    string
+	// password policy related, see crypto.CredentialState
+	PreviousPassword           string
+	PreviousPasswordExpireTime int64
+	PasswordHistory            []string
+	UpdatedTime                int64
 }
 
 func MarshalCredentialModel(cred *Credential) *internalpb.CredentialInfo {
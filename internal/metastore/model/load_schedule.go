@@ -0,0 +1,35 @@
+package model
+
+import (
+	"encoding/json"
+)
+
+// LoadSchedule is the calendar to load and release the collection periodically, the specs are in cron format.
+type LoadSchedule struct {
+	CollectionID   int64    `json:"collection_id"`
+	LoadSpec       string   `json:"load_spec"`
+	ReleaseSpec    string   `json:"release_spec"`
+	Timezone       string   `json:"timezone,omitempty"`
+	ReplicaNumber  int32    `json:"replica_number"`
+	ResourceGroups []string `json:"resource_groups,omitempty"`
+	// unix seconds of the latest load or release event applied, the events before it are ignored
+	LastTriggerTime int64 `json:"last_trigger_time"`
+}
+
+func (s *LoadSchedule) Clone() *LoadSchedule {
+	cloned := *s
+	cloned.ResourceGroups = append([]string(nil), s.ResourceGroups...)
+	return &cloned
+}
+
+func MarshalLoadScheduleModel(schedule *LoadSchedule) ([]byte, error) {
+	return json.Marshal(schedule)
+}
+
+func UnmarshalLoadScheduleModel(value []byte) (*LoadSchedule, error) {
+	schedule := &LoadSchedule{}
+	if err := json.Unmarshal(value, schedule); err != nil {
+		return nil, err
+	}
+	return schedule, nil
+}
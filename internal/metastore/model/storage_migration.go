@@ -0,0 +1,32 @@
+package model
+
+import (
+	"encoding/json"
+)
+
+// StorageMigration is the record of the binlogs and index files of a collection copied to another object storage,
+// it's saved once all the copied files are verified.
+type StorageMigration struct {
+	CollectionID int64  `json:"collection_id"`
+	StorageType  string `json:"storage_type"`
+	Address      string `json:"address"`
+	BucketName   string `json:"bucket_name"`
+	RootPath     string `json:"root_path"`
+	// the segments whose files are all copied
+	SegmentIDs   []int64 `json:"segment_ids"`
+	FileNum      int64   `json:"file_num"`
+	TotalBytes   int64   `json:"total_bytes"`
+	CompleteTime int64   `json:"complete_time"`
+}
+
+func MarshalStorageMigrationModel(migration *StorageMigration) ([]byte, error) {
+	return json.Marshal(migration)
+}
+
+func UnmarshalStorageMigrationModel(value []byte) (*StorageMigration, error) {
+	migration := &StorageMigration{}
+	if err := json.Unmarshal(value, migration); err != nil {
+		return nil, err
+	}
+	return migration, nil
+}
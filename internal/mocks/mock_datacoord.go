@@ -146,6 +146,61 @@ func (_c *MockDataCoord_BroadcastAlteredCollection_Call) RunAndReturn(run func(c
 	return _c
 }
 
+// CancelCompactionPlan provides a mock function with given fields: _a0, _a1
+func (_m *MockDataCoord) CancelCompactionPlan(_a0 context.Context, _a1 *datapb.CancelCompactionPlanRequest) (*commonpb.Status, error) {
+	ret := _m.Called(_a0, _a1)
+
+	var r0 *commonpb.Status
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *datapb.CancelCompactionPlanRequest) (*commonpb.Status, error)); ok {
+		return rf(_a0, _a1)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *datapb.CancelCompactionPlanRequest) *commonpb.Status); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*commonpb.Status)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *datapb.CancelCompactionPlanRequest) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockDataCoord_CancelCompactionPlan_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CancelCompactionPlan'
+type MockDataCoord_CancelCompactionPlan_Call struct {
+	*mock.Call
+}
+
+// CancelCompactionPlan is a helper method to define mock.On call
+//   - _a0 context.Context
+//   - _a1 *datapb.CancelCompactionPlanRequest
+func (_e *MockDataCoord_Expecter) CancelCompactionPlan(_a0 interface{}, _a1 interface{}) *MockDataCoord_CancelCompactionPlan_Call {
+	return &MockDataCoord_CancelCompactionPlan_Call{Call: _e.mock.On("CancelCompactionPlan", _a0, _a1)}
+}
+
+func (_c *MockDataCoord_CancelCompactionPlan_Call) Run(run func(_a0 context.Context, _a1 *datapb.CancelCompactionPlanRequest)) *MockDataCoord_CancelCompactionPlan_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*datapb.CancelCompactionPlanRequest))
+	})
+	return _c
+}
+
+func (_c *MockDataCoord_CancelCompactionPlan_Call) Return(_a0 *commonpb.Status, _a1 error) *MockDataCoord_CancelCompactionPlan_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockDataCoord_CancelCompactionPlan_Call) RunAndReturn(run func(context.Context, *datapb.CancelCompactionPlanRequest) (*commonpb.Status, error)) *MockDataCoord_CancelCompactionPlan_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // CheckHealth provides a mock function with given fields: _a0, _a1
 func (_m *MockDataCoord) CheckHealth(_a0 context.Context, _a1 *milvuspb.CheckHealthRequest) (*milvuspb.CheckHealthResponse, error) {
 	ret := _m.Called(_a0, _a1)
@@ -2002,6 +2057,61 @@ func (_c *MockDataCoord_MarkSegmentsDropped_Call) RunAndReturn(run func(context.
 	return _c
 }
 
+// OperateStorageMigration provides a mock function with given fields: _a0, _a1
+func (_m *MockDataCoord) OperateStorageMigration(_a0 context.Context, _a1 *datapb.OperateStorageMigrationRequest) (*commonpb.Status, error) {
+	ret := _m.Called(_a0, _a1)
+
+	var r0 *commonpb.Status
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *datapb.OperateStorageMigrationRequest) (*commonpb.Status, error)); ok {
+		return rf(_a0, _a1)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *datapb.OperateStorageMigrationRequest) *commonpb.Status); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*commonpb.Status)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *datapb.OperateStorageMigrationRequest) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockDataCoord_OperateStorageMigration_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'OperateStorageMigration'
+type MockDataCoord_OperateStorageMigration_Call struct {
+	*mock.Call
+}
+
+// OperateStorageMigration is a helper method to define mock.On call
+//   - _a0 context.Context
+//   - _a1 *datapb.OperateStorageMigrationRequest
+func (_e *MockDataCoord_Expecter) OperateStorageMigration(_a0 interface{}, _a1 interface{}) *MockDataCoord_OperateStorageMigration_Call {
+	return &MockDataCoord_OperateStorageMigration_Call{Call: _e.mock.On("OperateStorageMigration", _a0, _a1)}
+}
+
+func (_c *MockDataCoord_OperateStorageMigration_Call) Run(run func(_a0 context.Context, _a1 *datapb.OperateStorageMigrationRequest)) *MockDataCoord_OperateStorageMigration_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*datapb.OperateStorageMigrationRequest))
+	})
+	return _c
+}
+
+func (_c *MockDataCoord_OperateStorageMigration_Call) Return(_a0 *commonpb.Status, _a1 error) *MockDataCoord_OperateStorageMigration_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockDataCoord_OperateStorageMigration_Call) RunAndReturn(run func(context.Context, *datapb.OperateStorageMigrationRequest) (*commonpb.Status, error)) *MockDataCoord_OperateStorageMigration_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // Register provides a mock function with given fields:
 func (_m *MockDataCoord) Register() error {
 	ret := _m.Called()
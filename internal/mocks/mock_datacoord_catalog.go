@@ -997,6 +997,60 @@ func (_c *DataCoordCatalog_ListSegments_Call) RunAndReturn(run func(context.Cont
 	return _c
 }
 
+// ListStorageMigrations provides a mock function with given fields: ctx
+func (_m *DataCoordCatalog) ListStorageMigrations(ctx context.Context) ([]*model.StorageMigration, error) {
+	ret := _m.Called(ctx)
+
+	var r0 []*model.StorageMigration
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context) ([]*model.StorageMigration, error)); ok {
+		return rf(ctx)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context) []*model.StorageMigration); ok {
+		r0 = rf(ctx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*model.StorageMigration)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// DataCoordCatalog_ListStorageMigrations_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListStorageMigrations'
+type DataCoordCatalog_ListStorageMigrations_Call struct {
+	*mock.Call
+}
+
+// ListStorageMigrations is a helper method to define mock.On call
+//   - ctx context.Context
+func (_e *DataCoordCatalog_Expecter) ListStorageMigrations(ctx interface{}) *DataCoordCatalog_ListStorageMigrations_Call {
+	return &DataCoordCatalog_ListStorageMigrations_Call{Call: _e.mock.On("ListStorageMigrations", ctx)}
+}
+
+func (_c *DataCoordCatalog_ListStorageMigrations_Call) Run(run func(ctx context.Context)) *DataCoordCatalog_ListStorageMigrations_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context))
+	})
+	return _c
+}
+
+func (_c *DataCoordCatalog_ListStorageMigrations_Call) Return(_a0 []*model.StorageMigration, _a1 error) *DataCoordCatalog_ListStorageMigrations_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *DataCoordCatalog_ListStorageMigrations_Call) RunAndReturn(run func(context.Context) ([]*model.StorageMigration, error)) *DataCoordCatalog_ListStorageMigrations_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // MarkChannelAdded provides a mock function with given fields: ctx, channel
 func (_m *DataCoordCatalog) MarkChannelAdded(ctx context.Context, channel string) error {
 	ret := _m.Called(ctx, channel)
@@ -1170,6 +1224,49 @@ func (_c *DataCoordCatalog_SaveDroppedSegmentsInBatch_Call) RunAndReturn(run fun
 	return _c
 }
 
+// SaveStorageMigration provides a mock function with given fields: ctx, migration
+func (_m *DataCoordCatalog) SaveStorageMigration(ctx context.Context, migration *model.StorageMigration) error {
+	ret := _m.Called(ctx, migration)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, *model.StorageMigration) error); ok {
+		r0 = rf(ctx, migration)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// DataCoordCatalog_SaveStorageMigration_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SaveStorageMigration'
+type DataCoordCatalog_SaveStorageMigration_Call struct {
+	*mock.Call
+}
+
+// SaveStorageMigration is a helper method to define mock.On call
+//   - ctx context.Context
+//   - migration *model.StorageMigration
+func (_e *DataCoordCatalog_Expecter) SaveStorageMigration(ctx interface{}, migration interface{}) *DataCoordCatalog_SaveStorageMigration_Call {
+	return &DataCoordCatalog_SaveStorageMigration_Call{Call: _e.mock.On("SaveStorageMigration", ctx, migration)}
+}
+
+func (_c *DataCoordCatalog_SaveStorageMigration_Call) Run(run func(ctx context.Context, migration *model.StorageMigration)) *DataCoordCatalog_SaveStorageMigration_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*model.StorageMigration))
+	})
+	return _c
+}
+
+func (_c *DataCoordCatalog_SaveStorageMigration_Call) Return(_a0 error) *DataCoordCatalog_SaveStorageMigration_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *DataCoordCatalog_SaveStorageMigration_Call) RunAndReturn(run func(context.Context, *model.StorageMigration) error) *DataCoordCatalog_SaveStorageMigration_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // ShouldDropChannel provides a mock function with given fields: ctx, channel
 func (_m *DataCoordCatalog) ShouldDropChannel(ctx context.Context, channel string) bool {
 	ret := _m.Called(ctx, channel)
@@ -173,6 +173,76 @@ func (_c *MockDataCoordClient_BroadcastAlteredCollection_Call) RunAndReturn(run
 	return _c
 }
 
+// CancelCompactionPlan provides a mock function with given fields: ctx, in, opts
+func (_m *MockDataCoordClient) CancelCompactionPlan(ctx context.Context, in *datapb.CancelCompactionPlanRequest, opts ...grpc.CallOption) (*commonpb.Status, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *commonpb.Status
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *datapb.CancelCompactionPlanRequest, ...grpc.CallOption) (*commonpb.Status, error)); ok {
+		return rf(ctx, in, opts...)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *datapb.CancelCompactionPlanRequest, ...grpc.CallOption) *commonpb.Status); ok {
+		r0 = rf(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*commonpb.Status)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *datapb.CancelCompactionPlanRequest, ...grpc.CallOption) error); ok {
+		r1 = rf(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockDataCoordClient_CancelCompactionPlan_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CancelCompactionPlan'
+type MockDataCoordClient_CancelCompactionPlan_Call struct {
+	*mock.Call
+}
+
+// CancelCompactionPlan is a helper method to define mock.On call
+//   - ctx context.Context
+//   - in *datapb.CancelCompactionPlanRequest
+//   - opts ...grpc.CallOption
+func (_e *MockDataCoordClient_Expecter) CancelCompactionPlan(ctx interface{}, in interface{}, opts ...interface{}) *MockDataCoordClient_CancelCompactionPlan_Call {
+	return &MockDataCoordClient_CancelCompactionPlan_Call{Call: _e.mock.On("CancelCompactionPlan",
+		append([]interface{}{ctx, in}, opts...)...)}
+}
+
+func (_c *MockDataCoordClient_CancelCompactionPlan_Call) Run(run func(ctx context.Context, in *datapb.CancelCompactionPlanRequest, opts ...grpc.CallOption)) *MockDataCoordClient_CancelCompactionPlan_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		variadicArgs := make([]grpc.CallOption, len(args)-2)
+		for i, a := range args[2:] {
+			if a != nil {
+				variadicArgs[i] = a.(grpc.CallOption)
+			}
+		}
+		run(args[0].(context.Context), args[1].(*datapb.CancelCompactionPlanRequest), variadicArgs...)
+	})
+	return _c
+}
+
+func (_c *MockDataCoordClient_CancelCompactionPlan_Call) Return(_a0 *commonpb.Status, _a1 error) *MockDataCoordClient_CancelCompactionPlan_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockDataCoordClient_CancelCompactionPlan_Call) RunAndReturn(run func(context.Context, *datapb.CancelCompactionPlanRequest, ...grpc.CallOption) (*commonpb.Status, error)) *MockDataCoordClient_CancelCompactionPlan_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // CheckHealth provides a mock function with given fields: ctx, in, opts
 func (_m *MockDataCoordClient) CheckHealth(ctx context.Context, in *milvuspb.CheckHealthRequest, opts ...grpc.CallOption) (*milvuspb.CheckHealthResponse, error) {
 	_va := make([]interface{}, len(opts))
@@ -2524,6 +2594,76 @@ func (_c *MockDataCoordClient_MarkSegmentsDropped_Call) RunAndReturn(run func(co
 	return _c
 }
 
+// OperateStorageMigration provides a mock function with given fields: ctx, in, opts
+func (_m *MockDataCoordClient) OperateStorageMigration(ctx context.Context, in *datapb.OperateStorageMigrationRequest, opts ...grpc.CallOption) (*commonpb.Status, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *commonpb.Status
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *datapb.OperateStorageMigrationRequest, ...grpc.CallOption) (*commonpb.Status, error)); ok {
+		return rf(ctx, in, opts...)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *datapb.OperateStorageMigrationRequest, ...grpc.CallOption) *commonpb.Status); ok {
+		r0 = rf(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*commonpb.Status)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *datapb.OperateStorageMigrationRequest, ...grpc.CallOption) error); ok {
+		r1 = rf(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockDataCoordClient_OperateStorageMigration_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'OperateStorageMigration'
+type MockDataCoordClient_OperateStorageMigration_Call struct {
+	*mock.Call
+}
+
+// OperateStorageMigration is a helper method to define mock.On call
+//   - ctx context.Context
+//   - in *datapb.OperateStorageMigrationRequest
+//   - opts ...grpc.CallOption
+func (_e *MockDataCoordClient_Expecter) OperateStorageMigration(ctx interface{}, in interface{}, opts ...interface{}) *MockDataCoordClient_OperateStorageMigration_Call {
+	return &MockDataCoordClient_OperateStorageMigration_Call{Call: _e.mock.On("OperateStorageMigration",
+		append([]interface{}{ctx, in}, opts...)...)}
+}
+
+func (_c *MockDataCoordClient_OperateStorageMigration_Call) Run(run func(ctx context.Context, in *datapb.OperateStorageMigrationRequest, opts ...grpc.CallOption)) *MockDataCoordClient_OperateStorageMigration_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		variadicArgs := make([]grpc.CallOption, len(args)-2)
+		for i, a := range args[2:] {
+			if a != nil {
+				variadicArgs[i] = a.(grpc.CallOption)
+			}
+		}
+		run(args[0].(context.Context), args[1].(*datapb.OperateStorageMigrationRequest), variadicArgs...)
+	})
+	return _c
+}
+
+func (_c *MockDataCoordClient_OperateStorageMigration_Call) Return(_a0 *commonpb.Status, _a1 error) *MockDataCoordClient_OperateStorageMigration_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockDataCoordClient_OperateStorageMigration_Call) RunAndReturn(run func(context.Context, *datapb.OperateStorageMigrationRequest, ...grpc.CallOption) (*commonpb.Status, error)) *MockDataCoordClient_OperateStorageMigration_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // ReportDataNodeTtMsgs provides a mock function with given fields: ctx, in, opts
 func (_m *MockDataCoordClient) ReportDataNodeTtMsgs(ctx context.Context, in *datapb.ReportDataNodeTtMsgsRequest, opts ...grpc.CallOption) (*commonpb.Status, error) {
 	_va := make([]interface{}, len(opts))
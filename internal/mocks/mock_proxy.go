@@ -8,6 +8,8 @@ import (
 	commonpb "github.com/milvus-io/milvus-proto/go-api/v2/commonpb"
 	clientv3 "go.etcd.io/etcd/client/v3"
 
+	datapb "github.com/milvus-io/milvus/internal/proto/datapb"
+
 	federpb "github.com/milvus-io/milvus-proto/go-api/v2/federpb"
 
 	internalpb "github.com/milvus-io/milvus/internal/proto/internalpb"
@@ -18,6 +20,10 @@ import (
 
 	proxypb "github.com/milvus-io/milvus/internal/proto/proxypb"
 
+	querypb "github.com/milvus-io/milvus/internal/proto/querypb"
+
+	rootcoordpb "github.com/milvus-io/milvus/internal/proto/rootcoordpb"
+
 	types "github.com/milvus-io/milvus/internal/types"
 )
 
@@ -254,6 +260,116 @@ func (_c *MockProxy_CalcDistance_Call) RunAndReturn(run func(context.Context, *m
 	return _c
 }
 
+// CancelCompactionPlan provides a mock function with given fields: _a0, _a1
+func (_m *MockProxy) CancelCompactionPlan(_a0 context.Context, _a1 *datapb.CancelCompactionPlanRequest) (*commonpb.Status, error) {
+	ret := _m.Called(_a0, _a1)
+
+	var r0 *commonpb.Status
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *datapb.CancelCompactionPlanRequest) (*commonpb.Status, error)); ok {
+		return rf(_a0, _a1)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *datapb.CancelCompactionPlanRequest) *commonpb.Status); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*commonpb.Status)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *datapb.CancelCompactionPlanRequest) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockProxy_CancelCompactionPlan_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CancelCompactionPlan'
+type MockProxy_CancelCompactionPlan_Call struct {
+	*mock.Call
+}
+
+// CancelCompactionPlan is a helper method to define mock.On call
+//   - _a0 context.Context
+//   - _a1 *datapb.CancelCompactionPlanRequest
+func (_e *MockProxy_Expecter) CancelCompactionPlan(_a0 interface{}, _a1 interface{}) *MockProxy_CancelCompactionPlan_Call {
+	return &MockProxy_CancelCompactionPlan_Call{Call: _e.mock.On("CancelCompactionPlan", _a0, _a1)}
+}
+
+func (_c *MockProxy_CancelCompactionPlan_Call) Run(run func(_a0 context.Context, _a1 *datapb.CancelCompactionPlanRequest)) *MockProxy_CancelCompactionPlan_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*datapb.CancelCompactionPlanRequest))
+	})
+	return _c
+}
+
+func (_c *MockProxy_CancelCompactionPlan_Call) Return(_a0 *commonpb.Status, _a1 error) *MockProxy_CancelCompactionPlan_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockProxy_CancelCompactionPlan_Call) RunAndReturn(run func(context.Context, *datapb.CancelCompactionPlanRequest) (*commonpb.Status, error)) *MockProxy_CancelCompactionPlan_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// CancelDDLTask provides a mock function with given fields: _a0, _a1
+func (_m *MockProxy) CancelDDLTask(_a0 context.Context, _a1 *rootcoordpb.CancelDDLTaskRequest) (*commonpb.Status, error) {
+	ret := _m.Called(_a0, _a1)
+
+	var r0 *commonpb.Status
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *rootcoordpb.CancelDDLTaskRequest) (*commonpb.Status, error)); ok {
+		return rf(_a0, _a1)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *rootcoordpb.CancelDDLTaskRequest) *commonpb.Status); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*commonpb.Status)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *rootcoordpb.CancelDDLTaskRequest) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockProxy_CancelDDLTask_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CancelDDLTask'
+type MockProxy_CancelDDLTask_Call struct {
+	*mock.Call
+}
+
+// CancelDDLTask is a helper method to define mock.On call
+//   - _a0 context.Context
+//   - _a1 *rootcoordpb.CancelDDLTaskRequest
+func (_e *MockProxy_Expecter) CancelDDLTask(_a0 interface{}, _a1 interface{}) *MockProxy_CancelDDLTask_Call {
+	return &MockProxy_CancelDDLTask_Call{Call: _e.mock.On("CancelDDLTask", _a0, _a1)}
+}
+
+func (_c *MockProxy_CancelDDLTask_Call) Run(run func(_a0 context.Context, _a1 *rootcoordpb.CancelDDLTaskRequest)) *MockProxy_CancelDDLTask_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*rootcoordpb.CancelDDLTaskRequest))
+	})
+	return _c
+}
+
+func (_c *MockProxy_CancelDDLTask_Call) Return(_a0 *commonpb.Status, _a1 error) *MockProxy_CancelDDLTask_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockProxy_CancelDDLTask_Call) RunAndReturn(run func(context.Context, *rootcoordpb.CancelDDLTaskRequest) (*commonpb.Status, error)) *MockProxy_CancelDDLTask_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // CheckHealth provides a mock function with given fields: _a0, _a1
 func (_m *MockProxy) CheckHealth(_a0 context.Context, _a1 *milvuspb.CheckHealthRequest) (*milvuspb.CheckHealthResponse, error) {
 	ret := _m.Called(_a0, _a1)
@@ -474,6 +590,61 @@ func (_c *MockProxy_CreateCollection_Call) RunAndReturn(run func(context.Context
 	return _c
 }
 
+// CreateCollectionFromTemplate provides a mock function with given fields: _a0, _a1
+func (_m *MockProxy) CreateCollectionFromTemplate(_a0 context.Context, _a1 *rootcoordpb.CreateCollectionFromTemplateRequest) (*commonpb.Status, error) {
+	ret := _m.Called(_a0, _a1)
+
+	var r0 *commonpb.Status
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *rootcoordpb.CreateCollectionFromTemplateRequest) (*commonpb.Status, error)); ok {
+		return rf(_a0, _a1)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *rootcoordpb.CreateCollectionFromTemplateRequest) *commonpb.Status); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*commonpb.Status)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *rootcoordpb.CreateCollectionFromTemplateRequest) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockProxy_CreateCollectionFromTemplate_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateCollectionFromTemplate'
+type MockProxy_CreateCollectionFromTemplate_Call struct {
+	*mock.Call
+}
+
+// CreateCollectionFromTemplate is a helper method to define mock.On call
+//   - _a0 context.Context
+//   - _a1 *rootcoordpb.CreateCollectionFromTemplateRequest
+func (_e *MockProxy_Expecter) CreateCollectionFromTemplate(_a0 interface{}, _a1 interface{}) *MockProxy_CreateCollectionFromTemplate_Call {
+	return &MockProxy_CreateCollectionFromTemplate_Call{Call: _e.mock.On("CreateCollectionFromTemplate", _a0, _a1)}
+}
+
+func (_c *MockProxy_CreateCollectionFromTemplate_Call) Run(run func(_a0 context.Context, _a1 *rootcoordpb.CreateCollectionFromTemplateRequest)) *MockProxy_CreateCollectionFromTemplate_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*rootcoordpb.CreateCollectionFromTemplateRequest))
+	})
+	return _c
+}
+
+func (_c *MockProxy_CreateCollectionFromTemplate_Call) Return(_a0 *commonpb.Status, _a1 error) *MockProxy_CreateCollectionFromTemplate_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockProxy_CreateCollectionFromTemplate_Call) RunAndReturn(run func(context.Context, *rootcoordpb.CreateCollectionFromTemplateRequest) (*commonpb.Status, error)) *MockProxy_CreateCollectionFromTemplate_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // CreateCredential provides a mock function with given fields: _a0, _a1
 func (_m *MockProxy) CreateCredential(_a0 context.Context, _a1 *milvuspb.CreateCredentialRequest) (*commonpb.Status, error) {
 	ret := _m.Called(_a0, _a1)
@@ -3359,6 +3530,116 @@ func (_c *MockProxy_InvalidateCredentialCache_Call) RunAndReturn(run func(contex
 	return _c
 }
 
+// KillSession provides a mock function with given fields: _a0, _a1
+func (_m *MockProxy) KillSession(_a0 context.Context, _a1 *proxypb.KillSessionRequest) (*commonpb.Status, error) {
+	ret := _m.Called(_a0, _a1)
+
+	var r0 *commonpb.Status
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *proxypb.KillSessionRequest) (*commonpb.Status, error)); ok {
+		return rf(_a0, _a1)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *proxypb.KillSessionRequest) *commonpb.Status); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*commonpb.Status)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *proxypb.KillSessionRequest) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockProxy_KillSession_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'KillSession'
+type MockProxy_KillSession_Call struct {
+	*mock.Call
+}
+
+// KillSession is a helper method to define mock.On call
+//   - _a0 context.Context
+//   - _a1 *proxypb.KillSessionRequest
+func (_e *MockProxy_Expecter) KillSession(_a0 interface{}, _a1 interface{}) *MockProxy_KillSession_Call {
+	return &MockProxy_KillSession_Call{Call: _e.mock.On("KillSession", _a0, _a1)}
+}
+
+func (_c *MockProxy_KillSession_Call) Run(run func(_a0 context.Context, _a1 *proxypb.KillSessionRequest)) *MockProxy_KillSession_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*proxypb.KillSessionRequest))
+	})
+	return _c
+}
+
+func (_c *MockProxy_KillSession_Call) Return(_a0 *commonpb.Status, _a1 error) *MockProxy_KillSession_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockProxy_KillSession_Call) RunAndReturn(run func(context.Context, *proxypb.KillSessionRequest) (*commonpb.Status, error)) *MockProxy_KillSession_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ListAPIKeys provides a mock function with given fields: _a0, _a1
+func (_m *MockProxy) ListAPIKeys(_a0 context.Context, _a1 *rootcoordpb.ListAPIKeysRequest) (*rootcoordpb.ListAPIKeysResponse, error) {
+	ret := _m.Called(_a0, _a1)
+
+	var r0 *rootcoordpb.ListAPIKeysResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *rootcoordpb.ListAPIKeysRequest) (*rootcoordpb.ListAPIKeysResponse, error)); ok {
+		return rf(_a0, _a1)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *rootcoordpb.ListAPIKeysRequest) *rootcoordpb.ListAPIKeysResponse); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*rootcoordpb.ListAPIKeysResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *rootcoordpb.ListAPIKeysRequest) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockProxy_ListAPIKeys_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListAPIKeys'
+type MockProxy_ListAPIKeys_Call struct {
+	*mock.Call
+}
+
+// ListAPIKeys is a helper method to define mock.On call
+//   - _a0 context.Context
+//   - _a1 *rootcoordpb.ListAPIKeysRequest
+func (_e *MockProxy_Expecter) ListAPIKeys(_a0 interface{}, _a1 interface{}) *MockProxy_ListAPIKeys_Call {
+	return &MockProxy_ListAPIKeys_Call{Call: _e.mock.On("ListAPIKeys", _a0, _a1)}
+}
+
+func (_c *MockProxy_ListAPIKeys_Call) Run(run func(_a0 context.Context, _a1 *rootcoordpb.ListAPIKeysRequest)) *MockProxy_ListAPIKeys_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*rootcoordpb.ListAPIKeysRequest))
+	})
+	return _c
+}
+
+func (_c *MockProxy_ListAPIKeys_Call) Return(_a0 *rootcoordpb.ListAPIKeysResponse, _a1 error) *MockProxy_ListAPIKeys_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockProxy_ListAPIKeys_Call) RunAndReturn(run func(context.Context, *rootcoordpb.ListAPIKeysRequest) (*rootcoordpb.ListAPIKeysResponse, error)) *MockProxy_ListAPIKeys_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // ListAliases provides a mock function with given fields: _a0, _a1
 func (_m *MockProxy) ListAliases(_a0 context.Context, _a1 *milvuspb.ListAliasesRequest) (*milvuspb.ListAliasesResponse, error) {
 	ret := _m.Called(_a0, _a1)
@@ -3744,24 +4025,24 @@ func (_c *MockProxy_ListResourceGroups_Call) RunAndReturn(run func(context.Conte
 	return _c
 }
 
-// LoadBalance provides a mock function with given fields: _a0, _a1
-func (_m *MockProxy) LoadBalance(_a0 context.Context, _a1 *milvuspb.LoadBalanceRequest) (*commonpb.Status, error) {
+// ListRoleInheritances provides a mock function with given fields: _a0, _a1
+func (_m *MockProxy) ListRoleInheritances(_a0 context.Context, _a1 *rootcoordpb.ListRoleInheritancesRequest) (*rootcoordpb.ListRoleInheritancesResponse, error) {
 	ret := _m.Called(_a0, _a1)
 
-	var r0 *commonpb.Status
+	var r0 *rootcoordpb.ListRoleInheritancesResponse
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, *milvuspb.LoadBalanceRequest) (*commonpb.Status, error)); ok {
+	if rf, ok := ret.Get(0).(func(context.Context, *rootcoordpb.ListRoleInheritancesRequest) (*rootcoordpb.ListRoleInheritancesResponse, error)); ok {
 		return rf(_a0, _a1)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, *milvuspb.LoadBalanceRequest) *commonpb.Status); ok {
+	if rf, ok := ret.Get(0).(func(context.Context, *rootcoordpb.ListRoleInheritancesRequest) *rootcoordpb.ListRoleInheritancesResponse); ok {
 		r0 = rf(_a0, _a1)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*commonpb.Status)
+			r0 = ret.Get(0).(*rootcoordpb.ListRoleInheritancesResponse)
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, *milvuspb.LoadBalanceRequest) error); ok {
+	if rf, ok := ret.Get(1).(func(context.Context, *rootcoordpb.ListRoleInheritancesRequest) error); ok {
 		r1 = rf(_a0, _a1)
 	} else {
 		r1 = ret.Error(1)
@@ -3770,53 +4051,53 @@ func (_m *MockProxy) LoadBalance(_a0 context.Context, _a1 *milvuspb.LoadBalanceR
 	return r0, r1
 }
 
-// MockProxy_LoadBalance_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'LoadBalance'
-type MockProxy_LoadBalance_Call struct {
+// MockProxy_ListRoleInheritances_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListRoleInheritances'
+type MockProxy_ListRoleInheritances_Call struct {
 	*mock.Call
 }
 
-// LoadBalance is a helper method to define mock.On call
+// ListRoleInheritances is a helper method to define mock.On call
 //   - _a0 context.Context
-//   - _a1 *milvuspb.LoadBalanceRequest
-func (_e *MockProxy_Expecter) LoadBalance(_a0 interface{}, _a1 interface{}) *MockProxy_LoadBalance_Call {
-	return &MockProxy_LoadBalance_Call{Call: _e.mock.On("LoadBalance", _a0, _a1)}
+//   - _a1 *rootcoordpb.ListRoleInheritancesRequest
+func (_e *MockProxy_Expecter) ListRoleInheritances(_a0 interface{}, _a1 interface{}) *MockProxy_ListRoleInheritances_Call {
+	return &MockProxy_ListRoleInheritances_Call{Call: _e.mock.On("ListRoleInheritances", _a0, _a1)}
 }
 
-func (_c *MockProxy_LoadBalance_Call) Run(run func(_a0 context.Context, _a1 *milvuspb.LoadBalanceRequest)) *MockProxy_LoadBalance_Call {
+func (_c *MockProxy_ListRoleInheritances_Call) Run(run func(_a0 context.Context, _a1 *rootcoordpb.ListRoleInheritancesRequest)) *MockProxy_ListRoleInheritances_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(*milvuspb.LoadBalanceRequest))
+		run(args[0].(context.Context), args[1].(*rootcoordpb.ListRoleInheritancesRequest))
 	})
 	return _c
 }
 
-func (_c *MockProxy_LoadBalance_Call) Return(_a0 *commonpb.Status, _a1 error) *MockProxy_LoadBalance_Call {
+func (_c *MockProxy_ListRoleInheritances_Call) Return(_a0 *rootcoordpb.ListRoleInheritancesResponse, _a1 error) *MockProxy_ListRoleInheritances_Call {
 	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-func (_c *MockProxy_LoadBalance_Call) RunAndReturn(run func(context.Context, *milvuspb.LoadBalanceRequest) (*commonpb.Status, error)) *MockProxy_LoadBalance_Call {
+func (_c *MockProxy_ListRoleInheritances_Call) RunAndReturn(run func(context.Context, *rootcoordpb.ListRoleInheritancesRequest) (*rootcoordpb.ListRoleInheritancesResponse, error)) *MockProxy_ListRoleInheritances_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// LoadCollection provides a mock function with given fields: _a0, _a1
-func (_m *MockProxy) LoadCollection(_a0 context.Context, _a1 *milvuspb.LoadCollectionRequest) (*commonpb.Status, error) {
+// ListSessions provides a mock function with given fields: _a0, _a1
+func (_m *MockProxy) ListSessions(_a0 context.Context, _a1 *proxypb.ListSessionsRequest) (*proxypb.ListSessionsResponse, error) {
 	ret := _m.Called(_a0, _a1)
 
-	var r0 *commonpb.Status
+	var r0 *proxypb.ListSessionsResponse
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, *milvuspb.LoadCollectionRequest) (*commonpb.Status, error)); ok {
+	if rf, ok := ret.Get(0).(func(context.Context, *proxypb.ListSessionsRequest) (*proxypb.ListSessionsResponse, error)); ok {
 		return rf(_a0, _a1)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, *milvuspb.LoadCollectionRequest) *commonpb.Status); ok {
+	if rf, ok := ret.Get(0).(func(context.Context, *proxypb.ListSessionsRequest) *proxypb.ListSessionsResponse); ok {
 		r0 = rf(_a0, _a1)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*commonpb.Status)
+			r0 = ret.Get(0).(*proxypb.ListSessionsResponse)
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, *milvuspb.LoadCollectionRequest) error); ok {
+	if rf, ok := ret.Get(1).(func(context.Context, *proxypb.ListSessionsRequest) error); ok {
 		r1 = rf(_a0, _a1)
 	} else {
 		r1 = ret.Error(1)
@@ -3825,45 +4106,45 @@ func (_m *MockProxy) LoadCollection(_a0 context.Context, _a1 *milvuspb.LoadColle
 	return r0, r1
 }
 
-// MockProxy_LoadCollection_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'LoadCollection'
-type MockProxy_LoadCollection_Call struct {
+// MockProxy_ListSessions_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListSessions'
+type MockProxy_ListSessions_Call struct {
 	*mock.Call
 }
 
-// LoadCollection is a helper method to define mock.On call
+// ListSessions is a helper method to define mock.On call
 //   - _a0 context.Context
-//   - _a1 *milvuspb.LoadCollectionRequest
-func (_e *MockProxy_Expecter) LoadCollection(_a0 interface{}, _a1 interface{}) *MockProxy_LoadCollection_Call {
-	return &MockProxy_LoadCollection_Call{Call: _e.mock.On("LoadCollection", _a0, _a1)}
+//   - _a1 *proxypb.ListSessionsRequest
+func (_e *MockProxy_Expecter) ListSessions(_a0 interface{}, _a1 interface{}) *MockProxy_ListSessions_Call {
+	return &MockProxy_ListSessions_Call{Call: _e.mock.On("ListSessions", _a0, _a1)}
 }
 
-func (_c *MockProxy_LoadCollection_Call) Run(run func(_a0 context.Context, _a1 *milvuspb.LoadCollectionRequest)) *MockProxy_LoadCollection_Call {
+func (_c *MockProxy_ListSessions_Call) Run(run func(_a0 context.Context, _a1 *proxypb.ListSessionsRequest)) *MockProxy_ListSessions_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(*milvuspb.LoadCollectionRequest))
+		run(args[0].(context.Context), args[1].(*proxypb.ListSessionsRequest))
 	})
 	return _c
 }
 
-func (_c *MockProxy_LoadCollection_Call) Return(_a0 *commonpb.Status, _a1 error) *MockProxy_LoadCollection_Call {
+func (_c *MockProxy_ListSessions_Call) Return(_a0 *proxypb.ListSessionsResponse, _a1 error) *MockProxy_ListSessions_Call {
 	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-func (_c *MockProxy_LoadCollection_Call) RunAndReturn(run func(context.Context, *milvuspb.LoadCollectionRequest) (*commonpb.Status, error)) *MockProxy_LoadCollection_Call {
+func (_c *MockProxy_ListSessions_Call) RunAndReturn(run func(context.Context, *proxypb.ListSessionsRequest) (*proxypb.ListSessionsResponse, error)) *MockProxy_ListSessions_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// LoadPartitions provides a mock function with given fields: _a0, _a1
-func (_m *MockProxy) LoadPartitions(_a0 context.Context, _a1 *milvuspb.LoadPartitionsRequest) (*commonpb.Status, error) {
+// LoadBalance provides a mock function with given fields: _a0, _a1
+func (_m *MockProxy) LoadBalance(_a0 context.Context, _a1 *milvuspb.LoadBalanceRequest) (*commonpb.Status, error) {
 	ret := _m.Called(_a0, _a1)
 
 	var r0 *commonpb.Status
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, *milvuspb.LoadPartitionsRequest) (*commonpb.Status, error)); ok {
+	if rf, ok := ret.Get(0).(func(context.Context, *milvuspb.LoadBalanceRequest) (*commonpb.Status, error)); ok {
 		return rf(_a0, _a1)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, *milvuspb.LoadPartitionsRequest) *commonpb.Status); ok {
+	if rf, ok := ret.Get(0).(func(context.Context, *milvuspb.LoadBalanceRequest) *commonpb.Status); ok {
 		r0 = rf(_a0, _a1)
 	} else {
 		if ret.Get(0) != nil {
@@ -3871,7 +4152,7 @@ func (_m *MockProxy) LoadPartitions(_a0 context.Context, _a1 *milvuspb.LoadParti
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, *milvuspb.LoadPartitionsRequest) error); ok {
+	if rf, ok := ret.Get(1).(func(context.Context, *milvuspb.LoadBalanceRequest) error); ok {
 		r1 = rf(_a0, _a1)
 	} else {
 		r1 = ret.Error(1)
@@ -3880,18 +4161,128 @@ func (_m *MockProxy) LoadPartitions(_a0 context.Context, _a1 *milvuspb.LoadParti
 	return r0, r1
 }
 
-// MockProxy_LoadPartitions_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'LoadPartitions'
-type MockProxy_LoadPartitions_Call struct {
+// MockProxy_LoadBalance_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'LoadBalance'
+type MockProxy_LoadBalance_Call struct {
 	*mock.Call
 }
 
-// LoadPartitions is a helper method to define mock.On call
+// LoadBalance is a helper method to define mock.On call
 //   - _a0 context.Context
-//   - _a1 *milvuspb.LoadPartitionsRequest
-func (_e *MockProxy_Expecter) LoadPartitions(_a0 interface{}, _a1 interface{}) *MockProxy_LoadPartitions_Call {
-	return &MockProxy_LoadPartitions_Call{Call: _e.mock.On("LoadPartitions", _a0, _a1)}
-}
-
+//   - _a1 *milvuspb.LoadBalanceRequest
+func (_e *MockProxy_Expecter) LoadBalance(_a0 interface{}, _a1 interface{}) *MockProxy_LoadBalance_Call {
+	return &MockProxy_LoadBalance_Call{Call: _e.mock.On("LoadBalance", _a0, _a1)}
+}
+
+func (_c *MockProxy_LoadBalance_Call) Run(run func(_a0 context.Context, _a1 *milvuspb.LoadBalanceRequest)) *MockProxy_LoadBalance_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*milvuspb.LoadBalanceRequest))
+	})
+	return _c
+}
+
+func (_c *MockProxy_LoadBalance_Call) Return(_a0 *commonpb.Status, _a1 error) *MockProxy_LoadBalance_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockProxy_LoadBalance_Call) RunAndReturn(run func(context.Context, *milvuspb.LoadBalanceRequest) (*commonpb.Status, error)) *MockProxy_LoadBalance_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// LoadCollection provides a mock function with given fields: _a0, _a1
+func (_m *MockProxy) LoadCollection(_a0 context.Context, _a1 *milvuspb.LoadCollectionRequest) (*commonpb.Status, error) {
+	ret := _m.Called(_a0, _a1)
+
+	var r0 *commonpb.Status
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *milvuspb.LoadCollectionRequest) (*commonpb.Status, error)); ok {
+		return rf(_a0, _a1)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *milvuspb.LoadCollectionRequest) *commonpb.Status); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*commonpb.Status)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *milvuspb.LoadCollectionRequest) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockProxy_LoadCollection_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'LoadCollection'
+type MockProxy_LoadCollection_Call struct {
+	*mock.Call
+}
+
+// LoadCollection is a helper method to define mock.On call
+//   - _a0 context.Context
+//   - _a1 *milvuspb.LoadCollectionRequest
+func (_e *MockProxy_Expecter) LoadCollection(_a0 interface{}, _a1 interface{}) *MockProxy_LoadCollection_Call {
+	return &MockProxy_LoadCollection_Call{Call: _e.mock.On("LoadCollection", _a0, _a1)}
+}
+
+func (_c *MockProxy_LoadCollection_Call) Run(run func(_a0 context.Context, _a1 *milvuspb.LoadCollectionRequest)) *MockProxy_LoadCollection_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*milvuspb.LoadCollectionRequest))
+	})
+	return _c
+}
+
+func (_c *MockProxy_LoadCollection_Call) Return(_a0 *commonpb.Status, _a1 error) *MockProxy_LoadCollection_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockProxy_LoadCollection_Call) RunAndReturn(run func(context.Context, *milvuspb.LoadCollectionRequest) (*commonpb.Status, error)) *MockProxy_LoadCollection_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// LoadPartitions provides a mock function with given fields: _a0, _a1
+func (_m *MockProxy) LoadPartitions(_a0 context.Context, _a1 *milvuspb.LoadPartitionsRequest) (*commonpb.Status, error) {
+	ret := _m.Called(_a0, _a1)
+
+	var r0 *commonpb.Status
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *milvuspb.LoadPartitionsRequest) (*commonpb.Status, error)); ok {
+		return rf(_a0, _a1)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *milvuspb.LoadPartitionsRequest) *commonpb.Status); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*commonpb.Status)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *milvuspb.LoadPartitionsRequest) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockProxy_LoadPartitions_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'LoadPartitions'
+type MockProxy_LoadPartitions_Call struct {
+	*mock.Call
+}
+
+// LoadPartitions is a helper method to define mock.On call
+//   - _a0 context.Context
+//   - _a1 *milvuspb.LoadPartitionsRequest
+func (_e *MockProxy_Expecter) LoadPartitions(_a0 interface{}, _a1 interface{}) *MockProxy_LoadPartitions_Call {
+	return &MockProxy_LoadPartitions_Call{Call: _e.mock.On("LoadPartitions", _a0, _a1)}
+}
+
 func (_c *MockProxy_LoadPartitions_Call) Run(run func(_a0 context.Context, _a1 *milvuspb.LoadPartitionsRequest)) *MockProxy_LoadPartitions_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		run(args[0].(context.Context), args[1].(*milvuspb.LoadPartitionsRequest))
@@ -3964,6 +4355,226 @@ func (_c *MockProxy_ManualCompaction_Call) RunAndReturn(run func(context.Context
 	return _c
 }
 
+// OperateAPIKey provides a mock function with given fields: _a0, _a1
+func (_m *MockProxy) OperateAPIKey(_a0 context.Context, _a1 *rootcoordpb.OperateAPIKeyRequest) (*rootcoordpb.OperateAPIKeyResponse, error) {
+	ret := _m.Called(_a0, _a1)
+
+	var r0 *rootcoordpb.OperateAPIKeyResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *rootcoordpb.OperateAPIKeyRequest) (*rootcoordpb.OperateAPIKeyResponse, error)); ok {
+		return rf(_a0, _a1)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *rootcoordpb.OperateAPIKeyRequest) *rootcoordpb.OperateAPIKeyResponse); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*rootcoordpb.OperateAPIKeyResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *rootcoordpb.OperateAPIKeyRequest) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockProxy_OperateAPIKey_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'OperateAPIKey'
+type MockProxy_OperateAPIKey_Call struct {
+	*mock.Call
+}
+
+// OperateAPIKey is a helper method to define mock.On call
+//   - _a0 context.Context
+//   - _a1 *rootcoordpb.OperateAPIKeyRequest
+func (_e *MockProxy_Expecter) OperateAPIKey(_a0 interface{}, _a1 interface{}) *MockProxy_OperateAPIKey_Call {
+	return &MockProxy_OperateAPIKey_Call{Call: _e.mock.On("OperateAPIKey", _a0, _a1)}
+}
+
+func (_c *MockProxy_OperateAPIKey_Call) Run(run func(_a0 context.Context, _a1 *rootcoordpb.OperateAPIKeyRequest)) *MockProxy_OperateAPIKey_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*rootcoordpb.OperateAPIKeyRequest))
+	})
+	return _c
+}
+
+func (_c *MockProxy_OperateAPIKey_Call) Return(_a0 *rootcoordpb.OperateAPIKeyResponse, _a1 error) *MockProxy_OperateAPIKey_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockProxy_OperateAPIKey_Call) RunAndReturn(run func(context.Context, *rootcoordpb.OperateAPIKeyRequest) (*rootcoordpb.OperateAPIKeyResponse, error)) *MockProxy_OperateAPIKey_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// OperateCollectionTemplate provides a mock function with given fields: _a0, _a1
+func (_m *MockProxy) OperateCollectionTemplate(_a0 context.Context, _a1 *rootcoordpb.OperateCollectionTemplateRequest) (*commonpb.Status, error) {
+	ret := _m.Called(_a0, _a1)
+
+	var r0 *commonpb.Status
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *rootcoordpb.OperateCollectionTemplateRequest) (*commonpb.Status, error)); ok {
+		return rf(_a0, _a1)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *rootcoordpb.OperateCollectionTemplateRequest) *commonpb.Status); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*commonpb.Status)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *rootcoordpb.OperateCollectionTemplateRequest) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockProxy_OperateCollectionTemplate_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'OperateCollectionTemplate'
+type MockProxy_OperateCollectionTemplate_Call struct {
+	*mock.Call
+}
+
+// OperateCollectionTemplate is a helper method to define mock.On call
+//   - _a0 context.Context
+//   - _a1 *rootcoordpb.OperateCollectionTemplateRequest
+func (_e *MockProxy_Expecter) OperateCollectionTemplate(_a0 interface{}, _a1 interface{}) *MockProxy_OperateCollectionTemplate_Call {
+	return &MockProxy_OperateCollectionTemplate_Call{Call: _e.mock.On("OperateCollectionTemplate", _a0, _a1)}
+}
+
+func (_c *MockProxy_OperateCollectionTemplate_Call) Run(run func(_a0 context.Context, _a1 *rootcoordpb.OperateCollectionTemplateRequest)) *MockProxy_OperateCollectionTemplate_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*rootcoordpb.OperateCollectionTemplateRequest))
+	})
+	return _c
+}
+
+func (_c *MockProxy_OperateCollectionTemplate_Call) Return(_a0 *commonpb.Status, _a1 error) *MockProxy_OperateCollectionTemplate_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockProxy_OperateCollectionTemplate_Call) RunAndReturn(run func(context.Context, *rootcoordpb.OperateCollectionTemplateRequest) (*commonpb.Status, error)) *MockProxy_OperateCollectionTemplate_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// OperateCollectionTrash provides a mock function with given fields: _a0, _a1
+func (_m *MockProxy) OperateCollectionTrash(_a0 context.Context, _a1 *rootcoordpb.OperateCollectionTrashRequest) (*commonpb.Status, error) {
+	ret := _m.Called(_a0, _a1)
+
+	var r0 *commonpb.Status
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *rootcoordpb.OperateCollectionTrashRequest) (*commonpb.Status, error)); ok {
+		return rf(_a0, _a1)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *rootcoordpb.OperateCollectionTrashRequest) *commonpb.Status); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*commonpb.Status)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *rootcoordpb.OperateCollectionTrashRequest) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockProxy_OperateCollectionTrash_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'OperateCollectionTrash'
+type MockProxy_OperateCollectionTrash_Call struct {
+	*mock.Call
+}
+
+// OperateCollectionTrash is a helper method to define mock.On call
+//   - _a0 context.Context
+//   - _a1 *rootcoordpb.OperateCollectionTrashRequest
+func (_e *MockProxy_Expecter) OperateCollectionTrash(_a0 interface{}, _a1 interface{}) *MockProxy_OperateCollectionTrash_Call {
+	return &MockProxy_OperateCollectionTrash_Call{Call: _e.mock.On("OperateCollectionTrash", _a0, _a1)}
+}
+
+func (_c *MockProxy_OperateCollectionTrash_Call) Run(run func(_a0 context.Context, _a1 *rootcoordpb.OperateCollectionTrashRequest)) *MockProxy_OperateCollectionTrash_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*rootcoordpb.OperateCollectionTrashRequest))
+	})
+	return _c
+}
+
+func (_c *MockProxy_OperateCollectionTrash_Call) Return(_a0 *commonpb.Status, _a1 error) *MockProxy_OperateCollectionTrash_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockProxy_OperateCollectionTrash_Call) RunAndReturn(run func(context.Context, *rootcoordpb.OperateCollectionTrashRequest) (*commonpb.Status, error)) *MockProxy_OperateCollectionTrash_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// OperateLoadSchedule provides a mock function with given fields: _a0, _a1
+func (_m *MockProxy) OperateLoadSchedule(_a0 context.Context, _a1 *querypb.OperateLoadScheduleRequest) (*commonpb.Status, error) {
+	ret := _m.Called(_a0, _a1)
+
+	var r0 *commonpb.Status
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *querypb.OperateLoadScheduleRequest) (*commonpb.Status, error)); ok {
+		return rf(_a0, _a1)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *querypb.OperateLoadScheduleRequest) *commonpb.Status); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*commonpb.Status)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *querypb.OperateLoadScheduleRequest) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockProxy_OperateLoadSchedule_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'OperateLoadSchedule'
+type MockProxy_OperateLoadSchedule_Call struct {
+	*mock.Call
+}
+
+// OperateLoadSchedule is a helper method to define mock.On call
+//   - _a0 context.Context
+//   - _a1 *querypb.OperateLoadScheduleRequest
+func (_e *MockProxy_Expecter) OperateLoadSchedule(_a0 interface{}, _a1 interface{}) *MockProxy_OperateLoadSchedule_Call {
+	return &MockProxy_OperateLoadSchedule_Call{Call: _e.mock.On("OperateLoadSchedule", _a0, _a1)}
+}
+
+func (_c *MockProxy_OperateLoadSchedule_Call) Run(run func(_a0 context.Context, _a1 *querypb.OperateLoadScheduleRequest)) *MockProxy_OperateLoadSchedule_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*querypb.OperateLoadScheduleRequest))
+	})
+	return _c
+}
+
+func (_c *MockProxy_OperateLoadSchedule_Call) Return(_a0 *commonpb.Status, _a1 error) *MockProxy_OperateLoadSchedule_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockProxy_OperateLoadSchedule_Call) RunAndReturn(run func(context.Context, *querypb.OperateLoadScheduleRequest) (*commonpb.Status, error)) *MockProxy_OperateLoadSchedule_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // OperatePrivilege provides a mock function with given fields: _a0, _a1
 func (_m *MockProxy) OperatePrivilege(_a0 context.Context, _a1 *milvuspb.OperatePrivilegeRequest) (*commonpb.Status, error) {
 	ret := _m.Called(_a0, _a1)
@@ -4019,6 +4630,116 @@ func (_c *MockProxy_OperatePrivilege_Call) RunAndReturn(run func(context.Context
 	return _c
 }
 
+// OperateRoleInheritance provides a mock function with given fields: _a0, _a1
+func (_m *MockProxy) OperateRoleInheritance(_a0 context.Context, _a1 *rootcoordpb.OperateRoleInheritanceRequest) (*commonpb.Status, error) {
+	ret := _m.Called(_a0, _a1)
+
+	var r0 *commonpb.Status
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *rootcoordpb.OperateRoleInheritanceRequest) (*commonpb.Status, error)); ok {
+		return rf(_a0, _a1)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *rootcoordpb.OperateRoleInheritanceRequest) *commonpb.Status); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*commonpb.Status)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *rootcoordpb.OperateRoleInheritanceRequest) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockProxy_OperateRoleInheritance_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'OperateRoleInheritance'
+type MockProxy_OperateRoleInheritance_Call struct {
+	*mock.Call
+}
+
+// OperateRoleInheritance is a helper method to define mock.On call
+//   - _a0 context.Context
+//   - _a1 *rootcoordpb.OperateRoleInheritanceRequest
+func (_e *MockProxy_Expecter) OperateRoleInheritance(_a0 interface{}, _a1 interface{}) *MockProxy_OperateRoleInheritance_Call {
+	return &MockProxy_OperateRoleInheritance_Call{Call: _e.mock.On("OperateRoleInheritance", _a0, _a1)}
+}
+
+func (_c *MockProxy_OperateRoleInheritance_Call) Run(run func(_a0 context.Context, _a1 *rootcoordpb.OperateRoleInheritanceRequest)) *MockProxy_OperateRoleInheritance_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*rootcoordpb.OperateRoleInheritanceRequest))
+	})
+	return _c
+}
+
+func (_c *MockProxy_OperateRoleInheritance_Call) Return(_a0 *commonpb.Status, _a1 error) *MockProxy_OperateRoleInheritance_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockProxy_OperateRoleInheritance_Call) RunAndReturn(run func(context.Context, *rootcoordpb.OperateRoleInheritanceRequest) (*commonpb.Status, error)) *MockProxy_OperateRoleInheritance_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// OperateStorageMigration provides a mock function with given fields: _a0, _a1
+func (_m *MockProxy) OperateStorageMigration(_a0 context.Context, _a1 *datapb.OperateStorageMigrationRequest) (*commonpb.Status, error) {
+	ret := _m.Called(_a0, _a1)
+
+	var r0 *commonpb.Status
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *datapb.OperateStorageMigrationRequest) (*commonpb.Status, error)); ok {
+		return rf(_a0, _a1)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *datapb.OperateStorageMigrationRequest) *commonpb.Status); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*commonpb.Status)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *datapb.OperateStorageMigrationRequest) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockProxy_OperateStorageMigration_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'OperateStorageMigration'
+type MockProxy_OperateStorageMigration_Call struct {
+	*mock.Call
+}
+
+// OperateStorageMigration is a helper method to define mock.On call
+//   - _a0 context.Context
+//   - _a1 *datapb.OperateStorageMigrationRequest
+func (_e *MockProxy_Expecter) OperateStorageMigration(_a0 interface{}, _a1 interface{}) *MockProxy_OperateStorageMigration_Call {
+	return &MockProxy_OperateStorageMigration_Call{Call: _e.mock.On("OperateStorageMigration", _a0, _a1)}
+}
+
+func (_c *MockProxy_OperateStorageMigration_Call) Run(run func(_a0 context.Context, _a1 *datapb.OperateStorageMigrationRequest)) *MockProxy_OperateStorageMigration_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*datapb.OperateStorageMigrationRequest))
+	})
+	return _c
+}
+
+func (_c *MockProxy_OperateStorageMigration_Call) Return(_a0 *commonpb.Status, _a1 error) *MockProxy_OperateStorageMigration_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockProxy_OperateStorageMigration_Call) RunAndReturn(run func(context.Context, *datapb.OperateStorageMigrationRequest) (*commonpb.Status, error)) *MockProxy_OperateStorageMigration_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // OperateUserRole provides a mock function with given fields: _a0, _a1
 func (_m *MockProxy) OperateUserRole(_a0 context.Context, _a1 *milvuspb.OperateUserRoleRequest) (*commonpb.Status, error) {
 	ret := _m.Called(_a0, _a1)
@@ -5385,6 +6106,61 @@ func (_c *MockProxy_UpdateCredentialCache_Call) RunAndReturn(run func(context.Co
 	return _c
 }
 
+// UpdateLoadConfig provides a mock function with given fields: _a0, _a1
+func (_m *MockProxy) UpdateLoadConfig(_a0 context.Context, _a1 *querypb.UpdateLoadConfigRequest) (*commonpb.Status, error) {
+	ret := _m.Called(_a0, _a1)
+
+	var r0 *commonpb.Status
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *querypb.UpdateLoadConfigRequest) (*commonpb.Status, error)); ok {
+		return rf(_a0, _a1)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *querypb.UpdateLoadConfigRequest) *commonpb.Status); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*commonpb.Status)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *querypb.UpdateLoadConfigRequest) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockProxy_UpdateLoadConfig_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateLoadConfig'
+type MockProxy_UpdateLoadConfig_Call struct {
+	*mock.Call
+}
+
+// UpdateLoadConfig is a helper method to define mock.On call
+//   - _a0 context.Context
+//   - _a1 *querypb.UpdateLoadConfigRequest
+func (_e *MockProxy_Expecter) UpdateLoadConfig(_a0 interface{}, _a1 interface{}) *MockProxy_UpdateLoadConfig_Call {
+	return &MockProxy_UpdateLoadConfig_Call{Call: _e.mock.On("UpdateLoadConfig", _a0, _a1)}
+}
+
+func (_c *MockProxy_UpdateLoadConfig_Call) Run(run func(_a0 context.Context, _a1 *querypb.UpdateLoadConfigRequest)) *MockProxy_UpdateLoadConfig_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*querypb.UpdateLoadConfigRequest))
+	})
+	return _c
+}
+
+func (_c *MockProxy_UpdateLoadConfig_Call) Return(_a0 *commonpb.Status, _a1 error) *MockProxy_UpdateLoadConfig_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockProxy_UpdateLoadConfig_Call) RunAndReturn(run func(context.Context, *querypb.UpdateLoadConfigRequest) (*commonpb.Status, error)) *MockProxy_UpdateLoadConfig_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // UpdateStateCode provides a mock function with given fields: stateCode
 func (_m *MockProxy) UpdateStateCode(stateCode commonpb.StateCode) {
 	_m.Called(stateCode)
@@ -955,6 +955,61 @@ func (_c *MockQueryCoord_LoadPartitions_Call) RunAndReturn(run func(context.Cont
 	return _c
 }
 
+// OperateLoadSchedule provides a mock function with given fields: _a0, _a1
+func (_m *MockQueryCoord) OperateLoadSchedule(_a0 context.Context, _a1 *querypb.OperateLoadScheduleRequest) (*commonpb.Status, error) {
+	ret := _m.Called(_a0, _a1)
+
+	var r0 *commonpb.Status
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *querypb.OperateLoadScheduleRequest) (*commonpb.Status, error)); ok {
+		return rf(_a0, _a1)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *querypb.OperateLoadScheduleRequest) *commonpb.Status); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*commonpb.Status)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *querypb.OperateLoadScheduleRequest) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockQueryCoord_OperateLoadSchedule_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'OperateLoadSchedule'
+type MockQueryCoord_OperateLoadSchedule_Call struct {
+	*mock.Call
+}
+
+// OperateLoadSchedule is a helper method to define mock.On call
+//   - _a0 context.Context
+//   - _a1 *querypb.OperateLoadScheduleRequest
+func (_e *MockQueryCoord_Expecter) OperateLoadSchedule(_a0 interface{}, _a1 interface{}) *MockQueryCoord_OperateLoadSchedule_Call {
+	return &MockQueryCoord_OperateLoadSchedule_Call{Call: _e.mock.On("OperateLoadSchedule", _a0, _a1)}
+}
+
+func (_c *MockQueryCoord_OperateLoadSchedule_Call) Run(run func(_a0 context.Context, _a1 *querypb.OperateLoadScheduleRequest)) *MockQueryCoord_OperateLoadSchedule_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*querypb.OperateLoadScheduleRequest))
+	})
+	return _c
+}
+
+func (_c *MockQueryCoord_OperateLoadSchedule_Call) Return(_a0 *commonpb.Status, _a1 error) *MockQueryCoord_OperateLoadSchedule_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockQueryCoord_OperateLoadSchedule_Call) RunAndReturn(run func(context.Context, *querypb.OperateLoadScheduleRequest) (*commonpb.Status, error)) *MockQueryCoord_OperateLoadSchedule_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // Register provides a mock function with given fields:
 func (_m *MockQueryCoord) Register() error {
 	ret := _m.Called()
@@ -1734,6 +1789,61 @@ func (_c *MockQueryCoord_TransferReplica_Call) RunAndReturn(run func(context.Con
 	return _c
 }
 
+// UpdateLoadConfig provides a mock function with given fields: _a0, _a1
+func (_m *MockQueryCoord) UpdateLoadConfig(_a0 context.Context, _a1 *querypb.UpdateLoadConfigRequest) (*commonpb.Status, error) {
+	ret := _m.Called(_a0, _a1)
+
+	var r0 *commonpb.Status
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *querypb.UpdateLoadConfigRequest) (*commonpb.Status, error)); ok {
+		return rf(_a0, _a1)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *querypb.UpdateLoadConfigRequest) *commonpb.Status); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*commonpb.Status)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *querypb.UpdateLoadConfigRequest) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockQueryCoord_UpdateLoadConfig_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateLoadConfig'
+type MockQueryCoord_UpdateLoadConfig_Call struct {
+	*mock.Call
+}
+
+// UpdateLoadConfig is a helper method to define mock.On call
+//   - _a0 context.Context
+//   - _a1 *querypb.UpdateLoadConfigRequest
+func (_e *MockQueryCoord_Expecter) UpdateLoadConfig(_a0 interface{}, _a1 interface{}) *MockQueryCoord_UpdateLoadConfig_Call {
+	return &MockQueryCoord_UpdateLoadConfig_Call{Call: _e.mock.On("UpdateLoadConfig", _a0, _a1)}
+}
+
+func (_c *MockQueryCoord_UpdateLoadConfig_Call) Run(run func(_a0 context.Context, _a1 *querypb.UpdateLoadConfigRequest)) *MockQueryCoord_UpdateLoadConfig_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*querypb.UpdateLoadConfigRequest))
+	})
+	return _c
+}
+
+func (_c *MockQueryCoord_UpdateLoadConfig_Call) Return(_a0 *commonpb.Status, _a1 error) *MockQueryCoord_UpdateLoadConfig_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockQueryCoord_UpdateLoadConfig_Call) RunAndReturn(run func(context.Context, *querypb.UpdateLoadConfigRequest) (*commonpb.Status, error)) *MockQueryCoord_UpdateLoadConfig_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // UpdateStateCode provides a mock function with given fields: stateCode
 func (_m *MockQueryCoord) UpdateStateCode(stateCode commonpb.StateCode) {
 	_m.Called(stateCode)
@@ -256,6 +256,61 @@ func (_c *RootCoord_AlterCollection_Call) RunAndReturn(run func(context.Context,
 	return _c
 }
 
+// CancelDDLTask provides a mock function with given fields: _a0, _a1
+func (_m *RootCoord) CancelDDLTask(_a0 context.Context, _a1 *rootcoordpb.CancelDDLTaskRequest) (*commonpb.Status, error) {
+	ret := _m.Called(_a0, _a1)
+
+	var r0 *commonpb.Status
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *rootcoordpb.CancelDDLTaskRequest) (*commonpb.Status, error)); ok {
+		return rf(_a0, _a1)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *rootcoordpb.CancelDDLTaskRequest) *commonpb.Status); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*commonpb.Status)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *rootcoordpb.CancelDDLTaskRequest) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// RootCoord_CancelDDLTask_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CancelDDLTask'
+type RootCoord_CancelDDLTask_Call struct {
+	*mock.Call
+}
+
+// CancelDDLTask is a helper method to define mock.On call
+//   - _a0 context.Context
+//   - _a1 *rootcoordpb.CancelDDLTaskRequest
+func (_e *RootCoord_Expecter) CancelDDLTask(_a0 interface{}, _a1 interface{}) *RootCoord_CancelDDLTask_Call {
+	return &RootCoord_CancelDDLTask_Call{Call: _e.mock.On("CancelDDLTask", _a0, _a1)}
+}
+
+func (_c *RootCoord_CancelDDLTask_Call) Run(run func(_a0 context.Context, _a1 *rootcoordpb.CancelDDLTaskRequest)) *RootCoord_CancelDDLTask_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*rootcoordpb.CancelDDLTaskRequest))
+	})
+	return _c
+}
+
+func (_c *RootCoord_CancelDDLTask_Call) Return(_a0 *commonpb.Status, _a1 error) *RootCoord_CancelDDLTask_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *RootCoord_CancelDDLTask_Call) RunAndReturn(run func(context.Context, *rootcoordpb.CancelDDLTaskRequest) (*commonpb.Status, error)) *RootCoord_CancelDDLTask_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // CheckHealth provides a mock function with given fields: _a0, _a1
 func (_m *RootCoord) CheckHealth(_a0 context.Context, _a1 *milvuspb.CheckHealthRequest) (*milvuspb.CheckHealthResponse, error) {
 	ret := _m.Called(_a0, _a1)
@@ -421,6 +476,61 @@ func (_c *RootCoord_CreateCollection_Call) RunAndReturn(run func(context.Context
 	return _c
 }
 
+// CreateCollectionFromTemplate provides a mock function with given fields: _a0, _a1
+func (_m *RootCoord) CreateCollectionFromTemplate(_a0 context.Context, _a1 *rootcoordpb.CreateCollectionFromTemplateRequest) (*commonpb.Status, error) {
+	ret := _m.Called(_a0, _a1)
+
+	var r0 *commonpb.Status
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *rootcoordpb.CreateCollectionFromTemplateRequest) (*commonpb.Status, error)); ok {
+		return rf(_a0, _a1)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *rootcoordpb.CreateCollectionFromTemplateRequest) *commonpb.Status); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*commonpb.Status)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *rootcoordpb.CreateCollectionFromTemplateRequest) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// RootCoord_CreateCollectionFromTemplate_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateCollectionFromTemplate'
+type RootCoord_CreateCollectionFromTemplate_Call struct {
+	*mock.Call
+}
+
+// CreateCollectionFromTemplate is a helper method to define mock.On call
+//   - _a0 context.Context
+//   - _a1 *rootcoordpb.CreateCollectionFromTemplateRequest
+func (_e *RootCoord_Expecter) CreateCollectionFromTemplate(_a0 interface{}, _a1 interface{}) *RootCoord_CreateCollectionFromTemplate_Call {
+	return &RootCoord_CreateCollectionFromTemplate_Call{Call: _e.mock.On("CreateCollectionFromTemplate", _a0, _a1)}
+}
+
+func (_c *RootCoord_CreateCollectionFromTemplate_Call) Run(run func(_a0 context.Context, _a1 *rootcoordpb.CreateCollectionFromTemplateRequest)) *RootCoord_CreateCollectionFromTemplate_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*rootcoordpb.CreateCollectionFromTemplateRequest))
+	})
+	return _c
+}
+
+func (_c *RootCoord_CreateCollectionFromTemplate_Call) Return(_a0 *commonpb.Status, _a1 error) *RootCoord_CreateCollectionFromTemplate_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *RootCoord_CreateCollectionFromTemplate_Call) RunAndReturn(run func(context.Context, *rootcoordpb.CreateCollectionFromTemplateRequest) (*commonpb.Status, error)) *RootCoord_CreateCollectionFromTemplate_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // CreateCredential provides a mock function with given fields: _a0, _a1
 func (_m *RootCoord) CreateCredential(_a0 context.Context, _a1 *internalpb.CredentialInfo) (*commonpb.Status, error) {
 	ret := _m.Called(_a0, _a1)
@@ -1672,6 +1782,61 @@ func (_c *RootCoord_InvalidateCollectionMetaCache_Call) RunAndReturn(run func(co
 	return _c
 }
 
+// ListAPIKeys provides a mock function with given fields: _a0, _a1
+func (_m *RootCoord) ListAPIKeys(_a0 context.Context, _a1 *rootcoordpb.ListAPIKeysRequest) (*rootcoordpb.ListAPIKeysResponse, error) {
+	ret := _m.Called(_a0, _a1)
+
+	var r0 *rootcoordpb.ListAPIKeysResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *rootcoordpb.ListAPIKeysRequest) (*rootcoordpb.ListAPIKeysResponse, error)); ok {
+		return rf(_a0, _a1)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *rootcoordpb.ListAPIKeysRequest) *rootcoordpb.ListAPIKeysResponse); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*rootcoordpb.ListAPIKeysResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *rootcoordpb.ListAPIKeysRequest) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// RootCoord_ListAPIKeys_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListAPIKeys'
+type RootCoord_ListAPIKeys_Call struct {
+	*mock.Call
+}
+
+// ListAPIKeys is a helper method to define mock.On call
+//   - _a0 context.Context
+//   - _a1 *rootcoordpb.ListAPIKeysRequest
+func (_e *RootCoord_Expecter) ListAPIKeys(_a0 interface{}, _a1 interface{}) *RootCoord_ListAPIKeys_Call {
+	return &RootCoord_ListAPIKeys_Call{Call: _e.mock.On("ListAPIKeys", _a0, _a1)}
+}
+
+func (_c *RootCoord_ListAPIKeys_Call) Run(run func(_a0 context.Context, _a1 *rootcoordpb.ListAPIKeysRequest)) *RootCoord_ListAPIKeys_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*rootcoordpb.ListAPIKeysRequest))
+	})
+	return _c
+}
+
+func (_c *RootCoord_ListAPIKeys_Call) Return(_a0 *rootcoordpb.ListAPIKeysResponse, _a1 error) *RootCoord_ListAPIKeys_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *RootCoord_ListAPIKeys_Call) RunAndReturn(run func(context.Context, *rootcoordpb.ListAPIKeysRequest) (*rootcoordpb.ListAPIKeysResponse, error)) *RootCoord_ListAPIKeys_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // ListCredUsers provides a mock function with given fields: _a0, _a1
 func (_m *RootCoord) ListCredUsers(_a0 context.Context, _a1 *milvuspb.ListCredUsersRequest) (*milvuspb.ListCredUsersResponse, error) {
 	ret := _m.Called(_a0, _a1)
@@ -1892,6 +2057,226 @@ func (_c *RootCoord_ListPolicy_Call) RunAndReturn(run func(context.Context, *int
 	return _c
 }
 
+// ListRoleInheritances provides a mock function with given fields: _a0, _a1
+func (_m *RootCoord) ListRoleInheritances(_a0 context.Context, _a1 *rootcoordpb.ListRoleInheritancesRequest) (*rootcoordpb.ListRoleInheritancesResponse, error) {
+	ret := _m.Called(_a0, _a1)
+
+	var r0 *rootcoordpb.ListRoleInheritancesResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *rootcoordpb.ListRoleInheritancesRequest) (*rootcoordpb.ListRoleInheritancesResponse, error)); ok {
+		return rf(_a0, _a1)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *rootcoordpb.ListRoleInheritancesRequest) *rootcoordpb.ListRoleInheritancesResponse); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*rootcoordpb.ListRoleInheritancesResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *rootcoordpb.ListRoleInheritancesRequest) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// RootCoord_ListRoleInheritances_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListRoleInheritances'
+type RootCoord_ListRoleInheritances_Call struct {
+	*mock.Call
+}
+
+// ListRoleInheritances is a helper method to define mock.On call
+//   - _a0 context.Context
+//   - _a1 *rootcoordpb.ListRoleInheritancesRequest
+func (_e *RootCoord_Expecter) ListRoleInheritances(_a0 interface{}, _a1 interface{}) *RootCoord_ListRoleInheritances_Call {
+	return &RootCoord_ListRoleInheritances_Call{Call: _e.mock.On("ListRoleInheritances", _a0, _a1)}
+}
+
+func (_c *RootCoord_ListRoleInheritances_Call) Run(run func(_a0 context.Context, _a1 *rootcoordpb.ListRoleInheritancesRequest)) *RootCoord_ListRoleInheritances_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*rootcoordpb.ListRoleInheritancesRequest))
+	})
+	return _c
+}
+
+func (_c *RootCoord_ListRoleInheritances_Call) Return(_a0 *rootcoordpb.ListRoleInheritancesResponse, _a1 error) *RootCoord_ListRoleInheritances_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *RootCoord_ListRoleInheritances_Call) RunAndReturn(run func(context.Context, *rootcoordpb.ListRoleInheritancesRequest) (*rootcoordpb.ListRoleInheritancesResponse, error)) *RootCoord_ListRoleInheritances_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// OperateAPIKey provides a mock function with given fields: _a0, _a1
+func (_m *RootCoord) OperateAPIKey(_a0 context.Context, _a1 *rootcoordpb.OperateAPIKeyRequest) (*rootcoordpb.OperateAPIKeyResponse, error) {
+	ret := _m.Called(_a0, _a1)
+
+	var r0 *rootcoordpb.OperateAPIKeyResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *rootcoordpb.OperateAPIKeyRequest) (*rootcoordpb.OperateAPIKeyResponse, error)); ok {
+		return rf(_a0, _a1)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *rootcoordpb.OperateAPIKeyRequest) *rootcoordpb.OperateAPIKeyResponse); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*rootcoordpb.OperateAPIKeyResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *rootcoordpb.OperateAPIKeyRequest) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// RootCoord_OperateAPIKey_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'OperateAPIKey'
+type RootCoord_OperateAPIKey_Call struct {
+	*mock.Call
+}
+
+// OperateAPIKey is a helper method to define mock.On call
+//   - _a0 context.Context
+//   - _a1 *rootcoordpb.OperateAPIKeyRequest
+func (_e *RootCoord_Expecter) OperateAPIKey(_a0 interface{}, _a1 interface{}) *RootCoord_OperateAPIKey_Call {
+	return &RootCoord_OperateAPIKey_Call{Call: _e.mock.On("OperateAPIKey", _a0, _a1)}
+}
+
+func (_c *RootCoord_OperateAPIKey_Call) Run(run func(_a0 context.Context, _a1 *rootcoordpb.OperateAPIKeyRequest)) *RootCoord_OperateAPIKey_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*rootcoordpb.OperateAPIKeyRequest))
+	})
+	return _c
+}
+
+func (_c *RootCoord_OperateAPIKey_Call) Return(_a0 *rootcoordpb.OperateAPIKeyResponse, _a1 error) *RootCoord_OperateAPIKey_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *RootCoord_OperateAPIKey_Call) RunAndReturn(run func(context.Context, *rootcoordpb.OperateAPIKeyRequest) (*rootcoordpb.OperateAPIKeyResponse, error)) *RootCoord_OperateAPIKey_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// OperateCollectionTemplate provides a mock function with given fields: _a0, _a1
+func (_m *RootCoord) OperateCollectionTemplate(_a0 context.Context, _a1 *rootcoordpb.OperateCollectionTemplateRequest) (*commonpb.Status, error) {
+	ret := _m.Called(_a0, _a1)
+
+	var r0 *commonpb.Status
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *rootcoordpb.OperateCollectionTemplateRequest) (*commonpb.Status, error)); ok {
+		return rf(_a0, _a1)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *rootcoordpb.OperateCollectionTemplateRequest) *commonpb.Status); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*commonpb.Status)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *rootcoordpb.OperateCollectionTemplateRequest) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// RootCoord_OperateCollectionTemplate_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'OperateCollectionTemplate'
+type RootCoord_OperateCollectionTemplate_Call struct {
+	*mock.Call
+}
+
+// OperateCollectionTemplate is a helper method to define mock.On call
+//   - _a0 context.Context
+//   - _a1 *rootcoordpb.OperateCollectionTemplateRequest
+func (_e *RootCoord_Expecter) OperateCollectionTemplate(_a0 interface{}, _a1 interface{}) *RootCoord_OperateCollectionTemplate_Call {
+	return &RootCoord_OperateCollectionTemplate_Call{Call: _e.mock.On("OperateCollectionTemplate", _a0, _a1)}
+}
+
+func (_c *RootCoord_OperateCollectionTemplate_Call) Run(run func(_a0 context.Context, _a1 *rootcoordpb.OperateCollectionTemplateRequest)) *RootCoord_OperateCollectionTemplate_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*rootcoordpb.OperateCollectionTemplateRequest))
+	})
+	return _c
+}
+
+func (_c *RootCoord_OperateCollectionTemplate_Call) Return(_a0 *commonpb.Status, _a1 error) *RootCoord_OperateCollectionTemplate_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *RootCoord_OperateCollectionTemplate_Call) RunAndReturn(run func(context.Context, *rootcoordpb.OperateCollectionTemplateRequest) (*commonpb.Status, error)) *RootCoord_OperateCollectionTemplate_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// OperateCollectionTrash provides a mock function with given fields: _a0, _a1
+func (_m *RootCoord) OperateCollectionTrash(_a0 context.Context, _a1 *rootcoordpb.OperateCollectionTrashRequest) (*commonpb.Status, error) {
+	ret := _m.Called(_a0, _a1)
+
+	var r0 *commonpb.Status
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *rootcoordpb.OperateCollectionTrashRequest) (*commonpb.Status, error)); ok {
+		return rf(_a0, _a1)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *rootcoordpb.OperateCollectionTrashRequest) *commonpb.Status); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*commonpb.Status)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *rootcoordpb.OperateCollectionTrashRequest) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// RootCoord_OperateCollectionTrash_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'OperateCollectionTrash'
+type RootCoord_OperateCollectionTrash_Call struct {
+	*mock.Call
+}
+
+// OperateCollectionTrash is a helper method to define mock.On call
+//   - _a0 context.Context
+//   - _a1 *rootcoordpb.OperateCollectionTrashRequest
+func (_e *RootCoord_Expecter) OperateCollectionTrash(_a0 interface{}, _a1 interface{}) *RootCoord_OperateCollectionTrash_Call {
+	return &RootCoord_OperateCollectionTrash_Call{Call: _e.mock.On("OperateCollectionTrash", _a0, _a1)}
+}
+
+func (_c *RootCoord_OperateCollectionTrash_Call) Run(run func(_a0 context.Context, _a1 *rootcoordpb.OperateCollectionTrashRequest)) *RootCoord_OperateCollectionTrash_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*rootcoordpb.OperateCollectionTrashRequest))
+	})
+	return _c
+}
+
+func (_c *RootCoord_OperateCollectionTrash_Call) Return(_a0 *commonpb.Status, _a1 error) *RootCoord_OperateCollectionTrash_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *RootCoord_OperateCollectionTrash_Call) RunAndReturn(run func(context.Context, *rootcoordpb.OperateCollectionTrashRequest) (*commonpb.Status, error)) *RootCoord_OperateCollectionTrash_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // OperatePrivilege provides a mock function with given fields: _a0, _a1
 func (_m *RootCoord) OperatePrivilege(_a0 context.Context, _a1 *milvuspb.OperatePrivilegeRequest) (*commonpb.Status, error) {
 	ret := _m.Called(_a0, _a1)
@@ -1947,6 +2332,61 @@ func (_c *RootCoord_OperatePrivilege_Call) RunAndReturn(run func(context.Context
 	return _c
 }
 
+// OperateRoleInheritance provides a mock function with given fields: _a0, _a1
+func (_m *RootCoord) OperateRoleInheritance(_a0 context.Context, _a1 *rootcoordpb.OperateRoleInheritanceRequest) (*commonpb.Status, error) {
+	ret := _m.Called(_a0, _a1)
+
+	var r0 *commonpb.Status
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *rootcoordpb.OperateRoleInheritanceRequest) (*commonpb.Status, error)); ok {
+		return rf(_a0, _a1)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *rootcoordpb.OperateRoleInheritanceRequest) *commonpb.Status); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*commonpb.Status)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *rootcoordpb.OperateRoleInheritanceRequest) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// RootCoord_OperateRoleInheritance_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'OperateRoleInheritance'
+type RootCoord_OperateRoleInheritance_Call struct {
+	*mock.Call
+}
+
+// OperateRoleInheritance is a helper method to define mock.On call
+//   - _a0 context.Context
+//   - _a1 *rootcoordpb.OperateRoleInheritanceRequest
+func (_e *RootCoord_Expecter) OperateRoleInheritance(_a0 interface{}, _a1 interface{}) *RootCoord_OperateRoleInheritance_Call {
+	return &RootCoord_OperateRoleInheritance_Call{Call: _e.mock.On("OperateRoleInheritance", _a0, _a1)}
+}
+
+func (_c *RootCoord_OperateRoleInheritance_Call) Run(run func(_a0 context.Context, _a1 *rootcoordpb.OperateRoleInheritanceRequest)) *RootCoord_OperateRoleInheritance_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*rootcoordpb.OperateRoleInheritanceRequest))
+	})
+	return _c
+}
+
+func (_c *RootCoord_OperateRoleInheritance_Call) Return(_a0 *commonpb.Status, _a1 error) *RootCoord_OperateRoleInheritance_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *RootCoord_OperateRoleInheritance_Call) RunAndReturn(run func(context.Context, *rootcoordpb.OperateRoleInheritanceRequest) (*commonpb.Status, error)) *RootCoord_OperateRoleInheritance_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // OperateUserRole provides a mock function with given fields: _a0, _a1
 func (_m *RootCoord) OperateUserRole(_a0 context.Context, _a1 *milvuspb.OperateUserRoleRequest) (*commonpb.Status, error) {
 	ret := _m.Called(_a0, _a1)
@@ -313,6 +313,76 @@ func (_c *MockRootCoordClient_AlterCollection_Call) RunAndReturn(run func(contex
 	return _c
 }
 
+// CancelDDLTask provides a mock function with given fields: ctx, in, opts
+func (_m *MockRootCoordClient) CancelDDLTask(ctx context.Context, in *rootcoordpb.CancelDDLTaskRequest, opts ...grpc.CallOption) (*commonpb.Status, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *commonpb.Status
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *rootcoordpb.CancelDDLTaskRequest, ...grpc.CallOption) (*commonpb.Status, error)); ok {
+		return rf(ctx, in, opts...)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *rootcoordpb.CancelDDLTaskRequest, ...grpc.CallOption) *commonpb.Status); ok {
+		r0 = rf(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*commonpb.Status)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *rootcoordpb.CancelDDLTaskRequest, ...grpc.CallOption) error); ok {
+		r1 = rf(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockRootCoordClient_CancelDDLTask_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CancelDDLTask'
+type MockRootCoordClient_CancelDDLTask_Call struct {
+	*mock.Call
+}
+
+// CancelDDLTask is a helper method to define mock.On call
+//   - ctx context.Context
+//   - in *rootcoordpb.CancelDDLTaskRequest
+//   - opts ...grpc.CallOption
+func (_e *MockRootCoordClient_Expecter) CancelDDLTask(ctx interface{}, in interface{}, opts ...interface{}) *MockRootCoordClient_CancelDDLTask_Call {
+	return &MockRootCoordClient_CancelDDLTask_Call{Call: _e.mock.On("CancelDDLTask",
+		append([]interface{}{ctx, in}, opts...)...)}
+}
+
+func (_c *MockRootCoordClient_CancelDDLTask_Call) Run(run func(ctx context.Context, in *rootcoordpb.CancelDDLTaskRequest, opts ...grpc.CallOption)) *MockRootCoordClient_CancelDDLTask_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		variadicArgs := make([]grpc.CallOption, len(args)-2)
+		for i, a := range args[2:] {
+			if a != nil {
+				variadicArgs[i] = a.(grpc.CallOption)
+			}
+		}
+		run(args[0].(context.Context), args[1].(*rootcoordpb.CancelDDLTaskRequest), variadicArgs...)
+	})
+	return _c
+}
+
+func (_c *MockRootCoordClient_CancelDDLTask_Call) Return(_a0 *commonpb.Status, _a1 error) *MockRootCoordClient_CancelDDLTask_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockRootCoordClient_CancelDDLTask_Call) RunAndReturn(run func(context.Context, *rootcoordpb.CancelDDLTaskRequest, ...grpc.CallOption) (*commonpb.Status, error)) *MockRootCoordClient_CancelDDLTask_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // CheckHealth provides a mock function with given fields: ctx, in, opts
 func (_m *MockRootCoordClient) CheckHealth(ctx context.Context, in *milvuspb.CheckHealthRequest, opts ...grpc.CallOption) (*milvuspb.CheckHealthResponse, error) {
 	_va := make([]interface{}, len(opts))
@@ -564,6 +634,76 @@ func (_c *MockRootCoordClient_CreateCollection_Call) RunAndReturn(run func(conte
 	return _c
 }
 
+// CreateCollectionFromTemplate provides a mock function with given fields: ctx, in, opts
+func (_m *MockRootCoordClient) CreateCollectionFromTemplate(ctx context.Context, in *rootcoordpb.CreateCollectionFromTemplateRequest, opts ...grpc.CallOption) (*commonpb.Status, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *commonpb.Status
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *rootcoordpb.CreateCollectionFromTemplateRequest, ...grpc.CallOption) (*commonpb.Status, error)); ok {
+		return rf(ctx, in, opts...)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *rootcoordpb.CreateCollectionFromTemplateRequest, ...grpc.CallOption) *commonpb.Status); ok {
+		r0 = rf(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*commonpb.Status)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *rootcoordpb.CreateCollectionFromTemplateRequest, ...grpc.CallOption) error); ok {
+		r1 = rf(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockRootCoordClient_CreateCollectionFromTemplate_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateCollectionFromTemplate'
+type MockRootCoordClient_CreateCollectionFromTemplate_Call struct {
+	*mock.Call
+}
+
+// CreateCollectionFromTemplate is a helper method to define mock.On call
+//   - ctx context.Context
+//   - in *rootcoordpb.CreateCollectionFromTemplateRequest
+//   - opts ...grpc.CallOption
+func (_e *MockRootCoordClient_Expecter) CreateCollectionFromTemplate(ctx interface{}, in interface{}, opts ...interface{}) *MockRootCoordClient_CreateCollectionFromTemplate_Call {
+	return &MockRootCoordClient_CreateCollectionFromTemplate_Call{Call: _e.mock.On("CreateCollectionFromTemplate",
+		append([]interface{}{ctx, in}, opts...)...)}
+}
+
+func (_c *MockRootCoordClient_CreateCollectionFromTemplate_Call) Run(run func(ctx context.Context, in *rootcoordpb.CreateCollectionFromTemplateRequest, opts ...grpc.CallOption)) *MockRootCoordClient_CreateCollectionFromTemplate_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		variadicArgs := make([]grpc.CallOption, len(args)-2)
+		for i, a := range args[2:] {
+			if a != nil {
+				variadicArgs[i] = a.(grpc.CallOption)
+			}
+		}
+		run(args[0].(context.Context), args[1].(*rootcoordpb.CreateCollectionFromTemplateRequest), variadicArgs...)
+	})
+	return _c
+}
+
+func (_c *MockRootCoordClient_CreateCollectionFromTemplate_Call) Return(_a0 *commonpb.Status, _a1 error) *MockRootCoordClient_CreateCollectionFromTemplate_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockRootCoordClient_CreateCollectionFromTemplate_Call) RunAndReturn(run func(context.Context, *rootcoordpb.CreateCollectionFromTemplateRequest, ...grpc.CallOption) (*commonpb.Status, error)) *MockRootCoordClient_CreateCollectionFromTemplate_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // CreateCredential provides a mock function with given fields: ctx, in, opts
 func (_m *MockRootCoordClient) CreateCredential(ctx context.Context, in *internalpb.CredentialInfo, opts ...grpc.CallOption) (*commonpb.Status, error) {
 	_va := make([]interface{}, len(opts))
@@ -2104,6 +2244,76 @@ func (_c *MockRootCoordClient_InvalidateCollectionMetaCache_Call) RunAndReturn(r
 	return _c
 }
 
+// ListAPIKeys provides a mock function with given fields: ctx, in, opts
+func (_m *MockRootCoordClient) ListAPIKeys(ctx context.Context, in *rootcoordpb.ListAPIKeysRequest, opts ...grpc.CallOption) (*rootcoordpb.ListAPIKeysResponse, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *rootcoordpb.ListAPIKeysResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *rootcoordpb.ListAPIKeysRequest, ...grpc.CallOption) (*rootcoordpb.ListAPIKeysResponse, error)); ok {
+		return rf(ctx, in, opts...)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *rootcoordpb.ListAPIKeysRequest, ...grpc.CallOption) *rootcoordpb.ListAPIKeysResponse); ok {
+		r0 = rf(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*rootcoordpb.ListAPIKeysResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *rootcoordpb.ListAPIKeysRequest, ...grpc.CallOption) error); ok {
+		r1 = rf(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockRootCoordClient_ListAPIKeys_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListAPIKeys'
+type MockRootCoordClient_ListAPIKeys_Call struct {
+	*mock.Call
+}
+
+// ListAPIKeys is a helper method to define mock.On call
+//   - ctx context.Context
+//   - in *rootcoordpb.ListAPIKeysRequest
+//   - opts ...grpc.CallOption
+func (_e *MockRootCoordClient_Expecter) ListAPIKeys(ctx interface{}, in interface{}, opts ...interface{}) *MockRootCoordClient_ListAPIKeys_Call {
+	return &MockRootCoordClient_ListAPIKeys_Call{Call: _e.mock.On("ListAPIKeys",
+		append([]interface{}{ctx, in}, opts...)...)}
+}
+
+func (_c *MockRootCoordClient_ListAPIKeys_Call) Run(run func(ctx context.Context, in *rootcoordpb.ListAPIKeysRequest, opts ...grpc.CallOption)) *MockRootCoordClient_ListAPIKeys_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		variadicArgs := make([]grpc.CallOption, len(args)-2)
+		for i, a := range args[2:] {
+			if a != nil {
+				variadicArgs[i] = a.(grpc.CallOption)
+			}
+		}
+		run(args[0].(context.Context), args[1].(*rootcoordpb.ListAPIKeysRequest), variadicArgs...)
+	})
+	return _c
+}
+
+func (_c *MockRootCoordClient_ListAPIKeys_Call) Return(_a0 *rootcoordpb.ListAPIKeysResponse, _a1 error) *MockRootCoordClient_ListAPIKeys_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockRootCoordClient_ListAPIKeys_Call) RunAndReturn(run func(context.Context, *rootcoordpb.ListAPIKeysRequest, ...grpc.CallOption) (*rootcoordpb.ListAPIKeysResponse, error)) *MockRootCoordClient_ListAPIKeys_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // ListCredUsers provides a mock function with given fields: ctx, in, opts
 func (_m *MockRootCoordClient) ListCredUsers(ctx context.Context, in *milvuspb.ListCredUsersRequest, opts ...grpc.CallOption) (*milvuspb.ListCredUsersResponse, error) {
 	_va := make([]interface{}, len(opts))
@@ -2384,6 +2594,286 @@ func (_c *MockRootCoordClient_ListPolicy_Call) RunAndReturn(run func(context.Con
 	return _c
 }
 
+// ListRoleInheritances provides a mock function with given fields: ctx, in, opts
+func (_m *MockRootCoordClient) ListRoleInheritances(ctx context.Context, in *rootcoordpb.ListRoleInheritancesRequest, opts ...grpc.CallOption) (*rootcoordpb.ListRoleInheritancesResponse, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *rootcoordpb.ListRoleInheritancesResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *rootcoordpb.ListRoleInheritancesRequest, ...grpc.CallOption) (*rootcoordpb.ListRoleInheritancesResponse, error)); ok {
+		return rf(ctx, in, opts...)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *rootcoordpb.ListRoleInheritancesRequest, ...grpc.CallOption) *rootcoordpb.ListRoleInheritancesResponse); ok {
+		r0 = rf(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*rootcoordpb.ListRoleInheritancesResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *rootcoordpb.ListRoleInheritancesRequest, ...grpc.CallOption) error); ok {
+		r1 = rf(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockRootCoordClient_ListRoleInheritances_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListRoleInheritances'
+type MockRootCoordClient_ListRoleInheritances_Call struct {
+	*mock.Call
+}
+
+// ListRoleInheritances is a helper method to define mock.On call
+//   - ctx context.Context
+//   - in *rootcoordpb.ListRoleInheritancesRequest
+//   - opts ...grpc.CallOption
+func (_e *MockRootCoordClient_Expecter) ListRoleInheritances(ctx interface{}, in interface{}, opts ...interface{}) *MockRootCoordClient_ListRoleInheritances_Call {
+	return &MockRootCoordClient_ListRoleInheritances_Call{Call: _e.mock.On("ListRoleInheritances",
+		append([]interface{}{ctx, in}, opts...)...)}
+}
+
+func (_c *MockRootCoordClient_ListRoleInheritances_Call) Run(run func(ctx context.Context, in *rootcoordpb.ListRoleInheritancesRequest, opts ...grpc.CallOption)) *MockRootCoordClient_ListRoleInheritances_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		variadicArgs := make([]grpc.CallOption, len(args)-2)
+		for i, a := range args[2:] {
+			if a != nil {
+				variadicArgs[i] = a.(grpc.CallOption)
+			}
+		}
+		run(args[0].(context.Context), args[1].(*rootcoordpb.ListRoleInheritancesRequest), variadicArgs...)
+	})
+	return _c
+}
+
+func (_c *MockRootCoordClient_ListRoleInheritances_Call) Return(_a0 *rootcoordpb.ListRoleInheritancesResponse, _a1 error) *MockRootCoordClient_ListRoleInheritances_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockRootCoordClient_ListRoleInheritances_Call) RunAndReturn(run func(context.Context, *rootcoordpb.ListRoleInheritancesRequest, ...grpc.CallOption) (*rootcoordpb.ListRoleInheritancesResponse, error)) *MockRootCoordClient_ListRoleInheritances_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// OperateAPIKey provides a mock function with given fields: ctx, in, opts
+func (_m *MockRootCoordClient) OperateAPIKey(ctx context.Context, in *rootcoordpb.OperateAPIKeyRequest, opts ...grpc.CallOption) (*rootcoordpb.OperateAPIKeyResponse, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *rootcoordpb.OperateAPIKeyResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *rootcoordpb.OperateAPIKeyRequest, ...grpc.CallOption) (*rootcoordpb.OperateAPIKeyResponse, error)); ok {
+		return rf(ctx, in, opts...)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *rootcoordpb.OperateAPIKeyRequest, ...grpc.CallOption) *rootcoordpb.OperateAPIKeyResponse); ok {
+		r0 = rf(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*rootcoordpb.OperateAPIKeyResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *rootcoordpb.OperateAPIKeyRequest, ...grpc.CallOption) error); ok {
+		r1 = rf(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockRootCoordClient_OperateAPIKey_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'OperateAPIKey'
+type MockRootCoordClient_OperateAPIKey_Call struct {
+	*mock.Call
+}
+
+// OperateAPIKey is a helper method to define mock.On call
+//   - ctx context.Context
+//   - in *rootcoordpb.OperateAPIKeyRequest
+//   - opts ...grpc.CallOption
+func (_e *MockRootCoordClient_Expecter) OperateAPIKey(ctx interface{}, in interface{}, opts ...interface{}) *MockRootCoordClient_OperateAPIKey_Call {
+	return &MockRootCoordClient_OperateAPIKey_Call{Call: _e.mock.On("OperateAPIKey",
+		append([]interface{}{ctx, in}, opts...)...)}
+}
+
+func (_c *MockRootCoordClient_OperateAPIKey_Call) Run(run func(ctx context.Context, in *rootcoordpb.OperateAPIKeyRequest, opts ...grpc.CallOption)) *MockRootCoordClient_OperateAPIKey_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		variadicArgs := make([]grpc.CallOption, len(args)-2)
+		for i, a := range args[2:] {
+			if a != nil {
+				variadicArgs[i] = a.(grpc.CallOption)
+			}
+		}
+		run(args[0].(context.Context), args[1].(*rootcoordpb.OperateAPIKeyRequest), variadicArgs...)
+	})
+	return _c
+}
+
+func (_c *MockRootCoordClient_OperateAPIKey_Call) Return(_a0 *rootcoordpb.OperateAPIKeyResponse, _a1 error) *MockRootCoordClient_OperateAPIKey_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockRootCoordClient_OperateAPIKey_Call) RunAndReturn(run func(context.Context, *rootcoordpb.OperateAPIKeyRequest, ...grpc.CallOption) (*rootcoordpb.OperateAPIKeyResponse, error)) *MockRootCoordClient_OperateAPIKey_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// OperateCollectionTemplate provides a mock function with given fields: ctx, in, opts
+func (_m *MockRootCoordClient) OperateCollectionTemplate(ctx context.Context, in *rootcoordpb.OperateCollectionTemplateRequest, opts ...grpc.CallOption) (*commonpb.Status, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *commonpb.Status
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *rootcoordpb.OperateCollectionTemplateRequest, ...grpc.CallOption) (*commonpb.Status, error)); ok {
+		return rf(ctx, in, opts...)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *rootcoordpb.OperateCollectionTemplateRequest, ...grpc.CallOption) *commonpb.Status); ok {
+		r0 = rf(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*commonpb.Status)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *rootcoordpb.OperateCollectionTemplateRequest, ...grpc.CallOption) error); ok {
+		r1 = rf(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockRootCoordClient_OperateCollectionTemplate_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'OperateCollectionTemplate'
+type MockRootCoordClient_OperateCollectionTemplate_Call struct {
+	*mock.Call
+}
+
+// OperateCollectionTemplate is a helper method to define mock.On call
+//   - ctx context.Context
+//   - in *rootcoordpb.OperateCollectionTemplateRequest
+//   - opts ...grpc.CallOption
+func (_e *MockRootCoordClient_Expecter) OperateCollectionTemplate(ctx interface{}, in interface{}, opts ...interface{}) *MockRootCoordClient_OperateCollectionTemplate_Call {
+	return &MockRootCoordClient_OperateCollectionTemplate_Call{Call: _e.mock.On("OperateCollectionTemplate",
+		append([]interface{}{ctx, in}, opts...)...)}
+}
+
+func (_c *MockRootCoordClient_OperateCollectionTemplate_Call) Run(run func(ctx context.Context, in *rootcoordpb.OperateCollectionTemplateRequest, opts ...grpc.CallOption)) *MockRootCoordClient_OperateCollectionTemplate_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		variadicArgs := make([]grpc.CallOption, len(args)-2)
+		for i, a := range args[2:] {
+			if a != nil {
+				variadicArgs[i] = a.(grpc.CallOption)
+			}
+		}
+		run(args[0].(context.Context), args[1].(*rootcoordpb.OperateCollectionTemplateRequest), variadicArgs...)
+	})
+	return _c
+}
+
+func (_c *MockRootCoordClient_OperateCollectionTemplate_Call) Return(_a0 *commonpb.Status, _a1 error) *MockRootCoordClient_OperateCollectionTemplate_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockRootCoordClient_OperateCollectionTemplate_Call) RunAndReturn(run func(context.Context, *rootcoordpb.OperateCollectionTemplateRequest, ...grpc.CallOption) (*commonpb.Status, error)) *MockRootCoordClient_OperateCollectionTemplate_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// OperateCollectionTrash provides a mock function with given fields: ctx, in, opts
+func (_m *MockRootCoordClient) OperateCollectionTrash(ctx context.Context, in *rootcoordpb.OperateCollectionTrashRequest, opts ...grpc.CallOption) (*commonpb.Status, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *commonpb.Status
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *rootcoordpb.OperateCollectionTrashRequest, ...grpc.CallOption) (*commonpb.Status, error)); ok {
+		return rf(ctx, in, opts...)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *rootcoordpb.OperateCollectionTrashRequest, ...grpc.CallOption) *commonpb.Status); ok {
+		r0 = rf(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*commonpb.Status)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *rootcoordpb.OperateCollectionTrashRequest, ...grpc.CallOption) error); ok {
+		r1 = rf(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockRootCoordClient_OperateCollectionTrash_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'OperateCollectionTrash'
+type MockRootCoordClient_OperateCollectionTrash_Call struct {
+	*mock.Call
+}
+
+// OperateCollectionTrash is a helper method to define mock.On call
+//   - ctx context.Context
+//   - in *rootcoordpb.OperateCollectionTrashRequest
+//   - opts ...grpc.CallOption
+func (_e *MockRootCoordClient_Expecter) OperateCollectionTrash(ctx interface{}, in interface{}, opts ...interface{}) *MockRootCoordClient_OperateCollectionTrash_Call {
+	return &MockRootCoordClient_OperateCollectionTrash_Call{Call: _e.mock.On("OperateCollectionTrash",
+		append([]interface{}{ctx, in}, opts...)...)}
+}
+
+func (_c *MockRootCoordClient_OperateCollectionTrash_Call) Run(run func(ctx context.Context, in *rootcoordpb.OperateCollectionTrashRequest, opts ...grpc.CallOption)) *MockRootCoordClient_OperateCollectionTrash_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		variadicArgs := make([]grpc.CallOption, len(args)-2)
+		for i, a := range args[2:] {
+			if a != nil {
+				variadicArgs[i] = a.(grpc.CallOption)
+			}
+		}
+		run(args[0].(context.Context), args[1].(*rootcoordpb.OperateCollectionTrashRequest), variadicArgs...)
+	})
+	return _c
+}
+
+func (_c *MockRootCoordClient_OperateCollectionTrash_Call) Return(_a0 *commonpb.Status, _a1 error) *MockRootCoordClient_OperateCollectionTrash_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockRootCoordClient_OperateCollectionTrash_Call) RunAndReturn(run func(context.Context, *rootcoordpb.OperateCollectionTrashRequest, ...grpc.CallOption) (*commonpb.Status, error)) *MockRootCoordClient_OperateCollectionTrash_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // OperatePrivilege provides a mock function with given fields: ctx, in, opts
 func (_m *MockRootCoordClient) OperatePrivilege(ctx context.Context, in *milvuspb.OperatePrivilegeRequest, opts ...grpc.CallOption) (*commonpb.Status, error) {
 	_va := make([]interface{}, len(opts))
@@ -2454,6 +2944,76 @@ func (_c *MockRootCoordClient_OperatePrivilege_Call) RunAndReturn(run func(conte
 	return _c
 }
 
+// OperateRoleInheritance provides a mock function with given fields: ctx, in, opts
+func (_m *MockRootCoordClient) OperateRoleInheritance(ctx context.Context, in *rootcoordpb.OperateRoleInheritanceRequest, opts ...grpc.CallOption) (*commonpb.Status, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *commonpb.Status
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *rootcoordpb.OperateRoleInheritanceRequest, ...grpc.CallOption) (*commonpb.Status, error)); ok {
+		return rf(ctx, in, opts...)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *rootcoordpb.OperateRoleInheritanceRequest, ...grpc.CallOption) *commonpb.Status); ok {
+		r0 = rf(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*commonpb.Status)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *rootcoordpb.OperateRoleInheritanceRequest, ...grpc.CallOption) error); ok {
+		r1 = rf(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockRootCoordClient_OperateRoleInheritance_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'OperateRoleInheritance'
+type MockRootCoordClient_OperateRoleInheritance_Call struct {
+	*mock.Call
+}
+
+// OperateRoleInheritance is a helper method to define mock.On call
+//   - ctx context.Context
+//   - in *rootcoordpb.OperateRoleInheritanceRequest
+//   - opts ...grpc.CallOption
+func (_e *MockRootCoordClient_Expecter) OperateRoleInheritance(ctx interface{}, in interface{}, opts ...interface{}) *MockRootCoordClient_OperateRoleInheritance_Call {
+	return &MockRootCoordClient_OperateRoleInheritance_Call{Call: _e.mock.On("OperateRoleInheritance",
+		append([]interface{}{ctx, in}, opts...)...)}
+}
+
+func (_c *MockRootCoordClient_OperateRoleInheritance_Call) Run(run func(ctx context.Context, in *rootcoordpb.OperateRoleInheritanceRequest, opts ...grpc.CallOption)) *MockRootCoordClient_OperateRoleInheritance_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		variadicArgs := make([]grpc.CallOption, len(args)-2)
+		for i, a := range args[2:] {
+			if a != nil {
+				variadicArgs[i] = a.(grpc.CallOption)
+			}
+		}
+		run(args[0].(context.Context), args[1].(*rootcoordpb.OperateRoleInheritanceRequest), variadicArgs...)
+	})
+	return _c
+}
+
+func (_c *MockRootCoordClient_OperateRoleInheritance_Call) Return(_a0 *commonpb.Status, _a1 error) *MockRootCoordClient_OperateRoleInheritance_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockRootCoordClient_OperateRoleInheritance_Call) RunAndReturn(run func(context.Context, *rootcoordpb.OperateRoleInheritanceRequest, ...grpc.CallOption) (*commonpb.Status, error)) *MockRootCoordClient_OperateRoleInheritance_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // OperateUserRole provides a mock function with given fields: ctx, in, opts
 func (_m *MockRootCoordClient) OperateUserRole(ctx context.Context, in *milvuspb.OperateUserRoleRequest, opts ...grpc.CallOption) (*commonpb.Status, error) {
 	_va := make([]interface{}, len(opts))
@@ -30,6 +30,7 @@ import (
 	"github.com/milvus-io/milvus/internal/kv"
 	rocksdbkv "github.com/milvus-io/milvus/internal/kv/rocksdb"
 	"github.com/milvus-io/milvus/pkg/log"
+	"github.com/milvus-io/milvus/pkg/metrics"
 	"github.com/milvus-io/milvus/pkg/util/hardware"
 	"github.com/milvus-io/milvus/pkg/util/merr"
 	"github.com/milvus-io/milvus/pkg/util/paramtable"
@@ -485,6 +486,9 @@ func (rmq *rocksmq) DestroyTopic(topicName string) error {
 	// clean up retention info
 	topicMu.Delete(topicName)
 	rmq.retentionInfo.topicRetetionTime.GetAndRemove(topicName)
+	metrics.RocksmqTopicSize.DeleteLabelValues(topicName)
+	metrics.RocksmqTopicOldestMessageAge.DeleteLabelValues(topicName)
+	metrics.RocksmqRetentionCleanedSize.DeleteLabelValues(topicName)
 
 	log.Debug("Rocksmq destroy topic successfully ", zap.String("topic", topicName), zap.Int64("elapsed", time.Since(start).Milliseconds()))
 	return nil
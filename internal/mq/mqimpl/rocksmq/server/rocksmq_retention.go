@@ -23,6 +23,7 @@ import (
 
 	rocksdbkv "github.com/milvus-io/milvus/internal/kv/rocksdb"
 	"github.com/milvus-io/milvus/pkg/log"
+	"github.com/milvus-io/milvus/pkg/metrics"
 	"github.com/milvus-io/milvus/pkg/util/paramtable"
 	"github.com/milvus-io/milvus/pkg/util/typeutil"
 )
@@ -32,6 +33,10 @@ const (
 	MB = 1024 * 1024
 )
 
+// compactionQueueSize is the max number of topics waiting for compaction,
+// the topics beyond it are reclaimed by the next cleanup or the periodic full compaction.
+const compactionQueueSize = 128
+
 type retentionInfo struct {
 	// key is topic name, value is last retention time
 	topicRetetionTime *typeutil.ConcurrentMap[string, int64]
@@ -40,6 +45,10 @@ type retentionInfo struct {
 	kv *rocksdbkv.RocksdbKV
 	db *gorocksdb.DB
 
+	// topics whose cleaned messages wait for compaction to reclaim the space
+	compactionCh      chan string
+	pendingCompaction *typeutil.ConcurrentSet[string]
+
 	closeCh   chan struct{}
 	closeWg   sync.WaitGroup
 	closeOnce sync.Once
@@ -51,6 +60,8 @@ func initRetentionInfo(kv *rocksdbkv.RocksdbKV, db *gorocksdb.DB) (*retentionInf
 		mutex:             sync.RWMutex{},
 		kv:                kv,
 		db:                db,
+		compactionCh:      make(chan string, compactionQueueSize),
+		pendingCompaction: typeutil.NewConcurrentSet[string](),
 		closeCh:           make(chan struct{}),
 		closeWg:           sync.WaitGroup{},
 	}
@@ -71,8 +82,9 @@ func initRetentionInfo(kv *rocksdbkv.RocksdbKV, db *gorocksdb.DB) (*retentionInf
 // Because loadRetentionInfo may need some time, so do this asynchronously. Finally start retention goroutine.
 func (ri *retentionInfo) startRetentionInfo() {
 	// var wg sync.WaitGroup
-	ri.closeWg.Add(1)
+	ri.closeWg.Add(2)
 	go ri.retention()
+	go ri.compaction()
 }
 
 // retention do time ticker and trigger retention check and operation for each topic
@@ -97,16 +109,19 @@ func (ri *retentionInfo) retention() error {
 			go ri.kv.DB.CompactRange(gorocksdb.Range{Start: nil, Limit: nil})
 		case t := <-ticker.C:
 			timeNow := t.Unix()
-			checkTime := int64(params.RocksmqCfg.RetentionTimeInMinutes.GetAsFloat() * 60 / 10)
 			ri.mutex.RLock()
 			ri.topicRetetionTime.Range(func(topic string, lastRetentionTs int64) bool {
-				if lastRetentionTs+checkTime < timeNow {
-					err := ri.expiredCleanUp(topic)
+				policy := getRetentionPolicy(topic)
+				if lastRetentionTs+policy.checkInterval() < timeNow {
+					err := ri.expiredCleanUp(topic, policy)
 					if err != nil {
 						log.Warn("Retention expired clean failed", zap.Error(err))
 					}
 					ri.topicRetetionTime.Insert(topic, timeNow)
 				}
+				if err := ri.updateTopicMetrics(topic); err != nil {
+					log.Warn("failed to update rocksmq topic metrics", zap.String("topic", topic), zap.Error(err))
+				}
 				return true
 			})
 			ri.mutex.RUnlock()
@@ -122,12 +137,135 @@ func (ri *retentionInfo) Stop() {
 	})
 }
 
+// compaction reclaims the space of the cleaned messages in background, one topic at a time,
+// so the producers won't be blocked by the cleanup.
+func (ri *retentionInfo) compaction() {
+	defer ri.closeWg.Done()
+	for {
+		select {
+		case <-ri.closeCh:
+			return
+		case topic := <-ri.compactionCh:
+			ri.pendingCompaction.Remove(topic)
+			start := time.Now()
+			// compact the messages of the topic only, the range is [topic/, topic0)
+			prefix := topic + "/"
+			ri.db.CompactRange(gorocksdb.Range{Start: []byte(prefix), Limit: []byte(typeutil.AddOne(prefix))})
+			metrics.RocksmqCompactionLatency.Observe(float64(time.Since(start).Milliseconds()))
+			log.Info("rocksmq topic compaction done", zap.String("topic", topic), zap.Duration("elapse", time.Since(start)))
+		}
+	}
+}
+
+// triggerCompaction queues the topic for compaction without blocking, the topic already queued is skipped.
+func (ri *retentionInfo) triggerCompaction(topic string) {
+	if !ri.pendingCompaction.Insert(topic) {
+		return
+	}
+	select {
+	case ri.compactionCh <- topic:
+	default:
+		ri.pendingCompaction.Remove(topic)
+	}
+}
+
+// updateTopicMetrics updates the size retained by the topic, and the age of its oldest sealed page.
+func (ri *retentionInfo) updateTopicMetrics(topic string) error {
+	pageMsgPrefix := constructKey(PageMsgSizeTitle, topic) + "/"
+	_, sizes, err := ri.kv.LoadWithPrefix(pageMsgPrefix)
+	if err != nil {
+		return err
+	}
+	curPageSize, err := ri.kv.Load(MessageSizeTitle + topic)
+	if err != nil {
+		return err
+	}
+	var totalSize int64
+	for _, size := range append(sizes, curPageSize) {
+		if size == "" {
+			continue
+		}
+		pageSize, err := strconv.ParseInt(size, 10, 64)
+		if err != nil {
+			return err
+		}
+		totalSize += pageSize
+	}
+	metrics.RocksmqTopicSize.WithLabelValues(topic).Set(float64(totalSize))
+
+	var oldestAge float64
+	pageTsPrefix := constructKey(PageTsTitle, topic) + "/"
+	pageReadOpts := gorocksdb.NewDefaultReadOptions()
+	defer pageReadOpts.Destroy()
+	pageIter := rocksdbkv.NewRocksIteratorWithUpperBound(ri.kv.DB, typeutil.AddOne(pageTsPrefix), pageReadOpts)
+	defer pageIter.Close()
+	// pick the smallest page id rather than the first key, as the keys are in lexicographic order
+	oldestPageID := UniqueID(-1)
+	var oldestPageTs string
+	for pageIter.Seek([]byte(pageTsPrefix)); pageIter.Valid(); pageIter.Next() {
+		key, value := pageIter.Key(), pageIter.Value()
+		pageID, err := parsePageID(string(key.Data()))
+		pageTs := string(value.Data())
+		key.Free()
+		value.Free()
+		if err != nil {
+			return err
+		}
+		if oldestPageID < 0 || pageID < oldestPageID {
+			oldestPageID, oldestPageTs = pageID, pageTs
+		}
+	}
+	if err := pageIter.Err(); err != nil {
+		return err
+	}
+	if oldestPageID >= 0 {
+		ts, err := strconv.ParseInt(oldestPageTs, 10, 64)
+		if err != nil {
+			return err
+		}
+		oldestAge = time.Since(time.Unix(ts, 0)).Seconds()
+	}
+	metrics.RocksmqTopicOldestMessageAge.WithLabelValues(topic).Set(oldestAge)
+	return nil
+}
+
+// pageExpireTs returns the timestamp to check the expiration of the page, which is the acked ts of the page,
+// or the ts of the last message of the page if the unacked pages are expired as well.
+// ok is false if the page is not allowed to expire.
+func (ri *retentionInfo) pageExpireTs(topic string, pageID UniqueID, includeUnacked bool) (int64, bool, error) {
+	ackedTsKey := constructKey(AckedTsTitle, topic) + "/" + strconv.FormatInt(pageID, 10)
+	tsVal, err := ri.kv.Load(ackedTsKey)
+	if err != nil {
+		return 0, false, err
+	}
+	if tsVal == "" {
+		// not acked page
+		if !includeUnacked {
+			return 0, false, nil
+		}
+		pageTsKey := constructKey(PageTsTitle, topic) + "/" + strconv.FormatInt(pageID, 10)
+		tsVal, err = ri.kv.Load(pageTsKey)
+		if err != nil {
+			return 0, false, err
+		}
+		if tsVal == "" {
+			return 0, false, nil
+		}
+	}
+	ts, err := strconv.ParseInt(tsVal, 10, 64)
+	if err != nil {
+		return 0, false, err
+	}
+	return ts, true, nil
+}
+
 // expiredCleanUp check message retention by page:
 // 1. check acked timestamp of each page id, if expired, the whole page is expired;
 // 2. check acked size from the last unexpired page id;
 // 3. delete acked info by range of page id;
 // 4. delete message by range of page id;
-func (ri *retentionInfo) expiredCleanUp(topic string) error {
+// The unacked pages are checked by the ts of their last message as well, if the policy includes unacked.
+func (ri *retentionInfo) expiredCleanUp(topic string, policy retentionPolicy) error {
 	start := time.Now()
 	var deletedAckedSize int64
 	var pageCleaned UniqueID
@@ -135,9 +273,8 @@ func (ri *retentionInfo) expiredCleanUp(topic string) error {
 	var pageEndID UniqueID
 	var err error
 
-	fixedAckedTsKey := constructKey(AckedTsTitle, topic)
 	// calculate total acked size, simply add all page info
-	totalAckedSize, err := ri.calculateTopicAckedSize(topic)
+	totalAckedSize, err := ri.calculateTopicAckedSize(topic, policy.includeUnacked)
 	if err != nil {
 		return err
 	}
@@ -163,21 +300,16 @@ func (ri *retentionInfo) expiredCleanUp(topic string) error {
 		if err != nil {
 			return err
 		}
-		ackedTsKey := fixedAckedTsKey + "/" + strconv.FormatInt(pageID, 10)
-		ackedTsVal, err := ri.kv.Load(ackedTsKey)
+		ackedTs, ok, err := ri.pageExpireTs(topic, pageID, policy.includeUnacked)
 		if err != nil {
 			return err
 		}
-		// not acked page, TODO add TTL info there
-		if ackedTsVal == "" {
+		// not acked page
+		if !ok {
 			break
 		}
-		ackedTs, err := strconv.ParseInt(ackedTsVal, 10, 64)
-		if err != nil {
-			return err
-		}
 		lastAck = ackedTs
-		if msgTimeExpiredCheck(ackedTs) {
+		if policy.timeExpired(ackedTs) {
 			pageEndID = pageID
 			pValue := pageIter.Value()
 			size, err := strconv.ParseInt(string(pValue.Data()), 10, 64)
@@ -216,7 +348,7 @@ func (ri *retentionInfo) expiredCleanUp(topic string) error {
 			return err
 		}
 		curDeleteSize := deletedAckedSize + size
-		if msgSizeExpiredCheck(curDeleteSize, totalAckedSize) {
+		if policy.sizeExpired(curDeleteSize, totalAckedSize) {
 			pageEndID, err = parsePageID(pKeyStr)
 			if err != nil {
 				return err
@@ -239,12 +371,16 @@ func (ri *retentionInfo) expiredCleanUp(topic string) error {
 	log.Debug("Expired check by message size: ", zap.Any("topic", topic),
 		zap.Any("pageEndID", pageEndID), zap.Any("deletedAckedSize", deletedAckedSize),
 		zap.Any("pageCleaned", pageCleaned), zap.Any("time taken", expireTime))
-	return ri.cleanData(topic, pageEndID)
+	if err := ri.cleanData(topic, pageEndID); err != nil {
+		return err
+	}
+	metrics.RocksmqRetentionCleanedSize.WithLabelValues(topic).Add(float64(deletedAckedSize))
+	ri.triggerCompaction(topic)
+	return nil
 }
 
-func (ri *retentionInfo) calculateTopicAckedSize(topic string) (int64, error) {
-	fixedAckedTsKey := constructKey(AckedTsTitle, topic)
-
+// calculateTopicAckedSize sums the size of the acked pages, the unacked pages are counted as well if includeUnacked.
+func (ri *retentionInfo) calculateTopicAckedSize(topic string, includeUnacked bool) (int64, error) {
 	pageReadOpts := gorocksdb.NewDefaultReadOptions()
 	defer pageReadOpts.Destroy()
 	pageMsgPrefix := constructKey(PageMsgSizeTitle, topic) + "/"
@@ -264,14 +400,12 @@ func (ri *retentionInfo) calculateTopicAckedSize(topic string) (int64, error) {
 		}
 
 		// check if page is acked
-		ackedTsKey := fixedAckedTsKey + "/" + strconv.FormatInt(pageID, 10)
-		ackedTsVal, err := ri.kv.Load(ackedTsKey)
+		_, ok, err := ri.pageExpireTs(topic, pageID, includeUnacked)
 		if err != nil {
 			return -1, err
 		}
 		// not acked yet, break
-		// TODO, Add TTL logic here, mark it as acked if not
-		if ackedTsVal == "" {
+		if !ok {
 			break
 		}
 
@@ -353,21 +487,3 @@ func DeleteMessages(db *gorocksdb.DB, topic string, startID, endID UniqueID) err
 	log.Debug("Delete message for topic", zap.String("topic", topic), zap.Int64("startID", startID), zap.Int64("endID", endID))
 	return nil
 }
-
-func msgTimeExpiredCheck(ackedTs int64) bool {
-	params := paramtable.Get()
-	retentionSeconds := int64(params.RocksmqCfg.RetentionTimeInMinutes.GetAsFloat() * 60)
-	if retentionSeconds < 0 {
-		return false
-	}
-	return ackedTs+retentionSeconds < time.Now().Unix()
-}
-
-func msgSizeExpiredCheck(deletedAckedSize, ackedSize int64) bool {
-	params := paramtable.Get()
-	size := params.RocksmqCfg.RetentionSizeInMB.GetAsInt64()
-	if size < 0 {
-		return false
-	}
-	return ackedSize-deletedAckedSize > size*MB
-}
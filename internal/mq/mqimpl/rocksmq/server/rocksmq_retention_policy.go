@@ -0,0 +1,119 @@
+// Copyright (C) 2019-2020 Zilliz. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software distributed under the License
+// is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express
+// or implied. See the License for the specific language governing permissions and limitations under the License.
+
+package server
+
+import (
+	"strconv"
+	"strings"
+	"time"
+
+	"go.uber.org/zap"
+
+	"github.com/milvus-io/milvus/pkg/log"
+	"github.com/milvus-io/milvus/pkg/util/paramtable"
+)
+
+// keys of the topic retention, the prefix of the topic goes before them
+const (
+	retentionTimeKey           = "retentiontimeinminutes"
+	retentionSizeKey           = "retentionsizeinmb"
+	retentionIncludeUnackedKey = "retentionincludeunacked"
+)
+
+// retentionPolicy is the retention of a topic, the messages are expired once either the time or the size exceeds.
+type retentionPolicy struct {
+	// negative means no limit
+	timeInSeconds int64
+	sizeInBytes   int64
+	// includeUnacked expires the messages not acked yet, so the lagging consumers won't grow the disk unbounded
+	includeUnacked bool
+}
+
+// getRetentionPolicy returns the retention of the topic, the topic retention with the longest matched prefix
+// overrides the global retention.
+func getRetentionPolicy(topic string) retentionPolicy {
+	params := paramtable.Get()
+	policy := retentionPolicy{
+		timeInSeconds:  int64(params.RocksmqCfg.RetentionTimeInMinutes.GetAsFloat() * 60),
+		sizeInBytes:    params.RocksmqCfg.RetentionSizeInMB.GetAsInt64() * MB,
+		includeUnacked: params.RocksmqCfg.RetentionIncludeUnacked.GetAsBool(),
+	}
+	if policy.sizeInBytes < 0 {
+		policy.sizeInBytes = -1
+	}
+
+	// the keys are lower cased by the config manager
+	topic = strings.ToLower(topic)
+	matched := make(map[string]string)
+	matchedPrefix := make(map[string]int)
+	for key, value := range params.RocksmqCfg.TopicRetention.GetValue() {
+		idx := strings.LastIndex(key, ".")
+		if idx <= 0 {
+			continue
+		}
+		prefix, name := key[:idx], key[idx+1:]
+		if !strings.HasPrefix(topic, prefix) || len(prefix) < matchedPrefix[name] {
+			continue
+		}
+		matched[name] = value
+		matchedPrefix[name] = len(prefix)
+	}
+
+	if value, ok := matched[retentionTimeKey]; ok {
+		minutes, err := strconv.ParseFloat(value, 64)
+		if err != nil {
+			log.Warn("invalid topic retention time", zap.String("topic", topic), zap.String("value", value))
+		} else {
+			policy.timeInSeconds = int64(minutes * 60)
+		}
+	}
+	if value, ok := matched[retentionSizeKey]; ok {
+		size, err := strconv.ParseInt(value, 10, 64)
+		if err != nil {
+			log.Warn("invalid topic retention size", zap.String("topic", topic), zap.String("value", value))
+		} else if size < 0 {
+			policy.sizeInBytes = -1
+		} else {
+			policy.sizeInBytes = size * MB
+		}
+	}
+	if value, ok := matched[retentionIncludeUnackedKey]; ok {
+		includeUnacked, err := strconv.ParseBool(value)
+		if err != nil {
+			log.Warn("invalid topic retention includeUnacked", zap.String("topic", topic), zap.String("value", value))
+		} else {
+			policy.includeUnacked = includeUnacked
+		}
+	}
+	return policy
+}
+
+// timeExpired checks whether the page acked or sealed at ts is expired.
+func (p retentionPolicy) timeExpired(ts int64) bool {
+	if p.timeInSeconds < 0 {
+		return false
+	}
+	return ts+p.timeInSeconds < time.Now().Unix()
+}
+
+// sizeExpired checks whether the retained size still exceeds after the deleted size cleaned.
+func (p retentionPolicy) sizeExpired(deletedSize, totalSize int64) bool {
+	if p.sizeInBytes < 0 {
+		return false
+	}
+	return totalSize-deletedSize > p.sizeInBytes
+}
+
+// checkInterval is the interval to check the retention of the topic.
+func (p retentionPolicy) checkInterval() int64 {
+	return p.timeInSeconds / 10
+}
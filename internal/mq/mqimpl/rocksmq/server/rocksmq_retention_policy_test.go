@@ -0,0 +1,68 @@
+// Copyright (C) 2019-2020 Zilliz. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software distributed under the License
+// is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express
+// or implied. See the License for the specific language governing permissions and limitations under the License.
+
+package server
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/milvus-io/milvus/pkg/util/paramtable"
+)
+
+func TestGetRetentionPolicy(t *testing.T) {
+	paramtable.Init()
+	params := paramtable.Get()
+	params.Save(params.RocksmqCfg.RetentionTimeInMinutes.Key, "10")
+	defer params.Reset(params.RocksmqCfg.RetentionTimeInMinutes.Key)
+	params.Save(params.RocksmqCfg.RetentionSizeInMB.Key, "100")
+	defer params.Reset(params.RocksmqCfg.RetentionSizeInMB.Key)
+
+	policy := getRetentionPolicy("by-dev-rootcoord-dml_0")
+	assert.Equal(t, retentionPolicy{timeInSeconds: 600, sizeInBytes: 100 * MB}, policy)
+
+	// the keys are lower cased by the config manager
+	params.RocksmqCfg.TopicRetention.GetFunc = func() map[string]string {
+		return map[string]string{
+			"by-dev-rootcoord-dml.retentionsizeinmb":          "10",
+			"by-dev-rootcoord-dml.retentionincludeunacked":    "true",
+			"by-dev-rootcoord-dml_1.retentionsizeinmb":        "-1",
+			"by-dev-rootcoord-dml_1.retentiontimeinminutes":   "invalid",
+			"by-dev-rootcoord-delta_0.retentiontimeinminutes": "1",
+		}
+	}
+	defer func() {
+		params.RocksmqCfg.TopicRetention.GetFunc = nil
+	}()
+
+	policy = getRetentionPolicy("by-dev-rootcoord-dml_0")
+	assert.Equal(t, retentionPolicy{timeInSeconds: 600, sizeInBytes: 10 * MB, includeUnacked: true}, policy)
+	// the longer prefix wins, the invalid value is ignored
+	policy = getRetentionPolicy("by-dev-rootcoord-dml_1")
+	assert.Equal(t, retentionPolicy{timeInSeconds: 600, sizeInBytes: -1, includeUnacked: true}, policy)
+	policy = getRetentionPolicy("by-dev-rootcoord-delta_0")
+	assert.Equal(t, retentionPolicy{timeInSeconds: 60, sizeInBytes: 100 * MB}, policy)
+}
+
+func TestRetentionPolicy_Expired(t *testing.T) {
+	policy := retentionPolicy{timeInSeconds: 60, sizeInBytes: 10}
+	assert.True(t, policy.timeExpired(time.Now().Unix()-61))
+	assert.False(t, policy.timeExpired(time.Now().Unix()))
+	assert.True(t, policy.sizeExpired(5, 20))
+	assert.False(t, policy.sizeExpired(10, 20))
+	assert.EqualValues(t, 6, policy.checkInterval())
+
+	policy = retentionPolicy{timeInSeconds: -1, sizeInBytes: -1}
+	assert.False(t, policy.timeExpired(0))
+	assert.False(t, policy.sizeExpired(0, 1<<40))
+}
@@ -17,10 +17,12 @@ import (
 	"testing"
 	"time"
 
+	"github.com/prometheus/client_golang/prometheus/testutil"
 	"github.com/stretchr/testify/assert"
 	"go.uber.org/zap"
 
 	"github.com/milvus-io/milvus/pkg/log"
+	"github.com/milvus-io/milvus/pkg/metrics"
 	"github.com/milvus-io/milvus/pkg/util/paramtable"
 )
 
@@ -652,3 +654,83 @@ func TestRmqRetention_PageSizeExpire(t *testing.T) {
 	// make sure clean up happens
 	assert.True(t, newRes[0].MsgID > ids[0])
 }
+
+func TestRmqRetention_IncludeUnacked(t *testing.T) {
+	err := os.MkdirAll(retentionPath, os.ModePerm)
+	if err != nil {
+		log.Error("MkdirAll error for path", zap.Any("path", retentionPath))
+		return
+	}
+	defer os.RemoveAll(retentionPath)
+	suffix := "unacked"
+	kvPath := retentionPath + kvPathSuffix + suffix
+	defer os.RemoveAll(kvPath)
+	idAllocator := InitIDAllocator(kvPath)
+
+	rocksdbPath := retentionPath + suffix
+	defer os.RemoveAll(rocksdbPath)
+	metaPath := retentionPath + metaPathSuffix + suffix
+	defer os.RemoveAll(metaPath)
+
+	params := paramtable.Get()
+	paramtable.Init()
+	params.Save(params.RocksmqCfg.PageSize.Key, "10")
+	params.Save(params.RocksmqCfg.TickerTimeInSeconds.Key, "600")
+
+	rmq, err := NewRocksMQ(rocksdbPath, idAllocator)
+	assert.NoError(t, err)
+	defer rmq.Close()
+
+	topicName := "topic_unacked"
+	err = rmq.CreateTopic(topicName)
+	assert.NoError(t, err)
+	defer rmq.DestroyTopic(topicName)
+
+	// the last message stays in the unsealed page
+	msgNum := 101
+	pMsgs := make([]ProducerMessage, msgNum)
+	for i := 0; i < msgNum; i++ {
+		pMsgs[i] = ProducerMessage{Payload: []byte("message_" + strconv.Itoa(i))}
+	}
+	ids, err := rmq.Produce(topicName, pMsgs)
+	assert.NoError(t, err)
+
+	groupName := "test_group"
+	err = rmq.CreateConsumerGroup(topicName, groupName)
+	assert.NoError(t, err)
+	rmq.RegisterConsumer(&Consumer{Topic: topicName, GroupName: groupName})
+
+	pageMsgSizeKey := constructKey(PageMsgSizeTitle, topicName)
+	keys, _, err := rmq.kv.LoadWithPrefix(pageMsgSizeKey)
+	assert.NoError(t, err)
+	assert.NotEmpty(t, keys)
+
+	// nothing acked, the messages are retained
+	policy := retentionPolicy{timeInSeconds: -1, sizeInBytes: 0}
+	err = rmq.retentionInfo.expiredCleanUp(topicName, policy)
+	assert.NoError(t, err)
+	keys, _, err = rmq.kv.LoadWithPrefix(pageMsgSizeKey)
+	assert.NoError(t, err)
+	assert.NotEmpty(t, keys)
+
+	// expire the unacked messages as well, the last sealed page is retained
+	policy.includeUnacked = true
+	err = rmq.retentionInfo.expiredCleanUp(topicName, policy)
+	assert.NoError(t, err)
+	keys, _, err = rmq.kv.LoadWithPrefix(pageMsgSizeKey)
+	assert.NoError(t, err)
+	assert.Len(t, keys, 1)
+
+	// the lagging consumer skips the cleaned messages
+	cMsgs, err := rmq.Consume(topicName, groupName, 1)
+	assert.NoError(t, err)
+	assert.Len(t, cMsgs, 1)
+	assert.Equal(t, ids[msgNum-3], cMsgs[0].MsgID)
+
+	err = rmq.retentionInfo.updateTopicMetrics(topicName)
+	assert.NoError(t, err)
+	// the last sealed page with 2 messages and the unsealed page with 1 message
+	retainedSize := len(pMsgs[msgNum-3].Payload) + len(pMsgs[msgNum-2].Payload) + len(pMsgs[msgNum-1].Payload)
+	assert.Equal(t, float64(retainedSize), testutil.ToFloat64(metrics.RocksmqTopicSize.WithLabelValues(topicName)))
+	assert.Less(t, testutil.ToFloat64(metrics.RocksmqTopicOldestMessageAge.WithLabelValues(topicName)), float64(60))
+}
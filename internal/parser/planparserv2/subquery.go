@@ -0,0 +1,139 @@
+package planparserv2
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+)
+
+// Subquery is the subquery in the expression like `pk in (select pk from other_collection where ...)`,
+// it's executed before the expression parsed, and replaced with the term of its results.
+type Subquery struct {
+	// Field is the selected field of the subquery collection.
+	Field string
+	// Collection is the name of the subquery collection, in the same database.
+	Collection string
+	// Expr is the filter of the subquery, empty if no where clause.
+	Expr string
+
+	// the range of the parenthesized subquery in the expression
+	start, end int
+}
+
+var subqueryPattern = regexp.MustCompile(`(?is)^\s*select\s+([A-Za-z_][A-Za-z0-9_]*)\s+from\s+([A-Za-z_][A-Za-z0-9_]*)(?:\s+where\s+(.*?))?\s*$`)
+
+// ParseSubqueries finds the subqueries of the IN and NOT IN operators in the expression, nested subquery is not supported.
+func ParseSubqueries(exprStr string) ([]*Subquery, error) {
+	subqueries := make([]*Subquery, 0)
+	var quote byte
+	for i := 0; i < len(exprStr); i++ {
+		c := exprStr[i]
+		if quote != 0 {
+			if c == '\\' {
+				i++
+			} else if c == quote {
+				quote = 0
+			}
+			continue
+		}
+		if c == '"' || c == '\'' {
+			quote = c
+			continue
+		}
+		if !isKeywordAt(exprStr, i, "in") {
+			continue
+		}
+		open := skipSpaces(exprStr, i+len("in"))
+		if open >= len(exprStr) || exprStr[open] != '(' || !isKeywordAt(exprStr, skipSpaces(exprStr, open+1), "select") {
+			continue
+		}
+
+		closing, err := findClosingParen(exprStr, open)
+		if err != nil {
+			return nil, err
+		}
+		body := exprStr[open+1 : closing]
+		matches := subqueryPattern.FindStringSubmatch(body)
+		if matches == nil {
+			return nil, fmt.Errorf("invalid subquery (%s), should be like (select field from collection where expr)", strings.TrimSpace(body))
+		}
+		if nested, err := ParseSubqueries(matches[3]); err != nil || len(nested) > 0 {
+			return nil, fmt.Errorf("nested subquery is not supported: (%s)", strings.TrimSpace(body))
+		}
+		subqueries = append(subqueries, &Subquery{
+			Field:      matches[1],
+			Collection: matches[2],
+			Expr:       matches[3],
+			start:      open,
+			end:        closing + 1,
+		})
+		i = closing
+	}
+	return subqueries, nil
+}
+
+// ReplaceSubqueries replaces the subqueries in the expression with the terms, like `[1, 2, 3]`,
+// the subqueries must be the ones parsed from the expression, and the terms are in the same order.
+func ReplaceSubqueries(exprStr string, subqueries []*Subquery, terms []string) string {
+	var b strings.Builder
+	last := 0
+	for i, subquery := range subqueries {
+		b.WriteString(exprStr[last:subquery.start])
+		b.WriteString(terms[i])
+		last = subquery.end
+	}
+	b.WriteString(exprStr[last:])
+	return b.String()
+}
+
+// isKeywordAt checks whether the keyword is at the position as a whole word, case-insensitively.
+func isKeywordAt(s string, pos int, keyword string) bool {
+	if pos+len(keyword) > len(s) || !strings.EqualFold(s[pos:pos+len(keyword)], keyword) {
+		return false
+	}
+	if pos > 0 && isIdentifierChar(s[pos-1]) {
+		return false
+	}
+	end := pos + len(keyword)
+	return end == len(s) || !isIdentifierChar(s[end])
+}
+
+func isIdentifierChar(c byte) bool {
+	return c == '_' || c == '$' || (c >= 'a' && c <= 'z') || (c >= 'A' && c <= 'Z') || (c >= '0' && c <= '9')
+}
+
+func skipSpaces(s string, pos int) int {
+	for pos < len(s) && (s[pos] == ' ' || s[pos] == '\t' || s[pos] == '\n' || s[pos] == '\r') {
+		pos++
+	}
+	return pos
+}
+
+// findClosingParen returns the position of the parenthesis closing the open one, string literals are skipped.
+func findClosingParen(s string, open int) (int, error) {
+	depth := 0
+	var quote byte
+	for i := open; i < len(s); i++ {
+		c := s[i]
+		if quote != 0 {
+			if c == '\\' {
+				i++
+			} else if c == quote {
+				quote = 0
+			}
+			continue
+		}
+		switch c {
+		case '"', '\'':
+			quote = c
+		case '(':
+			depth++
+		case ')':
+			depth--
+			if depth == 0 {
+				return i, nil
+			}
+		}
+	}
+	return 0, fmt.Errorf("unclosed parenthesis of subquery: %s", s[open:])
+}
@@ -0,0 +1,57 @@
+package planparserv2
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseSubqueries(t *testing.T) {
+	t.Run("no subquery", func(t *testing.T) {
+		for _, expr := range []string{
+			"",
+			"pk in [1, 2, 3]",
+			`name == "in (select pk from c)"`,
+			"(pk > 1) and (join in [1])",
+		} {
+			subqueries, err := ParseSubqueries(expr)
+			assert.NoError(t, err, expr)
+			assert.Empty(t, subqueries, expr)
+		}
+	})
+
+	t.Run("subqueries", func(t *testing.T) {
+		expr := `age > 10 and pk in (SELECT id from users where name like "a%" and (x > 1)) or pk not in (select id from banned)`
+		subqueries, err := ParseSubqueries(expr)
+		require.NoError(t, err)
+		require.Len(t, subqueries, 2)
+		assert.Equal(t, "id", subqueries[0].Field)
+		assert.Equal(t, "users", subqueries[0].Collection)
+		assert.Equal(t, `name like "a%" and (x > 1)`, subqueries[0].Expr)
+		assert.Equal(t, "banned", subqueries[1].Collection)
+		assert.Empty(t, subqueries[1].Expr)
+
+		assert.Equal(t, "age > 10 and pk in [1, 2] or pk not in []",
+			ReplaceSubqueries(expr, subqueries, []string{"[1, 2]", "[]"}))
+	})
+
+	t.Run("string literal with parenthesis", func(t *testing.T) {
+		subqueries, err := ParseSubqueries(`pk in (select id from users where name == "a)\"b")`)
+		require.NoError(t, err)
+		require.Len(t, subqueries, 1)
+		assert.Equal(t, `name == "a)\"b"`, subqueries[0].Expr)
+	})
+
+	t.Run("invalid", func(t *testing.T) {
+		for _, expr := range []string{
+			"pk in (select id from users where a > 1",
+			"pk in (select from users)",
+			"pk in (select id, name from users)",
+			"pk in (select id from users where id in (select id from others))",
+		} {
+			_, err := ParseSubqueries(expr)
+			assert.Error(t, err, expr)
+		}
+	})
+}
@@ -173,6 +173,31 @@ func (CompactionType) EnumDescriptor() ([]byte, []int) {
 	return fileDescriptor_82cd95f524594f49, []int{3}
 }
 
+type StorageMigrationOperateType int32
+
+const (
+	StorageMigrationOperateType_StartMigration  StorageMigrationOperateType = 0
+	StorageMigrationOperateType_CancelMigration StorageMigrationOperateType = 1
+)
+
+var StorageMigrationOperateType_name = map[int32]string{
+	0: "StartMigration",
+	1: "CancelMigration",
+}
+
+var StorageMigrationOperateType_value = map[string]int32{
+	"StartMigration":  0,
+	"CancelMigration": 1,
+}
+
+func (x StorageMigrationOperateType) String() string {
+	return proto.EnumName(StorageMigrationOperateType_name, int32(x))
+}
+
+func (StorageMigrationOperateType) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_82cd95f524594f49, []int{4}
+}
+
 // TODO: import google/protobuf/empty.proto
 type Empty struct {
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
@@ -1853,6 +1878,8 @@ type SaveBinlogPathsRequest struct {
 	Dropped              bool                    `protobuf:"varint,10,opt,name=dropped,proto3" json:"dropped,omitempty"`
 	Importing            bool                    `protobuf:"varint,11,opt,name=importing,proto3" json:"importing,omitempty"`
 	Channel              string                  `protobuf:"bytes,12,opt,name=channel,proto3" json:"channel,omitempty"`
+	SegLevel             SegmentLevel            `protobuf:"varint,13,opt,name=seg_level,json=segLevel,proto3,enum=milvus.proto.data.SegmentLevel" json:"seg_level,omitempty"`
+	PartitionID          int64                   `protobuf:"varint,14,opt,name=partitionID,proto3" json:"partitionID,omitempty"`
 	XXX_NoUnkeyedLiteral struct{}                `json:"-"`
 	XXX_unrecognized     []byte                  `json:"-"`
 	XXX_sizecache        int32                   `json:"-"`
@@ -1967,6 +1994,20 @@ func (m *SaveBinlogPathsRequest) GetChannel() string {
 	return ""
 }
 
+func (m *SaveBinlogPathsRequest) GetSegLevel() SegmentLevel {
+	if m != nil {
+		return m.SegLevel
+	}
+	return SegmentLevel_Legacy
+}
+
+func (m *SaveBinlogPathsRequest) GetPartitionID() int64 {
+	if m != nil {
+		return m.PartitionID
+	}
+	return 0
+}
+
 type CheckPoint struct {
 	SegmentID            int64              `protobuf:"varint,1,opt,name=segmentID,proto3" json:"segmentID,omitempty"`
 	Position             *msgpb.MsgPosition `protobuf:"bytes,2,opt,name=position,proto3" json:"position,omitempty"`
@@ -2337,6 +2378,7 @@ type Binlog struct {
 	LogPath              string   `protobuf:"bytes,4,opt,name=log_path,json=logPath,proto3" json:"log_path,omitempty"`
 	LogSize              int64    `protobuf:"varint,5,opt,name=log_size,json=logSize,proto3" json:"log_size,omitempty"`
 	LogID                int64    `protobuf:"varint,6,opt,name=logID,proto3" json:"logID,omitempty"`
+	Checksum             string   `protobuf:"bytes,7,opt,name=checksum,proto3" json:"checksum,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
@@ -2409,6 +2451,13 @@ func (m *Binlog) GetLogID() int64 {
 	return 0
 }
 
+func (m *Binlog) GetChecksum() string {
+	if m != nil {
+		return m.Checksum
+	}
+	return ""
+}
+
 type GetRecoveryInfoResponse struct {
 	Status               *commonpb.Status  `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
 	Channels             []*VchannelInfo   `protobuf:"bytes,2,rep,name=channels,proto3" json:"channels,omitempty"`
@@ -3027,14 +3076,16 @@ func (m *CompactionStateRequest) GetBase() *commonpb.MsgBase {
 }
 
 type SyncSegmentsRequest struct {
-	PlanID               int64          `protobuf:"varint,1,opt,name=planID,proto3" json:"planID,omitempty"`
-	CompactedTo          int64          `protobuf:"varint,2,opt,name=compacted_to,json=compactedTo,proto3" json:"compacted_to,omitempty"`
-	NumOfRows            int64          `protobuf:"varint,3,opt,name=num_of_rows,json=numOfRows,proto3" json:"num_of_rows,omitempty"`
-	CompactedFrom        []int64        `protobuf:"varint,4,rep,packed,name=compacted_from,json=compactedFrom,proto3" json:"compacted_from,omitempty"`
-	StatsLogs            []*FieldBinlog `protobuf:"bytes,5,rep,name=stats_logs,json=statsLogs,proto3" json:"stats_logs,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}       `json:"-"`
-	XXX_unrecognized     []byte         `json:"-"`
-	XXX_sizecache        int32          `json:"-"`
+	PlanID        int64          `protobuf:"varint,1,opt,name=planID,proto3" json:"planID,omitempty"`
+	CompactedTo   int64          `protobuf:"varint,2,opt,name=compacted_to,json=compactedTo,proto3" json:"compacted_to,omitempty"`
+	NumOfRows     int64          `protobuf:"varint,3,opt,name=num_of_rows,json=numOfRows,proto3" json:"num_of_rows,omitempty"`
+	CompactedFrom []int64        `protobuf:"varint,4,rep,packed,name=compacted_from,json=compactedFrom,proto3" json:"compacted_from,omitempty"`
+	StatsLogs     []*FieldBinlog `protobuf:"bytes,5,rep,name=stats_logs,json=statsLogs,proto3" json:"stats_logs,omitempty"`
+	// abandoned means the compaction result is discarded, e.g. failed to pass the verification
+	Abandoned            bool     `protobuf:"varint,6,opt,name=abandoned,proto3" json:"abandoned,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
 func (m *SyncSegmentsRequest) Reset()         { *m = SyncSegmentsRequest{} }
@@ -3097,12 +3148,21 @@ func (m *SyncSegmentsRequest) GetStatsLogs() []*FieldBinlog {
 	return nil
 }
 
+func (m *SyncSegmentsRequest) GetAbandoned() bool {
+	if m != nil {
+		return m.Abandoned
+	}
+	return false
+}
+
 type CompactionSegmentBinlogs struct {
 	SegmentID            int64          `protobuf:"varint,1,opt,name=segmentID,proto3" json:"segmentID,omitempty"`
 	FieldBinlogs         []*FieldBinlog `protobuf:"bytes,2,rep,name=fieldBinlogs,proto3" json:"fieldBinlogs,omitempty"`
 	Field2StatslogPaths  []*FieldBinlog `protobuf:"bytes,3,rep,name=field2StatslogPaths,proto3" json:"field2StatslogPaths,omitempty"`
 	Deltalogs            []*FieldBinlog `protobuf:"bytes,4,rep,name=deltalogs,proto3" json:"deltalogs,omitempty"`
 	InsertChannel        string         `protobuf:"bytes,5,opt,name=insert_channel,json=insertChannel,proto3" json:"insert_channel,omitempty"`
+	Level                SegmentLevel   `protobuf:"varint,6,opt,name=level,proto3,enum=milvus.proto.data.SegmentLevel" json:"level,omitempty"`
+	PartitionID          int64          `protobuf:"varint,7,opt,name=partitionID,proto3" json:"partitionID,omitempty"`
 	XXX_NoUnkeyedLiteral struct{}       `json:"-"`
 	XXX_unrecognized     []byte         `json:"-"`
 	XXX_sizecache        int32          `json:"-"`
@@ -3168,6 +3228,20 @@ func (m *CompactionSegmentBinlogs) GetInsertChannel() string {
 	return ""
 }
 
+func (m *CompactionSegmentBinlogs) GetLevel() SegmentLevel {
+	if m != nil {
+		return m.Level
+	}
+	return SegmentLevel_Legacy
+}
+
+func (m *CompactionSegmentBinlogs) GetPartitionID() int64 {
+	if m != nil {
+		return m.PartitionID
+	}
+	return 0
+}
+
 type CompactionPlan struct {
 	PlanID               int64                       `protobuf:"varint,1,opt,name=planID,proto3" json:"planID,omitempty"`
 	SegmentBinlogs       []*CompactionSegmentBinlogs `protobuf:"bytes,2,rep,name=segmentBinlogs,proto3" json:"segmentBinlogs,omitempty"`
@@ -3272,16 +3346,18 @@ func (m *CompactionPlan) GetTotalRows() int64 {
 }
 
 type CompactionResult struct {
-	PlanID               int64          `protobuf:"varint,1,opt,name=planID,proto3" json:"planID,omitempty"`
-	SegmentID            int64          `protobuf:"varint,2,opt,name=segmentID,proto3" json:"segmentID,omitempty"`
-	NumOfRows            int64          `protobuf:"varint,3,opt,name=num_of_rows,json=numOfRows,proto3" json:"num_of_rows,omitempty"`
-	InsertLogs           []*FieldBinlog `protobuf:"bytes,4,rep,name=insert_logs,json=insertLogs,proto3" json:"insert_logs,omitempty"`
-	Field2StatslogPaths  []*FieldBinlog `protobuf:"bytes,5,rep,name=field2StatslogPaths,proto3" json:"field2StatslogPaths,omitempty"`
-	Deltalogs            []*FieldBinlog `protobuf:"bytes,6,rep,name=deltalogs,proto3" json:"deltalogs,omitempty"`
-	Channel              string         `protobuf:"bytes,7,opt,name=channel,proto3" json:"channel,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}       `json:"-"`
-	XXX_unrecognized     []byte         `json:"-"`
-	XXX_sizecache        int32          `json:"-"`
+	PlanID              int64          `protobuf:"varint,1,opt,name=planID,proto3" json:"planID,omitempty"`
+	SegmentID           int64          `protobuf:"varint,2,opt,name=segmentID,proto3" json:"segmentID,omitempty"`
+	NumOfRows           int64          `protobuf:"varint,3,opt,name=num_of_rows,json=numOfRows,proto3" json:"num_of_rows,omitempty"`
+	InsertLogs          []*FieldBinlog `protobuf:"bytes,4,rep,name=insert_logs,json=insertLogs,proto3" json:"insert_logs,omitempty"`
+	Field2StatslogPaths []*FieldBinlog `protobuf:"bytes,5,rep,name=field2StatslogPaths,proto3" json:"field2StatslogPaths,omitempty"`
+	Deltalogs           []*FieldBinlog `protobuf:"bytes,6,rep,name=deltalogs,proto3" json:"deltalogs,omitempty"`
+	Channel             string         `protobuf:"bytes,7,opt,name=channel,proto3" json:"channel,omitempty"`
+	// deltalogs appended to each target segment by level zero compaction
+	LevelZeroSegments    []*CompactionSegmentBinlogs `protobuf:"bytes,8,rep,name=level_zero_segments,json=levelZeroSegments,proto3" json:"level_zero_segments,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                    `json:"-"`
+	XXX_unrecognized     []byte                      `json:"-"`
+	XXX_sizecache        int32                       `json:"-"`
 }
 
 func (m *CompactionResult) Reset()         { *m = CompactionResult{} }
@@ -3358,6 +3434,13 @@ func (m *CompactionResult) GetChannel() string {
 	return ""
 }
 
+func (m *CompactionResult) GetLevelZeroSegments() []*CompactionSegmentBinlogs {
+	if m != nil {
+		return m.LevelZeroSegments
+	}
+	return nil
+}
+
 type CompactionStateResult struct {
 	PlanID               int64                    `protobuf:"varint,1,opt,name=planID,proto3" json:"planID,omitempty"`
 	State                commonpb.CompactionState `protobuf:"varint,2,opt,name=state,proto3,enum=milvus.proto.common.CompactionState" json:"state,omitempty"`
@@ -5288,11 +5371,257 @@ func (m *ChannelOperationProgressResponse) GetProgress() int32 {
 	return 0
 }
 
+type CancelCompactionPlanRequest struct {
+	Base                 *commonpb.MsgBase `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
+	PlanID               int64             `protobuf:"varint,2,opt,name=planID,proto3" json:"planID,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
+	XXX_unrecognized     []byte            `json:"-"`
+	XXX_sizecache        int32             `json:"-"`
+}
+
+func (m *CancelCompactionPlanRequest) Reset()         { *m = CancelCompactionPlanRequest{} }
+func (m *CancelCompactionPlanRequest) String() string { return proto.CompactTextString(m) }
+func (*CancelCompactionPlanRequest) ProtoMessage()    {}
+func (*CancelCompactionPlanRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_82cd95f524594f49, []int{81}
+}
+
+func (m *CancelCompactionPlanRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_CancelCompactionPlanRequest.Unmarshal(m, b)
+}
+func (m *CancelCompactionPlanRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_CancelCompactionPlanRequest.Marshal(b, m, deterministic)
+}
+func (m *CancelCompactionPlanRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CancelCompactionPlanRequest.Merge(m, src)
+}
+func (m *CancelCompactionPlanRequest) XXX_Size() int {
+	return xxx_messageInfo_CancelCompactionPlanRequest.Size(m)
+}
+func (m *CancelCompactionPlanRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_CancelCompactionPlanRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CancelCompactionPlanRequest proto.InternalMessageInfo
+
+func (m *CancelCompactionPlanRequest) GetBase() *commonpb.MsgBase {
+	if m != nil {
+		return m.Base
+	}
+	return nil
+}
+
+func (m *CancelCompactionPlanRequest) GetPlanID() int64 {
+	if m != nil {
+		return m.PlanID
+	}
+	return 0
+}
+
+type StorageMigrationTarget struct {
+	StorageType          string   `protobuf:"bytes,1,opt,name=storage_type,json=storageType,proto3" json:"storage_type,omitempty"`
+	Address              string   `protobuf:"bytes,2,opt,name=address,proto3" json:"address,omitempty"`
+	BucketName           string   `protobuf:"bytes,3,opt,name=bucket_name,json=bucketName,proto3" json:"bucket_name,omitempty"`
+	RootPath             string   `protobuf:"bytes,4,opt,name=root_path,json=rootPath,proto3" json:"root_path,omitempty"`
+	AccessKeyID          string   `protobuf:"bytes,5,opt,name=access_keyID,json=accessKeyID,proto3" json:"access_keyID,omitempty"`
+	SecretAccessKey      string   `protobuf:"bytes,6,opt,name=secret_access_key,json=secretAccessKey,proto3" json:"secret_access_key,omitempty"`
+	UseSsl               bool     `protobuf:"varint,7,opt,name=use_ssl,json=useSsl,proto3" json:"use_ssl,omitempty"`
+	UseIam               bool     `protobuf:"varint,8,opt,name=use_iam,json=useIam,proto3" json:"use_iam,omitempty"`
+	CloudProvider        string   `protobuf:"bytes,9,opt,name=cloud_provider,json=cloudProvider,proto3" json:"cloud_provider,omitempty"`
+	IamEndpoint          string   `protobuf:"bytes,10,opt,name=iam_endpoint,json=iamEndpoint,proto3" json:"iam_endpoint,omitempty"`
+	Region               string   `protobuf:"bytes,11,opt,name=region,proto3" json:"region,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *StorageMigrationTarget) Reset()         { *m = StorageMigrationTarget{} }
+func (m *StorageMigrationTarget) String() string { return proto.CompactTextString(m) }
+func (*StorageMigrationTarget) ProtoMessage()    {}
+func (*StorageMigrationTarget) Descriptor() ([]byte, []int) {
+	return fileDescriptor_82cd95f524594f49, []int{82}
+}
+
+func (m *StorageMigrationTarget) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_StorageMigrationTarget.Unmarshal(m, b)
+}
+func (m *StorageMigrationTarget) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_StorageMigrationTarget.Marshal(b, m, deterministic)
+}
+func (m *StorageMigrationTarget) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_StorageMigrationTarget.Merge(m, src)
+}
+func (m *StorageMigrationTarget) XXX_Size() int {
+	return xxx_messageInfo_StorageMigrationTarget.Size(m)
+}
+func (m *StorageMigrationTarget) XXX_DiscardUnknown() {
+	xxx_messageInfo_StorageMigrationTarget.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_StorageMigrationTarget proto.InternalMessageInfo
+
+func (m *StorageMigrationTarget) GetStorageType() string {
+	if m != nil {
+		return m.StorageType
+	}
+	return ""
+}
+
+func (m *StorageMigrationTarget) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+func (m *StorageMigrationTarget) GetBucketName() string {
+	if m != nil {
+		return m.BucketName
+	}
+	return ""
+}
+
+func (m *StorageMigrationTarget) GetRootPath() string {
+	if m != nil {
+		return m.RootPath
+	}
+	return ""
+}
+
+func (m *StorageMigrationTarget) GetAccessKeyID() string {
+	if m != nil {
+		return m.AccessKeyID
+	}
+	return ""
+}
+
+func (m *StorageMigrationTarget) GetSecretAccessKey() string {
+	if m != nil {
+		return m.SecretAccessKey
+	}
+	return ""
+}
+
+func (m *StorageMigrationTarget) GetUseSsl() bool {
+	if m != nil {
+		return m.UseSsl
+	}
+	return false
+}
+
+func (m *StorageMigrationTarget) GetUseIam() bool {
+	if m != nil {
+		return m.UseIam
+	}
+	return false
+}
+
+func (m *StorageMigrationTarget) GetCloudProvider() string {
+	if m != nil {
+		return m.CloudProvider
+	}
+	return ""
+}
+
+func (m *StorageMigrationTarget) GetIamEndpoint() string {
+	if m != nil {
+		return m.IamEndpoint
+	}
+	return ""
+}
+
+func (m *StorageMigrationTarget) GetRegion() string {
+	if m != nil {
+		return m.Region
+	}
+	return ""
+}
+
+type OperateStorageMigrationRequest struct {
+	Base                 *commonpb.MsgBase           `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
+	DbName               string                      `protobuf:"bytes,2,opt,name=db_name,json=dbName,proto3" json:"db_name,omitempty"`
+	CollectionName       string                      `protobuf:"bytes,3,opt,name=collection_name,json=collectionName,proto3" json:"collection_name,omitempty"`
+	CollectionID         int64                       `protobuf:"varint,4,opt,name=collectionID,proto3" json:"collectionID,omitempty"`
+	OperateType          StorageMigrationOperateType `protobuf:"varint,5,opt,name=operate_type,json=operateType,proto3,enum=milvus.proto.data.StorageMigrationOperateType" json:"operate_type,omitempty"`
+	Target               *StorageMigrationTarget     `protobuf:"bytes,6,opt,name=target,proto3" json:"target,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                    `json:"-"`
+	XXX_unrecognized     []byte                      `json:"-"`
+	XXX_sizecache        int32                       `json:"-"`
+}
+
+func (m *OperateStorageMigrationRequest) Reset()         { *m = OperateStorageMigrationRequest{} }
+func (m *OperateStorageMigrationRequest) String() string { return proto.CompactTextString(m) }
+func (*OperateStorageMigrationRequest) ProtoMessage()    {}
+func (*OperateStorageMigrationRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_82cd95f524594f49, []int{83}
+}
+
+func (m *OperateStorageMigrationRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_OperateStorageMigrationRequest.Unmarshal(m, b)
+}
+func (m *OperateStorageMigrationRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_OperateStorageMigrationRequest.Marshal(b, m, deterministic)
+}
+func (m *OperateStorageMigrationRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_OperateStorageMigrationRequest.Merge(m, src)
+}
+func (m *OperateStorageMigrationRequest) XXX_Size() int {
+	return xxx_messageInfo_OperateStorageMigrationRequest.Size(m)
+}
+func (m *OperateStorageMigrationRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_OperateStorageMigrationRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_OperateStorageMigrationRequest proto.InternalMessageInfo
+
+func (m *OperateStorageMigrationRequest) GetBase() *commonpb.MsgBase {
+	if m != nil {
+		return m.Base
+	}
+	return nil
+}
+
+func (m *OperateStorageMigrationRequest) GetDbName() string {
+	if m != nil {
+		return m.DbName
+	}
+	return ""
+}
+
+func (m *OperateStorageMigrationRequest) GetCollectionName() string {
+	if m != nil {
+		return m.CollectionName
+	}
+	return ""
+}
+
+func (m *OperateStorageMigrationRequest) GetCollectionID() int64 {
+	if m != nil {
+		return m.CollectionID
+	}
+	return 0
+}
+
+func (m *OperateStorageMigrationRequest) GetOperateType() StorageMigrationOperateType {
+	if m != nil {
+		return m.OperateType
+	}
+	return StorageMigrationOperateType_StartMigration
+}
+
+func (m *OperateStorageMigrationRequest) GetTarget() *StorageMigrationTarget {
+	if m != nil {
+		return m.Target
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterEnum("milvus.proto.data.SegmentType", SegmentType_name, SegmentType_value)
 	proto.RegisterEnum("milvus.proto.data.SegmentLevel", SegmentLevel_name, SegmentLevel_value)
 	proto.RegisterEnum("milvus.proto.data.ChannelWatchState", ChannelWatchState_name, ChannelWatchState_value)
 	proto.RegisterEnum("milvus.proto.data.CompactionType", CompactionType_name, CompactionType_value)
+	proto.RegisterEnum("milvus.proto.data.StorageMigrationOperateType", StorageMigrationOperateType_name, StorageMigrationOperateType_value)
 	proto.RegisterType((*Empty)(nil), "milvus.proto.data.Empty")
 	proto.RegisterType((*FlushRequest)(nil), "milvus.proto.data.FlushRequest")
 	proto.RegisterType((*FlushResponse)(nil), "milvus.proto.data.FlushResponse")
@@ -5375,332 +5704,358 @@ func init() {
 	proto.RegisterType((*GetFlushStateRequest)(nil), "milvus.proto.data.GetFlushStateRequest")
 	proto.RegisterType((*ChannelOperationsRequest)(nil), "milvus.proto.data.ChannelOperationsRequest")
 	proto.RegisterType((*ChannelOperationProgressResponse)(nil), "milvus.proto.data.ChannelOperationProgressResponse")
+	proto.RegisterType((*CancelCompactionPlanRequest)(nil), "milvus.proto.data.CancelCompactionPlanRequest")
+	proto.RegisterType((*StorageMigrationTarget)(nil), "milvus.proto.data.StorageMigrationTarget")
+	proto.RegisterType((*OperateStorageMigrationRequest)(nil), "milvus.proto.data.OperateStorageMigrationRequest")
 }
 
 func init() { proto.RegisterFile("data_coord.proto", fileDescriptor_82cd95f524594f49) }
 
 var fileDescriptor_82cd95f524594f49 = []byte{
-	// 5108 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe4, 0x7c, 0xcb, 0x6f, 0x1c, 0x47,
-	0x7a, 0xb8, 0x7a, 0x5e, 0x9c, 0xf9, 0x66, 0x38, 0x1c, 0x96, 0x68, 0x6a, 0x34, 0xb2, 0x1e, 0xdb,
-	0x92, 0x6c, 0x5a, 0xb6, 0x25, 0x99, 0xfa, 0x19, 0xeb, 0xc7, 0xda, 0xbb, 0x22, 0x69, 0xc9, 0xf3,
-	0x0b, 0x29, 0x73, 0x9b, 0x94, 0x1c, 0x78, 0x03, 0x0c, 0x9a, 0xd3, 0xc5, 0x61, 0x9b, 0x3d, 0xdd,
-	0xe3, 0xee, 0x1e, 0x52, 0x74, 0x80, 0xac, 0x37, 0x71, 0x02, 0xe4, 0x81, 0x24, 0xc8, 0xe3, 0x90,
-	0x5b, 0x90, 0x43, 0xb0, 0x79, 0xec, 0x69, 0x13, 0x2c, 0x10, 0x04, 0x58, 0x20, 0xb9, 0x6c, 0x90,
-	0x43, 0x10, 0x04, 0x08, 0x92, 0x43, 0xfe, 0x80, 0x9c, 0x83, 0xdc, 0x83, 0x7a, 0x74, 0xf5, 0xab,
-	0x7a, 0xa6, 0xc9, 0x91, 0x6c, 0x20, 0x39, 0x91, 0x55, 0xfd, 0x55, 0xd5, 0x57, 0x5f, 0x7d, 0xef,
-	0xaf, 0x6a, 0xa0, 0x65, 0xe8, 0xbe, 0xde, 0xeb, 0x3b, 0x8e, 0x6b, 0xdc, 0x1e, 0xb9, 0x8e, 0xef,
-	0xa0, 0xc5, 0xa1, 0x69, 0x1d, 0x8d, 0x3d, 0xd6, 0xba, 0x4d, 0x3e, 0x77, 0x1a, 0x7d, 0x67, 0x38,
-	0x74, 0x6c, 0xd6, 0xd5, 0x69, 0x9a, 0xb6, 0x8f, 0x5d, 0x5b, 0xb7, 0x78, 0xbb, 0x11, 0x1d, 0xd0,
-	0x69, 0x78, 0xfd, 0x03, 0x3c, 0xd4, 0x79, 0xab, 0x36, 0xf4, 0x06, 0xfc, 0xdf, 0x45, 0xd3, 0x36,
-	0xf0, 0xd3, 0xe8, 0x52, 0xea, 0x1c, 0x94, 0x3f, 0x18, 0x8e, 0xfc, 0x13, 0xf5, 0xaf, 0x14, 0x68,
-	0x3c, 0xb0, 0xc6, 0xde, 0x81, 0x86, 0x3f, 0x1b, 0x63, 0xcf, 0x47, 0x77, 0xa1, 0xb4, 0xa7, 0x7b,
-	0xb8, 0xad, 0x5c, 0x53, 0x56, 0xea, 0xab, 0x2f, 0xde, 0x8e, 0xe1, 0xc4, 0xb1, 0xd9, 0xf2, 0x06,
-	0x6b, 0xba, 0x87, 0x35, 0x0a, 0x89, 0x10, 0x94, 0x8c, 0xbd, 0xee, 0x46, 0xbb, 0x70, 0x4d, 0x59,
-	0x29, 0x6a, 0xf4, 0x7f, 0x74, 0x05, 0xc0, 0xc3, 0x83, 0x21, 0xb6, 0xfd, 0xee, 0x86, 0xd7, 0x2e,
-	0x5e, 0x2b, 0xae, 0x14, 0xb5, 0x48, 0x0f, 0x52, 0xa1, 0xd1, 0x77, 0x2c, 0x0b, 0xf7, 0x7d, 0xd3,
-	0xb1, 0xbb, 0x1b, 0xed, 0x12, 0x1d, 0x1b, 0xeb, 0x43, 0x1d, 0xa8, 0x9a, 0x5e, 0x77, 0x38, 0x72,
-	0x5c, 0xbf, 0x5d, 0xbe, 0xa6, 0xac, 0x54, 0x35, 0xd1, 0x56, 0x7f, 0x50, 0x80, 0x79, 0x8e, 0xb6,
-	0x37, 0x72, 0x6c, 0x0f, 0xa3, 0x7b, 0x50, 0xf1, 0x7c, 0xdd, 0x1f, 0x7b, 0x1c, 0xf3, 0x4b, 0x52,
-	0xcc, 0x77, 0x28, 0x88, 0xc6, 0x41, 0xa5, 0xa8, 0x27, 0x51, 0x2b, 0x4a, 0x50, 0x8b, 0x6f, 0xaf,
-	0x94, 0xda, 0xde, 0x0a, 0x2c, 0xec, 0x13, 0xec, 0x76, 0x42, 0xa0, 0x32, 0x05, 0x4a, 0x76, 0x93,
-	0x99, 0x7c, 0x73, 0x88, 0x3f, 0xda, 0xdf, 0xc1, 0xba, 0xd5, 0xae, 0xd0, 0xb5, 0x22, 0x3d, 0xe8,
-	0x22, 0x54, 0xe9, 0x90, 0x9e, 0xef, 0xb5, 0xe7, 0xae, 0x29, 0x2b, 0x25, 0x6d, 0x8e, 0xb6, 0x77,
-	0x3d, 0xf5, 0xfb, 0xb0, 0x44, 0x49, 0xb0, 0x7e, 0xa0, 0xdb, 0x36, 0xb6, 0xbc, 0xb3, 0x9f, 0x60,
-	0x74, 0x91, 0x42, 0x6c, 0x11, 0x72, 0x08, 0x7d, 0x3e, 0x3f, 0x3d, 0xc6, 0x9a, 0x26, 0xda, 0xea,
-	0x3f, 0x2b, 0xd0, 0x12, 0x5b, 0x09, 0x56, 0x5f, 0x82, 0x72, 0xdf, 0x19, 0xdb, 0x3e, 0x5d, 0x7e,
-	0x5e, 0x63, 0x0d, 0xf4, 0x0d, 0x68, 0xf0, 0x61, 0x3d, 0x5b, 0x1f, 0x62, 0xba, 0x4a, 0x4d, 0xab,
-	0xf3, 0xbe, 0x47, 0xfa, 0x10, 0xe7, 0xa2, 0xfb, 0x35, 0xa8, 0x8f, 0x74, 0xd7, 0x37, 0x63, 0x5c,
-	0x13, 0xed, 0x9a, 0xc4, 0x34, 0x64, 0x05, 0x93, 0xfe, 0xb7, 0xab, 0x7b, 0x87, 0xdd, 0x0d, 0x4e,
-	0xed, 0x58, 0x9f, 0xfa, 0xc7, 0x0a, 0x2c, 0xdf, 0xf7, 0x3c, 0x73, 0x60, 0xa7, 0x76, 0xb6, 0x0c,
-	0x15, 0xdb, 0x31, 0x70, 0x77, 0x83, 0x6e, 0xad, 0xa8, 0xf1, 0x16, 0xba, 0x04, 0xb5, 0x11, 0xc6,
-	0x6e, 0xcf, 0x75, 0xac, 0x60, 0x63, 0x55, 0xd2, 0xa1, 0x39, 0x16, 0x46, 0xdf, 0x85, 0x45, 0x2f,
-	0x31, 0x11, 0x23, 0x64, 0x7d, 0xf5, 0xfa, 0xed, 0x94, 0xbc, 0xdf, 0x4e, 0x2e, 0xaa, 0xa5, 0x47,
-	0xab, 0x5f, 0x14, 0xe0, 0xbc, 0x80, 0x63, 0xb8, 0x92, 0xff, 0x09, 0xe5, 0x3d, 0x3c, 0x10, 0xe8,
-	0xb1, 0x46, 0x1e, 0xca, 0x8b, 0x23, 0x2b, 0x46, 0x8f, 0x2c, 0x8f, 0x88, 0x26, 0xce, 0xa3, 0x9c,
-	0x3e, 0x8f, 0xab, 0x50, 0xc7, 0x4f, 0x47, 0xa6, 0x8b, 0x7b, 0x84, 0xa9, 0x29, 0xc9, 0x4b, 0x1a,
-	0xb0, 0xae, 0x5d, 0x73, 0x18, 0x95, 0xdb, 0xb9, 0xdc, 0x72, 0xab, 0xfe, 0x89, 0x02, 0x17, 0x52,
-	0xa7, 0xc4, 0x15, 0x81, 0x06, 0x2d, 0xba, 0xf3, 0x90, 0x32, 0x44, 0x25, 0x10, 0x82, 0xbf, 0x34,
-	0x89, 0xe0, 0x21, 0xb8, 0x96, 0x1a, 0x1f, 0x41, 0xb2, 0x90, 0x1f, 0xc9, 0x43, 0xb8, 0xf0, 0x10,
-	0xfb, 0x7c, 0x01, 0xf2, 0x0d, 0xcf, 0x20, 0xa2, 0x71, 0x8d, 0x53, 0x48, 0x6a, 0x1c, 0xf5, 0x4f,
-	0x0b, 0x42, 0x16, 0xe9, 0x52, 0x5d, 0x7b, 0xdf, 0x41, 0x2f, 0x42, 0x4d, 0x80, 0x70, 0xae, 0x08,
-	0x3b, 0xd0, 0x37, 0xa1, 0x4c, 0x30, 0x65, 0x2c, 0xd1, 0x5c, 0xfd, 0x86, 0x7c, 0x4f, 0x91, 0x39,
-	0x35, 0x06, 0x8f, 0x36, 0xa0, 0xe9, 0xf9, 0xba, 0xeb, 0xf7, 0x46, 0x8e, 0x47, 0xcf, 0x99, 0x32,
-	0x4e, 0x7d, 0xf5, 0x72, 0x7c, 0x06, 0x62, 0x80, 0xb6, 0xbc, 0xc1, 0x36, 0x07, 0xd2, 0xe6, 0xe9,
-	0xa0, 0xa0, 0x89, 0xbe, 0x03, 0x0d, 0x6c, 0x1b, 0xe1, 0x1c, 0xa5, 0x3c, 0x73, 0xd4, 0xb1, 0x6d,
-	0x88, 0x19, 0xc2, 0x53, 0x29, 0xe7, 0x3f, 0x95, 0xdf, 0x52, 0xa0, 0x9d, 0x3e, 0x96, 0x59, 0x8c,
-	0xc8, 0xbb, 0x6c, 0x10, 0x66, 0xc7, 0x32, 0x51, 0xae, 0xc5, 0xd1, 0x68, 0x7c, 0x88, 0xfa, 0x87,
-	0x0a, 0xbc, 0x10, 0xa2, 0x43, 0x3f, 0x3d, 0x2f, 0x1e, 0x41, 0xb7, 0xa0, 0x65, 0xda, 0x7d, 0x6b,
-	0x6c, 0xe0, 0xc7, 0xf6, 0x87, 0x58, 0xb7, 0xfc, 0x83, 0x13, 0x7a, 0x72, 0x55, 0x2d, 0xd5, 0xaf,
-	0xfe, 0x7b, 0x01, 0x96, 0x93, 0x78, 0xcd, 0x42, 0xa4, 0xff, 0x07, 0x65, 0xd3, 0xde, 0x77, 0x02,
-	0x1a, 0x5d, 0x99, 0x20, 0x8a, 0x64, 0x2d, 0x06, 0x8c, 0x1c, 0x40, 0x81, 0xf2, 0xea, 0x1f, 0xe0,
-	0xfe, 0xe1, 0xc8, 0x31, 0xa9, 0x9a, 0x22, 0x53, 0x7c, 0x47, 0x32, 0x85, 0x1c, 0xe3, 0xdb, 0xdc,
-	0x42, 0xae, 0x8b, 0x29, 0x3e, 0xb0, 0x7d, 0xf7, 0x44, 0x5b, 0xec, 0x27, 0xfb, 0x3b, 0x7d, 0x58,
-	0x96, 0x03, 0xa3, 0x16, 0x14, 0x0f, 0xf1, 0x09, 0xdd, 0x72, 0x4d, 0x23, 0xff, 0xa2, 0x7b, 0x50,
-	0x3e, 0xd2, 0xad, 0x31, 0xe6, 0x3a, 0x61, 0x0a, 0xe7, 0x32, 0xd8, 0x77, 0x0a, 0x6f, 0x29, 0xea,
-	0x10, 0x2e, 0x3d, 0xc4, 0x7e, 0xd7, 0xf6, 0xb0, 0xeb, 0xaf, 0x99, 0xb6, 0xe5, 0x0c, 0xb6, 0x75,
-	0xff, 0x60, 0x06, 0xe5, 0x10, 0x93, 0xf3, 0x42, 0x42, 0xce, 0xd5, 0x1f, 0x2a, 0xf0, 0xa2, 0x7c,
-	0x3d, 0x7e, 0xa0, 0x1d, 0xa8, 0xee, 0x9b, 0xd8, 0x32, 0x08, 0xd7, 0x28, 0x94, 0x6b, 0x44, 0x9b,
-	0x28, 0x89, 0x11, 0x01, 0xe6, 0xe7, 0x96, 0x50, 0x12, 0xc2, 0x1f, 0xdd, 0xf1, 0x5d, 0xd3, 0x1e,
-	0x6c, 0x9a, 0x9e, 0xaf, 0x31, 0xf8, 0x08, 0x97, 0x14, 0xf3, 0x0b, 0xe7, 0x6f, 0x28, 0x70, 0xe5,
-	0x21, 0xf6, 0xd7, 0x85, 0x8d, 0x21, 0xdf, 0x4d, 0xcf, 0x37, 0xfb, 0xde, 0xb3, 0xf5, 0x4f, 0x73,
-	0x38, 0x1b, 0xea, 0xef, 0x28, 0x70, 0x35, 0x13, 0x19, 0x4e, 0x3a, 0xae, 0x43, 0x03, 0x0b, 0x23,
-	0xd7, 0xa1, 0x3f, 0x87, 0x4f, 0x9e, 0x90, 0xc3, 0xdf, 0xd6, 0x4d, 0x97, 0xe9, 0xd0, 0x33, 0x5a,
-	0x94, 0x1f, 0x29, 0x70, 0xf9, 0x21, 0xf6, 0xb7, 0x03, 0xfb, 0xfa, 0x35, 0x52, 0x87, 0xc0, 0x44,
-	0xec, 0x7c, 0xe0, 0x04, 0xc7, 0xfa, 0xd4, 0xdf, 0x66, 0xc7, 0x29, 0xc5, 0xf7, 0x6b, 0x21, 0xe0,
-	0x15, 0x2a, 0x09, 0x11, 0x15, 0xc1, 0x85, 0x9d, 0x93, 0x4f, 0xfd, 0xb2, 0x0c, 0x8d, 0x27, 0x5c,
-	0x2b, 0x50, 0x0b, 0x9a, 0xa4, 0x84, 0x22, 0x77, 0x82, 0x22, 0xde, 0x94, 0xcc, 0xc1, 0x5a, 0x83,
-	0x79, 0x0f, 0xe3, 0xc3, 0x53, 0xda, 0xcb, 0x06, 0x19, 0x23, 0x8c, 0xdd, 0x26, 0x2c, 0x8e, 0x6d,
-	0xea, 0x95, 0x63, 0x83, 0x6f, 0x80, 0x11, 0x7d, 0xba, 0x32, 0x4d, 0x0f, 0x44, 0x1f, 0xf2, 0x00,
-	0x25, 0x32, 0x57, 0x39, 0xd7, 0x5c, 0xc9, 0x61, 0xa8, 0x0b, 0x2d, 0xc3, 0x75, 0x46, 0x23, 0x6c,
-	0xf4, 0xbc, 0x60, 0xaa, 0x4a, 0xbe, 0xa9, 0xf8, 0x38, 0x31, 0xd5, 0x5d, 0x38, 0x9f, 0xc4, 0xb4,
-	0x6b, 0x10, 0xbf, 0x90, 0x70, 0x96, 0xec, 0x13, 0x7a, 0x0d, 0x16, 0xd3, 0xf0, 0x55, 0x0a, 0x9f,
-	0xfe, 0x80, 0x5e, 0x07, 0x94, 0x40, 0x95, 0x80, 0xd7, 0x18, 0x78, 0x1c, 0x19, 0x0e, 0x4e, 0x03,
-	0xe7, 0x38, 0x38, 0x30, 0x70, 0xfe, 0x25, 0x02, 0xde, 0x25, 0xd6, 0x35, 0x06, 0xee, 0xb5, 0xeb,
-	0xf9, 0x08, 0x11, 0x9f, 0xcc, 0x53, 0x7f, 0x5d, 0x81, 0xe5, 0x8f, 0x75, 0xbf, 0x7f, 0xb0, 0x31,
-	0x9c, 0x3d, 0xb8, 0x7b, 0x0f, 0x6a, 0x47, 0x22, 0x84, 0x63, 0x5a, 0xfc, 0xaa, 0x04, 0xa1, 0x28,
-	0xdb, 0x6b, 0xe1, 0x08, 0xf5, 0xef, 0x14, 0x1e, 0x66, 0x06, 0xd8, 0x7d, 0xf5, 0xaa, 0x66, 0x5a,
-	0xb4, 0x9d, 0x10, 0xc0, 0x72, 0x4a, 0x00, 0xd5, 0xa7, 0x00, 0x1c, 0xfd, 0x2d, 0x6f, 0x70, 0x06,
-	0xcc, 0xdf, 0x82, 0x39, 0xbe, 0x1e, 0xd7, 0x36, 0xd3, 0x8e, 0x34, 0x00, 0x57, 0xff, 0xbb, 0x02,
-	0xf5, 0xc8, 0x07, 0xd4, 0x84, 0x82, 0x50, 0x23, 0x05, 0xc9, 0xfe, 0x0b, 0xd3, 0xa3, 0xac, 0x62,
-	0x3a, 0xca, 0xba, 0x09, 0x4d, 0x93, 0x9a, 0xf7, 0x1e, 0xdf, 0x35, 0xf5, 0xa6, 0x6b, 0xda, 0x3c,
-	0xeb, 0xe5, 0x4c, 0x84, 0xae, 0x40, 0xdd, 0x1e, 0x0f, 0x7b, 0xce, 0x7e, 0xcf, 0x75, 0x8e, 0x3d,
-	0x1e, 0xae, 0xd5, 0xec, 0xf1, 0xf0, 0xa3, 0x7d, 0xcd, 0x39, 0xf6, 0xc2, 0x88, 0xa0, 0x72, 0xca,
-	0x88, 0xe0, 0x0a, 0xd4, 0x87, 0xfa, 0x53, 0x32, 0x6b, 0xcf, 0x1e, 0x0f, 0x69, 0x24, 0x57, 0xd4,
-	0x6a, 0x43, 0xfd, 0xa9, 0xe6, 0x1c, 0x3f, 0x1a, 0x0f, 0xd1, 0x0a, 0xb4, 0x2c, 0xdd, 0xf3, 0x7b,
-	0xd1, 0x50, 0xb0, 0x4a, 0x43, 0xc1, 0x26, 0xe9, 0xff, 0x20, 0x0c, 0x07, 0xd3, 0xb1, 0x45, 0xed,
-	0x6c, 0xb1, 0x85, 0x31, 0xb4, 0xc2, 0x39, 0x20, 0x57, 0x6c, 0x61, 0x0c, 0x2d, 0x31, 0xc3, 0x5b,
-	0x30, 0xb7, 0x47, 0x5d, 0xa5, 0x49, 0x42, 0xfc, 0x80, 0x78, 0x49, 0xcc, 0xa3, 0xd2, 0x02, 0x70,
-	0xf4, 0x2d, 0xa8, 0x51, 0x0b, 0x45, 0xc7, 0x36, 0x72, 0x8d, 0x0d, 0x07, 0x90, 0xd1, 0x06, 0xb6,
-	0x7c, 0x9d, 0x8e, 0x9e, 0xcf, 0x37, 0x5a, 0x0c, 0x20, 0x1a, 0xb4, 0xef, 0x62, 0xdd, 0xc7, 0xc6,
-	0xda, 0xc9, 0xba, 0x33, 0x1c, 0xe9, 0x94, 0x85, 0xda, 0x4d, 0xea, 0xe4, 0xcb, 0x3e, 0xa1, 0x97,
-	0xa0, 0xd9, 0x17, 0xad, 0x07, 0xae, 0x33, 0x6c, 0x2f, 0x50, 0xf9, 0x4a, 0xf4, 0xa2, 0xcb, 0x00,
-	0x81, 0xee, 0xd4, 0xfd, 0x76, 0x8b, 0x9e, 0x5d, 0x8d, 0xf7, 0xdc, 0xa7, 0xf9, 0x1d, 0xd3, 0xeb,
-	0xb1, 0x4c, 0x8a, 0x69, 0x0f, 0xda, 0x8b, 0x74, 0xc5, 0x7a, 0x90, 0x7a, 0x31, 0xed, 0x01, 0xba,
-	0x00, 0x73, 0xa6, 0xd7, 0xdb, 0xd7, 0x0f, 0x71, 0x1b, 0xd1, 0xaf, 0x15, 0xd3, 0x7b, 0xa0, 0x1f,
-	0x52, 0xef, 0x95, 0x2f, 0x86, 0x8d, 0xf6, 0x79, 0xfa, 0x29, 0xec, 0x40, 0x6f, 0x42, 0xd9, 0xc2,
-	0x47, 0xd8, 0x6a, 0x2f, 0x51, 0x9e, 0xbc, 0x9a, 0x2d, 0x78, 0x9b, 0x04, 0x4c, 0x63, 0xd0, 0xea,
-	0xe7, 0xb0, 0x14, 0x32, 0x6a, 0x84, 0x33, 0xd2, 0xfc, 0xa5, 0x9c, 0x81, 0xbf, 0x26, 0x3b, 0xdc,
-	0xff, 0x59, 0x82, 0xe5, 0x1d, 0xfd, 0x08, 0x3f, 0x7f, 0xdf, 0x3e, 0x97, 0xfa, 0xdc, 0x84, 0x45,
-	0xea, 0xce, 0xaf, 0x46, 0xf0, 0x99, 0xe0, 0x39, 0x44, 0x59, 0x2b, 0x3d, 0x10, 0x7d, 0x9b, 0x28,
-	0x5b, 0xdc, 0x3f, 0xdc, 0x26, 0xa1, 0x51, 0xe0, 0x35, 0x5c, 0x96, 0xcc, 0xb3, 0x2e, 0xa0, 0xb4,
-	0xe8, 0x08, 0xb4, 0x0d, 0x0b, 0xf1, 0x13, 0x08, 0xfc, 0x85, 0x97, 0x27, 0xc6, 0xcd, 0x21, 0xf5,
-	0xb5, 0x66, 0xec, 0x30, 0x3c, 0xd4, 0x86, 0x39, 0x6e, 0xec, 0xa9, 0xe6, 0xa9, 0x6a, 0x41, 0x13,
-	0x6d, 0xc3, 0x79, 0xb6, 0x83, 0x1d, 0x2e, 0x60, 0x6c, 0xf3, 0xd5, 0x5c, 0x9b, 0x97, 0x0d, 0x8d,
-	0xcb, 0x67, 0xed, 0xb4, 0xf2, 0xd9, 0x86, 0x39, 0x2e, 0x33, 0x54, 0x25, 0x55, 0xb5, 0xa0, 0x49,
-	0x8e, 0x39, 0x94, 0x9e, 0x3a, 0x13, 0x02, 0xd1, 0x41, 0xc6, 0x05, 0x8a, 0xbd, 0x41, 0x15, 0x7b,
-	0xd0, 0x54, 0x7f, 0x55, 0x01, 0x08, 0x29, 0x3d, 0x25, 0xe3, 0xf3, 0x36, 0x54, 0x05, 0xdb, 0xe7,
-	0x0a, 0x5a, 0x05, 0x78, 0xd2, 0x74, 0x14, 0x13, 0xa6, 0x43, 0xfd, 0x47, 0x05, 0x1a, 0x1b, 0x64,
-	0x9f, 0x9b, 0xce, 0x80, 0x1a, 0xba, 0x9b, 0xd0, 0x74, 0x71, 0xdf, 0x71, 0x8d, 0x1e, 0xb6, 0x7d,
-	0xd7, 0xc4, 0x2c, 0x5b, 0x50, 0xd2, 0xe6, 0x59, 0xef, 0x07, 0xac, 0x93, 0x80, 0x11, 0x6b, 0xe0,
-	0xf9, 0xfa, 0x70, 0xd4, 0xdb, 0x27, 0xfa, 0x87, 0x25, 0xa0, 0xe7, 0x45, 0x2f, 0x55, 0x3f, 0xdf,
-	0x80, 0x46, 0x08, 0xe6, 0x3b, 0x74, 0xfd, 0x92, 0x56, 0x17, 0x7d, 0xbb, 0x0e, 0xba, 0x01, 0x4d,
-	0x4a, 0xe8, 0x9e, 0xe5, 0x0c, 0x7a, 0x24, 0x06, 0xe5, 0x36, 0xb0, 0x61, 0x70, 0xb4, 0xc8, 0x01,
-	0xc6, 0xa1, 0x3c, 0xf3, 0x73, 0xcc, 0xad, 0xa0, 0x80, 0xda, 0x31, 0x3f, 0xc7, 0xea, 0xaf, 0x28,
-	0x30, 0xcf, 0x8d, 0xe6, 0x8e, 0xa8, 0x14, 0xd0, 0xf4, 0x29, 0x8b, 0xff, 0xe9, 0xff, 0xe8, 0x9d,
-	0x78, 0x02, 0xed, 0x86, 0x54, 0x08, 0xe8, 0x24, 0xd4, 0x99, 0x8b, 0x59, 0xcc, 0x3c, 0x01, 0xe8,
-	0x17, 0x84, 0xa6, 0xba, 0xaf, 0x3f, 0x72, 0x0c, 0x96, 0xcf, 0x6b, 0xc3, 0x9c, 0x6e, 0x18, 0x2e,
-	0xf6, 0x3c, 0x8e, 0x47, 0xd0, 0x24, 0x5f, 0x8e, 0xb0, 0xeb, 0x05, 0x07, 0x5b, 0xd4, 0x82, 0x26,
-	0xfa, 0x56, 0x22, 0x81, 0x5f, 0x5f, 0xbd, 0x96, 0x8d, 0x27, 0x0f, 0x97, 0xc2, 0x14, 0xff, 0x5f,
-	0x17, 0xa0, 0xc9, 0x65, 0x70, 0x8d, 0xdb, 0xb7, 0xc9, 0x2c, 0xb6, 0x06, 0x8d, 0xfd, 0x90, 0xf7,
-	0x27, 0xa5, 0x7b, 0xa2, 0x22, 0x12, 0x1b, 0x33, 0x8d, 0xd7, 0xe2, 0x16, 0xb6, 0x34, 0x93, 0x85,
-	0x2d, 0x9f, 0x56, 0x82, 0xd3, 0x9e, 0x56, 0x45, 0xe2, 0x69, 0xa9, 0xbf, 0x00, 0xf5, 0xc8, 0x04,
-	0x54, 0x43, 0xb1, 0x8c, 0x0a, 0xa7, 0x58, 0xd0, 0x44, 0xf7, 0x42, 0x3f, 0x83, 0x91, 0xea, 0xa2,
-	0x04, 0x97, 0x84, 0x8b, 0xa1, 0xfe, 0x54, 0x81, 0x0a, 0x9f, 0xf9, 0x2a, 0xd4, 0xb9, 0x7c, 0x51,
-	0xcf, 0x8b, 0xcd, 0x0e, 0xbc, 0x8b, 0xb8, 0x5e, 0xcf, 0x4e, 0xc0, 0x2e, 0x42, 0x35, 0x21, 0x5a,
-	0x73, 0x5c, 0x2d, 0x06, 0x9f, 0x22, 0xf2, 0x44, 0x3e, 0x11, 0x51, 0x42, 0x4b, 0x50, 0xb6, 0x9c,
-	0x81, 0xa8, 0xb6, 0xb0, 0x86, 0xfa, 0x33, 0x85, 0x26, 0xc7, 0x35, 0xdc, 0x77, 0x8e, 0xb0, 0x7b,
-	0x32, 0x7b, 0x7e, 0xf1, 0xdd, 0x08, 0x9b, 0xe7, 0x0c, 0x72, 0xc4, 0x00, 0xf4, 0x6e, 0x78, 0x08,
-	0x45, 0x59, 0x1a, 0x22, 0x6a, 0x8a, 0x38, 0x93, 0x86, 0x87, 0xf1, 0xbb, 0x0a, 0xcd, 0x94, 0xc6,
-	0xb7, 0x72, 0x56, 0x6b, 0xff, 0x4c, 0xc2, 0x01, 0xf5, 0x1f, 0x14, 0xb8, 0x98, 0x41, 0xdd, 0x27,
-	0xab, 0x5f, 0x03, 0x7d, 0xdf, 0x81, 0xaa, 0x08, 0x89, 0x8b, 0xb9, 0x42, 0x62, 0x01, 0xaf, 0xfe,
-	0x01, 0xcb, 0xd7, 0x4b, 0xc8, 0xfb, 0x64, 0xf5, 0x39, 0x11, 0x38, 0x99, 0xda, 0x2a, 0x4a, 0x52,
-	0x5b, 0xff, 0xa4, 0x40, 0x27, 0x4c, 0x25, 0x79, 0x6b, 0x27, 0xb3, 0x16, 0x78, 0x9e, 0x4d, 0x20,
-	0xf8, 0xb6, 0xa8, 0x45, 0x10, 0xbd, 0x98, 0x2b, 0x84, 0x0b, 0x2a, 0x11, 0x36, 0xcd, 0x4a, 0xa7,
-	0x37, 0x34, 0x8b, 0x54, 0x76, 0x22, 0x07, 0xcf, 0xea, 0x11, 0xe1, 0xc1, 0xfe, 0x94, 0x31, 0xe9,
-	0x83, 0x78, 0x3e, 0xe9, 0xeb, 0x26, 0x60, 0xb4, 0x46, 0x72, 0xc0, 0x6b, 0x24, 0xa5, 0x44, 0x8d,
-	0x84, 0xf7, 0xab, 0x43, 0xca, 0x02, 0xa9, 0x0d, 0x3c, 0x2f, 0x82, 0xfd, 0x9a, 0x02, 0x6d, 0xbe,
-	0x0a, 0xab, 0xfb, 0x3b, 0xc3, 0x91, 0x85, 0x7d, 0x6c, 0x7c, 0xd5, 0x39, 0x8d, 0xbf, 0x2c, 0x40,
-	0x2b, 0xea, 0xd8, 0x50, 0xdf, 0xe4, 0x4d, 0x28, 0xd3, 0xa4, 0x11, 0xc7, 0x60, 0xaa, 0x76, 0x60,
-	0xd0, 0xc4, 0x32, 0x52, 0x6f, 0x7e, 0xd7, 0x0b, 0x1c, 0x17, 0xde, 0x0c, 0xbd, 0xab, 0xe2, 0xe9,
-	0xbd, 0xab, 0x17, 0xa1, 0x46, 0x2c, 0x97, 0x33, 0x26, 0xf3, 0xb2, 0xc2, 0x75, 0xd8, 0x81, 0xde,
-	0x83, 0x0a, 0xbb, 0x2a, 0xc3, 0xeb, 0x86, 0x37, 0xe3, 0x53, 0xf3, 0x6b, 0x34, 0x91, 0xbc, 0x3f,
-	0xed, 0xd0, 0xf8, 0x20, 0x72, 0x46, 0x23, 0xd7, 0x19, 0x50, 0x37, 0x8c, 0x18, 0xb5, 0xb2, 0x26,
-	0xda, 0xc4, 0x4d, 0x74, 0x46, 0xdd, 0x0d, 0x9e, 0x01, 0xa1, 0xff, 0xab, 0xff, 0x1f, 0x96, 0xc3,
-	0x80, 0x9b, 0xa1, 0x79, 0x56, 0x26, 0x57, 0xff, 0x55, 0x81, 0xf3, 0x3b, 0x27, 0x76, 0x3f, 0x29,
-	0x2e, 0xcb, 0x50, 0x19, 0x59, 0x7a, 0x98, 0xa1, 0xe6, 0x2d, 0x5a, 0xfd, 0x0f, 0x42, 0x69, 0x62,
-	0xd6, 0x19, 0x8d, 0xeb, 0xa2, 0x6f, 0xd7, 0x99, 0xea, 0x6d, 0xdd, 0x14, 0x19, 0x02, 0x6c, 0x30,
-	0x07, 0x82, 0x65, 0xe0, 0xe6, 0x45, 0x2f, 0x75, 0x20, 0xde, 0x03, 0xa0, 0x3e, 0x56, 0xef, 0x34,
-	0x7e, 0x15, 0x1d, 0xb1, 0x49, 0xac, 0xe8, 0x8f, 0x0b, 0xd0, 0x8e, 0x50, 0xe9, 0xab, 0x76, 0x39,
-	0x33, 0x02, 0xc5, 0xe2, 0x33, 0x0a, 0x14, 0x4b, 0xb3, 0xbb, 0x99, 0x65, 0x99, 0x9b, 0xf9, 0x83,
-	0x22, 0x34, 0x43, 0xaa, 0x6d, 0x5b, 0xba, 0x9d, 0xc9, 0x09, 0x3b, 0xd0, 0xf4, 0x62, 0x54, 0xe5,
-	0x74, 0x7a, 0x55, 0x26, 0x57, 0x19, 0x07, 0xa1, 0x25, 0xa6, 0x40, 0x97, 0xe9, 0xa1, 0xbb, 0x3e,
-	0xcb, 0xe8, 0x31, 0x9f, 0xb1, 0xc6, 0x04, 0xd8, 0x1c, 0x62, 0xf4, 0x1a, 0x20, 0x2e, 0x75, 0x3d,
-	0xd3, 0xee, 0x79, 0xb8, 0xef, 0xd8, 0x06, 0x93, 0xc7, 0xb2, 0xd6, 0xe2, 0x5f, 0xba, 0xf6, 0x0e,
-	0xeb, 0x47, 0x6f, 0x42, 0xc9, 0x3f, 0x19, 0x31, 0x07, 0xb2, 0x29, 0x75, 0xc1, 0x42, 0xbc, 0x76,
-	0x4f, 0x46, 0x58, 0xa3, 0xe0, 0xc1, 0x0d, 0x2a, 0xdf, 0xd5, 0x8f, 0xb8, 0x37, 0x5e, 0xd2, 0x22,
-	0x3d, 0xd1, 0xd8, 0x79, 0x2e, 0x16, 0x3b, 0x33, 0xce, 0x0e, 0x84, 0xbc, 0xe7, 0xfb, 0x16, 0xcd,
-	0x49, 0x52, 0xce, 0x0e, 0x7a, 0x77, 0x7d, 0x8b, 0x6c, 0xd2, 0x77, 0x7c, 0xdd, 0x62, 0xf2, 0x51,
-	0xe3, 0xda, 0x84, 0xf4, 0xd0, 0xc8, 0xf7, 0x5f, 0x88, 0x36, 0x14, 0x88, 0x69, 0xd8, 0x1b, 0x5b,
-	0xd9, 0xf2, 0x38, 0x39, 0x9b, 0x33, 0x4d, 0x14, 0xbf, 0x0d, 0x75, 0xce, 0x15, 0xa7, 0xe0, 0x2a,
-	0x60, 0x43, 0x36, 0x27, 0xb0, 0x79, 0xf9, 0x19, 0xb1, 0x79, 0xe5, 0x0c, 0xf9, 0x10, 0xf9, 0xd9,
-	0xa8, 0x3f, 0x54, 0xe0, 0x85, 0x94, 0xd6, 0x9c, 0x48, 0xda, 0xc9, 0xd1, 0x38, 0xd7, 0xa6, 0xc9,
-	0x29, 0xb9, 0xbd, 0x78, 0x17, 0x2a, 0x2e, 0x9d, 0x9d, 0x57, 0xe6, 0xae, 0x4f, 0x64, 0x3e, 0x86,
-	0x88, 0xc6, 0x87, 0xa8, 0xbf, 0xa7, 0xc0, 0x85, 0x34, 0xaa, 0x33, 0x38, 0x01, 0x6b, 0x30, 0xc7,
-	0xa6, 0x0e, 0x64, 0x74, 0x65, 0xb2, 0x8c, 0x86, 0xc4, 0xd1, 0x82, 0x81, 0xea, 0x0e, 0x2c, 0x07,
-	0xbe, 0x42, 0x48, 0xfa, 0x2d, 0xec, 0xeb, 0x13, 0x62, 0xd1, 0xab, 0x50, 0x67, 0x41, 0x0d, 0x8b,
-	0xf1, 0x58, 0x21, 0x13, 0xf6, 0x44, 0xf2, 0x4f, 0xfd, 0xfd, 0x02, 0x2c, 0x51, 0x63, 0x9b, 0xac,
-	0x4a, 0xe5, 0x29, 0x93, 0xaa, 0xe2, 0x22, 0xda, 0x23, 0x7d, 0xc8, 0x2f, 0xcb, 0xd4, 0xb4, 0x58,
-	0x1f, 0xea, 0xa6, 0x73, 0x83, 0xd2, 0x9c, 0x45, 0x58, 0x17, 0xde, 0xd0, 0x7d, 0x9d, 0x96, 0x85,
-	0x93, 0x49, 0xc1, 0xd0, 0xc8, 0x97, 0xce, 0x62, 0xe4, 0x5f, 0x81, 0x16, 0x4b, 0x97, 0xf7, 0x44,
-	0x08, 0x4c, 0x15, 0x53, 0x49, 0x5b, 0x60, 0xfd, 0xbb, 0x41, 0xb7, 0xba, 0x09, 0x2f, 0x24, 0x88,
-	0x32, 0xc3, 0xe1, 0xab, 0x7f, 0xa6, 0x90, 0x93, 0x8b, 0xdd, 0x4f, 0x3a, 0xbb, 0x4f, 0x7c, 0x59,
-	0x54, 0xce, 0x7a, 0xa6, 0x91, 0xd4, 0x37, 0x06, 0x7a, 0x1f, 0x6a, 0x36, 0x3e, 0xee, 0x45, 0xdd,
-	0xac, 0x1c, 0x01, 0x43, 0xd5, 0xc6, 0xc7, 0xf4, 0x3f, 0xf5, 0x11, 0x5c, 0x48, 0xa1, 0x3a, 0xcb,
-	0xde, 0xff, 0x46, 0x81, 0x8b, 0x1b, 0xae, 0x33, 0x7a, 0x62, 0xba, 0xfe, 0x58, 0xb7, 0xe2, 0xc5,
-	0xf9, 0x33, 0x6c, 0x3f, 0xc7, 0xdd, 0xc7, 0x0f, 0x53, 0xa1, 0xe9, 0x6b, 0x12, 0x61, 0x4b, 0x23,
-	0xc5, 0x37, 0x1d, 0x71, 0xcf, 0xff, 0xa3, 0x28, 0x43, 0x9e, 0xc3, 0x4d, 0x71, 0x61, 0xf2, 0xc4,
-	0x2e, 0xd2, 0x34, 0x7e, 0xf1, 0xac, 0x69, 0xfc, 0x0c, 0x4b, 0x50, 0x7a, 0x46, 0x96, 0xe0, 0xd4,
-	0x79, 0xb5, 0x75, 0x88, 0x97, 0x58, 0xa8, 0x21, 0x3f, 0x6d, 0x59, 0xe6, 0x3d, 0x80, 0xb0, 0xd2,
-	0xc0, 0xef, 0x93, 0x4e, 0x99, 0x21, 0x32, 0x80, 0x9c, 0x91, 0xb0, 0xb5, 0xdc, 0x15, 0x88, 0x64,
-	0xb8, 0xbf, 0x0b, 0x1d, 0x19, 0x6f, 0xce, 0xc2, 0xef, 0xff, 0x56, 0x00, 0xe8, 0x8a, 0xdb, 0xc7,
-	0x67, 0x33, 0x16, 0xd7, 0x21, 0xe2, 0xae, 0x84, 0x52, 0x1e, 0xe5, 0x1d, 0x83, 0x08, 0x82, 0x08,
-	0x72, 0x09, 0x4c, 0x2a, 0xf0, 0x35, 0xe8, 0x3c, 0x11, 0x59, 0x61, 0xac, 0x90, 0xd4, 0xcf, 0x97,
-	0xa0, 0xe6, 0x3a, 0xc7, 0x3d, 0x22, 0x5c, 0x46, 0x70, 0xbd, 0xda, 0x75, 0x8e, 0x89, 0xc8, 0x19,
-	0xe8, 0x02, 0xcc, 0xf9, 0xba, 0x77, 0x48, 0xe6, 0x67, 0xb9, 0xbe, 0x0a, 0x69, 0x76, 0x0d, 0xb4,
-	0x04, 0xe5, 0x7d, 0xd3, 0xc2, 0xec, 0x26, 0x47, 0x4d, 0x63, 0x0d, 0xf4, 0xcd, 0xe0, 0x46, 0x60,
-	0x35, 0xf7, 0xcd, 0x1f, 0x76, 0x29, 0xf0, 0x3a, 0xcc, 0x13, 0x4e, 0x22, 0x48, 0x30, 0xb1, 0x6e,
-	0xf1, 0x3c, 0x3f, 0xef, 0xa4, 0x15, 0xff, 0x9f, 0x29, 0xb0, 0x10, 0x92, 0x96, 0xea, 0x26, 0xa2,
-	0xee, 0xa8, 0xaa, 0x5b, 0x77, 0x0c, 0xa6, 0x45, 0x9a, 0x19, 0x76, 0x85, 0x0d, 0x64, 0x0a, 0x2d,
-	0x1c, 0x32, 0x29, 0x38, 0x27, 0x9b, 0x27, 0x94, 0x31, 0x8d, 0x20, 0x5d, 0x54, 0x71, 0x9d, 0xe3,
-	0xae, 0x21, 0x48, 0xc6, 0x2e, 0x58, 0xb3, 0x50, 0x94, 0x90, 0x6c, 0x9d, 0xde, 0xb1, 0xbe, 0x0e,
-	0xf3, 0xd8, 0x75, 0x1d, 0xb7, 0x37, 0xc4, 0x9e, 0xa7, 0x0f, 0x82, 0xbb, 0x0b, 0x0d, 0xda, 0xb9,
-	0xc5, 0xfa, 0xd4, 0xbf, 0x2d, 0x41, 0x33, 0xdc, 0x4a, 0x70, 0x8b, 0xc0, 0x34, 0x82, 0x5b, 0x04,
-	0x26, 0x39, 0x5f, 0x70, 0x99, 0x96, 0x14, 0x1c, 0xb0, 0x56, 0x68, 0x2b, 0x5a, 0x8d, 0xf7, 0x76,
-	0x0d, 0x62, 0xdc, 0x09, 0x81, 0x6c, 0xc7, 0xc0, 0x21, 0x07, 0x40, 0xd0, 0xc5, 0x19, 0x20, 0xc6,
-	0x48, 0xa5, 0x1c, 0x8c, 0x54, 0xce, 0xc1, 0x48, 0x15, 0x09, 0x23, 0x2d, 0x43, 0x65, 0x6f, 0xdc,
-	0x3f, 0xc4, 0x3e, 0xf7, 0xfb, 0x78, 0x2b, 0xce, 0x60, 0xd5, 0x04, 0x83, 0x09, 0x3e, 0xaa, 0x45,
-	0xf9, 0xe8, 0x12, 0xd4, 0x02, 0x4b, 0xed, 0xd1, 0xaa, 0x5a, 0x51, 0xab, 0x72, 0x13, 0xed, 0xa1,
-	0xb7, 0x02, 0xa7, 0xb0, 0x4e, 0x25, 0x4a, 0x95, 0x28, 0xa4, 0x04, 0x97, 0x04, 0x2e, 0xe1, 0xcb,
-	0xb0, 0x10, 0x21, 0x07, 0xe5, 0x33, 0x56, 0x7a, 0x8b, 0xc4, 0x0c, 0xd4, 0x82, 0xdc, 0x84, 0x66,
-	0x48, 0x12, 0x0a, 0x37, 0xcf, 0x42, 0x35, 0xd1, 0x4b, 0xc1, 0x04, 0xbb, 0x37, 0x4f, 0xc9, 0xee,
-	0x17, 0xa1, 0xca, 0x63, 0x2c, 0xaf, 0xbd, 0x10, 0x4f, 0x91, 0xe4, 0x92, 0x84, 0x4f, 0x01, 0x85,
-	0x5b, 0x9c, 0xcd, 0x31, 0x4d, 0xf0, 0x50, 0x21, 0xc9, 0x43, 0xea, 0x9f, 0x2b, 0xb0, 0x18, 0x5d,
-	0xec, 0xac, 0x86, 0xfb, 0x7d, 0xa8, 0xb3, 0xe2, 0x67, 0x8f, 0xa8, 0x10, 0x79, 0xad, 0x32, 0x71,
-	0x78, 0x1a, 0x84, 0xef, 0x38, 0x08, 0x61, 0x8e, 0x1d, 0xf7, 0xd0, 0xb4, 0x07, 0x3d, 0x82, 0x99,
-	0x48, 0xe1, 0xf2, 0xce, 0x47, 0xa4, 0x4f, 0xfd, 0x4d, 0x05, 0xae, 0x3c, 0x1e, 0x19, 0xba, 0x8f,
-	0x23, 0x1e, 0xcc, 0xac, 0xd7, 0x29, 0xc5, 0x7d, 0xc6, 0xc2, 0x84, 0x63, 0x8e, 0xac, 0xe7, 0xf1,
-	0xfb, 0x8c, 0xc4, 0xef, 0xe3, 0xd8, 0xa4, 0x2e, 0x20, 0x9f, 0x1d, 0x9b, 0x0e, 0x54, 0x8f, 0xf8,
-	0x74, 0xc1, 0xcb, 0x94, 0xa0, 0x1d, 0x2b, 0x06, 0x17, 0x4f, 0x55, 0x0c, 0x56, 0xb7, 0xe0, 0xa2,
-	0x86, 0x3d, 0x6c, 0x1b, 0xb1, 0x8d, 0x9c, 0x39, 0xa9, 0x35, 0x82, 0x8e, 0x6c, 0xba, 0x59, 0x38,
-	0x95, 0x39, 0xbe, 0x3d, 0x97, 0x4c, 0xeb, 0x73, 0x65, 0x4d, 0xfc, 0x2d, 0xba, 0x8e, 0xaf, 0xfe,
-	0x45, 0x01, 0x2e, 0xdc, 0x37, 0x0c, 0xae, 0xe7, 0xb9, 0x2b, 0xf7, 0xbc, 0xbc, 0xec, 0xa4, 0x17,
-	0x5a, 0x4c, 0x7b, 0xa1, 0xcf, 0x4a, 0xf7, 0x72, 0x2b, 0x64, 0x8f, 0x87, 0x81, 0x09, 0x76, 0xd9,
-	0x05, 0xac, 0x77, 0x79, 0xc9, 0xb4, 0x67, 0x39, 0x03, 0x6a, 0x86, 0xa7, 0x3b, 0x67, 0xd5, 0x20,
-	0x39, 0xa7, 0x8e, 0xa0, 0x9d, 0x26, 0xd6, 0x8c, 0x7a, 0x24, 0xa0, 0xc8, 0xc8, 0x61, 0x89, 0xdf,
-	0x06, 0xf1, 0xc4, 0x68, 0xd7, 0xb6, 0xe3, 0xa9, 0xff, 0x55, 0x80, 0xf6, 0x8e, 0x7e, 0x84, 0xff,
-	0xef, 0x1c, 0xd0, 0x27, 0xb0, 0xe4, 0xe9, 0x47, 0xb8, 0x17, 0x09, 0xc0, 0x7b, 0x2e, 0xfe, 0x8c,
-	0x3b, 0xb1, 0xaf, 0xc8, 0x52, 0xf3, 0xd2, 0x1b, 0x46, 0xda, 0xa2, 0x17, 0xeb, 0xd7, 0xf0, 0x67,
-	0xe8, 0x25, 0x58, 0x88, 0xde, 0x86, 0x23, 0xa8, 0x55, 0x29, 0xc9, 0xe7, 0x23, 0x37, 0xde, 0xba,
-	0x86, 0xfa, 0x19, 0xbc, 0xf8, 0xd8, 0xf6, 0xb0, 0xdf, 0x0d, 0x6f, 0x6d, 0xcd, 0x18, 0x7f, 0x5e,
-	0x85, 0x7a, 0x48, 0xf8, 0xd4, 0x93, 0x14, 0xc3, 0x53, 0x1d, 0xe8, 0x6c, 0xe9, 0xee, 0x61, 0x90,
-	0xce, 0xde, 0x60, 0xb7, 0x61, 0x9e, 0xe3, 0x82, 0xfb, 0xe2, 0x5e, 0x98, 0x86, 0xf7, 0xb1, 0x8b,
-	0xed, 0x3e, 0xde, 0x74, 0xfa, 0x87, 0xc4, 0x21, 0xf1, 0xd9, 0xab, 0x40, 0x25, 0xe2, 0xbb, 0x6e,
-	0x44, 0x1e, 0xfd, 0x15, 0x62, 0x8f, 0xfe, 0xa6, 0x3c, 0x70, 0x55, 0x7f, 0x54, 0x80, 0xe5, 0xfb,
-	0x96, 0x8f, 0xdd, 0x30, 0xc3, 0x70, 0x9a, 0x64, 0x49, 0x98, 0xbd, 0x28, 0x9c, 0x25, 0x7b, 0x91,
-	0xa3, 0x82, 0x29, 0xcb, 0xb5, 0x94, 0xce, 0x98, 0x6b, 0xb9, 0x0f, 0x30, 0x72, 0x9d, 0x11, 0x76,
-	0x7d, 0x13, 0x07, 0xb1, 0x5f, 0x0e, 0x07, 0x27, 0x32, 0x48, 0xfd, 0x04, 0x5a, 0x0f, 0xfb, 0xeb,
-	0x8e, 0xbd, 0x6f, 0xba, 0xc3, 0x80, 0x50, 0x29, 0xa1, 0x53, 0x72, 0x08, 0x5d, 0x21, 0x25, 0x74,
-	0xaa, 0x09, 0x8b, 0x91, 0xb9, 0x67, 0x54, 0x5c, 0x83, 0x7e, 0x6f, 0xdf, 0xb4, 0x4d, 0x7a, 0xdb,
-	0xac, 0x40, 0x1d, 0x54, 0x18, 0xf4, 0x1f, 0xf0, 0x1e, 0xf5, 0x4b, 0x05, 0x2e, 0x69, 0x98, 0x08,
-	0x4f, 0x70, 0x71, 0x67, 0xd7, 0xdf, 0xf2, 0x06, 0x33, 0x38, 0x14, 0xf7, 0xa0, 0x34, 0xf4, 0x06,
-	0x19, 0x45, 0x77, 0x62, 0xa2, 0x63, 0x0b, 0x69, 0x14, 0x58, 0xfd, 0x89, 0x02, 0x4b, 0x41, 0x69,
-	0x32, 0x26, 0xc2, 0x71, 0xb6, 0x55, 0x52, 0x57, 0xa9, 0x27, 0xbc, 0x04, 0xbe, 0x00, 0x73, 0xc6,
-	0x5e, 0x54, 0x41, 0x56, 0x8c, 0x3d, 0xaa, 0x1b, 0x25, 0x9e, 0x72, 0x49, 0xea, 0x29, 0x27, 0x19,
-	0xbf, 0x2c, 0xb9, 0xf3, 0xf4, 0x18, 0xda, 0xdc, 0x41, 0xf9, 0x68, 0x84, 0x5d, 0x9d, 0xf2, 0x57,
-	0x80, 0xfc, 0xdb, 0x81, 0x0b, 0xad, 0x64, 0xbe, 0xb3, 0x4b, 0x96, 0x25, 0xb9, 0x13, 0xad, 0xfe,
-	0xbd, 0x02, 0xd7, 0x92, 0xf3, 0x6e, 0xf3, 0xa2, 0xdd, 0xcc, 0x4f, 0xc8, 0x69, 0xc5, 0xaf, 0x10,
-	0x56, 0xfc, 0x66, 0x2a, 0x5d, 0x46, 0xab, 0x8b, 0xa5, 0x78, 0x75, 0xf1, 0xd6, 0xfb, 0xe2, 0x2e,
-	0xf9, 0xee, 0xc9, 0x08, 0xa3, 0x39, 0x28, 0x3e, 0xc2, 0xc7, 0xad, 0x73, 0x08, 0xa0, 0xf2, 0xc8,
-	0x71, 0x87, 0xba, 0xd5, 0x52, 0x50, 0x1d, 0xe6, 0x78, 0x45, 0xba, 0x55, 0x40, 0xf3, 0x50, 0x5b,
-	0x0f, 0xaa, 0x74, 0xad, 0xe2, 0xad, 0x5b, 0xd0, 0x88, 0xde, 0x95, 0x25, 0xe3, 0x36, 0xf1, 0x40,
-	0xef, 0x9f, 0xb4, 0xce, 0xa1, 0x0a, 0x14, 0x36, 0xef, 0xb6, 0x14, 0xfa, 0xf7, 0x8d, 0x56, 0xe1,
-	0xd6, 0x1f, 0x29, 0xb0, 0x98, 0x42, 0x12, 0x35, 0x01, 0x1e, 0xdb, 0x7d, 0x5e, 0x78, 0x6e, 0x9d,
-	0x43, 0x0d, 0xa8, 0x06, 0x65, 0x68, 0xb6, 0xf6, 0xae, 0x43, 0xa1, 0x5b, 0x05, 0xd4, 0x82, 0x06,
-	0x1b, 0x38, 0xee, 0xf7, 0xb1, 0xe7, 0xb5, 0x8a, 0xa2, 0xe7, 0x81, 0x6e, 0x5a, 0x63, 0x17, 0xb7,
-	0x4a, 0x04, 0xbf, 0x5d, 0x47, 0xc3, 0x16, 0xd6, 0x3d, 0xdc, 0x2a, 0x23, 0x04, 0x4d, 0xde, 0x08,
-	0x06, 0x55, 0x22, 0x7d, 0xc1, 0xb0, 0xb9, 0x5b, 0x3f, 0x56, 0xa2, 0x65, 0x2f, 0x4a, 0x8b, 0x0b,
-	0x70, 0xfe, 0xb1, 0x6d, 0xe0, 0x7d, 0xd3, 0xc6, 0x46, 0xf8, 0xa9, 0x75, 0x0e, 0x9d, 0x87, 0x85,
-	0x2d, 0xec, 0x0e, 0x70, 0xa4, 0xb3, 0x80, 0x16, 0x61, 0x7e, 0xcb, 0x7c, 0x1a, 0xe9, 0x2a, 0xa2,
-	0x25, 0x68, 0xed, 0x98, 0xf6, 0xc0, 0x8a, 0x02, 0x96, 0xe8, 0x68, 0xd3, 0x76, 0xdc, 0x48, 0x67,
-	0x99, 0x76, 0xea, 0x9f, 0xc6, 0x3a, 0x2b, 0xa8, 0x03, 0xcb, 0x94, 0xa8, 0x77, 0x37, 0x30, 0xa1,
-	0x46, 0xe4, 0xdb, 0x9c, 0x5a, 0xaa, 0x2a, 0x2d, 0x65, 0xf5, 0x27, 0x37, 0xa1, 0x46, 0x84, 0x75,
-	0xdd, 0x71, 0x5c, 0x03, 0x59, 0x80, 0xe8, 0xe3, 0xb2, 0xe1, 0xc8, 0xb1, 0xc5, 0x43, 0x54, 0x74,
-	0x3b, 0x21, 0xdf, 0xac, 0x91, 0x06, 0xe4, 0x22, 0xd1, 0xb9, 0x21, 0x85, 0x4f, 0x00, 0xab, 0xe7,
-	0xd0, 0x90, 0xae, 0xb6, 0x6b, 0x0e, 0xf1, 0xae, 0xd9, 0x3f, 0x0c, 0x42, 0x80, 0xbb, 0x19, 0xaf,
-	0xf9, 0xd2, 0xa0, 0xc1, 0x7a, 0xd7, 0xa5, 0xeb, 0xb1, 0xd7, 0x7f, 0x81, 0x1c, 0xa9, 0xe7, 0xd0,
-	0x67, 0x54, 0xfd, 0x84, 0xf1, 0x54, 0xb0, 0xe0, 0x6a, 0xf6, 0x82, 0x29, 0xe0, 0x53, 0x2e, 0xb9,
-	0x09, 0x65, 0xca, 0xf7, 0x48, 0x76, 0xf3, 0x20, 0xfa, 0x0b, 0x17, 0x9d, 0x6b, 0xd9, 0x00, 0x62,
-	0xb6, 0x4f, 0x61, 0x21, 0xf1, 0xbe, 0x1c, 0xc9, 0x7c, 0x30, 0xf9, 0x2f, 0x05, 0x74, 0x6e, 0xe5,
-	0x01, 0x15, 0x6b, 0x0d, 0xa0, 0x19, 0x7f, 0x94, 0x86, 0x56, 0x72, 0x3c, 0x6d, 0x65, 0x2b, 0xbd,
-	0x92, 0xfb, 0x11, 0x2c, 0x65, 0x82, 0x56, 0xf2, 0xe5, 0x33, 0xba, 0x35, 0x71, 0x82, 0x38, 0xb3,
-	0xbd, 0x9a, 0x0b, 0x56, 0x2c, 0x77, 0x42, 0x99, 0x20, 0xf5, 0xec, 0x34, 0xc9, 0xe3, 0xc1, 0x34,
-	0x59, 0xef, 0x61, 0x3b, 0x77, 0x72, 0xc3, 0x8b, 0xa5, 0x7f, 0x99, 0x5d, 0x2f, 0x94, 0x3d, 0xdd,
-	0x44, 0x6f, 0xc8, 0xa7, 0x9b, 0xf0, 0xe6, 0xb4, 0xb3, 0x7a, 0x9a, 0x21, 0x02, 0x89, 0xef, 0xd3,
-	0x7b, 0x81, 0x92, 0xc7, 0x8f, 0x49, 0xb9, 0x0b, 0xe6, 0xcb, 0x7e, 0xd7, 0xd9, 0x79, 0xe3, 0x14,
-	0x23, 0x04, 0x02, 0x4e, 0xf2, 0x69, 0x79, 0x20, 0x86, 0x77, 0xa6, 0x72, 0xcd, 0xd9, 0x64, 0xf0,
-	0x7b, 0xb0, 0x90, 0x88, 0x4a, 0x50, 0xfe, 0xc8, 0xa5, 0x33, 0xc9, 0xdc, 0x32, 0x91, 0x4c, 0xdc,
-	0x03, 0x44, 0x19, 0xdc, 0x2f, 0xb9, 0x2b, 0xd8, 0xb9, 0x95, 0x07, 0x54, 0x6c, 0x64, 0x04, 0x8b,
-	0x89, 0x8f, 0x4f, 0x56, 0xd1, 0xab, 0xb9, 0x57, 0x7b, 0xb2, 0xda, 0x79, 0x2d, 0xff, 0x7a, 0x4f,
-	0x56, 0xd5, 0x73, 0xc8, 0xa3, 0x0a, 0x3a, 0x71, 0x97, 0x0c, 0x65, 0xcc, 0x22, 0xbf, 0x33, 0xd7,
-	0x79, 0x3d, 0x27, 0xb4, 0xd8, 0xe6, 0x11, 0x9c, 0x97, 0x5c, 0xf9, 0x43, 0xaf, 0x4f, 0x64, 0x8f,
-	0xe4, 0x5d, 0xc7, 0xce, 0xed, 0xbc, 0xe0, 0x11, 0xf3, 0xd0, 0x0a, 0xf0, 0xba, 0x6f, 0x59, 0xcc,
-	0xb3, 0x78, 0x2d, 0xcb, 0xf2, 0xc5, 0xc0, 0x32, 0xb6, 0x9a, 0x09, 0x2d, 0x96, 0xfc, 0x45, 0x40,
-	0x3b, 0x07, 0xce, 0x31, 0x8d, 0x02, 0x06, 0x63, 0xee, 0x58, 0x66, 0x1a, 0xc0, 0x34, 0x68, 0x86,
-	0x20, 0x4e, 0x1c, 0x21, 0x16, 0xef, 0x01, 0x3c, 0xc4, 0xfe, 0x16, 0xf6, 0x5d, 0x22, 0xfd, 0x2f,
-	0x65, 0xe1, 0xce, 0x01, 0x82, 0xa5, 0x5e, 0x9e, 0x0a, 0x17, 0x25, 0xe8, 0x96, 0x6e, 0x8f, 0x75,
-	0x2b, 0xf2, 0xb2, 0x4b, 0x4e, 0xd0, 0x24, 0xd8, 0x64, 0x82, 0xa6, 0xa1, 0xc5, 0x92, 0xc7, 0xc2,
-	0x7f, 0x89, 0xdc, 0x42, 0x98, 0xec, 0xbf, 0xa4, 0x6f, 0xc0, 0x25, 0x75, 0xfb, 0x04, 0x78, 0xb1,
-	0xf0, 0x17, 0x0a, 0xbd, 0xa8, 0x9a, 0x00, 0xf8, 0xd8, 0xf4, 0x0f, 0xb6, 0x2d, 0xdd, 0xf6, 0xf2,
-	0xa0, 0x40, 0x01, 0x4f, 0x81, 0x02, 0x87, 0x17, 0x28, 0x18, 0x30, 0x1f, 0xab, 0xf8, 0x23, 0xd9,
-	0xd3, 0x25, 0xd9, 0x45, 0x89, 0xce, 0xca, 0x74, 0x40, 0xb1, 0xca, 0x3e, 0xcc, 0xc7, 0x62, 0x38,
-	0xe9, 0x2a, 0xb2, 0x28, 0x2f, 0xa9, 0xec, 0x12, 0xd2, 0x91, 0x24, 0xa8, 0x07, 0x28, 0x5d, 0xd8,
-	0x44, 0xf9, 0xca, 0xe0, 0x93, 0x54, 0x4f, 0x76, 0xb5, 0x94, 0x69, 0xf3, 0xc4, 0xd5, 0x01, 0xb9,
-	0xa9, 0x90, 0xde, 0x84, 0x90, 0x6a, 0xf3, 0x8c, 0x9b, 0x08, 0xea, 0x39, 0xf4, 0x31, 0x54, 0xf8,
-	0x2f, 0x40, 0xdd, 0x98, 0x5c, 0x42, 0xe0, 0xb3, 0xdf, 0x9c, 0x02, 0x25, 0x26, 0x3e, 0x84, 0x0b,
-	0x19, 0x05, 0x04, 0xa9, 0x97, 0x31, 0xb9, 0xd8, 0x30, 0xcd, 0xfe, 0x89, 0xc5, 0x52, 0xf5, 0x81,
-	0x09, 0x8b, 0x65, 0xd5, 0x12, 0xa6, 0x2d, 0xd6, 0x83, 0xc5, 0x54, 0xfe, 0x55, 0x6a, 0x00, 0xb3,
-	0xb2, 0xb4, 0xd3, 0x16, 0x18, 0xc0, 0x0b, 0xd2, 0x5c, 0xa3, 0xd4, 0x37, 0x99, 0x94, 0x95, 0x9c,
-	0xb6, 0x50, 0x1f, 0xce, 0x4b, 0x32, 0x8c, 0x52, 0x1b, 0x97, 0x9d, 0x89, 0x9c, 0xb6, 0xc8, 0x3e,
-	0x74, 0xd6, 0x5c, 0x47, 0x37, 0xfa, 0xba, 0xe7, 0xd3, 0xac, 0x1f, 0x09, 0x42, 0x03, 0xe7, 0x50,
-	0x1e, 0x39, 0x48, 0x73, 0x83, 0xd3, 0xd6, 0xd9, 0x83, 0x3a, 0x3d, 0x4a, 0xf6, 0x2b, 0x3d, 0x48,
-	0x6e, 0x21, 0x22, 0x10, 0x19, 0x6a, 0x47, 0x06, 0x28, 0x98, 0x7a, 0x17, 0xea, 0xeb, 0xb4, 0x7c,
-	0xda, 0xb5, 0x0d, 0xfc, 0x34, 0x69, 0xad, 0xe8, 0x4f, 0x15, 0xdc, 0x8e, 0x00, 0xe4, 0xa6, 0xd0,
-	0x3c, 0xf5, 0xd9, 0x0d, 0xfc, 0x94, 0x9d, 0xf3, 0x8a, 0x6c, 0xde, 0x18, 0x48, 0x46, 0x8c, 0x23,
-	0x85, 0x8c, 0xd8, 0xf9, 0xa5, 0xa8, 0x27, 0x2b, 0x96, 0xbb, 0x93, 0x31, 0x49, 0x0a, 0x32, 0x58,
-	0xf5, 0x6e, 0xfe, 0x01, 0x51, 0xbb, 0x10, 0xe0, 0xd5, 0xa5, 0xb5, 0xdb, 0x97, 0x27, 0xa1, 0x1e,
-	0x75, 0x4f, 0x57, 0xa6, 0x03, 0x8a, 0x55, 0xb6, 0xa1, 0x46, 0xb8, 0x93, 0x1d, 0xcf, 0x0d, 0xd9,
-	0x40, 0xf1, 0x39, 0xff, 0xe1, 0x6c, 0x60, 0xaf, 0xef, 0x9a, 0x7b, 0xfc, 0xd0, 0xa5, 0xe8, 0xc4,
-	0x40, 0x26, 0x1e, 0x4e, 0x02, 0x52, 0x60, 0x3e, 0xa6, 0x3e, 0x83, 0x20, 0x1d, 0x57, 0x95, 0xaf,
-	0x4f, 0x3b, 0xdf, 0xb8, 0x9a, 0xbc, 0x9d, 0x17, 0x5c, 0x2c, 0xfb, 0x4b, 0x34, 0x0e, 0xa2, 0xdf,
-	0xd7, 0xc6, 0xa6, 0x65, 0x04, 0x89, 0x3f, 0x74, 0x77, 0xd2, 0x54, 0x31, 0xd0, 0x4c, 0xf7, 0x6f,
-	0xc2, 0x08, 0xb1, 0xfe, 0xcf, 0x43, 0x4d, 0xe4, 0x9f, 0x91, 0x2c, 0x6b, 0x99, 0xcc, 0x7c, 0x77,
-	0x6e, 0x4c, 0x06, 0x12, 0x33, 0x63, 0x58, 0x92, 0x65, 0x9b, 0xa5, 0x21, 0xf6, 0x84, 0xb4, 0xf4,
-	0x14, 0xfe, 0x58, 0xfd, 0xb2, 0x01, 0xd5, 0x60, 0xe0, 0x57, 0x9c, 0xb8, 0xfa, 0x1a, 0x32, 0x49,
-	0xdf, 0x83, 0x85, 0xc4, 0x8f, 0xaf, 0x48, 0x35, 0xb8, 0xfc, 0x07, 0x5a, 0xa6, 0x89, 0xda, 0xc7,
-	0xfc, 0x77, 0x4b, 0x45, 0x88, 0xf7, 0x72, 0x56, 0x36, 0x2a, 0x19, 0xdd, 0x4d, 0x99, 0xf8, 0x7f,
-	0x77, 0x80, 0xf3, 0x08, 0x20, 0x12, 0xda, 0x4c, 0x7e, 0x1b, 0x40, 0xbc, 0xf5, 0x69, 0xd4, 0x1a,
-	0x4a, 0xa3, 0x97, 0x57, 0xf2, 0xdc, 0xb3, 0xce, 0xf6, 0x40, 0xb3, 0x63, 0x96, 0xc7, 0xd0, 0x88,
-	0xbe, 0xda, 0x41, 0xd2, 0x5f, 0xa2, 0x4c, 0x3f, 0xeb, 0x99, 0xb6, 0x8b, 0xad, 0x53, 0x3a, 0xb6,
-	0x53, 0xa6, 0xf3, 0x00, 0xa5, 0xef, 0x61, 0x48, 0x03, 0x81, 0xcc, 0xdb, 0x1f, 0xd2, 0x40, 0x20,
-	0xfb, 0x72, 0x07, 0x4b, 0x4a, 0x26, 0x2f, 0x17, 0x48, 0x93, 0x92, 0x19, 0xd7, 0x35, 0xa4, 0x49,
-	0xc9, 0xac, 0xdb, 0x0a, 0x11, 0xf9, 0x9b, 0x18, 0xba, 0xc9, 0x7e, 0x56, 0x77, 0x1a, 0xf1, 0x0c,
-	0x58, 0x7e, 0xe4, 0xf8, 0xe6, 0xfe, 0x49, 0xb2, 0xcc, 0x24, 0x75, 0x9b, 0xb3, 0x6a, 0x5c, 0xd3,
-	0xa5, 0xfc, 0x32, 0xf5, 0xda, 0xb2, 0x6a, 0x59, 0x28, 0x4f, 0x51, 0xac, 0x73, 0x2f, 0x07, 0x46,
-	0x69, 0x3b, 0xb6, 0x76, 0xef, 0x93, 0x37, 0x06, 0xa6, 0x7f, 0x30, 0xde, 0x23, 0x68, 0xdd, 0x61,
-	0x53, 0xbc, 0x6e, 0x3a, 0xfc, 0xbf, 0x3b, 0x81, 0xaa, 0xb8, 0x43, 0x67, 0xbd, 0x43, 0x66, 0x1d,
-	0xed, 0xed, 0x55, 0x68, 0xeb, 0xde, 0xff, 0x04, 0x00, 0x00, 0xff, 0xff, 0x33, 0xb2, 0x39, 0x67,
-	0xef, 0x5a, 0x00, 0x00,
+	// 5484 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe4, 0x7c, 0x59, 0x6f, 0x1c, 0x57,
+	0x76, 0xb0, 0xaa, 0xf7, 0x3e, 0xdd, 0x6c, 0x36, 0xaf, 0x68, 0xaa, 0xd5, 0x5a, 0x5d, 0x96, 0x2c,
+	0x9a, 0xb6, 0x28, 0x99, 0xfa, 0x06, 0x9f, 0x97, 0xb1, 0x67, 0x44, 0xd2, 0x92, 0x3b, 0x43, 0xca,
+	0x74, 0x91, 0x92, 0x03, 0x3b, 0x40, 0xa3, 0xd8, 0x75, 0xd9, 0x2a, 0xb3, 0xbb, 0xaa, 0x5d, 0x55,
+	0x4d, 0x89, 0x0e, 0x90, 0xf1, 0x24, 0x4e, 0x80, 0x64, 0x06, 0x49, 0x90, 0xe5, 0x21, 0x6f, 0x41,
+	0x1e, 0x82, 0xc9, 0x32, 0x40, 0x80, 0x24, 0x08, 0x10, 0x04, 0x08, 0x90, 0xbc, 0xcc, 0x24, 0x0f,
+	0x41, 0xde, 0x12, 0x04, 0x01, 0xf2, 0x1f, 0xb2, 0xbc, 0x26, 0xb8, 0x4b, 0xdd, 0xda, 0x6e, 0x75,
+	0x17, 0xd9, 0x92, 0x0d, 0x24, 0x7a, 0x11, 0xef, 0xa9, 0x73, 0xb7, 0x73, 0xcf, 0x39, 0xf7, 0x6c,
+	0xb7, 0xa1, 0x69, 0xe8, 0x9e, 0xde, 0xed, 0xd9, 0xb6, 0x63, 0xac, 0x8e, 0x1c, 0xdb, 0xb3, 0xd1,
+	0xc2, 0xd0, 0x1c, 0x1c, 0x8d, 0x5d, 0xd6, 0x5a, 0x25, 0x9f, 0xdb, 0xf5, 0x9e, 0x3d, 0x1c, 0xda,
+	0x16, 0x03, 0xb5, 0x1b, 0xa6, 0xe5, 0x61, 0xc7, 0xd2, 0x07, 0xbc, 0x5d, 0x0f, 0x77, 0x68, 0xd7,
+	0xdd, 0xde, 0x63, 0x3c, 0xd4, 0x79, 0xab, 0x3a, 0x74, 0xfb, 0xfc, 0xcf, 0x05, 0xd3, 0x32, 0xf0,
+	0xd3, 0xf0, 0x54, 0x6a, 0x19, 0x8a, 0xef, 0x0d, 0x47, 0xde, 0xb1, 0xfa, 0x67, 0x0a, 0xd4, 0xef,
+	0x0d, 0xc6, 0xee, 0x63, 0x0d, 0x7f, 0x36, 0xc6, 0xae, 0x87, 0x6e, 0x43, 0x61, 0x5f, 0x77, 0x71,
+	0x4b, 0xb9, 0xaa, 0x2c, 0xd7, 0xd6, 0x2e, 0xae, 0x46, 0xd6, 0xc4, 0x57, 0xb3, 0xed, 0xf6, 0xd7,
+	0x75, 0x17, 0x6b, 0x14, 0x13, 0x21, 0x28, 0x18, 0xfb, 0x9d, 0xcd, 0x56, 0xee, 0xaa, 0xb2, 0x9c,
+	0xd7, 0xe8, 0xdf, 0xe8, 0x32, 0x80, 0x8b, 0xfb, 0x43, 0x6c, 0x79, 0x9d, 0x4d, 0xb7, 0x95, 0xbf,
+	0x9a, 0x5f, 0xce, 0x6b, 0x21, 0x08, 0x52, 0xa1, 0xde, 0xb3, 0x07, 0x03, 0xdc, 0xf3, 0x4c, 0xdb,
+	0xea, 0x6c, 0xb6, 0x0a, 0xb4, 0x6f, 0x04, 0x86, 0xda, 0x50, 0x31, 0xdd, 0xce, 0x70, 0x64, 0x3b,
+	0x5e, 0xab, 0x78, 0x55, 0x59, 0xae, 0x68, 0xa2, 0xad, 0x7e, 0x2f, 0x07, 0x73, 0x7c, 0xd9, 0xee,
+	0xc8, 0xb6, 0x5c, 0x8c, 0xee, 0x40, 0xc9, 0xf5, 0x74, 0x6f, 0xec, 0xf2, 0x95, 0x5f, 0x90, 0xae,
+	0x7c, 0x97, 0xa2, 0x68, 0x1c, 0x55, 0xba, 0xf4, 0xf8, 0xd2, 0xf2, 0x92, 0xa5, 0x45, 0xb7, 0x57,
+	0x48, 0x6c, 0x6f, 0x19, 0xe6, 0x0f, 0xc8, 0xea, 0x76, 0x03, 0xa4, 0x22, 0x45, 0x8a, 0x83, 0xc9,
+	0x48, 0x9e, 0x39, 0xc4, 0x1f, 0x1c, 0xec, 0x62, 0x7d, 0xd0, 0x2a, 0xd1, 0xb9, 0x42, 0x10, 0x74,
+	0x1e, 0x2a, 0xb4, 0x4b, 0xd7, 0x73, 0x5b, 0xe5, 0xab, 0xca, 0x72, 0x41, 0x2b, 0xd3, 0xf6, 0x9e,
+	0xab, 0x7e, 0x17, 0x16, 0x29, 0x09, 0x36, 0x1e, 0xeb, 0x96, 0x85, 0x07, 0xee, 0xe9, 0x4f, 0x30,
+	0x3c, 0x49, 0x2e, 0x32, 0x09, 0x39, 0x84, 0x1e, 0x1f, 0x9f, 0x1e, 0x63, 0x55, 0x13, 0x6d, 0xf5,
+	0x1f, 0x15, 0x68, 0x8a, 0xad, 0xf8, 0xb3, 0x2f, 0x42, 0xb1, 0x67, 0x8f, 0x2d, 0x8f, 0x4e, 0x3f,
+	0xa7, 0xb1, 0x06, 0x7a, 0x11, 0xea, 0xbc, 0x5b, 0xd7, 0xd2, 0x87, 0x98, 0xce, 0x52, 0xd5, 0x6a,
+	0x1c, 0xf6, 0x40, 0x1f, 0xe2, 0x4c, 0x74, 0xbf, 0x0a, 0xb5, 0x91, 0xee, 0x78, 0x66, 0x84, 0x6b,
+	0xc2, 0xa0, 0x49, 0x4c, 0x43, 0x66, 0x30, 0xe9, 0x5f, 0x7b, 0xba, 0x7b, 0xd8, 0xd9, 0xe4, 0xd4,
+	0x8e, 0xc0, 0xd4, 0xdf, 0x55, 0x60, 0xe9, 0xae, 0xeb, 0x9a, 0x7d, 0x2b, 0xb1, 0xb3, 0x25, 0x28,
+	0x59, 0xb6, 0x81, 0x3b, 0x9b, 0x74, 0x6b, 0x79, 0x8d, 0xb7, 0xd0, 0x05, 0xa8, 0x8e, 0x30, 0x76,
+	0xba, 0x8e, 0x3d, 0xf0, 0x37, 0x56, 0x21, 0x00, 0xcd, 0x1e, 0x60, 0xf4, 0x21, 0x2c, 0xb8, 0xb1,
+	0x81, 0x18, 0x21, 0x6b, 0x6b, 0x2f, 0xad, 0x26, 0xe4, 0x7d, 0x35, 0x3e, 0xa9, 0x96, 0xec, 0xad,
+	0x7e, 0x91, 0x83, 0xb3, 0x02, 0x8f, 0xad, 0x95, 0xfc, 0x4d, 0x28, 0xef, 0xe2, 0xbe, 0x58, 0x1e,
+	0x6b, 0x64, 0xa1, 0xbc, 0x38, 0xb2, 0x7c, 0xf8, 0xc8, 0xb2, 0x88, 0x68, 0xec, 0x3c, 0x8a, 0xc9,
+	0xf3, 0xb8, 0x02, 0x35, 0xfc, 0x74, 0x64, 0x3a, 0xb8, 0x4b, 0x98, 0x9a, 0x92, 0xbc, 0xa0, 0x01,
+	0x03, 0xed, 0x99, 0xc3, 0xb0, 0xdc, 0x96, 0x33, 0xcb, 0xad, 0xfa, 0x7b, 0x0a, 0x9c, 0x4b, 0x9c,
+	0x12, 0x57, 0x04, 0x1a, 0x34, 0xe9, 0xce, 0x03, 0xca, 0x10, 0x95, 0x40, 0x08, 0xfe, 0xf2, 0x24,
+	0x82, 0x07, 0xe8, 0x5a, 0xa2, 0x7f, 0x68, 0x91, 0xb9, 0xec, 0x8b, 0x3c, 0x84, 0x73, 0xf7, 0xb1,
+	0xc7, 0x27, 0x20, 0xdf, 0xf0, 0x0c, 0x22, 0x1a, 0xd5, 0x38, 0xb9, 0xb8, 0xc6, 0x51, 0x7f, 0x3f,
+	0x07, 0xcd, 0xf0, 0x54, 0x1d, 0xeb, 0xc0, 0x46, 0x17, 0xa1, 0x2a, 0x50, 0x38, 0x57, 0x04, 0x00,
+	0xf4, 0xff, 0xa1, 0x48, 0x56, 0xca, 0x58, 0xa2, 0xb1, 0xf6, 0xa2, 0x7c, 0x4f, 0xa1, 0x31, 0x35,
+	0x86, 0x8f, 0x36, 0xa1, 0xe1, 0x7a, 0xba, 0xe3, 0x75, 0x47, 0xb6, 0x4b, 0xcf, 0x99, 0x32, 0x4e,
+	0x6d, 0xed, 0x52, 0x74, 0x04, 0x72, 0x01, 0x6d, 0xbb, 0xfd, 0x1d, 0x8e, 0xa4, 0xcd, 0xd1, 0x4e,
+	0x7e, 0x13, 0x7d, 0x1b, 0xea, 0xd8, 0x32, 0x82, 0x31, 0x0a, 0x59, 0xc6, 0xa8, 0x61, 0xcb, 0x10,
+	0x23, 0x04, 0xa7, 0x52, 0xcc, 0x7e, 0x2a, 0x3f, 0x50, 0xa0, 0x95, 0x3c, 0x96, 0x59, 0x2e, 0x91,
+	0xb7, 0x59, 0x27, 0xcc, 0x8e, 0x65, 0xa2, 0x5c, 0x8b, 0xa3, 0xd1, 0x78, 0x17, 0xf5, 0xb7, 0x15,
+	0x78, 0x21, 0x58, 0x0e, 0xfd, 0xf4, 0xbc, 0x78, 0x04, 0xad, 0x40, 0xd3, 0xb4, 0x7a, 0x83, 0xb1,
+	0x81, 0x1f, 0x5a, 0xef, 0x63, 0x7d, 0xe0, 0x3d, 0x3e, 0xa6, 0x27, 0x57, 0xd1, 0x12, 0x70, 0xf5,
+	0x9f, 0x73, 0xb0, 0x14, 0x5f, 0xd7, 0x2c, 0x44, 0xfa, 0x7f, 0x50, 0x34, 0xad, 0x03, 0xdb, 0xa7,
+	0xd1, 0xe5, 0x09, 0xa2, 0x48, 0xe6, 0x62, 0xc8, 0xc8, 0x06, 0xe4, 0x2b, 0xaf, 0xde, 0x63, 0xdc,
+	0x3b, 0x1c, 0xd9, 0x26, 0x55, 0x53, 0x64, 0x88, 0x6f, 0x4b, 0x86, 0x90, 0xaf, 0x78, 0x95, 0xdf,
+	0x90, 0x1b, 0x62, 0x88, 0xf7, 0x2c, 0xcf, 0x39, 0xd6, 0x16, 0x7a, 0x71, 0x78, 0xbb, 0x07, 0x4b,
+	0x72, 0x64, 0xd4, 0x84, 0xfc, 0x21, 0x3e, 0xa6, 0x5b, 0xae, 0x6a, 0xe4, 0x4f, 0x74, 0x07, 0x8a,
+	0x47, 0xfa, 0x60, 0x8c, 0x5b, 0xb9, 0x2c, 0x9c, 0xcb, 0x70, 0xdf, 0xca, 0xbd, 0xa1, 0xa8, 0x43,
+	0xb8, 0x70, 0x1f, 0x7b, 0x1d, 0xcb, 0xc5, 0x8e, 0xb7, 0x6e, 0x5a, 0x03, 0xbb, 0xbf, 0xa3, 0x7b,
+	0x8f, 0x67, 0x50, 0x0e, 0x11, 0x39, 0xcf, 0xc5, 0xe4, 0x5c, 0xfd, 0xa1, 0x02, 0x17, 0xe5, 0xf3,
+	0xf1, 0x03, 0x6d, 0x43, 0xe5, 0xc0, 0xc4, 0x03, 0xa3, 0xb3, 0xc9, 0x34, 0x65, 0x5e, 0x13, 0x6d,
+	0xa2, 0x24, 0x46, 0x04, 0x99, 0x9f, 0x5b, 0x4c, 0x49, 0x08, 0x7b, 0x74, 0xd7, 0x73, 0x4c, 0xab,
+	0xbf, 0x65, 0xba, 0x9e, 0xc6, 0xf0, 0x43, 0x5c, 0x92, 0xcf, 0x2e, 0x9c, 0xbf, 0xa2, 0xc0, 0xe5,
+	0xfb, 0xd8, 0xdb, 0x10, 0x77, 0x0c, 0xf9, 0x6e, 0xba, 0x9e, 0xd9, 0x73, 0x9f, 0xad, 0x7d, 0x9a,
+	0xc1, 0xd8, 0x50, 0x7f, 0x4d, 0x81, 0x2b, 0xa9, 0x8b, 0xe1, 0xa4, 0xe3, 0x3a, 0xd4, 0xbf, 0x61,
+	0xe4, 0x3a, 0xf4, 0x3b, 0xf8, 0xf8, 0x11, 0x39, 0xfc, 0x1d, 0xdd, 0x74, 0x98, 0x0e, 0x3d, 0xe5,
+	0x8d, 0xf2, 0x23, 0x05, 0x2e, 0xdd, 0xc7, 0xde, 0x8e, 0x7f, 0xbf, 0x7e, 0x8d, 0xd4, 0x21, 0x38,
+	0xa1, 0x7b, 0xde, 0x37, 0x82, 0x23, 0x30, 0xf5, 0x57, 0xd9, 0x71, 0x4a, 0xd7, 0xfb, 0xb5, 0x10,
+	0xf0, 0x32, 0x5c, 0x8c, 0xaa, 0x08, 0x2e, 0xec, 0x9c, 0x7c, 0xea, 0x97, 0x45, 0xa8, 0x3f, 0xe2,
+	0x5a, 0x81, 0x7c, 0x4e, 0x50, 0x42, 0x91, 0x1b, 0x41, 0x21, 0x6b, 0x4a, 0x66, 0x60, 0xad, 0xc3,
+	0x9c, 0x8b, 0xf1, 0xe1, 0x09, 0xef, 0xcb, 0x3a, 0xe9, 0xe3, 0xb7, 0xd0, 0x16, 0x2c, 0x8c, 0x2d,
+	0x6a, 0x95, 0x63, 0x83, 0x6f, 0x80, 0x11, 0x7d, 0xba, 0x32, 0x4d, 0x76, 0x44, 0xef, 0xc3, 0x7c,
+	0x0c, 0xd4, 0x2a, 0x66, 0x1a, 0x2b, 0xde, 0x0d, 0x75, 0xa0, 0x69, 0x38, 0xf6, 0x68, 0x84, 0x8d,
+	0xae, 0xeb, 0x0f, 0x55, 0xca, 0x36, 0x14, 0xef, 0x27, 0x86, 0xba, 0x0d, 0x67, 0xe3, 0x2b, 0xed,
+	0x18, 0xc4, 0x2e, 0x24, 0x9c, 0x25, 0xfb, 0x84, 0x5e, 0x83, 0x85, 0x24, 0x7e, 0x85, 0xe2, 0x27,
+	0x3f, 0xa0, 0x9b, 0x80, 0x62, 0x4b, 0x25, 0xe8, 0x55, 0x86, 0x1e, 0x5d, 0x0c, 0x47, 0xa7, 0x8e,
+	0x73, 0x14, 0x1d, 0x18, 0x3a, 0xff, 0x12, 0x42, 0xef, 0x40, 0x93, 0x03, 0x03, 0x42, 0xd4, 0xb2,
+	0x11, 0x22, 0x3a, 0x98, 0xab, 0xfe, 0xb2, 0x02, 0x4b, 0x1f, 0xe9, 0x5e, 0xef, 0xf1, 0xe6, 0x70,
+	0x76, 0xe7, 0xee, 0x1d, 0xa8, 0x1e, 0x09, 0x17, 0x8e, 0x69, 0xf1, 0x2b, 0x92, 0x05, 0x85, 0xd9,
+	0x5e, 0x0b, 0x7a, 0xa8, 0x7f, 0xa3, 0x70, 0x37, 0xd3, 0x5f, 0xdd, 0x57, 0xaf, 0x6a, 0xa6, 0x79,
+	0xdb, 0x31, 0x01, 0x2c, 0x26, 0x04, 0x50, 0x7d, 0x0a, 0xc0, 0x97, 0xbf, 0xed, 0xf6, 0x4f, 0xb1,
+	0xf2, 0x37, 0xa0, 0xcc, 0xe7, 0xe3, 0xda, 0x66, 0xda, 0x91, 0xfa, 0xe8, 0xea, 0x7f, 0x96, 0xa0,
+	0x16, 0xfa, 0x80, 0x1a, 0x90, 0x13, 0x6a, 0x24, 0x27, 0xd9, 0x7f, 0x6e, 0xba, 0x97, 0x95, 0x4f,
+	0x7a, 0x59, 0xd7, 0xa1, 0x61, 0xd2, 0xeb, 0xbd, 0xcb, 0x77, 0x4d, 0xad, 0xe9, 0xaa, 0x36, 0xc7,
+	0xa0, 0x9c, 0x89, 0xd0, 0x65, 0xa8, 0x59, 0xe3, 0x61, 0xd7, 0x3e, 0xe8, 0x3a, 0xf6, 0x13, 0x97,
+	0xbb, 0x6b, 0x55, 0x6b, 0x3c, 0xfc, 0xe0, 0x40, 0xb3, 0x9f, 0xb8, 0x81, 0x47, 0x50, 0x3a, 0xa1,
+	0x47, 0x70, 0x19, 0x6a, 0x43, 0xfd, 0x29, 0x19, 0xb5, 0x6b, 0x8d, 0x87, 0xd4, 0x93, 0xcb, 0x6b,
+	0xd5, 0xa1, 0xfe, 0x54, 0xb3, 0x9f, 0x3c, 0x18, 0x0f, 0xd1, 0x32, 0x34, 0x07, 0xba, 0xeb, 0x75,
+	0xc3, 0xae, 0x60, 0x85, 0xba, 0x82, 0x0d, 0x02, 0x7f, 0x2f, 0x70, 0x07, 0x93, 0xbe, 0x45, 0xf5,
+	0x74, 0xbe, 0x85, 0x31, 0x1c, 0x04, 0x63, 0x40, 0x26, 0xdf, 0xc2, 0x18, 0x0e, 0xc4, 0x08, 0x6f,
+	0x40, 0x79, 0x9f, 0x9a, 0x4a, 0x93, 0x84, 0xf8, 0x1e, 0xb1, 0x92, 0x98, 0x45, 0xa5, 0xf9, 0xe8,
+	0xe8, 0x9b, 0x50, 0xa5, 0x37, 0x14, 0xed, 0x5b, 0xcf, 0xd4, 0x37, 0xe8, 0x40, 0x7a, 0x1b, 0x78,
+	0xe0, 0xe9, 0xb4, 0xf7, 0x5c, 0xb6, 0xde, 0xa2, 0x03, 0xd1, 0xa0, 0x3d, 0x07, 0xeb, 0x1e, 0x36,
+	0xd6, 0x8f, 0x37, 0xec, 0xe1, 0x48, 0xa7, 0x2c, 0xd4, 0x6a, 0x50, 0x23, 0x5f, 0xf6, 0x09, 0xbd,
+	0x0c, 0x8d, 0x9e, 0x68, 0xdd, 0x73, 0xec, 0x61, 0x6b, 0x9e, 0xca, 0x57, 0x0c, 0x8a, 0x2e, 0x01,
+	0xf8, 0xba, 0x53, 0xf7, 0x5a, 0x4d, 0x7a, 0x76, 0x55, 0x0e, 0xb9, 0x4b, 0xe3, 0x3b, 0xa6, 0xdb,
+	0x65, 0x91, 0x14, 0xd3, 0xea, 0xb7, 0x16, 0xe8, 0x8c, 0x35, 0x3f, 0xf4, 0x62, 0x5a, 0x7d, 0x74,
+	0x0e, 0xca, 0xa6, 0xdb, 0x3d, 0xd0, 0x0f, 0x71, 0x0b, 0xd1, 0xaf, 0x25, 0xd3, 0xbd, 0xa7, 0x1f,
+	0x52, 0xeb, 0x95, 0x4f, 0x86, 0x8d, 0xd6, 0x59, 0xfa, 0x29, 0x00, 0xa0, 0x6f, 0x40, 0x71, 0x80,
+	0x8f, 0xf0, 0xa0, 0xb5, 0x48, 0x79, 0xf2, 0x4a, 0xba, 0xe0, 0x6d, 0x11, 0x34, 0x8d, 0x61, 0xab,
+	0x9f, 0xc3, 0x62, 0xc0, 0xa8, 0x21, 0xce, 0x48, 0xf2, 0x97, 0x72, 0x0a, 0xfe, 0x9a, 0x6c, 0x70,
+	0xff, 0xa4, 0x08, 0x4b, 0xbb, 0xfa, 0x11, 0x7e, 0xfe, 0xb6, 0x7d, 0x26, 0xf5, 0xb9, 0x05, 0x0b,
+	0xd4, 0x9c, 0x5f, 0x0b, 0xad, 0xa7, 0x55, 0xc8, 0xc4, 0x5a, 0xc9, 0x8e, 0xe8, 0x5b, 0x44, 0xd9,
+	0xe2, 0xde, 0xe1, 0x8e, 0x6d, 0x06, 0x56, 0xc3, 0x25, 0xc9, 0x38, 0x1b, 0x02, 0x4b, 0x0b, 0xf7,
+	0x40, 0x3b, 0x30, 0x1f, 0x3d, 0x01, 0xdf, 0x5e, 0xb8, 0x31, 0xd1, 0x6f, 0x0e, 0xa8, 0xaf, 0x35,
+	0x22, 0x87, 0xe1, 0xa2, 0x16, 0x94, 0xf9, 0x65, 0x4f, 0x35, 0x4f, 0x45, 0xf3, 0x9b, 0x68, 0x07,
+	0xce, 0xb2, 0x1d, 0xec, 0x72, 0x01, 0x63, 0x9b, 0xaf, 0x64, 0xda, 0xbc, 0xac, 0x6b, 0x54, 0x3e,
+	0xab, 0x27, 0x95, 0xcf, 0x16, 0x94, 0xb9, 0xcc, 0x50, 0x95, 0x54, 0xd1, 0xfc, 0x26, 0x39, 0xe6,
+	0x40, 0x7a, 0x6a, 0x4c, 0x08, 0x04, 0x80, 0xf4, 0xf3, 0x15, 0x7b, 0x9d, 0x2a, 0x76, 0xbf, 0x49,
+	0xb5, 0x0d, 0xee, 0x77, 0x99, 0x88, 0xcc, 0x65, 0x13, 0x91, 0x8a, 0x8b, 0xfb, 0xf4, 0xaf, 0xf8,
+	0xcd, 0xd2, 0x48, 0xdc, 0x2c, 0xea, 0x2f, 0x2a, 0x00, 0xc1, 0x49, 0x4e, 0x89, 0x28, 0xbd, 0x09,
+	0x15, 0x21, 0x56, 0x99, 0x9c, 0x62, 0x81, 0x1e, 0xbf, 0x9a, 0xf2, 0xb1, 0xab, 0x49, 0xfd, 0x7b,
+	0x05, 0xea, 0x9b, 0x84, 0x8e, 0x5b, 0x76, 0x9f, 0x5e, 0xa4, 0xd7, 0xa1, 0xe1, 0xe0, 0x9e, 0xed,
+	0x18, 0x5d, 0x6c, 0x79, 0x8e, 0x89, 0x59, 0x34, 0xa2, 0xa0, 0xcd, 0x31, 0xe8, 0x7b, 0x0c, 0x48,
+	0xd0, 0xc8, 0x6d, 0xe3, 0x7a, 0xfa, 0x70, 0xd4, 0x3d, 0x20, 0xfa, 0x8d, 0x05, 0xb8, 0xe7, 0x04,
+	0x94, 0xaa, 0xb7, 0x17, 0xa1, 0x1e, 0xa0, 0x79, 0x36, 0x9d, 0xbf, 0xa0, 0xd5, 0x04, 0x6c, 0xcf,
+	0x46, 0xd7, 0xa0, 0x41, 0x0f, 0xb2, 0x3b, 0xb0, 0xfb, 0x5d, 0xe2, 0xe3, 0xf2, 0x3b, 0xb6, 0x6e,
+	0xf0, 0x65, 0x11, 0x06, 0x89, 0x62, 0xb9, 0xe6, 0xe7, 0x98, 0xdf, 0xb2, 0x02, 0x6b, 0xd7, 0xfc,
+	0x1c, 0xab, 0xbf, 0xa0, 0xc0, 0x1c, 0xbf, 0x94, 0x77, 0x45, 0x26, 0x82, 0x86, 0x67, 0x59, 0x7c,
+	0x81, 0xfe, 0x8d, 0xde, 0x8a, 0x06, 0xe8, 0xae, 0x49, 0x85, 0x8c, 0x0e, 0x42, 0x8d, 0xc5, 0xc8,
+	0x8d, 0x9c, 0xc5, 0xc1, 0xfd, 0x82, 0xd0, 0x54, 0xf7, 0xf4, 0x07, 0x24, 0x8e, 0x4d, 0x68, 0xda,
+	0x82, 0xb2, 0x6e, 0x18, 0x0e, 0x76, 0x5d, 0xbe, 0x0e, 0xbf, 0x49, 0xbe, 0x1c, 0x61, 0xc7, 0xf5,
+	0x0f, 0x36, 0xaf, 0xf9, 0x4d, 0xf4, 0xcd, 0x58, 0x82, 0xa0, 0xb6, 0x76, 0x35, 0x7d, 0x9d, 0xdc,
+	0x1d, 0x13, 0x3d, 0xd4, 0x3f, 0xcf, 0x41, 0x83, 0xf3, 0xe6, 0x3a, 0xbf, 0x3f, 0x27, 0xb3, 0xd8,
+	0x3a, 0xd4, 0x0f, 0x02, 0xd9, 0x9a, 0x14, 0x4e, 0x0a, 0x8b, 0x60, 0xa4, 0xcf, 0x34, 0x5e, 0x8b,
+	0xde, 0xe0, 0x85, 0x99, 0x6e, 0xf0, 0xe2, 0x49, 0x35, 0x44, 0xd2, 0x92, 0x2b, 0x49, 0x2c, 0x39,
+	0xf5, 0x67, 0xa0, 0x16, 0x1a, 0x80, 0x6a, 0x40, 0x16, 0xb1, 0xe1, 0x14, 0xf3, 0x9b, 0xe8, 0x4e,
+	0x60, 0xc7, 0x30, 0x52, 0x9d, 0x97, 0xac, 0x25, 0x66, 0xc2, 0xa8, 0xff, 0xa2, 0x40, 0x89, 0x8f,
+	0x4c, 0xe2, 0xf7, 0x4c, 0x94, 0xa8, 0x65, 0xc7, 0x46, 0x07, 0x0e, 0x22, 0xa6, 0xdd, 0xb3, 0x13,
+	0xb0, 0xf3, 0x50, 0x89, 0x89, 0x56, 0x99, 0xab, 0x5d, 0xff, 0x53, 0x48, 0x9e, 0xca, 0x03, 0x26,
+	0x4a, 0x24, 0x79, 0x31, 0xb0, 0xfb, 0x22, 0x9b, 0xc3, 0x1a, 0x2c, 0x6d, 0x85, 0x7b, 0x87, 0x2e,
+	0xb7, 0x46, 0xab, 0x9a, 0x68, 0xab, 0x3f, 0x56, 0x68, 0x60, 0x5e, 0xc3, 0x3d, 0xfb, 0x08, 0x3b,
+	0xc7, 0xb3, 0xc7, 0x36, 0xdf, 0x0e, 0x89, 0x40, 0x46, 0x07, 0x4b, 0x74, 0x40, 0x6f, 0x07, 0x07,
+	0x94, 0x97, 0x85, 0x40, 0xc2, 0xea, 0x9b, 0x33, 0x70, 0x70, 0x50, 0xbf, 0xae, 0xc0, 0x52, 0x62,
+	0x2b, 0xa7, 0xb5, 0x34, 0x9e, 0x89, 0x2b, 0xa2, 0xfe, 0x44, 0x81, 0xf3, 0x29, 0xd4, 0x7d, 0xb4,
+	0xf6, 0x35, 0xd0, 0xf7, 0x2d, 0xa8, 0x08, 0x77, 0x3c, 0x9f, 0xc9, 0x1d, 0x17, 0xf8, 0xea, 0x6f,
+	0xb1, 0x5c, 0x81, 0x84, 0xbc, 0x8f, 0xd6, 0x9e, 0x13, 0x81, 0xe3, 0x61, 0xb5, 0xbc, 0x24, 0xac,
+	0xf6, 0x0f, 0x0a, 0xb4, 0x83, 0x30, 0x96, 0xbb, 0x7e, 0x3c, 0x6b, 0x72, 0xe9, 0xd9, 0x38, 0xa1,
+	0x6f, 0x8a, 0x3c, 0x08, 0xd1, 0x99, 0x99, 0xdc, 0x47, 0xde, 0x41, 0xb5, 0x68, 0x44, 0x3c, 0xb9,
+	0xa1, 0x59, 0xa4, 0xb2, 0x1d, 0x3a, 0x78, 0x96, 0x0b, 0x09, 0x0e, 0xf6, 0xaf, 0x19, 0x93, 0xde,
+	0x8b, 0xc6, 0xb2, 0xbe, 0x6e, 0x02, 0x86, 0xf3, 0x33, 0x8f, 0x79, 0x7e, 0xa6, 0x10, 0xcb, 0xcf,
+	0x70, 0xb8, 0x3a, 0x84, 0xb6, 0x6c, 0x03, 0xcf, 0x8b, 0x60, 0xbf, 0xa4, 0x40, 0x8b, 0xcf, 0x42,
+	0xe7, 0x24, 0x1e, 0xe4, 0x00, 0x7b, 0xd8, 0xf8, 0xaa, 0xe3, 0x29, 0x7f, 0x9c, 0x83, 0x66, 0xd8,
+	0xe8, 0x21, 0x5f, 0x89, 0x8f, 0x48, 0x03, 0x56, 0x7c, 0x05, 0x53, 0xb5, 0x03, 0xc3, 0x26, 0xb7,
+	0x26, 0xf5, 0x24, 0xf6, 0x5c, 0xdf, 0xa8, 0xe1, 0xcd, 0xc0, 0xf2, 0xca, 0x9f, 0xdc, 0xf2, 0xba,
+	0x08, 0x55, 0x72, 0xab, 0xd9, 0x63, 0x32, 0x2e, 0x4b, 0x9a, 0x07, 0x00, 0xf4, 0x0e, 0x94, 0x58,
+	0x99, 0x0e, 0xcf, 0x59, 0x5e, 0x8f, 0x0e, 0xcd, 0xbe, 0xad, 0x86, 0x72, 0x0e, 0x14, 0xa0, 0xf1,
+	0x4e, 0xe4, 0x8c, 0x46, 0x8e, 0xdd, 0xa7, 0x26, 0x1a, 0xb9, 0xf0, 0x8a, 0x9a, 0x68, 0x13, 0x13,
+	0xd2, 0x1e, 0x75, 0x36, 0x79, 0xf4, 0x85, 0xfe, 0xad, 0xfe, 0x14, 0x2c, 0x05, 0xce, 0x3e, 0x5b,
+	0xe6, 0x69, 0x99, 0x5c, 0xfd, 0x0f, 0x05, 0xce, 0xee, 0x1e, 0x5b, 0xbd, 0xb8, 0xb8, 0x2c, 0x41,
+	0x69, 0x34, 0xd0, 0x83, 0xe8, 0x38, 0x6f, 0xd1, 0xca, 0x03, 0xdf, 0x8d, 0x27, 0x57, 0x3e, 0xa3,
+	0x71, 0x4d, 0xc0, 0xf6, 0xec, 0xa9, 0x96, 0xd8, 0x75, 0x11, 0x9d, 0xc0, 0x06, 0x33, 0x2e, 0x58,
+	0xf4, 0x6f, 0x4e, 0x40, 0xa9, 0x71, 0xf1, 0x0e, 0x00, 0xb5, 0xbf, 0xba, 0x27, 0xb1, 0xb9, 0x68,
+	0x8f, 0x2d, 0x6e, 0x71, 0xea, 0xfb, 0xba, 0x65, 0xd8, 0x16, 0x36, 0x28, 0x55, 0x2b, 0x5a, 0x00,
+	0x50, 0x7f, 0x90, 0x87, 0x56, 0x88, 0x86, 0x5f, 0xb5, 0xb1, 0x9a, 0xe2, 0xc2, 0xe6, 0x9f, 0x91,
+	0x0b, 0x5b, 0x98, 0xdd, 0x40, 0x2d, 0xca, 0x42, 0x8d, 0x22, 0x6c, 0x53, 0x3a, 0x49, 0xd8, 0x26,
+	0xae, 0x24, 0xcb, 0x49, 0xfb, 0xe2, 0x7b, 0x79, 0x68, 0x04, 0xc7, 0xb1, 0x33, 0xd0, 0xad, 0x54,
+	0x06, 0xdc, 0x85, 0x86, 0x1b, 0x39, 0x2e, 0x7e, 0x00, 0xaf, 0xca, 0xc4, 0x39, 0xe5, 0x84, 0xb5,
+	0xd8, 0x10, 0x24, 0x10, 0xc6, 0xc2, 0x17, 0x34, 0x88, 0xc9, 0xcc, 0xd8, 0x2a, 0xd3, 0x1b, 0x24,
+	0x7e, 0xf9, 0x1a, 0x20, 0x2e, 0xec, 0x5d, 0xd3, 0xea, 0xba, 0xb8, 0x67, 0x5b, 0x06, 0x53, 0x03,
+	0x45, 0xad, 0xc9, 0xbf, 0x74, 0xac, 0x5d, 0x06, 0x47, 0xdf, 0x80, 0x82, 0x77, 0x3c, 0x62, 0x36,
+	0x6d, 0x63, 0xed, 0xc5, 0x89, 0xeb, 0xda, 0x3b, 0x1e, 0x61, 0x8d, 0xa2, 0xfb, 0x45, 0x63, 0x9e,
+	0xa3, 0xfb, 0x14, 0x2e, 0x68, 0x21, 0x48, 0x38, 0x5c, 0x50, 0x8e, 0x86, 0x0b, 0xa8, 0x40, 0xf9,
+	0xba, 0xa5, 0xeb, 0x79, 0x03, 0x1a, 0x86, 0xa5, 0x02, 0xe5, 0x43, 0xf7, 0xbc, 0x01, 0xd9, 0xa4,
+	0x67, 0x7b, 0xfa, 0x80, 0x89, 0x65, 0x95, 0x2b, 0x31, 0x02, 0xa1, 0xce, 0xf8, 0x9f, 0xe4, 0xa1,
+	0x19, 0x2c, 0x4c, 0xc3, 0xee, 0x78, 0x90, 0xae, 0x06, 0x26, 0x07, 0xb0, 0xa6, 0x69, 0x80, 0x6f,
+	0x41, 0x8d, 0xb3, 0xdb, 0x09, 0xd8, 0x15, 0x58, 0x97, 0xad, 0x09, 0xf2, 0x53, 0x7c, 0x46, 0xf2,
+	0x53, 0x3a, 0x45, 0x08, 0x28, 0xe5, 0x6c, 0x3e, 0x81, 0xb3, 0x54, 0x08, 0xba, 0x9f, 0x63, 0xc7,
+	0x0e, 0x72, 0x48, 0x95, 0x93, 0xf3, 0xec, 0x02, 0x1d, 0xe7, 0x63, 0xec, 0xd8, 0x22, 0xa5, 0xf4,
+	0x43, 0x05, 0x5e, 0x48, 0xdc, 0x04, 0x13, 0xcf, 0x6d, 0x72, 0xf4, 0x81, 0xdf, 0x10, 0xf1, 0x21,
+	0xf9, 0x1d, 0xf8, 0x36, 0x94, 0x1c, 0x3a, 0x3a, 0xcf, 0x74, 0xbe, 0x34, 0x71, 0xf5, 0x6c, 0x21,
+	0x1a, 0xef, 0xa2, 0xfe, 0x86, 0x02, 0xe7, 0x92, 0x4b, 0x9d, 0xc1, 0xb0, 0x59, 0x87, 0x32, 0x1b,
+	0xda, 0x57, 0x00, 0xcb, 0x93, 0x89, 0x19, 0x10, 0x47, 0xf3, 0x3b, 0xaa, 0xbb, 0xb0, 0xe4, 0xdb,
+	0x3f, 0xc1, 0xb9, 0x6e, 0x63, 0x4f, 0x9f, 0xe0, 0x7b, 0x5f, 0x81, 0x1a, 0x73, 0xd4, 0x98, 0x4f,
+	0xcb, 0x12, 0xc3, 0xb0, 0x2f, 0x82, 0xa9, 0xea, 0x6f, 0xe6, 0x60, 0x91, 0x1a, 0x10, 0xf1, 0x2c,
+	0x5f, 0x96, 0xb4, 0xb3, 0x0a, 0xf5, 0x50, 0x8a, 0x8b, 0x6d, 0xad, 0xaa, 0x45, 0x60, 0xa8, 0x93,
+	0x8c, 0xb5, 0x4a, 0x63, 0x34, 0x41, 0x9e, 0x9d, 0xc4, 0x83, 0x68, 0x9a, 0x3d, 0x1e, 0x64, 0x0d,
+	0x0c, 0x97, 0xc2, 0x69, 0x0c, 0x97, 0x57, 0xa0, 0xc9, 0xd2, 0x0f, 0x5d, 0xe1, 0xf2, 0x53, 0xad,
+	0x57, 0xd0, 0xe6, 0x19, 0x7c, 0xcf, 0x07, 0xab, 0x5b, 0xf0, 0x42, 0x8c, 0x28, 0x33, 0x1c, 0xbe,
+	0xfa, 0x07, 0x0a, 0x39, 0xb9, 0x48, 0xbd, 0xd7, 0xe9, 0xed, 0xfc, 0x4b, 0x22, 0x13, 0xd9, 0x35,
+	0x8d, 0xb8, 0x32, 0x33, 0xd0, 0xbb, 0x50, 0xb5, 0xf0, 0x93, 0x6e, 0xd8, 0x74, 0xcc, 0xe0, 0x04,
+	0x55, 0x2c, 0xfc, 0x84, 0xfe, 0xa5, 0x3e, 0x80, 0x73, 0x89, 0xa5, 0xce, 0xb2, 0xf7, 0xbf, 0x54,
+	0xe0, 0xfc, 0xa6, 0x63, 0x8f, 0x1e, 0x99, 0x8e, 0x37, 0xd6, 0x07, 0xd1, 0x62, 0x87, 0x53, 0x6c,
+	0x3f, 0x43, 0x2d, 0xe9, 0xfb, 0x09, 0x77, 0xfb, 0x35, 0x89, 0xb0, 0x25, 0x17, 0xc5, 0x37, 0x1d,
+	0x72, 0x39, 0xfe, 0x35, 0x0f, 0xe7, 0x53, 0xf1, 0xa6, 0x18, 0x5e, 0x59, 0xfc, 0x31, 0x69, 0x5a,
+	0x24, 0x7f, 0xda, 0xb4, 0x48, 0xca, 0x35, 0x53, 0x78, 0x46, 0xd7, 0xcc, 0x89, 0xe3, 0x88, 0x1b,
+	0x10, 0x4d, 0x59, 0xb5, 0x4a, 0x59, 0xe2, 0xf1, 0xd1, 0x3e, 0xc4, 0xae, 0x0e, 0x32, 0x37, 0xad,
+	0x72, 0x96, 0x11, 0x42, 0x1d, 0xc8, 0x19, 0x89, 0x8b, 0x9c, 0xdb, 0x19, 0x01, 0x40, 0xfd, 0x10,
+	0xda, 0x32, 0xde, 0x9c, 0x85, 0xdf, 0xff, 0x29, 0x07, 0xd0, 0x11, 0xd5, 0xdc, 0xa7, 0xbb, 0x2c,
+	0x5e, 0x82, 0x90, 0x2d, 0x14, 0x48, 0x79, 0x98, 0x77, 0x0c, 0x22, 0x08, 0xc2, 0x26, 0x25, 0x38,
+	0x09, 0x67, 0xde, 0xa0, 0xe3, 0x84, 0x64, 0x85, 0xb1, 0x42, 0x5c, 0x3f, 0x5f, 0x80, 0x2a, 0xc9,
+	0x99, 0x13, 0xe1, 0x32, 0xfc, 0x72, 0x75, 0xc7, 0x7e, 0x42, 0x44, 0xce, 0x20, 0x09, 0x53, 0x4f,
+	0x77, 0x0f, 0xc9, 0xf8, 0x2c, 0xb6, 0x59, 0x22, 0xcd, 0x8e, 0x41, 0x42, 0x9e, 0x07, 0xe6, 0x00,
+	0xb3, 0xca, 0x98, 0xaa, 0xc6, 0x1a, 0x24, 0x79, 0xcf, 0x2a, 0x2c, 0x2b, 0x99, 0x2b, 0xa9, 0x28,
+	0x3e, 0x59, 0x29, 0xe1, 0x24, 0xb2, 0x08, 0x26, 0xd6, 0x4d, 0x9e, 0xd7, 0xe0, 0x40, 0x5a, 0x41,
+	0xf1, 0x63, 0x05, 0xe6, 0x03, 0xd2, 0x52, 0xdd, 0x44, 0xd4, 0x1d, 0x55, 0x75, 0x1b, 0xb6, 0xc1,
+	0xb4, 0x48, 0x23, 0xe5, 0x5e, 0x61, 0x1d, 0x99, 0x42, 0x0b, 0xba, 0x4c, 0x0a, 0x38, 0x90, 0xcd,
+	0x13, 0xca, 0x98, 0x86, 0x1f, 0x02, 0x2b, 0x39, 0xf6, 0x93, 0x8e, 0x21, 0x48, 0xc6, 0x0a, 0xd6,
+	0x99, 0x7b, 0x4d, 0x48, 0xb6, 0x41, 0xda, 0x64, 0x2b, 0xd8, 0x71, 0x6c, 0xa7, 0x3b, 0xc4, 0xae,
+	0xab, 0xf7, 0xfd, 0x5a, 0x90, 0x3a, 0x05, 0x6e, 0x33, 0x98, 0xfa, 0x57, 0x05, 0x68, 0x04, 0x5b,
+	0xf1, 0xab, 0x32, 0x4c, 0xc3, 0xaf, 0xca, 0x30, 0xc9, 0xf9, 0x82, 0xc3, 0xb4, 0xa4, 0xe0, 0x80,
+	0xf5, 0x5c, 0x4b, 0xd1, 0xaa, 0x1c, 0xda, 0x31, 0xc8, 0xe5, 0x4e, 0x08, 0x64, 0xd9, 0x06, 0x0e,
+	0x38, 0x00, 0x7c, 0x10, 0x67, 0x80, 0x08, 0x23, 0x15, 0x32, 0x30, 0x52, 0x31, 0x03, 0x23, 0x95,
+	0x24, 0x8c, 0xb4, 0x04, 0xa5, 0xfd, 0x71, 0xef, 0x10, 0x7b, 0xdc, 0xa8, 0xe4, 0xad, 0x28, 0x83,
+	0x55, 0x62, 0x0c, 0x26, 0xf8, 0xa8, 0x1a, 0xe6, 0xa3, 0x0b, 0x50, 0xf5, 0x6f, 0x6a, 0x97, 0x66,
+	0x29, 0xf3, 0x5a, 0x85, 0x5f, 0xd1, 0x2e, 0x7a, 0xc3, 0x37, 0x0a, 0x6b, 0x54, 0xa2, 0x54, 0x89,
+	0x42, 0x8a, 0x71, 0x89, 0x6f, 0x12, 0xde, 0x80, 0xf9, 0x10, 0x39, 0x28, 0x9f, 0xb1, 0x54, 0x66,
+	0xc8, 0x21, 0xa1, 0x37, 0xc8, 0x75, 0x68, 0x04, 0x24, 0xa1, 0x78, 0x73, 0xcc, 0xc1, 0x14, 0x50,
+	0x8a, 0x26, 0xd8, 0xbd, 0x71, 0x42, 0x76, 0x3f, 0x0f, 0x15, 0xee, 0xc0, 0xb9, 0xad, 0xf9, 0x68,
+	0xd8, 0x27, 0x93, 0x24, 0x7c, 0x0a, 0x28, 0xd8, 0xe2, 0x6c, 0x86, 0x69, 0x8c, 0x87, 0x72, 0x71,
+	0x1e, 0x52, 0xff, 0x50, 0x81, 0x85, 0xf0, 0x64, 0xa7, 0xbd, 0xb8, 0xdf, 0x85, 0x1a, 0x4b, 0x26,
+	0x77, 0x89, 0x0a, 0x91, 0xe7, 0x66, 0x63, 0x87, 0xa7, 0x41, 0xf0, 0x2e, 0x86, 0x10, 0xe6, 0x89,
+	0xed, 0x1c, 0x9a, 0x56, 0xbf, 0x4b, 0x56, 0x26, 0xc2, 0xd2, 0x1c, 0x48, 0x12, 0x88, 0xae, 0xfa,
+	0x7d, 0x05, 0x2e, 0x3f, 0x1c, 0x19, 0xba, 0x87, 0x43, 0x16, 0xcc, 0xac, 0xe5, 0xa9, 0xa2, 0x3e,
+	0x34, 0x37, 0xe1, 0x98, 0x43, 0xf3, 0xb9, 0x8c, 0xdf, 0xa8, 0xdd, 0xc7, 0x57, 0x93, 0x28, 0xe8,
+	0x3e, 0xfd, 0x6a, 0xda, 0x50, 0x39, 0xe2, 0xc3, 0xf9, 0x2f, 0x7d, 0xfc, 0x76, 0x24, 0xf9, 0x9d,
+	0x3f, 0x51, 0xf2, 0x5b, 0xdd, 0x86, 0xf3, 0x1a, 0x76, 0xb1, 0x65, 0x44, 0x36, 0x72, 0xea, 0x40,
+	0xdd, 0x08, 0xda, 0xb2, 0xe1, 0x66, 0xe1, 0x54, 0x66, 0xf8, 0x76, 0x1d, 0xec, 0xb2, 0x98, 0x6d,
+	0x9e, 0xdb, 0x5b, 0x74, 0x1e, 0x4f, 0xfd, 0xa3, 0x1c, 0x9c, 0xbb, 0x6b, 0x18, 0x5c, 0xcf, 0x73,
+	0x53, 0xee, 0x79, 0x59, 0xd9, 0x71, 0x2b, 0x34, 0x9f, 0xb4, 0x42, 0x9f, 0x95, 0xee, 0xe5, 0xb7,
+	0x10, 0xc9, 0x7c, 0xf2, 0x2b, 0xd8, 0x61, 0x05, 0x6d, 0x6f, 0xf3, 0x14, 0x31, 0x89, 0x4a, 0xb4,
+	0xca, 0x99, 0x8c, 0xb3, 0x8a, 0x1f, 0x70, 0x54, 0x47, 0xd0, 0x4a, 0x12, 0x6b, 0x46, 0x3d, 0xe2,
+	0x53, 0x64, 0x64, 0xb3, 0x60, 0x76, 0x5d, 0x03, 0x0e, 0xda, 0xb1, 0x5d, 0xf5, 0xdf, 0x73, 0xd0,
+	0x22, 0x15, 0x49, 0xff, 0x77, 0x0e, 0xe8, 0x63, 0x58, 0x74, 0xf5, 0x23, 0xdc, 0x0d, 0x39, 0xe0,
+	0x5d, 0x07, 0x7f, 0xc6, 0x8d, 0xd8, 0x57, 0x64, 0xe1, 0x48, 0x69, 0xc5, 0x96, 0xb6, 0xe0, 0x46,
+	0xe0, 0x1a, 0xfe, 0x0c, 0xbd, 0x0c, 0xf3, 0xe1, 0xea, 0xc2, 0xae, 0xc9, 0xae, 0xd6, 0xba, 0x36,
+	0x17, 0xaa, 0x20, 0xec, 0x18, 0xea, 0x67, 0x70, 0xf1, 0xa1, 0xe5, 0x62, 0xaf, 0x13, 0x54, 0xc1,
+	0xcd, 0xe8, 0x7f, 0x5e, 0x81, 0x5a, 0x40, 0xf8, 0xc4, 0x13, 0x1f, 0xc3, 0x55, 0x6d, 0x68, 0x6f,
+	0xeb, 0xce, 0x21, 0x3f, 0x61, 0x77, 0x93, 0x55, 0x17, 0x3d, 0xc7, 0x09, 0x0f, 0x44, 0x9d, 0x9d,
+	0x86, 0x0f, 0xb0, 0x83, 0xad, 0x1e, 0xde, 0xb2, 0x7b, 0x87, 0xc4, 0x20, 0xf1, 0xd8, 0x2b, 0x4b,
+	0x25, 0x64, 0xbb, 0x6e, 0x86, 0x1e, 0x51, 0xe6, 0x22, 0x8f, 0x28, 0xa7, 0x3c, 0x18, 0x56, 0x7f,
+	0x94, 0x83, 0xa5, 0xbb, 0x03, 0x0f, 0x3b, 0x41, 0x84, 0xe1, 0x24, 0xc1, 0x92, 0x20, 0x7a, 0x91,
+	0x3b, 0x4d, 0xf4, 0x22, 0x43, 0x56, 0x56, 0x16, 0x6b, 0x29, 0x9c, 0x32, 0xd6, 0x72, 0x17, 0x60,
+	0xe4, 0xd8, 0x23, 0xec, 0x78, 0x26, 0xf6, 0x7d, 0xbf, 0x0c, 0x06, 0x4e, 0xa8, 0x93, 0xfa, 0x31,
+	0x34, 0xef, 0xf7, 0x36, 0x6c, 0xeb, 0xc0, 0x74, 0x86, 0x3e, 0xa1, 0x12, 0x42, 0xa7, 0x64, 0x10,
+	0xba, 0x5c, 0x42, 0xe8, 0x54, 0x13, 0x16, 0x42, 0x63, 0xcf, 0xa8, 0xb8, 0xfa, 0xbd, 0xee, 0x81,
+	0x69, 0x99, 0xb4, 0x7a, 0x2f, 0x47, 0x0d, 0x54, 0xe8, 0xf7, 0xee, 0x71, 0x88, 0xfa, 0xa5, 0x02,
+	0x17, 0x34, 0x4c, 0x84, 0xc7, 0x2f, 0x54, 0xda, 0x23, 0x25, 0xdc, 0x33, 0x18, 0x14, 0x77, 0xa0,
+	0x30, 0x74, 0xfb, 0x29, 0x85, 0x04, 0xe4, 0x8a, 0x8e, 0x4c, 0xa4, 0x51, 0x64, 0xf5, 0x2f, 0x14,
+	0x58, 0xf4, 0xd3, 0xad, 0x11, 0x11, 0x8e, 0xb2, 0xad, 0x92, 0x28, 0x4d, 0x9f, 0xf0, 0xb2, 0xfa,
+	0x1c, 0x94, 0x8d, 0xfd, 0xb0, 0x82, 0x2c, 0x19, 0xfb, 0x54, 0x37, 0x4a, 0x2c, 0xe5, 0x82, 0xd4,
+	0x52, 0x8e, 0x33, 0x7e, 0x51, 0x52, 0xe3, 0xf5, 0x10, 0x5a, 0xdc, 0x40, 0xf9, 0x60, 0x84, 0x1d,
+	0x9d, 0x40, 0x05, 0xf1, 0xde, 0xf4, 0x4d, 0x68, 0x25, 0xf5, 0xdd, 0x62, 0x3c, 0xd5, 0xca, 0x8d,
+	0x68, 0xf5, 0x6f, 0x15, 0xb8, 0x1a, 0x1f, 0x77, 0x87, 0x27, 0x22, 0x67, 0x7e, 0x92, 0x4f, 0xb3,
+	0x98, 0xb9, 0x20, 0x8b, 0x39, 0x53, 0x3a, 0x36, 0x9c, 0x31, 0x2d, 0x44, 0x33, 0xa6, 0xea, 0x31,
+	0x5c, 0xd8, 0xd0, 0xad, 0x1e, 0x1e, 0x44, 0x13, 0x4a, 0xa7, 0x67, 0xae, 0x20, 0x96, 0x9e, 0x0b,
+	0xc7, 0xd2, 0xdf, 0x6a, 0xfe, 0xdd, 0xbb, 0x73, 0xad, 0xff, 0xf6, 0xff, 0x29, 0x15, 0x45, 0xfd,
+	0xaf, 0x1c, 0x2c, 0xed, 0x7a, 0xb6, 0xa3, 0xf7, 0xf1, 0xb6, 0xd9, 0x67, 0x04, 0xdc, 0xd3, 0x9d,
+	0x3e, 0xa6, 0xb5, 0xd4, 0x2e, 0xfb, 0xd2, 0xa5, 0xc9, 0x21, 0x56, 0x8a, 0x57, 0xe3, 0x30, 0x92,
+	0x06, 0x0a, 0x17, 0xea, 0xe5, 0xa2, 0x85, 0x7a, 0x24, 0xe6, 0x4c, 0x5d, 0xbf, 0x30, 0x67, 0x01,
+	0x03, 0x51, 0xa6, 0xa1, 0x1e, 0xa1, 0xed, 0x85, 0xcb, 0xac, 0x2a, 0x04, 0x40, 0xeb, 0xac, 0x5e,
+	0x84, 0xba, 0xde, 0xeb, 0x61, 0xd7, 0xed, 0x1e, 0xe2, 0x63, 0xce, 0x51, 0x55, 0xad, 0xc6, 0x60,
+	0xdf, 0x21, 0x20, 0xb4, 0x42, 0x1e, 0xb4, 0xf7, 0x1c, 0xec, 0x75, 0x03, 0x4c, 0x5e, 0xa3, 0x36,
+	0xcf, 0x3e, 0xdc, 0xf5, 0xb1, 0x09, 0x8b, 0x8f, 0x5d, 0xdc, 0x75, 0xdd, 0x01, 0x2f, 0xcc, 0x2d,
+	0x8d, 0x5d, 0xbc, 0xeb, 0x0e, 0xfc, 0x0f, 0xa6, 0x3e, 0x6c, 0x55, 0xc4, 0x87, 0x8e, 0x4e, 0xab,
+	0xc9, 0x7a, 0x03, 0x7b, 0x6c, 0x74, 0x47, 0x8e, 0x7d, 0x64, 0x1a, 0xd8, 0xa1, 0xc9, 0xa7, 0xaa,
+	0x36, 0x47, 0xa1, 0x3b, 0x1c, 0x48, 0xd6, 0x69, 0xea, 0xc3, 0x2e, 0xb6, 0x0c, 0xf6, 0x22, 0x14,
+	0xd8, 0x3a, 0x4d, 0x7d, 0xf8, 0x1e, 0x07, 0x91, 0xa3, 0x70, 0x70, 0x9f, 0x18, 0xe3, 0x35, 0x26,
+	0x5d, 0xac, 0xa5, 0xfe, 0x5b, 0x0e, 0x2e, 0x33, 0x96, 0xc5, 0x71, 0xfa, 0x9f, 0xfe, 0xdc, 0x43,
+	0xb2, 0x9c, 0x9b, 0x26, 0xcb, 0xf9, 0x4c, 0xb2, 0x2c, 0x7b, 0x6d, 0xff, 0x21, 0xd4, 0x6d, 0xb6,
+	0xf2, 0x6e, 0x28, 0x6b, 0xb8, 0x2a, 0xb3, 0x65, 0x62, 0x3b, 0xe3, 0x1b, 0xa6, 0x29, 0xc4, 0x9a,
+	0x1d, 0x34, 0xd0, 0x5d, 0x72, 0x4d, 0x13, 0xae, 0x6b, 0x95, 0xd2, 0x0d, 0x23, 0x29, 0x9b, 0x6a,
+	0xbc, 0x23, 0xe5, 0xed, 0x8a, 0x12, 0xe2, 0xee, 0x95, 0x77, 0xc5, 0x93, 0x17, 0x3a, 0x47, 0x19,
+	0xf2, 0x0f, 0xf0, 0x93, 0xe6, 0x19, 0x04, 0x50, 0x7a, 0x60, 0x3b, 0x43, 0x7d, 0xd0, 0x54, 0x50,
+	0x0d, 0xca, 0xbc, 0x78, 0xa5, 0x99, 0x43, 0x73, 0x50, 0xdd, 0xf0, 0x13, 0xfa, 0xcd, 0xfc, 0xca,
+	0x0a, 0xd4, 0xc3, 0xb9, 0x61, 0xd2, 0x6f, 0x0b, 0xf7, 0xf5, 0xde, 0x71, 0xf3, 0x0c, 0x2a, 0x41,
+	0x6e, 0xeb, 0x76, 0x53, 0xa1, 0xff, 0xbf, 0xde, 0xcc, 0xad, 0xfc, 0x8e, 0x02, 0x0b, 0x09, 0xd9,
+	0x47, 0x0d, 0x80, 0x87, 0x56, 0x8f, 0xd7, 0xa8, 0x34, 0xcf, 0xa0, 0x3a, 0x54, 0xfc, 0x8a, 0x15,
+	0x36, 0xf7, 0x9e, 0x4d, 0xb1, 0x9b, 0x39, 0xd4, 0x84, 0x3a, 0xeb, 0x38, 0xa6, 0x6c, 0xdb, 0xcc,
+	0x0b, 0xc8, 0x3d, 0xdd, 0x1c, 0x8c, 0x1d, 0xdc, 0x2c, 0x90, 0xf5, 0xed, 0xd9, 0x1a, 0x1e, 0x60,
+	0xdd, 0xc5, 0xcd, 0x22, 0x42, 0xd0, 0xe0, 0x0d, 0xbf, 0x53, 0x29, 0x04, 0xf3, 0xbb, 0x95, 0x57,
+	0xfe, 0x54, 0x09, 0xa7, 0xaa, 0x29, 0x2d, 0xce, 0xc1, 0xd9, 0x87, 0x96, 0x81, 0x0f, 0x4c, 0x0b,
+	0x1b, 0xc1, 0xa7, 0xe6, 0x19, 0x74, 0x16, 0xe6, 0xb7, 0xb1, 0xd3, 0xc7, 0x21, 0x60, 0x0e, 0x2d,
+	0xc0, 0xdc, 0xb6, 0xf9, 0x34, 0x04, 0xca, 0xa3, 0x45, 0x68, 0xee, 0x9a, 0x56, 0x7f, 0x10, 0x46,
+	0x2c, 0xd0, 0xde, 0xa6, 0x65, 0x3b, 0x21, 0x60, 0x91, 0x02, 0xf5, 0x4f, 0x23, 0xc0, 0x12, 0x6a,
+	0xc3, 0x12, 0x25, 0xea, 0xed, 0x4d, 0x4c, 0xa8, 0x11, 0xfa, 0x56, 0x56, 0x0b, 0x15, 0xa5, 0xa9,
+	0xac, 0xdc, 0x83, 0x0b, 0x13, 0xd8, 0x87, 0x6c, 0x94, 0x56, 0xe3, 0x8b, 0x8f, 0x6c, 0xf1, 0x4c,
+	0x8f, 0x06, 0x40, 0x65, 0xed, 0xfb, 0x37, 0xa0, 0x4a, 0xee, 0xd2, 0x0d, 0xdb, 0x76, 0x0c, 0x34,
+	0x00, 0x44, 0xdf, 0xd2, 0x0e, 0x47, 0xb6, 0x25, 0xde, 0xdd, 0xa3, 0x18, 0xef, 0xf2, 0x46, 0x12,
+	0x91, 0x4b, 0x66, 0xfb, 0x9a, 0x14, 0x3f, 0x86, 0xac, 0x9e, 0x41, 0x43, 0x3a, 0x1b, 0x49, 0x29,
+	0xed, 0x99, 0xbd, 0x43, 0xdf, 0x43, 0xbf, 0x9d, 0xf2, 0x78, 0x39, 0x89, 0xea, 0xcf, 0xf7, 0x92,
+	0x74, 0x3e, 0xf6, 0xd8, 0xd9, 0xbf, 0xe6, 0xd4, 0x33, 0xe8, 0x33, 0x6a, 0x1d, 0x04, 0xe1, 0x0e,
+	0x7f, 0xc2, 0xb5, 0xf4, 0x09, 0x13, 0xc8, 0x27, 0x9c, 0x72, 0x0b, 0x8a, 0x54, 0x7e, 0x90, 0xac,
+	0xb2, 0x22, 0xfc, 0x83, 0x3e, 0xed, 0xab, 0xe9, 0x08, 0x62, 0xb4, 0x4f, 0x61, 0x3e, 0xf6, 0x73,
+	0x1a, 0x48, 0xa6, 0x09, 0xe4, 0x3f, 0x8c, 0xd2, 0x5e, 0xc9, 0x82, 0x2a, 0xe6, 0xea, 0x43, 0x23,
+	0xfa, 0x06, 0x17, 0x2d, 0x67, 0x78, 0xc9, 0xcf, 0x66, 0x7a, 0x25, 0xf3, 0x9b, 0x7f, 0xca, 0x04,
+	0xcd, 0xf8, 0x0f, 0x3d, 0xa0, 0x95, 0x89, 0x03, 0x44, 0x99, 0xed, 0xd5, 0x4c, 0xb8, 0x62, 0xba,
+	0x63, 0x58, 0x94, 0xbd, 0xb2, 0x47, 0xab, 0xf2, 0x61, 0xd2, 0x9e, 0xff, 0xb7, 0x6f, 0x65, 0xc6,
+	0x17, 0x53, 0xff, 0x3c, 0xab, 0x68, 0x96, 0xbd, 0x54, 0x47, 0xaf, 0xcb, 0x87, 0x9b, 0xf0, 0xc4,
+	0xbe, 0xbd, 0x76, 0x92, 0x2e, 0x62, 0x11, 0xdf, 0x85, 0x25, 0xf9, 0x5b, 0x6f, 0x74, 0x5b, 0x3e,
+	0x5e, 0xfa, 0x33, 0xf6, 0xf6, 0xeb, 0x27, 0xe8, 0x21, 0x16, 0x60, 0xc7, 0x7f, 0x49, 0xc3, 0x17,
+	0xc3, 0x5b, 0x53, 0xb9, 0xe6, 0x74, 0x32, 0xf8, 0x09, 0xcc, 0xc7, 0x82, 0x06, 0x28, 0x7b, 0x60,
+	0xa1, 0x3d, 0xc9, 0x1a, 0x66, 0x22, 0x19, 0x2b, 0x3d, 0x46, 0x29, 0xdc, 0x2f, 0x29, 0x4f, 0x6e,
+	0xaf, 0x64, 0x41, 0x15, 0x1b, 0x19, 0xc1, 0x42, 0xec, 0xe3, 0xa3, 0x35, 0xf4, 0x6a, 0xe6, 0xd9,
+	0x1e, 0xad, 0xb5, 0x5f, 0xcb, 0x3e, 0xdf, 0xa3, 0x35, 0xf5, 0x0c, 0x72, 0xa9, 0x82, 0x8e, 0x95,
+	0xaf, 0xa2, 0x94, 0x51, 0xe4, 0x65, 0xba, 0xed, 0x9b, 0x19, 0xb1, 0xc5, 0x36, 0x8f, 0xe0, 0xac,
+	0xa4, 0xca, 0x18, 0xdd, 0x9c, 0xc8, 0x1e, 0xf1, 0xf2, 0xea, 0xf6, 0x6a, 0x56, 0xf4, 0xd0, 0xf5,
+	0xd0, 0xf4, 0xd7, 0x75, 0x77, 0x40, 0xdf, 0xc0, 0xe0, 0xf8, 0x56, 0x83, 0x9b, 0x2f, 0x82, 0x96,
+	0xb2, 0xd5, 0x54, 0x6c, 0x31, 0xe5, 0xcf, 0x02, 0xda, 0x7d, 0x4c, 0xb2, 0x62, 0xd6, 0x81, 0xd9,
+	0x1f, 0x73, 0xbf, 0x2f, 0xf5, 0x02, 0x4c, 0xa2, 0xa6, 0x08, 0xe2, 0xc4, 0x1e, 0x62, 0xf2, 0x2e,
+	0xc0, 0x7d, 0xec, 0x6d, 0x63, 0xcf, 0x21, 0xd2, 0xff, 0x72, 0xda, 0xda, 0x39, 0x82, 0x3f, 0xd5,
+	0x8d, 0xa9, 0x78, 0x61, 0x82, 0x6e, 0xeb, 0x16, 0xc9, 0x1a, 0x07, 0x0f, 0x59, 0xe5, 0x04, 0x8d,
+	0xa3, 0x4d, 0x26, 0x68, 0x12, 0x5b, 0x4c, 0xf9, 0x44, 0xd8, 0x2f, 0xa1, 0x22, 0xa1, 0xc9, 0xf6,
+	0x4b, 0xb2, 0xe8, 0xb6, 0x7d, 0x2b, 0x33, 0xbe, 0x98, 0xf8, 0x0b, 0x05, 0x2e, 0x24, 0x11, 0x3e,
+	0x32, 0xbd, 0xc7, 0xc4, 0x55, 0x75, 0xb3, 0x2c, 0x81, 0x22, 0x9e, 0x60, 0x09, 0x1c, 0x5f, 0x2c,
+	0xc1, 0x80, 0xb9, 0x48, 0x41, 0x0e, 0x92, 0xbd, 0xd4, 0x94, 0xd5, 0x31, 0xb5, 0x97, 0xa7, 0x23,
+	0x8a, 0x59, 0x0e, 0x60, 0x2e, 0x12, 0x62, 0x91, 0xce, 0x22, 0x0b, 0xc2, 0xc4, 0x95, 0x5d, 0x4c,
+	0x3a, 0xe2, 0x04, 0x75, 0x01, 0x25, 0xeb, 0x0e, 0x50, 0xb6, 0x2a, 0x95, 0x49, 0xaa, 0x27, 0xbd,
+	0x98, 0x81, 0x69, 0xf3, 0x58, 0x65, 0x8f, 0xfc, 0xaa, 0x90, 0x16, 0x2a, 0xb5, 0x57, 0xb2, 0xa0,
+	0x8a, 0xb9, 0x3e, 0x82, 0x12, 0xff, 0xc1, 0xbb, 0x6b, 0x93, 0x33, 0x7c, 0x7c, 0xf4, 0xeb, 0x53,
+	0xb0, 0xc4, 0xc0, 0x87, 0x70, 0x2e, 0x25, 0xbf, 0x27, 0xb5, 0x32, 0x26, 0xe7, 0x02, 0xa7, 0xdd,
+	0x7f, 0x62, 0xb2, 0x44, 0xfa, 0x6e, 0xc2, 0x64, 0x69, 0xa9, 0xbe, 0x69, 0x93, 0x75, 0x61, 0x21,
+	0x91, 0x1e, 0x91, 0x5e, 0x80, 0x69, 0x49, 0x94, 0x69, 0x13, 0xf4, 0xe1, 0x05, 0x69, 0x2a, 0x40,
+	0x6a, 0x9b, 0x4c, 0x4a, 0x1a, 0x4c, 0x9b, 0xa8, 0x07, 0x67, 0x25, 0x09, 0x00, 0xe9, 0x1d, 0x97,
+	0x9e, 0x28, 0x98, 0x36, 0xc9, 0x01, 0xb4, 0xd7, 0x1d, 0x5b, 0x37, 0x7a, 0xba, 0xeb, 0xd1, 0xa0,
+	0x3c, 0x36, 0x02, 0xe3, 0x50, 0xee, 0x39, 0x48, 0x43, 0xf7, 0xd3, 0xe6, 0xd9, 0x87, 0x1a, 0x3d,
+	0x4a, 0xf6, 0xa3, 0x64, 0x48, 0x7e, 0x43, 0x84, 0x30, 0x52, 0xd4, 0x8e, 0x0c, 0x51, 0x30, 0xf5,
+	0x1e, 0xd4, 0x36, 0x68, 0x75, 0x43, 0x87, 0xfc, 0x08, 0x4b, 0xfc, 0xb6, 0xa2, 0xbf, 0xcc, 0xb2,
+	0x1a, 0x42, 0xc8, 0x4c, 0xa1, 0x39, 0x6a, 0xb3, 0x1b, 0xf8, 0x29, 0x3b, 0xe7, 0x65, 0xd9, 0xb8,
+	0x11, 0x94, 0x14, 0x1f, 0x47, 0x8a, 0x19, 0xba, 0xe7, 0x17, 0xc3, 0x96, 0xac, 0x98, 0xee, 0x56,
+	0xca, 0x20, 0x09, 0x4c, 0x7f, 0xd6, 0xdb, 0xd9, 0x3b, 0x84, 0xef, 0x05, 0x7f, 0x5d, 0x1d, 0x5a,
+	0x5a, 0x71, 0x63, 0xd2, 0xd2, 0xc3, 0xe6, 0xe9, 0xf2, 0x74, 0x44, 0x31, 0xcb, 0x0e, 0x54, 0x09,
+	0x77, 0xb2, 0xe3, 0xb9, 0x26, 0xeb, 0x28, 0x3e, 0x67, 0x3f, 0x9c, 0x4d, 0xec, 0xf6, 0x1c, 0x73,
+	0x9f, 0x1f, 0xba, 0x74, 0x39, 0x11, 0x94, 0x89, 0x87, 0x13, 0xc3, 0x14, 0x2b, 0x1f, 0x53, 0x9b,
+	0x41, 0x90, 0x8e, 0xab, 0xca, 0x9b, 0xd3, 0xce, 0x37, 0xaa, 0x26, 0x57, 0xb3, 0xa2, 0x8b, 0x69,
+	0x7f, 0x0e, 0x5e, 0xf0, 0xbf, 0xaf, 0x8f, 0xcd, 0x81, 0xe1, 0xc7, 0xe5, 0xd1, 0xed, 0x49, 0x43,
+	0x45, 0x50, 0x53, 0xcd, 0xbf, 0x09, 0x3d, 0xc4, 0xfc, 0x3f, 0x0d, 0x55, 0x91, 0x1e, 0x42, 0xb2,
+	0xa4, 0x42, 0x3c, 0x31, 0xd5, 0xbe, 0x36, 0x19, 0x49, 0x8c, 0x8c, 0x61, 0x51, 0x96, 0x0c, 0x92,
+	0xba, 0xd8, 0x13, 0xb2, 0x46, 0xd3, 0xf8, 0x03, 0xc3, 0xa2, 0x2c, 0x2d, 0x20, 0x9d, 0x66, 0x42,
+	0xfe, 0x20, 0xc3, 0x0d, 0x97, 0x12, 0x88, 0x96, 0xde, 0x70, 0x93, 0x83, 0xd6, 0x53, 0x26, 0x5b,
+	0xfb, 0xb2, 0x0e, 0x15, 0x9f, 0x18, 0x5f, 0x71, 0x30, 0xee, 0x6b, 0x88, 0x8e, 0x7d, 0x02, 0xf3,
+	0xb1, 0xdf, 0xcf, 0x92, 0xde, 0x4a, 0xf2, 0xdf, 0xd8, 0x9a, 0x76, 0x6e, 0x1f, 0xf1, 0x9f, 0x9e,
+	0x16, 0x6e, 0xeb, 0x8d, 0xb4, 0x08, 0x5b, 0xdc, 0x63, 0x9d, 0x32, 0xf0, 0xff, 0x6e, 0xa7, 0xed,
+	0x01, 0x40, 0xc8, 0x5d, 0x9b, 0xfc, 0xd6, 0x89, 0x48, 0xd1, 0x34, 0x6a, 0x0d, 0xa5, 0x1e, 0xd9,
+	0x2b, 0x59, 0x9e, 0x76, 0xa4, 0x5b, 0xd5, 0xe9, 0x7e, 0xd8, 0x43, 0xa8, 0x87, 0x1f, 0x3f, 0x22,
+	0xe9, 0x8f, 0x09, 0x27, 0x5f, 0x47, 0x4e, 0xdb, 0xc5, 0xf6, 0x09, 0x8d, 0xf5, 0x29, 0xc3, 0xb9,
+	0x80, 0x92, 0xa5, 0x5f, 0x52, 0xe7, 0x26, 0xb5, 0xe0, 0xac, 0x7d, 0x33, 0x23, 0x76, 0x38, 0xd0,
+	0x1a, 0xaf, 0x67, 0x92, 0x06, 0x5a, 0x53, 0x2a, 0xc4, 0xda, 0xaf, 0x66, 0xc2, 0x0d, 0xf9, 0x37,
+	0x73, 0x91, 0xdf, 0x3d, 0x4f, 0x97, 0xbf, 0x13, 0x0a, 0xb6, 0x01, 0x4b, 0x0f, 0x6c, 0xcf, 0x3c,
+	0x38, 0x8e, 0x67, 0xb6, 0xa5, 0xae, 0x40, 0x5a, 0x5a, 0x7d, 0xba, 0x94, 0x5f, 0xa2, 0x96, 0x68,
+	0x5a, 0xfa, 0x1c, 0x65, 0xc9, 0xc3, 0xb7, 0xef, 0x64, 0x58, 0x51, 0xf2, 0x6e, 0x5e, 0xbf, 0xf3,
+	0xf1, 0xeb, 0x7d, 0xd3, 0x7b, 0x3c, 0xde, 0x27, 0xcb, 0xba, 0xc5, 0x86, 0xb8, 0x69, 0xda, 0xfc,
+	0xaf, 0x5b, 0xbe, 0xaa, 0xb8, 0x45, 0x47, 0xbd, 0x45, 0x46, 0x1d, 0xed, 0xef, 0x97, 0x68, 0xeb,
+	0xce, 0xff, 0x0c, 0x00, 0xe1, 0x6a, 0xc0, 0x53, 0xb2, 0x60, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -5763,6 +6118,8 @@ type DataCoordClient interface {
 	GetIndexBuildProgress(ctx context.Context, in *indexpb.GetIndexBuildProgressRequest, opts ...grpc.CallOption) (*indexpb.GetIndexBuildProgressResponse, error)
 	GcConfirm(ctx context.Context, in *GcConfirmRequest, opts ...grpc.CallOption) (*GcConfirmResponse, error)
 	ReportDataNodeTtMsgs(ctx context.Context, in *ReportDataNodeTtMsgsRequest, opts ...grpc.CallOption) (*commonpb.Status, error)
+	CancelCompactionPlan(ctx context.Context, in *CancelCompactionPlanRequest, opts ...grpc.CallOption) (*commonpb.Status, error)
+	OperateStorageMigration(ctx context.Context, in *OperateStorageMigrationRequest, opts ...grpc.CallOption) (*commonpb.Status, error)
 }
 
 type dataCoordClient struct {
@@ -6169,6 +6526,24 @@ func (c *dataCoordClient) ReportDataNodeTtMsgs(ctx context.Context, in *ReportDa
 	return out, nil
 }
 
+func (c *dataCoordClient) CancelCompactionPlan(ctx context.Context, in *CancelCompactionPlanRequest, opts ...grpc.CallOption) (*commonpb.Status, error) {
+	out := new(commonpb.Status)
+	err := c.cc.Invoke(ctx, "/milvus.proto.data.DataCoord/CancelCompactionPlan", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *dataCoordClient) OperateStorageMigration(ctx context.Context, in *OperateStorageMigrationRequest, opts ...grpc.CallOption) (*commonpb.Status, error) {
+	out := new(commonpb.Status)
+	err := c.cc.Invoke(ctx, "/milvus.proto.data.DataCoord/OperateStorageMigration", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // DataCoordServer is the server API for DataCoord service.
 type DataCoordServer interface {
 	GetComponentStates(context.Context, *milvuspb.GetComponentStatesRequest) (*milvuspb.ComponentStates, error)
@@ -6219,6 +6594,8 @@ type DataCoordServer interface {
 	GetIndexBuildProgress(context.Context, *indexpb.GetIndexBuildProgressRequest) (*indexpb.GetIndexBuildProgressResponse, error)
 	GcConfirm(context.Context, *GcConfirmRequest) (*GcConfirmResponse, error)
 	ReportDataNodeTtMsgs(context.Context, *ReportDataNodeTtMsgsRequest) (*commonpb.Status, error)
+	CancelCompactionPlan(context.Context, *CancelCompactionPlanRequest) (*commonpb.Status, error)
+	OperateStorageMigration(context.Context, *OperateStorageMigrationRequest) (*commonpb.Status, error)
 }
 
 // UnimplementedDataCoordServer can be embedded to have forward compatible implementations.
@@ -6357,6 +6734,12 @@ func (*UnimplementedDataCoordServer) GcConfirm(ctx context.Context, req *GcConfi
 func (*UnimplementedDataCoordServer) ReportDataNodeTtMsgs(ctx context.Context, req *ReportDataNodeTtMsgsRequest) (*commonpb.Status, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ReportDataNodeTtMsgs not implemented")
 }
+func (*UnimplementedDataCoordServer) CancelCompactionPlan(ctx context.Context, req *CancelCompactionPlanRequest) (*commonpb.Status, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CancelCompactionPlan not implemented")
+}
+func (*UnimplementedDataCoordServer) OperateStorageMigration(ctx context.Context, req *OperateStorageMigrationRequest) (*commonpb.Status, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method OperateStorageMigration not implemented")
+}
 
 func RegisterDataCoordServer(s *grpc.Server, srv DataCoordServer) {
 	s.RegisterService(&_DataCoord_serviceDesc, srv)
@@ -7154,6 +7537,42 @@ func _DataCoord_ReportDataNodeTtMsgs_Handler(srv interface{}, ctx context.Contex
 	return interceptor(ctx, in, info, handler)
 }
 
+func _DataCoord_CancelCompactionPlan_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CancelCompactionPlanRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DataCoordServer).CancelCompactionPlan(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/milvus.proto.data.DataCoord/CancelCompactionPlan",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DataCoordServer).CancelCompactionPlan(ctx, req.(*CancelCompactionPlanRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DataCoord_OperateStorageMigration_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(OperateStorageMigrationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DataCoordServer).OperateStorageMigration(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/milvus.proto.data.DataCoord/OperateStorageMigration",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DataCoordServer).OperateStorageMigration(ctx, req.(*OperateStorageMigrationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _DataCoord_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "milvus.proto.data.DataCoord",
 	HandlerType: (*DataCoordServer)(nil),
@@ -7334,6 +7753,14 @@ var _DataCoord_serviceDesc = grpc.ServiceDesc{
 			MethodName: "ReportDataNodeTtMsgs",
 			Handler:    _DataCoord_ReportDataNodeTtMsgs_Handler,
 		},
+		{
+			MethodName: "CancelCompactionPlan",
+			Handler:    _DataCoord_CancelCompactionPlan_Handler,
+		},
+		{
+			MethodName: "OperateStorageMigration",
+			Handler:    _DataCoord_OperateStorageMigration_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "data_coord.proto",
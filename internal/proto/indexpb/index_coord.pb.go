@@ -44,9 +44,12 @@ type IndexInfo struct {
 	IsAutoIndex          bool                     `protobuf:"varint,11,opt,name=is_auto_index,json=isAutoIndex,proto3" json:"is_auto_index,omitempty"`
 	UserIndexParams      []*commonpb.KeyValuePair `protobuf:"bytes,12,rep,name=user_index_params,json=userIndexParams,proto3" json:"user_index_params,omitempty"`
 	PendingIndexRows     int64                    `protobuf:"varint,13,opt,name=pending_index_rows,json=pendingIndexRows,proto3" json:"pending_index_rows,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}                 `json:"-"`
-	XXX_unrecognized     []byte                   `json:"-"`
-	XXX_sizecache        int32                    `json:"-"`
+	// the percentage of the rows indexed, counting the estimated progress of the building tasks
+	Progress                  float32  `protobuf:"fixed32,14,opt,name=progress,proto3" json:"progress,omitempty"`
+	EstimatedRemainingSeconds int64    `protobuf:"varint,15,opt,name=estimated_remaining_seconds,json=estimatedRemainingSeconds,proto3" json:"estimated_remaining_seconds,omitempty"`
+	XXX_NoUnkeyedLiteral      struct{} `json:"-"`
+	XXX_unrecognized          []byte   `json:"-"`
+	XXX_sizecache             int32    `json:"-"`
 }
 
 func (m *IndexInfo) Reset()         { *m = IndexInfo{} }
@@ -165,6 +168,20 @@ func (m *IndexInfo) GetPendingIndexRows() int64 {
 	return 0
 }
 
+func (m *IndexInfo) GetProgress() float32 {
+	if m != nil {
+		return m.Progress
+	}
+	return 0
+}
+
+func (m *IndexInfo) GetEstimatedRemainingSeconds() int64 {
+	if m != nil {
+		return m.EstimatedRemainingSeconds
+	}
+	return 0
+}
+
 type FieldIndex struct {
 	IndexInfo            *IndexInfo `protobuf:"bytes,1,opt,name=index_info,json=indexInfo,proto3" json:"index_info,omitempty"`
 	Deleted              bool       `protobuf:"varint,2,opt,name=deleted,proto3" json:"deleted,omitempty"`
@@ -1332,13 +1349,15 @@ func (m *GetIndexBuildProgressRequest) GetIndexName() string {
 }
 
 type GetIndexBuildProgressResponse struct {
-	Status               *commonpb.Status `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
-	IndexedRows          int64            `protobuf:"varint,2,opt,name=indexed_rows,json=indexedRows,proto3" json:"indexed_rows,omitempty"`
-	TotalRows            int64            `protobuf:"varint,3,opt,name=total_rows,json=totalRows,proto3" json:"total_rows,omitempty"`
-	PendingIndexRows     int64            `protobuf:"varint,4,opt,name=pending_index_rows,json=pendingIndexRows,proto3" json:"pending_index_rows,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}         `json:"-"`
-	XXX_unrecognized     []byte           `json:"-"`
-	XXX_sizecache        int32            `json:"-"`
+	Status                    *commonpb.Status `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
+	IndexedRows               int64            `protobuf:"varint,2,opt,name=indexed_rows,json=indexedRows,proto3" json:"indexed_rows,omitempty"`
+	TotalRows                 int64            `protobuf:"varint,3,opt,name=total_rows,json=totalRows,proto3" json:"total_rows,omitempty"`
+	PendingIndexRows          int64            `protobuf:"varint,4,opt,name=pending_index_rows,json=pendingIndexRows,proto3" json:"pending_index_rows,omitempty"`
+	Progress                  float32          `protobuf:"fixed32,5,opt,name=progress,proto3" json:"progress,omitempty"`
+	EstimatedRemainingSeconds int64            `protobuf:"varint,6,opt,name=estimated_remaining_seconds,json=estimatedRemainingSeconds,proto3" json:"estimated_remaining_seconds,omitempty"`
+	XXX_NoUnkeyedLiteral      struct{}         `json:"-"`
+	XXX_unrecognized          []byte           `json:"-"`
+	XXX_sizecache             int32            `json:"-"`
 }
 
 func (m *GetIndexBuildProgressResponse) Reset()         { *m = GetIndexBuildProgressResponse{} }
@@ -1394,6 +1413,20 @@ func (m *GetIndexBuildProgressResponse) GetPendingIndexRows() int64 {
 	return 0
 }
 
+func (m *GetIndexBuildProgressResponse) GetProgress() float32 {
+	if m != nil {
+		return m.Progress
+	}
+	return 0
+}
+
+func (m *GetIndexBuildProgressResponse) GetEstimatedRemainingSeconds() int64 {
+	if m != nil {
+		return m.EstimatedRemainingSeconds
+	}
+	return 0
+}
+
 type StorageConfig struct {
 	Address              string   `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
 	AccessKeyID          string   `protobuf:"bytes,2,opt,name=access_keyID,json=accessKeyID,proto3" json:"access_keyID,omitempty"`
@@ -1704,15 +1737,19 @@ func (m *QueryJobsRequest) GetBuildIDs() []int64 {
 }
 
 type IndexTaskInfo struct {
-	BuildID              int64               `protobuf:"varint,1,opt,name=buildID,proto3" json:"buildID,omitempty"`
-	State                commonpb.IndexState `protobuf:"varint,2,opt,name=state,proto3,enum=milvus.proto.common.IndexState" json:"state,omitempty"`
-	IndexFileKeys        []string            `protobuf:"bytes,3,rep,name=index_file_keys,json=indexFileKeys,proto3" json:"index_file_keys,omitempty"`
-	SerializedSize       uint64              `protobuf:"varint,4,opt,name=serialized_size,json=serializedSize,proto3" json:"serialized_size,omitempty"`
-	FailReason           string              `protobuf:"bytes,5,opt,name=fail_reason,json=failReason,proto3" json:"fail_reason,omitempty"`
-	CurrentIndexVersion  int32               `protobuf:"varint,6,opt,name=current_index_version,json=currentIndexVersion,proto3" json:"current_index_version,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}            `json:"-"`
-	XXX_unrecognized     []byte              `json:"-"`
-	XXX_sizecache        int32               `json:"-"`
+	BuildID                   int64               `protobuf:"varint,1,opt,name=buildID,proto3" json:"buildID,omitempty"`
+	State                     commonpb.IndexState `protobuf:"varint,2,opt,name=state,proto3,enum=milvus.proto.common.IndexState" json:"state,omitempty"`
+	IndexFileKeys             []string            `protobuf:"bytes,3,rep,name=index_file_keys,json=indexFileKeys,proto3" json:"index_file_keys,omitempty"`
+	SerializedSize            uint64              `protobuf:"varint,4,opt,name=serialized_size,json=serializedSize,proto3" json:"serialized_size,omitempty"`
+	FailReason                string              `protobuf:"bytes,5,opt,name=fail_reason,json=failReason,proto3" json:"fail_reason,omitempty"`
+	CurrentIndexVersion       int32               `protobuf:"varint,6,opt,name=current_index_version,json=currentIndexVersion,proto3" json:"current_index_version,omitempty"`
+	Phase                     string              `protobuf:"bytes,7,opt,name=phase,proto3" json:"phase,omitempty"`
+	ProcessedRows             int64               `protobuf:"varint,8,opt,name=processed_rows,json=processedRows,proto3" json:"processed_rows,omitempty"`
+	TotalRows                 int64               `protobuf:"varint,9,opt,name=total_rows,json=totalRows,proto3" json:"total_rows,omitempty"`
+	EstimatedRemainingSeconds int64               `protobuf:"varint,10,opt,name=estimated_remaining_seconds,json=estimatedRemainingSeconds,proto3" json:"estimated_remaining_seconds,omitempty"`
+	XXX_NoUnkeyedLiteral      struct{}            `json:"-"`
+	XXX_unrecognized          []byte              `json:"-"`
+	XXX_sizecache             int32               `json:"-"`
 }
 
 func (m *IndexTaskInfo) Reset()         { *m = IndexTaskInfo{} }
@@ -1782,6 +1819,34 @@ func (m *IndexTaskInfo) GetCurrentIndexVersion() int32 {
 	return 0
 }
 
+func (m *IndexTaskInfo) GetPhase() string {
+	if m != nil {
+		return m.Phase
+	}
+	return ""
+}
+
+func (m *IndexTaskInfo) GetProcessedRows() int64 {
+	if m != nil {
+		return m.ProcessedRows
+	}
+	return 0
+}
+
+func (m *IndexTaskInfo) GetTotalRows() int64 {
+	if m != nil {
+		return m.TotalRows
+	}
+	return 0
+}
+
+func (m *IndexTaskInfo) GetEstimatedRemainingSeconds() int64 {
+	if m != nil {
+		return m.EstimatedRemainingSeconds
+	}
+	return 0
+}
+
 type QueryJobsResponse struct {
 	Status               *commonpb.Status `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
 	ClusterID            string           `protobuf:"bytes,2,opt,name=clusterID,proto3" json:"clusterID,omitempty"`
@@ -2213,156 +2278,161 @@ func init() {
 func init() { proto.RegisterFile("index_coord.proto", fileDescriptor_f9e019eb3fda53c2) }
 
 var fileDescriptor_f9e019eb3fda53c2 = []byte{
-	// 2373 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe4, 0x5a, 0x5f, 0x6f, 0x1b, 0x59,
-	0x15, 0xef, 0xc4, 0x4e, 0xe2, 0x39, 0x63, 0xe7, 0xcf, 0x6d, 0x0a, 0xae, 0xdb, 0xd2, 0x74, 0xba,
-	0x6d, 0x0d, 0xa2, 0x69, 0xc9, 0xb2, 0x68, 0x41, 0x80, 0x94, 0x26, 0xdb, 0xd6, 0xed, 0xa6, 0x0a,
-	0x93, 0xaa, 0x12, 0x2b, 0xc4, 0x30, 0xf6, 0x5c, 0x27, 0x77, 0x33, 0x9e, 0xeb, 0xce, 0xbd, 0xd3,
-	0x36, 0x45, 0x42, 0xf0, 0x00, 0x12, 0x68, 0x25, 0x04, 0x42, 0xf0, 0x05, 0x78, 0x5a, 0x3e, 0x01,
-	0xbc, 0x20, 0x21, 0x1e, 0xf9, 0x0a, 0x7c, 0x17, 0x74, 0xff, 0xcc, 0x78, 0x66, 0x3c, 0x8e, 0xdd,
-	0x24, 0x08, 0x89, 0x7d, 0xf3, 0x3d, 0xf7, 0xdc, 0x3f, 0x73, 0xce, 0xef, 0x9c, 0xdf, 0x39, 0x57,
-	0x86, 0x55, 0x12, 0xfa, 0xf8, 0x8d, 0xdb, 0xa3, 0x34, 0xf2, 0x37, 0x86, 0x11, 0xe5, 0x14, 0xa1,
-	0x01, 0x09, 0x5e, 0xc5, 0x4c, 0x8d, 0x36, 0xe4, 0x7c, 0xab, 0xde, 0xa3, 0x83, 0x01, 0x0d, 0x95,
-	0xac, 0xb5, 0x44, 0x42, 0x8e, 0xa3, 0xd0, 0x0b, 0xf4, 0xb8, 0x9e, 0x5d, 0x61, 0xff, 0xbb, 0x0a,
-	0x66, 0x47, 0xac, 0xea, 0x84, 0x7d, 0x8a, 0x6c, 0xa8, 0xf7, 0x68, 0x10, 0xe0, 0x1e, 0x27, 0x34,
-	0xec, 0xec, 0x34, 0x8d, 0x75, 0xa3, 0x5d, 0x71, 0x72, 0x32, 0xd4, 0x84, 0xc5, 0x3e, 0xc1, 0x81,
-	0xdf, 0xd9, 0x69, 0xce, 0xc9, 0xe9, 0x64, 0x88, 0xae, 0x01, 0xa8, 0x0b, 0x86, 0xde, 0x00, 0x37,
-	0x2b, 0xeb, 0x46, 0xdb, 0x74, 0x4c, 0x29, 0x79, 0xe6, 0x0d, 0xb0, 0x58, 0x28, 0x07, 0x9d, 0x9d,
-	0x66, 0x55, 0x2d, 0xd4, 0x43, 0xf4, 0x00, 0x2c, 0x7e, 0x3c, 0xc4, 0xee, 0xd0, 0x8b, 0xbc, 0x01,
-	0x6b, 0xce, 0xaf, 0x57, 0xda, 0xd6, 0xe6, 0x8d, 0x8d, 0xdc, 0xa7, 0xe9, 0x6f, 0x7a, 0x8a, 0x8f,
-	0x5f, 0x78, 0x41, 0x8c, 0xf7, 0x3c, 0x12, 0x39, 0x20, 0x56, 0xed, 0xc9, 0x45, 0x68, 0x07, 0xea,
-	0xea, 0x70, 0xbd, 0xc9, 0xc2, 0xac, 0x9b, 0x58, 0x72, 0x99, 0xde, 0xe5, 0x86, 0xde, 0x05, 0xfb,
-	0x6e, 0x44, 0x5f, 0xb3, 0xe6, 0xa2, 0xbc, 0xa8, 0xa5, 0x65, 0x0e, 0x7d, 0xcd, 0xc4, 0x57, 0x72,
-	0xca, 0xbd, 0x40, 0x29, 0xd4, 0xa4, 0x82, 0x29, 0x25, 0x72, 0xfa, 0x03, 0x98, 0x67, 0xdc, 0xe3,
-	0xb8, 0x69, 0xae, 0x1b, 0xed, 0xa5, 0xcd, 0xeb, 0xa5, 0x17, 0x90, 0x16, 0xdf, 0x17, 0x6a, 0x8e,
-	0xd2, 0x46, 0x1f, 0xc0, 0x97, 0xd5, 0xf5, 0xe5, 0xd0, 0xed, 0x7b, 0x24, 0x70, 0x23, 0xec, 0x31,
-	0x1a, 0x36, 0x41, 0x1a, 0x72, 0x8d, 0xa4, 0x6b, 0x1e, 0x7a, 0x24, 0x70, 0xe4, 0x1c, 0xb2, 0xa1,
-	0x41, 0x98, 0xeb, 0xc5, 0x9c, 0xba, 0x72, 0xbe, 0x69, 0xad, 0x1b, 0xed, 0x9a, 0x63, 0x11, 0xb6,
-	0x15, 0x73, 0x2a, 0x8f, 0x41, 0xbb, 0xb0, 0x1a, 0x33, 0x1c, 0xb9, 0x39, 0xf3, 0xd4, 0x67, 0x35,
-	0xcf, 0xb2, 0x58, 0xdb, 0xc9, 0x98, 0xe8, 0xeb, 0x80, 0x86, 0x38, 0xf4, 0x49, 0x78, 0xa0, 0x77,
-	0x94, 0x76, 0x68, 0x48, 0x3b, 0xac, 0xe8, 0x19, 0xa9, 0x2f, 0xcc, 0x61, 0xff, 0xd2, 0x00, 0x78,
-	0x28, 0xf1, 0x21, 0xef, 0xf2, 0xdd, 0x04, 0x22, 0x24, 0xec, 0x53, 0x09, 0x2f, 0x6b, 0xf3, 0xda,
-	0xc6, 0x38, 0x86, 0x37, 0x52, 0x4c, 0x6a, 0x04, 0x49, 0x78, 0x36, 0x61, 0xd1, 0xc7, 0x01, 0xe6,
-	0xd8, 0x97, 0xd0, 0xab, 0x39, 0xc9, 0x10, 0x5d, 0x07, 0xab, 0x17, 0x61, 0x61, 0x39, 0x4e, 0x34,
-	0xf6, 0xaa, 0x0e, 0x28, 0xd1, 0x73, 0x32, 0xc0, 0xf6, 0x5f, 0xab, 0x50, 0xdf, 0xc7, 0x07, 0x03,
-	0x1c, 0x72, 0x75, 0x93, 0x59, 0xa0, 0xbe, 0x0e, 0xd6, 0xd0, 0x8b, 0x38, 0xd1, 0x2a, 0x0a, 0xee,
-	0x59, 0x11, 0xba, 0x0a, 0x26, 0xd3, 0xbb, 0xee, 0xc8, 0x53, 0x2b, 0xce, 0x48, 0x80, 0x2e, 0x43,
-	0x2d, 0x8c, 0x07, 0xca, 0x40, 0x1a, 0xf2, 0x61, 0x3c, 0x90, 0x30, 0xc9, 0x04, 0xc3, 0x7c, 0x3e,
-	0x18, 0x9a, 0xb0, 0xd8, 0x8d, 0x89, 0x8c, 0xaf, 0x05, 0x35, 0xa3, 0x87, 0xe8, 0x4b, 0xb0, 0x10,
-	0x52, 0x1f, 0x77, 0x76, 0x34, 0x2c, 0xf5, 0x08, 0xdd, 0x84, 0x86, 0x32, 0xea, 0x2b, 0x1c, 0x31,
-	0x42, 0x43, 0x0d, 0x4a, 0x85, 0xe4, 0x17, 0x4a, 0x76, 0x5a, 0x5c, 0x5e, 0x07, 0x6b, 0x1c, 0x8b,
-	0xd0, 0x1f, 0x21, 0xf0, 0x36, 0x2c, 0xab, 0xc3, 0xfb, 0x24, 0xc0, 0xee, 0x11, 0x3e, 0x66, 0x4d,
-	0x6b, 0xbd, 0xd2, 0x36, 0x1d, 0x75, 0xa7, 0x87, 0x24, 0xc0, 0x4f, 0xf1, 0x31, 0xcb, 0xfa, 0xae,
-	0x7e, 0xa2, 0xef, 0x1a, 0x45, 0xdf, 0xa1, 0x5b, 0xb0, 0xc4, 0x70, 0x44, 0xbc, 0x80, 0xbc, 0xc5,
-	0x2e, 0x23, 0x6f, 0x71, 0x73, 0x49, 0xea, 0x34, 0x52, 0xe9, 0x3e, 0x79, 0x8b, 0x85, 0x19, 0x5e,
-	0x47, 0x84, 0x63, 0xf7, 0xd0, 0x0b, 0x7d, 0xda, 0xef, 0x37, 0x97, 0xe5, 0x39, 0x75, 0x29, 0x7c,
-	0xac, 0x64, 0x68, 0x13, 0x2e, 0xf5, 0xe2, 0x28, 0xc2, 0x21, 0x77, 0xf3, 0x36, 0x5b, 0x59, 0x37,
-	0xda, 0xf3, 0xce, 0x45, 0x3d, 0xd9, 0xc9, 0x98, 0xce, 0xfe, 0x93, 0x01, 0x17, 0x1d, 0x7c, 0x40,
-	0x18, 0xc7, 0xd1, 0x33, 0xea, 0x63, 0x07, 0xbf, 0x8c, 0x31, 0xe3, 0xe8, 0x3e, 0x54, 0xbb, 0x1e,
-	0xc3, 0x1a, 0xc6, 0x57, 0x4b, 0x2d, 0xba, 0xcb, 0x0e, 0x1e, 0x78, 0x0c, 0x3b, 0x52, 0x13, 0x7d,
-	0x0b, 0x16, 0x3d, 0xdf, 0x8f, 0x30, 0x63, 0x12, 0x4c, 0x93, 0x16, 0x6d, 0x29, 0x1d, 0x27, 0x51,
-	0xce, 0x78, 0xbe, 0x92, 0xf5, 0xbc, 0xfd, 0x5b, 0x03, 0xd6, 0xf2, 0x37, 0x63, 0x43, 0x1a, 0x32,
-	0x8c, 0xde, 0x87, 0x05, 0xe1, 0xbf, 0x98, 0xe9, 0xcb, 0x5d, 0x29, 0x3d, 0x67, 0x5f, 0xaa, 0x38,
-	0x5a, 0x55, 0xa4, 0x61, 0x12, 0x12, 0x9e, 0xa4, 0x08, 0x75, 0xc3, 0x1b, 0xc5, 0xe8, 0xd4, 0x64,
-	0xd2, 0x09, 0x09, 0x57, 0x19, 0xc1, 0x01, 0x92, 0xfe, 0xb6, 0x7f, 0x08, 0x6b, 0x8f, 0x30, 0xcf,
-	0xe0, 0x48, 0xdb, 0x6a, 0x96, 0x70, 0xcb, 0xf3, 0xc7, 0x5c, 0x81, 0x3f, 0xec, 0x3f, 0x1b, 0x70,
-	0xa9, 0xb0, 0xf7, 0x59, 0xbe, 0x36, 0x0d, 0x88, 0xb9, 0xb3, 0x04, 0x44, 0xa5, 0x18, 0x10, 0xf6,
-	0xcf, 0x0d, 0xb8, 0xf2, 0x08, 0xf3, 0x6c, 0xb2, 0x39, 0x67, 0x4b, 0xa0, 0xaf, 0x00, 0xa4, 0x49,
-	0x86, 0x35, 0x2b, 0xeb, 0x95, 0x76, 0xc5, 0xc9, 0x48, 0xec, 0x5f, 0x1b, 0xb0, 0x3a, 0x76, 0x7e,
-	0x3e, 0x57, 0x19, 0xc5, 0x5c, 0xf5, 0xdf, 0x32, 0xc7, 0xef, 0x0d, 0xb8, 0x5a, 0x6e, 0x8e, 0xb3,
-	0x38, 0xef, 0x7b, 0x6a, 0x11, 0x16, 0x28, 0x15, 0x44, 0x76, 0xab, 0x8c, 0x43, 0xc6, 0xcf, 0xd4,
-	0x8b, 0xec, 0xcf, 0x2a, 0x80, 0xb6, 0x65, 0x82, 0x51, 0x4c, 0xf5, 0x0e, 0xae, 0x39, 0x75, 0xf9,
-	0x53, 0x28, 0x72, 0xaa, 0xe7, 0x51, 0xe4, 0xcc, 0x9f, 0xaa, 0xc8, 0xb9, 0x0a, 0xa6, 0xc8, 0xb4,
-	0x8c, 0x7b, 0x83, 0xa1, 0xe4, 0x98, 0xaa, 0x33, 0x12, 0x8c, 0x97, 0x14, 0x8b, 0x33, 0x96, 0x14,
-	0xb5, 0xd3, 0x96, 0x14, 0xf6, 0x1b, 0xb8, 0x98, 0x04, 0xb6, 0xa4, 0xfc, 0x77, 0x70, 0x47, 0x3e,
-	0x14, 0xe6, 0x8a, 0xa1, 0x30, 0xc5, 0x29, 0xf6, 0x5f, 0x2a, 0xb0, 0xda, 0x49, 0x78, 0x6a, 0xcf,
-	0xe3, 0x87, 0xb2, 0xce, 0x38, 0x39, 0x52, 0x26, 0x23, 0x20, 0x43, 0xea, 0x95, 0x89, 0xa4, 0x5e,
-	0xcd, 0x93, 0x7a, 0xfe, 0x82, 0xf3, 0x45, 0xd4, 0x9c, 0x4f, 0x59, 0xdb, 0x86, 0x95, 0x0c, 0x49,
-	0x0f, 0x3d, 0x7e, 0x28, 0x4a, 0x5b, 0xc1, 0xd2, 0x4b, 0x24, 0xfb, 0xf5, 0x0c, 0xdd, 0x81, 0xe5,
-	0x94, 0x55, 0x7d, 0x45, 0xb6, 0x35, 0x89, 0x90, 0x11, 0x05, 0xfb, 0x09, 0xdb, 0xe6, 0x09, 0xd4,
-	0x2c, 0x29, 0x3a, 0xb2, 0x05, 0x10, 0xe4, 0x0b, 0xa0, 0x89, 0x44, 0x6c, 0x4d, 0x26, 0xe2, 0xbf,
-	0x19, 0x60, 0xa5, 0x41, 0x3d, 0x63, 0xbb, 0x92, 0xf3, 0xe5, 0x5c, 0xd1, 0x97, 0x37, 0xa0, 0x8e,
-	0x43, 0xaf, 0x1b, 0x60, 0x8d, 0xf5, 0x8a, 0xc2, 0xba, 0x92, 0x29, 0xac, 0x3f, 0x04, 0x6b, 0x54,
-	0xb2, 0x26, 0x71, 0x7b, 0x6b, 0x62, 0xcd, 0x9a, 0x05, 0x92, 0x03, 0x69, 0xed, 0xca, 0xec, 0xdf,
-	0xcc, 0x8d, 0xa8, 0x51, 0xa1, 0xfc, 0x2c, 0x09, 0xf0, 0x47, 0x50, 0xd7, 0x5f, 0xa1, 0x4a, 0x69,
-	0x95, 0x06, 0xbf, 0x5d, 0x76, 0xad, 0xb2, 0x43, 0x37, 0x32, 0x66, 0xfc, 0x28, 0xe4, 0xd1, 0xb1,
-	0x63, 0xb1, 0x91, 0xa4, 0xe5, 0xc2, 0x4a, 0x51, 0x01, 0xad, 0x40, 0xe5, 0x08, 0x1f, 0x6b, 0x1b,
-	0x8b, 0x9f, 0x82, 0x32, 0x5e, 0x09, 0xbc, 0xe9, 0x4a, 0xe1, 0xfa, 0x89, 0x39, 0xb8, 0x4f, 0x1d,
-	0xa5, 0xfd, 0x9d, 0xb9, 0x0f, 0x0d, 0xfb, 0x0f, 0x06, 0xac, 0xec, 0x44, 0x74, 0xf8, 0xce, 0xe9,
-	0xd7, 0x86, 0x7a, 0xa6, 0xfe, 0x4e, 0x22, 0x3e, 0x27, 0x9b, 0x96, 0x88, 0x2f, 0x43, 0xcd, 0x8f,
-	0xe8, 0xd0, 0xf5, 0x82, 0x40, 0x06, 0xa3, 0x28, 0x45, 0x23, 0x3a, 0xdc, 0x0a, 0x02, 0xfb, 0x35,
-	0xac, 0xed, 0x60, 0xd6, 0x8b, 0x48, 0xf7, 0xdd, 0x89, 0x61, 0x0a, 0x67, 0xe7, 0x92, 0x6e, 0xa5,
-	0x90, 0x74, 0xed, 0xcf, 0x0c, 0xb8, 0x54, 0x38, 0xf9, 0x2c, 0xe8, 0xf8, 0x7e, 0x1e, 0xb3, 0x0a,
-	0x1c, 0x53, 0xfa, 0xac, 0x2c, 0x56, 0x3d, 0xc9, 0xd9, 0x72, 0xee, 0x81, 0xc8, 0x53, 0x7b, 0x11,
-	0x3d, 0x90, 0x15, 0xe9, 0xf9, 0x55, 0x73, 0xff, 0x34, 0xe0, 0xda, 0x84, 0x33, 0xce, 0xf2, 0xe5,
-	0xc5, 0x06, 0x7e, 0x6e, 0x5a, 0x03, 0x5f, 0x29, 0x36, 0xf0, 0xe5, 0xfd, 0x6d, 0x75, 0x42, 0x7f,
-	0xfb, 0x8f, 0x0a, 0x34, 0xf6, 0x39, 0x8d, 0xbc, 0x03, 0xbc, 0x4d, 0xc3, 0x3e, 0x39, 0x10, 0xa9,
-	0x3e, 0xa9, 0xf1, 0x0d, 0xf9, 0xd1, 0x69, 0x15, 0x7f, 0x03, 0xea, 0x5e, 0xaf, 0x87, 0x19, 0x13,
-	0x6d, 0x92, 0xce, 0x46, 0xa6, 0x63, 0x29, 0xd9, 0x53, 0x21, 0x42, 0x5f, 0x83, 0x55, 0x86, 0x7b,
-	0x11, 0xe6, 0xee, 0x48, 0x53, 0x23, 0x78, 0x59, 0x4d, 0x6c, 0x25, 0xda, 0xa2, 0x29, 0x88, 0x19,
-	0xde, 0xdf, 0xff, 0x58, 0xa3, 0x58, 0x8f, 0x44, 0x49, 0xd6, 0x8d, 0x7b, 0x47, 0x98, 0x67, 0x29,
-	0x05, 0x94, 0x48, 0x42, 0xf1, 0x0a, 0x98, 0x11, 0xa5, 0x5c, 0xf2, 0x80, 0xe4, 0x7f, 0xd3, 0xa9,
-	0x09, 0x81, 0x48, 0x5b, 0x7a, 0xd7, 0xce, 0xd6, 0xae, 0xe6, 0x7d, 0x3d, 0x12, 0xbd, 0x70, 0x67,
-	0x6b, 0xf7, 0xa3, 0xd0, 0x1f, 0x52, 0x12, 0x72, 0x49, 0x0a, 0xa6, 0x93, 0x15, 0x89, 0xcf, 0x63,
-	0xca, 0x12, 0xae, 0x28, 0x59, 0x24, 0x21, 0x98, 0x8e, 0xa5, 0x65, 0xcf, 0x8f, 0x87, 0x58, 0xf0,
-	0x50, 0xcc, 0xb0, 0xfb, 0x8a, 0x44, 0x3c, 0xf6, 0x02, 0xf7, 0x90, 0x32, 0x2e, 0x79, 0xa1, 0xe6,
-	0x2c, 0xc5, 0x0c, 0xbf, 0x50, 0xe2, 0xc7, 0x94, 0x71, 0x71, 0x8d, 0x08, 0x1f, 0x24, 0x7c, 0x60,
-	0x3a, 0x7a, 0x24, 0x7a, 0xc1, 0x5e, 0x40, 0x63, 0xdf, 0x1d, 0x46, 0xf4, 0x15, 0xf1, 0x71, 0x24,
-	0xbb, 0x49, 0xd3, 0x69, 0x48, 0xe9, 0x9e, 0x16, 0x0a, 0x27, 0x46, 0x0a, 0xab, 0xb2, 0xa9, 0xa4,
-	0x31, 0x77, 0x07, 0xe9, 0x23, 0x85, 0x9e, 0x79, 0xae, 0x26, 0x76, 0x99, 0xfd, 0xc7, 0x2a, 0xac,
-	0xa8, 0x72, 0xf0, 0x09, 0xed, 0x26, 0x18, 0xbf, 0x0a, 0x66, 0x2f, 0x88, 0x45, 0x67, 0xa5, 0x01,
-	0x6e, 0x3a, 0x23, 0x81, 0x70, 0x54, 0x96, 0x51, 0x23, 0xdc, 0x27, 0x6f, 0xb4, 0x43, 0x97, 0x47,
-	0x94, 0x2a, 0xc5, 0x59, 0xf2, 0xaf, 0x8c, 0x91, 0xbf, 0xef, 0x71, 0x4f, 0x33, 0x72, 0x55, 0x32,
-	0xb2, 0x29, 0x24, 0x8a, 0x8c, 0xc7, 0x38, 0x76, 0xbe, 0x84, 0x63, 0x33, 0x45, 0xc7, 0x42, 0xbe,
-	0xe8, 0xc8, 0x47, 0xe0, 0x62, 0x31, 0x23, 0x3d, 0x86, 0xa5, 0xc4, 0x5f, 0x3d, 0x09, 0x5d, 0xe9,
-	0xd4, 0x92, 0x8e, 0x4f, 0xe6, 0xf1, 0x2c, 0xc6, 0x9d, 0x06, 0xcb, 0x41, 0xbe, 0x58, 0xa4, 0x98,
-	0xa7, 0x2a, 0x52, 0x0a, 0x05, 0x32, 0x9c, 0xa6, 0x40, 0xce, 0x16, 0x1c, 0xd6, 0x8c, 0x05, 0x47,
-	0x7d, 0x72, 0xc1, 0xf1, 0x31, 0xac, 0xfc, 0x20, 0xc6, 0xd1, 0xf1, 0x13, 0xda, 0x65, 0xb3, 0xe1,
-	0xa2, 0x05, 0x35, 0xed, 0xdc, 0x84, 0x9b, 0xd2, 0xb1, 0xfd, 0xab, 0x39, 0x68, 0xc8, 0xed, 0x9f,
-	0x7b, 0xec, 0x28, 0x79, 0xd0, 0x4a, 0x90, 0x61, 0xe4, 0x91, 0x71, 0xca, 0x76, 0xac, 0xe4, 0x35,
-	0xa6, 0x52, 0xf6, 0x1a, 0x53, 0x52, 0xe6, 0x55, 0x4b, 0xcb, 0xbc, 0x42, 0x7f, 0x37, 0x3f, 0xf6,
-	0xfe, 0x33, 0xd1, 0xac, 0x0b, 0x93, 0xcd, 0xfa, 0xb9, 0x01, 0xab, 0x19, 0xbb, 0x9e, 0x25, 0xdf,
-	0xe7, 0xbc, 0x31, 0x57, 0xf4, 0xc6, 0x83, 0x3c, 0x0f, 0x56, 0xca, 0x20, 0x95, 0xe1, 0xc1, 0xc4,
-	0x2f, 0x39, 0x2e, 0x7c, 0x0a, 0xcb, 0xa2, 0x52, 0x39, 0x1f, 0x08, 0xfc, 0xcb, 0x80, 0xc5, 0x27,
-	0xb4, 0x2b, 0x9d, 0x9f, 0xc5, 0xaa, 0x91, 0xc7, 0xea, 0x0a, 0x54, 0x7c, 0x32, 0xd0, 0xe4, 0x25,
-	0x7e, 0x8a, 0x58, 0x66, 0xdc, 0x8b, 0xf8, 0xe8, 0x7d, 0x53, 0xd4, 0xb1, 0x42, 0x22, 0x9f, 0xc8,
-	0x2e, 0x43, 0x0d, 0x87, 0xbe, 0x9a, 0xd4, 0x0d, 0x06, 0x0e, 0x7d, 0x39, 0x75, 0x3e, 0x3d, 0xe3,
-	0x1a, 0xcc, 0x0f, 0xe9, 0xe8, 0x4d, 0x52, 0x0d, 0xec, 0x35, 0x40, 0x8f, 0x30, 0x7f, 0x42, 0xbb,
-	0xc2, 0x2b, 0x89, 0x79, 0xec, 0xbf, 0xcf, 0xc9, 0x7e, 0x6e, 0x24, 0x3e, 0x8b, 0x83, 0x6d, 0x68,
-	0x28, 0xb6, 0xfe, 0x94, 0x76, 0xdd, 0x30, 0x4e, 0x8c, 0x62, 0x49, 0xe1, 0x13, 0xda, 0x7d, 0x16,
-	0x0f, 0xd0, 0x5d, 0xb8, 0x48, 0x42, 0xc1, 0x08, 0xb2, 0x80, 0x48, 0x35, 0x95, 0x95, 0x56, 0x48,
-	0x98, 0x94, 0x16, 0x5a, 0xfd, 0x36, 0x2c, 0xe3, 0xf0, 0x65, 0x8c, 0x63, 0x9c, 0xaa, 0x2a, 0x9b,
-	0x35, 0xb4, 0x58, 0xeb, 0x89, 0x42, 0xc1, 0x63, 0x47, 0x2e, 0x0b, 0x28, 0x67, 0x3a, 0xf7, 0x9a,
-	0x42, 0xb2, 0x2f, 0x04, 0xe8, 0x43, 0x30, 0xc5, 0x72, 0x05, 0x2d, 0xd5, 0x97, 0x5d, 0x29, 0x83,
-	0x96, 0xf6, 0xb7, 0x53, 0xfb, 0x54, 0xfd, 0x60, 0x22, 0xa8, 0x74, 0xd7, 0xe1, 0x13, 0x76, 0xa4,
-	0x89, 0x16, 0x94, 0x68, 0x87, 0xb0, 0x23, 0xfb, 0xc7, 0x70, 0x39, 0xfb, 0xd2, 0x45, 0x18, 0x27,
-	0xbd, 0xf3, 0x2c, 0xbe, 0x7e, 0x67, 0x40, 0xab, 0xec, 0x80, 0xff, 0x61, 0xcd, 0xb9, 0xf9, 0x0b,
-	0x0b, 0x40, 0xce, 0x6c, 0x53, 0x1a, 0xf9, 0x28, 0x90, 0xd0, 0xda, 0xa6, 0x83, 0x21, 0x0d, 0x71,
-	0xc8, 0x65, 0x96, 0x63, 0x68, 0x23, 0xbf, 0x9f, 0x1e, 0x8c, 0x2b, 0x6a, 0x5b, 0xb5, 0xde, 0x2b,
-	0xd5, 0x2f, 0x28, 0xdb, 0x17, 0xd0, 0x4b, 0xd9, 0x9b, 0x8d, 0x4c, 0xb1, 0x7d, 0xe8, 0x85, 0x21,
-	0x0e, 0xd0, 0xe6, 0x84, 0xd7, 0xcf, 0x32, 0xe5, 0xe4, 0xcc, 0x9b, 0xa5, 0x67, 0xee, 0xf3, 0x88,
-	0x84, 0x07, 0x89, 0x89, 0xed, 0x0b, 0xe8, 0x39, 0x58, 0x99, 0x27, 0x28, 0x74, 0xbb, 0xcc, 0x52,
-	0xe3, 0x6f, 0x54, 0xad, 0x93, 0x7c, 0x61, 0x5f, 0x40, 0x7d, 0x68, 0xe4, 0xde, 0x48, 0x51, 0xfb,
-	0xa4, 0x96, 0x30, 0xfb, 0x30, 0xd9, 0xfa, 0xea, 0x0c, 0x9a, 0xe9, 0xed, 0x7f, 0xaa, 0x0c, 0x36,
-	0xf6, 0xc8, 0x78, 0x6f, 0xc2, 0x26, 0x93, 0x9e, 0x43, 0x5b, 0xf7, 0x67, 0x5f, 0x90, 0x1e, 0xee,
-	0x8f, 0x3e, 0x52, 0x05, 0xd4, 0x9d, 0xe9, 0x7d, 0xaf, 0x3a, 0xad, 0x3d, 0x6b, 0x83, 0x6c, 0x5f,
-	0x40, 0x7b, 0x60, 0xa6, 0x2d, 0x2a, 0x7a, 0xaf, 0x6c, 0x61, 0xb1, 0x83, 0x9d, 0xc1, 0x39, 0xb9,
-	0x26, 0xaf, 0xdc, 0x39, 0x65, 0x1d, 0x68, 0xb9, 0x73, 0x4a, 0x3b, 0x46, 0xfb, 0x02, 0x8a, 0x65,
-	0xec, 0x14, 0xa2, 0x1b, 0xdd, 0x9d, 0xe6, 0xdf, 0x5c, 0x9a, 0x69, 0x6d, 0xcc, 0xaa, 0x9e, 0x1e,
-	0xfb, 0xb3, 0xd1, 0xfb, 0x7c, 0xae, 0xa3, 0x43, 0xf7, 0x4f, 0xda, 0xaa, 0xac, 0xc1, 0x6c, 0x7d,
-	0xe3, 0x1d, 0x56, 0x64, 0x30, 0x89, 0xf6, 0x0f, 0xe9, 0x6b, 0x55, 0x94, 0xc6, 0x91, 0x27, 0x72,
-	0x61, 0xc9, 0xe1, 0x3a, 0x84, 0xc7, 0x55, 0x27, 0x1e, 0x7e, 0xc2, 0x8a, 0xf4, 0x70, 0x17, 0xe0,
-	0x11, 0xe6, 0xbb, 0x98, 0x47, 0xc2, 0xd6, 0xb7, 0x27, 0xe5, 0x29, 0xad, 0x90, 0x1c, 0x75, 0x67,
-	0xaa, 0x5e, 0x7a, 0x40, 0x17, 0xac, 0xed, 0x43, 0xdc, 0x3b, 0x7a, 0x8c, 0xbd, 0x80, 0x1f, 0xa2,
-	0xf2, 0x95, 0x19, 0x8d, 0x09, 0x90, 0x2f, 0x53, 0x4c, 0xce, 0xd8, 0xfc, 0x7c, 0x41, 0xff, 0x1b,
-	0xe0, 0x19, 0xf5, 0xf1, 0xff, 0x7f, 0x0a, 0xde, 0x03, 0x33, 0x6d, 0xfb, 0xca, 0x23, 0xbc, 0xd8,
-	0x15, 0x4e, 0x8b, 0xf0, 0x4f, 0xc0, 0x4c, 0x0b, 0xdb, 0xf2, 0x1d, 0x8b, 0xfd, 0x44, 0xeb, 0xd6,
-	0x14, 0xad, 0xf4, 0xb6, 0xcf, 0xa0, 0x96, 0x14, 0xa2, 0xe8, 0xe6, 0xa4, 0x74, 0x94, 0xdd, 0x79,
-	0xca, 0x5d, 0x7f, 0x02, 0x56, 0xa6, 0x4a, 0x2b, 0x27, 0xa0, 0xf1, 0xea, 0xae, 0x75, 0x67, 0xaa,
-	0xde, 0x17, 0x23, 0x20, 0x1f, 0x7c, 0xf3, 0x93, 0xcd, 0x03, 0xc2, 0x0f, 0xe3, 0xae, 0xb0, 0xec,
-	0x3d, 0xa5, 0x79, 0x97, 0x50, 0xfd, 0xeb, 0x5e, 0x72, 0xcb, 0x7b, 0x72, 0xa7, 0x7b, 0xd2, 0x4e,
-	0xc3, 0x6e, 0x77, 0x41, 0x0e, 0xdf, 0xff, 0x4f, 0x00, 0x00, 0x00, 0xff, 0xff, 0x2c, 0x89, 0xb2,
-	0xd8, 0xcc, 0x23, 0x00, 0x00,
+	// 2451 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe4, 0x5a, 0x5d, 0x6f, 0x1b, 0x59,
+	0xf9, 0xef, 0xd8, 0x4e, 0xe2, 0x79, 0x6c, 0xe7, 0xe5, 0x34, 0xfd, 0xff, 0x5d, 0xb7, 0x4b, 0xd3,
+	0xd9, 0x6d, 0xeb, 0x45, 0x34, 0x2d, 0x59, 0x16, 0x2d, 0x08, 0x56, 0x4a, 0x93, 0x6d, 0xeb, 0x76,
+	0x53, 0x85, 0x71, 0x55, 0x89, 0x15, 0x62, 0x18, 0x7b, 0x8e, 0x93, 0xb3, 0x19, 0xcf, 0x71, 0xe7,
+	0x9c, 0x69, 0x9b, 0x22, 0x21, 0xb8, 0xe0, 0x02, 0xb4, 0x12, 0x02, 0x21, 0xb8, 0x45, 0x88, 0xab,
+	0xe5, 0x13, 0x2c, 0x37, 0x48, 0x5c, 0xf3, 0xa1, 0xd0, 0x79, 0x99, 0xf1, 0xcc, 0x78, 0x1c, 0x3b,
+	0x2f, 0x08, 0x09, 0xee, 0x7c, 0x9e, 0xf3, 0x9c, 0x97, 0x79, 0xde, 0x7e, 0xbf, 0xe7, 0x24, 0xb0,
+	0x46, 0x02, 0x0f, 0xbf, 0x71, 0xfa, 0x94, 0x86, 0xde, 0xe6, 0x28, 0xa4, 0x9c, 0x22, 0x34, 0x24,
+	0xfe, 0xab, 0x88, 0xa9, 0xd1, 0xa6, 0x9c, 0x6f, 0xd5, 0xfb, 0x74, 0x38, 0xa4, 0x81, 0x92, 0xb5,
+	0x96, 0x49, 0xc0, 0x71, 0x18, 0xb8, 0xbe, 0x1e, 0xd7, 0xd3, 0x2b, 0xac, 0xaf, 0x16, 0xc0, 0xec,
+	0x88, 0x55, 0x9d, 0x60, 0x40, 0x91, 0x05, 0xf5, 0x3e, 0xf5, 0x7d, 0xdc, 0xe7, 0x84, 0x06, 0x9d,
+	0xdd, 0xa6, 0xb1, 0x61, 0xb4, 0xcb, 0x76, 0x46, 0x86, 0x9a, 0xb0, 0x34, 0x20, 0xd8, 0xf7, 0x3a,
+	0xbb, 0xcd, 0x92, 0x9c, 0x8e, 0x87, 0xe8, 0x1d, 0x00, 0x75, 0xc1, 0xc0, 0x1d, 0xe2, 0x66, 0x79,
+	0xc3, 0x68, 0x9b, 0xb6, 0x29, 0x25, 0xcf, 0xdc, 0x21, 0x16, 0x0b, 0xe5, 0xa0, 0xb3, 0xdb, 0xac,
+	0xa8, 0x85, 0x7a, 0x88, 0x1e, 0x40, 0x8d, 0x1f, 0x8f, 0xb0, 0x33, 0x72, 0x43, 0x77, 0xc8, 0x9a,
+	0x0b, 0x1b, 0xe5, 0x76, 0x6d, 0xeb, 0xe6, 0x66, 0xe6, 0xd3, 0xf4, 0x37, 0x3d, 0xc5, 0xc7, 0x2f,
+	0x5c, 0x3f, 0xc2, 0xfb, 0x2e, 0x09, 0x6d, 0x10, 0xab, 0xf6, 0xe5, 0x22, 0xb4, 0x0b, 0x75, 0x75,
+	0xb8, 0xde, 0x64, 0x71, 0xde, 0x4d, 0x6a, 0x72, 0x99, 0xde, 0xe5, 0xa6, 0xde, 0x05, 0x7b, 0x4e,
+	0x48, 0x5f, 0xb3, 0xe6, 0x92, 0xbc, 0x68, 0x4d, 0xcb, 0x6c, 0xfa, 0x9a, 0x89, 0xaf, 0xe4, 0x94,
+	0xbb, 0xbe, 0x52, 0xa8, 0x4a, 0x05, 0x53, 0x4a, 0xe4, 0xf4, 0x87, 0xb0, 0xc0, 0xb8, 0xcb, 0x71,
+	0xd3, 0xdc, 0x30, 0xda, 0xcb, 0x5b, 0x37, 0x0a, 0x2f, 0x20, 0x2d, 0xde, 0x15, 0x6a, 0xb6, 0xd2,
+	0x46, 0x1f, 0xc2, 0xff, 0xab, 0xeb, 0xcb, 0xa1, 0x33, 0x70, 0x89, 0xef, 0x84, 0xd8, 0x65, 0x34,
+	0x68, 0x82, 0x34, 0xe4, 0x3a, 0x49, 0xd6, 0x3c, 0x74, 0x89, 0x6f, 0xcb, 0x39, 0x64, 0x41, 0x83,
+	0x30, 0xc7, 0x8d, 0x38, 0x75, 0xe4, 0x7c, 0xb3, 0xb6, 0x61, 0xb4, 0xab, 0x76, 0x8d, 0xb0, 0xed,
+	0x88, 0x53, 0x79, 0x0c, 0xda, 0x83, 0xb5, 0x88, 0xe1, 0xd0, 0xc9, 0x98, 0xa7, 0x3e, 0xaf, 0x79,
+	0x56, 0xc4, 0xda, 0x4e, 0xca, 0x44, 0xdf, 0x00, 0x34, 0xc2, 0x81, 0x47, 0x82, 0x03, 0xbd, 0xa3,
+	0xb4, 0x43, 0x43, 0xda, 0x61, 0x55, 0xcf, 0x48, 0x7d, 0x69, 0x8e, 0x16, 0x54, 0x47, 0x21, 0x3d,
+	0x08, 0x31, 0x63, 0xcd, 0xe5, 0x0d, 0xa3, 0x5d, 0xb2, 0x93, 0x31, 0xfa, 0x18, 0xae, 0x61, 0xc6,
+	0xc9, 0xd0, 0xe5, 0xc2, 0xdc, 0x78, 0xe8, 0x92, 0x40, 0xec, 0xca, 0x70, 0x9f, 0x06, 0x1e, 0x6b,
+	0xae, 0xc8, 0x2d, 0xaf, 0x26, 0x2a, 0x76, 0xac, 0xd1, 0x55, 0x0a, 0xd6, 0x2f, 0x0d, 0x80, 0x87,
+	0x32, 0xf6, 0xe4, 0x77, 0x7e, 0x2f, 0x0e, 0x3f, 0x12, 0x0c, 0xa8, 0x0c, 0xdd, 0xda, 0xd6, 0x3b,
+	0x9b, 0x93, 0xf9, 0xb1, 0x99, 0xc4, 0xbb, 0x8e, 0x4e, 0xf1, 0x53, 0x44, 0xa7, 0x87, 0x7d, 0xcc,
+	0xb1, 0x27, 0xc3, 0xba, 0x6a, 0xc7, 0x43, 0x74, 0x03, 0x6a, 0xfd, 0x10, 0x0b, 0xaf, 0x70, 0xa2,
+	0xe3, 0xba, 0x62, 0x83, 0x12, 0x3d, 0x27, 0x43, 0x6c, 0x7d, 0x55, 0x81, 0x7a, 0x17, 0x1f, 0x0c,
+	0x71, 0xc0, 0xd5, 0x4d, 0xe6, 0x49, 0xa3, 0x0d, 0xa8, 0x8d, 0xdc, 0x90, 0x13, 0xad, 0xa2, 0x52,
+	0x29, 0x2d, 0x42, 0xd7, 0xc1, 0x64, 0x7a, 0xd7, 0x5d, 0x79, 0x6a, 0xd9, 0x1e, 0x0b, 0xd0, 0x55,
+	0xa8, 0x06, 0xd1, 0x50, 0x19, 0x5f, 0xa7, 0x53, 0x10, 0x0d, 0xa5, 0xcd, 0x53, 0x89, 0xb6, 0x90,
+	0x4d, 0xb4, 0x26, 0x2c, 0xf5, 0x22, 0x22, 0x73, 0x77, 0x51, 0xcd, 0xe8, 0x21, 0xfa, 0x3f, 0x58,
+	0x0c, 0xa8, 0x87, 0x3b, 0xbb, 0x3a, 0xe4, 0xf5, 0x08, 0xbd, 0x0b, 0x0d, 0x65, 0xd4, 0x57, 0x38,
+	0x64, 0x84, 0x06, 0x3a, 0xe0, 0x55, 0x96, 0xbc, 0x50, 0xb2, 0xb3, 0xc6, 0xfc, 0x0d, 0xa8, 0x4d,
+	0xc6, 0x39, 0x0c, 0xc6, 0xd1, 0x7d, 0x1b, 0x56, 0xd4, 0xe1, 0x03, 0xe2, 0x63, 0xe7, 0x08, 0x1f,
+	0xb3, 0x66, 0x6d, 0xa3, 0xdc, 0x36, 0x6d, 0x75, 0xa7, 0x87, 0xc4, 0xc7, 0x4f, 0xf1, 0x31, 0x4b,
+	0xfb, 0xae, 0x7e, 0xa2, 0xef, 0x1a, 0x79, 0xdf, 0xa1, 0x5b, 0xb0, 0xcc, 0x70, 0x48, 0x5c, 0x9f,
+	0xbc, 0xc5, 0x0e, 0x23, 0x6f, 0xb1, 0x8c, 0xd2, 0x8a, 0xdd, 0x48, 0xa4, 0x5d, 0xf2, 0x16, 0x0b,
+	0x33, 0xbc, 0x0e, 0x09, 0xc7, 0xce, 0xa1, 0x1b, 0x78, 0x74, 0x30, 0x90, 0xc1, 0x59, 0xb5, 0xeb,
+	0x52, 0xf8, 0x58, 0xc9, 0xd0, 0x16, 0x5c, 0xe9, 0x47, 0x61, 0x88, 0x03, 0xee, 0x64, 0x6d, 0xb6,
+	0xba, 0x61, 0xb4, 0x17, 0xec, 0xcb, 0x7a, 0xb2, 0x93, 0x32, 0x9d, 0xf5, 0x47, 0x03, 0x2e, 0xdb,
+	0xf8, 0x80, 0x30, 0x8e, 0xc3, 0x67, 0xd4, 0xc3, 0x36, 0x7e, 0x19, 0x61, 0xc6, 0xd1, 0x7d, 0xa8,
+	0xf4, 0x5c, 0x86, 0x75, 0x18, 0x5f, 0x2f, 0xb4, 0xe8, 0x1e, 0x3b, 0x78, 0xe0, 0x32, 0x6c, 0x4b,
+	0x4d, 0xf4, 0x6d, 0x58, 0x72, 0x3d, 0x4f, 0x26, 0x5a, 0xe9, 0x84, 0x45, 0xdb, 0x4a, 0xc7, 0x8e,
+	0x95, 0x53, 0x9e, 0x2f, 0xa7, 0x3d, 0x6f, 0xfd, 0xc6, 0x80, 0xf5, 0xec, 0xcd, 0xd8, 0x88, 0x06,
+	0x0c, 0xa3, 0x0f, 0x60, 0x51, 0xf8, 0x2f, 0x62, 0xfa, 0x72, 0xd7, 0x0a, 0xcf, 0xe9, 0x4a, 0x15,
+	0x5b, 0xab, 0x8a, 0x12, 0x4f, 0x02, 0xc2, 0xe3, 0xf2, 0xa3, 0x6e, 0x78, 0x33, 0x9f, 0x9d, 0x1a,
+	0xa8, 0x3a, 0x01, 0xe1, 0xaa, 0xda, 0xd8, 0x40, 0x92, 0xdf, 0xd6, 0x0f, 0x61, 0xfd, 0x11, 0xe6,
+	0xa9, 0x38, 0xd2, 0xb6, 0x9a, 0x27, 0xdd, 0xb2, 0xd8, 0x54, 0xca, 0x61, 0x93, 0xf5, 0x17, 0x03,
+	0xae, 0xe4, 0xf6, 0x3e, 0xcf, 0xd7, 0x26, 0x09, 0x51, 0x3a, 0x4f, 0x42, 0x94, 0xf3, 0x09, 0x61,
+	0xfd, 0xdc, 0x80, 0x6b, 0x8f, 0x30, 0x4f, 0x17, 0x9b, 0x0b, 0xb6, 0x04, 0xfa, 0x1a, 0x40, 0x52,
+	0x64, 0x58, 0xb3, 0xbc, 0x51, 0x6e, 0x97, 0xed, 0x94, 0xc4, 0xfa, 0x95, 0x01, 0x6b, 0x13, 0xe7,
+	0x67, 0x6b, 0x95, 0x91, 0xaf, 0x55, 0xff, 0x2e, 0x73, 0xfc, 0xce, 0x80, 0xeb, 0xc5, 0xe6, 0x38,
+	0x8f, 0xf3, 0xbe, 0xaf, 0x16, 0x61, 0x11, 0xa5, 0x02, 0x24, 0x6f, 0x15, 0x61, 0xc8, 0xe4, 0x99,
+	0x7a, 0x91, 0xf5, 0x45, 0x19, 0xd0, 0x8e, 0x2c, 0x30, 0x72, 0xf2, 0x34, 0xae, 0x39, 0x33, 0xb5,
+	0xca, 0x11, 0xa8, 0xca, 0x45, 0x10, 0xa8, 0x85, 0x33, 0x11, 0xa8, 0xeb, 0x60, 0x8a, 0x4a, 0xcb,
+	0xb8, 0x3b, 0x1c, 0x49, 0x8c, 0xa9, 0xd8, 0x63, 0xc1, 0x24, 0x5d, 0x59, 0x9a, 0x93, 0xae, 0x54,
+	0xcf, 0x4a, 0x57, 0xac, 0x37, 0x70, 0x39, 0x4e, 0x6c, 0x09, 0xf9, 0xa7, 0x70, 0x47, 0x36, 0x15,
+	0x4a, 0xf9, 0x54, 0x98, 0xe1, 0x14, 0xeb, 0xaf, 0x65, 0x58, 0xeb, 0xc4, 0x38, 0xb5, 0xef, 0xf2,
+	0x43, 0xc9, 0x33, 0x4e, 0xce, 0x94, 0xe9, 0x11, 0x90, 0x02, 0xf5, 0xf2, 0x54, 0x50, 0xaf, 0x64,
+	0x41, 0x3d, 0x7b, 0xc1, 0x85, 0x7c, 0xd4, 0x5c, 0x0c, 0x65, 0x6e, 0xc3, 0x6a, 0x0a, 0xa4, 0x47,
+	0x2e, 0x3f, 0x14, 0xb4, 0x59, 0xa0, 0xf4, 0x32, 0x49, 0x7f, 0x3d, 0x43, 0x77, 0x60, 0x25, 0x41,
+	0x55, 0x4f, 0x81, 0x6d, 0x55, 0x46, 0xc8, 0x18, 0x82, 0xbd, 0x18, 0x6d, 0xb3, 0x00, 0x6a, 0x16,
+	0x90, 0x8e, 0x34, 0x01, 0x82, 0x2c, 0x01, 0x9a, 0x0a, 0xc4, 0xb5, 0xe9, 0x40, 0xfc, 0x37, 0x03,
+	0x6a, 0x49, 0x52, 0xcf, 0xd9, 0x0a, 0x65, 0x7c, 0x59, 0xca, 0xfb, 0xf2, 0x26, 0xd4, 0x71, 0xe0,
+	0xf6, 0x7c, 0xac, 0x63, 0xbd, 0xac, 0x62, 0x5d, 0xc9, 0x54, 0xac, 0x3f, 0x84, 0xda, 0x98, 0xb2,
+	0xc6, 0x79, 0x7b, 0x6b, 0x2a, 0x67, 0x4d, 0x07, 0x92, 0x0d, 0x09, 0x77, 0x65, 0xd6, 0xaf, 0x4b,
+	0x63, 0x68, 0x94, 0x93, 0xe7, 0x2a, 0x80, 0x3f, 0x82, 0xba, 0xfe, 0x0a, 0x45, 0xa5, 0x55, 0x19,
+	0xfc, 0x4e, 0xd1, 0xb5, 0x8a, 0x0e, 0xdd, 0x4c, 0x99, 0xf1, 0x93, 0x80, 0x87, 0xc7, 0x76, 0x8d,
+	0x8d, 0x25, 0x2d, 0x07, 0x56, 0xf3, 0x0a, 0x68, 0x15, 0xca, 0x47, 0xf8, 0x58, 0xdb, 0x58, 0xfc,
+	0x14, 0x90, 0xf1, 0x4a, 0xc4, 0x9b, 0x66, 0x0a, 0x37, 0x4e, 0xac, 0xc1, 0x03, 0x6a, 0x2b, 0xed,
+	0xef, 0x96, 0x3e, 0x32, 0xac, 0xdf, 0x1b, 0xb0, 0xba, 0x1b, 0xd2, 0xd1, 0xa9, 0xcb, 0xaf, 0x05,
+	0xf5, 0x14, 0xff, 0x8e, 0x33, 0x3e, 0x23, 0x9b, 0x55, 0x88, 0xaf, 0x42, 0xd5, 0x0b, 0xe9, 0xc8,
+	0x71, 0x7d, 0xbf, 0x59, 0xd1, 0x54, 0x34, 0xa4, 0xa3, 0x6d, 0xdf, 0xb7, 0x5e, 0xc3, 0xfa, 0x2e,
+	0x66, 0xfd, 0x90, 0xf4, 0x4e, 0x0f, 0x0c, 0x33, 0x30, 0x3b, 0x53, 0x74, 0xcb, 0xb9, 0xa2, 0x6b,
+	0x7d, 0x61, 0xc0, 0x95, 0xdc, 0xc9, 0xe7, 0x89, 0x8e, 0x8f, 0xb3, 0x31, 0xab, 0x82, 0x63, 0x46,
+	0x9f, 0x95, 0x8e, 0x55, 0x57, 0x62, 0xb6, 0x9c, 0x7b, 0x20, 0xea, 0xd4, 0xbe, 0x6e, 0x07, 0x2f,
+	0x90, 0xcd, 0xfd, 0xa9, 0x04, 0xef, 0x4c, 0x39, 0xe3, 0x3c, 0x5f, 0x9e, 0x7f, 0x1c, 0x28, 0xcd,
+	0x7a, 0x1c, 0x28, 0xe7, 0x1f, 0x07, 0x8a, 0x7b, 0xe7, 0xca, 0x1c, 0xbd, 0xf3, 0xc2, 0xe9, 0x7a,
+	0xe7, 0xc5, 0x59, 0xbd, 0xf3, 0x3f, 0xca, 0xd0, 0xe8, 0x72, 0x1a, 0xba, 0x07, 0x78, 0x87, 0x06,
+	0x03, 0x72, 0x20, 0x60, 0x24, 0xee, 0x1f, 0x0c, 0x69, 0xd0, 0x78, 0x28, 0xbe, 0xdb, 0xed, 0xf7,
+	0x31, 0x63, 0xa2, 0x05, 0xd3, 0x95, 0xce, 0xb4, 0x6b, 0x4a, 0xf6, 0x54, 0x88, 0xd0, 0xd7, 0x61,
+	0x8d, 0xe1, 0x7e, 0x88, 0xb9, 0x33, 0xd6, 0xd4, 0xd9, 0xb1, 0xa2, 0x26, 0xb6, 0x63, 0x6d, 0xd1,
+	0x70, 0x44, 0x0c, 0x77, 0xbb, 0x9f, 0xea, 0x0c, 0xd1, 0x23, 0x41, 0xf7, 0x7a, 0x51, 0xff, 0x08,
+	0xf3, 0x34, 0x5c, 0x81, 0x12, 0xc9, 0x30, 0xbf, 0x06, 0x66, 0x48, 0x29, 0x97, 0x18, 0x23, 0xbf,
+	0xd0, 0xb4, 0xab, 0x42, 0x20, 0x4a, 0xa2, 0xde, 0xb5, 0xb3, 0xbd, 0xa7, 0x39, 0x85, 0x1e, 0x89,
+	0x3e, 0xbb, 0xb3, 0xbd, 0xf7, 0x49, 0xe0, 0x8d, 0x28, 0x09, 0xb8, 0x04, 0x1c, 0xd3, 0x4e, 0x8b,
+	0xc4, 0xe7, 0x31, 0x65, 0x09, 0x47, 0xd0, 0x21, 0x09, 0x36, 0xa6, 0x5d, 0xd3, 0xb2, 0xe7, 0xc7,
+	0x23, 0x2c, 0x30, 0x2e, 0x62, 0xd8, 0x79, 0x45, 0x42, 0x1e, 0xb9, 0xbe, 0x73, 0x48, 0x19, 0x97,
+	0x98, 0x53, 0xb5, 0x97, 0x23, 0x86, 0x5f, 0x28, 0xf1, 0x63, 0xca, 0xb8, 0xb8, 0x46, 0x88, 0x0f,
+	0x62, 0xac, 0x31, 0x6d, 0x3d, 0x12, 0x7d, 0x66, 0xdf, 0xa7, 0x91, 0xe7, 0x8c, 0x42, 0xfa, 0x8a,
+	0x78, 0x38, 0x94, 0x9d, 0xaa, 0x69, 0x37, 0xa4, 0x74, 0x5f, 0x0b, 0x45, 0x80, 0x84, 0x2a, 0x0f,
+	0x64, 0xc3, 0x4a, 0x23, 0xee, 0x0c, 0x93, 0xc7, 0x15, 0x3d, 0xf3, 0x5c, 0x4d, 0xec, 0x31, 0xeb,
+	0x0f, 0x15, 0x58, 0x55, 0x54, 0xf3, 0x09, 0xed, 0xc5, 0xf9, 0x73, 0x1d, 0xcc, 0xbe, 0x1f, 0x31,
+	0x8e, 0x43, 0x9d, 0x3c, 0xa6, 0x3d, 0x16, 0x08, 0x47, 0xa5, 0xd1, 0x3a, 0xc4, 0x03, 0xf2, 0x46,
+	0x3b, 0x74, 0x65, 0x0c, 0xd7, 0x52, 0x9c, 0x26, 0x16, 0xe5, 0x09, 0x62, 0xe1, 0xb9, 0xdc, 0xd5,
+	0x68, 0x5f, 0x91, 0x68, 0x6f, 0x0a, 0x89, 0x02, 0xfa, 0x09, 0xfc, 0x5e, 0x28, 0xc0, 0xef, 0x14,
+	0xa1, 0x59, 0xcc, 0x12, 0x9a, 0x6c, 0x76, 0x2f, 0xe5, 0xab, 0xdd, 0x63, 0x58, 0x8e, 0xfd, 0xd5,
+	0x97, 0xa1, 0x2b, 0x9d, 0x5a, 0xd0, 0x4d, 0x4a, 0x8c, 0x48, 0xc7, 0xb8, 0xdd, 0x60, 0xe9, 0xe1,
+	0x04, 0x01, 0x32, 0xcf, 0x44, 0x80, 0x72, 0xe4, 0x1b, 0xce, 0x42, 0xbe, 0xd3, 0x64, 0xa6, 0x36,
+	0x27, 0x99, 0xa9, 0x4f, 0x27, 0x33, 0x9f, 0xc2, 0xea, 0x0f, 0x22, 0x1c, 0x1e, 0x3f, 0xa1, 0x3d,
+	0x36, 0x5f, 0x5c, 0xb4, 0xa0, 0xaa, 0x9d, 0x1b, 0xe3, 0x5e, 0x32, 0xb6, 0xfe, 0x5c, 0x86, 0x86,
+	0xdc, 0xfe, 0xb9, 0xcb, 0x8e, 0xe2, 0xc7, 0xb2, 0x38, 0x32, 0x8c, 0x6c, 0x64, 0x9c, 0xb1, 0xd5,
+	0x2b, 0x78, 0xe9, 0x29, 0x17, 0xbd, 0xf4, 0x14, 0x50, 0xc8, 0x4a, 0x21, 0x85, 0xcc, 0xf5, 0x8e,
+	0x0b, 0x13, 0x6f, 0x4b, 0x53, 0xcd, 0xba, 0x38, 0xd5, 0xac, 0x68, 0x1d, 0x16, 0x46, 0x87, 0x2e,
+	0x8b, 0x63, 0x52, 0x0d, 0x44, 0x6a, 0x8f, 0x42, 0x2a, 0xaa, 0x5b, 0x0c, 0x0c, 0xea, 0x8d, 0xac,
+	0x91, 0x48, 0x0b, 0xa0, 0xc1, 0xcc, 0x43, 0xc3, 0x8c, 0x82, 0x0e, 0xb3, 0x0a, 0xfa, 0x97, 0x06,
+	0xac, 0xa5, 0x7c, 0x7e, 0x1e, 0x9c, 0xcb, 0x44, 0x4a, 0x29, 0x1f, 0x29, 0x0f, 0xb2, 0xf8, 0x5f,
+	0x2e, 0x0a, 0xf7, 0x14, 0xfe, 0xc7, 0x31, 0x93, 0xe1, 0x00, 0x4f, 0x61, 0x45, 0x30, 0xb4, 0x8b,
+	0x09, 0xcf, 0x7f, 0x1a, 0xb0, 0xf4, 0x84, 0xf6, 0x64, 0x60, 0xa6, 0xf3, 0xc8, 0xc8, 0xe6, 0xd1,
+	0x2a, 0x94, 0x3d, 0x32, 0xd4, 0xa0, 0x2d, 0x7e, 0x0a, 0x8f, 0x30, 0xee, 0x86, 0x7c, 0xfc, 0xae,
+	0x2b, 0xf8, 0xbb, 0x90, 0xc8, 0xa7, 0xc1, 0xab, 0x50, 0xc5, 0x81, 0xa7, 0x26, 0x75, 0x63, 0x85,
+	0x03, 0x4f, 0x4e, 0x5d, 0x4c, 0xaf, 0x2c, 0xc2, 0x89, 0x8e, 0xdf, 0x62, 0xd5, 0xc0, 0x5a, 0x07,
+	0xf4, 0x08, 0xf3, 0x27, 0xb4, 0x27, 0xbc, 0x12, 0x9b, 0xc7, 0xfa, 0x7b, 0x09, 0x2e, 0x67, 0xc4,
+	0xe7, 0x71, 0xb0, 0x05, 0x0d, 0x15, 0x8a, 0x9f, 0xd3, 0x9e, 0x13, 0x44, 0xb1, 0x51, 0x6a, 0x52,
+	0xf8, 0x84, 0xf6, 0x9e, 0x45, 0x43, 0x74, 0x17, 0x2e, 0x93, 0xc0, 0x89, 0xf9, 0x46, 0xa2, 0xa9,
+	0xac, 0xb4, 0x4a, 0x82, 0x98, 0x52, 0x69, 0xf5, 0xdb, 0xb0, 0x82, 0x83, 0x97, 0x11, 0x8e, 0x70,
+	0xa2, 0xaa, 0x6c, 0xd6, 0xd0, 0x62, 0xad, 0x27, 0xb2, 0xc0, 0x65, 0x47, 0x0e, 0xf3, 0x29, 0x67,
+	0x1a, 0x17, 0x4c, 0x21, 0xe9, 0x0a, 0x01, 0xfa, 0x08, 0x4c, 0xb1, 0x5c, 0x85, 0x96, 0xea, 0x47,
+	0xaf, 0x15, 0x85, 0x96, 0xf6, 0xb7, 0x5d, 0xfd, 0x5c, 0xfd, 0x60, 0x22, 0xe1, 0x75, 0xb7, 0xe5,
+	0x11, 0x76, 0xa4, 0x49, 0x00, 0x28, 0xd1, 0x2e, 0x61, 0x47, 0xd6, 0x8f, 0xe1, 0x6a, 0xfa, 0x85,
+	0x8f, 0x30, 0x4e, 0xfa, 0x17, 0x49, 0x3a, 0x7f, 0x6b, 0x40, 0xab, 0xe8, 0x80, 0xff, 0x20, 0xd7,
+	0xde, 0xfa, 0x45, 0x0d, 0x40, 0xce, 0xec, 0x50, 0x1a, 0x7a, 0xc8, 0x97, 0xa1, 0xb5, 0x43, 0x87,
+	0x23, 0x1a, 0xe0, 0x80, 0x77, 0xe5, 0x83, 0x15, 0xda, 0xcc, 0xee, 0xa7, 0x07, 0x93, 0x8a, 0xda,
+	0x56, 0xad, 0xf7, 0x0a, 0xf5, 0x73, 0xca, 0xd6, 0x25, 0xf4, 0x52, 0xf6, 0xa4, 0x63, 0x53, 0xec,
+	0x1c, 0xba, 0x41, 0x80, 0x7d, 0xb4, 0x35, 0xe5, 0xd5, 0xb7, 0x48, 0x39, 0x3e, 0xf3, 0xdd, 0xc2,
+	0x33, 0xbb, 0x3c, 0x24, 0xc1, 0x41, 0x6c, 0x62, 0xeb, 0x12, 0x7a, 0x0e, 0xb5, 0xd4, 0xd3, 0x1b,
+	0xba, 0x5d, 0x64, 0xa9, 0xc9, 0xb7, 0xb9, 0xd6, 0x49, 0xbe, 0xb0, 0x2e, 0xa1, 0x01, 0x34, 0xd2,
+	0x8e, 0xc5, 0xa8, 0x7d, 0x52, 0x2b, 0x9c, 0x7e, 0x90, 0x6d, 0xbd, 0x3f, 0x87, 0x66, 0x72, 0xfb,
+	0x9f, 0x2a, 0x83, 0x4d, 0x3c, 0xae, 0xde, 0x9b, 0xb2, 0xc9, 0xb4, 0x67, 0xe0, 0xd6, 0xfd, 0xf9,
+	0x17, 0x24, 0x87, 0x7b, 0xe3, 0x8f, 0x54, 0x09, 0x75, 0x67, 0x76, 0xbf, 0xaf, 0x4e, 0x6b, 0xcf,
+	0xfb, 0x30, 0x60, 0x5d, 0x42, 0xfb, 0x60, 0x26, 0xad, 0x39, 0x7a, 0xaf, 0x68, 0x61, 0xbe, 0x73,
+	0x9f, 0xc3, 0x39, 0x99, 0xe6, 0xb6, 0xd8, 0x39, 0x45, 0x9d, 0x77, 0xeb, 0xfd, 0x39, 0x34, 0x93,
+	0x9b, 0x47, 0x32, 0x77, 0x72, 0xd9, 0x8d, 0xee, 0xce, 0xf2, 0x6f, 0xa6, 0xcc, 0xb4, 0x36, 0xe7,
+	0x55, 0x4f, 0x8e, 0xfd, 0x19, 0x5c, 0x29, 0xec, 0x64, 0xd1, 0xfd, 0x93, 0xb6, 0x2a, 0x6a, 0xac,
+	0x5b, 0xdf, 0x3c, 0xc5, 0x8a, 0x54, 0x4c, 0xa2, 0xee, 0x21, 0x7d, 0xad, 0x08, 0x73, 0x14, 0xba,
+	0x9c, 0xd0, 0xa0, 0xe0, 0x70, 0x9d, 0xc2, 0x93, 0xaa, 0x53, 0x0f, 0x3f, 0x61, 0x45, 0x72, 0xb8,
+	0x03, 0xf0, 0x08, 0xf3, 0x3d, 0xcc, 0x43, 0x61, 0xeb, 0xdb, 0xd3, 0xea, 0x94, 0x56, 0x88, 0x8f,
+	0xba, 0x33, 0x53, 0x2f, 0x39, 0xa0, 0x07, 0xb5, 0x9d, 0x43, 0xdc, 0x3f, 0x7a, 0x8c, 0x5d, 0x9f,
+	0x1f, 0xa2, 0xe2, 0x95, 0x29, 0x8d, 0x29, 0x21, 0x5f, 0xa4, 0x18, 0x9f, 0xb1, 0xf5, 0xe5, 0xa2,
+	0xfe, 0x0f, 0x0b, 0xf1, 0x47, 0xb4, 0xff, 0xfe, 0x12, 0xbc, 0x0f, 0x66, 0xd2, 0x92, 0x16, 0x67,
+	0x78, 0xbe, 0x63, 0x9d, 0x95, 0xe1, 0x9f, 0x81, 0x99, 0x10, 0xdb, 0xe2, 0x1d, 0xf3, 0xbd, 0x4e,
+	0xeb, 0xd6, 0x0c, 0xad, 0xe4, 0xb6, 0xcf, 0xa0, 0x1a, 0x13, 0x51, 0xf4, 0xee, 0xb4, 0x72, 0x94,
+	0xde, 0x79, 0xc6, 0x5d, 0x7f, 0x02, 0xb5, 0x14, 0x4b, 0x2b, 0x06, 0xa0, 0x49, 0x76, 0xd7, 0xba,
+	0x33, 0x53, 0xef, 0x7f, 0x23, 0x21, 0x1f, 0x7c, 0xeb, 0xb3, 0xad, 0x03, 0xc2, 0x0f, 0xa3, 0x9e,
+	0xb0, 0xec, 0x3d, 0xa5, 0x79, 0x97, 0x50, 0xfd, 0xeb, 0x5e, 0x7c, 0xcb, 0x7b, 0x72, 0xa7, 0x7b,
+	0xd2, 0x4e, 0xa3, 0x5e, 0x6f, 0x51, 0x0e, 0x3f, 0xf8, 0xd7, 0x00, 0x55, 0x15, 0x83, 0xfc, 0x20,
+	0x25, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
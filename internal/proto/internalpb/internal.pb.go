@@ -740,20 +740,29 @@ type SearchRequest struct {
 	PartitionIDs []int64           `protobuf:"varint,5,rep,packed,name=partitionIDs,proto3" json:"partitionIDs,omitempty"`
 	Dsl          string            `protobuf:"bytes,6,opt,name=dsl,proto3" json:"dsl,omitempty"`
 	// serialized `PlaceholderGroup`
-	PlaceholderGroup     []byte           `protobuf:"bytes,7,opt,name=placeholder_group,json=placeholderGroup,proto3" json:"placeholder_group,omitempty"`
-	DslType              commonpb.DslType `protobuf:"varint,8,opt,name=dsl_type,json=dslType,proto3,enum=milvus.proto.common.DslType" json:"dsl_type,omitempty"`
-	SerializedExprPlan   []byte           `protobuf:"bytes,9,opt,name=serialized_expr_plan,json=serializedExprPlan,proto3" json:"serialized_expr_plan,omitempty"`
-	OutputFieldsId       []int64          `protobuf:"varint,10,rep,packed,name=output_fields_id,json=outputFieldsId,proto3" json:"output_fields_id,omitempty"`
-	GuaranteeTimestamp   uint64           `protobuf:"varint,12,opt,name=guarantee_timestamp,json=guaranteeTimestamp,proto3" json:"guarantee_timestamp,omitempty"`
-	TimeoutTimestamp     uint64           `protobuf:"varint,13,opt,name=timeout_timestamp,json=timeoutTimestamp,proto3" json:"timeout_timestamp,omitempty"`
-	Nq                   int64            `protobuf:"varint,14,opt,name=nq,proto3" json:"nq,omitempty"`
-	Topk                 int64            `protobuf:"varint,15,opt,name=topk,proto3" json:"topk,omitempty"`
-	MetricType           string           `protobuf:"bytes,16,opt,name=metricType,proto3" json:"metricType,omitempty"`
-	IgnoreGrowing        bool             `protobuf:"varint,17,opt,name=ignoreGrowing,proto3" json:"ignoreGrowing,omitempty"`
-	Username             string           `protobuf:"bytes,18,opt,name=username,proto3" json:"username,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}         `json:"-"`
-	XXX_unrecognized     []byte           `json:"-"`
-	XXX_sizecache        int32            `json:"-"`
+	PlaceholderGroup   []byte           `protobuf:"bytes,7,opt,name=placeholder_group,json=placeholderGroup,proto3" json:"placeholder_group,omitempty"`
+	DslType            commonpb.DslType `protobuf:"varint,8,opt,name=dsl_type,json=dslType,proto3,enum=milvus.proto.common.DslType" json:"dsl_type,omitempty"`
+	SerializedExprPlan []byte           `protobuf:"bytes,9,opt,name=serialized_expr_plan,json=serializedExprPlan,proto3" json:"serialized_expr_plan,omitempty"`
+	OutputFieldsId     []int64          `protobuf:"varint,10,rep,packed,name=output_fields_id,json=outputFieldsId,proto3" json:"output_fields_id,omitempty"`
+	GuaranteeTimestamp uint64           `protobuf:"varint,12,opt,name=guarantee_timestamp,json=guaranteeTimestamp,proto3" json:"guarantee_timestamp,omitempty"`
+	TimeoutTimestamp   uint64           `protobuf:"varint,13,opt,name=timeout_timestamp,json=timeoutTimestamp,proto3" json:"timeout_timestamp,omitempty"`
+	Nq                 int64            `protobuf:"varint,14,opt,name=nq,proto3" json:"nq,omitempty"`
+	Topk               int64            `protobuf:"varint,15,opt,name=topk,proto3" json:"topk,omitempty"`
+	MetricType         string           `protobuf:"bytes,16,opt,name=metricType,proto3" json:"metricType,omitempty"`
+	IgnoreGrowing      bool             `protobuf:"varint,17,opt,name=ignoreGrowing,proto3" json:"ignoreGrowing,omitempty"`
+	Username           string           `protobuf:"bytes,18,opt,name=username,proto3" json:"username,omitempty"`
+	MvccTimestamp      uint64           `protobuf:"varint,19,opt,name=mvcc_timestamp,json=mvccTimestamp,proto3" json:"mvcc_timestamp,omitempty"`
+	GroupByFieldId     int64            `protobuf:"varint,20,opt,name=group_by_field_id,json=groupByFieldId,proto3" json:"group_by_field_id,omitempty"`
+	GroupSize          int64            `protobuf:"varint,21,opt,name=group_size,json=groupSize,proto3" json:"group_size,omitempty"`
+	// return the results of the completed segments if some segments exceed the segment timeout
+	AllowPartialResult bool `protobuf:"varint,22,opt,name=allow_partial_result,json=allowPartialResult,proto3" json:"allow_partial_result,omitempty"`
+	// in milliseconds, 0 means the default of QueryNode
+	SegmentTimeout int64 `protobuf:"varint,23,opt,name=segment_timeout,json=segmentTimeout,proto3" json:"segment_timeout,omitempty"`
+	// explain the executed plan of each segment instead of returning the results
+	Explain              bool     `protobuf:"varint,24,opt,name=explain,proto3" json:"explain,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
 func (m *SearchRequest) Reset()         { *m = SearchRequest{} }
@@ -900,6 +909,48 @@ func (m *SearchRequest) GetUsername() string {
 	return ""
 }
 
+func (m *SearchRequest) GetMvccTimestamp() uint64 {
+	if m != nil {
+		return m.MvccTimestamp
+	}
+	return 0
+}
+
+func (m *SearchRequest) GetGroupByFieldId() int64 {
+	if m != nil {
+		return m.GroupByFieldId
+	}
+	return 0
+}
+
+func (m *SearchRequest) GetGroupSize() int64 {
+	if m != nil {
+		return m.GroupSize
+	}
+	return 0
+}
+
+func (m *SearchRequest) GetAllowPartialResult() bool {
+	if m != nil {
+		return m.AllowPartialResult
+	}
+	return false
+}
+
+func (m *SearchRequest) GetSegmentTimeout() int64 {
+	if m != nil {
+		return m.SegmentTimeout
+	}
+	return 0
+}
+
+func (m *SearchRequest) GetExplain() bool {
+	if m != nil {
+		return m.Explain
+	}
+	return false
+}
+
 type SearchResults struct {
 	Base                     *commonpb.MsgBase `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
 	Status                   *commonpb.Status  `protobuf:"bytes,2,opt,name=status,proto3" json:"status,omitempty"`
@@ -915,10 +966,15 @@ type SearchResults struct {
 	SlicedNumCount int64  `protobuf:"varint,11,opt,name=sliced_num_count,json=slicedNumCount,proto3" json:"sliced_num_count,omitempty"`
 	SlicedOffset   int64  `protobuf:"varint,12,opt,name=sliced_offset,json=slicedOffset,proto3" json:"sliced_offset,omitempty"`
 	// search request cost
-	CostAggregation      *CostAggregation `protobuf:"bytes,13,opt,name=costAggregation,proto3" json:"costAggregation,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}         `json:"-"`
-	XXX_unrecognized     []byte           `json:"-"`
-	XXX_sizecache        int32            `json:"-"`
+	CostAggregation *CostAggregation `protobuf:"bytes,13,opt,name=costAggregation,proto3" json:"costAggregation,omitempty"`
+	// the results come from the completed segments only, the missed segments are not searched in time
+	Partial          bool    `protobuf:"varint,14,opt,name=partial,proto3" json:"partial,omitempty"`
+	MissedSegmentIDs []int64 `protobuf:"varint,15,rep,packed,name=missed_segmentIDs,json=missedSegmentIDs,proto3" json:"missed_segmentIDs,omitempty"`
+	// the executed plans of segments in json if explain requested
+	Explains             []string `protobuf:"bytes,16,rep,name=explains,proto3" json:"explains,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
 func (m *SearchResults) Reset()         { *m = SearchResults{} }
@@ -1037,6 +1093,27 @@ func (m *SearchResults) GetCostAggregation() *CostAggregation {
 	return nil
 }
 
+func (m *SearchResults) GetPartial() bool {
+	if m != nil {
+		return m.Partial
+	}
+	return false
+}
+
+func (m *SearchResults) GetMissedSegmentIDs() []int64 {
+	if m != nil {
+		return m.MissedSegmentIDs
+	}
+	return nil
+}
+
+func (m *SearchResults) GetExplains() []string {
+	if m != nil {
+		return m.Explains
+	}
+	return nil
+}
+
 type CostAggregation struct {
 	ResponseTime         int64    `protobuf:"varint,1,opt,name=responseTime,proto3" json:"responseTime,omitempty"`
 	ServiceTime          int64    `protobuf:"varint,2,opt,name=serviceTime,proto3" json:"serviceTime,omitempty"`
@@ -1109,9 +1186,11 @@ type RetrieveRequest struct {
 	IterationExtensionReduceRate int64             `protobuf:"varint,14,opt,name=iteration_extension_reduce_rate,json=iterationExtensionReduceRate,proto3" json:"iteration_extension_reduce_rate,omitempty"`
 	Username                     string            `protobuf:"bytes,15,opt,name=username,proto3" json:"username,omitempty"`
 	ReduceStopForBest            bool              `protobuf:"varint,16,opt,name=reduce_stop_for_best,json=reduceStopForBest,proto3" json:"reduce_stop_for_best,omitempty"`
-	XXX_NoUnkeyedLiteral         struct{}          `json:"-"`
-	XXX_unrecognized             []byte            `json:"-"`
-	XXX_sizecache                int32             `json:"-"`
+	// explain the executed plan of each segment instead of returning the results
+	Explain              bool     `protobuf:"varint,17,opt,name=explain,proto3" json:"explain,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
 func (m *RetrieveRequest) Reset()         { *m = RetrieveRequest{} }
@@ -1251,6 +1330,13 @@ func (m *RetrieveRequest) GetReduceStopForBest() bool {
 	return false
 }
 
+func (m *RetrieveRequest) GetExplain() bool {
+	if m != nil {
+		return m.Explain
+	}
+	return false
+}
+
 type RetrieveResults struct {
 	Base                      *commonpb.MsgBase     `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
 	Status                    *commonpb.Status      `protobuf:"bytes,2,opt,name=status,proto3" json:"status,omitempty"`
@@ -1261,10 +1347,12 @@ type RetrieveResults struct {
 	ChannelIDsRetrieved       []string              `protobuf:"bytes,7,rep,name=channelIDs_retrieved,json=channelIDsRetrieved,proto3" json:"channelIDs_retrieved,omitempty"`
 	GlobalSealedSegmentIDs    []int64               `protobuf:"varint,8,rep,packed,name=global_sealed_segmentIDs,json=globalSealedSegmentIDs,proto3" json:"global_sealed_segmentIDs,omitempty"`
 	// query request cost
-	CostAggregation      *CostAggregation `protobuf:"bytes,13,opt,name=costAggregation,proto3" json:"costAggregation,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}         `json:"-"`
-	XXX_unrecognized     []byte           `json:"-"`
-	XXX_sizecache        int32            `json:"-"`
+	CostAggregation *CostAggregation `protobuf:"bytes,13,opt,name=costAggregation,proto3" json:"costAggregation,omitempty"`
+	// the executed plans of segments in json if explain requested
+	Explains             []string `protobuf:"bytes,14,rep,name=explains,proto3" json:"explains,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
 func (m *RetrieveResults) Reset()         { *m = RetrieveResults{} }
@@ -1355,6 +1443,13 @@ func (m *RetrieveResults) GetCostAggregation() *CostAggregation {
 	return nil
 }
 
+func (m *RetrieveResults) GetExplains() []string {
+	if m != nil {
+		return m.Explains
+	}
+	return nil
+}
+
 type LoadIndex struct {
 	Base                 *commonpb.MsgBase        `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
 	SegmentID            int64                    `protobuf:"varint,2,opt,name=segmentID,proto3" json:"segmentID,omitempty"`
@@ -2007,128 +2102,137 @@ func init() {
 func init() { proto.RegisterFile("internal.proto", fileDescriptor_41f4a519b878ee3b) }
 
 var fileDescriptor_41f4a519b878ee3b = []byte{
-	// 1966 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xd4, 0x58, 0xcb, 0x6e, 0x1c, 0xb9,
-	0x15, 0x4d, 0xf5, 0xbb, 0x6f, 0xb7, 0x5a, 0x2d, 0x5a, 0x76, 0xca, 0x8f, 0x19, 0x6b, 0x2a, 0x2f,
-	0x65, 0x82, 0xb1, 0x26, 0x1a, 0xcc, 0x38, 0x8b, 0x20, 0x81, 0xa5, 0xb2, 0x85, 0xc6, 0xb4, 0x1d,
-	0xb9, 0xda, 0x19, 0x20, 0xd9, 0x14, 0xd8, 0x55, 0x54, 0x8b, 0x71, 0x55, 0xb1, 0x44, 0xb2, 0x64,
-	0xcb, 0xbb, 0x00, 0xd9, 0x05, 0xc8, 0x2e, 0x9b, 0x00, 0xc9, 0x1f, 0x64, 0x3d, 0xc8, 0x2a, 0x7f,
-	0x90, 0x1f, 0xc9, 0x1f, 0xcc, 0x2a, 0xe0, 0xa3, 0xfa, 0xa5, 0x96, 0x22, 0xcb, 0x79, 0xcc, 0xec,
-	0x8a, 0xe7, 0x1e, 0x5e, 0x92, 0x97, 0x97, 0x87, 0x97, 0x05, 0x3d, 0x9a, 0x49, 0xc2, 0x33, 0x9c,
-	0x3c, 0xc8, 0x39, 0x93, 0x0c, 0xdd, 0x4c, 0x69, 0x72, 0x5a, 0x08, 0xd3, 0x7a, 0x50, 0x1a, 0xef,
-	0x74, 0x23, 0x96, 0xa6, 0x2c, 0x33, 0xf0, 0x9d, 0xae, 0x88, 0x8e, 0x49, 0x8a, 0x4d, 0xcb, 0xbb,
-	0x0b, 0xb7, 0x0f, 0x88, 0x7c, 0x41, 0x53, 0xf2, 0x82, 0x46, 0x2f, 0xf7, 0x8f, 0x71, 0x96, 0x91,
-	0x24, 0x20, 0x27, 0x05, 0x11, 0xd2, 0x7b, 0x0f, 0xee, 0x1e, 0x10, 0x39, 0x92, 0x58, 0x52, 0x21,
-	0x69, 0x24, 0x96, 0xcc, 0x37, 0xe1, 0xc6, 0x01, 0x91, 0x7e, 0xbc, 0x04, 0x7f, 0x01, 0xad, 0x67,
-	0x2c, 0x26, 0x83, 0xec, 0x88, 0xa1, 0xcf, 0xa0, 0x89, 0xe3, 0x98, 0x13, 0x21, 0x5c, 0x67, 0xcb,
-	0xd9, 0xee, 0xec, 0xde, 0x7b, 0xb0, 0x30, 0x47, 0x3b, 0xb3, 0x47, 0x86, 0x13, 0x94, 0x64, 0x84,
-	0xa0, 0xc6, 0x59, 0x42, 0xdc, 0xca, 0x96, 0xb3, 0xdd, 0x0e, 0xf4, 0xb7, 0xf7, 0x1b, 0x80, 0x41,
-	0x46, 0xe5, 0x21, 0xe6, 0x38, 0x15, 0xe8, 0x16, 0x34, 0x32, 0x35, 0x8a, 0xaf, 0x1d, 0x57, 0x03,
-	0xdb, 0x42, 0x3e, 0x74, 0x85, 0xc4, 0x5c, 0x86, 0xb9, 0xe6, 0xb9, 0x95, 0xad, 0xea, 0x76, 0x67,
-	0xf7, 0x83, 0x95, 0xc3, 0x7e, 0x4e, 0xce, 0xbe, 0xc0, 0x49, 0x41, 0x0e, 0x31, 0xe5, 0x41, 0x47,
-	0x77, 0x33, 0xde, 0xbd, 0x5f, 0x01, 0x8c, 0x24, 0xa7, 0xd9, 0x64, 0x48, 0x85, 0x54, 0x63, 0x9d,
-	0x2a, 0x9e, 0x5a, 0x44, 0x75, 0xbb, 0x1d, 0xd8, 0x16, 0xfa, 0x04, 0x1a, 0x42, 0x62, 0x59, 0x08,
-	0x3d, 0xcf, 0xce, 0xee, 0xdd, 0x95, 0xa3, 0x8c, 0x34, 0x25, 0xb0, 0x54, 0xef, 0xaf, 0x15, 0xd8,
-	0x5c, 0x88, 0xaa, 0x8d, 0x1b, 0xfa, 0x18, 0x6a, 0x63, 0x2c, 0xc8, 0xa5, 0x81, 0x7a, 0x2a, 0x26,
-	0x7b, 0x58, 0x90, 0x40, 0x33, 0x55, 0x94, 0xe2, 0xf1, 0xc0, 0xd7, 0xa3, 0x57, 0x03, 0xfd, 0x8d,
-	0x3c, 0xe8, 0x46, 0x2c, 0x49, 0x48, 0x24, 0x29, 0xcb, 0x06, 0xbe, 0x5b, 0xd5, 0xb6, 0x05, 0x4c,
-	0x71, 0x72, 0xcc, 0x25, 0x35, 0x4d, 0xe1, 0xd6, 0xb6, 0xaa, 0x8a, 0x33, 0x8f, 0xa1, 0x1f, 0x42,
-	0x5f, 0x72, 0x7c, 0x4a, 0x92, 0x50, 0xd2, 0x94, 0x08, 0x89, 0xd3, 0xdc, 0xad, 0x6f, 0x39, 0xdb,
-	0xb5, 0x60, 0xdd, 0xe0, 0x2f, 0x4a, 0x18, 0xed, 0xc0, 0x8d, 0x49, 0x81, 0x39, 0xce, 0x24, 0x21,
-	0x73, 0xec, 0x86, 0x66, 0xa3, 0xa9, 0x69, 0xd6, 0xe1, 0x47, 0xb0, 0xa1, 0x68, 0xac, 0x90, 0x73,
-	0xf4, 0xa6, 0xa6, 0xf7, 0xad, 0x61, 0x4a, 0xf6, 0xbe, 0x74, 0xe0, 0xe6, 0x52, 0xbc, 0x44, 0xce,
-	0x32, 0x41, 0xae, 0x11, 0xb0, 0xeb, 0x6c, 0x18, 0x7a, 0x08, 0x75, 0xf5, 0x25, 0xdc, 0xea, 0x55,
-	0x53, 0xc9, 0xf0, 0xbd, 0xbf, 0x38, 0x80, 0xf6, 0x39, 0xc1, 0x92, 0x3c, 0x4a, 0x28, 0x7e, 0x87,
-	0x7d, 0xfe, 0x36, 0x34, 0xe3, 0x71, 0x98, 0xe1, 0xb4, 0x3c, 0x10, 0x8d, 0x78, 0xfc, 0x0c, 0xa7,
-	0x04, 0xfd, 0x00, 0xd6, 0x67, 0x1b, 0x6b, 0x08, 0x55, 0x4d, 0xe8, 0xcd, 0x60, 0x4d, 0xdc, 0x84,
-	0x3a, 0x56, 0x73, 0x70, 0x6b, 0xda, 0x6c, 0x1a, 0x9e, 0x80, 0xbe, 0xcf, 0x59, 0xfe, 0xdf, 0x9a,
-	0xdd, 0x74, 0xd0, 0xea, 0xfc, 0xa0, 0x7f, 0x76, 0x60, 0xe3, 0x51, 0x22, 0x09, 0xff, 0x9a, 0x06,
-	0xe5, 0xef, 0x95, 0x72, 0xd7, 0x06, 0x59, 0x4c, 0x5e, 0xff, 0x3f, 0x27, 0xf8, 0x1e, 0xc0, 0x11,
-	0x25, 0x49, 0x6c, 0x38, 0x66, 0x96, 0x6d, 0x8d, 0x68, 0x73, 0x79, 0xfc, 0xeb, 0x97, 0x1c, 0xff,
-	0xc6, 0x8a, 0xe3, 0xef, 0x42, 0x53, 0x3b, 0x19, 0xf8, 0xfa, 0xd0, 0x55, 0x83, 0xb2, 0xa9, 0xc4,
-	0x93, 0xbc, 0x96, 0x1c, 0x97, 0xe2, 0xd9, 0xba, 0xb2, 0x78, 0xea, 0x6e, 0x56, 0x3c, 0xff, 0x59,
-	0x83, 0xb5, 0x11, 0xc1, 0x3c, 0x3a, 0xbe, 0x7e, 0xf0, 0x36, 0xa1, 0xce, 0xc9, 0xc9, 0x54, 0xdb,
-	0x4c, 0x63, 0xba, 0xe2, 0xea, 0x25, 0x2b, 0xae, 0x5d, 0x41, 0xf0, 0xea, 0x2b, 0x04, 0xaf, 0x0f,
-	0xd5, 0x58, 0x24, 0x3a, 0x60, 0xed, 0x40, 0x7d, 0x2a, 0x99, 0xca, 0x13, 0x1c, 0x91, 0x63, 0x96,
-	0xc4, 0x84, 0x87, 0x13, 0xce, 0x0a, 0x23, 0x53, 0xdd, 0xa0, 0x3f, 0x67, 0x38, 0x50, 0x38, 0x7a,
-	0x08, 0xad, 0x58, 0x24, 0xa1, 0x3c, 0xcb, 0x89, 0xdb, 0xda, 0x72, 0xb6, 0x7b, 0x17, 0x2c, 0xd3,
-	0x17, 0xc9, 0x8b, 0xb3, 0x9c, 0x04, 0xcd, 0xd8, 0x7c, 0xa0, 0x8f, 0x61, 0x53, 0x10, 0x4e, 0x71,
-	0x42, 0xdf, 0x90, 0x38, 0x24, 0xaf, 0x73, 0x1e, 0xe6, 0x09, 0xce, 0xdc, 0xb6, 0x1e, 0x08, 0xcd,
-	0x6c, 0x8f, 0x5f, 0xe7, 0xfc, 0x30, 0xc1, 0x19, 0xda, 0x86, 0x3e, 0x2b, 0x64, 0x5e, 0xc8, 0x50,
-	0xef, 0x9b, 0x08, 0x69, 0xec, 0x82, 0x5e, 0x51, 0xcf, 0xe0, 0x4f, 0x34, 0x3c, 0x88, 0x2f, 0x52,
-	0xe6, 0xee, 0xdb, 0x29, 0xf3, 0xda, 0x6a, 0x65, 0x46, 0x3d, 0xa8, 0x64, 0x27, 0x6e, 0x4f, 0xc7,
-	0xbb, 0x92, 0x9d, 0xa8, 0xdd, 0x91, 0x2c, 0x7f, 0xe9, 0xae, 0x9b, 0xdd, 0x51, 0xdf, 0xe8, 0x7d,
-	0x80, 0x94, 0x48, 0x4e, 0x23, 0xb5, 0x56, 0xb7, 0xaf, 0x83, 0x3b, 0x87, 0xa0, 0xef, 0xc2, 0x1a,
-	0x9d, 0x64, 0x8c, 0x93, 0x03, 0xce, 0x5e, 0xd1, 0x6c, 0xe2, 0x6e, 0x6c, 0x39, 0xdb, 0xad, 0x60,
-	0x11, 0x44, 0x77, 0xa0, 0x55, 0x08, 0x55, 0xcc, 0xa4, 0xc4, 0x45, 0xda, 0xc7, 0xb4, 0xed, 0xfd,
-	0x63, 0x2e, 0xdb, 0x44, 0x91, 0x48, 0xf1, 0xbf, 0xba, 0x17, 0xa6, 0x29, 0x5a, 0x9d, 0x4f, 0xd1,
-	0xfb, 0xd0, 0x31, 0xcb, 0x33, 0xa9, 0x50, 0x3b, 0xb7, 0xe2, 0xfb, 0xd0, 0xc9, 0x8a, 0x34, 0x3c,
-	0x29, 0x08, 0xa7, 0x44, 0xd8, 0xc3, 0x0b, 0x59, 0x91, 0x3e, 0x37, 0x08, 0xba, 0x01, 0x75, 0xc9,
-	0xf2, 0xf0, 0xa5, 0x3d, 0xbb, 0x2a, 0x8e, 0x9f, 0xa3, 0x9f, 0xc2, 0x1d, 0x41, 0x70, 0x42, 0xe2,
-	0x50, 0x90, 0x49, 0x4a, 0x32, 0x39, 0xf0, 0x45, 0x28, 0xf4, 0xb2, 0x49, 0xec, 0x36, 0xf5, 0xee,
-	0xbb, 0x86, 0x31, 0x9a, 0x12, 0x46, 0xd6, 0xae, 0xf2, 0x20, 0x32, 0x45, 0xda, 0x42, 0xb7, 0x96,
-	0xae, 0x66, 0xd0, 0xcc, 0x34, 0xed, 0xf0, 0x13, 0x70, 0x27, 0x09, 0x1b, 0xe3, 0x24, 0x3c, 0x37,
-	0xaa, 0xdb, 0xd6, 0x83, 0xdd, 0x32, 0xf6, 0xd1, 0xd2, 0x90, 0x6a, 0x79, 0x22, 0xa1, 0x11, 0x89,
-	0xc3, 0x71, 0xc2, 0xc6, 0x2e, 0xe8, 0x2c, 0x06, 0x03, 0xed, 0x25, 0x6c, 0xac, 0xb2, 0xd7, 0x12,
-	0x54, 0x18, 0x22, 0x56, 0x64, 0xd2, 0xed, 0xe8, 0x95, 0xf6, 0x0c, 0xfe, 0xac, 0x48, 0xf7, 0x15,
-	0x8a, 0xbe, 0x03, 0x6b, 0x96, 0xc9, 0x8e, 0x8e, 0x04, 0x91, 0x3a, 0x6f, 0xab, 0x41, 0xd7, 0x80,
-	0xbf, 0xd0, 0x18, 0x3a, 0x54, 0x62, 0x2a, 0xe4, 0xa3, 0xc9, 0x84, 0x93, 0x09, 0x56, 0x87, 0x59,
-	0xe7, 0x6b, 0x67, 0xf7, 0xfb, 0x0f, 0x56, 0x56, 0xc3, 0x0f, 0xf6, 0x17, 0xd9, 0xc1, 0x72, 0x77,
-	0xef, 0x04, 0xd6, 0x97, 0x38, 0x4a, 0x3f, 0xb8, 0xad, 0x3a, 0x54, 0xfa, 0xdb, 0x92, 0x73, 0x01,
-	0x43, 0x5b, 0xd0, 0x11, 0x84, 0x9f, 0xd2, 0xc8, 0x50, 0x8c, 0x6e, 0xcd, 0x43, 0x4a, 0x77, 0x25,
-	0x93, 0x38, 0x79, 0xf6, 0xdc, 0xa6, 0x4c, 0xd9, 0xf4, 0x7e, 0x5b, 0x87, 0xf5, 0x40, 0xa5, 0x08,
-	0x39, 0x25, 0xdf, 0x24, 0xcd, 0xbc, 0x48, 0xbb, 0x1a, 0x6f, 0xa5, 0x5d, 0xcd, 0x95, 0xda, 0xf5,
-	0x3d, 0xe8, 0xa5, 0xa7, 0x51, 0x34, 0xa7, 0x43, 0x2d, 0xad, 0x43, 0x6b, 0x0a, 0xfd, 0xb7, 0xc5,
-	0x67, 0xfb, 0xed, 0x24, 0x0e, 0x2e, 0x90, 0xb8, 0x4d, 0xa8, 0x27, 0x34, 0xa5, 0x65, 0x86, 0x9a,
-	0xc6, 0x79, 0xd1, 0xea, 0xae, 0x12, 0xad, 0xdb, 0xd0, 0xa2, 0xc2, 0x26, 0xf8, 0x9a, 0x26, 0x34,
-	0xa9, 0x30, 0x99, 0xfd, 0x18, 0xee, 0x53, 0x49, 0xb8, 0x4e, 0xae, 0x90, 0xbc, 0x96, 0x24, 0x13,
-	0xea, 0x8b, 0x93, 0xb8, 0x88, 0x48, 0xc8, 0xb1, 0x24, 0x56, 0x56, 0xef, 0x4d, 0x69, 0x8f, 0x4b,
-	0x56, 0xa0, 0x49, 0x01, 0x96, 0x64, 0x41, 0x16, 0xd7, 0x17, 0x65, 0x11, 0xed, 0xc0, 0xa6, 0x75,
-	0x27, 0x94, 0x9a, 0x1c, 0x31, 0x1e, 0x8e, 0x89, 0x90, 0x5a, 0x82, 0x5b, 0xc1, 0x86, 0xb1, 0x8d,
-	0x24, 0xcb, 0x9f, 0x30, 0xbe, 0xa7, 0x9e, 0x6d, 0x5f, 0x55, 0xe7, 0x73, 0xf0, 0x6b, 0xa0, 0xa4,
-	0x1f, 0x42, 0x95, 0xc6, 0xa6, 0x38, 0xeb, 0xec, 0xba, 0x8b, 0x7e, 0xec, 0x1b, 0x76, 0xe0, 0x8b,
-	0x40, 0x91, 0xd0, 0xcf, 0xa1, 0x63, 0xf3, 0x29, 0xc6, 0x12, 0xeb, 0x5c, 0xed, 0xec, 0xbe, 0xbf,
-	0xb2, 0x8f, 0x4e, 0x30, 0x1f, 0x4b, 0x1c, 0x98, 0xe2, 0x4a, 0xa8, 0x6f, 0xf4, 0x33, 0xb8, 0x7b,
-	0x5e, 0x5f, 0xb9, 0x0d, 0x47, 0xec, 0x36, 0x74, 0x8a, 0xde, 0x5e, 0x16, 0xd8, 0x32, 0x5e, 0x31,
-	0xfa, 0x31, 0x6c, 0xce, 0x29, 0xec, 0xac, 0x63, 0x53, 0x4b, 0xec, 0x9c, 0xfa, 0xce, 0xba, 0x5c,
-	0xa6, 0xb1, 0xad, 0x4b, 0x35, 0xf6, 0x3f, 0xaf, 0x79, 0x5f, 0x39, 0xd0, 0x1e, 0x32, 0x1c, 0xeb,
-	0x92, 0xf7, 0x1a, 0xdb, 0x7e, 0x0f, 0xda, 0xd3, 0xd9, 0x5b, 0xf9, 0x99, 0x01, 0xca, 0x3a, 0xad,
-	0x5a, 0x6d, 0xa9, 0x3b, 0x57, 0xc6, 0xce, 0x95, 0xa3, 0xb5, 0xc5, 0x72, 0xf4, 0x3e, 0x74, 0xa8,
-	0x9a, 0x50, 0x98, 0x63, 0x79, 0x6c, 0x14, 0xa8, 0x1d, 0x80, 0x86, 0x0e, 0x15, 0xa2, 0xea, 0xd5,
-	0x92, 0xa0, 0xeb, 0xd5, 0xc6, 0x95, 0xeb, 0x55, 0xeb, 0x44, 0xd7, 0xab, 0xbf, 0x73, 0x00, 0xf4,
-	0xc2, 0x55, 0x5a, 0x9e, 0x77, 0xea, 0x5c, 0xc7, 0xa9, 0x92, 0x46, 0x75, 0xbf, 0x71, 0x92, 0x60,
-	0x39, 0xdb, 0x5b, 0x61, 0x83, 0x83, 0xb2, 0x22, 0x0d, 0x8c, 0xc9, 0xee, 0xab, 0xf0, 0xfe, 0xe0,
-	0x00, 0xe8, 0xe4, 0x34, 0xd3, 0x58, 0xd6, 0x68, 0xe7, 0xf2, 0x4a, 0xbe, 0xb2, 0x18, 0xba, 0xbd,
-	0x32, 0x74, 0x97, 0x3c, 0x5d, 0xa7, 0xe9, 0x31, 0x5b, 0xbc, 0x8d, 0xae, 0xfe, 0xf6, 0xfe, 0xe8,
-	0x40, 0xd7, 0xce, 0xce, 0x4c, 0x69, 0x61, 0x97, 0x9d, 0xe5, 0x5d, 0xd6, 0x95, 0x4f, 0xca, 0xf8,
-	0x59, 0x28, 0xe8, 0x9b, 0xf2, 0x02, 0x04, 0x03, 0x8d, 0xe8, 0x1b, 0xa2, 0x04, 0x51, 0x87, 0x84,
-	0xbd, 0x12, 0xe5, 0x05, 0xa8, 0xc2, 0xc0, 0x5e, 0x09, 0x25, 0xca, 0x9c, 0x44, 0x24, 0x93, 0xc9,
-	0x59, 0x98, 0xb2, 0x98, 0x1e, 0x51, 0x12, 0xeb, 0x6c, 0x68, 0x05, 0xfd, 0xd2, 0xf0, 0xd4, 0xe2,
-	0xde, 0x97, 0xea, 0x5d, 0x6d, 0x0e, 0x54, 0xf9, 0xe3, 0xea, 0xa9, 0x98, 0x5c, 0x23, 0x6b, 0x55,
-	0x88, 0x8d, 0x1f, 0x95, 0x88, 0xe6, 0x5f, 0x51, 0x3b, 0x58, 0xc0, 0x54, 0x01, 0x3b, 0xbd, 0x26,
-	0x4c, 0x1c, 0x6b, 0xc1, 0x1c, 0xa2, 0x66, 0x1e, 0x93, 0x23, 0x5c, 0x24, 0xf3, 0xd7, 0x49, 0xcd,
-	0x5c, 0x27, 0xd6, 0xb0, 0xf0, 0x2f, 0xa3, 0xb7, 0xcf, 0x49, 0x4c, 0x32, 0x49, 0x71, 0xa2, 0xff,
-	0x90, 0xcd, 0x6b, 0xb8, 0xb3, 0xa4, 0xe1, 0x1f, 0x01, 0x22, 0x59, 0xc4, 0xcf, 0x72, 0x95, 0x41,
-	0x39, 0x16, 0xe2, 0x15, 0xe3, 0xb1, 0x7d, 0x4c, 0x6e, 0x4c, 0x2d, 0x87, 0xd6, 0x80, 0x6e, 0x41,
-	0x43, 0x92, 0x0c, 0x67, 0xd2, 0x9e, 0x31, 0xdb, 0xb2, 0x17, 0x91, 0x28, 0x72, 0xc2, 0x6d, 0x4c,
-	0x9b, 0x54, 0x8c, 0x54, 0x53, 0x3d, 0x45, 0xc5, 0x31, 0xde, 0xfd, 0xf4, 0xb3, 0x99, 0xfb, 0xba,
-	0x79, 0x8a, 0x1a, 0xb8, 0xf4, 0xed, 0x3d, 0x86, 0x8d, 0x21, 0x15, 0xf2, 0x90, 0x25, 0x34, 0x3a,
-	0xbb, 0x76, 0x89, 0xe2, 0xfd, 0xde, 0x01, 0x34, 0xef, 0xc7, 0xfe, 0xc9, 0x99, 0xdd, 0x1a, 0xce,
-	0xd5, 0x6f, 0x8d, 0x0f, 0xa0, 0x9b, 0x6b, 0x37, 0x21, 0xcd, 0x8e, 0x58, 0xb9, 0x7b, 0x1d, 0x83,
-	0xa9, 0xd8, 0x0a, 0xf5, 0x80, 0x56, 0xc1, 0x0c, 0x39, 0x4b, 0x88, 0xd9, 0xbc, 0x76, 0xd0, 0x56,
-	0x48, 0xa0, 0x00, 0x6f, 0x02, 0xb7, 0x47, 0xc7, 0xec, 0xd5, 0x3e, 0xcb, 0x8e, 0xe8, 0xa4, 0x30,
-	0xf7, 0xec, 0x3b, 0xfc, 0x91, 0x70, 0xa1, 0x99, 0x63, 0xa9, 0xce, 0x94, 0xdd, 0xa3, 0xb2, 0xe9,
-	0xfd, 0xc9, 0x81, 0x3b, 0xab, 0x46, 0x7a, 0x97, 0xe5, 0x1f, 0xc0, 0x5a, 0x64, 0xdc, 0x19, 0x6f,
-	0x57, 0xff, 0xd3, 0xb9, 0xd8, 0xcf, 0x7b, 0x0c, 0x35, 0x5d, 0x4d, 0xec, 0x40, 0x85, 0x4b, 0x3d,
-	0x83, 0xde, 0xee, 0xfd, 0x0b, 0x94, 0x42, 0x11, 0xf5, 0xf3, 0xb5, 0xc2, 0x25, 0xea, 0x82, 0xc3,
-	0xf5, 0x4a, 0x9d, 0xc0, 0xe1, 0x1f, 0xfe, 0xcd, 0x81, 0x56, 0x69, 0x46, 0x1b, 0xb0, 0xe6, 0xfb,
-	0xc3, 0xfd, 0xa9, 0x56, 0xf5, 0xbf, 0x85, 0xfa, 0xd0, 0xf5, 0xfd, 0xe1, 0x61, 0x59, 0x3e, 0xf6,
-	0x1d, 0xd4, 0x85, 0x96, 0xef, 0x0f, 0xb5, 0xf8, 0xf4, 0x2b, 0xb6, 0xf5, 0x24, 0x29, 0xc4, 0x71,
-	0xbf, 0x3a, 0x75, 0x90, 0xe6, 0xd8, 0x38, 0xa8, 0xa1, 0x35, 0x68, 0xfb, 0x4f, 0x87, 0x83, 0x4c,
-	0x10, 0x2e, 0xfb, 0x75, 0xdb, 0xf4, 0x49, 0x42, 0x24, 0xe9, 0x37, 0xd0, 0x3a, 0x74, 0xfc, 0xa7,
-	0xc3, 0xbd, 0x22, 0x79, 0xa9, 0xee, 0xb1, 0x7e, 0x53, 0xdb, 0x9f, 0x0f, 0xcd, 0x8b, 0xa6, 0xdf,
-	0xd2, 0xee, 0x9f, 0x0f, 0xd5, 0x1b, 0xeb, 0xac, 0xdf, 0xb6, 0x9d, 0x7f, 0x99, 0x6b, 0x5f, 0xb0,
-	0xf7, 0xf0, 0xd7, 0x9f, 0x4e, 0xa8, 0x3c, 0x2e, 0xc6, 0x2a, 0x5e, 0x3b, 0x66, 0xe9, 0x1f, 0x51,
-	0x66, 0xbf, 0x76, 0xca, 0xe5, 0xef, 0xe8, 0x68, 0x4c, 0x9b, 0xf9, 0x78, 0xdc, 0xd0, 0xc8, 0x27,
-	0xff, 0x0a, 0x00, 0x00, 0xff, 0xff, 0x39, 0x3a, 0x8f, 0x34, 0x8a, 0x17, 0x00, 0x00,
+	// 2106 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xd4, 0x58, 0x4b, 0x73, 0x1b, 0xb9,
+	0xf1, 0xff, 0x8f, 0xf8, 0x10, 0xd9, 0xa4, 0x28, 0x0a, 0x96, 0x77, 0xc7, 0xb2, 0x77, 0xad, 0xe5,
+	0x3f, 0x0f, 0xed, 0xa6, 0xd6, 0xda, 0x68, 0x6b, 0xd7, 0x39, 0xa4, 0x92, 0xb2, 0x44, 0x5b, 0xc5,
+	0x5a, 0xd9, 0x91, 0x87, 0xce, 0x56, 0x25, 0x97, 0x29, 0x90, 0x03, 0x51, 0x88, 0x67, 0x06, 0x23,
+	0x00, 0xa3, 0x87, 0xcf, 0xb9, 0xa5, 0x2a, 0xb7, 0xe4, 0x90, 0x54, 0xf2, 0x0d, 0x72, 0xc8, 0x29,
+	0x95, 0x53, 0x3e, 0x43, 0xbe, 0x4e, 0x4e, 0x29, 0x34, 0x30, 0x7c, 0x89, 0x52, 0xc9, 0x72, 0x1e,
+	0x9b, 0x1b, 0xfa, 0x81, 0x46, 0xa3, 0xd1, 0xfd, 0x6b, 0x00, 0xd0, 0xe2, 0xa9, 0x66, 0x32, 0xa5,
+	0xf1, 0xa3, 0x4c, 0x0a, 0x2d, 0xc8, 0xdd, 0x84, 0xc7, 0xa7, 0xb9, 0xb2, 0xd4, 0xa3, 0x42, 0xb8,
+	0xd1, 0x1c, 0x8a, 0x24, 0x11, 0xa9, 0x65, 0x6f, 0x34, 0xd5, 0xf0, 0x98, 0x25, 0xd4, 0x52, 0x9d,
+	0xfb, 0x70, 0x6f, 0x9f, 0xe9, 0x57, 0x3c, 0x61, 0xaf, 0xf8, 0xf0, 0xf5, 0xde, 0x31, 0x4d, 0x53,
+	0x16, 0x07, 0xec, 0x24, 0x67, 0x4a, 0x77, 0x3e, 0x80, 0xfb, 0xfb, 0x4c, 0xf7, 0x35, 0xd5, 0x5c,
+	0x69, 0x3e, 0x54, 0x73, 0xe2, 0xbb, 0x70, 0x67, 0x9f, 0xe9, 0x6e, 0x34, 0xc7, 0xfe, 0x1a, 0x6a,
+	0x2f, 0x44, 0xc4, 0x7a, 0xe9, 0x91, 0x20, 0x5f, 0xc2, 0x32, 0x8d, 0x22, 0xc9, 0x94, 0xf2, 0xbd,
+	0x4d, 0x6f, 0xab, 0xb1, 0xf3, 0xe0, 0xd1, 0x8c, 0x8f, 0xce, 0xb3, 0x27, 0x56, 0x27, 0x28, 0x94,
+	0x09, 0x81, 0xb2, 0x14, 0x31, 0xf3, 0x97, 0x36, 0xbd, 0xad, 0x7a, 0x80, 0xe3, 0xce, 0x2f, 0x00,
+	0x7a, 0x29, 0xd7, 0x87, 0x54, 0xd2, 0x44, 0x91, 0xf7, 0xa0, 0x9a, 0x9a, 0x55, 0xba, 0x68, 0xb8,
+	0x14, 0x38, 0x8a, 0x74, 0xa1, 0xa9, 0x34, 0x95, 0x3a, 0xcc, 0x50, 0xcf, 0x5f, 0xda, 0x2c, 0x6d,
+	0x35, 0x76, 0x3e, 0x5a, 0xb8, 0xec, 0x57, 0xec, 0xe2, 0x6b, 0x1a, 0xe7, 0xec, 0x90, 0x72, 0x19,
+	0x34, 0x70, 0x9a, 0xb5, 0xde, 0xf9, 0x19, 0x40, 0x5f, 0x4b, 0x9e, 0x8e, 0x0e, 0xb8, 0xd2, 0x66,
+	0xad, 0x53, 0xa3, 0x67, 0x36, 0x51, 0xda, 0xaa, 0x07, 0x8e, 0x22, 0x9f, 0x43, 0x55, 0x69, 0xaa,
+	0x73, 0x85, 0x7e, 0x36, 0x76, 0xee, 0x2f, 0x5c, 0xa5, 0x8f, 0x2a, 0x81, 0x53, 0xed, 0xfc, 0x69,
+	0x09, 0xd6, 0x67, 0xa2, 0xea, 0xe2, 0x46, 0x3e, 0x83, 0xf2, 0x80, 0x2a, 0x76, 0x6d, 0xa0, 0x9e,
+	0xab, 0xd1, 0x2e, 0x55, 0x2c, 0x40, 0x4d, 0x13, 0xa5, 0x68, 0xd0, 0xeb, 0xe2, 0xea, 0xa5, 0x00,
+	0xc7, 0xa4, 0x03, 0xcd, 0xa1, 0x88, 0x63, 0x36, 0xd4, 0x5c, 0xa4, 0xbd, 0xae, 0x5f, 0x42, 0xd9,
+	0x0c, 0xcf, 0xe8, 0x64, 0x54, 0x6a, 0x6e, 0x49, 0xe5, 0x97, 0x37, 0x4b, 0x46, 0x67, 0x9a, 0x47,
+	0x3e, 0x86, 0xb6, 0x96, 0xf4, 0x94, 0xc5, 0xa1, 0xe6, 0x09, 0x53, 0x9a, 0x26, 0x99, 0x5f, 0xd9,
+	0xf4, 0xb6, 0xca, 0xc1, 0xaa, 0xe5, 0xbf, 0x2a, 0xd8, 0x64, 0x1b, 0xee, 0x8c, 0x72, 0x2a, 0x69,
+	0xaa, 0x19, 0x9b, 0xd2, 0xae, 0xa2, 0x36, 0x19, 0x8b, 0x26, 0x13, 0xbe, 0x07, 0x6b, 0x46, 0x4d,
+	0xe4, 0x7a, 0x4a, 0x7d, 0x19, 0xd5, 0xdb, 0x4e, 0x30, 0x56, 0xee, 0xfc, 0xc5, 0x83, 0xbb, 0x73,
+	0xf1, 0x52, 0x99, 0x48, 0x15, 0xbb, 0x45, 0xc0, 0x6e, 0x73, 0x60, 0xe4, 0x31, 0x54, 0xcc, 0x48,
+	0xf9, 0xa5, 0x9b, 0xa6, 0x92, 0xd5, 0xef, 0xfc, 0xd1, 0x03, 0xb2, 0x27, 0x19, 0xd5, 0xec, 0x49,
+	0xcc, 0xe9, 0x3b, 0x9c, 0xf3, 0xfb, 0xb0, 0x1c, 0x0d, 0xc2, 0x94, 0x26, 0x45, 0x41, 0x54, 0xa3,
+	0xc1, 0x0b, 0x9a, 0x30, 0xf2, 0x5d, 0x58, 0x9d, 0x1c, 0xac, 0x55, 0x28, 0xa1, 0x42, 0x6b, 0xc2,
+	0x46, 0xc5, 0x75, 0xa8, 0x50, 0xe3, 0x83, 0x5f, 0x46, 0xb1, 0x25, 0x3a, 0x0a, 0xda, 0x5d, 0x29,
+	0xb2, 0x7f, 0x97, 0x77, 0xe3, 0x45, 0x4b, 0xd3, 0x8b, 0xfe, 0xc1, 0x83, 0xb5, 0x27, 0xb1, 0x66,
+	0xf2, 0x1b, 0x1a, 0x94, 0xbf, 0x2d, 0x15, 0xa7, 0xd6, 0x4b, 0x23, 0x76, 0xfe, 0xdf, 0x74, 0xf0,
+	0x03, 0x80, 0x23, 0xce, 0xe2, 0xc8, 0xea, 0x58, 0x2f, 0xeb, 0xc8, 0x41, 0x71, 0x51, 0xfe, 0x95,
+	0x6b, 0xca, 0xbf, 0xba, 0xa0, 0xfc, 0x7d, 0x58, 0x46, 0x23, 0xbd, 0x2e, 0x16, 0x5d, 0x29, 0x28,
+	0x48, 0x03, 0x9e, 0xec, 0x5c, 0x4b, 0x5a, 0x80, 0x67, 0xed, 0xc6, 0xe0, 0x89, 0xd3, 0x1c, 0x78,
+	0xfe, 0xbd, 0x0a, 0x2b, 0x7d, 0x46, 0xe5, 0xf0, 0xf8, 0xf6, 0xc1, 0x5b, 0x87, 0x8a, 0x64, 0x27,
+	0x63, 0x6c, 0xb3, 0xc4, 0x78, 0xc7, 0xa5, 0x6b, 0x76, 0x5c, 0xbe, 0x01, 0xe0, 0x55, 0x16, 0x00,
+	0x5e, 0x1b, 0x4a, 0x91, 0x8a, 0x31, 0x60, 0xf5, 0xc0, 0x0c, 0x0d, 0x4c, 0x65, 0x31, 0x1d, 0xb2,
+	0x63, 0x11, 0x47, 0x4c, 0x86, 0x23, 0x29, 0x72, 0x0b, 0x53, 0xcd, 0xa0, 0x3d, 0x25, 0xd8, 0x37,
+	0x7c, 0xf2, 0x18, 0x6a, 0x91, 0x8a, 0x43, 0x7d, 0x91, 0x31, 0xbf, 0xb6, 0xe9, 0x6d, 0xb5, 0xae,
+	0xd8, 0x66, 0x57, 0xc5, 0xaf, 0x2e, 0x32, 0x16, 0x2c, 0x47, 0x76, 0x40, 0x3e, 0x83, 0x75, 0xc5,
+	0x24, 0xa7, 0x31, 0x7f, 0xc3, 0xa2, 0x90, 0x9d, 0x67, 0x32, 0xcc, 0x62, 0x9a, 0xfa, 0x75, 0x5c,
+	0x88, 0x4c, 0x64, 0x4f, 0xcf, 0x33, 0x79, 0x18, 0xd3, 0x94, 0x6c, 0x41, 0x5b, 0xe4, 0x3a, 0xcb,
+	0x75, 0x88, 0xe7, 0xa6, 0x42, 0x1e, 0xf9, 0x80, 0x3b, 0x6a, 0x59, 0xfe, 0x33, 0x64, 0xf7, 0xa2,
+	0xab, 0x90, 0xb9, 0xf9, 0x76, 0xc8, 0xbc, 0xb2, 0x18, 0x99, 0x49, 0x0b, 0x96, 0xd2, 0x13, 0xbf,
+	0x85, 0xf1, 0x5e, 0x4a, 0x4f, 0xcc, 0xe9, 0x68, 0x91, 0xbd, 0xf6, 0x57, 0xed, 0xe9, 0x98, 0x31,
+	0xf9, 0x10, 0x20, 0x61, 0x5a, 0xf2, 0xa1, 0xd9, 0xab, 0xdf, 0xc6, 0xe0, 0x4e, 0x71, 0xc8, 0xb7,
+	0x60, 0x85, 0x8f, 0x52, 0x21, 0xd9, 0xbe, 0x14, 0x67, 0x3c, 0x1d, 0xf9, 0x6b, 0x9b, 0xde, 0x56,
+	0x2d, 0x98, 0x65, 0x92, 0x0d, 0xa8, 0xe5, 0xca, 0x5c, 0x66, 0x12, 0xe6, 0x13, 0xb4, 0x31, 0xa6,
+	0xc9, 0xb7, 0xa1, 0x95, 0x9c, 0x0e, 0x87, 0x53, 0xfe, 0xde, 0x41, 0x7f, 0x57, 0x0c, 0x77, 0xe2,
+	0xec, 0xc7, 0xb0, 0x86, 0x07, 0x18, 0x0e, 0x2e, 0x6c, 0xd8, 0x4c, 0xd4, 0xd6, 0xd1, 0xd3, 0x16,
+	0x0a, 0x76, 0x2f, 0x30, 0x6c, 0xbd, 0xc8, 0x94, 0x9d, 0x55, 0x55, 0xfc, 0x0d, 0xf3, 0xef, 0xa2,
+	0x4e, 0x1d, 0x39, 0x7d, 0xfe, 0x06, 0x0f, 0x8c, 0xc6, 0xb1, 0x38, 0x0b, 0x31, 0x7d, 0x68, 0x1c,
+	0x4a, 0xa6, 0xf2, 0x58, 0xfb, 0xef, 0xa1, 0xe7, 0x04, 0x65, 0x87, 0x56, 0x14, 0xa0, 0xc4, 0x14,
+	0xbc, 0x62, 0xa3, 0x84, 0xa5, 0x36, 0xaa, 0x22, 0xd7, 0xfe, 0xfb, 0x76, 0x65, 0xc7, 0x7e, 0x65,
+	0xb9, 0xa6, 0x32, 0xd9, 0x79, 0x16, 0x53, 0x9e, 0xfa, 0x3e, 0x5a, 0x2b, 0xc8, 0xce, 0x9f, 0x2b,
+	0x93, 0x9a, 0x32, 0x36, 0xd5, 0x7f, 0xaa, 0xfb, 0x8d, 0x0b, 0xb1, 0x34, 0x5d, 0x88, 0x0f, 0xa1,
+	0x61, 0x0f, 0xd1, 0x26, 0x7c, 0xf9, 0xd2, 0xb9, 0x3e, 0x84, 0x46, 0x9a, 0x27, 0xe1, 0x49, 0xce,
+	0x24, 0x67, 0xca, 0x41, 0x14, 0xa4, 0x79, 0xf2, 0xd2, 0x72, 0xc8, 0x1d, 0xa8, 0x68, 0x91, 0x85,
+	0xaf, 0xfd, 0xea, 0x38, 0x5b, 0xbe, 0x22, 0x3f, 0x84, 0x0d, 0xc5, 0x68, 0xcc, 0xa2, 0xd0, 0x05,
+	0xa6, 0xd7, 0x55, 0xa1, 0xc2, 0x6d, 0xb3, 0xc8, 0x5f, 0xc6, 0x1c, 0xf7, 0xad, 0x46, 0x7f, 0xac,
+	0xd0, 0x77, 0x72, 0x93, 0xed, 0x43, 0x7b, 0x15, 0x9d, 0x99, 0x56, 0xc3, 0x3b, 0x1b, 0x99, 0x88,
+	0xc6, 0x13, 0x7e, 0x00, 0xfe, 0x28, 0x16, 0x03, 0x1a, 0x87, 0x97, 0x56, 0xf5, 0xeb, 0xb8, 0xd8,
+	0x7b, 0x56, 0xde, 0x9f, 0x5b, 0xd2, 0x6c, 0x4f, 0xc5, 0x7c, 0xc8, 0xa2, 0x70, 0x10, 0x8b, 0x81,
+	0x0f, 0x58, 0xab, 0x60, 0x59, 0xbb, 0xb1, 0x18, 0x98, 0x1a, 0x75, 0x0a, 0x26, 0x0c, 0x43, 0x91,
+	0xa7, 0xda, 0x6f, 0xb8, 0x33, 0x47, 0xfe, 0x8b, 0x3c, 0xd9, 0x33, 0x5c, 0xf2, 0xff, 0xb0, 0xe2,
+	0x34, 0xc5, 0xd1, 0x91, 0x62, 0x1a, 0xab, 0xb3, 0x14, 0x34, 0x2d, 0xf3, 0x27, 0xc8, 0x23, 0x87,
+	0xa6, 0x65, 0x28, 0xfd, 0x64, 0x34, 0x92, 0x6c, 0x44, 0x0d, 0x64, 0x61, 0x55, 0x36, 0x76, 0xbe,
+	0xf3, 0x68, 0xe1, 0x9d, 0xff, 0xd1, 0xde, 0xac, 0x76, 0x30, 0x3f, 0xdd, 0xa4, 0x9a, 0xcb, 0x5f,
+	0xac, 0xe0, 0x5a, 0x50, 0x90, 0x06, 0x03, 0x12, 0xae, 0xd4, 0x6c, 0x38, 0x56, 0x31, 0x1c, 0x6d,
+	0x2b, 0x98, 0x0a, 0xc4, 0x06, 0xd4, 0x5c, 0x8a, 0x2a, 0xbf, 0x8d, 0x81, 0x1e, 0xd3, 0x9d, 0x13,
+	0x58, 0x9d, 0x73, 0xc3, 0x00, 0xb1, 0x74, 0xd7, 0x37, 0x93, 0xf3, 0xee, 0xee, 0x3e, 0xc3, 0x23,
+	0x9b, 0xd0, 0x50, 0x4c, 0x9e, 0xf2, 0xa1, 0x55, 0xb1, 0x0d, 0x60, 0x9a, 0x65, 0x7c, 0xd7, 0x42,
+	0xd3, 0xf8, 0xc5, 0x4b, 0x97, 0x95, 0x05, 0xd9, 0xf9, 0x7d, 0x05, 0x56, 0x03, 0x93, 0x85, 0xec,
+	0x94, 0xfd, 0x2f, 0x35, 0x9f, 0xab, 0x9a, 0x40, 0xf5, 0xad, 0x9a, 0xc0, 0xf2, 0xc2, 0x26, 0x70,
+	0x19, 0x20, 0x6b, 0x8b, 0x00, 0xf2, 0x8a, 0x5e, 0x51, 0x7f, 0xbb, 0x5e, 0x01, 0x57, 0xf4, 0x8a,
+	0x75, 0xa8, 0xc4, 0x3c, 0xe1, 0x45, 0x11, 0x58, 0xe2, 0x32, 0xfa, 0x37, 0x17, 0xa1, 0xff, 0x3d,
+	0xa8, 0x71, 0xe5, 0x6a, 0x68, 0xc5, 0xe6, 0x2a, 0x57, 0xb6, 0x78, 0x9e, 0xc2, 0x43, 0xae, 0x99,
+	0xc4, 0xe4, 0x0a, 0xd9, 0xb9, 0x66, 0xa9, 0x32, 0x23, 0xc9, 0xa2, 0x7c, 0xc8, 0x42, 0x49, 0x35,
+	0x73, 0xfd, 0xe9, 0xc1, 0x58, 0xed, 0x69, 0xa1, 0x15, 0xa0, 0x52, 0x40, 0x35, 0x9b, 0xe9, 0x2f,
+	0xab, 0x73, 0xfd, 0x65, 0x1b, 0xd6, 0x9d, 0x39, 0x65, 0x00, 0xeb, 0x48, 0xc8, 0x70, 0xc0, 0x94,
+	0xc6, 0x5e, 0x56, 0x0b, 0xd6, 0xac, 0xac, 0xaf, 0x45, 0xf6, 0x4c, 0xc8, 0x5d, 0xa6, 0x66, 0x40,
+	0x7c, 0x6d, 0x16, 0xc4, 0x7f, 0x5b, 0x9e, 0xce, 0xce, 0x6f, 0x00, 0x8c, 0x7f, 0x02, 0x25, 0x1e,
+	0xd9, 0xfb, 0x6f, 0x63, 0xc7, 0x9f, 0xb5, 0xe3, 0xbe, 0x09, 0x7a, 0x5d, 0x15, 0x18, 0x25, 0xf2,
+	0x63, 0x68, 0xb8, 0x4c, 0x8b, 0xa8, 0xa6, 0x98, 0xc5, 0x8d, 0x9d, 0x0f, 0x17, 0xce, 0xc1, 0xd4,
+	0xeb, 0x52, 0x4d, 0x03, 0x7b, 0x7f, 0x55, 0x66, 0x4c, 0x7e, 0x04, 0xf7, 0x2f, 0x83, 0xbb, 0x74,
+	0xe1, 0x88, 0xfc, 0x2a, 0x26, 0xef, 0xbd, 0x79, 0x74, 0x2f, 0xe2, 0x15, 0x91, 0xef, 0xc3, 0xfa,
+	0x14, 0xbc, 0x4f, 0x26, 0x2e, 0x23, 0xec, 0x4c, 0x41, 0xff, 0x64, 0xca, 0x75, 0x00, 0x5f, 0xbb,
+	0x16, 0xe0, 0xff, 0xf5, 0x80, 0x3b, 0x8d, 0x94, 0xad, 0x39, 0xa4, 0xfc, 0x87, 0x07, 0xf5, 0x03,
+	0x41, 0x23, 0x7c, 0x71, 0xdc, 0x22, 0x25, 0x1e, 0x40, 0x7d, 0xbc, 0x33, 0x07, 0x5a, 0x13, 0x86,
+	0x91, 0x8e, 0x1f, 0x0d, 0xee, 0xa5, 0x31, 0x61, 0x4c, 0xbf, 0x06, 0xca, 0xb3, 0xaf, 0x81, 0x87,
+	0xd0, 0xe0, 0xc6, 0xa1, 0x30, 0xa3, 0xfa, 0xd8, 0xe2, 0x56, 0x3d, 0x00, 0x64, 0x1d, 0x1a, 0x8e,
+	0x79, 0x2e, 0x14, 0x0a, 0xf8, 0x5c, 0xa8, 0xde, 0xf8, 0xb9, 0xe0, 0x8c, 0xe0, 0x73, 0xe1, 0x97,
+	0x9e, 0xf9, 0xd8, 0x89, 0xd8, 0xb9, 0x49, 0xd9, 0xcb, 0x46, 0xbd, 0xdb, 0x18, 0x35, 0x80, 0x6a,
+	0x1a, 0xaf, 0x64, 0x31, 0xd5, 0x93, 0x73, 0x57, 0x2e, 0x38, 0x24, 0xcd, 0x93, 0xc0, 0x8a, 0xdc,
+	0x99, 0xab, 0xce, 0xaf, 0x3d, 0x00, 0x4c, 0x5c, 0xeb, 0xc6, 0x3c, 0xb2, 0x7b, 0xd7, 0x3f, 0xa4,
+	0x96, 0x66, 0x43, 0xb7, 0x5b, 0x84, 0xee, 0x9a, 0x9f, 0x83, 0x71, 0xea, 0x4c, 0x36, 0xef, 0xa2,
+	0x8b, 0xe3, 0xce, 0x6f, 0x3c, 0x68, 0x3a, 0xef, 0xac, 0x4b, 0x33, 0xa7, 0xec, 0xcd, 0x9f, 0x32,
+	0x5e, 0xc9, 0x12, 0x21, 0x2f, 0xec, 0xb5, 0xd5, 0x3a, 0x04, 0x96, 0x85, 0xf7, 0xd6, 0x7b, 0x50,
+	0xc3, 0x90, 0x88, 0x33, 0x55, 0xb4, 0x4d, 0x13, 0x06, 0x71, 0xa6, 0x0c, 0x94, 0x4b, 0x36, 0x64,
+	0xa9, 0x8e, 0x2f, 0xc2, 0x44, 0x44, 0xfc, 0x88, 0xb3, 0x08, 0xb3, 0xa1, 0x16, 0xb4, 0x0b, 0xc1,
+	0x73, 0xc7, 0x37, 0x1f, 0x32, 0xc4, 0x7d, 0xf9, 0x15, 0xff, 0x86, 0xcf, 0xd5, 0xe8, 0x16, 0x59,
+	0x6b, 0x42, 0x6c, 0xed, 0x98, 0x44, 0xb4, 0x5f, 0x75, 0xf5, 0x60, 0x86, 0x67, 0xde, 0x0f, 0xe3,
+	0xe6, 0x62, 0xe3, 0x58, 0x0e, 0xa6, 0x38, 0xc6, 0xf3, 0x88, 0x1d, 0xd1, 0x3c, 0x9e, 0x6e, 0x42,
+	0x65, 0xdb, 0x84, 0x9c, 0x60, 0xe6, 0x2b, 0xa9, 0xb5, 0x27, 0x59, 0xc4, 0x52, 0x73, 0xd1, 0xc1,
+	0x0f, 0xca, 0x69, 0xe4, 0xf7, 0xe6, 0x90, 0xff, 0x53, 0x20, 0x2c, 0x1d, 0xca, 0x8b, 0xcc, 0x64,
+	0x50, 0x46, 0x95, 0x3a, 0x13, 0x32, 0x72, 0x6f, 0xf9, 0xb5, 0xb1, 0xe4, 0xd0, 0x09, 0xcc, 0x2f,
+	0xa1, 0x66, 0x29, 0x4d, 0xb5, 0xab, 0x31, 0x47, 0xb9, 0xf6, 0xa5, 0xf2, 0x8c, 0x49, 0x17, 0xd3,
+	0x65, 0xae, 0xfa, 0x86, 0xc4, 0x87, 0xc1, 0x31, 0xdd, 0xf9, 0xe2, 0xcb, 0x89, 0xf9, 0x8a, 0xfd,
+	0x09, 0xb0, 0xec, 0xc2, 0x76, 0xe7, 0x29, 0xac, 0x99, 0x9f, 0xc8, 0x43, 0x11, 0xf3, 0xe1, 0xc5,
+	0xad, 0x2f, 0x36, 0x9d, 0x5f, 0x79, 0x40, 0xa6, 0xed, 0xb8, 0x8f, 0xb4, 0x49, 0x47, 0xf1, 0x6e,
+	0xde, 0x51, 0x3e, 0x82, 0x66, 0x86, 0x66, 0x42, 0x9e, 0x1e, 0x89, 0xe2, 0xf4, 0x1a, 0x96, 0x67,
+	0x62, 0xab, 0xcc, 0x43, 0xca, 0x04, 0x33, 0x94, 0x22, 0x66, 0xf6, 0xf0, 0xea, 0x41, 0xdd, 0x70,
+	0x02, 0xc3, 0xe8, 0x8c, 0xe0, 0x5e, 0xff, 0x58, 0x9c, 0xed, 0x89, 0xf4, 0x88, 0x8f, 0x72, 0xdb,
+	0x9d, 0xdf, 0xe1, 0x43, 0x08, 0x6f, 0xb4, 0xda, 0xd4, 0x94, 0x3b, 0xa3, 0x82, 0xec, 0xfc, 0xce,
+	0x83, 0x8d, 0x45, 0x2b, 0xbd, 0xcb, 0xf6, 0xf7, 0x61, 0x65, 0x68, 0xcd, 0x59, 0x6b, 0x37, 0xff,
+	0x68, 0x9e, 0x9d, 0xd7, 0x79, 0x0a, 0x65, 0xbc, 0x83, 0x6c, 0xc3, 0x92, 0xd4, 0xe8, 0x41, 0x6b,
+	0xe7, 0xe1, 0x15, 0x48, 0x61, 0x14, 0xf1, 0xf7, 0x60, 0x49, 0x6a, 0xd2, 0x04, 0x4f, 0xe2, 0x4e,
+	0xbd, 0xc0, 0x93, 0x9f, 0xfc, 0xd5, 0x83, 0x5a, 0x21, 0x26, 0x6b, 0xb0, 0xd2, 0xed, 0x1e, 0xec,
+	0x8d, 0xb1, 0xaa, 0xfd, 0x7f, 0xa4, 0x0d, 0xcd, 0x6e, 0xf7, 0xe0, 0xb0, 0xb8, 0x74, 0xb6, 0x3d,
+	0xd2, 0x84, 0x5a, 0xb7, 0x7b, 0x80, 0xe0, 0xd3, 0x5e, 0x72, 0xd4, 0xb3, 0x38, 0x57, 0xc7, 0xed,
+	0xd2, 0xd8, 0x40, 0x92, 0x51, 0x6b, 0xa0, 0x4c, 0x56, 0xa0, 0xde, 0x7d, 0x7e, 0xd0, 0x4b, 0x15,
+	0x93, 0xba, 0x5d, 0x71, 0x64, 0x97, 0xc5, 0x4c, 0xb3, 0x76, 0x95, 0xac, 0x42, 0xa3, 0xfb, 0xfc,
+	0x60, 0x37, 0x8f, 0x5f, 0x9b, 0x3e, 0xd6, 0x5e, 0x46, 0xf9, 0xcb, 0x03, 0xfb, 0xd4, 0x6a, 0xd7,
+	0xd0, 0xfc, 0xcb, 0x03, 0xf3, 0xf8, 0xbb, 0x68, 0xd7, 0xdd, 0xe4, 0x9f, 0x66, 0x68, 0x0b, 0x76,
+	0x1f, 0xff, 0xfc, 0x8b, 0x11, 0xd7, 0xc7, 0xf9, 0xc0, 0xc4, 0x6b, 0xdb, 0x6e, 0xfd, 0x53, 0x2e,
+	0xdc, 0x68, 0xbb, 0xd8, 0xfe, 0x36, 0x46, 0x63, 0x4c, 0x66, 0x83, 0x41, 0x15, 0x39, 0x9f, 0xff,
+	0x73, 0x00, 0xa3, 0xe0, 0x70, 0x7a, 0x09, 0x19, 0x00, 0x00,
 }
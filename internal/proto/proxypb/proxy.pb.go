@@ -448,6 +448,234 @@ func (m *ListClientInfosResponse) GetClientInfos() []*commonpb.ClientInfo {
 	return nil
 }
 
+type ListSessionsRequest struct {
+	Base                 *commonpb.MsgBase `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
+	User                 string            `protobuf:"bytes,2,opt,name=user,proto3" json:"user,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
+	XXX_unrecognized     []byte            `json:"-"`
+	XXX_sizecache        int32             `json:"-"`
+}
+
+func (m *ListSessionsRequest) Reset()         { *m = ListSessionsRequest{} }
+func (m *ListSessionsRequest) String() string { return proto.CompactTextString(m) }
+func (*ListSessionsRequest) ProtoMessage()    {}
+func (*ListSessionsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_700b50b08ed8dbaf, []int{8}
+}
+
+func (m *ListSessionsRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ListSessionsRequest.Unmarshal(m, b)
+}
+func (m *ListSessionsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ListSessionsRequest.Marshal(b, m, deterministic)
+}
+func (m *ListSessionsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListSessionsRequest.Merge(m, src)
+}
+func (m *ListSessionsRequest) XXX_Size() int {
+	return xxx_messageInfo_ListSessionsRequest.Size(m)
+}
+func (m *ListSessionsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListSessionsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ListSessionsRequest proto.InternalMessageInfo
+
+func (m *ListSessionsRequest) GetBase() *commonpb.MsgBase {
+	if m != nil {
+		return m.Base
+	}
+	return nil
+}
+
+func (m *ListSessionsRequest) GetUser() string {
+	if m != nil {
+		return m.User
+	}
+	return ""
+}
+
+type SessionInfo struct {
+	Identifier           int64    `protobuf:"varint,1,opt,name=identifier,proto3" json:"identifier,omitempty"`
+	User                 string   `protobuf:"bytes,2,opt,name=user,proto3" json:"user,omitempty"`
+	SdkType              string   `protobuf:"bytes,3,opt,name=sdk_type,json=sdkType,proto3" json:"sdk_type,omitempty"`
+	SdkVersion           string   `protobuf:"bytes,4,opt,name=sdk_version,json=sdkVersion,proto3" json:"sdk_version,omitempty"`
+	Host                 string   `protobuf:"bytes,5,opt,name=host,proto3" json:"host,omitempty"`
+	LastActiveTime       int64    `protobuf:"varint,6,opt,name=last_active_time,json=lastActiveTime,proto3" json:"last_active_time,omitempty"`
+	IdleSeconds          int64    `protobuf:"varint,7,opt,name=idle_seconds,json=idleSeconds,proto3" json:"idle_seconds,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *SessionInfo) Reset()         { *m = SessionInfo{} }
+func (m *SessionInfo) String() string { return proto.CompactTextString(m) }
+func (*SessionInfo) ProtoMessage()    {}
+func (*SessionInfo) Descriptor() ([]byte, []int) {
+	return fileDescriptor_700b50b08ed8dbaf, []int{9}
+}
+
+func (m *SessionInfo) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_SessionInfo.Unmarshal(m, b)
+}
+func (m *SessionInfo) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_SessionInfo.Marshal(b, m, deterministic)
+}
+func (m *SessionInfo) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SessionInfo.Merge(m, src)
+}
+func (m *SessionInfo) XXX_Size() int {
+	return xxx_messageInfo_SessionInfo.Size(m)
+}
+func (m *SessionInfo) XXX_DiscardUnknown() {
+	xxx_messageInfo_SessionInfo.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SessionInfo proto.InternalMessageInfo
+
+func (m *SessionInfo) GetIdentifier() int64 {
+	if m != nil {
+		return m.Identifier
+	}
+	return 0
+}
+
+func (m *SessionInfo) GetUser() string {
+	if m != nil {
+		return m.User
+	}
+	return ""
+}
+
+func (m *SessionInfo) GetSdkType() string {
+	if m != nil {
+		return m.SdkType
+	}
+	return ""
+}
+
+func (m *SessionInfo) GetSdkVersion() string {
+	if m != nil {
+		return m.SdkVersion
+	}
+	return ""
+}
+
+func (m *SessionInfo) GetHost() string {
+	if m != nil {
+		return m.Host
+	}
+	return ""
+}
+
+func (m *SessionInfo) GetLastActiveTime() int64 {
+	if m != nil {
+		return m.LastActiveTime
+	}
+	return 0
+}
+
+func (m *SessionInfo) GetIdleSeconds() int64 {
+	if m != nil {
+		return m.IdleSeconds
+	}
+	return 0
+}
+
+type ListSessionsResponse struct {
+	Status               *commonpb.Status `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
+	Sessions             []*SessionInfo   `protobuf:"bytes,2,rep,name=sessions,proto3" json:"sessions,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}         `json:"-"`
+	XXX_unrecognized     []byte           `json:"-"`
+	XXX_sizecache        int32            `json:"-"`
+}
+
+func (m *ListSessionsResponse) Reset()         { *m = ListSessionsResponse{} }
+func (m *ListSessionsResponse) String() string { return proto.CompactTextString(m) }
+func (*ListSessionsResponse) ProtoMessage()    {}
+func (*ListSessionsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_700b50b08ed8dbaf, []int{10}
+}
+
+func (m *ListSessionsResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ListSessionsResponse.Unmarshal(m, b)
+}
+func (m *ListSessionsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ListSessionsResponse.Marshal(b, m, deterministic)
+}
+func (m *ListSessionsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListSessionsResponse.Merge(m, src)
+}
+func (m *ListSessionsResponse) XXX_Size() int {
+	return xxx_messageInfo_ListSessionsResponse.Size(m)
+}
+func (m *ListSessionsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListSessionsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ListSessionsResponse proto.InternalMessageInfo
+
+func (m *ListSessionsResponse) GetStatus() *commonpb.Status {
+	if m != nil {
+		return m.Status
+	}
+	return nil
+}
+
+func (m *ListSessionsResponse) GetSessions() []*SessionInfo {
+	if m != nil {
+		return m.Sessions
+	}
+	return nil
+}
+
+type KillSessionRequest struct {
+	Base                 *commonpb.MsgBase `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
+	Identifier           int64             `protobuf:"varint,2,opt,name=identifier,proto3" json:"identifier,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
+	XXX_unrecognized     []byte            `json:"-"`
+	XXX_sizecache        int32             `json:"-"`
+}
+
+func (m *KillSessionRequest) Reset()         { *m = KillSessionRequest{} }
+func (m *KillSessionRequest) String() string { return proto.CompactTextString(m) }
+func (*KillSessionRequest) ProtoMessage()    {}
+func (*KillSessionRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_700b50b08ed8dbaf, []int{11}
+}
+
+func (m *KillSessionRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_KillSessionRequest.Unmarshal(m, b)
+}
+func (m *KillSessionRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_KillSessionRequest.Marshal(b, m, deterministic)
+}
+func (m *KillSessionRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_KillSessionRequest.Merge(m, src)
+}
+func (m *KillSessionRequest) XXX_Size() int {
+	return xxx_messageInfo_KillSessionRequest.Size(m)
+}
+func (m *KillSessionRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_KillSessionRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_KillSessionRequest proto.InternalMessageInfo
+
+func (m *KillSessionRequest) GetBase() *commonpb.MsgBase {
+	if m != nil {
+		return m.Base
+	}
+	return nil
+}
+
+func (m *KillSessionRequest) GetIdentifier() int64 {
+	if m != nil {
+		return m.Identifier
+	}
+	return 0
+}
+
 func init() {
 	proto.RegisterType((*InvalidateCollMetaCacheRequest)(nil), "milvus.proto.proxy.InvalidateCollMetaCacheRequest")
 	proto.RegisterType((*InvalidateCredCacheRequest)(nil), "milvus.proto.proxy.InvalidateCredCacheRequest")
@@ -457,58 +685,75 @@ func init() {
 	proto.RegisterType((*SetRatesRequest)(nil), "milvus.proto.proxy.SetRatesRequest")
 	proto.RegisterType((*ListClientInfosRequest)(nil), "milvus.proto.proxy.ListClientInfosRequest")
 	proto.RegisterType((*ListClientInfosResponse)(nil), "milvus.proto.proxy.ListClientInfosResponse")
+	proto.RegisterType((*ListSessionsRequest)(nil), "milvus.proto.proxy.ListSessionsRequest")
+	proto.RegisterType((*SessionInfo)(nil), "milvus.proto.proxy.SessionInfo")
+	proto.RegisterType((*ListSessionsResponse)(nil), "milvus.proto.proxy.ListSessionsResponse")
+	proto.RegisterType((*KillSessionRequest)(nil), "milvus.proto.proxy.KillSessionRequest")
 }
 
 func init() { proto.RegisterFile("proxy.proto", fileDescriptor_700b50b08ed8dbaf) }
 
 var fileDescriptor_700b50b08ed8dbaf = []byte{
-	// 735 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x54, 0xdd, 0x4e, 0x1a, 0x5b,
-	0x14, 0x76, 0x44, 0x38, 0x9e, 0x05, 0x81, 0x64, 0xc7, 0x83, 0x1c, 0x3c, 0x2a, 0x19, 0x4f, 0x2a,
-	0xb1, 0x29, 0x54, 0x34, 0x69, 0xaf, 0xc5, 0x86, 0xd8, 0x56, 0x63, 0x87, 0xf6, 0xa6, 0x37, 0x66,
-	0x33, 0xb3, 0x94, 0x6d, 0x86, 0xbd, 0xc7, 0xd9, 0x1b, 0x5b, 0x2e, 0x9a, 0x26, 0x7d, 0x84, 0xbe,
-	0x40, 0x5f, 0xa3, 0xcf, 0xd1, 0x27, 0x6a, 0xe6, 0x87, 0x81, 0xc1, 0xad, 0xb4, 0x9a, 0xde, 0xb1,
-	0xf6, 0x7c, 0x6b, 0x7d, 0xdf, 0xfa, 0xe1, 0x83, 0xbc, 0xe7, 0x8b, 0x8f, 0xa3, 0x86, 0xe7, 0x0b,
-	0x25, 0x08, 0x19, 0x30, 0xf7, 0x7a, 0x28, 0xa3, 0xa8, 0x11, 0x7e, 0xa9, 0x16, 0x6c, 0x31, 0x18,
-	0x08, 0x1e, 0xbd, 0x55, 0x8b, 0x8c, 0x2b, 0xf4, 0x39, 0x75, 0xe3, 0xb8, 0x30, 0x9d, 0x61, 0x7e,
-	0x37, 0x60, 0xe3, 0x88, 0x5f, 0x53, 0x97, 0x39, 0x54, 0x61, 0x5b, 0xb8, 0xee, 0x31, 0x2a, 0xda,
-	0xa6, 0x76, 0x1f, 0x2d, 0xbc, 0x1a, 0xa2, 0x54, 0xe4, 0x29, 0x2c, 0xf5, 0xa8, 0xc4, 0x8a, 0x51,
-	0x33, 0xea, 0xf9, 0xd6, 0x7f, 0x8d, 0x14, 0x63, 0x4c, 0x75, 0x2c, 0x2f, 0x0e, 0xa8, 0x44, 0x2b,
-	0x44, 0x92, 0x55, 0xf8, 0xcb, 0xe9, 0x9d, 0x71, 0x3a, 0xc0, 0xca, 0x62, 0xcd, 0xa8, 0xff, 0x6d,
-	0xe5, 0x9c, 0xde, 0x09, 0x1d, 0x20, 0xd9, 0x86, 0x92, 0x2d, 0x5c, 0x17, 0x6d, 0xc5, 0x04, 0x8f,
-	0x00, 0x99, 0x10, 0x50, 0x9c, 0x3c, 0x87, 0x40, 0x13, 0x0a, 0x93, 0x97, 0xa3, 0xc3, 0xca, 0x52,
-	0xcd, 0xa8, 0x67, 0xac, 0xd4, 0x9b, 0x79, 0x09, 0xd5, 0x29, 0xe5, 0x3e, 0x3a, 0x0f, 0x54, 0x5d,
-	0x85, 0xe5, 0xa1, 0x0c, 0x26, 0x95, 0xc8, 0x4e, 0x62, 0xf3, 0x8b, 0x01, 0xe5, 0x77, 0xde, 0x9f,
-	0x27, 0x0a, 0xbe, 0x79, 0x54, 0xca, 0x0f, 0xc2, 0x77, 0xe2, 0xd1, 0x24, 0xb1, 0xf9, 0x19, 0xd6,
-	0x2d, 0x3c, 0xf7, 0x51, 0xf6, 0x4f, 0x85, 0xcb, 0xec, 0xd1, 0x11, 0x3f, 0x17, 0x0f, 0x94, 0x52,
-	0x86, 0x9c, 0xf0, 0xde, 0x8e, 0xbc, 0x48, 0x48, 0xd6, 0x8a, 0x23, 0xb2, 0x02, 0x59, 0xe1, 0xbd,
-	0xc2, 0x51, 0xac, 0x21, 0x0a, 0xcc, 0x1f, 0x06, 0x14, 0xdb, 0xc9, 0x0a, 0x2c, 0xaa, 0x90, 0x6c,
-	0x00, 0x4c, 0x96, 0x12, 0x12, 0x67, 0xac, 0xa9, 0x17, 0xb2, 0x0b, 0x59, 0x9f, 0x2a, 0x94, 0x95,
-	0xc5, 0x5a, 0xa6, 0x9e, 0x6f, 0xad, 0xa5, 0x35, 0x25, 0xa7, 0x19, 0xd4, 0xb2, 0x22, 0x24, 0x79,
-	0x06, 0x39, 0xa9, 0xc2, 0x9c, 0x4c, 0x2d, 0x53, 0x2f, 0xb6, 0x36, 0xd3, 0x39, 0x71, 0xf0, 0x66,
-	0x28, 0x14, 0xed, 0x06, 0x38, 0x2b, 0x86, 0x93, 0x7d, 0xc8, 0xda, 0xc2, 0x41, 0x59, 0x59, 0x0a,
-	0xf3, 0x36, 0xb4, 0xfd, 0xbf, 0xf0, 0x7d, 0xe1, 0xb7, 0x85, 0x83, 0x56, 0x04, 0x36, 0x3f, 0x41,
-	0xa9, 0x8b, 0x2a, 0x10, 0x20, 0xef, 0x3f, 0xc7, 0xe7, 0xe9, 0x36, 0xcd, 0xc6, 0xcd, 0xbf, 0x65,
-	0x23, 0x3d, 0xb9, 0xb8, 0x5b, 0xf3, 0x25, 0x94, 0x5f, 0x33, 0xa9, 0xda, 0x2e, 0x43, 0xae, 0x82,
-	0x8d, 0xde, 0x5f, 0x85, 0xf9, 0xd5, 0x80, 0xd5, 0x1b, 0xc5, 0xa4, 0x27, 0xb8, 0x44, 0xb2, 0x17,
-	0x4d, 0x75, 0x28, 0xe3, 0x7a, 0x6b, 0xda, 0x7a, 0xdd, 0x10, 0x62, 0xc5, 0x50, 0x72, 0x00, 0x05,
-	0x3b, 0xac, 0x75, 0xc6, 0x82, 0x62, 0x71, 0x77, 0x9b, 0xda, 0xd4, 0x09, 0xa9, 0x95, 0xb7, 0x27,
-	0x02, 0x5a, 0xdf, 0x96, 0x21, 0x7b, 0x1a, 0x0c, 0x80, 0xb8, 0x40, 0x3a, 0xa8, 0xda, 0x62, 0xe0,
-	0x09, 0x8e, 0x5c, 0x75, 0xa3, 0xad, 0x35, 0xb4, 0xeb, 0xbd, 0x09, 0x8c, 0xc7, 0x52, 0xfd, 0x5f,
-	0x8b, 0x9f, 0x01, 0x9b, 0x0b, 0xe4, 0x0a, 0x56, 0x3a, 0x18, 0x86, 0x4c, 0x2a, 0x66, 0xcb, 0x76,
-	0x9f, 0x72, 0x8e, 0x2e, 0x69, 0xdd, 0x72, 0x82, 0x3a, 0xf0, 0x98, 0x73, 0x4b, 0xcb, 0xd9, 0x55,
-	0x3e, 0xe3, 0x17, 0xe3, 0x09, 0x9b, 0x0b, 0xc4, 0x87, 0xf5, 0xb4, 0x97, 0x46, 0xeb, 0x4e, 0x1c,
-	0x75, 0x96, 0x3b, 0xba, 0x8b, 0xbb, 0xed, 0xb7, 0x7a, 0xd7, 0xa2, 0xcc, 0x05, 0x42, 0xa1, 0xd0,
-	0x41, 0x75, 0xe8, 0x8c, 0xdb, 0xdb, 0xb9, 0xbd, 0xbd, 0x04, 0xf4, 0x9b, 0x6d, 0x5d, 0xc2, 0xbf,
-	0x69, 0xa3, 0x45, 0xae, 0x18, 0x75, 0xa3, 0x96, 0x1a, 0x73, 0x5a, 0x9a, 0xb1, 0xcb, 0x79, 0xed,
-	0xf4, 0xe0, 0x9f, 0x89, 0xcf, 0x4e, 0xf3, 0xec, 0xe8, 0x78, 0xf4, 0x96, 0x3c, 0x8f, 0xe3, 0x12,
-	0xca, 0x7a, 0x1f, 0x25, 0xbb, 0x3a, 0x92, 0x3b, 0x3d, 0x77, 0x1e, 0x97, 0x03, 0xa5, 0x0e, 0xaa,
-	0xf0, 0xfe, 0x8f, 0x51, 0xf9, 0xcc, 0x96, 0xe4, 0xd1, 0x6d, 0x07, 0x1f, 0x03, 0xc6, 0x95, 0xb7,
-	0xe7, 0xe2, 0x92, 0x0d, 0x9d, 0xc0, 0xf2, 0xd8, 0xc3, 0xc8, 0x96, 0xae, 0x87, 0x19, 0x87, 0x9b,
-	0xa7, 0xda, 0x85, 0xd2, 0x8c, 0x8f, 0xe8, 0xe7, 0xaf, 0x77, 0xae, 0xea, 0xe3, 0x5f, 0xc2, 0x8e,
-	0xd5, 0x1f, 0xec, 0xbf, 0x6f, 0x5d, 0x30, 0xd5, 0x1f, 0xf6, 0x02, 0x1d, 0xcd, 0x28, 0xf5, 0x09,
-	0x13, 0xf1, 0xaf, 0xe6, 0xf8, 0x84, 0x9b, 0x61, 0xb5, 0x66, 0x58, 0xcd, 0xeb, 0xf5, 0x72, 0x61,
-	0xb8, 0xf7, 0x33, 0x00, 0x00, 0xff, 0xff, 0x0e, 0x7a, 0xad, 0xdf, 0x0f, 0x09, 0x00, 0x00,
+	// 941 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x55, 0xdf, 0x6e, 0x1b, 0x45,
+	0x17, 0xcf, 0xc6, 0xb1, 0xe3, 0x1e, 0x5b, 0x76, 0x35, 0x5f, 0x3e, 0x67, 0xeb, 0xd2, 0xc4, 0x6c,
+	0x11, 0xb5, 0x8a, 0x70, 0xa8, 0x5b, 0x09, 0x04, 0x12, 0x12, 0x71, 0x51, 0x14, 0x4a, 0xaa, 0xb2,
+	0x6e, 0xb8, 0xe0, 0xc6, 0x1a, 0xef, 0x9e, 0xc4, 0x93, 0xac, 0x77, 0xb6, 0x3b, 0xe3, 0x40, 0x90,
+	0x10, 0x12, 0x57, 0x5c, 0xf3, 0x02, 0xbc, 0x06, 0xcf, 0xc0, 0x25, 0x0f, 0xc0, 0xab, 0x80, 0x66,
+	0x76, 0x76, 0xed, 0x4d, 0x36, 0x31, 0x24, 0x62, 0xaf, 0xf6, 0x9c, 0xf9, 0x9d, 0x73, 0x7e, 0xe7,
+	0xcf, 0xcc, 0x81, 0x5a, 0x14, 0xf3, 0xef, 0xce, 0x7b, 0x51, 0xcc, 0x25, 0x27, 0x64, 0xca, 0x82,
+	0xb3, 0x99, 0x48, 0xa4, 0x9e, 0x3e, 0x69, 0xd7, 0x3d, 0x3e, 0x9d, 0xf2, 0x30, 0xd1, 0xb5, 0x1b,
+	0x2c, 0x94, 0x18, 0x87, 0x34, 0x30, 0x72, 0x7d, 0xd1, 0xc2, 0xf9, 0xcd, 0x82, 0xad, 0xfd, 0xf0,
+	0x8c, 0x06, 0xcc, 0xa7, 0x12, 0x07, 0x3c, 0x08, 0x0e, 0x50, 0xd2, 0x01, 0xf5, 0x26, 0xe8, 0xe2,
+	0x9b, 0x19, 0x0a, 0x49, 0x3e, 0x80, 0xb5, 0x31, 0x15, 0x68, 0x5b, 0x1d, 0xab, 0x5b, 0xeb, 0xbf,
+	0xd5, 0xcb, 0x45, 0x34, 0xa1, 0x0e, 0xc4, 0xf1, 0x2e, 0x15, 0xe8, 0x6a, 0x24, 0xd9, 0x84, 0x75,
+	0x7f, 0x3c, 0x0a, 0xe9, 0x14, 0xed, 0xd5, 0x8e, 0xd5, 0xbd, 0xe3, 0x56, 0xfc, 0xf1, 0x4b, 0x3a,
+	0x45, 0xf2, 0x08, 0x9a, 0x1e, 0x0f, 0x02, 0xf4, 0x24, 0xe3, 0x61, 0x02, 0x28, 0x69, 0x40, 0x63,
+	0xae, 0xd6, 0x40, 0x07, 0xea, 0x73, 0xcd, 0xfe, 0x73, 0x7b, 0xad, 0x63, 0x75, 0x4b, 0x6e, 0x4e,
+	0xe7, 0x9c, 0x40, 0x7b, 0x81, 0x79, 0x8c, 0xfe, 0x2d, 0x59, 0xb7, 0xa1, 0x3a, 0x13, 0x18, 0x2f,
+	0xd0, 0xce, 0x64, 0xe7, 0x27, 0x0b, 0x5a, 0x87, 0xd1, 0x7f, 0x1f, 0x48, 0x9d, 0x45, 0x54, 0x88,
+	0x6f, 0x79, 0xec, 0x9b, 0xd2, 0x64, 0xb2, 0xf3, 0x23, 0x3c, 0x70, 0xf1, 0x28, 0x46, 0x31, 0x79,
+	0xc5, 0x03, 0xe6, 0x9d, 0xef, 0x87, 0x47, 0xfc, 0x96, 0x54, 0x5a, 0x50, 0xe1, 0xd1, 0xeb, 0xf3,
+	0x28, 0x21, 0x52, 0x76, 0x8d, 0x44, 0x36, 0xa0, 0xcc, 0xa3, 0x17, 0x78, 0x6e, 0x38, 0x24, 0x82,
+	0xf3, 0x87, 0x05, 0x8d, 0x41, 0xd6, 0x02, 0x97, 0x4a, 0x24, 0x5b, 0x00, 0xf3, 0xa6, 0xe8, 0xc0,
+	0x25, 0x77, 0x41, 0x43, 0x9e, 0x40, 0x39, 0xa6, 0x12, 0x85, 0xbd, 0xda, 0x29, 0x75, 0x6b, 0xfd,
+	0xfb, 0x79, 0x4e, 0xd9, 0x68, 0x2a, 0x5f, 0x6e, 0x82, 0x24, 0x1f, 0x42, 0x45, 0x48, 0x6d, 0x53,
+	0xea, 0x94, 0xba, 0x8d, 0xfe, 0x76, 0xde, 0xc6, 0x08, 0x5f, 0xcd, 0xb8, 0xa4, 0x43, 0x85, 0x73,
+	0x0d, 0x9c, 0x3c, 0x83, 0xb2, 0xc7, 0x7d, 0x14, 0xf6, 0x9a, 0xb6, 0xdb, 0x2a, 0xcc, 0xff, 0xf3,
+	0x38, 0xe6, 0xf1, 0x80, 0xfb, 0xe8, 0x26, 0x60, 0xe7, 0x07, 0x68, 0x0e, 0x51, 0x2a, 0x02, 0xe2,
+	0xe6, 0x75, 0xfc, 0x28, 0x9f, 0xa6, 0xd3, 0xbb, 0x7c, 0x2d, 0x7b, 0xf9, 0xca, 0x99, 0x6c, 0x9d,
+	0x2f, 0xa0, 0xf5, 0x25, 0x13, 0x72, 0x10, 0x30, 0x0c, 0xa5, 0xea, 0xe8, 0xcd, 0x59, 0x38, 0xbf,
+	0x58, 0xb0, 0x79, 0xc9, 0x99, 0x88, 0x78, 0x28, 0x90, 0x3c, 0x4d, 0xaa, 0x3a, 0x13, 0xc6, 0xdf,
+	0xfd, 0x42, 0x7f, 0x43, 0x0d, 0x71, 0x0d, 0x94, 0xec, 0x42, 0xdd, 0xd3, 0xbe, 0x46, 0x4c, 0x39,
+	0x33, 0xd9, 0x6d, 0x17, 0x9a, 0xce, 0x83, 0xba, 0x35, 0x2f, 0xfb, 0x17, 0x0e, 0x87, 0xff, 0x29,
+	0x4e, 0x43, 0x14, 0x82, 0xf1, 0xf0, 0x16, 0x35, 0x26, 0xb0, 0xa6, 0xae, 0x89, 0xb9, 0x32, 0xfa,
+	0xff, 0x63, 0xf2, 0xfb, 0xa7, 0xcd, 0xaa, 0x75, 0xb7, 0x65, 0xff, 0x95, 0x7e, 0x96, 0xf3, 0xa7,
+	0x05, 0x35, 0x13, 0x4d, 0x31, 0x50, 0x23, 0xca, 0x7c, 0x0c, 0x25, 0x3b, 0x62, 0x18, 0xa7, 0x23,
+	0x3a, 0xd7, 0x14, 0xf9, 0x25, 0xf7, 0xa0, 0x2a, 0xfc, 0xd3, 0x91, 0x54, 0x37, 0x23, 0xb9, 0x02,
+	0xeb, 0xc2, 0x3f, 0xd5, 0x57, 0x63, 0x1b, 0x6a, 0xea, 0xe8, 0x0c, 0x63, 0x15, 0x41, 0xbf, 0x4c,
+	0x77, 0x5c, 0x10, 0xfe, 0xe9, 0xd7, 0x89, 0x46, 0xf9, 0x9b, 0x70, 0x21, 0xed, 0x72, 0xe2, 0x4f,
+	0xfd, 0x93, 0x2e, 0xdc, 0x0d, 0xa8, 0x90, 0x23, 0xea, 0x49, 0x76, 0x86, 0x23, 0xc9, 0xa6, 0x68,
+	0x57, 0x34, 0x93, 0x86, 0xd2, 0x7f, 0xa6, 0xd5, 0xaf, 0xd9, 0x14, 0xc9, 0xdb, 0x50, 0x67, 0x7e,
+	0x80, 0x23, 0x81, 0x1e, 0x0f, 0x7d, 0x61, 0xaf, 0x6b, 0x54, 0x4d, 0xe9, 0x86, 0x89, 0xca, 0xf9,
+	0xd9, 0x82, 0x8d, 0x7c, 0x49, 0x6f, 0xd3, 0xe3, 0x4f, 0xa0, 0x2a, 0x8c, 0xa3, 0xe2, 0xfe, 0x26,
+	0xd3, 0xbb, 0x50, 0x51, 0x37, 0x33, 0x70, 0xbe, 0x07, 0xf2, 0x82, 0x05, 0x81, 0x39, 0xbc, 0x79,
+	0x6f, 0xf3, 0x3d, 0x5a, 0xbd, 0xd8, 0xa3, 0xb4, 0xcf, 0x9b, 0x0b, 0x7d, 0xee, 0xff, 0x5a, 0x85,
+	0xf2, 0x2b, 0xc5, 0x8d, 0x04, 0x40, 0xf6, 0x50, 0x0e, 0xf8, 0x34, 0xe2, 0x21, 0x86, 0x72, 0x98,
+	0x3c, 0x07, 0xbd, 0xc2, 0x77, 0xe3, 0x32, 0xd0, 0xb0, 0x6e, 0xbf, 0x53, 0x88, 0xbf, 0x00, 0x76,
+	0x56, 0xc8, 0x1b, 0xd8, 0xd8, 0x43, 0x2d, 0x32, 0x21, 0x99, 0x27, 0x06, 0x13, 0x1a, 0x86, 0x18,
+	0x90, 0xfe, 0x15, 0x6f, 0x5b, 0x11, 0x38, 0x8d, 0xf9, 0xb0, 0x30, 0xe6, 0x50, 0xc6, 0x2c, 0x3c,
+	0x4e, 0xdb, 0xea, 0xac, 0x90, 0x18, 0x1e, 0xe4, 0x97, 0x74, 0xf2, 0x8e, 0x64, 0xab, 0x9a, 0xf4,
+	0x8b, 0x5a, 0x76, 0xfd, 0x5e, 0x6f, 0x5f, 0x37, 0x1d, 0xce, 0x0a, 0xa1, 0x50, 0xdf, 0x43, 0xf9,
+	0xdc, 0x4f, 0xd3, 0x7b, 0x7c, 0x75, 0x7a, 0x19, 0xe8, 0x5f, 0xa6, 0x75, 0x02, 0xf7, 0xf2, 0x1b,
+	0x5c, 0xf5, 0x9b, 0x06, 0x49, 0x4a, 0xbd, 0x25, 0x29, 0x5d, 0xd8, 0xc3, 0xcb, 0xd2, 0x19, 0xc3,
+	0xff, 0x0f, 0xa3, 0xa2, 0x38, 0x8f, 0x8b, 0xe2, 0x1c, 0x46, 0x37, 0x89, 0x71, 0x02, 0xad, 0xe2,
+	0x05, 0x4d, 0x9e, 0x14, 0x05, 0xb9, 0x76, 0x99, 0x2f, 0x8b, 0xe5, 0x43, 0x73, 0x0f, 0xa5, 0x9e,
+	0xff, 0x03, 0x94, 0x31, 0xf3, 0x04, 0x79, 0xf7, 0xaa, 0x81, 0x37, 0x80, 0xd4, 0xf3, 0xa3, 0xa5,
+	0xb8, 0xac, 0x43, 0x2f, 0xa1, 0x9a, 0x2e, 0x47, 0xf2, 0xb0, 0xf8, 0x59, 0xc8, 0xad, 0xce, 0x65,
+	0xac, 0x03, 0x68, 0x5e, 0x58, 0x50, 0xc5, 0xf5, 0x2f, 0x5e, 0x89, 0xed, 0xf7, 0xfe, 0x11, 0x36,
+	0x65, 0xbf, 0xfb, 0xec, 0x9b, 0xfe, 0x31, 0x93, 0x93, 0xd9, 0x58, 0xf1, 0xd8, 0x49, 0x4c, 0xdf,
+	0x67, 0xdc, 0xfc, 0xed, 0xa4, 0x23, 0xbc, 0xa3, 0xbd, 0xed, 0x68, 0x6f, 0xd1, 0x78, 0x5c, 0xd1,
+	0xe2, 0xd3, 0xbf, 0x07, 0x00, 0x8f, 0xc8, 0x98, 0x73, 0x68, 0x0b, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
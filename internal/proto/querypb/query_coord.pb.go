@@ -251,6 +251,31 @@ func (SyncType) EnumDescriptor() ([]byte, []int) {
 	return fileDescriptor_aab7cc9a69ed26e8, []int{6}
 }
 
+type LoadScheduleOperateType int32
+
+const (
+	LoadScheduleOperateType_PutLoadSchedule    LoadScheduleOperateType = 0
+	LoadScheduleOperateType_RemoveLoadSchedule LoadScheduleOperateType = 1
+)
+
+var LoadScheduleOperateType_name = map[int32]string{
+	0: "PutLoadSchedule",
+	1: "RemoveLoadSchedule",
+}
+
+var LoadScheduleOperateType_value = map[string]int32{
+	"PutLoadSchedule":    0,
+	"RemoveLoadSchedule": 1,
+}
+
+func (x LoadScheduleOperateType) String() string {
+	return proto.EnumName(LoadScheduleOperateType_name, int32(x))
+}
+
+func (LoadScheduleOperateType) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_aab7cc9a69ed26e8, []int{7}
+}
+
 type ShowCollectionsRequest struct {
 	Base *commonpb.MsgBase `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
 	// Not useful for now
@@ -515,6 +540,7 @@ type LoadCollectionRequest struct {
 	Refresh      bool            `protobuf:"varint,7,opt,name=refresh,proto3" json:"refresh,omitempty"`
 	// resource group names
 	ResourceGroups       []string `protobuf:"bytes,8,rep,name=resource_groups,json=resourceGroups,proto3" json:"resource_groups,omitempty"`
+	LoadPriority         int32    `protobuf:"varint,9,opt,name=load_priority,json=loadPriority,proto3" json:"load_priority,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
@@ -601,6 +627,13 @@ func (m *LoadCollectionRequest) GetResourceGroups() []string {
 	return nil
 }
 
+func (m *LoadCollectionRequest) GetLoadPriority() int32 {
+	if m != nil {
+		return m.LoadPriority
+	}
+	return 0
+}
+
 type ReleaseCollectionRequest struct {
 	Base                 *commonpb.MsgBase `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
 	DbID                 int64             `protobuf:"varint,2,opt,name=dbID,proto3" json:"dbID,omitempty"`
@@ -748,6 +781,7 @@ type LoadPartitionsRequest struct {
 	// resource group names
 	ResourceGroups       []string             `protobuf:"bytes,9,rep,name=resource_groups,json=resourceGroups,proto3" json:"resource_groups,omitempty"`
 	IndexInfoList        []*indexpb.IndexInfo `protobuf:"bytes,10,rep,name=index_info_list,json=indexInfoList,proto3" json:"index_info_list,omitempty"`
+	LoadPriority         int32                `protobuf:"varint,11,opt,name=load_priority,json=loadPriority,proto3" json:"load_priority,omitempty"`
 	XXX_NoUnkeyedLiteral struct{}             `json:"-"`
 	XXX_unrecognized     []byte               `json:"-"`
 	XXX_sizecache        int32                `json:"-"`
@@ -848,6 +882,13 @@ func (m *LoadPartitionsRequest) GetIndexInfoList() []*indexpb.IndexInfo {
 	return nil
 }
 
+func (m *LoadPartitionsRequest) GetLoadPriority() int32 {
+	if m != nil {
+		return m.LoadPriority
+	}
+	return 0
+}
+
 type ReleasePartitionsRequest struct {
 	Base                 *commonpb.MsgBase `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
 	DbID                 int64             `protobuf:"varint,2,opt,name=dbID,proto3" json:"dbID,omitempty"`
@@ -3419,15 +3460,19 @@ func (m *GetDataDistributionResponse) GetLeaderViews() []*LeaderView {
 }
 
 type LeaderView struct {
-	Collection           int64                        `protobuf:"varint,1,opt,name=collection,proto3" json:"collection,omitempty"`
-	Channel              string                       `protobuf:"bytes,2,opt,name=channel,proto3" json:"channel,omitempty"`
-	SegmentDist          map[int64]*SegmentDist       `protobuf:"bytes,3,rep,name=segment_dist,json=segmentDist,proto3" json:"segment_dist,omitempty" protobuf_key:"varint,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
-	GrowingSegmentIDs    []int64                      `protobuf:"varint,4,rep,packed,name=growing_segmentIDs,json=growingSegmentIDs,proto3" json:"growing_segmentIDs,omitempty"`
-	GrowingSegments      map[int64]*msgpb.MsgPosition `protobuf:"bytes,5,rep,name=growing_segments,json=growingSegments,proto3" json:"growing_segments,omitempty" protobuf_key:"varint,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
-	TargetVersion        int64                        `protobuf:"varint,6,opt,name=TargetVersion,proto3" json:"TargetVersion,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}                     `json:"-"`
-	XXX_unrecognized     []byte                       `json:"-"`
-	XXX_sizecache        int32                        `json:"-"`
+	Collection        int64                        `protobuf:"varint,1,opt,name=collection,proto3" json:"collection,omitempty"`
+	Channel           string                       `protobuf:"bytes,2,opt,name=channel,proto3" json:"channel,omitempty"`
+	SegmentDist       map[int64]*SegmentDist       `protobuf:"bytes,3,rep,name=segment_dist,json=segmentDist,proto3" json:"segment_dist,omitempty" protobuf_key:"varint,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	GrowingSegmentIDs []int64                      `protobuf:"varint,4,rep,packed,name=growing_segmentIDs,json=growingSegmentIDs,proto3" json:"growing_segmentIDs,omitempty"`
+	GrowingSegments   map[int64]*msgpb.MsgPosition `protobuf:"bytes,5,rep,name=growing_segments,json=growingSegments,proto3" json:"growing_segments,omitempty" protobuf_key:"varint,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	TargetVersion     int64                        `protobuf:"varint,6,opt,name=TargetVersion,proto3" json:"TargetVersion,omitempty"`
+	// the resource cost of the delegator, used to balance the segments
+	NumOfGrowingRows      int64    `protobuf:"varint,7,opt,name=num_of_growing_rows,json=numOfGrowingRows,proto3" json:"num_of_growing_rows,omitempty"`
+	NumOfDeleteBufferRows int64    `protobuf:"varint,8,opt,name=num_of_delete_buffer_rows,json=numOfDeleteBufferRows,proto3" json:"num_of_delete_buffer_rows,omitempty"`
+	DeleteForwardRate     float64  `protobuf:"fixed64,9,opt,name=delete_forward_rate,json=deleteForwardRate,proto3" json:"delete_forward_rate,omitempty"`
+	XXX_NoUnkeyedLiteral  struct{} `json:"-"`
+	XXX_unrecognized      []byte   `json:"-"`
+	XXX_sizecache         int32    `json:"-"`
 }
 
 func (m *LeaderView) Reset()         { *m = LeaderView{} }
@@ -3497,6 +3542,27 @@ func (m *LeaderView) GetTargetVersion() int64 {
 	return 0
 }
 
+func (m *LeaderView) GetNumOfGrowingRows() int64 {
+	if m != nil {
+		return m.NumOfGrowingRows
+	}
+	return 0
+}
+
+func (m *LeaderView) GetNumOfDeleteBufferRows() int64 {
+	if m != nil {
+		return m.NumOfDeleteBufferRows
+	}
+	return 0
+}
+
+func (m *LeaderView) GetDeleteForwardRate() float64 {
+	if m != nil {
+		return m.DeleteForwardRate
+	}
+	return 0
+}
+
 type SegmentDist struct {
 	NodeID               int64    `protobuf:"varint,1,opt,name=nodeID,proto3" json:"nodeID,omitempty"`
 	Version              int64    `protobuf:"varint,2,opt,name=version,proto3" json:"version,omitempty"`
@@ -3687,16 +3753,22 @@ func (m *ChannelVersionInfo) GetVersion() int64 {
 }
 
 type CollectionLoadInfo struct {
-	CollectionID         int64           `protobuf:"varint,1,opt,name=collectionID,proto3" json:"collectionID,omitempty"`
-	ReleasedPartitions   []int64         `protobuf:"varint,2,rep,packed,name=released_partitions,json=releasedPartitions,proto3" json:"released_partitions,omitempty"`
-	ReplicaNumber        int32           `protobuf:"varint,3,opt,name=replica_number,json=replicaNumber,proto3" json:"replica_number,omitempty"`
-	Status               LoadStatus      `protobuf:"varint,4,opt,name=status,proto3,enum=milvus.proto.query.LoadStatus" json:"status,omitempty"`
-	FieldIndexID         map[int64]int64 `protobuf:"bytes,5,rep,name=field_indexID,json=fieldIndexID,proto3" json:"field_indexID,omitempty" protobuf_key:"varint,1,opt,name=key,proto3" protobuf_val:"varint,2,opt,name=value,proto3"`
-	LoadType             LoadType        `protobuf:"varint,6,opt,name=load_type,json=loadType,proto3,enum=milvus.proto.query.LoadType" json:"load_type,omitempty"`
-	RecoverTimes         int32           `protobuf:"varint,7,opt,name=recover_times,json=recoverTimes,proto3" json:"recover_times,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}        `json:"-"`
-	XXX_unrecognized     []byte          `json:"-"`
-	XXX_sizecache        int32           `json:"-"`
+	CollectionID       int64           `protobuf:"varint,1,opt,name=collectionID,proto3" json:"collectionID,omitempty"`
+	ReleasedPartitions []int64         `protobuf:"varint,2,rep,packed,name=released_partitions,json=releasedPartitions,proto3" json:"released_partitions,omitempty"`
+	ReplicaNumber      int32           `protobuf:"varint,3,opt,name=replica_number,json=replicaNumber,proto3" json:"replica_number,omitempty"`
+	Status             LoadStatus      `protobuf:"varint,4,opt,name=status,proto3,enum=milvus.proto.query.LoadStatus" json:"status,omitempty"`
+	FieldIndexID       map[int64]int64 `protobuf:"bytes,5,rep,name=field_indexID,json=fieldIndexID,proto3" json:"field_indexID,omitempty" protobuf_key:"varint,1,opt,name=key,proto3" protobuf_val:"varint,2,opt,name=value,proto3"`
+	LoadType           LoadType        `protobuf:"varint,6,opt,name=load_type,json=loadType,proto3,enum=milvus.proto.query.LoadType" json:"load_type,omitempty"`
+	RecoverTimes       int32           `protobuf:"varint,7,opt,name=recover_times,json=recoverTimes,proto3" json:"recover_times,omitempty"`
+	LoadPriority       int32           `protobuf:"varint,8,opt,name=load_priority,json=loadPriority,proto3" json:"load_priority,omitempty"`
+	// the resource groups the replicas placed in, empty if the placement is not specified,
+	// has one name for all replicas or one name for each replica
+	ResourceGroups []string `protobuf:"bytes,9,rep,name=resource_groups,json=resourceGroups,proto3" json:"resource_groups,omitempty"`
+	// the collection is loaded without mmap even if mmap is enabled by querynode
+	MmapDisabled         bool     `protobuf:"varint,10,opt,name=mmap_disabled,json=mmapDisabled,proto3" json:"mmap_disabled,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
 func (m *CollectionLoadInfo) Reset()         { *m = CollectionLoadInfo{} }
@@ -3773,6 +3845,27 @@ func (m *CollectionLoadInfo) GetRecoverTimes() int32 {
 	return 0
 }
 
+func (m *CollectionLoadInfo) GetLoadPriority() int32 {
+	if m != nil {
+		return m.LoadPriority
+	}
+	return 0
+}
+
+func (m *CollectionLoadInfo) GetResourceGroups() []string {
+	if m != nil {
+		return m.ResourceGroups
+	}
+	return nil
+}
+
+func (m *CollectionLoadInfo) GetMmapDisabled() bool {
+	if m != nil {
+		return m.MmapDisabled
+	}
+	return false
+}
+
 type PartitionLoadInfo struct {
 	CollectionID         int64           `protobuf:"varint,1,opt,name=collectionID,proto3" json:"collectionID,omitempty"`
 	PartitionID          int64           `protobuf:"varint,2,opt,name=partitionID,proto3" json:"partitionID,omitempty"`
@@ -4511,6 +4604,212 @@ func (m *DeleteRequest) GetTimestamps() []uint64 {
 	return nil
 }
 
+type OperateLoadScheduleRequest struct {
+	Base                 *commonpb.MsgBase       `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
+	DbName               string                  `protobuf:"bytes,2,opt,name=db_name,json=dbName,proto3" json:"db_name,omitempty"`
+	CollectionName       string                  `protobuf:"bytes,3,opt,name=collection_name,json=collectionName,proto3" json:"collection_name,omitempty"`
+	CollectionID         int64                   `protobuf:"varint,4,opt,name=collectionID,proto3" json:"collectionID,omitempty"`
+	OperateType          LoadScheduleOperateType `protobuf:"varint,5,opt,name=operate_type,json=operateType,proto3,enum=milvus.proto.query.LoadScheduleOperateType" json:"operate_type,omitempty"`
+	LoadSpec             string                  `protobuf:"bytes,6,opt,name=load_spec,json=loadSpec,proto3" json:"load_spec,omitempty"`
+	ReleaseSpec          string                  `protobuf:"bytes,7,opt,name=release_spec,json=releaseSpec,proto3" json:"release_spec,omitempty"`
+	Timezone             string                  `protobuf:"bytes,8,opt,name=timezone,proto3" json:"timezone,omitempty"`
+	ReplicaNumber        int32                   `protobuf:"varint,9,opt,name=replica_number,json=replicaNumber,proto3" json:"replica_number,omitempty"`
+	ResourceGroups       []string                `protobuf:"bytes,10,rep,name=resource_groups,json=resourceGroups,proto3" json:"resource_groups,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                `json:"-"`
+	XXX_unrecognized     []byte                  `json:"-"`
+	XXX_sizecache        int32                   `json:"-"`
+}
+
+func (m *OperateLoadScheduleRequest) Reset()         { *m = OperateLoadScheduleRequest{} }
+func (m *OperateLoadScheduleRequest) String() string { return proto.CompactTextString(m) }
+func (*OperateLoadScheduleRequest) ProtoMessage()    {}
+func (*OperateLoadScheduleRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_aab7cc9a69ed26e8, []int{58}
+}
+
+func (m *OperateLoadScheduleRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_OperateLoadScheduleRequest.Unmarshal(m, b)
+}
+func (m *OperateLoadScheduleRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_OperateLoadScheduleRequest.Marshal(b, m, deterministic)
+}
+func (m *OperateLoadScheduleRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_OperateLoadScheduleRequest.Merge(m, src)
+}
+func (m *OperateLoadScheduleRequest) XXX_Size() int {
+	return xxx_messageInfo_OperateLoadScheduleRequest.Size(m)
+}
+func (m *OperateLoadScheduleRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_OperateLoadScheduleRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_OperateLoadScheduleRequest proto.InternalMessageInfo
+
+func (m *OperateLoadScheduleRequest) GetBase() *commonpb.MsgBase {
+	if m != nil {
+		return m.Base
+	}
+	return nil
+}
+
+func (m *OperateLoadScheduleRequest) GetDbName() string {
+	if m != nil {
+		return m.DbName
+	}
+	return ""
+}
+
+func (m *OperateLoadScheduleRequest) GetCollectionName() string {
+	if m != nil {
+		return m.CollectionName
+	}
+	return ""
+}
+
+func (m *OperateLoadScheduleRequest) GetCollectionID() int64 {
+	if m != nil {
+		return m.CollectionID
+	}
+	return 0
+}
+
+func (m *OperateLoadScheduleRequest) GetOperateType() LoadScheduleOperateType {
+	if m != nil {
+		return m.OperateType
+	}
+	return LoadScheduleOperateType_PutLoadSchedule
+}
+
+func (m *OperateLoadScheduleRequest) GetLoadSpec() string {
+	if m != nil {
+		return m.LoadSpec
+	}
+	return ""
+}
+
+func (m *OperateLoadScheduleRequest) GetReleaseSpec() string {
+	if m != nil {
+		return m.ReleaseSpec
+	}
+	return ""
+}
+
+func (m *OperateLoadScheduleRequest) GetTimezone() string {
+	if m != nil {
+		return m.Timezone
+	}
+	return ""
+}
+
+func (m *OperateLoadScheduleRequest) GetReplicaNumber() int32 {
+	if m != nil {
+		return m.ReplicaNumber
+	}
+	return 0
+}
+
+func (m *OperateLoadScheduleRequest) GetResourceGroups() []string {
+	if m != nil {
+		return m.ResourceGroups
+	}
+	return nil
+}
+
+type UpdateLoadConfigRequest struct {
+	Base                 *commonpb.MsgBase `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
+	DbName               string            `protobuf:"bytes,2,opt,name=db_name,json=dbName,proto3" json:"db_name,omitempty"`
+	CollectionName       string            `protobuf:"bytes,3,opt,name=collection_name,json=collectionName,proto3" json:"collection_name,omitempty"`
+	CollectionID         int64             `protobuf:"varint,4,opt,name=collectionID,proto3" json:"collectionID,omitempty"`
+	ReplicaNumber        int32             `protobuf:"varint,5,opt,name=replica_number,json=replicaNumber,proto3" json:"replica_number,omitempty"`
+	ResourceGroups       []string          `protobuf:"bytes,6,rep,name=resource_groups,json=resourceGroups,proto3" json:"resource_groups,omitempty"`
+	MmapEnabled          bool              `protobuf:"varint,7,opt,name=mmap_enabled,json=mmapEnabled,proto3" json:"mmap_enabled,omitempty"`
+	MmapSpecified        bool              `protobuf:"varint,8,opt,name=mmap_specified,json=mmapSpecified,proto3" json:"mmap_specified,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
+	XXX_unrecognized     []byte            `json:"-"`
+	XXX_sizecache        int32             `json:"-"`
+}
+
+func (m *UpdateLoadConfigRequest) Reset()         { *m = UpdateLoadConfigRequest{} }
+func (m *UpdateLoadConfigRequest) String() string { return proto.CompactTextString(m) }
+func (*UpdateLoadConfigRequest) ProtoMessage()    {}
+func (*UpdateLoadConfigRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_aab7cc9a69ed26e8, []int{59}
+}
+
+func (m *UpdateLoadConfigRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_UpdateLoadConfigRequest.Unmarshal(m, b)
+}
+func (m *UpdateLoadConfigRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_UpdateLoadConfigRequest.Marshal(b, m, deterministic)
+}
+func (m *UpdateLoadConfigRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_UpdateLoadConfigRequest.Merge(m, src)
+}
+func (m *UpdateLoadConfigRequest) XXX_Size() int {
+	return xxx_messageInfo_UpdateLoadConfigRequest.Size(m)
+}
+func (m *UpdateLoadConfigRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_UpdateLoadConfigRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_UpdateLoadConfigRequest proto.InternalMessageInfo
+
+func (m *UpdateLoadConfigRequest) GetBase() *commonpb.MsgBase {
+	if m != nil {
+		return m.Base
+	}
+	return nil
+}
+
+func (m *UpdateLoadConfigRequest) GetDbName() string {
+	if m != nil {
+		return m.DbName
+	}
+	return ""
+}
+
+func (m *UpdateLoadConfigRequest) GetCollectionName() string {
+	if m != nil {
+		return m.CollectionName
+	}
+	return ""
+}
+
+func (m *UpdateLoadConfigRequest) GetCollectionID() int64 {
+	if m != nil {
+		return m.CollectionID
+	}
+	return 0
+}
+
+func (m *UpdateLoadConfigRequest) GetReplicaNumber() int32 {
+	if m != nil {
+		return m.ReplicaNumber
+	}
+	return 0
+}
+
+func (m *UpdateLoadConfigRequest) GetResourceGroups() []string {
+	if m != nil {
+		return m.ResourceGroups
+	}
+	return nil
+}
+
+func (m *UpdateLoadConfigRequest) GetMmapEnabled() bool {
+	if m != nil {
+		return m.MmapEnabled
+	}
+	return false
+}
+
+func (m *UpdateLoadConfigRequest) GetMmapSpecified() bool {
+	if m != nil {
+		return m.MmapSpecified
+	}
+	return false
+}
+
 func init() {
 	proto.RegisterEnum("milvus.proto.query.LoadScope", LoadScope_name, LoadScope_value)
 	proto.RegisterEnum("milvus.proto.query.DataScope", DataScope_name, DataScope_value)
@@ -4519,6 +4818,7 @@ func init() {
 	proto.RegisterEnum("milvus.proto.query.LoadType", LoadType_name, LoadType_value)
 	proto.RegisterEnum("milvus.proto.query.LoadStatus", LoadStatus_name, LoadStatus_value)
 	proto.RegisterEnum("milvus.proto.query.SyncType", SyncType_name, SyncType_value)
+	proto.RegisterEnum("milvus.proto.query.LoadScheduleOperateType", LoadScheduleOperateType_name, LoadScheduleOperateType_value)
 	proto.RegisterType((*ShowCollectionsRequest)(nil), "milvus.proto.query.ShowCollectionsRequest")
 	proto.RegisterType((*ShowCollectionsResponse)(nil), "milvus.proto.query.ShowCollectionsResponse")
 	proto.RegisterType((*ShowPartitionsRequest)(nil), "milvus.proto.query.ShowPartitionsRequest")
@@ -4589,314 +4889,338 @@ func init() {
 	proto.RegisterMapType((map[int64]int32)(nil), "milvus.proto.query.ResourceGroupInfo.NumLoadedReplicaEntry")
 	proto.RegisterMapType((map[int64]int32)(nil), "milvus.proto.query.ResourceGroupInfo.NumOutgoingNodeEntry")
 	proto.RegisterType((*DeleteRequest)(nil), "milvus.proto.query.DeleteRequest")
+	proto.RegisterType((*OperateLoadScheduleRequest)(nil), "milvus.proto.query.OperateLoadScheduleRequest")
+	proto.RegisterType((*UpdateLoadConfigRequest)(nil), "milvus.proto.query.UpdateLoadConfigRequest")
 }
 
 func init() { proto.RegisterFile("query_coord.proto", fileDescriptor_aab7cc9a69ed26e8) }
 
 var fileDescriptor_aab7cc9a69ed26e8 = []byte{
-	// 4829 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xec, 0x7c, 0xcb, 0x6f, 0x1c, 0x47,
-	0x7a, 0xb8, 0x7a, 0x5e, 0x9c, 0xf9, 0xe6, 0xd5, 0x2c, 0x92, 0xd2, 0xec, 0xac, 0x24, 0xcb, 0x2d,
-	0x3f, 0xb8, 0xb2, 0x4d, 0x6a, 0xa9, 0xb5, 0x57, 0xbb, 0xb6, 0xe1, 0x9f, 0x44, 0x5a, 0x32, 0xd7,
-	0x36, 0xcd, 0x5f, 0x53, 0xf2, 0x06, 0x5e, 0xef, 0x8e, 0x9b, 0xd3, 0xc5, 0x61, 0x43, 0xfd, 0x18,
-	0x75, 0xf7, 0x90, 0xa6, 0x03, 0x04, 0x39, 0xe4, 0x92, 0x4d, 0x36, 0x08, 0x92, 0x43, 0x72, 0x08,
-	0x72, 0x48, 0x10, 0x60, 0x13, 0x24, 0x97, 0x20, 0x01, 0x72, 0xc8, 0x21, 0xb7, 0xe4, 0x92, 0xd7,
-	0xff, 0x90, 0xdc, 0x72, 0xcc, 0x22, 0x30, 0x90, 0x43, 0x50, 0x8f, 0x7e, 0x54, 0x77, 0x0d, 0xa7,
-	0xc9, 0x91, 0xfc, 0x08, 0x72, 0x9b, 0xfe, 0xea, 0xf1, 0x7d, 0xf5, 0xbd, 0xea, 0xfb, 0xbe, 0xaa,
-	0x1a, 0x58, 0x7c, 0x3c, 0xc1, 0xfe, 0xc9, 0x60, 0xe8, 0x79, 0xbe, 0xb9, 0x36, 0xf6, 0xbd, 0xd0,
-	0x43, 0xc8, 0xb1, 0xec, 0xa3, 0x49, 0xc0, 0xbe, 0xd6, 0x68, 0x7b, 0xbf, 0x35, 0xf4, 0x1c, 0xc7,
-	0x73, 0x19, 0xac, 0xdf, 0x4a, 0xf7, 0xe8, 0x77, 0x2c, 0x37, 0xc4, 0xbe, 0x6b, 0xd8, 0x51, 0x6b,
-	0x30, 0x3c, 0xc4, 0x8e, 0xc1, 0xbf, 0x1a, 0x4e, 0x30, 0xe2, 0x3f, 0x55, 0xd3, 0x08, 0x8d, 0x34,
-	0xaa, 0xfe, 0xa2, 0xe5, 0x9a, 0xf8, 0xd3, 0x34, 0x48, 0xfb, 0x35, 0x05, 0x2e, 0xee, 0x1d, 0x7a,
-	0xc7, 0x9b, 0x9e, 0x6d, 0xe3, 0x61, 0x68, 0x79, 0x6e, 0xa0, 0xe3, 0xc7, 0x13, 0x1c, 0x84, 0xe8,
-	0x26, 0x54, 0xf6, 0x8d, 0x00, 0xf7, 0x94, 0x6b, 0xca, 0x6a, 0x73, 0xe3, 0xf2, 0x9a, 0x40, 0x27,
-	0x27, 0xf0, 0xfd, 0x60, 0x74, 0xd7, 0x08, 0xb0, 0x4e, 0x7b, 0x22, 0x04, 0x15, 0x73, 0x7f, 0x7b,
-	0xab, 0x57, 0xba, 0xa6, 0xac, 0x96, 0x75, 0xfa, 0x1b, 0x3d, 0x07, 0xed, 0x61, 0x3c, 0xf7, 0xf6,
-	0x56, 0xd0, 0x2b, 0x5f, 0x2b, 0xaf, 0x96, 0x75, 0x11, 0xa8, 0xfd, 0xb4, 0x04, 0x97, 0x72, 0x64,
-	0x04, 0x63, 0xcf, 0x0d, 0x30, 0xba, 0x05, 0xb5, 0x20, 0x34, 0xc2, 0x49, 0xc0, 0x29, 0xf9, 0xa6,
-	0x94, 0x92, 0x3d, 0xda, 0x45, 0xe7, 0x5d, 0xf3, 0x68, 0x4b, 0x12, 0xb4, 0xe8, 0xdb, 0xb0, 0x6c,
-	0xb9, 0xef, 0x63, 0xc7, 0xf3, 0x4f, 0x06, 0x63, 0xec, 0x0f, 0xb1, 0x1b, 0x1a, 0x23, 0x1c, 0xd1,
-	0xb8, 0x14, 0xb5, 0xed, 0x26, 0x4d, 0xe8, 0x35, 0xb8, 0xc4, 0x64, 0x18, 0x60, 0xff, 0xc8, 0x1a,
-	0xe2, 0x81, 0x71, 0x64, 0x58, 0xb6, 0xb1, 0x6f, 0xe3, 0x5e, 0xe5, 0x5a, 0x79, 0xb5, 0xae, 0xaf,
-	0xd0, 0xe6, 0x3d, 0xd6, 0x7a, 0x27, 0x6a, 0x44, 0xdf, 0x02, 0xd5, 0xc7, 0x07, 0x3e, 0x0e, 0x0e,
-	0x07, 0x63, 0xdf, 0x1b, 0xf9, 0x38, 0x08, 0x7a, 0x55, 0x8a, 0xa6, 0xcb, 0xe1, 0xbb, 0x1c, 0xac,
-	0xfd, 0x89, 0x02, 0x2b, 0x84, 0x19, 0xbb, 0x86, 0x1f, 0x5a, 0x4f, 0x41, 0x24, 0x1a, 0xb4, 0xd2,
-	0x6c, 0xe8, 0x95, 0x69, 0x9b, 0x00, 0x23, 0x7d, 0xc6, 0x11, 0x7a, 0xc2, 0xbe, 0x0a, 0x25, 0x55,
-	0x80, 0x69, 0xff, 0xcc, 0x75, 0x27, 0x4d, 0xe7, 0x3c, 0x32, 0xcb, 0xe2, 0x2c, 0xe5, 0x71, 0x9e,
-	0x47, 0x62, 0x32, 0xce, 0x57, 0xe4, 0x9c, 0xff, 0xc7, 0x32, 0xac, 0xbc, 0xe7, 0x19, 0x66, 0xa2,
-	0x86, 0x5f, 0x3c, 0xe7, 0xdf, 0x84, 0x1a, 0xb3, 0xe8, 0x5e, 0x85, 0xe2, 0x7a, 0x5e, 0xc4, 0xc5,
-	0xad, 0x3d, 0xa1, 0x70, 0x8f, 0x02, 0x74, 0x3e, 0x08, 0x3d, 0x0f, 0x1d, 0x1f, 0x8f, 0x6d, 0x6b,
-	0x68, 0x0c, 0xdc, 0x89, 0xb3, 0x8f, 0xfd, 0x5e, 0xf5, 0x9a, 0xb2, 0x5a, 0xd5, 0xdb, 0x1c, 0xba,
-	0x43, 0x81, 0xe8, 0x13, 0x68, 0x1f, 0x58, 0xd8, 0x36, 0x07, 0xd4, 0x25, 0x6c, 0x6f, 0xf5, 0x6a,
-	0xd7, 0xca, 0xab, 0xcd, 0x8d, 0xd7, 0xd7, 0xf2, 0xde, 0x68, 0x4d, 0xca, 0x91, 0xb5, 0x7b, 0x64,
-	0xf8, 0x36, 0x1b, 0xfd, 0xb6, 0x1b, 0xfa, 0x27, 0x7a, 0xeb, 0x20, 0x05, 0x42, 0x3d, 0x58, 0xe0,
-	0xec, 0xed, 0x2d, 0x5c, 0x53, 0x56, 0xeb, 0x7a, 0xf4, 0x89, 0x5e, 0x84, 0xae, 0x8f, 0x03, 0x6f,
-	0xe2, 0x0f, 0xf1, 0x60, 0xe4, 0x7b, 0x93, 0x71, 0xd0, 0xab, 0x5f, 0x2b, 0xaf, 0x36, 0xf4, 0x4e,
-	0x04, 0xbe, 0x4f, 0xa1, 0xfd, 0xb7, 0x60, 0x31, 0x87, 0x05, 0xa9, 0x50, 0x7e, 0x84, 0x4f, 0xa8,
-	0x20, 0xca, 0x3a, 0xf9, 0x89, 0x96, 0xa1, 0x7a, 0x64, 0xd8, 0x13, 0xcc, 0x59, 0xcd, 0x3e, 0xbe,
-	0x5f, 0xba, 0xad, 0x68, 0x7f, 0xa0, 0x40, 0x4f, 0xc7, 0x36, 0x36, 0x02, 0xfc, 0x65, 0x8a, 0xf4,
-	0x22, 0xd4, 0x5c, 0xcf, 0xc4, 0xdb, 0x5b, 0x54, 0xa4, 0x65, 0x9d, 0x7f, 0x69, 0x9f, 0x2b, 0xb0,
-	0x7c, 0x1f, 0x87, 0xc4, 0x0c, 0xac, 0x20, 0xb4, 0x86, 0xb1, 0x9d, 0xbf, 0x09, 0x65, 0x1f, 0x3f,
-	0xe6, 0x94, 0xbd, 0x24, 0x52, 0x16, 0xbb, 0x7f, 0xd9, 0x48, 0x9d, 0x8c, 0x43, 0xcf, 0x42, 0xcb,
-	0x74, 0xec, 0xc1, 0xf0, 0xd0, 0x70, 0x5d, 0x6c, 0x33, 0x43, 0x6a, 0xe8, 0x4d, 0xd3, 0xb1, 0x37,
-	0x39, 0x08, 0x5d, 0x05, 0x08, 0xf0, 0xc8, 0xc1, 0x6e, 0x98, 0xf8, 0xe4, 0x14, 0x04, 0xdd, 0x80,
-	0xc5, 0x03, 0xdf, 0x73, 0x06, 0xc1, 0xa1, 0xe1, 0x9b, 0x03, 0x1b, 0x1b, 0x26, 0xf6, 0x29, 0xf5,
-	0x75, 0xbd, 0x4b, 0x1a, 0xf6, 0x08, 0xfc, 0x3d, 0x0a, 0x46, 0xb7, 0xa0, 0x1a, 0x0c, 0xbd, 0x31,
-	0xa6, 0x9a, 0xd6, 0xd9, 0xb8, 0x22, 0xd3, 0xa1, 0x2d, 0x23, 0x34, 0xf6, 0x48, 0x27, 0x9d, 0xf5,
-	0xd5, 0xfe, 0xa6, 0xc2, 0x4c, 0xed, 0x2b, 0xee, 0xe4, 0x52, 0xe6, 0x58, 0x7d, 0x32, 0xe6, 0x58,
-	0x2b, 0x64, 0x8e, 0x0b, 0xa7, 0x9b, 0x63, 0x8e, 0x6b, 0x67, 0x31, 0xc7, 0xfa, 0x4c, 0x73, 0x6c,
-	0xc8, 0xcc, 0x11, 0xbd, 0x0d, 0x5d, 0x16, 0x40, 0x58, 0xee, 0x81, 0x37, 0xb0, 0xad, 0x20, 0xec,
-	0x01, 0x25, 0xf3, 0x4a, 0x56, 0x43, 0x4d, 0xfc, 0xe9, 0x1a, 0x43, 0xec, 0x1e, 0x78, 0x7a, 0xdb,
-	0x8a, 0x7e, 0xbe, 0x67, 0x05, 0xe1, 0xfc, 0x56, 0xfd, 0x77, 0x89, 0x55, 0x7f, 0xd5, 0xb5, 0x27,
-	0xb1, 0xfc, 0xaa, 0x60, 0xf9, 0x7f, 0xaa, 0xc0, 0x37, 0xee, 0xe3, 0x30, 0x26, 0x9f, 0x18, 0x32,
-	0xfe, 0x8a, 0x6e, 0xf3, 0x7f, 0xa1, 0x40, 0x5f, 0x46, 0xeb, 0x3c, 0x5b, 0xfd, 0x47, 0x70, 0x31,
-	0xc6, 0x31, 0x30, 0x71, 0x30, 0xf4, 0xad, 0x31, 0x15, 0x23, 0xf5, 0x55, 0xcd, 0x8d, 0xeb, 0x32,
-	0xc5, 0xcf, 0x52, 0xb0, 0x12, 0x4f, 0xb1, 0x95, 0x9a, 0x41, 0xfb, 0x99, 0x02, 0x2b, 0xc4, 0x37,
-	0x72, 0x67, 0x46, 0x34, 0xf0, 0xdc, 0x7c, 0x15, 0xdd, 0x64, 0x29, 0xe7, 0x26, 0x0b, 0xf0, 0x98,
-	0x86, 0xd8, 0x59, 0x7a, 0xe6, 0xe1, 0xdd, 0xab, 0x50, 0x25, 0x06, 0x18, 0xb1, 0xea, 0x19, 0x19,
-	0xab, 0xd2, 0xc8, 0x58, 0x6f, 0xcd, 0x65, 0x54, 0x24, 0x7e, 0x7b, 0x0e, 0x75, 0xcb, 0x2e, 0xbb,
-	0x24, 0x59, 0xf6, 0x6f, 0x2a, 0x70, 0x29, 0x87, 0x70, 0x9e, 0x75, 0xbf, 0x01, 0x35, 0xba, 0x1b,
-	0x45, 0x0b, 0x7f, 0x4e, 0xba, 0xf0, 0x14, 0x3a, 0xe2, 0x6d, 0x74, 0x3e, 0x46, 0xf3, 0x40, 0xcd,
-	0xb6, 0x91, 0x7d, 0x92, 0xef, 0x91, 0x03, 0xd7, 0x70, 0x18, 0x03, 0x1a, 0x7a, 0x93, 0xc3, 0x76,
-	0x0c, 0x07, 0xa3, 0x6f, 0x40, 0x9d, 0x98, 0xec, 0xc0, 0x32, 0x23, 0xf1, 0x2f, 0x50, 0x13, 0x36,
-	0x03, 0x74, 0x05, 0x80, 0x36, 0x19, 0xa6, 0xe9, 0xb3, 0x2d, 0xb4, 0xa1, 0x37, 0x08, 0xe4, 0x0e,
-	0x01, 0x68, 0xbf, 0xaf, 0xc0, 0xd5, 0xbd, 0x13, 0x77, 0xb8, 0x83, 0x8f, 0x37, 0x7d, 0x6c, 0x84,
-	0x38, 0x71, 0xda, 0x4f, 0x95, 0xf1, 0xe8, 0x1a, 0x34, 0x53, 0xf6, 0xcb, 0x55, 0x32, 0x0d, 0xd2,
-	0xfe, 0x52, 0x81, 0x16, 0xd9, 0x45, 0xde, 0xc7, 0xa1, 0x41, 0x54, 0x04, 0x7d, 0x0f, 0x1a, 0xb6,
-	0x67, 0x98, 0x83, 0xf0, 0x64, 0xcc, 0xa8, 0xe9, 0x64, 0xa9, 0x49, 0xb6, 0x9e, 0x07, 0x27, 0x63,
-	0xac, 0xd7, 0x6d, 0xfe, 0xab, 0x10, 0x45, 0x59, 0x2f, 0x53, 0x96, 0x78, 0xca, 0x67, 0xa0, 0xe9,
-	0xe0, 0xd0, 0xb7, 0x86, 0x8c, 0x88, 0x0a, 0x15, 0x05, 0x30, 0x10, 0x41, 0xa4, 0xfd, 0xac, 0x06,
-	0x17, 0x7f, 0x68, 0x84, 0xc3, 0xc3, 0x2d, 0x27, 0x8a, 0x62, 0xce, 0xcf, 0xc7, 0xc4, 0x2f, 0x97,
-	0xd2, 0x7e, 0xf9, 0x89, 0xf9, 0xfd, 0xd8, 0x46, 0xab, 0x32, 0x1b, 0x25, 0x89, 0xf9, 0xda, 0x87,
-	0x5c, 0xcd, 0x52, 0x36, 0x9a, 0x0a, 0x36, 0x6a, 0xe7, 0x09, 0x36, 0x36, 0xa1, 0x8d, 0x3f, 0x1d,
-	0xda, 0x13, 0xa2, 0xaf, 0x14, 0x3b, 0x8b, 0x22, 0xae, 0x4a, 0xb0, 0xa7, 0x1d, 0x44, 0x8b, 0x0f,
-	0xda, 0xe6, 0x34, 0x30, 0x5d, 0x70, 0x70, 0x68, 0xd0, 0x50, 0xa1, 0xb9, 0x71, 0x6d, 0x9a, 0x2e,
-	0x44, 0x0a, 0xc4, 0xf4, 0x81, 0x7c, 0xa1, 0xcb, 0xd0, 0xe0, 0xa1, 0xcd, 0xf6, 0x56, 0xaf, 0x41,
-	0xd9, 0x97, 0x00, 0x90, 0x01, 0x6d, 0xee, 0x3d, 0x39, 0x85, 0x2c, 0x80, 0x78, 0x43, 0x86, 0x40,
-	0x2e, 0xec, 0x34, 0xe5, 0x01, 0x0f, 0x74, 0x82, 0x14, 0x88, 0x64, 0xfe, 0xde, 0xc1, 0x81, 0x6d,
-	0xb9, 0x78, 0x87, 0x49, 0xb8, 0x49, 0x89, 0x10, 0x81, 0x24, 0x1c, 0x3a, 0xc2, 0x7e, 0x60, 0x79,
-	0x6e, 0xaf, 0x45, 0xdb, 0xa3, 0x4f, 0x59, 0x94, 0xd3, 0x3e, 0x47, 0x94, 0x33, 0x80, 0xc5, 0x1c,
-	0xa5, 0x92, 0x28, 0xe7, 0x3b, 0xe9, 0x28, 0x67, 0xb6, 0xa8, 0x52, 0x51, 0xd0, 0xcf, 0x15, 0x58,
-	0x79, 0xe8, 0x06, 0x93, 0xfd, 0x98, 0x45, 0x5f, 0x8e, 0x39, 0x64, 0x9d, 0x68, 0x25, 0xe7, 0x44,
-	0xb5, 0xff, 0xac, 0x42, 0x97, 0xaf, 0x82, 0x68, 0x0d, 0x75, 0x39, 0x97, 0xa1, 0x11, 0xef, 0xa3,
-	0x9c, 0x21, 0x09, 0x20, 0xeb, 0xc3, 0x4a, 0x39, 0x1f, 0x56, 0x88, 0xb4, 0x28, 0x2a, 0xaa, 0xa4,
-	0xa2, 0xa2, 0x2b, 0x00, 0x07, 0xf6, 0x24, 0x38, 0x1c, 0x84, 0x96, 0x83, 0x79, 0x54, 0xd6, 0xa0,
-	0x90, 0x07, 0x96, 0x83, 0xd1, 0x1d, 0x68, 0xed, 0x5b, 0xae, 0xed, 0x8d, 0x06, 0x63, 0x23, 0x3c,
-	0x0c, 0x78, 0x5a, 0x2c, 0x13, 0x0b, 0x8d, 0x61, 0xef, 0xd2, 0xbe, 0x7a, 0x93, 0x8d, 0xd9, 0x25,
-	0x43, 0xd0, 0x55, 0x68, 0xba, 0x13, 0x67, 0xe0, 0x1d, 0x0c, 0x7c, 0xef, 0x38, 0xa0, 0xc9, 0x6f,
-	0x59, 0x6f, 0xb8, 0x13, 0xe7, 0x83, 0x03, 0xdd, 0x3b, 0x26, 0xfb, 0x58, 0x83, 0xec, 0x68, 0x81,
-	0xed, 0x8d, 0x58, 0xe2, 0x3b, 0x7b, 0xfe, 0x64, 0x00, 0x19, 0x6d, 0x62, 0x3b, 0x34, 0xe8, 0xe8,
-	0x46, 0xb1, 0xd1, 0xf1, 0x00, 0xf4, 0x02, 0x74, 0x86, 0x9e, 0x33, 0x36, 0x28, 0x87, 0xee, 0xf9,
-	0x9e, 0x43, 0x0d, 0xb0, 0xac, 0x67, 0xa0, 0x68, 0x13, 0x9a, 0x89, 0x11, 0x04, 0xbd, 0x26, 0xc5,
-	0xa3, 0xc9, 0xac, 0x34, 0x15, 0xca, 0x13, 0x05, 0x85, 0xd8, 0x0a, 0x02, 0xa2, 0x19, 0x91, 0xb1,
-	0x07, 0xd6, 0x67, 0x98, 0x1b, 0x5a, 0x93, 0xc3, 0xf6, 0xac, 0xcf, 0x30, 0x49, 0x8f, 0x2c, 0x37,
-	0xc0, 0x7e, 0x18, 0x25, 0xab, 0xbd, 0x36, 0x55, 0x9f, 0x36, 0x83, 0x72, 0xc5, 0x46, 0x5b, 0xd0,
-	0x09, 0x42, 0xc3, 0x0f, 0x07, 0x63, 0x2f, 0xa0, 0x0a, 0xd0, 0xeb, 0x50, 0xdd, 0xce, 0x98, 0xa4,
-	0x13, 0x8c, 0x88, 0x62, 0xef, 0xf2, 0x4e, 0x7a, 0x9b, 0x0e, 0x8a, 0x3e, 0xc9, 0x2c, 0x94, 0x13,
-	0xc9, 0x2c, 0xdd, 0x42, 0xb3, 0xd0, 0x41, 0xf1, 0x2c, 0xab, 0x24, 0x5d, 0x32, 0x4c, 0x63, 0xdf,
-	0xc6, 0x1f, 0x72, 0x0f, 0xa2, 0xd2, 0x85, 0x65, 0xc1, 0xda, 0x1f, 0x97, 0xa1, 0x23, 0xb2, 0x87,
-	0xb8, 0x1d, 0x96, 0x95, 0x45, 0x3a, 0x1f, 0x7d, 0x12, 0x66, 0x61, 0x97, 0x8c, 0x66, 0x29, 0x20,
-	0x55, 0xf9, 0xba, 0xde, 0x64, 0x30, 0x3a, 0x01, 0x51, 0x5d, 0x26, 0x14, 0x6a, 0x67, 0x65, 0xca,
-	0xa8, 0x06, 0x85, 0xd0, 0x50, 0xa5, 0x07, 0x0b, 0x51, 0xf6, 0xc8, 0x14, 0x3e, 0xfa, 0x24, 0x2d,
-	0xfb, 0x13, 0x8b, 0x62, 0x65, 0x0a, 0x1f, 0x7d, 0xa2, 0x2d, 0x68, 0xb1, 0x29, 0xc7, 0x86, 0x6f,
-	0x38, 0x91, 0xba, 0x3f, 0x2b, 0x75, 0x19, 0xef, 0xe2, 0x93, 0x0f, 0x89, 0xf7, 0xd9, 0x35, 0x2c,
-	0x5f, 0x67, 0xea, 0xb1, 0x4b, 0x47, 0xa1, 0x55, 0x50, 0xd9, 0x2c, 0x07, 0x96, 0x8d, 0xb9, 0xe1,
-	0x2c, 0xb0, 0x14, 0x92, 0xc2, 0xef, 0x59, 0x36, 0x66, 0xb6, 0x11, 0x2f, 0x81, 0x2a, 0x44, 0x9d,
-	0x99, 0x06, 0x85, 0x50, 0x75, 0xb8, 0x0e, 0xcc, 0x8b, 0x0e, 0x22, 0xdf, 0xcc, 0x36, 0x10, 0x46,
-	0x23, 0x67, 0x2b, 0x0d, 0xc9, 0x26, 0x0e, 0x33, 0x2e, 0x60, 0xcb, 0x71, 0x27, 0x0e, 0x35, 0xad,
-	0x0d, 0x58, 0x19, 0x4e, 0x7c, 0x9f, 0x6d, 0x2f, 0xe9, 0x79, 0x9a, 0x34, 0xe9, 0x5e, 0xe2, 0x8d,
-	0xdb, 0xa9, 0xe9, 0xb4, 0xdf, 0xa9, 0xc2, 0x12, 0xf1, 0x4a, 0xdc, 0x41, 0xcd, 0x11, 0x54, 0x5c,
-	0x01, 0x30, 0x83, 0x70, 0x20, 0x78, 0xd2, 0x86, 0x19, 0x84, 0x7c, 0xcb, 0xf9, 0x5e, 0x14, 0x13,
-	0x94, 0xa7, 0xa7, 0x38, 0x19, 0x2f, 0x99, 0x8f, 0x0b, 0xce, 0x55, 0x13, 0xbc, 0x0e, 0x6d, 0x9e,
-	0xdf, 0x0b, 0xc9, 0x68, 0x8b, 0x01, 0x77, 0xe4, 0xbe, 0xbe, 0x26, 0xad, 0x4d, 0xa6, 0x62, 0x83,
-	0x85, 0xf9, 0x62, 0x83, 0x7a, 0x36, 0x36, 0xb8, 0x07, 0x5d, 0xd1, 0x3c, 0x23, 0xff, 0x36, 0xc3,
-	0x3e, 0x3b, 0x82, 0x7d, 0x06, 0xe9, 0xad, 0x1d, 0xc4, 0xad, 0xfd, 0x3a, 0xb4, 0x5d, 0x8c, 0xcd,
-	0x41, 0xe8, 0x1b, 0x6e, 0x70, 0x80, 0x7d, 0xaa, 0x16, 0x75, 0xbd, 0x45, 0x80, 0x0f, 0x38, 0x0c,
-	0xbd, 0x01, 0x40, 0xd7, 0xc8, 0x4a, 0x5a, 0xad, 0xe9, 0x25, 0x2d, 0xaa, 0x34, 0xb4, 0xa4, 0x45,
-	0x99, 0x42, 0x7f, 0x3e, 0xa1, 0xe8, 0x41, 0xfb, 0xa7, 0x12, 0x5c, 0xe4, 0x25, 0x8e, 0xf9, 0xf5,
-	0x72, 0xda, 0xee, 0x1e, 0x6d, 0x8f, 0xe5, 0x53, 0x8a, 0x06, 0x95, 0x02, 0x01, 0x70, 0x55, 0x12,
-	0x00, 0x8b, 0x89, 0x73, 0x2d, 0x97, 0x38, 0xc7, 0x35, 0xc3, 0x85, 0xe2, 0x35, 0x43, 0xb4, 0x0c,
-	0x55, 0x9a, 0xcd, 0x51, 0xdd, 0x69, 0xe8, 0xec, 0xa3, 0x90, 0x54, 0xb5, 0xdf, 0x2b, 0x41, 0x7b,
-	0x0f, 0x1b, 0xfe, 0xf0, 0x30, 0xe2, 0xe3, 0x6b, 0xe9, 0x1a, 0xeb, 0x73, 0x53, 0x6a, 0xac, 0xc2,
-	0x90, 0xaf, 0x4d, 0x71, 0x95, 0x20, 0x08, 0xbd, 0xd0, 0x88, 0xa9, 0x1c, 0xb8, 0x13, 0x87, 0x17,
-	0x1e, 0xbb, 0xb4, 0x81, 0x93, 0xba, 0x33, 0x71, 0xb4, 0xff, 0x50, 0xa0, 0xf5, 0xff, 0xc9, 0x34,
-	0x11, 0x63, 0x6e, 0xa7, 0x19, 0xf3, 0xc2, 0x14, 0xc6, 0xe8, 0x24, 0x31, 0xc3, 0x47, 0xf8, 0x6b,
-	0x57, 0x77, 0xfe, 0x7b, 0x05, 0xfa, 0x24, 0x2d, 0xd7, 0x99, 0xdf, 0x99, 0xdf, 0xba, 0xae, 0x43,
-	0xfb, 0x48, 0x08, 0x80, 0x4b, 0x54, 0x39, 0x5b, 0x47, 0xe9, 0x32, 0x82, 0x0e, 0x6a, 0x54, 0x06,
-	0xe6, 0x8b, 0x8d, 0xb6, 0x81, 0x17, 0x65, 0x54, 0x67, 0x88, 0xa3, 0x1e, 0xa2, 0xeb, 0x8b, 0x40,
-	0xed, 0xb7, 0x14, 0x58, 0x92, 0x74, 0x44, 0x97, 0x60, 0x81, 0x97, 0x2c, 0x78, 0x8c, 0xc1, 0xec,
-	0xdd, 0x24, 0xe2, 0x49, 0x8a, 0x6e, 0x96, 0x99, 0x8f, 0xaa, 0x4d, 0x92, 0x85, 0xc7, 0xf9, 0x99,
-	0x99, 0x93, 0x8f, 0x19, 0xa0, 0x3e, 0xd4, 0xb9, 0x37, 0x8d, 0x12, 0xdf, 0xf8, 0x5b, 0x7b, 0x04,
-	0xe8, 0x3e, 0x4e, 0xf6, 0xae, 0x79, 0x38, 0x9a, 0xf8, 0x9b, 0x84, 0xd0, 0xb4, 0x13, 0x32, 0xb5,
-	0x7f, 0x53, 0x60, 0x49, 0xc0, 0x36, 0x4f, 0x69, 0x29, 0xd9, 0x5f, 0x4b, 0xe7, 0xd9, 0x5f, 0x85,
-	0xf2, 0x49, 0xf9, 0x4c, 0xe5, 0x93, 0xab, 0x00, 0x31, 0xff, 0x23, 0x8e, 0xa6, 0x20, 0xda, 0xdf,
-	0x2a, 0x70, 0xf1, 0x1d, 0xc3, 0x35, 0xbd, 0x83, 0x83, 0xf9, 0x55, 0x75, 0x13, 0x84, 0x54, 0xb9,
-	0x68, 0x01, 0x51, 0xcc, 0xaf, 0x5f, 0x82, 0x45, 0x9f, 0xed, 0x4c, 0xa6, 0xa8, 0xcb, 0x65, 0x5d,
-	0x8d, 0x1a, 0x62, 0x1d, 0xfd, 0xf3, 0x12, 0x20, 0xb2, 0xea, 0xbb, 0x86, 0x6d, 0xb8, 0x43, 0x7c,
-	0x7e, 0xd2, 0x9f, 0x87, 0x8e, 0x10, 0xc2, 0xc4, 0x07, 0xfa, 0xe9, 0x18, 0x26, 0x40, 0xef, 0x42,
-	0x67, 0x9f, 0xa1, 0x1a, 0xf8, 0xd8, 0x08, 0x3c, 0x97, 0x8b, 0x43, 0x5a, 0x2b, 0x7c, 0xe0, 0x5b,
-	0xa3, 0x11, 0xf6, 0x37, 0x3d, 0xd7, 0xe4, 0x91, 0xfe, 0x7e, 0x44, 0x26, 0x19, 0x4a, 0x8c, 0x21,
-	0x89, 0xe7, 0x62, 0xe1, 0xc4, 0x01, 0x1d, 0x65, 0x45, 0x80, 0x0d, 0x3b, 0x61, 0x44, 0xb2, 0x1b,
-	0xaa, 0xac, 0x61, 0x6f, 0x7a, 0xa9, 0x58, 0x12, 0x5f, 0x69, 0x7f, 0xa5, 0x00, 0x8a, 0xd3, 0x79,
-	0x5a, 0xff, 0xa0, 0x16, 0x9d, 0x1d, 0xaa, 0x48, 0x36, 0xe5, 0xcb, 0xd0, 0x30, 0xa3, 0x91, 0xdc,
-	0x05, 0x25, 0x00, 0xba, 0x47, 0x52, 0xa2, 0x07, 0x44, 0xf3, 0xb0, 0x19, 0xa5, 0xcb, 0x0c, 0xf8,
-	0x1e, 0x85, 0x89, 0xe1, 0x59, 0x25, 0x1b, 0x9e, 0xa5, 0x2b, 0xa1, 0x55, 0xa1, 0x12, 0xaa, 0xfd,
-	0xbc, 0x04, 0x2a, 0xdd, 0x42, 0x36, 0x93, 0x92, 0x56, 0x21, 0xa2, 0xaf, 0x43, 0x9b, 0x5f, 0x88,
-	0x11, 0x08, 0x6f, 0x3d, 0x4e, 0x4d, 0x86, 0x6e, 0xc2, 0x32, 0xeb, 0xe4, 0xe3, 0x60, 0x62, 0x27,
-	0x99, 0x22, 0x4b, 0x80, 0xd0, 0x63, 0xb6, 0x77, 0x91, 0xa6, 0x68, 0xc4, 0x43, 0xb8, 0x38, 0xb2,
-	0xbd, 0x7d, 0xc3, 0x1e, 0x88, 0xe2, 0x61, 0x32, 0x2c, 0xa0, 0xf1, 0xcb, 0x6c, 0xf8, 0x5e, 0x5a,
-	0x86, 0x01, 0xba, 0x0b, 0xed, 0x00, 0xe3, 0x47, 0x49, 0xfa, 0x58, 0x2d, 0x92, 0x3e, 0xb6, 0xc8,
-	0x98, 0xe8, 0x4b, 0xfb, 0x43, 0x05, 0xba, 0x99, 0x73, 0x8c, 0x6c, 0xb1, 0x43, 0xc9, 0x17, 0x3b,
-	0x6e, 0x43, 0x95, 0x78, 0x2a, 0xb6, 0xb7, 0x74, 0xe4, 0x89, 0xb8, 0x38, 0xab, 0xce, 0x06, 0xa0,
-	0x75, 0x58, 0x92, 0xdc, 0x97, 0xe0, 0xe2, 0x47, 0xf9, 0xeb, 0x12, 0xda, 0x2f, 0x2a, 0xd0, 0x4c,
-	0xb1, 0x62, 0x46, 0x9d, 0xe6, 0x89, 0xd4, 0xa3, 0xa7, 0x9d, 0x8f, 0x13, 0x95, 0x73, 0xb0, 0xc3,
-	0x72, 0x45, 0x9e, 0xb8, 0x3a, 0xd8, 0xa1, 0x99, 0x62, 0x3a, 0x09, 0xac, 0x89, 0x49, 0xa0, 0x98,
-	0x26, 0x2f, 0x9c, 0x92, 0x26, 0xd7, 0xc5, 0x34, 0x59, 0x30, 0xa1, 0x46, 0xd6, 0x84, 0x8a, 0x96,
-	0x4e, 0x6e, 0xc2, 0xd2, 0x90, 0xd5, 0xfb, 0xef, 0x9e, 0x6c, 0xc6, 0x4d, 0x3c, 0x28, 0x95, 0x35,
-	0xa1, 0x7b, 0x49, 0x51, 0x94, 0x49, 0x99, 0x25, 0x1d, 0xf2, 0x2c, 0x9c, 0xcb, 0x86, 0x09, 0x39,
-	0xf2, 0xcc, 0xf4, 0x2b, 0x5b, 0xb4, 0x69, 0x9f, 0xab, 0x68, 0xf3, 0x0c, 0x34, 0xa3, 0x48, 0x85,
-	0x58, 0x7a, 0x87, 0x39, 0xbd, 0xc8, 0x0d, 0x98, 0x81, 0xe0, 0x07, 0xba, 0xe2, 0x89, 0x48, 0xb6,
-	0x86, 0xa1, 0xe6, 0x6b, 0x18, 0x97, 0x60, 0xc1, 0x0a, 0x06, 0x07, 0xc6, 0x23, 0xdc, 0x5b, 0xa4,
-	0xad, 0x35, 0x2b, 0xb8, 0x67, 0x3c, 0xc2, 0xda, 0xbf, 0x94, 0xa1, 0x93, 0x6c, 0xb0, 0x85, 0x3d,
-	0x48, 0x91, 0x3b, 0x43, 0x3b, 0xa0, 0x26, 0x71, 0x0f, 0xe5, 0xf0, 0xa9, 0x39, 0x78, 0xf6, 0x98,
-	0xb1, 0x3b, 0xce, 0xd8, 0xab, 0xb0, 0xdd, 0x57, 0xce, 0xb4, 0xdd, 0xcf, 0x79, 0x9b, 0xe0, 0x16,
-	0xac, 0xc4, 0x7b, 0xaf, 0xb0, 0x6c, 0x96, 0x60, 0x2d, 0x47, 0x8d, 0xbb, 0xe9, 0xe5, 0x4f, 0x71,
-	0x01, 0x0b, 0xd3, 0x5c, 0x40, 0x56, 0x05, 0xea, 0x39, 0x15, 0xc8, 0x5f, 0x6a, 0x68, 0x48, 0x2e,
-	0x35, 0x68, 0x0f, 0x61, 0x89, 0x16, 0xa8, 0x83, 0xa1, 0x6f, 0xed, 0xe3, 0x38, 0x05, 0x28, 0x22,
-	0xd6, 0x3e, 0xd4, 0x33, 0x59, 0x44, 0xfc, 0xad, 0xfd, 0x54, 0x81, 0x8b, 0xf9, 0x79, 0xa9, 0xc6,
-	0x24, 0x8e, 0x44, 0x11, 0x1c, 0xc9, 0x2f, 0xc1, 0x52, 0x2a, 0xa2, 0x14, 0x66, 0x9e, 0x12, 0x81,
-	0x4b, 0x08, 0xd7, 0x51, 0x32, 0x47, 0x04, 0xd3, 0x7e, 0xa1, 0xc4, 0x75, 0x7e, 0x02, 0x1b, 0xd1,
-	0x43, 0x14, 0xb2, 0xaf, 0x79, 0xae, 0x6d, 0xb9, 0x71, 0xc1, 0x85, 0xaf, 0x91, 0x01, 0x79, 0xc1,
-	0xe5, 0x1d, 0xe8, 0xf2, 0x4e, 0xf1, 0xf6, 0x54, 0x30, 0x20, 0xeb, 0xb0, 0x71, 0xf1, 0xc6, 0xf4,
-	0x3c, 0x74, 0xf8, 0xe9, 0x46, 0x84, 0xaf, 0x2c, 0x3b, 0xf3, 0xf8, 0x01, 0xa8, 0x51, 0xb7, 0xb3,
-	0x6e, 0x88, 0x5d, 0x3e, 0x30, 0x0e, 0xec, 0x7e, 0x5d, 0x81, 0x9e, 0xb8, 0x3d, 0xa6, 0x96, 0x7f,
-	0xf6, 0xf0, 0xee, 0x75, 0xf1, 0x4c, 0xfb, 0xf9, 0x53, 0xe8, 0x49, 0xf0, 0x44, 0x27, 0xdb, 0xbf,
-	0x5d, 0xa2, 0x17, 0x14, 0x48, 0xaa, 0xb7, 0x65, 0x05, 0xa1, 0x6f, 0xed, 0x4f, 0xe6, 0x3b, 0x65,
-	0x35, 0xa0, 0x39, 0x3c, 0xc4, 0xc3, 0x47, 0x63, 0xcf, 0x4a, 0xa4, 0xf2, 0x96, 0x8c, 0xa6, 0xe9,
-	0x68, 0xd7, 0x36, 0x93, 0x19, 0xd8, 0x31, 0x55, 0x7a, 0xce, 0xfe, 0x8f, 0x41, 0xcd, 0x76, 0x48,
-	0x9f, 0x0e, 0x35, 0xd8, 0xe9, 0xd0, 0x2d, 0xf1, 0x74, 0x68, 0x46, 0xa4, 0x91, 0x3a, 0x1c, 0xfa,
-	0xeb, 0x12, 0x7c, 0x53, 0x4a, 0xdb, 0x3c, 0x59, 0xd2, 0xb4, 0x3a, 0xd2, 0x5d, 0xa8, 0x67, 0x92,
-	0xda, 0x17, 0x4e, 0x91, 0x1f, 0xaf, 0xbb, 0xb2, 0xd2, 0x60, 0x90, 0xc4, 0x56, 0x89, 0xc1, 0x57,
-	0xa6, 0xcf, 0xc1, 0xed, 0x4e, 0x98, 0x23, 0x1a, 0x87, 0xee, 0x40, 0x8b, 0x15, 0x0c, 0x06, 0x47,
-	0x16, 0x3e, 0x8e, 0xce, 0x5e, 0xaf, 0x4a, 0x5d, 0x33, 0xed, 0xf7, 0xa1, 0x85, 0x8f, 0xf5, 0xa6,
-	0x1d, 0xff, 0x0e, 0xb4, 0xdf, 0xad, 0x00, 0x24, 0x6d, 0x24, 0x3b, 0x4b, 0x6c, 0x9e, 0x1b, 0x71,
-	0x0a, 0x42, 0x62, 0x09, 0x31, 0x72, 0x8d, 0x3e, 0x91, 0x9e, 0x9c, 0x7d, 0x98, 0x56, 0x10, 0x72,
-	0xbe, 0xac, 0x9f, 0x4e, 0x4b, 0xc4, 0x22, 0x22, 0x32, 0xae, 0x33, 0x41, 0x02, 0x41, 0xaf, 0x00,
-	0x1a, 0xf9, 0xde, 0xb1, 0xe5, 0x8e, 0xd2, 0xf9, 0x06, 0x4b, 0x4b, 0x16, 0x79, 0x4b, 0x2a, 0xe1,
-	0xf8, 0x09, 0xa8, 0x99, 0xee, 0x11, 0x4b, 0x6e, 0xcd, 0x20, 0xe3, 0xbe, 0x30, 0x17, 0x57, 0xdf,
-	0xae, 0x88, 0x81, 0x1e, 0xb4, 0x3e, 0x30, 0xfc, 0x11, 0x8e, 0x24, 0xca, 0xe3, 0x30, 0x11, 0xd8,
-	0x1f, 0x80, 0x9a, 0x5d, 0x95, 0xe4, 0x18, 0xf4, 0x55, 0x51, 0xd1, 0x4f, 0xf3, 0x47, 0x64, 0x9a,
-	0x94, 0xaa, 0xf7, 0x0d, 0x58, 0x96, 0xd1, 0x2b, 0x41, 0x72, 0x6e, 0x6b, 0x7a, 0x2b, 0x0e, 0x89,
-	0xa9, 0x1c, 0xa6, 0xed, 0x32, 0xa9, 0xc2, 0x73, 0x49, 0x28, 0x3c, 0x6b, 0xbf, 0x5a, 0x06, 0x94,
-	0x57, 0x7f, 0xd4, 0x81, 0x52, 0x3c, 0x49, 0x69, 0x7b, 0x2b, 0xa3, 0x6e, 0xa5, 0x9c, 0xba, 0x5d,
-	0x86, 0x46, 0xbc, 0xeb, 0x73, 0x17, 0x9f, 0x00, 0xd2, 0xca, 0x58, 0x11, 0x95, 0x31, 0x45, 0x58,
-	0x55, 0xac, 0x88, 0xdf, 0x84, 0x65, 0xdb, 0x08, 0xc2, 0x01, 0x2b, 0xbc, 0x87, 0x96, 0x83, 0x83,
-	0xd0, 0x70, 0xc6, 0x54, 0x94, 0x15, 0x1d, 0x91, 0xb6, 0x2d, 0xd2, 0xf4, 0x20, 0x6a, 0x41, 0x0f,
-	0xa2, 0xe8, 0x9a, 0xf8, 0x5e, 0x7e, 0xc1, 0xe0, 0xd5, 0x62, 0xe6, 0x9e, 0x94, 0xbb, 0x99, 0x46,
-	0x35, 0xe2, 0xb0, 0xb3, 0xff, 0x09, 0x74, 0xc4, 0x46, 0x89, 0xf8, 0x6e, 0x8b, 0xe2, 0x2b, 0x12,
-	0xd8, 0xa6, 0x64, 0x78, 0x08, 0x28, 0xef, 0x3c, 0xd2, 0x3c, 0x53, 0x44, 0x9e, 0xcd, 0x92, 0x45,
-	0x8a, 0xa7, 0x65, 0x51, 0xd8, 0xff, 0x50, 0x06, 0x94, 0x44, 0x70, 0xf1, 0x81, 0x77, 0x91, 0xb0,
-	0x67, 0x1d, 0x96, 0xf2, 0xf1, 0x5d, 0x14, 0xd4, 0xa2, 0x5c, 0x74, 0x27, 0x8b, 0xc4, 0xca, 0xb2,
-	0xeb, 0xa5, 0xaf, 0xc5, 0xee, 0x9e, 0x85, 0xab, 0x57, 0xa7, 0x9e, 0x67, 0x88, 0x1e, 0xff, 0xc7,
-	0xd9, 0x6b, 0xa9, 0xcc, 0x7f, 0xdc, 0x96, 0xba, 0xe6, 0xdc, 0x92, 0x67, 0xde, 0x49, 0x15, 0x02,
-	0xe9, 0xda, 0x99, 0x02, 0xe9, 0xeb, 0xd0, 0xf6, 0xf1, 0xd0, 0x3b, 0xc2, 0x3e, 0xd3, 0x5a, 0x1a,
-	0xce, 0x56, 0xf5, 0x16, 0x07, 0x52, 0x7d, 0x9d, 0xff, 0xa6, 0xe9, 0x7f, 0x97, 0x60, 0x31, 0xe6,
-	0xf6, 0x99, 0x24, 0x39, 0xfb, 0x02, 0xc3, 0x53, 0x16, 0xdd, 0xc7, 0x72, 0xd1, 0x7d, 0xf7, 0xd4,
-	0x8c, 0xa7, 0xb0, 0xe4, 0xbe, 0x18, 0xf6, 0x7f, 0x06, 0x0b, 0xbc, 0xc0, 0x9d, 0x73, 0x95, 0x45,
-	0x0a, 0x0f, 0xcb, 0x50, 0x25, 0x9e, 0x39, 0xaa, 0x4e, 0xb2, 0x0f, 0xc6, 0xf7, 0xf4, 0x75, 0x67,
-	0xee, 0x2d, 0xdb, 0xc2, 0x6d, 0x67, 0xed, 0x37, 0xca, 0x00, 0x7b, 0x27, 0xee, 0xf0, 0x0e, 0x33,
-	0xf7, 0x9b, 0x50, 0x99, 0x75, 0x39, 0x8e, 0xf4, 0xa6, 0x5a, 0x4a, 0x7b, 0x16, 0xd0, 0x00, 0xa1,
-	0xb4, 0x52, 0xce, 0x96, 0x56, 0xa6, 0x15, 0x45, 0xa6, 0x3b, 0xf3, 0xef, 0x42, 0x85, 0x3a, 0x65,
-	0x76, 0x77, 0xac, 0xd0, 0xf9, 0x32, 0x1d, 0x80, 0x56, 0x21, 0xda, 0xdc, 0xb7, 0x5d, 0xb6, 0x7b,
-	0x53, 0xc7, 0x5e, 0xd6, 0xb3, 0x60, 0xf4, 0x02, 0x74, 0x58, 0x49, 0x2d, 0xee, 0xc8, 0xb2, 0xc3,
-	0x0c, 0x34, 0x1f, 0x1b, 0x34, 0x24, 0xb1, 0x01, 0xc1, 0x6b, 0xfa, 0xde, 0x78, 0x9c, 0x9a, 0x8e,
-	0xd5, 0x54, 0xb2, 0x60, 0xed, 0xf3, 0x12, 0x5c, 0x22, 0xfc, 0x7d, 0x32, 0xf1, 0x7d, 0x11, 0xe5,
-	0x49, 0xed, 0x0c, 0x65, 0x71, 0x67, 0xb8, 0x0d, 0x0b, 0xac, 0x70, 0x13, 0x45, 0xaa, 0x57, 0xa7,
-	0x69, 0x03, 0xd3, 0x1d, 0x3d, 0xea, 0x3e, 0x6f, 0xf6, 0x2f, 0x9c, 0xbe, 0xd7, 0xe6, 0x3b, 0x7d,
-	0x5f, 0xc8, 0x96, 0x77, 0x53, 0x6a, 0x55, 0x17, 0xf7, 0xb3, 0x87, 0xd0, 0xd6, 0xd3, 0xa6, 0x81,
-	0x10, 0x54, 0x52, 0xd7, 0x65, 0xe9, 0x6f, 0x9a, 0xb0, 0x1b, 0x63, 0x63, 0x68, 0x85, 0x27, 0x94,
-	0x9d, 0x55, 0x3d, 0xfe, 0x96, 0xdb, 0xa1, 0xf6, 0x5f, 0x0a, 0x5c, 0x8c, 0x8e, 0x67, 0xb9, 0x95,
-	0x9f, 0x5f, 0xa2, 0x1b, 0xb0, 0xc2, 0x4d, 0x3a, 0x63, 0xdb, 0x2c, 0x2c, 0x5f, 0x62, 0x30, 0x71,
-	0x19, 0x1b, 0xb0, 0x12, 0x52, 0xed, 0xca, 0x8e, 0x61, 0xf2, 0x5e, 0x62, 0x8d, 0xe2, 0x98, 0x22,
-	0xc7, 0xe3, 0xcf, 0xb0, 0xfb, 0x5f, 0x9c, 0xb5, 0xdc, 0x48, 0xc1, 0x9d, 0x38, 0x7c, 0x95, 0xda,
-	0x31, 0x5c, 0x66, 0x17, 0xd6, 0xf7, 0x45, 0x8a, 0xe6, 0x3a, 0x1d, 0x91, 0xae, 0x3b, 0xe3, 0xd3,
-	0xfe, 0x48, 0x81, 0x2b, 0x53, 0x30, 0xcf, 0x93, 0x17, 0xbe, 0x27, 0xc5, 0x3e, 0x25, 0x8b, 0x17,
-	0xf0, 0xb2, 0xab, 0x0f, 0x22, 0x91, 0x9f, 0x57, 0x60, 0x31, 0xd7, 0xe9, 0xcc, 0x3a, 0xf7, 0x32,
-	0x20, 0x22, 0x84, 0xf8, 0x71, 0x26, 0x2d, 0x8c, 0xf0, 0x1d, 0x56, 0x75, 0x27, 0x4e, 0xfc, 0x30,
-	0x73, 0xc7, 0x33, 0x31, 0xb2, 0x58, 0x6f, 0x76, 0x36, 0x12, 0x4b, 0xae, 0x32, 0xfd, 0x0d, 0x4e,
-	0x8e, 0xc0, 0xb5, 0x9d, 0x89, 0xc3, 0x8e, 0x51, 0xb8, 0x94, 0xd9, 0xae, 0x49, 0x50, 0x09, 0x60,
-	0x74, 0x00, 0x8b, 0xf4, 0x76, 0xe0, 0x24, 0x1c, 0x79, 0x24, 0x35, 0xa3, 0x74, 0xb1, 0xbd, 0xf9,
-	0xfb, 0x85, 0x31, 0x7d, 0xc0, 0x47, 0x13, 0xe2, 0x79, 0x76, 0xe6, 0x8a, 0xd0, 0x08, 0x8f, 0xe5,
-	0x0e, 0x3d, 0x27, 0xc6, 0x53, 0x3b, 0x23, 0x9e, 0x6d, 0x3e, 0x5a, 0xc4, 0x93, 0x86, 0xf6, 0x37,
-	0x61, 0x45, 0xba, 0xf4, 0x59, 0x1b, 0x7d, 0x35, 0x9d, 0xc3, 0xdd, 0x85, 0x65, 0xd9, 0xaa, 0xce,
-	0x31, 0x47, 0x8e, 0xe2, 0xb3, 0xcc, 0xa1, 0xfd, 0x59, 0x09, 0xda, 0x5b, 0xd8, 0xc6, 0x21, 0x7e,
-	0xba, 0xa7, 0xd7, 0xb9, 0xa3, 0xf8, 0x72, 0xfe, 0x28, 0x3e, 0x77, 0xaf, 0xa0, 0x22, 0xb9, 0x57,
-	0x70, 0x25, 0xbe, 0x4e, 0x41, 0x66, 0xa9, 0x8a, 0x31, 0x84, 0x89, 0x5e, 0x87, 0xd6, 0xd8, 0xb7,
-	0x1c, 0xc3, 0x3f, 0x19, 0x3c, 0xc2, 0x27, 0x01, 0xdf, 0x34, 0x7a, 0xd2, 0x6d, 0x67, 0x7b, 0x2b,
-	0xd0, 0x9b, 0xbc, 0xf7, 0xbb, 0xf8, 0x84, 0x5e, 0xd5, 0x88, 0x13, 0x42, 0x76, 0x9f, 0xaf, 0xa2,
-	0xa7, 0x20, 0x37, 0x5e, 0x82, 0x46, 0x7c, 0x05, 0x0a, 0xd5, 0xa1, 0x72, 0x6f, 0x62, 0xdb, 0xea,
-	0x05, 0xd4, 0x80, 0x2a, 0x4d, 0x19, 0x55, 0x85, 0xfc, 0xa4, 0xb1, 0x9f, 0x5a, 0xba, 0xf1, 0xff,
-	0xa0, 0x11, 0x5f, 0xc5, 0x40, 0x4d, 0x58, 0x78, 0xe8, 0xbe, 0xeb, 0x7a, 0xc7, 0xae, 0x7a, 0x01,
-	0x2d, 0x40, 0xf9, 0x8e, 0x6d, 0xab, 0x0a, 0x6a, 0x43, 0x63, 0x2f, 0xf4, 0xb1, 0x41, 0xc4, 0xa7,
-	0x96, 0x50, 0x07, 0xe0, 0x1d, 0x2b, 0x08, 0x3d, 0xdf, 0x1a, 0x1a, 0xb6, 0x5a, 0xbe, 0xf1, 0x19,
-	0x74, 0xc4, 0xca, 0x3c, 0x6a, 0x41, 0x7d, 0xc7, 0x0b, 0xdf, 0xfe, 0xd4, 0x0a, 0x42, 0xf5, 0x02,
-	0xe9, 0xbf, 0xe3, 0x85, 0xbb, 0x3e, 0x0e, 0xb0, 0x1b, 0xaa, 0x0a, 0x02, 0xa8, 0x7d, 0xe0, 0x6e,
-	0x59, 0xc1, 0x23, 0xb5, 0x84, 0x96, 0xf8, 0xa1, 0x9b, 0x61, 0x6f, 0xf3, 0x72, 0xb7, 0x5a, 0x26,
-	0xc3, 0xe3, 0xaf, 0x0a, 0x52, 0xa1, 0x15, 0x77, 0xb9, 0xbf, 0xfb, 0x50, 0xad, 0x32, 0xea, 0xc9,
-	0xcf, 0xda, 0x0d, 0x13, 0xd4, 0xec, 0x61, 0x31, 0x99, 0x93, 0x2d, 0x22, 0x06, 0xa9, 0x17, 0xc8,
-	0xca, 0xf8, 0x69, 0xbd, 0xaa, 0xa0, 0x2e, 0x34, 0x53, 0x67, 0xdf, 0x6a, 0x89, 0x00, 0xee, 0xfb,
-	0xe3, 0x21, 0xd7, 0x2d, 0x46, 0x02, 0x51, 0xd4, 0x2d, 0xc2, 0x89, 0xca, 0x8d, 0xbb, 0x50, 0x8f,
-	0x32, 0x1d, 0xd2, 0x95, 0xb3, 0x88, 0x7c, 0xaa, 0x17, 0xd0, 0x22, 0xb4, 0x85, 0x87, 0x7f, 0xaa,
-	0x82, 0x10, 0x74, 0xc4, 0xa7, 0xb9, 0x6a, 0xe9, 0xc6, 0x06, 0x40, 0x92, 0x0c, 0x10, 0x72, 0xb6,
-	0xdd, 0x23, 0xc3, 0xb6, 0x4c, 0x46, 0x1b, 0x69, 0x22, 0xdc, 0xa5, 0xdc, 0x61, 0x36, 0xab, 0x96,
-	0x6e, 0xbc, 0x09, 0xf5, 0x28, 0x76, 0x25, 0x70, 0x1d, 0x3b, 0xde, 0x11, 0x66, 0x92, 0xd9, 0xc3,
-	0x21, 0x93, 0xe3, 0x1d, 0x07, 0xbb, 0xa6, 0x5a, 0x22, 0x64, 0x3c, 0x1c, 0x9b, 0x46, 0x18, 0x5d,
-	0x72, 0x55, 0xcb, 0x1b, 0xff, 0xbe, 0x04, 0xc0, 0x4e, 0x7f, 0x3d, 0xcf, 0x37, 0x91, 0x4d, 0x6f,
-	0x81, 0x6c, 0x7a, 0xce, 0xd8, 0x73, 0xa3, 0xa3, 0xa9, 0x00, 0xad, 0x65, 0x8a, 0x2d, 0xec, 0x23,
-	0xdf, 0x91, 0xf3, 0xa6, 0xff, 0x9c, 0xb4, 0x7f, 0xa6, 0xb3, 0x76, 0x01, 0x39, 0x14, 0x1b, 0xc9,
-	0x37, 0x1e, 0x58, 0xc3, 0x47, 0xf1, 0x91, 0xf1, 0xf4, 0x27, 0xb3, 0x99, 0xae, 0x11, 0xbe, 0xeb,
-	0x52, 0x7c, 0x7b, 0xa1, 0x6f, 0xb9, 0xa3, 0x68, 0x77, 0xd4, 0x2e, 0xa0, 0xc7, 0x99, 0x07, 0xbb,
-	0x11, 0xc2, 0x8d, 0x22, 0x6f, 0x74, 0xcf, 0x87, 0xd2, 0x86, 0x6e, 0xe6, 0x9f, 0x11, 0xd0, 0x0d,
-	0xf9, 0xcb, 0x27, 0xd9, 0xbf, 0x38, 0xf4, 0x5f, 0x2a, 0xd4, 0x37, 0xc6, 0x66, 0x41, 0x47, 0x7c,
-	0xd2, 0x8f, 0xbe, 0x35, 0x6d, 0x82, 0xdc, 0xdb, 0xcb, 0xfe, 0x8d, 0x22, 0x5d, 0x63, 0x54, 0x1f,
-	0x31, 0xf5, 0x9d, 0x85, 0x4a, 0xfa, 0xdc, 0xb5, 0x7f, 0x5a, 0x60, 0xa2, 0x5d, 0x40, 0x9f, 0x90,
-	0x18, 0x22, 0xf3, 0x42, 0x14, 0xbd, 0x2c, 0xdf, 0xf7, 0xe4, 0x0f, 0x49, 0x67, 0x61, 0xf8, 0x28,
-	0x6b, 0x7c, 0xd3, 0xa9, 0xcf, 0x3d, 0x3d, 0x2f, 0x4e, 0x7d, 0x6a, 0xfa, 0xd3, 0xa8, 0x3f, 0x33,
-	0x06, 0x9b, 0xa5, 0x53, 0x92, 0xb7, 0x69, 0x59, 0x55, 0x4e, 0xb2, 0x99, 0xe9, 0x0f, 0xd9, 0x66,
-	0x61, 0x9b, 0x50, 0x23, 0xcd, 0x5e, 0x7b, 0x78, 0x65, 0xca, 0x81, 0x8a, 0xfc, 0x51, 0x6c, 0x7f,
-	0xad, 0x68, 0xf7, 0xb4, 0x2e, 0x8b, 0xef, 0x2e, 0xe5, 0x22, 0x92, 0xbe, 0x15, 0x95, 0xeb, 0xb2,
-	0xfc, 0x19, 0xa7, 0x76, 0x01, 0x3d, 0x10, 0x5c, 0x3d, 0x7a, 0x61, 0x9a, 0x2a, 0x88, 0xf7, 0xa0,
-	0x66, 0xf1, 0xed, 0x97, 0x01, 0x31, 0x4b, 0x75, 0x0f, 0xac, 0xd1, 0xc4, 0x37, 0x98, 0x1a, 0x4f,
-	0x73, 0x6e, 0xf9, 0xae, 0x11, 0x9a, 0x6f, 0x9f, 0x61, 0x44, 0xbc, 0xa4, 0x01, 0xc0, 0x7d, 0x1c,
-	0xbe, 0x4f, 0x1f, 0xe0, 0x05, 0xd9, 0x15, 0x25, 0xfe, 0x9b, 0x77, 0x88, 0x50, 0xbd, 0x38, 0xb3,
-	0x5f, 0x8c, 0x60, 0x1f, 0x9a, 0xf7, 0x49, 0x7e, 0x45, 0x63, 0xc6, 0x00, 0x4d, 0x1d, 0x19, 0xf5,
-	0x88, 0x50, 0xac, 0xce, 0xee, 0x98, 0x76, 0x9e, 0x99, 0x37, 0xa8, 0x68, 0xaa, 0x60, 0xf3, 0x2f,
-	0x63, 0xe5, 0xce, 0x73, 0xca, 0xa3, 0x56, 0xb6, 0x22, 0x7a, 0xa8, 0xf7, 0x0e, 0x36, 0xec, 0xf0,
-	0x70, 0xca, 0x8a, 0x52, 0x3d, 0x4e, 0x5f, 0x91, 0xd0, 0x31, 0xc6, 0x81, 0x61, 0x89, 0x59, 0xa1,
-	0x98, 0x98, 0xae, 0xcb, 0xa7, 0xc8, 0xf7, 0x2c, 0xa8, 0x7a, 0x06, 0x2c, 0x6e, 0xf9, 0xde, 0x58,
-	0x44, 0xf2, 0x8a, 0x14, 0x49, 0xae, 0x5f, 0x41, 0x14, 0x3f, 0x84, 0x56, 0x94, 0xff, 0xd3, 0x8c,
-	0x45, 0xce, 0x85, 0x74, 0x97, 0x82, 0x13, 0x7f, 0x0c, 0xdd, 0x4c, 0x61, 0x41, 0x2e, 0x74, 0x79,
-	0xf5, 0x61, 0xd6, 0xec, 0xc7, 0x80, 0xe8, 0xc3, 0x62, 0xf1, 0xbf, 0x11, 0xe4, 0xf1, 0x4d, 0xbe,
-	0x63, 0x84, 0x64, 0xbd, 0x70, 0xff, 0x58, 0xf2, 0xbf, 0x02, 0x2b, 0xd2, 0xe4, 0x3d, 0xeb, 0x10,
-	0xf8, 0xc5, 0xe7, 0x53, 0x2a, 0x0c, 0x59, 0x87, 0x70, 0xea, 0x88, 0x08, 0xff, 0xc6, 0xbf, 0x22,
-	0x68, 0xd0, 0x38, 0x8f, 0x4a, 0xeb, 0xff, 0xc2, 0xbc, 0x27, 0x1b, 0xe6, 0x7d, 0x0c, 0xdd, 0xcc,
-	0x83, 0x57, 0xb9, 0xd2, 0xca, 0x5f, 0xc5, 0x16, 0x88, 0x56, 0xc4, 0xb7, 0xa2, 0xf2, 0xad, 0x50,
-	0xfa, 0x9e, 0x74, 0xd6, 0xdc, 0x1f, 0xb2, 0xc7, 0xe4, 0xf1, 0xb9, 0xf0, 0x8b, 0x53, 0x4f, 0x28,
-	0xc4, 0x0b, 0xcc, 0x5f, 0x7e, 0x14, 0xf4, 0xf5, 0x8e, 0x40, 0x3f, 0x86, 0x6e, 0xe6, 0x89, 0x90,
-	0x5c, 0x63, 0xe4, 0xef, 0x88, 0x66, 0xcd, 0xfe, 0x05, 0x06, 0x4f, 0x26, 0x2c, 0x49, 0x5e, 0x64,
-	0xa0, 0xb5, 0x69, 0x81, 0xa8, 0xfc, 0xe9, 0xc6, 0xec, 0x05, 0xb5, 0x05, 0x33, 0xcd, 0xee, 0x37,
-	0x09, 0x91, 0xd9, 0x3f, 0x55, 0xea, 0xbf, 0x5c, 0xec, 0x1f, 0x98, 0xe2, 0x05, 0xed, 0x41, 0x8d,
-	0x3d, 0x1c, 0x42, 0xcf, 0xca, 0x0f, 0x61, 0x52, 0x8f, 0x8a, 0xfa, 0xb3, 0x9e, 0x1e, 0x05, 0x13,
-	0x3b, 0x24, 0xf4, 0xff, 0x08, 0x3a, 0x0c, 0x14, 0x33, 0xe8, 0x09, 0x4e, 0xbe, 0x07, 0x55, 0xea,
-	0xda, 0x91, 0xf4, 0x40, 0x21, 0xfd, 0x3c, 0xa8, 0x3f, 0xfb, 0x45, 0x50, 0x42, 0x71, 0x93, 0x8e,
-	0x64, 0x55, 0x9d, 0x27, 0x39, 0xf5, 0x4d, 0x05, 0xfd, 0x08, 0xda, 0x6c, 0xf2, 0x88, 0x1b, 0x4f,
-	0x92, 0xf2, 0x21, 0x2c, 0xa5, 0x28, 0x7f, 0x1a, 0x28, 0x6e, 0x2a, 0xff, 0xcb, 0xa3, 0xfb, 0x4f,
-	0xe9, 0xf3, 0x9c, 0xec, 0x05, 0x34, 0xb4, 0x76, 0xb6, 0x5b, 0x74, 0xfd, 0xf5, 0xc2, 0xfd, 0x63,
-	0xcc, 0x3f, 0x01, 0x35, 0x7b, 0x54, 0x88, 0x5e, 0x9a, 0xe6, 0x4b, 0x64, 0x38, 0x67, 0x38, 0x92,
-	0x1f, 0x40, 0x8d, 0xd5, 0x88, 0xe5, 0x06, 0x28, 0xd4, 0x8f, 0x67, 0xcc, 0x75, 0xf7, 0x3b, 0x1f,
-	0x6d, 0x8c, 0xac, 0xf0, 0x70, 0xb2, 0x4f, 0x5a, 0xd6, 0x59, 0xd7, 0x57, 0x2c, 0x8f, 0xff, 0x5a,
-	0x8f, 0x64, 0xb9, 0x4e, 0x47, 0xaf, 0x53, 0x04, 0xe3, 0xfd, 0xfd, 0x1a, 0xfd, 0xbc, 0xf5, 0x3f,
-	0x01, 0x00, 0x00, 0xff, 0xff, 0x83, 0xe6, 0x60, 0xbe, 0x4e, 0x54, 0x00, 0x00,
+	// 5178 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xec, 0x7c, 0x4b, 0x6c, 0x1c, 0x47,
+	0x7a, 0xb0, 0x7a, 0x5e, 0x9c, 0xf9, 0xe6, 0xd5, 0x2c, 0x8a, 0xd2, 0x78, 0x2c, 0xc9, 0x74, 0xcb,
+	0x0f, 0x2e, 0x65, 0x53, 0x32, 0xb5, 0xf6, 0x6a, 0xfd, 0xf8, 0xfd, 0x4b, 0xa4, 0x25, 0x73, 0x6d,
+	0xd3, 0x4c, 0x53, 0xf2, 0x06, 0x5e, 0xaf, 0xc7, 0x3d, 0xd3, 0x35, 0x64, 0x43, 0x3d, 0xdd, 0xa3,
+	0xee, 0x1e, 0xd2, 0x74, 0x80, 0x20, 0x87, 0x5c, 0xb2, 0xc9, 0x06, 0x41, 0x2e, 0xc9, 0x21, 0xc8,
+	0x21, 0x41, 0x80, 0x4d, 0xb2, 0xb9, 0x04, 0xc9, 0x21, 0x48, 0x0e, 0xb9, 0x05, 0xc8, 0x21, 0x0f,
+	0x20, 0xd7, 0xdc, 0x72, 0xcc, 0x29, 0xc8, 0x22, 0x30, 0x90, 0x43, 0x50, 0x8f, 0x7e, 0x54, 0x77,
+	0x0d, 0xa7, 0xc9, 0x91, 0xd6, 0x76, 0x90, 0xdb, 0xf4, 0x57, 0x8f, 0xef, 0xab, 0xaf, 0xbe, 0x77,
+	0x55, 0x0d, 0x2c, 0x3e, 0x9a, 0x60, 0xef, 0xb8, 0x37, 0x70, 0x5d, 0xcf, 0x5c, 0x1f, 0x7b, 0x6e,
+	0xe0, 0x22, 0x34, 0xb2, 0xec, 0xc3, 0x89, 0xcf, 0xbe, 0xd6, 0x69, 0x7b, 0xb7, 0x31, 0x70, 0x47,
+	0x23, 0xd7, 0x61, 0xb0, 0x6e, 0x23, 0xd9, 0xa3, 0xdb, 0xb2, 0x9c, 0x00, 0x7b, 0x8e, 0x61, 0x87,
+	0xad, 0xfe, 0xe0, 0x00, 0x8f, 0x0c, 0xfe, 0x55, 0x1b, 0xf9, 0xfb, 0xfc, 0xa7, 0x6a, 0x1a, 0x81,
+	0x91, 0x44, 0xd5, 0x5d, 0xb4, 0x1c, 0x13, 0x7f, 0x9e, 0x04, 0x69, 0xbf, 0xaa, 0xc0, 0x85, 0xbd,
+	0x03, 0xf7, 0x68, 0xd3, 0xb5, 0x6d, 0x3c, 0x08, 0x2c, 0xd7, 0xf1, 0x75, 0xfc, 0x68, 0x82, 0xfd,
+	0x00, 0xdd, 0x80, 0x52, 0xdf, 0xf0, 0x71, 0x47, 0x59, 0x51, 0x56, 0xeb, 0x1b, 0x97, 0xd6, 0x05,
+	0x3a, 0x39, 0x81, 0x1f, 0xf8, 0xfb, 0x77, 0x0c, 0x1f, 0xeb, 0xb4, 0x27, 0x42, 0x50, 0x32, 0xfb,
+	0xdb, 0x5b, 0x9d, 0xc2, 0x8a, 0xb2, 0x5a, 0xd4, 0xe9, 0x6f, 0xf4, 0x1c, 0x34, 0x07, 0xd1, 0xdc,
+	0xdb, 0x5b, 0x7e, 0xa7, 0xb8, 0x52, 0x5c, 0x2d, 0xea, 0x22, 0x50, 0xfb, 0x51, 0x01, 0x2e, 0x66,
+	0xc8, 0xf0, 0xc7, 0xae, 0xe3, 0x63, 0x74, 0x13, 0x2a, 0x7e, 0x60, 0x04, 0x13, 0x9f, 0x53, 0xf2,
+	0xb4, 0x94, 0x92, 0x3d, 0xda, 0x45, 0xe7, 0x5d, 0xb3, 0x68, 0x0b, 0x12, 0xb4, 0xe8, 0x15, 0x38,
+	0x6f, 0x39, 0x1f, 0xe0, 0x91, 0xeb, 0x1d, 0xf7, 0xc6, 0xd8, 0x1b, 0x60, 0x27, 0x30, 0xf6, 0x71,
+	0x48, 0xe3, 0x52, 0xd8, 0xb6, 0x1b, 0x37, 0xa1, 0xd7, 0xe0, 0x22, 0xdb, 0x43, 0x1f, 0x7b, 0x87,
+	0xd6, 0x00, 0xf7, 0x8c, 0x43, 0xc3, 0xb2, 0x8d, 0xbe, 0x8d, 0x3b, 0xa5, 0x95, 0xe2, 0x6a, 0x55,
+	0x5f, 0xa6, 0xcd, 0x7b, 0xac, 0xf5, 0x76, 0xd8, 0x88, 0xbe, 0x05, 0xaa, 0x87, 0x87, 0x1e, 0xf6,
+	0x0f, 0x7a, 0x63, 0xcf, 0xdd, 0xf7, 0xb0, 0xef, 0x77, 0xca, 0x14, 0x4d, 0x9b, 0xc3, 0x77, 0x39,
+	0x58, 0xfb, 0x23, 0x05, 0x96, 0x09, 0x33, 0x76, 0x0d, 0x2f, 0xb0, 0x9e, 0xc0, 0x96, 0x68, 0xd0,
+	0x48, 0xb2, 0xa1, 0x53, 0xa4, 0x6d, 0x02, 0x8c, 0xf4, 0x19, 0x87, 0xe8, 0x09, 0xfb, 0x4a, 0x94,
+	0x54, 0x01, 0xa6, 0xfd, 0x23, 0x97, 0x9d, 0x24, 0x9d, 0xf3, 0xec, 0x59, 0x1a, 0x67, 0x21, 0x8b,
+	0xf3, 0x2c, 0x3b, 0x26, 0xe3, 0x7c, 0x49, 0xce, 0xf9, 0xff, 0x28, 0xc2, 0xf2, 0xfb, 0xae, 0x61,
+	0xc6, 0x62, 0xf8, 0xf3, 0xe7, 0xfc, 0x5b, 0x50, 0x61, 0x1a, 0xdd, 0x29, 0x51, 0x5c, 0xcf, 0x8b,
+	0xb8, 0x58, 0xdb, 0x7a, 0x4c, 0xe1, 0x1e, 0x05, 0xe8, 0x7c, 0x10, 0x7a, 0x1e, 0x5a, 0x1e, 0x1e,
+	0xdb, 0xd6, 0xc0, 0xe8, 0x39, 0x93, 0x51, 0x1f, 0x7b, 0x9d, 0xf2, 0x8a, 0xb2, 0x5a, 0xd6, 0x9b,
+	0x1c, 0xba, 0x43, 0x81, 0xe8, 0x33, 0x68, 0x0e, 0x2d, 0x6c, 0x9b, 0x3d, 0x6a, 0x12, 0xb6, 0xb7,
+	0x3a, 0x95, 0x95, 0xe2, 0x6a, 0x7d, 0xe3, 0x8d, 0xf5, 0xac, 0x35, 0x5a, 0x97, 0x72, 0x64, 0xfd,
+	0x2e, 0x19, 0xbe, 0xcd, 0x46, 0xbf, 0xe3, 0x04, 0xde, 0xb1, 0xde, 0x18, 0x26, 0x40, 0xa8, 0x03,
+	0x0b, 0x9c, 0xbd, 0x9d, 0x85, 0x15, 0x65, 0xb5, 0xaa, 0x87, 0x9f, 0xe8, 0x45, 0x68, 0x7b, 0xd8,
+	0x77, 0x27, 0xde, 0x00, 0xf7, 0xf6, 0x3d, 0x77, 0x32, 0xf6, 0x3b, 0xd5, 0x95, 0xe2, 0x6a, 0x4d,
+	0x6f, 0x85, 0xe0, 0x7b, 0x14, 0x8a, 0xae, 0x42, 0xd3, 0x76, 0x0d, 0xb3, 0x37, 0xf6, 0x2c, 0xd7,
+	0xb3, 0x82, 0xe3, 0x4e, 0x8d, 0x2e, 0xa5, 0x41, 0x80, 0xbb, 0x1c, 0xd6, 0x7d, 0x1b, 0x16, 0x33,
+	0xa4, 0x20, 0x15, 0x8a, 0x0f, 0xf1, 0x31, 0xdd, 0xad, 0xa2, 0x4e, 0x7e, 0xa2, 0xf3, 0x50, 0x3e,
+	0x34, 0xec, 0x09, 0xe6, 0xfb, 0xc1, 0x3e, 0x5e, 0x2f, 0xdc, 0x52, 0xb4, 0xdf, 0x53, 0xa0, 0xa3,
+	0x63, 0x1b, 0x1b, 0x3e, 0xfe, 0x2a, 0xf7, 0xfd, 0x02, 0x54, 0x1c, 0xd7, 0xc4, 0xdb, 0x5b, 0x74,
+	0xdf, 0x8b, 0x3a, 0xff, 0xd2, 0xbe, 0x54, 0xe0, 0xfc, 0x3d, 0x1c, 0x10, 0x5d, 0xb1, 0xfc, 0xc0,
+	0x1a, 0x44, 0xc6, 0xe0, 0x2d, 0x28, 0x7a, 0xf8, 0x11, 0xa7, 0xec, 0x9a, 0x48, 0x59, 0xe4, 0x23,
+	0x64, 0x23, 0x75, 0x32, 0x0e, 0x3d, 0x0b, 0x0d, 0x73, 0x64, 0xf7, 0x06, 0x07, 0x86, 0xe3, 0x60,
+	0x9b, 0x69, 0x5b, 0x4d, 0xaf, 0x9b, 0x23, 0x7b, 0x93, 0x83, 0xd0, 0x15, 0x00, 0x1f, 0xef, 0x8f,
+	0xb0, 0x13, 0xc4, 0x86, 0x3b, 0x01, 0x41, 0x6b, 0xb0, 0x38, 0xf4, 0xdc, 0x51, 0xcf, 0x3f, 0x30,
+	0x3c, 0xb3, 0x67, 0x63, 0xc3, 0xc4, 0x1e, 0xa5, 0xbe, 0xaa, 0xb7, 0x49, 0xc3, 0x1e, 0x81, 0xbf,
+	0x4f, 0xc1, 0xe8, 0x26, 0x94, 0xfd, 0x81, 0x3b, 0xc6, 0x54, 0x1c, 0x5b, 0x1b, 0x97, 0x65, 0x82,
+	0xb6, 0x65, 0x04, 0xc6, 0x1e, 0xe9, 0xa4, 0xb3, 0xbe, 0xda, 0xbf, 0x96, 0x98, 0x3e, 0x7e, 0xcd,
+	0x2d, 0x61, 0x42, 0x67, 0xcb, 0x8f, 0x47, 0x67, 0x2b, 0xb9, 0x74, 0x76, 0xe1, 0x64, 0x9d, 0xcd,
+	0x70, 0xed, 0x34, 0x3a, 0x5b, 0x9d, 0xa9, 0xb3, 0x35, 0xa9, 0xce, 0xbe, 0x03, 0x6d, 0x16, 0x65,
+	0x58, 0xce, 0xd0, 0xed, 0xd9, 0x96, 0x1f, 0x74, 0x80, 0x92, 0x79, 0x39, 0x2d, 0xa1, 0x26, 0xfe,
+	0x7c, 0x9d, 0x21, 0x76, 0x86, 0xae, 0xde, 0xb4, 0xc2, 0x9f, 0xef, 0x5b, 0x7e, 0x90, 0x55, 0xfd,
+	0xfa, 0x93, 0x50, 0xfd, 0xbf, 0x8d, 0x55, 0xff, 0xeb, 0x2e, 0x62, 0xb1, 0x79, 0x28, 0x0b, 0xe6,
+	0xe1, 0x8f, 0x15, 0x78, 0xea, 0x1e, 0x0e, 0x22, 0xf2, 0x89, 0xb6, 0xe3, 0xaf, 0x69, 0xc0, 0xf0,
+	0x67, 0x0a, 0x74, 0x65, 0xb4, 0xce, 0x13, 0x34, 0x7c, 0x0c, 0x17, 0x22, 0x1c, 0x3d, 0x13, 0xfb,
+	0x03, 0xcf, 0x1a, 0x93, 0xdf, 0xcc, 0xa0, 0xd5, 0x37, 0xae, 0xca, 0xb4, 0x23, 0x4d, 0xc1, 0x72,
+	0x34, 0xc5, 0x56, 0x62, 0x06, 0xed, 0xc7, 0x0a, 0x2c, 0x13, 0x03, 0xca, 0x2d, 0x1e, 0x11, 0xd3,
+	0x33, 0xf3, 0x55, 0xb4, 0xa5, 0x85, 0x8c, 0x2d, 0xcd, 0xc1, 0x63, 0x1a, 0xac, 0xa7, 0xe9, 0x99,
+	0x87, 0x77, 0xaf, 0x42, 0x99, 0x68, 0x69, 0xc8, 0xaa, 0x67, 0x64, 0xac, 0x4a, 0x22, 0x63, 0xbd,
+	0x35, 0x87, 0x51, 0x11, 0x1b, 0xf7, 0x39, 0xc4, 0x2d, 0xbd, 0xec, 0x82, 0x64, 0xd9, 0xbf, 0xa1,
+	0xc0, 0xc5, 0x0c, 0xc2, 0x79, 0xd6, 0xfd, 0x26, 0x54, 0xa8, 0xcb, 0x0a, 0x17, 0xfe, 0x9c, 0x74,
+	0xe1, 0x09, 0x74, 0xc4, 0x24, 0xe9, 0x7c, 0x8c, 0xe6, 0x82, 0x9a, 0x6e, 0x23, 0xce, 0x94, 0x3b,
+	0xd2, 0x9e, 0x63, 0x8c, 0x18, 0x03, 0x6a, 0x7a, 0x9d, 0xc3, 0x76, 0x8c, 0x11, 0x46, 0x4f, 0x41,
+	0x95, 0xa8, 0x6c, 0xcf, 0x32, 0xc3, 0xed, 0x5f, 0xa0, 0x2a, 0x6c, 0xfa, 0xe8, 0x32, 0x00, 0x6d,
+	0x32, 0x4c, 0xd3, 0x63, 0x7e, 0xb6, 0xa6, 0xd7, 0x08, 0xe4, 0x36, 0x01, 0x68, 0xbf, 0xab, 0xc0,
+	0x95, 0xbd, 0x63, 0x67, 0xb0, 0x83, 0x8f, 0x36, 0x3d, 0x6c, 0x04, 0x38, 0xb6, 0xec, 0x4f, 0x94,
+	0xf1, 0x68, 0x05, 0xea, 0x09, 0xfd, 0xe5, 0x22, 0x99, 0x04, 0x69, 0x7f, 0xae, 0x40, 0x83, 0xb8,
+	0x9a, 0x0f, 0x70, 0x60, 0x10, 0x11, 0x41, 0xdf, 0x85, 0x1a, 0xb5, 0xdb, 0xc1, 0xf1, 0x98, 0x51,
+	0xd3, 0xda, 0xb8, 0x24, 0xe3, 0x2e, 0x19, 0x74, 0xff, 0x78, 0x8c, 0xf5, 0xaa, 0xcd, 0x7f, 0xe5,
+	0xa2, 0x28, 0x6d, 0x65, 0x8a, 0x12, 0x4b, 0xf9, 0x0c, 0xd4, 0x47, 0x38, 0xf0, 0xac, 0x01, 0x23,
+	0xa2, 0x44, 0xb7, 0x02, 0x18, 0x88, 0x20, 0xd2, 0x7e, 0x5c, 0x81, 0x0b, 0xdf, 0x37, 0x82, 0xc1,
+	0xc1, 0xd6, 0x28, 0x0c, 0x75, 0xce, 0xce, 0xc7, 0xd8, 0x2e, 0x17, 0x92, 0x76, 0xf9, 0xb1, 0xd9,
+	0xfd, 0x48, 0x47, 0xcb, 0x32, 0x1d, 0x25, 0x29, 0xfe, 0xfa, 0x47, 0x5c, 0xcc, 0x12, 0x3a, 0x9a,
+	0x88, 0x48, 0x2a, 0x67, 0x89, 0x48, 0x36, 0xa1, 0x89, 0x3f, 0x1f, 0xd8, 0x13, 0x22, 0xaf, 0x14,
+	0x3b, 0x0b, 0x35, 0xae, 0x48, 0xb0, 0x27, 0x0d, 0x44, 0x83, 0x0f, 0xda, 0xe6, 0x34, 0x30, 0x59,
+	0x18, 0xe1, 0xc0, 0xa0, 0xf1, 0x44, 0x7d, 0x63, 0x65, 0x9a, 0x2c, 0x84, 0x02, 0xc4, 0xe4, 0x81,
+	0x7c, 0xa1, 0x4b, 0x50, 0xe3, 0xf1, 0xcf, 0xf6, 0x16, 0x8d, 0xfc, 0x8b, 0x7a, 0x0c, 0x40, 0x06,
+	0x34, 0xb9, 0xf5, 0xe4, 0x14, 0xb2, 0x28, 0xe3, 0x4d, 0x19, 0x02, 0xf9, 0x66, 0x27, 0x29, 0xf7,
+	0x79, 0x34, 0xe4, 0x27, 0x40, 0xa4, 0x86, 0xe0, 0x0e, 0x87, 0xb6, 0xe5, 0xe0, 0x1d, 0xb6, 0xc3,
+	0x75, 0x4a, 0x84, 0x08, 0x24, 0x31, 0xd3, 0x21, 0xf6, 0x7c, 0xcb, 0x75, 0x3a, 0x0d, 0xda, 0x1e,
+	0x7e, 0xca, 0x42, 0xa1, 0xe6, 0xe9, 0x43, 0xa1, 0x6e, 0x0f, 0x16, 0x33, 0x94, 0x4a, 0xa2, 0x9c,
+	0x6f, 0x27, 0xa3, 0x9c, 0xd9, 0x5b, 0x95, 0x88, 0x82, 0x7e, 0xa2, 0xc0, 0xf2, 0x03, 0xc7, 0x9f,
+	0xf4, 0x23, 0x16, 0x7d, 0x35, 0xea, 0x90, 0x36, 0xa2, 0xa5, 0x8c, 0x11, 0xd5, 0xfe, 0xb3, 0x0c,
+	0x6d, 0xbe, 0x0a, 0x22, 0x35, 0xd4, 0xe4, 0x5c, 0x82, 0x5a, 0xe4, 0x47, 0x39, 0x43, 0x62, 0x40,
+	0xda, 0x86, 0x15, 0x32, 0x36, 0x2c, 0x17, 0x69, 0x61, 0x54, 0x54, 0x4a, 0x44, 0x45, 0x97, 0x01,
+	0x86, 0xf6, 0xc4, 0x3f, 0xe8, 0x05, 0xd6, 0x08, 0xf3, 0xa8, 0xac, 0x46, 0x21, 0xf7, 0xad, 0x11,
+	0x46, 0xb7, 0xa1, 0xd1, 0xb7, 0x1c, 0xdb, 0xdd, 0xef, 0x8d, 0x8d, 0xe0, 0xc0, 0xe7, 0x09, 0xb6,
+	0x6c, 0x5b, 0x68, 0x0c, 0x7b, 0x87, 0xf6, 0xd5, 0xeb, 0x6c, 0xcc, 0x2e, 0x19, 0x82, 0xae, 0x40,
+	0xdd, 0x99, 0x8c, 0x7a, 0xee, 0xb0, 0xe7, 0xb9, 0x47, 0x3e, 0x4d, 0xa3, 0x8b, 0x7a, 0xcd, 0x99,
+	0x8c, 0x3e, 0x1c, 0xea, 0xee, 0x11, 0xf1, 0x63, 0x35, 0xe2, 0xd1, 0x7c, 0xdb, 0xdd, 0x67, 0x29,
+	0xf4, 0xec, 0xf9, 0xe3, 0x01, 0x64, 0xb4, 0x89, 0xed, 0xc0, 0xa0, 0xa3, 0x6b, 0xf9, 0x46, 0x47,
+	0x03, 0xd0, 0x0b, 0xd0, 0x1a, 0xb8, 0xa3, 0xb1, 0x41, 0x39, 0x74, 0xd7, 0x73, 0x47, 0x54, 0x01,
+	0x8b, 0x7a, 0x0a, 0x8a, 0x36, 0xa1, 0x1e, 0x2b, 0x81, 0xdf, 0xa9, 0x53, 0x3c, 0x9a, 0x4c, 0x4b,
+	0x13, 0xa1, 0x3c, 0x11, 0x50, 0x88, 0xb4, 0xc0, 0x27, 0x92, 0x11, 0x2a, 0xbb, 0x6f, 0x7d, 0x81,
+	0xb9, 0xa2, 0xd5, 0x39, 0x6c, 0xcf, 0xfa, 0x02, 0x93, 0x1c, 0xca, 0x72, 0x7c, 0xec, 0x05, 0x61,
+	0x46, 0xdb, 0x69, 0x52, 0xf1, 0x69, 0x32, 0x28, 0x17, 0x6c, 0xb4, 0x05, 0x2d, 0x3f, 0x30, 0xbc,
+	0xa0, 0x37, 0x76, 0x7d, 0x2a, 0x00, 0x9d, 0xd6, 0x8a, 0x92, 0x55, 0x49, 0x52, 0x45, 0xfd, 0xc0,
+	0xdf, 0xdf, 0xe5, 0x9d, 0xf4, 0x26, 0x1d, 0x14, 0x7e, 0x92, 0x59, 0x28, 0x27, 0xe2, 0x59, 0xda,
+	0xb9, 0x66, 0xa1, 0x83, 0xa2, 0x59, 0x56, 0x49, 0x4e, 0x65, 0x98, 0xa4, 0x3c, 0xf8, 0x11, 0xb7,
+	0x20, 0x2a, 0x5d, 0x58, 0x1a, 0xac, 0xfd, 0x61, 0x11, 0x5a, 0x22, 0x7b, 0x88, 0xd9, 0x61, 0xa9,
+	0x5b, 0x28, 0xf3, 0xe1, 0x27, 0x61, 0x16, 0x76, 0xc8, 0x68, 0x96, 0x27, 0x52, 0x91, 0xaf, 0xea,
+	0x75, 0x06, 0xa3, 0x13, 0x10, 0xd1, 0x65, 0x9b, 0x42, 0xf5, 0xac, 0x48, 0x19, 0x55, 0xa3, 0x10,
+	0x1a, 0xaa, 0x74, 0x60, 0x21, 0x4c, 0x31, 0x99, 0xc0, 0x87, 0x9f, 0xa4, 0xa5, 0x3f, 0xb1, 0x28,
+	0x56, 0x26, 0xf0, 0xe1, 0x27, 0xda, 0x82, 0x06, 0x9b, 0x72, 0x6c, 0x78, 0xc6, 0x28, 0x14, 0xf7,
+	0x67, 0xa5, 0x26, 0xe3, 0x3d, 0x7c, 0xfc, 0x11, 0xb1, 0x3e, 0xbb, 0x86, 0xe5, 0xe9, 0x4c, 0x3c,
+	0x76, 0xe9, 0x28, 0xb4, 0x0a, 0x2a, 0x9b, 0x65, 0x68, 0xd9, 0x98, 0x2b, 0xce, 0x02, 0xcb, 0x33,
+	0x29, 0xfc, 0xae, 0x65, 0x63, 0xa6, 0x1b, 0xd1, 0x12, 0xa8, 0x40, 0x54, 0x99, 0x6a, 0x50, 0x08,
+	0x15, 0x87, 0xab, 0xc0, 0xac, 0x68, 0x2f, 0xb4, 0xcd, 0xcc, 0x81, 0x30, 0x1a, 0x39, 0x5b, 0x69,
+	0x48, 0x36, 0x19, 0x31, 0xe5, 0x02, 0xb6, 0x1c, 0x67, 0x32, 0xa2, 0xaa, 0xb5, 0x01, 0xcb, 0x83,
+	0x89, 0xe7, 0x31, 0xf7, 0x92, 0x9c, 0x87, 0xe5, 0xa1, 0x4b, 0xbc, 0x71, 0x3b, 0x31, 0x9d, 0xf6,
+	0xdb, 0x65, 0x58, 0x22, 0x56, 0x89, 0x1b, 0xa8, 0x39, 0x82, 0x8a, 0xcb, 0x00, 0xa6, 0x1f, 0xf4,
+	0x04, 0x4b, 0x5a, 0x33, 0xfd, 0x80, 0xbb, 0x9c, 0xef, 0x86, 0x31, 0x41, 0x71, 0x7a, 0x8a, 0x93,
+	0xb2, 0x92, 0xd9, 0xb8, 0xe0, 0x4c, 0xd5, 0xc5, 0xab, 0xd0, 0xe4, 0x45, 0x00, 0x21, 0x19, 0x6d,
+	0x30, 0xe0, 0x8e, 0xdc, 0xd6, 0x57, 0xa4, 0x55, 0xce, 0x44, 0x6c, 0xb0, 0x30, 0x5f, 0x6c, 0x50,
+	0x4d, 0xc7, 0x06, 0x77, 0xa1, 0x2d, 0xaa, 0x67, 0x68, 0xdf, 0x66, 0xe8, 0x67, 0x4b, 0xd0, 0x4f,
+	0x3f, 0xe9, 0xda, 0x41, 0x74, 0xed, 0x57, 0xa1, 0xe9, 0x60, 0x6c, 0xf6, 0x02, 0xcf, 0x70, 0xfc,
+	0x21, 0xf6, 0xa8, 0x58, 0x54, 0xf5, 0x06, 0x01, 0xde, 0xe7, 0x30, 0xf4, 0x26, 0x00, 0x5d, 0x23,
+	0xab, 0x7b, 0x35, 0xa6, 0xd7, 0xbd, 0xa8, 0xd0, 0x90, 0x4e, 0x7a, 0xcd, 0x0e, 0x7f, 0x3e, 0xa6,
+	0xe8, 0x41, 0xfb, 0x87, 0x02, 0x5c, 0xe0, 0x25, 0x8e, 0xf9, 0xe5, 0x72, 0x9a, 0x77, 0x0f, 0xdd,
+	0x63, 0xf1, 0x84, 0xa2, 0x41, 0x29, 0x47, 0x00, 0x5c, 0x96, 0x04, 0xc0, 0x62, 0xe2, 0x5c, 0xc9,
+	0x24, 0xce, 0x51, 0x61, 0x71, 0x21, 0x7f, 0x61, 0x91, 0x94, 0x84, 0x68, 0x36, 0x47, 0x65, 0xa7,
+	0xa6, 0xb3, 0x8f, 0x5c, 0xbb, 0xaa, 0xfd, 0x4e, 0x01, 0x9a, 0x7b, 0xd8, 0xf0, 0x06, 0x07, 0x21,
+	0x1f, 0x5f, 0x4b, 0x16, 0x62, 0x9f, 0x9b, 0x52, 0x88, 0x15, 0x86, 0x7c, 0x63, 0x2a, 0xb0, 0x04,
+	0x41, 0xe0, 0x06, 0x46, 0x44, 0x25, 0x29, 0x50, 0xf2, 0xea, 0x64, 0x9b, 0x36, 0x70, 0x52, 0x77,
+	0x26, 0x23, 0xed, 0xdf, 0x15, 0x68, 0xfc, 0x02, 0x99, 0x26, 0x64, 0xcc, 0xad, 0x24, 0x63, 0x5e,
+	0x98, 0xc2, 0x18, 0x9d, 0x24, 0x66, 0xf8, 0x10, 0x7f, 0xe3, 0x8a, 0xd3, 0x7f, 0xa7, 0x40, 0x97,
+	0xa4, 0xe5, 0x3a, 0xb3, 0x3b, 0xf3, 0x6b, 0xd7, 0x55, 0x68, 0x1e, 0x0a, 0x01, 0x70, 0x81, 0x0a,
+	0x67, 0xe3, 0x30, 0x59, 0x46, 0xd0, 0xc9, 0x69, 0x16, 0xab, 0x15, 0xf3, 0xc5, 0x86, 0x6e, 0xe0,
+	0x45, 0x19, 0xd5, 0x29, 0xe2, 0xa8, 0x85, 0x68, 0x7b, 0x22, 0x50, 0xfb, 0x4d, 0x05, 0x96, 0x24,
+	0x1d, 0xd1, 0x45, 0x58, 0xe0, 0x25, 0x8b, 0x8e, 0x92, 0xd0, 0x77, 0x93, 0x6c, 0x4f, 0x5c, 0x74,
+	0xb3, 0xcc, 0x6c, 0x54, 0x6d, 0x92, 0x2c, 0x3c, 0xca, 0xcf, 0xcc, 0xcc, 0xfe, 0x98, 0x3e, 0xea,
+	0x42, 0x95, 0x5b, 0xd3, 0x30, 0xf1, 0x8d, 0xbe, 0xb5, 0x87, 0x80, 0xee, 0xe1, 0xd8, 0x77, 0xcd,
+	0xc3, 0xd1, 0xd8, 0xde, 0xc4, 0x84, 0x26, 0x8d, 0x90, 0xa9, 0xfd, 0x9b, 0x02, 0x4b, 0x02, 0xb6,
+	0x79, 0x4a, 0x4b, 0xb1, 0x7f, 0x2d, 0x9c, 0xc5, 0xbf, 0x0a, 0xe5, 0x93, 0xe2, 0xa9, 0xca, 0x27,
+	0x57, 0x00, 0x22, 0xfe, 0x87, 0x1c, 0x4d, 0x40, 0xb4, 0xbf, 0x51, 0xe0, 0xc2, 0xbb, 0x86, 0x63,
+	0xba, 0xc3, 0xe1, 0xfc, 0xa2, 0xba, 0x09, 0x42, 0xaa, 0x9c, 0xb7, 0x80, 0x28, 0x0c, 0x42, 0xd7,
+	0x60, 0xd1, 0x63, 0x9e, 0xc9, 0x14, 0x65, 0xb9, 0xa8, 0xab, 0x61, 0x43, 0x24, 0xa3, 0x3f, 0x2d,
+	0x00, 0x22, 0xab, 0xbe, 0x63, 0xd8, 0x86, 0x33, 0xc0, 0x67, 0x27, 0xfd, 0x79, 0x68, 0x09, 0x21,
+	0x4c, 0x74, 0x35, 0x20, 0x19, 0xc3, 0xf8, 0xe8, 0x3d, 0x68, 0xf5, 0x19, 0xaa, 0x9e, 0x87, 0x0d,
+	0xdf, 0x75, 0xf8, 0x76, 0x48, 0x6b, 0x85, 0xf7, 0x3d, 0x6b, 0x7f, 0x1f, 0x7b, 0x9b, 0xae, 0x63,
+	0xf2, 0x48, 0xbf, 0x1f, 0x92, 0x49, 0x86, 0x12, 0x65, 0x88, 0xe3, 0xb9, 0x68, 0x73, 0xa2, 0x80,
+	0x8e, 0xb2, 0xc2, 0xc7, 0x86, 0x1d, 0x33, 0x22, 0xf6, 0x86, 0x2a, 0x6b, 0xd8, 0x9b, 0x5e, 0x2a,
+	0x96, 0xc4, 0x57, 0xda, 0x5f, 0x28, 0x80, 0xa2, 0x74, 0x9e, 0xd6, 0x3f, 0xa8, 0x46, 0xa7, 0x87,
+	0x2a, 0xd9, 0xa1, 0x24, 0xb6, 0x32, 0xc3, 0x91, 0xdc, 0x04, 0xc5, 0x00, 0xea, 0x23, 0x29, 0xd1,
+	0x3d, 0x22, 0x79, 0xd8, 0x0c, 0xd3, 0x65, 0x06, 0x7c, 0x9f, 0xc2, 0xc4, 0xf0, 0xac, 0x94, 0x0e,
+	0xcf, 0x92, 0x95, 0xd0, 0xb2, 0x50, 0x09, 0xd5, 0x7e, 0x52, 0x00, 0x95, 0xba, 0x90, 0xcd, 0xb8,
+	0xa4, 0x95, 0x8b, 0xe8, 0xab, 0xd0, 0xe4, 0x57, 0x6b, 0x04, 0xc2, 0x1b, 0x8f, 0x12, 0x93, 0xa1,
+	0x1b, 0x70, 0x9e, 0x75, 0xf2, 0xb0, 0x3f, 0xb1, 0xe3, 0x4c, 0x91, 0x25, 0x40, 0xe8, 0x11, 0xf3,
+	0x5d, 0xa4, 0x29, 0x1c, 0xf1, 0x00, 0x2e, 0xec, 0xdb, 0x6e, 0xdf, 0xb0, 0x7b, 0xe2, 0xf6, 0xb0,
+	0x3d, 0xcc, 0x21, 0xf1, 0xe7, 0xd9, 0xf0, 0xbd, 0xe4, 0x1e, 0xfa, 0xe8, 0x0e, 0x29, 0x5e, 0xe1,
+	0x87, 0x71, 0xfa, 0x58, 0xce, 0x93, 0x3e, 0x36, 0xc8, 0x98, 0xf0, 0x4b, 0xfb, 0x7d, 0x05, 0xda,
+	0xa9, 0x73, 0x8c, 0x74, 0xb1, 0x43, 0xc9, 0x16, 0x3b, 0x6e, 0x41, 0x99, 0x58, 0x2a, 0xe6, 0x5b,
+	0x5a, 0xf2, 0x44, 0x5c, 0x9c, 0x55, 0x67, 0x03, 0xd0, 0x75, 0x58, 0x92, 0xdc, 0xbc, 0xe0, 0xdb,
+	0x8f, 0xb2, 0x17, 0x2f, 0xb4, 0x9f, 0x95, 0xa0, 0x9e, 0x60, 0xc5, 0x8c, 0x3a, 0xcd, 0x63, 0xa9,
+	0x47, 0x4f, 0x3b, 0x44, 0x27, 0x22, 0x37, 0xc2, 0x23, 0x96, 0x2b, 0xf2, 0xc4, 0x75, 0x84, 0x47,
+	0x34, 0x53, 0x4c, 0x26, 0x81, 0x15, 0x31, 0x09, 0x14, 0xd3, 0xe4, 0x85, 0x13, 0xd2, 0xe4, 0xaa,
+	0x98, 0x26, 0x0b, 0x2a, 0x54, 0x4b, 0xab, 0x50, 0xde, 0xd2, 0xc9, 0x0d, 0x58, 0x1a, 0xb0, 0x7a,
+	0xff, 0x9d, 0xe3, 0xcd, 0xa8, 0x89, 0x07, 0xa5, 0xb2, 0x26, 0x74, 0x37, 0x2e, 0x8a, 0xb2, 0x5d,
+	0x66, 0x49, 0x87, 0x3c, 0x0b, 0xe7, 0x7b, 0xc3, 0x36, 0xb9, 0xe1, 0x27, 0xbe, 0xd2, 0x45, 0x9b,
+	0xe6, 0x99, 0x8a, 0x36, 0xcf, 0x40, 0x3d, 0x8c, 0x54, 0x88, 0xa6, 0xb7, 0x98, 0xd1, 0xe3, 0x20,
+	0x12, 0x01, 0x24, 0xed, 0x40, 0x5b, 0x3c, 0x11, 0x49, 0xd7, 0x30, 0xd4, 0x6c, 0x0d, 0xe3, 0x22,
+	0x2c, 0x58, 0x7e, 0x6f, 0x68, 0x3c, 0xc4, 0x9d, 0x45, 0xda, 0x5a, 0xb1, 0xfc, 0xbb, 0xc6, 0x43,
+	0xac, 0xfd, 0x53, 0x11, 0x5a, 0xb1, 0x83, 0xcd, 0x6d, 0x41, 0xf2, 0xdc, 0x3e, 0xda, 0x01, 0x35,
+	0xfa, 0x66, 0x1c, 0x3e, 0x31, 0x07, 0x4f, 0x1f, 0x33, 0xb6, 0xc7, 0x22, 0x40, 0x74, 0xf7, 0xa5,
+	0x53, 0xb9, 0xfb, 0x39, 0xaf, 0x1c, 0xdc, 0x84, 0xe5, 0xc8, 0xf7, 0x0a, 0xcb, 0x66, 0x09, 0xd6,
+	0xf9, 0xb0, 0x71, 0x37, 0xb9, 0xfc, 0x29, 0x26, 0x60, 0x61, 0x9a, 0x09, 0x48, 0x8b, 0x40, 0x35,
+	0x23, 0x02, 0xd9, 0x9b, 0x0f, 0x35, 0xc9, 0xcd, 0x07, 0xed, 0x01, 0x2c, 0xd1, 0x02, 0x35, 0x39,
+	0x9b, 0xed, 0xe3, 0x28, 0x05, 0xc8, 0xb3, 0xad, 0x5d, 0xa8, 0xa6, 0xb2, 0x88, 0xe8, 0x5b, 0xfb,
+	0x91, 0x02, 0x17, 0xb2, 0xf3, 0x52, 0x89, 0x89, 0x0d, 0x89, 0x22, 0x18, 0x92, 0x5f, 0x84, 0xa5,
+	0x44, 0x44, 0x29, 0xcc, 0x3c, 0x25, 0x02, 0x97, 0x10, 0xae, 0xa3, 0x78, 0x8e, 0x10, 0xa6, 0xfd,
+	0x4c, 0x89, 0xea, 0xfc, 0x04, 0xb6, 0x4f, 0x0f, 0x51, 0x88, 0x5f, 0x73, 0x1d, 0xdb, 0x72, 0x70,
+	0x4f, 0x20, 0xa7, 0xc1, 0x80, 0xbc, 0xe0, 0xf2, 0x2e, 0xb4, 0x79, 0xa7, 0xc8, 0x3d, 0xe5, 0x0c,
+	0xc8, 0x5a, 0x6c, 0x5c, 0xe4, 0x98, 0x9e, 0x87, 0x16, 0x3f, 0xdd, 0x08, 0xf1, 0x15, 0x65, 0x67,
+	0x1e, 0xdf, 0x03, 0x35, 0xec, 0x76, 0x5a, 0x87, 0xd8, 0xe6, 0x03, 0xa3, 0xc0, 0xee, 0xd7, 0x14,
+	0xe8, 0x88, 0xee, 0x31, 0xb1, 0xfc, 0xd3, 0x87, 0x77, 0x6f, 0x88, 0x67, 0xda, 0xcf, 0x9f, 0x40,
+	0x4f, 0x8c, 0x27, 0x3c, 0xd9, 0xfe, 0xad, 0x02, 0xbd, 0xa0, 0x40, 0x52, 0xbd, 0x2d, 0xcb, 0x0f,
+	0x3c, 0xab, 0x3f, 0x99, 0xef, 0x94, 0xd5, 0x80, 0xfa, 0xe0, 0x00, 0x0f, 0x1e, 0x8e, 0x5d, 0x2b,
+	0xde, 0x95, 0xb7, 0x65, 0x34, 0x4d, 0x47, 0xbb, 0xbe, 0x19, 0xcf, 0xc0, 0x8e, 0xa9, 0x92, 0x73,
+	0x76, 0x7f, 0x08, 0x6a, 0xba, 0x43, 0xf2, 0x74, 0xa8, 0xc6, 0x4e, 0x87, 0x6e, 0x8a, 0xa7, 0x43,
+	0x33, 0x22, 0x8d, 0xc4, 0xe1, 0xd0, 0x5f, 0x16, 0xe0, 0x69, 0x29, 0x6d, 0xf3, 0x64, 0x49, 0xd3,
+	0xea, 0x48, 0x77, 0xa0, 0x9a, 0x4a, 0x6a, 0x5f, 0x38, 0x61, 0xff, 0x78, 0xdd, 0x95, 0x95, 0x06,
+	0xfd, 0x38, 0xb6, 0x8a, 0x15, 0xbe, 0x34, 0x7d, 0x0e, 0xae, 0x77, 0xc2, 0x1c, 0xe1, 0x38, 0x72,
+	0x76, 0xc3, 0x0a, 0x06, 0xbd, 0x43, 0x0b, 0x1f, 0x85, 0x67, 0xaf, 0x57, 0xa4, 0xa6, 0x99, 0xf6,
+	0xfb, 0xc8, 0xc2, 0x47, 0x7a, 0xdd, 0x8e, 0x7e, 0xfb, 0xda, 0x4f, 0xcb, 0x00, 0x71, 0x1b, 0xc9,
+	0xce, 0x62, 0x9d, 0xe7, 0x4a, 0x9c, 0x80, 0x90, 0x58, 0x42, 0x8c, 0x5c, 0xc3, 0x4f, 0xa4, 0xc7,
+	0x67, 0x1f, 0x26, 0x29, 0x02, 0x32, 0xbe, 0x5c, 0x3f, 0x99, 0x96, 0x90, 0x45, 0x64, 0xcb, 0xb8,
+	0xcc, 0xf8, 0x31, 0x04, 0xbd, 0x0c, 0x68, 0xdf, 0x73, 0x8f, 0x2c, 0x67, 0x3f, 0x99, 0x6f, 0xb0,
+	0xb4, 0x64, 0x91, 0xb7, 0x24, 0x12, 0x8e, 0x4f, 0x41, 0x4d, 0x75, 0x0f, 0x59, 0x72, 0x73, 0x06,
+	0x19, 0xf7, 0x84, 0xb9, 0xb8, 0xf8, 0xb6, 0x45, 0x0c, 0xf4, 0xa0, 0xf5, 0xbe, 0xe1, 0xed, 0xe3,
+	0x70, 0x47, 0x79, 0x1c, 0x26, 0x02, 0xd1, 0xcb, 0xb0, 0xc4, 0x4f, 0xc3, 0x42, 0x62, 0x12, 0xa7,
+	0x62, 0x2a, 0x3d, 0x15, 0xe3, 0xe8, 0x68, 0xf0, 0x76, 0x0b, 0x9e, 0xe2, 0xdd, 0x4d, 0x6c, 0xe3,
+	0x00, 0xf7, 0xfa, 0x93, 0xe1, 0x10, 0x7b, 0x6c, 0x10, 0x8b, 0xd7, 0x96, 0xe9, 0xa0, 0x2d, 0xda,
+	0x7c, 0x87, 0xb6, 0xd2, 0x91, 0xeb, 0xb0, 0xc4, 0x87, 0x0c, 0x5d, 0xef, 0x88, 0x54, 0x8f, 0x3c,
+	0x12, 0x4b, 0x11, 0xcf, 0xa4, 0xe8, 0x8b, 0xac, 0xe9, 0x2e, 0x6b, 0xd1, 0x8d, 0x00, 0x77, 0x7b,
+	0xa0, 0xa6, 0xd9, 0x2d, 0x39, 0x9f, 0x7d, 0x55, 0xd4, 0xc0, 0x93, 0x0c, 0x25, 0x99, 0x26, 0xa1,
+	0x83, 0x5d, 0x03, 0xce, 0xcb, 0x18, 0x29, 0x41, 0x72, 0x66, 0x35, 0x7f, 0x1b, 0xea, 0x09, 0xe4,
+	0x53, 0xdd, 0x5f, 0xa2, 0x22, 0x5e, 0x10, 0x2a, 0xe2, 0xda, 0xaf, 0x14, 0x01, 0x65, 0xf5, 0x12,
+	0xb5, 0xa0, 0x10, 0x4d, 0x52, 0xd8, 0xde, 0x4a, 0xe9, 0x41, 0x21, 0xa3, 0x07, 0x97, 0xa0, 0x16,
+	0x85, 0x23, 0xdc, 0xf7, 0xc4, 0x80, 0xa4, 0x96, 0x94, 0x44, 0x2d, 0x49, 0x10, 0x56, 0x16, 0x08,
+	0x23, 0x49, 0x9f, 0x6d, 0xf8, 0x41, 0x8f, 0x9d, 0x08, 0x04, 0xd6, 0x08, 0xfb, 0x81, 0x31, 0x1a,
+	0x53, 0x19, 0x2b, 0xe9, 0x88, 0xb4, 0x6d, 0x91, 0xa6, 0xfb, 0x61, 0x0b, 0xba, 0x1f, 0x86, 0xfd,
+	0xc4, 0x29, 0xf0, 0x9b, 0x0f, 0xaf, 0xe6, 0xb3, 0x43, 0x71, 0x1d, 0x9e, 0x89, 0x7a, 0x2d, 0x8a,
+	0x87, 0xbb, 0x9f, 0x41, 0x4b, 0x6c, 0x94, 0x6c, 0xdf, 0x2d, 0x71, 0xfb, 0xf2, 0x44, 0xdc, 0x89,
+	0x3d, 0x3c, 0x00, 0x94, 0xb5, 0x6a, 0x49, 0x9e, 0x29, 0x22, 0xcf, 0x66, 0xed, 0x45, 0x82, 0xa7,
+	0x45, 0x71, 0xb3, 0xff, 0xba, 0x04, 0x28, 0x0e, 0x2d, 0xa3, 0x93, 0xf8, 0x3c, 0xf1, 0xd8, 0x75,
+	0x58, 0xca, 0x06, 0x9e, 0x61, 0xb4, 0x8d, 0x32, 0x61, 0xa7, 0x2c, 0x44, 0x2c, 0xca, 0x2e, 0xc7,
+	0xbe, 0x16, 0xf9, 0x21, 0x16, 0x47, 0x5f, 0x99, 0x7a, 0xd0, 0x22, 0xba, 0xa2, 0x1f, 0xa6, 0x2f,
+	0xd5, 0x32, 0xc3, 0x76, 0x4b, 0xea, 0x33, 0x32, 0x4b, 0x9e, 0x79, 0xa3, 0x56, 0x88, 0xf0, 0x2b,
+	0xa7, 0x8a, 0xf0, 0xaf, 0x42, 0xd3, 0xc3, 0x03, 0xf7, 0x10, 0x7b, 0x4c, 0x6a, 0xa9, 0xa5, 0x2b,
+	0xeb, 0x0d, 0x0e, 0xa4, 0xf2, 0x9a, 0xbd, 0x27, 0x5b, 0xcd, 0xde, 0x93, 0xcd, 0x7f, 0x79, 0xf7,
+	0x2a, 0x34, 0x47, 0x23, 0x63, 0x4c, 0x1c, 0x0d, 0x49, 0xb4, 0x4c, 0x7a, 0xec, 0x55, 0xd5, 0x1b,
+	0x04, 0xb8, 0xc5, 0x61, 0xf3, 0xdf, 0xba, 0xfd, 0xef, 0x02, 0x2c, 0x46, 0x1b, 0x7c, 0x2a, 0xe1,
+	0x99, 0x7d, 0x99, 0xe3, 0x09, 0x4b, 0xcb, 0x27, 0x72, 0x69, 0xf9, 0xce, 0x89, 0xd9, 0x5f, 0x6e,
+	0x61, 0xc9, 0xb3, 0xe3, 0xf3, 0xb3, 0xff, 0x0b, 0x58, 0xe0, 0xc5, 0xfe, 0x8c, 0x75, 0xce, 0x53,
+	0x84, 0x39, 0x0f, 0x65, 0xe2, 0x0c, 0xc2, 0x4a, 0x2d, 0xfb, 0x60, 0x7c, 0x4f, 0x8a, 0x18, 0x37,
+	0xd0, 0x4d, 0x41, 0xc2, 0xb4, 0x5f, 0x2f, 0x02, 0x90, 0x33, 0x93, 0xdb, 0xcc, 0xc2, 0xdc, 0x80,
+	0xd2, 0xac, 0x8b, 0x82, 0xa4, 0x37, 0x55, 0x0c, 0xda, 0x33, 0x87, 0x04, 0x08, 0x65, 0xa6, 0x62,
+	0xba, 0xcc, 0x34, 0xad, 0x40, 0x34, 0xdd, 0x7f, 0x7c, 0x07, 0x4a, 0xd4, 0x0f, 0xb0, 0x7b, 0x74,
+	0xb9, 0xce, 0xda, 0xe9, 0x00, 0x72, 0xbd, 0x83, 0x07, 0x2a, 0xdb, 0x0e, 0x8b, 0x64, 0xa8, 0x2f,
+	0x29, 0xea, 0x69, 0x30, 0x29, 0x08, 0xb1, 0xf2, 0x62, 0xd4, 0x91, 0x65, 0xca, 0x29, 0x68, 0x36,
+	0x4e, 0xaa, 0xc9, 0xe2, 0xa4, 0x55, 0x68, 0x9b, 0x9e, 0x3b, 0x1e, 0x27, 0xa6, 0x63, 0xf5, 0xa5,
+	0x34, 0x58, 0xfb, 0x92, 0xbc, 0xba, 0x3b, 0x76, 0x06, 0x8f, 0x27, 0xd7, 0xc9, 0x23, 0x3c, 0x09,
+	0x67, 0x54, 0x14, 0x9d, 0xd1, 0x2d, 0x58, 0x60, 0x45, 0xac, 0x30, 0x6a, 0xbf, 0x32, 0x4d, 0x1a,
+	0x98, 0xec, 0xe8, 0x61, 0xf7, 0x79, 0x2b, 0x21, 0xc2, 0x4d, 0x84, 0xca, 0x7c, 0x37, 0x11, 0x16,
+	0xd2, 0xa5, 0xee, 0x84, 0x58, 0x55, 0x45, 0x17, 0xfa, 0x00, 0x9a, 0x7a, 0x52, 0x35, 0xc8, 0x19,
+	0x7a, 0xe2, 0xea, 0x30, 0xfd, 0x4d, 0x8b, 0x17, 0xc6, 0xd8, 0x18, 0x10, 0xc3, 0x5e, 0xa0, 0xb6,
+	0x20, 0xfa, 0x96, 0xeb, 0xa1, 0xf6, 0x5f, 0x0a, 0x5c, 0x08, 0x8f, 0xaa, 0xb9, 0x96, 0x9f, 0x7d,
+	0x47, 0x37, 0x60, 0x99, 0xab, 0x74, 0x4a, 0xb7, 0x59, 0x8a, 0xb2, 0xc4, 0x60, 0xe2, 0x32, 0x36,
+	0x60, 0x39, 0xa0, 0xd2, 0x95, 0x1e, 0xc3, 0xf6, 0x7b, 0x89, 0x35, 0x8a, 0x63, 0xf2, 0x5c, 0x15,
+	0x78, 0x86, 0xdd, 0x85, 0xe3, 0xac, 0xe5, 0x4a, 0x0a, 0xa4, 0x52, 0xcb, 0x20, 0xda, 0x11, 0x5c,
+	0x62, 0x97, 0xf7, 0xfb, 0x22, 0x45, 0x73, 0x9d, 0x14, 0x49, 0xd7, 0x9d, 0xb2, 0x69, 0x7f, 0xa0,
+	0xc0, 0xe5, 0x29, 0x98, 0xe7, 0xc9, 0x91, 0xdf, 0x97, 0x62, 0x9f, 0x52, 0xd1, 0x10, 0xf0, 0xb2,
+	0x6b, 0x20, 0x22, 0x91, 0x5f, 0x96, 0x60, 0x31, 0xd3, 0xe9, 0xd4, 0x32, 0xf7, 0x12, 0x20, 0xb2,
+	0x09, 0xd1, 0x93, 0x57, 0x5a, 0x24, 0xe2, 0x1e, 0x96, 0x64, 0x60, 0xd1, 0x73, 0x57, 0x52, 0x27,
+	0x42, 0x16, 0xeb, 0xcd, 0xce, 0x89, 0xa2, 0x9d, 0x2b, 0x4d, 0x7f, 0xb4, 0x94, 0x21, 0x70, 0x7d,
+	0x67, 0x32, 0x62, 0x47, 0x4a, 0x7c, 0x97, 0x99, 0xd7, 0x54, 0x9d, 0x14, 0x18, 0x0d, 0x61, 0x91,
+	0xa0, 0x72, 0x27, 0xc1, 0xbe, 0x4b, 0x32, 0x43, 0x4a, 0x17, 0xf3, 0xcd, 0xaf, 0xe7, 0xc6, 0xf4,
+	0x21, 0x1f, 0x4d, 0x88, 0xe7, 0x99, 0xaa, 0x23, 0x42, 0x43, 0x3c, 0x96, 0x33, 0x70, 0x47, 0x11,
+	0x9e, 0xca, 0x29, 0xf1, 0x6c, 0xf3, 0xd1, 0x22, 0x9e, 0x24, 0xb4, 0xbb, 0x09, 0xcb, 0xd2, 0xa5,
+	0xcf, 0x72, 0xf4, 0xe5, 0x64, 0xda, 0x78, 0x07, 0xce, 0xcb, 0x56, 0x75, 0x86, 0x39, 0x32, 0x14,
+	0x9f, 0x66, 0x0e, 0xed, 0x4f, 0x0a, 0xd0, 0x64, 0x49, 0xf6, 0x93, 0x3d, 0xc9, 0xcf, 0x5c, 0x4b,
+	0x28, 0x66, 0xaf, 0x25, 0x64, 0xee, 0x58, 0x94, 0x24, 0x77, 0x2c, 0x2e, 0x47, 0x57, 0x4b, 0xc8,
+	0x2c, 0x65, 0x31, 0x86, 0x30, 0xd1, 0x1b, 0xd0, 0x18, 0x7b, 0xd6, 0xc8, 0xf0, 0x8e, 0x7b, 0x0f,
+	0xf1, 0xb1, 0xcf, 0x9d, 0x46, 0x47, 0xea, 0x76, 0xb6, 0xb7, 0x7c, 0xbd, 0xce, 0x7b, 0xbf, 0x87,
+	0x8f, 0xe9, 0xb5, 0x95, 0x28, 0x07, 0x65, 0x77, 0x1b, 0x4b, 0x7a, 0x02, 0xa2, 0xfd, 0x55, 0x11,
+	0xba, 0x1f, 0x8e, 0xb1, 0x67, 0x04, 0x98, 0x5d, 0x0b, 0x3b, 0xc0, 0xe6, 0xc4, 0x9e, 0x83, 0x73,
+	0x17, 0x61, 0xc1, 0xec, 0x27, 0xef, 0x93, 0x54, 0xcc, 0x3e, 0x5d, 0xe5, 0x8b, 0xd0, 0x4e, 0xb0,
+	0x34, 0x71, 0x13, 0xb4, 0x15, 0x83, 0x69, 0xc7, 0x3c, 0xe6, 0x79, 0x07, 0x1a, 0x2e, 0xa3, 0x9a,
+	0xa5, 0x3a, 0xec, 0x22, 0xcd, 0xb5, 0xe9, 0xb7, 0xdd, 0xd8, 0xb2, 0xf8, 0x4a, 0x69, 0x80, 0x57,
+	0x77, 0xe3, 0x0f, 0xf4, 0x34, 0xf7, 0xca, 0xfe, 0x18, 0x0f, 0x28, 0x83, 0x6b, 0xcc, 0xe7, 0xee,
+	0x8d, 0xf1, 0x80, 0xec, 0x33, 0x4f, 0x14, 0x59, 0x3b, 0x3b, 0x99, 0xab, 0x73, 0x18, 0xed, 0xd2,
+	0x85, 0x2a, 0x61, 0xea, 0x17, 0xae, 0x83, 0xf9, 0x1d, 0xaf, 0xe8, 0x3b, 0xe7, 0xa1, 0x83, 0x2c,
+	0x6b, 0x02, 0x59, 0xd6, 0xf4, 0xfa, 0xc2, 0xdf, 0xff, 0xbf, 0x92, 0x5a, 0xee, 0x14, 0xb5, 0x7f,
+	0x29, 0xc0, 0xc5, 0x07, 0x63, 0x93, 0x6f, 0xdd, 0xa6, 0xeb, 0x0c, 0xad, 0xfd, 0xaf, 0xfb, 0xc6,
+	0xe5, 0x7c, 0x2f, 0x2e, 0x61, 0x46, 0x45, 0x9a, 0x42, 0x3e, 0x0b, 0x34, 0x5b, 0xec, 0xb1, 0xa3,
+	0x3a, 0x93, 0xbf, 0xfd, 0xae, 0x13, 0xd8, 0x3b, 0x0c, 0x44, 0x50, 0xd2, 0x2e, 0x64, 0xef, 0xac,
+	0xa1, 0x85, 0x4d, 0xfe, 0xd8, 0x94, 0xe6, 0x9e, 0x7b, 0x21, 0x30, 0x62, 0xeb, 0xda, 0x35, 0xa8,
+	0x45, 0x37, 0x24, 0x51, 0x15, 0x4a, 0x77, 0x27, 0xb6, 0xad, 0x9e, 0x43, 0x35, 0x28, 0xd3, 0xc2,
+	0x8d, 0xaa, 0x90, 0x9f, 0x34, 0x1d, 0x52, 0x0b, 0x6b, 0xff, 0x1f, 0x6a, 0xd1, 0x4d, 0x2d, 0x54,
+	0x87, 0x85, 0x07, 0xce, 0x7b, 0x8e, 0x7b, 0xe4, 0xa8, 0xe7, 0xd0, 0x02, 0x14, 0x6f, 0xdb, 0xb6,
+	0xaa, 0xa0, 0x26, 0xd4, 0xf6, 0x02, 0x0f, 0x1b, 0xc4, 0xa2, 0xa9, 0x05, 0xd4, 0x02, 0x78, 0xd7,
+	0xf2, 0x03, 0xd7, 0xb3, 0x06, 0x86, 0xad, 0x16, 0xd7, 0xbe, 0x80, 0x96, 0x78, 0x70, 0x87, 0x1a,
+	0x50, 0xdd, 0x71, 0x83, 0x77, 0x3e, 0xb7, 0xfc, 0x40, 0x3d, 0x47, 0xfa, 0xef, 0xb8, 0xc1, 0xae,
+	0x87, 0x7d, 0xec, 0x04, 0xaa, 0x82, 0x00, 0x2a, 0x1f, 0x3a, 0x5b, 0x96, 0xff, 0x50, 0x2d, 0xa0,
+	0x25, 0x7e, 0x26, 0x6f, 0xd8, 0xdb, 0xfc, 0x34, 0x4c, 0x2d, 0x92, 0xe1, 0xd1, 0x57, 0x09, 0xa9,
+	0xd0, 0x88, 0xba, 0xdc, 0xdb, 0x7d, 0xa0, 0x96, 0x19, 0xf5, 0xe4, 0x67, 0x65, 0xcd, 0x04, 0x35,
+	0x7d, 0x97, 0x84, 0xcc, 0xc9, 0x16, 0x11, 0x81, 0xd4, 0x73, 0x64, 0x65, 0xfc, 0x32, 0x8f, 0xaa,
+	0xa0, 0x36, 0xd4, 0x13, 0x57, 0x63, 0xd4, 0x02, 0x01, 0xdc, 0xf3, 0xc6, 0x03, 0x2e, 0x7b, 0x8c,
+	0x04, 0x62, 0xbb, 0xb7, 0x08, 0x27, 0x4a, 0x6b, 0x77, 0xa0, 0x1a, 0xd6, 0x1b, 0x48, 0x57, 0xce,
+	0x22, 0xf2, 0xa9, 0x9e, 0x43, 0x8b, 0xd0, 0x14, 0x1e, 0x0f, 0xab, 0x0a, 0x42, 0xd0, 0x12, 0xff,
+	0x03, 0x40, 0x2d, 0xac, 0x6d, 0x00, 0xc4, 0xf9, 0x31, 0x21, 0x67, 0xdb, 0x39, 0x34, 0x6c, 0xcb,
+	0x64, 0xb4, 0x91, 0x26, 0xc2, 0x5d, 0xca, 0x1d, 0xe6, 0xc6, 0xd4, 0xc2, 0xda, 0x5b, 0x50, 0x0d,
+	0xd3, 0x39, 0x02, 0xd7, 0xf1, 0xc8, 0x3d, 0xc4, 0x6c, 0x67, 0xf6, 0x70, 0xc0, 0xf6, 0xf1, 0xf6,
+	0x08, 0x3b, 0xa6, 0x5a, 0x20, 0x64, 0x30, 0x55, 0xe2, 0x69, 0x8d, 0x5a, 0x5c, 0xbb, 0x0b, 0x17,
+	0xa7, 0xd8, 0x0e, 0xca, 0xf7, 0x49, 0x90, 0x6c, 0x55, 0xcf, 0xa1, 0x0b, 0x80, 0x18, 0x0a, 0x01,
+	0xae, 0x6c, 0xfc, 0xe9, 0x32, 0x00, 0xbb, 0x64, 0xe2, 0xba, 0x9e, 0x89, 0x6c, 0x7a, 0xd9, 0x8c,
+	0x9c, 0xa2, 0xbb, 0x4e, 0x78, 0x02, 0xee, 0xa3, 0xf5, 0x54, 0xe9, 0x94, 0x7d, 0x64, 0x3b, 0x72,
+	0x1e, 0x77, 0x9f, 0x93, 0xf6, 0x4f, 0x75, 0xd6, 0xce, 0xa1, 0x11, 0xc5, 0x46, 0x52, 0xf9, 0xfb,
+	0xd6, 0xe0, 0x61, 0x74, 0x33, 0x65, 0xfa, 0xf3, 0xfd, 0x54, 0xd7, 0x10, 0xdf, 0x55, 0x29, 0xbe,
+	0xbd, 0xc0, 0x23, 0xf5, 0x6f, 0x1e, 0x78, 0x6a, 0xe7, 0xd0, 0xa3, 0xd4, 0x9f, 0x07, 0x84, 0x08,
+	0x37, 0xf2, 0xfc, 0x5f, 0xc0, 0xd9, 0x50, 0xda, 0xd0, 0x4e, 0xfd, 0x95, 0x0b, 0x5a, 0x93, 0x3f,
+	0xb0, 0x94, 0xfd, 0xed, 0x4c, 0xf7, 0x5a, 0xae, 0xbe, 0x11, 0x36, 0x0b, 0x5a, 0xe2, 0x7f, 0x90,
+	0xa0, 0x6f, 0x4d, 0x9b, 0x20, 0xf3, 0xc4, 0xbb, 0xbb, 0x96, 0xa7, 0x6b, 0x84, 0xea, 0x63, 0xa6,
+	0x06, 0xb3, 0x50, 0x49, 0x9f, 0xde, 0x77, 0x4f, 0x8a, 0xf9, 0xb5, 0x73, 0xe8, 0x33, 0x12, 0x9e,
+	0xa7, 0x1e, 0xa2, 0xa3, 0x97, 0xe4, 0x21, 0xa5, 0xfc, 0xbd, 0xfa, 0x2c, 0x0c, 0x1f, 0xa7, 0x95,
+	0x78, 0x3a, 0xf5, 0x99, 0xbf, 0xc1, 0xc8, 0x4f, 0x7d, 0x62, 0xfa, 0x93, 0xa8, 0x3f, 0x35, 0x06,
+	0x1b, 0x2e, 0x4e, 0x79, 0x02, 0x8b, 0x36, 0x64, 0x78, 0x4e, 0x7e, 0x2f, 0x3b, 0x0b, 0xdb, 0x84,
+	0x2a, 0x69, 0xfa, 0x76, 0xd5, 0xcb, 0x53, 0xce, 0x6d, 0xe5, 0x6f, 0xef, 0xbb, 0xeb, 0x79, 0xbb,
+	0x27, 0x65, 0x59, 0x7c, 0xde, 0x2d, 0xdf, 0x22, 0xe9, 0x93, 0xf4, 0xee, 0x5a, 0x9e, 0xae, 0x11,
+	0xaa, 0xfb, 0x82, 0xcb, 0x40, 0x2f, 0x4c, 0x13, 0x05, 0xf1, 0xba, 0xe5, 0x2c, 0xbe, 0xfd, 0x12,
+	0x20, 0xa6, 0xa9, 0x24, 0xf2, 0x99, 0x78, 0x06, 0x13, 0xe3, 0x69, 0xc6, 0x2d, 0xdb, 0x35, 0x44,
+	0xf3, 0xca, 0x29, 0x46, 0x44, 0x4b, 0xea, 0x01, 0xdc, 0xc3, 0xc1, 0x07, 0xf4, 0x9d, 0xaf, 0x9f,
+	0x5e, 0x51, 0x6c, 0xbf, 0x79, 0x87, 0x10, 0xd5, 0x8b, 0x33, 0xfb, 0x45, 0x08, 0xfa, 0x50, 0xbf,
+	0x87, 0x03, 0x9e, 0x8e, 0xf9, 0x68, 0xea, 0xc8, 0xb0, 0x47, 0x88, 0x62, 0x75, 0x76, 0xc7, 0xa4,
+	0xf1, 0x4c, 0x3d, 0x75, 0x47, 0x53, 0x37, 0x36, 0xfb, 0x00, 0xbf, 0x7b, 0x2d, 0x57, 0xdf, 0xe4,
+	0x8a, 0xe8, 0xdd, 0x81, 0x77, 0xb1, 0x61, 0x07, 0x07, 0x53, 0x56, 0x94, 0xe8, 0x71, 0xf2, 0x8a,
+	0x84, 0x8e, 0x11, 0x0e, 0x0c, 0x4b, 0x4c, 0x0b, 0xc5, 0x9a, 0xcf, 0x75, 0xf9, 0x14, 0xd9, 0x9e,
+	0x39, 0x45, 0xcf, 0x80, 0xc5, 0x2d, 0xcf, 0x1d, 0x8b, 0x48, 0x5e, 0x96, 0x22, 0xc9, 0xf4, 0xcb,
+	0x89, 0xe2, 0xfb, 0xd0, 0x08, 0x4b, 0x6b, 0xb4, 0x18, 0x20, 0xe7, 0x42, 0xb2, 0x4b, 0xce, 0x89,
+	0x3f, 0x81, 0x76, 0xaa, 0x66, 0x27, 0xdf, 0x74, 0x79, 0x61, 0x6f, 0xd6, 0xec, 0x47, 0x80, 0xe8,
+	0xff, 0x17, 0x88, 0x71, 0xba, 0x3c, 0xbe, 0xc9, 0x76, 0x0c, 0x91, 0x5c, 0xcf, 0xdd, 0x3f, 0xda,
+	0xf9, 0x5f, 0x86, 0x65, 0x69, 0x5d, 0x0c, 0xdd, 0x90, 0x2d, 0xee, 0xa4, 0xe2, 0x5d, 0xf7, 0x95,
+	0x53, 0x8c, 0x88, 0xf0, 0x9b, 0xb0, 0x24, 0xc9, 0xa4, 0x91, 0xd4, 0x2e, 0x4f, 0x4f, 0xb9, 0x67,
+	0xb1, 0xf7, 0x53, 0x50, 0xd3, 0x39, 0x1f, 0x92, 0xaa, 0xe1, 0x94, 0xcc, 0x70, 0xc6, 0xfc, 0x1b,
+	0xff, 0x8c, 0xa0, 0x46, 0xa3, 0x55, 0x2a, 0x73, 0xff, 0x17, 0xac, 0x3e, 0xde, 0x60, 0xf5, 0x13,
+	0x68, 0xa7, 0xfe, 0x1d, 0x40, 0xae, 0x7a, 0xf2, 0xbf, 0x10, 0xc8, 0x11, 0x73, 0x89, 0x0f, 0xeb,
+	0xe5, 0x0e, 0x5d, 0xfa, 0xf8, 0x7e, 0xd6, 0xdc, 0x1f, 0xb1, 0x7f, 0xde, 0x88, 0x2e, 0xd1, 0xbc,
+	0x38, 0xb5, 0xd6, 0x22, 0xbe, 0xf6, 0xf8, 0xea, 0x63, 0xb9, 0x6f, 0x76, 0x1c, 0xfd, 0x09, 0xb4,
+	0x53, 0xef, 0x29, 0xe5, 0x12, 0x23, 0x7f, 0x74, 0x39, 0x6b, 0xf6, 0x9f, 0x63, 0x08, 0x68, 0xc2,
+	0x92, 0xe4, 0xf9, 0x9a, 0xdc, 0x3c, 0x4e, 0x7f, 0xe7, 0x36, 0x7b, 0x41, 0x4d, 0x41, 0x4d, 0xd1,
+	0xaa, 0x6c, 0x7e, 0xd9, 0xdf, 0xd4, 0x75, 0x5f, 0xca, 0xf7, 0x9f, 0x76, 0xd1, 0x82, 0xf6, 0xa0,
+	0xc2, 0x5e, 0x59, 0xa2, 0x67, 0xa5, 0x6b, 0x48, 0xbe, 0xc0, 0xec, 0xce, 0x7a, 0xa7, 0xe9, 0x4f,
+	0xec, 0x80, 0xd0, 0xff, 0x03, 0x68, 0x31, 0x50, 0xc4, 0xa0, 0xc7, 0x38, 0xf9, 0x1e, 0x94, 0xa9,
+	0x69, 0x47, 0xd2, 0x13, 0xc7, 0xe4, 0x5b, 0xca, 0xee, 0xec, 0xe7, 0x93, 0x31, 0xc5, 0x75, 0x3a,
+	0x92, 0xd5, 0xb8, 0x1e, 0xe7, 0xd4, 0x37, 0x14, 0xf4, 0x03, 0x68, 0xb2, 0xc9, 0x43, 0x6e, 0x3c,
+	0x4e, 0xca, 0x07, 0xb0, 0x94, 0xa0, 0xfc, 0x49, 0xa0, 0xb8, 0xa1, 0xfc, 0x2f, 0xcf, 0x51, 0x3e,
+	0xa7, 0x6f, 0x19, 0xd3, 0xb7, 0x75, 0xd1, 0xfa, 0xe9, 0xae, 0x1c, 0x77, 0xaf, 0xe7, 0xee, 0x1f,
+	0x61, 0xfe, 0x14, 0xd4, 0xf4, 0x5d, 0x02, 0x79, 0x1c, 0x34, 0xe5, 0xc6, 0xc1, 0x2c, 0x43, 0xf2,
+	0x3d, 0xa8, 0xb0, 0x43, 0x24, 0xb9, 0x02, 0x0a, 0x07, 0x4c, 0x33, 0xe6, 0xba, 0xf3, 0xed, 0x8f,
+	0x37, 0xf6, 0xad, 0xe0, 0x60, 0xd2, 0x27, 0x2d, 0xd7, 0x59, 0xd7, 0x97, 0x2d, 0x97, 0xff, 0xba,
+	0x1e, 0xee, 0xe5, 0x75, 0x3a, 0xfa, 0x3a, 0x45, 0x30, 0xee, 0xf7, 0x2b, 0xf4, 0xf3, 0xe6, 0xff,
+	0x0c, 0x00, 0xbe, 0x0d, 0x38, 0x44, 0xc5, 0x59, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -4937,6 +5261,8 @@ type QueryCoordClient interface {
 	TransferReplica(ctx context.Context, in *TransferReplicaRequest, opts ...grpc.CallOption) (*commonpb.Status, error)
 	ListResourceGroups(ctx context.Context, in *milvuspb.ListResourceGroupsRequest, opts ...grpc.CallOption) (*milvuspb.ListResourceGroupsResponse, error)
 	DescribeResourceGroup(ctx context.Context, in *DescribeResourceGroupRequest, opts ...grpc.CallOption) (*DescribeResourceGroupResponse, error)
+	OperateLoadSchedule(ctx context.Context, in *OperateLoadScheduleRequest, opts ...grpc.CallOption) (*commonpb.Status, error)
+	UpdateLoadConfig(ctx context.Context, in *UpdateLoadConfigRequest, opts ...grpc.CallOption) (*commonpb.Status, error)
 }
 
 type queryCoordClient struct {
@@ -5163,6 +5489,24 @@ func (c *queryCoordClient) DescribeResourceGroup(ctx context.Context, in *Descri
 	return out, nil
 }
 
+func (c *queryCoordClient) OperateLoadSchedule(ctx context.Context, in *OperateLoadScheduleRequest, opts ...grpc.CallOption) (*commonpb.Status, error) {
+	out := new(commonpb.Status)
+	err := c.cc.Invoke(ctx, "/milvus.proto.query.QueryCoord/OperateLoadSchedule", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryCoordClient) UpdateLoadConfig(ctx context.Context, in *UpdateLoadConfigRequest, opts ...grpc.CallOption) (*commonpb.Status, error) {
+	out := new(commonpb.Status)
+	err := c.cc.Invoke(ctx, "/milvus.proto.query.QueryCoord/UpdateLoadConfig", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // QueryCoordServer is the server API for QueryCoord service.
 type QueryCoordServer interface {
 	GetComponentStates(context.Context, *milvuspb.GetComponentStatesRequest) (*milvuspb.ComponentStates, error)
@@ -5191,6 +5535,8 @@ type QueryCoordServer interface {
 	TransferReplica(context.Context, *TransferReplicaRequest) (*commonpb.Status, error)
 	ListResourceGroups(context.Context, *milvuspb.ListResourceGroupsRequest) (*milvuspb.ListResourceGroupsResponse, error)
 	DescribeResourceGroup(context.Context, *DescribeResourceGroupRequest) (*DescribeResourceGroupResponse, error)
+	OperateLoadSchedule(context.Context, *OperateLoadScheduleRequest) (*commonpb.Status, error)
+	UpdateLoadConfig(context.Context, *UpdateLoadConfigRequest) (*commonpb.Status, error)
 }
 
 // UnimplementedQueryCoordServer can be embedded to have forward compatible implementations.
@@ -5269,6 +5615,12 @@ func (*UnimplementedQueryCoordServer) ListResourceGroups(ctx context.Context, re
 func (*UnimplementedQueryCoordServer) DescribeResourceGroup(ctx context.Context, req *DescribeResourceGroupRequest) (*DescribeResourceGroupResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method DescribeResourceGroup not implemented")
 }
+func (*UnimplementedQueryCoordServer) OperateLoadSchedule(ctx context.Context, req *OperateLoadScheduleRequest) (*commonpb.Status, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method OperateLoadSchedule not implemented")
+}
+func (*UnimplementedQueryCoordServer) UpdateLoadConfig(ctx context.Context, req *UpdateLoadConfigRequest) (*commonpb.Status, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateLoadConfig not implemented")
+}
 
 func RegisterQueryCoordServer(s *grpc.Server, srv QueryCoordServer) {
 	s.RegisterService(&_QueryCoord_serviceDesc, srv)
@@ -5706,6 +6058,42 @@ func _QueryCoord_DescribeResourceGroup_Handler(srv interface{}, ctx context.Cont
 	return interceptor(ctx, in, info, handler)
 }
 
+func _QueryCoord_OperateLoadSchedule_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(OperateLoadScheduleRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryCoordServer).OperateLoadSchedule(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/milvus.proto.query.QueryCoord/OperateLoadSchedule",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryCoordServer).OperateLoadSchedule(ctx, req.(*OperateLoadScheduleRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _QueryCoord_UpdateLoadConfig_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateLoadConfigRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryCoordServer).UpdateLoadConfig(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/milvus.proto.query.QueryCoord/UpdateLoadConfig",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryCoordServer).UpdateLoadConfig(ctx, req.(*UpdateLoadConfigRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _QueryCoord_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "milvus.proto.query.QueryCoord",
 	HandlerType: (*QueryCoordServer)(nil),
@@ -5806,6 +6194,14 @@ var _QueryCoord_serviceDesc = grpc.ServiceDesc{
 			MethodName: "DescribeResourceGroup",
 			Handler:    _QueryCoord_DescribeResourceGroup_Handler,
 		},
+		{
+			MethodName: "OperateLoadSchedule",
+			Handler:    _QueryCoord_OperateLoadSchedule_Handler,
+		},
+		{
+			MethodName: "UpdateLoadConfig",
+			Handler:    _QueryCoord_UpdateLoadConfig_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "query_coord.proto",
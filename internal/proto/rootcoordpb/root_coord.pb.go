@@ -29,6 +29,84 @@ var _ = math.Inf
 // proto package needs to be updated.
 const _ = proto.ProtoPackageIsVersion3 // please upgrade the proto package
 
+type CollectionTrashOperateType int32
+
+const (
+	CollectionTrashOperateType_RestoreCollection CollectionTrashOperateType = 0
+	CollectionTrashOperateType_PurgeCollection   CollectionTrashOperateType = 1
+)
+
+var CollectionTrashOperateType_name = map[int32]string{
+	0: "RestoreCollection",
+	1: "PurgeCollection",
+}
+
+var CollectionTrashOperateType_value = map[string]int32{
+	"RestoreCollection": 0,
+	"PurgeCollection":   1,
+}
+
+func (x CollectionTrashOperateType) String() string {
+	return proto.EnumName(CollectionTrashOperateType_name, int32(x))
+}
+
+func (CollectionTrashOperateType) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_4513485a144f6b06, []int{0}
+}
+
+type CollectionTemplateOperateType int32
+
+const (
+	CollectionTemplateOperateType_CreateCollectionTemplate CollectionTemplateOperateType = 0
+	CollectionTemplateOperateType_DropCollectionTemplate   CollectionTemplateOperateType = 1
+)
+
+var CollectionTemplateOperateType_name = map[int32]string{
+	0: "CreateCollectionTemplate",
+	1: "DropCollectionTemplate",
+}
+
+var CollectionTemplateOperateType_value = map[string]int32{
+	"CreateCollectionTemplate": 0,
+	"DropCollectionTemplate":   1,
+}
+
+func (x CollectionTemplateOperateType) String() string {
+	return proto.EnumName(CollectionTemplateOperateType_name, int32(x))
+}
+
+func (CollectionTemplateOperateType) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_4513485a144f6b06, []int{1}
+}
+
+type APIKeyOperateType int32
+
+const (
+	APIKeyOperateType_CreateAPIKey APIKeyOperateType = 0
+	APIKeyOperateType_RotateAPIKey APIKeyOperateType = 1
+	APIKeyOperateType_RevokeAPIKey APIKeyOperateType = 2
+)
+
+var APIKeyOperateType_name = map[int32]string{
+	0: "CreateAPIKey",
+	1: "RotateAPIKey",
+	2: "RevokeAPIKey",
+}
+
+var APIKeyOperateType_value = map[string]int32{
+	"CreateAPIKey": 0,
+	"RotateAPIKey": 1,
+	"RevokeAPIKey": 2,
+}
+
+func (x APIKeyOperateType) String() string {
+	return proto.EnumName(APIKeyOperateType_name, int32(x))
+}
+
+func (APIKeyOperateType) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_4513485a144f6b06, []int{2}
+}
+
 type AllocTimestampRequest struct {
 	Base                 *commonpb.MsgBase `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
 	Count                uint32            `protobuf:"varint,3,opt,name=count,proto3" json:"count,omitempty"`
@@ -543,119 +621,1003 @@ func (m *DescribeSegmentsResponse) GetCollectionID() int64 {
 
 func (m *DescribeSegmentsResponse) GetSegmentInfos() map[int64]*SegmentInfos {
 	if m != nil {
-		return m.SegmentInfos
+		return m.SegmentInfos
+	}
+	return nil
+}
+
+type GetCredentialRequest struct {
+	// Not useful for now
+	Base *commonpb.MsgBase `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
+	// username
+	Username             string   `protobuf:"bytes,2,opt,name=username,proto3" json:"username,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *GetCredentialRequest) Reset()         { *m = GetCredentialRequest{} }
+func (m *GetCredentialRequest) String() string { return proto.CompactTextString(m) }
+func (*GetCredentialRequest) ProtoMessage()    {}
+func (*GetCredentialRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4513485a144f6b06, []int{9}
+}
+
+func (m *GetCredentialRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetCredentialRequest.Unmarshal(m, b)
+}
+func (m *GetCredentialRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetCredentialRequest.Marshal(b, m, deterministic)
+}
+func (m *GetCredentialRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetCredentialRequest.Merge(m, src)
+}
+func (m *GetCredentialRequest) XXX_Size() int {
+	return xxx_messageInfo_GetCredentialRequest.Size(m)
+}
+func (m *GetCredentialRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetCredentialRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetCredentialRequest proto.InternalMessageInfo
+
+func (m *GetCredentialRequest) GetBase() *commonpb.MsgBase {
+	if m != nil {
+		return m.Base
+	}
+	return nil
+}
+
+func (m *GetCredentialRequest) GetUsername() string {
+	if m != nil {
+		return m.Username
+	}
+	return ""
+}
+
+type GetCredentialResponse struct {
+	// Contain error_code and reason
+	Status *commonpb.Status `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
+	// username
+	Username string `protobuf:"bytes,2,opt,name=username,proto3" json:"username,omitempty"`
+	// password stored in etcd/mysql
+	Password             string   `protobuf:"bytes,3,opt,name=password,proto3" json:"password,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *GetCredentialResponse) Reset()         { *m = GetCredentialResponse{} }
+func (m *GetCredentialResponse) String() string { return proto.CompactTextString(m) }
+func (*GetCredentialResponse) ProtoMessage()    {}
+func (*GetCredentialResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4513485a144f6b06, []int{10}
+}
+
+func (m *GetCredentialResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetCredentialResponse.Unmarshal(m, b)
+}
+func (m *GetCredentialResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetCredentialResponse.Marshal(b, m, deterministic)
+}
+func (m *GetCredentialResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetCredentialResponse.Merge(m, src)
+}
+func (m *GetCredentialResponse) XXX_Size() int {
+	return xxx_messageInfo_GetCredentialResponse.Size(m)
+}
+func (m *GetCredentialResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetCredentialResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetCredentialResponse proto.InternalMessageInfo
+
+func (m *GetCredentialResponse) GetStatus() *commonpb.Status {
+	if m != nil {
+		return m.Status
+	}
+	return nil
+}
+
+func (m *GetCredentialResponse) GetUsername() string {
+	if m != nil {
+		return m.Username
+	}
+	return ""
+}
+
+func (m *GetCredentialResponse) GetPassword() string {
+	if m != nil {
+		return m.Password
+	}
+	return ""
+}
+
+type CancelDDLTaskRequest struct {
+	Base                 *commonpb.MsgBase `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
+	TaskID               int64             `protobuf:"varint,2,opt,name=taskID,proto3" json:"taskID,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
+	XXX_unrecognized     []byte            `json:"-"`
+	XXX_sizecache        int32             `json:"-"`
+}
+
+func (m *CancelDDLTaskRequest) Reset()         { *m = CancelDDLTaskRequest{} }
+func (m *CancelDDLTaskRequest) String() string { return proto.CompactTextString(m) }
+func (*CancelDDLTaskRequest) ProtoMessage()    {}
+func (*CancelDDLTaskRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4513485a144f6b06, []int{11}
+}
+
+func (m *CancelDDLTaskRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_CancelDDLTaskRequest.Unmarshal(m, b)
+}
+func (m *CancelDDLTaskRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_CancelDDLTaskRequest.Marshal(b, m, deterministic)
+}
+func (m *CancelDDLTaskRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CancelDDLTaskRequest.Merge(m, src)
+}
+func (m *CancelDDLTaskRequest) XXX_Size() int {
+	return xxx_messageInfo_CancelDDLTaskRequest.Size(m)
+}
+func (m *CancelDDLTaskRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_CancelDDLTaskRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CancelDDLTaskRequest proto.InternalMessageInfo
+
+func (m *CancelDDLTaskRequest) GetBase() *commonpb.MsgBase {
+	if m != nil {
+		return m.Base
+	}
+	return nil
+}
+
+func (m *CancelDDLTaskRequest) GetTaskID() int64 {
+	if m != nil {
+		return m.TaskID
+	}
+	return 0
+}
+
+type OperateCollectionTrashRequest struct {
+	Base                 *commonpb.MsgBase          `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
+	CollectionID         int64                      `protobuf:"varint,2,opt,name=collectionID,proto3" json:"collectionID,omitempty"`
+	OperateType          CollectionTrashOperateType `protobuf:"varint,3,opt,name=operate_type,json=operateType,proto3,enum=milvus.proto.rootcoord.CollectionTrashOperateType" json:"operate_type,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                   `json:"-"`
+	XXX_unrecognized     []byte                     `json:"-"`
+	XXX_sizecache        int32                      `json:"-"`
+}
+
+func (m *OperateCollectionTrashRequest) Reset()         { *m = OperateCollectionTrashRequest{} }
+func (m *OperateCollectionTrashRequest) String() string { return proto.CompactTextString(m) }
+func (*OperateCollectionTrashRequest) ProtoMessage()    {}
+func (*OperateCollectionTrashRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4513485a144f6b06, []int{12}
+}
+
+func (m *OperateCollectionTrashRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_OperateCollectionTrashRequest.Unmarshal(m, b)
+}
+func (m *OperateCollectionTrashRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_OperateCollectionTrashRequest.Marshal(b, m, deterministic)
+}
+func (m *OperateCollectionTrashRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_OperateCollectionTrashRequest.Merge(m, src)
+}
+func (m *OperateCollectionTrashRequest) XXX_Size() int {
+	return xxx_messageInfo_OperateCollectionTrashRequest.Size(m)
+}
+func (m *OperateCollectionTrashRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_OperateCollectionTrashRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_OperateCollectionTrashRequest proto.InternalMessageInfo
+
+func (m *OperateCollectionTrashRequest) GetBase() *commonpb.MsgBase {
+	if m != nil {
+		return m.Base
+	}
+	return nil
+}
+
+func (m *OperateCollectionTrashRequest) GetCollectionID() int64 {
+	if m != nil {
+		return m.CollectionID
+	}
+	return 0
+}
+
+func (m *OperateCollectionTrashRequest) GetOperateType() CollectionTrashOperateType {
+	if m != nil {
+		return m.OperateType
+	}
+	return CollectionTrashOperateType_RestoreCollection
+}
+
+type CollectionTemplateIndexPreset struct {
+	FieldName            string                   `protobuf:"bytes,1,opt,name=field_name,json=fieldName,proto3" json:"field_name,omitempty"`
+	IndexType            string                   `protobuf:"bytes,2,opt,name=index_type,json=indexType,proto3" json:"index_type,omitempty"`
+	MetricType           string                   `protobuf:"bytes,3,opt,name=metric_type,json=metricType,proto3" json:"metric_type,omitempty"`
+	Params               []*commonpb.KeyValuePair `protobuf:"bytes,4,rep,name=params,proto3" json:"params,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                 `json:"-"`
+	XXX_unrecognized     []byte                   `json:"-"`
+	XXX_sizecache        int32                    `json:"-"`
+}
+
+func (m *CollectionTemplateIndexPreset) Reset()         { *m = CollectionTemplateIndexPreset{} }
+func (m *CollectionTemplateIndexPreset) String() string { return proto.CompactTextString(m) }
+func (*CollectionTemplateIndexPreset) ProtoMessage()    {}
+func (*CollectionTemplateIndexPreset) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4513485a144f6b06, []int{13}
+}
+
+func (m *CollectionTemplateIndexPreset) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_CollectionTemplateIndexPreset.Unmarshal(m, b)
+}
+func (m *CollectionTemplateIndexPreset) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_CollectionTemplateIndexPreset.Marshal(b, m, deterministic)
+}
+func (m *CollectionTemplateIndexPreset) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CollectionTemplateIndexPreset.Merge(m, src)
+}
+func (m *CollectionTemplateIndexPreset) XXX_Size() int {
+	return xxx_messageInfo_CollectionTemplateIndexPreset.Size(m)
+}
+func (m *CollectionTemplateIndexPreset) XXX_DiscardUnknown() {
+	xxx_messageInfo_CollectionTemplateIndexPreset.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CollectionTemplateIndexPreset proto.InternalMessageInfo
+
+func (m *CollectionTemplateIndexPreset) GetFieldName() string {
+	if m != nil {
+		return m.FieldName
+	}
+	return ""
+}
+
+func (m *CollectionTemplateIndexPreset) GetIndexType() string {
+	if m != nil {
+		return m.IndexType
+	}
+	return ""
+}
+
+func (m *CollectionTemplateIndexPreset) GetMetricType() string {
+	if m != nil {
+		return m.MetricType
+	}
+	return ""
+}
+
+func (m *CollectionTemplateIndexPreset) GetParams() []*commonpb.KeyValuePair {
+	if m != nil {
+		return m.Params
+	}
+	return nil
+}
+
+type CollectionTemplate struct {
+	Name                 string                           `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Description          string                           `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty"`
+	Schema               []byte                           `protobuf:"bytes,3,opt,name=schema,proto3" json:"schema,omitempty"`
+	ShardsNum            int32                            `protobuf:"varint,4,opt,name=shards_num,json=shardsNum,proto3" json:"shards_num,omitempty"`
+	ConsistencyLevel     commonpb.ConsistencyLevel        `protobuf:"varint,5,opt,name=consistency_level,json=consistencyLevel,proto3,enum=milvus.proto.common.ConsistencyLevel" json:"consistency_level,omitempty"`
+	Properties           []*commonpb.KeyValuePair         `protobuf:"bytes,6,rep,name=properties,proto3" json:"properties,omitempty"`
+	IndexPresets         []*CollectionTemplateIndexPreset `protobuf:"bytes,7,rep,name=index_presets,json=indexPresets,proto3" json:"index_presets,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                         `json:"-"`
+	XXX_unrecognized     []byte                           `json:"-"`
+	XXX_sizecache        int32                            `json:"-"`
+}
+
+func (m *CollectionTemplate) Reset()         { *m = CollectionTemplate{} }
+func (m *CollectionTemplate) String() string { return proto.CompactTextString(m) }
+func (*CollectionTemplate) ProtoMessage()    {}
+func (*CollectionTemplate) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4513485a144f6b06, []int{14}
+}
+
+func (m *CollectionTemplate) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_CollectionTemplate.Unmarshal(m, b)
+}
+func (m *CollectionTemplate) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_CollectionTemplate.Marshal(b, m, deterministic)
+}
+func (m *CollectionTemplate) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CollectionTemplate.Merge(m, src)
+}
+func (m *CollectionTemplate) XXX_Size() int {
+	return xxx_messageInfo_CollectionTemplate.Size(m)
+}
+func (m *CollectionTemplate) XXX_DiscardUnknown() {
+	xxx_messageInfo_CollectionTemplate.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CollectionTemplate proto.InternalMessageInfo
+
+func (m *CollectionTemplate) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *CollectionTemplate) GetDescription() string {
+	if m != nil {
+		return m.Description
+	}
+	return ""
+}
+
+func (m *CollectionTemplate) GetSchema() []byte {
+	if m != nil {
+		return m.Schema
+	}
+	return nil
+}
+
+func (m *CollectionTemplate) GetShardsNum() int32 {
+	if m != nil {
+		return m.ShardsNum
+	}
+	return 0
+}
+
+func (m *CollectionTemplate) GetConsistencyLevel() commonpb.ConsistencyLevel {
+	if m != nil {
+		return m.ConsistencyLevel
+	}
+	return commonpb.ConsistencyLevel_Strong
+}
+
+func (m *CollectionTemplate) GetProperties() []*commonpb.KeyValuePair {
+	if m != nil {
+		return m.Properties
+	}
+	return nil
+}
+
+func (m *CollectionTemplate) GetIndexPresets() []*CollectionTemplateIndexPreset {
+	if m != nil {
+		return m.IndexPresets
+	}
+	return nil
+}
+
+type OperateCollectionTemplateRequest struct {
+	Base                 *commonpb.MsgBase             `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
+	OperateType          CollectionTemplateOperateType `protobuf:"varint,2,opt,name=operate_type,json=operateType,proto3,enum=milvus.proto.rootcoord.CollectionTemplateOperateType" json:"operate_type,omitempty"`
+	Template             *CollectionTemplate           `protobuf:"bytes,3,opt,name=template,proto3" json:"template,omitempty"`
+	TemplateName         string                        `protobuf:"bytes,4,opt,name=template_name,json=templateName,proto3" json:"template_name,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                      `json:"-"`
+	XXX_unrecognized     []byte                        `json:"-"`
+	XXX_sizecache        int32                         `json:"-"`
+}
+
+func (m *OperateCollectionTemplateRequest) Reset()         { *m = OperateCollectionTemplateRequest{} }
+func (m *OperateCollectionTemplateRequest) String() string { return proto.CompactTextString(m) }
+func (*OperateCollectionTemplateRequest) ProtoMessage()    {}
+func (*OperateCollectionTemplateRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4513485a144f6b06, []int{15}
+}
+
+func (m *OperateCollectionTemplateRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_OperateCollectionTemplateRequest.Unmarshal(m, b)
+}
+func (m *OperateCollectionTemplateRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_OperateCollectionTemplateRequest.Marshal(b, m, deterministic)
+}
+func (m *OperateCollectionTemplateRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_OperateCollectionTemplateRequest.Merge(m, src)
+}
+func (m *OperateCollectionTemplateRequest) XXX_Size() int {
+	return xxx_messageInfo_OperateCollectionTemplateRequest.Size(m)
+}
+func (m *OperateCollectionTemplateRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_OperateCollectionTemplateRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_OperateCollectionTemplateRequest proto.InternalMessageInfo
+
+func (m *OperateCollectionTemplateRequest) GetBase() *commonpb.MsgBase {
+	if m != nil {
+		return m.Base
+	}
+	return nil
+}
+
+func (m *OperateCollectionTemplateRequest) GetOperateType() CollectionTemplateOperateType {
+	if m != nil {
+		return m.OperateType
+	}
+	return CollectionTemplateOperateType_CreateCollectionTemplate
+}
+
+func (m *OperateCollectionTemplateRequest) GetTemplate() *CollectionTemplate {
+	if m != nil {
+		return m.Template
+	}
+	return nil
+}
+
+func (m *OperateCollectionTemplateRequest) GetTemplateName() string {
+	if m != nil {
+		return m.TemplateName
+	}
+	return ""
+}
+
+type CreateCollectionFromTemplateRequest struct {
+	Base                 *commonpb.MsgBase `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
+	DbName               string            `protobuf:"bytes,2,opt,name=db_name,json=dbName,proto3" json:"db_name,omitempty"`
+	CollectionName       string            `protobuf:"bytes,3,opt,name=collection_name,json=collectionName,proto3" json:"collection_name,omitempty"`
+	TemplateName         string            `protobuf:"bytes,4,opt,name=template_name,json=templateName,proto3" json:"template_name,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
+	XXX_unrecognized     []byte            `json:"-"`
+	XXX_sizecache        int32             `json:"-"`
+}
+
+func (m *CreateCollectionFromTemplateRequest) Reset()         { *m = CreateCollectionFromTemplateRequest{} }
+func (m *CreateCollectionFromTemplateRequest) String() string { return proto.CompactTextString(m) }
+func (*CreateCollectionFromTemplateRequest) ProtoMessage()    {}
+func (*CreateCollectionFromTemplateRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4513485a144f6b06, []int{16}
+}
+
+func (m *CreateCollectionFromTemplateRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_CreateCollectionFromTemplateRequest.Unmarshal(m, b)
+}
+func (m *CreateCollectionFromTemplateRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_CreateCollectionFromTemplateRequest.Marshal(b, m, deterministic)
+}
+func (m *CreateCollectionFromTemplateRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CreateCollectionFromTemplateRequest.Merge(m, src)
+}
+func (m *CreateCollectionFromTemplateRequest) XXX_Size() int {
+	return xxx_messageInfo_CreateCollectionFromTemplateRequest.Size(m)
+}
+func (m *CreateCollectionFromTemplateRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_CreateCollectionFromTemplateRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CreateCollectionFromTemplateRequest proto.InternalMessageInfo
+
+func (m *CreateCollectionFromTemplateRequest) GetBase() *commonpb.MsgBase {
+	if m != nil {
+		return m.Base
+	}
+	return nil
+}
+
+func (m *CreateCollectionFromTemplateRequest) GetDbName() string {
+	if m != nil {
+		return m.DbName
+	}
+	return ""
+}
+
+func (m *CreateCollectionFromTemplateRequest) GetCollectionName() string {
+	if m != nil {
+		return m.CollectionName
+	}
+	return ""
+}
+
+func (m *CreateCollectionFromTemplateRequest) GetTemplateName() string {
+	if m != nil {
+		return m.TemplateName
+	}
+	return ""
+}
+
+type OperateRoleInheritanceRequest struct {
+	Base                 *commonpb.MsgBase             `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
+	RoleName             string                        `protobuf:"bytes,2,opt,name=role_name,json=roleName,proto3" json:"role_name,omitempty"`
+	ParentRoleName       string                        `protobuf:"bytes,3,opt,name=parent_role_name,json=parentRoleName,proto3" json:"parent_role_name,omitempty"`
+	Type                 milvuspb.OperatePrivilegeType `protobuf:"varint,4,opt,name=type,proto3,enum=milvus.proto.milvus.OperatePrivilegeType" json:"type,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                      `json:"-"`
+	XXX_unrecognized     []byte                        `json:"-"`
+	XXX_sizecache        int32                         `json:"-"`
+}
+
+func (m *OperateRoleInheritanceRequest) Reset()         { *m = OperateRoleInheritanceRequest{} }
+func (m *OperateRoleInheritanceRequest) String() string { return proto.CompactTextString(m) }
+func (*OperateRoleInheritanceRequest) ProtoMessage()    {}
+func (*OperateRoleInheritanceRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4513485a144f6b06, []int{17}
+}
+
+func (m *OperateRoleInheritanceRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_OperateRoleInheritanceRequest.Unmarshal(m, b)
+}
+func (m *OperateRoleInheritanceRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_OperateRoleInheritanceRequest.Marshal(b, m, deterministic)
+}
+func (m *OperateRoleInheritanceRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_OperateRoleInheritanceRequest.Merge(m, src)
+}
+func (m *OperateRoleInheritanceRequest) XXX_Size() int {
+	return xxx_messageInfo_OperateRoleInheritanceRequest.Size(m)
+}
+func (m *OperateRoleInheritanceRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_OperateRoleInheritanceRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_OperateRoleInheritanceRequest proto.InternalMessageInfo
+
+func (m *OperateRoleInheritanceRequest) GetBase() *commonpb.MsgBase {
+	if m != nil {
+		return m.Base
+	}
+	return nil
+}
+
+func (m *OperateRoleInheritanceRequest) GetRoleName() string {
+	if m != nil {
+		return m.RoleName
+	}
+	return ""
+}
+
+func (m *OperateRoleInheritanceRequest) GetParentRoleName() string {
+	if m != nil {
+		return m.ParentRoleName
+	}
+	return ""
+}
+
+func (m *OperateRoleInheritanceRequest) GetType() milvuspb.OperatePrivilegeType {
+	if m != nil {
+		return m.Type
+	}
+	return milvuspb.OperatePrivilegeType_Grant
+}
+
+type ListRoleInheritancesRequest struct {
+	Base                 *commonpb.MsgBase `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
+	XXX_unrecognized     []byte            `json:"-"`
+	XXX_sizecache        int32             `json:"-"`
+}
+
+func (m *ListRoleInheritancesRequest) Reset()         { *m = ListRoleInheritancesRequest{} }
+func (m *ListRoleInheritancesRequest) String() string { return proto.CompactTextString(m) }
+func (*ListRoleInheritancesRequest) ProtoMessage()    {}
+func (*ListRoleInheritancesRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4513485a144f6b06, []int{18}
+}
+
+func (m *ListRoleInheritancesRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ListRoleInheritancesRequest.Unmarshal(m, b)
+}
+func (m *ListRoleInheritancesRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ListRoleInheritancesRequest.Marshal(b, m, deterministic)
+}
+func (m *ListRoleInheritancesRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListRoleInheritancesRequest.Merge(m, src)
+}
+func (m *ListRoleInheritancesRequest) XXX_Size() int {
+	return xxx_messageInfo_ListRoleInheritancesRequest.Size(m)
+}
+func (m *ListRoleInheritancesRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListRoleInheritancesRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ListRoleInheritancesRequest proto.InternalMessageInfo
+
+func (m *ListRoleInheritancesRequest) GetBase() *commonpb.MsgBase {
+	if m != nil {
+		return m.Base
+	}
+	return nil
+}
+
+type RoleInheritance struct {
+	RoleName             string   `protobuf:"bytes,1,opt,name=role_name,json=roleName,proto3" json:"role_name,omitempty"`
+	ParentRoleNames      []string `protobuf:"bytes,2,rep,name=parent_role_names,json=parentRoleNames,proto3" json:"parent_role_names,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *RoleInheritance) Reset()         { *m = RoleInheritance{} }
+func (m *RoleInheritance) String() string { return proto.CompactTextString(m) }
+func (*RoleInheritance) ProtoMessage()    {}
+func (*RoleInheritance) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4513485a144f6b06, []int{19}
+}
+
+func (m *RoleInheritance) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_RoleInheritance.Unmarshal(m, b)
+}
+func (m *RoleInheritance) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_RoleInheritance.Marshal(b, m, deterministic)
+}
+func (m *RoleInheritance) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RoleInheritance.Merge(m, src)
+}
+func (m *RoleInheritance) XXX_Size() int {
+	return xxx_messageInfo_RoleInheritance.Size(m)
+}
+func (m *RoleInheritance) XXX_DiscardUnknown() {
+	xxx_messageInfo_RoleInheritance.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RoleInheritance proto.InternalMessageInfo
+
+func (m *RoleInheritance) GetRoleName() string {
+	if m != nil {
+		return m.RoleName
+	}
+	return ""
+}
+
+func (m *RoleInheritance) GetParentRoleNames() []string {
+	if m != nil {
+		return m.ParentRoleNames
+	}
+	return nil
+}
+
+type ListRoleInheritancesResponse struct {
+	Status               *commonpb.Status   `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
+	Inheritances         []*RoleInheritance `protobuf:"bytes,2,rep,name=inheritances,proto3" json:"inheritances,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}           `json:"-"`
+	XXX_unrecognized     []byte             `json:"-"`
+	XXX_sizecache        int32              `json:"-"`
+}
+
+func (m *ListRoleInheritancesResponse) Reset()         { *m = ListRoleInheritancesResponse{} }
+func (m *ListRoleInheritancesResponse) String() string { return proto.CompactTextString(m) }
+func (*ListRoleInheritancesResponse) ProtoMessage()    {}
+func (*ListRoleInheritancesResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4513485a144f6b06, []int{20}
+}
+
+func (m *ListRoleInheritancesResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ListRoleInheritancesResponse.Unmarshal(m, b)
+}
+func (m *ListRoleInheritancesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ListRoleInheritancesResponse.Marshal(b, m, deterministic)
+}
+func (m *ListRoleInheritancesResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListRoleInheritancesResponse.Merge(m, src)
+}
+func (m *ListRoleInheritancesResponse) XXX_Size() int {
+	return xxx_messageInfo_ListRoleInheritancesResponse.Size(m)
+}
+func (m *ListRoleInheritancesResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListRoleInheritancesResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ListRoleInheritancesResponse proto.InternalMessageInfo
+
+func (m *ListRoleInheritancesResponse) GetStatus() *commonpb.Status {
+	if m != nil {
+		return m.Status
+	}
+	return nil
+}
+
+func (m *ListRoleInheritancesResponse) GetInheritances() []*RoleInheritance {
+	if m != nil {
+		return m.Inheritances
+	}
+	return nil
+}
+
+type OperateAPIKeyRequest struct {
+	Base                 *commonpb.MsgBase `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
+	OperateType          APIKeyOperateType `protobuf:"varint,2,opt,name=operate_type,json=operateType,proto3,enum=milvus.proto.rootcoord.APIKeyOperateType" json:"operate_type,omitempty"`
+	Id                   string            `protobuf:"bytes,3,opt,name=id,proto3" json:"id,omitempty"`
+	Username             string            `protobuf:"bytes,4,opt,name=username,proto3" json:"username,omitempty"`
+	Scopes               []string          `protobuf:"bytes,5,rep,name=scopes,proto3" json:"scopes,omitempty"`
+	TtlSeconds           int64             `protobuf:"varint,6,opt,name=ttl_seconds,json=ttlSeconds,proto3" json:"ttl_seconds,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
+	XXX_unrecognized     []byte            `json:"-"`
+	XXX_sizecache        int32             `json:"-"`
+}
+
+func (m *OperateAPIKeyRequest) Reset()         { *m = OperateAPIKeyRequest{} }
+func (m *OperateAPIKeyRequest) String() string { return proto.CompactTextString(m) }
+func (*OperateAPIKeyRequest) ProtoMessage()    {}
+func (*OperateAPIKeyRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4513485a144f6b06, []int{21}
+}
+
+func (m *OperateAPIKeyRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_OperateAPIKeyRequest.Unmarshal(m, b)
+}
+func (m *OperateAPIKeyRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_OperateAPIKeyRequest.Marshal(b, m, deterministic)
+}
+func (m *OperateAPIKeyRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_OperateAPIKeyRequest.Merge(m, src)
+}
+func (m *OperateAPIKeyRequest) XXX_Size() int {
+	return xxx_messageInfo_OperateAPIKeyRequest.Size(m)
+}
+func (m *OperateAPIKeyRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_OperateAPIKeyRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_OperateAPIKeyRequest proto.InternalMessageInfo
+
+func (m *OperateAPIKeyRequest) GetBase() *commonpb.MsgBase {
+	if m != nil {
+		return m.Base
+	}
+	return nil
+}
+
+func (m *OperateAPIKeyRequest) GetOperateType() APIKeyOperateType {
+	if m != nil {
+		return m.OperateType
+	}
+	return APIKeyOperateType_CreateAPIKey
+}
+
+func (m *OperateAPIKeyRequest) GetId() string {
+	if m != nil {
+		return m.Id
+	}
+	return ""
+}
+
+func (m *OperateAPIKeyRequest) GetUsername() string {
+	if m != nil {
+		return m.Username
+	}
+	return ""
+}
+
+func (m *OperateAPIKeyRequest) GetScopes() []string {
+	if m != nil {
+		return m.Scopes
+	}
+	return nil
+}
+
+func (m *OperateAPIKeyRequest) GetTtlSeconds() int64 {
+	if m != nil {
+		return m.TtlSeconds
+	}
+	return 0
+}
+
+type APIKeyInfo struct {
+	Id                   string   `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Username             string   `protobuf:"bytes,2,opt,name=username,proto3" json:"username,omitempty"`
+	Scopes               []string `protobuf:"bytes,3,rep,name=scopes,proto3" json:"scopes,omitempty"`
+	ExpireTime           int64    `protobuf:"varint,4,opt,name=expire_time,json=expireTime,proto3" json:"expire_time,omitempty"`
+	CreatedTime          int64    `protobuf:"varint,5,opt,name=created_time,json=createdTime,proto3" json:"created_time,omitempty"`
+	RotatedTime          int64    `protobuf:"varint,6,opt,name=rotated_time,json=rotatedTime,proto3" json:"rotated_time,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *APIKeyInfo) Reset()         { *m = APIKeyInfo{} }
+func (m *APIKeyInfo) String() string { return proto.CompactTextString(m) }
+func (*APIKeyInfo) ProtoMessage()    {}
+func (*APIKeyInfo) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4513485a144f6b06, []int{22}
+}
+
+func (m *APIKeyInfo) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_APIKeyInfo.Unmarshal(m, b)
+}
+func (m *APIKeyInfo) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_APIKeyInfo.Marshal(b, m, deterministic)
+}
+func (m *APIKeyInfo) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_APIKeyInfo.Merge(m, src)
+}
+func (m *APIKeyInfo) XXX_Size() int {
+	return xxx_messageInfo_APIKeyInfo.Size(m)
+}
+func (m *APIKeyInfo) XXX_DiscardUnknown() {
+	xxx_messageInfo_APIKeyInfo.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_APIKeyInfo proto.InternalMessageInfo
+
+func (m *APIKeyInfo) GetId() string {
+	if m != nil {
+		return m.Id
+	}
+	return ""
+}
+
+func (m *APIKeyInfo) GetUsername() string {
+	if m != nil {
+		return m.Username
+	}
+	return ""
+}
+
+func (m *APIKeyInfo) GetScopes() []string {
+	if m != nil {
+		return m.Scopes
+	}
+	return nil
+}
+
+func (m *APIKeyInfo) GetExpireTime() int64 {
+	if m != nil {
+		return m.ExpireTime
+	}
+	return 0
+}
+
+func (m *APIKeyInfo) GetCreatedTime() int64 {
+	if m != nil {
+		return m.CreatedTime
+	}
+	return 0
+}
+
+func (m *APIKeyInfo) GetRotatedTime() int64 {
+	if m != nil {
+		return m.RotatedTime
+	}
+	return 0
+}
+
+type OperateAPIKeyResponse struct {
+	Status               *commonpb.Status `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
+	Key                  *APIKeyInfo      `protobuf:"bytes,2,opt,name=key,proto3" json:"key,omitempty"`
+	Token                string           `protobuf:"bytes,3,opt,name=token,proto3" json:"token,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}         `json:"-"`
+	XXX_unrecognized     []byte           `json:"-"`
+	XXX_sizecache        int32            `json:"-"`
+}
+
+func (m *OperateAPIKeyResponse) Reset()         { *m = OperateAPIKeyResponse{} }
+func (m *OperateAPIKeyResponse) String() string { return proto.CompactTextString(m) }
+func (*OperateAPIKeyResponse) ProtoMessage()    {}
+func (*OperateAPIKeyResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4513485a144f6b06, []int{23}
+}
+
+func (m *OperateAPIKeyResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_OperateAPIKeyResponse.Unmarshal(m, b)
+}
+func (m *OperateAPIKeyResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_OperateAPIKeyResponse.Marshal(b, m, deterministic)
+}
+func (m *OperateAPIKeyResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_OperateAPIKeyResponse.Merge(m, src)
+}
+func (m *OperateAPIKeyResponse) XXX_Size() int {
+	return xxx_messageInfo_OperateAPIKeyResponse.Size(m)
+}
+func (m *OperateAPIKeyResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_OperateAPIKeyResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_OperateAPIKeyResponse proto.InternalMessageInfo
+
+func (m *OperateAPIKeyResponse) GetStatus() *commonpb.Status {
+	if m != nil {
+		return m.Status
+	}
+	return nil
+}
+
+func (m *OperateAPIKeyResponse) GetKey() *APIKeyInfo {
+	if m != nil {
+		return m.Key
+	}
+	return nil
+}
+
+func (m *OperateAPIKeyResponse) GetToken() string {
+	if m != nil {
+		return m.Token
 	}
-	return nil
+	return ""
 }
 
-type GetCredentialRequest struct {
-	// Not useful for now
-	Base *commonpb.MsgBase `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
-	// username
-	Username             string   `protobuf:"bytes,2,opt,name=username,proto3" json:"username,omitempty"`
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_unrecognized     []byte   `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
+type ListAPIKeysRequest struct {
+	Base                 *commonpb.MsgBase `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
+	Username             string            `protobuf:"bytes,2,opt,name=username,proto3" json:"username,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
+	XXX_unrecognized     []byte            `json:"-"`
+	XXX_sizecache        int32             `json:"-"`
 }
 
-func (m *GetCredentialRequest) Reset()         { *m = GetCredentialRequest{} }
-func (m *GetCredentialRequest) String() string { return proto.CompactTextString(m) }
-func (*GetCredentialRequest) ProtoMessage()    {}
-func (*GetCredentialRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_4513485a144f6b06, []int{9}
+func (m *ListAPIKeysRequest) Reset()         { *m = ListAPIKeysRequest{} }
+func (m *ListAPIKeysRequest) String() string { return proto.CompactTextString(m) }
+func (*ListAPIKeysRequest) ProtoMessage()    {}
+func (*ListAPIKeysRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4513485a144f6b06, []int{24}
 }
 
-func (m *GetCredentialRequest) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_GetCredentialRequest.Unmarshal(m, b)
+func (m *ListAPIKeysRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ListAPIKeysRequest.Unmarshal(m, b)
 }
-func (m *GetCredentialRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_GetCredentialRequest.Marshal(b, m, deterministic)
+func (m *ListAPIKeysRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ListAPIKeysRequest.Marshal(b, m, deterministic)
 }
-func (m *GetCredentialRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_GetCredentialRequest.Merge(m, src)
+func (m *ListAPIKeysRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListAPIKeysRequest.Merge(m, src)
 }
-func (m *GetCredentialRequest) XXX_Size() int {
-	return xxx_messageInfo_GetCredentialRequest.Size(m)
+func (m *ListAPIKeysRequest) XXX_Size() int {
+	return xxx_messageInfo_ListAPIKeysRequest.Size(m)
 }
-func (m *GetCredentialRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_GetCredentialRequest.DiscardUnknown(m)
+func (m *ListAPIKeysRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListAPIKeysRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_GetCredentialRequest proto.InternalMessageInfo
+var xxx_messageInfo_ListAPIKeysRequest proto.InternalMessageInfo
 
-func (m *GetCredentialRequest) GetBase() *commonpb.MsgBase {
+func (m *ListAPIKeysRequest) GetBase() *commonpb.MsgBase {
 	if m != nil {
 		return m.Base
 	}
 	return nil
 }
 
-func (m *GetCredentialRequest) GetUsername() string {
+func (m *ListAPIKeysRequest) GetUsername() string {
 	if m != nil {
 		return m.Username
 	}
 	return ""
 }
 
-type GetCredentialResponse struct {
-	// Contain error_code and reason
-	Status *commonpb.Status `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
-	// username
-	Username string `protobuf:"bytes,2,opt,name=username,proto3" json:"username,omitempty"`
-	// password stored in etcd/mysql
-	Password             string   `protobuf:"bytes,3,opt,name=password,proto3" json:"password,omitempty"`
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_unrecognized     []byte   `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
+type ListAPIKeysResponse struct {
+	Status               *commonpb.Status `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
+	Keys                 []*APIKeyInfo    `protobuf:"bytes,2,rep,name=keys,proto3" json:"keys,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}         `json:"-"`
+	XXX_unrecognized     []byte           `json:"-"`
+	XXX_sizecache        int32            `json:"-"`
 }
 
-func (m *GetCredentialResponse) Reset()         { *m = GetCredentialResponse{} }
-func (m *GetCredentialResponse) String() string { return proto.CompactTextString(m) }
-func (*GetCredentialResponse) ProtoMessage()    {}
-func (*GetCredentialResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_4513485a144f6b06, []int{10}
+func (m *ListAPIKeysResponse) Reset()         { *m = ListAPIKeysResponse{} }
+func (m *ListAPIKeysResponse) String() string { return proto.CompactTextString(m) }
+func (*ListAPIKeysResponse) ProtoMessage()    {}
+func (*ListAPIKeysResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4513485a144f6b06, []int{25}
 }
 
-func (m *GetCredentialResponse) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_GetCredentialResponse.Unmarshal(m, b)
+func (m *ListAPIKeysResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ListAPIKeysResponse.Unmarshal(m, b)
 }
-func (m *GetCredentialResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_GetCredentialResponse.Marshal(b, m, deterministic)
+func (m *ListAPIKeysResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ListAPIKeysResponse.Marshal(b, m, deterministic)
 }
-func (m *GetCredentialResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_GetCredentialResponse.Merge(m, src)
+func (m *ListAPIKeysResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListAPIKeysResponse.Merge(m, src)
 }
-func (m *GetCredentialResponse) XXX_Size() int {
-	return xxx_messageInfo_GetCredentialResponse.Size(m)
+func (m *ListAPIKeysResponse) XXX_Size() int {
+	return xxx_messageInfo_ListAPIKeysResponse.Size(m)
 }
-func (m *GetCredentialResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_GetCredentialResponse.DiscardUnknown(m)
+func (m *ListAPIKeysResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListAPIKeysResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_GetCredentialResponse proto.InternalMessageInfo
+var xxx_messageInfo_ListAPIKeysResponse proto.InternalMessageInfo
 
-func (m *GetCredentialResponse) GetStatus() *commonpb.Status {
+func (m *ListAPIKeysResponse) GetStatus() *commonpb.Status {
 	if m != nil {
 		return m.Status
 	}
 	return nil
 }
 
-func (m *GetCredentialResponse) GetUsername() string {
-	if m != nil {
-		return m.Username
-	}
-	return ""
-}
-
-func (m *GetCredentialResponse) GetPassword() string {
+func (m *ListAPIKeysResponse) GetKeys() []*APIKeyInfo {
 	if m != nil {
-		return m.Password
+		return m.Keys
 	}
-	return ""
+	return nil
 }
 
 func init() {
+	proto.RegisterEnum("milvus.proto.rootcoord.CollectionTrashOperateType", CollectionTrashOperateType_name, CollectionTrashOperateType_value)
+	proto.RegisterEnum("milvus.proto.rootcoord.CollectionTemplateOperateType", CollectionTemplateOperateType_name, CollectionTemplateOperateType_value)
+	proto.RegisterEnum("milvus.proto.rootcoord.APIKeyOperateType", APIKeyOperateType_name, APIKeyOperateType_value)
 	proto.RegisterType((*AllocTimestampRequest)(nil), "milvus.proto.rootcoord.AllocTimestampRequest")
 	proto.RegisterType((*AllocTimestampResponse)(nil), "milvus.proto.rootcoord.AllocTimestampResponse")
 	proto.RegisterType((*AllocIDRequest)(nil), "milvus.proto.rootcoord.AllocIDRequest")
@@ -669,113 +1631,187 @@ func init() {
 	proto.RegisterMapType((map[int64]*SegmentInfos)(nil), "milvus.proto.rootcoord.DescribeSegmentsResponse.SegmentInfosEntry")
 	proto.RegisterType((*GetCredentialRequest)(nil), "milvus.proto.rootcoord.GetCredentialRequest")
 	proto.RegisterType((*GetCredentialResponse)(nil), "milvus.proto.rootcoord.GetCredentialResponse")
+	proto.RegisterType((*CancelDDLTaskRequest)(nil), "milvus.proto.rootcoord.CancelDDLTaskRequest")
+	proto.RegisterType((*OperateCollectionTrashRequest)(nil), "milvus.proto.rootcoord.OperateCollectionTrashRequest")
+	proto.RegisterType((*CollectionTemplateIndexPreset)(nil), "milvus.proto.rootcoord.CollectionTemplateIndexPreset")
+	proto.RegisterType((*CollectionTemplate)(nil), "milvus.proto.rootcoord.CollectionTemplate")
+	proto.RegisterType((*OperateCollectionTemplateRequest)(nil), "milvus.proto.rootcoord.OperateCollectionTemplateRequest")
+	proto.RegisterType((*CreateCollectionFromTemplateRequest)(nil), "milvus.proto.rootcoord.CreateCollectionFromTemplateRequest")
+	proto.RegisterType((*OperateRoleInheritanceRequest)(nil), "milvus.proto.rootcoord.OperateRoleInheritanceRequest")
+	proto.RegisterType((*ListRoleInheritancesRequest)(nil), "milvus.proto.rootcoord.ListRoleInheritancesRequest")
+	proto.RegisterType((*RoleInheritance)(nil), "milvus.proto.rootcoord.RoleInheritance")
+	proto.RegisterType((*ListRoleInheritancesResponse)(nil), "milvus.proto.rootcoord.ListRoleInheritancesResponse")
+	proto.RegisterType((*OperateAPIKeyRequest)(nil), "milvus.proto.rootcoord.OperateAPIKeyRequest")
+	proto.RegisterType((*APIKeyInfo)(nil), "milvus.proto.rootcoord.APIKeyInfo")
+	proto.RegisterType((*OperateAPIKeyResponse)(nil), "milvus.proto.rootcoord.OperateAPIKeyResponse")
+	proto.RegisterType((*ListAPIKeysRequest)(nil), "milvus.proto.rootcoord.ListAPIKeysRequest")
+	proto.RegisterType((*ListAPIKeysResponse)(nil), "milvus.proto.rootcoord.ListAPIKeysResponse")
 }
 
 func init() { proto.RegisterFile("root_coord.proto", fileDescriptor_4513485a144f6b06) }
 
 var fileDescriptor_4513485a144f6b06 = []byte{
-	// 1616 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x58, 0x6d, 0x93, 0xda, 0xb6,
-	0x16, 0x0e, 0x90, 0xdd, 0x85, 0x03, 0x0b, 0x1b, 0x4d, 0x5e, 0xb8, 0x24, 0xf7, 0x5e, 0x42, 0xf6,
-	0xde, 0x90, 0x37, 0x36, 0xdd, 0xcc, 0xa4, 0x69, 0xbe, 0x65, 0x21, 0xb3, 0x61, 0xda, 0x9d, 0x6c,
-	0x4d, 0xd2, 0xa6, 0x2f, 0x3b, 0x54, 0xd8, 0x0a, 0x78, 0xd6, 0x58, 0xc4, 0x12, 0xfb, 0x32, 0xfd,
-	0xd0, 0xe9, 0x4c, 0xbf, 0xf7, 0x3f, 0xb5, 0x3f, 0xa5, 0xff, 0xa1, 0x9f, 0x3b, 0xb2, 0x6c, 0x61,
-	0x1b, 0x1b, 0x4c, 0x92, 0xf6, 0x9b, 0x25, 0x3d, 0x7a, 0x9e, 0xa3, 0x73, 0x74, 0x8e, 0x24, 0xc3,
-	0x96, 0x43, 0x29, 0xef, 0xeb, 0x94, 0x3a, 0x46, 0x6b, 0xe2, 0x50, 0x4e, 0xd1, 0xd5, 0xb1, 0x69,
-	0x9d, 0x4c, 0x99, 0x6c, 0xb5, 0xc4, 0xb0, 0x3b, 0x5a, 0x2b, 0xe9, 0x74, 0x3c, 0xa6, 0xb6, 0xec,
-	0xaf, 0x95, 0x82, 0xa8, 0x5a, 0xd9, 0xb4, 0x39, 0x71, 0x6c, 0x6c, 0x79, 0xed, 0xe2, 0xc4, 0xa1,
-	0x67, 0xe7, 0x5e, 0xa3, 0x42, 0xb8, 0x6e, 0xf4, 0xc7, 0x84, 0x63, 0xd9, 0xd1, 0xe8, 0xc3, 0x95,
-	0x67, 0x96, 0x45, 0xf5, 0x57, 0xe6, 0x98, 0x30, 0x8e, 0xc7, 0x13, 0x8d, 0xbc, 0x9b, 0x12, 0xc6,
-	0xd1, 0x43, 0xb8, 0x38, 0xc0, 0x8c, 0x54, 0x33, 0xf5, 0x4c, 0xb3, 0xb8, 0x7b, 0xa3, 0x15, 0xb2,
-	0xc4, 0x93, 0x3f, 0x60, 0xc3, 0x3d, 0xcc, 0x88, 0xe6, 0x22, 0xd1, 0x65, 0x58, 0xd3, 0xe9, 0xd4,
-	0xe6, 0xd5, 0x5c, 0x3d, 0xd3, 0xdc, 0xd4, 0x64, 0xa3, 0xf1, 0x73, 0x06, 0xae, 0x46, 0x15, 0xd8,
-	0x84, 0xda, 0x8c, 0xa0, 0x47, 0xb0, 0xce, 0x38, 0xe6, 0x53, 0xe6, 0x89, 0x5c, 0x8f, 0x15, 0xe9,
-	0xb9, 0x10, 0xcd, 0x83, 0xa2, 0x1b, 0x50, 0xe0, 0x3e, 0x53, 0x35, 0x5b, 0xcf, 0x34, 0x2f, 0x6a,
-	0xb3, 0x8e, 0x04, 0x1b, 0xde, 0x40, 0xd9, 0x35, 0xa1, 0xdb, 0xf9, 0x08, 0xab, 0xcb, 0x06, 0x99,
-	0x2d, 0xa8, 0x28, 0xe6, 0x0f, 0x59, 0x55, 0x19, 0xb2, 0xdd, 0x8e, 0x4b, 0x9d, 0xd3, 0xb2, 0xdd,
-	0x4e, 0xc2, 0x3a, 0x7e, 0xcb, 0x42, 0xa9, 0x3b, 0x9e, 0x50, 0x87, 0x6b, 0x84, 0x4d, 0x2d, 0xfe,
-	0x7e, 0x5a, 0xd7, 0x60, 0x83, 0x63, 0x76, 0xdc, 0x37, 0x0d, 0x4f, 0x70, 0x5d, 0x34, 0xbb, 0x06,
-	0xfa, 0x2f, 0x14, 0x0d, 0xcc, 0xb1, 0x4d, 0x0d, 0x22, 0x06, 0x73, 0xee, 0x20, 0xf8, 0x5d, 0x5d,
-	0x03, 0x3d, 0x86, 0x35, 0xc1, 0x41, 0xaa, 0x17, 0xeb, 0x99, 0x66, 0x79, 0xb7, 0x1e, 0xab, 0x26,
-	0x0d, 0x14, 0x9a, 0x44, 0x93, 0x70, 0x54, 0x83, 0x3c, 0x23, 0xc3, 0x31, 0xb1, 0x39, 0xab, 0xae,
-	0xd5, 0x73, 0xcd, 0x9c, 0xa6, 0xda, 0xe8, 0x5f, 0x90, 0xc7, 0x53, 0x4e, 0xfb, 0xa6, 0xc1, 0xaa,
-	0xeb, 0xee, 0xd8, 0x86, 0x68, 0x77, 0x0d, 0x86, 0xae, 0x43, 0xc1, 0xa1, 0xa7, 0x7d, 0xe9, 0x88,
-	0x0d, 0xd7, 0x9a, 0xbc, 0x43, 0x4f, 0xdb, 0xa2, 0x8d, 0x3e, 0x85, 0x35, 0xd3, 0x7e, 0x4b, 0x59,
-	0x35, 0x5f, 0xcf, 0x35, 0x8b, 0xbb, 0x37, 0x63, 0x6d, 0xf9, 0x9c, 0x9c, 0x7f, 0x85, 0xad, 0x29,
-	0x39, 0xc4, 0xa6, 0xa3, 0x49, 0x7c, 0xe3, 0xd7, 0x0c, 0x5c, 0xeb, 0x10, 0xa6, 0x3b, 0xe6, 0x80,
-	0xf4, 0x3c, 0x2b, 0xde, 0x7f, 0x5b, 0x34, 0xa0, 0xa4, 0x53, 0xcb, 0x22, 0x3a, 0x37, 0xa9, 0xad,
-	0x42, 0x18, 0xea, 0x43, 0xff, 0x01, 0xf0, 0x96, 0xdb, 0xed, 0xb0, 0x6a, 0xce, 0x5d, 0x64, 0xa0,
-	0xa7, 0x31, 0x85, 0x8a, 0x67, 0x88, 0x20, 0xee, 0xda, 0x6f, 0xe9, 0x1c, 0x6d, 0x26, 0x86, 0xb6,
-	0x0e, 0xc5, 0x09, 0x76, 0xb8, 0x19, 0x52, 0x0e, 0x76, 0x89, 0x5c, 0x51, 0x32, 0x5e, 0x38, 0x67,
-	0x1d, 0x8d, 0x3f, 0xb2, 0x50, 0xf2, 0x74, 0x85, 0x26, 0x43, 0x1d, 0x28, 0x88, 0x35, 0xf5, 0x85,
-	0x9f, 0x3c, 0x17, 0xdc, 0x6e, 0xc5, 0x57, 0xa0, 0x56, 0xc4, 0x60, 0x2d, 0x3f, 0xf0, 0x4d, 0xef,
-	0x40, 0xd1, 0xb4, 0x0d, 0x72, 0xd6, 0x97, 0xe1, 0xc9, 0xba, 0xe1, 0xb9, 0x15, 0xe6, 0x11, 0x55,
-	0xa8, 0xa5, 0xb4, 0x0d, 0x72, 0xe6, 0x72, 0x80, 0xe9, 0x7f, 0x32, 0x44, 0xe0, 0x12, 0x39, 0xe3,
-	0x0e, 0xee, 0x07, 0xb9, 0x72, 0x2e, 0xd7, 0x67, 0x4b, 0x6c, 0x72, 0x09, 0x5a, 0xcf, 0xc5, 0x6c,
-	0xc5, 0xcd, 0x9e, 0xdb, 0xdc, 0x39, 0xd7, 0x2a, 0x24, 0xdc, 0x5b, 0xfb, 0x01, 0x2e, 0xc7, 0x01,
-	0xd1, 0x16, 0xe4, 0x8e, 0xc9, 0xb9, 0xe7, 0x76, 0xf1, 0x89, 0x76, 0x61, 0xed, 0x44, 0x6c, 0x25,
-	0xd7, 0xcf, 0x73, 0x7b, 0xc3, 0x5d, 0xd0, 0x6c, 0x25, 0x12, 0xfa, 0x34, 0xfb, 0x24, 0xd3, 0xf8,
-	0x3d, 0x0b, 0xd5, 0xf9, 0xed, 0xf6, 0x21, 0xb5, 0x22, 0xcd, 0x96, 0x1b, 0xc2, 0xa6, 0x17, 0xe8,
-	0x90, 0xeb, 0xf6, 0x92, 0x5c, 0x97, 0x64, 0x61, 0xc8, 0xa7, 0xd2, 0x87, 0x25, 0x16, 0xe8, 0xaa,
-	0x11, 0xb8, 0x34, 0x07, 0x89, 0xf1, 0xde, 0xd3, 0xb0, 0xf7, 0xb6, 0xd3, 0x84, 0x30, 0xe8, 0x45,
-	0x03, 0x2e, 0xef, 0x13, 0xde, 0x76, 0x88, 0x41, 0x6c, 0x6e, 0x62, 0xeb, 0xfd, 0x13, 0xb6, 0x06,
-	0xf9, 0x29, 0x13, 0xe7, 0xe3, 0x58, 0x1a, 0x53, 0xd0, 0x54, 0xbb, 0xf1, 0x4b, 0x06, 0xae, 0x44,
-	0x64, 0x3e, 0x24, 0x50, 0x0b, 0xa4, 0xc4, 0xd8, 0x04, 0x33, 0x76, 0x4a, 0x1d, 0x59, 0x68, 0x0b,
-	0x9a, 0x6a, 0xef, 0xfe, 0xb9, 0x0d, 0x05, 0x8d, 0x52, 0xde, 0x16, 0x2e, 0x41, 0x16, 0x20, 0x61,
-	0x13, 0x1d, 0x4f, 0xa8, 0x4d, 0x6c, 0x59, 0x58, 0x19, 0x6a, 0x85, 0x0d, 0xf0, 0x1a, 0xf3, 0x40,
-	0xcf, 0x51, 0xb5, 0xed, 0x58, 0x7c, 0x04, 0xdc, 0xb8, 0x80, 0xc6, 0xae, 0x9a, 0x38, 0xab, 0x5f,
-	0x99, 0xfa, 0x71, 0x7b, 0x84, 0x6d, 0x9b, 0x58, 0xe8, 0x61, 0x78, 0xb6, 0xba, 0x61, 0xcc, 0x43,
-	0x7d, 0xbd, 0x5b, 0xb1, 0x7a, 0x3d, 0xee, 0x98, 0xf6, 0xd0, 0xf7, 0x6a, 0xe3, 0x02, 0x7a, 0xe7,
-	0xc6, 0x55, 0xa8, 0x9b, 0x8c, 0x9b, 0x3a, 0xf3, 0x05, 0x77, 0x93, 0x05, 0xe7, 0xc0, 0x2b, 0x4a,
-	0xf6, 0x61, 0xab, 0xed, 0x10, 0xcc, 0x49, 0x5b, 0x25, 0x0c, 0xba, 0x1f, 0xef, 0x9d, 0x08, 0xcc,
-	0x17, 0x5a, 0x14, 0xfc, 0xc6, 0x05, 0xf4, 0x1d, 0x94, 0x3b, 0x0e, 0x9d, 0x04, 0xe8, 0xef, 0xc6,
-	0xd2, 0x87, 0x41, 0x29, 0xc9, 0xfb, 0xb0, 0xf9, 0x02, 0xb3, 0x00, 0xf7, 0x9d, 0x58, 0xee, 0x10,
-	0xc6, 0xa7, 0xbe, 0x19, 0x0b, 0xdd, 0xa3, 0xd4, 0x0a, 0xb8, 0xe7, 0x14, 0x90, 0x5f, 0x0c, 0x02,
-	0x2a, 0xf1, 0xdb, 0x6d, 0x1e, 0xe8, 0x4b, 0xed, 0xa4, 0xc6, 0x2b, 0xe1, 0x9f, 0xa0, 0x36, 0x3f,
-	0xde, 0xf5, 0x02, 0xff, 0x4f, 0x18, 0xf0, 0x1a, 0x8a, 0x32, 0xe2, 0xcf, 0x2c, 0x13, 0x33, 0x74,
-	0x7b, 0xc1, 0x9e, 0x70, 0x11, 0x29, 0x23, 0xf6, 0x25, 0x14, 0x44, 0xa4, 0x25, 0xe9, 0xff, 0x12,
-	0x77, 0xc2, 0x2a, 0x94, 0x3d, 0x80, 0x67, 0x16, 0x27, 0x8e, 0xe4, 0xfc, 0x7f, 0x2c, 0xe7, 0x0c,
-	0x90, 0x92, 0xd4, 0x86, 0x4a, 0x6f, 0x24, 0x6e, 0x57, 0xbe, 0x6b, 0x18, 0xba, 0x17, 0x9f, 0x51,
-	0x61, 0x94, 0x4f, 0x7f, 0x3f, 0x1d, 0x58, 0xb9, 0xfb, 0x48, 0x5c, 0x9d, 0x39, 0x71, 0x02, 0xbb,
-	0xec, 0x5e, 0xf2, 0x4a, 0x56, 0x4e, 0x94, 0x23, 0xa8, 0xc8, 0x58, 0x1d, 0xfa, 0x17, 0xa2, 0x04,
-	0xfa, 0x08, 0x2a, 0x25, 0xfd, 0x37, 0xb0, 0x29, 0xa2, 0x36, 0x23, 0xbf, 0x93, 0x18, 0xd9, 0x55,
-	0xa9, 0x8f, 0xa0, 0xf4, 0x02, 0xb3, 0x19, 0x73, 0x33, 0x29, 0xc3, 0xe7, 0x88, 0x53, 0x25, 0xf8,
-	0x31, 0x94, 0x45, 0x50, 0xd4, 0x64, 0x96, 0x50, 0x9e, 0xc2, 0x20, 0x5f, 0xe2, 0x5e, 0x2a, 0xac,
-	0x12, 0x63, 0x70, 0x35, 0x3c, 0xa6, 0x12, 0xfa, 0x6f, 0x14, 0x25, 0x50, 0x12, 0x63, 0xfe, 0x5d,
-	0x26, 0xc1, 0x81, 0x41, 0x88, 0x2f, 0x74, 0x27, 0x05, 0x32, 0x70, 0x76, 0x95, 0xc3, 0x0f, 0x5b,
-	0xf4, 0x20, 0xe9, 0x5a, 0x13, 0xfb, 0xc4, 0xae, 0xb5, 0xd2, 0xc2, 0x95, 0xe4, 0xf7, 0xb0, 0xe1,
-	0x3d, 0x37, 0xa3, 0x59, 0x1f, 0x99, 0xac, 0x5e, 0xba, 0xb5, 0xdb, 0x4b, 0x71, 0x8a, 0x1d, 0xc3,
-	0x95, 0xd7, 0x13, 0x43, 0x1c, 0x79, 0xf2, 0x60, 0xf5, 0x8f, 0xf6, 0xe8, 0xde, 0x56, 0xa7, 0x71,
-	0x04, 0x77, 0xc0, 0x86, 0xcb, 0xf6, 0xb6, 0x03, 0xff, 0xee, 0xda, 0x27, 0xd8, 0x32, 0x8d, 0xd0,
-	0xc9, 0x7a, 0x40, 0x38, 0x6e, 0x63, 0x7d, 0x44, 0xa2, 0x07, 0xbf, 0xfc, 0x77, 0x11, 0x9e, 0xa2,
-	0xc0, 0x29, 0xf3, 0xe9, 0x47, 0x40, 0xb2, 0x0a, 0xd9, 0x6f, 0xcd, 0xe1, 0xd4, 0xc1, 0x72, 0xd3,
-	0x27, 0x5d, 0x69, 0xe6, 0xa1, 0xbe, 0xcc, 0x27, 0x2b, 0xcc, 0x08, 0xdc, 0x36, 0x60, 0x9f, 0xf0,
-	0x03, 0xc2, 0x1d, 0x53, 0x4f, 0x2a, 0xd5, 0x33, 0x40, 0x42, 0xd0, 0x62, 0x70, 0x4a, 0xa0, 0x07,
-	0xeb, 0xf2, 0xc5, 0x8d, 0x1a, 0xb1, 0x93, 0xfc, 0xff, 0x05, 0x8b, 0xee, 0x48, 0xea, 0x9f, 0x42,
-	0xa0, 0x46, 0xec, 0x13, 0x1e, 0x78, 0xc9, 0x27, 0xa4, 0x6b, 0x18, 0xb4, 0x38, 0x5d, 0xa3, 0x58,
-	0x25, 0x66, 0x43, 0xe5, 0x0b, 0x93, 0x79, 0x83, 0xaf, 0x30, 0x3b, 0x4e, 0x3a, 0x78, 0x22, 0xa8,
-	0xc5, 0x07, 0xcf, 0x1c, 0x38, 0xe0, 0xb1, 0x92, 0x46, 0xc4, 0x80, 0xe7, 0xb7, 0xc4, 0xc7, 0x48,
-	0xf0, 0x57, 0xcb, 0xb2, 0x4d, 0xf6, 0x46, 0xdd, 0x2a, 0xd5, 0xe3, 0x21, 0x7a, 0xd8, 0xcf, 0xd2,
-	0x46, 0x41, 0xc4, 0x3b, 0x27, 0x05, 0xb3, 0x97, 0x95, 0x1f, 0x9b, 0xb9, 0x0f, 0x5b, 0x1d, 0x62,
-	0x91, 0x10, 0xf3, 0xfd, 0x84, 0x7b, 0x53, 0x18, 0x96, 0x32, 0xf3, 0x46, 0xb0, 0x29, 0xc2, 0x20,
-	0xe6, 0xbd, 0x66, 0xc4, 0x61, 0x09, 0x87, 0x64, 0x08, 0xe3, 0x53, 0xdf, 0x4d, 0x03, 0x0d, 0xec,
-	0xa1, 0xcd, 0xd0, 0xc3, 0x2d, 0xba, 0x8e, 0x59, 0x50, 0xe3, 0x9e, 0x91, 0xb5, 0x07, 0x29, 0xd1,
-	0x81, 0x3d, 0x04, 0x32, 0xdc, 0x1a, 0xb5, 0x48, 0x42, 0x5a, 0xcf, 0x00, 0x29, 0xdd, 0xf5, 0x12,
-	0xf2, 0xe2, 0xbe, 0xe0, 0x52, 0x6e, 0x27, 0x5e, 0x27, 0x56, 0x20, 0x3c, 0x82, 0xca, 0xcb, 0x09,
-	0x71, 0x30, 0x27, 0xc2, 0x5f, 0x2e, 0x6f, 0x7c, 0x66, 0x45, 0x50, 0xa9, 0xdf, 0x22, 0xd0, 0x23,
-	0xa2, 0x82, 0x2f, 0x70, 0xc2, 0x0c, 0xb0, 0xb8, 0xb6, 0x05, 0x71, 0xc1, 0xe2, 0x29, 0xfb, 0x85,
-	0x61, 0x0b, 0x05, 0x5c, 0xcb, 0x53, 0x08, 0x48, 0x5c, 0xf0, 0x2d, 0xe8, 0x2d, 0xfd, 0xd0, 0x31,
-	0x4f, 0x4c, 0x8b, 0x0c, 0x49, 0x42, 0x06, 0x44, 0x61, 0x29, 0x5d, 0x34, 0x80, 0xa2, 0x14, 0xde,
-	0x77, 0xb0, 0xcd, 0xd1, 0x22, 0xd3, 0x5c, 0x84, 0x4f, 0xdb, 0x5c, 0x0e, 0x54, 0x8b, 0xd0, 0x01,
-	0x44, 0x5a, 0x1c, 0x52, 0xcb, 0xd4, 0xcf, 0xa3, 0x97, 0x1d, 0x55, 0x1a, 0x66, 0x90, 0x84, 0xcb,
-	0x4e, 0x2c, 0x52, 0x89, 0x0c, 0xa0, 0xd8, 0x1e, 0x11, 0xfd, 0xf8, 0x05, 0xc1, 0x16, 0x1f, 0x25,
-	0x3d, 0x8e, 0x66, 0x88, 0xc5, 0x0b, 0x09, 0x01, 0x83, 0xd1, 0xd0, 0x88, 0x8d, 0xc7, 0xcb, 0x5f,
-	0xe6, 0x51, 0x58, 0xfa, 0x97, 0xb9, 0x4c, 0xca, 0x0e, 0xe6, 0xd8, 0xfd, 0x1b, 0x74, 0x77, 0x41,
-	0xe6, 0xfa, 0xa0, 0x94, 0xe4, 0x5f, 0x43, 0x49, 0xa4, 0xa7, 0xa2, 0x6e, 0x26, 0x66, 0xf0, 0x8a,
-	0xc4, 0x5e, 0x15, 0xf5, 0x67, 0x2d, 0xaa, 0xa2, 0x0a, 0xb3, 0xbc, 0x8a, 0x06, 0xa0, 0x7e, 0x00,
-	0xf6, 0x9e, 0x7c, 0xfb, 0x78, 0x68, 0xf2, 0xd1, 0x74, 0x20, 0x6c, 0xd8, 0x91, 0xe0, 0x07, 0x26,
-	0xf5, 0xbe, 0x76, 0xfc, 0x1d, 0xb2, 0xe3, 0x92, 0xed, 0xa8, 0x2a, 0x39, 0x19, 0x0c, 0xd6, 0xdd,
-	0xae, 0x47, 0x7f, 0x05, 0x00, 0x00, 0xff, 0xff, 0xcc, 0x48, 0x0a, 0xdf, 0xc3, 0x1a, 0x00, 0x00,
+	// 2557 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x59, 0xcd, 0x73, 0x13, 0xc9,
+	0x15, 0xf7, 0xc8, 0x5f, 0xd2, 0x93, 0x6c, 0xc9, 0xbd, 0xc6, 0x68, 0x05, 0x24, 0x66, 0x80, 0x45,
+	0x18, 0x30, 0x1b, 0xb3, 0x21, 0x2c, 0xa9, 0x6c, 0x15, 0x58, 0xbb, 0xa0, 0x02, 0x76, 0x9d, 0x31,
+	0x24, 0x2c, 0x09, 0xa5, 0x8c, 0x47, 0x8d, 0x35, 0xe5, 0xd1, 0xf4, 0xec, 0x74, 0xcb, 0xe0, 0xe4,
+	0x90, 0xca, 0x47, 0xe5, 0xba, 0xd7, 0x1c, 0xf3, 0x0f, 0xe4, 0x9c, 0xca, 0x35, 0xb9, 0xed, 0x31,
+	0x7f, 0x41, 0xaa, 0x72, 0xcc, 0x35, 0xf7, 0xa4, 0xba, 0x7b, 0xbe, 0x35, 0x2d, 0x8d, 0xed, 0x25,
+	0x3a, 0xa9, 0xdf, 0xfc, 0xfa, 0xbd, 0xd7, 0xef, 0xab, 0x3f, 0x1e, 0x34, 0x7c, 0x42, 0x58, 0xcf,
+	0x22, 0xc4, 0xef, 0x6f, 0x7a, 0x3e, 0x61, 0x04, 0xad, 0x0d, 0x6d, 0xe7, 0x70, 0x44, 0xe5, 0x68,
+	0x93, 0x7f, 0x16, 0x5f, 0x5b, 0x35, 0x8b, 0x0c, 0x87, 0xc4, 0x95, 0xf4, 0x56, 0x2d, 0x89, 0x6a,
+	0x2d, 0xdb, 0x2e, 0xc3, 0xbe, 0x6b, 0x3a, 0xc1, 0xb8, 0xea, 0xf9, 0xe4, 0xed, 0x51, 0x30, 0xa8,
+	0x63, 0x66, 0xf5, 0x7b, 0x43, 0xcc, 0x4c, 0x49, 0xd0, 0x7b, 0x70, 0xe6, 0xbe, 0xe3, 0x10, 0xeb,
+	0x99, 0x3d, 0xc4, 0x94, 0x99, 0x43, 0xcf, 0xc0, 0x5f, 0x8d, 0x30, 0x65, 0xe8, 0x43, 0x98, 0xdb,
+	0x33, 0x29, 0x6e, 0x6a, 0xeb, 0x5a, 0xbb, 0xba, 0x75, 0x7e, 0x33, 0xa5, 0x49, 0x20, 0xfe, 0x29,
+	0xdd, 0x7f, 0x60, 0x52, 0x6c, 0x08, 0x24, 0x5a, 0x85, 0x79, 0x8b, 0x8c, 0x5c, 0xd6, 0x9c, 0x5d,
+	0xd7, 0xda, 0x4b, 0x86, 0x1c, 0xe8, 0xbf, 0xd1, 0x60, 0x2d, 0x2b, 0x81, 0x7a, 0xc4, 0xa5, 0x18,
+	0xdd, 0x86, 0x05, 0xca, 0x4c, 0x36, 0xa2, 0x81, 0x90, 0x73, 0xb9, 0x42, 0x76, 0x05, 0xc4, 0x08,
+	0xa0, 0xe8, 0x3c, 0x54, 0x58, 0xc8, 0xa9, 0x59, 0x5a, 0xd7, 0xda, 0x73, 0x46, 0x4c, 0x50, 0xe8,
+	0xf0, 0x02, 0x96, 0x85, 0x0a, 0xdd, 0xce, 0xb7, 0xb0, 0xba, 0x52, 0x92, 0xb3, 0x03, 0xf5, 0x88,
+	0xf3, 0x69, 0x56, 0xb5, 0x0c, 0xa5, 0x6e, 0x47, 0xb0, 0x9e, 0x35, 0x4a, 0xdd, 0x8e, 0x62, 0x1d,
+	0x7f, 0x2b, 0x41, 0xad, 0x3b, 0xf4, 0x88, 0xcf, 0x0c, 0x4c, 0x47, 0x0e, 0x3b, 0x99, 0xac, 0xb3,
+	0xb0, 0xc8, 0x4c, 0x7a, 0xd0, 0xb3, 0xfb, 0x81, 0xc0, 0x05, 0x3e, 0xec, 0xf6, 0xd1, 0x77, 0xa1,
+	0xda, 0x37, 0x99, 0xe9, 0x92, 0x3e, 0xe6, 0x1f, 0x67, 0xc5, 0x47, 0x08, 0x49, 0xdd, 0x3e, 0xba,
+	0x03, 0xf3, 0x9c, 0x07, 0x6e, 0xce, 0xad, 0x6b, 0xed, 0xe5, 0xad, 0xf5, 0x5c, 0x69, 0x52, 0x41,
+	0x2e, 0x13, 0x1b, 0x12, 0x8e, 0x5a, 0x50, 0xa6, 0x78, 0x7f, 0x88, 0x5d, 0x46, 0x9b, 0xf3, 0xeb,
+	0xb3, 0xed, 0x59, 0x23, 0x1a, 0xa3, 0xf7, 0xa1, 0x6c, 0x8e, 0x18, 0xe9, 0xd9, 0x7d, 0xda, 0x5c,
+	0x10, 0xdf, 0x16, 0xf9, 0xb8, 0xdb, 0xa7, 0xe8, 0x1c, 0x54, 0x7c, 0xf2, 0xa6, 0x27, 0x0d, 0xb1,
+	0x28, 0xb4, 0x29, 0xfb, 0xe4, 0xcd, 0x36, 0x1f, 0xa3, 0x1f, 0xc0, 0xbc, 0xed, 0xbe, 0x26, 0xb4,
+	0x59, 0x5e, 0x9f, 0x6d, 0x57, 0xb7, 0x2e, 0xe6, 0xea, 0xf2, 0x18, 0x1f, 0xfd, 0xc4, 0x74, 0x46,
+	0x78, 0xc7, 0xb4, 0x7d, 0x43, 0xe2, 0xf5, 0xaf, 0x35, 0x38, 0xdb, 0xc1, 0xd4, 0xf2, 0xed, 0x3d,
+	0xbc, 0x1b, 0x68, 0x71, 0xf2, 0xb0, 0xd0, 0xa1, 0x66, 0x11, 0xc7, 0xc1, 0x16, 0xb3, 0x89, 0x1b,
+	0xb9, 0x30, 0x45, 0x43, 0xdf, 0x01, 0x08, 0x96, 0xdb, 0xed, 0xd0, 0xe6, 0xac, 0x58, 0x64, 0x82,
+	0xa2, 0x8f, 0xa0, 0x1e, 0x28, 0xc2, 0x19, 0x77, 0xdd, 0xd7, 0x64, 0x8c, 0xad, 0x96, 0xc3, 0x76,
+	0x1d, 0xaa, 0x9e, 0xe9, 0x33, 0x3b, 0x25, 0x39, 0x49, 0xe2, 0xb9, 0x12, 0x89, 0x09, 0xdc, 0x19,
+	0x13, 0xf4, 0x7f, 0x95, 0xa0, 0x16, 0xc8, 0xe5, 0x32, 0x29, 0xea, 0x40, 0x85, 0xaf, 0xa9, 0xc7,
+	0xed, 0x14, 0x98, 0xe0, 0xea, 0x66, 0x7e, 0x05, 0xda, 0xcc, 0x28, 0x6c, 0x94, 0xf7, 0x42, 0xd5,
+	0x3b, 0x50, 0xb5, 0xdd, 0x3e, 0x7e, 0xdb, 0x93, 0xee, 0x29, 0x09, 0xf7, 0x5c, 0x4a, 0xf3, 0xe1,
+	0x55, 0x68, 0x33, 0x92, 0xdd, 0xc7, 0x6f, 0x05, 0x0f, 0xb0, 0xc3, 0xbf, 0x14, 0x61, 0x58, 0xc1,
+	0x6f, 0x99, 0x6f, 0xf6, 0x92, 0xbc, 0x66, 0x05, 0xaf, 0x8f, 0xa7, 0xe8, 0x24, 0x18, 0x6c, 0x7e,
+	0xca, 0x67, 0x47, 0xbc, 0xe9, 0xa7, 0x2e, 0xf3, 0x8f, 0x8c, 0x3a, 0x4e, 0x53, 0x5b, 0xbf, 0x80,
+	0xd5, 0x3c, 0x20, 0x6a, 0xc0, 0xec, 0x01, 0x3e, 0x0a, 0xcc, 0xce, 0xff, 0xa2, 0x2d, 0x98, 0x3f,
+	0xe4, 0xa1, 0xd4, 0x2c, 0xe5, 0xc5, 0x86, 0x58, 0x50, 0xbc, 0x12, 0x09, 0xbd, 0x57, 0xba, 0xab,
+	0xe9, 0x7f, 0x2f, 0x41, 0x73, 0x3c, 0xdc, 0x4e, 0x53, 0x2b, 0x8a, 0x84, 0xdc, 0x3e, 0x2c, 0x05,
+	0x8e, 0x4e, 0x99, 0xee, 0x81, 0xca, 0x74, 0x2a, 0x0d, 0x53, 0x36, 0x95, 0x36, 0xac, 0xd1, 0x04,
+	0xa9, 0x85, 0x61, 0x65, 0x0c, 0x92, 0x63, 0xbd, 0x7b, 0x69, 0xeb, 0x5d, 0x2e, 0xe2, 0xc2, 0xa4,
+	0x15, 0xfb, 0xb0, 0xfa, 0x10, 0xb3, 0x6d, 0x1f, 0xf7, 0xb1, 0xcb, 0x6c, 0xd3, 0x39, 0x79, 0xc2,
+	0xb6, 0xa0, 0x3c, 0xa2, 0x7c, 0x7f, 0x1c, 0x4a, 0x65, 0x2a, 0x46, 0x34, 0xd6, 0x7f, 0xaf, 0xc1,
+	0x99, 0x8c, 0x98, 0xd3, 0x38, 0x6a, 0x82, 0x28, 0xfe, 0xcd, 0x33, 0x29, 0x7d, 0x43, 0x7c, 0x59,
+	0x68, 0x2b, 0x46, 0x34, 0xd6, 0x7d, 0x58, 0xdd, 0x36, 0x5d, 0x0b, 0x3b, 0x9d, 0xce, 0x93, 0x67,
+	0x26, 0x3d, 0x38, 0xf9, 0x62, 0xd7, 0x40, 0xd6, 0xf6, 0x4e, 0xaa, 0xd2, 0x77, 0xee, 0x35, 0xbe,
+	0xf9, 0x64, 0xa9, 0xac, 0x35, 0xff, 0x1b, 0xfe, 0x34, 0xfd, 0x9f, 0x1a, 0x5c, 0xf8, 0xc2, 0xc3,
+	0xbe, 0xc9, 0xf0, 0x76, 0x14, 0x48, 0xcf, 0x7c, 0x93, 0x0e, 0xde, 0x6d, 0x6d, 0x7c, 0x0e, 0x35,
+	0x22, 0xc5, 0xf6, 0xd8, 0x91, 0x87, 0x85, 0x2d, 0x96, 0xb7, 0xb6, 0x54, 0xf1, 0x91, 0xd1, 0x2d,
+	0xd0, 0xf8, 0xd9, 0x91, 0x87, 0x8d, 0x2a, 0x89, 0x07, 0xf7, 0xd0, 0x37, 0x9f, 0xd4, 0xcb, 0x5a,
+	0xa3, 0x94, 0x5c, 0xe2, 0x5f, 0x35, 0xb8, 0x90, 0x98, 0x8f, 0x87, 0x9e, 0x63, 0x32, 0x2c, 0x92,
+	0x76, 0xc7, 0xc7, 0x14, 0x33, 0x74, 0x01, 0xe0, 0xb5, 0x8d, 0x9d, 0x7e, 0x4f, 0xb8, 0x4c, 0x13,
+	0x6e, 0xa9, 0x08, 0xca, 0xe7, 0xdc, 0x67, 0x17, 0x40, 0x56, 0x28, 0xa9, 0xa9, 0xf4, 0x68, 0x45,
+	0x50, 0xb8, 0x4c, 0xbe, 0x7d, 0x0e, 0x31, 0xf3, 0x6d, 0x2b, 0x5e, 0x49, 0xc5, 0x00, 0x49, 0x12,
+	0x80, 0x8f, 0x61, 0xc1, 0x33, 0x7d, 0x73, 0x48, 0x9b, 0x73, 0x45, 0xf7, 0xac, 0x60, 0x82, 0xfe,
+	0x9f, 0x12, 0xa0, 0x71, 0xdd, 0x11, 0x82, 0xb9, 0x84, 0xaa, 0xe2, 0x3f, 0xdf, 0x16, 0xfa, 0x22,
+	0x9b, 0x3d, 0x0e, 0x0d, 0xd4, 0x4c, 0x92, 0x78, 0x54, 0x50, 0x6b, 0x80, 0x87, 0xa6, 0xd0, 0xb1,
+	0x66, 0x04, 0x23, 0xbe, 0x3e, 0x3a, 0x30, 0xfd, 0x3e, 0xed, 0xb9, 0xa3, 0xa1, 0xd8, 0xe3, 0xe7,
+	0x8d, 0x8a, 0xa4, 0x7c, 0x3e, 0x1a, 0x22, 0x03, 0x56, 0x2c, 0xe2, 0x52, 0x9b, 0x32, 0xec, 0x5a,
+	0x47, 0x3d, 0x07, 0x1f, 0x62, 0xa7, 0x39, 0x2f, 0xfc, 0x75, 0x25, 0x77, 0x25, 0xdb, 0x31, 0xfa,
+	0x09, 0x07, 0x1b, 0x0d, 0x2b, 0x43, 0x41, 0xf7, 0x01, 0x3c, 0x9f, 0x3b, 0x8e, 0xd9, 0x58, 0xee,
+	0xff, 0x85, 0xcc, 0x92, 0x98, 0x84, 0x5e, 0xc2, 0x92, 0xf4, 0x8a, 0x27, 0x9c, 0x48, 0x9b, 0x8b,
+	0x82, 0xcb, 0xf7, 0x0b, 0x84, 0xd0, 0x78, 0x08, 0x18, 0x35, 0x3b, 0x1e, 0x50, 0xfd, 0xcf, 0x25,
+	0x58, 0x1f, 0xcf, 0x8a, 0x60, 0xda, 0xc9, 0x13, 0xe3, 0x45, 0x26, 0xe8, 0x4b, 0xc2, 0x88, 0xc7,
+	0xd0, 0x58, 0x15, 0xf7, 0xe8, 0x33, 0x28, 0xb3, 0x00, 0x23, 0x9c, 0x5b, 0xdd, 0xda, 0x28, 0xce,
+	0xd5, 0x88, 0xe6, 0xa2, 0x4b, 0xb0, 0x14, 0xfe, 0x97, 0xc9, 0x30, 0x27, 0xc2, 0xa8, 0x16, 0x12,
+	0x79, 0x3e, 0x88, 0x2a, 0x92, 0x48, 0xb0, 0xb2, 0xa6, 0xff, 0x43, 0x83, 0x4b, 0xdb, 0x3e, 0x4e,
+	0x99, 0xeb, 0x33, 0x9f, 0x0c, 0x4f, 0x6f, 0xb2, 0xb3, 0xb0, 0xd8, 0xdf, 0xeb, 0x25, 0x4a, 0xe9,
+	0x42, 0x7f, 0x4f, 0x24, 0xe5, 0x55, 0xa8, 0xc7, 0x05, 0x45, 0x02, 0x64, 0xe6, 0x2d, 0xc7, 0x64,
+	0x01, 0x2c, 0xb4, 0x24, 0x5e, 0x37, 0x52, 0x4b, 0x6a, 0x68, 0xfa, 0xbf, 0xe3, 0xd2, 0x68, 0x10,
+	0x07, 0x77, 0xdd, 0x01, 0xf6, 0x6d, 0xc6, 0x0b, 0xf4, 0xc9, 0x97, 0x23, 0x8e, 0xb6, 0x0e, 0x4e,
+	0x2e, 0xa8, 0xcc, 0x09, 0x42, 0xd3, 0x36, 0x34, 0x3c, 0xd3, 0xe7, 0x7b, 0x77, 0x8c, 0x09, 0xd6,
+	0x24, 0xe9, 0x46, 0x88, 0xfc, 0x11, 0xcc, 0x89, 0x00, 0x92, 0xe7, 0xf1, 0x6b, 0x69, 0xc1, 0xc1,
+	0x20, 0x50, 0x7d, 0xc7, 0xb7, 0x0f, 0x6d, 0x07, 0xef, 0xcb, 0xa0, 0x11, 0xd3, 0x72, 0x56, 0x7b,
+	0x56, 0xb7, 0xe0, 0xdc, 0x13, 0x9b, 0xb2, 0xcc, 0x4a, 0x4f, 0x7e, 0x42, 0xce, 0x11, 0xb2, 0xa6,
+	0xbf, 0x84, 0x7a, 0x46, 0x40, 0xda, 0x22, 0x5a, 0xc6, 0x22, 0x1b, 0xb0, 0x92, 0xb5, 0x88, 0x3c,
+	0x59, 0x56, 0x8c, 0x7a, 0xda, 0x24, 0x54, 0xff, 0x93, 0x06, 0xe7, 0xf3, 0x57, 0x70, 0x9a, 0xbd,
+	0xfc, 0x31, 0xd4, 0xec, 0x04, 0xb3, 0xe0, 0x58, 0xab, 0x3c, 0x1e, 0x67, 0x03, 0x25, 0x35, 0x59,
+	0xff, 0xba, 0x04, 0xab, 0x81, 0x5b, 0xee, 0xef, 0x74, 0x1f, 0xe3, 0xa3, 0x93, 0x07, 0xd2, 0x93,
+	0xdc, 0x52, 0x72, 0x4d, 0xa5, 0x97, 0x14, 0xa7, 0x2c, 0x1f, 0xcb, 0x50, 0xb2, 0xc3, 0xf3, 0x48,
+	0xc9, 0xee, 0xa7, 0x4e, 0x30, 0x73, 0x99, 0x13, 0x8c, 0xd8, 0x45, 0x88, 0x87, 0xe5, 0x95, 0xae,
+	0x62, 0x04, 0x23, 0xbe, 0x0d, 0x32, 0xe6, 0xf4, 0x28, 0xb6, 0x88, 0x2b, 0xee, 0x74, 0xe2, 0x16,
+	0xc9, 0x98, 0xb3, 0x2b, 0x29, 0x22, 0x20, 0x1a, 0x67, 0xd3, 0x85, 0xe3, 0x2f, 0x1a, 0x80, 0xd4,
+	0x4d, 0xdc, 0x21, 0xa4, 0x1e, 0x5a, 0xae, 0x1e, 0x25, 0xa5, 0x1e, 0xb3, 0x59, 0x3d, 0xf0, 0x5b,
+	0xcf, 0xf6, 0x71, 0x8f, 0xd9, 0x81, 0xfa, 0xb3, 0x06, 0x48, 0x12, 0x7f, 0x8b, 0x40, 0x17, 0xa1,
+	0x66, 0x89, 0x5a, 0xd5, 0x97, 0x88, 0x79, 0x79, 0x81, 0x0a, 0x68, 0x21, 0xc4, 0x27, 0x2c, 0x86,
+	0xc8, 0xc5, 0x54, 0x03, 0x1a, 0x87, 0xe8, 0x7f, 0xd4, 0xe0, 0x4c, 0xc6, 0x97, 0xa7, 0x89, 0xb3,
+	0x8f, 0xe4, 0xd1, 0x59, 0x1e, 0x93, 0xf5, 0xc9, 0x6e, 0x14, 0x57, 0x0d, 0x0e, 0xe7, 0xcf, 0x05,
+	0x8c, 0x1c, 0x60, 0x37, 0x70, 0x9d, 0x1c, 0xe8, 0x87, 0x80, 0x78, 0x22, 0x48, 0x30, 0x7d, 0x27,
+	0x47, 0xe6, 0xdc, 0xec, 0xfe, 0xad, 0x06, 0xef, 0xa5, 0x04, 0x9f, 0xc6, 0x20, 0x77, 0x60, 0xee,
+	0x00, 0x1f, 0x85, 0x09, 0x57, 0xc4, 0x22, 0x02, 0xbf, 0xf1, 0x08, 0x5a, 0xea, 0xc3, 0x22, 0x3a,
+	0x03, 0x2b, 0x06, 0xa6, 0x8c, 0xf8, 0x89, 0x8d, 0xaa, 0x31, 0x83, 0xde, 0x83, 0xfa, 0xce, 0xc8,
+	0xdf, 0x4f, 0x12, 0xb5, 0x8d, 0x2f, 0xf3, 0x8e, 0x8d, 0x49, 0x66, 0xe7, 0xa1, 0x99, 0xdd, 0xf4,
+	0x42, 0x58, 0x63, 0x06, 0xb5, 0x60, 0xad, 0xe3, 0x13, 0x2f, 0xe7, 0x9b, 0xb6, 0xd1, 0x85, 0x95,
+	0xb1, 0x8c, 0x44, 0x0d, 0xa8, 0x49, 0x76, 0xf2, 0x53, 0x63, 0x86, 0x53, 0x0c, 0xc2, 0x62, 0x8a,
+	0x26, 0x28, 0xf8, 0x90, 0x1c, 0x84, 0x94, 0xd2, 0xd6, 0x1f, 0x6e, 0x40, 0xc5, 0x20, 0x84, 0x6d,
+	0x73, 0x73, 0x20, 0x07, 0x10, 0xbf, 0xc8, 0x90, 0xa1, 0x47, 0x5c, 0xec, 0xca, 0xd7, 0x18, 0x8a,
+	0x36, 0x73, 0x37, 0x88, 0x71, 0x60, 0x10, 0x2a, 0xad, 0xcb, 0xb9, 0xf8, 0x0c, 0x58, 0x9f, 0x41,
+	0x43, 0x21, 0x8d, 0xa7, 0xc3, 0x33, 0xdb, 0x3a, 0xd8, 0x1e, 0x98, 0xae, 0x8b, 0x1d, 0xf4, 0x61,
+	0x7a, 0x76, 0xf4, 0x2c, 0x39, 0x0e, 0x0d, 0xe5, 0x5d, 0xca, 0x95, 0xb7, 0xcb, 0x7c, 0xdb, 0xdd,
+	0x0f, 0xa3, 0x48, 0x9f, 0x41, 0x5f, 0x89, 0xcb, 0x20, 0x97, 0x6e, 0x53, 0x66, 0x5b, 0x34, 0x14,
+	0xb8, 0xa5, 0x16, 0x38, 0x06, 0x3e, 0xa6, 0xc8, 0x1e, 0x34, 0xb2, 0x2e, 0x46, 0x37, 0xf2, 0xad,
+	0x93, 0x81, 0x85, 0x82, 0x26, 0x05, 0xbb, 0x3e, 0x83, 0x7e, 0x06, 0xcb, 0xe9, 0x28, 0x41, 0x1b,
+	0xb9, 0xec, 0xd3, 0xa0, 0x82, 0xcc, 0x7b, 0xb0, 0xf4, 0xc8, 0xa4, 0x09, 0xde, 0xf9, 0x27, 0x85,
+	0x14, 0x26, 0x64, 0x7d, 0x31, 0x17, 0xfa, 0x80, 0x10, 0x27, 0x61, 0x9e, 0x37, 0x80, 0xc2, 0x17,
+	0x84, 0x84, 0x94, 0xfc, 0x70, 0x1b, 0x07, 0x86, 0xa2, 0x6e, 0x15, 0xc6, 0x47, 0x82, 0x7f, 0x0d,
+	0xad, 0xf1, 0xef, 0xdd, 0xc0, 0xf1, 0xff, 0x0f, 0x05, 0x9e, 0x43, 0x35, 0x48, 0x56, 0xc7, 0x36,
+	0x29, 0xba, 0x3a, 0x21, 0x26, 0x04, 0xa2, 0xa0, 0xc7, 0x7e, 0x0c, 0x15, 0xee, 0x69, 0xc9, 0xf4,
+	0x8a, 0x32, 0x12, 0x8e, 0xc3, 0x72, 0x17, 0xe0, 0xbe, 0xc3, 0xb0, 0x2f, 0x79, 0x7e, 0x90, 0xcb,
+	0x33, 0x06, 0x14, 0x64, 0xea, 0x42, 0x7d, 0x77, 0x40, 0xde, 0xc4, 0xa6, 0xa1, 0xe8, 0x7a, 0x7e,
+	0x46, 0xa5, 0x51, 0x21, 0xfb, 0x1b, 0xc5, 0xc0, 0x91, 0xb9, 0x5f, 0xf1, 0xf7, 0x76, 0x86, 0xfd,
+	0xf8, 0xab, 0x42, 0x5e, 0x06, 0x55, 0x70, 0x39, 0xaf, 0xa0, 0x2e, 0x7d, 0xb5, 0x13, 0xbe, 0xa2,
+	0x2a, 0xd8, 0x67, 0x50, 0x05, 0xd9, 0x7f, 0x09, 0x4b, 0xdc, 0x6b, 0x31, 0xf3, 0x6b, 0x4a, 0xcf,
+	0x1e, 0x97, 0xf5, 0x2b, 0xa8, 0x3d, 0x32, 0x69, 0xcc, 0xb9, 0xad, 0xca, 0xf0, 0x31, 0xc6, 0x85,
+	0x12, 0xfc, 0x00, 0x96, 0xb9, 0x53, 0xa2, 0xc9, 0x54, 0x51, 0x9e, 0xd2, 0xa0, 0x50, 0xc4, 0xf5,
+	0x42, 0xd8, 0x48, 0x18, 0x85, 0xb5, 0xf4, 0xb7, 0x28, 0xa1, 0xdf, 0xa1, 0x50, 0x0c, 0x35, 0xfe,
+	0x2d, 0x7c, 0x00, 0x55, 0x18, 0x30, 0x09, 0x09, 0x05, 0x5d, 0x2b, 0x80, 0x4c, 0xec, 0x5d, 0xcb,
+	0xe9, 0x6e, 0x18, 0xba, 0xa9, 0x3c, 0xd2, 0xe4, 0xf5, 0xe5, 0x5a, 0x9b, 0x45, 0xe1, 0x91, 0xc8,
+	0x9f, 0xc3, 0x62, 0xd0, 0xa3, 0x42, 0x1f, 0x4c, 0x9c, 0x1c, 0xb5, 0xc7, 0x5a, 0x57, 0xa7, 0xe2,
+	0x22, 0xee, 0x26, 0x9c, 0x79, 0xee, 0xf5, 0xf9, 0x96, 0x27, 0x37, 0xd6, 0x70, 0x6b, 0x47, 0xd7,
+	0x14, 0xbb, 0x71, 0x06, 0xf7, 0x94, 0xee, 0x4f, 0x8b, 0x6d, 0x1f, 0x2e, 0x74, 0xdd, 0x43, 0xd3,
+	0xb1, 0xfb, 0xa9, 0x9d, 0xf5, 0x29, 0x66, 0xe6, 0xb6, 0x69, 0x0d, 0x70, 0x76, 0xe3, 0x97, 0x0d,
+	0xcf, 0xf4, 0x94, 0x08, 0x5c, 0x30, 0x9f, 0x7e, 0x05, 0x48, 0x56, 0x21, 0xf7, 0xb5, 0xbd, 0x3f,
+	0xf2, 0x4d, 0x19, 0xf4, 0xaa, 0x23, 0xcd, 0x38, 0x34, 0x14, 0xf3, 0xbd, 0x63, 0xcc, 0x48, 0x9c,
+	0x36, 0xe0, 0x21, 0x66, 0x4f, 0xc5, 0xcb, 0xa1, 0xaa, 0x54, 0xc7, 0x00, 0x85, 0xd3, 0x72, 0x70,
+	0x91, 0x80, 0x5d, 0x58, 0x90, 0x6d, 0x3a, 0xa4, 0xe7, 0x4e, 0x0a, 0x9b, 0x8c, 0x93, 0xce, 0x48,
+	0x21, 0x26, 0x59, 0x23, 0x1e, 0x62, 0x96, 0x68, 0xff, 0x29, 0xd2, 0x35, 0x0d, 0x9a, 0x9c, 0xae,
+	0x59, 0x6c, 0x24, 0xcc, 0x85, 0x3a, 0xbf, 0x62, 0xc8, 0x8f, 0xfc, 0x91, 0x5c, 0xb5, 0xf1, 0x64,
+	0x50, 0x93, 0x37, 0x9e, 0x31, 0x70, 0xc2, 0x62, 0x35, 0x03, 0xf3, 0x0f, 0x81, 0xdd, 0x94, 0x1d,
+	0x8c, 0x64, 0x7f, 0x76, 0x5a, 0x90, 0xbd, 0x88, 0x4e, 0x95, 0x51, 0xc7, 0x01, 0x5d, 0x51, 0x04,
+	0x4c, 0x0c, 0xe1, 0x97, 0x9c, 0x02, 0x9c, 0x83, 0xac, 0xfc, 0xb6, 0x39, 0xf7, 0xa0, 0xd1, 0xc1,
+	0x0e, 0x4e, 0x71, 0xbe, 0xa1, 0x38, 0x37, 0xa5, 0x61, 0x05, 0x33, 0x6f, 0x00, 0x4b, 0xdc, 0x0d,
+	0x7c, 0xde, 0x73, 0x8a, 0x7d, 0xaa, 0xd8, 0x24, 0x53, 0x98, 0x90, 0xf5, 0x46, 0x11, 0x68, 0x22,
+	0x86, 0x96, 0x52, 0xdd, 0x1e, 0x74, 0x43, 0xe5, 0xd4, 0xbc, 0xde, 0x53, 0xeb, 0x66, 0x41, 0x74,
+	0x22, 0x86, 0x40, 0xba, 0xdb, 0x20, 0x0e, 0x56, 0xa4, 0x75, 0x0c, 0x28, 0x68, 0xae, 0x2f, 0xa0,
+	0xcc, 0xcf, 0x0b, 0x82, 0xe5, 0x65, 0xe5, 0x71, 0xe2, 0x18, 0x0c, 0x5f, 0x41, 0x3d, 0xb8, 0x8d,
+	0x72, 0x7b, 0x09, 0xbe, 0xd7, 0x27, 0x3d, 0x2c, 0x86, 0xa8, 0xc2, 0x77, 0x11, 0xd8, 0xc5, 0xbc,
+	0x82, 0x4f, 0x30, 0x42, 0x0c, 0x98, 0x5c, 0xdb, 0x92, 0xb8, 0x64, 0xf1, 0x94, 0x74, 0xae, 0xd8,
+	0x44, 0x01, 0x42, 0xf3, 0x02, 0x02, 0x24, 0x2e, 0x79, 0x17, 0xcc, 0xbe, 0xa9, 0x2a, 0x32, 0x20,
+	0x0b, 0x2b, 0x68, 0xa2, 0x3d, 0xa8, 0x4a, 0xc1, 0x0f, 0x7d, 0xd3, 0x65, 0x68, 0x92, 0x6a, 0x02,
+	0x11, 0xb2, 0x6d, 0x4f, 0x07, 0x46, 0x8b, 0xb0, 0x00, 0x78, 0x5a, 0xec, 0x10, 0xc7, 0xb6, 0x8e,
+	0x50, 0x5b, 0x51, 0x1a, 0x62, 0x88, 0xe2, 0xb0, 0x93, 0x8b, 0x8c, 0x84, 0xec, 0x41, 0x75, 0x7b,
+	0x80, 0xad, 0x83, 0x47, 0xd8, 0x74, 0xd8, 0x40, 0x75, 0x39, 0x8a, 0x11, 0x93, 0x17, 0x92, 0x02,
+	0x26, 0xbd, 0x61, 0x60, 0xfe, 0x14, 0x35, 0xf5, 0x66, 0x9e, 0x85, 0x15, 0xbf, 0x99, 0xcb, 0xa4,
+	0xec, 0x98, 0xcc, 0x14, 0xef, 0x61, 0x1b, 0x13, 0x32, 0x37, 0x04, 0x15, 0x64, 0xfe, 0x53, 0xa8,
+	0xf1, 0xf4, 0x8c, 0x58, 0xb7, 0x95, 0x19, 0x7c, 0x4c, 0xc6, 0x41, 0x15, 0x0d, 0x67, 0x4d, 0xaa,
+	0xa2, 0x11, 0x66, 0x7a, 0x15, 0x4d, 0x40, 0x13, 0xc7, 0xcb, 0xa5, 0x54, 0xb7, 0x5a, 0x5d, 0x45,
+	0xf3, 0x9a, 0xda, 0xd3, 0x2f, 0x98, 0x6b, 0xf9, 0x6d, 0x69, 0xa4, 0x6c, 0x97, 0x4d, 0x6c, 0x63,
+	0x4f, 0x93, 0xc7, 0xe0, 0x7d, 0x65, 0xc3, 0x0f, 0xdd, 0x2d, 0x2e, 0x32, 0xdd, 0xf0, 0x9a, 0x26,
+	0xf5, 0x97, 0x70, 0x7e, 0x52, 0xdb, 0x0c, 0xfd, 0x50, 0x69, 0xd2, 0xe9, 0xcd, 0xb6, 0xe2, 0x16,
+	0xce, 0xb6, 0x64, 0xa6, 0x59, 0x38, 0xbf, 0x1b, 0x36, 0x4d, 0xde, 0xef, 0x34, 0x58, 0xcd, 0xeb,
+	0xcf, 0xa0, 0xdb, 0x2a, 0x71, 0x13, 0xfa, 0x51, 0xad, 0x8f, 0x8e, 0x37, 0x29, 0xb9, 0xf7, 0xa7,
+	0x5e, 0xed, 0xd5, 0x51, 0x9b, 0xd7, 0xa8, 0x69, 0xdd, 0x2c, 0x88, 0x8e, 0xe4, 0x0d, 0xa0, 0x9a,
+	0x78, 0x12, 0x47, 0x1b, 0x93, 0xd4, 0x4e, 0x3f, 0xd8, 0xb7, 0xae, 0x17, 0xc2, 0x86, 0x92, 0x1e,
+	0xdc, 0x7d, 0x79, 0x67, 0xdf, 0x66, 0x83, 0xd1, 0x1e, 0xb7, 0xfc, 0x2d, 0x39, 0xf5, 0xa6, 0x4d,
+	0x82, 0x7f, 0xb7, 0xc2, 0x8a, 0x7d, 0x4b, 0x70, 0xbb, 0x15, 0x71, 0xf3, 0xf6, 0xf6, 0x16, 0x04,
+	0xe9, 0xf6, 0xff, 0x06, 0x00, 0x2d, 0x6b, 0x83, 0xfc, 0x88, 0x2a, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -889,6 +1925,14 @@ type RootCoordClient interface {
 	CreateDatabase(ctx context.Context, in *milvuspb.CreateDatabaseRequest, opts ...grpc.CallOption) (*commonpb.Status, error)
 	DropDatabase(ctx context.Context, in *milvuspb.DropDatabaseRequest, opts ...grpc.CallOption) (*commonpb.Status, error)
 	ListDatabases(ctx context.Context, in *milvuspb.ListDatabasesRequest, opts ...grpc.CallOption) (*milvuspb.ListDatabasesResponse, error)
+	CancelDDLTask(ctx context.Context, in *CancelDDLTaskRequest, opts ...grpc.CallOption) (*commonpb.Status, error)
+	OperateCollectionTrash(ctx context.Context, in *OperateCollectionTrashRequest, opts ...grpc.CallOption) (*commonpb.Status, error)
+	OperateCollectionTemplate(ctx context.Context, in *OperateCollectionTemplateRequest, opts ...grpc.CallOption) (*commonpb.Status, error)
+	CreateCollectionFromTemplate(ctx context.Context, in *CreateCollectionFromTemplateRequest, opts ...grpc.CallOption) (*commonpb.Status, error)
+	OperateRoleInheritance(ctx context.Context, in *OperateRoleInheritanceRequest, opts ...grpc.CallOption) (*commonpb.Status, error)
+	ListRoleInheritances(ctx context.Context, in *ListRoleInheritancesRequest, opts ...grpc.CallOption) (*ListRoleInheritancesResponse, error)
+	OperateAPIKey(ctx context.Context, in *OperateAPIKeyRequest, opts ...grpc.CallOption) (*OperateAPIKeyResponse, error)
+	ListAPIKeys(ctx context.Context, in *ListAPIKeysRequest, opts ...grpc.CallOption) (*ListAPIKeysResponse, error)
 }
 
 type rootCoordClient struct {
@@ -1322,6 +2366,78 @@ func (c *rootCoordClient) ListDatabases(ctx context.Context, in *milvuspb.ListDa
 	return out, nil
 }
 
+func (c *rootCoordClient) CancelDDLTask(ctx context.Context, in *CancelDDLTaskRequest, opts ...grpc.CallOption) (*commonpb.Status, error) {
+	out := new(commonpb.Status)
+	err := c.cc.Invoke(ctx, "/milvus.proto.rootcoord.RootCoord/CancelDDLTask", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *rootCoordClient) OperateCollectionTrash(ctx context.Context, in *OperateCollectionTrashRequest, opts ...grpc.CallOption) (*commonpb.Status, error) {
+	out := new(commonpb.Status)
+	err := c.cc.Invoke(ctx, "/milvus.proto.rootcoord.RootCoord/OperateCollectionTrash", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *rootCoordClient) OperateCollectionTemplate(ctx context.Context, in *OperateCollectionTemplateRequest, opts ...grpc.CallOption) (*commonpb.Status, error) {
+	out := new(commonpb.Status)
+	err := c.cc.Invoke(ctx, "/milvus.proto.rootcoord.RootCoord/OperateCollectionTemplate", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *rootCoordClient) CreateCollectionFromTemplate(ctx context.Context, in *CreateCollectionFromTemplateRequest, opts ...grpc.CallOption) (*commonpb.Status, error) {
+	out := new(commonpb.Status)
+	err := c.cc.Invoke(ctx, "/milvus.proto.rootcoord.RootCoord/CreateCollectionFromTemplate", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *rootCoordClient) OperateRoleInheritance(ctx context.Context, in *OperateRoleInheritanceRequest, opts ...grpc.CallOption) (*commonpb.Status, error) {
+	out := new(commonpb.Status)
+	err := c.cc.Invoke(ctx, "/milvus.proto.rootcoord.RootCoord/OperateRoleInheritance", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *rootCoordClient) ListRoleInheritances(ctx context.Context, in *ListRoleInheritancesRequest, opts ...grpc.CallOption) (*ListRoleInheritancesResponse, error) {
+	out := new(ListRoleInheritancesResponse)
+	err := c.cc.Invoke(ctx, "/milvus.proto.rootcoord.RootCoord/ListRoleInheritances", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *rootCoordClient) OperateAPIKey(ctx context.Context, in *OperateAPIKeyRequest, opts ...grpc.CallOption) (*OperateAPIKeyResponse, error) {
+	out := new(OperateAPIKeyResponse)
+	err := c.cc.Invoke(ctx, "/milvus.proto.rootcoord.RootCoord/OperateAPIKey", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *rootCoordClient) ListAPIKeys(ctx context.Context, in *ListAPIKeysRequest, opts ...grpc.CallOption) (*ListAPIKeysResponse, error) {
+	out := new(ListAPIKeysResponse)
+	err := c.cc.Invoke(ctx, "/milvus.proto.rootcoord.RootCoord/ListAPIKeys", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // RootCoordServer is the server API for RootCoord service.
 type RootCoordServer interface {
 	GetComponentStates(context.Context, *milvuspb.GetComponentStatesRequest) (*milvuspb.ComponentStates, error)
@@ -1423,6 +2539,14 @@ type RootCoordServer interface {
 	CreateDatabase(context.Context, *milvuspb.CreateDatabaseRequest) (*commonpb.Status, error)
 	DropDatabase(context.Context, *milvuspb.DropDatabaseRequest) (*commonpb.Status, error)
 	ListDatabases(context.Context, *milvuspb.ListDatabasesRequest) (*milvuspb.ListDatabasesResponse, error)
+	CancelDDLTask(context.Context, *CancelDDLTaskRequest) (*commonpb.Status, error)
+	OperateCollectionTrash(context.Context, *OperateCollectionTrashRequest) (*commonpb.Status, error)
+	OperateCollectionTemplate(context.Context, *OperateCollectionTemplateRequest) (*commonpb.Status, error)
+	CreateCollectionFromTemplate(context.Context, *CreateCollectionFromTemplateRequest) (*commonpb.Status, error)
+	OperateRoleInheritance(context.Context, *OperateRoleInheritanceRequest) (*commonpb.Status, error)
+	ListRoleInheritances(context.Context, *ListRoleInheritancesRequest) (*ListRoleInheritancesResponse, error)
+	OperateAPIKey(context.Context, *OperateAPIKeyRequest) (*OperateAPIKeyResponse, error)
+	ListAPIKeys(context.Context, *ListAPIKeysRequest) (*ListAPIKeysResponse, error)
 }
 
 // UnimplementedRootCoordServer can be embedded to have forward compatible implementations.
@@ -1570,6 +2694,30 @@ func (*UnimplementedRootCoordServer) DropDatabase(ctx context.Context, req *milv
 func (*UnimplementedRootCoordServer) ListDatabases(ctx context.Context, req *milvuspb.ListDatabasesRequest) (*milvuspb.ListDatabasesResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ListDatabases not implemented")
 }
+func (*UnimplementedRootCoordServer) CancelDDLTask(ctx context.Context, req *CancelDDLTaskRequest) (*commonpb.Status, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CancelDDLTask not implemented")
+}
+func (*UnimplementedRootCoordServer) OperateCollectionTrash(ctx context.Context, req *OperateCollectionTrashRequest) (*commonpb.Status, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method OperateCollectionTrash not implemented")
+}
+func (*UnimplementedRootCoordServer) OperateCollectionTemplate(ctx context.Context, req *OperateCollectionTemplateRequest) (*commonpb.Status, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method OperateCollectionTemplate not implemented")
+}
+func (*UnimplementedRootCoordServer) CreateCollectionFromTemplate(ctx context.Context, req *CreateCollectionFromTemplateRequest) (*commonpb.Status, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateCollectionFromTemplate not implemented")
+}
+func (*UnimplementedRootCoordServer) OperateRoleInheritance(ctx context.Context, req *OperateRoleInheritanceRequest) (*commonpb.Status, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method OperateRoleInheritance not implemented")
+}
+func (*UnimplementedRootCoordServer) ListRoleInheritances(ctx context.Context, req *ListRoleInheritancesRequest) (*ListRoleInheritancesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListRoleInheritances not implemented")
+}
+func (*UnimplementedRootCoordServer) OperateAPIKey(ctx context.Context, req *OperateAPIKeyRequest) (*OperateAPIKeyResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method OperateAPIKey not implemented")
+}
+func (*UnimplementedRootCoordServer) ListAPIKeys(ctx context.Context, req *ListAPIKeysRequest) (*ListAPIKeysResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListAPIKeys not implemented")
+}
 
 func RegisterRootCoordServer(s *grpc.Server, srv RootCoordServer) {
 	s.RegisterService(&_RootCoord_serviceDesc, srv)
@@ -2421,6 +3569,150 @@ func _RootCoord_ListDatabases_Handler(srv interface{}, ctx context.Context, dec
 	return interceptor(ctx, in, info, handler)
 }
 
+func _RootCoord_CancelDDLTask_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CancelDDLTaskRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RootCoordServer).CancelDDLTask(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/milvus.proto.rootcoord.RootCoord/CancelDDLTask",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RootCoordServer).CancelDDLTask(ctx, req.(*CancelDDLTaskRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RootCoord_OperateCollectionTrash_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(OperateCollectionTrashRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RootCoordServer).OperateCollectionTrash(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/milvus.proto.rootcoord.RootCoord/OperateCollectionTrash",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RootCoordServer).OperateCollectionTrash(ctx, req.(*OperateCollectionTrashRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RootCoord_OperateCollectionTemplate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(OperateCollectionTemplateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RootCoordServer).OperateCollectionTemplate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/milvus.proto.rootcoord.RootCoord/OperateCollectionTemplate",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RootCoordServer).OperateCollectionTemplate(ctx, req.(*OperateCollectionTemplateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RootCoord_CreateCollectionFromTemplate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateCollectionFromTemplateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RootCoordServer).CreateCollectionFromTemplate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/milvus.proto.rootcoord.RootCoord/CreateCollectionFromTemplate",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RootCoordServer).CreateCollectionFromTemplate(ctx, req.(*CreateCollectionFromTemplateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RootCoord_OperateRoleInheritance_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(OperateRoleInheritanceRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RootCoordServer).OperateRoleInheritance(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/milvus.proto.rootcoord.RootCoord/OperateRoleInheritance",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RootCoordServer).OperateRoleInheritance(ctx, req.(*OperateRoleInheritanceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RootCoord_ListRoleInheritances_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListRoleInheritancesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RootCoordServer).ListRoleInheritances(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/milvus.proto.rootcoord.RootCoord/ListRoleInheritances",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RootCoordServer).ListRoleInheritances(ctx, req.(*ListRoleInheritancesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RootCoord_OperateAPIKey_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(OperateAPIKeyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RootCoordServer).OperateAPIKey(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/milvus.proto.rootcoord.RootCoord/OperateAPIKey",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RootCoordServer).OperateAPIKey(ctx, req.(*OperateAPIKeyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RootCoord_ListAPIKeys_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListAPIKeysRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RootCoordServer).ListAPIKeys(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/milvus.proto.rootcoord.RootCoord/ListAPIKeys",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RootCoordServer).ListAPIKeys(ctx, req.(*ListAPIKeysRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _RootCoord_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "milvus.proto.rootcoord.RootCoord",
 	HandlerType: (*RootCoordServer)(nil),
@@ -2613,6 +3905,38 @@ var _RootCoord_serviceDesc = grpc.ServiceDesc{
 			MethodName: "ListDatabases",
 			Handler:    _RootCoord_ListDatabases_Handler,
 		},
+		{
+			MethodName: "CancelDDLTask",
+			Handler:    _RootCoord_CancelDDLTask_Handler,
+		},
+		{
+			MethodName: "OperateCollectionTrash",
+			Handler:    _RootCoord_OperateCollectionTrash_Handler,
+		},
+		{
+			MethodName: "OperateCollectionTemplate",
+			Handler:    _RootCoord_OperateCollectionTemplate_Handler,
+		},
+		{
+			MethodName: "CreateCollectionFromTemplate",
+			Handler:    _RootCoord_CreateCollectionFromTemplate_Handler,
+		},
+		{
+			MethodName: "OperateRoleInheritance",
+			Handler:    _RootCoord_OperateRoleInheritance_Handler,
+		},
+		{
+			MethodName: "ListRoleInheritances",
+			Handler:    _RootCoord_ListRoleInheritances_Handler,
+		},
+		{
+			MethodName: "OperateAPIKey",
+			Handler:    _RootCoord_OperateAPIKey_Handler,
+		},
+		{
+			MethodName: "ListAPIKeys",
+			Handler:    _RootCoord_ListAPIKeys_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "root_coord.proto",
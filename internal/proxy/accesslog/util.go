@@ -72,9 +72,11 @@ func getAccessAddr(ctx context.Context) string {
 }
 
 func getTraceID(ctx context.Context) (id string, ok bool) {
-	meta, ok := metadata.FromOutgoingContext(ctx)
-	if ok {
-		return meta.Get(clientRequestIDKey)[0], true
+	// the request id is provided by the client or generated by proxy
+	if meta, ok := metadata.FromOutgoingContext(ctx); ok {
+		if requestIDs := meta.Get(clientRequestIDKey); len(requestIDs) > 0 {
+			return requestIDs[0], true
+		}
 	}
 
 	traceID := trace.SpanFromContext(ctx).SpanContext().TraceID()
@@ -0,0 +1,121 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package proxy
+
+import (
+	"context"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"go.uber.org/zap"
+
+	"github.com/milvus-io/milvus/pkg/log"
+	"github.com/milvus-io/milvus/pkg/metrics"
+	"github.com/milvus-io/milvus/pkg/util/crypto"
+	"github.com/milvus-io/milvus/pkg/util/merr"
+	"github.com/milvus-io/milvus/pkg/util/paramtable"
+)
+
+// apiKeyAdminMethods are the methods requiring the admin scope of api key,
+// which manage the users, privileges, databases and resource groups.
+var apiKeyAdminMethods = map[string]struct{}{
+	"CreateCredential":    {},
+	"UpdateCredential":    {},
+	"DeleteCredential":    {},
+	"ListCredUsers":       {},
+	"CreateRole":          {},
+	"DropRole":            {},
+	"OperateUserRole":     {},
+	"SelectRole":          {},
+	"SelectUser":          {},
+	"OperatePrivilege":    {},
+	"SelectGrant":         {},
+	"CreateDatabase":      {},
+	"DropDatabase":        {},
+	"CreateResourceGroup": {},
+	"DropResourceGroup":   {},
+	"TransferNode":        {},
+	"TransferReplica":     {},
+}
+
+// apiKeyReadMethodPrefixes are the prefixes of the methods only reading data or meta, which require the read scope of api key.
+var apiKeyReadMethodPrefixes = []string{"Search", "HybridSearch", "Query", "Get", "Describe", "Show", "Has", "List", "Check", "Calc", "Connect"}
+
+// apiKeyScopeOfMethod returns the scope of api key required by the grpc method,
+// the methods not known to be admin or read require the write scope.
+func apiKeyScopeOfMethod(fullMethod string) string {
+	method := fullMethod[strings.LastIndex(fullMethod, "/")+1:]
+	if _, ok := apiKeyAdminMethods[method]; ok {
+		return crypto.APIKeyScopeAdmin
+	}
+	for _, prefix := range apiKeyReadMethodPrefixes {
+		if strings.HasPrefix(method, prefix) {
+			return crypto.APIKeyScopeRead
+		}
+	}
+	return crypto.APIKeyScopeWrite
+}
+
+// apiKeyHTTPRouteScopes are the scopes of api key required by the restful routes, keyed by the method and path,
+// the routes not listed, e.g. the admin ones, require the admin scope.
+var apiKeyHTTPRouteScopes = map[string]string{
+	http.MethodGet + " /v1/vector/collections":          crypto.APIKeyScopeRead,
+	http.MethodGet + " /v1/vector/collections/describe": crypto.APIKeyScopeRead,
+	http.MethodPost + " /v1/vector/query":               crypto.APIKeyScopeRead,
+	http.MethodPost + " /v1/vector/get":                 crypto.APIKeyScopeRead,
+	http.MethodPost + " /v1/vector/search":              crypto.APIKeyScopeRead,
+	http.MethodPost + " /v1/vector/hybrid_search":       crypto.APIKeyScopeRead,
+	http.MethodPost + " /v1/vector/sql":                 crypto.APIKeyScopeRead,
+	http.MethodPost + " /v1/vector/collections/create":  crypto.APIKeyScopeWrite,
+	http.MethodPost + " /v1/vector/collections/drop":    crypto.APIKeyScopeWrite,
+	http.MethodPost + " /v1/vector/insert":              crypto.APIKeyScopeWrite,
+	http.MethodPost + " /v1/vector/upsert":              crypto.APIKeyScopeWrite,
+	http.MethodPost + " /v1/vector/delete":              crypto.APIKeyScopeWrite,
+}
+
+// APIKeyScopeOfHTTPRequest returns the scope of api key required by the restful request.
+func APIKeyScopeOfHTTPRequest(method string, path string) string {
+	if scope, ok := apiKeyHTTPRouteScopes[method+" "+path]; ok {
+		return scope
+	}
+	return crypto.APIKeyScopeAdmin
+}
+
+// verifyBuiltinAPIKey verifies the api key issued by rootcoord, and returns the user bound to the key.
+func verifyBuiltinAPIKey(ctx context.Context, id string, secret string, scope string, cache Cache) (string, error) {
+	nodeID := strconv.FormatInt(paramtable.GetNodeID(), 10)
+	key, err := cache.GetAPIKey(ctx, id)
+	if err != nil {
+		log.Ctx(ctx).Warn("fail to get api key", zap.String("id", id), zap.Error(err))
+		// the id is not recorded since the unknown ids could blow up the metric
+		metrics.ProxyAPIKeyRequestCounter.WithLabelValues(nodeID, "", "", metrics.FailLabel).Inc()
+		return "", merr.WrapErrParameterInvalidMsg("invalid api key %s", id)
+	}
+	if err := key.Verify(secret, time.Now()); err != nil {
+		log.Ctx(ctx).Warn("fail to verify api key", zap.String("id", id), zap.String("username", key.Username), zap.Error(err))
+		metrics.ProxyAPIKeyRequestCounter.WithLabelValues(nodeID, id, key.Username, metrics.FailLabel).Inc()
+		return "", merr.WrapErrParameterInvalidMsg("invalid api key: %s", err.Error())
+	}
+	if !key.Allow(scope) {
+		metrics.ProxyAPIKeyRequestCounter.WithLabelValues(nodeID, id, key.Username, metrics.FailLabel).Inc()
+		return "", merr.WrapErrPrivilegeNotPermitted("api key %s with scopes %v is not permitted, %s scope is required", id, key.Scopes, scope)
+	}
+	metrics.ProxyAPIKeyRequestCounter.WithLabelValues(nodeID, id, key.Username, metrics.SuccessLabel).Inc()
+	return key.Username, nil
+}
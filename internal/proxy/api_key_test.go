@@ -0,0 +1,114 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package proxy
+
+import (
+	"context"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/cockroachdb/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+
+	"github.com/milvus-io/milvus/internal/proto/internalpb"
+	"github.com/milvus-io/milvus/pkg/util/crypto"
+	"github.com/milvus-io/milvus/pkg/util/merr"
+	"github.com/milvus-io/milvus/pkg/util/paramtable"
+)
+
+func TestAPIKeyScope(t *testing.T) {
+	assert.Equal(t, crypto.APIKeyScopeRead, apiKeyScopeOfMethod("/milvus.proto.milvus.MilvusService/Search"))
+	assert.Equal(t, crypto.APIKeyScopeRead, apiKeyScopeOfMethod("/milvus.proto.milvus.MilvusService/DescribeCollection"))
+	assert.Equal(t, crypto.APIKeyScopeRead, apiKeyScopeOfMethod("/milvus.proto.milvus.MilvusService/ListDatabases"))
+	assert.Equal(t, crypto.APIKeyScopeWrite, apiKeyScopeOfMethod("/milvus.proto.milvus.MilvusService/Insert"))
+	assert.Equal(t, crypto.APIKeyScopeWrite, apiKeyScopeOfMethod("/milvus.proto.milvus.MilvusService/CreateCollection"))
+	assert.Equal(t, crypto.APIKeyScopeAdmin, apiKeyScopeOfMethod("/milvus.proto.milvus.MilvusService/ListCredUsers"))
+	assert.Equal(t, crypto.APIKeyScopeAdmin, apiKeyScopeOfMethod("/milvus.proto.milvus.MilvusService/OperatePrivilege"))
+	assert.Equal(t, crypto.APIKeyScopeWrite, apiKeyScopeOfMethod(""))
+
+	assert.Equal(t, crypto.APIKeyScopeRead, APIKeyScopeOfHTTPRequest(http.MethodGet, "/v1/vector/collections"))
+	assert.Equal(t, crypto.APIKeyScopeRead, APIKeyScopeOfHTTPRequest(http.MethodPost, "/v1/vector/search"))
+	assert.Equal(t, crypto.APIKeyScopeWrite, APIKeyScopeOfHTTPRequest(http.MethodPost, "/v1/vector/insert"))
+	assert.Equal(t, crypto.APIKeyScopeAdmin, APIKeyScopeOfHTTPRequest(http.MethodGet, "/api/v1/credential/users"))
+	// the routes not listed require the admin scope, even if they only read
+	assert.Equal(t, crypto.APIKeyScopeAdmin, APIKeyScopeOfHTTPRequest(http.MethodPost, "/v1/admin/collection/purge"))
+	assert.Equal(t, crypto.APIKeyScopeAdmin, APIKeyScopeOfHTTPRequest(http.MethodGet, "/v1/admin/apikey/list"))
+	assert.Equal(t, crypto.APIKeyScopeAdmin, APIKeyScopeOfHTTPRequest(http.MethodPost, "/v1/vector/collections"))
+}
+
+func TestVerifyBuiltinAPIKey(t *testing.T) {
+	paramtable.Init()
+	ctx := context.Background()
+
+	secret, hashedSecret, err := crypto.NewAPIKeySecret()
+	assert.NoError(t, err)
+	cache := NewMockCache(t)
+	cache.EXPECT().GetAPIKey(mock.Anything, "1").Return(&crypto.APIKey{
+		ID:           "1",
+		Username:     "foo",
+		HashedSecret: hashedSecret,
+		Scopes:       []string{crypto.APIKeyScopeWrite},
+	}, nil)
+	cache.EXPECT().GetAPIKey(mock.Anything, "2").Return(&crypto.APIKey{
+		ID:           "2",
+		Username:     "foo",
+		HashedSecret: hashedSecret,
+		Scopes:       []string{crypto.APIKeyScopeAdmin},
+		ExpireTime:   time.Now().Add(-time.Minute).Unix(),
+	}, nil)
+	cache.EXPECT().GetAPIKey(mock.Anything, "3").Return(nil, merr.ErrIoKeyNotFound)
+
+	user, err := verifyBuiltinAPIKey(ctx, "1", secret, crypto.APIKeyScopeRead, cache)
+	assert.NoError(t, err)
+	assert.Equal(t, "foo", user)
+
+	_, err = verifyBuiltinAPIKey(ctx, "1", secret, crypto.APIKeyScopeAdmin, cache)
+	assert.True(t, errors.Is(err, merr.ErrPrivilegeNotPermitted))
+
+	_, err = verifyBuiltinAPIKey(ctx, "1", "wrong", crypto.APIKeyScopeRead, cache)
+	assert.True(t, errors.Is(err, merr.ErrParameterInvalid))
+
+	// expired
+	_, err = verifyBuiltinAPIKey(ctx, "2", secret, crypto.APIKeyScopeRead, cache)
+	assert.True(t, errors.Is(err, merr.ErrParameterInvalid))
+
+	_, err = verifyBuiltinAPIKey(ctx, "3", secret, crypto.APIKeyScopeRead, cache)
+	assert.True(t, errors.Is(err, merr.ErrParameterInvalid))
+}
+
+func TestMetaCache_APIKey(t *testing.T) {
+	cache := &MetaCache{
+		credMap:      map[string]*internalpb.CredentialInfo{},
+		credStateMap: map[string]*crypto.CredentialState{},
+		apiKeyMap: map[string]*crypto.APIKey{
+			"1": {ID: "1", Username: "foo"},
+			"2": {ID: "2", Username: "bar"},
+		},
+	}
+	key, err := cache.GetAPIKey(context.Background(), "1")
+	assert.NoError(t, err)
+	assert.Equal(t, "foo", key.Username)
+
+	cache.RemoveCredential("foo")
+	assert.NotContains(t, cache.apiKeyMap, "1")
+	assert.Contains(t, cache.apiKeyMap, "2")
+
+	cache.RemoveAPIKey("2")
+	assert.Empty(t, cache.apiKeyMap)
+}
@@ -0,0 +1,136 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package proxy
+
+import (
+	"context"
+	"strings"
+	"time"
+
+	"go.opentelemetry.io/otel/trace"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/peer"
+
+	"github.com/milvus-io/milvus-proto/go-api/v2/commonpb"
+	"github.com/milvus-io/milvus-proto/go-api/v2/milvuspb"
+	"github.com/milvus-io/milvus/internal/proxy/auditlog"
+	"github.com/milvus-io/milvus/pkg/util/funcutil"
+	"github.com/milvus-io/milvus/pkg/util/merr"
+	"github.com/milvus-io/milvus/pkg/util/typeutil"
+)
+
+// AuditLogInterceptor returns a new unary server interceptor that logs the data-plane accesses for auditing.
+func AuditLogInterceptor() grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+		logger := auditlog.L()
+		if logger == nil {
+			return handler(ctx, req)
+		}
+		event := newAuditEvent(ctx, req, info.FullMethod)
+		if event == nil {
+			return handler(ctx, req)
+		}
+
+		start := time.Now()
+		resp, err := handler(ctx, req)
+		event.CostMs = time.Since(start).Milliseconds()
+		fillAuditEventResult(event, resp, err)
+		logger.Log(event)
+		return resp, err
+	}
+}
+
+// newAuditEvent returns the audit event of the request, nil if the request is not a data-plane access.
+func newAuditEvent(ctx context.Context, req interface{}, fullMethod string) *auditlog.Event {
+	event := &auditlog.Event{
+		Time:   time.Now().UnixMilli(),
+		Method: fullMethod[strings.LastIndex(fullMethod, "/")+1:],
+	}
+	switch r := req.(type) {
+	case *milvuspb.SearchRequest:
+		event.Database, event.Collection, event.Partitions = r.GetDbName(), r.GetCollectionName(), r.GetPartitionNames()
+		event.Expr, event.OutputFields = r.GetDsl(), r.GetOutputFields()
+	case *milvuspb.QueryRequest:
+		event.Database, event.Collection, event.Partitions = r.GetDbName(), r.GetCollectionName(), r.GetPartitionNames()
+		event.Expr, event.OutputFields = r.GetExpr(), r.GetOutputFields()
+	case *milvuspb.InsertRequest:
+		event.Database, event.Collection = r.GetDbName(), r.GetCollectionName()
+		event.Partitions = nonEmptyPartition(r.GetPartitionName())
+	case *milvuspb.UpsertRequest:
+		event.Database, event.Collection = r.GetDbName(), r.GetCollectionName()
+		event.Partitions = nonEmptyPartition(r.GetPartitionName())
+	case *milvuspb.DeleteRequest:
+		event.Database, event.Collection = r.GetDbName(), r.GetCollectionName()
+		event.Partitions = nonEmptyPartition(r.GetPartitionName())
+		event.Expr = r.GetExpr()
+	default:
+		return nil
+	}
+	if event.Database == "" {
+		event.Database = GetCurDBNameFromContextOrDefault(ctx)
+	}
+	event.User, _ = GetCurUserFromContext(ctx)
+	if p, ok := peer.FromContext(ctx); ok {
+		event.ClientAddr = p.Addr.String()
+	}
+	if traceID := trace.SpanFromContext(ctx).SpanContext().TraceID(); traceID.IsValid() {
+		event.TraceID = traceID.String()
+	}
+	return event
+}
+
+func nonEmptyPartition(partitionName string) []string {
+	if partitionName == "" {
+		return nil
+	}
+	return []string{partitionName}
+}
+
+// fillAuditEventResult fills the status and the number of rows accessed by the request.
+func fillAuditEventResult(event *auditlog.Event, resp interface{}, err error) {
+	if err != nil {
+		event.Status, event.ErrorCode, event.Reason = auditlog.StatusFailure, merr.Code(err), err.Error()
+		return
+	}
+	var status *commonpb.Status
+	switch r := resp.(type) {
+	case *milvuspb.SearchResults:
+		status = r.GetStatus()
+		event.RowCount = int64(typeutil.GetSizeOfIDs(r.GetResults().GetIds()))
+	case *milvuspb.QueryResults:
+		status = r.GetStatus()
+		if len(r.GetFieldsData()) > 0 {
+			rowNum, _ := funcutil.GetNumRowOfFieldData(r.GetFieldsData()[0])
+			event.RowCount = int64(rowNum)
+		}
+	case *milvuspb.MutationResult:
+		status = r.GetStatus()
+		switch event.Method {
+		case "Insert":
+			event.RowCount = r.GetInsertCnt()
+		case "Upsert":
+			event.RowCount = r.GetUpsertCnt()
+		default:
+			event.RowCount = r.GetDeleteCnt()
+		}
+	}
+	if err := merr.Error(status); err != nil {
+		event.Status, event.ErrorCode, event.Reason = auditlog.StatusFailure, merr.Code(err), status.GetReason()
+		return
+	}
+	event.Status = auditlog.StatusSuccess
+}
@@ -0,0 +1,122 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package proxy
+
+import (
+	"context"
+	"testing"
+
+	"github.com/cockroachdb/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+
+	"github.com/milvus-io/milvus-proto/go-api/v2/milvuspb"
+	"github.com/milvus-io/milvus-proto/go-api/v2/schemapb"
+	"github.com/milvus-io/milvus/internal/proxy/auditlog"
+	"github.com/milvus-io/milvus/pkg/util"
+	"github.com/milvus-io/milvus/pkg/util/merr"
+	"github.com/milvus-io/milvus/pkg/util/paramtable"
+)
+
+func TestAuditLogInterceptor(t *testing.T) {
+	paramtable.Init()
+	ctx := GetContext(context.Background(), "foo:123456")
+
+	t.Run("not data-plane access", func(t *testing.T) {
+		assert.Nil(t, newAuditEvent(ctx, &milvuspb.CreateCollectionRequest{}, "/milvus.proto.milvus.MilvusService/CreateCollection"))
+	})
+
+	t.Run("query", func(t *testing.T) {
+		event := newAuditEvent(ctx, &milvuspb.QueryRequest{
+			CollectionName: "test",
+			PartitionNames: []string{"p1"},
+			Expr:           "id > 0",
+			OutputFields:   []string{"id"},
+		}, "/milvus.proto.milvus.MilvusService/Query")
+		require.NotNil(t, event)
+		assert.Equal(t, "Query", event.Method)
+		assert.Equal(t, "foo", event.User)
+		assert.Equal(t, util.DefaultDBName, event.Database)
+		assert.Equal(t, "test", event.Collection)
+		assert.Equal(t, []string{"p1"}, event.Partitions)
+		assert.Equal(t, "id > 0", event.Expr)
+
+		fillAuditEventResult(event, &milvuspb.QueryResults{
+			Status: merr.Success(),
+			FieldsData: []*schemapb.FieldData{{
+				Type: schemapb.DataType_Int64,
+				Field: &schemapb.FieldData_Scalars{Scalars: &schemapb.ScalarField{
+					Data: &schemapb.ScalarField_LongData{LongData: &schemapb.LongArray{Data: []int64{1, 2, 3}}},
+				}},
+			}},
+		}, nil)
+		assert.Equal(t, auditlog.StatusSuccess, event.Status)
+		assert.EqualValues(t, 3, event.RowCount)
+	})
+
+	t.Run("search", func(t *testing.T) {
+		event := newAuditEvent(ctx, &milvuspb.SearchRequest{DbName: "db", CollectionName: "test"}, "/milvus.proto.milvus.MilvusService/Search")
+		require.NotNil(t, event)
+		assert.Equal(t, "db", event.Database)
+
+		fillAuditEventResult(event, &milvuspb.SearchResults{
+			Status: merr.Success(),
+			Results: &schemapb.SearchResultData{Ids: &schemapb.IDs{
+				IdField: &schemapb.IDs_IntId{IntId: &schemapb.LongArray{Data: []int64{1, 2}}},
+			}},
+		}, nil)
+		assert.EqualValues(t, 2, event.RowCount)
+	})
+
+	t.Run("mutation", func(t *testing.T) {
+		event := newAuditEvent(ctx, &milvuspb.InsertRequest{CollectionName: "test", PartitionName: "p1"}, "/milvus.proto.milvus.MilvusService/Insert")
+		require.NotNil(t, event)
+		assert.Equal(t, []string{"p1"}, event.Partitions)
+		fillAuditEventResult(event, &milvuspb.MutationResult{Status: merr.Success(), InsertCnt: 10}, nil)
+		assert.EqualValues(t, 10, event.RowCount)
+
+		event = newAuditEvent(ctx, &milvuspb.DeleteRequest{CollectionName: "test", Expr: "id in [1]"}, "/milvus.proto.milvus.MilvusService/Delete")
+		require.NotNil(t, event)
+		assert.Empty(t, event.Partitions)
+		assert.Equal(t, "id in [1]", event.Expr)
+		fillAuditEventResult(event, &milvuspb.MutationResult{Status: merr.Success(), DeleteCnt: 1}, nil)
+		assert.EqualValues(t, 1, event.RowCount)
+	})
+
+	t.Run("failure", func(t *testing.T) {
+		event := newAuditEvent(ctx, &milvuspb.QueryRequest{CollectionName: "test"}, "/milvus.proto.milvus.MilvusService/Query")
+		fillAuditEventResult(event, &milvuspb.QueryResults{Status: merr.Status(merr.WrapErrCollectionNotFound("test"))}, nil)
+		assert.Equal(t, auditlog.StatusFailure, event.Status)
+		assert.Equal(t, merr.Code(merr.ErrCollectionNotFound), event.ErrorCode)
+
+		event = newAuditEvent(ctx, &milvuspb.QueryRequest{CollectionName: "test"}, "/milvus.proto.milvus.MilvusService/Query")
+		fillAuditEventResult(event, nil, errors.New("mock"))
+		assert.Equal(t, auditlog.StatusFailure, event.Status)
+		assert.Equal(t, "mock", event.Reason)
+	})
+
+	t.Run("disabled", func(t *testing.T) {
+		interceptor := AuditLogInterceptor()
+		resp, err := interceptor(ctx, &milvuspb.QueryRequest{}, &grpc.UnaryServerInfo{FullMethod: "/milvus.proto.milvus.MilvusService/Query"},
+			func(ctx context.Context, req interface{}) (interface{}, error) {
+				return &milvuspb.QueryResults{Status: merr.Success()}, nil
+			})
+		assert.NoError(t, err)
+		assert.True(t, merr.Ok(resp.(*milvuspb.QueryResults).GetStatus()))
+	})
+}
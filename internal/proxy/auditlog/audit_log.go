@@ -0,0 +1,187 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package auditlog
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"math/rand"
+	"regexp"
+	"sync"
+
+	"go.uber.org/zap"
+
+	"github.com/milvus-io/milvus/pkg/log"
+	"github.com/milvus-io/milvus/pkg/util/paramtable"
+)
+
+const (
+	StatusSuccess = "success"
+	StatusFailure = "failure"
+
+	redactedMask = "***"
+)
+
+// Event is an audit record of a data-plane access.
+type Event struct {
+	Time         int64    `json:"time"`
+	TraceID      string   `json:"trace_id,omitempty"`
+	User         string   `json:"user"`
+	ClientAddr   string   `json:"client_addr,omitempty"`
+	Method       string   `json:"method"`
+	Database     string   `json:"database,omitempty"`
+	Collection   string   `json:"collection"`
+	Partitions   []string `json:"partitions,omitempty"`
+	Expr         string   `json:"expr,omitempty"`
+	ExprDigest   string   `json:"expr_digest,omitempty"`
+	OutputFields []string `json:"output_fields,omitempty"`
+	RowCount     int64    `json:"row_count"`
+	Status       string   `json:"status"`
+	ErrorCode    int32    `json:"error_code,omitempty"`
+	Reason       string   `json:"reason,omitempty"`
+	CostMs       int64    `json:"cost_ms"`
+}
+
+// Sink is where the audit events are written to.
+type Sink interface {
+	Write(data []byte) error
+	Close()
+}
+
+// Logger writes the audit events to the sink asynchronously,
+// the events are dropped if the sink can't keep up with the accesses.
+type Logger struct {
+	cfg  *paramtable.AuditLogConfig
+	sink Sink
+
+	eventCh   chan *Event
+	closeOnce sync.Once
+	wg        sync.WaitGroup
+}
+
+// NewLogger creates an audit logger writing to the sink.
+func NewLogger(cfg *paramtable.AuditLogConfig, sink Sink) *Logger {
+	bufferSize := cfg.BufferSize.GetAsInt()
+	if bufferSize <= 0 {
+		bufferSize = 1
+	}
+	l := &Logger{
+		cfg:     cfg,
+		sink:    sink,
+		eventCh: make(chan *Event, bufferSize),
+	}
+	l.wg.Add(1)
+	go l.work()
+	return l
+}
+
+func (l *Logger) work() {
+	defer l.wg.Done()
+	for event := range l.eventCh {
+		data, err := json.Marshal(event)
+		if err != nil {
+			log.Warn("failed to marshal audit event", zap.String("method", event.Method), zap.Error(err))
+			continue
+		}
+		if err := l.sink.Write(data); err != nil {
+			log.Warn("failed to write audit event", zap.String("method", event.Method), zap.Error(err))
+		}
+	}
+}
+
+// Log samples, redacts and queues the event, the failed accesses are never sampled out.
+func (l *Logger) Log(event *Event) {
+	if event.Status == StatusSuccess && !sampled(l.cfg.SampleRate.GetAsFloat()) {
+		return
+	}
+	redact(event, l.cfg.RedactFields.GetAsStrings(), l.cfg.IncludeExpr.GetAsBool())
+	select {
+	case l.eventCh <- event:
+	default:
+		log.RatedWarn(10, "audit log buffer is full, drop the event",
+			zap.String("method", event.Method), zap.String("user", event.User))
+	}
+}
+
+// Close flushes the queued events and closes the sink.
+func (l *Logger) Close() {
+	l.closeOnce.Do(func() {
+		close(l.eventCh)
+		l.wg.Wait()
+		l.sink.Close()
+	})
+}
+
+func sampled(rate float64) bool {
+	if rate >= 1 {
+		return true
+	}
+	if rate <= 0 {
+		return false
+	}
+	return rand.Float64() < rate
+}
+
+// ExprDigest returns the digest of the expression, which identifies the expression without revealing it.
+func ExprDigest(expr string) string {
+	if expr == "" {
+		return ""
+	}
+	sum := sha256.Sum256([]byte(expr))
+	return hex.EncodeToString(sum[:8])
+}
+
+// redact masks the redacted output fields, and only keeps the digest of the expression
+// unless it's allowed to be logged and refers no redacted field.
+func redact(event *Event, redactFields []string, includeExpr bool) {
+	event.ExprDigest = ExprDigest(event.Expr)
+	if len(redactFields) > 0 {
+		outputFields := make([]string, 0, len(event.OutputFields))
+		for _, field := range event.OutputFields {
+			if containsField(redactFields, field) {
+				field = redactedMask
+			}
+			outputFields = append(outputFields, field)
+		}
+		event.OutputFields = outputFields
+	}
+	if !includeExpr || referRedactedField(event.Expr, redactFields) {
+		event.Expr = ""
+	}
+}
+
+func containsField(fields []string, field string) bool {
+	for _, f := range fields {
+		if f == field {
+			return true
+		}
+	}
+	return false
+}
+
+func referRedactedField(expr string, redactFields []string) bool {
+	for _, field := range redactFields {
+		if field == "" {
+			continue
+		}
+		if regexp.MustCompile(`\b` + regexp.QuoteMeta(field) + `\b`).MatchString(expr) {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,127 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package auditlog
+
+import (
+	"encoding/json"
+	"os"
+	"path"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/milvus-io/milvus/pkg/util/paramtable"
+)
+
+type memorySink struct {
+	mu     sync.Mutex
+	events []*Event
+	closed bool
+}
+
+func (s *memorySink) Write(data []byte) error {
+	event := &Event{}
+	if err := json.Unmarshal(data, event); err != nil {
+		return err
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.events = append(s.events, event)
+	return nil
+}
+
+func (s *memorySink) Close() {
+	s.closed = true
+}
+
+func TestLogger(t *testing.T) {
+	paramtable.Init()
+	params := paramtable.Get()
+	cfg := &params.ProxyCfg.AuditLog
+
+	params.Save(cfg.RedactFields.Key, "ssn,phone")
+	defer params.Reset(cfg.RedactFields.Key)
+	params.Save(cfg.IncludeExpr.Key, "true")
+	defer params.Reset(cfg.IncludeExpr.Key)
+
+	sink := &memorySink{}
+	logger := NewLogger(cfg, sink)
+	logger.Log(&Event{Method: "Query", Expr: "age > 10", OutputFields: []string{"age", "ssn"}, Status: StatusSuccess})
+	logger.Log(&Event{Method: "Query", Expr: "ssn == \"123\"", Status: StatusSuccess})
+	logger.Log(&Event{Method: "Delete", Expr: "ssnx > 1", Status: StatusSuccess})
+
+	// the successful accesses are sampled out, but the failed ones are always logged
+	params.Save(cfg.SampleRate.Key, "0")
+	defer params.Reset(cfg.SampleRate.Key)
+	logger.Log(&Event{Method: "Search", Status: StatusSuccess})
+	logger.Log(&Event{Method: "Insert", Status: StatusFailure, RowCount: 10})
+	logger.Close()
+
+	assert.True(t, sink.closed)
+	require.Len(t, sink.events, 4)
+	assert.Equal(t, "age > 10", sink.events[0].Expr)
+	assert.Equal(t, ExprDigest("age > 10"), sink.events[0].ExprDigest)
+	assert.Equal(t, []string{"age", redactedMask}, sink.events[0].OutputFields)
+	assert.Empty(t, sink.events[1].Expr)
+	assert.Equal(t, ExprDigest("ssn == \"123\""), sink.events[1].ExprDigest)
+	assert.Equal(t, "ssnx > 1", sink.events[2].Expr)
+	assert.Equal(t, "Insert", sink.events[3].Method)
+	assert.EqualValues(t, 10, sink.events[3].RowCount)
+}
+
+func TestRedact(t *testing.T) {
+	event := &Event{Expr: "id in [1, 2]"}
+	redact(event, nil, false)
+	assert.Empty(t, event.Expr)
+	assert.Len(t, event.ExprDigest, 16)
+
+	event = &Event{}
+	redact(event, nil, true)
+	assert.Empty(t, event.ExprDigest)
+}
+
+func TestFileSink(t *testing.T) {
+	paramtable.Init()
+	params := paramtable.Get()
+	cfg := &params.ProxyCfg.AuditLog
+	dir := t.TempDir()
+	params.Save(cfg.LocalPath.Key, dir)
+	defer params.Reset(cfg.LocalPath.Key)
+
+	sink, err := NewSink(cfg, &params.KafkaCfg)
+	require.NoError(t, err)
+	logger := NewLogger(cfg, sink)
+	logger.Log(&Event{Method: "Search", Collection: "foo", Status: StatusSuccess})
+	logger.Log(&Event{Method: "Query", Collection: "bar", Status: StatusSuccess})
+	logger.Close()
+
+	data, err := os.ReadFile(path.Join(dir, cfg.Filename.GetValue()))
+	require.NoError(t, err)
+	lines := strings.Split(strings.TrimSpace(string(data)), "\n")
+	require.Len(t, lines, 2)
+	event := &Event{}
+	require.NoError(t, json.Unmarshal([]byte(lines[1]), event))
+	assert.Equal(t, "bar", event.Collection)
+
+	params.Save(cfg.Sink.Key, "unknown")
+	defer params.Reset(cfg.Sink.Key)
+	_, err = NewSink(cfg, &params.KafkaCfg)
+	assert.Error(t, err)
+}
@@ -0,0 +1,144 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package auditlog
+
+import (
+	"context"
+	"path"
+	"sync"
+	"sync/atomic"
+
+	"github.com/cockroachdb/errors"
+	"go.uber.org/zap"
+	"gopkg.in/natefinch/lumberjack.v2"
+
+	"github.com/milvus-io/milvus/pkg/log"
+	"github.com/milvus-io/milvus/pkg/mq/msgstream/mqwrapper"
+	"github.com/milvus-io/milvus/pkg/mq/msgstream/mqwrapper/kafka"
+	"github.com/milvus-io/milvus/pkg/util/paramtable"
+)
+
+const (
+	SinkFile  = "file"
+	SinkKafka = "kafka"
+)
+
+var (
+	_globalL atomic.Value
+	once     sync.Once
+)
+
+// SetupAuditLog initializes the global audit logger if the audit log is enabled.
+func SetupAuditLog(cfg *paramtable.AuditLogConfig, kafkaCfg *paramtable.KafkaConfig) {
+	once.Do(func() {
+		if !cfg.Enable.GetAsBool() {
+			return
+		}
+		sink, err := NewSink(cfg, kafkaCfg)
+		if err != nil {
+			log.Fatal("initialize audit logger error", zap.Error(err))
+		}
+		_globalL.Store(NewLogger(cfg, sink))
+		log.Info("audit log start successful", zap.String("sink", cfg.Sink.GetValue()))
+	})
+}
+
+// L returns the global audit logger, nil if the audit log is disabled.
+func L() *Logger {
+	l, _ := _globalL.Load().(*Logger)
+	return l
+}
+
+// Close flushes and closes the global audit logger.
+func Close() {
+	if l := L(); l != nil {
+		l.Close()
+	}
+}
+
+// NewSink creates the sink configured.
+func NewSink(cfg *paramtable.AuditLogConfig, kafkaCfg *paramtable.KafkaConfig) (Sink, error) {
+	switch cfg.Sink.GetValue() {
+	case SinkFile:
+		return newFileSink(cfg), nil
+	case SinkKafka:
+		return newKafkaSink(cfg, kafkaCfg)
+	default:
+		return nil, errors.Newf("unknown audit log sink %s", cfg.Sink.GetValue())
+	}
+}
+
+// fileSink writes the audit events to the local file line by line, the file is rotated by size.
+type fileSink struct {
+	writer *lumberjack.Logger
+}
+
+func newFileSink(cfg *paramtable.AuditLogConfig) *fileSink {
+	return &fileSink{
+		writer: &lumberjack.Logger{
+			Filename:   path.Join(cfg.LocalPath.GetValue(), cfg.Filename.GetValue()),
+			MaxSize:    cfg.MaxSize.GetAsInt(),
+			MaxBackups: cfg.MaxBackups.GetAsInt(),
+		},
+	}
+}
+
+func (s *fileSink) Write(data []byte) error {
+	_, err := s.writer.Write(append(data, '\n'))
+	return err
+}
+
+func (s *fileSink) Close() {
+	if err := s.writer.Close(); err != nil {
+		log.Warn("failed to close audit log file", zap.Error(err))
+	}
+}
+
+// kafkaSink produces the audit events to the kafka topic, one message per event.
+type kafkaSink struct {
+	client   mqwrapper.Client
+	producer mqwrapper.Producer
+}
+
+func newKafkaSink(cfg *paramtable.AuditLogConfig, kafkaCfg *paramtable.KafkaConfig) (*kafkaSink, error) {
+	if kafkaCfg.Address.GetValue() == "" {
+		return nil, errors.New("kafka address is not configured for audit log")
+	}
+	client, err := kafka.NewKafkaClientInstanceWithConfig(context.Background(), kafkaCfg)
+	if err != nil {
+		return nil, err
+	}
+	producer, err := client.CreateProducer(mqwrapper.ProducerOptions{Topic: cfg.KafkaTopic.GetValue()})
+	if err != nil {
+		client.Close()
+		return nil, err
+	}
+	return &kafkaSink{
+		client:   client,
+		producer: producer,
+	}, nil
+}
+
+func (s *kafkaSink) Write(data []byte) error {
+	_, err := s.producer.Send(context.Background(), &mqwrapper.ProducerMessage{Payload: data})
+	return err
+}
+
+func (s *kafkaSink) Close() {
+	s.producer.Close()
+	s.client.Close()
+}
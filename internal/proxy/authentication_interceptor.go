@@ -6,6 +6,7 @@ import (
 	"strings"
 
 	"go.uber.org/zap"
+	"google.golang.org/grpc"
 	"google.golang.org/grpc/metadata"
 
 	"github.com/milvus-io/milvus/pkg/log"
@@ -40,6 +41,11 @@ func validSourceID(ctx context.Context, authorization []string) bool {
 	return sourceID == util.MemberCredID
 }
 
+func isUpdateCredentialMethod(ctx context.Context) bool {
+	method, ok := grpc.Method(ctx)
+	return ok && strings.HasSuffix(method, "/UpdateCredential")
+}
+
 // AuthenticationInterceptor verify based on kv pair <"authorization": "token"> in header
 func AuthenticationInterceptor(ctx context.Context) (context.Context, error) {
 	// The keys within metadata.MD are normalized to lowercase.
@@ -56,6 +62,14 @@ func AuthenticationInterceptor(ctx context.Context) (context.Context, error) {
 	// 	2. if rpc call from sdk
 	if Params.CommonCfg.AuthorizationEnabled.GetAsBool() {
 		if !validSourceID(ctx, md[strings.ToLower(util.HeaderSourceID)]) {
+			// client certificate identity authentication
+			if user, ok := tlsIdentityVerifyFromContext(ctx); ok {
+				metrics.UserRPCCounter.WithLabelValues(user).Inc()
+				userToken := fmt.Sprintf("%s%s%s", user, util.CredentialSeperator, "___")
+				md[strings.ToLower(util.HeaderAuthorize)] = []string{crypto.Base64Encode(userToken)}
+				return metadata.NewIncomingContext(ctx, md), nil
+			}
+
 			authStrArr := md[strings.ToLower(util.HeaderAuthorize)]
 
 			if len(authStrArr) < 1 {
@@ -73,7 +87,8 @@ func AuthenticationInterceptor(ctx context.Context) (context.Context, error) {
 			}
 
 			if !strings.Contains(rawToken, util.CredentialSeperator) {
-				user, err := VerifyAPIKey(rawToken)
+				method, _ := grpc.Method(ctx)
+				user, err := VerifyAPIKey(ctx, rawToken, apiKeyScopeOfMethod(method))
 				if err != nil {
 					log.Warn("fail to verify apikey", zap.Error(err))
 					return nil, err
@@ -89,6 +104,13 @@ func AuthenticationInterceptor(ctx context.Context) (context.Context, error) {
 					msg := fmt.Sprintf("username: %s, password: %s", username, password)
 					return nil, merr.WrapErrParameterInvalid("vaild username and password", msg, "auth check failure, please check username and password are correct")
 				}
+				// the user with expired password could only update the password
+				if !isUpdateCredentialMethod(ctx) {
+					if err := checkPasswordExpired(ctx, username, globalMetaCache); err != nil {
+						log.Warn("password check failed", zap.String("username", username), zap.Error(err))
+						return nil, err
+					}
+				}
 				metrics.UserRPCCounter.WithLabelValues(username).Inc()
 			}
 		}
@@ -13,6 +13,7 @@ import (
 type clientInfo struct {
 	*commonpb.ClientInfo
 	identifier     int64
+	user           string
 	lastActiveTime time.Time
 }
 
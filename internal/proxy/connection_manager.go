@@ -2,20 +2,29 @@ package proxy
 
 import (
 	"context"
+	"fmt"
+	"sort"
 	"strconv"
 	"sync"
 	"time"
 
 	"github.com/golang/protobuf/proto"
+	"go.uber.org/zap"
 
 	"github.com/milvus-io/milvus-proto/go-api/v2/commonpb"
+	"github.com/milvus-io/milvus/internal/proto/proxypb"
 	"github.com/milvus-io/milvus/pkg/log"
+	"github.com/milvus-io/milvus/pkg/util/merr"
+	"github.com/milvus-io/milvus/pkg/util/paramtable"
 )
 
 const (
 	// we shouldn't check this too frequently.
 	defaultConnCheckDuration  = 2 * time.Minute
 	defaultTTLForInactiveConn = 24 * time.Hour
+	// the least recently active session of the user reaching proxy.session.maxPerUser is evicted
+	// if it has been idle for it, since the clients never close their sessions.
+	defaultMinIdleToEvict = time.Minute
 )
 
 type connectionManager struct {
@@ -27,11 +36,14 @@ type connectionManager struct {
 	closeSignal chan struct{}
 	wg          sync.WaitGroup
 
-	buffer   chan int64
-	duration time.Duration
-	ttl      time.Duration
+	buffer         chan int64
+	duration       time.Duration
+	ttl            time.Duration
+	minIdleToEvict time.Duration
 
 	clientInfos map[int64]clientInfo
+	// the killed sessions, whose requests are rejected until they are idle for ttl
+	killed map[int64]time.Time
 }
 
 type connectionManagerOption func(s *connectionManager)
@@ -87,18 +99,76 @@ func (s *connectionManager) checkLoop() {
 	}
 }
 
-func (s *connectionManager) register(ctx context.Context, identifier int64, info *commonpb.ClientInfo) {
+// register records the session established by Connect. If the user has reached proxy.session.maxPerUser sessions,
+// the least recently active one is evicted if it has been idle for minIdleToEvict, otherwise the register fails.
+// The user of the session is the authenticated one, or the one reported by the client if the authorization is disabled.
+func (s *connectionManager) register(ctx context.Context, identifier int64, info *commonpb.ClientInfo) error {
+	user, err := GetCurUserFromContext(ctx)
+	if err != nil {
+		user = info.GetUser()
+	}
 	cli := clientInfo{
 		ClientInfo:     info,
 		identifier:     identifier,
+		user:           user,
 		lastActiveTime: time.Now(),
 	}
 
 	s.mu.Lock()
 	defer s.mu.Unlock()
 
+	if maxSessions := paramtable.Get().ProxyCfg.SessionMaxPerUser.GetAsInt(); maxSessions > 0 {
+		sessions := 0
+		var oldest *clientInfo
+		for id, other := range s.clientInfos {
+			if id != identifier && other.user == user {
+				sessions++
+				if oldest == nil || other.lastActiveTime.Before(oldest.lastActiveTime) {
+					other := other
+					oldest = &other
+				}
+			}
+		}
+		if sessions >= maxSessions {
+			if time.Since(oldest.lastActiveTime) < s.minIdleToEvict {
+				log.Ctx(ctx).Warn("too many sessions of the user", zap.String("user", user), zap.Int("sessions", sessions))
+				return merr.WrapErrServiceRequestLimitExceeded(int32(maxSessions), fmt.Sprintf("too many sessions of user %s", user))
+			}
+			log.Ctx(ctx).Info("evict the least recently active session of the user", zap.String("user", user),
+				zap.Int64("evicted", oldest.identifier), zap.Time("lastActiveTime", oldest.lastActiveTime))
+			delete(s.clientInfos, oldest.identifier)
+		}
+	}
+
 	s.clientInfos[identifier] = cli
 	cli.ctxLogRegister(ctx)
+	return nil
+}
+
+// kill terminates the session, returns false if the session not found. The following requests carrying the identifier
+// of session are rejected as unauthenticated, so the client has to Connect again, which authenticates the user again and
+// establishes a new session. Killing doesn't keep the user out, revoke the credential or api key of the user for that.
+// The requests without the identifier, i.e. the ones of the clients not calling Connect, don't belong to any session.
+func (s *connectionManager) kill(identifier int64) bool {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	cli, ok := s.clientInfos[identifier]
+	if !ok {
+		return false
+	}
+	delete(s.clientInfos, identifier)
+	s.killed[identifier] = time.Now()
+	log.Info("client session killed", cli.getLogger()...)
+	return true
+}
+
+func (s *connectionManager) isKilled(identifier int64) bool {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	_, ok := s.killed[identifier]
+	return ok
 }
 
 func (s *connectionManager) keepActive(identifier int64) {
@@ -115,6 +185,9 @@ func (s *connectionManager) update(identifier int64) {
 		cli.lastActiveTime = time.Now()
 		s.clientInfos[identifier] = cli
 	}
+	if _, ok := s.killed[identifier]; ok {
+		s.killed[identifier] = time.Now()
+	}
 }
 
 func (s *connectionManager) removeLongInactiveClients() {
@@ -127,6 +200,42 @@ func (s *connectionManager) removeLongInactiveClients() {
 			delete(s.clientInfos, candidate)
 		}
 	}
+	for candidate, lastActiveTime := range s.killed {
+		if time.Since(lastActiveTime) > s.ttl {
+			delete(s.killed, candidate)
+		}
+	}
+}
+
+// listSessions lists the sessions of the user, all the sessions if user is empty, the most idle first.
+func (s *connectionManager) listSessions(user string) []*proxypb.SessionInfo {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	clients := make([]clientInfo, 0, len(s.clientInfos))
+	for _, cli := range s.clientInfos {
+		if user == "" || cli.user == user {
+			clients = append(clients, cli)
+		}
+	}
+	sort.Slice(clients, func(i, j int) bool {
+		return clients[i].lastActiveTime.Before(clients[j].lastActiveTime)
+	})
+
+	now := time.Now()
+	sessions := make([]*proxypb.SessionInfo, 0, len(clients))
+	for _, cli := range clients {
+		sessions = append(sessions, &proxypb.SessionInfo{
+			Identifier:     cli.identifier,
+			User:           cli.user,
+			SdkType:        cli.GetSdkType(),
+			SdkVersion:     cli.GetSdkVersion(),
+			Host:           cli.GetHost(),
+			LastActiveTime: cli.lastActiveTime.Unix(),
+			IdleSeconds:    int64(now.Sub(cli.lastActiveTime).Seconds()),
+		})
+	}
+	return sessions
 }
 
 func (s *connectionManager) list() []*commonpb.ClientInfo {
@@ -153,11 +262,13 @@ func (s *connectionManager) list() []*commonpb.ClientInfo {
 
 func newConnectionManager(opts ...connectionManagerOption) *connectionManager {
 	s := &connectionManager{
-		closeSignal: make(chan struct{}, 1),
-		buffer:      make(chan int64, 64),
-		duration:    defaultConnCheckDuration,
-		ttl:         defaultTTLForInactiveConn,
-		clientInfos: make(map[int64]clientInfo),
+		closeSignal:    make(chan struct{}, 1),
+		buffer:         make(chan int64, 64),
+		duration:       defaultConnCheckDuration,
+		ttl:            defaultTTLForInactiveConn,
+		minIdleToEvict: defaultMinIdleToEvict,
+		clientInfos:    make(map[int64]clientInfo),
+		killed:         make(map[int64]time.Time),
 	}
 	s.apply(opts...)
 	s.init()
@@ -173,7 +284,7 @@ func GetConnectionManager() *connectionManager {
 	getConnectionManagerInstanceOnce.Do(func() {
 		connectionManagerInstance = newConnectionManager(
 			withDuration(defaultConnCheckDuration),
-			withTTL(defaultTTLForInactiveConn))
+			withTTL(paramtable.Get().ProxyCfg.SessionIdleTimeout.GetAsDuration(time.Second)))
 	})
 	return connectionManagerInstance
 }
@@ -5,9 +5,12 @@ import (
 	"testing"
 	"time"
 
+	"github.com/cockroachdb/errors"
 	"github.com/stretchr/testify/assert"
 
 	"github.com/milvus-io/milvus-proto/go-api/v2/commonpb"
+	"github.com/milvus-io/milvus/pkg/util/merr"
+	"github.com/milvus-io/milvus/pkg/util/paramtable"
 )
 
 func Test_withDuration(t *testing.T) {
@@ -42,16 +45,16 @@ func TestConnectionManager(t *testing.T) {
 		withDuration(time.Millisecond*5),
 		withTTL(time.Millisecond*100))
 
-	s.register(context.TODO(), 1, &commonpb.ClientInfo{
+	assert.NoError(t, s.register(context.TODO(), 1, &commonpb.ClientInfo{
 		Reserved: map[string]string{"for_test": "for_test"},
-	})
+	}))
 	assert.Equal(t, 1, len(s.list()))
 
 	// register duplicate.
-	s.register(context.TODO(), 1, &commonpb.ClientInfo{})
+	assert.NoError(t, s.register(context.TODO(), 1, &commonpb.ClientInfo{}))
 	assert.Equal(t, 1, len(s.list()))
 
-	s.register(context.TODO(), 2, &commonpb.ClientInfo{})
+	assert.NoError(t, s.register(context.TODO(), 2, &commonpb.ClientInfo{}))
 	assert.Equal(t, 2, len(s.list()))
 
 	s.keepActive(1)
@@ -67,3 +70,68 @@ func TestConnectionManager(t *testing.T) {
 
 	time.Sleep(time.Millisecond * 5)
 }
+
+func TestConnectionManager_Sessions(t *testing.T) {
+	paramtable.Get().Save(paramtable.Get().ProxyCfg.SessionMaxPerUser.Key, "2")
+	defer paramtable.Get().Reset(paramtable.Get().ProxyCfg.SessionMaxPerUser.Key)
+
+	s := newConnectionManager(
+		withDuration(time.Hour),
+		withTTL(time.Millisecond*100))
+	defer s.stop()
+
+	ctx := GetContext(context.TODO(), "foo:123456")
+	assert.NoError(t, s.register(ctx, 1, &commonpb.ClientInfo{SdkType: "python"}))
+	assert.NoError(t, s.register(ctx, 2, &commonpb.ClientInfo{SdkType: "python"}))
+	// the client reported user is ignored if authenticated
+	err := s.register(ctx, 3, &commonpb.ClientInfo{User: "bar"})
+	assert.True(t, errors.Is(err, merr.ErrServiceRequestLimitExceeded))
+	// register again
+	assert.NoError(t, s.register(ctx, 2, &commonpb.ClientInfo{SdkType: "python"}))
+	// not authenticated
+	assert.NoError(t, s.register(context.TODO(), 3, &commonpb.ClientInfo{User: "bar"}))
+
+	sessions := s.listSessions("")
+	assert.Len(t, sessions, 3)
+	assert.Len(t, s.listSessions("bar"), 1)
+	sessions = s.listSessions("foo")
+	assert.Len(t, sessions, 2)
+	assert.Equal(t, "foo", sessions[0].User)
+	assert.Equal(t, "python", sessions[0].SdkType)
+
+	assert.False(t, s.kill(4))
+	assert.True(t, s.kill(1))
+	assert.True(t, s.isKilled(1))
+	assert.False(t, s.isKilled(2))
+	assert.Len(t, s.listSessions("foo"), 1)
+	assert.NoError(t, s.register(ctx, 4, &commonpb.ClientInfo{}))
+
+	// the killed sessions are forgotten once idle for ttl
+	time.Sleep(time.Millisecond * 150)
+	s.removeLongInactiveClients()
+	assert.False(t, s.isKilled(1))
+	assert.Empty(t, s.listSessions(""))
+}
+
+func TestConnectionManager_EvictIdleSession(t *testing.T) {
+	paramtable.Get().Save(paramtable.Get().ProxyCfg.SessionMaxPerUser.Key, "2")
+	defer paramtable.Get().Reset(paramtable.Get().ProxyCfg.SessionMaxPerUser.Key)
+
+	s := newConnectionManager(withDuration(time.Hour))
+	defer s.stop()
+	s.minIdleToEvict = time.Millisecond * 100
+
+	ctx := GetContext(context.TODO(), "foo:123456")
+	assert.NoError(t, s.register(ctx, 1, &commonpb.ClientInfo{}))
+	assert.NoError(t, s.register(ctx, 2, &commonpb.ClientInfo{}))
+	err := s.register(ctx, 3, &commonpb.ClientInfo{})
+	assert.True(t, errors.Is(err, merr.ErrServiceRequestLimitExceeded))
+
+	time.Sleep(time.Millisecond * 150)
+	s.update(2)
+	// session 1 is the least recently active and idle for long enough
+	assert.NoError(t, s.register(ctx, 3, &commonpb.ClientInfo{}))
+	sessions := s.listSessions("foo")
+	assert.Len(t, sessions, 2)
+	assert.ElementsMatch(t, []int64{2, 3}, []int64{sessions[0].Identifier, sessions[1].Identifier})
+}
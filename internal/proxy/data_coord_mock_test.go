@@ -328,3 +328,11 @@ func NewDataCoordMock() *DataCoordMock {
 	dc.updateState(commonpb.StateCode_Healthy)
 	return dc
 }
+
+func (coord *DataCoordMock) CancelCompactionPlan(ctx context.Context, req *datapb.CancelCompactionPlanRequest, opts ...grpc.CallOption) (*commonpb.Status, error) {
+	return &commonpb.Status{}, nil
+}
+
+func (coord *DataCoordMock) OperateStorageMigration(ctx context.Context, req *datapb.OperateStorageMigrationRequest, opts ...grpc.CallOption) (*commonpb.Status, error) {
+	return &commonpb.Status{}, nil
+}
@@ -6,16 +6,26 @@ import (
 	"google.golang.org/grpc"
 
 	"github.com/milvus-io/milvus-proto/go-api/v2/milvuspb"
+	"github.com/milvus-io/milvus/internal/util/grpcclient"
 )
 
-// DatabaseInterceptor fill dbname into request based on kv pair <"dbname": "xx"> in header
+// DatabaseInterceptor fill dbname into request based on kv pair <"dbname": "xx"> in header,
+// and the database is also the pool key of the calls to coordinators made for the request.
 func DatabaseInterceptor() grpc.UnaryServerInterceptor {
 	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (resp interface{}, err error) {
 		filledCtx, filledReq := fillDatabase(ctx, req)
+		filledCtx = grpcclient.WithPoolKey(filledCtx, getRequestDatabase(filledCtx, filledReq))
 		return handler(filledCtx, filledReq)
 	}
 }
 
+func getRequestDatabase(ctx context.Context, req interface{}) string {
+	if r, ok := req.(interface{ GetDbName() string }); ok && r.GetDbName() != "" {
+		return r.GetDbName()
+	}
+	return GetCurDBNameFromContextOrDefault(ctx)
+}
+
 func fillDatabase(ctx context.Context, req interface{}) (context.Context, interface{}) {
 	switch r := req.(type) {
 	case *milvuspb.CreateCollectionRequest:
@@ -10,6 +10,7 @@ import (
 	"google.golang.org/grpc/metadata"
 
 	"github.com/milvus-io/milvus-proto/go-api/v2/milvuspb"
+	"github.com/milvus-io/milvus/internal/util/grpcclient"
 	"github.com/milvus-io/milvus/pkg/util"
 )
 
@@ -133,4 +134,24 @@ func TestDatabaseInterceptor(t *testing.T) {
 			}
 		}
 	})
+	t.Run("pool key", func(t *testing.T) {
+		var key string
+		keyHandler := func(ctx context.Context, req interface{}) (interface{}, error) {
+			key = grpcclient.GetPoolKey(ctx)
+			return "", nil
+		}
+
+		ctx := metadata.NewIncomingContext(context.Background(), metadata.Pairs(util.HeaderDBName, "db1"))
+		_, err := interceptor(ctx, &milvuspb.CreateCollectionRequest{}, &grpc.UnaryServerInfo{}, keyHandler)
+		assert.NoError(t, err)
+		assert.Equal(t, "db1", key)
+
+		_, err = interceptor(ctx, &milvuspb.CreateCollectionRequest{DbName: "db2"}, &grpc.UnaryServerInfo{}, keyHandler)
+		assert.NoError(t, err)
+		assert.Equal(t, "db2", key)
+
+		_, err = interceptor(context.Background(), &milvuspb.GetMetricsRequest{}, &grpc.UnaryServerInfo{}, keyHandler)
+		assert.NoError(t, err)
+		assert.Equal(t, util.DefaultDBName, key)
+	})
 }
@@ -0,0 +1,239 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package proxy
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/trace"
+	"go.uber.org/zap"
+
+	"github.com/milvus-io/milvus/pkg/log"
+	"github.com/milvus-io/milvus/pkg/metrics"
+	"github.com/milvus-io/milvus/pkg/util/hardware"
+	"github.com/milvus-io/milvus/pkg/util/paramtable"
+	"github.com/milvus-io/milvus/pkg/util/typeutil"
+)
+
+// stages of the dql pipeline, in the order of execution
+const (
+	dqlStageValidation  = "validation"
+	dqlStagePlanning    = "planning"
+	dqlStageShardFanout = "shard_fanout"
+	dqlStageReduce      = "reduce"
+	dqlStageRequery     = "requery"
+)
+
+var dqlStages = []string{dqlStageValidation, dqlStagePlanning, dqlStageShardFanout, dqlStageReduce, dqlStageRequery}
+
+type dqlStageFunc func(ctx context.Context) error
+
+// pipelinedTask is the dql task which splits its execution into the stages of dql pipeline.
+type pipelinedTask interface {
+	task
+	// stageFuncs returns the function of each stage in dqlStages, nil to skip the stage.
+	stageFuncs() []dqlStageFunc
+}
+
+// stageFuncsOf returns the stage functions of task, the tasks not pipelined are executed
+// by the validation, shard fanout and reduce stages.
+func stageFuncsOf(t task) []dqlStageFunc {
+	if pt, ok := t.(pipelinedTask); ok {
+		return pt.stageFuncs()
+	}
+	return []dqlStageFunc{t.PreExecute, nil, t.Execute, t.PostExecute, nil}
+}
+
+type dqlPipelineItem struct {
+	t     task
+	ctx   context.Context
+	span  trace.Span
+	funcs []dqlStageFunc
+
+	enqueueTime time.Time
+}
+
+// dqlStage executes one stage of dql tasks with a bounded worker pool,
+// the tasks wait in the bounded queue of stage when all workers are busy.
+type dqlStage struct {
+	name    string
+	index   int
+	workers int
+	queue   chan *dqlPipelineItem
+}
+
+// dqlPipeline executes the dql tasks in stages, each stage has its own workers and queue,
+// so a slow stage only blocks the stages before it when its queue is full.
+type dqlPipeline struct {
+	queue  taskQueue
+	stages []*dqlStage
+
+	ctx    context.Context
+	cancel context.CancelFunc
+	wg     sync.WaitGroup
+}
+
+func newDqlPipeline(ctx context.Context, queue taskQueue) *dqlPipeline {
+	params := paramtable.Get()
+	computeWorkers := params.ProxyCfg.DQLPipelineComputeWorkers.GetAsInt()
+	if computeWorkers <= 0 {
+		computeWorkers = hardware.GetCPUNum() * 2
+	}
+	ioWorkers := params.ProxyCfg.DQLPipelineIOWorkers.GetAsInt()
+	if ioWorkers <= 0 {
+		ioWorkers = 1
+	}
+	queueSize := params.ProxyCfg.DQLPipelineQueueSize.GetAsInt()
+	if queueSize <= 0 {
+		queueSize = 1
+	}
+
+	ctx, cancel := context.WithCancel(ctx)
+	p := &dqlPipeline{
+		queue:  queue,
+		ctx:    ctx,
+		cancel: cancel,
+	}
+	for i, name := range dqlStages {
+		workers := computeWorkers
+		if name == dqlStageShardFanout || name == dqlStageRequery {
+			workers = ioWorkers
+		}
+		p.stages = append(p.stages, &dqlStage{
+			name:    name,
+			index:   i,
+			workers: workers,
+			queue:   make(chan *dqlPipelineItem, queueSize),
+		})
+	}
+	return p
+}
+
+func (p *dqlPipeline) start() {
+	for _, stage := range p.stages {
+		for i := 0; i < stage.workers; i++ {
+			p.wg.Add(1)
+			go p.work(stage)
+		}
+	}
+}
+
+func (p *dqlPipeline) close() {
+	p.cancel()
+	p.wg.Wait()
+}
+
+// submit submits the task to the first stage, blocks if the queue of first stage is full.
+func (p *dqlPipeline) submit(t task) {
+	ctx, span := otel.Tracer(typeutil.ProxyRole).Start(t.TraceCtx(), t.Name())
+	span.AddEvent("scheduler process AddActiveTask")
+	p.queue.AddActiveTask(t)
+
+	item := &dqlPipelineItem{
+		t:     t,
+		ctx:   ctx,
+		span:  span,
+		funcs: stageFuncsOf(t),
+	}
+	p.forward(item, 0)
+}
+
+// execute executes all stages of the task in the caller goroutine, bypassing the bounded stages.
+// It's used by the tasks issued by the dql tasks being executed, e.g. the query of requery,
+// which would wait forever when the stages are full of their issuers waiting for them.
+func (p *dqlPipeline) execute(t task) {
+	ctx, span := otel.Tracer(typeutil.ProxyRole).Start(t.TraceCtx(), t.Name())
+	span.AddEvent("scheduler process AddActiveTask")
+	p.queue.AddActiveTask(t)
+
+	item := &dqlPipelineItem{
+		t:     t,
+		ctx:   ctx,
+		span:  span,
+		funcs: stageFuncsOf(t),
+	}
+	for i, f := range item.funcs {
+		if f == nil {
+			continue
+		}
+		span.AddEvent("scheduler process " + dqlStages[i])
+		if err := f(ctx); err != nil {
+			span.RecordError(err)
+			log.Ctx(ctx).Warn("failed to execute dql task", zap.String("stage", dqlStages[i]), zap.Error(err))
+			p.finish(item, err)
+			return
+		}
+	}
+	p.finish(item, nil)
+}
+
+// forward pushes the item to the first stage from index having a function to execute, the stages without function
+// are skipped, and the item is finished if there is no such stage.
+func (p *dqlPipeline) forward(item *dqlPipelineItem, index int) {
+	for ; index < len(p.stages); index++ {
+		if item.funcs[index] != nil {
+			p.push(p.stages[index], item)
+			return
+		}
+	}
+	p.finish(item, nil)
+}
+
+func (p *dqlPipeline) push(stage *dqlStage, item *dqlPipelineItem) {
+	item.enqueueTime = time.Now()
+	select {
+	case stage.queue <- item:
+		metrics.ProxyDQLStageQueueLength.WithLabelValues(fmt.Sprint(paramtable.GetNodeID()), stage.name).Inc()
+	case <-p.ctx.Done():
+		p.finish(item, p.ctx.Err())
+	}
+}
+
+func (p *dqlPipeline) work(stage *dqlStage) {
+	defer p.wg.Done()
+	nodeID := fmt.Sprint(paramtable.GetNodeID())
+	for {
+		select {
+		case <-p.ctx.Done():
+			return
+		case item := <-stage.queue:
+			metrics.ProxyDQLStageQueueLength.WithLabelValues(nodeID, stage.name).Dec()
+			metrics.ProxyDQLStageQueueLatency.WithLabelValues(nodeID, stage.name).
+				Observe(float64(time.Since(item.enqueueTime).Milliseconds()))
+
+			item.span.AddEvent("scheduler process " + stage.name)
+			if err := item.funcs[stage.index](item.ctx); err != nil {
+				item.span.RecordError(err)
+				log.Ctx(item.ctx).Warn("failed to execute dql task", zap.String("stage", stage.name), zap.Error(err))
+				p.finish(item, err)
+				continue
+			}
+			p.forward(item, stage.index+1)
+		}
+	}
+}
+
+func (p *dqlPipeline) finish(item *dqlPipelineItem, err error) {
+	item.t.Notify(err)
+	item.span.AddEvent("scheduler process PopActiveTask")
+	p.queue.PopActiveTask(item.t.ID())
+	item.span.End()
+}
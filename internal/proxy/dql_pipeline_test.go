@@ -0,0 +1,216 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package proxy
+
+import (
+	"context"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/cockroachdb/errors"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/milvus-io/milvus/pkg/util/paramtable"
+)
+
+type pipelinedMockTask struct {
+	*mockDqlTask
+
+	mu       sync.Mutex
+	executed []string
+	failAt   string
+}
+
+func newPipelinedMockTask(ctx context.Context) *pipelinedMockTask {
+	return &pipelinedMockTask{mockDqlTask: newMockDqlTask(ctx)}
+}
+
+func (t *pipelinedMockTask) stageFuncs() []dqlStageFunc {
+	funcs := make([]dqlStageFunc, 0, len(dqlStages))
+	for _, stage := range dqlStages {
+		stage := stage
+		funcs = append(funcs, func(ctx context.Context) error {
+			t.mu.Lock()
+			defer t.mu.Unlock()
+			t.executed = append(t.executed, stage)
+			if stage == t.failAt {
+				return errors.New("mock")
+			}
+			return nil
+		})
+	}
+	return funcs
+}
+
+func (t *pipelinedMockTask) getExecuted() []string {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	return t.executed
+}
+
+type requeryMockTask struct {
+	*mockDqlTask
+	pipeline *dqlPipeline
+}
+
+func (t *requeryMockTask) stageFuncs() []dqlStageFunc {
+	requery := func(ctx context.Context) error {
+		query := newMockDqlTask(ctx)
+		t.pipeline.execute(query)
+		return query.WaitToFinish()
+	}
+	return []dqlStageFunc{t.PreExecute, nil, t.Execute, t.PostExecute, requery}
+}
+
+func TestDqlPipeline(t *testing.T) {
+	paramtable.Init()
+	params := paramtable.Get()
+	params.Save(params.ProxyCfg.DQLPipelineComputeWorkers.Key, "2")
+	params.Save(params.ProxyCfg.DQLPipelineIOWorkers.Key, "2")
+	params.Save(params.ProxyCfg.DQLPipelineQueueSize.Key, "4")
+	defer params.Reset(params.ProxyCfg.DQLPipelineComputeWorkers.Key)
+	defer params.Reset(params.ProxyCfg.DQLPipelineIOWorkers.Key)
+	defer params.Reset(params.ProxyCfg.DQLPipelineQueueSize.Key)
+
+	ctx := context.Background()
+
+	t.Run("stages", func(t *testing.T) {
+		p := newDqlPipeline(ctx, newDqTaskQueue(newMockTsoAllocator()))
+		assert.Len(t, p.stages, len(dqlStages))
+		for i, stage := range p.stages {
+			assert.Equal(t, dqlStages[i], stage.name)
+			assert.Equal(t, 2, stage.workers)
+			assert.Equal(t, 4, cap(stage.queue))
+		}
+	})
+
+	t.Run("execute in order", func(t *testing.T) {
+		queue := newDqTaskQueue(newMockTsoAllocator())
+		p := newDqlPipeline(ctx, queue)
+		p.start()
+		defer p.close()
+
+		tasks := make([]*pipelinedMockTask, 0)
+		for i := 0; i < 10; i++ {
+			task := newPipelinedMockTask(ctx)
+			tasks = append(tasks, task)
+			p.submit(task)
+		}
+		for _, task := range tasks {
+			assert.NoError(t, task.WaitToFinish())
+			assert.Equal(t, dqlStages, task.getExecuted())
+		}
+	})
+
+	t.Run("stop at failed stage", func(t *testing.T) {
+		queue := newDqTaskQueue(newMockTsoAllocator())
+		p := newDqlPipeline(ctx, queue)
+		p.start()
+		defer p.close()
+
+		task := newPipelinedMockTask(ctx)
+		task.failAt = dqlStageShardFanout
+		p.submit(task)
+		assert.Error(t, task.WaitToFinish())
+		assert.Equal(t, []string{dqlStageValidation, dqlStagePlanning, dqlStageShardFanout}, task.getExecuted())
+	})
+
+	t.Run("task not pipelined", func(t *testing.T) {
+		queue := newDqTaskQueue(newMockTsoAllocator())
+		p := newDqlPipeline(ctx, queue)
+		p.start()
+		defer p.close()
+
+		task := newDefaultMockDqlTask()
+		p.submit(task)
+		assert.NoError(t, task.WaitToFinish())
+	})
+
+	t.Run("requery when saturated", func(t *testing.T) {
+		params.Save(params.ProxyCfg.DQLPipelineComputeWorkers.Key, "1")
+		defer params.Save(params.ProxyCfg.DQLPipelineComputeWorkers.Key, "2")
+		params.Save(params.ProxyCfg.DQLPipelineIOWorkers.Key, "1")
+		defer params.Save(params.ProxyCfg.DQLPipelineIOWorkers.Key, "2")
+		params.Save(params.ProxyCfg.DQLPipelineQueueSize.Key, "1")
+		defer params.Save(params.ProxyCfg.DQLPipelineQueueSize.Key, "4")
+
+		queue := newDqTaskQueue(newMockTsoAllocator())
+		p := newDqlPipeline(ctx, queue)
+		p.start()
+		defer p.close()
+
+		// the requery stage issues a query task and waits for it, as searchTask.Requery does,
+		// while all the stages before are full of the tasks waiting for the requery workers
+		wg := sync.WaitGroup{}
+		errs := make(chan error, 20)
+		for i := 0; i < 20; i++ {
+			wg.Add(1)
+			go func() {
+				defer wg.Done()
+				task := &requeryMockTask{mockDqlTask: newMockDqlTask(ctx), pipeline: p}
+				p.submit(task)
+				errs <- task.WaitToFinish()
+			}()
+		}
+		done := make(chan struct{})
+		go func() {
+			wg.Wait()
+			close(done)
+		}()
+		select {
+		case <-done:
+		case <-time.After(10 * time.Second):
+			t.Fatal("requery blocked by the saturated pipeline")
+		}
+		close(errs)
+		for err := range errs {
+			assert.NoError(t, err)
+		}
+	})
+
+	t.Run("execute directly", func(t *testing.T) {
+		queue := newDqTaskQueue(newMockTsoAllocator())
+		p := newDqlPipeline(ctx, queue)
+
+		// executed without workers
+		task := newPipelinedMockTask(ctx)
+		p.execute(task)
+		assert.NoError(t, task.WaitToFinish())
+		assert.Equal(t, dqlStages, task.getExecuted())
+
+		task = newPipelinedMockTask(ctx)
+		task.failAt = dqlStagePlanning
+		p.execute(task)
+		assert.Error(t, task.WaitToFinish())
+		assert.Equal(t, []string{dqlStageValidation, dqlStagePlanning}, task.getExecuted())
+	})
+
+	t.Run("closed", func(t *testing.T) {
+		queue := newDqTaskQueue(newMockTsoAllocator())
+		p := newDqlPipeline(ctx, queue)
+		p.close()
+
+		// fill up the queue of first stage to block the submission
+		for i := 0; i < cap(p.stages[0].queue); i++ {
+			p.stages[0].queue <- &dqlPipelineItem{}
+		}
+		task := newDefaultMockDqlTask()
+		p.submit(task)
+		assert.ErrorIs(t, task.WaitToFinish(), context.Canceled)
+	})
+}
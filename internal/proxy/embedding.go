@@ -0,0 +1,462 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package proxy
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"math"
+	"net/http"
+	"os"
+	"os/exec"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/cockroachdb/errors"
+	"github.com/golang/protobuf/proto"
+	"github.com/samber/lo"
+
+	"github.com/milvus-io/milvus-proto/go-api/v2/commonpb"
+	"github.com/milvus-io/milvus-proto/go-api/v2/schemapb"
+	"github.com/milvus-io/milvus/pkg/common"
+	"github.com/milvus-io/milvus/pkg/mq/msgstream"
+	"github.com/milvus-io/milvus/pkg/util/cache"
+	"github.com/milvus-io/milvus/pkg/util/funcutil"
+	"github.com/milvus-io/milvus/pkg/util/merr"
+	"github.com/milvus-io/milvus/pkg/util/typeutil"
+)
+
+const (
+	// embeddingProviderHTTP calls a remote service following the openai embeddings api.
+	embeddingProviderHTTP = "http"
+	// embeddingProviderOnnx runs a local onnx model by the runner configured in proxy.embedding.onnxRunner.
+	embeddingProviderOnnx = "onnx"
+)
+
+// embeddingFunction declares the vectors of OutputField are computed from the raw text of InputField,
+// it's stored in the collection property collection.embedding.functions as a json list.
+type embeddingFunction struct {
+	InputField  string `json:"input_field"`
+	OutputField string `json:"output_field"`
+	Provider    string `json:"provider"`
+	Endpoint    string `json:"endpoint,omitempty"`
+	Model       string `json:"model,omitempty"`
+	// APIKeyEnv is the environment variable of proxy which holds the api key of the http provider,
+	// the key itself is never stored in the collection properties since they're visible to all users.
+	APIKeyEnv string `json:"api_key_env,omitempty"`
+	// ModelPath is the path of the onnx model on the proxy nodes.
+	ModelPath string `json:"model_path,omitempty"`
+}
+
+// cacheKey returns the key of the embedding of text in cache, the functions of the same model share the cache.
+func (fn *embeddingFunction) cacheKey(text string) string {
+	return strings.Join([]string{fn.Provider, fn.Endpoint, fn.Model, fn.ModelPath, text}, "\x00")
+}
+
+// parseEmbeddingFunctions parses the embedding functions declared in collection properties.
+func parseEmbeddingFunctions(properties map[string]string) ([]*embeddingFunction, error) {
+	v, ok := properties[common.CollectionEmbeddingFunctionsKey]
+	if !ok {
+		return nil, nil
+	}
+	functions := make([]*embeddingFunction, 0)
+	if err := json.Unmarshal([]byte(v), &functions); err != nil {
+		return nil, merr.WrapErrParameterInvalidMsg("invalid %s: %s", common.CollectionEmbeddingFunctionsKey, err.Error())
+	}
+	outputs := typeutil.NewSet[string]()
+	for _, fn := range functions {
+		if fn.InputField == "" || fn.OutputField == "" {
+			return nil, merr.WrapErrParameterInvalidMsg("input_field and output_field are required by embedding function")
+		}
+		if outputs.Contain(fn.OutputField) {
+			return nil, merr.WrapErrParameterInvalidMsg("duplicate embedding functions of field %s", fn.OutputField)
+		}
+		outputs.Insert(fn.OutputField)
+
+		switch fn.Provider {
+		case embeddingProviderHTTP:
+			if fn.Endpoint == "" {
+				return nil, merr.WrapErrParameterInvalidMsg("endpoint is required by the embedding function of field %s", fn.OutputField)
+			}
+		case embeddingProviderOnnx:
+			if fn.ModelPath == "" {
+				return nil, merr.WrapErrParameterInvalidMsg("model_path is required by the embedding function of field %s", fn.OutputField)
+			}
+		default:
+			return nil, merr.WrapErrParameterInvalidMsg("unknown embedding provider %s of field %s", fn.Provider, fn.OutputField)
+		}
+	}
+	return functions, nil
+}
+
+// getEmbeddingFunctions returns the embedding functions of the collection, nil if the embedding is disabled.
+func getEmbeddingFunctions(ctx context.Context, dbName string, collectionName string) ([]*embeddingFunction, error) {
+	if !Params.ProxyCfg.EmbeddingEnabled.GetAsBool() {
+		return nil, nil
+	}
+	collectionID, err := globalMetaCache.GetCollectionID(ctx, dbName, collectionName)
+	if err != nil {
+		return nil, err
+	}
+	collectionInfo, err := globalMetaCache.GetCollectionInfo(ctx, dbName, collectionName, collectionID)
+	if err != nil {
+		return nil, err
+	}
+	return parseEmbeddingFunctions(collectionInfo.properties)
+}
+
+// getEmbeddingFields checks the fields of the embedding function against the schema,
+// the input field must be VARCHAR and the output field must be float vector.
+func getEmbeddingFields(fn *embeddingFunction, schema *schemapb.CollectionSchema) (*schemapb.FieldSchema, *schemapb.FieldSchema, error) {
+	helper, err := typeutil.CreateSchemaHelper(schema)
+	if err != nil {
+		return nil, nil, err
+	}
+	input, err := helper.GetFieldFromName(fn.InputField)
+	if err != nil {
+		return nil, nil, err
+	}
+	if input.GetDataType() != schemapb.DataType_VarChar {
+		return nil, nil, merr.WrapErrParameterInvalidMsg("input field %s of embedding function must be VARCHAR", fn.InputField)
+	}
+	output, err := helper.GetFieldFromName(fn.OutputField)
+	if err != nil {
+		return nil, nil, err
+	}
+	if output.GetDataType() != schemapb.DataType_FloatVector {
+		return nil, nil, merr.WrapErrParameterInvalidMsg("output field %s of embedding function must be float vector", fn.OutputField)
+	}
+	return input, output, nil
+}
+
+// fillEmbeddingFieldsData computes the vectors of the embedding functions from the raw text of insert data,
+// the vectors passed by request are kept as is.
+func fillEmbeddingFieldsData(ctx context.Context, functions []*embeddingFunction, schema *schemapb.CollectionSchema, msg *msgstream.InsertMsg) error {
+	for _, fn := range functions {
+		input, output, err := getEmbeddingFields(fn, schema)
+		if err != nil {
+			return err
+		}
+		if lo.ContainsBy(msg.GetFieldsData(), func(data *schemapb.FieldData) bool {
+			return data.GetFieldName() == output.GetName()
+		}) {
+			continue
+		}
+		inputData, ok := lo.Find(msg.GetFieldsData(), func(data *schemapb.FieldData) bool {
+			return data.GetFieldName() == input.GetName()
+		})
+		if !ok {
+			return merr.WrapErrParameterInvalidMsg("field %s is required to compute the embeddings of field %s", input.GetName(), output.GetName())
+		}
+		texts := inputData.GetScalars().GetStringData().GetData()
+		if uint64(len(texts)) != msg.NRows() {
+			return merr.WrapErrParameterInvalidMsg("the num_rows (%d) of field %s is not equal to passed num_rows (%d)", len(texts), input.GetName(), msg.NRows())
+		}
+		dim, err := typeutil.GetDim(output)
+		if err != nil {
+			return err
+		}
+
+		vectors, err := getEmbeddingManager().embed(ctx, fn, texts, int(dim))
+		if err != nil {
+			return err
+		}
+		data := make([]float32, 0, len(texts)*int(dim))
+		for _, vector := range vectors {
+			data = append(data, vector...)
+		}
+		msg.FieldsData = append(msg.FieldsData, &schemapb.FieldData{
+			Type:      schemapb.DataType_FloatVector,
+			FieldName: output.GetName(),
+			FieldId:   output.GetFieldID(),
+			Field: &schemapb.FieldData_Vectors{
+				Vectors: &schemapb.VectorField{
+					Dim: dim,
+					Data: &schemapb.VectorField_FloatVector{
+						FloatVector: &schemapb.FloatArray{Data: data},
+					},
+				},
+			},
+		})
+	}
+	return nil
+}
+
+// embedSearchPlaceholder converts the raw text placeholders of search request to the vectors of the anns field,
+// the anns field could be omitted if the collection declares only one embedding function.
+func embedSearchPlaceholder(ctx context.Context, functions []*embeddingFunction, schema *schemapb.CollectionSchema,
+	searchParams []*commonpb.KeyValuePair, placeholderGroup []byte,
+) ([]byte, error) {
+	if len(functions) == 0 {
+		return placeholderGroup, nil
+	}
+	group := &commonpb.PlaceholderGroup{}
+	if err := proto.Unmarshal(placeholderGroup, group); err != nil {
+		return nil, err
+	}
+	if !lo.ContainsBy(group.GetPlaceholders(), func(placeholder *commonpb.PlaceholderValue) bool {
+		return placeholder.GetType() == commonpb.PlaceholderType_VarChar
+	}) {
+		return placeholderGroup, nil
+	}
+
+	annsField, _ := funcutil.GetAttrByKeyFromRepeatedKV(AnnsFieldKey, searchParams)
+	fn, ok := lo.Find(functions, func(fn *embeddingFunction) bool {
+		return fn.OutputField == annsField
+	})
+	if !ok && annsField == "" && len(functions) == 1 {
+		fn, ok = functions[0], true
+	}
+	if !ok {
+		return nil, merr.WrapErrParameterInvalidMsg("no embedding function of anns field %s to search with text", annsField)
+	}
+	_, output, err := getEmbeddingFields(fn, schema)
+	if err != nil {
+		return nil, err
+	}
+	dim, err := typeutil.GetDim(output)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, placeholder := range group.GetPlaceholders() {
+		if placeholder.GetType() != commonpb.PlaceholderType_VarChar {
+			continue
+		}
+		texts := lo.Map(placeholder.GetValues(), func(value []byte, _ int) string {
+			return string(value)
+		})
+		vectors, err := getEmbeddingManager().embed(ctx, fn, texts, int(dim))
+		if err != nil {
+			return nil, err
+		}
+		placeholder.Type = commonpb.PlaceholderType_FloatVector
+		placeholder.Values = lo.Map(vectors, func(vector []float32, _ int) []byte {
+			return floatVectorToBytes(vector)
+		})
+	}
+	return proto.Marshal(group)
+}
+
+func floatVectorToBytes(vector []float32) []byte {
+	buf := make([]byte, len(vector)*4)
+	for i, v := range vector {
+		common.Endian.PutUint32(buf[i*4:], math.Float32bits(v))
+	}
+	return buf
+}
+
+// embeddingProvider computes the embeddings of texts.
+type embeddingProvider interface {
+	Embed(ctx context.Context, texts []string) ([][]float32, error)
+}
+
+// newEmbeddingProvider creates the provider of the embedding function, replaced in unit tests.
+var newEmbeddingProvider = func(fn *embeddingFunction) (embeddingProvider, error) {
+	switch fn.Provider {
+	case embeddingProviderHTTP:
+		provider := &httpEmbeddingProvider{endpoint: fn.Endpoint, model: fn.Model}
+		if fn.APIKeyEnv != "" {
+			provider.apiKey = os.Getenv(fn.APIKeyEnv)
+		}
+		return provider, nil
+	case embeddingProviderOnnx:
+		runner := strings.Fields(Params.ProxyCfg.EmbeddingOnnxRunner.GetValue())
+		if len(runner) == 0 {
+			return nil, merr.WrapErrServiceUnavailable("onnx embedding provider is disabled, set proxy.embedding.onnxRunner to enable it")
+		}
+		return &onnxEmbeddingProvider{runner: runner, modelPath: fn.ModelPath}, nil
+	default:
+		return nil, merr.WrapErrParameterInvalidMsg("unknown embedding provider %s", fn.Provider)
+	}
+}
+
+// httpEmbeddingProvider calls the remote service following the openai embeddings api.
+type httpEmbeddingProvider struct {
+	endpoint string
+	model    string
+	apiKey   string
+}
+
+type httpEmbeddingRequest struct {
+	Input []string `json:"input"`
+	Model string   `json:"model,omitempty"`
+}
+
+type httpEmbeddingResponse struct {
+	Data []struct {
+		Index     int       `json:"index"`
+		Embedding []float32 `json:"embedding"`
+	} `json:"data"`
+}
+
+func (p *httpEmbeddingProvider) Embed(ctx context.Context, texts []string) ([][]float32, error) {
+	body, err := json.Marshal(&httpEmbeddingRequest{Input: texts, Model: p.model})
+	if err != nil {
+		return nil, err
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, p.endpoint, bytes.NewReader(body))
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	if p.apiKey != "" {
+		req.Header.Set("Authorization", "Bearer "+p.apiKey)
+	}
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		msg, _ := io.ReadAll(io.LimitReader(resp.Body, 1024))
+		return nil, fmt.Errorf("embedding service returns status %d: %s", resp.StatusCode, string(msg))
+	}
+
+	result := &httpEmbeddingResponse{}
+	if err := json.NewDecoder(resp.Body).Decode(result); err != nil {
+		return nil, err
+	}
+	vectors := make([][]float32, len(texts))
+	for _, data := range result.Data {
+		if data.Index < 0 || data.Index >= len(texts) {
+			return nil, fmt.Errorf("embedding service returns invalid index %d", data.Index)
+		}
+		vectors[data.Index] = data.Embedding
+	}
+	return vectors, nil
+}
+
+// onnxEmbeddingProvider runs the local onnx model by the runner, which is invoked with the model path,
+// reads {"input": [texts]} from stdin and writes {"embeddings": [vectors]} to stdout.
+type onnxEmbeddingProvider struct {
+	runner    []string
+	modelPath string
+}
+
+func (p *onnxEmbeddingProvider) Embed(ctx context.Context, texts []string) ([][]float32, error) {
+	input, err := json.Marshal(map[string][]string{"input": texts})
+	if err != nil {
+		return nil, err
+	}
+	args := append(p.runner[1:len(p.runner):len(p.runner)], p.modelPath)
+	cmd := exec.CommandContext(ctx, p.runner[0], args...)
+	cmd.Stdin = bytes.NewReader(input)
+	stderr := &bytes.Buffer{}
+	cmd.Stderr = stderr
+	output, err := cmd.Output()
+	if err != nil {
+		return nil, errors.Wrapf(err, "onnx runner failed: %s", stderr.String())
+	}
+
+	result := struct {
+		Embeddings [][]float32 `json:"embeddings"`
+	}{}
+	if err := json.Unmarshal(output, &result); err != nil {
+		return nil, errors.Wrap(err, "invalid output of onnx runner")
+	}
+	return result.Embeddings, nil
+}
+
+// embeddingManager computes the embeddings in batches, and caches them for the texts inserted or searched repeatedly.
+type embeddingManager struct {
+	cache cache.Cache[string, []float32]
+}
+
+var (
+	embeddingManagerOnce   sync.Once
+	globalEmbeddingManager *embeddingManager
+)
+
+func getEmbeddingManager() *embeddingManager {
+	embeddingManagerOnce.Do(func() {
+		globalEmbeddingManager = newEmbeddingManager(Params.ProxyCfg.EmbeddingCacheSize.GetAsInt64())
+	})
+	return globalEmbeddingManager
+}
+
+func newEmbeddingManager(cacheSize int64) *embeddingManager {
+	m := &embeddingManager{}
+	if cacheSize > 0 {
+		m.cache = cache.NewCache[string, []float32](cache.WithMaximumSize[string, []float32](cacheSize))
+	}
+	return m
+}
+
+// embed returns the embeddings of texts in order, the dim of each embedding must be dim.
+func (m *embeddingManager) embed(ctx context.Context, fn *embeddingFunction, texts []string, dim int) ([][]float32, error) {
+	vectors := make([][]float32, len(texts))
+	// the offsets of the texts to compute, the same text is computed only once
+	missing := make(map[string][]int)
+	missingTexts := make([]string, 0)
+	for i, text := range texts {
+		if m.cache != nil {
+			if vector, ok := m.cache.GetIfPresent(fn.cacheKey(text)); ok {
+				vectors[i] = vector
+				continue
+			}
+		}
+		if _, ok := missing[text]; !ok {
+			missingTexts = append(missingTexts, text)
+		}
+		missing[text] = append(missing[text], i)
+	}
+	if len(missingTexts) == 0 {
+		return vectors, nil
+	}
+
+	provider, err := newEmbeddingProvider(fn)
+	if err != nil {
+		return nil, err
+	}
+	batchSize := Params.ProxyCfg.EmbeddingBatchSize.GetAsInt()
+	if batchSize <= 0 {
+		batchSize = len(missingTexts)
+	}
+	timeout := Params.ProxyCfg.EmbeddingTimeout.GetAsDuration(time.Second)
+	for start := 0; start < len(missingTexts); start += batchSize {
+		batch := missingTexts[start:lo.Min([]int{start + batchSize, len(missingTexts)})]
+		embeddings, err := func() ([][]float32, error) {
+			ctx, cancel := context.WithTimeout(ctx, timeout)
+			defer cancel()
+			return provider.Embed(ctx, batch)
+		}()
+		if err != nil {
+			return nil, errors.Wrapf(err, "failed to compute the embeddings of field %s", fn.OutputField)
+		}
+		if len(embeddings) != len(batch) {
+			return nil, fmt.Errorf("embedding provider returns %d embeddings for %d texts", len(embeddings), len(batch))
+		}
+		for i, embedding := range embeddings {
+			if len(embedding) != dim {
+				return nil, merr.WrapErrParameterInvalidMsg("the dim (%d) of embedding returned by provider is not equal to the dim (%d) of field %s",
+					len(embedding), dim, fn.OutputField)
+			}
+			if m.cache != nil {
+				m.cache.Put(fn.cacheKey(batch[i]), embedding)
+			}
+			for _, offset := range missing[batch[i]] {
+				vectors[offset] = embedding
+			}
+		}
+	}
+	return vectors, nil
+}
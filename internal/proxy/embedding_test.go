@@ -0,0 +1,277 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package proxy
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/golang/protobuf/proto"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/milvus-io/milvus-proto/go-api/v2/commonpb"
+	"github.com/milvus-io/milvus-proto/go-api/v2/msgpb"
+	"github.com/milvus-io/milvus-proto/go-api/v2/schemapb"
+	"github.com/milvus-io/milvus/pkg/common"
+	"github.com/milvus-io/milvus/pkg/mq/msgstream"
+	"github.com/milvus-io/milvus/pkg/util/merr"
+	"github.com/milvus-io/milvus/pkg/util/paramtable"
+)
+
+// mockEmbeddingProvider embeds a text to [len(text), 1].
+type mockEmbeddingProvider struct {
+	mu    sync.Mutex
+	calls [][]string
+}
+
+func (p *mockEmbeddingProvider) Embed(ctx context.Context, texts []string) ([][]float32, error) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	p.calls = append(p.calls, texts)
+	vectors := make([][]float32, 0, len(texts))
+	for _, text := range texts {
+		vectors = append(vectors, []float32{float32(len(text)), 1})
+	}
+	return vectors, nil
+}
+
+func mockEmbedding(t *testing.T) *mockEmbeddingProvider {
+	provider := &mockEmbeddingProvider{}
+	newProvider, manager := newEmbeddingProvider, getEmbeddingManager()
+	newEmbeddingProvider = func(fn *embeddingFunction) (embeddingProvider, error) {
+		return provider, nil
+	}
+	globalEmbeddingManager = newEmbeddingManager(0)
+	t.Cleanup(func() {
+		newEmbeddingProvider = newProvider
+		globalEmbeddingManager = manager
+	})
+	return provider
+}
+
+func Test_parseEmbeddingFunctions(t *testing.T) {
+	functions, err := parseEmbeddingFunctions(map[string]string{})
+	assert.NoError(t, err)
+	assert.Nil(t, functions)
+
+	functions, err = parseEmbeddingFunctions(map[string]string{
+		common.CollectionEmbeddingFunctionsKey: `[{"input_field": "text", "output_field": "vec", "provider": "http", "endpoint": "http://localhost/v1/embeddings"}]`,
+	})
+	assert.NoError(t, err)
+	assert.Len(t, functions, 1)
+	assert.Equal(t, "text", functions[0].InputField)
+
+	invalid := []string{
+		`{`,
+		`[{"input_field": "text", "provider": "http", "endpoint": "http://localhost"}]`,
+		`[{"input_field": "text", "output_field": "vec", "provider": "http"}]`,
+		`[{"input_field": "text", "output_field": "vec", "provider": "onnx"}]`,
+		`[{"input_field": "text", "output_field": "vec", "provider": "unknown"}]`,
+		`[{"input_field": "text", "output_field": "vec", "provider": "onnx", "model_path": "/a"},
+		  {"input_field": "text", "output_field": "vec", "provider": "onnx", "model_path": "/b"}]`,
+	}
+	for _, v := range invalid {
+		_, err = parseEmbeddingFunctions(map[string]string{common.CollectionEmbeddingFunctionsKey: v})
+		assert.ErrorIs(t, err, merr.ErrParameterInvalid, v)
+	}
+}
+
+func Test_fillEmbeddingFieldsData(t *testing.T) {
+	paramtable.Init()
+	provider := mockEmbedding(t)
+	schema := constructCollectionSchema("pk", "vec", 2, "col")
+	schema.Fields = append(schema.Fields, &schemapb.FieldSchema{FieldID: 102, Name: "text", DataType: schemapb.DataType_VarChar})
+	functions := []*embeddingFunction{{InputField: "text", OutputField: "vec", Provider: embeddingProviderHTTP, Endpoint: "http://localhost"}}
+	newMsg := func(fieldsData ...*schemapb.FieldData) *msgstream.InsertMsg {
+		return &msgstream.InsertMsg{InsertRequest: msgpb.InsertRequest{
+			FieldsData: fieldsData,
+			NumRows:    3,
+			Version:    msgpb.InsertDataVersion_ColumnBased,
+		}}
+	}
+	text := &schemapb.FieldData{
+		Type:      schemapb.DataType_VarChar,
+		FieldName: "text",
+		Field: &schemapb.FieldData_Scalars{Scalars: &schemapb.ScalarField{
+			Data: &schemapb.ScalarField_StringData{StringData: &schemapb.StringArray{Data: []string{"a", "bb", "a"}}},
+		}},
+	}
+
+	t.Run("compute embeddings", func(t *testing.T) {
+		msg := newMsg(text)
+		require.NoError(t, fillEmbeddingFieldsData(context.Background(), functions, schema, msg))
+		require.Len(t, msg.GetFieldsData(), 2)
+		vec := msg.GetFieldsData()[1]
+		assert.Equal(t, "vec", vec.GetFieldName())
+		assert.Equal(t, int64(101), vec.GetFieldId())
+		assert.Equal(t, int64(2), vec.GetVectors().GetDim())
+		assert.Equal(t, []float32{1, 1, 2, 1, 1, 1}, vec.GetVectors().GetFloatVector().GetData())
+		// the same text is computed only once
+		assert.Equal(t, [][]string{{"a", "bb"}}, provider.calls)
+	})
+
+	t.Run("vectors passed", func(t *testing.T) {
+		provider.calls = nil
+		msg := newMsg(text, &schemapb.FieldData{FieldName: "vec", Type: schemapb.DataType_FloatVector})
+		require.NoError(t, fillEmbeddingFieldsData(context.Background(), functions, schema, msg))
+		assert.Len(t, msg.GetFieldsData(), 2)
+		assert.Empty(t, provider.calls)
+	})
+
+	t.Run("missing text", func(t *testing.T) {
+		err := fillEmbeddingFieldsData(context.Background(), functions, schema, newMsg())
+		assert.ErrorIs(t, err, merr.ErrParameterInvalid)
+	})
+
+	t.Run("wrong dim", func(t *testing.T) {
+		schema := constructCollectionSchema("pk", "vec", 4, "col")
+		schema.Fields = append(schema.Fields, &schemapb.FieldSchema{FieldID: 102, Name: "text", DataType: schemapb.DataType_VarChar})
+		err := fillEmbeddingFieldsData(context.Background(), functions, schema, newMsg(text))
+		assert.ErrorIs(t, err, merr.ErrParameterInvalid)
+	})
+
+	t.Run("wrong field type", func(t *testing.T) {
+		functions := []*embeddingFunction{{InputField: "pk", OutputField: "vec", Provider: embeddingProviderHTTP, Endpoint: "http://localhost"}}
+		err := fillEmbeddingFieldsData(context.Background(), functions, schema, newMsg(text))
+		assert.ErrorIs(t, err, merr.ErrParameterInvalid)
+	})
+}
+
+func Test_embedSearchPlaceholder(t *testing.T) {
+	paramtable.Init()
+	mockEmbedding(t)
+	schema := constructCollectionSchema("pk", "vec", 2, "col")
+	schema.Fields = append(schema.Fields, &schemapb.FieldSchema{FieldID: 102, Name: "text", DataType: schemapb.DataType_VarChar})
+	functions := []*embeddingFunction{{InputField: "text", OutputField: "vec", Provider: embeddingProviderHTTP, Endpoint: "http://localhost"}}
+
+	textGroup, err := proto.Marshal(&commonpb.PlaceholderGroup{Placeholders: []*commonpb.PlaceholderValue{{
+		Tag:    "$0",
+		Type:   commonpb.PlaceholderType_VarChar,
+		Values: [][]byte{[]byte("abc")},
+	}}})
+	require.NoError(t, err)
+
+	// no embedding functions
+	result, err := embedSearchPlaceholder(context.Background(), nil, schema, nil, textGroup)
+	assert.NoError(t, err)
+	assert.Equal(t, textGroup, result)
+
+	// anns field omitted
+	result, err = embedSearchPlaceholder(context.Background(), functions, schema, nil, textGroup)
+	require.NoError(t, err)
+	group := &commonpb.PlaceholderGroup{}
+	require.NoError(t, proto.Unmarshal(result, group))
+	assert.Equal(t, commonpb.PlaceholderType_FloatVector, group.GetPlaceholders()[0].GetType())
+	assert.Equal(t, [][]byte{floatVectorToBytes([]float32{3, 1})}, group.GetPlaceholders()[0].GetValues())
+
+	// no embedding function of anns field
+	_, err = embedSearchPlaceholder(context.Background(), functions, schema,
+		[]*commonpb.KeyValuePair{{Key: AnnsFieldKey, Value: "other"}}, textGroup)
+	assert.ErrorIs(t, err, merr.ErrParameterInvalid)
+
+	// vector placeholders are kept as is
+	vectorGroup, err := proto.Marshal(&commonpb.PlaceholderGroup{Placeholders: []*commonpb.PlaceholderValue{{
+		Tag:    "$0",
+		Type:   commonpb.PlaceholderType_FloatVector,
+		Values: [][]byte{floatVectorToBytes([]float32{1, 2})},
+	}}})
+	require.NoError(t, err)
+	result, err = embedSearchPlaceholder(context.Background(), functions, schema, nil, vectorGroup)
+	assert.NoError(t, err)
+	assert.Equal(t, vectorGroup, result)
+}
+
+func Test_embeddingManager(t *testing.T) {
+	paramtable.Init()
+	provider := mockEmbedding(t)
+	paramtable.Get().Save(Params.ProxyCfg.EmbeddingBatchSize.Key, "2")
+	defer paramtable.Get().Reset(Params.ProxyCfg.EmbeddingBatchSize.Key)
+
+	fn := &embeddingFunction{InputField: "text", OutputField: "vec", Provider: embeddingProviderHTTP, Endpoint: "http://localhost"}
+	manager := newEmbeddingManager(100)
+	defer manager.cache.Close()
+
+	vectors, err := manager.embed(context.Background(), fn, []string{"a", "bb", "ccc"}, 2)
+	require.NoError(t, err)
+	assert.Equal(t, [][]float32{{1, 1}, {2, 1}, {3, 1}}, vectors)
+	assert.Equal(t, [][]string{{"a", "bb"}, {"ccc"}}, provider.calls)
+
+	// hit the cache
+	assert.Eventually(t, func() bool {
+		_, ok := manager.cache.GetIfPresent(fn.cacheKey("ccc"))
+		return ok
+	}, time.Second, 10*time.Millisecond)
+	provider.calls = nil
+	vectors, err = manager.embed(context.Background(), fn, []string{"ccc", "dddd"}, 2)
+	require.NoError(t, err)
+	assert.Equal(t, [][]float32{{3, 1}, {4, 1}}, vectors)
+	assert.Equal(t, [][]string{{"dddd"}}, provider.calls)
+}
+
+func Test_httpEmbeddingProvider(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		req := &httpEmbeddingRequest{}
+		if err := json.NewDecoder(r.Body).Decode(req); err != nil || r.Header.Get("Authorization") != "Bearer key" {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+		resp := map[string]interface{}{}
+		data := make([]map[string]interface{}, 0)
+		// returned in reverse order
+		for i := len(req.Input) - 1; i >= 0; i-- {
+			data = append(data, map[string]interface{}{"index": i, "embedding": []float32{float32(len(req.Input[i]))}})
+		}
+		resp["data"] = data
+		json.NewEncoder(w).Encode(resp)
+	}))
+	defer server.Close()
+
+	provider := &httpEmbeddingProvider{endpoint: server.URL, model: "m", apiKey: "key"}
+	vectors, err := provider.Embed(context.Background(), []string{"a", "bb"})
+	assert.NoError(t, err)
+	assert.Equal(t, [][]float32{{1}, {2}}, vectors)
+
+	provider.apiKey = ""
+	_, err = provider.Embed(context.Background(), []string{"a"})
+	assert.Error(t, err)
+}
+
+func Test_onnxEmbeddingProvider(t *testing.T) {
+	paramtable.Init()
+	fn := &embeddingFunction{InputField: "text", OutputField: "vec", Provider: embeddingProviderOnnx, ModelPath: "/model.onnx"}
+	_, err := newEmbeddingProvider(fn)
+	assert.ErrorIs(t, err, merr.ErrServiceUnavailable)
+
+	runner := filepath.Join(t.TempDir(), "runner.sh")
+	require.NoError(t, os.WriteFile(runner, []byte("#!/bin/sh\necho '{\"embeddings\": [[1, 2]]}'\n"), 0o755))
+	paramtable.Get().Save(Params.ProxyCfg.EmbeddingOnnxRunner.Key, runner+" --threads 2")
+	defer paramtable.Get().Reset(Params.ProxyCfg.EmbeddingOnnxRunner.Key)
+	provider, err := newEmbeddingProvider(fn)
+	require.NoError(t, err)
+	assert.Equal(t, []string{runner, "--threads", "2"}, provider.(*onnxEmbeddingProvider).runner)
+	vectors, err := provider.Embed(context.Background(), []string{"a"})
+	assert.NoError(t, err)
+	assert.Equal(t, [][]float32{{1, 2}}, vectors)
+}
@@ -0,0 +1,65 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package proxy
+
+import (
+	"strconv"
+
+	"github.com/milvus-io/milvus-proto/go-api/v2/commonpb"
+	"github.com/milvus-io/milvus-proto/go-api/v2/schemapb"
+	"github.com/milvus-io/milvus/pkg/util/merr"
+)
+
+// ExplainFieldName is the output field holding the executed plans of segments in json if explain requested.
+const ExplainFieldName = "explain"
+
+// parseExplain pops the explain flag from the search or query params.
+func parseExplain(paramsPair []*commonpb.KeyValuePair) (bool, []*commonpb.KeyValuePair, error) {
+	var (
+		explain bool
+		err     error
+	)
+	rest := make([]*commonpb.KeyValuePair, 0, len(paramsPair))
+	for _, kv := range paramsPair {
+		if kv.GetKey() != ExplainKey {
+			rest = append(rest, kv)
+			continue
+		}
+		explain, err = strconv.ParseBool(kv.GetValue())
+		if err != nil {
+			return false, nil, merr.WrapErrParameterInvalidMsg("%s [%s] is invalid, should be true or false", ExplainKey, kv.GetValue())
+		}
+	}
+	return explain, rest, nil
+}
+
+// newExplainFieldData returns the field data of the executed plans, one row per segment.
+func newExplainFieldData(explains []string) *schemapb.FieldData {
+	return &schemapb.FieldData{
+		Type:      schemapb.DataType_VarChar,
+		FieldName: ExplainFieldName,
+		Field: &schemapb.FieldData_Scalars{
+			Scalars: &schemapb.ScalarField{
+				Data: &schemapb.ScalarField_StringData{
+					StringData: &schemapb.StringArray{
+						Data: explains,
+					},
+				},
+			},
+		},
+	}
+}
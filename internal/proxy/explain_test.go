@@ -0,0 +1,71 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package proxy
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/milvus-io/milvus-proto/go-api/v2/commonpb"
+	"github.com/milvus-io/milvus/internal/proto/internalpb"
+	"github.com/milvus-io/milvus/pkg/util/typeutil"
+)
+
+func TestParseExplain(t *testing.T) {
+	explain, rest, err := parseExplain([]*commonpb.KeyValuePair{
+		{Key: TopKKey, Value: "10"},
+		{Key: ExplainKey, Value: "true"},
+	})
+	assert.NoError(t, err)
+	assert.True(t, explain)
+	assert.Len(t, rest, 1)
+	assert.Equal(t, TopKKey, rest[0].GetKey())
+
+	explain, rest, err = parseExplain([]*commonpb.KeyValuePair{{Key: TopKKey, Value: "10"}})
+	assert.NoError(t, err)
+	assert.False(t, explain)
+	assert.Len(t, rest, 1)
+
+	_, _, err = parseExplain([]*commonpb.KeyValuePair{{Key: ExplainKey, Value: "invalid"}})
+	assert.Error(t, err)
+}
+
+func TestSearchTask_ReduceExplains(t *testing.T) {
+	task := &searchTask{
+		Condition: NewTaskCondition(context.Background()),
+		SearchRequest: &internalpb.SearchRequest{
+			Nq:      2,
+			Topk:    10,
+			Explain: true,
+		},
+		ctx:            context.Background(),
+		collectionName: "test",
+		resultBuf:      typeutil.NewConcurrentSet[*internalpb.SearchResults](),
+	}
+	task.resultBuf.Insert(&internalpb.SearchResults{Explains: []string{`{"segment_id":1}`}})
+
+	require.NoError(t, task.PostExecute(context.Background()))
+	result := task.result.GetResults()
+	assert.EqualValues(t, 2, result.GetNumQueries())
+	assert.Equal(t, []int64{0, 0}, result.GetTopks())
+	assert.Equal(t, []string{ExplainFieldName}, result.GetOutputFields())
+	require.Len(t, result.GetFieldsData(), 1)
+	assert.Equal(t, []string{`{"segment_id":1}`}, result.GetFieldsData()[0].GetScalars().GetStringData().GetData())
+}
@@ -0,0 +1,166 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package proxy
+
+import (
+	"context"
+	"sort"
+
+	"go.uber.org/zap"
+	"golang.org/x/sync/errgroup"
+
+	"github.com/milvus-io/milvus-proto/go-api/v2/milvuspb"
+	"github.com/milvus-io/milvus-proto/go-api/v2/schemapb"
+	"github.com/milvus-io/milvus/pkg/common"
+	"github.com/milvus-io/milvus/pkg/log"
+	"github.com/milvus-io/milvus/pkg/util/funcutil"
+	"github.com/milvus-io/milvus/pkg/util/merr"
+	"github.com/milvus-io/milvus/pkg/util/timerecord"
+	"github.com/milvus-io/milvus/pkg/util/typeutil"
+)
+
+// SearchFunc runs an ann search request, e.g. Proxy.Search.
+type SearchFunc func(ctx context.Context, req *milvuspb.SearchRequest) (*milvuspb.SearchResults, error)
+
+// HybridSearch runs the ann search requests of a hybrid search concurrently by search,
+// then fuses their results of each query by the ranker and keeps the topk most relevant ones.
+// The requests must have the same nq and output fields.
+func HybridSearch(ctx context.Context, search SearchFunc, reqs []*milvuspb.SearchRequest, ranker Ranker, topk int64) (*milvuspb.SearchResults, error) {
+	maxRequestNum := Params.ProxyCfg.HybridSearchMaxRequestNum.GetAsInt()
+	if len(reqs) == 0 || len(reqs) > maxRequestNum {
+		return nil, merr.WrapErrParameterInvalidRange(1, maxRequestNum, len(reqs), "invalid number of ann search requests of hybrid search")
+	}
+	if topk <= 0 {
+		return nil, merr.WrapErrParameterInvalidMsg("limit of hybrid search should be positive, but got %d", topk)
+	}
+	nq := reqs[0].GetNq()
+	for _, req := range reqs {
+		if req.GetNq() != nq {
+			return nil, merr.WrapErrParameterInvalidMsg("the nq of ann search requests of hybrid search should be the same")
+		}
+	}
+
+	tr := timerecord.NewTimeRecorder("hybridSearch")
+	results := make([]*schemapb.SearchResultData, len(reqs))
+	g, gctx := errgroup.WithContext(ctx)
+	for i, req := range reqs {
+		i, req := i, req
+		g.Go(func() error {
+			resp, err := search(gctx, req)
+			if err == nil {
+				err = merr.Error(resp.GetStatus())
+			}
+			if err != nil {
+				return err
+			}
+			results[i] = resp.GetResults()
+			return nil
+		})
+	}
+	if err := g.Wait(); err != nil {
+		log.Ctx(ctx).Warn("fail to run the ann search requests of hybrid search", zap.Error(err))
+		return nil, err
+	}
+	tr.CtxRecord(ctx, "search done")
+
+	metricTypes := make([]string, len(reqs))
+	for i, req := range reqs {
+		metricTypes[i], _ = funcutil.GetAttrByKeyFromRepeatedKV(common.MetricTypeKey, req.GetSearchParams())
+	}
+	ret, err := fuseSearchResults(results, metricTypes, ranker, nq, topk)
+	if err != nil {
+		return nil, err
+	}
+	tr.CtxElapse(ctx, "fuse done")
+	return &milvuspb.SearchResults{
+		Status:         merr.Success(),
+		Results:        ret,
+		CollectionName: reqs[0].GetCollectionName(),
+	}, nil
+}
+
+// hitLocation is where an entity is first hit among the results of ann search requests.
+type hitLocation struct {
+	result int
+	idx    int64
+}
+
+// fuseSearchResults fuses the results of ann search requests of each query by the ranker,
+// the fields of an entity are taken from the first result hitting it.
+func fuseSearchResults(results []*schemapb.SearchResultData, metricTypes []string, ranker Ranker, nq int64, topk int64) (*schemapb.SearchResultData, error) {
+	for _, result := range results {
+		if result.GetNumQueries() != nq || int64(len(result.GetTopks())) != nq {
+			return nil, merr.WrapErrServiceInternal("invalid search result of hybrid search, nq mismatched")
+		}
+	}
+	// the results without any hit may carry no fields
+	fieldNum := 0
+	for _, result := range results {
+		if len(result.GetFieldsData()) > fieldNum {
+			fieldNum = len(result.GetFieldsData())
+		}
+	}
+	ret := &schemapb.SearchResultData{
+		NumQueries:   nq,
+		TopK:         topk,
+		FieldsData:   make([]*schemapb.FieldData, fieldNum),
+		Scores:       []float32{},
+		Ids:          &schemapb.IDs{},
+		Topks:        []int64{},
+		OutputFields: results[0].GetOutputFields(),
+	}
+
+	offsets := make([]int64, len(results))
+	for i := int64(0); i < nq; i++ {
+		hits := make([]*RankHits, len(results))
+		locations := make(map[interface{}]hitLocation)
+		ids := make([]interface{}, 0)
+		for j, result := range results {
+			hit := &RankHits{MetricType: metricTypes[j]}
+			for k := offsets[j]; k < offsets[j]+result.GetTopks()[i]; k++ {
+				id := typeutil.GetPK(result.GetIds(), k)
+				hit.IDs = append(hit.IDs, id)
+				hit.Scores = append(hit.Scores, result.GetScores()[k])
+				if _, ok := locations[id]; !ok {
+					locations[id] = hitLocation{result: j, idx: k}
+					ids = append(ids, id)
+				}
+			}
+			offsets[j] += result.GetTopks()[i]
+			hits[j] = hit
+		}
+
+		scores := ranker.Fuse(hits)
+		// the ties are kept in the order of hits for stable results
+		sort.SliceStable(ids, func(a, b int) bool {
+			return scores[ids[a]] > scores[ids[b]]
+		})
+		if int64(len(ids)) > topk {
+			ids = ids[:topk]
+		}
+		for _, id := range ids {
+			loc := locations[id]
+			if fieldNum > 0 {
+				typeutil.AppendFieldData(ret.FieldsData, results[loc.result].GetFieldsData(), loc.idx)
+			}
+			typeutil.AppendPKs(ret.Ids, id)
+			ret.Scores = append(ret.Scores, scores[id])
+		}
+		ret.Topks = append(ret.Topks, int64(len(ids)))
+	}
+	return ret, nil
+}
@@ -41,6 +41,7 @@ import (
 	"github.com/milvus-io/milvus/internal/proto/internalpb"
 	"github.com/milvus-io/milvus/internal/proto/proxypb"
 	"github.com/milvus-io/milvus/internal/proto/querypb"
+	"github.com/milvus-io/milvus/internal/proto/rootcoordpb"
 	"github.com/milvus-io/milvus/internal/util/importutil"
 	"github.com/milvus-io/milvus/pkg/common"
 	"github.com/milvus-io/milvus/pkg/log"
@@ -130,6 +131,10 @@ func (node *Proxy) InvalidateCollectionMetaCache(ctx context.Context, request *p
 			metrics.CleanupCollectionMetrics(paramtable.GetNodeID(), alias)
 		}
 	}
+	if request.GetBase().GetMsgType() == commonpb.MsgType_AlterCollection {
+		// the virtual channels changed, recreate the dml stream on demand.
+		node.chMgr.removeDMLStream(request.GetCollectionID())
+	}
 	log.Info("complete to invalidate collection meta cache")
 
 	return merr.Success(), nil
@@ -1886,6 +1891,7 @@ func (node *Proxy) DescribeIndex(ctx context.Context, request *milvuspb.Describe
 	metrics.ProxyFunctionCall.WithLabelValues(strconv.FormatInt(paramtable.GetNodeID(), 10), method,
 		metrics.SuccessLabel).Inc()
 	metrics.ProxyReqLatency.WithLabelValues(strconv.FormatInt(paramtable.GetNodeID(), 10), method).Observe(float64(tr.ElapseSpan().Milliseconds()))
+	setIndexBuildProgressHeader(ctx, dit.progress)
 	return dit.result, nil
 }
 
@@ -2104,6 +2110,7 @@ func (node *Proxy) GetIndexBuildProgress(ctx context.Context, request *milvuspb.
 	metrics.ProxyFunctionCall.WithLabelValues(strconv.FormatInt(paramtable.GetNodeID(), 10), method,
 		metrics.SuccessLabel).Inc()
 	metrics.ProxyReqLatency.WithLabelValues(strconv.FormatInt(paramtable.GetNodeID(), 10), method).Observe(float64(tr.ElapseSpan().Milliseconds()))
+	setIndexBuildProgressHeader(ctx, gibpt.progress)
 	return gibpt.result, nil
 }
 
@@ -2291,7 +2298,7 @@ func (node *Proxy) Insert(ctx context.Context, request *milvuspb.InsertRequest)
 		metrics.SuccessLabel).Inc()
 	successCnt := it.result.InsertCnt - int64(len(it.result.ErrIndex))
 	metrics.ProxyInsertVectors.WithLabelValues(strconv.FormatInt(paramtable.GetNodeID(), 10)).Add(float64(successCnt))
-	metrics.ProxyMutationLatency.WithLabelValues(strconv.FormatInt(paramtable.GetNodeID(), 10), metrics.InsertLabel).Observe(float64(tr.ElapseSpan().Milliseconds()))
+	metrics.ObserveWithTrace(ctx, metrics.ProxyMutationLatency.WithLabelValues(strconv.FormatInt(paramtable.GetNodeID(), 10), metrics.InsertLabel), float64(tr.ElapseSpan().Milliseconds()))
 	metrics.ProxyCollectionMutationLatency.WithLabelValues(strconv.FormatInt(paramtable.GetNodeID(), 10), metrics.InsertLabel, request.CollectionName).Observe(float64(tr.ElapseSpan().Milliseconds()))
 	return it.result, nil
 }
@@ -2364,7 +2371,7 @@ func (node *Proxy) Delete(ctx context.Context, request *milvuspb.DeleteRequest)
 
 	metrics.ProxyFunctionCall.WithLabelValues(strconv.FormatInt(paramtable.GetNodeID(), 10), method,
 		metrics.SuccessLabel).Inc()
-	metrics.ProxyMutationLatency.WithLabelValues(strconv.FormatInt(paramtable.GetNodeID(), 10), metrics.DeleteLabel).Observe(float64(tr.ElapseSpan().Milliseconds()))
+	metrics.ObserveWithTrace(ctx, metrics.ProxyMutationLatency.WithLabelValues(strconv.FormatInt(paramtable.GetNodeID(), 10), metrics.DeleteLabel), float64(tr.ElapseSpan().Milliseconds()))
 	metrics.ProxyCollectionMutationLatency.WithLabelValues(strconv.FormatInt(paramtable.GetNodeID(), 10), metrics.DeleteLabel, request.CollectionName).Observe(float64(tr.ElapseSpan().Milliseconds()))
 	return dt.result, nil
 }
@@ -2415,10 +2422,22 @@ func (node *Proxy) Upsert(ctx context.Context, request *milvuspb.UpsertRequest)
 			},
 		},
 
-		idAllocator:   node.rowIDAllocator,
-		segIDAssigner: node.segAssigner,
-		chMgr:         node.chMgr,
-		chTicker:      node.chTicker,
+		idAllocator:     node.rowIDAllocator,
+		segIDAssigner:   node.segAssigner,
+		chMgr:           node.chMgr,
+		chTicker:        node.chTicker,
+		conflictTracker: node.upsertTracker,
+	}
+
+	if Params.ProxyCfg.UpsertPartialUpdateEnabled.GetAsBool() {
+		if err := node.fillPartialUpsert(ctx, it); err != nil {
+			log.Info("Failed to fill partial upsert", zap.Error(err))
+			metrics.ProxyFunctionCall.WithLabelValues(strconv.FormatInt(paramtable.GetNodeID(), 10), method,
+				metrics.FailLabel).Inc()
+			return &milvuspb.MutationResult{
+				Status: merr.Status(err),
+			}, nil
+		}
 	}
 
 	log.Debug("Enqueue upsert request in Proxy",
@@ -2478,7 +2497,7 @@ func (node *Proxy) Upsert(ctx context.Context, request *milvuspb.UpsertRequest)
 
 	metrics.ProxyFunctionCall.WithLabelValues(strconv.FormatInt(paramtable.GetNodeID(), 10), method,
 		metrics.SuccessLabel).Inc()
-	metrics.ProxyMutationLatency.WithLabelValues(strconv.FormatInt(paramtable.GetNodeID(), 10), metrics.UpsertLabel).Observe(float64(tr.ElapseSpan().Milliseconds()))
+	metrics.ObserveWithTrace(ctx, metrics.ProxyMutationLatency.WithLabelValues(strconv.FormatInt(paramtable.GetNodeID(), 10), metrics.UpsertLabel), float64(tr.ElapseSpan().Milliseconds()))
 	metrics.ProxyCollectionMutationLatency.WithLabelValues(strconv.FormatInt(paramtable.GetNodeID(), 10), metrics.UpsertLabel, request.CollectionName).Observe(float64(tr.ElapseSpan().Milliseconds()))
 
 	log.Debug("Finish processing upsert request in Proxy")
@@ -2605,10 +2624,15 @@ func (node *Proxy) Search(ctx context.Context, request *milvuspb.SearchRequest)
 			metrics.FailLabel,
 		).Inc()
 
+		if errors.Is(err, merr.ErrPartitionNotLoaded) {
+			node.partitionPrefetcher.recordMiss(request.GetDbName(), request.GetCollectionName(), request.GetPartitionNames())
+		}
+
 		return &milvuspb.SearchResults{
 			Status: merr.Status(err),
 		}, nil
 	}
+	node.partitionPrefetcher.recordAccess(request.GetDbName(), request.GetCollectionName(), request.GetPartitionNames())
 
 	span := tr.CtxRecord(ctx, "wait search result")
 	metrics.ProxyWaitForSearchResultLatency.WithLabelValues(
@@ -2628,22 +2652,25 @@ func (node *Proxy) Search(ctx context.Context, request *milvuspb.SearchRequest)
 	metrics.ProxySearchVectors.WithLabelValues(strconv.FormatInt(paramtable.GetNodeID(), 10)).Add(float64(qt.result.GetResults().GetNumQueries()))
 
 	searchDur := tr.ElapseSpan().Milliseconds()
-	metrics.ProxySQLatency.WithLabelValues(
+	metrics.ObserveWithTrace(ctx, metrics.ProxySQLatency.WithLabelValues(
 		strconv.FormatInt(paramtable.GetNodeID(), 10),
 		metrics.SearchLabel,
-	).Observe(float64(searchDur))
+	), float64(searchDur))
 
-	metrics.ProxyCollectionSQLatency.WithLabelValues(
+	metrics.ObserveWithTrace(ctx, metrics.ProxyCollectionSQLatency.WithLabelValues(
 		strconv.FormatInt(paramtable.GetNodeID(), 10),
 		metrics.SearchLabel,
 		request.CollectionName,
-	).Observe(float64(searchDur))
+	), float64(searchDur))
 
 	if qt.result != nil {
 		sentSize := proto.Size(qt.result)
 		metrics.ProxyReadReqSendBytes.WithLabelValues(strconv.FormatInt(paramtable.GetNodeID(), 10)).Add(float64(sentSize))
 		rateCol.Add(metricsinfo.ReadResultThroughput, float64(sentSize))
 	}
+	setSearchPartialHeader(ctx, qt.partial, qt.missedSegments)
+	setResultTruncatedHeader(ctx, qt.truncation)
+	compressResponseIfLarge(ctx, qt.result.GetResults().GetFieldsData())
 	return qt.result, nil
 }
 
@@ -2837,7 +2864,11 @@ func (node *Proxy) query(ctx context.Context, qt *queryTask) (*milvuspb.QueryRes
 		zap.Uint64("guarantee_timestamp", request.GuaranteeTimestamp),
 	)
 
-	if err := node.sched.dqQueue.Enqueue(qt); err != nil {
+	enqueue := node.sched.dqQueue.Enqueue
+	if qt.direct {
+		enqueue = node.sched.executeDqTask
+	}
+	if err := enqueue(qt); err != nil {
 		log.Warn(
 			rpcFailedToEnqueue(method),
 			zap.Error(err),
@@ -2865,10 +2896,15 @@ func (node *Proxy) query(ctx context.Context, qt *queryTask) (*milvuspb.QueryRes
 		metrics.ProxyFunctionCall.WithLabelValues(strconv.FormatInt(paramtable.GetNodeID(), 10), method,
 			metrics.FailLabel).Inc()
 
+		if errors.Is(err, merr.ErrPartitionNotLoaded) {
+			node.partitionPrefetcher.recordMiss(request.GetDbName(), request.GetCollectionName(), request.GetPartitionNames())
+		}
+
 		return &milvuspb.QueryResults{
 			Status: merr.Status(err),
 		}, nil
 	}
+	node.partitionPrefetcher.recordAccess(request.GetDbName(), request.GetCollectionName(), request.GetPartitionNames())
 	span := tr.CtxRecord(ctx, "wait query result")
 	metrics.ProxyWaitForSearchResultLatency.WithLabelValues(
 		strconv.FormatInt(paramtable.GetNodeID(), 10),
@@ -2883,21 +2919,24 @@ func (node *Proxy) query(ctx context.Context, qt *queryTask) (*milvuspb.QueryRes
 		metrics.SuccessLabel,
 	).Inc()
 
-	metrics.ProxySQLatency.WithLabelValues(
+	metrics.ObserveWithTrace(ctx, metrics.ProxySQLatency.WithLabelValues(
 		strconv.FormatInt(paramtable.GetNodeID(), 10),
 		metrics.QueryLabel,
-	).Observe(float64(tr.ElapseSpan().Milliseconds()))
+	), float64(tr.ElapseSpan().Milliseconds()))
 
-	metrics.ProxyCollectionSQLatency.WithLabelValues(
+	metrics.ObserveWithTrace(ctx, metrics.ProxyCollectionSQLatency.WithLabelValues(
 		strconv.FormatInt(paramtable.GetNodeID(), 10),
 		metrics.QueryLabel,
 		request.CollectionName,
-	).Observe(float64(tr.ElapseSpan().Milliseconds()))
+	), float64(tr.ElapseSpan().Milliseconds()))
 
 	sentSize := proto.Size(qt.result)
 	rateCol.Add(metricsinfo.ReadResultThroughput, float64(sentSize))
 	metrics.ProxyReadReqSendBytes.WithLabelValues(strconv.FormatInt(paramtable.GetNodeID(), 10)).Add(float64(sentSize))
 
+	setQueryIteratorHeader(ctx, qt.nextCursor, qt.queryParams != nil && qt.queryParams.chunked)
+	setResultTruncatedHeader(ctx, qt.truncation)
+	compressResponseIfLarge(ctx, qt.result.GetFieldsData())
 	return qt.result, nil
 }
 
@@ -2913,9 +2952,10 @@ func (node *Proxy) Query(ctx context.Context, request *milvuspb.QueryRequest) (*
 			),
 			ReqID: paramtable.GetNodeID(),
 		},
-		request: request,
-		qc:      node.queryCoord,
-		lb:      node.lbPolicy,
+		request:     request,
+		qc:          node.queryCoord,
+		lb:          node.lbPolicy,
+		truncatable: true,
 	}
 	return node.query(ctx, qt)
 }
@@ -3974,6 +4014,16 @@ func (node *Proxy) InvalidateCredentialCache(ctx context.Context, request *proxy
 		return merr.Status(err), nil
 	}
 
+	// the api key is rotated or revoked
+	if id, ok := request.GetBase().GetProperties()[util.APIKeyIDKey]; ok {
+		if globalMetaCache != nil {
+			globalMetaCache.RemoveAPIKey(id)
+		}
+		metrics.CleanupAPIKeyMetrics(paramtable.GetNodeID(), id)
+		log.Debug("complete to invalidate api key cache", zap.String("id", id))
+		return merr.Success(), nil
+	}
+
 	username := request.Username
 	if globalMetaCache != nil {
 		globalMetaCache.RemoveCredential(username) // no need to return error, though credential may be not cached
@@ -4106,6 +4156,10 @@ func (node *Proxy) UpdateCredential(ctx context.Context, req *milvuspb.UpdateCre
 		err := merr.WrapErrPrivilegeNotAuthenticated("old password not correct for %s", req.GetUsername())
 		return merr.Status(err), nil
 	}
+	if err := checkPasswordReused(ctx, req.Username, rawNewPassword, globalMetaCache); err != nil {
+		log.Warn("check password reuse failed", zap.Error(err))
+		return merr.Status(err), nil
+	}
 	// update meta data
 	encryptedPassword, err := crypto.PasswordEncrypt(rawNewPassword)
 	if err != nil {
@@ -4154,6 +4208,337 @@ func (node *Proxy) DeleteCredential(ctx context.Context, req *milvuspb.DeleteCre
 	return result, err
 }
 
+// CancelDDLTask cancels the pending ddl task queued in rootcoord.
+func (node *Proxy) CancelDDLTask(ctx context.Context, req *rootcoordpb.CancelDDLTaskRequest) (*commonpb.Status, error) {
+	ctx, sp := otel.Tracer(typeutil.ProxyRole).Start(ctx, "Proxy-CancelDDLTask")
+	defer sp.End()
+
+	log := log.Ctx(ctx).With(
+		zap.String("role", typeutil.ProxyRole),
+		zap.Int64("taskID", req.GetTaskID()))
+
+	log.Info("CancelDDLTask")
+	if err := merr.CheckHealthy(node.GetStateCode()); err != nil {
+		return merr.Status(err), nil
+	}
+
+	result, err := node.rootCoord.CancelDDLTask(ctx, req)
+	if err != nil {
+		log.Warn("cancel ddl task fail", zap.Error(err))
+		return merr.Status(err), nil
+	}
+	return result, nil
+}
+
+// CancelCompactionPlan cancels the running compaction plan in datacoord.
+func (node *Proxy) CancelCompactionPlan(ctx context.Context, req *datapb.CancelCompactionPlanRequest) (*commonpb.Status, error) {
+	ctx, sp := otel.Tracer(typeutil.ProxyRole).Start(ctx, "Proxy-CancelCompactionPlan")
+	defer sp.End()
+
+	log := log.Ctx(ctx).With(
+		zap.String("role", typeutil.ProxyRole),
+		zap.Int64("planID", req.GetPlanID()))
+
+	log.Info("CancelCompactionPlan")
+	if err := merr.CheckHealthy(node.GetStateCode()); err != nil {
+		return merr.Status(err), nil
+	}
+
+	result, err := node.dataCoord.CancelCompactionPlan(ctx, req)
+	if err != nil {
+		log.Warn("cancel compaction plan fail", zap.Error(err))
+		return merr.Status(err), nil
+	}
+	return result, nil
+}
+
+// OperateStorageMigration starts or cancels the storage migration of the collection in datacoord.
+func (node *Proxy) OperateStorageMigration(ctx context.Context, req *datapb.OperateStorageMigrationRequest) (*commonpb.Status, error) {
+	ctx, sp := otel.Tracer(typeutil.ProxyRole).Start(ctx, "Proxy-OperateStorageMigration")
+	defer sp.End()
+
+	log := log.Ctx(ctx).With(
+		zap.String("role", typeutil.ProxyRole),
+		zap.String("db", req.GetDbName()),
+		zap.String("collection", req.GetCollectionName()),
+		zap.String("operateType", req.GetOperateType().String()))
+
+	log.Info("OperateStorageMigration")
+	if err := merr.CheckHealthy(node.GetStateCode()); err != nil {
+		return merr.Status(err), nil
+	}
+
+	collectionID, err := globalMetaCache.GetCollectionID(ctx, req.GetDbName(), req.GetCollectionName())
+	if err != nil {
+		log.Warn("failed to get collection id", zap.Error(err))
+		return merr.Status(err), nil
+	}
+	req.CollectionID = collectionID
+	result, err := node.dataCoord.OperateStorageMigration(ctx, req)
+	if err != nil {
+		log.Warn("operate storage migration fail", zap.Error(err))
+		return merr.Status(err), nil
+	}
+	return result, nil
+}
+
+// OperateLoadSchedule puts or removes the load schedule of the collection in querycoord.
+func (node *Proxy) OperateLoadSchedule(ctx context.Context, req *querypb.OperateLoadScheduleRequest) (*commonpb.Status, error) {
+	ctx, sp := otel.Tracer(typeutil.ProxyRole).Start(ctx, "Proxy-OperateLoadSchedule")
+	defer sp.End()
+
+	log := log.Ctx(ctx).With(
+		zap.String("role", typeutil.ProxyRole),
+		zap.String("db", req.GetDbName()),
+		zap.String("collection", req.GetCollectionName()),
+		zap.String("operateType", req.GetOperateType().String()))
+
+	log.Info("OperateLoadSchedule")
+	if err := merr.CheckHealthy(node.GetStateCode()); err != nil {
+		return merr.Status(err), nil
+	}
+
+	collectionID, err := globalMetaCache.GetCollectionID(ctx, req.GetDbName(), req.GetCollectionName())
+	if err != nil {
+		log.Warn("failed to get collection id", zap.Error(err))
+		return merr.Status(err), nil
+	}
+	req.CollectionID = collectionID
+	result, err := node.queryCoord.OperateLoadSchedule(ctx, req)
+	if err != nil {
+		log.Warn("operate load schedule fail", zap.Error(err))
+		return merr.Status(err), nil
+	}
+	return result, nil
+}
+
+// UpdateLoadConfig updates the replica number, resource groups and mmap setting of the loaded collection without releasing it.
+func (node *Proxy) UpdateLoadConfig(ctx context.Context, req *querypb.UpdateLoadConfigRequest) (*commonpb.Status, error) {
+	ctx, sp := otel.Tracer(typeutil.ProxyRole).Start(ctx, "Proxy-UpdateLoadConfig")
+	defer sp.End()
+
+	log := log.Ctx(ctx).With(
+		zap.String("role", typeutil.ProxyRole),
+		zap.String("db", req.GetDbName()),
+		zap.String("collection", req.GetCollectionName()))
+
+	log.Info("UpdateLoadConfig",
+		zap.Int32("replicaNumber", req.GetReplicaNumber()),
+		zap.Strings("resourceGroups", req.GetResourceGroups()))
+	if err := merr.CheckHealthy(node.GetStateCode()); err != nil {
+		return merr.Status(err), nil
+	}
+
+	collectionID, err := globalMetaCache.GetCollectionID(ctx, req.GetDbName(), req.GetCollectionName())
+	if err != nil {
+		log.Warn("failed to get collection id", zap.Error(err))
+		return merr.Status(err), nil
+	}
+	req.CollectionID = collectionID
+	result, err := node.queryCoord.UpdateLoadConfig(ctx, req)
+	if err != nil {
+		log.Warn("update load config fail", zap.Error(err))
+		return merr.Status(err), nil
+	}
+	return result, nil
+}
+
+// OperateCollectionTrash restores the dropped collection kept in the trash, or purges it at once.
+func (node *Proxy) OperateCollectionTrash(ctx context.Context, req *rootcoordpb.OperateCollectionTrashRequest) (*commonpb.Status, error) {
+	ctx, sp := otel.Tracer(typeutil.ProxyRole).Start(ctx, "Proxy-OperateCollectionTrash")
+	defer sp.End()
+
+	log := log.Ctx(ctx).With(
+		zap.String("role", typeutil.ProxyRole),
+		zap.Int64("collectionID", req.GetCollectionID()),
+		zap.String("operateType", req.GetOperateType().String()))
+
+	log.Info("OperateCollectionTrash")
+	if err := merr.CheckHealthy(node.GetStateCode()); err != nil {
+		return merr.Status(err), nil
+	}
+
+	result, err := node.rootCoord.OperateCollectionTrash(ctx, req)
+	if err != nil {
+		log.Warn("operate collection trash fail", zap.Error(err))
+		return merr.Status(err), nil
+	}
+	return result, nil
+}
+
+// OperateCollectionTemplate creates or drops the collection template.
+func (node *Proxy) OperateCollectionTemplate(ctx context.Context, req *rootcoordpb.OperateCollectionTemplateRequest) (*commonpb.Status, error) {
+	ctx, sp := otel.Tracer(typeutil.ProxyRole).Start(ctx, "Proxy-OperateCollectionTemplate")
+	defer sp.End()
+
+	log := log.Ctx(ctx).With(
+		zap.String("role", typeutil.ProxyRole),
+		zap.String("operateType", req.GetOperateType().String()))
+
+	log.Info("OperateCollectionTemplate")
+	if err := merr.CheckHealthy(node.GetStateCode()); err != nil {
+		return merr.Status(err), nil
+	}
+
+	result, err := node.rootCoord.OperateCollectionTemplate(ctx, req)
+	if err != nil {
+		log.Warn("operate collection template fail", zap.Error(err))
+		return merr.Status(err), nil
+	}
+	return result, nil
+}
+
+// CreateCollectionFromTemplate creates the collection with the schema, indexes and properties of the collection template.
+func (node *Proxy) CreateCollectionFromTemplate(ctx context.Context, req *rootcoordpb.CreateCollectionFromTemplateRequest) (*commonpb.Status, error) {
+	ctx, sp := otel.Tracer(typeutil.ProxyRole).Start(ctx, "Proxy-CreateCollectionFromTemplate")
+	defer sp.End()
+
+	if req.GetDbName() == "" {
+		req.DbName = GetCurDBNameFromContextOrDefault(ctx)
+	}
+	log := log.Ctx(ctx).With(
+		zap.String("role", typeutil.ProxyRole),
+		zap.String("db", req.GetDbName()),
+		zap.String("collection", req.GetCollectionName()),
+		zap.String("template", req.GetTemplateName()))
+
+	log.Info("CreateCollectionFromTemplate")
+	if err := merr.CheckHealthy(node.GetStateCode()); err != nil {
+		return merr.Status(err), nil
+	}
+
+	result, err := node.rootCoord.CreateCollectionFromTemplate(ctx, req)
+	if err != nil {
+		log.Warn("create collection from template fail", zap.Error(err))
+		return merr.Status(err), nil
+	}
+	return result, nil
+}
+
+// OperateRoleInheritance grants or revokes the parent role to the role, the role inherits the privileges of the parent role.
+func (node *Proxy) OperateRoleInheritance(ctx context.Context, req *rootcoordpb.OperateRoleInheritanceRequest) (*commonpb.Status, error) {
+	ctx, sp := otel.Tracer(typeutil.ProxyRole).Start(ctx, "Proxy-OperateRoleInheritance")
+	defer sp.End()
+
+	log := log.Ctx(ctx).With(
+		zap.String("role", typeutil.ProxyRole),
+		zap.String("roleName", req.GetRoleName()),
+		zap.String("parentRoleName", req.GetParentRoleName()),
+		zap.String("type", req.GetType().String()))
+
+	log.Info("OperateRoleInheritance")
+	if err := merr.CheckHealthy(node.GetStateCode()); err != nil {
+		return merr.Status(err), nil
+	}
+
+	result, err := node.rootCoord.OperateRoleInheritance(ctx, req)
+	if err != nil {
+		log.Warn("operate role inheritance fail", zap.Error(err))
+		return merr.Status(err), nil
+	}
+	return result, nil
+}
+
+// ListRoleInheritances lists the parent roles of each role.
+func (node *Proxy) ListRoleInheritances(ctx context.Context, req *rootcoordpb.ListRoleInheritancesRequest) (*rootcoordpb.ListRoleInheritancesResponse, error) {
+	ctx, sp := otel.Tracer(typeutil.ProxyRole).Start(ctx, "Proxy-ListRoleInheritances")
+	defer sp.End()
+
+	log := log.Ctx(ctx).With(zap.String("role", typeutil.ProxyRole))
+
+	log.Debug("ListRoleInheritances")
+	if err := merr.CheckHealthy(node.GetStateCode()); err != nil {
+		return &rootcoordpb.ListRoleInheritancesResponse{Status: merr.Status(err)}, nil
+	}
+
+	result, err := node.rootCoord.ListRoleInheritances(ctx, req)
+	if err != nil {
+		log.Warn("list role inheritances fail", zap.Error(err))
+		return &rootcoordpb.ListRoleInheritancesResponse{Status: merr.Status(err)}, nil
+	}
+	return result, nil
+}
+
+// OperateAPIKey creates, rotates or revokes the api key, the secret is only returned when the api key is created or rotated.
+func (node *Proxy) OperateAPIKey(ctx context.Context, req *rootcoordpb.OperateAPIKeyRequest) (*rootcoordpb.OperateAPIKeyResponse, error) {
+	ctx, sp := otel.Tracer(typeutil.ProxyRole).Start(ctx, "Proxy-OperateAPIKey")
+	defer sp.End()
+
+	log := log.Ctx(ctx).With(
+		zap.String("role", typeutil.ProxyRole),
+		zap.String("operateType", req.GetOperateType().String()))
+
+	log.Info("OperateAPIKey")
+	if err := merr.CheckHealthy(node.GetStateCode()); err != nil {
+		return &rootcoordpb.OperateAPIKeyResponse{Status: merr.Status(err)}, nil
+	}
+
+	result, err := node.rootCoord.OperateAPIKey(ctx, req)
+	if err != nil {
+		log.Warn("operate api key fail", zap.Error(err))
+		return &rootcoordpb.OperateAPIKeyResponse{Status: merr.Status(err)}, nil
+	}
+	return result, nil
+}
+
+// ListAPIKeys lists the api keys of the user, or all the api keys if the username is empty.
+func (node *Proxy) ListAPIKeys(ctx context.Context, req *rootcoordpb.ListAPIKeysRequest) (*rootcoordpb.ListAPIKeysResponse, error) {
+	ctx, sp := otel.Tracer(typeutil.ProxyRole).Start(ctx, "Proxy-ListAPIKeys")
+	defer sp.End()
+
+	log := log.Ctx(ctx).With(zap.String("role", typeutil.ProxyRole), zap.String("username", req.GetUsername()))
+
+	log.Debug("ListAPIKeys")
+	if err := merr.CheckHealthy(node.GetStateCode()); err != nil {
+		return &rootcoordpb.ListAPIKeysResponse{Status: merr.Status(err)}, nil
+	}
+
+	result, err := node.rootCoord.ListAPIKeys(ctx, req)
+	if err != nil {
+		log.Warn("list api keys fail", zap.Error(err))
+		return &rootcoordpb.ListAPIKeysResponse{Status: merr.Status(err)}, nil
+	}
+	return result, nil
+}
+
+// ListSessions lists the sessions established by Connect in the proxy, of the user or all the users.
+func (node *Proxy) ListSessions(ctx context.Context, req *proxypb.ListSessionsRequest) (*proxypb.ListSessionsResponse, error) {
+	ctx, sp := otel.Tracer(typeutil.ProxyRole).Start(ctx, "Proxy-ListSessions")
+	defer sp.End()
+
+	log := log.Ctx(ctx).With(zap.String("role", typeutil.ProxyRole), zap.String("user", req.GetUser()))
+
+	log.Debug("ListSessions")
+	if err := merr.CheckHealthy(node.GetStateCode()); err != nil {
+		return &proxypb.ListSessionsResponse{Status: merr.Status(err)}, nil
+	}
+
+	return &proxypb.ListSessionsResponse{
+		Status:   merr.Success(),
+		Sessions: GetConnectionManager().listSessions(req.GetUser()),
+	}, nil
+}
+
+// KillSession terminates the session established by Connect in the proxy, the client has to connect again.
+func (node *Proxy) KillSession(ctx context.Context, req *proxypb.KillSessionRequest) (*commonpb.Status, error) {
+	ctx, sp := otel.Tracer(typeutil.ProxyRole).Start(ctx, "Proxy-KillSession")
+	defer sp.End()
+
+	log := log.Ctx(ctx).With(zap.String("role", typeutil.ProxyRole), zap.Int64("identifier", req.GetIdentifier()))
+
+	log.Info("KillSession")
+	if err := merr.CheckHealthy(node.GetStateCode()); err != nil {
+		return merr.Status(err), nil
+	}
+
+	if !GetConnectionManager().kill(req.GetIdentifier()) {
+		err := merr.WrapErrParameterInvalidMsg("session %d not found", req.GetIdentifier())
+		log.Warn("kill session fail", zap.Error(err))
+		return merr.Status(err), nil
+	}
+	return merr.Success(), nil
+}
+
 func (node *Proxy) ListCredUsers(ctx context.Context, req *milvuspb.ListCredUsersRequest) (*milvuspb.ListCredUsersResponse, error) {
 	ctx, sp := otel.Tracer(typeutil.ProxyRole).Start(ctx, "Proxy-ListCredUsers")
 	defer sp.End()
@@ -5026,7 +5411,12 @@ func (node *Proxy) Connect(ctx context.Context, request *milvuspb.ConnectRequest
 		Reserved:   make(map[string]string),
 	}
 
-	GetConnectionManager().register(ctx, int64(ts), request.GetClientInfo())
+	if err := GetConnectionManager().register(ctx, int64(ts), request.GetClientInfo()); err != nil {
+		log.Info("connect failed, failed to register the session", zap.Error(err))
+		return &milvuspb.ConnectResponse{
+			Status: merr.Status(err),
+		}, nil
+	}
 
 	return &milvuspb.ConnectResponse{
 		Status:     merr.Success(),
@@ -25,6 +25,7 @@ import (
 	"github.com/cockroachdb/errors"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
 	"go.uber.org/zap"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/metadata"
@@ -1377,3 +1378,180 @@ func TestProxy_ReplicateMessage(t *testing.T) {
 		}
 	})
 }
+
+func TestProxy_OperateStorageMigration(t *testing.T) {
+	factory := dependency.NewDefaultFactory(true)
+	ctx := context.Background()
+
+	node, err := NewProxy(ctx, factory)
+	assert.NoError(t, err)
+	dataCoord := mocks.NewMockDataCoordClient(t)
+	node.dataCoord = dataCoord
+
+	t.Run("not healthy", func(t *testing.T) {
+		node.UpdateStateCode(commonpb.StateCode_Abnormal)
+		defer node.UpdateStateCode(commonpb.StateCode_Healthy)
+		resp, err := node.OperateStorageMigration(ctx, &datapb.OperateStorageMigrationRequest{})
+		assert.NoError(t, err)
+		assert.Equal(t, commonpb.ErrorCode_NotReadyServe, resp.GetErrorCode())
+	})
+
+	cacheBak := globalMetaCache
+	defer func() { globalMetaCache = cacheBak }()
+	cache := NewMockCache(t)
+	cache.EXPECT().GetCollectionID(mock.Anything, mock.Anything, "collection1").Return(UniqueID(100), nil)
+	cache.EXPECT().GetCollectionID(mock.Anything, mock.Anything, "collection2").Return(UniqueID(0), merr.WrapErrCollectionNotFound("collection2"))
+	globalMetaCache = cache
+
+	t.Run("collection not found", func(t *testing.T) {
+		resp, err := node.OperateStorageMigration(ctx, &datapb.OperateStorageMigrationRequest{CollectionName: "collection2"})
+		assert.NoError(t, err)
+		assert.ErrorIs(t, merr.Error(resp), merr.ErrCollectionNotFound)
+	})
+
+	t.Run("ok", func(t *testing.T) {
+		dataCoord.EXPECT().OperateStorageMigration(mock.Anything, mock.MatchedBy(func(req *datapb.OperateStorageMigrationRequest) bool {
+			return req.GetCollectionID() == 100
+		})).Return(merr.Success(), nil).Once()
+		resp, err := node.OperateStorageMigration(ctx, &datapb.OperateStorageMigrationRequest{CollectionName: "collection1"})
+		assert.NoError(t, err)
+		assert.True(t, merr.Ok(resp))
+	})
+
+	t.Run("datacoord failed", func(t *testing.T) {
+		dataCoord.EXPECT().OperateStorageMigration(mock.Anything, mock.Anything).Return(nil, errors.New("mock")).Once()
+		resp, err := node.OperateStorageMigration(ctx, &datapb.OperateStorageMigrationRequest{CollectionName: "collection1"})
+		assert.NoError(t, err)
+		assert.False(t, merr.Ok(resp))
+	})
+}
+
+func TestProxy_OperateLoadSchedule(t *testing.T) {
+	factory := dependency.NewDefaultFactory(true)
+	ctx := context.Background()
+
+	node, err := NewProxy(ctx, factory)
+	assert.NoError(t, err)
+	queryCoord := mocks.NewMockQueryCoordClient(t)
+	node.queryCoord = queryCoord
+
+	t.Run("not healthy", func(t *testing.T) {
+		node.UpdateStateCode(commonpb.StateCode_Abnormal)
+		defer node.UpdateStateCode(commonpb.StateCode_Healthy)
+		resp, err := node.OperateLoadSchedule(ctx, &querypb.OperateLoadScheduleRequest{})
+		assert.NoError(t, err)
+		assert.Equal(t, commonpb.ErrorCode_NotReadyServe, resp.GetErrorCode())
+	})
+
+	cacheBak := globalMetaCache
+	defer func() { globalMetaCache = cacheBak }()
+	cache := NewMockCache(t)
+	cache.EXPECT().GetCollectionID(mock.Anything, mock.Anything, "collection1").Return(UniqueID(100), nil)
+	cache.EXPECT().GetCollectionID(mock.Anything, mock.Anything, "collection2").Return(UniqueID(0), merr.WrapErrCollectionNotFound("collection2"))
+	globalMetaCache = cache
+
+	t.Run("collection not found", func(t *testing.T) {
+		resp, err := node.OperateLoadSchedule(ctx, &querypb.OperateLoadScheduleRequest{CollectionName: "collection2"})
+		assert.NoError(t, err)
+		assert.ErrorIs(t, merr.Error(resp), merr.ErrCollectionNotFound)
+	})
+
+	t.Run("ok", func(t *testing.T) {
+		queryCoord.EXPECT().OperateLoadSchedule(mock.Anything, mock.MatchedBy(func(req *querypb.OperateLoadScheduleRequest) bool {
+			return req.GetCollectionID() == 100
+		})).Return(merr.Success(), nil).Once()
+		resp, err := node.OperateLoadSchedule(ctx, &querypb.OperateLoadScheduleRequest{CollectionName: "collection1"})
+		assert.NoError(t, err)
+		assert.True(t, merr.Ok(resp))
+	})
+
+	t.Run("querycoord failed", func(t *testing.T) {
+		queryCoord.EXPECT().OperateLoadSchedule(mock.Anything, mock.Anything).Return(nil, errors.New("mock")).Once()
+		resp, err := node.OperateLoadSchedule(ctx, &querypb.OperateLoadScheduleRequest{CollectionName: "collection1"})
+		assert.NoError(t, err)
+		assert.False(t, merr.Ok(resp))
+	})
+}
+
+func TestProxy_UpdateLoadConfig(t *testing.T) {
+	factory := dependency.NewDefaultFactory(true)
+	ctx := context.Background()
+
+	node, err := NewProxy(ctx, factory)
+	assert.NoError(t, err)
+	queryCoord := mocks.NewMockQueryCoordClient(t)
+	node.queryCoord = queryCoord
+
+	t.Run("not healthy", func(t *testing.T) {
+		node.UpdateStateCode(commonpb.StateCode_Abnormal)
+		defer node.UpdateStateCode(commonpb.StateCode_Healthy)
+		resp, err := node.UpdateLoadConfig(ctx, &querypb.UpdateLoadConfigRequest{})
+		assert.NoError(t, err)
+		assert.Equal(t, commonpb.ErrorCode_NotReadyServe, resp.GetErrorCode())
+	})
+
+	cacheBak := globalMetaCache
+	defer func() { globalMetaCache = cacheBak }()
+	cache := NewMockCache(t)
+	cache.EXPECT().GetCollectionID(mock.Anything, mock.Anything, "collection1").Return(UniqueID(100), nil)
+	cache.EXPECT().GetCollectionID(mock.Anything, mock.Anything, "collection2").Return(UniqueID(0), merr.WrapErrCollectionNotFound("collection2"))
+	globalMetaCache = cache
+
+	t.Run("collection not found", func(t *testing.T) {
+		resp, err := node.UpdateLoadConfig(ctx, &querypb.UpdateLoadConfigRequest{CollectionName: "collection2"})
+		assert.NoError(t, err)
+		assert.ErrorIs(t, merr.Error(resp), merr.ErrCollectionNotFound)
+	})
+
+	t.Run("ok", func(t *testing.T) {
+		queryCoord.EXPECT().UpdateLoadConfig(mock.Anything, mock.MatchedBy(func(req *querypb.UpdateLoadConfigRequest) bool {
+			return req.GetCollectionID() == 100
+		})).Return(merr.Success(), nil).Once()
+		resp, err := node.UpdateLoadConfig(ctx, &querypb.UpdateLoadConfigRequest{CollectionName: "collection1"})
+		assert.NoError(t, err)
+		assert.True(t, merr.Ok(resp))
+	})
+
+	t.Run("querycoord failed", func(t *testing.T) {
+		queryCoord.EXPECT().UpdateLoadConfig(mock.Anything, mock.Anything).Return(nil, errors.New("mock")).Once()
+		resp, err := node.UpdateLoadConfig(ctx, &querypb.UpdateLoadConfigRequest{CollectionName: "collection1"})
+		assert.NoError(t, err)
+		assert.False(t, merr.Ok(resp))
+	})
+}
+
+func TestProxy_Sessions(t *testing.T) {
+	paramtable.Init()
+	ctx := context.Background()
+	node := &Proxy{}
+	node.UpdateStateCode(commonpb.StateCode_Healthy)
+
+	identifier := int64(20231017)
+	require.NoError(t, GetConnectionManager().register(GetContext(ctx, "session_user:123456"), identifier, &commonpb.ClientInfo{SdkType: "python"}))
+
+	t.Run("list", func(t *testing.T) {
+		resp, err := node.ListSessions(ctx, &proxypb.ListSessionsRequest{User: "session_user"})
+		require.NoError(t, merr.CheckRPCCall(resp, err))
+		require.Len(t, resp.GetSessions(), 1)
+		assert.Equal(t, identifier, resp.GetSessions()[0].GetIdentifier())
+		assert.Equal(t, "python", resp.GetSessions()[0].GetSdkType())
+	})
+
+	t.Run("kill", func(t *testing.T) {
+		resp, err := node.KillSession(ctx, &proxypb.KillSessionRequest{Identifier: identifier})
+		require.NoError(t, merr.CheckRPCCall(resp, err))
+		assert.True(t, GetConnectionManager().isKilled(identifier))
+
+		resp, err = node.KillSession(ctx, &proxypb.KillSessionRequest{Identifier: identifier})
+		assert.ErrorIs(t, merr.CheckRPCCall(resp, err), merr.ErrParameterInvalid)
+	})
+
+	t.Run("not healthy", func(t *testing.T) {
+		node.UpdateStateCode(commonpb.StateCode_Abnormal)
+		defer node.UpdateStateCode(commonpb.StateCode_Healthy)
+		listResp, err := node.ListSessions(ctx, &proxypb.ListSessionsRequest{})
+		assert.ErrorIs(t, merr.CheckRPCCall(listResp, err), merr.ErrServiceNotReady)
+		resp, err := node.KillSession(ctx, &proxypb.KillSessionRequest{Identifier: identifier})
+		assert.ErrorIs(t, merr.CheckRPCCall(resp, err), merr.ErrServiceNotReady)
+	})
+}
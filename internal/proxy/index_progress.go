@@ -0,0 +1,61 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package proxy
+
+import (
+	"context"
+	"fmt"
+
+	"go.uber.org/zap"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+
+	"github.com/milvus-io/milvus/pkg/log"
+)
+
+const (
+	// IndexBuildProgressHeader carries the percentage of the rows indexed of each index described,
+	// in the form of <index name>=<percentage>.
+	IndexBuildProgressHeader = "index-build-progress"
+	// IndexBuildRemainingSecondsHeader carries the estimated seconds to finish building each index described,
+	// in the form of <index name>=<seconds>, it's absent if the remaining time can't be estimated yet.
+	IndexBuildRemainingSecondsHeader = "index-build-remaining-seconds"
+)
+
+// indexBuildProgress is the build progress of an index, which is not carried by the responses of DescribeIndex
+// and GetIndexBuildProgress, so it's sent by the grpc response header.
+type indexBuildProgress struct {
+	indexName        string
+	progress         float32
+	remainingSeconds int64
+}
+
+func setIndexBuildProgressHeader(ctx context.Context, progresses []indexBuildProgress) {
+	if len(progresses) == 0 {
+		return
+	}
+	header := metadata.MD{}
+	for _, progress := range progresses {
+		header.Append(IndexBuildProgressHeader, fmt.Sprintf("%s=%.2f", progress.indexName, progress.progress))
+		if progress.remainingSeconds > 0 {
+			header.Append(IndexBuildRemainingSecondsHeader, fmt.Sprintf("%s=%d", progress.indexName, progress.remainingSeconds))
+		}
+	}
+	if err := grpc.SetHeader(ctx, header); err != nil {
+		log.Ctx(ctx).Warn("failed to set index build progress header", zap.Error(err))
+	}
+}
@@ -4,9 +4,12 @@ import (
 	"context"
 	"fmt"
 	"strconv"
+	"strings"
 
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
 
 	"github.com/milvus-io/milvus/pkg/util"
 	"github.com/milvus-io/milvus/pkg/util/funcutil"
@@ -32,12 +35,19 @@ func KeepActiveInterceptor(ctx context.Context, req interface{}, info *grpc.Unar
 	// We shouldn't block the normal rpc. though this may be not very accurate enough.
 	// On the other hand, too many goroutines will also influence the rpc.
 	// Not sure which way is better, since actually we already make the `keepActive` asynchronous.
+	identifier, err := getIdentifierFromContext(ctx)
+	if err != nil {
+		return handler(ctx, req)
+	}
 	go func() {
-		identifier, err := getIdentifierFromContext(ctx)
-		if err == nil && funcutil.CheckCtxValid(ctx) {
+		if funcutil.CheckCtxValid(ctx) {
 			GetConnectionManager().keepActive(identifier)
 		}
 	}()
 
+	// the killed session has to connect again
+	if !strings.HasSuffix(info.FullMethod, "/Connect") && GetConnectionManager().isKilled(identifier) {
+		return nil, status.Errorf(codes.Unauthenticated, "session %d is killed, please connect again", identifier)
+	}
 	return handler(ctx, req)
 }
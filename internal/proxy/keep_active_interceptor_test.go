@@ -2,11 +2,16 @@ package proxy
 
 import (
 	"context"
+	"strconv"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+
+	"github.com/milvus-io/milvus-proto/go-api/v2/commonpb"
 )
 
 func Test_getIdentifierFromContext(t *testing.T) {
@@ -57,9 +62,32 @@ func TestKeepActiveInterceptor(t *testing.T) {
 		return "not-important", nil
 	}
 
-	got, err := KeepActiveInterceptor(ctx, nil, nil, handler)
+	info := &grpc.UnaryServerInfo{FullMethod: "/milvus.proto.milvus.MilvusService/Search"}
+	got, err := KeepActiveInterceptor(ctx, nil, info, handler)
 	<-rpcChan
 	assert.True(t, rpcCalled)
 	assert.NoError(t, err)
 	assert.Equal(t, "not-important", got)
 }
+
+func TestKeepActiveInterceptor_KilledSession(t *testing.T) {
+	identifier := int64(20231016)
+	assert.NoError(t, GetConnectionManager().register(context.TODO(), identifier, &commonpb.ClientInfo{}))
+	assert.True(t, GetConnectionManager().kill(identifier))
+
+	md := metadata.New(map[string]string{
+		"identifier": strconv.FormatInt(identifier, 10),
+	})
+	ctx := metadata.NewIncomingContext(context.TODO(), md)
+	var handler grpc.UnaryHandler = func(ctx context.Context, req interface{}) (interface{}, error) {
+		return "not-important", nil
+	}
+
+	_, err := KeepActiveInterceptor(ctx, nil, &grpc.UnaryServerInfo{FullMethod: "/milvus.proto.milvus.MilvusService/Search"}, handler)
+	assert.Equal(t, codes.Unauthenticated, status.Code(err))
+
+	// connect again
+	got, err := KeepActiveInterceptor(ctx, nil, &grpc.UnaryServerInfo{FullMethod: "/milvus.proto.milvus.MilvusService/Connect"}, handler)
+	assert.NoError(t, err)
+	assert.Equal(t, "not-important", got)
+}
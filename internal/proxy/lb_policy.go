@@ -184,7 +184,10 @@ func (lb *LBPolicyImpl) ExecuteWithRetry(ctx context.Context, workload ChannelWo
 
 		lb.balancer.CancelWorkload(targetNode, workload.nq)
 		return nil
-	}, retry.Attempts(workload.retryTimes))
+	}, retry.Attempts(workload.retryTimes),
+		// other replicas of the dropped collection fail in the same way
+		retry.FatalOn(retry.ErrorIs(merr.ErrCollectionNotFound)),
+		retry.CallSite("proxy.ExecuteWithRetry"))
 
 	return err
 }
@@ -356,6 +356,27 @@ func (s *LBPolicySuite) TestExecuteWithRetry() {
 		retryTimes: 2,
 	})
 	s.True(merr.IsCanceledOrTimeout(err))
+
+	// test collection dropped, expected no retry
+	s.mgr.ExpectedCalls = nil
+	s.mgr.EXPECT().GetClient(mock.Anything, mock.Anything).Return(s.qn, nil)
+	s.lbBalancer.EXPECT().CancelWorkload(mock.Anything, mock.Anything)
+	execTimes := 0
+	err = s.lbPolicy.ExecuteWithRetry(ctx, ChannelWorkload{
+		db:             dbName,
+		collectionName: s.collectionName,
+		collectionID:   s.collectionID,
+		channel:        s.channels[0],
+		shardLeaders:   s.nodes,
+		nq:             1,
+		exec: func(ctx context.Context, ui UniqueID, qn types.QueryNodeClient, s ...string) error {
+			execTimes++
+			return merr.WrapErrCollectionNotFound(s[0])
+		},
+		retryTimes: 2,
+	})
+	s.ErrorIs(err, merr.ErrCollectionNotFound)
+	s.Equal(1, execTimes)
 }
 
 func (s *LBPolicySuite) TestExecute() {
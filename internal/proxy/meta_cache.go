@@ -41,6 +41,7 @@ import (
 	"github.com/milvus-io/milvus/pkg/metrics"
 	"github.com/milvus-io/milvus/pkg/util"
 	"github.com/milvus-io/milvus/pkg/util/commonpbutil"
+	"github.com/milvus-io/milvus/pkg/util/crypto"
 	"github.com/milvus-io/milvus/pkg/util/funcutil"
 	"github.com/milvus-io/milvus/pkg/util/merr"
 	"github.com/milvus-io/milvus/pkg/util/paramtable"
@@ -76,8 +77,13 @@ type Cache interface {
 
 	// GetCredentialInfo operate credential cache
 	GetCredentialInfo(ctx context.Context, username string) (*internalpb.CredentialInfo, error)
+	// GetCredentialState returns the password state for the password policy enforcement
+	GetCredentialState(ctx context.Context, username string) (*crypto.CredentialState, error)
 	RemoveCredential(username string)
 	UpdateCredential(credInfo *internalpb.CredentialInfo)
+	// GetAPIKey returns the api key issued by rootcoord for the verification
+	GetAPIKey(ctx context.Context, id string) (*crypto.APIKey, error)
+	RemoveAPIKey(id string)
 
 	GetPrivilegeInfo(ctx context.Context) []string
 	GetUserRole(username string) []string
@@ -93,6 +99,7 @@ type collectionBasicInfo struct {
 	createdUtcTimestamp uint64
 	consistencyLevel    commonpb.ConsistencyLevel
 	partInfo            map[string]*partitionInfo
+	properties          map[string]string
 }
 
 type collectionInfo struct {
@@ -104,6 +111,7 @@ type collectionInfo struct {
 	createdTimestamp    uint64
 	createdUtcTimestamp uint64
 	consistencyLevel    commonpb.ConsistencyLevel
+	properties          map[string]string
 }
 
 // getBasicInfo get a basic info by deep copy.
@@ -115,6 +123,10 @@ func (info *collectionInfo) getBasicInfo() *collectionBasicInfo {
 		createdUtcTimestamp: info.createdUtcTimestamp,
 		consistencyLevel:    info.consistencyLevel,
 		partInfo:            make(map[string]*partitionInfo, len(info.partInfo)),
+		properties:          make(map[string]string, len(info.properties)),
+	}
+	for k, v := range info.properties {
+		basicInfo.properties[k] = v
 	}
 	for s, info := range info.partInfo {
 		info2 := *info
@@ -197,6 +209,8 @@ type MetaCache struct {
 
 	collInfo       map[string]map[string]*collectionInfo // database -> collection -> collection_info
 	credMap        map[string]*internalpb.CredentialInfo // cache for credential, lazy load
+	credStateMap   map[string]*crypto.CredentialState    // cache for password state, lazy load
+	apiKeyMap      map[string]*crypto.APIKey             // cache for api key, lazy load
 	privilegeInfos map[string]struct{}                   // privileges cache
 	userToRoles    map[string]map[string]struct{}        // user to role cache
 	mu             sync.RWMutex
@@ -235,6 +249,8 @@ func NewMetaCache(rootCoord types.RootCoordClient, queryCoord types.QueryCoordCl
 		queryCoord:     queryCoord,
 		collInfo:       map[string]map[string]*collectionInfo{},
 		credMap:        map[string]*internalpb.CredentialInfo{},
+		credStateMap:   map[string]*crypto.CredentialState{},
+		apiKeyMap:      map[string]*crypto.APIKey{},
 		shardMgr:       shardMgr,
 		privilegeInfos: map[string]struct{}{},
 		userToRoles:    map[string]map[string]struct{}{},
@@ -441,6 +457,7 @@ func (m *MetaCache) updateCollection(coll *milvuspb.DescribeCollectionResponse,
 	m.collInfo[database][collectionName].createdTimestamp = coll.CreatedTimestamp
 	m.collInfo[database][collectionName].createdUtcTimestamp = coll.CreatedUtcTimestamp
 	m.collInfo[database][collectionName].consistencyLevel = coll.ConsistencyLevel
+	m.collInfo[database][collectionName].properties = funcutil.KeyValuePair2Map(coll.GetProperties())
 }
 
 func (m *MetaCache) GetPartitionID(ctx context.Context, database, collectionName string, partitionName string) (typeutil.UniqueID, error) {
@@ -741,11 +758,85 @@ func (m *MetaCache) GetCredentialInfo(ctx context.Context, username string) (*in
 	return credInfo, nil
 }
 
+// GetCredentialState returns the password state related to provided username
+func (m *MetaCache) GetCredentialState(ctx context.Context, username string) (*crypto.CredentialState, error) {
+	m.credMut.RLock()
+	state, ok := m.credStateMap[username]
+	m.credMut.RUnlock()
+	if ok {
+		return state, nil
+	}
+
+	req := &rootcoordpb.GetCredentialRequest{
+		Base: commonpbutil.NewMsgBase(
+			commonpbutil.WithMsgType(commonpb.MsgType_GetCredential),
+		),
+		Username: username,
+	}
+	req.Base.Properties = map[string]string{util.CredentialStateKey: "true"}
+	resp, err := m.rootCoord.GetCredential(ctx, req)
+	if err = merr.CheckRPCCall(resp, err); err != nil {
+		return nil, err
+	}
+	state, err = crypto.DecodeCredentialState(resp.GetPassword())
+	if err != nil {
+		return nil, err
+	}
+
+	m.credMut.Lock()
+	defer m.credMut.Unlock()
+	m.credStateMap[username] = state
+	return state, nil
+}
+
 func (m *MetaCache) RemoveCredential(username string) {
 	m.credMut.Lock()
 	defer m.credMut.Unlock()
 	// delete pair in credMap
 	delete(m.credMap, username)
+	delete(m.credStateMap, username)
+	// the api keys of the removed user are dropped by rootcoord as well
+	for id, key := range m.apiKeyMap {
+		if key.Username == username {
+			delete(m.apiKeyMap, id)
+		}
+	}
+}
+
+// GetAPIKey returns the api key related to provided id
+func (m *MetaCache) GetAPIKey(ctx context.Context, id string) (*crypto.APIKey, error) {
+	m.credMut.RLock()
+	key, ok := m.apiKeyMap[id]
+	m.credMut.RUnlock()
+	if ok {
+		return key, nil
+	}
+
+	req := &rootcoordpb.GetCredentialRequest{
+		Base: commonpbutil.NewMsgBase(
+			commonpbutil.WithMsgType(commonpb.MsgType_GetCredential),
+		),
+	}
+	req.Base.Properties = map[string]string{util.APIKeyIDKey: id}
+	resp, err := m.rootCoord.GetCredential(ctx, req)
+	if err = merr.CheckRPCCall(resp, err); err != nil {
+		return nil, err
+	}
+	key, err = crypto.DecodeAPIKey(resp.GetPassword())
+	if err != nil {
+		return nil, err
+	}
+
+	m.credMut.Lock()
+	defer m.credMut.Unlock()
+	m.apiKeyMap[id] = key
+	return key, nil
+}
+
+func (m *MetaCache) RemoveAPIKey(id string) {
+	m.credMut.Lock()
+	defer m.credMut.Unlock()
+	delete(m.apiKeyMap, id)
 }
 
 func (m *MetaCache) UpdateCredential(credInfo *internalpb.CredentialInfo) {
@@ -760,6 +851,8 @@ func (m *MetaCache) UpdateCredential(credInfo *internalpb.CredentialInfo) {
 	// Do not cache encrypted password content
 	m.credMap[username].Username = username
 	m.credMap[username].Sha256Password = credInfo.Sha256Password
+	// the password may be changed, reload the password state
+	delete(m.credStateMap, username)
 }
 
 // GetShards update cache if withCache == false
@@ -816,7 +909,7 @@ func (m *MetaCache) GetShards(ctx context.Context, withCache bool, database, col
 			return retry.Unrecoverable(err2)
 		}
 		return err2
-	})
+	}, retry.CallSite("proxy.GetShardLeaders"))
 	if err != nil {
 		return nil, err
 	}
@@ -39,6 +39,7 @@ import (
 	"github.com/milvus-io/milvus/internal/proto/querypb"
 	"github.com/milvus-io/milvus/internal/proto/rootcoordpb"
 	"github.com/milvus-io/milvus/internal/types"
+	"github.com/milvus-io/milvus/pkg/util"
 	"github.com/milvus-io/milvus/pkg/util/crypto"
 	"github.com/milvus-io/milvus/pkg/util/funcutil"
 	"github.com/milvus-io/milvus/pkg/util/merr"
@@ -603,6 +604,42 @@ func TestMetaCache_ClearShards(t *testing.T) {
 	})
 }
 
+func TestMetaCache_GetCredentialState(t *testing.T) {
+	ctx := context.Background()
+	rc := mocks.NewMockRootCoordClient(t)
+	cache, err := NewMetaCache(rc, nil, nil)
+	require.NoError(t, err)
+
+	password, err := crypto.EncodeCredentialState(&crypto.CredentialState{EncryptedPassword: "pwd", UpdatedTime: 10})
+	require.NoError(t, err)
+	rc.EXPECT().GetCredential(mock.Anything, mock.Anything).RunAndReturn(
+		func(ctx context.Context, req *rootcoordpb.GetCredentialRequest, opts ...grpc.CallOption) (*rootcoordpb.GetCredentialResponse, error) {
+			if req.GetUsername() != "user1" {
+				return &rootcoordpb.GetCredentialResponse{Status: merr.Status(merr.WrapErrParameterInvalidMsg("mock"))}, nil
+			}
+			assert.Equal(t, "true", req.GetBase().GetProperties()[util.CredentialStateKey])
+			return &rootcoordpb.GetCredentialResponse{Status: merr.Success(), Username: "user1", Password: password}, nil
+		}).Times(3)
+
+	state, err := cache.GetCredentialState(ctx, "user1")
+	assert.NoError(t, err)
+	assert.Equal(t, "pwd", state.EncryptedPassword)
+	assert.EqualValues(t, 10, state.UpdatedTime)
+
+	// cached
+	state, err = cache.GetCredentialState(ctx, "user1")
+	assert.NoError(t, err)
+	assert.Equal(t, "pwd", state.EncryptedPassword)
+
+	// the password state is reloaded once the password is updated
+	cache.UpdateCredential(&internalpb.CredentialInfo{Username: "user1", Sha256Password: "sha256"})
+	_, err = cache.GetCredentialState(ctx, "user1")
+	assert.NoError(t, err)
+
+	_, err = cache.GetCredentialState(ctx, "user2")
+	assert.Error(t, err)
+}
+
 func TestMetaCache_PolicyInfo(t *testing.T) {
 	client := &MockRootCoordClientInterface{}
 	qc := &mocks.MockQueryCoordClient{}
@@ -5,6 +5,8 @@ package proxy
 import (
 	context "context"
 
+	crypto "github.com/milvus-io/milvus/pkg/util/crypto"
+
 	internalpb "github.com/milvus-io/milvus/internal/proto/internalpb"
 	mock "github.com/stretchr/testify/mock"
 
@@ -60,6 +62,61 @@ func (_c *MockCache_DeprecateShardCache_Call) RunAndReturn(run func(string, stri
 	return _c
 }
 
+// GetAPIKey provides a mock function with given fields: ctx, id
+func (_m *MockCache) GetAPIKey(ctx context.Context, id string) (*crypto.APIKey, error) {
+	ret := _m.Called(ctx, id)
+
+	var r0 *crypto.APIKey
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) (*crypto.APIKey, error)); ok {
+		return rf(ctx, id)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) *crypto.APIKey); ok {
+		r0 = rf(ctx, id)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*crypto.APIKey)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, id)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockCache_GetAPIKey_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetAPIKey'
+type MockCache_GetAPIKey_Call struct {
+	*mock.Call
+}
+
+// GetAPIKey is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id string
+func (_e *MockCache_Expecter) GetAPIKey(ctx interface{}, id interface{}) *MockCache_GetAPIKey_Call {
+	return &MockCache_GetAPIKey_Call{Call: _e.mock.On("GetAPIKey", ctx, id)}
+}
+
+func (_c *MockCache_GetAPIKey_Call) Run(run func(ctx context.Context, id string)) *MockCache_GetAPIKey_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *MockCache_GetAPIKey_Call) Return(_a0 *crypto.APIKey, _a1 error) *MockCache_GetAPIKey_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockCache_GetAPIKey_Call) RunAndReturn(run func(context.Context, string) (*crypto.APIKey, error)) *MockCache_GetAPIKey_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // GetCollectionID provides a mock function with given fields: ctx, database, collectionName
 func (_m *MockCache) GetCollectionID(ctx context.Context, database string, collectionName string) (int64, error) {
 	ret := _m.Called(ctx, database, collectionName)
@@ -336,6 +393,61 @@ func (_c *MockCache_GetCredentialInfo_Call) RunAndReturn(run func(context.Contex
 	return _c
 }
 
+// GetCredentialState provides a mock function with given fields: ctx, username
+func (_m *MockCache) GetCredentialState(ctx context.Context, username string) (*crypto.CredentialState, error) {
+	ret := _m.Called(ctx, username)
+
+	var r0 *crypto.CredentialState
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) (*crypto.CredentialState, error)); ok {
+		return rf(ctx, username)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) *crypto.CredentialState); ok {
+		r0 = rf(ctx, username)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*crypto.CredentialState)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, username)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockCache_GetCredentialState_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetCredentialState'
+type MockCache_GetCredentialState_Call struct {
+	*mock.Call
+}
+
+// GetCredentialState is a helper method to define mock.On call
+//   - ctx context.Context
+//   - username string
+func (_e *MockCache_Expecter) GetCredentialState(ctx interface{}, username interface{}) *MockCache_GetCredentialState_Call {
+	return &MockCache_GetCredentialState_Call{Call: _e.mock.On("GetCredentialState", ctx, username)}
+}
+
+func (_c *MockCache_GetCredentialState_Call) Run(run func(ctx context.Context, username string)) *MockCache_GetCredentialState_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *MockCache_GetCredentialState_Call) Return(_a0 *crypto.CredentialState, _a1 error) *MockCache_GetCredentialState_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockCache_GetCredentialState_Call) RunAndReturn(run func(context.Context, string) (*crypto.CredentialState, error)) *MockCache_GetCredentialState_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // GetPartitionID provides a mock function with given fields: ctx, database, collectionName, partitionName
 func (_m *MockCache) GetPartitionID(ctx context.Context, database string, collectionName string, partitionName string) (int64, error) {
 	ret := _m.Called(ctx, database, collectionName, partitionName)
@@ -806,6 +918,39 @@ func (_c *MockCache_RemoveCollectionsByID_Call) RunAndReturn(run func(context.Co
 	return _c
 }
 
+// RemoveAPIKey provides a mock function with given fields: id
+func (_m *MockCache) RemoveAPIKey(id string) {
+	_m.Called(id)
+}
+
+// MockCache_RemoveAPIKey_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RemoveAPIKey'
+type MockCache_RemoveAPIKey_Call struct {
+	*mock.Call
+}
+
+// RemoveAPIKey is a helper method to define mock.On call
+//   - id string
+func (_e *MockCache_Expecter) RemoveAPIKey(id interface{}) *MockCache_RemoveAPIKey_Call {
+	return &MockCache_RemoveAPIKey_Call{Call: _e.mock.On("RemoveAPIKey", id)}
+}
+
+func (_c *MockCache_RemoveAPIKey_Call) Run(run func(id string)) *MockCache_RemoveAPIKey_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(string))
+	})
+	return _c
+}
+
+func (_c *MockCache_RemoveAPIKey_Call) Return() *MockCache_RemoveAPIKey_Call {
+	_c.Call.Return()
+	return _c
+}
+
+func (_c *MockCache_RemoveAPIKey_Call) RunAndReturn(run func(string)) *MockCache_RemoveAPIKey_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // RemoveCredential provides a mock function with given fields: username
 func (_m *MockCache) RemoveCredential(username string) {
 	_m.Called(username)
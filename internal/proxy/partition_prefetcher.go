@@ -0,0 +1,310 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package proxy
+
+import (
+	"context"
+	"strconv"
+	"sync"
+	"time"
+
+	"go.uber.org/zap"
+
+	"github.com/milvus-io/milvus-proto/go-api/v2/milvuspb"
+	"github.com/milvus-io/milvus/pkg/log"
+	"github.com/milvus-io/milvus/pkg/util/funcutil"
+	"github.com/milvus-io/milvus/pkg/util/merr"
+	"github.com/milvus-io/milvus/pkg/util/paramtable"
+	"github.com/milvus-io/milvus/pkg/util/typeutil"
+)
+
+const partitionPrefetchCheckInterval = time.Minute
+
+type partitionKey struct {
+	dbName         string
+	collectionName string
+	partitionName  string
+}
+
+type partitionUsage struct {
+	missCount   int
+	windowStart time.Time
+
+	loading    bool
+	prefetched bool
+	size       int64
+	lastAccess time.Time
+}
+
+// partitionPrefetcher tracks the partitions of partially loaded collections accessed while not loaded,
+// loads the ones missed repeatedly within the memory budget, and releases them after idle for the ttl.
+// Only the partitions loaded by the prefetcher of this proxy are released.
+type partitionPrefetcher struct {
+	mu         sync.Mutex
+	partitions map[partitionKey]*partitionUsage
+	usedBudget int64
+
+	load     func(ctx context.Context, key partitionKey) error
+	release  func(ctx context.Context, key partitionKey) error
+	estimate func(ctx context.Context, key partitionKey) (int64, error)
+
+	ctx    context.Context
+	cancel context.CancelFunc
+	wg     sync.WaitGroup
+}
+
+func newPartitionPrefetcher(ctx context.Context, node *Proxy) *partitionPrefetcher {
+	return newPartitionPrefetcherWithFuncs(ctx, node.loadPartition, node.releasePartition, node.estimatePartitionSize)
+}
+
+func newPartitionPrefetcherWithFuncs(ctx context.Context,
+	load func(ctx context.Context, key partitionKey) error,
+	release func(ctx context.Context, key partitionKey) error,
+	estimate func(ctx context.Context, key partitionKey) (int64, error),
+) *partitionPrefetcher {
+	ctx, cancel := context.WithCancel(ctx)
+	return &partitionPrefetcher{
+		partitions: make(map[partitionKey]*partitionUsage),
+		load:       load,
+		release:    release,
+		estimate:   estimate,
+		ctx:        ctx,
+		cancel:     cancel,
+	}
+}
+
+func (p *partitionPrefetcher) start() {
+	p.wg.Add(1)
+	go func() {
+		defer p.wg.Done()
+		ticker := time.NewTicker(partitionPrefetchCheckInterval)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-p.ctx.Done():
+				return
+			case <-ticker.C:
+				p.releaseIdle()
+			}
+		}
+	}()
+}
+
+func (p *partitionPrefetcher) close() {
+	p.cancel()
+	p.wg.Wait()
+}
+
+// recordMiss records the partitions accessed while not loaded, and loads the ones reaching the miss threshold.
+func (p *partitionPrefetcher) recordMiss(dbName, collectionName string, partitionNames []string) {
+	if p == nil || !paramtable.Get().ProxyCfg.PartitionPrefetchEnabled.GetAsBool() {
+		return
+	}
+	threshold := paramtable.Get().ProxyCfg.PartitionPrefetchMissThreshold.GetAsInt()
+	window := paramtable.Get().ProxyCfg.PartitionPrefetchMissWindow.GetAsDuration(time.Second)
+
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	now := time.Now()
+	for _, partitionName := range partitionNames {
+		key := partitionKey{dbName: dbName, collectionName: collectionName, partitionName: partitionName}
+		usage, ok := p.partitions[key]
+		if !ok {
+			usage = &partitionUsage{windowStart: now}
+			p.partitions[key] = usage
+		}
+		if usage.loading || usage.prefetched {
+			continue
+		}
+		if now.Sub(usage.windowStart) > window {
+			usage.windowStart = now
+			usage.missCount = 0
+		}
+		usage.missCount++
+		if usage.missCount >= threshold {
+			usage.loading = true
+			p.wg.Add(1)
+			go p.prefetch(key)
+		}
+	}
+}
+
+// recordAccess refreshes the last access time of the partitions loaded by prefetcher.
+func (p *partitionPrefetcher) recordAccess(dbName, collectionName string, partitionNames []string) {
+	if p == nil || len(partitionNames) == 0 {
+		return
+	}
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	now := time.Now()
+	for _, partitionName := range partitionNames {
+		key := partitionKey{dbName: dbName, collectionName: collectionName, partitionName: partitionName}
+		if usage, ok := p.partitions[key]; ok && usage.prefetched {
+			usage.lastAccess = now
+		}
+	}
+}
+
+func (p *partitionPrefetcher) prefetch(key partitionKey) {
+	defer p.wg.Done()
+	log := log.Ctx(p.ctx).With(zap.String("db", key.dbName),
+		zap.String("collection", key.collectionName),
+		zap.String("partition", key.partitionName))
+
+	size, err := p.estimate(p.ctx, key)
+	if err != nil {
+		log.Warn("failed to estimate partition size, skip prefetch", zap.Error(err))
+		p.reset(key, 0)
+		return
+	}
+
+	budget := paramtable.Get().ProxyCfg.PartitionPrefetchMemoryBudget.GetAsInt64() * 1024 * 1024
+	p.mu.Lock()
+	if p.usedBudget+size > budget {
+		p.mu.Unlock()
+		log.Info("memory budget of partition prefetch exhausted, skip prefetch",
+			zap.Int64("size", size), zap.Int64("usedBudget", p.usedBudget), zap.Int64("budget", budget))
+		p.reset(key, 0)
+		return
+	}
+	p.usedBudget += size
+	p.mu.Unlock()
+
+	if err := p.load(p.ctx, key); err != nil {
+		log.Warn("failed to prefetch partition", zap.Error(err))
+		p.reset(key, size)
+		return
+	}
+
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	if usage, ok := p.partitions[key]; ok {
+		usage.loading = false
+		usage.prefetched = true
+		usage.size = size
+		usage.lastAccess = time.Now()
+	}
+	log.Info("partition prefetched", zap.Int64("size", size), zap.Int64("usedBudget", p.usedBudget))
+}
+
+// reset resets the usage of the partition failed to prefetch, and returns the budget reserved.
+func (p *partitionPrefetcher) reset(key partitionKey, reserved int64) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	p.usedBudget -= reserved
+	if usage, ok := p.partitions[key]; ok {
+		usage.loading = false
+		usage.missCount = 0
+		usage.windowStart = time.Now()
+	}
+}
+
+// releaseIdle releases the partitions loaded by prefetcher and not accessed for the idle ttl.
+func (p *partitionPrefetcher) releaseIdle() {
+	ttl := paramtable.Get().ProxyCfg.PartitionPrefetchIdleTTL.GetAsDuration(time.Second)
+
+	p.mu.Lock()
+	idle := make([]partitionKey, 0)
+	for key, usage := range p.partitions {
+		if usage.prefetched && time.Since(usage.lastAccess) > ttl {
+			idle = append(idle, key)
+		}
+	}
+	p.mu.Unlock()
+
+	for _, key := range idle {
+		if err := p.release(p.ctx, key); err != nil {
+			log.Warn("failed to release idle partition", zap.String("db", key.dbName),
+				zap.String("collection", key.collectionName),
+				zap.String("partition", key.partitionName),
+				zap.Error(err))
+			continue
+		}
+		p.mu.Lock()
+		if usage, ok := p.partitions[key]; ok {
+			p.usedBudget -= usage.size
+			delete(p.partitions, key)
+		}
+		p.mu.Unlock()
+		log.Info("idle partition released", zap.String("db", key.dbName),
+			zap.String("collection", key.collectionName),
+			zap.String("partition", key.partitionName))
+	}
+
+	// forget the partitions missed long ago
+	window := paramtable.Get().ProxyCfg.PartitionPrefetchMissWindow.GetAsDuration(time.Second)
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	for key, usage := range p.partitions {
+		if !usage.prefetched && !usage.loading && time.Since(usage.windowStart) > window {
+			delete(p.partitions, key)
+		}
+	}
+}
+
+// loadPartition loads the partition with the same replica number as the partially loaded collection.
+func (node *Proxy) loadPartition(ctx context.Context, key partitionKey) error {
+	collectionID, err := globalMetaCache.GetCollectionID(ctx, key.dbName, key.collectionName)
+	if err != nil {
+		return err
+	}
+	replicas, err := node.queryCoord.GetReplicas(ctx, &milvuspb.GetReplicasRequest{CollectionID: collectionID})
+	if err = merr.CheckRPCCall(replicas, err); err != nil {
+		return err
+	}
+	status, err := node.LoadPartitions(ctx, &milvuspb.LoadPartitionsRequest{
+		DbName:         key.dbName,
+		CollectionName: key.collectionName,
+		PartitionNames: []string{key.partitionName},
+		ReplicaNumber:  int32(len(replicas.GetReplicas())),
+	})
+	return merr.CheckRPCCall(status, err)
+}
+
+func (node *Proxy) releasePartition(ctx context.Context, key partitionKey) error {
+	status, err := node.ReleasePartitions(ctx, &milvuspb.ReleasePartitionsRequest{
+		DbName:         key.dbName,
+		CollectionName: key.collectionName,
+		PartitionNames: []string{key.partitionName},
+	})
+	return merr.CheckRPCCall(status, err)
+}
+
+// estimatePartitionSize estimates the memory of partition by its row count and the schema of collection.
+func (node *Proxy) estimatePartitionSize(ctx context.Context, key partitionKey) (int64, error) {
+	schema, err := globalMetaCache.GetCollectionSchema(ctx, key.dbName, key.collectionName)
+	if err != nil {
+		return 0, err
+	}
+	sizePerRecord, err := typeutil.EstimateSizePerRecord(schema)
+	if err != nil {
+		return 0, err
+	}
+	resp, err := node.GetPartitionStatistics(ctx, &milvuspb.GetPartitionStatisticsRequest{
+		DbName:         key.dbName,
+		CollectionName: key.collectionName,
+		PartitionName:  key.partitionName,
+	})
+	if err = merr.CheckRPCCall(resp, err); err != nil {
+		return 0, err
+	}
+	rowCount, err := strconv.ParseInt(funcutil.KeyValuePair2Map(resp.GetStats())["row_count"], 10, 64)
+	if err != nil {
+		return 0, err
+	}
+	return rowCount * int64(sizePerRecord), nil
+}
@@ -0,0 +1,178 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package proxy
+
+import (
+	"context"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/cockroachdb/errors"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/milvus-io/milvus/pkg/util/paramtable"
+)
+
+type fakePartitionLoader struct {
+	mu       sync.Mutex
+	loaded   map[partitionKey]struct{}
+	released []partitionKey
+	sizes    map[string]int64
+	loadErr  error
+}
+
+func newFakePartitionLoader() *fakePartitionLoader {
+	return &fakePartitionLoader{
+		loaded: make(map[partitionKey]struct{}),
+		sizes:  make(map[string]int64),
+	}
+}
+
+func (l *fakePartitionLoader) load(ctx context.Context, key partitionKey) error {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	if l.loadErr != nil {
+		return l.loadErr
+	}
+	l.loaded[key] = struct{}{}
+	return nil
+}
+
+func (l *fakePartitionLoader) release(ctx context.Context, key partitionKey) error {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	delete(l.loaded, key)
+	l.released = append(l.released, key)
+	return nil
+}
+
+func (l *fakePartitionLoader) estimate(ctx context.Context, key partitionKey) (int64, error) {
+	return l.sizes[key.partitionName], nil
+}
+
+func (l *fakePartitionLoader) isLoaded(key partitionKey) bool {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	_, ok := l.loaded[key]
+	return ok
+}
+
+func TestPartitionPrefetcher(t *testing.T) {
+	paramtable.Init()
+	params := paramtable.Get()
+	params.Save(params.ProxyCfg.PartitionPrefetchEnabled.Key, "true")
+	params.Save(params.ProxyCfg.PartitionPrefetchMissThreshold.Key, "2")
+	params.Save(params.ProxyCfg.PartitionPrefetchMemoryBudget.Key, "1")
+	defer params.Reset(params.ProxyCfg.PartitionPrefetchEnabled.Key)
+	defer params.Reset(params.ProxyCfg.PartitionPrefetchMissThreshold.Key)
+	defer params.Reset(params.ProxyCfg.PartitionPrefetchMemoryBudget.Key)
+
+	keyOf := func(partition string) partitionKey {
+		return partitionKey{dbName: "db", collectionName: "coll", partitionName: partition}
+	}
+
+	t.Run("nil prefetcher", func(t *testing.T) {
+		var p *partitionPrefetcher
+		p.recordMiss("db", "coll", []string{"p1"})
+		p.recordAccess("db", "coll", []string{"p1"})
+	})
+
+	t.Run("prefetch after threshold", func(t *testing.T) {
+		l := newFakePartitionLoader()
+		l.sizes["p1"] = 512 * 1024
+		p := newPartitionPrefetcherWithFuncs(context.Background(), l.load, l.release, l.estimate)
+		defer p.close()
+
+		p.recordMiss("db", "coll", []string{"p1"})
+		p.wg.Wait()
+		assert.False(t, l.isLoaded(keyOf("p1")))
+
+		p.recordMiss("db", "coll", []string{"p1"})
+		p.wg.Wait()
+		assert.True(t, l.isLoaded(keyOf("p1")))
+		assert.EqualValues(t, 512*1024, p.usedBudget)
+	})
+
+	t.Run("exceed budget", func(t *testing.T) {
+		l := newFakePartitionLoader()
+		l.sizes["p1"] = 768 * 1024
+		l.sizes["p2"] = 768 * 1024
+		p := newPartitionPrefetcherWithFuncs(context.Background(), l.load, l.release, l.estimate)
+		defer p.close()
+
+		p.recordMiss("db", "coll", []string{"p1", "p2"})
+		p.recordMiss("db", "coll", []string{"p1"})
+		p.wg.Wait()
+		p.recordMiss("db", "coll", []string{"p2"})
+		p.wg.Wait()
+		assert.True(t, l.isLoaded(keyOf("p1")))
+		assert.False(t, l.isLoaded(keyOf("p2")))
+		assert.EqualValues(t, 768*1024, p.usedBudget)
+	})
+
+	t.Run("load failed", func(t *testing.T) {
+		l := newFakePartitionLoader()
+		l.loadErr = errors.New("mock")
+		p := newPartitionPrefetcherWithFuncs(context.Background(), l.load, l.release, l.estimate)
+		defer p.close()
+
+		p.recordMiss("db", "coll", []string{"p1"})
+		p.recordMiss("db", "coll", []string{"p1"})
+		p.wg.Wait()
+		assert.EqualValues(t, 0, p.usedBudget)
+		assert.Equal(t, 0, p.partitions[keyOf("p1")].missCount)
+		assert.False(t, p.partitions[keyOf("p1")].loading)
+	})
+
+	t.Run("release idle", func(t *testing.T) {
+		params.Save(params.ProxyCfg.PartitionPrefetchIdleTTL.Key, "1")
+		defer params.Reset(params.ProxyCfg.PartitionPrefetchIdleTTL.Key)
+
+		l := newFakePartitionLoader()
+		l.sizes["p1"] = 1024
+		l.sizes["p2"] = 1024
+		p := newPartitionPrefetcherWithFuncs(context.Background(), l.load, l.release, l.estimate)
+		defer p.close()
+
+		p.recordMiss("db", "coll", []string{"p1", "p2"})
+		p.recordMiss("db", "coll", []string{"p1", "p2"})
+		p.wg.Wait()
+		assert.EqualValues(t, 2048, p.usedBudget)
+
+		p.partitions[keyOf("p1")].lastAccess = time.Now().Add(-2 * time.Second)
+		p.recordAccess("db", "coll", []string{"p2"})
+		p.releaseIdle()
+		assert.Equal(t, []partitionKey{keyOf("p1")}, l.released)
+		assert.EqualValues(t, 1024, p.usedBudget)
+		assert.NotContains(t, p.partitions, keyOf("p1"))
+	})
+
+	t.Run("disabled", func(t *testing.T) {
+		params.Save(params.ProxyCfg.PartitionPrefetchEnabled.Key, "false")
+		defer params.Save(params.ProxyCfg.PartitionPrefetchEnabled.Key, "true")
+
+		l := newFakePartitionLoader()
+		p := newPartitionPrefetcherWithFuncs(context.Background(), l.load, l.release, l.estimate)
+		defer p.close()
+
+		p.recordMiss("db", "coll", []string{"p1"})
+		p.recordMiss("db", "coll", []string{"p1"})
+		p.wg.Wait()
+		assert.Empty(t, p.partitions)
+	})
+}
@@ -33,15 +33,33 @@ r = sub, obj, act
 [policy_definition]
 p = sub, obj, act
 
+[role_definition]
+g = _, _
+g2 = _, _
+
 [policy_effect]
 e = some(where (p.eft == allow))
 
 [matchers]
-m = r.sub == p.sub && globMatch(r.obj, p.obj) && globMatch(r.act, p.act) || r.sub == "admin" || (r.sub == p.sub && dbMatch(r.obj, p.obj) && p.act == "PrivilegeAll")
+m = g(r.sub, p.sub) && globMatch(r.obj, p.obj) && (globMatch(r.act, p.act) || g2(r.act, p.act)) || g(r.sub, "admin") || (g(r.sub, p.sub) && dbMatch(r.obj, p.obj) && p.act == "PrivilegeAll")
 `
 )
 
-var templateModel = getPolicyModel(ModelStr)
+var (
+	templateModel = getPolicyModel(ModelStr)
+	// the policies that the privileges belong to the privilege groups, the g2 in the model
+	privilegeGroupPolicyInfo = getPrivilegeGroupPolicyInfo()
+)
+
+func getPrivilegeGroupPolicyInfo() string {
+	policies := make([]string, 0)
+	for group, privileges := range util.PrivilegeGroups {
+		for _, privilege := range privileges {
+			policies = append(policies, funcutil.PolicyForPrivilegeGroup(util.PrivilegeWord+privilege, util.PrivilegeWord+group))
+		}
+	}
+	return strings.Join(policies, ",")
+}
 
 func getPolicyModel(modelString string) model.Model {
 	m, err := model.NewModelFromString(modelString)
@@ -106,7 +124,10 @@ func PrivilegeInterceptor(ctx context.Context, req interface{}) (context.Context
 		zap.String("policy_info", policyInfo))
 
 	policy := fmt.Sprintf("[%s]", policyInfo)
-	b := []byte(policy)
+	b := []byte(fmt.Sprintf("[%s]", privilegeGroupPolicyInfo))
+	if policyInfo != "" {
+		b = []byte(fmt.Sprintf("[%s,%s]", policyInfo, privilegeGroupPolicyInfo))
+	}
 	a := jsonadapter.NewAdapter(&b)
 	// the `templateModel` object isn't safe in the concurrent situation
 	casbinModel := templateModel.Copy()
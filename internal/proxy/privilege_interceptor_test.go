@@ -11,6 +11,7 @@ import (
 	"github.com/milvus-io/milvus-proto/go-api/v2/milvuspb"
 	"github.com/milvus-io/milvus/internal/mocks"
 	"github.com/milvus-io/milvus/internal/proto/internalpb"
+	"github.com/milvus-io/milvus/pkg/util"
 	"github.com/milvus-io/milvus/pkg/util/funcutil"
 	"github.com/milvus-io/milvus/pkg/util/merr"
 	"github.com/milvus-io/milvus/pkg/util/paramtable"
@@ -221,3 +222,57 @@ func TestResourceGroupPrivilege(t *testing.T) {
 		assert.NoError(t, err)
 	})
 }
+
+func TestPrivilegeGroupAndRoleInheritance(t *testing.T) {
+	paramtable.Get().Save(Params.CommonCfg.AuthorizationEnabled.Key, "true")
+	defer paramtable.Get().Reset(Params.CommonCfg.AuthorizationEnabled.Key)
+
+	client := &MockRootCoordClientInterface{}
+	queryCoord := &mocks.MockQueryCoordClient{}
+	mgr := newShardClientMgr()
+	client.listPolicy = func(ctx context.Context, in *internalpb.ListPolicyRequest) (*internalpb.ListPolicyResponse, error) {
+		return &internalpb.ListPolicyResponse{
+			Status: merr.Success(),
+			PolicyInfos: []string{
+				funcutil.PolicyForPrivilege("reader", commonpb.ObjectType_Collection.String(), "col1", util.PrivilegeWord+util.PrivilegeGroupReadOnly, "default"),
+				funcutil.PolicyForPrivilege("writer", commonpb.ObjectType_Collection.String(), "*", util.PrivilegeWord+util.PrivilegeGroupReadWrite, "default"),
+				funcutil.PolicyForRoleInheritance("team", "reader"),
+				funcutil.PolicyForRoleInheritance("ops", "admin"),
+			},
+			UserRoles: []string{
+				funcutil.EncodeUserRoleCache("alice", "reader"),
+				funcutil.EncodeUserRoleCache("bob", "writer"),
+				funcutil.EncodeUserRoleCache("carol", "team"),
+				funcutil.EncodeUserRoleCache("dave", "ops"),
+			},
+		}, nil
+	}
+	err := InitMetaCache(context.Background(), client, queryCoord, mgr)
+	assert.NoError(t, err)
+
+	check := func(user string, req interface{}) error {
+		_, err := PrivilegeInterceptor(GetContext(context.Background(), user+":123456"), req)
+		return err
+	}
+
+	t.Run("privilege group", func(t *testing.T) {
+		assert.NoError(t, check("alice", &milvuspb.QueryRequest{CollectionName: "col1"}))
+		assert.NoError(t, check("alice", &milvuspb.SearchRequest{CollectionName: "col1"}))
+		assert.Error(t, check("alice", &milvuspb.QueryRequest{CollectionName: "col2"}))
+		assert.Error(t, check("alice", &milvuspb.InsertRequest{CollectionName: "col1"}))
+		// the global privileges of group not granted by the collection grant
+		assert.Error(t, check("alice", &milvuspb.ShowCollectionsRequest{}))
+
+		assert.NoError(t, check("bob", &milvuspb.InsertRequest{CollectionName: "col2"}))
+		assert.NoError(t, check("bob", &milvuspb.QueryRequest{CollectionName: "col2"}))
+		assert.Error(t, check("bob", &milvuspb.CreateCollectionRequest{CollectionName: "col2"}))
+	})
+
+	t.Run("role inheritance", func(t *testing.T) {
+		assert.NoError(t, check("carol", &milvuspb.QueryRequest{CollectionName: "col1"}))
+		assert.Error(t, check("carol", &milvuspb.InsertRequest{CollectionName: "col1"}))
+
+		assert.NoError(t, check("dave", &milvuspb.CreateCollectionRequest{CollectionName: "col2"}))
+		assert.NoError(t, check("dave", &milvuspb.InsertRequest{CollectionName: "col2"}))
+	})
+}
@@ -35,6 +35,7 @@ import (
 	"github.com/milvus-io/milvus/internal/allocator"
 	"github.com/milvus-io/milvus/internal/proto/internalpb"
 	"github.com/milvus-io/milvus/internal/proxy/accesslog"
+	"github.com/milvus-io/milvus/internal/proxy/auditlog"
 	"github.com/milvus-io/milvus/internal/types"
 	"github.com/milvus-io/milvus/internal/util/dependency"
 	"github.com/milvus-io/milvus/internal/util/sessionutil"
@@ -119,6 +120,12 @@ type Proxy struct {
 	// resource manager
 	resourceManager        resource.Manager
 	replicateStreamManager *ReplicateStreamManager
+
+	// for loading the hot partitions of partially loaded collections
+	partitionPrefetcher *partitionPrefetcher
+
+	// for detecting the conflicts of partial upserts
+	upsertTracker *upsertConflictTracker
 }
 
 // NewProxy returns a Proxy struct.
@@ -141,6 +148,7 @@ func NewProxy(ctx context.Context, factory dependency.Factory) (*Proxy, error) {
 		lbPolicy:               lbPolicy,
 		resourceManager:        resourceManager,
 		replicateStreamManager: replicateStreamManager,
+		upsertTracker:          newUpsertConflictTracker(),
 	}
 	node.UpdateStateCode(commonpb.StateCode_Abnormal)
 	logutil.Logger(ctx).Debug("create a new Proxy instance", zap.Any("state", node.stateCode.Load()))
@@ -220,6 +228,9 @@ func (node *Proxy) Init() error {
 	accesslog.SetupAccseeLog(&Params.ProxyCfg.AccessLog, &Params.MinioCfg)
 	log.Debug("init access log for Proxy done")
 
+	auditlog.SetupAuditLog(&Params.ProxyCfg.AuditLog, &Params.KafkaCfg)
+	log.Debug("init audit log for Proxy done")
+
 	err := node.initRateCollector()
 	if err != nil {
 		return err
@@ -408,6 +419,9 @@ func (node *Proxy) Start() error {
 
 	node.sendChannelsTimeTickLoop()
 
+	node.partitionPrefetcher = newPartitionPrefetcher(node.ctx, node)
+	node.partitionPrefetcher.start()
+
 	// Start callbacks
 	for _, cb := range node.startCallbacks {
 		cb()
@@ -446,6 +460,11 @@ func (node *Proxy) Stop() error {
 		log.Info("close channels time ticker", zap.String("role", typeutil.ProxyRole))
 	}
 
+	if node.partitionPrefetcher != nil {
+		node.partitionPrefetcher.close()
+		log.Info("close partition prefetcher", zap.String("role", typeutil.ProxyRole))
+	}
+
 	node.wg.Wait()
 
 	for _, cb := range node.closeCallbacks {
@@ -477,6 +496,8 @@ func (node *Proxy) Stop() error {
 
 	GetConnectionManager().stop()
 
+	auditlog.Close()
+
 	return nil
 }
 
@@ -0,0 +1,154 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package proxy
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"time"
+
+	"go.uber.org/zap"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+
+	"github.com/milvus-io/milvus-proto/go-api/v2/schemapb"
+	"github.com/milvus-io/milvus/pkg/log"
+	"github.com/milvus-io/milvus/pkg/util/merr"
+	"github.com/milvus-io/milvus/pkg/util/tsoutil"
+	"github.com/milvus-io/milvus/pkg/util/typeutil"
+)
+
+const (
+	// QueryIteratorCursorHeader is the grpc response header carrying the cursor of the next page.
+	QueryIteratorCursorHeader = "iterator-cursor"
+	// QueryIteratorChunkedHeader tells the client the page is cut by size,
+	// the following pages may have more entities even if the page has less entities than limit.
+	QueryIteratorChunkedHeader = "iterator-chunked"
+)
+
+// queryCursor is the position of the query iterator, the pages are read from the snapshot at Ts,
+// and the next page starts from the primary key greater than the last one returned.
+type queryCursor struct {
+	Ts    uint64  `json:"ts"`
+	IntPK *int64  `json:"int_pk,omitempty"`
+	StrPK *string `json:"str_pk,omitempty"`
+}
+
+func (c *queryCursor) encode() string {
+	bs, _ := json.Marshal(c)
+	return base64.RawURLEncoding.EncodeToString(bs)
+}
+
+func decodeQueryCursor(token string) (*queryCursor, error) {
+	bs, err := base64.RawURLEncoding.DecodeString(token)
+	if err != nil {
+		return nil, merr.WrapErrParameterInvalidMsg("invalid %s: %s", IteratorCursorKey, err.Error())
+	}
+	cursor := &queryCursor{}
+	if err := json.Unmarshal(bs, cursor); err != nil {
+		return nil, merr.WrapErrParameterInvalidMsg("invalid %s: %s", IteratorCursorKey, err.Error())
+	}
+	if cursor.Ts == 0 {
+		return nil, merr.WrapErrParameterInvalidMsg("invalid %s: snapshot timestamp not set", IteratorCursorKey)
+	}
+	return cursor, nil
+}
+
+// validate checks the snapshot of cursor is still readable at tMax.
+func (c *queryCursor) validate(tMax typeutil.Timestamp) error {
+	if c.Ts > tMax {
+		return merr.WrapErrParameterInvalidMsg("iterator snapshot timestamp %d is later than current timestamp %d", c.Ts, tMax)
+	}
+	ttl := Params.ProxyCfg.QueryIteratorSnapshotTTL.GetAsDuration(time.Second)
+	if ttl > 0 && tsoutil.AddPhysicalDurationOnTs(c.Ts, ttl) < tMax {
+		return merr.WrapErrParameterInvalidMsg("iterator cursor expired, the snapshot is older than %v", ttl)
+	}
+	return nil
+}
+
+// cursorExpr returns the expression filtering the entities after the cursor,
+// returns the original expression if the cursor has no primary key, which is the first page.
+func cursorExpr(expr string, pkField *schemapb.FieldSchema, cursor *queryCursor) (string, error) {
+	var cond string
+	switch pkField.GetDataType() {
+	case schemapb.DataType_Int64:
+		if cursor.IntPK == nil {
+			if cursor.StrPK != nil {
+				return "", merr.WrapErrParameterInvalidMsg("iterator cursor mismatches the primary key type %s", pkField.GetDataType().String())
+			}
+			return expr, nil
+		}
+		cond = fmt.Sprintf("%s > %d", pkField.GetName(), *cursor.IntPK)
+	case schemapb.DataType_VarChar:
+		if cursor.StrPK == nil {
+			if cursor.IntPK != nil {
+				return "", merr.WrapErrParameterInvalidMsg("iterator cursor mismatches the primary key type %s", pkField.GetDataType().String())
+			}
+			return expr, nil
+		}
+		cond = fmt.Sprintf("%s > %s", pkField.GetName(), strconv.Quote(*cursor.StrPK))
+	default:
+		return "", merr.WrapErrParameterInvalidMsg("unsupported primary key type %s", pkField.GetDataType().String())
+	}
+	if expr == "" {
+		return cond, nil
+	}
+	return fmt.Sprintf("(%s) and %s", expr, cond), nil
+}
+
+// nextQueryCursor returns the cursor after the last entity of the page,
+// the cursor stays unchanged if the page is empty.
+func nextQueryCursor(fieldsData []*schemapb.FieldData, pkField *schemapb.FieldSchema, cursor *queryCursor) *queryCursor {
+	next := &queryCursor{Ts: cursor.Ts, IntPK: cursor.IntPK, StrPK: cursor.StrPK}
+	for _, fieldData := range fieldsData {
+		if fieldData.GetFieldId() != pkField.GetFieldID() {
+			continue
+		}
+		switch pkField.GetDataType() {
+		case schemapb.DataType_Int64:
+			data := fieldData.GetScalars().GetLongData().GetData()
+			if len(data) > 0 {
+				pk := data[len(data)-1]
+				next.IntPK = &pk
+			}
+		case schemapb.DataType_VarChar:
+			data := fieldData.GetScalars().GetStringData().GetData()
+			if len(data) > 0 {
+				pk := data[len(data)-1]
+				next.StrPK = &pk
+			}
+		}
+	}
+	return next
+}
+
+// setQueryIteratorHeader sends the cursor of the next page by the grpc response header.
+func setQueryIteratorHeader(ctx context.Context, token string, chunked bool) {
+	if token == "" {
+		return
+	}
+	header := metadata.Pairs(QueryIteratorCursorHeader, token)
+	if chunked {
+		header.Set(QueryIteratorChunkedHeader, "true")
+	}
+	if err := grpc.SetHeader(ctx, header); err != nil {
+		log.Ctx(ctx).Warn("failed to set query iterator cursor header", zap.Error(err))
+	}
+}
@@ -0,0 +1,137 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package proxy
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/milvus-io/milvus-proto/go-api/v2/commonpb"
+	"github.com/milvus-io/milvus-proto/go-api/v2/schemapb"
+	"github.com/milvus-io/milvus/pkg/util/tsoutil"
+)
+
+func TestQueryCursor_encode(t *testing.T) {
+	pk := int64(100)
+	cursor := &queryCursor{Ts: 1000, IntPK: &pk}
+	decoded, err := decodeQueryCursor(cursor.encode())
+	require.NoError(t, err)
+	assert.Equal(t, cursor, decoded)
+
+	_, err = decodeQueryCursor("!!!")
+	assert.Error(t, err)
+	_, err = decodeQueryCursor((&queryCursor{}).encode())
+	assert.Error(t, err)
+}
+
+func TestQueryCursor_validate(t *testing.T) {
+	now := tsoutil.ComposeTSByTime(time.Now(), 0)
+	assert.NoError(t, (&queryCursor{Ts: now}).validate(now))
+	assert.Error(t, (&queryCursor{Ts: now + 1}).validate(now))
+
+	expired := tsoutil.ComposeTSByTime(time.Now().Add(-2*time.Hour), 0)
+	assert.Error(t, (&queryCursor{Ts: expired}).validate(now))
+}
+
+func TestCursorExpr(t *testing.T) {
+	intPK := int64(10)
+	strPK := `a"b`
+	int64Field := &schemapb.FieldSchema{Name: "id", DataType: schemapb.DataType_Int64}
+	varcharField := &schemapb.FieldSchema{Name: "id", DataType: schemapb.DataType_VarChar}
+
+	expr, err := cursorExpr("a > 1", int64Field, &queryCursor{Ts: 1})
+	assert.NoError(t, err)
+	assert.Equal(t, "a > 1", expr)
+
+	expr, err = cursorExpr("a > 1", int64Field, &queryCursor{Ts: 1, IntPK: &intPK})
+	assert.NoError(t, err)
+	assert.Equal(t, "(a > 1) and id > 10", expr)
+
+	expr, err = cursorExpr("", varcharField, &queryCursor{Ts: 1, StrPK: &strPK})
+	assert.NoError(t, err)
+	assert.Equal(t, `id > "a\"b"`, expr)
+
+	_, err = cursorExpr("", varcharField, &queryCursor{Ts: 1, IntPK: &intPK})
+	assert.Error(t, err)
+	_, err = cursorExpr("", int64Field, &queryCursor{Ts: 1, StrPK: &strPK})
+	assert.Error(t, err)
+}
+
+func TestNextQueryCursor(t *testing.T) {
+	pkField := &schemapb.FieldSchema{FieldID: 100, Name: "id", DataType: schemapb.DataType_Int64}
+	fieldsData := []*schemapb.FieldData{
+		{
+			FieldId: 100,
+			Field: &schemapb.FieldData_Scalars{
+				Scalars: &schemapb.ScalarField{
+					Data: &schemapb.ScalarField_LongData{LongData: &schemapb.LongArray{Data: []int64{1, 5, 9}}},
+				},
+			},
+		},
+	}
+	next := nextQueryCursor(fieldsData, pkField, &queryCursor{Ts: 1000})
+	assert.EqualValues(t, 1000, next.Ts)
+	assert.EqualValues(t, 9, *next.IntPK)
+
+	// empty page keeps the cursor
+	next = nextQueryCursor(nil, pkField, next)
+	assert.EqualValues(t, 9, *next.IntPK)
+}
+
+func TestParseQueryParams_iterator(t *testing.T) {
+	pk := int64(10)
+	cursor := &queryCursor{Ts: 1000, IntPK: &pk}
+
+	params, err := parseQueryParams([]*commonpb.KeyValuePair{
+		{Key: IteratorKey, Value: "true"},
+		{Key: LimitKey, Value: "10"},
+	})
+	assert.NoError(t, err)
+	assert.True(t, params.iterator)
+	assert.Nil(t, params.cursor)
+	assert.Equal(t, Params.ProxyCfg.QueryIteratorMaxChunkSize.GetAsInt64(), params.chunkSize)
+
+	params, err = parseQueryParams([]*commonpb.KeyValuePair{
+		{Key: IteratorCursorKey, Value: cursor.encode()},
+		{Key: LimitKey, Value: "10"},
+	})
+	assert.NoError(t, err)
+	assert.True(t, params.iterator)
+	assert.Equal(t, cursor, params.cursor)
+
+	// limit is required
+	_, err = parseQueryParams([]*commonpb.KeyValuePair{
+		{Key: IteratorKey, Value: "true"},
+	})
+	assert.Error(t, err)
+
+	// offset is not allowed
+	_, err = parseQueryParams([]*commonpb.KeyValuePair{
+		{Key: IteratorKey, Value: "true"},
+		{Key: LimitKey, Value: "10"},
+		{Key: OffsetKey, Value: "10"},
+	})
+	assert.Error(t, err)
+
+	_, err = parseQueryParams([]*commonpb.KeyValuePair{
+		{Key: IteratorKey, Value: "invalid"},
+	})
+	assert.Error(t, err)
+}
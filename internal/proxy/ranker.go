@@ -0,0 +1,198 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package proxy
+
+import (
+	"fmt"
+	"math"
+	"plugin"
+	"strings"
+	"sync"
+
+	"go.uber.org/zap"
+
+	"github.com/milvus-io/milvus/pkg/log"
+	"github.com/milvus-io/milvus/pkg/util/merr"
+	"github.com/milvus-io/milvus/pkg/util/metric"
+	"github.com/milvus-io/milvus/pkg/util/typeutil"
+)
+
+const (
+	// RRFRankerName fuses the results by reciprocal rank fusion, the score of an entity is sum(1 / (k + rank)).
+	RRFRankerName = "rrf"
+	// WeightedRankerName fuses the results by the weighted sum of the normalized scores.
+	WeightedRankerName = "weighted"
+
+	rrfParamK            = "k"
+	rrfDefaultK          = 60
+	weightedParamWeights = "weights"
+	rankerPluginSymbol   = "MilvusRanker"
+)
+
+// RankHits are the hits of one ann search request of a hybrid search for one query, sorted by relevance.
+type RankHits struct {
+	// MetricType is the metric type of the ann search request, the scores are taken as L2 distances if empty.
+	MetricType string
+	IDs        []interface{}
+	Scores     []float32
+}
+
+// Ranker fuses the hits of the ann search requests of a hybrid search.
+type Ranker interface {
+	Name() string
+	// Fuse returns the fused scores of the ids hit by the requests for one query, the higher the more relevant.
+	Fuse(hits []*RankHits) map[interface{}]float32
+}
+
+// RankerFactory creates a ranker with params for a hybrid search of requestNum ann search requests.
+type RankerFactory func(params map[string]interface{}, requestNum int) (Ranker, error)
+
+// RankerPlugin is the custom ranker provided by the go plugin configured in proxy.hybridSearch.rankerPlugin,
+// the plugin exports it by the symbol MilvusRanker.
+type RankerPlugin interface {
+	Name() string
+	NewRanker(params map[string]interface{}, requestNum int) (Ranker, error)
+}
+
+var (
+	rankerFactories      = typeutil.NewConcurrentMap[string, RankerFactory]()
+	loadRankerPluginOnce sync.Once
+)
+
+func init() {
+	RegisterRanker(RRFRankerName, newRRFRanker)
+	RegisterRanker(WeightedRankerName, newWeightedRanker)
+}
+
+// RegisterRanker registers the factory of ranker name, the registered one of the same name is replaced.
+func RegisterRanker(name string, factory RankerFactory) {
+	rankerFactories.Insert(name, factory)
+}
+
+// NewRanker creates the ranker of name for a hybrid search of requestNum ann search requests.
+func NewRanker(name string, params map[string]interface{}, requestNum int) (Ranker, error) {
+	loadRankerPluginOnce.Do(func() {
+		if err := loadRankerPlugin(Params.ProxyCfg.HybridSearchRankerPlugin.GetValue()); err != nil {
+			log.Warn("fail to load ranker plugin", zap.Error(err))
+		}
+	})
+	factory, ok := rankerFactories.Get(name)
+	if !ok {
+		return nil, merr.WrapErrParameterInvalidMsg("unknown ranker %s", name)
+	}
+	return factory(params, requestNum)
+}
+
+func loadRankerPlugin(path string) error {
+	if path == "" {
+		return nil
+	}
+	p, err := plugin.Open(path)
+	if err != nil {
+		return fmt.Errorf("fail to open the ranker plugin %s, error: %s", path, err.Error())
+	}
+	symbol, err := p.Lookup(rankerPluginSymbol)
+	if err != nil {
+		return fmt.Errorf("fail to find the '%s' object in the ranker plugin, error: %s", rankerPluginSymbol, err.Error())
+	}
+	rp, ok := symbol.(RankerPlugin)
+	if !ok {
+		return fmt.Errorf("fail to convert the '%s' object to RankerPlugin", rankerPluginSymbol)
+	}
+	RegisterRanker(rp.Name(), rp.NewRanker)
+	log.Info("ranker plugin loaded", zap.String("path", path), zap.String("ranker", rp.Name()))
+	return nil
+}
+
+type rrfRanker struct {
+	k float64
+}
+
+func newRRFRanker(params map[string]interface{}, requestNum int) (Ranker, error) {
+	k := float64(rrfDefaultK)
+	if v, ok := params[rrfParamK]; ok {
+		f, ok := v.(float64)
+		if !ok || f <= 0 || f >= 16384 {
+			return nil, merr.WrapErrParameterInvalidMsg("%s of rrf ranker should be a number in range (0, 16384), but got %v", rrfParamK, v)
+		}
+		k = f
+	}
+	return &rrfRanker{k: k}, nil
+}
+
+func (r *rrfRanker) Name() string {
+	return RRFRankerName
+}
+
+func (r *rrfRanker) Fuse(hits []*RankHits) map[interface{}]float32 {
+	scores := make(map[interface{}]float32)
+	for _, h := range hits {
+		for rank, id := range h.IDs {
+			scores[id] += float32(1 / (r.k + float64(rank+1)))
+		}
+	}
+	return scores
+}
+
+type weightedRanker struct {
+	weights []float64
+}
+
+func newWeightedRanker(params map[string]interface{}, requestNum int) (Ranker, error) {
+	v, ok := params[weightedParamWeights].([]interface{})
+	if !ok || len(v) != requestNum {
+		return nil, merr.WrapErrParameterInvalidMsg("%s of weighted ranker should be a list of %d numbers, but got %v", weightedParamWeights, requestNum, params[weightedParamWeights])
+	}
+	weights := make([]float64, 0, len(v))
+	for _, w := range v {
+		f, ok := w.(float64)
+		if !ok || f < 0 || f > 1 {
+			return nil, merr.WrapErrParameterInvalidMsg("weight of weighted ranker should be a number in range [0, 1], but got %v", w)
+		}
+		weights = append(weights, f)
+	}
+	return &weightedRanker{weights: weights}, nil
+}
+
+func (r *weightedRanker) Name() string {
+	return WeightedRankerName
+}
+
+func (r *weightedRanker) Fuse(hits []*RankHits) map[interface{}]float32 {
+	scores := make(map[interface{}]float32)
+	for i, h := range hits {
+		for j, id := range h.IDs {
+			scores[id] += float32(r.weights[i] * normalizeScore(h.MetricType, h.Scores[j]))
+		}
+	}
+	return scores
+}
+
+// normalizeScore maps the score of metricType into [0, 1], the higher the more relevant,
+// so that the scores of different metric types are comparable.
+func normalizeScore(metricType string, score float32) float64 {
+	s := float64(score)
+	switch strings.ToUpper(metricType) {
+	case metric.COSINE:
+		return (1 + s) / 2
+	case metric.IP:
+		return 0.5 + math.Atan(s)/math.Pi
+	default:
+		// the distances, the smaller the more relevant
+		return 1 - 2*math.Atan(s)/math.Pi
+	}
+}
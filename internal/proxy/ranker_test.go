@@ -0,0 +1,140 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package proxy
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/milvus-io/milvus-proto/go-api/v2/commonpb"
+	"github.com/milvus-io/milvus-proto/go-api/v2/milvuspb"
+	"github.com/milvus-io/milvus-proto/go-api/v2/schemapb"
+	"github.com/milvus-io/milvus/pkg/common"
+	"github.com/milvus-io/milvus/pkg/util/merr"
+	"github.com/milvus-io/milvus/pkg/util/metric"
+	"github.com/milvus-io/milvus/pkg/util/paramtable"
+)
+
+func TestNewRanker(t *testing.T) {
+	paramtable.Init()
+
+	r, err := NewRanker(RRFRankerName, nil, 2)
+	assert.NoError(t, err)
+	assert.Equal(t, float64(rrfDefaultK), r.(*rrfRanker).k)
+	_, err = NewRanker(RRFRankerName, map[string]interface{}{"k": "foo"}, 2)
+	assert.ErrorIs(t, err, merr.ErrParameterInvalid)
+
+	r, err = NewRanker(WeightedRankerName, map[string]interface{}{"weights": []interface{}{0.2, 0.8}}, 2)
+	assert.NoError(t, err)
+	assert.Equal(t, []float64{0.2, 0.8}, r.(*weightedRanker).weights)
+	_, err = NewRanker(WeightedRankerName, map[string]interface{}{"weights": []interface{}{0.2}}, 2)
+	assert.ErrorIs(t, err, merr.ErrParameterInvalid)
+	_, err = NewRanker(WeightedRankerName, map[string]interface{}{"weights": []interface{}{0.2, 2.0}}, 2)
+	assert.ErrorIs(t, err, merr.ErrParameterInvalid)
+
+	_, err = NewRanker("foo", nil, 2)
+	assert.ErrorIs(t, err, merr.ErrParameterInvalid)
+
+	// custom ranker
+	RegisterRanker("foo", newRRFRanker)
+	defer rankerFactories.Remove("foo")
+	_, err = NewRanker("foo", nil, 2)
+	assert.NoError(t, err)
+}
+
+func TestRanker_Fuse(t *testing.T) {
+	hits := []*RankHits{
+		{MetricType: metric.IP, IDs: []interface{}{int64(1), int64(2)}, Scores: []float32{10, 1}},
+		{MetricType: metric.L2, IDs: []interface{}{int64(2), int64(3)}, Scores: []float32{0, 10}},
+	}
+
+	scores := (&rrfRanker{k: 60}).Fuse(hits)
+	assert.Len(t, scores, 3)
+	assert.InDelta(t, 1.0/61+1.0/61, scores[int64(2)], 1e-6)
+	assert.Greater(t, scores[int64(2)], scores[int64(1)])
+	assert.Greater(t, scores[int64(1)], scores[int64(3)])
+
+	scores = (&weightedRanker{weights: []float64{1, 0}}).Fuse(hits)
+	assert.Greater(t, scores[int64(1)], scores[int64(2)])
+	assert.Zero(t, scores[int64(3)])
+}
+
+func TestNormalizeScore(t *testing.T) {
+	assert.InDelta(t, 1.0, normalizeScore(metric.COSINE, 1), 1e-6)
+	assert.InDelta(t, 0.5, normalizeScore(metric.IP, 0), 1e-6)
+	assert.InDelta(t, 1.0, normalizeScore(metric.L2, 0), 1e-6)
+	assert.InDelta(t, 1.0, normalizeScore("", 0), 1e-6)
+	assert.Greater(t, normalizeScore(metric.L2, 1), normalizeScore(metric.L2, 2))
+	assert.Greater(t, normalizeScore("ip", 2), normalizeScore("ip", 1))
+}
+
+func TestHybridSearch(t *testing.T) {
+	paramtable.Init()
+	ctx := context.Background()
+	reqs := []*milvuspb.SearchRequest{
+		{CollectionName: "coll", Nq: 1, SearchParams: []*commonpb.KeyValuePair{{Key: common.MetricTypeKey, Value: metric.IP}}},
+		{CollectionName: "coll", Nq: 1, SearchParams: []*commonpb.KeyValuePair{{Key: common.MetricTypeKey, Value: metric.L2}}},
+	}
+	search := func(ctx context.Context, req *milvuspb.SearchRequest) (*milvuspb.SearchResults, error) {
+		ids, scores := []int64{3, 4}, []float32{0.1, 0.2}
+		if req == reqs[0] {
+			ids, scores = []int64{1, 2, 3}, []float32{3, 2, 1}
+		}
+		values := make([]int64, 0, len(ids))
+		for _, id := range ids {
+			values = append(values, id*10)
+		}
+		return &milvuspb.SearchResults{
+			Status: merr.Success(),
+			Results: &schemapb.SearchResultData{
+				NumQueries:   1,
+				TopK:         int64(len(ids)),
+				Topks:        []int64{int64(len(ids))},
+				Ids:          &schemapb.IDs{IdField: &schemapb.IDs_IntId{IntId: &schemapb.LongArray{Data: ids}}},
+				Scores:       scores,
+				FieldsData:   []*schemapb.FieldData{getFieldData("value", 0, schemapb.DataType_Int64, values, 0)},
+				OutputFields: []string{"value"},
+			},
+		}, nil
+	}
+	ranker, err := NewRanker(RRFRankerName, nil, len(reqs))
+	assert.NoError(t, err)
+
+	result, err := HybridSearch(ctx, search, reqs, ranker, 3)
+	assert.NoError(t, err)
+	assert.Equal(t, "coll", result.GetCollectionName())
+	assert.Equal(t, []int64{3}, result.GetResults().GetTopks())
+	// the ties of 2 and 4 are kept in the order of hits
+	assert.Equal(t, []int64{3, 1, 2}, result.GetResults().GetIds().GetIntId().GetData())
+	assert.Equal(t, []int64{30, 10, 20}, result.GetResults().GetFieldsData()[0].GetScalars().GetLongData().GetData())
+	assert.InDelta(t, 1.0/63+1.0/61, result.GetResults().GetScores()[0], 1e-6)
+
+	_, err = HybridSearch(ctx, search, reqs, ranker, 0)
+	assert.ErrorIs(t, err, merr.ErrParameterInvalid)
+	_, err = HybridSearch(ctx, search, nil, ranker, 3)
+	assert.ErrorIs(t, err, merr.ErrParameterInvalid)
+	_, err = HybridSearch(ctx, search, []*milvuspb.SearchRequest{{Nq: 1}, {Nq: 2}}, ranker, 3)
+	assert.ErrorIs(t, err, merr.ErrParameterInvalid)
+
+	failed := func(ctx context.Context, req *milvuspb.SearchRequest) (*milvuspb.SearchResults, error) {
+		return &milvuspb.SearchResults{Status: merr.Status(merr.ErrCollectionNotFound)}, nil
+	}
+	_, err = HybridSearch(ctx, failed, reqs, ranker, 3)
+	assert.ErrorIs(t, err, merr.ErrCollectionNotFound)
+}
@@ -0,0 +1,89 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package proxy
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+
+	"github.com/golang/protobuf/proto"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+
+	"github.com/milvus-io/milvus-proto/go-api/v2/commonpb"
+	"github.com/milvus-io/milvus/pkg/util/logutil"
+	"github.com/milvus-io/milvus/pkg/util/merr"
+)
+
+// RequestIDInterceptor makes sure every rpc carries a request id, the valid one provided by the client
+// through the metadata is kept, otherwise a new one is generated. The following interceptors and the downstream
+// components see the id in the incoming metadata. The id is returned by the response header, and appended to
+// the reason of the failed response.
+func RequestIDInterceptor(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+	md, ok := metadata.FromIncomingContext(ctx)
+	if ok {
+		md = md.Copy()
+	} else {
+		md = metadata.MD{}
+	}
+	requestIDs := md.Get(logutil.RequestIDKey)
+	var requestID string
+	if len(requestIDs) > 0 && logutil.IsValidRequestID(requestIDs[0]) {
+		requestID = requestIDs[0]
+	} else {
+		requestID = logutil.NewRequestID()
+		md.Set(logutil.RequestIDKey, requestID)
+		ctx = metadata.NewIncomingContext(ctx, md)
+	}
+	// there is no stream if the interceptor is called directly, ignore the error
+	_ = grpc.SetHeader(ctx, metadata.Pairs(logutil.RequestIDKey, requestID))
+
+	resp, err := handler(ctx, req)
+	return withRequestIDInReason(resp, requestID), err
+}
+
+// withRequestIDInReason appends the request id to the reason of the failed response,
+// the status is cloned as it may be shared by the responses.
+func withRequestIDInReason(resp interface{}, requestID string) interface{} {
+	withRequestID := func(status *commonpb.Status) *commonpb.Status {
+		status = proto.Clone(status).(*commonpb.Status)
+		status.Reason = fmt.Sprintf("%s [requestID=%s]", status.GetReason(), requestID)
+		return status
+	}
+
+	if status, ok := resp.(*commonpb.Status); ok {
+		if status == nil || merr.Ok(status) {
+			return resp
+		}
+		return withRequestID(status)
+	}
+	statusGetter, ok := resp.(interface{ GetStatus() *commonpb.Status })
+	if !ok || statusGetter.GetStatus() == nil || merr.Ok(statusGetter.GetStatus()) {
+		return resp
+	}
+	value := reflect.ValueOf(resp)
+	if value.Kind() != reflect.Ptr || value.IsNil() {
+		return resp
+	}
+	field := value.Elem().FieldByName("Status")
+	if !field.IsValid() || !field.CanSet() || field.Type() != reflect.TypeOf(&commonpb.Status{}) {
+		return resp
+	}
+	field.Set(reflect.ValueOf(withRequestID(statusGetter.GetStatus())))
+	return resp
+}
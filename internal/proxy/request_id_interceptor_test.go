@@ -0,0 +1,79 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package proxy
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+
+	"github.com/milvus-io/milvus-proto/go-api/v2/commonpb"
+	"github.com/milvus-io/milvus-proto/go-api/v2/milvuspb"
+	"github.com/milvus-io/milvus/pkg/util/logutil"
+	"github.com/milvus-io/milvus/pkg/util/merr"
+)
+
+func TestRequestIDInterceptor(t *testing.T) {
+	info := &grpc.UnaryServerInfo{FullMethod: "/milvus.proto.milvus.MilvusService/HasCollection"}
+	requestIDOf := func(ctx context.Context) string {
+		md, ok := metadata.FromIncomingContext(ctx)
+		assert.True(t, ok)
+		return md.Get(logutil.RequestIDKey)[0]
+	}
+
+	t.Run("client provided", func(t *testing.T) {
+		ctx := metadata.NewIncomingContext(context.Background(), metadata.Pairs(logutil.RequestIDKey, "client-req-id"))
+		resp, err := RequestIDInterceptor(ctx, nil, info, func(ctx context.Context, req interface{}) (interface{}, error) {
+			assert.Equal(t, "client-req-id", requestIDOf(ctx))
+			return merr.Success(), nil
+		})
+		assert.NoError(t, err)
+		assert.Empty(t, resp.(*commonpb.Status).GetReason())
+	})
+
+	t.Run("generated", func(t *testing.T) {
+		ctx := metadata.NewIncomingContext(context.Background(), metadata.Pairs(logutil.RequestIDKey, "invalid id"))
+		var requestID string
+		status := merr.Status(merr.ErrCollectionNotFound)
+		resp, err := RequestIDInterceptor(ctx, nil, info, func(ctx context.Context, req interface{}) (interface{}, error) {
+			requestID = requestIDOf(ctx)
+			assert.True(t, logutil.IsValidRequestID(requestID))
+			return status, nil
+		})
+		assert.NoError(t, err)
+		assert.Contains(t, resp.(*commonpb.Status).GetReason(), requestID)
+		// the original status is not changed
+		assert.NotContains(t, status.GetReason(), requestID)
+	})
+
+	t.Run("failed response", func(t *testing.T) {
+		resp, err := RequestIDInterceptor(context.Background(), nil, info, func(ctx context.Context, req interface{}) (interface{}, error) {
+			return &milvuspb.BoolResponse{Status: merr.Status(merr.ErrCollectionNotFound)}, nil
+		})
+		assert.NoError(t, err)
+		assert.Contains(t, resp.(*milvuspb.BoolResponse).GetStatus().GetReason(), "requestID=")
+
+		resp, err = RequestIDInterceptor(context.Background(), nil, info, func(ctx context.Context, req interface{}) (interface{}, error) {
+			return &milvuspb.BoolResponse{Status: merr.Success(), Value: true}, nil
+		})
+		assert.NoError(t, err)
+		assert.Empty(t, resp.(*milvuspb.BoolResponse).GetStatus().GetReason())
+	})
+}
@@ -0,0 +1,93 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package proxy
+
+import (
+	"context"
+	"strings"
+
+	"github.com/samber/lo"
+	"go.uber.org/zap"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+
+	"github.com/milvus-io/milvus-proto/go-api/v2/schemapb"
+	"github.com/milvus-io/milvus/internal/util/grpcclient"
+	"github.com/milvus-io/milvus/pkg/log"
+)
+
+// ResponseCompressionHeader is the grpc request header asking to compress the large responses,
+// the only supported value is zstd, which has to be accepted by the client as well.
+const ResponseCompressionHeader = "response-compression"
+
+// requestedResponseCompression returns the compressor requested by the client, or empty if not requested.
+func requestedResponseCompression(ctx context.Context) string {
+	md, ok := metadata.FromIncomingContext(ctx)
+	if !ok {
+		return grpcclient.None
+	}
+	for _, value := range md.Get(ResponseCompressionHeader) {
+		if strings.EqualFold(strings.TrimSpace(value), grpcclient.Zstd) {
+			return grpcclient.Zstd
+		}
+	}
+	return grpcclient.None
+}
+
+// varLenOutputSize returns the size of varchar and json outputs, which benefit from compression most.
+func varLenOutputSize(fieldsData []*schemapb.FieldData) int {
+	size := 0
+	for _, fieldData := range fieldsData {
+		switch fieldData.GetType() {
+		case schemapb.DataType_VarChar, schemapb.DataType_String:
+			for _, str := range fieldData.GetScalars().GetStringData().GetData() {
+				size += len(str)
+			}
+		case schemapb.DataType_JSON:
+			for _, bs := range fieldData.GetScalars().GetJsonData().GetData() {
+				size += len(bs)
+			}
+		}
+	}
+	return size
+}
+
+// compressResponseIfLarge compresses the response by zstd if requested by the client and the varchar and json outputs are large,
+// the response is sent uncompressed if the client doesn't accept zstd.
+func compressResponseIfLarge(ctx context.Context, fieldsData []*schemapb.FieldData) {
+	if !Params.ProxyCfg.ResponseCompressionEnabled.GetAsBool() {
+		return
+	}
+	compressor := requestedResponseCompression(ctx)
+	if compressor == grpcclient.None {
+		return
+	}
+	size := varLenOutputSize(fieldsData)
+	if size < Params.ProxyCfg.ResponseCompressionMinSize.GetAsInt() {
+		return
+	}
+
+	log := log.Ctx(ctx).With(zap.String("compressor", compressor), zap.Int("size", size)).WithRateGroup("proxy.responseCompression", 1, 60)
+	accepted, err := grpc.ClientSupportedCompressors(ctx)
+	if err != nil || !lo.Contains(accepted, compressor) {
+		log.RatedInfo(60, "response compression requested but not accepted by client", zap.Strings("accepted", accepted), zap.Error(err))
+		return
+	}
+	if err := grpc.SetSendCompressor(ctx, compressor); err != nil {
+		log.Warn("failed to set response compressor", zap.Error(err))
+	}
+}
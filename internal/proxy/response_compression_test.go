@@ -0,0 +1,84 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package proxy
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc/metadata"
+
+	"github.com/milvus-io/milvus-proto/go-api/v2/schemapb"
+	"github.com/milvus-io/milvus/internal/util/grpcclient"
+	"github.com/milvus-io/milvus/pkg/util/paramtable"
+)
+
+func TestRequestedResponseCompression(t *testing.T) {
+	assert.Equal(t, grpcclient.None, requestedResponseCompression(context.Background()))
+
+	ctx := metadata.NewIncomingContext(context.Background(), metadata.Pairs(ResponseCompressionHeader, "ZSTD"))
+	assert.Equal(t, grpcclient.Zstd, requestedResponseCompression(ctx))
+
+	ctx = metadata.NewIncomingContext(context.Background(), metadata.Pairs(ResponseCompressionHeader, "gzip"))
+	assert.Equal(t, grpcclient.None, requestedResponseCompression(ctx))
+}
+
+func TestVarLenOutputSize(t *testing.T) {
+	fieldsData := []*schemapb.FieldData{
+		{
+			Type: schemapb.DataType_VarChar,
+			Field: &schemapb.FieldData_Scalars{Scalars: &schemapb.ScalarField{
+				Data: &schemapb.ScalarField_StringData{StringData: &schemapb.StringArray{Data: []string{"ab", "cde"}}},
+			}},
+		},
+		{
+			Type: schemapb.DataType_JSON,
+			Field: &schemapb.FieldData_Scalars{Scalars: &schemapb.ScalarField{
+				Data: &schemapb.ScalarField_JsonData{JsonData: &schemapb.JSONArray{Data: [][]byte{[]byte(`{"a":1}`)}}},
+			}},
+		},
+		{
+			Type: schemapb.DataType_Int64,
+			Field: &schemapb.FieldData_Scalars{Scalars: &schemapb.ScalarField{
+				Data: &schemapb.ScalarField_LongData{LongData: &schemapb.LongArray{Data: []int64{1, 2, 3}}},
+			}},
+		},
+	}
+	assert.Equal(t, 12, varLenOutputSize(fieldsData))
+	assert.Equal(t, 0, varLenOutputSize(nil))
+}
+
+func TestCompressResponseIfLarge(t *testing.T) {
+	paramtable.Get().Save(Params.ProxyCfg.ResponseCompressionMinSize.Key, "1")
+	defer paramtable.Get().Reset(Params.ProxyCfg.ResponseCompressionMinSize.Key)
+
+	fieldsData := []*schemapb.FieldData{
+		{
+			Type: schemapb.DataType_VarChar,
+			Field: &schemapb.FieldData_Scalars{Scalars: &schemapb.ScalarField{
+				Data: &schemapb.ScalarField_StringData{StringData: &schemapb.StringArray{Data: []string{"ab"}}},
+			}},
+		},
+	}
+	// not in grpc stream, the response is kept uncompressed
+	ctx := metadata.NewIncomingContext(context.Background(), metadata.Pairs(ResponseCompressionHeader, grpcclient.Zstd))
+	assert.NotPanics(t, func() {
+		compressResponseIfLarge(ctx, fieldsData)
+		compressResponseIfLarge(context.Background(), fieldsData)
+	})
+}
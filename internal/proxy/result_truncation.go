@@ -0,0 +1,192 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package proxy
+
+import (
+	"context"
+	"strconv"
+	"strings"
+
+	"github.com/golang/protobuf/proto"
+	"github.com/samber/lo"
+	"go.uber.org/zap"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+
+	"github.com/milvus-io/milvus-proto/go-api/v2/milvuspb"
+	"github.com/milvus-io/milvus-proto/go-api/v2/schemapb"
+	"github.com/milvus-io/milvus/pkg/log"
+	"github.com/milvus-io/milvus/pkg/util/funcutil"
+	"github.com/milvus-io/milvus/pkg/util/typeutil"
+)
+
+const (
+	// ResultTruncatedHeader tells the client the results are truncated by proxy.resultSizeLimit.
+	ResultTruncatedHeader = "result-truncated"
+	// ResultReturnedRowsHeader is the number of rows returned after truncated.
+	ResultReturnedRowsHeader = "result-returned-rows"
+	// ResultMatchedRowsHeader is the number of rows matched before truncated.
+	ResultMatchedRowsHeader = "result-matched-rows"
+	// ResultNextOffsetHeader is the offset to request the rest results with,
+	// the offsets of the queries are separated by comma for search.
+	ResultNextOffsetHeader = "result-next-offset"
+)
+
+// resultTruncation records how the results are truncated.
+type resultTruncation struct {
+	returned    int64
+	matched     int64
+	nextOffsets []int64
+}
+
+// varLenRowSize returns the size of the varchar, json and array values of the idx-th row,
+// which are not counted by typeutil.AppendFieldData.
+func varLenRowSize(fieldsData []*schemapb.FieldData, idx int64) int64 {
+	var size int
+	for _, fieldData := range fieldsData {
+		switch fieldData.GetType() {
+		case schemapb.DataType_VarChar, schemapb.DataType_String:
+			if data := fieldData.GetScalars().GetStringData().GetData(); idx < int64(len(data)) {
+				size += len(data[idx])
+			}
+		case schemapb.DataType_JSON:
+			if data := fieldData.GetScalars().GetJsonData().GetData(); idx < int64(len(data)) {
+				size += len(data[idx])
+			}
+		case schemapb.DataType_Array:
+			if data := fieldData.GetScalars().GetArrayData().GetData(); idx < int64(len(data)) {
+				size += proto.Size(data[idx])
+			}
+		}
+	}
+	return int64(size)
+}
+
+// truncateFieldsData keeps the leading rows of the fields data within the size limit,
+// at least one row is kept so that the client is able to continue with the offset.
+func truncateFieldsData(fieldsData []*schemapb.FieldData, rowNum int64, sizeLimit int64) ([]*schemapb.FieldData, int64) {
+	truncated := make([]*schemapb.FieldData, len(fieldsData))
+	var size, kept int64
+	for ; kept < rowNum; kept++ {
+		size += typeutil.AppendFieldData(truncated, fieldsData, kept) + varLenRowSize(fieldsData, kept)
+		if size > sizeLimit {
+			break
+		}
+	}
+	if kept == rowNum {
+		return truncated, kept
+	}
+	if kept == 0 {
+		// the first row alone exceeds the limit
+		return truncated, 1
+	}
+	// the row exceeding the limit is appended already, rebuild without it
+	truncated = make([]*schemapb.FieldData, len(fieldsData))
+	for i := int64(0); i < kept; i++ {
+		typeutil.AppendFieldData(truncated, fieldsData, i)
+	}
+	return truncated, kept
+}
+
+// truncateQueryResults truncates the query results exceeding proxy.resultSizeLimit,
+// returns nil if not truncated.
+func truncateQueryResults(result *milvuspb.QueryResults, offset int64) *resultTruncation {
+	sizeLimit := Params.ProxyCfg.ResultSizeLimit.GetAsInt64()
+	if sizeLimit <= 0 || len(result.GetFieldsData()) == 0 || int64(proto.Size(result)) <= sizeLimit {
+		return nil
+	}
+	rowNum, err := funcutil.GetNumRowOfFieldData(result.GetFieldsData()[0])
+	if err != nil || rowNum <= 1 {
+		return nil
+	}
+
+	fieldsData, kept := truncateFieldsData(result.GetFieldsData(), int64(rowNum), sizeLimit)
+	if kept == int64(rowNum) {
+		return nil
+	}
+	result.FieldsData = fieldsData
+	return &resultTruncation{
+		returned:    kept,
+		matched:     int64(rowNum),
+		nextOffsets: []int64{offset + kept},
+	}
+}
+
+// truncateSearchResults truncates the search results exceeding proxy.resultSizeLimit,
+// the results of the leading queries are kept, returns nil if not truncated.
+func truncateSearchResults(result *milvuspb.SearchResults, offset int64) *resultTruncation {
+	sizeLimit := Params.ProxyCfg.ResultSizeLimit.GetAsInt64()
+	data := result.GetResults()
+	rowNum := int64(len(data.GetScores()))
+	if sizeLimit <= 0 || rowNum <= 1 || int64(proto.Size(result)) <= sizeLimit {
+		return nil
+	}
+
+	kept := int64(1)
+	if len(data.GetFieldsData()) > 0 {
+		data.FieldsData, kept = truncateFieldsData(data.GetFieldsData(), rowNum, sizeLimit)
+	} else {
+		// only the ids and scores, which are about 16 bytes per row at most
+		kept = lo.Clamp(sizeLimit/16, 1, rowNum)
+	}
+	if kept == rowNum {
+		return nil
+	}
+
+	data.Scores = data.Scores[:kept]
+	switch ids := data.GetIds().GetIdField().(type) {
+	case *schemapb.IDs_IntId:
+		ids.IntId.Data = ids.IntId.Data[:kept]
+	case *schemapb.IDs_StrId:
+		ids.StrId.Data = ids.StrId.Data[:kept]
+	}
+	nextOffsets := make([]int64, len(data.GetTopks()))
+	remain := kept
+	for i, topk := range data.GetTopks() {
+		topk = lo.Min([]int64{topk, remain})
+		remain -= topk
+		data.Topks[i] = topk
+		nextOffsets[i] = offset + topk
+	}
+	if len(data.GetTopks()) > 0 {
+		data.TopK = data.Topks[len(data.Topks)-1]
+	}
+	return &resultTruncation{
+		returned:    kept,
+		matched:     rowNum,
+		nextOffsets: nextOffsets,
+	}
+}
+
+// setResultTruncatedHeader tells the client the results are truncated by the grpc response header.
+func setResultTruncatedHeader(ctx context.Context, truncation *resultTruncation) {
+	if truncation == nil {
+		return
+	}
+	offsets := lo.Map(truncation.nextOffsets, func(offset int64, _ int) string {
+		return strconv.FormatInt(offset, 10)
+	})
+	header := metadata.Pairs(
+		ResultTruncatedHeader, "true",
+		ResultReturnedRowsHeader, strconv.FormatInt(truncation.returned, 10),
+		ResultMatchedRowsHeader, strconv.FormatInt(truncation.matched, 10),
+		ResultNextOffsetHeader, strings.Join(offsets, ","),
+	)
+	if err := grpc.SetHeader(ctx, header); err != nil {
+		log.Ctx(ctx).Warn("failed to set result truncated header", zap.Error(err))
+	}
+}
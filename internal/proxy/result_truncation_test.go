@@ -0,0 +1,108 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package proxy
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/milvus-io/milvus-proto/go-api/v2/milvuspb"
+	"github.com/milvus-io/milvus-proto/go-api/v2/schemapb"
+	"github.com/milvus-io/milvus/pkg/util/paramtable"
+)
+
+func TestTruncateQueryResults(t *testing.T) {
+	paramtable.Init()
+	strs := make([]string, 10)
+	for i := range strs {
+		strs[i] = strings.Repeat("a", 100)
+	}
+	result := &milvuspb.QueryResults{FieldsData: []*schemapb.FieldData{
+		getFieldData("pk", 100, schemapb.DataType_Int64, []int64{0, 1, 2, 3, 4, 5, 6, 7, 8, 9}, 0),
+		getFieldData("str", 101, schemapb.DataType_VarChar, strs, 0),
+	}}
+
+	// disabled
+	assert.Nil(t, truncateQueryResults(result, 0))
+
+	paramtable.Get().Save(Params.ProxyCfg.ResultSizeLimit.Key, "10000")
+	defer paramtable.Get().Reset(Params.ProxyCfg.ResultSizeLimit.Key)
+	assert.Nil(t, truncateQueryResults(result, 0))
+
+	// each row is counted as 124 bytes
+	paramtable.Get().Save(Params.ProxyCfg.ResultSizeLimit.Key, "500")
+	truncation := truncateQueryResults(result, 5)
+	assert.Equal(t, &resultTruncation{returned: 4, matched: 10, nextOffsets: []int64{9}}, truncation)
+	assert.Equal(t, []int64{0, 1, 2, 3}, result.GetFieldsData()[0].GetScalars().GetLongData().GetData())
+	assert.Len(t, result.GetFieldsData()[1].GetScalars().GetStringData().GetData(), 4)
+
+	// at least one row is returned
+	paramtable.Get().Save(Params.ProxyCfg.ResultSizeLimit.Key, "1")
+	truncation = truncateQueryResults(result, 0)
+	assert.EqualValues(t, 1, truncation.returned)
+	assert.Equal(t, []int64{0}, result.GetFieldsData()[0].GetScalars().GetLongData().GetData())
+}
+
+func TestTruncateSearchResults(t *testing.T) {
+	paramtable.Init()
+	paramtable.Get().Save(Params.ProxyCfg.ResultSizeLimit.Key, "500")
+	defer paramtable.Get().Reset(Params.ProxyCfg.ResultSizeLimit.Key)
+
+	strs := make([]string, 10)
+	for i := range strs {
+		strs[i] = strings.Repeat("a", 100)
+	}
+	result := &milvuspb.SearchResults{
+		Results: &schemapb.SearchResultData{
+			NumQueries: 2,
+			TopK:       5,
+			FieldsData: []*schemapb.FieldData{
+				getFieldData("pk", 100, schemapb.DataType_Int64, []int64{0, 1, 2, 3, 4, 5, 6, 7, 8, 9}, 0),
+				getFieldData("str", 101, schemapb.DataType_VarChar, strs, 0),
+			},
+			Scores: make([]float32, 10),
+			Ids: &schemapb.IDs{IdField: &schemapb.IDs_IntId{IntId: &schemapb.LongArray{
+				Data: []int64{0, 1, 2, 3, 4, 5, 6, 7, 8, 9},
+			}}},
+			Topks: []int64{3, 7},
+		},
+	}
+	truncation := truncateSearchResults(result, 0)
+	assert.Equal(t, &resultTruncation{returned: 4, matched: 10, nextOffsets: []int64{3, 1}}, truncation)
+	assert.Equal(t, []int64{3, 1}, result.GetResults().GetTopks())
+	assert.EqualValues(t, 1, result.GetResults().GetTopK())
+	assert.Len(t, result.GetResults().GetScores(), 4)
+	assert.Equal(t, []int64{0, 1, 2, 3}, result.GetResults().GetIds().GetIntId().GetData())
+
+	// no output fields
+	result = &milvuspb.SearchResults{
+		Results: &schemapb.SearchResultData{
+			NumQueries: 1,
+			TopK:       100,
+			Scores:     make([]float32, 100),
+			Ids: &schemapb.IDs{IdField: &schemapb.IDs_StrId{StrId: &schemapb.StringArray{
+				Data: make([]string, 100),
+			}}},
+			Topks: []int64{100},
+		},
+	}
+	truncation = truncateSearchResults(result, 10)
+	assert.Equal(t, &resultTruncation{returned: 31, matched: 100, nextOffsets: []int64{41}}, truncation)
+	assert.Len(t, result.GetResults().GetIds().GetStrId().GetData(), 31)
+}
@@ -1091,6 +1091,38 @@ func (coord *RootCoordMock) RenameCollection(ctx context.Context, req *milvuspb.
 	return &commonpb.Status{}, nil
 }
 
+func (coord *RootCoordMock) CancelDDLTask(ctx context.Context, req *rootcoordpb.CancelDDLTaskRequest, opts ...grpc.CallOption) (*commonpb.Status, error) {
+	return &commonpb.Status{}, nil
+}
+
+func (coord *RootCoordMock) OperateCollectionTrash(ctx context.Context, req *rootcoordpb.OperateCollectionTrashRequest, opts ...grpc.CallOption) (*commonpb.Status, error) {
+	return &commonpb.Status{}, nil
+}
+
+func (coord *RootCoordMock) OperateCollectionTemplate(ctx context.Context, req *rootcoordpb.OperateCollectionTemplateRequest, opts ...grpc.CallOption) (*commonpb.Status, error) {
+	return &commonpb.Status{}, nil
+}
+
+func (coord *RootCoordMock) CreateCollectionFromTemplate(ctx context.Context, req *rootcoordpb.CreateCollectionFromTemplateRequest, opts ...grpc.CallOption) (*commonpb.Status, error) {
+	return &commonpb.Status{}, nil
+}
+
+func (coord *RootCoordMock) OperateRoleInheritance(ctx context.Context, req *rootcoordpb.OperateRoleInheritanceRequest, opts ...grpc.CallOption) (*commonpb.Status, error) {
+	return &commonpb.Status{}, nil
+}
+
+func (coord *RootCoordMock) ListRoleInheritances(ctx context.Context, req *rootcoordpb.ListRoleInheritancesRequest, opts ...grpc.CallOption) (*rootcoordpb.ListRoleInheritancesResponse, error) {
+	return &rootcoordpb.ListRoleInheritancesResponse{}, nil
+}
+
+func (coord *RootCoordMock) OperateAPIKey(ctx context.Context, req *rootcoordpb.OperateAPIKeyRequest, opts ...grpc.CallOption) (*rootcoordpb.OperateAPIKeyResponse, error) {
+	return &rootcoordpb.OperateAPIKeyResponse{}, nil
+}
+
+func (coord *RootCoordMock) ListAPIKeys(ctx context.Context, req *rootcoordpb.ListAPIKeysRequest, opts ...grpc.CallOption) (*rootcoordpb.ListAPIKeysResponse, error) {
+	return &rootcoordpb.ListAPIKeysResponse{}, nil
+}
+
 type DescribeCollectionFunc func(ctx context.Context, request *milvuspb.DescribeCollectionRequest, opts ...grpc.CallOption) (*milvuspb.DescribeCollectionResponse, error)
 
 type ShowPartitionsFunc func(ctx context.Context, request *milvuspb.ShowPartitionsRequest, opts ...grpc.CallOption) (*milvuspb.ShowPartitionsResponse, error)
@@ -0,0 +1,156 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package proxy
+
+import (
+	"context"
+	"strconv"
+	"strings"
+
+	"go.uber.org/zap"
+
+	"github.com/milvus-io/milvus-proto/go-api/v2/commonpb"
+	"github.com/milvus-io/milvus-proto/go-api/v2/milvuspb"
+	"github.com/milvus-io/milvus-proto/go-api/v2/schemapb"
+	"github.com/milvus-io/milvus/internal/parser/planparserv2"
+	"github.com/milvus-io/milvus/internal/proto/internalpb"
+	"github.com/milvus-io/milvus/pkg/log"
+	"github.com/milvus-io/milvus/pkg/util/commonpbutil"
+	"github.com/milvus-io/milvus/pkg/util/merr"
+	"github.com/milvus-io/milvus/pkg/util/paramtable"
+	"github.com/milvus-io/milvus/pkg/util/typeutil"
+)
+
+// resolveSubqueries executes the subqueries in the expression, like `pk in (select pk from other_collection where ...)`,
+// and replaces them with the primary keys returned, so the query is filtered by another collection in one request.
+// The subqueries read the same snapshot as the query.
+func (t *queryTask) resolveSubqueries(ctx context.Context) error {
+	subqueries, err := planparserv2.ParseSubqueries(t.request.GetExpr())
+	if err != nil {
+		return merr.WrapErrParameterInvalidMsg("%s", err.Error())
+	}
+	if len(subqueries) == 0 {
+		return nil
+	}
+	if maxNum := Params.ProxyCfg.SubqueryMaxNum.GetAsInt(); len(subqueries) > maxNum {
+		return merr.WrapErrParameterInvalidMsg("too many subqueries in the expression, %d exceeds the limit %d", len(subqueries), maxNum)
+	}
+
+	terms := make([]string, 0, len(subqueries))
+	for _, subquery := range subqueries {
+		term, err := t.executeSubquery(ctx, subquery)
+		if err != nil {
+			return err
+		}
+		terms = append(terms, term)
+	}
+	t.request.Expr = planparserv2.ReplaceSubqueries(t.request.GetExpr(), subqueries, terms)
+	return nil
+}
+
+func (t *queryTask) executeSubquery(ctx context.Context, subquery *planparserv2.Subquery) (string, error) {
+	log := log.Ctx(ctx).With(zap.String("subqueryCollection", subquery.Collection), zap.String("subqueryExpr", subquery.Expr))
+
+	schema, err := globalMetaCache.GetCollectionSchema(ctx, t.request.GetDbName(), subquery.Collection)
+	if err != nil {
+		return "", err
+	}
+	pkField, err := typeutil.GetPrimaryFieldSchema(schema)
+	if err != nil {
+		return "", err
+	}
+	if pkField.GetName() != subquery.Field {
+		return "", merr.WrapErrParameterInvalidMsg("only the primary key %s of collection %s could be selected by subquery, but got %s",
+			pkField.GetName(), subquery.Collection, subquery.Field)
+	}
+
+	maxResultNum := Params.ProxyCfg.SubqueryMaxResultNum.GetAsInt()
+	request := &milvuspb.QueryRequest{
+		Base: &commonpb.MsgBase{
+			MsgType: commonpb.MsgType_Retrieve,
+		},
+		DbName:                t.request.GetDbName(),
+		CollectionName:        subquery.Collection,
+		Expr:                  subquery.Expr,
+		OutputFields:          []string{subquery.Field},
+		TravelTimestamp:       t.request.GetTravelTimestamp(),
+		GuaranteeTimestamp:    t.request.GetGuaranteeTimestamp(),
+		ConsistencyLevel:      t.request.GetConsistencyLevel(),
+		UseDefaultConsistency: t.request.GetUseDefaultConsistency(),
+		// one more to tell whether the results exceed the limit
+		QueryParams: []*commonpb.KeyValuePair{{Key: LimitKey, Value: strconv.Itoa(maxResultNum + 1)}},
+	}
+	// the subquery collection is checked like queried directly
+	if _, err := PrivilegeInterceptor(ctx, request); err != nil {
+		return "", err
+	}
+
+	qt := &queryTask{
+		ctx:       ctx,
+		Condition: NewTaskCondition(ctx),
+		RetrieveRequest: &internalpb.RetrieveRequest{
+			Base: commonpbutil.NewMsgBase(
+				commonpbutil.WithMsgType(commonpb.MsgType_Retrieve),
+				commonpbutil.WithSourceID(paramtable.GetNodeID()),
+			),
+			ReqID: paramtable.GetNodeID(),
+		},
+		request: request,
+		qc:      t.qc,
+		lb:      t.lb,
+	}
+	qt.SetID(t.ID())
+	qt.SetTs(t.BeginTs())
+	if err := qt.PreExecute(ctx); err != nil {
+		return "", err
+	}
+	if err := qt.Execute(ctx); err != nil {
+		return "", err
+	}
+	if err := qt.PostExecute(ctx); err != nil {
+		return "", err
+	}
+
+	var pkData *schemapb.FieldData
+	if len(qt.result.GetFieldsData()) > 0 {
+		pkData, err = typeutil.GetPrimaryFieldData(qt.result.GetFieldsData(), pkField)
+		if err != nil {
+			return "", err
+		}
+	}
+	if num := typeutil.GetPKSize(pkData); num > maxResultNum {
+		return "", merr.WrapErrParameterInvalidMsg("subquery on collection %s returns more than %d primary keys, narrow down its filter",
+			subquery.Collection, maxResultNum)
+	}
+	log.Debug("subquery executed", zap.Int("resultNum", typeutil.GetPKSize(pkData)))
+	return formatSubqueryTerm(pkData), nil
+}
+
+// formatSubqueryTerm formats the primary keys to the term of expression, like `[1, 2, 3]` or `["a", "b"]`.
+func formatSubqueryTerm(pkData *schemapb.FieldData) string {
+	num := typeutil.GetPKSize(pkData)
+	values := make([]string, 0, num)
+	for i := 0; i < num; i++ {
+		switch pk := typeutil.GetData(pkData, i).(type) {
+		case int64:
+			values = append(values, strconv.FormatInt(pk, 10))
+		case string:
+			values = append(values, strconv.Quote(pk))
+		}
+	}
+	return "[" + strings.Join(values, ", ") + "]"
+}
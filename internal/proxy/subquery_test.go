@@ -0,0 +1,90 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package proxy
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+
+	"github.com/milvus-io/milvus-proto/go-api/v2/commonpb"
+	"github.com/milvus-io/milvus-proto/go-api/v2/milvuspb"
+	"github.com/milvus-io/milvus-proto/go-api/v2/schemapb"
+	"github.com/milvus-io/milvus/internal/proto/internalpb"
+	"github.com/milvus-io/milvus/pkg/util/merr"
+	"github.com/milvus-io/milvus/pkg/util/paramtable"
+)
+
+func Test_formatSubqueryTerm(t *testing.T) {
+	assert.Equal(t, "[]", formatSubqueryTerm(nil))
+	assert.Equal(t, "[1, 2, 3]", formatSubqueryTerm(&schemapb.FieldData{
+		Type: schemapb.DataType_Int64,
+		Field: &schemapb.FieldData_Scalars{Scalars: &schemapb.ScalarField{
+			Data: &schemapb.ScalarField_LongData{LongData: &schemapb.LongArray{Data: []int64{1, 2, 3}}},
+		}},
+	}))
+	assert.Equal(t, `["a", "b\"c"]`, formatSubqueryTerm(&schemapb.FieldData{
+		Type: schemapb.DataType_VarChar,
+		Field: &schemapb.FieldData_Scalars{Scalars: &schemapb.ScalarField{
+			Data: &schemapb.ScalarField_StringData{StringData: &schemapb.StringArray{Data: []string{"a", `b"c`}}},
+		}},
+	}))
+}
+
+func Test_queryTask_resolveSubqueries(t *testing.T) {
+	paramtable.Init()
+	newTask := func(expr string) *queryTask {
+		return &queryTask{
+			ctx:             context.Background(),
+			RetrieveRequest: &internalpb.RetrieveRequest{Base: &commonpb.MsgBase{}},
+			request:         &milvuspb.QueryRequest{CollectionName: "col", Expr: expr},
+		}
+	}
+
+	t.Run("no subquery", func(t *testing.T) {
+		task := newTask("pk in [1, 2]")
+		assert.NoError(t, task.resolveSubqueries(context.Background()))
+		assert.Equal(t, "pk in [1, 2]", task.request.GetExpr())
+	})
+
+	t.Run("invalid subquery", func(t *testing.T) {
+		task := newTask("pk in (select pk from other where")
+		assert.ErrorIs(t, task.resolveSubqueries(context.Background()), merr.ErrParameterInvalid)
+	})
+
+	t.Run("too many subqueries", func(t *testing.T) {
+		paramtable.Get().Save(Params.ProxyCfg.SubqueryMaxNum.Key, "1")
+		defer paramtable.Get().Reset(Params.ProxyCfg.SubqueryMaxNum.Key)
+
+		task := newTask("pk in (select pk from a) and pk in (select pk from b)")
+		assert.ErrorIs(t, task.resolveSubqueries(context.Background()), merr.ErrParameterInvalid)
+	})
+
+	t.Run("select non primary key", func(t *testing.T) {
+		cache := globalMetaCache
+		defer func() { globalMetaCache = cache }()
+		mockCache := NewMockCache(t)
+		mockCache.EXPECT().GetCollectionSchema(mock.Anything, mock.Anything, "other").
+			Return(constructCollectionSchema("pk", "vec", 8, "other"), nil)
+		globalMetaCache = mockCache
+
+		task := newTask("pk in (select vec from other where pk > 10)")
+		assert.ErrorIs(t, task.resolveSubqueries(context.Background()), merr.ErrParameterInvalid)
+	})
+}
@@ -20,10 +20,13 @@ import (
 	"context"
 	"fmt"
 	"math"
+	"strconv"
+	"strings"
 
 	"github.com/cockroachdb/errors"
 	"github.com/golang/protobuf/proto"
 	"go.uber.org/zap"
+	"google.golang.org/grpc/metadata"
 
 	"github.com/milvus-io/milvus-proto/go-api/v2/commonpb"
 	"github.com/milvus-io/milvus-proto/go-api/v2/milvuspb"
@@ -36,6 +39,7 @@ import (
 	"github.com/milvus-io/milvus/pkg/log"
 	"github.com/milvus-io/milvus/pkg/mq/msgstream"
 	"github.com/milvus-io/milvus/pkg/util/commonpbutil"
+	"github.com/milvus-io/milvus/pkg/util/funcutil"
 	"github.com/milvus-io/milvus/pkg/util/merr"
 	"github.com/milvus-io/milvus/pkg/util/paramtable"
 	"github.com/milvus-io/milvus/pkg/util/typeutil"
@@ -52,6 +56,12 @@ const (
 	RoundDecimalKey      = "round_decimal"
 	OffsetKey            = "offset"
 	LimitKey             = "limit"
+	GroupByFieldKey      = "group_by_field"
+	IteratorKey          = "iterator"
+	IteratorCursorKey    = "iterator_cursor"
+	PartialResultKey     = "partial_result"
+	SegmentTimeoutKey    = "segment_timeout"
+	ExplainKey           = "explain"
 
 	InsertTaskName                = "InsertTask"
 	CreateCollectionTaskName      = "CreateCollectionTask"
@@ -800,7 +810,11 @@ func (t *alterCollectionTask) PreExecute(ctx context.Context) error {
 	t.Base.MsgType = commonpb.MsgType_AlterCollection
 	t.Base.SourceID = paramtable.GetNodeID()
 
-	return nil
+	if err := validateDefaultSearchProperties(t.GetProperties()); err != nil {
+		return err
+	}
+	_, err := parseEmbeddingFunctions(funcutil.KeyValuePair2Map(t.GetProperties()))
+	return err
 }
 
 func (t *alterCollectionTask) Execute(ctx context.Context) error {
@@ -1471,6 +1485,10 @@ func (t *loadCollectionTask) Execute(ctx context.Context) (err error) {
 		log.Error(errMsg)
 		return errors.New(errMsg)
 	}
+	loadPriority, err := getCollectionLoadPriority(ctx, t.GetDbName(), t.CollectionName, collID)
+	if err != nil {
+		return err
+	}
 	request := &querypb.LoadCollectionRequest{
 		Base: commonpbutil.UpdateMsgBase(
 			t.Base,
@@ -1483,6 +1501,7 @@ func (t *loadCollectionTask) Execute(ctx context.Context) (err error) {
 		FieldIndexID:   fieldIndexIDs,
 		Refresh:        t.Refresh,
 		ResourceGroups: t.ResourceGroups,
+		LoadPriority:   loadPriority,
 	}
 	log.Debug("send LoadCollectionRequest to query coordinator",
 		zap.Any("schema", request.Schema))
@@ -1720,6 +1739,10 @@ func (t *loadPartitionsTask) Execute(ctx context.Context) error {
 	if len(partitionIDs) == 0 {
 		return errors.New("failed to load partition, due to no partition specified")
 	}
+	loadPriority, err := getCollectionLoadPriority(ctx, t.GetDbName(), t.CollectionName, collID)
+	if err != nil {
+		return err
+	}
 	request := &querypb.LoadPartitionsRequest{
 		Base: commonpbutil.UpdateMsgBase(
 			t.Base,
@@ -1733,6 +1756,7 @@ func (t *loadPartitionsTask) Execute(ctx context.Context) error {
 		FieldIndexID:   fieldIndexIDs,
 		Refresh:        t.Refresh,
 		ResourceGroups: t.ResourceGroups,
+		LoadPriority:   loadPriority,
 	}
 	t.result, err = t.queryCoord.LoadPartitions(ctx, request)
 	return err
@@ -2048,9 +2072,32 @@ func (t *AlterAliasTask) OnEnqueue() error {
 	if t.Base == nil {
 		t.Base = commonpbutil.NewMsgBase()
 	}
+	if aliasDropPreviousRequested(t.ctx) {
+		if t.Base.Properties == nil {
+			t.Base.Properties = make(map[string]string)
+		}
+		t.Base.Properties[common.AliasDropPreviousKey] = "true"
+	}
 	return nil
 }
 
+// AliasDropPreviousHeader is the grpc request header asking AlterAlias to drop the collection
+// the alias pointed to, in the same metadata transaction of repointing the alias.
+const AliasDropPreviousHeader = "alias-drop-previous"
+
+func aliasDropPreviousRequested(ctx context.Context) bool {
+	md, ok := metadata.FromIncomingContext(ctx)
+	if !ok {
+		return false
+	}
+	for _, value := range md.Get(AliasDropPreviousHeader) {
+		if drop, err := strconv.ParseBool(strings.TrimSpace(value)); err == nil && drop {
+			return true
+		}
+	}
+	return false
+}
+
 func (t *AlterAliasTask) PreExecute(ctx context.Context) error {
 	t.Base.MsgType = commonpb.MsgType_AlterAlias
 	t.Base.SourceID = paramtable.GetNodeID()
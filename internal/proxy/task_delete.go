@@ -56,6 +56,7 @@ type deleteTask struct {
 	collectionID UniqueID
 	partitionID  UniqueID
 	count        int
+	shardsScaled bool
 }
 
 func (dt *deleteTask) TraceCtx() context.Context {
@@ -175,6 +176,10 @@ func (dt *deleteTask) PreExecute(ctx context.Context) error {
 	}
 	dt.collectionID = collID
 
+	if err := checkCollectionWritable(ctx, dt.req.GetDbName(), collName); err != nil {
+		return ErrWithLog(log, "Collection is not writable", err)
+	}
+
 	partitionKeyMode, err := isPartitionKeyMode(ctx, dt.req.GetDbName(), dt.req.GetCollectionName())
 	if err != nil {
 		return ErrWithLog(log, "Failed to get partition key mode", err)
@@ -210,6 +215,10 @@ func (dt *deleteTask) PreExecute(ctx context.Context) error {
 		return ErrWithLog(log, "Failed to get primary keys from expr", err)
 	}
 	dt.vChannels = channelNames
+	dt.shardsScaled, err = isCollectionShardsScaled(ctx, dt.req.GetDbName(), collName, dt.collectionID)
+	if err != nil {
+		return ErrWithLog(log, "Failed to get collection info", err)
+	}
 
 	log.Debug("pre delete done", zap.Int64("collection_id", dt.collectionID))
 
@@ -311,11 +320,22 @@ func (dt *deleteTask) getStreamingQueryAndDelteFunc(stream msgstream.MsgStream,
 			return err
 		}
 
+		batcher := newDeleteBatcher(paramtable.Get().ProxyCfg.DeleteByFilterBatchSize.GetAsInt(), func(primaryKeys *schemapb.IDs) error {
+			return dt.produce(ctx, stream, primaryKeys)
+		})
 		for {
 			result, err := client.Recv()
 			if err != nil {
 				if err == io.EOF {
-					log.Debug("query stream for delete finished", zap.Int64("msgID", dt.msgID), zap.Duration("duration", rc.ElapseSpan()))
+					err = batcher.Flush()
+					if err != nil {
+						log.Warn("query stream for delete produce result failed", zap.Int64("msgID", dt.msgID), zap.Error(err))
+						return err
+					}
+					log.Debug("query stream for delete finished",
+						zap.Int64("msgID", dt.msgID),
+						zap.Int("batchNum", batcher.batchNum),
+						zap.Duration("duration", rc.ElapseSpan()))
 					return nil
 				}
 				return err
@@ -327,7 +347,7 @@ func (dt *deleteTask) getStreamingQueryAndDelteFunc(stream msgstream.MsgStream,
 				return err
 			}
 
-			err = dt.produce(ctx, stream, result.GetIds())
+			err = batcher.Add(result.GetIds())
 			if err != nil {
 				log.Warn("query stream for delete produce result failed", zap.Int64("msgID", dt.msgID), zap.Error(err))
 				return err
@@ -336,6 +356,55 @@ func (dt *deleteTask) getStreamingQueryAndDelteFunc(stream msgstream.MsgStream,
 	}
 }
 
+// deleteBatcher regroups the primary keys streamed from querynodes into batches of batchSize,
+// so that deleting by a filter matching millions of rows never produces oversized delete messages,
+// and the tiny streamed results are not sent one message pack each.
+type deleteBatcher struct {
+	batchSize int
+	buffer    *schemapb.IDs
+	size      int
+	batchNum  int
+	produce   func(primaryKeys *schemapb.IDs) error
+}
+
+func newDeleteBatcher(batchSize int, produce func(primaryKeys *schemapb.IDs) error) *deleteBatcher {
+	if batchSize <= 0 {
+		batchSize = 1
+	}
+	return &deleteBatcher{
+		batchSize: batchSize,
+		buffer:    &schemapb.IDs{},
+		produce:   produce,
+	}
+}
+
+// Add buffers the primary keys and produces the full batches.
+func (b *deleteBatcher) Add(primaryKeys *schemapb.IDs) error {
+	num := typeutil.GetSizeOfIDs(primaryKeys)
+	for i := 0; i < num; i++ {
+		typeutil.AppendIDs(b.buffer, primaryKeys, i)
+		b.size++
+		if b.size >= b.batchSize {
+			if err := b.Flush(); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// Flush produces the buffered primary keys if any.
+func (b *deleteBatcher) Flush() error {
+	if b.size == 0 {
+		return nil
+	}
+	primaryKeys := b.buffer
+	b.buffer = &schemapb.IDs{}
+	b.size = 0
+	b.batchNum++
+	return b.produce(primaryKeys)
+}
+
 func (dt *deleteTask) complexDelete(ctx context.Context, plan *planpb.PlanNode, stream msgstream.MsgStream) error {
 	err := dt.lb.Execute(ctx, CollectionWorkLoad{
 		db:             dt.req.GetDbName(),
@@ -374,23 +443,25 @@ func (dt *deleteTask) produce(ctx context.Context, stream msgstream.MsgStream, p
 	// repack delete msg by dmChannel
 	result := make(map[uint32]msgstream.TsMsg)
 	numRows := int64(0)
-	for index, key := range hashValues {
-		vchannel := dt.vChannels[key]
-		_, ok := result[key]
-		if !ok {
-			deleteMsg, err := dt.newDeleteMsg(ctx)
-			if err != nil {
-				return err
+	for index, hashValue := range hashValues {
+		for _, key := range deleteTargetChannels(hashValue, len(dt.vChannels), dt.shardsScaled) {
+			vchannel := dt.vChannels[key]
+			_, ok := result[key]
+			if !ok {
+				deleteMsg, err := dt.newDeleteMsg(ctx)
+				if err != nil {
+					return err
+				}
+				deleteMsg.ShardName = vchannel
+				result[key] = deleteMsg
 			}
-			deleteMsg.ShardName = vchannel
-			result[key] = deleteMsg
-		}
-		curMsg := result[key].(*msgstream.DeleteMsg)
-		curMsg.HashValues = append(curMsg.HashValues, hashValues[index])
-		curMsg.Timestamps = append(curMsg.Timestamps, dt.ts)
+			curMsg := result[key].(*msgstream.DeleteMsg)
+			curMsg.HashValues = append(curMsg.HashValues, key)
+			curMsg.Timestamps = append(curMsg.Timestamps, dt.ts)
 
-		typeutil.AppendIDs(curMsg.PrimaryKeys, primaryKeys, index)
-		curMsg.NumRows++
+			typeutil.AppendIDs(curMsg.PrimaryKeys, primaryKeys, index)
+			curMsg.NumRows++
+		}
 		numRows++
 	}
 
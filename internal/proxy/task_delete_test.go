@@ -139,6 +139,30 @@ func TestDeleteTask_PreExecute(t *testing.T) {
 		assert.Error(t, dt.PreExecute(context.Background()))
 	})
 
+	t.Run("collection read only", func(t *testing.T) {
+		dt := deleteTask{req: &milvuspb.DeleteRequest{
+			CollectionName: "foo",
+			DbName:         "db_1",
+		}}
+		cache := NewMockCache(t)
+		cache.On("GetCollectionID",
+			mock.Anything, // context.Context
+			mock.AnythingOfType("string"),
+			mock.AnythingOfType("string"),
+		).Return(int64(10000), nil)
+		cache.On("GetCollectionInfo",
+			mock.Anything, // context.Context
+			mock.AnythingOfType("string"),
+			mock.AnythingOfType("string"),
+			mock.AnythingOfType("int64"),
+		).Return(&collectionBasicInfo{
+			collID:     10000,
+			properties: map[string]string{common.CollectionReadOnlyKey: "true"},
+		}, nil)
+		globalMetaCache = cache
+		assert.ErrorIs(t, dt.PreExecute(context.Background()), merr.ErrCollectionReadOnly)
+	})
+
 	t.Run("fail partition key mode", func(t *testing.T) {
 		dt := deleteTask{req: &milvuspb.DeleteRequest{
 			CollectionName: "foo",
@@ -150,6 +174,12 @@ func TestDeleteTask_PreExecute(t *testing.T) {
 			mock.AnythingOfType("string"),
 			mock.AnythingOfType("string"),
 		).Return(int64(10000), nil)
+		cache.On("GetCollectionInfo",
+			mock.Anything, // context.Context
+			mock.AnythingOfType("string"),
+			mock.AnythingOfType("string"),
+			mock.AnythingOfType("int64"),
+		).Return(&collectionBasicInfo{collID: 10000}, nil)
 		cache.On("GetCollectionSchema",
 			mock.Anything, // context.Context
 			mock.AnythingOfType("string"),
@@ -172,6 +202,12 @@ func TestDeleteTask_PreExecute(t *testing.T) {
 			mock.AnythingOfType("string"),
 			mock.AnythingOfType("string"),
 		).Return(int64(10000), nil)
+		cache.On("GetCollectionInfo",
+			mock.Anything, // context.Context
+			mock.AnythingOfType("string"),
+			mock.AnythingOfType("string"),
+			mock.AnythingOfType("int64"),
+		).Return(&collectionBasicInfo{collID: 10000}, nil)
 		cache.On("GetCollectionSchema",
 			mock.Anything, // context.Context
 			mock.AnythingOfType("string"),
@@ -210,6 +246,12 @@ func TestDeleteTask_PreExecute(t *testing.T) {
 			mock.AnythingOfType("string"),
 			mock.AnythingOfType("string"),
 		).Return(int64(10000), nil)
+		cache.On("GetCollectionInfo",
+			mock.Anything, // context.Context
+			mock.AnythingOfType("string"),
+			mock.AnythingOfType("string"),
+			mock.AnythingOfType("int64"),
+		).Return(&collectionBasicInfo{collID: 10000}, nil)
 		cache.On("GetCollectionSchema",
 			mock.Anything, // context.Context
 			mock.AnythingOfType("string"),
@@ -664,3 +706,32 @@ func TestDeleteTask_SimpleDelete(t *testing.T) {
 		assert.Error(t, err)
 	})
 }
+
+func TestDeleteBatcher(t *testing.T) {
+	newIDs := func(data ...int64) *schemapb.IDs {
+		return &schemapb.IDs{IdField: &schemapb.IDs_IntId{IntId: &schemapb.LongArray{Data: data}}}
+	}
+
+	var batches [][]int64
+	batcher := newDeleteBatcher(3, func(primaryKeys *schemapb.IDs) error {
+		batches = append(batches, primaryKeys.GetIntId().GetData())
+		return nil
+	})
+	assert.NoError(t, batcher.Add(newIDs(1, 2)))
+	assert.Empty(t, batches)
+	assert.NoError(t, batcher.Add(newIDs(3, 4, 5, 6, 7)))
+	assert.Equal(t, [][]int64{{1, 2, 3}, {4, 5, 6}}, batches)
+	assert.NoError(t, batcher.Add(&schemapb.IDs{}))
+	assert.NoError(t, batcher.Flush())
+	assert.Equal(t, [][]int64{{1, 2, 3}, {4, 5, 6}, {7}}, batches)
+	assert.Equal(t, 3, batcher.batchNum)
+	// nothing buffered
+	assert.NoError(t, batcher.Flush())
+	assert.Len(t, batches, 3)
+
+	batcher = newDeleteBatcher(2, func(primaryKeys *schemapb.IDs) error {
+		return errors.New("mock error")
+	})
+	assert.NoError(t, batcher.Add(newIDs(1)))
+	assert.Error(t, batcher.Add(newIDs(2)))
+}
@@ -433,6 +433,7 @@ type describeIndexTask struct {
 	ctx       context.Context
 	datacoord types.DataCoordClient
 	result    *milvuspb.DescribeIndexResponse
+	progress  []indexBuildProgress
 
 	collectionID UniqueID
 }
@@ -542,6 +543,11 @@ func (dit *describeIndexTask) Execute(ctx context.Context) error {
 			IndexStateFailReason: indexInfo.GetIndexStateFailReason(),
 		}
 		dit.result.IndexDescriptions = append(dit.result.IndexDescriptions, desc)
+		dit.progress = append(dit.progress, indexBuildProgress{
+			indexName:        indexInfo.GetIndexName(),
+			progress:         indexInfo.GetProgress(),
+			remainingSeconds: indexInfo.GetEstimatedRemainingSeconds(),
+		})
 	}
 	return err
 }
@@ -793,6 +799,7 @@ type getIndexBuildProgressTask struct {
 	rootCoord types.RootCoordClient
 	dataCoord types.DataCoordClient
 	result    *milvuspb.GetIndexBuildProgressResponse
+	progress  []indexBuildProgress
 
 	collectionID UniqueID
 }
@@ -870,6 +877,13 @@ func (gibpt *getIndexBuildProgressTask) Execute(ctx context.Context) error {
 		TotalRows:   resp.GetTotalRows(),
 		IndexedRows: resp.GetIndexedRows(),
 	}
+	if merr.Ok(resp.GetStatus()) {
+		gibpt.progress = []indexBuildProgress{{
+			indexName:        gibpt.IndexName,
+			progress:         resp.GetProgress(),
+			remainingSeconds: resp.GetEstimatedRemainingSeconds(),
+		}}
+	}
 
 	return nil
 }
@@ -5,6 +5,7 @@ import (
 	"fmt"
 	"strconv"
 
+	"github.com/cockroachdb/errors"
 	"go.opentelemetry.io/otel"
 	"go.uber.org/zap"
 
@@ -111,6 +112,11 @@ func (it *insertTask) PreExecute(ctx context.Context) error {
 		return err
 	}
 
+	if err := checkCollectionWritable(ctx, it.insertMsg.GetDbName(), collectionName); err != nil {
+		log.Warn("collection is not writable", zap.String("collectionName", collectionName), zap.Error(err))
+		return err
+	}
+
 	schema, err := globalMetaCache.GetCollectionSchema(ctx, it.insertMsg.GetDbName(), collectionName)
 	if err != nil {
 		log.Warn("get collection schema from global meta cache failed", zap.String("collectionName", collectionName), zap.Error(err))
@@ -118,6 +124,17 @@ func (it *insertTask) PreExecute(ctx context.Context) error {
 	}
 	it.schema = schema
 
+	// compute the vectors of the embedding functions from the raw text
+	embeddingFunctions, err := getEmbeddingFunctions(ctx, it.insertMsg.GetDbName(), collectionName)
+	if err != nil {
+		log.Warn("get embedding functions failed", zap.String("collectionName", collectionName), zap.Error(err))
+		return err
+	}
+	if err := fillEmbeddingFieldsData(ctx, embeddingFunctions, schema, it.insertMsg); err != nil {
+		log.Warn("compute embeddings failed", zap.String("collectionName", collectionName), zap.Error(err))
+		return err
+	}
+
 	rowNums := uint32(it.insertMsg.NRows())
 	// set insertTask.rowIDs
 	var rowIDBegin UniqueID
@@ -185,10 +202,10 @@ func (it *insertTask) PreExecute(ctx context.Context) error {
 		}
 	} else {
 		// set default partition name if not use partition key
-		// insert to _default partition
+		// insert to _default partition, or the partition of current interval if the collection is partitioned by time
 		partitionTag := it.insertMsg.GetPartitionName()
 		if len(partitionTag) <= 0 {
-			partitionTag = Params.CommonCfg.DefaultPartitionName.GetValue()
+			partitionTag = getInsertPartitionName(ctx, it.insertMsg.GetDbName(), collectionName)
 			it.insertMsg.PartitionName = partitionTag
 		}
 
@@ -200,7 +217,23 @@ func (it *insertTask) PreExecute(ctx context.Context) error {
 
 	if err := newValidateUtil(withNANCheck(), withOverflowCheck(), withMaxLenCheck(), withMaxCapCheck()).
 		Validate(it.insertMsg.GetFieldsData(), schema, it.insertMsg.NRows()); err != nil {
-		return err
+		var rowErr *rowValidationError
+		if !errors.As(err, &rowErr) || !Params.ProxyCfg.InsertPartialAcceptEnabled.GetAsBool() ||
+			len(rowErr.invalidRows()) == int(it.insertMsg.NRows()) {
+			log.Warn("validate insert data failed", zap.Error(err))
+			return err
+		}
+		log.Warn("skip the invalid rows of insert request", zap.Ints("invalidRows", rowErr.invalidRows()), zap.Error(err))
+		it.skipInvalidRows(rowErr.invalidRows())
+		it.result.Status.Reason = rowErr.Error()
+		if partitionKeyMode {
+			fieldSchema, _ := typeutil.GetPartitionKeyFieldSchema(it.schema)
+			it.partitionKeys, err = getPartitionKeyFieldData(fieldSchema, it.insertMsg)
+			if err != nil {
+				log.Warn("get partition keys from insert request failed", zap.String("collectionName", collectionName), zap.Error(err))
+				return err
+			}
+		}
 	}
 
 	log.Debug("Proxy Insert PreExecute done")
@@ -208,6 +241,38 @@ func (it *insertTask) PreExecute(ctx context.Context) error {
 	return nil
 }
 
+// skipInvalidRows removes the invalid rows from the insert message in partial accept mode,
+// the offsets of the invalid rows in request are reported by ErrIndex of the result.
+func (it *insertTask) skipInvalidRows(invalidRows []int) {
+	invalid := typeutil.NewSet(invalidRows...)
+	numRows := int(it.insertMsg.NRows())
+	fieldsData := make([]*schemapb.FieldData, len(it.insertMsg.GetFieldsData()))
+	rowIDs := make([]UniqueID, 0, numRows-invalid.Len())
+	timestamps := make([]uint64, 0, numRows-invalid.Len())
+	ids := &schemapb.IDs{}
+	succIndex := make([]uint32, 0, numRows-invalid.Len())
+	errIndex := make([]uint32, 0, invalid.Len())
+	for i := 0; i < numRows; i++ {
+		if invalid.Contain(i) {
+			errIndex = append(errIndex, uint32(i))
+			continue
+		}
+		typeutil.AppendFieldData(fieldsData, it.insertMsg.GetFieldsData(), int64(i))
+		rowIDs = append(rowIDs, it.insertMsg.RowIDs[i])
+		timestamps = append(timestamps, it.insertMsg.Timestamps[i])
+		typeutil.AppendIDs(ids, it.result.GetIDs(), i)
+		succIndex = append(succIndex, uint32(i))
+	}
+
+	it.insertMsg.FieldsData = fieldsData
+	it.insertMsg.RowIDs = rowIDs
+	it.insertMsg.Timestamps = timestamps
+	it.insertMsg.NumRows = uint64(len(rowIDs))
+	it.result.IDs = ids
+	it.result.SuccIndex = succIndex
+	it.result.ErrIndex = errIndex
+}
+
 func (it *insertTask) Execute(ctx context.Context) error {
 	ctx, sp := otel.Tracer(typeutil.ProxyRole).Start(ctx, "Proxy-Insert-Execute")
 	defer sp.End()
@@ -8,6 +8,7 @@ import (
 	"github.com/stretchr/testify/mock"
 
 	"github.com/milvus-io/milvus-proto/go-api/v2/commonpb"
+	"github.com/milvus-io/milvus-proto/go-api/v2/milvuspb"
 	"github.com/milvus-io/milvus-proto/go-api/v2/msgpb"
 	"github.com/milvus-io/milvus-proto/go-api/v2/schemapb"
 	"github.com/milvus-io/milvus/pkg/mq/msgstream"
@@ -252,4 +253,42 @@ func TestInsertTask(t *testing.T) {
 		assert.ElementsMatch(t, channels, resChannels)
 		assert.ElementsMatch(t, channels, it.pChannels)
 	})
+	t.Run("test skipInvalidRows", func(t *testing.T) {
+		it := insertTask{
+			insertMsg: &msgstream.InsertMsg{
+				InsertRequest: msgpb.InsertRequest{
+					NumRows:    4,
+					RowIDs:     []int64{10, 11, 12, 13},
+					Timestamps: []uint64{1, 1, 1, 1},
+					FieldsData: []*schemapb.FieldData{
+						{
+							FieldName: "pk",
+							Type:      schemapb.DataType_Int64,
+							Field: &schemapb.FieldData_Scalars{
+								Scalars: &schemapb.ScalarField{
+									Data: &schemapb.ScalarField_LongData{
+										LongData: &schemapb.LongArray{Data: []int64{0, 1, 2, 3}},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			result: &milvuspb.MutationResult{
+				IDs: &schemapb.IDs{
+					IdField: &schemapb.IDs_IntId{IntId: &schemapb.LongArray{Data: []int64{0, 1, 2, 3}}},
+				},
+			},
+		}
+		it.skipInvalidRows([]int{1, 3})
+		assert.EqualValues(t, 2, it.insertMsg.NRows())
+		assert.Equal(t, []int64{10, 12}, it.insertMsg.GetRowIDs())
+		assert.Equal(t, []uint64{1, 1}, it.insertMsg.GetTimestamps())
+		assert.Equal(t, []int64{0, 2}, it.insertMsg.GetFieldsData()[0].GetScalars().GetLongData().GetData())
+		assert.Equal(t, []int64{0, 2}, it.result.GetIDs().GetIntId().GetData())
+		assert.Equal(t, []uint32{0, 2}, it.result.GetSuccIndex())
+		assert.Equal(t, []uint32{1, 3}, it.result.GetErrIndex())
+		assert.NoError(t, it.insertMsg.CheckAligned())
+	})
 }
@@ -61,12 +61,29 @@ type queryTask struct {
 	plan             *planpb.PlanNode
 	partitionKeyMode bool
 	lb               LBPolicy
+
+	// cursor of the next page returned to the query iterator
+	nextCursor string
+	// the results of user requests are truncated by proxy.resultSizeLimit,
+	// but never those of internal queries, such as requery
+	truncatable bool
+	truncation  *resultTruncation
+	// the internal queries issued by the dql tasks being executed, such as requery,
+	// are executed directly instead of waiting in the dql pipeline occupied by their issuers
+	direct bool
 }
 
 type queryParams struct {
 	limit             int64
 	offset            int64
 	reduceStopForBest bool
+	iterator          bool
+	// cursor is nil if not provided, which means the first page of the iterator
+	cursor *queryCursor
+	// the page of iterator is cut once its output exceeds chunkSize, 0 means never cut,
+	// chunked is set by reduce if the page is cut
+	chunkSize int64
+	chunked   bool
 }
 
 // translateToOutputFieldIDs translates output fields name to output fields id.
@@ -131,8 +148,29 @@ func parseQueryParams(queryParamsPair []*commonpb.KeyValuePair) (*queryParams, e
 		limit             int64
 		offset            int64
 		reduceStopForBest bool
+		iterator          bool
+		cursor            *queryCursor
 		err               error
 	)
+	iteratorStr, err := funcutil.GetAttrByKeyFromRepeatedKV(IteratorKey, queryParamsPair)
+	// if iterator is provided
+	if err == nil {
+		iterator, err = strconv.ParseBool(iteratorStr)
+		if err != nil {
+			return nil, merr.WrapErrParameterInvalid("true or false", iteratorStr,
+				"value for iterator is invalid")
+		}
+	}
+	cursorStr, err := funcutil.GetAttrByKeyFromRepeatedKV(IteratorCursorKey, queryParamsPair)
+	// the cursor of the previous page implies iterator
+	if err == nil && cursorStr != "" {
+		iterator = true
+		cursor, err = decodeQueryCursor(cursorStr)
+		if err != nil {
+			return nil, err
+		}
+	}
+
 	reduceStopForBestStr, err := funcutil.GetAttrByKeyFromRepeatedKV(ReduceStopForBestKey, queryParamsPair)
 	// if reduce_stop_for_best is provided
 	if err == nil {
@@ -146,6 +184,9 @@ func parseQueryParams(queryParamsPair []*commonpb.KeyValuePair) (*queryParams, e
 	limitStr, err := funcutil.GetAttrByKeyFromRepeatedKV(LimitKey, queryParamsPair)
 	// if limit is not provided
 	if err != nil {
+		if iterator {
+			return nil, merr.WrapErrParameterInvalidMsg("%s is required by the query iterator", LimitKey)
+		}
 		return &queryParams{limit: typeutil.Unlimited, reduceStopForBest: reduceStopForBest}, nil
 	}
 	limit, err = strconv.ParseInt(limitStr, 0, 64)
@@ -167,10 +208,23 @@ func parseQueryParams(queryParamsPair []*commonpb.KeyValuePair) (*queryParams, e
 		return nil, fmt.Errorf("invalid max query result window, %w", err)
 	}
 
+	// the iterator pages by the cursor instead of offset
+	if iterator && offset != 0 {
+		return nil, merr.WrapErrParameterInvalidMsg("%s is not allowed by the query iterator", OffsetKey)
+	}
+
+	var chunkSize int64
+	if iterator {
+		chunkSize = Params.ProxyCfg.QueryIteratorMaxChunkSize.GetAsInt64()
+	}
+
 	return &queryParams{
 		limit:             limit,
 		offset:            offset,
 		reduceStopForBest: reduceStopForBest,
+		iterator:          iterator,
+		cursor:            cursor,
+		chunkSize:         chunkSize,
 	}, nil
 }
 
@@ -293,10 +347,20 @@ func (t *queryTask) PreExecute(ctx context.Context) error {
 	}
 	t.RetrieveRequest.IgnoreGrowing = ignoreGrowing
 
+	explain, restParams, err := parseExplain(t.request.GetQueryParams())
+	if err != nil {
+		return err
+	}
+	t.request.QueryParams = restParams
+	t.RetrieveRequest.Explain = explain
+
 	queryParams, err := parseQueryParams(t.request.GetQueryParams())
 	if err != nil {
 		return err
 	}
+	if explain && queryParams.iterator {
+		return merr.WrapErrParameterInvalidMsg("%s is not supported by the query iterator", ExplainKey)
+	}
 	t.RetrieveRequest.ReduceStopForBest = queryParams.reduceStopForBest
 
 	t.queryParams = queryParams
@@ -315,6 +379,28 @@ func (t *queryTask) PreExecute(ctx context.Context) error {
 		t.request.Expr = IDs2Expr(pkField, t.ids)
 	}
 
+	if err := t.resolveSubqueries(ctx); err != nil {
+		log.Warn("failed to resolve subqueries", zap.Error(err))
+		return err
+	}
+
+	if queryParams.iterator {
+		if t.request.GetTravelTimestamp() > 0 {
+			return merr.WrapErrParameterInvalidMsg("travel timestamp is not allowed by the query iterator")
+		}
+		// continue after the primary key of the previous page
+		if queryParams.cursor != nil {
+			pkField, err := typeutil.GetPrimaryFieldSchema(schema)
+			if err != nil {
+				return err
+			}
+			t.request.Expr, err = cursorExpr(t.request.GetExpr(), pkField, queryParams.cursor)
+			if err != nil {
+				return err
+			}
+		}
+	}
+
 	if err := t.createPlan(ctx); err != nil {
 		return err
 	}
@@ -384,6 +470,32 @@ func (t *queryTask) PreExecute(ctx context.Context) error {
 			guaranteeTs = parseGuaranteeTsFromConsistency(guaranteeTs, t.BeginTs(), consistencyLevel)
 		}
 	}
+
+	travelTs := t.request.GetTravelTimestamp()
+	if err := validateTravelTimestamp(travelTs, t.BeginTs(), collectionInfo.properties); err != nil {
+		log.Warn("invalid travel timestamp", zap.Uint64("travelTs", travelTs), zap.Error(err))
+		return err
+	}
+	if travelTs > 0 {
+		// read the snapshot at travel timestamp, which must be consumed by query node first
+		t.MvccTimestamp = travelTs
+		guaranteeTs = funcutil.Max(guaranteeTs, travelTs)
+	}
+	if queryParams.iterator {
+		// all pages read the snapshot pinned by the first page, which must be consumed completely
+		if queryParams.cursor == nil {
+			snapshotTs := guaranteeTs
+			if snapshotTs <= 1 {
+				snapshotTs = t.BeginTs()
+			}
+			queryParams.cursor = &queryCursor{Ts: snapshotTs}
+		} else if err := queryParams.cursor.validate(t.BeginTs()); err != nil {
+			log.Warn("invalid query iterator cursor", zap.Error(err))
+			return err
+		}
+		t.MvccTimestamp = queryParams.cursor.Ts
+		guaranteeTs = queryParams.cursor.Ts
+	}
 	t.GuaranteeTimestamp = guaranteeTs
 
 	deadline, ok := t.TraceCtx().Deadline()
@@ -449,6 +561,21 @@ func (t *queryTask) PostExecute(ctx context.Context) error {
 		})
 	}
 
+	// the executed plans of all segments queried are returned instead of the results
+	if t.RetrieveRequest.GetExplain() {
+		explains := make([]string, 0)
+		for _, result := range toReduceResults {
+			explains = append(explains, result.GetExplains()...)
+		}
+		t.result = &milvuspb.QueryResults{
+			Status:         merr.Success(),
+			CollectionName: t.collectionName,
+			FieldsData:     []*schemapb.FieldData{newExplainFieldData(explains)},
+			OutputFields:   []string{ExplainFieldName},
+		}
+		return nil
+	}
+
 	metrics.ProxyDecodeResultLatency.WithLabelValues(strconv.FormatInt(paramtable.GetNodeID(), 10), metrics.QueryLabel).Observe(0.0)
 	tr.CtxRecord(ctx, "reduceResultStart")
 
@@ -460,6 +587,20 @@ func (t *queryTask) PostExecute(ctx context.Context) error {
 		return err
 	}
 	t.result.OutputFields = t.userOutputFields
+	if t.truncatable {
+		t.truncation = truncateQueryResults(t.result, t.queryParams.offset)
+	}
+	if t.truncation != nil {
+		// the rest entities are returned by the following pages of iterator
+		t.queryParams.chunked = true
+	}
+	if t.queryParams.iterator {
+		pkField, err := typeutil.GetPrimaryFieldSchema(t.schema)
+		if err != nil {
+			return err
+		}
+		t.nextCursor = nextQueryCursor(t.result.GetFieldsData(), pkField, t.queryParams.cursor).encode()
+	}
 	metrics.ProxyReduceResultLatency.WithLabelValues(strconv.FormatInt(paramtable.GetNodeID(), 10), metrics.QueryLabel).Observe(float64(tr.RecordSpan().Milliseconds()))
 
 	log.Debug("Query PostExecute done")
@@ -589,6 +730,12 @@ func reduceRetrieveResults(ctx context.Context, retrieveResults []*internalpb.Re
 		}
 
 		cursors[sel]++
+
+		// the rest entities are returned by the following pages of iterator
+		if queryParams != nil && queryParams.chunkSize > 0 && retSize >= queryParams.chunkSize {
+			queryParams.chunked = true
+			break
+		}
 	}
 
 	if skipDupCnt > 0 {
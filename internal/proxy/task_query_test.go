@@ -586,6 +586,20 @@ func TestTaskQuery_functions(t *testing.T) {
 				}
 			})
 
+			t.Run("test iterator chunk size", func(t *testing.T) {
+				params := &queryParams{limit: 4, iterator: true, chunkSize: 1}
+				result, err := reduceRetrieveResults(context.Background(), []*internalpb.RetrieveResults{r1, r2}, params)
+				assert.NoError(t, err)
+				assert.True(t, params.chunked)
+				assert.Equal(t, []int64{11}, result.GetFieldsData()[0].GetScalars().GetLongData().Data)
+
+				params = &queryParams{limit: 4, iterator: true, chunkSize: 1 << 20}
+				result, err = reduceRetrieveResults(context.Background(), []*internalpb.RetrieveResults{r1, r2}, params)
+				assert.NoError(t, err)
+				assert.False(t, params.chunked)
+				assert.Equal(t, []int64{11, 22}, result.GetFieldsData()[0].GetScalars().GetLongData().Data)
+			})
+
 			t.Run("test stop reduce for best for limit", func(t *testing.T) {
 				result, err := reduceRetrieveResults(context.Background(),
 					[]*internalpb.RetrieveResults{r1, r2},
@@ -20,16 +20,13 @@ import (
 	"container/list"
 	"context"
 	"sync"
-	"time"
 
 	"go.opentelemetry.io/otel"
 	"go.uber.org/zap"
 
 	"github.com/milvus-io/milvus/pkg/log"
 	"github.com/milvus-io/milvus/pkg/mq/msgstream"
-	"github.com/milvus-io/milvus/pkg/util/conc"
 	"github.com/milvus-io/milvus/pkg/util/merr"
-	"github.com/milvus-io/milvus/pkg/util/paramtable"
 	"github.com/milvus-io/milvus/pkg/util/typeutil"
 )
 
@@ -378,6 +375,9 @@ type taskScheduler struct {
 	// data control queue, use for such as flush operation, which control the data status
 	dcQueue *ddTaskQueue
 
+	// executes the dql tasks in stages
+	dqPipeline *dqlPipeline
+
 	wg     sync.WaitGroup
 	ctx    context.Context
 	cancel context.CancelFunc
@@ -520,7 +520,6 @@ func (sched *taskScheduler) manipulationLoop() {
 func (sched *taskScheduler) queryLoop() {
 	defer sched.wg.Done()
 
-	pool := conc.NewPool[struct{}](paramtable.Get().ProxyCfg.MaxTaskNum.GetAsInt(), conc.WithExpiryDuration(time.Minute))
 	for {
 		select {
 		case <-sched.ctx.Done():
@@ -528,10 +527,7 @@ func (sched *taskScheduler) queryLoop() {
 		case <-sched.dqQueue.utChan():
 			if !sched.dqQueue.utEmpty() {
 				t := sched.scheduleDqTask()
-				pool.Submit(func() (struct{}, error) {
-					sched.processTask(t, sched.dqQueue)
-					return struct{}{}, nil
-				})
+				sched.dqPipeline.submit(t)
 			} else {
 				log.Debug("query queue is empty ...")
 			}
@@ -539,6 +535,22 @@ func (sched *taskScheduler) queryLoop() {
 	}
 }
 
+// executeDqTask allocates the timestamp of the dql task and executes it in the caller goroutine instead of the dql pipeline,
+// the error is returned only if the task fails to be set up, the result of execution is notified to the task.
+func (sched *taskScheduler) executeDqTask(t task) error {
+	if err := t.OnEnqueue(); err != nil {
+		return err
+	}
+	ts, err := sched.dqQueue.tsoAllocatorIns.AllocOne(t.TraceCtx())
+	if err != nil {
+		return err
+	}
+	t.SetTs(ts)
+	t.SetID(UniqueID(ts))
+	sched.dqPipeline.execute(t)
+	return nil
+}
+
 func (sched *taskScheduler) Start() error {
 	sched.wg.Add(1)
 	go sched.definitionLoop()
@@ -549,6 +561,8 @@ func (sched *taskScheduler) Start() error {
 	sched.wg.Add(1)
 	go sched.manipulationLoop()
 
+	sched.dqPipeline = newDqlPipeline(sched.ctx, sched.dqQueue)
+	sched.dqPipeline.start()
 	sched.wg.Add(1)
 	go sched.queryLoop()
 
@@ -558,6 +572,9 @@ func (sched *taskScheduler) Start() error {
 func (sched *taskScheduler) Close() {
 	sched.cancel()
 	sched.wg.Wait()
+	if sched.dqPipeline != nil {
+		sched.dqPipeline.close()
+	}
 }
 
 func (sched *taskScheduler) getPChanStatistics() (map[pChan]*pChanStatistics, error) {
@@ -2,16 +2,20 @@ package proxy
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
 	"math"
 	"regexp"
 	"strconv"
+	"strings"
 
 	"github.com/cockroachdb/errors"
 	"github.com/golang/protobuf/proto"
 	"github.com/samber/lo"
 	"go.opentelemetry.io/otel"
 	"go.uber.org/zap"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
 
 	"github.com/milvus-io/milvus-proto/go-api/v2/commonpb"
 	"github.com/milvus-io/milvus-proto/go-api/v2/milvuspb"
@@ -21,11 +25,13 @@ import (
 	"github.com/milvus-io/milvus/internal/proto/planpb"
 	"github.com/milvus-io/milvus/internal/proto/querypb"
 	"github.com/milvus-io/milvus/internal/types"
+	typeutil2 "github.com/milvus-io/milvus/internal/util/typeutil"
 	"github.com/milvus-io/milvus/pkg/common"
 	"github.com/milvus-io/milvus/pkg/log"
 	"github.com/milvus-io/milvus/pkg/metrics"
 	"github.com/milvus-io/milvus/pkg/util/commonpbutil"
 	"github.com/milvus-io/milvus/pkg/util/funcutil"
+	"github.com/milvus-io/milvus/pkg/util/indexparams"
 	"github.com/milvus-io/milvus/pkg/util/merr"
 	"github.com/milvus-io/milvus/pkg/util/metric"
 	"github.com/milvus-io/milvus/pkg/util/paramtable"
@@ -38,6 +44,10 @@ const (
 	SearchTaskName = "SearchTask"
 	SearchLevelKey = "level"
 
+	// the grpc response headers telling the search results are partial and the segments missed
+	SearchPartialResultHeader  = "search-partial-result"
+	SearchMissedSegmentsHeader = "search-missed-segments"
+
 	// requeryThreshold is the estimated threshold for the size of the search results.
 	// If the number of estimated search results exceeds this threshold,
 	// a second query request will be initiated to retrieve output fields data.
@@ -53,16 +63,27 @@ type searchTask struct {
 	result  *milvuspb.SearchResults
 	request *milvuspb.SearchRequest
 
-	tr             *timerecord.TimeRecorder
-	collectionName string
-	schema         *schemapb.CollectionSchema
-	requery        bool
+	tr               *timerecord.TimeRecorder
+	collectionName   string
+	schema           *schemapb.CollectionSchema
+	partitionKeyMode bool
+	requery          bool
 
 	userOutputFields []string
 
+	groupByField         *schemapb.FieldSchema
+	groupByFieldAppended bool // the group by field is appended to the output fields only for grouping
+
 	offset    int64
 	resultBuf *typeutil.ConcurrentSet[*internalpb.SearchResults]
 
+	// the segments not searched in time if partial result allowed
+	partial        bool
+	missedSegments []int64
+
+	// set if the results are truncated by proxy.resultSizeLimit
+	truncation *resultTruncation
+
 	qc   types.QueryCoordClient
 	node types.ProxyComponent
 	lb   LBPolicy
@@ -161,6 +182,9 @@ func parseSearchInfo(searchParamsPair []*commonpb.KeyValuePair) (*planpb.QueryIn
 	if err != nil {
 		searchParamStr = ""
 	}
+	if err := checkDiskANNSearchParams(searchParamStr); err != nil {
+		return nil, 0, err
+	}
 	return &planpb.QueryInfo{
 		Topk:         queryTopK,
 		MetricType:   metricType,
@@ -169,6 +193,123 @@ func parseSearchInfo(searchParamsPair []*commonpb.KeyValuePair) (*planpb.QueryIn
 	}, offset, nil
 }
 
+// getGroupByField returns the field to group the search results by, nil if not grouping.
+func getGroupByField(schema *schemapb.CollectionSchema, searchParamsPair []*commonpb.KeyValuePair) (*schemapb.FieldSchema, error) {
+	fieldName, err := funcutil.GetAttrByKeyFromRepeatedKV(GroupByFieldKey, searchParamsPair)
+	if err != nil || fieldName == "" {
+		return nil, nil
+	}
+	for _, field := range schema.GetFields() {
+		if field.GetName() != fieldName {
+			continue
+		}
+		switch field.GetDataType() {
+		case schemapb.DataType_Bool, schemapb.DataType_Int8, schemapb.DataType_Int16,
+			schemapb.DataType_Int32, schemapb.DataType_Int64, schemapb.DataType_VarChar:
+		default:
+			return nil, merr.WrapErrParameterInvalidMsg("%s [%s] is invalid, group by field of type %s is not supported",
+				GroupByFieldKey, fieldName, field.GetDataType().String())
+		}
+		if field.GetIsDynamic() {
+			return nil, merr.WrapErrParameterInvalidMsg("%s [%s] is invalid, group by dynamic field is not supported", GroupByFieldKey, fieldName)
+		}
+		return field, nil
+	}
+	return nil, merr.WrapErrParameterInvalidMsg("%s [%s] is invalid, field not found", GroupByFieldKey, fieldName)
+}
+
+// parsePartialResultPolicy pops the partial result policy from the search params,
+// the segment timeout is in milliseconds, 0 means the default of QueryNode.
+func parsePartialResultPolicy(searchParamsPair []*commonpb.KeyValuePair) (bool, int64, []*commonpb.KeyValuePair, error) {
+	var (
+		allowPartial   bool
+		segmentTimeout int64
+		err            error
+	)
+	rest := make([]*commonpb.KeyValuePair, 0, len(searchParamsPair))
+	for _, kv := range searchParamsPair {
+		switch kv.GetKey() {
+		case PartialResultKey:
+			allowPartial, err = strconv.ParseBool(kv.GetValue())
+			if err != nil {
+				return false, 0, nil, merr.WrapErrParameterInvalidMsg("%s [%s] is invalid, should be true or false", PartialResultKey, kv.GetValue())
+			}
+		case SegmentTimeoutKey:
+			segmentTimeout, err = strconv.ParseInt(kv.GetValue(), 10, 64)
+			if err != nil || segmentTimeout < 0 {
+				return false, 0, nil, merr.WrapErrParameterInvalidMsg("%s [%s] is invalid, should be non-negative integer in milliseconds", SegmentTimeoutKey, kv.GetValue())
+			}
+		default:
+			rest = append(rest, kv)
+		}
+	}
+	if segmentTimeout > 0 && !allowPartial {
+		return false, 0, nil, merr.WrapErrParameterInvalidMsg("%s takes effect only if %s is true", SegmentTimeoutKey, PartialResultKey)
+	}
+	return allowPartial, segmentTimeout, rest, nil
+}
+
+// applyGroupBy makes the query info to group the results by the field,
+// the topK is applied to each group, and more candidates are searched to fill the groups.
+func applyGroupBy(queryInfo *planpb.QueryInfo, field *schemapb.FieldSchema, offset int64) (groupSize int64, err error) {
+	if offset != 0 {
+		return 0, merr.WrapErrParameterInvalidMsg("%s is not supported in group by search", OffsetKey)
+	}
+	groupSize = queryInfo.GetTopk()
+	candidates := groupSize * Params.ProxyCfg.GroupBySearchCandidateFactor.GetAsInt64()
+	if topKLimit := Params.QuotaConfig.TopKLimit.GetAsInt64(); candidates > topKLimit {
+		candidates = topKLimit
+	}
+	if candidates < groupSize {
+		candidates = groupSize
+	}
+
+	// segcore reads the group by settings from the search params
+	searchParams := make(map[string]interface{})
+	if queryInfo.GetSearchParams() != "" {
+		if err := json.Unmarshal([]byte(queryInfo.GetSearchParams()), &searchParams); err != nil {
+			return 0, merr.WrapErrParameterInvalidMsg("%s [%s] is invalid, %s", SearchParamsKey, queryInfo.GetSearchParams(), err.Error())
+		}
+	}
+	searchParams[common.GroupByFieldIDKey] = field.GetFieldID()
+	searchParams[common.GroupSizeKey] = groupSize
+	searchParamsBytes, err := json.Marshal(searchParams)
+	if err != nil {
+		return 0, err
+	}
+	queryInfo.SearchParams = string(searchParamsBytes)
+	queryInfo.Topk = candidates
+	return groupSize, nil
+}
+
+// checkDiskANNSearchParams checks the DiskANN params set by the search request are within the server side limits.
+func checkDiskANNSearchParams(searchParamStr string) error {
+	if searchParamStr == "" {
+		return nil
+	}
+	searchParams := make(map[string]interface{})
+	if err := json.Unmarshal([]byte(searchParamStr), &searchParams); err != nil {
+		// leave the malformed params to the index checking
+		return nil
+	}
+
+	limits := map[string]int64{
+		indexparams.BeamWidthKey:  Params.CommonCfg.MaxSearchBeamWidth.GetAsInt64(),
+		indexparams.SearchListKey: Params.CommonCfg.MaxSearchListSize.GetAsInt64(),
+	}
+	for key, limit := range limits {
+		v, ok := searchParams[key]
+		if !ok {
+			continue
+		}
+		value, ok := v.(float64)
+		if !ok || value != math.Trunc(value) || value < 1 || int64(value) > limit {
+			return merr.WrapErrParameterInvalidMsg("%s [%v] is invalid, should be an integer in range [1, %d]", key, v, limit)
+		}
+	}
+	return nil
+}
+
 func getOutputFieldIDs(schema *schemapb.CollectionSchema, outputFields []string) (outputFieldIDs []UniqueID, err error) {
 	outputFieldIDs = make([]UniqueID, 0, len(outputFields))
 	for _, name := range outputFields {
@@ -208,6 +349,14 @@ func (t *searchTask) PreExecute(ctx context.Context) error {
 	ctx, sp := otel.Tracer(typeutil.ProxyRole).Start(ctx, "Proxy-Search-PreExecute")
 	defer sp.End()
 
+	if err := t.validate(ctx); err != nil {
+		return err
+	}
+	return t.plan(ctx)
+}
+
+// validate validates the search request against the collection schema.
+func (t *searchTask) validate(ctx context.Context) error {
 	t.Base.MsgType = commonpb.MsgType_Search
 	t.Base.SourceID = paramtable.GetNodeID()
 	log := log.Ctx(ctx)
@@ -227,12 +376,38 @@ func (t *searchTask) PreExecute(ctx context.Context) error {
 		return err
 	}
 
-	partitionKeyMode, err := isPartitionKeyMode(ctx, t.request.GetDbName(), collectionName)
+	// fill the search params omitted by request with the collection defaults
+	collectionInfo, err := globalMetaCache.GetCollectionInfo(ctx, t.request.GetDbName(), collectionName, collID)
+	if err != nil {
+		log.Warn("get collection info failed", zap.Error(err))
+		return err
+	}
+	t.request.SearchParams, err = applyDefaultSearchParams(t.request.GetSearchParams(), collectionInfo.properties)
+	if err != nil {
+		log.Warn("apply default search params failed", zap.Error(err))
+		return err
+	}
+
+	// search with the raw text if the anns field is computed by embedding function
+	if Params.ProxyCfg.EmbeddingEnabled.GetAsBool() {
+		embeddingFunctions, err := parseEmbeddingFunctions(collectionInfo.properties)
+		if err != nil {
+			log.Warn("get embedding functions failed", zap.Error(err))
+			return err
+		}
+		t.request.PlaceholderGroup, err = embedSearchPlaceholder(ctx, embeddingFunctions, t.schema, t.request.GetSearchParams(), t.request.GetPlaceholderGroup())
+		if err != nil {
+			log.Warn("compute embeddings of search text failed", zap.Error(err))
+			return err
+		}
+	}
+
+	t.partitionKeyMode, err = isPartitionKeyMode(ctx, t.request.GetDbName(), collectionName)
 	if err != nil {
 		log.Warn("is partition key mode failed", zap.Error(err))
 		return err
 	}
-	if partitionKeyMode && len(t.request.GetPartitionNames()) != 0 {
+	if t.partitionKeyMode && len(t.request.GetPartitionNames()) != 0 {
 		return errors.New("not support manually specifying the partition names if partition key mode is used")
 	}
 
@@ -244,6 +419,17 @@ func (t *searchTask) PreExecute(ctx context.Context) error {
 	log.Debug("translate output fields",
 		zap.Strings("output fields", t.request.GetOutputFields()))
 
+	// the values of group by field are required to reduce the results in groups
+	t.groupByField, err = getGroupByField(t.schema, t.request.GetSearchParams())
+	if err != nil {
+		log.Warn("invalid group by field", zap.Error(err))
+		return err
+	}
+	if t.groupByField != nil && !lo.Contains(t.request.GetOutputFields(), t.groupByField.GetName()) {
+		t.request.OutputFields = append(t.request.OutputFields, t.groupByField.GetName())
+		t.groupByFieldAppended = true
+	}
+
 	// fetch search_growing from search param
 	var ignoreGrowing bool
 	for i, kv := range t.request.GetSearchParams() {
@@ -258,6 +444,23 @@ func (t *searchTask) PreExecute(ctx context.Context) error {
 	}
 	t.SearchRequest.IgnoreGrowing = ignoreGrowing
 
+	allowPartial, segmentTimeout, searchParams, err := parsePartialResultPolicy(t.request.GetSearchParams())
+	if err != nil {
+		log.Warn("invalid partial result policy", zap.Error(err))
+		return err
+	}
+	t.request.SearchParams = searchParams
+	t.SearchRequest.AllowPartialResult = allowPartial
+	t.SearchRequest.SegmentTimeout = segmentTimeout
+
+	explain, searchParams, err := parseExplain(t.request.GetSearchParams())
+	if err != nil {
+		log.Warn("invalid explain flag", zap.Error(err))
+		return err
+	}
+	t.request.SearchParams = searchParams
+	t.SearchRequest.Explain = explain
+
 	// Manually update nq if not set.
 	nq, err := getNq(t.request)
 	if err != nil {
@@ -277,6 +480,18 @@ func (t *searchTask) PreExecute(ctx context.Context) error {
 		return err
 	}
 	t.SearchRequest.OutputFieldsId = outputFieldIDs
+	return nil
+}
+
+// plan creates the search plan, and resolves the partitions and timestamps to search.
+func (t *searchTask) plan(ctx context.Context) error {
+	log := log.Ctx(ctx)
+	var (
+		collectionName = t.collectionName
+		nq             = t.SearchRequest.GetNq()
+		outputFieldIDs = t.SearchRequest.GetOutputFieldsId()
+		err            error
+	)
 
 	partitionNames := t.request.GetPartitionNames()
 	if t.request.GetDslType() == commonpb.DslType_BoolExprV1 {
@@ -297,6 +512,15 @@ func (t *searchTask) PreExecute(ctx context.Context) error {
 		}
 		t.offset = offset
 
+		if t.groupByField != nil {
+			groupSize, err := applyGroupBy(queryInfo, t.groupByField, offset)
+			if err != nil {
+				return err
+			}
+			t.SearchRequest.GroupByFieldId = t.groupByField.GetFieldID()
+			t.SearchRequest.GroupSize = groupSize
+		}
+
 		plan, err := planparserv2.CreateSearchPlan(t.schema, t.request.Dsl, annsField, queryInfo)
 		if err != nil {
 			log.Warn("failed to create query plan", zap.Error(err),
@@ -308,7 +532,7 @@ func (t *searchTask) PreExecute(ctx context.Context) error {
 			zap.String("dsl", t.request.Dsl), // may be very large if large term passed.
 			zap.String("anns field", annsField), zap.Any("query info", queryInfo))
 
-		if partitionKeyMode {
+		if t.partitionKeyMode {
 			expr, err := ParseExprFromPlan(plan)
 			if err != nil {
 				log.Warn("failed to parse expr", zap.Error(err))
@@ -338,6 +562,9 @@ func (t *searchTask) PreExecute(ctx context.Context) error {
 		if estimateSize >= requeryThreshold {
 			t.requery = true
 			plan.OutputFieldIds = nil
+			if t.groupByField != nil {
+				plan.OutputFieldIds = []int64{t.groupByField.GetFieldID()}
+			}
 		}
 
 		t.SearchRequest.SerializedExprPlan, err = proto.Marshal(plan)
@@ -367,7 +594,11 @@ func (t *searchTask) PreExecute(ctx context.Context) error {
 	var consistencyLevel commonpb.ConsistencyLevel
 	useDefaultConsistency := t.request.GetUseDefaultConsistency()
 	if useDefaultConsistency {
-		consistencyLevel = collectionInfo.consistencyLevel
+		consistencyLevel, err = getDefaultConsistencyLevel(collectionInfo.properties, collectionInfo.consistencyLevel)
+		if err != nil {
+			log.Warn("invalid default consistency level", zap.Error(err))
+			return err
+		}
 		guaranteeTs = parseGuaranteeTsFromConsistency(guaranteeTs, t.BeginTs(), consistencyLevel)
 	} else {
 		consistencyLevel = t.request.GetConsistencyLevel()
@@ -379,6 +610,17 @@ func (t *searchTask) PreExecute(ctx context.Context) error {
 			guaranteeTs = parseGuaranteeTsFromConsistency(guaranteeTs, t.BeginTs(), consistencyLevel)
 		}
 	}
+
+	travelTs := t.request.GetTravelTimestamp()
+	if err := validateTravelTimestamp(travelTs, t.BeginTs(), collectionInfo.properties); err != nil {
+		log.Warn("invalid travel timestamp", zap.Uint64("travelTs", travelTs), zap.Error(err))
+		return err
+	}
+	if travelTs > 0 {
+		// read the snapshot at travel timestamp, which must be consumed by query node first
+		t.SearchRequest.MvccTimestamp = travelTs
+		guaranteeTs = funcutil.Max(guaranteeTs, travelTs)
+	}
 	t.SearchRequest.GuaranteeTimestamp = guaranteeTs
 
 	if deadline, ok := t.TraceCtx().Deadline(); ok {
@@ -401,6 +643,10 @@ func (t *searchTask) PreExecute(ctx context.Context) error {
 	return nil
 }
 
+func (t *searchTask) stageFuncs() []dqlStageFunc {
+	return []dqlStageFunc{t.validate, t.plan, t.Execute, t.reduce, t.requeryOutputFields}
+}
+
 func (t *searchTask) Execute(ctx context.Context) error {
 	ctx, sp := otel.Tracer(typeutil.ProxyRole).Start(ctx, "Proxy-Search-Execute")
 	defer sp.End()
@@ -433,7 +679,45 @@ func (t *searchTask) PostExecute(ctx context.Context) error {
 	ctx, sp := otel.Tracer(typeutil.ProxyRole).Start(ctx, "Proxy-Search-PostExecute")
 	defer sp.End()
 
-	tr := timerecord.NewTimeRecorder("searchTask PostExecute")
+	if t.SearchRequest.GetExplain() {
+		return t.reduceExplains(ctx)
+	}
+	if err := t.reduce(ctx); err != nil {
+		return err
+	}
+	return t.requeryOutputFields(ctx)
+}
+
+// reduceExplains returns the executed plans of all segments searched instead of the results.
+func (t *searchTask) reduceExplains(ctx context.Context) error {
+	toReduceResults, err := t.collectSearchResults(ctx)
+	if err != nil {
+		log.Ctx(ctx).Warn("failed to collect search explains", zap.Error(err))
+		return err
+	}
+	explains := make([]string, 0)
+	for _, result := range toReduceResults {
+		explains = append(explains, result.GetExplains()...)
+	}
+
+	nq := t.SearchRequest.GetNq()
+	t.result = &milvuspb.SearchResults{
+		Status:         merr.Success(),
+		CollectionName: t.collectionName,
+		Results: &schemapb.SearchResultData{
+			NumQueries:   nq,
+			TopK:         t.SearchRequest.GetTopk(),
+			Topks:        make([]int64, nq),
+			FieldsData:   []*schemapb.FieldData{newExplainFieldData(explains)},
+			OutputFields: []string{ExplainFieldName},
+		},
+	}
+	return nil
+}
+
+// reduce decodes and reduces the search results of shards.
+func (t *searchTask) reduce(ctx context.Context) error {
+	tr := timerecord.NewTimeRecorder("searchTask reduce")
 	defer func() {
 		tr.CtxElapse(ctx, "done")
 	}()
@@ -453,6 +737,16 @@ func (t *searchTask) PostExecute(ctx context.Context) error {
 	if len(toReduceResults) >= 1 {
 		MetricType = toReduceResults[0].GetMetricType()
 	}
+	for _, result := range toReduceResults {
+		if result.GetPartial() {
+			t.partial = true
+			t.missedSegments = append(t.missedSegments, result.GetMissedSegmentIDs()...)
+		}
+	}
+	if t.partial {
+		log.Warn("search results are partial, some segments not searched in time",
+			zap.Int64s("missedSegments", t.missedSegments))
+	}
 
 	// Decode all search results
 	tr.CtxRecord(ctx, "decodeResultStart")
@@ -466,6 +760,7 @@ func (t *searchTask) PostExecute(ctx context.Context) error {
 
 	if len(validSearchResults) <= 0 {
 		t.fillInEmptyResult(Nq)
+		t.requery = false
 		return nil
 	}
 
@@ -481,7 +776,8 @@ func (t *searchTask) PostExecute(ctx context.Context) error {
 		return err
 	}
 
-	t.result, err = reduceSearchResultData(ctx, validSearchResults, Nq, Topk, MetricType, primaryFieldSchema.DataType, t.offset)
+	t.result, err = reduceSearchResultData(ctx, validSearchResults, Nq, Topk, MetricType, primaryFieldSchema.DataType, t.offset,
+		t.SearchRequest.GetGroupByFieldId(), t.SearchRequest.GetGroupSize())
 	if err != nil {
 		log.Warn("failed to reduce search results", zap.Error(err))
 		return err
@@ -491,15 +787,26 @@ func (t *searchTask) PostExecute(ctx context.Context) error {
 
 	t.result.CollectionName = t.collectionName
 	t.fillInFieldInfo()
+	return nil
+}
 
+// requeryOutputFields retrieves the output fields by the primary keys of reduced results if requery is needed.
+func (t *searchTask) requeryOutputFields(ctx context.Context) error {
+	log := log.Ctx(ctx)
 	if t.requery {
-		err = t.Requery()
+		err := t.Requery()
 		if err != nil {
 			log.Warn("failed to requery", zap.Error(err))
 			return err
 		}
 	}
+	if t.groupByFieldAppended {
+		t.result.Results.FieldsData = lo.Filter(t.result.Results.FieldsData, func(fieldData *schemapb.FieldData, _ int) bool {
+			return fieldData.GetFieldName() != t.groupByField.GetName()
+		})
+	}
 	t.result.Results.OutputFields = t.userOutputFields
+	t.truncation = truncateSearchResults(t.result, t.offset)
 
 	log.Debug("Search post execute done",
 		zap.Int64("collection", t.GetCollectionID()),
@@ -585,6 +892,7 @@ func (t *searchTask) Requery() error {
 		OutputFields:       t.request.GetOutputFields(),
 		PartitionNames:     t.request.GetPartitionNames(),
 		GuaranteeTimestamp: t.request.GetGuaranteeTimestamp(),
+		TravelTimestamp:    t.request.GetTravelTimestamp(),
 		QueryParams:        t.request.GetSearchParams(),
 	}
 	qt := &queryTask{
@@ -601,6 +909,7 @@ func (t *searchTask) Requery() error {
 		plan:    plan,
 		qc:      t.node.(*Proxy).queryCoord,
 		lb:      t.node.(*Proxy).lbPolicy,
+		direct:  true,
 	}
 	queryResult, err := t.node.(*Proxy).query(t.ctx, qt)
 	if err != nil {
@@ -678,6 +987,23 @@ func (t *searchTask) fillInFieldInfo() {
 	}
 }
 
+// setSearchPartialHeader tells the client the search results are partial by the grpc response header.
+func setSearchPartialHeader(ctx context.Context, partial bool, missedSegments []int64) {
+	if !partial {
+		return
+	}
+	segmentIDs := lo.Map(missedSegments, func(segmentID int64, _ int) string {
+		return strconv.FormatInt(segmentID, 10)
+	})
+	header := metadata.Pairs(
+		SearchPartialResultHeader, "true",
+		SearchMissedSegmentsHeader, strings.Join(segmentIDs, ","),
+	)
+	if err := grpc.SetHeader(ctx, header); err != nil {
+		log.Ctx(ctx).Warn("failed to set search partial result header", zap.Error(err))
+	}
+}
+
 func (t *searchTask) collectSearchResults(ctx context.Context) ([]*internalpb.SearchResults, error) {
 	select {
 	case <-t.TraceCtx().Done():
@@ -767,7 +1093,9 @@ func selectHighestScoreIndex(subSearchResultData []*schemapb.SearchResultData, s
 	return subSearchIdx, resultDataIdx
 }
 
-func reduceSearchResultData(ctx context.Context, subSearchResultData []*schemapb.SearchResultData, nq int64, topk int64, metricType string, pkType schemapb.DataType, offset int64) (*milvuspb.SearchResults, error) {
+func reduceSearchResultData(ctx context.Context, subSearchResultData []*schemapb.SearchResultData, nq int64, topk int64, metricType string, pkType schemapb.DataType, offset int64,
+	groupByFieldID int64, groupSize int64,
+) (*milvuspb.SearchResults, error) {
 	tr := timerecord.NewTimeRecorder("reduceSearchResultData")
 	defer func() {
 		tr.CtxElapse(ctx, "done")
@@ -793,17 +1121,32 @@ func reduceSearchResultData(ctx context.Context, subSearchResultData []*schemapb
 		},
 	}
 
+	// pre-allocate for the results, which are limited by both the limit and the sub results
+	resultNum := nq * limit
+	var subResultNum int64
+	for _, sData := range subSearchResultData {
+		subResultNum += int64(len(sData.GetScores()))
+	}
+	if subResultNum < resultNum {
+		resultNum = subResultNum
+	}
+	if resultNum < 0 {
+		resultNum = 0
+	}
+	ret.Results.Scores = make([]float32, 0, resultNum)
+	ret.Results.Topks = make([]int64, 0, nq)
+
 	switch pkType {
 	case schemapb.DataType_Int64:
 		ret.GetResults().Ids.IdField = &schemapb.IDs_IntId{
 			IntId: &schemapb.LongArray{
-				Data: make([]int64, 0),
+				Data: make([]int64, 0, resultNum),
 			},
 		}
 	case schemapb.DataType_VarChar:
 		ret.GetResults().Ids.IdField = &schemapb.IDs_StrId{
 			StrId: &schemapb.StringArray{
-				Data: make([]string, 0),
+				Data: make([]string, 0, resultNum),
 			},
 		}
 	default:
@@ -825,44 +1168,33 @@ func reduceSearchResultData(ctx context.Context, subSearchResultData []*schemapb
 		// printSearchResultData(sData, strconv.FormatInt(int64(i), 10))
 	}
 
-	var (
-		subSearchNum = len(subSearchResultData)
-		// for results of each subSearchResultData, storing the start offset of each query of nq queries
-		subSearchNqOffset = make([][]int64, subSearchNum)
-	)
-	for i := 0; i < subSearchNum; i++ {
-		subSearchNqOffset[i] = make([]int64, subSearchResultData[i].GetNumQueries())
-		for j := int64(1); j < nq; j++ {
-			subSearchNqOffset[i][j] = subSearchNqOffset[i][j-1] + subSearchResultData[i].Topks[j-1]
-		}
-	}
+	metrics.ProxyReduceSubResultNum.WithLabelValues(strconv.FormatInt(paramtable.GetNodeID(), 10), metrics.SearchLabel).
+		Observe(float64(len(subSearchResultData)))
 
 	var (
 		skipDupCnt int64
 		realTopK   int64 = -1
 	)
 
+	merger := typeutil2.NewSearchResultMerger(subSearchResultData)
+	defer merger.Release()
+	if groupSize > 0 {
+		merger.SetGroupBy(groupByFieldID, groupSize)
+	}
+
 	var retSize int64
 	maxOutputSize := paramtable.Get().QuotaConfig.MaxOutputSize.GetAsInt64()
 	// reducing nq * topk results
 	for i := int64(0); i < nq; i++ {
-		var (
-			// cursor of current data of each subSearch for merging the j-th data of TopK.
-			// sum(cursors) == j
-			cursors = make([]int64, subSearchNum)
-
-			j     int64
-			idSet = make(map[interface{}]struct{})
-		)
+		var j int64
+		merger.Start(i)
 
 		// skip offset results
 		for k := int64(0); k < offset; k++ {
-			subSearchIdx, _ := selectHighestScoreIndex(subSearchResultData, subSearchNqOffset, cursors, i)
+			subSearchIdx, _ := merger.Next()
 			if subSearchIdx == -1 {
 				break
 			}
-
-			cursors[subSearchIdx]++
 		}
 
 		// keep limit results
@@ -870,7 +1202,7 @@ func reduceSearchResultData(ctx context.Context, subSearchResultData []*schemapb
 			// From all the sub-query result sets of the i-th query vector,
 			//   find the sub-query result set index of the score j-th data,
 			//   and the index of the data in schemapb.SearchResultData
-			subSearchIdx, resultDataIdx := selectHighestScoreIndex(subSearchResultData, subSearchNqOffset, cursors, i)
+			subSearchIdx, resultDataIdx := merger.Next()
 			if subSearchIdx == -1 {
 				break
 			}
@@ -879,17 +1211,19 @@ func reduceSearchResultData(ctx context.Context, subSearchResultData []*schemapb
 			score := subSearchResultData[subSearchIdx].Scores[resultDataIdx]
 
 			// remove duplicates
-			if _, ok := idSet[id]; !ok {
+			if !merger.Skip(id) {
+				// skip entity if its group is full
+				if merger.SkipGroup(subSearchIdx, resultDataIdx) {
+					continue
+				}
 				retSize += typeutil.AppendFieldData(ret.Results.FieldsData, subSearchResultData[subSearchIdx].FieldsData, resultDataIdx)
 				typeutil.AppendPKs(ret.Results.Ids, id)
 				ret.Results.Scores = append(ret.Results.Scores, score)
-				idSet[id] = struct{}{}
 				j++
 			} else {
 				// skip entity with same id
 				skipDupCnt++
 			}
-			cursors[subSearchIdx]++
 		}
 		if realTopK != -1 && realTopK != j {
 			log.Ctx(ctx).Warn("Proxy Reduce Search Result", zap.Error(errors.New("the length (topk) between all result of query is different")))
@@ -17,6 +17,7 @@ package proxy
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
 	"strconv"
 	"strings"
@@ -28,12 +29,14 @@ import (
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
 
 	"github.com/milvus-io/milvus-proto/go-api/v2/commonpb"
 	"github.com/milvus-io/milvus-proto/go-api/v2/milvuspb"
 	"github.com/milvus-io/milvus-proto/go-api/v2/schemapb"
 	"github.com/milvus-io/milvus/internal/mocks"
 	"github.com/milvus-io/milvus/internal/proto/internalpb"
+	"github.com/milvus-io/milvus/internal/proto/planpb"
 	"github.com/milvus-io/milvus/internal/proto/querypb"
 	"github.com/milvus-io/milvus/internal/types"
 	"github.com/milvus-io/milvus/internal/util/dependency"
@@ -43,6 +46,7 @@ import (
 	"github.com/milvus-io/milvus/pkg/util/metric"
 	"github.com/milvus-io/milvus/pkg/util/paramtable"
 	"github.com/milvus-io/milvus/pkg/util/timerecord"
+	"github.com/milvus-io/milvus/pkg/util/tsoutil"
 	"github.com/milvus-io/milvus/pkg/util/typeutil"
 )
 
@@ -1477,7 +1481,7 @@ func TestTaskSearch_reduceSearchResultData(t *testing.T) {
 
 		for _, test := range tests {
 			t.Run(test.description, func(t *testing.T) {
-				reduced, err := reduceSearchResultData(context.TODO(), results, nq, topk, metric.L2, schemapb.DataType_Int64, test.offset)
+				reduced, err := reduceSearchResultData(context.TODO(), results, nq, topk, metric.L2, schemapb.DataType_Int64, test.offset, 0, 0)
 				assert.NoError(t, err)
 				assert.Equal(t, test.outData, reduced.GetResults().GetIds().GetIntId().GetData())
 				assert.Equal(t, []int64{test.limit, test.limit}, reduced.GetResults().GetTopks())
@@ -1529,7 +1533,7 @@ func TestTaskSearch_reduceSearchResultData(t *testing.T) {
 
 		for _, test := range lessThanLimitTests {
 			t.Run(test.description, func(t *testing.T) {
-				reduced, err := reduceSearchResultData(context.TODO(), results, nq, topk, metric.L2, schemapb.DataType_Int64, test.offset)
+				reduced, err := reduceSearchResultData(context.TODO(), results, nq, topk, metric.L2, schemapb.DataType_Int64, test.offset, 0, 0)
 				assert.NoError(t, err)
 				assert.Equal(t, test.outData, reduced.GetResults().GetIds().GetIntId().GetData())
 				assert.Equal(t, []int64{test.outLimit, test.outLimit}, reduced.GetResults().GetTopks())
@@ -1553,7 +1557,7 @@ func TestTaskSearch_reduceSearchResultData(t *testing.T) {
 			results = append(results, r)
 		}
 
-		reduced, err := reduceSearchResultData(context.TODO(), results, nq, topk, metric.L2, schemapb.DataType_Int64, 0)
+		reduced, err := reduceSearchResultData(context.TODO(), results, nq, topk, metric.L2, schemapb.DataType_Int64, 0, 0, 0)
 
 		assert.NoError(t, err)
 		assert.Equal(t, resultData, reduced.GetResults().GetIds().GetIntId().GetData())
@@ -1580,7 +1584,7 @@ func TestTaskSearch_reduceSearchResultData(t *testing.T) {
 			results = append(results, r)
 		}
 
-		reduced, err := reduceSearchResultData(context.TODO(), results, nq, topk, metric.L2, schemapb.DataType_VarChar, 0)
+		reduced, err := reduceSearchResultData(context.TODO(), results, nq, topk, metric.L2, schemapb.DataType_VarChar, 0, 0, 0)
 
 		assert.NoError(t, err)
 		assert.Equal(t, resultData, reduced.GetResults().GetIds().GetStrId().GetData())
@@ -1766,6 +1770,11 @@ func TestTaskSearch_parseQueryInfo(t *testing.T) {
 			Value: strconv.FormatInt(targetOffset, 10),
 		})
 
+		diskANNParams := append(noSearchParams, &commonpb.KeyValuePair{
+			Key:   SearchParamsKey,
+			Value: `{"search_list": 100, "beamwidth": 8}`,
+		})
+
 		tests := []struct {
 			description string
 			validParams []*commonpb.KeyValuePair
@@ -1774,6 +1783,7 @@ func TestTaskSearch_parseQueryInfo(t *testing.T) {
 			{"noSearchParams", noSearchParams},
 			{"normal", normalParam},
 			{"offsetParam", offsetParam},
+			{"diskANNParams", diskANNParams},
 		}
 
 		for _, test := range tests {
@@ -1850,10 +1860,22 @@ func TestTaskSearch_parseQueryInfo(t *testing.T) {
 			Value: "16386",
 		})
 
+		spInvalidBeamWidth := append(append([]*commonpb.KeyValuePair{}, spNoSearchParams...), &commonpb.KeyValuePair{
+			Key:   SearchParamsKey,
+			Value: `{"search_list": 100, "beamwidth": 64}`,
+		})
+
+		spInvalidSearchList := append(append([]*commonpb.KeyValuePair{}, spNoSearchParams...), &commonpb.KeyValuePair{
+			Key:   SearchParamsKey,
+			Value: `{"search_list": 0.5}`,
+		})
+
 		tests := []struct {
 			description   string
 			invalidParams []*commonpb.KeyValuePair
 		}{
+			{"Invalid_beamwidth", spInvalidBeamWidth},
+			{"Invalid_search_list", spInvalidSearchList},
 			{"No_topk", spNoTopk},
 			{"Invalid_topk", spInvalidTopk},
 			{"Invalid_topk_65536", spInvalidTopk65536},
@@ -1878,6 +1900,94 @@ func TestTaskSearch_parseQueryInfo(t *testing.T) {
 	})
 }
 
+func TestTaskSearch_groupBy(t *testing.T) {
+	schema := &schemapb.CollectionSchema{
+		Fields: []*schemapb.FieldSchema{
+			{FieldID: 100, Name: "pk", DataType: schemapb.DataType_Int64, IsPrimaryKey: true},
+			{FieldID: 101, Name: "category", DataType: schemapb.DataType_VarChar},
+			{FieldID: 102, Name: "price", DataType: schemapb.DataType_Float},
+			{FieldID: 103, Name: "vec", DataType: schemapb.DataType_FloatVector},
+		},
+	}
+	groupByParam := func(name string) []*commonpb.KeyValuePair {
+		return []*commonpb.KeyValuePair{{Key: GroupByFieldKey, Value: name}}
+	}
+
+	t.Run("get group by field", func(t *testing.T) {
+		field, err := getGroupByField(schema, nil)
+		assert.NoError(t, err)
+		assert.Nil(t, field)
+
+		field, err = getGroupByField(schema, groupByParam("category"))
+		assert.NoError(t, err)
+		assert.EqualValues(t, 101, field.GetFieldID())
+
+		_, err = getGroupByField(schema, groupByParam("price"))
+		assert.ErrorIs(t, err, merr.ErrParameterInvalid)
+		_, err = getGroupByField(schema, groupByParam("vec"))
+		assert.ErrorIs(t, err, merr.ErrParameterInvalid)
+		_, err = getGroupByField(schema, groupByParam("not_exist"))
+		assert.ErrorIs(t, err, merr.ErrParameterInvalid)
+	})
+
+	t.Run("apply group by", func(t *testing.T) {
+		paramtable.Get().Save(Params.ProxyCfg.GroupBySearchCandidateFactor.Key, "10")
+		defer paramtable.Get().Reset(Params.ProxyCfg.GroupBySearchCandidateFactor.Key)
+
+		queryInfo := &planpb.QueryInfo{Topk: 5, SearchParams: `{"nprobe": 10}`}
+		groupSize, err := applyGroupBy(queryInfo, schema.GetFields()[1], 0)
+		assert.NoError(t, err)
+		assert.EqualValues(t, 5, groupSize)
+		assert.EqualValues(t, 50, queryInfo.GetTopk())
+		searchParams := make(map[string]interface{})
+		assert.NoError(t, json.Unmarshal([]byte(queryInfo.GetSearchParams()), &searchParams))
+		assert.EqualValues(t, 10, searchParams["nprobe"])
+		assert.EqualValues(t, 101, searchParams[common.GroupByFieldIDKey])
+		assert.EqualValues(t, 5, searchParams[common.GroupSizeKey])
+
+		// the candidates are limited by the max topk
+		queryInfo = &planpb.QueryInfo{Topk: Params.QuotaConfig.TopKLimit.GetAsInt64()}
+		_, err = applyGroupBy(queryInfo, schema.GetFields()[1], 0)
+		assert.NoError(t, err)
+		assert.Equal(t, Params.QuotaConfig.TopKLimit.GetAsInt64(), queryInfo.GetTopk())
+
+		_, err = applyGroupBy(&planpb.QueryInfo{Topk: 5}, schema.GetFields()[1], 10)
+		assert.ErrorIs(t, err, merr.ErrParameterInvalid)
+	})
+
+	t.Run("reduce in groups", func(t *testing.T) {
+		const (
+			nq   = 1
+			topk = 10
+		)
+		genResult := func(ids []int64, scores []float32, groups []string) *schemapb.SearchResultData {
+			r := getSearchResultData(nq, topk)
+			r.Ids.IdField = &schemapb.IDs_IntId{IntId: &schemapb.LongArray{Data: ids}}
+			r.Scores = scores
+			r.Topks = []int64{int64(len(ids))}
+			r.FieldsData = []*schemapb.FieldData{{
+				Type:      schemapb.DataType_VarChar,
+				FieldName: "category",
+				FieldId:   101,
+				Field: &schemapb.FieldData_Scalars{Scalars: &schemapb.ScalarField{
+					Data: &schemapb.ScalarField_StringData{StringData: &schemapb.StringArray{Data: groups}},
+				}},
+			}}
+			return r
+		}
+		results := []*schemapb.SearchResultData{
+			genResult([]int64{1, 2, 3}, []float32{-1, -2, -3}, []string{"a", "a", "a"}),
+			genResult([]int64{4, 5}, []float32{-1.5, -2.5}, []string{"b", "a"}),
+		}
+
+		reduced, err := reduceSearchResultData(context.TODO(), results, nq, topk, metric.L2, schemapb.DataType_Int64, 0, 101, 2)
+		assert.NoError(t, err)
+		assert.Equal(t, []int64{1, 4, 2}, reduced.GetResults().GetIds().GetIntId().GetData())
+		assert.Equal(t, []string{"a", "b", "a"}, reduced.GetResults().GetFieldsData()[0].GetScalars().GetStringData().GetData())
+		assert.Equal(t, []int64{3}, reduced.GetResults().GetTopks())
+	})
+}
+
 func getSearchResultData(nq, topk int64) *schemapb.SearchResultData {
 	result := schemapb.SearchResultData{
 		NumQueries: nq,
@@ -2011,6 +2121,86 @@ func TestSearchTask_Requery(t *testing.T) {
 		assert.Equal(t, vecField, qt.result.Results.FieldsData[1].GetFieldName())
 	})
 
+	t.Run("Test travel timestamp", func(t *testing.T) {
+		paramtable.Get().Save(Params.CommonCfg.RetentionDuration.Key, "3600")
+		defer paramtable.Get().Reset(Params.CommonCfg.RetentionDuration.Key)
+
+		schema := constructCollectionSchema(pkField, vecField, dim, collection)
+		travelTs := tsoutil.AddPhysicalDurationOnTs(Timestamp(time.Now().UnixNano()), -time.Second)
+		qn := mocks.NewMockQueryNodeClient(t)
+		qn.EXPECT().Query(mock.Anything, mock.Anything).
+			Run(func(ctx context.Context, in *querypb.QueryRequest, opts ...grpc.CallOption) {
+				// the requery reads the same snapshot as the search
+				assert.Equal(t, travelTs, in.GetReq().GetMvccTimestamp())
+			}).
+			Return(&internalpb.RetrieveResults{
+				Ids: &schemapb.IDs{
+					IdField: &schemapb.IDs_IntId{
+						IntId: &schemapb.LongArray{
+							Data: ids,
+						},
+					},
+				},
+				FieldsData: []*schemapb.FieldData{
+					{
+						Type:      schemapb.DataType_Int64,
+						FieldName: pkField,
+						Field: &schemapb.FieldData_Scalars{
+							Scalars: &schemapb.ScalarField{
+								Data: &schemapb.ScalarField_LongData{
+									LongData: &schemapb.LongArray{
+										Data: ids,
+									},
+								},
+							},
+						},
+					},
+					newFloatVectorFieldData(vecField, rows, dim),
+				},
+			}, nil)
+
+		lb := NewMockLBPolicy(t)
+		lb.EXPECT().Execute(mock.Anything, mock.Anything).Run(func(ctx context.Context, workload CollectionWorkLoad) {
+			err = workload.exec(ctx, 0, qn)
+			assert.NoError(t, err)
+		}).Return(nil)
+		lb.EXPECT().UpdateCostMetrics(mock.Anything, mock.Anything).Return()
+		node.lbPolicy = lb
+
+		qt := &searchTask{
+			ctx: ctx,
+			SearchRequest: &internalpb.SearchRequest{
+				Base: &commonpb.MsgBase{
+					MsgType:  commonpb.MsgType_Search,
+					SourceID: paramtable.GetNodeID(),
+				},
+			},
+			request: &milvuspb.SearchRequest{
+				CollectionName:  collectionName,
+				OutputFields:    []string{pkField, vecField},
+				TravelTimestamp: travelTs,
+			},
+			result: &milvuspb.SearchResults{
+				Results: &schemapb.SearchResultData{
+					Ids: &schemapb.IDs{
+						IdField: &schemapb.IDs_IntId{
+							IntId: &schemapb.LongArray{
+								Data: ids,
+							},
+						},
+					},
+				},
+			},
+			schema: schema,
+			tr:     timerecord.NewTimeRecorder("search"),
+			node:   node,
+		}
+
+		err := qt.Requery()
+		assert.NoError(t, err)
+		assert.Len(t, qt.result.Results.FieldsData, 2)
+	})
+
 	t.Run("Test no primary key", func(t *testing.T) {
 		schema := &schemapb.CollectionSchema{}
 		node := mocks.NewMockProxy(t)
@@ -2128,3 +2318,34 @@ func TestSearchTask_Requery(t *testing.T) {
 		assert.Error(t, err)
 	})
 }
+
+func TestTaskSearch_parsePartialResultPolicy(t *testing.T) {
+	allowPartial, segmentTimeout, rest, err := parsePartialResultPolicy([]*commonpb.KeyValuePair{
+		{Key: TopKKey, Value: "10"},
+		{Key: PartialResultKey, Value: "true"},
+		{Key: SegmentTimeoutKey, Value: "100"},
+	})
+	assert.NoError(t, err)
+	assert.True(t, allowPartial)
+	assert.EqualValues(t, 100, segmentTimeout)
+	assert.Len(t, rest, 1)
+	assert.Equal(t, TopKKey, rest[0].GetKey())
+
+	allowPartial, segmentTimeout, rest, err = parsePartialResultPolicy([]*commonpb.KeyValuePair{
+		{Key: TopKKey, Value: "10"},
+	})
+	assert.NoError(t, err)
+	assert.False(t, allowPartial)
+	assert.EqualValues(t, 0, segmentTimeout)
+	assert.Len(t, rest, 1)
+
+	for _, params := range [][]*commonpb.KeyValuePair{
+		{{Key: PartialResultKey, Value: "invalid"}},
+		{{Key: PartialResultKey, Value: "true"}, {Key: SegmentTimeoutKey, Value: "-1"}},
+		{{Key: PartialResultKey, Value: "true"}, {Key: SegmentTimeoutKey, Value: "abc"}},
+		{{Key: SegmentTimeoutKey, Value: "100"}},
+	} {
+		_, _, _, err = parsePartialResultPolicy(params)
+		assert.Error(t, err)
+	}
+}
@@ -31,6 +31,7 @@ import (
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
 
 	"github.com/milvus-io/milvus-proto/go-api/v2/commonpb"
 	"github.com/milvus-io/milvus-proto/go-api/v2/milvuspb"
@@ -2150,6 +2151,23 @@ func TestAlterAlias_all(t *testing.T) {
 	assert.NoError(t, task.PostExecute(ctx))
 }
 
+func TestAlterAlias_dropPrevious(t *testing.T) {
+	ctx := metadata.NewIncomingContext(context.Background(), metadata.Pairs(AliasDropPreviousHeader, "true"))
+	task := &AlterAliasTask{
+		AlterAliasRequest: &milvuspb.AlterAliasRequest{CollectionName: "green", Alias: "prod"},
+		ctx:               ctx,
+	}
+	assert.NoError(t, task.OnEnqueue())
+	assert.True(t, common.IsAliasDropPrevious(task.Base.GetProperties()))
+
+	task = &AlterAliasTask{
+		AlterAliasRequest: &milvuspb.AlterAliasRequest{CollectionName: "green", Alias: "prod"},
+		ctx:               context.Background(),
+	}
+	assert.NoError(t, task.OnEnqueue())
+	assert.False(t, common.IsAliasDropPrevious(task.Base.GetProperties()))
+}
+
 func Test_createIndexTask_getIndexedField(t *testing.T) {
 	collectionName := "test"
 	fieldName := "test"
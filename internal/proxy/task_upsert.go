@@ -62,6 +62,10 @@ type upsertTask struct {
 	schema           *schemapb.CollectionSchema
 	partitionKeyMode bool
 	partitionKeys    *schemapb.FieldData
+
+	// the timestamp the absent fields are read at for partial upsert, zero if all fields are specified
+	partialReadTs   Timestamp
+	conflictTracker *upsertConflictTracker
 }
 
 // TraceCtx returns upsertTask context
@@ -283,6 +287,11 @@ func (it *upsertTask) PreExecute(ctx context.Context) error {
 		Timestamp: it.EndTs(),
 	}
 
+	if err := checkCollectionWritable(ctx, it.req.GetDbName(), collectionName); err != nil {
+		log.Warn("collection is not writable", zap.Error(err))
+		return err
+	}
+
 	schema, err := globalMetaCache.GetCollectionSchema(ctx, it.req.GetDbName(), collectionName)
 	if err != nil {
 		log.Warn("Failed to get collection schema",
@@ -354,6 +363,13 @@ func (it *upsertTask) PreExecute(ctx context.Context) error {
 		return err
 	}
 
+	if it.conflictTracker != nil {
+		if err := it.conflictTracker.checkAndRecord(it.collectionID, it.result.GetIDs(), it.partialReadTs, it.BeginTs()); err != nil {
+			log.Warn("partial upsert conflicts with other writes", zap.Uint64("readTs", it.partialReadTs), zap.Error(err))
+			return err
+		}
+	}
+
 	it.result.DeleteCnt = it.upsertMsg.DeleteMsg.NumRows
 	it.result.InsertCnt = int64(it.upsertMsg.InsertMsg.NumRows)
 	if it.result.DeleteCnt != it.result.InsertCnt {
@@ -439,6 +455,12 @@ func (it *upsertTask) deleteExecute(ctx context.Context, msgPack *msgstream.MsgP
 		it.result.Status = merr.Status(err)
 		return err
 	}
+	shardsScaled, err := isCollectionShardsScaled(ctx, it.req.GetDbName(), it.req.GetCollectionName(), collID)
+	if err != nil {
+		log.Warn("get collection info failed when deleteExecute", zap.Error(err))
+		it.result.Status = merr.Status(err)
+		return err
+	}
 	it.upsertMsg.DeleteMsg.PrimaryKeys = it.result.IDs
 	it.upsertMsg.DeleteMsg.HashValues = typeutil.HashPK2Channels(it.upsertMsg.DeleteMsg.PrimaryKeys, channelNames)
 
@@ -449,44 +471,46 @@ func (it *upsertTask) deleteExecute(ctx context.Context, msgPack *msgstream.MsgP
 	partitionID := it.upsertMsg.DeleteMsg.PartitionID
 	partitionName := it.upsertMsg.DeleteMsg.PartitionName
 	proxyID := it.upsertMsg.DeleteMsg.Base.SourceID
-	for index, key := range it.upsertMsg.DeleteMsg.HashValues {
+	for index, hashValue := range it.upsertMsg.DeleteMsg.HashValues {
 		ts := it.upsertMsg.DeleteMsg.Timestamps[index]
-		_, ok := result[key]
-		if !ok {
-			msgid, err := it.idAllocator.AllocOne()
-			if err != nil {
-				errors.Wrap(err, "failed to allocate MsgID for delete of upsert")
-			}
-			sliceRequest := msgpb.DeleteRequest{
-				Base: commonpbutil.NewMsgBase(
-					commonpbutil.WithMsgType(commonpb.MsgType_Delete),
-					commonpbutil.WithTimeStamp(ts),
-					// id of upsertTask were set as ts in scheduler
-					// msgid of delete msg must be set
-					// or it will be seen as duplicated msg in mq
-					commonpbutil.WithMsgID(msgid),
-					commonpbutil.WithSourceID(proxyID),
-				),
-				CollectionID:   collectionID,
-				PartitionID:    partitionID,
-				CollectionName: collectionName,
-				PartitionName:  partitionName,
-				PrimaryKeys:    &schemapb.IDs{},
-			}
-			deleteMsg := &msgstream.DeleteMsg{
-				BaseMsg: msgstream.BaseMsg{
-					Ctx: ctx,
-				},
-				DeleteRequest: sliceRequest,
+		for _, key := range deleteTargetChannels(hashValue, len(channelNames), shardsScaled) {
+			_, ok := result[key]
+			if !ok {
+				msgid, err := it.idAllocator.AllocOne()
+				if err != nil {
+					errors.Wrap(err, "failed to allocate MsgID for delete of upsert")
+				}
+				sliceRequest := msgpb.DeleteRequest{
+					Base: commonpbutil.NewMsgBase(
+						commonpbutil.WithMsgType(commonpb.MsgType_Delete),
+						commonpbutil.WithTimeStamp(ts),
+						// id of upsertTask were set as ts in scheduler
+						// msgid of delete msg must be set
+						// or it will be seen as duplicated msg in mq
+						commonpbutil.WithMsgID(msgid),
+						commonpbutil.WithSourceID(proxyID),
+					),
+					CollectionID:   collectionID,
+					PartitionID:    partitionID,
+					CollectionName: collectionName,
+					PartitionName:  partitionName,
+					PrimaryKeys:    &schemapb.IDs{},
+				}
+				deleteMsg := &msgstream.DeleteMsg{
+					BaseMsg: msgstream.BaseMsg{
+						Ctx: ctx,
+					},
+					DeleteRequest: sliceRequest,
+				}
+				result[key] = deleteMsg
 			}
-			result[key] = deleteMsg
+			curMsg := result[key].(*msgstream.DeleteMsg)
+			curMsg.HashValues = append(curMsg.HashValues, key)
+			curMsg.Timestamps = append(curMsg.Timestamps, ts)
+			typeutil.AppendIDs(curMsg.PrimaryKeys, it.upsertMsg.DeleteMsg.PrimaryKeys, index)
+			curMsg.NumRows++
+			curMsg.ShardName = channelNames[key]
 		}
-		curMsg := result[key].(*msgstream.DeleteMsg)
-		curMsg.HashValues = append(curMsg.HashValues, it.upsertMsg.DeleteMsg.HashValues[index])
-		curMsg.Timestamps = append(curMsg.Timestamps, it.upsertMsg.DeleteMsg.Timestamps[index])
-		typeutil.AppendIDs(curMsg.PrimaryKeys, it.upsertMsg.DeleteMsg.PrimaryKeys, index)
-		curMsg.NumRows++
-		curMsg.ShardName = channelNames[key]
 	}
 
 	// send delete request to log broker
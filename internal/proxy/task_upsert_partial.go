@@ -0,0 +1,243 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package proxy
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/samber/lo"
+	"go.uber.org/zap"
+
+	"github.com/milvus-io/milvus-proto/go-api/v2/commonpb"
+	"github.com/milvus-io/milvus-proto/go-api/v2/milvuspb"
+	"github.com/milvus-io/milvus-proto/go-api/v2/schemapb"
+	"github.com/milvus-io/milvus/internal/parser/planparserv2"
+	"github.com/milvus-io/milvus/internal/proto/internalpb"
+	"github.com/milvus-io/milvus/pkg/common"
+	"github.com/milvus-io/milvus/pkg/log"
+	"github.com/milvus-io/milvus/pkg/util/commonpbutil"
+	"github.com/milvus-io/milvus/pkg/util/merr"
+	"github.com/milvus-io/milvus/pkg/util/paramtable"
+	"github.com/milvus-io/milvus/pkg/util/tsoutil"
+	"github.com/milvus-io/milvus/pkg/util/typeutil"
+)
+
+// upsertConflictTracker records the latest timestamp each primary key is upserted through this proxy,
+// a partial upsert is rejected if any of its entities is written by others between its read and its write.
+// Entities written through other proxies are not visible here, so the detection is best-effort.
+type upsertConflictTracker struct {
+	mu        sync.Mutex
+	written   map[UniqueID]map[any]Timestamp
+	lastPrune Timestamp
+}
+
+func newUpsertConflictTracker() *upsertConflictTracker {
+	return &upsertConflictTracker{
+		written: make(map[UniqueID]map[any]Timestamp),
+	}
+}
+
+// checkAndRecord checks whether the entities read at readTs are modified before writeTs, and records the write if not.
+// readTs is zero for the upserts carrying all the fields, which are recorded without check.
+func (t *upsertConflictTracker) checkAndRecord(collectionID UniqueID, ids *schemapb.IDs, readTs, writeTs Timestamp) error {
+	window := paramtable.Get().ProxyCfg.UpsertPartialUpdateConflictWindow.GetAsDuration(time.Second)
+
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	t.prune(writeTs, window)
+	written := t.written[collectionID]
+	if readTs != 0 {
+		// the writes before the window are forgotten, the read is too stale to tell
+		if tsoutil.PhysicalTime(writeTs).Sub(tsoutil.PhysicalTime(readTs)) > window {
+			return merr.WrapErrCollectionWriteConflict(collectionID,
+				fmt.Sprintf("entities read %s before written, longer than the conflict window",
+					tsoutil.PhysicalTime(writeTs).Sub(tsoutil.PhysicalTime(readTs))))
+		}
+		for i := 0; i < typeutil.GetSizeOfIDs(ids); i++ {
+			pk := typeutil.GetPK(ids, int64(i))
+			if ts, ok := written[pk]; ok && ts > readTs {
+				return merr.WrapErrCollectionWriteConflict(collectionID,
+					fmt.Sprintf("entity with primary key %v is modified after read", pk))
+			}
+		}
+	}
+
+	if written == nil {
+		written = make(map[any]Timestamp)
+		t.written[collectionID] = written
+	}
+	for i := 0; i < typeutil.GetSizeOfIDs(ids); i++ {
+		pk := typeutil.GetPK(ids, int64(i))
+		if written[pk] < writeTs {
+			written[pk] = writeTs
+		}
+	}
+	return nil
+}
+
+// prune forgets the writes earlier than the window, at most once per window
+func (t *upsertConflictTracker) prune(now Timestamp, window time.Duration) {
+	if tsoutil.PhysicalTime(now).Sub(tsoutil.PhysicalTime(t.lastPrune)) < window {
+		return
+	}
+	expire := tsoutil.AddPhysicalDurationOnTs(now, -window)
+	for collectionID, written := range t.written {
+		for pk, ts := range written {
+			if ts < expire {
+				delete(written, pk)
+			}
+		}
+		if len(written) == 0 {
+			delete(t.written, collectionID)
+		}
+	}
+	t.lastPrune = now
+}
+
+// missingUpsertFields returns the fields of the schema absent in the upsert request,
+// including the dynamic field if enabled
+func missingUpsertFields(schema *schemapb.CollectionSchema, fieldsData []*schemapb.FieldData) []*schemapb.FieldSchema {
+	present := typeutil.NewSet[string]()
+	for _, fieldData := range fieldsData {
+		present.Insert(fieldData.GetFieldName())
+		if fieldData.GetIsDynamic() {
+			present.Insert(common.MetaFieldName)
+		}
+	}
+	return lo.Filter(schema.GetFields(), func(field *schemapb.FieldSchema, _ int) bool {
+		if field.GetIsDynamic() && !schema.GetEnableDynamicField() {
+			return false
+		}
+		return !present.Contain(field.GetName())
+	})
+}
+
+// fillPartialUpsert fills the fields absent in the upsert request with the existing entities,
+// so that only the specified fields are updated.
+// The entities are read with strong consistency before the task enqueued,
+// the read timestamp is checked against the write timestamp of the task for conflicts.
+func (node *Proxy) fillPartialUpsert(ctx context.Context, it *upsertTask) error {
+	request := it.req
+	log := log.Ctx(ctx).With(zap.String("collectionName", request.GetCollectionName()))
+
+	schema, err := globalMetaCache.GetCollectionSchema(ctx, request.GetDbName(), request.GetCollectionName())
+	if err != nil {
+		return err
+	}
+	missing := missingUpsertFields(schema, request.GetFieldsData())
+	if len(missing) == 0 {
+		return nil
+	}
+	pkField, err := typeutil.GetPrimaryFieldSchema(schema)
+	if err != nil {
+		return err
+	}
+	pkFieldData, err := typeutil.GetPrimaryFieldData(request.GetFieldsData(), pkField)
+	if err != nil {
+		return merr.WrapErrParameterInvalidMsg("primary key is required for partial upsert: %s", err.Error())
+	}
+	ids, err := parsePrimaryFieldData2IDs(pkFieldData)
+	if err != nil {
+		return err
+	}
+	if typeutil.GetSizeOfIDs(ids) != int(request.GetNumRows()) {
+		return merr.WrapErrParameterInvalid(int(request.GetNumRows()), typeutil.GetSizeOfIDs(ids), "the num_rows doesn't match the primary keys")
+	}
+
+	partitionKeyMode, err := isPartitionKeyMode(ctx, request.GetDbName(), request.GetCollectionName())
+	if err != nil {
+		return err
+	}
+	var partitionNames []string
+	if !partitionKeyMode {
+		partitionName := request.GetPartitionName()
+		if len(partitionName) == 0 {
+			partitionName = Params.CommonCfg.DefaultPartitionName.GetValue()
+		}
+		partitionNames = []string{partitionName}
+	}
+
+	queryReq := &milvuspb.QueryRequest{
+		Base: &commonpb.MsgBase{
+			MsgType: commonpb.MsgType_Retrieve,
+		},
+		DbName:         request.GetDbName(),
+		CollectionName: request.GetCollectionName(),
+		OutputFields: lo.Map(missing, func(field *schemapb.FieldSchema, _ int) string {
+			return field.GetName()
+		}),
+		PartitionNames:   partitionNames,
+		ConsistencyLevel: commonpb.ConsistencyLevel_Strong,
+	}
+	qt := &queryTask{
+		ctx:       ctx,
+		Condition: NewTaskCondition(ctx),
+		RetrieveRequest: &internalpb.RetrieveRequest{
+			Base: commonpbutil.NewMsgBase(
+				commonpbutil.WithMsgType(commonpb.MsgType_Retrieve),
+				commonpbutil.WithSourceID(paramtable.GetNodeID()),
+			),
+			ReqID: paramtable.GetNodeID(),
+		},
+		request: queryReq,
+		plan:    planparserv2.CreateRequeryPlan(pkField, ids),
+		qc:      node.queryCoord,
+		lb:      node.lbPolicy,
+	}
+	queryResult, err := node.query(ctx, qt)
+	if err != nil {
+		return err
+	}
+	if err := merr.Error(queryResult.GetStatus()); err != nil {
+		return err
+	}
+
+	// reorganize the existing entities in the order of the upsert request
+	resultPks, err := typeutil.GetPrimaryFieldData(queryResult.GetFieldsData(), pkField)
+	if err != nil {
+		return err
+	}
+	offsets := make(map[any]int64)
+	for i := 0; i < typeutil.GetPKSize(resultPks); i++ {
+		offsets[typeutil.GetData(resultPks, i)] = int64(i)
+	}
+	filled := make([]*schemapb.FieldData, len(queryResult.GetFieldsData()))
+	for i := 0; i < typeutil.GetSizeOfIDs(ids); i++ {
+		pk := typeutil.GetPK(ids, int64(i))
+		offset, ok := offsets[pk]
+		if !ok {
+			return merr.WrapErrParameterInvalidMsg("entity with primary key %v not found, all fields are required to upsert a new entity", pk)
+		}
+		typeutil.AppendFieldData(filled, queryResult.GetFieldsData(), offset)
+	}
+	for _, fieldData := range filled {
+		if fieldData.GetFieldName() == pkField.GetName() {
+			continue
+		}
+		request.FieldsData = append(request.FieldsData, fieldData)
+	}
+	it.partialReadTs = qt.BeginTs()
+
+	log.Debug("fill partial upsert with existing entities",
+		zap.Strings("fields", queryReq.GetOutputFields()),
+		zap.Uint64("readTs", it.partialReadTs))
+	return nil
+}
@@ -0,0 +1,97 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package proxy
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/milvus-io/milvus-proto/go-api/v2/schemapb"
+	"github.com/milvus-io/milvus/pkg/common"
+	"github.com/milvus-io/milvus/pkg/util/merr"
+	"github.com/milvus-io/milvus/pkg/util/tsoutil"
+)
+
+func TestUpsertConflictTracker(t *testing.T) {
+	ids := func(pks ...int64) *schemapb.IDs {
+		return &schemapb.IDs{IdField: &schemapb.IDs_IntId{IntId: &schemapb.LongArray{Data: pks}}}
+	}
+	now := time.Now()
+	ts := func(offset time.Duration) Timestamp {
+		return tsoutil.ComposeTSByTime(now.Add(offset), 0)
+	}
+
+	t.Run("conflict", func(t *testing.T) {
+		tracker := newUpsertConflictTracker()
+		// full upserts are recorded without check
+		assert.NoError(t, tracker.checkAndRecord(1, ids(1, 2), 0, ts(time.Second)))
+
+		// read before the write of pk 2
+		err := tracker.checkAndRecord(1, ids(2, 3), ts(0), ts(2*time.Second))
+		assert.ErrorIs(t, err, merr.ErrCollectionWriteConflict)
+
+		// the rejected write is not recorded
+		assert.NoError(t, tracker.checkAndRecord(1, ids(3), ts(0), ts(2*time.Second)))
+		// read after the write
+		assert.NoError(t, tracker.checkAndRecord(1, ids(2), ts(time.Second), ts(2*time.Second)))
+		// other collections are not affected
+		assert.NoError(t, tracker.checkAndRecord(2, ids(1, 2), ts(0), ts(2*time.Second)))
+	})
+
+	t.Run("stale read", func(t *testing.T) {
+		tracker := newUpsertConflictTracker()
+		err := tracker.checkAndRecord(1, ids(1), ts(-2*time.Minute), ts(0))
+		assert.ErrorIs(t, err, merr.ErrCollectionWriteConflict)
+	})
+
+	t.Run("prune", func(t *testing.T) {
+		tracker := newUpsertConflictTracker()
+		assert.NoError(t, tracker.checkAndRecord(1, ids(1), 0, ts(0)))
+		assert.NoError(t, tracker.checkAndRecord(2, ids(1), 0, ts(2*time.Minute)))
+		assert.NotContains(t, tracker.written, int64(1))
+		assert.Contains(t, tracker.written, int64(2))
+	})
+}
+
+func TestMissingUpsertFields(t *testing.T) {
+	schema := &schemapb.CollectionSchema{
+		Fields: []*schemapb.FieldSchema{
+			{FieldID: 100, Name: "pk", IsPrimaryKey: true, DataType: schemapb.DataType_Int64},
+			{FieldID: 101, Name: "vec", DataType: schemapb.DataType_FloatVector},
+			{FieldID: 102, Name: "age", DataType: schemapb.DataType_Int32},
+			{FieldID: 103, Name: common.MetaFieldName, DataType: schemapb.DataType_JSON, IsDynamic: true},
+		},
+	}
+	fieldNames := func(fields []*schemapb.FieldSchema) []string {
+		names := make([]string, 0, len(fields))
+		for _, field := range fields {
+			names = append(names, field.GetName())
+		}
+		return names
+	}
+
+	fieldsData := []*schemapb.FieldData{{FieldName: "pk"}, {FieldName: "age"}}
+	assert.Equal(t, []string{"vec"}, fieldNames(missingUpsertFields(schema, fieldsData)))
+
+	schema.EnableDynamicField = true
+	assert.Equal(t, []string{"vec", common.MetaFieldName}, fieldNames(missingUpsertFields(schema, fieldsData)))
+
+	fieldsData = append(fieldsData, &schemapb.FieldData{FieldName: "vec"}, &schemapb.FieldData{FieldName: "extra", IsDynamic: true})
+	assert.Empty(t, missingUpsertFields(schema, fieldsData))
+}
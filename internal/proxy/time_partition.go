@@ -0,0 +1,57 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package proxy
+
+import (
+	"context"
+	"time"
+
+	"go.uber.org/zap"
+
+	"github.com/milvus-io/milvus/internal/util/timepartition"
+	"github.com/milvus-io/milvus/pkg/log"
+)
+
+// getInsertPartitionName returns the partition to insert into when the request names no partition,
+// it's the partition of current interval for the collection partitioned by time if created by datacoord,
+// otherwise the default partition.
+func getInsertPartitionName(ctx context.Context, dbName string, collectionName string) string {
+	defaultPartition := Params.CommonCfg.DefaultPartitionName.GetValue()
+	collectionID, err := globalMetaCache.GetCollectionID(ctx, dbName, collectionName)
+	if err != nil {
+		return defaultPartition
+	}
+	collectionInfo, err := globalMetaCache.GetCollectionInfo(ctx, dbName, collectionName, collectionID)
+	if err != nil {
+		return defaultPartition
+	}
+	policy, err := timepartition.Parse(collectionInfo.properties)
+	if err != nil || policy == nil {
+		return defaultPartition
+	}
+	name := policy.PartitionName(time.Now())
+	partitions, err := globalMetaCache.GetPartitions(ctx, dbName, collectionName)
+	if err != nil {
+		return defaultPartition
+	}
+	if _, ok := partitions[name]; !ok {
+		log.Ctx(ctx).RatedWarn(60, "the partition of current interval is not created yet, insert into the default partition",
+			zap.String("collection", collectionName), zap.String("partition", name))
+		return defaultPartition
+	}
+	return name
+}
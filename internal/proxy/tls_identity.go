@@ -0,0 +1,115 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package proxy
+
+import (
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"strings"
+
+	"go.uber.org/zap"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/peer"
+
+	"github.com/milvus-io/milvus/pkg/log"
+	"github.com/milvus-io/milvus/pkg/util"
+)
+
+const (
+	tlsIdentitySourceCN  = "cn"
+	tlsIdentitySourceSAN = "san"
+)
+
+// getCertIdentity returns the identity of the certificate by the identity source.
+func getCertIdentity(cert *x509.Certificate, source string) string {
+	switch strings.ToLower(source) {
+	case tlsIdentitySourceSAN:
+		if len(cert.DNSNames) > 0 {
+			return cert.DNSNames[0]
+		}
+		if len(cert.EmailAddresses) > 0 {
+			return cert.EmailAddresses[0]
+		}
+		if len(cert.URIs) > 0 {
+			return cert.URIs[0].String()
+		}
+		return ""
+	default:
+		return cert.Subject.CommonName
+	}
+}
+
+// mapTLSIdentity maps the certificate identity to the user,
+// the mapping is in format of identity1:user1,identity2:user2.
+func mapTLSIdentity(identity string, mapping []string) string {
+	for _, item := range mapping {
+		// the user name never contains the separator, while the identity might be an URI
+		i := strings.LastIndex(item, util.CredentialSeperator)
+		if i <= 0 {
+			continue
+		}
+		if strings.TrimSpace(item[:i]) == identity {
+			return strings.TrimSpace(item[i+1:])
+		}
+	}
+	return identity
+}
+
+// getTLSIdentityUser returns the user mapped from the verified client certificate of the connection.
+func getTLSIdentityUser(state *tls.ConnectionState) (string, bool) {
+	if !Params.CommonCfg.TLSIdentityEnabled.GetAsBool() || state == nil ||
+		len(state.VerifiedChains) == 0 || len(state.VerifiedChains[0]) == 0 {
+		return "", false
+	}
+	identity := getCertIdentity(state.VerifiedChains[0][0], Params.CommonCfg.TLSIdentitySource.GetValue())
+	if identity == "" {
+		return "", false
+	}
+	user := mapTLSIdentity(identity, Params.CommonCfg.TLSIdentityMapping.GetAsStrings())
+	return user, user != ""
+}
+
+// TLSIdentityVerify verifies the user mapped from the client certificate exists,
+// returns false if the identity authentication is disabled or there is no verified client certificate.
+func TLSIdentityVerify(ctx context.Context, state *tls.ConnectionState) (string, bool) {
+	user, ok := getTLSIdentityUser(state)
+	if !ok {
+		return "", false
+	}
+	if globalMetaCache == nil {
+		return "", false
+	}
+	if _, err := globalMetaCache.GetCredentialInfo(ctx, user); err != nil {
+		log.Warn("user of the client certificate not found", zap.String("username", user), zap.Error(err))
+		return "", false
+	}
+	return user, true
+}
+
+// tlsIdentityVerifyFromContext verifies the client certificate of the grpc connection.
+func tlsIdentityVerifyFromContext(ctx context.Context) (string, bool) {
+	p, ok := peer.FromContext(ctx)
+	if !ok {
+		return "", false
+	}
+	tlsInfo, ok := p.AuthInfo.(credentials.TLSInfo)
+	if !ok {
+		return "", false
+	}
+	return TLSIdentityVerify(ctx, &tlsInfo.State)
+}
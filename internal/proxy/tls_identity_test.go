@@ -0,0 +1,106 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package proxy
+
+import (
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"net/url"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/peer"
+
+	"github.com/milvus-io/milvus/internal/mocks"
+	"github.com/milvus-io/milvus/pkg/util"
+	"github.com/milvus-io/milvus/pkg/util/crypto"
+	"github.com/milvus-io/milvus/pkg/util/paramtable"
+)
+
+func TestGetCertIdentity(t *testing.T) {
+	cert := &x509.Certificate{Subject: pkix.Name{CommonName: "client"}}
+	assert.Equal(t, "client", getCertIdentity(cert, "cn"))
+	assert.Equal(t, "", getCertIdentity(cert, "san"))
+
+	cert.URIs = []*url.URL{{Scheme: "spiffe", Host: "milvus", Path: "/client"}}
+	assert.Equal(t, "spiffe://milvus/client", getCertIdentity(cert, "SAN"))
+	cert.EmailAddresses = []string{"client@milvus.io"}
+	assert.Equal(t, "client@milvus.io", getCertIdentity(cert, "san"))
+	cert.DNSNames = []string{"client.milvus.io"}
+	assert.Equal(t, "client.milvus.io", getCertIdentity(cert, "san"))
+}
+
+func TestMapTLSIdentity(t *testing.T) {
+	mapping := []string{"", "invalid", "client:user1", " spiffe://milvus/client : user2 "}
+	assert.Equal(t, "user1", mapTLSIdentity("client", mapping))
+	assert.Equal(t, "user2", mapTLSIdentity("spiffe://milvus/client", mapping))
+	assert.Equal(t, "other", mapTLSIdentity("other", mapping))
+}
+
+func TestTLSIdentityAuthentication(t *testing.T) {
+	paramtable.Init()
+	ctx := context.Background()
+	rootCoord := &MockRootCoordClientInterface{}
+	queryCoord := &mocks.MockQueryCoordClient{}
+	err := InitMetaCache(ctx, rootCoord, queryCoord, newShardClientMgr())
+	assert.NoError(t, err)
+
+	state := &tls.ConnectionState{
+		VerifiedChains: [][]*x509.Certificate{{{Subject: pkix.Name{CommonName: "client"}}}},
+	}
+
+	// disabled
+	_, ok := TLSIdentityVerify(ctx, state)
+	assert.False(t, ok)
+
+	paramtable.Get().Save(Params.CommonCfg.TLSIdentityEnabled.Key, "true")
+	defer paramtable.Get().Reset(Params.CommonCfg.TLSIdentityEnabled.Key)
+	_, ok = TLSIdentityVerify(ctx, nil)
+	assert.False(t, ok)
+	_, ok = TLSIdentityVerify(ctx, &tls.ConnectionState{})
+	assert.False(t, ok)
+	// user not exist
+	_, ok = TLSIdentityVerify(ctx, state)
+	assert.False(t, ok)
+
+	paramtable.Get().Save(Params.CommonCfg.TLSIdentityMapping.Key, "client:mockUser")
+	defer paramtable.Get().Reset(Params.CommonCfg.TLSIdentityMapping.Key)
+	user, ok := TLSIdentityVerify(ctx, state)
+	assert.True(t, ok)
+	assert.Equal(t, "mockUser", user)
+
+	// authentication interceptor skips the password authentication
+	paramtable.Get().Save(Params.CommonCfg.AuthorizationEnabled.Key, "true")
+	defer paramtable.Get().Reset(Params.CommonCfg.AuthorizationEnabled.Key)
+	ctx = metadata.NewIncomingContext(ctx, metadata.Pairs("xxx", "yyy"))
+	_, err = AuthenticationInterceptor(ctx)
+	assert.Error(t, err)
+
+	ctx = peer.NewContext(ctx, &peer.Peer{AuthInfo: credentials.TLSInfo{State: *state}})
+	ctx, err = AuthenticationInterceptor(ctx)
+	assert.NoError(t, err)
+	md, ok := metadata.FromIncomingContext(ctx)
+	assert.True(t, ok)
+	rawToken, err := crypto.Base64Decode(md[strings.ToLower(util.HeaderAuthorize)][0])
+	assert.NoError(t, err)
+	assert.Equal(t, "mockUser", strings.Split(rawToken, util.CredentialSeperator)[0])
+}
@@ -43,6 +43,7 @@ import (
 	"github.com/milvus-io/milvus/pkg/util"
 	"github.com/milvus-io/milvus/pkg/util/commonpbutil"
 	"github.com/milvus-io/milvus/pkg/util/crypto"
+	"github.com/milvus-io/milvus/pkg/util/funcutil"
 	"github.com/milvus-io/milvus/pkg/util/merr"
 	"github.com/milvus-io/milvus/pkg/util/metric"
 	"github.com/milvus-io/milvus/pkg/util/tsoutil"
@@ -760,9 +761,34 @@ func ValidatePassword(password string) error {
 			Params.ProxyCfg.MaxPasswordLength.GetAsInt(),
 			len(password), "invalid password length")
 	}
+	if minClasses := Params.CommonCfg.PasswordMinCharClasses.GetAsInt(); minClasses > 0 {
+		if classes := countPasswordCharClasses(password); classes < minClasses {
+			return merr.WrapErrParameterInvalidMsg("the password must contain at least %d of lowercase letters, uppercase letters, digits and special characters, but got %d",
+				minClasses, classes)
+		}
+	}
 	return nil
 }
 
+// countPasswordCharClasses counts the classes of lowercase letters, uppercase letters, digits and special characters in the password.
+func countPasswordCharClasses(password string) int {
+	var lower, upper, digit, special int
+	for i := 0; i < len(password); i++ {
+		c := password[i]
+		switch {
+		case c >= 'a' && c <= 'z':
+			lower = 1
+		case c >= 'A' && c <= 'Z':
+			upper = 1
+		case isNumber(c):
+			digit = 1
+		default:
+			special = 1
+		}
+	}
+	return lower + upper + digit + special
+}
+
 func ReplaceID2Name(oldStr string, id int64, name string) string {
 	return strings.ReplaceAll(oldStr, strconv.FormatInt(id, 10), name)
 }
@@ -791,6 +817,149 @@ func parseGuaranteeTs(ts, tMax typeutil.Timestamp) typeutil.Timestamp {
 	return ts
 }
 
+// validateTravelTimestamp checks the travel timestamp is within the time travel retention of collection.
+func validateTravelTimestamp(travelTs, tMax typeutil.Timestamp, properties map[string]string) error {
+	if travelTs == 0 {
+		return nil
+	}
+	if travelTs > tMax {
+		return merr.WrapErrParameterInvalidMsg("travel timestamp %d is later than current timestamp %d", travelTs, tMax)
+	}
+	retention, err := common.GetCollectionRetention(properties, Params.CommonCfg.RetentionDuration.GetAsDuration(time.Second))
+	if err != nil {
+		return merr.WrapErrParameterInvalidMsg(err.Error())
+	}
+	if retention <= 0 {
+		return merr.WrapErrParameterInvalidMsg("time travel is not enabled for the collection, set %s to enable it", common.CollectionRetentionKey)
+	}
+	earliest := tsoutil.AddPhysicalDurationOnTs(tMax, -retention)
+	if travelTs < earliest {
+		return merr.WrapErrParameterInvalidMsg("travel timestamp %d exceeds the retention duration %v", travelTs, retention)
+	}
+	return nil
+}
+
+// applyDefaultSearchParams fills the search params omitted by the request with the defaults
+// stored in the collection properties, the values passed by request always win.
+func applyDefaultSearchParams(searchParams []*commonpb.KeyValuePair, properties map[string]string) ([]*commonpb.KeyValuePair, error) {
+	_, hasMetricType := properties[common.CollectionSearchMetricTypeKey]
+	_, hasParams := properties[common.CollectionSearchParamsKey]
+	if !hasMetricType && !hasParams {
+		return searchParams, nil
+	}
+	params := funcutil.KeyValuePair2Map(searchParams)
+
+	if metricType, ok := properties[common.CollectionSearchMetricTypeKey]; ok && params[common.MetricTypeKey] == "" {
+		params[common.MetricTypeKey] = metricType
+	}
+
+	if v, ok := properties[common.CollectionSearchParamsKey]; ok {
+		defaults := make(map[string]interface{})
+		if err := json.Unmarshal([]byte(v), &defaults); err != nil {
+			return nil, merr.WrapErrParameterInvalidMsg("invalid %s: %s", common.CollectionSearchParamsKey, v)
+		}
+		requested := make(map[string]interface{})
+		if str := params[SearchParamsKey]; str != "" {
+			if err := json.Unmarshal([]byte(str), &requested); err != nil {
+				return nil, merr.WrapErrParameterInvalidMsg("invalid %s: %s", SearchParamsKey, str)
+			}
+		}
+		for key, value := range defaults {
+			if _, ok := requested[key]; !ok {
+				requested[key] = value
+			}
+		}
+		bytes, err := json.Marshal(requested)
+		if err != nil {
+			return nil, err
+		}
+		params[SearchParamsKey] = string(bytes)
+	}
+
+	return funcutil.Map2KeyValuePair(params), nil
+}
+
+// getDefaultConsistencyLevel returns the consistency level used by the search requests
+// which don't specify it, the collection property overrides the one set at creation.
+func getDefaultConsistencyLevel(properties map[string]string, level commonpb.ConsistencyLevel) (commonpb.ConsistencyLevel, error) {
+	v, ok := properties[common.CollectionSearchConsistencyLevelKey]
+	if !ok {
+		return level, nil
+	}
+	if value, ok := commonpb.ConsistencyLevel_value[v]; ok {
+		return commonpb.ConsistencyLevel(value), nil
+	}
+	if value, err := strconv.ParseInt(v, 10, 32); err == nil {
+		if _, ok := commonpb.ConsistencyLevel_name[int32(value)]; ok {
+			return commonpb.ConsistencyLevel(value), nil
+		}
+	}
+	return level, merr.WrapErrParameterInvalidMsg("invalid %s: %s", common.CollectionSearchConsistencyLevelKey, v)
+}
+
+// validateDefaultSearchProperties checks the default search params set in collection properties.
+func validateDefaultSearchProperties(properties []*commonpb.KeyValuePair) error {
+	props := funcutil.KeyValuePair2Map(properties)
+	if _, err := applyDefaultSearchParams(nil, props); err != nil {
+		return err
+	}
+	if _, err := getDefaultConsistencyLevel(props, commonpb.ConsistencyLevel_Bounded); err != nil {
+		return err
+	}
+	return nil
+}
+
+// checkCollectionWritable returns error if the collection is set to read-only mode.
+func checkCollectionWritable(ctx context.Context, dbName string, collectionName string) error {
+	collectionID, err := globalMetaCache.GetCollectionID(ctx, dbName, collectionName)
+	if err != nil {
+		return err
+	}
+	collectionInfo, err := globalMetaCache.GetCollectionInfo(ctx, dbName, collectionName, collectionID)
+	if err != nil {
+		return err
+	}
+	if common.IsCollectionReadOnly(collectionInfo.properties) {
+		return merr.WrapErrCollectionReadOnly(collectionName)
+	}
+	return nil
+}
+
+// isCollectionShardsScaled returns true if the virtual channels of the collection were scaled.
+func isCollectionShardsScaled(ctx context.Context, dbName string, collectionName string, collectionID int64) (bool, error) {
+	collectionInfo, err := globalMetaCache.GetCollectionInfo(ctx, dbName, collectionName, collectionID)
+	if err != nil {
+		return false, err
+	}
+	return common.IsCollectionShardsScaled(collectionInfo.properties), nil
+}
+
+// deleteTargetChannels returns the indexes of channels to send the delete of the primary key hashed to key,
+// the delete is sent to all channels if the channels scaled, since the primary key may be inserted into any of them.
+func deleteTargetChannels(key uint32, numChannels int, shardsScaled bool) []uint32 {
+	if !shardsScaled {
+		return []uint32{key}
+	}
+	keys := make([]uint32, numChannels)
+	for i := range keys {
+		keys[i] = uint32(i)
+	}
+	return keys
+}
+
+// getCollectionLoadPriority returns the load priority of collection set by properties.
+func getCollectionLoadPriority(ctx context.Context, dbName string, collectionName string, collectionID int64) (int32, error) {
+	collectionInfo, err := globalMetaCache.GetCollectionInfo(ctx, dbName, collectionName, collectionID)
+	if err != nil {
+		return common.LoadPriorityNormal, err
+	}
+	priority, err := common.GetCollectionLoadPriority(collectionInfo.properties)
+	if err != nil {
+		return common.LoadPriorityNormal, merr.WrapErrParameterInvalidMsg(err.Error())
+	}
+	return priority, nil
+}
+
 func validateName(entity string, nameType string) error {
 	entity = strings.TrimSpace(entity)
 
@@ -918,7 +1087,15 @@ func PasswordVerify(ctx context.Context, username, rawPwd string) bool {
 	return passwordVerify(ctx, username, rawPwd, globalMetaCache)
 }
 
-func VerifyAPIKey(rawToken string) (string, error) {
+// VerifyAPIKey verifies the api key and returns the user bound to it, the api keys issued by rootcoord
+// are checked against the scope required, the others are verified by the hook.
+func VerifyAPIKey(ctx context.Context, rawToken string, scope string) (string, error) {
+	if id, secret, ok := crypto.ParseAPIKey(rawToken); ok && Params.CommonCfg.APIKeyEnabled.GetAsBool() {
+		if globalMetaCache == nil {
+			return "", merr.WrapErrServiceUnavailable("internal: Milvus Proxy is not ready yet. please wait")
+		}
+		return verifyBuiltinAPIKey(ctx, id, secret, scope, globalMetaCache)
+	}
 	if hoo == nil {
 		return "", merr.WrapErrServiceInternal("internal: Milvus Proxy is not ready yet. please wait")
 	}
@@ -943,11 +1120,14 @@ func passwordVerify(ctx context.Context, username, rawPwd string, globalMetaCach
 	// hit cache
 	sha256Pwd := crypto.SHA256(rawPwd, credInfo.Username)
 	if credInfo.Sha256Password != "" {
-		return sha256Pwd == credInfo.Sha256Password
+		return sha256Pwd == credInfo.Sha256Password || previousPasswordVerify(ctx, username, rawPwd, globalMetaCache)
 	}
 
 	// miss cache, verify against encrypted password from etcd
 	if err := bcrypt.CompareHashAndPassword([]byte(credInfo.EncryptedPassword), []byte(rawPwd)); err != nil {
+		if previousPasswordVerify(ctx, username, rawPwd, globalMetaCache) {
+			return true
+		}
 		log.Error("Verify password failed", zap.Error(err))
 		return false
 	}
@@ -959,6 +1139,61 @@ func passwordVerify(ctx context.Context, username, rawPwd string, globalMetaCach
 	return true
 }
 
+// previousPasswordVerify verifies the password against the previous password, which is still valid during the rotation window.
+func previousPasswordVerify(ctx context.Context, username, rawPwd string, globalMetaCache Cache) bool {
+	if Params.CommonCfg.PasswordRotationWindow.GetAsInt64() <= 0 {
+		return false
+	}
+	state, err := globalMetaCache.GetCredentialState(ctx, username)
+	if err != nil {
+		log.Warn("failed to get credential state", zap.String("username", username), zap.Error(err))
+		return false
+	}
+	if !state.VerifyPreviousPassword(rawPwd, time.Now()) {
+		return false
+	}
+	log.Ctx(ctx).Info("verified by the previous password in rotation window", zap.String("username", username),
+		zap.Time("expireTime", time.Unix(state.PreviousPasswordExpireTime, 0)))
+	return true
+}
+
+// checkPasswordExpired returns error if the password of the user is not changed for longer than the expire days.
+func checkPasswordExpired(ctx context.Context, username string, globalMetaCache Cache) error {
+	expireDays := Params.CommonCfg.PasswordExpireDays.GetAsInt64()
+	if expireDays <= 0 {
+		return nil
+	}
+	state, err := globalMetaCache.GetCredentialState(ctx, username)
+	if err != nil {
+		return err
+	}
+	if state.Expired(time.Duration(expireDays)*24*time.Hour, time.Now()) {
+		return merr.WrapErrPrivilegeNotAuthenticated("the password of %s is expired, please update the password", username)
+	}
+	return nil
+}
+
+// CheckPasswordExpired returns error if the password of the user is expired.
+func CheckPasswordExpired(ctx context.Context, username string) error {
+	return checkPasswordExpired(ctx, username, globalMetaCache)
+}
+
+// checkPasswordReused returns error if the new password is one of the latest passwords of the user.
+func checkPasswordReused(ctx context.Context, username, rawPwd string, globalMetaCache Cache) error {
+	history := Params.CommonCfg.PasswordReuseHistory.GetAsInt()
+	if history <= 0 {
+		return nil
+	}
+	state, err := globalMetaCache.GetCredentialState(ctx, username)
+	if err != nil {
+		return err
+	}
+	if state.Reused(rawPwd, history) {
+		return merr.WrapErrParameterInvalidMsg("the password can't be the same as the latest %d passwords", history)
+	}
+	return nil
+}
+
 func translatePkOutputFields(schema *schemapb.CollectionSchema) ([]string, []int64) {
 	pkNames := []string{}
 	fieldIDs := []int64{}
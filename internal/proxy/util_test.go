@@ -44,6 +44,7 @@ import (
 	"github.com/milvus-io/milvus/pkg/mq/msgstream"
 	"github.com/milvus-io/milvus/pkg/util"
 	"github.com/milvus-io/milvus/pkg/util/crypto"
+	"github.com/milvus-io/milvus/pkg/util/funcutil"
 	"github.com/milvus-io/milvus/pkg/util/merr"
 	"github.com/milvus-io/milvus/pkg/util/paramtable"
 	"github.com/milvus-io/milvus/pkg/util/tsoutil"
@@ -764,6 +765,17 @@ func TestValidatePassword(t *testing.T) {
 	assert.Error(t, res)
 }
 
+func TestValidatePasswordComplexity(t *testing.T) {
+	paramtable.Get().Save(Params.CommonCfg.PasswordMinCharClasses.Key, "3")
+	defer paramtable.Get().Reset(Params.CommonCfg.PasswordMinCharClasses.Key)
+
+	assert.Error(t, ValidatePassword("abcdefgh"))
+	assert.Error(t, ValidatePassword("abcdEFGH"))
+	assert.NoError(t, ValidatePassword("abcdEF12"))
+	assert.NoError(t, ValidatePassword("abcd12#$"))
+	assert.NoError(t, ValidatePassword("aB1#aB1#"))
+}
+
 func TestReplaceID2Name(t *testing.T) {
 	srcStr := "collection 432682805904801793 has not been loaded to memory or load failed"
 	dstStr := "collection default_collection has not been loaded to memory or load failed"
@@ -944,6 +956,61 @@ func TestPasswordVerify(t *testing.T) {
 	assert.Equal(t, 1, invokedCount)
 }
 
+func TestPasswordPolicy(t *testing.T) {
+	ctx := context.Background()
+	username := "user-test00"
+	current, err := crypto.PasswordEncrypt("current")
+	assert.NoError(t, err)
+	previous, err := crypto.PasswordEncrypt("previous")
+	assert.NoError(t, err)
+
+	cache := NewMockCache(t)
+	cache.EXPECT().GetCredentialInfo(mock.Anything, username).Return(&internalpb.CredentialInfo{
+		Username:       username,
+		Sha256Password: crypto.SHA256("current", username),
+	}, nil)
+	state := &crypto.CredentialState{
+		EncryptedPassword:          current,
+		PreviousPassword:           previous,
+		PreviousPasswordExpireTime: time.Now().Add(time.Hour).Unix(),
+		PasswordHistory:            []string{previous},
+		UpdatedTime:                time.Now().Add(-48 * time.Hour).Unix(),
+	}
+	cache.EXPECT().GetCredentialState(mock.Anything, username).Return(state, nil)
+
+	t.Run("rotation window", func(t *testing.T) {
+		assert.True(t, passwordVerify(ctx, username, "current", cache))
+		// the previous password is invalid if rotation is disabled
+		assert.False(t, passwordVerify(ctx, username, "previous", cache))
+
+		paramtable.Get().Save(Params.CommonCfg.PasswordRotationWindow.Key, "3600")
+		defer paramtable.Get().Reset(Params.CommonCfg.PasswordRotationWindow.Key)
+		assert.True(t, passwordVerify(ctx, username, "previous", cache))
+		assert.False(t, passwordVerify(ctx, username, "wrong", cache))
+	})
+
+	t.Run("expire", func(t *testing.T) {
+		assert.NoError(t, checkPasswordExpired(ctx, username, cache))
+
+		paramtable.Get().Save(Params.CommonCfg.PasswordExpireDays.Key, "1")
+		defer paramtable.Get().Reset(Params.CommonCfg.PasswordExpireDays.Key)
+		assert.ErrorIs(t, checkPasswordExpired(ctx, username, cache), merr.ErrPrivilegeNotAuthenticated)
+
+		paramtable.Get().Save(Params.CommonCfg.PasswordExpireDays.Key, "3")
+		assert.NoError(t, checkPasswordExpired(ctx, username, cache))
+	})
+
+	t.Run("reuse", func(t *testing.T) {
+		assert.NoError(t, checkPasswordReused(ctx, username, "current", cache))
+
+		paramtable.Get().Save(Params.CommonCfg.PasswordReuseHistory.Key, "2")
+		defer paramtable.Get().Reset(Params.CommonCfg.PasswordReuseHistory.Key)
+		assert.Error(t, checkPasswordReused(ctx, username, "current", cache))
+		assert.Error(t, checkPasswordReused(ctx, username, "previous", cache))
+		assert.NoError(t, checkPasswordReused(ctx, username, "new", cache))
+	})
+}
+
 func Test_isCollectionIsLoaded(t *testing.T) {
 	ctx := context.Background()
 	t.Run("normal", func(t *testing.T) {
@@ -2053,6 +2120,124 @@ func Test_validateMaxCapacityPerRow(t *testing.T) {
 	})
 }
 
+func Test_validateTravelTimestamp(t *testing.T) {
+	paramtable.Init()
+	now := time.Now()
+	tMax := tsoutil.ComposeTSByTime(now, 0)
+
+	t.Run("no travel", func(t *testing.T) {
+		assert.NoError(t, validateTravelTimestamp(0, tMax, nil))
+	})
+
+	t.Run("time travel disabled", func(t *testing.T) {
+		travelTs := tsoutil.ComposeTSByTime(now.Add(-time.Second), 0)
+		err := validateTravelTimestamp(travelTs, tMax, nil)
+		assert.ErrorIs(t, err, merr.ErrParameterInvalid)
+	})
+
+	t.Run("future travel timestamp", func(t *testing.T) {
+		properties := map[string]string{common.CollectionRetentionKey: "60"}
+		travelTs := tsoutil.ComposeTSByTime(now.Add(time.Second), 0)
+		err := validateTravelTimestamp(travelTs, tMax, properties)
+		assert.ErrorIs(t, err, merr.ErrParameterInvalid)
+	})
+
+	t.Run("invalid retention", func(t *testing.T) {
+		properties := map[string]string{common.CollectionRetentionKey: "abc"}
+		travelTs := tsoutil.ComposeTSByTime(now.Add(-time.Second), 0)
+		err := validateTravelTimestamp(travelTs, tMax, properties)
+		assert.ErrorIs(t, err, merr.ErrParameterInvalid)
+	})
+
+	t.Run("collection retention", func(t *testing.T) {
+		properties := map[string]string{common.CollectionRetentionKey: "60"}
+		travelTs := tsoutil.ComposeTSByTime(now.Add(-30*time.Second), 0)
+		assert.NoError(t, validateTravelTimestamp(travelTs, tMax, properties))
+
+		travelTs = tsoutil.ComposeTSByTime(now.Add(-2*time.Minute), 0)
+		err := validateTravelTimestamp(travelTs, tMax, properties)
+		assert.ErrorIs(t, err, merr.ErrParameterInvalid)
+	})
+
+	t.Run("global retention", func(t *testing.T) {
+		paramtable.Get().Save(Params.CommonCfg.RetentionDuration.Key, "60")
+		defer paramtable.Get().Reset(Params.CommonCfg.RetentionDuration.Key)
+
+		travelTs := tsoutil.ComposeTSByTime(now.Add(-30*time.Second), 0)
+		assert.NoError(t, validateTravelTimestamp(travelTs, tMax, nil))
+	})
+}
+
+func Test_applyDefaultSearchParams(t *testing.T) {
+	searchParams := []*commonpb.KeyValuePair{
+		{Key: TopKKey, Value: "10"},
+		{Key: SearchParamsKey, Value: `{"ef": 32}`},
+	}
+
+	t.Run("no defaults", func(t *testing.T) {
+		params, err := applyDefaultSearchParams(searchParams, nil)
+		assert.NoError(t, err)
+		assert.Equal(t, searchParams, params)
+	})
+
+	t.Run("request wins", func(t *testing.T) {
+		properties := map[string]string{
+			common.CollectionSearchParamsKey:     `{"ef": 64, "nprobe": 16}`,
+			common.CollectionSearchMetricTypeKey: "IP",
+		}
+		params, err := applyDefaultSearchParams(searchParams, properties)
+		assert.NoError(t, err)
+		kvs := funcutil.KeyValuePair2Map(params)
+		assert.Equal(t, "10", kvs[TopKKey])
+		assert.Equal(t, "IP", kvs[common.MetricTypeKey])
+		values := make(map[string]interface{})
+		assert.NoError(t, json.Unmarshal([]byte(kvs[SearchParamsKey]), &values))
+		assert.EqualValues(t, 32, values["ef"])
+		assert.EqualValues(t, 16, values["nprobe"])
+
+		params, err = applyDefaultSearchParams(append(params, &commonpb.KeyValuePair{Key: common.MetricTypeKey, Value: "L2"}), properties)
+		assert.NoError(t, err)
+		assert.Equal(t, "L2", funcutil.KeyValuePair2Map(params)[common.MetricTypeKey])
+	})
+
+	t.Run("no search params in request", func(t *testing.T) {
+		properties := map[string]string{common.CollectionSearchParamsKey: `{"nprobe": 16}`}
+		params, err := applyDefaultSearchParams([]*commonpb.KeyValuePair{{Key: TopKKey, Value: "10"}}, properties)
+		assert.NoError(t, err)
+		assert.JSONEq(t, `{"nprobe": 16}`, funcutil.KeyValuePair2Map(params)[SearchParamsKey])
+	})
+
+	t.Run("invalid", func(t *testing.T) {
+		_, err := applyDefaultSearchParams(searchParams, map[string]string{common.CollectionSearchParamsKey: "invalid"})
+		assert.ErrorIs(t, err, merr.ErrParameterInvalid)
+
+		_, err = applyDefaultSearchParams([]*commonpb.KeyValuePair{{Key: SearchParamsKey, Value: "invalid"}},
+			map[string]string{common.CollectionSearchParamsKey: `{"nprobe": 16}`})
+		assert.ErrorIs(t, err, merr.ErrParameterInvalid)
+	})
+}
+
+func Test_getDefaultConsistencyLevel(t *testing.T) {
+	level, err := getDefaultConsistencyLevel(nil, commonpb.ConsistencyLevel_Bounded)
+	assert.NoError(t, err)
+	assert.Equal(t, commonpb.ConsistencyLevel_Bounded, level)
+
+	level, err = getDefaultConsistencyLevel(map[string]string{common.CollectionSearchConsistencyLevelKey: "Eventually"}, commonpb.ConsistencyLevel_Bounded)
+	assert.NoError(t, err)
+	assert.Equal(t, commonpb.ConsistencyLevel_Eventually, level)
+
+	level, err = getDefaultConsistencyLevel(map[string]string{common.CollectionSearchConsistencyLevelKey: "0"}, commonpb.ConsistencyLevel_Bounded)
+	assert.NoError(t, err)
+	assert.Equal(t, commonpb.ConsistencyLevel_Strong, level)
+
+	_, err = getDefaultConsistencyLevel(map[string]string{common.CollectionSearchConsistencyLevelKey: "unknown"}, commonpb.ConsistencyLevel_Bounded)
+	assert.ErrorIs(t, err, merr.ErrParameterInvalid)
+
+	assert.NoError(t, validateDefaultSearchProperties(nil))
+	assert.Error(t, validateDefaultSearchProperties([]*commonpb.KeyValuePair{{Key: common.CollectionSearchConsistencyLevelKey, Value: "99"}}))
+	assert.Error(t, validateDefaultSearchProperties([]*commonpb.KeyValuePair{{Key: common.CollectionSearchParamsKey, Value: "[1]"}}))
+}
+
 func TestSendReplicateMessagePack(t *testing.T) {
 	ctx := context.Background()
 	mockStream := msgstream.NewMockMsgStream(t)
@@ -2087,3 +2272,37 @@ func TestSendReplicateMessagePack(t *testing.T) {
 		SendReplicateMessagePack(ctx, mockStream, &milvuspb.DropIndexRequest{})
 	})
 }
+
+func TestGetCollectionLoadPriority(t *testing.T) {
+	cache := globalMetaCache
+	defer func() { globalMetaCache = cache }()
+
+	mockCache := NewMockCache(t)
+	mockCache.On("GetCollectionInfo",
+		mock.Anything, // context.Context
+		mock.AnythingOfType("string"),
+		mock.AnythingOfType("string"),
+		mock.AnythingOfType("int64"),
+	).Return(func(ctx context.Context, dbName string, collectionName string, collectionID int64) *collectionBasicInfo {
+		switch collectionName {
+		case "high":
+			return &collectionBasicInfo{collID: collectionID, properties: map[string]string{common.CollectionLoadPriorityKey: "high"}}
+		case "invalid":
+			return &collectionBasicInfo{collID: collectionID, properties: map[string]string{common.CollectionLoadPriorityKey: "urgent"}}
+		default:
+			return &collectionBasicInfo{collID: collectionID}
+		}
+	}, nil)
+	globalMetaCache = mockCache
+
+	priority, err := getCollectionLoadPriority(context.Background(), "db", "high", 1)
+	assert.NoError(t, err)
+	assert.Equal(t, common.LoadPriorityHigh, priority)
+
+	priority, err = getCollectionLoadPriority(context.Background(), "db", "normal", 2)
+	assert.NoError(t, err)
+	assert.Equal(t, common.LoadPriorityNormal, priority)
+
+	_, err = getCollectionLoadPriority(context.Background(), "db", "invalid", 3)
+	assert.ErrorIs(t, err, merr.ErrParameterInvalid)
+}
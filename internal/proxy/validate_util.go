@@ -4,7 +4,10 @@ import (
 	"fmt"
 	"math"
 	"reflect"
+	"sort"
+	"strings"
 
+	"github.com/cockroachdb/errors"
 	"go.uber.org/zap"
 
 	"github.com/milvus-io/milvus-proto/go-api/v2/schemapb"
@@ -55,12 +58,16 @@ func (v *validateUtil) apply(opts ...validateOption) {
 	}
 }
 
+// Validate checks the insert data against the schema. The rows failed in validation are reported
+// by *rowValidationError together, which is returned after the data is checked to be aligned,
+// so that the invalid rows could be skipped in partial accept mode.
 func (v *validateUtil) Validate(data []*schemapb.FieldData, schema *schemapb.CollectionSchema, numRows uint64) error {
 	helper, err := typeutil.CreateSchemaHelper(schema)
 	if err != nil {
 		return err
 	}
 
+	rowErr := &rowValidationError{}
 	for _, field := range data {
 		fieldSchema, err := helper.GetFieldFromName(field.GetFieldName())
 		if err != nil {
@@ -69,36 +76,24 @@ func (v *validateUtil) Validate(data []*schemapb.FieldData, schema *schemapb.Col
 
 		switch fieldSchema.GetDataType() {
 		case schemapb.DataType_FloatVector:
-			if err := v.checkFloatVectorFieldData(field, fieldSchema); err != nil {
-				return err
-			}
+			err = v.checkFloatVectorFieldData(field, fieldSchema)
 		case schemapb.DataType_Float16Vector:
-			if err := v.checkFloat16VectorFieldData(field, fieldSchema); err != nil {
-				return err
-			}
+			err = v.checkFloat16VectorFieldData(field, fieldSchema)
 		case schemapb.DataType_BinaryVector:
-			if err := v.checkBinaryVectorFieldData(field, fieldSchema); err != nil {
-				return err
-			}
+			err = v.checkBinaryVectorFieldData(field, fieldSchema)
 		case schemapb.DataType_VarChar:
-			if err := v.checkVarCharFieldData(field, fieldSchema); err != nil {
-				return err
-			}
+			err = v.checkVarCharFieldData(field, fieldSchema)
 		case schemapb.DataType_JSON:
-			if err := v.checkJSONFieldData(field, fieldSchema); err != nil {
-				return err
-			}
+			err = v.checkJSONFieldData(field, fieldSchema)
 		case schemapb.DataType_Int8, schemapb.DataType_Int16:
-			if err := v.checkIntegerFieldData(field, fieldSchema); err != nil {
-				return err
-			}
+			err = v.checkIntegerFieldData(field, fieldSchema)
 		case schemapb.DataType_Array:
-			if err := v.checkArrayFieldData(field, fieldSchema); err != nil {
-				return err
-			}
-
+			err = v.checkArrayFieldData(field, fieldSchema)
 		default:
 		}
+		if err != nil && !rowErr.merge(err) {
+			return err
+		}
 	}
 
 	err = v.fillWithDefaultValue(data, helper, numRows)
@@ -110,6 +105,9 @@ func (v *validateUtil) Validate(data []*schemapb.FieldData, schema *schemapb.Col
 		return err
 	}
 
+	if len(rowErr.errs) > 0 {
+		return rowErr
+	}
 	return nil
 }
 
@@ -281,7 +279,21 @@ func (v *validateUtil) checkFloatVectorFieldData(field *schemapb.FieldData, fiel
 	}
 
 	if v.checkNAN {
-		return typeutil.VerifyFloats32(floatArray)
+		dim := int(field.GetVectors().GetDim())
+		if dim <= 0 {
+			dim = 1
+		}
+		rowErr := &rowValidationError{}
+		for row := 0; row*dim < len(floatArray); row++ {
+			end := (row + 1) * dim
+			if end > len(floatArray) {
+				end = len(floatArray)
+			}
+			if err := typeutil.VerifyFloats32(floatArray[row*dim : end]); err != nil {
+				rowErr.add(row, field.GetFieldName(), "float vector contains NaN or Inf")
+			}
+		}
+		return rowErr.err()
 	}
 
 	return nil
@@ -313,7 +325,7 @@ func (v *validateUtil) checkVarCharFieldData(field *schemapb.FieldData, fieldSch
 		if err != nil {
 			return err
 		}
-		return verifyLengthPerRow(strArr, maxLength)
+		return withRowErrorField(verifyLengthPerRow(strArr, maxLength), field.GetFieldName())
 	}
 
 	return nil
@@ -327,18 +339,20 @@ func (v *validateUtil) checkJSONFieldData(field *schemapb.FieldData, fieldSchema
 	}
 
 	if v.checkMaxLen {
-		for _, s := range jsonArray {
-			if int64(len(s)) > paramtable.Get().CommonCfg.JSONMaxLength.GetAsInt64() {
+		maxLength := paramtable.Get().CommonCfg.JSONMaxLength.GetAsInt64()
+		rowErr := &rowValidationError{}
+		for i, s := range jsonArray {
+			if int64(len(s)) > maxLength {
 				if field.GetIsDynamic() {
-					msg := fmt.Sprintf("the length (%d) of dynamic field exceeds max length (%d)", len(s),
-						paramtable.Get().CommonCfg.JSONMaxLength.GetAsInt64())
-					return merr.WrapErrParameterInvalid("valid length dynamic field", "length exceeds max length", msg)
+					rowErr.add(i, field.GetFieldName(),
+						fmt.Sprintf("the length (%d) of dynamic field exceeds max length (%d)", len(s), maxLength))
+					continue
 				}
-				msg := fmt.Sprintf("the length (%d) of json field (%s) exceeds max length (%d)", len(s),
-					field.GetFieldName(), paramtable.Get().CommonCfg.JSONMaxLength.GetAsInt64())
-				return merr.WrapErrParameterInvalid("valid length json string", "length exceeds max length", msg)
+				rowErr.add(i, field.GetFieldName(),
+					fmt.Sprintf("the length (%d) of json field exceeds max length (%d)", len(s), maxLength))
 			}
 		}
+		return rowErr.err()
 	}
 
 	return nil
@@ -357,78 +371,67 @@ func (v *validateUtil) checkIntegerFieldData(field *schemapb.FieldData, fieldSch
 
 	switch fieldSchema.GetDataType() {
 	case schemapb.DataType_Int8:
-		return verifyOverflowByRange(data, math.MinInt8, math.MaxInt8)
+		return withRowErrorField(verifyOverflowByRange(data, math.MinInt8, math.MaxInt8), field.GetFieldName())
 	case schemapb.DataType_Int16:
-		return verifyOverflowByRange(data, math.MinInt16, math.MaxInt16)
+		return withRowErrorField(verifyOverflowByRange(data, math.MinInt16, math.MaxInt16), field.GetFieldName())
 	}
 
 	return nil
 }
 
 func (v *validateUtil) checkArrayElement(array *schemapb.ArrayArray, field *schemapb.FieldSchema) error {
+	rowErr := &rowValidationError{}
+	checkType := func(i int, row *schemapb.ScalarField, expected reflect.Type, expectedStr string) bool {
+		actualType := reflect.TypeOf(row.GetData())
+		if actualType != expected {
+			rowErr.add(i, field.GetName(), fmt.Sprintf("array element type mismatch, expected %s array, got %s array",
+				expectedStr, actualType.String()))
+			return false
+		}
+		return true
+	}
+
 	switch field.GetElementType() {
 	case schemapb.DataType_Bool:
-		for _, row := range array.GetData() {
-			actualType := reflect.TypeOf(row.GetData())
-			if actualType != reflect.TypeOf((*schemapb.ScalarField_BoolData)(nil)) {
-				return merr.WrapErrParameterInvalid("bool array",
-					fmt.Sprintf("%s array", actualType.String()), "insert data does not match")
-			}
+		for i, row := range array.GetData() {
+			checkType(i, row, reflect.TypeOf((*schemapb.ScalarField_BoolData)(nil)), "bool")
 		}
 	case schemapb.DataType_Int8, schemapb.DataType_Int16, schemapb.DataType_Int32:
-		for _, row := range array.GetData() {
-			actualType := reflect.TypeOf(row.GetData())
-			if actualType != reflect.TypeOf((*schemapb.ScalarField_IntData)(nil)) {
-				return merr.WrapErrParameterInvalid("int array",
-					fmt.Sprintf("%s array", actualType.String()), "insert data does not match")
+		for i, row := range array.GetData() {
+			if !checkType(i, row, reflect.TypeOf((*schemapb.ScalarField_IntData)(nil)), "int") {
+				continue
 			}
 			if v.checkOverflow {
-				if field.GetElementType() == schemapb.DataType_Int8 {
-					if err := verifyOverflowByRange(row.GetIntData().GetData(), math.MinInt8, math.MaxInt8); err != nil {
-						return err
-					}
+				var err error
+				switch field.GetElementType() {
+				case schemapb.DataType_Int8:
+					err = verifyOverflowByRange(row.GetIntData().GetData(), math.MinInt8, math.MaxInt8)
+				case schemapb.DataType_Int16:
+					err = verifyOverflowByRange(row.GetIntData().GetData(), math.MinInt16, math.MaxInt16)
 				}
-				if field.GetElementType() == schemapb.DataType_Int16 {
-					if err := verifyOverflowByRange(row.GetIntData().GetData(), math.MinInt16, math.MaxInt16); err != nil {
-						return err
-					}
+				if err != nil {
+					rowErr.add(i, field.GetName(), fmt.Sprintf("array element out of range of %s", field.GetElementType().String()))
 				}
 			}
 		}
 	case schemapb.DataType_Int64:
-		for _, row := range array.GetData() {
-			actualType := reflect.TypeOf(row.GetData())
-			if actualType != reflect.TypeOf((*schemapb.ScalarField_LongData)(nil)) {
-				return merr.WrapErrParameterInvalid("int64 array",
-					fmt.Sprintf("%s array", actualType.String()), "insert data does not match")
-			}
+		for i, row := range array.GetData() {
+			checkType(i, row, reflect.TypeOf((*schemapb.ScalarField_LongData)(nil)), "int64")
 		}
 	case schemapb.DataType_Float:
-		for _, row := range array.GetData() {
-			actualType := reflect.TypeOf(row.GetData())
-			if actualType != reflect.TypeOf((*schemapb.ScalarField_FloatData)(nil)) {
-				return merr.WrapErrParameterInvalid("float array",
-					fmt.Sprintf("%s array", actualType.String()), "insert data does not match")
-			}
+		for i, row := range array.GetData() {
+			checkType(i, row, reflect.TypeOf((*schemapb.ScalarField_FloatData)(nil)), "float")
 		}
 	case schemapb.DataType_Double:
-		for _, row := range array.GetData() {
-			actualType := reflect.TypeOf(row.GetData())
-			if actualType != reflect.TypeOf((*schemapb.ScalarField_DoubleData)(nil)) {
-				return merr.WrapErrParameterInvalid("double array",
-					fmt.Sprintf("%s array", actualType.String()), "insert data does not match")
-			}
+		for i, row := range array.GetData() {
+			checkType(i, row, reflect.TypeOf((*schemapb.ScalarField_DoubleData)(nil)), "double")
 		}
 	case schemapb.DataType_VarChar, schemapb.DataType_String:
-		for _, row := range array.GetData() {
-			actualType := reflect.TypeOf(row.GetData())
-			if actualType != reflect.TypeOf((*schemapb.ScalarField_StringData)(nil)) {
-				return merr.WrapErrParameterInvalid("string array",
-					fmt.Sprintf("%s array", actualType.String()), "insert data does not match")
-			}
+		for i, row := range array.GetData() {
+			checkType(i, row, reflect.TypeOf((*schemapb.ScalarField_StringData)(nil)), "string")
 		}
 	}
-	return nil
+	return rowErr.err()
 }
 
 func (v *validateUtil) checkArrayFieldData(field *schemapb.FieldData, fieldSchema *schemapb.FieldSchema) error {
@@ -439,12 +442,14 @@ func (v *validateUtil) checkArrayFieldData(field *schemapb.FieldData, fieldSchem
 		expectStr := fmt.Sprintf("need %s array", elementTypeStr)
 		return merr.WrapErrParameterInvalid(expectStr, "got nil", msg)
 	}
+	rowErr := &rowValidationError{}
 	if v.checkMaxCap {
 		maxCapacity, err := parameterutil.GetMaxCapacity(fieldSchema)
 		if err != nil {
 			return err
 		}
-		if err := verifyCapacityPerRow(data.GetData(), maxCapacity, fieldSchema.GetElementType()); err != nil {
+		err = verifyCapacityPerRow(data.GetData(), maxCapacity, fieldSchema.GetElementType())
+		if err != nil && !rowErr.merge(withRowErrorField(err, field.GetFieldName())) {
 			return err
 		}
 	}
@@ -453,27 +458,33 @@ func (v *validateUtil) checkArrayFieldData(field *schemapb.FieldData, fieldSchem
 		if err != nil {
 			return err
 		}
-		for _, row := range data.GetData() {
+		for i, row := range data.GetData() {
 			if err := verifyLengthPerRow(row.GetStringData().GetData(), maxLength); err != nil {
-				return err
+				rowErr.add(i, field.GetFieldName(), fmt.Sprintf("the length of string element exceeds max length (%d)", maxLength))
 			}
 		}
 	}
-	return v.checkArrayElement(data, fieldSchema)
+	if err := v.checkArrayElement(data, fieldSchema); err != nil && !rowErr.merge(withRowErrorField(err, field.GetFieldName())) {
+		return err
+	}
+	return rowErr.err()
 }
 
+// verifyLengthPerRow returns a *rowValidationError of all the strings exceed the max length.
 func verifyLengthPerRow[E interface{ ~string | ~[]byte }](strArr []E, maxLength int64) error {
+	rowErr := &rowValidationError{}
 	for i, s := range strArr {
 		if int64(len(s)) > maxLength {
-			msg := fmt.Sprintf("the length (%d) of %dth string exceeds max length (%d)", len(s), i, maxLength)
-			return merr.WrapErrParameterInvalid("valid length string", "string length exceeds max length", msg)
+			rowErr.add(i, "", fmt.Sprintf("the length (%d) of string exceeds max length (%d)", len(s), maxLength))
 		}
 	}
 
-	return nil
+	return rowErr.err()
 }
 
+// verifyCapacityPerRow returns a *rowValidationError of all the arrays exceed the max capacity.
 func verifyCapacityPerRow(arrayArray []*schemapb.ScalarField, maxCapacity int64, elementType schemapb.DataType) error {
+	rowErr := &rowValidationError{}
 	for i, array := range arrayArray {
 		arrayLen := 0
 		switch elementType {
@@ -497,21 +508,104 @@ func verifyCapacityPerRow(arrayArray []*schemapb.ScalarField, maxCapacity int64,
 		if int64(arrayLen) <= maxCapacity {
 			continue
 		}
-		msg := fmt.Sprintf("the length (%d) of %dth array exceeds max capacity (%d)", arrayLen, i, maxCapacity)
-		return merr.WrapErrParameterInvalid("valid length array", "array length exceeds max capacity", msg)
+		rowErr.add(i, "", fmt.Sprintf("the length (%d) of array exceeds max capacity (%d)", arrayLen, maxCapacity))
 	}
 
-	return nil
+	return rowErr.err()
 }
 
+// verifyOverflowByRange returns a *rowValidationError of all the elements out of range [lb, ub].
 func verifyOverflowByRange(arr []int32, lb int64, ub int64) error {
+	rowErr := &rowValidationError{}
 	for idx, e := range arr {
 		if lb > int64(e) || ub < int64(e) {
-			msg := fmt.Sprintf("the %dth element (%d) out of range: [%d, %d]", idx, e, lb, ub)
-			return merr.WrapErrParameterInvalid("integer doesn't overflow", "out of range", msg)
+			rowErr.add(idx, "", fmt.Sprintf("the element (%d) out of range: [%d, %d]", e, lb, ub))
 		}
 	}
-	return nil
+	return rowErr.err()
+}
+
+// maxReportedRowErrors limits the number of row errors detailed in the error message.
+const maxReportedRowErrors = 10
+
+// rowError describes why a row of the insert data is invalid.
+type rowError struct {
+	row    int
+	field  string
+	reason string
+}
+
+// rowValidationError collects all the invalid rows found in validation,
+// it's a parameter invalid error whose message details the first rows failed.
+type rowValidationError struct {
+	errs []rowError
+}
+
+func (e *rowValidationError) add(row int, field string, reason string) {
+	e.errs = append(e.errs, rowError{row: row, field: field, reason: reason})
+}
+
+// merge appends the row errors of err, returns false if err is not a *rowValidationError.
+func (e *rowValidationError) merge(err error) bool {
+	var other *rowValidationError
+	if !errors.As(err, &other) {
+		return false
+	}
+	e.errs = append(e.errs, other.errs...)
+	return true
+}
+
+// err returns nil if there is no row error, avoiding the typed nil error.
+func (e *rowValidationError) err() error {
+	if len(e.errs) == 0 {
+		return nil
+	}
+	return e
+}
+
+// invalidRows returns the sorted offsets of the invalid rows.
+func (e *rowValidationError) invalidRows() []int {
+	rows := typeutil.NewSet[int]()
+	for _, re := range e.errs {
+		rows.Insert(re.row)
+	}
+	result := rows.Collect()
+	sort.Ints(result)
+	return result
+}
+
+func (e *rowValidationError) Error() string {
+	var sb strings.Builder
+	fmt.Fprintf(&sb, "%d rows failed in validation:", len(e.invalidRows()))
+	for i, re := range e.errs {
+		if i >= maxReportedRowErrors {
+			fmt.Fprintf(&sb, " ...and %d more errors", len(e.errs)-maxReportedRowErrors)
+			break
+		}
+		if i > 0 {
+			sb.WriteString(";")
+		}
+		fmt.Fprintf(&sb, " row %d field %s: %s", re.row, re.field, re.reason)
+	}
+	return sb.String()
+}
+
+// Unwrap makes the row validation error a parameter invalid error.
+func (e *rowValidationError) Unwrap() error {
+	return merr.ErrParameterInvalid
+}
+
+// withRowErrorField fills the field name of the row errors, other errors are returned as is.
+func withRowErrorField(err error, field string) error {
+	var rowErr *rowValidationError
+	if errors.As(err, &rowErr) {
+		for i := range rowErr.errs {
+			if rowErr.errs[i].field == "" {
+				rowErr.errs[i].field = field
+			}
+		}
+	}
+	return err
 }
 
 func newValidateUtil(opts ...validateOption) *validateUtil {
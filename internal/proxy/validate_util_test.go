@@ -10,6 +10,7 @@ import (
 	"github.com/milvus-io/milvus-proto/go-api/v2/commonpb"
 	"github.com/milvus-io/milvus-proto/go-api/v2/schemapb"
 	"github.com/milvus-io/milvus/pkg/common"
+	"github.com/milvus-io/milvus/pkg/util/merr"
 	"github.com/milvus-io/milvus/pkg/util/paramtable"
 	"github.com/milvus-io/milvus/pkg/util/typeutil"
 )
@@ -3210,3 +3211,112 @@ func Test_validateUtil_checkJSONData(t *testing.T) {
 		assert.Error(t, err)
 	})
 }
+
+func Test_rowValidationError(t *testing.T) {
+	rowErr := &rowValidationError{}
+	assert.NoError(t, rowErr.err())
+
+	rowErr.add(3, "a", "reason")
+	rowErr.add(1, "b", "reason")
+	assert.True(t, rowErr.merge(verifyOverflowByRange([]int32{1, math.MaxInt8 + 1}, math.MinInt8, math.MaxInt8)))
+	assert.False(t, rowErr.merge(merr.WrapErrParameterInvalid("a", "b")))
+	assert.Equal(t, []int{1, 3}, rowErr.invalidRows())
+
+	err := rowErr.err()
+	assert.ErrorIs(t, err, merr.ErrParameterInvalid)
+	assert.Equal(t, merr.Code(merr.ErrParameterInvalid), merr.Code(err))
+	assert.Contains(t, err.Error(), "2 rows failed")
+
+	for i := 0; i < maxReportedRowErrors*2; i++ {
+		rowErr.add(i, "c", "reason")
+	}
+	assert.Contains(t, rowErr.Error(), "more errors")
+}
+
+func Test_validateUtil_Validate_rowErrors(t *testing.T) {
+	paramtable.Init()
+
+	data := []*schemapb.FieldData{
+		{
+			FieldName: "vec",
+			Type:      schemapb.DataType_FloatVector,
+			Field: &schemapb.FieldData_Vectors{
+				Vectors: &schemapb.VectorField{
+					Dim: 2,
+					Data: &schemapb.VectorField_FloatVector{
+						FloatVector: &schemapb.FloatArray{
+							Data: []float32{1, 1, float32(math.NaN()), 1, 1, 1, 1, 1},
+						},
+					},
+				},
+			},
+		},
+		{
+			FieldName: "str",
+			Type:      schemapb.DataType_VarChar,
+			Field: &schemapb.FieldData_Scalars{
+				Scalars: &schemapb.ScalarField{
+					Data: &schemapb.ScalarField_StringData{
+						StringData: &schemapb.StringArray{
+							Data: []string{"1", "1", "1", "11111111"},
+						},
+					},
+				},
+			},
+		},
+		{
+			FieldName: "int8",
+			Type:      schemapb.DataType_Int8,
+			Field: &schemapb.FieldData_Scalars{
+				Scalars: &schemapb.ScalarField{
+					Data: &schemapb.ScalarField_IntData{
+						IntData: &schemapb.IntArray{
+							Data: []int32{1, 1, 1, math.MaxInt8 + 1},
+						},
+					},
+				},
+			},
+		},
+	}
+
+	schema := &schemapb.CollectionSchema{
+		Fields: []*schemapb.FieldSchema{
+			{
+				Name:     "vec",
+				DataType: schemapb.DataType_FloatVector,
+				TypeParams: []*commonpb.KeyValuePair{
+					{
+						Key:   common.DimKey,
+						Value: "2",
+					},
+				},
+			},
+			{
+				Name:     "str",
+				DataType: schemapb.DataType_VarChar,
+				TypeParams: []*commonpb.KeyValuePair{
+					{
+						Key:   common.MaxLengthKey,
+						Value: "4",
+					},
+				},
+			},
+			{
+				Name:     "int8",
+				DataType: schemapb.DataType_Int8,
+			},
+		},
+	}
+
+	v := newValidateUtil(withNANCheck(), withMaxLenCheck(), withOverflowCheck())
+	err := v.Validate(data, schema, 4)
+	assert.ErrorIs(t, err, merr.ErrParameterInvalid)
+
+	var rowErr *rowValidationError
+	assert.ErrorAs(t, err, &rowErr)
+	assert.Equal(t, []int{1, 3}, rowErr.invalidRows())
+	assert.Len(t, rowErr.errs, 3)
+	assert.Contains(t, err.Error(), "row 1 field vec")
+	assert.Contains(t, err.Error(), "row 3 field str")
+	assert.Contains(t, err.Error(), "row 3 field int8")
+}
@@ -23,8 +23,12 @@ import (
 	"github.com/milvus-io/milvus/internal/querycoordv2/meta"
 	"github.com/milvus-io/milvus/internal/querycoordv2/session"
 	"github.com/milvus-io/milvus/internal/querycoordv2/task"
+	"github.com/milvus-io/milvus/pkg/log"
 )
 
+// logger is the module logger of balancers, its level could be altered at runtime by module name "querycoordv2.balance".
+var logger = log.Module("querycoordv2.balance")
+
 type SegmentAssignPlan struct {
 	Segment   *meta.Segment
 	ReplicaID int64
@@ -117,13 +121,23 @@ func (b *RoundRobinBalancer) BalanceReplica(replica *meta.Replica) ([]SegmentAss
 	return nil, nil
 }
 
+// getNodes returns the available nodes, gray failing nodes are excluded unless all the nodes are gray failing.
 func (b *RoundRobinBalancer) getNodes(nodes []int64) []*session.NodeInfo {
 	ret := make([]*session.NodeInfo, 0, len(nodes))
+	grayFailing := make([]*session.NodeInfo, 0)
 	for _, n := range nodes {
 		node := b.nodeManager.Get(n)
-		if node != nil && !node.IsStoppingState() {
-			ret = append(ret, node)
+		if node == nil || node.IsStoppingState() {
+			continue
+		}
+		if node.IsGrayFailing() {
+			grayFailing = append(grayFailing, node)
+			continue
 		}
+		ret = append(ret, node)
+	}
+	if len(ret) == 0 {
+		return grayFailing
 	}
 	return ret
 }
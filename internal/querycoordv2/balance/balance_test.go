@@ -25,6 +25,7 @@ import (
 	"github.com/milvus-io/milvus/internal/querycoordv2/meta"
 	"github.com/milvus-io/milvus/internal/querycoordv2/session"
 	"github.com/milvus-io/milvus/internal/querycoordv2/task"
+	"github.com/milvus-io/milvus/pkg/util/paramtable"
 )
 
 type BalanceTestSuite struct {
@@ -33,6 +34,10 @@ type BalanceTestSuite struct {
 	roundRobinBalancer *RoundRobinBalancer
 }
 
+func (suite *BalanceTestSuite) SetupSuite() {
+	paramtable.Init()
+}
+
 func (suite *BalanceTestSuite) SetupTest() {
 	nodeManager := session.NewNodeManager()
 	suite.mockScheduler = task.NewMockScheduler(suite.T())
@@ -159,6 +164,27 @@ func (suite *BalanceTestSuite) TestAssignChannel() {
 	}
 }
 
+func (suite *BalanceTestSuite) TestGetNodesExcludeGrayFailing() {
+	nodeManager := suite.roundRobinBalancer.nodeManager
+	for _, id := range []int64{1, 2} {
+		nodeManager.Add(session.NewNodeInfo(id, "127.0.0.1:0"))
+	}
+	for i := 0; i < 10; i++ {
+		nodeManager.Get(2).RecordRPCResult(false)
+		nodeManager.Get(2).RecordTaskResult(false)
+	}
+	suite.True(nodeManager.Get(2).IsGrayFailing())
+
+	nodes := suite.roundRobinBalancer.getNodes([]int64{1, 2})
+	suite.Len(nodes, 1)
+	suite.EqualValues(1, nodes[0].ID())
+
+	// fallback to gray failing nodes if no healthy one
+	nodes = suite.roundRobinBalancer.getNodes([]int64{2})
+	suite.Len(nodes, 1)
+	suite.EqualValues(2, nodes[0].ID())
+}
+
 func TestBalanceSuite(t *testing.T) {
 	suite.Run(t, new(BalanceTestSuite))
 }
@@ -0,0 +1,114 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package balance
+
+import (
+	"github.com/milvus-io/milvus/internal/querycoordv2/params"
+)
+
+// NodeScore is the score breakdown of a node in the view of the balancer,
+// new segments are assigned to the available node with the lowest score.
+type NodeScore struct {
+	NodeID               int64   `json:"node_id"`
+	Stopping             bool    `json:"stopping"`
+	GrayFailing          bool    `json:"gray_failing"`
+	SegmentCount         int     `json:"segment_count"`
+	SegmentDelta         int     `json:"segment_delta"`
+	ChannelCount         int     `json:"channel_count"`
+	ChannelDelta         int     `json:"channel_delta"`
+	CollectionRowCount   int64   `json:"collection_row_count"`
+	GlobalRowCount       int64   `json:"global_row_count"`
+	GlobalRowCountFactor float64 `json:"global_row_count_factor,omitempty"`
+	DelegatorScore       int64   `json:"delegator_score,omitempty"`
+	Score                int64   `json:"score"`
+}
+
+// ScoreExplainer is implemented by the balancers which could explain how the nodes are scored.
+type ScoreExplainer interface {
+	ExplainScores(collectionID int64, nodes []int64) []NodeScore
+}
+
+var (
+	_ ScoreExplainer = (*RoundRobinBalancer)(nil)
+	_ ScoreExplainer = (*RowCountBasedBalancer)(nil)
+	_ ScoreExplainer = (*ScoreBasedBalancer)(nil)
+)
+
+// ExplainScores scores the nodes by the segment count including the executing tasks
+func (b *RoundRobinBalancer) ExplainScores(collectionID int64, nodes []int64) []NodeScore {
+	ret := make([]NodeScore, 0, len(nodes))
+	for _, node := range nodes {
+		score := b.newNodeScore(node)
+		score.Score = int64(score.SegmentCount + score.SegmentDelta)
+		ret = append(ret, score)
+	}
+	return ret
+}
+
+func (b *RoundRobinBalancer) newNodeScore(nodeID int64) NodeScore {
+	score := NodeScore{
+		NodeID:       nodeID,
+		SegmentDelta: b.scheduler.GetNodeSegmentDelta(nodeID),
+		ChannelDelta: b.scheduler.GetNodeChannelDelta(nodeID),
+	}
+	if node := b.nodeManager.Get(nodeID); node != nil {
+		score.Stopping = node.IsStoppingState()
+		score.GrayFailing = node.IsGrayFailing()
+		score.SegmentCount = node.SegmentCnt()
+		score.ChannelCount = node.ChannelCnt()
+	}
+	return score
+}
+
+// ExplainScores scores the nodes by the row count of all the loaded segments
+func (b *RowCountBasedBalancer) ExplainScores(collectionID int64, nodes []int64) []NodeScore {
+	ret := make([]NodeScore, 0, len(nodes))
+	for _, node := range nodes {
+		score := b.newNodeScore(node)
+		score.CollectionRowCount, score.GlobalRowCount = b.countRows(collectionID, node)
+		score.Score = score.GlobalRowCount
+		ret = append(ret, score)
+	}
+	return ret
+}
+
+// countRows returns the row count of the collection and all the collections loaded on the node
+func (b *RowCountBasedBalancer) countRows(collectionID, nodeID int64) (int64, int64) {
+	var collectionRowCount, globalRowCount int64
+	for _, s := range b.dist.SegmentDistManager.GetByNode(nodeID) {
+		globalRowCount += s.GetNumOfRows()
+		if s.GetCollectionID() == collectionID {
+			collectionRowCount += s.GetNumOfRows()
+		}
+	}
+	return collectionRowCount, globalRowCount
+}
+
+// ExplainScores scores the nodes by the row count of the collection, plus the weighted row count of all the collections
+// and the cost of the shard leaders on the node
+func (b *ScoreBasedBalancer) ExplainScores(collectionID int64, nodes []int64) []NodeScore {
+	ret := make([]NodeScore, 0, len(nodes))
+	for _, node := range nodes {
+		score := b.newNodeScore(node)
+		score.CollectionRowCount, score.GlobalRowCount = b.countRows(collectionID, node)
+		score.GlobalRowCountFactor = params.Params.QueryCoordCfg.GlobalRowCountFactor.GetAsFloat()
+		score.DelegatorScore = int64(b.calculateDelegatorScore(collectionID, node))
+		score.Score = int64(b.calculatePriority(collectionID, node))
+		ret = append(ret, score)
+	}
+	return ret
+}
@@ -25,7 +25,6 @@ import (
 	"github.com/milvus-io/milvus/internal/querycoordv2/meta"
 	"github.com/milvus-io/milvus/internal/querycoordv2/session"
 	"github.com/milvus-io/milvus/internal/querycoordv2/task"
-	"github.com/milvus-io/milvus/pkg/log"
 )
 
 type RowCountBasedBalancer struct {
@@ -102,13 +101,13 @@ func (b *RowCountBasedBalancer) BalanceReplica(replica *meta.Replica) ([]Segment
 		})
 
 		if isStopping, err := b.nodeManager.IsStoppingNode(nid); err != nil {
-			log.Info("not existed node", zap.Int64("nid", nid), zap.Any("segments", segments), zap.Error(err))
+			logger.Info("not existed node", zap.Int64("nid", nid), zap.Any("segments", segments), zap.Error(err))
 			continue
 		} else if isStopping {
 			stoppingNodesSegments[nid] = segments
 		} else if outboundNodes.Contain(nid) {
 			// if node is stop or transfer to other rg
-			log.RatedInfo(10, "meet outbound node, try to move out all segment/channel",
+			logger.RatedInfo(10, "meet outbound node, try to move out all segment/channel",
 				zap.Int64("collectionID", replica.GetCollectionID()),
 				zap.Int64("replicaID", replica.GetCollectionID()),
 				zap.Int64("node", nid),
@@ -201,7 +200,7 @@ func (b *RowCountBasedBalancer) genSegmentPlan(replica *meta.Replica, nodesWithL
 }
 
 func (b *RowCountBasedBalancer) genChannelPlan(replica *meta.Replica, onlineNodes []int64, offlineNodes []int64) []ChannelAssignPlan {
-	log.Info("balance channel",
+	logger.Info("balance channel",
 		zap.Int64s("online nodes", onlineNodes),
 		zap.Int64s("offline nodes", offlineNodes))
 	channelPlans := make([]ChannelAssignPlan, 0)
@@ -27,7 +27,6 @@ import (
 	"github.com/milvus-io/milvus/internal/querycoordv2/params"
 	"github.com/milvus-io/milvus/internal/querycoordv2/session"
 	"github.com/milvus-io/milvus/internal/querycoordv2/task"
-	"github.com/milvus-io/milvus/pkg/log"
 	"github.com/milvus-io/milvus/pkg/util/typeutil"
 )
 
@@ -104,7 +103,28 @@ func (b *ScoreBasedBalancer) calculatePriority(collectionID, nodeID int64) int {
 		collectionRowCount += int(s.GetNumOfRows())
 	}
 	return collectionRowCount + int(float64(rowCount)*
-		params.Params.QueryCoordCfg.GlobalRowCountFactor.GetAsFloat())
+		params.Params.QueryCoordCfg.GlobalRowCountFactor.GetAsFloat()) +
+		b.calculateDelegatorScore(collectionID, nodeID)
+}
+
+// calculateDelegatorScore converts the resource cost of the delegators on the node to row count,
+// so that the nodes hosting heavy shard leaders receive fewer sealed segments.
+func (b *ScoreBasedBalancer) calculateDelegatorScore(collectionID, nodeID int64) int {
+	growingRowFactor := params.Params.QueryCoordCfg.DelegatorGrowingRowFactor.GetAsFloat()
+	deleteBufferRowFactor := params.Params.QueryCoordCfg.DelegatorDeleteBufferRowFactor.GetAsFloat()
+	forwardRateFactor := params.Params.QueryCoordCfg.DelegatorForwardRateFactor.GetAsFloat()
+
+	collectionScore, globalScore := 0.0, 0.0
+	for _, view := range b.dist.LeaderViewManager.GetLeaderView(nodeID) {
+		score := float64(view.NumOfGrowingRows)*growingRowFactor +
+			float64(view.NumOfDeleteBufferRows)*deleteBufferRowFactor +
+			view.DeleteForwardRate*forwardRateFactor
+		if view.CollectionID == collectionID {
+			collectionScore += score
+		}
+		globalScore += score
+	}
+	return int(collectionScore + globalScore*params.Params.QueryCoordCfg.GlobalRowCountFactor.GetAsFloat())
 }
 
 func (b *ScoreBasedBalancer) BalanceReplica(replica *meta.Replica) ([]SegmentAssignPlan, []ChannelAssignPlan) {
@@ -126,13 +146,13 @@ func (b *ScoreBasedBalancer) BalanceReplica(replica *meta.Replica) ([]SegmentAss
 		})
 
 		if isStopping, err := b.nodeManager.IsStoppingNode(nid); err != nil {
-			log.Info("not existed node", zap.Int64("nid", nid), zap.Any("segments", segments), zap.Error(err))
+			logger.Info("not existed node", zap.Int64("nid", nid), zap.Any("segments", segments), zap.Error(err))
 			continue
 		} else if isStopping {
 			stoppingNodesSegments[nid] = segments
 		} else if outboundNodes.Contain(nid) {
 			// if node is stop or transfer to other rg
-			log.RatedInfo(10, "meet outbound node, try to move out all segment/channel",
+			logger.RatedInfo(10, "meet outbound node, try to move out all segment/channel",
 				zap.Int64("collectionID", replica.GetCollectionID()),
 				zap.Int64("replicaID", replica.GetCollectionID()),
 				zap.Int64("node", nid),
@@ -145,7 +165,7 @@ func (b *ScoreBasedBalancer) BalanceReplica(replica *meta.Replica) ([]SegmentAss
 
 	if len(nodes) == len(stoppingNodesSegments) {
 		// no available nodes to balance
-		log.Warn("All nodes is under stopping mode or outbound, skip balance replica",
+		logger.Warn("All nodes is under stopping mode or outbound, skip balance replica",
 			zap.Int64("collection", replica.CollectionID),
 			zap.Int64("replica id", replica.Replica.GetID()),
 			zap.String("replica group", replica.Replica.GetResourceGroup()),
@@ -155,7 +175,7 @@ func (b *ScoreBasedBalancer) BalanceReplica(replica *meta.Replica) ([]SegmentAss
 	}
 
 	if len(nodesSegments) <= 0 {
-		log.Warn("No nodes is available in resource group, skip balance replica",
+		logger.Warn("No nodes is available in resource group, skip balance replica",
 			zap.Int64("collection", replica.CollectionID),
 			zap.Int64("replica id", replica.Replica.GetID()),
 			zap.String("replica group", replica.Replica.GetResourceGroup()),
@@ -166,7 +186,7 @@ func (b *ScoreBasedBalancer) BalanceReplica(replica *meta.Replica) ([]SegmentAss
 	// print current distribution before generating plans
 	segmentPlans, channelPlans := make([]SegmentAssignPlan, 0), make([]ChannelAssignPlan, 0)
 	if len(stoppingNodesSegments) != 0 {
-		log.Info("Handle stopping nodes",
+		logger.Info("Handle stopping nodes",
 			zap.Int64("collection", replica.CollectionID),
 			zap.Int64("replica id", replica.Replica.GetID()),
 			zap.String("replica group", replica.Replica.GetResourceGroup()),
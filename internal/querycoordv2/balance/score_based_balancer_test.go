@@ -607,3 +607,92 @@ func (suite *ScoreBasedBalancerTestSuite) getCollectionBalancePlans(balancer *Sc
 	}
 	return segmentPlans, channelPlans
 }
+
+func (suite *ScoreBasedBalancerTestSuite) TestDelegatorScore() {
+	balancer := suite.balancer
+	for _, nodeID := range []int64{1, 2} {
+		nodeInfo := session.NewNodeInfo(nodeID, "localhost")
+		nodeInfo.SetState(session.NodeStateNormal)
+		balancer.nodeManager.Add(nodeInfo)
+	}
+	balancer.dist.SegmentDistManager.Update(2,
+		&meta.Segment{SegmentInfo: &datapb.SegmentInfo{ID: 1, CollectionID: 1, NumOfRows: 100}, Node: 2},
+	)
+	// the shard leader on node 1 is heavier than the sealed segment on node 2
+	balancer.dist.LeaderViewManager.Update(1,
+		&meta.LeaderView{ID: 1, CollectionID: 1, Channel: "v1", NumOfGrowingRows: 100, NumOfDeleteBufferRows: 10, DeleteForwardRate: 1},
+		&meta.LeaderView{ID: 1, CollectionID: 2, Channel: "v2", NumOfGrowingRows: 100},
+	)
+	suite.mockScheduler.EXPECT().GetNodeSegmentDelta(mock.Anything).Return(0)
+	suite.mockScheduler.EXPECT().GetNodeChannelDelta(mock.Anything).Return(0)
+
+	paramtable.Get().Save(Params.QueryCoordCfg.GlobalRowCountFactor.Key, "0.5")
+	defer paramtable.Get().Reset(Params.QueryCoordCfg.GlobalRowCountFactor.Key)
+
+	// 120 of collection 1, plus (120 + 100) * 0.5 of all the collections
+	scores := balancer.ExplainScores(1, []int64{1, 2})
+	suite.Equal(int64(230), scores[0].DelegatorScore)
+	suite.Equal(int64(230), scores[0].Score)
+	suite.Equal(int64(0), scores[1].DelegatorScore)
+	suite.Equal(int64(150), scores[1].Score)
+
+	plans := balancer.AssignSegment(1, []*meta.Segment{
+		{SegmentInfo: &datapb.SegmentInfo{ID: 2, CollectionID: 1, NumOfRows: 10}},
+	}, []int64{1, 2})
+	suite.Len(plans, 1)
+	suite.Equal(int64(2), plans[0].To)
+
+	// the cost of shard leaders is ignored if all the factors are zero
+	for _, key := range []string{
+		Params.QueryCoordCfg.DelegatorGrowingRowFactor.Key,
+		Params.QueryCoordCfg.DelegatorDeleteBufferRowFactor.Key,
+		Params.QueryCoordCfg.DelegatorForwardRateFactor.Key,
+	} {
+		paramtable.Get().Save(key, "0")
+		defer paramtable.Get().Reset(key)
+	}
+	plans = balancer.AssignSegment(1, []*meta.Segment{
+		{SegmentInfo: &datapb.SegmentInfo{ID: 2, CollectionID: 1, NumOfRows: 10}},
+	}, []int64{1, 2})
+	suite.Len(plans, 1)
+	suite.Equal(int64(1), plans[0].To)
+}
+
+func (suite *ScoreBasedBalancerTestSuite) TestExplainScores() {
+	balancer := suite.balancer
+	balancer.dist.SegmentDistManager.Update(1,
+		&meta.Segment{SegmentInfo: &datapb.SegmentInfo{ID: 1, CollectionID: 1, NumOfRows: 10}, Node: 1},
+		&meta.Segment{SegmentInfo: &datapb.SegmentInfo{ID: 2, CollectionID: 2, NumOfRows: 20}, Node: 1},
+	)
+	balancer.dist.SegmentDistManager.Update(2,
+		&meta.Segment{SegmentInfo: &datapb.SegmentInfo{ID: 3, CollectionID: 1, NumOfRows: 5}, Node: 2},
+	)
+	for _, nodeID := range []int64{1, 2} {
+		nodeInfo := session.NewNodeInfo(nodeID, "localhost")
+		nodeInfo.SetState(session.NodeStateNormal)
+		balancer.nodeManager.Add(nodeInfo)
+	}
+	balancer.nodeManager.Stopping(2)
+	suite.mockScheduler.EXPECT().GetNodeSegmentDelta(mock.Anything).Return(0)
+	suite.mockScheduler.EXPECT().GetNodeChannelDelta(mock.Anything).Return(0)
+
+	paramtable.Get().Save(Params.QueryCoordCfg.GlobalRowCountFactor.Key, "0.5")
+	defer paramtable.Get().Reset(Params.QueryCoordCfg.GlobalRowCountFactor.Key)
+
+	scores := balancer.ExplainScores(1, []int64{1, 2})
+	suite.Len(scores, 2)
+	suite.Equal(int64(1), scores[0].NodeID)
+	suite.Equal(int64(10), scores[0].CollectionRowCount)
+	suite.Equal(int64(30), scores[0].GlobalRowCount)
+	suite.Equal(0.5, scores[0].GlobalRowCountFactor)
+	suite.Equal(int64(25), scores[0].Score)
+	suite.False(scores[0].Stopping)
+
+	suite.Equal(int64(5), scores[1].CollectionRowCount)
+	suite.Equal(int64(7), scores[1].Score)
+	suite.True(scores[1].Stopping)
+
+	scores = balancer.RowCountBasedBalancer.ExplainScores(1, []int64{1, 2})
+	suite.Equal(int64(30), scores[0].Score)
+	suite.Equal(int64(5), scores[1].Score)
+}
@@ -26,7 +26,6 @@ import (
 	"github.com/milvus-io/milvus/internal/proto/querypb"
 	"github.com/milvus-io/milvus/internal/querycoordv2/meta"
 	"github.com/milvus-io/milvus/internal/querycoordv2/task"
-	"github.com/milvus-io/milvus/pkg/log"
 )
 
 const (
@@ -55,7 +54,7 @@ func CreateSegmentTasksFromPlans(ctx context.Context, source task.Source, timeou
 			actions...,
 		)
 		if err != nil {
-			log.Warn("create segment task from plan failed",
+			logger.Warn("create segment task from plan failed",
 				zap.Int64("collection", p.Segment.GetCollectionID()),
 				zap.Int64("segmentID", p.Segment.GetID()),
 				zap.Int64("replica", p.ReplicaID),
@@ -67,7 +66,7 @@ func CreateSegmentTasksFromPlans(ctx context.Context, source task.Source, timeou
 			continue
 		}
 
-		log.Info("create segment task",
+		logger.Info("create segment task",
 			zap.Int64("collection", p.Segment.GetCollectionID()),
 			zap.Int64("segmentID", p.Segment.GetID()),
 			zap.Int64("replica", p.ReplicaID),
@@ -100,7 +99,7 @@ func CreateChannelTasksFromPlans(ctx context.Context, source task.Source, timeou
 		}
 		t, err := task.NewChannelTask(ctx, timeout, source, p.Channel.GetCollectionID(), p.ReplicaID, actions...)
 		if err != nil {
-			log.Warn("create channel task failed",
+			logger.Warn("create channel task failed",
 				zap.Int64("collection", p.Channel.GetCollectionID()),
 				zap.Int64("replica", p.ReplicaID),
 				zap.String("channel", p.Channel.GetChannelName()),
@@ -111,7 +110,7 @@ func CreateChannelTasksFromPlans(ctx context.Context, source task.Source, timeou
 			continue
 		}
 
-		log.Info("create channel task",
+		logger.Info("create channel task",
 			zap.Int64("collection", p.Channel.GetCollectionID()),
 			zap.Int64("replica", p.ReplicaID),
 			zap.String("channel", p.Channel.GetChannelName()),
@@ -134,7 +133,7 @@ func PrintNewBalancePlans(collectionID int64, replicaID int64, segmentPlans []Se
 		balanceInfo += channelPlan.ToString()
 	}
 	balanceInfo += "}"
-	log.Info(balanceInfo)
+	logger.Info(balanceInfo)
 }
 
 func PrintCurrentReplicaDist(replica *meta.Replica,
@@ -199,5 +198,5 @@ func PrintCurrentReplicaDist(replica *meta.Replica,
 	}
 	distInfo += "]"
 
-	log.Info(distInfo)
+	logger.Info(distInfo)
 }
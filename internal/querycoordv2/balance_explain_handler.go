@@ -0,0 +1,188 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package querycoordv2
+
+import (
+	"net/http"
+	"sort"
+	"strconv"
+
+	"github.com/samber/lo"
+
+	management "github.com/milvus-io/milvus/internal/http"
+	"github.com/milvus-io/milvus/internal/querycoordv2/balance"
+	"github.com/milvus-io/milvus/internal/querycoordv2/meta"
+)
+
+var balanceExplainComponent = management.NewComponent[*balanceExplainContext]("querycoord")
+
+type balanceExplainContext struct {
+	meta     *meta.Meta
+	dist     *meta.DistributionManager
+	balancer balance.Balance
+}
+
+type explainedSegment struct {
+	SegmentID   int64  `json:"segment_id"`
+	PartitionID int64  `json:"partition_id"`
+	Channel     string `json:"channel"`
+	NumOfRows   int64  `json:"num_of_rows"`
+}
+
+type explainedNode struct {
+	NodeID   int64              `json:"node_id"`
+	Score    *balance.NodeScore `json:"score,omitempty"`
+	Channels []string           `json:"channels"`
+	Segments []explainedSegment `json:"segments"`
+}
+
+type explainedSegmentMove struct {
+	SegmentID int64 `json:"segment_id"`
+	NumOfRows int64 `json:"num_of_rows"`
+	From      int64 `json:"from"`
+	To        int64 `json:"to"`
+}
+
+type explainedChannelMove struct {
+	Channel string `json:"channel"`
+	From    int64  `json:"from"`
+	To      int64  `json:"to"`
+}
+
+type explainedReplica struct {
+	ReplicaID     int64                  `json:"replica_id"`
+	ResourceGroup string                 `json:"resource_group"`
+	Nodes         []explainedNode        `json:"nodes"`
+	SegmentMoves  []explainedSegmentMove `json:"proposed_segment_moves"`
+	ChannelMoves  []explainedChannelMove `json:"proposed_channel_moves"`
+}
+
+type balanceExplanation struct {
+	CollectionID int64              `json:"collection_id"`
+	Replicas     []explainedReplica `json:"replicas"`
+}
+
+// registerBalanceExplainHandler exposes the explanation of segment distribution through the management http server,
+// the handler is registered only once and serves the latest started querycoord.
+func registerBalanceExplainHandler(m *meta.Meta, dist *meta.DistributionManager, balancer balance.Balance) {
+	balanceExplainComponent.Serve(&balanceExplainContext{
+		meta:     m,
+		dist:     dist,
+		balancer: balancer,
+	}, &management.Handler{
+		Path:        management.QueryCoordBalanceExplainRouterPath,
+		HandlerFunc: balanceExplainHandler,
+	})
+}
+
+// balanceExplainHandler explains the segment distribution of each replica of the collection per node,
+// with the score breakdown of the nodes and the moves the balancer would propose next.
+// Proposing moves doesn't submit any balance task.
+//
+//	GET /querycoord/balance/explain?collection_id=445566778899
+func balanceExplainHandler(w http.ResponseWriter, req *http.Request) {
+	if req.Method != http.MethodGet {
+		management.WriteJSON(w, http.StatusMethodNotAllowed, map[string]string{"error": "method not allowed"})
+		return
+	}
+	explainer, ok := balanceExplainComponent.Get(w)
+	if !ok {
+		return
+	}
+
+	collectionID, err := strconv.ParseInt(req.URL.Query().Get("collection_id"), 10, 64)
+	if err != nil {
+		management.WriteJSON(w, http.StatusBadRequest, map[string]string{"error": "invalid collection id: " + err.Error()})
+		return
+	}
+	if explainer.meta.CollectionManager.GetCollection(collectionID) == nil {
+		management.WriteJSON(w, http.StatusNotFound, map[string]string{"error": "collection not loaded: " + strconv.FormatInt(collectionID, 10)})
+		return
+	}
+	management.WriteJSON(w, http.StatusOK, explainer.explain(collectionID))
+}
+
+func (e *balanceExplainContext) explain(collectionID int64) *balanceExplanation {
+	replicas := e.meta.ReplicaManager.GetByCollection(collectionID)
+	sort.Slice(replicas, func(i, j int) bool { return replicas[i].GetID() < replicas[j].GetID() })
+
+	ret := &balanceExplanation{
+		CollectionID: collectionID,
+		Replicas:     make([]explainedReplica, 0, len(replicas)),
+	}
+	for _, replica := range replicas {
+		nodes := replica.GetNodes()
+		sort.Slice(nodes, func(i, j int) bool { return nodes[i] < nodes[j] })
+
+		scores := make(map[int64]balance.NodeScore)
+		if explainer, ok := e.balancer.(balance.ScoreExplainer); ok {
+			for _, score := range explainer.ExplainScores(collectionID, nodes) {
+				scores[score.NodeID] = score
+			}
+		}
+
+		explained := explainedReplica{
+			ReplicaID:     replica.GetID(),
+			ResourceGroup: replica.GetResourceGroup(),
+			Nodes:         make([]explainedNode, 0, len(nodes)),
+			SegmentMoves:  make([]explainedSegmentMove, 0),
+			ChannelMoves:  make([]explainedChannelMove, 0),
+		}
+		for _, node := range nodes {
+			segments := e.dist.SegmentDistManager.GetByCollectionAndNode(collectionID, node)
+			sort.Slice(segments, func(i, j int) bool { return segments[i].GetID() < segments[j].GetID() })
+			channels := e.dist.ChannelDistManager.GetByCollectionAndNode(collectionID, node)
+			explainedNode := explainedNode{
+				NodeID: node,
+				Channels: lo.Map(channels, func(channel *meta.DmChannel, _ int) string {
+					return channel.GetChannelName()
+				}),
+				Segments: lo.Map(segments, func(segment *meta.Segment, _ int) explainedSegment {
+					return explainedSegment{
+						SegmentID:   segment.GetID(),
+						PartitionID: segment.GetPartitionID(),
+						Channel:     segment.GetInsertChannel(),
+						NumOfRows:   segment.GetNumOfRows(),
+					}
+				}),
+			}
+			if score, ok := scores[node]; ok {
+				explainedNode.Score = &score
+			}
+			explained.Nodes = append(explained.Nodes, explainedNode)
+		}
+
+		segmentPlans, channelPlans := e.balancer.BalanceReplica(replica)
+		for _, plan := range segmentPlans {
+			explained.SegmentMoves = append(explained.SegmentMoves, explainedSegmentMove{
+				SegmentID: plan.Segment.GetID(),
+				NumOfRows: plan.Segment.GetNumOfRows(),
+				From:      plan.From,
+				To:        plan.To,
+			})
+		}
+		for _, plan := range channelPlans {
+			explained.ChannelMoves = append(explained.ChannelMoves, explainedChannelMove{
+				Channel: plan.Channel.GetChannelName(),
+				From:    plan.From,
+				To:      plan.To,
+			})
+		}
+		ret.Replicas = append(ret.Replicas, explained)
+	}
+	return ret
+}
@@ -0,0 +1,108 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package querycoordv2
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	management "github.com/milvus-io/milvus/internal/http"
+	"github.com/milvus-io/milvus/internal/metastore/mocks"
+	"github.com/milvus-io/milvus/internal/proto/datapb"
+	"github.com/milvus-io/milvus/internal/proto/querypb"
+	"github.com/milvus-io/milvus/internal/querycoordv2/balance"
+	"github.com/milvus-io/milvus/internal/querycoordv2/meta"
+	"github.com/milvus-io/milvus/internal/querycoordv2/params"
+	"github.com/milvus-io/milvus/internal/querycoordv2/session"
+	"github.com/milvus-io/milvus/pkg/util/typeutil"
+)
+
+func Test_balanceExplainHandler(t *testing.T) {
+	// the handler serves a standalone component, the one of the querycoord started by other tests is restored after
+	defer func(c *management.Component[*balanceExplainContext]) { balanceExplainComponent = c }(balanceExplainComponent)
+	balanceExplainComponent = management.NewComponent[*balanceExplainContext]("querycoord")
+
+	t.Run("not started", func(t *testing.T) {
+		w := httptest.NewRecorder()
+		balanceExplainHandler(w, httptest.NewRequest(http.MethodGet, "/querycoord/balance/explain?collection_id=1", nil))
+		assert.Equal(t, http.StatusServiceUnavailable, w.Code)
+	})
+
+	catalog := mocks.NewQueryCoordCatalog(t)
+	catalog.EXPECT().SaveCollection(mock.Anything).Return(nil).Maybe()
+	catalog.EXPECT().SaveReplica(mock.Anything).Return(nil).Maybe()
+	testMeta := meta.NewMeta(params.RandomIncrementIDAllocator(), catalog, session.NewNodeManager())
+	require.NoError(t, testMeta.CollectionManager.PutCollection(&meta.Collection{
+		CollectionLoadInfo: &querypb.CollectionLoadInfo{CollectionID: 1, ReplicaNumber: 1},
+	}))
+	replica := meta.NewReplica(&querypb.Replica{ID: 10, CollectionID: 1, Nodes: []int64{1, 2}}, typeutil.NewUniqueSet(1, 2))
+	require.NoError(t, testMeta.ReplicaManager.Put(replica))
+
+	dist := meta.NewDistributionManager()
+	segment := &meta.Segment{SegmentInfo: &datapb.SegmentInfo{ID: 100, CollectionID: 1, PartitionID: 2, InsertChannel: "ch-1", NumOfRows: 10}, Node: 1}
+	dist.SegmentDistManager.Update(1, segment)
+	dist.ChannelDistManager.Update(1, &meta.DmChannel{VchannelInfo: &datapb.VchannelInfo{CollectionID: 1, ChannelName: "ch-1"}, Node: 1})
+
+	balancer := balance.NewMockBalancer(t)
+	balancer.EXPECT().BalanceReplica(mock.Anything).Return([]balance.SegmentAssignPlan{
+		{Segment: segment, ReplicaID: 10, From: 1, To: 2},
+	}, nil).Maybe()
+	balanceExplainComponent.Serve(&balanceExplainContext{meta: testMeta, dist: dist, balancer: balancer})
+
+	t.Run("method not allowed", func(t *testing.T) {
+		w := httptest.NewRecorder()
+		balanceExplainHandler(w, httptest.NewRequest(http.MethodPost, "/querycoord/balance/explain?collection_id=1", nil))
+		assert.Equal(t, http.StatusMethodNotAllowed, w.Code)
+	})
+
+	t.Run("invalid collection", func(t *testing.T) {
+		w := httptest.NewRecorder()
+		balanceExplainHandler(w, httptest.NewRequest(http.MethodGet, "/querycoord/balance/explain?collection_id=a", nil))
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+
+		w = httptest.NewRecorder()
+		balanceExplainHandler(w, httptest.NewRequest(http.MethodGet, "/querycoord/balance/explain?collection_id=2", nil))
+		assert.Equal(t, http.StatusNotFound, w.Code)
+	})
+
+	t.Run("explain", func(t *testing.T) {
+		w := httptest.NewRecorder()
+		balanceExplainHandler(w, httptest.NewRequest(http.MethodGet, "/querycoord/balance/explain?collection_id=1", nil))
+		require.Equal(t, http.StatusOK, w.Code)
+
+		result := &balanceExplanation{}
+		require.NoError(t, json.Unmarshal(w.Body.Bytes(), result))
+		require.Len(t, result.Replicas, 1)
+		explained := result.Replicas[0]
+		assert.EqualValues(t, 10, explained.ReplicaID)
+		require.Len(t, explained.Nodes, 2)
+		assert.EqualValues(t, 1, explained.Nodes[0].NodeID)
+		assert.Equal(t, []string{"ch-1"}, explained.Nodes[0].Channels)
+		assert.Equal(t, []explainedSegment{{SegmentID: 100, PartitionID: 2, Channel: "ch-1", NumOfRows: 10}}, explained.Nodes[0].Segments)
+		// mock balancer doesn't explain scores
+		assert.Nil(t, explained.Nodes[0].Score)
+		assert.Empty(t, explained.Nodes[1].Segments)
+		assert.Equal(t, []explainedSegmentMove{{SegmentID: 100, NumOfRows: 10, From: 1, To: 2}}, explained.SegmentMoves)
+		assert.Empty(t, explained.ChannelMoves)
+	})
+}
@@ -30,7 +30,6 @@ import (
 	. "github.com/milvus-io/milvus/internal/querycoordv2/params"
 	"github.com/milvus-io/milvus/internal/querycoordv2/session"
 	"github.com/milvus-io/milvus/internal/querycoordv2/task"
-	"github.com/milvus-io/milvus/pkg/log"
 	"github.com/milvus-io/milvus/pkg/util/typeutil"
 )
 
@@ -79,8 +78,7 @@ func (b *BalanceChecker) replicasToBalance() []int64 {
 		replicas := b.meta.ReplicaManager.GetByCollection(cid)
 		for _, replica := range replicas {
 			for _, nodeID := range replica.GetNodes() {
-				isStopping, _ := b.nodeManager.IsStoppingNode(nodeID)
-				if isStopping {
+				if b.isStoppingNode(nodeID) {
 					stoppingReplicas = append(stoppingReplicas, replica.GetID())
 					break
 				}
@@ -106,7 +104,7 @@ func (b *BalanceChecker) replicasToBalance() []int64 {
 	hasUnbalancedCollection := false
 	for _, cid := range loadedCollections {
 		if b.normalBalanceCollectionsCurrentRound.Contain(cid) {
-			log.Debug("ScoreBasedBalancer has balanced collection, skip balancing in this round",
+			logger.Debug("ScoreBasedBalancer has balanced collection, skip balancing in this round",
 				zap.Int64("collectionID", cid))
 			continue
 		}
@@ -120,7 +118,7 @@ func (b *BalanceChecker) replicasToBalance() []int64 {
 
 	if !hasUnbalancedCollection {
 		b.normalBalanceCollectionsCurrentRound.Clear()
-		log.RatedDebug(10, "ScoreBasedBalancer has balanced all "+
+		logger.RatedDebug(10, "ScoreBasedBalancer has balanced all "+
 			"collections in one round, clear collectionIDs for this round")
 	}
 	return normalReplicasToBalance
@@ -149,13 +147,32 @@ func (b *BalanceChecker) Check(ctx context.Context) []task.Task {
 	replicasToBalance := b.replicasToBalance()
 	segmentPlans, channelPlans := b.balanceReplicas(replicasToBalance)
 
-	tasks := balance.CreateSegmentTasksFromPlans(ctx, b.ID(), Params.QueryCoordCfg.SegmentTaskTimeout.GetAsDuration(time.Millisecond), segmentPlans)
-	task.SetPriority(task.TaskPriorityLow, tasks...)
-	task.SetReason("segment unbalanced", tasks...)
+	// move the shard leaders out of the stopping nodes first, the channel tasks are of high priority
+	tasks := balance.CreateChannelTasksFromPlans(ctx, b.ID(), Params.QueryCoordCfg.ChannelTaskTimeout.GetAsDuration(time.Millisecond), channelPlans)
+	task.SetReason("channel unbalanced", tasks...)
 	ret = append(ret, tasks...)
 
-	tasks = balance.CreateChannelTasksFromPlans(ctx, b.ID(), Params.QueryCoordCfg.ChannelTaskTimeout.GetAsDuration(time.Millisecond), channelPlans)
-	task.SetReason("channel unbalanced", tasks...)
+	// the segments on stopping nodes are moved out prior to the normal balance
+	stoppingPlans := lo.Filter(segmentPlans, func(plan balance.SegmentAssignPlan, _ int) bool {
+		return b.isStoppingNode(plan.From)
+	})
+	normalPlans := lo.Filter(segmentPlans, func(plan balance.SegmentAssignPlan, _ int) bool {
+		return !b.isStoppingNode(plan.From)
+	})
+
+	tasks = balance.CreateSegmentTasksFromPlans(ctx, b.ID(), Params.QueryCoordCfg.SegmentTaskTimeout.GetAsDuration(time.Millisecond), stoppingPlans)
+	task.SetPriority(task.TaskPriorityNormal, tasks...)
+	task.SetReason("segment on stopping node", tasks...)
+	ret = append(ret, tasks...)
+
+	tasks = balance.CreateSegmentTasksFromPlans(ctx, b.ID(), Params.QueryCoordCfg.SegmentTaskTimeout.GetAsDuration(time.Millisecond), normalPlans)
+	task.SetPriority(task.TaskPriorityLow, tasks...)
+	task.SetReason("segment unbalanced", tasks...)
 	ret = append(ret, tasks...)
 	return ret
 }
+
+func (b *BalanceChecker) isStoppingNode(nodeID int64) bool {
+	isStopping, _ := b.nodeManager.IsStoppingNode(nodeID)
+	return isStopping
+}
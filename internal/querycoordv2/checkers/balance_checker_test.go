@@ -204,6 +204,10 @@ func (suite *BalanceCheckerTestSuite) TestStoppingBalance() {
 	suite.balancer.EXPECT().BalanceReplica(mock.Anything).Return(segPlans, chanPlans)
 	tasks := suite.checker.Check(context.TODO())
 	suite.Len(tasks, 2)
+	for _, t := range tasks {
+		// segments on stopping node are moved out prior to the normal balance
+		suite.Equal(task.TaskPriorityNormal, t.Priority())
+	}
 }
 
 func TestBalanceCheckerSuite(t *testing.T) {
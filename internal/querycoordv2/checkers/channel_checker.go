@@ -28,7 +28,6 @@ import (
 	. "github.com/milvus-io/milvus/internal/querycoordv2/params"
 	"github.com/milvus-io/milvus/internal/querycoordv2/task"
 	"github.com/milvus-io/milvus/internal/querycoordv2/utils"
-	"github.com/milvus-io/milvus/pkg/log"
 	"github.com/milvus-io/milvus/pkg/util/typeutil"
 )
 
@@ -117,7 +116,7 @@ func (c *ChannelChecker) getDmChannelDiff(collectionID int64,
 ) (toLoad, toRelease []*meta.DmChannel) {
 	replica := c.meta.Get(replicaID)
 	if replica == nil {
-		log.Info("replica does not exist, skip it")
+		logger.Info("replica does not exist, skip it")
 		return
 	}
 
@@ -163,7 +162,7 @@ func (c *ChannelChecker) findRepeatedChannels(replicaID int64) []*meta.DmChannel
 	ret := make([]*meta.DmChannel, 0)
 
 	if replica == nil {
-		log.Info("replica does not exist, skip it")
+		logger.Info("replica does not exist, skip it")
 		return ret
 	}
 	dist := c.getChannelDist(replica)
@@ -204,7 +203,7 @@ func (c *ChannelChecker) createChannelReduceTasks(ctx context.Context, channels
 		action := task.NewChannelAction(ch.Node, task.ActionTypeReduce, ch.GetChannelName())
 		task, err := task.NewChannelTask(ctx, Params.QueryCoordCfg.ChannelTaskTimeout.GetAsDuration(time.Millisecond), c.ID(), ch.GetCollectionID(), replicaID, action)
 		if err != nil {
-			log.Warn("create channel reduce task failed",
+			logger.Warn("create channel reduce task failed",
 				zap.Int64("collection", ch.GetCollectionID()),
 				zap.Int64("replica", replicaID),
 				zap.String("channel", ch.GetChannelName()),
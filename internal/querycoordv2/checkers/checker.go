@@ -20,8 +20,12 @@ import (
 	"context"
 
 	"github.com/milvus-io/milvus/internal/querycoordv2/task"
+	"github.com/milvus-io/milvus/pkg/log"
 )
 
+// logger is the module logger of checkers, its level could be altered at runtime by module name "querycoordv2.checkers".
+var logger = log.Module("querycoordv2.checkers")
+
 type Checker interface {
 	ID() task.Source
 	Description() string
@@ -0,0 +1,307 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package checkers
+
+import (
+	"context"
+	"fmt"
+	"math/rand"
+	"sort"
+	"time"
+
+	"go.uber.org/zap"
+
+	"github.com/milvus-io/milvus/internal/proto/querypb"
+	"github.com/milvus-io/milvus/internal/querycoordv2/meta"
+	"github.com/milvus-io/milvus/internal/querycoordv2/params"
+	"github.com/milvus-io/milvus/internal/querycoordv2/session"
+	"github.com/milvus-io/milvus/internal/querycoordv2/task"
+	"github.com/milvus-io/milvus/pkg/eventlog"
+	"github.com/milvus-io/milvus/pkg/metrics"
+	"github.com/milvus-io/milvus/pkg/util/commonpbutil"
+	"github.com/milvus-io/milvus/pkg/util/merr"
+	"github.com/milvus-io/milvus/pkg/util/tsoutil"
+)
+
+var _ Checker = (*ConsistencyChecker)(nil)
+
+// replicaSegment is a sampled segment loaded by a replica.
+type replicaSegment struct {
+	replica *meta.Replica
+	segment *meta.Segment
+	numRows int64
+}
+
+// segmentDivergence is a sampled segment divergent across replicas, the lagging replicas are behind the others.
+type segmentDivergence struct {
+	segmentID     int64
+	divergentType string
+	lagging       []*replicaSegment
+}
+
+// ConsistencyChecker samples the segments of the collections loaded with multiple replicas,
+// and compares the row count and the last delete timestamp of them across replicas.
+type ConsistencyChecker struct {
+	meta      *meta.Meta
+	dist      *meta.DistributionManager
+	targetMgr *meta.TargetManager
+	nodeMgr   *session.NodeManager
+	cluster   session.Cluster
+}
+
+func NewConsistencyChecker(
+	meta *meta.Meta,
+	dist *meta.DistributionManager,
+	targetMgr *meta.TargetManager,
+	nodeMgr *session.NodeManager,
+	cluster session.Cluster,
+) *ConsistencyChecker {
+	return &ConsistencyChecker{
+		meta:      meta,
+		dist:      dist,
+		targetMgr: targetMgr,
+		nodeMgr:   nodeMgr,
+		cluster:   cluster,
+	}
+}
+
+func (c *ConsistencyChecker) ID() task.Source {
+	return consistencyChecker
+}
+
+func (c *ConsistencyChecker) Description() string {
+	return "ConsistencyChecker checks the divergence of segments across replicas and generates re-sync tasks for the lagging replica"
+}
+
+func (c *ConsistencyChecker) Check(ctx context.Context) []task.Task {
+	if !params.Params.QueryCoordCfg.ConsistencyCheckEnabled.GetAsBool() {
+		return nil
+	}
+
+	var tasks []task.Task
+	for _, collectionID := range c.meta.CollectionManager.GetAll() {
+		replicas := c.meta.ReplicaManager.GetByCollection(collectionID)
+		if len(replicas) < 2 {
+			continue
+		}
+		divergences := c.checkCollection(ctx, collectionID, replicas)
+
+		counts := map[string]int{
+			metrics.ReplicaDivergenceRowCountLabel: 0,
+			metrics.ReplicaDivergenceDeltaLagLabel: 0,
+		}
+		for _, divergence := range divergences {
+			counts[divergence.divergentType]++
+			c.report(collectionID, divergence)
+			if params.Params.QueryCoordCfg.ConsistencyCheckAutoResync.GetAsBool() {
+				tasks = append(tasks, c.createResyncTasks(ctx, divergence)...)
+			}
+		}
+		for divergentType, count := range counts {
+			metrics.QueryCoordDivergentSegmentNum.WithLabelValues(fmt.Sprint(collectionID), divergentType).Set(float64(count))
+		}
+	}
+	return tasks
+}
+
+// checkCollection samples the sealed segments in current target, and compares the segments loaded by the replicas.
+func (c *ConsistencyChecker) checkCollection(ctx context.Context, collectionID int64, replicas []*meta.Replica) []*segmentDivergence {
+	log := logger.With(zap.Int64("collectionID", collectionID))
+
+	segmentIDs := make([]int64, 0)
+	for segmentID := range c.targetMgr.GetSealedSegmentsByCollection(collectionID, meta.CurrentTarget) {
+		segmentIDs = append(segmentIDs, segmentID)
+	}
+	sampleSize := params.Params.QueryCoordCfg.ConsistencyCheckSampleSize.GetAsInt()
+	if len(segmentIDs) > sampleSize {
+		rand.Shuffle(len(segmentIDs), func(i, j int) {
+			segmentIDs[i], segmentIDs[j] = segmentIDs[j], segmentIDs[i]
+		})
+		segmentIDs = segmentIDs[:sampleSize]
+	}
+	sort.Slice(segmentIDs, func(i, j int) bool { return segmentIDs[i] < segmentIDs[j] })
+
+	// segmentID -> the copies loaded by replicas
+	loaded := make(map[int64][]*replicaSegment)
+	// nodeID -> the sampled segments loaded by the node
+	nodeSegments := make(map[int64][]int64)
+	for _, segmentID := range segmentIDs {
+		dist := c.dist.SegmentDistManager.Get(segmentID)
+		for _, replica := range replicas {
+			// the segment may be loaded by 2 nodes of the replica during balance, use the latest one
+			var latest *meta.Segment
+			for _, segment := range dist {
+				if replica.Contains(segment.Node) && (latest == nil || segment.Version > latest.Version) {
+					latest = segment
+				}
+			}
+			if latest == nil {
+				continue
+			}
+			loaded[segmentID] = append(loaded[segmentID], &replicaSegment{replica: replica, segment: latest, numRows: -1})
+			nodeSegments[latest.Node] = append(nodeSegments[latest.Node], segmentID)
+		}
+	}
+
+	// nodeID -> segmentID -> row count
+	rowCounts := make(map[int64]map[int64]int64)
+	for nodeID, segments := range nodeSegments {
+		resp, err := c.cluster.GetSegmentInfo(ctx, nodeID, &querypb.GetSegmentInfoRequest{
+			Base:         commonpbutil.NewMsgBase(commonpbutil.WithTargetID(nodeID)),
+			SegmentIDs:   segments,
+			CollectionID: collectionID,
+		})
+		if err := merr.CheckRPCCall(resp, err); err != nil {
+			log.Warn("failed to get segment info from QueryNode, skip it", zap.Int64("nodeID", nodeID), zap.Error(err))
+			continue
+		}
+		rowCounts[nodeID] = make(map[int64]int64, len(resp.GetInfos()))
+		for _, info := range resp.GetInfos() {
+			rowCounts[nodeID][info.GetSegmentID()] = info.GetNumRows()
+		}
+	}
+	for _, copies := range loaded {
+		for _, rs := range copies {
+			if numRows, ok := rowCounts[rs.segment.Node][rs.segment.GetID()]; ok {
+				rs.numRows = numRows
+			}
+		}
+	}
+
+	divergences := make([]*segmentDivergence, 0)
+	for _, segmentID := range segmentIDs {
+		if divergence := c.compare(segmentID, loaded[segmentID]); divergence != nil {
+			divergences = append(divergences, divergence)
+		}
+	}
+	return divergences
+}
+
+// compare finds the lagging replicas of the segment, the row count is compared first,
+// then the last delete timestamp, which lags behind if the replica misses delete application.
+func (c *ConsistencyChecker) compare(segmentID int64, copies []*replicaSegment) *segmentDivergence {
+	if len(copies) < 2 {
+		return nil
+	}
+
+	var maxRows int64 = -1
+	for _, rs := range copies {
+		if rs.numRows > maxRows {
+			maxRows = rs.numRows
+		}
+	}
+	var lagging []*replicaSegment
+	for _, rs := range copies {
+		// the unknown row count is not compared
+		if rs.numRows >= 0 && rs.numRows < maxRows {
+			lagging = append(lagging, rs)
+		}
+	}
+	if len(lagging) > 0 {
+		return &segmentDivergence{segmentID: segmentID, divergentType: metrics.ReplicaDivergenceRowCountLabel, lagging: lagging}
+	}
+
+	var maxTs uint64
+	for _, rs := range copies {
+		if rs.segment.LastDeltaTimestamp > maxTs {
+			maxTs = rs.segment.LastDeltaTimestamp
+		}
+	}
+	maxTime := tsoutil.PhysicalTime(maxTs)
+	tolerance := params.Params.QueryCoordCfg.ConsistencyCheckDeltaLagSecond.GetAsDuration(time.Second)
+	for _, rs := range copies {
+		if maxTime.Sub(tsoutil.PhysicalTime(rs.segment.LastDeltaTimestamp)) > tolerance {
+			lagging = append(lagging, rs)
+		}
+	}
+	if len(lagging) > 0 {
+		return &segmentDivergence{segmentID: segmentID, divergentType: metrics.ReplicaDivergenceDeltaLagLabel, lagging: lagging}
+	}
+	return nil
+}
+
+func (c *ConsistencyChecker) report(collectionID int64, divergence *segmentDivergence) {
+	for _, lagging := range divergence.lagging {
+		logger.Warn("segment divergent across replicas",
+			zap.Int64("collectionID", collectionID),
+			zap.Int64("segmentID", divergence.segmentID),
+			zap.String("divergentType", divergence.divergentType),
+			zap.Int64("replicaID", lagging.replica.GetID()),
+			zap.Int64("nodeID", lagging.segment.Node),
+			zap.Int64("numRows", lagging.numRows),
+			zap.Uint64("lastDeltaTimestamp", lagging.segment.LastDeltaTimestamp))
+		eventlog.Record(eventlog.NewRawEvt(eventlog.Level_Warn,
+			fmt.Sprintf("Segment %d of collection %d divergent across replicas, replica %d on node %d lags behind, type: %s",
+				divergence.segmentID, collectionID, lagging.replica.GetID(), lagging.segment.Node, divergence.divergentType)))
+	}
+}
+
+// createResyncTasks reloads the segment for the lagging replicas. The segment is moved to another node of the replica
+// to keep it serviceable during reloading, or released to be reloaded by segment checker if no other node available.
+func (c *ConsistencyChecker) createResyncTasks(ctx context.Context, divergence *segmentDivergence) []task.Task {
+	tasks := make([]task.Task, 0, len(divergence.lagging))
+	for _, lagging := range divergence.lagging {
+		segment := lagging.segment
+		actions := make([]task.Action, 0, 2)
+		if target := c.selectResyncNode(lagging); target != -1 {
+			actions = append(actions, task.NewSegmentActionWithScope(target, task.ActionTypeGrow, segment.GetInsertChannel(), segment.GetID(), querypb.DataScope_Historical))
+		}
+		actions = append(actions, task.NewSegmentActionWithScope(segment.Node, task.ActionTypeReduce, segment.GetInsertChannel(), segment.GetID(), querypb.DataScope_Historical))
+		t, err := task.NewSegmentTask(
+			ctx,
+			params.Params.QueryCoordCfg.SegmentTaskTimeout.GetAsDuration(time.Millisecond),
+			c.ID(),
+			segment.GetCollectionID(),
+			lagging.replica.GetID(),
+			actions...,
+		)
+		if err != nil {
+			logger.Warn("create segment resync task failed",
+				zap.Int64("collection", segment.GetCollectionID()),
+				zap.Int64("segmentID", segment.GetID()),
+				zap.Int64("replica", lagging.replica.GetID()),
+				zap.Int64("node", segment.Node),
+				zap.Error(err),
+			)
+			continue
+		}
+		t.SetPriority(task.TaskPriorityLow)
+		t.SetReason("replica divergent: " + divergence.divergentType)
+		metrics.QueryCoordReplicaResyncCount.WithLabelValues(fmt.Sprint(segment.GetCollectionID())).Inc()
+		tasks = append(tasks, t)
+	}
+	return tasks
+}
+
+// selectResyncNode returns the node of the replica with the fewest segments to reload the segment, -1 if none.
+func (c *ConsistencyChecker) selectResyncNode(lagging *replicaSegment) int64 {
+	target, minCnt := int64(-1), 0
+	for _, nodeID := range lagging.replica.GetNodes() {
+		if nodeID == lagging.segment.Node {
+			continue
+		}
+		node := c.nodeMgr.Get(nodeID)
+		if node == nil || node.IsStoppingState() {
+			continue
+		}
+		cnt := len(c.dist.SegmentDistManager.GetByNode(nodeID))
+		if target == -1 || cnt < minCnt {
+			target, minCnt = nodeID, cnt
+		}
+	}
+	return target
+}
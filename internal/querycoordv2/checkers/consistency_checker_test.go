@@ -0,0 +1,202 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package checkers
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/milvus-io/milvus/internal/kv"
+	etcdkv "github.com/milvus-io/milvus/internal/kv/etcd"
+	"github.com/milvus-io/milvus/internal/metastore/kv/querycoord"
+	"github.com/milvus-io/milvus/internal/proto/datapb"
+	"github.com/milvus-io/milvus/internal/proto/querypb"
+	"github.com/milvus-io/milvus/internal/querycoordv2/meta"
+	. "github.com/milvus-io/milvus/internal/querycoordv2/params"
+	"github.com/milvus-io/milvus/internal/querycoordv2/session"
+	"github.com/milvus-io/milvus/internal/querycoordv2/task"
+	"github.com/milvus-io/milvus/internal/querycoordv2/utils"
+	"github.com/milvus-io/milvus/pkg/util/etcd"
+	"github.com/milvus-io/milvus/pkg/util/merr"
+	"github.com/milvus-io/milvus/pkg/util/paramtable"
+	"github.com/milvus-io/milvus/pkg/util/tsoutil"
+)
+
+type ConsistencyCheckerTestSuite struct {
+	suite.Suite
+	kv      kv.MetaKv
+	checker *ConsistencyChecker
+	meta    *meta.Meta
+	broker  *meta.MockBroker
+	cluster *session.MockCluster
+	nodeMgr *session.NodeManager
+}
+
+func (suite *ConsistencyCheckerTestSuite) SetupSuite() {
+	paramtable.Init()
+	paramtable.Get().Save(Params.QueryCoordCfg.ConsistencyCheckEnabled.Key, "true")
+}
+
+func (suite *ConsistencyCheckerTestSuite) TearDownSuite() {
+	paramtable.Get().Reset(Params.QueryCoordCfg.ConsistencyCheckEnabled.Key)
+}
+
+func (suite *ConsistencyCheckerTestSuite) SetupTest() {
+	var err error
+	config := GenerateEtcdConfig()
+	cli, err := etcd.GetEtcdClient(
+		config.UseEmbedEtcd.GetAsBool(),
+		config.EtcdUseSSL.GetAsBool(),
+		config.Endpoints.GetAsStrings(),
+		config.EtcdTLSCert.GetValue(),
+		config.EtcdTLSKey.GetValue(),
+		config.EtcdTLSCACert.GetValue(),
+		config.EtcdTLSMinVersion.GetValue())
+	suite.Require().NoError(err)
+	suite.kv = etcdkv.NewEtcdKV(cli, config.MetaRootPath.GetValue())
+
+	// meta
+	store := querycoord.NewCatalog(suite.kv)
+	idAllocator := RandomIncrementIDAllocator()
+	suite.nodeMgr = session.NewNodeManager()
+	suite.meta = meta.NewMeta(idAllocator, store, suite.nodeMgr)
+	distManager := meta.NewDistributionManager()
+	suite.broker = meta.NewMockBroker(suite.T())
+	targetManager := meta.NewTargetManager(suite.broker, suite.meta)
+	suite.cluster = session.NewMockCluster(suite.T())
+
+	suite.checker = NewConsistencyChecker(suite.meta, distManager, targetManager, suite.nodeMgr, suite.cluster)
+
+	suite.broker.EXPECT().GetPartitions(mock.Anything, int64(1)).Return([]int64{1}, nil).Maybe()
+}
+
+func (suite *ConsistencyCheckerTestSuite) TearDownTest() {
+	suite.kv.Close()
+}
+
+// prepare loads the collection with 2 replicas, replica 1 on node 1 and 3, replica 2 on node 2, the segment 1 is loaded by node 1 and 2.
+func (suite *ConsistencyCheckerTestSuite) prepare(deltaTs1, deltaTs2 uint64) {
+	checker := suite.checker
+	checker.meta.CollectionManager.PutCollection(utils.CreateTestCollection(1, 2))
+	checker.meta.CollectionManager.PutPartition(utils.CreateTestPartition(1, 1))
+	checker.meta.ReplicaManager.Put(utils.CreateTestReplica(1, 1, []int64{1, 3}))
+	checker.meta.ReplicaManager.Put(utils.CreateTestReplica(2, 1, []int64{2}))
+	for _, node := range []int64{1, 2, 3} {
+		suite.nodeMgr.Add(session.NewNodeInfo(node, "localhost"))
+	}
+
+	segments := []*datapb.SegmentInfo{
+		{
+			ID:            1,
+			PartitionID:   1,
+			InsertChannel: "test-insert-channel",
+		},
+	}
+	channels := []*datapb.VchannelInfo{
+		{
+			CollectionID: 1,
+			ChannelName:  "test-insert-channel",
+		},
+	}
+	suite.broker.EXPECT().GetRecoveryInfoV2(mock.Anything, int64(1)).Return(channels, segments, nil)
+	checker.targetMgr.UpdateCollectionNextTarget(int64(1))
+	checker.targetMgr.UpdateCollectionCurrentTarget(int64(1))
+
+	segment1 := utils.CreateTestSegment(1, 1, 1, 1, 1, "test-insert-channel")
+	segment1.LastDeltaTimestamp = deltaTs1
+	segment2 := utils.CreateTestSegment(1, 1, 1, 2, 1, "test-insert-channel")
+	segment2.LastDeltaTimestamp = deltaTs2
+	checker.dist.SegmentDistManager.Update(1, segment1)
+	checker.dist.SegmentDistManager.Update(2, segment2)
+}
+
+func (suite *ConsistencyCheckerTestSuite) expectRows(node int64, rows int64) {
+	suite.cluster.EXPECT().GetSegmentInfo(mock.Anything, node, mock.Anything).Return(&querypb.GetSegmentInfoResponse{
+		Status: merr.Success(),
+		Infos:  []*querypb.SegmentInfo{{SegmentID: 1, NumRows: rows}},
+	}, nil)
+}
+
+func (suite *ConsistencyCheckerTestSuite) TestConsistent() {
+	ts := tsoutil.ComposeTSByTime(time.Now(), 0)
+	suite.prepare(ts, ts)
+	suite.expectRows(1, 100)
+	suite.expectRows(2, 100)
+
+	tasks := suite.checker.Check(context.Background())
+	suite.Len(tasks, 0)
+}
+
+func (suite *ConsistencyCheckerTestSuite) TestRowCountDivergent() {
+	paramtable.Get().Save(Params.QueryCoordCfg.ConsistencyCheckAutoResync.Key, "true")
+	defer paramtable.Get().Reset(Params.QueryCoordCfg.ConsistencyCheckAutoResync.Key)
+
+	suite.prepare(0, 0)
+	suite.expectRows(1, 90)
+	suite.expectRows(2, 100)
+
+	tasks := suite.checker.Check(context.Background())
+	suite.Require().Len(tasks, 1)
+	suite.EqualValues(1, tasks[0].ReplicaID())
+	suite.Equal(task.TaskPriorityLow, tasks[0].Priority())
+	// moved to node 3 of the same replica
+	actions := tasks[0].Actions()
+	suite.Require().Len(actions, 2)
+	suite.EqualValues(3, actions[0].Node())
+	suite.Equal(task.ActionTypeGrow, actions[0].Type())
+	suite.EqualValues(1, actions[1].Node())
+	suite.Equal(task.ActionTypeReduce, actions[1].Type())
+}
+
+func (suite *ConsistencyCheckerTestSuite) TestDeltaLag() {
+	now := time.Now()
+	suite.prepare(tsoutil.ComposeTSByTime(now, 0), tsoutil.ComposeTSByTime(now.Add(-time.Hour), 0))
+	suite.expectRows(1, 100)
+	suite.expectRows(2, 100)
+
+	// reported only without resync
+	tasks := suite.checker.Check(context.Background())
+	suite.Len(tasks, 0)
+
+	paramtable.Get().Save(Params.QueryCoordCfg.ConsistencyCheckAutoResync.Key, "true")
+	defer paramtable.Get().Reset(Params.QueryCoordCfg.ConsistencyCheckAutoResync.Key)
+	tasks = suite.checker.Check(context.Background())
+	suite.Require().Len(tasks, 1)
+	suite.EqualValues(2, tasks[0].ReplicaID())
+	// no other node in replica 2, released to be reloaded by segment checker
+	actions := tasks[0].Actions()
+	suite.Require().Len(actions, 1)
+	suite.EqualValues(2, actions[0].Node())
+	suite.Equal(task.ActionTypeReduce, actions[0].Type())
+}
+
+func (suite *ConsistencyCheckerTestSuite) TestDisabled() {
+	paramtable.Get().Save(Params.QueryCoordCfg.ConsistencyCheckEnabled.Key, "false")
+	defer paramtable.Get().Save(Params.QueryCoordCfg.ConsistencyCheckEnabled.Key, "true")
+
+	suite.prepare(0, 0)
+	tasks := suite.checker.Check(context.Background())
+	suite.Len(tasks, 0)
+}
+
+func TestConsistencyChecker(t *testing.T) {
+	suite.Run(t, new(ConsistencyCheckerTestSuite))
+}
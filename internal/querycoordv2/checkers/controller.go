@@ -28,14 +28,14 @@ import (
 	. "github.com/milvus-io/milvus/internal/querycoordv2/params"
 	"github.com/milvus-io/milvus/internal/querycoordv2/session"
 	"github.com/milvus-io/milvus/internal/querycoordv2/task"
-	"github.com/milvus-io/milvus/pkg/log"
 )
 
 const (
-	segmentCheckerName = "segment_checker"
-	channelCheckerName = "channel_checker"
-	balanceCheckerName = "balance_checker"
-	indexCheckerName   = "index_checker"
+	segmentCheckerName     = "segment_checker"
+	channelCheckerName     = "channel_checker"
+	balanceCheckerName     = "balance_checker"
+	indexCheckerName       = "index_checker"
+	consistencyCheckerName = "consistency_checker"
 )
 
 type checkerType int32
@@ -45,16 +45,18 @@ const (
 	segmentChecker
 	balanceChecker
 	indexChecker
+	consistencyChecker
 )
 
 var (
 	checkRoundTaskNumLimit = 256
-	checkerOrder           = []string{channelCheckerName, segmentCheckerName, balanceCheckerName, indexCheckerName}
+	checkerOrder           = []string{channelCheckerName, segmentCheckerName, balanceCheckerName, indexCheckerName, consistencyCheckerName}
 	checkerNames           = map[checkerType]string{
-		segmentChecker: segmentCheckerName,
-		channelChecker: channelCheckerName,
-		balanceChecker: balanceCheckerName,
-		indexChecker:   indexCheckerName,
+		segmentChecker:     segmentCheckerName,
+		channelChecker:     channelCheckerName,
+		balanceChecker:     balanceCheckerName,
+		indexChecker:       indexCheckerName,
+		consistencyChecker: consistencyCheckerName,
 	}
 )
 
@@ -69,6 +71,7 @@ type CheckerController struct {
 	dist           *meta.DistributionManager
 	targetMgr      *meta.TargetManager
 	broker         meta.Broker
+	cluster        session.Cluster
 	nodeMgr        *session.NodeManager
 	balancer       balance.Balance
 
@@ -86,14 +89,16 @@ func NewCheckerController(
 	nodeMgr *session.NodeManager,
 	scheduler task.Scheduler,
 	broker meta.Broker,
+	cluster session.Cluster,
 ) *CheckerController {
 	// CheckerController runs checkers with the order,
 	// the former checker has higher priority
 	checkers := map[checkerType]Checker{
-		channelChecker: NewChannelChecker(meta, dist, targetMgr, balancer),
-		segmentChecker: NewSegmentChecker(meta, dist, targetMgr, balancer, nodeMgr),
-		balanceChecker: NewBalanceChecker(meta, balancer, nodeMgr, scheduler),
-		indexChecker:   NewIndexChecker(meta, dist, broker),
+		channelChecker:     NewChannelChecker(meta, dist, targetMgr, balancer),
+		segmentChecker:     NewSegmentChecker(meta, dist, targetMgr, balancer, nodeMgr),
+		balanceChecker:     NewBalanceChecker(meta, balancer, nodeMgr, scheduler),
+		indexChecker:       NewIndexChecker(meta, dist, broker),
+		consistencyChecker: NewConsistencyChecker(meta, dist, targetMgr, nodeMgr, cluster),
 	}
 
 	manualCheckChs := map[checkerType]chan struct{}{
@@ -110,6 +115,7 @@ func NewCheckerController(
 		scheduler:      scheduler,
 		checkers:       checkers,
 		broker:         broker,
+		cluster:        cluster,
 	}
 }
 
@@ -132,6 +138,8 @@ func getCheckerInterval(checker checkerType) time.Duration {
 		return Params.QueryCoordCfg.BalanceCheckInterval.GetAsDuration(time.Millisecond)
 	case indexChecker:
 		return Params.QueryCoordCfg.IndexCheckInterval.GetAsDuration(time.Millisecond)
+	case consistencyChecker:
+		return Params.QueryCoordCfg.ConsistencyCheckInterval.GetAsDuration(time.Second)
 	default:
 		return Params.QueryCoordCfg.CheckInterval.GetAsDuration(time.Millisecond)
 	}
@@ -145,7 +153,7 @@ func (controller *CheckerController) startChecker(ctx context.Context, checker c
 	for {
 		select {
 		case <-ctx.Done():
-			log.Info("Checker stopped",
+			logger.Info("Checker stopped",
 				zap.String("type", checker.String()))
 			return
 
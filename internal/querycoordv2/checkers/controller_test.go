@@ -48,6 +48,7 @@ type CheckerControllerSuite struct {
 	targetManager *meta.TargetManager
 	scheduler     *task.MockScheduler
 	balancer      *balance.MockBalancer
+	cluster       *session.MockCluster
 
 	controller *CheckerController
 }
@@ -81,7 +82,8 @@ func (suite *CheckerControllerSuite) SetupTest() {
 
 	suite.balancer = balance.NewMockBalancer(suite.T())
 	suite.scheduler = task.NewMockScheduler(suite.T())
-	suite.controller = NewCheckerController(suite.meta, suite.dist, suite.targetManager, suite.balancer, suite.nodeMgr, suite.scheduler, suite.broker)
+	suite.cluster = session.NewMockCluster(suite.T())
+	suite.controller = NewCheckerController(suite.meta, suite.dist, suite.targetManager, suite.balancer, suite.nodeMgr, suite.scheduler, suite.broker, suite.cluster)
 }
 
 func (suite *CheckerControllerSuite) TestBasic() {
@@ -27,7 +27,6 @@ import (
 	"github.com/milvus-io/milvus/internal/querycoordv2/meta"
 	"github.com/milvus-io/milvus/internal/querycoordv2/params"
 	"github.com/milvus-io/milvus/internal/querycoordv2/task"
-	"github.com/milvus-io/milvus/pkg/log"
 	"github.com/milvus-io/milvus/pkg/util/typeutil"
 )
 
@@ -67,7 +66,7 @@ func (c *IndexChecker) Check(ctx context.Context) []task.Task {
 	for _, collectionID := range collectionIDs {
 		collection := c.meta.CollectionManager.GetCollection(collectionID)
 		if collection == nil {
-			log.Warn("collection released during check index", zap.Int64("collection", collectionID))
+			logger.Warn("collection released during check index", zap.Int64("collection", collectionID))
 			continue
 		}
 		replicas := c.meta.ReplicaManager.GetByCollection(collectionID)
@@ -80,7 +79,7 @@ func (c *IndexChecker) Check(ctx context.Context) []task.Task {
 }
 
 func (c *IndexChecker) checkReplica(ctx context.Context, collection *meta.Collection, replica *meta.Replica) []task.Task {
-	log := log.Ctx(ctx).With(
+	log := logger.With(
 		zap.Int64("collectionID", collection.GetCollectionID()),
 	)
 	var tasks []task.Task
@@ -155,7 +154,7 @@ func (c *IndexChecker) createSegmentUpdateTask(ctx context.Context, segment *met
 		action,
 	)
 	if err != nil {
-		log.Warn("create segment update task failed",
+		logger.Warn("create segment update task failed",
 			zap.Int64("collection", segment.GetCollectionID()),
 			zap.String("channel", segment.GetInsertChannel()),
 			zap.Int64("node", segment.Node),
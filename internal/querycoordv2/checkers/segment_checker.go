@@ -32,7 +32,6 @@ import (
 	"github.com/milvus-io/milvus/internal/querycoordv2/session"
 	"github.com/milvus-io/milvus/internal/querycoordv2/task"
 	"github.com/milvus-io/milvus/internal/querycoordv2/utils"
-	"github.com/milvus-io/milvus/pkg/log"
 )
 
 type SegmentChecker struct {
@@ -97,9 +96,10 @@ func (c *SegmentChecker) Check(ctx context.Context) []task.Task {
 }
 
 func (c *SegmentChecker) checkReplica(ctx context.Context, replica *meta.Replica) []task.Task {
-	log := log.Ctx(ctx).WithRateGroup("qcv2.SegmentChecker", 1, 60).With(
+	log := logger.With(
 		zap.Int64("collectionID", replica.CollectionID),
-		zap.Int64("replicaID", replica.ID))
+		zap.Int64("replicaID", replica.ID)).
+		WithRateGroup("qcv2.SegmentChecker", 1, 60)
 	ret := make([]task.Task, 0)
 
 	// get channel dist by replica (ch -> node list), cause more then one delegator may exists during channel balance.
@@ -146,13 +146,14 @@ func (c *SegmentChecker) getGrowingSegmentDiff(collectionID int64,
 ) (toLoad []*datapb.SegmentInfo, toRelease []*meta.Segment) {
 	replica := c.meta.Get(replicaID)
 	if replica == nil {
-		log.Info("replica does not exist, skip it")
+		logger.Info("replica does not exist, skip it")
 		return
 	}
 
-	log := log.Ctx(context.TODO()).WithRateGroup("qcv2.SegmentChecker", 1, 60).With(
+	log := logger.With(
 		zap.Int64("collectionID", collectionID),
-		zap.Int64("replicaID", replica.ID))
+		zap.Int64("replicaID", replica.ID)).
+		WithRateGroup("qcv2.SegmentChecker", 1, 60)
 
 	leaders := c.dist.ChannelDistManager.GetShardLeadersByReplica(replica)
 	//	distMgr.LeaderViewManager.
@@ -206,7 +207,7 @@ func (c *SegmentChecker) getSealedSegmentDiff(
 ) (toLoad []*datapb.SegmentInfo, toRelease []*meta.Segment) {
 	replica := c.meta.Get(replicaID)
 	if replica == nil {
-		log.Info("replica does not exist, skip it")
+		logger.Info("replica does not exist, skip it")
 		return
 	}
 	dist := c.getSealedSegmentsDist(replica)
@@ -271,7 +272,7 @@ func (c *SegmentChecker) findRepeatedSealedSegments(replicaID int64) []*meta.Seg
 	segments := make([]*meta.Segment, 0)
 	replica := c.meta.Get(replicaID)
 	if replica == nil {
-		log.Info("replica does not exist, skip it")
+		logger.Info("replica does not exist, skip it")
 		return segments
 	}
 	dist := c.getSealedSegmentsDist(replica)
@@ -392,7 +393,7 @@ func (c *SegmentChecker) createSegmentReduceTasks(ctx context.Context, segments
 			action,
 		)
 		if err != nil {
-			log.Warn("create segment reduce task failed",
+			logger.Warn("create segment reduce task failed",
 				zap.Int64("collection", s.GetCollectionID()),
 				zap.Int64("replica", replicaID),
 				zap.String("channel", s.GetInsertChannel()),
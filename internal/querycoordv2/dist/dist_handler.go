@@ -18,6 +18,7 @@ package dist
 
 import (
 	"context"
+	"fmt"
 	"sync"
 	"time"
 
@@ -33,6 +34,7 @@ import (
 	"github.com/milvus-io/milvus/internal/querycoordv2/session"
 	"github.com/milvus-io/milvus/internal/querycoordv2/task"
 	"github.com/milvus-io/milvus/pkg/log"
+	"github.com/milvus-io/milvus/pkg/metrics"
 	"github.com/milvus-io/milvus/pkg/util/commonpbutil"
 	"github.com/milvus-io/milvus/pkg/util/merr"
 )
@@ -73,8 +75,11 @@ func (dh *distHandler) start(ctx context.Context) {
 			return
 		case <-ticker.C:
 			resp, err := dh.getDistribution(ctx)
+			node := dh.nodeManager.Get(dh.nodeID)
+			if node != nil {
+				node.RecordRPCResult(err == nil)
+			}
 			if err != nil {
-				node := dh.nodeManager.Get(dh.nodeID)
 				fields := []zap.Field{zap.Int("times", failures)}
 				if node != nil {
 					fields = append(fields, zap.Time("lastHeartbeat", node.LastHeartbeat()))
@@ -101,6 +106,10 @@ func (dh *distHandler) handleDistResp(resp *querypb.GetDataDistributionResponse)
 				zap.Time("lastHeartBeatTime", node.LastHeartbeat()), zap.Int64("nodeID", node.ID()))
 		}
 		node.SetLastHeartbeat(time.Now())
+		metrics.QueryCoordNodeHealthScore.WithLabelValues(fmt.Sprint(node.ID())).Set(node.HealthScore())
+		if node.IsGrayFailing() {
+			log.RatedWarn(60, "node is gray failing", zap.Int64("nodeID", node.ID()), zap.Float64("healthScore", node.HealthScore()))
+		}
 	}
 
 	dh.updateSegmentsDistribution(resp)
@@ -204,6 +213,10 @@ func (dh *distHandler) updateLeaderView(resp *querypb.GetDataDistributionRespons
 			Segments:        lview.GetSegmentDist(),
 			GrowingSegments: segments,
 			TargetVersion:   lview.TargetVersion,
+
+			NumOfGrowingRows:      lview.GetNumOfGrowingRows(),
+			NumOfDeleteBufferRows: lview.GetNumOfDeleteBufferRows(),
+			DeleteForwardRate:     lview.GetDeleteForwardRate(),
 		}
 		updates = append(updates, view)
 	}
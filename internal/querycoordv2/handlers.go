@@ -19,7 +19,6 @@ package querycoordv2
 import (
 	"context"
 	"fmt"
-	"sync"
 	"time"
 
 	"github.com/samber/lo"
@@ -33,6 +32,7 @@ import (
 	"github.com/milvus-io/milvus/internal/querycoordv2/task"
 	"github.com/milvus-io/milvus/internal/querycoordv2/utils"
 	"github.com/milvus-io/milvus/pkg/log"
+	"github.com/milvus-io/milvus/pkg/util/funcutil"
 	"github.com/milvus-io/milvus/pkg/util/hardware"
 	"github.com/milvus-io/milvus/pkg/util/merr"
 	"github.com/milvus-io/milvus/pkg/util/metricsinfo"
@@ -198,6 +198,7 @@ func (s *Server) getSystemInfoMetrics(
 		ConnectedNodes: make([]metricsinfo.QueryNodeInfos, 0),
 	}
 	metricsinfo.FillDeployMetricsWithEnv(&clusterTopology.Self.SystemInfo)
+	clusterTopology.SnapshotTime = time.Now().String()
 	nodesMetrics := s.tryGetNodesMetrics(ctx, req, s.nodeMgr.GetAll()...)
 	s.fillMetricsWithNodes(&clusterTopology, nodesMetrics)
 
@@ -223,13 +224,13 @@ func (s *Server) fillMetricsWithNodes(topo *metricsinfo.QueryClusterTopology, no
 		if metric.err != nil {
 			log.Warn("invalid metrics of query node was found",
 				zap.Error(metric.err))
+			topo.Partial = true
 			topo.ConnectedNodes = append(topo.ConnectedNodes, metricsinfo.QueryNodeInfos{
 				BaseComponentInfos: metricsinfo.BaseComponentInfos{
 					HasError:    true,
 					ErrorReason: metric.err.Error(),
-					// Name doesn't matter here because we can't get it when error occurs, using address as the Name?
-					Name: "",
-					ID:   int64(uniquegenerator.GetUniqueIntGeneratorIns().GetInt()),
+					Name:        metricsinfo.ConstructComponentName(typeutil.QueryNodeRole, metric.nodeID),
+					ID:          metric.nodeID,
 				},
 			})
 			continue
@@ -239,6 +240,7 @@ func (s *Server) fillMetricsWithNodes(topo *metricsinfo.QueryClusterTopology, no
 			log.Warn("invalid metrics of query node was found",
 				zap.Any("error_code", metric.resp.GetStatus().GetErrorCode()),
 				zap.Any("error_reason", metric.resp.GetStatus().GetReason()))
+			topo.Partial = true
 			topo.ConnectedNodes = append(topo.ConnectedNodes, metricsinfo.QueryNodeInfos{
 				BaseComponentInfos: metricsinfo.BaseComponentInfos{
 					HasError:    true,
@@ -255,6 +257,7 @@ func (s *Server) fillMetricsWithNodes(topo *metricsinfo.QueryClusterTopology, no
 		if err != nil {
 			log.Warn("invalid metrics of query node was found",
 				zap.Error(err))
+			topo.Partial = true
 			topo.ConnectedNodes = append(topo.ConnectedNodes, metricsinfo.QueryNodeInfos{
 				BaseComponentInfos: metricsinfo.BaseComponentInfos{
 					HasError:    true,
@@ -270,36 +273,33 @@ func (s *Server) fillMetricsWithNodes(topo *metricsinfo.QueryClusterTopology, no
 }
 
 type metricResp struct {
-	resp *milvuspb.GetMetricsResponse
-	err  error
+	nodeID int64
+	resp   *milvuspb.GetMetricsResponse
+	err    error
 }
 
+// tryGetNodesMetrics collects the metrics of nodes in parallel,
+// the nodes failing or timed out are returned with error so that they are tagged in the topology.
 func (s *Server) tryGetNodesMetrics(ctx context.Context, req *milvuspb.GetMetricsRequest, nodes ...*session.NodeInfo) []*metricResp {
-	wg := sync.WaitGroup{}
+	snapshot := funcutil.CollectMetricsParallel(ctx, nodes,
+		Params.CommonCfg.MetricsCollectTimeout.GetAsDuration(time.Millisecond),
+		Params.CommonCfg.MetricsCollectParallelism.GetAsInt(),
+		func(ctx context.Context, node *session.NodeInfo) (*milvuspb.GetMetricsResponse, error) {
+			return s.cluster.GetMetrics(ctx, node.ID(), req)
+		})
 	ret := make([]*metricResp, 0, len(nodes))
-	retCh := make(chan *metricResp, len(nodes))
-	for _, node := range nodes {
-		node := node
-		wg.Add(1)
-		go func() {
-			defer wg.Done()
-
-			resp, err := s.cluster.GetMetrics(ctx, node.ID(), req)
-			if err != nil {
-				log.Warn("failed to get metric from QueryNode",
-					zap.Int64("nodeID", node.ID()))
-				return
-			}
-			retCh <- &metricResp{
-				resp: resp,
-				err:  err,
-			}
-		}()
-	}
-	wg.Wait()
-	close(retCh)
-	for resp := range retCh {
-		ret = append(ret, resp)
+	for i, result := range snapshot.Results {
+		if result.Err != nil {
+			log.Warn("failed to get metric from QueryNode",
+				zap.Int64("nodeID", nodes[i].ID()),
+				zap.Bool("timeout", result.TimedOut),
+				zap.Error(result.Err))
+		}
+		ret = append(ret, &metricResp{
+			nodeID: nodes[i].ID(),
+			resp:   result.Value,
+			err:    result.Err,
+		})
 	}
 	return ret
 }
@@ -365,6 +365,36 @@ func checkNodeAvailable(nodeID int64, info *session.NodeInfo) error {
 	return nil
 }
 
+// getFallbackShardNodes returns the available QueryNodes hosting the most sealed segments of the shard in each replica,
+// which serve the searches with their local sealed segments while the shard delegator is unavailable.
+func (s *Server) getFallbackShardNodes(collectionID int64, channel string) ([]int64, []string) {
+	ids := make([]int64, 0)
+	addrs := make([]string, 0)
+	for _, replica := range s.meta.ReplicaManager.GetByCollection(collectionID) {
+		segmentNum := make(map[int64]int)
+		for _, segment := range s.dist.SegmentDistManager.GetByShardWithReplica(channel, replica) {
+			segmentNum[segment.Node]++
+		}
+
+		var fallback *session.NodeInfo
+		for nodeID, num := range segmentNum {
+			info := s.nodeMgr.Get(nodeID)
+			if checkNodeAvailable(nodeID, info) != nil {
+				continue
+			}
+			if fallback == nil || num > segmentNum[fallback.ID()] ||
+				(num == segmentNum[fallback.ID()] && nodeID < fallback.ID()) {
+				fallback = info
+			}
+		}
+		if fallback != nil {
+			ids = append(ids, fallback.ID())
+			addrs = append(addrs, fallback.Addr())
+		}
+	}
+	return ids, addrs
+}
+
 func filterDupLeaders(replicaManager *meta.ReplicaManager, leaders map[int64]*meta.LeaderView) map[int64]*meta.LeaderView {
 	type leaderID struct {
 		ReplicaID int64
@@ -28,6 +28,7 @@ import (
 	"github.com/milvus-io/milvus/internal/proto/querypb"
 	"github.com/milvus-io/milvus/internal/querycoordv2/meta"
 	"github.com/milvus-io/milvus/internal/querycoordv2/observers"
+	"github.com/milvus-io/milvus/internal/querycoordv2/params"
 	"github.com/milvus-io/milvus/internal/querycoordv2/session"
 	"github.com/milvus-io/milvus/internal/querycoordv2/utils"
 	"github.com/milvus-io/milvus/pkg/eventlog"
@@ -91,7 +92,8 @@ func (job *LoadCollectionJob) PreExecute() error {
 		return nil
 	}
 
-	if collection.GetReplicaNumber() != req.GetReplicaNumber() {
+	// the replica number of loaded collection is managed by QueryCoord if auto replica enabled
+	if collection.GetReplicaNumber() != req.GetReplicaNumber() && !params.Params.QueryCoordCfg.AutoReplicaEnabled.GetAsBool() {
 		msg := fmt.Sprintf("collection with different replica number %d existed, release this collection first before changing its replica number",
 			job.meta.GetReplicaNumber(req.GetCollectionID()),
 		)
@@ -127,7 +129,7 @@ func (job *LoadCollectionJob) Execute() error {
 		return partID, !lo.Contains(loadedPartitionIDs, partID)
 	})
 	if len(lackPartitionIDs) == 0 {
-		return nil
+		return updateLoadPriority(job.meta, req.GetCollectionID(), req.GetLoadPriority())
 	}
 	job.undo.CollectionID = req.GetCollectionID()
 	job.undo.LackPartitions = lackPartitionIDs
@@ -186,6 +188,7 @@ func (job *LoadCollectionJob) Execute() error {
 			Status:        querypb.LoadStatus_Loading,
 			FieldIndexID:  req.GetFieldIndexID(),
 			LoadType:      querypb.LoadType_LoadCollection,
+			LoadPriority:  req.GetLoadPriority(),
 		},
 		CreatedAt: time.Now(),
 	}
@@ -198,6 +201,7 @@ func (job *LoadCollectionJob) Execute() error {
 	}
 	eventlog.Record(eventlog.NewRawEvt(eventlog.Level_Info, fmt.Sprintf("Start load collection %d", collection.CollectionID)))
 	metrics.QueryCoordNumPartitions.WithLabelValues().Add(float64(len(partitions)))
+	meta.GlobalLoadTraces.Start(job.ctx, req.GetCollectionID(), "QueryCoord-LoadCollection")
 
 	// 5. update next target, no need to rollback if pull target failed, target observer will pull target in periodically
 	_, err = job.targetObserver.UpdateNextTarget(req.GetCollectionID())
@@ -270,7 +274,7 @@ func (job *LoadPartitionJob) PreExecute() error {
 		return nil
 	}
 
-	if collection.GetReplicaNumber() != req.GetReplicaNumber() {
+	if collection.GetReplicaNumber() != req.GetReplicaNumber() && !params.Params.QueryCoordCfg.AutoReplicaEnabled.GetAsBool() {
 		msg := "collection with different replica number existed, release this collection first before changing its replica number"
 		log.Warn(msg)
 		return merr.WrapErrParameterInvalid(collection.GetReplicaNumber(), req.GetReplicaNumber(), "can't change the replica number for loaded partitions")
@@ -301,7 +305,7 @@ func (job *LoadPartitionJob) Execute() error {
 		return partID, !lo.Contains(loadedPartitionIDs, partID)
 	})
 	if len(lackPartitionIDs) == 0 {
-		return nil
+		return updateLoadPriority(job.meta, req.GetCollectionID(), req.GetLoadPriority())
 	}
 	job.undo.CollectionID = req.GetCollectionID()
 	job.undo.LackPartitions = lackPartitionIDs
@@ -362,6 +366,7 @@ func (job *LoadPartitionJob) Execute() error {
 				Status:        querypb.LoadStatus_Loading,
 				FieldIndexID:  req.GetFieldIndexID(),
 				LoadType:      querypb.LoadType_LoadPartition,
+				LoadPriority:  req.GetLoadPriority(),
 			},
 			CreatedAt: time.Now(),
 		}
@@ -378,8 +383,13 @@ func (job *LoadPartitionJob) Execute() error {
 			log.Warn(msg, zap.Error(err))
 			return errors.Wrap(err, msg)
 		}
+		err = updateLoadPriority(job.meta, req.GetCollectionID(), req.GetLoadPriority())
+		if err != nil {
+			return err
+		}
 	}
 	metrics.QueryCoordNumPartitions.WithLabelValues().Add(float64(len(partitions)))
+	meta.GlobalLoadTraces.Start(job.ctx, req.GetCollectionID(), "QueryCoord-LoadPartitions")
 
 	// 5. update next target, no need to rollback if pull target failed, target observer will pull target in periodically
 	_, err = job.targetObserver.UpdateNextTarget(req.GetCollectionID())
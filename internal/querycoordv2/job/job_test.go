@@ -38,6 +38,7 @@ import (
 	"github.com/milvus-io/milvus/internal/querycoordv2/observers"
 	. "github.com/milvus-io/milvus/internal/querycoordv2/params"
 	"github.com/milvus-io/milvus/internal/querycoordv2/session"
+	"github.com/milvus-io/milvus/pkg/common"
 	"github.com/milvus-io/milvus/pkg/util/etcd"
 	"github.com/milvus-io/milvus/pkg/util/merr"
 	"github.com/milvus-io/milvus/pkg/util/paramtable"
@@ -383,6 +384,38 @@ func (suite *JobSuite) TestLoadCollectionWithReplicas() {
 	}
 }
 
+func (suite *JobSuite) TestLoadCollectionWithPriority() {
+	ctx := context.Background()
+
+	for _, priority := range []int32{common.LoadPriorityHigh, common.LoadPriorityLow} {
+		for _, collection := range suite.collections {
+			if suite.loadTypes[collection] != querypb.LoadType_LoadCollection {
+				continue
+			}
+			req := &querypb.LoadCollectionRequest{
+				CollectionID: collection,
+				LoadPriority: priority,
+			}
+			job := NewLoadCollectionJob(
+				ctx,
+				req,
+				suite.dist,
+				suite.meta,
+				suite.broker,
+				suite.cluster,
+				suite.targetMgr,
+				suite.targetObserver,
+				suite.nodeMgr,
+			)
+			suite.scheduler.Add(job)
+			err := job.Wait()
+			suite.NoError(err)
+			// priority of the loaded collection is updated by the load request again
+			suite.Equal(priority, suite.meta.CollectionManager.GetLoadPriority(collection))
+		}
+	}
+}
+
 func (suite *JobSuite) TestLoadCollectionWithDiffIndex() {
 	ctx := context.Background()
 
@@ -20,6 +20,7 @@ import (
 	"context"
 	"time"
 
+	"github.com/cockroachdb/errors"
 	"github.com/samber/lo"
 	"go.uber.org/zap"
 
@@ -140,3 +141,18 @@ func releasePartitions(ctx context.Context,
 		}
 	}
 }
+
+// updateLoadPriority updates the load priority of the loaded collection if changed by the load request.
+func updateLoadPriority(m *meta.Meta, collectionID int64, priority int32) error {
+	if m.CollectionManager.GetLoadPriority(collectionID) == priority {
+		return nil
+	}
+	err := m.CollectionManager.UpdateLoadPriority(collectionID, priority)
+	if err != nil {
+		msg := "failed to update load priority"
+		log.Warn(msg, zap.Int64("collectionID", collectionID), zap.Int32("loadPriority", priority), zap.Error(err))
+		return errors.Wrap(err, msg)
+	}
+	log.Info("load priority updated", zap.Int64("collectionID", collectionID), zap.Int32("loadPriority", priority))
+	return nil
+}
@@ -0,0 +1,206 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package querycoordv2
+
+import (
+	"context"
+	"net/http"
+	"strconv"
+
+	"github.com/samber/lo"
+	"go.uber.org/zap"
+
+	"github.com/milvus-io/milvus-proto/go-api/v2/commonpb"
+	management "github.com/milvus-io/milvus/internal/http"
+	"github.com/milvus-io/milvus/internal/proto/querypb"
+	"github.com/milvus-io/milvus/internal/querycoordv2/meta"
+	"github.com/milvus-io/milvus/internal/querycoordv2/utils"
+	"github.com/milvus-io/milvus/pkg/log"
+	"github.com/milvus-io/milvus/pkg/util/merr"
+)
+
+// UpdateLoadConfig updates the replica number, resource groups and mmap setting of the loaded collection without releasing it.
+// The replicas converge to the new config incrementally by the LoadConfigObserver, the lacking replicas are spawned
+// and loaded before the surplus ones removed. The mmap setting applies to the segments loaded afterwards.
+func (s *Server) UpdateLoadConfig(ctx context.Context, req *querypb.UpdateLoadConfigRequest) (*commonpb.Status, error) {
+	log := log.Ctx(ctx).With(zap.Int64("collectionID", req.GetCollectionID()))
+
+	log.Info("update load config request received",
+		zap.Int32("replicaNumber", req.GetReplicaNumber()),
+		zap.Strings("resourceGroups", req.GetResourceGroups()))
+	if err := merr.CheckHealthy(s.State()); err != nil {
+		log.Warn("failed to update load config", zap.Error(err))
+		return merr.Status(err), nil
+	}
+
+	if err := s.updateLoadConfig(ctx, req); err != nil {
+		log.Warn("failed to update load config", zap.Error(err))
+		return merr.Status(err), nil
+	}
+	return merr.Success(), nil
+}
+
+// updateLoadConfig validates the load config against the resource groups and saves it, the zero fields are kept unchanged.
+func (s *Server) updateLoadConfig(ctx context.Context, req *querypb.UpdateLoadConfigRequest) error {
+	collection := s.meta.CollectionManager.GetCollection(req.GetCollectionID())
+	if collection == nil {
+		return merr.WrapErrCollectionNotLoaded(req.GetCollectionID())
+	}
+
+	replicaNumber := req.GetReplicaNumber()
+	if replicaNumber <= 0 {
+		replicaNumber = collection.GetReplicaNumber()
+	}
+	if replicaNumber != collection.GetReplicaNumber() && Params.QueryCoordCfg.AutoReplicaEnabled.GetAsBool() {
+		return merr.WrapErrParameterInvalidMsg("the replica number is managed by QueryCoord as auto replica enabled")
+	}
+
+	resourceGroups := req.GetResourceGroups()
+	if len(resourceGroups) == 0 {
+		resourceGroups = s.currentResourceGroups(collection)
+	}
+	expected, err := utils.ReplicaNumberByRG(replicaNumber, resourceGroups)
+	if err != nil {
+		return merr.WrapErrParameterInvalidMsg("invalid resource groups %v for %d replicas: %s", resourceGroups, replicaNumber, err.Error())
+	}
+	for rgName, num := range expected {
+		if !s.meta.ResourceManager.ContainResourceGroup(rgName) {
+			return merr.WrapErrResourceGroupNotFound(rgName)
+		}
+		nodes, err := s.meta.ResourceManager.GetNodes(rgName)
+		if err != nil {
+			return err
+		}
+		if len(nodes) < num {
+			return merr.WrapErrParameterInvalidMsg("resource group %s has %d nodes, not enough for %d replicas", rgName, len(nodes), num)
+		}
+	}
+
+	mmapDisabled := collection.GetMmapDisabled()
+	if req.GetMmapSpecified() {
+		mmapDisabled = !req.GetMmapEnabled()
+	}
+
+	if err := s.meta.CollectionManager.UpdateLoadConfig(req.GetCollectionID(), replicaNumber, resourceGroups, mmapDisabled); err != nil {
+		return err
+	}
+	log.Ctx(ctx).Info("load config updated",
+		zap.Int64("collectionID", req.GetCollectionID()),
+		zap.Int32("replicaNumber", replicaNumber),
+		zap.Strings("resourceGroups", resourceGroups),
+		zap.Bool("mmapDisabled", mmapDisabled))
+	return nil
+}
+
+// currentResourceGroups returns the resource groups of the last load config,
+// or the ones the replicas placed in if the load config never updated.
+func (s *Server) currentResourceGroups(collection *meta.Collection) []string {
+	if len(collection.GetResourceGroups()) > 0 {
+		return collection.GetResourceGroups()
+	}
+	rgs := s.meta.ReplicaManager.GetResourceGroupByCollection(collection.GetCollectionID())
+	if rgs.Len() == 1 {
+		return rgs.Collect()
+	}
+	return lo.Map(s.meta.ReplicaManager.GetByCollection(collection.GetCollectionID()), func(replica *meta.Replica, _ int) string {
+		return replica.GetResourceGroup()
+	})
+}
+
+// resetLoadConfigResourceGroups updates the resource groups of the load config to the current placement of the replicas,
+// so the replicas moved by TransferReplica are not moved back by the LoadConfigObserver.
+func (s *Server) resetLoadConfigResourceGroups(collectionID int64) {
+	collection := s.meta.CollectionManager.GetCollection(collectionID)
+	if collection == nil || len(collection.GetResourceGroups()) == 0 {
+		return
+	}
+	replicas := s.meta.ReplicaManager.GetByCollection(collectionID)
+	rgs := lo.Map(replicas, func(replica *meta.Replica, _ int) string { return replica.GetResourceGroup() })
+	if err := s.meta.CollectionManager.UpdateLoadConfig(collectionID, int32(len(replicas)), rgs, collection.GetMmapDisabled()); err != nil {
+		log.Warn("failed to reset resource groups of load config",
+			zap.Int64("collectionID", collectionID),
+			zap.Error(err))
+	}
+}
+
+var loadConfigComponent = management.NewComponent[*meta.Meta]("querycoord")
+
+// loadConfigStatus is the load config of collection, and the replicas in each resource group currently.
+type loadConfigStatus struct {
+	CollectionID   int64          `json:"collection_id"`
+	ReplicaNumber  int32          `json:"replica_number"`
+	ResourceGroups []string       `json:"resource_groups"`
+	MmapEnabled    bool           `json:"mmap_enabled"`
+	Replicas       map[string]int `json:"replicas"`
+	Converged      bool           `json:"converged"`
+}
+
+// registerLoadConfigHandler exposes the load configs of loaded collections through the management http server,
+// the load config is updated by the authenticated UpdateLoadConfig rpc rather than the management port.
+func registerLoadConfigHandler(m *meta.Meta) {
+	loadConfigComponent.Serve(m, &management.Handler{
+		Path:        management.QueryCoordLoadConfigRouterPath,
+		HandlerFunc: loadConfigHandler,
+	})
+}
+
+// loadConfigHandler gets the load config of the loaded collection.
+//
+//	GET /querycoord/load/config?collection_id=445566778899
+func loadConfigHandler(w http.ResponseWriter, req *http.Request) {
+	if req.Method != http.MethodGet {
+		management.WriteJSON(w, http.StatusMethodNotAllowed, map[string]string{"error": "method not allowed"})
+		return
+	}
+	m, ok := loadConfigComponent.Get(w)
+	if !ok {
+		return
+	}
+
+	collectionID, err := strconv.ParseInt(req.URL.Query().Get("collection_id"), 10, 64)
+	if err != nil {
+		management.WriteJSON(w, http.StatusBadRequest, map[string]string{"error": "invalid collection id: " + err.Error()})
+		return
+	}
+	collection := m.CollectionManager.GetCollection(collectionID)
+	if collection == nil {
+		management.WriteError(w, merr.WrapErrCollectionNotLoaded(collectionID))
+		return
+	}
+	status := &loadConfigStatus{
+		CollectionID:   collectionID,
+		ReplicaNumber:  collection.GetReplicaNumber(),
+		ResourceGroups: collection.GetResourceGroups(),
+		MmapEnabled:    !collection.GetMmapDisabled(),
+		Replicas:       make(map[string]int),
+	}
+	for _, replica := range m.ReplicaManager.GetByCollection(collectionID) {
+		status.Replicas[replica.GetResourceGroup()]++
+	}
+	// the replicas of collection never updated its load config are not converged by QueryCoord
+	if len(status.ResourceGroups) == 0 {
+		status.Converged = true
+	} else if expected, err := utils.ReplicaNumberByRG(status.ReplicaNumber, status.ResourceGroups); err == nil {
+		status.Converged = len(status.Replicas) == len(expected)
+		for rgName, num := range expected {
+			if status.Replicas[rgName] != num {
+				status.Converged = false
+			}
+		}
+	}
+	management.WriteJSON(w, http.StatusOK, status)
+}
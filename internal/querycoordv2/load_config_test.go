@@ -0,0 +1,162 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package querycoordv2
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/milvus-io/milvus-proto/go-api/v2/commonpb"
+	management "github.com/milvus-io/milvus/internal/http"
+	"github.com/milvus-io/milvus/internal/metastore/mocks"
+	"github.com/milvus-io/milvus/internal/proto/querypb"
+	"github.com/milvus-io/milvus/internal/querycoordv2/meta"
+	"github.com/milvus-io/milvus/internal/querycoordv2/params"
+	"github.com/milvus-io/milvus/internal/querycoordv2/session"
+	"github.com/milvus-io/milvus/pkg/util/merr"
+	"github.com/milvus-io/milvus/pkg/util/paramtable"
+	"github.com/milvus-io/milvus/pkg/util/typeutil"
+)
+
+func (suite *ServiceSuite) TestUpdateLoadConfig() {
+	ctx := context.Background()
+	server := suite.server
+	update := func(req *querypb.UpdateLoadConfigRequest) error {
+		status, err := server.UpdateLoadConfig(ctx, req)
+		suite.NoError(err)
+		return merr.Error(status)
+	}
+
+	err := update(&querypb.UpdateLoadConfigRequest{CollectionID: 1000, ReplicaNumber: 2})
+	suite.ErrorIs(err, merr.ErrCollectionNotLoaded)
+
+	suite.loadAll()
+
+	// scale up the replicas in the resource group placed
+	suite.NoError(update(&querypb.UpdateLoadConfigRequest{CollectionID: 1000, ReplicaNumber: 2}))
+	collection := suite.meta.CollectionManager.GetCollection(1000)
+	suite.EqualValues(2, collection.GetReplicaNumber())
+	suite.Equal([]string{meta.DefaultResourceGroupName}, collection.GetResourceGroups())
+	suite.False(collection.GetMmapDisabled())
+
+	// the mmap setting is kept if not specified
+	suite.NoError(update(&querypb.UpdateLoadConfigRequest{CollectionID: 1000, MmapEnabled: false, MmapSpecified: true}))
+	suite.NoError(update(&querypb.UpdateLoadConfigRequest{CollectionID: 1000, ReplicaNumber: 1}))
+	collection = suite.meta.CollectionManager.GetCollection(1000)
+	suite.EqualValues(1, collection.GetReplicaNumber())
+	suite.True(collection.GetMmapDisabled())
+
+	err = update(&querypb.UpdateLoadConfigRequest{CollectionID: 1000, ReplicaNumber: 2, ResourceGroups: []string{"rg1", "rg2"}})
+	suite.ErrorIs(err, merr.ErrResourceGroupNotFound)
+
+	// not enough nodes
+	err = update(&querypb.UpdateLoadConfigRequest{CollectionID: 1000, ReplicaNumber: int32(len(suite.nodes) + 1)})
+	suite.ErrorIs(err, merr.ErrParameterInvalid)
+
+	// the replicas of collection 1001 are in the default resource group only, 2 resource groups for 3 replicas are invalid
+	err = update(&querypb.UpdateLoadConfigRequest{CollectionID: 1001, ResourceGroups: []string{meta.DefaultResourceGroupName, "rg1"}})
+	suite.ErrorIs(err, merr.ErrParameterInvalid)
+
+	paramtable.Get().Save(params.Params.QueryCoordCfg.AutoReplicaEnabled.Key, "true")
+	defer paramtable.Get().Reset(params.Params.QueryCoordCfg.AutoReplicaEnabled.Key)
+	err = update(&querypb.UpdateLoadConfigRequest{CollectionID: 1000, ReplicaNumber: 2})
+	suite.ErrorIs(err, merr.ErrParameterInvalid)
+
+	server.UpdateStateCode(commonpb.StateCode_Abnormal)
+	defer server.UpdateStateCode(commonpb.StateCode_Healthy)
+	err = update(&querypb.UpdateLoadConfigRequest{CollectionID: 1000})
+	suite.ErrorIs(err, merr.ErrServiceNotReady)
+}
+
+func Test_loadConfigHandler(t *testing.T) {
+	paramtable.Init()
+	// the handler serves a standalone component, the one of the querycoord started by other tests is restored after
+	defer func(c *management.Component[*meta.Meta]) { loadConfigComponent = c }(loadConfigComponent)
+	loadConfigComponent = management.NewComponent[*meta.Meta]("querycoord")
+
+	t.Run("not started", func(t *testing.T) {
+		w := httptest.NewRecorder()
+		loadConfigHandler(w, httptest.NewRequest(http.MethodGet, "/querycoord/load/config?collection_id=1", nil))
+		assert.Equal(t, http.StatusServiceUnavailable, w.Code)
+	})
+
+	catalog := mocks.NewQueryCoordCatalog(t)
+	catalog.EXPECT().SaveCollection(mock.Anything).Return(nil).Maybe()
+	catalog.EXPECT().SaveReplica(mock.Anything).Return(nil).Maybe()
+	testMeta := meta.NewMeta(params.RandomIncrementIDAllocator(), catalog, session.NewNodeManager())
+	require.NoError(t, testMeta.CollectionManager.PutCollection(&meta.Collection{
+		CollectionLoadInfo: &querypb.CollectionLoadInfo{CollectionID: 1, ReplicaNumber: 1},
+	}))
+	require.NoError(t, testMeta.ReplicaManager.Put(meta.NewReplica(&querypb.Replica{
+		ID:            10,
+		CollectionID:  1,
+		ResourceGroup: meta.DefaultResourceGroupName,
+	}, typeutil.NewUniqueSet(1))))
+	loadConfigComponent.Serve(testMeta)
+
+	get := func(w *httptest.ResponseRecorder) *loadConfigStatus {
+		status := &loadConfigStatus{}
+		require.NoError(t, json.Unmarshal(w.Body.Bytes(), status))
+		return status
+	}
+
+	t.Run("method not allowed", func(t *testing.T) {
+		w := httptest.NewRecorder()
+		loadConfigHandler(w, httptest.NewRequest(http.MethodPost, "/querycoord/load/config",
+			strings.NewReader(`{"collection_id": 1, "replica_number": 2}`)))
+		assert.Equal(t, http.StatusMethodNotAllowed, w.Code)
+	})
+
+	t.Run("get", func(t *testing.T) {
+		w := httptest.NewRecorder()
+		loadConfigHandler(w, httptest.NewRequest(http.MethodGet, "/querycoord/load/config?collection_id=1", nil))
+		require.Equal(t, http.StatusOK, w.Code)
+		status := get(w)
+		assert.EqualValues(t, 1, status.ReplicaNumber)
+		assert.True(t, status.MmapEnabled)
+		assert.Equal(t, map[string]int{meta.DefaultResourceGroupName: 1}, status.Replicas)
+		assert.True(t, status.Converged)
+
+		w = httptest.NewRecorder()
+		loadConfigHandler(w, httptest.NewRequest(http.MethodGet, "/querycoord/load/config?collection_id=2", nil))
+		assert.Equal(t, http.StatusNotFound, w.Code)
+
+		w = httptest.NewRecorder()
+		loadConfigHandler(w, httptest.NewRequest(http.MethodGet, "/querycoord/load/config?collection_id=abc", nil))
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+	})
+
+	t.Run("not converged", func(t *testing.T) {
+		require.NoError(t, testMeta.CollectionManager.UpdateLoadConfig(1, 2, []string{meta.DefaultResourceGroupName}, true))
+		w := httptest.NewRecorder()
+		loadConfigHandler(w, httptest.NewRequest(http.MethodGet, "/querycoord/load/config?collection_id=1", nil))
+		require.Equal(t, http.StatusOK, w.Code)
+		status := get(w)
+		assert.EqualValues(t, 2, status.ReplicaNumber)
+		assert.False(t, status.MmapEnabled)
+		// the replica is not spawned yet
+		assert.False(t, status.Converged)
+	})
+}
@@ -0,0 +1,355 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package querycoordv2
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/cockroachdb/errors"
+	"github.com/samber/lo"
+	"go.uber.org/zap"
+
+	management "github.com/milvus-io/milvus/internal/http"
+	"github.com/milvus-io/milvus/internal/proto/datapb"
+	"github.com/milvus-io/milvus/internal/querycoordv2/meta"
+	"github.com/milvus-io/milvus/internal/querycoordv2/session"
+	"github.com/milvus-io/milvus/pkg/common"
+	"github.com/milvus-io/milvus/pkg/log"
+	"github.com/milvus-io/milvus/pkg/util/funcutil"
+	"github.com/milvus-io/milvus/pkg/util/indexparamcheck"
+	"github.com/milvus-io/milvus/pkg/util/merr"
+	"github.com/milvus-io/milvus/pkg/util/metricsinfo"
+	"github.com/milvus-io/milvus/pkg/util/typeutil"
+)
+
+// diskIndexMemoryRatio is the rough ratio of memory to the raw data size of the fields with disk index,
+// which keeps the compressed vectors in memory and the rest on local disk.
+const diskIndexMemoryRatio = 0.25
+
+var loadFeasibilityComponent = management.NewComponent[*loadFeasibilityContext]("querycoord")
+
+type loadFeasibilityContext struct {
+	meta   *meta.Meta
+	broker meta.Broker
+	// nodeResources returns the hardware of the querynodes, the nodes failing to report are absent.
+	nodeResources func(ctx context.Context, nodes []int64) map[int64]*metricsinfo.HardwareMetrics
+}
+
+// segmentSize is the estimated memory and disk in bytes to load a sealed segment.
+type segmentSize struct {
+	segmentID int64
+	memory    uint64
+	disk      uint64
+}
+
+type loadFeasibilityNode struct {
+	NodeID         int64  `json:"node_id"`
+	ResourceGroup  string `json:"resource_group"`
+	Replica        int    `json:"replica"`
+	SegmentNum     int    `json:"segment_num"`
+	MemoryRequired uint64 `json:"memory_required"`
+	DiskRequired   uint64 `json:"disk_required"`
+	MemoryFree     uint64 `json:"memory_free"`
+	DiskFree       uint64 `json:"disk_free"`
+	Sufficient     bool   `json:"sufficient"`
+}
+
+type loadFeasibilityReport struct {
+	CollectionID   int64                  `json:"collection_id"`
+	ReplicaNumber  int32                  `json:"replica_number"`
+	SegmentNum     int                    `json:"segment_num"`
+	MemoryRequired uint64                 `json:"memory_required_per_replica"`
+	DiskRequired   uint64                 `json:"disk_required_per_replica"`
+	Feasible       bool                   `json:"feasible"`
+	Insufficient   []string               `json:"insufficient,omitempty"`
+	Nodes          []*loadFeasibilityNode `json:"nodes"`
+}
+
+// registerLoadFeasibilityHandler exposes the dry-run of loading collection through the management http server,
+// the handler is registered only once and serves the latest started querycoord.
+func registerLoadFeasibilityHandler(s *Server) {
+	loadFeasibilityComponent.Serve(&loadFeasibilityContext{
+		meta:          s.meta,
+		broker:        s.broker,
+		nodeResources: s.getNodeResources,
+	}, &management.Handler{
+		Path:        management.QueryCoordLoadFeasibilityRouterPath,
+		HandlerFunc: loadFeasibilityHandler,
+	})
+}
+
+// loadFeasibilityHandler estimates the memory and disk to load the collection with the replicas,
+// and previews the placement of the segments on the querynodes against their free resources.
+// Nothing is loaded, and the placement of the real load may differ.
+//
+//	GET /querycoord/load/feasibility?collection_id=445566778899&replica_number=2[&resource_groups=rg1,rg2]
+func loadFeasibilityHandler(w http.ResponseWriter, req *http.Request) {
+	if req.Method != http.MethodGet {
+		management.WriteJSON(w, http.StatusMethodNotAllowed, map[string]string{"error": "method not allowed"})
+		return
+	}
+	c, ok := loadFeasibilityComponent.Get(w)
+	if !ok {
+		return
+	}
+
+	query := req.URL.Query()
+	collectionID, err := strconv.ParseInt(query.Get("collection_id"), 10, 64)
+	if err != nil {
+		management.WriteJSON(w, http.StatusBadRequest, map[string]string{"error": "invalid collection id: " + err.Error()})
+		return
+	}
+	replicaNumber := int64(1)
+	if v := query.Get("replica_number"); v != "" {
+		replicaNumber, err = strconv.ParseInt(v, 10, 32)
+		if err != nil || replicaNumber <= 0 {
+			management.WriteJSON(w, http.StatusBadRequest, map[string]string{"error": "invalid replica number: " + v})
+			return
+		}
+	}
+	var resourceGroups []string
+	if v := query.Get("resource_groups"); v != "" {
+		resourceGroups = strings.Split(v, ",")
+	}
+
+	report, err := c.check(req.Context(), collectionID, int32(replicaNumber), resourceGroups)
+	if err != nil {
+		management.WriteError(w, err)
+		return
+	}
+	management.WriteJSON(w, http.StatusOK, report)
+}
+
+func (c *loadFeasibilityContext) check(ctx context.Context, collectionID int64, replicaNumber int32, resourceGroups []string) (*loadFeasibilityReport, error) {
+	if c.meta.CollectionManager.Exist(collectionID) {
+		return nil, merr.WrapErrParameterInvalidMsg("collection %d is loaded already", collectionID)
+	}
+	rgReplicas, err := splitReplicasToResourceGroups(replicaNumber, resourceGroups)
+	if err != nil {
+		return nil, err
+	}
+	sizes, err := c.estimateSegmentSizes(ctx, collectionID)
+	if err != nil {
+		return nil, err
+	}
+
+	report := &loadFeasibilityReport{
+		CollectionID:  collectionID,
+		ReplicaNumber: replicaNumber,
+		SegmentNum:    len(sizes),
+		Nodes:         make([]*loadFeasibilityNode, 0),
+	}
+	for _, size := range sizes {
+		report.MemoryRequired += size.memory
+		report.DiskRequired += size.disk
+	}
+
+	replicaIndex := 0
+	for _, rg := range rgReplicas {
+		nodes, err := c.meta.ResourceManager.GetNodes(rg.name)
+		if err != nil {
+			return nil, err
+		}
+		if len(nodes) < rg.replicas {
+			report.Feasible = false
+			report.Insufficient = append(report.Insufficient,
+				fmt.Sprintf("resource group %s has %d querynodes, less than %d replicas", rg.name, len(nodes), rg.replicas))
+			replicaIndex += rg.replicas
+			continue
+		}
+		sort.Slice(nodes, func(i, j int) bool { return nodes[i] < nodes[j] })
+		resources := c.nodeResources(ctx, nodes)
+		for replica := 0; replica < rg.replicas; replica++ {
+			replicaNodes := make([]*loadFeasibilityNode, 0)
+			for i := replica; i < len(nodes); i += rg.replicas {
+				node := &loadFeasibilityNode{
+					NodeID:        nodes[i],
+					ResourceGroup: rg.name,
+					Replica:       replicaIndex,
+				}
+				if hw, ok := resources[nodes[i]]; ok {
+					node.MemoryFree = freeResource(hw.Memory, hw.MemoryUsage, Params.QueryNodeCfg.OverloadedMemoryThresholdPercentage.GetAsFloat())
+					node.DiskFree = freeResource(hw.Disk, hw.DiskUsage, 1)
+				} else {
+					report.Insufficient = append(report.Insufficient, fmt.Sprintf("querynode %d failed to report its resources", nodes[i]))
+				}
+				replicaNodes = append(replicaNodes, node)
+			}
+			placeSegments(replicaNodes, sizes)
+			report.Nodes = append(report.Nodes, replicaNodes...)
+			replicaIndex++
+		}
+	}
+
+	for _, node := range report.Nodes {
+		node.Sufficient = node.MemoryRequired <= node.MemoryFree && node.DiskRequired <= node.DiskFree
+		if node.MemoryRequired > node.MemoryFree {
+			report.Insufficient = append(report.Insufficient, fmt.Sprintf("querynode %d requires %d bytes of memory, but %d bytes free",
+				node.NodeID, node.MemoryRequired, node.MemoryFree))
+		}
+		if node.DiskRequired > node.DiskFree {
+			report.Insufficient = append(report.Insufficient, fmt.Sprintf("querynode %d requires %d bytes of disk, but %d bytes free",
+				node.NodeID, node.DiskRequired, node.DiskFree))
+		}
+	}
+	report.Feasible = len(report.Insufficient) == 0
+	log.Ctx(ctx).Info("load feasibility checked", zap.Int64("collectionID", collectionID), zap.Int32("replicaNumber", replicaNumber),
+		zap.Bool("feasible", report.Feasible), zap.Strings("insufficient", report.Insufficient))
+	return report, nil
+}
+
+type resourceGroupReplicas struct {
+	name     string
+	replicas int
+}
+
+// splitReplicasToResourceGroups splits the replicas like the load does,
+// all the replicas are in the only resource group, or one replica per resource group.
+func splitReplicasToResourceGroups(replicaNumber int32, resourceGroups []string) ([]resourceGroupReplicas, error) {
+	switch len(resourceGroups) {
+	case 0:
+		return []resourceGroupReplicas{{name: meta.DefaultResourceGroupName, replicas: int(replicaNumber)}}, nil
+	case 1:
+		return []resourceGroupReplicas{{name: resourceGroups[0], replicas: int(replicaNumber)}}, nil
+	case int(replicaNumber):
+		ret := make([]resourceGroupReplicas, 0, len(resourceGroups))
+		for _, rg := range resourceGroups {
+			ret = append(ret, resourceGroupReplicas{name: rg, replicas: 1})
+		}
+		return ret, nil
+	default:
+		return nil, merr.WrapErrParameterInvalidMsg("the number of resource groups should be 1 or equal to the replica number %d", replicaNumber)
+	}
+}
+
+// estimateSegmentSizes estimates the resources to load the sealed segments of the collection like querynode does,
+// the fields are loaded by the binlog size, into local disk if mmap enabled, and the fields with disk index are mostly on disk.
+func (c *loadFeasibilityContext) estimateSegmentSizes(ctx context.Context, collectionID int64) ([]segmentSize, error) {
+	partitions, err := c.broker.GetPartitions(ctx, collectionID)
+	if err != nil {
+		return nil, err
+	}
+	_, segments, err := c.broker.GetRecoveryInfoV2(ctx, collectionID, partitions...)
+	if err != nil {
+		return nil, err
+	}
+	indexes, err := c.broker.DescribeIndex(ctx, collectionID)
+	if err != nil && !errors.Is(err, merr.ErrIndexNotFound) {
+		return nil, err
+	}
+	diskIndexFields := typeutil.NewSet[int64]()
+	for _, index := range indexes {
+		indexType, _ := funcutil.GetAttrByKeyFromRepeatedKV(common.IndexTypeKey, index.GetIndexParams())
+		if indexType == indexparamcheck.IndexDISKANN {
+			diskIndexFields.Insert(index.GetFieldID())
+		}
+	}
+	mmapEnabled := len(Params.QueryNodeCfg.MmapDirPath.GetValue()) > 0
+	return lo.Map(segments, func(segment *datapb.SegmentInfo, _ int) segmentSize {
+		return estimateSegmentSize(segment, diskIndexFields, mmapEnabled)
+	}), nil
+}
+
+func estimateSegmentSize(segment *datapb.SegmentInfo, diskIndexFields typeutil.Set[int64], mmapEnabled bool) segmentSize {
+	size := segmentSize{segmentID: segment.GetID()}
+	for _, fieldBinlog := range segment.GetBinlogs() {
+		fieldSize := uint64(0)
+		for _, binlog := range fieldBinlog.GetBinlogs() {
+			fieldSize += uint64(binlog.GetLogSize())
+		}
+		switch {
+		case diskIndexFields.Contain(fieldBinlog.GetFieldID()):
+			size.memory += uint64(float64(fieldSize) * diskIndexMemoryRatio)
+			size.disk += fieldSize
+		case mmapEnabled:
+			size.disk += fieldSize
+		default:
+			size.memory += fieldSize
+		}
+	}
+	for _, fieldBinlogs := range [][]*datapb.FieldBinlog{segment.GetStatslogs(), segment.GetDeltalogs()} {
+		for _, fieldBinlog := range fieldBinlogs {
+			for _, binlog := range fieldBinlog.GetBinlogs() {
+				size.memory += uint64(binlog.GetLogSize())
+			}
+		}
+	}
+	return size
+}
+
+// placeSegments places the largest segments first on the node having the most free memory left.
+func placeSegments(nodes []*loadFeasibilityNode, sizes []segmentSize) {
+	if len(nodes) == 0 {
+		return
+	}
+	sorted := append([]segmentSize{}, sizes...)
+	sort.SliceStable(sorted, func(i, j int) bool { return sorted[i].memory+sorted[i].disk > sorted[j].memory+sorted[j].disk })
+	left := func(node *loadFeasibilityNode) int64 {
+		return int64(node.MemoryFree) - int64(node.MemoryRequired)
+	}
+	for _, size := range sorted {
+		target := nodes[0]
+		for _, node := range nodes[1:] {
+			if left(node) > left(target) {
+				target = node
+			}
+		}
+		target.SegmentNum++
+		target.MemoryRequired += size.memory
+		target.DiskRequired += size.disk
+	}
+}
+
+func freeResource(total uint64, used uint64, threshold float64) uint64 {
+	limit := uint64(float64(total) * threshold)
+	if used >= limit {
+		return 0
+	}
+	return limit - used
+}
+
+// getNodeResources collects the hardware metrics of the querynodes.
+func (s *Server) getNodeResources(ctx context.Context, nodes []int64) map[int64]*metricsinfo.HardwareMetrics {
+	req, err := metricsinfo.ConstructRequestByMetricType(metricsinfo.SystemInfoMetrics)
+	if err != nil {
+		return nil
+	}
+	nodeInfos := make([]*session.NodeInfo, 0, len(nodes))
+	for _, node := range nodes {
+		if info := s.nodeMgr.Get(node); info != nil {
+			nodeInfos = append(nodeInfos, info)
+		}
+	}
+	ret := make(map[int64]*metricsinfo.HardwareMetrics)
+	for _, metric := range s.tryGetNodesMetrics(ctx, req, nodeInfos...) {
+		if err := merr.CheckRPCCall(metric.resp, metric.err); err != nil {
+			continue
+		}
+		infos := metricsinfo.QueryNodeInfos{}
+		if err := metricsinfo.UnmarshalComponentInfos(metric.resp.GetResponse(), &infos); err != nil {
+			log.Warn("invalid metrics of querynode", zap.Int64("nodeID", metric.nodeID), zap.Error(err))
+			continue
+		}
+		ret[metric.nodeID] = &infos.HardwareInfos
+	}
+	return ret
+}
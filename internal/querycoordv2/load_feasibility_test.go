@@ -0,0 +1,208 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package querycoordv2
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/milvus-io/milvus-proto/go-api/v2/commonpb"
+	management "github.com/milvus-io/milvus/internal/http"
+	"github.com/milvus-io/milvus/internal/metastore/mocks"
+	"github.com/milvus-io/milvus/internal/proto/datapb"
+	"github.com/milvus-io/milvus/internal/proto/indexpb"
+	"github.com/milvus-io/milvus/internal/proto/querypb"
+	"github.com/milvus-io/milvus/internal/querycoordv2/meta"
+	"github.com/milvus-io/milvus/internal/querycoordv2/params"
+	"github.com/milvus-io/milvus/internal/querycoordv2/session"
+	"github.com/milvus-io/milvus/pkg/common"
+	"github.com/milvus-io/milvus/pkg/util/metricsinfo"
+	"github.com/milvus-io/milvus/pkg/util/paramtable"
+	"github.com/milvus-io/milvus/pkg/util/typeutil"
+)
+
+func Test_loadFeasibilityHandler(t *testing.T) {
+	paramtable.Init()
+	// the handler serves a standalone component, the one of the querycoord started by other tests is restored after
+	defer func(c *management.Component[*loadFeasibilityContext]) { loadFeasibilityComponent = c }(loadFeasibilityComponent)
+	loadFeasibilityComponent = management.NewComponent[*loadFeasibilityContext]("querycoord")
+
+	t.Run("not started", func(t *testing.T) {
+		w := httptest.NewRecorder()
+		loadFeasibilityHandler(w, httptest.NewRequest(http.MethodGet, "/querycoord/load/feasibility?collection_id=1", nil))
+		assert.Equal(t, http.StatusServiceUnavailable, w.Code)
+	})
+
+	catalog := mocks.NewQueryCoordCatalog(t)
+	catalog.EXPECT().SaveCollection(mock.Anything).Return(nil).Maybe()
+	catalog.EXPECT().SaveResourceGroup(mock.Anything).Return(nil).Maybe()
+	nodeMgr := session.NewNodeManager()
+	testMeta := meta.NewMeta(params.RandomIncrementIDAllocator(), catalog, nodeMgr)
+	for _, node := range []int64{1, 2} {
+		nodeMgr.Add(session.NewNodeInfo(node, "localhost"))
+		require.NoError(t, testMeta.ResourceManager.AssignNode(meta.DefaultResourceGroupName, node))
+	}
+	require.NoError(t, testMeta.CollectionManager.PutCollection(&meta.Collection{
+		CollectionLoadInfo: &querypb.CollectionLoadInfo{CollectionID: 2, ReplicaNumber: 1},
+	}))
+
+	// segments 100, 101 and 102 have the vector binlogs of 1000, 2000 and 500 bytes
+	segments := make([]*datapb.SegmentInfo, 0, 3)
+	for i, vectorSize := range []int64{1000, 2000, 500} {
+		segments = append(segments, &datapb.SegmentInfo{
+			ID:           int64(100 + i),
+			CollectionID: 1,
+			PartitionID:  10,
+			Binlogs: []*datapb.FieldBinlog{
+				{FieldID: 100, Binlogs: []*datapb.Binlog{{LogSize: 100}}},
+				{FieldID: 101, Binlogs: []*datapb.Binlog{{LogSize: vectorSize / 2}, {LogSize: vectorSize / 2}}},
+			},
+			Statslogs: []*datapb.FieldBinlog{{FieldID: 100, Binlogs: []*datapb.Binlog{{LogSize: 10}}}},
+		})
+	}
+	broker := meta.NewMockBroker(t)
+	broker.EXPECT().GetPartitions(mock.Anything, int64(1)).Return([]int64{10}, nil).Maybe()
+	broker.EXPECT().GetRecoveryInfoV2(mock.Anything, int64(1), int64(10)).Return(nil, segments, nil).Maybe()
+	broker.EXPECT().DescribeIndex(mock.Anything, int64(1)).Return([]*indexpb.IndexInfo{
+		{FieldID: 101, IndexParams: []*commonpb.KeyValuePair{{Key: common.IndexTypeKey, Value: "DISKANN"}}},
+	}, nil).Maybe()
+
+	memory := uint64(10000)
+	loadFeasibilityComponent.Serve(&loadFeasibilityContext{
+		meta:   testMeta,
+		broker: broker,
+		nodeResources: func(ctx context.Context, nodes []int64) map[int64]*metricsinfo.HardwareMetrics {
+			ret := make(map[int64]*metricsinfo.HardwareMetrics)
+			for _, node := range nodes {
+				ret[node] = &metricsinfo.HardwareMetrics{Memory: memory, Disk: 10000, DiskUsage: 5000}
+			}
+			return ret
+		},
+	})
+
+	get := func(query string) (int, *loadFeasibilityReport) {
+		w := httptest.NewRecorder()
+		loadFeasibilityHandler(w, httptest.NewRequest(http.MethodGet, "/querycoord/load/feasibility?"+query, nil))
+		report := &loadFeasibilityReport{}
+		if w.Code == http.StatusOK {
+			require.NoError(t, json.Unmarshal(w.Body.Bytes(), report))
+		}
+		return w.Code, report
+	}
+
+	t.Run("method not allowed", func(t *testing.T) {
+		w := httptest.NewRecorder()
+		loadFeasibilityHandler(w, httptest.NewRequest(http.MethodPost, "/querycoord/load/feasibility?collection_id=1", nil))
+		assert.Equal(t, http.StatusMethodNotAllowed, w.Code)
+	})
+
+	t.Run("invalid params", func(t *testing.T) {
+		code, _ := get("collection_id=abc")
+		assert.Equal(t, http.StatusBadRequest, code)
+		code, _ = get("collection_id=1&replica_number=0")
+		assert.Equal(t, http.StatusBadRequest, code)
+		code, _ = get("collection_id=1&replica_number=3&resource_groups=rg1,rg2")
+		assert.Equal(t, http.StatusBadRequest, code)
+		code, _ = get("collection_id=1&resource_groups=rg1")
+		assert.Equal(t, http.StatusBadRequest, code)
+		// loaded already
+		code, _ = get("collection_id=2")
+		assert.Equal(t, http.StatusBadRequest, code)
+	})
+
+	t.Run("feasible", func(t *testing.T) {
+		code, report := get("collection_id=1")
+		require.Equal(t, http.StatusOK, code)
+		assert.True(t, report.Feasible)
+		assert.Equal(t, 3, report.SegmentNum)
+		assert.EqualValues(t, 3*(100+10)+3500/4, report.MemoryRequired)
+		assert.EqualValues(t, 3500, report.DiskRequired)
+		require.Len(t, report.Nodes, 2)
+		assert.Equal(t, 3, report.Nodes[0].SegmentNum+report.Nodes[1].SegmentNum)
+		for _, node := range report.Nodes {
+			assert.True(t, node.Sufficient)
+			assert.EqualValues(t, 9000, node.MemoryFree)
+			assert.EqualValues(t, 5000, node.DiskFree)
+		}
+	})
+
+	t.Run("insufficient", func(t *testing.T) {
+		// each replica has only one querynode
+		code, report := get("collection_id=1&replica_number=2")
+		require.Equal(t, http.StatusOK, code)
+		assert.True(t, report.Feasible)
+		require.Len(t, report.Nodes, 2)
+		assert.Equal(t, 0, report.Nodes[0].Replica)
+		assert.Equal(t, 1, report.Nodes[1].Replica)
+
+		memory = 1000
+		code, report = get("collection_id=1&replica_number=2")
+		require.Equal(t, http.StatusOK, code)
+		assert.False(t, report.Feasible)
+		assert.NotEmpty(t, report.Insufficient)
+		for _, node := range report.Nodes {
+			assert.False(t, node.Sufficient)
+		}
+
+		code, report = get("collection_id=1&replica_number=3")
+		require.Equal(t, http.StatusOK, code)
+		assert.False(t, report.Feasible)
+		assert.Contains(t, report.Insufficient[0], "less than 3 replicas")
+	})
+}
+
+func Test_estimateSegmentSize(t *testing.T) {
+	segment := &datapb.SegmentInfo{
+		ID: 100,
+		Binlogs: []*datapb.FieldBinlog{
+			{FieldID: 100, Binlogs: []*datapb.Binlog{{LogSize: 100}}},
+			{FieldID: 101, Binlogs: []*datapb.Binlog{{LogSize: 500}, {LogSize: 500}}},
+		},
+		Statslogs: []*datapb.FieldBinlog{{FieldID: 100, Binlogs: []*datapb.Binlog{{LogSize: 10}}}},
+	}
+
+	size := estimateSegmentSize(segment, typeutil.NewSet[int64](), false)
+	assert.EqualValues(t, 1110, size.memory)
+	assert.EqualValues(t, 0, size.disk)
+
+	size = estimateSegmentSize(segment, typeutil.NewSet[int64](101), false)
+	assert.EqualValues(t, 360, size.memory)
+	assert.EqualValues(t, 1000, size.disk)
+
+	size = estimateSegmentSize(segment, typeutil.NewSet[int64](), true)
+	assert.EqualValues(t, 10, size.memory)
+	assert.EqualValues(t, 1100, size.disk)
+}
+
+func Test_placeSegments(t *testing.T) {
+	nodes := []*loadFeasibilityNode{{NodeID: 1, MemoryFree: 100}, {NodeID: 2, MemoryFree: 60}}
+	placeSegments(nodes, []segmentSize{{segmentID: 1, memory: 10}, {segmentID: 2, memory: 50}, {segmentID: 3, memory: 40}})
+	assert.Equal(t, 2, nodes[0].SegmentNum)
+	assert.EqualValues(t, 60, nodes[0].MemoryRequired)
+	assert.Equal(t, 1, nodes[1].SegmentNum)
+	assert.EqualValues(t, 40, nodes[1].MemoryRequired)
+
+	assert.EqualValues(t, 40, freeResource(100, 50, 0.9))
+	assert.EqualValues(t, 0, freeResource(100, 95, 0.9))
+}
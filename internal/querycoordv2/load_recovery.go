@@ -0,0 +1,98 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package querycoordv2
+
+import (
+	"context"
+	"net/http"
+	"strconv"
+
+	"github.com/samber/lo"
+
+	"github.com/milvus-io/milvus-proto/go-api/v2/commonpb"
+	management "github.com/milvus-io/milvus/internal/http"
+	"github.com/milvus-io/milvus/internal/proto/querypb"
+	"github.com/milvus-io/milvus/internal/querycoordv2/meta"
+	"github.com/milvus-io/milvus/internal/querycoordv2/observers"
+	"github.com/milvus-io/milvus/pkg/util/commonpbutil"
+	"github.com/milvus-io/milvus/pkg/util/merr"
+)
+
+// reloadCollection releases and loads the collection again with the same partitions, replica number and resource groups,
+// to recover the impossible load state.
+func (s *Server) reloadCollection(ctx context.Context, collectionID int64) error {
+	collection := s.meta.CollectionManager.GetCollection(collectionID)
+	if collection == nil {
+		return merr.WrapErrCollectionNotLoaded(collectionID)
+	}
+	var partitionIDs []int64
+	if collection.GetLoadType() == querypb.LoadType_LoadPartition {
+		partitionIDs = lo.Map(s.meta.CollectionManager.GetPartitionsByCollection(collectionID), func(partition *meta.Partition, _ int) int64 {
+			return partition.GetPartitionID()
+		})
+	}
+	resourceGroups := lo.Uniq(lo.Map(s.meta.ReplicaManager.GetByCollection(collectionID), func(replica *meta.Replica, _ int) string {
+		return replica.GetResourceGroup()
+	}))
+
+	status, err := s.ReleaseCollection(ctx, &querypb.ReleaseCollectionRequest{
+		Base: commonpbutil.NewMsgBase(
+			commonpbutil.WithMsgType(commonpb.MsgType_ReleaseCollection),
+		),
+		CollectionID: collectionID,
+	})
+	if err := merr.CheckRPCCall(status, err); err != nil {
+		return err
+	}
+	return s.loadWithIndexes(ctx, collectionID, partitionIDs, collection.GetReplicaNumber(), resourceGroups)
+}
+
+var loadIncidentComponent = management.NewComponent[*observers.LoadRecoveryObserver]("querycoord")
+
+// registerLoadIncidentHandler exposes the load incidents through the management http server,
+// the handler is registered only once and serves the latest started querycoord.
+func registerLoadIncidentHandler(ob *observers.LoadRecoveryObserver) {
+	loadIncidentComponent.Serve(ob, &management.Handler{
+		Path:        management.QueryCoordLoadIncidentRouterPath,
+		HandlerFunc: loadIncidentHandler,
+	})
+}
+
+// loadIncidentHandler lists the impossible load states detected and the recoveries taken.
+//
+//	GET /querycoord/load/incidents[?collection_id=445566778899]
+func loadIncidentHandler(w http.ResponseWriter, req *http.Request) {
+	if req.Method != http.MethodGet {
+		management.WriteJSON(w, http.StatusMethodNotAllowed, map[string]string{"error": "method not allowed"})
+		return
+	}
+	ob, ok := loadIncidentComponent.Get(w)
+	if !ok {
+		return
+	}
+
+	var collectionID int64
+	if value := req.URL.Query().Get("collection_id"); value != "" {
+		var err error
+		collectionID, err = strconv.ParseInt(value, 10, 64)
+		if err != nil {
+			management.WriteJSON(w, http.StatusBadRequest, map[string]string{"error": "invalid collection id: " + err.Error()})
+			return
+		}
+	}
+	management.WriteJSON(w, http.StatusOK, ob.Incidents(collectionID))
+}
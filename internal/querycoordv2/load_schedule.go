@@ -0,0 +1,183 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package querycoordv2
+
+import (
+	"context"
+	"net/http"
+	"strconv"
+	"time"
+
+	"go.uber.org/zap"
+
+	"github.com/milvus-io/milvus-proto/go-api/v2/commonpb"
+	management "github.com/milvus-io/milvus/internal/http"
+	"github.com/milvus-io/milvus/internal/metastore/model"
+	"github.com/milvus-io/milvus/internal/proto/querypb"
+	"github.com/milvus-io/milvus/internal/querycoordv2/meta"
+	"github.com/milvus-io/milvus/pkg/log"
+	"github.com/milvus-io/milvus/pkg/util/commonpbutil"
+	"github.com/milvus-io/milvus/pkg/util/merr"
+)
+
+// loadScheduledCollection loads the collection with the replica number and resource groups of the schedule,
+// the collection is loaded with all the indexes built like loaded by the proxy.
+func (s *Server) loadScheduledCollection(ctx context.Context, schedule *model.LoadSchedule) error {
+	return s.loadWithIndexes(ctx, schedule.CollectionID, nil, schedule.ReplicaNumber, schedule.ResourceGroups)
+}
+
+// loadWithIndexes loads the collection, or the partitions if specified, with all the indexes built.
+func (s *Server) loadWithIndexes(ctx context.Context, collectionID int64, partitionIDs []int64, replicaNumber int32, resourceGroups []string) error {
+	schema, err := s.broker.GetCollectionSchema(ctx, collectionID)
+	if err != nil {
+		return err
+	}
+	indexes, err := s.broker.DescribeIndex(ctx, collectionID)
+	if err != nil {
+		return err
+	}
+	fieldIndexIDs := make(map[int64]int64, len(indexes))
+	for _, index := range indexes {
+		fieldIndexIDs[index.GetFieldID()] = index.GetIndexID()
+	}
+
+	if len(partitionIDs) > 0 {
+		status, err := s.LoadPartitions(ctx, &querypb.LoadPartitionsRequest{
+			Base: commonpbutil.NewMsgBase(
+				commonpbutil.WithMsgType(commonpb.MsgType_LoadPartitions),
+			),
+			CollectionID:   collectionID,
+			PartitionIDs:   partitionIDs,
+			Schema:         schema,
+			ReplicaNumber:  replicaNumber,
+			FieldIndexID:   fieldIndexIDs,
+			ResourceGroups: resourceGroups,
+		})
+		return merr.CheckRPCCall(status, err)
+	}
+	status, err := s.LoadCollection(ctx, &querypb.LoadCollectionRequest{
+		Base: commonpbutil.NewMsgBase(
+			commonpbutil.WithMsgType(commonpb.MsgType_LoadCollection),
+		),
+		CollectionID:   collectionID,
+		Schema:         schema,
+		ReplicaNumber:  replicaNumber,
+		FieldIndexID:   fieldIndexIDs,
+		ResourceGroups: resourceGroups,
+	})
+	return merr.CheckRPCCall(status, err)
+}
+
+func (s *Server) releaseScheduledCollection(ctx context.Context, collectionID int64) error {
+	status, err := s.ReleaseCollection(ctx, &querypb.ReleaseCollectionRequest{
+		Base: commonpbutil.NewMsgBase(
+			commonpbutil.WithMsgType(commonpb.MsgType_ReleaseCollection),
+		),
+		CollectionID: collectionID,
+	})
+	return merr.CheckRPCCall(status, err)
+}
+
+// OperateLoadSchedule puts or removes the schedule loading and releasing the collection periodically.
+func (s *Server) OperateLoadSchedule(ctx context.Context, req *querypb.OperateLoadScheduleRequest) (*commonpb.Status, error) {
+	log := log.Ctx(ctx).With(
+		zap.Int64("collectionID", req.GetCollectionID()),
+		zap.String("operateType", req.GetOperateType().String()),
+	)
+
+	log.Info("operate load schedule request received")
+	if err := merr.CheckHealthy(s.State()); err != nil {
+		log.Warn("failed to operate load schedule", zap.Error(err))
+		return merr.Status(err), nil
+	}
+
+	var err error
+	switch req.GetOperateType() {
+	case querypb.LoadScheduleOperateType_PutLoadSchedule:
+		err = s.putLoadSchedule(ctx, req)
+	case querypb.LoadScheduleOperateType_RemoveLoadSchedule:
+		err = s.meta.RemoveLoadSchedule(req.GetCollectionID())
+	default:
+		err = merr.WrapErrParameterInvalidMsg("invalid load schedule operate type %d", req.GetOperateType())
+	}
+	if err != nil {
+		log.Warn("failed to operate load schedule", zap.Error(err))
+		return merr.Status(err), nil
+	}
+	return merr.Success(), nil
+}
+
+func (s *Server) putLoadSchedule(ctx context.Context, req *querypb.OperateLoadScheduleRequest) error {
+	if _, err := s.broker.GetCollectionSchema(ctx, req.GetCollectionID()); err != nil {
+		return err
+	}
+	schedule := &model.LoadSchedule{
+		CollectionID:   req.GetCollectionID(),
+		LoadSpec:       req.GetLoadSpec(),
+		ReleaseSpec:    req.GetReleaseSpec(),
+		Timezone:       req.GetTimezone(),
+		ReplicaNumber:  req.GetReplicaNumber(),
+		ResourceGroups: req.GetResourceGroups(),
+		// only the events after the schedule created are applied
+		LastTriggerTime: time.Now().Unix(),
+	}
+	if schedule.ReplicaNumber == 0 {
+		schedule.ReplicaNumber = 1
+	}
+	return s.meta.PutLoadSchedule(schedule)
+}
+
+var loadScheduleComponent = management.NewComponent[*meta.Meta]("querycoord")
+
+// registerLoadScheduleHandler exposes the load schedules through the management http server,
+// the schedule is put or removed by the authenticated OperateLoadSchedule rpc rather than the management port.
+func registerLoadScheduleHandler(m *meta.Meta) {
+	loadScheduleComponent.Serve(m, &management.Handler{
+		Path:        management.QueryCoordLoadScheduleRouterPath,
+		HandlerFunc: loadScheduleHandler,
+	})
+}
+
+// loadScheduleHandler lists the load schedules, or gets the one of the collection.
+//
+//	GET /querycoord/load/schedule[?collection_id=445566778899]
+func loadScheduleHandler(w http.ResponseWriter, req *http.Request) {
+	if req.Method != http.MethodGet {
+		management.WriteJSON(w, http.StatusMethodNotAllowed, map[string]string{"error": "method not allowed"})
+		return
+	}
+	m, ok := loadScheduleComponent.Get(w)
+	if !ok {
+		return
+	}
+
+	if req.URL.Query().Get("collection_id") == "" {
+		management.WriteJSON(w, http.StatusOK, m.GetLoadSchedules())
+		return
+	}
+	collectionID, err := strconv.ParseInt(req.URL.Query().Get("collection_id"), 10, 64)
+	if err != nil {
+		management.WriteJSON(w, http.StatusBadRequest, map[string]string{"error": "invalid collection id: " + err.Error()})
+		return
+	}
+	schedule := m.GetLoadSchedule(collectionID)
+	if schedule == nil {
+		management.WriteJSON(w, http.StatusNotFound, map[string]string{"error": "no load schedule of collection " + strconv.FormatInt(collectionID, 10)})
+		return
+	}
+	management.WriteJSON(w, http.StatusOK, schedule)
+}
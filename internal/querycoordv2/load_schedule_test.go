@@ -0,0 +1,164 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package querycoordv2
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/milvus-io/milvus-proto/go-api/v2/commonpb"
+	"github.com/milvus-io/milvus-proto/go-api/v2/schemapb"
+	management "github.com/milvus-io/milvus/internal/http"
+	"github.com/milvus-io/milvus/internal/metastore/mocks"
+	"github.com/milvus-io/milvus/internal/metastore/model"
+	"github.com/milvus-io/milvus/internal/proto/querypb"
+	"github.com/milvus-io/milvus/internal/querycoordv2/meta"
+	"github.com/milvus-io/milvus/internal/querycoordv2/params"
+	"github.com/milvus-io/milvus/internal/querycoordv2/session"
+	"github.com/milvus-io/milvus/pkg/util/merr"
+)
+
+func TestServer_OperateLoadSchedule(t *testing.T) {
+	ctx := context.Background()
+	catalog := mocks.NewQueryCoordCatalog(t)
+	catalog.EXPECT().SaveLoadSchedule(mock.Anything).Return(nil).Maybe()
+	catalog.EXPECT().RemoveLoadSchedule(mock.Anything).Return(nil).Maybe()
+	broker := meta.NewMockBroker(t)
+	broker.EXPECT().GetCollectionSchema(mock.Anything, int64(1)).Return(&schemapb.CollectionSchema{}, nil).Maybe()
+	broker.EXPECT().GetCollectionSchema(mock.Anything, int64(2)).Return(nil, merr.WrapErrCollectionNotFound(2)).Maybe()
+	server := &Server{
+		meta:   meta.NewMeta(params.RandomIncrementIDAllocator(), catalog, session.NewNodeManager()),
+		broker: broker,
+	}
+
+	t.Run("not healthy", func(t *testing.T) {
+		server.UpdateStateCode(commonpb.StateCode_Abnormal)
+		status, err := server.OperateLoadSchedule(ctx, &querypb.OperateLoadScheduleRequest{CollectionID: 1})
+		assert.NoError(t, err)
+		assert.ErrorIs(t, merr.Error(status), merr.ErrServiceNotReady)
+	})
+	server.UpdateStateCode(commonpb.StateCode_Healthy)
+
+	t.Run("put", func(t *testing.T) {
+		status, err := server.OperateLoadSchedule(ctx, &querypb.OperateLoadScheduleRequest{
+			CollectionID: 1,
+			OperateType:  querypb.LoadScheduleOperateType_PutLoadSchedule,
+			LoadSpec:     "0 9 * * 1-5",
+			ReleaseSpec:  "0 18 * * 1-5",
+			Timezone:     "UTC",
+		})
+		assert.NoError(t, err)
+		require.True(t, merr.Ok(status))
+		schedule := server.meta.GetLoadSchedule(1)
+		require.NotNil(t, schedule)
+		assert.EqualValues(t, 1, schedule.ReplicaNumber)
+		assert.NotZero(t, schedule.LastTriggerTime)
+
+		status, err = server.OperateLoadSchedule(ctx, &querypb.OperateLoadScheduleRequest{
+			CollectionID: 1,
+			LoadSpec:     "0 9 * *",
+			ReleaseSpec:  "0 18 * * 1-5",
+		})
+		assert.NoError(t, err)
+		assert.ErrorIs(t, merr.Error(status), merr.ErrParameterInvalid)
+
+		status, err = server.OperateLoadSchedule(ctx, &querypb.OperateLoadScheduleRequest{
+			CollectionID: 2,
+			LoadSpec:     "0 9 * * 1-5",
+			ReleaseSpec:  "0 18 * * 1-5",
+		})
+		assert.NoError(t, err)
+		assert.ErrorIs(t, merr.Error(status), merr.ErrCollectionNotFound)
+	})
+
+	t.Run("remove", func(t *testing.T) {
+		status, err := server.OperateLoadSchedule(ctx, &querypb.OperateLoadScheduleRequest{
+			CollectionID: 1,
+			OperateType:  querypb.LoadScheduleOperateType_RemoveLoadSchedule,
+		})
+		assert.NoError(t, err)
+		assert.True(t, merr.Ok(status))
+		assert.Nil(t, server.meta.GetLoadSchedule(1))
+	})
+
+	t.Run("invalid operate type", func(t *testing.T) {
+		status, err := server.OperateLoadSchedule(ctx, &querypb.OperateLoadScheduleRequest{
+			CollectionID: 1,
+			OperateType:  querypb.LoadScheduleOperateType(100),
+		})
+		assert.NoError(t, err)
+		assert.ErrorIs(t, merr.Error(status), merr.ErrParameterInvalid)
+	})
+}
+
+func Test_loadScheduleHandler(t *testing.T) {
+	// the handler serves a standalone component, the one of the querycoord started by other tests is restored after
+	defer func(c *management.Component[*meta.Meta]) { loadScheduleComponent = c }(loadScheduleComponent)
+	loadScheduleComponent = management.NewComponent[*meta.Meta]("querycoord")
+
+	t.Run("not started", func(t *testing.T) {
+		w := httptest.NewRecorder()
+		loadScheduleHandler(w, httptest.NewRequest(http.MethodGet, "/querycoord/load/schedule", nil))
+		assert.Equal(t, http.StatusServiceUnavailable, w.Code)
+	})
+
+	catalog := mocks.NewQueryCoordCatalog(t)
+	catalog.EXPECT().SaveLoadSchedule(mock.Anything).Return(nil).Maybe()
+	testMeta := meta.NewMeta(params.RandomIncrementIDAllocator(), catalog, session.NewNodeManager())
+	require.NoError(t, testMeta.PutLoadSchedule(&model.LoadSchedule{
+		CollectionID:  1,
+		LoadSpec:      "0 9 * * 1-5",
+		ReleaseSpec:   "0 18 * * 1-5",
+		ReplicaNumber: 1,
+	}))
+	loadScheduleComponent.Serve(testMeta)
+
+	t.Run("method not allowed", func(t *testing.T) {
+		w := httptest.NewRecorder()
+		loadScheduleHandler(w, httptest.NewRequest(http.MethodDelete, "/querycoord/load/schedule?collection_id=1", nil))
+		assert.Equal(t, http.StatusMethodNotAllowed, w.Code)
+	})
+
+	t.Run("get", func(t *testing.T) {
+		w := httptest.NewRecorder()
+		loadScheduleHandler(w, httptest.NewRequest(http.MethodGet, "/querycoord/load/schedule", nil))
+		require.Equal(t, http.StatusOK, w.Code)
+		schedules := make([]*model.LoadSchedule, 0)
+		require.NoError(t, json.Unmarshal(w.Body.Bytes(), &schedules))
+		require.Len(t, schedules, 1)
+		assert.Equal(t, "0 9 * * 1-5", schedules[0].LoadSpec)
+
+		w = httptest.NewRecorder()
+		loadScheduleHandler(w, httptest.NewRequest(http.MethodGet, "/querycoord/load/schedule?collection_id=1", nil))
+		assert.Equal(t, http.StatusOK, w.Code)
+
+		w = httptest.NewRecorder()
+		loadScheduleHandler(w, httptest.NewRequest(http.MethodGet, "/querycoord/load/schedule?collection_id=2", nil))
+		assert.Equal(t, http.StatusNotFound, w.Code)
+
+		w = httptest.NewRecorder()
+		loadScheduleHandler(w, httptest.NewRequest(http.MethodGet, "/querycoord/load/schedule?collection_id=a", nil))
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+	})
+}
@@ -30,6 +30,7 @@ import (
 
 	"github.com/milvus-io/milvus/internal/metastore"
 	"github.com/milvus-io/milvus/internal/proto/querypb"
+	"github.com/milvus-io/milvus/pkg/common"
 	"github.com/milvus-io/milvus/pkg/eventlog"
 	"github.com/milvus-io/milvus/pkg/log"
 	"github.com/milvus-io/milvus/pkg/metrics"
@@ -310,6 +311,69 @@ func (m *CollectionManager) GetReplicaNumber(collectionID typeutil.UniqueID) int
 	return -1
 }
 
+// GetLoadPriority returns the load priority of collection, normal if the collection not loaded.
+func (m *CollectionManager) GetLoadPriority(collectionID typeutil.UniqueID) int32 {
+	m.rwmutex.RLock()
+	defer m.rwmutex.RUnlock()
+
+	collection, ok := m.collections[collectionID]
+	if ok {
+		return collection.GetLoadPriority()
+	}
+	return common.LoadPriorityNormal
+}
+
+// UpdateLoadPriority updates the load priority of loaded collection.
+func (m *CollectionManager) UpdateLoadPriority(collectionID typeutil.UniqueID, priority int32) error {
+	m.rwmutex.Lock()
+	defer m.rwmutex.Unlock()
+
+	collection, ok := m.collections[collectionID]
+	if !ok {
+		return merr.WrapErrCollectionNotLoaded(collectionID)
+	}
+	if collection.GetLoadPriority() == priority {
+		return nil
+	}
+	newCollection := collection.Clone()
+	newCollection.LoadPriority = priority
+	return m.putCollection(true, newCollection)
+}
+
+// UpdateReplicaNumber updates the replica number of loaded collection.
+func (m *CollectionManager) UpdateReplicaNumber(collectionID typeutil.UniqueID, replicaNumber int32) error {
+	m.rwmutex.Lock()
+	defer m.rwmutex.Unlock()
+
+	collection, ok := m.collections[collectionID]
+	if !ok {
+		return merr.WrapErrCollectionNotLoaded(collectionID)
+	}
+	if collection.GetReplicaNumber() == replicaNumber {
+		return nil
+	}
+	newCollection := collection.Clone()
+	newCollection.ReplicaNumber = replicaNumber
+	return m.putCollection(true, newCollection)
+}
+
+// UpdateLoadConfig updates the replica number, resource groups and mmap setting of loaded collection,
+// the replicas converge to the new load config later.
+func (m *CollectionManager) UpdateLoadConfig(collectionID typeutil.UniqueID, replicaNumber int32, resourceGroups []string, mmapDisabled bool) error {
+	m.rwmutex.Lock()
+	defer m.rwmutex.Unlock()
+
+	collection, ok := m.collections[collectionID]
+	if !ok {
+		return merr.WrapErrCollectionNotLoaded(collectionID)
+	}
+	newCollection := collection.Clone()
+	newCollection.ReplicaNumber = replicaNumber
+	newCollection.ResourceGroups = resourceGroups
+	newCollection.MmapDisabled = mmapDisabled
+	return m.putCollection(true, newCollection)
+}
+
 // CalculateLoadPercentage checks if collection is currently fully loaded.
 func (m *CollectionManager) CalculateLoadPercentage(collectionID typeutil.UniqueID) int32 {
 	m.rwmutex.RLock()
@@ -33,6 +33,7 @@ import (
 	"github.com/milvus-io/milvus/internal/metastore/kv/querycoord"
 	"github.com/milvus-io/milvus/internal/proto/querypb"
 	. "github.com/milvus-io/milvus/internal/querycoordv2/params"
+	"github.com/milvus-io/milvus/pkg/common"
 	"github.com/milvus-io/milvus/pkg/log"
 	"github.com/milvus-io/milvus/pkg/util/etcd"
 	"github.com/milvus-io/milvus/pkg/util/merr"
@@ -535,6 +536,70 @@ func (suite *CollectionManagerSuite) TestUpdateLoadPercentage() {
 	suite.Equal(querypb.LoadStatus_Loaded, mgr.CalculateLoadStatus(collection.CollectionID))
 }
 
+func (suite *CollectionManagerSuite) TestLoadPriority() {
+	mgr := suite.mgr
+	collection := suite.collections[0]
+
+	suite.Equal(common.LoadPriorityNormal, mgr.GetLoadPriority(collection))
+	suite.NoError(mgr.UpdateLoadPriority(collection, common.LoadPriorityHigh))
+	suite.Equal(common.LoadPriorityHigh, mgr.GetLoadPriority(collection))
+
+	// persisted
+	collections, err := suite.catalog.GetCollections()
+	suite.NoError(err)
+	for _, info := range collections {
+		if info.GetCollectionID() == collection {
+			suite.Equal(common.LoadPriorityHigh, info.GetLoadPriority())
+		}
+	}
+
+	suite.Equal(common.LoadPriorityNormal, mgr.GetLoadPriority(-1))
+	suite.ErrorIs(mgr.UpdateLoadPriority(-1, common.LoadPriorityHigh), merr.ErrCollectionNotLoaded)
+}
+
+func (suite *CollectionManagerSuite) TestUpdateReplicaNumber() {
+	mgr := suite.mgr
+	collection := suite.collections[0]
+
+	suite.NoError(mgr.UpdateReplicaNumber(collection, suite.replicaNumber[0]+1))
+	suite.Equal(suite.replicaNumber[0]+1, mgr.GetReplicaNumber(collection))
+
+	// persisted
+	collections, err := suite.catalog.GetCollections()
+	suite.NoError(err)
+	for _, info := range collections {
+		if info.GetCollectionID() == collection {
+			suite.Equal(suite.replicaNumber[0]+1, info.GetReplicaNumber())
+		}
+	}
+
+	suite.ErrorIs(mgr.UpdateReplicaNumber(-1, 1), merr.ErrCollectionNotLoaded)
+}
+
+func (suite *CollectionManagerSuite) TestUpdateLoadConfig() {
+	mgr := suite.mgr
+	collection := suite.collections[0]
+
+	suite.NoError(mgr.UpdateLoadConfig(collection, 2, []string{"rg1", "rg2"}, true))
+	loaded := mgr.GetCollection(collection)
+	suite.EqualValues(2, loaded.GetReplicaNumber())
+	suite.Equal([]string{"rg1", "rg2"}, loaded.GetResourceGroups())
+	suite.True(loaded.GetMmapDisabled())
+
+	// persisted
+	collections, err := suite.catalog.GetCollections()
+	suite.NoError(err)
+	for _, info := range collections {
+		if info.GetCollectionID() == collection {
+			suite.EqualValues(2, info.GetReplicaNumber())
+			suite.Equal([]string{"rg1", "rg2"}, info.GetResourceGroups())
+			suite.True(info.GetMmapDisabled())
+		}
+	}
+
+	suite.ErrorIs(mgr.UpdateLoadConfig(-1, 1, nil, false), merr.ErrCollectionNotLoaded)
+}
+
 func (suite *CollectionManagerSuite) TestUpgradeRecover() {
 	suite.releaseAll()
 	mgr := suite.mgr
@@ -34,6 +34,8 @@ import (
 	"github.com/milvus-io/milvus/internal/types"
 	"github.com/milvus-io/milvus/pkg/log"
 	"github.com/milvus-io/milvus/pkg/util/commonpbutil"
+	"github.com/milvus-io/milvus/pkg/util/conc"
+	"github.com/milvus-io/milvus/pkg/util/funcutil"
 	"github.com/milvus-io/milvus/pkg/util/merr"
 	"github.com/milvus-io/milvus/pkg/util/paramtable"
 	. "github.com/milvus-io/milvus/pkg/util/typeutil"
@@ -41,6 +43,7 @@ import (
 
 type Broker interface {
 	GetCollectionSchema(ctx context.Context, collectionID UniqueID) (*schemapb.CollectionSchema, error)
+	GetCollectionProperties(ctx context.Context, collectionID UniqueID) (map[string]string, error)
 	GetPartitions(ctx context.Context, collectionID UniqueID) ([]UniqueID, error)
 	GetRecoveryInfo(ctx context.Context, collectionID UniqueID, partitionID UniqueID) ([]*datapb.VchannelInfo, []*datapb.SegmentBinlogs, error)
 	DescribeIndex(ctx context.Context, collectionID UniqueID) ([]*indexpb.IndexInfo, error)
@@ -52,6 +55,23 @@ type Broker interface {
 type CoordinatorBroker struct {
 	dataCoord types.DataCoordClient
 	rootCoord types.RootCoordClient
+
+	// coalesce the identical in-flight requests,
+	// the checkers may ask for the same collection concurrently during recovery
+	describeSF       conc.Singleflight[*milvuspb.DescribeCollectionResponse]
+	partitionsSF     conc.Singleflight[[]UniqueID]
+	recoveryInfoSF   conc.Singleflight[*recoveryInfo]
+	recoveryInfoV2SF conc.Singleflight[*recoveryInfoV2]
+}
+
+type recoveryInfo struct {
+	channels []*datapb.VchannelInfo
+	binlogs  []*datapb.SegmentBinlogs
+}
+
+type recoveryInfoV2 struct {
+	channels []*datapb.VchannelInfo
+	segments []*datapb.SegmentInfo
 }
 
 func NewCoordinatorBroker(
@@ -59,12 +79,51 @@ func NewCoordinatorBroker(
 	rootCoord types.RootCoordClient,
 ) *CoordinatorBroker {
 	return &CoordinatorBroker{
-		dataCoord,
-		rootCoord,
+		dataCoord: dataCoord,
+		rootCoord: rootCoord,
+	}
+}
+
+// coalesce shares one in-flight call among the concurrent callers with the same key.
+// The call runs with a detached context, so the first caller canceled doesn't fail the others,
+// and each caller still returns once its own context is done.
+// Note the result is shared by the callers, which must not modify it.
+func coalesce[T any](ctx context.Context, sf *conc.Singleflight[T], key string, fn func(ctx context.Context) (T, error)) (T, error) {
+	ch := sf.DoChan(key, func() (T, error) {
+		return fn(context.Background())
+	})
+	select {
+	case <-ctx.Done():
+		var zero T
+		return zero, ctx.Err()
+	case result := <-ch:
+		return result.Val, result.Err
 	}
 }
 
 func (broker *CoordinatorBroker) GetCollectionSchema(ctx context.Context, collectionID UniqueID) (*schemapb.CollectionSchema, error) {
+	resp, err := broker.describeCollection(ctx, collectionID)
+	if err != nil {
+		return nil, err
+	}
+	return resp.GetSchema(), nil
+}
+
+func (broker *CoordinatorBroker) GetCollectionProperties(ctx context.Context, collectionID UniqueID) (map[string]string, error) {
+	resp, err := broker.describeCollection(ctx, collectionID)
+	if err != nil {
+		return nil, err
+	}
+	return funcutil.KeyValuePair2Map(resp.GetProperties()), nil
+}
+
+func (broker *CoordinatorBroker) describeCollection(ctx context.Context, collectionID UniqueID) (*milvuspb.DescribeCollectionResponse, error) {
+	return coalesce(ctx, &broker.describeSF, fmt.Sprint(collectionID), func(ctx context.Context) (*milvuspb.DescribeCollectionResponse, error) {
+		return broker.doDescribeCollection(ctx, collectionID)
+	})
+}
+
+func (broker *CoordinatorBroker) doDescribeCollection(ctx context.Context, collectionID UniqueID) (*milvuspb.DescribeCollectionResponse, error) {
 	ctx, cancel := context.WithTimeout(ctx, paramtable.Get().QueryCoordCfg.BrokerTimeout.GetAsDuration(time.Millisecond))
 	defer cancel()
 
@@ -77,13 +136,19 @@ func (broker *CoordinatorBroker) GetCollectionSchema(ctx context.Context, collec
 	}
 	resp, err := broker.rootCoord.DescribeCollection(ctx, req)
 	if err := merr.CheckRPCCall(resp, err); err != nil {
-		log.Ctx(ctx).Warn("failed to get collection schema", zap.Error(err))
+		log.Ctx(ctx).Warn("failed to describe collection", zap.Error(err))
 		return nil, err
 	}
-	return resp.GetSchema(), nil
+	return resp, nil
 }
 
 func (broker *CoordinatorBroker) GetPartitions(ctx context.Context, collectionID UniqueID) ([]UniqueID, error) {
+	return coalesce(ctx, &broker.partitionsSF, fmt.Sprint(collectionID), func(ctx context.Context) ([]UniqueID, error) {
+		return broker.getPartitions(ctx, collectionID)
+	})
+}
+
+func (broker *CoordinatorBroker) getPartitions(ctx context.Context, collectionID UniqueID) ([]UniqueID, error) {
 	ctx, cancel := context.WithTimeout(ctx, paramtable.Get().QueryCoordCfg.BrokerTimeout.GetAsDuration(time.Millisecond))
 	defer cancel()
 	log := log.Ctx(ctx).With(zap.Int64("collectionID", collectionID))
@@ -104,6 +169,21 @@ func (broker *CoordinatorBroker) GetPartitions(ctx context.Context, collectionID
 }
 
 func (broker *CoordinatorBroker) GetRecoveryInfo(ctx context.Context, collectionID UniqueID, partitionID UniqueID) ([]*datapb.VchannelInfo, []*datapb.SegmentBinlogs, error) {
+	key := fmt.Sprintf("%d-%d", collectionID, partitionID)
+	info, err := coalesce(ctx, &broker.recoveryInfoSF, key, func(ctx context.Context) (*recoveryInfo, error) {
+		channels, binlogs, err := broker.getRecoveryInfo(ctx, collectionID, partitionID)
+		if err != nil {
+			return nil, err
+		}
+		return &recoveryInfo{channels: channels, binlogs: binlogs}, nil
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+	return info.channels, info.binlogs, nil
+}
+
+func (broker *CoordinatorBroker) getRecoveryInfo(ctx context.Context, collectionID UniqueID, partitionID UniqueID) ([]*datapb.VchannelInfo, []*datapb.SegmentBinlogs, error) {
 	ctx, cancel := context.WithTimeout(ctx, paramtable.Get().QueryCoordCfg.BrokerTimeout.GetAsDuration(time.Millisecond))
 	defer cancel()
 	log := log.Ctx(ctx).With(
@@ -128,6 +208,21 @@ func (broker *CoordinatorBroker) GetRecoveryInfo(ctx context.Context, collection
 }
 
 func (broker *CoordinatorBroker) GetRecoveryInfoV2(ctx context.Context, collectionID UniqueID, partitionIDs ...UniqueID) ([]*datapb.VchannelInfo, []*datapb.SegmentInfo, error) {
+	key := fmt.Sprintf("%d-%v", collectionID, partitionIDs)
+	info, err := coalesce(ctx, &broker.recoveryInfoV2SF, key, func(ctx context.Context) (*recoveryInfoV2, error) {
+		channels, segments, err := broker.getRecoveryInfoV2(ctx, collectionID, partitionIDs...)
+		if err != nil {
+			return nil, err
+		}
+		return &recoveryInfoV2{channels: channels, segments: segments}, nil
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+	return info.channels, info.segments, nil
+}
+
+func (broker *CoordinatorBroker) getRecoveryInfoV2(ctx context.Context, collectionID UniqueID, partitionIDs ...UniqueID) ([]*datapb.VchannelInfo, []*datapb.SegmentInfo, error) {
 	ctx, cancel := context.WithTimeout(ctx, paramtable.Get().QueryCoordCfg.BrokerTimeout.GetAsDuration(time.Millisecond))
 	defer cancel()
 	log := log.Ctx(ctx).With(
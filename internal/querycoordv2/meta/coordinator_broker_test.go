@@ -18,12 +18,15 @@ package meta
 
 import (
 	"context"
+	"sync"
 	"testing"
+	"time"
 
 	"github.com/cockroachdb/errors"
 	"github.com/samber/lo"
 	"github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/suite"
+	"google.golang.org/grpc"
 
 	"github.com/milvus-io/milvus-proto/go-api/v2/commonpb"
 	"github.com/milvus-io/milvus-proto/go-api/v2/milvuspb"
@@ -32,6 +35,7 @@ import (
 	"github.com/milvus-io/milvus/internal/proto/datapb"
 	"github.com/milvus-io/milvus/internal/proto/indexpb"
 	"github.com/milvus-io/milvus/internal/proto/querypb"
+	"github.com/milvus-io/milvus/pkg/common"
 	"github.com/milvus-io/milvus/pkg/util/merr"
 	"github.com/milvus-io/milvus/pkg/util/paramtable"
 )
@@ -97,6 +101,37 @@ func (s *CoordinatorBrokerRootCoordSuite) TestGetCollectionSchema() {
 	})
 }
 
+func (s *CoordinatorBrokerRootCoordSuite) TestGetCollectionProperties() {
+	ctx := context.Background()
+	collectionID := int64(100)
+
+	s.Run("normal_case", func() {
+		s.rootcoord.EXPECT().DescribeCollection(mock.Anything, mock.Anything).
+			Return(&milvuspb.DescribeCollectionResponse{
+				Status: merr.Status(nil),
+				Properties: []*commonpb.KeyValuePair{
+					{Key: common.CollectionStringPoolKey, Value: "true"},
+				},
+			}, nil)
+
+		properties, err := s.broker.GetCollectionProperties(ctx, collectionID)
+		s.NoError(err)
+		s.True(common.IsCollectionStringPoolEnabled(properties))
+		s.resetMock()
+	})
+
+	s.Run("collection_not_exist", func() {
+		s.rootcoord.EXPECT().DescribeCollection(mock.Anything, mock.Anything).
+			Return(&milvuspb.DescribeCollectionResponse{
+				Status: merr.Status(merr.WrapErrCollectionNotFound(collectionID)),
+			}, nil)
+
+		_, err := s.broker.GetCollectionProperties(ctx, collectionID)
+		s.ErrorIs(err, merr.ErrCollectionNotFound)
+		s.resetMock()
+	})
+}
+
 func (s *CoordinatorBrokerRootCoordSuite) TestGetPartitions() {
 	ctx := context.Background()
 	ctx, cancel := context.WithCancel(ctx)
@@ -128,6 +163,75 @@ func (s *CoordinatorBrokerRootCoordSuite) TestGetPartitions() {
 	})
 }
 
+func (s *CoordinatorBrokerRootCoordSuite) TestCoalesceRequests() {
+	ctx := context.Background()
+	collection := int64(100)
+	partitions := []int64{10, 11, 12}
+
+	s.Run("share_inflight_call", func() {
+		block := make(chan struct{})
+		s.rootcoord.EXPECT().ShowPartitions(mock.Anything, mock.Anything).RunAndReturn(
+			func(ctx context.Context, req *milvuspb.ShowPartitionsRequest, opts ...grpc.CallOption) (*milvuspb.ShowPartitionsResponse, error) {
+				<-block
+				return &milvuspb.ShowPartitionsResponse{
+					Status:       merr.Success(),
+					PartitionIDs: partitions,
+				}, nil
+			}).Once()
+
+		wg := sync.WaitGroup{}
+		for i := 0; i < 10; i++ {
+			wg.Add(1)
+			go func() {
+				defer wg.Done()
+				retPartitions, err := s.broker.GetPartitions(ctx, collection)
+				s.NoError(err)
+				s.ElementsMatch(partitions, retPartitions)
+			}()
+		}
+		// wait all callers joined the in-flight call
+		time.Sleep(100 * time.Millisecond)
+		close(block)
+		wg.Wait()
+		s.resetMock()
+	})
+
+	s.Run("caller_canceled", func() {
+		block := make(chan struct{})
+		s.rootcoord.EXPECT().ShowPartitions(mock.Anything, mock.Anything).RunAndReturn(
+			func(ctx context.Context, req *milvuspb.ShowPartitionsRequest, opts ...grpc.CallOption) (*milvuspb.ShowPartitionsResponse, error) {
+				<-block
+				return &milvuspb.ShowPartitionsResponse{
+					Status:       merr.Success(),
+					PartitionIDs: partitions,
+				}, nil
+			}).Once()
+
+		canceledCtx, cancel := context.WithCancel(ctx)
+		done := make(chan struct{})
+		go func() {
+			defer close(done)
+			_, err := s.broker.GetPartitions(canceledCtx, collection)
+			s.ErrorIs(err, context.Canceled)
+		}()
+		time.Sleep(50 * time.Millisecond)
+		cancel()
+		<-done
+
+		// the shared call is not affected by the canceled caller
+		result := make(chan []int64, 1)
+		go func() {
+			retPartitions, err := s.broker.GetPartitions(ctx, collection)
+			s.NoError(err)
+			result <- retPartitions
+		}()
+		time.Sleep(50 * time.Millisecond)
+		close(block)
+		s.ElementsMatch(partitions, <-result)
+		s.resetMock()
+	})
+}
+
 type CoordinatorBrokerDataCoordSuite struct {
 	suite.Suite
 
@@ -32,6 +32,10 @@ type LeaderView struct {
 	Segments        map[int64]*querypb.SegmentDist
 	GrowingSegments map[int64]*Segment
 	TargetVersion   int64
+	// the resource cost of the delegator reported by QueryNode
+	NumOfGrowingRows      int64
+	NumOfDeleteBufferRows int64
+	DeleteForwardRate     float64
 }
 
 func (view *LeaderView) Clone() *LeaderView {
@@ -53,6 +57,10 @@ func (view *LeaderView) Clone() *LeaderView {
 		Segments:        segments,
 		GrowingSegments: growings,
 		TargetVersion:   view.TargetVersion,
+
+		NumOfGrowingRows:      view.NumOfGrowingRows,
+		NumOfDeleteBufferRows: view.NumOfDeleteBufferRows,
+		DeleteForwardRate:     view.DeleteForwardRate,
 	}
 }
 
@@ -0,0 +1,168 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package meta
+
+import (
+	"sync"
+	"time"
+
+	"go.uber.org/zap"
+
+	"github.com/milvus-io/milvus/internal/metastore"
+	"github.com/milvus-io/milvus/internal/metastore/model"
+	"github.com/milvus-io/milvus/pkg/log"
+	"github.com/milvus-io/milvus/pkg/util/cronutil"
+	"github.com/milvus-io/milvus/pkg/util/merr"
+	"github.com/milvus-io/milvus/pkg/util/typeutil"
+)
+
+// LoadScheduleSpecs is the parsed specs of the load schedule.
+type LoadScheduleSpecs struct {
+	Load     *cronutil.Schedule
+	Release  *cronutil.Schedule
+	Location *time.Location
+}
+
+// ParseLoadSchedule parses the cron specs and the timezone of the load schedule,
+// the specs are in the local timezone of QueryCoord if the timezone is not specified.
+func ParseLoadSchedule(schedule *model.LoadSchedule) (*LoadScheduleSpecs, error) {
+	load, err := cronutil.Parse(schedule.LoadSpec)
+	if err != nil {
+		return nil, merr.WrapErrParameterInvalidMsg("invalid load spec: %s", err.Error())
+	}
+	release, err := cronutil.Parse(schedule.ReleaseSpec)
+	if err != nil {
+		return nil, merr.WrapErrParameterInvalidMsg("invalid release spec: %s", err.Error())
+	}
+	location := time.Local
+	if schedule.Timezone != "" {
+		location, err = time.LoadLocation(schedule.Timezone)
+		if err != nil {
+			return nil, merr.WrapErrParameterInvalidMsg("invalid timezone %s: %s", schedule.Timezone, err.Error())
+		}
+	}
+	return &LoadScheduleSpecs{
+		Load:     load,
+		Release:  release,
+		Location: location,
+	}, nil
+}
+
+// LoadScheduleManager manages the calendars to load and release the collections periodically.
+type LoadScheduleManager struct {
+	rwmutex sync.RWMutex
+
+	schedules map[typeutil.UniqueID]*model.LoadSchedule
+	catalog   metastore.QueryCoordCatalog
+}
+
+func NewLoadScheduleManager(catalog metastore.QueryCoordCatalog) *LoadScheduleManager {
+	return &LoadScheduleManager{
+		schedules: make(map[typeutil.UniqueID]*model.LoadSchedule),
+		catalog:   catalog,
+	}
+}
+
+// Recover recovers the load schedules from kv store.
+func (m *LoadScheduleManager) Recover() error {
+	m.rwmutex.Lock()
+	defer m.rwmutex.Unlock()
+
+	schedules, err := m.catalog.GetLoadSchedules()
+	if err != nil {
+		return err
+	}
+	for _, schedule := range schedules {
+		m.schedules[schedule.CollectionID] = schedule
+	}
+	log.Info("recover load schedules", zap.Int("num", len(schedules)))
+	return nil
+}
+
+// PutLoadSchedule creates or overwrites the load schedule of the collection, the specs are verified before saved.
+func (m *LoadScheduleManager) PutLoadSchedule(schedule *model.LoadSchedule) error {
+	if _, err := ParseLoadSchedule(schedule); err != nil {
+		return err
+	}
+	if schedule.ReplicaNumber <= 0 {
+		return merr.WrapErrParameterInvalidMsg("replica number must be positive, got %d", schedule.ReplicaNumber)
+	}
+
+	m.rwmutex.Lock()
+	defer m.rwmutex.Unlock()
+	return m.putLoadSchedule(schedule.Clone())
+}
+
+func (m *LoadScheduleManager) putLoadSchedule(schedule *model.LoadSchedule) error {
+	if err := m.catalog.SaveLoadSchedule(schedule); err != nil {
+		return err
+	}
+	m.schedules[schedule.CollectionID] = schedule
+	return nil
+}
+
+// UpdateLastTriggerTime records the time of the latest load or release event applied.
+func (m *LoadScheduleManager) UpdateLastTriggerTime(collectionID typeutil.UniqueID, triggerTime time.Time) error {
+	m.rwmutex.Lock()
+	defer m.rwmutex.Unlock()
+
+	schedule, ok := m.schedules[collectionID]
+	if !ok {
+		return merr.WrapErrParameterInvalidMsg("no load schedule of collection %d", collectionID)
+	}
+	schedule = schedule.Clone()
+	schedule.LastTriggerTime = triggerTime.Unix()
+	return m.putLoadSchedule(schedule)
+}
+
+// RemoveLoadSchedule removes the load schedule of the collection, it's no-op if no schedule.
+func (m *LoadScheduleManager) RemoveLoadSchedule(collectionID typeutil.UniqueID) error {
+	m.rwmutex.Lock()
+	defer m.rwmutex.Unlock()
+
+	if _, ok := m.schedules[collectionID]; !ok {
+		return nil
+	}
+	if err := m.catalog.RemoveLoadSchedule(collectionID); err != nil {
+		return err
+	}
+	delete(m.schedules, collectionID)
+	return nil
+}
+
+// GetLoadSchedule returns the load schedule of the collection, nil if no schedule.
+func (m *LoadScheduleManager) GetLoadSchedule(collectionID typeutil.UniqueID) *model.LoadSchedule {
+	m.rwmutex.RLock()
+	defer m.rwmutex.RUnlock()
+
+	if schedule, ok := m.schedules[collectionID]; ok {
+		return schedule.Clone()
+	}
+	return nil
+}
+
+// GetLoadSchedules returns all the load schedules.
+func (m *LoadScheduleManager) GetLoadSchedules() []*model.LoadSchedule {
+	m.rwmutex.RLock()
+	defer m.rwmutex.RUnlock()
+
+	ret := make([]*model.LoadSchedule, 0, len(m.schedules))
+	for _, schedule := range m.schedules {
+		ret = append(ret, schedule.Clone())
+	}
+	return ret
+}
@@ -0,0 +1,135 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package meta
+
+import (
+	"testing"
+	"time"
+
+	"github.com/cockroachdb/errors"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/milvus-io/milvus/internal/metastore/mocks"
+	"github.com/milvus-io/milvus/internal/metastore/model"
+	"github.com/milvus-io/milvus/pkg/util/merr"
+)
+
+type LoadScheduleManagerSuite struct {
+	suite.Suite
+
+	catalog *mocks.QueryCoordCatalog
+	manager *LoadScheduleManager
+}
+
+func (suite *LoadScheduleManagerSuite) SetupTest() {
+	suite.catalog = mocks.NewQueryCoordCatalog(suite.T())
+	suite.manager = NewLoadScheduleManager(suite.catalog)
+}
+
+func (suite *LoadScheduleManagerSuite) TestParseLoadSchedule() {
+	specs, err := ParseLoadSchedule(&model.LoadSchedule{
+		LoadSpec:    "0 9 * * 1-5",
+		ReleaseSpec: "0 18 * * 1-5",
+		Timezone:    "UTC",
+	})
+	suite.NoError(err)
+	suite.Equal(time.UTC, specs.Location)
+
+	specs, err = ParseLoadSchedule(&model.LoadSchedule{
+		LoadSpec:    "0 9 * * 1-5",
+		ReleaseSpec: "0 18 * * 1-5",
+	})
+	suite.NoError(err)
+	suite.Equal(time.Local, specs.Location)
+
+	_, err = ParseLoadSchedule(&model.LoadSchedule{
+		LoadSpec:    "0 9 * *",
+		ReleaseSpec: "0 18 * * 1-5",
+	})
+	suite.ErrorIs(err, merr.ErrParameterInvalid)
+
+	_, err = ParseLoadSchedule(&model.LoadSchedule{
+		LoadSpec:    "0 9 * * 1-5",
+		ReleaseSpec: "0 25 * * 1-5",
+	})
+	suite.ErrorIs(err, merr.ErrParameterInvalid)
+
+	_, err = ParseLoadSchedule(&model.LoadSchedule{
+		LoadSpec:    "0 9 * * 1-5",
+		ReleaseSpec: "0 18 * * 1-5",
+		Timezone:    "Mars/Olympus",
+	})
+	suite.ErrorIs(err, merr.ErrParameterInvalid)
+}
+
+func (suite *LoadScheduleManagerSuite) TestRecover() {
+	suite.catalog.EXPECT().GetLoadSchedules().Return([]*model.LoadSchedule{
+		{CollectionID: 1, LoadSpec: "0 9 * * *", ReleaseSpec: "0 18 * * *", ReplicaNumber: 1},
+		{CollectionID: 2, LoadSpec: "0 9 * * *", ReleaseSpec: "0 18 * * *", ReplicaNumber: 2},
+	}, nil).Once()
+	suite.NoError(suite.manager.Recover())
+	suite.Len(suite.manager.GetLoadSchedules(), 2)
+	suite.EqualValues(2, suite.manager.GetLoadSchedule(2).ReplicaNumber)
+
+	suite.catalog.EXPECT().GetLoadSchedules().Return(nil, errors.New("mock")).Once()
+	suite.Error(suite.manager.Recover())
+}
+
+func (suite *LoadScheduleManagerSuite) TestPutAndRemove() {
+	schedule := &model.LoadSchedule{
+		CollectionID:  1,
+		LoadSpec:      "0 9 * * 1-5",
+		ReleaseSpec:   "0 18 * * 1-5",
+		ReplicaNumber: 1,
+	}
+	suite.catalog.EXPECT().SaveLoadSchedule(mock.Anything).Return(nil)
+	suite.NoError(suite.manager.PutLoadSchedule(schedule))
+
+	// the schedule is cloned
+	schedule.ReplicaNumber = 3
+	suite.EqualValues(1, suite.manager.GetLoadSchedule(1).ReplicaNumber)
+
+	// invalid schedules are rejected
+	suite.ErrorIs(suite.manager.PutLoadSchedule(&model.LoadSchedule{
+		CollectionID:  2,
+		LoadSpec:      "invalid",
+		ReleaseSpec:   "0 18 * * 1-5",
+		ReplicaNumber: 1,
+	}), merr.ErrParameterInvalid)
+	suite.ErrorIs(suite.manager.PutLoadSchedule(&model.LoadSchedule{
+		CollectionID: 2,
+		LoadSpec:     "0 9 * * 1-5",
+		ReleaseSpec:  "0 18 * * 1-5",
+	}), merr.ErrParameterInvalid)
+	suite.Nil(suite.manager.GetLoadSchedule(2))
+
+	now := time.Now()
+	suite.NoError(suite.manager.UpdateLastTriggerTime(1, now))
+	suite.Equal(now.Unix(), suite.manager.GetLoadSchedule(1).LastTriggerTime)
+	suite.Error(suite.manager.UpdateLastTriggerTime(2, now))
+
+	suite.catalog.EXPECT().RemoveLoadSchedule(int64(1)).Return(nil).Once()
+	suite.NoError(suite.manager.RemoveLoadSchedule(1))
+	suite.Nil(suite.manager.GetLoadSchedule(1))
+	// no-op for the collection without schedule
+	suite.NoError(suite.manager.RemoveLoadSchedule(1))
+}
+
+func TestLoadScheduleManager(t *testing.T) {
+	suite.Run(t, new(LoadScheduleManagerSuite))
+}
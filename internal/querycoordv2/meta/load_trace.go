@@ -0,0 +1,87 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package meta
+
+import (
+	"context"
+	"sync"
+
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// GlobalLoadTraces records the root spans of the loading collections,
+// the spans of the segment load tasks are linked to them, so slow loads could be attributed to the right stage.
+var GlobalLoadTraces = NewLoadTraces()
+
+type LoadTraces struct {
+	mu sync.RWMutex
+	// CollectionID -> root span of the load job
+	spans map[int64]trace.Span
+}
+
+func NewLoadTraces() *LoadTraces {
+	return &LoadTraces{
+		spans: make(map[int64]trace.Span),
+	}
+}
+
+// Start starts the root span of loading the collection,
+// the previous one of the same collection is ended as the collection is loaded again.
+func (l *LoadTraces) Start(ctx context.Context, collectionID int64, name string) {
+	_, span := otel.Tracer("QueryCoord").Start(ctx, name,
+		trace.WithAttributes(attribute.Int64("collectionID", collectionID)),
+	)
+
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	if old, ok := l.spans[collectionID]; ok {
+		old.End()
+	}
+	l.spans[collectionID] = span
+}
+
+// Context returns the context whose span is the root span of loading the collection,
+// returns the given one if the collection is not loading.
+func (l *LoadTraces) Context(ctx context.Context, collectionID int64) context.Context {
+	l.mu.RLock()
+	defer l.mu.RUnlock()
+	span, ok := l.spans[collectionID]
+	if !ok {
+		return ctx
+	}
+	return trace.ContextWithSpan(ctx, span)
+}
+
+// End ends the root span of loading the collection, the error is recorded if the load failed.
+func (l *LoadTraces) End(collectionID int64, err error) {
+	l.mu.Lock()
+	span, ok := l.spans[collectionID]
+	delete(l.spans, collectionID)
+	l.mu.Unlock()
+
+	if !ok {
+		return
+	}
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+	}
+	span.End()
+}
@@ -0,0 +1,88 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package meta
+
+import (
+	"context"
+	"testing"
+
+	"github.com/cockroachdb/errors"
+	"github.com/stretchr/testify/suite"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/codes"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+	"go.opentelemetry.io/otel/trace"
+)
+
+type LoadTracesSuite struct {
+	suite.Suite
+
+	recorder *tracetest.SpanRecorder
+	provider trace.TracerProvider
+}
+
+func (suite *LoadTracesSuite) SetupTest() {
+	suite.provider = otel.GetTracerProvider()
+	suite.recorder = tracetest.NewSpanRecorder()
+	otel.SetTracerProvider(sdktrace.NewTracerProvider(sdktrace.WithSpanProcessor(suite.recorder)))
+}
+
+func (suite *LoadTracesSuite) TearDownTest() {
+	otel.SetTracerProvider(suite.provider)
+}
+
+func (suite *LoadTracesSuite) TestLoadTraces() {
+	ctx := context.Background()
+	traces := NewLoadTraces()
+
+	// not loading
+	suite.Equal(ctx, traces.Context(ctx, 1))
+
+	traces.Start(ctx, 1, "QueryCoord-LoadCollection")
+	_, span := otel.Tracer("QueryCoord").Start(traces.Context(ctx, 1), "QueryCoord-BaseTask")
+	span.End()
+	traces.End(1, nil)
+	// ended twice
+	traces.End(1, nil)
+
+	spans := suite.recorder.Ended()
+	suite.Len(spans, 2)
+	suite.Equal("QueryCoord-BaseTask", spans[0].Name())
+	suite.Equal("QueryCoord-LoadCollection", spans[1].Name())
+	suite.Equal(spans[1].SpanContext().SpanID(), spans[0].Parent().SpanID())
+	suite.Equal(ctx, traces.Context(ctx, 1))
+}
+
+func (suite *LoadTracesSuite) TestLoadAgain() {
+	ctx := context.Background()
+	traces := NewLoadTraces()
+
+	traces.Start(ctx, 1, "QueryCoord-LoadCollection")
+	traces.Start(ctx, 1, "QueryCoord-LoadPartitions")
+	suite.Len(suite.recorder.Ended(), 1)
+
+	traces.End(1, errors.New("load timeout"))
+	spans := suite.recorder.Ended()
+	suite.Len(spans, 2)
+	suite.Equal("QueryCoord-LoadPartitions", spans[1].Name())
+	suite.Equal(codes.Error, spans[1].Status().Code)
+}
+
+func TestLoadTraces(t *testing.T) {
+	suite.Run(t, new(LoadTracesSuite))
+}
@@ -25,6 +25,7 @@ type Meta struct {
 	*CollectionManager
 	*ReplicaManager
 	*ResourceManager
+	*LoadScheduleManager
 }
 
 func NewMeta(
@@ -36,5 +37,6 @@ func NewMeta(
 		NewCollectionManager(catalog),
 		NewReplicaManager(idAllocator, catalog),
 		NewResourceManager(catalog, nodeMgr),
+		NewLoadScheduleManager(catalog),
 	}
 }
@@ -83,6 +83,61 @@ func (_c *MockBroker_DescribeIndex_Call) RunAndReturn(run func(context.Context,
 	return _c
 }
 
+// GetCollectionProperties provides a mock function with given fields: ctx, collectionID
+func (_m *MockBroker) GetCollectionProperties(ctx context.Context, collectionID int64) (map[string]string, error) {
+	ret := _m.Called(ctx, collectionID)
+
+	var r0 map[string]string
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, int64) (map[string]string, error)); ok {
+		return rf(ctx, collectionID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int64) map[string]string); ok {
+		r0 = rf(ctx, collectionID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(map[string]string)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int64) error); ok {
+		r1 = rf(ctx, collectionID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockBroker_GetCollectionProperties_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetCollectionProperties'
+type MockBroker_GetCollectionProperties_Call struct {
+	*mock.Call
+}
+
+// GetCollectionProperties is a helper method to define mock.On call
+//   - ctx context.Context
+//   - collectionID int64
+func (_e *MockBroker_Expecter) GetCollectionProperties(ctx interface{}, collectionID interface{}) *MockBroker_GetCollectionProperties_Call {
+	return &MockBroker_GetCollectionProperties_Call{Call: _e.mock.On("GetCollectionProperties", ctx, collectionID)}
+}
+
+func (_c *MockBroker_GetCollectionProperties_Call) Run(run func(ctx context.Context, collectionID int64)) *MockBroker_GetCollectionProperties_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int64))
+	})
+	return _c
+}
+
+func (_c *MockBroker_GetCollectionProperties_Call) Return(_a0 map[string]string, _a1 error) *MockBroker_GetCollectionProperties_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockBroker_GetCollectionProperties_Call) RunAndReturn(run func(context.Context, int64) (map[string]string, error)) *MockBroker_GetCollectionProperties_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // GetCollectionSchema provides a mock function with given fields: ctx, collectionID
 func (_m *MockBroker) GetCollectionSchema(ctx context.Context, collectionID int64) (*schemapb.CollectionSchema, error) {
 	ret := _m.Called(ctx, collectionID)
@@ -223,6 +223,22 @@ func (m *ReplicaManager) RemoveCollection(collectionID typeutil.UniqueID) error
 	return nil
 }
 
+// RemoveReplicas removes the given replicas of collection,
+// returns error if failed to remove replica from KV
+func (m *ReplicaManager) RemoveReplicas(collectionID typeutil.UniqueID, replicas ...typeutil.UniqueID) error {
+	m.rwmutex.Lock()
+	defer m.rwmutex.Unlock()
+
+	for _, id := range replicas {
+		err := m.catalog.ReleaseReplica(collectionID, id)
+		if err != nil {
+			return err
+		}
+		delete(m.replicas, id)
+	}
+	return nil
+}
+
 func (m *ReplicaManager) GetByCollection(collectionID typeutil.UniqueID) []*Replica {
 	m.rwmutex.RLock()
 	defer m.rwmutex.RUnlock()
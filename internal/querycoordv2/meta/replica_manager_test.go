@@ -166,6 +166,23 @@ func (suite *ReplicaManagerSuite) TestRemove() {
 	}
 }
 
+func (suite *ReplicaManagerSuite) TestRemoveReplicas() {
+	mgr := suite.mgr
+
+	collection := suite.collections[2]
+	replicas := mgr.GetByCollection(collection)
+	suite.Len(replicas, 3)
+	suite.NoError(mgr.RemoveReplicas(collection, replicas[0].GetID(), replicas[1].GetID()))
+	suite.Nil(mgr.Get(replicas[0].GetID()))
+	suite.Len(mgr.GetByCollection(collection), 1)
+
+	// Check whether the replicas are also removed from meta store
+	mgr.Recover(suite.collections)
+	remained := mgr.GetByCollection(collection)
+	suite.Len(remained, 1)
+	suite.Equal(replicas[2].GetID(), remained[0].GetID())
+}
+
 func (suite *ReplicaManagerSuite) TestNodeManipulate() {
 	mgr := suite.mgr
 
@@ -218,7 +218,10 @@ func (mgr *TargetManager) PullNextTargetV2(broker Broker, collectionID int64, ch
 		return nil
 	}
 
-	err := retry.Do(context.TODO(), getRecoveryInfo, retry.Attempts(10))
+	// the dropped collection never recovers, no need to retry
+	err := retry.Do(context.TODO(), getRecoveryInfo, retry.Attempts(10),
+		retry.FatalOn(retry.ErrorIs(merr.ErrCollectionNotFound)),
+		retry.CallSite("querycoord.PullNextTarget"))
 	if err != nil {
 		return nil, nil, err
 	}
@@ -31,6 +31,7 @@ import (
 	"github.com/milvus-io/milvus/internal/querycoordv2/utils"
 	"github.com/milvus-io/milvus/pkg/eventlog"
 	"github.com/milvus-io/milvus/pkg/log"
+	"github.com/milvus-io/milvus/pkg/util/merr"
 )
 
 type CollectionObserver struct {
@@ -119,6 +120,7 @@ func (ob *CollectionObserver) observeTimeout() {
 		ob.meta.CollectionManager.RemoveCollection(collection.GetCollectionID())
 		ob.meta.ReplicaManager.RemoveCollection(collection.GetCollectionID())
 		ob.targetMgr.RemoveCollection(collection.GetCollectionID())
+		meta.GlobalLoadTraces.End(collection.GetCollectionID(), merr.WrapErrCollectionNotLoaded(collection.GetCollectionID(), "load timeout"))
 	}
 
 	partitions := utils.GroupPartitionsByCollection(ob.meta.CollectionManager.GetAllPartitions())
@@ -143,6 +145,7 @@ func (ob *CollectionObserver) observeTimeout() {
 			ob.meta.CollectionManager.RemoveCollection(collection)
 			ob.meta.ReplicaManager.RemoveCollection(collection)
 			ob.targetMgr.RemoveCollection(collection)
+			meta.GlobalLoadTraces.End(collection, merr.WrapErrCollectionNotLoaded(collection, "load timeout"))
 		}
 	}
 }
@@ -241,6 +244,9 @@ func (ob *CollectionObserver) observePartitionLoadStatus(ctx context.Context, pa
 	if err != nil {
 		log.Warn("failed to update load percentage")
 	}
+	if collectionPercentage == 100 {
+		meta.GlobalLoadTraces.End(partition.GetCollectionID(), nil)
+	}
 	log.Info("load status updated",
 		zap.Int32("partitionLoadPercentage", loadPercentage),
 		zap.Int32("collectionLoadPercentage", collectionPercentage),
@@ -0,0 +1,255 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package observers
+
+import (
+	"context"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/samber/lo"
+	"go.uber.org/zap"
+
+	"github.com/milvus-io/milvus/internal/proto/querypb"
+	"github.com/milvus-io/milvus/internal/querycoordv2/meta"
+	"github.com/milvus-io/milvus/internal/querycoordv2/params"
+	"github.com/milvus-io/milvus/internal/querycoordv2/utils"
+	"github.com/milvus-io/milvus/pkg/log"
+)
+
+// LoadConfigObserver converges the replicas of loaded collections to their updated load configs incrementally.
+// The lacking replicas are spawned first, the surplus replicas are removed one by one only after all replicas
+// of the collection are serviceable, so the collection keeps serving during the convergence.
+type LoadConfigObserver struct {
+	cancel    context.CancelFunc
+	wg        sync.WaitGroup
+	meta      *meta.Meta
+	dist      *meta.DistributionManager
+	targetMgr *meta.TargetManager
+
+	stopOnce sync.Once
+}
+
+func NewLoadConfigObserver(meta *meta.Meta, dist *meta.DistributionManager, targetMgr *meta.TargetManager) *LoadConfigObserver {
+	return &LoadConfigObserver{
+		meta:      meta,
+		dist:      dist,
+		targetMgr: targetMgr,
+	}
+}
+
+func (ob *LoadConfigObserver) Start() {
+	ctx, cancel := context.WithCancel(context.Background())
+	ob.cancel = cancel
+
+	ob.wg.Add(1)
+	go ob.schedule(ctx)
+}
+
+func (ob *LoadConfigObserver) Stop() {
+	ob.stopOnce.Do(func() {
+		if ob.cancel != nil {
+			ob.cancel()
+		}
+		ob.wg.Wait()
+	})
+}
+
+func (ob *LoadConfigObserver) schedule(ctx context.Context) {
+	defer ob.wg.Done()
+	log.Info("Start load config observer loop")
+
+	ticker := time.NewTicker(params.Params.QueryCoordCfg.LoadConfigCheckInterval.GetAsDuration(time.Second))
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			log.Info("Close load config observer")
+			return
+
+		case <-ticker.C:
+			ob.check()
+		}
+	}
+}
+
+func (ob *LoadConfigObserver) check() {
+	for _, collection := range ob.meta.CollectionManager.GetAllCollections() {
+		// the collections never updated their load configs are placed by the load requests, don't touch them
+		if collection.GetStatus() != querypb.LoadStatus_Loaded || len(collection.GetResourceGroups()) == 0 {
+			continue
+		}
+		ob.checkCollection(collection)
+	}
+}
+
+func (ob *LoadConfigObserver) checkCollection(collection *meta.Collection) {
+	collectionID := collection.GetCollectionID()
+	log := log.With(zap.Int64("collectionID", collectionID)).WithRateGroup("qcv2.loadConfigObserver", 1, 60)
+
+	expected, err := utils.ReplicaNumberByRG(collection.GetReplicaNumber(), collection.GetResourceGroups())
+	if err != nil {
+		log.RatedWarn(10, "invalid load config", zap.Error(err))
+		return
+	}
+	replicas := ob.meta.ReplicaManager.GetByCollection(collectionID)
+	current := lo.GroupBy(replicas, func(replica *meta.Replica) string { return replica.GetResourceGroup() })
+
+	lacking := false
+	for rgName, num := range expected {
+		if len(current[rgName]) >= num {
+			continue
+		}
+		lacking = true
+		if err := ob.spawnReplica(collectionID, rgName, current[rgName]); err != nil {
+			log.RatedWarn(10, "failed to spawn replica for load config",
+				zap.String("resourceGroup", rgName),
+				zap.Error(err))
+		}
+	}
+	// the surplus replicas keep serving until the spawned ones loaded
+	if lacking || !ob.allReplicasServiceable(collectionID, replicas) {
+		return
+	}
+
+	rgNames := lo.Keys(current)
+	sort.Strings(rgNames)
+	for _, rgName := range rgNames {
+		if len(current[rgName]) > expected[rgName] {
+			if err := ob.removeReplica(collectionID, current[rgName]); err != nil {
+				log.RatedWarn(10, "failed to remove replica for load config",
+					zap.String("resourceGroup", rgName),
+					zap.Error(err))
+			}
+			// remove one replica each round, the others keep serving
+			return
+		}
+	}
+}
+
+// spawnReplica spawns a replica in the resource group, the nodes of it come from the nodes
+// not in any replica of collection, then the replicas of collection in the same resource group with most nodes.
+func (ob *LoadConfigObserver) spawnReplica(collectionID int64, rgName string, replicas []*meta.Replica) error {
+	nodes, err := ob.meta.ResourceManager.GetNodes(rgName)
+	if err != nil {
+		return err
+	}
+	if len(nodes) < len(replicas)+1 {
+		return meta.ErrNodeNotEnough
+	}
+
+	newReplicas, err := ob.meta.ReplicaManager.Spawn(collectionID, 1, rgName)
+	if err != nil {
+		return err
+	}
+	newReplica := newReplicas[0]
+
+	expected := len(nodes) / (len(replicas) + 1)
+	for _, node := range nodes {
+		if ob.meta.ReplicaManager.GetByCollectionAndNode(collectionID, node) == nil {
+			newReplica.AddNode(node)
+		}
+	}
+
+	// donate nodes from the replicas with most nodes, and keep at least one node in each replica
+	donors := make(map[int64][]int64)
+	sizes := lo.SliceToMap(replicas, func(r *meta.Replica) (int64, int) { return r.GetID(), r.Len() })
+	for newReplica.Len() < expected && len(replicas) > 0 {
+		sort.Slice(replicas, func(i, j int) bool {
+			return sizes[replicas[i].GetID()] > sizes[replicas[j].GetID()]
+		})
+		donor := replicas[0]
+		if sizes[donor.GetID()] <= 1 {
+			break
+		}
+		node, _ := lo.Find(donor.GetNodes(), func(node int64) bool {
+			return !lo.Contains(donors[donor.GetID()], node)
+		})
+		donors[donor.GetID()] = append(donors[donor.GetID()], node)
+		sizes[donor.GetID()]--
+		newReplica.AddNode(node)
+	}
+	if newReplica.Len() == 0 {
+		return meta.ErrNodeNotEnough
+	}
+
+	if err := ob.meta.ReplicaManager.Put(newReplica); err != nil {
+		return err
+	}
+	for replicaID, nodes := range donors {
+		if err := ob.meta.ReplicaManager.RemoveNode(replicaID, nodes...); err != nil {
+			log.Warn("failed to remove nodes from replica for load config",
+				zap.Int64("collectionID", collectionID),
+				zap.Int64("replicaID", replicaID),
+				zap.Int64s("nodes", nodes),
+				zap.Error(err))
+		}
+	}
+	log.Info("spawn replica for load config",
+		zap.Int64("collectionID", collectionID),
+		zap.String("resourceGroup", rgName),
+		zap.Int64("replicaID", newReplica.GetID()),
+		zap.Int64s("nodes", newReplica.GetNodes()),
+	)
+	return nil
+}
+
+// removeReplica removes the replica with least nodes in the resource group,
+// and assigns its nodes to the other replicas of collection in the same resource group.
+func (ob *LoadConfigObserver) removeReplica(collectionID int64, replicas []*meta.Replica) error {
+	sort.Slice(replicas, func(i, j int) bool {
+		return replicas[i].Len() < replicas[j].Len()
+	})
+	removed, remained := replicas[0], replicas[1:]
+	if err := ob.meta.ReplicaManager.RemoveReplicas(collectionID, removed.GetID()); err != nil {
+		return err
+	}
+	for _, node := range removed.GetNodes() {
+		utils.AddNodesToReplicas(ob.meta, remained, node)
+	}
+	log.Info("remove replica for load config",
+		zap.Int64("collectionID", collectionID),
+		zap.String("resourceGroup", removed.GetResourceGroup()),
+		zap.Int64("replicaID", removed.GetID()),
+		zap.Int64s("nodes", removed.GetNodes()),
+	)
+	return nil
+}
+
+// allReplicasServiceable returns true if every replica has the leaders of all channels in the current target,
+// and the leaders serve all sealed segments in the current target.
+func (ob *LoadConfigObserver) allReplicasServiceable(collectionID int64, replicas []*meta.Replica) bool {
+	channels := ob.targetMgr.GetDmChannelsByCollection(collectionID, meta.CurrentTarget)
+	if len(channels) == 0 {
+		return false
+	}
+	for _, replica := range replicas {
+		for channelName := range channels {
+			leader := ob.dist.LeaderViewManager.GetLatestLeadersByReplicaShard(replica, channelName)
+			if leader == nil {
+				return false
+			}
+			for segmentID := range ob.targetMgr.GetSealedSegmentsByChannel(collectionID, channelName, meta.CurrentTarget) {
+				if _, ok := leader.Segments[segmentID]; !ok {
+					return false
+				}
+			}
+		}
+	}
+	return true
+}
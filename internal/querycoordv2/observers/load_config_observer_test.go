@@ -0,0 +1,207 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package observers
+
+import (
+	"testing"
+
+	"github.com/samber/lo"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/milvus-io/milvus/internal/kv"
+	etcdkv "github.com/milvus-io/milvus/internal/kv/etcd"
+	"github.com/milvus-io/milvus/internal/metastore/kv/querycoord"
+	"github.com/milvus-io/milvus/internal/proto/datapb"
+	"github.com/milvus-io/milvus/internal/proto/querypb"
+	"github.com/milvus-io/milvus/internal/querycoordv2/meta"
+	. "github.com/milvus-io/milvus/internal/querycoordv2/params"
+	"github.com/milvus-io/milvus/internal/querycoordv2/session"
+	"github.com/milvus-io/milvus/internal/querycoordv2/utils"
+	"github.com/milvus-io/milvus/pkg/util/etcd"
+	"github.com/milvus-io/milvus/pkg/util/paramtable"
+	"github.com/milvus-io/milvus/pkg/util/typeutil"
+)
+
+type LoadConfigObserverSuite struct {
+	suite.Suite
+
+	kv        kv.MetaKv
+	meta      *meta.Meta
+	targetMgr *meta.TargetManager
+	dist      *meta.DistributionManager
+	broker    *meta.MockBroker
+	observer  *LoadConfigObserver
+
+	collectionID int64
+}
+
+func (suite *LoadConfigObserverSuite) SetupSuite() {
+	paramtable.Init()
+}
+
+func (suite *LoadConfigObserverSuite) SetupTest() {
+	config := GenerateEtcdConfig()
+	cli, err := etcd.GetEtcdClient(
+		config.UseEmbedEtcd.GetAsBool(),
+		config.EtcdUseSSL.GetAsBool(),
+		config.Endpoints.GetAsStrings(),
+		config.EtcdTLSCert.GetValue(),
+		config.EtcdTLSKey.GetValue(),
+		config.EtcdTLSCACert.GetValue(),
+		config.EtcdTLSMinVersion.GetValue())
+	suite.Require().NoError(err)
+	suite.kv = etcdkv.NewEtcdKV(cli, config.MetaRootPath.GetValue())
+
+	store := querycoord.NewCatalog(suite.kv)
+	nodeMgr := session.NewNodeManager()
+	suite.meta = meta.NewMeta(RandomIncrementIDAllocator(), store, nodeMgr)
+	suite.broker = meta.NewMockBroker(suite.T())
+	suite.targetMgr = meta.NewTargetManager(suite.broker, suite.meta)
+	suite.dist = meta.NewDistributionManager()
+	suite.observer = NewLoadConfigObserver(suite.meta, suite.dist, suite.targetMgr)
+
+	// nodes 1~4 in the default resource group, nodes 5~6 in rg1
+	suite.Require().NoError(suite.meta.ResourceManager.AddResourceGroup("rg1"))
+	for node := int64(1); node <= 6; node++ {
+		nodeMgr.Add(session.NewNodeInfo(node, "localhost"))
+		rgName := meta.DefaultResourceGroupName
+		if node > 4 {
+			rgName = "rg1"
+		}
+		suite.Require().NoError(suite.meta.ResourceManager.AssignNode(rgName, node))
+	}
+
+	suite.collectionID = 1000
+	collection := utils.CreateTestCollection(suite.collectionID, 1)
+	collection.Status = querypb.LoadStatus_Loaded
+	suite.Require().NoError(suite.meta.CollectionManager.PutCollection(collection))
+	suite.Require().NoError(suite.meta.CollectionManager.PutPartition(utils.CreateTestPartition(suite.collectionID, 100)))
+	replica := meta.NewReplica(&querypb.Replica{
+		ID:            10000,
+		CollectionID:  suite.collectionID,
+		ResourceGroup: meta.DefaultResourceGroupName,
+		Nodes:         []int64{1, 2, 3, 4},
+	}, typeutil.NewUniqueSet(1, 2, 3, 4))
+	suite.Require().NoError(suite.meta.ReplicaManager.Put(replica))
+
+	suite.broker.EXPECT().GetRecoveryInfoV2(mock.Anything, suite.collectionID).Return(
+		[]*datapb.VchannelInfo{{CollectionID: suite.collectionID, ChannelName: "channel-1"}},
+		[]*datapb.SegmentInfo{
+			{ID: 11, PartitionID: 100, InsertChannel: "channel-1"},
+			{ID: 12, PartitionID: 100, InsertChannel: "channel-1"},
+		}, nil).Maybe()
+	suite.Require().NoError(suite.targetMgr.UpdateCollectionNextTarget(suite.collectionID))
+	suite.Require().True(suite.targetMgr.UpdateCollectionCurrentTarget(suite.collectionID))
+}
+
+func (suite *LoadConfigObserverSuite) TearDownTest() {
+	suite.kv.Close()
+}
+
+// serveAllReplicas makes the first node of each replica the leader serving all segments.
+func (suite *LoadConfigObserverSuite) serveAllReplicas() {
+	for _, replica := range suite.meta.ReplicaManager.GetByCollection(suite.collectionID) {
+		leader := replica.GetNodes()[0]
+		suite.dist.LeaderViewManager.Update(leader, &meta.LeaderView{
+			ID:           leader,
+			CollectionID: suite.collectionID,
+			Channel:      "channel-1",
+			Segments: map[int64]*querypb.SegmentDist{
+				11: {NodeID: leader},
+				12: {NodeID: leader},
+			},
+		})
+	}
+}
+
+func (suite *LoadConfigObserverSuite) replicaNodes() map[string][]int64 {
+	ret := make(map[string][]int64)
+	for _, replica := range suite.meta.ReplicaManager.GetByCollection(suite.collectionID) {
+		ret[replica.GetResourceGroup()] = append(ret[replica.GetResourceGroup()], replica.GetNodes()...)
+	}
+	return ret
+}
+
+func (suite *LoadConfigObserverSuite) TestNotUpdated() {
+	suite.observer.check()
+	suite.Len(suite.meta.ReplicaManager.GetByCollection(suite.collectionID), 1)
+}
+
+func (suite *LoadConfigObserverSuite) TestReplicaNumber() {
+	suite.Require().NoError(suite.meta.CollectionManager.UpdateLoadConfig(suite.collectionID, 2, []string{meta.DefaultResourceGroupName}, false))
+	suite.observer.check()
+	replicas := suite.meta.ReplicaManager.GetByCollection(suite.collectionID)
+	suite.Len(replicas, 2)
+	for _, replica := range replicas {
+		suite.Equal(2, replica.Len())
+	}
+	suite.ElementsMatch([]int64{1, 2, 3, 4}, suite.replicaNodes()[meta.DefaultResourceGroupName])
+
+	// scale down, the replicas are kept until all of them serviceable
+	suite.Require().NoError(suite.meta.CollectionManager.UpdateLoadConfig(suite.collectionID, 1, []string{meta.DefaultResourceGroupName}, false))
+	suite.observer.check()
+	suite.Len(suite.meta.ReplicaManager.GetByCollection(suite.collectionID), 2)
+
+	suite.serveAllReplicas()
+	suite.observer.check()
+	replicas = suite.meta.ReplicaManager.GetByCollection(suite.collectionID)
+	suite.Len(replicas, 1)
+	suite.ElementsMatch([]int64{1, 2, 3, 4}, replicas[0].GetNodes())
+}
+
+func (suite *LoadConfigObserverSuite) TestResourceGroup() {
+	suite.Require().NoError(suite.meta.CollectionManager.UpdateLoadConfig(suite.collectionID, 1, []string{"rg1"}, false))
+	suite.observer.check()
+	suite.ElementsMatch([]int64{1, 2, 3, 4}, suite.replicaNodes()[meta.DefaultResourceGroupName])
+	suite.ElementsMatch([]int64{5, 6}, suite.replicaNodes()["rg1"])
+
+	// the replica in rg1 isn't serviceable yet
+	suite.observer.check()
+	suite.Len(suite.meta.ReplicaManager.GetByCollection(suite.collectionID), 2)
+
+	suite.serveAllReplicas()
+	suite.observer.check()
+	replicas := suite.meta.ReplicaManager.GetByCollection(suite.collectionID)
+	suite.Len(replicas, 1)
+	suite.Equal("rg1", replicas[0].GetResourceGroup())
+
+	// converged
+	suite.observer.check()
+	suite.Len(suite.meta.ReplicaManager.GetByCollection(suite.collectionID), 1)
+}
+
+func (suite *LoadConfigObserverSuite) TestNodeNotEnough() {
+	suite.Require().NoError(suite.meta.CollectionManager.UpdateLoadConfig(suite.collectionID, 3, []string{"rg1"}, false))
+	// one replica spawned each round, until the nodes of rg1 run out
+	for i := 0; i < 3; i++ {
+		suite.observer.check()
+	}
+	rgs := lo.Map(suite.meta.ReplicaManager.GetByCollection(suite.collectionID), func(replica *meta.Replica, _ int) string {
+		return replica.GetResourceGroup()
+	})
+	suite.ElementsMatch([]string{meta.DefaultResourceGroupName, "rg1", "rg1"}, rgs)
+
+	// the replica in default resource group keeps serving as rg1 lacks replica
+	suite.serveAllReplicas()
+	suite.observer.check()
+	suite.Len(suite.meta.ReplicaManager.GetByCollection(suite.collectionID), 3)
+}
+
+func TestLoadConfigObserver(t *testing.T) {
+	suite.Run(t, new(LoadConfigObserverSuite))
+}
@@ -0,0 +1,293 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package observers
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/samber/lo"
+	"go.uber.org/zap"
+
+	"github.com/milvus-io/milvus-proto/go-api/v2/commonpb"
+	"github.com/milvus-io/milvus/internal/proto/querypb"
+	"github.com/milvus-io/milvus/internal/querycoordv2/meta"
+	"github.com/milvus-io/milvus/internal/querycoordv2/params"
+	"github.com/milvus-io/milvus/pkg/log"
+)
+
+const (
+	// LoadIncidentNoServiceableShard means the collection is loaded, but none of its shards has a leader to serve.
+	LoadIncidentNoServiceableShard = "no_serviceable_shard"
+	// LoadIncidentDroppedSegmentInTarget means the target of the collection references the segments dropped by DataCoord.
+	LoadIncidentDroppedSegmentInTarget = "dropped_segment_in_target"
+
+	LoadRecoveryRebuildTarget = "rebuild_target"
+	LoadRecoveryReload        = "reload"
+
+	// maxLoadIncidents is the max number of the latest incidents kept.
+	maxLoadIncidents = 256
+)
+
+type ReloadFunc func(ctx context.Context, collectionID int64) error
+
+// LoadIncident is an impossible load state detected and the action taken to recover it.
+type LoadIncident struct {
+	CollectionID int64  `json:"collection_id"`
+	Kind         string `json:"kind"`
+	Detail       string `json:"detail"`
+	Action       string `json:"action"`
+	Error        string `json:"error,omitempty"`
+	DetectTime   int64  `json:"detect_time"`
+	ActionTime   int64  `json:"action_time"`
+}
+
+// loadIncidentState records since when the impossible load state of collection lasts, and the recoveries taken.
+type loadIncidentState struct {
+	kind       string
+	since      time.Time
+	lastAction time.Time
+	rebuilds   int
+}
+
+// LoadRecoveryObserver detects the impossible load states which never recover by themselves, like the loaded collection
+// without any serviceable shard, or the target referencing the dropped segments. The state lasting longer than the grace
+// period is recovered by rebuilding the target first, and by reloading the collection if the rebuilds don't work,
+// without restarting QueryCoord. Each recovery is recorded as an incident for the operators.
+type LoadRecoveryObserver struct {
+	cancel    context.CancelFunc
+	wg        sync.WaitGroup
+	meta      *meta.Meta
+	dist      *meta.DistributionManager
+	targetMgr *meta.TargetManager
+	broker    meta.Broker
+	reload    ReloadFunc
+
+	// only accessed in the schedule loop
+	states map[int64]*loadIncidentState
+
+	incidentMu sync.RWMutex
+	incidents  []*LoadIncident
+
+	stopOnce sync.Once
+}
+
+func NewLoadRecoveryObserver(
+	meta *meta.Meta,
+	dist *meta.DistributionManager,
+	targetMgr *meta.TargetManager,
+	broker meta.Broker,
+	reload ReloadFunc,
+) *LoadRecoveryObserver {
+	return &LoadRecoveryObserver{
+		meta:      meta,
+		dist:      dist,
+		targetMgr: targetMgr,
+		broker:    broker,
+		reload:    reload,
+		states:    make(map[int64]*loadIncidentState),
+	}
+}
+
+func (ob *LoadRecoveryObserver) Start() {
+	ctx, cancel := context.WithCancel(context.Background())
+	ob.cancel = cancel
+
+	ob.wg.Add(1)
+	go ob.schedule(ctx)
+}
+
+func (ob *LoadRecoveryObserver) Stop() {
+	ob.stopOnce.Do(func() {
+		if ob.cancel != nil {
+			ob.cancel()
+		}
+		ob.wg.Wait()
+	})
+}
+
+func (ob *LoadRecoveryObserver) schedule(ctx context.Context) {
+	defer ob.wg.Done()
+	log.Info("Start load recovery loop")
+
+	ticker := time.NewTicker(params.Params.QueryCoordCfg.LoadRecoveryCheckInterval.GetAsDuration(time.Second))
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			log.Info("Close load recovery observer")
+			return
+
+		case <-ticker.C:
+			ob.check(ctx, time.Now())
+		}
+	}
+}
+
+// Incidents returns the latest incidents of the collection in time order, or of all the collections if the collection id is 0.
+func (ob *LoadRecoveryObserver) Incidents(collectionID int64) []*LoadIncident {
+	ob.incidentMu.RLock()
+	defer ob.incidentMu.RUnlock()
+	ret := make([]*LoadIncident, 0, len(ob.incidents))
+	for _, incident := range ob.incidents {
+		if collectionID == 0 || incident.CollectionID == collectionID {
+			clone := *incident
+			ret = append(ret, &clone)
+		}
+	}
+	return ret
+}
+
+func (ob *LoadRecoveryObserver) check(ctx context.Context, now time.Time) {
+	if !params.Params.QueryCoordCfg.LoadRecoveryEnabled.GetAsBool() {
+		ob.states = make(map[int64]*loadIncidentState)
+		return
+	}
+
+	for _, collection := range ob.meta.CollectionManager.GetAllCollections() {
+		if collection.GetStatus() != querypb.LoadStatus_Loaded {
+			delete(ob.states, collection.GetCollectionID())
+			continue
+		}
+		ob.checkCollection(ctx, collection.GetCollectionID(), now)
+	}
+
+	// clean the states of released collections
+	for collectionID := range ob.states {
+		if !ob.meta.CollectionManager.Exist(collectionID) {
+			delete(ob.states, collectionID)
+		}
+	}
+}
+
+func (ob *LoadRecoveryObserver) checkCollection(ctx context.Context, collectionID int64, now time.Time) {
+	log := log.With(zap.Int64("collectionID", collectionID))
+
+	kind, detail := ob.detect(ctx, collectionID)
+	if kind == "" {
+		delete(ob.states, collectionID)
+		return
+	}
+	state, ok := ob.states[collectionID]
+	if !ok || state.kind != kind {
+		log.Info("impossible load state detected, wait for the grace period", zap.String("kind", kind), zap.String("detail", detail))
+		ob.states[collectionID] = &loadIncidentState{kind: kind, since: now, lastAction: now}
+		return
+	}
+	// wait the grace period since detected, and since the last recovery to take effect
+	grace := params.Params.QueryCoordCfg.LoadRecoveryGracePeriod.GetAsDuration(time.Second)
+	if now.Sub(state.lastAction) < grace {
+		return
+	}
+
+	incident := &LoadIncident{
+		CollectionID: collectionID,
+		Kind:         kind,
+		Detail:       detail,
+		DetectTime:   state.since.Unix(),
+		ActionTime:   now.Unix(),
+	}
+	var err error
+	maxRebuild := params.Params.QueryCoordCfg.LoadRecoveryMaxTargetRebuild.GetAsInt()
+	if maxRebuild <= 0 || state.rebuilds < maxRebuild {
+		incident.Action = LoadRecoveryRebuildTarget
+		err = ob.targetMgr.UpdateCollectionNextTarget(collectionID)
+		state.rebuilds++
+		state.lastAction = now
+	} else {
+		incident.Action = LoadRecoveryReload
+		err = ob.reload(ctx, collectionID)
+		if err == nil {
+			delete(ob.states, collectionID)
+		} else {
+			state.lastAction = now
+		}
+	}
+	if err != nil {
+		incident.Error = err.Error()
+	}
+	log.Warn("recover impossible load state",
+		zap.String("kind", kind),
+		zap.String("detail", detail),
+		zap.String("action", incident.Action),
+		zap.Duration("lasting", now.Sub(state.since)),
+		zap.Error(err))
+	ob.addIncident(incident)
+}
+
+// detect returns the kind and detail of the impossible load state of the collection, empty kind if none.
+func (ob *LoadRecoveryObserver) detect(ctx context.Context, collectionID int64) (string, string) {
+	channels := ob.targetMgr.GetDmChannelsByCollection(collectionID, meta.CurrentTarget)
+	if len(channels) == 0 {
+		return LoadIncidentNoServiceableShard, "no current target"
+	}
+	replicas := ob.meta.ReplicaManager.GetByCollection(collectionID)
+	serviceable := lo.CountBy(lo.Keys(channels), func(channel string) bool {
+		return lo.ContainsBy(replicas, func(replica *meta.Replica) bool {
+			return ob.dist.LeaderViewManager.GetLatestLeadersByReplicaShard(replica, channel) != nil
+		})
+	})
+	if serviceable == 0 {
+		return LoadIncidentNoServiceableShard, fmt.Sprintf("none of the %d shards has leader in %d replicas", len(channels), len(replicas))
+	}
+
+	dropped := ob.getDroppedSegmentsInTarget(ctx, collectionID)
+	if len(dropped) > 0 {
+		return LoadIncidentDroppedSegmentInTarget, fmt.Sprintf("%d dropped segments in target, like %v", len(dropped), dropped[:lo.Min([]int{len(dropped), 10})])
+	}
+	return "", ""
+}
+
+// getDroppedSegmentsInTarget returns the segments in the current and next targets but dropped or absent in DataCoord.
+func (ob *LoadRecoveryObserver) getDroppedSegmentsInTarget(ctx context.Context, collectionID int64) []int64 {
+	segmentIDs := lo.Union(
+		lo.Keys(ob.targetMgr.GetSealedSegmentsByCollection(collectionID, meta.CurrentTarget)),
+		lo.Keys(ob.targetMgr.GetSealedSegmentsByCollection(collectionID, meta.NextTarget)),
+	)
+	if len(segmentIDs) == 0 {
+		return nil
+	}
+	resp, err := ob.broker.GetSegmentInfo(ctx, segmentIDs...)
+	if err != nil {
+		log.Warn("failed to get segment info, skip checking dropped segments in target", zap.Int64("collectionID", collectionID), zap.Error(err))
+		return nil
+	}
+	healthy := make(map[int64]struct{}, len(resp.GetInfos()))
+	for _, info := range resp.GetInfos() {
+		if info.GetState() != commonpb.SegmentState_Dropped {
+			healthy[info.GetID()] = struct{}{}
+		}
+	}
+	dropped := lo.Filter(segmentIDs, func(id int64, _ int) bool {
+		_, ok := healthy[id]
+		return !ok
+	})
+	sort.Slice(dropped, func(i, j int) bool { return dropped[i] < dropped[j] })
+	return dropped
+}
+
+func (ob *LoadRecoveryObserver) addIncident(incident *LoadIncident) {
+	ob.incidentMu.Lock()
+	defer ob.incidentMu.Unlock()
+	ob.incidents = append(ob.incidents, incident)
+	if len(ob.incidents) > maxLoadIncidents {
+		ob.incidents = ob.incidents[len(ob.incidents)-maxLoadIncidents:]
+	}
+}
@@ -0,0 +1,223 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package observers
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/cockroachdb/errors"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/milvus-io/milvus-proto/go-api/v2/commonpb"
+	"github.com/milvus-io/milvus/internal/kv"
+	etcdkv "github.com/milvus-io/milvus/internal/kv/etcd"
+	"github.com/milvus-io/milvus/internal/metastore/kv/querycoord"
+	"github.com/milvus-io/milvus/internal/proto/datapb"
+	"github.com/milvus-io/milvus/internal/proto/querypb"
+	"github.com/milvus-io/milvus/internal/querycoordv2/meta"
+	. "github.com/milvus-io/milvus/internal/querycoordv2/params"
+	"github.com/milvus-io/milvus/internal/querycoordv2/session"
+	"github.com/milvus-io/milvus/internal/querycoordv2/utils"
+	"github.com/milvus-io/milvus/pkg/util/etcd"
+	"github.com/milvus-io/milvus/pkg/util/paramtable"
+)
+
+type LoadRecoveryObserverSuite struct {
+	suite.Suite
+
+	kv        kv.MetaKv
+	meta      *meta.Meta
+	targetMgr *meta.TargetManager
+	dist      *meta.DistributionManager
+	broker    *meta.MockBroker
+	observer  *LoadRecoveryObserver
+
+	collectionID int64
+	reloaded     []int64
+	reloadErr    error
+}
+
+func (suite *LoadRecoveryObserverSuite) SetupSuite() {
+	paramtable.Init()
+}
+
+func (suite *LoadRecoveryObserverSuite) SetupTest() {
+	paramtable.Get().Save(Params.QueryCoordCfg.LoadRecoveryGracePeriod.Key, "60")
+	paramtable.Get().Save(Params.QueryCoordCfg.LoadRecoveryMaxTargetRebuild.Key, "1")
+
+	config := GenerateEtcdConfig()
+	cli, err := etcd.GetEtcdClient(
+		config.UseEmbedEtcd.GetAsBool(),
+		config.EtcdUseSSL.GetAsBool(),
+		config.Endpoints.GetAsStrings(),
+		config.EtcdTLSCert.GetValue(),
+		config.EtcdTLSKey.GetValue(),
+		config.EtcdTLSCACert.GetValue(),
+		config.EtcdTLSMinVersion.GetValue())
+	suite.Require().NoError(err)
+	suite.kv = etcdkv.NewEtcdKV(cli, config.MetaRootPath.GetValue())
+
+	store := querycoord.NewCatalog(suite.kv)
+	suite.meta = meta.NewMeta(RandomIncrementIDAllocator(), store, session.NewNodeManager())
+	suite.broker = meta.NewMockBroker(suite.T())
+	suite.targetMgr = meta.NewTargetManager(suite.broker, suite.meta)
+	suite.dist = meta.NewDistributionManager()
+
+	suite.reloaded, suite.reloadErr = nil, nil
+	suite.observer = NewLoadRecoveryObserver(suite.meta, suite.dist, suite.targetMgr, suite.broker,
+		func(ctx context.Context, collectionID int64) error {
+			if suite.reloadErr != nil {
+				return suite.reloadErr
+			}
+			suite.reloaded = append(suite.reloaded, collectionID)
+			return nil
+		})
+
+	suite.collectionID = 1000
+	collection := utils.CreateTestCollection(suite.collectionID, 1)
+	collection.Status = querypb.LoadStatus_Loaded
+	suite.Require().NoError(suite.meta.CollectionManager.PutCollection(collection))
+	suite.Require().NoError(suite.meta.CollectionManager.PutPartition(utils.CreateTestPartition(suite.collectionID, 100)))
+	replicas, err := suite.meta.ReplicaManager.Spawn(suite.collectionID, 1, meta.DefaultResourceGroupName)
+	suite.Require().NoError(err)
+	replicas[0].AddNode(2)
+	suite.Require().NoError(suite.meta.ReplicaManager.Put(replicas...))
+
+	suite.broker.EXPECT().GetRecoveryInfoV2(mock.Anything, suite.collectionID).Return(
+		[]*datapb.VchannelInfo{{CollectionID: suite.collectionID, ChannelName: "channel-1"}},
+		[]*datapb.SegmentInfo{
+			{ID: 11, PartitionID: 100, InsertChannel: "channel-1"},
+			{ID: 12, PartitionID: 100, InsertChannel: "channel-1"},
+		}, nil).Maybe()
+	suite.Require().NoError(suite.targetMgr.UpdateCollectionNextTarget(suite.collectionID))
+	suite.Require().True(suite.targetMgr.UpdateCollectionCurrentTarget(suite.collectionID))
+}
+
+func (suite *LoadRecoveryObserverSuite) TearDownTest() {
+	paramtable.Get().Reset(Params.QueryCoordCfg.LoadRecoveryGracePeriod.Key)
+	paramtable.Get().Reset(Params.QueryCoordCfg.LoadRecoveryMaxTargetRebuild.Key)
+	suite.kv.Close()
+}
+
+func (suite *LoadRecoveryObserverSuite) serveShard() {
+	suite.dist.LeaderViewManager.Update(2, &meta.LeaderView{
+		ID:           2,
+		CollectionID: suite.collectionID,
+		Channel:      "channel-1",
+	})
+}
+
+// mockSegmentStates mocks the states of segments 11 and 12 returned by DataCoord for the next checks.
+func (suite *LoadRecoveryObserverSuite) mockSegmentStates(times int, states map[int64]commonpb.SegmentState) {
+	infos := make([]*datapb.SegmentInfo, 0, len(states))
+	for id, state := range states {
+		infos = append(infos, &datapb.SegmentInfo{ID: id, State: state})
+	}
+	suite.broker.EXPECT().GetSegmentInfo(mock.Anything, mock.Anything, mock.Anything).
+		Return(&datapb.GetSegmentInfoResponse{Infos: infos}, nil).Times(times)
+}
+
+func (suite *LoadRecoveryObserverSuite) TestHealthy() {
+	ctx := context.Background()
+	suite.serveShard()
+	suite.mockSegmentStates(2, map[int64]commonpb.SegmentState{
+		11: commonpb.SegmentState_Flushed,
+		12: commonpb.SegmentState_Flushed,
+	})
+
+	now := time.Now()
+	suite.observer.check(ctx, now)
+	suite.observer.check(ctx, now.Add(time.Hour))
+	suite.Empty(suite.observer.states)
+	suite.Empty(suite.observer.Incidents(0))
+}
+
+func (suite *LoadRecoveryObserverSuite) TestNoServiceableShard() {
+	ctx := context.Background()
+
+	now := time.Now()
+	suite.observer.check(ctx, now)
+	suite.Empty(suite.observer.Incidents(0))
+	// within the grace period
+	suite.observer.check(ctx, now.Add(30*time.Second))
+	suite.Empty(suite.observer.Incidents(0))
+
+	// rebuild the target first
+	suite.observer.check(ctx, now.Add(time.Minute))
+	incidents := suite.observer.Incidents(suite.collectionID)
+	suite.Require().Len(incidents, 1)
+	suite.Equal(LoadIncidentNoServiceableShard, incidents[0].Kind)
+	suite.Equal(LoadRecoveryRebuildTarget, incidents[0].Action)
+	suite.Empty(incidents[0].Error)
+	suite.Empty(suite.reloaded)
+
+	// then reload after the rebuilds don't work
+	suite.reloadErr = errors.New("mock")
+	suite.observer.check(ctx, now.Add(2*time.Minute))
+	incidents = suite.observer.Incidents(suite.collectionID)
+	suite.Require().Len(incidents, 2)
+	suite.Equal(LoadRecoveryReload, incidents[1].Action)
+	suite.Equal("mock", incidents[1].Error)
+
+	suite.reloadErr = nil
+	suite.observer.check(ctx, now.Add(3*time.Minute))
+	suite.Equal([]int64{suite.collectionID}, suite.reloaded)
+	suite.Len(suite.observer.Incidents(0), 3)
+	suite.NotContains(suite.observer.states, suite.collectionID)
+}
+
+func (suite *LoadRecoveryObserverSuite) TestDroppedSegmentInTarget() {
+	ctx := context.Background()
+	suite.serveShard()
+	suite.mockSegmentStates(2, map[int64]commonpb.SegmentState{
+		11: commonpb.SegmentState_Flushed,
+		12: commonpb.SegmentState_Dropped,
+	})
+
+	now := time.Now()
+	suite.observer.check(ctx, now)
+	suite.observer.check(ctx, now.Add(time.Minute))
+	incidents := suite.observer.Incidents(0)
+	suite.Require().Len(incidents, 1)
+	suite.Equal(LoadIncidentDroppedSegmentInTarget, incidents[0].Kind)
+	suite.Contains(incidents[0].Detail, "[12]")
+
+	// recovered after the target updated
+	suite.mockSegmentStates(1, map[int64]commonpb.SegmentState{
+		11: commonpb.SegmentState_Flushed,
+		12: commonpb.SegmentState_Flushed,
+	})
+	suite.observer.check(ctx, now.Add(2*time.Minute))
+	suite.Empty(suite.observer.states)
+}
+
+func (suite *LoadRecoveryObserverSuite) TestDisabled() {
+	paramtable.Get().Save(Params.QueryCoordCfg.LoadRecoveryEnabled.Key, "false")
+	defer paramtable.Get().Reset(Params.QueryCoordCfg.LoadRecoveryEnabled.Key)
+
+	now := time.Now()
+	suite.observer.check(context.Background(), now)
+	suite.observer.check(context.Background(), now.Add(time.Hour))
+	suite.Empty(suite.observer.Incidents(0))
+}
+
+func TestLoadRecoveryObserver(t *testing.T) {
+	suite.Run(t, new(LoadRecoveryObserverSuite))
+}
@@ -0,0 +1,160 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package observers
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/cockroachdb/errors"
+	"go.uber.org/zap"
+
+	"github.com/milvus-io/milvus/internal/metastore/model"
+	"github.com/milvus-io/milvus/internal/querycoordv2/meta"
+	"github.com/milvus-io/milvus/internal/querycoordv2/params"
+	"github.com/milvus-io/milvus/pkg/log"
+	"github.com/milvus-io/milvus/pkg/util/merr"
+)
+
+type (
+	ScheduledLoadFunc    func(ctx context.Context, schedule *model.LoadSchedule) error
+	ScheduledReleaseFunc func(ctx context.Context, collectionID int64) error
+)
+
+// LoadScheduleObserver loads and releases the collections at the times of their load schedules.
+// Only the latest event since the last applied one is applied, so the collection loaded or released manually
+// keeps its state until the next event of the schedule.
+type LoadScheduleObserver struct {
+	cancel  context.CancelFunc
+	wg      sync.WaitGroup
+	meta    *meta.Meta
+	load    ScheduledLoadFunc
+	release ScheduledReleaseFunc
+
+	stopOnce sync.Once
+}
+
+func NewLoadScheduleObserver(meta *meta.Meta, load ScheduledLoadFunc, release ScheduledReleaseFunc) *LoadScheduleObserver {
+	return &LoadScheduleObserver{
+		meta:    meta,
+		load:    load,
+		release: release,
+	}
+}
+
+func (ob *LoadScheduleObserver) Start() {
+	ctx, cancel := context.WithCancel(context.Background())
+	ob.cancel = cancel
+
+	ob.wg.Add(1)
+	go ob.schedule(ctx)
+}
+
+func (ob *LoadScheduleObserver) Stop() {
+	ob.stopOnce.Do(func() {
+		if ob.cancel != nil {
+			ob.cancel()
+		}
+		ob.wg.Wait()
+	})
+}
+
+func (ob *LoadScheduleObserver) schedule(ctx context.Context) {
+	defer ob.wg.Done()
+	log.Info("Start load schedule loop")
+
+	ticker := time.NewTicker(params.Params.QueryCoordCfg.LoadScheduleCheckInterval.GetAsDuration(time.Second))
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			log.Info("Close load schedule observer")
+			return
+
+		case <-ticker.C:
+			ob.check(ctx, time.Now())
+		}
+	}
+}
+
+func (ob *LoadScheduleObserver) check(ctx context.Context, now time.Time) {
+	if !params.Params.QueryCoordCfg.LoadScheduleEnabled.GetAsBool() {
+		return
+	}
+	for _, schedule := range ob.meta.GetLoadSchedules() {
+		ob.checkSchedule(ctx, schedule, now)
+	}
+}
+
+func (ob *LoadScheduleObserver) checkSchedule(ctx context.Context, schedule *model.LoadSchedule, now time.Time) {
+	collectionID := schedule.CollectionID
+	log := log.With(zap.Int64("collectionID", collectionID)).WithRateGroup("qcv2.loadScheduleObserver", 1, 60)
+
+	specs, err := meta.ParseLoadSchedule(schedule)
+	if err != nil {
+		log.RatedWarn(10, "invalid load schedule", zap.Error(err))
+		return
+	}
+
+	lookback := params.Params.QueryCoordCfg.LoadScheduleLookback.GetAsDuration(time.Second)
+	lastTrigger := time.Unix(schedule.LastTriggerTime, 0)
+	local := now.In(specs.Location)
+	loadTime, hasLoad := specs.Load.Prev(local, lookback)
+	hasLoad = hasLoad && loadTime.After(lastTrigger)
+	releaseTime, hasRelease := specs.Release.Prev(local, lookback)
+	hasRelease = hasRelease && releaseTime.After(lastTrigger)
+
+	var (
+		toLoad      bool
+		triggerTime time.Time
+	)
+	switch {
+	case hasLoad && (!hasRelease || loadTime.After(releaseTime)):
+		toLoad, triggerTime = true, loadTime
+	case hasRelease:
+		triggerTime = releaseTime
+	default:
+		return
+	}
+
+	loaded := ob.meta.CollectionManager.Exist(collectionID)
+	switch {
+	case toLoad && !loaded:
+		log.Info("load collection by schedule", zap.Time("triggerTime", triggerTime))
+		err = ob.load(ctx, schedule)
+	case !toLoad && loaded:
+		log.Info("release collection by schedule", zap.Time("triggerTime", triggerTime))
+		err = ob.release(ctx, collectionID)
+	}
+	if errors.Is(err, merr.ErrCollectionNotFound) {
+		log.Info("collection dropped, remove its load schedule")
+		if err := ob.meta.RemoveLoadSchedule(collectionID); err != nil {
+			log.Warn("failed to remove load schedule", zap.Error(err))
+		}
+		return
+	}
+	if err != nil {
+		// retry in the next check
+		log.RatedWarn(10, "failed to apply load schedule", zap.Bool("load", toLoad), zap.Error(err))
+		return
+	}
+
+	if err := ob.meta.UpdateLastTriggerTime(collectionID, triggerTime); err != nil {
+		log.Warn("failed to update the last trigger time of load schedule", zap.Error(err))
+	}
+}
@@ -0,0 +1,185 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package observers
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/cockroachdb/errors"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/milvus-io/milvus/internal/kv"
+	etcdkv "github.com/milvus-io/milvus/internal/kv/etcd"
+	"github.com/milvus-io/milvus/internal/metastore/kv/querycoord"
+	"github.com/milvus-io/milvus/internal/metastore/model"
+	"github.com/milvus-io/milvus/internal/proto/querypb"
+	"github.com/milvus-io/milvus/internal/querycoordv2/meta"
+	. "github.com/milvus-io/milvus/internal/querycoordv2/params"
+	"github.com/milvus-io/milvus/internal/querycoordv2/session"
+	"github.com/milvus-io/milvus/pkg/util/etcd"
+	"github.com/milvus-io/milvus/pkg/util/merr"
+	"github.com/milvus-io/milvus/pkg/util/paramtable"
+)
+
+type LoadScheduleObserverSuite struct {
+	suite.Suite
+
+	kv kv.MetaKv
+	// dependency
+	meta     *meta.Meta
+	observer *LoadScheduleObserver
+
+	loaded     []int64
+	released   []int64
+	loadErr    error
+	releaseErr error
+
+	collectionID int64
+}
+
+func (suite *LoadScheduleObserverSuite) SetupSuite() {
+	paramtable.Init()
+}
+
+func (suite *LoadScheduleObserverSuite) SetupTest() {
+	paramtable.Get().Save(Params.QueryCoordCfg.LoadScheduleEnabled.Key, "true")
+
+	config := GenerateEtcdConfig()
+	cli, err := etcd.GetEtcdClient(
+		config.UseEmbedEtcd.GetAsBool(),
+		config.EtcdUseSSL.GetAsBool(),
+		config.Endpoints.GetAsStrings(),
+		config.EtcdTLSCert.GetValue(),
+		config.EtcdTLSKey.GetValue(),
+		config.EtcdTLSCACert.GetValue(),
+		config.EtcdTLSMinVersion.GetValue())
+	suite.Require().NoError(err)
+	suite.kv = etcdkv.NewEtcdKV(cli, config.MetaRootPath.GetValue())
+
+	store := querycoord.NewCatalog(suite.kv)
+	suite.meta = meta.NewMeta(RandomIncrementIDAllocator(), store, session.NewNodeManager())
+
+	suite.loaded, suite.released = nil, nil
+	suite.loadErr, suite.releaseErr = nil, nil
+	suite.observer = NewLoadScheduleObserver(suite.meta,
+		func(ctx context.Context, schedule *model.LoadSchedule) error {
+			if suite.loadErr != nil {
+				return suite.loadErr
+			}
+			suite.loaded = append(suite.loaded, schedule.CollectionID)
+			return nil
+		},
+		func(ctx context.Context, collectionID int64) error {
+			if suite.releaseErr != nil {
+				return suite.releaseErr
+			}
+			suite.released = append(suite.released, collectionID)
+			return nil
+		})
+
+	suite.collectionID = 1000
+	// load at 9:00 and release at 18:00 of the weekdays
+	suite.Require().NoError(suite.meta.PutLoadSchedule(&model.LoadSchedule{
+		CollectionID:    suite.collectionID,
+		LoadSpec:        "0 9 * * 1-5",
+		ReleaseSpec:     "0 18 * * 1-5",
+		Timezone:        "UTC",
+		ReplicaNumber:   1,
+		LastTriggerTime: time.Date(2023, 10, 13, 20, 0, 0, 0, time.UTC).Unix(),
+	}))
+}
+
+func (suite *LoadScheduleObserverSuite) TearDownTest() {
+	paramtable.Get().Reset(Params.QueryCoordCfg.LoadScheduleEnabled.Key)
+	suite.kv.Close()
+}
+
+func (suite *LoadScheduleObserverSuite) putCollection() {
+	err := suite.meta.CollectionManager.PutCollection(&meta.Collection{
+		CollectionLoadInfo: &querypb.CollectionLoadInfo{
+			CollectionID:  suite.collectionID,
+			ReplicaNumber: 1,
+			Status:        querypb.LoadStatus_Loaded,
+		},
+	})
+	suite.Require().NoError(err)
+}
+
+func (suite *LoadScheduleObserverSuite) lastTriggerTime() time.Time {
+	return time.Unix(suite.meta.GetLoadSchedule(suite.collectionID).LastTriggerTime, 0).UTC()
+}
+
+func (suite *LoadScheduleObserverSuite) TestLoadAndRelease() {
+	ctx := context.Background()
+
+	// Saturday, no event since the last trigger
+	suite.observer.check(ctx, time.Date(2023, 10, 14, 10, 0, 0, 0, time.UTC))
+	suite.Empty(suite.loaded)
+
+	// Monday 9:30, load
+	monday := time.Date(2023, 10, 16, 9, 30, 0, 0, time.UTC)
+	suite.observer.check(ctx, monday)
+	suite.Equal([]int64{suite.collectionID}, suite.loaded)
+	suite.Equal(time.Date(2023, 10, 16, 9, 0, 0, 0, time.UTC), suite.lastTriggerTime())
+
+	// the event is applied only once
+	suite.observer.check(ctx, monday.Add(time.Minute))
+	suite.Len(suite.loaded, 1)
+
+	// Monday 18:10, release
+	suite.putCollection()
+	suite.observer.check(ctx, time.Date(2023, 10, 16, 18, 10, 0, 0, time.UTC))
+	suite.Equal([]int64{suite.collectionID}, suite.released)
+	suite.Equal(time.Date(2023, 10, 16, 18, 0, 0, 0, time.UTC), suite.lastTriggerTime())
+}
+
+func (suite *LoadScheduleObserverSuite) TestAlreadyLoaded() {
+	suite.putCollection()
+	now := time.Date(2023, 10, 16, 9, 30, 0, 0, time.UTC)
+	suite.observer.check(context.Background(), now)
+	suite.Empty(suite.loaded)
+	suite.Equal(time.Date(2023, 10, 16, 9, 0, 0, 0, time.UTC), suite.lastTriggerTime())
+}
+
+func (suite *LoadScheduleObserverSuite) TestDisabled() {
+	paramtable.Get().Save(Params.QueryCoordCfg.LoadScheduleEnabled.Key, "false")
+	suite.observer.check(context.Background(), time.Date(2023, 10, 16, 9, 30, 0, 0, time.UTC))
+	suite.Empty(suite.loaded)
+}
+
+func (suite *LoadScheduleObserverSuite) TestFailure() {
+	ctx := context.Background()
+	now := time.Date(2023, 10, 16, 9, 30, 0, 0, time.UTC)
+	lastTrigger := suite.lastTriggerTime()
+
+	// retry in the next check
+	suite.loadErr = errors.New("mock")
+	suite.observer.check(ctx, now)
+	suite.Empty(suite.loaded)
+	suite.Equal(lastTrigger, suite.lastTriggerTime())
+
+	// the schedule of the dropped collection is removed
+	suite.loadErr = merr.WrapErrCollectionNotFound(suite.collectionID)
+	suite.observer.check(ctx, now)
+	suite.Nil(suite.meta.GetLoadSchedule(suite.collectionID))
+}
+
+func TestLoadScheduleObserver(t *testing.T) {
+	suite.Run(t, new(LoadScheduleObserverSuite))
+}
@@ -0,0 +1,376 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package observers
+
+import (
+	"context"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/samber/lo"
+	"go.uber.org/zap"
+
+	"github.com/milvus-io/milvus/internal/proto/querypb"
+	"github.com/milvus-io/milvus/internal/querycoordv2/meta"
+	"github.com/milvus-io/milvus/internal/querycoordv2/params"
+	"github.com/milvus-io/milvus/internal/querycoordv2/session"
+	"github.com/milvus-io/milvus/internal/querycoordv2/utils"
+	"github.com/milvus-io/milvus/pkg/log"
+	"github.com/milvus-io/milvus/pkg/util/funcutil"
+	"github.com/milvus-io/milvus/pkg/util/merr"
+	"github.com/milvus-io/milvus/pkg/util/metricsinfo"
+)
+
+// collectionLoadState records since when the query load of collection keeps high or low.
+type collectionLoadState struct {
+	highSince time.Time
+	lowSince  time.Time
+}
+
+// ReplicaAutoScaleObserver scales the replica number of loaded collections up and down
+// within the configured bounds, by the sustained search load and cpu usage of the QueryNodes.
+type ReplicaAutoScaleObserver struct {
+	cancel  context.CancelFunc
+	wg      sync.WaitGroup
+	meta    *meta.Meta
+	cluster session.Cluster
+	nodeMgr *session.NodeManager
+
+	// only accessed in the schedule loop
+	states map[int64]*collectionLoadState
+
+	stopOnce sync.Once
+}
+
+func NewReplicaAutoScaleObserver(meta *meta.Meta, cluster session.Cluster, nodeMgr *session.NodeManager) *ReplicaAutoScaleObserver {
+	return &ReplicaAutoScaleObserver{
+		meta:    meta,
+		cluster: cluster,
+		nodeMgr: nodeMgr,
+		states:  make(map[int64]*collectionLoadState),
+	}
+}
+
+func (ob *ReplicaAutoScaleObserver) Start() {
+	ctx, cancel := context.WithCancel(context.Background())
+	ob.cancel = cancel
+
+	ob.wg.Add(1)
+	go ob.schedule(ctx)
+}
+
+func (ob *ReplicaAutoScaleObserver) Stop() {
+	ob.stopOnce.Do(func() {
+		if ob.cancel != nil {
+			ob.cancel()
+		}
+		ob.wg.Wait()
+	})
+}
+
+func (ob *ReplicaAutoScaleObserver) schedule(ctx context.Context) {
+	defer ob.wg.Done()
+	log.Info("Start replica auto scale loop")
+
+	ticker := time.NewTicker(params.Params.QueryCoordCfg.AutoReplicaCheckInterval.GetAsDuration(time.Second))
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			log.Info("Close replica auto scale observer")
+			return
+
+		case <-ticker.C:
+			ob.check(ctx, time.Now())
+		}
+	}
+}
+
+func (ob *ReplicaAutoScaleObserver) check(ctx context.Context, now time.Time) {
+	if !params.Params.QueryCoordCfg.AutoReplicaEnabled.GetAsBool() {
+		ob.states = make(map[int64]*collectionLoadState)
+		return
+	}
+
+	metrics := ob.collectNodeMetrics(ctx)
+	collections := ob.meta.CollectionManager.GetAllCollections()
+	for _, collection := range collections {
+		if collection.GetStatus() != querypb.LoadStatus_Loaded {
+			delete(ob.states, collection.GetCollectionID())
+			continue
+		}
+		ob.checkCollection(collection.GetCollectionID(), metrics, now)
+	}
+
+	// clean the states of released collections
+	for collectionID := range ob.states {
+		if !ob.meta.CollectionManager.Exist(collectionID) {
+			delete(ob.states, collectionID)
+		}
+	}
+}
+
+// collectNodeMetrics collects the quota metrics of all QueryNodes, the nodes failed are absent in the result.
+func (ob *ReplicaAutoScaleObserver) collectNodeMetrics(ctx context.Context) map[int64]*metricsinfo.QueryNodeQuotaMetrics {
+	req, err := metricsinfo.ConstructRequestByMetricType(metricsinfo.SystemInfoMetrics)
+	if err != nil {
+		log.Warn("failed to construct metrics request", zap.Error(err))
+		return nil
+	}
+
+	nodes := lo.Map(ob.nodeMgr.GetAll(), func(node *session.NodeInfo, _ int) int64 { return node.ID() })
+	snapshot := funcutil.CollectMetricsParallel(ctx, nodes,
+		params.Params.CommonCfg.MetricsCollectTimeout.GetAsDuration(time.Millisecond),
+		params.Params.CommonCfg.MetricsCollectParallelism.GetAsInt(),
+		func(ctx context.Context, node int64) (*metricsinfo.QueryNodeQuotaMetrics, error) {
+			resp, err := ob.cluster.GetMetrics(ctx, node, req)
+			if err := merr.CheckRPCCall(resp, err); err != nil {
+				return nil, err
+			}
+			infos := metricsinfo.QueryNodeInfos{}
+			if err := metricsinfo.UnmarshalComponentInfos(resp.GetResponse(), &infos); err != nil {
+				return nil, err
+			}
+			if infos.QuotaMetrics == nil {
+				return nil, merr.WrapErrServiceInternal("no quota metrics")
+			}
+			return infos.QuotaMetrics, nil
+		})
+
+	ret := make(map[int64]*metricsinfo.QueryNodeQuotaMetrics, len(nodes))
+	for i, result := range snapshot.Results {
+		if result.Err != nil {
+			log.Warn("failed to get metrics of QueryNode for replica auto scale",
+				zap.Int64("nodeID", nodes[i]),
+				zap.Bool("timeout", result.TimedOut),
+				zap.Error(result.Err))
+			continue
+		}
+		ret[nodes[i]] = result.Value
+	}
+	return ret
+}
+
+func (ob *ReplicaAutoScaleObserver) checkCollection(collectionID int64, metrics map[int64]*metricsinfo.QueryNodeQuotaMetrics, now time.Time) {
+	log := log.With(zap.Int64("collectionID", collectionID)).WithRateGroup("qcv2.replicaAutoScaleObserver", 1, 60)
+
+	replicas := ob.meta.ReplicaManager.GetByCollection(collectionID)
+	rgs := ob.meta.ReplicaManager.GetResourceGroupByCollection(collectionID)
+	if len(replicas) == 0 || len(rgs) != 1 {
+		// the replicas across resource groups are placed by user, don't touch them
+		delete(ob.states, collectionID)
+		return
+	}
+
+	// the search load of nodes is shared by all the collections on them,
+	// which overestimates the load of collection and scales conservatively
+	var nq, cpuUsage float64
+	nodeNum := 0
+	for _, replica := range replicas {
+		for _, node := range replica.GetNodes() {
+			metric, ok := metrics[node]
+			if !ok {
+				// the load is unknown, restart the observation
+				delete(ob.states, collectionID)
+				return
+			}
+			for _, rm := range metric.Rms {
+				if rm.Label == metricsinfo.NQPerSecond {
+					nq += rm.Rate
+				}
+			}
+			cpuUsage += metric.Hms.CPUCoreUsage
+			nodeNum++
+		}
+	}
+	if nodeNum == 0 {
+		delete(ob.states, collectionID)
+		return
+	}
+	nqPerReplica := nq / float64(len(replicas))
+	cpuUsage /= float64(nodeNum)
+
+	cfg := &params.Params.QueryCoordCfg
+	high := nqPerReplica > cfg.AutoReplicaScaleUpQPSThreshold.GetAsFloat() ||
+		cpuUsage > cfg.AutoReplicaScaleUpCPUThreshold.GetAsFloat()
+	low := nqPerReplica < cfg.AutoReplicaScaleDownQPSThreshold.GetAsFloat() &&
+		cpuUsage < cfg.AutoReplicaScaleDownCPUThreshold.GetAsFloat()
+
+	state, ok := ob.states[collectionID]
+	if !ok {
+		state = &collectionLoadState{}
+		ob.states[collectionID] = state
+	}
+	switch {
+	case high:
+		state.lowSince = time.Time{}
+		if state.highSince.IsZero() {
+			state.highSince = now
+		}
+	case low:
+		state.highSince = time.Time{}
+		if state.lowSince.IsZero() {
+			state.lowSince = now
+		}
+	default:
+		state.highSince, state.lowSince = time.Time{}, time.Time{}
+	}
+
+	sustained := cfg.AutoReplicaSustainedSeconds.GetAsDuration(time.Second)
+	replicaNum := len(replicas)
+	rgName := rgs.Collect()[0]
+	var err error
+	switch {
+	case high && now.Sub(state.highSince) >= sustained && replicaNum < cfg.AutoReplicaMaxNumber.GetAsInt():
+		log.Info("query load keeps high, scale up replica",
+			zap.Float64("nqPerReplica", nqPerReplica),
+			zap.Float64("cpuUsage", cpuUsage),
+			zap.Int("replicaNumber", replicaNum))
+		err = ob.scaleUp(collectionID, rgName, replicas, metrics)
+	case low && now.Sub(state.lowSince) >= sustained && replicaNum > cfg.AutoReplicaMinNumber.GetAsInt():
+		log.Info("query load keeps low, scale down replica",
+			zap.Float64("nqPerReplica", nqPerReplica),
+			zap.Float64("cpuUsage", cpuUsage),
+			zap.Int("replicaNumber", replicaNum))
+		err = ob.scaleDown(collectionID, replicas)
+	default:
+		return
+	}
+	if err != nil {
+		log.RatedWarn(10, "failed to scale replica", zap.Error(err))
+		return
+	}
+	// the scaled collection has to wait for another sustained duration before scaling again
+	delete(ob.states, collectionID)
+}
+
+// scaleUp spawns a new replica, the nodes of it come from the nodes not in any replica of collection,
+// then the replicas with most nodes.
+func (ob *ReplicaAutoScaleObserver) scaleUp(collectionID int64, rgName string, replicas []*meta.Replica, metrics map[int64]*metricsinfo.QueryNodeQuotaMetrics) error {
+	nodes, err := ob.meta.ResourceManager.GetNodes(rgName)
+	if err != nil {
+		return err
+	}
+	if len(nodes) < len(replicas)+1 {
+		return meta.ErrNodeNotEnough
+	}
+	if err := checkMemoryHeadroom(nodes, len(replicas), metrics); err != nil {
+		return err
+	}
+
+	newReplicas, err := ob.meta.ReplicaManager.Spawn(collectionID, 1, rgName)
+	if err != nil {
+		return err
+	}
+	newReplica := newReplicas[0]
+
+	expected := len(nodes) / (len(replicas) + 1)
+	for _, node := range nodes {
+		if newReplica.Len() >= expected {
+			break
+		}
+		if ob.meta.ReplicaManager.GetByCollectionAndNode(collectionID, node) == nil {
+			newReplica.AddNode(node)
+		}
+	}
+
+	// donate nodes from the replicas with most nodes, and keep at least one node in each replica
+	donors := make(map[int64][]int64)
+	sizes := lo.SliceToMap(replicas, func(r *meta.Replica) (int64, int) { return r.GetID(), r.Len() })
+	for newReplica.Len() < expected {
+		sort.Slice(replicas, func(i, j int) bool {
+			return sizes[replicas[i].GetID()] > sizes[replicas[j].GetID()]
+		})
+		donor := replicas[0]
+		if sizes[donor.GetID()] <= 1 {
+			break
+		}
+		candidates := lo.Filter(donor.GetNodes(), func(node int64, _ int) bool {
+			return !lo.Contains(donors[donor.GetID()], node)
+		})
+		// the node with least memory usage holds least data to reload
+		node := lo.MinBy(candidates, func(a, b int64) bool {
+			return metrics[a].Hms.MemoryUsage < metrics[b].Hms.MemoryUsage
+		})
+		donors[donor.GetID()] = append(donors[donor.GetID()], node)
+		sizes[donor.GetID()]--
+		newReplica.AddNode(node)
+	}
+	if newReplica.Len() == 0 {
+		return meta.ErrNodeNotEnough
+	}
+
+	if err := ob.meta.ReplicaManager.Put(newReplica); err != nil {
+		return err
+	}
+	for replicaID, nodes := range donors {
+		if err := ob.meta.ReplicaManager.RemoveNode(replicaID, nodes...); err != nil {
+			log.Warn("failed to remove nodes from replica for scaling up",
+				zap.Int64("collectionID", collectionID),
+				zap.Int64("replicaID", replicaID),
+				zap.Int64s("nodes", nodes),
+				zap.Error(err))
+		}
+	}
+	log.Info("spawn replica for scaling up",
+		zap.Int64("collectionID", collectionID),
+		zap.Int64("replicaID", newReplica.GetID()),
+		zap.Int64s("nodes", newReplica.GetNodes()),
+	)
+	return ob.meta.CollectionManager.UpdateReplicaNumber(collectionID, int32(len(replicas)+1))
+}
+
+// scaleDown removes the replica with least nodes, and assigns its nodes to the other replicas.
+func (ob *ReplicaAutoScaleObserver) scaleDown(collectionID int64, replicas []*meta.Replica) error {
+	sort.Slice(replicas, func(i, j int) bool {
+		return replicas[i].Len() < replicas[j].Len()
+	})
+	removed, remained := replicas[0], replicas[1:]
+	if err := ob.meta.ReplicaManager.RemoveReplicas(collectionID, removed.GetID()); err != nil {
+		return err
+	}
+	for _, node := range removed.GetNodes() {
+		utils.AddNodesToReplicas(ob.meta, remained, node)
+	}
+	log.Info("remove replica for scaling down",
+		zap.Int64("collectionID", collectionID),
+		zap.Int64("replicaID", removed.GetID()),
+		zap.Int64s("nodes", removed.GetNodes()),
+	)
+	return ob.meta.CollectionManager.UpdateReplicaNumber(collectionID, int32(len(remained)))
+}
+
+// checkMemoryHeadroom checks whether the nodes still keep enough free memory after loading one more replica,
+// the memory of a replica is estimated by the used memory of nodes divided by the replica number.
+func checkMemoryHeadroom(nodes []int64, replicaNum int, metrics map[int64]*metricsinfo.QueryNodeQuotaMetrics) error {
+	var total, used uint64
+	for _, node := range nodes {
+		metric, ok := metrics[node]
+		if !ok {
+			return merr.WrapErrNodeNotAvailable(node, "no metrics to check memory")
+		}
+		total += metric.Hms.Memory
+		used += metric.Hms.MemoryUsage
+	}
+	replicaMemory := used / uint64(replicaNum)
+	limit := float64(total) * (1 - params.Params.QueryCoordCfg.AutoReplicaMemoryHeadroomRatio.GetAsFloat())
+	if float64(used+replicaMemory) > limit {
+		return merr.WrapErrServiceMemoryLimitExceeded(float32(used+replicaMemory), float32(limit), "no memory headroom to scale up replica")
+	}
+	return nil
+}
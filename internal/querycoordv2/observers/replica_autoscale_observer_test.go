@@ -0,0 +1,248 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+package observers
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/milvus-io/milvus-proto/go-api/v2/milvuspb"
+	"github.com/milvus-io/milvus/internal/kv"
+	etcdkv "github.com/milvus-io/milvus/internal/kv/etcd"
+	"github.com/milvus-io/milvus/internal/metastore/kv/querycoord"
+	"github.com/milvus-io/milvus/internal/proto/querypb"
+	"github.com/milvus-io/milvus/internal/querycoordv2/meta"
+	. "github.com/milvus-io/milvus/internal/querycoordv2/params"
+	"github.com/milvus-io/milvus/internal/querycoordv2/session"
+	"github.com/milvus-io/milvus/pkg/util/etcd"
+	"github.com/milvus-io/milvus/pkg/util/merr"
+	"github.com/milvus-io/milvus/pkg/util/metricsinfo"
+	"github.com/milvus-io/milvus/pkg/util/paramtable"
+	"github.com/milvus-io/milvus/pkg/util/typeutil"
+)
+
+type nodeLoad struct {
+	nq          float64
+	cpu         float64
+	memoryUsage uint64
+}
+
+type ReplicaAutoScaleObserverSuite struct {
+	suite.Suite
+
+	kv kv.MetaKv
+	// dependency
+	meta     *meta.Meta
+	nodeMgr  *session.NodeManager
+	cluster  *session.MockCluster
+	observer *ReplicaAutoScaleObserver
+
+	loads        map[int64]*nodeLoad
+	collectionID int64
+}
+
+func (suite *ReplicaAutoScaleObserverSuite) SetupSuite() {
+	paramtable.Init()
+}
+
+func (suite *ReplicaAutoScaleObserverSuite) SetupTest() {
+	paramtable.Get().Save(Params.QueryCoordCfg.AutoReplicaEnabled.Key, "true")
+	paramtable.Get().Save(Params.QueryCoordCfg.AutoReplicaSustainedSeconds.Key, "60")
+
+	config := GenerateEtcdConfig()
+	cli, err := etcd.GetEtcdClient(
+		config.UseEmbedEtcd.GetAsBool(),
+		config.EtcdUseSSL.GetAsBool(),
+		config.Endpoints.GetAsStrings(),
+		config.EtcdTLSCert.GetValue(),
+		config.EtcdTLSKey.GetValue(),
+		config.EtcdTLSCACert.GetValue(),
+		config.EtcdTLSMinVersion.GetValue())
+	suite.Require().NoError(err)
+	suite.kv = etcdkv.NewEtcdKV(cli, config.MetaRootPath.GetValue())
+
+	store := querycoord.NewCatalog(suite.kv)
+	suite.nodeMgr = session.NewNodeManager()
+	suite.meta = meta.NewMeta(RandomIncrementIDAllocator(), store, suite.nodeMgr)
+	suite.cluster = session.NewMockCluster(suite.T())
+	suite.observer = NewReplicaAutoScaleObserver(suite.meta, suite.cluster, suite.nodeMgr)
+
+	suite.collectionID = 1000
+	suite.loads = make(map[int64]*nodeLoad)
+	for node := int64(1); node <= 4; node++ {
+		suite.nodeMgr.Add(session.NewNodeInfo(node, "localhost"))
+		suite.meta.ResourceManager.AssignNode(meta.DefaultResourceGroupName, node)
+		suite.loads[node] = &nodeLoad{memoryUsage: 10}
+	}
+	suite.cluster.EXPECT().GetMetrics(mock.Anything, mock.Anything, mock.Anything).RunAndReturn(
+		func(ctx context.Context, node int64, req *milvuspb.GetMetricsRequest) (*milvuspb.GetMetricsResponse, error) {
+			load := suite.loads[node]
+			infos, err := metricsinfo.MarshalComponentInfos(metricsinfo.QueryNodeInfos{
+				QuotaMetrics: &metricsinfo.QueryNodeQuotaMetrics{
+					Hms: metricsinfo.HardwareMetrics{CPUCoreUsage: load.cpu, Memory: 100, MemoryUsage: load.memoryUsage},
+					Rms: []metricsinfo.RateMetric{{Label: metricsinfo.NQPerSecond, Rate: load.nq}},
+				},
+			})
+			suite.Require().NoError(err)
+			return &milvuspb.GetMetricsResponse{Status: merr.Success(), Response: infos}, nil
+		}).Maybe()
+}
+
+func (suite *ReplicaAutoScaleObserverSuite) TearDownTest() {
+	paramtable.Get().Reset(Params.QueryCoordCfg.AutoReplicaEnabled.Key)
+	paramtable.Get().Reset(Params.QueryCoordCfg.AutoReplicaSustainedSeconds.Key)
+	suite.kv.Close()
+}
+
+func (suite *ReplicaAutoScaleObserverSuite) loadCollection(nodes ...[]int64) {
+	err := suite.meta.CollectionManager.PutCollection(&meta.Collection{
+		CollectionLoadInfo: &querypb.CollectionLoadInfo{
+			CollectionID:  suite.collectionID,
+			ReplicaNumber: int32(len(nodes)),
+			Status:        querypb.LoadStatus_Loaded,
+		},
+	})
+	suite.Require().NoError(err)
+	for i, replicaNodes := range nodes {
+		replica := meta.NewReplica(&querypb.Replica{
+			ID:            int64(10000 + i),
+			CollectionID:  suite.collectionID,
+			ResourceGroup: meta.DefaultResourceGroupName,
+			Nodes:         replicaNodes,
+		}, typeutil.NewUniqueSet(replicaNodes...))
+		suite.Require().NoError(suite.meta.ReplicaManager.Put(replica))
+	}
+}
+
+func (suite *ReplicaAutoScaleObserverSuite) setLoad(nq float64, cpu float64) {
+	for _, load := range suite.loads {
+		load.nq, load.cpu = nq, cpu
+	}
+}
+
+func (suite *ReplicaAutoScaleObserverSuite) TestScaleUp() {
+	suite.loadCollection([]int64{1, 2, 3, 4})
+	suite.setLoad(2000, 50)
+
+	now := time.Now()
+	suite.observer.check(context.Background(), now)
+	suite.Len(suite.meta.ReplicaManager.GetByCollection(suite.collectionID), 1)
+
+	// not sustained long enough
+	suite.observer.check(context.Background(), now.Add(30*time.Second))
+	suite.Len(suite.meta.ReplicaManager.GetByCollection(suite.collectionID), 1)
+
+	suite.observer.check(context.Background(), now.Add(60*time.Second))
+	replicas := suite.meta.ReplicaManager.GetByCollection(suite.collectionID)
+	suite.Len(replicas, 2)
+	for _, replica := range replicas {
+		suite.Equal(2, replica.Len())
+	}
+	suite.EqualValues(2, suite.meta.CollectionManager.GetReplicaNumber(suite.collectionID))
+
+	// cool down after scaling
+	suite.observer.check(context.Background(), now.Add(90*time.Second))
+	suite.Len(suite.meta.ReplicaManager.GetByCollection(suite.collectionID), 2)
+}
+
+func (suite *ReplicaAutoScaleObserverSuite) TestScaleUpByCPU() {
+	suite.loadCollection([]int64{1, 2, 3, 4})
+	suite.setLoad(10, 90)
+
+	now := time.Now()
+	suite.observer.check(context.Background(), now)
+	suite.observer.check(context.Background(), now.Add(60*time.Second))
+	suite.Len(suite.meta.ReplicaManager.GetByCollection(suite.collectionID), 2)
+}
+
+func (suite *ReplicaAutoScaleObserverSuite) TestNoMemoryHeadroom() {
+	suite.loadCollection([]int64{1, 2, 3, 4})
+	suite.setLoad(2000, 90)
+	for _, load := range suite.loads {
+		load.memoryUsage = 50
+	}
+
+	now := time.Now()
+	suite.observer.check(context.Background(), now)
+	suite.observer.check(context.Background(), now.Add(60*time.Second))
+	suite.Len(suite.meta.ReplicaManager.GetByCollection(suite.collectionID), 1)
+	suite.EqualValues(1, suite.meta.CollectionManager.GetReplicaNumber(suite.collectionID))
+}
+
+func (suite *ReplicaAutoScaleObserverSuite) TestMaxReplicaNumber() {
+	paramtable.Get().Save(Params.QueryCoordCfg.AutoReplicaMaxNumber.Key, "2")
+	defer paramtable.Get().Reset(Params.QueryCoordCfg.AutoReplicaMaxNumber.Key)
+	suite.loadCollection([]int64{1, 2}, []int64{3, 4})
+	suite.setLoad(5000, 90)
+
+	now := time.Now()
+	suite.observer.check(context.Background(), now)
+	suite.observer.check(context.Background(), now.Add(60*time.Second))
+	suite.Len(suite.meta.ReplicaManager.GetByCollection(suite.collectionID), 2)
+}
+
+func (suite *ReplicaAutoScaleObserverSuite) TestScaleDown() {
+	suite.loadCollection([]int64{1, 2, 3}, []int64{4})
+	suite.setLoad(10, 10)
+
+	now := time.Now()
+	suite.observer.check(context.Background(), now)
+	suite.Len(suite.meta.ReplicaManager.GetByCollection(suite.collectionID), 2)
+
+	suite.observer.check(context.Background(), now.Add(60*time.Second))
+	replicas := suite.meta.ReplicaManager.GetByCollection(suite.collectionID)
+	suite.Len(replicas, 1)
+	suite.ElementsMatch([]int64{1, 2, 3, 4}, replicas[0].GetNodes())
+	suite.EqualValues(1, suite.meta.CollectionManager.GetReplicaNumber(suite.collectionID))
+
+	// never below the min replica number
+	suite.observer.check(context.Background(), now.Add(120*time.Second))
+	suite.observer.check(context.Background(), now.Add(180*time.Second))
+	suite.Len(suite.meta.ReplicaManager.GetByCollection(suite.collectionID), 1)
+}
+
+func (suite *ReplicaAutoScaleObserverSuite) TestLoadFluctuation() {
+	suite.loadCollection([]int64{1, 2, 3, 4})
+
+	now := time.Now()
+	suite.setLoad(2000, 50)
+	suite.observer.check(context.Background(), now)
+	suite.setLoad(500, 50)
+	suite.observer.check(context.Background(), now.Add(30*time.Second))
+	suite.setLoad(2000, 50)
+	suite.observer.check(context.Background(), now.Add(60*time.Second))
+	suite.Len(suite.meta.ReplicaManager.GetByCollection(suite.collectionID), 1)
+}
+
+func (suite *ReplicaAutoScaleObserverSuite) TestDisabled() {
+	paramtable.Get().Save(Params.QueryCoordCfg.AutoReplicaEnabled.Key, "false")
+	suite.loadCollection([]int64{1, 2, 3, 4})
+	suite.setLoad(2000, 90)
+
+	now := time.Now()
+	suite.observer.check(context.Background(), now)
+	suite.observer.check(context.Background(), now.Add(60*time.Second))
+	suite.Len(suite.meta.ReplicaManager.GetByCollection(suite.collectionID), 1)
+	suite.Empty(suite.observer.states)
+}
+
+func TestReplicaAutoScaleObserver(t *testing.T) {
+	suite.Run(t, new(ReplicaAutoScaleObserverSuite))
+}
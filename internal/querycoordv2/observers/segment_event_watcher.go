@@ -0,0 +1,126 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package observers
+
+import (
+	"context"
+	"time"
+
+	v3rpc "go.etcd.io/etcd/api/v3/v3rpc/rpctypes"
+	clientv3 "go.etcd.io/etcd/client/v3"
+	"go.uber.org/zap"
+
+	"github.com/milvus-io/milvus/internal/kv"
+	"github.com/milvus-io/milvus/internal/util/segmentevent"
+	"github.com/milvus-io/milvus/pkg/log"
+)
+
+// segmentEventRewatchInterval is the interval to watch again after the watch broken.
+const segmentEventRewatchInterval = time.Second
+
+// segmentEventWatcher watches the segment events pushed by DataCoord,
+// the revision of the last received event is the cursor to resume watching after the watch broken,
+// so no event is missed unless etcd compacted the revisions since the cursor.
+type segmentEventWatcher struct {
+	kv kv.WatchKV
+	// onEvent is called with the collections of the events in each watch response
+	onEvent func(collectionIDs ...int64)
+	// onReset is called if the events since the cursor are lost
+	onReset func()
+
+	revision int64
+}
+
+func newSegmentEventWatcher(kv kv.WatchKV, onEvent func(collectionIDs ...int64), onReset func()) *segmentEventWatcher {
+	return &segmentEventWatcher{
+		kv:      kv,
+		onEvent: onEvent,
+		onReset: onReset,
+	}
+}
+
+func (w *segmentEventWatcher) watch(ctx context.Context) {
+	log.Info("start watching segment events")
+	for {
+		var watchChan clientv3.WatchChan
+		if w.revision == 0 {
+			watchChan = w.kv.WatchWithPrefix(segmentevent.Prefix)
+		} else {
+			watchChan = w.kv.WatchWithRevision(segmentevent.Prefix, w.revision+1)
+		}
+		w.consume(ctx, watchChan)
+
+		select {
+		case <-ctx.Done():
+			log.Info("stop watching segment events")
+			return
+		case <-time.After(segmentEventRewatchInterval):
+		}
+	}
+}
+
+// consume handles the watch responses until the watch broken.
+func (w *segmentEventWatcher) consume(ctx context.Context, watchChan clientv3.WatchChan) {
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case resp, ok := <-watchChan:
+			if !ok {
+				log.Warn("segment event watch closed, watch again", zap.Int64("revision", w.revision))
+				return
+			}
+			if err := resp.Err(); err != nil {
+				if err == v3rpc.ErrCompacted {
+					log.Warn("segment events since the revision are compacted, pull the targets of all collections",
+						zap.Int64("revision", w.revision),
+						zap.Int64("compactRevision", resp.CompactRevision))
+					w.revision = 0
+					w.onReset()
+				} else {
+					log.Warn("segment event watch failed, watch again", zap.Int64("revision", w.revision), zap.Error(err))
+				}
+				return
+			}
+			w.handle(resp)
+		}
+	}
+}
+
+func (w *segmentEventWatcher) handle(resp clientv3.WatchResponse) {
+	if resp.Created && w.revision == 0 {
+		w.revision = resp.Header.GetRevision()
+	}
+	collectionIDs := make([]int64, 0, len(resp.Events))
+	for _, event := range resp.Events {
+		if event.Kv.ModRevision > w.revision {
+			w.revision = event.Kv.ModRevision
+		}
+		if event.Type != clientv3.EventTypePut {
+			continue
+		}
+		collectionID, err := segmentevent.ParseCollectionID(string(event.Kv.Key))
+		if err != nil {
+			log.Warn("invalid segment event key", zap.ByteString("key", event.Kv.Key), zap.Error(err))
+			continue
+		}
+		collectionIDs = append(collectionIDs, collectionID)
+	}
+	if len(collectionIDs) > 0 {
+		w.onEvent(collectionIDs...)
+	}
+}
@@ -0,0 +1,86 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package observers
+
+import (
+	"context"
+	"path"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"go.etcd.io/etcd/api/v3/etcdserverpb"
+	"go.etcd.io/etcd/api/v3/mvccpb"
+	clientv3 "go.etcd.io/etcd/client/v3"
+
+	"github.com/milvus-io/milvus/internal/kv/mocks"
+	"github.com/milvus-io/milvus/internal/util/segmentevent"
+)
+
+func newSegmentEventPut(collectionID int64, revision int64) *clientv3.Event {
+	return &clientv3.Event{
+		Type: mvccpb.PUT,
+		Kv: &mvccpb.KeyValue{
+			Key:         []byte(path.Join("by-dev/meta", segmentevent.Key(collectionID))),
+			ModRevision: revision,
+		},
+	}
+}
+
+func TestSegmentEventWatcher(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	watchKV := mocks.NewWatchKV(t)
+	events := make([]int64, 0)
+	resetCount := 0
+	watcher := newSegmentEventWatcher(watchKV, func(collectionIDs ...int64) {
+		events = append(events, collectionIDs...)
+	}, func() {
+		resetCount++
+	})
+
+	// the first watch is created at revision 10, then broken after receiving the events
+	first := make(chan clientv3.WatchResponse, 3)
+	first <- clientv3.WatchResponse{Header: etcdserverpb.ResponseHeader{Revision: 10}, Created: true}
+	first <- clientv3.WatchResponse{Events: []*clientv3.Event{
+		newSegmentEventPut(1, 11),
+		{Type: mvccpb.PUT, Kv: &mvccpb.KeyValue{Key: []byte("by-dev/meta/datacoord-segment-event/invalid"), ModRevision: 12}},
+	}}
+	first <- clientv3.WatchResponse{Events: []*clientv3.Event{
+		{Type: mvccpb.DELETE, Kv: &mvccpb.KeyValue{Key: []byte(segmentevent.Key(2)), ModRevision: 13}},
+		newSegmentEventPut(3, 14),
+	}}
+	close(first)
+	watchKV.EXPECT().WatchWithPrefix(segmentevent.Prefix).Return(first).Once()
+
+	// resumed from the last received revision, but the revisions are compacted
+	second := make(chan clientv3.WatchResponse, 1)
+	second <- clientv3.WatchResponse{CompactRevision: 20}
+	close(second)
+	watchKV.EXPECT().WatchWithRevision(segmentevent.Prefix, int64(15)).Return(second).Once()
+
+	// watch from the latest revision again after reset
+	watchKV.EXPECT().WatchWithPrefix(segmentevent.Prefix).RunAndReturn(func(string) clientv3.WatchChan {
+		cancel()
+		return make(chan clientv3.WatchResponse)
+	}).Once()
+
+	watcher.watch(ctx)
+	assert.Equal(t, []int64{1, 3}, events)
+	assert.Equal(t, 1, resetCount)
+	assert.EqualValues(t, 0, watcher.revision)
+}
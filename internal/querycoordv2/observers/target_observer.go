@@ -23,6 +23,7 @@ import (
 
 	"go.uber.org/zap"
 
+	"github.com/milvus-io/milvus/internal/kv"
 	"github.com/milvus-io/milvus/internal/querycoordv2/meta"
 	"github.com/milvus-io/milvus/internal/querycoordv2/params"
 	"github.com/milvus-io/milvus/internal/querycoordv2/utils"
@@ -61,6 +62,11 @@ type TargetObserver struct {
 
 	dispatcher *taskDispatcher[int64]
 
+	// nil unless watching the segment events pushed by DataCoord
+	segmentEventWatcher *segmentEventWatcher
+	// the collections of which the segments changed since the next target updated
+	segmentChanged *typeutil.ConcurrentSet[int64]
+
 	stopOnce sync.Once
 }
 
@@ -75,6 +81,7 @@ func NewTargetObserver(meta *meta.Meta, targetMgr *meta.TargetManager, distMgr *
 		updateChan:           make(chan targetUpdateRequest),
 		readyNotifiers:       make(map[int64][]chan struct{}),
 		initChan:             make(chan initRequest),
+		segmentChanged:       typeutil.NewConcurrentSet[int64](),
 	}
 
 	dispatcher := newTaskDispatcher(result.check)
@@ -94,10 +101,35 @@ func (ob *TargetObserver) Start() {
 		ob.schedule(ctx)
 	}()
 
+	if ob.segmentEventWatcher != nil {
+		ob.wg.Add(1)
+		go func() {
+			defer ob.wg.Done()
+			ob.segmentEventWatcher.watch(ctx)
+		}()
+	}
+
 	// after target observer start, update target for all collection
 	ob.initChan <- initRequest{}
 }
 
+// WatchSegmentEvents updates the next target once the segments of the collection flushed, dropped or compacted,
+// rather than pulling the next targets periodically, must be called before Start.
+func (ob *TargetObserver) WatchSegmentEvents(watchKV kv.WatchKV) {
+	ob.segmentEventWatcher = newSegmentEventWatcher(watchKV, ob.onSegmentChanged, func() {
+		ob.onSegmentChanged(ob.meta.GetAll()...)
+	})
+}
+
+func (ob *TargetObserver) onSegmentChanged(collectionIDs ...int64) {
+	for _, collectionID := range collectionIDs {
+		if ob.meta.Exist(collectionID) {
+			ob.segmentChanged.Insert(collectionID)
+			ob.dispatcher.AddTask(collectionID)
+		}
+	}
+}
+
 func (ob *TargetObserver) Stop() {
 	ob.stopOnce.Do(func() {
 		if ob.cancel != nil {
@@ -234,7 +266,10 @@ func (ob *TargetObserver) clean() {
 }
 
 func (ob *TargetObserver) shouldUpdateNextTarget(collectionID int64) bool {
-	return !ob.targetMgr.IsNextTargetExist(collectionID) || ob.isNextTargetExpired(collectionID)
+	// the segment changed flag is removed before pulling the target,
+	// so the events received during pulling trigger another update
+	segmentChanged := ob.segmentChanged.TryRemove(collectionID)
+	return segmentChanged || !ob.targetMgr.IsNextTargetExist(collectionID) || ob.isNextTargetExpired(collectionID)
 }
 
 func (ob *TargetObserver) isNextTargetExpired(collectionID int64) bool {
@@ -242,7 +277,11 @@ func (ob *TargetObserver) isNextTargetExpired(collectionID int64) bool {
 	if !has {
 		return true
 	}
-	return time.Since(lastUpdated) > params.Params.QueryCoordCfg.NextTargetSurviveTime.GetAsDuration(time.Second)
+	surviveTime := params.Params.QueryCoordCfg.NextTargetSurviveTime.GetAsDuration(time.Second)
+	if ob.segmentEventWatcher != nil {
+		surviveTime = params.Params.QueryCoordCfg.SegmentEventFallbackPullInterval.GetAsDuration(time.Second)
+	}
+	return time.Since(lastUpdated) > surviveTime
 }
 
 func (ob *TargetObserver) updateNextTarget(collectionID int64) error {
@@ -279,6 +279,17 @@ func (s *TargetObserverCheckSuite) TestCheck() {
 	s.True(s.observer.dispatcher.tasks.Contain(s.collectionID))
 }
 
+func (s *TargetObserverCheckSuite) TestSegmentChanged() {
+	s.observer.onSegmentChanged(s.collectionID, s.collectionID+1)
+	s.True(s.observer.segmentChanged.Contain(s.collectionID))
+	s.True(s.observer.dispatcher.tasks.Contain(s.collectionID))
+	// the collection not loaded is ignored
+	s.False(s.observer.segmentChanged.Contain(s.collectionID + 1))
+
+	s.True(s.observer.shouldUpdateNextTarget(s.collectionID))
+	s.False(s.observer.segmentChanged.Contain(s.collectionID))
+}
+
 func TestTargetObserver(t *testing.T) {
 	suite.Run(t, new(TargetObserverSuite))
 	suite.Run(t, new(TargetObserverCheckSuite))
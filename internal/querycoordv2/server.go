@@ -33,12 +33,14 @@ import (
 	"github.com/milvus-io/milvus-proto/go-api/v2/commonpb"
 	"github.com/milvus-io/milvus-proto/go-api/v2/milvuspb"
 	"github.com/milvus-io/milvus/internal/allocator"
+	"github.com/milvus-io/milvus/internal/http/healthz"
 	"github.com/milvus-io/milvus/internal/kv"
 	etcdkv "github.com/milvus-io/milvus/internal/kv/etcd"
 	"github.com/milvus-io/milvus/internal/kv/tikv"
 	"github.com/milvus-io/milvus/internal/metastore"
 	"github.com/milvus-io/milvus/internal/metastore/kv/querycoord"
 	"github.com/milvus-io/milvus/internal/proto/internalpb"
+	"github.com/milvus-io/milvus/internal/proto/querypb"
 	"github.com/milvus-io/milvus/internal/querycoordv2/balance"
 	"github.com/milvus-io/milvus/internal/querycoordv2/checkers"
 	"github.com/milvus-io/milvus/internal/querycoordv2/dist"
@@ -53,6 +55,7 @@ import (
 	"github.com/milvus-io/milvus/internal/util/sessionutil"
 	"github.com/milvus-io/milvus/internal/util/tsoutil"
 	"github.com/milvus-io/milvus/pkg/common"
+	"github.com/milvus-io/milvus/pkg/eventlog"
 	"github.com/milvus-io/milvus/pkg/log"
 	"github.com/milvus-io/milvus/pkg/metrics"
 	"github.com/milvus-io/milvus/pkg/util"
@@ -111,6 +114,10 @@ type Server struct {
 	targetObserver     *observers.TargetObserver
 	replicaObserver    *observers.ReplicaObserver
 	resourceObserver   *observers.ResourceObserver
+	autoScaleObserver  *observers.ReplicaAutoScaleObserver
+	scheduleObserver   *observers.LoadScheduleObserver
+	recoveryObserver   *observers.LoadRecoveryObserver
+	loadConfigObserver *observers.LoadConfigObserver
 
 	balancer    balance.Balance
 	balancerMap map[string]balance.Balance
@@ -294,6 +301,7 @@ func (s *Server) initQueryCoord() error {
 		s.nodeMgr,
 		s.taskScheduler,
 		s.broker,
+		s.cluster,
 	)
 
 	// Init observers
@@ -344,6 +352,12 @@ func (s *Server) initMeta() error {
 		return err
 	}
 
+	err = s.meta.LoadScheduleManager.Recover()
+	if err != nil {
+		log.Warn("failed to recover load schedules", zap.Error(err))
+		return err
+	}
+
 	s.dist = &meta.DistributionManager{
 		SegmentDistManager: meta.NewSegmentDistManager(),
 		ChannelDistManager: meta.NewChannelDistManager(),
@@ -369,6 +383,10 @@ func (s *Server) initObserver() {
 		s.dist,
 		s.broker,
 	)
+	if Params.QueryCoordCfg.SegmentEventWatchEnabled.GetAsBool() {
+		// DataCoord pushes the segment events into etcd, even though the meta is stored in tikv
+		s.targetObserver.WatchSegmentEvents(etcdkv.NewEtcdKV(s.etcdCli, Params.EtcdCfg.MetaRootPath.GetValue()))
+	}
 	s.collectionObserver = observers.NewCollectionObserver(
 		s.dist,
 		s.meta,
@@ -384,6 +402,32 @@ func (s *Server) initObserver() {
 	)
 
 	s.resourceObserver = observers.NewResourceObserver(s.meta)
+
+	s.autoScaleObserver = observers.NewReplicaAutoScaleObserver(
+		s.meta,
+		s.cluster,
+		s.nodeMgr,
+	)
+
+	s.scheduleObserver = observers.NewLoadScheduleObserver(
+		s.meta,
+		s.loadScheduledCollection,
+		s.releaseScheduledCollection,
+	)
+
+	s.recoveryObserver = observers.NewLoadRecoveryObserver(
+		s.meta,
+		s.dist,
+		s.targetMgr,
+		s.broker,
+		s.reloadCollection,
+	)
+
+	s.loadConfigObserver = observers.NewLoadConfigObserver(
+		s.meta,
+		s.dist,
+		s.targetMgr,
+	)
 }
 
 func (s *Server) afterStart() {
@@ -427,6 +471,12 @@ func (s *Server) startQueryCoord() error {
 
 	s.startServerLoop()
 	s.afterStart()
+	registerBalanceExplainHandler(s.meta, s.dist, s.balancer)
+	registerLoadScheduleHandler(s.meta)
+	registerLoadIncidentHandler(s.recoveryObserver)
+	registerLoadFeasibilityHandler(s)
+	registerLoadConfigHandler(s.meta)
+	healthz.RegisterCheck(typeutil.QueryCoordRole, healthz.TargetsReadyCheck, s.checkTargetsReady)
 	s.UpdateStateCode(commonpb.StateCode_Healthy)
 	sessionutil.SaveServerInfo(typeutil.QueryCoordRole, s.session.ServerID)
 	return nil
@@ -444,6 +494,10 @@ func (s *Server) startServerLoop() {
 	s.targetObserver.Start()
 	s.replicaObserver.Start()
 	s.resourceObserver.Start()
+	s.autoScaleObserver.Start()
+	s.scheduleObserver.Start()
+	s.recoveryObserver.Start()
+	s.loadConfigObserver.Start()
 
 	log.Info("start task scheduler...")
 	s.taskScheduler.Start()
@@ -455,6 +509,20 @@ func (s *Server) startServerLoop() {
 	s.jobScheduler.Start()
 }
 
+// checkTargetsReady returns error if the current targets of some loaded collections are not ready, e.g. after recovery.
+func (s *Server) checkTargetsReady(ctx context.Context) error {
+	notReady := make([]int64, 0)
+	for _, collection := range s.meta.CollectionManager.GetAllCollections() {
+		if collection.GetStatus() == querypb.LoadStatus_Loaded && !s.targetMgr.IsCurrentTargetExist(collection.GetCollectionID()) {
+			notReady = append(notReady, collection.GetCollectionID())
+		}
+	}
+	if len(notReady) > 0 {
+		return fmt.Errorf("the current targets of collections %v are not ready", notReady)
+	}
+	return nil
+}
+
 func (s *Server) Stop() error {
 	// stop the components from outside to inside,
 	// to make the dependencies stopped working properly,
@@ -496,6 +564,18 @@ func (s *Server) Stop() error {
 	if s.resourceObserver != nil {
 		s.resourceObserver.Stop()
 	}
+	if s.autoScaleObserver != nil {
+		s.autoScaleObserver.Stop()
+	}
+	if s.scheduleObserver != nil {
+		s.scheduleObserver.Stop()
+	}
+	if s.recoveryObserver != nil {
+		s.recoveryObserver.Stop()
+	}
+	if s.loadConfigObserver != nil {
+		s.loadConfigObserver.Stop()
+	}
 
 	if s.distController != nil {
 		log.Info("stop dist controller...")
@@ -647,6 +727,12 @@ func (s *Server) watchNodes(revision int64) {
 				s.nodeMgr.Remove(nodeID)
 				s.handleNodeDown(nodeID)
 				s.metricsCacheManager.InvalidateSystemInfoMetrics()
+				eventlog.Publish(&eventlog.ClusterEvent{
+					EventType: eventlog.EventNodeDown,
+					Role:      typeutil.QueryNodeRole,
+					NodeID:    nodeID,
+					Attrs:     map[string]string{"address": event.Session.Address},
+				})
 			}
 		}
 	}
@@ -507,6 +507,7 @@ func (suite *ServerSuite) hackServer() {
 		suite.server.nodeMgr,
 		suite.server.taskScheduler,
 		suite.server.broker,
+		suite.server.cluster,
 	)
 	suite.server.targetObserver = observers.NewTargetObserver(
 		suite.server.meta,
@@ -524,6 +525,7 @@ func (suite *ServerSuite) hackServer() {
 	)
 
 	suite.broker.EXPECT().GetCollectionSchema(mock.Anything, mock.Anything).Return(&schemapb.CollectionSchema{}, nil).Maybe()
+	suite.broker.EXPECT().GetCollectionProperties(mock.Anything, mock.Anything).Return(nil, nil).Maybe()
 	suite.broker.EXPECT().DescribeIndex(mock.Anything, mock.Anything).Return(nil, nil).Maybe()
 	for _, collection := range suite.collections {
 		suite.broker.EXPECT().GetPartitions(mock.Anything, collection).Return(suite.partitions[collection], nil).Maybe()
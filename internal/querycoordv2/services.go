@@ -283,6 +283,7 @@ func (s *Server) ReleaseCollection(ctx context.Context, req *querypb.ReleaseColl
 	log.Info("collection released")
 	metrics.QueryCoordReleaseLatency.WithLabelValues().Observe(float64(tr.ElapseSpan().Milliseconds()))
 	meta.GlobalFailedLoadCache.Remove(req.GetCollectionID())
+	meta.GlobalLoadTraces.End(req.GetCollectionID(), merr.WrapErrCollectionNotLoaded(req.GetCollectionID(), "collection released"))
 
 	return merr.Success(), nil
 }
@@ -948,6 +949,14 @@ func (s *Server) GetShardLeaders(ctx context.Context, req *querypb.GetShardLeade
 			addrs = append(addrs, info.Addr())
 		}
 
+		if len(ids) == 0 && Params.QueryNodeCfg.DelegatorFallbackEnabled.GetAsBool() {
+			ids, addrs = s.getFallbackShardNodes(req.GetCollectionID(), channel.GetChannelName())
+			if len(ids) > 0 {
+				log.Warn("no shard leader available, fallback to the QueryNodes hosting the sealed segments",
+					zap.Int64s("nodes", ids), zap.Error(channelErr))
+			}
+		}
+
 		if len(ids) == 0 {
 			msg := fmt.Sprintf("channel %s is not available in any replica", channel.GetChannelName())
 			log.Warn(msg, zap.Error(channelErr))
@@ -1165,6 +1174,7 @@ func (s *Server) TransferReplica(ctx context.Context, req *querypb.TransferRepli
 	if err != nil {
 		return merr.Status(err), nil
 	}
+	s.resetLoadConfigResourceGroups(req.GetCollectionID())
 
 	return merr.Success(), nil
 }
@@ -1318,7 +1318,7 @@ func (suite *ServiceSuite) TestGetMetrics() {
 	server := suite.server
 
 	for _, node := range suite.nodes {
-		suite.cluster.EXPECT().GetMetrics(ctx, node, mock.Anything).Return(&milvuspb.GetMetricsResponse{
+		suite.cluster.EXPECT().GetMetrics(mock.Anything, node, mock.Anything).Return(&milvuspb.GetMetricsResponse{
 			Status:        merr.Success(),
 			ComponentName: "QueryNode",
 		}, nil)
@@ -1563,6 +1563,49 @@ func (suite *ServiceSuite) TestGetShardLeadersFailed() {
 	suite.True(errors.Is(merr.Error(resp.GetStatus()), merr.ErrCollectionNotLoaded))
 }
 
+func (suite *ServiceSuite) TestGetShardLeadersFallback() {
+	suite.loadAll()
+	ctx := context.Background()
+	server := suite.server
+
+	paramtable.Get().Save(Params.QueryNodeCfg.DelegatorFallbackEnabled.Key, "true")
+	defer paramtable.Get().Reset(Params.QueryNodeCfg.DelegatorFallbackEnabled.Key)
+	suite.fetchHeartbeats(time.Now())
+	for _, collection := range suite.collections {
+		suite.updateCollectionStatus(collection, querypb.LoadStatus_Loaded)
+		req := &querypb.GetShardLeadersRequest{
+			CollectionID: collection,
+		}
+
+		// no delegator and no sealed segment
+		resp, err := server.GetShardLeaders(ctx, req)
+		suite.NoError(err)
+		suite.ErrorIs(merr.Error(resp.GetStatus()), merr.ErrChannelNotAvailable)
+
+		// the first node of each replica hosts the sealed segments of all channels
+		channels := suite.channels[collection]
+		for _, replica := range suite.meta.ReplicaManager.GetByCollection(collection) {
+			node := suite.sortInt64(replica.GetNodes())[0]
+			segments := make([]*meta.Segment, 0)
+			for partition, segmentIDs := range suite.segments[collection] {
+				for i, segmentID := range segmentIDs {
+					segments = append(segments,
+						utils.CreateTestSegment(collection, partition, segmentID, node, 1, channels[i%len(channels)]))
+				}
+			}
+			suite.dist.SegmentDistManager.Update(node, segments...)
+		}
+
+		resp, err = server.GetShardLeaders(ctx, req)
+		suite.NoError(err)
+		suite.Equal(commonpb.ErrorCode_Success, resp.GetStatus().GetErrorCode())
+		suite.Len(resp.Shards, len(channels))
+		for _, shard := range resp.Shards {
+			suite.Len(shard.NodeIds, int(suite.replicaNumber[collection]))
+		}
+	}
+}
+
 func (suite *ServiceSuite) TestHandleNodeUp() {
 	server := suite.server
 	suite.server.meta.CollectionManager.PutCollection(utils.CreateTestCollection(1, 1))
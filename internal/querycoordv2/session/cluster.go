@@ -55,6 +55,7 @@ type Cluster interface {
 	ReleasePartitions(ctx context.Context, nodeID int64, req *querypb.ReleasePartitionsRequest) (*commonpb.Status, error)
 	GetDataDistribution(ctx context.Context, nodeID int64, req *querypb.GetDataDistributionRequest) (*querypb.GetDataDistributionResponse, error)
 	GetMetrics(ctx context.Context, nodeID int64, req *milvuspb.GetMetricsRequest) (*milvuspb.GetMetricsResponse, error)
+	GetSegmentInfo(ctx context.Context, nodeID int64, req *querypb.GetSegmentInfoRequest) (*querypb.GetSegmentInfoResponse, error)
 	SyncDistribution(ctx context.Context, nodeID int64, req *querypb.SyncDistributionRequest) (*commonpb.Status, error)
 	GetComponentStates(ctx context.Context, nodeID int64) (*milvuspb.ComponentStates, error)
 	Start()
@@ -232,6 +233,20 @@ func (c *QueryCluster) GetMetrics(ctx context.Context, nodeID int64, req *milvus
 	return resp, err
 }
 
+func (c *QueryCluster) GetSegmentInfo(ctx context.Context, nodeID int64, req *querypb.GetSegmentInfoRequest) (*querypb.GetSegmentInfoResponse, error) {
+	var (
+		resp *querypb.GetSegmentInfoResponse
+		err  error
+	)
+	err1 := c.send(ctx, nodeID, func(cli types.QueryNodeClient) {
+		resp, err = cli.GetSegmentInfo(ctx, req)
+	})
+	if err1 != nil {
+		return nil, err1
+	}
+	return resp, err
+}
+
 func (c *QueryCluster) SyncDistribution(ctx context.Context, nodeID int64, req *querypb.SyncDistributionRequest) (*commonpb.Status, error) {
 	var (
 		resp *commonpb.Status
@@ -0,0 +1,93 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package session
+
+import (
+	"sync"
+	"time"
+
+	"github.com/milvus-io/milvus/pkg/util/paramtable"
+)
+
+const (
+	heartbeatJitterWeight = 0.2
+	taskFailureWeight     = 0.4
+	rpcErrorWeight        = 0.4
+)
+
+// health tracks the exponential moving averages of the signals of a node,
+// a node keeps responding but with a bad score is considered gray failing.
+type health struct {
+	mu              sync.Mutex
+	lastHeartbeat   time.Time
+	heartbeatJitter float64
+	taskFailureRate float64
+	rpcErrorRate    float64
+}
+
+func (h *health) smooth(avg, sample float64) float64 {
+	alpha := paramtable.Get().QueryCoordCfg.HealthScoreSmoothingFactor.GetAsFloat()
+	if alpha <= 0 || alpha > 1 {
+		alpha = 1
+	}
+	return alpha*sample + (1-alpha)*avg
+}
+
+// recordHeartbeat updates the jitter with the relative deviation of heartbeat interval from the expected one.
+func (h *health) recordHeartbeat(ts time.Time, expected time.Duration) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	if !h.lastHeartbeat.IsZero() && expected > 0 {
+		deviation := float64(ts.Sub(h.lastHeartbeat)-expected) / float64(expected)
+		if deviation < 0 {
+			deviation = -deviation
+		}
+		if deviation > 1 {
+			deviation = 1
+		}
+		h.heartbeatJitter = h.smooth(h.heartbeatJitter, deviation)
+	}
+	h.lastHeartbeat = ts
+}
+
+func (h *health) recordTaskResult(success bool) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	h.taskFailureRate = h.smooth(h.taskFailureRate, failureSample(success))
+}
+
+func (h *health) recordRPCResult(success bool) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	h.rpcErrorRate = h.smooth(h.rpcErrorRate, failureSample(success))
+}
+
+// score returns the health score in [0, 1], 1 for a fully healthy node.
+func (h *health) score() float64 {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	return 1 - (heartbeatJitterWeight*h.heartbeatJitter +
+		taskFailureWeight*h.taskFailureRate +
+		rpcErrorWeight*h.rpcErrorRate)
+}
+
+func failureSample(success bool) float64 {
+	if success {
+		return 0
+	}
+	return 1
+}
@@ -0,0 +1,95 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package session
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/suite"
+
+	"github.com/milvus-io/milvus/pkg/util/paramtable"
+)
+
+type HealthSuite struct {
+	suite.Suite
+	node *NodeInfo
+}
+
+func (s *HealthSuite) SetupSuite() {
+	paramtable.Init()
+}
+
+func (s *HealthSuite) SetupTest() {
+	s.node = NewNodeInfo(1, "127.0.0.1:0")
+}
+
+func (s *HealthSuite) TestHealthy() {
+	s.Equal(1.0, s.node.HealthScore())
+	s.False(s.node.IsGrayFailing())
+
+	interval := paramtable.Get().QueryCoordCfg.DistPullInterval.GetAsDuration(time.Millisecond)
+	now := time.Now()
+	for i := 0; i < 10; i++ {
+		s.node.SetLastHeartbeat(now.Add(time.Duration(i) * interval))
+		s.node.RecordRPCResult(true)
+		s.node.RecordTaskResult(true)
+	}
+	s.InDelta(1.0, s.node.HealthScore(), 1e-6)
+	s.False(s.node.IsGrayFailing())
+}
+
+func (s *HealthSuite) TestHeartbeatJitter() {
+	interval := paramtable.Get().QueryCoordCfg.DistPullInterval.GetAsDuration(time.Millisecond)
+	now := time.Now()
+	for i := 0; i < 20; i++ {
+		now = now.Add(interval * 3)
+		s.node.SetLastHeartbeat(now)
+	}
+	// jitter contributes at most its weight
+	s.InDelta(1-heartbeatJitterWeight, s.node.HealthScore(), 0.01)
+	s.False(s.node.IsGrayFailing())
+}
+
+func (s *HealthSuite) TestGrayFailing() {
+	for i := 0; i < 10; i++ {
+		s.node.RecordRPCResult(false)
+		s.node.RecordTaskResult(false)
+	}
+	s.Less(s.node.HealthScore(), 0.6)
+	s.True(s.node.IsGrayFailing())
+
+	// disabled
+	paramtable.Get().Save(paramtable.Get().QueryCoordCfg.GrayFailureScoreThreshold.Key, "0")
+	defer paramtable.Get().Reset(paramtable.Get().QueryCoordCfg.GrayFailureScoreThreshold.Key)
+	s.False(s.node.IsGrayFailing())
+}
+
+func (s *HealthSuite) TestRecover() {
+	for i := 0; i < 10; i++ {
+		s.node.RecordRPCResult(false)
+	}
+	low := s.node.HealthScore()
+	for i := 0; i < 10; i++ {
+		s.node.RecordRPCResult(true)
+	}
+	s.Greater(s.node.HealthScore(), low)
+}
+
+func TestHealth(t *testing.T) {
+	suite.Run(t, new(HealthSuite))
+}
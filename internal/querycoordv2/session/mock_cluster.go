@@ -194,6 +194,62 @@ func (_c *MockCluster_GetMetrics_Call) RunAndReturn(run func(context.Context, in
 	return _c
 }
 
+// GetSegmentInfo provides a mock function with given fields: ctx, nodeID, req
+func (_m *MockCluster) GetSegmentInfo(ctx context.Context, nodeID int64, req *querypb.GetSegmentInfoRequest) (*querypb.GetSegmentInfoResponse, error) {
+	ret := _m.Called(ctx, nodeID, req)
+
+	var r0 *querypb.GetSegmentInfoResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, int64, *querypb.GetSegmentInfoRequest) (*querypb.GetSegmentInfoResponse, error)); ok {
+		return rf(ctx, nodeID, req)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int64, *querypb.GetSegmentInfoRequest) *querypb.GetSegmentInfoResponse); ok {
+		r0 = rf(ctx, nodeID, req)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*querypb.GetSegmentInfoResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int64, *querypb.GetSegmentInfoRequest) error); ok {
+		r1 = rf(ctx, nodeID, req)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockCluster_GetSegmentInfo_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetSegmentInfo'
+type MockCluster_GetSegmentInfo_Call struct {
+	*mock.Call
+}
+
+// GetSegmentInfo is a helper method to define mock.On call
+//   - ctx context.Context
+//   - nodeID int64
+//   - req *querypb.GetSegmentInfoRequest
+func (_e *MockCluster_Expecter) GetSegmentInfo(ctx interface{}, nodeID interface{}, req interface{}) *MockCluster_GetSegmentInfo_Call {
+	return &MockCluster_GetSegmentInfo_Call{Call: _e.mock.On("GetSegmentInfo", ctx, nodeID, req)}
+}
+
+func (_c *MockCluster_GetSegmentInfo_Call) Run(run func(ctx context.Context, nodeID int64, req *querypb.GetSegmentInfoRequest)) *MockCluster_GetSegmentInfo_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int64), args[2].(*querypb.GetSegmentInfoRequest))
+	})
+	return _c
+}
+
+func (_c *MockCluster_GetSegmentInfo_Call) Return(_a0 *querypb.GetSegmentInfoResponse, _a1 error) *MockCluster_GetSegmentInfo_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockCluster_GetSegmentInfo_Call) RunAndReturn(run func(context.Context, int64, *querypb.GetSegmentInfoRequest) (*querypb.GetSegmentInfoResponse, error)) *MockCluster_GetSegmentInfo_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // LoadPartitions provides a mock function with given fields: ctx, nodeID, req
 func (_m *MockCluster) LoadPartitions(ctx context.Context, nodeID int64, req *querypb.LoadPartitionsRequest) (*commonpb.Status, error) {
 	ret := _m.Called(ctx, nodeID, req)
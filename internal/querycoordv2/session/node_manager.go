@@ -24,6 +24,7 @@ import (
 	"go.uber.org/atomic"
 
 	"github.com/milvus-io/milvus/pkg/metrics"
+	"github.com/milvus-io/milvus/pkg/util/paramtable"
 )
 
 type Manager interface {
@@ -51,6 +52,7 @@ func (m *NodeManager) Remove(nodeID int64) {
 	defer m.mu.Unlock()
 	delete(m.nodes, nodeID)
 	metrics.QueryCoordNumQueryNodes.WithLabelValues().Set(float64(len(m.nodes)))
+	metrics.QueryCoordNodeHealthScore.DeleteLabelValues(fmt.Sprint(nodeID))
 }
 
 func (m *NodeManager) Stopping(nodeID int64) {
@@ -108,6 +110,7 @@ type NodeInfo struct {
 	addr          string
 	state         State
 	lastHeartbeat *atomic.Int64
+	health        *health
 }
 
 func (n *NodeInfo) ID() int64 {
@@ -130,14 +133,36 @@ func (n *NodeInfo) ChannelCnt() int {
 	return n.stats.getChannelCnt()
 }
 
-func (n *NodeInfo) SetLastHeartbeat(time time.Time) {
-	n.lastHeartbeat.Store(time.UnixNano())
+func (n *NodeInfo) SetLastHeartbeat(ts time.Time) {
+	n.lastHeartbeat.Store(ts.UnixNano())
+	n.health.recordHeartbeat(ts, paramtable.Get().QueryCoordCfg.DistPullInterval.GetAsDuration(time.Millisecond))
 }
 
 func (n *NodeInfo) LastHeartbeat() time.Time {
 	return time.Unix(0, n.lastHeartbeat.Load())
 }
 
+// RecordRPCResult records whether a request to the node succeeded, which affects its health score.
+func (n *NodeInfo) RecordRPCResult(success bool) {
+	n.health.recordRPCResult(success)
+}
+
+// RecordTaskResult records whether a task executed on the node succeeded, which affects its health score.
+func (n *NodeInfo) RecordTaskResult(success bool) {
+	n.health.recordTaskResult(success)
+}
+
+// HealthScore returns the health score of the node in [0, 1], the higher the healthier.
+func (n *NodeInfo) HealthScore() float64 {
+	return n.health.score()
+}
+
+// IsGrayFailing returns true if the node is still alive but its health score falls below the threshold.
+func (n *NodeInfo) IsGrayFailing() bool {
+	threshold := paramtable.Get().QueryCoordCfg.GrayFailureScoreThreshold.GetAsFloat()
+	return threshold > 0 && n.HealthScore() < threshold
+}
+
 func (n *NodeInfo) IsStoppingState() bool {
 	n.mu.RLock()
 	defer n.mu.RUnlock()
@@ -164,6 +189,7 @@ func NewNodeInfo(id int64, addr string) *NodeInfo {
 		id:            id,
 		addr:          addr,
 		lastHeartbeat: atomic.NewInt64(0),
+		health:        &health{},
 	}
 }
 
@@ -22,6 +22,10 @@ import (
 	"time"
 
 	"github.com/cockroachdb/errors"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/trace"
 	"go.uber.org/atomic"
 	"go.uber.org/zap"
 
@@ -109,8 +113,11 @@ func (ex *Executor) Execute(task Task, step int) bool {
 
 	go func() {
 		log.Info("execute the action of task")
-		switch task.Actions()[step].(type) {
+		switch action := task.Actions()[step].(type) {
 		case *SegmentAction:
+			if step == 0 && action.Type() == ActionTypeGrow {
+				traceSchedulingWait(task.(*SegmentTask))
+			}
 			ex.executeSegmentAction(task.(*SegmentTask), step)
 
 		case *ChannelAction:
@@ -182,6 +189,23 @@ func (ex *Executor) processMergeTask(mergeTask *LoadSegmentsTask) {
 	}
 
 	log.Info("load segments...")
+	spans := make([]trace.Span, 0, len(mergeTask.tasks))
+	for _, task := range mergeTask.tasks {
+		_, span := otel.Tracer("QueryCoord").Start(task.Context(), "QueryCoord-LoadSegmentsRPC", trace.WithAttributes(
+			attribute.Int64("nodeID", leader),
+			attribute.Int("mergedSegmentNum", len(mergeTask.tasks)),
+		))
+		spans = append(spans, span)
+	}
+	defer func() {
+		for _, span := range spans {
+			if err != nil {
+				span.RecordError(err)
+				span.SetStatus(codes.Error, err.Error())
+			}
+			span.End()
+		}
+	}()
 	status, err := ex.cluster.LoadSegments(task.Context(), leader, mergeTask.req)
 	if err != nil {
 		log.Warn("failed to load segment", zap.Error(err))
@@ -197,6 +221,16 @@ func (ex *Executor) processMergeTask(mergeTask *LoadSegmentsTask) {
 	log.Info("load segments done", zap.Duration("elapsed", elapsed))
 }
 
+// traceSchedulingWait records the span from the task created to executed,
+// which is the time the task waits for the scheduler.
+func traceSchedulingWait(task *SegmentTask) {
+	_, span := otel.Tracer("QueryCoord").Start(task.Context(), "QueryCoord-SchedulingWait",
+		trace.WithTimestamp(task.createdAt),
+		trace.WithAttributes(attribute.Int64("segmentID", task.SegmentID())),
+	)
+	span.End()
+}
+
 func (ex *Executor) removeTask(task Task, step int) {
 	if task.Err() != nil {
 		log.Info("execute action done, remove it",
@@ -294,6 +328,17 @@ func (ex *Executor) loadSegment(task *SegmentTask, step int) error {
 	}
 
 	req := packLoadSegmentRequest(task, action, schema, loadMeta, loadInfo, indexInfo)
+	// the string pool and serviceable early are optimizations of querynode, load the segment anyway if failed to get the properties
+	mmapDisabled := false
+	if collection := ex.meta.CollectionManager.GetCollection(task.CollectionID()); collection != nil {
+		mmapDisabled = collection.GetMmapDisabled()
+	}
+	if properties, err := ex.broker.GetCollectionProperties(ctx, task.CollectionID()); err != nil {
+		log.Warn("failed to get properties of collection, load segment without string pool", zap.Error(err))
+		req.Base.Properties = packLoadSegmentProperties(nil, mmapDisabled)
+	} else {
+		req.Base.Properties = packLoadSegmentProperties(properties, mmapDisabled)
+	}
 	loadTask := NewLoadSegmentsTask(task, step, req)
 	ex.merger.Add(loadTask)
 	log.Info("load segment task committed")
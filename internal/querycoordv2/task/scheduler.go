@@ -20,6 +20,7 @@ import (
 	"context"
 	"fmt"
 	"runtime"
+	"sort"
 	"sync"
 	"time"
 
@@ -31,6 +32,7 @@ import (
 	"github.com/milvus-io/milvus/internal/proto/datapb"
 	"github.com/milvus-io/milvus/internal/proto/querypb"
 	"github.com/milvus-io/milvus/internal/querycoordv2/meta"
+	. "github.com/milvus-io/milvus/internal/querycoordv2/params"
 	"github.com/milvus-io/milvus/internal/querycoordv2/session"
 	"github.com/milvus-io/milvus/internal/querycoordv2/utils"
 	"github.com/milvus-io/milvus/pkg/log"
@@ -54,6 +56,9 @@ var TaskTypeName = map[Type]string{
 	TaskTypeUpdate: "Update",
 }
 
+// grayFailingNodeTaskCap is the max number of executing tasks with priority lower than high on a gray failing node
+const grayFailingNodeTaskCap = 1
+
 type Type int32
 
 func (t Type) String() string {
@@ -550,14 +555,27 @@ func (scheduler *taskScheduler) schedule(node int64) {
 	})
 
 	// The scheduler doesn't limit the number of tasks,
-	// to commit tasks to executors as soon as possible, to reach higher merge possibility
+	// to commit tasks to executors as soon as possible, to reach higher merge possibility.
+	// The tasks of collections with higher load priority are committed first,
+	// and the segment tasks of the lower ones are held back while the executor is full
 	failCount := atomic.NewInt32(0)
-	funcutil.ProcessFuncParallel(len(toProcess), runtime.GOMAXPROCS(0), func(idx int) error {
-		if !scheduler.process(toProcess[idx]) {
-			failCount.Inc()
+	heldBack := 0
+	for i, group := range scheduler.groupByLoadPriority(toProcess) {
+		tasks := group
+		if i > 0 && scheduler.isExecutorFull(node) {
+			tasks = lo.Filter(group, func(task Task, _ int) bool {
+				_, ok := task.(*SegmentTask)
+				return !ok
+			})
+			heldBack += len(group) - len(tasks)
 		}
-		return nil
-	}, "process")
+		funcutil.ProcessFuncParallel(len(tasks), runtime.GOMAXPROCS(0), func(idx int) error {
+			if !scheduler.process(tasks[idx]) {
+				failCount.Inc()
+			}
+			return nil
+		}, "process")
+	}
 
 	for _, task := range toRemove {
 		scheduler.remove(task)
@@ -566,6 +584,7 @@ func (scheduler *taskScheduler) schedule(node int64) {
 	log.Info("processed tasks",
 		zap.Int("toProcessNum", len(toProcess)),
 		zap.Int32("failCount", failCount.Load()),
+		zap.Int("heldBackNum", heldBack),
 		zap.Int("toRemoveNum", len(toRemove)),
 	)
 
@@ -577,6 +596,26 @@ func (scheduler *taskScheduler) schedule(node int64) {
 	)
 }
 
+// groupByLoadPriority groups the tasks by the load priority of their collections, from high to low.
+func (scheduler *taskScheduler) groupByLoadPriority(tasks []Task) [][]Task {
+	groups := lo.GroupBy(tasks, func(task Task) int32 {
+		return scheduler.meta.CollectionManager.GetLoadPriority(task.CollectionID())
+	})
+	priorities := lo.Keys(groups)
+	sort.Slice(priorities, func(i, j int) bool {
+		return priorities[i] > priorities[j]
+	})
+	return lo.Map(priorities, func(priority int32, _ int) []Task {
+		return groups[priority]
+	})
+}
+
+// isExecutorFull returns true if the executor of node reaches the cap of executing tasks.
+func (scheduler *taskScheduler) isExecutorFull(node int64) bool {
+	executor, ok := scheduler.executors[node]
+	return ok && executor.executingTaskNum.Load() >= Params.QueryCoordCfg.TaskExecutionCap.GetAsInt32()
+}
+
 func (scheduler *taskScheduler) isRelated(task Task, node int64) bool {
 	for _, action := range task.Actions() {
 		if action.Node() == node {
@@ -655,6 +694,18 @@ func (scheduler *taskScheduler) process(task Task) bool {
 		return false
 	}
 
+	// throttle the tasks on gray failing node, only high priority tasks could be executed concurrently
+	node := scheduler.nodeMgr.Get(actions[step].Node())
+	if node != nil && node.IsGrayFailing() &&
+		task.Priority() < TaskPriorityHigh &&
+		executor.executingTaskNum.Load() >= grayFailingNodeTaskCap {
+		log.RatedInfo(30, "throttle task on gray failing QueryNode",
+			zap.Int("step", step),
+			zap.Int64("nodeID", node.ID()),
+			zap.Float64("healthScore", node.HealthScore()))
+		return false
+	}
+
 	return executor.Execute(task, step)
 }
 
@@ -706,6 +757,7 @@ func (scheduler *taskScheduler) remove(task Task) {
 	scheduler.tasks.Remove(task.ID())
 	scheduler.waitQueue.Remove(task)
 	scheduler.processQueue.Remove(task)
+	scheduler.recordTaskResult(task)
 
 	switch task := task.(type) {
 	case *SegmentTask:
@@ -728,6 +780,20 @@ func (scheduler *taskScheduler) remove(task Task) {
 	log.Debug("task removed", zap.Stack("stack"))
 }
 
+// recordTaskResult records the result of finished task to the health of the nodes it executed on,
+// canceled tasks are ignored as they are not the fault of nodes.
+func (scheduler *taskScheduler) recordTaskResult(task Task) {
+	status := task.Status()
+	if status != TaskStatusSucceeded && status != TaskStatusFailed {
+		return
+	}
+	for _, action := range task.Actions() {
+		if node := scheduler.nodeMgr.Get(action.Node()); node != nil {
+			node.RecordTaskResult(status == TaskStatusSucceeded)
+		}
+	}
+}
+
 func (scheduler *taskScheduler) checkStale(task Task) error {
 	log := log.With(
 		zap.Int64("taskID", task.ID()),
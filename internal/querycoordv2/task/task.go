@@ -23,6 +23,8 @@ import (
 
 	"github.com/cockroachdb/errors"
 	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
 	"go.opentelemetry.io/otel/trace"
 	"go.uber.org/atomic"
 
@@ -115,12 +117,19 @@ type baseTask struct {
 	reason   string
 
 	// span for tracing
-	span trace.Span
+	span      trace.Span
+	createdAt time.Time
 }
 
 func newBaseTask(ctx context.Context, source Source, collectionID, replicaID UniqueID, shard string) *baseTask {
 	ctx, cancel := context.WithCancel(ctx)
-	ctx, span := otel.Tracer("QueryCoord").Start(ctx, "QueryCoord-BaseTask")
+	// the task span is the child of the load job span if the collection is loading
+	ctx, span := otel.Tracer("QueryCoord").Start(meta.GlobalLoadTraces.Context(ctx, collectionID), "QueryCoord-BaseTask",
+		trace.WithAttributes(
+			attribute.Int64("collectionID", collectionID),
+			attribute.Int64("replicaID", replicaID),
+			attribute.String("shard", shard),
+		))
 
 	return &baseTask{
 		source:       source,
@@ -128,13 +137,14 @@ func newBaseTask(ctx context.Context, source Source, collectionID, replicaID Uni
 		replicaID:    replicaID,
 		shard:        shard,
 
-		status:   atomic.NewInt32(TaskStatusStarted),
-		priority: TaskPriorityNormal,
-		ctx:      ctx,
-		cancel:   cancel,
-		doneCh:   make(chan struct{}),
-		canceled: atomic.NewBool(false),
-		span:     span,
+		status:    atomic.NewInt32(TaskStatusStarted),
+		priority:  TaskPriorityNormal,
+		ctx:       ctx,
+		cancel:    cancel,
+		doneCh:    make(chan struct{}),
+		canceled:  atomic.NewBool(false),
+		span:      span,
+		createdAt: time.Now(),
 	}
 }
 
@@ -217,6 +227,13 @@ func (task *baseTask) Fail(err error) {
 		}
 		task.err = err
 		close(task.doneCh)
+		if task.span != nil {
+			if err != nil {
+				task.span.RecordError(err)
+				task.span.SetStatus(codes.Error, err.Error())
+			}
+			task.span.End()
+		}
 	}
 }
 
@@ -312,6 +329,7 @@ func NewSegmentTask(ctx context.Context,
 
 	base := newBaseTask(ctx, source, collectionID, replicaID, shard)
 	base.actions = actions
+	base.span.SetAttributes(attribute.Int64("segmentID", segmentID))
 	return &SegmentTask{
 		baseTask:  base,
 		segmentID: segmentID,
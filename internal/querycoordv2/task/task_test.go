@@ -141,6 +141,7 @@ func (suite *TaskSuite) SetupTest() {
 	suite.meta = meta.NewMeta(RandomIncrementIDAllocator(), suite.store, session.NewNodeManager())
 	suite.dist = meta.NewDistributionManager()
 	suite.broker = meta.NewMockBroker(suite.T())
+	suite.broker.EXPECT().GetCollectionProperties(mock.Anything, mock.Anything).Return(nil, nil).Maybe()
 	suite.target = meta.NewTargetManager(suite.broker, suite.meta)
 	suite.nodeMgr = session.NewNodeManager()
 	suite.cluster = session.NewMockCluster(suite.T())
@@ -163,6 +164,7 @@ func (suite *TaskSuite) BeforeTest(suiteName, testName string) {
 		"TestUnsubscribeChannelTask",
 		"TestLoadSegmentTask",
 		"TestLoadSegmentTaskNotIndex",
+		"TestLoadSegmentTaskWithStringPool",
 		"TestLoadSegmentTaskFailed",
 		"TestSegmentTaskStale",
 		"TestTaskCanceled",
@@ -565,6 +567,80 @@ func (suite *TaskSuite) TestLoadSegmentTaskNotIndex() {
 	}
 }
 
+func (suite *TaskSuite) TestLoadSegmentTaskWithStringPool() {
+	ctx := context.Background()
+	timeout := 10 * time.Second
+	targetNode := int64(3)
+	segment := suite.loadSegments[0]
+	channel := &datapb.VchannelInfo{
+		CollectionID: suite.collection,
+		ChannelName:  Params.CommonCfg.RootCoordDml.GetValue() + "-test",
+	}
+
+	// the load config disables mmap
+	suite.NoError(suite.meta.CollectionManager.UpdateLoadConfig(suite.collection, 1, nil, true))
+
+	// Expect
+	suite.broker.ExpectedCalls = nil
+	suite.broker.EXPECT().GetCollectionProperties(mock.Anything, suite.collection).Return(map[string]string{
+		common.CollectionStringPoolKey:         "true",
+		common.CollectionServiceableEarlyKey:   "true",
+		common.CollectionVectorQuantizationKey: "sq8",
+	}, nil)
+	suite.broker.EXPECT().GetCollectionSchema(mock.Anything, suite.collection).Return(&schemapb.CollectionSchema{
+		Name: "TestLoadSegmentTaskWithStringPool",
+		Fields: []*schemapb.FieldSchema{
+			{FieldID: 100, Name: "vec", DataType: schemapb.DataType_FloatVector},
+		},
+	}, nil)
+	suite.broker.EXPECT().DescribeIndex(mock.Anything, suite.collection).Return(nil, nil)
+	suite.broker.EXPECT().GetSegmentInfo(mock.Anything, segment).Return(&datapb.GetSegmentInfoResponse{
+		Infos: []*datapb.SegmentInfo{
+			{
+				ID:            segment,
+				CollectionID:  suite.collection,
+				PartitionID:   100,
+				InsertChannel: channel.ChannelName,
+			},
+		},
+	}, nil)
+	suite.broker.EXPECT().GetIndexInfo(mock.Anything, suite.collection, segment).Return(nil, nil)
+	suite.cluster.EXPECT().LoadSegments(mock.Anything, targetNode, mock.Anything).
+		Run(func(ctx context.Context, nodeID int64, req *querypb.LoadSegmentsRequest) {
+			suite.True(common.IsCollectionStringPoolEnabled(req.GetBase().GetProperties()))
+			suite.True(common.IsCollectionServiceableEarly(req.GetBase().GetProperties()))
+			suite.True(common.IsCollectionMmapDisabled(req.GetBase().GetProperties()))
+			suite.Equal(common.VectorQuantizationSQ8, common.GetCollectionVectorQuantization(req.GetBase().GetProperties()))
+		}).Return(merr.Success(), nil)
+
+	// Test load segment task
+	suite.dist.ChannelDistManager.Update(targetNode, meta.DmChannelFromVChannel(channel))
+	task, err := NewSegmentTask(
+		ctx,
+		timeout,
+		WrapIDSource(0),
+		suite.collection,
+		suite.replica,
+		NewSegmentAction(targetNode, ActionTypeGrow, channel.GetChannelName(), segment),
+	)
+	suite.NoError(err)
+	err = suite.scheduler.Add(task)
+	suite.NoError(err)
+	suite.broker.EXPECT().GetRecoveryInfoV2(mock.Anything, suite.collection).Return(nil, []*datapb.SegmentInfo{
+		{
+			ID:            segment,
+			InsertChannel: channel.ChannelName,
+			PartitionID:   1,
+		},
+	}, nil)
+	suite.target.UpdateCollectionNextTarget(suite.collection)
+	suite.AssertTaskNum(0, 1, 0, 1)
+
+	// Process tasks
+	suite.dispatchAndWait(targetNode)
+	suite.AssertTaskNum(1, 0, 0, 1)
+}
+
 func (suite *TaskSuite) TestLoadSegmentTaskFailed() {
 	ctx := context.Background()
 	timeout := 10 * time.Second
@@ -1354,6 +1430,44 @@ func (suite *TaskSuite) TestNoExecutor() {
 	suite.AssertTaskNum(0, 0, 0, 0)
 }
 
+func (suite *TaskSuite) TestGroupByLoadPriority() {
+	ctx := context.Background()
+	timeout := 10 * time.Second
+	highCollection, lowCollection := suite.collection+1, suite.collection+2
+	suite.meta.PutCollection(&meta.Collection{
+		CollectionLoadInfo: &querypb.CollectionLoadInfo{
+			CollectionID: highCollection,
+			LoadPriority: common.LoadPriorityHigh,
+		},
+	})
+	suite.meta.PutCollection(&meta.Collection{
+		CollectionLoadInfo: &querypb.CollectionLoadInfo{
+			CollectionID: lowCollection,
+			LoadPriority: common.LoadPriorityLow,
+		},
+	})
+
+	tasks := make([]Task, 0)
+	for i, collection := range []int64{lowCollection, suite.collection, highCollection, lowCollection} {
+		task, err := NewSegmentTask(ctx, timeout, WrapIDSource(0), collection, suite.replica,
+			NewSegmentAction(1, ActionTypeGrow, "", int64(i)))
+		suite.NoError(err)
+		tasks = append(tasks, task)
+	}
+
+	groups := suite.scheduler.groupByLoadPriority(tasks)
+	suite.Len(groups, 3)
+	suite.ElementsMatch([]Task{tasks[2]}, groups[0])
+	suite.ElementsMatch([]Task{tasks[1]}, groups[1])
+	suite.ElementsMatch([]Task{tasks[0], tasks[3]}, groups[2])
+
+	suite.False(suite.scheduler.isExecutorFull(1))
+	suite.scheduler.executors[1].executingTaskNum.Store(Params.QueryCoordCfg.TaskExecutionCap.GetAsInt32())
+	suite.True(suite.scheduler.isExecutorFull(1))
+	suite.scheduler.executors[1].executingTaskNum.Store(0)
+	suite.False(suite.scheduler.isExecutorFull(-1))
+}
+
 func (suite *TaskSuite) AssertTaskNum(process, wait, channel, segment int) {
 	scheduler := suite.scheduler
 
@@ -129,6 +129,34 @@ func packLoadSegmentRequest(
 	}
 }
 
+// packLoadSegmentProperties picks the collection properties of querynode loading segments,
+// and the mmap setting of the load config.
+func packLoadSegmentProperties(properties map[string]string, mmapDisabled bool) map[string]string {
+	var ret map[string]string
+	if common.IsCollectionStringPoolEnabled(properties) {
+		ret = map[string]string{common.CollectionStringPoolKey: "true"}
+	}
+	if common.IsCollectionServiceableEarly(properties) {
+		if ret == nil {
+			ret = make(map[string]string)
+		}
+		ret[common.CollectionServiceableEarlyKey] = "true"
+	}
+	if mmapDisabled {
+		if ret == nil {
+			ret = make(map[string]string)
+		}
+		ret[common.CollectionMmapEnabledKey] = "false"
+	}
+	if quantization := common.GetCollectionVectorQuantization(properties); quantization != "" {
+		if ret == nil {
+			ret = make(map[string]string)
+		}
+		ret[common.CollectionVectorQuantizationKey] = quantization
+	}
+	return ret
+}
+
 func packReleaseSegmentRequest(task *SegmentTask, action *SegmentAction) *querypb.ReleaseSegmentsRequest {
 	return &querypb.ReleaseSegmentsRequest{
 		Base: commonpbutil.NewMsgBase(
@@ -206,6 +206,25 @@ func checkResourceGroup(collectionID int64, replicaNumber int32, resourceGroups
 	return nil
 }
 
+// ReplicaNumberByRG returns the number of replicas expected in each resource group,
+// all replicas are in the only resource group, or one replica in each resource group.
+func ReplicaNumberByRG(replicaNumber int32, resourceGroups []string) (map[string]int, error) {
+	if err := checkResourceGroup(0, replicaNumber, resourceGroups); err != nil {
+		return nil, err
+	}
+	switch len(resourceGroups) {
+	case 0:
+		return map[string]int{meta.DefaultResourceGroupName: int(replicaNumber)}, nil
+	case 1:
+		return map[string]int{resourceGroups[0]: int(replicaNumber)}, nil
+	}
+	ret := make(map[string]int, len(resourceGroups))
+	for _, rgName := range resourceGroups {
+		ret[rgName]++
+	}
+	return ret, nil
+}
+
 func SpawnReplicasWithRG(m *meta.Meta, collection int64, resourceGroups []string, replicaNumber int32) ([]*meta.Replica, error) {
 	if err := checkResourceGroup(collection, replicaNumber, resourceGroups); err != nil {
 		return nil, err
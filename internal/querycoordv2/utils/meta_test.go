@@ -239,3 +239,20 @@ func TestAddNodesToCollectionsInRG(t *testing.T) {
 	assert.Len(t, m.ReplicaManager.Get(3).GetNodes(), 2)
 	assert.Len(t, m.ReplicaManager.Get(4).GetNodes(), 2)
 }
+
+func TestReplicaNumberByRG(t *testing.T) {
+	numbers, err := ReplicaNumberByRG(2, nil)
+	assert.NoError(t, err)
+	assert.Equal(t, map[string]int{meta.DefaultResourceGroupName: 2}, numbers)
+
+	numbers, err = ReplicaNumberByRG(3, []string{"rg1"})
+	assert.NoError(t, err)
+	assert.Equal(t, map[string]int{"rg1": 3}, numbers)
+
+	numbers, err = ReplicaNumberByRG(3, []string{"rg1", "rg2", "rg1"})
+	assert.NoError(t, err)
+	assert.Equal(t, map[string]int{"rg1": 2, "rg2": 1}, numbers)
+
+	_, err = ReplicaNumberByRG(3, []string{"rg1", "rg2"})
+	assert.ErrorIs(t, err, ErrUseWrongNumRG)
+}
@@ -46,10 +46,13 @@ import (
 	"github.com/milvus-io/milvus/pkg/util/lifetime"
 	"github.com/milvus-io/milvus/pkg/util/merr"
 	"github.com/milvus-io/milvus/pkg/util/paramtable"
+	"github.com/milvus-io/milvus/pkg/util/ratelimitutil"
 	"github.com/milvus-io/milvus/pkg/util/timerecord"
 	"github.com/milvus-io/milvus/pkg/util/tsoutil"
 )
 
+const deleteForwardRateLabel = "delete_forward"
+
 // ShardDelegator is the interface definition.
 type ShardDelegator interface {
 	Collection() int64
@@ -69,6 +72,7 @@ type ShardDelegator interface {
 	ReleaseSegments(ctx context.Context, req *querypb.ReleaseSegmentsRequest, force bool) error
 	SyncTargetVersion(newVersion int64, growingInTarget []int64, sealedInTarget []int64, droppedInTarget []int64)
 	GetTargetVersion() int64
+	GetLoadStats() LoadStats
 
 	// control
 	Serviceable() bool
@@ -106,6 +110,17 @@ type shardDelegator struct {
 	loader      segments.Loader
 	tsCond      *sync.Cond
 	latestTsafe *atomic.Uint64
+	// rate of the delete rows forwarded to the workers
+	forwardRate *ratelimitutil.RateCollector
+}
+
+// LoadStats is the resource cost of the delegator besides the sealed segments,
+// reported to QueryCoord so that the nodes hosting heavy delegators receive fewer sealed segments.
+type LoadStats struct {
+	NumOfGrowingRows      int64
+	NumOfDeleteBufferRows int64
+	// the number of delete rows forwarded to workers per second
+	DeleteForwardRate float64
 }
 
 // getLogger returns the zap logger with pre-defined shard attributes.
@@ -146,6 +161,20 @@ func (sd *shardDelegator) GetSegmentInfo(readable bool) ([]SnapshotItem, []Segme
 	return sd.distribution.PeekSegments(readable)
 }
 
+// GetLoadStats returns the resource cost of the growing segments, delete buffer and delete forwarding of the delegator.
+func (sd *shardDelegator) GetLoadStats() LoadStats {
+	stats := LoadStats{}
+	growings := sd.segmentManager.GetBy(segments.WithChannel(sd.vchannelName), segments.WithType(segments.SegmentTypeGrowing))
+	for _, segment := range growings {
+		stats.NumOfGrowingRows += segment.InsertCount()
+	}
+	stats.NumOfDeleteBufferRows, _ = sd.deleteBuffer.Size()
+	if rate, err := sd.forwardRate.Rate(deleteForwardRateLabel, ratelimitutil.DefaultAvgDuration); err == nil {
+		stats.DeleteForwardRate = rate
+	}
+	return stats
+}
+
 // SyncDistribution revises distribution.
 func (sd *shardDelegator) SyncDistribution(ctx context.Context, entries ...SegmentEntry) {
 	log := sd.getLogger(ctx)
@@ -654,6 +683,12 @@ func NewShardDelegator(ctx context.Context, collectionID UniqueID, replicaID Uni
 		loader:         loader,
 		factory:        factory,
 	}
+	forwardRate, err := ratelimitutil.NewRateCollector(ratelimitutil.DefaultWindow, ratelimitutil.DefaultGranularity)
+	if err != nil {
+		return nil, err
+	}
+	forwardRate.Register(deleteForwardRateLabel)
+	sd.forwardRate = forwardRate
 	m := sync.Mutex{}
 	sd.tsCond = sync.NewCond(&m)
 	if sd.lifetime.Add(lifetime.NotStopped) == nil {
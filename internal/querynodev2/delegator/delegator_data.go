@@ -184,6 +184,10 @@ func (sd *shardDelegator) ProcessDelete(deleteData []*DeleteData, ts uint64) {
 		}
 	}
 
+	sd.forwardRate.Add(deleteForwardRateLabel, float64(lo.SumBy(lo.Values(delRecords), func(record DeleteData) int64 {
+		return record.RowCount
+	})))
+
 	offlineSegments := typeutil.NewConcurrentSet[int64]()
 
 	sealed, growing, version := sd.distribution.GetSegments(false)
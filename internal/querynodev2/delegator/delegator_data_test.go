@@ -329,6 +329,24 @@ func (s *DelegatorDataSuite) TestProcessDelete() {
 	}, 10)
 }
 
+func (s *DelegatorDataSuite) TestGetLoadStats() {
+	stats := s.delegator.GetLoadStats()
+	s.EqualValues(0, stats.NumOfGrowingRows)
+	s.EqualValues(0, stats.NumOfDeleteBufferRows)
+
+	s.delegator.ProcessDelete([]*DeleteData{
+		{
+			PartitionID: 500,
+			PrimaryKeys: []storage.PrimaryKey{storage.NewInt64PrimaryKey(10), storage.NewInt64PrimaryKey(20)},
+			Timestamps:  []uint64{10, 10},
+			RowCount:    2,
+		},
+	}, 10)
+
+	stats = s.delegator.GetLoadStats()
+	s.EqualValues(2, stats.NumOfDeleteBufferRows)
+}
+
 func (s *DelegatorDataSuite) TestLoadSegments() {
 	s.Run("normal_run", func() {
 		defer func() {
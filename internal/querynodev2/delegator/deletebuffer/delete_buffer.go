@@ -28,6 +28,7 @@ var errBufferFull = errors.New("buffer full")
 type timed interface {
 	Timestamp() uint64
 	Size() int64
+	EntryNum() int64
 }
 
 // DeleteBuffer is the interface for delete buffer.
@@ -35,6 +36,8 @@ type DeleteBuffer[T timed] interface {
 	Put(T)
 	ListAfter(uint64) []T
 	SafeTs() uint64
+	// Size returns the number of buffered delete entries and their memory size.
+	Size() (entryNum, memorySize int64)
 }
 
 func NewDoubleCacheDeleteBuffer[T timed](startTs uint64, maxSize int64) DeleteBuffer[T] {
@@ -83,6 +86,21 @@ func (c *doubleCacheBuffer[T]) ListAfter(ts uint64) []T {
 	return result
 }
 
+// Size implements DeleteBuffer.
+func (c *doubleCacheBuffer[T]) Size() (entryNum, memorySize int64) {
+	c.mut.RLock()
+	defer c.mut.RUnlock()
+	for _, item := range []*doubleCacheItem[T]{c.head, c.tail} {
+		if item == nil {
+			continue
+		}
+		num, size := item.Size()
+		entryNum += num
+		memorySize += size
+	}
+	return entryNum, memorySize
+}
+
 // evict sets head as tail and evicts tail.
 func (c *doubleCacheBuffer[T]) evict(newTs uint64) {
 	c.tail = c.head
@@ -98,10 +116,11 @@ func newDoubleCacheItem[T timed](ts uint64, maxSize int64) *doubleCacheItem[T] {
 }
 
 type doubleCacheItem[T timed] struct {
-	mut     sync.RWMutex
-	headTs  uint64
-	size    int64
-	maxSize int64
+	mut      sync.RWMutex
+	headTs   uint64
+	size     int64
+	entryNum int64
+	maxSize  int64
 
 	data []T
 }
@@ -118,9 +137,17 @@ func (c *doubleCacheItem[T]) Put(entry T) error {
 
 	c.data = append(c.data, entry)
 	c.size += entry.Size()
+	c.entryNum += entry.EntryNum()
 	return nil
 }
 
+// Size returns the number of delete entries and the memory size of cache item.
+func (c *doubleCacheItem[T]) Size() (entryNum, memorySize int64) {
+	c.mut.RLock()
+	defer c.mut.RUnlock()
+	return c.entryNum, c.size
+}
+
 // ListAfter returns entries of which ts after provided value.
 func (c *doubleCacheItem[T]) ListAfter(ts uint64) []T {
 	c.mut.RLock()
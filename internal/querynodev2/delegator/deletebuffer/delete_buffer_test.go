@@ -78,6 +78,28 @@ func (s *DoubleCacheBufferSuite) TestCache() {
 	s.Equal(1, len(buffer.ListAfter(12)))
 }
 
+func (s *DoubleCacheBufferSuite) TestSize() {
+	buffer := NewDoubleCacheDeleteBuffer[*Item](10, 1000)
+	item := &Item{
+		Ts: 11,
+		Data: []BufferItem{
+			{
+				PartitionID: 200,
+				DeleteData: storage.DeleteData{
+					Pks:      []storage.PrimaryKey{storage.NewInt64PrimaryKey(1), storage.NewInt64PrimaryKey(2)},
+					Tss:      []uint64{11, 11},
+					RowCount: 2,
+				},
+			},
+		},
+	}
+	buffer.Put(item)
+
+	entryNum, memorySize := buffer.Size()
+	s.EqualValues(2, entryNum)
+	s.Equal(item.Size(), memorySize)
+}
+
 func TestDoubleCacheDeleteBuffer(t *testing.T) {
 	suite.Run(t, new(DoubleCacheBufferSuite))
 }
@@ -24,6 +24,13 @@ func (item *Item) Size() int64 {
 	}, int64(0))
 }
 
+// EntryNum implements `timed`.
+func (item *Item) EntryNum() int64 {
+	return lo.Reduce(item.Data, func(entryNum int64, item BufferItem, _ int) int64 {
+		return entryNum + item.DeleteData.RowCount
+	}, int64(0))
+}
+
 type BufferItem struct {
 	PartitionID int64
 	DeleteData  storage.DeleteData
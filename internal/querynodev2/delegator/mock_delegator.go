@@ -99,6 +99,47 @@ func (_c *MockShardDelegator_Collection_Call) RunAndReturn(run func() int64) *Mo
 	return _c
 }
 
+// GetLoadStats provides a mock function with given fields:
+func (_m *MockShardDelegator) GetLoadStats() LoadStats {
+	ret := _m.Called()
+
+	var r0 LoadStats
+	if rf, ok := ret.Get(0).(func() LoadStats); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(LoadStats)
+	}
+
+	return r0
+}
+
+// MockShardDelegator_GetLoadStats_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetLoadStats'
+type MockShardDelegator_GetLoadStats_Call struct {
+	*mock.Call
+}
+
+// GetLoadStats is a helper method to define mock.On call
+func (_e *MockShardDelegator_Expecter) GetLoadStats() *MockShardDelegator_GetLoadStats_Call {
+	return &MockShardDelegator_GetLoadStats_Call{Call: _e.mock.On("GetLoadStats")}
+}
+
+func (_c *MockShardDelegator_GetLoadStats_Call) Run(run func()) *MockShardDelegator_GetLoadStats_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *MockShardDelegator_GetLoadStats_Call) Return(_a0 LoadStats) *MockShardDelegator_GetLoadStats_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockShardDelegator_GetLoadStats_Call) RunAndReturn(run func() LoadStats) *MockShardDelegator_GetLoadStats_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // GetSegmentInfo provides a mock function with given fields: readable
 func (_m *MockShardDelegator) GetSegmentInfo(readable bool) ([]SnapshotItem, []SegmentEntry) {
 	ret := _m.Called(readable)
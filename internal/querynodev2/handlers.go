@@ -391,6 +391,9 @@ func (node *QueryNode) searchChannel(ctx context.Context, req *querypb.SearchReq
 	tr := timerecord.NewTimeRecorder("searchDelegator")
 	// get delegator
 	sd, ok := node.delegators.Get(channel)
+	if !ok && paramtable.Get().QueryNodeCfg.DelegatorFallbackEnabled.GetAsBool() {
+		return node.fallbackSearchChannel(searchCtx, req, channel)
+	}
 	if !ok {
 		err := merr.WrapErrChannelNotFound(channel)
 		log.Warn("Query failed, failed to get shard delegator for search", zap.Error(err))
@@ -416,7 +419,8 @@ func (node *QueryNode) searchChannel(ctx context.Context, req *querypb.SearchReq
 		req.GetSegmentIDs(),
 	))
 
-	resp, err := segments.ReduceSearchResults(ctx, results, req.Req.GetNq(), req.Req.GetTopk(), req.Req.GetMetricType())
+	resp, err := segments.ReduceSearchResults(ctx, results, req.Req.GetNq(), req.Req.GetTopk(), req.Req.GetMetricType(),
+		req.Req.GetGroupByFieldId(), req.Req.GetGroupSize())
 	if err != nil {
 		return nil, err
 	}
@@ -436,6 +440,55 @@ func (node *QueryNode) searchChannel(ctx context.Context, req *querypb.SearchReq
 	return resp, nil
 }
 
+// fallbackSearchChannel searches the local sealed segments of the channel while the shard delegator is being rebuilt elsewhere,
+// the growing data and the sealed segments on the other nodes are missed, so the results are always partial.
+func (node *QueryNode) fallbackSearchChannel(ctx context.Context, req *querypb.SearchRequest, channel string) (*internalpb.SearchResults, error) {
+	log := log.Ctx(ctx).With(
+		zap.Int64("collectionID", req.GetReq().GetCollectionID()),
+		zap.String("channel", channel),
+	)
+
+	sealed := node.manager.Segment.GetBy(
+		segments.WithChannel(channel),
+		segments.WithType(segments.SegmentTypeSealed),
+	)
+	if len(sealed) == 0 {
+		err := merr.WrapErrChannelNotFound(channel)
+		log.Warn("failed to fallback search, no sealed segment of the channel", zap.Error(err))
+		return nil, err
+	}
+
+	// without the delegator there is no tsafe to wait for, the guarantee is reduced to the deletions applied to the sealed segments
+	guaranteeTs := req.GetReq().GetGuaranteeTimestamp()
+	for _, segment := range sealed {
+		if ts := segment.LastDeltaTimestamp(); ts > 0 && ts < guaranteeTs {
+			guaranteeTs = ts
+		}
+	}
+	searchReq := proto.Clone(req.GetReq()).(*internalpb.SearchRequest)
+	searchReq.GuaranteeTimestamp = guaranteeTs
+	segmentIDs := lo.Map(sealed, func(segment segments.Segment, _ int) int64 { return segment.ID() })
+	log.Warn("shard delegator not found, fallback to search the local sealed segments",
+		zap.Int64s("segmentIDs", segmentIDs),
+		zap.Uint64("guaranteeTimestamp", guaranteeTs))
+
+	resp, err := node.SearchSegments(ctx, &querypb.SearchRequest{
+		Req:             searchReq,
+		DmlChannels:     []string{channel},
+		SegmentIDs:      segmentIDs,
+		FromShardLeader: true,
+		Scope:           querypb.DataScope_Historical,
+		TotalChannelNum: req.GetTotalChannelNum(),
+	})
+	if err := merr.CheckRPCCall(resp, err); err != nil {
+		log.Warn("failed to fallback search the local sealed segments", zap.Error(err))
+		return nil, err
+	}
+	metrics.QueryNodeDelegatorFallbackCounter.WithLabelValues(fmt.Sprint(paramtable.GetNodeID())).Inc()
+	resp.Partial = true
+	return resp, nil
+}
+
 func (node *QueryNode) getChannelStatistics(ctx context.Context, req *querypb.GetStatisticsRequest, channel string) (*internalpb.GetStatisticsResponse, error) {
 	log := log.Ctx(ctx).With(
 		zap.Int64("collectionID", req.Req.GetCollectionID()),
@@ -19,6 +19,7 @@ package querynodev2
 import (
 	"context"
 	"fmt"
+	"sort"
 	"time"
 
 	"github.com/samber/lo"
@@ -209,3 +210,58 @@ func getSystemInfoMetrics(ctx context.Context, req *milvuspb.GetMetricsRequest,
 		ComponentName: metricsinfo.ConstructComponentName(typeutil.QueryNodeRole, paramtable.GetNodeID()),
 	}, nil
 }
+
+// getSegmentAccessStats returns the access statistics of the segments loaded, of all collections if collectionID is 0.
+func getSegmentAccessStats(manager *segments.Manager, collectionID int64) []metricsinfo.SegmentAccessStats {
+	filters := make([]segments.SegmentFilter, 0, 1)
+	if collectionID != 0 {
+		filters = append(filters, func(segment segments.Segment) bool {
+			return segment.Collection() == collectionID
+		})
+	}
+	loaded := manager.Segment.GetBy(filters...)
+	ret := make([]metricsinfo.SegmentAccessStats, 0, len(loaded))
+	for _, segment := range loaded {
+		stats := segment.AccessStats()
+		accessStats := metricsinfo.SegmentAccessStats{
+			SegmentID:          segment.ID(),
+			CollectionID:       segment.Collection(),
+			PartitionID:        segment.Partition(),
+			Channel:            segment.Shard(),
+			Type:               segment.Type().String(),
+			MemSize:            segment.MemSize(),
+			SearchCount:        stats.SearchCount,
+			QueryCount:         stats.QueryCount,
+			SearchAvgLatencyMs: float64(stats.SearchAvgLatency) / float64(time.Millisecond),
+			QueryAvgLatencyMs:  float64(stats.QueryAvgLatency) / float64(time.Millisecond),
+		}
+		if !stats.LastAccessTime.IsZero() {
+			accessStats.LastAccessTime = stats.LastAccessTime.Unix()
+		}
+		ret = append(ret, accessStats)
+	}
+	sort.Slice(ret, func(i, j int) bool {
+		return ret[i].SegmentID < ret[j].SegmentID
+	})
+	return ret
+}
+
+// getSegmentAccessStatsMetrics returns the access statistics of the segments loaded in QueryNode
+func getSegmentAccessStatsMetrics(node *QueryNode) (*milvuspb.GetMetricsResponse, error) {
+	componentName := metricsinfo.ConstructComponentName(typeutil.QueryNodeRole, paramtable.GetNodeID())
+	resp, err := metricsinfo.MarshalComponentInfos(metricsinfo.QueryNodeSegmentAccessStats{
+		Name:     componentName,
+		Segments: getSegmentAccessStats(node.manager, 0),
+	})
+	if err != nil {
+		return &milvuspb.GetMetricsResponse{
+			Status:        merr.Status(err),
+			ComponentName: componentName,
+		}, nil
+	}
+	return &milvuspb.GetMetricsResponse{
+		Status:        merr.Success(),
+		Response:      resp,
+		ComponentName: componentName,
+	}, nil
+}
@@ -0,0 +1,63 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package querynodev2
+
+import (
+	"net/http"
+	"strconv"
+
+	management "github.com/milvus-io/milvus/internal/http"
+	"github.com/milvus-io/milvus/internal/querynodev2/segments"
+)
+
+var segmentAccessStatsComponent = management.NewComponent[*segments.Manager]("querynode")
+
+// registerSegmentAccessStatsHandler exposes the access statistics of segments through the management http server,
+// the handler is registered only once and serves the segments of the latest started querynode.
+func registerSegmentAccessStatsHandler(manager *segments.Manager) {
+	segmentAccessStatsComponent.Serve(manager, &management.Handler{
+		Path:        management.QueryNodeSegmentAccessStatsRouterPath,
+		HandlerFunc: segmentAccessStatsHandler,
+	})
+}
+
+// segmentAccessStatsHandler lists the search and query hit counts, average latencies and last access time
+// of the loaded segments, which helps to decide the segments to mmap, release or move to cheaper nodes.
+//
+//	GET /querynode/segments/access-stats
+//	GET /querynode/segments/access-stats?collection_id=445566778899
+func segmentAccessStatsHandler(w http.ResponseWriter, req *http.Request) {
+	if req.Method != http.MethodGet {
+		management.WriteJSON(w, http.StatusMethodNotAllowed, map[string]string{"error": "method not allowed"})
+		return
+	}
+	manager, ok := segmentAccessStatsComponent.Get(w)
+	if !ok {
+		return
+	}
+
+	var collectionID int64
+	if param := req.URL.Query().Get("collection_id"); param != "" {
+		var err error
+		collectionID, err = strconv.ParseInt(param, 10, 64)
+		if err != nil {
+			management.WriteJSON(w, http.StatusBadRequest, map[string]string{"error": "invalid collection id: " + err.Error()})
+			return
+		}
+	}
+	management.WriteJSON(w, http.StatusOK, getSegmentAccessStats(manager, collectionID))
+}
@@ -0,0 +1,114 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package querynodev2
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	management "github.com/milvus-io/milvus/internal/http"
+	"github.com/milvus-io/milvus/internal/querynodev2/segments"
+	"github.com/milvus-io/milvus/pkg/util/metricsinfo"
+)
+
+func Test_segmentAccessStatsHandler(t *testing.T) {
+	// the handler serves a standalone component, the one of the querynode started by other tests is restored after
+	defer func(c *management.Component[*segments.Manager]) { segmentAccessStatsComponent = c }(segmentAccessStatsComponent)
+	segmentAccessStatsComponent = management.NewComponent[*segments.Manager]("querynode")
+
+	t.Run("not started", func(t *testing.T) {
+		w := httptest.NewRecorder()
+		segmentAccessStatsHandler(w, httptest.NewRequest(http.MethodGet, "/querynode/segments/access-stats", nil))
+		assert.Equal(t, http.StatusServiceUnavailable, w.Code)
+	})
+
+	lastAccess := time.Now()
+	loaded := make([]segments.Segment, 0)
+	for _, id := range []int64{2, 1} {
+		segment := segments.NewMockSegment(t)
+		segment.EXPECT().ID().Return(id).Maybe()
+		segment.EXPECT().Collection().Return(100 + id).Maybe()
+		segment.EXPECT().Partition().Return(int64(10)).Maybe()
+		segment.EXPECT().Shard().Return("ch-1").Maybe()
+		segment.EXPECT().Type().Return(segments.SegmentTypeSealed).Maybe()
+		segment.EXPECT().MemSize().Return(int64(1024)).Maybe()
+		segment.EXPECT().AccessStats().Return(segments.AccessStats{
+			SearchCount:      id * 10,
+			SearchAvgLatency: 2 * time.Millisecond,
+			LastAccessTime:   lastAccess,
+		}).Maybe()
+		loaded = append(loaded, segment)
+	}
+	segmentManager := segments.NewMockSegmentManager(t)
+	getBy := func(filters ...segments.SegmentFilter) []segments.Segment {
+		result := make([]segments.Segment, 0)
+	outer:
+		for _, segment := range loaded {
+			for _, filter := range filters {
+				if !filter(segment) {
+					continue outer
+				}
+			}
+			result = append(result, segment)
+		}
+		return result
+	}
+	segmentManager.EXPECT().GetBy().RunAndReturn(getBy).Maybe()
+	segmentManager.EXPECT().GetBy(mock.Anything).RunAndReturn(getBy).Maybe()
+	segmentAccessStatsComponent.Serve(&segments.Manager{Segment: segmentManager})
+
+	t.Run("all segments", func(t *testing.T) {
+		w := httptest.NewRecorder()
+		segmentAccessStatsHandler(w, httptest.NewRequest(http.MethodGet, "/querynode/segments/access-stats", nil))
+		assert.Equal(t, http.StatusOK, w.Code)
+		var stats []metricsinfo.SegmentAccessStats
+		require.NoError(t, json.Unmarshal(w.Body.Bytes(), &stats))
+		require.Len(t, stats, 2)
+		assert.EqualValues(t, 1, stats[0].SegmentID)
+		assert.EqualValues(t, 10, stats[0].SearchCount)
+		assert.Equal(t, 2.0, stats[0].SearchAvgLatencyMs)
+		assert.Equal(t, lastAccess.Unix(), stats[0].LastAccessTime)
+		assert.Equal(t, "Sealed", stats[0].Type)
+	})
+
+	t.Run("filter by collection", func(t *testing.T) {
+		w := httptest.NewRecorder()
+		segmentAccessStatsHandler(w, httptest.NewRequest(http.MethodGet, "/querynode/segments/access-stats?collection_id=102", nil))
+		assert.Equal(t, http.StatusOK, w.Code)
+		var stats []metricsinfo.SegmentAccessStats
+		require.NoError(t, json.Unmarshal(w.Body.Bytes(), &stats))
+		require.Len(t, stats, 1)
+		assert.EqualValues(t, 2, stats[0].SegmentID)
+
+		w = httptest.NewRecorder()
+		segmentAccessStatsHandler(w, httptest.NewRequest(http.MethodGet, "/querynode/segments/access-stats?collection_id=abc", nil))
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+	})
+
+	t.Run("method not allowed", func(t *testing.T) {
+		w := httptest.NewRecorder()
+		segmentAccessStatsHandler(w, httptest.NewRequest(http.MethodPost, "/querynode/segments/access-stats", nil))
+		assert.Equal(t, http.StatusMethodNotAllowed, w.Code)
+	})
+}
@@ -0,0 +1,75 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package segments
+
+import (
+	"time"
+
+	"go.uber.org/atomic"
+
+	"github.com/milvus-io/milvus/pkg/metrics"
+)
+
+// AccessStats is the snapshot of access statistics of a segment since it's loaded.
+type AccessStats struct {
+	SearchCount      int64
+	QueryCount       int64
+	SearchAvgLatency time.Duration
+	QueryAvgLatency  time.Duration
+	// zero if never accessed
+	LastAccessTime time.Time
+}
+
+// accessStats records the searches and queries on a segment, it's lock free as it's updated by every request.
+type accessStats struct {
+	searchCount   atomic.Int64
+	queryCount    atomic.Int64
+	searchLatency atomic.Int64
+	queryLatency  atomic.Int64
+	lastAccess    atomic.Int64
+}
+
+func (s *accessStats) record(label string, latency time.Duration) {
+	switch label {
+	case metrics.SearchLabel:
+		s.searchCount.Inc()
+		s.searchLatency.Add(latency.Nanoseconds())
+	case metrics.QueryLabel:
+		s.queryCount.Inc()
+		s.queryLatency.Add(latency.Nanoseconds())
+	default:
+		return
+	}
+	s.lastAccess.Store(time.Now().UnixNano())
+}
+
+func (s *accessStats) snapshot() AccessStats {
+	stats := AccessStats{
+		SearchCount: s.searchCount.Load(),
+		QueryCount:  s.queryCount.Load(),
+	}
+	if stats.SearchCount > 0 {
+		stats.SearchAvgLatency = time.Duration(s.searchLatency.Load() / stats.SearchCount)
+	}
+	if stats.QueryCount > 0 {
+		stats.QueryAvgLatency = time.Duration(s.queryLatency.Load() / stats.QueryCount)
+	}
+	if lastAccess := s.lastAccess.Load(); lastAccess > 0 {
+		stats.LastAccessTime = time.Unix(0, lastAccess)
+	}
+	return stats
+}
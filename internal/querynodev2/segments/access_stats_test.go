@@ -0,0 +1,46 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package segments
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/milvus-io/milvus/pkg/metrics"
+)
+
+func TestAccessStats(t *testing.T) {
+	stats := &accessStats{}
+	snapshot := stats.snapshot()
+	assert.Zero(t, snapshot.SearchCount)
+	assert.True(t, snapshot.LastAccessTime.IsZero())
+
+	stats.record(metrics.SearchLabel, 10*time.Millisecond)
+	stats.record(metrics.SearchLabel, 30*time.Millisecond)
+	stats.record(metrics.QueryLabel, 5*time.Millisecond)
+	// unknown label is ignored
+	stats.record("insert", time.Second)
+
+	snapshot = stats.snapshot()
+	assert.EqualValues(t, 2, snapshot.SearchCount)
+	assert.EqualValues(t, 1, snapshot.QueryCount)
+	assert.Equal(t, 20*time.Millisecond, snapshot.SearchAvgLatency)
+	assert.Equal(t, 5*time.Millisecond, snapshot.QueryAvgLatency)
+	assert.WithinDuration(t, time.Now(), snapshot.LastAccessTime, time.Minute)
+}
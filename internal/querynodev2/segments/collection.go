@@ -36,6 +36,7 @@ import (
 	"github.com/milvus-io/milvus/internal/proto/querypb"
 	"github.com/milvus-io/milvus/internal/proto/segcorepb"
 	"github.com/milvus-io/milvus/pkg/log"
+	"github.com/milvus-io/milvus/pkg/util/paramtable"
 	"github.com/milvus-io/milvus/pkg/util/typeutil"
 )
 
@@ -121,6 +122,14 @@ type Collection struct {
 	loadType      querypb.LoadType
 	metricType    atomic.String
 	schema        *schemapb.CollectionSchema
+	// share the identical strings of the sealed segments in the string pool
+	stringPoolEnabled atomic.Bool
+	// load the scalar fields of the sealed segments after they become serviceable
+	scalarFieldsDelayed atomic.Bool
+	// load the segments without mmap even if mmap enabled
+	mmapDisabled atomic.Bool
+	// the quantization of the raw float vectors of the sealed segments, empty if not quantized
+	vectorQuantization atomic.String
 
 	refCount *atomic.Uint32
 }
@@ -171,6 +180,42 @@ func (c *Collection) GetMetricType() string {
 	return c.metricType.Load()
 }
 
+func (c *Collection) SetStringPoolEnabled(enabled bool) {
+	c.stringPoolEnabled.Store(enabled)
+}
+
+func (c *Collection) IsStringPoolEnabled() bool {
+	return c.stringPoolEnabled.Load()
+}
+
+func (c *Collection) SetScalarFieldsDelayed(delayed bool) {
+	c.scalarFieldsDelayed.Store(delayed)
+}
+
+func (c *Collection) IsScalarFieldsDelayed() bool {
+	return c.scalarFieldsDelayed.Load()
+}
+
+func (c *Collection) SetMmapDisabled(disabled bool) {
+	c.mmapDisabled.Store(disabled)
+}
+
+func (c *Collection) SetVectorQuantization(quantization string) {
+	c.vectorQuantization.Store(quantization)
+}
+
+func (c *Collection) GetVectorQuantization() string {
+	return c.vectorQuantization.Load()
+}
+
+// MmapDirPath returns the dir to mmap the field data and indexes of the segments, empty if mmap is not enabled for the collection.
+func (c *Collection) MmapDirPath() string {
+	if c.mmapDisabled.Load() {
+		return ""
+	}
+	return paramtable.Get().QueryNodeCfg.MmapDirPath.GetValue()
+}
+
 func (c *Collection) Ref(count uint32) uint32 {
 	refCount := c.refCount.Add(count)
 	log.Debug("collection ref increment",
@@ -0,0 +1,150 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package segments
+
+import (
+	"context"
+
+	"github.com/golang/protobuf/proto"
+	"go.uber.org/zap"
+
+	"github.com/milvus-io/milvus-proto/go-api/v2/schemapb"
+	"github.com/milvus-io/milvus/internal/proto/datapb"
+	"github.com/milvus-io/milvus/internal/proto/planpb"
+	"github.com/milvus-io/milvus/pkg/common"
+	"github.com/milvus-io/milvus/pkg/log"
+	"github.com/milvus-io/milvus/pkg/util/typeutil"
+)
+
+// delayedFields are the scalar fields of the sealed segment loaded in background,
+// after the segment becomes serviceable with the system, primary key, vector and indexed fields loaded.
+type delayedFields struct {
+	fieldIDs typeutil.UniqueSet
+	done     chan struct{}
+	err      error
+}
+
+func newDelayedFields(fields []*datapb.FieldBinlog) *delayedFields {
+	fieldIDs := typeutil.NewUniqueSet()
+	for _, field := range fields {
+		fieldIDs.Insert(field.GetFieldID())
+	}
+	return &delayedFields{
+		fieldIDs: fieldIDs,
+		done:     make(chan struct{}),
+	}
+}
+
+func (d *delayedFields) finish(err error) {
+	d.err = err
+	close(d.done)
+}
+
+func (d *delayedFields) loaded() bool {
+	select {
+	case <-d.done:
+		return true
+	default:
+		return false
+	}
+}
+
+// requiredBy returns whether the request requires the delayed fields,
+// the filter may refer any field, so it always requires the delayed fields.
+func (d *delayedFields) requiredBy(hasFilter bool, outputFieldIDs []int64) bool {
+	if hasFilter {
+		return true
+	}
+	for _, fieldID := range outputFieldIDs {
+		if d.fieldIDs.Contain(fieldID) {
+			return true
+		}
+	}
+	return false
+}
+
+// waitDelayedFields blocks until the delayed fields required by the request are loaded,
+// it must be called without holding the ptrLock, or the segment could never be released while waiting.
+func (s *LocalSegment) waitDelayedFields(ctx context.Context, hasFilter bool, outputFieldIDs []int64) error {
+	d := s.delayedFields
+	if d == nil || d.loaded() && d.err == nil || !d.requiredBy(hasFilter, outputFieldIDs) {
+		return nil
+	}
+	select {
+	case <-d.done:
+		return d.err
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
+
+// IsServiceableEarly returns whether the segment is serviceable while loading the delayed fields.
+func (s *LocalSegment) IsServiceableEarly() bool {
+	return s.delayedFields != nil && !s.delayedFields.loaded()
+}
+
+// splitDelayedFields splits the scalar fields without index, which could be loaded in background, from the fields to load.
+func splitDelayedFields(schema *schemapb.CollectionSchema, fields []*datapb.FieldBinlog) (immediate, delayed []*datapb.FieldBinlog) {
+	delayedFieldIDs := typeutil.NewUniqueSet()
+	for _, field := range schema.GetFields() {
+		if field.GetFieldID() < common.StartOfUserFieldID || field.GetIsPrimaryKey() || typeutil.IsVectorType(field.GetDataType()) {
+			continue
+		}
+		delayedFieldIDs.Insert(field.GetFieldID())
+	}
+	for _, field := range fields {
+		if delayedFieldIDs.Contain(field.GetFieldID()) {
+			delayed = append(delayed, field)
+		} else {
+			immediate = append(immediate, field)
+		}
+	}
+	return immediate, delayed
+}
+
+// loadDelayedFields loads the delayed fields of the serviceable segment in background.
+func (loader *segmentLoader) loadDelayedFields(segment *LocalSegment, fields []*datapb.FieldBinlog, rowCount int64) {
+	d := newDelayedFields(fields)
+	segment.delayedFields = d
+	go func() {
+		log := log.With(
+			zap.Int64("collectionID", segment.Collection()),
+			zap.Int64("segmentID", segment.ID()),
+			zap.Int64s("fieldIDs", d.fieldIDs.Collect()),
+		)
+		err := loader.loadSealedSegmentFields(context.Background(), segment, fields, rowCount)
+		if err != nil {
+			log.Warn("failed to load delayed fields of segment, the requests requiring them fail", zap.Error(err))
+		} else {
+			log.Info("delayed fields of segment loaded")
+		}
+		d.finish(err)
+	}()
+}
+
+// parsePlanFields returns whether the serialized plan has filter, and the output fields of the plan.
+func parsePlanFields(serializedPlan []byte) (bool, []int64) {
+	plan := &planpb.PlanNode{}
+	if err := proto.Unmarshal(serializedPlan, plan); err != nil {
+		// the plan unknown requires all the fields
+		return true, nil
+	}
+	hasFilter := plan.GetVectorAnns().GetPredicates() != nil ||
+		plan.GetQuery().GetPredicates() != nil ||
+		plan.GetPredicates() != nil
+	return hasFilter, plan.GetOutputFieldIds()
+}
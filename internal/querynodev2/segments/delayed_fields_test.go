@@ -0,0 +1,103 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package segments
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/cockroachdb/errors"
+	"github.com/golang/protobuf/proto"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/milvus-io/milvus-proto/go-api/v2/schemapb"
+	"github.com/milvus-io/milvus/internal/proto/datapb"
+	"github.com/milvus-io/milvus/internal/proto/planpb"
+	"github.com/milvus-io/milvus/pkg/common"
+)
+
+func TestSplitDelayedFields(t *testing.T) {
+	schema := &schemapb.CollectionSchema{
+		Fields: []*schemapb.FieldSchema{
+			{FieldID: common.RowIDField, DataType: schemapb.DataType_Int64},
+			{FieldID: common.TimeStampField, DataType: schemapb.DataType_Int64},
+			{FieldID: 100, DataType: schemapb.DataType_Int64, IsPrimaryKey: true},
+			{FieldID: 101, DataType: schemapb.DataType_FloatVector},
+			{FieldID: 102, DataType: schemapb.DataType_VarChar},
+			{FieldID: 103, DataType: schemapb.DataType_JSON},
+		},
+	}
+	fields := []*datapb.FieldBinlog{
+		{FieldID: common.RowIDField},
+		{FieldID: common.TimeStampField},
+		{FieldID: 100},
+		{FieldID: 101},
+		{FieldID: 102},
+		{FieldID: 103},
+	}
+	immediate, delayed := splitDelayedFields(schema, fields)
+	assert.Equal(t, fields[:4], immediate)
+	assert.Equal(t, fields[4:], delayed)
+}
+
+func TestDelayedFields(t *testing.T) {
+	d := newDelayedFields([]*datapb.FieldBinlog{{FieldID: 102}})
+	assert.True(t, d.requiredBy(true, nil))
+	assert.True(t, d.requiredBy(false, []int64{100, 102}))
+	assert.False(t, d.requiredBy(false, []int64{100, 101}))
+
+	segment := &LocalSegment{delayedFields: d}
+	assert.True(t, segment.IsServiceableEarly())
+	assert.NoError(t, segment.waitDelayedFields(context.Background(), false, []int64{100}))
+
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+	assert.ErrorIs(t, segment.waitDelayedFields(ctx, true, nil), context.DeadlineExceeded)
+
+	go d.finish(errors.New("mock"))
+	assert.Error(t, segment.waitDelayedFields(context.Background(), true, nil))
+	assert.False(t, segment.IsServiceableEarly())
+	// the request not requiring the fields failed to load never fails
+	assert.NoError(t, segment.waitDelayedFields(context.Background(), false, nil))
+
+	assert.NoError(t, (&LocalSegment{}).waitDelayedFields(context.Background(), true, nil))
+}
+
+func TestParsePlanFields(t *testing.T) {
+	marshal := func(plan *planpb.PlanNode) []byte {
+		bytes, err := proto.Marshal(plan)
+		require.NoError(t, err)
+		return bytes
+	}
+
+	hasFilter, outputFieldIDs := parsePlanFields(marshal(&planpb.PlanNode{
+		Node:           &planpb.PlanNode_VectorAnns{VectorAnns: &planpb.VectorANNS{FieldId: 101}},
+		OutputFieldIds: []int64{100},
+	}))
+	assert.False(t, hasFilter)
+	assert.Equal(t, []int64{100}, outputFieldIDs)
+
+	hasFilter, _ = parsePlanFields(marshal(&planpb.PlanNode{
+		Node: &planpb.PlanNode_Query{Query: &planpb.QueryPlanNode{Predicates: &planpb.Expr{}}},
+	}))
+	assert.True(t, hasFilter)
+
+	hasFilter, _ = parsePlanFields([]byte("invalid"))
+	assert.True(t, hasFilter)
+}
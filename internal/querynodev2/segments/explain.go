@@ -0,0 +1,266 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package segments
+
+import (
+	"context"
+	"encoding/json"
+	"time"
+
+	"github.com/golang/protobuf/proto"
+
+	"github.com/milvus-io/milvus/internal/proto/internalpb"
+	"github.com/milvus-io/milvus/internal/proto/planpb"
+	"github.com/milvus-io/milvus/internal/proto/querypb"
+	"github.com/milvus-io/milvus/internal/util/funcutil"
+	"github.com/milvus-io/milvus/pkg/common"
+	"github.com/milvus-io/milvus/pkg/util/merr"
+	"github.com/milvus-io/milvus/pkg/util/paramtable"
+)
+
+const (
+	ExplainStageFilter   = "filter"
+	ExplainStageSearch   = "search"
+	ExplainStageRetrieve = "retrieve"
+
+	// explainBruteForce is the index reported for the segments searched without index
+	explainBruteForce = "BRUTE_FORCE"
+)
+
+// SegmentExplain is the plan executed on a segment, returned instead of the results if explain requested.
+type SegmentExplain struct {
+	NodeID      int64  `json:"node_id"`
+	SegmentID   int64  `json:"segment_id"`
+	SegmentType string `json:"segment_type"`
+	RowNum      int64  `json:"row_num"`
+	// the index type of the searched vector field, BRUTE_FORCE if the segment has no index
+	Index string `json:"index,omitempty"`
+	// the rows evaluated by the filter and the rows passed
+	RowsScanned       int64          `json:"rows_scanned"`
+	FilteredRows      int64          `json:"filtered_rows"`
+	FilterSelectivity float64        `json:"filter_selectivity"`
+	Stages            []ExplainStage `json:"stages"`
+}
+
+// ExplainStage is the time cost of a stage executed on segment.
+type ExplainStage struct {
+	Name     string  `json:"name"`
+	CostInMs float64 `json:"cost_ms"`
+}
+
+func (e *SegmentExplain) addStage(name string, cost time.Duration) {
+	e.Stages = append(e.Stages, ExplainStage{Name: name, CostInMs: float64(cost.Microseconds()) / 1000})
+}
+
+// explainPredicates returns the filter of the serialized plan, nil if no filter.
+func explainPredicates(serializedPlan []byte) (*planpb.Expr, error) {
+	plan := &planpb.PlanNode{}
+	if err := proto.Unmarshal(serializedPlan, plan); err != nil {
+		return nil, err
+	}
+	switch node := plan.GetNode().(type) {
+	case *planpb.PlanNode_VectorAnns:
+		return node.VectorAnns.GetPredicates(), nil
+	case *planpb.PlanNode_Query:
+		return node.Query.GetPredicates(), nil
+	case *planpb.PlanNode_Predicates:
+		return node.Predicates, nil
+	}
+	return nil, nil
+}
+
+// newFilterCountPlan creates the plan counting the rows passed the filter.
+func newFilterCountPlan(collection *Collection, predicates *planpb.Expr, timestamp uint64, msgID int64) (*RetrievePlan, error) {
+	expr, err := proto.Marshal(&planpb.PlanNode{
+		Node: &planpb.PlanNode_Query{
+			Query: &planpb.QueryPlanNode{
+				Predicates: predicates,
+				IsCount:    true,
+			},
+		},
+	})
+	if err != nil {
+		return nil, err
+	}
+	return NewRetrievePlan(collection, expr, timestamp, msgID)
+}
+
+// explainFilter evaluates the filter on the segment and records the selectivity,
+// all rows pass if the plan is nil, which means no filter.
+func explainFilter(ctx context.Context, seg Segment, countPlan *RetrievePlan, explain *SegmentExplain) error {
+	explain.RowsScanned = seg.RowNum()
+	explain.FilteredRows = explain.RowsScanned
+	if countPlan != nil {
+		start := time.Now()
+		result, err := seg.Retrieve(ctx, countPlan)
+		if err != nil {
+			return err
+		}
+		explain.addStage(ExplainStageFilter, time.Since(start))
+		explain.FilteredRows, err = funcutil.CntOfSegCoreResult(result)
+		if err != nil {
+			return err
+		}
+	}
+	if explain.RowsScanned > 0 {
+		explain.FilterSelectivity = float64(explain.FilteredRows) / float64(explain.RowsScanned)
+	}
+	return nil
+}
+
+func newSegmentExplain(seg Segment) *SegmentExplain {
+	return &SegmentExplain{
+		NodeID:      paramtable.GetNodeID(),
+		SegmentID:   seg.ID(),
+		SegmentType: seg.Type().String(),
+		RowNum:      seg.RowNum(),
+		Stages:      make([]ExplainStage, 0),
+	}
+}
+
+// segmentIndexType returns the index type of the field, BRUTE_FORCE if the field has no index enabled.
+func segmentIndexType(seg Segment, fieldID int64) string {
+	if !seg.ExistIndex(fieldID) {
+		return explainBruteForce
+	}
+	for _, param := range seg.GetIndex(fieldID).IndexInfo.GetIndexParams() {
+		if param.GetKey() == common.IndexTypeKey {
+			return param.GetValue()
+		}
+	}
+	return explainBruteForce
+}
+
+func encodeExplains(explains []*SegmentExplain) ([]string, error) {
+	encoded := make([]string, 0, len(explains))
+	for _, explain := range explains {
+		bs, err := json.Marshal(explain)
+		if err != nil {
+			return nil, err
+		}
+		encoded = append(encoded, string(bs))
+	}
+	return encoded, nil
+}
+
+func validateScope(ctx context.Context, manager *Manager, collectionID int64, scope querypb.DataScope, segmentIDs []int64) ([]Segment, error) {
+	if scope == querypb.DataScope_Historical {
+		return validateOnHistorical(ctx, manager, collectionID, nil, segmentIDs)
+	}
+	return validateOnStream(ctx, manager, collectionID, nil, segmentIDs)
+}
+
+// ExplainSearch searches the segments one by one, and explains the plan executed on each segment instead of returning the results,
+// the segments are searched sequentially to measure the cost of stages without interference.
+func ExplainSearch(ctx context.Context, manager *Manager, collection *Collection, searchReq *SearchRequest, req *querypb.SearchRequest) ([]string, []Segment, error) {
+	segments, err := validateScope(ctx, manager, req.GetReq().GetCollectionID(), req.GetScope(), req.GetSegmentIDs())
+	if err != nil {
+		return nil, segments, err
+	}
+
+	predicates, err := explainPredicates(req.GetReq().GetSerializedExprPlan())
+	if err != nil {
+		return nil, segments, merr.WrapErrParameterInvalidMsg("failed to parse search plan: %s", err.Error())
+	}
+	var countPlan *RetrievePlan
+	if predicates != nil {
+		countPlan, err = newFilterCountPlan(collection, predicates, searchReq.mvccTimestamp, searchReq.msgID)
+		if err != nil {
+			return nil, segments, err
+		}
+		defer countPlan.Delete()
+	}
+
+	explains := make([]*SegmentExplain, 0, len(segments))
+	for _, seg := range segments {
+		explain := newSegmentExplain(seg)
+		explain.Index = segmentIndexType(seg, searchReq.searchFieldID)
+		if err := explainFilter(ctx, seg, countPlan, explain); err != nil {
+			return nil, segments, err
+		}
+
+		start := time.Now()
+		result, err := seg.Search(ctx, searchReq)
+		if err != nil {
+			return nil, segments, err
+		}
+		explain.addStage(ExplainStageSearch, time.Since(start))
+		DeleteSearchResults([]*SearchResult{result})
+		explains = append(explains, explain)
+	}
+
+	encoded, err := encodeExplains(explains)
+	return encoded, segments, err
+}
+
+// ExplainRetrieve retrieves the segments one by one, and explains the plan executed on each segment instead of returning the results.
+func ExplainRetrieve(ctx context.Context, manager *Manager, collection *Collection, plan *RetrievePlan, req *querypb.QueryRequest) ([]string, []Segment, error) {
+	segments, err := validateScope(ctx, manager, req.GetReq().GetCollectionID(), req.GetScope(), req.GetSegmentIDs())
+	if err != nil {
+		return nil, segments, err
+	}
+
+	predicates, err := explainPredicates(req.GetReq().GetSerializedExprPlan())
+	if err != nil {
+		return nil, segments, merr.WrapErrParameterInvalidMsg("failed to parse query plan: %s", err.Error())
+	}
+	var countPlan *RetrievePlan
+	if predicates != nil {
+		countPlan, err = newFilterCountPlan(collection, predicates, plan.Timestamp, plan.msgID)
+		if err != nil {
+			return nil, segments, err
+		}
+		defer countPlan.Delete()
+	}
+
+	explains := make([]*SegmentExplain, 0, len(segments))
+	for _, seg := range segments {
+		explain := newSegmentExplain(seg)
+		if err := explainFilter(ctx, seg, countPlan, explain); err != nil {
+			return nil, segments, err
+		}
+
+		start := time.Now()
+		if _, err := seg.Retrieve(ctx, plan); err != nil {
+			return nil, segments, err
+		}
+		explain.addStage(ExplainStageRetrieve, time.Since(start))
+		explains = append(explains, explain)
+	}
+
+	encoded, err := encodeExplains(explains)
+	return encoded, segments, err
+}
+
+// mergeExplains concatenates the explains of sub results.
+func mergeExplains[T interface{ GetExplains() []string }](results []T) []string {
+	explains := make([]string, 0)
+	for _, result := range results {
+		explains = append(explains, result.GetExplains()...)
+	}
+	return explains
+}
+
+// explainReducer merges the explains of sub results, which carry no data.
+type explainReducer struct{}
+
+func (r *explainReducer) Reduce(ctx context.Context, results []*internalpb.RetrieveResults) (*internalpb.RetrieveResults, error) {
+	return &internalpb.RetrieveResults{
+		Status:   merr.Success(),
+		Explains: mergeExplains(results),
+	}, nil
+}
@@ -0,0 +1,128 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package segments
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/golang/protobuf/proto"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/milvus-io/milvus-proto/go-api/v2/commonpb"
+	"github.com/milvus-io/milvus/internal/proto/internalpb"
+	"github.com/milvus-io/milvus/internal/proto/planpb"
+	"github.com/milvus-io/milvus/internal/proto/querypb"
+	"github.com/milvus-io/milvus/pkg/common"
+	"github.com/milvus-io/milvus/pkg/util/paramtable"
+)
+
+func TestExplainPredicates(t *testing.T) {
+	expr := &planpb.Expr{
+		Expr: &planpb.Expr_UnaryRangeExpr{
+			UnaryRangeExpr: &planpb.UnaryRangeExpr{
+				ColumnInfo: &planpb.ColumnInfo{FieldId: 101},
+				Op:         planpb.OpType_LessThan,
+				Value:      &planpb.GenericValue{Val: &planpb.GenericValue_Int64Val{Int64Val: 100}},
+			},
+		},
+	}
+	marshal := func(plan *planpb.PlanNode) []byte {
+		bytes, err := proto.Marshal(plan)
+		require.NoError(t, err)
+		return bytes
+	}
+
+	predicates, err := explainPredicates(marshal(&planpb.PlanNode{
+		Node: &planpb.PlanNode_VectorAnns{VectorAnns: &planpb.VectorANNS{FieldId: 100, Predicates: expr}},
+	}))
+	assert.NoError(t, err)
+	assert.True(t, proto.Equal(expr, predicates))
+
+	predicates, err = explainPredicates(marshal(&planpb.PlanNode{
+		Node: &planpb.PlanNode_Query{Query: &planpb.QueryPlanNode{Predicates: expr, Limit: 10}},
+	}))
+	assert.NoError(t, err)
+	assert.True(t, proto.Equal(expr, predicates))
+
+	predicates, err = explainPredicates(marshal(&planpb.PlanNode{
+		Node: &planpb.PlanNode_VectorAnns{VectorAnns: &planpb.VectorANNS{FieldId: 100}},
+	}))
+	assert.NoError(t, err)
+	assert.Nil(t, predicates)
+
+	_, err = explainPredicates([]byte{0xff})
+	assert.Error(t, err)
+}
+
+func TestSegmentExplain(t *testing.T) {
+	paramtable.Init()
+
+	seg := NewMockSegment(t)
+	seg.EXPECT().ID().Return(1)
+	seg.EXPECT().Type().Return(SegmentTypeSealed)
+	seg.EXPECT().RowNum().Return(100)
+	seg.EXPECT().ExistIndex(int64(100)).Return(true)
+	seg.EXPECT().ExistIndex(int64(101)).Return(false)
+	seg.EXPECT().GetIndex(int64(100)).Return(&IndexedFieldInfo{
+		IndexInfo: &querypb.FieldIndexInfo{
+			EnableIndex: true,
+			IndexParams: []*commonpb.KeyValuePair{{Key: common.IndexTypeKey, Value: "HNSW"}},
+		},
+	})
+
+	assert.Equal(t, "HNSW", segmentIndexType(seg, 100))
+	assert.Equal(t, explainBruteForce, segmentIndexType(seg, 101))
+
+	// all rows pass without filter
+	explain := newSegmentExplain(seg)
+	assert.NoError(t, explainFilter(context.Background(), seg, nil, explain))
+	explain.addStage(ExplainStageSearch, 1500*time.Microsecond)
+	assert.EqualValues(t, 100, explain.RowsScanned)
+	assert.EqualValues(t, 100, explain.FilteredRows)
+	assert.Equal(t, 1.0, explain.FilterSelectivity)
+
+	encoded, err := encodeExplains([]*SegmentExplain{explain})
+	require.NoError(t, err)
+	require.Len(t, encoded, 1)
+	decoded := &SegmentExplain{}
+	require.NoError(t, json.Unmarshal([]byte(encoded[0]), decoded))
+	assert.Equal(t, explain, decoded)
+	assert.Equal(t, []ExplainStage{{Name: ExplainStageSearch, CostInMs: 1.5}}, decoded.Stages)
+}
+
+func TestMergeExplains(t *testing.T) {
+	result, err := ReduceSearchResults(context.Background(), []*internalpb.SearchResults{
+		{Explains: []string{"a", "b"}},
+		{Explains: []string{"c"}},
+	}, 1, 10, "L2", 0, 0)
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"a", "b", "c"}, result.GetExplains())
+
+	reducer := CreateInternalReducer(&querypb.QueryRequest{
+		Req: &internalpb.RetrieveRequest{Explain: true, IsCount: true},
+	}, nil)
+	retrieveResult, err := reducer.Reduce(context.Background(), []*internalpb.RetrieveResults{
+		{Explains: []string{"a"}},
+		{Explains: []string{"b"}},
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"a", "b"}, retrieveResult.GetExplains())
+}
@@ -0,0 +1,195 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package segments
+
+import (
+	"container/list"
+	"fmt"
+	"hash/fnv"
+	"sync"
+	"time"
+
+	"github.com/golang/protobuf/proto"
+	"go.uber.org/atomic"
+
+	"github.com/milvus-io/milvus/internal/proto/planpb"
+	"github.com/milvus-io/milvus/pkg/metrics"
+	"github.com/milvus-io/milvus/pkg/util/paramtable"
+	"github.com/milvus-io/milvus/pkg/util/tsoutil"
+	"github.com/milvus-io/milvus/pkg/util/typeutil"
+)
+
+var (
+	fbc         atomic.Pointer[FilterBitsetCache]
+	fbcInitOnce sync.Once
+)
+
+// GetFilterBitsetCache returns the singleton filter bitset cache,
+// the cache is disabled if queryNode.filterBitsetCache.size is 0.
+func GetFilterBitsetCache() *FilterBitsetCache {
+	fbcInitOnce.Do(func() {
+		size := paramtable.Get().QueryNodeCfg.FilterBitsetCacheSize.GetAsInt64() * 1024 * 1024
+		fbc.Store(NewFilterBitsetCache(size))
+	})
+	return fbc.Load()
+}
+
+// filterBitsetKey identifies the evaluated filter expression on a segment.
+type filterBitsetKey struct {
+	segmentID int64
+	exprHash  uint64
+	tsBucket  uint64
+}
+
+// filterBitset is the evaluated filter result of a sealed segment,
+// the set bits are the rows filtered out, before masking the deletes.
+type filterBitset struct {
+	blocks  []uint64
+	numBits int64
+}
+
+func (b *filterBitset) memSize() int64 {
+	return int64(len(b.blocks)) * 8
+}
+
+type filterBitsetEntry struct {
+	key    filterBitsetKey
+	bitset *filterBitset
+}
+
+// FilterBitsetCache caches the evaluated filter bitsets of sealed segments in LRU,
+// so the repeated filtered searches don't evaluate the scalar filters again.
+// The cached bitsets of a segment are invalidated once any delete applied on it.
+type FilterBitsetCache struct {
+	mu       sync.Mutex
+	capacity int64
+	used     int64
+	lru      *list.List
+	entries  map[filterBitsetKey]*list.Element
+	segments map[int64]typeutil.Set[filterBitsetKey]
+}
+
+func NewFilterBitsetCache(capacity int64) *FilterBitsetCache {
+	return &FilterBitsetCache{
+		capacity: capacity,
+		lru:      list.New(),
+		entries:  make(map[filterBitsetKey]*list.Element),
+		segments: make(map[int64]typeutil.Set[filterBitsetKey]),
+	}
+}
+
+func (c *FilterBitsetCache) Enabled() bool {
+	return c.capacity > 0
+}
+
+func (c *FilterBitsetCache) Get(key filterBitsetKey) (*filterBitset, bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	elem, ok := c.entries[key]
+	if !ok {
+		metrics.QueryNodeFilterBitsetCacheCount.WithLabelValues(fmt.Sprint(paramtable.GetNodeID()), metrics.CacheMissLabel).Inc()
+		return nil, false
+	}
+	c.lru.MoveToFront(elem)
+	metrics.QueryNodeFilterBitsetCacheCount.WithLabelValues(fmt.Sprint(paramtable.GetNodeID()), metrics.CacheHitLabel).Inc()
+	return elem.Value.(*filterBitsetEntry).bitset, true
+}
+
+// Put adds the evaluated bitset into cache, evicts the least recently used ones if exceeds the capacity.
+func (c *FilterBitsetCache) Put(key filterBitsetKey, bitset *filterBitset) {
+	if bitset.memSize() > c.capacity {
+		return
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if elem, ok := c.entries[key]; ok {
+		c.removeElement(elem)
+	}
+	for c.used+bitset.memSize() > c.capacity && c.lru.Len() > 0 {
+		c.removeElement(c.lru.Back())
+	}
+
+	c.entries[key] = c.lru.PushFront(&filterBitsetEntry{key: key, bitset: bitset})
+	keys, ok := c.segments[key.segmentID]
+	if !ok {
+		keys = typeutil.NewSet[filterBitsetKey]()
+		c.segments[key.segmentID] = keys
+	}
+	keys.Insert(key)
+	c.used += bitset.memSize()
+	metrics.QueryNodeFilterBitsetCacheSize.WithLabelValues(fmt.Sprint(paramtable.GetNodeID())).Set(float64(c.used))
+}
+
+// Invalidate removes all cached bitsets of the given segment.
+func (c *FilterBitsetCache) Invalidate(segmentID int64) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	for key := range c.segments[segmentID] {
+		c.removeElement(c.entries[key])
+	}
+	metrics.QueryNodeFilterBitsetCacheSize.WithLabelValues(fmt.Sprint(paramtable.GetNodeID())).Set(float64(c.used))
+}
+
+func (c *FilterBitsetCache) removeElement(elem *list.Element) {
+	entry := c.lru.Remove(elem).(*filterBitsetEntry)
+	delete(c.entries, entry.key)
+	if keys, ok := c.segments[entry.key.segmentID]; ok {
+		keys.Remove(entry.key)
+		if keys.Len() == 0 {
+			delete(c.segments, entry.key.segmentID)
+		}
+	}
+	c.used -= entry.bitset.memSize()
+}
+
+// hashFilterExpr returns the hash of the predicates of the serialized search plan,
+// returns false if the plan has no predicate.
+func hashFilterExpr(serializedPlan []byte) (uint64, bool) {
+	plan := &planpb.PlanNode{}
+	if err := proto.Unmarshal(serializedPlan, plan); err != nil {
+		return 0, false
+	}
+	predicates := plan.GetVectorAnns().GetPredicates()
+	if predicates == nil {
+		return 0, false
+	}
+	bytes, err := proto.Marshal(predicates)
+	if err != nil {
+		return 0, false
+	}
+	h := fnv.New64a()
+	h.Write(bytes)
+	return h.Sum64(), true
+}
+
+// tsBucketOf returns the time bucket of the mvcc timestamp,
+// the requests reading the latest data fall into the bucket of now.
+func tsBucketOf(mvccTimestamp uint64) uint64 {
+	bucket := paramtable.Get().QueryNodeCfg.FilterBitsetCacheTsBucket.GetAsDuration(time.Second)
+	if bucket <= 0 {
+		return 0
+	}
+	physical := time.Now()
+	if mvccTimestamp != typeutil.MaxTimestamp {
+		physical, _ = tsoutil.ParseTS(mvccTimestamp)
+	}
+	return uint64(physical.UnixNano() / int64(bucket))
+}
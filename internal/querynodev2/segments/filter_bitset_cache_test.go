@@ -0,0 +1,145 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package segments
+
+import (
+	"testing"
+	"time"
+
+	"github.com/golang/protobuf/proto"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/milvus-io/milvus/internal/proto/planpb"
+	"github.com/milvus-io/milvus/pkg/util/paramtable"
+	"github.com/milvus-io/milvus/pkg/util/tsoutil"
+	"github.com/milvus-io/milvus/pkg/util/typeutil"
+)
+
+func TestFilterBitsetCache(t *testing.T) {
+	paramtable.Init()
+
+	newBitset := func(numBlocks int) *filterBitset {
+		return &filterBitset{blocks: make([]uint64, numBlocks), numBits: int64(numBlocks) * 64}
+	}
+
+	t.Run("disabled", func(t *testing.T) {
+		cache := NewFilterBitsetCache(0)
+		assert.False(t, cache.Enabled())
+		cache.Put(filterBitsetKey{segmentID: 1}, newBitset(1))
+		_, ok := cache.Get(filterBitsetKey{segmentID: 1})
+		assert.False(t, ok)
+	})
+
+	t.Run("evict lru", func(t *testing.T) {
+		cache := NewFilterBitsetCache(32)
+		assert.True(t, cache.Enabled())
+		key1 := filterBitsetKey{segmentID: 1, exprHash: 1}
+		key2 := filterBitsetKey{segmentID: 1, exprHash: 2}
+		key3 := filterBitsetKey{segmentID: 2, exprHash: 1}
+		cache.Put(key1, newBitset(2))
+		cache.Put(key2, newBitset(2))
+		_, ok := cache.Get(key1)
+		assert.True(t, ok)
+
+		// key2 is the least recently used
+		cache.Put(key3, newBitset(2))
+		_, ok = cache.Get(key2)
+		assert.False(t, ok)
+		_, ok = cache.Get(key1)
+		assert.True(t, ok)
+		_, ok = cache.Get(key3)
+		assert.True(t, ok)
+		assert.EqualValues(t, 32, cache.used)
+
+		// larger than the capacity
+		cache.Put(filterBitsetKey{segmentID: 3}, newBitset(5))
+		assert.Equal(t, 2, cache.lru.Len())
+	})
+
+	t.Run("invalidate", func(t *testing.T) {
+		cache := NewFilterBitsetCache(1024)
+		key1 := filterBitsetKey{segmentID: 1, exprHash: 1}
+		key2 := filterBitsetKey{segmentID: 1, exprHash: 2, tsBucket: 1}
+		key3 := filterBitsetKey{segmentID: 2, exprHash: 1}
+		cache.Put(key1, newBitset(1))
+		cache.Put(key2, newBitset(1))
+		cache.Put(key3, newBitset(1))
+
+		cache.Invalidate(1)
+		_, ok := cache.Get(key1)
+		assert.False(t, ok)
+		_, ok = cache.Get(key2)
+		assert.False(t, ok)
+		_, ok = cache.Get(key3)
+		assert.True(t, ok)
+		assert.EqualValues(t, 8, cache.used)
+		assert.NotContains(t, cache.segments, int64(1))
+	})
+}
+
+func TestHashFilterExpr(t *testing.T) {
+	newPlan := func(predicates *planpb.Expr, topk int64) []byte {
+		plan := &planpb.PlanNode{
+			Node: &planpb.PlanNode_VectorAnns{
+				VectorAnns: &planpb.VectorANNS{
+					FieldId:    100,
+					Predicates: predicates,
+					QueryInfo:  &planpb.QueryInfo{Topk: topk},
+				},
+			},
+		}
+		bytes, err := proto.Marshal(plan)
+		require.NoError(t, err)
+		return bytes
+	}
+	newExpr := func(value int64) *planpb.Expr {
+		return &planpb.Expr{
+			Expr: &planpb.Expr_UnaryRangeExpr{
+				UnaryRangeExpr: &planpb.UnaryRangeExpr{
+					ColumnInfo: &planpb.ColumnInfo{FieldId: 101},
+					Op:         planpb.OpType_LessThan,
+					Value:      &planpb.GenericValue{Val: &planpb.GenericValue_Int64Val{Int64Val: value}},
+				},
+			},
+		}
+	}
+
+	_, ok := hashFilterExpr(newPlan(nil, 10))
+	assert.False(t, ok)
+
+	// the hash only depends on the filter
+	hash1, ok := hashFilterExpr(newPlan(newExpr(100), 10))
+	assert.True(t, ok)
+	hash2, ok := hashFilterExpr(newPlan(newExpr(100), 20))
+	assert.True(t, ok)
+	assert.Equal(t, hash1, hash2)
+	hash3, ok := hashFilterExpr(newPlan(newExpr(200), 10))
+	assert.True(t, ok)
+	assert.NotEqual(t, hash1, hash3)
+}
+
+func TestTsBucketOf(t *testing.T) {
+	paramtable.Init()
+	now := time.Now()
+	ts1 := tsoutil.ComposeTSByTime(now.Truncate(10*time.Second), 0)
+	ts2 := tsoutil.ComposeTSByTime(now.Truncate(10*time.Second).Add(5*time.Second), 0)
+	ts3 := tsoutil.ComposeTSByTime(now.Truncate(10*time.Second).Add(15*time.Second), 0)
+	assert.Equal(t, tsBucketOf(ts1), tsBucketOf(ts2))
+	assert.NotEqual(t, tsBucketOf(ts1), tsBucketOf(ts3))
+	assert.InDelta(t, tsBucketOf(tsoutil.ComposeTSByTime(time.Now(), 0)), tsBucketOf(typeutil.MaxTimestamp), 1)
+}
@@ -70,3 +70,14 @@ func (ld *LoadFieldDataInfo) appendMMapDirPath(dir string) {
 
 	C.AppendMMapDirPath(ld.cLoadFieldDataInfo, cDir)
 }
+
+func (ld *LoadFieldDataInfo) enableStringPool(enabled bool) {
+	C.EnableStringPool(ld.cLoadFieldDataInfo, C.bool(enabled))
+}
+
+func (ld *LoadFieldDataInfo) setVectorQuantization(quantization string) {
+	cQuantization := C.CString(quantization)
+	defer C.free(unsafe.Pointer(cQuantization))
+
+	C.SetVectorQuantization(ld.cLoadFieldDataInfo, cQuantization)
+}
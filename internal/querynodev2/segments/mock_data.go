@@ -680,6 +680,7 @@ func SaveBinLog(ctx context.Context,
 			Binlogs: []*datapb.Binlog{{
 				LogPath:    key,
 				EntriesNum: blob.RowNum,
+				Checksum:   storage.BinlogChecksum(blob.Value),
 			}},
 		})
 	}
@@ -700,7 +701,7 @@ func SaveBinLog(ctx context.Context,
 		kvs[key] = blob.Value[:]
 		statsBinlog = append(statsBinlog, &datapb.FieldBinlog{
 			FieldID: fieldID,
-			Binlogs: []*datapb.Binlog{{LogPath: key}},
+			Binlogs: []*datapb.Binlog{{LogPath: key, Checksum: storage.BinlogChecksum(blob.Value)}},
 		})
 	}
 	log.Debug("[query node unittest] save statsLog file to MinIO/S3")
@@ -888,6 +889,7 @@ func SaveDeltaLog(collectionID int64,
 			LogPath:       keyPath,
 			TimestampFrom: 100,
 			TimestampTo:   200,
+			Checksum:      storage.BinlogChecksum(blob.Value),
 		}},
 	})
 	log.Debug("[query node unittest] save delta log file to MinIO/S3")
@@ -14,6 +14,8 @@ import (
 	segcorepb "github.com/milvus-io/milvus/internal/proto/segcorepb"
 
 	storage "github.com/milvus-io/milvus/internal/storage"
+
+	time "time"
 )
 
 // MockSegment is an autogenerated mock type for the Segment type
@@ -29,6 +31,47 @@ func (_m *MockSegment) EXPECT() *MockSegment_Expecter {
 	return &MockSegment_Expecter{mock: &_m.Mock}
 }
 
+// AccessStats provides a mock function with given fields:
+func (_m *MockSegment) AccessStats() AccessStats {
+	ret := _m.Called()
+
+	var r0 AccessStats
+	if rf, ok := ret.Get(0).(func() AccessStats); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(AccessStats)
+	}
+
+	return r0
+}
+
+// MockSegment_AccessStats_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'AccessStats'
+type MockSegment_AccessStats_Call struct {
+	*mock.Call
+}
+
+// AccessStats is a helper method to define mock.On call
+func (_e *MockSegment_Expecter) AccessStats() *MockSegment_AccessStats_Call {
+	return &MockSegment_AccessStats_Call{Call: _e.mock.On("AccessStats")}
+}
+
+func (_c *MockSegment_AccessStats_Call) Run(run func()) *MockSegment_AccessStats_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *MockSegment_AccessStats_Call) Return(_a0 AccessStats) *MockSegment_AccessStats_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockSegment_AccessStats_Call) RunAndReturn(run func() AccessStats) *MockSegment_AccessStats_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // AddIndex provides a mock function with given fields: fieldID, index
 func (_m *MockSegment) AddIndex(fieldID int64, index *IndexedFieldInfo) {
 	_m.Called(fieldID, index)
@@ -683,6 +726,40 @@ func (_c *MockSegment_RUnlock_Call) RunAndReturn(run func()) *MockSegment_RUnloc
 	return _c
 }
 
+// RecordAccess provides a mock function with given fields: label, latency
+func (_m *MockSegment) RecordAccess(label string, latency time.Duration) {
+	_m.Called(label, latency)
+}
+
+// MockSegment_RecordAccess_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RecordAccess'
+type MockSegment_RecordAccess_Call struct {
+	*mock.Call
+}
+
+// RecordAccess is a helper method to define mock.On call
+//   - label string
+//   - latency time.Duration
+func (_e *MockSegment_Expecter) RecordAccess(label interface{}, latency interface{}) *MockSegment_RecordAccess_Call {
+	return &MockSegment_RecordAccess_Call{Call: _e.mock.On("RecordAccess", label, latency)}
+}
+
+func (_c *MockSegment_RecordAccess_Call) Run(run func(label string, latency time.Duration)) *MockSegment_RecordAccess_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(string), args[1].(time.Duration))
+	})
+	return _c
+}
+
+func (_c *MockSegment_RecordAccess_Call) Return() *MockSegment_RecordAccess_Call {
+	_c.Call.Return()
+	return _c
+}
+
+func (_c *MockSegment_RecordAccess_Call) RunAndReturn(run func(string, time.Duration)) *MockSegment_RecordAccess_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // Release provides a mock function with given fields:
 func (_m *MockSegment) Release() {
 	_m.Called()
@@ -27,12 +27,15 @@ import "C"
 
 import (
 	"fmt"
+	"sync"
+	"time"
 	"unsafe"
 
 	"github.com/cockroachdb/errors"
 
 	"github.com/milvus-io/milvus/internal/proto/querypb"
 	"github.com/milvus-io/milvus/pkg/util/merr"
+	"github.com/milvus-io/milvus/pkg/util/paramtable"
 	. "github.com/milvus-io/milvus/pkg/util/typeutil"
 )
 
@@ -89,6 +92,20 @@ type SearchRequest struct {
 	cPlaceholderGroup C.CPlaceholderGroup
 	msgID             UniqueID
 	searchFieldID     UniqueID
+	mvccTimestamp     Timestamp
+	// hash of the filter expression, used to reuse the evaluated filter bitsets
+	filterHash     uint64
+	hasFilter      bool
+	outputFieldIDs []int64
+
+	// the segments not searched within segmentTimeout are missed in the results if partial results allowed
+	allowPartialResult bool
+	segmentTimeout     time.Duration
+
+	mu             sync.Mutex
+	missedSegments []int64
+	// the searches still running on the missed segments, which use the plan and placeholder group
+	inflight sync.WaitGroup
 }
 
 func NewSearchRequest(collection *Collection, req *querypb.SearchRequest, placeholderGrp []byte) (*SearchRequest, error) {
@@ -123,11 +140,29 @@ func NewSearchRequest(collection *Collection, req *querypb.SearchRequest, placeh
 		return nil, err
 	}
 
+	// search the latest data unless the request specifies the snapshot to read
+	mvccTimestamp := req.GetReq().GetMvccTimestamp()
+	if mvccTimestamp == 0 {
+		mvccTimestamp = MaxTimestamp
+	}
+	filterHash, hasFilter := hashFilterExpr(expr)
+
+	segmentTimeout := time.Duration(req.GetReq().GetSegmentTimeout()) * time.Millisecond
+	if segmentTimeout <= 0 {
+		segmentTimeout = paramtable.Get().QueryNodeCfg.PartialSearchSegmentTimeout.GetAsDuration(time.Millisecond)
+	}
+
 	ret := &SearchRequest{
-		plan:              plan,
-		cPlaceholderGroup: cPlaceholderGroup,
-		msgID:             req.GetReq().GetBase().GetMsgID(),
-		searchFieldID:     int64(fieldID),
+		plan:               plan,
+		cPlaceholderGroup:  cPlaceholderGroup,
+		msgID:              req.GetReq().GetBase().GetMsgID(),
+		searchFieldID:      int64(fieldID),
+		mvccTimestamp:      mvccTimestamp,
+		filterHash:         filterHash,
+		hasFilter:          hasFilter,
+		outputFieldIDs:     req.GetReq().GetOutputFieldsId(),
+		allowPartialResult: req.GetReq().GetAllowPartialResult(),
+		segmentTimeout:     segmentTimeout,
 	}
 
 	return ret, nil
@@ -142,7 +177,32 @@ func (req *SearchRequest) Plan() *SearchPlan {
 	return req.plan
 }
 
+// MissedSegments returns the segments not searched in time, whose results are missed.
+func (req *SearchRequest) MissedSegments() []int64 {
+	req.mu.Lock()
+	defer req.mu.Unlock()
+	return append([]int64{}, req.missedSegments...)
+}
+
+func (req *SearchRequest) addMissedSegments(segmentIDs ...int64) {
+	req.mu.Lock()
+	defer req.mu.Unlock()
+	req.missedSegments = append(req.missedSegments, segmentIDs...)
+}
+
 func (req *SearchRequest) Delete() {
+	if len(req.MissedSegments()) > 0 {
+		// free the request after the searches on missed segments done
+		go func() {
+			req.inflight.Wait()
+			req.delete()
+		}()
+		return
+	}
+	req.delete()
+}
+
+func (req *SearchRequest) delete() {
 	if req.plan != nil {
 		req.plan.delete()
 	}
@@ -168,9 +228,11 @@ func parseSearchRequest(plan *SearchPlan, searchRequestBlob []byte) (*SearchRequ
 
 // RetrievePlan is a wrapper of the underlying C-structure C.CRetrievePlan
 type RetrievePlan struct {
-	cRetrievePlan C.CRetrievePlan
-	Timestamp     Timestamp
-	msgID         UniqueID // only used to debug.
+	cRetrievePlan  C.CRetrievePlan
+	Timestamp      Timestamp
+	msgID          UniqueID // only used to debug.
+	hasFilter      bool
+	outputFieldIDs []int64
 }
 
 func NewRetrievePlan(col *Collection, expr []byte, timestamp Timestamp, msgID UniqueID) (*RetrievePlan, error) {
@@ -189,10 +251,13 @@ func NewRetrievePlan(col *Collection, expr []byte, timestamp Timestamp, msgID Un
 		return nil, err
 	}
 
+	hasFilter, outputFieldIDs := parsePlanFields(expr)
 	newPlan := &RetrievePlan{
-		cRetrievePlan: cPlan,
-		Timestamp:     timestamp,
-		msgID:         msgID,
+		cRetrievePlan:  cPlan,
+		Timestamp:      timestamp,
+		msgID:          msgID,
+		hasFilter:      hasFilter,
+		outputFieldIDs: outputFieldIDs,
 	}
 	return newPlan, nil
 }
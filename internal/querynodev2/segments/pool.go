@@ -36,6 +36,9 @@ var (
 	sqOnce  sync.Once
 	dp      atomic.Pointer[conc.Pool[any]]
 	dynOnce sync.Once
+	// warm up the segments one by one to limit the io
+	wp         atomic.Pointer[conc.Pool[any]]
+	warmupOnce sync.Once
 )
 
 // initSQPool initialize
@@ -66,6 +69,18 @@ func initDynamicPool() {
 	})
 }
 
+func initWarmupPool() {
+	warmupOnce.Do(func() {
+		pool := conc.NewPool[any](
+			1,
+			conc.WithPreAlloc(false),
+			conc.WithDisablePurge(false),
+		)
+
+		wp.Store(pool)
+	})
+}
+
 // GetSQPool returns the singleton pool instance for search/query operations.
 func GetSQPool() *conc.Pool[any] {
 	initSQPool()
@@ -77,3 +92,9 @@ func GetDynamicPool() *conc.Pool[any] {
 	initDynamicPool()
 	return dp.Load()
 }
+
+// GetWarmupPool returns the singleton pool for warming up the mmapped segments.
+func GetWarmupPool() *conc.Pool[any] {
+	initWarmupPool()
+	return wp.Load()
+}
@@ -14,6 +14,9 @@ type internalReducer interface {
 }
 
 func CreateInternalReducer(req *querypb.QueryRequest, schema *schemapb.CollectionSchema) internalReducer {
+	if req.GetReq().GetExplain() {
+		return &explainReducer{}
+	}
 	if req.GetReq().GetIsCount() {
 		return &cntReducer{}
 	}
@@ -31,6 +31,7 @@ import (
 	typeutil2 "github.com/milvus-io/milvus/internal/util/typeutil"
 	"github.com/milvus-io/milvus/pkg/common"
 	"github.com/milvus-io/milvus/pkg/log"
+	"github.com/milvus-io/milvus/pkg/metrics"
 	"github.com/milvus-io/milvus/pkg/util/merr"
 	"github.com/milvus-io/milvus/pkg/util/paramtable"
 	"github.com/milvus-io/milvus/pkg/util/typeutil"
@@ -40,18 +41,30 @@ var _ typeutil.ResultWithID = &internalpb.RetrieveResults{}
 
 var _ typeutil.ResultWithID = &segcorepb.RetrieveResults{}
 
-func ReduceSearchResults(ctx context.Context, results []*internalpb.SearchResults, nq int64, topk int64, metricType string) (*internalpb.SearchResults, error) {
+// ReduceSearchResults reduces the search results of segments or shards,
+// the results are grouped by the field if groupSize is positive.
+func ReduceSearchResults(ctx context.Context, results []*internalpb.SearchResults, nq int64, topk int64, metricType string, groupByFieldID int64, groupSize int64) (*internalpb.SearchResults, error) {
+	// the sub results without data may still miss segments
+	partial, missedSegments := mergeMissedSegments(results)
+	explains := mergeExplains(results)
 	results = lo.Filter(results, func(result *internalpb.SearchResults, _ int) bool {
 		return result != nil && result.GetSlicedBlob() != nil
 	})
 
 	if len(results) == 1 {
+		results[0].Partial = partial
+		results[0].MissedSegmentIDs = missedSegments
+		results[0].Explains = explains
 		return results[0], nil
 	}
 
 	log := log.Ctx(ctx)
 
-	searchResultData, err := DecodeSearchResults(results)
+	// the decoded sub results are dropped once reduced, decode them into the reusable buffers
+	arenas := GetScratchArenaPool()
+	arena := arenas.Acquire()
+	defer arenas.Release(arena)
+	searchResultData, err := decodeSearchResults(results, arena)
 	if err != nil {
 		log.Warn("shard leader decode search results errors", zap.Error(err))
 		return nil, err
@@ -65,7 +78,9 @@ func ReduceSearchResults(ctx context.Context, results []*internalpb.SearchResult
 			zap.Int64("topk", sData.TopK))
 	}
 
-	reducedResultData, err := ReduceSearchResultData(ctx, searchResultData, nq, topk)
+	metrics.QueryNodeReduceSubResultNum.WithLabelValues(fmt.Sprint(paramtable.GetNodeID()), metrics.SearchLabel, metrics.ReduceShards).
+		Observe(float64(len(searchResultData)))
+	reducedResultData, err := ReduceSearchResultData(ctx, searchResultData, nq, topk, groupByFieldID, groupSize)
 	if err != nil {
 		log.Warn("shard leader reduce errors", zap.Error(err))
 		return nil, err
@@ -88,11 +103,28 @@ func ReduceSearchResults(ctx context.Context, results []*internalpb.SearchResult
 		return nil, false
 	})
 	searchResults.CostAggregation = mergeRequestCost(requestCosts)
+	searchResults.Partial = partial
+	searchResults.MissedSegmentIDs = missedSegments
+	searchResults.Explains = explains
 
 	return searchResults, nil
 }
 
-func ReduceSearchResultData(ctx context.Context, searchResultData []*schemapb.SearchResultData, nq int64, topk int64) (*schemapb.SearchResultData, error) {
+// mergeMissedSegments returns whether any sub result is partial, and the segments missed by them.
+func mergeMissedSegments(results []*internalpb.SearchResults) (bool, []int64) {
+	partial := false
+	missedSegments := make([]int64, 0)
+	for _, result := range results {
+		partial = partial || result.GetPartial()
+		missedSegments = append(missedSegments, result.GetMissedSegmentIDs()...)
+	}
+	if !partial {
+		return false, nil
+	}
+	return true, missedSegments
+}
+
+func ReduceSearchResultData(ctx context.Context, searchResultData []*schemapb.SearchResultData, nq int64, topk int64, groupByFieldID int64, groupSize int64) (*schemapb.SearchResultData, error) {
 	log := log.Ctx(ctx)
 
 	if len(searchResultData) == 0 {
@@ -111,47 +143,59 @@ func ReduceSearchResultData(ctx context.Context, searchResultData []*schemapb.Se
 		FieldsData: make([]*schemapb.FieldData, len(searchResultData[0].FieldsData)),
 		Scores:     make([]float32, 0),
 		Ids:        &schemapb.IDs{},
-		Topks:      make([]int64, 0),
+		Topks:      make([]int64, 0, nq),
 	}
+	resultNum := nq * topk
+	var inputNum int64
+	for _, data := range searchResultData {
+		inputNum += int64(len(data.GetScores()))
+	}
+	if inputNum < resultNum {
+		resultNum = inputNum
+	}
+	typeutil2.PrepareSearchResultData(ret, searchResultData[0].GetIds(), resultNum)
 
-	resultOffsets := make([][]int64, len(searchResultData))
-	for i := 0; i < len(searchResultData); i++ {
-		resultOffsets[i] = make([]int64, len(searchResultData[i].Topks))
-		for j := int64(1); j < nq; j++ {
-			resultOffsets[i][j] = resultOffsets[i][j-1] + searchResultData[i].Topks[j-1]
-		}
+	merger := typeutil2.NewSearchResultMerger(searchResultData)
+	defer merger.Release()
+	if groupSize > 0 {
+		merger.SetGroupBy(groupByFieldID, groupSize)
 	}
 
 	var skipDupCnt int64
 	var retSize int64
 	maxOutputSize := paramtable.Get().QuotaConfig.MaxOutputSize.GetAsInt64()
 	for i := int64(0); i < nq; i++ {
-		offsets := make([]int64, len(searchResultData))
-
-		idSet := make(map[interface{}]struct{})
+		merger.Start(i)
 		var j int64
 		for j = 0; j < topk; {
-			sel := SelectSearchResultData(searchResultData, resultOffsets, offsets, i)
+			sel, idx := merger.Next()
 			if sel == -1 {
 				break
 			}
-			idx := resultOffsets[sel][i] + offsets[sel]
 
 			id := typeutil.GetPK(searchResultData[sel].GetIds(), idx)
 			score := searchResultData[sel].Scores[idx]
+			if score == -math.MaxFloat32 {
+				// A bad case happens where knowhere returns distance == +/-maxFloat32
+				// by mistake, and the rest results are not better than it.
+				log.Warn("a bad distance is found, something is wrong here!", zap.Float32("score", score))
+				break
+			}
 
 			// remove duplicates
-			if _, ok := idSet[id]; !ok {
+			if !merger.Skip(id) {
+				// skip entity if its group is full
+				if merger.SkipGroup(sel, idx) {
+					continue
+				}
 				retSize += typeutil.AppendFieldData(ret.FieldsData, searchResultData[sel].FieldsData, idx)
 				typeutil.AppendPKs(ret.Ids, id)
 				ret.Scores = append(ret.Scores, score)
-				idSet[id] = struct{}{}
 				j++
 			} else {
 				// skip entity with same id
 				skipDupCnt++
 			}
-			offsets[sel]++
 		}
 
 		// if realTopK != -1 && realTopK != j {
@@ -205,19 +249,25 @@ func SelectSearchResultData(dataArray []*schemapb.SearchResultData, resultOffset
 }
 
 func DecodeSearchResults(searchResults []*internalpb.SearchResults) ([]*schemapb.SearchResultData, error) {
+	return decodeSearchResults(searchResults, nil)
+}
+
+// decodeSearchResults decodes the search results into the buffers of the arena,
+// which are valid until the arena released.
+func decodeSearchResults(searchResults []*internalpb.SearchResults, arena *ScratchArena) ([]*schemapb.SearchResultData, error) {
 	results := make([]*schemapb.SearchResultData, 0)
 	for _, partialSearchResult := range searchResults {
 		if partialSearchResult.SlicedBlob == nil {
 			continue
 		}
 
-		var partialResultData schemapb.SearchResultData
-		err := proto.Unmarshal(partialSearchResult.SlicedBlob, &partialResultData)
+		partialResultData := arena.SearchResultData()
+		err := proto.UnmarshalMerge(partialSearchResult.SlicedBlob, partialResultData)
 		if err != nil {
 			return nil, err
 		}
 
-		results = append(results, &partialResultData)
+		results = append(results, partialResultData)
 	}
 	return results, nil
 }
@@ -580,7 +580,7 @@ func (suite *ResultSuite) TestResult_ReduceSearchResultData() {
 		dataArray := make([]*schemapb.SearchResultData, 0)
 		dataArray = append(dataArray, data1)
 		dataArray = append(dataArray, data2)
-		res, err := ReduceSearchResultData(context.TODO(), dataArray, nq, topk)
+		res, err := ReduceSearchResultData(context.TODO(), dataArray, nq, topk, 0, 0)
 		suite.Nil(err)
 		suite.Equal(ids, res.Ids.GetIntId().Data)
 		suite.Equal(scores, res.Scores)
@@ -597,7 +597,7 @@ func (suite *ResultSuite) TestResult_ReduceSearchResultData() {
 		dataArray := make([]*schemapb.SearchResultData, 0)
 		dataArray = append(dataArray, data1)
 		dataArray = append(dataArray, data2)
-		res, err := ReduceSearchResultData(context.TODO(), dataArray, nq, topk)
+		res, err := ReduceSearchResultData(context.TODO(), dataArray, nq, topk, 0, 0)
 		suite.Nil(err)
 		suite.ElementsMatch([]int64{1, 5, 2, 3}, res.Ids.GetIntId().Data)
 	})
@@ -820,6 +820,32 @@ func TestResult_MergeRequestCost(t *testing.T) {
 	assert.Equal(t, int64(43), channelCost.TotalNQ)
 }
 
+func TestResult_MergeMissedSegments(t *testing.T) {
+	partial, missed := mergeMissedSegments([]*internalpb.SearchResults{
+		{SlicedBlob: []byte{1}},
+		nil,
+	})
+	assert.False(t, partial)
+	assert.Nil(t, missed)
+
+	partial, missed = mergeMissedSegments([]*internalpb.SearchResults{
+		{SlicedBlob: []byte{1}, Partial: true, MissedSegmentIDs: []int64{1, 2}},
+		{Partial: true, MissedSegmentIDs: []int64{3}},
+		{SlicedBlob: []byte{1}},
+	})
+	assert.True(t, partial)
+	assert.ElementsMatch(t, []int64{1, 2, 3}, missed)
+
+	// the partial flag is kept even if the only sub result with data is complete
+	result, err := ReduceSearchResults(context.Background(), []*internalpb.SearchResults{
+		{SlicedBlob: []byte{1}},
+		{Partial: true, MissedSegmentIDs: []int64{3}},
+	}, 1, 10, "L2", 0, 0)
+	assert.NoError(t, err)
+	assert.True(t, result.GetPartial())
+	assert.Equal(t, []int64{3}, result.GetMissedSegmentIDs())
+}
+
 func TestResult(t *testing.T) {
 	paramtable.Init()
 	suite.Run(t, new(ResultSuite))
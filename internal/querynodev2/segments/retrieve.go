@@ -58,8 +58,10 @@ func retrieveOnSegments(ctx context.Context, segments []Segment, segType Segment
 			}
 			errs[i] = nil
 			resultCh <- result
-			metrics.QueryNodeSQSegmentLatency.WithLabelValues(fmt.Sprint(paramtable.GetNodeID()),
-				metrics.QueryLabel, label).Observe(float64(tr.ElapseSpan().Milliseconds()))
+			span := tr.ElapseSpan()
+			seg.RecordAccess(metrics.QueryLabel, span)
+			metrics.ObserveWithTrace(ctx, metrics.QueryNodeSQSegmentLatency.WithLabelValues(fmt.Sprint(paramtable.GetNodeID()),
+				metrics.QueryLabel, label), float64(span.Milliseconds()))
 		}(segment, i)
 	}
 	wg.Wait()
@@ -111,8 +113,10 @@ func retrieveOnSegmentsWithStream(ctx context.Context, segments []Segment, segTy
 			}
 
 			errs[i] = nil
-			metrics.QueryNodeSQSegmentLatency.WithLabelValues(fmt.Sprint(paramtable.GetNodeID()),
-				metrics.QueryLabel, label).Observe(float64(tr.ElapseSpan().Milliseconds()))
+			span := tr.ElapseSpan()
+			seg.RecordAccess(metrics.QueryLabel, span)
+			metrics.ObserveWithTrace(ctx, metrics.QueryNodeSQSegmentLatency.WithLabelValues(fmt.Sprint(paramtable.GetNodeID()),
+				metrics.QueryLabel, label), float64(span.Milliseconds()))
 		}(segment, i)
 	}
 	wg.Wait()
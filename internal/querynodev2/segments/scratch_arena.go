@@ -0,0 +1,165 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package segments
+
+import (
+	"fmt"
+	"sync"
+
+	"go.uber.org/atomic"
+
+	"github.com/milvus-io/milvus-proto/go-api/v2/schemapb"
+	"github.com/milvus-io/milvus/pkg/metrics"
+	"github.com/milvus-io/milvus/pkg/util/paramtable"
+)
+
+var (
+	sap         atomic.Pointer[ScratchArenaPool]
+	sapInitOnce sync.Once
+)
+
+// GetScratchArenaPool returns the singleton pool of scratch arenas, one arena for each search worker.
+func GetScratchArenaPool() *ScratchArenaPool {
+	sapInitOnce.Do(func() {
+		sap.Store(NewScratchArenaPool(GetSQPool().Cap()))
+	})
+	return sap.Load()
+}
+
+// ScratchArena keeps the intermediate buffers of a search, the distances and ids of the decoded sub results,
+// which are reused by the following searches instead of allocated for every request.
+// The buffers handed out by an arena are valid until the arena is released.
+type ScratchArena struct {
+	temporary bool
+	results   []*schemapb.SearchResultData
+	used      int
+}
+
+// SearchResultData returns an empty search result data to decode into,
+// whose distances and int64 ids reuse the capacity of the previous searches.
+// A nil arena returns a newly allocated one.
+func (a *ScratchArena) SearchResultData() *schemapb.SearchResultData {
+	if a == nil {
+		return &schemapb.SearchResultData{}
+	}
+	if a.used == len(a.results) {
+		a.results = append(a.results, &schemapb.SearchResultData{})
+	}
+	data := a.results[a.used]
+	a.used++
+	return data
+}
+
+// reset clears the handed out results but keeps their buffers.
+func (a *ScratchArena) reset() {
+	for _, data := range a.results[:a.used] {
+		resetSearchResultData(data)
+	}
+	a.used = 0
+}
+
+// trim releases the buffers beyond the max size.
+func (a *ScratchArena) trim(maxSize int64) {
+	size := a.size()
+	for len(a.results) > 0 && size > maxSize {
+		last := a.results[len(a.results)-1]
+		size -= searchResultDataBufferSize(last)
+		a.results[len(a.results)-1] = nil
+		a.results = a.results[:len(a.results)-1]
+	}
+}
+
+func (a *ScratchArena) size() int64 {
+	var size int64
+	for _, data := range a.results {
+		size += searchResultDataBufferSize(data)
+	}
+	return size
+}
+
+// resetSearchResultData resets the data to decode into with proto merging,
+// which appends the distances and ids to the kept buffers.
+func resetSearchResultData(data *schemapb.SearchResultData) {
+	scores := data.Scores[:0]
+	topks := data.Topks[:0]
+	intIDs := data.GetIds().GetIntId()
+	*data = schemapb.SearchResultData{
+		Scores: scores,
+		Topks:  topks,
+	}
+	if intIDs != nil {
+		intIDs.Data = intIDs.Data[:0]
+		data.Ids = &schemapb.IDs{IdField: &schemapb.IDs_IntId{IntId: intIDs}}
+	}
+}
+
+func searchResultDataBufferSize(data *schemapb.SearchResultData) int64 {
+	return int64(cap(data.Scores))*4 + int64(cap(data.Topks))*8 + int64(cap(data.GetIds().GetIntId().GetData()))*8
+}
+
+// ScratchArenaPool holds the scratch arenas, the searches beyond the number of arenas use temporary ones,
+// which are not reused.
+type ScratchArenaPool struct {
+	idle      chan *ScratchArena
+	idleBytes atomic.Int64
+	inUse     atomic.Int64
+}
+
+func NewScratchArenaPool(num int) *ScratchArenaPool {
+	pool := &ScratchArenaPool{
+		idle: make(chan *ScratchArena, num),
+	}
+	for i := 0; i < num; i++ {
+		pool.idle <- &ScratchArena{}
+	}
+	return pool
+}
+
+// Acquire returns an idle arena, or a temporary one if all arenas are in use or the arenas are disabled.
+func (p *ScratchArenaPool) Acquire() *ScratchArena {
+	defer p.updateMetrics()
+	p.inUse.Inc()
+	if paramtable.Get().QueryNodeCfg.ScratchArenaMaxSize.GetAsInt64() <= 0 {
+		return &ScratchArena{temporary: true}
+	}
+	select {
+	case arena := <-p.idle:
+		p.idleBytes.Sub(arena.size())
+		return arena
+	default:
+		return &ScratchArena{temporary: true}
+	}
+}
+
+// Release returns the arena to the pool, the buffers beyond the max size are released.
+func (p *ScratchArenaPool) Release(arena *ScratchArena) {
+	defer p.updateMetrics()
+	p.inUse.Dec()
+	if arena.temporary {
+		return
+	}
+	arena.reset()
+	arena.trim(paramtable.Get().QueryNodeCfg.ScratchArenaMaxSize.GetAsInt64() * 1024 * 1024)
+	p.idleBytes.Add(arena.size())
+	p.idle <- arena
+}
+
+func (p *ScratchArenaPool) updateMetrics() {
+	nodeID := fmt.Sprint(paramtable.GetNodeID())
+	metrics.QueryNodeScratchArenaBytes.WithLabelValues(nodeID).Set(float64(p.idleBytes.Load()))
+	metrics.QueryNodeScratchArenaInUse.WithLabelValues(nodeID).Set(float64(p.inUse.Load()))
+}
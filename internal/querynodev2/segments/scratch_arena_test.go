@@ -0,0 +1,85 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package segments
+
+import (
+	"testing"
+
+	"github.com/golang/protobuf/proto"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/milvus-io/milvus-proto/go-api/v2/schemapb"
+	"github.com/milvus-io/milvus/internal/proto/internalpb"
+	"github.com/milvus-io/milvus/pkg/util/paramtable"
+)
+
+func TestScratchArena(t *testing.T) {
+	paramtable.Init()
+
+	newResults := func(ids []int64, scores []float32) []*internalpb.SearchResults {
+		blob, err := proto.Marshal(&schemapb.SearchResultData{
+			NumQueries: 1,
+			TopK:       int64(len(ids)),
+			Scores:     scores,
+			Ids:        &schemapb.IDs{IdField: &schemapb.IDs_IntId{IntId: &schemapb.LongArray{Data: ids}}},
+			Topks:      []int64{int64(len(ids))},
+		})
+		require.NoError(t, err)
+		return []*internalpb.SearchResults{{SlicedBlob: blob}, {SlicedBlob: nil}}
+	}
+
+	pool := NewScratchArenaPool(1)
+	arena := pool.Acquire()
+	assert.False(t, arena.temporary)
+	// all arenas are in use
+	temporary := pool.Acquire()
+	assert.True(t, temporary.temporary)
+	pool.Release(temporary)
+
+	data, err := decodeSearchResults(newResults([]int64{1, 2, 3}, []float32{0.3, 0.2, 0.1}), arena)
+	require.NoError(t, err)
+	require.Len(t, data, 1)
+	assert.Equal(t, []int64{1, 2, 3}, data[0].GetIds().GetIntId().GetData())
+	scores := data[0].GetScores()
+	pool.Release(arena)
+	assert.Greater(t, pool.idleBytes.Load(), int64(0))
+
+	// the buffers are reused by the next search
+	arena = pool.Acquire()
+	data, err = decodeSearchResults(newResults([]int64{4, 5}, []float32{0.5, 0.4}), arena)
+	require.NoError(t, err)
+	require.Len(t, data, 1)
+	assert.Equal(t, []int64{4, 5}, data[0].GetIds().GetIntId().GetData())
+	assert.Equal(t, []float32{0.5, 0.4}, data[0].GetScores())
+	assert.Equal(t, &scores[0], &data[0].GetScores()[0])
+	assert.EqualValues(t, 2, data[0].GetTopK())
+
+	// the buffers beyond the max size are released
+	paramtable.Get().Save(paramtable.Get().QueryNodeCfg.ScratchArenaMaxSize.Key, "0")
+	defer paramtable.Get().Reset(paramtable.Get().QueryNodeCfg.ScratchArenaMaxSize.Key)
+	pool.Release(arena)
+	assert.EqualValues(t, 0, pool.idleBytes.Load())
+	assert.EqualValues(t, 0, pool.inUse.Load())
+	// the arenas are disabled
+	assert.True(t, pool.Acquire().temporary)
+
+	// nil arena allocates
+	data, err = decodeSearchResults(newResults([]int64{1}, []float32{0.1}), nil)
+	require.NoError(t, err)
+	assert.Equal(t, []int64{1}, data[0].GetIds().GetIntId().GetData())
+}
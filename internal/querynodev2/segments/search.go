@@ -20,6 +20,7 @@ import (
 	"context"
 	"fmt"
 	"sync"
+	"time"
 
 	"go.uber.org/zap"
 
@@ -33,14 +34,21 @@ import (
 // searchOnSegments performs search on listed segments
 // all segment ids are validated before calling this function
 func searchSegments(ctx context.Context, segments []Segment, segType SegmentType, searchReq *SearchRequest) ([]*SearchResult, error) {
+	type segmentResult struct {
+		idx    int
+		result *SearchResult
+		err    error
+	}
+
 	var (
 		// results variables
-		resultCh = make(chan *SearchResult, len(segments))
-		errs     = make([]error, len(segments))
-		wg       sync.WaitGroup
+		resultCh = make(chan segmentResult, len(segments))
+		// the results of the segments finished after timeout are freed by themselves
+		collected = true
 
+		// guards collected and segmentsWithoutIndex
+		mu sync.Mutex
 		// For log only
-		mu                   sync.Mutex
 		segmentsWithoutIndex []int64
 	)
 
@@ -51,9 +59,9 @@ func searchSegments(ctx context.Context, segments []Segment, segType SegmentType
 
 	// calling segment search in goroutines
 	for i, segment := range segments {
-		wg.Add(1)
+		searchReq.inflight.Add(1)
 		go func(seg Segment, i int) {
-			defer wg.Done()
+			defer searchReq.inflight.Done()
 			if !seg.ExistIndex(searchReq.searchFieldID) {
 				mu.Lock()
 				segmentsWithoutIndex = append(segmentsWithoutIndex, seg.ID())
@@ -62,34 +70,91 @@ func searchSegments(ctx context.Context, segments []Segment, segType SegmentType
 			// record search time
 			tr := timerecord.NewTimeRecorder("searchOnSegments")
 			searchResult, err := seg.Search(ctx, searchReq)
-			errs[i] = err
-			resultCh <- searchResult
+			mu.Lock()
+			if collected {
+				resultCh <- segmentResult{idx: i, result: searchResult, err: err}
+			} else if searchResult != nil {
+				DeleteSearchResults([]*SearchResult{searchResult})
+			}
+			mu.Unlock()
 			// update metrics
-			elapsed := tr.ElapseSpan().Milliseconds()
-			metrics.QueryNodeSQSegmentLatency.WithLabelValues(fmt.Sprint(paramtable.GetNodeID()),
-				metrics.SearchLabel, searchLabel).Observe(float64(elapsed))
+			span := tr.ElapseSpan()
+			if err == nil {
+				seg.RecordAccess(metrics.SearchLabel, span)
+			}
+			elapsed := span.Milliseconds()
+			metrics.ObserveWithTrace(ctx, metrics.QueryNodeSQSegmentLatency.WithLabelValues(fmt.Sprint(paramtable.GetNodeID()),
+				metrics.SearchLabel, searchLabel), float64(elapsed))
 			metrics.QueryNodeSegmentSearchLatencyPerVector.WithLabelValues(fmt.Sprint(paramtable.GetNodeID()),
 				metrics.SearchLabel, searchLabel).Observe(float64(elapsed) / float64(searchReq.getNumOfQuery()))
 		}(segment, i)
 	}
-	wg.Wait()
-	close(resultCh)
 
-	searchResults := make([]*SearchResult, 0, len(segments))
-	for result := range resultCh {
-		searchResults = append(searchResults, result)
+	// the segments not searched in time are missed if partial results allowed
+	var timeout <-chan time.Time
+	if searchReq.allowPartialResult && searchReq.segmentTimeout > 0 {
+		timer := time.NewTimer(searchReq.segmentTimeout)
+		defer timer.Stop()
+		timeout = timer.C
+	}
+
+	var (
+		searchResults = make([]*SearchResult, 0, len(segments))
+		finished      = make([]bool, len(segments))
+		errs          = make([]error, 0)
+	)
+	handle := func(r segmentResult) {
+		finished[r.idx] = true
+		if r.result != nil {
+			searchResults = append(searchResults, r.result)
+		}
+		if r.err != nil {
+			errs = append(errs, r.err)
+		}
+	}
+	for count := 0; count < len(segments); count++ {
+		select {
+		case r := <-resultCh:
+			handle(r)
+			continue
+		case <-timeout:
+		}
+
+		mu.Lock()
+		collected = false
+		mu.Unlock()
+		// the results sent before the collection stopped
+		close(resultCh)
+		for r := range resultCh {
+			handle(r)
+		}
+		break
 	}
 
-	for _, err := range errs {
-		if err != nil {
-			DeleteSearchResults(searchResults)
-			return nil, err
+	if len(errs) > 0 {
+		DeleteSearchResults(searchResults)
+		return nil, errs[0]
+	}
+
+	missed := make([]int64, 0)
+	for i, segment := range segments {
+		if !finished[i] {
+			missed = append(missed, segment.ID())
 		}
 	}
+	if len(missed) > 0 {
+		searchReq.addMissedSegments(missed...)
+		metrics.QueryNodeSearchMissedSegments.WithLabelValues(fmt.Sprint(paramtable.GetNodeID()), searchLabel).Add(float64(len(missed)))
+		log.Ctx(ctx).Warn("segments not searched in time, return partial results",
+			zap.Duration("segmentTimeout", searchReq.segmentTimeout),
+			zap.Int64s("missedSegments", missed))
+	}
 
+	mu.Lock()
 	if len(segmentsWithoutIndex) > 0 {
 		log.Ctx(ctx).Debug("search growing/sealed segments without indexes", zap.Int64s("segmentIDs", segmentsWithoutIndex))
 	}
+	mu.Unlock()
 
 	return searchResults, nil
 }
@@ -29,6 +29,7 @@ import (
 	"context"
 	"fmt"
 	"sync"
+	"time"
 	"unsafe"
 
 	"github.com/cockroachdb/errors"
@@ -77,6 +78,7 @@ type baseSegment struct {
 	version        *atomic.Int64
 	startPosition  *msgpb.MsgPosition // for growing segment release
 	bloomFilterSet *pkoracle.BloomFilterSet
+	accessStats    *accessStats
 }
 
 func newBaseSegment(id, partitionID, collectionID int64, shard string, typ SegmentType, version int64, startPosition *msgpb.MsgPosition) baseSegment {
@@ -89,6 +91,7 @@ func newBaseSegment(id, partitionID, collectionID int64, shard string, typ Segme
 		version:        atomic.NewInt64(version),
 		startPosition:  startPosition,
 		bloomFilterSet: pkoracle.NewBloomFilterSet(id, partitionID, typ),
+		accessStats:    &accessStats{},
 	}
 }
 
@@ -136,6 +139,16 @@ func (s *baseSegment) MayPkExist(pk storage.PrimaryKey) bool {
 	return s.bloomFilterSet.MayPkExist(pk)
 }
 
+// RecordAccess records a search or query on the segment with its latency.
+func (s *baseSegment) RecordAccess(label string, latency time.Duration) {
+	s.accessStats.record(label, latency)
+}
+
+// AccessStats returns the access statistics of the segment since it's loaded.
+func (s *baseSegment) AccessStats() AccessStats {
+	return s.accessStats.snapshot()
+}
+
 var _ Segment = (*LocalSegment)(nil)
 
 // Segment is a wrapper of the underlying C-structure segment.
@@ -148,6 +161,12 @@ type LocalSegment struct {
 	row                int64
 	lastDeltaTimestamp *atomic.Uint64
 	fieldIndexes       *typeutil.ConcurrentMap[int64, *IndexedFieldInfo]
+	stringPoolEnabled  bool
+	mmapDirPath        string
+	// the quantization of the raw float vectors, empty if not quantized
+	vectorQuantization string
+	// nil unless the scalar fields are loaded after the segment becomes serviceable
+	delayedFields *delayedFields
 }
 
 func NewSegment(collection *Collection,
@@ -185,6 +204,11 @@ func NewSegment(collection *Collection,
 		ptr:                segmentPtr,
 		lastDeltaTimestamp: atomic.NewUint64(0),
 		fieldIndexes:       typeutil.NewConcurrentMap[int64, *IndexedFieldInfo](),
+		stringPoolEnabled:  segmentType == SegmentTypeSealed && collection.IsStringPoolEnabled(),
+		mmapDirPath:        collection.MmapDirPath(),
+	}
+	if segmentType == SegmentTypeSealed {
+		segment.vectorQuantization = collection.GetVectorQuantization()
 	}
 
 	return segment, nil
@@ -317,6 +341,9 @@ func (s *LocalSegment) Search(ctx context.Context, searchReq *SearchRequest) (*S
 		zap.Int64("segmentID", s.ID()),
 		zap.String("segmentType", s.typ.String()),
 	)
+	if err := s.waitDelayedFields(ctx, searchReq.hasFilter, searchReq.outputFieldIDs); err != nil {
+		return nil, err
+	}
 	s.ptrLock.RLock()
 	defer s.ptrLock.RUnlock()
 
@@ -338,6 +365,13 @@ func (s *LocalSegment) Search(ctx context.Context, searchReq *SearchRequest) (*S
 	log = log.With(zap.Bool("withIndex", hasIndex))
 	log.Debug("search segment...")
 
+	// the filter results of sealed segments are unchanged until deletes applied,
+	// reuse them for the repeated filtered searches
+	cache := GetFilterBitsetCache()
+	if s.typ == SegmentTypeSealed && searchReq.hasFilter && cache.Enabled() {
+		return s.searchWithFilterBitsetCache(ctx, cache, searchReq, traceCtx)
+	}
+
 	var searchResult SearchResult
 	var status C.CStatus
 	GetSQPool().Submit(func() (any, error) {
@@ -345,10 +379,11 @@ func (s *LocalSegment) Search(ctx context.Context, searchReq *SearchRequest) (*S
 		status = C.Search(s.ptr,
 			searchReq.plan.cSearchPlan,
 			searchReq.cPlaceholderGroup,
+			C.uint64_t(searchReq.mvccTimestamp),
 			traceCtx,
 			&searchResult.cSearchResult,
 		)
-		metrics.QueryNodeSQSegmentLatencyInCore.WithLabelValues(fmt.Sprint(paramtable.GetNodeID()), metrics.SearchLabel).Observe(float64(tr.ElapseSpan().Milliseconds()))
+		metrics.ObserveWithTrace(ctx, metrics.QueryNodeSQSegmentLatencyInCore.WithLabelValues(fmt.Sprint(paramtable.GetNodeID()), metrics.SearchLabel), float64(tr.ElapseSpan().Milliseconds()))
 		return nil, nil
 	}).Await()
 	if err := HandleCStatus(&status, "Search failed"); err != nil {
@@ -358,7 +393,65 @@ func (s *LocalSegment) Search(ctx context.Context, searchReq *SearchRequest) (*S
 	return &searchResult, nil
 }
 
+// searchWithFilterBitsetCache searches with the cached filter bitset if any,
+// otherwise caches the filter bitset evaluated by segcore.
+// The caller must hold the ptrLock.
+func (s *LocalSegment) searchWithFilterBitsetCache(ctx context.Context, cache *FilterBitsetCache, searchReq *SearchRequest, traceCtx C.CTraceContext) (*SearchResult, error) {
+	key := filterBitsetKey{
+		segmentID: s.ID(),
+		exprHash:  searchReq.filterHash,
+		tsBucket:  tsBucketOf(searchReq.mvccTimestamp),
+	}
+	cached, hit := cache.Get(key)
+
+	var (
+		cachedBlocks    *C.uint64_t
+		cachedNumBlocks C.int64_t
+		cachedNumBits   C.int64_t
+	)
+	if hit && len(cached.blocks) > 0 {
+		cachedBlocks = (*C.uint64_t)(unsafe.Pointer(&cached.blocks[0]))
+		cachedNumBlocks = C.int64_t(len(cached.blocks))
+		cachedNumBits = C.int64_t(cached.numBits)
+	}
+
+	var searchResult SearchResult
+	var evaluated C.CFilterBitset
+	var status C.CStatus
+	GetSQPool().Submit(func() (any, error) {
+		tr := timerecord.NewTimeRecorder("cgoSearch")
+		status = C.SearchWithFilterBitset(s.ptr,
+			searchReq.plan.cSearchPlan,
+			searchReq.cPlaceholderGroup,
+			C.uint64_t(searchReq.mvccTimestamp),
+			traceCtx,
+			cachedBlocks,
+			cachedNumBlocks,
+			cachedNumBits,
+			&evaluated,
+			&searchResult.cSearchResult,
+		)
+		metrics.ObserveWithTrace(ctx, metrics.QueryNodeSQSegmentLatencyInCore.WithLabelValues(fmt.Sprint(paramtable.GetNodeID()), metrics.SearchLabel), float64(tr.ElapseSpan().Milliseconds()))
+		return nil, nil
+	}).Await()
+	if err := HandleCStatus(&status, "Search failed"); err != nil {
+		return nil, err
+	}
+
+	if evaluated.blocks != nil {
+		blocks := make([]uint64, int(evaluated.num_blocks))
+		copy(blocks, unsafe.Slice((*uint64)(unsafe.Pointer(evaluated.blocks)), int(evaluated.num_blocks)))
+		cache.Put(key, &filterBitset{blocks: blocks, numBits: int64(evaluated.num_bits)})
+		C.DeleteFilterBitset(&evaluated)
+	}
+	log.Ctx(ctx).Debug("search segment done", zap.Int64("segmentID", s.ID()), zap.Bool("filterBitsetCacheHit", hit))
+	return &searchResult, nil
+}
+
 func (s *LocalSegment) Retrieve(ctx context.Context, plan *RetrievePlan) (*segcorepb.RetrieveResults, error) {
+	if err := s.waitDelayedFields(ctx, plan.hasFilter, plan.outputFieldIDs); err != nil {
+		return nil, err
+	}
 	s.ptrLock.RLock()
 	defer s.ptrLock.RUnlock()
 
@@ -397,8 +490,8 @@ func (s *LocalSegment) Retrieve(ctx context.Context, plan *RetrievePlan) (*segco
 			&retrieveResult.cRetrieveResult,
 			C.int64_t(maxLimitSize))
 
-		metrics.QueryNodeSQSegmentLatencyInCore.WithLabelValues(fmt.Sprint(paramtable.GetNodeID()),
-			metrics.QueryLabel).Observe(float64(tr.ElapseSpan().Milliseconds()))
+		metrics.ObserveWithTrace(ctx, metrics.QueryNodeSQSegmentLatencyInCore.WithLabelValues(fmt.Sprint(paramtable.GetNodeID()),
+			metrics.QueryLabel), float64(tr.ElapseSpan().Milliseconds()))
 		log.Debug("cgo retrieve done", zap.Duration("timeTaken", tr.ElapseSpan()))
 		return nil, nil
 	}).Await()
@@ -577,6 +670,7 @@ func (s *LocalSegment) Delete(primaryKeys []storage.PrimaryKey, timestamps []typ
 	}
 
 	s.lastDeltaTimestamp.Store(timestamps[len(timestamps)-1])
+	GetFilterBitsetCache().Invalidate(s.ID())
 
 	return nil
 }
@@ -616,8 +710,10 @@ func (s *LocalSegment) LoadMultiFieldData(rowCount int64, fields []*datapb.Field
 			}
 		}
 
-		loadFieldDataInfo.appendMMapDirPath(paramtable.Get().QueryNodeCfg.MmapDirPath.GetValue())
+		loadFieldDataInfo.appendMMapDirPath(s.mmapDirPath)
 	}
+	loadFieldDataInfo.enableStringPool(s.stringPoolEnabled)
+	loadFieldDataInfo.setVectorQuantization(s.vectorQuantization)
 
 	var status C.CStatus
 	GetDynamicPool().Submit(func() (any, error) {
@@ -627,6 +723,9 @@ func (s *LocalSegment) LoadMultiFieldData(rowCount int64, fields []*datapb.Field
 	if err := HandleCStatus(&status, "LoadMultiFieldData failed"); err != nil {
 		return err
 	}
+	if s.stringPoolEnabled {
+		updateStringPoolMetrics()
+	}
 
 	log.Info("load mutil field done",
 		zap.Int64("row count", rowCount),
@@ -669,7 +768,9 @@ func (s *LocalSegment) LoadFieldData(fieldID int64, rowCount int64, field *datap
 			return err
 		}
 	}
-	loadFieldDataInfo.appendMMapDirPath(paramtable.Get().QueryNodeCfg.MmapDirPath.GetValue())
+	loadFieldDataInfo.appendMMapDirPath(s.mmapDirPath)
+	loadFieldDataInfo.enableStringPool(s.stringPoolEnabled)
+	loadFieldDataInfo.setVectorQuantization(s.vectorQuantization)
 
 	var status C.CStatus
 	GetDynamicPool().Submit(func() (any, error) {
@@ -680,6 +781,9 @@ func (s *LocalSegment) LoadFieldData(fieldID int64, rowCount int64, field *datap
 	if err := HandleCStatus(&status, "LoadFieldData failed"); err != nil {
 		return err
 	}
+	if s.stringPoolEnabled {
+		updateStringPoolMetrics()
+	}
 
 	log.Info("load field done")
 
@@ -805,6 +909,7 @@ func (s *LocalSegment) LoadDeltaData(deltaData *storage.DeleteData) error {
 	}
 
 	s.lastDeltaTimestamp.Store(tss[len(tss)-1])
+	GetFilterBitsetCache().Invalidate(s.ID())
 
 	log.Info("load deleted record done",
 		zap.Int64("rowNum", rowNum),
@@ -819,7 +924,7 @@ func (s *LocalSegment) LoadIndex(indexInfo *querypb.FieldIndexInfo, fieldType sc
 		return err
 	}
 
-	err = loadIndexInfo.appendLoadIndexInfo(indexInfo, s.collectionID, s.partitionID, s.segmentID, fieldType)
+	err = loadIndexInfo.appendLoadIndexInfo(indexInfo, s.collectionID, s.partitionID, s.segmentID, fieldType, s.mmapDirPath)
 	if err != nil {
 		if loadIndexInfo.cleanLocalData() != nil {
 			log.Warn("failed to clean cached data on disk after append index failed",
@@ -903,6 +1008,10 @@ func (s *LocalSegment) Release() {
 	}
 
 	C.DeleteSegment(ptr)
+	GetFilterBitsetCache().Invalidate(s.ID())
+	if s.stringPoolEnabled {
+		updateStringPoolMetrics()
+	}
 	log.Info("delete segment from memory",
 		zap.Int64("collectionID", s.collectionID),
 		zap.Int64("partitionID", s.partitionID),
@@ -18,6 +18,7 @@ package segments
 
 import (
 	"context"
+	"time"
 
 	"github.com/milvus-io/milvus-proto/go-api/v2/msgpb"
 	"github.com/milvus-io/milvus/internal/proto/segcorepb"
@@ -44,6 +45,10 @@ type Segment interface {
 	// RowNum returns the number of rows, it's slow, so DO NOT call it in a loop
 	RowNum() int64
 	MemSize() int64
+	// RecordAccess records a search or query on the segment with its latency
+	RecordAccess(label string, latency time.Duration)
+	// AccessStats returns the access statistics of the segment since it's loaded
+	AccessStats() AccessStats
 
 	// Index related
 	AddIndex(fieldID int64, index *IndexedFieldInfo)
@@ -39,6 +39,10 @@ import (
 
 	"github.com/cockroachdb/errors"
 	"github.com/samber/lo"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/trace"
 	"go.uber.org/atomic"
 	"go.uber.org/zap"
 	"golang.org/x/sync/errgroup"
@@ -258,6 +262,9 @@ func (loader *segmentLoader) Load(ctx context.Context,
 		loaded.Insert(segmentID, segment)
 		log.Info("load segment done", zap.Int64("segmentID", segmentID))
 		loader.notifyLoadFinish(loadInfo)
+		if segmentType == SegmentTypeSealed {
+			loader.warmup(segmentID)
+		}
 
 		metrics.QueryNodeLoadSegmentLatency.WithLabelValues(fmt.Sprint(paramtable.GetNodeID())).Observe(tr.ElapseSpan().Seconds())
 		return nil
@@ -290,6 +297,21 @@ func (loader *segmentLoader) Load(ctx context.Context,
 	return result, nil
 }
 
+// warmup warms up the mmapped pages of loaded sealed segment in background if enabled.
+func (loader *segmentLoader) warmup(segmentID int64) {
+	params := paramtable.Get()
+	if !params.QueryNodeCfg.MmapWarmupAuto.GetAsBool() || len(params.QueryNodeCfg.MmapDirPath.GetValue()) == 0 {
+		return
+	}
+	GetWarmupPool().Submit(func() (any, error) {
+		if _, err := WarmupSegment(context.Background(), segmentID); err != nil {
+			log.Warn("failed to warm up segment", zap.Int64("segmentID", segmentID), zap.Error(err))
+			return nil, err
+		}
+		return nil, nil
+	})
+}
+
 func (loader *segmentLoader) prepare(segmentType SegmentType, version int64, segments ...*querypb.SegmentLoadInfo) []*querypb.SegmentLoadInfo {
 	loader.mut.Lock()
 	defer loader.mut.Unlock()
@@ -553,12 +575,19 @@ func (loader *segmentLoader) loadSegment(ctx context.Context,
 		return err
 	}
 	pkField := GetPkField(collection.Schema())
+	var delayedFieldBinlogs []*datapb.FieldBinlog
 
 	// TODO(xige-16): Optimize the data loading process and reduce data copying
 	// for now, there will be multiple copies in the process of data loading into segCore
 	defer debug.FreeOSMemory()
 
 	if segment.Type() == SegmentTypeSealed {
+		if paramtable.Get().QueryNodeCfg.VerifyBinlogChecksum.GetAsBool() {
+			if err := loader.verifyFieldBinlogs(ctx, loadInfo.GetBinlogPaths()); err != nil {
+				return err
+			}
+		}
+
 		fieldID2IndexInfo := make(map[int64]*querypb.FieldIndexInfo)
 		for _, indexInfo := range loadInfo.IndexInfos {
 			if len(indexInfo.GetIndexFilePaths()) > 0 {
@@ -584,13 +613,23 @@ func (loader *segmentLoader) loadSegment(ctx context.Context,
 			}
 		}
 
+		// the segment is serviceable before the scalar fields loaded if delayed
+		if collection.IsScalarFieldsDelayed() {
+			fieldBinlogs, delayedFieldBinlogs = splitDelayedFields(collection.Schema(), fieldBinlogs)
+		}
+
 		log.Info("load fields...",
 			zap.Int64s("indexedFields", lo.Keys(indexedFieldInfos)),
+			zap.Int("delayedFieldNum", len(delayedFieldBinlogs)),
 		)
-		if err := loader.loadFieldsIndex(ctx, collection.Schema(), segment, loadInfo.GetNumOfRows(), indexedFieldInfos); err != nil {
+		if err := traceLoadStage(ctx, segment, "IndexLoad", func(ctx context.Context) error {
+			return loader.loadFieldsIndex(ctx, collection.Schema(), segment, loadInfo.GetNumOfRows(), indexedFieldInfos)
+		}); err != nil {
 			return err
 		}
-		if err := loader.loadSealedSegmentFields(ctx, segment, fieldBinlogs, loadInfo.GetNumOfRows()); err != nil {
+		if err := traceLoadStage(ctx, segment, "BinlogDownload", func(ctx context.Context) error {
+			return loader.loadSealedSegmentFields(ctx, segment, fieldBinlogs, loadInfo.GetNumOfRows())
+		}); err != nil {
 			return err
 		}
 		if err := segment.AddFieldDataInfo(loadInfo.GetNumOfRows(), loadInfo.GetBinlogPaths()); err != nil {
@@ -618,27 +657,44 @@ func (loader *segmentLoader) loadSegment(ctx context.Context,
 	}
 
 	log.Info("loading delta...")
-	return loader.LoadDeltaLogs(ctx, segment, loadInfo.Deltalogs)
+	if err := traceLoadStage(ctx, segment, "DeltaApply", func(ctx context.Context) error {
+		return loader.LoadDeltaLogs(ctx, segment, loadInfo.Deltalogs)
+	}); err != nil {
+		return err
+	}
+
+	if len(delayedFieldBinlogs) > 0 {
+		loader.loadDelayedFields(segment, delayedFieldBinlogs, loadInfo.GetNumOfRows())
+	}
+	return nil
+}
+
+// traceLoadStage runs the stage of loading the segment in a span,
+// which is linked to the load task of QueryCoord through the context.
+func traceLoadStage(ctx context.Context, segment *LocalSegment, stage string, fn func(ctx context.Context) error) error {
+	ctx, span := otel.Tracer(typeutil.QueryNodeRole).Start(ctx, "QueryNode-"+stage, trace.WithAttributes(
+		attribute.Int64("collectionID", segment.Collection()),
+		attribute.Int64("segmentID", segment.ID()),
+	))
+	defer span.End()
+
+	err := fn(ctx)
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+	}
+	return err
 }
 
-func (loader *segmentLoader) filterPKStatsBinlogs(fieldBinlogs []*datapb.FieldBinlog, pkFieldID int64) ([]string, storage.StatsLogType) {
-	result := make([]string, 0)
+func (loader *segmentLoader) filterPKStatsBinlogs(fieldBinlogs []*datapb.FieldBinlog, pkFieldID int64) ([]*datapb.Binlog, storage.StatsLogType) {
+	result := make([]*datapb.Binlog, 0)
 	for _, fieldBinlog := range fieldBinlogs {
 		if fieldBinlog.FieldID == pkFieldID {
-			for _, binlog := range fieldBinlog.GetBinlogs() {
-				_, logidx := path.Split(binlog.GetLogPath())
-				// if special status log exist
-				// only load one file
-				switch logidx {
-				case storage.CompoundStatsType.LogIdx():
-					return []string{binlog.GetLogPath()}, storage.CompoundStatsType
-				default:
-					result = append(result, binlog.GetLogPath())
-				}
-			}
+			result = append(result, fieldBinlog.GetBinlogs()...)
 		}
 	}
-	return result, storage.DefaultStatsType
+	// if compound stats log exist, only load it and the ones synced after it
+	return storage.SelectPkStatsLogs(result)
 }
 
 func (loader *segmentLoader) loadSealedSegmentFields(ctx context.Context, segment *LocalSegment, fields []*datapb.FieldBinlog, rowCount int64) error {
@@ -725,39 +781,34 @@ func (loader *segmentLoader) loadFieldIndex(ctx context.Context, segment *LocalS
 }
 
 func (loader *segmentLoader) loadBloomFilter(ctx context.Context, segmentID int64, bfs *pkoracle.BloomFilterSet,
-	binlogPaths []string, logType storage.StatsLogType,
+	binlogs []*datapb.Binlog, logType storage.StatsLogType,
 ) error {
 	log := log.Ctx(ctx).With(
 		zap.Int64("segmentID", segmentID),
 	)
-	if len(binlogPaths) == 0 {
+	if len(binlogs) == 0 {
 		log.Info("there are no stats logs saved with segment")
 		return nil
 	}
 
 	startTs := time.Now()
+	binlogPaths := lo.Map(binlogs, func(binlog *datapb.Binlog, _ int) string { return binlog.GetLogPath() })
 	values, err := loader.cm.MultiRead(ctx, binlogPaths)
 	if err != nil {
 		return err
 	}
 	blobs := []*storage.Blob{}
 	for i := 0; i < len(values); i++ {
+		if err := verifyBinlogChecksum(binlogs[i], values[i]); err != nil {
+			return err
+		}
 		blobs = append(blobs, &storage.Blob{Value: values[i]})
 	}
 
-	var stats []*storage.PrimaryKeyStats
-	if logType == storage.CompoundStatsType {
-		stats, err = storage.DeserializeStatsList(blobs[0])
-		if err != nil {
-			log.Warn("failed to deserialize stats list", zap.Error(err))
-			return err
-		}
-	} else {
-		stats, err = storage.DeserializeStats(blobs)
-		if err != nil {
-			log.Warn("failed to deserialize stats", zap.Error(err))
-			return err
-		}
+	stats, err := storage.DeserializePkStatsLogs(blobs, logType)
+	if err != nil {
+		log.Warn("failed to deserialize stats", zap.Int("logType", int(logType)), zap.Error(err))
+		return err
 	}
 
 	var size uint
@@ -788,6 +839,9 @@ func (loader *segmentLoader) LoadDeltaLogs(ctx context.Context, segment *LocalSe
 			if err != nil {
 				return err
 			}
+			if err := verifyBinlogChecksum(bLog, value); err != nil {
+				return err
+			}
 			blob := &storage.Blob{
 				Key:   bLog.GetLogPath(),
 				Value: value,
@@ -811,6 +865,38 @@ func (loader *segmentLoader) LoadDeltaLogs(ctx context.Context, segment *LocalSe
 	return nil
 }
 
+// verifyFieldBinlogs reads the field binlogs with checksum recorded and verifies them.
+func (loader *segmentLoader) verifyFieldBinlogs(ctx context.Context, fieldBinlogs []*datapb.FieldBinlog) error {
+	runningGroup, ctx := errgroup.WithContext(ctx)
+	runningGroup.SetLimit(runtime.GOMAXPROCS(0))
+	for _, fieldBinlog := range fieldBinlogs {
+		for _, binlog := range fieldBinlog.GetBinlogs() {
+			if binlog.GetChecksum() == "" {
+				continue
+			}
+			binlog := binlog
+			runningGroup.Go(func() error {
+				value, err := loader.cm.Read(ctx, binlog.GetLogPath())
+				if err != nil {
+					return err
+				}
+				return verifyBinlogChecksum(binlog, value)
+			})
+		}
+	}
+	return runningGroup.Wait()
+}
+
+// verifyBinlogChecksum verifies the binlog content read from object storage against the checksum in segment meta.
+func verifyBinlogChecksum(binlog *datapb.Binlog, value []byte) error {
+	err := storage.VerifyBinlogChecksum(binlog, value)
+	if err != nil {
+		metrics.PersistentDataChecksumMismatchCounter.WithLabelValues(typeutil.QueryNodeRole).Inc()
+		log.Warn("binlog corrupted", zap.String("path", binlog.GetLogPath()), zap.Error(err))
+	}
+	return err
+}
+
 func (loader *segmentLoader) patchEntryNumber(ctx context.Context, segment *LocalSegment, loadInfo *querypb.SegmentLoadInfo) error {
 	var needReset bool
 
@@ -930,6 +1016,13 @@ func (loader *segmentLoader) checkSegmentSize(ctx context.Context, segmentLoadIn
 	diskUsage := uint64(localDiskUsage) + loader.committedResource.DiskSize
 
 	mmapEnabled := len(paramtable.Get().QueryNodeCfg.MmapDirPath.GetValue()) > 0
+	vectorQuantization := ""
+	var schema *schemapb.CollectionSchema
+	if collection := loader.manager.Collection.Get(segmentLoadInfos[0].GetCollectionID()); collection != nil {
+		mmapEnabled = len(collection.MmapDirPath()) > 0
+		vectorQuantization = collection.GetVectorQuantization()
+		schema = collection.Schema()
+	}
 	maxSegmentSize := uint64(0)
 	predictMemUsage := memUsage
 	predictDiskUsage := diskUsage
@@ -966,7 +1059,7 @@ func (loader *segmentLoader) checkSegmentSize(ctx context.Context, segmentLoadIn
 				if mmapEnabled {
 					predictDiskUsage += uint64(getBinlogDataSize(fieldBinlog))
 				} else {
-					predictMemUsage += uint64(getBinlogDataSize(fieldBinlog))
+					predictMemUsage += uint64(getFieldDataMemSize(schema, fieldBinlog, vectorQuantization))
 				}
 			}
 		}
@@ -1109,6 +1202,30 @@ func getBinlogDataSize(fieldBinlog *datapb.FieldBinlog) int64 {
 	return fieldSize
 }
 
+// getFieldDataMemSize returns the memory size of the loaded field data,
+// the float vectors quantized at load time take less memory than the binlogs.
+func getFieldDataMemSize(schema *schemapb.CollectionSchema, fieldBinlog *datapb.FieldBinlog, vectorQuantization string) int64 {
+	field := typeutil.GetField(schema, fieldBinlog.GetFieldID())
+	if vectorQuantization == "" || field.GetDataType() != schemapb.DataType_FloatVector {
+		return getBinlogDataSize(fieldBinlog)
+	}
+	dim, err := typeutil.GetDim(field)
+	if err != nil {
+		return getBinlogDataSize(fieldBinlog)
+	}
+
+	// FP16 takes 2 bytes per dimension, SQ8 takes 1 byte per dimension plus the min and scale of float32
+	codeSize := dim * 2
+	if vectorQuantization == common.VectorQuantizationSQ8 {
+		codeSize = dim + 8
+	}
+	rowNum := int64(0)
+	for _, binlog := range fieldBinlog.GetBinlogs() {
+		rowNum += binlog.GetEntriesNum()
+	}
+	return rowNum * codeSize
+}
+
 func getIndexEngineVersion() (minimal, current int32) {
 	cMinimal, cCurrent := C.GetMinimalIndexVersion(), C.GetCurrentIndexVersion()
 	return int32(cMinimal), int32(cCurrent)
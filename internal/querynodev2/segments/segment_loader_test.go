@@ -22,14 +22,17 @@ import (
 	"testing"
 	"time"
 
+	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/suite"
 
 	"github.com/milvus-io/milvus-proto/go-api/v2/commonpb"
 	"github.com/milvus-io/milvus-proto/go-api/v2/schemapb"
+	"github.com/milvus-io/milvus/internal/proto/datapb"
 	"github.com/milvus-io/milvus/internal/proto/querypb"
 	"github.com/milvus-io/milvus/internal/storage"
 	"github.com/milvus-io/milvus/internal/util/initcore"
+	"github.com/milvus-io/milvus/pkg/common"
 	"github.com/milvus-io/milvus/pkg/util/funcutil"
 	"github.com/milvus-io/milvus/pkg/util/merr"
 	"github.com/milvus-io/milvus/pkg/util/metric"
@@ -355,6 +358,62 @@ func (suite *SegmentLoaderSuite) TestLoadDeltaLogs() {
 	}
 }
 
+func (suite *SegmentLoaderSuite) TestLoadCorruptedBinlogs() {
+	ctx := context.Background()
+	msgLength := 100
+
+	newLoadInfo := func(segmentID int64) *querypb.SegmentLoadInfo {
+		binlogs, statsLogs, err := SaveBinLog(ctx,
+			suite.collectionID,
+			suite.partitionID,
+			segmentID,
+			msgLength,
+			suite.schema,
+			suite.chunkManager,
+		)
+		suite.NoError(err)
+		deltaLogs, err := SaveDeltaLog(suite.collectionID,
+			suite.partitionID,
+			segmentID,
+			suite.chunkManager,
+		)
+		suite.NoError(err)
+		return &querypb.SegmentLoadInfo{
+			SegmentID:    segmentID,
+			PartitionID:  suite.partitionID,
+			CollectionID: suite.collectionID,
+			BinlogPaths:  binlogs,
+			Statslogs:    statsLogs,
+			Deltalogs:    deltaLogs,
+			NumOfRows:    int64(msgLength),
+		}
+	}
+
+	// corrupted delta log
+	loadInfo := newLoadInfo(suite.segmentID)
+	loadInfo.Deltalogs[0].Binlogs[0].Checksum = "00000000"
+	_, err := suite.loader.Load(ctx, suite.collectionID, SegmentTypeSealed, 0, loadInfo)
+	suite.ErrorIs(err, merr.ErrIoChecksum)
+
+	// corrupted stats log
+	loadInfo = newLoadInfo(suite.segmentID + 1)
+	loadInfo.Statslogs[0].Binlogs[0].Checksum = "00000000"
+	_, err = suite.loader.Load(ctx, suite.collectionID, SegmentTypeGrowing, 0, loadInfo)
+	suite.ErrorIs(err, merr.ErrIoChecksum)
+
+	// corrupted insert binlog, verified only if enabled
+	paramtable.Get().Save(paramtable.Get().QueryNodeCfg.VerifyBinlogChecksum.Key, "true")
+	defer paramtable.Get().Reset(paramtable.Get().QueryNodeCfg.VerifyBinlogChecksum.Key)
+	loadInfo = newLoadInfo(suite.segmentID + 2)
+	loadInfo.BinlogPaths[0].Binlogs[0].Checksum = "00000000"
+	_, err = suite.loader.Load(ctx, suite.collectionID, SegmentTypeSealed, 0, loadInfo)
+	suite.ErrorIs(err, merr.ErrIoChecksum)
+
+	loadInfo = newLoadInfo(suite.segmentID + 3)
+	_, err = suite.loader.Load(ctx, suite.collectionID, SegmentTypeSealed, 0, loadInfo)
+	suite.NoError(err)
+}
+
 func (suite *SegmentLoaderSuite) TestLoadDupDeltaLogs() {
 	ctx := context.Background()
 	loadInfos := make([]*querypb.SegmentLoadInfo, 0, suite.segmentNum)
@@ -728,3 +787,22 @@ func TestSegmentLoader(t *testing.T) {
 	suite.Run(t, &SegmentLoaderSuite{})
 	suite.Run(t, &SegmentLoaderDetailSuite{})
 }
+
+func TestGetFieldDataMemSize(t *testing.T) {
+	schema := &schemapb.CollectionSchema{
+		Fields: []*schemapb.FieldSchema{
+			{FieldID: 100, DataType: schemapb.DataType_Int64},
+			{FieldID: 101, DataType: schemapb.DataType_FloatVector, TypeParams: []*commonpb.KeyValuePair{{Key: common.DimKey, Value: "128"}}},
+		},
+	}
+	vectorBinlog := &datapb.FieldBinlog{FieldID: 101, Binlogs: []*datapb.Binlog{
+		{EntriesNum: 600, LogSize: 600 * 512}, {EntriesNum: 400, LogSize: 400 * 512},
+	}}
+	scalarBinlog := &datapb.FieldBinlog{FieldID: 100, Binlogs: []*datapb.Binlog{{EntriesNum: 1000, LogSize: 8000}}}
+
+	assert.EqualValues(t, 1000*512, getFieldDataMemSize(schema, vectorBinlog, ""))
+	assert.EqualValues(t, 1000*256, getFieldDataMemSize(schema, vectorBinlog, common.VectorQuantizationFP16))
+	assert.EqualValues(t, 1000*136, getFieldDataMemSize(schema, vectorBinlog, common.VectorQuantizationSQ8))
+	assert.EqualValues(t, 8000, getFieldDataMemSize(schema, scalarBinlog, common.VectorQuantizationSQ8))
+	assert.EqualValues(t, 1000*512, getFieldDataMemSize(nil, vectorBinlog, common.VectorQuantizationSQ8))
+}
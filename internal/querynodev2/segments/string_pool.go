@@ -0,0 +1,62 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package segments
+
+/*
+#cgo pkg-config: milvus_segcore
+
+#include "segcore/metrics_c.h"
+*/
+import "C"
+
+import (
+	"fmt"
+
+	"github.com/milvus-io/milvus/pkg/metrics"
+	"github.com/milvus-io/milvus/pkg/util/paramtable"
+)
+
+// StringPoolStats is the stats of the node level string pool,
+// which is shared by the string fields of the sealed segments loaded with the pool enabled.
+type StringPoolStats struct {
+	UniqueNum   int64
+	PooledBytes int64
+	// the size of the strings referencing the pool, as if they are not shared
+	ReferencedBytes int64
+}
+
+// SavedBytes returns the memory saved by sharing the identical strings.
+func (s StringPoolStats) SavedBytes() int64 {
+	return s.ReferencedBytes - s.PooledBytes
+}
+
+func GetStringPoolStats() StringPoolStats {
+	stats := C.GetStringPoolStats()
+	return StringPoolStats{
+		UniqueNum:       int64(stats.unique_num),
+		PooledBytes:     int64(stats.pooled_bytes),
+		ReferencedBytes: int64(stats.referenced_bytes),
+	}
+}
+
+func updateStringPoolMetrics() {
+	stats := GetStringPoolStats()
+	nodeID := fmt.Sprint(paramtable.GetNodeID())
+	metrics.QueryNodeStringPoolUniqueNum.WithLabelValues(nodeID).Set(float64(stats.UniqueNum))
+	metrics.QueryNodeStringPoolBytes.WithLabelValues(nodeID).Set(float64(stats.PooledBytes))
+	metrics.QueryNodeStringPoolSavedBytes.WithLabelValues(nodeID).Set(float64(stats.SavedBytes()))
+}
@@ -0,0 +1,170 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package segments
+
+import (
+	"bufio"
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/cockroachdb/errors"
+	"go.uber.org/zap"
+
+	"github.com/milvus-io/milvus/pkg/log"
+	"github.com/milvus-io/milvus/pkg/metrics"
+	"github.com/milvus-io/milvus/pkg/util/merr"
+	"github.com/milvus-io/milvus/pkg/util/paramtable"
+	"github.com/milvus-io/milvus/pkg/util/timerecord"
+)
+
+const (
+	procMapsPath = "/proc/self/maps"
+	procMemPath  = "/proc/self/mem"
+
+	// the size of each read while touching the mmapped pages
+	warmupChunkSize = 1024 * 1024
+)
+
+// mmapRegion is an address range of the process mapped from the file under mmap dir.
+type mmapRegion struct {
+	start uint64
+	end   uint64
+	path  string
+}
+
+// WarmupSegment touches the mmapped index and field data pages of the sealed segment sequentially,
+// so the first queries on the segment don't suffer from the page faults.
+// The mmap files are unlinked by segcore after mapped, so the pages are touched through the memory of process,
+// at the max rate of queryNode.mmapWarmup.maxRate. Returns the bytes touched.
+func WarmupSegment(ctx context.Context, segmentID int64) (int64, error) {
+	mmapDirPath := paramtable.Get().QueryNodeCfg.MmapDirPath.GetValue()
+	if len(mmapDirPath) == 0 {
+		return 0, merr.WrapErrServiceUnavailable("mmap not enabled", "warmup segment")
+	}
+	dir, err := filepath.Abs(filepath.Join(mmapDirPath, strconv.FormatInt(segmentID, 10)))
+	if err != nil {
+		return 0, err
+	}
+
+	tr := timerecord.NewTimeRecorder("warmupSegment")
+	regions, err := readMmapRegions(procMapsPath, dir)
+	if err != nil {
+		return 0, err
+	}
+	rate := paramtable.Get().QueryNodeCfg.MmapWarmupMaxRate.GetAsInt64() * 1024 * 1024
+	touched, err := touchRegions(ctx, procMemPath, regions, rate)
+
+	nodeID := fmt.Sprint(paramtable.GetNodeID())
+	metrics.QueryNodeSegmentWarmupBytes.WithLabelValues(nodeID).Add(float64(touched))
+	if err != nil {
+		return touched, err
+	}
+	metrics.QueryNodeSegmentWarmupLatency.WithLabelValues(nodeID).Observe(tr.ElapseSpan().Seconds())
+	log.Ctx(ctx).Info("segment warmed up", zap.Int64("segmentID", segmentID),
+		zap.Int("regions", len(regions)),
+		zap.Int64("bytes", touched),
+		zap.Duration("duration", tr.ElapseSpan()))
+	return touched, nil
+}
+
+// readMmapRegions returns the regions mapped from the files under dir, parsed from the maps file of process.
+func readMmapRegions(mapsPath string, dir string) ([]mmapRegion, error) {
+	f, err := os.Open(mapsPath)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to read memory mappings")
+	}
+	defer f.Close()
+
+	prefix := dir + string(filepath.Separator)
+	regions := make([]mmapRegion, 0)
+	scanner := bufio.NewScanner(f)
+	for scanner.Scan() {
+		// address perms offset dev inode pathname, the pathname ends with " (deleted)" after the file unlinked
+		fields := strings.Fields(scanner.Text())
+		if len(fields) < 6 {
+			continue
+		}
+		path := strings.TrimSuffix(strings.Join(fields[5:], " "), " (deleted)")
+		if !strings.HasPrefix(path, prefix) {
+			continue
+		}
+		start, end, ok := strings.Cut(fields[0], "-")
+		if !ok {
+			continue
+		}
+		region := mmapRegion{path: path}
+		if region.start, err = strconv.ParseUint(start, 16, 64); err != nil {
+			continue
+		}
+		if region.end, err = strconv.ParseUint(end, 16, 64); err != nil {
+			continue
+		}
+		regions = append(regions, region)
+	}
+	return regions, scanner.Err()
+}
+
+// touchRegions reads the regions sequentially through the memory file of process, which faults in the pages,
+// the reads are throttled at rate bytes per second if rate is positive.
+func touchRegions(ctx context.Context, memPath string, regions []mmapRegion, rate int64) (int64, error) {
+	if len(regions) == 0 {
+		return 0, nil
+	}
+	mem, err := os.Open(memPath)
+	if err != nil {
+		return 0, errors.Wrap(err, "failed to open process memory")
+	}
+	defer mem.Close()
+
+	buf := make([]byte, warmupChunkSize)
+	start := time.Now()
+	var touched int64
+	for _, region := range regions {
+		for offset := region.start; offset < region.end; offset += warmupChunkSize {
+			if err := ctx.Err(); err != nil {
+				return touched, err
+			}
+			size := region.end - offset
+			if size > warmupChunkSize {
+				size = warmupChunkSize
+			}
+			// the region may be unmapped after the segment released
+			if _, err := mem.ReadAt(buf[:size], int64(offset)); err != nil {
+				return touched, errors.Wrapf(err, "failed to touch mmapped file %s", region.path)
+			}
+			touched += int64(size)
+
+			if rate <= 0 {
+				continue
+			}
+			expected := time.Duration(float64(touched) / float64(rate) * float64(time.Second))
+			if wait := expected - time.Since(start); wait > 0 {
+				select {
+				case <-ctx.Done():
+					return touched, ctx.Err()
+				case <-time.After(wait):
+				}
+			}
+		}
+	}
+	return touched, nil
+}
@@ -0,0 +1,111 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package segments
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"syscall"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/milvus-io/milvus/pkg/util/merr"
+	"github.com/milvus-io/milvus/pkg/util/paramtable"
+)
+
+func TestWarmupSegment(t *testing.T) {
+	if _, err := os.Stat(procMapsPath); err != nil {
+		t.Skip("memory mappings of process not available")
+	}
+	paramtable.Init()
+	params := paramtable.Get()
+
+	t.Run("mmap not enabled", func(t *testing.T) {
+		params.Save(params.QueryNodeCfg.MmapDirPath.Key, "")
+		defer params.Reset(params.QueryNodeCfg.MmapDirPath.Key)
+		_, err := WarmupSegment(context.Background(), 100)
+		assert.ErrorIs(t, err, merr.ErrServiceUnavailable)
+	})
+
+	// map the field data like segcore, {mmap_dir_path}/{segment_id}/{field_id}, unlinked after mapped
+	mmapDir := t.TempDir()
+	require.NoError(t, os.MkdirAll(filepath.Join(mmapDir, "100"), 0o755))
+	size := 3*warmupChunkSize + 4096
+	filePath := filepath.Join(mmapDir, "100", "101")
+	require.NoError(t, os.WriteFile(filePath, make([]byte, size), 0o600))
+	f, err := os.Open(filePath)
+	require.NoError(t, err)
+	data, err := syscall.Mmap(int(f.Fd()), 0, size, syscall.PROT_READ, syscall.MAP_SHARED)
+	require.NoError(t, err)
+	defer syscall.Munmap(data)
+	f.Close()
+	require.NoError(t, os.Remove(filePath))
+
+	params.Save(params.QueryNodeCfg.MmapDirPath.Key, mmapDir)
+	defer params.Reset(params.QueryNodeCfg.MmapDirPath.Key)
+
+	t.Run("read regions", func(t *testing.T) {
+		regions, err := readMmapRegions(procMapsPath, filepath.Join(mmapDir, "100"))
+		assert.NoError(t, err)
+		assert.Len(t, regions, 1)
+		assert.Equal(t, filePath, regions[0].path)
+		assert.EqualValues(t, size, regions[0].end-regions[0].start)
+
+		regions, err = readMmapRegions(procMapsPath, filepath.Join(mmapDir, "10"))
+		assert.NoError(t, err)
+		assert.Empty(t, regions)
+	})
+
+	t.Run("warmup", func(t *testing.T) {
+		params.Save(params.QueryNodeCfg.MmapWarmupMaxRate.Key, "0")
+		defer params.Reset(params.QueryNodeCfg.MmapWarmupMaxRate.Key)
+		touched, err := WarmupSegment(context.Background(), 100)
+		assert.NoError(t, err)
+		assert.EqualValues(t, size, touched)
+
+		touched, err = WarmupSegment(context.Background(), 200)
+		assert.NoError(t, err)
+		assert.EqualValues(t, 0, touched)
+	})
+
+	t.Run("throttled", func(t *testing.T) {
+		regions, err := readMmapRegions(procMapsPath, filepath.Join(mmapDir, "100"))
+		require.NoError(t, err)
+
+		// 4 chunks at 40 chunks per second
+		start := time.Now()
+		touched, err := touchRegions(context.Background(), procMemPath, regions, 40*warmupChunkSize)
+		assert.NoError(t, err)
+		assert.EqualValues(t, size, touched)
+		assert.GreaterOrEqual(t, time.Since(start), 70*time.Millisecond)
+
+		ctx, cancel := context.WithCancel(context.Background())
+		cancel()
+		touched, err = touchRegions(ctx, procMemPath, regions, 40*warmupChunkSize)
+		assert.ErrorIs(t, err, context.Canceled)
+		assert.EqualValues(t, 0, touched)
+	})
+
+	t.Run("region unmapped", func(t *testing.T) {
+		_, err := touchRegions(context.Background(), procMemPath, []mmapRegion{{start: 0, end: 4096, path: filePath}}, 0)
+		assert.Error(t, err)
+	})
+}
@@ -202,6 +202,11 @@ func (node *QueryNode) InitSegcore() error {
 	nprobe := C.int64_t(paramtable.Get().QueryNodeCfg.GrowingIndexNProbe.GetAsInt64())
 	C.SegcoreSetNprobe(nprobe)
 
+	C.SegcoreSetFilterPlanner(C.bool(paramtable.Get().QueryNodeCfg.FilterPlannerEnabled.GetAsBool()),
+		C.float(paramtable.Get().QueryNodeCfg.FilterPlannerBruteForceRatio.GetAsFloat()),
+		C.float(paramtable.Get().QueryNodeCfg.FilterPlannerPostFilterRatio.GetAsFloat()),
+		C.float(paramtable.Get().QueryNodeCfg.FilterPlannerPostFilterAmplification.GetAsFloat()))
+
 	// override segcore SIMD type
 	cSimdType := C.CString(paramtable.Get().CommonCfg.SimdType.GetValue())
 	C.SegcoreSetSimdType(cSimdType)
@@ -395,6 +400,9 @@ func (node *QueryNode) Start() error {
 		mmapDirPath := paramtable.Get().QueryNodeCfg.MmapDirPath.GetValue()
 		mmapEnabled := len(mmapDirPath) > 0
 		node.UpdateStateCode(commonpb.StateCode_Healthy)
+		registerWarmupHandler(node.manager)
+		registerSegmentAccessStatsHandler(node.manager)
+		registerStoppingHandler(node)
 
 		registry.GetInMemoryResolver().RegisterQueryNode(paramtable.GetNodeID(), node)
 		log.Info("query node start successfully",
@@ -411,7 +419,7 @@ func (node *QueryNode) Start() error {
 func (node *QueryNode) Stop() error {
 	node.stopOnce.Do(func() {
 		log.Info("Query node stop...")
-		err := node.session.GoingStop()
+		err := node.GoingStop()
 		if err != nil {
 			log.Warn("session fail to go stopping state", zap.Error(err))
 		} else {
@@ -475,6 +483,13 @@ func (node *QueryNode) Stop() error {
 	return nil
 }
 
+// GoingStop reports the query node is going to stop, QueryCoord excludes the stopping node from new assignments
+// and moves its shard leaders and segments to other nodes. Besides Stop, it could be triggered by the preStop hook,
+// to migrate the data before the process receives SIGTERM.
+func (node *QueryNode) GoingStop() error {
+	return node.session.GoingStop()
+}
+
 // UpdateStateCode updata the state of query node, which can be initializing, healthy, and abnormal
 func (node *QueryNode) UpdateStateCode(code commonpb.StateCode) {
 	node.lifetime.SetState(code)
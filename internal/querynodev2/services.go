@@ -451,6 +451,12 @@ func (node *QueryNode) LoadSegments(ctx context.Context, req *querypb.LoadSegmen
 	node.manager.Collection.PutOrRef(req.GetCollectionID(), req.GetSchema(),
 		node.composeIndexMeta(req.GetIndexInfoList(), req.GetSchema()), req.GetLoadMeta())
 	defer node.manager.Collection.Unref(req.GetCollectionID(), 1)
+	if collection := node.manager.Collection.Get(req.GetCollectionID()); collection != nil {
+		collection.SetStringPoolEnabled(common.IsCollectionStringPoolEnabled(req.GetBase().GetProperties()))
+		collection.SetScalarFieldsDelayed(common.IsCollectionServiceableEarly(req.GetBase().GetProperties()))
+		collection.SetMmapDisabled(common.IsCollectionMmapDisabled(req.GetBase().GetProperties()))
+		collection.SetVectorQuantization(common.GetCollectionVectorQuantization(req.GetBase().GetProperties()))
+	}
 
 	// Actual load segment
 	log.Info("start to load segments...")
@@ -798,7 +804,8 @@ func (node *QueryNode) Search(ctx context.Context, req *querypb.SearchRequest) (
 	}
 
 	tr.RecordSpan()
-	result, err := segments.ReduceSearchResults(ctx, toReduceResults, req.Req.GetNq(), req.Req.GetTopk(), req.Req.GetMetricType())
+	result, err := segments.ReduceSearchResults(ctx, toReduceResults, req.Req.GetNq(), req.Req.GetTopk(), req.Req.GetMetricType(),
+		req.Req.GetGroupByFieldId(), req.Req.GetGroupSize())
 	if err != nil {
 		log.Warn("failed to reduce search results", zap.Error(err))
 		failRet.Status = merr.Status(err)
@@ -1185,6 +1192,10 @@ func (node *QueryNode) GetMetrics(ctx context.Context, req *milvuspb.GetMetricsR
 		return queryNodeMetrics, nil
 	}
 
+	if metricType == metricsinfo.SegmentAccessStatsMetrics {
+		return getSegmentAccessStatsMetrics(node)
+	}
+
 	log.Debug("QueryNode.GetMetrics failed, request metric type is not implemented yet",
 		zap.Int64("nodeID", paramtable.GetNodeID()),
 		zap.String("req", req.Request),
@@ -1268,12 +1279,16 @@ func (node *QueryNode) GetDataDistribution(ctx context.Context, req *querypb.Get
 			growingSegments[entry.SegmentID] = segment.StartPosition()
 		}
 
+		stats := delegator.GetLoadStats()
 		leaderViews = append(leaderViews, &querypb.LeaderView{
-			Collection:      delegator.Collection(),
-			Channel:         key,
-			SegmentDist:     sealedSegments,
-			GrowingSegments: growingSegments,
-			TargetVersion:   delegator.GetTargetVersion(),
+			Collection:            delegator.Collection(),
+			Channel:               key,
+			SegmentDist:           sealedSegments,
+			GrowingSegments:       growingSegments,
+			TargetVersion:         delegator.GetTargetVersion(),
+			NumOfGrowingRows:      stats.NumOfGrowingRows,
+			NumOfDeleteBufferRows: stats.NumOfDeleteBufferRows,
+			DeleteForwardRate:     stats.DeleteForwardRate,
 		})
 		return true
 	})
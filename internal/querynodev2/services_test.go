@@ -44,6 +44,7 @@ import (
 	"github.com/milvus-io/milvus/internal/storage"
 	"github.com/milvus-io/milvus/internal/util/dependency"
 	"github.com/milvus-io/milvus/internal/util/streamrpc"
+	"github.com/milvus-io/milvus/pkg/common"
 	"github.com/milvus-io/milvus/pkg/mq/msgstream"
 	"github.com/milvus-io/milvus/pkg/util/conc"
 	"github.com/milvus-io/milvus/pkg/util/etcd"
@@ -577,6 +578,33 @@ func (suite *ServiceSuite) TestLoadSegments_VarChar() {
 	}
 }
 
+func (suite *ServiceSuite) TestLoadSegments_StringPool() {
+	ctx := context.Background()
+	suite.TestWatchDmChannelsVarchar()
+	// data
+	schema := segments.GenTestCollectionSchema(suite.collectionName, schemapb.DataType_VarChar)
+	infos := suite.genSegmentLoadInfos(schema)
+	req := &querypb.LoadSegmentsRequest{
+		Base: &commonpb.MsgBase{
+			MsgID:      rand.Int63(),
+			TargetID:   suite.node.session.ServerID,
+			Properties: map[string]string{common.CollectionStringPoolKey: "true"},
+		},
+		CollectionID:   suite.collectionID,
+		DstNodeID:      suite.node.session.ServerID,
+		Infos:          infos[:1],
+		Schema:         schema,
+		DeltaPositions: []*msgpb.MsgPosition{{Timestamp: 20000}},
+		NeedTransfer:   true,
+	}
+
+	// LoadSegment
+	status, err := suite.node.LoadSegments(ctx, req)
+	suite.NoError(err)
+	suite.Equal(commonpb.ErrorCode_Success, status.GetErrorCode())
+	suite.True(suite.node.manager.Collection.Get(suite.collectionID).IsStringPoolEnabled())
+}
+
 func (suite *ServiceSuite) TestLoadDeltaInt64() {
 	ctx := context.Background()
 	suite.TestLoadSegments_Int64()
@@ -1150,6 +1178,36 @@ func (suite *ServiceSuite) TestSearch_Concurrent() {
 	}
 }
 
+func (suite *ServiceSuite) TestSearch_DelegatorFallback() {
+	ctx := context.Background()
+	// pre
+	suite.TestWatchDmChannelsInt64()
+	suite.TestLoadSegments_Int64()
+	// the delegator is being rebuilt elsewhere
+	suite.node.delegators.GetAndRemove(suite.vchannel)
+
+	schema := segments.GenTestCollectionSchema(suite.collectionName, schemapb.DataType_Int64)
+	creq, err := suite.genCSearchRequest(10, IndexFaissIDMap, schema)
+	suite.NoError(err)
+	req := &querypb.SearchRequest{
+		Req:             creq,
+		FromShardLeader: false,
+		DmlChannels:     []string{suite.vchannel},
+		TotalChannelNum: 2,
+	}
+
+	rsp, err := suite.node.Search(ctx, req)
+	suite.NoError(err)
+	suite.ErrorIs(merr.Error(rsp.GetStatus()), merr.ErrChannelNotFound)
+
+	paramtable.Get().Save(paramtable.Get().QueryNodeCfg.DelegatorFallbackEnabled.Key, "true")
+	defer paramtable.Get().Reset(paramtable.Get().QueryNodeCfg.DelegatorFallbackEnabled.Key)
+	rsp, err = suite.node.Search(ctx, req)
+	suite.NoError(err)
+	suite.Equal(commonpb.ErrorCode_Success, rsp.GetStatus().GetErrorCode())
+	suite.True(rsp.GetPartial())
+}
+
 func (suite *ServiceSuite) TestSearch_Failed() {
 	ctx := context.Background()
 
@@ -0,0 +1,64 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package querynodev2
+
+import (
+	"net/http"
+
+	"go.uber.org/zap"
+
+	management "github.com/milvus-io/milvus/internal/http"
+	"github.com/milvus-io/milvus/pkg/log"
+)
+
+// goingStopper reports the node is going to stop.
+type goingStopper interface {
+	GoingStop() error
+}
+
+var stoppingComponent = management.NewComponent[goingStopper]("querynode")
+
+// registerStoppingHandler exposes marking the querynode stopping through the management http server,
+// the handler is registered only once and serves the latest started querynode.
+func registerStoppingHandler(node goingStopper) {
+	stoppingComponent.Serve(node, &management.Handler{
+		Path:        management.QueryNodeStoppingRouterPath,
+		HandlerFunc: stoppingHandler,
+	})
+}
+
+// stoppingHandler marks the querynode stopping, the process keeps serving until it receives SIGTERM.
+//
+//	POST /querynode/stopping
+func stoppingHandler(w http.ResponseWriter, req *http.Request) {
+	if req.Method != http.MethodPost {
+		management.WriteJSON(w, http.StatusMethodNotAllowed, map[string]string{"error": "method not allowed"})
+		return
+	}
+	node, ok := stoppingComponent.Get(w)
+	if !ok {
+		return
+	}
+
+	if err := node.GoingStop(); err != nil {
+		log.Warn("failed to mark querynode stopping", zap.Error(err))
+		management.WriteJSON(w, http.StatusInternalServerError, map[string]string{"error": "failed to mark stopping: " + err.Error()})
+		return
+	}
+	log.Info("querynode marked stopping by the management request")
+	management.WriteJSON(w, http.StatusOK, map[string]bool{"stopping": true})
+}
@@ -0,0 +1,74 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package querynodev2
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/cockroachdb/errors"
+	"github.com/stretchr/testify/assert"
+
+	management "github.com/milvus-io/milvus/internal/http"
+)
+
+type mockGoingStopper struct {
+	calls int
+	err   error
+}
+
+func (s *mockGoingStopper) GoingStop() error {
+	s.calls++
+	return s.err
+}
+
+func Test_stoppingHandler(t *testing.T) {
+	// the handler serves a standalone component, the one of the querynode started by other tests is restored after
+	defer func(c *management.Component[goingStopper]) { stoppingComponent = c }(stoppingComponent)
+	stoppingComponent = management.NewComponent[goingStopper]("querynode")
+
+	t.Run("not started", func(t *testing.T) {
+		w := httptest.NewRecorder()
+		stoppingHandler(w, httptest.NewRequest(http.MethodPost, "/querynode/stopping", nil))
+		assert.Equal(t, http.StatusServiceUnavailable, w.Code)
+	})
+
+	node := &mockGoingStopper{}
+	stoppingComponent.Serve(node)
+
+	t.Run("method not allowed", func(t *testing.T) {
+		w := httptest.NewRecorder()
+		stoppingHandler(w, httptest.NewRequest(http.MethodGet, "/querynode/stopping", nil))
+		assert.Equal(t, http.StatusMethodNotAllowed, w.Code)
+		assert.Equal(t, 0, node.calls)
+	})
+
+	t.Run("stopping", func(t *testing.T) {
+		w := httptest.NewRecorder()
+		stoppingHandler(w, httptest.NewRequest(http.MethodPost, "/querynode/stopping", nil))
+		assert.Equal(t, http.StatusOK, w.Code)
+		assert.Equal(t, 1, node.calls)
+	})
+
+	t.Run("failed", func(t *testing.T) {
+		node.err = errors.New("mock")
+		w := httptest.NewRecorder()
+		stoppingHandler(w, httptest.NewRequest(http.MethodPost, "/querynode/stopping", nil))
+		assert.Equal(t, http.StatusInternalServerError, w.Code)
+	})
+}
@@ -0,0 +1,96 @@
+package tasks
+
+import (
+	"fmt"
+	"sync"
+
+	"github.com/milvus-io/milvus/pkg/metrics"
+	"github.com/milvus-io/milvus/pkg/util/paramtable"
+)
+
+// collectionQuota limits the concurrency of search tasks of each collection.
+// The task exceeding the quota is parked until a running task of the same collection finishes,
+// so the tasks of other collections are not blocked by it.
+type collectionQuota struct {
+	mu      sync.Mutex
+	running map[int64]int
+	waiting map[int64][]func()
+	// limit returns the max concurrency per collection, replaced in unittest.
+	limit func() int
+}
+
+func newCollectionQuota() *collectionQuota {
+	return &collectionQuota{
+		running: make(map[int64]int),
+		waiting: make(map[int64][]func()),
+		limit: func() int {
+			return paramtable.Get().QueryNodeCfg.MaxSearchConcurrencyPerCollection.GetAsInt()
+		},
+	}
+}
+
+// Run calls run at once if the collection has free quota, otherwise after a running task of the collection releases.
+// run must call the release function after the task finished.
+func (q *collectionQuota) Run(collectionID int64, run func(release func())) {
+	release := func() { q.release(collectionID) }
+	limit := q.limit()
+
+	q.mu.Lock()
+	if limit <= 0 || q.running[collectionID] < limit {
+		q.running[collectionID]++
+		q.updateMetric(collectionID)
+		q.mu.Unlock()
+		run(release)
+		return
+	}
+	q.waiting[collectionID] = append(q.waiting[collectionID], func() { run(release) })
+	q.updateMetric(collectionID)
+	q.mu.Unlock()
+}
+
+func (q *collectionQuota) release(collectionID int64) {
+	limit := q.limit()
+
+	q.mu.Lock()
+	defer q.mu.Unlock()
+	q.running[collectionID]--
+	// hand over the quota to the waiting tasks in order,
+	// more than one task may start if the limit is raised
+	waiting := q.waiting[collectionID]
+	for len(waiting) > 0 && (limit <= 0 || q.running[collectionID] < limit) {
+		next := waiting[0]
+		waiting = waiting[1:]
+		q.running[collectionID]++
+		go next()
+	}
+	if len(waiting) == 0 {
+		delete(q.waiting, collectionID)
+	} else {
+		q.waiting[collectionID] = waiting
+	}
+	if q.running[collectionID] <= 0 {
+		delete(q.running, collectionID)
+	}
+	q.updateMetric(collectionID)
+}
+
+// Running returns the number of running tasks of the collection.
+func (q *collectionQuota) Running(collectionID int64) int {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+	return q.running[collectionID]
+}
+
+// Waiting returns the number of tasks of the collection waiting for quota.
+func (q *collectionQuota) Waiting(collectionID int64) int {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+	return len(q.waiting[collectionID])
+}
+
+func (q *collectionQuota) updateMetric(collectionID int64) {
+	nodeID := fmt.Sprint(paramtable.GetNodeID())
+	collection := fmt.Sprint(collectionID)
+	metrics.QueryNodeCollectionSearchConcurrency.WithLabelValues(nodeID, collection).Set(float64(q.running[collectionID]))
+	metrics.QueryNodeCollectionSearchWaitingNum.WithLabelValues(nodeID, collection).Set(float64(len(q.waiting[collectionID])))
+}
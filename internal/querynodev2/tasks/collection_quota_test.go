@@ -0,0 +1,123 @@
+package tasks
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/suite"
+
+	"github.com/milvus-io/milvus/pkg/util/paramtable"
+)
+
+type CollectionQuotaSuite struct {
+	suite.Suite
+
+	limit int
+	quota *collectionQuota
+}
+
+func (s *CollectionQuotaSuite) SetupSuite() {
+	paramtable.Init()
+}
+
+func (s *CollectionQuotaSuite) SetupTest() {
+	s.limit = 2
+	s.quota = newCollectionQuota()
+	s.quota.limit = func() int { return s.limit }
+}
+
+// start runs a task of the collection and returns the channels notified when it starts,
+// and to finish the task.
+func (s *CollectionQuotaSuite) start(collectionID int64) (<-chan struct{}, chan<- struct{}) {
+	started := make(chan struct{})
+	finish := make(chan struct{})
+	s.quota.Run(collectionID, func(release func()) {
+		close(started)
+		go func() {
+			<-finish
+			release()
+		}()
+	})
+	return started, finish
+}
+
+func (s *CollectionQuotaSuite) waitStarted(started <-chan struct{}) {
+	select {
+	case <-started:
+	case <-time.After(time.Second):
+		s.FailNow("task not started")
+	}
+}
+
+func (s *CollectionQuotaSuite) TestDisabled() {
+	s.limit = 0
+	for i := 0; i < 5; i++ {
+		started, finish := s.start(1)
+		s.waitStarted(started)
+		defer close(finish)
+	}
+	s.Equal(5, s.quota.Running(1))
+	s.Equal(0, s.quota.Waiting(1))
+}
+
+func (s *CollectionQuotaSuite) TestLimit() {
+	started1, finish1 := s.start(1)
+	started2, finish2 := s.start(1)
+	s.waitStarted(started1)
+	s.waitStarted(started2)
+
+	// the third task of collection 1 waits
+	started3, finish3 := s.start(1)
+	s.Equal(2, s.quota.Running(1))
+	s.Equal(1, s.quota.Waiting(1))
+	select {
+	case <-started3:
+		s.FailNow("task started exceeding quota")
+	default:
+	}
+
+	// the tasks of other collections are not blocked
+	started4, finish4 := s.start(2)
+	s.waitStarted(started4)
+	close(finish4)
+
+	// the waiting task starts after one running task finished
+	close(finish1)
+	s.waitStarted(started3)
+	s.Equal(0, s.quota.Waiting(1))
+	s.Equal(2, s.quota.Running(1))
+
+	close(finish2)
+	close(finish3)
+	s.Eventually(func() bool {
+		return s.quota.Running(1) == 0 && s.quota.Running(2) == 0
+	}, time.Second, 10*time.Millisecond)
+}
+
+func (s *CollectionQuotaSuite) TestRaiseLimit() {
+	started1, finish1 := s.start(1)
+	started2, finish2 := s.start(1)
+	s.waitStarted(started1)
+	s.waitStarted(started2)
+	started3, finish3 := s.start(1)
+	started4, finish4 := s.start(1)
+	s.Equal(2, s.quota.Waiting(1))
+
+	// all the waiting tasks start after the limit raised
+	s.limit = 4
+	close(finish1)
+	s.waitStarted(started3)
+	s.waitStarted(started4)
+	s.Equal(3, s.quota.Running(1))
+
+	close(finish2)
+	close(finish3)
+	close(finish4)
+	s.Eventually(func() bool {
+		return s.quota.Running(1) == 0
+	}, time.Second, 10*time.Millisecond)
+}
+
+func TestCollectionQuota(t *testing.T) {
+	suite.Run(t, new(CollectionQuotaSuite))
+}
@@ -32,6 +32,8 @@ func newScheduler(policy schedulePolicy) Scheduler {
 		pool:             conc.NewPool[any](maxReadConcurrency, conc.WithPreAlloc(true)),
 		schedulerCounter: schedulerCounter{},
 		lifetime:         lifetime.NewLifetime(lifetime.Initializing),
+		memoryBudget:     newMemoryBudget(),
+		collectionQuota:  newCollectionQuota(),
 	}
 }
 
@@ -51,6 +53,10 @@ type scheduler struct {
 	wg sync.WaitGroup
 	// lifetime controls scheduler State & make sure all requests accepted will be processed
 	lifetime lifetime.Lifetime[lifetime.State]
+	// memoryBudget limits the estimated memory held by executing tasks
+	memoryBudget *memoryBudget
+	// collectionQuota limits the concurrency of executing tasks per collection
+	collectionQuota *collectionQuota
 
 	schedulerCounter
 }
@@ -227,22 +233,62 @@ func (s *scheduler) exec() {
 			continue
 		}
 
-		s.pool.Submit(func() (any, error) {
-			// Update concurrency metric and notify task done.
-			metrics.QueryNodeReadTaskConcurrency.WithLabelValues(fmt.Sprint(paramtable.GetNodeID())).Inc()
-			collector.Counter.Inc(metricsinfo.ExecuteQueueType, 1)
+		// Reserve memory for task results, the task is parked in order if budget not enough.
+		s.runWithMemoryBudget(t, func(release func()) {
+			s.runWithCollectionQuota(t, func(releaseQuota func()) {
+				s.submit(t, func() {
+					releaseQuota()
+					release()
+				})
+			})
+		})
+	}
+}
 
-			err := t.Execute()
+// submit executes the task in the pool, release is called after the task done.
+func (s *scheduler) submit(t Task, release func()) {
+	s.pool.Submit(func() (any, error) {
+		defer release()
 
-			// Update all metric after task finished.
-			metrics.QueryNodeReadTaskConcurrency.WithLabelValues(fmt.Sprint(paramtable.GetNodeID())).Dec()
-			collector.Counter.Dec(metricsinfo.ExecuteQueueType, -1)
+		// Update concurrency metric and notify task done.
+		metrics.QueryNodeReadTaskConcurrency.WithLabelValues(fmt.Sprint(paramtable.GetNodeID())).Inc()
+		collector.Counter.Inc(metricsinfo.ExecuteQueueType, 1)
 
-			// Notify task done.
-			t.Done(err)
-			return nil, err
-		})
+		err := t.Execute()
+
+		// Update all metric after task finished.
+		metrics.QueryNodeReadTaskConcurrency.WithLabelValues(fmt.Sprint(paramtable.GetNodeID())).Dec()
+		collector.Counter.Dec(metricsinfo.ExecuteQueueType, -1)
+
+		// Notify task done.
+		t.Done(err)
+		return nil, err
+	})
+}
+
+// runWithCollectionQuota runs the task within the concurrency quota of its collection,
+// the task is parked without blocking the execute loop if the quota is used up.
+func (s *scheduler) runWithCollectionQuota(t Task, run func(release func())) {
+	ct, ok := t.(CollectionTask)
+	if !ok {
+		run(func() {})
+		return
+	}
+	s.collectionQuota.Run(ct.CollectionID(), run)
+}
+
+// runWithMemoryBudget runs the task after the estimated memory of task reserved from memory budget,
+// the task is parked without blocking the execute loop if the budget is not enough.
+func (s *scheduler) runWithMemoryBudget(t Task, run func(release func())) {
+	mt, ok := t.(MemoryEstimateTask)
+	if !ok {
+		run(func() {})
+		return
 	}
+	s.memoryBudget.Run(mt.EstimateMemory(), t.Canceled, run, func(err error) {
+		log.Warn("failed to reserve memory for task", zap.Error(err))
+		t.Done(err)
+	})
 }
 
 // setupExecListener setup the execChan and next task to run.
@@ -0,0 +1,213 @@
+package tasks
+
+import (
+	"fmt"
+	"sync"
+	"time"
+
+	"go.uber.org/zap"
+
+	"github.com/milvus-io/milvus-proto/go-api/v2/schemapb"
+	"github.com/milvus-io/milvus/pkg/log"
+	"github.com/milvus-io/milvus/pkg/metrics"
+	"github.com/milvus-io/milvus/pkg/util/hardware"
+	"github.com/milvus-io/milvus/pkg/util/merr"
+	"github.com/milvus-io/milvus/pkg/util/paramtable"
+	"github.com/milvus-io/milvus/pkg/util/typeutil"
+)
+
+const (
+	// size of one search hit without output fields, int64 id + float32 score.
+	searchHitBaseSize = 12
+)
+
+// memoryBudget is the global budget of memory reserved by executing read tasks.
+// Read task estimates the memory it may hold before executing,
+// the task is parked if the budget is not enough, and rejected if it waits too long or too many tasks are parked,
+// so the other tasks are not blocked by it.
+type memoryBudget struct {
+	mu   sync.Mutex
+	used int64
+	// waiting are the parked tasks in arrival order
+	waiting []*memoryWaiter
+	// total returns the total memory of current node, replaced in unittest.
+	total func() uint64
+}
+
+type memoryWaiter struct {
+	size     int64
+	canceled func() error
+	run      func(release func())
+	reject   func(err error)
+	timer    *time.Timer
+}
+
+func newMemoryBudget() *memoryBudget {
+	return &memoryBudget{
+		total: hardware.GetMemoryCount,
+	}
+}
+
+// limit returns the current budget limit in bytes, non-positive means unlimited.
+func (b *memoryBudget) limit() int64 {
+	ratio := paramtable.Get().QueryNodeCfg.ReadMemoryBudgetRatio.GetAsFloat()
+	if ratio <= 0 {
+		return 0
+	}
+	return int64(float64(b.total()) * ratio)
+}
+
+// Run calls run at once if the budget has enough memory for size, otherwise after the reserved memory released.
+// run must call the release function after the task finished.
+// reject is called instead if size exceeds the whole budget, the waiting queue is full, the wait timeout is reached,
+// or canceled returns error while parked.
+func (b *memoryBudget) Run(size int64, canceled func() error, run func(release func()), reject func(err error)) {
+	limit := b.limit()
+	if limit <= 0 || size <= 0 {
+		run(func() {})
+		return
+	}
+	if size > limit {
+		b.reject()
+		reject(merr.WrapErrServiceMemoryLimitExceeded(float32(size), float32(limit),
+			"estimated memory of read request exceeds the read memory budget, try smaller nq, topk or fewer output fields"))
+		return
+	}
+
+	b.mu.Lock()
+	// the parked tasks go first, so a large task is not starved by the small ones
+	if len(b.waiting) == 0 && b.used+size <= limit {
+		b.used += size
+		b.updateMetric()
+		b.mu.Unlock()
+		run(func() { b.release(size) })
+		return
+	}
+	b.dropCanceled()
+	if maxWaiting := paramtable.Get().QueryNodeCfg.ReadMemoryMaxWaitingTasks.GetAsInt(); maxWaiting > 0 && len(b.waiting) >= maxWaiting {
+		b.mu.Unlock()
+		b.reject()
+		reject(merr.WrapErrServiceMemoryLimitExceeded(float32(size), float32(limit),
+			fmt.Sprintf("too many read tasks waiting for read memory budget, max waiting tasks %d", maxWaiting)))
+		return
+	}
+	waiter := &memoryWaiter{size: size, canceled: canceled, run: run, reject: reject}
+	timeout := paramtable.Get().QueryNodeCfg.ReadMemoryWaitTimeout.GetAsDuration(time.Millisecond)
+	waiter.timer = time.AfterFunc(timeout, func() { b.timeout(waiter, timeout) })
+	b.waiting = append(b.waiting, waiter)
+	b.mu.Unlock()
+}
+
+func (b *memoryBudget) timeout(waiter *memoryWaiter, timeout time.Duration) {
+	b.mu.Lock()
+	removed := false
+	for i, w := range b.waiting {
+		if w == waiter {
+			b.waiting = append(b.waiting[:i], b.waiting[i+1:]...)
+			removed = true
+			break
+		}
+	}
+	used := b.used
+	// the tasks behind may fit now
+	b.wakeup()
+	b.mu.Unlock()
+	if !removed {
+		// already started by release, or dropped as canceled
+		return
+	}
+	if err := waiter.canceled(); err != nil {
+		waiter.reject(err)
+		return
+	}
+
+	b.reject()
+	limit := b.limit()
+	log.Warn("timeout to wait for read memory budget",
+		zap.Int64("size", waiter.size),
+		zap.Int64("used", used),
+		zap.Int64("limit", limit))
+	waiter.reject(merr.WrapErrServiceMemoryLimitExceeded(float32(used+waiter.size), float32(limit),
+		fmt.Sprintf("wait for read memory budget timeout after %v", timeout)))
+}
+
+func (b *memoryBudget) release(size int64) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	b.used -= size
+	b.wakeup()
+	b.updateMetric()
+}
+
+// wakeup starts the parked tasks in order until the budget is not enough, must be called with mu held.
+func (b *memoryBudget) wakeup() {
+	b.dropCanceled()
+	limit := b.limit()
+	for len(b.waiting) > 0 {
+		next := b.waiting[0]
+		if limit > 0 && b.used+next.size > limit {
+			return
+		}
+		b.waiting = b.waiting[1:]
+		next.timer.Stop()
+		b.used += next.size
+		size := next.size
+		go next.run(func() { b.release(size) })
+	}
+}
+
+// dropCanceled removes the parked tasks canceled by their callers, so they neither hold the queue nor reserve memory,
+// must be called with mu held.
+func (b *memoryBudget) dropCanceled() {
+	waiting := b.waiting[:0]
+	for _, w := range b.waiting {
+		if err := w.canceled(); err != nil {
+			w.timer.Stop()
+			go w.reject(err)
+			continue
+		}
+		waiting = append(waiting, w)
+	}
+	b.waiting = waiting
+}
+
+// Waiting returns the number of parked tasks.
+func (b *memoryBudget) Waiting() int {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	return len(b.waiting)
+}
+
+// Used returns the memory reserved now.
+func (b *memoryBudget) Used() int64 {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	return b.used
+}
+
+func (b *memoryBudget) updateMetric() {
+	metrics.QueryNodeReservedReadMemory.WithLabelValues(fmt.Sprint(paramtable.GetNodeID())).Set(float64(b.used))
+}
+
+func (b *memoryBudget) reject() {
+	metrics.QueryNodeReadMemoryRejectCount.WithLabelValues(fmt.Sprint(paramtable.GetNodeID())).Inc()
+}
+
+// estimateOutputFieldsSize returns the estimated size of one row of the output fields.
+func estimateOutputFieldsSize(schema *schemapb.CollectionSchema, outputFieldIDs []int64) int64 {
+	if schema == nil || len(outputFieldIDs) == 0 {
+		return 0
+	}
+	fieldIDs := typeutil.NewSet(outputFieldIDs...)
+	outputSchema := &schemapb.CollectionSchema{}
+	for _, field := range schema.GetFields() {
+		if fieldIDs.Contain(field.GetFieldID()) {
+			outputSchema.Fields = append(outputSchema.Fields, field)
+		}
+	}
+	size, err := typeutil.EstimateSizePerRecord(outputSchema)
+	if err != nil || size < 0 {
+		return 0
+	}
+	return int64(size)
+}
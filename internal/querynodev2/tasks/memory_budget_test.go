@@ -0,0 +1,207 @@
+package tasks
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/suite"
+	"go.uber.org/atomic"
+
+	"github.com/milvus-io/milvus-proto/go-api/v2/commonpb"
+	"github.com/milvus-io/milvus-proto/go-api/v2/schemapb"
+	"github.com/milvus-io/milvus/pkg/common"
+	"github.com/milvus-io/milvus/pkg/util/merr"
+	"github.com/milvus-io/milvus/pkg/util/paramtable"
+)
+
+func notCanceled() error { return nil }
+
+type MemoryBudgetSuite struct {
+	suite.Suite
+
+	budget *memoryBudget
+}
+
+func (s *MemoryBudgetSuite) SetupSuite() {
+	paramtable.Init()
+}
+
+func (s *MemoryBudgetSuite) SetupTest() {
+	s.budget = newMemoryBudget()
+	s.budget.total = func() uint64 { return 1000 }
+	paramtable.Get().Save(paramtable.Get().QueryNodeCfg.ReadMemoryBudgetRatio.Key, "0.1")
+	paramtable.Get().Save(paramtable.Get().QueryNodeCfg.ReadMemoryWaitTimeout.Key, "100")
+}
+
+func (s *MemoryBudgetSuite) TearDownTest() {
+	paramtable.Get().Reset(paramtable.Get().QueryNodeCfg.ReadMemoryBudgetRatio.Key)
+	paramtable.Get().Reset(paramtable.Get().QueryNodeCfg.ReadMemoryWaitTimeout.Key)
+	paramtable.Get().Reset(paramtable.Get().QueryNodeCfg.ReadMemoryMaxWaitingTasks.Key)
+}
+
+// reserve waits until the memory reserved or rejected.
+func (s *MemoryBudgetSuite) reserve(size int64) (func(), error) {
+	reserved := make(chan func(), 1)
+	rejected := make(chan error, 1)
+	s.budget.Run(size, notCanceled, func(release func()) {
+		reserved <- release
+	}, func(err error) {
+		rejected <- err
+	})
+	select {
+	case release := <-reserved:
+		return release, nil
+	case err := <-rejected:
+		return nil, err
+	}
+}
+
+func (s *MemoryBudgetSuite) TestParkInOrder() {
+	release, err := s.reserve(80)
+	s.NoError(err)
+
+	paramtable.Get().Save(paramtable.Get().QueryNodeCfg.ReadMemoryWaitTimeout.Key, "10000")
+	started := make(chan int64, 2)
+	// parked without blocking the caller
+	s.budget.Run(30, notCanceled, func(release func()) {
+		started <- 30
+		release()
+	}, func(err error) { s.Fail("rejected", err.Error()) })
+	// parked behind the previous one even though it fits
+	s.budget.Run(10, notCanceled, func(release func()) {
+		started <- 10
+		release()
+	}, func(err error) { s.Fail("rejected", err.Error()) })
+	s.Equal(2, s.budget.Waiting())
+
+	release()
+	s.ElementsMatch([]int64{30, 10}, []int64{<-started, <-started})
+	s.Eventually(func() bool {
+		return s.budget.Used() == 0
+	}, time.Second, 10*time.Millisecond)
+	s.Equal(0, s.budget.Waiting())
+}
+
+func (s *MemoryBudgetSuite) TestDisabled() {
+	paramtable.Get().Save(paramtable.Get().QueryNodeCfg.ReadMemoryBudgetRatio.Key, "0")
+	release, err := s.reserve(10000)
+	s.NoError(err)
+	release()
+	s.EqualValues(0, s.budget.Used())
+}
+
+func (s *MemoryBudgetSuite) TestReserveAndRelease() {
+	release1, err := s.reserve(60)
+	s.NoError(err)
+	s.EqualValues(60, s.budget.Used())
+
+	release2, err := s.reserve(40)
+	s.NoError(err)
+	s.EqualValues(100, s.budget.Used())
+
+	release1()
+	release2()
+	s.EqualValues(0, s.budget.Used())
+}
+
+func (s *MemoryBudgetSuite) TestExceedLimit() {
+	_, err := s.reserve(101)
+	s.ErrorIs(err, merr.ErrServiceMemoryLimitExceeded)
+	s.EqualValues(0, s.budget.Used())
+}
+
+func (s *MemoryBudgetSuite) TestWaitTimeout() {
+	release, err := s.reserve(80)
+	s.NoError(err)
+	defer release()
+
+	_, err = s.reserve(30)
+	s.ErrorIs(err, merr.ErrServiceMemoryLimitExceeded)
+	s.EqualValues(80, s.budget.Used())
+}
+
+func (s *MemoryBudgetSuite) TestWaitRelease() {
+	release, err := s.reserve(80)
+	s.NoError(err)
+
+	go func() {
+		time.Sleep(20 * time.Millisecond)
+		release()
+	}()
+	release2, err := s.reserve(30)
+	s.NoError(err)
+	s.EqualValues(30, s.budget.Used())
+	release2()
+}
+
+func (s *MemoryBudgetSuite) TestDropCanceled() {
+	release, err := s.reserve(80)
+	s.NoError(err)
+
+	paramtable.Get().Save(paramtable.Get().QueryNodeCfg.ReadMemoryWaitTimeout.Key, "10000")
+	canceled := atomic.NewBool(false)
+	rejected := make(chan error, 1)
+	s.budget.Run(30, func() error {
+		if canceled.Load() {
+			return merr.ErrServiceNotReady
+		}
+		return nil
+	}, func(release func()) {
+		s.Fail("canceled task started")
+		release()
+	}, func(err error) {
+		rejected <- err
+	})
+	started := make(chan struct{})
+	s.budget.Run(10, notCanceled, func(release func()) {
+		close(started)
+		release()
+	}, func(err error) { s.Fail("rejected", err.Error()) })
+	s.Equal(2, s.budget.Waiting())
+
+	// the canceled task is dropped, and the one behind starts without waiting for it
+	canceled.Store(true)
+	release()
+	s.ErrorIs(<-rejected, merr.ErrServiceNotReady)
+	<-started
+	s.Eventually(func() bool {
+		return s.budget.Used() == 0
+	}, time.Second, 10*time.Millisecond)
+	s.Equal(0, s.budget.Waiting())
+}
+
+func (s *MemoryBudgetSuite) TestWaitingFull() {
+	release, err := s.reserve(80)
+	s.NoError(err)
+	defer release()
+
+	paramtable.Get().Save(paramtable.Get().QueryNodeCfg.ReadMemoryWaitTimeout.Key, "10000")
+	paramtable.Get().Save(paramtable.Get().QueryNodeCfg.ReadMemoryMaxWaitingTasks.Key, "1")
+	s.budget.Run(30, notCanceled, func(release func()) {
+		release()
+	}, func(err error) {})
+	s.Equal(1, s.budget.Waiting())
+
+	_, err = s.reserve(30)
+	s.ErrorIs(err, merr.ErrServiceMemoryLimitExceeded)
+	s.Equal(1, s.budget.Waiting())
+}
+
+func (s *MemoryBudgetSuite) TestEstimateOutputFieldsSize() {
+	schema := &schemapb.CollectionSchema{
+		Fields: []*schemapb.FieldSchema{
+			{FieldID: 100, DataType: schemapb.DataType_Int64},
+			{FieldID: 101, DataType: schemapb.DataType_FloatVector, TypeParams: []*commonpb.KeyValuePair{
+				{Key: common.DimKey, Value: "128"},
+			}},
+			{FieldID: 102, DataType: schemapb.DataType_Float},
+		},
+	}
+	s.EqualValues(0, estimateOutputFieldsSize(schema, nil))
+	s.EqualValues(12, estimateOutputFieldsSize(schema, []int64{100, 102}))
+	s.EqualValues(520, estimateOutputFieldsSize(schema, []int64{101, 100}))
+}
+
+func TestMemoryBudget(t *testing.T) {
+	suite.Run(t, new(MemoryBudgetSuite))
+}
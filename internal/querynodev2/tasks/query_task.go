@@ -16,9 +16,13 @@ import (
 	"github.com/milvus-io/milvus/pkg/util/metricsinfo"
 	"github.com/milvus-io/milvus/pkg/util/paramtable"
 	"github.com/milvus-io/milvus/pkg/util/timerecord"
+	"github.com/milvus-io/milvus/pkg/util/typeutil"
 )
 
-var _ Task = &QueryTask{}
+var (
+	_ Task               = &QueryTask{}
+	_ MemoryEstimateTask = &QueryTask{}
+)
 
 func NewQueryTask(ctx context.Context,
 	collection *segments.Collection,
@@ -89,6 +93,26 @@ func (t *QueryTask) Execute() error {
 		return err
 	}
 	defer retrievePlan.Delete()
+
+	if t.req.GetReq().GetExplain() {
+		explains, querySegments, err := segments.ExplainRetrieve(t.ctx, t.segmentManager, t.collection, retrievePlan, t.req)
+		defer t.segmentManager.Segment.Unpin(querySegments)
+		if err != nil {
+			return err
+		}
+		t.result = &internalpb.RetrieveResults{
+			Base: &commonpb.MsgBase{
+				SourceID: paramtable.GetNodeID(),
+			},
+			Status:   merr.Success(),
+			Explains: explains,
+			CostAggregation: &internalpb.CostAggregation{
+				ServiceTime: tr.ElapseSpan().Milliseconds(),
+			},
+		}
+		return nil
+	}
+
 	results, querySegments, err := segments.Retrieve(t.ctx, t.segmentManager, retrievePlan, t.req)
 	defer t.segmentManager.Segment.Unpin(querySegments)
 	if err != nil {
@@ -143,3 +167,14 @@ func (t *QueryTask) Result() *internalpb.RetrieveResults {
 func (t *QueryTask) NQ() int64 {
 	return 1
 }
+
+// EstimateMemory returns the estimated memory of retrieve results,
+// the max output size is used if the query has no limit.
+func (t *QueryTask) EstimateMemory() int64 {
+	limit := t.req.GetReq().GetLimit()
+	if limit == typeutil.Unlimited || limit <= 0 {
+		return paramtable.Get().QuotaConfig.MaxOutputSize.GetAsInt64()
+	}
+	rowSize := estimateOutputFieldsSize(t.collection.Schema(), t.req.GetReq().GetOutputFieldsId())
+	return limit * rowSize
+}
@@ -26,8 +26,10 @@ import (
 )
 
 var (
-	_ Task      = &SearchTask{}
-	_ MergeTask = &SearchTask{}
+	_ Task               = &SearchTask{}
+	_ MergeTask          = &SearchTask{}
+	_ MemoryEstimateTask = &SearchTask{}
+	_ CollectionTask     = &SearchTask{}
 )
 
 type SearchTask struct {
@@ -114,6 +116,12 @@ func (t *SearchTask) Execute() error {
 		zap.String("shard", t.req.GetDmlChannels()[0]),
 	)
 
+	// the wait time includes the time waiting for the concurrency quota of collection
+	metrics.QueryNodeCollectionSearchWaitLatency.WithLabelValues(
+		fmt.Sprint(paramtable.GetNodeID()),
+		fmt.Sprint(t.collection.ID())).
+		Observe(float64(t.tr.ElapseSpan().Milliseconds()))
+
 	tr := timerecord.NewTimeRecorderWithTrace(t.ctx, "SearchTask")
 
 	req := t.req
@@ -124,6 +132,10 @@ func (t *SearchTask) Execute() error {
 	}
 	defer searchReq.Delete()
 
+	if req.GetReq().GetExplain() {
+		return t.explain(searchReq)
+	}
+
 	var (
 		results          []*segments.SearchResult
 		searchedSegments []segments.Segment
@@ -152,6 +164,7 @@ func (t *SearchTask) Execute() error {
 		return err
 	}
 	defer segments.DeleteSearchResults(results)
+	missedSegments := searchReq.MissedSegments()
 
 	if len(results) == 0 {
 		for i := range t.originNqs {
@@ -175,6 +188,8 @@ func (t *SearchTask) Execute() error {
 				CostAggregation: &internalpb.CostAggregation{
 					ServiceTime: tr.ElapseSpan().Milliseconds(),
 				},
+				Partial:          len(missedSegments) > 0,
+				MissedSegmentIDs: missedSegments,
 			}
 		}
 		return nil
@@ -229,12 +244,42 @@ func (t *SearchTask) Execute() error {
 			CostAggregation: &internalpb.CostAggregation{
 				ServiceTime: tr.ElapseSpan().Milliseconds(),
 			},
+			Partial:          len(missedSegments) > 0,
+			MissedSegmentIDs: missedSegments,
 		}
 	}
 
 	return nil
 }
 
+// explain explains the plan executed on each segment instead of searching,
+// the explain tasks are never merged so only the task itself has result.
+func (t *SearchTask) explain(searchReq *segments.SearchRequest) error {
+	tr := timerecord.NewTimeRecorderWithTrace(t.ctx, "SearchTaskExplain")
+	explains, searchedSegments, err := segments.ExplainSearch(t.ctx, t.segmentManager, t.collection, searchReq, t.req)
+	defer t.segmentManager.Segment.Unpin(searchedSegments)
+	if err != nil {
+		return err
+	}
+
+	t.result = &internalpb.SearchResults{
+		Base: &commonpb.MsgBase{
+			SourceID: paramtable.GetNodeID(),
+		},
+		Status:         merr.Success(),
+		MetricType:     t.req.GetReq().GetMetricType(),
+		NumQueries:     t.originNqs[0],
+		TopK:           t.originTopks[0],
+		SlicedOffset:   1,
+		SlicedNumCount: 1,
+		CostAggregation: &internalpb.CostAggregation{
+			ServiceTime: tr.ElapseSpan().Milliseconds(),
+		},
+		Explains: explains,
+	}
+	return nil
+}
+
 func (t *SearchTask) Merge(other *SearchTask) bool {
 	var (
 		nq        = t.nq
@@ -250,7 +295,8 @@ func (t *SearchTask) Merge(other *SearchTask) bool {
 	ratio := float64(after) / float64(pre)
 
 	// Check mergeable
-	if t.req.GetReq().GetDbID() != other.req.GetReq().GetDbID() ||
+	if t.req.GetReq().GetExplain() || other.req.GetReq().GetExplain() ||
+		t.req.GetReq().GetDbID() != other.req.GetReq().GetDbID() ||
 		t.req.GetReq().GetCollectionID() != other.req.GetReq().GetCollectionID() ||
 		t.req.GetReq().GetDslType() != other.req.GetReq().GetDslType() ||
 		t.req.GetDmlChannels()[0] != other.req.GetDmlChannels()[0] ||
@@ -298,10 +344,21 @@ func (t *SearchTask) Result() *internalpb.SearchResults {
 	return t.result
 }
 
+func (t *SearchTask) CollectionID() int64 {
+	return t.collection.ID()
+}
+
 func (t *SearchTask) NQ() int64 {
 	return t.nq
 }
 
+// EstimateMemory returns the estimated memory of search results,
+// merged tasks share the same nq * topk results.
+func (t *SearchTask) EstimateMemory() int64 {
+	rowSize := searchHitBaseSize + estimateOutputFieldsSize(t.collection.Schema(), t.req.GetReq().GetOutputFieldsId())
+	return t.nq * t.topk * rowSize
+}
+
 func (t *SearchTask) MergeWith(other Task) bool {
 	switch other := other.(type) {
 	case *SearchTask:
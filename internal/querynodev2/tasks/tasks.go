@@ -76,6 +76,22 @@ type MergeTask interface {
 	MergeWith(Task) bool
 }
 
+// MemoryEstimateTask is a Task which can estimate the memory it holds while executing.
+type MemoryEstimateTask interface {
+	Task
+
+	// EstimateMemory returns the estimated memory (bytes) of task results.
+	EstimateMemory() int64
+}
+
+// CollectionTask is a Task belonging to a collection, whose concurrency is limited per collection.
+type CollectionTask interface {
+	Task
+
+	// CollectionID returns the collection the task belongs to.
+	CollectionID() int64
+}
+
 // A task is execute unit of scheduler.
 type Task interface {
 	// Return the username which task is belong to.
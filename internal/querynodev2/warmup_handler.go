@@ -0,0 +1,88 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package querynodev2
+
+import (
+	"net/http"
+	"strconv"
+	"strings"
+
+	management "github.com/milvus-io/milvus/internal/http"
+	"github.com/milvus-io/milvus/internal/querynodev2/segments"
+)
+
+var (
+	warmupComponent = management.NewComponent[*segments.Manager]("querynode")
+	warmupSegment   = segments.WarmupSegment
+)
+
+// registerWarmupHandler exposes the on-demand warmup of segments through the management http server,
+// the handler is registered only once and serves the segments of the latest started querynode.
+func registerWarmupHandler(manager *segments.Manager) {
+	warmupComponent.Serve(manager, &management.Handler{
+		Path:        management.QueryNodeWarmupRouterPath,
+		HandlerFunc: warmupHandler,
+	})
+}
+
+// warmupHandler warms up the mmapped pages of sealed segments sequentially, returns the bytes touched of each segment.
+//
+//	POST /querynode/warmup
+//	POST /querynode/warmup?segment_ids=445566778899,445566778900
+func warmupHandler(w http.ResponseWriter, req *http.Request) {
+	if req.Method != http.MethodPost {
+		management.WriteJSON(w, http.StatusMethodNotAllowed, map[string]string{"error": "method not allowed"})
+		return
+	}
+	manager, ok := warmupComponent.Get(w)
+	if !ok {
+		return
+	}
+
+	var segmentIDs []int64
+	if param := req.URL.Query().Get("segment_ids"); param != "" {
+		for _, str := range strings.Split(param, ",") {
+			segmentID, err := strconv.ParseInt(strings.TrimSpace(str), 10, 64)
+			if err != nil {
+				management.WriteJSON(w, http.StatusBadRequest, map[string]string{"error": "invalid segment id: " + err.Error()})
+				return
+			}
+			if len(manager.Segment.GetBy(segments.WithType(segments.SegmentTypeSealed), segments.WithID(segmentID))) == 0 {
+				management.WriteJSON(w, http.StatusBadRequest, map[string]string{"error": "sealed segment not loaded: " + str})
+				return
+			}
+			segmentIDs = append(segmentIDs, segmentID)
+		}
+	} else {
+		for _, segment := range manager.Segment.GetBy(segments.WithType(segments.SegmentTypeSealed)) {
+			segmentIDs = append(segmentIDs, segment.ID())
+		}
+	}
+
+	touched := make(map[int64]int64, len(segmentIDs))
+	for _, segmentID := range segmentIDs {
+		bytes, err := warmupSegment(req.Context(), segmentID)
+		if err != nil {
+			management.WriteJSON(w, http.StatusInternalServerError, map[string]string{
+				"error": "failed to warm up segment " + strconv.FormatInt(segmentID, 10) + ": " + err.Error(),
+			})
+			return
+		}
+		touched[segmentID] = bytes
+	}
+	management.WriteJSON(w, http.StatusOK, touched)
+}
@@ -0,0 +1,115 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package querynodev2
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/cockroachdb/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+
+	management "github.com/milvus-io/milvus/internal/http"
+	"github.com/milvus-io/milvus/internal/querynodev2/segments"
+)
+
+func Test_warmupHandler(t *testing.T) {
+	// the handler serves a standalone component, the one of the querynode started by other tests is restored after
+	defer func(c *management.Component[*segments.Manager]) {
+		warmupComponent = c
+		warmupSegment = segments.WarmupSegment
+	}(warmupComponent)
+	warmupComponent = management.NewComponent[*segments.Manager]("querynode")
+
+	t.Run("not started", func(t *testing.T) {
+		w := httptest.NewRecorder()
+		warmupHandler(w, httptest.NewRequest(http.MethodPost, "/querynode/warmup", nil))
+		assert.Equal(t, http.StatusServiceUnavailable, w.Code)
+	})
+
+	sealed := make([]segments.Segment, 0)
+	for _, id := range []int64{1, 2} {
+		segment := segments.NewMockSegment(t)
+		segment.EXPECT().ID().Return(id).Maybe()
+		segment.EXPECT().Type().Return(segments.SegmentTypeSealed).Maybe()
+		sealed = append(sealed, segment)
+	}
+	segmentManager := segments.NewMockSegmentManager(t)
+	getBy := func(filters ...segments.SegmentFilter) []segments.Segment {
+		result := make([]segments.Segment, 0)
+	outer:
+		for _, segment := range sealed {
+			for _, filter := range filters {
+				if !filter(segment) {
+					continue outer
+				}
+			}
+			result = append(result, segment)
+		}
+		return result
+	}
+	segmentManager.EXPECT().GetBy(mock.Anything).RunAndReturn(getBy).Maybe()
+	segmentManager.EXPECT().GetBy(mock.Anything, mock.Anything).RunAndReturn(getBy).Maybe()
+	warmupComponent.Serve(&segments.Manager{Segment: segmentManager})
+
+	warmed := make([]int64, 0)
+	warmupSegment = func(ctx context.Context, segmentID int64) (int64, error) {
+		if segmentID == 2 {
+			return 0, errors.New("mock")
+		}
+		warmed = append(warmed, segmentID)
+		return 4096, nil
+	}
+
+	t.Run("method not allowed", func(t *testing.T) {
+		w := httptest.NewRecorder()
+		warmupHandler(w, httptest.NewRequest(http.MethodGet, "/querynode/warmup", nil))
+		assert.Equal(t, http.StatusMethodNotAllowed, w.Code)
+	})
+
+	t.Run("specified segments", func(t *testing.T) {
+		w := httptest.NewRecorder()
+		warmupHandler(w, httptest.NewRequest(http.MethodPost, "/querynode/warmup?segment_ids=1", nil))
+		assert.Equal(t, http.StatusOK, w.Code)
+		touched := make(map[int64]int64)
+		assert.NoError(t, json.Unmarshal(w.Body.Bytes(), &touched))
+		assert.Equal(t, map[int64]int64{1: 4096}, touched)
+		assert.Equal(t, []int64{1}, warmed)
+	})
+
+	t.Run("invalid segments", func(t *testing.T) {
+		w := httptest.NewRecorder()
+		warmupHandler(w, httptest.NewRequest(http.MethodPost, "/querynode/warmup?segment_ids=a", nil))
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+
+		w = httptest.NewRecorder()
+		warmupHandler(w, httptest.NewRequest(http.MethodPost, "/querynode/warmup?segment_ids=1,3", nil))
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+	})
+
+	t.Run("all segments", func(t *testing.T) {
+		warmed = warmed[:0]
+		w := httptest.NewRecorder()
+		warmupHandler(w, httptest.NewRequest(http.MethodPost, "/querynode/warmup", nil))
+		assert.Equal(t, http.StatusInternalServerError, w.Code)
+		assert.Equal(t, []int64{1}, warmed)
+	})
+}
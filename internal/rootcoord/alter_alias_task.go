@@ -21,6 +21,8 @@ import (
 
 	"github.com/milvus-io/milvus-proto/go-api/v2/commonpb"
 	"github.com/milvus-io/milvus-proto/go-api/v2/milvuspb"
+	"github.com/milvus-io/milvus/pkg/common"
+	"github.com/milvus-io/milvus/pkg/util/typeutil"
 )
 
 type alterAliasTask struct {
@@ -36,9 +38,44 @@ func (t *alterAliasTask) Prepare(ctx context.Context) error {
 }
 
 func (t *alterAliasTask) Execute(ctx context.Context) error {
+	if common.IsAliasDropPrevious(t.Req.GetBase().GetProperties()) {
+		return t.executeAndDropPrevious(ctx)
+	}
 	if err := t.core.ExpireMetaCache(ctx, t.Req.GetDbName(), []string{t.Req.GetAlias()}, InvalidCollectionID, t.GetTs()); err != nil {
 		return err
 	}
 	// alter alias is atomic enough.
 	return t.core.meta.AlterAlias(ctx, t.Req.GetDbName(), t.Req.GetAlias(), t.Req.GetCollectionName(), t.GetTs())
 }
+
+// executeAndDropPrevious repoints the alias and marks the collection it pointed to as dropping in one metadata
+// transaction, then the previous collection is cleaned up like dropping collection.
+func (t *alterAliasTask) executeAndDropPrevious(ctx context.Context) error {
+	prevColl, err := t.core.meta.GetCollectionByName(ctx, t.Req.GetDbName(), t.Req.GetAlias(), typeutil.MaxTimestamp)
+	if err != nil {
+		return err
+	}
+	aliases := t.core.meta.ListAliasesByID(prevColl.CollectionID)
+
+	ts := t.GetTs()
+	redoTask := newBaseRedoTask(t.core.stepExecutor)
+	redoTask.AddSyncStep(&expireCacheStep{
+		baseStep:        baseStep{core: t.core},
+		dbName:          t.Req.GetDbName(),
+		collectionNames: append(aliases, prevColl.Name),
+		collectionID:    prevColl.CollectionID,
+		ts:              ts,
+		opts:            []expireCacheOpt{expireCacheWithDropFlag()},
+	})
+	redoTask.AddSyncStep(&alterAliasAndDropCollectionStep{
+		baseStep:       baseStep{core: t.core},
+		dbName:         t.Req.GetDbName(),
+		alias:          t.Req.GetAlias(),
+		collectionName: t.Req.GetCollectionName(),
+		ts:             ts,
+	})
+
+	addDropCollectionAsyncSteps(t.core, redoTask, prevColl, t.Req.GetBase().GetReplicateInfo().GetIsReplicate(), ts)
+
+	return redoTask.Execute(ctx)
+}
@@ -20,10 +20,15 @@ import (
 	"context"
 	"testing"
 
+	"github.com/cockroachdb/errors"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
 
 	"github.com/milvus-io/milvus-proto/go-api/v2/commonpb"
 	"github.com/milvus-io/milvus-proto/go-api/v2/milvuspb"
+	"github.com/milvus-io/milvus/internal/metastore/model"
+	mockrootcoord "github.com/milvus-io/milvus/internal/rootcoord/mocks"
+	"github.com/milvus-io/milvus/pkg/common"
 )
 
 func Test_alterAliasTask_Prepare(t *testing.T) {
@@ -67,3 +72,50 @@ func Test_alterAliasTask_Execute(t *testing.T) {
 		assert.Error(t, err)
 	})
 }
+
+func Test_alterAliasTask_ExecuteAndDropPrevious(t *testing.T) {
+	newTask := func(core *Core) *alterAliasTask {
+		return &alterAliasTask{
+			baseTask: newBaseTask(context.Background(), core),
+			Req: &milvuspb.AlterAliasRequest{
+				Base: &commonpb.MsgBase{
+					MsgType:    commonpb.MsgType_AlterAlias,
+					Properties: map[string]string{common.AliasDropPreviousKey: "true"},
+				},
+				Alias:          "prod",
+				CollectionName: "green",
+			},
+		}
+	}
+
+	t.Run("alias not exist", func(t *testing.T) {
+		meta := mockrootcoord.NewIMetaTable(t)
+		meta.EXPECT().GetCollectionByName(mock.Anything, mock.Anything, "prod", mock.Anything).
+			Return(nil, errors.New("error mock GetCollectionByName"))
+		core := newTestCore(withValidProxyManager(), withMeta(meta))
+		err := newTask(core).Execute(context.Background())
+		assert.Error(t, err)
+	})
+
+	t.Run("failed to expire cache", func(t *testing.T) {
+		meta := mockrootcoord.NewIMetaTable(t)
+		meta.EXPECT().GetCollectionByName(mock.Anything, mock.Anything, "prod", mock.Anything).
+			Return(&model.Collection{Name: "blue", CollectionID: 100}, nil)
+		meta.EXPECT().ListAliasesByID(int64(100)).Return([]string{"prod"})
+		core := newTestCore(withInvalidProxyManager(), withMeta(meta))
+		err := newTask(core).Execute(context.Background())
+		assert.Error(t, err)
+	})
+
+	t.Run("failed to alter alias", func(t *testing.T) {
+		meta := mockrootcoord.NewIMetaTable(t)
+		meta.EXPECT().GetCollectionByName(mock.Anything, mock.Anything, "prod", mock.Anything).
+			Return(&model.Collection{Name: "blue", CollectionID: 100}, nil)
+		meta.EXPECT().ListAliasesByID(int64(100)).Return([]string{"prod"})
+		meta.EXPECT().AlterAliasAndDropCollection(mock.Anything, mock.Anything, "prod", "green", mock.Anything).
+			Return(errors.New("error mock AlterAliasAndDropCollection"))
+		core := newTestCore(withValidProxyManager(), withMeta(meta))
+		err := newTask(core).Execute(context.Background())
+		assert.Error(t, err)
+	})
+}
@@ -0,0 +1,251 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package rootcoord
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+
+	"github.com/golang/protobuf/proto"
+	"github.com/samber/lo"
+	"go.uber.org/zap"
+
+	"github.com/milvus-io/milvus-proto/go-api/v2/commonpb"
+	"github.com/milvus-io/milvus-proto/go-api/v2/msgpb"
+	"github.com/milvus-io/milvus-proto/go-api/v2/schemapb"
+	"github.com/milvus-io/milvus/internal/metastore/model"
+	"github.com/milvus-io/milvus/pkg/common"
+	"github.com/milvus-io/milvus/pkg/log"
+	ms "github.com/milvus-io/milvus/pkg/mq/msgstream"
+	"github.com/milvus-io/milvus/pkg/util/commonpbutil"
+	"github.com/milvus-io/milvus/pkg/util/merr"
+	"github.com/milvus-io/milvus/pkg/util/typeutil"
+)
+
+// extractShardsNum pops the shards num from the properties to alter,
+// which is not persisted as property since the collection meta records it.
+func extractShardsNum(props []*commonpb.KeyValuePair) (int32, []*commonpb.KeyValuePair, bool, error) {
+	var (
+		shardsNum int32
+		found     bool
+	)
+	rest := make([]*commonpb.KeyValuePair, 0, len(props))
+	for _, prop := range props {
+		if prop.GetKey() != common.CollectionShardsNumKey {
+			rest = append(rest, prop)
+			continue
+		}
+		num, err := strconv.ParseInt(prop.GetValue(), 10, 32)
+		if err != nil {
+			return 0, nil, false, merr.WrapErrParameterInvalid("integer", prop.GetValue(), "invalid shards num")
+		}
+		shardsNum, found = int32(num), true
+	}
+	if !found {
+		return 0, props, false, nil
+	}
+
+	if shardsNum <= 0 {
+		return 0, nil, false, merr.WrapErrParameterInvalidMsg("shards num (%d) must be positive", shardsNum)
+	}
+	cfgMaxShardNum := Params.RootCoordCfg.DmlChannelNum.GetAsInt32()
+	if shardsNum > cfgMaxShardNum {
+		return 0, nil, false, fmt.Errorf("shard num (%d) exceeds max configuration (%d)", shardsNum, cfgMaxShardNum)
+	}
+	cfgShardLimit := Params.ProxyCfg.MaxShardNum.GetAsInt32()
+	if shardsNum > cfgShardLimit {
+		return 0, nil, false, fmt.Errorf("shard num (%d) exceeds system limit (%d)", shardsNum, cfgShardLimit)
+	}
+	return shardsNum, rest, true, nil
+}
+
+// scaleChannels changes the number of virtual channels of the collection,
+// the new channels are appended, and the channels to remove are the last ones, which must be drained.
+func (a *alterCollectionTask) scaleChannels(ctx context.Context, oldColl *model.Collection, newColl *model.Collection, shardsNum int32) error {
+	// the primary keys may not hash to the channels they were inserted any longer
+	updateCollectionProperties(newColl, []*commonpb.KeyValuePair{{Key: common.CollectionShardsScaledKey, Value: "true"}})
+	newColl.ShardsNum = shardsNum
+
+	log.Ctx(ctx).Info("scale collection channels",
+		zap.Int64("collectionID", oldColl.CollectionID),
+		zap.Int("oldShardsNum", len(oldColl.VirtualChannelNames)),
+		zap.Int32("newShardsNum", shardsNum))
+	if int(shardsNum) > len(oldColl.VirtualChannelNames) {
+		return a.addChannels(ctx, oldColl, newColl, int(shardsNum)-len(oldColl.VirtualChannelNames))
+	}
+	return a.removeChannels(ctx, oldColl, newColl, int(shardsNum))
+}
+
+// assignNewChannels picks the physical channels not used by the collection yet,
+// to avoid subscribing the same physical channel twice for a collection.
+func (a *alterCollectionTask) assignNewChannels(coll *model.Collection, num int) (collectionChannels, error) {
+	used := typeutil.NewSet(coll.PhysicalChannelNames...)
+	candidates := a.core.chanTimeTick.getDmlChannelNames(num + used.Len())
+	pChannels := lo.Filter(candidates, func(ch string, _ int) bool {
+		return !used.Contain(ch)
+	})
+	if len(pChannels) < num {
+		return collectionChannels{}, fmt.Errorf("no enough channels, want: %d, got: %d", num, len(pChannels))
+	}
+	pChannels = pChannels[:num]
+
+	vChannels := make([]string, num)
+	for i := range pChannels {
+		vChannels[i] = fmt.Sprintf("%s_%dv%d", pChannels[i], coll.CollectionID, len(coll.VirtualChannelNames)+i)
+	}
+	return collectionChannels{
+		virtualChannels:  vChannels,
+		physicalChannels: pChannels,
+	}, nil
+}
+
+func genAddChannelsMsg(ctx context.Context, coll *model.Collection, schema *schemapb.CollectionSchema, channels collectionChannels, ts Timestamp) *ms.MsgPack {
+	// error won't happen here.
+	marshaledSchema, _ := proto.Marshal(schema)
+	msg := &ms.CreateCollectionMsg{
+		BaseMsg: ms.BaseMsg{
+			Ctx:            ctx,
+			BeginTimestamp: ts,
+			EndTimestamp:   ts,
+			HashValues:     []uint32{0},
+		},
+		CreateCollectionRequest: msgpb.CreateCollectionRequest{
+			Base: commonpbutil.NewMsgBase(
+				commonpbutil.WithMsgType(commonpb.MsgType_CreateCollection),
+				commonpbutil.WithTimeStamp(ts),
+			),
+			CollectionID:         coll.CollectionID,
+			PartitionIDs:         lo.Map(coll.Partitions, func(p *model.Partition, _ int) int64 { return p.PartitionID }),
+			Schema:               marshaledSchema,
+			VirtualChannelNames:  channels.virtualChannels,
+			PhysicalChannelNames: channels.physicalChannels,
+		},
+	}
+	return &ms.MsgPack{Msgs: []ms.TsMsg{msg}}
+}
+
+func (a *alterCollectionTask) addChannels(ctx context.Context, oldColl *model.Collection, newColl *model.Collection, num int) error {
+	channels, err := a.assignNewChannels(oldColl, num)
+	if err != nil {
+		return err
+	}
+
+	ts := a.GetTs()
+	schema := &schemapb.CollectionSchema{
+		Name:               oldColl.Name,
+		Description:        oldColl.Description,
+		AutoID:             oldColl.AutoID,
+		Fields:             model.MarshalFieldModels(oldColl.Fields),
+		EnableDynamicField: oldColl.EnableDynamicField,
+	}
+	a.core.chanTimeTick.addDmlChannels(channels.physicalChannels...)
+	startPositions, err := a.core.chanTimeTick.broadcastMarkDmlChannels(channels.physicalChannels,
+		genAddChannelsMsg(ctx, oldColl, schema, channels, ts))
+	if err != nil {
+		// ugly here, since we must get start positions first.
+		a.core.chanTimeTick.removeDmlChannels(channels.physicalChannels...)
+		return err
+	}
+
+	newColl.VirtualChannelNames = append(newColl.VirtualChannelNames, channels.virtualChannels...)
+	newColl.PhysicalChannelNames = append(newColl.PhysicalChannelNames, channels.physicalChannels...)
+	newColl.StartPositions = append(newColl.StartPositions, toKeyDataPairs(startPositions)...)
+
+	undoTask := newBaseUndoTask(a.core.stepExecutor)
+	undoTask.AddStep(&nullStep{}, &removeDmlChannelsStep{
+		baseStep:  baseStep{core: a.core},
+		pChannels: channels.physicalChannels,
+	}) // remove dml channels if any error occurs.
+	// the channels watched but not in the collection meta are removed by DataCoord on the next alteration.
+	undoTask.AddStep(&watchChannelsStep{
+		baseStep: baseStep{core: a.core},
+		info: &watchInfo{
+			ts:             ts,
+			collectionID:   oldColl.CollectionID,
+			vChannels:      channels.virtualChannels,
+			startPositions: toKeyDataPairs(startPositions),
+			schema:         schema,
+		},
+	}, &nullStep{})
+	undoTask.AddStep(&AlterCollectionStep{
+		baseStep: baseStep{core: a.core},
+		oldColl:  oldColl,
+		newColl:  newColl,
+		ts:       ts,
+	}, &nullStep{})
+	undoTask.AddStep(&expireCacheStep{
+		baseStep:        baseStep{core: a.core},
+		dbName:          a.Req.GetDbName(),
+		collectionNames: []string{oldColl.Name},
+		collectionID:    oldColl.CollectionID,
+		ts:              ts,
+		opts:            []expireCacheOpt{expireCacheWithChannelsChangedFlag()},
+	}, &nullStep{})
+	a.Req.CollectionID = oldColl.CollectionID
+	undoTask.AddStep(&BroadcastAlteredCollectionStep{
+		baseStep: baseStep{core: a.core},
+		req:      a.Req,
+		core:     a.core,
+	}, &nullStep{})
+	return undoTask.Execute(ctx)
+}
+
+func (a *alterCollectionTask) removeChannels(ctx context.Context, oldColl *model.Collection, newColl *model.Collection, keep int) error {
+	removedVChannels := oldColl.VirtualChannelNames[keep:]
+	removedPChannels := oldColl.PhysicalChannelNames[keep:]
+	if err := a.core.broker.CheckChannelsDrained(ctx, oldColl.CollectionID, removedVChannels); err != nil {
+		log.Ctx(ctx).Warn("failed to remove collection channels", zap.Strings("channels", removedVChannels), zap.Error(err))
+		return err
+	}
+
+	removed := typeutil.NewSet(removedPChannels...)
+	newColl.VirtualChannelNames = newColl.VirtualChannelNames[:keep]
+	newColl.PhysicalChannelNames = newColl.PhysicalChannelNames[:keep]
+	newColl.StartPositions = lo.Filter(newColl.StartPositions, func(pos *commonpb.KeyDataPair, _ int) bool {
+		return !removed.Contain(pos.GetKey())
+	})
+
+	ts := a.GetTs()
+	redoTask := newBaseRedoTask(a.core.stepExecutor)
+	redoTask.AddSyncStep(&AlterCollectionStep{
+		baseStep: baseStep{core: a.core},
+		oldColl:  oldColl,
+		newColl:  newColl,
+		ts:       ts,
+	})
+	redoTask.AddSyncStep(&expireCacheStep{
+		baseStep:        baseStep{core: a.core},
+		dbName:          a.Req.GetDbName(),
+		collectionNames: []string{oldColl.Name},
+		collectionID:    oldColl.CollectionID,
+		ts:              ts,
+		opts:            []expireCacheOpt{expireCacheWithChannelsChangedFlag()},
+	})
+	// DataCoord moves the flushed segments of the removed channels to the remaining ones, then unwatches them.
+	a.Req.CollectionID = oldColl.CollectionID
+	redoTask.AddSyncStep(&BroadcastAlteredCollectionStep{
+		baseStep: baseStep{core: a.core},
+		req:      a.Req,
+		core:     a.core,
+	})
+	redoTask.AddAsyncStep(&removeDmlChannelsStep{
+		baseStep:  baseStep{core: a.core},
+		pChannels: removedPChannels,
+	})
+	return redoTask.Execute(ctx)
+}
@@ -55,8 +55,16 @@ func (a *alterCollectionTask) Execute(ctx context.Context) error {
 		return err
 	}
 
+	shardsNum, props, hasShardsNum, err := extractShardsNum(a.Req.GetProperties())
+	if err != nil {
+		return err
+	}
+
 	newColl := oldColl.Clone()
-	updateCollectionProperties(newColl, a.Req.GetProperties())
+	updateCollectionProperties(newColl, props)
+	if hasShardsNum && int(shardsNum) != len(oldColl.VirtualChannelNames) {
+		return a.scaleChannels(ctx, oldColl, newColl, shardsNum)
+	}
 
 	ts := a.GetTs()
 	redoTask := newBaseRedoTask(a.core.stepExecutor)
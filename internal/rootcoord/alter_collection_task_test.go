@@ -222,3 +222,26 @@ func Test_alterCollectionTask_Execute(t *testing.T) {
 		})
 	})
 }
+
+func Test_extractShardsNum(t *testing.T) {
+	props := []*commonpb.KeyValuePair{
+		{Key: common.CollectionTTLConfigKey, Value: "3600"},
+		{Key: common.CollectionShardsNumKey, Value: "2"},
+	}
+	shardsNum, rest, found, err := extractShardsNum(props)
+	assert.NoError(t, err)
+	assert.True(t, found)
+	assert.EqualValues(t, 2, shardsNum)
+	assert.Len(t, rest, 1)
+	assert.Equal(t, common.CollectionTTLConfigKey, rest[0].GetKey())
+
+	_, rest, found, err = extractShardsNum(props[:1])
+	assert.NoError(t, err)
+	assert.False(t, found)
+	assert.Len(t, rest, 1)
+
+	for _, value := range []string{"abc", "0", "-1", "100000"} {
+		_, _, _, err = extractShardsNum([]*commonpb.KeyValuePair{{Key: common.CollectionShardsNumKey, Value: value}})
+		assert.Error(t, err, value)
+	}
+}
@@ -0,0 +1,161 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package rootcoord
+
+import (
+	"context"
+	"time"
+
+	"go.uber.org/zap"
+
+	"github.com/milvus-io/milvus/internal/proto/proxypb"
+	"github.com/milvus-io/milvus/internal/proto/rootcoordpb"
+	"github.com/milvus-io/milvus/pkg/log"
+	"github.com/milvus-io/milvus/pkg/util"
+	"github.com/milvus-io/milvus/pkg/util/commonpbutil"
+	"github.com/milvus-io/milvus/pkg/util/crypto"
+	"github.com/milvus-io/milvus/pkg/util/merr"
+)
+
+// createAPIKey issues an api key for the user, the returned token is the only chance to get the secret.
+// The key never expires if the ttl is zero.
+func (c *Core) createAPIKey(ctx context.Context, username string, scopes []string, ttl time.Duration) (*crypto.APIKey, string, error) {
+	if err := crypto.ValidateAPIKeyScopes(scopes); err != nil {
+		return nil, "", merr.WrapErrParameterInvalidMsg("%s", err.Error())
+	}
+	if ttl < 0 {
+		return nil, "", merr.WrapErrParameterInvalidMsg("the ttl of api key should not be negative")
+	}
+	if maxTTL := Params.CommonCfg.APIKeyMaxTTL.GetAsDuration(time.Second); maxTTL > 0 && (ttl == 0 || ttl > maxTTL) {
+		return nil, "", merr.WrapErrParameterInvalidMsg("the ttl of api key should be in (0, %s]", maxTTL)
+	}
+	if _, err := c.meta.GetCredential(username); err != nil {
+		return nil, "", merr.WrapErrParameterInvalidMsg("user %s not found", username)
+	}
+	keys, err := c.meta.ListAPIKeys(ctx, username)
+	if err != nil {
+		return nil, "", err
+	}
+	if maxKeys := Params.CommonCfg.APIKeyMaxKeysPerUser.GetAsInt(); len(keys) >= maxKeys {
+		return nil, "", merr.WrapErrParameterInvalidMsg("user %s already has %d api keys, revoke the unused ones first", username, len(keys))
+	}
+
+	id, err := crypto.NewAPIKeyID()
+	if err != nil {
+		return nil, "", err
+	}
+	secret, hashedSecret, err := crypto.NewAPIKeySecret()
+	if err != nil {
+		return nil, "", err
+	}
+	now := time.Now()
+	key := &crypto.APIKey{
+		ID:           id,
+		Username:     username,
+		HashedSecret: hashedSecret,
+		Scopes:       scopes,
+		CreatedTime:  now.Unix(),
+	}
+	if ttl > 0 {
+		key.ExpireTime = now.Add(ttl).Unix()
+	}
+	if err := c.meta.SaveAPIKey(ctx, key); err != nil {
+		log.Ctx(ctx).Warn("failed to create api key", zap.String("username", username), zap.Error(err))
+		return nil, "", err
+	}
+	log.Ctx(ctx).Info("api key created", zap.String("id", id), zap.String("username", username), zap.Strings("scopes", scopes))
+	return key, crypto.FormatAPIKey(id, secret), nil
+}
+
+// rotateAPIKey replaces the secret of the api key, the previous secret is invalid immediately.
+func (c *Core) rotateAPIKey(ctx context.Context, id string) (*crypto.APIKey, string, error) {
+	key, err := c.meta.GetAPIKey(ctx, id)
+	if err != nil {
+		return nil, "", merr.WrapErrParameterInvalidMsg("api key %s not found", id)
+	}
+	secret, hashedSecret, err := crypto.NewAPIKeySecret()
+	if err != nil {
+		return nil, "", err
+	}
+	key.HashedSecret = hashedSecret
+	key.RotatedTime = time.Now().Unix()
+	if err := c.meta.SaveAPIKey(ctx, key); err != nil {
+		log.Ctx(ctx).Warn("failed to rotate api key", zap.String("id", id), zap.Error(err))
+		return nil, "", err
+	}
+	if err := c.ExpireAPIKeyCache(ctx, id); err != nil {
+		log.Ctx(ctx).Warn("failed to expire the api key cache of proxies", zap.String("id", id), zap.Error(err))
+		return nil, "", err
+	}
+	log.Ctx(ctx).Info("api key rotated", zap.String("id", id), zap.String("username", key.Username))
+	return key, crypto.FormatAPIKey(id, secret), nil
+}
+
+// revokeAPIKey removes the api key.
+func (c *Core) revokeAPIKey(ctx context.Context, id string) error {
+	if err := c.meta.DropAPIKey(ctx, id); err != nil {
+		log.Ctx(ctx).Warn("failed to revoke api key", zap.String("id", id), zap.Error(err))
+		return err
+	}
+	if err := c.ExpireAPIKeyCache(ctx, id); err != nil {
+		log.Ctx(ctx).Warn("failed to expire the api key cache of proxies", zap.String("id", id), zap.Error(err))
+		return err
+	}
+	log.Ctx(ctx).Info("api key revoked", zap.String("id", id))
+	return nil
+}
+
+// newAPIKeyInfo converts the api key, the hashed secret is never exposed.
+func newAPIKeyInfo(key *crypto.APIKey) *rootcoordpb.APIKeyInfo {
+	return &rootcoordpb.APIKeyInfo{
+		Id:          key.ID,
+		Username:    key.Username,
+		Scopes:      key.Scopes,
+		ExpireTime:  key.ExpireTime,
+		CreatedTime: key.CreatedTime,
+		RotatedTime: key.RotatedTime,
+	}
+}
+
+// ExpireAPIKeyCache invalidates the api key cached by proxies.
+func (c *Core) ExpireAPIKeyCache(ctx context.Context, id string) error {
+	req := proxypb.InvalidateCredCacheRequest{
+		Base: commonpbutil.NewMsgBase(
+			commonpbutil.WithMsgType(0), // TODO, msg type
+			commonpbutil.WithMsgID(0),   // TODO, msg id
+			commonpbutil.WithSourceID(c.session.ServerID),
+		),
+	}
+	req.Base.Properties = map[string]string{util.APIKeyIDKey: id}
+	return c.proxyClientManager.InvalidateCredentialCache(ctx, &req)
+}
+
+// getEncodedAPIKey returns the owner and the encoded api key, the key is treated as not found if the owner is gone.
+func (c *Core) getEncodedAPIKey(ctx context.Context, id string) (string, string, error) {
+	key, err := c.meta.GetAPIKey(ctx, id)
+	if err != nil {
+		return "", "", err
+	}
+	if _, err := c.meta.GetCredential(key.Username); err != nil {
+		return "", "", err
+	}
+	encoded, err := crypto.EncodeAPIKey(key)
+	if err != nil {
+		return "", "", err
+	}
+	return key.Username, encoded, nil
+}
@@ -0,0 +1,185 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package rootcoord
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/milvus-io/milvus-proto/go-api/v2/commonpb"
+	"github.com/milvus-io/milvus/internal/proto/internalpb"
+	"github.com/milvus-io/milvus/internal/proto/rootcoordpb"
+	"github.com/milvus-io/milvus/internal/types"
+	"github.com/milvus-io/milvus/pkg/util"
+	"github.com/milvus-io/milvus/pkg/util/crypto"
+	"github.com/milvus-io/milvus/pkg/util/merr"
+	"github.com/milvus-io/milvus/pkg/util/paramtable"
+)
+
+func TestCore_APIKey(t *testing.T) {
+	paramtable.Init()
+	ctx := context.Background()
+
+	createAPIKey := func(c *Core, username string, scopes []string, ttl time.Duration) (*rootcoordpb.OperateAPIKeyResponse, error) {
+		return c.OperateAPIKey(ctx, &rootcoordpb.OperateAPIKeyRequest{
+			OperateType: rootcoordpb.APIKeyOperateType_CreateAPIKey,
+			Username:    username,
+			Scopes:      scopes,
+			TtlSeconds:  int64(ttl / time.Second),
+		})
+	}
+
+	t.Run("not healthy", func(t *testing.T) {
+		c := newTestCore(withAbnormalCode())
+		resp, err := createAPIKey(c, "foo", []string{crypto.APIKeyScopeRead}, 0)
+		assert.Error(t, merr.CheckRPCCall(resp, err))
+		listResp, err := c.ListAPIKeys(ctx, &rootcoordpb.ListAPIKeysRequest{})
+		assert.Error(t, merr.CheckRPCCall(listResp, err))
+	})
+
+	keys := make(map[string]*crypto.APIKey)
+	meta := newMockMetaTable()
+	meta.GetCredentialFunc = func(username string) (*internalpb.CredentialInfo, error) {
+		if username != "foo" {
+			return nil, merr.ErrIoKeyNotFound
+		}
+		return &internalpb.CredentialInfo{Username: username}, nil
+	}
+	meta.SaveAPIKeyFunc = func(ctx context.Context, key *crypto.APIKey) error {
+		keys[key.ID] = key
+		return nil
+	}
+	meta.GetAPIKeyFunc = func(ctx context.Context, id string) (*crypto.APIKey, error) {
+		key, ok := keys[id]
+		if !ok {
+			return nil, merr.ErrIoKeyNotFound
+		}
+		return key, nil
+	}
+	meta.DropAPIKeyFunc = func(ctx context.Context, id string) error {
+		delete(keys, id)
+		return nil
+	}
+	meta.ListAPIKeysFunc = func(ctx context.Context, username string) ([]*crypto.APIKey, error) {
+		list := make([]*crypto.APIKey, 0, len(keys))
+		for _, key := range keys {
+			if username == "" || key.Username == username {
+				list = append(list, key)
+			}
+		}
+		return list, nil
+	}
+	c := newTestCore(withHealthyCode(), withMeta(meta))
+	c.proxyClientManager = &proxyClientManager{proxyClient: make(map[UniqueID]types.ProxyClient)}
+
+	t.Run("invalid operate type", func(t *testing.T) {
+		resp, err := c.OperateAPIKey(ctx, &rootcoordpb.OperateAPIKeyRequest{OperateType: 100})
+		assert.ErrorIs(t, merr.CheckRPCCall(resp, err), merr.ErrParameterInvalid)
+	})
+
+	t.Run("create", func(t *testing.T) {
+		resp, err := createAPIKey(c, "foo", []string{"unknown"}, 0)
+		assert.ErrorIs(t, merr.CheckRPCCall(resp, err), merr.ErrParameterInvalid)
+		resp, err = createAPIKey(c, "foo", []string{crypto.APIKeyScopeRead}, -time.Second)
+		assert.ErrorIs(t, merr.CheckRPCCall(resp, err), merr.ErrParameterInvalid)
+		resp, err = createAPIKey(c, "bar", []string{crypto.APIKeyScopeRead}, 0)
+		assert.ErrorIs(t, merr.CheckRPCCall(resp, err), merr.ErrParameterInvalid)
+
+		paramtable.Get().Save(Params.CommonCfg.APIKeyMaxTTL.Key, "3600")
+		resp, err = createAPIKey(c, "foo", []string{crypto.APIKeyScopeRead}, 0)
+		assert.ErrorIs(t, merr.CheckRPCCall(resp, err), merr.ErrParameterInvalid)
+		resp, err = createAPIKey(c, "foo", []string{crypto.APIKeyScopeRead}, 2*time.Hour)
+		assert.ErrorIs(t, merr.CheckRPCCall(resp, err), merr.ErrParameterInvalid)
+		paramtable.Get().Reset(Params.CommonCfg.APIKeyMaxTTL.Key)
+
+		resp, err = createAPIKey(c, "foo", []string{crypto.APIKeyScopeWrite}, time.Hour)
+		require.NoError(t, merr.CheckRPCCall(resp, err))
+		assert.Equal(t, "foo", resp.GetKey().GetUsername())
+		assert.Greater(t, resp.GetKey().GetExpireTime(), resp.GetKey().GetCreatedTime())
+		id, secret, ok := crypto.ParseAPIKey(resp.GetToken())
+		require.True(t, ok)
+		assert.Equal(t, resp.GetKey().GetId(), id)
+		assert.NoError(t, keys[id].Verify(secret, time.Now()))
+
+		paramtable.Get().Save(Params.CommonCfg.APIKeyMaxKeysPerUser.Key, "1")
+		defer paramtable.Get().Reset(Params.CommonCfg.APIKeyMaxKeysPerUser.Key)
+		resp, err = createAPIKey(c, "foo", []string{crypto.APIKeyScopeRead}, 0)
+		assert.ErrorIs(t, merr.CheckRPCCall(resp, err), merr.ErrParameterInvalid)
+	})
+
+	t.Run("list", func(t *testing.T) {
+		resp, err := c.ListAPIKeys(ctx, &rootcoordpb.ListAPIKeysRequest{Username: "foo"})
+		require.NoError(t, merr.CheckRPCCall(resp, err))
+		require.Len(t, resp.GetKeys(), 1)
+		assert.Equal(t, []string{crypto.APIKeyScopeWrite}, resp.GetKeys()[0].GetScopes())
+
+		resp, err = c.ListAPIKeys(ctx, &rootcoordpb.ListAPIKeysRequest{Username: "bar"})
+		require.NoError(t, merr.CheckRPCCall(resp, err))
+		assert.Empty(t, resp.GetKeys())
+	})
+
+	t.Run("get credential", func(t *testing.T) {
+		keyList, err := c.meta.ListAPIKeys(ctx, "foo")
+		require.NoError(t, err)
+		require.Len(t, keyList, 1)
+		id := keyList[0].ID
+
+		req := &rootcoordpb.GetCredentialRequest{Base: &commonpb.MsgBase{Properties: map[string]string{util.APIKeyIDKey: id}}}
+		resp, err := c.GetCredential(ctx, req)
+		require.NoError(t, merr.CheckRPCCall(resp, err))
+		assert.Equal(t, "foo", resp.GetUsername())
+		key, err := crypto.DecodeAPIKey(resp.GetPassword())
+		require.NoError(t, err)
+		assert.Equal(t, keyList[0], key)
+
+		req.Base.Properties[util.APIKeyIDKey] = "unknown"
+		resp, err = c.GetCredential(ctx, req)
+		assert.Error(t, merr.CheckRPCCall(resp, err))
+
+		// the key is not found once the owner is gone
+		keys[id].Username = "bar"
+		req.Base.Properties[util.APIKeyIDKey] = id
+		resp, err = c.GetCredential(ctx, req)
+		assert.Error(t, merr.CheckRPCCall(resp, err))
+		keys[id].Username = "foo"
+	})
+
+	t.Run("rotate and revoke", func(t *testing.T) {
+		keyList, err := c.meta.ListAPIKeys(ctx, "foo")
+		require.NoError(t, err)
+		id := keyList[0].ID
+		hashedSecret := keyList[0].HashedSecret
+
+		resp, err := c.OperateAPIKey(ctx, &rootcoordpb.OperateAPIKeyRequest{OperateType: rootcoordpb.APIKeyOperateType_RotateAPIKey, Id: "unknown"})
+		assert.ErrorIs(t, merr.CheckRPCCall(resp, err), merr.ErrParameterInvalid)
+		resp, err = c.OperateAPIKey(ctx, &rootcoordpb.OperateAPIKeyRequest{OperateType: rootcoordpb.APIKeyOperateType_RotateAPIKey, Id: id})
+		require.NoError(t, merr.CheckRPCCall(resp, err))
+		assert.NotEqual(t, hashedSecret, keys[id].HashedSecret)
+		assert.NotZero(t, resp.GetKey().GetRotatedTime())
+		_, secret, _ := crypto.ParseAPIKey(resp.GetToken())
+		assert.NoError(t, keys[id].Verify(secret, time.Now()))
+
+		resp, err = c.OperateAPIKey(ctx, &rootcoordpb.OperateAPIKeyRequest{OperateType: rootcoordpb.APIKeyOperateType_RevokeAPIKey, Id: id})
+		require.NoError(t, merr.CheckRPCCall(resp, err))
+		assert.Empty(t, resp.GetToken())
+		assert.Empty(t, keys)
+	})
+}
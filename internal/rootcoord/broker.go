@@ -65,6 +65,7 @@ type Broker interface {
 	DescribeIndex(ctx context.Context, colID UniqueID) (*indexpb.DescribeIndexResponse, error)
 
 	BroadcastAlteredCollection(ctx context.Context, req *milvuspb.AlterCollectionRequest) error
+	CheckChannelsDrained(ctx context.Context, collectionID UniqueID, vChannels []string) error
 }
 
 type ServerBroker struct {
@@ -188,6 +189,31 @@ func (b *ServerBroker) UnwatchChannels(ctx context.Context, info *watchInfo) err
 	return nil
 }
 
+// CheckChannelsDrained returns error if any of the vChannels still has unflushed segments.
+func (b *ServerBroker) CheckChannelsDrained(ctx context.Context, collectionID UniqueID, vChannels []string) error {
+	resp, err := b.s.dataCoord.GetRecoveryInfoV2(ctx, &datapb.GetRecoveryInfoRequestV2{
+		Base: commonpbutil.NewMsgBase(
+			commonpbutil.WithSourceID(b.s.session.ServerID),
+		),
+		CollectionID: collectionID,
+	})
+	if err := merr.CheckRPCCall(resp, err); err != nil {
+		return err
+	}
+
+	toCheck := typeutil.NewSet(vChannels...)
+	for _, channel := range resp.GetChannels() {
+		if !toCheck.Contain(channel.GetChannelName()) {
+			continue
+		}
+		if len(channel.GetUnflushedSegmentIds()) > 0 {
+			return merr.WrapErrParameterInvalidMsg("channel %s is not drained, %d segments are not flushed yet, flush the collection and stop writing before removing the channel",
+				channel.GetChannelName(), len(channel.GetUnflushedSegmentIds()))
+		}
+	}
+	return nil
+}
+
 func (b *ServerBroker) Flush(ctx context.Context, cID int64, segIDs []int64) error {
 	resp, err := b.s.dataCoord.Flush(ctx, &datapb.FlushRequest{
 		Base: commonpbutil.NewMsgBase(
@@ -0,0 +1,256 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package rootcoord
+
+import (
+	"context"
+	"encoding/json"
+	"strconv"
+	"time"
+
+	"github.com/golang/protobuf/proto"
+
+	"github.com/milvus-io/milvus-proto/go-api/v2/commonpb"
+	"github.com/milvus-io/milvus-proto/go-api/v2/milvuspb"
+	"github.com/milvus-io/milvus-proto/go-api/v2/schemapb"
+	"github.com/milvus-io/milvus/internal/metastore/model"
+	"github.com/milvus-io/milvus/internal/proto/rootcoordpb"
+	"github.com/milvus-io/milvus/pkg/common"
+	"github.com/milvus-io/milvus/pkg/util/commonpbutil"
+	"github.com/milvus-io/milvus/pkg/util/funcutil"
+	"github.com/milvus-io/milvus/pkg/util/indexparamcheck"
+	"github.com/milvus-io/milvus/pkg/util/merr"
+	"github.com/milvus-io/milvus/pkg/util/typeutil"
+)
+
+func isValidEntityName(name string, allowHyphen bool) bool {
+	if name == "" || len(name) > Params.ProxyCfg.MaxNameLength.GetAsInt() {
+		return false
+	}
+	if c := name[0]; c != '_' && !isAlphaChar(c) {
+		return false
+	}
+	for i := 1; i < len(name); i++ {
+		c := name[i]
+		if c != '_' && !isAlphaChar(c) && !(c >= '0' && c <= '9') && !(allowHyphen && c == '-') {
+			return false
+		}
+	}
+	return true
+}
+
+func isAlphaChar(c byte) bool {
+	return (c >= 'a' && c <= 'z') || (c >= 'A' && c <= 'Z')
+}
+
+// validateCollectionTemplate checks the template strictly, since the collections created from it bypass the checks of proxy.
+func validateCollectionTemplate(template *model.CollectionTemplate) error {
+	if !isValidEntityName(template.Name, true) {
+		return merr.WrapErrParameterInvalidMsg("invalid collection template name %s, only numbers, letters, underscores and hyphens are allowed", template.Name)
+	}
+	schema := template.Schema
+	if len(schema.GetFields()) == 0 {
+		return merr.WrapErrParameterInvalidMsg("the schema of collection template has no field")
+	}
+	if hasSystemFields(schema, []string{RowIDFieldName, TimeStampFieldName, MetaFieldName}) {
+		return merr.WrapErrParameterInvalidMsg("the schema of collection template contains system field")
+	}
+	if err := checkDefaultValue(schema); err != nil {
+		return err
+	}
+
+	fields := make(map[string]*schemapb.FieldSchema, len(schema.GetFields()))
+	var primaryKeys, vectors int
+	for _, field := range schema.GetFields() {
+		if !isValidEntityName(field.GetName(), false) {
+			return merr.WrapErrParameterInvalidMsg("invalid field name %s", field.GetName())
+		}
+		if _, ok := fields[field.GetName()]; ok {
+			return merr.WrapErrParameterInvalidMsg("duplicated field name %s", field.GetName())
+		}
+		fields[field.GetName()] = field
+		if _, ok := schemapb.DataType_name[int32(field.GetDataType())]; !ok || field.GetDataType() == schemapb.DataType_None {
+			return merr.WrapErrParameterInvalidMsg("invalid data type of field %s", field.GetName())
+		}
+
+		if field.GetIsPrimaryKey() {
+			primaryKeys++
+			if field.GetDataType() != schemapb.DataType_Int64 && field.GetDataType() != schemapb.DataType_VarChar {
+				return merr.WrapErrParameterInvalidMsg("the data type of primary key %s should be Int64 or VarChar", field.GetName())
+			}
+		}
+		if typeutil.IsVectorType(field.GetDataType()) {
+			vectors++
+			if dim, err := typeutil.GetDim(field); err != nil || dim <= 0 {
+				return merr.WrapErrParameterInvalidMsg("invalid dim of vector field %s", field.GetName())
+			}
+		}
+		if field.GetDataType() == schemapb.DataType_VarChar {
+			maxLength, err := funcutil.GetAttrByKeyFromRepeatedKV(common.MaxLengthKey, field.GetTypeParams())
+			if err != nil {
+				return merr.WrapErrParameterInvalidMsg("invalid max length of varchar field %s", field.GetName())
+			}
+			if length, err := strconv.ParseInt(maxLength, 10, 64); err != nil || length <= 0 {
+				return merr.WrapErrParameterInvalidMsg("invalid max length of varchar field %s", field.GetName())
+			}
+		}
+	}
+	if primaryKeys != 1 {
+		return merr.WrapErrParameterInvalidMsg("the schema of collection template should have exactly one primary key")
+	}
+	if vectors == 0 {
+		return merr.WrapErrParameterInvalidMsg("the schema of collection template should have at least one vector field")
+	}
+
+	if template.ShardsNum < 0 || template.ShardsNum > Params.RootCoordCfg.DmlChannelNum.GetAsInt32() ||
+		template.ShardsNum > Params.ProxyCfg.MaxShardNum.GetAsInt32() {
+		return merr.WrapErrParameterInvalidMsg("invalid shards num %d of collection template", template.ShardsNum)
+	}
+	if _, ok := commonpb.ConsistencyLevel_name[int32(template.ConsistencyLevel)]; !ok {
+		return merr.WrapErrParameterInvalidMsg("invalid consistency level %d of collection template", template.ConsistencyLevel)
+	}
+	for _, prop := range template.Properties {
+		if prop.GetKey() == common.CollectionTemplateKey || prop.GetKey() == common.CollectionAutoIndexPoliciesKey {
+			return merr.WrapErrParameterInvalidMsg("the property %s is reserved", prop.GetKey())
+		}
+	}
+
+	indexedFields := typeutil.NewSet[string]()
+	for _, preset := range template.IndexPresets {
+		field, ok := fields[preset.FieldName]
+		if !ok || !typeutil.IsVectorType(field.GetDataType()) {
+			return merr.WrapErrParameterInvalidMsg("the field %s of index preset is not a vector field of the schema", preset.FieldName)
+		}
+		if indexedFields.Contain(preset.FieldName) {
+			return merr.WrapErrParameterInvalidMsg("duplicated index presets of field %s", preset.FieldName)
+		}
+		indexedFields.Insert(preset.FieldName)
+
+		checker, err := indexparamcheck.GetIndexCheckerMgrInstance().GetChecker(preset.IndexType)
+		if err != nil {
+			return merr.WrapErrParameterInvalidMsg("invalid index type %s of field %s", preset.IndexType, preset.FieldName)
+		}
+		if err := checker.CheckValidDataType(field.GetDataType()); err != nil {
+			return merr.WrapErrParameterInvalidMsg(err.Error())
+		}
+		params := make(map[string]string, len(preset.Params)+3)
+		for k, v := range preset.Params {
+			params[k] = v
+		}
+		for _, kv := range field.GetTypeParams() {
+			params[kv.GetKey()] = kv.GetValue()
+		}
+		params[common.IndexTypeKey] = preset.IndexType
+		params[common.MetricTypeKey] = preset.MetricType
+		if err := checker.StaticCheck(params); err != nil {
+			return merr.WrapErrParameterInvalidMsg(err.Error())
+		}
+	}
+	return nil
+}
+
+// newCreateCollectionRequestFromTemplate generates the request to create the collection with the schema, properties of the template,
+// the index presets are passed to DataCoord as the auto index policies of the collection.
+func newCreateCollectionRequestFromTemplate(template *model.CollectionTemplate, dbName string, collectionName string) (*milvuspb.CreateCollectionRequest, error) {
+	if !isValidEntityName(collectionName, false) {
+		return nil, merr.WrapErrParameterInvalidMsg("invalid collection name %s", collectionName)
+	}
+	schema := proto.Clone(template.Schema).(*schemapb.CollectionSchema)
+	schema.Name = collectionName
+	marshaledSchema, err := proto.Marshal(schema)
+	if err != nil {
+		return nil, err
+	}
+
+	properties := common.CloneKeyValuePairs(template.Properties)
+	properties = append(properties, &commonpb.KeyValuePair{Key: common.CollectionTemplateKey, Value: template.Name})
+	if len(template.IndexPresets) > 0 {
+		policies, err := json.Marshal(template.IndexPresets)
+		if err != nil {
+			return nil, err
+		}
+		properties = append(properties,
+			&commonpb.KeyValuePair{Key: common.CollectionAutoIndexPoliciesKey, Value: string(policies)},
+			&commonpb.KeyValuePair{Key: common.CollectionAutoIndexOnSealKey, Value: "true"})
+	}
+
+	return &milvuspb.CreateCollectionRequest{
+		Base:             commonpbutil.NewMsgBase(commonpbutil.WithMsgType(commonpb.MsgType_CreateCollection)),
+		DbName:           dbName,
+		CollectionName:   collectionName,
+		Schema:           marshaledSchema,
+		ShardsNum:        template.ShardsNum,
+		ConsistencyLevel: template.ConsistencyLevel,
+		Properties:       properties,
+	}, nil
+}
+
+// newCollectionTemplateModel converts the collection template in the request to the model.
+func newCollectionTemplateModel(template *rootcoordpb.CollectionTemplate) (*model.CollectionTemplate, error) {
+	schema := &schemapb.CollectionSchema{}
+	if err := proto.Unmarshal(template.GetSchema(), schema); err != nil {
+		return nil, merr.WrapErrParameterInvalidMsg("invalid schema of collection template: %s", err.Error())
+	}
+	presets := make([]*model.IndexPreset, 0, len(template.GetIndexPresets()))
+	for _, preset := range template.GetIndexPresets() {
+		presets = append(presets, &model.IndexPreset{
+			FieldName:  preset.GetFieldName(),
+			IndexType:  preset.GetIndexType(),
+			MetricType: preset.GetMetricType(),
+			Params:     funcutil.KeyValuePair2Map(preset.GetParams()),
+		})
+	}
+	return &model.CollectionTemplate{
+		Name:             template.GetName(),
+		Description:      template.GetDescription(),
+		Schema:           schema,
+		ShardsNum:        template.GetShardsNum(),
+		ConsistencyLevel: template.GetConsistencyLevel(),
+		Properties:       template.GetProperties(),
+		IndexPresets:     presets,
+	}, nil
+}
+
+// createCollectionTemplate validates and saves the collection template.
+func (c *Core) createCollectionTemplate(ctx context.Context, template *model.CollectionTemplate) error {
+	if err := validateCollectionTemplate(template); err != nil {
+		return err
+	}
+	template.CreatedTime = uint64(time.Now().UnixNano())
+	return c.meta.CreateCollectionTemplate(ctx, template)
+}
+
+// ListCollectionTemplates lists all the collection templates.
+func (c *Core) ListCollectionTemplates(ctx context.Context) ([]*model.CollectionTemplate, error) {
+	if err := merr.CheckHealthy(c.GetStateCode()); err != nil {
+		return nil, err
+	}
+	return c.meta.ListCollectionTemplates(ctx)
+}
+
+// createCollectionFromTemplate creates the collection with the schema, indexes and properties of the template.
+func (c *Core) createCollectionFromTemplate(ctx context.Context, templateName string, dbName string, collectionName string) error {
+	template, err := c.meta.GetCollectionTemplate(ctx, templateName)
+	if err != nil {
+		return err
+	}
+	req, err := newCreateCollectionRequestFromTemplate(template, dbName, collectionName)
+	if err != nil {
+		return err
+	}
+	return merr.CheckRPCCall(c.CreateCollection(ctx, req))
+}
@@ -0,0 +1,117 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package rootcoord
+
+import (
+	"context"
+	"net/http"
+
+	management "github.com/milvus-io/milvus/internal/http"
+	"github.com/milvus-io/milvus/internal/metastore/model"
+	"github.com/milvus-io/milvus/pkg/util/funcutil"
+)
+
+// collectionTemplateLister is the part of rootcoord listing the collection templates.
+type collectionTemplateLister interface {
+	ListCollectionTemplates(ctx context.Context) ([]*model.CollectionTemplate, error)
+}
+
+var collectionTemplateComponent = management.NewComponent[collectionTemplateLister]("rootcoord")
+
+// collectionTemplateField is the field schema in the http response, the data type is in the name like "FloatVector".
+type collectionTemplateField struct {
+	Name           string            `json:"name"`
+	Description    string            `json:"description,omitempty"`
+	DataType       string            `json:"data_type"`
+	IsPrimaryKey   bool              `json:"is_primary_key,omitempty"`
+	AutoID         bool              `json:"auto_id,omitempty"`
+	IsPartitionKey bool              `json:"is_partition_key,omitempty"`
+	TypeParams     map[string]string `json:"type_params,omitempty"`
+}
+
+// collectionTemplateBody is the collection template in the http response.
+type collectionTemplateBody struct {
+	Name               string                     `json:"name"`
+	Description        string                     `json:"description,omitempty"`
+	Fields             []*collectionTemplateField `json:"fields"`
+	EnableDynamicField bool                       `json:"enable_dynamic_field,omitempty"`
+	ShardsNum          int32                      `json:"shards_num,omitempty"`
+	ConsistencyLevel   string                     `json:"consistency_level,omitempty"`
+	Properties         map[string]string          `json:"properties,omitempty"`
+	Indexes            []*model.IndexPreset       `json:"indexes,omitempty"`
+	CreatedTime        uint64                     `json:"created_time,omitempty"`
+}
+
+func newCollectionTemplateBody(template *model.CollectionTemplate) *collectionTemplateBody {
+	fields := make([]*collectionTemplateField, 0, len(template.Schema.GetFields()))
+	for _, field := range template.Schema.GetFields() {
+		fields = append(fields, &collectionTemplateField{
+			Name:           field.GetName(),
+			Description:    field.GetDescription(),
+			DataType:       field.GetDataType().String(),
+			IsPrimaryKey:   field.GetIsPrimaryKey(),
+			AutoID:         field.GetAutoID(),
+			IsPartitionKey: field.GetIsPartitionKey(),
+			TypeParams:     funcutil.KeyValuePair2Map(field.GetTypeParams()),
+		})
+	}
+	return &collectionTemplateBody{
+		Name:               template.Name,
+		Description:        template.Description,
+		Fields:             fields,
+		EnableDynamicField: template.Schema.GetEnableDynamicField(),
+		ShardsNum:          template.ShardsNum,
+		ConsistencyLevel:   template.ConsistencyLevel.String(),
+		Properties:         funcutil.KeyValuePair2Map(template.Properties),
+		Indexes:            template.IndexPresets,
+		CreatedTime:        template.CreatedTime,
+	}
+}
+
+// registerCollectionTemplateHandler exposes the collection templates through the management http server, the templates are
+// created, dropped and instantiated by the authenticated rpcs rather than the management port.
+func registerCollectionTemplateHandler(lister collectionTemplateLister) {
+	collectionTemplateComponent.Serve(lister, &management.Handler{
+		Path:        management.RootCoordCollectionTemplateRouterPath,
+		HandlerFunc: collectionTemplateHandler,
+	})
+}
+
+// collectionTemplateHandler lists the collection templates.
+//
+//	GET /rootcoord/collection-templates
+func collectionTemplateHandler(w http.ResponseWriter, req *http.Request) {
+	if req.Method != http.MethodGet {
+		management.WriteJSON(w, http.StatusMethodNotAllowed, map[string]string{"error": "method not allowed"})
+		return
+	}
+	lister, ok := collectionTemplateComponent.Get(w)
+	if !ok {
+		return
+	}
+
+	templates, err := lister.ListCollectionTemplates(req.Context())
+	if err != nil {
+		management.WriteError(w, err)
+		return
+	}
+	bodies := make([]*collectionTemplateBody, 0, len(templates))
+	for _, template := range templates {
+		bodies = append(bodies, newCollectionTemplateBody(template))
+	}
+	management.WriteJSON(w, http.StatusOK, bodies)
+}
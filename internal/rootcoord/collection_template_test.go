@@ -0,0 +1,324 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package rootcoord
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/golang/protobuf/proto"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/milvus-io/milvus-proto/go-api/v2/commonpb"
+	"github.com/milvus-io/milvus-proto/go-api/v2/schemapb"
+	management "github.com/milvus-io/milvus/internal/http"
+	"github.com/milvus-io/milvus/internal/metastore/model"
+	"github.com/milvus-io/milvus/internal/proto/rootcoordpb"
+	"github.com/milvus-io/milvus/pkg/common"
+	"github.com/milvus-io/milvus/pkg/util/funcutil"
+	"github.com/milvus-io/milvus/pkg/util/merr"
+	"github.com/milvus-io/milvus/pkg/util/paramtable"
+)
+
+func newTestCollectionTemplate() *model.CollectionTemplate {
+	return &model.CollectionTemplate{
+		Name: "openai-1536-cosine",
+		Schema: &schemapb.CollectionSchema{
+			Fields: []*schemapb.FieldSchema{
+				{Name: "id", DataType: schemapb.DataType_Int64, IsPrimaryKey: true, AutoID: true},
+				{Name: "text", DataType: schemapb.DataType_VarChar, TypeParams: []*commonpb.KeyValuePair{{Key: common.MaxLengthKey, Value: "65535"}}},
+				{Name: "embedding", DataType: schemapb.DataType_FloatVector, TypeParams: []*commonpb.KeyValuePair{{Key: common.DimKey, Value: "1536"}}},
+			},
+		},
+		ShardsNum:        2,
+		ConsistencyLevel: commonpb.ConsistencyLevel_Bounded,
+		Properties:       []*commonpb.KeyValuePair{{Key: common.CollectionTTLConfigKey, Value: "86400"}},
+		IndexPresets: []*model.IndexPreset{
+			{FieldName: "embedding", IndexType: "HNSW", MetricType: "COSINE", Params: map[string]string{"M": "16", "efConstruction": "200"}},
+		},
+	}
+}
+
+func Test_validateCollectionTemplate(t *testing.T) {
+	paramtable.Init()
+
+	assert.NoError(t, validateCollectionTemplate(newTestCollectionTemplate()))
+
+	tests := []struct {
+		description string
+		modify      func(template *model.CollectionTemplate)
+	}{
+		{"invalid name", func(template *model.CollectionTemplate) { template.Name = "1536/cosine" }},
+		{"no field", func(template *model.CollectionTemplate) { template.Schema.Fields = nil }},
+		{"system field", func(template *model.CollectionTemplate) { template.Schema.Fields[1].Name = RowIDFieldName }},
+		{"duplicated field", func(template *model.CollectionTemplate) { template.Schema.Fields[1].Name = "id" }},
+		{"no primary key", func(template *model.CollectionTemplate) { template.Schema.Fields[0].IsPrimaryKey = false }},
+		{"invalid primary key", func(template *model.CollectionTemplate) { template.Schema.Fields[0].DataType = schemapb.DataType_Float }},
+		{"no vector field", func(template *model.CollectionTemplate) {
+			template.Schema.Fields = template.Schema.Fields[:2]
+			template.IndexPresets = nil
+		}},
+		{"no dim", func(template *model.CollectionTemplate) { template.Schema.Fields[2].TypeParams = nil }},
+		{"no max length", func(template *model.CollectionTemplate) { template.Schema.Fields[1].TypeParams = nil }},
+		{"invalid shards num", func(template *model.CollectionTemplate) { template.ShardsNum = 1024 }},
+		{"reserved property", func(template *model.CollectionTemplate) {
+			template.Properties = append(template.Properties, &commonpb.KeyValuePair{Key: common.CollectionTemplateKey, Value: "other"})
+		}},
+		{"index preset of scalar field", func(template *model.CollectionTemplate) { template.IndexPresets[0].FieldName = "text" }},
+		{"duplicated index preset", func(template *model.CollectionTemplate) {
+			template.IndexPresets = append(template.IndexPresets, template.IndexPresets[0])
+		}},
+		{"invalid index type", func(template *model.CollectionTemplate) { template.IndexPresets[0].IndexType = "UNKNOWN" }},
+		{"invalid index params", func(template *model.CollectionTemplate) { template.IndexPresets[0].Params["M"] = "0" }},
+		{"invalid metric type", func(template *model.CollectionTemplate) { template.IndexPresets[0].MetricType = "HAMMING_L2" }},
+	}
+	for _, test := range tests {
+		t.Run(test.description, func(t *testing.T) {
+			template := newTestCollectionTemplate()
+			test.modify(template)
+			err := validateCollectionTemplate(template)
+			assert.ErrorIs(t, err, merr.ErrParameterInvalid)
+		})
+	}
+}
+
+func Test_newCreateCollectionRequestFromTemplate(t *testing.T) {
+	paramtable.Init()
+
+	template := newTestCollectionTemplate()
+	req, err := newCreateCollectionRequestFromTemplate(template, "default", "docs")
+	require.NoError(t, err)
+	assert.Equal(t, commonpb.MsgType_CreateCollection, req.GetBase().GetMsgType())
+	assert.Equal(t, "docs", req.GetCollectionName())
+	assert.Equal(t, int32(2), req.GetShardsNum())
+	assert.Equal(t, commonpb.ConsistencyLevel_Bounded, req.GetConsistencyLevel())
+
+	schema := &schemapb.CollectionSchema{}
+	require.NoError(t, proto.Unmarshal(req.GetSchema(), schema))
+	assert.Equal(t, "docs", schema.GetName())
+	assert.Len(t, schema.GetFields(), 3)
+	// the template is not changed
+	assert.Empty(t, template.Schema.GetName())
+
+	props := funcutil.KeyValuePair2Map(req.GetProperties())
+	assert.Equal(t, "86400", props[common.CollectionTTLConfigKey])
+	assert.Equal(t, template.Name, props[common.CollectionTemplateKey])
+	assert.Equal(t, "true", props[common.CollectionAutoIndexOnSealKey])
+	var presets []*model.IndexPreset
+	require.NoError(t, json.Unmarshal([]byte(props[common.CollectionAutoIndexPoliciesKey]), &presets))
+	assert.Equal(t, template.IndexPresets, presets)
+
+	_, err = newCreateCollectionRequestFromTemplate(template, "default", "1docs")
+	assert.ErrorIs(t, err, merr.ErrParameterInvalid)
+}
+
+func TestRootCoord_OperateCollectionTemplate(t *testing.T) {
+	paramtable.Init()
+	ctx := context.Background()
+
+	t.Run("not healthy", func(t *testing.T) {
+		c := newTestCore(withAbnormalCode())
+		resp, err := c.OperateCollectionTemplate(ctx, &rootcoordpb.OperateCollectionTemplateRequest{})
+		assert.NoError(t, err)
+		assert.Equal(t, commonpb.ErrorCode_NotReadyServe, resp.GetErrorCode())
+	})
+
+	templates := make(map[string]*model.CollectionTemplate)
+	meta := newMockMetaTable()
+	meta.CreateCollectionTemplateFunc = func(ctx context.Context, template *model.CollectionTemplate) error {
+		templates[template.Name] = template
+		return nil
+	}
+	meta.DropCollectionTemplateFunc = func(ctx context.Context, name string) error {
+		delete(templates, name)
+		return nil
+	}
+	c := newTestCore(withHealthyCode(), withMeta(meta))
+
+	template := newTestCollectionTemplate()
+	schema, err := proto.Marshal(template.Schema)
+	require.NoError(t, err)
+	templateReq := &rootcoordpb.CollectionTemplate{
+		Name:             template.Name,
+		Schema:           schema,
+		ShardsNum:        template.ShardsNum,
+		ConsistencyLevel: template.ConsistencyLevel,
+		Properties:       template.Properties,
+		IndexPresets: []*rootcoordpb.CollectionTemplateIndexPreset{{
+			FieldName:  "embedding",
+			IndexType:  "HNSW",
+			MetricType: "COSINE",
+			Params:     funcutil.Map2KeyValuePair(map[string]string{"M": "16", "efConstruction": "200"}),
+		}},
+	}
+
+	t.Run("create", func(t *testing.T) {
+		resp, err := c.OperateCollectionTemplate(ctx, &rootcoordpb.OperateCollectionTemplateRequest{
+			OperateType: rootcoordpb.CollectionTemplateOperateType_CreateCollectionTemplate,
+			Template:    templateReq,
+		})
+		assert.NoError(t, err)
+		assert.True(t, merr.Ok(resp))
+		created := templates[template.Name]
+		require.NotNil(t, created)
+		assert.Len(t, created.Schema.GetFields(), 3)
+		assert.Equal(t, template.IndexPresets, created.IndexPresets)
+		assert.NotZero(t, created.CreatedTime)
+	})
+
+	t.Run("create invalid", func(t *testing.T) {
+		resp, err := c.OperateCollectionTemplate(ctx, &rootcoordpb.OperateCollectionTemplateRequest{
+			OperateType: rootcoordpb.CollectionTemplateOperateType_CreateCollectionTemplate,
+			Template:    &rootcoordpb.CollectionTemplate{Name: "invalid", Schema: []byte("invalid")},
+		})
+		assert.NoError(t, err)
+		assert.ErrorIs(t, merr.Error(resp), merr.ErrParameterInvalid)
+
+		resp, err = c.OperateCollectionTemplate(ctx, &rootcoordpb.OperateCollectionTemplateRequest{
+			OperateType: rootcoordpb.CollectionTemplateOperateType_CreateCollectionTemplate,
+			Template:    &rootcoordpb.CollectionTemplate{Name: "no-field"},
+		})
+		assert.NoError(t, err)
+		assert.ErrorIs(t, merr.Error(resp), merr.ErrParameterInvalid)
+	})
+
+	t.Run("drop", func(t *testing.T) {
+		resp, err := c.OperateCollectionTemplate(ctx, &rootcoordpb.OperateCollectionTemplateRequest{
+			OperateType:  rootcoordpb.CollectionTemplateOperateType_DropCollectionTemplate,
+			TemplateName: template.Name,
+		})
+		assert.NoError(t, err)
+		assert.True(t, merr.Ok(resp))
+		assert.Empty(t, templates)
+	})
+
+	t.Run("invalid operate type", func(t *testing.T) {
+		resp, err := c.OperateCollectionTemplate(ctx, &rootcoordpb.OperateCollectionTemplateRequest{
+			OperateType: rootcoordpb.CollectionTemplateOperateType(-1),
+		})
+		assert.NoError(t, err)
+		assert.ErrorIs(t, merr.Error(resp), merr.ErrParameterInvalid)
+	})
+}
+
+func TestRootCoord_CreateCollectionFromTemplate(t *testing.T) {
+	paramtable.Init()
+	ctx := context.Background()
+
+	t.Run("not healthy", func(t *testing.T) {
+		c := newTestCore(withAbnormalCode())
+		resp, err := c.CreateCollectionFromTemplate(ctx, &rootcoordpb.CreateCollectionFromTemplateRequest{})
+		assert.NoError(t, err)
+		assert.Equal(t, commonpb.ErrorCode_NotReadyServe, resp.GetErrorCode())
+	})
+
+	meta := newMockMetaTable()
+	meta.GetCollectionTemplateFunc = func(ctx context.Context, name string) (*model.CollectionTemplate, error) {
+		if name != "openai-1536-cosine" {
+			return nil, merr.WrapErrParameterInvalidMsg("collection template %s not found", name)
+		}
+		return newTestCollectionTemplate(), nil
+	}
+	var created *createCollectionTask
+	sched := newMockScheduler()
+	sched.AddTaskFunc = func(t task) error {
+		created, _ = t.(*createCollectionTask)
+		t.NotifyDone(nil)
+		return nil
+	}
+	c := newTestCore(withHealthyCode(), withMeta(meta), withScheduler(sched))
+
+	t.Run("template not found", func(t *testing.T) {
+		resp, err := c.CreateCollectionFromTemplate(ctx, &rootcoordpb.CreateCollectionFromTemplateRequest{
+			DbName:         "default",
+			CollectionName: "docs",
+			TemplateName:   "unknown",
+		})
+		assert.NoError(t, err)
+		assert.ErrorIs(t, merr.Error(resp), merr.ErrParameterInvalid)
+	})
+
+	t.Run("invalid collection name", func(t *testing.T) {
+		resp, err := c.CreateCollectionFromTemplate(ctx, &rootcoordpb.CreateCollectionFromTemplateRequest{
+			DbName:         "default",
+			CollectionName: "1docs",
+			TemplateName:   "openai-1536-cosine",
+		})
+		assert.NoError(t, err)
+		assert.ErrorIs(t, merr.Error(resp), merr.ErrParameterInvalid)
+	})
+
+	t.Run("ok", func(t *testing.T) {
+		resp, err := c.CreateCollectionFromTemplate(ctx, &rootcoordpb.CreateCollectionFromTemplateRequest{
+			DbName:         "default",
+			CollectionName: "docs",
+			TemplateName:   "openai-1536-cosine",
+		})
+		assert.NoError(t, err)
+		assert.True(t, merr.Ok(resp))
+		require.NotNil(t, created)
+		assert.Equal(t, "docs", created.Req.GetCollectionName())
+		assert.Equal(t, "openai-1536-cosine", funcutil.KeyValuePair2Map(created.Req.GetProperties())[common.CollectionTemplateKey])
+	})
+}
+
+type mockCollectionTemplateLister struct {
+	templates []*model.CollectionTemplate
+}
+
+func (m *mockCollectionTemplateLister) ListCollectionTemplates(ctx context.Context) ([]*model.CollectionTemplate, error) {
+	return m.templates, nil
+}
+
+func Test_collectionTemplateHandler(t *testing.T) {
+	paramtable.Init()
+	// the handler serves a standalone component, the one of the rootcoord started by other tests is restored after
+	defer func(c *management.Component[collectionTemplateLister]) { collectionTemplateComponent = c }(collectionTemplateComponent)
+	collectionTemplateComponent = management.NewComponent[collectionTemplateLister]("rootcoord")
+
+	t.Run("not started", func(t *testing.T) {
+		w := httptest.NewRecorder()
+		collectionTemplateHandler(w, httptest.NewRequest(http.MethodGet, "/rootcoord/collection-templates", nil))
+		assert.Equal(t, http.StatusServiceUnavailable, w.Code)
+	})
+
+	collectionTemplateComponent.Serve(&mockCollectionTemplateLister{
+		templates: []*model.CollectionTemplate{newTestCollectionTemplate()},
+	})
+
+	t.Run("method not allowed", func(t *testing.T) {
+		w := httptest.NewRecorder()
+		collectionTemplateHandler(w, httptest.NewRequest(http.MethodDelete, "/rootcoord/collection-templates?name=openai-1536-cosine", nil))
+		assert.Equal(t, http.StatusMethodNotAllowed, w.Code)
+	})
+
+	t.Run("list", func(t *testing.T) {
+		w := httptest.NewRecorder()
+		collectionTemplateHandler(w, httptest.NewRequest(http.MethodGet, "/rootcoord/collection-templates", nil))
+		assert.Equal(t, http.StatusOK, w.Code)
+		var bodies []*collectionTemplateBody
+		require.NoError(t, json.Unmarshal(w.Body.Bytes(), &bodies))
+		require.Len(t, bodies, 1)
+		assert.Equal(t, "FloatVector", bodies[0].Fields[2].DataType)
+		assert.Equal(t, "Bounded", bodies[0].ConsistencyLevel)
+		assert.Equal(t, "HNSW", bodies[0].Indexes[0].IndexType)
+	})
+}
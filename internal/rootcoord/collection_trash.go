@@ -0,0 +1,230 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package rootcoord
+
+import (
+	"context"
+	"strconv"
+	"time"
+
+	"go.uber.org/zap"
+
+	"github.com/milvus-io/milvus-proto/go-api/v2/commonpb"
+	"github.com/milvus-io/milvus/internal/metastore/model"
+	"github.com/milvus-io/milvus/pkg/log"
+	"github.com/milvus-io/milvus/pkg/util"
+	"github.com/milvus-io/milvus/pkg/util/merr"
+	"github.com/milvus-io/milvus/pkg/util/typeutil"
+)
+
+// collectionTrashDroppedAtKey is the collection property marking the dropped collection kept in the trash,
+// the value is the unix time in seconds when dropped.
+const collectionTrashDroppedAtKey = "collection.trash.droppedAt"
+
+// collectionTrashDroppedAt returns when the collection was dropped, if it's in the trash.
+func collectionTrashDroppedAt(coll *model.Collection) (time.Time, bool) {
+	for _, kv := range coll.Properties {
+		if kv.GetKey() != collectionTrashDroppedAtKey {
+			continue
+		}
+		seconds, err := strconv.ParseInt(kv.GetValue(), 10, 64)
+		if err != nil {
+			return time.Time{}, false
+		}
+		return time.Unix(seconds, 0), true
+	}
+	return time.Time{}, false
+}
+
+func withCollectionTrashMark(properties []*commonpb.KeyValuePair, droppedAt time.Time) []*commonpb.KeyValuePair {
+	return append(withoutCollectionTrashMark(properties), &commonpb.KeyValuePair{
+		Key:   collectionTrashDroppedAtKey,
+		Value: strconv.FormatInt(droppedAt.Unix(), 10),
+	})
+}
+
+func withoutCollectionTrashMark(properties []*commonpb.KeyValuePair) []*commonpb.KeyValuePair {
+	ret := make([]*commonpb.KeyValuePair, 0, len(properties))
+	for _, kv := range properties {
+		if kv.GetKey() != collectionTrashDroppedAtKey {
+			ret = append(ret, kv)
+		}
+	}
+	return ret
+}
+
+// collectionTrashEntry is the dropped collection kept in the trash.
+type collectionTrashEntry struct {
+	DBName         string    `json:"db_name"`
+	CollectionName string    `json:"collection_name"`
+	CollectionID   int64     `json:"collection_id"`
+	DroppedAt      time.Time `json:"dropped_at"`
+	PurgeAt        time.Time `json:"purge_at"`
+}
+
+// ListCollectionTrash lists the dropped collections kept in the trash.
+func (c *Core) ListCollectionTrash(ctx context.Context) ([]*collectionTrashEntry, error) {
+	if err := merr.CheckHealthy(c.GetStateCode()); err != nil {
+		return nil, err
+	}
+	dbs, err := c.meta.ListDatabases(ctx, typeutil.MaxTimestamp)
+	if err != nil {
+		return nil, err
+	}
+	retention := Params.RootCoordCfg.CollectionTrashRetention.GetAsDuration(time.Second)
+	entries := make([]*collectionTrashEntry, 0)
+	for _, db := range dbs {
+		colls, err := c.meta.ListCollections(ctx, db.Name, typeutil.MaxTimestamp, false)
+		if err != nil {
+			return nil, err
+		}
+		for _, coll := range colls {
+			droppedAt, ok := collectionTrashDroppedAt(coll)
+			if !ok {
+				continue
+			}
+			entries = append(entries, &collectionTrashEntry{
+				DBName:         db.Name,
+				CollectionName: coll.Name,
+				CollectionID:   coll.CollectionID,
+				DroppedAt:      droppedAt,
+				PurgeAt:        droppedAt.Add(retention),
+			})
+		}
+	}
+	return entries, nil
+}
+
+// restoreCollection restores the dropped collection in the trash, the collection should be loaded again to search.
+func (c *Core) restoreCollection(ctx context.Context, collectionID UniqueID) error {
+	t := &restoreCollectionTask{
+		baseTask:     newBaseTask(ctx, c),
+		collectionID: collectionID,
+	}
+	if err := c.scheduler.AddTask(t); err != nil {
+		return err
+	}
+	return t.WaitToFinish()
+}
+
+// purgeCollection drops the collection in the trash at once, which couldn't be restored anymore.
+func (c *Core) purgeCollection(ctx context.Context, collectionID UniqueID) error {
+	t := &purgeCollectionTask{
+		baseTask:     newBaseTask(ctx, c),
+		collectionID: collectionID,
+	}
+	if err := c.scheduler.AddTask(t); err != nil {
+		return err
+	}
+	return t.WaitToFinish()
+}
+
+// collectionTrashLoop purges the collections expired in the trash periodically.
+func (c *Core) collectionTrashLoop() {
+	defer c.wg.Done()
+	ticker := time.NewTicker(Params.RootCoordCfg.CollectionTrashCheckInterval.GetAsDuration(time.Second))
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ticker.C:
+			c.purgeExpiredCollections(c.ctx)
+		case <-c.ctx.Done():
+			log.Info("rootcoord's collection trash loop quit!")
+			return
+		}
+	}
+}
+
+func (c *Core) purgeExpiredCollections(ctx context.Context) {
+	entries, err := c.ListCollectionTrash(ctx)
+	if err != nil {
+		log.Warn("failed to list the collection trash", zap.Error(err))
+		return
+	}
+	now := time.Now()
+	for _, entry := range entries {
+		if now.Before(entry.PurgeAt) {
+			continue
+		}
+		log.Info("purge collection expired in trash", zap.String("dbName", entry.DBName),
+			zap.String("collection", entry.CollectionName), zap.Int64("collectionID", entry.CollectionID),
+			zap.Time("droppedAt", entry.DroppedAt))
+		if err := c.purgeCollection(ctx, entry.CollectionID); err != nil {
+			log.Warn("failed to purge collection", zap.Int64("collectionID", entry.CollectionID), zap.Error(err))
+		}
+	}
+}
+
+type restoreCollectionTask struct {
+	baseTask
+	collectionID UniqueID
+}
+
+func (t *restoreCollectionTask) Execute(ctx context.Context) error {
+	coll, err := t.core.meta.GetCollectionByID(ctx, "", t.collectionID, typeutil.MaxTimestamp, true)
+	if err != nil {
+		return err
+	}
+	dbName := util.DefaultDBName
+	if coll.DBID != util.NonDBID {
+		db, err := t.core.meta.GetDatabaseByID(ctx, coll.DBID, typeutil.MaxTimestamp)
+		if err != nil {
+			return err
+		}
+		dbName = db.Name
+	}
+
+	redoTask := newBaseRedoTask(t.core.stepExecutor)
+	redoTask.AddSyncStep(&restoreCollectionStep{
+		baseStep:     baseStep{core: t.core},
+		collectionID: t.collectionID,
+		ts:           t.GetTs(),
+	})
+	redoTask.AddSyncStep(&expireCacheStep{
+		baseStep:        baseStep{core: t.core},
+		dbName:          dbName,
+		collectionNames: []string{coll.Name},
+		collectionID:    t.collectionID,
+		ts:              t.GetTs(),
+	})
+	return redoTask.Execute(ctx)
+}
+
+type purgeCollectionTask struct {
+	baseTask
+	collectionID UniqueID
+}
+
+func (t *purgeCollectionTask) Execute(ctx context.Context) error {
+	coll, err := t.core.meta.GetCollectionByID(ctx, "", t.collectionID, typeutil.MaxTimestamp, true)
+	if err != nil {
+		return err
+	}
+	if _, ok := collectionTrashDroppedAt(coll); !ok {
+		return merr.WrapErrParameterInvalidMsg("collection %d is not in the trash", t.collectionID)
+	}
+
+	// unmark the collection first, so the purge is redone rather than kept in the trash if rootcoord restarts
+	purged := coll.Clone()
+	purged.Properties = withoutCollectionTrashMark(purged.Properties)
+	if err := t.core.meta.AlterCollection(ctx, coll, purged, t.GetTs()); err != nil {
+		return err
+	}
+	redoTask := newBaseRedoTask(t.core.stepExecutor)
+	addDropCollectionAsyncSteps(t.core, redoTask, purged, false, t.GetTs())
+	return redoTask.Execute(ctx)
+}
@@ -0,0 +1,61 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package rootcoord
+
+import (
+	"context"
+	"net/http"
+
+	management "github.com/milvus-io/milvus/internal/http"
+)
+
+// collectionTrashLister is the part of rootcoord listing the collection trash.
+type collectionTrashLister interface {
+	ListCollectionTrash(ctx context.Context) ([]*collectionTrashEntry, error)
+}
+
+var collectionTrashComponent = management.NewComponent[collectionTrashLister]("rootcoord")
+
+// registerCollectionTrashHandler exposes the collection trash through the management http server, the collection
+// is restored or purged by the authenticated OperateCollectionTrash rpc rather than the management port.
+func registerCollectionTrashHandler(lister collectionTrashLister) {
+	collectionTrashComponent.Serve(lister, &management.Handler{
+		Path:        management.RootCoordCollectionTrashRouterPath,
+		HandlerFunc: collectionTrashHandler,
+	})
+}
+
+// collectionTrashHandler lists the collections in the trash.
+//
+//	GET /rootcoord/collection-trash
+func collectionTrashHandler(w http.ResponseWriter, req *http.Request) {
+	if req.Method != http.MethodGet {
+		management.WriteJSON(w, http.StatusMethodNotAllowed, map[string]string{"error": "method not allowed"})
+		return
+	}
+	lister, ok := collectionTrashComponent.Get(w)
+	if !ok {
+		return
+	}
+
+	entries, err := lister.ListCollectionTrash(req.Context())
+	if err != nil {
+		management.WriteError(w, err)
+		return
+	}
+	management.WriteJSON(w, http.StatusOK, entries)
+}
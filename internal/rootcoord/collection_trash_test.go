@@ -0,0 +1,292 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package rootcoord
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+
+	"github.com/milvus-io/milvus-proto/go-api/v2/commonpb"
+	"github.com/milvus-io/milvus-proto/go-api/v2/milvuspb"
+	management "github.com/milvus-io/milvus/internal/http"
+	"github.com/milvus-io/milvus/internal/metastore/mocks"
+	"github.com/milvus-io/milvus/internal/metastore/model"
+	pb "github.com/milvus-io/milvus/internal/proto/etcdpb"
+	"github.com/milvus-io/milvus/internal/proto/rootcoordpb"
+	mockrootcoord "github.com/milvus-io/milvus/internal/rootcoord/mocks"
+	"github.com/milvus-io/milvus/pkg/util"
+	"github.com/milvus-io/milvus/pkg/util/merr"
+	"github.com/milvus-io/milvus/pkg/util/paramtable"
+	"github.com/milvus-io/milvus/pkg/util/typeutil"
+)
+
+func Test_collectionTrashMark(t *testing.T) {
+	coll := &model.Collection{Properties: []*commonpb.KeyValuePair{{Key: "foo", Value: "bar"}}}
+	_, ok := collectionTrashDroppedAt(coll)
+	assert.False(t, ok)
+
+	droppedAt := time.Unix(1700000000, 0)
+	coll.Properties = withCollectionTrashMark(coll.Properties, droppedAt)
+	got, ok := collectionTrashDroppedAt(coll)
+	assert.True(t, ok)
+	assert.Equal(t, droppedAt, got)
+
+	coll.Properties = withoutCollectionTrashMark(coll.Properties)
+	assert.Equal(t, []*commonpb.KeyValuePair{{Key: "foo", Value: "bar"}}, coll.Properties)
+}
+
+func TestMetaTable_CollectionTrash(t *testing.T) {
+	catalog := mocks.NewRootCoordCatalog(t)
+	catalog.On("AlterCollection",
+		mock.Anything,
+		mock.Anything,
+		mock.Anything,
+		mock.Anything,
+		mock.Anything,
+	).Return(nil)
+	meta := &MetaTable{
+		dbName2Meta: map[string]*model.Database{
+			util.DefaultDBName: model.NewDefaultDatabase(),
+		},
+		catalog: catalog,
+		names:   newNameDb(),
+		aliases: newNameDb(),
+		collID2Meta: map[typeutil.UniqueID]*model.Collection{
+			1: {CollectionID: 1, DBID: util.DefaultDBID, Name: "coll", State: pb.CollectionState_CollectionCreated},
+		},
+	}
+	meta.names.insert(util.DefaultDBName, "coll", 1)
+	ctx := context.Background()
+
+	// not in the trash
+	assert.ErrorIs(t, meta.RestoreCollection(ctx, 1, 100), merr.ErrParameterInvalid)
+
+	assert.NoError(t, meta.MoveCollectionToTrash(ctx, 1, time.Now(), 100))
+	_, err := meta.GetCollectionByName(ctx, util.DefaultDBName, "coll", typeutil.MaxTimestamp)
+	assert.ErrorIs(t, err, merr.ErrCollectionNotFound)
+
+	// the name is used by another collection created since dropped
+	meta.collID2Meta[2] = &model.Collection{CollectionID: 2, DBID: util.DefaultDBID, Name: "coll", State: pb.CollectionState_CollectionCreated}
+	meta.names.insert(util.DefaultDBName, "coll", 2)
+	assert.ErrorIs(t, meta.RestoreCollection(ctx, 1, 200), merr.ErrParameterInvalid)
+
+	// the name is free once the other is dropped
+	meta.collID2Meta[2].State = pb.CollectionState_CollectionDropping
+	assert.NoError(t, meta.RestoreCollection(ctx, 1, 300))
+	coll, err := meta.GetCollectionByName(ctx, util.DefaultDBName, "coll", typeutil.MaxTimestamp)
+	assert.NoError(t, err)
+	assert.EqualValues(t, 1, coll.CollectionID)
+	_, ok := collectionTrashDroppedAt(coll)
+	assert.False(t, ok)
+
+	assert.ErrorIs(t, meta.RestoreCollection(ctx, 3, 400), merr.ErrCollectionNotFound)
+}
+
+func Test_dropCollectionTask_Trash(t *testing.T) {
+	paramtable.Init()
+	paramtable.Get().Save(Params.RootCoordCfg.CollectionTrashRetention.Key, "3600")
+	defer paramtable.Get().Reset(Params.RootCoordCfg.CollectionTrashRetention.Key)
+
+	coll := &model.Collection{CollectionID: 1, Name: "coll"}
+	meta := mockrootcoord.NewIMetaTable(t)
+	meta.On("GetCollectionByName",
+		mock.Anything,
+		mock.Anything,
+		mock.Anything,
+		mock.Anything,
+	).Return(coll.Clone(), nil)
+	meta.On("ListAliasesByID",
+		mock.Anything,
+	).Return([]string{})
+	meta.On("MoveCollectionToTrash",
+		mock.Anything,
+		int64(1),
+		mock.Anything,
+		mock.Anything,
+	).Return(nil)
+
+	broker := newMockBroker()
+	releaseCollectionChan := make(chan struct{}, 1)
+	broker.ReleaseCollectionFunc = func(ctx context.Context, collectionID UniqueID) error {
+		releaseCollectionChan <- struct{}{}
+		return nil
+	}
+
+	core := newTestCore(withValidProxyManager(), withMeta(meta), withBroker(broker))
+	task := &dropCollectionTask{
+		baseTask: newBaseTask(context.Background(), core),
+		Req: &milvuspb.DropCollectionRequest{
+			Base:           &commonpb.MsgBase{MsgType: commonpb.MsgType_DropCollection},
+			CollectionName: "coll",
+		},
+	}
+	assert.NoError(t, task.Execute(context.Background()))
+	// the collection is released but its data and meta are kept
+	<-releaseCollectionChan
+}
+
+func Test_purgeCollectionTask(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("not in trash", func(t *testing.T) {
+		meta := mockrootcoord.NewIMetaTable(t)
+		meta.On("GetCollectionByID",
+			mock.Anything,
+			mock.Anything,
+			int64(1),
+			mock.Anything,
+			true,
+		).Return(&model.Collection{CollectionID: 1, State: pb.CollectionState_CollectionCreated}, nil)
+		core := newTestCore(withMeta(meta))
+		task := &purgeCollectionTask{baseTask: newBaseTask(ctx, core), collectionID: 1}
+		assert.ErrorIs(t, task.Execute(ctx), merr.ErrParameterInvalid)
+	})
+
+	t.Run("unmark before purged", func(t *testing.T) {
+		coll := &model.Collection{
+			CollectionID: 1,
+			State:        pb.CollectionState_CollectionDropping,
+			Properties:   withCollectionTrashMark(nil, time.Now()),
+		}
+		meta := mockrootcoord.NewIMetaTable(t)
+		meta.On("GetCollectionByID",
+			mock.Anything,
+			mock.Anything,
+			int64(1),
+			mock.Anything,
+			true,
+		).Return(coll, nil)
+		meta.On("AlterCollection",
+			mock.Anything,
+			mock.Anything,
+			mock.Anything,
+			mock.Anything,
+		).Return(func(ctx context.Context, oldColl *model.Collection, newColl *model.Collection, ts Timestamp) error {
+			_, ok := collectionTrashDroppedAt(newColl)
+			assert.False(t, ok)
+			return nil
+		})
+		core := newTestCore(withMeta(meta), withStepExecutor(newMockStepExecutor()))
+		task := &purgeCollectionTask{baseTask: newBaseTask(ctx, core), collectionID: 1}
+		assert.NoError(t, task.Execute(ctx))
+	})
+}
+
+func TestRootCoord_OperateCollectionTrash(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("not healthy", func(t *testing.T) {
+		c := newTestCore(withAbnormalCode())
+		resp, err := c.OperateCollectionTrash(ctx, &rootcoordpb.OperateCollectionTrashRequest{CollectionID: 1})
+		assert.NoError(t, err)
+		assert.Equal(t, commonpb.ErrorCode_NotReadyServe, resp.GetErrorCode())
+	})
+
+	var executed task
+	sched := newMockScheduler()
+	sched.AddTaskFunc = func(t task) error {
+		executed = t
+		t.NotifyDone(nil)
+		return nil
+	}
+	c := newTestCore(withHealthyCode(), withScheduler(sched))
+
+	t.Run("restore", func(t *testing.T) {
+		resp, err := c.OperateCollectionTrash(ctx, &rootcoordpb.OperateCollectionTrashRequest{
+			CollectionID: 1,
+			OperateType:  rootcoordpb.CollectionTrashOperateType_RestoreCollection,
+		})
+		assert.NoError(t, err)
+		assert.True(t, merr.Ok(resp))
+		assert.IsType(t, &restoreCollectionTask{}, executed)
+	})
+
+	t.Run("purge", func(t *testing.T) {
+		resp, err := c.OperateCollectionTrash(ctx, &rootcoordpb.OperateCollectionTrashRequest{
+			CollectionID: 1,
+			OperateType:  rootcoordpb.CollectionTrashOperateType_PurgeCollection,
+		})
+		assert.NoError(t, err)
+		assert.True(t, merr.Ok(resp))
+		assert.IsType(t, &purgeCollectionTask{}, executed)
+	})
+
+	t.Run("invalid operate type", func(t *testing.T) {
+		resp, err := c.OperateCollectionTrash(ctx, &rootcoordpb.OperateCollectionTrashRequest{
+			CollectionID: 1,
+			OperateType:  rootcoordpb.CollectionTrashOperateType(-1),
+		})
+		assert.NoError(t, err)
+		assert.ErrorIs(t, merr.Error(resp), merr.ErrParameterInvalid)
+	})
+
+	t.Run("task failed", func(t *testing.T) {
+		sched.AddTaskFunc = func(t task) error {
+			return merr.WrapErrCollectionNotFound(1)
+		}
+		resp, err := c.OperateCollectionTrash(ctx, &rootcoordpb.OperateCollectionTrashRequest{CollectionID: 1})
+		assert.NoError(t, err)
+		assert.ErrorIs(t, merr.Error(resp), merr.ErrCollectionNotFound)
+	})
+}
+
+type mockCollectionTrashLister struct {
+	entries []*collectionTrashEntry
+}
+
+func (l *mockCollectionTrashLister) ListCollectionTrash(ctx context.Context) ([]*collectionTrashEntry, error) {
+	return l.entries, nil
+}
+
+func Test_collectionTrashHandler(t *testing.T) {
+	// the handler serves a standalone component, the one of the rootcoord started by other tests is restored after
+	defer func(c *management.Component[collectionTrashLister]) { collectionTrashComponent = c }(collectionTrashComponent)
+	collectionTrashComponent = management.NewComponent[collectionTrashLister]("rootcoord")
+
+	t.Run("not started", func(t *testing.T) {
+		w := httptest.NewRecorder()
+		collectionTrashHandler(w, httptest.NewRequest(http.MethodGet, "/rootcoord/collection-trash", nil))
+		assert.Equal(t, http.StatusServiceUnavailable, w.Code)
+	})
+
+	collectionTrashComponent.Serve(&mockCollectionTrashLister{
+		entries: []*collectionTrashEntry{{DBName: "default", CollectionName: "coll", CollectionID: 1}},
+	})
+
+	t.Run("method not allowed", func(t *testing.T) {
+		w := httptest.NewRecorder()
+		collectionTrashHandler(w, httptest.NewRequest(http.MethodDelete, "/rootcoord/collection-trash?collection_id=1", nil))
+		assert.Equal(t, http.StatusMethodNotAllowed, w.Code)
+	})
+
+	t.Run("list", func(t *testing.T) {
+		w := httptest.NewRecorder()
+		collectionTrashHandler(w, httptest.NewRequest(http.MethodGet, "/rootcoord/collection-trash", nil))
+		assert.Equal(t, http.StatusOK, w.Code)
+		var entries []*collectionTrashEntry
+		assert.NoError(t, json.Unmarshal(w.Body.Bytes(), &entries))
+		assert.Len(t, entries, 1)
+		assert.EqualValues(t, 1, entries[0].CollectionID)
+	})
+}
@@ -0,0 +1,49 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package rootcoord
+
+import (
+	"net/http"
+
+	management "github.com/milvus-io/milvus/internal/http"
+)
+
+var ddlQueueComponent = management.NewComponent[IScheduler]("rootcoord")
+
+// registerDDLQueueHandler exposes the ddl queue of the scheduler through the management http server,
+// the task is cancelled by the authenticated CancelDDLTask rpc rather than the management port.
+func registerDDLQueueHandler(s IScheduler) {
+	ddlQueueComponent.Serve(s, &management.Handler{
+		Path:        management.RootCoordDDLQueueRouterPath,
+		HandlerFunc: ddlQueueHandler,
+	})
+}
+
+// ddlQueueHandler lists the queued ddl tasks.
+//
+//	GET /rootcoord/ddl
+func ddlQueueHandler(w http.ResponseWriter, req *http.Request) {
+	if req.Method != http.MethodGet {
+		management.WriteJSON(w, http.StatusMethodNotAllowed, map[string]string{"error": "method not allowed"})
+		return
+	}
+	s, ok := ddlQueueComponent.Get(w)
+	if !ok {
+		return
+	}
+	management.WriteJSON(w, http.StatusOK, s.ListTasks())
+}
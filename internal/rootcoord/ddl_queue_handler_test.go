@@ -0,0 +1,65 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package rootcoord
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	management "github.com/milvus-io/milvus/internal/http"
+)
+
+func Test_ddlQueueHandler(t *testing.T) {
+	// the handler serves a standalone component, the one of the rootcoord started by other tests is restored after
+	defer func(c *management.Component[IScheduler]) { ddlQueueComponent = c }(ddlQueueComponent)
+	ddlQueueComponent = management.NewComponent[IScheduler]("rootcoord")
+
+	t.Run("not started", func(t *testing.T) {
+		w := httptest.NewRecorder()
+		ddlQueueHandler(w, httptest.NewRequest(http.MethodGet, "/rootcoord/ddl", nil))
+		assert.Equal(t, http.StatusServiceUnavailable, w.Code)
+	})
+
+	sched := newMockScheduler()
+	sched.ListTasksFunc = func() []*ddlTaskInfo {
+		return []*ddlTaskInfo{
+			{ID: 1, Type: "createCollectionTask", Ts: 100, State: ddlTaskExecuting},
+			{ID: 2, Type: "dropCollectionTask", Ts: 101, State: ddlTaskPending, BlockedBy: []UniqueID{1}},
+		}
+	}
+	ddlQueueComponent.Serve(sched)
+
+	t.Run("list", func(t *testing.T) {
+		w := httptest.NewRecorder()
+		ddlQueueHandler(w, httptest.NewRequest(http.MethodGet, "/rootcoord/ddl", nil))
+		assert.Equal(t, http.StatusOK, w.Code)
+		var infos []*ddlTaskInfo
+		assert.NoError(t, json.Unmarshal(w.Body.Bytes(), &infos))
+		assert.Len(t, infos, 2)
+		assert.Equal(t, []UniqueID{1}, infos[1].BlockedBy)
+	})
+
+	t.Run("method not allowed", func(t *testing.T) {
+		w := httptest.NewRecorder()
+		ddlQueueHandler(w, httptest.NewRequest(http.MethodDelete, "/rootcoord/ddl?id=2", nil))
+		assert.Equal(t, http.StatusMethodNotAllowed, w.Code)
+	})
+}
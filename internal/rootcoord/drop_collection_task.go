@@ -25,9 +25,11 @@ import (
 
 	"github.com/milvus-io/milvus-proto/go-api/v2/commonpb"
 	"github.com/milvus-io/milvus-proto/go-api/v2/milvuspb"
+	"github.com/milvus-io/milvus/internal/metastore/model"
 	pb "github.com/milvus-io/milvus/internal/proto/etcdpb"
 	"github.com/milvus-io/milvus/pkg/log"
 	"github.com/milvus-io/milvus/pkg/util/merr"
+	"github.com/milvus-io/milvus/pkg/util/tsoutil"
 	"github.com/milvus-io/milvus/pkg/util/typeutil"
 )
 
@@ -81,6 +83,22 @@ func (t *dropCollectionTask) Execute(ctx context.Context) error {
 		ts:              ts,
 		opts:            []expireCacheOpt{expireCacheWithDropFlag()},
 	})
+
+	// keep the collection in the trash to be restored, it's purged by the trash loop once expired
+	if Params.RootCoordCfg.CollectionTrashRetention.GetAsInt64() > 0 && !t.Req.GetBase().GetReplicateInfo().GetIsReplicate() {
+		redoTask.AddSyncStep(&moveCollectionToTrashStep{
+			baseStep:     baseStep{core: t.core},
+			collectionID: collMeta.CollectionID,
+			droppedAt:    tsoutil.PhysicalTime(ts),
+			ts:           ts,
+		})
+		redoTask.AddAsyncStep(&releaseCollectionStep{
+			baseStep:     baseStep{core: t.core},
+			collectionID: collMeta.CollectionID,
+		})
+		return redoTask.Execute(ctx)
+	}
+
 	redoTask.AddSyncStep(&changeCollectionStateStep{
 		baseStep:     baseStep{core: t.core},
 		collectionID: collMeta.CollectionID,
@@ -88,33 +106,38 @@ func (t *dropCollectionTask) Execute(ctx context.Context) error {
 		ts:           ts,
 	})
 
+	addDropCollectionAsyncSteps(t.core, redoTask, collMeta, t.Req.GetBase().GetReplicateInfo().GetIsReplicate(), ts)
+
+	return redoTask.Execute(ctx)
+}
+
+// addDropCollectionAsyncSteps adds the steps to clean up the collection marked dropping.
+func addDropCollectionAsyncSteps(core *Core, redoTask *baseRedoTask, collMeta *model.Collection, isReplicate bool, ts Timestamp) {
 	redoTask.AddAsyncStep(&releaseCollectionStep{
-		baseStep:     baseStep{core: t.core},
+		baseStep:     baseStep{core: core},
 		collectionID: collMeta.CollectionID,
 	})
 	redoTask.AddAsyncStep(&dropIndexStep{
-		baseStep: baseStep{core: t.core},
+		baseStep: baseStep{core: core},
 		collID:   collMeta.CollectionID,
 		partIDs:  nil,
 	})
 	redoTask.AddAsyncStep(&deleteCollectionDataStep{
-		baseStep: baseStep{core: t.core},
+		baseStep: baseStep{core: core},
 		coll:     collMeta,
-		isSkip:   t.Req.GetBase().GetReplicateInfo().GetIsReplicate(),
+		isSkip:   isReplicate,
 	})
 	redoTask.AddAsyncStep(&removeDmlChannelsStep{
-		baseStep:  baseStep{core: t.core},
+		baseStep:  baseStep{core: core},
 		pChannels: collMeta.PhysicalChannelNames,
 	})
-	redoTask.AddAsyncStep(newConfirmGCStep(t.core, collMeta.CollectionID, allPartition))
+	redoTask.AddAsyncStep(newConfirmGCStep(core, collMeta.CollectionID, allPartition))
 	redoTask.AddAsyncStep(&deleteCollectionMetaStep{
-		baseStep:     baseStep{core: t.core},
+		baseStep:     baseStep{core: core},
 		collectionID: collMeta.CollectionID,
 		// This ts is less than the ts when we notify data nodes to drop collection, but it's OK since we have already
 		// marked this collection as deleted. If we want to make this ts greater than the notification's ts, we should
 		// wrap a step who will have these three children and connect them with ts.
 		ts: ts,
 	})
-
-	return redoTask.Execute(ctx)
 }
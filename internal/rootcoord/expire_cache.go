@@ -26,17 +26,23 @@ import (
 )
 
 type expireCacheConfig struct {
-	withDropFlag bool
+	withDropFlag            bool
+	withChannelsChangedFlag bool
 }
 
 func (c expireCacheConfig) apply(req *proxypb.InvalidateCollMetaCacheRequest) {
-	if !c.withDropFlag {
+	if !c.withDropFlag && !c.withChannelsChangedFlag {
 		return
 	}
 	if req.GetBase() == nil {
 		req.Base = commonpbutil.NewMsgBase()
 	}
-	req.Base.MsgType = commonpb.MsgType_DropCollection
+	if c.withDropFlag {
+		req.Base.MsgType = commonpb.MsgType_DropCollection
+		return
+	}
+	// proxy recreates the dml stream of the altered collection
+	req.Base.MsgType = commonpb.MsgType_AlterCollection
 }
 
 func defaultExpireCacheConfig() expireCacheConfig {
@@ -51,6 +57,12 @@ func expireCacheWithDropFlag() expireCacheOpt {
 	}
 }
 
+func expireCacheWithChannelsChangedFlag() expireCacheOpt {
+	return func(c *expireCacheConfig) {
+		c.withChannelsChangedFlag = true
+	}
+}
+
 // ExpireMetaCache will call invalidate collection meta cache
 func (c *Core) ExpireMetaCache(ctx context.Context, dbName string, collNames []string, collectionID UniqueID, ts typeutil.Timestamp, opts ...expireCacheOpt) error {
 	// if collectionID is specified, invalidate all the collection meta cache with the specified collectionID and return
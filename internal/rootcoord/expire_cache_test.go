@@ -35,3 +35,11 @@ func Test_expireCacheConfig_apply(t *testing.T) {
 	c.apply(req)
 	assert.Equal(t, commonpb.MsgType_DropCollection, req.GetBase().GetMsgType())
 }
+
+func Test_expireCacheConfig_applyChannelsChanged(t *testing.T) {
+	c := defaultExpireCacheConfig()
+	req := &proxypb.InvalidateCollMetaCacheRequest{}
+	expireCacheWithChannelsChangedFlag()(&c)
+	c.apply(req)
+	assert.Equal(t, commonpb.MsgType_AlterCollection, req.GetBase().GetMsgType())
+}
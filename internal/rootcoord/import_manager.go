@@ -223,6 +223,16 @@ func (m *importManager) sendOutTasks(ctx context.Context) error {
 			ImportTask:   it,
 			WorkingNodes: busyNodeList,
 		})
+		if errors.Is(merr.Error(resp.GetStatus()), merr.ErrParameterInvalid) {
+			// The import files failed the pre-validation of dataCoord, the task never succeeds, fail it rather than retry.
+			log.Warn("import task failed the pre-validation",
+				zap.Int64("task ID", it.GetTaskId()),
+				zap.String("cause", resp.GetStatus().GetReason()))
+			if err := m.failPendingTask(task, resp.GetStatus().GetReason()); err != nil {
+				return err
+			}
+			continue
+		}
 		if resp.GetStatus().GetErrorCode() != commonpb.ErrorCode_Success {
 			log.Warn("import task is rejected",
 				zap.Int64("task ID", it.GetTaskId()),
@@ -268,6 +278,22 @@ func (m *importManager) sendOutTasks(ctx context.Context) error {
 	return nil
 }
 
+// failPendingTask marks the task at the head of the pending list as failed and removes it from the list.
+// The caller must hold the pendingLock.
+func (m *importManager) failPendingTask(task *datapb.ImportTaskInfo, errReason string) error {
+	toPersistImportTaskInfo := cloneImportTaskInfo(task)
+	toPersistImportTaskInfo.State.StateCode = commonpb.ImportState_ImportFailed
+	tryUpdateErrMsg(errReason, toPersistImportTaskInfo)
+	if err := m.persistTaskInfo(toPersistImportTaskInfo); err != nil {
+		log.Error("failed to update import task",
+			zap.Int64("task ID", task.GetId()),
+			zap.Error(err))
+		return err
+	}
+	m.pendingTasks = append(m.pendingTasks[:0], m.pendingTasks[1:]...)
+	return nil
+}
+
 func (m *importManager) markTaskFailed(task *datapb.ImportTaskInfo) {
 	if err := m.setImportTaskStateAndReason(task.GetId(), commonpb.ImportState_ImportFailed,
 		"the import task failed"); err != nil {
@@ -399,18 +425,18 @@ func (m *importManager) isRowbased(files []string) (bool, error) {
 	isRowBased := false
 	for _, filePath := range files {
 		_, fileType := importutil.GetFileNameAndExt(filePath)
-		if fileType == importutil.JSONFileExt {
+		if importutil.IsRowBasedFileType(fileType) {
 			isRowBased = true
 		} else if isRowBased {
-			log.Error("row-based data file type must be JSON, mixed file types is not allowed", zap.Strings("files", files))
-			return isRowBased, fmt.Errorf("row-based data file type must be JSON, file type '%s' is not allowed", fileType)
+			log.Error("row-based data file type must be JSON, Arrow or Avro, mixed file types is not allowed", zap.Strings("files", files))
+			return isRowBased, fmt.Errorf("row-based data file type must be JSON, Arrow or Avro, file type '%s' is not allowed", fileType)
 		}
 	}
 
 	// for row_based, we only allow one file so that each invocation only generate a task
 	if isRowBased && len(files) > 1 {
-		log.Error("row-based import, only allow one JSON file each time", zap.Strings("files", files))
-		return isRowBased, fmt.Errorf("row-based import, only allow one JSON file each time")
+		log.Error("row-based import, only allow one file each time", zap.Strings("files", files))
+		return isRowBased, fmt.Errorf("row-based import, only allow one file each time")
 	}
 
 	return isRowBased, nil
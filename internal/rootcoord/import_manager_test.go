@@ -723,6 +723,45 @@ func TestImportManager_AllDataNodesBusy(t *testing.T) {
 	assert.Equal(t, 3, len(mgr.workingTasks))
 }
 
+func TestImportManager_PreValidationFailed(t *testing.T) {
+	idAlloc := func(count uint32) (typeutil.UniqueID, typeutil.UniqueID, error) {
+		return 1, 0, nil
+	}
+	paramtable.Get().Save(Params.RootCoordCfg.ImportTaskSubPath.Key, "test_import_task")
+	mockKv := memkv.NewMemoryKV()
+	rowReq := &milvuspb.ImportRequest{
+		CollectionName: "c1",
+		PartitionName:  "p1",
+		Files:          []string{"f1.json"},
+	}
+
+	importServiceFunc := func(ctx context.Context, req *datapb.ImportTaskRequest) (*datapb.ImportTaskResponse, error) {
+		return &datapb.ImportTaskResponse{
+			Status: merr.Status(merr.WrapErrParameterInvalidMsg("dim mismatch at the row 3")),
+		}, nil
+	}
+	callGetSegmentStates := func(ctx context.Context, req *datapb.GetSegmentStatesRequest) (*datapb.GetSegmentStatesResponse, error) {
+		return &datapb.GetSegmentStatesResponse{
+			Status: merr.Success(),
+		}, nil
+	}
+
+	// the task failed the pre-validation is removed from the pending list rather than retried
+	mgr := newImportManager(context.TODO(), mockKv, idAlloc, importServiceFunc, callGetSegmentStates, nil, nil)
+	resp := mgr.importJob(context.TODO(), rowReq, 100, 0)
+	assert.Equal(t, commonpb.ErrorCode_Success, resp.GetStatus().GetErrorCode())
+	assert.Equal(t, 0, len(mgr.pendingTasks))
+	assert.Equal(t, 0, len(mgr.workingTasks))
+
+	state := mgr.getTaskState(resp.GetTasks()[0])
+	assert.Equal(t, commonpb.ImportState_ImportFailed, state.GetState())
+	for _, info := range state.GetInfos() {
+		if info.GetKey() == importutil2.FailedReason {
+			assert.Contains(t, info.GetValue(), "dim mismatch at the row 3")
+		}
+	}
+}
+
 func TestImportManager_TaskState(t *testing.T) {
 	var countLock sync.RWMutex
 	globalCount := typeutil.UniqueID(0)
@@ -1100,4 +1139,14 @@ func TestImportManager_isRowbased(t *testing.T) {
 	rb, err = mgr.isRowbased(files)
 	assert.NoError(t, err)
 	assert.False(t, rb)
+
+	files = []string{"1.arrows"}
+	rb, err = mgr.isRowbased(files)
+	assert.NoError(t, err)
+	assert.True(t, rb)
+
+	files = []string{"1.avro", "2.npy"}
+	rb, err = mgr.isRowbased(files)
+	assert.Error(t, err)
+	assert.True(t, rb)
 }
@@ -19,7 +19,9 @@ package rootcoord
 import (
 	"context"
 	"fmt"
+	"sort"
 	"sync"
+	"time"
 
 	"github.com/cockroachdb/errors"
 	"go.uber.org/zap"
@@ -36,6 +38,7 @@ import (
 	"github.com/milvus-io/milvus/pkg/metrics"
 	"github.com/milvus-io/milvus/pkg/util"
 	"github.com/milvus-io/milvus/pkg/util/contextutil"
+	"github.com/milvus-io/milvus/pkg/util/crypto"
 	"github.com/milvus-io/milvus/pkg/util/funcutil"
 	"github.com/milvus-io/milvus/pkg/util/merr"
 	"github.com/milvus-io/milvus/pkg/util/timerecord"
@@ -53,6 +56,8 @@ type IMetaTable interface {
 	AddCollection(ctx context.Context, coll *model.Collection) error
 	ChangeCollectionState(ctx context.Context, collectionID UniqueID, state pb.CollectionState, ts Timestamp) error
 	RemoveCollection(ctx context.Context, collectionID UniqueID, ts Timestamp) error
+	MoveCollectionToTrash(ctx context.Context, collectionID UniqueID, droppedAt time.Time, ts Timestamp) error
+	RestoreCollection(ctx context.Context, collectionID UniqueID, ts Timestamp) error
 	GetCollectionByName(ctx context.Context, dbName string, collectionName string, ts Timestamp) (*model.Collection, error)
 	GetCollectionByID(ctx context.Context, dbName string, collectionID UniqueID, ts Timestamp, allowUnavailable bool) (*model.Collection, error)
 	ListCollections(ctx context.Context, dbName string, ts Timestamp, onlyAvail bool) ([]*model.Collection, error)
@@ -65,6 +70,7 @@ type IMetaTable interface {
 	CreateAlias(ctx context.Context, dbName string, alias string, collectionName string, ts Timestamp) error
 	DropAlias(ctx context.Context, dbName string, alias string, ts Timestamp) error
 	AlterAlias(ctx context.Context, dbName string, alias string, collectionName string, ts Timestamp) error
+	AlterAliasAndDropCollection(ctx context.Context, dbName string, alias string, collectionName string, ts Timestamp) error
 	AlterCollection(ctx context.Context, oldColl *model.Collection, newColl *model.Collection, ts Timestamp) error
 	RenameCollection(ctx context.Context, dbName string, oldName string, newDBName string, newName string, ts Timestamp) error
 
@@ -79,6 +85,7 @@ type IMetaTable interface {
 	// TODO: better to accept ctx.
 	AddCredential(credInfo *internalpb.CredentialInfo) error
 	GetCredential(username string) (*internalpb.CredentialInfo, error)
+	GetCredentialState(username string) (*crypto.CredentialState, error)
 	DeleteCredential(username string) error
 	AlterCredential(credInfo *internalpb.CredentialInfo) error
 	ListCredentialUsernames() (*milvuspb.ListCredUsersResponse, error)
@@ -94,6 +101,18 @@ type IMetaTable interface {
 	DropGrant(tenant string, role *milvuspb.RoleEntity) error
 	ListPolicy(tenant string) ([]string, error)
 	ListUserRole(tenant string) ([]string, error)
+	OperateRoleInheritance(tenant string, roleName string, parentRoleName string, operateType milvuspb.OperatePrivilegeType) error
+	ListRoleInheritance(tenant string) (map[string][]string, error)
+
+	CreateCollectionTemplate(ctx context.Context, template *model.CollectionTemplate) error
+	DropCollectionTemplate(ctx context.Context, name string) error
+	GetCollectionTemplate(ctx context.Context, name string) (*model.CollectionTemplate, error)
+	ListCollectionTemplates(ctx context.Context) ([]*model.CollectionTemplate, error)
+
+	SaveAPIKey(ctx context.Context, key *crypto.APIKey) error
+	GetAPIKey(ctx context.Context, id string) (*crypto.APIKey, error)
+	DropAPIKey(ctx context.Context, id string) error
+	ListAPIKeys(ctx context.Context, username string) ([]*crypto.APIKey, error)
 }
 
 type MetaTable struct {
@@ -111,6 +130,7 @@ type MetaTable struct {
 
 	ddLock         sync.RWMutex
 	permissionLock sync.RWMutex
+	templateLock   sync.RWMutex
 }
 
 func NewMetaTable(ctx context.Context, catalog metastore.RootCoordCatalog, tsoAllocator tso.Allocator) (*MetaTable, error) {
@@ -478,6 +498,83 @@ func (mt *MetaTable) RemoveCollection(ctx context.Context, collectionID UniqueID
 	return nil
 }
 
+// MoveCollectionToTrash marks the collection dropping and keeps it in the trash since droppedAt,
+// the collection is invisible to the users but could be restored until purged.
+func (mt *MetaTable) MoveCollectionToTrash(ctx context.Context, collectionID UniqueID, droppedAt time.Time, ts Timestamp) error {
+	mt.ddLock.Lock()
+	defer mt.ddLock.Unlock()
+
+	coll, ok := mt.collID2Meta[collectionID]
+	if !ok {
+		return nil
+	}
+	clone := coll.Clone()
+	clone.State = pb.CollectionState_CollectionDropping
+	clone.Properties = withCollectionTrashMark(clone.Properties, droppedAt)
+	ctx1 := contextutil.WithTenantID(ctx, Params.CommonCfg.ClusterName.GetValue())
+	if err := mt.catalog.AlterCollection(ctx1, coll, clone, metastore.MODIFY, ts); err != nil {
+		return err
+	}
+	mt.collID2Meta[collectionID] = clone
+
+	if coll.State == pb.CollectionState_CollectionCreated {
+		metrics.RootCoordNumOfCollections.Dec()
+		metrics.RootCoordNumOfPartitions.WithLabelValues().Sub(float64(coll.GetPartitionNum(true)))
+	}
+
+	log.Ctx(ctx).Info("move collection to trash", zap.Int64("collection", collectionID),
+		zap.Time("droppedAt", droppedAt), zap.Uint64("ts", ts))
+	return nil
+}
+
+// RestoreCollection restores the collection in the trash under its original name,
+// which fails if the name is used by another collection or alias since dropped.
+func (mt *MetaTable) RestoreCollection(ctx context.Context, collectionID UniqueID, ts Timestamp) error {
+	mt.ddLock.Lock()
+	defer mt.ddLock.Unlock()
+
+	coll, ok := mt.collID2Meta[collectionID]
+	if !ok {
+		return merr.WrapErrCollectionNotFound(collectionID)
+	}
+	if _, ok := collectionTrashDroppedAt(coll); !ok {
+		return merr.WrapErrParameterInvalidMsg("collection %d is not in the trash", collectionID)
+	}
+	dbName := util.DefaultDBName
+	if coll.DBID != util.NonDBID {
+		db, err := mt.getDatabaseByIDInternal(ctx, coll.DBID, typeutil.MaxTimestamp)
+		if err != nil {
+			return err
+		}
+		dbName = db.Name
+	}
+	if _, ok := mt.aliases.get(dbName, coll.Name); ok {
+		return merr.WrapErrParameterInvalidMsg("the name %s of collection %d is used by an alias", coll.Name, collectionID)
+	}
+	if id, ok := mt.names.get(dbName, coll.Name); ok && id != collectionID {
+		if other, ok := mt.collID2Meta[id]; ok && (other.Available() || other.State == pb.CollectionState_CollectionCreating) {
+			return merr.WrapErrParameterInvalidMsg("the name %s of collection %d is used by collection %d", coll.Name, collectionID, id)
+		}
+	}
+
+	clone := coll.Clone()
+	clone.State = pb.CollectionState_CollectionCreated
+	clone.Properties = withoutCollectionTrashMark(clone.Properties)
+	ctx1 := contextutil.WithTenantID(ctx, Params.CommonCfg.ClusterName.GetValue())
+	if err := mt.catalog.AlterCollection(ctx1, coll, clone, metastore.MODIFY, ts); err != nil {
+		return err
+	}
+	mt.collID2Meta[collectionID] = clone
+	mt.names.insert(dbName, coll.Name, collectionID)
+
+	metrics.RootCoordNumOfCollections.Inc()
+	metrics.RootCoordNumOfPartitions.WithLabelValues().Add(float64(clone.GetPartitionNum(true)))
+
+	log.Ctx(ctx).Info("restore collection from trash", zap.String("dbName", dbName),
+		zap.String("collection", coll.Name), zap.Int64("collectionID", collectionID), zap.Uint64("ts", ts))
+	return nil
+}
+
 func filterUnavailable(coll *model.Collection) *model.Collection {
 	clone := coll.Clone()
 	// pick available partitions.
@@ -1048,6 +1145,80 @@ func (mt *MetaTable) AlterAlias(ctx context.Context, dbName string, alias string
 	return nil
 }
 
+// AlterAliasAndDropCollection repoints the alias to the collection, and marks the collection the alias pointed to previously
+// dropping in the same meta transaction, so there is no moment the alias points to a dropping collection.
+func (mt *MetaTable) AlterAliasAndDropCollection(ctx context.Context, dbName string, alias string, collectionName string, ts Timestamp) error {
+	mt.ddLock.Lock()
+	defer mt.ddLock.Unlock()
+	if dbName == "" {
+		dbName = util.DefaultDBName
+	}
+
+	if !mt.names.exist(dbName) {
+		return merr.WrapErrDatabaseNotFound(dbName)
+	}
+
+	previousID, ok := mt.aliases.get(dbName, alias)
+	if !ok {
+		return merr.WrapErrAliasNotFound(dbName, alias)
+	}
+	previous, ok := mt.collID2Meta[previousID]
+	if !ok || !previous.Available() {
+		return merr.WrapErrCollectionNotFound(previousID)
+	}
+
+	collectionID, ok := mt.names.get(dbName, collectionName)
+	if !ok {
+		return merr.WrapErrCollectionNotFound(collectionName)
+	}
+	coll, ok := mt.collID2Meta[collectionID]
+	if !ok || !coll.Available() {
+		return merr.WrapErrCollectionNotFound(collectionName)
+	}
+	if collectionID == previousID {
+		return merr.WrapErrParameterInvalidMsg("alias %s already points to collection %s, can't drop it", alias, collectionName)
+	}
+	// the other aliases would point to the dropped collection
+	others := make([]string, 0)
+	for _, name := range mt.listAliasesByID(previousID) {
+		if name != alias {
+			others = append(others, name)
+		}
+	}
+	if len(others) > 0 {
+		return merr.WrapErrParameterInvalidMsg("collection %s has other aliases %v, can't drop it", previous.Name, others)
+	}
+
+	clone := previous.Clone()
+	clone.State = pb.CollectionState_CollectionDropping
+	ctx1 := contextutil.WithTenantID(ctx, Params.CommonCfg.ClusterName.GetValue())
+	if err := mt.catalog.AlterAliasWithCollection(ctx1, &model.Alias{
+		Name:         alias,
+		CollectionID: collectionID,
+		CreatedTime:  ts,
+		State:        pb.AliasState_AliasCreated,
+		DbID:         coll.DBID,
+	}, clone, ts); err != nil {
+		return err
+	}
+
+	mt.aliases.insert(dbName, alias, collectionID)
+	mt.collID2Meta[previousID] = clone
+	metrics.RootCoordNumOfCollections.Dec()
+	metrics.RootCoordNumOfPartitions.WithLabelValues().Sub(float64(previous.GetPartitionNum(true)))
+
+	log.Ctx(ctx).Info("alter alias and drop previous collection",
+		zap.String("db", dbName),
+		zap.String("alias", alias),
+		zap.String("collection", collectionName),
+		zap.String("previous", previous.Name),
+		zap.Int64("previousID", previousID),
+		zap.Uint64("ts", ts),
+	)
+
+	return nil
+}
+
 func (mt *MetaTable) IsAlias(db, name string) bool {
 	mt.ddLock.RLock()
 	defer mt.ddLock.RUnlock()
@@ -1167,6 +1338,7 @@ func (mt *MetaTable) AddCredential(credInfo *internalpb.CredentialInfo) error {
 	credential := &model.Credential{
 		Username:          credInfo.Username,
 		EncryptedPassword: credInfo.EncryptedPassword,
+		UpdatedTime:       time.Now().Unix(),
 	}
 	return mt.catalog.CreateCredential(mt.ctx, credential)
 }
@@ -1180,13 +1352,52 @@ func (mt *MetaTable) AlterCredential(credInfo *internalpb.CredentialInfo) error
 	mt.permissionLock.Lock()
 	defer mt.permissionLock.Unlock()
 
+	now := time.Now()
 	credential := &model.Credential{
 		Username:          credInfo.Username,
 		EncryptedPassword: credInfo.EncryptedPassword,
+		UpdatedTime:       now.Unix(),
+	}
+	origin, err := mt.catalog.GetCredential(mt.ctx, credInfo.Username)
+	if err != nil && !errors.Is(err, merr.ErrIoKeyNotFound) {
+		return err
+	}
+	if origin != nil && origin.EncryptedPassword != "" {
+		// keep the current password in history for the reuse check, the current one is checked separately
+		if historySize := Params.CommonCfg.PasswordReuseHistory.GetAsInt() - 1; historySize > 0 {
+			history := append([]string{origin.EncryptedPassword}, origin.PasswordHistory...)
+			if len(history) > historySize {
+				history = history[:historySize]
+			}
+			credential.PasswordHistory = history
+		}
+		// the previous password is still valid in the rotation window
+		if window := Params.CommonCfg.PasswordRotationWindow.GetAsDuration(time.Second); window > 0 {
+			credential.PreviousPassword = origin.EncryptedPassword
+			credential.PreviousPasswordExpireTime = now.Add(window).Unix()
+		}
 	}
 	return mt.catalog.AlterCredential(mt.ctx, credential)
 }
 
+// GetCredentialState get the password state of the user for the password policy enforcement
+func (mt *MetaTable) GetCredentialState(username string) (*crypto.CredentialState, error) {
+	mt.permissionLock.RLock()
+	defer mt.permissionLock.RUnlock()
+
+	credential, err := mt.catalog.GetCredential(mt.ctx, username)
+	if err != nil {
+		return nil, err
+	}
+	return &crypto.CredentialState{
+		EncryptedPassword:          credential.EncryptedPassword,
+		PreviousPassword:           credential.PreviousPassword,
+		PreviousPasswordExpireTime: credential.PreviousPasswordExpireTime,
+		PasswordHistory:            credential.PasswordHistory,
+		UpdatedTime:                credential.UpdatedTime,
+	}, nil
+}
+
 // GetCredential get credential by username
 func (mt *MetaTable) GetCredential(username string) (*internalpb.CredentialInfo, error) {
 	mt.permissionLock.RLock()
@@ -1201,7 +1412,23 @@ func (mt *MetaTable) DeleteCredential(username string) error {
 	mt.permissionLock.Lock()
 	defer mt.permissionLock.Unlock()
 
-	return mt.catalog.DropCredential(mt.ctx, username)
+	if err := mt.catalog.DropCredential(mt.ctx, username); err != nil {
+		return err
+	}
+	// the api keys of the user are dropped as well, otherwise they revive once the user is recreated
+	keys, err := mt.catalog.ListAPIKeys(mt.ctx)
+	if err != nil {
+		return err
+	}
+	for _, key := range keys {
+		if key.Username != username {
+			continue
+		}
+		if err := mt.catalog.DropAPIKey(mt.ctx, key.ID); err != nil {
+			return err
+		}
+	}
+	return nil
 }
 
 // ListCredentialUsernames list credential usernames
@@ -1365,3 +1592,163 @@ func (mt *MetaTable) ListUserRole(tenant string) ([]string, error) {
 
 	return mt.catalog.ListUserRole(mt.ctx, tenant)
 }
+
+// OperateRoleInheritance grants or revokes the parent role to the role, the role inherits the privileges of the parent role.
+// The inheritance which makes a cycle is rejected.
+func (mt *MetaTable) OperateRoleInheritance(tenant string, roleName string, parentRoleName string, operateType milvuspb.OperatePrivilegeType) error {
+	if funcutil.IsEmptyString(roleName) || funcutil.IsEmptyString(parentRoleName) {
+		return fmt.Errorf("the role name or the parent role name is empty")
+	}
+	if roleName == parentRoleName {
+		return fmt.Errorf("the role[%s] can't inherit itself", roleName)
+	}
+	if !funcutil.IsRevoke(operateType) && !funcutil.IsGrant(operateType) {
+		return fmt.Errorf("the operate type of the role inheritance is invalid")
+	}
+
+	mt.permissionLock.Lock()
+	defer mt.permissionLock.Unlock()
+
+	if funcutil.IsGrant(operateType) {
+		inheritances, err := mt.catalog.ListRoleInheritance(mt.ctx, tenant)
+		if err != nil {
+			return err
+		}
+		if isInheritedRole(inheritances, parentRoleName, roleName) {
+			return fmt.Errorf("the parent role[%s] has inherited the role[%s]", parentRoleName, roleName)
+		}
+	}
+	return mt.catalog.AlterRoleInheritance(mt.ctx, tenant, roleName, parentRoleName, operateType)
+}
+
+// ListRoleInheritance lists the parent roles of each role
+func (mt *MetaTable) ListRoleInheritance(tenant string) (map[string][]string, error) {
+	mt.permissionLock.RLock()
+	defer mt.permissionLock.RUnlock()
+
+	return mt.catalog.ListRoleInheritance(mt.ctx, tenant)
+}
+
+// isInheritedRole returns whether the role inherits the ancestor role directly or indirectly.
+func isInheritedRole(inheritances map[string][]string, roleName string, ancestorRoleName string) bool {
+	visited := make(map[string]struct{})
+	roles := []string{roleName}
+	for len(roles) > 0 {
+		role := roles[len(roles)-1]
+		roles = roles[:len(roles)-1]
+		if role == ancestorRoleName {
+			return true
+		}
+		if _, ok := visited[role]; ok {
+			continue
+		}
+		visited[role] = struct{}{}
+		roles = append(roles, inheritances[role]...)
+	}
+	return false
+}
+
+// CreateCollectionTemplate saves the collection template, the template with the same name is not overwritten.
+func (mt *MetaTable) CreateCollectionTemplate(ctx context.Context, template *model.CollectionTemplate) error {
+	mt.templateLock.Lock()
+	defer mt.templateLock.Unlock()
+
+	templates, err := mt.catalog.ListCollectionTemplates(ctx)
+	if err != nil {
+		return err
+	}
+	for _, t := range templates {
+		if t.Name == template.Name {
+			return merr.WrapErrParameterInvalidMsg("collection template %s already exists", template.Name)
+		}
+	}
+	return mt.catalog.SaveCollectionTemplate(ctx, template)
+}
+
+// DropCollectionTemplate removes the collection template, the collections created from it are not affected.
+func (mt *MetaTable) DropCollectionTemplate(ctx context.Context, name string) error {
+	mt.templateLock.Lock()
+	defer mt.templateLock.Unlock()
+
+	return mt.catalog.DropCollectionTemplate(ctx, name)
+}
+
+// GetCollectionTemplate returns the collection template by name.
+func (mt *MetaTable) GetCollectionTemplate(ctx context.Context, name string) (*model.CollectionTemplate, error) {
+	templates, err := mt.ListCollectionTemplates(ctx)
+	if err != nil {
+		return nil, err
+	}
+	for _, t := range templates {
+		if t.Name == name {
+			return t, nil
+		}
+	}
+	return nil, merr.WrapErrParameterInvalidMsg("collection template %s not found", name)
+}
+
+// ListCollectionTemplates lists all the collection templates ordered by name.
+func (mt *MetaTable) ListCollectionTemplates(ctx context.Context) ([]*model.CollectionTemplate, error) {
+	mt.templateLock.RLock()
+	defer mt.templateLock.RUnlock()
+
+	templates, err := mt.catalog.ListCollectionTemplates(ctx)
+	if err != nil {
+		return nil, err
+	}
+	sort.Slice(templates, func(i, j int) bool {
+		return templates[i].Name < templates[j].Name
+	})
+	return templates, nil
+}
+
+// SaveAPIKey creates or overwrites the api key.
+func (mt *MetaTable) SaveAPIKey(ctx context.Context, key *crypto.APIKey) error {
+	mt.permissionLock.Lock()
+	defer mt.permissionLock.Unlock()
+
+	return mt.catalog.SaveAPIKey(ctx, key)
+}
+
+// GetAPIKey returns the api key by id.
+func (mt *MetaTable) GetAPIKey(ctx context.Context, id string) (*crypto.APIKey, error) {
+	mt.permissionLock.RLock()
+	defer mt.permissionLock.RUnlock()
+
+	return mt.catalog.GetAPIKey(ctx, id)
+}
+
+// DropAPIKey removes the api key.
+func (mt *MetaTable) DropAPIKey(ctx context.Context, id string) error {
+	mt.permissionLock.Lock()
+	defer mt.permissionLock.Unlock()
+
+	return mt.catalog.DropAPIKey(ctx, id)
+}
+
+// ListAPIKeys lists the api keys of the user ordered by created time, all the keys are listed if the username is empty.
+func (mt *MetaTable) ListAPIKeys(ctx context.Context, username string) ([]*crypto.APIKey, error) {
+	mt.permissionLock.RLock()
+	defer mt.permissionLock.RUnlock()
+
+	keys, err := mt.catalog.ListAPIKeys(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if username != "" {
+		filtered := make([]*crypto.APIKey, 0, len(keys))
+		for _, key := range keys {
+			if key.Username == username {
+				filtered = append(filtered, key)
+			}
+		}
+		keys = filtered
+	}
+	sort.Slice(keys, func(i, j int) bool {
+		if keys[i].CreatedTime != keys[j].CreatedTime {
+			return keys[i].CreatedTime < keys[j].CreatedTime
+		}
+		return keys[i].ID < keys[j].ID
+	})
+	return keys, nil
+}
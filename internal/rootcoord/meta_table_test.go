@@ -18,8 +18,10 @@ package rootcoord
 
 import (
 	"context"
+	"fmt"
 	"math/rand"
 	"testing"
+	"time"
 
 	"github.com/cockroachdb/errors"
 	"github.com/stretchr/testify/assert"
@@ -27,6 +29,7 @@ import (
 	"github.com/stretchr/testify/require"
 
 	"github.com/milvus-io/milvus-proto/go-api/v2/milvuspb"
+	"github.com/milvus-io/milvus-proto/go-api/v2/schemapb"
 	memkv "github.com/milvus-io/milvus/internal/kv/mem"
 	"github.com/milvus-io/milvus/internal/metastore/kv/rootcoord"
 	"github.com/milvus-io/milvus/internal/metastore/mocks"
@@ -78,6 +81,48 @@ func TestRbacAddCredential(t *testing.T) {
 	}
 }
 
+func TestRbacAlterCredential(t *testing.T) {
+	paramtable.Get().Save(Params.CommonCfg.PasswordReuseHistory.Key, "3")
+	defer paramtable.Get().Reset(Params.CommonCfg.PasswordReuseHistory.Key)
+	paramtable.Get().Save(Params.CommonCfg.PasswordRotationWindow.Key, "3600")
+	defer paramtable.Get().Reset(Params.CommonCfg.PasswordRotationWindow.Key)
+
+	mt := generateMetaTable(t)
+	err := mt.AddCredential(&internalpb.CredentialInfo{Username: "user1", EncryptedPassword: "pwd1"})
+	require.NoError(t, err)
+	state, err := mt.GetCredentialState("user1")
+	require.NoError(t, err)
+	assert.Equal(t, "pwd1", state.EncryptedPassword)
+	assert.Empty(t, state.PreviousPassword)
+	assert.Empty(t, state.PasswordHistory)
+	assert.NotZero(t, state.UpdatedTime)
+
+	for i := 2; i <= 4; i++ {
+		err = mt.AlterCredential(&internalpb.CredentialInfo{Username: "user1", EncryptedPassword: fmt.Sprintf("pwd%d", i)})
+		require.NoError(t, err)
+	}
+	state, err = mt.GetCredentialState("user1")
+	require.NoError(t, err)
+	assert.Equal(t, "pwd4", state.EncryptedPassword)
+	assert.Equal(t, "pwd3", state.PreviousPassword)
+	assert.Greater(t, state.PreviousPasswordExpireTime, time.Now().Unix())
+	assert.Equal(t, []string{"pwd3", "pwd2"}, state.PasswordHistory)
+
+	// no previous password or history if the policy is disabled
+	paramtable.Get().Save(Params.CommonCfg.PasswordReuseHistory.Key, "0")
+	paramtable.Get().Save(Params.CommonCfg.PasswordRotationWindow.Key, "0")
+	err = mt.AlterCredential(&internalpb.CredentialInfo{Username: "user1", EncryptedPassword: "pwd5"})
+	require.NoError(t, err)
+	state, err = mt.GetCredentialState("user1")
+	require.NoError(t, err)
+	assert.Equal(t, "pwd5", state.EncryptedPassword)
+	assert.Empty(t, state.PreviousPassword)
+	assert.Empty(t, state.PasswordHistory)
+
+	_, err = mt.GetCredentialState("user2")
+	assert.Error(t, err)
+}
+
 func TestRbacCreateRole(t *testing.T) {
 	mt := generateMetaTable(t)
 
@@ -447,6 +492,86 @@ func TestRbacListPolicy(t *testing.T) {
 	assert.Equal(t, 0, len(userRoles))
 }
 
+func TestRbacOperateRoleInheritance(t *testing.T) {
+	mt := generateMetaTable(t)
+
+	tests := []struct {
+		description string
+
+		isValid     bool
+		role        string
+		parentRole  string
+		operateType milvuspb.OperatePrivilegeType
+	}{
+		{"empty role name", false, "", "reader", milvuspb.OperatePrivilegeType_Grant},
+		{"empty parent role name", false, "team", "", milvuspb.OperatePrivilegeType_Grant},
+		{"inherit itself", false, "team", "team", milvuspb.OperatePrivilegeType_Grant},
+		{"invalid operate type", false, "team", "reader", milvuspb.OperatePrivilegeType(100)},
+		{"valid grant", true, "team", "reader", milvuspb.OperatePrivilegeType_Grant},
+		{"valid indirect grant", true, "reader", "base", milvuspb.OperatePrivilegeType_Grant},
+		{"cycle inheritance", false, "base", "team", milvuspb.OperatePrivilegeType_Grant},
+	}
+
+	for _, test := range tests {
+		t.Run(test.description, func(t *testing.T) {
+			err := mt.OperateRoleInheritance(util.DefaultTenant, test.role, test.parentRole, test.operateType)
+			if test.isValid {
+				assert.NoError(t, err)
+			} else {
+				assert.Error(t, err)
+			}
+		})
+	}
+
+	inheritances, err := mt.ListRoleInheritance(util.DefaultTenant)
+	assert.NoError(t, err)
+	assert.ElementsMatch(t, []string{"reader"}, inheritances["team"])
+	assert.ElementsMatch(t, []string{"base"}, inheritances["reader"])
+
+	err = mt.OperateRoleInheritance(util.DefaultTenant, "team", "reader", milvuspb.OperatePrivilegeType_Revoke)
+	assert.NoError(t, err)
+	inheritances, err = mt.ListRoleInheritance(util.DefaultTenant)
+	assert.NoError(t, err)
+	assert.Empty(t, inheritances["team"])
+}
+
+func TestMetaTable_CollectionTemplates(t *testing.T) {
+	ctx := context.Background()
+	mt := generateMetaTable(t)
+
+	for _, name := range []string{"openai-1536-cosine", "bge-768-ip"} {
+		err := mt.CreateCollectionTemplate(ctx, &model.CollectionTemplate{
+			Name:   name,
+			Schema: &schemapb.CollectionSchema{Name: name},
+		})
+		assert.NoError(t, err)
+	}
+	err := mt.CreateCollectionTemplate(ctx, &model.CollectionTemplate{Name: "bge-768-ip", Schema: &schemapb.CollectionSchema{}})
+	assert.ErrorIs(t, err, merr.ErrParameterInvalid)
+
+	templates, err := mt.ListCollectionTemplates(ctx)
+	assert.NoError(t, err)
+	assert.Len(t, templates, 2)
+	assert.Equal(t, "bge-768-ip", templates[0].Name)
+
+	template, err := mt.GetCollectionTemplate(ctx, "openai-1536-cosine")
+	assert.NoError(t, err)
+	assert.Equal(t, "openai-1536-cosine", template.Schema.GetName())
+
+	assert.NoError(t, mt.DropCollectionTemplate(ctx, "openai-1536-cosine"))
+	_, err = mt.GetCollectionTemplate(ctx, "openai-1536-cosine")
+	assert.ErrorIs(t, err, merr.ErrParameterInvalid)
+
+	t.Run("failed to list", func(t *testing.T) {
+		catalog := mocks.NewRootCoordCatalog(t)
+		catalog.On("ListCollectionTemplates", mock.Anything).Return(nil, errors.New("error mock ListCollectionTemplates"))
+		mt := &MetaTable{catalog: catalog}
+		assert.Error(t, mt.CreateCollectionTemplate(ctx, &model.CollectionTemplate{Name: "test"}))
+		_, err := mt.GetCollectionTemplate(ctx, "test")
+		assert.Error(t, err)
+	})
+}
+
 func TestMetaTable_getCollectionByIDInternal(t *testing.T) {
 	t.Run("failed to get from catalog", func(t *testing.T) {
 		catalog := mocks.NewRootCoordCatalog(t)
@@ -1127,6 +1252,85 @@ func TestMetaTable_ChangeCollectionState(t *testing.T) {
 	})
 }
 
+func TestMetaTable_AlterAliasAndDropCollection(t *testing.T) {
+	newMeta := func(catalog *mocks.RootCoordCatalog) *MetaTable {
+		meta := &MetaTable{
+			catalog: catalog,
+			names:   newNameDb(),
+			aliases: newNameDb(),
+			collID2Meta: map[typeutil.UniqueID]*model.Collection{
+				100: {Name: "blue", CollectionID: 100, DBID: util.DefaultDBID, State: pb.CollectionState_CollectionCreated},
+				101: {Name: "green", CollectionID: 101, DBID: util.DefaultDBID, State: pb.CollectionState_CollectionCreated},
+			},
+		}
+		meta.names.insert(util.DefaultDBName, "blue", 100)
+		meta.names.insert(util.DefaultDBName, "green", 101)
+		meta.aliases.insert(util.DefaultDBName, "prod", 100)
+		return meta
+	}
+
+	t.Run("database not exist", func(t *testing.T) {
+		meta := newMeta(nil)
+		err := meta.AlterAliasAndDropCollection(context.TODO(), "non-exist", "prod", "green", 1000)
+		assert.ErrorIs(t, err, merr.ErrDatabaseNotFound)
+	})
+
+	t.Run("alias not exist", func(t *testing.T) {
+		meta := newMeta(nil)
+		err := meta.AlterAliasAndDropCollection(context.TODO(), "", "non-exist", "green", 1000)
+		assert.ErrorIs(t, err, merr.ErrAliasNotFound)
+	})
+
+	t.Run("collection not exist", func(t *testing.T) {
+		meta := newMeta(nil)
+		err := meta.AlterAliasAndDropCollection(context.TODO(), "", "prod", "non-exist", 1000)
+		assert.ErrorIs(t, err, merr.ErrCollectionNotFound)
+	})
+
+	t.Run("alias already points to collection", func(t *testing.T) {
+		meta := newMeta(nil)
+		err := meta.AlterAliasAndDropCollection(context.TODO(), "", "prod", "blue", 1000)
+		assert.ErrorIs(t, err, merr.ErrParameterInvalid)
+	})
+
+	t.Run("previous collection has other aliases", func(t *testing.T) {
+		meta := newMeta(nil)
+		meta.aliases.insert(util.DefaultDBName, "backup", 100)
+		err := meta.AlterAliasAndDropCollection(context.TODO(), "", "prod", "green", 1000)
+		assert.ErrorIs(t, err, merr.ErrParameterInvalid)
+	})
+
+	t.Run("failed to save", func(t *testing.T) {
+		catalog := mocks.NewRootCoordCatalog(t)
+		catalog.EXPECT().AlterAliasWithCollection(mock.Anything, mock.Anything, mock.Anything, mock.Anything).
+			Return(errors.New("error mock AlterAliasWithCollection"))
+		meta := newMeta(catalog)
+		err := meta.AlterAliasAndDropCollection(context.TODO(), "", "prod", "green", 1000)
+		assert.Error(t, err)
+		id, _ := meta.aliases.get(util.DefaultDBName, "prod")
+		assert.EqualValues(t, 100, id)
+		assert.True(t, meta.collID2Meta[100].Available())
+	})
+
+	t.Run("normal case", func(t *testing.T) {
+		catalog := mocks.NewRootCoordCatalog(t)
+		catalog.EXPECT().AlterAliasWithCollection(mock.Anything, mock.Anything, mock.Anything, mock.Anything).
+			RunAndReturn(func(ctx context.Context, alias *model.Alias, coll *model.Collection, ts uint64) error {
+				assert.Equal(t, "prod", alias.Name)
+				assert.EqualValues(t, 101, alias.CollectionID)
+				assert.EqualValues(t, 100, coll.CollectionID)
+				assert.Equal(t, pb.CollectionState_CollectionDropping, coll.State)
+				return nil
+			})
+		meta := newMeta(catalog)
+		err := meta.AlterAliasAndDropCollection(context.TODO(), "", "prod", "green", 1000)
+		assert.NoError(t, err)
+		id, _ := meta.aliases.get(util.DefaultDBName, "prod")
+		assert.EqualValues(t, 101, id)
+		assert.Equal(t, pb.CollectionState_CollectionDropping, meta.collID2Meta[100].State)
+	})
+}
+
 func TestMetaTable_AddPartition(t *testing.T) {
 	t.Run("collection not available", func(t *testing.T) {
 		meta := &MetaTable{}
@@ -20,6 +20,7 @@ import (
 	"context"
 	"math/rand"
 	"os"
+	"time"
 
 	"github.com/cockroachdb/errors"
 	"github.com/stretchr/testify/mock"
@@ -43,6 +44,7 @@ import (
 	"github.com/milvus-io/milvus/internal/util/sessionutil"
 	"github.com/milvus-io/milvus/pkg/log"
 	"github.com/milvus-io/milvus/pkg/mq/msgstream"
+	"github.com/milvus-io/milvus/pkg/util/crypto"
 	"github.com/milvus-io/milvus/pkg/util/merr"
 	"github.com/milvus-io/milvus/pkg/util/metricsinfo"
 	"github.com/milvus-io/milvus/pkg/util/paramtable"
@@ -64,6 +66,8 @@ type mockMetaTable struct {
 	GetCollectionByIDFunc            func(ctx context.Context, collectionID UniqueID, ts Timestamp, allowUnavailable bool) (*model.Collection, error)
 	ChangeCollectionStateFunc        func(ctx context.Context, collectionID UniqueID, state pb.CollectionState, ts Timestamp) error
 	RemoveCollectionFunc             func(ctx context.Context, collectionID UniqueID, ts Timestamp) error
+	MoveCollectionToTrashFunc        func(ctx context.Context, collectionID UniqueID, droppedAt time.Time, ts Timestamp) error
+	RestoreCollectionFunc            func(ctx context.Context, collectionID UniqueID, ts Timestamp) error
 	AddPartitionFunc                 func(ctx context.Context, partition *model.Partition) error
 	ChangePartitionStateFunc         func(ctx context.Context, collectionID UniqueID, partitionID UniqueID, state pb.PartitionState, ts Timestamp) error
 	RemovePartitionFunc              func(ctx context.Context, collectionID UniqueID, partitionID UniqueID, ts Timestamp) error
@@ -79,6 +83,7 @@ type mockMetaTable struct {
 	RenameCollectionFunc             func(ctx context.Context, oldName string, newName string, ts Timestamp) error
 	AddCredentialFunc                func(credInfo *internalpb.CredentialInfo) error
 	GetCredentialFunc                func(username string) (*internalpb.CredentialInfo, error)
+	GetCredentialStateFunc           func(username string) (*crypto.CredentialState, error)
 	DeleteCredentialFunc             func(username string) error
 	AlterCredentialFunc              func(credInfo *internalpb.CredentialInfo) error
 	ListCredentialUsernamesFunc      func() (*milvuspb.ListCredUsersResponse, error)
@@ -92,6 +97,16 @@ type mockMetaTable struct {
 	DropGrantFunc                    func(tenant string, role *milvuspb.RoleEntity) error
 	ListPolicyFunc                   func(tenant string) ([]string, error)
 	ListUserRoleFunc                 func(tenant string) ([]string, error)
+	OperateRoleInheritanceFunc       func(tenant string, roleName string, parentRoleName string, operateType milvuspb.OperatePrivilegeType) error
+	ListRoleInheritanceFunc          func(tenant string) (map[string][]string, error)
+	CreateCollectionTemplateFunc     func(ctx context.Context, template *model.CollectionTemplate) error
+	DropCollectionTemplateFunc       func(ctx context.Context, name string) error
+	GetCollectionTemplateFunc        func(ctx context.Context, name string) (*model.CollectionTemplate, error)
+	ListCollectionTemplatesFunc      func(ctx context.Context) ([]*model.CollectionTemplate, error)
+	SaveAPIKeyFunc                   func(ctx context.Context, key *crypto.APIKey) error
+	GetAPIKeyFunc                    func(ctx context.Context, id string) (*crypto.APIKey, error)
+	DropAPIKeyFunc                   func(ctx context.Context, id string) error
+	ListAPIKeysFunc                  func(ctx context.Context, username string) ([]*crypto.APIKey, error)
 }
 
 func (m mockMetaTable) ListDatabases(ctx context.Context, ts typeutil.Timestamp) ([]*model.Database, error) {
@@ -122,6 +137,14 @@ func (m mockMetaTable) RemoveCollection(ctx context.Context, collectionID Unique
 	return m.RemoveCollectionFunc(ctx, collectionID, ts)
 }
 
+func (m mockMetaTable) MoveCollectionToTrash(ctx context.Context, collectionID UniqueID, droppedAt time.Time, ts Timestamp) error {
+	return m.MoveCollectionToTrashFunc(ctx, collectionID, droppedAt, ts)
+}
+
+func (m mockMetaTable) RestoreCollection(ctx context.Context, collectionID UniqueID, ts Timestamp) error {
+	return m.RestoreCollectionFunc(ctx, collectionID, ts)
+}
+
 func (m mockMetaTable) AddPartition(ctx context.Context, partition *model.Partition) error {
 	return m.AddPartitionFunc(ctx, partition)
 }
@@ -182,6 +205,10 @@ func (m mockMetaTable) GetCredential(username string) (*internalpb.CredentialInf
 	return m.GetCredentialFunc(username)
 }
 
+func (m mockMetaTable) GetCredentialState(username string) (*crypto.CredentialState, error) {
+	return m.GetCredentialStateFunc(username)
+}
+
 func (m mockMetaTable) DeleteCredential(username string) error {
 	return m.DeleteCredentialFunc(username)
 }
@@ -234,6 +261,46 @@ func (m mockMetaTable) ListUserRole(tenant string) ([]string, error) {
 	return m.ListUserRoleFunc(tenant)
 }
 
+func (m mockMetaTable) OperateRoleInheritance(tenant string, roleName string, parentRoleName string, operateType milvuspb.OperatePrivilegeType) error {
+	return m.OperateRoleInheritanceFunc(tenant, roleName, parentRoleName, operateType)
+}
+
+func (m mockMetaTable) ListRoleInheritance(tenant string) (map[string][]string, error) {
+	return m.ListRoleInheritanceFunc(tenant)
+}
+
+func (m mockMetaTable) CreateCollectionTemplate(ctx context.Context, template *model.CollectionTemplate) error {
+	return m.CreateCollectionTemplateFunc(ctx, template)
+}
+
+func (m mockMetaTable) DropCollectionTemplate(ctx context.Context, name string) error {
+	return m.DropCollectionTemplateFunc(ctx, name)
+}
+
+func (m mockMetaTable) GetCollectionTemplate(ctx context.Context, name string) (*model.CollectionTemplate, error) {
+	return m.GetCollectionTemplateFunc(ctx, name)
+}
+
+func (m mockMetaTable) ListCollectionTemplates(ctx context.Context) ([]*model.CollectionTemplate, error) {
+	return m.ListCollectionTemplatesFunc(ctx)
+}
+
+func (m mockMetaTable) SaveAPIKey(ctx context.Context, key *crypto.APIKey) error {
+	return m.SaveAPIKeyFunc(ctx, key)
+}
+
+func (m mockMetaTable) GetAPIKey(ctx context.Context, id string) (*crypto.APIKey, error) {
+	return m.GetAPIKeyFunc(ctx, id)
+}
+
+func (m mockMetaTable) DropAPIKey(ctx context.Context, id string) error {
+	return m.DropAPIKeyFunc(ctx, id)
+}
+
+func (m mockMetaTable) ListAPIKeys(ctx context.Context, username string) ([]*crypto.APIKey, error) {
+	return m.ListAPIKeysFunc(ctx, username)
+}
+
 func newMockMetaTable() *mockMetaTable {
 	return &mockMetaTable{}
 }
@@ -534,6 +601,36 @@ func withInvalidMeta() Opt {
 	meta.ListUserRoleFunc = func(tenant string) ([]string, error) {
 		return nil, errors.New("error mock ListUserRole")
 	}
+	meta.OperateRoleInheritanceFunc = func(tenant string, roleName string, parentRoleName string, operateType milvuspb.OperatePrivilegeType) error {
+		return errors.New("error mock OperateRoleInheritance")
+	}
+	meta.ListRoleInheritanceFunc = func(tenant string) (map[string][]string, error) {
+		return nil, errors.New("error mock ListRoleInheritance")
+	}
+	meta.CreateCollectionTemplateFunc = func(ctx context.Context, template *model.CollectionTemplate) error {
+		return errors.New("error mock CreateCollectionTemplate")
+	}
+	meta.DropCollectionTemplateFunc = func(ctx context.Context, name string) error {
+		return errors.New("error mock DropCollectionTemplate")
+	}
+	meta.GetCollectionTemplateFunc = func(ctx context.Context, name string) (*model.CollectionTemplate, error) {
+		return nil, errors.New("error mock GetCollectionTemplate")
+	}
+	meta.ListCollectionTemplatesFunc = func(ctx context.Context) ([]*model.CollectionTemplate, error) {
+		return nil, errors.New("error mock ListCollectionTemplates")
+	}
+	meta.SaveAPIKeyFunc = func(ctx context.Context, key *crypto.APIKey) error {
+		return errors.New("error mock SaveAPIKey")
+	}
+	meta.GetAPIKeyFunc = func(ctx context.Context, id string) (*crypto.APIKey, error) {
+		return nil, errors.New("error mock GetAPIKey")
+	}
+	meta.DropAPIKeyFunc = func(ctx context.Context, id string) error {
+		return errors.New("error mock DropAPIKey")
+	}
+	meta.ListAPIKeysFunc = func(ctx context.Context, username string) ([]*crypto.APIKey, error) {
+		return nil, errors.New("error mock ListAPIKeys")
+	}
 	return withMeta(meta)
 }
 
@@ -837,6 +934,8 @@ type mockScheduler struct {
 	AddTaskFunc     func(t task) error
 	GetMinDdlTsFunc func() Timestamp
 	StopFunc        func()
+	ListTasksFunc   func() []*ddlTaskInfo
+	CancelTaskFunc  func(id UniqueID) error
 	minDdlTs        Timestamp
 }
 
@@ -864,6 +963,20 @@ func (m mockScheduler) Stop() {
 	}
 }
 
+func (m mockScheduler) ListTasks() []*ddlTaskInfo {
+	if m.ListTasksFunc != nil {
+		return m.ListTasksFunc()
+	}
+	return nil
+}
+
+func (m mockScheduler) CancelTask(id UniqueID) error {
+	if m.CancelTaskFunc != nil {
+		return m.CancelTaskFunc(id)
+	}
+	return nil
+}
+
 func withScheduler(sched IScheduler) Opt {
 	return func(c *Core) {
 		c.scheduler = sched
@@ -939,6 +1052,7 @@ type mockBroker struct {
 	GetSegmentIndexStateFunc func(ctx context.Context, collID UniqueID, indexName string, segIDs []UniqueID) ([]*indexpb.SegmentIndexState, error)
 
 	BroadcastAlteredCollectionFunc func(ctx context.Context, req *milvuspb.AlterCollectionRequest) error
+	CheckChannelsDrainedFunc       func(ctx context.Context, collectionID UniqueID, vChannels []string) error
 
 	GCConfirmFunc func(ctx context.Context, collectionID, partitionID UniqueID) bool
 }
@@ -983,6 +1097,10 @@ func (b mockBroker) BroadcastAlteredCollection(ctx context.Context, req *milvusp
 	return b.BroadcastAlteredCollectionFunc(ctx, req)
 }
 
+func (b mockBroker) CheckChannelsDrained(ctx context.Context, collectionID UniqueID, vChannels []string) error {
+	return b.CheckChannelsDrainedFunc(ctx, collectionID, vChannels)
+}
+
 func (b mockBroker) GcConfirm(ctx context.Context, collectionID, partitionID UniqueID) bool {
 	return b.GCConfirmFunc(ctx, collectionID, partitionID)
 }
@@ -5,6 +5,10 @@ package mockrootcoord
 import (
 	context "context"
 
+	time "time"
+
+	crypto "github.com/milvus-io/milvus/pkg/util/crypto"
+
 	etcdpb "github.com/milvus-io/milvus/internal/proto/etcdpb"
 	internalpb "github.com/milvus-io/milvus/internal/proto/internalpb"
 
@@ -202,6 +206,52 @@ func (_c *IMetaTable_AlterAlias_Call) RunAndReturn(run func(context.Context, str
 	return _c
 }
 
+// AlterAliasAndDropCollection provides a mock function with given fields: ctx, dbName, alias, collectionName, ts
+func (_m *IMetaTable) AlterAliasAndDropCollection(ctx context.Context, dbName string, alias string, collectionName string, ts uint64) error {
+	ret := _m.Called(ctx, dbName, alias, collectionName, ts)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, string, uint64) error); ok {
+		r0 = rf(ctx, dbName, alias, collectionName, ts)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// IMetaTable_AlterAliasAndDropCollection_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'AlterAliasAndDropCollection'
+type IMetaTable_AlterAliasAndDropCollection_Call struct {
+	*mock.Call
+}
+
+// AlterAliasAndDropCollection is a helper method to define mock.On call
+//   - ctx context.Context
+//   - dbName string
+//   - alias string
+//   - collectionName string
+//   - ts uint64
+func (_e *IMetaTable_Expecter) AlterAliasAndDropCollection(ctx interface{}, dbName interface{}, alias interface{}, collectionName interface{}, ts interface{}) *IMetaTable_AlterAliasAndDropCollection_Call {
+	return &IMetaTable_AlterAliasAndDropCollection_Call{Call: _e.mock.On("AlterAliasAndDropCollection", ctx, dbName, alias, collectionName, ts)}
+}
+
+func (_c *IMetaTable_AlterAliasAndDropCollection_Call) Run(run func(ctx context.Context, dbName string, alias string, collectionName string, ts uint64)) *IMetaTable_AlterAliasAndDropCollection_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(string), args[3].(string), args[4].(uint64))
+	})
+	return _c
+}
+
+func (_c *IMetaTable_AlterAliasAndDropCollection_Call) Return(_a0 error) *IMetaTable_AlterAliasAndDropCollection_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *IMetaTable_AlterAliasAndDropCollection_Call) RunAndReturn(run func(context.Context, string, string, string, uint64) error) *IMetaTable_AlterAliasAndDropCollection_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // AlterCollection provides a mock function with given fields: ctx, oldColl, newColl, ts
 func (_m *IMetaTable) AlterCollection(ctx context.Context, oldColl *model.Collection, newColl *model.Collection, ts uint64) error {
 	ret := _m.Called(ctx, oldColl, newColl, ts)
@@ -426,6 +476,49 @@ func (_c *IMetaTable_CreateAlias_Call) RunAndReturn(run func(context.Context, st
 	return _c
 }
 
+// CreateCollectionTemplate provides a mock function with given fields: ctx, template
+func (_m *IMetaTable) CreateCollectionTemplate(ctx context.Context, template *model.CollectionTemplate) error {
+	ret := _m.Called(ctx, template)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, *model.CollectionTemplate) error); ok {
+		r0 = rf(ctx, template)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// IMetaTable_CreateCollectionTemplate_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateCollectionTemplate'
+type IMetaTable_CreateCollectionTemplate_Call struct {
+	*mock.Call
+}
+
+// CreateCollectionTemplate is a helper method to define mock.On call
+//   - ctx context.Context
+//   - template *model.CollectionTemplate
+func (_e *IMetaTable_Expecter) CreateCollectionTemplate(ctx interface{}, template interface{}) *IMetaTable_CreateCollectionTemplate_Call {
+	return &IMetaTable_CreateCollectionTemplate_Call{Call: _e.mock.On("CreateCollectionTemplate", ctx, template)}
+}
+
+func (_c *IMetaTable_CreateCollectionTemplate_Call) Run(run func(ctx context.Context, template *model.CollectionTemplate)) *IMetaTable_CreateCollectionTemplate_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*model.CollectionTemplate))
+	})
+	return _c
+}
+
+func (_c *IMetaTable_CreateCollectionTemplate_Call) Return(_a0 error) *IMetaTable_CreateCollectionTemplate_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *IMetaTable_CreateCollectionTemplate_Call) RunAndReturn(run func(context.Context, *model.CollectionTemplate) error) *IMetaTable_CreateCollectionTemplate_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // CreateDatabase provides a mock function with given fields: ctx, db, ts
 func (_m *IMetaTable) CreateDatabase(ctx context.Context, db *model.Database, ts uint64) error {
 	ret := _m.Called(ctx, db, ts)
@@ -555,6 +648,49 @@ func (_c *IMetaTable_DeleteCredential_Call) RunAndReturn(run func(string) error)
 	return _c
 }
 
+// DropAPIKey provides a mock function with given fields: ctx, id
+func (_m *IMetaTable) DropAPIKey(ctx context.Context, id string) error {
+	ret := _m.Called(ctx, id)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) error); ok {
+		r0 = rf(ctx, id)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// IMetaTable_DropAPIKey_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DropAPIKey'
+type IMetaTable_DropAPIKey_Call struct {
+	*mock.Call
+}
+
+// DropAPIKey is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id string
+func (_e *IMetaTable_Expecter) DropAPIKey(ctx interface{}, id interface{}) *IMetaTable_DropAPIKey_Call {
+	return &IMetaTable_DropAPIKey_Call{Call: _e.mock.On("DropAPIKey", ctx, id)}
+}
+
+func (_c *IMetaTable_DropAPIKey_Call) Run(run func(ctx context.Context, id string)) *IMetaTable_DropAPIKey_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *IMetaTable_DropAPIKey_Call) Return(_a0 error) *IMetaTable_DropAPIKey_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *IMetaTable_DropAPIKey_Call) RunAndReturn(run func(context.Context, string) error) *IMetaTable_DropAPIKey_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // DropAlias provides a mock function with given fields: ctx, dbName, alias, ts
 func (_m *IMetaTable) DropAlias(ctx context.Context, dbName string, alias string, ts uint64) error {
 	ret := _m.Called(ctx, dbName, alias, ts)
@@ -600,6 +736,49 @@ func (_c *IMetaTable_DropAlias_Call) RunAndReturn(run func(context.Context, stri
 	return _c
 }
 
+// DropCollectionTemplate provides a mock function with given fields: ctx, name
+func (_m *IMetaTable) DropCollectionTemplate(ctx context.Context, name string) error {
+	ret := _m.Called(ctx, name)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) error); ok {
+		r0 = rf(ctx, name)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// IMetaTable_DropCollectionTemplate_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DropCollectionTemplate'
+type IMetaTable_DropCollectionTemplate_Call struct {
+	*mock.Call
+}
+
+// DropCollectionTemplate is a helper method to define mock.On call
+//   - ctx context.Context
+//   - name string
+func (_e *IMetaTable_Expecter) DropCollectionTemplate(ctx interface{}, name interface{}) *IMetaTable_DropCollectionTemplate_Call {
+	return &IMetaTable_DropCollectionTemplate_Call{Call: _e.mock.On("DropCollectionTemplate", ctx, name)}
+}
+
+func (_c *IMetaTable_DropCollectionTemplate_Call) Run(run func(ctx context.Context, name string)) *IMetaTable_DropCollectionTemplate_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *IMetaTable_DropCollectionTemplate_Call) Return(_a0 error) *IMetaTable_DropCollectionTemplate_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *IMetaTable_DropCollectionTemplate_Call) RunAndReturn(run func(context.Context, string) error) *IMetaTable_DropCollectionTemplate_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // DropDatabase provides a mock function with given fields: ctx, dbName, ts
 func (_m *IMetaTable) DropDatabase(ctx context.Context, dbName string, ts uint64) error {
 	ret := _m.Called(ctx, dbName, ts)
@@ -730,6 +909,61 @@ func (_c *IMetaTable_DropRole_Call) RunAndReturn(run func(string, string) error)
 	return _c
 }
 
+// GetAPIKey provides a mock function with given fields: ctx, id
+func (_m *IMetaTable) GetAPIKey(ctx context.Context, id string) (*crypto.APIKey, error) {
+	ret := _m.Called(ctx, id)
+
+	var r0 *crypto.APIKey
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) (*crypto.APIKey, error)); ok {
+		return rf(ctx, id)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) *crypto.APIKey); ok {
+		r0 = rf(ctx, id)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*crypto.APIKey)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, id)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// IMetaTable_GetAPIKey_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetAPIKey'
+type IMetaTable_GetAPIKey_Call struct {
+	*mock.Call
+}
+
+// GetAPIKey is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id string
+func (_e *IMetaTable_Expecter) GetAPIKey(ctx interface{}, id interface{}) *IMetaTable_GetAPIKey_Call {
+	return &IMetaTable_GetAPIKey_Call{Call: _e.mock.On("GetAPIKey", ctx, id)}
+}
+
+func (_c *IMetaTable_GetAPIKey_Call) Run(run func(ctx context.Context, id string)) *IMetaTable_GetAPIKey_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *IMetaTable_GetAPIKey_Call) Return(_a0 *crypto.APIKey, _a1 error) *IMetaTable_GetAPIKey_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *IMetaTable_GetAPIKey_Call) RunAndReturn(run func(context.Context, string) (*crypto.APIKey, error)) *IMetaTable_GetAPIKey_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // GetCollectionByID provides a mock function with given fields: ctx, dbName, collectionID, ts, allowUnavailable
 func (_m *IMetaTable) GetCollectionByID(ctx context.Context, dbName string, collectionID int64, ts uint64, allowUnavailable bool) (*model.Collection, error) {
 	ret := _m.Called(ctx, dbName, collectionID, ts, allowUnavailable)
@@ -845,6 +1079,61 @@ func (_c *IMetaTable_GetCollectionByName_Call) RunAndReturn(run func(context.Con
 	return _c
 }
 
+// GetCollectionTemplate provides a mock function with given fields: ctx, name
+func (_m *IMetaTable) GetCollectionTemplate(ctx context.Context, name string) (*model.CollectionTemplate, error) {
+	ret := _m.Called(ctx, name)
+
+	var r0 *model.CollectionTemplate
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) (*model.CollectionTemplate, error)); ok {
+		return rf(ctx, name)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) *model.CollectionTemplate); ok {
+		r0 = rf(ctx, name)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*model.CollectionTemplate)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, name)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// IMetaTable_GetCollectionTemplate_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetCollectionTemplate'
+type IMetaTable_GetCollectionTemplate_Call struct {
+	*mock.Call
+}
+
+// GetCollectionTemplate is a helper method to define mock.On call
+//   - ctx context.Context
+//   - name string
+func (_e *IMetaTable_Expecter) GetCollectionTemplate(ctx interface{}, name interface{}) *IMetaTable_GetCollectionTemplate_Call {
+	return &IMetaTable_GetCollectionTemplate_Call{Call: _e.mock.On("GetCollectionTemplate", ctx, name)}
+}
+
+func (_c *IMetaTable_GetCollectionTemplate_Call) Run(run func(ctx context.Context, name string)) *IMetaTable_GetCollectionTemplate_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *IMetaTable_GetCollectionTemplate_Call) Return(_a0 *model.CollectionTemplate, _a1 error) *IMetaTable_GetCollectionTemplate_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *IMetaTable_GetCollectionTemplate_Call) RunAndReturn(run func(context.Context, string) (*model.CollectionTemplate, error)) *IMetaTable_GetCollectionTemplate_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // GetCollectionVirtualChannels provides a mock function with given fields: colID
 func (_m *IMetaTable) GetCollectionVirtualChannels(colID int64) []string {
 	ret := _m.Called(colID)
@@ -943,6 +1232,60 @@ func (_c *IMetaTable_GetCredential_Call) RunAndReturn(run func(string) (*interna
 	return _c
 }
 
+// GetCredentialState provides a mock function with given fields: username
+func (_m *IMetaTable) GetCredentialState(username string) (*crypto.CredentialState, error) {
+	ret := _m.Called(username)
+
+	var r0 *crypto.CredentialState
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string) (*crypto.CredentialState, error)); ok {
+		return rf(username)
+	}
+	if rf, ok := ret.Get(0).(func(string) *crypto.CredentialState); ok {
+		r0 = rf(username)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*crypto.CredentialState)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(string) error); ok {
+		r1 = rf(username)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// IMetaTable_GetCredentialState_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetCredentialState'
+type IMetaTable_GetCredentialState_Call struct {
+	*mock.Call
+}
+
+// GetCredentialState is a helper method to define mock.On call
+//   - username string
+func (_e *IMetaTable_Expecter) GetCredentialState(username interface{}) *IMetaTable_GetCredentialState_Call {
+	return &IMetaTable_GetCredentialState_Call{Call: _e.mock.On("GetCredentialState", username)}
+}
+
+func (_c *IMetaTable_GetCredentialState_Call) Run(run func(username string)) *IMetaTable_GetCredentialState_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(string))
+	})
+	return _c
+}
+
+func (_c *IMetaTable_GetCredentialState_Call) Return(_a0 *crypto.CredentialState, _a1 error) *IMetaTable_GetCredentialState_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *IMetaTable_GetCredentialState_Call) RunAndReturn(run func(string) (*crypto.CredentialState, error)) *IMetaTable_GetCredentialState_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // GetDatabaseByID provides a mock function with given fields: ctx, dbID, ts
 func (_m *IMetaTable) GetDatabaseByID(ctx context.Context, dbID int64, ts uint64) (*model.Database, error) {
 	ret := _m.Called(ctx, dbID, ts)
@@ -1206,6 +1549,61 @@ func (_c *IMetaTable_IsAlias_Call) RunAndReturn(run func(string, string) bool) *
 	return _c
 }
 
+// ListAPIKeys provides a mock function with given fields: ctx, username
+func (_m *IMetaTable) ListAPIKeys(ctx context.Context, username string) ([]*crypto.APIKey, error) {
+	ret := _m.Called(ctx, username)
+
+	var r0 []*crypto.APIKey
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) ([]*crypto.APIKey, error)); ok {
+		return rf(ctx, username)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) []*crypto.APIKey); ok {
+		r0 = rf(ctx, username)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*crypto.APIKey)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, username)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// IMetaTable_ListAPIKeys_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListAPIKeys'
+type IMetaTable_ListAPIKeys_Call struct {
+	*mock.Call
+}
+
+// ListAPIKeys is a helper method to define mock.On call
+//   - ctx context.Context
+//   - username string
+func (_e *IMetaTable_Expecter) ListAPIKeys(ctx interface{}, username interface{}) *IMetaTable_ListAPIKeys_Call {
+	return &IMetaTable_ListAPIKeys_Call{Call: _e.mock.On("ListAPIKeys", ctx, username)}
+}
+
+func (_c *IMetaTable_ListAPIKeys_Call) Run(run func(ctx context.Context, username string)) *IMetaTable_ListAPIKeys_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *IMetaTable_ListAPIKeys_Call) Return(_a0 []*crypto.APIKey, _a1 error) *IMetaTable_ListAPIKeys_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *IMetaTable_ListAPIKeys_Call) RunAndReturn(run func(context.Context, string) ([]*crypto.APIKey, error)) *IMetaTable_ListAPIKeys_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // ListAliasesByID provides a mock function with given fields: collID
 func (_m *IMetaTable) ListAliasesByID(collID int64) []string {
 	ret := _m.Called(collID)
@@ -1337,6 +1735,60 @@ func (_c *IMetaTable_ListCollectionPhysicalChannels_Call) RunAndReturn(run func(
 	return _c
 }
 
+// ListCollectionTemplates provides a mock function with given fields: ctx
+func (_m *IMetaTable) ListCollectionTemplates(ctx context.Context) ([]*model.CollectionTemplate, error) {
+	ret := _m.Called(ctx)
+
+	var r0 []*model.CollectionTemplate
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context) ([]*model.CollectionTemplate, error)); ok {
+		return rf(ctx)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context) []*model.CollectionTemplate); ok {
+		r0 = rf(ctx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*model.CollectionTemplate)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// IMetaTable_ListCollectionTemplates_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListCollectionTemplates'
+type IMetaTable_ListCollectionTemplates_Call struct {
+	*mock.Call
+}
+
+// ListCollectionTemplates is a helper method to define mock.On call
+//   - ctx context.Context
+func (_e *IMetaTable_Expecter) ListCollectionTemplates(ctx interface{}) *IMetaTable_ListCollectionTemplates_Call {
+	return &IMetaTable_ListCollectionTemplates_Call{Call: _e.mock.On("ListCollectionTemplates", ctx)}
+}
+
+func (_c *IMetaTable_ListCollectionTemplates_Call) Run(run func(ctx context.Context)) *IMetaTable_ListCollectionTemplates_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context))
+	})
+	return _c
+}
+
+func (_c *IMetaTable_ListCollectionTemplates_Call) Return(_a0 []*model.CollectionTemplate, _a1 error) *IMetaTable_ListCollectionTemplates_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *IMetaTable_ListCollectionTemplates_Call) RunAndReturn(run func(context.Context) ([]*model.CollectionTemplate, error)) *IMetaTable_ListCollectionTemplates_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // ListCollections provides a mock function with given fields: ctx, dbName, ts, onlyAvail
 func (_m *IMetaTable) ListCollections(ctx context.Context, dbName string, ts uint64, onlyAvail bool) ([]*model.Collection, error) {
 	ret := _m.Called(ctx, dbName, ts, onlyAvail)
@@ -1556,6 +2008,60 @@ func (_c *IMetaTable_ListPolicy_Call) RunAndReturn(run func(string) ([]string, e
 	return _c
 }
 
+// ListRoleInheritance provides a mock function with given fields: tenant
+func (_m *IMetaTable) ListRoleInheritance(tenant string) (map[string][]string, error) {
+	ret := _m.Called(tenant)
+
+	var r0 map[string][]string
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string) (map[string][]string, error)); ok {
+		return rf(tenant)
+	}
+	if rf, ok := ret.Get(0).(func(string) map[string][]string); ok {
+		r0 = rf(tenant)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(map[string][]string)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(string) error); ok {
+		r1 = rf(tenant)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// IMetaTable_ListRoleInheritance_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListRoleInheritance'
+type IMetaTable_ListRoleInheritance_Call struct {
+	*mock.Call
+}
+
+// ListRoleInheritance is a helper method to define mock.On call
+//   - tenant string
+func (_e *IMetaTable_Expecter) ListRoleInheritance(tenant interface{}) *IMetaTable_ListRoleInheritance_Call {
+	return &IMetaTable_ListRoleInheritance_Call{Call: _e.mock.On("ListRoleInheritance", tenant)}
+}
+
+func (_c *IMetaTable_ListRoleInheritance_Call) Run(run func(tenant string)) *IMetaTable_ListRoleInheritance_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(string))
+	})
+	return _c
+}
+
+func (_c *IMetaTable_ListRoleInheritance_Call) Return(_a0 map[string][]string, _a1 error) *IMetaTable_ListRoleInheritance_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *IMetaTable_ListRoleInheritance_Call) RunAndReturn(run func(string) (map[string][]string, error)) *IMetaTable_ListRoleInheritance_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // ListUserRole provides a mock function with given fields: tenant
 func (_m *IMetaTable) ListUserRole(tenant string) ([]string, error) {
 	ret := _m.Called(tenant)
@@ -1610,6 +2116,51 @@ func (_c *IMetaTable_ListUserRole_Call) RunAndReturn(run func(string) ([]string,
 	return _c
 }
 
+// MoveCollectionToTrash provides a mock function with given fields: ctx, collectionID, droppedAt, ts
+func (_m *IMetaTable) MoveCollectionToTrash(ctx context.Context, collectionID int64, droppedAt time.Time, ts uint64) error {
+	ret := _m.Called(ctx, collectionID, droppedAt, ts)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, int64, time.Time, uint64) error); ok {
+		r0 = rf(ctx, collectionID, droppedAt, ts)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// IMetaTable_MoveCollectionToTrash_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'MoveCollectionToTrash'
+type IMetaTable_MoveCollectionToTrash_Call struct {
+	*mock.Call
+}
+
+// MoveCollectionToTrash is a helper method to define mock.On call
+//   - ctx context.Context
+//   - collectionID int64
+//   - droppedAt time.Time
+//   - ts uint64
+func (_e *IMetaTable_Expecter) MoveCollectionToTrash(ctx interface{}, collectionID interface{}, droppedAt interface{}, ts interface{}) *IMetaTable_MoveCollectionToTrash_Call {
+	return &IMetaTable_MoveCollectionToTrash_Call{Call: _e.mock.On("MoveCollectionToTrash", ctx, collectionID, droppedAt, ts)}
+}
+
+func (_c *IMetaTable_MoveCollectionToTrash_Call) Run(run func(ctx context.Context, collectionID int64, droppedAt time.Time, ts uint64)) *IMetaTable_MoveCollectionToTrash_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int64), args[2].(time.Time), args[3].(uint64))
+	})
+	return _c
+}
+
+func (_c *IMetaTable_MoveCollectionToTrash_Call) Return(_a0 error) *IMetaTable_MoveCollectionToTrash_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *IMetaTable_MoveCollectionToTrash_Call) RunAndReturn(run func(context.Context, int64, time.Time, uint64) error) *IMetaTable_MoveCollectionToTrash_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // OperatePrivilege provides a mock function with given fields: tenant, entity, operateType
 func (_m *IMetaTable) OperatePrivilege(tenant string, entity *milvuspb.GrantEntity, operateType milvuspb.OperatePrivilegeType) error {
 	ret := _m.Called(tenant, entity, operateType)
@@ -1654,6 +2205,51 @@ func (_c *IMetaTable_OperatePrivilege_Call) RunAndReturn(run func(string, *milvu
 	return _c
 }
 
+// OperateRoleInheritance provides a mock function with given fields: tenant, roleName, parentRoleName, operateType
+func (_m *IMetaTable) OperateRoleInheritance(tenant string, roleName string, parentRoleName string, operateType milvuspb.OperatePrivilegeType) error {
+	ret := _m.Called(tenant, roleName, parentRoleName, operateType)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(string, string, string, milvuspb.OperatePrivilegeType) error); ok {
+		r0 = rf(tenant, roleName, parentRoleName, operateType)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// IMetaTable_OperateRoleInheritance_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'OperateRoleInheritance'
+type IMetaTable_OperateRoleInheritance_Call struct {
+	*mock.Call
+}
+
+// OperateRoleInheritance is a helper method to define mock.On call
+//   - tenant string
+//   - roleName string
+//   - parentRoleName string
+//   - operateType milvuspb.OperatePrivilegeType
+func (_e *IMetaTable_Expecter) OperateRoleInheritance(tenant interface{}, roleName interface{}, parentRoleName interface{}, operateType interface{}) *IMetaTable_OperateRoleInheritance_Call {
+	return &IMetaTable_OperateRoleInheritance_Call{Call: _e.mock.On("OperateRoleInheritance", tenant, roleName, parentRoleName, operateType)}
+}
+
+func (_c *IMetaTable_OperateRoleInheritance_Call) Run(run func(tenant string, roleName string, parentRoleName string, operateType milvuspb.OperatePrivilegeType)) *IMetaTable_OperateRoleInheritance_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(string), args[1].(string), args[2].(string), args[3].(milvuspb.OperatePrivilegeType))
+	})
+	return _c
+}
+
+func (_c *IMetaTable_OperateRoleInheritance_Call) Return(_a0 error) *IMetaTable_OperateRoleInheritance_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *IMetaTable_OperateRoleInheritance_Call) RunAndReturn(run func(string, string, string, milvuspb.OperatePrivilegeType) error) *IMetaTable_OperateRoleInheritance_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // OperateUserRole provides a mock function with given fields: tenant, userEntity, roleEntity, operateType
 func (_m *IMetaTable) OperateUserRole(tenant string, userEntity *milvuspb.UserEntity, roleEntity *milvuspb.RoleEntity, operateType milvuspb.OperateUserRoleType) error {
 	ret := _m.Called(tenant, userEntity, roleEntity, operateType)
@@ -1836,6 +2432,93 @@ func (_c *IMetaTable_RenameCollection_Call) RunAndReturn(run func(context.Contex
 	return _c
 }
 
+// RestoreCollection provides a mock function with given fields: ctx, collectionID, ts
+func (_m *IMetaTable) RestoreCollection(ctx context.Context, collectionID int64, ts uint64) error {
+	ret := _m.Called(ctx, collectionID, ts)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, int64, uint64) error); ok {
+		r0 = rf(ctx, collectionID, ts)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// IMetaTable_RestoreCollection_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RestoreCollection'
+type IMetaTable_RestoreCollection_Call struct {
+	*mock.Call
+}
+
+// RestoreCollection is a helper method to define mock.On call
+//   - ctx context.Context
+//   - collectionID int64
+//   - ts uint64
+func (_e *IMetaTable_Expecter) RestoreCollection(ctx interface{}, collectionID interface{}, ts interface{}) *IMetaTable_RestoreCollection_Call {
+	return &IMetaTable_RestoreCollection_Call{Call: _e.mock.On("RestoreCollection", ctx, collectionID, ts)}
+}
+
+func (_c *IMetaTable_RestoreCollection_Call) Run(run func(ctx context.Context, collectionID int64, ts uint64)) *IMetaTable_RestoreCollection_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int64), args[2].(uint64))
+	})
+	return _c
+}
+
+func (_c *IMetaTable_RestoreCollection_Call) Return(_a0 error) *IMetaTable_RestoreCollection_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *IMetaTable_RestoreCollection_Call) RunAndReturn(run func(context.Context, int64, uint64) error) *IMetaTable_RestoreCollection_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// SaveAPIKey provides a mock function with given fields: ctx, key
+func (_m *IMetaTable) SaveAPIKey(ctx context.Context, key *crypto.APIKey) error {
+	ret := _m.Called(ctx, key)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, *crypto.APIKey) error); ok {
+		r0 = rf(ctx, key)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// IMetaTable_SaveAPIKey_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SaveAPIKey'
+type IMetaTable_SaveAPIKey_Call struct {
+	*mock.Call
+}
+
+// SaveAPIKey is a helper method to define mock.On call
+//   - ctx context.Context
+//   - key *crypto.APIKey
+func (_e *IMetaTable_Expecter) SaveAPIKey(ctx interface{}, key interface{}) *IMetaTable_SaveAPIKey_Call {
+	return &IMetaTable_SaveAPIKey_Call{Call: _e.mock.On("SaveAPIKey", ctx, key)}
+}
+
+func (_c *IMetaTable_SaveAPIKey_Call) Run(run func(ctx context.Context, key *crypto.APIKey)) *IMetaTable_SaveAPIKey_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*crypto.APIKey))
+	})
+	return _c
+}
+
+func (_c *IMetaTable_SaveAPIKey_Call) Return(_a0 error) *IMetaTable_SaveAPIKey_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *IMetaTable_SaveAPIKey_Call) RunAndReturn(run func(context.Context, *crypto.APIKey) error) *IMetaTable_SaveAPIKey_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // SelectGrant provides a mock function with given fields: tenant, entity
 func (_m *IMetaTable) SelectGrant(tenant string, entity *milvuspb.GrantEntity) ([]*milvuspb.GrantEntity, error) {
 	ret := _m.Called(tenant, entity)
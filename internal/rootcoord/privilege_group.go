@@ -0,0 +1,141 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package rootcoord
+
+import (
+	"context"
+
+	"go.uber.org/zap"
+
+	"github.com/milvus-io/milvus-proto/go-api/v2/milvuspb"
+	"github.com/milvus-io/milvus/pkg/log"
+	"github.com/milvus-io/milvus/pkg/util"
+	"github.com/milvus-io/milvus/pkg/util/typeutil"
+)
+
+// migrationPrivilegeGroups are the privilege groups to migrate, broadest first,
+// so the grants covered by a broader group are not collapsed into a narrower one.
+var migrationPrivilegeGroups = []string{
+	util.PrivilegeGroupAdmin,
+	util.PrivilegeGroupReadWrite,
+	util.PrivilegeGroupReadOnly,
+}
+
+// migratePrivilegeGroups collapses the existing grants of each role into the privilege groups,
+// the grants on an object are replaced by the group grant if they cover all the privileges of the group for the object type.
+func (c *Core) migratePrivilegeGroups(ctx context.Context) error {
+	roles, err := c.meta.SelectRole(util.DefaultTenant, nil, false)
+	if err != nil {
+		return err
+	}
+	dbs, err := c.meta.ListDatabases(ctx, typeutil.MaxTimestamp)
+	if err != nil {
+		return err
+	}
+
+	for _, role := range roles {
+		for _, db := range dbs {
+			grants, err := c.meta.SelectGrant(util.DefaultTenant, &milvuspb.GrantEntity{
+				Role:   role.GetRole(),
+				DbName: db.Name,
+			})
+			if err != nil {
+				return err
+			}
+			if err := c.migrateObjectGrants(role.GetRole().GetName(), groupGrantsByObject(grants)); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+type grantObjectKey struct {
+	object     string
+	objectName string
+}
+
+func groupGrantsByObject(grants []*milvuspb.GrantEntity) map[grantObjectKey]map[string]*milvuspb.GrantEntity {
+	objectGrants := make(map[grantObjectKey]map[string]*milvuspb.GrantEntity)
+	for _, grant := range grants {
+		key := grantObjectKey{object: grant.GetObject().GetName(), objectName: grant.GetObjectName()}
+		if _, ok := objectGrants[key]; !ok {
+			objectGrants[key] = make(map[string]*milvuspb.GrantEntity)
+		}
+		objectGrants[key][grant.GetGrantor().GetPrivilege().GetName()] = grant
+	}
+	return objectGrants
+}
+
+func (c *Core) migrateObjectGrants(roleName string, objectGrants map[grantObjectKey]map[string]*milvuspb.GrantEntity) error {
+	for key, privilegeGrants := range objectGrants {
+		for _, group := range migrationPrivilegeGroups {
+			if _, ok := privilegeGrants[group]; ok {
+				break
+			}
+			privileges := util.PrivilegeGroupObjectPrivileges(group, key.object)
+			if len(privileges) == 0 || !coverPrivileges(privilegeGrants, privileges) {
+				continue
+			}
+
+			grant := privilegeGrants[privileges[0]]
+			groupGrant := &milvuspb.GrantEntity{
+				Role:       grant.GetRole(),
+				Object:     grant.GetObject(),
+				ObjectName: grant.GetObjectName(),
+				DbName:     grant.GetDbName(),
+				Grantor: &milvuspb.GrantorEntity{
+					User:      grant.GetGrantor().GetUser(),
+					Privilege: &milvuspb.PrivilegeEntity{Name: util.PrivilegeWord + group},
+				},
+			}
+			if err := c.meta.OperatePrivilege(util.DefaultTenant, groupGrant, milvuspb.OperatePrivilegeType_Grant); err != nil {
+				return err
+			}
+			for _, privilege := range privileges {
+				covered := privilegeGrants[privilege]
+				revoked := &milvuspb.GrantEntity{
+					Role:       covered.GetRole(),
+					Object:     covered.GetObject(),
+					ObjectName: covered.GetObjectName(),
+					DbName:     covered.GetDbName(),
+					Grantor: &milvuspb.GrantorEntity{
+						User:      covered.GetGrantor().GetUser(),
+						Privilege: &milvuspb.PrivilegeEntity{Name: util.PrivilegeNameForMetastore(privilege)},
+					},
+				}
+				if err := c.meta.OperatePrivilege(util.DefaultTenant, revoked, milvuspb.OperatePrivilegeType_Revoke); err != nil {
+					return err
+				}
+			}
+			log.Info("migrate the grants to the privilege group", zap.String("role", roleName),
+				zap.String("object", key.object), zap.String("objectName", key.objectName),
+				zap.String("db", grant.GetDbName()), zap.String("group", group))
+			break
+		}
+	}
+	return nil
+}
+
+func coverPrivileges(privilegeGrants map[string]*milvuspb.GrantEntity, privileges []string) bool {
+	for _, privilege := range privileges {
+		if _, ok := privilegeGrants[privilege]; !ok {
+			return false
+		}
+	}
+	return true
+}
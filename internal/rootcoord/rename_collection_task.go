@@ -19,8 +19,19 @@ package rootcoord
 import (
 	"context"
 
+	"github.com/cockroachdb/errors"
+	"github.com/golang/protobuf/proto"
+	"go.uber.org/zap"
+
 	"github.com/milvus-io/milvus-proto/go-api/v2/commonpb"
 	"github.com/milvus-io/milvus-proto/go-api/v2/milvuspb"
+	"github.com/milvus-io/milvus/internal/proto/proxypb"
+	"github.com/milvus-io/milvus/pkg/common"
+	"github.com/milvus-io/milvus/pkg/log"
+	"github.com/milvus-io/milvus/pkg/util"
+	"github.com/milvus-io/milvus/pkg/util/funcutil"
+	"github.com/milvus-io/milvus/pkg/util/merr"
+	"github.com/milvus-io/milvus/pkg/util/typeutil"
 )
 
 type renameCollectionTask struct {
@@ -36,8 +47,113 @@ func (t *renameCollectionTask) Prepare(ctx context.Context) error {
 }
 
 func (t *renameCollectionTask) Execute(ctx context.Context) error {
-	if err := t.core.ExpireMetaCache(ctx, t.Req.GetDbName(), []string{t.Req.GetOldName()}, InvalidCollectionID, t.GetTs()); err != nil {
+	dbName, newDBName := t.Req.GetDbName(), t.Req.GetNewDBName()
+	if dbName == "" {
+		dbName = util.DefaultDBName
+	}
+	if newDBName == "" {
+		newDBName = dbName
+	}
+
+	redoTask := newBaseRedoTask(t.core.stepExecutor)
+	redoTask.AddSyncStep(&expireCacheStep{
+		baseStep:        baseStep{core: t.core},
+		dbName:          t.Req.GetDbName(),
+		collectionNames: []string{t.Req.GetOldName()},
+		collectionID:    InvalidCollectionID,
+		ts:              t.GetTs(),
+	})
+	redoTask.AddSyncStep(&renameCollectionStep{
+		baseStep:  baseStep{core: t.core},
+		dbName:    t.Req.GetDbName(),
+		oldName:   t.Req.GetOldName(),
+		newDBName: t.Req.GetNewDBName(),
+		newName:   t.Req.GetNewName(),
+		ts:        t.GetTs(),
+	})
+
+	if dbName != newDBName {
+		// the collection is moved to another database, the grants on it and the caches of proxies are migrated
+		// as well after renamed, until done. The segments are kept since they're bound to the collection id only.
+		coll, err := t.core.meta.GetCollectionByName(ctx, dbName, t.Req.GetOldName(), typeutil.MaxTimestamp)
+		if err != nil {
+			return err
+		}
+		redoTask.AddAsyncStep(&migrateCollectionGrantsStep{
+			baseStep:  baseStep{core: t.core},
+			dbName:    dbName,
+			oldName:   t.Req.GetOldName(),
+			newDBName: newDBName,
+			newName:   t.Req.GetNewName(),
+		})
+		redoTask.AddAsyncStep(&expireCacheStep{
+			baseStep:     baseStep{core: t.core},
+			dbName:       newDBName,
+			collectionID: coll.CollectionID,
+			ts:           t.GetTs(),
+		})
+	}
+	return redoTask.Execute(ctx)
+}
+
+// migrateCollectionGrants moves the grants of all the roles on the collection to its new database and name,
+// and refreshes the policy caches of proxies. It's idempotent, so could be redone until succeeded.
+func (c *Core) migrateCollectionGrants(ctx context.Context, dbName string, oldName string, newDBName string, newName string) error {
+	log := log.Ctx(ctx).With(zap.String("dbName", dbName), zap.String("oldName", oldName),
+		zap.String("newDBName", newDBName), zap.String("newName", newName))
+
+	roles, err := c.meta.SelectRole(util.DefaultTenant, nil, false)
+	if err != nil {
 		return err
 	}
-	return t.core.meta.RenameCollection(ctx, t.Req.GetDbName(), t.Req.GetOldName(), t.Req.GetNewDBName(), t.Req.GetNewName(), t.GetTs())
+	for _, role := range roles {
+		grants, err := c.meta.SelectGrant(util.DefaultTenant, &milvuspb.GrantEntity{
+			Role:       role.GetRole(),
+			Object:     &milvuspb.ObjectEntity{Name: commonpb.ObjectType_Collection.String()},
+			ObjectName: oldName,
+			DbName:     dbName,
+		})
+		if err != nil && !errors.Is(err, merr.ErrIoKeyNotFound) {
+			return err
+		}
+		for _, grant := range grants {
+			privilege := grant.GetGrantor().GetPrivilege().GetName()
+			if !util.IsAnyWord(privilege) {
+				privilege = util.PrivilegeNameForMetastore(privilege)
+			}
+			newGrant := &milvuspb.GrantEntity{
+				Role:       role.GetRole(),
+				Object:     grant.GetObject(),
+				ObjectName: newName,
+				DbName:     newDBName,
+				Grantor: &milvuspb.GrantorEntity{
+					User:      grant.GetGrantor().GetUser(),
+					Privilege: &milvuspb.PrivilegeEntity{Name: privilege},
+				},
+			}
+			oldGrant := proto.Clone(newGrant).(*milvuspb.GrantEntity)
+			oldGrant.ObjectName = oldName
+			oldGrant.DbName = dbName
+
+			if err := c.meta.OperatePrivilege(util.DefaultTenant, newGrant, milvuspb.OperatePrivilegeType_Grant); err != nil && !common.IsIgnorableError(err) {
+				return err
+			}
+			if err := c.meta.OperatePrivilege(util.DefaultTenant, oldGrant, milvuspb.OperatePrivilegeType_Revoke); err != nil && !common.IsIgnorableError(err) {
+				return err
+			}
+			for opType, entity := range map[typeutil.CacheOpType]*milvuspb.GrantEntity{
+				typeutil.CacheGrantPrivilege:  newGrant,
+				typeutil.CacheRevokePrivilege: oldGrant,
+			} {
+				if err := c.proxyClientManager.RefreshPolicyInfoCache(ctx, &proxypb.RefreshPolicyInfoCacheRequest{
+					OpType: int32(opType),
+					OpKey:  funcutil.PolicyForPrivilege(entity.Role.Name, entity.Object.Name, entity.ObjectName, entity.Grantor.Privilege.Name, entity.DbName),
+				}); err != nil {
+					return err
+				}
+			}
+			log.Info("grant of collection migrated", zap.String("role", role.GetRole().GetName()), zap.String("privilege", privilege))
+		}
+	}
+	return nil
 }
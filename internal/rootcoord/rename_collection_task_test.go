@@ -18,13 +18,21 @@ package rootcoord
 
 import (
 	"context"
+	"sync"
 	"testing"
+	"time"
 
 	"github.com/cockroachdb/errors"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/atomic"
 
 	"github.com/milvus-io/milvus-proto/go-api/v2/commonpb"
 	"github.com/milvus-io/milvus-proto/go-api/v2/milvuspb"
+	"github.com/milvus-io/milvus/internal/metastore/model"
+	"github.com/milvus-io/milvus/internal/proto/proxypb"
+	"github.com/milvus-io/milvus/pkg/util"
+	"github.com/milvus-io/milvus/pkg/util/merr"
 )
 
 func Test_renameCollectionTask_Prepare(t *testing.T) {
@@ -86,4 +94,96 @@ func Test_renameCollectionTask_Execute(t *testing.T) {
 		err := task.Execute(context.Background())
 		assert.Error(t, err)
 	})
+
+	t.Run("move to another database", func(t *testing.T) {
+		var mu sync.Mutex
+		renamed := false
+		meta := newMockMetaTable()
+		meta.RenameCollectionFunc = func(ctx context.Context, oldName string, newName string, ts Timestamp) error {
+			mu.Lock()
+			defer mu.Unlock()
+			renamed = true
+			return nil
+		}
+		meta.GetCollectionByNameFunc = func(ctx context.Context, collectionName string, ts Timestamp) (*model.Collection, error) {
+			return &model.Collection{CollectionID: 100, Name: collectionName}, nil
+		}
+		meta.SelectRoleFunc = func(tenant string, entity *milvuspb.RoleEntity, includeUserInfo bool) ([]*milvuspb.RoleResult, error) {
+			return []*milvuspb.RoleResult{
+				{Role: &milvuspb.RoleEntity{Name: "role1"}},
+				{Role: &milvuspb.RoleEntity{Name: "role2"}},
+			}, nil
+		}
+		meta.SelectGrantFunc = func(tenant string, entity *milvuspb.GrantEntity) ([]*milvuspb.GrantEntity, error) {
+			assert.Equal(t, "db1", entity.GetDbName())
+			assert.Equal(t, "old", entity.GetObjectName())
+			if entity.GetRole().GetName() == "role2" {
+				return nil, merr.WrapErrIoKeyNotFound("grant")
+			}
+			return []*milvuspb.GrantEntity{{
+				Role:       entity.GetRole(),
+				Object:     entity.GetObject(),
+				ObjectName: entity.GetObjectName(),
+				DbName:     entity.GetDbName(),
+				Grantor: &milvuspb.GrantorEntity{
+					User:      &milvuspb.UserEntity{Name: "root"},
+					Privilege: &milvuspb.PrivilegeEntity{Name: util.MetaStore2API(commonpb.ObjectPrivilege_PrivilegeSearch.String())},
+				},
+			}}, nil
+		}
+		operated := make(map[milvuspb.OperatePrivilegeType]*milvuspb.GrantEntity)
+		meta.OperatePrivilegeFunc = func(tenant string, entity *milvuspb.GrantEntity, operateType milvuspb.OperatePrivilegeType) error {
+			mu.Lock()
+			defer mu.Unlock()
+			// the grants are migrated after renamed
+			assert.True(t, renamed)
+			operated[operateType] = entity
+			return nil
+		}
+
+		executor := newMockStepExecutor()
+		executor.AddStepsFunc = func(s *stepStack) {
+			// reschedule until done
+			for s != nil {
+				s = s.Execute(context.Background())
+			}
+		}
+		core := newTestCore(withValidProxyManager(), withMeta(meta), withStepExecutor(executor))
+		refreshed := atomic.NewInt32(0)
+		core.proxyClientManager.proxyClient[TestProxyID].(*mockProxy).RefreshPolicyInfoCacheFunc = func(ctx context.Context, request *proxypb.RefreshPolicyInfoCacheRequest) (*commonpb.Status, error) {
+			// fail once, the migration is redone
+			if refreshed.Inc() == 1 {
+				return nil, errors.New("mock")
+			}
+			return merr.Success(), nil
+		}
+		task := &renameCollectionTask{
+			baseTask: newBaseTask(context.Background(), core),
+			Req: &milvuspb.RenameCollectionRequest{
+				Base: &commonpb.MsgBase{
+					MsgType: commonpb.MsgType_RenameCollection,
+				},
+				DbName:    "db1",
+				OldName:   "old",
+				NewDBName: "db2",
+				NewName:   "new",
+			},
+		}
+		err := task.Execute(context.Background())
+		assert.NoError(t, err)
+
+		assert.Eventually(t, func() bool {
+			return refreshed.Load() == 3
+		}, 10*time.Second, 10*time.Millisecond)
+		mu.Lock()
+		defer mu.Unlock()
+		require.Len(t, operated, 2)
+		grant := operated[milvuspb.OperatePrivilegeType_Grant]
+		assert.Equal(t, "db2", grant.GetDbName())
+		assert.Equal(t, "new", grant.GetObjectName())
+		assert.Equal(t, commonpb.ObjectPrivilege_PrivilegeSearch.String(), grant.GetGrantor().GetPrivilege().GetName())
+		revoke := operated[milvuspb.OperatePrivilegeType_Revoke]
+		assert.Equal(t, "db1", revoke.GetDbName())
+		assert.Equal(t, "old", revoke.GetObjectName())
+	})
 }
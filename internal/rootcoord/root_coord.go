@@ -21,6 +21,7 @@ import (
 	"fmt"
 	"math/rand"
 	"os"
+	"sort"
 	"sync"
 	"syscall"
 	"time"
@@ -109,8 +110,9 @@ type Core struct {
 
 	chanTimeTick *timetickSync
 
-	idAllocator  allocator.Interface
-	tsoAllocator tso2.Allocator
+	idAllocator    allocator.Interface
+	tsoAllocator   tso2.Allocator
+	tsoDiagnostics *tsoutil.Diagnostics
 
 	dataCoord  types.DataCoordClient
 	queryCoord types.QueryCoordClient
@@ -418,6 +420,7 @@ func (c *Core) initTSOAllocator() error {
 		return err
 	}
 	c.tsoAllocator = tsoAllocator
+	c.tsoDiagnostics = newTSODiagnostics()
 
 	log.Info("tso allocator initialized",
 		zap.String("root_path", kvPath),
@@ -637,6 +640,10 @@ func (c *Core) restore(ctx context.Context) error {
 			} else {
 				switch coll.State {
 				case pb.CollectionState_CollectionDropping:
+					// the collections in the trash are purged by the trash loop once expired
+					if _, ok := collectionTrashDroppedAt(coll); ok {
+						continue
+					}
 					go c.garbageCollector.ReDropCollection(coll.Clone(), ts)
 				case pb.CollectionState_CollectionCreating:
 					go c.garbageCollector.RemoveCreatingCollection(coll.Clone())
@@ -663,7 +670,17 @@ func (c *Core) startInternal() error {
 		go c.quotaCenter.run()
 	}
 
+	if Params.RootCoordCfg.MigratePrivilegeGroups.GetAsBool() {
+		if err := c.migratePrivilegeGroups(c.ctx); err != nil {
+			log.Warn("fail to migrate the grants to privilege groups", zap.Error(err))
+		}
+	}
+
 	c.scheduler.Start()
+	registerDDLQueueHandler(c.scheduler)
+	registerCollectionTemplateHandler(c)
+	registerCollectionTrashHandler(c)
+	registerTSODiagnosticsHandler(c.tsoDiagnostics)
 	c.stepExecutor.Start()
 	go func() {
 		// refresh rbac cache
@@ -689,13 +706,14 @@ func (c *Core) startInternal() error {
 }
 
 func (c *Core) startServerLoop() {
-	c.wg.Add(6)
+	c.wg.Add(7)
 	go c.startTimeTickLoop()
 	go c.tsLoop()
 	go c.chanTimeTick.startWatch(&c.wg)
 	go c.importManager.cleanupLoop(&c.wg)
 	go c.importManager.sendOutTasksLoop(&c.wg)
 	go c.importManager.flipTaskStateLoop(&c.wg)
+	go c.collectionTrashLoop()
 }
 
 // Start starts RootCoord.
@@ -1532,6 +1550,7 @@ func (c *Core) AllocTimestamp(ctx context.Context, in *rootcoordpb.AllocTimestam
 	// return first available timestamp
 	ts = ts - uint64(in.GetCount()) + 1
 	metrics.RootCoordTimestamp.Set(float64(ts))
+	c.observeTimestamps(ts, in.GetCount())
 	return &rootcoordpb.AllocTimestampResponse{
 		Status:    merr.Success(),
 		Timestamp: ts,
@@ -2101,6 +2120,25 @@ func (c *Core) GetCredential(ctx context.Context, in *rootcoordpb.GetCredentialR
 		return &rootcoordpb.GetCredentialResponse{Status: merr.Status(err)}, nil
 	}
 
+	// the proxy verifying the api key requires the key state, which is encoded as the password
+	if id, ok := in.GetBase().GetProperties()[util.APIKeyIDKey]; ok {
+		username, encoded, err := c.getEncodedAPIKey(ctx, id)
+		if err != nil {
+			ctxLog.Warn("GetCredential query api key failed", zap.String("id", id), zap.Error(err))
+			metrics.RootCoordDDLReqCounter.WithLabelValues(method, metrics.FailLabel).Inc()
+			return &rootcoordpb.GetCredentialResponse{
+				Status: merr.StatusWithErrorCode(err, commonpb.ErrorCode_GetCredentialFailure),
+			}, nil
+		}
+		metrics.RootCoordDDLReqCounter.WithLabelValues(method, metrics.SuccessLabel).Inc()
+		metrics.RootCoordDDLReqLatency.WithLabelValues(method).Observe(float64(tr.ElapseSpan().Milliseconds()))
+		return &rootcoordpb.GetCredentialResponse{
+			Status:   merr.Success(),
+			Username: username,
+			Password: encoded,
+		}, nil
+	}
+
 	credInfo, err := c.meta.GetCredential(in.Username)
 	if err != nil {
 		ctxLog.Warn("GetCredential query credential failed", zap.Error(err))
@@ -2109,6 +2147,18 @@ func (c *Core) GetCredential(ctx context.Context, in *rootcoordpb.GetCredentialR
 			Status: merr.StatusWithErrorCode(err, commonpb.ErrorCode_GetCredentialFailure),
 		}, nil
 	}
+	password := credInfo.EncryptedPassword
+	// the proxy enforcing the password policy requires the password state, which is encoded as the password
+	if in.GetBase().GetProperties()[util.CredentialStateKey] == "true" {
+		password, err = c.getEncodedCredentialState(in.Username)
+		if err != nil {
+			ctxLog.Warn("GetCredential query credential state failed", zap.Error(err))
+			metrics.RootCoordDDLReqCounter.WithLabelValues(method, metrics.FailLabel).Inc()
+			return &rootcoordpb.GetCredentialResponse{
+				Status: merr.StatusWithErrorCode(err, commonpb.ErrorCode_GetCredentialFailure),
+			}, nil
+		}
+	}
 	ctxLog.Debug("GetCredential success")
 
 	metrics.RootCoordDDLReqCounter.WithLabelValues(method, metrics.SuccessLabel).Inc()
@@ -2116,10 +2166,18 @@ func (c *Core) GetCredential(ctx context.Context, in *rootcoordpb.GetCredentialR
 	return &rootcoordpb.GetCredentialResponse{
 		Status:   merr.Success(),
 		Username: credInfo.Username,
-		Password: credInfo.EncryptedPassword,
+		Password: password,
 	}, nil
 }
 
+func (c *Core) getEncodedCredentialState(username string) (string, error) {
+	state, err := c.meta.GetCredentialState(username)
+	if err != nil {
+		return "", err
+	}
+	return crypto.EncodeCredentialState(state)
+}
+
 // UpdateCredential update password for a user
 func (c *Core) UpdateCredential(ctx context.Context, credInfo *internalpb.CredentialInfo) (*commonpb.Status, error) {
 	method := "UpdateCredential"
@@ -2302,6 +2360,19 @@ func (c *Core) DropRole(ctx context.Context, in *milvuspb.DropRoleRequest) (*com
 		ctxLog.Warn(errMsg, zap.Error(err))
 		return merr.StatusWithErrorCode(errors.New(errMsg), commonpb.ErrorCode_DropRoleFailure), nil
 	}
+	inheritances, err := c.meta.ListRoleInheritance(util.DefaultTenant)
+	if err != nil {
+		errMsg := "fail to list the role inheritances"
+		ctxLog.Warn(errMsg, zap.Error(err))
+		return merr.StatusWithErrorCode(errors.New(errMsg), commonpb.ErrorCode_DropRoleFailure), nil
+	}
+	for roleName, parentRoleNames := range inheritances {
+		if roleName == in.RoleName || lo.Contains(parentRoleNames, in.RoleName) {
+			errMsg := "fail to drop the role that it has role inheritances. Revoke the role inheritances first"
+			ctxLog.Warn(errMsg, zap.String("inheritance_role", roleName), zap.Strings("parent_roles", parentRoleNames))
+			return merr.StatusWithErrorCode(errors.New(errMsg), commonpb.ErrorCode_DropRoleFailure), nil
+		}
+	}
 	redoTask := newBaseRedoTask(c.stepExecutor)
 	redoTask.AddSyncStep(NewSimpleStep("drop role meta data", func(ctx context.Context) ([]nestedStep, error) {
 		err := c.meta.DropRole(util.DefaultTenant, in.RoleName)
@@ -2552,6 +2623,12 @@ func (c *Core) isValidGrantor(entity *milvuspb.GrantorEntity, object string) err
 	if !ok {
 		return fmt.Errorf("not found the object type[name: %s], supported the object types: %v", object, lo.Keys(commonpb.ObjectType_value))
 	}
+	if util.IsPrivilegeGroup(entity.Privilege.Name) {
+		if len(util.PrivilegeGroupObjectPrivileges(entity.Privilege.Name, object)) == 0 {
+			return fmt.Errorf("the privilege group[%s] has no privilege of the object type[%s]", entity.Privilege.Name, object)
+		}
+		return nil
+	}
 	for _, privilege := range privileges {
 		if privilege == entity.Privilege.Name {
 			return nil
@@ -2744,6 +2821,20 @@ func (c *Core) ListPolicy(ctx context.Context, in *internalpb.ListPolicyRequest)
 		}, nil
 	}
 
+	inheritances, err := c.meta.ListRoleInheritance(util.DefaultTenant)
+	if err != nil {
+		errMsg := "fail to list role inheritance"
+		ctxLog.Warn(errMsg, zap.Any("in", in), zap.Error(err))
+		return &internalpb.ListPolicyResponse{
+			Status: merr.StatusWithErrorCode(errors.New(errMsg), commonpb.ErrorCode_ListPolicyFailure),
+		}, nil
+	}
+	for roleName, parentRoleNames := range inheritances {
+		for _, parentRoleName := range parentRoleNames {
+			policies = append(policies, funcutil.PolicyForRoleInheritance(roleName, parentRoleName))
+		}
+	}
+
 	ctxLog.Debug(method + " success")
 	metrics.RootCoordDDLReqCounter.WithLabelValues(method, metrics.SuccessLabel).Inc()
 	metrics.RootCoordDDLReqLatency.WithLabelValues(method).Observe(float64(tr.ElapseSpan().Milliseconds()))
@@ -2754,6 +2845,99 @@ func (c *Core) ListPolicy(ctx context.Context, in *internalpb.ListPolicyRequest)
 	}, nil
 }
 
+// OperateRoleInheritance grants or revokes the parent role to the role, the role inherits the privileges of the parent role.
+// - check the node health
+// - check if the roles are valid
+// - operate the role inheritance by the meta api
+// - update the policy cache
+func (c *Core) OperateRoleInheritance(ctx context.Context, in *rootcoordpb.OperateRoleInheritanceRequest) (*commonpb.Status, error) {
+	method := "OperateRoleInheritance-" + in.GetType().String()
+	metrics.RootCoordDDLReqCounter.WithLabelValues(method, metrics.TotalLabel).Inc()
+	tr := timerecord.NewTimeRecorder(method)
+	roleName, parentRoleName := in.GetRoleName(), in.GetParentRoleName()
+	ctxLog := log.Ctx(ctx).With(zap.String("role", typeutil.RootCoordRole),
+		zap.String("role_name", roleName), zap.String("parent_role_name", parentRoleName))
+	ctxLog.Debug(method)
+
+	if err := merr.CheckHealthy(c.GetStateCode()); err != nil {
+		return merr.Status(err), nil
+	}
+	if err := c.isValidRole(&milvuspb.RoleEntity{Name: roleName}); err != nil {
+		return merr.Status(merr.WrapErrParameterInvalidMsg(err.Error())), nil
+	}
+	if err := c.isValidRole(&milvuspb.RoleEntity{Name: parentRoleName}); err != nil {
+		return merr.Status(merr.WrapErrParameterInvalidMsg(err.Error())), nil
+	}
+
+	redoTask := newBaseRedoTask(c.stepExecutor)
+	redoTask.AddSyncStep(NewSimpleStep("operate role inheritance meta data", func(ctx context.Context) ([]nestedStep, error) {
+		err := c.meta.OperateRoleInheritance(util.DefaultTenant, roleName, parentRoleName, in.GetType())
+		if err != nil && !common.IsIgnorableError(err) {
+			ctxLog.Warn("fail to operate the role inheritance", zap.Error(err))
+			return nil, merr.WrapErrParameterInvalidMsg(err.Error())
+		}
+		return nil, nil
+	}))
+	redoTask.AddAsyncStep(NewSimpleStep("operate role inheritance cache", func(ctx context.Context) ([]nestedStep, error) {
+		opType := int32(typeutil.CacheGrantPrivilege)
+		if funcutil.IsRevoke(in.GetType()) {
+			opType = int32(typeutil.CacheRevokePrivilege)
+		}
+		if err := c.proxyClientManager.RefreshPolicyInfoCache(ctx, &proxypb.RefreshPolicyInfoCacheRequest{
+			OpType: opType,
+			OpKey:  funcutil.PolicyForRoleInheritance(roleName, parentRoleName),
+		}); err != nil {
+			ctxLog.Warn("fail to refresh policy info cache", zap.Error(err))
+			return nil, err
+		}
+		return nil, nil
+	}))
+
+	if err := redoTask.Execute(ctx); err != nil {
+		ctxLog.Warn("fail to execute task when operating the role inheritance", zap.Error(err))
+		metrics.RootCoordDDLReqCounter.WithLabelValues(method, metrics.FailLabel).Inc()
+		return merr.Status(err), nil
+	}
+
+	ctxLog.Debug(method + " success")
+	metrics.RootCoordDDLReqCounter.WithLabelValues(method, metrics.SuccessLabel).Inc()
+	metrics.RootCoordDDLReqLatency.WithLabelValues(method).Observe(float64(tr.ElapseSpan().Milliseconds()))
+	return merr.Success(), nil
+}
+
+// ListRoleInheritances lists the parent roles of each role, the roles are sorted by name.
+func (c *Core) ListRoleInheritances(ctx context.Context, in *rootcoordpb.ListRoleInheritancesRequest) (*rootcoordpb.ListRoleInheritancesResponse, error) {
+	method := "ListRoleInheritances"
+	metrics.RootCoordDDLReqCounter.WithLabelValues(method, metrics.TotalLabel).Inc()
+	ctxLog := log.Ctx(ctx).With(zap.String("role", typeutil.RootCoordRole))
+	ctxLog.Debug(method)
+
+	if err := merr.CheckHealthy(c.GetStateCode()); err != nil {
+		return &rootcoordpb.ListRoleInheritancesResponse{Status: merr.Status(err)}, nil
+	}
+	inheritances, err := c.meta.ListRoleInheritance(util.DefaultTenant)
+	if err != nil {
+		ctxLog.Warn("fail to list role inheritance", zap.Error(err))
+		metrics.RootCoordDDLReqCounter.WithLabelValues(method, metrics.FailLabel).Inc()
+		return &rootcoordpb.ListRoleInheritancesResponse{Status: merr.Status(err)}, nil
+	}
+
+	roleNames := lo.Keys(inheritances)
+	sort.Strings(roleNames)
+	resp := &rootcoordpb.ListRoleInheritancesResponse{
+		Status:       merr.Success(),
+		Inheritances: make([]*rootcoordpb.RoleInheritance, 0, len(roleNames)),
+	}
+	for _, roleName := range roleNames {
+		resp.Inheritances = append(resp.Inheritances, &rootcoordpb.RoleInheritance{
+			RoleName:        roleName,
+			ParentRoleNames: inheritances[roleName],
+		})
+	}
+	metrics.RootCoordDDLReqCounter.WithLabelValues(method, metrics.SuccessLabel).Inc()
+	return resp, nil
+}
+
 func (c *Core) RenameCollection(ctx context.Context, req *milvuspb.RenameCollectionRequest) (*commonpb.Status, error) {
 	if err := merr.CheckHealthy(c.GetStateCode()); err != nil {
 		return merr.Status(err), nil
@@ -2831,3 +3015,188 @@ func (c *Core) CheckHealth(ctx context.Context, in *milvuspb.CheckHealthRequest)
 
 	return &milvuspb.CheckHealthResponse{Status: merr.Success(), IsHealthy: true, Reasons: errReasons}, nil
 }
+
+// CancelDDLTask cancels the pending ddl task in the queue of scheduler, the executing task could not be cancelled.
+func (c *Core) CancelDDLTask(ctx context.Context, in *rootcoordpb.CancelDDLTaskRequest) (*commonpb.Status, error) {
+	method := "CancelDDLTask"
+	metrics.RootCoordDDLReqCounter.WithLabelValues(method, metrics.TotalLabel).Inc()
+	log := log.Ctx(ctx).With(zap.String("role", typeutil.RootCoordRole), zap.Int64("taskID", in.GetTaskID()))
+	log.Info("received request to cancel ddl task")
+
+	if err := merr.CheckHealthy(c.GetStateCode()); err != nil {
+		return merr.Status(err), nil
+	}
+	if err := c.scheduler.CancelTask(in.GetTaskID()); err != nil {
+		log.Warn("failed to cancel ddl task", zap.Error(err))
+		metrics.RootCoordDDLReqCounter.WithLabelValues(method, metrics.FailLabel).Inc()
+		return merr.Status(err), nil
+	}
+
+	metrics.RootCoordDDLReqCounter.WithLabelValues(method, metrics.SuccessLabel).Inc()
+	log.Info("done to cancel ddl task")
+	return merr.Success(), nil
+}
+
+// OperateCollectionTrash restores the dropped collection kept in the trash, or purges it at once.
+func (c *Core) OperateCollectionTrash(ctx context.Context, in *rootcoordpb.OperateCollectionTrashRequest) (*commonpb.Status, error) {
+	method := "OperateCollectionTrash"
+	metrics.RootCoordDDLReqCounter.WithLabelValues(method, metrics.TotalLabel).Inc()
+	log := log.Ctx(ctx).With(zap.String("role", typeutil.RootCoordRole),
+		zap.Int64("collectionID", in.GetCollectionID()),
+		zap.String("operateType", in.GetOperateType().String()))
+	log.Info("received request to operate collection trash")
+
+	if err := merr.CheckHealthy(c.GetStateCode()); err != nil {
+		return merr.Status(err), nil
+	}
+
+	var err error
+	switch in.GetOperateType() {
+	case rootcoordpb.CollectionTrashOperateType_RestoreCollection:
+		err = c.restoreCollection(ctx, in.GetCollectionID())
+	case rootcoordpb.CollectionTrashOperateType_PurgeCollection:
+		err = c.purgeCollection(ctx, in.GetCollectionID())
+	default:
+		err = merr.WrapErrParameterInvalidMsg("invalid collection trash operate type %d", in.GetOperateType())
+	}
+	if err != nil {
+		log.Warn("failed to operate collection trash", zap.Error(err))
+		metrics.RootCoordDDLReqCounter.WithLabelValues(method, metrics.FailLabel).Inc()
+		return merr.Status(err), nil
+	}
+
+	metrics.RootCoordDDLReqCounter.WithLabelValues(method, metrics.SuccessLabel).Inc()
+	log.Info("done to operate collection trash")
+	return merr.Success(), nil
+}
+
+// OperateCollectionTemplate creates or drops the collection template.
+func (c *Core) OperateCollectionTemplate(ctx context.Context, in *rootcoordpb.OperateCollectionTemplateRequest) (*commonpb.Status, error) {
+	method := "OperateCollectionTemplate"
+	metrics.RootCoordDDLReqCounter.WithLabelValues(method, metrics.TotalLabel).Inc()
+	log := log.Ctx(ctx).With(zap.String("role", typeutil.RootCoordRole),
+		zap.String("operateType", in.GetOperateType().String()))
+	log.Info("received request to operate collection template")
+
+	if err := merr.CheckHealthy(c.GetStateCode()); err != nil {
+		return merr.Status(err), nil
+	}
+
+	var err error
+	switch in.GetOperateType() {
+	case rootcoordpb.CollectionTemplateOperateType_CreateCollectionTemplate:
+		var template *model.CollectionTemplate
+		template, err = newCollectionTemplateModel(in.GetTemplate())
+		if err == nil {
+			log = log.With(zap.String("template", template.Name))
+			err = c.createCollectionTemplate(ctx, template)
+		}
+	case rootcoordpb.CollectionTemplateOperateType_DropCollectionTemplate:
+		log = log.With(zap.String("template", in.GetTemplateName()))
+		err = c.meta.DropCollectionTemplate(ctx, in.GetTemplateName())
+	default:
+		err = merr.WrapErrParameterInvalidMsg("invalid collection template operate type %d", in.GetOperateType())
+	}
+	if err != nil {
+		log.Warn("failed to operate collection template", zap.Error(err))
+		metrics.RootCoordDDLReqCounter.WithLabelValues(method, metrics.FailLabel).Inc()
+		return merr.Status(err), nil
+	}
+
+	metrics.RootCoordDDLReqCounter.WithLabelValues(method, metrics.SuccessLabel).Inc()
+	log.Info("done to operate collection template")
+	return merr.Success(), nil
+}
+
+// CreateCollectionFromTemplate creates the collection with the schema, indexes and properties of the collection template.
+func (c *Core) CreateCollectionFromTemplate(ctx context.Context, in *rootcoordpb.CreateCollectionFromTemplateRequest) (*commonpb.Status, error) {
+	method := "CreateCollectionFromTemplate"
+	metrics.RootCoordDDLReqCounter.WithLabelValues(method, metrics.TotalLabel).Inc()
+	log := log.Ctx(ctx).With(zap.String("role", typeutil.RootCoordRole),
+		zap.String("template", in.GetTemplateName()),
+		zap.String("dbName", in.GetDbName()),
+		zap.String("collectionName", in.GetCollectionName()))
+	log.Info("received request to create collection from template")
+
+	if err := merr.CheckHealthy(c.GetStateCode()); err != nil {
+		return merr.Status(err), nil
+	}
+	if err := c.createCollectionFromTemplate(ctx, in.GetTemplateName(), in.GetDbName(), in.GetCollectionName()); err != nil {
+		log.Warn("failed to create collection from template", zap.Error(err))
+		metrics.RootCoordDDLReqCounter.WithLabelValues(method, metrics.FailLabel).Inc()
+		return merr.Status(err), nil
+	}
+
+	metrics.RootCoordDDLReqCounter.WithLabelValues(method, metrics.SuccessLabel).Inc()
+	log.Info("done to create collection from template")
+	return merr.Success(), nil
+}
+
+// OperateAPIKey creates, rotates or revokes the api key, the secret is only returned when the api key is created or rotated.
+func (c *Core) OperateAPIKey(ctx context.Context, in *rootcoordpb.OperateAPIKeyRequest) (*rootcoordpb.OperateAPIKeyResponse, error) {
+	method := "OperateAPIKey"
+	metrics.RootCoordDDLReqCounter.WithLabelValues(method, metrics.TotalLabel).Inc()
+	log := log.Ctx(ctx).With(zap.String("role", typeutil.RootCoordRole),
+		zap.String("operateType", in.GetOperateType().String()))
+	log.Info("received request to operate api key")
+
+	if err := merr.CheckHealthy(c.GetStateCode()); err != nil {
+		return &rootcoordpb.OperateAPIKeyResponse{Status: merr.Status(err)}, nil
+	}
+
+	var (
+		key   *crypto.APIKey
+		token string
+		err   error
+	)
+	switch in.GetOperateType() {
+	case rootcoordpb.APIKeyOperateType_CreateAPIKey:
+		key, token, err = c.createAPIKey(ctx, in.GetUsername(), in.GetScopes(), time.Duration(in.GetTtlSeconds())*time.Second)
+	case rootcoordpb.APIKeyOperateType_RotateAPIKey:
+		key, token, err = c.rotateAPIKey(ctx, in.GetId())
+	case rootcoordpb.APIKeyOperateType_RevokeAPIKey:
+		err = c.revokeAPIKey(ctx, in.GetId())
+	default:
+		err = merr.WrapErrParameterInvalidMsg("invalid api key operate type %d", in.GetOperateType())
+	}
+	if err != nil {
+		log.Warn("failed to operate api key", zap.Error(err))
+		metrics.RootCoordDDLReqCounter.WithLabelValues(method, metrics.FailLabel).Inc()
+		return &rootcoordpb.OperateAPIKeyResponse{Status: merr.Status(err)}, nil
+	}
+
+	metrics.RootCoordDDLReqCounter.WithLabelValues(method, metrics.SuccessLabel).Inc()
+	resp := &rootcoordpb.OperateAPIKeyResponse{Status: merr.Success(), Token: token}
+	if key != nil {
+		resp.Key = newAPIKeyInfo(key)
+	}
+	return resp, nil
+}
+
+// ListAPIKeys lists the api keys of the user, or all the api keys if the username is empty.
+func (c *Core) ListAPIKeys(ctx context.Context, in *rootcoordpb.ListAPIKeysRequest) (*rootcoordpb.ListAPIKeysResponse, error) {
+	method := "ListAPIKeys"
+	metrics.RootCoordDDLReqCounter.WithLabelValues(method, metrics.TotalLabel).Inc()
+	ctxLog := log.Ctx(ctx).With(zap.String("role", typeutil.RootCoordRole), zap.String("username", in.GetUsername()))
+	ctxLog.Debug(method)
+
+	if err := merr.CheckHealthy(c.GetStateCode()); err != nil {
+		return &rootcoordpb.ListAPIKeysResponse{Status: merr.Status(err)}, nil
+	}
+	keys, err := c.meta.ListAPIKeys(ctx, in.GetUsername())
+	if err != nil {
+		ctxLog.Warn("fail to list api keys", zap.Error(err))
+		metrics.RootCoordDDLReqCounter.WithLabelValues(method, metrics.FailLabel).Inc()
+		return &rootcoordpb.ListAPIKeysResponse{Status: merr.Status(err)}, nil
+	}
+
+	resp := &rootcoordpb.ListAPIKeysResponse{
+		Status: merr.Success(),
+		Keys:   make([]*rootcoordpb.APIKeyInfo, 0, len(keys)),
+	}
+	for _, key := range keys {
+		resp.Keys = append(resp.Keys, newAPIKeyInfo(key))
+	}
+	metrics.RootCoordDDLReqCounter.WithLabelValues(method, metrics.SuccessLabel).Inc()
+	return resp, nil
+}
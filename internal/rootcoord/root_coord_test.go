@@ -48,6 +48,8 @@ import (
 	"github.com/milvus-io/milvus/internal/util/importutil"
 	"github.com/milvus-io/milvus/internal/util/sessionutil"
 	"github.com/milvus-io/milvus/pkg/common"
+	"github.com/milvus-io/milvus/pkg/util"
+	"github.com/milvus-io/milvus/pkg/util/crypto"
 	"github.com/milvus-io/milvus/pkg/util/etcd"
 	"github.com/milvus-io/milvus/pkg/util/funcutil"
 	"github.com/milvus-io/milvus/pkg/util/merr"
@@ -1878,6 +1880,171 @@ func TestRootCoord_CheckHealth(t *testing.T) {
 	})
 }
 
+func TestRootCoord_CancelDDLTask(t *testing.T) {
+	t.Run("not healthy", func(t *testing.T) {
+		c := newTestCore(withAbnormalCode())
+		resp, err := c.CancelDDLTask(context.Background(), &rootcoordpb.CancelDDLTaskRequest{TaskID: 1})
+		assert.NoError(t, err)
+		assert.Equal(t, commonpb.ErrorCode_NotReadyServe, resp.GetErrorCode())
+	})
+
+	sched := newMockScheduler()
+	sched.CancelTaskFunc = func(id UniqueID) error {
+		if id != 2 {
+			return merr.WrapErrParameterInvalidMsg("ddl task %d not found in queue", id)
+		}
+		return nil
+	}
+	c := newTestCore(withHealthyCode(), withScheduler(sched))
+
+	t.Run("not found", func(t *testing.T) {
+		resp, err := c.CancelDDLTask(context.Background(), &rootcoordpb.CancelDDLTaskRequest{TaskID: 1})
+		assert.NoError(t, err)
+		assert.ErrorIs(t, merr.Error(resp), merr.ErrParameterInvalid)
+	})
+
+	t.Run("ok", func(t *testing.T) {
+		resp, err := c.CancelDDLTask(context.Background(), &rootcoordpb.CancelDDLTaskRequest{TaskID: 2})
+		assert.NoError(t, err)
+		assert.True(t, merr.Ok(resp))
+	})
+}
+
+func TestRootCoord_OperateRoleInheritance(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("not healthy", func(t *testing.T) {
+		c := newTestCore(withAbnormalCode())
+		resp, err := c.OperateRoleInheritance(ctx, &rootcoordpb.OperateRoleInheritanceRequest{})
+		assert.NoError(t, err)
+		assert.Equal(t, commonpb.ErrorCode_NotReadyServe, resp.GetErrorCode())
+	})
+
+	inheritances := make(map[string][]string)
+	meta := newMockMetaTable()
+	meta.SelectRoleFunc = func(tenant string, entity *milvuspb.RoleEntity, includeUserInfo bool) ([]*milvuspb.RoleResult, error) {
+		if entity.GetName() == "unknown" {
+			return nil, errors.New("mock")
+		}
+		return []*milvuspb.RoleResult{{Role: entity}}, nil
+	}
+	meta.OperateRoleInheritanceFunc = func(tenant string, roleName string, parentRoleName string, operateType milvuspb.OperatePrivilegeType) error {
+		if operateType == milvuspb.OperatePrivilegeType_Grant {
+			inheritances[roleName] = append(inheritances[roleName], parentRoleName)
+		} else {
+			delete(inheritances, roleName)
+		}
+		return nil
+	}
+	c := newTestCore(withHealthyCode(), withMeta(meta), withStepExecutor(newMockStepExecutor()))
+
+	t.Run("invalid role", func(t *testing.T) {
+		resp, err := c.OperateRoleInheritance(ctx, &rootcoordpb.OperateRoleInheritanceRequest{RoleName: "team", ParentRoleName: "unknown"})
+		assert.NoError(t, err)
+		assert.ErrorIs(t, merr.Error(resp), merr.ErrParameterInvalid)
+
+		resp, err = c.OperateRoleInheritance(ctx, &rootcoordpb.OperateRoleInheritanceRequest{ParentRoleName: "reader"})
+		assert.NoError(t, err)
+		assert.ErrorIs(t, merr.Error(resp), merr.ErrParameterInvalid)
+	})
+
+	t.Run("grant", func(t *testing.T) {
+		resp, err := c.OperateRoleInheritance(ctx, &rootcoordpb.OperateRoleInheritanceRequest{
+			RoleName:       "team",
+			ParentRoleName: "reader",
+			Type:           milvuspb.OperatePrivilegeType_Grant,
+		})
+		assert.NoError(t, err)
+		assert.True(t, merr.Ok(resp))
+		assert.Equal(t, map[string][]string{"team": {"reader"}}, inheritances)
+	})
+
+	t.Run("revoke", func(t *testing.T) {
+		resp, err := c.OperateRoleInheritance(ctx, &rootcoordpb.OperateRoleInheritanceRequest{
+			RoleName:       "team",
+			ParentRoleName: "reader",
+			Type:           milvuspb.OperatePrivilegeType_Revoke,
+		})
+		assert.NoError(t, err)
+		assert.True(t, merr.Ok(resp))
+		assert.Empty(t, inheritances)
+	})
+}
+
+func TestRootCoord_ListRoleInheritances(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("not healthy", func(t *testing.T) {
+		c := newTestCore(withAbnormalCode())
+		resp, err := c.ListRoleInheritances(ctx, &rootcoordpb.ListRoleInheritancesRequest{})
+		assert.NoError(t, err)
+		assert.Equal(t, commonpb.ErrorCode_NotReadyServe, resp.GetStatus().GetErrorCode())
+	})
+
+	t.Run("meta failed", func(t *testing.T) {
+		c := newTestCore(withHealthyCode(), withInvalidMeta())
+		resp, err := c.ListRoleInheritances(ctx, &rootcoordpb.ListRoleInheritancesRequest{})
+		assert.NoError(t, err)
+		assert.False(t, merr.Ok(resp.GetStatus()))
+	})
+
+	meta := newMockMetaTable()
+	meta.ListRoleInheritanceFunc = func(tenant string) (map[string][]string, error) {
+		return map[string][]string{"writer": {"reader"}, "team": {"reader", "writer"}}, nil
+	}
+	c := newTestCore(withHealthyCode(), withMeta(meta))
+	resp, err := c.ListRoleInheritances(ctx, &rootcoordpb.ListRoleInheritancesRequest{})
+	assert.NoError(t, err)
+	assert.True(t, merr.Ok(resp.GetStatus()))
+	assert.Equal(t, []*rootcoordpb.RoleInheritance{
+		{RoleName: "team", ParentRoleNames: []string{"reader", "writer"}},
+		{RoleName: "writer", ParentRoleNames: []string{"reader"}},
+	}, resp.GetInheritances())
+}
+
+func TestRootCoord_GetCredentialState(t *testing.T) {
+	ctx := context.Background()
+	meta := newMockMetaTable()
+	meta.GetCredentialFunc = func(username string) (*internalpb.CredentialInfo, error) {
+		return &internalpb.CredentialInfo{Username: username, EncryptedPassword: "pwd"}, nil
+	}
+	meta.GetCredentialStateFunc = func(username string) (*crypto.CredentialState, error) {
+		return &crypto.CredentialState{EncryptedPassword: "pwd", PreviousPassword: "previous", UpdatedTime: 10}, nil
+	}
+	c := newTestCore(withHealthyCode(), withMeta(meta))
+
+	resp, err := c.GetCredential(ctx, &rootcoordpb.GetCredentialRequest{Username: "foo"})
+	assert.NoError(t, err)
+	assert.NoError(t, merr.Error(resp.GetStatus()))
+	assert.Equal(t, "pwd", resp.GetPassword())
+
+	resp, err = c.GetCredential(ctx, &rootcoordpb.GetCredentialRequest{
+		Base: &commonpb.MsgBase{
+			Properties: map[string]string{util.CredentialStateKey: "true"},
+		},
+		Username: "foo",
+	})
+	assert.NoError(t, err)
+	assert.NoError(t, merr.Error(resp.GetStatus()))
+	state, err := crypto.DecodeCredentialState(resp.GetPassword())
+	assert.NoError(t, err)
+	assert.Equal(t, "pwd", state.EncryptedPassword)
+	assert.Equal(t, "previous", state.PreviousPassword)
+	assert.EqualValues(t, 10, state.UpdatedTime)
+
+	meta.GetCredentialStateFunc = func(username string) (*crypto.CredentialState, error) {
+		return nil, errors.New("mock")
+	}
+	resp, err = c.GetCredential(ctx, &rootcoordpb.GetCredentialRequest{
+		Base: &commonpb.MsgBase{
+			Properties: map[string]string{util.CredentialStateKey: "true"},
+		},
+		Username: "foo",
+	})
+	assert.NoError(t, err)
+	assert.Error(t, merr.Error(resp.GetStatus()))
+}
+
 func TestRootCoord_RBACError(t *testing.T) {
 	ctx := context.Background()
 	c := newTestCore(withHealthyCode(), withInvalidMeta())
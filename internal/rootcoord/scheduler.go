@@ -18,15 +18,19 @@ package rootcoord
 
 import (
 	"context"
+	"reflect"
+	"sort"
 	"sync"
 	"time"
 
+	"github.com/cockroachdb/errors"
 	"go.uber.org/atomic"
 	"go.uber.org/zap"
 
 	"github.com/milvus-io/milvus/internal/allocator"
 	"github.com/milvus-io/milvus/internal/tso"
 	"github.com/milvus-io/milvus/pkg/log"
+	"github.com/milvus-io/milvus/pkg/util/merr"
 )
 
 type IScheduler interface {
@@ -34,6 +38,30 @@ type IScheduler interface {
 	Stop()
 	AddTask(t task) error
 	GetMinDdlTs() Timestamp
+	ListTasks() []*ddlTaskInfo
+	CancelTask(id UniqueID) error
+}
+
+const (
+	ddlTaskPending   = "pending"
+	ddlTaskExecuting = "executing"
+)
+
+// ddlTaskInfo describes a ddl task in the queue of scheduler.
+type ddlTaskInfo struct {
+	ID          UniqueID  `json:"id"`
+	Type        string    `json:"type"`
+	Ts          Timestamp `json:"ts"`
+	State       string    `json:"state"`
+	EnqueueTime time.Time `json:"enqueueTime"`
+	// BlockedBy is the executing task which the pending task waits for, and the tasks ahead of it in queue.
+	BlockedBy []UniqueID `json:"blockedBy,omitempty"`
+}
+
+type queuedTask struct {
+	task        task
+	enqueueTime time.Time
+	executing   bool
 }
 
 type scheduler struct {
@@ -48,6 +76,10 @@ type scheduler struct {
 
 	lock sync.Mutex
 
+	// queued are the ddl tasks pending or executing, excluding the tasks to sync ts
+	queueLock sync.Mutex
+	queued    map[task]*queuedTask
+
 	minDdlTs atomic.Uint64
 }
 
@@ -61,6 +93,7 @@ func newScheduler(ctx context.Context, idAllocator allocator.Interface, tsoAlloc
 		idAllocator:  idAllocator,
 		tsoAllocator: tsoAllocator,
 		taskChan:     make(chan task, n),
+		queued:       make(map[task]*queuedTask),
 		minDdlTs:     *atomic.NewUint64(0),
 	}
 }
@@ -80,6 +113,11 @@ func (s *scheduler) Stop() {
 
 func (s *scheduler) execute(task task) {
 	defer s.setMinDdlTs(task.GetTs()) // we should update ts, whatever task succeeds or not.
+	if !s.startExecuting(task) {
+		// cancelled before executing
+		return
+	}
+	defer s.finishExecuting(task)
 	task.SetInQueueDuration()
 	if err := task.Prepare(task.GetCtx()); err != nil {
 		task.NotifyDone(err)
@@ -143,9 +181,98 @@ func (s *scheduler) setTs(task task) error {
 }
 
 func (s *scheduler) enqueue(task task) {
+	if !isSyncTsTask(task) {
+		s.queueLock.Lock()
+		s.queued[task] = &queuedTask{task: task, enqueueTime: time.Now()}
+		s.queueLock.Unlock()
+	}
 	s.taskChan <- task
 }
 
+// isSyncTsTask returns true for the base task used to update the min ddl ts, which is invisible in queue.
+func isSyncTsTask(t task) bool {
+	_, ok := t.(*baseTask)
+	return ok
+}
+
+// startExecuting marks the task executing, returns false if the task was cancelled.
+func (s *scheduler) startExecuting(t task) bool {
+	if isSyncTsTask(t) {
+		return true
+	}
+	s.queueLock.Lock()
+	defer s.queueLock.Unlock()
+	queued, ok := s.queued[t]
+	if !ok {
+		return false
+	}
+	queued.executing = true
+	return true
+}
+
+func (s *scheduler) finishExecuting(t task) {
+	s.queueLock.Lock()
+	defer s.queueLock.Unlock()
+	delete(s.queued, t)
+}
+
+// ListTasks returns the pending and executing ddl tasks in the order of execution.
+func (s *scheduler) ListTasks() []*ddlTaskInfo {
+	s.queueLock.Lock()
+	defer s.queueLock.Unlock()
+
+	infos := make([]*ddlTaskInfo, 0, len(s.queued))
+	for _, queued := range s.queued {
+		info := &ddlTaskInfo{
+			ID:          queued.task.GetID(),
+			Type:        reflect.Indirect(reflect.ValueOf(queued.task)).Type().Name(),
+			Ts:          queued.task.GetTs(),
+			State:       ddlTaskPending,
+			EnqueueTime: queued.enqueueTime,
+		}
+		if queued.executing {
+			info.State = ddlTaskExecuting
+		}
+		infos = append(infos, info)
+	}
+	sort.Slice(infos, func(i, j int) bool {
+		return infos[i].Ts < infos[j].Ts
+	})
+
+	blockers := make([]UniqueID, 0, len(infos))
+	for _, info := range infos {
+		if info.State == ddlTaskPending && len(blockers) > 0 {
+			info.BlockedBy = append([]UniqueID{}, blockers...)
+		}
+		blockers = append(blockers, info.ID)
+	}
+	return infos
+}
+
+// CancelTask cancels the pending ddl task, the executing task could not be cancelled.
+func (s *scheduler) CancelTask(id UniqueID) error {
+	s.queueLock.Lock()
+	defer s.queueLock.Unlock()
+
+	var queued *queuedTask
+	for t, q := range s.queued {
+		if t.GetID() == id {
+			queued = q
+			break
+		}
+	}
+	if queued == nil {
+		return merr.WrapErrParameterInvalidMsg("ddl task %d not found in queue", id)
+	}
+	if queued.executing {
+		return merr.WrapErrParameterInvalidMsg("ddl task %d is executing, cannot be cancelled", id)
+	}
+	delete(s.queued, queued.task)
+	queued.task.NotifyDone(errors.Wrapf(context.Canceled, "ddl task %d cancelled", id))
+	log.Info("ddl task cancelled", zap.Int64("taskID", id), zap.Uint64("ts", queued.task.GetTs()))
+	return nil
+}
+
 func (s *scheduler) AddTask(task task) error {
 	// make sure that setting ts and enqueue is atomic.
 	s.lock.Lock()
@@ -25,6 +25,7 @@ import (
 
 	"github.com/cockroachdb/errors"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 	"go.uber.org/atomic"
 
 	"github.com/milvus-io/milvus/pkg/util/paramtable"
@@ -242,3 +243,80 @@ func Test_scheduler_updateDdlMinTsLoop(t *testing.T) {
 		s.Stop()
 	})
 }
+
+type mockBlockingTask struct {
+	baseTask
+	started chan struct{}
+	release chan struct{}
+}
+
+func newMockBlockingTask() *mockBlockingTask {
+	task := &mockBlockingTask{
+		baseTask: newBaseTask(context.Background(), nil),
+		started:  make(chan struct{}),
+		release:  make(chan struct{}),
+	}
+	task.SetCtx(context.Background())
+	return task
+}
+
+func (m *mockBlockingTask) Execute(context.Context) error {
+	close(m.started)
+	<-m.release
+	return nil
+}
+
+func Test_scheduler_ListTasks_CancelTask(t *testing.T) {
+	idAlloc := newMockIDAllocator()
+	tsoAlloc := newMockTsoAllocator()
+	id := atomic.NewInt64(100)
+	idAlloc.AllocOneF = func() (UniqueID, error) {
+		return id.Inc(), nil
+	}
+	ts := atomic.NewUint64(1000)
+	tsoAlloc.GenerateTSOF = func(count uint32) (uint64, error) {
+		return ts.Inc(), nil
+	}
+	ctx := context.Background()
+	s := newScheduler(ctx, idAlloc, tsoAlloc)
+	paramtable.Init()
+	s.Start()
+	defer s.Stop()
+
+	blocking := newMockBlockingTask()
+	require.NoError(t, s.AddTask(blocking))
+	<-blocking.started
+
+	pending1 := newMockNormalTask()
+	require.NoError(t, s.AddTask(pending1))
+	pending2 := newMockNormalTask()
+	require.NoError(t, s.AddTask(pending2))
+
+	infos := s.ListTasks()
+	require.Len(t, infos, 3)
+	assert.Equal(t, blocking.GetID(), infos[0].ID)
+	assert.Equal(t, "mockBlockingTask", infos[0].Type)
+	assert.Equal(t, ddlTaskExecuting, infos[0].State)
+	assert.Empty(t, infos[0].BlockedBy)
+	assert.Equal(t, pending1.GetID(), infos[1].ID)
+	assert.Equal(t, ddlTaskPending, infos[1].State)
+	assert.Equal(t, []UniqueID{blocking.GetID()}, infos[1].BlockedBy)
+	assert.Equal(t, []UniqueID{blocking.GetID(), pending1.GetID()}, infos[2].BlockedBy)
+
+	// executing task could not be cancelled
+	assert.Error(t, s.CancelTask(blocking.GetID()))
+	// unknown task
+	assert.Error(t, s.CancelTask(-1))
+
+	assert.NoError(t, s.CancelTask(pending1.GetID()))
+	err := pending1.WaitToFinish()
+	assert.ErrorIs(t, err, context.Canceled)
+	assert.Error(t, s.CancelTask(pending1.GetID()))
+
+	close(blocking.release)
+	assert.NoError(t, blocking.WaitToFinish())
+	assert.NoError(t, pending2.WaitToFinish())
+	assert.Eventually(t, func() bool {
+		return len(s.ListTasks()) == 0
+	}, 5*time.Second, 10*time.Millisecond)
+}
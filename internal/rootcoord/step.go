@@ -164,6 +164,55 @@ func (s *changeCollectionStateStep) Desc() string {
 		s.collectionID, s.ts, s.state.String())
 }
 
+type moveCollectionToTrashStep struct {
+	baseStep
+	collectionID UniqueID
+	droppedAt    time.Time
+	ts           Timestamp
+}
+
+func (s *moveCollectionToTrashStep) Execute(ctx context.Context) ([]nestedStep, error) {
+	err := s.core.meta.MoveCollectionToTrash(ctx, s.collectionID, s.droppedAt, s.ts)
+	return nil, err
+}
+
+func (s *moveCollectionToTrashStep) Desc() string {
+	return fmt.Sprintf("move collection to trash, collection: %d, ts: %d", s.collectionID, s.ts)
+}
+
+type restoreCollectionStep struct {
+	baseStep
+	collectionID UniqueID
+	ts           Timestamp
+}
+
+func (s *restoreCollectionStep) Execute(ctx context.Context) ([]nestedStep, error) {
+	err := s.core.meta.RestoreCollection(ctx, s.collectionID, s.ts)
+	return nil, err
+}
+
+func (s *restoreCollectionStep) Desc() string {
+	return fmt.Sprintf("restore collection from trash, collection: %d, ts: %d", s.collectionID, s.ts)
+}
+
+type alterAliasAndDropCollectionStep struct {
+	baseStep
+	dbName         string
+	alias          string
+	collectionName string
+	ts             Timestamp
+}
+
+func (s *alterAliasAndDropCollectionStep) Execute(ctx context.Context) ([]nestedStep, error) {
+	err := s.core.meta.AlterAliasAndDropCollection(ctx, s.dbName, s.alias, s.collectionName, s.ts)
+	return nil, err
+}
+
+func (s *alterAliasAndDropCollectionStep) Desc() string {
+	return fmt.Sprintf("alter alias and drop previous collection, db: %s, alias: %s, collection: %s, ts: %d",
+		s.dbName, s.alias, s.collectionName, s.ts)
+}
+
 type expireCacheStep struct {
 	baseStep
 	dbName          string
@@ -183,6 +232,47 @@ func (s *expireCacheStep) Desc() string {
 		s.collectionID, s.collectionNames, s.ts)
 }
 
+type renameCollectionStep struct {
+	baseStep
+	dbName    string
+	oldName   string
+	newDBName string
+	newName   string
+	ts        Timestamp
+}
+
+func (s *renameCollectionStep) Execute(ctx context.Context) ([]nestedStep, error) {
+	err := s.core.meta.RenameCollection(ctx, s.dbName, s.oldName, s.newDBName, s.newName, s.ts)
+	return nil, err
+}
+
+func (s *renameCollectionStep) Desc() string {
+	return fmt.Sprintf("rename collection, db: %s, old name: %s, new db: %s, new name: %s, ts: %d",
+		s.dbName, s.oldName, s.newDBName, s.newName, s.ts)
+}
+
+type migrateCollectionGrantsStep struct {
+	baseStep
+	dbName    string
+	oldName   string
+	newDBName string
+	newName   string
+}
+
+func (s *migrateCollectionGrantsStep) Execute(ctx context.Context) ([]nestedStep, error) {
+	err := s.core.migrateCollectionGrants(ctx, s.dbName, s.oldName, s.newDBName, s.newName)
+	return nil, err
+}
+
+func (s *migrateCollectionGrantsStep) Desc() string {
+	return fmt.Sprintf("migrate grants of collection, db: %s, old name: %s, new db: %s, new name: %s",
+		s.dbName, s.oldName, s.newDBName, s.newName)
+}
+
+func (s *migrateCollectionGrantsStep) Weight() stepPriority {
+	return stepPriorityImportant
+}
+
 type deleteCollectionDataStep struct {
 	baseStep
 	coll *model.Collection
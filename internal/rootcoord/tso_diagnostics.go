@@ -0,0 +1,96 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package rootcoord
+
+import (
+	"net/http"
+	"strconv"
+	"time"
+
+	"go.uber.org/zap"
+
+	management "github.com/milvus-io/milvus/internal/http"
+	"github.com/milvus-io/milvus/pkg/eventlog"
+	"github.com/milvus-io/milvus/pkg/log"
+	"github.com/milvus-io/milvus/pkg/metrics"
+	"github.com/milvus-io/milvus/pkg/util/paramtable"
+	"github.com/milvus-io/milvus/pkg/util/tsoutil"
+	"github.com/milvus-io/milvus/pkg/util/typeutil"
+)
+
+// newTSODiagnostics creates the diagnostics of the timestamps allocated by rootcoord,
+// the clock drift alarms are logged and published as cluster events.
+func newTSODiagnostics() *tsoutil.Diagnostics {
+	threshold := func() time.Duration {
+		return Params.RootCoordCfg.TSODriftAlarmThreshold.GetAsDuration(time.Millisecond)
+	}
+	return tsoutil.NewDiagnostics(threshold, func(alarm *tsoutil.DriftAlarm) {
+		if alarm.Raised {
+			log.Warn("clock drift alarm raised, the allocated timestamps drift from the wall clock",
+				zap.Duration("drift", alarm.Drift), zap.Duration("threshold", alarm.Threshold), zap.Uint64("timestamp", alarm.Timestamp))
+		} else {
+			log.Info("clock drift alarm cleared", zap.Duration("drift", alarm.Drift))
+		}
+		eventlog.Publish(&eventlog.ClusterEvent{
+			EventType: eventlog.EventClockDrift,
+			Role:      typeutil.RootCoordRole,
+			NodeID:    paramtable.GetNodeID(),
+			Attrs: map[string]string{
+				"raised":       strconv.FormatBool(alarm.Raised),
+				"drift_ms":     strconv.FormatInt(alarm.Drift.Milliseconds(), 10),
+				"threshold_ms": strconv.FormatInt(alarm.Threshold.Milliseconds(), 10),
+				"timestamp":    strconv.FormatUint(alarm.Timestamp, 10),
+			},
+		})
+	})
+}
+
+// observeTimestamps records the count timestamps allocated starting from ts.
+func (c *Core) observeTimestamps(ts uint64, count uint32) {
+	if c.tsoDiagnostics == nil {
+		return
+	}
+	c.tsoDiagnostics.Observe(ts, count, time.Now())
+	metrics.RootCoordTimestampDrift.Set(float64(c.tsoDiagnostics.Snapshot().DriftMs))
+	metrics.RootCoordTimestampAllocCounter.Add(float64(count))
+}
+
+var tsoDiagnosticsComponent = management.NewComponent[*tsoutil.Diagnostics]("rootcoord")
+
+// registerTSODiagnosticsHandler exposes the timestamp allocation diagnostics through the management http server,
+// the handler is registered only once and serves the diagnostics of the latest started rootcoord.
+func registerTSODiagnosticsHandler(d *tsoutil.Diagnostics) {
+	tsoDiagnosticsComponent.Serve(d, &management.Handler{
+		Path:        management.RootCoordTSODiagnosticsRouterPath,
+		HandlerFunc: tsoDiagnosticsHandler,
+	})
+}
+
+// tsoDiagnosticsHandler returns the drift and the allocation rate of the timestamps.
+//
+//	GET /rootcoord/tso/diagnostics
+func tsoDiagnosticsHandler(w http.ResponseWriter, req *http.Request) {
+	if req.Method != http.MethodGet {
+		management.WriteJSON(w, http.StatusMethodNotAllowed, map[string]string{"error": "method not allowed"})
+		return
+	}
+	d, ok := tsoDiagnosticsComponent.Get(w)
+	if !ok {
+		return
+	}
+	management.WriteJSON(w, http.StatusOK, d.Snapshot())
+}
@@ -0,0 +1,64 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package rootcoord
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	management "github.com/milvus-io/milvus/internal/http"
+	"github.com/milvus-io/milvus/pkg/util/paramtable"
+	"github.com/milvus-io/milvus/pkg/util/tsoutil"
+)
+
+func Test_tsoDiagnostics(t *testing.T) {
+	paramtable.Init()
+	// the handler serves a standalone component, the one of the rootcoord started by other tests is restored after
+	defer func(c *management.Component[*tsoutil.Diagnostics]) { tsoDiagnosticsComponent = c }(tsoDiagnosticsComponent)
+	tsoDiagnosticsComponent = management.NewComponent[*tsoutil.Diagnostics]("rootcoord")
+
+	t.Run("not started", func(t *testing.T) {
+		w := httptest.NewRecorder()
+		tsoDiagnosticsHandler(w, httptest.NewRequest(http.MethodGet, "/rootcoord/tso/diagnostics", nil))
+		assert.Equal(t, http.StatusServiceUnavailable, w.Code)
+	})
+
+	c := &Core{tsoDiagnostics: newTSODiagnostics()}
+	tsoDiagnosticsComponent.Serve(c.tsoDiagnostics)
+	// the timestamps run 2 seconds ahead of the wall clock
+	c.observeTimestamps(tsoutil.ComposeTSByTime(time.Now().Add(2*time.Second), 0), 10)
+
+	t.Run("get", func(t *testing.T) {
+		w := httptest.NewRecorder()
+		tsoDiagnosticsHandler(w, httptest.NewRequest(http.MethodGet, "/rootcoord/tso/diagnostics", nil))
+		assert.Equal(t, http.StatusOK, w.Code)
+		snapshot := &tsoutil.DiagnosticsSnapshot{}
+		assert.NoError(t, json.Unmarshal(w.Body.Bytes(), snapshot))
+		assert.EqualValues(t, 10, snapshot.Allocated)
+		assert.InDelta(t, 2000, snapshot.DriftMs, 100)
+		assert.True(t, snapshot.Alarming)
+
+		w = httptest.NewRecorder()
+		tsoDiagnosticsHandler(w, httptest.NewRequest(http.MethodPost, "/rootcoord/tso/diagnostics", nil))
+		assert.Equal(t, http.StatusMethodNotAllowed, w.Code)
+	})
+}
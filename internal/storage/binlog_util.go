@@ -2,12 +2,17 @@ package storage
 
 import (
 	"fmt"
+	"hash/crc32"
 	"strconv"
 	"strings"
 
+	"github.com/milvus-io/milvus/internal/proto/datapb"
 	"github.com/milvus-io/milvus/pkg/common"
+	"github.com/milvus-io/milvus/pkg/util/merr"
 )
 
+var crc32cTable = crc32.MakeTable(crc32.Castagnoli)
+
 // ParseSegmentIDByBinlog parse segment id from binlog paths
 // if path format is not expected, returns error
 func ParseSegmentIDByBinlog(rootPath, path string) (UniqueID, error) {
@@ -39,3 +44,20 @@ func ParseSegmentIDByBinlog(rootPath, path string) (UniqueID, error) {
 	}
 	return 0, fmt.Errorf("%s is not a valid binlog path", path)
 }
+
+// BinlogChecksum returns the checksum of binlog content recorded in segment meta.
+func BinlogChecksum(data []byte) string {
+	return fmt.Sprintf("%08x", crc32.Checksum(data, crc32cTable))
+}
+
+// VerifyBinlogChecksum verifies the binlog content against the checksum recorded in segment meta,
+// the legacy binlogs without checksum are not verified.
+func VerifyBinlogChecksum(binlog *datapb.Binlog, data []byte) error {
+	if binlog.GetChecksum() == "" {
+		return nil
+	}
+	if actual := BinlogChecksum(data); actual != binlog.GetChecksum() {
+		return merr.WrapErrIoChecksum(binlog.GetLogPath(), fmt.Sprintf("expected=%s, actual=%s", binlog.GetChecksum(), actual))
+	}
+	return nil
+}
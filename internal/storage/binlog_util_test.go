@@ -4,6 +4,9 @@ import (
 	"testing"
 
 	"github.com/stretchr/testify/assert"
+
+	"github.com/milvus-io/milvus/internal/proto/datapb"
+	"github.com/milvus-io/milvus/pkg/util/merr"
 )
 
 func TestParseSegmentIDByBinlog(t *testing.T) {
@@ -82,3 +85,16 @@ func TestParseSegmentIDByBinlog(t *testing.T) {
 		})
 	}
 }
+
+func TestVerifyBinlogChecksum(t *testing.T) {
+	data := []byte("binlog content")
+	checksum := BinlogChecksum(data)
+	assert.Len(t, checksum, 8)
+	assert.Equal(t, checksum, BinlogChecksum([]byte("binlog content")))
+
+	assert.NoError(t, VerifyBinlogChecksum(&datapb.Binlog{Checksum: checksum}, data))
+	// legacy binlog without checksum
+	assert.NoError(t, VerifyBinlogChecksum(&datapb.Binlog{}, []byte("corrupted")))
+	err := VerifyBinlogChecksum(&datapb.Binlog{LogPath: "files/insertLog/1/2/3/4/5", Checksum: checksum}, []byte("corrupted"))
+	assert.ErrorIs(t, err, merr.ErrIoChecksum)
+}
@@ -201,7 +201,6 @@ func (insertCodec *InsertCodec) SerializePkStatsByData(data *InsertData) (*Blob,
 // It returns binlog buffer in the end.
 func (insertCodec *InsertCodec) Serialize(partitionID UniqueID, segmentID UniqueID, data *InsertData) ([]*Blob, error) {
 	blobs := make([]*Blob, 0)
-	var writer *InsertBinlogWriter
 	timeFieldData, ok := data.Data[common.TimeStampField]
 	if !ok {
 		return nil, fmt.Errorf("data doesn't contains timestamp field")
@@ -209,7 +208,6 @@ func (insertCodec *InsertCodec) Serialize(partitionID UniqueID, segmentID Unique
 	if timeFieldData.RowNum() <= 0 {
 		return nil, fmt.Errorf("there's no data in InsertData")
 	}
-	rowNum := int64(timeFieldData.RowNum())
 
 	ts := timeFieldData.(*Int64FieldData).Data
 	var startTs, endTs Timestamp
@@ -232,175 +230,184 @@ func (insertCodec *InsertCodec) Serialize(partitionID UniqueID, segmentID Unique
 	sort.Sort(dataSorter)
 
 	for _, field := range insertCodec.Schema.Schema.Fields {
-		singleData := data.Data[field.FieldID]
-
-		// encode fields
-		writer = NewInsertBinlogWriter(field.DataType, insertCodec.Schema.ID, partitionID, segmentID, field.FieldID)
-		var eventWriter *insertEventWriter
-		var err error
-		if typeutil.IsVectorType(field.DataType) {
-			switch field.DataType {
-			case schemapb.DataType_FloatVector:
-				eventWriter, err = writer.NextInsertEventWriter(singleData.(*FloatVectorFieldData).Dim)
-			case schemapb.DataType_BinaryVector:
-				eventWriter, err = writer.NextInsertEventWriter(singleData.(*BinaryVectorFieldData).Dim)
-			case schemapb.DataType_Float16Vector:
-				eventWriter, err = writer.NextInsertEventWriter(singleData.(*Float16VectorFieldData).Dim)
-			default:
-				return nil, fmt.Errorf("undefined data type %d", field.DataType)
-			}
-		} else {
-			eventWriter, err = writer.NextInsertEventWriter()
-		}
+		blob, err := insertCodec.SerializeField(partitionID, segmentID, field, data.Data[field.FieldID], startTs, endTs)
 		if err != nil {
-			writer.Close()
 			return nil, err
 		}
+		blobs = append(blobs, blob)
+	}
+
+	return blobs, nil
+}
 
-		eventWriter.SetEventTimestamp(startTs, endTs)
+// SerializeField transfers the data of a field to blob as is, without sorting by rowID,
+// the event timestamp is set to the range given.
+func (insertCodec *InsertCodec) SerializeField(partitionID UniqueID, segmentID UniqueID, field *schemapb.FieldSchema,
+	singleData FieldData, startTs Timestamp, endTs Timestamp,
+) (*Blob, error) {
+	// encode fields
+	writer := NewInsertBinlogWriter(field.DataType, insertCodec.Schema.ID, partitionID, segmentID, field.FieldID)
+	var eventWriter *insertEventWriter
+	var err error
+	if typeutil.IsVectorType(field.DataType) {
 		switch field.DataType {
-		case schemapb.DataType_Bool:
-			err = eventWriter.AddBoolToPayload(singleData.(*BoolFieldData).Data)
-			if err != nil {
-				eventWriter.Close()
-				writer.Close()
-				return nil, err
-			}
-			writer.AddExtra(originalSizeKey, fmt.Sprintf("%v", singleData.(*BoolFieldData).GetMemorySize()))
-		case schemapb.DataType_Int8:
-			err = eventWriter.AddInt8ToPayload(singleData.(*Int8FieldData).Data)
-			if err != nil {
-				eventWriter.Close()
-				writer.Close()
-				return nil, err
-			}
-			writer.AddExtra(originalSizeKey, fmt.Sprintf("%v", singleData.(*Int8FieldData).GetMemorySize()))
-		case schemapb.DataType_Int16:
-			err = eventWriter.AddInt16ToPayload(singleData.(*Int16FieldData).Data)
-			if err != nil {
-				eventWriter.Close()
-				writer.Close()
-				return nil, err
-			}
-			writer.AddExtra(originalSizeKey, fmt.Sprintf("%v", singleData.(*Int16FieldData).GetMemorySize()))
-		case schemapb.DataType_Int32:
-			err = eventWriter.AddInt32ToPayload(singleData.(*Int32FieldData).Data)
-			if err != nil {
-				eventWriter.Close()
-				writer.Close()
-				return nil, err
-			}
-			writer.AddExtra(originalSizeKey, fmt.Sprintf("%v", singleData.(*Int32FieldData).GetMemorySize()))
-		case schemapb.DataType_Int64:
-			err = eventWriter.AddInt64ToPayload(singleData.(*Int64FieldData).Data)
-			if err != nil {
-				eventWriter.Close()
-				writer.Close()
-				return nil, err
-			}
-			writer.AddExtra(originalSizeKey, fmt.Sprintf("%v", singleData.(*Int64FieldData).GetMemorySize()))
-		case schemapb.DataType_Float:
-			err = eventWriter.AddFloatToPayload(singleData.(*FloatFieldData).Data)
-			if err != nil {
-				eventWriter.Close()
-				writer.Close()
-				return nil, err
-			}
-			writer.AddExtra(originalSizeKey, fmt.Sprintf("%v", singleData.(*FloatFieldData).GetMemorySize()))
-		case schemapb.DataType_Double:
-			err = eventWriter.AddDoubleToPayload(singleData.(*DoubleFieldData).Data)
-			if err != nil {
-				eventWriter.Close()
-				writer.Close()
-				return nil, err
-			}
-			writer.AddExtra(originalSizeKey, fmt.Sprintf("%v", singleData.(*DoubleFieldData).GetMemorySize()))
-		case schemapb.DataType_String, schemapb.DataType_VarChar:
-			for _, singleString := range singleData.(*StringFieldData).Data {
-				err = eventWriter.AddOneStringToPayload(singleString)
-				if err != nil {
-					eventWriter.Close()
-					writer.Close()
-					return nil, err
-				}
-			}
-			writer.AddExtra(originalSizeKey, fmt.Sprintf("%v", singleData.(*StringFieldData).GetMemorySize()))
-		case schemapb.DataType_Array:
-			for _, singleArray := range singleData.(*ArrayFieldData).Data {
-				err = eventWriter.AddOneArrayToPayload(singleArray)
-				if err != nil {
-					eventWriter.Close()
-					writer.Close()
-					return nil, err
-				}
-			}
-			writer.AddExtra(originalSizeKey, fmt.Sprintf("%v", singleData.(*ArrayFieldData).GetMemorySize()))
-		case schemapb.DataType_JSON:
-			for _, singleJSON := range singleData.(*JSONFieldData).Data {
-				err = eventWriter.AddOneJSONToPayload(singleJSON)
-				if err != nil {
-					eventWriter.Close()
-					writer.Close()
-					return nil, err
-				}
-			}
-			writer.AddExtra(originalSizeKey, fmt.Sprintf("%v", singleData.(*JSONFieldData).GetMemorySize()))
+		case schemapb.DataType_FloatVector:
+			eventWriter, err = writer.NextInsertEventWriter(singleData.(*FloatVectorFieldData).Dim)
 		case schemapb.DataType_BinaryVector:
-			err = eventWriter.AddBinaryVectorToPayload(singleData.(*BinaryVectorFieldData).Data, singleData.(*BinaryVectorFieldData).Dim)
+			eventWriter, err = writer.NextInsertEventWriter(singleData.(*BinaryVectorFieldData).Dim)
+		case schemapb.DataType_Float16Vector:
+			eventWriter, err = writer.NextInsertEventWriter(singleData.(*Float16VectorFieldData).Dim)
+		default:
+			return nil, fmt.Errorf("undefined data type %d", field.DataType)
+		}
+	} else {
+		eventWriter, err = writer.NextInsertEventWriter()
+	}
+	if err != nil {
+		writer.Close()
+		return nil, err
+	}
+
+	eventWriter.SetEventTimestamp(startTs, endTs)
+	switch field.DataType {
+	case schemapb.DataType_Bool:
+		err = eventWriter.AddBoolToPayload(singleData.(*BoolFieldData).Data)
+		if err != nil {
+			eventWriter.Close()
+			writer.Close()
+			return nil, err
+		}
+		writer.AddExtra(originalSizeKey, fmt.Sprintf("%v", singleData.(*BoolFieldData).GetMemorySize()))
+	case schemapb.DataType_Int8:
+		err = eventWriter.AddInt8ToPayload(singleData.(*Int8FieldData).Data)
+		if err != nil {
+			eventWriter.Close()
+			writer.Close()
+			return nil, err
+		}
+		writer.AddExtra(originalSizeKey, fmt.Sprintf("%v", singleData.(*Int8FieldData).GetMemorySize()))
+	case schemapb.DataType_Int16:
+		err = eventWriter.AddInt16ToPayload(singleData.(*Int16FieldData).Data)
+		if err != nil {
+			eventWriter.Close()
+			writer.Close()
+			return nil, err
+		}
+		writer.AddExtra(originalSizeKey, fmt.Sprintf("%v", singleData.(*Int16FieldData).GetMemorySize()))
+	case schemapb.DataType_Int32:
+		err = eventWriter.AddInt32ToPayload(singleData.(*Int32FieldData).Data)
+		if err != nil {
+			eventWriter.Close()
+			writer.Close()
+			return nil, err
+		}
+		writer.AddExtra(originalSizeKey, fmt.Sprintf("%v", singleData.(*Int32FieldData).GetMemorySize()))
+	case schemapb.DataType_Int64:
+		err = eventWriter.AddInt64ToPayload(singleData.(*Int64FieldData).Data)
+		if err != nil {
+			eventWriter.Close()
+			writer.Close()
+			return nil, err
+		}
+		writer.AddExtra(originalSizeKey, fmt.Sprintf("%v", singleData.(*Int64FieldData).GetMemorySize()))
+	case schemapb.DataType_Float:
+		err = eventWriter.AddFloatToPayload(singleData.(*FloatFieldData).Data)
+		if err != nil {
+			eventWriter.Close()
+			writer.Close()
+			return nil, err
+		}
+		writer.AddExtra(originalSizeKey, fmt.Sprintf("%v", singleData.(*FloatFieldData).GetMemorySize()))
+	case schemapb.DataType_Double:
+		err = eventWriter.AddDoubleToPayload(singleData.(*DoubleFieldData).Data)
+		if err != nil {
+			eventWriter.Close()
+			writer.Close()
+			return nil, err
+		}
+		writer.AddExtra(originalSizeKey, fmt.Sprintf("%v", singleData.(*DoubleFieldData).GetMemorySize()))
+	case schemapb.DataType_String, schemapb.DataType_VarChar:
+		for _, singleString := range singleData.(*StringFieldData).Data {
+			err = eventWriter.AddOneStringToPayload(singleString)
 			if err != nil {
 				eventWriter.Close()
 				writer.Close()
 				return nil, err
 			}
-			writer.AddExtra(originalSizeKey, fmt.Sprintf("%v", singleData.(*BinaryVectorFieldData).GetMemorySize()))
-		case schemapb.DataType_FloatVector:
-			err = eventWriter.AddFloatVectorToPayload(singleData.(*FloatVectorFieldData).Data, singleData.(*FloatVectorFieldData).Dim)
+		}
+		writer.AddExtra(originalSizeKey, fmt.Sprintf("%v", singleData.(*StringFieldData).GetMemorySize()))
+	case schemapb.DataType_Array:
+		for _, singleArray := range singleData.(*ArrayFieldData).Data {
+			err = eventWriter.AddOneArrayToPayload(singleArray)
 			if err != nil {
 				eventWriter.Close()
 				writer.Close()
 				return nil, err
 			}
-			writer.AddExtra(originalSizeKey, fmt.Sprintf("%v", singleData.(*FloatVectorFieldData).GetMemorySize()))
-		case schemapb.DataType_Float16Vector:
-			err = eventWriter.AddFloat16VectorToPayload(singleData.(*Float16VectorFieldData).Data, singleData.(*Float16VectorFieldData).Dim)
+		}
+		writer.AddExtra(originalSizeKey, fmt.Sprintf("%v", singleData.(*ArrayFieldData).GetMemorySize()))
+	case schemapb.DataType_JSON:
+		for _, singleJSON := range singleData.(*JSONFieldData).Data {
+			err = eventWriter.AddOneJSONToPayload(singleJSON)
 			if err != nil {
 				eventWriter.Close()
 				writer.Close()
 				return nil, err
 			}
-			writer.AddExtra(originalSizeKey, fmt.Sprintf("%v", singleData.(*Float16VectorFieldData).GetMemorySize()))
-		default:
-			return nil, fmt.Errorf("undefined data type %d", field.DataType)
 		}
+		writer.AddExtra(originalSizeKey, fmt.Sprintf("%v", singleData.(*JSONFieldData).GetMemorySize()))
+	case schemapb.DataType_BinaryVector:
+		err = eventWriter.AddBinaryVectorToPayload(singleData.(*BinaryVectorFieldData).Data, singleData.(*BinaryVectorFieldData).Dim)
 		if err != nil {
+			eventWriter.Close()
+			writer.Close()
 			return nil, err
 		}
-		writer.SetEventTimeStamp(startTs, endTs)
-
-		err = writer.Finish()
+		writer.AddExtra(originalSizeKey, fmt.Sprintf("%v", singleData.(*BinaryVectorFieldData).GetMemorySize()))
+	case schemapb.DataType_FloatVector:
+		err = eventWriter.AddFloatVectorToPayload(singleData.(*FloatVectorFieldData).Data, singleData.(*FloatVectorFieldData).Dim)
 		if err != nil {
 			eventWriter.Close()
 			writer.Close()
 			return nil, err
 		}
-
-		buffer, err := writer.GetBuffer()
+		writer.AddExtra(originalSizeKey, fmt.Sprintf("%v", singleData.(*FloatVectorFieldData).GetMemorySize()))
+	case schemapb.DataType_Float16Vector:
+		err = eventWriter.AddFloat16VectorToPayload(singleData.(*Float16VectorFieldData).Data, singleData.(*Float16VectorFieldData).Dim)
 		if err != nil {
 			eventWriter.Close()
 			writer.Close()
 			return nil, err
 		}
-		blobKey := fmt.Sprintf("%d", field.FieldID)
-		blobs = append(blobs, &Blob{
-			Key:    blobKey,
-			Value:  buffer,
-			RowNum: rowNum,
-		})
+		writer.AddExtra(originalSizeKey, fmt.Sprintf("%v", singleData.(*Float16VectorFieldData).GetMemorySize()))
+	default:
+		return nil, fmt.Errorf("undefined data type %d", field.DataType)
+	}
+	if err != nil {
+		return nil, err
+	}
+	writer.SetEventTimeStamp(startTs, endTs)
+
+	err = writer.Finish()
+	if err != nil {
 		eventWriter.Close()
 		writer.Close()
+		return nil, err
 	}
 
-	return blobs, nil
+	buffer, err := writer.GetBuffer()
+	if err != nil {
+		eventWriter.Close()
+		writer.Close()
+		return nil, err
+	}
+	eventWriter.Close()
+	writer.Close()
+	return &Blob{
+		Key:    fmt.Sprintf("%d", field.FieldID),
+		Value:  buffer,
+		RowNum: int64(singleData.RowNum()),
+	}, nil
 }
 
 func (insertCodec *InsertCodec) DeserializeAll(blobs []*Blob) (
@@ -849,15 +856,32 @@ func (data *DeleteData) Append(pk PrimaryKey, ts Timestamp) {
 }
 
 // DeleteCodec serializes and deserializes the delete data
-type DeleteCodec struct{}
+type DeleteCodec struct {
+	format string
+}
+
+// DeleteCodecOption is the option of DeleteCodec.
+type DeleteCodecOption func(*DeleteCodec)
+
+// WithDeltalogFormat sets the format of the delta logs serialized, the delta logs of all formats are readable.
+func WithDeltalogFormat(format string) DeleteCodecOption {
+	return func(codec *DeleteCodec) {
+		codec.format = format
+	}
+}
 
 // NewDeleteCodec returns a DeleteCodec
-func NewDeleteCodec() *DeleteCodec {
-	return &DeleteCodec{}
+func NewDeleteCodec(opts ...DeleteCodecOption) *DeleteCodec {
+	codec := &DeleteCodec{format: DeltalogFormatJSON}
+	for _, opt := range opts {
+		opt(codec)
+	}
+	return codec
 }
 
 // Serialize transfer delete data to blob. .
-// For each delete message, it will save "pk,ts" string to binlog.
+// For each delete message, it will save "pk,ts" string to binlog in the json format,
+// or save all of them as one roaring encoded payload in the roaring format.
 func (deleteCodec *DeleteCodec) Serialize(collectionID UniqueID, partitionID UniqueID, segmentID UniqueID, data *DeleteData) (*Blob, error) {
 	binlogWriter := NewDeleteBinlogWriter(schemapb.DataType_String, collectionID, partitionID, segmentID)
 	eventWriter, err := binlogWriter.NextDeleteEventWriter()
@@ -871,6 +895,12 @@ func (deleteCodec *DeleteCodec) Serialize(collectionID UniqueID, partitionID Uni
 	if length != len(data.Tss) {
 		return nil, fmt.Errorf("the length of pks, and TimeStamps is not equal")
 	}
+	if deleteCodec.format == DeltalogFormatRoaring {
+		return deleteCodec.serializeRoaring(binlogWriter, eventWriter, data)
+	}
+	if deleteCodec.format != "" && deleteCodec.format != DeltalogFormatJSON {
+		return nil, fmt.Errorf("unknown delta log format %s", deleteCodec.format)
+	}
 
 	sizeTotal := 0
 	var startTs, endTs Timestamp
@@ -918,6 +948,58 @@ func (deleteCodec *DeleteCodec) Serialize(collectionID UniqueID, partitionID Uni
 	return blob, nil
 }
 
+func (deleteCodec *DeleteCodec) serializeRoaring(binlogWriter *DeleteBinlogWriter, eventWriter *deleteEventWriter, data *DeleteData) (*Blob, error) {
+	var startTs, endTs Timestamp
+	startTs, endTs = math.MaxUint64, 0
+	sizeTotal := int64(0)
+	for i, ts := range data.Tss {
+		if ts < startTs {
+			startTs = ts
+		}
+		if ts > endTs {
+			endTs = ts
+		}
+		sizeTotal += data.Pks[i].Size() + 8
+	}
+	payload, err := serializeRoaringDeltalog(data)
+	if err != nil {
+		return nil, err
+	}
+	if err := eventWriter.AddOneStringToPayload(string(payload)); err != nil {
+		return nil, err
+	}
+	eventWriter.SetEventTimestamp(startTs, endTs)
+	binlogWriter.SetEventTimeStamp(startTs, endTs)
+	binlogWriter.AddExtra(originalSizeKey, fmt.Sprintf("%v", sizeTotal))
+	binlogWriter.AddExtra(deltalogFormatKey, DeltalogFormatRoaring)
+
+	if err := binlogWriter.Finish(); err != nil {
+		return nil, err
+	}
+	buffer, err := binlogWriter.GetBuffer()
+	if err != nil {
+		return nil, err
+	}
+	return &Blob{Value: buffer}, nil
+}
+
+// IsRoaringDeltalog returns true if the delta log is serialized in the roaring format.
+func (reader *BinlogReader) IsRoaringDeltalog() bool {
+	format, ok := reader.descriptorEvent.Extras[deltalogFormatKey]
+	return ok && format == DeltalogFormatRoaring
+}
+
+// DeserializeRoaringDeltalog decodes the payload of the delta log in roaring format.
+func DeserializeRoaringDeltalog(payload []string) (*DeleteData, error) {
+	result := &DeleteData{}
+	for _, value := range payload {
+		if err := deserializeRoaringDeltalog([]byte(value), result); err != nil {
+			return nil, err
+		}
+	}
+	return result, nil
+}
+
 // Deserialize deserializes the deltalog blobs into DeleteData
 func (deleteCodec *DeleteCodec) Deserialize(blobs []*Blob) (partitionID UniqueID, segmentID UniqueID, data *DeleteData, err error) {
 	if len(blobs) == 0 {
@@ -945,6 +1027,16 @@ func (deleteCodec *DeleteCodec) Deserialize(blobs []*Blob) (partitionID UniqueID
 			binlogReader.Close()
 			return InvalidUniqueID, InvalidUniqueID, nil, err
 		}
+		if binlogReader.IsRoaringDeltalog() {
+			for _, value := range stringArray {
+				if err := deserializeRoaringDeltalog([]byte(value), result); err != nil {
+					eventReader.Close()
+					binlogReader.Close()
+					return InvalidUniqueID, InvalidUniqueID, nil, err
+				}
+			}
+			stringArray = nil
+		}
 		for i := 0; i < len(stringArray); i++ {
 			deleteLog := &DeleteLog{}
 			if err = json.Unmarshal([]byte(stringArray[i]), deleteLog); err != nil {
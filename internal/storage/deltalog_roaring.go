@@ -0,0 +1,315 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package storage
+
+import (
+	"bytes"
+	"encoding/binary"
+	"fmt"
+	"io"
+	"math/bits"
+	"sort"
+
+	"github.com/cockroachdb/errors"
+
+	"github.com/milvus-io/milvus-proto/go-api/v2/schemapb"
+	"github.com/milvus-io/milvus/pkg/common"
+)
+
+const (
+	// DeltalogFormatJSON serializes each delete as a json string of pk and ts.
+	DeltalogFormatJSON = "json"
+	// DeltalogFormatRoaring groups the deletes by ts, the pks of each group are serialized as a roaring bitmap,
+	// the varchar pks are dictionary encoded and the bitmap holds the offsets in dictionary.
+	DeltalogFormatRoaring = "roaring"
+
+	// deltalogFormatKey is the extra of binlog recording the format of delta log, absent for the json format.
+	deltalogFormatKey = "deltalog_format"
+
+	roaringDeltalogVersion = 1
+	// the containers with more values than roaringArrayMaxSize are stored as bitmaps
+	roaringArrayMaxSize   = 4096
+	roaringBitmapWords    = 1 << 16 / 64
+	roaringContainerArray = 0
+	roaringContainerBits  = 1
+)
+
+// roaringBitmap is a set of uint64 split into containers by the high 48 bits,
+// each container holds the low 16 bits in a sorted array or a bitmap depending on its cardinality.
+type roaringBitmap struct {
+	keys       []uint64
+	containers [][]uint16
+}
+
+func newRoaringBitmap(values []uint64) *roaringBitmap {
+	sort.Slice(values, func(i, j int) bool { return values[i] < values[j] })
+	rb := &roaringBitmap{}
+	for i, v := range values {
+		if i > 0 && v == values[i-1] {
+			continue
+		}
+		key := v >> 16
+		if len(rb.keys) == 0 || rb.keys[len(rb.keys)-1] != key {
+			rb.keys = append(rb.keys, key)
+			rb.containers = append(rb.containers, nil)
+		}
+		last := len(rb.containers) - 1
+		rb.containers[last] = append(rb.containers[last], uint16(v))
+	}
+	return rb
+}
+
+// values returns the values in ascending order.
+func (rb *roaringBitmap) values() []uint64 {
+	result := make([]uint64, 0)
+	for i, key := range rb.keys {
+		for _, low := range rb.containers[i] {
+			result = append(result, key<<16|uint64(low))
+		}
+	}
+	return result
+}
+
+func (rb *roaringBitmap) marshal(buf *bytes.Buffer) {
+	writeUvarint(buf, uint64(len(rb.keys)))
+	var prevKey uint64
+	for i, key := range rb.keys {
+		writeUvarint(buf, key-prevKey)
+		prevKey = key
+		container := rb.containers[i]
+		writeUvarint(buf, uint64(len(container)))
+		if len(container) <= roaringArrayMaxSize {
+			buf.WriteByte(roaringContainerArray)
+			for _, low := range container {
+				binary.Write(buf, common.Endian, low)
+			}
+			continue
+		}
+		buf.WriteByte(roaringContainerBits)
+		words := make([]uint64, roaringBitmapWords)
+		for _, low := range container {
+			words[low/64] |= 1 << (low % 64)
+		}
+		binary.Write(buf, common.Endian, words)
+	}
+}
+
+func unmarshalRoaringBitmap(r *bytes.Reader) (*roaringBitmap, error) {
+	num, err := binary.ReadUvarint(r)
+	if err != nil {
+		return nil, err
+	}
+	rb := &roaringBitmap{}
+	var key uint64
+	for i := uint64(0); i < num; i++ {
+		delta, err := binary.ReadUvarint(r)
+		if err != nil {
+			return nil, err
+		}
+		key += delta
+		cardinality, err := binary.ReadUvarint(r)
+		if err != nil {
+			return nil, err
+		}
+		if cardinality > 1<<16 {
+			return nil, fmt.Errorf("invalid cardinality %d of roaring container", cardinality)
+		}
+		typ, err := r.ReadByte()
+		if err != nil {
+			return nil, err
+		}
+
+		container := make([]uint16, cardinality)
+		switch typ {
+		case roaringContainerArray:
+			if err := binary.Read(r, common.Endian, container); err != nil {
+				return nil, err
+			}
+		case roaringContainerBits:
+			words := make([]uint64, roaringBitmapWords)
+			if err := binary.Read(r, common.Endian, words); err != nil {
+				return nil, err
+			}
+			container = container[:0]
+			for j, word := range words {
+				for word != 0 {
+					container = append(container, uint16(j*64+bits.TrailingZeros64(word)))
+					word &= word - 1
+				}
+			}
+			if uint64(len(container)) != cardinality {
+				return nil, fmt.Errorf("cardinality of roaring container mismatch, expect %d, actual %d", cardinality, len(container))
+			}
+		default:
+			return nil, fmt.Errorf("unknown roaring container type %d", typ)
+		}
+		rb.keys = append(rb.keys, key)
+		rb.containers = append(rb.containers, container)
+	}
+	return rb, nil
+}
+
+// serializeRoaringDeltalog encodes the delete data in the roaring format:
+//
+//	version | pk type | [varchar dictionary] | ts groups: (ts delta | roaring bitmap of pks)*
+//
+// the duplicate pk and ts pairs are merged since they make no difference for deletion.
+func serializeRoaringDeltalog(data *DeleteData) ([]byte, error) {
+	buf := &bytes.Buffer{}
+	buf.WriteByte(roaringDeltalogVersion)
+	if len(data.Pks) == 0 {
+		buf.WriteByte(byte(schemapb.DataType_None))
+		return buf.Bytes(), nil
+	}
+
+	pkType := data.Pks[0].Type()
+	buf.WriteByte(byte(pkType))
+	groups := make(map[Timestamp][]uint64)
+	switch pkType {
+	case schemapb.DataType_Int64:
+		for i, pk := range data.Pks {
+			int64PK, ok := pk.(*Int64PrimaryKey)
+			if !ok {
+				return nil, errors.New("mixed primary key types in delete data")
+			}
+			groups[data.Tss[i]] = append(groups[data.Tss[i]], uint64(int64PK.Value))
+		}
+	case schemapb.DataType_VarChar:
+		dict := make(map[string]uint64)
+		for _, pk := range data.Pks {
+			varCharPK, ok := pk.(*VarCharPrimaryKey)
+			if !ok {
+				return nil, errors.New("mixed primary key types in delete data")
+			}
+			dict[varCharPK.Value] = 0
+		}
+		words := make([]string, 0, len(dict))
+		for word := range dict {
+			words = append(words, word)
+		}
+		sort.Strings(words)
+		writeUvarint(buf, uint64(len(words)))
+		for i, word := range words {
+			dict[word] = uint64(i)
+			writeUvarint(buf, uint64(len(word)))
+			buf.WriteString(word)
+		}
+		for i, pk := range data.Pks {
+			groups[data.Tss[i]] = append(groups[data.Tss[i]], dict[pk.(*VarCharPrimaryKey).Value])
+		}
+	default:
+		return nil, fmt.Errorf("unsupported primary key type %s", pkType.String())
+	}
+
+	tss := make([]Timestamp, 0, len(groups))
+	for ts := range groups {
+		tss = append(tss, ts)
+	}
+	sort.Slice(tss, func(i, j int) bool { return tss[i] < tss[j] })
+	writeUvarint(buf, uint64(len(tss)))
+	var prevTs Timestamp
+	for _, ts := range tss {
+		writeUvarint(buf, ts-prevTs)
+		prevTs = ts
+		newRoaringBitmap(groups[ts]).marshal(buf)
+	}
+	return buf.Bytes(), nil
+}
+
+// deserializeRoaringDeltalog decodes the delete data in the roaring format,
+// the deletes are ordered by ts, and then by pk.
+func deserializeRoaringDeltalog(value []byte, result *DeleteData) error {
+	r := bytes.NewReader(value)
+	version, err := r.ReadByte()
+	if err != nil {
+		return err
+	}
+	if version != roaringDeltalogVersion {
+		return fmt.Errorf("unsupported roaring delta log version %d", version)
+	}
+	typ, err := r.ReadByte()
+	if err != nil {
+		return err
+	}
+	pkType := schemapb.DataType(typ)
+
+	var words []string
+	switch pkType {
+	case schemapb.DataType_None:
+		return nil
+	case schemapb.DataType_Int64:
+	case schemapb.DataType_VarChar:
+		num, err := binary.ReadUvarint(r)
+		if err != nil {
+			return err
+		}
+		if num > uint64(r.Len()) {
+			return fmt.Errorf("invalid dictionary size %d of roaring delta log", num)
+		}
+		words = make([]string, 0, num)
+		for i := uint64(0); i < num; i++ {
+			length, err := binary.ReadUvarint(r)
+			if err != nil {
+				return err
+			}
+			if length > uint64(r.Len()) {
+				return io.ErrUnexpectedEOF
+			}
+			word := make([]byte, length)
+			if _, err := io.ReadFull(r, word); err != nil {
+				return err
+			}
+			words = append(words, string(word))
+		}
+	default:
+		return fmt.Errorf("unsupported primary key type %d of roaring delta log", typ)
+	}
+
+	num, err := binary.ReadUvarint(r)
+	if err != nil {
+		return err
+	}
+	var ts Timestamp
+	for i := uint64(0); i < num; i++ {
+		delta, err := binary.ReadUvarint(r)
+		if err != nil {
+			return err
+		}
+		ts += delta
+		rb, err := unmarshalRoaringBitmap(r)
+		if err != nil {
+			return err
+		}
+		for _, v := range rb.values() {
+			if pkType == schemapb.DataType_Int64 {
+				result.Append(NewInt64PrimaryKey(int64(v)), ts)
+				continue
+			}
+			if v >= uint64(len(words)) {
+				return fmt.Errorf("dictionary offset %d out of range %d", v, len(words))
+			}
+			result.Append(NewVarCharPrimaryKey(words[v]), ts)
+		}
+	}
+	return nil
+}
+
+func writeUvarint(buf *bytes.Buffer, v uint64) {
+	var b [binary.MaxVarintLen64]byte
+	n := binary.PutUvarint(b[:], v)
+	buf.Write(b[:n])
+}
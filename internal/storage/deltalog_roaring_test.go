@@ -0,0 +1,169 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package storage
+
+import (
+	"bytes"
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRoaringBitmap(t *testing.T) {
+	values := []uint64{1 << 40, 3, 1, 3, 70000}
+	// a dense container stored as bitmap
+	for i := uint64(0); i < 5000; i++ {
+		values = append(values, 1<<20+i*2)
+	}
+	rb := newRoaringBitmap(values)
+	assert.Len(t, rb.keys, 4)
+
+	buf := &bytes.Buffer{}
+	rb.marshal(buf)
+	decoded, err := unmarshalRoaringBitmap(bytes.NewReader(buf.Bytes()))
+	require.NoError(t, err)
+	result := decoded.values()
+	assert.Len(t, result, 5004)
+	assert.Equal(t, []uint64{1, 3, 70000}, result[:3])
+	assert.Equal(t, uint64(1<<20+4999*2), result[5002])
+	assert.Equal(t, uint64(1<<40), result[5003])
+
+	// truncated
+	_, err = unmarshalRoaringBitmap(bytes.NewReader(buf.Bytes()[:buf.Len()-1]))
+	assert.Error(t, err)
+}
+
+func TestRoaringDeltalog(t *testing.T) {
+	t.Run("int64 pk", func(t *testing.T) {
+		data := &DeleteData{}
+		data.Append(NewInt64PrimaryKey(3), 200)
+		data.Append(NewInt64PrimaryKey(-1), 100)
+		data.Append(NewInt64PrimaryKey(1), 100)
+		data.Append(NewInt64PrimaryKey(1), 100)
+
+		value, err := serializeRoaringDeltalog(data)
+		require.NoError(t, err)
+		result := &DeleteData{}
+		require.NoError(t, deserializeRoaringDeltalog(value, result))
+		assert.Equal(t, []PrimaryKey{NewInt64PrimaryKey(1), NewInt64PrimaryKey(-1), NewInt64PrimaryKey(3)}, result.Pks)
+		assert.Equal(t, []Timestamp{100, 100, 200}, result.Tss)
+		assert.EqualValues(t, 3, result.RowCount)
+	})
+
+	t.Run("varchar pk", func(t *testing.T) {
+		data := &DeleteData{}
+		data.Append(NewVarCharPrimaryKey("b"), 100)
+		data.Append(NewVarCharPrimaryKey("a"), 200)
+		data.Append(NewVarCharPrimaryKey("b"), 200)
+
+		value, err := serializeRoaringDeltalog(data)
+		require.NoError(t, err)
+		result := &DeleteData{}
+		require.NoError(t, deserializeRoaringDeltalog(value, result))
+		assert.Equal(t, []PrimaryKey{NewVarCharPrimaryKey("b"), NewVarCharPrimaryKey("a"), NewVarCharPrimaryKey("b")}, result.Pks)
+		assert.Equal(t, []Timestamp{100, 200, 200}, result.Tss)
+	})
+
+	t.Run("empty", func(t *testing.T) {
+		value, err := serializeRoaringDeltalog(&DeleteData{})
+		require.NoError(t, err)
+		result := &DeleteData{}
+		require.NoError(t, deserializeRoaringDeltalog(value, result))
+		assert.Empty(t, result.Pks)
+	})
+
+	t.Run("mixed pk types", func(t *testing.T) {
+		data := &DeleteData{}
+		data.Append(NewInt64PrimaryKey(1), 100)
+		data.Append(NewVarCharPrimaryKey("a"), 100)
+		_, err := serializeRoaringDeltalog(data)
+		assert.Error(t, err)
+	})
+
+	t.Run("corrupted", func(t *testing.T) {
+		data := &DeleteData{}
+		data.Append(NewVarCharPrimaryKey("a"), 100)
+		value, err := serializeRoaringDeltalog(data)
+		require.NoError(t, err)
+		for i := 0; i < len(value); i++ {
+			assert.Error(t, deserializeRoaringDeltalog(value[:i], &DeleteData{}))
+		}
+		value[0] = 2
+		assert.Error(t, deserializeRoaringDeltalog(value, &DeleteData{}))
+	})
+}
+
+func TestDeleteCodec_Roaring(t *testing.T) {
+	jsonCodec := NewDeleteCodec()
+	roaringCodec := NewDeleteCodec(WithDeltalogFormat(DeltalogFormatRoaring))
+
+	data := &DeleteData{}
+	for i := 0; i < 10000; i++ {
+		data.Append(NewInt64PrimaryKey(int64(i)), uint64(100+i/1000))
+	}
+	jsonBlob, err := jsonCodec.Serialize(CollectionID, PartitionID, SegmentID, data)
+	require.NoError(t, err)
+	roaringBlob, err := roaringCodec.Serialize(CollectionID, PartitionID, SegmentID, data)
+	require.NoError(t, err)
+	assert.Less(t, len(roaringBlob.GetValue()), len(jsonBlob.GetValue()))
+
+	// both formats are readable by any codec, and could be mixed
+	for _, codec := range []*DeleteCodec{jsonCodec, roaringCodec} {
+		pid, sid, result, err := codec.Deserialize([]*Blob{roaringBlob, jsonBlob})
+		require.NoError(t, err)
+		assert.EqualValues(t, PartitionID, pid)
+		assert.EqualValues(t, SegmentID, sid)
+		assert.EqualValues(t, 20000, result.RowCount)
+		assert.Equal(t, data.Pks, result.Pks[:10000])
+		assert.Equal(t, data.Tss, result.Tss[:10000])
+	}
+
+	reader, err := NewBinlogReader(roaringBlob.GetValue())
+	require.NoError(t, err)
+	assert.True(t, reader.IsRoaringDeltalog())
+	reader.Close()
+	reader, err = NewBinlogReader(jsonBlob.GetValue())
+	require.NoError(t, err)
+	assert.False(t, reader.IsRoaringDeltalog())
+	reader.Close()
+
+	_, err = NewDeleteCodec(WithDeltalogFormat("unknown")).Serialize(CollectionID, PartitionID, SegmentID, data)
+	assert.Error(t, err)
+}
+
+func BenchmarkDeleteCodec_Deserialize(b *testing.B) {
+	data := &DeleteData{}
+	for i := 0; i < 100000; i++ {
+		data.Append(NewInt64PrimaryKey(int64(i)), uint64(100+i/1000))
+	}
+	for _, format := range []string{DeltalogFormatJSON, DeltalogFormatRoaring} {
+		codec := NewDeleteCodec(WithDeltalogFormat(format))
+		blob, err := codec.Serialize(CollectionID, PartitionID, SegmentID, data)
+		if err != nil {
+			b.Fatal(err)
+		}
+		b.Run(fmt.Sprintf("%s-%d", format, len(blob.GetValue())), func(b *testing.B) {
+			for i := 0; i < b.N; i++ {
+				if _, _, _, err := codec.Deserialize([]*Blob{blob}); err != nil {
+					b.Fatal(err)
+				}
+			}
+		})
+	}
+}
@@ -2,6 +2,7 @@ package storage
 
 import (
 	"context"
+	"time"
 
 	"github.com/cockroachdb/errors"
 
@@ -15,7 +16,11 @@ type ChunkManagerFactory struct {
 
 func NewChunkManagerFactoryWithParam(params *paramtable.ComponentParam) *ChunkManagerFactory {
 	if params.CommonCfg.StorageType.GetValue() == "local" {
-		return NewChunkManagerFactory("local", RootPath(params.LocalStorageCfg.Path.GetValue()))
+		return NewChunkManagerFactory("local",
+			RootPath(params.LocalStorageCfg.Path.GetValue()),
+			LocalFsyncPolicy(params.LocalStorageCfg.FsyncPolicy.GetValue()),
+			LocalDirShardNum(params.LocalStorageCfg.DirShardNum.GetAsInt()),
+			LocalMinFreeSpaceRatio(params.LocalStorageCfg.MinFreeSpaceRatio.GetAsFloat()))
 	}
 	return NewChunkManagerFactory(params.CommonCfg.StorageType.GetValue(),
 		RootPath(params.MinioCfg.RootPath.GetValue()),
@@ -30,6 +35,11 @@ func NewChunkManagerFactoryWithParam(params *paramtable.ComponentParam) *ChunkMa
 		UseVirtualHost(params.MinioCfg.UseVirtualHost.GetAsBool()),
 		Region(params.MinioCfg.Region.GetValue()),
 		RequestTimeout(params.MinioCfg.RequestTimeoutMs.GetAsInt64()),
+		CircuitBreaker(params.MinioCfg.CircuitBreakerThreshold.GetAsInt(),
+			params.MinioCfg.CircuitBreakerOpenDuration.GetAsDuration(time.Second)),
+		GcsCredentialFile(params.MinioCfg.GcsCredentialFile.GetValue()),
+		GcsKmsKeyName(params.MinioCfg.GcsKmsKeyName.GetValue()),
+		GcsUploadChunkSize(params.MinioCfg.GcsUploadChunkSize.GetAsInt64()*1024*1024),
 		CreateBucket(true))
 }
 
@@ -47,11 +57,16 @@ func NewChunkManagerFactory(persistentStorage string, opts ...Option) *ChunkMana
 func (f *ChunkManagerFactory) newChunkManager(ctx context.Context, engine string) (ChunkManager, error) {
 	switch engine {
 	case "local":
-		return NewLocalChunkManager(RootPath(f.config.rootPath)), nil
+		return NewLocalChunkManager(RootPath(f.config.rootPath),
+			LocalFsyncPolicy(f.config.localFsyncPolicy),
+			LocalDirShardNum(f.config.localDirShardNum),
+			LocalMinFreeSpaceRatio(f.config.localMinFreeSpaceRatio)), nil
 	case "minio":
 		return newMinioChunkManagerWithConfig(ctx, f.config)
 	case "remote":
 		return NewRemoteChunkManager(ctx, f.config)
+	case "gcs":
+		return newGcsChunkManager(ctx, f.config)
 	default:
 		return nil, errors.New("no chunk manager implemented with engine: " + engine)
 	}
@@ -0,0 +1,530 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package storage
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"os"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/cockroachdb/errors"
+	"go.uber.org/zap"
+	"golang.org/x/oauth2"
+	"golang.org/x/oauth2/google"
+
+	"github.com/milvus-io/milvus/internal/storage/gcp"
+	"github.com/milvus-io/milvus/pkg/log"
+	"github.com/milvus-io/milvus/pkg/util/retry"
+)
+
+const (
+	gcsScopeReadWrite = "https://www.googleapis.com/auth/devstorage.read_write"
+
+	// the chunk size of resumable upload must be a multiple of 256 KiB
+	gcsChunkSizeUnit          = 256 * 1024
+	defaultGcsUploadChunk     = 16 * 1024 * 1024
+	gcsUploadChunkRetries     = 5
+	gcsStatusResumeIncomplete = 308
+)
+
+// gcsError is the error responded by the GCS JSON API.
+type gcsError struct {
+	StatusCode int
+	Message    string
+}
+
+func (e *gcsError) Error() string {
+	return fmt.Sprintf("gcs error, status code: %d, message: %s", e.StatusCode, e.Message)
+}
+
+func isGcsNotFound(err error) bool {
+	gcsErr := &gcsError{}
+	return errors.As(err, &gcsErr) && gcsErr.StatusCode == http.StatusNotFound
+}
+
+// isGcsRetryable returns true if the request could be retried, the rate limited, server errors and network errors.
+func isGcsRetryable(err error) bool {
+	gcsErr := &gcsError{}
+	if errors.As(err, &gcsErr) {
+		return gcsErr.StatusCode == http.StatusTooManyRequests || gcsErr.StatusCode >= http.StatusInternalServerError
+	}
+	return !errors.Is(err, context.Canceled) && !errors.Is(err, context.DeadlineExceeded)
+}
+
+// GcsObjectStorage accesses Google Cloud Storage by the native JSON API instead of the S3 compatible API,
+// it supports the workload identity, customer-managed encryption keys and resumable uploads.
+type GcsObjectStorage struct {
+	client     *http.Client
+	endpoint   string
+	projectID  string
+	kmsKeyName string
+	chunkSize  int64
+}
+
+// newGcsHTTPClient returns the http client authorized by the credentials file, which might be a service account key
+// or an external account config of the workload identity federation. The application default credentials are used
+// if IAM enabled, including the workload identity of GKE.
+func newGcsHTTPClient(ctx context.Context, c *config) (*http.Client, string, error) {
+	var creds *google.Credentials
+	var err error
+	switch {
+	case c.gcsCredentialFile != "":
+		data, readErr := os.ReadFile(c.gcsCredentialFile)
+		if readErr != nil {
+			return nil, "", errors.Wrap(readErr, "failed to read gcs credential file")
+		}
+		creds, err = google.CredentialsFromJSON(ctx, data, gcsScopeReadWrite)
+	case c.useIAM:
+		creds, err = google.FindDefaultCredentials(ctx, gcsScopeReadWrite)
+	default:
+		// anonymous access, for the emulators
+		return &http.Client{}, "", nil
+	}
+	if err != nil {
+		return nil, "", errors.Wrap(err, "failed to find gcs credentials")
+	}
+	client := &http.Client{
+		Transport: &oauth2.Transport{
+			Source: creds.TokenSource,
+			Base:   http.DefaultTransport,
+		},
+	}
+	return client, creds.ProjectID, nil
+}
+
+func getGcsEndpoint(c *config) string {
+	if c.address == "" || strings.Contains(c.address, gcp.GcsDefaultAddress) {
+		return "https://" + gcp.GcsDefaultAddress
+	}
+	if c.useSSL {
+		return "https://" + c.address
+	}
+	return "http://" + c.address
+}
+
+func newGcsObjectStorageWithConfig(ctx context.Context, c *config) (*GcsObjectStorage, error) {
+	if c.bucketName == "" {
+		return nil, fmt.Errorf("invalid bucket name")
+	}
+	client, projectID, err := newGcsHTTPClient(ctx, c)
+	if err != nil {
+		return nil, err
+	}
+	chunkSize := c.gcsUploadChunkSize
+	if chunkSize <= 0 {
+		chunkSize = defaultGcsUploadChunk
+	}
+	// round up to the multiple of 256 KiB
+	chunkSize = (chunkSize + gcsChunkSizeUnit - 1) / gcsChunkSizeUnit * gcsChunkSizeUnit
+	objectStorage := &GcsObjectStorage{
+		client:     client,
+		endpoint:   getGcsEndpoint(c),
+		projectID:  projectID,
+		kmsKeyName: c.gcsKmsKeyName,
+		chunkSize:  chunkSize,
+	}
+
+	// check valid in first query
+	checkBucketFn := func() error {
+		err := objectStorage.getBucket(ctx, c.bucketName)
+		if isGcsNotFound(err) && c.createBucket {
+			log.Info("gcs bucket not exist, create bucket.", zap.String("bucket", c.bucketName))
+			return objectStorage.createBucket(ctx, c.bucketName)
+		}
+		if err != nil {
+			log.Warn("failed to check gcs bucket exist", zap.String("bucket", c.bucketName), zap.Error(err))
+		}
+		return err
+	}
+	err = retry.Do(ctx, checkBucketFn, retry.Attempts(CheckBucketRetryAttempts))
+	if err != nil {
+		return nil, err
+	}
+	return objectStorage, nil
+}
+
+// newGcsChunkManager returns the RemoteChunkManager accessing GCS by the native client.
+func newGcsChunkManager(ctx context.Context, c *config) (*RemoteChunkManager, error) {
+	client, err := newGcsObjectStorageWithConfig(ctx, c)
+	if err != nil {
+		return nil, err
+	}
+	mcm := &RemoteChunkManager{
+		client:     client,
+		bucketName: c.bucketName,
+		rootPath:   strings.TrimLeft(c.rootPath, "/"),
+	}
+	log.Info("gcs chunk manager init success.", zap.String("bucketname", c.bucketName), zap.String("root", mcm.RootPath()),
+		zap.Bool("cmek", c.gcsKmsKeyName != ""))
+	return mcm, nil
+}
+
+func (s *GcsObjectStorage) objectURL(bucketName, objectName string) string {
+	return s.endpoint + "/storage/v1/b/" + url.PathEscape(bucketName) + "/o/" + url.PathEscape(objectName)
+}
+
+func (s *GcsObjectStorage) uploadURL(bucketName, objectName, uploadType string) string {
+	query := url.Values{}
+	query.Set("uploadType", uploadType)
+	query.Set("name", objectName)
+	if s.kmsKeyName != "" {
+		query.Set("kmsKeyName", s.kmsKeyName)
+	}
+	return s.endpoint + "/upload/storage/v1/b/" + url.PathEscape(bucketName) + "/o?" + query.Encode()
+}
+
+// do sends the request, the response body shall be closed by caller if no error.
+func (s *GcsObjectStorage) do(req *http.Request, expectedCodes ...int) (*http.Response, error) {
+	resp, err := s.client.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	for _, code := range expectedCodes {
+		if resp.StatusCode == code {
+			return resp, nil
+		}
+	}
+	defer resp.Body.Close()
+	return nil, parseGcsError(resp)
+}
+
+func parseGcsError(resp *http.Response) error {
+	body, _ := io.ReadAll(io.LimitReader(resp.Body, 64*1024))
+	errResp := struct {
+		Error struct {
+			Message string `json:"message"`
+		} `json:"error"`
+	}{}
+	message := string(body)
+	if err := json.Unmarshal(body, &errResp); err == nil && errResp.Error.Message != "" {
+		message = errResp.Error.Message
+	}
+	return &gcsError{StatusCode: resp.StatusCode, Message: message}
+}
+
+func (s *GcsObjectStorage) getBucket(ctx context.Context, bucketName string) error {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, s.endpoint+"/storage/v1/b/"+url.PathEscape(bucketName), nil)
+	if err != nil {
+		return err
+	}
+	resp, err := s.do(req, http.StatusOK)
+	if err != nil {
+		return err
+	}
+	return resp.Body.Close()
+}
+
+func (s *GcsObjectStorage) createBucket(ctx context.Context, bucketName string) error {
+	if s.projectID == "" {
+		return fmt.Errorf("failed to create gcs bucket %s, project id not found in credentials", bucketName)
+	}
+	bucket := map[string]interface{}{"name": bucketName}
+	if s.kmsKeyName != "" {
+		bucket["encryption"] = map[string]string{"defaultKmsKeyName": s.kmsKeyName}
+	}
+	body, err := json.Marshal(bucket)
+	if err != nil {
+		return err
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost,
+		s.endpoint+"/storage/v1/b?project="+url.QueryEscape(s.projectID), bytes.NewReader(body))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	resp, err := s.do(req, http.StatusOK)
+	if err != nil {
+		return err
+	}
+	return resp.Body.Close()
+}
+
+func (s *GcsObjectStorage) GetObject(ctx context.Context, bucketName, objectName string, offset int64, size int64) (FileReader, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, s.objectURL(bucketName, objectName)+"?alt=media", nil)
+	if err != nil {
+		return nil, err
+	}
+	if offset > 0 {
+		req.Header.Set("Range", fmt.Sprintf("bytes=%d-%d", offset, offset+size-1))
+	}
+	resp, err := s.do(req, http.StatusOK, http.StatusPartialContent)
+	if err != nil {
+		if isGcsNotFound(err) {
+			return nil, WrapErrNoSuchKey(objectName)
+		}
+		return nil, err
+	}
+	return resp.Body, nil
+}
+
+// PutObject uploads the object in a single request if it's small, otherwise uploads it in chunks by the resumable
+// upload, the failed chunk is retried from the offset persisted instead of restarting the whole upload.
+func (s *GcsObjectStorage) PutObject(ctx context.Context, bucketName, objectName string, reader io.Reader, objectSize int64) error {
+	if objectSize >= 0 && objectSize <= s.chunkSize {
+		req, err := http.NewRequestWithContext(ctx, http.MethodPost, s.uploadURL(bucketName, objectName, "media"), reader)
+		if err != nil {
+			return err
+		}
+		req.ContentLength = objectSize
+		req.Header.Set("Content-Type", "application/octet-stream")
+		resp, err := s.do(req, http.StatusOK)
+		if err != nil {
+			return err
+		}
+		return resp.Body.Close()
+	}
+	return s.resumableUpload(ctx, bucketName, objectName, reader, objectSize)
+}
+
+func (s *GcsObjectStorage) resumableUpload(ctx context.Context, bucketName, objectName string, reader io.Reader, objectSize int64) error {
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, s.uploadURL(bucketName, objectName, "resumable"), nil)
+	if err != nil {
+		return err
+	}
+	if objectSize >= 0 {
+		req.Header.Set("X-Upload-Content-Length", strconv.FormatInt(objectSize, 10))
+	}
+	req.Header.Set("X-Upload-Content-Type", "application/octet-stream")
+	resp, err := s.do(req, http.StatusOK)
+	if err != nil {
+		return err
+	}
+	resp.Body.Close()
+	sessionURI := resp.Header.Get("Location")
+	if sessionURI == "" {
+		return errors.New("gcs resumable upload session uri not found")
+	}
+
+	buf := make([]byte, s.chunkSize)
+	var offset int64
+	for {
+		n, readErr := io.ReadFull(reader, buf)
+		if readErr != nil && readErr != io.EOF && readErr != io.ErrUnexpectedEOF {
+			return readErr
+		}
+		last := readErr != nil || int64(n) < s.chunkSize || (objectSize >= 0 && offset+int64(n) >= objectSize)
+		done, err := s.uploadChunk(ctx, sessionURI, buf[:n], offset, last)
+		if err != nil {
+			log.Warn("failed to upload chunk to gcs", zap.String("bucket", bucketName), zap.String("path", objectName),
+				zap.Int64("offset", offset), zap.Error(err))
+			return err
+		}
+		offset += int64(n)
+		if done {
+			return nil
+		}
+		if last {
+			return errors.New("gcs resumable upload not finalized after the last chunk")
+		}
+	}
+}
+
+// uploadChunk uploads the chunk starting at offset of the object, resumes from the persisted offset on failures,
+// returns true if the upload is finalized.
+func (s *GcsObjectStorage) uploadChunk(ctx context.Context, sessionURI string, chunk []byte, offset int64, last bool) (bool, error) {
+	total := "*"
+	if last {
+		total = strconv.FormatInt(offset+int64(len(chunk)), 10)
+	}
+	sent := int64(0)
+	var err error
+	for i := 0; i < gcsUploadChunkRetries; i++ {
+		var done bool
+		var persisted int64
+		done, persisted, err = s.putChunk(ctx, sessionURI, chunk[sent:], offset+sent, total)
+		if err == nil {
+			if done {
+				return true, nil
+			}
+			sent = persisted - offset
+			if sent >= int64(len(chunk)) {
+				return false, nil
+			}
+			if sent < 0 {
+				sent = 0
+			}
+			// partially persisted, send the rest
+			continue
+		}
+		if !isGcsRetryable(err) {
+			return false, err
+		}
+		// query the offset persisted before resuming
+		persisted, err = s.queryUploadOffset(ctx, sessionURI, total)
+		if err != nil {
+			if !isGcsRetryable(err) {
+				return false, err
+			}
+			continue
+		}
+		sent = persisted - offset
+		if sent < 0 {
+			sent = 0
+		}
+	}
+	if err == nil {
+		err = fmt.Errorf("gcs chunk at offset %d not persisted after %d attempts", offset, gcsUploadChunkRetries)
+	}
+	return false, err
+}
+
+// putChunk puts the data at offset, returns the offset persisted if the upload is incomplete.
+func (s *GcsObjectStorage) putChunk(ctx context.Context, sessionURI string, data []byte, offset int64, total string) (bool, int64, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodPut, sessionURI, bytes.NewReader(data))
+	if err != nil {
+		return false, 0, err
+	}
+	req.ContentLength = int64(len(data))
+	if len(data) == 0 {
+		req.Header.Set("Content-Range", "bytes */"+total)
+	} else {
+		req.Header.Set("Content-Range", fmt.Sprintf("bytes %d-%d/%s", offset, offset+int64(len(data))-1, total))
+	}
+	resp, err := s.do(req, http.StatusOK, http.StatusCreated, gcsStatusResumeIncomplete)
+	if err != nil {
+		return false, 0, err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != gcsStatusResumeIncomplete {
+		return true, 0, nil
+	}
+	return false, parsePersistedOffset(resp), nil
+}
+
+func (s *GcsObjectStorage) queryUploadOffset(ctx context.Context, sessionURI string, total string) (int64, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodPut, sessionURI, nil)
+	if err != nil {
+		return 0, err
+	}
+	req.Header.Set("Content-Range", "bytes */"+total)
+	resp, err := s.do(req, gcsStatusResumeIncomplete)
+	if err != nil {
+		return 0, err
+	}
+	defer resp.Body.Close()
+	return parsePersistedOffset(resp), nil
+}
+
+// parsePersistedOffset parses the range header like "bytes=0-1023" of the incomplete upload,
+// returns the offset of next byte to send.
+func parsePersistedOffset(resp *http.Response) int64 {
+	rng := resp.Header.Get("Range")
+	i := strings.LastIndex(rng, "-")
+	if i < 0 {
+		return 0
+	}
+	end, err := strconv.ParseInt(rng[i+1:], 10, 64)
+	if err != nil {
+		return 0
+	}
+	return end + 1
+}
+
+func (s *GcsObjectStorage) StatObject(ctx context.Context, bucketName, objectName string) (int64, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, s.objectURL(bucketName, objectName)+"?fields=size", nil)
+	if err != nil {
+		return 0, err
+	}
+	resp, err := s.do(req, http.StatusOK)
+	if err != nil {
+		if isGcsNotFound(err) {
+			return 0, WrapErrNoSuchKey(objectName)
+		}
+		return 0, err
+	}
+	defer resp.Body.Close()
+	object := struct {
+		Size string `json:"size"`
+	}{}
+	if err := json.NewDecoder(resp.Body).Decode(&object); err != nil {
+		return 0, err
+	}
+	return strconv.ParseInt(object.Size, 10, 64)
+}
+
+func (s *GcsObjectStorage) ListObjects(ctx context.Context, bucketName string, prefix string, recursive bool) (map[string]time.Time, error) {
+	objects := map[string]time.Time{}
+	pageToken := ""
+	for {
+		query := url.Values{}
+		query.Set("prefix", prefix)
+		query.Set("fields", "items(name,updated),prefixes,nextPageToken")
+		if !recursive {
+			query.Set("delimiter", "/")
+		}
+		if pageToken != "" {
+			query.Set("pageToken", pageToken)
+		}
+		req, err := http.NewRequestWithContext(ctx, http.MethodGet,
+			s.endpoint+"/storage/v1/b/"+url.PathEscape(bucketName)+"/o?"+query.Encode(), nil)
+		if err != nil {
+			return nil, err
+		}
+		resp, err := s.do(req, http.StatusOK)
+		if err != nil {
+			return nil, err
+		}
+		page := struct {
+			Items []struct {
+				Name    string    `json:"name"`
+				Updated time.Time `json:"updated"`
+			} `json:"items"`
+			Prefixes      []string `json:"prefixes"`
+			NextPageToken string   `json:"nextPageToken"`
+		}{}
+		err = json.NewDecoder(resp.Body).Decode(&page)
+		resp.Body.Close()
+		if err != nil {
+			return nil, err
+		}
+		for _, item := range page.Items {
+			objects[item.Name] = item.Updated
+		}
+		// the common prefixes are listed as the directories if not recursive
+		for _, p := range page.Prefixes {
+			objects[p] = time.Time{}
+		}
+		if page.NextPageToken == "" {
+			return objects, nil
+		}
+		pageToken = page.NextPageToken
+	}
+}
+
+func (s *GcsObjectStorage) RemoveObject(ctx context.Context, bucketName, objectName string) error {
+	req, err := http.NewRequestWithContext(ctx, http.MethodDelete, s.objectURL(bucketName, objectName), nil)
+	if err != nil {
+		return err
+	}
+	resp, err := s.do(req, http.StatusOK, http.StatusNoContent)
+	if err != nil {
+		// removing the object not existed is not an error, same as the S3 semantic
+		if isGcsNotFound(err) {
+			return nil
+		}
+		return err
+	}
+	return resp.Body.Close()
+}
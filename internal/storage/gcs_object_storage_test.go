@@ -0,0 +1,285 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package storage
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// fakeGcsServer serves the subset of GCS JSON API used by GcsObjectStorage.
+type fakeGcsServer struct {
+	*httptest.Server
+
+	mu       sync.Mutex
+	bucket   string
+	objects  map[string][]byte
+	kmsKeys  map[string]string
+	sessions map[string]*bytes.Buffer
+	// fail the chunk upload once at the offset
+	failAt int64
+	failed bool
+}
+
+func newFakeGcsServer(bucket string) *fakeGcsServer {
+	s := &fakeGcsServer{
+		bucket:   bucket,
+		objects:  make(map[string][]byte),
+		kmsKeys:  make(map[string]string),
+		sessions: make(map[string]*bytes.Buffer),
+		failAt:   -1,
+	}
+	s.Server = httptest.NewServer(http.HandlerFunc(s.handle))
+	return s
+}
+
+func (s *fakeGcsServer) handle(w http.ResponseWriter, r *http.Request) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	path := r.URL.Path
+	query := r.URL.Query()
+	switch {
+	case strings.HasPrefix(path, "/upload/session/"):
+		s.handleSession(w, r, strings.TrimPrefix(path, "/upload/session/"))
+	case strings.HasPrefix(path, "/upload/storage/v1/b/"+s.bucket+"/o"):
+		name := query.Get("name")
+		switch query.Get("uploadType") {
+		case "media":
+			data, _ := io.ReadAll(r.Body)
+			s.objects[name] = data
+			s.kmsKeys[name] = query.Get("kmsKeyName")
+			w.WriteHeader(http.StatusOK)
+		case "resumable":
+			s.sessions[name] = &bytes.Buffer{}
+			s.kmsKeys[name] = query.Get("kmsKeyName")
+			w.Header().Set("Location", s.URL+"/upload/session/"+name)
+			w.WriteHeader(http.StatusOK)
+		}
+	case path == "/storage/v1/b/"+s.bucket:
+		w.WriteHeader(http.StatusOK)
+	case path == "/storage/v1/b/"+s.bucket+"/o":
+		s.handleList(w, query.Get("prefix"), query.Get("delimiter"))
+	case strings.HasPrefix(path, "/storage/v1/b/"+s.bucket+"/o/"):
+		name := strings.TrimPrefix(path, "/storage/v1/b/"+s.bucket+"/o/")
+		data, ok := s.objects[name]
+		if !ok {
+			w.WriteHeader(http.StatusNotFound)
+			_, _ = w.Write([]byte(`{"error": {"code": 404, "message": "No such object"}}`))
+			return
+		}
+		switch {
+		case r.Method == http.MethodDelete:
+			delete(s.objects, name)
+			w.WriteHeader(http.StatusNoContent)
+		case query.Get("alt") == "media":
+			if rng := r.Header.Get("Range"); rng != "" {
+				var start, end int
+				_, _ = fmt.Sscanf(rng, "bytes=%d-%d", &start, &end)
+				w.WriteHeader(http.StatusPartialContent)
+				_, _ = w.Write(data[start : end+1])
+				return
+			}
+			_, _ = w.Write(data)
+		default:
+			_ = json.NewEncoder(w).Encode(map[string]string{"size": strconv.Itoa(len(data))})
+		}
+	default:
+		w.WriteHeader(http.StatusNotFound)
+	}
+}
+
+func (s *fakeGcsServer) getObject(name string) ([]byte, string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.objects[name], s.kmsKeys[name]
+}
+
+func (s *fakeGcsServer) handleSession(w http.ResponseWriter, r *http.Request, name string) {
+	buf := s.sessions[name]
+	// Content-Range: bytes 0-1023/* or bytes */2048
+	contentRange := strings.TrimPrefix(r.Header.Get("Content-Range"), "bytes ")
+	i := strings.Index(contentRange, "/")
+	rng, total := contentRange[:i], contentRange[i+1:]
+	data, _ := io.ReadAll(r.Body)
+	if rng != "*" {
+		var start int64
+		_, _ = fmt.Sscanf(rng, "%d-", &start)
+		if start == s.failAt && !s.failed {
+			// persist half of the chunk then fail
+			s.failed = true
+			buf.Write(data[:len(data)/2])
+			w.WriteHeader(http.StatusServiceUnavailable)
+			return
+		}
+		if start != int64(buf.Len()) {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+		buf.Write(data)
+	}
+	if total != "*" && strconv.Itoa(buf.Len()) == total {
+		s.objects[name] = buf.Bytes()
+		w.WriteHeader(http.StatusOK)
+		return
+	}
+	if buf.Len() > 0 {
+		w.Header().Set("Range", fmt.Sprintf("bytes=0-%d", buf.Len()-1))
+	}
+	w.WriteHeader(gcsStatusResumeIncomplete)
+}
+
+func (s *fakeGcsServer) handleList(w http.ResponseWriter, prefix string, delimiter string) {
+	type item struct {
+		Name    string    `json:"name"`
+		Updated time.Time `json:"updated"`
+	}
+	page := struct {
+		Items    []item   `json:"items"`
+		Prefixes []string `json:"prefixes"`
+	}{}
+	prefixes := make(map[string]struct{})
+	for name := range s.objects {
+		if !strings.HasPrefix(name, prefix) {
+			continue
+		}
+		if delimiter != "" {
+			if i := strings.Index(name[len(prefix):], delimiter); i >= 0 {
+				prefixes[name[:len(prefix)+i+1]] = struct{}{}
+				continue
+			}
+		}
+		page.Items = append(page.Items, item{Name: name, Updated: time.Now()})
+	}
+	for p := range prefixes {
+		page.Prefixes = append(page.Prefixes, p)
+	}
+	sort.Strings(page.Prefixes)
+	_ = json.NewEncoder(w).Encode(page)
+}
+
+func TestGcsObjectStorage(t *testing.T) {
+	ctx := context.Background()
+	server := newFakeGcsServer("test-bucket")
+	defer server.Close()
+
+	c := &config{
+		address:            strings.TrimPrefix(server.URL, "http://"),
+		bucketName:         "test-bucket",
+		gcsKmsKeyName:      "projects/p/locations/l/keyRings/r/cryptoKeys/k",
+		gcsUploadChunkSize: 1,
+	}
+
+	t.Run("invalid bucket", func(t *testing.T) {
+		_, err := newGcsObjectStorageWithConfig(ctx, &config{})
+		assert.Error(t, err)
+	})
+
+	objectStorage, err := newGcsObjectStorageWithConfig(ctx, c)
+	require.NoError(t, err)
+	// rounded up to 256 KiB
+	assert.EqualValues(t, gcsChunkSizeUnit, objectStorage.chunkSize)
+
+	t.Run("put and get", func(t *testing.T) {
+		err := objectStorage.PutObject(ctx, c.bucketName, "dir/small", bytes.NewReader([]byte("123")), 3)
+		require.NoError(t, err)
+		_, kmsKeyName := server.getObject("dir/small")
+		assert.Equal(t, c.gcsKmsKeyName, kmsKeyName)
+
+		size, err := objectStorage.StatObject(ctx, c.bucketName, "dir/small")
+		assert.NoError(t, err)
+		assert.EqualValues(t, 3, size)
+
+		reader, err := objectStorage.GetObject(ctx, c.bucketName, "dir/small", 0, 0)
+		require.NoError(t, err)
+		data, err := io.ReadAll(reader)
+		reader.Close()
+		assert.NoError(t, err)
+		assert.Equal(t, []byte("123"), data)
+
+		reader, err = objectStorage.GetObject(ctx, c.bucketName, "dir/small", 1, 2)
+		require.NoError(t, err)
+		data, err = io.ReadAll(reader)
+		reader.Close()
+		assert.NoError(t, err)
+		assert.Equal(t, []byte("23"), data)
+	})
+
+	t.Run("resumable upload", func(t *testing.T) {
+		content := make([]byte, gcsChunkSizeUnit*2+100)
+		for i := range content {
+			content[i] = byte(i)
+		}
+		// the second chunk fails after half persisted, and resumes from the persisted offset
+		server.mu.Lock()
+		server.failAt = gcsChunkSizeUnit
+		server.mu.Unlock()
+		err := objectStorage.PutObject(ctx, c.bucketName, "dir/large", bytes.NewReader(content), int64(len(content)))
+		require.NoError(t, err)
+		data, kmsKeyName := server.getObject("dir/large")
+		assert.Equal(t, content, data)
+		assert.Equal(t, c.gcsKmsKeyName, kmsKeyName)
+		server.mu.Lock()
+		assert.True(t, server.failed)
+		server.mu.Unlock()
+
+		// unknown size
+		err = objectStorage.PutObject(ctx, c.bucketName, "dir/unknown", bytes.NewReader(content[:gcsChunkSizeUnit*2]), -1)
+		require.NoError(t, err)
+		data, _ = server.getObject("dir/unknown")
+		assert.Equal(t, content[:gcsChunkSizeUnit*2], data)
+	})
+
+	t.Run("list", func(t *testing.T) {
+		objects, err := objectStorage.ListObjects(ctx, c.bucketName, "dir/", true)
+		assert.NoError(t, err)
+		assert.Len(t, objects, 3)
+
+		objects, err = objectStorage.ListObjects(ctx, c.bucketName, "", false)
+		assert.NoError(t, err)
+		assert.Len(t, objects, 1)
+		assert.Contains(t, objects, "dir/")
+	})
+
+	t.Run("not found", func(t *testing.T) {
+		_, err := objectStorage.GetObject(ctx, c.bucketName, "not_exist", 0, 0)
+		assert.True(t, IsErrNoSuchKey(err))
+		_, err = objectStorage.StatObject(ctx, c.bucketName, "not_exist")
+		assert.True(t, IsErrNoSuchKey(err))
+		assert.NoError(t, objectStorage.RemoveObject(ctx, c.bucketName, "not_exist"))
+	})
+
+	t.Run("remove", func(t *testing.T) {
+		assert.NoError(t, objectStorage.RemoveObject(ctx, c.bucketName, "dir/small"))
+		_, err := objectStorage.StatObject(ctx, c.bucketName, "dir/small")
+		assert.True(t, IsErrNoSuchKey(err))
+	})
+}
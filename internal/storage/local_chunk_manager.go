@@ -19,6 +19,7 @@ package storage
 import (
 	"context"
 	"fmt"
+	"hash/fnv"
 	"io"
 	"os"
 	"path"
@@ -31,12 +32,33 @@ import (
 	"golang.org/x/exp/mmap"
 
 	"github.com/milvus-io/milvus/pkg/log"
+	"github.com/milvus-io/milvus/pkg/util/hardware"
 	"github.com/milvus-io/milvus/pkg/util/merr"
 )
 
+const (
+	// LocalFsyncNone leaves flushing the written files to the OS.
+	LocalFsyncNone = "none"
+	// LocalFsyncFile syncs the written file before it's renamed to the target path.
+	LocalFsyncFile = "file"
+	// LocalFsyncFull syncs the parent directory after the rename too, so the new entry survives the power loss.
+	LocalFsyncFull = "full"
+
+	// the files are written into the temporary files named ".<name>.tmp-<random>" first
+	localTempFileInfix = ".tmp-"
+	// the sharded files are placed in the sub directories named "_shard_<n>"
+	localShardDirPrefix = "_shard_"
+)
+
 // LocalChunkManager is responsible for read and write local file.
+// The files are written atomically by renaming the temporary file, and could be spread into the sub directories
+// of each directory to avoid millions of files in a single directory, while the callers always see the original paths.
 type LocalChunkManager struct {
 	localPath string
+
+	fsyncPolicy       string
+	dirShardNum       int
+	minFreeSpaceRatio float64
 }
 
 var _ ChunkManager = (*LocalChunkManager)(nil)
@@ -47,8 +69,20 @@ func NewLocalChunkManager(opts ...Option) *LocalChunkManager {
 	for _, opt := range opts {
 		opt(c)
 	}
+	fsyncPolicy := c.localFsyncPolicy
+	switch fsyncPolicy {
+	case LocalFsyncNone, LocalFsyncFile, LocalFsyncFull:
+	case "":
+		fsyncPolicy = LocalFsyncNone
+	default:
+		log.Warn("unknown fsync policy of local chunk manager, use file instead", zap.String("policy", fsyncPolicy))
+		fsyncPolicy = LocalFsyncFile
+	}
 	return &LocalChunkManager{
-		localPath: c.rootPath,
+		localPath:         c.rootPath,
+		fsyncPolicy:       fsyncPolicy,
+		dirShardNum:       c.localDirShardNum,
+		minFreeSpaceRatio: c.localMinFreeSpaceRatio,
 	}
 }
 
@@ -68,7 +102,7 @@ func (lcm *LocalChunkManager) Path(ctx context.Context, filePath string) (string
 		return "", fmt.Errorf("local file cannot be found with filePath: %s", filePath)
 	}
 
-	return filePath, nil
+	return lcm.resolvePath(filePath), nil
 }
 
 func (lcm *LocalChunkManager) Reader(ctx context.Context, filePath string) (FileReader, error) {
@@ -80,23 +114,77 @@ func (lcm *LocalChunkManager) Reader(ctx context.Context, filePath string) (File
 		return nil, errors.New("local file cannot be found with filePath:" + filePath)
 	}
 
-	return os.Open(filePath)
+	return os.Open(lcm.resolvePath(filePath))
 }
 
 // Write writes the data to local storage.
 func (lcm *LocalChunkManager) Write(ctx context.Context, filePath string, content []byte) error {
+	filePath = lcm.shardedPath(filePath)
 	dir := path.Dir(filePath)
-	exist, err := lcm.Exist(ctx, dir)
+	if err := os.MkdirAll(dir, os.ModePerm); err != nil {
+		return err
+	}
+	if err := lcm.checkFreeSpace(dir, int64(len(content))); err != nil {
+		return err
+	}
+	return lcm.writeAtomic(dir, filePath, content)
+}
+
+// writeAtomic writes the content into a temporary file in the same directory then renames it to the file path,
+// so the readers never see a partially written file even if the process crashes.
+func (lcm *LocalChunkManager) writeAtomic(dir string, filePath string, content []byte) error {
+	tmp, err := os.CreateTemp(dir, "."+path.Base(filePath)+localTempFileInfix+"*")
 	if err != nil {
 		return err
 	}
-	if !exist {
-		err := os.MkdirAll(dir, os.ModePerm)
-		if err != nil {
+	tmpPath := tmp.Name()
+	err = func() error {
+		defer tmp.Close()
+		if _, err := tmp.Write(content); err != nil {
+			return err
+		}
+		if err := tmp.Chmod(0o644); err != nil {
 			return err
 		}
+		if lcm.fsyncPolicy != LocalFsyncNone {
+			return tmp.Sync()
+		}
+		return nil
+	}()
+	if err == nil {
+		err = os.Rename(tmpPath, filePath)
+	}
+	if err != nil {
+		_ = os.Remove(tmpPath)
+		return err
 	}
-	return os.WriteFile(filePath, content, os.ModePerm)
+
+	if lcm.fsyncPolicy == LocalFsyncFull {
+		return syncDir(dir)
+	}
+	return nil
+}
+
+// checkFreeSpace rejects the write if the free space ratio of the filesystem would fall below the watermark.
+func (lcm *LocalChunkManager) checkFreeSpace(dir string, size int64) error {
+	if lcm.minFreeSpaceRatio <= 0 {
+		return nil
+	}
+	free, total, err := hardware.GetDiskFreeSpace(dir)
+	if err != nil {
+		return err
+	}
+	if total == 0 {
+		return nil
+	}
+	remain := float64(free) - float64(size)
+	if remain/float64(total) < lcm.minFreeSpaceRatio {
+		used := float64(total) - remain
+		limit := float64(total) * (1 - lcm.minFreeSpaceRatio)
+		return merr.WrapErrServiceDiskLimitExceeded(float32(used), float32(limit),
+			fmt.Sprintf("free space of local storage is below the watermark %v", lcm.minFreeSpaceRatio))
+	}
+	return nil
 }
 
 // MultiWrite writes the data to local storage.
@@ -113,7 +201,7 @@ func (lcm *LocalChunkManager) MultiWrite(ctx context.Context, contents map[strin
 
 // Exist checks whether chunk is saved to local storage.
 func (lcm *LocalChunkManager) Exist(ctx context.Context, filePath string) (bool, error) {
-	_, err := os.Stat(filePath)
+	_, err := os.Stat(lcm.resolvePath(filePath))
 	if err != nil {
 		if os.IsNotExist(err) {
 			return false, nil
@@ -133,7 +221,7 @@ func (lcm *LocalChunkManager) Read(ctx context.Context, filePath string) ([]byte
 		return nil, fmt.Errorf("file not exist: %s", filePath)
 	}
 
-	return os.ReadFile(filePath)
+	return os.ReadFile(lcm.resolvePath(filePath))
 }
 
 // MultiRead reads the local storage data if exists.
@@ -150,37 +238,63 @@ func (lcm *LocalChunkManager) MultiRead(ctx context.Context, filePaths []string)
 	return results, el
 }
 
+// ListWithPrefix lists the files with the prefix, the sharded files are listed with their original paths,
+// and the temporary files being written are skipped.
 func (lcm *LocalChunkManager) ListWithPrefix(ctx context.Context, prefix string, recursive bool) ([]string, []time.Time, error) {
 	var filePaths []string
-	var modTimes []time.Time
+	var physicalPaths []string
+	seen := make(map[string]struct{})
+	add := func(filePath, physicalPath string) {
+		if _, ok := seen[filePath]; ok {
+			return
+		}
+		seen[filePath] = struct{}{}
+		filePaths = append(filePaths, filePath)
+		physicalPaths = append(physicalPaths, physicalPath)
+	}
+
 	if recursive {
 		dir := filepath.Dir(prefix)
-		err := filepath.Walk(dir, func(filePath string, f os.FileInfo, err error) error {
-			if strings.HasPrefix(filePath, prefix) && !f.IsDir() {
-				filePaths = append(filePaths, filePath)
+		err := filepath.Walk(dir, func(physicalPath string, f os.FileInfo, err error) error {
+			if f == nil || f.IsDir() || isLocalTempFile(f.Name()) {
+				return nil
+			}
+			if filePath := logicalPath(physicalPath); strings.HasPrefix(filePath, prefix) {
+				add(filePath, physicalPath)
 			}
 			return nil
 		})
 		if err != nil {
 			return nil, nil, err
 		}
-		for _, filePath := range filePaths {
-			modTime, err2 := lcm.getModTime(filePath)
-			if err2 != nil {
-				return filePaths, nil, err2
+	} else {
+		globPaths, err := filepath.Glob(prefix + "*")
+		if err != nil {
+			return nil, nil, err
+		}
+		for _, globPath := range globPaths {
+			name := filepath.Base(globPath)
+			if isLocalTempFile(name) || strings.HasPrefix(name, localShardDirPrefix) {
+				continue
 			}
-			modTimes = append(modTimes, modTime)
+			add(globPath, globPath)
+		}
+		dir, base := filepath.Split(prefix)
+		shardedPaths, err := filepath.Glob(filepath.Join(dir, localShardDirPrefix+"*", base+"*"))
+		if err != nil {
+			return nil, nil, err
+		}
+		for _, shardedPath := range shardedPaths {
+			if isLocalTempFile(filepath.Base(shardedPath)) {
+				continue
+			}
+			add(logicalPath(shardedPath), shardedPath)
 		}
-		return filePaths, modTimes, nil
 	}
 
-	globPaths, err := filepath.Glob(prefix + "*")
-	if err != nil {
-		return nil, nil, err
-	}
-	filePaths = append(filePaths, globPaths...)
-	for _, filePath := range filePaths {
-		modTime, err2 := lcm.getModTime(filePath)
+	modTimes := make([]time.Time, 0, len(filePaths))
+	for _, physicalPath := range physicalPaths {
+		modTime, err2 := lcm.getModTime(physicalPath)
 		if err2 != nil {
 			return filePaths, nil, err2
 		}
@@ -205,7 +319,7 @@ func (lcm *LocalChunkManager) ReadAt(ctx context.Context, filePath string, off i
 		return nil, io.EOF
 	}
 
-	file, err := os.Open(path.Clean(filePath))
+	file, err := os.Open(path.Clean(lcm.resolvePath(filePath)))
 	if err != nil {
 		return nil, err
 	}
@@ -218,11 +332,11 @@ func (lcm *LocalChunkManager) ReadAt(ctx context.Context, filePath string, off i
 }
 
 func (lcm *LocalChunkManager) Mmap(ctx context.Context, filePath string) (*mmap.ReaderAt, error) {
-	return mmap.Open(path.Clean(filePath))
+	return mmap.Open(path.Clean(lcm.resolvePath(filePath)))
 }
 
 func (lcm *LocalChunkManager) Size(ctx context.Context, filePath string) (int64, error) {
-	fi, err := os.Stat(filePath)
+	fi, err := os.Stat(lcm.resolvePath(filePath))
 	if err != nil {
 		return 0, err
 	}
@@ -232,13 +346,9 @@ func (lcm *LocalChunkManager) Size(ctx context.Context, filePath string) (int64,
 }
 
 func (lcm *LocalChunkManager) Remove(ctx context.Context, filePath string) error {
-	exist, err := lcm.Exist(ctx, filePath)
-	if err != nil {
-		return err
-	}
-	if exist {
-		err := os.RemoveAll(filePath)
-		if err != nil {
+	// remove both the sharded and the unsharded file, which might be written before the sharding enabled
+	for _, candidate := range lcm.candidatePaths(filePath) {
+		if err := os.RemoveAll(candidate); err != nil {
 			return err
 		}
 	}
@@ -282,3 +392,60 @@ func (lcm *LocalChunkManager) getModTime(filepath string) (time.Time, error) {
 
 	return fi.ModTime(), nil
 }
+
+// shardedPath returns the path the file is written to, which is in the shard sub directory if the sharding is enabled.
+func (lcm *LocalChunkManager) shardedPath(filePath string) string {
+	if lcm.dirShardNum <= 0 {
+		return filePath
+	}
+	dir, name := path.Split(filePath)
+	if name == "" {
+		return filePath
+	}
+	h := fnv.New32a()
+	h.Write([]byte(name))
+	shard := h.Sum32() % uint32(lcm.dirShardNum)
+	return path.Join(dir, fmt.Sprintf("%s%d", localShardDirPrefix, shard), name)
+}
+
+// candidatePaths returns the paths the file might be stored at, the sharded one first.
+func (lcm *LocalChunkManager) candidatePaths(filePath string) []string {
+	if sharded := lcm.shardedPath(filePath); sharded != filePath {
+		return []string{sharded, filePath}
+	}
+	return []string{filePath}
+}
+
+// resolvePath returns the path the file is actually stored at, the original path if the file doesn't exist.
+func (lcm *LocalChunkManager) resolvePath(filePath string) string {
+	candidates := lcm.candidatePaths(filePath)
+	for _, candidate := range candidates[:len(candidates)-1] {
+		if _, err := os.Stat(candidate); err == nil {
+			return candidate
+		}
+	}
+	return filePath
+}
+
+// logicalPath strips the shard sub directory from the path of the stored file.
+func logicalPath(physicalPath string) string {
+	dir, name := filepath.Split(physicalPath)
+	dir = filepath.Clean(dir)
+	if strings.HasPrefix(filepath.Base(dir), localShardDirPrefix) {
+		return filepath.Join(filepath.Dir(dir), name)
+	}
+	return physicalPath
+}
+
+func isLocalTempFile(name string) bool {
+	return strings.HasPrefix(name, ".") && strings.Contains(name, localTempFileInfix)
+}
+
+func syncDir(dir string) error {
+	d, err := os.Open(dir)
+	if err != nil {
+		return err
+	}
+	defer d.Close()
+	return d.Sync()
+}
@@ -18,12 +18,17 @@ package storage
 
 import (
 	"context"
+	"fmt"
+	"os"
 	"path"
 	"path/filepath"
+	"strings"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
+
+	"github.com/milvus-io/milvus/pkg/util/merr"
 )
 
 func TestLocalCM(t *testing.T) {
@@ -603,4 +608,99 @@ func TestLocalCM(t *testing.T) {
 		assert.Equal(t, 1, len(mods))
 		assert.Contains(t, dirs, filepath.Dir(key4))
 	})
+
+	t.Run("test atomic write", func(t *testing.T) {
+		testRoot := path.Join(localPath, "test_atomic_write")
+
+		testCM := NewLocalChunkManager(RootPath(localPath), LocalFsyncPolicy(LocalFsyncFull))
+		defer testCM.RemoveWithPrefix(ctx, testCM.RootPath())
+
+		key := path.Join(testRoot, "key_1")
+		assert.NoError(t, testCM.Write(ctx, key, []byte("111")))
+		assert.NoError(t, testCM.Write(ctx, key, []byte("222")))
+		val, err := testCM.Read(ctx, key)
+		assert.NoError(t, err)
+		assert.Equal(t, []byte("222"), val)
+
+		// the temporary files being written are invisible
+		require.NoError(t, os.WriteFile(path.Join(testRoot, ".key_2"+localTempFileInfix+"123"), []byte("2"), 0o644))
+		entries, err := os.ReadDir(testRoot)
+		require.NoError(t, err)
+		assert.Len(t, entries, 2)
+		keys, _, err := testCM.ListWithPrefix(ctx, testRoot+"/", false)
+		assert.NoError(t, err)
+		assert.Equal(t, []string{key}, keys)
+		keys, _, err = testCM.ListWithPrefix(ctx, testRoot+"/", true)
+		assert.NoError(t, err)
+		assert.Equal(t, []string{key}, keys)
+	})
+
+	t.Run("test dir sharding", func(t *testing.T) {
+		testRoot := path.Join(localPath, "test_dir_sharding")
+
+		testCM := NewLocalChunkManager(RootPath(localPath), LocalDirShardNum(4))
+		defer testCM.RemoveWithPrefix(ctx, testCM.RootPath())
+
+		// the file written before the sharding enabled is still readable
+		legacyKey := path.Join(testRoot, "legacy")
+		require.NoError(t, NewLocalChunkManager(RootPath(localPath)).Write(ctx, legacyKey, []byte("0")))
+
+		keys := make([]string, 0)
+		for i := 0; i < 16; i++ {
+			key := path.Join(testRoot, fmt.Sprintf("key_%d", i))
+			require.NoError(t, testCM.Write(ctx, key, []byte{byte(i)}))
+			keys = append(keys, key)
+		}
+		entries, err := os.ReadDir(testRoot)
+		require.NoError(t, err)
+		for _, entry := range entries {
+			if entry.IsDir() {
+				assert.True(t, strings.HasPrefix(entry.Name(), localShardDirPrefix))
+			}
+		}
+
+		for i, key := range keys {
+			exist, err := testCM.Exist(ctx, key)
+			assert.NoError(t, err)
+			assert.True(t, exist)
+			val, err := testCM.Read(ctx, key)
+			assert.NoError(t, err)
+			assert.Equal(t, []byte{byte(i)}, val)
+			size, err := testCM.Size(ctx, key)
+			assert.NoError(t, err)
+			assert.EqualValues(t, 1, size)
+			p, err := testCM.Path(ctx, key)
+			assert.NoError(t, err)
+			assert.Contains(t, p, localShardDirPrefix)
+		}
+		val, err := testCM.Read(ctx, legacyKey)
+		assert.NoError(t, err)
+		assert.Equal(t, []byte("0"), val)
+
+		listed, mods, err := testCM.ListWithPrefix(ctx, testRoot+"/", true)
+		assert.NoError(t, err)
+		assert.Len(t, mods, len(keys)+1)
+		assert.ElementsMatch(t, append(keys, legacyKey), listed)
+		listed, _, err = testCM.ListWithPrefix(ctx, path.Join(testRoot, "key_1"), false)
+		assert.NoError(t, err)
+		assert.ElementsMatch(t, []string{keys[1], keys[10], keys[11], keys[12], keys[13], keys[14], keys[15]}, listed)
+
+		assert.NoError(t, testCM.Remove(ctx, keys[0]))
+		exist, err := testCM.Exist(ctx, keys[0])
+		assert.NoError(t, err)
+		assert.False(t, exist)
+
+		assert.NoError(t, testCM.RemoveWithPrefix(ctx, testRoot))
+		listed, _, err = testCM.ListWithPrefix(ctx, testRoot, true)
+		assert.NoError(t, err)
+		assert.Empty(t, listed)
+	})
+
+	t.Run("test free space watermark", func(t *testing.T) {
+		testCM := NewLocalChunkManager(RootPath(localPath), LocalMinFreeSpaceRatio(1))
+		defer testCM.RemoveWithPrefix(ctx, testCM.RootPath())
+
+		err := testCM.Write(ctx, path.Join(localPath, "test_watermark", "key_1"), []byte("111"))
+		assert.ErrorIs(t, err, merr.ErrServiceDiskLimitExceeded)
+	})
 }
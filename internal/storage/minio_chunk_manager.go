@@ -20,6 +20,8 @@ import (
 	"bytes"
 	"container/list"
 	"context"
+	"crypto/md5"
+	"encoding/hex"
 	"fmt"
 	"io"
 	"strings"
@@ -58,6 +60,8 @@ type MinioChunkManager struct {
 	//	ctx        context.Context
 	bucketName string
 	rootPath   string
+
+	retrier *requestRetrier
 }
 
 var _ ChunkManager = (*MinioChunkManager)(nil)
@@ -81,6 +85,8 @@ func newMinioChunkManagerWithConfig(ctx context.Context, c *config) (*MinioChunk
 	mcm := &MinioChunkManager{
 		Client:     minIOClient,
 		bucketName: c.bucketName,
+		retrier: newRequestRetrier(getCircuitBreaker(minIOClient.EndpointURL().Host,
+			c.circuitBreakerThreshold, c.circuitBreakerOpenDuration)),
 	}
 	mcm.rootPath = mcm.normalizeRootPath(c.rootPath)
 	log.Info("minio chunk manager init success.", zap.String("bucketname", c.bucketName), zap.String("root", mcm.RootPath()))
@@ -128,7 +134,11 @@ func (mcm *MinioChunkManager) Reader(ctx context.Context, filePath string) (File
 }
 
 func (mcm *MinioChunkManager) Size(ctx context.Context, filePath string) (int64, error) {
-	objectInfo, err := mcm.statMinioObject(ctx, mcm.bucketName, filePath, minio.StatObjectOptions{})
+	var objectInfo minio.ObjectInfo
+	err := mcm.retrier.do(ctx, metrics.DataStatLabel, func() (err error) {
+		objectInfo, err = mcm.statMinioObject(ctx, mcm.bucketName, filePath, minio.StatObjectOptions{})
+		return err
+	})
 	if err != nil {
 		log.Warn("failed to stat object", zap.String("bucket", mcm.bucketName), zap.String("path", filePath), zap.Error(err))
 		return 0, err
@@ -139,7 +149,12 @@ func (mcm *MinioChunkManager) Size(ctx context.Context, filePath string) (int64,
 
 // Write writes the data to minio storage.
 func (mcm *MinioChunkManager) Write(ctx context.Context, filePath string, content []byte) error {
-	_, err := mcm.putMinioObject(ctx, mcm.bucketName, filePath, bytes.NewReader(content), int64(len(content)), minio.PutObjectOptions{})
+	err := mcm.retrier.do(ctx, metrics.DataPutLabel, func() error {
+		// send the md5 to let the server reject the content corrupted in transit
+		_, err := mcm.putMinioObject(ctx, mcm.bucketName, filePath, bytes.NewReader(content), int64(len(content)),
+			minio.PutObjectOptions{SendContentMd5: true})
+		return err
+	})
 	if err != nil {
 		log.Warn("failed to put object", zap.String("bucket", mcm.bucketName), zap.String("path", filePath), zap.Error(err))
 		return err
@@ -164,7 +179,10 @@ func (mcm *MinioChunkManager) MultiWrite(ctx context.Context, kvs map[string][]b
 
 // Exist checks whether chunk is saved to minio storage.
 func (mcm *MinioChunkManager) Exist(ctx context.Context, filePath string) (bool, error) {
-	_, err := mcm.statMinioObject(ctx, mcm.bucketName, filePath, minio.StatObjectOptions{})
+	err := mcm.retrier.do(ctx, metrics.DataStatLabel, func() error {
+		_, err := mcm.statMinioObject(ctx, mcm.bucketName, filePath, minio.StatObjectOptions{})
+		return err
+	})
 	if err != nil {
 		errResponse := minio.ToErrorResponse(err)
 		if errResponse.Code == "NoSuchKey" {
@@ -178,6 +196,15 @@ func (mcm *MinioChunkManager) Exist(ctx context.Context, filePath string) (bool,
 
 // Read reads the minio storage data if exists.
 func (mcm *MinioChunkManager) Read(ctx context.Context, filePath string) ([]byte, error) {
+	var data []byte
+	err := mcm.retrier.do(ctx, metrics.DataGetLabel, func() (err error) {
+		data, err = mcm.read(ctx, filePath)
+		return err
+	})
+	return data, err
+}
+
+func (mcm *MinioChunkManager) read(ctx context.Context, filePath string) ([]byte, error) {
 	object, err := mcm.getMinioObject(ctx, mcm.bucketName, filePath, minio.GetObjectOptions{})
 	if err != nil {
 		log.Warn("failed to get object", zap.String("bucket", mcm.bucketName), zap.String("path", filePath), zap.Error(err))
@@ -216,6 +243,10 @@ func (mcm *MinioChunkManager) Read(ctx context.Context, filePath string) ([]byte
 		log.Warn("failed to read object", zap.String("bucket", mcm.bucketName), zap.String("path", filePath), zap.Error(err))
 		return nil, err
 	}
+	if err := verifyChecksum(data, objectInfo); err != nil {
+		log.Warn("failed to verify object", zap.String("bucket", mcm.bucketName), zap.String("path", filePath), zap.Error(err))
+		return nil, err
+	}
 	metrics.PersistentDataKvSize.WithLabelValues(metrics.DataGetLabel).Observe(float64(objectInfo.Size))
 	return data, nil
 }
@@ -264,14 +295,17 @@ func (mcm *MinioChunkManager) ReadAt(ctx context.Context, filePath string, off i
 		return nil, err
 	}
 
-	object, err := mcm.getMinioObject(ctx, mcm.bucketName, filePath, opts)
-	if err != nil {
-		log.Warn("failed to get object", zap.String("bucket", mcm.bucketName), zap.String("path", filePath), zap.Error(err))
-		return nil, err
-	}
-	defer object.Close()
-
-	data, err := Read(object, length)
+	var data []byte
+	err = mcm.retrier.do(ctx, metrics.DataGetLabel, func() error {
+		object, err := mcm.getMinioObject(ctx, mcm.bucketName, filePath, opts)
+		if err != nil {
+			log.Warn("failed to get object", zap.String("bucket", mcm.bucketName), zap.String("path", filePath), zap.Error(err))
+			return err
+		}
+		defer object.Close()
+		data, err = Read(object, length)
+		return err
+	})
 	if err != nil {
 		errResponse := minio.ToErrorResponse(err)
 		if errResponse.Code == "NoSuchKey" {
@@ -286,7 +320,9 @@ func (mcm *MinioChunkManager) ReadAt(ctx context.Context, filePath string, off i
 
 // Remove deletes an object with @key.
 func (mcm *MinioChunkManager) Remove(ctx context.Context, filePath string) error {
-	err := mcm.removeMinioObject(ctx, mcm.bucketName, filePath, minio.RemoveObjectOptions{})
+	err := mcm.retrier.do(ctx, metrics.DataRemoveLabel, func() error {
+		return mcm.removeMinioObject(ctx, mcm.bucketName, filePath, minio.RemoveObjectOptions{})
+	})
 	if err != nil {
 		log.Warn("failed to remove object", zap.String("bucket", mcm.bucketName), zap.String("path", filePath), zap.Error(err))
 		return err
@@ -386,6 +422,26 @@ func (mcm *MinioChunkManager) ListWithPrefix(ctx context.Context, prefix string,
 	return objectsKeys, modTimes, nil
 }
 
+// verifyChecksum verifies the size of data read, and the md5 of data if the etag of object is the md5 of its content,
+// which is not true for the objects uploaded by multipart or encrypted by kms.
+func verifyChecksum(data []byte, info minio.ObjectInfo) error {
+	if int64(len(data)) != info.Size {
+		return errors.Wrapf(errChecksumMismatch, "read %d bytes, expected %d", len(data), info.Size)
+	}
+	etag := strings.Trim(info.ETag, "\"")
+	if len(etag) != md5.Size*2 || info.Metadata.Get("X-Amz-Server-Side-Encryption") == "aws:kms" {
+		return nil
+	}
+	expected, err := hex.DecodeString(etag)
+	if err != nil {
+		return nil
+	}
+	if actual := md5.Sum(data); !bytes.Equal(actual[:], expected) {
+		return errors.Wrapf(errChecksumMismatch, "md5 %x, expected %s", actual, etag)
+	}
+	return nil
+}
+
 // Learn from file.ReadFile
 func Read(r io.Reader, size int64) ([]byte, error) {
 	data := make([]byte, 0, size)
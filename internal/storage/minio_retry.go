@@ -0,0 +1,253 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package storage
+
+import (
+	"context"
+	"io"
+	"net"
+	"net/http"
+	"sync"
+	"time"
+
+	"github.com/cockroachdb/errors"
+	minio "github.com/minio/minio-go/v7"
+	"go.uber.org/zap"
+
+	"github.com/milvus-io/milvus/pkg/log"
+	"github.com/milvus-io/milvus/pkg/metrics"
+	"github.com/milvus-io/milvus/pkg/util/merr"
+)
+
+// errorClass is the class of errors of the requests to object storage, which decides how to retry the request.
+type errorClass string
+
+const (
+	errorClassNone     errorClass = "none"
+	errorClassThrottle errorClass = "throttle"
+	errorClassServer   errorClass = "server"
+	errorClassChecksum errorClass = "checksum"
+)
+
+var errChecksumMismatch = errors.New("checksum mismatch")
+
+var throttleErrorCodes = map[string]struct{}{
+	"SlowDown":             {},
+	"Throttling":           {},
+	"ThrottlingException":  {},
+	"RequestLimitExceeded": {},
+	"RequestThrottled":     {},
+	"TooManyRequests":      {},
+}
+
+var checksumErrorCodes = map[string]struct{}{
+	"BadDigest":                 {},
+	"InvalidDigest":             {},
+	"XAmzContentSHA256Mismatch": {},
+}
+
+// classifyError returns the class of the error returned by object storage.
+func classifyError(err error) errorClass {
+	if err == nil || errors.Is(err, context.Canceled) || errors.Is(err, context.DeadlineExceeded) ||
+		errors.Is(err, ErrNoSuchKey) {
+		return errorClassNone
+	}
+	if errors.Is(err, errChecksumMismatch) {
+		return errorClassChecksum
+	}
+
+	resp := minio.ToErrorResponse(errors.Cause(err))
+	if _, ok := throttleErrorCodes[resp.Code]; ok || resp.StatusCode == http.StatusTooManyRequests {
+		return errorClassThrottle
+	}
+	if _, ok := checksumErrorCodes[resp.Code]; ok {
+		return errorClassChecksum
+	}
+	if resp.StatusCode >= http.StatusInternalServerError {
+		return errorClassServer
+	}
+	if resp.Code != "" {
+		return errorClassNone
+	}
+
+	var netErr net.Error
+	if errors.As(err, &netErr) || errors.Is(err, io.ErrUnexpectedEOF) {
+		return errorClassServer
+	}
+	return errorClassNone
+}
+
+// retryPolicy is the retry policy of one error class, the backoff is doubled after each attempt.
+type retryPolicy struct {
+	attempts       uint
+	initialBackoff time.Duration
+	maxBackoff     time.Duration
+}
+
+// defaultRetryPolicies retries the checksum errors only, the throttling and server errors are retried
+// with backoff by minio-go itself (up to minio.MaxRetry times), retrying them again multiplies the attempts,
+// they are only recorded by the circuit breaker.
+var defaultRetryPolicies = map[errorClass]retryPolicy{
+	// the data is corrupted in transit, re-download once then fail
+	errorClassChecksum: {attempts: 2},
+}
+
+const (
+	circuitClosed = iota
+	circuitOpen
+	circuitHalfOpen
+)
+
+// circuitBreaker fails the requests to an endpoint fast after too many consecutive failures of the endpoint,
+// and lets one request through to probe the endpoint after the open duration.
+type circuitBreaker struct {
+	endpoint     string
+	threshold    int
+	openDuration time.Duration
+
+	mu       sync.Mutex
+	state    int
+	failures int
+	openedAt time.Time
+}
+
+var (
+	circuitBreakersMu sync.Mutex
+	circuitBreakers   = make(map[string]*circuitBreaker)
+)
+
+// getCircuitBreaker returns the circuit breaker of endpoint shared by the chunk managers, nil if disabled.
+func getCircuitBreaker(endpoint string, threshold int, openDuration time.Duration) *circuitBreaker {
+	if threshold <= 0 {
+		return nil
+	}
+	circuitBreakersMu.Lock()
+	defer circuitBreakersMu.Unlock()
+	cb, ok := circuitBreakers[endpoint]
+	if !ok {
+		cb = &circuitBreaker{endpoint: endpoint, threshold: threshold, openDuration: openDuration}
+		circuitBreakers[endpoint] = cb
+	}
+	return cb
+}
+
+func (cb *circuitBreaker) allow() error {
+	if cb == nil {
+		return nil
+	}
+	cb.mu.Lock()
+	defer cb.mu.Unlock()
+	switch cb.state {
+	case circuitOpen:
+		if time.Since(cb.openedAt) < cb.openDuration {
+			return merr.WrapErrServiceUnavailable("circuit breaker open", "object storage endpoint "+cb.endpoint)
+		}
+		cb.setState(circuitHalfOpen)
+		return nil
+	case circuitHalfOpen:
+		return merr.WrapErrServiceUnavailable("circuit breaker half open", "object storage endpoint "+cb.endpoint)
+	default:
+		return nil
+	}
+}
+
+// record records the result of request, only the throttling and server errors count as failures of endpoint.
+func (cb *circuitBreaker) record(class errorClass) {
+	if cb == nil {
+		return
+	}
+	cb.mu.Lock()
+	defer cb.mu.Unlock()
+	if class != errorClassThrottle && class != errorClassServer {
+		cb.failures = 0
+		if cb.state != circuitClosed {
+			cb.setState(circuitClosed)
+		}
+		return
+	}
+	cb.failures++
+	if cb.state == circuitHalfOpen || cb.failures >= cb.threshold {
+		cb.openedAt = time.Now()
+		if cb.state != circuitOpen {
+			log.Warn("object storage circuit breaker open", zap.String("endpoint", cb.endpoint), zap.Int("failures", cb.failures))
+		}
+		cb.setState(circuitOpen)
+	}
+}
+
+func (cb *circuitBreaker) setState(state int) {
+	cb.state = state
+	metrics.PersistentDataCircuitBreakerState.WithLabelValues(cb.endpoint).Set(float64(state))
+}
+
+// requestRetrier retries the requests to object storage by the policy of the error class,
+// and fails the requests fast by the circuit breaker of endpoint.
+type requestRetrier struct {
+	policies map[errorClass]retryPolicy
+	breaker  *circuitBreaker
+}
+
+func newRequestRetrier(breaker *circuitBreaker) *requestRetrier {
+	return &requestRetrier{
+		policies: defaultRetryPolicies,
+		breaker:  breaker,
+	}
+}
+
+// do executes the request fn of operation op with retry, the request is executed once if the retrier is nil.
+func (r *requestRetrier) do(ctx context.Context, op string, fn func() error) error {
+	if r == nil {
+		return fn()
+	}
+
+	var attempt uint
+	var backoff time.Duration
+	for {
+		if err := r.breaker.allow(); err != nil {
+			return err
+		}
+		err := fn()
+		class := classifyError(err)
+		r.breaker.record(class)
+		if err == nil || class == errorClassNone {
+			return err
+		}
+
+		attempt++
+		policy, ok := r.policies[class]
+		if !ok || attempt >= policy.attempts {
+			return err
+		}
+		metrics.PersistentDataRetryCounter.WithLabelValues(op, string(class)).Inc()
+		log.Ctx(ctx).Debug("retry object storage request", zap.String("op", op),
+			zap.String("errorClass", string(class)), zap.Uint("attempt", attempt), zap.Error(err))
+
+		if attempt == 1 {
+			backoff = policy.initialBackoff
+		} else if backoff *= 2; backoff > policy.maxBackoff {
+			backoff = policy.maxBackoff
+		}
+		if backoff <= 0 {
+			continue
+		}
+		select {
+		case <-ctx.Done():
+			return err
+		case <-time.After(backoff):
+		}
+	}
+}
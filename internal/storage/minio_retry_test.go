@@ -0,0 +1,186 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package storage
+
+import (
+	"context"
+	"crypto/md5"
+	"encoding/hex"
+	"io"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/cockroachdb/errors"
+	minio "github.com/minio/minio-go/v7"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/milvus-io/milvus/pkg/util/merr"
+)
+
+func TestClassifyError(t *testing.T) {
+	cases := []struct {
+		err   error
+		class errorClass
+	}{
+		{nil, errorClassNone},
+		{context.Canceled, errorClassNone},
+		{WrapErrNoSuchKey("a"), errorClassNone},
+		{minio.ErrorResponse{Code: "NoSuchKey", StatusCode: http.StatusNotFound}, errorClassNone},
+		{minio.ErrorResponse{Code: "SlowDown", StatusCode: http.StatusServiceUnavailable}, errorClassThrottle},
+		{minio.ErrorResponse{StatusCode: http.StatusTooManyRequests}, errorClassThrottle},
+		{minio.ErrorResponse{Code: "InternalError", StatusCode: http.StatusInternalServerError}, errorClassServer},
+		{io.ErrUnexpectedEOF, errorClassServer},
+		{minio.ErrorResponse{Code: "BadDigest", StatusCode: http.StatusBadRequest}, errorClassChecksum},
+		{errors.Wrap(errChecksumMismatch, "md5"), errorClassChecksum},
+		{errors.New("unknown"), errorClassNone},
+	}
+	for _, c := range cases {
+		assert.Equal(t, c.class, classifyError(c.err), "%v", c.err)
+	}
+}
+
+func TestRequestRetrier(t *testing.T) {
+	ctx := context.Background()
+	newRetrier := func(breaker *circuitBreaker) *requestRetrier {
+		r := newRequestRetrier(breaker)
+		r.policies = map[errorClass]retryPolicy{
+			errorClassThrottle: {attempts: 4, initialBackoff: time.Millisecond, maxBackoff: 2 * time.Millisecond},
+			errorClassServer:   {attempts: 2},
+			errorClassChecksum: {attempts: 2},
+		}
+		return r
+	}
+	failN := func(n int, err error) (func() error, *int) {
+		calls := 0
+		return func() error {
+			calls++
+			if calls <= n {
+				return err
+			}
+			return nil
+		}, &calls
+	}
+
+	t.Run("nil retrier", func(t *testing.T) {
+		var r *requestRetrier
+		fn, calls := failN(1, io.ErrUnexpectedEOF)
+		assert.Error(t, r.do(ctx, "get", fn))
+		assert.Equal(t, 1, *calls)
+	})
+
+	t.Run("default policies", func(t *testing.T) {
+		r := newRequestRetrier(nil)
+		// retried by minio-go already
+		fn, calls := failN(1, minio.ErrorResponse{Code: "SlowDown", StatusCode: http.StatusServiceUnavailable})
+		assert.Error(t, r.do(ctx, "get", fn))
+		assert.Equal(t, 1, *calls)
+
+		fn, calls = failN(1, minio.ErrorResponse{Code: "InternalError", StatusCode: http.StatusInternalServerError})
+		assert.Error(t, r.do(ctx, "get", fn))
+		assert.Equal(t, 1, *calls)
+
+		fn, calls = failN(1, errChecksumMismatch)
+		assert.NoError(t, r.do(ctx, "get", fn))
+		assert.Equal(t, 2, *calls)
+	})
+
+	t.Run("per class attempts", func(t *testing.T) {
+		r := newRetrier(nil)
+		fn, calls := failN(3, minio.ErrorResponse{Code: "SlowDown", StatusCode: http.StatusServiceUnavailable})
+		assert.NoError(t, r.do(ctx, "get", fn))
+		assert.Equal(t, 4, *calls)
+
+		fn, calls = failN(3, io.ErrUnexpectedEOF)
+		assert.Error(t, r.do(ctx, "get", fn))
+		assert.Equal(t, 2, *calls)
+
+		// re-download once then fail
+		fn, calls = failN(3, errChecksumMismatch)
+		assert.ErrorIs(t, r.do(ctx, "get", fn), errChecksumMismatch)
+		assert.Equal(t, 2, *calls)
+
+		fn, calls = failN(3, WrapErrNoSuchKey("a"))
+		assert.ErrorIs(t, r.do(ctx, "get", fn), ErrNoSuchKey)
+		assert.Equal(t, 1, *calls)
+	})
+
+	t.Run("context done", func(t *testing.T) {
+		r := newRetrier(nil)
+		r.policies[errorClassThrottle] = retryPolicy{attempts: 10, initialBackoff: time.Hour, maxBackoff: time.Hour}
+		ctx, cancel := context.WithCancel(ctx)
+		cancel()
+		fn, calls := failN(3, minio.ErrorResponse{StatusCode: http.StatusTooManyRequests})
+		assert.Error(t, r.do(ctx, "get", fn))
+		assert.Equal(t, 1, *calls)
+	})
+
+	t.Run("circuit breaker", func(t *testing.T) {
+		breaker := &circuitBreaker{endpoint: "test", threshold: 2, openDuration: 50 * time.Millisecond}
+		r := newRetrier(breaker)
+		fn, calls := failN(2, io.ErrUnexpectedEOF)
+		assert.Error(t, r.do(ctx, "get", fn))
+		assert.Equal(t, 2, *calls)
+
+		// fail fast when open
+		err := r.do(ctx, "get", fn)
+		assert.ErrorIs(t, err, merr.ErrServiceUnavailable)
+		assert.Equal(t, 2, *calls)
+
+		// probe after open duration, closed on success
+		time.Sleep(60 * time.Millisecond)
+		assert.NoError(t, r.do(ctx, "get", fn))
+		assert.Equal(t, 3, *calls)
+		assert.Equal(t, circuitClosed, breaker.state)
+	})
+
+	t.Run("half open probe failed", func(t *testing.T) {
+		breaker := &circuitBreaker{endpoint: "test", threshold: 1, openDuration: time.Millisecond}
+		breaker.record(errorClassServer)
+		assert.Equal(t, circuitOpen, breaker.state)
+		time.Sleep(2 * time.Millisecond)
+		assert.NoError(t, breaker.allow())
+		assert.Equal(t, circuitHalfOpen, breaker.state)
+		assert.Error(t, breaker.allow())
+		breaker.record(errorClassThrottle)
+		assert.Equal(t, circuitOpen, breaker.state)
+	})
+}
+
+func TestGetCircuitBreaker(t *testing.T) {
+	assert.Nil(t, getCircuitBreaker("localhost:9000", 0, time.Second))
+	cb := getCircuitBreaker("localhost:9000", 10, time.Second)
+	assert.NotNil(t, cb)
+	assert.Same(t, cb, getCircuitBreaker("localhost:9000", 10, time.Second))
+}
+
+func TestVerifyChecksum(t *testing.T) {
+	data := []byte("hello")
+	sum := md5.Sum(data)
+	etag := hex.EncodeToString(sum[:])
+
+	assert.NoError(t, verifyChecksum(data, minio.ObjectInfo{Size: 5, ETag: etag}))
+	assert.NoError(t, verifyChecksum(data, minio.ObjectInfo{Size: 5, ETag: "\"" + etag + "\""}))
+	// multipart etag not verified
+	assert.NoError(t, verifyChecksum(data, minio.ObjectInfo{Size: 5, ETag: etag + "-2"}))
+	assert.ErrorIs(t, verifyChecksum(data[:4], minio.ObjectInfo{Size: 5, ETag: etag}), errChecksumMismatch)
+	assert.ErrorIs(t, verifyChecksum([]byte("hellp"), minio.ObjectInfo{Size: 5, ETag: etag}), errChecksumMismatch)
+	// kms encrypted etag not verified
+	header := http.Header{}
+	header.Set("X-Amz-Server-Side-Encryption", "aws:kms")
+	assert.NoError(t, verifyChecksum([]byte("hellp"), minio.ObjectInfo{Size: 5, ETag: etag, Metadata: header}))
+}
@@ -1,5 +1,7 @@
 package storage
 
+import "time"
+
 // Option for setting params used by chunk manager client.
 type config struct {
 	address           string
@@ -15,6 +17,17 @@ type config struct {
 	useVirtualHost    bool
 	region            string
 	requestTimeoutMs  int64
+
+	circuitBreakerThreshold    int
+	circuitBreakerOpenDuration time.Duration
+
+	gcsCredentialFile  string
+	gcsKmsKeyName      string
+	gcsUploadChunkSize int64
+
+	localFsyncPolicy       string
+	localDirShardNum       int
+	localMinFreeSpaceRatio float64
 }
 
 func newDefaultConfig() *config {
@@ -101,3 +114,58 @@ func RequestTimeout(requestTimeoutMs int64) Option {
 		c.requestTimeoutMs = requestTimeoutMs
 	}
 }
+
+// CircuitBreaker sets the consecutive failures to open the circuit breaker of endpoint and how long it keeps open.
+func CircuitBreaker(threshold int, openDuration time.Duration) Option {
+	return func(c *config) {
+		c.circuitBreakerThreshold = threshold
+		c.circuitBreakerOpenDuration = openDuration
+	}
+}
+
+// GcsCredentialFile sets the credential file of the native GCS client, which might be a service account key,
+// or an external account config of the workload identity federation.
+func GcsCredentialFile(path string) Option {
+	return func(c *config) {
+		c.gcsCredentialFile = path
+	}
+}
+
+// GcsKmsKeyName sets the customer-managed encryption key to encrypt the objects written to GCS.
+func GcsKmsKeyName(name string) Option {
+	return func(c *config) {
+		c.gcsKmsKeyName = name
+	}
+}
+
+// GcsUploadChunkSize sets the chunk size in bytes of the GCS resumable upload,
+// the smaller objects are uploaded in a single request.
+func GcsUploadChunkSize(size int64) Option {
+	return func(c *config) {
+		c.gcsUploadChunkSize = size
+	}
+}
+
+// LocalFsyncPolicy sets when the local chunk manager flushes the written files to disk,
+// "none", "file" to sync the file before renamed, or "full" to sync the parent directory after renamed too.
+func LocalFsyncPolicy(policy string) Option {
+	return func(c *config) {
+		c.localFsyncPolicy = policy
+	}
+}
+
+// LocalDirShardNum sets the number of sub directories the local chunk manager spreads the files of a directory into,
+// zero disables the sharding.
+func LocalDirShardNum(num int) Option {
+	return func(c *config) {
+		c.localDirShardNum = num
+	}
+}
+
+// LocalMinFreeSpaceRatio sets the ratio of free space of the filesystem the local chunk manager keeps,
+// the writes exceeding the watermark are rejected, zero disables the check.
+func LocalMinFreeSpaceRatio(ratio float64) Option {
+	return func(c *config) {
+		c.localMinFreeSpaceRatio = ratio
+	}
+}
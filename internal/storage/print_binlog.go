@@ -131,6 +131,12 @@ func printBinlogFile(filename string) error {
 			fmt.Printf("\tStartTimestamp: %v\n", physical)
 			physical, _ = tsoutil.ParseTS(evd.EndTimestamp)
 			fmt.Printf("\tEndTimestamp: %v\n", physical)
+			if r.IsRoaringDeltalog() {
+				if err := printRoaringDeltalogValues(event.PayloadReaderInterface); err != nil {
+					return err
+				}
+				break
+			}
 			if err := printPayloadValues(r.descriptorEvent.descriptorEventData.PayloadDataType, event.PayloadReaderInterface); err != nil {
 				return err
 			}
@@ -341,6 +347,22 @@ func printPayloadValues(colType schemapb.DataType, reader PayloadReaderInterface
 }
 
 // nolint
+func printRoaringDeltalogValues(reader PayloadReaderInterface) error {
+	fmt.Println("\tpayload values:")
+	val, err := reader.GetStringFromPayload()
+	if err != nil {
+		return err
+	}
+	data, err := DeserializeRoaringDeltalog(val)
+	if err != nil {
+		return err
+	}
+	for i, pk := range data.Pks {
+		fmt.Printf("\t\t%d : pk = %v, ts = %d\n", i, pk.GetValue(), data.Tss[i])
+	}
+	return nil
+}
+
 func printDDLPayloadValues(eventType EventTypeCode, colType schemapb.DataType, reader PayloadReaderInterface) error {
 	fmt.Println("\tpayload values:")
 	switch colType {
@@ -19,10 +19,12 @@ package storage
 import (
 	"encoding/json"
 	"fmt"
+	"path"
 
 	"github.com/bits-and-blooms/bloom/v3"
 
 	"github.com/milvus-io/milvus-proto/go-api/v2/schemapb"
+	"github.com/milvus-io/milvus/internal/proto/datapb"
 	"github.com/milvus-io/milvus/pkg/common"
 	"github.com/milvus-io/milvus/pkg/log"
 	"github.com/milvus-io/milvus/pkg/util/merr"
@@ -308,3 +310,35 @@ func DeserializeStatsList(blob *Blob) ([]*PrimaryKeyStats, error) {
 	}
 	return stats, nil
 }
+
+// SelectPkStatsLogs selects the pk stats logs to load from the stats logs of a segment in the order synced,
+// the compound stats log contains all the stats synced before it, so the ones before the last compound stats log are skipped.
+// The first stats log selected is the compound one if the returned type is CompoundStatsType.
+func SelectPkStatsLogs(binlogs []*datapb.Binlog) ([]*datapb.Binlog, StatsLogType) {
+	logType := DefaultStatsType
+	selected := make([]*datapb.Binlog, 0, len(binlogs))
+	for _, binlog := range binlogs {
+		if path.Base(binlog.GetLogPath()) == CompoundStatsType.LogIdx() {
+			selected = selected[:0]
+			logType = CompoundStatsType
+		}
+		selected = append(selected, binlog)
+	}
+	return selected, logType
+}
+
+// DeserializePkStatsLogs deserializes the blobs of the pk stats logs selected by SelectPkStatsLogs.
+func DeserializePkStatsLogs(blobs []*Blob, logType StatsLogType) ([]*PrimaryKeyStats, error) {
+	if logType != CompoundStatsType || len(blobs) == 0 {
+		return DeserializeStats(blobs)
+	}
+	stats, err := DeserializeStatsList(blobs[0])
+	if err != nil {
+		return nil, err
+	}
+	synced, err := DeserializeStats(blobs[1:])
+	if err != nil {
+		return nil, err
+	}
+	return append(stats, synced...), nil
+}
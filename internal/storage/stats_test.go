@@ -24,6 +24,7 @@ import (
 	"github.com/stretchr/testify/assert"
 
 	"github.com/milvus-io/milvus-proto/go-api/v2/schemapb"
+	"github.com/milvus-io/milvus/internal/proto/datapb"
 	"github.com/milvus-io/milvus/pkg/common"
 	"github.com/milvus-io/milvus/pkg/util/merr"
 )
@@ -174,3 +175,45 @@ func TestDeserializeEmptyStats(t *testing.T) {
 	_, err := DeserializeStats([]*Blob{blob})
 	assert.NoError(t, err)
 }
+
+func TestSelectPkStatsLogs(t *testing.T) {
+	binlogs := []*datapb.Binlog{
+		{LogPath: "stats_log/1/10/100/101/1001"},
+		{LogPath: "stats_log/1/10/100/101/1"},
+		{LogPath: "stats_log/1/10/100/101/1002"},
+	}
+	selected, logType := SelectPkStatsLogs(binlogs)
+	assert.Equal(t, CompoundStatsType, logType)
+	assert.Equal(t, binlogs[1:], selected)
+
+	selected, logType = SelectPkStatsLogs(binlogs[:1])
+	assert.Equal(t, DefaultStatsType, logType)
+	assert.Equal(t, binlogs[:1], selected)
+}
+
+func TestDeserializePkStatsLogs(t *testing.T) {
+	generate := func(ids ...int64) *PrimaryKeyStats {
+		stats := NewPrimaryKeyStats(common.StartOfUserFieldID, int64(schemapb.DataType_Int64), int64(len(ids)))
+		stats.UpdateByMsgs(&Int64FieldData{Data: ids})
+		return stats
+	}
+
+	sw := &StatsWriter{}
+	assert.NoError(t, sw.GenerateList([]*PrimaryKeyStats{generate(1, 2), generate(3)}))
+	compound := &Blob{Value: sw.GetBuffer()}
+	sw = &StatsWriter{}
+	assert.NoError(t, sw.Generate(generate(4, 5)))
+	synced := &Blob{Value: sw.GetBuffer()}
+
+	stats, err := DeserializePkStatsLogs([]*Blob{compound, synced}, CompoundStatsType)
+	assert.NoError(t, err)
+	assert.Len(t, stats, 3)
+	assert.True(t, stats[2].MaxPk.EQ(&Int64PrimaryKey{Value: 5}))
+
+	stats, err = DeserializePkStatsLogs([]*Blob{synced}, DefaultStatsType)
+	assert.NoError(t, err)
+	assert.Len(t, stats, 1)
+
+	_, err = DeserializePkStatsLogs([]*Blob{{Value: []byte("abc")}, synced}, CompoundStatsType)
+	assert.Error(t, err)
+}
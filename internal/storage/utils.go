@@ -410,6 +410,19 @@ func RowBasedInsertMsgToInsertData(msg *msgstream.InsertMsg, collSchema *schemap
 }
 
 func ColumnBasedInsertMsgToInsertData(msg *msgstream.InsertMsg, collSchema *schemapb.CollectionSchema) (idata *InsertData, err error) {
+	return columnBasedInsertMsgToInsertData(msg, collSchema, false)
+}
+
+// shareOrCopy returns the src itself if share, otherwise a copy of it.
+func shareOrCopy[T any](src []T, share bool) []T {
+	if share {
+		return src
+	}
+	dst := make([]T, 0, len(src))
+	return append(dst, src...)
+}
+
+func columnBasedInsertMsgToInsertData(msg *msgstream.InsertMsg, collSchema *schemapb.CollectionSchema, share bool) (idata *InsertData, err error) {
 	srcFields := make(map[FieldID]*schemapb.FieldData)
 	for _, field := range msg.FieldsData {
 		srcFields[field.FieldId] = field
@@ -433,10 +446,9 @@ func ColumnBasedInsertMsgToInsertData(msg *msgstream.InsertMsg, collSchema *sche
 			srcData := srcFields[field.FieldID].GetVectors().GetFloatVector().GetData()
 
 			fieldData := &FloatVectorFieldData{
-				Data: make([]float32, 0, len(srcData)),
+				Data: shareOrCopy(srcData, share),
 				Dim:  dim,
 			}
-			fieldData.Data = append(fieldData.Data, srcData...)
 
 			idata.Data[field.FieldID] = fieldData
 
@@ -450,10 +462,9 @@ func ColumnBasedInsertMsgToInsertData(msg *msgstream.InsertMsg, collSchema *sche
 			srcData := srcFields[field.FieldID].GetVectors().GetBinaryVector()
 
 			fieldData := &BinaryVectorFieldData{
-				Data: make([]byte, 0, len(srcData)),
+				Data: shareOrCopy(srcData, share),
 				Dim:  dim,
 			}
-			fieldData.Data = append(fieldData.Data, srcData...)
 
 			idata.Data[field.FieldID] = fieldData
 
@@ -467,10 +478,9 @@ func ColumnBasedInsertMsgToInsertData(msg *msgstream.InsertMsg, collSchema *sche
 			srcData := srcFields[field.FieldID].GetVectors().GetFloat16Vector()
 
 			fieldData := &Float16VectorFieldData{
-				Data: make([]byte, 0, len(srcData)),
+				Data: shareOrCopy(srcData, share),
 				Dim:  dim,
 			}
-			fieldData.Data = append(fieldData.Data, srcData...)
 
 			idata.Data[field.FieldID] = fieldData
 
@@ -478,9 +488,8 @@ func ColumnBasedInsertMsgToInsertData(msg *msgstream.InsertMsg, collSchema *sche
 			srcData := srcFields[field.FieldID].GetScalars().GetBoolData().GetData()
 
 			fieldData := &BoolFieldData{
-				Data: make([]bool, 0, len(srcData)),
+				Data: shareOrCopy(srcData, share),
 			}
-			fieldData.Data = append(fieldData.Data, srcData...)
 
 			idata.Data[field.FieldID] = fieldData
 
@@ -516,9 +525,8 @@ func ColumnBasedInsertMsgToInsertData(msg *msgstream.InsertMsg, collSchema *sche
 			srcData := srcFields[field.FieldID].GetScalars().GetIntData().GetData()
 
 			fieldData := &Int32FieldData{
-				Data: make([]int32, 0, len(srcData)),
+				Data: shareOrCopy(srcData, share),
 			}
-			fieldData.Data = append(fieldData.Data, srcData...)
 
 			idata.Data[field.FieldID] = fieldData
 
@@ -529,8 +537,7 @@ func ColumnBasedInsertMsgToInsertData(msg *msgstream.InsertMsg, collSchema *sche
 
 			switch field.FieldID {
 			case 0: // rowIDs
-				fieldData.Data = make([]int64, 0, len(msg.RowIDs))
-				fieldData.Data = append(fieldData.Data, msg.RowIDs...)
+				fieldData.Data = shareOrCopy(msg.RowIDs, share)
 			case 1: // Timestamps
 				fieldData.Data = make([]int64, 0, len(msg.Timestamps))
 				for _, ts := range msg.Timestamps {
@@ -538,8 +545,7 @@ func ColumnBasedInsertMsgToInsertData(msg *msgstream.InsertMsg, collSchema *sche
 				}
 			default:
 				srcData := srcFields[field.FieldID].GetScalars().GetLongData().GetData()
-				fieldData.Data = make([]int64, 0, len(srcData))
-				fieldData.Data = append(fieldData.Data, srcData...)
+				fieldData.Data = shareOrCopy(srcData, share)
 			}
 
 			idata.Data[field.FieldID] = fieldData
@@ -548,9 +554,8 @@ func ColumnBasedInsertMsgToInsertData(msg *msgstream.InsertMsg, collSchema *sche
 			srcData := srcFields[field.FieldID].GetScalars().GetFloatData().GetData()
 
 			fieldData := &FloatFieldData{
-				Data: make([]float32, 0, len(srcData)),
+				Data: shareOrCopy(srcData, share),
 			}
-			fieldData.Data = append(fieldData.Data, srcData...)
 
 			idata.Data[field.FieldID] = fieldData
 
@@ -558,37 +563,30 @@ func ColumnBasedInsertMsgToInsertData(msg *msgstream.InsertMsg, collSchema *sche
 			srcData := srcFields[field.FieldID].GetScalars().GetDoubleData().GetData()
 
 			fieldData := &DoubleFieldData{
-				Data: make([]float64, 0, len(srcData)),
+				Data: shareOrCopy(srcData, share),
 			}
-			fieldData.Data = append(fieldData.Data, srcData...)
 
 			idata.Data[field.FieldID] = fieldData
 		case schemapb.DataType_String, schemapb.DataType_VarChar:
 			srcData := srcFields[field.FieldID].GetScalars().GetStringData().GetData()
 
 			fieldData := &StringFieldData{
-				Data: make([]string, 0, len(srcData)),
+				Data: shareOrCopy(srcData, share),
 			}
-
-			fieldData.Data = append(fieldData.Data, srcData...)
 			idata.Data[field.FieldID] = fieldData
 		case schemapb.DataType_Array:
 			srcData := srcFields[field.FieldID].GetScalars().GetArrayData().GetData()
 
 			fieldData := &ArrayFieldData{
-				Data: make([]*schemapb.ScalarField, 0, len(srcData)),
+				Data: shareOrCopy(srcData, share),
 			}
-
-			fieldData.Data = append(fieldData.Data, srcData...)
 			idata.Data[field.FieldID] = fieldData
 		case schemapb.DataType_JSON:
 			srcData := srcFields[field.FieldID].GetScalars().GetJsonData().GetData()
 
 			fieldData := &JSONFieldData{
-				Data: make([][]byte, 0, len(srcData)),
+				Data: shareOrCopy(srcData, share),
 			}
-
-			fieldData.Data = append(fieldData.Data, srcData...)
 			idata.Data[field.FieldID] = fieldData
 		}
 	}
@@ -603,6 +601,15 @@ func InsertMsgToInsertData(msg *msgstream.InsertMsg, schema *schemapb.Collection
 	return ColumnBasedInsertMsgToInsertData(msg, schema)
 }
 
+// InsertMsgToInsertDataShared is like InsertMsgToInsertData, but the column based insert data shares the memory with
+// the insert message instead of copying it, so neither of them could be modified afterwards.
+func InsertMsgToInsertDataShared(msg *msgstream.InsertMsg, schema *schemapb.CollectionSchema) (idata *InsertData, err error) {
+	if msg.IsRowBased() {
+		return RowBasedInsertMsgToInsertData(msg, schema)
+	}
+	return columnBasedInsertMsgToInsertData(msg, schema, true)
+}
+
 func mergeBoolField(data *InsertData, fid FieldID, field *BoolFieldData) {
 	if _, ok := data.Data[fid]; !ok {
 		fieldData := &BoolFieldData{
@@ -803,6 +810,68 @@ func MergeInsertData(buffer *InsertData, datas ...*InsertData) {
 	}
 }
 
+// ConcatInsertData concatenates the insert datas into a new one, the memory of each field is allocated once
+// with the total size, instead of growing on every merge.
+func ConcatInsertData(datas ...*InsertData) *InsertData {
+	rows := make(map[FieldID]int)
+	for _, data := range datas {
+		if data == nil {
+			continue
+		}
+		for fid, field := range data.Data {
+			rows[fid] += field.RowNum()
+		}
+	}
+
+	result := &InsertData{Data: make(map[FieldID]FieldData, len(rows))}
+	for _, data := range datas {
+		if data == nil {
+			continue
+		}
+		for fid, field := range data.Data {
+			if _, ok := result.Data[fid]; !ok {
+				if fieldData := newFieldDataWithCapacity(field, rows[fid]); fieldData != nil {
+					result.Data[fid] = fieldData
+				}
+			}
+			MergeFieldData(result, fid, field)
+		}
+	}
+	return result
+}
+
+func newFieldDataWithCapacity(field FieldData, rows int) FieldData {
+	switch field := field.(type) {
+	case *BoolFieldData:
+		return &BoolFieldData{Data: make([]bool, 0, rows)}
+	case *Int8FieldData:
+		return &Int8FieldData{Data: make([]int8, 0, rows)}
+	case *Int16FieldData:
+		return &Int16FieldData{Data: make([]int16, 0, rows)}
+	case *Int32FieldData:
+		return &Int32FieldData{Data: make([]int32, 0, rows)}
+	case *Int64FieldData:
+		return &Int64FieldData{Data: make([]int64, 0, rows)}
+	case *FloatFieldData:
+		return &FloatFieldData{Data: make([]float32, 0, rows)}
+	case *DoubleFieldData:
+		return &DoubleFieldData{Data: make([]float64, 0, rows)}
+	case *StringFieldData:
+		return &StringFieldData{Data: make([]string, 0, rows)}
+	case *ArrayFieldData:
+		return &ArrayFieldData{ElementType: field.ElementType, Data: make([]*schemapb.ScalarField, 0, rows)}
+	case *JSONFieldData:
+		return &JSONFieldData{Data: make([][]byte, 0, rows)}
+	case *BinaryVectorFieldData:
+		return &BinaryVectorFieldData{Data: make([]byte, 0, rows*field.Dim/8), Dim: field.Dim}
+	case *FloatVectorFieldData:
+		return &FloatVectorFieldData{Data: make([]float32, 0, rows*field.Dim), Dim: field.Dim}
+	case *Float16VectorFieldData:
+		return &Float16VectorFieldData{Data: make([]byte, 0, rows*field.Dim*2), Dim: field.Dim}
+	}
+	return nil
+}
+
 // TODO: string type.
 func GetPkFromInsertData(collSchema *schemapb.CollectionSchema, data *InsertData) (FieldData, error) {
 	helper, err := typeutil.CreateSchemaHelper(collSchema)
@@ -969,6 +969,64 @@ func TestInsertMsgToInsertData2(t *testing.T) {
 	}
 }
 
+func TestInsertMsgToInsertDataShared(t *testing.T) {
+	numRows, fVecDim, bVecDim, f16VecDim := 2, 2, 8, 2
+	schema, _, fieldIDs := genAllFieldsSchema(fVecDim, bVecDim, f16VecDim)
+	msg, _, columns := genColumnBasedInsertMsg(schema, numRows, fVecDim, bVecDim, f16VecDim)
+
+	idata, err := InsertMsgToInsertDataShared(msg, schema)
+	assert.NoError(t, err)
+	for idx, fID := range fieldIDs {
+		column := columns[idx]
+		fData, ok := idata.Data[fID]
+		assert.True(t, ok)
+		assert.Equal(t, len(column), fData.RowNum())
+		for j := range column {
+			assert.Equal(t, fData.GetRow(j), column[j])
+		}
+	}
+
+	// the data shares memory with the message
+	for _, field := range msg.FieldsData {
+		if vector := field.GetVectors().GetFloatVector(); vector != nil {
+			vector.Data[0] = 100
+			assert.Equal(t, float32(100), idata.Data[field.FieldId].(*FloatVectorFieldData).Data[0])
+		}
+	}
+}
+
+func TestConcatInsertData(t *testing.T) {
+	numRows, fVecDim, bVecDim, f16VecDim := 2, 2, 8, 2
+	schema, _, fieldIDs := genAllFieldsSchema(fVecDim, bVecDim, f16VecDim)
+	msg, _, columns := genColumnBasedInsertMsg(schema, numRows, fVecDim, bVecDim, f16VecDim)
+
+	d1, err := InsertMsgToInsertDataShared(msg, schema)
+	assert.NoError(t, err)
+	d2, err := InsertMsgToInsertDataShared(msg, schema)
+	assert.NoError(t, err)
+
+	merged := ConcatInsertData(d1, nil, d2)
+	for idx, fID := range fieldIDs {
+		column := columns[idx]
+		fData, ok := merged.Data[fID]
+		assert.True(t, ok)
+		assert.Equal(t, 2*len(column), fData.RowNum())
+		for j := range column {
+			assert.Equal(t, fData.GetRow(j), column[j])
+			assert.Equal(t, fData.GetRow(len(column)+j), column[j])
+		}
+	}
+
+	// the result doesn't share memory with the inputs
+	for _, field := range msg.FieldsData {
+		if vector := field.GetVectors().GetFloatVector(); vector != nil {
+			vector.Data[0] = 100
+			assert.NotEqual(t, float32(100), merged.Data[field.FieldId].(*FloatVectorFieldData).Data[0])
+		}
+	}
+	assert.Empty(t, ConcatInsertData().Data)
+}
+
 func TestMergeInsertData(t *testing.T) {
 	d1 := &InsertData{
 		Data: map[int64]FieldData{
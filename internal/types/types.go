@@ -258,6 +258,48 @@ type ProxyComponent interface {
 	// UpdateStateCode updates state code for Proxy
 	//  `stateCode` is current statement of this proxy node, indicating whether it's healthy.
 	UpdateStateCode(stateCode commonpb.StateCode)
+
+	// CancelDDLTask cancels the pending ddl task queued in rootcoord
+	CancelDDLTask(ctx context.Context, req *rootcoordpb.CancelDDLTaskRequest) (*commonpb.Status, error)
+
+	// CancelCompactionPlan cancels the running compaction plan in datacoord
+	CancelCompactionPlan(ctx context.Context, req *datapb.CancelCompactionPlanRequest) (*commonpb.Status, error)
+
+	// OperateStorageMigration starts or cancels the storage migration of the collection in datacoord
+	OperateStorageMigration(ctx context.Context, req *datapb.OperateStorageMigrationRequest) (*commonpb.Status, error)
+
+	// OperateLoadSchedule puts or removes the load schedule of the collection in querycoord
+	OperateLoadSchedule(ctx context.Context, req *querypb.OperateLoadScheduleRequest) (*commonpb.Status, error)
+
+	// UpdateLoadConfig updates the replica number, resource groups and mmap setting of the loaded collection in querycoord
+	UpdateLoadConfig(ctx context.Context, req *querypb.UpdateLoadConfigRequest) (*commonpb.Status, error)
+
+	// OperateCollectionTrash restores or purges the dropped collection kept in the trash of rootcoord
+	OperateCollectionTrash(ctx context.Context, req *rootcoordpb.OperateCollectionTrashRequest) (*commonpb.Status, error)
+
+	// OperateCollectionTemplate creates or drops the collection template in rootcoord
+	OperateCollectionTemplate(ctx context.Context, req *rootcoordpb.OperateCollectionTemplateRequest) (*commonpb.Status, error)
+
+	// CreateCollectionFromTemplate creates the collection from the collection template in rootcoord
+	CreateCollectionFromTemplate(ctx context.Context, req *rootcoordpb.CreateCollectionFromTemplateRequest) (*commonpb.Status, error)
+
+	// OperateRoleInheritance grants or revokes the parent role to the role in rootcoord
+	OperateRoleInheritance(ctx context.Context, req *rootcoordpb.OperateRoleInheritanceRequest) (*commonpb.Status, error)
+
+	// ListRoleInheritances lists the parent roles of each role in rootcoord
+	ListRoleInheritances(ctx context.Context, req *rootcoordpb.ListRoleInheritancesRequest) (*rootcoordpb.ListRoleInheritancesResponse, error)
+
+	// OperateAPIKey creates, rotates or revokes the api key in rootcoord
+	OperateAPIKey(ctx context.Context, req *rootcoordpb.OperateAPIKeyRequest) (*rootcoordpb.OperateAPIKeyResponse, error)
+
+	// ListAPIKeys lists the api keys in rootcoord
+	ListAPIKeys(ctx context.Context, req *rootcoordpb.ListAPIKeysRequest) (*rootcoordpb.ListAPIKeysResponse, error)
+
+	// ListSessions lists the sessions established by Connect in proxy
+	ListSessions(ctx context.Context, req *proxypb.ListSessionsRequest) (*proxypb.ListSessionsResponse, error)
+
+	// KillSession terminates the session established by Connect in proxy
+	KillSession(ctx context.Context, req *proxypb.KillSessionRequest) (*commonpb.Status, error)
 }
 
 type QueryNodeClient interface {
@@ -30,6 +30,7 @@ import (
 // TimeTickedFlowGraph flowgraph with input from tt msg stream
 type TimeTickedFlowGraph struct {
 	nodeCtx         map[NodeName]*nodeCtx
+	nodeOrder       []NodeName
 	stopOnce        sync.Once
 	startOnce       sync.Once
 	closeWg         *sync.WaitGroup
@@ -43,9 +44,36 @@ func (fg *TimeTickedFlowGraph) AddNode(node Node) {
 		closeCh: make(chan struct{}),
 		closeWg: fg.closeWg,
 	}
+	if _, ok := fg.nodeCtx[node.Name()]; !ok {
+		fg.nodeOrder = append(fg.nodeOrder, node.Name())
+	}
 	fg.nodeCtx[node.Name()] = &nodeCtx
 }
 
+// SetNodeObserver sets the observer called after each operation of all nodes, it must be set before Start.
+func (fg *TimeTickedFlowGraph) SetNodeObserver(observer NodeObserver) {
+	for _, v := range fg.nodeCtx {
+		v.observer = observer
+	}
+}
+
+// GetNode returns the node of the name, nil if not found.
+func (fg *TimeTickedFlowGraph) GetNode(name NodeName) Node {
+	if nodeCtx, ok := fg.nodeCtx[name]; ok {
+		return nodeCtx.node
+	}
+	return nil
+}
+
+// Stats returns the statistics of all nodes in the order they are added.
+func (fg *TimeTickedFlowGraph) Stats() []NodeStats {
+	stats := make([]NodeStats, 0, len(fg.nodeOrder))
+	for _, name := range fg.nodeOrder {
+		stats = append(stats, fg.nodeCtx[name].stats())
+	}
+	return stats
+}
+
 // SetEdges set directed edges from in nodes to out nodes
 func (fg *TimeTickedFlowGraph) SetEdges(nodeName string, out []string) error {
 	currentNode, ok := fg.nodeCtx[nodeName]
@@ -219,3 +219,35 @@ func TestTimeTickedFlowGraph_Close(t *testing.T) {
 	defer cancel()
 	fg.Close()
 }
+
+func TestTimeTickedFlowGraph_Stats(t *testing.T) {
+	fg, _, _, cancel, err := createExampleFlowGraph()
+	assert.NoError(t, err)
+	defer cancel()
+
+	fg.nodeCtx["NodeB"].nodeStats.record(10 * time.Millisecond)
+	fg.nodeCtx["NodeB"].nodeStats.record(30 * time.Millisecond)
+	fg.nodeCtx["NodeB"].inputChannel <- []Msg{&numMsg{}}
+
+	stats := fg.Stats()
+	assert.Len(t, stats, 3)
+	assert.Equal(t, "NodeA", stats[0].Name)
+	assert.Equal(t, "NodeB", stats[0].Downstream)
+	assert.Zero(t, stats[0].OperateCount)
+	assert.True(t, stats[0].LastOperateTime.IsZero())
+
+	assert.Equal(t, "NodeB", stats[1].Name)
+	assert.Equal(t, "NodeC", stats[1].Downstream)
+	assert.EqualValues(t, 2, stats[1].OperateCount)
+	assert.Equal(t, 20*time.Millisecond, stats[1].AvgLatency)
+	assert.Equal(t, 30*time.Millisecond, stats[1].LastLatency)
+	assert.Equal(t, 1, stats[1].QueueLength)
+	assert.Equal(t, 1024, stats[1].QueueCapacity)
+	assert.False(t, stats[1].LastOperateTime.IsZero())
+
+	assert.Equal(t, "NodeC", stats[2].Name)
+	assert.Empty(t, stats[2].Downstream)
+
+	assert.Equal(t, "NodeA", fg.GetNode("NodeA").Name())
+	assert.Nil(t, fg.GetNode("NodeD"))
+}
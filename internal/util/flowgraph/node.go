@@ -63,6 +63,9 @@ type nodeCtx struct {
 	closeWg *sync.WaitGroup
 
 	blockMutex sync.RWMutex
+
+	nodeStats nodeStats
+	observer  NodeObserver
 }
 
 // Start invoke Node `Start` method and start a worker goroutine
@@ -132,8 +135,14 @@ func (nodeCtx *nodeCtx) work() {
 				nodeCtx.blockMutex.RUnlock()
 				continue
 			}
+			start := time.Now()
 			output = n.Operate(input)
+			latency := time.Since(start)
 			nodeCtx.blockMutex.RUnlock()
+			nodeCtx.nodeStats.record(latency)
+			if nodeCtx.observer != nil {
+				nodeCtx.observer(n, latency, len(nodeCtx.inputChannel))
+			}
 			// the output decide whether the node should be closed.
 			if isCloseMsg(output) {
 				close(nodeCtx.closeCh)
@@ -0,0 +1,77 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package flowgraph
+
+import (
+	"time"
+
+	"go.uber.org/atomic"
+)
+
+// NodeObserver is called after each operation of the node, the latency of input node includes the time waiting for
+// the upstream messages. queueLength is the number of messages left in the input queue of the node.
+type NodeObserver func(node Node, latency time.Duration, queueLength int)
+
+// NodeStats is the snapshot of the statistics of a flowgraph node.
+type NodeStats struct {
+	Name          string        `json:"name"`
+	Downstream    string        `json:"downstream,omitempty"`
+	QueueLength   int           `json:"queue_length"`
+	QueueCapacity int           `json:"queue_capacity"`
+	OperateCount  int64         `json:"operate_count"`
+	AvgLatency    time.Duration `json:"avg_latency"`
+	LastLatency   time.Duration `json:"last_latency"`
+	// zero if never operated
+	LastOperateTime time.Time `json:"last_operate_time"`
+}
+
+// nodeStats records the operations of a node, it's updated by the worker of the node and read by others.
+type nodeStats struct {
+	operateCount atomic.Int64
+	totalLatency atomic.Int64
+	lastLatency  atomic.Int64
+	lastOperate  atomic.Int64
+}
+
+func (s *nodeStats) record(latency time.Duration) {
+	s.operateCount.Inc()
+	s.totalLatency.Add(latency.Nanoseconds())
+	s.lastLatency.Store(latency.Nanoseconds())
+	s.lastOperate.Store(time.Now().UnixNano())
+}
+
+func (nodeCtx *nodeCtx) stats() NodeStats {
+	stats := NodeStats{
+		Name:         nodeCtx.node.Name(),
+		QueueLength:  len(nodeCtx.inputChannel),
+		OperateCount: nodeCtx.nodeStats.operateCount.Load(),
+		LastLatency:  time.Duration(nodeCtx.nodeStats.lastLatency.Load()),
+	}
+	if nodeCtx.inputChannel != nil {
+		stats.QueueCapacity = cap(nodeCtx.inputChannel)
+	}
+	if nodeCtx.downstream != nil {
+		stats.Downstream = nodeCtx.downstream.node.Name()
+	}
+	if stats.OperateCount > 0 {
+		stats.AvgLatency = time.Duration(nodeCtx.nodeStats.totalLatency.Load() / stats.OperateCount)
+	}
+	if lastOperate := nodeCtx.nodeStats.lastOperate.Load(); lastOperate > 0 {
+		stats.LastOperateTime = time.Unix(0, lastOperate)
+	}
+	return stats
+}
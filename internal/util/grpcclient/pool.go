@@ -0,0 +1,228 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package grpcclient
+
+import (
+	"context"
+	"hash/fnv"
+	"strconv"
+	"sync"
+	"time"
+
+	"google.golang.org/grpc"
+
+	"github.com/milvus-io/milvus-proto/go-api/v2/milvuspb"
+	"github.com/milvus-io/milvus/internal/util/sessionutil"
+	"github.com/milvus-io/milvus/pkg/metrics"
+	"github.com/milvus-io/milvus/pkg/util/generic"
+	"github.com/milvus-io/milvus/pkg/util/merr"
+	"github.com/milvus-io/milvus/pkg/util/paramtable"
+)
+
+type poolKeyCtxKey struct{}
+
+// WithPoolKey returns a context whose grpc calls through ClientPool are routed by the key, like the database name.
+func WithPoolKey(ctx context.Context, key string) context.Context {
+	return context.WithValue(ctx, poolKeyCtxKey{}, key)
+}
+
+// GetPoolKey returns the pool key of the context, empty if not set.
+func GetPoolKey(ctx context.Context) string {
+	if key, ok := ctx.Value(poolKeyCtxKey{}).(string); ok {
+		return key
+	}
+	return ""
+}
+
+var _ GrpcClient[milvuspb.MilvusServiceClient] = (*ClientPool[milvuspb.MilvusServiceClient])(nil)
+
+// ClientPool spreads the grpc calls over several connections by the pool key of the context,
+// so the heavy calls of one key can't exhaust the streams of the connection shared by others.
+// The calls without key are sent by the first connection, which is reserved for them if there are more than one connections.
+// The concurrent calls of each key are limited by maxConcurrencyPerKey, the exceeded calls wait in queue.
+type ClientPool[T interface {
+	GetComponentStates(ctx context.Context, in *milvuspb.GetComponentStatesRequest, opts ...grpc.CallOption) (*milvuspb.ComponentStates, error)
+}] struct {
+	clients              []*ClientBase[T]
+	maxConcurrencyPerKey int
+
+	limitersMu sync.Mutex
+	limiters   map[string]chan struct{}
+}
+
+// NewClientPool creates a pool of size connections.
+func NewClientPool[T interface {
+	GetComponentStates(ctx context.Context, in *milvuspb.GetComponentStatesRequest, opts ...grpc.CallOption) (*milvuspb.ComponentStates, error)
+}](config *paramtable.GrpcClientConfig, serviceName string, size int, maxConcurrencyPerKey int,
+) *ClientPool[T] {
+	if size < 1 {
+		size = 1
+	}
+	clients := make([]*ClientBase[T], 0, size)
+	for i := 0; i < size; i++ {
+		clients = append(clients, NewClientBase[T](config, serviceName))
+	}
+	return &ClientPool[T]{
+		clients:              clients,
+		maxConcurrencyPerKey: maxConcurrencyPerKey,
+		limiters:             make(map[string]chan struct{}),
+	}
+}
+
+// pick returns the client of the key
+func (p *ClientPool[T]) pick(key string) *ClientBase[T] {
+	if key == "" || len(p.clients) == 1 {
+		return p.clients[0]
+	}
+	h := fnv.New32a()
+	h.Write([]byte(key))
+	return p.clients[1+int(h.Sum32()%uint32(len(p.clients)-1))]
+}
+
+func (p *ClientPool[T]) getLimiter(key string) chan struct{} {
+	p.limitersMu.Lock()
+	defer p.limitersMu.Unlock()
+	limiter, ok := p.limiters[key]
+	if !ok {
+		limiter = make(chan struct{}, p.maxConcurrencyPerKey)
+		p.limiters[key] = limiter
+	}
+	return limiter
+}
+
+// acquire waits for the concurrency quota of the key, the returned function releases the quota.
+func (p *ClientPool[T]) acquire(ctx context.Context, key string) (func(), error) {
+	if key == "" || p.maxConcurrencyPerKey <= 0 {
+		return func() {}, nil
+	}
+	nodeID := strconv.FormatInt(paramtable.GetNodeID(), 10)
+	role := p.GetRole()
+	limiter := p.getLimiter(key)
+
+	select {
+	case limiter <- struct{}{}:
+	default:
+		queueLength := metrics.ProxyCoordClientQueueLength.WithLabelValues(nodeID, role, key)
+		queueLength.Inc()
+		start := time.Now()
+		select {
+		case limiter <- struct{}{}:
+			queueLength.Dec()
+			metrics.ProxyCoordClientQueueLatency.WithLabelValues(nodeID, role, key).
+				Observe(float64(time.Since(start).Milliseconds()))
+		case <-ctx.Done():
+			queueLength.Dec()
+			return nil, ctx.Err()
+		}
+	}
+
+	inflight := metrics.ProxyCoordClientInflight.WithLabelValues(nodeID, role, key)
+	inflight.Inc()
+	return func() {
+		inflight.Dec()
+		<-limiter
+	}, nil
+}
+
+// SetRole sets role of clients
+func (p *ClientPool[T]) SetRole(role string) {
+	for _, c := range p.clients {
+		c.SetRole(role)
+	}
+}
+
+// GetRole returns role of clients
+func (p *ClientPool[T]) GetRole() string {
+	return p.clients[0].GetRole()
+}
+
+// SetGetAddrFunc sets getAddrFunc of clients
+func (p *ClientPool[T]) SetGetAddrFunc(f func() (string, error)) {
+	for _, c := range p.clients {
+		c.SetGetAddrFunc(f)
+	}
+}
+
+func (p *ClientPool[T]) EnableEncryption() {
+	for _, c := range p.clients {
+		c.EnableEncryption()
+	}
+}
+
+// SetNewGrpcClientFunc sets newGrpcClient of clients
+func (p *ClientPool[T]) SetNewGrpcClientFunc(f func(cc *grpc.ClientConn) T) {
+	for _, c := range p.clients {
+		c.SetNewGrpcClientFunc(f)
+	}
+}
+
+// GetGrpcClient returns grpc client of the pool key of context
+func (p *ClientPool[T]) GetGrpcClient(ctx context.Context) (T, error) {
+	return p.pick(GetPoolKey(ctx)).GetGrpcClient(ctx)
+}
+
+// ReCall does the grpc call by the client of the pool key of context
+func (p *ClientPool[T]) ReCall(ctx context.Context, caller func(client T) (any, error)) (any, error) {
+	key := GetPoolKey(ctx)
+	release, err := p.acquire(ctx, key)
+	if err != nil {
+		return generic.Zero[T](), err
+	}
+	defer release()
+	return p.pick(key).ReCall(ctx, caller)
+}
+
+// Call does a grpc call by the client of the pool key of context
+func (p *ClientPool[T]) Call(ctx context.Context, caller func(client T) (any, error)) (any, error) {
+	key := GetPoolKey(ctx)
+	release, err := p.acquire(ctx, key)
+	if err != nil {
+		return generic.Zero[T](), err
+	}
+	defer release()
+	return p.pick(key).Call(ctx, caller)
+}
+
+// Close closes all the connections
+func (p *ClientPool[T]) Close() error {
+	errs := make([]error, 0)
+	for _, c := range p.clients {
+		if err := c.Close(); err != nil {
+			errs = append(errs, err)
+		}
+	}
+	return merr.Combine(errs...)
+}
+
+// SetNodeID set ID role of clients
+func (p *ClientPool[T]) SetNodeID(nodeID int64) {
+	for _, c := range p.clients {
+		c.SetNodeID(nodeID)
+	}
+}
+
+// GetNodeID returns ID of clients
+func (p *ClientPool[T]) GetNodeID() int64 {
+	return p.clients[0].GetNodeID()
+}
+
+// SetSession set session of clients
+func (p *ClientPool[T]) SetSession(sess *sessionutil.Session) {
+	for _, c := range p.clients {
+		c.SetSession(sess)
+	}
+}
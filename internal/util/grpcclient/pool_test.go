@@ -0,0 +1,120 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package grpcclient
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/cockroachdb/errors"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/milvus-io/milvus/pkg/util/paramtable"
+	"github.com/milvus-io/milvus/pkg/util/typeutil"
+)
+
+func newTestClientPool(size int, maxConcurrencyPerKey int) *ClientPool[*mockClient] {
+	pool := NewClientPool[*mockClient](&paramtable.Get().RootCoordGrpcClientCfg, "test", size, maxConcurrencyPerKey)
+	pool.SetRole(typeutil.RootCoordRole)
+	for _, c := range pool.clients {
+		c.grpcClient = &mockClient{}
+	}
+	return pool
+}
+
+func TestClientPool_PoolKey(t *testing.T) {
+	ctx := context.Background()
+	assert.Equal(t, "", GetPoolKey(ctx))
+	assert.Equal(t, "db1", GetPoolKey(WithPoolKey(ctx, "db1")))
+}
+
+func TestClientPool_Route(t *testing.T) {
+	pool := newTestClientPool(3, 0)
+	assert.Equal(t, typeutil.RootCoordRole, pool.GetRole())
+
+	pool.SetNodeID(100)
+	assert.EqualValues(t, 100, pool.GetNodeID())
+	for _, c := range pool.clients {
+		assert.EqualValues(t, 100, c.GetNodeID())
+	}
+
+	// the calls without key use the reserved connection
+	assert.Same(t, pool.clients[0], pool.pick(""))
+	for _, key := range []string{"db1", "db2", "default"} {
+		assert.NotSame(t, pool.clients[0], pool.pick(key))
+		assert.Same(t, pool.pick(key), pool.pick(key))
+
+		ctx := WithPoolKey(context.Background(), key)
+		_, err := pool.GetGrpcClient(ctx)
+		assert.NoError(t, err)
+		_, err = pool.ReCall(ctx, func(c *mockClient) (any, error) {
+			return struct{}{}, nil
+		})
+		assert.NoError(t, err)
+	}
+
+	// single connection is shared by all
+	pool = newTestClientPool(0, 0)
+	assert.Equal(t, 1, len(pool.clients))
+	assert.Same(t, pool.clients[0], pool.pick("db1"))
+}
+
+func TestClientPool_Concurrency(t *testing.T) {
+	pool := newTestClientPool(2, 1)
+
+	started := make(chan struct{})
+	block := make(chan struct{})
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		_, err := pool.Call(WithPoolKey(context.Background(), "db1"), func(c *mockClient) (any, error) {
+			close(started)
+			<-block
+			return struct{}{}, nil
+		})
+		assert.NoError(t, err)
+	}()
+	<-started
+
+	// the call of the same database waits for the quota
+	ctx, cancel := context.WithTimeout(WithPoolKey(context.Background(), "db1"), 50*time.Millisecond)
+	defer cancel()
+	_, err := pool.ReCall(ctx, func(c *mockClient) (any, error) {
+		return struct{}{}, nil
+	})
+	assert.True(t, errors.Is(err, context.DeadlineExceeded))
+
+	// the calls of other database and internal calls are not blocked
+	_, err = pool.ReCall(WithPoolKey(context.Background(), "db2"), func(c *mockClient) (any, error) {
+		return struct{}{}, nil
+	})
+	assert.NoError(t, err)
+	_, err = pool.ReCall(context.Background(), func(c *mockClient) (any, error) {
+		return struct{}{}, nil
+	})
+	assert.NoError(t, err)
+
+	close(block)
+	<-done
+	_, err = pool.ReCall(WithPoolKey(context.Background(), "db1"), func(c *mockClient) (any, error) {
+		return struct{}{}, nil
+	})
+	assert.NoError(t, err)
+
+	assert.NoError(t, pool.Close())
+}
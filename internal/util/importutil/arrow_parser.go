@@ -0,0 +1,322 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package importutil
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strconv"
+
+	"github.com/apache/arrow/go/v8/arrow"
+	"github.com/apache/arrow/go/v8/arrow/array"
+	"github.com/apache/arrow/go/v8/arrow/ipc"
+	"github.com/cockroachdb/errors"
+	"go.uber.org/zap"
+
+	"github.com/milvus-io/milvus-proto/go-api/v2/schemapb"
+	"github.com/milvus-io/milvus/internal/storage"
+	"github.com/milvus-io/milvus/pkg/log"
+)
+
+// ArrowParser parses the arrow IPC stream record batch by record batch,
+// only one record batch is held in memory, the rows are passed to the handler in the same way of the JSONParser.
+type ArrowParser struct {
+	ctx                context.Context     // for canceling parse process
+	collectionInfo     *CollectionInfo     // collection details including schema
+	bufRowCount        int                 // max rows in a buffer
+	updateProgressFunc func(percent int64) // update working progress percent value
+	rowParser          *JSONParser         // to verify the rows and combine the dynamic values
+}
+
+// NewArrowParser helper function to create an ArrowParser
+func NewArrowParser(ctx context.Context, collectionInfo *CollectionInfo, updateProgressFunc func(percent int64)) *ArrowParser {
+	rowParser := NewJSONParser(ctx, collectionInfo, nil)
+	return &ArrowParser{
+		ctx:                ctx,
+		collectionInfo:     collectionInfo,
+		bufRowCount:        rowParser.bufRowCount,
+		updateProgressFunc: updateProgressFunc,
+		rowParser:          rowParser,
+	}
+}
+
+// verifySchema checks the column names and types of the stream before reading any record batch
+func (p *ArrowParser) verifySchema(schema *arrow.Schema) error {
+	names := make([]string, 0, len(schema.Fields()))
+	for _, field := range schema.Fields() {
+		names = append(names, field.Name)
+		fieldID, ok := p.collectionInfo.Name2FieldID[field.Name]
+		if !ok {
+			// the redundant columns are combined into the dynamic field
+			if !isArrowScalarType(field.Type) {
+				return fmt.Errorf("arrow type %s of column '%s' is not supported for dynamic field", field.Type, field.Name)
+			}
+			continue
+		}
+		for _, fieldSchema := range p.collectionInfo.Schema.GetFields() {
+			if fieldSchema.GetFieldID() == fieldID {
+				if err := checkArrowType(fieldSchema, field.Type); err != nil {
+					return err
+				}
+				break
+			}
+		}
+	}
+	return verifyColumnNames(p.collectionInfo, names)
+}
+
+func isArrowScalarType(dt arrow.DataType) bool {
+	switch dt.ID() {
+	case arrow.BOOL, arrow.INT8, arrow.INT16, arrow.INT32, arrow.INT64, arrow.FLOAT32, arrow.FLOAT64, arrow.STRING:
+		return true
+	default:
+		return false
+	}
+}
+
+// checkArrowType checks whether the values of the arrow type could be converted to the field
+func checkArrowType(schema *schemapb.FieldSchema, dt arrow.DataType) error {
+	accepted := false
+	switch schema.GetDataType() {
+	case schemapb.DataType_Bool:
+		accepted = dt.ID() == arrow.BOOL
+	case schemapb.DataType_Int8:
+		accepted = dt.ID() == arrow.INT8
+	case schemapb.DataType_Int16:
+		accepted = dt.ID() == arrow.INT8 || dt.ID() == arrow.INT16
+	case schemapb.DataType_Int32:
+		accepted = dt.ID() == arrow.INT8 || dt.ID() == arrow.INT16 || dt.ID() == arrow.INT32
+	case schemapb.DataType_Int64:
+		accepted = dt.ID() == arrow.INT8 || dt.ID() == arrow.INT16 || dt.ID() == arrow.INT32 || dt.ID() == arrow.INT64
+	case schemapb.DataType_Float:
+		accepted = dt.ID() == arrow.FLOAT32
+	case schemapb.DataType_Double:
+		accepted = dt.ID() == arrow.FLOAT32 || dt.ID() == arrow.FLOAT64
+	case schemapb.DataType_String, schemapb.DataType_VarChar:
+		accepted = dt.ID() == arrow.STRING
+	case schemapb.DataType_JSON:
+		accepted = dt.ID() == arrow.STRING || dt.ID() == arrow.BINARY
+	case schemapb.DataType_FloatVector:
+		dim, err := getFieldDimension(schema)
+		if err != nil {
+			return err
+		}
+		switch t := dt.(type) {
+		case *arrow.FixedSizeListType:
+			accepted = t.Elem().ID() == arrow.FLOAT32 && int(t.Len()) == dim
+		case *arrow.ListType:
+			accepted = t.Elem().ID() == arrow.FLOAT32
+		}
+	case schemapb.DataType_BinaryVector:
+		dim, err := getFieldDimension(schema)
+		if err != nil {
+			return err
+		}
+		switch t := dt.(type) {
+		case *arrow.FixedSizeBinaryType:
+			accepted = t.ByteWidth*8 == dim
+		case *arrow.ListType:
+			accepted = t.Elem().ID() == arrow.UINT8
+		}
+	default:
+		return fmt.Errorf("unsupport data type: %s", getTypeName(schema.GetDataType()))
+	}
+
+	if !accepted {
+		return fmt.Errorf("arrow type %s of column '%s' doesn't match the data type %s of the field",
+			dt, schema.GetName(), getTypeName(schema.GetDataType()))
+	}
+	return nil
+}
+
+// arrowValueGetter returns the value of a row in the same form of the values decoded from json,
+// so that the values could be converted by the validators of the JSONRowConsumer
+type arrowValueGetter func(i int) interface{}
+
+func newArrowValueGetter(column arrow.Array) (arrowValueGetter, error) {
+	switch arr := column.(type) {
+	case *array.Boolean:
+		return func(i int) interface{} { return arr.Value(i) }, nil
+	case *array.Int8:
+		return func(i int) interface{} { return json.Number(strconv.FormatInt(int64(arr.Value(i)), 10)) }, nil
+	case *array.Int16:
+		return func(i int) interface{} { return json.Number(strconv.FormatInt(int64(arr.Value(i)), 10)) }, nil
+	case *array.Int32:
+		return func(i int) interface{} { return json.Number(strconv.FormatInt(int64(arr.Value(i)), 10)) }, nil
+	case *array.Int64:
+		return func(i int) interface{} { return json.Number(strconv.FormatInt(arr.Value(i), 10)) }, nil
+	case *array.Float32:
+		return func(i int) interface{} { return json.Number(strconv.FormatFloat(float64(arr.Value(i)), 'f', -1, 32)) }, nil
+	case *array.Float64:
+		return func(i int) interface{} { return json.Number(strconv.FormatFloat(arr.Value(i), 'f', -1, 64)) }, nil
+	case *array.String:
+		return func(i int) interface{} { return arr.Value(i) }, nil
+	case *array.Binary:
+		return func(i int) interface{} { return string(arr.Value(i)) }, nil
+	case *array.FixedSizeBinary:
+		return func(i int) interface{} { return bytesToNumbers(arr.Value(i)) }, nil
+	case *array.FixedSizeList:
+		values, ok := arr.ListValues().(*array.Float32)
+		if !ok {
+			return nil, fmt.Errorf("unsupported element type %s of fixed size list", arr.ListValues().DataType())
+		}
+		n := int(arr.DataType().(*arrow.FixedSizeListType).Len())
+		offset := arr.Data().Offset()
+		return func(i int) interface{} {
+			return float32sToNumbers(values.Float32Values()[(offset+i)*n : (offset+i+1)*n])
+		}, nil
+	case *array.List:
+		offsets := arr.Offsets()
+		offset := arr.Data().Offset()
+		switch values := arr.ListValues().(type) {
+		case *array.Float32:
+			return func(i int) interface{} {
+				return float32sToNumbers(values.Float32Values()[offsets[offset+i]:offsets[offset+i+1]])
+			}, nil
+		case *array.Uint8:
+			return func(i int) interface{} {
+				return bytesToNumbers(values.Uint8Values()[offsets[offset+i]:offsets[offset+i+1]])
+			}, nil
+		default:
+			return nil, fmt.Errorf("unsupported element type %s of list", arr.ListValues().DataType())
+		}
+	default:
+		return nil, fmt.Errorf("unsupported arrow type %s", column.DataType())
+	}
+}
+
+func float32sToNumbers(values []float32) []interface{} {
+	ret := make([]interface{}, 0, len(values))
+	for _, v := range values {
+		ret = append(ret, json.Number(strconv.FormatFloat(float64(v), 'f', -1, 32)))
+	}
+	return ret
+}
+
+func bytesToNumbers(values []byte) []interface{} {
+	ret := make([]interface{}, 0, len(values))
+	for _, v := range values {
+		ret = append(ret, json.Number(strconv.FormatUint(uint64(v), 10)))
+	}
+	return ret
+}
+
+func (p *ArrowParser) ParseRows(reader *IOReader, handler JSONRowHandler) error {
+	if handler == nil || reader == nil {
+		log.Warn("Arrow parse handler is nil")
+		return errors.New("Arrow parse handler is nil")
+	}
+
+	counter := &progressReader{r: reader.r}
+	oldPercent := int64(0)
+	updateProgress := func() {
+		if p.updateProgressFunc != nil && reader.fileSize > 0 {
+			percent := (counter.offset * ProgressValueForPersist) / reader.fileSize
+			if percent > oldPercent { // avoid too many log
+				log.Debug("Arrow parser: working progress", zap.Int64("offset", counter.offset),
+					zap.Int64("fileSize", reader.fileSize), zap.Int64("percent", percent))
+			}
+			oldPercent = percent
+			p.updateProgressFunc(percent)
+		}
+	}
+
+	streamReader, err := ipc.NewReader(counter)
+	if err != nil {
+		log.Warn("Arrow parser: failed to read the arrow IPC stream", zap.Error(err))
+		return fmt.Errorf("failed to read the arrow IPC stream, error: %w", err)
+	}
+	defer streamReader.Release()
+
+	if err = p.verifySchema(streamReader.Schema()); err != nil {
+		log.Warn("Arrow parser: schema of the stream doesn't match the collection", zap.Error(err))
+		return err
+	}
+
+	isEmpty := true
+	buf := make([]map[storage.FieldID]interface{}, 0, p.bufRowCount)
+	for streamReader.Next() {
+		record := streamReader.Record()
+		getters := make([]arrowValueGetter, record.NumCols())
+		for j, column := range record.Columns() {
+			if column.NullN() > 0 {
+				return fmt.Errorf("null value is not allowed, column '%s' has %d null values", record.ColumnName(j), column.NullN())
+			}
+			getters[j], err = newArrowValueGetter(column)
+			if err != nil {
+				return fmt.Errorf("failed to read column '%s', error: %w", record.ColumnName(j), err)
+			}
+		}
+
+		for i := 0; i < int(record.NumRows()); i++ {
+			value := make(map[string]interface{}, len(getters))
+			for j, getter := range getters {
+				value[record.ColumnName(j)] = getter(i)
+			}
+
+			row, err := p.rowParser.verifyRow(value)
+			if err != nil {
+				return err
+			}
+
+			buf = append(buf, row)
+			if len(buf) >= p.bufRowCount {
+				isEmpty = false
+				if err = handler.Handle(buf); err != nil {
+					log.Warn("Arrow parser: failed to convert row value to entity", zap.Error(err))
+					return fmt.Errorf("failed to convert row value to entity, error: %w", err)
+				}
+
+				// clear the buffer
+				buf = make([]map[storage.FieldID]interface{}, 0, p.bufRowCount)
+			}
+		}
+
+		updateProgress()
+
+		// outside context might be canceled(service stop, or future enhancement for canceling import task)
+		if isCanceled(p.ctx) {
+			log.Warn("Arrow parser: import task was canceled")
+			return errors.New("import task was canceled")
+		}
+	}
+	if err = streamReader.Err(); err != nil {
+		log.Warn("Arrow parser: failed to read record batch", zap.Error(err))
+		return fmt.Errorf("failed to read record batch, error: %w", err)
+	}
+
+	// some rows in buffer not parsed, parse them
+	if len(buf) > 0 {
+		isEmpty = false
+		if err = handler.Handle(buf); err != nil {
+			log.Warn("Arrow parser: failed to convert row value to entity", zap.Error(err))
+			return fmt.Errorf("failed to convert row value to entity, error: %w", err)
+		}
+	}
+
+	// empty stream is allowed, don't return error
+	if isEmpty {
+		log.Info("Arrow parser: row count is 0")
+		return nil
+	}
+
+	updateProgress()
+
+	// send nil to notify the handler all have done
+	return handler.Handle(nil)
+}
@@ -0,0 +1,254 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package importutil
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"testing"
+
+	"github.com/apache/arrow/go/v8/arrow"
+	"github.com/apache/arrow/go/v8/arrow/array"
+	"github.com/apache/arrow/go/v8/arrow/ipc"
+	"github.com/apache/arrow/go/v8/arrow/memory"
+	"github.com/cockroachdb/errors"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/milvus-io/milvus-proto/go-api/v2/schemapb"
+	"github.com/milvus-io/milvus/internal/storage"
+	"github.com/milvus-io/milvus/pkg/common"
+)
+
+// sampleArrowSchema is the arrow schema to represent sampleSchema() for testing
+func sampleArrowSchema() *arrow.Schema {
+	return arrow.NewSchema([]arrow.Field{
+		{Name: "FieldBool", Type: arrow.FixedWidthTypes.Boolean},
+		{Name: "FieldInt8", Type: arrow.PrimitiveTypes.Int8},
+		{Name: "FieldInt16", Type: arrow.PrimitiveTypes.Int16},
+		{Name: "FieldInt32", Type: arrow.PrimitiveTypes.Int32},
+		{Name: "FieldInt64", Type: arrow.PrimitiveTypes.Int64},
+		{Name: "FieldFloat", Type: arrow.PrimitiveTypes.Float32},
+		{Name: "FieldDouble", Type: arrow.PrimitiveTypes.Float64},
+		{Name: "FieldString", Type: arrow.BinaryTypes.String},
+		{Name: "FieldBinaryVector", Type: &arrow.FixedSizeBinaryType{ByteWidth: 2}},
+		{Name: "FieldFloatVector", Type: arrow.FixedSizeListOf(4, arrow.PrimitiveTypes.Float32)},
+		{Name: "FieldJSON", Type: arrow.BinaryTypes.String},
+	}, nil)
+}
+
+// createArrowStream writes the record batches of sampleArrowSchema(), each batch has rowCount rows
+func createArrowStream(t *testing.T, batchCount int, rowCount int) []byte {
+	mem := memory.NewGoAllocator()
+	schema := sampleArrowSchema()
+	buf := &bytes.Buffer{}
+	writer := ipc.NewWriter(buf, ipc.WithSchema(schema), ipc.WithAllocator(mem))
+
+	builder := array.NewRecordBuilder(mem, schema)
+	defer builder.Release()
+	for b := 0; b < batchCount; b++ {
+		for r := 0; r < rowCount; r++ {
+			i := b*rowCount + r
+			builder.Field(0).(*array.BooleanBuilder).Append(i%2 == 0)
+			builder.Field(1).(*array.Int8Builder).Append(int8(i))
+			builder.Field(2).(*array.Int16Builder).Append(int16(100 + i))
+			builder.Field(3).(*array.Int32Builder).Append(int32(1000 + i))
+			builder.Field(4).(*array.Int64Builder).Append(int64(10000 + i))
+			builder.Field(5).(*array.Float32Builder).Append(float32(i) + 0.5)
+			builder.Field(6).(*array.Float64Builder).Append(float64(i) + 0.25)
+			builder.Field(7).(*array.StringBuilder).Append(fmt.Sprintf("No.%d", i))
+			builder.Field(8).(*array.FixedSizeBinaryBuilder).Append([]byte{byte(i), 0})
+			listBuilder := builder.Field(9).(*array.FixedSizeListBuilder)
+			listBuilder.Append(true)
+			listBuilder.ValueBuilder().(*array.Float32Builder).AppendValues([]float32{float32(i), 0.1, 0.2, 0.3}, nil)
+			builder.Field(10).(*array.StringBuilder).Append(fmt.Sprintf("{\"x\": %d}", i))
+		}
+		record := builder.NewRecord()
+		assert.NoError(t, writer.Write(record))
+		record.Release()
+	}
+	assert.NoError(t, writer.Close())
+	return buf.Bytes()
+}
+
+func Test_ArrowParserParseRows(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	collectionInfo, err := NewCollectionInfo(sampleSchema(), 2, []int64{1})
+	assert.NoError(t, err)
+	content := createArrowStream(t, 3, 5)
+
+	t.Run("parse success", func(t *testing.T) {
+		parser := NewArrowParser(ctx, collectionInfo, nil)
+		// set bufRowCount = 4, means call handle() after reading 4 rows, across the record batches
+		parser.bufRowCount = 4
+		consumer := &mockJSONRowConsumer{}
+		err := parser.ParseRows(&IOReader{r: bytes.NewReader(content), fileSize: int64(len(content))}, consumer)
+		assert.NoError(t, err)
+		assert.Equal(t, 15, len(consumer.rows))
+		// 15 rows in 4 buffers, plus the final nil
+		assert.Equal(t, 5, consumer.handleCount)
+
+		row := consumer.rows[7]
+		assert.Equal(t, false, row[102])
+		assert.Equal(t, json.Number("7"), row[103])
+		assert.Equal(t, json.Number("107"), row[104])
+		assert.Equal(t, json.Number("1007"), row[105])
+		assert.Equal(t, json.Number("10007"), row[106])
+		assert.Equal(t, json.Number("7.5"), row[107])
+		assert.Equal(t, json.Number("7.25"), row[108])
+		assert.Equal(t, "No.7", row[109])
+		assert.Equal(t, []interface{}{json.Number("7"), json.Number("0")}, row[110])
+		assert.Equal(t, []interface{}{json.Number("7"), json.Number("0.1"), json.Number("0.2"), json.Number("0.3")}, row[111])
+		assert.Equal(t, "{\"x\": 7}", row[112])
+	})
+
+	t.Run("empty stream", func(t *testing.T) {
+		empty := createArrowStream(t, 0, 0)
+		consumer := &mockJSONRowConsumer{}
+		err := NewArrowParser(ctx, collectionInfo, nil).ParseRows(&IOReader{r: bytes.NewReader(empty), fileSize: int64(len(empty))}, consumer)
+		assert.NoError(t, err)
+		assert.Equal(t, 0, consumer.handleCount)
+	})
+
+	t.Run("handle error", func(t *testing.T) {
+		consumer := &mockJSONRowConsumer{handleErr: errors.New("error")}
+		err := NewArrowParser(ctx, collectionInfo, nil).ParseRows(&IOReader{r: bytes.NewReader(content), fileSize: int64(len(content))}, consumer)
+		assert.Error(t, err)
+	})
+
+	t.Run("invalid input", func(t *testing.T) {
+		parser := NewArrowParser(ctx, collectionInfo, nil)
+		err := parser.ParseRows(nil, &mockJSONRowConsumer{})
+		assert.Error(t, err)
+		err = parser.ParseRows(&IOReader{r: bytes.NewReader(content)}, nil)
+		assert.Error(t, err)
+		err = parser.ParseRows(&IOReader{r: bytes.NewReader([]byte("dummy"))}, &mockJSONRowConsumer{})
+		assert.Error(t, err)
+	})
+
+	t.Run("canceled", func(t *testing.T) {
+		canceledCtx, cancelFunc := context.WithCancel(ctx)
+		cancelFunc()
+		err := NewArrowParser(canceledCtx, collectionInfo, nil).ParseRows(&IOReader{r: bytes.NewReader(content)}, &mockJSONRowConsumer{})
+		assert.Error(t, err)
+	})
+}
+
+func Test_ArrowParserVerifySchema(t *testing.T) {
+	ctx := context.Background()
+	collectionInfo, err := NewCollectionInfo(sampleSchema(), 2, []int64{1})
+	assert.NoError(t, err)
+	parser := NewArrowParser(ctx, collectionInfo, nil)
+
+	fields := sampleArrowSchema().Fields()
+	assert.NoError(t, parser.verifySchema(arrow.NewSchema(fields, nil)))
+
+	// column missed
+	assert.Error(t, parser.verifySchema(arrow.NewSchema(fields[1:], nil)))
+
+	// redundant column without dynamic field
+	withExtra := append(append([]arrow.Field{}, fields...), arrow.Field{Name: "extra", Type: arrow.PrimitiveTypes.Int32})
+	assert.Error(t, parser.verifySchema(arrow.NewSchema(withExtra, nil)))
+
+	// type mismatched
+	mismatched := append([]arrow.Field{}, fields...)
+	mismatched[1] = arrow.Field{Name: "FieldInt8", Type: arrow.PrimitiveTypes.Int64}
+	assert.Error(t, parser.verifySchema(arrow.NewSchema(mismatched, nil)))
+	mismatched = append([]arrow.Field{}, fields...)
+	mismatched[9] = arrow.Field{Name: "FieldFloatVector", Type: arrow.FixedSizeListOf(8, arrow.PrimitiveTypes.Float32)}
+	assert.Error(t, parser.verifySchema(arrow.NewSchema(mismatched, nil)))
+
+	// variable length list is accepted, the dimension is checked for each row
+	listed := append([]arrow.Field{}, fields...)
+	listed[8] = arrow.Field{Name: "FieldBinaryVector", Type: arrow.ListOf(arrow.PrimitiveTypes.Uint8)}
+	listed[9] = arrow.Field{Name: "FieldFloatVector", Type: arrow.ListOf(arrow.PrimitiveTypes.Float32)}
+	assert.NoError(t, parser.verifySchema(arrow.NewSchema(listed, nil)))
+
+	// dynamic field
+	schema := sampleSchema()
+	schema.EnableDynamicField = true
+	schema.Fields = append(schema.Fields, &schemapb.FieldSchema{
+		FieldID:   113,
+		Name:      common.MetaFieldName,
+		DataType:  schemapb.DataType_JSON,
+		IsDynamic: true,
+	})
+	collectionInfo, err = NewCollectionInfo(schema, 2, []int64{1})
+	assert.NoError(t, err)
+	parser = NewArrowParser(ctx, collectionInfo, nil)
+	assert.NoError(t, parser.verifySchema(arrow.NewSchema(withExtra, nil)))
+	withList := append(append([]arrow.Field{}, fields...), arrow.Field{Name: "extra", Type: arrow.ListOf(arrow.PrimitiveTypes.Int32)})
+	assert.Error(t, parser.verifySchema(arrow.NewSchema(withList, nil)))
+
+	// auto-generated primary key provided
+	schema = sampleSchema()
+	schema.Fields[4].AutoID = true
+	collectionInfo, err = NewCollectionInfo(schema, 2, []int64{1})
+	assert.NoError(t, err)
+	parser = NewArrowParser(ctx, collectionInfo, nil)
+	assert.Error(t, parser.verifySchema(arrow.NewSchema(fields, nil)))
+}
+
+func Test_ArrowParserDynamicField(t *testing.T) {
+	ctx := context.Background()
+	schema := sampleSchema()
+	schema.EnableDynamicField = true
+	schema.Fields = append(schema.Fields, &schemapb.FieldSchema{
+		FieldID:   113,
+		Name:      common.MetaFieldName,
+		DataType:  schemapb.DataType_JSON,
+		IsDynamic: true,
+	})
+	collectionInfo, err := NewCollectionInfo(schema, 2, []int64{1})
+	assert.NoError(t, err)
+
+	mem := memory.NewGoAllocator()
+	arrowSchema := arrow.NewSchema(append(sampleArrowSchema().Fields(),
+		arrow.Field{Name: "extra", Type: arrow.PrimitiveTypes.Int32}), nil)
+	builder := array.NewRecordBuilder(mem, arrowSchema)
+	defer builder.Release()
+	builder.Field(0).(*array.BooleanBuilder).Append(true)
+	builder.Field(1).(*array.Int8Builder).Append(1)
+	builder.Field(2).(*array.Int16Builder).Append(2)
+	builder.Field(3).(*array.Int32Builder).Append(3)
+	builder.Field(4).(*array.Int64Builder).Append(4)
+	builder.Field(5).(*array.Float32Builder).Append(5)
+	builder.Field(6).(*array.Float64Builder).Append(6)
+	builder.Field(7).(*array.StringBuilder).Append("7")
+	builder.Field(8).(*array.FixedSizeBinaryBuilder).Append([]byte{8, 0})
+	listBuilder := builder.Field(9).(*array.FixedSizeListBuilder)
+	listBuilder.Append(true)
+	listBuilder.ValueBuilder().(*array.Float32Builder).AppendValues([]float32{9, 9, 9, 9}, nil)
+	builder.Field(10).(*array.StringBuilder).Append("{}")
+	builder.Field(11).(*array.Int32Builder).Append(11)
+	record := builder.NewRecord()
+	defer record.Release()
+
+	buf := &bytes.Buffer{}
+	writer := ipc.NewWriter(buf, ipc.WithSchema(arrowSchema), ipc.WithAllocator(mem))
+	assert.NoError(t, writer.Write(record))
+	assert.NoError(t, writer.Close())
+
+	consumer := &mockJSONRowConsumer{}
+	err = NewArrowParser(ctx, collectionInfo, nil).ParseRows(&IOReader{r: buf, fileSize: int64(buf.Len())}, consumer)
+	assert.NoError(t, err)
+	assert.Equal(t, 1, len(consumer.rows))
+	assert.Equal(t, map[string]interface{}{"extra": json.Number("11")}, consumer.rows[0][storage.FieldID(113)])
+}
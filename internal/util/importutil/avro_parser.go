@@ -0,0 +1,374 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package importutil
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strconv"
+
+	"github.com/cockroachdb/errors"
+	"github.com/linkedin/goavro/v2"
+	"go.uber.org/zap"
+
+	"github.com/milvus-io/milvus-proto/go-api/v2/schemapb"
+	"github.com/milvus-io/milvus/internal/storage"
+	"github.com/milvus-io/milvus/pkg/log"
+)
+
+// avroType is the parsed type of an avro record field, only the types mapped to the milvus data types are recognized
+type avroType struct {
+	name  string // primitive type name, "array" or "fixed"
+	items string // element type of array
+	size  int    // byte size of fixed
+}
+
+func (t avroType) String() string {
+	switch t.name {
+	case "array":
+		return "array<" + t.items + ">"
+	case "fixed":
+		return "fixed[" + strconv.Itoa(t.size) + "]"
+	default:
+		return t.name
+	}
+}
+
+type avroField struct {
+	Name string      `json:"name"`
+	Type interface{} `json:"type"`
+}
+
+type avroRecordSchema struct {
+	Type   string      `json:"type"`
+	Fields []avroField `json:"fields"`
+}
+
+// avroValueConverter converts the value decoded by goavro to the same form of the values decoded from json,
+// so that the values could be converted by the validators of the JSONRowConsumer
+type avroValueConverter func(value interface{}) (interface{}, error)
+
+// AvroParser parses the avro object container file block by block,
+// only one block is held in memory, the rows are passed to the handler in the same way of the JSONParser.
+type AvroParser struct {
+	ctx                context.Context     // for canceling parse process
+	collectionInfo     *CollectionInfo     // collection details including schema
+	bufRowCount        int                 // max rows in a buffer
+	updateProgressFunc func(percent int64) // update working progress percent value
+	rowParser          *JSONParser         // to verify the rows and combine the dynamic values
+}
+
+// NewAvroParser helper function to create an AvroParser
+func NewAvroParser(ctx context.Context, collectionInfo *CollectionInfo, updateProgressFunc func(percent int64)) *AvroParser {
+	rowParser := NewJSONParser(ctx, collectionInfo, nil)
+	return &AvroParser{
+		ctx:                ctx,
+		collectionInfo:     collectionInfo,
+		bufRowCount:        rowParser.bufRowCount,
+		updateProgressFunc: updateProgressFunc,
+		rowParser:          rowParser,
+	}
+}
+
+func parseAvroType(fieldName string, t interface{}) (avroType, error) {
+	switch v := t.(type) {
+	case string:
+		return avroType{name: v}, nil
+	case map[string]interface{}:
+		name, _ := v["type"].(string)
+		switch name {
+		case "array":
+			items, ok := v["items"].(string)
+			if !ok {
+				return avroType{}, fmt.Errorf("element type '%v' of array field '%s' is not supported", v["items"], fieldName)
+			}
+			return avroType{name: name, items: items}, nil
+		case "fixed":
+			size, ok := v["size"].(float64)
+			if !ok {
+				return avroType{}, fmt.Errorf("illegal size '%v' of fixed field '%s'", v["size"], fieldName)
+			}
+			return avroType{name: name, size: int(size)}, nil
+		default:
+			// primitive type with logical type annotation
+			return avroType{name: name}, nil
+		}
+	default:
+		// union types are not supported since null values are not allowed
+		return avroType{}, fmt.Errorf("avro type '%v' of field '%s' is not supported", t, fieldName)
+	}
+}
+
+// verifySchema checks the field names and types of the avro schema before reading any block,
+// returns the converters of the record fields
+func (p *AvroParser) verifySchema(schema string) (map[string]avroValueConverter, error) {
+	record := &avroRecordSchema{}
+	if err := json.Unmarshal([]byte(schema), record); err != nil {
+		return nil, fmt.Errorf("failed to parse avro schema, error: %w", err)
+	}
+	if record.Type != "record" {
+		return nil, fmt.Errorf("avro schema type should be 'record', but get '%s'", record.Type)
+	}
+
+	converters := make(map[string]avroValueConverter, len(record.Fields))
+	names := make([]string, 0, len(record.Fields))
+	for _, field := range record.Fields {
+		names = append(names, field.Name)
+		t, err := parseAvroType(field.Name, field.Type)
+		if err != nil {
+			return nil, err
+		}
+
+		var fieldSchema *schemapb.FieldSchema
+		if fieldID, ok := p.collectionInfo.Name2FieldID[field.Name]; ok {
+			for _, schema := range p.collectionInfo.Schema.GetFields() {
+				if schema.GetFieldID() == fieldID {
+					fieldSchema = schema
+					break
+				}
+			}
+		}
+		// the redundant fields are combined into the dynamic field
+		converters[field.Name], err = newAvroValueConverter(field.Name, fieldSchema, t)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	if err := verifyColumnNames(p.collectionInfo, names); err != nil {
+		return nil, err
+	}
+	return converters, nil
+}
+
+func newAvroValueConverter(fieldName string, schema *schemapb.FieldSchema, t avroType) (avroValueConverter, error) {
+	mismatch := func() error {
+		return fmt.Errorf("avro type %s of field '%s' doesn't match the data type %s of the field",
+			t, fieldName, getTypeName(schema.GetDataType()))
+	}
+
+	if schema == nil {
+		switch t.name {
+		case "boolean", "int", "long", "float", "double", "string":
+			return convertAvroScalar, nil
+		default:
+			return nil, fmt.Errorf("avro type %s of field '%s' is not supported for dynamic field", t, fieldName)
+		}
+	}
+
+	switch schema.GetDataType() {
+	case schemapb.DataType_Bool:
+		if t.name != "boolean" {
+			return nil, mismatch()
+		}
+		return convertAvroScalar, nil
+	case schemapb.DataType_Int8, schemapb.DataType_Int16, schemapb.DataType_Int32:
+		// out of range values are rejected by the validators
+		if t.name != "int" {
+			return nil, mismatch()
+		}
+		return convertAvroScalar, nil
+	case schemapb.DataType_Int64:
+		if t.name != "int" && t.name != "long" {
+			return nil, mismatch()
+		}
+		return convertAvroScalar, nil
+	case schemapb.DataType_Float:
+		if t.name != "float" {
+			return nil, mismatch()
+		}
+		return convertAvroScalar, nil
+	case schemapb.DataType_Double:
+		if t.name != "float" && t.name != "double" {
+			return nil, mismatch()
+		}
+		return convertAvroScalar, nil
+	case schemapb.DataType_String, schemapb.DataType_VarChar:
+		if t.name != "string" {
+			return nil, mismatch()
+		}
+		return convertAvroScalar, nil
+	case schemapb.DataType_JSON:
+		if t.name != "string" && t.name != "bytes" {
+			return nil, mismatch()
+		}
+		return func(value interface{}) (interface{}, error) {
+			if bs, ok := value.([]byte); ok {
+				return string(bs), nil
+			}
+			return value, nil
+		}, nil
+	case schemapb.DataType_FloatVector:
+		if t.name != "array" || (t.items != "float" && t.items != "double") {
+			return nil, mismatch()
+		}
+		return func(value interface{}) (interface{}, error) {
+			arr, ok := value.([]interface{})
+			if !ok {
+				return nil, fmt.Errorf("'%v' is not an array for float vector field '%s'", value, fieldName)
+			}
+			ret := make([]interface{}, 0, len(arr))
+			for _, v := range arr {
+				num, err := convertAvroScalar(v)
+				if err != nil {
+					return nil, err
+				}
+				ret = append(ret, num)
+			}
+			return ret, nil
+		}, nil
+	case schemapb.DataType_BinaryVector:
+		dim, err := getFieldDimension(schema)
+		if err != nil {
+			return nil, err
+		}
+		if t.name != "bytes" && (t.name != "fixed" || t.size*8 != dim) {
+			return nil, mismatch()
+		}
+		return func(value interface{}) (interface{}, error) {
+			bs, ok := value.([]byte)
+			if !ok {
+				return nil, fmt.Errorf("'%v' is not bytes for binary vector field '%s'", value, fieldName)
+			}
+			return bytesToNumbers(bs), nil
+		}, nil
+	default:
+		return nil, fmt.Errorf("unsupport data type: %s", getTypeName(schema.GetDataType()))
+	}
+}
+
+func convertAvroScalar(value interface{}) (interface{}, error) {
+	switch v := value.(type) {
+	case bool, string:
+		return v, nil
+	case int32:
+		return json.Number(strconv.FormatInt(int64(v), 10)), nil
+	case int64:
+		return json.Number(strconv.FormatInt(v, 10)), nil
+	case float32:
+		return json.Number(strconv.FormatFloat(float64(v), 'f', -1, 32)), nil
+	case float64:
+		return json.Number(strconv.FormatFloat(v, 'f', -1, 64)), nil
+	default:
+		return nil, fmt.Errorf("unsupported avro value '%v' of type %T", value, value)
+	}
+}
+
+func (p *AvroParser) ParseRows(reader *IOReader, handler JSONRowHandler) error {
+	if handler == nil || reader == nil {
+		log.Warn("Avro parse handler is nil")
+		return errors.New("Avro parse handler is nil")
+	}
+
+	counter := &progressReader{r: reader.r}
+	oldPercent := int64(0)
+	updateProgress := func() {
+		if p.updateProgressFunc != nil && reader.fileSize > 0 {
+			percent := (counter.offset * ProgressValueForPersist) / reader.fileSize
+			if percent > oldPercent { // avoid too many log
+				log.Debug("Avro parser: working progress", zap.Int64("offset", counter.offset),
+					zap.Int64("fileSize", reader.fileSize), zap.Int64("percent", percent))
+			}
+			oldPercent = percent
+			p.updateProgressFunc(percent)
+		}
+	}
+
+	ocfReader, err := goavro.NewOCFReader(counter)
+	if err != nil {
+		log.Warn("Avro parser: failed to read the avro file", zap.Error(err))
+		return fmt.Errorf("failed to read the avro file, error: %w", err)
+	}
+
+	converters, err := p.verifySchema(ocfReader.Codec().Schema())
+	if err != nil {
+		log.Warn("Avro parser: schema of the file doesn't match the collection", zap.Error(err))
+		return err
+	}
+
+	isEmpty := true
+	buf := make([]map[storage.FieldID]interface{}, 0, p.bufRowCount)
+	for ocfReader.Scan() {
+		datum, err := ocfReader.Read()
+		if err != nil {
+			log.Warn("Avro parser: failed to read record", zap.Error(err))
+			return fmt.Errorf("failed to read record, error: %w", err)
+		}
+		record, ok := datum.(map[string]interface{})
+		if !ok {
+			return errors.New("invalid avro record, each record should be a key-value map")
+		}
+
+		value := make(map[string]interface{}, len(record))
+		for k, v := range record {
+			value[k], err = converters[k](v)
+			if err != nil {
+				return err
+			}
+		}
+
+		row, err := p.rowParser.verifyRow(value)
+		if err != nil {
+			return err
+		}
+
+		buf = append(buf, row)
+		if len(buf) >= p.bufRowCount {
+			isEmpty = false
+			if err = handler.Handle(buf); err != nil {
+				log.Warn("Avro parser: failed to convert row value to entity", zap.Error(err))
+				return fmt.Errorf("failed to convert row value to entity, error: %w", err)
+			}
+
+			// clear the buffer
+			buf = make([]map[storage.FieldID]interface{}, 0, p.bufRowCount)
+
+			updateProgress()
+
+			// outside context might be canceled(service stop, or future enhancement for canceling import task)
+			if isCanceled(p.ctx) {
+				log.Warn("Avro parser: import task was canceled")
+				return errors.New("import task was canceled")
+			}
+		}
+	}
+	if err = ocfReader.Err(); err != nil {
+		log.Warn("Avro parser: failed to read block", zap.Error(err))
+		return fmt.Errorf("failed to read block, error: %w", err)
+	}
+
+	// some rows in buffer not parsed, parse them
+	if len(buf) > 0 {
+		isEmpty = false
+		if err = handler.Handle(buf); err != nil {
+			log.Warn("Avro parser: failed to convert row value to entity", zap.Error(err))
+			return fmt.Errorf("failed to convert row value to entity, error: %w", err)
+		}
+	}
+
+	// empty file is allowed, don't return error
+	if isEmpty {
+		log.Info("Avro parser: row count is 0")
+		return nil
+	}
+
+	updateProgress()
+
+	// send nil to notify the handler all have done
+	return handler.Handle(nil)
+}
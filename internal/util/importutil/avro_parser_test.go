@@ -0,0 +1,223 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package importutil
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/cockroachdb/errors"
+	"github.com/linkedin/goavro/v2"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/milvus-io/milvus-proto/go-api/v2/schemapb"
+	"github.com/milvus-io/milvus/internal/storage"
+	"github.com/milvus-io/milvus/pkg/common"
+)
+
+// sampleAvroSchema is the avro schema to represent sampleSchema() for testing
+const sampleAvroSchema = `{"type": "record", "name": "sample", "fields": [
+	{"name": "FieldBool", "type": "boolean"},
+	{"name": "FieldInt8", "type": "int"},
+	{"name": "FieldInt16", "type": "int"},
+	{"name": "FieldInt32", "type": "int"},
+	{"name": "FieldInt64", "type": "long"},
+	{"name": "FieldFloat", "type": "float"},
+	{"name": "FieldDouble", "type": "double"},
+	{"name": "FieldString", "type": "string"},
+	{"name": "FieldBinaryVector", "type": {"type": "fixed", "name": "bin", "size": 2}},
+	{"name": "FieldFloatVector", "type": {"type": "array", "items": "float"}},
+	{"name": "FieldJSON", "type": "bytes"}
+]}`
+
+func sampleAvroRecord(i int) map[string]interface{} {
+	return map[string]interface{}{
+		"FieldBool":         i%2 == 0,
+		"FieldInt8":         int32(i),
+		"FieldInt16":        int32(100 + i),
+		"FieldInt32":        int32(1000 + i),
+		"FieldInt64":        int64(10000 + i),
+		"FieldFloat":        float32(i) + 0.5,
+		"FieldDouble":       float64(i) + 0.25,
+		"FieldString":       fmt.Sprintf("No.%d", i),
+		"FieldBinaryVector": []byte{byte(i), 0},
+		"FieldFloatVector":  []float32{float32(i), 0.1, 0.2, 0.3},
+		"FieldJSON":         []byte(fmt.Sprintf("{\"x\": %d}", i)),
+	}
+}
+
+// createAvroFile writes the records into an avro object container file, each block has blockSize records
+func createAvroFile(t *testing.T, schema string, records []map[string]interface{}, blockSize int) []byte {
+	buf := &bytes.Buffer{}
+	writer, err := goavro.NewOCFWriter(goavro.OCFConfig{W: buf, Schema: schema})
+	assert.NoError(t, err)
+	for i := 0; i < len(records); i += blockSize {
+		end := i + blockSize
+		if end > len(records) {
+			end = len(records)
+		}
+		assert.NoError(t, writer.Append(records[i:end]))
+	}
+	return buf.Bytes()
+}
+
+func Test_AvroParserParseRows(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	collectionInfo, err := NewCollectionInfo(sampleSchema(), 2, []int64{1})
+	assert.NoError(t, err)
+	records := make([]map[string]interface{}, 0)
+	for i := 0; i < 15; i++ {
+		records = append(records, sampleAvroRecord(i))
+	}
+	content := createAvroFile(t, sampleAvroSchema, records, 5)
+
+	t.Run("parse success", func(t *testing.T) {
+		parser := NewAvroParser(ctx, collectionInfo, nil)
+		// set bufRowCount = 4, means call handle() after reading 4 rows, across the blocks
+		parser.bufRowCount = 4
+		consumer := &mockJSONRowConsumer{}
+		err := parser.ParseRows(&IOReader{r: bytes.NewReader(content), fileSize: int64(len(content))}, consumer)
+		assert.NoError(t, err)
+		assert.Equal(t, 15, len(consumer.rows))
+		// 15 rows in 4 buffers, plus the final nil
+		assert.Equal(t, 5, consumer.handleCount)
+
+		row := consumer.rows[7]
+		assert.Equal(t, false, row[102])
+		assert.Equal(t, json.Number("7"), row[103])
+		assert.Equal(t, json.Number("107"), row[104])
+		assert.Equal(t, json.Number("1007"), row[105])
+		assert.Equal(t, json.Number("10007"), row[106])
+		assert.Equal(t, json.Number("7.5"), row[107])
+		assert.Equal(t, json.Number("7.25"), row[108])
+		assert.Equal(t, "No.7", row[109])
+		assert.Equal(t, []interface{}{json.Number("7"), json.Number("0")}, row[110])
+		assert.Equal(t, []interface{}{json.Number("7"), json.Number("0.1"), json.Number("0.2"), json.Number("0.3")}, row[111])
+		assert.Equal(t, "{\"x\": 7}", row[112])
+	})
+
+	t.Run("empty file", func(t *testing.T) {
+		empty := createAvroFile(t, sampleAvroSchema, nil, 1)
+		consumer := &mockJSONRowConsumer{}
+		err := NewAvroParser(ctx, collectionInfo, nil).ParseRows(&IOReader{r: bytes.NewReader(empty), fileSize: int64(len(empty))}, consumer)
+		assert.NoError(t, err)
+		assert.Equal(t, 0, consumer.handleCount)
+	})
+
+	t.Run("handle error", func(t *testing.T) {
+		consumer := &mockJSONRowConsumer{handleErr: errors.New("error")}
+		err := NewAvroParser(ctx, collectionInfo, nil).ParseRows(&IOReader{r: bytes.NewReader(content), fileSize: int64(len(content))}, consumer)
+		assert.Error(t, err)
+	})
+
+	t.Run("invalid input", func(t *testing.T) {
+		parser := NewAvroParser(ctx, collectionInfo, nil)
+		err := parser.ParseRows(nil, &mockJSONRowConsumer{})
+		assert.Error(t, err)
+		err = parser.ParseRows(&IOReader{r: bytes.NewReader(content)}, nil)
+		assert.Error(t, err)
+		err = parser.ParseRows(&IOReader{r: bytes.NewReader([]byte("dummy"))}, &mockJSONRowConsumer{})
+		assert.Error(t, err)
+	})
+
+	t.Run("illegal value", func(t *testing.T) {
+		// the float vector dimension is checked by the consumer for each row
+		record := sampleAvroRecord(0)
+		record["FieldFloatVector"] = []float32{1, 2}
+		illegal := createAvroFile(t, sampleAvroSchema, []map[string]interface{}{record}, 1)
+		consumer, err := NewJSONRowConsumer(ctx, collectionInfo, newIDAllocator(ctx, t, nil), SingleBlockSize,
+			func(fields BlockData, shardID int, partID int64) error { return nil })
+		assert.NoError(t, err)
+		err = NewAvroParser(ctx, collectionInfo, nil).ParseRows(&IOReader{r: bytes.NewReader(illegal)}, consumer)
+		assert.Error(t, err)
+	})
+}
+
+func Test_AvroParserVerifySchema(t *testing.T) {
+	ctx := context.Background()
+	collectionInfo, err := NewCollectionInfo(sampleSchema(), 2, []int64{1})
+	assert.NoError(t, err)
+	parser := NewAvroParser(ctx, collectionInfo, nil)
+
+	converters, err := parser.verifySchema(sampleAvroSchema)
+	assert.NoError(t, err)
+	assert.Equal(t, 11, len(converters))
+
+	// not a record
+	_, err = parser.verifySchema(`{"type": "array", "items": "int"}`)
+	assert.Error(t, err)
+	_, err = parser.verifySchema(`dummy`)
+	assert.Error(t, err)
+
+	// column missed
+	_, err = parser.verifySchema(strings.Replace(sampleAvroSchema, `{"name": "FieldBool", "type": "boolean"},`, "", 1))
+	assert.Error(t, err)
+
+	// type mismatched
+	_, err = parser.verifySchema(strings.Replace(sampleAvroSchema, `{"name": "FieldInt8", "type": "int"}`, `{"name": "FieldInt8", "type": "long"}`, 1))
+	assert.Error(t, err)
+	_, err = parser.verifySchema(strings.Replace(sampleAvroSchema, `"size": 2`, `"size": 4`, 1))
+	assert.Error(t, err)
+
+	// union is not supported
+	_, err = parser.verifySchema(strings.Replace(sampleAvroSchema, `{"name": "FieldString", "type": "string"}`, `{"name": "FieldString", "type": ["null", "string"]}`, 1))
+	assert.Error(t, err)
+
+	// redundant column without dynamic field
+	withExtra := strings.Replace(sampleAvroSchema, `{"name": "FieldBool", "type": "boolean"},`, `{"name": "FieldBool", "type": "boolean"}, {"name": "extra", "type": "int"},`, 1)
+	_, err = parser.verifySchema(withExtra)
+	assert.Error(t, err)
+
+	// dynamic field
+	schema := sampleSchema()
+	schema.EnableDynamicField = true
+	schema.Fields = append(schema.Fields, &schemapb.FieldSchema{
+		FieldID:   113,
+		Name:      common.MetaFieldName,
+		DataType:  schemapb.DataType_JSON,
+		IsDynamic: true,
+	})
+	collectionInfo, err = NewCollectionInfo(schema, 2, []int64{1})
+	assert.NoError(t, err)
+	parser = NewAvroParser(ctx, collectionInfo, nil)
+	_, err = parser.verifySchema(withExtra)
+	assert.NoError(t, err)
+
+	record := sampleAvroRecord(1)
+	record["extra"] = int32(11)
+	content := createAvroFile(t, withExtra, []map[string]interface{}{record}, 1)
+	consumer := &mockJSONRowConsumer{}
+	err = parser.ParseRows(&IOReader{r: bytes.NewReader(content), fileSize: int64(len(content))}, consumer)
+	assert.NoError(t, err)
+	assert.Equal(t, 1, len(consumer.rows))
+	assert.Equal(t, map[string]interface{}{"extra": json.Number("11")}, consumer.rows[0][storage.FieldID(113)])
+
+	// auto-generated primary key provided
+	schema = sampleSchema()
+	schema.Fields[4].AutoID = true
+	collectionInfo, err = NewCollectionInfo(schema, 2, []int64{1})
+	assert.NoError(t, err)
+	parser = NewAvroParser(ctx, collectionInfo, nil)
+	_, err = parser.verifySchema(sampleAvroSchema)
+	assert.Error(t, err)
+}
@@ -428,6 +428,22 @@ func (p *BinlogAdapter) readDeltalog(logPath string) ([]string, error) {
 		log.Warn("Binlog adapter: failed to read delta log", zap.String("logPath", logPath), zap.Error(err))
 		return nil, fmt.Errorf("failed to read delta log '%s', error: %w", logPath, err)
 	}
+	// the deletes of delta log in roaring format are converted to the strings marshaled from storage.DeleteLog
+	if binlogFile.IsRoaringDeltalog() {
+		deleteData, err := storage.DeserializeRoaringDeltalog(data)
+		if err != nil {
+			log.Warn("Binlog adapter: failed to decode roaring delta log", zap.String("logPath", logPath), zap.Error(err))
+			return nil, fmt.Errorf("failed to decode roaring delta log '%s', error: %w", logPath, err)
+		}
+		data = make([]string, 0, len(deleteData.Pks))
+		for i, pk := range deleteData.Pks {
+			deltaBytes, err := json.Marshal(storage.NewDeleteLog(pk, deleteData.Tss[i]))
+			if err != nil {
+				return nil, err
+			}
+			data = append(data, string(deltaBytes))
+		}
+	}
 	log.Info("Binlog adapter: successfully read deltalog", zap.Int("deleteCount", len(data)))
 
 	return data, nil
@@ -74,6 +74,11 @@ func (p *BinlogFile) Open(filePath string) error {
 	return nil
 }
 
+// IsRoaringDeltalog returns true if the binlog is a delta log in roaring format
+func (p *BinlogFile) IsRoaringDeltalog() bool {
+	return p.reader != nil && p.reader.IsRoaringDeltalog()
+}
+
 // Close close the reader object, outer caller must call this method in defer
 func (p *BinlogFile) Close() {
 	if p.reader != nil {
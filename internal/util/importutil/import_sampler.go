@@ -0,0 +1,262 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package importutil
+
+import (
+	"bufio"
+	"context"
+	"fmt"
+	"strconv"
+
+	"github.com/cockroachdb/errors"
+	"go.uber.org/zap"
+
+	"github.com/milvus-io/milvus/internal/storage"
+	"github.com/milvus-io/milvus/pkg/log"
+)
+
+// at most maxSampleDiagnostics duplicated primary keys are reported
+const maxSampleDiagnostics = 10
+
+// errSampleDone is returned by the sample handler to stop the parser once enough rows are sampled
+var errSampleDone = errors.New("sample done")
+
+// SampleResult is the result of sampling the import files.
+type SampleResult struct {
+	SampledRows   int64
+	DuplicatedPKs int64
+	// row level diagnostics of the duplicated primary keys
+	Diagnostics []string
+}
+
+// DuplicateRatio returns the ratio of the sampled rows whose primary key is duplicated.
+func (r *SampleResult) DuplicateRatio() float64 {
+	if r.SampledRows == 0 {
+		return 0
+	}
+	return float64(r.DuplicatedPKs) / float64(r.SampledRows)
+}
+
+type importSampler struct {
+	ctx            context.Context
+	collectionInfo *CollectionInfo
+	chunkManager   storage.ChunkManager
+	sampleRows     int64
+
+	result *SampleResult
+	// the location of the first row of each sampled primary key
+	pks map[string]string
+}
+
+// SampleImportFiles parses the first sampleRows rows of each import file, to verify the files are compatible with the schema,
+// the vectors have the right dimension, and to count the duplicated primary keys.
+// An error is returned if the files can't be imported, the message locates the file and row.
+// The milvus binlog files of backup are not sampled, they are generated by milvus.
+func SampleImportFiles(ctx context.Context, collectionInfo *CollectionInfo, chunkManager storage.ChunkManager,
+	filePaths []string, sampleRows int64,
+) (*SampleResult, error) {
+	if collectionInfo == nil {
+		return nil, errors.New("collection schema is nil")
+	}
+	if chunkManager == nil {
+		return nil, errors.New("chunk manager pointer is nil")
+	}
+	if len(filePaths) == 0 {
+		return nil, errors.New("import files are empty")
+	}
+
+	s := &importSampler{
+		ctx:            ctx,
+		collectionInfo: collectionInfo,
+		chunkManager:   chunkManager,
+		sampleRows:     sampleRows,
+		result:         &SampleResult{},
+		pks:            make(map[string]string),
+	}
+
+	_, fileType := GetFileNameAndExt(filePaths[0])
+	var err error
+	if IsRowBasedFileType(fileType) {
+		for _, filePath := range filePaths {
+			if err = s.sampleRowBasedFile(filePath); err != nil {
+				break
+			}
+		}
+	} else {
+		err = s.sampleNumpyFiles(filePaths)
+	}
+	if err != nil {
+		log.Warn("import sampler: failed to sample import files", zap.Strings("files", filePaths), zap.Error(err))
+		return nil, err
+	}
+	return s.result, nil
+}
+
+func (s *importSampler) addPK(pk string, location string) {
+	first, ok := s.pks[pk]
+	if !ok {
+		s.pks[pk] = location
+		return
+	}
+	s.result.DuplicatedPKs++
+	if len(s.result.Diagnostics) < maxSampleDiagnostics {
+		s.result.Diagnostics = append(s.result.Diagnostics,
+			fmt.Sprintf("primary key '%s' at %s is duplicated with %s", pk, location, first))
+	}
+}
+
+func (s *importSampler) sampleRowBasedFile(filePath string) error {
+	_, fileType := GetFileNameAndExt(filePath)
+	if !IsRowBasedFileType(fileType) {
+		return fmt.Errorf("unsupported file type for row-based mode: '%s'", filePath)
+	}
+
+	file, err := s.chunkManager.Reader(s.ctx, filePath)
+	if err != nil {
+		return fmt.Errorf("failed to read the file '%s', error: %w", filePath, err)
+	}
+	defer file.Close()
+
+	size, err := s.chunkManager.Size(s.ctx, filePath)
+	if err != nil {
+		return fmt.Errorf("failed to get file size of '%s', error: %w", filePath, err)
+	}
+
+	handler, err := newSampleRowHandler(s, filePath)
+	if err != nil {
+		return err
+	}
+
+	reader := &IOReader{r: bufio.NewReader(file), fileSize: size}
+	switch fileType {
+	case ArrowFileExt:
+		err = NewArrowParser(s.ctx, s.collectionInfo, nil).ParseRows(reader, handler)
+	case AvroFileExt:
+		err = NewAvroParser(s.ctx, s.collectionInfo, nil).ParseRows(reader, handler)
+	default:
+		err = NewJSONParser(s.ctx, s.collectionInfo, nil).ParseRows(reader, handler)
+	}
+	if err != nil && !errors.Is(err, errSampleDone) {
+		return fmt.Errorf("failed to sample the file '%s', error: %w", filePath, err)
+	}
+	return nil
+}
+
+func (s *importSampler) sampleNumpyFiles(filePaths []string) error {
+	parser := &NumpyParser{
+		ctx:            s.ctx,
+		collectionInfo: s.collectionInfo,
+		chunkManager:   s.chunkManager,
+	}
+	if err := parser.validateFileNames(filePaths); err != nil {
+		return err
+	}
+
+	// the file headers are verified against the schema when the readers are created
+	readers, err := parser.createReaders(filePaths)
+	defer closeReaders(readers)
+	if err != nil {
+		return err
+	}
+
+	if len(readers) == 0 {
+		return nil
+	}
+	// the row count of files are verified to be equal
+	rowCount := readers[0].rowCount
+	if int64(rowCount) > s.sampleRows {
+		rowCount = int(s.sampleRows)
+	}
+	s.result.SampledRows += int64(rowCount)
+
+	primaryKey := s.collectionInfo.PrimaryKey
+	for _, reader := range readers {
+		data, err := parser.readData(reader, rowCount)
+		if err != nil {
+			return fmt.Errorf("failed to sample the file '%s%s', error: %w", reader.fieldName, NumpyFileExt, err)
+		}
+		if reader.fieldID != primaryKey.GetFieldID() {
+			continue
+		}
+		for i := 0; i < data.RowNum(); i++ {
+			s.addPK(fmt.Sprint(data.GetRow(i)), fmt.Sprintf("file '%s%s' row %d", reader.fieldName, NumpyFileExt, i))
+		}
+	}
+	return nil
+}
+
+// sampleRowHandler verifies the rows like JSONRowConsumer without generating any data,
+// it stops the parser by errSampleDone once enough rows are sampled.
+type sampleRowHandler struct {
+	sampler    *importSampler
+	filePath   string
+	validators map[storage.FieldID]*Validator
+	rowCounter int64
+}
+
+func newSampleRowHandler(sampler *importSampler, filePath string) (*sampleRowHandler, error) {
+	h := &sampleRowHandler{
+		sampler:    sampler,
+		filePath:   filePath,
+		validators: make(map[storage.FieldID]*Validator),
+	}
+	if err := initValidators(sampler.collectionInfo.Schema, h.validators); err != nil {
+		return nil, fmt.Errorf("fail to initialize validators, error: %w", err)
+	}
+	return h, nil
+}
+
+func (h *sampleRowHandler) Handle(rows []map[storage.FieldID]interface{}) error {
+	// rows is nil means read to end of file
+	if rows == nil {
+		return nil
+	}
+
+	// the converted values are dropped, the block is only the target of conversion
+	blockData := initBlockData(h.sampler.collectionInfo.Schema)
+	for _, row := range rows {
+		if h.rowCounter >= h.sampler.sampleRows {
+			return errSampleDone
+		}
+		rowNumber := h.rowCounter
+		for fieldID, validator := range h.validators {
+			if validator.primaryKey {
+				if validator.autoID {
+					continue
+				}
+				pk, err := getKeyValue(row[fieldID], validator.fieldName, validator.isString)
+				if err != nil {
+					return fmt.Errorf("failed to parse primary key at the row %d, error: %w", rowNumber, err)
+				}
+				if !validator.isString {
+					if _, err := strconv.ParseInt(pk, 10, 64); err != nil {
+						return fmt.Errorf("failed to parse primary key '%s' at the row %d, error: %w", pk, rowNumber, err)
+					}
+				}
+				h.sampler.addPK(pk, fmt.Sprintf("file '%s' row %d", h.filePath, rowNumber))
+				continue
+			}
+			if err := validator.convertFunc(row[fieldID], blockData[fieldID]); err != nil {
+				return fmt.Errorf("failed to convert value for field '%s' at the row %d, error: %w",
+					validator.fieldName, rowNumber, err)
+			}
+		}
+		h.rowCounter++
+		h.sampler.result.SampledRows++
+	}
+	return nil
+}
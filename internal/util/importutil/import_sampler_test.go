@@ -0,0 +1,120 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package importutil
+
+import (
+	"context"
+	"os"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_SampleImportFiles(t *testing.T) {
+	ctx := context.Background()
+	err := os.MkdirAll(TempFilesPath, os.ModePerm)
+	assert.NoError(t, err)
+	defer os.RemoveAll(TempFilesPath)
+
+	cm := createLocalChunkManager(t)
+	collectionInfo, err := NewCollectionInfo(strKeySchema(), 2, []int64{1})
+	assert.NoError(t, err)
+
+	t.Run("invalid params", func(t *testing.T) {
+		result, err := SampleImportFiles(ctx, nil, cm, []string{"rows.json"}, 10)
+		assert.Error(t, err)
+		assert.Nil(t, result)
+
+		result, err = SampleImportFiles(ctx, collectionInfo, nil, []string{"rows.json"}, 10)
+		assert.Error(t, err)
+		assert.Nil(t, result)
+
+		result, err = SampleImportFiles(ctx, collectionInfo, cm, []string{}, 10)
+		assert.Error(t, err)
+		assert.Nil(t, result)
+	})
+
+	t.Run("duplicated primary keys", func(t *testing.T) {
+		content := []byte(`{
+			"rows":[
+				{"UID": "a", "FieldInt32": 1, "FieldFloat": 1.1, "FieldString": "s1", "FieldBool": true, "FieldFloatVector": [1.1, 1.2, 1.3, 1.4]},
+				{"UID": "b", "FieldInt32": 2, "FieldFloat": 2.1, "FieldString": "s2", "FieldBool": false, "FieldFloatVector": [2.1, 2.2, 2.3, 2.4]},
+				{"UID": "a", "FieldInt32": 3, "FieldFloat": 3.1, "FieldString": "s3", "FieldBool": true, "FieldFloatVector": [3.1, 3.2, 3.3, 3.4]},
+				{"UID": "c", "FieldInt32": 4, "FieldFloat": 4.1, "FieldString": "s4", "FieldBool": false, "FieldFloatVector": [4.1, 4.2, 4.3, 4.4]}
+			]
+		}`)
+		filePath := TempFilesPath + "sample_dup.json"
+		err := cm.Write(ctx, filePath, content)
+		assert.NoError(t, err)
+
+		result, err := SampleImportFiles(ctx, collectionInfo, cm, []string{filePath}, 100)
+		assert.NoError(t, err)
+		assert.Equal(t, int64(4), result.SampledRows)
+		assert.Equal(t, int64(1), result.DuplicatedPKs)
+		assert.Equal(t, 0.25, result.DuplicateRatio())
+		assert.Equal(t, 1, len(result.Diagnostics))
+		assert.Contains(t, result.Diagnostics[0], "row 2")
+		assert.Contains(t, result.Diagnostics[0], "row 0")
+
+		// only the first 2 rows are sampled, no duplication found
+		result, err = SampleImportFiles(ctx, collectionInfo, cm, []string{filePath}, 2)
+		assert.NoError(t, err)
+		assert.Equal(t, int64(2), result.SampledRows)
+		assert.Equal(t, int64(0), result.DuplicatedPKs)
+		assert.Equal(t, float64(0), result.DuplicateRatio())
+	})
+
+	t.Run("dim mismatch", func(t *testing.T) {
+		content := []byte(`{
+			"rows":[
+				{"UID": "a", "FieldInt32": 1, "FieldFloat": 1.1, "FieldString": "s1", "FieldBool": true, "FieldFloatVector": [1.1, 1.2, 1.3, 1.4]},
+				{"UID": "b", "FieldInt32": 2, "FieldFloat": 2.1, "FieldString": "s2", "FieldBool": false, "FieldFloatVector": [2.1, 2.2, 2.3]}
+			]
+		}`)
+		filePath := TempFilesPath + "sample_dim.json"
+		err := cm.Write(ctx, filePath, content)
+		assert.NoError(t, err)
+
+		result, err := SampleImportFiles(ctx, collectionInfo, cm, []string{filePath}, 100)
+		assert.Error(t, err)
+		assert.Nil(t, result)
+		assert.Contains(t, err.Error(), "at the row 1")
+		assert.Contains(t, err.Error(), "FieldFloatVector")
+	})
+
+	t.Run("file not found", func(t *testing.T) {
+		result, err := SampleImportFiles(ctx, collectionInfo, cm, []string{TempFilesPath + "dummy.json"}, 100)
+		assert.Error(t, err)
+		assert.Nil(t, result)
+	})
+
+	t.Run("numpy files", func(t *testing.T) {
+		numpyInfo, err := NewCollectionInfo(sampleSchema(), 2, []int64{1})
+		assert.NoError(t, err)
+		files := createSampleNumpyFiles(t, cm)
+
+		result, err := SampleImportFiles(ctx, numpyInfo, cm, files, 3)
+		assert.NoError(t, err)
+		assert.Equal(t, int64(3), result.SampledRows)
+		assert.Equal(t, int64(0), result.DuplicatedPKs)
+
+		// a file is missing
+		result, err = SampleImportFiles(ctx, numpyInfo, cm, files[1:], 3)
+		assert.Error(t, err)
+		assert.Nil(t, result)
+	})
+}
@@ -20,6 +20,7 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"io"
 	"path"
 	"runtime/debug"
 	"strconv"
@@ -388,6 +389,52 @@ func GetFileNameAndExt(filePath string) (string, string) {
 	return fileNameWithoutExt, fileType
 }
 
+// IsRowBasedFileType returns true if the file contains whole rows, the row-based files are imported one by one
+func IsRowBasedFileType(fileType string) bool {
+	return fileType == JSONFileExt || fileType == ArrowFileExt || fileType == AvroFileExt
+}
+
+// verifyColumnNames checks the columns declared by the schema of arrow/avro files against the collection schema,
+// the rows of json files are checked one by one since the json files have no schema
+func verifyColumnNames(collectionInfo *CollectionInfo, columnNames []string) error {
+	provided := make(map[string]struct{}, len(columnNames))
+	for _, name := range columnNames {
+		provided[name] = struct{}{}
+		fieldID, ok := collectionInfo.Name2FieldID[name]
+		if ok && fieldID == collectionInfo.PrimaryKey.GetFieldID() && collectionInfo.PrimaryKey.GetAutoID() {
+			return fmt.Errorf("the primary key '%s' is auto-generated, no need to provide", name)
+		}
+		if !ok && collectionInfo.DynamicField == nil {
+			return fmt.Errorf("the field '%s' is not defined in collection schema", name)
+		}
+	}
+
+	for name, fieldID := range collectionInfo.Name2FieldID {
+		if collectionInfo.DynamicField != nil && fieldID == collectionInfo.DynamicField.GetFieldID() {
+			continue
+		}
+		if fieldID == collectionInfo.PrimaryKey.GetFieldID() && collectionInfo.PrimaryKey.GetAutoID() {
+			continue
+		}
+		if _, ok := provided[name]; !ok {
+			return fmt.Errorf("column of field '%s' is missed", name)
+		}
+	}
+	return nil
+}
+
+// progressReader counts the bytes consumed by the underlying decoder to estimate the working progress
+type progressReader struct {
+	r      io.Reader
+	offset int64
+}
+
+func (r *progressReader) Read(p []byte) (int, error) {
+	n, err := r.r.Read(p)
+	r.offset += int64(n)
+	return n, err
+}
+
 // getFieldDimension gets dimension of vecotor field
 func getFieldDimension(schema *schemapb.FieldSchema) (int, error) {
 	for _, kvPair := range schema.GetTypeParams() {
@@ -38,6 +38,8 @@ import (
 const (
 	JSONFileExt  = ".json"
 	NumpyFileExt = ".npy"
+	ArrowFileExt = ".arrows" // arrow IPC streaming format
+	AvroFileExt  = ".avro"   // avro object container file
 
 	// supposed size of a single block, to control a binlog file size, the max biglog file size is no more than 2*SingleBlockSize
 	SingleBlockSize = 16 * 1024 * 1024 // 16MB
@@ -165,7 +167,7 @@ func (p *ImportWrapper) Cancel() error {
 }
 
 // fileValidation verify the input paths
-// if all the files are json type, return true
+// if all the files are row-based type(json/arrow/avro), return true
 // if all the files are numpy type, return false, and not allow duplicate file name
 func (p *ImportWrapper) fileValidation(filePaths []string) (bool, error) {
 	// use this map to check duplicate file name(only for numpy file)
@@ -177,21 +179,21 @@ func (p *ImportWrapper) fileValidation(filePaths []string) (bool, error) {
 		filePath := filePaths[i]
 		name, fileType := GetFileNameAndExt(filePath)
 
-		// only allow json file or numpy file
-		if fileType != JSONFileExt && fileType != NumpyFileExt {
+		// only allow row-based file or numpy file
+		if !IsRowBasedFileType(fileType) && fileType != NumpyFileExt {
 			log.Warn("import wrapper: unsupported file type", zap.String("filePath", filePath))
 			return false, fmt.Errorf("unsupported file type: '%s'", filePath)
 		}
 
 		// we use the first file to determine row-based or column-based
-		if i == 0 && fileType == JSONFileExt {
+		if i == 0 && IsRowBasedFileType(fileType) {
 			rowBased = true
 		}
 
 		// check file type
-		// row-based only support json type, column-based only support numpy type
+		// row-based only support json/arrow/avro type, column-based only support numpy type
 		if rowBased {
-			if fileType != JSONFileExt {
+			if !IsRowBasedFileType(fileType) {
 				log.Warn("import wrapper: unsupported file type for row-based mode", zap.String("filePath", filePath))
 				return rowBased, fmt.Errorf("unsupported file type for row-based mode: '%s'", filePath)
 			}
@@ -255,7 +257,7 @@ func (p *ImportWrapper) Import(filePaths []string, options ImportOptions) error
 
 	tr := timerecord.NewTimeRecorder("Import task")
 	if rowBased {
-		// parse and consume row-based files
+		// parse and consume row-based files(json/arrow/avro)
 		// for row-based files, the JSONRowConsumer will generate autoid for primary key, and split rows into segments
 		// according to shard number, so the flushFunc will be called in the JSONRowConsumer
 		for i := 0; i < len(filePaths); i++ {
@@ -263,13 +265,11 @@ func (p *ImportWrapper) Import(filePaths []string, options ImportOptions) error
 			_, fileType := GetFileNameAndExt(filePath)
 			log.Info("import wrapper:  row-based file ", zap.Any("filePath", filePath), zap.Any("fileType", fileType))
 
-			if fileType == JSONFileExt {
-				err = p.parseRowBasedJSON(filePath, options.OnlyValidate)
-				if err != nil {
-					log.Warn("import wrapper: failed to parse row-based json file", zap.Error(err), zap.String("filePath", filePath))
-					return err
-				}
-			} // no need to check else, since the fileValidation() already do this
+			err = p.parseRowBasedFile(filePath, fileType, options.OnlyValidate)
+			if err != nil {
+				log.Warn("import wrapper: failed to parse row-based file", zap.Error(err), zap.String("filePath", filePath))
+				return err
+			}
 
 			// trigger gc after each file finished
 			triggerGC()
@@ -398,9 +398,9 @@ func (p *ImportWrapper) doBinlogImport(filePaths []string, tsStartPoint uint64,
 	return p.reportPersisted(p.reportImportAttempts, tr)
 }
 
-// parseRowBasedJSON is the entry of row-based json import operation
-func (p *ImportWrapper) parseRowBasedJSON(filePath string, onlyValidate bool) error {
-	tr := timerecord.NewTimeRecorder("json row-based parser: " + filePath)
+// parseRowBasedFile is the entry of row-based json/arrow/avro import operation
+func (p *ImportWrapper) parseRowBasedFile(filePath string, fileType string, onlyValidate bool) error {
+	tr := timerecord.NewTimeRecorder("row-based parser: " + filePath)
 
 	// for minio storage, chunkManager will download file into local memory
 	// for local storage, chunkManager open the file directly
@@ -415,10 +415,6 @@ func (p *ImportWrapper) parseRowBasedJSON(filePath string, onlyValidate bool) er
 		return err
 	}
 
-	// parse file
-	reader := bufio.NewReader(file)
-	parser := NewJSONParser(p.ctx, p.collectionInfo, p.updateProgressPercent)
-
 	// if only validate, we input a empty flushFunc so that the consumer do nothing but only validation.
 	var flushFunc ImportFlushFunc
 	if onlyValidate {
@@ -438,7 +434,16 @@ func (p *ImportWrapper) parseRowBasedJSON(filePath string, onlyValidate bool) er
 		return err
 	}
 
-	err = parser.ParseRows(&IOReader{r: reader, fileSize: size}, consumer)
+	// parse file
+	reader := &IOReader{r: bufio.NewReader(file), fileSize: size}
+	switch fileType {
+	case ArrowFileExt:
+		err = NewArrowParser(p.ctx, p.collectionInfo, p.updateProgressPercent).ParseRows(reader, consumer)
+	case AvroFileExt:
+		err = NewAvroParser(p.ctx, p.collectionInfo, p.updateProgressPercent).ParseRows(reader, consumer)
+	default:
+		err = NewJSONParser(p.ctx, p.collectionInfo, p.updateProgressPercent).ParseRows(reader, consumer)
+	}
 	if err != nil {
 		return err
 	}
@@ -600,6 +600,11 @@ func Test_ImportWrapperFileValidation(t *testing.T) {
 		rowBased, err = wrapper.fileValidation(files)
 		assert.NoError(t, err)
 		assert.False(t, rowBased)
+
+		files = []string{"a/1.arrows", "b/2.avro"}
+		rowBased, err = wrapper.fileValidation(files)
+		assert.NoError(t, err)
+		assert.True(t, rowBased)
 	})
 
 	t.Run("empty file list", func(t *testing.T) {
@@ -231,6 +231,14 @@ func (m *GrpcDataCoordClient) ReportDataNodeTtMsgs(ctx context.Context, in *data
 	return &commonpb.Status{}, m.Err
 }
 
+func (m *GrpcDataCoordClient) CancelCompactionPlan(ctx context.Context, in *datapb.CancelCompactionPlanRequest, opts ...grpc.CallOption) (*commonpb.Status, error) {
+	return &commonpb.Status{}, m.Err
+}
+
+func (m *GrpcDataCoordClient) OperateStorageMigration(ctx context.Context, in *datapb.OperateStorageMigrationRequest, opts ...grpc.CallOption) (*commonpb.Status, error) {
+	return &commonpb.Status{}, m.Err
+}
+
 func (m *GrpcDataCoordClient) Close() error {
 	return nil
 }
@@ -262,6 +262,38 @@ func (m *GrpcRootCoordClient) AlterCollection(ctx context.Context, in *milvuspb.
 	return &commonpb.Status{}, m.Err
 }
 
+func (m *GrpcRootCoordClient) CancelDDLTask(ctx context.Context, in *rootcoordpb.CancelDDLTaskRequest, opts ...grpc.CallOption) (*commonpb.Status, error) {
+	return &commonpb.Status{}, m.Err
+}
+
+func (m *GrpcRootCoordClient) OperateCollectionTrash(ctx context.Context, in *rootcoordpb.OperateCollectionTrashRequest, opts ...grpc.CallOption) (*commonpb.Status, error) {
+	return &commonpb.Status{}, m.Err
+}
+
+func (m *GrpcRootCoordClient) OperateCollectionTemplate(ctx context.Context, in *rootcoordpb.OperateCollectionTemplateRequest, opts ...grpc.CallOption) (*commonpb.Status, error) {
+	return &commonpb.Status{}, m.Err
+}
+
+func (m *GrpcRootCoordClient) CreateCollectionFromTemplate(ctx context.Context, in *rootcoordpb.CreateCollectionFromTemplateRequest, opts ...grpc.CallOption) (*commonpb.Status, error) {
+	return &commonpb.Status{}, m.Err
+}
+
+func (m *GrpcRootCoordClient) OperateRoleInheritance(ctx context.Context, in *rootcoordpb.OperateRoleInheritanceRequest, opts ...grpc.CallOption) (*commonpb.Status, error) {
+	return &commonpb.Status{}, m.Err
+}
+
+func (m *GrpcRootCoordClient) ListRoleInheritances(ctx context.Context, in *rootcoordpb.ListRoleInheritancesRequest, opts ...grpc.CallOption) (*rootcoordpb.ListRoleInheritancesResponse, error) {
+	return &rootcoordpb.ListRoleInheritancesResponse{}, m.Err
+}
+
+func (m *GrpcRootCoordClient) OperateAPIKey(ctx context.Context, in *rootcoordpb.OperateAPIKeyRequest, opts ...grpc.CallOption) (*rootcoordpb.OperateAPIKeyResponse, error) {
+	return &rootcoordpb.OperateAPIKeyResponse{}, m.Err
+}
+
+func (m *GrpcRootCoordClient) ListAPIKeys(ctx context.Context, in *rootcoordpb.ListAPIKeysRequest, opts ...grpc.CallOption) (*rootcoordpb.ListAPIKeysResponse, error) {
+	return &rootcoordpb.ListAPIKeysResponse{}, m.Err
+}
+
 func (m *GrpcRootCoordClient) Close() error {
 	return nil
 }
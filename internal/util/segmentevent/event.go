@@ -0,0 +1,37 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package segmentevent
+
+import (
+	"path"
+	"strconv"
+)
+
+// Prefix is the etcd prefix of the segment events pushed to QueryCoord by DataCoord,
+// the latest event of each collection is put to the key of the collection,
+// so the watchers receive the events in order and resume from the revision of the last received one.
+const Prefix = "datacoord-segment-event"
+
+// Key returns the key of the segment events of the collection.
+func Key(collectionID int64) string {
+	return path.Join(Prefix, strconv.FormatInt(collectionID, 10))
+}
+
+// ParseCollectionID returns the collection of the key, which may be prefixed by the root path of etcd.
+func ParseCollectionID(key string) (int64, error) {
+	return strconv.ParseInt(path.Base(key), 10, 64)
+}
@@ -101,6 +101,8 @@ type SessionRaw struct {
 
 	HostName   string `json:"HostName,omitempty"`
 	EnableDisk bool   `json:"EnableDisk,omitempty"`
+	// CompactionDedicated indicates the datanode is dedicated to compaction without any channel watched
+	CompactionDedicated bool `json:"CompactionDedicated,omitempty"`
 }
 
 // Session is a struct to store service's session, including ServerID, ServerName,
@@ -168,6 +170,13 @@ func WithEnableDisk(enableDisk bool) SessionOption {
 	}
 }
 
+// WithCompactionDedicated should be only used by datanode.
+func WithCompactionDedicated(dedicated bool) SessionOption {
+	return func(s *Session) {
+		s.CompactionDedicated = dedicated
+	}
+}
+
 func (s *Session) apply(opts ...SessionOption) {
 	for _, opt := range opts {
 		opt(s)
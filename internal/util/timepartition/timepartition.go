@@ -0,0 +1,135 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package timepartition
+
+import (
+	"strconv"
+	"time"
+
+	"github.com/cockroachdb/errors"
+
+	"github.com/milvus-io/milvus/pkg/common"
+)
+
+// PartitionPrefix is the prefix of the partitions created by the rollover,
+// the partitions named otherwise are never dropped by the rollover.
+const PartitionPrefix = "p_"
+
+const (
+	Hourly  = "hourly"
+	Daily   = "daily"
+	Weekly  = "weekly"
+	Monthly = "monthly"
+)
+
+// Policy is the time-based partitioning of a collection declared by its properties,
+// a partition holds the entities inserted in an interval, and the intervals are aligned in UTC.
+type Policy struct {
+	Interval string
+	// Retention is the number of the latest intervals whose partitions are kept, 0 means keeping all.
+	Retention int
+}
+
+// Parse returns the time-based partitioning declared by the collection properties, nil if not declared.
+func Parse(properties map[string]string) (*Policy, error) {
+	interval, ok := properties[common.CollectionPartitionRolloverKey]
+	if !ok || interval == "" {
+		return nil, nil
+	}
+	switch interval {
+	case Hourly, Daily, Weekly, Monthly:
+	default:
+		return nil, errors.Newf("invalid %s %s, should be one of hourly, daily, weekly and monthly", common.CollectionPartitionRolloverKey, interval)
+	}
+	policy := &Policy{Interval: interval}
+	if v, ok := properties[common.CollectionPartitionRetentionKey]; ok {
+		retention, err := strconv.Atoi(v)
+		if err != nil || retention < 0 {
+			return nil, errors.Newf("invalid %s %s, should be a non-negative integer", common.CollectionPartitionRetentionKey, v)
+		}
+		policy.Retention = retention
+	}
+	return policy, nil
+}
+
+func (p *Policy) layout() string {
+	switch p.Interval {
+	case Hourly:
+		return "2006010215"
+	case Monthly:
+		return "200601"
+	default:
+		return "20060102"
+	}
+}
+
+// Start returns the start of the interval t is in.
+func (p *Policy) Start(t time.Time) time.Time {
+	t = t.UTC()
+	switch p.Interval {
+	case Hourly:
+		return t.Truncate(time.Hour)
+	case Monthly:
+		return time.Date(t.Year(), t.Month(), 1, 0, 0, 0, 0, time.UTC)
+	case Weekly:
+		day := time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, time.UTC)
+		// weeks start on monday
+		return day.AddDate(0, 0, -(int(day.Weekday())+6)%7)
+	default:
+		return time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, time.UTC)
+	}
+}
+
+// Add returns the start of the n-th interval after the one t is in, n could be negative.
+func (p *Policy) Add(t time.Time, n int) time.Time {
+	start := p.Start(t)
+	switch p.Interval {
+	case Hourly:
+		return start.Add(time.Duration(n) * time.Hour)
+	case Monthly:
+		return start.AddDate(0, n, 0)
+	case Weekly:
+		return start.AddDate(0, 0, 7*n)
+	default:
+		return start.AddDate(0, 0, n)
+	}
+}
+
+// PartitionName returns the name of the partition of the interval t is in.
+func (p *Policy) PartitionName(t time.Time) string {
+	return PartitionPrefix + p.Start(t).Format(p.layout())
+}
+
+// ParsePartitionName returns the start of the interval of the partition, false if it's not created by the rollover.
+func (p *Policy) ParsePartitionName(name string) (time.Time, bool) {
+	if len(name) <= len(PartitionPrefix) || name[:len(PartitionPrefix)] != PartitionPrefix {
+		return time.Time{}, false
+	}
+	t, err := time.ParseInLocation(p.layout(), name[len(PartitionPrefix):], time.UTC)
+	if err != nil {
+		return time.Time{}, false
+	}
+	return t, true
+}
+
+// Expired returns whether the partition of the interval starting at start is beyond the retention at now.
+func (p *Policy) Expired(start time.Time, now time.Time) bool {
+	if p.Retention <= 0 {
+		return false
+	}
+	return start.Before(p.Add(now, 1-p.Retention))
+}
@@ -0,0 +1,79 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package timepartition
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/milvus-io/milvus/pkg/common"
+)
+
+func TestParse(t *testing.T) {
+	policy, err := Parse(map[string]string{})
+	assert.NoError(t, err)
+	assert.Nil(t, policy)
+
+	policy, err = Parse(map[string]string{common.CollectionPartitionRolloverKey: Daily, common.CollectionPartitionRetentionKey: "7"})
+	assert.NoError(t, err)
+	assert.Equal(t, &Policy{Interval: Daily, Retention: 7}, policy)
+
+	_, err = Parse(map[string]string{common.CollectionPartitionRolloverKey: "yearly"})
+	assert.Error(t, err)
+	_, err = Parse(map[string]string{common.CollectionPartitionRolloverKey: Daily, common.CollectionPartitionRetentionKey: "-1"})
+	assert.Error(t, err)
+}
+
+func TestPolicy(t *testing.T) {
+	// a wednesday
+	now := time.Date(2023, 11, 15, 13, 30, 0, 0, time.UTC)
+
+	cases := []struct {
+		interval string
+		name     string
+		next     string
+	}{
+		{Hourly, "p_2023111513", "p_2023111514"},
+		{Daily, "p_20231115", "p_20231116"},
+		{Weekly, "p_20231113", "p_20231120"},
+		{Monthly, "p_202311", "p_202312"},
+	}
+	for _, c := range cases {
+		policy := &Policy{Interval: c.interval}
+		assert.Equal(t, c.name, policy.PartitionName(now), c.interval)
+		assert.Equal(t, c.next, policy.PartitionName(policy.Add(now, 1)), c.interval)
+
+		start, ok := policy.ParsePartitionName(c.name)
+		assert.True(t, ok)
+		assert.Equal(t, policy.Start(now), start)
+	}
+
+	policy := &Policy{Interval: Daily}
+	_, ok := policy.ParsePartitionName("_default")
+	assert.False(t, ok)
+	_, ok = policy.ParsePartitionName("p_foo")
+	assert.False(t, ok)
+	assert.False(t, policy.Expired(policy.Add(now, -100), now))
+
+	// the partitions of today and the previous day are kept
+	policy.Retention = 2
+	assert.False(t, policy.Expired(policy.Add(now, 0), now))
+	assert.False(t, policy.Expired(policy.Add(now, -1), now))
+	assert.True(t, policy.Expired(policy.Add(now, -2), now))
+}
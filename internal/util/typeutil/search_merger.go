@@ -0,0 +1,250 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package typeutil
+
+import (
+	"sync"
+
+	"github.com/milvus-io/milvus-proto/go-api/v2/schemapb"
+	"github.com/milvus-io/milvus/pkg/util/typeutil"
+)
+
+var searchMergerPool = sync.Pool{
+	New: func() any {
+		return &SearchResultMerger{}
+	},
+}
+
+type mergeCursor struct {
+	sub   int
+	idx   int64
+	score float32
+}
+
+// SearchResultMerger merges the results of sub searches on each query in the order of score desc and pk asc,
+// by a max heap whose size is bounded by the number of sub searches.
+// The mergers are pooled and the buffers are reused, to avoid the transient allocations
+// when reducing nq*topK results from many segments or shards.
+type SearchResultMerger struct {
+	data     []*schemapb.SearchResultData
+	offsets  []int64 // the start offsets of current query in sub search results
+	cursors  []int64 // the number of results merged of current query in sub search results
+	heap     []mergeCursor
+	idSet    map[any]struct{}
+	query    int64
+	released bool
+
+	// grouping by field, each group keeps at most groupSize results of a query
+	groupFields []*schemapb.FieldData
+	groupSize   int64
+	groupCount  map[any]int64
+}
+
+// NewSearchResultMerger gets a merger from pool, which shall be released after merging done.
+func NewSearchResultMerger(data []*schemapb.SearchResultData) *SearchResultMerger {
+	m := searchMergerPool.Get().(*SearchResultMerger)
+	m.data = data
+	m.offsets = resize(m.offsets, len(data))
+	m.cursors = resize(m.cursors, len(data))
+	if m.heap == nil {
+		m.heap = make([]mergeCursor, 0, len(data))
+	}
+	if m.idSet == nil {
+		m.idSet = make(map[any]struct{})
+	}
+	if m.groupCount == nil {
+		m.groupCount = make(map[any]int64)
+	}
+	m.query = -1
+	m.released = false
+	return m
+}
+
+// SetGroupBy groups the results by the field, the results of the sub searches shall carry the field data.
+func (m *SearchResultMerger) SetGroupBy(fieldID int64, groupSize int64) {
+	m.groupSize = groupSize
+	m.groupFields = make([]*schemapb.FieldData, len(m.data))
+	for i, data := range m.data {
+		for _, field := range data.GetFieldsData() {
+			if field.GetFieldId() == fieldID {
+				m.groupFields[i] = field
+				break
+			}
+		}
+	}
+}
+
+func resize(s []int64, n int) []int64 {
+	if cap(s) < n {
+		return make([]int64, n)
+	}
+	s = s[:n]
+	for i := range s {
+		s[i] = 0
+	}
+	return s
+}
+
+// Release puts the merger back to pool, the merger shall not be used after released.
+func (m *SearchResultMerger) Release() {
+	if m.released {
+		return
+	}
+	m.released = true
+	m.data = nil
+	m.heap = m.heap[:0]
+	for id := range m.idSet {
+		delete(m.idSet, id)
+	}
+	m.groupFields = nil
+	m.groupSize = 0
+	for value := range m.groupCount {
+		delete(m.groupCount, value)
+	}
+	searchMergerPool.Put(m)
+}
+
+// Start starts to merge the results of the query qi, the queries shall be merged in order.
+func (m *SearchResultMerger) Start(qi int64) {
+	from := m.query
+	if from < 0 {
+		from = 0
+	}
+	for i, data := range m.data {
+		for q := from; q < qi; q++ {
+			m.offsets[i] += data.GetTopks()[q]
+		}
+		m.cursors[i] = 0
+	}
+	m.query = qi
+	for id := range m.idSet {
+		delete(m.idSet, id)
+	}
+	for value := range m.groupCount {
+		delete(m.groupCount, value)
+	}
+
+	m.heap = m.heap[:0]
+	for i := range m.data {
+		m.push(i)
+	}
+}
+
+// Next returns the sub search and the index in it of the best result not merged of current query,
+// returns -1 if all results are merged.
+func (m *SearchResultMerger) Next() (int, int64) {
+	if len(m.heap) == 0 {
+		return -1, -1
+	}
+	top := m.heap[0]
+	last := len(m.heap) - 1
+	m.heap[0] = m.heap[last]
+	m.heap = m.heap[:last]
+	m.down(0)
+
+	m.cursors[top.sub]++
+	m.push(top.sub)
+	return top.sub, top.idx
+}
+
+// Skip returns false if the id is merged already for current query, otherwise marks it merged.
+func (m *SearchResultMerger) Skip(id any) bool {
+	if _, ok := m.idSet[id]; ok {
+		return true
+	}
+	m.idSet[id] = struct{}{}
+	return false
+}
+
+// SkipGroup returns true if the group of the result is full for current query, otherwise counts the result in its group.
+// It always returns false if not grouping.
+func (m *SearchResultMerger) SkipGroup(sub int, idx int64) bool {
+	if m.groupFields == nil {
+		return false
+	}
+	var value any
+	if field := m.groupFields[sub]; field != nil {
+		value = typeutil.GetData(field, int(idx))
+	}
+	if m.groupCount[value] >= m.groupSize {
+		return true
+	}
+	m.groupCount[value]++
+	return false
+}
+
+func (m *SearchResultMerger) push(sub int) {
+	data := m.data[sub]
+	if m.cursors[sub] >= data.GetTopks()[m.query] {
+		return
+	}
+	idx := m.offsets[sub] + m.cursors[sub]
+	m.heap = append(m.heap, mergeCursor{sub: sub, idx: idx, score: data.GetScores()[idx]})
+	m.up(len(m.heap) - 1)
+}
+
+func (m *SearchResultMerger) less(i, j int) bool {
+	a, b := m.heap[i], m.heap[j]
+	if a.score != b.score {
+		return a.score > b.score
+	}
+	return typeutil.ComparePK(typeutil.GetPK(m.data[a.sub].GetIds(), a.idx), typeutil.GetPK(m.data[b.sub].GetIds(), b.idx))
+}
+
+func (m *SearchResultMerger) up(j int) {
+	for j > 0 {
+		i := (j - 1) / 2
+		if !m.less(j, i) {
+			break
+		}
+		m.heap[i], m.heap[j] = m.heap[j], m.heap[i]
+		j = i
+	}
+}
+
+func (m *SearchResultMerger) down(i int) {
+	n := len(m.heap)
+	for {
+		j := 2*i + 1
+		if j >= n {
+			break
+		}
+		if r := j + 1; r < n && m.less(r, j) {
+			j = r
+		}
+		if !m.less(j, i) {
+			break
+		}
+		m.heap[i], m.heap[j] = m.heap[j], m.heap[i]
+		i = j
+	}
+}
+
+// PrepareSearchResultData pre-allocates the ids and scores of the reduced result for at most size results,
+// the ids are of the same type as the sample.
+func PrepareSearchResultData(ret *schemapb.SearchResultData, sample *schemapb.IDs, size int64) {
+	if size <= 0 {
+		return
+	}
+	ret.Scores = make([]float32, 0, size)
+	switch sample.GetIdField().(type) {
+	case *schemapb.IDs_IntId:
+		ret.Ids = &schemapb.IDs{IdField: &schemapb.IDs_IntId{IntId: &schemapb.LongArray{Data: make([]int64, 0, size)}}}
+	case *schemapb.IDs_StrId:
+		ret.Ids = &schemapb.IDs{IdField: &schemapb.IDs_StrId{StrId: &schemapb.StringArray{Data: make([]string, 0, size)}}}
+	}
+}
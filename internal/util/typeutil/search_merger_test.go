@@ -0,0 +1,176 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package typeutil
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/milvus-io/milvus-proto/go-api/v2/schemapb"
+	"github.com/milvus-io/milvus/pkg/util/typeutil"
+)
+
+func genSearchResultData(ids []int64, scores []float32, topks []int64) *schemapb.SearchResultData {
+	return &schemapb.SearchResultData{
+		NumQueries: int64(len(topks)),
+		Ids: &schemapb.IDs{
+			IdField: &schemapb.IDs_IntId{IntId: &schemapb.LongArray{Data: ids}},
+		},
+		Scores: scores,
+		Topks:  topks,
+	}
+}
+
+func TestSearchResultMerger(t *testing.T) {
+	data := []*schemapb.SearchResultData{
+		genSearchResultData([]int64{1, 3, 5, 7}, []float32{0.9, 0.5, 0.8, 0.2}, []int64{2, 2}),
+		genSearchResultData([]int64{2, 3, 6}, []float32{0.7, 0.5, 0.9}, []int64{2, 1}),
+		genSearchResultData([]int64{}, []float32{}, []int64{0, 0}),
+	}
+
+	merger := NewSearchResultMerger(data)
+	defer merger.Release()
+
+	merge := func(qi int64) []int64 {
+		merger.Start(qi)
+		var ids []int64
+		for {
+			sub, idx := merger.Next()
+			if sub == -1 {
+				break
+			}
+			id := typeutil.GetPK(data[sub].GetIds(), idx)
+			if !merger.Skip(id) {
+				ids = append(ids, id.(int64))
+			}
+		}
+		return ids
+	}
+
+	// same score 0.5 of pk 3 from different sub results, ordered by pk and deduplicated
+	assert.Equal(t, []int64{1, 2, 3}, merge(0))
+	assert.Equal(t, []int64{6, 5, 7}, merge(1))
+}
+
+func TestSearchResultMergerPooled(t *testing.T) {
+	data := []*schemapb.SearchResultData{
+		genSearchResultData([]int64{1, 2}, []float32{0.9, 0.5}, []int64{2}),
+	}
+	merger := NewSearchResultMerger(data)
+	merger.Start(0)
+	merger.Next()
+	merger.Release()
+	merger.Release()
+
+	data = []*schemapb.SearchResultData{
+		genSearchResultData([]int64{3}, []float32{0.1}, []int64{1}),
+		genSearchResultData([]int64{4}, []float32{0.2}, []int64{1}),
+	}
+	merger = NewSearchResultMerger(data)
+	defer merger.Release()
+	merger.Start(0)
+	sub, idx := merger.Next()
+	assert.Equal(t, 1, sub)
+	assert.EqualValues(t, 0, idx)
+	sub, _ = merger.Next()
+	assert.Equal(t, 0, sub)
+	sub, _ = merger.Next()
+	assert.Equal(t, -1, sub)
+}
+
+func TestSearchResultMergerGroupBy(t *testing.T) {
+	const groupFieldID = 101
+	genGroupField := func(values []int64) *schemapb.FieldData {
+		return &schemapb.FieldData{
+			Type:    schemapb.DataType_Int64,
+			FieldId: groupFieldID,
+			Field: &schemapb.FieldData_Scalars{Scalars: &schemapb.ScalarField{
+				Data: &schemapb.ScalarField_LongData{LongData: &schemapb.LongArray{Data: values}},
+			}},
+		}
+	}
+	data := []*schemapb.SearchResultData{
+		genSearchResultData([]int64{1, 3, 5}, []float32{0.9, 0.8, 0.7}, []int64{3}),
+		genSearchResultData([]int64{2, 4}, []float32{0.85, 0.75}, []int64{2}),
+	}
+	data[0].FieldsData = []*schemapb.FieldData{genGroupField([]int64{10, 10, 10})}
+	data[1].FieldsData = []*schemapb.FieldData{genGroupField([]int64{10, 20})}
+
+	merger := NewSearchResultMerger(data)
+	defer merger.Release()
+	merger.SetGroupBy(groupFieldID, 2)
+	merger.Start(0)
+	var ids []int64
+	for {
+		sub, idx := merger.Next()
+		if sub == -1 {
+			break
+		}
+		id := typeutil.GetPK(data[sub].GetIds(), idx)
+		if !merger.Skip(id) && !merger.SkipGroup(sub, idx) {
+			ids = append(ids, id.(int64))
+		}
+	}
+	// group 10 keeps the best 2 results
+	assert.Equal(t, []int64{1, 2, 4}, ids)
+}
+
+func TestPrepareSearchResultData(t *testing.T) {
+	ret := &schemapb.SearchResultData{Ids: &schemapb.IDs{}}
+	PrepareSearchResultData(ret, nil, 0)
+	assert.Nil(t, ret.GetIds().GetIdField())
+
+	PrepareSearchResultData(ret, &schemapb.IDs{IdField: &schemapb.IDs_StrId{StrId: &schemapb.StringArray{}}}, 10)
+	assert.Equal(t, 10, cap(ret.GetIds().GetStrId().GetData()))
+	assert.Equal(t, 10, cap(ret.GetScores()))
+}
+
+func BenchmarkSearchResultMerger(b *testing.B) {
+	const (
+		subNum = 32
+		nq     = 10
+		topk   = 100
+	)
+	data := make([]*schemapb.SearchResultData, 0, subNum)
+	for i := 0; i < subNum; i++ {
+		ids := make([]int64, 0, nq*topk)
+		scores := make([]float32, 0, nq*topk)
+		topks := make([]int64, 0, nq)
+		for q := 0; q < nq; q++ {
+			for k := 0; k < topk; k++ {
+				ids = append(ids, int64(i*nq*topk+q*topk+k))
+				scores = append(scores, float32(topk-k)+float32(i)/subNum)
+			}
+			topks = append(topks, topk)
+		}
+		data = append(data, genSearchResultData(ids, scores, topks))
+	}
+
+	b.ReportAllocs()
+	b.ResetTimer()
+	for n := 0; n < b.N; n++ {
+		merger := NewSearchResultMerger(data)
+		for q := int64(0); q < nq; q++ {
+			merger.Start(q)
+			for k := 0; k < topk; k++ {
+				merger.Next()
+			}
+		}
+		merger.Release()
+	}
+}
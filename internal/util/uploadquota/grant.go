@@ -0,0 +1,46 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package uploadquota
+
+import (
+	"path"
+	"strconv"
+)
+
+// GrantPrefix is the etcd prefix of the upload quota granted to the datanodes by datacoord.
+const GrantPrefix = "datacoord-upload-quota"
+
+// Grant is the rate of PUT requests a datanode is allowed to issue to the object storage endpoints.
+type Grant struct {
+	// PutRates is the PUT requests per second allowed to the listed endpoints.
+	PutRates map[string]float64 `json:"put_rates,omitempty"`
+	// DefaultPutRate is the PUT requests per second allowed to the endpoints not listed.
+	DefaultPutRate float64 `json:"default_put_rate"`
+}
+
+// PutRateOf returns the PUT requests per second allowed to the endpoint, non-positive means unlimited.
+func (g *Grant) PutRateOf(endpoint string) float64 {
+	if rate, ok := g.PutRates[endpoint]; ok {
+		return rate
+	}
+	return g.DefaultPutRate
+}
+
+// GrantKey returns the etcd key of the upload quota granted to the datanode.
+func GrantKey(nodeID int64) string {
+	return path.Join(GrantPrefix, strconv.FormatInt(nodeID, 10))
+}
@@ -16,7 +16,13 @@
 
 package common
 
-import "encoding/binary"
+import (
+	"encoding/binary"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+)
 
 // system field id:
 // 0: unique row id
@@ -95,13 +101,56 @@ const (
 	DimKey         = "dim"
 	MaxLengthKey   = "max_length"
 	MaxCapacityKey = "max_capacity"
+
+	// group by settings passed to segcore in the search params, keep consistent with common/Consts.h
+	GroupByFieldIDKey = "group_by_field_id"
+	GroupSizeKey      = "group_size"
 )
 
 //  Collection properties key
 
 const (
-	CollectionTTLConfigKey      = "collection.ttl.seconds"
-	CollectionAutoCompactionKey = "collection.autocompaction.enabled"
+	CollectionTTLConfigKey       = "collection.ttl.seconds"
+	CollectionAutoCompactionKey  = "collection.autocompaction.enabled"
+	CollectionRetentionKey       = "collection.timetravel.retention.seconds"
+	CollectionReadOnlyKey        = "collection.readonly.enabled"
+	CollectionLoadPriorityKey    = "collection.load.priority"
+	CollectionAutoIndexOnSealKey = "collection.autoindex.onseal.enabled"
+	CollectionStringPoolKey      = "collection.stringpool.enabled"
+	// CollectionServiceableEarlyKey makes the sealed segments serviceable once the primary key, vector and indexed fields loaded,
+	// the other scalar fields are loaded in background and the requests filtering or outputting them wait until loaded.
+	CollectionServiceableEarlyKey = "collection.load.serviceableEarly"
+	// CollectionMmapEnabledKey set to false loads the collection without mmap even if mmap is enabled by querynode,
+	// it's passed from the load config of QueryCoord.
+	CollectionMmapEnabledKey = "collection.mmap.enabled"
+	// CollectionVectorQuantizationKey quantizes the raw float vectors kept in memory by the sealed segments to FP16 or SQ8,
+	// which is independent of the vector index, the binlogs keep the float vectors.
+	CollectionVectorQuantizationKey = "collection.vector.quantization"
+
+	// CollectionShardsNumKey alters the number of virtual channels of an existing collection,
+	// CollectionShardsScaledKey is set once the number changed, so the primary keys no longer hash to the shards they were inserted.
+	CollectionShardsNumKey    = "collection.shards.num"
+	CollectionShardsScaledKey = "collection.shards.scaled"
+
+	// CollectionTemplateKey records the template the collection created from,
+	// CollectionAutoIndexPoliciesKey overrides the auto index policies of the cluster for the collection.
+	CollectionTemplateKey          = "collection.template"
+	CollectionAutoIndexPoliciesKey = "collection.autoindex.policies"
+
+	// default search params, applied when the search request omits them
+	CollectionSearchParamsKey           = "collection.search.params"
+	CollectionSearchMetricTypeKey       = "collection.search.metric_type"
+	CollectionSearchConsistencyLevelKey = "collection.search.consistency_level"
+
+	// CollectionEmbeddingFunctionsKey declares the embedding functions in json, proxy computes the vectors
+	// of the output fields from the raw text of the input VARCHAR fields for the inserts and searches.
+	CollectionEmbeddingFunctionsKey = "collection.embedding.functions"
+
+	// CollectionPartitionRolloverKey partitions the collection by time, the value is one of hourly, daily, weekly and monthly,
+	// datacoord creates the partition of each interval and drops the ones beyond CollectionPartitionRetentionKey intervals,
+	// and proxy inserts the entities into the partition of current interval if the request names no partition.
+	CollectionPartitionRolloverKey  = "collection.partition.rollover"
+	CollectionPartitionRetentionKey = "collection.partition.rollover.retention"
 
 	// rate limit
 	CollectionInsertRateMaxKey   = "collection.insertRate.max.mb"
@@ -124,10 +173,131 @@ const (
 	TraceIDKey    string = "uber-trace-id"
 )
 
+// AliasDropPreviousKey is the property of AlterAlias request in MsgBase, the collection the alias pointed to previously
+// is dropped in the same meta transaction of repointing the alias if it's true.
+const AliasDropPreviousKey = "alias.drop_previous"
+
+// IsAliasDropPrevious returns true if the AlterAlias request asks to drop the collection the alias pointed to previously.
+func IsAliasDropPrevious(properties map[string]string) bool {
+	v, ok := properties[AliasDropPreviousKey]
+	if !ok {
+		return false
+	}
+	dropPrevious, err := strconv.ParseBool(v)
+	return err == nil && dropPrevious
+}
+
 func IsSystemField(fieldID int64) bool {
 	return fieldID < StartOfUserFieldID
 }
 
+// IsCollectionReadOnly returns true if the collection is set to read-only mode by properties.
+func IsCollectionReadOnly(properties map[string]string) bool {
+	v, ok := properties[CollectionReadOnlyKey]
+	if !ok {
+		return false
+	}
+	readOnly, err := strconv.ParseBool(v)
+	return err == nil && readOnly
+}
+
+// IsCollectionStringPoolEnabled returns true if the string fields of the collection are shared in the string pool of querynode.
+func IsCollectionStringPoolEnabled(properties map[string]string) bool {
+	v, ok := properties[CollectionStringPoolKey]
+	if !ok {
+		return false
+	}
+	enabled, err := strconv.ParseBool(v)
+	return err == nil && enabled
+}
+
+// IsCollectionServiceableEarly returns true if the scalar fields of the collection are loaded after the segments become serviceable.
+func IsCollectionServiceableEarly(properties map[string]string) bool {
+	v, ok := properties[CollectionServiceableEarlyKey]
+	if !ok {
+		return false
+	}
+	enabled, err := strconv.ParseBool(v)
+	return err == nil && enabled
+}
+
+// IsCollectionMmapDisabled returns true if the collection is loaded without mmap.
+func IsCollectionMmapDisabled(properties map[string]string) bool {
+	v, ok := properties[CollectionMmapEnabledKey]
+	if !ok {
+		return false
+	}
+	enabled, err := strconv.ParseBool(v)
+	return err == nil && !enabled
+}
+
+// The quantizations of the raw float vectors kept in memory.
+const (
+	VectorQuantizationFP16 = "FP16"
+	VectorQuantizationSQ8  = "SQ8"
+)
+
+// GetCollectionVectorQuantization returns the quantization of the raw float vectors of the collection,
+// empty if not quantized.
+func GetCollectionVectorQuantization(properties map[string]string) string {
+	v := strings.ToUpper(properties[CollectionVectorQuantizationKey])
+	switch v {
+	case VectorQuantizationFP16, VectorQuantizationSQ8:
+		return v
+	default:
+		return ""
+	}
+}
+
+// IsCollectionShardsScaled returns true if the number of virtual channels of the collection has been changed.
+func IsCollectionShardsScaled(properties map[string]string) bool {
+	v, ok := properties[CollectionShardsScaledKey]
+	if !ok {
+		return false
+	}
+	scaled, err := strconv.ParseBool(v)
+	return err == nil && scaled
+}
+
+// Load priorities of collection, the segments and channels of collections with higher priority are loaded first.
+const (
+	LoadPriorityLow    int32 = -1
+	LoadPriorityNormal int32 = 0
+	LoadPriorityHigh   int32 = 1
+)
+
+// GetCollectionLoadPriority returns the load priority set by properties, "high", "normal" or "low".
+func GetCollectionLoadPriority(properties map[string]string) (int32, error) {
+	v, ok := properties[CollectionLoadPriorityKey]
+	if !ok {
+		return LoadPriorityNormal, nil
+	}
+	switch strings.ToLower(v) {
+	case "high":
+		return LoadPriorityHigh, nil
+	case "normal", "":
+		return LoadPriorityNormal, nil
+	case "low":
+		return LoadPriorityLow, nil
+	default:
+		return LoadPriorityNormal, fmt.Errorf("invalid load priority %s, should be one of high, normal and low", v)
+	}
+}
+
+// GetCollectionRetention returns the time travel retention set by properties in seconds,
+// or defaultRetention if the collection doesn't specify it.
+func GetCollectionRetention(properties map[string]string, defaultRetention time.Duration) (time.Duration, error) {
+	v, ok := properties[CollectionRetentionKey]
+	if !ok {
+		return defaultRetention, nil
+	}
+	retention, err := strconv.Atoi(v)
+	if err != nil {
+		return 0, fmt.Errorf("invalid %s: %s", CollectionRetentionKey, v)
+	}
+	return time.Duration(retention) * time.Second, nil
+}
+
 const (
 	// LatestVerision is the magic number for watch latest revision
 	LatestRevision = int64(-1)
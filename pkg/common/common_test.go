@@ -2,6 +2,7 @@ package common
 
 import (
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 )
@@ -38,3 +39,72 @@ func TestIsSystemField(t *testing.T) {
 		})
 	}
 }
+
+func TestIsCollectionReadOnly(t *testing.T) {
+	assert.False(t, IsCollectionReadOnly(nil))
+	assert.False(t, IsCollectionReadOnly(map[string]string{CollectionReadOnlyKey: "false"}))
+	assert.False(t, IsCollectionReadOnly(map[string]string{CollectionReadOnlyKey: "invalid"}))
+	assert.True(t, IsCollectionReadOnly(map[string]string{CollectionReadOnlyKey: "true"}))
+}
+
+func TestIsCollectionStringPoolEnabled(t *testing.T) {
+	assert.False(t, IsCollectionStringPoolEnabled(nil))
+	assert.False(t, IsCollectionStringPoolEnabled(map[string]string{CollectionStringPoolKey: "invalid"}))
+	assert.True(t, IsCollectionStringPoolEnabled(map[string]string{CollectionStringPoolKey: "true"}))
+}
+
+func TestIsCollectionServiceableEarly(t *testing.T) {
+	assert.False(t, IsCollectionServiceableEarly(nil))
+	assert.False(t, IsCollectionServiceableEarly(map[string]string{CollectionServiceableEarlyKey: "invalid"}))
+	assert.True(t, IsCollectionServiceableEarly(map[string]string{CollectionServiceableEarlyKey: "true"}))
+}
+
+func TestIsCollectionMmapDisabled(t *testing.T) {
+	assert.False(t, IsCollectionMmapDisabled(nil))
+	assert.False(t, IsCollectionMmapDisabled(map[string]string{CollectionMmapEnabledKey: "invalid"}))
+	assert.False(t, IsCollectionMmapDisabled(map[string]string{CollectionMmapEnabledKey: "true"}))
+	assert.True(t, IsCollectionMmapDisabled(map[string]string{CollectionMmapEnabledKey: "false"}))
+}
+
+func TestGetCollectionVectorQuantization(t *testing.T) {
+	assert.Equal(t, "", GetCollectionVectorQuantization(nil))
+	assert.Equal(t, "", GetCollectionVectorQuantization(map[string]string{CollectionVectorQuantizationKey: "PQ"}))
+	assert.Equal(t, VectorQuantizationFP16, GetCollectionVectorQuantization(map[string]string{CollectionVectorQuantizationKey: "fp16"}))
+	assert.Equal(t, VectorQuantizationSQ8, GetCollectionVectorQuantization(map[string]string{CollectionVectorQuantizationKey: "SQ8"}))
+}
+
+func TestIsAliasDropPrevious(t *testing.T) {
+	assert.False(t, IsAliasDropPrevious(nil))
+	assert.False(t, IsAliasDropPrevious(map[string]string{AliasDropPreviousKey: "invalid"}))
+	assert.True(t, IsAliasDropPrevious(map[string]string{AliasDropPreviousKey: "true"}))
+}
+
+func TestGetCollectionLoadPriority(t *testing.T) {
+	priority, err := GetCollectionLoadPriority(nil)
+	assert.NoError(t, err)
+	assert.Equal(t, LoadPriorityNormal, priority)
+
+	priority, err = GetCollectionLoadPriority(map[string]string{CollectionLoadPriorityKey: "High"})
+	assert.NoError(t, err)
+	assert.Equal(t, LoadPriorityHigh, priority)
+
+	priority, err = GetCollectionLoadPriority(map[string]string{CollectionLoadPriorityKey: "low"})
+	assert.NoError(t, err)
+	assert.Equal(t, LoadPriorityLow, priority)
+
+	_, err = GetCollectionLoadPriority(map[string]string{CollectionLoadPriorityKey: "urgent"})
+	assert.Error(t, err)
+}
+
+func TestGetCollectionRetention(t *testing.T) {
+	retention, err := GetCollectionRetention(nil, time.Hour)
+	assert.NoError(t, err)
+	assert.Equal(t, time.Hour, retention)
+
+	retention, err = GetCollectionRetention(map[string]string{CollectionRetentionKey: "60"}, time.Hour)
+	assert.NoError(t, err)
+	assert.Equal(t, time.Minute, retention)
+
+	_, err = GetCollectionRetention(map[string]string{CollectionRetentionKey: "abc"}, time.Hour)
+	assert.Error(t, err)
+}
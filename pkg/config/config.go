@@ -26,6 +26,8 @@ var (
 	ErrNotInitial   = errors.New("config is not initialized")
 	ErrIgnoreChange = errors.New("ignore change")
 	ErrKeyNotFound  = errors.New("key not found")
+	// ErrConfigRejected means the changes from a source are rejected by validators and rolled back
+	ErrConfigRejected = errors.New("config rejected")
 )
 
 func Init(opts ...Option) (*Manager, error) {
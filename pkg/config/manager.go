@@ -86,6 +86,7 @@ type Manager struct {
 	keySourceMap  map[string]string // store the key to config source, example: key is A.B.C and source is file which means the A.B.C's value is from file
 	overlays      map[string]string // store the highest priority configs which modified at runtime
 	forbiddenKeys typeutil.Set[string]
+	validators    map[string][]Validator
 }
 
 func NewManager() *Manager {
@@ -95,6 +96,7 @@ func NewManager() *Manager {
 		keySourceMap:  make(map[string]string),
 		overlays:      make(map[string]string),
 		forbiddenKeys: typeutil.NewSet[string](),
+		validators:    make(map[string][]Validator),
 	}
 }
 
@@ -195,6 +197,15 @@ func (m *Manager) AddSource(source Source) error {
 	return nil
 }
 
+// RegisterValidator registers a validator for the key, the changes from sources which contain
+// any value rejected by the validators will be rolled back as a whole
+func (m *Manager) RegisterValidator(key string, validator Validator) {
+	m.Lock()
+	defer m.Unlock()
+	key = formatKey(key)
+	m.validators[key] = append(m.validators[key], validator)
+}
+
 // Update config at runtime, which can be called by others
 // The most used scenario is UT
 func (m *Manager) SetConfig(key, value string) {
@@ -348,6 +359,69 @@ func (m *Manager) OnEvent(event *Event) {
 	m.Dispatcher.Dispatch(event)
 }
 
+// OnEvents implements BatchEventHandler, validates all the events first and applies them atomically
+func (m *Manager) OnEvents(events []*Event) error {
+	m.Lock()
+	defer m.Unlock()
+	if err := m.validateEvents(events); err != nil {
+		return err
+	}
+
+	applied := make([]*Event, 0, len(events))
+	for _, event := range events {
+		if m.forbiddenKeys.Contain(formatKey(event.Key)) {
+			log.Info("ignore event for forbidden key", zap.String("key", event.Key))
+			continue
+		}
+		err := m.updateEvent(event)
+		if err != nil {
+			log.Warn("failed in updating event with error", zap.Error(err), zap.Any("event", event))
+			continue
+		}
+		applied = append(applied, event)
+	}
+	for _, event := range applied {
+		m.Dispatcher.Dispatch(event)
+	}
+	return nil
+}
+
+// validateEvents checks the new values which would take effect against the registered validators,
+// not threadsafe, m.Lock shall be held by caller
+func (m *Manager) validateEvents(events []*Event) error {
+	var reasons []string
+	for _, event := range events {
+		if event.EventType == DeleteType {
+			continue
+		}
+		key := formatKey(event.Key)
+		validators, ok := m.validators[key]
+		if !ok || m.forbiddenKeys.Contain(key) {
+			continue
+		}
+		// the value is overridden by runtime configs or sources with higher priority
+		if _, ok := m.overlays[key]; ok {
+			continue
+		}
+		if sourceName, ok := m.keySourceMap[event.Key]; ok && sourceName != event.EventSource {
+			prioritySrc := m.getHighPrioritySource(sourceName, event.EventSource)
+			if prioritySrc != nil && prioritySrc.GetSourceName() == sourceName {
+				continue
+			}
+		}
+		for _, validator := range validators {
+			if err := validator(event.Value); err != nil {
+				reasons = append(reasons, fmt.Sprintf("invalid value %q for %s: %s", event.Value, event.Key, err.Error()))
+				break
+			}
+		}
+	}
+	if len(reasons) > 0 {
+		return errors.Wrap(ErrConfigRejected, strings.Join(reasons, "; "))
+	}
+	return nil
+}
+
 func (m *Manager) GetIdentifier() string {
 	return "Manager"
 }
@@ -55,6 +55,56 @@ func TestConfigChangeEvent(t *testing.T) {
 	assert.Equal(t, res, "6")
 }
 
+func TestConfigChangeRejected(t *testing.T) {
+	dir, _ := os.MkdirTemp("", "milvus")
+	defer os.RemoveAll(dir)
+	os.WriteFile(path.Join(dir, "milvus.yaml"), []byte("a.b: 1\nc.d: 2"), 0o600)
+
+	fs := NewFileSource(&FileInfo{[]string{path.Join(dir, "milvus.yaml")}, 0})
+	mgr, _ := Init()
+	err := mgr.AddSource(fs)
+	assert.NoError(t, err)
+	mgr.RegisterValidator("a.b", func(value string) error {
+		if value == "bad" {
+			return errors.New("bad value")
+		}
+		return nil
+	})
+	dispatched := make([]string, 0)
+	mgr.Dispatcher.RegisterForKeyPrefix("", NewHandler("test", func(e *Event) {
+		dispatched = append(dispatched, e.Key)
+	}))
+
+	// rejected as a whole, c.d shall not be changed either
+	os.WriteFile(path.Join(dir, "milvus.yaml"), []byte("a.b: bad\nc.d: 3"), 0o600)
+	err = fs.loadFromFile()
+	assert.ErrorIs(t, err, ErrConfigRejected)
+	res, _ := mgr.GetConfig("a.b")
+	assert.Equal(t, "1", res)
+	res, _ = mgr.GetConfig("c.d")
+	assert.Equal(t, "2", res)
+	assert.Empty(t, dispatched)
+
+	// rejected config won't be validated again until changed
+	err = fs.loadFromFile()
+	assert.ErrorIs(t, err, ErrConfigRejected)
+
+	os.WriteFile(path.Join(dir, "milvus.yaml"), []byte("a.b: 4\nc.d: 3"), 0o600)
+	err = fs.loadFromFile()
+	assert.NoError(t, err)
+	res, _ = mgr.GetConfig("a.b")
+	assert.Equal(t, "4", res)
+	res, _ = mgr.GetConfig("c.d")
+	assert.Equal(t, "3", res)
+	assert.NotEmpty(t, dispatched)
+
+	// overridden values are not validated
+	mgr.SetConfig("a.b", "5")
+	os.WriteFile(path.Join(dir, "milvus.yaml"), []byte("a.b: bad\nc.d: 3"), 0o600)
+	err = fs.loadFromFile()
+	assert.NoError(t, err)
+}
+
 func TestAllDupliateSource(t *testing.T) {
 	mgr, _ := Init()
 	err := mgr.AddSource(NewEnvSource(formatKey))
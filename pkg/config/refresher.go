@@ -16,9 +16,11 @@
 package config
 
 import (
+	"reflect"
 	"sync"
 	"time"
 
+	"github.com/cockroachdb/errors"
 	"go.uber.org/zap"
 
 	"github.com/milvus-io/milvus/pkg/log"
@@ -33,6 +35,9 @@ type refresher struct {
 	fetchFunc func() error
 	stopOnce  sync.Once
 	wg        sync.WaitGroup
+
+	// the last configs rejected by the event handler, which won't be validated again until changed
+	rejected map[string]string
 }
 
 func newRefresher(interval time.Duration, fetchFunc func() error) *refresher {
@@ -68,6 +73,10 @@ func (r *refresher) refreshPeriodically(name string) {
 		select {
 		case <-ticker.C:
 			err := r.fetchFunc()
+			if errors.Is(err, ErrConfigRejected) {
+				// keep refreshing, the source keeps serving the previous configs
+				continue
+			}
 			if err != nil {
 				log.Error("can not pull configs", zap.Error(err))
 				r.stop()
@@ -85,6 +94,32 @@ func (r *refresher) fireEvents(name string, source, target map[string]string) er
 		log.Warn("generating event error", zap.Error(err))
 		return err
 	}
+	if len(events) == 0 {
+		return nil
+	}
+	// apply all the events atomically if the handler supports, otherwise the source keeps the previous configs
+	if beh, ok := r.eh.(BatchEventHandler); ok {
+		if r.rejected != nil && reflect.DeepEqual(r.rejected, target) {
+			return ErrConfigRejected
+		}
+		if err := beh.OnEvents(events); err != nil {
+			if errors.Is(err, ErrConfigRejected) {
+				r.rejected = target
+				for _, e := range events {
+					log.Warn("config change rolled back",
+						zap.String("source", name),
+						zap.String("type", e.EventType),
+						zap.String("key", e.Key),
+						zap.String("current", source[e.Key]),
+						zap.String("rejected", target[e.Key]))
+				}
+			}
+			log.Warn("failed to apply config changes", zap.String("source", name), zap.Error(err))
+			return err
+		}
+		r.rejected = nil
+		return nil
+	}
 	// Generate OnEvent Callback based on the events created
 	if r.eh != nil {
 		for _, e := range events {
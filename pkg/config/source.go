@@ -92,6 +92,16 @@ type EventHandler interface {
 	GetIdentifier() string
 }
 
+// BatchEventHandler handles all the change events generated by one refresh of a source,
+// the events shall be applied all or none
+type BatchEventHandler interface {
+	EventHandler
+	OnEvents(events []*Event) error
+}
+
+// Validator checks whether the new value of a config is acceptable
+type Validator func(value string) error
+
 type simpleHandler struct {
 	identity string
 	onEvent  func(*Event)
@@ -0,0 +1,197 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package eventlog
+
+import (
+	"encoding/json"
+	"strings"
+	"sync"
+	"time"
+
+	"go.uber.org/atomic"
+
+	"github.com/milvus-io/milvus/pkg/util/funcutil"
+	"github.com/milvus-io/milvus/pkg/util/typeutil"
+)
+
+// EventType is the type of cluster event, which is also the `Type()` of Evt.
+type EventType int32
+
+const (
+	// EventTypeRaw is the type of raw text Evt.
+	EventTypeRaw EventType = iota
+	EventSegmentSealed
+	EventChannelReassigned
+	EventNodeDown
+	EventCompactionDone
+	EventClockDrift
+	EventSegmentFlushed
+	EventSegmentDropped
+)
+
+var eventTypeNames = map[EventType]string{
+	EventTypeRaw:           "raw",
+	EventSegmentSealed:     "segment_sealed",
+	EventChannelReassigned: "channel_reassigned",
+	EventNodeDown:          "node_down",
+	EventCompactionDone:    "compaction_done",
+	EventClockDrift:        "clock_drift",
+	EventSegmentFlushed:    "segment_flushed",
+	EventSegmentDropped:    "segment_dropped",
+}
+
+func (t EventType) String() string {
+	if name, ok := eventTypeNames[t]; ok {
+		return name
+	}
+	return "unknown"
+}
+
+// MarshalJSON implements json.Marshaler, marshals the type as its name.
+func (t EventType) MarshalJSON() ([]byte, error) {
+	return json.Marshal(t.String())
+}
+
+// ParseEventType returns the EventType by name.
+func ParseEventType(name string) (EventType, bool) {
+	name = strings.ToLower(strings.TrimSpace(name))
+	for t, n := range eventTypeNames {
+		if n == name {
+			return t, true
+		}
+	}
+	return EventTypeRaw, false
+}
+
+// ClusterEvent is the typed event of cluster state changes, published via the event bus.
+type ClusterEvent struct {
+	EventType    EventType         `json:"type"`
+	Role         string            `json:"role,omitempty"`
+	NodeID       int64             `json:"node_id,omitempty"`
+	CollectionID int64             `json:"collection_id,omitempty"`
+	Channel      string            `json:"channel,omitempty"`
+	SegmentIDs   []int64           `json:"segment_ids,omitempty"`
+	Attrs        map[string]string `json:"attrs,omitempty"`
+	Ts           int64             `json:"ts"`
+}
+
+// Level implements Evt, the cluster events are all in info level.
+func (e *ClusterEvent) Level() Level {
+	return Level_Info
+}
+
+// Type implements Evt.
+func (e *ClusterEvent) Type() int32 {
+	return int32(e.EventType)
+}
+
+// Raw implements Evt, returns the event in json.
+func (e *ClusterEvent) Raw() []byte {
+	bs, _ := json.Marshal(e)
+	return bs
+}
+
+// Subscription receives the published events of the subscribed types.
+type Subscription struct {
+	key    string
+	types  typeutil.Set[EventType]
+	ch     chan *ClusterEvent
+	mu     sync.RWMutex
+	closed bool
+
+	dropped atomic.Int64
+}
+
+// Events returns the channel of events, which is closed after the subscription closed.
+func (s *Subscription) Events() <-chan *ClusterEvent {
+	return s.ch
+}
+
+// Dropped returns the number of events dropped since the subscriber is too slow.
+func (s *Subscription) Dropped() int64 {
+	return s.dropped.Load()
+}
+
+// Close unsubscribes from the event bus.
+func (s *Subscription) Close() {
+	getEventBus().subscribers.GetAndRemove(s.key)
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if !s.closed {
+		s.closed = true
+		close(s.ch)
+	}
+}
+
+func (s *Subscription) notify(evt *ClusterEvent) {
+	if len(s.types) > 0 && !s.types.Contain(evt.EventType) {
+		return
+	}
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	if s.closed {
+		return
+	}
+	select {
+	case s.ch <- evt:
+	default:
+		s.dropped.Inc()
+	}
+}
+
+type eventBus struct {
+	subscribers *typeutil.ConcurrentMap[string, *Subscription]
+}
+
+var (
+	bus     *eventBus
+	busOnce sync.Once
+)
+
+func getEventBus() *eventBus {
+	busOnce.Do(func() {
+		bus = &eventBus{
+			subscribers: typeutil.NewConcurrentMap[string, *Subscription](),
+		}
+	})
+	return bus
+}
+
+// Publish dispatches the event to the subscribers without blocking,
+// and records it to the event loggers as well.
+func Publish(evt *ClusterEvent) {
+	if evt.Ts == 0 {
+		evt.Ts = time.Now().UnixNano()
+	}
+	getEventBus().subscribers.Range(func(_ string, sub *Subscription) bool {
+		sub.notify(evt)
+		return true
+	})
+	Record(evt)
+}
+
+// Subscribe subscribes the events of the given types, all types if no type specified.
+// The events are dropped if the buffer of subscription is full.
+func Subscribe(bufferSize int, types ...EventType) *Subscription {
+	sub := &Subscription{
+		key:   funcutil.RandomString(8),
+		types: typeutil.NewSet(types...),
+		ch:    make(chan *ClusterEvent, bufferSize),
+	}
+	getEventBus().subscribers.Insert(sub.key, sub)
+	return sub
+}
@@ -0,0 +1,118 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package eventlog
+
+import (
+	"bufio"
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/suite"
+)
+
+type BusSuite struct {
+	suite.Suite
+}
+
+func (s *BusSuite) TestParseEventType() {
+	tp, ok := ParseEventType(" Segment_Sealed ")
+	s.True(ok)
+	s.Equal(EventSegmentSealed, tp)
+
+	_, ok = ParseEventType("unknown")
+	s.False(ok)
+	s.Equal("unknown", EventType(100).String())
+}
+
+func (s *BusSuite) TestSubscribe() {
+	all := Subscribe(10)
+	defer all.Close()
+	sealed := Subscribe(10, EventSegmentSealed)
+	defer sealed.Close()
+
+	Publish(&ClusterEvent{EventType: EventSegmentSealed, SegmentIDs: []int64{1}})
+	Publish(&ClusterEvent{EventType: EventNodeDown, NodeID: 1})
+
+	evt := <-sealed.Events()
+	s.Equal(EventSegmentSealed, evt.EventType)
+	s.NotZero(evt.Ts)
+	s.Len(sealed.Events(), 0)
+
+	s.Equal(EventSegmentSealed, (<-all.Events()).EventType)
+	s.Equal(EventNodeDown, (<-all.Events()).EventType)
+}
+
+func (s *BusSuite) TestDropAndClose() {
+	sub := Subscribe(1)
+	Publish(&ClusterEvent{EventType: EventNodeDown})
+	Publish(&ClusterEvent{EventType: EventNodeDown})
+	s.EqualValues(1, sub.Dropped())
+
+	sub.Close()
+	sub.Close()
+	// publishing to closed subscription shall not panic
+	Publish(&ClusterEvent{EventType: EventNodeDown})
+	_, ok := <-sub.Events()
+	s.True(ok)
+	_, ok = <-sub.Events()
+	s.False(ok)
+}
+
+func (s *BusSuite) TestStreamHandler() {
+	req := httptest.NewRequest(http.MethodGet, "/eventlog/stream?types=abc", nil)
+	w := httptest.NewRecorder()
+	StreamHandler().ServeHTTP(w, req)
+	s.Equal(http.StatusBadRequest, w.Code)
+
+	server := httptest.NewServer(StreamHandler())
+	defer server.Close()
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+	httpReq, err := http.NewRequestWithContext(ctx, http.MethodGet, server.URL+"?types=compaction_done", nil)
+	s.Require().NoError(err)
+	resp, err := http.DefaultClient.Do(httpReq)
+	s.Require().NoError(err)
+	defer resp.Body.Close()
+	s.Equal(ContentTypeEventStream, resp.Header.Get(ContentTypeHeader))
+
+	go func() {
+		for ctx.Err() == nil {
+			Publish(&ClusterEvent{EventType: EventNodeDown})
+			Publish(&ClusterEvent{EventType: EventCompactionDone, CollectionID: 100})
+			time.Sleep(50 * time.Millisecond)
+		}
+	}()
+
+	reader := bufio.NewReader(resp.Body)
+	line, err := reader.ReadString('\n')
+	s.Require().NoError(err)
+	s.Equal("event: compaction_done\n", line)
+	line, err = reader.ReadString('\n')
+	s.Require().NoError(err)
+	s.True(strings.HasPrefix(line, "data: "))
+	s.Contains(line, `"type":"compaction_done"`)
+	s.Contains(line, `"collection_id":100`)
+}
+
+func TestBus(t *testing.T) {
+	suite.Run(t, new(BusSuite))
+}
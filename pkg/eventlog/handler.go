@@ -18,7 +18,11 @@ package eventlog
 
 import (
 	"encoding/json"
+	"fmt"
+	"io"
 	"net/http"
+	"strings"
+	"time"
 
 	"go.uber.org/zap"
 
@@ -72,3 +76,70 @@ func writeJSON(w http.ResponseWriter, r *http.Request, resp *eventLogResponse) {
 	}
 	w.Write(bs)
 }
+
+const (
+	// ContentTypeEventStream is the content type of server-sent events.
+	ContentTypeEventStream = "text/event-stream"
+
+	eventStreamBufferSize        = 1024
+	eventStreamKeepaliveInterval = 15 * time.Second
+)
+
+type eventStreamHandler struct{}
+
+// StreamHandler returns the handler streaming the cluster events as server-sent events,
+// the event types could be filtered by the "types" parameter separated by comma.
+func StreamHandler() http.Handler {
+	return &eventStreamHandler{}
+}
+
+func (h *eventStreamHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	flusher, ok := w.(http.Flusher)
+	if !ok {
+		http.Error(w, "streaming is not supported", http.StatusInternalServerError)
+		return
+	}
+
+	var types []EventType
+	if param := r.URL.Query().Get("types"); param != "" {
+		for _, name := range strings.Split(param, ",") {
+			tp, ok := ParseEventType(name)
+			if !ok {
+				http.Error(w, fmt.Sprintf("unknown event type %s", name), http.StatusBadRequest)
+				return
+			}
+			types = append(types, tp)
+		}
+	}
+
+	sub := Subscribe(eventStreamBufferSize, types...)
+	defer sub.Close()
+
+	w.Header().Set(ContentTypeHeader, ContentTypeEventStream)
+	w.Header().Set("Cache-Control", "no-cache")
+	w.Header().Set("Connection", "keep-alive")
+	w.WriteHeader(http.StatusOK)
+	flusher.Flush()
+
+	ticker := time.NewTicker(eventStreamKeepaliveInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-r.Context().Done():
+			return
+		case <-ticker.C:
+			if _, err := io.WriteString(w, ": keepalive\n\n"); err != nil {
+				return
+			}
+		case evt, ok := <-sub.Events():
+			if !ok {
+				return
+			}
+			if _, err := fmt.Fprintf(w, "event: %s\ndata: %s\n\n", evt.EventType, evt.Raw()); err != nil {
+				log.Warn("failed to send event", zap.Error(err))
+				return
+			}
+		}
+		flusher.Flush()
+	}
+}
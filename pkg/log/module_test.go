@@ -0,0 +1,66 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package log
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/zap"
+)
+
+func TestModuleLogger(t *testing.T) {
+	// module logger created before global logger replaced
+	logger := Module("test.module")
+
+	ts := newTestLogSpy(t)
+	conf := &Config{Level: "debug", DisableTimestamp: true}
+	l, p, _ := InitTestLogger(ts, conf)
+	ReplaceGlobals(l, p)
+	replaceLeveledLoggers(l)
+	SetLevel(zap.InfoLevel)
+
+	logger.Info("module info")
+	ts.assertLastMessageContains("module info")
+	ts.assertLastMessageContains("module=test.module")
+	logger.Debug("module debug hidden")
+	ts.assertMessagesNotContains("module debug hidden")
+	assert.Equal(t, zap.InfoLevel, GetModuleLevel("test.module"))
+
+	SetModuleLevel("test.module", zap.DebugLevel)
+	assert.Equal(t, zap.DebugLevel, GetModuleLevel("test.module"))
+	assert.Equal(t, zap.DebugLevel, GetModuleLevels()["test.module"])
+	logger.With(zap.String("field", "test")).Debug("module debug shown")
+	ts.assertLastMessageContains("module debug shown")
+	ts.assertLastMessageContains("field=test")
+	// other modules are not affected
+	Module("test.other").Debug("other debug hidden")
+	Debug("global debug hidden")
+	ts.assertMessagesNotContains("other debug hidden")
+	ts.assertMessagesNotContains("global debug hidden")
+
+	SetModuleLevel("test.module", zap.ErrorLevel)
+	logger.Warn("module warn hidden")
+	ts.assertMessagesNotContains("module warn hidden")
+
+	ResetModuleLevel("test.module")
+	assert.Equal(t, zap.InfoLevel, GetModuleLevel("test.module"))
+	logger.Warn("module warn shown")
+	ts.assertLastMessageContains("module warn shown")
+	logger.Debug("module debug hidden again")
+	ts.assertMessagesNotContains("module debug hidden again")
+}
@@ -24,6 +24,10 @@ import (
 	"github.com/milvus-io/milvus/pkg/util/typeutil"
 )
 
+const (
+	flowGraphNodeLabelName = "fg_node"
+)
+
 var (
 	DataNodeNumFlowGraphs = prometheus.NewGaugeVec(
 		prometheus.GaugeOpts{
@@ -221,6 +225,64 @@ var (
 			nodeIDLabelName,
 			channelNameLabelName,
 		})
+
+	DataNodeFlowGraphNodeOperateCount = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Namespace: milvusNamespace,
+			Subsystem: typeutil.DataNodeRole,
+			Name:      "fg_node_operate_count",
+			Help:      "count of operations of flow graph nodes",
+		}, []string{
+			nodeIDLabelName,
+			channelNameLabelName,
+			flowGraphNodeLabelName,
+		})
+
+	DataNodeFlowGraphNodeOperateLatency = prometheus.NewHistogramVec(
+		prometheus.HistogramOpts{
+			Namespace: milvusNamespace,
+			Subsystem: typeutil.DataNodeRole,
+			Name:      "fg_node_operate_latency",
+			Help:      "latency of operations of flow graph nodes, the input node includes the time waiting for messages",
+			Buckets:   buckets, // unit: ms
+		}, []string{
+			nodeIDLabelName,
+			channelNameLabelName,
+			flowGraphNodeLabelName,
+		})
+
+	DataNodeFlowGraphNodeQueueLength = prometheus.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Namespace: milvusNamespace,
+			Subsystem: typeutil.DataNodeRole,
+			Name:      "fg_node_queue_length",
+			Help:      "number of messages waiting in the input queue of flow graph nodes",
+		}, []string{
+			nodeIDLabelName,
+			channelNameLabelName,
+			flowGraphNodeLabelName,
+		})
+
+	DataNodeCDCPublishCount = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Namespace: milvusNamespace,
+			Subsystem: typeutil.DataNodeRole,
+			Name:      "cdc_publish_count",
+			Help:      "count of flush packs published to cdc topics, the failed ones are dropped after retries",
+		}, []string{
+			nodeIDLabelName,
+			statusLabelName,
+		})
+
+	DataNodeCDCPublishQueueLength = prometheus.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Namespace: milvusNamespace,
+			Subsystem: typeutil.DataNodeRole,
+			Name:      "cdc_publish_queue_length",
+			Help:      "number of committed flush packs waiting to be published to cdc topics",
+		}, []string{
+			nodeIDLabelName,
+		})
 )
 
 // RegisterDataNode registers DataNode metrics
@@ -243,6 +305,22 @@ func RegisterDataNode(registry *prometheus.Registry) {
 	registry.MustRegister(DataNodeMsgDispatcherTtLag)
 	registry.MustRegister(DataNodeCompactionLatencyInQueue)
 	registry.MustRegister(DataNodeFlowGraphBufferDataSize)
+	registry.MustRegister(DataNodeFlowGraphNodeOperateCount)
+	registry.MustRegister(DataNodeFlowGraphNodeOperateLatency)
+	registry.MustRegister(DataNodeFlowGraphNodeQueueLength)
+	registry.MustRegister(DataNodeCDCPublishCount)
+	registry.MustRegister(DataNodeCDCPublishQueueLength)
+}
+
+// CleanupDataNodeFlowGraphMetrics removes the metrics of the flow graph nodes of the channel
+func CleanupDataNodeFlowGraphMetrics(nodeID int64, channel string) {
+	labels := prometheus.Labels{
+		nodeIDLabelName:      fmt.Sprint(nodeID),
+		channelNameLabelName: channel,
+	}
+	DataNodeFlowGraphNodeOperateCount.DeletePartialMatch(labels)
+	DataNodeFlowGraphNodeOperateLatency.DeletePartialMatch(labels)
+	DataNodeFlowGraphNodeQueueLength.DeletePartialMatch(labels)
 }
 
 func CleanupDataNodeCollectionMetrics(nodeID int64, collectionID int64, channel string) {
@@ -0,0 +1,61 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package metrics
+
+import (
+	"context"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"go.opentelemetry.io/otel/trace"
+
+	"github.com/milvus-io/milvus/pkg/util/logutil"
+)
+
+const (
+	exemplarTraceIDLabel   = "trace_id"
+	exemplarRequestIDLabel = "request_id"
+)
+
+// ObserveWithTrace observes the value with the trace id and the request id in ctx as the exemplar,
+// so that a latency spike could be pivoted to the traces and logs of the requests causing it.
+// The exemplars are exposed in the OpenMetrics format only.
+func ObserveWithTrace(ctx context.Context, observer prometheus.Observer, value float64) {
+	exemplarObserver, ok := observer.(prometheus.ExemplarObserver)
+	if !ok {
+		observer.Observe(value)
+		return
+	}
+	labels := exemplarLabels(ctx)
+	if len(labels) == 0 {
+		observer.Observe(value)
+		return
+	}
+	exemplarObserver.ObserveWithExemplar(value, labels)
+}
+
+// exemplarLabels returns the labels of the exemplar, the length of which is within the limit of 128 runes,
+// as the trace id is 32 hex characters and the request id is at most 64 characters.
+func exemplarLabels(ctx context.Context) prometheus.Labels {
+	labels := prometheus.Labels{}
+	if traceID := trace.SpanFromContext(ctx).SpanContext().TraceID(); traceID.IsValid() {
+		labels[exemplarTraceIDLabel] = traceID.String()
+	}
+	if requestID := logutil.GetRequestID(ctx); requestID != "" {
+		labels[exemplarRequestIDLabel] = requestID
+	}
+	return labels
+}
@@ -0,0 +1,69 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package metrics
+
+import (
+	"context"
+	"testing"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/stretchr/testify/assert"
+	"go.opentelemetry.io/otel/trace"
+
+	"github.com/milvus-io/milvus/pkg/util/logutil"
+)
+
+type mockExemplarObserver struct {
+	values   []float64
+	exemplar prometheus.Labels
+}
+
+func (o *mockExemplarObserver) Observe(value float64) {
+	o.values = append(o.values, value)
+}
+
+func (o *mockExemplarObserver) ObserveWithExemplar(value float64, exemplar prometheus.Labels) {
+	o.values = append(o.values, value)
+	o.exemplar = exemplar
+}
+
+func TestObserveWithTrace(t *testing.T) {
+	observer := &mockExemplarObserver{}
+	ObserveWithTrace(context.Background(), observer, 1)
+	assert.Equal(t, []float64{1}, observer.values)
+	assert.Nil(t, observer.exemplar)
+
+	traceID := trace.TraceID{1, 2, 3}
+	ctx := trace.ContextWithSpanContext(context.Background(), trace.NewSpanContext(trace.SpanContextConfig{
+		TraceID: traceID,
+		SpanID:  trace.SpanID{1},
+	}))
+	ObserveWithTrace(ctx, observer, 2)
+	assert.Equal(t, prometheus.Labels{exemplarTraceIDLabel: traceID.String()}, observer.exemplar)
+
+	requestID := logutil.NewRequestID()
+	ObserveWithTrace(logutil.WithRequestID(ctx, requestID), observer, 3)
+	assert.Equal(t, prometheus.Labels{
+		exemplarTraceIDLabel:   traceID.String(),
+		exemplarRequestIDLabel: requestID,
+	}, observer.exemplar)
+	assert.Equal(t, []float64{1, 2, 3}, observer.values)
+
+	// the exemplar is accepted by the histogram
+	histogram := prometheus.NewHistogram(prometheus.HistogramOpts{Name: "test_observe_with_trace"})
+	ObserveWithTrace(logutil.WithRequestID(ctx, requestID), histogram, 1)
+}
@@ -77,6 +77,7 @@ const (
 	segmentStateLabelName    = "segment_state"
 	segmentIDLabelName       = "segment_id"
 	usernameLabelName        = "username"
+	apiKeyIDLabelName        = "api_key_id"
 	roleNameLabelName        = "role_name"
 	cacheNameLabelName       = "cache_name"
 	cacheStateLabelName      = "cache_state"
@@ -88,6 +89,8 @@ const (
 	lockSource               = "lock_source"
 	lockType                 = "lock_type"
 	lockOp                   = "lock_op"
+	stageLabelName           = "stage"
+	databaseLabelName        = "db_name"
 )
 
 var (
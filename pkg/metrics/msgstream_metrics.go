@@ -56,6 +56,39 @@ var (
 			Name:      "op_count",
 			Help:      "count of stream message operation",
 		}, []string{msgStreamOpType, statusLabelName})
+
+	RocksmqTopicSize = prometheus.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Namespace: milvusNamespace,
+			Subsystem: "rocksmq",
+			Name:      "topic_size",
+			Help:      "size of the messages retained by the rocksmq topic in bytes",
+		}, []string{channelNameLabelName})
+
+	RocksmqTopicOldestMessageAge = prometheus.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Namespace: milvusNamespace,
+			Subsystem: "rocksmq",
+			Name:      "topic_oldest_message_age",
+			Help:      "age of the oldest message page retained by the rocksmq topic in seconds",
+		}, []string{channelNameLabelName})
+
+	RocksmqRetentionCleanedSize = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Namespace: milvusNamespace,
+			Subsystem: "rocksmq",
+			Name:      "retention_cleaned_size",
+			Help:      "size of the messages cleaned by the rocksmq retention in bytes",
+		}, []string{channelNameLabelName})
+
+	RocksmqCompactionLatency = prometheus.NewHistogram(
+		prometheus.HistogramOpts{
+			Namespace: milvusNamespace,
+			Subsystem: "rocksmq",
+			Name:      "compaction_latency",
+			Help:      "latency of the background rocksmq compaction reclaiming the space of cleaned messages in milliseconds",
+			Buckets:   longTaskBuckets,
+		})
 )
 
 // RegisterMsgStreamMetrics registers msg stream metrics
@@ -63,4 +96,8 @@ func RegisterMsgStreamMetrics(registry *prometheus.Registry) {
 	registry.MustRegister(NumConsumers)
 	registry.MustRegister(MsgStreamRequestLatency)
 	registry.MustRegister(MsgStreamOpCounter)
+	registry.MustRegister(RocksmqTopicSize)
+	registry.MustRegister(RocksmqTopicOldestMessageAge)
+	registry.MustRegister(RocksmqRetentionCleanedSize)
+	registry.MustRegister(RocksmqCompactionLatency)
 }
@@ -26,6 +26,8 @@ const (
 	DataStatLabel   = "stat"
 
 	persistentDataOpType = "persistent_data_op_type"
+	errorClassLabelName  = "error_class"
+	endpointLabelName    = "endpoint"
 )
 
 var (
@@ -54,6 +56,30 @@ var (
 			Name:      "op_count",
 			Help:      "count of persistent data operation",
 		}, []string{persistentDataOpType, statusLabelName})
+
+	PersistentDataRetryCounter = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Namespace: milvusNamespace,
+			Subsystem: "storage",
+			Name:      "retry_count",
+			Help:      "count of persistent data operation retried by error class",
+		}, []string{persistentDataOpType, errorClassLabelName})
+
+	PersistentDataCircuitBreakerState = prometheus.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Namespace: milvusNamespace,
+			Subsystem: "storage",
+			Name:      "circuit_breaker_state",
+			Help:      "circuit breaker state of object storage endpoint, 0 for closed, 1 for open and 2 for half open",
+		}, []string{endpointLabelName})
+
+	PersistentDataChecksumMismatchCounter = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Namespace: milvusNamespace,
+			Subsystem: "storage",
+			Name:      "checksum_mismatch_count",
+			Help:      "count of binlogs whose content mismatches the checksum recorded in segment meta",
+		}, []string{roleNameLabelName})
 )
 
 // RegisterStorageMetrics registers storage metrics
@@ -61,4 +87,7 @@ func RegisterStorageMetrics(registry *prometheus.Registry) {
 	registry.MustRegister(PersistentDataKvSize)
 	registry.MustRegister(PersistentDataRequestLatency)
 	registry.MustRegister(PersistentDataOpCounter)
+	registry.MustRegister(PersistentDataRetryCounter)
+	registry.MustRegister(PersistentDataCircuitBreakerState)
+	registry.MustRegister(PersistentDataChecksumMismatchCounter)
 }
@@ -109,6 +109,16 @@ var (
 			Buckets:   buckets, // unit: ms
 		}, []string{nodeIDLabelName, queryTypeLabelName})
 
+	// ProxyReduceSubResultNum record the number of sub results merged when the proxy reduces search result.
+	ProxyReduceSubResultNum = prometheus.NewHistogramVec(
+		prometheus.HistogramOpts{
+			Namespace: milvusNamespace,
+			Subsystem: typeutil.ProxyRole,
+			Name:      "sq_reduce_sub_result_num",
+			Help:      "number of sub results merged when proxy reduces search result",
+			Buckets:   prometheus.ExponentialBuckets(1, 2, 10),
+		}, []string{nodeIDLabelName, queryTypeLabelName})
+
 	// ProxyDecodeResultLatency record the time that the proxy decodes the search result.
 	ProxyDecodeResultLatency = prometheus.NewHistogramVec(
 		prometheus.HistogramOpts{
@@ -268,6 +278,15 @@ var (
 			Help:      "the rpc count of a user",
 		}, []string{usernameLabelName})
 
+	// ProxyAPIKeyRequestCounter records the requests authenticated by the api keys issued by rootcoord.
+	ProxyAPIKeyRequestCounter = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Namespace: milvusNamespace,
+			Subsystem: typeutil.ProxyRole,
+			Name:      "api_key_request_count",
+			Help:      "the request count authenticated by the api key",
+		}, []string{nodeIDLabelName, apiKeyIDLabelName, usernameLabelName, statusLabelName})
+
 	// ProxyWorkLoadScore record the score that measured query node's workload.
 	ProxyWorkLoadScore = prometheus.NewGaugeVec(
 		prometheus.GaugeOpts{
@@ -288,6 +307,71 @@ var (
 		}, []string{
 			nodeIDLabelName,
 		})
+
+	// ProxyDQLStageQueueLength records the number of dql tasks waiting in the queue of each pipeline stage.
+	ProxyDQLStageQueueLength = prometheus.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Namespace: milvusNamespace,
+			Subsystem: typeutil.ProxyRole,
+			Name:      "dql_stage_queue_length",
+			Help:      "number of dql tasks waiting in the queue of pipeline stage",
+		}, []string{
+			nodeIDLabelName,
+			stageLabelName,
+		})
+
+	// ProxyDQLStageQueueLatency records the time dql tasks waited in the queue of each pipeline stage.
+	ProxyDQLStageQueueLatency = prometheus.NewHistogramVec(
+		prometheus.HistogramOpts{
+			Namespace: milvusNamespace,
+			Subsystem: typeutil.ProxyRole,
+			Name:      "dql_stage_queue_latency",
+			Help:      "latency of dql tasks waited in the queue of pipeline stage",
+			Buckets:   buckets, // unit: ms
+		}, []string{
+			nodeIDLabelName,
+			stageLabelName,
+		})
+
+	// ProxyCoordClientQueueLength records the number of requests of each database waiting for the concurrency quota of coordinator client.
+	ProxyCoordClientQueueLength = prometheus.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Namespace: milvusNamespace,
+			Subsystem: typeutil.ProxyRole,
+			Name:      "coord_client_queue_length",
+			Help:      "number of requests waiting for the concurrency quota of coordinator client",
+		}, []string{
+			nodeIDLabelName,
+			roleNameLabelName,
+			databaseLabelName,
+		})
+
+	// ProxyCoordClientQueueLatency records the time requests of each database waited for the concurrency quota of coordinator client.
+	ProxyCoordClientQueueLatency = prometheus.NewHistogramVec(
+		prometheus.HistogramOpts{
+			Namespace: milvusNamespace,
+			Subsystem: typeutil.ProxyRole,
+			Name:      "coord_client_queue_latency",
+			Help:      "latency of requests waited for the concurrency quota of coordinator client",
+			Buckets:   buckets, // unit: ms
+		}, []string{
+			nodeIDLabelName,
+			roleNameLabelName,
+			databaseLabelName,
+		})
+
+	// ProxyCoordClientInflight records the number of executing requests of each database to coordinator.
+	ProxyCoordClientInflight = prometheus.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Namespace: milvusNamespace,
+			Subsystem: typeutil.ProxyRole,
+			Name:      "coord_client_inflight",
+			Help:      "number of executing requests to coordinator",
+		}, []string{
+			nodeIDLabelName,
+			roleNameLabelName,
+			databaseLabelName,
+		})
 )
 
 // RegisterProxy registers Proxy metrics
@@ -303,6 +387,7 @@ func RegisterProxy(registry *prometheus.Registry) {
 
 	registry.MustRegister(ProxyWaitForSearchResultLatency)
 	registry.MustRegister(ProxyReduceResultLatency)
+	registry.MustRegister(ProxyReduceSubResultNum)
 	registry.MustRegister(ProxyDecodeResultLatency)
 
 	registry.MustRegister(ProxyMsgStreamObjectsForPChan)
@@ -328,9 +413,17 @@ func RegisterProxy(registry *prometheus.Registry) {
 	registry.MustRegister(ProxyLimiterRate)
 	registry.MustRegister(ProxyHookFunc)
 	registry.MustRegister(UserRPCCounter)
+	registry.MustRegister(ProxyAPIKeyRequestCounter)
 
 	registry.MustRegister(ProxyWorkLoadScore)
 	registry.MustRegister(ProxyExecutingTotalNq)
+
+	registry.MustRegister(ProxyDQLStageQueueLength)
+	registry.MustRegister(ProxyDQLStageQueueLatency)
+
+	registry.MustRegister(ProxyCoordClientQueueLength)
+	registry.MustRegister(ProxyCoordClientQueueLatency)
+	registry.MustRegister(ProxyCoordClientInflight)
 }
 
 func CleanupCollectionMetrics(nodeID int64, collection string) {
@@ -379,3 +472,11 @@ func CleanupCollectionMetrics(nodeID int64, collection string) {
 		msgTypeLabelName: UpsertLabel, collectionName: collection,
 	})
 }
+
+// CleanupAPIKeyMetrics removes the metrics of the revoked api key
+func CleanupAPIKeyMetrics(nodeID int64, id string) {
+	ProxyAPIKeyRequestCounter.DeletePartialMatch(prometheus.Labels{
+		nodeIDLabelName:   strconv.FormatInt(nodeID, 10),
+		apiKeyIDLabelName: id,
+	})
+}
@@ -32,6 +32,11 @@ const (
 	ChannelMoveTaskLabel   = "channel_move"
 
 	QueryCoordTaskType = "querycoord_task_type"
+
+	ReplicaDivergenceRowCountLabel = "row_count"
+	ReplicaDivergenceDeltaLagLabel = "delta_lag"
+
+	divergenceTypeLabelName = "divergence_type"
 )
 
 var (
@@ -104,6 +109,30 @@ var (
 			Name:      "querynode_num",
 			Help:      "number of QueryNodes managered by QueryCoord",
 		}, []string{})
+
+	QueryCoordNodeHealthScore = prometheus.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Namespace: milvusNamespace,
+			Subsystem: typeutil.QueryCoordRole,
+			Name:      "querynode_health_score",
+			Help:      "health score of QueryNodes evaluated by QueryCoord",
+		}, []string{nodeIDLabelName})
+
+	QueryCoordDivergentSegmentNum = prometheus.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Namespace: milvusNamespace,
+			Subsystem: typeutil.QueryCoordRole,
+			Name:      "divergent_segment_num",
+			Help:      "number of sampled segments divergent across replicas in the latest consistency check",
+		}, []string{collectionIDLabelName, divergenceTypeLabelName})
+
+	QueryCoordReplicaResyncCount = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Namespace: milvusNamespace,
+			Subsystem: typeutil.QueryCoordRole,
+			Name:      "replica_resync_count",
+			Help:      "count of segments reloaded for the lagging replicas",
+		}, []string{collectionIDLabelName})
 )
 
 // RegisterQueryCoord registers QueryCoord metrics
@@ -116,4 +145,7 @@ func RegisterQueryCoord(registry *prometheus.Registry) {
 	registry.MustRegister(QueryCoordReleaseLatency)
 	registry.MustRegister(QueryCoordTaskNum)
 	registry.MustRegister(QueryCoordNumQueryNodes)
+	registry.MustRegister(QueryCoordNodeHealthScore)
+	registry.MustRegister(QueryCoordDivergentSegmentNum)
+	registry.MustRegister(QueryCoordReplicaResyncCount)
 }
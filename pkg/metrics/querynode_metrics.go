@@ -228,6 +228,19 @@ var (
 			reduceLevelName,
 		})
 
+	QueryNodeReduceSubResultNum = prometheus.NewHistogramVec(
+		prometheus.HistogramOpts{
+			Namespace: milvusNamespace,
+			Subsystem: typeutil.QueryNodeRole,
+			Name:      "sq_reduce_sub_result_num",
+			Help:      "number of sub results merged in a reduce of search or query result",
+			Buckets:   prometheus.ExponentialBuckets(1, 2, 10),
+		}, []string{
+			nodeIDLabelName,
+			queryTypeLabelName,
+			reduceLevelName,
+		})
+
 	QueryNodeLoadSegmentLatency = prometheus.NewHistogramVec(
 		prometheus.HistogramOpts{
 			Namespace: milvusNamespace,
@@ -442,6 +455,173 @@ var (
 		}, []string{
 			nodeIDLabelName,
 		})
+
+	QueryNodeReservedReadMemory = prometheus.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Namespace: milvusNamespace,
+			Subsystem: typeutil.QueryNodeRole,
+			Name:      "reserved_read_memory",
+			Help:      "estimated memory(bytes) reserved by executing read tasks",
+		}, []string{
+			nodeIDLabelName,
+		})
+
+	QueryNodeReadMemoryRejectCount = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Namespace: milvusNamespace,
+			Subsystem: typeutil.QueryNodeRole,
+			Name:      "read_memory_reject_count",
+			Help:      "count of read tasks rejected because of memory budget",
+		}, []string{
+			nodeIDLabelName,
+		})
+
+	QueryNodeCollectionSearchWaitLatency = prometheus.NewHistogramVec(
+		prometheus.HistogramOpts{
+			Namespace: milvusNamespace,
+			Subsystem: typeutil.QueryNodeRole,
+			Name:      "collection_search_wait_latency",
+			Help:      "latency(ms) of search tasks waiting in queue before executing per collection",
+			Buckets:   buckets,
+		}, []string{
+			nodeIDLabelName,
+			collectionIDLabelName,
+		})
+
+	QueryNodeCollectionSearchConcurrency = prometheus.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Namespace: milvusNamespace,
+			Subsystem: typeutil.QueryNodeRole,
+			Name:      "collection_search_concurrency",
+			Help:      "number of search tasks executing per collection",
+		}, []string{
+			nodeIDLabelName,
+			collectionIDLabelName,
+		})
+
+	QueryNodeCollectionSearchWaitingNum = prometheus.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Namespace: milvusNamespace,
+			Subsystem: typeutil.QueryNodeRole,
+			Name:      "collection_search_waiting_num",
+			Help:      "number of search tasks waiting for the concurrency quota per collection",
+		}, []string{
+			nodeIDLabelName,
+			collectionIDLabelName,
+		})
+
+	QueryNodeSegmentWarmupLatency = prometheus.NewHistogramVec(
+		prometheus.HistogramOpts{
+			Namespace: milvusNamespace,
+			Subsystem: typeutil.QueryNodeRole,
+			Name:      "segment_warmup_latency",
+			Help:      "latency of warming up the mmapped pages per segment",
+			Buckets:   []float64{0.1, 0.5, 1, 5, 10, 20, 50, 100, 300, 600, 1200}, // unit seconds
+		}, []string{
+			nodeIDLabelName,
+		})
+
+	QueryNodeSegmentWarmupBytes = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Namespace: milvusNamespace,
+			Subsystem: typeutil.QueryNodeRole,
+			Name:      "segment_warmup_bytes",
+			Help:      "bytes of mmapped pages touched by segment warmup",
+		}, []string{
+			nodeIDLabelName,
+		})
+
+	QueryNodeFilterBitsetCacheCount = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Namespace: milvusNamespace,
+			Subsystem: typeutil.QueryNodeRole,
+			Name:      "filter_bitset_cache_count",
+			Help:      "count of filter bitset cache hits and misses",
+		}, []string{
+			nodeIDLabelName,
+			cacheStateLabelName,
+		})
+
+	QueryNodeFilterBitsetCacheSize = prometheus.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Namespace: milvusNamespace,
+			Subsystem: typeutil.QueryNodeRole,
+			Name:      "filter_bitset_cache_size",
+			Help:      "memory size in bytes of the cached filter bitsets",
+		}, []string{
+			nodeIDLabelName,
+		})
+
+	QueryNodeSearchMissedSegments = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Namespace: milvusNamespace,
+			Subsystem: typeutil.QueryNodeRole,
+			Name:      "search_missed_segments",
+			Help:      "count of segments not searched in time for the searches allowing partial results",
+		}, []string{
+			nodeIDLabelName,
+			segmentStateLabelName,
+		})
+
+	QueryNodeStringPoolUniqueNum = prometheus.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Namespace: milvusNamespace,
+			Subsystem: typeutil.QueryNodeRole,
+			Name:      "string_pool_unique_num",
+			Help:      "number of distinct strings in the string pool",
+		}, []string{
+			nodeIDLabelName,
+		})
+
+	QueryNodeStringPoolBytes = prometheus.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Namespace: milvusNamespace,
+			Subsystem: typeutil.QueryNodeRole,
+			Name:      "string_pool_bytes",
+			Help:      "memory size in bytes of the distinct strings in the string pool",
+		}, []string{
+			nodeIDLabelName,
+		})
+
+	QueryNodeStringPoolSavedBytes = prometheus.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Namespace: milvusNamespace,
+			Subsystem: typeutil.QueryNodeRole,
+			Name:      "string_pool_saved_bytes",
+			Help:      "memory size in bytes saved by sharing the identical strings in the string pool",
+		}, []string{
+			nodeIDLabelName,
+		})
+
+	QueryNodeScratchArenaBytes = prometheus.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Namespace: milvusNamespace,
+			Subsystem: typeutil.QueryNodeRole,
+			Name:      "scratch_arena_bytes",
+			Help:      "memory size in bytes of the search buffers kept by the idle scratch arenas",
+		}, []string{
+			nodeIDLabelName,
+		})
+
+	QueryNodeScratchArenaInUse = prometheus.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Namespace: milvusNamespace,
+			Subsystem: typeutil.QueryNodeRole,
+			Name:      "scratch_arena_in_use",
+			Help:      "number of the scratch arenas used by the running searches",
+		}, []string{
+			nodeIDLabelName,
+		})
+
+	QueryNodeDelegatorFallbackCounter = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Namespace: milvusNamespace,
+			Subsystem: typeutil.QueryNodeRole,
+			Name:      "delegator_fallback_search_total",
+			Help:      "count of searches served by the local sealed segments while the shard delegator is unavailable",
+		}, []string{
+			nodeIDLabelName,
+		})
 )
 
 // RegisterQueryNode registers QueryNode metrics
@@ -459,6 +639,7 @@ func RegisterQueryNode(registry *prometheus.Registry) {
 	registry.MustRegister(QueryNodeSQSegmentLatency)
 	registry.MustRegister(QueryNodeSQSegmentLatencyInCore)
 	registry.MustRegister(QueryNodeReduceLatency)
+	registry.MustRegister(QueryNodeReduceSubResultNum)
 	registry.MustRegister(QueryNodeLoadSegmentLatency)
 	registry.MustRegister(QueryNodeReadTaskUnsolveLen)
 	registry.MustRegister(QueryNodeReadTaskReadyLen)
@@ -483,6 +664,22 @@ func RegisterQueryNode(registry *prometheus.Registry) {
 	registry.MustRegister(QueryNodeDiskUsedSize)
 	registry.MustRegister(QueryNodeProcessCost)
 	registry.MustRegister(QueryNodeWaitProcessingMsgCount)
+	registry.MustRegister(QueryNodeReservedReadMemory)
+	registry.MustRegister(QueryNodeReadMemoryRejectCount)
+	registry.MustRegister(QueryNodeCollectionSearchWaitLatency)
+	registry.MustRegister(QueryNodeCollectionSearchConcurrency)
+	registry.MustRegister(QueryNodeCollectionSearchWaitingNum)
+	registry.MustRegister(QueryNodeSegmentWarmupLatency)
+	registry.MustRegister(QueryNodeSegmentWarmupBytes)
+	registry.MustRegister(QueryNodeFilterBitsetCacheCount)
+	registry.MustRegister(QueryNodeFilterBitsetCacheSize)
+	registry.MustRegister(QueryNodeSearchMissedSegments)
+	registry.MustRegister(QueryNodeStringPoolUniqueNum)
+	registry.MustRegister(QueryNodeStringPoolBytes)
+	registry.MustRegister(QueryNodeStringPoolSavedBytes)
+	registry.MustRegister(QueryNodeScratchArenaBytes)
+	registry.MustRegister(QueryNodeScratchArenaInUse)
+	registry.MustRegister(QueryNodeDelegatorFallbackCounter)
 }
 
 func CleanupQueryNodeCollectionMetrics(nodeID int64, collectionID int64) {
@@ -503,4 +700,12 @@ func CleanupQueryNodeCollectionMetrics(nodeID int64, collectionID int64) {
 					collectionIDLabelName: fmt.Sprint(collectionID),
 				})
 	}
+
+	labels := prometheus.Labels{
+		nodeIDLabelName:       fmt.Sprint(nodeID),
+		collectionIDLabelName: fmt.Sprint(collectionID),
+	}
+	QueryNodeCollectionSearchWaitLatency.Delete(labels)
+	QueryNodeCollectionSearchConcurrency.Delete(labels)
+	QueryNodeCollectionSearchWaitingNum.Delete(labels)
 }
@@ -0,0 +1,50 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package metrics
+
+import (
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+const (
+	retryCallSiteLabelName   = "call_site"
+	retryErrorClassLabelName = "error_class"
+)
+
+var (
+	RetryFailedAttemptCounter = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Namespace: milvusNamespace,
+			Subsystem: "retry",
+			Name:      "failed_attempt_count",
+			Help:      "count of failed attempts of the retried calls, by the class of the error",
+		}, []string{retryCallSiteLabelName, retryErrorClassLabelName})
+
+	RetryCallCounter = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Namespace: milvusNamespace,
+			Subsystem: "retry",
+			Name:      "call_count",
+			Help:      "count of the retried calls, by the final status",
+		}, []string{retryCallSiteLabelName, statusLabelName})
+)
+
+// RegisterRetryMetrics registers retry metrics
+func RegisterRetryMetrics(registry *prometheus.Registry) {
+	registry.MustRegister(RetryFailedAttemptCounter)
+	registry.MustRegister(RetryCallCounter)
+}
@@ -83,6 +83,24 @@ var (
 			Help:      "timestamp saved in meta storage",
 		})
 
+	// RootCoordTimestampDrift records the drift between the latest allocated timestamp and the wall clock.
+	RootCoordTimestampDrift = prometheus.NewGauge(
+		prometheus.GaugeOpts{
+			Namespace: milvusNamespace,
+			Subsystem: typeutil.RootCoordRole,
+			Name:      "timestamp_drift_ms",
+			Help:      "physical part of the latest allocated timestamp minus the wall clock in milliseconds",
+		})
+
+	// RootCoordTimestampAllocCounter counts the allocated timestamps.
+	RootCoordTimestampAllocCounter = prometheus.NewCounter(
+		prometheus.CounterOpts{
+			Namespace: milvusNamespace,
+			Subsystem: typeutil.RootCoordRole,
+			Name:      "timestamp_alloc_count",
+			Help:      "count of timestamps allocated",
+		})
+
 	// RootCoordNumOfDatabases counts the number of database.
 	RootCoordNumOfDatabases = prometheus.NewGauge(
 		prometheus.GaugeOpts{
@@ -203,6 +221,8 @@ func RegisterRootCoord(registry *prometheus.Registry) {
 	registry.MustRegister(RootCoordIDAllocCounter)
 	registry.MustRegister(RootCoordTimestamp)
 	registry.MustRegister(RootCoordTimestampSaved)
+	registry.MustRegister(RootCoordTimestampDrift)
+	registry.MustRegister(RootCoordTimestampAllocCounter)
 
 	// for collection
 	registry.MustRegister(RootCoordNumOfCollections)
@@ -47,6 +47,11 @@ const (
 	// MemberCredID id for Milvus members (data/index/query node/coord component)
 	MemberCredID        = "@@milvus-member@@"
 	CredentialSeperator = ":"
+	// CredentialStateKey is the property key of GetCredential request to get the password state with the password
+	CredentialStateKey = "credential_state"
+	// APIKeyIDKey is the property key of GetCredential request to get the api key by id instead of the password,
+	// and the property key of InvalidateCredCache request to invalidate the cached api key
+	APIKeyIDKey         = "api_key_id"
 	UserRoot            = "root"
 	DefaultRootPassword = "Milvus"
 	DefaultTenant       = ""
@@ -59,6 +64,11 @@ const (
 	PrivilegeWord = "Privilege"
 	AnyWord       = "*"
 
+	// built-in privilege groups
+	PrivilegeGroupReadOnly  = "ReadOnly"
+	PrivilegeGroupReadWrite = "ReadWrite"
+	PrivilegeGroupAdmin     = "Admin"
+
 	IdentifierKey = "identifier"
 	HeaderDBName  = "dbName"
 )
@@ -126,6 +136,79 @@ var (
 	}
 )
 
+var (
+	readOnlyPrivileges = []string{
+		MetaStore2API(commonpb.ObjectPrivilege_PrivilegeGetStatistics.String()),
+		MetaStore2API(commonpb.ObjectPrivilege_PrivilegeIndexDetail.String()),
+		MetaStore2API(commonpb.ObjectPrivilege_PrivilegeSearch.String()),
+		MetaStore2API(commonpb.ObjectPrivilege_PrivilegeQuery.String()),
+		MetaStore2API(commonpb.ObjectPrivilege_PrivilegeGetLoadingProgress.String()),
+		MetaStore2API(commonpb.ObjectPrivilege_PrivilegeGetLoadState.String()),
+
+		MetaStore2API(commonpb.ObjectPrivilege_PrivilegeDescribeCollection.String()),
+		MetaStore2API(commonpb.ObjectPrivilege_PrivilegeShowCollections.String()),
+		MetaStore2API(commonpb.ObjectPrivilege_PrivilegeListDatabases.String()),
+		MetaStore2API(commonpb.ObjectPrivilege_PrivilegeDescribeResourceGroup.String()),
+		MetaStore2API(commonpb.ObjectPrivilege_PrivilegeListResourceGroups.String()),
+	}
+
+	readWritePrivileges = append(append([]string{}, readOnlyPrivileges...),
+		MetaStore2API(commonpb.ObjectPrivilege_PrivilegeLoad.String()),
+		MetaStore2API(commonpb.ObjectPrivilege_PrivilegeRelease.String()),
+		MetaStore2API(commonpb.ObjectPrivilege_PrivilegeInsert.String()),
+		MetaStore2API(commonpb.ObjectPrivilege_PrivilegeDelete.String()),
+		MetaStore2API(commonpb.ObjectPrivilege_PrivilegeUpsert.String()),
+		MetaStore2API(commonpb.ObjectPrivilege_PrivilegeImport.String()),
+		MetaStore2API(commonpb.ObjectPrivilege_PrivilegeFlush.String()),
+		MetaStore2API(commonpb.ObjectPrivilege_PrivilegeCompaction.String()),
+		MetaStore2API(commonpb.ObjectPrivilege_PrivilegeCreateIndex.String()),
+		MetaStore2API(commonpb.ObjectPrivilege_PrivilegeDropIndex.String()),
+	)
+
+	// PrivilegeGroups are the built-in groups of privileges, granting a group to a role on an object
+	// grants all the privileges of the group applicable to the object type.
+	PrivilegeGroups = map[string][]string{
+		PrivilegeGroupReadOnly:  readOnlyPrivileges,
+		PrivilegeGroupReadWrite: readWritePrivileges,
+		PrivilegeGroupAdmin:     adminPrivileges(),
+	}
+)
+
+// adminPrivileges returns all the object privileges except the PrivilegeAll.
+func adminPrivileges() []string {
+	privileges := make([]string, 0)
+	for _, objectType := range []string{
+		commonpb.ObjectType_Collection.String(),
+		commonpb.ObjectType_Global.String(),
+		commonpb.ObjectType_User.String(),
+	} {
+		for _, privilege := range ObjectPrivileges[objectType] {
+			if privilege != MetaStore2API(commonpb.ObjectPrivilege_PrivilegeAll.String()) {
+				privileges = append(privileges, privilege)
+			}
+		}
+	}
+	return privileges
+}
+
+// IsPrivilegeGroup returns whether the api's privilege name is a privilege group.
+func IsPrivilegeGroup(name string) bool {
+	_, ok := PrivilegeGroups[name]
+	return ok
+}
+
+// PrivilegeGroupObjectPrivileges returns the privileges of group applicable to the object type.
+func PrivilegeGroupObjectPrivileges(group string, objectType string) []string {
+	objectPrivileges := StringSet(ObjectPrivileges[objectType])
+	privileges := make([]string, 0)
+	for _, privilege := range PrivilegeGroups[group] {
+		if _, ok := objectPrivileges[privilege]; ok {
+			privileges = append(privileges, privilege)
+		}
+	}
+	return privileges
+}
+
 // StringSet convert array to map for conveniently check if the array contains an element
 func StringSet(strings []string) map[string]struct{} {
 	stringsMap := make(map[string]struct{})
@@ -150,6 +233,9 @@ func MetaStore2API(name string) string {
 }
 
 func PrivilegeNameForAPI(name string) string {
+	if strings.HasPrefix(name, PrivilegeWord) && IsPrivilegeGroup(MetaStore2API(name)) {
+		return MetaStore2API(name)
+	}
 	_, ok := commonpb.ObjectPrivilege_value[name]
 	if !ok {
 		return ""
@@ -160,7 +246,7 @@ func PrivilegeNameForAPI(name string) string {
 func PrivilegeNameForMetastore(name string) string {
 	dbPrivilege := PrivilegeWord + name
 	_, ok := commonpb.ObjectPrivilege_value[dbPrivilege]
-	if !ok {
+	if !ok && !IsPrivilegeGroup(name) {
 		return ""
 	}
 	return dbPrivilege
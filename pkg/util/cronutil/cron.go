@@ -0,0 +1,167 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cronutil
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+)
+
+type field struct {
+	name     string
+	min, max int
+}
+
+var fields = []field{
+	{"minute", 0, 59},
+	{"hour", 0, 23},
+	{"day of month", 1, 31},
+	{"month", 1, 12},
+	{"day of week", 0, 7},
+}
+
+// Schedule is a parsed cron spec of 5 fields: minute, hour, day of month, month and day of week.
+// Each field is "*", a value, a range "a-b", with an optional step "/n", or a list of them separated by comma.
+// The day of week is 0-7, both 0 and 7 are Sunday. Like cron, if both day of month and day of week are restricted,
+// the time matches if either of them matches.
+type Schedule struct {
+	minute, hour, dom, month, dow uint64
+	domStar, dowStar              bool
+}
+
+// Parse parses the cron spec, like "0 9 * * 1-5" for 9:00 of the weekdays.
+func Parse(spec string) (*Schedule, error) {
+	parts := strings.Fields(spec)
+	if len(parts) != len(fields) {
+		return nil, fmt.Errorf("invalid cron spec '%s', expected %d fields but got %d", spec, len(fields), len(parts))
+	}
+	bits := make([]uint64, len(fields))
+	for i, part := range parts {
+		b, err := parseField(part, fields[i])
+		if err != nil {
+			return nil, fmt.Errorf("invalid cron spec '%s': %w", spec, err)
+		}
+		bits[i] = b
+	}
+	// 7 is an alias of Sunday
+	if bits[4]&(1<<7) != 0 {
+		bits[4] |= 1
+	}
+	return &Schedule{
+		minute:  bits[0],
+		hour:    bits[1],
+		dom:     bits[2],
+		month:   bits[3],
+		dow:     bits[4],
+		domStar: strings.HasPrefix(parts[2], "*"),
+		dowStar: strings.HasPrefix(parts[4], "*"),
+	}, nil
+}
+
+func parseField(part string, f field) (uint64, error) {
+	var bits uint64
+	for _, item := range strings.Split(part, ",") {
+		rangePart, step := item, 1
+		if i := strings.Index(item, "/"); i >= 0 {
+			var err error
+			step, err = strconv.Atoi(item[i+1:])
+			if err != nil || step <= 0 {
+				return 0, fmt.Errorf("invalid step '%s' of %s", item[i+1:], f.name)
+			}
+			rangePart = item[:i]
+		}
+
+		lo, hi := f.min, f.max
+		switch {
+		case rangePart == "*":
+		case strings.Contains(rangePart, "-"):
+			bounds := strings.SplitN(rangePart, "-", 2)
+			var err error
+			if lo, err = parseValue(bounds[0], f); err != nil {
+				return 0, err
+			}
+			if hi, err = parseValue(bounds[1], f); err != nil {
+				return 0, err
+			}
+			if lo > hi {
+				return 0, fmt.Errorf("invalid range '%s' of %s", rangePart, f.name)
+			}
+		default:
+			v, err := parseValue(rangePart, f)
+			if err != nil {
+				return 0, err
+			}
+			lo = v
+			// "a/n" means from a to the max with step n
+			if step == 1 {
+				hi = v
+			}
+		}
+		for v := lo; v <= hi; v += step {
+			bits |= 1 << uint(v)
+		}
+	}
+	return bits, nil
+}
+
+func parseValue(s string, f field) (int, error) {
+	v, err := strconv.Atoi(s)
+	if err != nil || v < f.min || v > f.max {
+		return 0, fmt.Errorf("invalid value '%s' of %s, should be in [%d, %d]", s, f.name, f.min, f.max)
+	}
+	return v, nil
+}
+
+// Match checks whether the minute of t matches the schedule.
+func (s *Schedule) Match(t time.Time) bool {
+	if s.minute&(1<<uint(t.Minute())) == 0 ||
+		s.hour&(1<<uint(t.Hour())) == 0 ||
+		s.month&(1<<uint(t.Month())) == 0 {
+		return false
+	}
+	return s.matchDay(t)
+}
+
+func (s *Schedule) matchDay(t time.Time) bool {
+	domMatch := s.dom&(1<<uint(t.Day())) != 0
+	dowMatch := s.dow&(1<<uint(t.Weekday())) != 0
+	if s.domStar || s.dowStar {
+		return domMatch && dowMatch
+	}
+	return domMatch || dowMatch
+}
+
+// Prev returns the latest minute not after t which matches the schedule, in the location of t.
+// It searches back at most the lookback duration, false is returned if no minute matches.
+func (s *Schedule) Prev(t time.Time, lookback time.Duration) (time.Time, bool) {
+	earliest := t.Add(-lookback)
+	cur := t.Truncate(time.Minute)
+	for !cur.Before(earliest) {
+		// skip the whole hour if the hour or day doesn't match
+		if s.hour&(1<<uint(cur.Hour())) == 0 || s.month&(1<<uint(cur.Month())) == 0 || !s.matchDay(cur) {
+			cur = cur.Add(-time.Duration(cur.Minute()+1) * time.Minute)
+			continue
+		}
+		if s.minute&(1<<uint(cur.Minute())) != 0 {
+			return cur, true
+		}
+		cur = cur.Add(-time.Minute)
+	}
+	return time.Time{}, false
+}
@@ -0,0 +1,124 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cronutil
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParse(t *testing.T) {
+	valid := []string{
+		"* * * * *",
+		"0 9 * * 1-5",
+		"*/15 0-23/2 1,15 * 0",
+		"30 18 * 1-12 7",
+		"5/10 * * * *",
+	}
+	for _, spec := range valid {
+		_, err := Parse(spec)
+		assert.NoError(t, err, spec)
+	}
+
+	invalid := []string{
+		"",
+		"* * * *",
+		"* * * * * *",
+		"60 * * * *",
+		"* 24 * * *",
+		"* * 0 * *",
+		"* * * 13 *",
+		"* * * * 8",
+		"*/0 * * * *",
+		"5-1 * * * *",
+		"a * * * *",
+	}
+	for _, spec := range invalid {
+		_, err := Parse(spec)
+		assert.Error(t, err, spec)
+	}
+}
+
+func TestSchedule_Match(t *testing.T) {
+	// 2023-10-16 is Monday
+	monday := time.Date(2023, 10, 16, 9, 0, 30, 0, time.UTC)
+	sunday := time.Date(2023, 10, 15, 9, 0, 0, 0, time.UTC)
+
+	s, err := Parse("0 9 * * 1-5")
+	assert.NoError(t, err)
+	assert.True(t, s.Match(monday))
+	assert.False(t, s.Match(monday.Add(time.Minute)))
+	assert.False(t, s.Match(monday.Add(time.Hour)))
+	assert.False(t, s.Match(sunday))
+
+	// 7 is Sunday
+	s, err = Parse("0 9 * * 7")
+	assert.NoError(t, err)
+	assert.True(t, s.Match(sunday))
+	assert.False(t, s.Match(monday))
+
+	// either day of month or day of week matches
+	s, err = Parse("0 9 15 * 1")
+	assert.NoError(t, err)
+	assert.True(t, s.Match(sunday))
+	assert.True(t, s.Match(monday))
+	assert.False(t, s.Match(monday.AddDate(0, 0, 1)))
+
+	s, err = Parse("*/20 * * * *")
+	assert.NoError(t, err)
+	assert.True(t, s.Match(monday.Add(40*time.Minute)))
+	assert.False(t, s.Match(monday.Add(30*time.Minute)))
+
+	s, err = Parse("10/20 * * * *")
+	assert.NoError(t, err)
+	assert.True(t, s.Match(monday.Add(10*time.Minute)))
+	assert.True(t, s.Match(monday.Add(50*time.Minute)))
+	assert.False(t, s.Match(monday.Add(20*time.Minute)))
+}
+
+func TestSchedule_Prev(t *testing.T) {
+	// Monday 2023-10-16 12:34
+	now := time.Date(2023, 10, 16, 12, 34, 56, 0, time.UTC)
+
+	s, err := Parse("0 9 * * 1-5")
+	assert.NoError(t, err)
+	prev, ok := s.Prev(now, 7*24*time.Hour)
+	assert.True(t, ok)
+	assert.Equal(t, time.Date(2023, 10, 16, 9, 0, 0, 0, time.UTC), prev)
+
+	// the matched minute itself
+	prev, ok = s.Prev(prev, time.Hour)
+	assert.True(t, ok)
+	assert.Equal(t, time.Date(2023, 10, 16, 9, 0, 0, 0, time.UTC), prev)
+
+	// the last weekday before Monday 8:00 is Friday
+	prev, ok = s.Prev(time.Date(2023, 10, 16, 8, 0, 0, 0, time.UTC), 7*24*time.Hour)
+	assert.True(t, ok)
+	assert.Equal(t, time.Date(2023, 10, 13, 9, 0, 0, 0, time.UTC), prev)
+
+	// out of lookback
+	_, ok = s.Prev(time.Date(2023, 10, 16, 8, 0, 0, 0, time.UTC), 24*time.Hour)
+	assert.False(t, ok)
+
+	s, err = Parse("45 * * * *")
+	assert.NoError(t, err)
+	prev, ok = s.Prev(now, 7*24*time.Hour)
+	assert.True(t, ok)
+	assert.Equal(t, time.Date(2023, 10, 16, 11, 45, 0, 0, time.UTC), prev)
+}
@@ -0,0 +1,168 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package crypto
+
+import (
+	"crypto/rand"
+	"crypto/sha256"
+	"crypto/subtle"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+)
+
+const (
+	// APIKeyPrefix is the prefix of the api keys issued by milvus, the keys without it are verified by the hook.
+	APIKeyPrefix = "mvk_"
+
+	// the scopes of api key, each scope covers the lower ones
+	APIKeyScopeRead  = "read"
+	APIKeyScopeWrite = "write"
+	APIKeyScopeAdmin = "admin"
+
+	apiKeyIDBytes     = 8
+	apiKeySecretBytes = 32
+)
+
+var apiKeyScopeLevels = map[string]int{
+	APIKeyScopeRead:  1,
+	APIKeyScopeWrite: 2,
+	APIKeyScopeAdmin: 3,
+}
+
+// APIKey is an api key bound to a user, the secret is never persisted and only returned once when the key is created or rotated.
+// It's both the api key meta persisted by rootcoord and the state sent to proxy for verification.
+type APIKey struct {
+	ID       string `json:"id"`
+	Username string `json:"username"`
+	// sha256 of the secret in hex
+	HashedSecret string   `json:"hashed_secret"`
+	Scopes       []string `json:"scopes"`
+	// unix seconds, zero means the key never expires
+	ExpireTime  int64 `json:"expire_time,omitempty"`
+	CreatedTime int64 `json:"created_time"`
+	RotatedTime int64 `json:"rotated_time,omitempty"`
+}
+
+func randomHex(n int) (string, error) {
+	bs := make([]byte, n)
+	if _, err := rand.Read(bs); err != nil {
+		return "", err
+	}
+	return hex.EncodeToString(bs), nil
+}
+
+// NewAPIKeyID generates a random id of api key.
+func NewAPIKeyID() (string, error) {
+	return randomHex(apiKeyIDBytes)
+}
+
+// NewAPIKeySecret generates a random secret of api key and its hash.
+func NewAPIKeySecret() (secret string, hashedSecret string, err error) {
+	secret, err = randomHex(apiKeySecretBytes)
+	if err != nil {
+		return "", "", err
+	}
+	return secret, HashAPIKeySecret(secret), nil
+}
+
+// HashAPIKeySecret returns the sha256 of the secret in hex,
+// the secrets are random enough to make the salt and slow hash unnecessary.
+func HashAPIKeySecret(secret string) string {
+	sum := sha256.Sum256([]byte(secret))
+	return hex.EncodeToString(sum[:])
+}
+
+// FormatAPIKey returns the api key presented by clients, in the format of mvk_<id>_<secret>.
+func FormatAPIKey(id string, secret string) string {
+	return APIKeyPrefix + id + "_" + secret
+}
+
+// ParseAPIKey splits the api key presented by clients into id and secret, ok is false if it's not issued by milvus.
+func ParseAPIKey(key string) (id string, secret string, ok bool) {
+	if !strings.HasPrefix(key, APIKeyPrefix) {
+		return "", "", false
+	}
+	id, secret, ok = strings.Cut(strings.TrimPrefix(key, APIKeyPrefix), "_")
+	if !ok || id == "" || secret == "" {
+		return "", "", false
+	}
+	return id, secret, true
+}
+
+// ValidateAPIKeyScopes checks the scopes are known and not empty.
+func ValidateAPIKeyScopes(scopes []string) error {
+	if len(scopes) == 0 {
+		return fmt.Errorf("the scopes of api key are empty")
+	}
+	for _, scope := range scopes {
+		if _, ok := apiKeyScopeLevels[scope]; !ok {
+			return fmt.Errorf("unknown scope %s of api key, should be one of %s, %s and %s", scope, APIKeyScopeRead, APIKeyScopeWrite, APIKeyScopeAdmin)
+		}
+	}
+	return nil
+}
+
+// Verify checks the secret matches and the key is not expired.
+func (k *APIKey) Verify(secret string, now time.Time) error {
+	if subtle.ConstantTimeCompare([]byte(HashAPIKeySecret(secret)), []byte(k.HashedSecret)) != 1 {
+		return fmt.Errorf("secret of api key %s mismatch", k.ID)
+	}
+	if k.Expired(now) {
+		return fmt.Errorf("api key %s expired at %s", k.ID, time.Unix(k.ExpireTime, 0).Format(time.RFC3339))
+	}
+	return nil
+}
+
+// Expired checks whether the key is expired.
+func (k *APIKey) Expired(now time.Time) bool {
+	return k.ExpireTime > 0 && now.Unix() >= k.ExpireTime
+}
+
+// Allow checks whether any scope of the key covers the required scope.
+func (k *APIKey) Allow(scope string) bool {
+	required, ok := apiKeyScopeLevels[scope]
+	if !ok {
+		return false
+	}
+	for _, s := range k.Scopes {
+		if apiKeyScopeLevels[s] >= required {
+			return true
+		}
+	}
+	return false
+}
+
+// EncodeAPIKey encodes the api key to string.
+func EncodeAPIKey(key *APIKey) (string, error) {
+	bs, err := json.Marshal(key)
+	if err != nil {
+		return "", err
+	}
+	return string(bs), nil
+}
+
+// DecodeAPIKey decodes the api key encoded by EncodeAPIKey.
+func DecodeAPIKey(value string) (*APIKey, error) {
+	key := &APIKey{}
+	if err := json.Unmarshal([]byte(value), key); err != nil {
+		return nil, err
+	}
+	return key, nil
+}
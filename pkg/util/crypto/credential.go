@@ -0,0 +1,89 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package crypto
+
+import (
+	"encoding/json"
+	"time"
+
+	"golang.org/x/crypto/bcrypt"
+)
+
+// CredentialState is the password state of a user, all the passwords are encrypted by bcrypt.
+// It's both the credential meta persisted by rootcoord and the state sent to proxy for the password policy enforcement.
+type CredentialState struct {
+	EncryptedPassword string `json:"encrypted_password,omitempty"`
+	// the previous password is still valid until PreviousPasswordExpireTime (unix seconds) during the rotation window
+	PreviousPassword           string `json:"previous_password,omitempty"`
+	PreviousPasswordExpireTime int64  `json:"previous_password_expire_time,omitempty"`
+	// the passwords used before, the most recent first
+	PasswordHistory []string `json:"password_history,omitempty"`
+	// unix seconds, zero if the credential is created before the password policy is supported
+	UpdatedTime int64 `json:"updated_time,omitempty"`
+}
+
+// EncodeCredentialState encodes the state to string.
+func EncodeCredentialState(state *CredentialState) (string, error) {
+	bs, err := json.Marshal(state)
+	if err != nil {
+		return "", err
+	}
+	return string(bs), nil
+}
+
+// DecodeCredentialState decodes the state encoded by EncodeCredentialState.
+func DecodeCredentialState(value string) (*CredentialState, error) {
+	state := &CredentialState{}
+	if err := json.Unmarshal([]byte(value), state); err != nil {
+		return nil, err
+	}
+	return state, nil
+}
+
+// VerifyPreviousPassword checks whether the raw password matches the previous password which is still in the rotation window.
+func (s *CredentialState) VerifyPreviousPassword(rawPwd string, now time.Time) bool {
+	if s.PreviousPassword == "" || now.Unix() >= s.PreviousPasswordExpireTime {
+		return false
+	}
+	return bcrypt.CompareHashAndPassword([]byte(s.PreviousPassword), []byte(rawPwd)) == nil
+}
+
+// Reused checks whether the raw password is one of the latest n passwords, including the current one.
+func (s *CredentialState) Reused(rawPwd string, n int) bool {
+	if n <= 0 {
+		return false
+	}
+	passwords := append([]string{s.EncryptedPassword}, s.PasswordHistory...)
+	if len(passwords) > n {
+		passwords = passwords[:n]
+	}
+	for _, password := range passwords {
+		if password != "" && bcrypt.CompareHashAndPassword([]byte(password), []byte(rawPwd)) == nil {
+			return true
+		}
+	}
+	return false
+}
+
+// Expired checks whether the password has not been changed for longer than maxAge,
+// the credentials without updated time never expire.
+func (s *CredentialState) Expired(maxAge time.Duration, now time.Time) bool {
+	if maxAge <= 0 || s.UpdatedTime <= 0 {
+		return false
+	}
+	return now.After(time.Unix(s.UpdatedTime, 0).Add(maxAge))
+}
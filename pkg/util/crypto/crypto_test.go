@@ -2,6 +2,7 @@ package crypto
 
 import (
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 	"golang.org/x/crypto/bcrypt"
@@ -45,3 +46,97 @@ func TestBcryptCost(t *testing.T) {
 func TestMD5(t *testing.T) {
 	assert.Equal(t, "67f48520697662a2", MD5("These pretzels are making me thirsty."))
 }
+
+func TestCredentialState(t *testing.T) {
+	current, err := PasswordEncrypt("current")
+	assert.NoError(t, err)
+	previous, err := PasswordEncrypt("previous")
+	assert.NoError(t, err)
+	oldest, err := PasswordEncrypt("oldest")
+	assert.NoError(t, err)
+
+	now := time.Now()
+	state := &CredentialState{
+		EncryptedPassword:          current,
+		PreviousPassword:           previous,
+		PreviousPasswordExpireTime: now.Add(time.Hour).Unix(),
+		PasswordHistory:            []string{previous, oldest},
+		UpdatedTime:                now.Add(-48 * time.Hour).Unix(),
+	}
+
+	value, err := EncodeCredentialState(state)
+	assert.NoError(t, err)
+	decoded, err := DecodeCredentialState(value)
+	assert.NoError(t, err)
+	assert.Equal(t, state, decoded)
+	_, err = DecodeCredentialState("invalid")
+	assert.Error(t, err)
+
+	assert.True(t, state.VerifyPreviousPassword("previous", now))
+	assert.False(t, state.VerifyPreviousPassword("current", now))
+	assert.False(t, state.VerifyPreviousPassword("previous", now.Add(2*time.Hour)))
+
+	assert.False(t, state.Reused("previous", 0))
+	assert.True(t, state.Reused("current", 1))
+	assert.False(t, state.Reused("previous", 1))
+	assert.True(t, state.Reused("previous", 2))
+	assert.False(t, state.Reused("oldest", 2))
+	assert.True(t, state.Reused("oldest", 3))
+	assert.False(t, state.Reused("new", 10))
+
+	assert.False(t, state.Expired(0, now))
+	assert.False(t, state.Expired(72*time.Hour, now))
+	assert.True(t, state.Expired(24*time.Hour, now))
+	state.UpdatedTime = 0
+	assert.False(t, state.Expired(24*time.Hour, now))
+}
+
+func TestAPIKey(t *testing.T) {
+	id, err := NewAPIKeyID()
+	assert.NoError(t, err)
+	assert.Len(t, id, 16)
+	secret, hashedSecret, err := NewAPIKeySecret()
+	assert.NoError(t, err)
+	assert.Equal(t, HashAPIKeySecret(secret), hashedSecret)
+
+	parsedID, parsedSecret, ok := ParseAPIKey(FormatAPIKey(id, secret))
+	assert.True(t, ok)
+	assert.Equal(t, id, parsedID)
+	assert.Equal(t, secret, parsedSecret)
+	for _, key := range []string{"", "plugin-key", "mvk_", "mvk_id", "mvk__secret", "mvk_id_"} {
+		_, _, ok = ParseAPIKey(key)
+		assert.False(t, ok, key)
+	}
+
+	now := time.Now()
+	key := &APIKey{
+		ID:           id,
+		Username:     "user",
+		HashedSecret: hashedSecret,
+		Scopes:       []string{APIKeyScopeWrite},
+		ExpireTime:   now.Add(time.Hour).Unix(),
+		CreatedTime:  now.Unix(),
+	}
+	value, err := EncodeAPIKey(key)
+	assert.NoError(t, err)
+	decoded, err := DecodeAPIKey(value)
+	assert.NoError(t, err)
+	assert.Equal(t, key, decoded)
+	_, err = DecodeAPIKey("invalid")
+	assert.Error(t, err)
+
+	assert.NoError(t, key.Verify(secret, now))
+	assert.Error(t, key.Verify("wrong", now))
+	assert.Error(t, key.Verify(secret, now.Add(2*time.Hour)))
+	key.ExpireTime = 0
+	assert.NoError(t, key.Verify(secret, now.Add(24*365*time.Hour)))
+
+	assert.True(t, key.Allow(APIKeyScopeRead))
+	assert.True(t, key.Allow(APIKeyScopeWrite))
+	assert.False(t, key.Allow(APIKeyScopeAdmin))
+	assert.False(t, key.Allow("unknown"))
+
+	assert.NoError(t, ValidateAPIKeyScopes([]string{APIKeyScopeRead, APIKeyScopeAdmin}))
+	assert.Error(t, ValidateAPIKeyScopes(nil))
+	assert.Error(t, ValidateAPIKeyScopes([]string{"superuser"}))
+}
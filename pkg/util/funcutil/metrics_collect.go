@@ -0,0 +1,138 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package funcutil
+
+import (
+	"context"
+	"time"
+
+	"github.com/cockroachdb/errors"
+)
+
+// ErrMetricsCollectTimeout is returned for the component which doesn't respond its metrics in time.
+var ErrMetricsCollectTimeout = errors.New("metrics collection timeout")
+
+// MetricsCollectResult is the metrics collected from a single component.
+type MetricsCollectResult[T any] struct {
+	Value    T
+	Err      error
+	TimedOut bool
+}
+
+// MetricsSnapshot is the metrics of a group of components collected in one round,
+// all of which are requested at SnapshotTime, the results are in the same order as the components.
+type MetricsSnapshot[T any] struct {
+	SnapshotTime time.Time
+	Results      []MetricsCollectResult[T]
+}
+
+// Partial returns whether the metrics of any component is missing in the snapshot.
+func (s *MetricsSnapshot[T]) Partial() bool {
+	for _, result := range s.Results {
+		if result.Err != nil {
+			return true
+		}
+	}
+	return false
+}
+
+// CollectMetricsParallel collects the metrics of targets concurrently, at most parallelism of them at the same time.
+// The collection of each target is bounded by timeout, the slow ones are tagged as timed out instead of
+// failing the whole snapshot, and the results arriving after that are discarded so the snapshot never changes once returned.
+func CollectMetricsParallel[K any, T any](
+	ctx context.Context,
+	targets []K,
+	timeout time.Duration,
+	parallelism int,
+	collect func(ctx context.Context, target K) (T, error),
+) *MetricsSnapshot[T] {
+	if parallelism <= 0 {
+		parallelism = 1
+	}
+
+	snapshot := &MetricsSnapshot[T]{
+		SnapshotTime: time.Now(),
+		Results:      make([]MetricsCollectResult[T], len(targets)),
+	}
+	if len(targets) == 0 {
+		return snapshot
+	}
+
+	sem := make(chan struct{}, parallelism)
+	done := make(chan struct{}, len(targets))
+	for i, target := range targets {
+		i, target := i, target
+		go func() {
+			defer func() { done <- struct{}{} }()
+			select {
+			case sem <- struct{}{}:
+			case <-ctx.Done():
+				snapshot.Results[i].Err = ctx.Err()
+				return
+			}
+			// the slot is released once timed out, the collection may still be running but won't block others
+			defer func() { <-sem }()
+			snapshot.Results[i] = collectWithTimeout(ctx, target, timeout, collect)
+		}()
+	}
+	for range targets {
+		<-done
+	}
+	return snapshot
+}
+
+func collectWithTimeout[K any, T any](
+	ctx context.Context,
+	target K,
+	timeout time.Duration,
+	collect func(ctx context.Context, target K) (T, error),
+) MetricsCollectResult[T] {
+	var (
+		collectCtx context.Context
+		cancel     context.CancelFunc
+	)
+	if timeout > 0 {
+		collectCtx, cancel = context.WithTimeout(ctx, timeout)
+	} else {
+		collectCtx, cancel = context.WithCancel(ctx)
+	}
+	defer cancel()
+
+	// buffered to not leak the goroutine if the collection doesn't respect the context
+	ch := make(chan MetricsCollectResult[T], 1)
+	go func() {
+		value, err := collect(collectCtx, target)
+		ch <- MetricsCollectResult[T]{Value: value, Err: err}
+	}()
+
+	select {
+	case result := <-ch:
+		if result.Err != nil && ctx.Err() == nil && errors.Is(collectCtx.Err(), context.DeadlineExceeded) {
+			result.TimedOut = true
+			result.Err = errors.Wrapf(ErrMetricsCollectTimeout, "after %v: %s", timeout, result.Err.Error())
+		}
+		return result
+	case <-collectCtx.Done():
+		if ctx.Err() != nil {
+			return MetricsCollectResult[T]{Err: ctx.Err()}
+		}
+		return MetricsCollectResult[T]{
+			TimedOut: true,
+			Err:      errors.Wrapf(ErrMetricsCollectTimeout, "after %v", timeout),
+		}
+	}
+}
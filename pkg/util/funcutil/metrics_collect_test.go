@@ -0,0 +1,103 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package funcutil
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/cockroachdb/errors"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/atomic"
+)
+
+func TestCollectMetricsParallel(t *testing.T) {
+	t.Run("normal", func(t *testing.T) {
+		running := atomic.NewInt32(0)
+		maxRunning := atomic.NewInt32(0)
+		snapshot := CollectMetricsParallel(context.Background(), []int{1, 2, 3, 4, 5}, time.Second, 2,
+			func(ctx context.Context, target int) (int, error) {
+				n := running.Inc()
+				defer running.Dec()
+				for {
+					cur := maxRunning.Load()
+					if n <= cur || maxRunning.CAS(cur, n) {
+						break
+					}
+				}
+				time.Sleep(10 * time.Millisecond)
+				return target * 10, nil
+			})
+		assert.False(t, snapshot.Partial())
+		assert.False(t, snapshot.SnapshotTime.IsZero())
+		for i, result := range snapshot.Results {
+			assert.NoError(t, result.Err)
+			assert.Equal(t, (i+1)*10, result.Value)
+		}
+		assert.LessOrEqual(t, maxRunning.Load(), int32(2))
+	})
+
+	t.Run("partial", func(t *testing.T) {
+		block := make(chan struct{})
+		defer close(block)
+		start := time.Now()
+		snapshot := CollectMetricsParallel(context.Background(), []int{1, 2, 3}, 50*time.Millisecond, 3,
+			func(ctx context.Context, target int) (int, error) {
+				switch target {
+				case 2:
+					// ignores the context
+					<-block
+				case 3:
+					return 0, errors.New("mock")
+				}
+				return target, nil
+			})
+		assert.Less(t, time.Since(start), time.Second)
+		assert.True(t, snapshot.Partial())
+
+		assert.NoError(t, snapshot.Results[0].Err)
+		assert.Equal(t, 1, snapshot.Results[0].Value)
+
+		assert.True(t, snapshot.Results[1].TimedOut)
+		assert.ErrorIs(t, snapshot.Results[1].Err, ErrMetricsCollectTimeout)
+
+		assert.False(t, snapshot.Results[2].TimedOut)
+		assert.Error(t, snapshot.Results[2].Err)
+	})
+
+	t.Run("context canceled", func(t *testing.T) {
+		ctx, cancel := context.WithCancel(context.Background())
+		cancel()
+		snapshot := CollectMetricsParallel(ctx, []int{1}, time.Second, 1,
+			func(ctx context.Context, target int) (int, error) {
+				<-ctx.Done()
+				return 0, ctx.Err()
+			})
+		assert.True(t, snapshot.Partial())
+		assert.False(t, snapshot.Results[0].TimedOut)
+	})
+
+	t.Run("empty", func(t *testing.T) {
+		snapshot := CollectMetricsParallel(context.Background(), nil, time.Second, 0,
+			func(ctx context.Context, target int) (int, error) {
+				return target, nil
+			})
+		assert.Empty(t, snapshot.Results)
+		assert.False(t, snapshot.Partial())
+	})
+}
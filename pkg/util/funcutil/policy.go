@@ -95,6 +95,16 @@ func PolicyForPrivilege(roleName string, objectType string, objectName string, p
 	return fmt.Sprintf(`{"PType":"p","V0":"%s","V1":"%s","V2":"%s"}`, roleName, PolicyForResource(dbName, objectType, objectName), privilege)
 }
 
+// PolicyForRoleInheritance returns the policy that the role inherits the privileges of the parent role.
+func PolicyForRoleInheritance(roleName string, parentRoleName string) string {
+	return fmt.Sprintf(`{"PType":"g","V0":"%s","V1":"%s"}`, roleName, parentRoleName)
+}
+
+// PolicyForPrivilegeGroup returns the policy that the privilege belongs to the privilege group, both in meta-store's name.
+func PolicyForPrivilegeGroup(privilege string, group string) string {
+	return fmt.Sprintf(`{"PType":"g2","V0":"%s","V1":"%s"}`, privilege, group)
+}
+
 func PolicyForResource(dbName string, objectType string, objectName string) string {
 	return fmt.Sprintf("%s-%s", objectType, CombineObjectName(dbName, objectName))
 }
@@ -63,6 +63,18 @@ func Test_PolicyForPrivilege(t *testing.T) {
 		PolicyForPrivilege("admin", "COLLECTION", "col1", "ALL", "default"))
 }
 
+func Test_PolicyForRoleInheritance(t *testing.T) {
+	assert.Equal(t,
+		`{"PType":"g","V0":"role1","V1":"role2"}`,
+		PolicyForRoleInheritance("role1", "role2"))
+}
+
+func Test_PolicyForPrivilegeGroup(t *testing.T) {
+	assert.Equal(t,
+		`{"PType":"g2","V0":"PrivilegeQuery","V1":"PrivilegeReadOnly"}`,
+		PolicyForPrivilegeGroup("PrivilegeQuery", "PrivilegeReadOnly"))
+}
+
 func Test_PolicyForResource(t *testing.T) {
 	assert.Equal(t,
 		`COLLECTION-default.col1`,
@@ -52,6 +52,16 @@ func Test_GetDiskUsage(t *testing.T) {
 		zap.Uint64("DiskUsage", GetDiskUsage()))
 }
 
+func Test_GetDiskFreeSpace(t *testing.T) {
+	free, total, err := GetDiskFreeSpace(t.TempDir())
+	assert.NoError(t, err)
+	assert.True(t, total > 0)
+	assert.True(t, free <= total)
+
+	_, _, err = GetDiskFreeSpace("/path/not/exist")
+	assert.Error(t, err)
+}
+
 func Test_GetMemoryUsageRatio(t *testing.T) {
 	log.Info("TestGetMemoryUsageRatio",
 		zap.Float64("Memory usage ratio", GetMemoryUseRatio()))
@@ -43,6 +43,7 @@ const (
 	SearchCacheBudgetKey = "search_cache_budget_gb"
 	NumLoadThreadKey     = "num_load_thread"
 	BeamWidthKey         = "beamwidth"
+	SearchListKey        = "search_list"
 
 	MaxLoadThread = 64
 	MaxBeamWidth  = 16
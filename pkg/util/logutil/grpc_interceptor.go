@@ -64,8 +64,8 @@ func withLevelAndTrace(ctx context.Context) context.Context {
 		// client request id
 		requestID := md.Get(clientRequestIDKey)
 		if len(requestID) >= 1 {
-			// inject traceid in order to pass client request id
-			newctx = metadata.AppendToOutgoingContext(newctx, clientRequestIDKey, requestID[0])
+			// attach the request id to logs and traces, and pass it to the downstream components
+			newctx = WithRequestID(newctx, requestID[0])
 		}
 	}
 	if !traceID.IsValid() {
@@ -0,0 +1,62 @@
+package logutil
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
+	"go.uber.org/zap"
+	"google.golang.org/grpc/metadata"
+
+	"github.com/milvus-io/milvus/pkg/log"
+)
+
+// RequestIDKey is the rpc metadata key of the request id, which is provided by the client or generated by proxy,
+// and propagated to all the downstream components.
+const RequestIDKey = clientRequestIDKey
+
+// maxRequestIDLen is the max length of the request id provided by the client,
+// which keeps it within the length limit of the metrics exemplar.
+const maxRequestIDLen = 64
+
+type requestIDCtxKey struct{}
+
+// NewRequestID generates a random request id.
+func NewRequestID() string {
+	bs := make([]byte, 16)
+	if _, err := rand.Read(bs); err != nil {
+		return ""
+	}
+	return hex.EncodeToString(bs)
+}
+
+// IsValidRequestID checks whether the request id provided by the client is acceptable,
+// only the printable ascii characters are allowed.
+func IsValidRequestID(requestID string) bool {
+	if len(requestID) == 0 || len(requestID) > maxRequestIDLen {
+		return false
+	}
+	for i := 0; i < len(requestID); i++ {
+		if requestID[i] < 0x21 || requestID[i] > 0x7e {
+			return false
+		}
+	}
+	return true
+}
+
+// WithRequestID attaches the request id to ctx, the logger and the trace span in ctx,
+// and the outgoing metadata to propagate it to the downstream components.
+func WithRequestID(ctx context.Context, requestID string) context.Context {
+	ctx = context.WithValue(ctx, requestIDCtxKey{}, requestID)
+	ctx = log.WithFields(ctx, zap.String("requestID", requestID))
+	trace.SpanFromContext(ctx).SetAttributes(attribute.String("request_id", requestID))
+	return metadata.AppendToOutgoingContext(ctx, RequestIDKey, requestID)
+}
+
+// GetRequestID returns the request id attached to ctx, or empty if not attached.
+func GetRequestID(ctx context.Context) string {
+	requestID, _ := ctx.Value(requestIDCtxKey{}).(string)
+	return requestID
+}
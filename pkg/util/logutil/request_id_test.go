@@ -0,0 +1,39 @@
+package logutil
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc/metadata"
+)
+
+func TestRequestID(t *testing.T) {
+	requestID := NewRequestID()
+	assert.Len(t, requestID, 32)
+	assert.True(t, IsValidRequestID(requestID))
+	assert.NotEqual(t, requestID, NewRequestID())
+
+	assert.False(t, IsValidRequestID(""))
+	assert.False(t, IsValidRequestID("request id"))
+	assert.False(t, IsValidRequestID("request\n"))
+	assert.False(t, IsValidRequestID(strings.Repeat("a", maxRequestIDLen+1)))
+
+	ctx := context.Background()
+	assert.Empty(t, GetRequestID(ctx))
+	ctx = WithRequestID(ctx, "client-req-id")
+	assert.Equal(t, "client-req-id", GetRequestID(ctx))
+	md, ok := metadata.FromOutgoingContext(ctx)
+	assert.True(t, ok)
+	assert.Equal(t, []string{"client-req-id"}, md.Get(RequestIDKey))
+}
+
+func TestCtxWithRequestID(t *testing.T) {
+	md := metadata.New(map[string]string{RequestIDKey: "client-req-id"})
+	ctx := withLevelAndTrace(metadata.NewIncomingContext(context.TODO(), md))
+	assert.Equal(t, "client-req-id", GetRequestID(ctx))
+
+	ctx = withLevelAndTrace(context.TODO())
+	assert.Empty(t, GetRequestID(ctx))
+}
@@ -49,6 +49,8 @@ var (
 	ErrCollectionNotLoaded        = newMilvusError("collection not loaded", 101, false)
 	ErrCollectionNumLimitExceeded = newMilvusError("exceeded the limit number of collections", 102, false)
 	ErrCollectionNotFullyLoaded   = newMilvusError("collection not fully loaded", 103, true)
+	ErrCollectionReadOnly         = newMilvusError("collection is read-only", 104, false)
+	ErrCollectionWriteConflict    = newMilvusError("write conflict", 105, true)
 
 	// Partition related
 	ErrPartitionNotFound       = newMilvusError("partition not found", 200, false)
@@ -94,6 +96,7 @@ var (
 	// IO related
 	ErrIoKeyNotFound = newMilvusError("key not found", 1000, false)
 	ErrIoFailed      = newMilvusError("IO failed", 1001, false)
+	ErrIoChecksum    = newMilvusError("checksum mismatch", 1002, false)
 
 	// Parameter related
 	ErrParameterInvalid = newMilvusError("invalid parameter", 1100, false)
@@ -84,6 +84,8 @@ func (s *ErrSuite) TestWrap() {
 	s.ErrorIs(WrapErrCollectionNotFound("test_collection", "failed to get collection"), ErrCollectionNotFound)
 	s.ErrorIs(WrapErrCollectionNotLoaded("test_collection", "failed to query"), ErrCollectionNotLoaded)
 	s.ErrorIs(WrapErrCollectionNotFullyLoaded("test_collection", "failed to query"), ErrCollectionNotFullyLoaded)
+	s.ErrorIs(WrapErrCollectionReadOnly("test_collection", "failed to insert"), ErrCollectionReadOnly)
+	s.ErrorIs(WrapErrCollectionWriteConflict("test_collection", "failed to upsert"), ErrCollectionWriteConflict)
 
 	// Partition related
 	s.ErrorIs(WrapErrPartitionNotFound("test_partition", "failed to get partition"), ErrPartitionNotFound)
@@ -122,6 +124,7 @@ func (s *ErrSuite) TestWrap() {
 	// IO related
 	s.ErrorIs(WrapErrIoKeyNotFound("test_key", "failed to read"), ErrIoKeyNotFound)
 	s.ErrorIs(WrapErrIoFailed("test_key", "failed to read"), ErrIoFailed)
+	s.ErrorIs(WrapErrIoChecksum("test_key", "expected=1, actual=2"), ErrIoChecksum)
 
 	// Parameter related
 	s.ErrorIs(WrapErrParameterInvalid(8, 1, "failed to create"), ErrParameterInvalid)
@@ -408,6 +408,22 @@ func WrapErrCollectionNotFullyLoaded(collection any, msg ...string) error {
 	return err
 }
 
+func WrapErrCollectionReadOnly(collection any, msg ...string) error {
+	err := wrapWithField(ErrCollectionReadOnly, "collection", collection)
+	if len(msg) > 0 {
+		err = errors.Wrap(err, strings.Join(msg, "; "))
+	}
+	return err
+}
+
+func WrapErrCollectionWriteConflict(collection any, msg ...string) error {
+	err := wrapWithField(ErrCollectionWriteConflict, "collection", collection)
+	if len(msg) > 0 {
+		err = errors.Wrap(err, strings.Join(msg, "; "))
+	}
+	return err
+}
+
 func WrapErrAliasNotFound(db any, alias any, msg ...string) error {
 	err := errors.Wrapf(ErrAliasNotFound, "alias %v:%v", db, alias)
 	if len(msg) > 0 {
@@ -656,6 +672,14 @@ func WrapErrIoFailed(key string, msg ...string) error {
 	return err
 }
 
+func WrapErrIoChecksum(key string, msg ...string) error {
+	err := errors.Wrapf(ErrIoChecksum, "key=%s", key)
+	if len(msg) > 0 {
+		err = errors.Wrap(err, strings.Join(msg, "; "))
+	}
+	return err
+}
+
 func WrapErrIoFailedReason(reason string, msg ...string) error {
 	err := errors.Wrapf(ErrIoFailed, reason)
 	if len(msg) > 0 {
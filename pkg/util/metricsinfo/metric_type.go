@@ -27,6 +27,9 @@ const (
 
 	// SystemInfoMetrics means users request for system information metrics.
 	SystemInfoMetrics = "system_info"
+
+	// SegmentAccessStatsMetrics means users request for the access statistics of segments loaded in QueryNode.
+	SegmentAccessStatsMetrics = "segment_access_stats"
 )
 
 // ParseMetricType returns the metric type of req
@@ -101,6 +101,28 @@ type QueryNodeInfos struct {
 	QuotaMetrics         *QueryNodeQuotaMetrics `json:"quota_metrics"`
 }
 
+// SegmentAccessStats records the access statistics of a segment loaded in QueryNode since it's loaded.
+type SegmentAccessStats struct {
+	SegmentID          int64   `json:"segment_id"`
+	CollectionID       int64   `json:"collection_id"`
+	PartitionID        int64   `json:"partition_id"`
+	Channel            string  `json:"channel"`
+	Type               string  `json:"type"`
+	MemSize            int64   `json:"mem_size"`
+	SearchCount        int64   `json:"search_count"`
+	QueryCount         int64   `json:"query_count"`
+	SearchAvgLatencyMs float64 `json:"search_avg_latency_ms"`
+	QueryAvgLatencyMs  float64 `json:"query_avg_latency_ms"`
+	// unix timestamp in seconds, 0 if never accessed
+	LastAccessTime int64 `json:"last_access_time"`
+}
+
+// QueryNodeSegmentAccessStats is the response of SegmentAccessStatsMetrics of QueryNode.
+type QueryNodeSegmentAccessStats struct {
+	Name     string               `json:"name"`
+	Segments []SegmentAccessStats `json:"segments"`
+}
+
 // QueryCoordConfiguration records the configuration of QueryCoord.
 type QueryCoordConfiguration struct {
 	SearchChannelPrefix       string `json:"search_channel_prefix"`
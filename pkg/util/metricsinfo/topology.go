@@ -44,6 +44,10 @@ func UnmarshalTopology(s string, topology Topology) error {
 type QueryClusterTopology struct {
 	Self           QueryCoordInfos  `json:"self"`
 	ConnectedNodes []QueryNodeInfos `json:"connected_nodes"`
+	// SnapshotTime is the time when the metrics of connected nodes requested
+	SnapshotTime string `json:"snapshot_time,omitempty"`
+	// Partial is true if the metrics of any connected node is missing
+	Partial bool `json:"partial,omitempty"`
 }
 
 // ConnectionType is the type of connection between nodes
@@ -98,6 +102,10 @@ type DataClusterTopology struct {
 	Self                DataCoordInfos   `json:"self"`
 	ConnectedDataNodes  []DataNodeInfos  `json:"connected_data_nodes"`
 	ConnectedIndexNodes []IndexNodeInfos `json:"connected_index_nodes"`
+	// SnapshotTime is the time when the metrics of connected nodes requested
+	SnapshotTime string `json:"snapshot_time,omitempty"`
+	// Partial is true if the metrics of any connected node is missing
+	Partial bool `json:"partial,omitempty"`
 }
 
 // DataCoordTopology shows the whole metrics of index cluster
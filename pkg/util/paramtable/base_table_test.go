@@ -146,3 +146,29 @@ func TestNewBaseTableFromYamlOnly(t *testing.T) {
 	gp = NewBaseTableFromYamlOnly(yaml)
 	assert.Empty(t, gp.Get("key"))
 }
+
+func TestParamItem_Validator(t *testing.T) {
+	assert.NoError(t, IntRange(1, 10)("5"))
+	assert.Error(t, IntRange(1, 10)("0"))
+	assert.Error(t, IntRange(1, 10)("abc"))
+	assert.NoError(t, FloatRange(0, 1)("0.5"))
+	assert.Error(t, FloatRange(0, 1)("1.5"))
+	assert.NoError(t, BoolValue()("true"))
+	assert.Error(t, BoolValue()("yes"))
+	assert.NoError(t, OneOf("a", "b")("b"))
+	assert.Error(t, OneOf("a", "b")("c"))
+
+	mgr, _ := config.Init()
+	item := ParamItem{
+		Key:          "test.validator.key",
+		DefaultValue: "1",
+		Formatter:    strings.TrimSpace,
+		Validator:    IntRange(1, 10),
+	}
+	item.Init(mgr)
+
+	err := mgr.OnEvents([]*config.Event{{EventSource: "test", EventType: config.CreateType, Key: "test.validator.key", Value: " 20 "}})
+	assert.ErrorIs(t, err, config.ErrConfigRejected)
+	err = mgr.OnEvents([]*config.Event{{EventSource: "test", EventType: config.CreateType, Key: "test.validator.key", Value: " 2 "}})
+	assert.NoError(t, err)
+}
@@ -13,6 +13,7 @@ package paramtable
 
 import (
 	"fmt"
+	"math"
 	"os"
 	"runtime"
 	"strconv"
@@ -154,6 +155,12 @@ func (p *ComponentParam) WatchKeyPrefix(keyPrefix string, watcher config.EventHa
 	p.baseTable.mgr.Dispatcher.RegisterForKeyPrefix(keyPrefix, watcher)
 }
 
+// RegisterValidator lets components reject the refreshed value of the key,
+// all the changes refreshed together will be rolled back if any of them is rejected
+func (p *ComponentParam) RegisterValidator(key string, validator config.Validator) {
+	p.baseTable.mgr.RegisterValidator(key, validator)
+}
+
 // /////////////////////////////////////////////////////////////////////////////
 // --- common ---
 type commonConfig struct {
@@ -177,6 +184,7 @@ type commonConfig struct {
 	DefaultPartitionName ParamItem `refreshable:"false"`
 	DefaultIndexName     ParamItem `refreshable:"true"`
 	EntityExpirationTTL  ParamItem `refreshable:"true"`
+	RetentionDuration    ParamItem `refreshable:"true"`
 
 	IndexSliceSize                      ParamItem `refreshable:"false"`
 	HighPriorityThreadCoreCoefficient   ParamItem `refreshable:"false"`
@@ -189,14 +197,32 @@ type commonConfig struct {
 	SearchCacheBudgetGBRatio            ParamItem `refreshable:"true"`
 	LoadNumThreadRatio                  ParamItem `refreshable:"true"`
 	BeamWidthRatio                      ParamItem `refreshable:"true"`
+	MaxSearchBeamWidth                  ParamItem `refreshable:"true"`
+	MaxSearchListSize                   ParamItem `refreshable:"true"`
 	GracefulTime                        ParamItem `refreshable:"true"`
 	GracefulStopTimeout                 ParamItem `refreshable:"true"`
+	GracefulStopOrder                   ParamItem `refreshable:"true"`
+	GracefulStopStageTimeout            ParamItem `refreshable:"true"`
+	ReadinessChecks                     ParamItem `refreshable:"true"`
+	ReadinessStandbyReady               ParamItem `refreshable:"true"`
 
 	StorageType ParamItem `refreshable:"false"`
 	SimdType    ParamItem `refreshable:"false"`
 
 	AuthorizationEnabled ParamItem `refreshable:"false"`
 	SuperUsers           ParamItem `refreshable:"true"`
+	TLSIdentityEnabled   ParamItem `refreshable:"true"`
+	TLSIdentitySource    ParamItem `refreshable:"true"`
+	TLSIdentityMapping   ParamItem `refreshable:"true"`
+
+	PasswordMinCharClasses ParamItem `refreshable:"true"`
+	PasswordExpireDays     ParamItem `refreshable:"true"`
+	PasswordReuseHistory   ParamItem `refreshable:"true"`
+	PasswordRotationWindow ParamItem `refreshable:"true"`
+
+	APIKeyEnabled        ParamItem `refreshable:"true"`
+	APIKeyMaxKeysPerUser ParamItem `refreshable:"true"`
+	APIKeyMaxTTL         ParamItem `refreshable:"true"`
 
 	ClusterName ParamItem `refreshable:"false"`
 
@@ -219,6 +245,9 @@ type commonConfig struct {
 	LockSlowLogWarnThreshold ParamItem `refreshable:"true"`
 
 	TTMsgEnabled ParamItem `refreshable:"true"`
+
+	MetricsCollectTimeout     ParamItem `refreshable:"true"`
+	MetricsCollectParallelism ParamItem `refreshable:"true"`
 }
 
 func (p *commonConfig) init(base *BaseTable) {
@@ -381,6 +410,22 @@ func (p *commonConfig) init(base *BaseTable) {
 	}
 	p.EntityExpirationTTL.Init(base.mgr)
 
+	p.RetentionDuration = ParamItem{
+		Key:          "common.retentionDuration",
+		Version:      "2.3.2",
+		DefaultValue: "0",
+		Formatter: func(value string) string {
+			duration := getAsInt(value)
+			if duration < 0 {
+				return "0"
+			}
+			return strconv.Itoa(duration)
+		},
+		Doc:    "Time travel retention duration in seconds, read at a timestamp older than it is rejected and data within it is not compacted, 0 disables time travel",
+		Export: true,
+	}
+	p.RetentionDuration.Init(base.mgr)
+
 	p.SimdType = ParamItem{
 		Key:          "common.simdType",
 		Version:      "2.1.0",
@@ -459,6 +504,24 @@ This configuration is only used by querynode and indexnode, it selects CPU instr
 	}
 	p.BeamWidthRatio.Init(base.mgr)
 
+	p.MaxSearchBeamWidth = ParamItem{
+		Key:          "common.DiskIndex.MaxSearchBeamWidth",
+		Version:      "2.3.2",
+		DefaultValue: "16",
+		Doc:          "the max beamwidth a search request can override for DiskANN index",
+		Export:       true,
+	}
+	p.MaxSearchBeamWidth.Init(base.mgr)
+
+	p.MaxSearchListSize = ParamItem{
+		Key:          "common.DiskIndex.MaxSearchListSize",
+		Version:      "2.3.2",
+		DefaultValue: "16384",
+		Doc:          "the max search_list a search request can set for DiskANN index",
+		Export:       true,
+	}
+	p.MaxSearchListSize.Init(base.mgr)
+
 	p.GracefulTime = ParamItem{
 		Key:          "common.gracefulTime",
 		Version:      "2.0.0",
@@ -477,6 +540,44 @@ This configuration is only used by querynode and indexnode, it selects CPU instr
 	}
 	p.GracefulStopTimeout.Init(base.mgr)
 
+	p.GracefulStopOrder = ParamItem{
+		Key:          "common.gracefulStop.order",
+		Version:      "2.3.2",
+		DefaultValue: "proxy,datanode,querynode,indexnode,coordinator",
+		Doc: `the order of stopping the components in the same process, the components in the same stage are stopped concurrently.
+proxy stops accepting requests first, then datanodes flush, querynodes release their segments and channels, the coordinators stop at last`,
+		Export: true,
+	}
+	p.GracefulStopOrder.Init(base.mgr)
+
+	p.GracefulStopStageTimeout = ParamItem{
+		Key:          "common.gracefulStop.stageTimeout",
+		Version:      "2.3.2",
+		DefaultValue: "600",
+		Doc:          "seconds. the max time to wait for each stage of graceful stop, the next stage starts once timeout",
+		Export:       true,
+	}
+	p.GracefulStopStageTimeout.Init(base.mgr)
+
+	p.ReadinessChecks = ParamItem{
+		Key:          "common.readiness.checks",
+		Version:      "2.3.2",
+		DefaultValue: "channelsWatched,targetsReady",
+		Doc: `comma separated checks required by /readyz besides the components serving, the checks failed but not required only degrade the components.
+channelsWatched: all the channels are watched by datanodes, targetsReady: the targets of all the loaded collections are ready`,
+		Export: true,
+	}
+	p.ReadinessChecks.Init(base.mgr)
+
+	p.ReadinessStandbyReady = ParamItem{
+		Key:          "common.readiness.standbyReady",
+		Version:      "2.3.2",
+		DefaultValue: "true",
+		Doc:          "whether the standby coordinators are ready in /readyz",
+		Export:       true,
+	}
+	p.ReadinessStandbyReady.Init(base.mgr)
+
 	p.StorageType = ParamItem{
 		Key:          "common.storageType",
 		Version:      "2.0.0",
@@ -534,6 +635,101 @@ like the old password verification when updating the credential`,
 	}
 	p.SuperUsers.Init(base.mgr)
 
+	p.TLSIdentityEnabled = ParamItem{
+		Key:     "common.security.tlsIdentity.enabled",
+		Version: "2.3.2",
+		Doc: `Whether to authenticate the client by the verified client certificate when tlsMode is 2,
+the password authentication is skipped if the certificate identity is mapped to an existing user`,
+		DefaultValue: "false",
+		Export:       true,
+	}
+	p.TLSIdentityEnabled.Init(base.mgr)
+
+	p.TLSIdentitySource = ParamItem{
+		Key:          "common.security.tlsIdentity.source",
+		Version:      "2.3.2",
+		Doc:          "The identity of client certificate, cn: the subject common name, san: the first subject alternative name",
+		DefaultValue: "cn",
+		Export:       true,
+	}
+	p.TLSIdentitySource.Init(base.mgr)
+
+	p.TLSIdentityMapping = ParamItem{
+		Key:     "common.security.tlsIdentity.mapping",
+		Version: "2.3.2",
+		Doc: `The mapping from certificate identity to user, in format of identity1:user1,identity2:user2,
+the identity is used as the user name directly if not mapped`,
+		DefaultValue: "",
+		Export:       true,
+	}
+	p.TLSIdentityMapping.Init(base.mgr)
+
+	p.PasswordMinCharClasses = ParamItem{
+		Key:     "common.security.passwordPolicy.minCharClasses",
+		Version: "2.3.2",
+		Doc: `The minimum number of character classes the password must contain,
+the classes are lowercase letters, uppercase letters, digits and special characters, 0 means no requirement`,
+		DefaultValue: "0",
+		Export:       true,
+	}
+	p.PasswordMinCharClasses.Init(base.mgr)
+
+	p.PasswordExpireDays = ParamItem{
+		Key:     "common.security.passwordPolicy.expireDays",
+		Version: "2.3.2",
+		Doc: `The password expires if it's not changed in the days, 0 means never expire.
+The user with expired password could only update the password`,
+		DefaultValue: "0",
+		Export:       true,
+	}
+	p.PasswordExpireDays.Init(base.mgr)
+
+	p.PasswordReuseHistory = ParamItem{
+		Key:          "common.security.passwordPolicy.reuseHistory",
+		Version:      "2.3.2",
+		Doc:          "The new password can't be the same as the latest passwords of the number, including the current one, 0 means no limit",
+		DefaultValue: "0",
+		Export:       true,
+	}
+	p.PasswordReuseHistory.Init(base.mgr)
+
+	p.PasswordRotationWindow = ParamItem{
+		Key:     "common.security.passwordPolicy.rotationWindow",
+		Version: "2.3.2",
+		Doc: `seconds, the previous password is still valid in the window after the password is updated,
+so the clients could be rotated to the new password without downtime, 0 means the previous password is invalid immediately`,
+		DefaultValue: "0",
+		Export:       true,
+	}
+	p.PasswordRotationWindow.Init(base.mgr)
+
+	p.APIKeyEnabled = ParamItem{
+		Key:          "common.security.apiKey.enabled",
+		Version:      "2.3.2",
+		Doc:          "Whether to authenticate the client by the api keys issued by rootcoord, as an alternative to username and password",
+		DefaultValue: "true",
+		Export:       true,
+	}
+	p.APIKeyEnabled.Init(base.mgr)
+
+	p.APIKeyMaxKeysPerUser = ParamItem{
+		Key:          "common.security.apiKey.maxKeysPerUser",
+		Version:      "2.3.2",
+		Doc:          "The maximum number of api keys of a user, including the expired ones",
+		DefaultValue: "10",
+		Export:       true,
+	}
+	p.APIKeyMaxKeysPerUser.Init(base.mgr)
+
+	p.APIKeyMaxTTL = ParamItem{
+		Key:          "common.security.apiKey.maxTTL",
+		Version:      "2.3.2",
+		Doc:          "seconds, the maximum ttl of api keys, the keys never expire are not allowed if set, 0 means no limit",
+		DefaultValue: "0",
+		Export:       true,
+	}
+	p.APIKeyMaxTTL.Init(base.mgr)
+
 	p.ClusterName = ParamItem{
 		Key:          "common.cluster.name",
 		Version:      "2.0.0",
@@ -633,6 +829,24 @@ like the old password verification when updating the credential`,
 		Doc:          "Whether the instance disable sending ts messages",
 	}
 	p.TTMsgEnabled.Init(base.mgr)
+
+	p.MetricsCollectTimeout = ParamItem{
+		Key:          "common.metricsCollect.timeout",
+		Version:      "2.3.2",
+		DefaultValue: "5000",
+		Doc:          "timeout in milliseconds for collecting the metrics of a single component, the slow components are tagged as timeout in the partial result",
+		Export:       true,
+	}
+	p.MetricsCollectTimeout.Init(base.mgr)
+
+	p.MetricsCollectParallelism = ParamItem{
+		Key:          "common.metricsCollect.parallelism",
+		Version:      "2.3.2",
+		DefaultValue: "16",
+		Doc:          "max number of components whose metrics are collected concurrently",
+		Export:       true,
+	}
+	p.MetricsCollectParallelism.Init(base.mgr)
 }
 
 type traceConfig struct {
@@ -763,15 +977,19 @@ func (l *logConfig) init(base *BaseTable) {
 // /////////////////////////////////////////////////////////////////////////////
 // --- rootcoord ---
 type rootCoordConfig struct {
-	DmlChannelNum               ParamItem `refreshable:"false"`
-	MaxPartitionNum             ParamItem `refreshable:"true"`
-	MinSegmentSizeToEnableIndex ParamItem `refreshable:"true"`
-	ImportTaskExpiration        ParamItem `refreshable:"true"`
-	ImportTaskRetention         ParamItem `refreshable:"true"`
-	ImportMaxPendingTaskCount   ParamItem `refreshable:"true"`
-	ImportTaskSubPath           ParamItem `refreshable:"true"`
-	EnableActiveStandby         ParamItem `refreshable:"false"`
-	MaxDatabaseNum              ParamItem `refreshable:"false"`
+	DmlChannelNum                ParamItem `refreshable:"false"`
+	MaxPartitionNum              ParamItem `refreshable:"true"`
+	MinSegmentSizeToEnableIndex  ParamItem `refreshable:"true"`
+	ImportTaskExpiration         ParamItem `refreshable:"true"`
+	ImportTaskRetention          ParamItem `refreshable:"true"`
+	ImportMaxPendingTaskCount    ParamItem `refreshable:"true"`
+	ImportTaskSubPath            ParamItem `refreshable:"true"`
+	EnableActiveStandby          ParamItem `refreshable:"false"`
+	MaxDatabaseNum               ParamItem `refreshable:"false"`
+	MigratePrivilegeGroups       ParamItem `refreshable:"false"`
+	CollectionTrashRetention     ParamItem `refreshable:"true"`
+	CollectionTrashCheckInterval ParamItem `refreshable:"false"`
+	TSODriftAlarmThreshold       ParamItem `refreshable:"true"`
 }
 
 func (p *rootCoordConfig) init(base *BaseTable) {
@@ -851,6 +1069,44 @@ func (p *rootCoordConfig) init(base *BaseTable) {
 		Export:       true,
 	}
 	p.MaxDatabaseNum.Init(base.mgr)
+
+	p.MigratePrivilegeGroups = ParamItem{
+		Key:          "rootCoord.migratePrivilegeGroups",
+		Version:      "2.3.2",
+		DefaultValue: "false",
+		Doc: `whether to migrate the existing grants to the privilege groups at startup, the privileges granted to a role on an object
+which cover all the privileges of a privilege group are replaced by the group`,
+		Export: true,
+	}
+	p.MigratePrivilegeGroups.Init(base.mgr)
+
+	p.CollectionTrashRetention = ParamItem{
+		Key:          "rootCoord.collectionTrash.retention",
+		Version:      "2.3.2",
+		DefaultValue: "0",
+		Doc: `The time in seconds the dropped collections are kept in the trash before purged, the collections in the trash could be restored.
+0 means the dropped collections are purged at once`,
+		Export: true,
+	}
+	p.CollectionTrashRetention.Init(base.mgr)
+
+	p.CollectionTrashCheckInterval = ParamItem{
+		Key:          "rootCoord.collectionTrash.checkInterval",
+		Version:      "2.3.2",
+		DefaultValue: "60",
+		Doc:          "The interval in seconds to purge the collections expired in the trash",
+		Export:       true,
+	}
+	p.CollectionTrashCheckInterval.Init(base.mgr)
+
+	p.TSODriftAlarmThreshold = ParamItem{
+		Key:          "rootCoord.tso.driftAlarmThreshold",
+		Version:      "2.3.2",
+		DefaultValue: "1000",
+		Doc:          "The threshold in milliseconds of the drift between the allocated timestamps and the wall clock to raise the clock drift alarm, 0 means disable the alarm",
+		Export:       true,
+	}
+	p.TSODriftAlarmThreshold.Init(base.mgr)
 }
 
 // /////////////////////////////////////////////////////////////////////////////
@@ -867,6 +1123,21 @@ type AccessLogConfig struct {
 	RemoteMaxTime ParamItem `refreshable:"false"`
 }
 
+// AuditLogConfig is the config of the audit log of data-plane accesses.
+type AuditLogConfig struct {
+	Enable       ParamItem `refreshable:"false"`
+	Sink         ParamItem `refreshable:"false"`
+	LocalPath    ParamItem `refreshable:"false"`
+	Filename     ParamItem `refreshable:"false"`
+	MaxSize      ParamItem `refreshable:"false"`
+	MaxBackups   ParamItem `refreshable:"false"`
+	KafkaTopic   ParamItem `refreshable:"false"`
+	BufferSize   ParamItem `refreshable:"false"`
+	SampleRate   ParamItem `refreshable:"true"`
+	IncludeExpr  ParamItem `refreshable:"true"`
+	RedactFields ParamItem `refreshable:"true"`
+}
+
 type proxyConfig struct {
 	// Alias  string
 	SoPath ParamItem `refreshable:"false"`
@@ -886,12 +1157,55 @@ type proxyConfig struct {
 	MaxRoleNum                   ParamItem `refreshable:"true"`
 	MaxTaskNum                   ParamItem `refreshable:"false"`
 	AccessLog                    AccessLogConfig
+	AuditLog                     AuditLogConfig
 	ShardLeaderCacheInterval     ParamItem `refreshable:"false"`
 	ReplicaSelectionPolicy       ParamItem `refreshable:"false"`
 	CheckQueryNodeHealthInterval ParamItem `refreshable:"false"`
 	CostMetricsExpireTime        ParamItem `refreshable:"true"`
 	RetryTimesOnReplica          ParamItem `refreshable:"true"`
 	RetryTimesOnHealthCheck      ParamItem `refreshable:"true"`
+	InsertPartialAcceptEnabled   ParamItem `refreshable:"true"`
+
+	UpsertPartialUpdateEnabled        ParamItem `refreshable:"true"`
+	UpsertPartialUpdateConflictWindow ParamItem `refreshable:"true"`
+
+	PartitionPrefetchEnabled       ParamItem `refreshable:"true"`
+	PartitionPrefetchMissThreshold ParamItem `refreshable:"true"`
+	PartitionPrefetchMissWindow    ParamItem `refreshable:"true"`
+	PartitionPrefetchMemoryBudget  ParamItem `refreshable:"true"`
+	PartitionPrefetchIdleTTL       ParamItem `refreshable:"true"`
+
+	DQLPipelineComputeWorkers ParamItem `refreshable:"false"`
+	DQLPipelineIOWorkers      ParamItem `refreshable:"false"`
+	DQLPipelineQueueSize      ParamItem `refreshable:"false"`
+
+	GroupBySearchCandidateFactor ParamItem `refreshable:"true"`
+	QueryIteratorSnapshotTTL     ParamItem `refreshable:"true"`
+	QueryIteratorMaxChunkSize    ParamItem `refreshable:"true"`
+	ResponseCompressionEnabled   ParamItem `refreshable:"true"`
+	ResponseCompressionMinSize   ParamItem `refreshable:"true"`
+	ResultSizeLimit              ParamItem `refreshable:"true"`
+
+	CoordClientPoolEnabled                   ParamItem `refreshable:"false"`
+	CoordClientPoolSize                      ParamItem `refreshable:"false"`
+	CoordClientPoolMaxConcurrencyPerDatabase ParamItem `refreshable:"false"`
+
+	SubqueryMaxNum       ParamItem `refreshable:"true"`
+	SubqueryMaxResultNum ParamItem `refreshable:"true"`
+
+	EmbeddingEnabled    ParamItem `refreshable:"true"`
+	EmbeddingBatchSize  ParamItem `refreshable:"true"`
+	EmbeddingTimeout    ParamItem `refreshable:"true"`
+	EmbeddingCacheSize  ParamItem `refreshable:"false"`
+	EmbeddingOnnxRunner ParamItem `refreshable:"true"`
+
+	HybridSearchMaxRequestNum ParamItem `refreshable:"true"`
+	HybridSearchRankerPlugin  ParamItem `refreshable:"false"`
+
+	DeleteByFilterBatchSize ParamItem `refreshable:"true"`
+
+	SessionIdleTimeout ParamItem `refreshable:"false"`
+	SessionMaxPerUser  ParamItem `refreshable:"true"`
 }
 
 func (p *proxyConfig) init(base *BaseTable) {
@@ -1105,6 +1419,105 @@ please adjust in embedded Milvus: false`,
 	}
 	p.AccessLog.RemoteMaxTime.Init(base.mgr)
 
+	p.AuditLog.Enable = ParamItem{
+		Key:          "proxy.auditLog.enable",
+		Version:      "2.3.2",
+		DefaultValue: "false",
+		Doc:          "Whether to log the data-plane accesses, i.e. search, query, insert, upsert and delete, for auditing",
+		Export:       true,
+	}
+	p.AuditLog.Enable.Init(base.mgr)
+
+	p.AuditLog.Sink = ParamItem{
+		Key:          "proxy.auditLog.sink",
+		Version:      "2.3.2",
+		DefaultValue: "file",
+		Doc:          "Where to write the audit logs, file or kafka",
+		Export:       true,
+	}
+	p.AuditLog.Sink.Init(base.mgr)
+
+	p.AuditLog.LocalPath = ParamItem{
+		Key:          "proxy.auditLog.localPath",
+		Version:      "2.3.2",
+		DefaultValue: "/tmp/milvus_auditlog",
+		Doc:          "The root path of the audit log file",
+		Export:       true,
+	}
+	p.AuditLog.LocalPath.Init(base.mgr)
+
+	p.AuditLog.Filename = ParamItem{
+		Key:          "proxy.auditLog.filename",
+		Version:      "2.3.2",
+		DefaultValue: "milvus_audit.log",
+		Doc:          "The audit log filename of file sink",
+		Export:       true,
+	}
+	p.AuditLog.Filename.Init(base.mgr)
+
+	p.AuditLog.MaxSize = ParamItem{
+		Key:          "proxy.auditLog.maxSize",
+		Version:      "2.3.2",
+		DefaultValue: "64",
+		Doc:          "Max size for a single audit log file in MB before rotated",
+		Export:       true,
+	}
+	p.AuditLog.MaxSize.Init(base.mgr)
+
+	p.AuditLog.MaxBackups = ParamItem{
+		Key:          "proxy.auditLog.maxBackups",
+		Version:      "2.3.2",
+		DefaultValue: "8",
+		Doc:          "Maximum number of rotated audit log files to retain",
+		Export:       true,
+	}
+	p.AuditLog.MaxBackups.Init(base.mgr)
+
+	p.AuditLog.KafkaTopic = ParamItem{
+		Key:          "proxy.auditLog.kafkaTopic",
+		Version:      "2.3.2",
+		DefaultValue: "milvus-audit-log",
+		Doc:          "The topic of kafka sink, the kafka address is configured in the kafka section",
+		Export:       true,
+	}
+	p.AuditLog.KafkaTopic.Init(base.mgr)
+
+	p.AuditLog.BufferSize = ParamItem{
+		Key:          "proxy.auditLog.bufferSize",
+		Version:      "2.3.2",
+		DefaultValue: "10000",
+		Doc:          "The number of audit logs buffered before written to sink, the logs are dropped if the buffer is full",
+		Export:       true,
+	}
+	p.AuditLog.BufferSize.Init(base.mgr)
+
+	p.AuditLog.SampleRate = ParamItem{
+		Key:          "proxy.auditLog.sampleRate",
+		Version:      "2.3.2",
+		DefaultValue: "1",
+		Doc:          "The ratio of the successful accesses logged, the failed ones are always logged",
+		Export:       true,
+	}
+	p.AuditLog.SampleRate.Init(base.mgr)
+
+	p.AuditLog.IncludeExpr = ParamItem{
+		Key:          "proxy.auditLog.includeExpr",
+		Version:      "2.3.2",
+		DefaultValue: "false",
+		Doc:          "Whether to log the filter expression in plain text, only its digest is logged by default",
+		Export:       true,
+	}
+	p.AuditLog.IncludeExpr.Init(base.mgr)
+
+	p.AuditLog.RedactFields = ParamItem{
+		Key:          "proxy.auditLog.redactFields",
+		Version:      "2.3.2",
+		DefaultValue: "",
+		Doc:          "Comma separated sensitive field names, which are masked in the output fields, and the expression referring them is never logged in plain text",
+		Export:       true,
+	}
+	p.AuditLog.RedactFields.Init(base.mgr)
+
 	p.ShardLeaderCacheInterval = ParamItem{
 		Key:          "proxy.shardLeaderCacheInterval",
 		Version:      "2.2.4",
@@ -1152,63 +1565,394 @@ please adjust in embedded Milvus: false`,
 		Doc:          "set query node unavailable on proxy when heartbeat failures reach this limit",
 	}
 	p.RetryTimesOnHealthCheck.Init(base.mgr)
-}
 
-// /////////////////////////////////////////////////////////////////////////////
-// --- querycoord ---
-type queryCoordConfig struct {
-	// Deprecated: Since 2.2.0
-	RetryNum ParamItem `refreshable:"true"`
-	// Deprecated: Since 2.2.0
-	RetryInterval    ParamItem `refreshable:"true"`
-	TaskMergeCap     ParamItem `refreshable:"false"`
-	TaskExecutionCap ParamItem `refreshable:"true"`
+	p.InsertPartialAcceptEnabled = ParamItem{
+		Key:          "proxy.insert.partialAccept.enabled",
+		Version:      "2.3.2",
+		DefaultValue: "false",
+		Doc:          "whether to insert the valid rows and report the invalid rows in ErrIndex, instead of rejecting the whole insert request",
+		Export:       true,
+	}
+	p.InsertPartialAcceptEnabled.Init(base.mgr)
 
-	// ---- Handoff ---
-	// Deprecated: Since 2.2.2
-	AutoHandoff ParamItem `refreshable:"true"`
+	p.UpsertPartialUpdateEnabled = ParamItem{
+		Key:          "proxy.upsert.partialUpdate.enabled",
+		Version:      "2.3.3",
+		DefaultValue: "false",
+		Doc:          "whether to fill the fields missing in the upsert request with the existing entities, instead of rejecting the request",
+		Export:       true,
+	}
+	p.UpsertPartialUpdateEnabled.Init(base.mgr)
 
-	// ---- Balance ---
-	AutoBalance                         ParamItem `refreshable:"true"`
-	Balancer                            ParamItem `refreshable:"true"`
-	GlobalRowCountFactor                ParamItem `refreshable:"true"`
-	ScoreUnbalanceTolerationFactor      ParamItem `refreshable:"true"`
-	ReverseUnbalanceTolerationFactor    ParamItem `refreshable:"true"`
-	OverloadedMemoryThresholdPercentage ParamItem `refreshable:"true"`
-	BalanceIntervalSeconds              ParamItem `refreshable:"true"`
-	MemoryUsageMaxDifferencePercentage  ParamItem `refreshable:"true"`
+	p.UpsertPartialUpdateConflictWindow = ParamItem{
+		Key:          "proxy.upsert.partialUpdate.conflictWindow",
+		Version:      "2.3.3",
+		DefaultValue: "60",
+		Doc:          "seconds, the partial upsert is rejected as conflict if the entities are read earlier than the window before written",
+		Export:       true,
+		Validator:    IntRange(1, math.MaxInt32),
+	}
+	p.UpsertPartialUpdateConflictWindow.Init(base.mgr)
 
-	SegmentCheckInterval       ParamItem `refreshable:"true"`
-	ChannelCheckInterval       ParamItem `refreshable:"true"`
-	BalanceCheckInterval       ParamItem `refreshable:"true"`
-	IndexCheckInterval         ParamItem `refreshable:"true"`
-	ChannelTaskTimeout         ParamItem `refreshable:"true"`
-	SegmentTaskTimeout         ParamItem `refreshable:"true"`
-	DistPullInterval           ParamItem `refreshable:"false"`
-	HeartbeatAvailableInterval ParamItem `refreshable:"true"`
-	LoadTimeoutSeconds         ParamItem `refreshable:"true"`
+	p.PartitionPrefetchEnabled = ParamItem{
+		Key:          "proxy.partitionPrefetch.enabled",
+		Version:      "2.3.2",
+		DefaultValue: "false",
+		Doc:          "whether to load the partitions of partially loaded collections automatically, which are accessed repeatedly while not loaded",
+		Export:       true,
+	}
+	p.PartitionPrefetchEnabled.Init(base.mgr)
 
-	// Deprecated: Since 2.2.2, QueryCoord do not use HandOff logic anymore
-	CheckHandoffInterval ParamItem `refreshable:"true"`
-	EnableActiveStandby  ParamItem `refreshable:"false"`
+	p.PartitionPrefetchMissThreshold = ParamItem{
+		Key:          "proxy.partitionPrefetch.missThreshold",
+		Version:      "2.3.2",
+		DefaultValue: "3",
+		Doc:          "the number of partition not loaded errors within the miss window to load the partition",
+		Export:       true,
+	}
+	p.PartitionPrefetchMissThreshold.Init(base.mgr)
 
-	// Deprecated: Since 2.2.2, use different interval for different checker
-	CheckInterval ParamItem `refreshable:"true"`
+	p.PartitionPrefetchMissWindow = ParamItem{
+		Key:          "proxy.partitionPrefetch.missWindow",
+		Version:      "2.3.2",
+		DefaultValue: "60",
+		Doc:          "seconds, the window to count the partition not loaded errors",
+		Export:       true,
+	}
+	p.PartitionPrefetchMissWindow.Init(base.mgr)
 
-	NextTargetSurviveTime       ParamItem `refreshable:"true"`
-	UpdateNextTargetInterval    ParamItem `refreshable:"false"`
-	CheckNodeInReplicaInterval  ParamItem `refreshable:"false"`
-	CheckResourceGroupInterval  ParamItem `refreshable:"false"`
-	EnableRGAutoRecover         ParamItem `refreshable:"true"`
-	CheckHealthInterval         ParamItem `refreshable:"false"`
-	CheckHealthRPCTimeout       ParamItem `refreshable:"true"`
-	BrokerTimeout               ParamItem `refreshable:"false"`
-	CollectionRecoverTimesLimit ParamItem `refreshable:"true"`
-	ObserverTaskParallel        ParamItem `refreshable:"false"`
-}
+	p.PartitionPrefetchMemoryBudget = ParamItem{
+		Key:          "proxy.partitionPrefetch.memoryBudget",
+		Version:      "2.3.2",
+		DefaultValue: "1024",
+		Doc:          "MB, the max estimated memory of the partitions loaded automatically",
+		Export:       true,
+	}
+	p.PartitionPrefetchMemoryBudget.Init(base.mgr)
 
-func (p *queryCoordConfig) init(base *BaseTable) {
-	// ---- Task ---
+	p.PartitionPrefetchIdleTTL = ParamItem{
+		Key:          "proxy.partitionPrefetch.idleTTL",
+		Version:      "2.3.2",
+		DefaultValue: "1800",
+		Doc:          "seconds, the partitions loaded automatically are released after not accessed for the duration",
+		Export:       true,
+	}
+	p.PartitionPrefetchIdleTTL.Init(base.mgr)
+
+	p.DQLPipelineComputeWorkers = ParamItem{
+		Key:          "proxy.dqlPipeline.computeWorkers",
+		Version:      "2.3.2",
+		DefaultValue: "0",
+		Doc:          "worker number of the validation, planning and reduce stages of search/query, 0 for twice the cpu number",
+		Export:       true,
+	}
+	p.DQLPipelineComputeWorkers.Init(base.mgr)
+
+	p.DQLPipelineIOWorkers = ParamItem{
+		Key:          "proxy.dqlPipeline.ioWorkers",
+		Version:      "2.3.2",
+		DefaultValue: "1024",
+		Doc:          "worker number of the shard fanout and requery stages of search/query",
+		Export:       true,
+	}
+	p.DQLPipelineIOWorkers.Init(base.mgr)
+
+	p.DQLPipelineQueueSize = ParamItem{
+		Key:          "proxy.dqlPipeline.queueSize",
+		Version:      "2.3.2",
+		DefaultValue: "1024",
+		Doc:          "max number of search/query tasks waiting in the queue of each stage",
+		Export:       true,
+	}
+	p.DQLPipelineQueueSize.Init(base.mgr)
+
+	p.GroupBySearchCandidateFactor = ParamItem{
+		Key:          "proxy.groupBySearch.candidateFactor",
+		Version:      "2.3.2",
+		DefaultValue: "10",
+		Doc:          "the group by search retrieves at most candidateFactor times of the group size results to group, which is also limited by the max topk",
+		Export:       true,
+	}
+	p.GroupBySearchCandidateFactor.Init(base.mgr)
+
+	p.QueryIteratorSnapshotTTL = ParamItem{
+		Key:          "proxy.queryIterator.snapshotTTL",
+		Version:      "2.3.2",
+		DefaultValue: "1800",
+		Doc:          "seconds, the query iterator cursor expires if its pinned snapshot is older than the ttl, 0 means never expire",
+		Export:       true,
+	}
+	p.QueryIteratorSnapshotTTL.Init(base.mgr)
+
+	p.QueryIteratorMaxChunkSize = ParamItem{
+		Key:          "proxy.queryIterator.maxChunkSize",
+		Version:      "2.3.2",
+		DefaultValue: "16777216",
+		Doc:          "bytes, the page of query iterator is cut once its output exceeds the size, and the rest is returned by the following pages, 0 to disable",
+		Export:       true,
+	}
+	p.QueryIteratorMaxChunkSize.Init(base.mgr)
+
+	p.ResponseCompressionEnabled = ParamItem{
+		Key:          "proxy.responseCompression.enabled",
+		Version:      "2.3.2",
+		DefaultValue: "true",
+		Doc:          "compress the search and query responses with large varchar or json outputs by zstd, if requested by the client",
+		Export:       true,
+	}
+	p.ResponseCompressionEnabled.Init(base.mgr)
+
+	p.ResponseCompressionMinSize = ParamItem{
+		Key:          "proxy.responseCompression.minSize",
+		Version:      "2.3.2",
+		DefaultValue: "1048576",
+		Doc:          "bytes, the responses are compressed only if the varchar and json outputs are larger than it",
+		Export:       true,
+	}
+	p.ResponseCompressionMinSize.Init(base.mgr)
+
+	p.ResultSizeLimit = ParamItem{
+		Key:          "proxy.resultSizeLimit",
+		Version:      "2.3.2",
+		DefaultValue: "0",
+		Doc: `bytes, the query and search results exceeding the size are truncated instead of failing the request,
+the rows returned and matched and the offset to continue are told by the response headers, 0 to disable`,
+		Export: true,
+	}
+	p.ResultSizeLimit.Init(base.mgr)
+
+	p.CoordClientPoolEnabled = ParamItem{
+		Key:          "proxy.coordClientPool.enabled",
+		Version:      "2.3.2",
+		DefaultValue: "false",
+		Doc:          "whether to spread the requests to coordinators over a pool of connections by database, so the heavy requests of one database can't exhaust the connection shared by others",
+		Export:       true,
+	}
+	p.CoordClientPoolEnabled.Init(base.mgr)
+
+	p.CoordClientPoolSize = ParamItem{
+		Key:          "proxy.coordClientPool.size",
+		Version:      "2.3.2",
+		DefaultValue: "4",
+		Doc:          "number of connections to each coordinator, one of them is reserved for the internal requests not bound to any database",
+		Export:       true,
+	}
+	p.CoordClientPoolSize.Init(base.mgr)
+
+	p.CoordClientPoolMaxConcurrencyPerDatabase = ParamItem{
+		Key:          "proxy.coordClientPool.maxConcurrencyPerDatabase",
+		Version:      "2.3.2",
+		DefaultValue: "32",
+		Doc:          "max number of concurrent requests of a database to each coordinator, the exceeded requests wait in queue, 0 means no limit",
+		Export:       true,
+	}
+	p.CoordClientPoolMaxConcurrencyPerDatabase.Init(base.mgr)
+
+	p.SubqueryMaxNum = ParamItem{
+		Key:          "proxy.subquery.maxNum",
+		Version:      "2.3.2",
+		DefaultValue: "4",
+		Doc:          "max number of subqueries like `pk in (select pk from other_collection where ...)` in a query expression, 0 means subquery is disabled",
+		Export:       true,
+	}
+	p.SubqueryMaxNum.Init(base.mgr)
+
+	p.SubqueryMaxResultNum = ParamItem{
+		Key:          "proxy.subquery.maxResultNum",
+		Version:      "2.3.2",
+		DefaultValue: "16384",
+		Doc:          "max number of primary keys returned by a subquery, the query fails if the subquery returns more",
+		Export:       true,
+	}
+	p.SubqueryMaxResultNum.Init(base.mgr)
+
+	p.EmbeddingEnabled = ParamItem{
+		Key:          "proxy.embedding.enabled",
+		Version:      "2.3.2",
+		DefaultValue: "true",
+		Doc:          "whether proxy computes the vectors of the embedding functions declared by collections for the inserts and searches with raw text",
+		Export:       true,
+	}
+	p.EmbeddingEnabled.Init(base.mgr)
+
+	p.EmbeddingBatchSize = ParamItem{
+		Key:          "proxy.embedding.batchSize",
+		Version:      "2.3.2",
+		DefaultValue: "32",
+		Doc:          "max number of texts sent to the embedding provider in one call",
+		Export:       true,
+	}
+	p.EmbeddingBatchSize.Init(base.mgr)
+
+	p.EmbeddingTimeout = ParamItem{
+		Key:          "proxy.embedding.timeout",
+		Version:      "2.3.2",
+		DefaultValue: "10",
+		Doc:          "timeout in seconds of a call to the embedding provider",
+		Export:       true,
+	}
+	p.EmbeddingTimeout.Init(base.mgr)
+
+	p.EmbeddingCacheSize = ParamItem{
+		Key:          "proxy.embedding.cacheSize",
+		Version:      "2.3.2",
+		DefaultValue: "10000",
+		Doc:          "max number of computed embeddings cached by proxy, 0 means no cache",
+		Export:       true,
+	}
+	p.EmbeddingCacheSize.Init(base.mgr)
+
+	p.EmbeddingOnnxRunner = ParamItem{
+		Key:          "proxy.embedding.onnxRunner",
+		Version:      "2.3.2",
+		DefaultValue: "",
+		Doc:          "command to run the local onnx models, it's invoked with the model path and reads the texts from stdin, the onnx provider is disabled if empty",
+		Export:       true,
+	}
+	p.EmbeddingOnnxRunner.Init(base.mgr)
+
+	p.HybridSearchMaxRequestNum = ParamItem{
+		Key:          "proxy.hybridSearch.maxRequestNum",
+		Version:      "2.3.2",
+		DefaultValue: "8",
+		Doc:          "max number of ann search requests fused by a hybrid search",
+		Export:       true,
+	}
+	p.HybridSearchMaxRequestNum.Init(base.mgr)
+
+	p.HybridSearchRankerPlugin = ParamItem{
+		Key:          "proxy.hybridSearch.rankerPlugin",
+		Version:      "2.3.2",
+		DefaultValue: "",
+		Doc:          "path of the go plugin providing a custom ranker of hybrid search by the symbol MilvusRanker, only the builtin rankers rrf and weighted are available if empty",
+		Export:       true,
+	}
+	p.HybridSearchRankerPlugin.Init(base.mgr)
+
+	p.DeleteByFilterBatchSize = ParamItem{
+		Key:          "proxy.deleteByFilter.batchSize",
+		Version:      "2.3.2",
+		DefaultValue: "10000",
+		Doc:          "max number of primary keys sent in one delete message pack when deleting by a filter expression, the primary keys queried from querynodes are regrouped into batches of it",
+		Export:       true,
+	}
+	p.DeleteByFilterBatchSize.Init(base.mgr)
+
+	p.SessionIdleTimeout = ParamItem{
+		Key:          "proxy.session.idleTimeout",
+		Version:      "2.3.2",
+		DefaultValue: "86400",
+		Doc:          "the sessions established by Connect are evicted after being idle for it, in seconds",
+		Export:       true,
+	}
+	p.SessionIdleTimeout.Init(base.mgr)
+
+	p.SessionMaxPerUser = ParamItem{
+		Key:          "proxy.session.maxPerUser",
+		Version:      "2.3.2",
+		DefaultValue: "0",
+		Doc:          "max number of the sessions established by Connect of each user on a proxy, 0 means unlimited. Once reached, the least recently active session is evicted if idle for over a minute, otherwise Connect is rejected",
+		Export:       true,
+	}
+	p.SessionMaxPerUser.Init(base.mgr)
+}
+
+// /////////////////////////////////////////////////////////////////////////////
+// --- querycoord ---
+type queryCoordConfig struct {
+	// Deprecated: Since 2.2.0
+	RetryNum ParamItem `refreshable:"true"`
+	// Deprecated: Since 2.2.0
+	RetryInterval    ParamItem `refreshable:"true"`
+	TaskMergeCap     ParamItem `refreshable:"false"`
+	TaskExecutionCap ParamItem `refreshable:"true"`
+
+	// ---- Handoff ---
+	// Deprecated: Since 2.2.2
+	AutoHandoff ParamItem `refreshable:"true"`
+
+	// ---- Balance ---
+	AutoBalance                         ParamItem `refreshable:"true"`
+	Balancer                            ParamItem `refreshable:"true"`
+	GlobalRowCountFactor                ParamItem `refreshable:"true"`
+	DelegatorGrowingRowFactor           ParamItem `refreshable:"true"`
+	DelegatorDeleteBufferRowFactor      ParamItem `refreshable:"true"`
+	DelegatorForwardRateFactor          ParamItem `refreshable:"true"`
+	ScoreUnbalanceTolerationFactor      ParamItem `refreshable:"true"`
+	ReverseUnbalanceTolerationFactor    ParamItem `refreshable:"true"`
+	OverloadedMemoryThresholdPercentage ParamItem `refreshable:"true"`
+	BalanceIntervalSeconds              ParamItem `refreshable:"true"`
+	MemoryUsageMaxDifferencePercentage  ParamItem `refreshable:"true"`
+
+	SegmentCheckInterval       ParamItem `refreshable:"true"`
+	ChannelCheckInterval       ParamItem `refreshable:"true"`
+	BalanceCheckInterval       ParamItem `refreshable:"true"`
+	IndexCheckInterval         ParamItem `refreshable:"true"`
+	ChannelTaskTimeout         ParamItem `refreshable:"true"`
+	SegmentTaskTimeout         ParamItem `refreshable:"true"`
+	DistPullInterval           ParamItem `refreshable:"false"`
+	HeartbeatAvailableInterval ParamItem `refreshable:"true"`
+	LoadTimeoutSeconds         ParamItem `refreshable:"true"`
+
+	// ---- Node health ---
+	HealthScoreSmoothingFactor ParamItem `refreshable:"true"`
+	GrayFailureScoreThreshold  ParamItem `refreshable:"true"`
+
+	// ---- Auto replica ---
+	AutoReplicaEnabled               ParamItem `refreshable:"true"`
+	AutoReplicaCheckInterval         ParamItem `refreshable:"false"`
+	AutoReplicaMinNumber             ParamItem `refreshable:"true"`
+	AutoReplicaMaxNumber             ParamItem `refreshable:"true"`
+	AutoReplicaScaleUpQPSThreshold   ParamItem `refreshable:"true"`
+	AutoReplicaScaleDownQPSThreshold ParamItem `refreshable:"true"`
+	AutoReplicaScaleUpCPUThreshold   ParamItem `refreshable:"true"`
+	AutoReplicaScaleDownCPUThreshold ParamItem `refreshable:"true"`
+	AutoReplicaSustainedSeconds      ParamItem `refreshable:"true"`
+	AutoReplicaMemoryHeadroomRatio   ParamItem `refreshable:"true"`
+
+	// ---- Consistency check ---
+	ConsistencyCheckEnabled        ParamItem `refreshable:"true"`
+	ConsistencyCheckInterval       ParamItem `refreshable:"false"`
+	ConsistencyCheckSampleSize     ParamItem `refreshable:"true"`
+	ConsistencyCheckDeltaLagSecond ParamItem `refreshable:"true"`
+	ConsistencyCheckAutoResync     ParamItem `refreshable:"true"`
+
+	// ---- Load schedule ---
+	LoadScheduleEnabled       ParamItem `refreshable:"true"`
+	LoadScheduleCheckInterval ParamItem `refreshable:"false"`
+	LoadScheduleLookback      ParamItem `refreshable:"true"`
+
+	LoadRecoveryEnabled          ParamItem `refreshable:"true"`
+	LoadRecoveryCheckInterval    ParamItem `refreshable:"false"`
+	LoadRecoveryGracePeriod      ParamItem `refreshable:"true"`
+	LoadRecoveryMaxTargetRebuild ParamItem `refreshable:"true"`
+
+	LoadConfigCheckInterval ParamItem `refreshable:"false"`
+
+	SegmentEventWatchEnabled         ParamItem `refreshable:"false"`
+	SegmentEventFallbackPullInterval ParamItem `refreshable:"true"`
+
+	// Deprecated: Since 2.2.2, QueryCoord do not use HandOff logic anymore
+	CheckHandoffInterval ParamItem `refreshable:"true"`
+	EnableActiveStandby  ParamItem `refreshable:"false"`
+
+	// Deprecated: Since 2.2.2, use different interval for different checker
+	CheckInterval ParamItem `refreshable:"true"`
+
+	NextTargetSurviveTime       ParamItem `refreshable:"true"`
+	UpdateNextTargetInterval    ParamItem `refreshable:"false"`
+	CheckNodeInReplicaInterval  ParamItem `refreshable:"false"`
+	CheckResourceGroupInterval  ParamItem `refreshable:"false"`
+	EnableRGAutoRecover         ParamItem `refreshable:"true"`
+	CheckHealthInterval         ParamItem `refreshable:"false"`
+	CheckHealthRPCTimeout       ParamItem `refreshable:"true"`
+	BrokerTimeout               ParamItem `refreshable:"false"`
+	CollectionRecoverTimesLimit ParamItem `refreshable:"true"`
+	ObserverTaskParallel        ParamItem `refreshable:"false"`
+}
+
+func (p *queryCoordConfig) init(base *BaseTable) {
+	// ---- Task ---
 	p.RetryNum = ParamItem{
 		Key:          "queryCoord.task.retrynum",
 		Version:      "2.2.0",
@@ -1279,6 +2023,33 @@ func (p *queryCoordConfig) init(base *BaseTable) {
 	}
 	p.GlobalRowCountFactor.Init(base.mgr)
 
+	p.DelegatorGrowingRowFactor = ParamItem{
+		Key:          "queryCoord.delegatorGrowingRowFactor",
+		Version:      "2.3.2",
+		DefaultValue: "1",
+		Doc:          "the weight of the growing rows of shard leaders when balancing segments among queryNodes, 0 to ignore them",
+		Export:       true,
+	}
+	p.DelegatorGrowingRowFactor.Init(base.mgr)
+
+	p.DelegatorDeleteBufferRowFactor = ParamItem{
+		Key:          "queryCoord.delegatorDeleteBufferRowFactor",
+		Version:      "2.3.2",
+		DefaultValue: "1",
+		Doc:          "the weight of the buffered delete rows of shard leaders when balancing segments among queryNodes, 0 to ignore them",
+		Export:       true,
+	}
+	p.DelegatorDeleteBufferRowFactor.Init(base.mgr)
+
+	p.DelegatorForwardRateFactor = ParamItem{
+		Key:          "queryCoord.delegatorForwardRateFactor",
+		Version:      "2.3.2",
+		DefaultValue: "10",
+		Doc:          "the rows counted for each delete row per second forwarded by shard leaders when balancing segments among queryNodes, 0 to ignore it",
+		Export:       true,
+	}
+	p.DelegatorForwardRateFactor.Init(base.mgr)
+
 	p.ScoreUnbalanceTolerationFactor = ParamItem{
 		Key:          "queryCoord.scoreUnbalanceTolerationFactor",
 		Version:      "2.0.0",
@@ -1420,6 +2191,249 @@ func (p *queryCoordConfig) init(base *BaseTable) {
 	}
 	p.HeartbeatAvailableInterval.Init(base.mgr)
 
+	p.HealthScoreSmoothingFactor = ParamItem{
+		Key:          "queryCoord.healthScore.smoothingFactor",
+		Version:      "2.3.2",
+		DefaultValue: "0.2",
+		Doc:          "weight of the latest sample when updating the moving averages of node health signals, in (0, 1]",
+		Export:       true,
+	}
+	p.HealthScoreSmoothingFactor.Init(base.mgr)
+
+	p.GrayFailureScoreThreshold = ParamItem{
+		Key:          "queryCoord.healthScore.grayFailureThreshold",
+		Version:      "2.3.2",
+		DefaultValue: "0.6",
+		Doc:          "QueryNodes with health score lower than it are treated as gray failing and deprioritized by balancer and scheduler, 0 to disable",
+		Export:       true,
+	}
+	p.GrayFailureScoreThreshold.Init(base.mgr)
+
+	p.AutoReplicaEnabled = ParamItem{
+		Key:          "queryCoord.autoReplica.enabled",
+		Version:      "2.3.2",
+		DefaultValue: "false",
+		Doc:          "scale the replica number of loaded collections up and down automatically by the query load",
+		Export:       true,
+	}
+	p.AutoReplicaEnabled.Init(base.mgr)
+
+	p.AutoReplicaCheckInterval = ParamItem{
+		Key:          "queryCoord.autoReplica.checkInterval",
+		Version:      "2.3.2",
+		DefaultValue: "30",
+		Doc:          "the interval in seconds to collect the query load and check the replica number",
+		Export:       true,
+	}
+	p.AutoReplicaCheckInterval.Init(base.mgr)
+
+	p.AutoReplicaMinNumber = ParamItem{
+		Key:          "queryCoord.autoReplica.minReplicaNumber",
+		Version:      "2.3.2",
+		DefaultValue: "1",
+		Doc:          "the replica number is never scaled down below it",
+		Export:       true,
+	}
+	p.AutoReplicaMinNumber.Init(base.mgr)
+
+	p.AutoReplicaMaxNumber = ParamItem{
+		Key:          "queryCoord.autoReplica.maxReplicaNumber",
+		Version:      "2.3.2",
+		DefaultValue: "3",
+		Doc:          "the replica number is never scaled up beyond it",
+		Export:       true,
+	}
+	p.AutoReplicaMaxNumber.Init(base.mgr)
+
+	p.AutoReplicaScaleUpQPSThreshold = ParamItem{
+		Key:          "queryCoord.autoReplica.scaleUpQPSThreshold",
+		Version:      "2.3.2",
+		DefaultValue: "1000",
+		Doc:          "scale up if the search nq per second of each replica keeps higher than it",
+		Export:       true,
+	}
+	p.AutoReplicaScaleUpQPSThreshold.Init(base.mgr)
+
+	p.AutoReplicaScaleDownQPSThreshold = ParamItem{
+		Key:          "queryCoord.autoReplica.scaleDownQPSThreshold",
+		Version:      "2.3.2",
+		DefaultValue: "100",
+		Doc:          "scale down if the search nq per second of each replica keeps lower than it and the cpu usage is low",
+		Export:       true,
+	}
+	p.AutoReplicaScaleDownQPSThreshold.Init(base.mgr)
+
+	p.AutoReplicaScaleUpCPUThreshold = ParamItem{
+		Key:          "queryCoord.autoReplica.scaleUpCPUThreshold",
+		Version:      "2.3.2",
+		DefaultValue: "80",
+		Doc:          "scale up if the average cpu usage percentage of the QueryNodes serving the collection keeps higher than it",
+		Export:       true,
+	}
+	p.AutoReplicaScaleUpCPUThreshold.Init(base.mgr)
+
+	p.AutoReplicaScaleDownCPUThreshold = ParamItem{
+		Key:          "queryCoord.autoReplica.scaleDownCPUThreshold",
+		Version:      "2.3.2",
+		DefaultValue: "30",
+		Doc:          "scale down only if the average cpu usage percentage of the QueryNodes serving the collection keeps lower than it",
+		Export:       true,
+	}
+	p.AutoReplicaScaleDownCPUThreshold.Init(base.mgr)
+
+	p.AutoReplicaSustainedSeconds = ParamItem{
+		Key:          "queryCoord.autoReplica.sustainedSeconds",
+		Version:      "2.3.2",
+		DefaultValue: "300",
+		Doc:          "the load has to keep beyond the thresholds for the duration before scaling, it's also the cool down after scaling",
+		Export:       true,
+	}
+	p.AutoReplicaSustainedSeconds.Init(base.mgr)
+
+	p.AutoReplicaMemoryHeadroomRatio = ParamItem{
+		Key:          "queryCoord.autoReplica.memoryHeadroomRatio",
+		Version:      "2.3.2",
+		DefaultValue: "0.2",
+		Doc:          "the ratio of memory of the QueryNodes in resource group to keep free after scaling up",
+		Export:       true,
+	}
+	p.AutoReplicaMemoryHeadroomRatio.Init(base.mgr)
+
+	p.ConsistencyCheckEnabled = ParamItem{
+		Key:          "queryCoord.consistencyCheck.enabled",
+		Version:      "2.3.2",
+		DefaultValue: "false",
+		Doc:          "sample the segments and compare the row count and the last delete timestamp across replicas of the same collection",
+		Export:       true,
+	}
+	p.ConsistencyCheckEnabled.Init(base.mgr)
+
+	p.ConsistencyCheckInterval = ParamItem{
+		Key:          "queryCoord.consistencyCheck.interval",
+		Version:      "2.3.2",
+		DefaultValue: "600",
+		Doc:          "the interval in seconds to check the consistency across replicas",
+		Export:       true,
+	}
+	p.ConsistencyCheckInterval.Init(base.mgr)
+
+	p.ConsistencyCheckSampleSize = ParamItem{
+		Key:          "queryCoord.consistencyCheck.sampleSize",
+		Version:      "2.3.2",
+		DefaultValue: "16",
+		Doc:          "the number of segments sampled for each collection in a check",
+		Export:       true,
+	}
+	p.ConsistencyCheckSampleSize.Init(base.mgr)
+
+	p.ConsistencyCheckDeltaLagSecond = ParamItem{
+		Key:          "queryCoord.consistencyCheck.deltaLagSeconds",
+		Version:      "2.3.2",
+		DefaultValue: "300",
+		Doc:          "a replica is divergent if its last delete timestamp of the segment lags behind the other replicas more than it",
+		Export:       true,
+	}
+	p.ConsistencyCheckDeltaLagSecond.Init(base.mgr)
+
+	p.ConsistencyCheckAutoResync = ParamItem{
+		Key:          "queryCoord.consistencyCheck.autoResync",
+		Version:      "2.3.2",
+		DefaultValue: "false",
+		Doc:          "reload the divergent segment of the lagging replica, by moving it to another node of the replica if possible",
+		Export:       true,
+	}
+	p.ConsistencyCheckAutoResync.Init(base.mgr)
+
+	p.LoadScheduleEnabled = ParamItem{
+		Key:          "queryCoord.loadSchedule.enabled",
+		Version:      "2.3.2",
+		DefaultValue: "true",
+		Doc:          "load and release the collections by their load schedules, which are configured through the management http api",
+		Export:       true,
+	}
+	p.LoadScheduleEnabled.Init(base.mgr)
+
+	p.LoadScheduleCheckInterval = ParamItem{
+		Key:          "queryCoord.loadSchedule.checkInterval",
+		Version:      "2.3.2",
+		DefaultValue: "30",
+		Doc:          "the interval in seconds to check the load schedules",
+		Export:       true,
+	}
+	p.LoadScheduleCheckInterval.Init(base.mgr)
+
+	p.LoadScheduleLookback = ParamItem{
+		Key:          "queryCoord.loadSchedule.lookback",
+		Version:      "2.3.2",
+		DefaultValue: "86400",
+		Doc:          "seconds, the load or release event missed for longer than it, like QueryCoord is down, is not applied",
+		Export:       true,
+	}
+	p.LoadScheduleLookback.Init(base.mgr)
+
+	p.LoadRecoveryEnabled = ParamItem{
+		Key:          "queryCoord.loadRecovery.enabled",
+		Version:      "2.3.2",
+		DefaultValue: "true",
+		Doc:          "detect the impossible load states, like the loaded collection without any serviceable shard, and recover them automatically",
+		Export:       true,
+	}
+	p.LoadRecoveryEnabled.Init(base.mgr)
+
+	p.LoadRecoveryCheckInterval = ParamItem{
+		Key:          "queryCoord.loadRecovery.checkInterval",
+		Version:      "2.3.2",
+		DefaultValue: "30",
+		Doc:          "the interval in seconds to check the load states",
+		Export:       true,
+	}
+	p.LoadRecoveryCheckInterval.Init(base.mgr)
+
+	p.LoadRecoveryGracePeriod = ParamItem{
+		Key:          "queryCoord.loadRecovery.gracePeriod",
+		Version:      "2.3.2",
+		DefaultValue: "300",
+		Doc:          "seconds, the impossible load state is recovered only if it lasts longer than it, to skip the transient ones during balance and compaction",
+		Export:       true,
+	}
+	p.LoadRecoveryGracePeriod.Init(base.mgr)
+
+	p.LoadRecoveryMaxTargetRebuild = ParamItem{
+		Key:          "queryCoord.loadRecovery.maxTargetRebuild",
+		Version:      "2.3.2",
+		DefaultValue: "3",
+		Doc:          "the collection is reloaded if its load state is still impossible after the targets rebuilt for so many times, 0 means never reload",
+		Export:       true,
+	}
+	p.LoadRecoveryMaxTargetRebuild.Init(base.mgr)
+
+	p.LoadConfigCheckInterval = ParamItem{
+		Key:          "queryCoord.loadConfig.checkInterval",
+		Version:      "2.3.2",
+		DefaultValue: "3",
+		Doc:          "the interval in seconds to converge the replicas of loaded collections to their updated load configs",
+		Export:       true,
+	}
+	p.LoadConfigCheckInterval.Init(base.mgr)
+
+	p.SegmentEventWatchEnabled = ParamItem{
+		Key:          "queryCoord.segmentEvent.watchEnabled",
+		Version:      "2.3.2",
+		DefaultValue: "true",
+		Doc:          "whether to watch the segment events pushed by DataCoord and update the next target once the segments flushed, dropped or compacted",
+		Export:       true,
+	}
+	p.SegmentEventWatchEnabled.Init(base.mgr)
+
+	p.SegmentEventFallbackPullInterval = ParamItem{
+		Key:          "queryCoord.segmentEvent.fallbackPullInterval",
+		Version:      "2.3.2",
+		DefaultValue: "1800",
+		Doc:          "the interval in seconds to pull the next target of the collections without segment events, which replaces NextTargetSurviveTime while watching the segment events",
+		Export:       true,
+	}
+	p.SegmentEventFallbackPullInterval.Init(base.mgr)
+
 	p.CheckHandoffInterval = ParamItem{
 		Key:          "queryCoord.checkHandoffInterval",
 		DefaultValue: "5000",
@@ -1547,6 +2561,12 @@ type queryNodeConfig struct {
 	GrowingIndexNlist         ParamItem `refreshable:"false"`
 	GrowingIndexNProbe        ParamItem `refreshable:"false"`
 
+	// filtered search planner
+	FilterPlannerEnabled                 ParamItem `refreshable:"false"`
+	FilterPlannerBruteForceRatio         ParamItem `refreshable:"false"`
+	FilterPlannerPostFilterRatio         ParamItem `refreshable:"false"`
+	FilterPlannerPostFilterAmplification ParamItem `refreshable:"false"`
+
 	// memory limit
 	LoadMemoryUsageFactor               ParamItem `refreshable:"true"`
 	OverloadedMemoryThresholdPercentage ParamItem `refreshable:"false"`
@@ -1595,6 +2615,33 @@ type queryNodeConfig struct {
 	CGOPoolSizeRatio ParamItem `refreshable:"false"`
 
 	EnableWorkerSQCostMetrics ParamItem `refreshable:"true"`
+
+	// read memory budget
+	ReadMemoryBudgetRatio     ParamItem `refreshable:"true"`
+	ReadMemoryWaitTimeout     ParamItem `refreshable:"true"`
+	ReadMemoryMaxWaitingTasks ParamItem `refreshable:"true"`
+
+	// search concurrency quota of each collection
+	MaxSearchConcurrencyPerCollection ParamItem `refreshable:"true"`
+
+	VerifyBinlogChecksum ParamItem `refreshable:"true"`
+
+	// warmup of mmapped segments
+	MmapWarmupAuto    ParamItem `refreshable:"true"`
+	MmapWarmupMaxRate ParamItem `refreshable:"true"`
+
+	// cache of the evaluated filter bitsets
+	FilterBitsetCacheSize     ParamItem `refreshable:"false"`
+	FilterBitsetCacheTsBucket ParamItem `refreshable:"true"`
+
+	// reusable buffers of the searches
+	ScratchArenaMaxSize ParamItem `refreshable:"true"`
+
+	// searches allowing partial results
+	PartialSearchSegmentTimeout ParamItem `refreshable:"true"`
+
+	// historical-only searches while the shard delegator is unavailable
+	DelegatorFallbackEnabled ParamItem `refreshable:"true"`
 }
 
 func (p *queryNodeConfig) init(base *BaseTable) {
@@ -1703,6 +2750,42 @@ func (p *queryNodeConfig) init(base *BaseTable) {
 	}
 	p.GrowingIndexNProbe.Init(base.mgr)
 
+	p.FilterPlannerEnabled = ParamItem{
+		Key:          "queryNode.segcore.filterPlanner.enabled",
+		Version:      "2.3.2",
+		DefaultValue: "true",
+		Doc:          "Whether to choose the strategy of filtered search on the indexed sealed segments by the selectivity of filter, otherwise the index is always searched with the filter",
+		Export:       true,
+	}
+	p.FilterPlannerEnabled.Init(base.mgr)
+
+	p.FilterPlannerBruteForceRatio = ParamItem{
+		Key:          "queryNode.segcore.filterPlanner.bruteForceRatio",
+		Version:      "2.3.2",
+		DefaultValue: "0.01",
+		Doc:          "The rows passing the filter are searched by brute force if their ratio to the rows of segment is not more than this, or they are not more than topk",
+		Export:       true,
+	}
+	p.FilterPlannerBruteForceRatio.Init(base.mgr)
+
+	p.FilterPlannerPostFilterRatio = ParamItem{
+		Key:          "queryNode.segcore.filterPlanner.postFilterRatio",
+		Version:      "2.3.2",
+		DefaultValue: "0.95",
+		Doc:          "The index is searched without the filter and the results are filtered afterwards if the ratio of the rows passing the filter is not less than this",
+		Export:       true,
+	}
+	p.FilterPlannerPostFilterRatio.Init(base.mgr)
+
+	p.FilterPlannerPostFilterAmplification = ParamItem{
+		Key:          "queryNode.segcore.filterPlanner.postFilterAmplification",
+		Version:      "2.3.2",
+		DefaultValue: "2",
+		Doc:          "The amplification of topk searched without the filter, which is further divided by the ratio of the rows passing the filter",
+		Export:       true,
+	}
+	p.FilterPlannerPostFilterAmplification.Init(base.mgr)
+
 	p.LoadMemoryUsageFactor = ParamItem{
 		Key:          "queryNode.loadMemoryUsageFactor",
 		Version:      "2.0.0",
@@ -1973,6 +3056,114 @@ Max read concurrency must greater than or equal to 1, and less than or equal to
 		Doc:          "whether use worker's cost to measure delegator's workload",
 	}
 	p.EnableWorkerSQCostMetrics.Init(base.mgr)
+
+	p.ReadMemoryBudgetRatio = ParamItem{
+		Key:          "queryNode.scheduler.readMemoryBudgetRatio",
+		Version:      "2.3.2",
+		DefaultValue: "0",
+		Doc: `The ratio of total memory that concurrent search/query tasks may reserve for their results,
+estimated from nq, topK and output fields. Tasks exceeding the budget are queued, non-positive value disables the budget.`,
+	}
+	p.ReadMemoryBudgetRatio.Init(base.mgr)
+
+	p.ReadMemoryWaitTimeout = ParamItem{
+		Key:          "queryNode.scheduler.readMemoryWaitTimeout",
+		Version:      "2.3.2",
+		DefaultValue: "3000",
+		Doc:          "The max time (milliseconds) a read task waits for memory budget before it's rejected",
+	}
+	p.ReadMemoryWaitTimeout.Init(base.mgr)
+
+	p.ReadMemoryMaxWaitingTasks = ParamItem{
+		Key:          "queryNode.scheduler.readMemoryMaxWaitingTasks",
+		Version:      "2.3.2",
+		DefaultValue: "1024",
+		Doc:          "The max number of read tasks waiting for memory budget, the new task is rejected if exceeded, non-positive value means unlimited",
+	}
+	p.ReadMemoryMaxWaitingTasks.Init(base.mgr)
+
+	p.MaxSearchConcurrencyPerCollection = ParamItem{
+		Key:          "queryNode.scheduler.maxSearchConcurrencyPerCollection",
+		Version:      "2.3.2",
+		DefaultValue: "0",
+		Doc: `The max number of search tasks of a collection executing concurrently, so one busy collection can't occupy
+all the read concurrency of the node. The exceeding tasks wait until the running ones finish, non-positive value means no limit.`,
+		Export: true,
+	}
+	p.MaxSearchConcurrencyPerCollection.Init(base.mgr)
+
+	p.VerifyBinlogChecksum = ParamItem{
+		Key:          "queryNode.verifyBinlogChecksum",
+		Version:      "2.3.2",
+		DefaultValue: "false",
+		Doc: `whether to verify the checksums of insert binlogs before loading sealed segments, which reads the binlogs twice.
+The stats logs and delta logs are always verified`,
+		Export: true,
+	}
+	p.VerifyBinlogChecksum.Init(base.mgr)
+
+	p.MmapWarmupAuto = ParamItem{
+		Key:          "queryNode.mmapWarmup.auto",
+		Version:      "2.3.2",
+		DefaultValue: "false",
+		Doc:          "whether to warm up the mmapped index and field data of sealed segments after loaded, to avoid the page faults of first queries",
+		Export:       true,
+	}
+	p.MmapWarmupAuto.Init(base.mgr)
+
+	p.MmapWarmupMaxRate = ParamItem{
+		Key:          "queryNode.mmapWarmup.maxRate",
+		Version:      "2.3.2",
+		DefaultValue: "64",
+		Doc:          "The max rate (MB/s) of reading the mmapped pages while warming up segments, 0 means no limit",
+		Export:       true,
+	}
+	p.MmapWarmupMaxRate.Init(base.mgr)
+
+	p.FilterBitsetCacheSize = ParamItem{
+		Key:          "queryNode.filterBitsetCache.size",
+		Version:      "2.3.2",
+		DefaultValue: "0",
+		Doc:          "The max memory size (MB) of the cached filter bitsets of sealed segments, 0 means disable the cache",
+		Export:       true,
+	}
+	p.FilterBitsetCacheSize.Init(base.mgr)
+
+	p.FilterBitsetCacheTsBucket = ParamItem{
+		Key:          "queryNode.filterBitsetCache.tsBucket",
+		Version:      "2.3.2",
+		DefaultValue: "10",
+		Doc:          "The time bucket (in seconds) of the search timestamp, the searches in the same bucket share the cached filter bitsets",
+		Export:       true,
+	}
+	p.FilterBitsetCacheTsBucket.Init(base.mgr)
+
+	p.ScratchArenaMaxSize = ParamItem{
+		Key:          "queryNode.scratchArena.maxSize",
+		Version:      "2.3.2",
+		DefaultValue: "16",
+		Doc:          "The max memory size (MB) of the intermediate search buffers kept by each scratch arena for reuse, the buffers beyond it are released, 0 means disable the arenas",
+		Export:       true,
+	}
+	p.ScratchArenaMaxSize.Init(base.mgr)
+
+	p.PartialSearchSegmentTimeout = ParamItem{
+		Key:          "queryNode.partialSearch.segmentTimeout",
+		Version:      "2.3.2",
+		DefaultValue: "1000",
+		Doc:          "The default timeout (in milliseconds) of searching a segment for the searches allowing partial results, the segments not searched in time are missed in the results",
+		Export:       true,
+	}
+	p.PartialSearchSegmentTimeout.Init(base.mgr)
+
+	p.DelegatorFallbackEnabled = ParamItem{
+		Key:          "queryNode.delegatorFallback.enabled",
+		Version:      "2.3.2",
+		DefaultValue: "false",
+		Doc:          "Whether the QueryNodes hosting the sealed segments of a shard serve the searches with their local sealed segments while the shard delegator is unavailable, the results are partial without the growing data",
+		Export:       true,
+	}
+	p.DelegatorFallbackEnabled.Init(base.mgr)
 }
 
 // /////////////////////////////////////////////////////////////////////////////
@@ -1983,6 +3174,7 @@ type dataCoordConfig struct {
 	ChannelBalanceSilentDuration ParamItem `refreshable:"true"`
 	ChannelBalanceInterval       ParamItem `refreshable:"true"`
 	ChannelOperationRPCTimeout   ParamItem `refreshable:"true"`
+	OrphanChannelCheckInterval   ParamItem `refreshable:"false"`
 
 	// --- SEGMENTS ---
 	SegmentMaxSize                 ParamItem `refreshable:"false"`
@@ -1994,6 +3186,12 @@ type dataCoordConfig struct {
 	SegmentMaxIdleTime             ParamItem `refreshable:"false"`
 	SegmentMinSizeFromIdleToSealed ParamItem `refreshable:"false"`
 	SegmentMaxBinlogFileNumber     ParamItem `refreshable:"false"`
+	EnableLevelZeroSegment         ParamItem `refreshable:"false"`
+
+	SegmentDynamicSizeEnabled   ParamItem `refreshable:"true"`
+	SegmentTargetIndexBuildTime ParamItem `refreshable:"true"`
+	SegmentDynamicSizeMinRatio  ParamItem `refreshable:"true"`
+	SegmentDynamicSizeMaxRatio  ParamItem `refreshable:"true"`
 
 	// compaction
 	EnableCompaction     ParamItem `refreshable:"false"`
@@ -2016,13 +3214,43 @@ type dataCoordConfig struct {
 	SingleCompactionDeltalogMaxNum    ParamItem `refreshable:"true"`
 	GlobalCompactionInterval          ParamItem `refreshable:"false"`
 
+	// LevelZero Segment
+	LevelZeroCompactionTriggerMinSize        ParamItem `refreshable:"true"`
+	LevelZeroCompactionTriggerDeltalogMinNum ParamItem `refreshable:"true"`
+	LevelZeroCompactionTriggerMaxInterval    ParamItem `refreshable:"true"`
+
+	CompactionVerificationEnabled      ParamItem `refreshable:"true"`
+	CompactionVerificationPkSampleLogs ParamItem `refreshable:"true"`
+	CompactionVerificationPkMaxKeys    ParamItem `refreshable:"true"`
+	CompactionVerificationMaxRetry     ParamItem `refreshable:"true"`
+	CompactionPreferDedicatedNode      ParamItem `refreshable:"true"`
+
 	// Garbage Collection
 	EnableGarbageCollection ParamItem `refreshable:"false"`
 	GCInterval              ParamItem `refreshable:"false"`
 	GCMissingTolerance      ParamItem `refreshable:"false"`
 	GCDropTolerance         ParamItem `refreshable:"false"`
+	GCChecksumVerifyBatch   ParamItem `refreshable:"false"`
 	EnableActiveStandby     ParamItem `refreshable:"false"`
 
+	// Binlog Consolidation
+	BinlogConsolidationEnable          ParamItem `refreshable:"false"`
+	BinlogConsolidationInterval        ParamItem `refreshable:"false"`
+	BinlogConsolidationMinBinlogNum    ParamItem `refreshable:"true"`
+	BinlogConsolidationSmallBinlogSize ParamItem `refreshable:"true"`
+	BinlogConsolidationTargetSize      ParamItem `refreshable:"true"`
+	BinlogConsolidationMaxSegments     ParamItem `refreshable:"true"`
+
+	// Upload Rate Limit
+	UploadRateLimitEnable           ParamItem `refreshable:"true"`
+	UploadRateLimitDefaultPutRate   ParamItem `refreshable:"true"`
+	UploadRateLimitEndpointPutRates ParamItem `refreshable:"true"`
+	UploadRateLimitGrantInterval    ParamItem `refreshable:"false"`
+
+	// Partition Rollover
+	PartitionRolloverEnable        ParamItem `refreshable:"false"`
+	PartitionRolloverCheckInterval ParamItem `refreshable:"false"`
+
 	BindIndexNodeMode          ParamItem `refreshable:"false"`
 	IndexNodeAddress           ParamItem `refreshable:"false"`
 	WithCredential             ParamItem `refreshable:"false"`
@@ -2030,6 +3258,23 @@ type dataCoordConfig struct {
 	IndexTaskSchedulerInterval ParamItem `refreshable:"false"`
 
 	MinSegmentNumRowsToEnableIndex ParamItem `refreshable:"true"`
+
+	// auto index creation on the first flushed segment
+	AutoIndexOnSealEnabled   ParamItem `refreshable:"true"`
+	AutoIndexOnSealDatabases ParamItem `refreshable:"true"`
+	AutoIndexOnSealPolicies  ParamItem `refreshable:"true"`
+
+	// sample the files before accepting an import task
+	ImportPreValidationEnabled             ParamItem `refreshable:"true"`
+	ImportPreValidationSampleRows          ParamItem `refreshable:"true"`
+	ImportPreValidationMaxPKDuplicateRatio ParamItem `refreshable:"true"`
+
+	// copy the files of collection to another object storage
+	StorageMigrationParallelism ParamItem `refreshable:"true"`
+	StorageMigrationMaxRounds   ParamItem `refreshable:"true"`
+
+	// push the segment events to QueryCoord
+	SegmentEventPushEnabled ParamItem `refreshable:"true"`
 }
 
 func (p *dataCoordConfig) init(base *BaseTable) {
@@ -2069,12 +3314,22 @@ func (p *dataCoordConfig) init(base *BaseTable) {
 	}
 	p.ChannelOperationRPCTimeout.Init(base.mgr)
 
+	p.OrphanChannelCheckInterval = ParamItem{
+		Key:          "dataCoord.channel.orphanCheckInterval",
+		Version:      "2.3.2",
+		DefaultValue: "600",
+		Doc:          "The interval in seconds to detect the orphaned channels, which are watched but the collection is gone or vice versa, 0 to disable",
+		Export:       true,
+	}
+	p.OrphanChannelCheckInterval.Init(base.mgr)
+
 	p.SegmentMaxSize = ParamItem{
 		Key:          "dataCoord.segment.maxSize",
 		Version:      "2.0.0",
 		DefaultValue: "512",
 		Doc:          "Maximum size of a segment in MB",
 		Export:       true,
+		Validator:    FloatRange(1, math.MaxInt32),
 	}
 	p.SegmentMaxSize.Init(base.mgr)
 
@@ -2092,26 +3347,73 @@ func (p *dataCoordConfig) init(base *BaseTable) {
 		Version:      "2.0.0",
 		DefaultValue: "0.23",
 		Export:       true,
+		Validator:    FloatRange(0, 1),
+	}
+	p.SegmentSealProportion.Init(base.mgr)
+
+	p.SegAssignmentExpiration = ParamItem{
+		Key:          "dataCoord.segment.assignmentExpiration",
+		Version:      "2.0.0",
+		DefaultValue: "2000",
+		Doc:          "The time of the assignment expiration in ms",
+		Export:       true,
+	}
+	p.SegAssignmentExpiration.Init(base.mgr)
+
+	p.AllocLatestExpireAttempt = ParamItem{
+		Key:          "dataCoord.segment.allocLatestExpireAttempt",
+		Version:      "2.2.0",
+		DefaultValue: "200",
+		Doc:          "The time attempting to alloc latest lastExpire from rootCoord after restart",
+		Export:       true,
+	}
+	p.AllocLatestExpireAttempt.Init(base.mgr)
+
+	p.EnableLevelZeroSegment = ParamItem{
+		Key:          "dataCoord.segment.enableLevelZero",
+		Version:      "2.3.3",
+		DefaultValue: "false",
+		Doc:          "Whether to buffer deletes into delete-only L0 segments instead of applying them to growing and sealed segments directly",
+		Export:       true,
+	}
+	p.EnableLevelZeroSegment.Init(base.mgr)
+
+	p.SegmentDynamicSizeEnabled = ParamItem{
+		Key:          "dataCoord.segment.dynamicSize.enable",
+		Version:      "2.3.2",
+		DefaultValue: "false",
+		Doc: `Whether to adjust the max rows of new segments per collection by the observed average row size
+and index build time, instead of the estimation by schema and the fixed maxSize`,
+		Export: true,
+	}
+	p.SegmentDynamicSizeEnabled.Init(base.mgr)
+
+	p.SegmentTargetIndexBuildTime = ParamItem{
+		Key:          "dataCoord.segment.dynamicSize.targetIndexBuildTime",
+		Version:      "2.3.2",
+		DefaultValue: "600",
+		Doc:          "seconds. the segment rows are limited so that building index of a sealed segment takes about this time, 0 means no limit",
+		Export:       true,
 	}
-	p.SegmentSealProportion.Init(base.mgr)
+	p.SegmentTargetIndexBuildTime.Init(base.mgr)
 
-	p.SegAssignmentExpiration = ParamItem{
-		Key:          "dataCoord.segment.assignmentExpiration",
-		Version:      "2.0.0",
-		DefaultValue: "2000",
-		Doc:          "The time of the assignment expiration in ms",
+	p.SegmentDynamicSizeMinRatio = ParamItem{
+		Key:          "dataCoord.segment.dynamicSize.minRatio",
+		Version:      "2.3.2",
+		DefaultValue: "0.25",
+		Doc:          "the min ratio of the adjusted max rows to the max rows estimated by schema",
 		Export:       true,
 	}
-	p.SegAssignmentExpiration.Init(base.mgr)
+	p.SegmentDynamicSizeMinRatio.Init(base.mgr)
 
-	p.AllocLatestExpireAttempt = ParamItem{
-		Key:          "dataCoord.segment.allocLatestExpireAttempt",
-		Version:      "2.2.0",
-		DefaultValue: "200",
-		Doc:          "The time attempting to alloc latest lastExpire from rootCoord after restart",
+	p.SegmentDynamicSizeMaxRatio = ParamItem{
+		Key:          "dataCoord.segment.dynamicSize.maxRatio",
+		Version:      "2.3.2",
+		DefaultValue: "4",
+		Doc:          "the max ratio of the adjusted max rows to the max rows estimated by schema",
 		Export:       true,
 	}
-	p.AllocLatestExpireAttempt.Init(base.mgr)
+	p.SegmentDynamicSizeMaxRatio.Init(base.mgr)
 
 	p.SegmentMaxLifetime = ParamItem{
 		Key:          "dataCoord.segment.maxLife",
@@ -2294,6 +3596,81 @@ During compaction, the size of segment # of rows is able to exceed segment max #
 	}
 	p.GlobalCompactionInterval.Init(base.mgr)
 
+	p.LevelZeroCompactionTriggerMinSize = ParamItem{
+		Key:          "dataCoord.compaction.levelzero.forceTrigger.minSize",
+		Version:      "2.3.3",
+		DefaultValue: strconv.Itoa(8 * 1024 * 1024),
+		Doc:          "The minimum size in bytes of L0 deltalogs in a channel to force trigger a LevelZero compaction",
+		Export:       true,
+		Validator:    IntRange(0, math.MaxInt64),
+	}
+	p.LevelZeroCompactionTriggerMinSize.Init(base.mgr)
+
+	p.LevelZeroCompactionTriggerDeltalogMinNum = ParamItem{
+		Key:          "dataCoord.compaction.levelzero.forceTrigger.deltalogMinNum",
+		Version:      "2.3.3",
+		DefaultValue: "10",
+		Doc:          "The minimum number of L0 deltalogs in a channel to force trigger a LevelZero compaction",
+		Export:       true,
+		Validator:    IntRange(1, math.MaxInt32),
+	}
+	p.LevelZeroCompactionTriggerDeltalogMinNum.Init(base.mgr)
+
+	p.LevelZeroCompactionTriggerMaxInterval = ParamItem{
+		Key:          "dataCoord.compaction.levelzero.forceTrigger.maxInterval",
+		Version:      "2.3.3",
+		DefaultValue: "600",
+		Doc:          "The max interval in seconds an L0 segment may wait before a LevelZero compaction is triggered regardless of its size",
+		Export:       true,
+		Validator:    IntRange(1, math.MaxInt32),
+	}
+	p.LevelZeroCompactionTriggerMaxInterval.Init(base.mgr)
+
+	p.CompactionVerificationEnabled = ParamItem{
+		Key:          "dataCoord.compaction.verification.enable",
+		Version:      "2.3.2",
+		DefaultValue: "false",
+		Doc:          "verify the compaction result before dropping the compacted segments, it costs extra reads of the result binlogs",
+		Export:       true,
+	}
+	p.CompactionVerificationEnabled.Init(base.mgr)
+
+	p.CompactionVerificationPkSampleLogs = ParamItem{
+		Key:          "dataCoord.compaction.verification.pkSampleLogs",
+		Version:      "2.3.2",
+		DefaultValue: "4",
+		Doc:          "max number of primary key binlogs sampled to check the uniqueness of primary keys",
+		Export:       true,
+	}
+	p.CompactionVerificationPkSampleLogs.Init(base.mgr)
+
+	p.CompactionVerificationPkMaxKeys = ParamItem{
+		Key:          "dataCoord.compaction.verification.pkMaxKeys",
+		Version:      "2.3.2",
+		DefaultValue: "1000000",
+		Doc:          "max number of primary keys held in memory to check the uniqueness, the sampled binlogs beyond are skipped",
+		Export:       true,
+	}
+	p.CompactionVerificationPkMaxKeys.Init(base.mgr)
+
+	p.CompactionVerificationMaxRetry = ParamItem{
+		Key:          "dataCoord.compaction.verification.maxRetry",
+		Version:      "2.3.2",
+		DefaultValue: "3",
+		Doc:          "max times to re-execute the compaction plan whose result failed the verification",
+		Export:       true,
+	}
+	p.CompactionVerificationMaxRetry.Init(base.mgr)
+
+	p.CompactionPreferDedicatedNode = ParamItem{
+		Key:          "dataCoord.compaction.preferDedicatedNode",
+		Version:      "2.3.2",
+		DefaultValue: "true",
+		Doc:          "whether to schedule the mix compactions onto the dedicated compaction DataNodes when any is registered, instead of the DataNodes watching the channels",
+		Export:       true,
+	}
+	p.CompactionPreferDedicatedNode.Init(base.mgr)
+
 	p.EnableGarbageCollection = ParamItem{
 		Key:          "dataCoord.enableGarbageCollection",
 		Version:      "2.0.0",
@@ -2331,6 +3708,15 @@ During compaction, the size of segment # of rows is able to exceed segment max #
 	}
 	p.GCDropTolerance.Init(base.mgr)
 
+	p.GCChecksumVerifyBatch = ParamItem{
+		Key:          "dataCoord.gc.checksumVerifyBatch",
+		Version:      "2.3.2",
+		DefaultValue: "100",
+		Doc:          "number of binlogs sampled to verify checksums in each gc round, 0 to disable",
+		Export:       true,
+	}
+	p.GCChecksumVerifyBatch.Init(base.mgr)
+
 	p.EnableActiveStandby = ParamItem{
 		Key:          "dataCoord.enableActiveStandby",
 		Version:      "2.0.0",
@@ -2339,6 +3725,114 @@ During compaction, the size of segment # of rows is able to exceed segment max #
 	}
 	p.EnableActiveStandby.Init(base.mgr)
 
+	p.BinlogConsolidationEnable = ParamItem{
+		Key:          "dataCoord.binlogConsolidation.enable",
+		Version:      "2.3.2",
+		DefaultValue: "false",
+		Doc:          "Switch value to control if to merge the small binlog files of flushed segments into larger ones, the segments are not merged",
+		Export:       true,
+	}
+	p.BinlogConsolidationEnable.Init(base.mgr)
+
+	p.BinlogConsolidationInterval = ParamItem{
+		Key:          "dataCoord.binlogConsolidation.interval",
+		Version:      "2.3.2",
+		DefaultValue: "600",
+		Doc:          "The interval in seconds to check the segments having small binlog files",
+		Export:       true,
+	}
+	p.BinlogConsolidationInterval.Init(base.mgr)
+
+	p.BinlogConsolidationMinBinlogNum = ParamItem{
+		Key:          "dataCoord.binlogConsolidation.minBinlogNum",
+		Version:      "2.3.2",
+		DefaultValue: "8",
+		Doc:          "The minimum number of small binlog files per field for a segment to be consolidated",
+		Export:       true,
+	}
+	p.BinlogConsolidationMinBinlogNum.Init(base.mgr)
+
+	p.BinlogConsolidationSmallBinlogSize = ParamItem{
+		Key:          "dataCoord.binlogConsolidation.smallBinlogSize",
+		Version:      "2.3.2",
+		DefaultValue: "1",
+		Doc:          "The binlog file smaller than this size in MB is treated as small file",
+		Export:       true,
+	}
+	p.BinlogConsolidationSmallBinlogSize.Init(base.mgr)
+
+	p.BinlogConsolidationTargetSize = ParamItem{
+		Key:          "dataCoord.binlogConsolidation.targetSize",
+		Version:      "2.3.2",
+		DefaultValue: "64",
+		Doc:          "The expected size in MB of the binlog files of all fields merged from the small ones",
+		Export:       true,
+	}
+	p.BinlogConsolidationTargetSize.Init(base.mgr)
+
+	p.BinlogConsolidationMaxSegments = ParamItem{
+		Key:          "dataCoord.binlogConsolidation.maxSegmentsPerRound",
+		Version:      "2.3.2",
+		DefaultValue: "10",
+		Doc:          "The maximum number of segments consolidated in each round",
+		Export:       true,
+	}
+	p.BinlogConsolidationMaxSegments.Init(base.mgr)
+
+	p.UploadRateLimitEnable = ParamItem{
+		Key:          "dataCoord.uploadRateLimit.enable",
+		Version:      "2.3.2",
+		DefaultValue: "false",
+		Doc:          "Switch value to control if to limit the rate of PUT requests issued by the flush and compaction of all datanodes to each object storage endpoint",
+		Export:       true,
+	}
+	p.UploadRateLimitEnable.Init(base.mgr)
+
+	p.UploadRateLimitDefaultPutRate = ParamItem{
+		Key:          "dataCoord.uploadRateLimit.defaultPutRate",
+		Version:      "2.3.2",
+		DefaultValue: "3000",
+		Doc:          "The maximum PUT requests per second of all datanodes to the object storage endpoint not listed in endpointPutRates, 0 means unlimited",
+		Export:       true,
+	}
+	p.UploadRateLimitDefaultPutRate.Init(base.mgr)
+
+	p.UploadRateLimitEndpointPutRates = ParamItem{
+		Key:          "dataCoord.uploadRateLimit.endpointPutRates",
+		Version:      "2.3.2",
+		DefaultValue: "",
+		Doc:          "The maximum PUT requests per second of all datanodes to the given object storage endpoints, e.g. s3.us-west-2.amazonaws.com=3500,localhost:9000=1000",
+		Export:       true,
+	}
+	p.UploadRateLimitEndpointPutRates.Init(base.mgr)
+
+	p.UploadRateLimitGrantInterval = ParamItem{
+		Key:          "dataCoord.uploadRateLimit.grantInterval",
+		Version:      "2.3.2",
+		DefaultValue: "10",
+		Doc:          "The interval in seconds to split the PUT rates among the datanodes, which is also the interval of datanodes to refresh their grants",
+		Export:       true,
+	}
+	p.UploadRateLimitGrantInterval.Init(base.mgr)
+
+	p.PartitionRolloverEnable = ParamItem{
+		Key:          "dataCoord.partitionRollover.enable",
+		Version:      "2.3.2",
+		DefaultValue: "true",
+		Doc:          "Switch value to control if to create and drop the partitions of the collections partitioned by time, i.e. having property collection.partition.rollover",
+		Export:       true,
+	}
+	p.PartitionRolloverEnable.Init(base.mgr)
+
+	p.PartitionRolloverCheckInterval = ParamItem{
+		Key:          "dataCoord.partitionRollover.checkInterval",
+		Version:      "2.3.2",
+		DefaultValue: "60",
+		Doc:          "The interval in seconds to check the partitions of the collections partitioned by time",
+		Export:       true,
+	}
+	p.PartitionRolloverCheckInterval.Init(base.mgr)
+
 	p.MinSegmentNumRowsToEnableIndex = ParamItem{
 		Key:          "indexCoord.segment.minSegmentNumRowsToEnableIndex",
 		Version:      "2.0.0",
@@ -2385,6 +3879,94 @@ During compaction, the size of segment # of rows is able to exceed segment max #
 		DefaultValue: "1000",
 	}
 	p.IndexTaskSchedulerInterval.Init(base.mgr)
+
+	p.AutoIndexOnSealEnabled = ParamItem{
+		Key:          "dataCoord.autoIndexOnSeal.enable",
+		Version:      "2.3.2",
+		DefaultValue: "false",
+		Doc: `whether to create the default vector index automatically when the first segment of a collection is flushed,
+if the vector field has no index. It could be overridden by the collection property collection.autoindex.onseal.enabled`,
+		Export: true,
+	}
+	p.AutoIndexOnSealEnabled.Init(base.mgr)
+
+	p.AutoIndexOnSealDatabases = ParamItem{
+		Key:          "dataCoord.autoIndexOnSeal.databases",
+		Version:      "2.3.2",
+		DefaultValue: "",
+		Doc:          "the databases to create the default vector index automatically, separated by comma, empty means all databases",
+		Export:       true,
+	}
+	p.AutoIndexOnSealDatabases.Init(base.mgr)
+
+	p.AutoIndexOnSealPolicies = ParamItem{
+		Key:     "dataCoord.autoIndexOnSeal.policies",
+		Version: "2.3.2",
+		DefaultValue: `[{"data_type": "FloatVector", "max_dim": 4096, "index_type": "HNSW", "metric_type": "L2", "params": {"M": "16", "efConstruction": "200"}},` +
+			`{"data_type": "FloatVector", "index_type": "IVF_FLAT", "metric_type": "L2", "params": {"nlist": "1024"}},` +
+			`{"data_type": "Float16Vector", "index_type": "IVF_FLAT", "metric_type": "L2", "params": {"nlist": "1024"}},` +
+			`{"data_type": "BinaryVector", "index_type": "BIN_IVF_FLAT", "metric_type": "HAMMING", "params": {"nlist": "1024"}}]`,
+		Doc: `the index to create for the vector field, in json format. The first policy matching the data type and dim range [min_dim, max_dim] of the field is used,
+max_dim 0 means no upper limit`,
+		Export: true,
+	}
+	p.AutoIndexOnSealPolicies.Init(base.mgr)
+
+	p.ImportPreValidationEnabled = ParamItem{
+		Key:          "dataCoord.import.preValidation.enable",
+		Version:      "2.3.2",
+		DefaultValue: "false",
+		Doc: `whether to sample the import files before assigning the import task to datanode,
+the task fails fast if the files are not compatible with the schema or have too many duplicated primary keys`,
+		Export: true,
+	}
+	p.ImportPreValidationEnabled.Init(base.mgr)
+
+	p.ImportPreValidationSampleRows = ParamItem{
+		Key:          "dataCoord.import.preValidation.sampleRows",
+		Version:      "2.3.2",
+		DefaultValue: "1000",
+		Doc:          "the number of rows sampled from the beginning of each import file",
+		Export:       true,
+	}
+	p.ImportPreValidationSampleRows.Init(base.mgr)
+
+	p.ImportPreValidationMaxPKDuplicateRatio = ParamItem{
+		Key:          "dataCoord.import.preValidation.maxPKDuplicateRatio",
+		Version:      "2.3.2",
+		DefaultValue: "0.01",
+		Doc:          "the import task is rejected if the ratio of the sampled rows with duplicated primary key exceeds it, 1 to disable the check",
+		Export:       true,
+	}
+	p.ImportPreValidationMaxPKDuplicateRatio.Init(base.mgr)
+
+	p.StorageMigrationParallelism = ParamItem{
+		Key:          "dataCoord.storageMigration.parallelism",
+		Version:      "2.3.2",
+		DefaultValue: "8",
+		Doc:          "the number of files copied concurrently by the storage migration job",
+		Export:       true,
+	}
+	p.StorageMigrationParallelism.Init(base.mgr)
+
+	p.StorageMigrationMaxRounds = ParamItem{
+		Key:          "dataCoord.storageMigration.maxRounds",
+		Version:      "2.3.2",
+		DefaultValue: "5",
+		Doc: `the max rounds the storage migration job copies the files added by flush and compaction during the migration,
+the job fails if the files of the collection keep changing after the rounds`,
+		Export: true,
+	}
+	p.StorageMigrationMaxRounds.Init(base.mgr)
+
+	p.SegmentEventPushEnabled = ParamItem{
+		Key:          "dataCoord.segmentEvent.pushEnabled",
+		Version:      "2.3.2",
+		DefaultValue: "true",
+		Doc:          "whether to push the events of the segments flushed, dropped or compacted to QueryCoord via etcd",
+		Export:       true,
+	}
+	p.SegmentEventPushEnabled.Init(base.mgr)
 }
 
 // /////////////////////////////////////////////////////////////////////////////
@@ -2393,12 +3975,18 @@ type dataNodeConfig struct {
 	FlowGraphMaxQueueLength ParamItem `refreshable:"false"`
 	FlowGraphMaxParallelism ParamItem `refreshable:"false"`
 	MaxParallelSyncTaskNum  ParamItem `refreshable:"false"`
+	ZeroCopyInsertEnabled   ParamItem `refreshable:"true"`
+	InsertBatchEnabled      ParamItem `refreshable:"true"`
+	InsertBatchMaxRows      ParamItem `refreshable:"true"`
 
 	// segment
 	FlushInsertBufferSize  ParamItem `refreshable:"true"`
 	FlushDeleteBufferBytes ParamItem `refreshable:"true"`
 	BinLogMaxSize          ParamItem `refreshable:"true"`
 	SyncPeriod             ParamItem `refreshable:"true"`
+	LevelZeroSyncPeriod    ParamItem `refreshable:"true"`
+	DeltalogFormat         ParamItem `refreshable:"true"`
+	StatsLogMergeNum       ParamItem `refreshable:"true"`
 
 	// watchEvent
 	WatchEventTicklerInterval ParamItem `refreshable:"false"`
@@ -2417,6 +4005,10 @@ type dataNodeConfig struct {
 	DataNodeTimeTickByRPC ParamItem `refreshable:"false"`
 	// DataNode send timetick interval per collection
 	DataNodeTimeTickInterval ParamItem `refreshable:"false"`
+	// size of the dedicated pool sending timetick
+	DataNodeTimeTickPoolSize ParamItem `refreshable:"false"`
+	// the DataNode is reported degraded if the timetick lag exceeds the threshold
+	DataNodeTimeTickLagThreshold ParamItem `refreshable:"true"`
 
 	// timeout for bulkinsert
 	BulkInsertTimeoutSeconds ParamItem `refreshable:"true"`
@@ -2425,7 +4017,20 @@ type dataNodeConfig struct {
 	SkipBFStatsLoad ParamItem `refreshable:"true"`
 
 	// channel
-	ChannelWorkPoolSize ParamItem `refreshable:"true"`
+	ChannelWorkPoolSize         ParamItem `refreshable:"true"`
+	ChannelRecoveryConcurrency  ParamItem `refreshable:"true"`
+	ChannelRecoveryMemoryBudget ParamItem `refreshable:"true"`
+
+	// cdc
+	CDCEnabled     ParamItem `refreshable:"false"`
+	CDCMQType      ParamItem `refreshable:"false"`
+	CDCAddress     ParamItem `refreshable:"false"`
+	CDCTopicPrefix ParamItem `refreshable:"false"`
+	CDCQueueSize   ParamItem `refreshable:"false"`
+	CDCMaxRetries  ParamItem `refreshable:"true"`
+
+	// compaction
+	CompactionDedicated ParamItem `refreshable:"false"`
 }
 
 func (p *dataNodeConfig) init(base *BaseTable) {
@@ -2456,6 +4061,33 @@ func (p *dataNodeConfig) init(base *BaseTable) {
 	}
 	p.MaxParallelSyncTaskNum.Init(base.mgr)
 
+	p.ZeroCopyInsertEnabled = ParamItem{
+		Key:          "dataNode.dataSync.zeroCopyInsert.enabled",
+		Version:      "2.3.2",
+		DefaultValue: "true",
+		Doc:          "Whether to buffer the insert data sharing the memory with the consumed messages until sync, instead of copying it on every message",
+		Export:       true,
+	}
+	p.ZeroCopyInsertEnabled.Init(base.mgr)
+
+	p.InsertBatchEnabled = ParamItem{
+		Key:          "dataNode.dataSync.insertBatch.enabled",
+		Version:      "2.3.2",
+		DefaultValue: "true",
+		Doc:          "Whether to coalesce the small insert messages of the same segment consumed together into batched ones before buffering",
+		Export:       true,
+	}
+	p.InsertBatchEnabled.Init(base.mgr)
+
+	p.InsertBatchMaxRows = ParamItem{
+		Key:          "dataNode.dataSync.insertBatch.maxRows",
+		Version:      "2.3.2",
+		DefaultValue: "1024",
+		Doc:          "The max rows of a batched insert, the insert messages of more rows are buffered alone",
+		Export:       true,
+	}
+	p.InsertBatchMaxRows.Init(base.mgr)
+
 	p.FlushInsertBufferSize = ParamItem{
 		Key:          "dataNode.segment.insertBufSize",
 		Version:      "2.0.0",
@@ -2528,6 +4160,36 @@ func (p *dataNodeConfig) init(base *BaseTable) {
 	}
 	p.SyncPeriod.Init(base.mgr)
 
+	p.LevelZeroSyncPeriod = ParamItem{
+		Key:          "dataNode.segment.levelZeroSyncPeriod",
+		Version:      "2.3.3",
+		DefaultValue: "30",
+		Doc:          "The period in seconds to sync buffered deletes into L0 segments if the buffer is not empty.",
+		Export:       true,
+		Validator:    IntRange(1, math.MaxInt32),
+	}
+	p.LevelZeroSyncPeriod.Init(base.mgr)
+
+	p.DeltalogFormat = ParamItem{
+		Key:          "dataNode.segment.deltalogFormat",
+		Version:      "2.3.2",
+		DefaultValue: "roaring",
+		Doc:          "format of the delta logs written, roaring or json, set json while rolling upgrading from the versions which can't read the roaring format",
+		Export:       true,
+		Validator:    OneOf("roaring", "json"),
+	}
+	p.DeltalogFormat.Init(base.mgr)
+
+	p.StatsLogMergeNum = ParamItem{
+		Key:          "dataNode.segment.statsLogMergeNum",
+		Version:      "2.3.2",
+		DefaultValue: "8",
+		Doc: `the growing segment persists all its pk stats into one compound stats log on every sync once it has this many stats logs,
+so the bloom filters are reloaded from one file on channel recovery, 0 means the stats logs are only merged on flush`,
+		Export: true,
+	}
+	p.StatsLogMergeNum.Init(base.mgr)
+
 	p.WatchEventTicklerInterval = ParamItem{
 		Key:          "datanode.segment.watchEventTicklerInterval",
 		Version:      "2.2.3",
@@ -2565,6 +4227,26 @@ func (p *dataNodeConfig) init(base *BaseTable) {
 	}
 	p.DataNodeTimeTickInterval.Init(base.mgr)
 
+	p.DataNodeTimeTickPoolSize = ParamItem{
+		Key:          "dataNode.timetick.poolSize",
+		Version:      "2.3.2",
+		DefaultValue: "-1",
+		Doc: `specify the size of the dedicated pool sending timetick, which is isolated from data syncing,
+if this parameter <= 0, will set it as the maximum number of CPUs that can be executing`,
+		Export: true,
+	}
+	p.DataNodeTimeTickPoolSize.Init(base.mgr)
+
+	p.DataNodeTimeTickLagThreshold = ParamItem{
+		Key:          "dataNode.timetick.lagThreshold",
+		Version:      "2.3.2",
+		DefaultValue: "60",
+		Doc: `seconds, the DataNode is reported degraded in health check if the lag of timetick sent exceeds the threshold,
+if this parameter <= 0, the lag check is disabled`,
+		Export: true,
+	}
+	p.DataNodeTimeTickLagThreshold.Init(base.mgr)
+
 	p.SkipBFStatsLoad = ParamItem{
 		Key:          "dataNode.skip.BFStats.Load",
 		Version:      "2.2.5",
@@ -2588,6 +4270,87 @@ func (p *dataNodeConfig) init(base *BaseTable) {
 		DefaultValue: "-1",
 	}
 	p.ChannelWorkPoolSize.Init(base.mgr)
+
+	p.ChannelRecoveryConcurrency = ParamItem{
+		Key:          "dataNode.channel.recoveryConcurrency",
+		Version:      "2.3.2",
+		DefaultValue: "8",
+		Doc:          "Maximum number of channels recovering their segments in parallel while watched",
+		Export:       true,
+	}
+	p.ChannelRecoveryConcurrency.Init(base.mgr)
+
+	p.ChannelRecoveryMemoryBudget = ParamItem{
+		Key:          "dataNode.channel.recoveryMemoryBudget",
+		Version:      "2.3.2",
+		DefaultValue: "1024",
+		Doc:          "Memory budget in MB of the stats logs loaded by the channels recovering in parallel, a channel exceeding the budget alone recovers exclusively",
+		Export:       true,
+	}
+	p.ChannelRecoveryMemoryBudget.Init(base.mgr)
+
+	p.CDCEnabled = ParamItem{
+		Key:          "dataNode.cdc.enabled",
+		Version:      "2.3.2",
+		DefaultValue: "false",
+		Doc:          "whether to publish the committed inserts and deletes of flushed segments to the external topics",
+		Export:       true,
+	}
+	p.CDCEnabled.Init(base.mgr)
+
+	p.CDCMQType = ParamItem{
+		Key:          "dataNode.cdc.mqType",
+		Version:      "2.3.2",
+		DefaultValue: "kafka",
+		Doc:          "the type of external message queue to publish changes to, kafka or pulsar",
+		Export:       true,
+	}
+	p.CDCMQType.Init(base.mgr)
+
+	p.CDCAddress = ParamItem{
+		Key:          "dataNode.cdc.address",
+		Version:      "2.3.2",
+		DefaultValue: "",
+		Doc:          "the address of external message queue, broker list for kafka or service url for pulsar",
+		Export:       true,
+	}
+	p.CDCAddress.Init(base.mgr)
+
+	p.CDCTopicPrefix = ParamItem{
+		Key:          "dataNode.cdc.topicPrefix",
+		Version:      "2.3.2",
+		DefaultValue: "milvus-cdc",
+		Doc:          "the changes of a collection are published to topic {topicPrefix}-{collectionID}",
+		Export:       true,
+	}
+	p.CDCTopicPrefix.Init(base.mgr)
+
+	p.CDCQueueSize = ParamItem{
+		Key:          "dataNode.cdc.queueSize",
+		Version:      "2.3.2",
+		DefaultValue: "1024",
+		Doc:          "maximum number of committed flush packs waiting to be published, the sync blocks until the queue has room",
+		Export:       true,
+	}
+	p.CDCQueueSize.Init(base.mgr)
+
+	p.CDCMaxRetries = ParamItem{
+		Key:          "dataNode.cdc.maxRetries",
+		Version:      "2.3.2",
+		DefaultValue: "10",
+		Doc:          "maximum number of attempts to publish a change, the change is dropped and counted as failed after that",
+		Export:       true,
+	}
+	p.CDCMaxRetries.Init(base.mgr)
+
+	p.CompactionDedicated = ParamItem{
+		Key:          "dataNode.compaction.dedicated",
+		Version:      "2.3.2",
+		DefaultValue: "false",
+		Doc:          "whether the DataNode is dedicated to compaction, no channel is assigned to a dedicated DataNode and it executes the mix compactions of any channel",
+		Export:       true,
+	}
+	p.CompactionDedicated.Init(base.mgr)
 }
 
 // /////////////////////////////////////////////////////////////////////////////
@@ -2600,6 +4363,9 @@ type indexNodeConfig struct {
 	MaxDiskUsagePercentage ParamItem `refreshable:"true"`
 
 	GracefulStopTimeout ParamItem `refreshable:"false"`
+
+	BuildCheckpointEnabled      ParamItem `refreshable:"true"`
+	BuildCheckpointMinBuildTime ParamItem `refreshable:"true"`
 }
 
 func (p *indexNodeConfig) init(base *BaseTable) {
@@ -2657,6 +4423,24 @@ func (p *indexNodeConfig) init(base *BaseTable) {
 		Export:       true,
 	}
 	p.GracefulStopTimeout.Init(base.mgr)
+
+	p.BuildCheckpointEnabled = ParamItem{
+		Key:          "indexNode.buildCheckpoint.enabled",
+		Version:      "2.3.2",
+		DefaultValue: "true",
+		Doc:          "checkpoint the index files of long builds, so that the retried build after IndexNode restarting reuses them instead of building from scratch",
+		Export:       true,
+	}
+	p.BuildCheckpointEnabled.Init(base.mgr)
+
+	p.BuildCheckpointMinBuildTime = ParamItem{
+		Key:          "indexNode.buildCheckpoint.minBuildTime",
+		Version:      "2.3.2",
+		DefaultValue: "60",
+		Doc:          "the minimum build time in seconds of the index to be checkpointed",
+		Export:       true,
+	}
+	p.BuildCheckpointMinBuildTime.Init(base.mgr)
 }
 
 type integrationTestConfig struct {
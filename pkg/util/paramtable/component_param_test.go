@@ -47,12 +47,21 @@ func TestComponentParam(t *testing.T) {
 		params.Save("common.entityExpiration", "50")
 		assert.Equal(t, Params.EntityExpirationTTL.GetAsInt(), 50)
 
+		assert.Equal(t, Params.RetentionDuration.GetAsInt64(), int64(0))
+		params.Save("common.retentionDuration", "-10")
+		assert.Equal(t, Params.RetentionDuration.GetAsInt64(), int64(0))
+		params.Save("common.retentionDuration", "3600")
+		assert.Equal(t, Params.RetentionDuration.GetAsInt64(), int64(3600))
+
 		assert.NotEqual(t, Params.SimdType.GetValue(), "")
 		t.Logf("knowhere simd type = %s", Params.SimdType.GetValue())
 
 		assert.Equal(t, Params.IndexSliceSize.GetAsInt64(), int64(DefaultIndexSliceSize))
 		t.Logf("knowhere index slice size = %d", Params.IndexSliceSize.GetAsInt64())
 
+		assert.Equal(t, 16, Params.MaxSearchBeamWidth.GetAsInt())
+		assert.Equal(t, 16384, Params.MaxSearchListSize.GetAsInt())
+
 		assert.Equal(t, Params.GracefulTime.GetAsInt64(), int64(DefaultGracefulTime))
 		t.Logf("default grafeful time = %d", Params.GracefulTime.GetAsInt64())
 
@@ -63,6 +72,14 @@ func TestComponentParam(t *testing.T) {
 		params.Save(Params.GracefulStopTimeout.Key, "50")
 		assert.Equal(t, Params.GracefulStopTimeout.GetAsInt64(), int64(50))
 
+		assert.Equal(t, []string{"proxy", "datanode", "querynode", "indexnode", "coordinator"}, Params.GracefulStopOrder.GetAsStrings())
+		assert.Equal(t, 600*time.Second, Params.GracefulStopStageTimeout.GetAsDuration(time.Second))
+		assert.Equal(t, []string{"channelsWatched", "targetsReady"}, Params.ReadinessChecks.GetAsStrings())
+		assert.True(t, Params.ReadinessStandbyReady.GetAsBool())
+
+		assert.Equal(t, 5*time.Second, Params.MetricsCollectTimeout.GetAsDuration(time.Millisecond))
+		assert.Equal(t, 16, Params.MetricsCollectParallelism.GetAsInt())
+
 		// -- rootcoord --
 		assert.Equal(t, Params.RootCoordTimeTick.GetValue(), "by-dev-rootcoord-timetick")
 		t.Logf("rootcoord timetick channel = %s", Params.RootCoordTimeTick.GetValue())
@@ -101,6 +118,18 @@ func TestComponentParam(t *testing.T) {
 		params.Save("common.security.superUsers", "")
 		assert.Equal(t, []string{""}, Params.SuperUsers.GetAsStrings())
 
+		assert.False(t, Params.TLSIdentityEnabled.GetAsBool())
+		assert.Equal(t, "cn", Params.TLSIdentitySource.GetValue())
+		assert.Equal(t, "", Params.TLSIdentityMapping.GetValue())
+
+		assert.Equal(t, 0, Params.PasswordMinCharClasses.GetAsInt())
+		assert.Equal(t, 0, Params.PasswordExpireDays.GetAsInt())
+		assert.Equal(t, 0, Params.PasswordReuseHistory.GetAsInt())
+		assert.Equal(t, time.Duration(0), Params.PasswordRotationWindow.GetAsDuration(time.Second))
+		assert.Equal(t, true, Params.APIKeyEnabled.GetAsBool())
+		assert.Equal(t, 10, Params.APIKeyMaxKeysPerUser.GetAsInt())
+		assert.Equal(t, time.Duration(0), Params.APIKeyMaxTTL.GetAsDuration(time.Second))
+
 		assert.Equal(t, false, Params.PreCreatedTopicEnabled.GetAsBool())
 
 		params.Save("common.preCreatedTopic.names", "topic1,topic2,topic3")
@@ -121,6 +150,10 @@ func TestComponentParam(t *testing.T) {
 		t.Logf("master ImportTaskRetention = %f", Params.ImportTaskRetention.GetAsFloat())
 		assert.Equal(t, Params.EnableActiveStandby.GetAsBool(), false)
 		t.Logf("rootCoord EnableActiveStandby = %t", Params.EnableActiveStandby.GetAsBool())
+		assert.False(t, Params.MigratePrivilegeGroups.GetAsBool())
+		assert.Equal(t, time.Duration(0), Params.CollectionTrashRetention.GetAsDuration(time.Second))
+		assert.Equal(t, 60*time.Second, Params.CollectionTrashCheckInterval.GetAsDuration(time.Second))
+		assert.Equal(t, time.Second, Params.TSODriftAlarmThreshold.GetAsDuration(time.Millisecond))
 
 		SetCreateTime(time.Now())
 		SetUpdateTime(time.Now())
@@ -153,6 +186,18 @@ func TestComponentParam(t *testing.T) {
 
 		t.Logf("AccessLog.MaxDays: %d", Params.AccessLog.RotatedTime.GetAsInt64())
 
+		assert.False(t, Params.AuditLog.Enable.GetAsBool())
+		assert.Equal(t, "file", Params.AuditLog.Sink.GetValue())
+		assert.Equal(t, "/tmp/milvus_auditlog", Params.AuditLog.LocalPath.GetValue())
+		assert.Equal(t, "milvus_audit.log", Params.AuditLog.Filename.GetValue())
+		assert.Equal(t, 64, Params.AuditLog.MaxSize.GetAsInt())
+		assert.Equal(t, 8, Params.AuditLog.MaxBackups.GetAsInt())
+		assert.Equal(t, "milvus-audit-log", Params.AuditLog.KafkaTopic.GetValue())
+		assert.Equal(t, 10000, Params.AuditLog.BufferSize.GetAsInt())
+		assert.Equal(t, 1.0, Params.AuditLog.SampleRate.GetAsFloat())
+		assert.False(t, Params.AuditLog.IncludeExpr.GetAsBool())
+		assert.Empty(t, Params.AuditLog.RedactFields.GetValue())
+
 		t.Logf("ShardLeaderCacheInterval: %d", Params.ShardLeaderCacheInterval.GetAsInt64())
 
 		assert.Equal(t, Params.ReplicaSelectionPolicy.GetValue(), "look_aside")
@@ -164,6 +209,38 @@ func TestComponentParam(t *testing.T) {
 		assert.Equal(t, Params.CostMetricsExpireTime.GetAsInt(), 1000)
 		assert.Equal(t, Params.RetryTimesOnReplica.GetAsInt(), 2)
 		assert.EqualValues(t, Params.HealthCheckTimeout.GetAsInt64(), 3000)
+		assert.False(t, Params.InsertPartialAcceptEnabled.GetAsBool())
+		assert.False(t, Params.UpsertPartialUpdateEnabled.GetAsBool())
+		assert.Equal(t, time.Minute, Params.UpsertPartialUpdateConflictWindow.GetAsDuration(time.Second))
+		assert.False(t, Params.PartitionPrefetchEnabled.GetAsBool())
+		assert.Equal(t, 3, Params.PartitionPrefetchMissThreshold.GetAsInt())
+		assert.Equal(t, time.Minute, Params.PartitionPrefetchMissWindow.GetAsDuration(time.Second))
+		assert.EqualValues(t, 1024, Params.PartitionPrefetchMemoryBudget.GetAsInt64())
+		assert.Equal(t, 30*time.Minute, Params.PartitionPrefetchIdleTTL.GetAsDuration(time.Second))
+		assert.Equal(t, 0, Params.DQLPipelineComputeWorkers.GetAsInt())
+		assert.Equal(t, 1024, Params.DQLPipelineIOWorkers.GetAsInt())
+		assert.Equal(t, 1024, Params.DQLPipelineQueueSize.GetAsInt())
+		assert.EqualValues(t, 10, Params.GroupBySearchCandidateFactor.GetAsInt64())
+		assert.Equal(t, 1800*time.Second, Params.QueryIteratorSnapshotTTL.GetAsDuration(time.Second))
+		assert.Equal(t, int64(16777216), Params.QueryIteratorMaxChunkSize.GetAsInt64())
+		assert.True(t, Params.ResponseCompressionEnabled.GetAsBool())
+		assert.Equal(t, 1048576, Params.ResponseCompressionMinSize.GetAsInt())
+		assert.Equal(t, int64(0), Params.ResultSizeLimit.GetAsInt64())
+		assert.False(t, Params.CoordClientPoolEnabled.GetAsBool())
+		assert.Equal(t, 4, Params.CoordClientPoolSize.GetAsInt())
+		assert.Equal(t, 32, Params.CoordClientPoolMaxConcurrencyPerDatabase.GetAsInt())
+		assert.Equal(t, 4, Params.SubqueryMaxNum.GetAsInt())
+		assert.Equal(t, 16384, Params.SubqueryMaxResultNum.GetAsInt())
+		assert.True(t, Params.EmbeddingEnabled.GetAsBool())
+		assert.Equal(t, 32, Params.EmbeddingBatchSize.GetAsInt())
+		assert.Equal(t, 10, Params.EmbeddingTimeout.GetAsInt())
+		assert.Equal(t, 10000, Params.EmbeddingCacheSize.GetAsInt())
+		assert.Equal(t, "", Params.EmbeddingOnnxRunner.GetValue())
+		assert.Equal(t, 8, Params.HybridSearchMaxRequestNum.GetAsInt())
+		assert.Equal(t, "", Params.HybridSearchRankerPlugin.GetValue())
+		assert.Equal(t, 10000, Params.DeleteByFilterBatchSize.GetAsInt())
+		assert.Equal(t, 24*time.Hour, Params.SessionIdleTimeout.GetAsDuration(time.Second))
+		assert.Equal(t, 0, Params.SessionMaxPerUser.GetAsInt())
 	})
 
 	// t.Run("test proxyConfig panic", func(t *testing.T) {
@@ -234,6 +311,35 @@ func TestComponentParam(t *testing.T) {
 		Params := &params.QueryCoordCfg
 		assert.Equal(t, Params.EnableActiveStandby.GetAsBool(), false)
 		t.Logf("queryCoord EnableActiveStandby = %t", Params.EnableActiveStandby.GetAsBool())
+		assert.Equal(t, 0.2, Params.HealthScoreSmoothingFactor.GetAsFloat())
+		assert.Equal(t, 0.6, Params.GrayFailureScoreThreshold.GetAsFloat())
+
+		assert.False(t, Params.AutoReplicaEnabled.GetAsBool())
+		assert.Equal(t, 30, Params.AutoReplicaCheckInterval.GetAsInt())
+		assert.Equal(t, 1, Params.AutoReplicaMinNumber.GetAsInt())
+		assert.Equal(t, 3, Params.AutoReplicaMaxNumber.GetAsInt())
+		assert.Equal(t, 1000.0, Params.AutoReplicaScaleUpQPSThreshold.GetAsFloat())
+		assert.Equal(t, 100.0, Params.AutoReplicaScaleDownQPSThreshold.GetAsFloat())
+		assert.Equal(t, 80.0, Params.AutoReplicaScaleUpCPUThreshold.GetAsFloat())
+		assert.Equal(t, 30.0, Params.AutoReplicaScaleDownCPUThreshold.GetAsFloat())
+		assert.Equal(t, 300, Params.AutoReplicaSustainedSeconds.GetAsInt())
+		assert.Equal(t, 0.2, Params.AutoReplicaMemoryHeadroomRatio.GetAsFloat())
+
+		assert.False(t, Params.ConsistencyCheckEnabled.GetAsBool())
+		assert.Equal(t, 600, Params.ConsistencyCheckInterval.GetAsInt())
+		assert.Equal(t, 16, Params.ConsistencyCheckSampleSize.GetAsInt())
+		assert.Equal(t, 300, Params.ConsistencyCheckDeltaLagSecond.GetAsInt())
+		assert.False(t, Params.ConsistencyCheckAutoResync.GetAsBool())
+		assert.True(t, Params.LoadScheduleEnabled.GetAsBool())
+		assert.Equal(t, 30, Params.LoadScheduleCheckInterval.GetAsInt())
+		assert.Equal(t, 86400, Params.LoadScheduleLookback.GetAsInt())
+		assert.True(t, Params.LoadRecoveryEnabled.GetAsBool())
+		assert.Equal(t, 30, Params.LoadRecoveryCheckInterval.GetAsInt())
+		assert.Equal(t, 300, Params.LoadRecoveryGracePeriod.GetAsInt())
+		assert.Equal(t, 3, Params.LoadRecoveryMaxTargetRebuild.GetAsInt())
+		assert.Equal(t, 3, Params.LoadConfigCheckInterval.GetAsInt())
+		assert.True(t, Params.SegmentEventWatchEnabled.GetAsBool())
+		assert.Equal(t, 1800*time.Second, Params.SegmentEventFallbackPullInterval.GetAsDuration(time.Second))
 
 		params.Save("queryCoord.NextTargetSurviveTime", "100")
 		NextTargetSurviveTime := &Params.NextTargetSurviveTime
@@ -265,6 +371,9 @@ func TestComponentParam(t *testing.T) {
 		assert.Equal(t, 0.1, Params.GlobalRowCountFactor.GetAsFloat())
 		params.Save("queryCoord.globalRowCountFactor", "0.4")
 		assert.Equal(t, 0.4, Params.GlobalRowCountFactor.GetAsFloat())
+		assert.Equal(t, 1.0, Params.DelegatorGrowingRowFactor.GetAsFloat())
+		assert.Equal(t, 1.0, Params.DelegatorDeleteBufferRowFactor.GetAsFloat())
+		assert.Equal(t, 10.0, Params.DelegatorForwardRateFactor.GetAsFloat())
 
 		assert.Equal(t, 0.05, Params.ScoreUnbalanceTolerationFactor.GetAsFloat())
 		params.Save("queryCoord.scoreUnbalanceTolerationFactor", "0.4")
@@ -303,6 +412,11 @@ func TestComponentParam(t *testing.T) {
 		nprobe := Params.GrowingIndexNProbe.GetAsInt64()
 		assert.Equal(t, int64(16), nprobe)
 
+		assert.True(t, Params.FilterPlannerEnabled.GetAsBool())
+		assert.Equal(t, 0.01, Params.FilterPlannerBruteForceRatio.GetAsFloat())
+		assert.Equal(t, 0.95, Params.FilterPlannerPostFilterRatio.GetAsFloat())
+		assert.Equal(t, 2.0, Params.FilterPlannerPostFilterAmplification.GetAsFloat())
+
 		assert.Equal(t, true, Params.GroupEnabled.GetAsBool())
 		assert.Equal(t, int32(10240), Params.MaxReceiveChanSize.GetAsInt32())
 		assert.Equal(t, int32(10240), Params.MaxUnsolvedQueueSize.GetAsInt32())
@@ -343,14 +457,63 @@ func TestComponentParam(t *testing.T) {
 		assert.Equal(t, int64(100), gracefulStopTimeout.GetAsInt64())
 
 		assert.Equal(t, false, Params.EnableWorkerSQCostMetrics.GetAsBool())
+
+		assert.Equal(t, 0.0, Params.ReadMemoryBudgetRatio.GetAsFloat())
+		assert.Equal(t, 3*time.Second, Params.ReadMemoryWaitTimeout.GetAsDuration(time.Millisecond))
+		assert.Equal(t, 1024, Params.ReadMemoryMaxWaitingTasks.GetAsInt())
+		assert.Equal(t, 0, Params.MaxSearchConcurrencyPerCollection.GetAsInt())
+		assert.False(t, Params.VerifyBinlogChecksum.GetAsBool())
+		assert.False(t, Params.MmapWarmupAuto.GetAsBool())
+		assert.Equal(t, 64, Params.MmapWarmupMaxRate.GetAsInt())
+		assert.Equal(t, int64(0), Params.FilterBitsetCacheSize.GetAsInt64())
+		assert.Equal(t, 10*time.Second, Params.FilterBitsetCacheTsBucket.GetAsDuration(time.Second))
+		assert.Equal(t, int64(16), Params.ScratchArenaMaxSize.GetAsInt64())
+		assert.Equal(t, time.Second, Params.PartialSearchSegmentTimeout.GetAsDuration(time.Millisecond))
+		assert.False(t, Params.DelegatorFallbackEnabled.GetAsBool())
 	})
 
 	t.Run("test dataCoordConfig", func(t *testing.T) {
 		Params := &params.DataCoordCfg
+		assert.Equal(t, 600*time.Second, Params.OrphanChannelCheckInterval.GetAsDuration(time.Second))
 		assert.Equal(t, 24*60*60*time.Second, Params.SegmentMaxLifetime.GetAsDuration(time.Second))
 		assert.True(t, Params.EnableGarbageCollection.GetAsBool())
+		assert.Equal(t, 100, Params.GCChecksumVerifyBatch.GetAsInt())
+		assert.False(t, Params.BinlogConsolidationEnable.GetAsBool())
+		assert.Equal(t, 600*time.Second, Params.BinlogConsolidationInterval.GetAsDuration(time.Second))
+		assert.Equal(t, 8, Params.BinlogConsolidationMinBinlogNum.GetAsInt())
+		assert.Equal(t, 1.0, Params.BinlogConsolidationSmallBinlogSize.GetAsFloat())
+		assert.Equal(t, 64.0, Params.BinlogConsolidationTargetSize.GetAsFloat())
+		assert.Equal(t, 10, Params.BinlogConsolidationMaxSegments.GetAsInt())
+		assert.False(t, Params.UploadRateLimitEnable.GetAsBool())
+		assert.Equal(t, 3000.0, Params.UploadRateLimitDefaultPutRate.GetAsFloat())
+		assert.Equal(t, "", Params.UploadRateLimitEndpointPutRates.GetValue())
+		assert.Equal(t, 10*time.Second, Params.UploadRateLimitGrantInterval.GetAsDuration(time.Second))
+		assert.True(t, Params.PartitionRolloverEnable.GetAsBool())
+		assert.Equal(t, time.Minute, Params.PartitionRolloverCheckInterval.GetAsDuration(time.Second))
 		assert.Equal(t, Params.EnableActiveStandby.GetAsBool(), false)
 		t.Logf("dataCoord EnableActiveStandby = %t", Params.EnableActiveStandby.GetAsBool())
+		assert.False(t, Params.CompactionVerificationEnabled.GetAsBool())
+		assert.Equal(t, 4, Params.CompactionVerificationPkSampleLogs.GetAsInt())
+		assert.Equal(t, int64(1000000), Params.CompactionVerificationPkMaxKeys.GetAsInt64())
+		assert.Equal(t, 3, Params.CompactionVerificationMaxRetry.GetAsInt())
+		assert.True(t, Params.CompactionPreferDedicatedNode.GetAsBool())
+		assert.False(t, Params.EnableLevelZeroSegment.GetAsBool())
+		assert.False(t, Params.SegmentDynamicSizeEnabled.GetAsBool())
+		assert.Equal(t, 10*time.Minute, Params.SegmentTargetIndexBuildTime.GetAsDuration(time.Second))
+		assert.Equal(t, 0.25, Params.SegmentDynamicSizeMinRatio.GetAsFloat())
+		assert.Equal(t, 4.0, Params.SegmentDynamicSizeMaxRatio.GetAsFloat())
+		assert.Equal(t, int64(8*1024*1024), Params.LevelZeroCompactionTriggerMinSize.GetAsInt64())
+		assert.Equal(t, 10, Params.LevelZeroCompactionTriggerDeltalogMinNum.GetAsInt())
+		assert.Equal(t, 10*time.Minute, Params.LevelZeroCompactionTriggerMaxInterval.GetAsDuration(time.Second))
+		assert.False(t, Params.AutoIndexOnSealEnabled.GetAsBool())
+		assert.Empty(t, Params.AutoIndexOnSealDatabases.GetValue())
+		assert.NotEmpty(t, Params.AutoIndexOnSealPolicies.GetValue())
+		assert.False(t, Params.ImportPreValidationEnabled.GetAsBool())
+		assert.Equal(t, int64(1000), Params.ImportPreValidationSampleRows.GetAsInt64())
+		assert.Equal(t, 0.01, Params.ImportPreValidationMaxPKDuplicateRatio.GetAsFloat())
+		assert.Equal(t, 8, Params.StorageMigrationParallelism.GetAsInt())
+		assert.Equal(t, 5, Params.StorageMigrationMaxRounds.GetAsInt())
+		assert.True(t, Params.SegmentEventPushEnabled.GetAsBool())
 	})
 
 	t.Run("test dataNodeConfig", func(t *testing.T) {
@@ -369,6 +532,9 @@ func TestComponentParam(t *testing.T) {
 
 		maxParallelSyncTaskNum := Params.MaxParallelSyncTaskNum.GetAsInt()
 		t.Logf("maxParallelSyncTaskNum: %d", maxParallelSyncTaskNum)
+		assert.True(t, Params.ZeroCopyInsertEnabled.GetAsBool())
+		assert.True(t, Params.InsertBatchEnabled.GetAsBool())
+		assert.Equal(t, 1024, Params.InsertBatchMaxRows.GetAsInt())
 
 		size := Params.FlushInsertBufferSize.GetAsInt()
 		t.Logf("FlushInsertBufferSize: %d", size)
@@ -376,6 +542,9 @@ func TestComponentParam(t *testing.T) {
 		period := &Params.SyncPeriod
 		t.Logf("SyncPeriod: %v", period)
 		assert.Equal(t, 10*time.Minute, Params.SyncPeriod.GetAsDuration(time.Second))
+		assert.Equal(t, 30*time.Second, Params.LevelZeroSyncPeriod.GetAsDuration(time.Second))
+		assert.Equal(t, "roaring", Params.DeltalogFormat.GetValue())
+		assert.Equal(t, 8, Params.StatsLogMergeNum.GetAsInt())
 
 		bulkinsertTimeout := &Params.BulkInsertTimeoutSeconds
 		t.Logf("BulkInsertTimeoutSeconds: %v", bulkinsertTimeout)
@@ -384,12 +553,26 @@ func TestComponentParam(t *testing.T) {
 		channelWorkPoolSize := Params.ChannelWorkPoolSize.GetAsInt()
 		t.Logf("channelWorkPoolSize: %d", channelWorkPoolSize)
 		assert.Equal(t, -1, Params.ChannelWorkPoolSize.GetAsInt())
+		assert.Equal(t, 8, Params.ChannelRecoveryConcurrency.GetAsInt())
+		assert.Equal(t, int64(1024), Params.ChannelRecoveryMemoryBudget.GetAsInt64())
+		assert.Equal(t, -1, Params.DataNodeTimeTickPoolSize.GetAsInt())
+		assert.Equal(t, time.Minute, Params.DataNodeTimeTickLagThreshold.GetAsDuration(time.Second))
+
+		assert.False(t, Params.CDCEnabled.GetAsBool())
+		assert.Equal(t, "kafka", Params.CDCMQType.GetValue())
+		assert.Equal(t, "milvus-cdc", Params.CDCTopicPrefix.GetValue())
+		assert.Equal(t, 1024, Params.CDCQueueSize.GetAsInt())
+		assert.Equal(t, 10, Params.CDCMaxRetries.GetAsInt())
+		assert.False(t, Params.CompactionDedicated.GetAsBool())
 	})
 
 	t.Run("test indexNodeConfig", func(t *testing.T) {
 		Params := &params.IndexNodeCfg
 		params.Save(Params.GracefulStopTimeout.Key, "50")
 		assert.Equal(t, Params.GracefulStopTimeout.GetAsInt64(), int64(50))
+
+		assert.True(t, Params.BuildCheckpointEnabled.GetAsBool())
+		assert.Equal(t, 60*time.Second, Params.BuildCheckpointMinBuildTime.GetAsDuration(time.Second))
 	})
 
 	t.Run("channel config priority", func(t *testing.T) {
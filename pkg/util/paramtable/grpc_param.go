@@ -63,6 +63,9 @@ type grpcConfig struct {
 	ServerPemPath ParamItem `refreshable:"false"`
 	ServerKeyPath ParamItem `refreshable:"false"`
 	CaPemPath     ParamItem `refreshable:"false"`
+
+	CrlPath           ParamItem `refreshable:"false"`
+	CrlReloadInterval ParamItem `refreshable:"true"`
 }
 
 func (p *grpcConfig) init(domain string, base *BaseTable) {
@@ -119,6 +122,23 @@ func (p *grpcConfig) init(domain string, base *BaseTable) {
 		Export:  true,
 	}
 	p.CaPemPath.Init(base.mgr)
+
+	p.CrlPath = ParamItem{
+		Key:     "tls.crlPath",
+		Version: "2.3.2",
+		Doc:     "The certificate revocation list in PEM, the revoked client certificates are rejected when tlsMode is 2",
+		Export:  true,
+	}
+	p.CrlPath.Init(base.mgr)
+
+	p.CrlReloadInterval = ParamItem{
+		Key:          "tls.crlReloadInterval",
+		Version:      "2.3.2",
+		DefaultValue: "60",
+		Doc:          "The interval in seconds to check and reload the updated certificate revocation list",
+		Export:       true,
+	}
+	p.CrlReloadInterval.Init(base.mgr)
 }
 
 // GetAddress return grpc address
@@ -172,4 +172,9 @@ func TestGrpcClientParams(t *testing.T) {
 	assert.Equal(t, clientConfig.ServerPemPath.GetValue(), "/pem")
 	assert.Equal(t, clientConfig.ServerKeyPath.GetValue(), "/key")
 	assert.Equal(t, clientConfig.CaPemPath.GetValue(), "/ca")
+
+	assert.Equal(t, clientConfig.CrlPath.GetValue(), "")
+	assert.Equal(t, clientConfig.CrlReloadInterval.GetAsInt(), 60)
+	base.Save("tls.crlPath", "/crl")
+	assert.Equal(t, clientConfig.CrlPath.GetValue(), "/crl")
 }
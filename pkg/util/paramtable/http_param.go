@@ -4,6 +4,12 @@ type httpConfig struct {
 	Enabled   ParamItem `refreshable:"false"`
 	DebugMode ParamItem `refreshable:"false"`
 	Port      ParamItem `refreshable:"false"`
+
+	GrpcWebEnabled        ParamItem `refreshable:"false"`
+	GrpcWebAllowedOrigins ParamItem `refreshable:"true"`
+	GrpcWebAllowedHeaders ParamItem `refreshable:"true"`
+
+	SQLEnabled ParamItem `refreshable:"false"`
 }
 
 func (p *httpConfig) init(base *BaseTable) {
@@ -33,4 +39,40 @@ func (p *httpConfig) init(base *BaseTable) {
 		Export:       true,
 	}
 	p.Port.Init(base.mgr)
+
+	p.GrpcWebEnabled = ParamItem{
+		Key:          "proxy.http.grpcWeb.enabled",
+		DefaultValue: "false",
+		Version:      "2.3.2",
+		Doc:          "Whether to serve gRPC-Web requests from browser SDKs on the http port",
+		Export:       true,
+	}
+	p.GrpcWebEnabled.Init(base.mgr)
+
+	p.GrpcWebAllowedOrigins = ParamItem{
+		Key:          "proxy.http.grpcWeb.allowedOrigins",
+		DefaultValue: "",
+		Version:      "2.3.2",
+		Doc:          "Comma separated origins allowed to send gRPC-Web requests from browser, * means any origin without credentials, no origin allowed if empty",
+		Export:       true,
+	}
+	p.GrpcWebAllowedOrigins.Init(base.mgr)
+
+	p.GrpcWebAllowedHeaders = ParamItem{
+		Key:          "proxy.http.grpcWeb.allowedHeaders",
+		DefaultValue: "",
+		Version:      "2.3.2",
+		Doc:          "Comma separated extra request headers allowed in CORS preflight of gRPC-Web requests",
+		Export:       true,
+	}
+	p.GrpcWebAllowedHeaders.Init(base.mgr)
+
+	p.SQLEnabled = ParamItem{
+		Key:          "proxy.http.sql.enabled",
+		DefaultValue: "false",
+		Version:      "2.3.2",
+		Doc:          "Whether to serve the experimental endpoint translating a restricted sql dialect to query or search",
+		Export:       true,
+	}
+	p.SQLEnabled.Init(base.mgr)
 }
@@ -13,4 +13,7 @@ func TestHTTPConfig_Init(t *testing.T) {
 	assert.Equal(t, cfg.Enabled.GetAsBool(), true)
 	assert.Equal(t, cfg.DebugMode.GetAsBool(), false)
 	assert.Equal(t, cfg.Port.GetValue(), "")
+	assert.Equal(t, cfg.GrpcWebEnabled.GetAsBool(), false)
+	assert.Equal(t, cfg.GrpcWebAllowedOrigins.GetValue(), "")
+	assert.Equal(t, cfg.SQLEnabled.GetAsBool(), false)
 }
@@ -33,6 +33,9 @@ type ParamItem struct {
 
 	Formatter func(originValue string) string
 	Forbidden bool
+	// Validator rejects the refreshed value which breaks the constraints of the param,
+	// the value is formatted before validation
+	Validator config.Validator
 
 	manager *config.Manager
 
@@ -45,6 +48,18 @@ func (pi *ParamItem) Init(manager *config.Manager) {
 	if pi.Forbidden {
 		pi.manager.ForbidUpdate(pi.Key)
 	}
+	if pi.Validator != nil {
+		validator := func(value string) error {
+			if pi.Formatter != nil {
+				value = pi.Formatter(value)
+			}
+			return pi.Validator(value)
+		}
+		pi.manager.RegisterValidator(pi.Key, validator)
+		for _, key := range pi.FallbackKeys {
+			pi.manager.RegisterValidator(key, validator)
+		}
+	}
 }
 
 // Get original value with error
@@ -220,6 +235,54 @@ func getAsDuration(v string, unit time.Duration) time.Duration {
 	}, 0)
 }
 
+// IntRange returns a validator which accepts integers in [min, max]
+func IntRange(min, max int64) config.Validator {
+	return func(value string) error {
+		v, err := strconv.ParseInt(value, 10, 64)
+		if err != nil {
+			return err
+		}
+		if v < min || v > max {
+			return fmt.Errorf("%d out of range [%d, %d]", v, min, max)
+		}
+		return nil
+	}
+}
+
+// FloatRange returns a validator which accepts floats in [min, max]
+func FloatRange(min, max float64) config.Validator {
+	return func(value string) error {
+		v, err := strconv.ParseFloat(value, 64)
+		if err != nil {
+			return err
+		}
+		if v < min || v > max {
+			return fmt.Errorf("%v out of range [%v, %v]", v, min, max)
+		}
+		return nil
+	}
+}
+
+// BoolValue returns a validator which accepts values could be parsed as bool
+func BoolValue() config.Validator {
+	return func(value string) error {
+		_, err := strconv.ParseBool(value)
+		return err
+	}
+}
+
+// OneOf returns a validator which accepts the given values only
+func OneOf(values ...string) config.Validator {
+	return func(value string) error {
+		for _, v := range values {
+			if value == v {
+				return nil
+			}
+		}
+		return fmt.Errorf("%s not in %v", value, values)
+	}
+}
+
 func getAndConvert[T any](v string, converter func(input string) (T, error), defaultValue T) T {
 	t, err := converter(v)
 	if err != nil {
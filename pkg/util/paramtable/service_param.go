@@ -383,7 +383,10 @@ func (p *TiKVConfig) Init(base *BaseTable) {
 }
 
 type LocalStorageConfig struct {
-	Path ParamItem `refreshable:"false"`
+	Path              ParamItem `refreshable:"false"`
+	FsyncPolicy       ParamItem `refreshable:"false"`
+	DirShardNum       ParamItem `refreshable:"false"`
+	MinFreeSpaceRatio ParamItem `refreshable:"false"`
 }
 
 func (p *LocalStorageConfig) Init(base *BaseTable) {
@@ -395,6 +398,36 @@ func (p *LocalStorageConfig) Init(base *BaseTable) {
 		Export:       true,
 	}
 	p.Path.Init(base.mgr)
+
+	p.FsyncPolicy = ParamItem{
+		Key:          "localStorage.fsyncPolicy",
+		Version:      "2.3.2",
+		DefaultValue: "file",
+		Doc: `when the written files are flushed to disk if local storage is used as the persistent storage,
+none: leave it to the OS, file: sync the file before it's renamed to the target path,
+full: sync the parent directory after the rename too`,
+		Export: true,
+	}
+	p.FsyncPolicy.Init(base.mgr)
+
+	p.DirShardNum = ParamItem{
+		Key:          "localStorage.dirShardNum",
+		Version:      "2.3.2",
+		DefaultValue: "0",
+		Doc: `number of the sub directories to spread the files of each directory into, to avoid millions of binlogs in a single directory,
+0 means no sharding. The files written before the sharding enabled are still readable, but don't disable it once enabled`,
+		Export: true,
+	}
+	p.DirShardNum.Init(base.mgr)
+
+	p.MinFreeSpaceRatio = ParamItem{
+		Key:          "localStorage.minFreeSpaceRatio",
+		Version:      "2.3.2",
+		DefaultValue: "0.05",
+		Doc:          "the writes to local storage are rejected if the free space ratio of the filesystem would fall below it, 0 means no check",
+		Export:       true,
+	}
+	p.MinFreeSpaceRatio.Init(base.mgr)
 }
 
 type MetaStoreConfig struct {
@@ -722,6 +755,10 @@ type RocksmqConfig struct {
 	// only support {0,7}, 0 means no compress, 7 means zstd
 	// default [0,7].
 	CompressionTypes ParamItem `refreshable:"false"`
+	// RetentionIncludeUnacked expires the messages not acked by the lagging consumers as well
+	RetentionIncludeUnacked ParamItem `refreshable:"true"`
+	// TopicRetention overrides the retention of the topics with the given prefix
+	TopicRetention ParamGroup `refreshable:"true"`
 }
 
 func (r *RocksmqConfig) Init(base *BaseTable) {
@@ -792,6 +829,24 @@ please adjust in embedded Milvus: /tmp/milvus/rdb_data`,
 		Version:      "2.2.12",
 	}
 	r.CompressionTypes.Init(base.mgr)
+
+	r.RetentionIncludeUnacked = ParamItem{
+		Key:          "rocksmq.retentionIncludeUnacked",
+		DefaultValue: "false",
+		Version:      "2.3.2",
+		Doc: `Whether the messages not acked by the consumers are expired by retention as well,
+enable it to keep the disk usage bounded if the consumers lag, the lagging consumers lose the expired messages.`,
+		Export: true,
+	}
+	r.RetentionIncludeUnacked.Init(base.mgr)
+
+	r.TopicRetention = ParamGroup{
+		KeyPrefix: "rocksmq.topicRetention.",
+		Version:   "2.3.2",
+		Doc: `The retention of the topics with the given prefix, overriding retentionTimeInMinutes, retentionSizeInMB and retentionIncludeUnacked.
+e.g. rocksmq.topicRetention.by-dev-rootcoord-dml.retentionSizeInMB: 1024`,
+	}
+	r.TopicRetention.Init(base.mgr)
 }
 
 // NatsmqConfig describes the configuration options for the Nats message queue
@@ -946,6 +1001,13 @@ type MinioConfig struct {
 	Region           ParamItem `refreshable:"false"`
 	UseVirtualHost   ParamItem `refreshable:"false"`
 	RequestTimeoutMs ParamItem `refreshable:"false"`
+
+	CircuitBreakerThreshold    ParamItem `refreshable:"false"`
+	CircuitBreakerOpenDuration ParamItem `refreshable:"false"`
+
+	GcsCredentialFile  ParamItem `refreshable:"false"`
+	GcsKmsKeyName      ParamItem `refreshable:"false"`
+	GcsUploadChunkSize ParamItem `refreshable:"false"`
 }
 
 func (p *MinioConfig) Init(base *BaseTable) {
@@ -1104,4 +1166,50 @@ Leave it empty if you want to use AWS default endpoint`,
 		Export:       true,
 	}
 	p.RequestTimeoutMs.Init(base.mgr)
+
+	p.CircuitBreakerThreshold = ParamItem{
+		Key:          "minio.circuitBreaker.failureThreshold",
+		Version:      "2.3.2",
+		DefaultValue: "20",
+		Doc:          "consecutive throttling or server errors of the endpoint to open the circuit breaker, 0 to disable",
+		Export:       true,
+	}
+	p.CircuitBreakerThreshold.Init(base.mgr)
+
+	p.CircuitBreakerOpenDuration = ParamItem{
+		Key:          "minio.circuitBreaker.openDuration",
+		Version:      "2.3.2",
+		DefaultValue: "10",
+		Doc:          "seconds to fail the requests fast after the circuit breaker opens, before probing the endpoint again",
+		Export:       true,
+	}
+	p.CircuitBreakerOpenDuration.Init(base.mgr)
+
+	p.GcsCredentialFile = ParamItem{
+		Key:          "minio.gcs.credentialFile",
+		Version:      "2.3.2",
+		DefaultValue: "",
+		Doc: `credential file of the native gcs client if common.storageType is gcs, a service account key or an external account config
+of the workload identity federation, the application default credentials are used if empty and useIAM is true`,
+		Export: true,
+	}
+	p.GcsCredentialFile.Init(base.mgr)
+
+	p.GcsKmsKeyName = ParamItem{
+		Key:          "minio.gcs.kmsKeyName",
+		Version:      "2.3.2",
+		DefaultValue: "",
+		Doc:          "customer-managed encryption key to encrypt the objects written to gcs, in format of projects/*/locations/*/keyRings/*/cryptoKeys/*",
+		Export:       true,
+	}
+	p.GcsKmsKeyName.Init(base.mgr)
+
+	p.GcsUploadChunkSize = ParamItem{
+		Key:          "minio.gcs.uploadChunkSize",
+		Version:      "2.3.2",
+		DefaultValue: "16",
+		Doc:          "MB, the objects larger than the chunk size are uploaded to gcs in chunks by the resumable upload",
+		Export:       true,
+	}
+	p.GcsUploadChunkSize.Init(base.mgr)
 }
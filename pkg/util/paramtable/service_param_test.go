@@ -157,6 +157,8 @@ func TestServiceParam(t *testing.T) {
 
 		assert.NotEqual(t, Params.Path.GetValue(), "")
 		t.Logf("rocksmq path = %s", Params.Path.GetValue())
+		assert.False(t, Params.RetentionIncludeUnacked.GetAsBool())
+		assert.Empty(t, Params.TopicRetention.GetValue())
 	})
 
 	t.Run("test kafkaConfig", func(t *testing.T) {
@@ -172,6 +174,14 @@ func TestServiceParam(t *testing.T) {
 		}
 	})
 
+	t.Run("test localStorageConfig", func(t *testing.T) {
+		Params := &SParams.LocalStorageCfg
+
+		assert.Equal(t, "file", Params.FsyncPolicy.GetValue())
+		assert.Equal(t, 0, Params.DirShardNum.GetAsInt())
+		assert.Equal(t, 0.05, Params.MinFreeSpaceRatio.GetAsFloat())
+	})
+
 	t.Run("test minioConfig", func(t *testing.T) {
 		Params := &SParams.MinioCfg
 
@@ -192,6 +202,12 @@ func TestServiceParam(t *testing.T) {
 
 		assert.Equal(t, Params.IAMEndpoint.GetValue(), "")
 
+		assert.Equal(t, 20, Params.CircuitBreakerThreshold.GetAsInt())
+		assert.Equal(t, 10*time.Second, Params.CircuitBreakerOpenDuration.GetAsDuration(time.Second))
+		assert.Equal(t, "", Params.GcsCredentialFile.GetValue())
+		assert.Equal(t, "", Params.GcsKmsKeyName.GetValue())
+		assert.EqualValues(t, 16, Params.GcsUploadChunkSize.GetAsInt64())
+
 		t.Logf("Minio BucketName = %s", Params.BucketName.GetValue())
 
 		t.Logf("Minio rootpath = %s", Params.RootPath.GetValue())
@@ -17,6 +17,15 @@ type config struct {
 	attempts     uint
 	sleep        time.Duration
 	maxSleepTime time.Duration
+
+	// predicates to classify the errors, see classify
+	fatal     []Predicate
+	retriable []Predicate
+	refresh   []Predicate
+	refreshFn func(err error)
+
+	// callSite labels the metrics of the retried call, no metrics if empty
+	callSite string
 }
 
 func newDefaultConfig() *config {
@@ -59,3 +68,35 @@ func MaxSleepTime(maxSleepTime time.Duration) Option {
 		}
 	}
 }
+
+// FatalOn stops the retry immediately if the error matches any of the predicates,
+// like the collection not found error which never recovers by retry.
+func FatalOn(predicates ...Predicate) Option {
+	return func(c *config) {
+		c.fatal = append(c.fatal, predicates...)
+	}
+}
+
+// RetryOn retries only the errors matching any of the predicates, the others are fatal.
+// All the recoverable errors are retried if no RetryOn option.
+func RetryOn(predicates ...Predicate) Option {
+	return func(c *config) {
+		c.retriable = append(c.retriable, predicates...)
+	}
+}
+
+// RefreshOn calls refresh if the error matches any of the predicates, then retries immediately without backoff,
+// like deprecating the stale cache which leads to the error.
+func RefreshOn(refresh func(err error), predicates ...Predicate) Option {
+	return func(c *config) {
+		c.refreshFn = refresh
+		c.refresh = append(c.refresh, predicates...)
+	}
+}
+
+// CallSite names the retried call in the retry metrics, like "proxy.GetShardLeaders".
+func CallSite(callSite string) Option {
+	return func(c *config) {
+		c.callSite = callSite
+	}
+}
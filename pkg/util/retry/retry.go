@@ -19,10 +19,73 @@ import (
 	"go.uber.org/zap"
 
 	"github.com/milvus-io/milvus/pkg/log"
+	"github.com/milvus-io/milvus/pkg/metrics"
 	"github.com/milvus-io/milvus/pkg/util/funcutil"
 	"github.com/milvus-io/milvus/pkg/util/merr"
 )
 
+// ErrorClass is the class of the error returned by the retried function, which decides how to retry.
+type ErrorClass int
+
+const (
+	// ErrorRetriable errors are retried after backoff.
+	ErrorRetriable ErrorClass = iota
+	// ErrorFatal errors stop the retry immediately.
+	ErrorFatal
+	// ErrorNeedsRefresh errors are retried immediately after refreshed.
+	ErrorNeedsRefresh
+)
+
+func (c ErrorClass) String() string {
+	switch c {
+	case ErrorRetriable:
+		return "retriable"
+	case ErrorFatal:
+		return "fatal"
+	case ErrorNeedsRefresh:
+		return "needs_refresh"
+	}
+	return "unknown"
+}
+
+// Predicate reports whether the error belongs to a class.
+type Predicate func(err error) bool
+
+// ErrorIs returns the predicate matching the errors which are any of the targets.
+func ErrorIs(targets ...error) Predicate {
+	return func(err error) bool {
+		for _, target := range targets {
+			if errors.Is(err, target) {
+				return true
+			}
+		}
+		return false
+	}
+}
+
+func matchAny(err error, predicates []Predicate) bool {
+	for _, predicate := range predicates {
+		if predicate(err) {
+			return true
+		}
+	}
+	return false
+}
+
+// classify classifies the error, the unrecoverable and fatal errors take precedence over the others.
+func (c *config) classify(err error) ErrorClass {
+	switch {
+	case !IsRecoverable(err), matchAny(err, c.fatal):
+		return ErrorFatal
+	case matchAny(err, c.refresh):
+		return ErrorNeedsRefresh
+	case len(c.retriable) > 0 && !matchAny(err, c.retriable):
+		return ErrorFatal
+	default:
+		return ErrorRetriable
+	}
+}
+
 // Do will run function with retry mechanism.
 // fn is the func to run.
 // Option can control the retry times and timeout.
@@ -31,27 +94,54 @@ func Do(ctx context.Context, fn func() error, opts ...Option) error {
 		return ctx.Err()
 	}
 
-	log := log.Ctx(ctx)
 	c := newDefaultConfig()
 
 	for _, opt := range opts {
 		opt(c)
 	}
 
+	err := c.do(ctx, fn)
+	if c.callSite != "" {
+		status := metrics.SuccessLabel
+		if err != nil {
+			status = metrics.FailLabel
+		}
+		metrics.RetryCallCounter.WithLabelValues(c.callSite, status).Inc()
+	}
+	return err
+}
+
+func (c *config) do(ctx context.Context, fn func() error) error {
+	log := log.Ctx(ctx)
 	var el error
 
 	for i := uint(0); i < c.attempts; i++ {
 		if err := fn(); err != nil {
 			if i%4 == 0 {
-				log.Error("retry func failed", zap.Uint("retry time", i), zap.Error(err))
+				log.Error("retry func failed", zap.Uint("retry time", i), zap.String("callSite", c.callSite), zap.Error(err))
+			}
+
+			class := c.classify(err)
+			if c.callSite != "" {
+				metrics.RetryFailedAttemptCounter.WithLabelValues(c.callSite, class.String()).Inc()
 			}
 
 			err = errors.Wrapf(err, "attempt #%d", i)
 			el = merr.Combine(el, err)
 
-			if !IsRecoverable(err) {
+			if class == ErrorFatal {
 				return el
 			}
+			// no more attempt if the caller has gone
+			if ctx.Err() != nil {
+				return merr.Combine(el, ctx.Err())
+			}
+			if class == ErrorNeedsRefresh {
+				if c.refreshFn != nil {
+					c.refreshFn(err)
+				}
+				continue
+			}
 
 			select {
 			case <-time.After(c.sleep):
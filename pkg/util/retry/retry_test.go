@@ -145,3 +145,73 @@ func TestWrap(t *testing.T) {
 	assert.True(t, errors.Is(err2, merr.ErrSegmentNotFound))
 	assert.False(t, IsRecoverable(err2))
 }
+
+func TestErrorClass(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("fatal on", func(t *testing.T) {
+		attempts := 0
+		err := Do(ctx, func() error {
+			attempts++
+			return merr.WrapErrCollectionNotFound(1)
+		}, Attempts(3), Sleep(time.Millisecond), FatalOn(ErrorIs(merr.ErrCollectionNotFound)), CallSite("test.fatal"))
+		assert.ErrorIs(t, err, merr.ErrCollectionNotFound)
+		assert.Equal(t, 1, attempts)
+	})
+
+	t.Run("retry on", func(t *testing.T) {
+		attempts := 0
+		err := Do(ctx, func() error {
+			attempts++
+			if attempts < 2 {
+				return merr.WrapErrServiceNotReady("test", 1, "initializing")
+			}
+			return merr.WrapErrParameterInvalidMsg("invalid")
+		}, Attempts(5), Sleep(time.Millisecond), RetryOn(ErrorIs(merr.ErrServiceNotReady)))
+		assert.ErrorIs(t, err, merr.ErrParameterInvalid)
+		assert.Equal(t, 2, attempts)
+	})
+
+	t.Run("refresh on", func(t *testing.T) {
+		attempts, refreshed := 0, 0
+		start := time.Now()
+		err := Do(ctx, func() error {
+			attempts++
+			if attempts < 3 {
+				return merr.WrapErrChannelNotAvailable("ch")
+			}
+			return nil
+		}, Attempts(5), Sleep(time.Second), RefreshOn(func(err error) {
+			assert.ErrorIs(t, err, merr.ErrChannelNotAvailable)
+			refreshed++
+		}, ErrorIs(merr.ErrChannelNotAvailable)), CallSite("test.refresh"))
+		assert.NoError(t, err)
+		assert.Equal(t, 2, refreshed)
+		// retried without backoff
+		assert.Less(t, time.Since(start), time.Second)
+	})
+
+	t.Run("unrecoverable takes precedence", func(t *testing.T) {
+		attempts := 0
+		err := Do(ctx, func() error {
+			attempts++
+			return Unrecoverable(merr.WrapErrChannelNotAvailable("ch"))
+		}, Attempts(3), RefreshOn(func(error) {}, ErrorIs(merr.ErrChannelNotAvailable)))
+		assert.Error(t, err)
+		assert.Equal(t, 1, attempts)
+	})
+
+	assert.Equal(t, "needs_refresh", ErrorNeedsRefresh.String())
+}
+
+func TestCallerGone(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	attempts := 0
+	err := Do(ctx, func() error {
+		attempts++
+		cancel()
+		return errors.New("some error")
+	}, Attempts(3), Sleep(time.Millisecond))
+	assert.True(t, merr.IsCanceledOrTimeout(err))
+	assert.Equal(t, 1, attempts)
+}
@@ -0,0 +1,164 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package tsoutil
+
+import (
+	"sync"
+	"time"
+)
+
+// rateWindow is the minimal window to calculate the allocation rate.
+const rateWindow = 10 * time.Second
+
+// DriftAlarm is raised when the drift between the physical part of the allocated timestamps and the wall clock
+// exceeds the threshold, and cleared once the drift gets back under the threshold.
+type DriftAlarm struct {
+	Raised    bool          `json:"raised"`
+	Drift     time.Duration `json:"drift"`
+	Threshold time.Duration `json:"threshold"`
+	Timestamp uint64        `json:"timestamp"`
+	Time      time.Time     `json:"time"`
+}
+
+// DiagnosticsSnapshot is the snapshot of the timestamp allocation diagnostics.
+type DiagnosticsSnapshot struct {
+	LastTimestamp uint64    `json:"last_timestamp"`
+	LastPhysical  time.Time `json:"last_physical"`
+	LastObserved  time.Time `json:"last_observed"`
+	// DriftMs is the physical part of the last allocated timestamp minus the wall clock,
+	// positive if the timestamps run ahead of the wall clock
+	DriftMs    int64 `json:"drift_ms"`
+	MaxDriftMs int64 `json:"max_drift_ms"`
+	// Regressions is the number of the allocated timestamps not greater than the previous one
+	Regressions int64   `json:"regressions"`
+	Allocated   int64   `json:"allocated"`
+	AllocRate   float64 `json:"alloc_rate"`
+	Alarming    bool    `json:"alarming"`
+	ThresholdMs int64   `json:"threshold_ms"`
+	Alarms      int64   `json:"alarms"`
+}
+
+// Diagnostics tracks the drift between the hybrid timestamps and the wall clock and the allocation rate,
+// so the clock issues surface as alarms rather than mysterious consistency bugs.
+type Diagnostics struct {
+	mu        sync.Mutex
+	threshold func() time.Duration
+	onAlarm   func(*DriftAlarm)
+
+	lastTs       uint64
+	lastObserved time.Time
+	drift        time.Duration
+	maxDrift     time.Duration
+	regressions  int64
+	allocated    int64
+	alarming     bool
+	alarms       int64
+
+	windowStart     time.Time
+	windowAllocated int64
+	allocRate       float64
+}
+
+// NewDiagnostics creates the diagnostics alarming when the absolute drift exceeds the threshold,
+// non-positive threshold disables the alarms. The onAlarm is called on raising and clearing the alarm.
+func NewDiagnostics(threshold func() time.Duration, onAlarm func(*DriftAlarm)) *Diagnostics {
+	return &Diagnostics{
+		threshold: threshold,
+		onAlarm:   onAlarm,
+	}
+}
+
+// Observe records the count timestamps allocated starting from ts at the wall clock now.
+func (d *Diagnostics) Observe(ts uint64, count uint32, now time.Time) {
+	d.mu.Lock()
+	if d.lastTs != 0 && ts <= d.lastTs {
+		d.regressions++
+	}
+	d.lastTs = ts
+	d.lastObserved = now
+	d.drift = PhysicalTime(ts).Sub(now)
+	if abs(d.drift) > abs(d.maxDrift) {
+		d.maxDrift = d.drift
+	}
+	d.allocated += int64(count)
+
+	if d.windowStart.IsZero() {
+		d.windowStart = now
+	}
+	d.windowAllocated += int64(count)
+	if elapsed := now.Sub(d.windowStart); elapsed >= rateWindow {
+		d.allocRate = float64(d.windowAllocated) / elapsed.Seconds()
+		d.windowStart = now
+		d.windowAllocated = 0
+	}
+
+	alarm := d.checkAlarm(ts, now)
+	d.mu.Unlock()
+
+	if alarm != nil && d.onAlarm != nil {
+		d.onAlarm(alarm)
+	}
+}
+
+// checkAlarm returns the alarm if the alarm state changes, must be called with the lock held.
+func (d *Diagnostics) checkAlarm(ts uint64, now time.Time) *DriftAlarm {
+	threshold := d.threshold()
+	exceeded := threshold > 0 && abs(d.drift) > threshold
+	if exceeded == d.alarming {
+		return nil
+	}
+	d.alarming = exceeded
+	if exceeded {
+		d.alarms++
+	}
+	return &DriftAlarm{
+		Raised:    exceeded,
+		Drift:     d.drift,
+		Threshold: threshold,
+		Timestamp: ts,
+		Time:      now,
+	}
+}
+
+// Snapshot returns the current diagnostics.
+func (d *Diagnostics) Snapshot() *DiagnosticsSnapshot {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	snapshot := &DiagnosticsSnapshot{
+		LastTimestamp: d.lastTs,
+		LastObserved:  d.lastObserved,
+		DriftMs:       d.drift.Milliseconds(),
+		MaxDriftMs:    d.maxDrift.Milliseconds(),
+		Regressions:   d.regressions,
+		Allocated:     d.allocated,
+		AllocRate:     d.allocRate,
+		Alarming:      d.alarming,
+		ThresholdMs:   d.threshold().Milliseconds(),
+		Alarms:        d.alarms,
+	}
+	if d.lastTs != 0 {
+		snapshot.LastPhysical = PhysicalTime(d.lastTs)
+	}
+	return snapshot
+}
+
+func abs(d time.Duration) time.Duration {
+	if d < 0 {
+		return -d
+	}
+	return d
+}
@@ -0,0 +1,76 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package tsoutil
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDiagnostics(t *testing.T) {
+	alarms := make([]*DriftAlarm, 0)
+	d := NewDiagnostics(func() time.Duration { return time.Second }, func(alarm *DriftAlarm) {
+		alarms = append(alarms, alarm)
+	})
+	// the timestamps are in milliseconds
+	now := time.UnixMilli(time.Now().UnixMilli())
+
+	d.Observe(ComposeTSByTime(now, 0), 10, now)
+	snapshot := d.Snapshot()
+	assert.EqualValues(t, 0, snapshot.DriftMs)
+	assert.EqualValues(t, 10, snapshot.Allocated)
+	assert.False(t, snapshot.Alarming)
+	assert.Empty(t, alarms)
+
+	// the wall clock jumps back by 5 seconds while the timestamps keep increasing
+	now = now.Add(-5 * time.Second)
+	d.Observe(ComposeTSByTime(now.Add(5*time.Second), 1), 10, now)
+	snapshot = d.Snapshot()
+	assert.EqualValues(t, 5000, snapshot.DriftMs)
+	assert.True(t, snapshot.Alarming)
+	assert.EqualValues(t, 1, snapshot.Alarms)
+	assert.Len(t, alarms, 1)
+	assert.True(t, alarms[0].Raised)
+
+	// the alarm is raised only once
+	d.Observe(ComposeTSByTime(now.Add(5*time.Second), 2), 10, now)
+	assert.Len(t, alarms, 1)
+
+	// the wall clock catches up
+	now = now.Add(5*time.Second + rateWindow)
+	d.Observe(ComposeTSByTime(now, 0), 10, now)
+	snapshot = d.Snapshot()
+	assert.False(t, snapshot.Alarming)
+	assert.EqualValues(t, 5000, snapshot.MaxDriftMs)
+	assert.EqualValues(t, 40, snapshot.Allocated)
+	assert.InDelta(t, 4, snapshot.AllocRate, 0.5)
+	assert.Len(t, alarms, 2)
+	assert.False(t, alarms[1].Raised)
+
+	// regression
+	d.Observe(ComposeTSByTime(now.Add(-time.Millisecond), 0), 1, now)
+	assert.EqualValues(t, 1, d.Snapshot().Regressions)
+}
+
+func TestDiagnostics_Disabled(t *testing.T) {
+	d := NewDiagnostics(func() time.Duration { return 0 }, nil)
+	now := time.Now()
+	d.Observe(ComposeTSByTime(now.Add(time.Hour), 0), 1, now)
+	assert.False(t, d.Snapshot().Alarming)
+}
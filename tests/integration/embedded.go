@@ -0,0 +1,148 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package integration
+
+import (
+	"context"
+	"os"
+	"path"
+	"strings"
+
+	"go.uber.org/zap"
+
+	"github.com/milvus-io/milvus/internal/util/dependency"
+	"github.com/milvus-io/milvus/pkg/log"
+	"github.com/milvus-io/milvus/pkg/util/etcd"
+	"github.com/milvus-io/milvus/pkg/util/paramtable"
+)
+
+// EmbeddedCluster is a MiniCluster running all coordinators and nodes in current process,
+// with embedded etcd, rocksmq and local storage, so no thirdparty component is needed.
+// It could be used by the integration tests out of this repository, e.g. plugins and SDKs.
+//
+//	cluster, err := integration.StartEmbeddedCluster(ctx)
+//	if err != nil {
+//		t.Fatal(err)
+//	}
+//	defer cluster.Stop()
+//	cluster.Proxy.CreateCollection(ctx, req)
+type EmbeddedCluster struct {
+	*MiniCluster
+	EmbedEtcdSuite
+
+	// DataDir is the directory holding rocksmq and local storage data, removed after stopped.
+	DataDir string
+
+	// restoreEnv restores the env vars overwritten by the embedded cluster
+	restoreEnv func()
+}
+
+// StartEmbeddedCluster starts an EmbeddedCluster, the options are applied to the inner MiniCluster.
+func StartEmbeddedCluster(ctx context.Context, opts ...Option) (*EmbeddedCluster, error) {
+	cluster := &EmbeddedCluster{}
+	var err error
+	defer func() {
+		if err != nil {
+			cluster.cleanup()
+		}
+	}()
+
+	if err = cluster.SetupEmbedEtcd(); err != nil {
+		return nil, err
+	}
+	cluster.DataDir, err = os.MkdirTemp(os.TempDir(), "milvus_embedded")
+	if err != nil {
+		return nil, err
+	}
+
+	paramtable.Init()
+	params = paramtable.Get()
+	endpoints := strings.Join(etcd.GetEmbedEtcdEndpoints(cluster.EtcdServer), ",")
+	embeddedParams := map[string]string{
+		params.EtcdCfg.Endpoints.Key:                  endpoints,
+		params.EtcdCfg.UseEmbedEtcd.Key:               "false",
+		params.MQCfg.Type.Key:                         "rocksmq",
+		params.RocksmqCfg.Path.Key:                    path.Join(cluster.DataDir, "rocksmq"),
+		params.CommonCfg.StorageType.Key:              "local",
+		params.LocalStorageCfg.Path.Key:               path.Join(cluster.DataDir, "storage"),
+		params.MinioCfg.RootPath.Key:                  path.Join(cluster.DataDir, "storage"),
+		params.IntegrationTestCfg.IntegrationMode.Key: "true",
+	}
+	// etcd source of paramtable reads endpoints from env
+	cluster.restoreEnv = setEnv(params.EtcdCfg.Endpoints.Key, endpoints)
+	for k, v := range embeddedParams {
+		params.Save(k, v)
+	}
+
+	// embedded params shall not be overwritten by default params of mini cluster
+	opts = append([]Option{func(c *MiniCluster) {
+		for k, v := range embeddedParams {
+			c.params[k] = v
+		}
+		factory := dependency.NewFactory(true)
+		factory.Init(params)
+		c.factory = factory
+	}}, opts...)
+
+	cluster.MiniCluster, err = StartMiniCluster(ctx, opts...)
+	if err != nil {
+		return nil, err
+	}
+	if err = cluster.MiniCluster.Start(); err != nil {
+		// stop the components started before the failure
+		if stopErr := cluster.MiniCluster.Stop(); stopErr != nil {
+			log.Warn("failed to stop embedded cluster", zap.Error(stopErr))
+		}
+		return nil, err
+	}
+	log.Info("embedded cluster started", zap.String("etcd", endpoints), zap.String("dataDir", cluster.DataDir))
+	return cluster, nil
+}
+
+// setEnv sets the env var, returns the function to restore the previous value.
+func setEnv(key, value string) func() {
+	previous, ok := os.LookupEnv(key)
+	os.Setenv(key, value)
+	return func() {
+		if ok {
+			os.Setenv(key, previous)
+		} else {
+			os.Unsetenv(key)
+		}
+	}
+}
+
+// Stop stops all components and removes the data of embedded cluster.
+func (cluster *EmbeddedCluster) Stop() error {
+	var err error
+	if cluster.MiniCluster != nil {
+		err = cluster.MiniCluster.Stop()
+	}
+	cluster.cleanup()
+	return err
+}
+
+func (cluster *EmbeddedCluster) cleanup() {
+	cluster.TearDownEmbedEtcd()
+	if cluster.DataDir != "" {
+		os.RemoveAll(cluster.DataDir)
+	}
+	if cluster.restoreEnv != nil {
+		cluster.restoreEnv()
+		cluster.restoreEnv = nil
+	}
+}
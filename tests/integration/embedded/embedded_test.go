@@ -0,0 +1,69 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package embedded
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/golang/protobuf/proto"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/milvus-io/milvus-proto/go-api/v2/commonpb"
+	"github.com/milvus-io/milvus-proto/go-api/v2/milvuspb"
+	"github.com/milvus-io/milvus/pkg/common"
+	"github.com/milvus-io/milvus/pkg/util/funcutil"
+	"github.com/milvus-io/milvus/pkg/util/merr"
+	"github.com/milvus-io/milvus/tests/integration"
+)
+
+type EmbeddedClusterSuite struct {
+	suite.Suite
+}
+
+func (s *EmbeddedClusterSuite) TestStartAndStop() {
+	ctx, cancel := context.WithTimeout(context.Background(), 3*time.Minute)
+	defer cancel()
+
+	cluster, err := integration.StartEmbeddedCluster(ctx)
+	s.Require().NoError(err)
+	s.DirExists(cluster.DataDir)
+
+	health, err := cluster.Proxy.CheckHealth(ctx, &milvuspb.CheckHealthRequest{})
+	s.NoError(err)
+	s.True(health.GetIsHealthy())
+
+	collectionName := "TestEmbeddedCluster" + funcutil.GenRandomStr()
+	schema := integration.ConstructSchema(collectionName, 128, true)
+	marshaledSchema, err := proto.Marshal(schema)
+	s.NoError(err)
+	status, err := cluster.Proxy.CreateCollection(ctx, &milvuspb.CreateCollectionRequest{
+		CollectionName:   collectionName,
+		Schema:           marshaledSchema,
+		ShardsNum:        common.DefaultShardsNum,
+		ConsistencyLevel: commonpb.ConsistencyLevel_Strong,
+	})
+	s.NoError(merr.CheckRPCCall(status, err))
+
+	s.NoError(cluster.Stop())
+	s.NoDirExists(cluster.DataDir)
+}
+
+func TestEmbeddedCluster(t *testing.T) {
+	suite.Run(t, new(EmbeddedClusterSuite))
+}